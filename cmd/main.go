@@ -1,10 +1,15 @@
 package main
 
 import (
+	"context"
+	"database/sql"
 	"log"
 	"net"
 	"os"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
@@ -14,14 +19,22 @@ import (
 	"github.com/longgggwwww/hrm-ms-hr/internal/grpc_clients"
 	"github.com/longgggwwww/hrm-ms-hr/internal/handlers"
 	"github.com/longgggwwww/hrm-ms-hr/internal/kafka"
+	"github.com/longgggwwww/hrm-ms-hr/internal/scheduler"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/recurrence"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/storage"
 	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
 )
 
+// attachmentReapBatch caps the orphaned attachments removed per run
+const attachmentReapBatch = 500
+
 func main() {
 	// Initialize custom validators
 	utils.InitValidator()
 
-	cli := initDatabase()
+	cli, db := initDatabase()
 	defer cli.Close()
 
 	// Initialize Kafka client
@@ -32,30 +45,39 @@ func main() {
 		}
 	}()
 
+	attachmentHandler := initAttachments(cli)
+
 	log.Println("Starting HR microservice...")
-	go startHTTPServer(cli, kafkaClient)
+	go startScheduler(cli, db, kafkaClient, attachmentHandler.Service)
+	go startHTTPServer(cli, kafkaClient, attachmentHandler)
 	startGRPCServer(cli)
 }
 
-func initDatabase() *ent.Client {
+// initDatabase opens the ent client and the connection pool under it, which
+// the scheduler takes its leader lock on
+func initDatabase() (*ent.Client, *sql.DB) {
 	connStr := os.Getenv("DB_URL")
 	if connStr == "" {
 		log.Fatal("DB_URL environment variable is not set")
 	}
 
-	cli, err := ent.Open("postgres", connStr)
+	db, err := sql.Open("postgres", connStr)
 	if err != nil {
 		log.Fatalf("failed opening connection to postgres: %v", err)
 	}
+	cli := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 
 	log.Println("Database connection established successfully")
-	return cli
+	return cli, db
 }
 
-func startHTTPServer(cli *ent.Client, kafkaClient *kafka.KafkaClient) {
+func startHTTPServer(cli *ent.Client, kafkaClient *kafka.KafkaClient, attachmentHandler *handlers.AttachmentHandler) {
 	r := gin.Default()
 	userServ := setupExternalServices()
-	registerHTTPRoutes(r, cli, userServ, kafkaClient)
+	if os.Getenv("CURSOR_SIGNING_KEY") == "" {
+		log.Println("Warning: CURSOR_SIGNING_KEY is not set, task list cursors will not survive a restart")
+	}
+	registerHTTPRoutes(r, cli, userServ, kafkaClient, attachmentHandler)
 
 	log.Println("Starting HTTP server on port 8080...")
 	if err := r.Run(":8080"); err != nil {
@@ -63,6 +85,68 @@ func startHTTPServer(cli *ent.Client, kafkaClient *kafka.KafkaClient) {
 	}
 }
 
+// startScheduler runs the periodic jobs of the service on one replica at a
+// time:
+//   - recurring task templates create their tasks
+//   - assignees are reminded of tasks coming due and overdue
+//   - attachments of deleted records are removed with their content
+func startScheduler(cli *ent.Client, db *sql.DB, kafkaClient *kafka.KafkaClient, attachments *attachment.AttachmentService) {
+	taskSvc := task.NewTaskService(cli)
+	taskSvc.SetKafkaClient(kafkaClient)
+	runner := recurrence.NewRunner(recurrence.NewRecurrenceService(cli, taskSvc), envDuration("RECURRENCE_POLL_INTERVAL", time.Minute))
+
+	sched := scheduler.New(db, scheduler.DefaultLockKey, envDuration("SCHEDULER_TICK", 30*time.Second))
+	sched.Register(scheduler.Job{
+		Name:     "recurrence",
+		Interval: runner.Interval,
+		Run: func(ctx context.Context, now time.Time) error {
+			runner.RunOnce(ctx)
+			return nil
+		},
+	})
+	sched.Register(scheduler.Job{
+		Name:     "task_reminders",
+		Interval: envDuration("REMINDER_POLL_INTERVAL", 5*time.Minute),
+		Run: func(ctx context.Context, now time.Time) error {
+			sent, err := taskSvc.SendReminders(ctx, now)
+			if sent > 0 {
+				log.Printf("Sent %d task reminders", sent)
+			}
+			return err
+		},
+	})
+	if attachments.Storage != nil {
+		sched.Register(scheduler.Job{
+			Name:     "attachment_reaper",
+			Interval: envDuration("ATTACHMENT_REAP_INTERVAL", 10*time.Minute),
+			Run: func(ctx context.Context, now time.Time) error {
+				reaped, err := attachments.ReapOrphans(ctx, attachmentReapBatch)
+				if reaped > 0 {
+					log.Printf("Removed %d attachments of deleted records", reaped)
+				}
+				return err
+			},
+		})
+	}
+
+	log.Println("Starting scheduler...")
+	sched.Run(context.Background())
+}
+
+// envDuration reads a positive duration from an environment variable
+func envDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed <= 0 {
+		log.Printf("Warning: invalid %s %q, using %s", key, value, fallback)
+		return fallback
+	}
+	return parsed
+}
+
 func setupExternalServices() grpc_clients.UserServiceClient {
 	userServiceAddr := os.Getenv("USER_SERVICE")
 	if userServiceAddr == "" {
@@ -74,7 +158,27 @@ func setupExternalServices() grpc_clients.UserServiceClient {
 	return grpc_clients.NewUserClient(userServiceAddr)
 }
 
-func registerHTTPRoutes(r *gin.Engine, cli *ent.Client, userServ grpc_clients.UserServiceClient, kafkaClient *kafka.KafkaClient) {
+func initAttachments(cli *ent.Client) *handlers.AttachmentHandler {
+	storageConfig := storage.NewConfig()
+	store, err := storage.New(storageConfig)
+	if err != nil {
+		log.Printf("Warning: attachment storage is unavailable: %v", err)
+	} else {
+		log.Printf("Attachment storage initialized with %s driver", storageConfig.Driver)
+	}
+
+	scanner := storage.NewScanner(storageConfig)
+	if scanner == nil {
+		log.Println("Virus scanning is disabled (CLAMD_ADDR not set)")
+	}
+	if storageConfig.SigningKey == "" {
+		log.Println("Warning: ATTACHMENT_SIGNING_KEY is not set, download links will not survive a restart")
+	}
+
+	return handlers.NewAttachmentHandler(cli, store, scanner, []byte(storageConfig.SigningKey))
+}
+
+func registerHTTPRoutes(r *gin.Engine, cli *ent.Client, userServ grpc_clients.UserServiceClient, kafkaClient *kafka.KafkaClient, attachmentHandler *handlers.AttachmentHandler) {
 	handlersList := []struct {
 		name     string
 		register func(*gin.Engine)
@@ -88,6 +192,22 @@ func registerHTTPRoutes(r *gin.Engine, cli *ent.Client, userServ grpc_clients.Us
 		{"TaskReport", handlers.NewTaskReportHandler(cli).RegisterRoutes},
 		{"Label", handlers.NewLabelHandler(cli).RegisterRoutes},
 		{"LeaveRequest", handlers.NewLeaveRequestHandler(cli).RegisterRoutes},
+		{"AppointmentHistory", handlers.NewAppointmentHistoryHandler(cli).RegisterRoutes},
+		{"Compensation", handlers.NewCompensationHandler(cli).RegisterRoutes},
+		{"Attendance", handlers.NewAttendanceHandler(cli).RegisterRoutes},
+		{"Shift", handlers.NewShiftHandler(cli).RegisterRoutes},
+		{"Overtime", handlers.NewOvertimeHandler(cli).RegisterRoutes},
+		{"Attachment", attachmentHandler.RegisterRoutes},
+		{"Workflow", handlers.NewWorkflowHandler(cli).RegisterRoutes},
+		{"Board", handlers.NewBoardHandler(cli).RegisterRoutes},
+		{"Sprint", handlers.NewSprintHandler(cli).RegisterRoutes},
+		{"Milestone", handlers.NewMilestoneHandler(cli).RegisterRoutes},
+		{"TaskTemplate", handlers.NewTaskTemplateHandler(cli, kafkaClient).RegisterRoutes},
+		{"TaskView", handlers.NewTaskViewHandler(cli).RegisterRoutes},
+		{"TaskActivity", handlers.NewTaskActivityHandler(cli).RegisterRoutes},
+		{"TaskWatcher", handlers.NewTaskWatcherHandler(cli).RegisterRoutes},
+		{"Notification", handlers.NewNotificationHandler(cli).RegisterRoutes},
+		{"ReminderSetting", handlers.NewReminderSettingHandler(cli).RegisterRoutes},
 	}
 
 	for _, h := range handlersList {
@@ -121,6 +241,7 @@ func registerGRPCServices(srv *grpc.Server, cli *ent.Client) {
 	entpb.RegisterProjectServiceServer(srv, entpb.NewProjectService(cli))
 	entpb.RegisterTaskServiceServer(srv, entpb.NewTaskService(cli))
 	entpb.RegisterTaskReportServiceServer(srv, entpb.NewTaskReportService(cli))
+	entpb.RegisterAppointmentHistoryServiceServer(srv, entpb.NewAppointmentHistoryService(cli))
 	entpb.RegisterExtServiceServer(srv, entpb.NewExtService(cli))
 
 	log.Println("All gRPC services registered successfully")
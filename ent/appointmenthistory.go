@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 )
 
 // AppointmentHistory is the model entity for the AppointmentHistory schema.
@@ -33,6 +35,12 @@ type AppointmentHistory struct {
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at"`
+	// PositionID holds the value of the "position_id" field.
+	PositionID *int `json:"position_id"`
+	// DepartmentID holds the value of the "department_id" field.
+	DepartmentID *int `json:"department_id"`
+	// EffectiveTo holds the value of the "effective_to" field.
+	EffectiveTo *time.Time `json:"effective_to"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AppointmentHistoryQuery when eager-loading is set.
 	Edges        AppointmentHistoryEdges `json:"edges"`
@@ -43,9 +51,13 @@ type AppointmentHistory struct {
 type AppointmentHistoryEdges struct {
 	// Employee holds the value of the employee edge.
 	Employee *Employee `json:"employee"`
+	// Position holds the value of the position edge.
+	Position *Position `json:"position"`
+	// Department holds the value of the department edge.
+	Department *Department `json:"department"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // EmployeeOrErr returns the Employee value or an error if the edge
@@ -59,6 +71,28 @@ func (e AppointmentHistoryEdges) EmployeeOrErr() (*Employee, error) {
 	return nil, &NotLoadedError{edge: "employee"}
 }
 
+// PositionOrErr returns the Position value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AppointmentHistoryEdges) PositionOrErr() (*Position, error) {
+	if e.Position != nil {
+		return e.Position, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: position.Label}
+	}
+	return nil, &NotLoadedError{edge: "position"}
+}
+
+// DepartmentOrErr returns the Department value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AppointmentHistoryEdges) DepartmentOrErr() (*Department, error) {
+	if e.Department != nil {
+		return e.Department, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: department.Label}
+	}
+	return nil, &NotLoadedError{edge: "department"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*AppointmentHistory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -66,11 +100,11 @@ func (*AppointmentHistory) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case appointmenthistory.FieldAttachmentUrls:
 			values[i] = new([]byte)
-		case appointmenthistory.FieldID, appointmenthistory.FieldEmployeeID:
+		case appointmenthistory.FieldID, appointmenthistory.FieldEmployeeID, appointmenthistory.FieldPositionID, appointmenthistory.FieldDepartmentID:
 			values[i] = new(sql.NullInt64)
 		case appointmenthistory.FieldPositionName, appointmenthistory.FieldDescription:
 			values[i] = new(sql.NullString)
-		case appointmenthistory.FieldJoiningAt, appointmenthistory.FieldCreatedAt, appointmenthistory.FieldUpdatedAt:
+		case appointmenthistory.FieldJoiningAt, appointmenthistory.FieldCreatedAt, appointmenthistory.FieldUpdatedAt, appointmenthistory.FieldEffectiveTo:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -137,6 +171,27 @@ func (ah *AppointmentHistory) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				ah.UpdatedAt = value.Time
 			}
+		case appointmenthistory.FieldPositionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position_id", values[i])
+			} else if value.Valid {
+				ah.PositionID = new(int)
+				*ah.PositionID = int(value.Int64)
+			}
+		case appointmenthistory.FieldDepartmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field department_id", values[i])
+			} else if value.Valid {
+				ah.DepartmentID = new(int)
+				*ah.DepartmentID = int(value.Int64)
+			}
+		case appointmenthistory.FieldEffectiveTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_to", values[i])
+			} else if value.Valid {
+				ah.EffectiveTo = new(time.Time)
+				*ah.EffectiveTo = value.Time
+			}
 		default:
 			ah.selectValues.Set(columns[i], values[i])
 		}
@@ -155,6 +210,16 @@ func (ah *AppointmentHistory) QueryEmployee() *EmployeeQuery {
 	return NewAppointmentHistoryClient(ah.config).QueryEmployee(ah)
 }
 
+// QueryPosition queries the "position" edge of the AppointmentHistory entity.
+func (ah *AppointmentHistory) QueryPosition() *PositionQuery {
+	return NewAppointmentHistoryClient(ah.config).QueryPosition(ah)
+}
+
+// QueryDepartment queries the "department" edge of the AppointmentHistory entity.
+func (ah *AppointmentHistory) QueryDepartment() *DepartmentQuery {
+	return NewAppointmentHistoryClient(ah.config).QueryDepartment(ah)
+}
+
 // Update returns a builder for updating this AppointmentHistory.
 // Note that you need to call AppointmentHistory.Unwrap() before calling this method if this AppointmentHistory
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,6 +263,21 @@ func (ah *AppointmentHistory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(ah.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ah.PositionID; v != nil {
+		builder.WriteString("position_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ah.DepartmentID; v != nil {
+		builder.WriteString("department_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ah.EffectiveTo; v != nil {
+		builder.WriteString("effective_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -85,6 +85,21 @@ func UpdatedAt(v time.Time) predicate.AppointmentHistory {
 	return predicate.AppointmentHistory(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// PositionID applies equality check predicate on the "position_id" field. It's identical to PositionIDEQ.
+func PositionID(v int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldEQ(FieldPositionID, v))
+}
+
+// DepartmentID applies equality check predicate on the "department_id" field. It's identical to DepartmentIDEQ.
+func DepartmentID(v int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// EffectiveTo applies equality check predicate on the "effective_to" field. It's identical to EffectiveToEQ.
+func EffectiveTo(v time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldEQ(FieldEffectiveTo, v))
+}
+
 // EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
 func EmployeeIDEQ(v int) predicate.AppointmentHistory {
 	return predicate.AppointmentHistory(sql.FieldEQ(FieldEmployeeID, v))
@@ -375,6 +390,116 @@ func UpdatedAtLTE(v time.Time) predicate.AppointmentHistory {
 	return predicate.AppointmentHistory(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// PositionIDEQ applies the EQ predicate on the "position_id" field.
+func PositionIDEQ(v int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldEQ(FieldPositionID, v))
+}
+
+// PositionIDNEQ applies the NEQ predicate on the "position_id" field.
+func PositionIDNEQ(v int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNEQ(FieldPositionID, v))
+}
+
+// PositionIDIn applies the In predicate on the "position_id" field.
+func PositionIDIn(vs ...int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldIn(FieldPositionID, vs...))
+}
+
+// PositionIDNotIn applies the NotIn predicate on the "position_id" field.
+func PositionIDNotIn(vs ...int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNotIn(FieldPositionID, vs...))
+}
+
+// PositionIDIsNil applies the IsNil predicate on the "position_id" field.
+func PositionIDIsNil() predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldIsNull(FieldPositionID))
+}
+
+// PositionIDNotNil applies the NotNil predicate on the "position_id" field.
+func PositionIDNotNil() predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNotNull(FieldPositionID))
+}
+
+// DepartmentIDEQ applies the EQ predicate on the "department_id" field.
+func DepartmentIDEQ(v int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDNEQ applies the NEQ predicate on the "department_id" field.
+func DepartmentIDNEQ(v int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDIn applies the In predicate on the "department_id" field.
+func DepartmentIDIn(vs ...int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDNotIn applies the NotIn predicate on the "department_id" field.
+func DepartmentIDNotIn(vs ...int) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNotIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDIsNil applies the IsNil predicate on the "department_id" field.
+func DepartmentIDIsNil() predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldIsNull(FieldDepartmentID))
+}
+
+// DepartmentIDNotNil applies the NotNil predicate on the "department_id" field.
+func DepartmentIDNotNil() predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNotNull(FieldDepartmentID))
+}
+
+// EffectiveToEQ applies the EQ predicate on the "effective_to" field.
+func EffectiveToEQ(v time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldEQ(FieldEffectiveTo, v))
+}
+
+// EffectiveToNEQ applies the NEQ predicate on the "effective_to" field.
+func EffectiveToNEQ(v time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNEQ(FieldEffectiveTo, v))
+}
+
+// EffectiveToIn applies the In predicate on the "effective_to" field.
+func EffectiveToIn(vs ...time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldIn(FieldEffectiveTo, vs...))
+}
+
+// EffectiveToNotIn applies the NotIn predicate on the "effective_to" field.
+func EffectiveToNotIn(vs ...time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNotIn(FieldEffectiveTo, vs...))
+}
+
+// EffectiveToGT applies the GT predicate on the "effective_to" field.
+func EffectiveToGT(v time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldGT(FieldEffectiveTo, v))
+}
+
+// EffectiveToGTE applies the GTE predicate on the "effective_to" field.
+func EffectiveToGTE(v time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldGTE(FieldEffectiveTo, v))
+}
+
+// EffectiveToLT applies the LT predicate on the "effective_to" field.
+func EffectiveToLT(v time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldLT(FieldEffectiveTo, v))
+}
+
+// EffectiveToLTE applies the LTE predicate on the "effective_to" field.
+func EffectiveToLTE(v time.Time) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldLTE(FieldEffectiveTo, v))
+}
+
+// EffectiveToIsNil applies the IsNil predicate on the "effective_to" field.
+func EffectiveToIsNil() predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldIsNull(FieldEffectiveTo))
+}
+
+// EffectiveToNotNil applies the NotNil predicate on the "effective_to" field.
+func EffectiveToNotNil() predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(sql.FieldNotNull(FieldEffectiveTo))
+}
+
 // HasEmployee applies the HasEdge predicate on the "employee" edge.
 func HasEmployee() predicate.AppointmentHistory {
 	return predicate.AppointmentHistory(func(s *sql.Selector) {
@@ -398,6 +523,52 @@ func HasEmployeeWith(preds ...predicate.Employee) predicate.AppointmentHistory {
 	})
 }
 
+// HasPosition applies the HasEdge predicate on the "position" edge.
+func HasPosition() predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PositionTable, PositionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPositionWith applies the HasEdge predicate on the "position" edge with a given conditions (other predicates).
+func HasPositionWith(preds ...predicate.Position) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(func(s *sql.Selector) {
+		step := newPositionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDepartment applies the HasEdge predicate on the "department" edge.
+func HasDepartment() predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DepartmentTable, DepartmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDepartmentWith applies the HasEdge predicate on the "department" edge with a given conditions (other predicates).
+func HasDepartmentWith(preds ...predicate.Department) predicate.AppointmentHistory {
+	return predicate.AppointmentHistory(func(s *sql.Selector) {
+		step := newDepartmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AppointmentHistory) predicate.AppointmentHistory {
 	return predicate.AppointmentHistory(sql.AndPredicates(predicates...))
@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 )
 
 // AppointmentHistoryCreate is the builder for creating a AppointmentHistory entity.
@@ -89,11 +91,63 @@ func (ahc *AppointmentHistoryCreate) SetNillableUpdatedAt(t *time.Time) *Appoint
 	return ahc
 }
 
+// SetPositionID sets the "position_id" field.
+func (ahc *AppointmentHistoryCreate) SetPositionID(i int) *AppointmentHistoryCreate {
+	ahc.mutation.SetPositionID(i)
+	return ahc
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (ahc *AppointmentHistoryCreate) SetNillablePositionID(i *int) *AppointmentHistoryCreate {
+	if i != nil {
+		ahc.SetPositionID(*i)
+	}
+	return ahc
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (ahc *AppointmentHistoryCreate) SetDepartmentID(i int) *AppointmentHistoryCreate {
+	ahc.mutation.SetDepartmentID(i)
+	return ahc
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (ahc *AppointmentHistoryCreate) SetNillableDepartmentID(i *int) *AppointmentHistoryCreate {
+	if i != nil {
+		ahc.SetDepartmentID(*i)
+	}
+	return ahc
+}
+
+// SetEffectiveTo sets the "effective_to" field.
+func (ahc *AppointmentHistoryCreate) SetEffectiveTo(t time.Time) *AppointmentHistoryCreate {
+	ahc.mutation.SetEffectiveTo(t)
+	return ahc
+}
+
+// SetNillableEffectiveTo sets the "effective_to" field if the given value is not nil.
+func (ahc *AppointmentHistoryCreate) SetNillableEffectiveTo(t *time.Time) *AppointmentHistoryCreate {
+	if t != nil {
+		ahc.SetEffectiveTo(*t)
+	}
+	return ahc
+}
+
 // SetEmployee sets the "employee" edge to the Employee entity.
 func (ahc *AppointmentHistoryCreate) SetEmployee(e *Employee) *AppointmentHistoryCreate {
 	return ahc.SetEmployeeID(e.ID)
 }
 
+// SetPosition sets the "position" edge to the Position entity.
+func (ahc *AppointmentHistoryCreate) SetPosition(p *Position) *AppointmentHistoryCreate {
+	return ahc.SetPositionID(p.ID)
+}
+
+// SetDepartment sets the "department" edge to the Department entity.
+func (ahc *AppointmentHistoryCreate) SetDepartment(d *Department) *AppointmentHistoryCreate {
+	return ahc.SetDepartmentID(d.ID)
+}
+
 // Mutation returns the AppointmentHistoryMutation object of the builder.
 func (ahc *AppointmentHistoryCreate) Mutation() *AppointmentHistoryMutation {
 	return ahc.mutation
@@ -210,6 +264,10 @@ func (ahc *AppointmentHistoryCreate) createSpec() (*AppointmentHistory, *sqlgrap
 		_spec.SetField(appointmenthistory.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := ahc.mutation.EffectiveTo(); ok {
+		_spec.SetField(appointmenthistory.FieldEffectiveTo, field.TypeTime, value)
+		_node.EffectiveTo = &value
+	}
 	if nodes := ahc.mutation.EmployeeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -227,6 +285,40 @@ func (ahc *AppointmentHistoryCreate) createSpec() (*AppointmentHistory, *sqlgrap
 		_node.EmployeeID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ahc.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.PositionTable,
+			Columns: []string{appointmenthistory.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PositionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ahc.mutation.DepartmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.DepartmentTable,
+			Columns: []string{appointmenthistory.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DepartmentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -375,6 +467,60 @@ func (u *AppointmentHistoryUpsert) UpdateUpdatedAt() *AppointmentHistoryUpsert {
 	return u
 }
 
+// SetPositionID sets the "position_id" field.
+func (u *AppointmentHistoryUpsert) SetPositionID(v int) *AppointmentHistoryUpsert {
+	u.Set(appointmenthistory.FieldPositionID, v)
+	return u
+}
+
+// UpdatePositionID sets the "position_id" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsert) UpdatePositionID() *AppointmentHistoryUpsert {
+	u.SetExcluded(appointmenthistory.FieldPositionID)
+	return u
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (u *AppointmentHistoryUpsert) ClearPositionID() *AppointmentHistoryUpsert {
+	u.SetNull(appointmenthistory.FieldPositionID)
+	return u
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (u *AppointmentHistoryUpsert) SetDepartmentID(v int) *AppointmentHistoryUpsert {
+	u.Set(appointmenthistory.FieldDepartmentID, v)
+	return u
+}
+
+// UpdateDepartmentID sets the "department_id" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsert) UpdateDepartmentID() *AppointmentHistoryUpsert {
+	u.SetExcluded(appointmenthistory.FieldDepartmentID)
+	return u
+}
+
+// ClearDepartmentID clears the value of the "department_id" field.
+func (u *AppointmentHistoryUpsert) ClearDepartmentID() *AppointmentHistoryUpsert {
+	u.SetNull(appointmenthistory.FieldDepartmentID)
+	return u
+}
+
+// SetEffectiveTo sets the "effective_to" field.
+func (u *AppointmentHistoryUpsert) SetEffectiveTo(v time.Time) *AppointmentHistoryUpsert {
+	u.Set(appointmenthistory.FieldEffectiveTo, v)
+	return u
+}
+
+// UpdateEffectiveTo sets the "effective_to" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsert) UpdateEffectiveTo() *AppointmentHistoryUpsert {
+	u.SetExcluded(appointmenthistory.FieldEffectiveTo)
+	return u
+}
+
+// ClearEffectiveTo clears the value of the "effective_to" field.
+func (u *AppointmentHistoryUpsert) ClearEffectiveTo() *AppointmentHistoryUpsert {
+	u.SetNull(appointmenthistory.FieldEffectiveTo)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -527,6 +673,69 @@ func (u *AppointmentHistoryUpsertOne) UpdateUpdatedAt() *AppointmentHistoryUpser
 	})
 }
 
+// SetPositionID sets the "position_id" field.
+func (u *AppointmentHistoryUpsertOne) SetPositionID(v int) *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.SetPositionID(v)
+	})
+}
+
+// UpdatePositionID sets the "position_id" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsertOne) UpdatePositionID() *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.UpdatePositionID()
+	})
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (u *AppointmentHistoryUpsertOne) ClearPositionID() *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.ClearPositionID()
+	})
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (u *AppointmentHistoryUpsertOne) SetDepartmentID(v int) *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.SetDepartmentID(v)
+	})
+}
+
+// UpdateDepartmentID sets the "department_id" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsertOne) UpdateDepartmentID() *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.UpdateDepartmentID()
+	})
+}
+
+// ClearDepartmentID clears the value of the "department_id" field.
+func (u *AppointmentHistoryUpsertOne) ClearDepartmentID() *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.ClearDepartmentID()
+	})
+}
+
+// SetEffectiveTo sets the "effective_to" field.
+func (u *AppointmentHistoryUpsertOne) SetEffectiveTo(v time.Time) *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.SetEffectiveTo(v)
+	})
+}
+
+// UpdateEffectiveTo sets the "effective_to" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsertOne) UpdateEffectiveTo() *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.UpdateEffectiveTo()
+	})
+}
+
+// ClearEffectiveTo clears the value of the "effective_to" field.
+func (u *AppointmentHistoryUpsertOne) ClearEffectiveTo() *AppointmentHistoryUpsertOne {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.ClearEffectiveTo()
+	})
+}
+
 // Exec executes the query.
 func (u *AppointmentHistoryUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -843,6 +1052,69 @@ func (u *AppointmentHistoryUpsertBulk) UpdateUpdatedAt() *AppointmentHistoryUpse
 	})
 }
 
+// SetPositionID sets the "position_id" field.
+func (u *AppointmentHistoryUpsertBulk) SetPositionID(v int) *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.SetPositionID(v)
+	})
+}
+
+// UpdatePositionID sets the "position_id" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsertBulk) UpdatePositionID() *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.UpdatePositionID()
+	})
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (u *AppointmentHistoryUpsertBulk) ClearPositionID() *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.ClearPositionID()
+	})
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (u *AppointmentHistoryUpsertBulk) SetDepartmentID(v int) *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.SetDepartmentID(v)
+	})
+}
+
+// UpdateDepartmentID sets the "department_id" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsertBulk) UpdateDepartmentID() *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.UpdateDepartmentID()
+	})
+}
+
+// ClearDepartmentID clears the value of the "department_id" field.
+func (u *AppointmentHistoryUpsertBulk) ClearDepartmentID() *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.ClearDepartmentID()
+	})
+}
+
+// SetEffectiveTo sets the "effective_to" field.
+func (u *AppointmentHistoryUpsertBulk) SetEffectiveTo(v time.Time) *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.SetEffectiveTo(v)
+	})
+}
+
+// UpdateEffectiveTo sets the "effective_to" field to the value that was provided on create.
+func (u *AppointmentHistoryUpsertBulk) UpdateEffectiveTo() *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.UpdateEffectiveTo()
+	})
+}
+
+// ClearEffectiveTo clears the value of the "effective_to" field.
+func (u *AppointmentHistoryUpsertBulk) ClearEffectiveTo() *AppointmentHistoryUpsertBulk {
+	return u.Update(func(s *AppointmentHistoryUpsert) {
+		s.ClearEffectiveTo()
+	})
+}
+
 // Exec executes the query.
 func (u *AppointmentHistoryUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,18 +12,22 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 )
 
 // AppointmentHistoryQuery is the builder for querying AppointmentHistory entities.
 type AppointmentHistoryQuery struct {
 	config
-	ctx          *QueryContext
-	order        []appointmenthistory.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.AppointmentHistory
-	withEmployee *EmployeeQuery
+	ctx            *QueryContext
+	order          []appointmenthistory.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.AppointmentHistory
+	withEmployee   *EmployeeQuery
+	withPosition   *PositionQuery
+	withDepartment *DepartmentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +86,50 @@ func (ahq *AppointmentHistoryQuery) QueryEmployee() *EmployeeQuery {
 	return query
 }
 
+// QueryPosition chains the current query on the "position" edge.
+func (ahq *AppointmentHistoryQuery) QueryPosition() *PositionQuery {
+	query := (&PositionClient{config: ahq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ahq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ahq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointmenthistory.Table, appointmenthistory.FieldID, selector),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, appointmenthistory.PositionTable, appointmenthistory.PositionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ahq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDepartment chains the current query on the "department" edge.
+func (ahq *AppointmentHistoryQuery) QueryDepartment() *DepartmentQuery {
+	query := (&DepartmentClient{config: ahq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ahq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ahq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointmenthistory.Table, appointmenthistory.FieldID, selector),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, appointmenthistory.DepartmentTable, appointmenthistory.DepartmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ahq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first AppointmentHistory entity from the query.
 // Returns a *NotFoundError when no AppointmentHistory was found.
 func (ahq *AppointmentHistoryQuery) First(ctx context.Context) (*AppointmentHistory, error) {
@@ -269,12 +317,14 @@ func (ahq *AppointmentHistoryQuery) Clone() *AppointmentHistoryQuery {
 		return nil
 	}
 	return &AppointmentHistoryQuery{
-		config:       ahq.config,
-		ctx:          ahq.ctx.Clone(),
-		order:        append([]appointmenthistory.OrderOption{}, ahq.order...),
-		inters:       append([]Interceptor{}, ahq.inters...),
-		predicates:   append([]predicate.AppointmentHistory{}, ahq.predicates...),
-		withEmployee: ahq.withEmployee.Clone(),
+		config:         ahq.config,
+		ctx:            ahq.ctx.Clone(),
+		order:          append([]appointmenthistory.OrderOption{}, ahq.order...),
+		inters:         append([]Interceptor{}, ahq.inters...),
+		predicates:     append([]predicate.AppointmentHistory{}, ahq.predicates...),
+		withEmployee:   ahq.withEmployee.Clone(),
+		withPosition:   ahq.withPosition.Clone(),
+		withDepartment: ahq.withDepartment.Clone(),
 		// clone intermediate query.
 		sql:  ahq.sql.Clone(),
 		path: ahq.path,
@@ -292,6 +342,28 @@ func (ahq *AppointmentHistoryQuery) WithEmployee(opts ...func(*EmployeeQuery)) *
 	return ahq
 }
 
+// WithPosition tells the query-builder to eager-load the nodes that are connected to
+// the "position" edge. The optional arguments are used to configure the query builder of the edge.
+func (ahq *AppointmentHistoryQuery) WithPosition(opts ...func(*PositionQuery)) *AppointmentHistoryQuery {
+	query := (&PositionClient{config: ahq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ahq.withPosition = query
+	return ahq
+}
+
+// WithDepartment tells the query-builder to eager-load the nodes that are connected to
+// the "department" edge. The optional arguments are used to configure the query builder of the edge.
+func (ahq *AppointmentHistoryQuery) WithDepartment(opts ...func(*DepartmentQuery)) *AppointmentHistoryQuery {
+	query := (&DepartmentClient{config: ahq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ahq.withDepartment = query
+	return ahq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +442,10 @@ func (ahq *AppointmentHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHo
 	var (
 		nodes       = []*AppointmentHistory{}
 		_spec       = ahq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			ahq.withEmployee != nil,
+			ahq.withPosition != nil,
+			ahq.withDepartment != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -398,6 +472,18 @@ func (ahq *AppointmentHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHo
 			return nil, err
 		}
 	}
+	if query := ahq.withPosition; query != nil {
+		if err := ahq.loadPosition(ctx, query, nodes, nil,
+			func(n *AppointmentHistory, e *Position) { n.Edges.Position = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ahq.withDepartment; query != nil {
+		if err := ahq.loadDepartment(ctx, query, nodes, nil,
+			func(n *AppointmentHistory, e *Department) { n.Edges.Department = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +516,70 @@ func (ahq *AppointmentHistoryQuery) loadEmployee(ctx context.Context, query *Emp
 	}
 	return nil
 }
+func (ahq *AppointmentHistoryQuery) loadPosition(ctx context.Context, query *PositionQuery, nodes []*AppointmentHistory, init func(*AppointmentHistory), assign func(*AppointmentHistory, *Position)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AppointmentHistory)
+	for i := range nodes {
+		if nodes[i].PositionID == nil {
+			continue
+		}
+		fk := *nodes[i].PositionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(position.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "position_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ahq *AppointmentHistoryQuery) loadDepartment(ctx context.Context, query *DepartmentQuery, nodes []*AppointmentHistory, init func(*AppointmentHistory), assign func(*AppointmentHistory, *Department)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AppointmentHistory)
+	for i := range nodes {
+		if nodes[i].DepartmentID == nil {
+			continue
+		}
+		fk := *nodes[i].DepartmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(department.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "department_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (ahq *AppointmentHistoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ahq.querySpec()
@@ -459,6 +609,12 @@ func (ahq *AppointmentHistoryQuery) querySpec() *sqlgraph.QuerySpec {
 		if ahq.withEmployee != nil {
 			_spec.Node.AddColumnOnce(appointmenthistory.FieldEmployeeID)
 		}
+		if ahq.withPosition != nil {
+			_spec.Node.AddColumnOnce(appointmenthistory.FieldPositionID)
+		}
+		if ahq.withDepartment != nil {
+			_spec.Node.AddColumnOnce(appointmenthistory.FieldDepartmentID)
+		}
 	}
 	if ps := ahq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 )
 
@@ -130,11 +132,81 @@ func (ahu *AppointmentHistoryUpdate) SetUpdatedAt(t time.Time) *AppointmentHisto
 	return ahu
 }
 
+// SetPositionID sets the "position_id" field.
+func (ahu *AppointmentHistoryUpdate) SetPositionID(i int) *AppointmentHistoryUpdate {
+	ahu.mutation.SetPositionID(i)
+	return ahu
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (ahu *AppointmentHistoryUpdate) SetNillablePositionID(i *int) *AppointmentHistoryUpdate {
+	if i != nil {
+		ahu.SetPositionID(*i)
+	}
+	return ahu
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (ahu *AppointmentHistoryUpdate) ClearPositionID() *AppointmentHistoryUpdate {
+	ahu.mutation.ClearPositionID()
+	return ahu
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (ahu *AppointmentHistoryUpdate) SetDepartmentID(i int) *AppointmentHistoryUpdate {
+	ahu.mutation.SetDepartmentID(i)
+	return ahu
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (ahu *AppointmentHistoryUpdate) SetNillableDepartmentID(i *int) *AppointmentHistoryUpdate {
+	if i != nil {
+		ahu.SetDepartmentID(*i)
+	}
+	return ahu
+}
+
+// ClearDepartmentID clears the value of the "department_id" field.
+func (ahu *AppointmentHistoryUpdate) ClearDepartmentID() *AppointmentHistoryUpdate {
+	ahu.mutation.ClearDepartmentID()
+	return ahu
+}
+
+// SetEffectiveTo sets the "effective_to" field.
+func (ahu *AppointmentHistoryUpdate) SetEffectiveTo(t time.Time) *AppointmentHistoryUpdate {
+	ahu.mutation.SetEffectiveTo(t)
+	return ahu
+}
+
+// SetNillableEffectiveTo sets the "effective_to" field if the given value is not nil.
+func (ahu *AppointmentHistoryUpdate) SetNillableEffectiveTo(t *time.Time) *AppointmentHistoryUpdate {
+	if t != nil {
+		ahu.SetEffectiveTo(*t)
+	}
+	return ahu
+}
+
+// ClearEffectiveTo clears the value of the "effective_to" field.
+func (ahu *AppointmentHistoryUpdate) ClearEffectiveTo() *AppointmentHistoryUpdate {
+	ahu.mutation.ClearEffectiveTo()
+	return ahu
+}
+
 // SetEmployee sets the "employee" edge to the Employee entity.
 func (ahu *AppointmentHistoryUpdate) SetEmployee(e *Employee) *AppointmentHistoryUpdate {
 	return ahu.SetEmployeeID(e.ID)
 }
 
+// SetPosition sets the "position" edge to the Position entity.
+func (ahu *AppointmentHistoryUpdate) SetPosition(p *Position) *AppointmentHistoryUpdate {
+	return ahu.SetPositionID(p.ID)
+}
+
+// SetDepartment sets the "department" edge to the Department entity.
+func (ahu *AppointmentHistoryUpdate) SetDepartment(d *Department) *AppointmentHistoryUpdate {
+	return ahu.SetDepartmentID(d.ID)
+}
+
 // Mutation returns the AppointmentHistoryMutation object of the builder.
 func (ahu *AppointmentHistoryUpdate) Mutation() *AppointmentHistoryMutation {
 	return ahu.mutation
@@ -146,6 +218,18 @@ func (ahu *AppointmentHistoryUpdate) ClearEmployee() *AppointmentHistoryUpdate {
 	return ahu
 }
 
+// ClearPosition clears the "position" edge to the Position entity.
+func (ahu *AppointmentHistoryUpdate) ClearPosition() *AppointmentHistoryUpdate {
+	ahu.mutation.ClearPosition()
+	return ahu
+}
+
+// ClearDepartment clears the "department" edge to the Department entity.
+func (ahu *AppointmentHistoryUpdate) ClearDepartment() *AppointmentHistoryUpdate {
+	ahu.mutation.ClearDepartment()
+	return ahu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ahu *AppointmentHistoryUpdate) Save(ctx context.Context) (int, error) {
 	ahu.defaults()
@@ -231,6 +315,12 @@ func (ahu *AppointmentHistoryUpdate) sqlSave(ctx context.Context) (n int, err er
 	if value, ok := ahu.mutation.UpdatedAt(); ok {
 		_spec.SetField(appointmenthistory.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ahu.mutation.EffectiveTo(); ok {
+		_spec.SetField(appointmenthistory.FieldEffectiveTo, field.TypeTime, value)
+	}
+	if ahu.mutation.EffectiveToCleared() {
+		_spec.ClearField(appointmenthistory.FieldEffectiveTo, field.TypeTime)
+	}
 	if ahu.mutation.EmployeeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -260,6 +350,64 @@ func (ahu *AppointmentHistoryUpdate) sqlSave(ctx context.Context) (n int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ahu.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.PositionTable,
+			Columns: []string{appointmenthistory.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ahu.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.PositionTable,
+			Columns: []string{appointmenthistory.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ahu.mutation.DepartmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.DepartmentTable,
+			Columns: []string{appointmenthistory.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ahu.mutation.DepartmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.DepartmentTable,
+			Columns: []string{appointmenthistory.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ahu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{appointmenthistory.Label}
@@ -380,11 +528,81 @@ func (ahuo *AppointmentHistoryUpdateOne) SetUpdatedAt(t time.Time) *AppointmentH
 	return ahuo
 }
 
+// SetPositionID sets the "position_id" field.
+func (ahuo *AppointmentHistoryUpdateOne) SetPositionID(i int) *AppointmentHistoryUpdateOne {
+	ahuo.mutation.SetPositionID(i)
+	return ahuo
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (ahuo *AppointmentHistoryUpdateOne) SetNillablePositionID(i *int) *AppointmentHistoryUpdateOne {
+	if i != nil {
+		ahuo.SetPositionID(*i)
+	}
+	return ahuo
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (ahuo *AppointmentHistoryUpdateOne) ClearPositionID() *AppointmentHistoryUpdateOne {
+	ahuo.mutation.ClearPositionID()
+	return ahuo
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (ahuo *AppointmentHistoryUpdateOne) SetDepartmentID(i int) *AppointmentHistoryUpdateOne {
+	ahuo.mutation.SetDepartmentID(i)
+	return ahuo
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (ahuo *AppointmentHistoryUpdateOne) SetNillableDepartmentID(i *int) *AppointmentHistoryUpdateOne {
+	if i != nil {
+		ahuo.SetDepartmentID(*i)
+	}
+	return ahuo
+}
+
+// ClearDepartmentID clears the value of the "department_id" field.
+func (ahuo *AppointmentHistoryUpdateOne) ClearDepartmentID() *AppointmentHistoryUpdateOne {
+	ahuo.mutation.ClearDepartmentID()
+	return ahuo
+}
+
+// SetEffectiveTo sets the "effective_to" field.
+func (ahuo *AppointmentHistoryUpdateOne) SetEffectiveTo(t time.Time) *AppointmentHistoryUpdateOne {
+	ahuo.mutation.SetEffectiveTo(t)
+	return ahuo
+}
+
+// SetNillableEffectiveTo sets the "effective_to" field if the given value is not nil.
+func (ahuo *AppointmentHistoryUpdateOne) SetNillableEffectiveTo(t *time.Time) *AppointmentHistoryUpdateOne {
+	if t != nil {
+		ahuo.SetEffectiveTo(*t)
+	}
+	return ahuo
+}
+
+// ClearEffectiveTo clears the value of the "effective_to" field.
+func (ahuo *AppointmentHistoryUpdateOne) ClearEffectiveTo() *AppointmentHistoryUpdateOne {
+	ahuo.mutation.ClearEffectiveTo()
+	return ahuo
+}
+
 // SetEmployee sets the "employee" edge to the Employee entity.
 func (ahuo *AppointmentHistoryUpdateOne) SetEmployee(e *Employee) *AppointmentHistoryUpdateOne {
 	return ahuo.SetEmployeeID(e.ID)
 }
 
+// SetPosition sets the "position" edge to the Position entity.
+func (ahuo *AppointmentHistoryUpdateOne) SetPosition(p *Position) *AppointmentHistoryUpdateOne {
+	return ahuo.SetPositionID(p.ID)
+}
+
+// SetDepartment sets the "department" edge to the Department entity.
+func (ahuo *AppointmentHistoryUpdateOne) SetDepartment(d *Department) *AppointmentHistoryUpdateOne {
+	return ahuo.SetDepartmentID(d.ID)
+}
+
 // Mutation returns the AppointmentHistoryMutation object of the builder.
 func (ahuo *AppointmentHistoryUpdateOne) Mutation() *AppointmentHistoryMutation {
 	return ahuo.mutation
@@ -396,6 +614,18 @@ func (ahuo *AppointmentHistoryUpdateOne) ClearEmployee() *AppointmentHistoryUpda
 	return ahuo
 }
 
+// ClearPosition clears the "position" edge to the Position entity.
+func (ahuo *AppointmentHistoryUpdateOne) ClearPosition() *AppointmentHistoryUpdateOne {
+	ahuo.mutation.ClearPosition()
+	return ahuo
+}
+
+// ClearDepartment clears the "department" edge to the Department entity.
+func (ahuo *AppointmentHistoryUpdateOne) ClearDepartment() *AppointmentHistoryUpdateOne {
+	ahuo.mutation.ClearDepartment()
+	return ahuo
+}
+
 // Where appends a list predicates to the AppointmentHistoryUpdate builder.
 func (ahuo *AppointmentHistoryUpdateOne) Where(ps ...predicate.AppointmentHistory) *AppointmentHistoryUpdateOne {
 	ahuo.mutation.Where(ps...)
@@ -511,6 +741,12 @@ func (ahuo *AppointmentHistoryUpdateOne) sqlSave(ctx context.Context) (_node *Ap
 	if value, ok := ahuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(appointmenthistory.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ahuo.mutation.EffectiveTo(); ok {
+		_spec.SetField(appointmenthistory.FieldEffectiveTo, field.TypeTime, value)
+	}
+	if ahuo.mutation.EffectiveToCleared() {
+		_spec.ClearField(appointmenthistory.FieldEffectiveTo, field.TypeTime)
+	}
 	if ahuo.mutation.EmployeeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -540,6 +776,64 @@ func (ahuo *AppointmentHistoryUpdateOne) sqlSave(ctx context.Context) (_node *Ap
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ahuo.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.PositionTable,
+			Columns: []string{appointmenthistory.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ahuo.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.PositionTable,
+			Columns: []string{appointmenthistory.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ahuo.mutation.DepartmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.DepartmentTable,
+			Columns: []string{appointmenthistory.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ahuo.mutation.DepartmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointmenthistory.DepartmentTable,
+			Columns: []string{appointmenthistory.DepartmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(department.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &AppointmentHistory{config: ahuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
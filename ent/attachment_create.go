@@ -0,0 +1,1132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+)
+
+// AttachmentCreate is the builder for creating a Attachment entity.
+type AttachmentCreate struct {
+	config
+	mutation *AttachmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (ac *AttachmentCreate) SetOrgID(i int) *AttachmentCreate {
+	ac.mutation.SetOrgID(i)
+	return ac
+}
+
+// SetOwnerType sets the "owner_type" field.
+func (ac *AttachmentCreate) SetOwnerType(at attachment.OwnerType) *AttachmentCreate {
+	ac.mutation.SetOwnerType(at)
+	return ac
+}
+
+// SetNillableOwnerType sets the "owner_type" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableOwnerType(at *attachment.OwnerType) *AttachmentCreate {
+	if at != nil {
+		ac.SetOwnerType(*at)
+	}
+	return ac
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ac *AttachmentCreate) SetOwnerID(i int) *AttachmentCreate {
+	ac.mutation.SetOwnerID(i)
+	return ac
+}
+
+// SetFileName sets the "file_name" field.
+func (ac *AttachmentCreate) SetFileName(s string) *AttachmentCreate {
+	ac.mutation.SetFileName(s)
+	return ac
+}
+
+// SetMimeType sets the "mime_type" field.
+func (ac *AttachmentCreate) SetMimeType(s string) *AttachmentCreate {
+	ac.mutation.SetMimeType(s)
+	return ac
+}
+
+// SetSize sets the "size" field.
+func (ac *AttachmentCreate) SetSize(i int64) *AttachmentCreate {
+	ac.mutation.SetSize(i)
+	return ac
+}
+
+// SetChecksum sets the "checksum" field.
+func (ac *AttachmentCreate) SetChecksum(s string) *AttachmentCreate {
+	ac.mutation.SetChecksum(s)
+	return ac
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (ac *AttachmentCreate) SetStorageKey(s string) *AttachmentCreate {
+	ac.mutation.SetStorageKey(s)
+	return ac
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (ac *AttachmentCreate) SetScanStatus(as attachment.ScanStatus) *AttachmentCreate {
+	ac.mutation.SetScanStatus(as)
+	return ac
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableScanStatus(as *attachment.ScanStatus) *AttachmentCreate {
+	if as != nil {
+		ac.SetScanStatus(*as)
+	}
+	return ac
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (ac *AttachmentCreate) SetUploaderID(i int) *AttachmentCreate {
+	ac.mutation.SetUploaderID(i)
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AttachmentCreate) SetCreatedAt(t time.Time) *AttachmentCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableCreatedAt(t *time.Time) *AttachmentCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (ac *AttachmentCreate) SetOrganizationID(id int) *AttachmentCreate {
+	ac.mutation.SetOrganizationID(id)
+	return ac
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (ac *AttachmentCreate) SetOrganization(o *Organization) *AttachmentCreate {
+	return ac.SetOrganizationID(o.ID)
+}
+
+// SetUploader sets the "uploader" edge to the Employee entity.
+func (ac *AttachmentCreate) SetUploader(e *Employee) *AttachmentCreate {
+	return ac.SetUploaderID(e.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (ac *AttachmentCreate) Mutation() *AttachmentMutation {
+	return ac.mutation
+}
+
+// Save creates the Attachment in the database.
+func (ac *AttachmentCreate) Save(ctx context.Context) (*Attachment, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AttachmentCreate) SaveX(ctx context.Context) *Attachment {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AttachmentCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AttachmentCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AttachmentCreate) defaults() {
+	if _, ok := ac.mutation.OwnerType(); !ok {
+		v := attachment.DefaultOwnerType
+		ac.mutation.SetOwnerType(v)
+	}
+	if _, ok := ac.mutation.ScanStatus(); !ok {
+		v := attachment.DefaultScanStatus
+		ac.mutation.SetScanStatus(v)
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := attachment.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AttachmentCreate) check() error {
+	if _, ok := ac.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "Attachment.org_id"`)}
+	}
+	if _, ok := ac.mutation.OwnerType(); !ok {
+		return &ValidationError{Name: "owner_type", err: errors.New(`ent: missing required field "Attachment.owner_type"`)}
+	}
+	if v, ok := ac.mutation.OwnerType(); ok {
+		if err := attachment.OwnerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "owner_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.owner_type": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Attachment.owner_id"`)}
+	}
+	if _, ok := ac.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "Attachment.file_name"`)}
+	}
+	if v, ok := ac.mutation.FileName(); ok {
+		if err := attachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Attachment.file_name": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mime_type", err: errors.New(`ent: missing required field "Attachment.mime_type"`)}
+	}
+	if _, ok := ac.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Attachment.size"`)}
+	}
+	if _, ok := ac.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "Attachment.checksum"`)}
+	}
+	if _, ok := ac.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "Attachment.storage_key"`)}
+	}
+	if _, ok := ac.mutation.ScanStatus(); !ok {
+		return &ValidationError{Name: "scan_status", err: errors.New(`ent: missing required field "Attachment.scan_status"`)}
+	}
+	if v, ok := ac.mutation.ScanStatus(); ok {
+		if err := attachment.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.scan_status": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.UploaderID(); !ok {
+		return &ValidationError{Name: "uploader_id", err: errors.New(`ent: missing required field "Attachment.uploader_id"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Attachment.created_at"`)}
+	}
+	if len(ac.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Attachment.organization"`)}
+	}
+	if len(ac.mutation.UploaderIDs()) == 0 {
+		return &ValidationError{Name: "uploader", err: errors.New(`ent: missing required edge "Attachment.uploader"`)}
+	}
+	return nil
+}
+
+func (ac *AttachmentCreate) sqlSave(ctx context.Context) (*Attachment, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AttachmentCreate) createSpec() (*Attachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Attachment{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(attachment.Table, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ac.conflict
+	if value, ok := ac.mutation.OwnerType(); ok {
+		_spec.SetField(attachment.FieldOwnerType, field.TypeEnum, value)
+		_node.OwnerType = value
+	}
+	if value, ok := ac.mutation.OwnerID(); ok {
+		_spec.SetField(attachment.FieldOwnerID, field.TypeInt, value)
+		_node.OwnerID = value
+	}
+	if value, ok := ac.mutation.FileName(); ok {
+		_spec.SetField(attachment.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := ac.mutation.MimeType(); ok {
+		_spec.SetField(attachment.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := ac.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := ac.mutation.Checksum(); ok {
+		_spec.SetField(attachment.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := ac.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := ac.mutation.ScanStatus(); ok {
+		_spec.SetField(attachment.FieldScanStatus, field.TypeEnum, value)
+		_node.ScanStatus = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(attachment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ac.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UploaderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Attachment.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttachmentUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *AttachmentCreate) OnConflict(opts ...sql.ConflictOption) *AttachmentUpsertOne {
+	ac.conflict = opts
+	return &AttachmentUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Attachment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *AttachmentCreate) OnConflictColumns(columns ...string) *AttachmentUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &AttachmentUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// AttachmentUpsertOne is the builder for "upsert"-ing
+	//  one Attachment node.
+	AttachmentUpsertOne struct {
+		create *AttachmentCreate
+	}
+
+	// AttachmentUpsert is the "OnConflict" setter.
+	AttachmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *AttachmentUpsert) SetOrgID(v int) *AttachmentUpsert {
+	u.Set(attachment.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateOrgID() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldOrgID)
+	return u
+}
+
+// SetOwnerType sets the "owner_type" field.
+func (u *AttachmentUpsert) SetOwnerType(v attachment.OwnerType) *AttachmentUpsert {
+	u.Set(attachment.FieldOwnerType, v)
+	return u
+}
+
+// UpdateOwnerType sets the "owner_type" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateOwnerType() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldOwnerType)
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *AttachmentUpsert) SetOwnerID(v int) *AttachmentUpsert {
+	u.Set(attachment.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateOwnerID() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldOwnerID)
+	return u
+}
+
+// AddOwnerID adds v to the "owner_id" field.
+func (u *AttachmentUpsert) AddOwnerID(v int) *AttachmentUpsert {
+	u.Add(attachment.FieldOwnerID, v)
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *AttachmentUpsert) SetFileName(v string) *AttachmentUpsert {
+	u.Set(attachment.FieldFileName, v)
+	return u
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateFileName() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldFileName)
+	return u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *AttachmentUpsert) SetMimeType(v string) *AttachmentUpsert {
+	u.Set(attachment.FieldMimeType, v)
+	return u
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateMimeType() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldMimeType)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *AttachmentUpsert) SetSize(v int64) *AttachmentUpsert {
+	u.Set(attachment.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateSize() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *AttachmentUpsert) AddSize(v int64) *AttachmentUpsert {
+	u.Add(attachment.FieldSize, v)
+	return u
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *AttachmentUpsert) SetChecksum(v string) *AttachmentUpsert {
+	u.Set(attachment.FieldChecksum, v)
+	return u
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateChecksum() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldChecksum)
+	return u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *AttachmentUpsert) SetStorageKey(v string) *AttachmentUpsert {
+	u.Set(attachment.FieldStorageKey, v)
+	return u
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateStorageKey() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldStorageKey)
+	return u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *AttachmentUpsert) SetScanStatus(v attachment.ScanStatus) *AttachmentUpsert {
+	u.Set(attachment.FieldScanStatus, v)
+	return u
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateScanStatus() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldScanStatus)
+	return u
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (u *AttachmentUpsert) SetUploaderID(v int) *AttachmentUpsert {
+	u.Set(attachment.FieldUploaderID, v)
+	return u
+}
+
+// UpdateUploaderID sets the "uploader_id" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateUploaderID() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldUploaderID)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttachmentUpsert) SetCreatedAt(v time.Time) *AttachmentUpsert {
+	u.Set(attachment.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttachmentUpsert) UpdateCreatedAt() *AttachmentUpsert {
+	u.SetExcluded(attachment.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Attachment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AttachmentUpsertOne) UpdateNewValues() *AttachmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Attachment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AttachmentUpsertOne) Ignore() *AttachmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttachmentUpsertOne) DoNothing() *AttachmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttachmentCreate.OnConflict
+// documentation for more info.
+func (u *AttachmentUpsertOne) Update(set func(*AttachmentUpsert)) *AttachmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttachmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AttachmentUpsertOne) SetOrgID(v int) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateOrgID() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetOwnerType sets the "owner_type" field.
+func (u *AttachmentUpsertOne) SetOwnerType(v attachment.OwnerType) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetOwnerType(v)
+	})
+}
+
+// UpdateOwnerType sets the "owner_type" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateOwnerType() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateOwnerType()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *AttachmentUpsertOne) SetOwnerID(v int) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// AddOwnerID adds v to the "owner_id" field.
+func (u *AttachmentUpsertOne) AddOwnerID(v int) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.AddOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateOwnerID() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *AttachmentUpsertOne) SetFileName(v string) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateFileName() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *AttachmentUpsertOne) SetMimeType(v string) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateMimeType() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AttachmentUpsertOne) SetSize(v int64) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AttachmentUpsertOne) AddSize(v int64) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateSize() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *AttachmentUpsertOne) SetChecksum(v string) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateChecksum() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *AttachmentUpsertOne) SetStorageKey(v string) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateStorageKey() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *AttachmentUpsertOne) SetScanStatus(v attachment.ScanStatus) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetScanStatus(v)
+	})
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateScanStatus() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateScanStatus()
+	})
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (u *AttachmentUpsertOne) SetUploaderID(v int) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetUploaderID(v)
+	})
+}
+
+// UpdateUploaderID sets the "uploader_id" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateUploaderID() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateUploaderID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttachmentUpsertOne) SetCreatedAt(v time.Time) *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttachmentUpsertOne) UpdateCreatedAt() *AttachmentUpsertOne {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AttachmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttachmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttachmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AttachmentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AttachmentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AttachmentCreateBulk is the builder for creating many Attachment entities in bulk.
+type AttachmentCreateBulk struct {
+	config
+	err      error
+	builders []*AttachmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Attachment entities in the database.
+func (acb *AttachmentCreateBulk) Save(ctx context.Context) ([]*Attachment, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Attachment, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AttachmentCreateBulk) SaveX(ctx context.Context) []*Attachment {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Attachment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttachmentUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *AttachmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *AttachmentUpsertBulk {
+	acb.conflict = opts
+	return &AttachmentUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Attachment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *AttachmentCreateBulk) OnConflictColumns(columns ...string) *AttachmentUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &AttachmentUpsertBulk{
+		create: acb,
+	}
+}
+
+// AttachmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Attachment nodes.
+type AttachmentUpsertBulk struct {
+	create *AttachmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Attachment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AttachmentUpsertBulk) UpdateNewValues() *AttachmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Attachment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AttachmentUpsertBulk) Ignore() *AttachmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttachmentUpsertBulk) DoNothing() *AttachmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttachmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *AttachmentUpsertBulk) Update(set func(*AttachmentUpsert)) *AttachmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttachmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AttachmentUpsertBulk) SetOrgID(v int) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateOrgID() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetOwnerType sets the "owner_type" field.
+func (u *AttachmentUpsertBulk) SetOwnerType(v attachment.OwnerType) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetOwnerType(v)
+	})
+}
+
+// UpdateOwnerType sets the "owner_type" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateOwnerType() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateOwnerType()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *AttachmentUpsertBulk) SetOwnerID(v int) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// AddOwnerID adds v to the "owner_id" field.
+func (u *AttachmentUpsertBulk) AddOwnerID(v int) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.AddOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateOwnerID() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *AttachmentUpsertBulk) SetFileName(v string) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateFileName() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *AttachmentUpsertBulk) SetMimeType(v string) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateMimeType() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AttachmentUpsertBulk) SetSize(v int64) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AttachmentUpsertBulk) AddSize(v int64) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateSize() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *AttachmentUpsertBulk) SetChecksum(v string) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateChecksum() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *AttachmentUpsertBulk) SetStorageKey(v string) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateStorageKey() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (u *AttachmentUpsertBulk) SetScanStatus(v attachment.ScanStatus) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetScanStatus(v)
+	})
+}
+
+// UpdateScanStatus sets the "scan_status" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateScanStatus() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateScanStatus()
+	})
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (u *AttachmentUpsertBulk) SetUploaderID(v int) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetUploaderID(v)
+	})
+}
+
+// UpdateUploaderID sets the "uploader_id" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateUploaderID() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateUploaderID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttachmentUpsertBulk) SetCreatedAt(v time.Time) *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttachmentUpsertBulk) UpdateCreatedAt() *AttachmentUpsertBulk {
+	return u.Update(func(s *AttachmentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AttachmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AttachmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttachmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttachmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
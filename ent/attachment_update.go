@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (au *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetOrgID sets the "org_id" field.
+func (au *AttachmentUpdate) SetOrgID(i int) *AttachmentUpdate {
+	au.mutation.SetOrgID(i)
+	return au
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableOrgID(i *int) *AttachmentUpdate {
+	if i != nil {
+		au.SetOrgID(*i)
+	}
+	return au
+}
+
+// SetOwnerType sets the "owner_type" field.
+func (au *AttachmentUpdate) SetOwnerType(at attachment.OwnerType) *AttachmentUpdate {
+	au.mutation.SetOwnerType(at)
+	return au
+}
+
+// SetNillableOwnerType sets the "owner_type" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableOwnerType(at *attachment.OwnerType) *AttachmentUpdate {
+	if at != nil {
+		au.SetOwnerType(*at)
+	}
+	return au
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (au *AttachmentUpdate) SetOwnerID(i int) *AttachmentUpdate {
+	au.mutation.ResetOwnerID()
+	au.mutation.SetOwnerID(i)
+	return au
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableOwnerID(i *int) *AttachmentUpdate {
+	if i != nil {
+		au.SetOwnerID(*i)
+	}
+	return au
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (au *AttachmentUpdate) AddOwnerID(i int) *AttachmentUpdate {
+	au.mutation.AddOwnerID(i)
+	return au
+}
+
+// SetFileName sets the "file_name" field.
+func (au *AttachmentUpdate) SetFileName(s string) *AttachmentUpdate {
+	au.mutation.SetFileName(s)
+	return au
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableFileName(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetFileName(*s)
+	}
+	return au
+}
+
+// SetMimeType sets the "mime_type" field.
+func (au *AttachmentUpdate) SetMimeType(s string) *AttachmentUpdate {
+	au.mutation.SetMimeType(s)
+	return au
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableMimeType(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetMimeType(*s)
+	}
+	return au
+}
+
+// SetSize sets the "size" field.
+func (au *AttachmentUpdate) SetSize(i int64) *AttachmentUpdate {
+	au.mutation.ResetSize()
+	au.mutation.SetSize(i)
+	return au
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableSize(i *int64) *AttachmentUpdate {
+	if i != nil {
+		au.SetSize(*i)
+	}
+	return au
+}
+
+// AddSize adds i to the "size" field.
+func (au *AttachmentUpdate) AddSize(i int64) *AttachmentUpdate {
+	au.mutation.AddSize(i)
+	return au
+}
+
+// SetChecksum sets the "checksum" field.
+func (au *AttachmentUpdate) SetChecksum(s string) *AttachmentUpdate {
+	au.mutation.SetChecksum(s)
+	return au
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableChecksum(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetChecksum(*s)
+	}
+	return au
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (au *AttachmentUpdate) SetStorageKey(s string) *AttachmentUpdate {
+	au.mutation.SetStorageKey(s)
+	return au
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableStorageKey(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetStorageKey(*s)
+	}
+	return au
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (au *AttachmentUpdate) SetScanStatus(as attachment.ScanStatus) *AttachmentUpdate {
+	au.mutation.SetScanStatus(as)
+	return au
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableScanStatus(as *attachment.ScanStatus) *AttachmentUpdate {
+	if as != nil {
+		au.SetScanStatus(*as)
+	}
+	return au
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (au *AttachmentUpdate) SetUploaderID(i int) *AttachmentUpdate {
+	au.mutation.SetUploaderID(i)
+	return au
+}
+
+// SetNillableUploaderID sets the "uploader_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableUploaderID(i *int) *AttachmentUpdate {
+	if i != nil {
+		au.SetUploaderID(*i)
+	}
+	return au
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (au *AttachmentUpdate) SetCreatedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetCreatedAt(t)
+	return au
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableCreatedAt(t *time.Time) *AttachmentUpdate {
+	if t != nil {
+		au.SetCreatedAt(*t)
+	}
+	return au
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (au *AttachmentUpdate) SetOrganizationID(id int) *AttachmentUpdate {
+	au.mutation.SetOrganizationID(id)
+	return au
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (au *AttachmentUpdate) SetOrganization(o *Organization) *AttachmentUpdate {
+	return au.SetOrganizationID(o.ID)
+}
+
+// SetUploader sets the "uploader" edge to the Employee entity.
+func (au *AttachmentUpdate) SetUploader(e *Employee) *AttachmentUpdate {
+	return au.SetUploaderID(e.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (au *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return au.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (au *AttachmentUpdate) ClearOrganization() *AttachmentUpdate {
+	au.mutation.ClearOrganization()
+	return au
+}
+
+// ClearUploader clears the "uploader" edge to the Employee entity.
+func (au *AttachmentUpdate) ClearUploader() *AttachmentUpdate {
+	au.mutation.ClearUploader()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AttachmentUpdate) check() error {
+	if v, ok := au.mutation.OwnerType(); ok {
+		if err := attachment.OwnerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "owner_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.owner_type": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.FileName(); ok {
+		if err := attachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Attachment.file_name": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.ScanStatus(); ok {
+		if err := attachment.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.scan_status": %w`, err)}
+		}
+	}
+	if au.mutation.OrganizationCleared() && len(au.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.organization"`)
+	}
+	if au.mutation.UploaderCleared() && len(au.mutation.UploaderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.uploader"`)
+	}
+	return nil
+}
+
+func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.OwnerType(); ok {
+		_spec.SetField(attachment.FieldOwnerType, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.OwnerID(); ok {
+		_spec.SetField(attachment.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedOwnerID(); ok {
+		_spec.AddField(attachment.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.FileName(); ok {
+		_spec.SetField(attachment.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := au.mutation.MimeType(); ok {
+		_spec.SetField(attachment.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.Checksum(); ok {
+		_spec.SetField(attachment.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := au.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := au.mutation.ScanStatus(); ok {
+		_spec.SetField(attachment.FieldScanStatus, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.CreatedAt(); ok {
+		_spec.SetField(attachment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if au.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (auo *AttachmentUpdateOne) SetOrgID(i int) *AttachmentUpdateOne {
+	auo.mutation.SetOrgID(i)
+	return auo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableOrgID(i *int) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetOrgID(*i)
+	}
+	return auo
+}
+
+// SetOwnerType sets the "owner_type" field.
+func (auo *AttachmentUpdateOne) SetOwnerType(at attachment.OwnerType) *AttachmentUpdateOne {
+	auo.mutation.SetOwnerType(at)
+	return auo
+}
+
+// SetNillableOwnerType sets the "owner_type" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableOwnerType(at *attachment.OwnerType) *AttachmentUpdateOne {
+	if at != nil {
+		auo.SetOwnerType(*at)
+	}
+	return auo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (auo *AttachmentUpdateOne) SetOwnerID(i int) *AttachmentUpdateOne {
+	auo.mutation.ResetOwnerID()
+	auo.mutation.SetOwnerID(i)
+	return auo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableOwnerID(i *int) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetOwnerID(*i)
+	}
+	return auo
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (auo *AttachmentUpdateOne) AddOwnerID(i int) *AttachmentUpdateOne {
+	auo.mutation.AddOwnerID(i)
+	return auo
+}
+
+// SetFileName sets the "file_name" field.
+func (auo *AttachmentUpdateOne) SetFileName(s string) *AttachmentUpdateOne {
+	auo.mutation.SetFileName(s)
+	return auo
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableFileName(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetFileName(*s)
+	}
+	return auo
+}
+
+// SetMimeType sets the "mime_type" field.
+func (auo *AttachmentUpdateOne) SetMimeType(s string) *AttachmentUpdateOne {
+	auo.mutation.SetMimeType(s)
+	return auo
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableMimeType(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetMimeType(*s)
+	}
+	return auo
+}
+
+// SetSize sets the "size" field.
+func (auo *AttachmentUpdateOne) SetSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.ResetSize()
+	auo.mutation.SetSize(i)
+	return auo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableSize(i *int64) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetSize(*i)
+	}
+	return auo
+}
+
+// AddSize adds i to the "size" field.
+func (auo *AttachmentUpdateOne) AddSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.AddSize(i)
+	return auo
+}
+
+// SetChecksum sets the "checksum" field.
+func (auo *AttachmentUpdateOne) SetChecksum(s string) *AttachmentUpdateOne {
+	auo.mutation.SetChecksum(s)
+	return auo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableChecksum(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetChecksum(*s)
+	}
+	return auo
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (auo *AttachmentUpdateOne) SetStorageKey(s string) *AttachmentUpdateOne {
+	auo.mutation.SetStorageKey(s)
+	return auo
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableStorageKey(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetStorageKey(*s)
+	}
+	return auo
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (auo *AttachmentUpdateOne) SetScanStatus(as attachment.ScanStatus) *AttachmentUpdateOne {
+	auo.mutation.SetScanStatus(as)
+	return auo
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableScanStatus(as *attachment.ScanStatus) *AttachmentUpdateOne {
+	if as != nil {
+		auo.SetScanStatus(*as)
+	}
+	return auo
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (auo *AttachmentUpdateOne) SetUploaderID(i int) *AttachmentUpdateOne {
+	auo.mutation.SetUploaderID(i)
+	return auo
+}
+
+// SetNillableUploaderID sets the "uploader_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableUploaderID(i *int) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetUploaderID(*i)
+	}
+	return auo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (auo *AttachmentUpdateOne) SetCreatedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetCreatedAt(t)
+	return auo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableCreatedAt(t *time.Time) *AttachmentUpdateOne {
+	if t != nil {
+		auo.SetCreatedAt(*t)
+	}
+	return auo
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (auo *AttachmentUpdateOne) SetOrganizationID(id int) *AttachmentUpdateOne {
+	auo.mutation.SetOrganizationID(id)
+	return auo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (auo *AttachmentUpdateOne) SetOrganization(o *Organization) *AttachmentUpdateOne {
+	return auo.SetOrganizationID(o.ID)
+}
+
+// SetUploader sets the "uploader" edge to the Employee entity.
+func (auo *AttachmentUpdateOne) SetUploader(e *Employee) *AttachmentUpdateOne {
+	return auo.SetUploaderID(e.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (auo *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return auo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (auo *AttachmentUpdateOne) ClearOrganization() *AttachmentUpdateOne {
+	auo.mutation.ClearOrganization()
+	return auo
+}
+
+// ClearUploader clears the "uploader" edge to the Employee entity.
+func (auo *AttachmentUpdateOne) ClearUploader() *AttachmentUpdateOne {
+	auo.mutation.ClearUploader()
+	return auo
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (auo *AttachmentUpdateOne) Where(ps ...predicate.Attachment) *AttachmentUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (auo *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AttachmentUpdateOne) check() error {
+	if v, ok := auo.mutation.OwnerType(); ok {
+		if err := attachment.OwnerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "owner_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.owner_type": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.FileName(); ok {
+		if err := attachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Attachment.file_name": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.ScanStatus(); ok {
+		if err := attachment.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.scan_status": %w`, err)}
+		}
+	}
+	if auo.mutation.OrganizationCleared() && len(auo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.organization"`)
+	}
+	if auo.mutation.UploaderCleared() && len(auo.mutation.UploaderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.uploader"`)
+	}
+	return nil
+}
+
+func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.OwnerType(); ok {
+		_spec.SetField(attachment.FieldOwnerType, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.OwnerID(); ok {
+		_spec.SetField(attachment.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedOwnerID(); ok {
+		_spec.AddField(attachment.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.FileName(); ok {
+		_spec.SetField(attachment.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.MimeType(); ok {
+		_spec.SetField(attachment.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.Checksum(); ok {
+		_spec.SetField(attachment.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.ScanStatus(); ok {
+		_spec.SetField(attachment.FieldScanStatus, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.CreatedAt(); ok {
+		_spec.SetField(attachment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if auo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.OrganizationTable,
+			Columns: []string{attachment.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Attachment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
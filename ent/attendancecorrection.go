@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+)
+
+// AttendanceCorrection is the model entity for the AttendanceCorrection schema.
+type AttendanceCorrection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AttendanceRecordID holds the value of the "attendance_record_id" field.
+	AttendanceRecordID *int `json:"attendance_record_id"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// WorkDate holds the value of the "work_date" field.
+	WorkDate time.Time `json:"work_date"`
+	// CheckInAt holds the value of the "check_in_at" field.
+	CheckInAt *time.Time `json:"check_in_at"`
+	// CheckOutAt holds the value of the "check_out_at" field.
+	CheckOutAt *time.Time `json:"check_out_at"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason"`
+	// Status holds the value of the "status" field.
+	Status attendancecorrection.Status `json:"status"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *int `json:"reviewer_id"`
+	// ReviewComment holds the value of the "review_comment" field.
+	ReviewComment *string `json:"review_comment"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttendanceCorrectionQuery when eager-loading is set.
+	Edges        AttendanceCorrectionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttendanceCorrectionEdges holds the relations/edges for other nodes in the graph.
+type AttendanceCorrectionEdges struct {
+	// AttendanceRecord holds the value of the attendance_record edge.
+	AttendanceRecord *AttendanceRecord `json:"attendance_record"`
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AttendanceRecordOrErr returns the AttendanceRecord value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceCorrectionEdges) AttendanceRecordOrErr() (*AttendanceRecord, error) {
+	if e.AttendanceRecord != nil {
+		return e.AttendanceRecord, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: attendancerecord.Label}
+	}
+	return nil, &NotLoadedError{edge: "attendance_record"}
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceCorrectionEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttendanceCorrection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attendancecorrection.FieldID, attendancecorrection.FieldAttendanceRecordID, attendancecorrection.FieldEmployeeID, attendancecorrection.FieldOrgID, attendancecorrection.FieldReviewerID:
+			values[i] = new(sql.NullInt64)
+		case attendancecorrection.FieldReason, attendancecorrection.FieldStatus, attendancecorrection.FieldReviewComment:
+			values[i] = new(sql.NullString)
+		case attendancecorrection.FieldWorkDate, attendancecorrection.FieldCheckInAt, attendancecorrection.FieldCheckOutAt, attendancecorrection.FieldReviewedAt, attendancecorrection.FieldCreatedAt, attendancecorrection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttendanceCorrection fields.
+func (ac *AttendanceCorrection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attendancecorrection.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ac.ID = int(value.Int64)
+		case attendancecorrection.FieldAttendanceRecordID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attendance_record_id", values[i])
+			} else if value.Valid {
+				ac.AttendanceRecordID = new(int)
+				*ac.AttendanceRecordID = int(value.Int64)
+			}
+		case attendancecorrection.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				ac.EmployeeID = int(value.Int64)
+			}
+		case attendancecorrection.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				ac.OrgID = int(value.Int64)
+			}
+		case attendancecorrection.FieldWorkDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_date", values[i])
+			} else if value.Valid {
+				ac.WorkDate = value.Time
+			}
+		case attendancecorrection.FieldCheckInAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field check_in_at", values[i])
+			} else if value.Valid {
+				ac.CheckInAt = new(time.Time)
+				*ac.CheckInAt = value.Time
+			}
+		case attendancecorrection.FieldCheckOutAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field check_out_at", values[i])
+			} else if value.Valid {
+				ac.CheckOutAt = new(time.Time)
+				*ac.CheckOutAt = value.Time
+			}
+		case attendancecorrection.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ac.Reason = value.String
+			}
+		case attendancecorrection.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ac.Status = attendancecorrection.Status(value.String)
+			}
+		case attendancecorrection.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				ac.ReviewerID = new(int)
+				*ac.ReviewerID = int(value.Int64)
+			}
+		case attendancecorrection.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				ac.ReviewComment = new(string)
+				*ac.ReviewComment = value.String
+			}
+		case attendancecorrection.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				ac.ReviewedAt = new(time.Time)
+				*ac.ReviewedAt = value.Time
+			}
+		case attendancecorrection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ac.CreatedAt = value.Time
+			}
+		case attendancecorrection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ac.UpdatedAt = value.Time
+			}
+		default:
+			ac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttendanceCorrection.
+// This includes values selected through modifiers, order, etc.
+func (ac *AttendanceCorrection) Value(name string) (ent.Value, error) {
+	return ac.selectValues.Get(name)
+}
+
+// QueryAttendanceRecord queries the "attendance_record" edge of the AttendanceCorrection entity.
+func (ac *AttendanceCorrection) QueryAttendanceRecord() *AttendanceRecordQuery {
+	return NewAttendanceCorrectionClient(ac.config).QueryAttendanceRecord(ac)
+}
+
+// QueryEmployee queries the "employee" edge of the AttendanceCorrection entity.
+func (ac *AttendanceCorrection) QueryEmployee() *EmployeeQuery {
+	return NewAttendanceCorrectionClient(ac.config).QueryEmployee(ac)
+}
+
+// Update returns a builder for updating this AttendanceCorrection.
+// Note that you need to call AttendanceCorrection.Unwrap() before calling this method if this AttendanceCorrection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ac *AttendanceCorrection) Update() *AttendanceCorrectionUpdateOne {
+	return NewAttendanceCorrectionClient(ac.config).UpdateOne(ac)
+}
+
+// Unwrap unwraps the AttendanceCorrection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ac *AttendanceCorrection) Unwrap() *AttendanceCorrection {
+	_tx, ok := ac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttendanceCorrection is not a transactional entity")
+	}
+	ac.config.driver = _tx.drv
+	return ac
+}
+
+// String implements the fmt.Stringer.
+func (ac *AttendanceCorrection) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttendanceCorrection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ac.ID))
+	if v := ac.AttendanceRecordID; v != nil {
+		builder.WriteString("attendance_record_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", ac.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", ac.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("work_date=")
+	builder.WriteString(ac.WorkDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ac.CheckInAt; v != nil {
+		builder.WriteString("check_in_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ac.CheckOutAt; v != nil {
+		builder.WriteString("check_out_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(ac.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ac.Status))
+	builder.WriteString(", ")
+	if v := ac.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ac.ReviewComment; v != nil {
+		builder.WriteString("review_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ac.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ac.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ac.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttendanceCorrections is a parsable slice of AttendanceCorrection.
+type AttendanceCorrections []*AttendanceCorrection
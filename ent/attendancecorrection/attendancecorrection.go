@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancecorrection
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the attendancecorrection type in the database.
+	Label = "attendance_correction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAttendanceRecordID holds the string denoting the attendance_record_id field in the database.
+	FieldAttendanceRecordID = "attendance_record_id"
+	// FieldEmployeeID holds the string denoting the employee_id field in the database.
+	FieldEmployeeID = "employee_id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldWorkDate holds the string denoting the work_date field in the database.
+	FieldWorkDate = "work_date"
+	// FieldCheckInAt holds the string denoting the check_in_at field in the database.
+	FieldCheckInAt = "check_in_at"
+	// FieldCheckOutAt holds the string denoting the check_out_at field in the database.
+	FieldCheckOutAt = "check_out_at"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewerID holds the string denoting the reviewer_id field in the database.
+	FieldReviewerID = "reviewer_id"
+	// FieldReviewComment holds the string denoting the review_comment field in the database.
+	FieldReviewComment = "review_comment"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeAttendanceRecord holds the string denoting the attendance_record edge name in mutations.
+	EdgeAttendanceRecord = "attendance_record"
+	// EdgeEmployee holds the string denoting the employee edge name in mutations.
+	EdgeEmployee = "employee"
+	// Table holds the table name of the attendancecorrection in the database.
+	Table = "attendance_corrections"
+	// AttendanceRecordTable is the table that holds the attendance_record relation/edge.
+	AttendanceRecordTable = "attendance_corrections"
+	// AttendanceRecordInverseTable is the table name for the AttendanceRecord entity.
+	// It exists in this package in order to avoid circular dependency with the "attendancerecord" package.
+	AttendanceRecordInverseTable = "attendance_records"
+	// AttendanceRecordColumn is the table column denoting the attendance_record relation/edge.
+	AttendanceRecordColumn = "attendance_record_id"
+	// EmployeeTable is the table that holds the employee relation/edge.
+	EmployeeTable = "attendance_corrections"
+	// EmployeeInverseTable is the table name for the Employee entity.
+	// It exists in this package in order to avoid circular dependency with the "employee" package.
+	EmployeeInverseTable = "employees"
+	// EmployeeColumn is the table column denoting the employee relation/edge.
+	EmployeeColumn = "employee_id"
+)
+
+// Columns holds all SQL columns for attendancecorrection fields.
+var Columns = []string{
+	FieldID,
+	FieldAttendanceRecordID,
+	FieldEmployeeID,
+	FieldOrgID,
+	FieldWorkDate,
+	FieldCheckInAt,
+	FieldCheckOutAt,
+	FieldReason,
+	FieldStatus,
+	FieldReviewerID,
+	FieldReviewComment,
+	FieldReviewedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("attendancecorrection: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AttendanceCorrection queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAttendanceRecordID orders the results by the attendance_record_id field.
+func ByAttendanceRecordID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttendanceRecordID, opts...).ToFunc()
+}
+
+// ByEmployeeID orders the results by the employee_id field.
+func ByEmployeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployeeID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// ByWorkDate orders the results by the work_date field.
+func ByWorkDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkDate, opts...).ToFunc()
+}
+
+// ByCheckInAt orders the results by the check_in_at field.
+func ByCheckInAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckInAt, opts...).ToFunc()
+}
+
+// ByCheckOutAt orders the results by the check_out_at field.
+func ByCheckOutAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckOutAt, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewerID orders the results by the reviewer_id field.
+func ByReviewerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewerID, opts...).ToFunc()
+}
+
+// ByReviewComment orders the results by the review_comment field.
+func ByReviewComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewComment, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByAttendanceRecordField orders the results by attendance_record field.
+func ByAttendanceRecordField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceRecordStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEmployeeField orders the results by employee field.
+func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttendanceRecordStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceRecordInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttendanceRecordTable, AttendanceRecordColumn),
+	)
+}
+func newEmployeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmployeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+	)
+}
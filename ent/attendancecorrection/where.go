@@ -0,0 +1,747 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancecorrection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldID, id))
+}
+
+// AttendanceRecordID applies equality check predicate on the "attendance_record_id" field. It's identical to AttendanceRecordIDEQ.
+func AttendanceRecordID(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldAttendanceRecordID, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldOrgID, v))
+}
+
+// WorkDate applies equality check predicate on the "work_date" field. It's identical to WorkDateEQ.
+func WorkDate(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldWorkDate, v))
+}
+
+// CheckInAt applies equality check predicate on the "check_in_at" field. It's identical to CheckInAtEQ.
+func CheckInAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldCheckInAt, v))
+}
+
+// CheckOutAt applies equality check predicate on the "check_out_at" field. It's identical to CheckOutAtEQ.
+func CheckOutAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldCheckOutAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReason, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AttendanceRecordIDEQ applies the EQ predicate on the "attendance_record_id" field.
+func AttendanceRecordIDEQ(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldAttendanceRecordID, v))
+}
+
+// AttendanceRecordIDNEQ applies the NEQ predicate on the "attendance_record_id" field.
+func AttendanceRecordIDNEQ(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldAttendanceRecordID, v))
+}
+
+// AttendanceRecordIDIn applies the In predicate on the "attendance_record_id" field.
+func AttendanceRecordIDIn(vs ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldAttendanceRecordID, vs...))
+}
+
+// AttendanceRecordIDNotIn applies the NotIn predicate on the "attendance_record_id" field.
+func AttendanceRecordIDNotIn(vs ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldAttendanceRecordID, vs...))
+}
+
+// AttendanceRecordIDIsNil applies the IsNil predicate on the "attendance_record_id" field.
+func AttendanceRecordIDIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldAttendanceRecordID))
+}
+
+// AttendanceRecordIDNotNil applies the NotNil predicate on the "attendance_record_id" field.
+func AttendanceRecordIDNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldAttendanceRecordID))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldOrgID, v))
+}
+
+// WorkDateEQ applies the EQ predicate on the "work_date" field.
+func WorkDateEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldWorkDate, v))
+}
+
+// WorkDateNEQ applies the NEQ predicate on the "work_date" field.
+func WorkDateNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldWorkDate, v))
+}
+
+// WorkDateIn applies the In predicate on the "work_date" field.
+func WorkDateIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldWorkDate, vs...))
+}
+
+// WorkDateNotIn applies the NotIn predicate on the "work_date" field.
+func WorkDateNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldWorkDate, vs...))
+}
+
+// WorkDateGT applies the GT predicate on the "work_date" field.
+func WorkDateGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldWorkDate, v))
+}
+
+// WorkDateGTE applies the GTE predicate on the "work_date" field.
+func WorkDateGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldWorkDate, v))
+}
+
+// WorkDateLT applies the LT predicate on the "work_date" field.
+func WorkDateLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldWorkDate, v))
+}
+
+// WorkDateLTE applies the LTE predicate on the "work_date" field.
+func WorkDateLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldWorkDate, v))
+}
+
+// CheckInAtEQ applies the EQ predicate on the "check_in_at" field.
+func CheckInAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldCheckInAt, v))
+}
+
+// CheckInAtNEQ applies the NEQ predicate on the "check_in_at" field.
+func CheckInAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldCheckInAt, v))
+}
+
+// CheckInAtIn applies the In predicate on the "check_in_at" field.
+func CheckInAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldCheckInAt, vs...))
+}
+
+// CheckInAtNotIn applies the NotIn predicate on the "check_in_at" field.
+func CheckInAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldCheckInAt, vs...))
+}
+
+// CheckInAtGT applies the GT predicate on the "check_in_at" field.
+func CheckInAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldCheckInAt, v))
+}
+
+// CheckInAtGTE applies the GTE predicate on the "check_in_at" field.
+func CheckInAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldCheckInAt, v))
+}
+
+// CheckInAtLT applies the LT predicate on the "check_in_at" field.
+func CheckInAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldCheckInAt, v))
+}
+
+// CheckInAtLTE applies the LTE predicate on the "check_in_at" field.
+func CheckInAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldCheckInAt, v))
+}
+
+// CheckInAtIsNil applies the IsNil predicate on the "check_in_at" field.
+func CheckInAtIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldCheckInAt))
+}
+
+// CheckInAtNotNil applies the NotNil predicate on the "check_in_at" field.
+func CheckInAtNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldCheckInAt))
+}
+
+// CheckOutAtEQ applies the EQ predicate on the "check_out_at" field.
+func CheckOutAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldCheckOutAt, v))
+}
+
+// CheckOutAtNEQ applies the NEQ predicate on the "check_out_at" field.
+func CheckOutAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldCheckOutAt, v))
+}
+
+// CheckOutAtIn applies the In predicate on the "check_out_at" field.
+func CheckOutAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldCheckOutAt, vs...))
+}
+
+// CheckOutAtNotIn applies the NotIn predicate on the "check_out_at" field.
+func CheckOutAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldCheckOutAt, vs...))
+}
+
+// CheckOutAtGT applies the GT predicate on the "check_out_at" field.
+func CheckOutAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldCheckOutAt, v))
+}
+
+// CheckOutAtGTE applies the GTE predicate on the "check_out_at" field.
+func CheckOutAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldCheckOutAt, v))
+}
+
+// CheckOutAtLT applies the LT predicate on the "check_out_at" field.
+func CheckOutAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldCheckOutAt, v))
+}
+
+// CheckOutAtLTE applies the LTE predicate on the "check_out_at" field.
+func CheckOutAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldCheckOutAt, v))
+}
+
+// CheckOutAtIsNil applies the IsNil predicate on the "check_out_at" field.
+func CheckOutAtIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldCheckOutAt))
+}
+
+// CheckOutAtNotNil applies the NotNil predicate on the "check_out_at" field.
+func CheckOutAtNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldCheckOutAt))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v int) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentIsNil applies the IsNil predicate on the "review_comment" field.
+func ReviewCommentIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldReviewComment))
+}
+
+// ReviewCommentNotNil applies the NotNil predicate on the "review_comment" field.
+func ReviewCommentNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldReviewComment))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasAttendanceRecord applies the HasEdge predicate on the "attendance_record" edge.
+func HasAttendanceRecord() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttendanceRecordTable, AttendanceRecordColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceRecordWith applies the HasEdge predicate on the "attendance_record" edge with a given conditions (other predicates).
+func HasAttendanceRecordWith(preds ...predicate.AttendanceRecord) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		step := newAttendanceRecordStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttendanceCorrection) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttendanceCorrection) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttendanceCorrection) predicate.AttendanceCorrection {
+	return predicate.AttendanceCorrection(sql.NotPredicates(p))
+}
@@ -0,0 +1,1369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+)
+
+// AttendanceCorrectionCreate is the builder for creating a AttendanceCorrection entity.
+type AttendanceCorrectionCreate struct {
+	config
+	mutation *AttendanceCorrectionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAttendanceRecordID sets the "attendance_record_id" field.
+func (acc *AttendanceCorrectionCreate) SetAttendanceRecordID(i int) *AttendanceCorrectionCreate {
+	acc.mutation.SetAttendanceRecordID(i)
+	return acc
+}
+
+// SetNillableAttendanceRecordID sets the "attendance_record_id" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableAttendanceRecordID(i *int) *AttendanceCorrectionCreate {
+	if i != nil {
+		acc.SetAttendanceRecordID(*i)
+	}
+	return acc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (acc *AttendanceCorrectionCreate) SetEmployeeID(i int) *AttendanceCorrectionCreate {
+	acc.mutation.SetEmployeeID(i)
+	return acc
+}
+
+// SetOrgID sets the "org_id" field.
+func (acc *AttendanceCorrectionCreate) SetOrgID(i int) *AttendanceCorrectionCreate {
+	acc.mutation.SetOrgID(i)
+	return acc
+}
+
+// SetWorkDate sets the "work_date" field.
+func (acc *AttendanceCorrectionCreate) SetWorkDate(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetWorkDate(t)
+	return acc
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (acc *AttendanceCorrectionCreate) SetCheckInAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetCheckInAt(t)
+	return acc
+}
+
+// SetNillableCheckInAt sets the "check_in_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableCheckInAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetCheckInAt(*t)
+	}
+	return acc
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (acc *AttendanceCorrectionCreate) SetCheckOutAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetCheckOutAt(t)
+	return acc
+}
+
+// SetNillableCheckOutAt sets the "check_out_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableCheckOutAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetCheckOutAt(*t)
+	}
+	return acc
+}
+
+// SetReason sets the "reason" field.
+func (acc *AttendanceCorrectionCreate) SetReason(s string) *AttendanceCorrectionCreate {
+	acc.mutation.SetReason(s)
+	return acc
+}
+
+// SetStatus sets the "status" field.
+func (acc *AttendanceCorrectionCreate) SetStatus(a attendancecorrection.Status) *AttendanceCorrectionCreate {
+	acc.mutation.SetStatus(a)
+	return acc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableStatus(a *attendancecorrection.Status) *AttendanceCorrectionCreate {
+	if a != nil {
+		acc.SetStatus(*a)
+	}
+	return acc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (acc *AttendanceCorrectionCreate) SetReviewerID(i int) *AttendanceCorrectionCreate {
+	acc.mutation.SetReviewerID(i)
+	return acc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableReviewerID(i *int) *AttendanceCorrectionCreate {
+	if i != nil {
+		acc.SetReviewerID(*i)
+	}
+	return acc
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (acc *AttendanceCorrectionCreate) SetReviewComment(s string) *AttendanceCorrectionCreate {
+	acc.mutation.SetReviewComment(s)
+	return acc
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableReviewComment(s *string) *AttendanceCorrectionCreate {
+	if s != nil {
+		acc.SetReviewComment(*s)
+	}
+	return acc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (acc *AttendanceCorrectionCreate) SetReviewedAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetReviewedAt(t)
+	return acc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableReviewedAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetReviewedAt(*t)
+	}
+	return acc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acc *AttendanceCorrectionCreate) SetCreatedAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetCreatedAt(t)
+	return acc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableCreatedAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetCreatedAt(*t)
+	}
+	return acc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (acc *AttendanceCorrectionCreate) SetUpdatedAt(t time.Time) *AttendanceCorrectionCreate {
+	acc.mutation.SetUpdatedAt(t)
+	return acc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (acc *AttendanceCorrectionCreate) SetNillableUpdatedAt(t *time.Time) *AttendanceCorrectionCreate {
+	if t != nil {
+		acc.SetUpdatedAt(*t)
+	}
+	return acc
+}
+
+// SetAttendanceRecord sets the "attendance_record" edge to the AttendanceRecord entity.
+func (acc *AttendanceCorrectionCreate) SetAttendanceRecord(a *AttendanceRecord) *AttendanceCorrectionCreate {
+	return acc.SetAttendanceRecordID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (acc *AttendanceCorrectionCreate) SetEmployee(e *Employee) *AttendanceCorrectionCreate {
+	return acc.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceCorrectionMutation object of the builder.
+func (acc *AttendanceCorrectionCreate) Mutation() *AttendanceCorrectionMutation {
+	return acc.mutation
+}
+
+// Save creates the AttendanceCorrection in the database.
+func (acc *AttendanceCorrectionCreate) Save(ctx context.Context) (*AttendanceCorrection, error) {
+	acc.defaults()
+	return withHooks(ctx, acc.sqlSave, acc.mutation, acc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (acc *AttendanceCorrectionCreate) SaveX(ctx context.Context) *AttendanceCorrection {
+	v, err := acc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acc *AttendanceCorrectionCreate) Exec(ctx context.Context) error {
+	_, err := acc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acc *AttendanceCorrectionCreate) ExecX(ctx context.Context) {
+	if err := acc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acc *AttendanceCorrectionCreate) defaults() {
+	if _, ok := acc.mutation.Status(); !ok {
+		v := attendancecorrection.DefaultStatus
+		acc.mutation.SetStatus(v)
+	}
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		v := attendancecorrection.DefaultCreatedAt()
+		acc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := acc.mutation.UpdatedAt(); !ok {
+		v := attendancecorrection.DefaultUpdatedAt()
+		acc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acc *AttendanceCorrectionCreate) check() error {
+	if _, ok := acc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "AttendanceCorrection.employee_id"`)}
+	}
+	if _, ok := acc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "AttendanceCorrection.org_id"`)}
+	}
+	if _, ok := acc.mutation.WorkDate(); !ok {
+		return &ValidationError{Name: "work_date", err: errors.New(`ent: missing required field "AttendanceCorrection.work_date"`)}
+	}
+	if _, ok := acc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "AttendanceCorrection.reason"`)}
+	}
+	if _, ok := acc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AttendanceCorrection.status"`)}
+	}
+	if v, ok := acc.mutation.Status(); ok {
+		if err := attendancecorrection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.status": %w`, err)}
+		}
+	}
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttendanceCorrection.created_at"`)}
+	}
+	if _, ok := acc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AttendanceCorrection.updated_at"`)}
+	}
+	if len(acc.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "AttendanceCorrection.employee"`)}
+	}
+	return nil
+}
+
+func (acc *AttendanceCorrectionCreate) sqlSave(ctx context.Context) (*AttendanceCorrection, error) {
+	if err := acc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := acc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, acc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	acc.mutation.id = &_node.ID
+	acc.mutation.done = true
+	return _node, nil
+}
+
+func (acc *AttendanceCorrectionCreate) createSpec() (*AttendanceCorrection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttendanceCorrection{config: acc.config}
+		_spec = sqlgraph.NewCreateSpec(attendancecorrection.Table, sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = acc.conflict
+	if value, ok := acc.mutation.OrgID(); ok {
+		_spec.SetField(attendancecorrection.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := acc.mutation.WorkDate(); ok {
+		_spec.SetField(attendancecorrection.FieldWorkDate, field.TypeTime, value)
+		_node.WorkDate = value
+	}
+	if value, ok := acc.mutation.CheckInAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCheckInAt, field.TypeTime, value)
+		_node.CheckInAt = &value
+	}
+	if value, ok := acc.mutation.CheckOutAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCheckOutAt, field.TypeTime, value)
+		_node.CheckOutAt = &value
+	}
+	if value, ok := acc.mutation.Reason(); ok {
+		_spec.SetField(attendancecorrection.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := acc.mutation.Status(); ok {
+		_spec.SetField(attendancecorrection.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := acc.mutation.ReviewerID(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewerID, field.TypeInt, value)
+		_node.ReviewerID = &value
+	}
+	if value, ok := acc.mutation.ReviewComment(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = &value
+	}
+	if value, ok := acc.mutation.ReviewedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := acc.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := acc.mutation.UpdatedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := acc.mutation.AttendanceRecordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceRecordTable,
+			Columns: []string{attendancecorrection.AttendanceRecordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttendanceRecordID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := acc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttendanceCorrection.Create().
+//		SetAttendanceRecordID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttendanceCorrectionUpsert) {
+//			SetAttendanceRecordID(v+v).
+//		}).
+//		Exec(ctx)
+func (acc *AttendanceCorrectionCreate) OnConflict(opts ...sql.ConflictOption) *AttendanceCorrectionUpsertOne {
+	acc.conflict = opts
+	return &AttendanceCorrectionUpsertOne{
+		create: acc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttendanceCorrection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acc *AttendanceCorrectionCreate) OnConflictColumns(columns ...string) *AttendanceCorrectionUpsertOne {
+	acc.conflict = append(acc.conflict, sql.ConflictColumns(columns...))
+	return &AttendanceCorrectionUpsertOne{
+		create: acc,
+	}
+}
+
+type (
+	// AttendanceCorrectionUpsertOne is the builder for "upsert"-ing
+	//  one AttendanceCorrection node.
+	AttendanceCorrectionUpsertOne struct {
+		create *AttendanceCorrectionCreate
+	}
+
+	// AttendanceCorrectionUpsert is the "OnConflict" setter.
+	AttendanceCorrectionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAttendanceRecordID sets the "attendance_record_id" field.
+func (u *AttendanceCorrectionUpsert) SetAttendanceRecordID(v int) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldAttendanceRecordID, v)
+	return u
+}
+
+// UpdateAttendanceRecordID sets the "attendance_record_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateAttendanceRecordID() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldAttendanceRecordID)
+	return u
+}
+
+// ClearAttendanceRecordID clears the value of the "attendance_record_id" field.
+func (u *AttendanceCorrectionUpsert) ClearAttendanceRecordID() *AttendanceCorrectionUpsert {
+	u.SetNull(attendancecorrection.FieldAttendanceRecordID)
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *AttendanceCorrectionUpsert) SetEmployeeID(v int) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateEmployeeID() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldEmployeeID)
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AttendanceCorrectionUpsert) SetOrgID(v int) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateOrgID() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *AttendanceCorrectionUpsert) AddOrgID(v int) *AttendanceCorrectionUpsert {
+	u.Add(attendancecorrection.FieldOrgID, v)
+	return u
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *AttendanceCorrectionUpsert) SetWorkDate(v time.Time) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldWorkDate, v)
+	return u
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateWorkDate() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldWorkDate)
+	return u
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (u *AttendanceCorrectionUpsert) SetCheckInAt(v time.Time) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldCheckInAt, v)
+	return u
+}
+
+// UpdateCheckInAt sets the "check_in_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateCheckInAt() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldCheckInAt)
+	return u
+}
+
+// ClearCheckInAt clears the value of the "check_in_at" field.
+func (u *AttendanceCorrectionUpsert) ClearCheckInAt() *AttendanceCorrectionUpsert {
+	u.SetNull(attendancecorrection.FieldCheckInAt)
+	return u
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (u *AttendanceCorrectionUpsert) SetCheckOutAt(v time.Time) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldCheckOutAt, v)
+	return u
+}
+
+// UpdateCheckOutAt sets the "check_out_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateCheckOutAt() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldCheckOutAt)
+	return u
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (u *AttendanceCorrectionUpsert) ClearCheckOutAt() *AttendanceCorrectionUpsert {
+	u.SetNull(attendancecorrection.FieldCheckOutAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *AttendanceCorrectionUpsert) SetReason(v string) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateReason() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldReason)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AttendanceCorrectionUpsert) SetStatus(v attendancecorrection.Status) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateStatus() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldStatus)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsert) SetReviewerID(v int) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateReviewerID() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldReviewerID)
+	return u
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsert) AddReviewerID(v int) *AttendanceCorrectionUpsert {
+	u.Add(attendancecorrection.FieldReviewerID, v)
+	return u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsert) ClearReviewerID() *AttendanceCorrectionUpsert {
+	u.SetNull(attendancecorrection.FieldReviewerID)
+	return u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *AttendanceCorrectionUpsert) SetReviewComment(v string) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldReviewComment, v)
+	return u
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateReviewComment() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldReviewComment)
+	return u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *AttendanceCorrectionUpsert) ClearReviewComment() *AttendanceCorrectionUpsert {
+	u.SetNull(attendancecorrection.FieldReviewComment)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *AttendanceCorrectionUpsert) SetReviewedAt(v time.Time) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateReviewedAt() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *AttendanceCorrectionUpsert) ClearReviewedAt() *AttendanceCorrectionUpsert {
+	u.SetNull(attendancecorrection.FieldReviewedAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttendanceCorrectionUpsert) SetCreatedAt(v time.Time) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateCreatedAt() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AttendanceCorrectionUpsert) SetUpdatedAt(v time.Time) *AttendanceCorrectionUpsert {
+	u.Set(attendancecorrection.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsert) UpdateUpdatedAt() *AttendanceCorrectionUpsert {
+	u.SetExcluded(attendancecorrection.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AttendanceCorrection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AttendanceCorrectionUpsertOne) UpdateNewValues() *AttendanceCorrectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttendanceCorrection.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AttendanceCorrectionUpsertOne) Ignore() *AttendanceCorrectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttendanceCorrectionUpsertOne) DoNothing() *AttendanceCorrectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttendanceCorrectionCreate.OnConflict
+// documentation for more info.
+func (u *AttendanceCorrectionUpsertOne) Update(set func(*AttendanceCorrectionUpsert)) *AttendanceCorrectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttendanceCorrectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAttendanceRecordID sets the "attendance_record_id" field.
+func (u *AttendanceCorrectionUpsertOne) SetAttendanceRecordID(v int) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetAttendanceRecordID(v)
+	})
+}
+
+// UpdateAttendanceRecordID sets the "attendance_record_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateAttendanceRecordID() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateAttendanceRecordID()
+	})
+}
+
+// ClearAttendanceRecordID clears the value of the "attendance_record_id" field.
+func (u *AttendanceCorrectionUpsertOne) ClearAttendanceRecordID() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearAttendanceRecordID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *AttendanceCorrectionUpsertOne) SetEmployeeID(v int) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateEmployeeID() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AttendanceCorrectionUpsertOne) SetOrgID(v int) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *AttendanceCorrectionUpsertOne) AddOrgID(v int) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateOrgID() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *AttendanceCorrectionUpsertOne) SetWorkDate(v time.Time) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetWorkDate(v)
+	})
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateWorkDate() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateWorkDate()
+	})
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (u *AttendanceCorrectionUpsertOne) SetCheckInAt(v time.Time) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetCheckInAt(v)
+	})
+}
+
+// UpdateCheckInAt sets the "check_in_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateCheckInAt() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateCheckInAt()
+	})
+}
+
+// ClearCheckInAt clears the value of the "check_in_at" field.
+func (u *AttendanceCorrectionUpsertOne) ClearCheckInAt() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearCheckInAt()
+	})
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (u *AttendanceCorrectionUpsertOne) SetCheckOutAt(v time.Time) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetCheckOutAt(v)
+	})
+}
+
+// UpdateCheckOutAt sets the "check_out_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateCheckOutAt() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateCheckOutAt()
+	})
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (u *AttendanceCorrectionUpsertOne) ClearCheckOutAt() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearCheckOutAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *AttendanceCorrectionUpsertOne) SetReason(v string) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateReason() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AttendanceCorrectionUpsertOne) SetStatus(v attendancecorrection.Status) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateStatus() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsertOne) SetReviewerID(v int) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsertOne) AddReviewerID(v int) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateReviewerID() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsertOne) ClearReviewerID() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *AttendanceCorrectionUpsertOne) SetReviewComment(v string) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateReviewComment() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *AttendanceCorrectionUpsertOne) ClearReviewComment() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *AttendanceCorrectionUpsertOne) SetReviewedAt(v time.Time) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateReviewedAt() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *AttendanceCorrectionUpsertOne) ClearReviewedAt() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttendanceCorrectionUpsertOne) SetCreatedAt(v time.Time) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateCreatedAt() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AttendanceCorrectionUpsertOne) SetUpdatedAt(v time.Time) *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertOne) UpdateUpdatedAt() *AttendanceCorrectionUpsertOne {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AttendanceCorrectionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttendanceCorrectionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttendanceCorrectionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AttendanceCorrectionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AttendanceCorrectionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AttendanceCorrectionCreateBulk is the builder for creating many AttendanceCorrection entities in bulk.
+type AttendanceCorrectionCreateBulk struct {
+	config
+	err      error
+	builders []*AttendanceCorrectionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AttendanceCorrection entities in the database.
+func (accb *AttendanceCorrectionCreateBulk) Save(ctx context.Context) ([]*AttendanceCorrection, error) {
+	if accb.err != nil {
+		return nil, accb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(accb.builders))
+	nodes := make([]*AttendanceCorrection, len(accb.builders))
+	mutators := make([]Mutator, len(accb.builders))
+	for i := range accb.builders {
+		func(i int, root context.Context) {
+			builder := accb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttendanceCorrectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, accb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = accb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, accb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, accb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (accb *AttendanceCorrectionCreateBulk) SaveX(ctx context.Context) []*AttendanceCorrection {
+	v, err := accb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (accb *AttendanceCorrectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := accb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (accb *AttendanceCorrectionCreateBulk) ExecX(ctx context.Context) {
+	if err := accb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttendanceCorrection.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttendanceCorrectionUpsert) {
+//			SetAttendanceRecordID(v+v).
+//		}).
+//		Exec(ctx)
+func (accb *AttendanceCorrectionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AttendanceCorrectionUpsertBulk {
+	accb.conflict = opts
+	return &AttendanceCorrectionUpsertBulk{
+		create: accb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttendanceCorrection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (accb *AttendanceCorrectionCreateBulk) OnConflictColumns(columns ...string) *AttendanceCorrectionUpsertBulk {
+	accb.conflict = append(accb.conflict, sql.ConflictColumns(columns...))
+	return &AttendanceCorrectionUpsertBulk{
+		create: accb,
+	}
+}
+
+// AttendanceCorrectionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AttendanceCorrection nodes.
+type AttendanceCorrectionUpsertBulk struct {
+	create *AttendanceCorrectionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AttendanceCorrection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AttendanceCorrectionUpsertBulk) UpdateNewValues() *AttendanceCorrectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttendanceCorrection.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AttendanceCorrectionUpsertBulk) Ignore() *AttendanceCorrectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttendanceCorrectionUpsertBulk) DoNothing() *AttendanceCorrectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttendanceCorrectionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AttendanceCorrectionUpsertBulk) Update(set func(*AttendanceCorrectionUpsert)) *AttendanceCorrectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttendanceCorrectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAttendanceRecordID sets the "attendance_record_id" field.
+func (u *AttendanceCorrectionUpsertBulk) SetAttendanceRecordID(v int) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetAttendanceRecordID(v)
+	})
+}
+
+// UpdateAttendanceRecordID sets the "attendance_record_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateAttendanceRecordID() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateAttendanceRecordID()
+	})
+}
+
+// ClearAttendanceRecordID clears the value of the "attendance_record_id" field.
+func (u *AttendanceCorrectionUpsertBulk) ClearAttendanceRecordID() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearAttendanceRecordID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *AttendanceCorrectionUpsertBulk) SetEmployeeID(v int) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateEmployeeID() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AttendanceCorrectionUpsertBulk) SetOrgID(v int) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *AttendanceCorrectionUpsertBulk) AddOrgID(v int) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateOrgID() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *AttendanceCorrectionUpsertBulk) SetWorkDate(v time.Time) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetWorkDate(v)
+	})
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateWorkDate() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateWorkDate()
+	})
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (u *AttendanceCorrectionUpsertBulk) SetCheckInAt(v time.Time) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetCheckInAt(v)
+	})
+}
+
+// UpdateCheckInAt sets the "check_in_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateCheckInAt() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateCheckInAt()
+	})
+}
+
+// ClearCheckInAt clears the value of the "check_in_at" field.
+func (u *AttendanceCorrectionUpsertBulk) ClearCheckInAt() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearCheckInAt()
+	})
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (u *AttendanceCorrectionUpsertBulk) SetCheckOutAt(v time.Time) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetCheckOutAt(v)
+	})
+}
+
+// UpdateCheckOutAt sets the "check_out_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateCheckOutAt() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateCheckOutAt()
+	})
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (u *AttendanceCorrectionUpsertBulk) ClearCheckOutAt() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearCheckOutAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *AttendanceCorrectionUpsertBulk) SetReason(v string) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateReason() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AttendanceCorrectionUpsertBulk) SetStatus(v attendancecorrection.Status) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateStatus() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsertBulk) SetReviewerID(v int) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsertBulk) AddReviewerID(v int) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateReviewerID() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *AttendanceCorrectionUpsertBulk) ClearReviewerID() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *AttendanceCorrectionUpsertBulk) SetReviewComment(v string) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateReviewComment() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *AttendanceCorrectionUpsertBulk) ClearReviewComment() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *AttendanceCorrectionUpsertBulk) SetReviewedAt(v time.Time) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateReviewedAt() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *AttendanceCorrectionUpsertBulk) ClearReviewedAt() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttendanceCorrectionUpsertBulk) SetCreatedAt(v time.Time) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateCreatedAt() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AttendanceCorrectionUpsertBulk) SetUpdatedAt(v time.Time) *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AttendanceCorrectionUpsertBulk) UpdateUpdatedAt() *AttendanceCorrectionUpsertBulk {
+	return u.Update(func(s *AttendanceCorrectionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AttendanceCorrectionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AttendanceCorrectionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttendanceCorrectionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttendanceCorrectionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// AttendanceCorrectionDelete is the builder for deleting a AttendanceCorrection entity.
+type AttendanceCorrectionDelete struct {
+	config
+	hooks    []Hook
+	mutation *AttendanceCorrectionMutation
+}
+
+// Where appends a list predicates to the AttendanceCorrectionDelete builder.
+func (acd *AttendanceCorrectionDelete) Where(ps ...predicate.AttendanceCorrection) *AttendanceCorrectionDelete {
+	acd.mutation.Where(ps...)
+	return acd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (acd *AttendanceCorrectionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, acd.sqlExec, acd.mutation, acd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acd *AttendanceCorrectionDelete) ExecX(ctx context.Context) int {
+	n, err := acd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (acd *AttendanceCorrectionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(attendancecorrection.Table, sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt))
+	if ps := acd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, acd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	acd.mutation.done = true
+	return affected, err
+}
+
+// AttendanceCorrectionDeleteOne is the builder for deleting a single AttendanceCorrection entity.
+type AttendanceCorrectionDeleteOne struct {
+	acd *AttendanceCorrectionDelete
+}
+
+// Where appends a list predicates to the AttendanceCorrectionDelete builder.
+func (acdo *AttendanceCorrectionDeleteOne) Where(ps ...predicate.AttendanceCorrection) *AttendanceCorrectionDeleteOne {
+	acdo.acd.mutation.Where(ps...)
+	return acdo
+}
+
+// Exec executes the deletion query.
+func (acdo *AttendanceCorrectionDeleteOne) Exec(ctx context.Context) error {
+	n, err := acdo.acd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{attendancecorrection.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acdo *AttendanceCorrectionDeleteOne) ExecX(ctx context.Context) {
+	if err := acdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
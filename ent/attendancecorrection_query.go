@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// AttendanceCorrectionQuery is the builder for querying AttendanceCorrection entities.
+type AttendanceCorrectionQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []attendancecorrection.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.AttendanceCorrection
+	withAttendanceRecord *AttendanceRecordQuery
+	withEmployee         *EmployeeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttendanceCorrectionQuery builder.
+func (acq *AttendanceCorrectionQuery) Where(ps ...predicate.AttendanceCorrection) *AttendanceCorrectionQuery {
+	acq.predicates = append(acq.predicates, ps...)
+	return acq
+}
+
+// Limit the number of records to be returned by this query.
+func (acq *AttendanceCorrectionQuery) Limit(limit int) *AttendanceCorrectionQuery {
+	acq.ctx.Limit = &limit
+	return acq
+}
+
+// Offset to start from.
+func (acq *AttendanceCorrectionQuery) Offset(offset int) *AttendanceCorrectionQuery {
+	acq.ctx.Offset = &offset
+	return acq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (acq *AttendanceCorrectionQuery) Unique(unique bool) *AttendanceCorrectionQuery {
+	acq.ctx.Unique = &unique
+	return acq
+}
+
+// Order specifies how the records should be ordered.
+func (acq *AttendanceCorrectionQuery) Order(o ...attendancecorrection.OrderOption) *AttendanceCorrectionQuery {
+	acq.order = append(acq.order, o...)
+	return acq
+}
+
+// QueryAttendanceRecord chains the current query on the "attendance_record" edge.
+func (acq *AttendanceCorrectionQuery) QueryAttendanceRecord() *AttendanceRecordQuery {
+	query := (&AttendanceRecordClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancecorrection.Table, attendancecorrection.FieldID, selector),
+			sqlgraph.To(attendancerecord.Table, attendancerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancecorrection.AttendanceRecordTable, attendancecorrection.AttendanceRecordColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (acq *AttendanceCorrectionQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancecorrection.Table, attendancecorrection.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancecorrection.EmployeeTable, attendancecorrection.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AttendanceCorrection entity from the query.
+// Returns a *NotFoundError when no AttendanceCorrection was found.
+func (acq *AttendanceCorrectionQuery) First(ctx context.Context) (*AttendanceCorrection, error) {
+	nodes, err := acq.Limit(1).All(setContextOp(ctx, acq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attendancecorrection.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (acq *AttendanceCorrectionQuery) FirstX(ctx context.Context) *AttendanceCorrection {
+	node, err := acq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttendanceCorrection ID from the query.
+// Returns a *NotFoundError when no AttendanceCorrection ID was found.
+func (acq *AttendanceCorrectionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = acq.Limit(1).IDs(setContextOp(ctx, acq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attendancecorrection.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (acq *AttendanceCorrectionQuery) FirstIDX(ctx context.Context) int {
+	id, err := acq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttendanceCorrection entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttendanceCorrection entity is found.
+// Returns a *NotFoundError when no AttendanceCorrection entities are found.
+func (acq *AttendanceCorrectionQuery) Only(ctx context.Context) (*AttendanceCorrection, error) {
+	nodes, err := acq.Limit(2).All(setContextOp(ctx, acq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attendancecorrection.Label}
+	default:
+		return nil, &NotSingularError{attendancecorrection.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (acq *AttendanceCorrectionQuery) OnlyX(ctx context.Context) *AttendanceCorrection {
+	node, err := acq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttendanceCorrection ID in the query.
+// Returns a *NotSingularError when more than one AttendanceCorrection ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (acq *AttendanceCorrectionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = acq.Limit(2).IDs(setContextOp(ctx, acq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attendancecorrection.Label}
+	default:
+		err = &NotSingularError{attendancecorrection.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (acq *AttendanceCorrectionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := acq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttendanceCorrections.
+func (acq *AttendanceCorrectionQuery) All(ctx context.Context) ([]*AttendanceCorrection, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryAll)
+	if err := acq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AttendanceCorrection, *AttendanceCorrectionQuery]()
+	return withInterceptors[[]*AttendanceCorrection](ctx, acq, qr, acq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (acq *AttendanceCorrectionQuery) AllX(ctx context.Context) []*AttendanceCorrection {
+	nodes, err := acq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttendanceCorrection IDs.
+func (acq *AttendanceCorrectionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if acq.ctx.Unique == nil && acq.path != nil {
+		acq.Unique(true)
+	}
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryIDs)
+	if err = acq.Select(attendancecorrection.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (acq *AttendanceCorrectionQuery) IDsX(ctx context.Context) []int {
+	ids, err := acq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (acq *AttendanceCorrectionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryCount)
+	if err := acq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, acq, querierCount[*AttendanceCorrectionQuery](), acq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (acq *AttendanceCorrectionQuery) CountX(ctx context.Context) int {
+	count, err := acq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (acq *AttendanceCorrectionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryExist)
+	switch _, err := acq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (acq *AttendanceCorrectionQuery) ExistX(ctx context.Context) bool {
+	exist, err := acq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttendanceCorrectionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (acq *AttendanceCorrectionQuery) Clone() *AttendanceCorrectionQuery {
+	if acq == nil {
+		return nil
+	}
+	return &AttendanceCorrectionQuery{
+		config:               acq.config,
+		ctx:                  acq.ctx.Clone(),
+		order:                append([]attendancecorrection.OrderOption{}, acq.order...),
+		inters:               append([]Interceptor{}, acq.inters...),
+		predicates:           append([]predicate.AttendanceCorrection{}, acq.predicates...),
+		withAttendanceRecord: acq.withAttendanceRecord.Clone(),
+		withEmployee:         acq.withEmployee.Clone(),
+		// clone intermediate query.
+		sql:  acq.sql.Clone(),
+		path: acq.path,
+	}
+}
+
+// WithAttendanceRecord tells the query-builder to eager-load the nodes that are connected to
+// the "attendance_record" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AttendanceCorrectionQuery) WithAttendanceRecord(opts ...func(*AttendanceRecordQuery)) *AttendanceCorrectionQuery {
+	query := (&AttendanceRecordClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withAttendanceRecord = query
+	return acq
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AttendanceCorrectionQuery) WithEmployee(opts ...func(*EmployeeQuery)) *AttendanceCorrectionQuery {
+	query := (&EmployeeClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withEmployee = query
+	return acq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AttendanceRecordID int `json:"attendance_record_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttendanceCorrection.Query().
+//		GroupBy(attendancecorrection.FieldAttendanceRecordID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (acq *AttendanceCorrectionQuery) GroupBy(field string, fields ...string) *AttendanceCorrectionGroupBy {
+	acq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AttendanceCorrectionGroupBy{build: acq}
+	grbuild.flds = &acq.ctx.Fields
+	grbuild.label = attendancecorrection.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AttendanceRecordID int `json:"attendance_record_id"`
+//	}
+//
+//	client.AttendanceCorrection.Query().
+//		Select(attendancecorrection.FieldAttendanceRecordID).
+//		Scan(ctx, &v)
+func (acq *AttendanceCorrectionQuery) Select(fields ...string) *AttendanceCorrectionSelect {
+	acq.ctx.Fields = append(acq.ctx.Fields, fields...)
+	sbuild := &AttendanceCorrectionSelect{AttendanceCorrectionQuery: acq}
+	sbuild.label = attendancecorrection.Label
+	sbuild.flds, sbuild.scan = &acq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AttendanceCorrectionSelect configured with the given aggregations.
+func (acq *AttendanceCorrectionQuery) Aggregate(fns ...AggregateFunc) *AttendanceCorrectionSelect {
+	return acq.Select().Aggregate(fns...)
+}
+
+func (acq *AttendanceCorrectionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range acq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, acq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range acq.ctx.Fields {
+		if !attendancecorrection.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if acq.path != nil {
+		prev, err := acq.path(ctx)
+		if err != nil {
+			return err
+		}
+		acq.sql = prev
+	}
+	return nil
+}
+
+func (acq *AttendanceCorrectionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttendanceCorrection, error) {
+	var (
+		nodes       = []*AttendanceCorrection{}
+		_spec       = acq.querySpec()
+		loadedTypes = [2]bool{
+			acq.withAttendanceRecord != nil,
+			acq.withEmployee != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AttendanceCorrection).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AttendanceCorrection{config: acq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, acq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := acq.withAttendanceRecord; query != nil {
+		if err := acq.loadAttendanceRecord(ctx, query, nodes, nil,
+			func(n *AttendanceCorrection, e *AttendanceRecord) { n.Edges.AttendanceRecord = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := acq.withEmployee; query != nil {
+		if err := acq.loadEmployee(ctx, query, nodes, nil,
+			func(n *AttendanceCorrection, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (acq *AttendanceCorrectionQuery) loadAttendanceRecord(ctx context.Context, query *AttendanceRecordQuery, nodes []*AttendanceCorrection, init func(*AttendanceCorrection), assign func(*AttendanceCorrection, *AttendanceRecord)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AttendanceCorrection)
+	for i := range nodes {
+		if nodes[i].AttendanceRecordID == nil {
+			continue
+		}
+		fk := *nodes[i].AttendanceRecordID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(attendancerecord.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "attendance_record_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (acq *AttendanceCorrectionQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*AttendanceCorrection, init func(*AttendanceCorrection), assign func(*AttendanceCorrection, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AttendanceCorrection)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (acq *AttendanceCorrectionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := acq.querySpec()
+	_spec.Node.Columns = acq.ctx.Fields
+	if len(acq.ctx.Fields) > 0 {
+		_spec.Unique = acq.ctx.Unique != nil && *acq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, acq.driver, _spec)
+}
+
+func (acq *AttendanceCorrectionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(attendancecorrection.Table, attendancecorrection.Columns, sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt))
+	_spec.From = acq.sql
+	if unique := acq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if acq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := acq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancecorrection.FieldID)
+		for i := range fields {
+			if fields[i] != attendancecorrection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if acq.withAttendanceRecord != nil {
+			_spec.Node.AddColumnOnce(attendancecorrection.FieldAttendanceRecordID)
+		}
+		if acq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(attendancecorrection.FieldEmployeeID)
+		}
+	}
+	if ps := acq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := acq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (acq *AttendanceCorrectionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(acq.driver.Dialect())
+	t1 := builder.Table(attendancecorrection.Table)
+	columns := acq.ctx.Fields
+	if len(columns) == 0 {
+		columns = attendancecorrection.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if acq.sql != nil {
+		selector = acq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if acq.ctx.Unique != nil && *acq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range acq.predicates {
+		p(selector)
+	}
+	for _, p := range acq.order {
+		p(selector)
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AttendanceCorrectionGroupBy is the group-by builder for AttendanceCorrection entities.
+type AttendanceCorrectionGroupBy struct {
+	selector
+	build *AttendanceCorrectionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (acgb *AttendanceCorrectionGroupBy) Aggregate(fns ...AggregateFunc) *AttendanceCorrectionGroupBy {
+	acgb.fns = append(acgb.fns, fns...)
+	return acgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acgb *AttendanceCorrectionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acgb.build.ctx, ent.OpQueryGroupBy)
+	if err := acgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendanceCorrectionQuery, *AttendanceCorrectionGroupBy](ctx, acgb.build, acgb, acgb.build.inters, v)
+}
+
+func (acgb *AttendanceCorrectionGroupBy) sqlScan(ctx context.Context, root *AttendanceCorrectionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(acgb.fns))
+	for _, fn := range acgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*acgb.flds)+len(acgb.fns))
+		for _, f := range *acgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*acgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AttendanceCorrectionSelect is the builder for selecting fields of AttendanceCorrection entities.
+type AttendanceCorrectionSelect struct {
+	*AttendanceCorrectionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (acs *AttendanceCorrectionSelect) Aggregate(fns ...AggregateFunc) *AttendanceCorrectionSelect {
+	acs.fns = append(acs.fns, fns...)
+	return acs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acs *AttendanceCorrectionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acs.ctx, ent.OpQuerySelect)
+	if err := acs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendanceCorrectionQuery, *AttendanceCorrectionSelect](ctx, acs.AttendanceCorrectionQuery, acs, acs.inters, v)
+}
+
+func (acs *AttendanceCorrectionSelect) sqlScan(ctx context.Context, root *AttendanceCorrectionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(acs.fns))
+	for _, fn := range acs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*acs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
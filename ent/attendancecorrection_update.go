@@ -0,0 +1,944 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// AttendanceCorrectionUpdate is the builder for updating AttendanceCorrection entities.
+type AttendanceCorrectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttendanceCorrectionMutation
+}
+
+// Where appends a list predicates to the AttendanceCorrectionUpdate builder.
+func (acu *AttendanceCorrectionUpdate) Where(ps ...predicate.AttendanceCorrection) *AttendanceCorrectionUpdate {
+	acu.mutation.Where(ps...)
+	return acu
+}
+
+// SetAttendanceRecordID sets the "attendance_record_id" field.
+func (acu *AttendanceCorrectionUpdate) SetAttendanceRecordID(i int) *AttendanceCorrectionUpdate {
+	acu.mutation.SetAttendanceRecordID(i)
+	return acu
+}
+
+// SetNillableAttendanceRecordID sets the "attendance_record_id" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableAttendanceRecordID(i *int) *AttendanceCorrectionUpdate {
+	if i != nil {
+		acu.SetAttendanceRecordID(*i)
+	}
+	return acu
+}
+
+// ClearAttendanceRecordID clears the value of the "attendance_record_id" field.
+func (acu *AttendanceCorrectionUpdate) ClearAttendanceRecordID() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearAttendanceRecordID()
+	return acu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (acu *AttendanceCorrectionUpdate) SetEmployeeID(i int) *AttendanceCorrectionUpdate {
+	acu.mutation.SetEmployeeID(i)
+	return acu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableEmployeeID(i *int) *AttendanceCorrectionUpdate {
+	if i != nil {
+		acu.SetEmployeeID(*i)
+	}
+	return acu
+}
+
+// SetOrgID sets the "org_id" field.
+func (acu *AttendanceCorrectionUpdate) SetOrgID(i int) *AttendanceCorrectionUpdate {
+	acu.mutation.ResetOrgID()
+	acu.mutation.SetOrgID(i)
+	return acu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableOrgID(i *int) *AttendanceCorrectionUpdate {
+	if i != nil {
+		acu.SetOrgID(*i)
+	}
+	return acu
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (acu *AttendanceCorrectionUpdate) AddOrgID(i int) *AttendanceCorrectionUpdate {
+	acu.mutation.AddOrgID(i)
+	return acu
+}
+
+// SetWorkDate sets the "work_date" field.
+func (acu *AttendanceCorrectionUpdate) SetWorkDate(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetWorkDate(t)
+	return acu
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableWorkDate(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetWorkDate(*t)
+	}
+	return acu
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (acu *AttendanceCorrectionUpdate) SetCheckInAt(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetCheckInAt(t)
+	return acu
+}
+
+// SetNillableCheckInAt sets the "check_in_at" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableCheckInAt(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetCheckInAt(*t)
+	}
+	return acu
+}
+
+// ClearCheckInAt clears the value of the "check_in_at" field.
+func (acu *AttendanceCorrectionUpdate) ClearCheckInAt() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearCheckInAt()
+	return acu
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (acu *AttendanceCorrectionUpdate) SetCheckOutAt(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetCheckOutAt(t)
+	return acu
+}
+
+// SetNillableCheckOutAt sets the "check_out_at" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableCheckOutAt(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetCheckOutAt(*t)
+	}
+	return acu
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (acu *AttendanceCorrectionUpdate) ClearCheckOutAt() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearCheckOutAt()
+	return acu
+}
+
+// SetReason sets the "reason" field.
+func (acu *AttendanceCorrectionUpdate) SetReason(s string) *AttendanceCorrectionUpdate {
+	acu.mutation.SetReason(s)
+	return acu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableReason(s *string) *AttendanceCorrectionUpdate {
+	if s != nil {
+		acu.SetReason(*s)
+	}
+	return acu
+}
+
+// SetStatus sets the "status" field.
+func (acu *AttendanceCorrectionUpdate) SetStatus(a attendancecorrection.Status) *AttendanceCorrectionUpdate {
+	acu.mutation.SetStatus(a)
+	return acu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableStatus(a *attendancecorrection.Status) *AttendanceCorrectionUpdate {
+	if a != nil {
+		acu.SetStatus(*a)
+	}
+	return acu
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (acu *AttendanceCorrectionUpdate) SetReviewerID(i int) *AttendanceCorrectionUpdate {
+	acu.mutation.ResetReviewerID()
+	acu.mutation.SetReviewerID(i)
+	return acu
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableReviewerID(i *int) *AttendanceCorrectionUpdate {
+	if i != nil {
+		acu.SetReviewerID(*i)
+	}
+	return acu
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (acu *AttendanceCorrectionUpdate) AddReviewerID(i int) *AttendanceCorrectionUpdate {
+	acu.mutation.AddReviewerID(i)
+	return acu
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (acu *AttendanceCorrectionUpdate) ClearReviewerID() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearReviewerID()
+	return acu
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (acu *AttendanceCorrectionUpdate) SetReviewComment(s string) *AttendanceCorrectionUpdate {
+	acu.mutation.SetReviewComment(s)
+	return acu
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableReviewComment(s *string) *AttendanceCorrectionUpdate {
+	if s != nil {
+		acu.SetReviewComment(*s)
+	}
+	return acu
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (acu *AttendanceCorrectionUpdate) ClearReviewComment() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearReviewComment()
+	return acu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (acu *AttendanceCorrectionUpdate) SetReviewedAt(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetReviewedAt(t)
+	return acu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableReviewedAt(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetReviewedAt(*t)
+	}
+	return acu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (acu *AttendanceCorrectionUpdate) ClearReviewedAt() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearReviewedAt()
+	return acu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acu *AttendanceCorrectionUpdate) SetCreatedAt(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetCreatedAt(t)
+	return acu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acu *AttendanceCorrectionUpdate) SetNillableCreatedAt(t *time.Time) *AttendanceCorrectionUpdate {
+	if t != nil {
+		acu.SetCreatedAt(*t)
+	}
+	return acu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (acu *AttendanceCorrectionUpdate) SetUpdatedAt(t time.Time) *AttendanceCorrectionUpdate {
+	acu.mutation.SetUpdatedAt(t)
+	return acu
+}
+
+// SetAttendanceRecord sets the "attendance_record" edge to the AttendanceRecord entity.
+func (acu *AttendanceCorrectionUpdate) SetAttendanceRecord(a *AttendanceRecord) *AttendanceCorrectionUpdate {
+	return acu.SetAttendanceRecordID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (acu *AttendanceCorrectionUpdate) SetEmployee(e *Employee) *AttendanceCorrectionUpdate {
+	return acu.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceCorrectionMutation object of the builder.
+func (acu *AttendanceCorrectionUpdate) Mutation() *AttendanceCorrectionMutation {
+	return acu.mutation
+}
+
+// ClearAttendanceRecord clears the "attendance_record" edge to the AttendanceRecord entity.
+func (acu *AttendanceCorrectionUpdate) ClearAttendanceRecord() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearAttendanceRecord()
+	return acu
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (acu *AttendanceCorrectionUpdate) ClearEmployee() *AttendanceCorrectionUpdate {
+	acu.mutation.ClearEmployee()
+	return acu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (acu *AttendanceCorrectionUpdate) Save(ctx context.Context) (int, error) {
+	acu.defaults()
+	return withHooks(ctx, acu.sqlSave, acu.mutation, acu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acu *AttendanceCorrectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := acu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (acu *AttendanceCorrectionUpdate) Exec(ctx context.Context) error {
+	_, err := acu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acu *AttendanceCorrectionUpdate) ExecX(ctx context.Context) {
+	if err := acu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acu *AttendanceCorrectionUpdate) defaults() {
+	if _, ok := acu.mutation.UpdatedAt(); !ok {
+		v := attendancecorrection.UpdateDefaultUpdatedAt()
+		acu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acu *AttendanceCorrectionUpdate) check() error {
+	if v, ok := acu.mutation.Status(); ok {
+		if err := attendancecorrection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.status": %w`, err)}
+		}
+	}
+	if acu.mutation.EmployeeCleared() && len(acu.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceCorrection.employee"`)
+	}
+	return nil
+}
+
+func (acu *AttendanceCorrectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := acu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancecorrection.Table, attendancecorrection.Columns, sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt))
+	if ps := acu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acu.mutation.OrgID(); ok {
+		_spec.SetField(attendancecorrection.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedOrgID(); ok {
+		_spec.AddField(attendancecorrection.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.WorkDate(); ok {
+		_spec.SetField(attendancecorrection.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := acu.mutation.CheckInAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCheckInAt, field.TypeTime, value)
+	}
+	if acu.mutation.CheckInAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldCheckInAt, field.TypeTime)
+	}
+	if value, ok := acu.mutation.CheckOutAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCheckOutAt, field.TypeTime, value)
+	}
+	if acu.mutation.CheckOutAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldCheckOutAt, field.TypeTime)
+	}
+	if value, ok := acu.mutation.Reason(); ok {
+		_spec.SetField(attendancecorrection.FieldReason, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.Status(); ok {
+		_spec.SetField(attendancecorrection.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := acu.mutation.ReviewerID(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedReviewerID(); ok {
+		_spec.AddField(attendancecorrection.FieldReviewerID, field.TypeInt, value)
+	}
+	if acu.mutation.ReviewerIDCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := acu.mutation.ReviewComment(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewComment, field.TypeString, value)
+	}
+	if acu.mutation.ReviewCommentCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := acu.mutation.ReviewedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedAt, field.TypeTime, value)
+	}
+	if acu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := acu.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := acu.mutation.UpdatedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if acu.mutation.AttendanceRecordCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceRecordTable,
+			Columns: []string{attendancecorrection.AttendanceRecordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.AttendanceRecordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceRecordTable,
+			Columns: []string{attendancecorrection.AttendanceRecordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acu.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, acu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancecorrection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	acu.mutation.done = true
+	return n, nil
+}
+
+// AttendanceCorrectionUpdateOne is the builder for updating a single AttendanceCorrection entity.
+type AttendanceCorrectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttendanceCorrectionMutation
+}
+
+// SetAttendanceRecordID sets the "attendance_record_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetAttendanceRecordID(i int) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetAttendanceRecordID(i)
+	return acuo
+}
+
+// SetNillableAttendanceRecordID sets the "attendance_record_id" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableAttendanceRecordID(i *int) *AttendanceCorrectionUpdateOne {
+	if i != nil {
+		acuo.SetAttendanceRecordID(*i)
+	}
+	return acuo
+}
+
+// ClearAttendanceRecordID clears the value of the "attendance_record_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearAttendanceRecordID() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearAttendanceRecordID()
+	return acuo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetEmployeeID(i int) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetEmployeeID(i)
+	return acuo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableEmployeeID(i *int) *AttendanceCorrectionUpdateOne {
+	if i != nil {
+		acuo.SetEmployeeID(*i)
+	}
+	return acuo
+}
+
+// SetOrgID sets the "org_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetOrgID(i int) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ResetOrgID()
+	acuo.mutation.SetOrgID(i)
+	return acuo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableOrgID(i *int) *AttendanceCorrectionUpdateOne {
+	if i != nil {
+		acuo.SetOrgID(*i)
+	}
+	return acuo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) AddOrgID(i int) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.AddOrgID(i)
+	return acuo
+}
+
+// SetWorkDate sets the "work_date" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetWorkDate(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetWorkDate(t)
+	return acuo
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableWorkDate(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetWorkDate(*t)
+	}
+	return acuo
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetCheckInAt(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetCheckInAt(t)
+	return acuo
+}
+
+// SetNillableCheckInAt sets the "check_in_at" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableCheckInAt(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetCheckInAt(*t)
+	}
+	return acuo
+}
+
+// ClearCheckInAt clears the value of the "check_in_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearCheckInAt() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearCheckInAt()
+	return acuo
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetCheckOutAt(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetCheckOutAt(t)
+	return acuo
+}
+
+// SetNillableCheckOutAt sets the "check_out_at" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableCheckOutAt(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetCheckOutAt(*t)
+	}
+	return acuo
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearCheckOutAt() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearCheckOutAt()
+	return acuo
+}
+
+// SetReason sets the "reason" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetReason(s string) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetReason(s)
+	return acuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableReason(s *string) *AttendanceCorrectionUpdateOne {
+	if s != nil {
+		acuo.SetReason(*s)
+	}
+	return acuo
+}
+
+// SetStatus sets the "status" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetStatus(a attendancecorrection.Status) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetStatus(a)
+	return acuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableStatus(a *attendancecorrection.Status) *AttendanceCorrectionUpdateOne {
+	if a != nil {
+		acuo.SetStatus(*a)
+	}
+	return acuo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetReviewerID(i int) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ResetReviewerID()
+	acuo.mutation.SetReviewerID(i)
+	return acuo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableReviewerID(i *int) *AttendanceCorrectionUpdateOne {
+	if i != nil {
+		acuo.SetReviewerID(*i)
+	}
+	return acuo
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) AddReviewerID(i int) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.AddReviewerID(i)
+	return acuo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearReviewerID() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearReviewerID()
+	return acuo
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetReviewComment(s string) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetReviewComment(s)
+	return acuo
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableReviewComment(s *string) *AttendanceCorrectionUpdateOne {
+	if s != nil {
+		acuo.SetReviewComment(*s)
+	}
+	return acuo
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearReviewComment() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearReviewComment()
+	return acuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetReviewedAt(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetReviewedAt(t)
+	return acuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableReviewedAt(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetReviewedAt(*t)
+	}
+	return acuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) ClearReviewedAt() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearReviewedAt()
+	return acuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetCreatedAt(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetCreatedAt(t)
+	return acuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acuo *AttendanceCorrectionUpdateOne) SetNillableCreatedAt(t *time.Time) *AttendanceCorrectionUpdateOne {
+	if t != nil {
+		acuo.SetCreatedAt(*t)
+	}
+	return acuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (acuo *AttendanceCorrectionUpdateOne) SetUpdatedAt(t time.Time) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.SetUpdatedAt(t)
+	return acuo
+}
+
+// SetAttendanceRecord sets the "attendance_record" edge to the AttendanceRecord entity.
+func (acuo *AttendanceCorrectionUpdateOne) SetAttendanceRecord(a *AttendanceRecord) *AttendanceCorrectionUpdateOne {
+	return acuo.SetAttendanceRecordID(a.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (acuo *AttendanceCorrectionUpdateOne) SetEmployee(e *Employee) *AttendanceCorrectionUpdateOne {
+	return acuo.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the AttendanceCorrectionMutation object of the builder.
+func (acuo *AttendanceCorrectionUpdateOne) Mutation() *AttendanceCorrectionMutation {
+	return acuo.mutation
+}
+
+// ClearAttendanceRecord clears the "attendance_record" edge to the AttendanceRecord entity.
+func (acuo *AttendanceCorrectionUpdateOne) ClearAttendanceRecord() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearAttendanceRecord()
+	return acuo
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (acuo *AttendanceCorrectionUpdateOne) ClearEmployee() *AttendanceCorrectionUpdateOne {
+	acuo.mutation.ClearEmployee()
+	return acuo
+}
+
+// Where appends a list predicates to the AttendanceCorrectionUpdate builder.
+func (acuo *AttendanceCorrectionUpdateOne) Where(ps ...predicate.AttendanceCorrection) *AttendanceCorrectionUpdateOne {
+	acuo.mutation.Where(ps...)
+	return acuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (acuo *AttendanceCorrectionUpdateOne) Select(field string, fields ...string) *AttendanceCorrectionUpdateOne {
+	acuo.fields = append([]string{field}, fields...)
+	return acuo
+}
+
+// Save executes the query and returns the updated AttendanceCorrection entity.
+func (acuo *AttendanceCorrectionUpdateOne) Save(ctx context.Context) (*AttendanceCorrection, error) {
+	acuo.defaults()
+	return withHooks(ctx, acuo.sqlSave, acuo.mutation, acuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acuo *AttendanceCorrectionUpdateOne) SaveX(ctx context.Context) *AttendanceCorrection {
+	node, err := acuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (acuo *AttendanceCorrectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := acuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acuo *AttendanceCorrectionUpdateOne) ExecX(ctx context.Context) {
+	if err := acuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acuo *AttendanceCorrectionUpdateOne) defaults() {
+	if _, ok := acuo.mutation.UpdatedAt(); !ok {
+		v := attendancecorrection.UpdateDefaultUpdatedAt()
+		acuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acuo *AttendanceCorrectionUpdateOne) check() error {
+	if v, ok := acuo.mutation.Status(); ok {
+		if err := attendancecorrection.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AttendanceCorrection.status": %w`, err)}
+		}
+	}
+	if acuo.mutation.EmployeeCleared() && len(acuo.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceCorrection.employee"`)
+	}
+	return nil
+}
+
+func (acuo *AttendanceCorrectionUpdateOne) sqlSave(ctx context.Context) (_node *AttendanceCorrection, err error) {
+	if err := acuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancecorrection.Table, attendancecorrection.Columns, sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt))
+	id, ok := acuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttendanceCorrection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := acuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancecorrection.FieldID)
+		for _, f := range fields {
+			if !attendancecorrection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attendancecorrection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := acuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acuo.mutation.OrgID(); ok {
+		_spec.SetField(attendancecorrection.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedOrgID(); ok {
+		_spec.AddField(attendancecorrection.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.WorkDate(); ok {
+		_spec.SetField(attendancecorrection.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := acuo.mutation.CheckInAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCheckInAt, field.TypeTime, value)
+	}
+	if acuo.mutation.CheckInAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldCheckInAt, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.CheckOutAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCheckOutAt, field.TypeTime, value)
+	}
+	if acuo.mutation.CheckOutAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldCheckOutAt, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.Reason(); ok {
+		_spec.SetField(attendancecorrection.FieldReason, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.Status(); ok {
+		_spec.SetField(attendancecorrection.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := acuo.mutation.ReviewerID(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedReviewerID(); ok {
+		_spec.AddField(attendancecorrection.FieldReviewerID, field.TypeInt, value)
+	}
+	if acuo.mutation.ReviewerIDCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := acuo.mutation.ReviewComment(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewComment, field.TypeString, value)
+	}
+	if acuo.mutation.ReviewCommentCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := acuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldReviewedAt, field.TypeTime, value)
+	}
+	if acuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(attendancecorrection.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := acuo.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := acuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(attendancecorrection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if acuo.mutation.AttendanceRecordCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceRecordTable,
+			Columns: []string{attendancecorrection.AttendanceRecordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.AttendanceRecordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.AttendanceRecordTable,
+			Columns: []string{attendancecorrection.AttendanceRecordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acuo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancecorrection.EmployeeTable,
+			Columns: []string{attendancecorrection.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AttendanceCorrection{config: acuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, acuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancecorrection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	acuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+)
+
+// AttendanceRecord is the model entity for the AttendanceRecord schema.
+type AttendanceRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// WorkDate holds the value of the "work_date" field.
+	WorkDate time.Time `json:"work_date"`
+	// CheckInAt holds the value of the "check_in_at" field.
+	CheckInAt time.Time `json:"check_in_at"`
+	// CheckOutAt holds the value of the "check_out_at" field.
+	CheckOutAt *time.Time `json:"check_out_at"`
+	// Source holds the value of the "source" field.
+	Source attendancerecord.Source `json:"source"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude *float64 `json:"latitude"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude"`
+	// Note holds the value of the "note" field.
+	Note *string `json:"note"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttendanceRecordQuery when eager-loading is set.
+	Edges        AttendanceRecordEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttendanceRecordEdges holds the relations/edges for other nodes in the graph.
+type AttendanceRecordEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee"`
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization"`
+	// Corrections holds the value of the corrections edge.
+	Corrections []*AttendanceCorrection `json:"corrections"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceRecordEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttendanceRecordEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// CorrectionsOrErr returns the Corrections value or an error if the edge
+// was not loaded in eager-loading.
+func (e AttendanceRecordEdges) CorrectionsOrErr() ([]*AttendanceCorrection, error) {
+	if e.loadedTypes[2] {
+		return e.Corrections, nil
+	}
+	return nil, &NotLoadedError{edge: "corrections"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttendanceRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attendancerecord.FieldLatitude, attendancerecord.FieldLongitude:
+			values[i] = new(sql.NullFloat64)
+		case attendancerecord.FieldID, attendancerecord.FieldEmployeeID, attendancerecord.FieldOrgID:
+			values[i] = new(sql.NullInt64)
+		case attendancerecord.FieldSource, attendancerecord.FieldNote:
+			values[i] = new(sql.NullString)
+		case attendancerecord.FieldWorkDate, attendancerecord.FieldCheckInAt, attendancerecord.FieldCheckOutAt, attendancerecord.FieldCreatedAt, attendancerecord.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttendanceRecord fields.
+func (ar *AttendanceRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attendancerecord.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ar.ID = int(value.Int64)
+		case attendancerecord.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				ar.EmployeeID = int(value.Int64)
+			}
+		case attendancerecord.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				ar.OrgID = int(value.Int64)
+			}
+		case attendancerecord.FieldWorkDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_date", values[i])
+			} else if value.Valid {
+				ar.WorkDate = value.Time
+			}
+		case attendancerecord.FieldCheckInAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field check_in_at", values[i])
+			} else if value.Valid {
+				ar.CheckInAt = value.Time
+			}
+		case attendancerecord.FieldCheckOutAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field check_out_at", values[i])
+			} else if value.Valid {
+				ar.CheckOutAt = new(time.Time)
+				*ar.CheckOutAt = value.Time
+			}
+		case attendancerecord.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				ar.Source = attendancerecord.Source(value.String)
+			}
+		case attendancerecord.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				ar.Latitude = new(float64)
+				*ar.Latitude = value.Float64
+			}
+		case attendancerecord.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				ar.Longitude = new(float64)
+				*ar.Longitude = value.Float64
+			}
+		case attendancerecord.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				ar.Note = new(string)
+				*ar.Note = value.String
+			}
+		case attendancerecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		case attendancerecord.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ar.UpdatedAt = value.Time
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttendanceRecord.
+// This includes values selected through modifiers, order, etc.
+func (ar *AttendanceRecord) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the AttendanceRecord entity.
+func (ar *AttendanceRecord) QueryEmployee() *EmployeeQuery {
+	return NewAttendanceRecordClient(ar.config).QueryEmployee(ar)
+}
+
+// QueryOrganization queries the "organization" edge of the AttendanceRecord entity.
+func (ar *AttendanceRecord) QueryOrganization() *OrganizationQuery {
+	return NewAttendanceRecordClient(ar.config).QueryOrganization(ar)
+}
+
+// QueryCorrections queries the "corrections" edge of the AttendanceRecord entity.
+func (ar *AttendanceRecord) QueryCorrections() *AttendanceCorrectionQuery {
+	return NewAttendanceRecordClient(ar.config).QueryCorrections(ar)
+}
+
+// Update returns a builder for updating this AttendanceRecord.
+// Note that you need to call AttendanceRecord.Unwrap() before calling this method if this AttendanceRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AttendanceRecord) Update() *AttendanceRecordUpdateOne {
+	return NewAttendanceRecordClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AttendanceRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AttendanceRecord) Unwrap() *AttendanceRecord {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttendanceRecord is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AttendanceRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttendanceRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("work_date=")
+	builder.WriteString(ar.WorkDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("check_in_at=")
+	builder.WriteString(ar.CheckInAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ar.CheckOutAt; v != nil {
+		builder.WriteString("check_out_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Source))
+	builder.WriteString(", ")
+	if v := ar.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ar.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ar.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ar.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttendanceRecords is a parsable slice of AttendanceRecord.
+type AttendanceRecords []*AttendanceRecord
@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attendancerecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldID, id))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldOrgID, v))
+}
+
+// WorkDate applies equality check predicate on the "work_date" field. It's identical to WorkDateEQ.
+func WorkDate(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldWorkDate, v))
+}
+
+// CheckInAt applies equality check predicate on the "check_in_at" field. It's identical to CheckInAtEQ.
+func CheckInAt(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldCheckInAt, v))
+}
+
+// CheckOutAt applies equality check predicate on the "check_out_at" field. It's identical to CheckOutAtEQ.
+func CheckOutAt(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldCheckOutAt, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldLongitude, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// WorkDateEQ applies the EQ predicate on the "work_date" field.
+func WorkDateEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldWorkDate, v))
+}
+
+// WorkDateNEQ applies the NEQ predicate on the "work_date" field.
+func WorkDateNEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldWorkDate, v))
+}
+
+// WorkDateIn applies the In predicate on the "work_date" field.
+func WorkDateIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldWorkDate, vs...))
+}
+
+// WorkDateNotIn applies the NotIn predicate on the "work_date" field.
+func WorkDateNotIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldWorkDate, vs...))
+}
+
+// WorkDateGT applies the GT predicate on the "work_date" field.
+func WorkDateGT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldWorkDate, v))
+}
+
+// WorkDateGTE applies the GTE predicate on the "work_date" field.
+func WorkDateGTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldWorkDate, v))
+}
+
+// WorkDateLT applies the LT predicate on the "work_date" field.
+func WorkDateLT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldWorkDate, v))
+}
+
+// WorkDateLTE applies the LTE predicate on the "work_date" field.
+func WorkDateLTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldWorkDate, v))
+}
+
+// CheckInAtEQ applies the EQ predicate on the "check_in_at" field.
+func CheckInAtEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldCheckInAt, v))
+}
+
+// CheckInAtNEQ applies the NEQ predicate on the "check_in_at" field.
+func CheckInAtNEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldCheckInAt, v))
+}
+
+// CheckInAtIn applies the In predicate on the "check_in_at" field.
+func CheckInAtIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldCheckInAt, vs...))
+}
+
+// CheckInAtNotIn applies the NotIn predicate on the "check_in_at" field.
+func CheckInAtNotIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldCheckInAt, vs...))
+}
+
+// CheckInAtGT applies the GT predicate on the "check_in_at" field.
+func CheckInAtGT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldCheckInAt, v))
+}
+
+// CheckInAtGTE applies the GTE predicate on the "check_in_at" field.
+func CheckInAtGTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldCheckInAt, v))
+}
+
+// CheckInAtLT applies the LT predicate on the "check_in_at" field.
+func CheckInAtLT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldCheckInAt, v))
+}
+
+// CheckInAtLTE applies the LTE predicate on the "check_in_at" field.
+func CheckInAtLTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldCheckInAt, v))
+}
+
+// CheckOutAtEQ applies the EQ predicate on the "check_out_at" field.
+func CheckOutAtEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldCheckOutAt, v))
+}
+
+// CheckOutAtNEQ applies the NEQ predicate on the "check_out_at" field.
+func CheckOutAtNEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldCheckOutAt, v))
+}
+
+// CheckOutAtIn applies the In predicate on the "check_out_at" field.
+func CheckOutAtIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldCheckOutAt, vs...))
+}
+
+// CheckOutAtNotIn applies the NotIn predicate on the "check_out_at" field.
+func CheckOutAtNotIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldCheckOutAt, vs...))
+}
+
+// CheckOutAtGT applies the GT predicate on the "check_out_at" field.
+func CheckOutAtGT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldCheckOutAt, v))
+}
+
+// CheckOutAtGTE applies the GTE predicate on the "check_out_at" field.
+func CheckOutAtGTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldCheckOutAt, v))
+}
+
+// CheckOutAtLT applies the LT predicate on the "check_out_at" field.
+func CheckOutAtLT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldCheckOutAt, v))
+}
+
+// CheckOutAtLTE applies the LTE predicate on the "check_out_at" field.
+func CheckOutAtLTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldCheckOutAt, v))
+}
+
+// CheckOutAtIsNil applies the IsNil predicate on the "check_out_at" field.
+func CheckOutAtIsNil() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIsNull(FieldCheckOutAt))
+}
+
+// CheckOutAtNotNil applies the NotNil predicate on the "check_out_at" field.
+func CheckOutAtNotNil() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotNull(FieldCheckOutAt))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotNull(FieldLongitude))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCorrections applies the HasEdge predicate on the "corrections" edge.
+func HasCorrections() predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CorrectionsTable, CorrectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCorrectionsWith applies the HasEdge predicate on the "corrections" edge with a given conditions (other predicates).
+func HasCorrectionsWith(preds ...predicate.AttendanceCorrection) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(func(s *sql.Selector) {
+		step := newCorrectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttendanceRecord) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttendanceRecord) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttendanceRecord) predicate.AttendanceRecord {
+	return predicate.AttendanceRecord(sql.NotPredicates(p))
+}
@@ -0,0 +1,1254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+)
+
+// AttendanceRecordCreate is the builder for creating a AttendanceRecord entity.
+type AttendanceRecordCreate struct {
+	config
+	mutation *AttendanceRecordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (arc *AttendanceRecordCreate) SetEmployeeID(i int) *AttendanceRecordCreate {
+	arc.mutation.SetEmployeeID(i)
+	return arc
+}
+
+// SetOrgID sets the "org_id" field.
+func (arc *AttendanceRecordCreate) SetOrgID(i int) *AttendanceRecordCreate {
+	arc.mutation.SetOrgID(i)
+	return arc
+}
+
+// SetWorkDate sets the "work_date" field.
+func (arc *AttendanceRecordCreate) SetWorkDate(t time.Time) *AttendanceRecordCreate {
+	arc.mutation.SetWorkDate(t)
+	return arc
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (arc *AttendanceRecordCreate) SetCheckInAt(t time.Time) *AttendanceRecordCreate {
+	arc.mutation.SetCheckInAt(t)
+	return arc
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (arc *AttendanceRecordCreate) SetCheckOutAt(t time.Time) *AttendanceRecordCreate {
+	arc.mutation.SetCheckOutAt(t)
+	return arc
+}
+
+// SetNillableCheckOutAt sets the "check_out_at" field if the given value is not nil.
+func (arc *AttendanceRecordCreate) SetNillableCheckOutAt(t *time.Time) *AttendanceRecordCreate {
+	if t != nil {
+		arc.SetCheckOutAt(*t)
+	}
+	return arc
+}
+
+// SetSource sets the "source" field.
+func (arc *AttendanceRecordCreate) SetSource(a attendancerecord.Source) *AttendanceRecordCreate {
+	arc.mutation.SetSource(a)
+	return arc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (arc *AttendanceRecordCreate) SetNillableSource(a *attendancerecord.Source) *AttendanceRecordCreate {
+	if a != nil {
+		arc.SetSource(*a)
+	}
+	return arc
+}
+
+// SetLatitude sets the "latitude" field.
+func (arc *AttendanceRecordCreate) SetLatitude(f float64) *AttendanceRecordCreate {
+	arc.mutation.SetLatitude(f)
+	return arc
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (arc *AttendanceRecordCreate) SetNillableLatitude(f *float64) *AttendanceRecordCreate {
+	if f != nil {
+		arc.SetLatitude(*f)
+	}
+	return arc
+}
+
+// SetLongitude sets the "longitude" field.
+func (arc *AttendanceRecordCreate) SetLongitude(f float64) *AttendanceRecordCreate {
+	arc.mutation.SetLongitude(f)
+	return arc
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (arc *AttendanceRecordCreate) SetNillableLongitude(f *float64) *AttendanceRecordCreate {
+	if f != nil {
+		arc.SetLongitude(*f)
+	}
+	return arc
+}
+
+// SetNote sets the "note" field.
+func (arc *AttendanceRecordCreate) SetNote(s string) *AttendanceRecordCreate {
+	arc.mutation.SetNote(s)
+	return arc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (arc *AttendanceRecordCreate) SetNillableNote(s *string) *AttendanceRecordCreate {
+	if s != nil {
+		arc.SetNote(*s)
+	}
+	return arc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *AttendanceRecordCreate) SetCreatedAt(t time.Time) *AttendanceRecordCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *AttendanceRecordCreate) SetNillableCreatedAt(t *time.Time) *AttendanceRecordCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (arc *AttendanceRecordCreate) SetUpdatedAt(t time.Time) *AttendanceRecordCreate {
+	arc.mutation.SetUpdatedAt(t)
+	return arc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (arc *AttendanceRecordCreate) SetNillableUpdatedAt(t *time.Time) *AttendanceRecordCreate {
+	if t != nil {
+		arc.SetUpdatedAt(*t)
+	}
+	return arc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (arc *AttendanceRecordCreate) SetEmployee(e *Employee) *AttendanceRecordCreate {
+	return arc.SetEmployeeID(e.ID)
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (arc *AttendanceRecordCreate) SetOrganizationID(id int) *AttendanceRecordCreate {
+	arc.mutation.SetOrganizationID(id)
+	return arc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (arc *AttendanceRecordCreate) SetOrganization(o *Organization) *AttendanceRecordCreate {
+	return arc.SetOrganizationID(o.ID)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the AttendanceCorrection entity by IDs.
+func (arc *AttendanceRecordCreate) AddCorrectionIDs(ids ...int) *AttendanceRecordCreate {
+	arc.mutation.AddCorrectionIDs(ids...)
+	return arc
+}
+
+// AddCorrections adds the "corrections" edges to the AttendanceCorrection entity.
+func (arc *AttendanceRecordCreate) AddCorrections(a ...*AttendanceCorrection) *AttendanceRecordCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return arc.AddCorrectionIDs(ids...)
+}
+
+// Mutation returns the AttendanceRecordMutation object of the builder.
+func (arc *AttendanceRecordCreate) Mutation() *AttendanceRecordMutation {
+	return arc.mutation
+}
+
+// Save creates the AttendanceRecord in the database.
+func (arc *AttendanceRecordCreate) Save(ctx context.Context) (*AttendanceRecord, error) {
+	arc.defaults()
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AttendanceRecordCreate) SaveX(ctx context.Context) *AttendanceRecord {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AttendanceRecordCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AttendanceRecordCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *AttendanceRecordCreate) defaults() {
+	if _, ok := arc.mutation.Source(); !ok {
+		v := attendancerecord.DefaultSource
+		arc.mutation.SetSource(v)
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := attendancerecord.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		v := attendancerecord.DefaultUpdatedAt()
+		arc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AttendanceRecordCreate) check() error {
+	if _, ok := arc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "AttendanceRecord.employee_id"`)}
+	}
+	if _, ok := arc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "AttendanceRecord.org_id"`)}
+	}
+	if _, ok := arc.mutation.WorkDate(); !ok {
+		return &ValidationError{Name: "work_date", err: errors.New(`ent: missing required field "AttendanceRecord.work_date"`)}
+	}
+	if _, ok := arc.mutation.CheckInAt(); !ok {
+		return &ValidationError{Name: "check_in_at", err: errors.New(`ent: missing required field "AttendanceRecord.check_in_at"`)}
+	}
+	if _, ok := arc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "AttendanceRecord.source"`)}
+	}
+	if v, ok := arc.mutation.Source(); ok {
+		if err := attendancerecord.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "AttendanceRecord.source": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttendanceRecord.created_at"`)}
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AttendanceRecord.updated_at"`)}
+	}
+	if len(arc.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "AttendanceRecord.employee"`)}
+	}
+	if len(arc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "AttendanceRecord.organization"`)}
+	}
+	return nil
+}
+
+func (arc *AttendanceRecordCreate) sqlSave(ctx context.Context) (*AttendanceRecord, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *AttendanceRecordCreate) createSpec() (*AttendanceRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttendanceRecord{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(attendancerecord.Table, sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = arc.conflict
+	if value, ok := arc.mutation.WorkDate(); ok {
+		_spec.SetField(attendancerecord.FieldWorkDate, field.TypeTime, value)
+		_node.WorkDate = value
+	}
+	if value, ok := arc.mutation.CheckInAt(); ok {
+		_spec.SetField(attendancerecord.FieldCheckInAt, field.TypeTime, value)
+		_node.CheckInAt = value
+	}
+	if value, ok := arc.mutation.CheckOutAt(); ok {
+		_spec.SetField(attendancerecord.FieldCheckOutAt, field.TypeTime, value)
+		_node.CheckOutAt = &value
+	}
+	if value, ok := arc.mutation.Source(); ok {
+		_spec.SetField(attendancerecord.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := arc.mutation.Latitude(); ok {
+		_spec.SetField(attendancerecord.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := arc.mutation.Longitude(); ok {
+		_spec.SetField(attendancerecord.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
+	if value, ok := arc.mutation.Note(); ok {
+		_spec.SetField(attendancerecord.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancerecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := arc.mutation.UpdatedAt(); ok {
+		_spec.SetField(attendancerecord.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := arc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.EmployeeTable,
+			Columns: []string{attendancerecord.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := arc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.OrganizationTable,
+			Columns: []string{attendancerecord.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := arc.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancerecord.CorrectionsTable,
+			Columns: []string{attendancerecord.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttendanceRecord.Create().
+//		SetEmployeeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttendanceRecordUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (arc *AttendanceRecordCreate) OnConflict(opts ...sql.ConflictOption) *AttendanceRecordUpsertOne {
+	arc.conflict = opts
+	return &AttendanceRecordUpsertOne{
+		create: arc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttendanceRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arc *AttendanceRecordCreate) OnConflictColumns(columns ...string) *AttendanceRecordUpsertOne {
+	arc.conflict = append(arc.conflict, sql.ConflictColumns(columns...))
+	return &AttendanceRecordUpsertOne{
+		create: arc,
+	}
+}
+
+type (
+	// AttendanceRecordUpsertOne is the builder for "upsert"-ing
+	//  one AttendanceRecord node.
+	AttendanceRecordUpsertOne struct {
+		create *AttendanceRecordCreate
+	}
+
+	// AttendanceRecordUpsert is the "OnConflict" setter.
+	AttendanceRecordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *AttendanceRecordUpsert) SetEmployeeID(v int) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateEmployeeID() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldEmployeeID)
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AttendanceRecordUpsert) SetOrgID(v int) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateOrgID() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldOrgID)
+	return u
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *AttendanceRecordUpsert) SetWorkDate(v time.Time) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldWorkDate, v)
+	return u
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateWorkDate() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldWorkDate)
+	return u
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (u *AttendanceRecordUpsert) SetCheckInAt(v time.Time) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldCheckInAt, v)
+	return u
+}
+
+// UpdateCheckInAt sets the "check_in_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateCheckInAt() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldCheckInAt)
+	return u
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (u *AttendanceRecordUpsert) SetCheckOutAt(v time.Time) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldCheckOutAt, v)
+	return u
+}
+
+// UpdateCheckOutAt sets the "check_out_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateCheckOutAt() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldCheckOutAt)
+	return u
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (u *AttendanceRecordUpsert) ClearCheckOutAt() *AttendanceRecordUpsert {
+	u.SetNull(attendancerecord.FieldCheckOutAt)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *AttendanceRecordUpsert) SetSource(v attendancerecord.Source) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateSource() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldSource)
+	return u
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *AttendanceRecordUpsert) SetLatitude(v float64) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldLatitude, v)
+	return u
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateLatitude() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldLatitude)
+	return u
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *AttendanceRecordUpsert) AddLatitude(v float64) *AttendanceRecordUpsert {
+	u.Add(attendancerecord.FieldLatitude, v)
+	return u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *AttendanceRecordUpsert) ClearLatitude() *AttendanceRecordUpsert {
+	u.SetNull(attendancerecord.FieldLatitude)
+	return u
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *AttendanceRecordUpsert) SetLongitude(v float64) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldLongitude, v)
+	return u
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateLongitude() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldLongitude)
+	return u
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *AttendanceRecordUpsert) AddLongitude(v float64) *AttendanceRecordUpsert {
+	u.Add(attendancerecord.FieldLongitude, v)
+	return u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *AttendanceRecordUpsert) ClearLongitude() *AttendanceRecordUpsert {
+	u.SetNull(attendancerecord.FieldLongitude)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *AttendanceRecordUpsert) SetNote(v string) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateNote() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *AttendanceRecordUpsert) ClearNote() *AttendanceRecordUpsert {
+	u.SetNull(attendancerecord.FieldNote)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttendanceRecordUpsert) SetCreatedAt(v time.Time) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateCreatedAt() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AttendanceRecordUpsert) SetUpdatedAt(v time.Time) *AttendanceRecordUpsert {
+	u.Set(attendancerecord.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsert) UpdateUpdatedAt() *AttendanceRecordUpsert {
+	u.SetExcluded(attendancerecord.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AttendanceRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AttendanceRecordUpsertOne) UpdateNewValues() *AttendanceRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttendanceRecord.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AttendanceRecordUpsertOne) Ignore() *AttendanceRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttendanceRecordUpsertOne) DoNothing() *AttendanceRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttendanceRecordCreate.OnConflict
+// documentation for more info.
+func (u *AttendanceRecordUpsertOne) Update(set func(*AttendanceRecordUpsert)) *AttendanceRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttendanceRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *AttendanceRecordUpsertOne) SetEmployeeID(v int) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateEmployeeID() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AttendanceRecordUpsertOne) SetOrgID(v int) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateOrgID() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *AttendanceRecordUpsertOne) SetWorkDate(v time.Time) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetWorkDate(v)
+	})
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateWorkDate() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateWorkDate()
+	})
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (u *AttendanceRecordUpsertOne) SetCheckInAt(v time.Time) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetCheckInAt(v)
+	})
+}
+
+// UpdateCheckInAt sets the "check_in_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateCheckInAt() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateCheckInAt()
+	})
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (u *AttendanceRecordUpsertOne) SetCheckOutAt(v time.Time) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetCheckOutAt(v)
+	})
+}
+
+// UpdateCheckOutAt sets the "check_out_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateCheckOutAt() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateCheckOutAt()
+	})
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (u *AttendanceRecordUpsertOne) ClearCheckOutAt() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.ClearCheckOutAt()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *AttendanceRecordUpsertOne) SetSource(v attendancerecord.Source) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateSource() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *AttendanceRecordUpsertOne) SetLatitude(v float64) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *AttendanceRecordUpsertOne) AddLatitude(v float64) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.AddLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateLatitude() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *AttendanceRecordUpsertOne) ClearLatitude() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.ClearLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *AttendanceRecordUpsertOne) SetLongitude(v float64) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *AttendanceRecordUpsertOne) AddLongitude(v float64) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.AddLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateLongitude() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *AttendanceRecordUpsertOne) ClearLongitude() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.ClearLongitude()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *AttendanceRecordUpsertOne) SetNote(v string) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateNote() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *AttendanceRecordUpsertOne) ClearNote() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttendanceRecordUpsertOne) SetCreatedAt(v time.Time) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateCreatedAt() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AttendanceRecordUpsertOne) SetUpdatedAt(v time.Time) *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertOne) UpdateUpdatedAt() *AttendanceRecordUpsertOne {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AttendanceRecordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttendanceRecordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttendanceRecordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AttendanceRecordUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AttendanceRecordUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AttendanceRecordCreateBulk is the builder for creating many AttendanceRecord entities in bulk.
+type AttendanceRecordCreateBulk struct {
+	config
+	err      error
+	builders []*AttendanceRecordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AttendanceRecord entities in the database.
+func (arcb *AttendanceRecordCreateBulk) Save(ctx context.Context) ([]*AttendanceRecord, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AttendanceRecord, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttendanceRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = arcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AttendanceRecordCreateBulk) SaveX(ctx context.Context) []*AttendanceRecord {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AttendanceRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AttendanceRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttendanceRecord.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttendanceRecordUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (arcb *AttendanceRecordCreateBulk) OnConflict(opts ...sql.ConflictOption) *AttendanceRecordUpsertBulk {
+	arcb.conflict = opts
+	return &AttendanceRecordUpsertBulk{
+		create: arcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttendanceRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arcb *AttendanceRecordCreateBulk) OnConflictColumns(columns ...string) *AttendanceRecordUpsertBulk {
+	arcb.conflict = append(arcb.conflict, sql.ConflictColumns(columns...))
+	return &AttendanceRecordUpsertBulk{
+		create: arcb,
+	}
+}
+
+// AttendanceRecordUpsertBulk is the builder for "upsert"-ing
+// a bulk of AttendanceRecord nodes.
+type AttendanceRecordUpsertBulk struct {
+	create *AttendanceRecordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AttendanceRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AttendanceRecordUpsertBulk) UpdateNewValues() *AttendanceRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttendanceRecord.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AttendanceRecordUpsertBulk) Ignore() *AttendanceRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttendanceRecordUpsertBulk) DoNothing() *AttendanceRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttendanceRecordCreateBulk.OnConflict
+// documentation for more info.
+func (u *AttendanceRecordUpsertBulk) Update(set func(*AttendanceRecordUpsert)) *AttendanceRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttendanceRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *AttendanceRecordUpsertBulk) SetEmployeeID(v int) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateEmployeeID() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AttendanceRecordUpsertBulk) SetOrgID(v int) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateOrgID() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *AttendanceRecordUpsertBulk) SetWorkDate(v time.Time) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetWorkDate(v)
+	})
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateWorkDate() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateWorkDate()
+	})
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (u *AttendanceRecordUpsertBulk) SetCheckInAt(v time.Time) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetCheckInAt(v)
+	})
+}
+
+// UpdateCheckInAt sets the "check_in_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateCheckInAt() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateCheckInAt()
+	})
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (u *AttendanceRecordUpsertBulk) SetCheckOutAt(v time.Time) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetCheckOutAt(v)
+	})
+}
+
+// UpdateCheckOutAt sets the "check_out_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateCheckOutAt() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateCheckOutAt()
+	})
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (u *AttendanceRecordUpsertBulk) ClearCheckOutAt() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.ClearCheckOutAt()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *AttendanceRecordUpsertBulk) SetSource(v attendancerecord.Source) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateSource() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *AttendanceRecordUpsertBulk) SetLatitude(v float64) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *AttendanceRecordUpsertBulk) AddLatitude(v float64) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.AddLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateLatitude() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *AttendanceRecordUpsertBulk) ClearLatitude() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.ClearLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *AttendanceRecordUpsertBulk) SetLongitude(v float64) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *AttendanceRecordUpsertBulk) AddLongitude(v float64) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.AddLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateLongitude() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *AttendanceRecordUpsertBulk) ClearLongitude() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.ClearLongitude()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *AttendanceRecordUpsertBulk) SetNote(v string) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateNote() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *AttendanceRecordUpsertBulk) ClearNote() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AttendanceRecordUpsertBulk) SetCreatedAt(v time.Time) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateCreatedAt() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AttendanceRecordUpsertBulk) SetUpdatedAt(v time.Time) *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AttendanceRecordUpsertBulk) UpdateUpdatedAt() *AttendanceRecordUpsertBulk {
+	return u.Update(func(s *AttendanceRecordUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AttendanceRecordUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AttendanceRecordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttendanceRecordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttendanceRecordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
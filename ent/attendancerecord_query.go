@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// AttendanceRecordQuery is the builder for querying AttendanceRecord entities.
+type AttendanceRecordQuery struct {
+	config
+	ctx              *QueryContext
+	order            []attendancerecord.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.AttendanceRecord
+	withEmployee     *EmployeeQuery
+	withOrganization *OrganizationQuery
+	withCorrections  *AttendanceCorrectionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttendanceRecordQuery builder.
+func (arq *AttendanceRecordQuery) Where(ps ...predicate.AttendanceRecord) *AttendanceRecordQuery {
+	arq.predicates = append(arq.predicates, ps...)
+	return arq
+}
+
+// Limit the number of records to be returned by this query.
+func (arq *AttendanceRecordQuery) Limit(limit int) *AttendanceRecordQuery {
+	arq.ctx.Limit = &limit
+	return arq
+}
+
+// Offset to start from.
+func (arq *AttendanceRecordQuery) Offset(offset int) *AttendanceRecordQuery {
+	arq.ctx.Offset = &offset
+	return arq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (arq *AttendanceRecordQuery) Unique(unique bool) *AttendanceRecordQuery {
+	arq.ctx.Unique = &unique
+	return arq
+}
+
+// Order specifies how the records should be ordered.
+func (arq *AttendanceRecordQuery) Order(o ...attendancerecord.OrderOption) *AttendanceRecordQuery {
+	arq.order = append(arq.order, o...)
+	return arq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (arq *AttendanceRecordQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: arq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := arq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := arq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancerecord.Table, attendancerecord.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancerecord.EmployeeTable, attendancerecord.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(arq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrganization chains the current query on the "organization" edge.
+func (arq *AttendanceRecordQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: arq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := arq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := arq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancerecord.Table, attendancerecord.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancerecord.OrganizationTable, attendancerecord.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(arq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCorrections chains the current query on the "corrections" edge.
+func (arq *AttendanceRecordQuery) QueryCorrections() *AttendanceCorrectionQuery {
+	query := (&AttendanceCorrectionClient{config: arq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := arq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := arq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancerecord.Table, attendancerecord.FieldID, selector),
+			sqlgraph.To(attendancecorrection.Table, attendancecorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendancerecord.CorrectionsTable, attendancerecord.CorrectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(arq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AttendanceRecord entity from the query.
+// Returns a *NotFoundError when no AttendanceRecord was found.
+func (arq *AttendanceRecordQuery) First(ctx context.Context) (*AttendanceRecord, error) {
+	nodes, err := arq.Limit(1).All(setContextOp(ctx, arq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attendancerecord.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (arq *AttendanceRecordQuery) FirstX(ctx context.Context) *AttendanceRecord {
+	node, err := arq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttendanceRecord ID from the query.
+// Returns a *NotFoundError when no AttendanceRecord ID was found.
+func (arq *AttendanceRecordQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = arq.Limit(1).IDs(setContextOp(ctx, arq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attendancerecord.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (arq *AttendanceRecordQuery) FirstIDX(ctx context.Context) int {
+	id, err := arq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttendanceRecord entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttendanceRecord entity is found.
+// Returns a *NotFoundError when no AttendanceRecord entities are found.
+func (arq *AttendanceRecordQuery) Only(ctx context.Context) (*AttendanceRecord, error) {
+	nodes, err := arq.Limit(2).All(setContextOp(ctx, arq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attendancerecord.Label}
+	default:
+		return nil, &NotSingularError{attendancerecord.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (arq *AttendanceRecordQuery) OnlyX(ctx context.Context) *AttendanceRecord {
+	node, err := arq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttendanceRecord ID in the query.
+// Returns a *NotSingularError when more than one AttendanceRecord ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (arq *AttendanceRecordQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = arq.Limit(2).IDs(setContextOp(ctx, arq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attendancerecord.Label}
+	default:
+		err = &NotSingularError{attendancerecord.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (arq *AttendanceRecordQuery) OnlyIDX(ctx context.Context) int {
+	id, err := arq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttendanceRecords.
+func (arq *AttendanceRecordQuery) All(ctx context.Context) ([]*AttendanceRecord, error) {
+	ctx = setContextOp(ctx, arq.ctx, ent.OpQueryAll)
+	if err := arq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AttendanceRecord, *AttendanceRecordQuery]()
+	return withInterceptors[[]*AttendanceRecord](ctx, arq, qr, arq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (arq *AttendanceRecordQuery) AllX(ctx context.Context) []*AttendanceRecord {
+	nodes, err := arq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttendanceRecord IDs.
+func (arq *AttendanceRecordQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if arq.ctx.Unique == nil && arq.path != nil {
+		arq.Unique(true)
+	}
+	ctx = setContextOp(ctx, arq.ctx, ent.OpQueryIDs)
+	if err = arq.Select(attendancerecord.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (arq *AttendanceRecordQuery) IDsX(ctx context.Context) []int {
+	ids, err := arq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (arq *AttendanceRecordQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, arq.ctx, ent.OpQueryCount)
+	if err := arq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, arq, querierCount[*AttendanceRecordQuery](), arq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (arq *AttendanceRecordQuery) CountX(ctx context.Context) int {
+	count, err := arq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (arq *AttendanceRecordQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, arq.ctx, ent.OpQueryExist)
+	switch _, err := arq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (arq *AttendanceRecordQuery) ExistX(ctx context.Context) bool {
+	exist, err := arq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttendanceRecordQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (arq *AttendanceRecordQuery) Clone() *AttendanceRecordQuery {
+	if arq == nil {
+		return nil
+	}
+	return &AttendanceRecordQuery{
+		config:           arq.config,
+		ctx:              arq.ctx.Clone(),
+		order:            append([]attendancerecord.OrderOption{}, arq.order...),
+		inters:           append([]Interceptor{}, arq.inters...),
+		predicates:       append([]predicate.AttendanceRecord{}, arq.predicates...),
+		withEmployee:     arq.withEmployee.Clone(),
+		withOrganization: arq.withOrganization.Clone(),
+		withCorrections:  arq.withCorrections.Clone(),
+		// clone intermediate query.
+		sql:  arq.sql.Clone(),
+		path: arq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (arq *AttendanceRecordQuery) WithEmployee(opts ...func(*EmployeeQuery)) *AttendanceRecordQuery {
+	query := (&EmployeeClient{config: arq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	arq.withEmployee = query
+	return arq
+}
+
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (arq *AttendanceRecordQuery) WithOrganization(opts ...func(*OrganizationQuery)) *AttendanceRecordQuery {
+	query := (&OrganizationClient{config: arq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	arq.withOrganization = query
+	return arq
+}
+
+// WithCorrections tells the query-builder to eager-load the nodes that are connected to
+// the "corrections" edge. The optional arguments are used to configure the query builder of the edge.
+func (arq *AttendanceRecordQuery) WithCorrections(opts ...func(*AttendanceCorrectionQuery)) *AttendanceRecordQuery {
+	query := (&AttendanceCorrectionClient{config: arq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	arq.withCorrections = query
+	return arq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID int `json:"employee_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttendanceRecord.Query().
+//		GroupBy(attendancerecord.FieldEmployeeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (arq *AttendanceRecordQuery) GroupBy(field string, fields ...string) *AttendanceRecordGroupBy {
+	arq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AttendanceRecordGroupBy{build: arq}
+	grbuild.flds = &arq.ctx.Fields
+	grbuild.label = attendancerecord.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID int `json:"employee_id"`
+//	}
+//
+//	client.AttendanceRecord.Query().
+//		Select(attendancerecord.FieldEmployeeID).
+//		Scan(ctx, &v)
+func (arq *AttendanceRecordQuery) Select(fields ...string) *AttendanceRecordSelect {
+	arq.ctx.Fields = append(arq.ctx.Fields, fields...)
+	sbuild := &AttendanceRecordSelect{AttendanceRecordQuery: arq}
+	sbuild.label = attendancerecord.Label
+	sbuild.flds, sbuild.scan = &arq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AttendanceRecordSelect configured with the given aggregations.
+func (arq *AttendanceRecordQuery) Aggregate(fns ...AggregateFunc) *AttendanceRecordSelect {
+	return arq.Select().Aggregate(fns...)
+}
+
+func (arq *AttendanceRecordQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range arq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, arq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range arq.ctx.Fields {
+		if !attendancerecord.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if arq.path != nil {
+		prev, err := arq.path(ctx)
+		if err != nil {
+			return err
+		}
+		arq.sql = prev
+	}
+	return nil
+}
+
+func (arq *AttendanceRecordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttendanceRecord, error) {
+	var (
+		nodes       = []*AttendanceRecord{}
+		_spec       = arq.querySpec()
+		loadedTypes = [3]bool{
+			arq.withEmployee != nil,
+			arq.withOrganization != nil,
+			arq.withCorrections != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AttendanceRecord).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AttendanceRecord{config: arq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, arq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := arq.withEmployee; query != nil {
+		if err := arq.loadEmployee(ctx, query, nodes, nil,
+			func(n *AttendanceRecord, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := arq.withOrganization; query != nil {
+		if err := arq.loadOrganization(ctx, query, nodes, nil,
+			func(n *AttendanceRecord, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := arq.withCorrections; query != nil {
+		if err := arq.loadCorrections(ctx, query, nodes,
+			func(n *AttendanceRecord) { n.Edges.Corrections = []*AttendanceCorrection{} },
+			func(n *AttendanceRecord, e *AttendanceCorrection) {
+				n.Edges.Corrections = append(n.Edges.Corrections, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (arq *AttendanceRecordQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*AttendanceRecord, init func(*AttendanceRecord), assign func(*AttendanceRecord, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AttendanceRecord)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (arq *AttendanceRecordQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*AttendanceRecord, init func(*AttendanceRecord), assign func(*AttendanceRecord, *Organization)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AttendanceRecord)
+	for i := range nodes {
+		fk := nodes[i].OrgID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "org_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (arq *AttendanceRecordQuery) loadCorrections(ctx context.Context, query *AttendanceCorrectionQuery, nodes []*AttendanceRecord, init func(*AttendanceRecord), assign func(*AttendanceRecord, *AttendanceCorrection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AttendanceRecord)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendancecorrection.FieldAttendanceRecordID)
+	}
+	query.Where(predicate.AttendanceCorrection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(attendancerecord.CorrectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AttendanceRecordID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attendance_record_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attendance_record_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (arq *AttendanceRecordQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := arq.querySpec()
+	_spec.Node.Columns = arq.ctx.Fields
+	if len(arq.ctx.Fields) > 0 {
+		_spec.Unique = arq.ctx.Unique != nil && *arq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, arq.driver, _spec)
+}
+
+func (arq *AttendanceRecordQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(attendancerecord.Table, attendancerecord.Columns, sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt))
+	_spec.From = arq.sql
+	if unique := arq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if arq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := arq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancerecord.FieldID)
+		for i := range fields {
+			if fields[i] != attendancerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if arq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(attendancerecord.FieldEmployeeID)
+		}
+		if arq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(attendancerecord.FieldOrgID)
+		}
+	}
+	if ps := arq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := arq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := arq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := arq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (arq *AttendanceRecordQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(arq.driver.Dialect())
+	t1 := builder.Table(attendancerecord.Table)
+	columns := arq.ctx.Fields
+	if len(columns) == 0 {
+		columns = attendancerecord.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if arq.sql != nil {
+		selector = arq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if arq.ctx.Unique != nil && *arq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range arq.predicates {
+		p(selector)
+	}
+	for _, p := range arq.order {
+		p(selector)
+	}
+	if offset := arq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := arq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AttendanceRecordGroupBy is the group-by builder for AttendanceRecord entities.
+type AttendanceRecordGroupBy struct {
+	selector
+	build *AttendanceRecordQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (argb *AttendanceRecordGroupBy) Aggregate(fns ...AggregateFunc) *AttendanceRecordGroupBy {
+	argb.fns = append(argb.fns, fns...)
+	return argb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (argb *AttendanceRecordGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, argb.build.ctx, ent.OpQueryGroupBy)
+	if err := argb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendanceRecordQuery, *AttendanceRecordGroupBy](ctx, argb.build, argb, argb.build.inters, v)
+}
+
+func (argb *AttendanceRecordGroupBy) sqlScan(ctx context.Context, root *AttendanceRecordQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(argb.fns))
+	for _, fn := range argb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*argb.flds)+len(argb.fns))
+		for _, f := range *argb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*argb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := argb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AttendanceRecordSelect is the builder for selecting fields of AttendanceRecord entities.
+type AttendanceRecordSelect struct {
+	*AttendanceRecordQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ars *AttendanceRecordSelect) Aggregate(fns ...AggregateFunc) *AttendanceRecordSelect {
+	ars.fns = append(ars.fns, fns...)
+	return ars
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ars *AttendanceRecordSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ars.ctx, ent.OpQuerySelect)
+	if err := ars.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttendanceRecordQuery, *AttendanceRecordSelect](ctx, ars.AttendanceRecordQuery, ars, ars.inters, v)
+}
+
+func (ars *AttendanceRecordSelect) sqlScan(ctx context.Context, root *AttendanceRecordQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ars.fns))
+	for _, fn := range ars.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ars.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ars.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1027 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// AttendanceRecordUpdate is the builder for updating AttendanceRecord entities.
+type AttendanceRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttendanceRecordMutation
+}
+
+// Where appends a list predicates to the AttendanceRecordUpdate builder.
+func (aru *AttendanceRecordUpdate) Where(ps ...predicate.AttendanceRecord) *AttendanceRecordUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (aru *AttendanceRecordUpdate) SetEmployeeID(i int) *AttendanceRecordUpdate {
+	aru.mutation.SetEmployeeID(i)
+	return aru
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableEmployeeID(i *int) *AttendanceRecordUpdate {
+	if i != nil {
+		aru.SetEmployeeID(*i)
+	}
+	return aru
+}
+
+// SetOrgID sets the "org_id" field.
+func (aru *AttendanceRecordUpdate) SetOrgID(i int) *AttendanceRecordUpdate {
+	aru.mutation.SetOrgID(i)
+	return aru
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableOrgID(i *int) *AttendanceRecordUpdate {
+	if i != nil {
+		aru.SetOrgID(*i)
+	}
+	return aru
+}
+
+// SetWorkDate sets the "work_date" field.
+func (aru *AttendanceRecordUpdate) SetWorkDate(t time.Time) *AttendanceRecordUpdate {
+	aru.mutation.SetWorkDate(t)
+	return aru
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableWorkDate(t *time.Time) *AttendanceRecordUpdate {
+	if t != nil {
+		aru.SetWorkDate(*t)
+	}
+	return aru
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (aru *AttendanceRecordUpdate) SetCheckInAt(t time.Time) *AttendanceRecordUpdate {
+	aru.mutation.SetCheckInAt(t)
+	return aru
+}
+
+// SetNillableCheckInAt sets the "check_in_at" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableCheckInAt(t *time.Time) *AttendanceRecordUpdate {
+	if t != nil {
+		aru.SetCheckInAt(*t)
+	}
+	return aru
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (aru *AttendanceRecordUpdate) SetCheckOutAt(t time.Time) *AttendanceRecordUpdate {
+	aru.mutation.SetCheckOutAt(t)
+	return aru
+}
+
+// SetNillableCheckOutAt sets the "check_out_at" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableCheckOutAt(t *time.Time) *AttendanceRecordUpdate {
+	if t != nil {
+		aru.SetCheckOutAt(*t)
+	}
+	return aru
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (aru *AttendanceRecordUpdate) ClearCheckOutAt() *AttendanceRecordUpdate {
+	aru.mutation.ClearCheckOutAt()
+	return aru
+}
+
+// SetSource sets the "source" field.
+func (aru *AttendanceRecordUpdate) SetSource(a attendancerecord.Source) *AttendanceRecordUpdate {
+	aru.mutation.SetSource(a)
+	return aru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableSource(a *attendancerecord.Source) *AttendanceRecordUpdate {
+	if a != nil {
+		aru.SetSource(*a)
+	}
+	return aru
+}
+
+// SetLatitude sets the "latitude" field.
+func (aru *AttendanceRecordUpdate) SetLatitude(f float64) *AttendanceRecordUpdate {
+	aru.mutation.ResetLatitude()
+	aru.mutation.SetLatitude(f)
+	return aru
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableLatitude(f *float64) *AttendanceRecordUpdate {
+	if f != nil {
+		aru.SetLatitude(*f)
+	}
+	return aru
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (aru *AttendanceRecordUpdate) AddLatitude(f float64) *AttendanceRecordUpdate {
+	aru.mutation.AddLatitude(f)
+	return aru
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (aru *AttendanceRecordUpdate) ClearLatitude() *AttendanceRecordUpdate {
+	aru.mutation.ClearLatitude()
+	return aru
+}
+
+// SetLongitude sets the "longitude" field.
+func (aru *AttendanceRecordUpdate) SetLongitude(f float64) *AttendanceRecordUpdate {
+	aru.mutation.ResetLongitude()
+	aru.mutation.SetLongitude(f)
+	return aru
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableLongitude(f *float64) *AttendanceRecordUpdate {
+	if f != nil {
+		aru.SetLongitude(*f)
+	}
+	return aru
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (aru *AttendanceRecordUpdate) AddLongitude(f float64) *AttendanceRecordUpdate {
+	aru.mutation.AddLongitude(f)
+	return aru
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (aru *AttendanceRecordUpdate) ClearLongitude() *AttendanceRecordUpdate {
+	aru.mutation.ClearLongitude()
+	return aru
+}
+
+// SetNote sets the "note" field.
+func (aru *AttendanceRecordUpdate) SetNote(s string) *AttendanceRecordUpdate {
+	aru.mutation.SetNote(s)
+	return aru
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableNote(s *string) *AttendanceRecordUpdate {
+	if s != nil {
+		aru.SetNote(*s)
+	}
+	return aru
+}
+
+// ClearNote clears the value of the "note" field.
+func (aru *AttendanceRecordUpdate) ClearNote() *AttendanceRecordUpdate {
+	aru.mutation.ClearNote()
+	return aru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aru *AttendanceRecordUpdate) SetCreatedAt(t time.Time) *AttendanceRecordUpdate {
+	aru.mutation.SetCreatedAt(t)
+	return aru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aru *AttendanceRecordUpdate) SetNillableCreatedAt(t *time.Time) *AttendanceRecordUpdate {
+	if t != nil {
+		aru.SetCreatedAt(*t)
+	}
+	return aru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aru *AttendanceRecordUpdate) SetUpdatedAt(t time.Time) *AttendanceRecordUpdate {
+	aru.mutation.SetUpdatedAt(t)
+	return aru
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (aru *AttendanceRecordUpdate) SetEmployee(e *Employee) *AttendanceRecordUpdate {
+	return aru.SetEmployeeID(e.ID)
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (aru *AttendanceRecordUpdate) SetOrganizationID(id int) *AttendanceRecordUpdate {
+	aru.mutation.SetOrganizationID(id)
+	return aru
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (aru *AttendanceRecordUpdate) SetOrganization(o *Organization) *AttendanceRecordUpdate {
+	return aru.SetOrganizationID(o.ID)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the AttendanceCorrection entity by IDs.
+func (aru *AttendanceRecordUpdate) AddCorrectionIDs(ids ...int) *AttendanceRecordUpdate {
+	aru.mutation.AddCorrectionIDs(ids...)
+	return aru
+}
+
+// AddCorrections adds the "corrections" edges to the AttendanceCorrection entity.
+func (aru *AttendanceRecordUpdate) AddCorrections(a ...*AttendanceCorrection) *AttendanceRecordUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aru.AddCorrectionIDs(ids...)
+}
+
+// Mutation returns the AttendanceRecordMutation object of the builder.
+func (aru *AttendanceRecordUpdate) Mutation() *AttendanceRecordMutation {
+	return aru.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (aru *AttendanceRecordUpdate) ClearEmployee() *AttendanceRecordUpdate {
+	aru.mutation.ClearEmployee()
+	return aru
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (aru *AttendanceRecordUpdate) ClearOrganization() *AttendanceRecordUpdate {
+	aru.mutation.ClearOrganization()
+	return aru
+}
+
+// ClearCorrections clears all "corrections" edges to the AttendanceCorrection entity.
+func (aru *AttendanceRecordUpdate) ClearCorrections() *AttendanceRecordUpdate {
+	aru.mutation.ClearCorrections()
+	return aru
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to AttendanceCorrection entities by IDs.
+func (aru *AttendanceRecordUpdate) RemoveCorrectionIDs(ids ...int) *AttendanceRecordUpdate {
+	aru.mutation.RemoveCorrectionIDs(ids...)
+	return aru
+}
+
+// RemoveCorrections removes "corrections" edges to AttendanceCorrection entities.
+func (aru *AttendanceRecordUpdate) RemoveCorrections(a ...*AttendanceCorrection) *AttendanceRecordUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aru.RemoveCorrectionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *AttendanceRecordUpdate) Save(ctx context.Context) (int, error) {
+	aru.defaults()
+	return withHooks(ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *AttendanceRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *AttendanceRecordUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *AttendanceRecordUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aru *AttendanceRecordUpdate) defaults() {
+	if _, ok := aru.mutation.UpdatedAt(); !ok {
+		v := attendancerecord.UpdateDefaultUpdatedAt()
+		aru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aru *AttendanceRecordUpdate) check() error {
+	if v, ok := aru.mutation.Source(); ok {
+		if err := attendancerecord.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "AttendanceRecord.source": %w`, err)}
+		}
+	}
+	if aru.mutation.EmployeeCleared() && len(aru.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceRecord.employee"`)
+	}
+	if aru.mutation.OrganizationCleared() && len(aru.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceRecord.organization"`)
+	}
+	return nil
+}
+
+func (aru *AttendanceRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancerecord.Table, attendancerecord.Columns, sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aru.mutation.WorkDate(); ok {
+		_spec.SetField(attendancerecord.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := aru.mutation.CheckInAt(); ok {
+		_spec.SetField(attendancerecord.FieldCheckInAt, field.TypeTime, value)
+	}
+	if value, ok := aru.mutation.CheckOutAt(); ok {
+		_spec.SetField(attendancerecord.FieldCheckOutAt, field.TypeTime, value)
+	}
+	if aru.mutation.CheckOutAtCleared() {
+		_spec.ClearField(attendancerecord.FieldCheckOutAt, field.TypeTime)
+	}
+	if value, ok := aru.mutation.Source(); ok {
+		_spec.SetField(attendancerecord.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := aru.mutation.Latitude(); ok {
+		_spec.SetField(attendancerecord.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := aru.mutation.AddedLatitude(); ok {
+		_spec.AddField(attendancerecord.FieldLatitude, field.TypeFloat64, value)
+	}
+	if aru.mutation.LatitudeCleared() {
+		_spec.ClearField(attendancerecord.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := aru.mutation.Longitude(); ok {
+		_spec.SetField(attendancerecord.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := aru.mutation.AddedLongitude(); ok {
+		_spec.AddField(attendancerecord.FieldLongitude, field.TypeFloat64, value)
+	}
+	if aru.mutation.LongitudeCleared() {
+		_spec.ClearField(attendancerecord.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := aru.mutation.Note(); ok {
+		_spec.SetField(attendancerecord.FieldNote, field.TypeString, value)
+	}
+	if aru.mutation.NoteCleared() {
+		_spec.ClearField(attendancerecord.FieldNote, field.TypeString)
+	}
+	if value, ok := aru.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancerecord.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := aru.mutation.UpdatedAt(); ok {
+		_spec.SetField(attendancerecord.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aru.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.EmployeeTable,
+			Columns: []string{attendancerecord.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aru.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.EmployeeTable,
+			Columns: []string{attendancerecord.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aru.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.OrganizationTable,
+			Columns: []string{attendancerecord.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aru.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.OrganizationTable,
+			Columns: []string{attendancerecord.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aru.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancerecord.CorrectionsTable,
+			Columns: []string{attendancerecord.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aru.mutation.RemovedCorrectionsIDs(); len(nodes) > 0 && !aru.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancerecord.CorrectionsTable,
+			Columns: []string{attendancerecord.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aru.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancerecord.CorrectionsTable,
+			Columns: []string{attendancerecord.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// AttendanceRecordUpdateOne is the builder for updating a single AttendanceRecord entity.
+type AttendanceRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttendanceRecordMutation
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (aruo *AttendanceRecordUpdateOne) SetEmployeeID(i int) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetEmployeeID(i)
+	return aruo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableEmployeeID(i *int) *AttendanceRecordUpdateOne {
+	if i != nil {
+		aruo.SetEmployeeID(*i)
+	}
+	return aruo
+}
+
+// SetOrgID sets the "org_id" field.
+func (aruo *AttendanceRecordUpdateOne) SetOrgID(i int) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetOrgID(i)
+	return aruo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableOrgID(i *int) *AttendanceRecordUpdateOne {
+	if i != nil {
+		aruo.SetOrgID(*i)
+	}
+	return aruo
+}
+
+// SetWorkDate sets the "work_date" field.
+func (aruo *AttendanceRecordUpdateOne) SetWorkDate(t time.Time) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetWorkDate(t)
+	return aruo
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableWorkDate(t *time.Time) *AttendanceRecordUpdateOne {
+	if t != nil {
+		aruo.SetWorkDate(*t)
+	}
+	return aruo
+}
+
+// SetCheckInAt sets the "check_in_at" field.
+func (aruo *AttendanceRecordUpdateOne) SetCheckInAt(t time.Time) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetCheckInAt(t)
+	return aruo
+}
+
+// SetNillableCheckInAt sets the "check_in_at" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableCheckInAt(t *time.Time) *AttendanceRecordUpdateOne {
+	if t != nil {
+		aruo.SetCheckInAt(*t)
+	}
+	return aruo
+}
+
+// SetCheckOutAt sets the "check_out_at" field.
+func (aruo *AttendanceRecordUpdateOne) SetCheckOutAt(t time.Time) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetCheckOutAt(t)
+	return aruo
+}
+
+// SetNillableCheckOutAt sets the "check_out_at" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableCheckOutAt(t *time.Time) *AttendanceRecordUpdateOne {
+	if t != nil {
+		aruo.SetCheckOutAt(*t)
+	}
+	return aruo
+}
+
+// ClearCheckOutAt clears the value of the "check_out_at" field.
+func (aruo *AttendanceRecordUpdateOne) ClearCheckOutAt() *AttendanceRecordUpdateOne {
+	aruo.mutation.ClearCheckOutAt()
+	return aruo
+}
+
+// SetSource sets the "source" field.
+func (aruo *AttendanceRecordUpdateOne) SetSource(a attendancerecord.Source) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetSource(a)
+	return aruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableSource(a *attendancerecord.Source) *AttendanceRecordUpdateOne {
+	if a != nil {
+		aruo.SetSource(*a)
+	}
+	return aruo
+}
+
+// SetLatitude sets the "latitude" field.
+func (aruo *AttendanceRecordUpdateOne) SetLatitude(f float64) *AttendanceRecordUpdateOne {
+	aruo.mutation.ResetLatitude()
+	aruo.mutation.SetLatitude(f)
+	return aruo
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableLatitude(f *float64) *AttendanceRecordUpdateOne {
+	if f != nil {
+		aruo.SetLatitude(*f)
+	}
+	return aruo
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (aruo *AttendanceRecordUpdateOne) AddLatitude(f float64) *AttendanceRecordUpdateOne {
+	aruo.mutation.AddLatitude(f)
+	return aruo
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (aruo *AttendanceRecordUpdateOne) ClearLatitude() *AttendanceRecordUpdateOne {
+	aruo.mutation.ClearLatitude()
+	return aruo
+}
+
+// SetLongitude sets the "longitude" field.
+func (aruo *AttendanceRecordUpdateOne) SetLongitude(f float64) *AttendanceRecordUpdateOne {
+	aruo.mutation.ResetLongitude()
+	aruo.mutation.SetLongitude(f)
+	return aruo
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableLongitude(f *float64) *AttendanceRecordUpdateOne {
+	if f != nil {
+		aruo.SetLongitude(*f)
+	}
+	return aruo
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (aruo *AttendanceRecordUpdateOne) AddLongitude(f float64) *AttendanceRecordUpdateOne {
+	aruo.mutation.AddLongitude(f)
+	return aruo
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (aruo *AttendanceRecordUpdateOne) ClearLongitude() *AttendanceRecordUpdateOne {
+	aruo.mutation.ClearLongitude()
+	return aruo
+}
+
+// SetNote sets the "note" field.
+func (aruo *AttendanceRecordUpdateOne) SetNote(s string) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetNote(s)
+	return aruo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableNote(s *string) *AttendanceRecordUpdateOne {
+	if s != nil {
+		aruo.SetNote(*s)
+	}
+	return aruo
+}
+
+// ClearNote clears the value of the "note" field.
+func (aruo *AttendanceRecordUpdateOne) ClearNote() *AttendanceRecordUpdateOne {
+	aruo.mutation.ClearNote()
+	return aruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aruo *AttendanceRecordUpdateOne) SetCreatedAt(t time.Time) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetCreatedAt(t)
+	return aruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aruo *AttendanceRecordUpdateOne) SetNillableCreatedAt(t *time.Time) *AttendanceRecordUpdateOne {
+	if t != nil {
+		aruo.SetCreatedAt(*t)
+	}
+	return aruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aruo *AttendanceRecordUpdateOne) SetUpdatedAt(t time.Time) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetUpdatedAt(t)
+	return aruo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (aruo *AttendanceRecordUpdateOne) SetEmployee(e *Employee) *AttendanceRecordUpdateOne {
+	return aruo.SetEmployeeID(e.ID)
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (aruo *AttendanceRecordUpdateOne) SetOrganizationID(id int) *AttendanceRecordUpdateOne {
+	aruo.mutation.SetOrganizationID(id)
+	return aruo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (aruo *AttendanceRecordUpdateOne) SetOrganization(o *Organization) *AttendanceRecordUpdateOne {
+	return aruo.SetOrganizationID(o.ID)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the AttendanceCorrection entity by IDs.
+func (aruo *AttendanceRecordUpdateOne) AddCorrectionIDs(ids ...int) *AttendanceRecordUpdateOne {
+	aruo.mutation.AddCorrectionIDs(ids...)
+	return aruo
+}
+
+// AddCorrections adds the "corrections" edges to the AttendanceCorrection entity.
+func (aruo *AttendanceRecordUpdateOne) AddCorrections(a ...*AttendanceCorrection) *AttendanceRecordUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aruo.AddCorrectionIDs(ids...)
+}
+
+// Mutation returns the AttendanceRecordMutation object of the builder.
+func (aruo *AttendanceRecordUpdateOne) Mutation() *AttendanceRecordMutation {
+	return aruo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (aruo *AttendanceRecordUpdateOne) ClearEmployee() *AttendanceRecordUpdateOne {
+	aruo.mutation.ClearEmployee()
+	return aruo
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (aruo *AttendanceRecordUpdateOne) ClearOrganization() *AttendanceRecordUpdateOne {
+	aruo.mutation.ClearOrganization()
+	return aruo
+}
+
+// ClearCorrections clears all "corrections" edges to the AttendanceCorrection entity.
+func (aruo *AttendanceRecordUpdateOne) ClearCorrections() *AttendanceRecordUpdateOne {
+	aruo.mutation.ClearCorrections()
+	return aruo
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to AttendanceCorrection entities by IDs.
+func (aruo *AttendanceRecordUpdateOne) RemoveCorrectionIDs(ids ...int) *AttendanceRecordUpdateOne {
+	aruo.mutation.RemoveCorrectionIDs(ids...)
+	return aruo
+}
+
+// RemoveCorrections removes "corrections" edges to AttendanceCorrection entities.
+func (aruo *AttendanceRecordUpdateOne) RemoveCorrections(a ...*AttendanceCorrection) *AttendanceRecordUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return aruo.RemoveCorrectionIDs(ids...)
+}
+
+// Where appends a list predicates to the AttendanceRecordUpdate builder.
+func (aruo *AttendanceRecordUpdateOne) Where(ps ...predicate.AttendanceRecord) *AttendanceRecordUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *AttendanceRecordUpdateOne) Select(field string, fields ...string) *AttendanceRecordUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated AttendanceRecord entity.
+func (aruo *AttendanceRecordUpdateOne) Save(ctx context.Context) (*AttendanceRecord, error) {
+	aruo.defaults()
+	return withHooks(ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *AttendanceRecordUpdateOne) SaveX(ctx context.Context) *AttendanceRecord {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *AttendanceRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *AttendanceRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aruo *AttendanceRecordUpdateOne) defaults() {
+	if _, ok := aruo.mutation.UpdatedAt(); !ok {
+		v := attendancerecord.UpdateDefaultUpdatedAt()
+		aruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aruo *AttendanceRecordUpdateOne) check() error {
+	if v, ok := aruo.mutation.Source(); ok {
+		if err := attendancerecord.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "AttendanceRecord.source": %w`, err)}
+		}
+	}
+	if aruo.mutation.EmployeeCleared() && len(aruo.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceRecord.employee"`)
+	}
+	if aruo.mutation.OrganizationCleared() && len(aruo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttendanceRecord.organization"`)
+	}
+	return nil
+}
+
+func (aruo *AttendanceRecordUpdateOne) sqlSave(ctx context.Context) (_node *AttendanceRecord, err error) {
+	if err := aruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attendancerecord.Table, attendancerecord.Columns, sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttendanceRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attendancerecord.FieldID)
+		for _, f := range fields {
+			if !attendancerecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attendancerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aruo.mutation.WorkDate(); ok {
+		_spec.SetField(attendancerecord.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := aruo.mutation.CheckInAt(); ok {
+		_spec.SetField(attendancerecord.FieldCheckInAt, field.TypeTime, value)
+	}
+	if value, ok := aruo.mutation.CheckOutAt(); ok {
+		_spec.SetField(attendancerecord.FieldCheckOutAt, field.TypeTime, value)
+	}
+	if aruo.mutation.CheckOutAtCleared() {
+		_spec.ClearField(attendancerecord.FieldCheckOutAt, field.TypeTime)
+	}
+	if value, ok := aruo.mutation.Source(); ok {
+		_spec.SetField(attendancerecord.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := aruo.mutation.Latitude(); ok {
+		_spec.SetField(attendancerecord.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := aruo.mutation.AddedLatitude(); ok {
+		_spec.AddField(attendancerecord.FieldLatitude, field.TypeFloat64, value)
+	}
+	if aruo.mutation.LatitudeCleared() {
+		_spec.ClearField(attendancerecord.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := aruo.mutation.Longitude(); ok {
+		_spec.SetField(attendancerecord.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := aruo.mutation.AddedLongitude(); ok {
+		_spec.AddField(attendancerecord.FieldLongitude, field.TypeFloat64, value)
+	}
+	if aruo.mutation.LongitudeCleared() {
+		_spec.ClearField(attendancerecord.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := aruo.mutation.Note(); ok {
+		_spec.SetField(attendancerecord.FieldNote, field.TypeString, value)
+	}
+	if aruo.mutation.NoteCleared() {
+		_spec.ClearField(attendancerecord.FieldNote, field.TypeString)
+	}
+	if value, ok := aruo.mutation.CreatedAt(); ok {
+		_spec.SetField(attendancerecord.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := aruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(attendancerecord.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if aruo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.EmployeeTable,
+			Columns: []string{attendancerecord.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aruo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.EmployeeTable,
+			Columns: []string{attendancerecord.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aruo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.OrganizationTable,
+			Columns: []string{attendancerecord.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aruo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attendancerecord.OrganizationTable,
+			Columns: []string{attendancerecord.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aruo.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancerecord.CorrectionsTable,
+			Columns: []string{attendancerecord.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aruo.mutation.RemovedCorrectionsIDs(); len(nodes) > 0 && !aruo.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancerecord.CorrectionsTable,
+			Columns: []string{attendancerecord.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aruo.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   attendancerecord.CorrectionsTable,
+			Columns: []string{attendancerecord.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AttendanceRecord{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attendancerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
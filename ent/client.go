@@ -16,16 +16,47 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaveapproval"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notificationpreference"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+	"github.com/longgggwwww/hrm-ms-hr/ent/remindersetting"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 )
 
 // Client is the client that holds all ent builders.
@@ -35,26 +66,88 @@ type Client struct {
 	Schema *migrate.Schema
 	// AppointmentHistory is the client for interacting with the AppointmentHistory builders.
 	AppointmentHistory *AppointmentHistoryClient
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// AttendanceCorrection is the client for interacting with the AttendanceCorrection builders.
+	AttendanceCorrection *AttendanceCorrectionClient
+	// AttendanceRecord is the client for interacting with the AttendanceRecord builders.
+	AttendanceRecord *AttendanceRecordClient
+	// Compensation is the client for interacting with the Compensation builders.
+	Compensation *CompensationClient
 	// Department is the client for interacting with the Department builders.
 	Department *DepartmentClient
 	// Employee is the client for interacting with the Employee builders.
 	Employee *EmployeeClient
+	// EmployeeContract is the client for interacting with the EmployeeContract builders.
+	EmployeeContract *EmployeeContractClient
+	// EmployeeStatusHistory is the client for interacting with the EmployeeStatusHistory builders.
+	EmployeeStatusHistory *EmployeeStatusHistoryClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
 	// Label is the client for interacting with the Label builders.
 	Label *LabelClient
 	// LeaveApproval is the client for interacting with the LeaveApproval builders.
 	LeaveApproval *LeaveApprovalClient
 	// LeaveRequest is the client for interacting with the LeaveRequest builders.
 	LeaveRequest *LeaveRequestClient
+	// Milestone is the client for interacting with the Milestone builders.
+	Milestone *MilestoneClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// OvertimeRequest is the client for interacting with the OvertimeRequest builders.
+	OvertimeRequest *OvertimeRequestClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// ProjectRole is the client for interacting with the ProjectRole builders.
+	ProjectRole *ProjectRoleClient
+	// ReminderSetting is the client for interacting with the ReminderSetting builders.
+	ReminderSetting *ReminderSettingClient
+	// Roster is the client for interacting with the Roster builders.
+	Roster *RosterClient
+	// SalaryGrade is the client for interacting with the SalaryGrade builders.
+	SalaryGrade *SalaryGradeClient
+	// Shift is the client for interacting with the Shift builders.
+	Shift *ShiftClient
+	// ShiftSwapRequest is the client for interacting with the ShiftSwapRequest builders.
+	ShiftSwapRequest *ShiftSwapRequestClient
+	// Sprint is the client for interacting with the Sprint builders.
+	Sprint *SprintClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskActivity is the client for interacting with the TaskActivity builders.
+	TaskActivity *TaskActivityClient
+	// TaskComment is the client for interacting with the TaskComment builders.
+	TaskComment *TaskCommentClient
+	// TaskCommentRevision is the client for interacting with the TaskCommentRevision builders.
+	TaskCommentRevision *TaskCommentRevisionClient
+	// TaskDependency is the client for interacting with the TaskDependency builders.
+	TaskDependency *TaskDependencyClient
+	// TaskRecurrence is the client for interacting with the TaskRecurrence builders.
+	TaskRecurrence *TaskRecurrenceClient
+	// TaskRecurrenceRun is the client for interacting with the TaskRecurrenceRun builders.
+	TaskRecurrenceRun *TaskRecurrenceRunClient
+	// TaskReminder is the client for interacting with the TaskReminder builders.
+	TaskReminder *TaskReminderClient
 	// TaskReport is the client for interacting with the TaskReport builders.
 	TaskReport *TaskReportClient
+	// TaskTemplate is the client for interacting with the TaskTemplate builders.
+	TaskTemplate *TaskTemplateClient
+	// TaskView is the client for interacting with the TaskView builders.
+	TaskView *TaskViewClient
+	// TaskWatcher is the client for interacting with the TaskWatcher builders.
+	TaskWatcher *TaskWatcherClient
+	// WorkLog is the client for interacting with the WorkLog builders.
+	WorkLog *WorkLogClient
+	// WorkflowStatus is the client for interacting with the WorkflowStatus builders.
+	WorkflowStatus *WorkflowStatusClient
+	// WorkflowTransition is the client for interacting with the WorkflowTransition builders.
+	WorkflowTransition *WorkflowTransitionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -67,16 +160,47 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AppointmentHistory = NewAppointmentHistoryClient(c.config)
+	c.Attachment = NewAttachmentClient(c.config)
+	c.AttendanceCorrection = NewAttendanceCorrectionClient(c.config)
+	c.AttendanceRecord = NewAttendanceRecordClient(c.config)
+	c.Compensation = NewCompensationClient(c.config)
 	c.Department = NewDepartmentClient(c.config)
 	c.Employee = NewEmployeeClient(c.config)
+	c.EmployeeContract = NewEmployeeContractClient(c.config)
+	c.EmployeeStatusHistory = NewEmployeeStatusHistoryClient(c.config)
+	c.Holiday = NewHolidayClient(c.config)
 	c.Label = NewLabelClient(c.config)
 	c.LeaveApproval = NewLeaveApprovalClient(c.config)
 	c.LeaveRequest = NewLeaveRequestClient(c.config)
+	c.Milestone = NewMilestoneClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
+	c.OvertimeRequest = NewOvertimeRequestClient(c.config)
 	c.Position = NewPositionClient(c.config)
 	c.Project = NewProjectClient(c.config)
+	c.ProjectRole = NewProjectRoleClient(c.config)
+	c.ReminderSetting = NewReminderSettingClient(c.config)
+	c.Roster = NewRosterClient(c.config)
+	c.SalaryGrade = NewSalaryGradeClient(c.config)
+	c.Shift = NewShiftClient(c.config)
+	c.ShiftSwapRequest = NewShiftSwapRequestClient(c.config)
+	c.Sprint = NewSprintClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.TaskActivity = NewTaskActivityClient(c.config)
+	c.TaskComment = NewTaskCommentClient(c.config)
+	c.TaskCommentRevision = NewTaskCommentRevisionClient(c.config)
+	c.TaskDependency = NewTaskDependencyClient(c.config)
+	c.TaskRecurrence = NewTaskRecurrenceClient(c.config)
+	c.TaskRecurrenceRun = NewTaskRecurrenceRunClient(c.config)
+	c.TaskReminder = NewTaskReminderClient(c.config)
 	c.TaskReport = NewTaskReportClient(c.config)
+	c.TaskTemplate = NewTaskTemplateClient(c.config)
+	c.TaskView = NewTaskViewClient(c.config)
+	c.TaskWatcher = NewTaskWatcherClient(c.config)
+	c.WorkLog = NewWorkLogClient(c.config)
+	c.WorkflowStatus = NewWorkflowStatusClient(c.config)
+	c.WorkflowTransition = NewWorkflowTransitionClient(c.config)
 }
 
 type (
@@ -167,19 +291,50 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		AppointmentHistory: NewAppointmentHistoryClient(cfg),
-		Department:         NewDepartmentClient(cfg),
-		Employee:           NewEmployeeClient(cfg),
-		Label:              NewLabelClient(cfg),
-		LeaveApproval:      NewLeaveApprovalClient(cfg),
-		LeaveRequest:       NewLeaveRequestClient(cfg),
-		Organization:       NewOrganizationClient(cfg),
-		Position:           NewPositionClient(cfg),
-		Project:            NewProjectClient(cfg),
-		Task:               NewTaskClient(cfg),
-		TaskReport:         NewTaskReportClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AppointmentHistory:     NewAppointmentHistoryClient(cfg),
+		Attachment:             NewAttachmentClient(cfg),
+		AttendanceCorrection:   NewAttendanceCorrectionClient(cfg),
+		AttendanceRecord:       NewAttendanceRecordClient(cfg),
+		Compensation:           NewCompensationClient(cfg),
+		Department:             NewDepartmentClient(cfg),
+		Employee:               NewEmployeeClient(cfg),
+		EmployeeContract:       NewEmployeeContractClient(cfg),
+		EmployeeStatusHistory:  NewEmployeeStatusHistoryClient(cfg),
+		Holiday:                NewHolidayClient(cfg),
+		Label:                  NewLabelClient(cfg),
+		LeaveApproval:          NewLeaveApprovalClient(cfg),
+		LeaveRequest:           NewLeaveRequestClient(cfg),
+		Milestone:              NewMilestoneClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		Organization:           NewOrganizationClient(cfg),
+		OvertimeRequest:        NewOvertimeRequestClient(cfg),
+		Position:               NewPositionClient(cfg),
+		Project:                NewProjectClient(cfg),
+		ProjectRole:            NewProjectRoleClient(cfg),
+		ReminderSetting:        NewReminderSettingClient(cfg),
+		Roster:                 NewRosterClient(cfg),
+		SalaryGrade:            NewSalaryGradeClient(cfg),
+		Shift:                  NewShiftClient(cfg),
+		ShiftSwapRequest:       NewShiftSwapRequestClient(cfg),
+		Sprint:                 NewSprintClient(cfg),
+		Task:                   NewTaskClient(cfg),
+		TaskActivity:           NewTaskActivityClient(cfg),
+		TaskComment:            NewTaskCommentClient(cfg),
+		TaskCommentRevision:    NewTaskCommentRevisionClient(cfg),
+		TaskDependency:         NewTaskDependencyClient(cfg),
+		TaskRecurrence:         NewTaskRecurrenceClient(cfg),
+		TaskRecurrenceRun:      NewTaskRecurrenceRunClient(cfg),
+		TaskReminder:           NewTaskReminderClient(cfg),
+		TaskReport:             NewTaskReportClient(cfg),
+		TaskTemplate:           NewTaskTemplateClient(cfg),
+		TaskView:               NewTaskViewClient(cfg),
+		TaskWatcher:            NewTaskWatcherClient(cfg),
+		WorkLog:                NewWorkLogClient(cfg),
+		WorkflowStatus:         NewWorkflowStatusClient(cfg),
+		WorkflowTransition:     NewWorkflowTransitionClient(cfg),
 	}, nil
 }
 
@@ -197,19 +352,50 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		AppointmentHistory: NewAppointmentHistoryClient(cfg),
-		Department:         NewDepartmentClient(cfg),
-		Employee:           NewEmployeeClient(cfg),
-		Label:              NewLabelClient(cfg),
-		LeaveApproval:      NewLeaveApprovalClient(cfg),
-		LeaveRequest:       NewLeaveRequestClient(cfg),
-		Organization:       NewOrganizationClient(cfg),
-		Position:           NewPositionClient(cfg),
-		Project:            NewProjectClient(cfg),
-		Task:               NewTaskClient(cfg),
-		TaskReport:         NewTaskReportClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AppointmentHistory:     NewAppointmentHistoryClient(cfg),
+		Attachment:             NewAttachmentClient(cfg),
+		AttendanceCorrection:   NewAttendanceCorrectionClient(cfg),
+		AttendanceRecord:       NewAttendanceRecordClient(cfg),
+		Compensation:           NewCompensationClient(cfg),
+		Department:             NewDepartmentClient(cfg),
+		Employee:               NewEmployeeClient(cfg),
+		EmployeeContract:       NewEmployeeContractClient(cfg),
+		EmployeeStatusHistory:  NewEmployeeStatusHistoryClient(cfg),
+		Holiday:                NewHolidayClient(cfg),
+		Label:                  NewLabelClient(cfg),
+		LeaveApproval:          NewLeaveApprovalClient(cfg),
+		LeaveRequest:           NewLeaveRequestClient(cfg),
+		Milestone:              NewMilestoneClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		Organization:           NewOrganizationClient(cfg),
+		OvertimeRequest:        NewOvertimeRequestClient(cfg),
+		Position:               NewPositionClient(cfg),
+		Project:                NewProjectClient(cfg),
+		ProjectRole:            NewProjectRoleClient(cfg),
+		ReminderSetting:        NewReminderSettingClient(cfg),
+		Roster:                 NewRosterClient(cfg),
+		SalaryGrade:            NewSalaryGradeClient(cfg),
+		Shift:                  NewShiftClient(cfg),
+		ShiftSwapRequest:       NewShiftSwapRequestClient(cfg),
+		Sprint:                 NewSprintClient(cfg),
+		Task:                   NewTaskClient(cfg),
+		TaskActivity:           NewTaskActivityClient(cfg),
+		TaskComment:            NewTaskCommentClient(cfg),
+		TaskCommentRevision:    NewTaskCommentRevisionClient(cfg),
+		TaskDependency:         NewTaskDependencyClient(cfg),
+		TaskRecurrence:         NewTaskRecurrenceClient(cfg),
+		TaskRecurrenceRun:      NewTaskRecurrenceRunClient(cfg),
+		TaskReminder:           NewTaskReminderClient(cfg),
+		TaskReport:             NewTaskReportClient(cfg),
+		TaskTemplate:           NewTaskTemplateClient(cfg),
+		TaskView:               NewTaskViewClient(cfg),
+		TaskWatcher:            NewTaskWatcherClient(cfg),
+		WorkLog:                NewWorkLogClient(cfg),
+		WorkflowStatus:         NewWorkflowStatusClient(cfg),
+		WorkflowTransition:     NewWorkflowTransitionClient(cfg),
 	}, nil
 }
 
@@ -239,8 +425,16 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AppointmentHistory, c.Department, c.Employee, c.Label, c.LeaveApproval,
-		c.LeaveRequest, c.Organization, c.Position, c.Project, c.Task, c.TaskReport,
+		c.AppointmentHistory, c.Attachment, c.AttendanceCorrection, c.AttendanceRecord,
+		c.Compensation, c.Department, c.Employee, c.EmployeeContract,
+		c.EmployeeStatusHistory, c.Holiday, c.Label, c.LeaveApproval, c.LeaveRequest,
+		c.Milestone, c.Notification, c.NotificationPreference, c.Organization,
+		c.OvertimeRequest, c.Position, c.Project, c.ProjectRole, c.ReminderSetting,
+		c.Roster, c.SalaryGrade, c.Shift, c.ShiftSwapRequest, c.Sprint, c.Task,
+		c.TaskActivity, c.TaskComment, c.TaskCommentRevision, c.TaskDependency,
+		c.TaskRecurrence, c.TaskRecurrenceRun, c.TaskReminder, c.TaskReport,
+		c.TaskTemplate, c.TaskView, c.TaskWatcher, c.WorkLog, c.WorkflowStatus,
+		c.WorkflowTransition,
 	} {
 		n.Use(hooks...)
 	}
@@ -250,8 +444,16 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AppointmentHistory, c.Department, c.Employee, c.Label, c.LeaveApproval,
-		c.LeaveRequest, c.Organization, c.Position, c.Project, c.Task, c.TaskReport,
+		c.AppointmentHistory, c.Attachment, c.AttendanceCorrection, c.AttendanceRecord,
+		c.Compensation, c.Department, c.Employee, c.EmployeeContract,
+		c.EmployeeStatusHistory, c.Holiday, c.Label, c.LeaveApproval, c.LeaveRequest,
+		c.Milestone, c.Notification, c.NotificationPreference, c.Organization,
+		c.OvertimeRequest, c.Position, c.Project, c.ProjectRole, c.ReminderSetting,
+		c.Roster, c.SalaryGrade, c.Shift, c.ShiftSwapRequest, c.Sprint, c.Task,
+		c.TaskActivity, c.TaskComment, c.TaskCommentRevision, c.TaskDependency,
+		c.TaskRecurrence, c.TaskRecurrenceRun, c.TaskReminder, c.TaskReport,
+		c.TaskTemplate, c.TaskView, c.TaskWatcher, c.WorkLog, c.WorkflowStatus,
+		c.WorkflowTransition,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -262,26 +464,88 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AppointmentHistoryMutation:
 		return c.AppointmentHistory.mutate(ctx, m)
+	case *AttachmentMutation:
+		return c.Attachment.mutate(ctx, m)
+	case *AttendanceCorrectionMutation:
+		return c.AttendanceCorrection.mutate(ctx, m)
+	case *AttendanceRecordMutation:
+		return c.AttendanceRecord.mutate(ctx, m)
+	case *CompensationMutation:
+		return c.Compensation.mutate(ctx, m)
 	case *DepartmentMutation:
 		return c.Department.mutate(ctx, m)
 	case *EmployeeMutation:
 		return c.Employee.mutate(ctx, m)
+	case *EmployeeContractMutation:
+		return c.EmployeeContract.mutate(ctx, m)
+	case *EmployeeStatusHistoryMutation:
+		return c.EmployeeStatusHistory.mutate(ctx, m)
+	case *HolidayMutation:
+		return c.Holiday.mutate(ctx, m)
 	case *LabelMutation:
 		return c.Label.mutate(ctx, m)
 	case *LeaveApprovalMutation:
 		return c.LeaveApproval.mutate(ctx, m)
 	case *LeaveRequestMutation:
 		return c.LeaveRequest.mutate(ctx, m)
+	case *MilestoneMutation:
+		return c.Milestone.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
 	case *OrganizationMutation:
 		return c.Organization.mutate(ctx, m)
+	case *OvertimeRequestMutation:
+		return c.OvertimeRequest.mutate(ctx, m)
 	case *PositionMutation:
 		return c.Position.mutate(ctx, m)
 	case *ProjectMutation:
 		return c.Project.mutate(ctx, m)
+	case *ProjectRoleMutation:
+		return c.ProjectRole.mutate(ctx, m)
+	case *ReminderSettingMutation:
+		return c.ReminderSetting.mutate(ctx, m)
+	case *RosterMutation:
+		return c.Roster.mutate(ctx, m)
+	case *SalaryGradeMutation:
+		return c.SalaryGrade.mutate(ctx, m)
+	case *ShiftMutation:
+		return c.Shift.mutate(ctx, m)
+	case *ShiftSwapRequestMutation:
+		return c.ShiftSwapRequest.mutate(ctx, m)
+	case *SprintMutation:
+		return c.Sprint.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *TaskActivityMutation:
+		return c.TaskActivity.mutate(ctx, m)
+	case *TaskCommentMutation:
+		return c.TaskComment.mutate(ctx, m)
+	case *TaskCommentRevisionMutation:
+		return c.TaskCommentRevision.mutate(ctx, m)
+	case *TaskDependencyMutation:
+		return c.TaskDependency.mutate(ctx, m)
+	case *TaskRecurrenceMutation:
+		return c.TaskRecurrence.mutate(ctx, m)
+	case *TaskRecurrenceRunMutation:
+		return c.TaskRecurrenceRun.mutate(ctx, m)
+	case *TaskReminderMutation:
+		return c.TaskReminder.mutate(ctx, m)
 	case *TaskReportMutation:
 		return c.TaskReport.mutate(ctx, m)
+	case *TaskTemplateMutation:
+		return c.TaskTemplate.mutate(ctx, m)
+	case *TaskViewMutation:
+		return c.TaskView.mutate(ctx, m)
+	case *TaskWatcherMutation:
+		return c.TaskWatcher.mutate(ctx, m)
+	case *WorkLogMutation:
+		return c.WorkLog.mutate(ctx, m)
+	case *WorkflowStatusMutation:
+		return c.WorkflowStatus.mutate(ctx, m)
+	case *WorkflowTransitionMutation:
+		return c.WorkflowTransition.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -411,6 +675,38 @@ func (c *AppointmentHistoryClient) QueryEmployee(ah *AppointmentHistory) *Employ
 	return query
 }
 
+// QueryPosition queries the position edge of a AppointmentHistory.
+func (c *AppointmentHistoryClient) QueryPosition(ah *AppointmentHistory) *PositionQuery {
+	query := (&PositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ah.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointmenthistory.Table, appointmenthistory.FieldID, id),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, appointmenthistory.PositionTable, appointmenthistory.PositionColumn),
+		)
+		fromV = sqlgraph.Neighbors(ah.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDepartment queries the department edge of a AppointmentHistory.
+func (c *AppointmentHistoryClient) QueryDepartment(ah *AppointmentHistory) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ah.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointmenthistory.Table, appointmenthistory.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, appointmenthistory.DepartmentTable, appointmenthistory.DepartmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(ah.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AppointmentHistoryClient) Hooks() []Hook {
 	return c.hooks.AppointmentHistory
@@ -436,107 +732,107 @@ func (c *AppointmentHistoryClient) mutate(ctx context.Context, m *AppointmentHis
 	}
 }
 
-// DepartmentClient is a client for the Department schema.
-type DepartmentClient struct {
+// AttachmentClient is a client for the Attachment schema.
+type AttachmentClient struct {
 	config
 }
 
-// NewDepartmentClient returns a client for the Department from the given config.
-func NewDepartmentClient(c config) *DepartmentClient {
-	return &DepartmentClient{config: c}
+// NewAttachmentClient returns a client for the Attachment from the given config.
+func NewAttachmentClient(c config) *AttachmentClient {
+	return &AttachmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `department.Hooks(f(g(h())))`.
-func (c *DepartmentClient) Use(hooks ...Hook) {
-	c.hooks.Department = append(c.hooks.Department, hooks...)
+// A call to `Use(f, g, h)` equals to `attachment.Hooks(f(g(h())))`.
+func (c *AttachmentClient) Use(hooks ...Hook) {
+	c.hooks.Attachment = append(c.hooks.Attachment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `department.Intercept(f(g(h())))`.
-func (c *DepartmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Department = append(c.inters.Department, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `attachment.Intercept(f(g(h())))`.
+func (c *AttachmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Attachment = append(c.inters.Attachment, interceptors...)
 }
 
-// Create returns a builder for creating a Department entity.
-func (c *DepartmentClient) Create() *DepartmentCreate {
-	mutation := newDepartmentMutation(c.config, OpCreate)
-	return &DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Attachment entity.
+func (c *AttachmentClient) Create() *AttachmentCreate {
+	mutation := newAttachmentMutation(c.config, OpCreate)
+	return &AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Department entities.
-func (c *DepartmentClient) CreateBulk(builders ...*DepartmentCreate) *DepartmentCreateBulk {
-	return &DepartmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Attachment entities.
+func (c *AttachmentClient) CreateBulk(builders ...*AttachmentCreate) *AttachmentCreateBulk {
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DepartmentClient) MapCreateBulk(slice any, setFunc func(*DepartmentCreate, int)) *DepartmentCreateBulk {
+func (c *AttachmentClient) MapCreateBulk(slice any, setFunc func(*AttachmentCreate, int)) *AttachmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DepartmentCreateBulk{err: fmt.Errorf("calling to DepartmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AttachmentCreateBulk{err: fmt.Errorf("calling to AttachmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DepartmentCreate, rv.Len())
+	builders := make([]*AttachmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DepartmentCreateBulk{config: c.config, builders: builders}
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Department.
-func (c *DepartmentClient) Update() *DepartmentUpdate {
-	mutation := newDepartmentMutation(c.config, OpUpdate)
-	return &DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Attachment.
+func (c *AttachmentClient) Update() *AttachmentUpdate {
+	mutation := newAttachmentMutation(c.config, OpUpdate)
+	return &AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DepartmentClient) UpdateOne(d *Department) *DepartmentUpdateOne {
-	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartment(d))
-	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttachmentClient) UpdateOne(a *Attachment) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachment(a))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DepartmentClient) UpdateOneID(id int) *DepartmentUpdateOne {
-	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartmentID(id))
-	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttachmentClient) UpdateOneID(id int) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachmentID(id))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Department.
-func (c *DepartmentClient) Delete() *DepartmentDelete {
-	mutation := newDepartmentMutation(c.config, OpDelete)
-	return &DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Attachment.
+func (c *AttachmentClient) Delete() *AttachmentDelete {
+	mutation := newAttachmentMutation(c.config, OpDelete)
+	return &AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DepartmentClient) DeleteOne(d *Department) *DepartmentDeleteOne {
-	return c.DeleteOneID(d.ID)
+func (c *AttachmentClient) DeleteOne(a *Attachment) *AttachmentDeleteOne {
+	return c.DeleteOneID(a.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DepartmentClient) DeleteOneID(id int) *DepartmentDeleteOne {
-	builder := c.Delete().Where(department.ID(id))
+func (c *AttachmentClient) DeleteOneID(id int) *AttachmentDeleteOne {
+	builder := c.Delete().Where(attachment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DepartmentDeleteOne{builder}
+	return &AttachmentDeleteOne{builder}
 }
 
-// Query returns a query builder for Department.
-func (c *DepartmentClient) Query() *DepartmentQuery {
-	return &DepartmentQuery{
+// Query returns a query builder for Attachment.
+func (c *AttachmentClient) Query() *AttachmentQuery {
+	return &AttachmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDepartment},
+		ctx:    &QueryContext{Type: TypeAttachment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Department entity by its id.
-func (c *DepartmentClient) Get(ctx context.Context, id int) (*Department, error) {
-	return c.Query().Where(department.ID(id)).Only(ctx)
+// Get returns a Attachment entity by its id.
+func (c *AttachmentClient) Get(ctx context.Context, id int) (*Attachment, error) {
+	return c.Query().Where(attachment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DepartmentClient) GetX(ctx context.Context, id int) *Department {
+func (c *AttachmentClient) GetX(ctx context.Context, id int) *Attachment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -544,164 +840,164 @@ func (c *DepartmentClient) GetX(ctx context.Context, id int) *Department {
 	return obj
 }
 
-// QueryPositions queries the positions edge of a Department.
-func (c *DepartmentClient) QueryPositions(d *Department) *PositionQuery {
-	query := (&PositionClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a Attachment.
+func (c *AttachmentClient) QueryOrganization(a *Attachment) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
+		id := a.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(position.Table, position.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, department.PositionsTable, department.PositionsColumn),
+			sqlgraph.From(attachment.Table, attachment.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachment.OrganizationTable, attachment.OrganizationColumn),
 		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryOrganization queries the organization edge of a Department.
-func (c *DepartmentClient) QueryOrganization(d *Department) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryUploader queries the uploader edge of a Attachment.
+func (c *AttachmentClient) QueryUploader(a *Attachment) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
+		id := a.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(department.Table, department.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, department.OrganizationTable, department.OrganizationColumn),
+			sqlgraph.From(attachment.Table, attachment.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachment.UploaderTable, attachment.UploaderColumn),
 		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *DepartmentClient) Hooks() []Hook {
-	return c.hooks.Department
+func (c *AttachmentClient) Hooks() []Hook {
+	return c.hooks.Attachment
 }
 
 // Interceptors returns the client interceptors.
-func (c *DepartmentClient) Interceptors() []Interceptor {
-	return c.inters.Department
+func (c *AttachmentClient) Interceptors() []Interceptor {
+	return c.inters.Attachment
 }
 
-func (c *DepartmentClient) mutate(ctx context.Context, m *DepartmentMutation) (Value, error) {
+func (c *AttachmentClient) mutate(ctx context.Context, m *AttachmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Department mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Attachment mutation op: %q", m.Op())
 	}
 }
 
-// EmployeeClient is a client for the Employee schema.
-type EmployeeClient struct {
+// AttendanceCorrectionClient is a client for the AttendanceCorrection schema.
+type AttendanceCorrectionClient struct {
 	config
 }
 
-// NewEmployeeClient returns a client for the Employee from the given config.
-func NewEmployeeClient(c config) *EmployeeClient {
-	return &EmployeeClient{config: c}
+// NewAttendanceCorrectionClient returns a client for the AttendanceCorrection from the given config.
+func NewAttendanceCorrectionClient(c config) *AttendanceCorrectionClient {
+	return &AttendanceCorrectionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `employee.Hooks(f(g(h())))`.
-func (c *EmployeeClient) Use(hooks ...Hook) {
-	c.hooks.Employee = append(c.hooks.Employee, hooks...)
+// A call to `Use(f, g, h)` equals to `attendancecorrection.Hooks(f(g(h())))`.
+func (c *AttendanceCorrectionClient) Use(hooks ...Hook) {
+	c.hooks.AttendanceCorrection = append(c.hooks.AttendanceCorrection, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `employee.Intercept(f(g(h())))`.
-func (c *EmployeeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Employee = append(c.inters.Employee, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `attendancecorrection.Intercept(f(g(h())))`.
+func (c *AttendanceCorrectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttendanceCorrection = append(c.inters.AttendanceCorrection, interceptors...)
 }
 
-// Create returns a builder for creating a Employee entity.
-func (c *EmployeeClient) Create() *EmployeeCreate {
-	mutation := newEmployeeMutation(c.config, OpCreate)
-	return &EmployeeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AttendanceCorrection entity.
+func (c *AttendanceCorrectionClient) Create() *AttendanceCorrectionCreate {
+	mutation := newAttendanceCorrectionMutation(c.config, OpCreate)
+	return &AttendanceCorrectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Employee entities.
-func (c *EmployeeClient) CreateBulk(builders ...*EmployeeCreate) *EmployeeCreateBulk {
-	return &EmployeeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AttendanceCorrection entities.
+func (c *AttendanceCorrectionClient) CreateBulk(builders ...*AttendanceCorrectionCreate) *AttendanceCorrectionCreateBulk {
+	return &AttendanceCorrectionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmployeeClient) MapCreateBulk(slice any, setFunc func(*EmployeeCreate, int)) *EmployeeCreateBulk {
+func (c *AttendanceCorrectionClient) MapCreateBulk(slice any, setFunc func(*AttendanceCorrectionCreate, int)) *AttendanceCorrectionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmployeeCreateBulk{err: fmt.Errorf("calling to EmployeeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AttendanceCorrectionCreateBulk{err: fmt.Errorf("calling to AttendanceCorrectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmployeeCreate, rv.Len())
+	builders := make([]*AttendanceCorrectionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmployeeCreateBulk{config: c.config, builders: builders}
+	return &AttendanceCorrectionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Employee.
-func (c *EmployeeClient) Update() *EmployeeUpdate {
-	mutation := newEmployeeMutation(c.config, OpUpdate)
-	return &EmployeeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AttendanceCorrection.
+func (c *AttendanceCorrectionClient) Update() *AttendanceCorrectionUpdate {
+	mutation := newAttendanceCorrectionMutation(c.config, OpUpdate)
+	return &AttendanceCorrectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmployeeClient) UpdateOne(e *Employee) *EmployeeUpdateOne {
-	mutation := newEmployeeMutation(c.config, OpUpdateOne, withEmployee(e))
-	return &EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttendanceCorrectionClient) UpdateOne(ac *AttendanceCorrection) *AttendanceCorrectionUpdateOne {
+	mutation := newAttendanceCorrectionMutation(c.config, OpUpdateOne, withAttendanceCorrection(ac))
+	return &AttendanceCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmployeeClient) UpdateOneID(id int) *EmployeeUpdateOne {
-	mutation := newEmployeeMutation(c.config, OpUpdateOne, withEmployeeID(id))
-	return &EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttendanceCorrectionClient) UpdateOneID(id int) *AttendanceCorrectionUpdateOne {
+	mutation := newAttendanceCorrectionMutation(c.config, OpUpdateOne, withAttendanceCorrectionID(id))
+	return &AttendanceCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Employee.
-func (c *EmployeeClient) Delete() *EmployeeDelete {
-	mutation := newEmployeeMutation(c.config, OpDelete)
-	return &EmployeeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AttendanceCorrection.
+func (c *AttendanceCorrectionClient) Delete() *AttendanceCorrectionDelete {
+	mutation := newAttendanceCorrectionMutation(c.config, OpDelete)
+	return &AttendanceCorrectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmployeeClient) DeleteOne(e *Employee) *EmployeeDeleteOne {
-	return c.DeleteOneID(e.ID)
+func (c *AttendanceCorrectionClient) DeleteOne(ac *AttendanceCorrection) *AttendanceCorrectionDeleteOne {
+	return c.DeleteOneID(ac.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmployeeClient) DeleteOneID(id int) *EmployeeDeleteOne {
-	builder := c.Delete().Where(employee.ID(id))
+func (c *AttendanceCorrectionClient) DeleteOneID(id int) *AttendanceCorrectionDeleteOne {
+	builder := c.Delete().Where(attendancecorrection.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmployeeDeleteOne{builder}
+	return &AttendanceCorrectionDeleteOne{builder}
 }
 
-// Query returns a query builder for Employee.
-func (c *EmployeeClient) Query() *EmployeeQuery {
-	return &EmployeeQuery{
+// Query returns a query builder for AttendanceCorrection.
+func (c *AttendanceCorrectionClient) Query() *AttendanceCorrectionQuery {
+	return &AttendanceCorrectionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmployee},
+		ctx:    &QueryContext{Type: TypeAttendanceCorrection},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Employee entity by its id.
-func (c *EmployeeClient) Get(ctx context.Context, id int) (*Employee, error) {
-	return c.Query().Where(employee.ID(id)).Only(ctx)
+// Get returns a AttendanceCorrection entity by its id.
+func (c *AttendanceCorrectionClient) Get(ctx context.Context, id int) (*AttendanceCorrection, error) {
+	return c.Query().Where(attendancecorrection.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmployeeClient) GetX(ctx context.Context, id int) *Employee {
+func (c *AttendanceCorrectionClient) GetX(ctx context.Context, id int) *AttendanceCorrection {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -709,276 +1005,164 @@ func (c *EmployeeClient) GetX(ctx context.Context, id int) *Employee {
 	return obj
 }
 
-// QueryPosition queries the position edge of a Employee.
-func (c *EmployeeClient) QueryPosition(e *Employee) *PositionQuery {
-	query := (&PositionClient{config: c.config}).Query()
+// QueryAttendanceRecord queries the attendance_record edge of a AttendanceCorrection.
+func (c *AttendanceCorrectionClient) QueryAttendanceRecord(ac *AttendanceCorrection) *AttendanceRecordQuery {
+	query := (&AttendanceRecordClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := ac.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(position.Table, position.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, employee.PositionTable, employee.PositionColumn),
+			sqlgraph.From(attendancecorrection.Table, attendancecorrection.FieldID, id),
+			sqlgraph.To(attendancerecord.Table, attendancerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancecorrection.AttendanceRecordTable, attendancecorrection.AttendanceRecordColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ac.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCreatedProjects queries the created_projects edge of a Employee.
-func (c *EmployeeClient) QueryCreatedProjects(e *Employee) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
+// QueryEmployee queries the employee edge of a AttendanceCorrection.
+func (c *AttendanceCorrectionClient) QueryEmployee(ac *AttendanceCorrection) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
+		id := ac.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, employee.CreatedProjectsTable, employee.CreatedProjectsColumn),
+			sqlgraph.From(attendancecorrection.Table, attendancecorrection.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancecorrection.EmployeeTable, attendancecorrection.EmployeeColumn),
 		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ac.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryUpdatedProjects queries the updated_projects edge of a Employee.
-func (c *EmployeeClient) QueryUpdatedProjects(e *Employee) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, employee.UpdatedProjectsTable, employee.UpdatedProjectsColumn),
-		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *AttendanceCorrectionClient) Hooks() []Hook {
+	return c.hooks.AttendanceCorrection
 }
 
-// QueryAssignedTasks queries the assigned_tasks edge of a Employee.
-func (c *EmployeeClient) QueryAssignedTasks(e *Employee) *TaskQuery {
-	query := (&TaskClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(task.Table, task.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, employee.AssignedTasksTable, employee.AssignedTasksPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Interceptors returns the client interceptors.
+func (c *AttendanceCorrectionClient) Interceptors() []Interceptor {
+	return c.inters.AttendanceCorrection
 }
 
-// QueryLeaveApproves queries the leave_approves edge of a Employee.
-func (c *EmployeeClient) QueryLeaveApproves(e *Employee) *LeaveApprovalQuery {
-	query := (&LeaveApprovalClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(leaveapproval.Table, leaveapproval.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, employee.LeaveApprovesTable, employee.LeaveApprovesColumn),
-		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
+func (c *AttendanceCorrectionClient) mutate(ctx context.Context, m *AttendanceCorrectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttendanceCorrectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttendanceCorrectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttendanceCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttendanceCorrectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AttendanceCorrection mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryLeaveRequests queries the leave_requests edge of a Employee.
-func (c *EmployeeClient) QueryLeaveRequests(e *Employee) *LeaveRequestQuery {
-	query := (&LeaveRequestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, employee.LeaveRequestsTable, employee.LeaveRequestsColumn),
-		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// AttendanceRecordClient is a client for the AttendanceRecord schema.
+type AttendanceRecordClient struct {
+	config
 }
 
-// QueryTaskReports queries the task_reports edge of a Employee.
-func (c *EmployeeClient) QueryTaskReports(e *Employee) *TaskReportQuery {
-	query := (&TaskReportClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(taskreport.Table, taskreport.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, employee.TaskReportsTable, employee.TaskReportsColumn),
-		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProjects queries the projects edge of a Employee.
-func (c *EmployeeClient) QueryProjects(e *Employee) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, employee.ProjectsTable, employee.ProjectsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAppointmentHistories queries the appointment_histories edge of a Employee.
-func (c *EmployeeClient) QueryAppointmentHistories(e *Employee) *AppointmentHistoryQuery {
-	query := (&AppointmentHistoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(employee.Table, employee.FieldID, id),
-			sqlgraph.To(appointmenthistory.Table, appointmenthistory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, employee.AppointmentHistoriesTable, employee.AppointmentHistoriesColumn),
-		)
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// Hooks returns the client hooks.
-func (c *EmployeeClient) Hooks() []Hook {
-	return c.hooks.Employee
-}
-
-// Interceptors returns the client interceptors.
-func (c *EmployeeClient) Interceptors() []Interceptor {
-	return c.inters.Employee
-}
-
-func (c *EmployeeClient) mutate(ctx context.Context, m *EmployeeMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&EmployeeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&EmployeeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&EmployeeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown Employee mutation op: %q", m.Op())
-	}
-}
-
-// LabelClient is a client for the Label schema.
-type LabelClient struct {
-	config
-}
-
-// NewLabelClient returns a client for the Label from the given config.
-func NewLabelClient(c config) *LabelClient {
-	return &LabelClient{config: c}
+// NewAttendanceRecordClient returns a client for the AttendanceRecord from the given config.
+func NewAttendanceRecordClient(c config) *AttendanceRecordClient {
+	return &AttendanceRecordClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `label.Hooks(f(g(h())))`.
-func (c *LabelClient) Use(hooks ...Hook) {
-	c.hooks.Label = append(c.hooks.Label, hooks...)
+// A call to `Use(f, g, h)` equals to `attendancerecord.Hooks(f(g(h())))`.
+func (c *AttendanceRecordClient) Use(hooks ...Hook) {
+	c.hooks.AttendanceRecord = append(c.hooks.AttendanceRecord, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `label.Intercept(f(g(h())))`.
-func (c *LabelClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Label = append(c.inters.Label, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `attendancerecord.Intercept(f(g(h())))`.
+func (c *AttendanceRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttendanceRecord = append(c.inters.AttendanceRecord, interceptors...)
 }
 
-// Create returns a builder for creating a Label entity.
-func (c *LabelClient) Create() *LabelCreate {
-	mutation := newLabelMutation(c.config, OpCreate)
-	return &LabelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AttendanceRecord entity.
+func (c *AttendanceRecordClient) Create() *AttendanceRecordCreate {
+	mutation := newAttendanceRecordMutation(c.config, OpCreate)
+	return &AttendanceRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Label entities.
-func (c *LabelClient) CreateBulk(builders ...*LabelCreate) *LabelCreateBulk {
-	return &LabelCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AttendanceRecord entities.
+func (c *AttendanceRecordClient) CreateBulk(builders ...*AttendanceRecordCreate) *AttendanceRecordCreateBulk {
+	return &AttendanceRecordCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LabelClient) MapCreateBulk(slice any, setFunc func(*LabelCreate, int)) *LabelCreateBulk {
+func (c *AttendanceRecordClient) MapCreateBulk(slice any, setFunc func(*AttendanceRecordCreate, int)) *AttendanceRecordCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LabelCreateBulk{err: fmt.Errorf("calling to LabelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AttendanceRecordCreateBulk{err: fmt.Errorf("calling to AttendanceRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LabelCreate, rv.Len())
+	builders := make([]*AttendanceRecordCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LabelCreateBulk{config: c.config, builders: builders}
+	return &AttendanceRecordCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Label.
-func (c *LabelClient) Update() *LabelUpdate {
-	mutation := newLabelMutation(c.config, OpUpdate)
-	return &LabelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AttendanceRecord.
+func (c *AttendanceRecordClient) Update() *AttendanceRecordUpdate {
+	mutation := newAttendanceRecordMutation(c.config, OpUpdate)
+	return &AttendanceRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LabelClient) UpdateOne(l *Label) *LabelUpdateOne {
-	mutation := newLabelMutation(c.config, OpUpdateOne, withLabel(l))
-	return &LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttendanceRecordClient) UpdateOne(ar *AttendanceRecord) *AttendanceRecordUpdateOne {
+	mutation := newAttendanceRecordMutation(c.config, OpUpdateOne, withAttendanceRecord(ar))
+	return &AttendanceRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LabelClient) UpdateOneID(id int) *LabelUpdateOne {
-	mutation := newLabelMutation(c.config, OpUpdateOne, withLabelID(id))
-	return &LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AttendanceRecordClient) UpdateOneID(id int) *AttendanceRecordUpdateOne {
+	mutation := newAttendanceRecordMutation(c.config, OpUpdateOne, withAttendanceRecordID(id))
+	return &AttendanceRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Label.
-func (c *LabelClient) Delete() *LabelDelete {
-	mutation := newLabelMutation(c.config, OpDelete)
-	return &LabelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AttendanceRecord.
+func (c *AttendanceRecordClient) Delete() *AttendanceRecordDelete {
+	mutation := newAttendanceRecordMutation(c.config, OpDelete)
+	return &AttendanceRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LabelClient) DeleteOne(l *Label) *LabelDeleteOne {
-	return c.DeleteOneID(l.ID)
+func (c *AttendanceRecordClient) DeleteOne(ar *AttendanceRecord) *AttendanceRecordDeleteOne {
+	return c.DeleteOneID(ar.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LabelClient) DeleteOneID(id int) *LabelDeleteOne {
-	builder := c.Delete().Where(label.ID(id))
+func (c *AttendanceRecordClient) DeleteOneID(id int) *AttendanceRecordDeleteOne {
+	builder := c.Delete().Where(attendancerecord.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LabelDeleteOne{builder}
+	return &AttendanceRecordDeleteOne{builder}
 }
 
-// Query returns a query builder for Label.
-func (c *LabelClient) Query() *LabelQuery {
-	return &LabelQuery{
+// Query returns a query builder for AttendanceRecord.
+func (c *AttendanceRecordClient) Query() *AttendanceRecordQuery {
+	return &AttendanceRecordQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLabel},
+		ctx:    &QueryContext{Type: TypeAttendanceRecord},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Label entity by its id.
-func (c *LabelClient) Get(ctx context.Context, id int) (*Label, error) {
-	return c.Query().Where(label.ID(id)).Only(ctx)
+// Get returns a AttendanceRecord entity by its id.
+func (c *AttendanceRecordClient) Get(ctx context.Context, id int) (*AttendanceRecord, error) {
+	return c.Query().Where(attendancerecord.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LabelClient) GetX(ctx context.Context, id int) *Label {
+func (c *AttendanceRecordClient) GetX(ctx context.Context, id int) *AttendanceRecord {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -986,164 +1170,180 @@ func (c *LabelClient) GetX(ctx context.Context, id int) *Label {
 	return obj
 }
 
-// QueryTasks queries the tasks edge of a Label.
-func (c *LabelClient) QueryTasks(l *Label) *TaskQuery {
-	query := (&TaskClient{config: c.config}).Query()
+// QueryEmployee queries the employee edge of a AttendanceRecord.
+func (c *AttendanceRecordClient) QueryEmployee(ar *AttendanceRecord) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := l.ID
+		id := ar.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(label.Table, label.FieldID, id),
-			sqlgraph.To(task.Table, task.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, label.TasksTable, label.TasksPrimaryKey...),
+			sqlgraph.From(attendancerecord.Table, attendancerecord.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancerecord.EmployeeTable, attendancerecord.EmployeeColumn),
 		)
-		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ar.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryOrganization queries the organization edge of a Label.
-func (c *LabelClient) QueryOrganization(l *Label) *OrganizationQuery {
+// QueryOrganization queries the organization edge of a AttendanceRecord.
+func (c *AttendanceRecordClient) QueryOrganization(ar *AttendanceRecord) *OrganizationQuery {
 	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := l.ID
+		id := ar.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(label.Table, label.FieldID, id),
+			sqlgraph.From(attendancerecord.Table, attendancerecord.FieldID, id),
 			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, label.OrganizationTable, label.OrganizationColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, attendancerecord.OrganizationTable, attendancerecord.OrganizationColumn),
 		)
-		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ar.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCorrections queries the corrections edge of a AttendanceRecord.
+func (c *AttendanceRecordClient) QueryCorrections(ar *AttendanceRecord) *AttendanceCorrectionQuery {
+	query := (&AttendanceCorrectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ar.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attendancerecord.Table, attendancerecord.FieldID, id),
+			sqlgraph.To(attendancecorrection.Table, attendancecorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, attendancerecord.CorrectionsTable, attendancerecord.CorrectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ar.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LabelClient) Hooks() []Hook {
-	return c.hooks.Label
+func (c *AttendanceRecordClient) Hooks() []Hook {
+	return c.hooks.AttendanceRecord
 }
 
 // Interceptors returns the client interceptors.
-func (c *LabelClient) Interceptors() []Interceptor {
-	return c.inters.Label
+func (c *AttendanceRecordClient) Interceptors() []Interceptor {
+	return c.inters.AttendanceRecord
 }
 
-func (c *LabelClient) mutate(ctx context.Context, m *LabelMutation) (Value, error) {
+func (c *AttendanceRecordClient) mutate(ctx context.Context, m *AttendanceRecordMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LabelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttendanceRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LabelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttendanceRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AttendanceRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LabelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AttendanceRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Label mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AttendanceRecord mutation op: %q", m.Op())
 	}
 }
 
-// LeaveApprovalClient is a client for the LeaveApproval schema.
-type LeaveApprovalClient struct {
+// CompensationClient is a client for the Compensation schema.
+type CompensationClient struct {
 	config
 }
 
-// NewLeaveApprovalClient returns a client for the LeaveApproval from the given config.
-func NewLeaveApprovalClient(c config) *LeaveApprovalClient {
-	return &LeaveApprovalClient{config: c}
+// NewCompensationClient returns a client for the Compensation from the given config.
+func NewCompensationClient(c config) *CompensationClient {
+	return &CompensationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `leaveapproval.Hooks(f(g(h())))`.
-func (c *LeaveApprovalClient) Use(hooks ...Hook) {
-	c.hooks.LeaveApproval = append(c.hooks.LeaveApproval, hooks...)
+// A call to `Use(f, g, h)` equals to `compensation.Hooks(f(g(h())))`.
+func (c *CompensationClient) Use(hooks ...Hook) {
+	c.hooks.Compensation = append(c.hooks.Compensation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `leaveapproval.Intercept(f(g(h())))`.
-func (c *LeaveApprovalClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LeaveApproval = append(c.inters.LeaveApproval, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `compensation.Intercept(f(g(h())))`.
+func (c *CompensationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Compensation = append(c.inters.Compensation, interceptors...)
 }
 
-// Create returns a builder for creating a LeaveApproval entity.
-func (c *LeaveApprovalClient) Create() *LeaveApprovalCreate {
-	mutation := newLeaveApprovalMutation(c.config, OpCreate)
-	return &LeaveApprovalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Compensation entity.
+func (c *CompensationClient) Create() *CompensationCreate {
+	mutation := newCompensationMutation(c.config, OpCreate)
+	return &CompensationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LeaveApproval entities.
-func (c *LeaveApprovalClient) CreateBulk(builders ...*LeaveApprovalCreate) *LeaveApprovalCreateBulk {
-	return &LeaveApprovalCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Compensation entities.
+func (c *CompensationClient) CreateBulk(builders ...*CompensationCreate) *CompensationCreateBulk {
+	return &CompensationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeaveApprovalClient) MapCreateBulk(slice any, setFunc func(*LeaveApprovalCreate, int)) *LeaveApprovalCreateBulk {
+func (c *CompensationClient) MapCreateBulk(slice any, setFunc func(*CompensationCreate, int)) *CompensationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeaveApprovalCreateBulk{err: fmt.Errorf("calling to LeaveApprovalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CompensationCreateBulk{err: fmt.Errorf("calling to CompensationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeaveApprovalCreate, rv.Len())
+	builders := make([]*CompensationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeaveApprovalCreateBulk{config: c.config, builders: builders}
+	return &CompensationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LeaveApproval.
-func (c *LeaveApprovalClient) Update() *LeaveApprovalUpdate {
-	mutation := newLeaveApprovalMutation(c.config, OpUpdate)
-	return &LeaveApprovalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Compensation.
+func (c *CompensationClient) Update() *CompensationUpdate {
+	mutation := newCompensationMutation(c.config, OpUpdate)
+	return &CompensationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeaveApprovalClient) UpdateOne(la *LeaveApproval) *LeaveApprovalUpdateOne {
-	mutation := newLeaveApprovalMutation(c.config, OpUpdateOne, withLeaveApproval(la))
-	return &LeaveApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CompensationClient) UpdateOne(co *Compensation) *CompensationUpdateOne {
+	mutation := newCompensationMutation(c.config, OpUpdateOne, withCompensation(co))
+	return &CompensationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeaveApprovalClient) UpdateOneID(id int) *LeaveApprovalUpdateOne {
-	mutation := newLeaveApprovalMutation(c.config, OpUpdateOne, withLeaveApprovalID(id))
-	return &LeaveApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CompensationClient) UpdateOneID(id int) *CompensationUpdateOne {
+	mutation := newCompensationMutation(c.config, OpUpdateOne, withCompensationID(id))
+	return &CompensationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LeaveApproval.
-func (c *LeaveApprovalClient) Delete() *LeaveApprovalDelete {
-	mutation := newLeaveApprovalMutation(c.config, OpDelete)
-	return &LeaveApprovalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Compensation.
+func (c *CompensationClient) Delete() *CompensationDelete {
+	mutation := newCompensationMutation(c.config, OpDelete)
+	return &CompensationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeaveApprovalClient) DeleteOne(la *LeaveApproval) *LeaveApprovalDeleteOne {
-	return c.DeleteOneID(la.ID)
+func (c *CompensationClient) DeleteOne(co *Compensation) *CompensationDeleteOne {
+	return c.DeleteOneID(co.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeaveApprovalClient) DeleteOneID(id int) *LeaveApprovalDeleteOne {
-	builder := c.Delete().Where(leaveapproval.ID(id))
+func (c *CompensationClient) DeleteOneID(id int) *CompensationDeleteOne {
+	builder := c.Delete().Where(compensation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeaveApprovalDeleteOne{builder}
+	return &CompensationDeleteOne{builder}
 }
 
-// Query returns a query builder for LeaveApproval.
-func (c *LeaveApprovalClient) Query() *LeaveApprovalQuery {
-	return &LeaveApprovalQuery{
+// Query returns a query builder for Compensation.
+func (c *CompensationClient) Query() *CompensationQuery {
+	return &CompensationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLeaveApproval},
+		ctx:    &QueryContext{Type: TypeCompensation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LeaveApproval entity by its id.
-func (c *LeaveApprovalClient) Get(ctx context.Context, id int) (*LeaveApproval, error) {
-	return c.Query().Where(leaveapproval.ID(id)).Only(ctx)
+// Get returns a Compensation entity by its id.
+func (c *CompensationClient) Get(ctx context.Context, id int) (*Compensation, error) {
+	return c.Query().Where(compensation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeaveApprovalClient) GetX(ctx context.Context, id int) *LeaveApproval {
+func (c *CompensationClient) GetX(ctx context.Context, id int) *Compensation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1151,164 +1351,164 @@ func (c *LeaveApprovalClient) GetX(ctx context.Context, id int) *LeaveApproval {
 	return obj
 }
 
-// QueryLeaveRequest queries the leave_request edge of a LeaveApproval.
-func (c *LeaveApprovalClient) QueryLeaveRequest(la *LeaveApproval) *LeaveRequestQuery {
-	query := (&LeaveRequestClient{config: c.config}).Query()
+// QueryEmployee queries the employee edge of a Compensation.
+func (c *CompensationClient) QueryEmployee(co *Compensation) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := la.ID
+		id := co.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leaveapproval.Table, leaveapproval.FieldID, id),
-			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leaveapproval.LeaveRequestTable, leaveapproval.LeaveRequestColumn),
+			sqlgraph.From(compensation.Table, compensation.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, compensation.EmployeeTable, compensation.EmployeeColumn),
 		)
-		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryReviewer queries the reviewer edge of a LeaveApproval.
-func (c *LeaveApprovalClient) QueryReviewer(la *LeaveApproval) *EmployeeQuery {
-	query := (&EmployeeClient{config: c.config}).Query()
+// QuerySalaryGrade queries the salary_grade edge of a Compensation.
+func (c *CompensationClient) QuerySalaryGrade(co *Compensation) *SalaryGradeQuery {
+	query := (&SalaryGradeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := la.ID
+		id := co.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leaveapproval.Table, leaveapproval.FieldID, id),
-			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leaveapproval.ReviewerTable, leaveapproval.ReviewerColumn),
+			sqlgraph.From(compensation.Table, compensation.FieldID, id),
+			sqlgraph.To(salarygrade.Table, salarygrade.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, compensation.SalaryGradeTable, compensation.SalaryGradeColumn),
 		)
-		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LeaveApprovalClient) Hooks() []Hook {
-	return c.hooks.LeaveApproval
+func (c *CompensationClient) Hooks() []Hook {
+	return c.hooks.Compensation
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeaveApprovalClient) Interceptors() []Interceptor {
-	return c.inters.LeaveApproval
+func (c *CompensationClient) Interceptors() []Interceptor {
+	return c.inters.Compensation
 }
 
-func (c *LeaveApprovalClient) mutate(ctx context.Context, m *LeaveApprovalMutation) (Value, error) {
+func (c *CompensationClient) mutate(ctx context.Context, m *CompensationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeaveApprovalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CompensationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeaveApprovalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CompensationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeaveApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CompensationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeaveApprovalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CompensationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LeaveApproval mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Compensation mutation op: %q", m.Op())
 	}
 }
 
-// LeaveRequestClient is a client for the LeaveRequest schema.
-type LeaveRequestClient struct {
+// DepartmentClient is a client for the Department schema.
+type DepartmentClient struct {
 	config
 }
 
-// NewLeaveRequestClient returns a client for the LeaveRequest from the given config.
-func NewLeaveRequestClient(c config) *LeaveRequestClient {
-	return &LeaveRequestClient{config: c}
+// NewDepartmentClient returns a client for the Department from the given config.
+func NewDepartmentClient(c config) *DepartmentClient {
+	return &DepartmentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `leaverequest.Hooks(f(g(h())))`.
-func (c *LeaveRequestClient) Use(hooks ...Hook) {
-	c.hooks.LeaveRequest = append(c.hooks.LeaveRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `department.Hooks(f(g(h())))`.
+func (c *DepartmentClient) Use(hooks ...Hook) {
+	c.hooks.Department = append(c.hooks.Department, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `leaverequest.Intercept(f(g(h())))`.
-func (c *LeaveRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LeaveRequest = append(c.inters.LeaveRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `department.Intercept(f(g(h())))`.
+func (c *DepartmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Department = append(c.inters.Department, interceptors...)
 }
 
-// Create returns a builder for creating a LeaveRequest entity.
-func (c *LeaveRequestClient) Create() *LeaveRequestCreate {
-	mutation := newLeaveRequestMutation(c.config, OpCreate)
-	return &LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Department entity.
+func (c *DepartmentClient) Create() *DepartmentCreate {
+	mutation := newDepartmentMutation(c.config, OpCreate)
+	return &DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LeaveRequest entities.
-func (c *LeaveRequestClient) CreateBulk(builders ...*LeaveRequestCreate) *LeaveRequestCreateBulk {
-	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Department entities.
+func (c *DepartmentClient) CreateBulk(builders ...*DepartmentCreate) *DepartmentCreateBulk {
+	return &DepartmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeaveRequestClient) MapCreateBulk(slice any, setFunc func(*LeaveRequestCreate, int)) *LeaveRequestCreateBulk {
+func (c *DepartmentClient) MapCreateBulk(slice any, setFunc func(*DepartmentCreate, int)) *DepartmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeaveRequestCreateBulk{err: fmt.Errorf("calling to LeaveRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DepartmentCreateBulk{err: fmt.Errorf("calling to DepartmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeaveRequestCreate, rv.Len())
+	builders := make([]*DepartmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+	return &DepartmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LeaveRequest.
-func (c *LeaveRequestClient) Update() *LeaveRequestUpdate {
-	mutation := newLeaveRequestMutation(c.config, OpUpdate)
-	return &LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Department.
+func (c *DepartmentClient) Update() *DepartmentUpdate {
+	mutation := newDepartmentMutation(c.config, OpUpdate)
+	return &DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeaveRequestClient) UpdateOne(lr *LeaveRequest) *LeaveRequestUpdateOne {
-	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequest(lr))
-	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DepartmentClient) UpdateOne(d *Department) *DepartmentUpdateOne {
+	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartment(d))
+	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeaveRequestClient) UpdateOneID(id int) *LeaveRequestUpdateOne {
-	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequestID(id))
-	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DepartmentClient) UpdateOneID(id int) *DepartmentUpdateOne {
+	mutation := newDepartmentMutation(c.config, OpUpdateOne, withDepartmentID(id))
+	return &DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LeaveRequest.
-func (c *LeaveRequestClient) Delete() *LeaveRequestDelete {
-	mutation := newLeaveRequestMutation(c.config, OpDelete)
-	return &LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Department.
+func (c *DepartmentClient) Delete() *DepartmentDelete {
+	mutation := newDepartmentMutation(c.config, OpDelete)
+	return &DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeaveRequestClient) DeleteOne(lr *LeaveRequest) *LeaveRequestDeleteOne {
-	return c.DeleteOneID(lr.ID)
+func (c *DepartmentClient) DeleteOne(d *Department) *DepartmentDeleteOne {
+	return c.DeleteOneID(d.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeaveRequestClient) DeleteOneID(id int) *LeaveRequestDeleteOne {
-	builder := c.Delete().Where(leaverequest.ID(id))
+func (c *DepartmentClient) DeleteOneID(id int) *DepartmentDeleteOne {
+	builder := c.Delete().Where(department.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeaveRequestDeleteOne{builder}
+	return &DepartmentDeleteOne{builder}
 }
 
-// Query returns a query builder for LeaveRequest.
-func (c *LeaveRequestClient) Query() *LeaveRequestQuery {
-	return &LeaveRequestQuery{
+// Query returns a query builder for Department.
+func (c *DepartmentClient) Query() *DepartmentQuery {
+	return &DepartmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLeaveRequest},
+		ctx:    &QueryContext{Type: TypeDepartment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LeaveRequest entity by its id.
-func (c *LeaveRequestClient) Get(ctx context.Context, id int) (*LeaveRequest, error) {
-	return c.Query().Where(leaverequest.ID(id)).Only(ctx)
+// Get returns a Department entity by its id.
+func (c *DepartmentClient) Get(ctx context.Context, id int) (*Department, error) {
+	return c.Query().Where(department.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeaveRequestClient) GetX(ctx context.Context, id int) *LeaveRequest {
+func (c *DepartmentClient) GetX(ctx context.Context, id int) *Department {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1316,180 +1516,180 @@ func (c *LeaveRequestClient) GetX(ctx context.Context, id int) *LeaveRequest {
 	return obj
 }
 
-// QueryLeaveApproves queries the leave_approves edge of a LeaveRequest.
-func (c *LeaveRequestClient) QueryLeaveApproves(lr *LeaveRequest) *LeaveApprovalQuery {
-	query := (&LeaveApprovalClient{config: c.config}).Query()
+// QueryPositions queries the positions edge of a Department.
+func (c *DepartmentClient) QueryPositions(d *Department) *PositionQuery {
+	query := (&PositionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lr.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
-			sqlgraph.To(leaveapproval.Table, leaveapproval.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, leaverequest.LeaveApprovesTable, leaverequest.LeaveApprovesColumn),
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.PositionsTable, department.PositionsColumn),
 		)
-		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryApplicant queries the applicant edge of a LeaveRequest.
-func (c *LeaveRequestClient) QueryApplicant(lr *LeaveRequest) *EmployeeQuery {
-	query := (&EmployeeClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a Department.
+func (c *DepartmentClient) QueryOrganization(d *Department) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lr.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
-			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.ApplicantTable, leaverequest.ApplicantColumn),
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, department.OrganizationTable, department.OrganizationColumn),
 		)
-		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryOrganization queries the organization edge of a LeaveRequest.
-func (c *LeaveRequestClient) QueryOrganization(lr *LeaveRequest) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryAppointmentHistories queries the appointment_histories edge of a Department.
+func (c *DepartmentClient) QueryAppointmentHistories(d *Department) *AppointmentHistoryQuery {
+	query := (&AppointmentHistoryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lr.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.OrganizationTable, leaverequest.OrganizationColumn),
+			sqlgraph.From(department.Table, department.FieldID, id),
+			sqlgraph.To(appointmenthistory.Table, appointmenthistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.AppointmentHistoriesTable, department.AppointmentHistoriesColumn),
 		)
-		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LeaveRequestClient) Hooks() []Hook {
-	return c.hooks.LeaveRequest
+func (c *DepartmentClient) Hooks() []Hook {
+	return c.hooks.Department
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeaveRequestClient) Interceptors() []Interceptor {
-	return c.inters.LeaveRequest
+func (c *DepartmentClient) Interceptors() []Interceptor {
+	return c.inters.Department
 }
 
-func (c *LeaveRequestClient) mutate(ctx context.Context, m *LeaveRequestMutation) (Value, error) {
+func (c *DepartmentClient) mutate(ctx context.Context, m *DepartmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DepartmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DepartmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DepartmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DepartmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LeaveRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Department mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationClient is a client for the Organization schema.
-type OrganizationClient struct {
+// EmployeeClient is a client for the Employee schema.
+type EmployeeClient struct {
 	config
 }
 
-// NewOrganizationClient returns a client for the Organization from the given config.
-func NewOrganizationClient(c config) *OrganizationClient {
-	return &OrganizationClient{config: c}
+// NewEmployeeClient returns a client for the Employee from the given config.
+func NewEmployeeClient(c config) *EmployeeClient {
+	return &EmployeeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
-func (c *OrganizationClient) Use(hooks ...Hook) {
-	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+// A call to `Use(f, g, h)` equals to `employee.Hooks(f(g(h())))`.
+func (c *EmployeeClient) Use(hooks ...Hook) {
+	c.hooks.Employee = append(c.hooks.Employee, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
-func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Organization = append(c.inters.Organization, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `employee.Intercept(f(g(h())))`.
+func (c *EmployeeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Employee = append(c.inters.Employee, interceptors...)
 }
 
-// Create returns a builder for creating a Organization entity.
-func (c *OrganizationClient) Create() *OrganizationCreate {
-	mutation := newOrganizationMutation(c.config, OpCreate)
-	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Employee entity.
+func (c *EmployeeClient) Create() *EmployeeCreate {
+	mutation := newEmployeeMutation(c.config, OpCreate)
+	return &EmployeeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Organization entities.
-func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Employee entities.
+func (c *EmployeeClient) CreateBulk(builders ...*EmployeeCreate) *EmployeeCreateBulk {
+	return &EmployeeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+func (c *EmployeeClient) MapCreateBulk(slice any, setFunc func(*EmployeeCreate, int)) *EmployeeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmployeeCreateBulk{err: fmt.Errorf("calling to EmployeeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationCreate, rv.Len())
+	builders := make([]*EmployeeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+	return &EmployeeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Organization.
-func (c *OrganizationClient) Update() *OrganizationUpdate {
-	mutation := newOrganizationMutation(c.config, OpUpdate)
-	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Employee.
+func (c *EmployeeClient) Update() *EmployeeUpdate {
+	mutation := newEmployeeMutation(c.config, OpUpdate)
+	return &EmployeeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmployeeClient) UpdateOne(e *Employee) *EmployeeUpdateOne {
+	mutation := newEmployeeMutation(c.config, OpUpdateOne, withEmployee(e))
+	return &EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmployeeClient) UpdateOneID(id int) *EmployeeUpdateOne {
+	mutation := newEmployeeMutation(c.config, OpUpdateOne, withEmployeeID(id))
+	return &EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Organization.
-func (c *OrganizationClient) Delete() *OrganizationDelete {
-	mutation := newOrganizationMutation(c.config, OpDelete)
-	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Employee.
+func (c *EmployeeClient) Delete() *EmployeeDelete {
+	mutation := newEmployeeMutation(c.config, OpDelete)
+	return &EmployeeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
-	return c.DeleteOneID(o.ID)
+func (c *EmployeeClient) DeleteOne(e *Employee) *EmployeeDeleteOne {
+	return c.DeleteOneID(e.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
-	builder := c.Delete().Where(organization.ID(id))
+func (c *EmployeeClient) DeleteOneID(id int) *EmployeeDeleteOne {
+	builder := c.Delete().Where(employee.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationDeleteOne{builder}
+	return &EmployeeDeleteOne{builder}
 }
 
-// Query returns a query builder for Organization.
-func (c *OrganizationClient) Query() *OrganizationQuery {
-	return &OrganizationQuery{
+// Query returns a query builder for Employee.
+func (c *EmployeeClient) Query() *EmployeeQuery {
+	return &EmployeeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganization},
+		ctx:    &QueryContext{Type: TypeEmployee},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Organization entity by its id.
-func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
-	return c.Query().Where(organization.ID(id)).Only(ctx)
+// Get returns a Employee entity by its id.
+func (c *EmployeeClient) Get(ctx context.Context, id int) (*Employee, error) {
+	return c.Query().Where(employee.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
+func (c *EmployeeClient) GetX(ctx context.Context, id int) *Employee {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1497,228 +1697,5962 @@ func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
 	return obj
 }
 
-// QueryParent queries the parent edge of a Organization.
-func (c *OrganizationClient) QueryParent(o *Organization) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryPosition queries the position edge of a Employee.
+func (c *EmployeeClient) QueryPosition(e *Employee) *PositionQuery {
+	query := (&PositionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := e.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organization.ParentTable, organization.ParentColumn),
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, employee.PositionTable, employee.PositionColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryChildren queries the children edge of a Organization.
-func (c *OrganizationClient) QueryChildren(o *Organization) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryCreatedProjects queries the created_projects edge of a Employee.
+func (c *EmployeeClient) QueryCreatedProjects(e *Employee) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := e.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.ChildrenTable, organization.ChildrenColumn),
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.CreatedProjectsTable, employee.CreatedProjectsColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryDepartments queries the departments edge of a Organization.
-func (c *OrganizationClient) QueryDepartments(o *Organization) *DepartmentQuery {
-	query := (&DepartmentClient{config: c.config}).Query()
+// QueryUpdatedProjects queries the updated_projects edge of a Employee.
+func (c *EmployeeClient) QueryUpdatedProjects(e *Employee) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := e.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(department.Table, department.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.DepartmentsTable, organization.DepartmentsColumn),
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.UpdatedProjectsTable, employee.UpdatedProjectsColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProjects queries the projects edge of a Organization.
-func (c *OrganizationClient) QueryProjects(o *Organization) *ProjectQuery {
+// QueryAssignedTasks queries the assigned_tasks edge of a Employee.
+func (c *EmployeeClient) QueryAssignedTasks(e *Employee) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, employee.AssignedTasksTable, employee.AssignedTasksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveApproves queries the leave_approves edge of a Employee.
+func (c *EmployeeClient) QueryLeaveApproves(e *Employee) *LeaveApprovalQuery {
+	query := (&LeaveApprovalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(leaveapproval.Table, leaveapproval.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.LeaveApprovesTable, employee.LeaveApprovesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveRequests queries the leave_requests edge of a Employee.
+func (c *EmployeeClient) QueryLeaveRequests(e *Employee) *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.LeaveRequestsTable, employee.LeaveRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTaskReports queries the task_reports edge of a Employee.
+func (c *EmployeeClient) QueryTaskReports(e *Employee) *TaskReportQuery {
+	query := (&TaskReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(taskreport.Table, taskreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.TaskReportsTable, employee.TaskReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProjects queries the projects edge of a Employee.
+func (c *EmployeeClient) QueryProjects(e *Employee) *ProjectQuery {
 	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := e.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.From(employee.Table, employee.FieldID, id),
 			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.ProjectsTable, organization.ProjectsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, employee.ProjectsTable, employee.ProjectsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryLabels queries the labels edge of a Organization.
-func (c *OrganizationClient) QueryLabels(o *Organization) *LabelQuery {
-	query := (&LabelClient{config: c.config}).Query()
+// QueryAppointmentHistories queries the appointment_histories edge of a Employee.
+func (c *EmployeeClient) QueryAppointmentHistories(e *Employee) *AppointmentHistoryQuery {
+	query := (&AppointmentHistoryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := e.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(label.Table, label.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.LabelsTable, organization.LabelsColumn),
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(appointmenthistory.Table, appointmenthistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AppointmentHistoriesTable, employee.AppointmentHistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContracts queries the contracts edge of a Employee.
+func (c *EmployeeClient) QueryContracts(e *Employee) *EmployeeContractQuery {
+	query := (&EmployeeContractClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(employeecontract.Table, employeecontract.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.ContractsTable, employee.ContractsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatusHistories queries the status_histories edge of a Employee.
+func (c *EmployeeClient) QueryStatusHistories(e *Employee) *EmployeeStatusHistoryQuery {
+	query := (&EmployeeStatusHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(employeestatushistory.Table, employeestatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.StatusHistoriesTable, employee.StatusHistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCompensations queries the compensations edge of a Employee.
+func (c *EmployeeClient) QueryCompensations(e *Employee) *CompensationQuery {
+	query := (&CompensationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(compensation.Table, compensation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.CompensationsTable, employee.CompensationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendanceRecords queries the attendance_records edge of a Employee.
+func (c *EmployeeClient) QueryAttendanceRecords(e *Employee) *AttendanceRecordQuery {
+	query := (&AttendanceRecordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(attendancerecord.Table, attendancerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendanceRecordsTable, employee.AttendanceRecordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendanceCorrections queries the attendance_corrections edge of a Employee.
+func (c *EmployeeClient) QueryAttendanceCorrections(e *Employee) *AttendanceCorrectionQuery {
+	query := (&AttendanceCorrectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(attendancecorrection.Table, attendancecorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendanceCorrectionsTable, employee.AttendanceCorrectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRosters queries the rosters edge of a Employee.
+func (c *EmployeeClient) QueryRosters(e *Employee) *RosterQuery {
+	query := (&RosterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(roster.Table, roster.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.RostersTable, employee.RostersColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOvertimeRequests queries the overtime_requests edge of a Employee.
+func (c *EmployeeClient) QueryOvertimeRequests(e *Employee) *OvertimeRequestQuery {
+	query := (&OvertimeRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(overtimerequest.Table, overtimerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.OvertimeRequestsTable, employee.OvertimeRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTaskComments queries the task_comments edge of a Employee.
+func (c *EmployeeClient) QueryTaskComments(e *Employee) *TaskCommentQuery {
+	query := (&TaskCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.TaskCommentsTable, employee.TaskCommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCommentMentions queries the comment_mentions edge of a Employee.
+func (c *EmployeeClient) QueryCommentMentions(e *Employee) *TaskCommentQuery {
+	query := (&TaskCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, employee.CommentMentionsTable, employee.CommentMentionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewedTaskReports queries the reviewed_task_reports edge of a Employee.
+func (c *EmployeeClient) QueryReviewedTaskReports(e *Employee) *TaskReportQuery {
+	query := (&TaskReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(taskreport.Table, taskreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.ReviewedTaskReportsTable, employee.ReviewedTaskReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a Employee.
+func (c *EmployeeClient) QueryAttachments(e *Employee) *AttachmentQuery {
+	query := (&AttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttachmentsTable, employee.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkLogs queries the work_logs edge of a Employee.
+func (c *EmployeeClient) QueryWorkLogs(e *Employee) *WorkLogQuery {
+	query := (&WorkLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, id),
+			sqlgraph.To(worklog.Table, worklog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.WorkLogsTable, employee.WorkLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmployeeClient) Hooks() []Hook {
+	return c.hooks.Employee
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmployeeClient) Interceptors() []Interceptor {
+	return c.inters.Employee
+}
+
+func (c *EmployeeClient) mutate(ctx context.Context, m *EmployeeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmployeeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmployeeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmployeeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmployeeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Employee mutation op: %q", m.Op())
+	}
+}
+
+// EmployeeContractClient is a client for the EmployeeContract schema.
+type EmployeeContractClient struct {
+	config
+}
+
+// NewEmployeeContractClient returns a client for the EmployeeContract from the given config.
+func NewEmployeeContractClient(c config) *EmployeeContractClient {
+	return &EmployeeContractClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `employeecontract.Hooks(f(g(h())))`.
+func (c *EmployeeContractClient) Use(hooks ...Hook) {
+	c.hooks.EmployeeContract = append(c.hooks.EmployeeContract, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `employeecontract.Intercept(f(g(h())))`.
+func (c *EmployeeContractClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmployeeContract = append(c.inters.EmployeeContract, interceptors...)
+}
+
+// Create returns a builder for creating a EmployeeContract entity.
+func (c *EmployeeContractClient) Create() *EmployeeContractCreate {
+	mutation := newEmployeeContractMutation(c.config, OpCreate)
+	return &EmployeeContractCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmployeeContract entities.
+func (c *EmployeeContractClient) CreateBulk(builders ...*EmployeeContractCreate) *EmployeeContractCreateBulk {
+	return &EmployeeContractCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmployeeContractClient) MapCreateBulk(slice any, setFunc func(*EmployeeContractCreate, int)) *EmployeeContractCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmployeeContractCreateBulk{err: fmt.Errorf("calling to EmployeeContractClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmployeeContractCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmployeeContractCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmployeeContract.
+func (c *EmployeeContractClient) Update() *EmployeeContractUpdate {
+	mutation := newEmployeeContractMutation(c.config, OpUpdate)
+	return &EmployeeContractUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmployeeContractClient) UpdateOne(ec *EmployeeContract) *EmployeeContractUpdateOne {
+	mutation := newEmployeeContractMutation(c.config, OpUpdateOne, withEmployeeContract(ec))
+	return &EmployeeContractUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmployeeContractClient) UpdateOneID(id int) *EmployeeContractUpdateOne {
+	mutation := newEmployeeContractMutation(c.config, OpUpdateOne, withEmployeeContractID(id))
+	return &EmployeeContractUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmployeeContract.
+func (c *EmployeeContractClient) Delete() *EmployeeContractDelete {
+	mutation := newEmployeeContractMutation(c.config, OpDelete)
+	return &EmployeeContractDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmployeeContractClient) DeleteOne(ec *EmployeeContract) *EmployeeContractDeleteOne {
+	return c.DeleteOneID(ec.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmployeeContractClient) DeleteOneID(id int) *EmployeeContractDeleteOne {
+	builder := c.Delete().Where(employeecontract.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmployeeContractDeleteOne{builder}
+}
+
+// Query returns a query builder for EmployeeContract.
+func (c *EmployeeContractClient) Query() *EmployeeContractQuery {
+	return &EmployeeContractQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmployeeContract},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmployeeContract entity by its id.
+func (c *EmployeeContractClient) Get(ctx context.Context, id int) (*EmployeeContract, error) {
+	return c.Query().Where(employeecontract.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmployeeContractClient) GetX(ctx context.Context, id int) *EmployeeContract {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a EmployeeContract.
+func (c *EmployeeContractClient) QueryEmployee(ec *EmployeeContract) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ec.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employeecontract.Table, employeecontract.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, employeecontract.EmployeeTable, employeecontract.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(ec.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmployeeContractClient) Hooks() []Hook {
+	return c.hooks.EmployeeContract
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmployeeContractClient) Interceptors() []Interceptor {
+	return c.inters.EmployeeContract
+}
+
+func (c *EmployeeContractClient) mutate(ctx context.Context, m *EmployeeContractMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmployeeContractCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmployeeContractUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmployeeContractUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmployeeContractDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmployeeContract mutation op: %q", m.Op())
+	}
+}
+
+// EmployeeStatusHistoryClient is a client for the EmployeeStatusHistory schema.
+type EmployeeStatusHistoryClient struct {
+	config
+}
+
+// NewEmployeeStatusHistoryClient returns a client for the EmployeeStatusHistory from the given config.
+func NewEmployeeStatusHistoryClient(c config) *EmployeeStatusHistoryClient {
+	return &EmployeeStatusHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `employeestatushistory.Hooks(f(g(h())))`.
+func (c *EmployeeStatusHistoryClient) Use(hooks ...Hook) {
+	c.hooks.EmployeeStatusHistory = append(c.hooks.EmployeeStatusHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `employeestatushistory.Intercept(f(g(h())))`.
+func (c *EmployeeStatusHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmployeeStatusHistory = append(c.inters.EmployeeStatusHistory, interceptors...)
+}
+
+// Create returns a builder for creating a EmployeeStatusHistory entity.
+func (c *EmployeeStatusHistoryClient) Create() *EmployeeStatusHistoryCreate {
+	mutation := newEmployeeStatusHistoryMutation(c.config, OpCreate)
+	return &EmployeeStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmployeeStatusHistory entities.
+func (c *EmployeeStatusHistoryClient) CreateBulk(builders ...*EmployeeStatusHistoryCreate) *EmployeeStatusHistoryCreateBulk {
+	return &EmployeeStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmployeeStatusHistoryClient) MapCreateBulk(slice any, setFunc func(*EmployeeStatusHistoryCreate, int)) *EmployeeStatusHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmployeeStatusHistoryCreateBulk{err: fmt.Errorf("calling to EmployeeStatusHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmployeeStatusHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmployeeStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmployeeStatusHistory.
+func (c *EmployeeStatusHistoryClient) Update() *EmployeeStatusHistoryUpdate {
+	mutation := newEmployeeStatusHistoryMutation(c.config, OpUpdate)
+	return &EmployeeStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmployeeStatusHistoryClient) UpdateOne(esh *EmployeeStatusHistory) *EmployeeStatusHistoryUpdateOne {
+	mutation := newEmployeeStatusHistoryMutation(c.config, OpUpdateOne, withEmployeeStatusHistory(esh))
+	return &EmployeeStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmployeeStatusHistoryClient) UpdateOneID(id int) *EmployeeStatusHistoryUpdateOne {
+	mutation := newEmployeeStatusHistoryMutation(c.config, OpUpdateOne, withEmployeeStatusHistoryID(id))
+	return &EmployeeStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmployeeStatusHistory.
+func (c *EmployeeStatusHistoryClient) Delete() *EmployeeStatusHistoryDelete {
+	mutation := newEmployeeStatusHistoryMutation(c.config, OpDelete)
+	return &EmployeeStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmployeeStatusHistoryClient) DeleteOne(esh *EmployeeStatusHistory) *EmployeeStatusHistoryDeleteOne {
+	return c.DeleteOneID(esh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmployeeStatusHistoryClient) DeleteOneID(id int) *EmployeeStatusHistoryDeleteOne {
+	builder := c.Delete().Where(employeestatushistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmployeeStatusHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for EmployeeStatusHistory.
+func (c *EmployeeStatusHistoryClient) Query() *EmployeeStatusHistoryQuery {
+	return &EmployeeStatusHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmployeeStatusHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmployeeStatusHistory entity by its id.
+func (c *EmployeeStatusHistoryClient) Get(ctx context.Context, id int) (*EmployeeStatusHistory, error) {
+	return c.Query().Where(employeestatushistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmployeeStatusHistoryClient) GetX(ctx context.Context, id int) *EmployeeStatusHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a EmployeeStatusHistory.
+func (c *EmployeeStatusHistoryClient) QueryEmployee(esh *EmployeeStatusHistory) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := esh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employeestatushistory.Table, employeestatushistory.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, employeestatushistory.EmployeeTable, employeestatushistory.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(esh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmployeeStatusHistoryClient) Hooks() []Hook {
+	return c.hooks.EmployeeStatusHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmployeeStatusHistoryClient) Interceptors() []Interceptor {
+	return c.inters.EmployeeStatusHistory
+}
+
+func (c *EmployeeStatusHistoryClient) mutate(ctx context.Context, m *EmployeeStatusHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmployeeStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmployeeStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmployeeStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmployeeStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmployeeStatusHistory mutation op: %q", m.Op())
+	}
+}
+
+// HolidayClient is a client for the Holiday schema.
+type HolidayClient struct {
+	config
+}
+
+// NewHolidayClient returns a client for the Holiday from the given config.
+func NewHolidayClient(c config) *HolidayClient {
+	return &HolidayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `holiday.Hooks(f(g(h())))`.
+func (c *HolidayClient) Use(hooks ...Hook) {
+	c.hooks.Holiday = append(c.hooks.Holiday, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `holiday.Intercept(f(g(h())))`.
+func (c *HolidayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Holiday = append(c.inters.Holiday, interceptors...)
+}
+
+// Create returns a builder for creating a Holiday entity.
+func (c *HolidayClient) Create() *HolidayCreate {
+	mutation := newHolidayMutation(c.config, OpCreate)
+	return &HolidayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Holiday entities.
+func (c *HolidayClient) CreateBulk(builders ...*HolidayCreate) *HolidayCreateBulk {
+	return &HolidayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HolidayClient) MapCreateBulk(slice any, setFunc func(*HolidayCreate, int)) *HolidayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HolidayCreateBulk{err: fmt.Errorf("calling to HolidayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HolidayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HolidayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Holiday.
+func (c *HolidayClient) Update() *HolidayUpdate {
+	mutation := newHolidayMutation(c.config, OpUpdate)
+	return &HolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HolidayClient) UpdateOne(h *Holiday) *HolidayUpdateOne {
+	mutation := newHolidayMutation(c.config, OpUpdateOne, withHoliday(h))
+	return &HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HolidayClient) UpdateOneID(id int) *HolidayUpdateOne {
+	mutation := newHolidayMutation(c.config, OpUpdateOne, withHolidayID(id))
+	return &HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Holiday.
+func (c *HolidayClient) Delete() *HolidayDelete {
+	mutation := newHolidayMutation(c.config, OpDelete)
+	return &HolidayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HolidayClient) DeleteOne(h *Holiday) *HolidayDeleteOne {
+	return c.DeleteOneID(h.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HolidayClient) DeleteOneID(id int) *HolidayDeleteOne {
+	builder := c.Delete().Where(holiday.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HolidayDeleteOne{builder}
+}
+
+// Query returns a query builder for Holiday.
+func (c *HolidayClient) Query() *HolidayQuery {
+	return &HolidayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHoliday},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Holiday entity by its id.
+func (c *HolidayClient) Get(ctx context.Context, id int) (*Holiday, error) {
+	return c.Query().Where(holiday.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HolidayClient) GetX(ctx context.Context, id int) *Holiday {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Holiday.
+func (c *HolidayClient) QueryOrganization(h *Holiday) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := h.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(holiday.Table, holiday.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, holiday.OrganizationTable, holiday.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HolidayClient) Hooks() []Hook {
+	return c.hooks.Holiday
+}
+
+// Interceptors returns the client interceptors.
+func (c *HolidayClient) Interceptors() []Interceptor {
+	return c.inters.Holiday
+}
+
+func (c *HolidayClient) mutate(ctx context.Context, m *HolidayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HolidayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HolidayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Holiday mutation op: %q", m.Op())
+	}
+}
+
+// LabelClient is a client for the Label schema.
+type LabelClient struct {
+	config
+}
+
+// NewLabelClient returns a client for the Label from the given config.
+func NewLabelClient(c config) *LabelClient {
+	return &LabelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `label.Hooks(f(g(h())))`.
+func (c *LabelClient) Use(hooks ...Hook) {
+	c.hooks.Label = append(c.hooks.Label, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `label.Intercept(f(g(h())))`.
+func (c *LabelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Label = append(c.inters.Label, interceptors...)
+}
+
+// Create returns a builder for creating a Label entity.
+func (c *LabelClient) Create() *LabelCreate {
+	mutation := newLabelMutation(c.config, OpCreate)
+	return &LabelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Label entities.
+func (c *LabelClient) CreateBulk(builders ...*LabelCreate) *LabelCreateBulk {
+	return &LabelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LabelClient) MapCreateBulk(slice any, setFunc func(*LabelCreate, int)) *LabelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LabelCreateBulk{err: fmt.Errorf("calling to LabelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LabelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LabelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Label.
+func (c *LabelClient) Update() *LabelUpdate {
+	mutation := newLabelMutation(c.config, OpUpdate)
+	return &LabelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LabelClient) UpdateOne(l *Label) *LabelUpdateOne {
+	mutation := newLabelMutation(c.config, OpUpdateOne, withLabel(l))
+	return &LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LabelClient) UpdateOneID(id int) *LabelUpdateOne {
+	mutation := newLabelMutation(c.config, OpUpdateOne, withLabelID(id))
+	return &LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Label.
+func (c *LabelClient) Delete() *LabelDelete {
+	mutation := newLabelMutation(c.config, OpDelete)
+	return &LabelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LabelClient) DeleteOne(l *Label) *LabelDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LabelClient) DeleteOneID(id int) *LabelDeleteOne {
+	builder := c.Delete().Where(label.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LabelDeleteOne{builder}
+}
+
+// Query returns a query builder for Label.
+func (c *LabelClient) Query() *LabelQuery {
+	return &LabelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLabel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Label entity by its id.
+func (c *LabelClient) Get(ctx context.Context, id int) (*Label, error) {
+	return c.Query().Where(label.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LabelClient) GetX(ctx context.Context, id int) *Label {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTasks queries the tasks edge of a Label.
+func (c *LabelClient) QueryTasks(l *Label) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(label.Table, label.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, label.TasksTable, label.TasksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a Label.
+func (c *LabelClient) QueryOrganization(l *Label) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(label.Table, label.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, label.OrganizationTable, label.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LabelClient) Hooks() []Hook {
+	return c.hooks.Label
+}
+
+// Interceptors returns the client interceptors.
+func (c *LabelClient) Interceptors() []Interceptor {
+	return c.inters.Label
+}
+
+func (c *LabelClient) mutate(ctx context.Context, m *LabelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LabelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LabelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LabelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LabelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Label mutation op: %q", m.Op())
+	}
+}
+
+// LeaveApprovalClient is a client for the LeaveApproval schema.
+type LeaveApprovalClient struct {
+	config
+}
+
+// NewLeaveApprovalClient returns a client for the LeaveApproval from the given config.
+func NewLeaveApprovalClient(c config) *LeaveApprovalClient {
+	return &LeaveApprovalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaveapproval.Hooks(f(g(h())))`.
+func (c *LeaveApprovalClient) Use(hooks ...Hook) {
+	c.hooks.LeaveApproval = append(c.hooks.LeaveApproval, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaveapproval.Intercept(f(g(h())))`.
+func (c *LeaveApprovalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveApproval = append(c.inters.LeaveApproval, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveApproval entity.
+func (c *LeaveApprovalClient) Create() *LeaveApprovalCreate {
+	mutation := newLeaveApprovalMutation(c.config, OpCreate)
+	return &LeaveApprovalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveApproval entities.
+func (c *LeaveApprovalClient) CreateBulk(builders ...*LeaveApprovalCreate) *LeaveApprovalCreateBulk {
+	return &LeaveApprovalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaveApprovalClient) MapCreateBulk(slice any, setFunc func(*LeaveApprovalCreate, int)) *LeaveApprovalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaveApprovalCreateBulk{err: fmt.Errorf("calling to LeaveApprovalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaveApprovalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaveApprovalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveApproval.
+func (c *LeaveApprovalClient) Update() *LeaveApprovalUpdate {
+	mutation := newLeaveApprovalMutation(c.config, OpUpdate)
+	return &LeaveApprovalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveApprovalClient) UpdateOne(la *LeaveApproval) *LeaveApprovalUpdateOne {
+	mutation := newLeaveApprovalMutation(c.config, OpUpdateOne, withLeaveApproval(la))
+	return &LeaveApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveApprovalClient) UpdateOneID(id int) *LeaveApprovalUpdateOne {
+	mutation := newLeaveApprovalMutation(c.config, OpUpdateOne, withLeaveApprovalID(id))
+	return &LeaveApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveApproval.
+func (c *LeaveApprovalClient) Delete() *LeaveApprovalDelete {
+	mutation := newLeaveApprovalMutation(c.config, OpDelete)
+	return &LeaveApprovalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveApprovalClient) DeleteOne(la *LeaveApproval) *LeaveApprovalDeleteOne {
+	return c.DeleteOneID(la.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveApprovalClient) DeleteOneID(id int) *LeaveApprovalDeleteOne {
+	builder := c.Delete().Where(leaveapproval.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveApprovalDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveApproval.
+func (c *LeaveApprovalClient) Query() *LeaveApprovalQuery {
+	return &LeaveApprovalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveApproval},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveApproval entity by its id.
+func (c *LeaveApprovalClient) Get(ctx context.Context, id int) (*LeaveApproval, error) {
+	return c.Query().Where(leaveapproval.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveApprovalClient) GetX(ctx context.Context, id int) *LeaveApproval {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLeaveRequest queries the leave_request edge of a LeaveApproval.
+func (c *LeaveApprovalClient) QueryLeaveRequest(la *LeaveApproval) *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := la.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaveapproval.Table, leaveapproval.FieldID, id),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaveapproval.LeaveRequestTable, leaveapproval.LeaveRequestColumn),
+		)
+		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewer queries the reviewer edge of a LeaveApproval.
+func (c *LeaveApprovalClient) QueryReviewer(la *LeaveApproval) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := la.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaveapproval.Table, leaveapproval.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaveapproval.ReviewerTable, leaveapproval.ReviewerColumn),
+		)
+		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveApprovalClient) Hooks() []Hook {
+	return c.hooks.LeaveApproval
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveApprovalClient) Interceptors() []Interceptor {
+	return c.inters.LeaveApproval
+}
+
+func (c *LeaveApprovalClient) mutate(ctx context.Context, m *LeaveApprovalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveApprovalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveApprovalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveApprovalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveApproval mutation op: %q", m.Op())
+	}
+}
+
+// LeaveRequestClient is a client for the LeaveRequest schema.
+type LeaveRequestClient struct {
+	config
+}
+
+// NewLeaveRequestClient returns a client for the LeaveRequest from the given config.
+func NewLeaveRequestClient(c config) *LeaveRequestClient {
+	return &LeaveRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaverequest.Hooks(f(g(h())))`.
+func (c *LeaveRequestClient) Use(hooks ...Hook) {
+	c.hooks.LeaveRequest = append(c.hooks.LeaveRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaverequest.Intercept(f(g(h())))`.
+func (c *LeaveRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveRequest = append(c.inters.LeaveRequest, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveRequest entity.
+func (c *LeaveRequestClient) Create() *LeaveRequestCreate {
+	mutation := newLeaveRequestMutation(c.config, OpCreate)
+	return &LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveRequest entities.
+func (c *LeaveRequestClient) CreateBulk(builders ...*LeaveRequestCreate) *LeaveRequestCreateBulk {
+	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaveRequestClient) MapCreateBulk(slice any, setFunc func(*LeaveRequestCreate, int)) *LeaveRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaveRequestCreateBulk{err: fmt.Errorf("calling to LeaveRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaveRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveRequest.
+func (c *LeaveRequestClient) Update() *LeaveRequestUpdate {
+	mutation := newLeaveRequestMutation(c.config, OpUpdate)
+	return &LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveRequestClient) UpdateOne(lr *LeaveRequest) *LeaveRequestUpdateOne {
+	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequest(lr))
+	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveRequestClient) UpdateOneID(id int) *LeaveRequestUpdateOne {
+	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequestID(id))
+	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveRequest.
+func (c *LeaveRequestClient) Delete() *LeaveRequestDelete {
+	mutation := newLeaveRequestMutation(c.config, OpDelete)
+	return &LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveRequestClient) DeleteOne(lr *LeaveRequest) *LeaveRequestDeleteOne {
+	return c.DeleteOneID(lr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveRequestClient) DeleteOneID(id int) *LeaveRequestDeleteOne {
+	builder := c.Delete().Where(leaverequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveRequest.
+func (c *LeaveRequestClient) Query() *LeaveRequestQuery {
+	return &LeaveRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveRequest entity by its id.
+func (c *LeaveRequestClient) Get(ctx context.Context, id int) (*LeaveRequest, error) {
+	return c.Query().Where(leaverequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveRequestClient) GetX(ctx context.Context, id int) *LeaveRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLeaveApproves queries the leave_approves edge of a LeaveRequest.
+func (c *LeaveRequestClient) QueryLeaveApproves(lr *LeaveRequest) *LeaveApprovalQuery {
+	query := (&LeaveApprovalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
+			sqlgraph.To(leaveapproval.Table, leaveapproval.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, leaverequest.LeaveApprovesTable, leaverequest.LeaveApprovesColumn),
+		)
+		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApplicant queries the applicant edge of a LeaveRequest.
+func (c *LeaveRequestClient) QueryApplicant(lr *LeaveRequest) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.ApplicantTable, leaverequest.ApplicantColumn),
+		)
+		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a LeaveRequest.
+func (c *LeaveRequestClient) QueryOrganization(lr *LeaveRequest) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.OrganizationTable, leaverequest.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(lr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveRequestClient) Hooks() []Hook {
+	return c.hooks.LeaveRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveRequestClient) Interceptors() []Interceptor {
+	return c.inters.LeaveRequest
+}
+
+func (c *LeaveRequestClient) mutate(ctx context.Context, m *LeaveRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveRequest mutation op: %q", m.Op())
+	}
+}
+
+// MilestoneClient is a client for the Milestone schema.
+type MilestoneClient struct {
+	config
+}
+
+// NewMilestoneClient returns a client for the Milestone from the given config.
+func NewMilestoneClient(c config) *MilestoneClient {
+	return &MilestoneClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `milestone.Hooks(f(g(h())))`.
+func (c *MilestoneClient) Use(hooks ...Hook) {
+	c.hooks.Milestone = append(c.hooks.Milestone, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `milestone.Intercept(f(g(h())))`.
+func (c *MilestoneClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Milestone = append(c.inters.Milestone, interceptors...)
+}
+
+// Create returns a builder for creating a Milestone entity.
+func (c *MilestoneClient) Create() *MilestoneCreate {
+	mutation := newMilestoneMutation(c.config, OpCreate)
+	return &MilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Milestone entities.
+func (c *MilestoneClient) CreateBulk(builders ...*MilestoneCreate) *MilestoneCreateBulk {
+	return &MilestoneCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MilestoneClient) MapCreateBulk(slice any, setFunc func(*MilestoneCreate, int)) *MilestoneCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MilestoneCreateBulk{err: fmt.Errorf("calling to MilestoneClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MilestoneCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MilestoneCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Milestone.
+func (c *MilestoneClient) Update() *MilestoneUpdate {
+	mutation := newMilestoneMutation(c.config, OpUpdate)
+	return &MilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MilestoneClient) UpdateOne(m *Milestone) *MilestoneUpdateOne {
+	mutation := newMilestoneMutation(c.config, OpUpdateOne, withMilestone(m))
+	return &MilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MilestoneClient) UpdateOneID(id int) *MilestoneUpdateOne {
+	mutation := newMilestoneMutation(c.config, OpUpdateOne, withMilestoneID(id))
+	return &MilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Milestone.
+func (c *MilestoneClient) Delete() *MilestoneDelete {
+	mutation := newMilestoneMutation(c.config, OpDelete)
+	return &MilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MilestoneClient) DeleteOne(m *Milestone) *MilestoneDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MilestoneClient) DeleteOneID(id int) *MilestoneDeleteOne {
+	builder := c.Delete().Where(milestone.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MilestoneDeleteOne{builder}
+}
+
+// Query returns a query builder for Milestone.
+func (c *MilestoneClient) Query() *MilestoneQuery {
+	return &MilestoneQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMilestone},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Milestone entity by its id.
+func (c *MilestoneClient) Get(ctx context.Context, id int) (*Milestone, error) {
+	return c.Query().Where(milestone.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MilestoneClient) GetX(ctx context.Context, id int) *Milestone {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Milestone.
+func (c *MilestoneClient) QueryProject(m *Milestone) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(milestone.Table, milestone.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, milestone.ProjectTable, milestone.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTasks queries the tasks edge of a Milestone.
+func (c *MilestoneClient) QueryTasks(m *Milestone) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(milestone.Table, milestone.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, milestone.TasksTable, milestone.TasksColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MilestoneClient) Hooks() []Hook {
+	return c.hooks.Milestone
+}
+
+// Interceptors returns the client interceptors.
+func (c *MilestoneClient) Interceptors() []Interceptor {
+	return c.inters.Milestone
+}
+
+func (c *MilestoneClient) mutate(ctx context.Context, m *MilestoneMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Milestone mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(np *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(np))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id int) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(np *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(np.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id int) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id int) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id int) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
+}
+
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(o *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(o))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationClient) DeleteOne(o *Organization) *OrganizationDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParent queries the parent edge of a Organization.
+func (c *OrganizationClient) QueryParent(o *Organization) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organization.ParentTable, organization.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Organization.
+func (c *OrganizationClient) QueryChildren(o *Organization) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ChildrenTable, organization.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDepartments queries the departments edge of a Organization.
+func (c *OrganizationClient) QueryDepartments(o *Organization) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.DepartmentsTable, organization.DepartmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProjects queries the projects edge of a Organization.
+func (c *OrganizationClient) QueryProjects(o *Organization) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ProjectsTable, organization.ProjectsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLabels queries the labels edge of a Organization.
+func (c *OrganizationClient) QueryLabels(o *Organization) *LabelQuery {
+	query := (&LabelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(label.Table, label.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.LabelsTable, organization.LabelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLeaveRequests queries the leave_requests edge of a Organization.
+func (c *OrganizationClient) QueryLeaveRequests(o *Organization) *LeaveRequestQuery {
+	query := (&LeaveRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.LeaveRequestsTable, organization.LeaveRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySalaryGrades queries the salary_grades edge of a Organization.
+func (c *OrganizationClient) QuerySalaryGrades(o *Organization) *SalaryGradeQuery {
+	query := (&SalaryGradeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(salarygrade.Table, salarygrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.SalaryGradesTable, organization.SalaryGradesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttendanceRecords queries the attendance_records edge of a Organization.
+func (c *OrganizationClient) QueryAttendanceRecords(o *Organization) *AttendanceRecordQuery {
+	query := (&AttendanceRecordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(attendancerecord.Table, attendancerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.AttendanceRecordsTable, organization.AttendanceRecordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShifts queries the shifts edge of a Organization.
+func (c *OrganizationClient) QueryShifts(o *Organization) *ShiftQuery {
+	query := (&ShiftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(shift.Table, shift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ShiftsTable, organization.ShiftsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHolidays queries the holidays edge of a Organization.
+func (c *OrganizationClient) QueryHolidays(o *Organization) *HolidayQuery {
+	query := (&HolidayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(holiday.Table, holiday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.HolidaysTable, organization.HolidaysColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a Organization.
+func (c *OrganizationClient) QueryAttachments(o *Organization) *AttachmentQuery {
+	query := (&AttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.AttachmentsTable, organization.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
+}
+
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+	}
+}
+
+// OvertimeRequestClient is a client for the OvertimeRequest schema.
+type OvertimeRequestClient struct {
+	config
+}
+
+// NewOvertimeRequestClient returns a client for the OvertimeRequest from the given config.
+func NewOvertimeRequestClient(c config) *OvertimeRequestClient {
+	return &OvertimeRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `overtimerequest.Hooks(f(g(h())))`.
+func (c *OvertimeRequestClient) Use(hooks ...Hook) {
+	c.hooks.OvertimeRequest = append(c.hooks.OvertimeRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `overtimerequest.Intercept(f(g(h())))`.
+func (c *OvertimeRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OvertimeRequest = append(c.inters.OvertimeRequest, interceptors...)
+}
+
+// Create returns a builder for creating a OvertimeRequest entity.
+func (c *OvertimeRequestClient) Create() *OvertimeRequestCreate {
+	mutation := newOvertimeRequestMutation(c.config, OpCreate)
+	return &OvertimeRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OvertimeRequest entities.
+func (c *OvertimeRequestClient) CreateBulk(builders ...*OvertimeRequestCreate) *OvertimeRequestCreateBulk {
+	return &OvertimeRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OvertimeRequestClient) MapCreateBulk(slice any, setFunc func(*OvertimeRequestCreate, int)) *OvertimeRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OvertimeRequestCreateBulk{err: fmt.Errorf("calling to OvertimeRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OvertimeRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OvertimeRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OvertimeRequest.
+func (c *OvertimeRequestClient) Update() *OvertimeRequestUpdate {
+	mutation := newOvertimeRequestMutation(c.config, OpUpdate)
+	return &OvertimeRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OvertimeRequestClient) UpdateOne(or *OvertimeRequest) *OvertimeRequestUpdateOne {
+	mutation := newOvertimeRequestMutation(c.config, OpUpdateOne, withOvertimeRequest(or))
+	return &OvertimeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OvertimeRequestClient) UpdateOneID(id int) *OvertimeRequestUpdateOne {
+	mutation := newOvertimeRequestMutation(c.config, OpUpdateOne, withOvertimeRequestID(id))
+	return &OvertimeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OvertimeRequest.
+func (c *OvertimeRequestClient) Delete() *OvertimeRequestDelete {
+	mutation := newOvertimeRequestMutation(c.config, OpDelete)
+	return &OvertimeRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OvertimeRequestClient) DeleteOne(or *OvertimeRequest) *OvertimeRequestDeleteOne {
+	return c.DeleteOneID(or.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OvertimeRequestClient) DeleteOneID(id int) *OvertimeRequestDeleteOne {
+	builder := c.Delete().Where(overtimerequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OvertimeRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for OvertimeRequest.
+func (c *OvertimeRequestClient) Query() *OvertimeRequestQuery {
+	return &OvertimeRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOvertimeRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OvertimeRequest entity by its id.
+func (c *OvertimeRequestClient) Get(ctx context.Context, id int) (*OvertimeRequest, error) {
+	return c.Query().Where(overtimerequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OvertimeRequestClient) GetX(ctx context.Context, id int) *OvertimeRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a OvertimeRequest.
+func (c *OvertimeRequestClient) QueryEmployee(or *OvertimeRequest) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := or.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimerequest.Table, overtimerequest.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtimerequest.EmployeeTable, overtimerequest.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(or.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProject queries the project edge of a OvertimeRequest.
+func (c *OvertimeRequestClient) QueryProject(or *OvertimeRequest) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := or.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimerequest.Table, overtimerequest.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtimerequest.ProjectTable, overtimerequest.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(or.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTask queries the task edge of a OvertimeRequest.
+func (c *OvertimeRequestClient) QueryTask(or *OvertimeRequest) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := or.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimerequest.Table, overtimerequest.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtimerequest.TaskTable, overtimerequest.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(or.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OvertimeRequestClient) Hooks() []Hook {
+	return c.hooks.OvertimeRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *OvertimeRequestClient) Interceptors() []Interceptor {
+	return c.inters.OvertimeRequest
+}
+
+func (c *OvertimeRequestClient) mutate(ctx context.Context, m *OvertimeRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OvertimeRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OvertimeRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OvertimeRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OvertimeRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OvertimeRequest mutation op: %q", m.Op())
+	}
+}
+
+// PositionClient is a client for the Position schema.
+type PositionClient struct {
+	config
+}
+
+// NewPositionClient returns a client for the Position from the given config.
+func NewPositionClient(c config) *PositionClient {
+	return &PositionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `position.Hooks(f(g(h())))`.
+func (c *PositionClient) Use(hooks ...Hook) {
+	c.hooks.Position = append(c.hooks.Position, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `position.Intercept(f(g(h())))`.
+func (c *PositionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Position = append(c.inters.Position, interceptors...)
+}
+
+// Create returns a builder for creating a Position entity.
+func (c *PositionClient) Create() *PositionCreate {
+	mutation := newPositionMutation(c.config, OpCreate)
+	return &PositionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Position entities.
+func (c *PositionClient) CreateBulk(builders ...*PositionCreate) *PositionCreateBulk {
+	return &PositionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PositionClient) MapCreateBulk(slice any, setFunc func(*PositionCreate, int)) *PositionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PositionCreateBulk{err: fmt.Errorf("calling to PositionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PositionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PositionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Position.
+func (c *PositionClient) Update() *PositionUpdate {
+	mutation := newPositionMutation(c.config, OpUpdate)
+	return &PositionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PositionClient) UpdateOne(po *Position) *PositionUpdateOne {
+	mutation := newPositionMutation(c.config, OpUpdateOne, withPosition(po))
+	return &PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PositionClient) UpdateOneID(id int) *PositionUpdateOne {
+	mutation := newPositionMutation(c.config, OpUpdateOne, withPositionID(id))
+	return &PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Position.
+func (c *PositionClient) Delete() *PositionDelete {
+	mutation := newPositionMutation(c.config, OpDelete)
+	return &PositionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PositionClient) DeleteOne(po *Position) *PositionDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PositionClient) DeleteOneID(id int) *PositionDeleteOne {
+	builder := c.Delete().Where(position.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PositionDeleteOne{builder}
+}
+
+// Query returns a query builder for Position.
+func (c *PositionClient) Query() *PositionQuery {
+	return &PositionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePosition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Position entity by its id.
+func (c *PositionClient) Get(ctx context.Context, id int) (*Position, error) {
+	return c.Query().Where(position.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PositionClient) GetX(ctx context.Context, id int) *Position {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployees queries the employees edge of a Position.
+func (c *PositionClient) QueryEmployees(po *Position) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, position.EmployeesTable, position.EmployeesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDepartment queries the department edge of a Position.
+func (c *PositionClient) QueryDepartment(po *Position) *DepartmentQuery {
+	query := (&DepartmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, id),
+			sqlgraph.To(department.Table, department.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, position.DepartmentTable, position.DepartmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Position.
+func (c *PositionClient) QueryChildren(po *Position) *PositionQuery {
+	query := (&PositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, id),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, position.ChildrenTable, position.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Position.
+func (c *PositionClient) QueryParent(po *Position) *PositionQuery {
+	query := (&PositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, id),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, position.ParentTable, position.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAppointmentHistories queries the appointment_histories edge of a Position.
+func (c *PositionClient) QueryAppointmentHistories(po *Position) *AppointmentHistoryQuery {
+	query := (&AppointmentHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, id),
+			sqlgraph.To(appointmenthistory.Table, appointmenthistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, position.AppointmentHistoriesTable, position.AppointmentHistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySalaryGrades queries the salary_grades edge of a Position.
+func (c *PositionClient) QuerySalaryGrades(po *Position) *SalaryGradeQuery {
+	query := (&SalaryGradeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, id),
+			sqlgraph.To(salarygrade.Table, salarygrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, position.SalaryGradesTable, position.SalaryGradesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PositionClient) Hooks() []Hook {
+	return c.hooks.Position
+}
+
+// Interceptors returns the client interceptors.
+func (c *PositionClient) Interceptors() []Interceptor {
+	return c.inters.Position
+}
+
+func (c *PositionClient) mutate(ctx context.Context, m *PositionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PositionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PositionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PositionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Position mutation op: %q", m.Op())
+	}
+}
+
+// ProjectClient is a client for the Project schema.
+type ProjectClient struct {
+	config
+}
+
+// NewProjectClient returns a client for the Project from the given config.
+func NewProjectClient(c config) *ProjectClient {
+	return &ProjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
+func (c *ProjectClient) Use(hooks ...Hook) {
+	c.hooks.Project = append(c.hooks.Project, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
+func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Project = append(c.inters.Project, interceptors...)
+}
+
+// Create returns a builder for creating a Project entity.
+func (c *ProjectClient) Create() *ProjectCreate {
+	mutation := newProjectMutation(c.config, OpCreate)
+	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Project entities.
+func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Project.
+func (c *ProjectClient) Update() *ProjectUpdate {
+	mutation := newProjectMutation(c.config, OpUpdate)
+	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProjectClient) UpdateOne(pr *Project) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(pr))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProjectClient) UpdateOneID(id int) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Project.
+func (c *ProjectClient) Delete() *ProjectDelete {
+	mutation := newProjectMutation(c.config, OpDelete)
+	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProjectClient) DeleteOne(pr *Project) *ProjectDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProjectClient) DeleteOneID(id int) *ProjectDeleteOne {
+	builder := c.Delete().Where(project.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProjectDeleteOne{builder}
+}
+
+// Query returns a query builder for Project.
+func (c *ProjectClient) Query() *ProjectQuery {
+	return &ProjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProject},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Project entity by its id.
+func (c *ProjectClient) Get(ctx context.Context, id int) (*Project, error) {
+	return c.Query().Where(project.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTasks queries the tasks edge of a Project.
+func (c *ProjectClient) QueryTasks(pr *Project) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TasksTable, project.TasksColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a Project.
+func (c *ProjectClient) QueryOrganization(pr *Project) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, project.OrganizationTable, project.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreator queries the creator edge of a Project.
+func (c *ProjectClient) QueryCreator(pr *Project) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, project.CreatorTable, project.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUpdater queries the updater edge of a Project.
+func (c *ProjectClient) QueryUpdater(pr *Project) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, project.UpdaterTable, project.UpdaterColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Project.
+func (c *ProjectClient) QueryMembers(pr *Project) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, project.MembersTable, project.MembersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOvertimeRequests queries the overtime_requests edge of a Project.
+func (c *ProjectClient) QueryOvertimeRequests(pr *Project) *OvertimeRequestQuery {
+	query := (&OvertimeRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(overtimerequest.Table, overtimerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.OvertimeRequestsTable, project.OvertimeRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflowStatuses queries the workflow_statuses edge of a Project.
+func (c *ProjectClient) QueryWorkflowStatuses(pr *Project) *WorkflowStatusQuery {
+	query := (&WorkflowStatusClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(workflowstatus.Table, workflowstatus.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStatusesTable, project.WorkflowStatusesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflowTransitions queries the workflow_transitions edge of a Project.
+func (c *ProjectClient) QueryWorkflowTransitions(pr *Project) *WorkflowTransitionQuery {
+	query := (&WorkflowTransitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(workflowtransition.Table, workflowtransition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowTransitionsTable, project.WorkflowTransitionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySprints queries the sprints edge of a Project.
+func (c *ProjectClient) QuerySprints(pr *Project) *SprintQuery {
+	query := (&SprintClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(sprint.Table, sprint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.SprintsTable, project.SprintsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMilestones queries the milestones edge of a Project.
+func (c *ProjectClient) QueryMilestones(pr *Project) *MilestoneQuery {
+	query := (&MilestoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(milestone.Table, milestone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.MilestonesTable, project.MilestonesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTaskTemplates queries the task_templates edge of a Project.
+func (c *ProjectClient) QueryTaskTemplates(pr *Project) *TaskTemplateQuery {
+	query := (&TaskTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(tasktemplate.Table, tasktemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TaskTemplatesTable, project.TaskTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTaskViews queries the task_views edge of a Project.
+func (c *ProjectClient) QueryTaskViews(pr *Project) *TaskViewQuery {
+	query := (&TaskViewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(taskview.Table, taskview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TaskViewsTable, project.TaskViewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoles queries the roles edge of a Project.
+func (c *ProjectClient) QueryRoles(pr *Project) *ProjectRoleQuery {
+	query := (&ProjectRoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(projectrole.Table, projectrole.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.RolesTable, project.RolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProjectClient) Hooks() []Hook {
+	return c.hooks.Project
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProjectClient) Interceptors() []Interceptor {
+	return c.inters.Project
+}
+
+func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Project mutation op: %q", m.Op())
+	}
+}
+
+// ProjectRoleClient is a client for the ProjectRole schema.
+type ProjectRoleClient struct {
+	config
+}
+
+// NewProjectRoleClient returns a client for the ProjectRole from the given config.
+func NewProjectRoleClient(c config) *ProjectRoleClient {
+	return &ProjectRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `projectrole.Hooks(f(g(h())))`.
+func (c *ProjectRoleClient) Use(hooks ...Hook) {
+	c.hooks.ProjectRole = append(c.hooks.ProjectRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `projectrole.Intercept(f(g(h())))`.
+func (c *ProjectRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProjectRole = append(c.inters.ProjectRole, interceptors...)
+}
+
+// Create returns a builder for creating a ProjectRole entity.
+func (c *ProjectRoleClient) Create() *ProjectRoleCreate {
+	mutation := newProjectRoleMutation(c.config, OpCreate)
+	return &ProjectRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProjectRole entities.
+func (c *ProjectRoleClient) CreateBulk(builders ...*ProjectRoleCreate) *ProjectRoleCreateBulk {
+	return &ProjectRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProjectRoleClient) MapCreateBulk(slice any, setFunc func(*ProjectRoleCreate, int)) *ProjectRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProjectRoleCreateBulk{err: fmt.Errorf("calling to ProjectRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProjectRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProjectRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProjectRole.
+func (c *ProjectRoleClient) Update() *ProjectRoleUpdate {
+	mutation := newProjectRoleMutation(c.config, OpUpdate)
+	return &ProjectRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProjectRoleClient) UpdateOne(pr *ProjectRole) *ProjectRoleUpdateOne {
+	mutation := newProjectRoleMutation(c.config, OpUpdateOne, withProjectRole(pr))
+	return &ProjectRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProjectRoleClient) UpdateOneID(id int) *ProjectRoleUpdateOne {
+	mutation := newProjectRoleMutation(c.config, OpUpdateOne, withProjectRoleID(id))
+	return &ProjectRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProjectRole.
+func (c *ProjectRoleClient) Delete() *ProjectRoleDelete {
+	mutation := newProjectRoleMutation(c.config, OpDelete)
+	return &ProjectRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProjectRoleClient) DeleteOne(pr *ProjectRole) *ProjectRoleDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProjectRoleClient) DeleteOneID(id int) *ProjectRoleDeleteOne {
+	builder := c.Delete().Where(projectrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProjectRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for ProjectRole.
+func (c *ProjectRoleClient) Query() *ProjectRoleQuery {
+	return &ProjectRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProjectRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProjectRole entity by its id.
+func (c *ProjectRoleClient) Get(ctx context.Context, id int) (*ProjectRole, error) {
+	return c.Query().Where(projectrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProjectRoleClient) GetX(ctx context.Context, id int) *ProjectRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a ProjectRole.
+func (c *ProjectRoleClient) QueryProject(pr *ProjectRole) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projectrole.Table, projectrole.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, projectrole.ProjectTable, projectrole.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProjectRoleClient) Hooks() []Hook {
+	return c.hooks.ProjectRole
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProjectRoleClient) Interceptors() []Interceptor {
+	return c.inters.ProjectRole
+}
+
+func (c *ProjectRoleClient) mutate(ctx context.Context, m *ProjectRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProjectRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProjectRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProjectRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProjectRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProjectRole mutation op: %q", m.Op())
+	}
+}
+
+// ReminderSettingClient is a client for the ReminderSetting schema.
+type ReminderSettingClient struct {
+	config
+}
+
+// NewReminderSettingClient returns a client for the ReminderSetting from the given config.
+func NewReminderSettingClient(c config) *ReminderSettingClient {
+	return &ReminderSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `remindersetting.Hooks(f(g(h())))`.
+func (c *ReminderSettingClient) Use(hooks ...Hook) {
+	c.hooks.ReminderSetting = append(c.hooks.ReminderSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `remindersetting.Intercept(f(g(h())))`.
+func (c *ReminderSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReminderSetting = append(c.inters.ReminderSetting, interceptors...)
+}
+
+// Create returns a builder for creating a ReminderSetting entity.
+func (c *ReminderSettingClient) Create() *ReminderSettingCreate {
+	mutation := newReminderSettingMutation(c.config, OpCreate)
+	return &ReminderSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReminderSetting entities.
+func (c *ReminderSettingClient) CreateBulk(builders ...*ReminderSettingCreate) *ReminderSettingCreateBulk {
+	return &ReminderSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReminderSettingClient) MapCreateBulk(slice any, setFunc func(*ReminderSettingCreate, int)) *ReminderSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReminderSettingCreateBulk{err: fmt.Errorf("calling to ReminderSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReminderSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReminderSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReminderSetting.
+func (c *ReminderSettingClient) Update() *ReminderSettingUpdate {
+	mutation := newReminderSettingMutation(c.config, OpUpdate)
+	return &ReminderSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReminderSettingClient) UpdateOne(rs *ReminderSetting) *ReminderSettingUpdateOne {
+	mutation := newReminderSettingMutation(c.config, OpUpdateOne, withReminderSetting(rs))
+	return &ReminderSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReminderSettingClient) UpdateOneID(id int) *ReminderSettingUpdateOne {
+	mutation := newReminderSettingMutation(c.config, OpUpdateOne, withReminderSettingID(id))
+	return &ReminderSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReminderSetting.
+func (c *ReminderSettingClient) Delete() *ReminderSettingDelete {
+	mutation := newReminderSettingMutation(c.config, OpDelete)
+	return &ReminderSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReminderSettingClient) DeleteOne(rs *ReminderSetting) *ReminderSettingDeleteOne {
+	return c.DeleteOneID(rs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReminderSettingClient) DeleteOneID(id int) *ReminderSettingDeleteOne {
+	builder := c.Delete().Where(remindersetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReminderSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for ReminderSetting.
+func (c *ReminderSettingClient) Query() *ReminderSettingQuery {
+	return &ReminderSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReminderSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReminderSetting entity by its id.
+func (c *ReminderSettingClient) Get(ctx context.Context, id int) (*ReminderSetting, error) {
+	return c.Query().Where(remindersetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReminderSettingClient) GetX(ctx context.Context, id int) *ReminderSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReminderSettingClient) Hooks() []Hook {
+	return c.hooks.ReminderSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReminderSettingClient) Interceptors() []Interceptor {
+	return c.inters.ReminderSetting
+}
+
+func (c *ReminderSettingClient) mutate(ctx context.Context, m *ReminderSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReminderSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReminderSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReminderSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReminderSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReminderSetting mutation op: %q", m.Op())
+	}
+}
+
+// RosterClient is a client for the Roster schema.
+type RosterClient struct {
+	config
+}
+
+// NewRosterClient returns a client for the Roster from the given config.
+func NewRosterClient(c config) *RosterClient {
+	return &RosterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roster.Hooks(f(g(h())))`.
+func (c *RosterClient) Use(hooks ...Hook) {
+	c.hooks.Roster = append(c.hooks.Roster, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roster.Intercept(f(g(h())))`.
+func (c *RosterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Roster = append(c.inters.Roster, interceptors...)
+}
+
+// Create returns a builder for creating a Roster entity.
+func (c *RosterClient) Create() *RosterCreate {
+	mutation := newRosterMutation(c.config, OpCreate)
+	return &RosterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Roster entities.
+func (c *RosterClient) CreateBulk(builders ...*RosterCreate) *RosterCreateBulk {
+	return &RosterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RosterClient) MapCreateBulk(slice any, setFunc func(*RosterCreate, int)) *RosterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RosterCreateBulk{err: fmt.Errorf("calling to RosterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RosterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RosterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Roster.
+func (c *RosterClient) Update() *RosterUpdate {
+	mutation := newRosterMutation(c.config, OpUpdate)
+	return &RosterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RosterClient) UpdateOne(r *Roster) *RosterUpdateOne {
+	mutation := newRosterMutation(c.config, OpUpdateOne, withRoster(r))
+	return &RosterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RosterClient) UpdateOneID(id int) *RosterUpdateOne {
+	mutation := newRosterMutation(c.config, OpUpdateOne, withRosterID(id))
+	return &RosterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Roster.
+func (c *RosterClient) Delete() *RosterDelete {
+	mutation := newRosterMutation(c.config, OpDelete)
+	return &RosterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RosterClient) DeleteOne(r *Roster) *RosterDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RosterClient) DeleteOneID(id int) *RosterDeleteOne {
+	builder := c.Delete().Where(roster.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RosterDeleteOne{builder}
+}
+
+// Query returns a query builder for Roster.
+func (c *RosterClient) Query() *RosterQuery {
+	return &RosterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoster},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Roster entity by its id.
+func (c *RosterClient) Get(ctx context.Context, id int) (*Roster, error) {
+	return c.Query().Where(roster.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RosterClient) GetX(ctx context.Context, id int) *Roster {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEmployee queries the employee edge of a Roster.
+func (c *RosterClient) QueryEmployee(r *Roster) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roster.Table, roster.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roster.EmployeeTable, roster.EmployeeColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShift queries the shift edge of a Roster.
+func (c *RosterClient) QueryShift(r *Roster) *ShiftQuery {
+	query := (&ShiftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roster.Table, roster.FieldID, id),
+			sqlgraph.To(shift.Table, shift.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roster.ShiftTable, roster.ShiftColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySwapRequests queries the swap_requests edge of a Roster.
+func (c *RosterClient) QuerySwapRequests(r *Roster) *ShiftSwapRequestQuery {
+	query := (&ShiftSwapRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roster.Table, roster.FieldID, id),
+			sqlgraph.To(shiftswaprequest.Table, shiftswaprequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roster.SwapRequestsTable, roster.SwapRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTargetedSwapRequests queries the targeted_swap_requests edge of a Roster.
+func (c *RosterClient) QueryTargetedSwapRequests(r *Roster) *ShiftSwapRequestQuery {
+	query := (&ShiftSwapRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roster.Table, roster.FieldID, id),
+			sqlgraph.To(shiftswaprequest.Table, shiftswaprequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roster.TargetedSwapRequestsTable, roster.TargetedSwapRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RosterClient) Hooks() []Hook {
+	return c.hooks.Roster
+}
+
+// Interceptors returns the client interceptors.
+func (c *RosterClient) Interceptors() []Interceptor {
+	return c.inters.Roster
+}
+
+func (c *RosterClient) mutate(ctx context.Context, m *RosterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RosterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RosterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RosterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RosterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Roster mutation op: %q", m.Op())
+	}
+}
+
+// SalaryGradeClient is a client for the SalaryGrade schema.
+type SalaryGradeClient struct {
+	config
+}
+
+// NewSalaryGradeClient returns a client for the SalaryGrade from the given config.
+func NewSalaryGradeClient(c config) *SalaryGradeClient {
+	return &SalaryGradeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `salarygrade.Hooks(f(g(h())))`.
+func (c *SalaryGradeClient) Use(hooks ...Hook) {
+	c.hooks.SalaryGrade = append(c.hooks.SalaryGrade, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `salarygrade.Intercept(f(g(h())))`.
+func (c *SalaryGradeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SalaryGrade = append(c.inters.SalaryGrade, interceptors...)
+}
+
+// Create returns a builder for creating a SalaryGrade entity.
+func (c *SalaryGradeClient) Create() *SalaryGradeCreate {
+	mutation := newSalaryGradeMutation(c.config, OpCreate)
+	return &SalaryGradeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SalaryGrade entities.
+func (c *SalaryGradeClient) CreateBulk(builders ...*SalaryGradeCreate) *SalaryGradeCreateBulk {
+	return &SalaryGradeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SalaryGradeClient) MapCreateBulk(slice any, setFunc func(*SalaryGradeCreate, int)) *SalaryGradeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SalaryGradeCreateBulk{err: fmt.Errorf("calling to SalaryGradeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SalaryGradeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SalaryGradeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SalaryGrade.
+func (c *SalaryGradeClient) Update() *SalaryGradeUpdate {
+	mutation := newSalaryGradeMutation(c.config, OpUpdate)
+	return &SalaryGradeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SalaryGradeClient) UpdateOne(sg *SalaryGrade) *SalaryGradeUpdateOne {
+	mutation := newSalaryGradeMutation(c.config, OpUpdateOne, withSalaryGrade(sg))
+	return &SalaryGradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SalaryGradeClient) UpdateOneID(id int) *SalaryGradeUpdateOne {
+	mutation := newSalaryGradeMutation(c.config, OpUpdateOne, withSalaryGradeID(id))
+	return &SalaryGradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SalaryGrade.
+func (c *SalaryGradeClient) Delete() *SalaryGradeDelete {
+	mutation := newSalaryGradeMutation(c.config, OpDelete)
+	return &SalaryGradeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SalaryGradeClient) DeleteOne(sg *SalaryGrade) *SalaryGradeDeleteOne {
+	return c.DeleteOneID(sg.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SalaryGradeClient) DeleteOneID(id int) *SalaryGradeDeleteOne {
+	builder := c.Delete().Where(salarygrade.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SalaryGradeDeleteOne{builder}
+}
+
+// Query returns a query builder for SalaryGrade.
+func (c *SalaryGradeClient) Query() *SalaryGradeQuery {
+	return &SalaryGradeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSalaryGrade},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SalaryGrade entity by its id.
+func (c *SalaryGradeClient) Get(ctx context.Context, id int) (*SalaryGrade, error) {
+	return c.Query().Where(salarygrade.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SalaryGradeClient) GetX(ctx context.Context, id int) *SalaryGrade {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a SalaryGrade.
+func (c *SalaryGradeClient) QueryOrganization(sg *SalaryGrade) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarygrade.Table, salarygrade.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarygrade.OrganizationTable, salarygrade.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(sg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPosition queries the position edge of a SalaryGrade.
+func (c *SalaryGradeClient) QueryPosition(sg *SalaryGrade) *PositionQuery {
+	query := (&PositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarygrade.Table, salarygrade.FieldID, id),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarygrade.PositionTable, salarygrade.PositionColumn),
+		)
+		fromV = sqlgraph.Neighbors(sg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCompensations queries the compensations edge of a SalaryGrade.
+func (c *SalaryGradeClient) QueryCompensations(sg *SalaryGrade) *CompensationQuery {
+	query := (&CompensationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarygrade.Table, salarygrade.FieldID, id),
+			sqlgraph.To(compensation.Table, compensation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, salarygrade.CompensationsTable, salarygrade.CompensationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(sg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SalaryGradeClient) Hooks() []Hook {
+	return c.hooks.SalaryGrade
+}
+
+// Interceptors returns the client interceptors.
+func (c *SalaryGradeClient) Interceptors() []Interceptor {
+	return c.inters.SalaryGrade
+}
+
+func (c *SalaryGradeClient) mutate(ctx context.Context, m *SalaryGradeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SalaryGradeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SalaryGradeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SalaryGradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SalaryGradeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SalaryGrade mutation op: %q", m.Op())
+	}
+}
+
+// ShiftClient is a client for the Shift schema.
+type ShiftClient struct {
+	config
+}
+
+// NewShiftClient returns a client for the Shift from the given config.
+func NewShiftClient(c config) *ShiftClient {
+	return &ShiftClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shift.Hooks(f(g(h())))`.
+func (c *ShiftClient) Use(hooks ...Hook) {
+	c.hooks.Shift = append(c.hooks.Shift, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shift.Intercept(f(g(h())))`.
+func (c *ShiftClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Shift = append(c.inters.Shift, interceptors...)
+}
+
+// Create returns a builder for creating a Shift entity.
+func (c *ShiftClient) Create() *ShiftCreate {
+	mutation := newShiftMutation(c.config, OpCreate)
+	return &ShiftCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Shift entities.
+func (c *ShiftClient) CreateBulk(builders ...*ShiftCreate) *ShiftCreateBulk {
+	return &ShiftCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShiftClient) MapCreateBulk(slice any, setFunc func(*ShiftCreate, int)) *ShiftCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShiftCreateBulk{err: fmt.Errorf("calling to ShiftClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShiftCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShiftCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Shift.
+func (c *ShiftClient) Update() *ShiftUpdate {
+	mutation := newShiftMutation(c.config, OpUpdate)
+	return &ShiftUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShiftClient) UpdateOne(s *Shift) *ShiftUpdateOne {
+	mutation := newShiftMutation(c.config, OpUpdateOne, withShift(s))
+	return &ShiftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShiftClient) UpdateOneID(id int) *ShiftUpdateOne {
+	mutation := newShiftMutation(c.config, OpUpdateOne, withShiftID(id))
+	return &ShiftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Shift.
+func (c *ShiftClient) Delete() *ShiftDelete {
+	mutation := newShiftMutation(c.config, OpDelete)
+	return &ShiftDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShiftClient) DeleteOne(s *Shift) *ShiftDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShiftClient) DeleteOneID(id int) *ShiftDeleteOne {
+	builder := c.Delete().Where(shift.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShiftDeleteOne{builder}
+}
+
+// Query returns a query builder for Shift.
+func (c *ShiftClient) Query() *ShiftQuery {
+	return &ShiftQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShift},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Shift entity by its id.
+func (c *ShiftClient) Get(ctx context.Context, id int) (*Shift, error) {
+	return c.Query().Where(shift.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShiftClient) GetX(ctx context.Context, id int) *Shift {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a Shift.
+func (c *ShiftClient) QueryOrganization(s *Shift) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shift.Table, shift.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shift.OrganizationTable, shift.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRosters queries the rosters edge of a Shift.
+func (c *ShiftClient) QueryRosters(s *Shift) *RosterQuery {
+	query := (&RosterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shift.Table, shift.FieldID, id),
+			sqlgraph.To(roster.Table, roster.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, shift.RostersTable, shift.RostersColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShiftClient) Hooks() []Hook {
+	return c.hooks.Shift
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShiftClient) Interceptors() []Interceptor {
+	return c.inters.Shift
+}
+
+func (c *ShiftClient) mutate(ctx context.Context, m *ShiftMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShiftCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShiftUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShiftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShiftDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Shift mutation op: %q", m.Op())
+	}
+}
+
+// ShiftSwapRequestClient is a client for the ShiftSwapRequest schema.
+type ShiftSwapRequestClient struct {
+	config
+}
+
+// NewShiftSwapRequestClient returns a client for the ShiftSwapRequest from the given config.
+func NewShiftSwapRequestClient(c config) *ShiftSwapRequestClient {
+	return &ShiftSwapRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shiftswaprequest.Hooks(f(g(h())))`.
+func (c *ShiftSwapRequestClient) Use(hooks ...Hook) {
+	c.hooks.ShiftSwapRequest = append(c.hooks.ShiftSwapRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shiftswaprequest.Intercept(f(g(h())))`.
+func (c *ShiftSwapRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShiftSwapRequest = append(c.inters.ShiftSwapRequest, interceptors...)
+}
+
+// Create returns a builder for creating a ShiftSwapRequest entity.
+func (c *ShiftSwapRequestClient) Create() *ShiftSwapRequestCreate {
+	mutation := newShiftSwapRequestMutation(c.config, OpCreate)
+	return &ShiftSwapRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShiftSwapRequest entities.
+func (c *ShiftSwapRequestClient) CreateBulk(builders ...*ShiftSwapRequestCreate) *ShiftSwapRequestCreateBulk {
+	return &ShiftSwapRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShiftSwapRequestClient) MapCreateBulk(slice any, setFunc func(*ShiftSwapRequestCreate, int)) *ShiftSwapRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShiftSwapRequestCreateBulk{err: fmt.Errorf("calling to ShiftSwapRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShiftSwapRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShiftSwapRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShiftSwapRequest.
+func (c *ShiftSwapRequestClient) Update() *ShiftSwapRequestUpdate {
+	mutation := newShiftSwapRequestMutation(c.config, OpUpdate)
+	return &ShiftSwapRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShiftSwapRequestClient) UpdateOne(ssr *ShiftSwapRequest) *ShiftSwapRequestUpdateOne {
+	mutation := newShiftSwapRequestMutation(c.config, OpUpdateOne, withShiftSwapRequest(ssr))
+	return &ShiftSwapRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShiftSwapRequestClient) UpdateOneID(id int) *ShiftSwapRequestUpdateOne {
+	mutation := newShiftSwapRequestMutation(c.config, OpUpdateOne, withShiftSwapRequestID(id))
+	return &ShiftSwapRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShiftSwapRequest.
+func (c *ShiftSwapRequestClient) Delete() *ShiftSwapRequestDelete {
+	mutation := newShiftSwapRequestMutation(c.config, OpDelete)
+	return &ShiftSwapRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShiftSwapRequestClient) DeleteOne(ssr *ShiftSwapRequest) *ShiftSwapRequestDeleteOne {
+	return c.DeleteOneID(ssr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShiftSwapRequestClient) DeleteOneID(id int) *ShiftSwapRequestDeleteOne {
+	builder := c.Delete().Where(shiftswaprequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShiftSwapRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for ShiftSwapRequest.
+func (c *ShiftSwapRequestClient) Query() *ShiftSwapRequestQuery {
+	return &ShiftSwapRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShiftSwapRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShiftSwapRequest entity by its id.
+func (c *ShiftSwapRequestClient) Get(ctx context.Context, id int) (*ShiftSwapRequest, error) {
+	return c.Query().Where(shiftswaprequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShiftSwapRequestClient) GetX(ctx context.Context, id int) *ShiftSwapRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoster queries the roster edge of a ShiftSwapRequest.
+func (c *ShiftSwapRequestClient) QueryRoster(ssr *ShiftSwapRequest) *RosterQuery {
+	query := (&RosterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ssr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftswaprequest.Table, shiftswaprequest.FieldID, id),
+			sqlgraph.To(roster.Table, roster.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftswaprequest.RosterTable, shiftswaprequest.RosterColumn),
+		)
+		fromV = sqlgraph.Neighbors(ssr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTargetRoster queries the target_roster edge of a ShiftSwapRequest.
+func (c *ShiftSwapRequestClient) QueryTargetRoster(ssr *ShiftSwapRequest) *RosterQuery {
+	query := (&RosterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ssr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftswaprequest.Table, shiftswaprequest.FieldID, id),
+			sqlgraph.To(roster.Table, roster.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftswaprequest.TargetRosterTable, shiftswaprequest.TargetRosterColumn),
+		)
+		fromV = sqlgraph.Neighbors(ssr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShiftSwapRequestClient) Hooks() []Hook {
+	return c.hooks.ShiftSwapRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShiftSwapRequestClient) Interceptors() []Interceptor {
+	return c.inters.ShiftSwapRequest
+}
+
+func (c *ShiftSwapRequestClient) mutate(ctx context.Context, m *ShiftSwapRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShiftSwapRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShiftSwapRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShiftSwapRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShiftSwapRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShiftSwapRequest mutation op: %q", m.Op())
+	}
+}
+
+// SprintClient is a client for the Sprint schema.
+type SprintClient struct {
+	config
+}
+
+// NewSprintClient returns a client for the Sprint from the given config.
+func NewSprintClient(c config) *SprintClient {
+	return &SprintClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sprint.Hooks(f(g(h())))`.
+func (c *SprintClient) Use(hooks ...Hook) {
+	c.hooks.Sprint = append(c.hooks.Sprint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sprint.Intercept(f(g(h())))`.
+func (c *SprintClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sprint = append(c.inters.Sprint, interceptors...)
+}
+
+// Create returns a builder for creating a Sprint entity.
+func (c *SprintClient) Create() *SprintCreate {
+	mutation := newSprintMutation(c.config, OpCreate)
+	return &SprintCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sprint entities.
+func (c *SprintClient) CreateBulk(builders ...*SprintCreate) *SprintCreateBulk {
+	return &SprintCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SprintClient) MapCreateBulk(slice any, setFunc func(*SprintCreate, int)) *SprintCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SprintCreateBulk{err: fmt.Errorf("calling to SprintClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SprintCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SprintCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sprint.
+func (c *SprintClient) Update() *SprintUpdate {
+	mutation := newSprintMutation(c.config, OpUpdate)
+	return &SprintUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SprintClient) UpdateOne(s *Sprint) *SprintUpdateOne {
+	mutation := newSprintMutation(c.config, OpUpdateOne, withSprint(s))
+	return &SprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SprintClient) UpdateOneID(id int) *SprintUpdateOne {
+	mutation := newSprintMutation(c.config, OpUpdateOne, withSprintID(id))
+	return &SprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sprint.
+func (c *SprintClient) Delete() *SprintDelete {
+	mutation := newSprintMutation(c.config, OpDelete)
+	return &SprintDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SprintClient) DeleteOne(s *Sprint) *SprintDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SprintClient) DeleteOneID(id int) *SprintDeleteOne {
+	builder := c.Delete().Where(sprint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SprintDeleteOne{builder}
+}
+
+// Query returns a query builder for Sprint.
+func (c *SprintClient) Query() *SprintQuery {
+	return &SprintQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSprint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Sprint entity by its id.
+func (c *SprintClient) Get(ctx context.Context, id int) (*Sprint, error) {
+	return c.Query().Where(sprint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SprintClient) GetX(ctx context.Context, id int) *Sprint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Sprint.
+func (c *SprintClient) QueryProject(s *Sprint) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sprint.Table, sprint.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sprint.ProjectTable, sprint.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTasks queries the tasks edge of a Sprint.
+func (c *SprintClient) QueryTasks(s *Sprint) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sprint.Table, sprint.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sprint.TasksTable, sprint.TasksColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SprintClient) Hooks() []Hook {
+	return c.hooks.Sprint
+}
+
+// Interceptors returns the client interceptors.
+func (c *SprintClient) Interceptors() []Interceptor {
+	return c.inters.Sprint
+}
+
+func (c *SprintClient) mutate(ctx context.Context, m *SprintMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SprintCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SprintUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SprintDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Sprint mutation op: %q", m.Op())
+	}
+}
+
+// TaskClient is a client for the Task schema.
+type TaskClient struct {
+	config
+}
+
+// NewTaskClient returns a client for the Task from the given config.
+func NewTaskClient(c config) *TaskClient {
+	return &TaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
+func (c *TaskClient) Use(hooks ...Hook) {
+	c.hooks.Task = append(c.hooks.Task, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
+func (c *TaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Task = append(c.inters.Task, interceptors...)
+}
+
+// Create returns a builder for creating a Task entity.
+func (c *TaskClient) Create() *TaskCreate {
+	mutation := newTaskMutation(c.config, OpCreate)
+	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Task entities.
+func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Task.
+func (c *TaskClient) Update() *TaskUpdate {
+	mutation := newTaskMutation(c.config, OpUpdate)
+	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskClient) UpdateOne(t *Task) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(t))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskClient) UpdateOneID(id int) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Task.
+func (c *TaskClient) Delete() *TaskDelete {
+	mutation := newTaskMutation(c.config, OpDelete)
+	return &TaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskClient) DeleteOne(t *Task) *TaskDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskClient) DeleteOneID(id int) *TaskDeleteOne {
+	builder := c.Delete().Where(task.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskDeleteOne{builder}
+}
+
+// Query returns a query builder for Task.
+func (c *TaskClient) Query() *TaskQuery {
+	return &TaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Task entity by its id.
+func (c *TaskClient) Get(ctx context.Context, id int) (*Task, error) {
+	return c.Query().Where(task.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Task.
+func (c *TaskClient) QueryProject(t *Task) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.ProjectTable, task.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLabels queries the labels edge of a Task.
+func (c *TaskClient) QueryLabels(t *Task) *LabelQuery {
+	query := (&LabelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(label.Table, label.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, task.LabelsTable, task.LabelsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignees queries the assignees edge of a Task.
+func (c *TaskClient) QueryAssignees(t *Task) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, task.AssigneesTable, task.AssigneesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReports queries the reports edge of a Task.
+func (c *TaskClient) QueryReports(t *Task) *TaskReportQuery {
+	query := (&TaskReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskreport.Table, taskreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ReportsTable, task.ReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOvertimeRequests queries the overtime_requests edge of a Task.
+func (c *TaskClient) QueryOvertimeRequests(t *Task) *OvertimeRequestQuery {
+	query := (&OvertimeRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(overtimerequest.Table, overtimerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.OvertimeRequestsTable, task.OvertimeRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Task.
+func (c *TaskClient) QueryChildren(t *Task) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ChildrenTable, task.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Task.
+func (c *TaskClient) QueryParent(t *Task) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.ParentTable, task.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDependencies queries the dependencies edge of a Task.
+func (c *TaskClient) QueryDependencies(t *Task) *TaskDependencyQuery {
+	query := (&TaskDependencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskdependency.Table, taskdependency.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.DependenciesTable, task.DependenciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDependents queries the dependents edge of a Task.
+func (c *TaskClient) QueryDependents(t *Task) *TaskDependencyQuery {
+	query := (&TaskDependencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskdependency.Table, taskdependency.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.DependentsTable, task.DependentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a Task.
+func (c *TaskClient) QueryComments(t *Task) *TaskCommentQuery {
+	query := (&TaskCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.CommentsTable, task.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkLogs queries the work_logs edge of a Task.
+func (c *TaskClient) QueryWorkLogs(t *Task) *WorkLogQuery {
+	query := (&WorkLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(worklog.Table, worklog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.WorkLogsTable, task.WorkLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySprint queries the sprint edge of a Task.
+func (c *TaskClient) QuerySprint(t *Task) *SprintQuery {
+	query := (&SprintClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(sprint.Table, sprint.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.SprintTable, task.SprintColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMilestone queries the milestone edge of a Task.
+func (c *TaskClient) QueryMilestone(t *Task) *MilestoneQuery {
+	query := (&MilestoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(milestone.Table, milestone.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.MilestoneTable, task.MilestoneColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActivities queries the activities edge of a Task.
+func (c *TaskClient) QueryActivities(t *Task) *TaskActivityQuery {
+	query := (&TaskActivityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskactivity.Table, taskactivity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ActivitiesTable, task.ActivitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWatchers queries the watchers edge of a Task.
+func (c *TaskClient) QueryWatchers(t *Task) *TaskWatcherQuery {
+	query := (&TaskWatcherClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskwatcher.Table, taskwatcher.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.WatchersTable, task.WatchersColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReminders queries the reminders edge of a Task.
+func (c *TaskClient) QueryReminders(t *Task) *TaskReminderQuery {
+	query := (&TaskReminderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskreminder.Table, taskreminder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.RemindersTable, task.RemindersColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskClient) Hooks() []Hook {
+	return c.hooks.Task
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskClient) Interceptors() []Interceptor {
+	return c.inters.Task
+}
+
+func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Task mutation op: %q", m.Op())
+	}
+}
+
+// TaskActivityClient is a client for the TaskActivity schema.
+type TaskActivityClient struct {
+	config
+}
+
+// NewTaskActivityClient returns a client for the TaskActivity from the given config.
+func NewTaskActivityClient(c config) *TaskActivityClient {
+	return &TaskActivityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskactivity.Hooks(f(g(h())))`.
+func (c *TaskActivityClient) Use(hooks ...Hook) {
+	c.hooks.TaskActivity = append(c.hooks.TaskActivity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskactivity.Intercept(f(g(h())))`.
+func (c *TaskActivityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskActivity = append(c.inters.TaskActivity, interceptors...)
+}
+
+// Create returns a builder for creating a TaskActivity entity.
+func (c *TaskActivityClient) Create() *TaskActivityCreate {
+	mutation := newTaskActivityMutation(c.config, OpCreate)
+	return &TaskActivityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskActivity entities.
+func (c *TaskActivityClient) CreateBulk(builders ...*TaskActivityCreate) *TaskActivityCreateBulk {
+	return &TaskActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskActivityClient) MapCreateBulk(slice any, setFunc func(*TaskActivityCreate, int)) *TaskActivityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskActivityCreateBulk{err: fmt.Errorf("calling to TaskActivityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskActivityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskActivity.
+func (c *TaskActivityClient) Update() *TaskActivityUpdate {
+	mutation := newTaskActivityMutation(c.config, OpUpdate)
+	return &TaskActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskActivityClient) UpdateOne(ta *TaskActivity) *TaskActivityUpdateOne {
+	mutation := newTaskActivityMutation(c.config, OpUpdateOne, withTaskActivity(ta))
+	return &TaskActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskActivityClient) UpdateOneID(id int) *TaskActivityUpdateOne {
+	mutation := newTaskActivityMutation(c.config, OpUpdateOne, withTaskActivityID(id))
+	return &TaskActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskActivity.
+func (c *TaskActivityClient) Delete() *TaskActivityDelete {
+	mutation := newTaskActivityMutation(c.config, OpDelete)
+	return &TaskActivityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskActivityClient) DeleteOne(ta *TaskActivity) *TaskActivityDeleteOne {
+	return c.DeleteOneID(ta.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskActivityClient) DeleteOneID(id int) *TaskActivityDeleteOne {
+	builder := c.Delete().Where(taskactivity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskActivityDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskActivity.
+func (c *TaskActivityClient) Query() *TaskActivityQuery {
+	return &TaskActivityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskActivity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskActivity entity by its id.
+func (c *TaskActivityClient) Get(ctx context.Context, id int) (*TaskActivity, error) {
+	return c.Query().Where(taskactivity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskActivityClient) GetX(ctx context.Context, id int) *TaskActivity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskActivity.
+func (c *TaskActivityClient) QueryTask(ta *TaskActivity) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ta.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskactivity.Table, taskactivity.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskactivity.TaskTable, taskactivity.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(ta.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskActivityClient) Hooks() []Hook {
+	return c.hooks.TaskActivity
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskActivityClient) Interceptors() []Interceptor {
+	return c.inters.TaskActivity
+}
+
+func (c *TaskActivityClient) mutate(ctx context.Context, m *TaskActivityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskActivityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskActivityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskActivity mutation op: %q", m.Op())
+	}
+}
+
+// TaskCommentClient is a client for the TaskComment schema.
+type TaskCommentClient struct {
+	config
+}
+
+// NewTaskCommentClient returns a client for the TaskComment from the given config.
+func NewTaskCommentClient(c config) *TaskCommentClient {
+	return &TaskCommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskcomment.Hooks(f(g(h())))`.
+func (c *TaskCommentClient) Use(hooks ...Hook) {
+	c.hooks.TaskComment = append(c.hooks.TaskComment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskcomment.Intercept(f(g(h())))`.
+func (c *TaskCommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskComment = append(c.inters.TaskComment, interceptors...)
+}
+
+// Create returns a builder for creating a TaskComment entity.
+func (c *TaskCommentClient) Create() *TaskCommentCreate {
+	mutation := newTaskCommentMutation(c.config, OpCreate)
+	return &TaskCommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskComment entities.
+func (c *TaskCommentClient) CreateBulk(builders ...*TaskCommentCreate) *TaskCommentCreateBulk {
+	return &TaskCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskCommentClient) MapCreateBulk(slice any, setFunc func(*TaskCommentCreate, int)) *TaskCommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCommentCreateBulk{err: fmt.Errorf("calling to TaskCommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskComment.
+func (c *TaskCommentClient) Update() *TaskCommentUpdate {
+	mutation := newTaskCommentMutation(c.config, OpUpdate)
+	return &TaskCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskCommentClient) UpdateOne(tc *TaskComment) *TaskCommentUpdateOne {
+	mutation := newTaskCommentMutation(c.config, OpUpdateOne, withTaskComment(tc))
+	return &TaskCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskCommentClient) UpdateOneID(id int) *TaskCommentUpdateOne {
+	mutation := newTaskCommentMutation(c.config, OpUpdateOne, withTaskCommentID(id))
+	return &TaskCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskComment.
+func (c *TaskCommentClient) Delete() *TaskCommentDelete {
+	mutation := newTaskCommentMutation(c.config, OpDelete)
+	return &TaskCommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskCommentClient) DeleteOne(tc *TaskComment) *TaskCommentDeleteOne {
+	return c.DeleteOneID(tc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskCommentClient) DeleteOneID(id int) *TaskCommentDeleteOne {
+	builder := c.Delete().Where(taskcomment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskCommentDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskComment.
+func (c *TaskCommentClient) Query() *TaskCommentQuery {
+	return &TaskCommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskComment entity by its id.
+func (c *TaskCommentClient) Get(ctx context.Context, id int) (*TaskComment, error) {
+	return c.Query().Where(taskcomment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskCommentClient) GetX(ctx context.Context, id int) *TaskComment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskComment.
+func (c *TaskCommentClient) QueryTask(tc *TaskComment) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskcomment.TaskTable, taskcomment.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(tc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthor queries the author edge of a TaskComment.
+func (c *TaskCommentClient) QueryAuthor(tc *TaskComment) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskcomment.AuthorTable, taskcomment.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(tc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplies queries the replies edge of a TaskComment.
+func (c *TaskCommentClient) QueryReplies(tc *TaskComment) *TaskCommentQuery {
+	query := (&TaskCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, id),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, taskcomment.RepliesTable, taskcomment.RepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(tc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a TaskComment.
+func (c *TaskCommentClient) QueryParent(tc *TaskComment) *TaskCommentQuery {
+	query := (&TaskCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, id),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskcomment.ParentTable, taskcomment.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(tc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentions queries the mentions edge of a TaskComment.
+func (c *TaskCommentClient) QueryMentions(tc *TaskComment) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, taskcomment.MentionsTable, taskcomment.MentionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(tc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a TaskComment.
+func (c *TaskCommentClient) QueryRevisions(tc *TaskComment) *TaskCommentRevisionQuery {
+	query := (&TaskCommentRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, id),
+			sqlgraph.To(taskcommentrevision.Table, taskcommentrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, taskcomment.RevisionsTable, taskcomment.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(tc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskCommentClient) Hooks() []Hook {
+	return c.hooks.TaskComment
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskCommentClient) Interceptors() []Interceptor {
+	return c.inters.TaskComment
+}
+
+func (c *TaskCommentClient) mutate(ctx context.Context, m *TaskCommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskCommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskCommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskComment mutation op: %q", m.Op())
+	}
+}
+
+// TaskCommentRevisionClient is a client for the TaskCommentRevision schema.
+type TaskCommentRevisionClient struct {
+	config
+}
+
+// NewTaskCommentRevisionClient returns a client for the TaskCommentRevision from the given config.
+func NewTaskCommentRevisionClient(c config) *TaskCommentRevisionClient {
+	return &TaskCommentRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskcommentrevision.Hooks(f(g(h())))`.
+func (c *TaskCommentRevisionClient) Use(hooks ...Hook) {
+	c.hooks.TaskCommentRevision = append(c.hooks.TaskCommentRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskcommentrevision.Intercept(f(g(h())))`.
+func (c *TaskCommentRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskCommentRevision = append(c.inters.TaskCommentRevision, interceptors...)
+}
+
+// Create returns a builder for creating a TaskCommentRevision entity.
+func (c *TaskCommentRevisionClient) Create() *TaskCommentRevisionCreate {
+	mutation := newTaskCommentRevisionMutation(c.config, OpCreate)
+	return &TaskCommentRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskCommentRevision entities.
+func (c *TaskCommentRevisionClient) CreateBulk(builders ...*TaskCommentRevisionCreate) *TaskCommentRevisionCreateBulk {
+	return &TaskCommentRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskCommentRevisionClient) MapCreateBulk(slice any, setFunc func(*TaskCommentRevisionCreate, int)) *TaskCommentRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCommentRevisionCreateBulk{err: fmt.Errorf("calling to TaskCommentRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCommentRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCommentRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskCommentRevision.
+func (c *TaskCommentRevisionClient) Update() *TaskCommentRevisionUpdate {
+	mutation := newTaskCommentRevisionMutation(c.config, OpUpdate)
+	return &TaskCommentRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskCommentRevisionClient) UpdateOne(tcr *TaskCommentRevision) *TaskCommentRevisionUpdateOne {
+	mutation := newTaskCommentRevisionMutation(c.config, OpUpdateOne, withTaskCommentRevision(tcr))
+	return &TaskCommentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskCommentRevisionClient) UpdateOneID(id int) *TaskCommentRevisionUpdateOne {
+	mutation := newTaskCommentRevisionMutation(c.config, OpUpdateOne, withTaskCommentRevisionID(id))
+	return &TaskCommentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskCommentRevision.
+func (c *TaskCommentRevisionClient) Delete() *TaskCommentRevisionDelete {
+	mutation := newTaskCommentRevisionMutation(c.config, OpDelete)
+	return &TaskCommentRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskCommentRevisionClient) DeleteOne(tcr *TaskCommentRevision) *TaskCommentRevisionDeleteOne {
+	return c.DeleteOneID(tcr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskCommentRevisionClient) DeleteOneID(id int) *TaskCommentRevisionDeleteOne {
+	builder := c.Delete().Where(taskcommentrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskCommentRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskCommentRevision.
+func (c *TaskCommentRevisionClient) Query() *TaskCommentRevisionQuery {
+	return &TaskCommentRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskCommentRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskCommentRevision entity by its id.
+func (c *TaskCommentRevisionClient) Get(ctx context.Context, id int) (*TaskCommentRevision, error) {
+	return c.Query().Where(taskcommentrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskCommentRevisionClient) GetX(ctx context.Context, id int) *TaskCommentRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryComment queries the comment edge of a TaskCommentRevision.
+func (c *TaskCommentRevisionClient) QueryComment(tcr *TaskCommentRevision) *TaskCommentQuery {
+	query := (&TaskCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tcr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcommentrevision.Table, taskcommentrevision.FieldID, id),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskcommentrevision.CommentTable, taskcommentrevision.CommentColumn),
+		)
+		fromV = sqlgraph.Neighbors(tcr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskCommentRevisionClient) Hooks() []Hook {
+	return c.hooks.TaskCommentRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskCommentRevisionClient) Interceptors() []Interceptor {
+	return c.inters.TaskCommentRevision
+}
+
+func (c *TaskCommentRevisionClient) mutate(ctx context.Context, m *TaskCommentRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskCommentRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskCommentRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskCommentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskCommentRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskCommentRevision mutation op: %q", m.Op())
+	}
+}
+
+// TaskDependencyClient is a client for the TaskDependency schema.
+type TaskDependencyClient struct {
+	config
+}
+
+// NewTaskDependencyClient returns a client for the TaskDependency from the given config.
+func NewTaskDependencyClient(c config) *TaskDependencyClient {
+	return &TaskDependencyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskdependency.Hooks(f(g(h())))`.
+func (c *TaskDependencyClient) Use(hooks ...Hook) {
+	c.hooks.TaskDependency = append(c.hooks.TaskDependency, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskdependency.Intercept(f(g(h())))`.
+func (c *TaskDependencyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskDependency = append(c.inters.TaskDependency, interceptors...)
+}
+
+// Create returns a builder for creating a TaskDependency entity.
+func (c *TaskDependencyClient) Create() *TaskDependencyCreate {
+	mutation := newTaskDependencyMutation(c.config, OpCreate)
+	return &TaskDependencyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskDependency entities.
+func (c *TaskDependencyClient) CreateBulk(builders ...*TaskDependencyCreate) *TaskDependencyCreateBulk {
+	return &TaskDependencyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskDependencyClient) MapCreateBulk(slice any, setFunc func(*TaskDependencyCreate, int)) *TaskDependencyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskDependencyCreateBulk{err: fmt.Errorf("calling to TaskDependencyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskDependencyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskDependencyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskDependency.
+func (c *TaskDependencyClient) Update() *TaskDependencyUpdate {
+	mutation := newTaskDependencyMutation(c.config, OpUpdate)
+	return &TaskDependencyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskDependencyClient) UpdateOne(td *TaskDependency) *TaskDependencyUpdateOne {
+	mutation := newTaskDependencyMutation(c.config, OpUpdateOne, withTaskDependency(td))
+	return &TaskDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskDependencyClient) UpdateOneID(id int) *TaskDependencyUpdateOne {
+	mutation := newTaskDependencyMutation(c.config, OpUpdateOne, withTaskDependencyID(id))
+	return &TaskDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskDependency.
+func (c *TaskDependencyClient) Delete() *TaskDependencyDelete {
+	mutation := newTaskDependencyMutation(c.config, OpDelete)
+	return &TaskDependencyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskDependencyClient) DeleteOne(td *TaskDependency) *TaskDependencyDeleteOne {
+	return c.DeleteOneID(td.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskDependencyClient) DeleteOneID(id int) *TaskDependencyDeleteOne {
+	builder := c.Delete().Where(taskdependency.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskDependencyDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskDependency.
+func (c *TaskDependencyClient) Query() *TaskDependencyQuery {
+	return &TaskDependencyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskDependency},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskDependency entity by its id.
+func (c *TaskDependencyClient) Get(ctx context.Context, id int) (*TaskDependency, error) {
+	return c.Query().Where(taskdependency.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskDependencyClient) GetX(ctx context.Context, id int) *TaskDependency {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskDependency.
+func (c *TaskDependencyClient) QueryTask(td *TaskDependency) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := td.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskdependency.Table, taskdependency.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskdependency.TaskTable, taskdependency.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(td.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTarget queries the target edge of a TaskDependency.
+func (c *TaskDependencyClient) QueryTarget(td *TaskDependency) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := td.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskdependency.Table, taskdependency.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskdependency.TargetTable, taskdependency.TargetColumn),
+		)
+		fromV = sqlgraph.Neighbors(td.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskDependencyClient) Hooks() []Hook {
+	return c.hooks.TaskDependency
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskDependencyClient) Interceptors() []Interceptor {
+	return c.inters.TaskDependency
+}
+
+func (c *TaskDependencyClient) mutate(ctx context.Context, m *TaskDependencyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskDependencyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskDependencyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskDependencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskDependencyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskDependency mutation op: %q", m.Op())
+	}
+}
+
+// TaskRecurrenceClient is a client for the TaskRecurrence schema.
+type TaskRecurrenceClient struct {
+	config
+}
+
+// NewTaskRecurrenceClient returns a client for the TaskRecurrence from the given config.
+func NewTaskRecurrenceClient(c config) *TaskRecurrenceClient {
+	return &TaskRecurrenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskrecurrence.Hooks(f(g(h())))`.
+func (c *TaskRecurrenceClient) Use(hooks ...Hook) {
+	c.hooks.TaskRecurrence = append(c.hooks.TaskRecurrence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskrecurrence.Intercept(f(g(h())))`.
+func (c *TaskRecurrenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskRecurrence = append(c.inters.TaskRecurrence, interceptors...)
+}
+
+// Create returns a builder for creating a TaskRecurrence entity.
+func (c *TaskRecurrenceClient) Create() *TaskRecurrenceCreate {
+	mutation := newTaskRecurrenceMutation(c.config, OpCreate)
+	return &TaskRecurrenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskRecurrence entities.
+func (c *TaskRecurrenceClient) CreateBulk(builders ...*TaskRecurrenceCreate) *TaskRecurrenceCreateBulk {
+	return &TaskRecurrenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskRecurrenceClient) MapCreateBulk(slice any, setFunc func(*TaskRecurrenceCreate, int)) *TaskRecurrenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskRecurrenceCreateBulk{err: fmt.Errorf("calling to TaskRecurrenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskRecurrenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskRecurrenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskRecurrence.
+func (c *TaskRecurrenceClient) Update() *TaskRecurrenceUpdate {
+	mutation := newTaskRecurrenceMutation(c.config, OpUpdate)
+	return &TaskRecurrenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskRecurrenceClient) UpdateOne(tr *TaskRecurrence) *TaskRecurrenceUpdateOne {
+	mutation := newTaskRecurrenceMutation(c.config, OpUpdateOne, withTaskRecurrence(tr))
+	return &TaskRecurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskRecurrenceClient) UpdateOneID(id int) *TaskRecurrenceUpdateOne {
+	mutation := newTaskRecurrenceMutation(c.config, OpUpdateOne, withTaskRecurrenceID(id))
+	return &TaskRecurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskRecurrence.
+func (c *TaskRecurrenceClient) Delete() *TaskRecurrenceDelete {
+	mutation := newTaskRecurrenceMutation(c.config, OpDelete)
+	return &TaskRecurrenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskRecurrenceClient) DeleteOne(tr *TaskRecurrence) *TaskRecurrenceDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskRecurrenceClient) DeleteOneID(id int) *TaskRecurrenceDeleteOne {
+	builder := c.Delete().Where(taskrecurrence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskRecurrenceDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskRecurrence.
+func (c *TaskRecurrenceClient) Query() *TaskRecurrenceQuery {
+	return &TaskRecurrenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskRecurrence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskRecurrence entity by its id.
+func (c *TaskRecurrenceClient) Get(ctx context.Context, id int) (*TaskRecurrence, error) {
+	return c.Query().Where(taskrecurrence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskRecurrenceClient) GetX(ctx context.Context, id int) *TaskRecurrence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTemplate queries the template edge of a TaskRecurrence.
+func (c *TaskRecurrenceClient) QueryTemplate(tr *TaskRecurrence) *TaskTemplateQuery {
+	query := (&TaskTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskrecurrence.Table, taskrecurrence.FieldID, id),
+			sqlgraph.To(tasktemplate.Table, tasktemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskrecurrence.TemplateTable, taskrecurrence.TemplateColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRuns queries the runs edge of a TaskRecurrence.
+func (c *TaskRecurrenceClient) QueryRuns(tr *TaskRecurrence) *TaskRecurrenceRunQuery {
+	query := (&TaskRecurrenceRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskrecurrence.Table, taskrecurrence.FieldID, id),
+			sqlgraph.To(taskrecurrencerun.Table, taskrecurrencerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, taskrecurrence.RunsTable, taskrecurrence.RunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskRecurrenceClient) Hooks() []Hook {
+	return c.hooks.TaskRecurrence
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskRecurrenceClient) Interceptors() []Interceptor {
+	return c.inters.TaskRecurrence
+}
+
+func (c *TaskRecurrenceClient) mutate(ctx context.Context, m *TaskRecurrenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskRecurrenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskRecurrenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskRecurrenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskRecurrenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskRecurrence mutation op: %q", m.Op())
+	}
+}
+
+// TaskRecurrenceRunClient is a client for the TaskRecurrenceRun schema.
+type TaskRecurrenceRunClient struct {
+	config
+}
+
+// NewTaskRecurrenceRunClient returns a client for the TaskRecurrenceRun from the given config.
+func NewTaskRecurrenceRunClient(c config) *TaskRecurrenceRunClient {
+	return &TaskRecurrenceRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskrecurrencerun.Hooks(f(g(h())))`.
+func (c *TaskRecurrenceRunClient) Use(hooks ...Hook) {
+	c.hooks.TaskRecurrenceRun = append(c.hooks.TaskRecurrenceRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskrecurrencerun.Intercept(f(g(h())))`.
+func (c *TaskRecurrenceRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskRecurrenceRun = append(c.inters.TaskRecurrenceRun, interceptors...)
+}
+
+// Create returns a builder for creating a TaskRecurrenceRun entity.
+func (c *TaskRecurrenceRunClient) Create() *TaskRecurrenceRunCreate {
+	mutation := newTaskRecurrenceRunMutation(c.config, OpCreate)
+	return &TaskRecurrenceRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskRecurrenceRun entities.
+func (c *TaskRecurrenceRunClient) CreateBulk(builders ...*TaskRecurrenceRunCreate) *TaskRecurrenceRunCreateBulk {
+	return &TaskRecurrenceRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskRecurrenceRunClient) MapCreateBulk(slice any, setFunc func(*TaskRecurrenceRunCreate, int)) *TaskRecurrenceRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskRecurrenceRunCreateBulk{err: fmt.Errorf("calling to TaskRecurrenceRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskRecurrenceRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskRecurrenceRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskRecurrenceRun.
+func (c *TaskRecurrenceRunClient) Update() *TaskRecurrenceRunUpdate {
+	mutation := newTaskRecurrenceRunMutation(c.config, OpUpdate)
+	return &TaskRecurrenceRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskRecurrenceRunClient) UpdateOne(trr *TaskRecurrenceRun) *TaskRecurrenceRunUpdateOne {
+	mutation := newTaskRecurrenceRunMutation(c.config, OpUpdateOne, withTaskRecurrenceRun(trr))
+	return &TaskRecurrenceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskRecurrenceRunClient) UpdateOneID(id int) *TaskRecurrenceRunUpdateOne {
+	mutation := newTaskRecurrenceRunMutation(c.config, OpUpdateOne, withTaskRecurrenceRunID(id))
+	return &TaskRecurrenceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskRecurrenceRun.
+func (c *TaskRecurrenceRunClient) Delete() *TaskRecurrenceRunDelete {
+	mutation := newTaskRecurrenceRunMutation(c.config, OpDelete)
+	return &TaskRecurrenceRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskRecurrenceRunClient) DeleteOne(trr *TaskRecurrenceRun) *TaskRecurrenceRunDeleteOne {
+	return c.DeleteOneID(trr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskRecurrenceRunClient) DeleteOneID(id int) *TaskRecurrenceRunDeleteOne {
+	builder := c.Delete().Where(taskrecurrencerun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskRecurrenceRunDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskRecurrenceRun.
+func (c *TaskRecurrenceRunClient) Query() *TaskRecurrenceRunQuery {
+	return &TaskRecurrenceRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskRecurrenceRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskRecurrenceRun entity by its id.
+func (c *TaskRecurrenceRunClient) Get(ctx context.Context, id int) (*TaskRecurrenceRun, error) {
+	return c.Query().Where(taskrecurrencerun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskRecurrenceRunClient) GetX(ctx context.Context, id int) *TaskRecurrenceRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRecurrence queries the recurrence edge of a TaskRecurrenceRun.
+func (c *TaskRecurrenceRunClient) QueryRecurrence(trr *TaskRecurrenceRun) *TaskRecurrenceQuery {
+	query := (&TaskRecurrenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := trr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskrecurrencerun.Table, taskrecurrencerun.FieldID, id),
+			sqlgraph.To(taskrecurrence.Table, taskrecurrence.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskrecurrencerun.RecurrenceTable, taskrecurrencerun.RecurrenceColumn),
+		)
+		fromV = sqlgraph.Neighbors(trr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskRecurrenceRunClient) Hooks() []Hook {
+	return c.hooks.TaskRecurrenceRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskRecurrenceRunClient) Interceptors() []Interceptor {
+	return c.inters.TaskRecurrenceRun
+}
+
+func (c *TaskRecurrenceRunClient) mutate(ctx context.Context, m *TaskRecurrenceRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskRecurrenceRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskRecurrenceRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskRecurrenceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskRecurrenceRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskRecurrenceRun mutation op: %q", m.Op())
+	}
+}
+
+// TaskReminderClient is a client for the TaskReminder schema.
+type TaskReminderClient struct {
+	config
+}
+
+// NewTaskReminderClient returns a client for the TaskReminder from the given config.
+func NewTaskReminderClient(c config) *TaskReminderClient {
+	return &TaskReminderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskreminder.Hooks(f(g(h())))`.
+func (c *TaskReminderClient) Use(hooks ...Hook) {
+	c.hooks.TaskReminder = append(c.hooks.TaskReminder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskreminder.Intercept(f(g(h())))`.
+func (c *TaskReminderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskReminder = append(c.inters.TaskReminder, interceptors...)
+}
+
+// Create returns a builder for creating a TaskReminder entity.
+func (c *TaskReminderClient) Create() *TaskReminderCreate {
+	mutation := newTaskReminderMutation(c.config, OpCreate)
+	return &TaskReminderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskReminder entities.
+func (c *TaskReminderClient) CreateBulk(builders ...*TaskReminderCreate) *TaskReminderCreateBulk {
+	return &TaskReminderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskReminderClient) MapCreateBulk(slice any, setFunc func(*TaskReminderCreate, int)) *TaskReminderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskReminderCreateBulk{err: fmt.Errorf("calling to TaskReminderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskReminderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskReminderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskReminder.
+func (c *TaskReminderClient) Update() *TaskReminderUpdate {
+	mutation := newTaskReminderMutation(c.config, OpUpdate)
+	return &TaskReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskReminderClient) UpdateOne(tr *TaskReminder) *TaskReminderUpdateOne {
+	mutation := newTaskReminderMutation(c.config, OpUpdateOne, withTaskReminder(tr))
+	return &TaskReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskReminderClient) UpdateOneID(id int) *TaskReminderUpdateOne {
+	mutation := newTaskReminderMutation(c.config, OpUpdateOne, withTaskReminderID(id))
+	return &TaskReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskReminder.
+func (c *TaskReminderClient) Delete() *TaskReminderDelete {
+	mutation := newTaskReminderMutation(c.config, OpDelete)
+	return &TaskReminderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskReminderClient) DeleteOne(tr *TaskReminder) *TaskReminderDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskReminderClient) DeleteOneID(id int) *TaskReminderDeleteOne {
+	builder := c.Delete().Where(taskreminder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskReminderDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskReminder.
+func (c *TaskReminderClient) Query() *TaskReminderQuery {
+	return &TaskReminderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskReminder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskReminder entity by its id.
+func (c *TaskReminderClient) Get(ctx context.Context, id int) (*TaskReminder, error) {
+	return c.Query().Where(taskreminder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskReminderClient) GetX(ctx context.Context, id int) *TaskReminder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskReminder.
+func (c *TaskReminderClient) QueryTask(tr *TaskReminder) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskreminder.Table, taskreminder.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskreminder.TaskTable, taskreminder.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskReminderClient) Hooks() []Hook {
+	return c.hooks.TaskReminder
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskReminderClient) Interceptors() []Interceptor {
+	return c.inters.TaskReminder
+}
+
+func (c *TaskReminderClient) mutate(ctx context.Context, m *TaskReminderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskReminderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskReminderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskReminder mutation op: %q", m.Op())
+	}
+}
+
+// TaskReportClient is a client for the TaskReport schema.
+type TaskReportClient struct {
+	config
+}
+
+// NewTaskReportClient returns a client for the TaskReport from the given config.
+func NewTaskReportClient(c config) *TaskReportClient {
+	return &TaskReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskreport.Hooks(f(g(h())))`.
+func (c *TaskReportClient) Use(hooks ...Hook) {
+	c.hooks.TaskReport = append(c.hooks.TaskReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskreport.Intercept(f(g(h())))`.
+func (c *TaskReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskReport = append(c.inters.TaskReport, interceptors...)
+}
+
+// Create returns a builder for creating a TaskReport entity.
+func (c *TaskReportClient) Create() *TaskReportCreate {
+	mutation := newTaskReportMutation(c.config, OpCreate)
+	return &TaskReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskReport entities.
+func (c *TaskReportClient) CreateBulk(builders ...*TaskReportCreate) *TaskReportCreateBulk {
+	return &TaskReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskReportClient) MapCreateBulk(slice any, setFunc func(*TaskReportCreate, int)) *TaskReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskReportCreateBulk{err: fmt.Errorf("calling to TaskReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskReport.
+func (c *TaskReportClient) Update() *TaskReportUpdate {
+	mutation := newTaskReportMutation(c.config, OpUpdate)
+	return &TaskReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskReportClient) UpdateOne(tr *TaskReport) *TaskReportUpdateOne {
+	mutation := newTaskReportMutation(c.config, OpUpdateOne, withTaskReport(tr))
+	return &TaskReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskReportClient) UpdateOneID(id int) *TaskReportUpdateOne {
+	mutation := newTaskReportMutation(c.config, OpUpdateOne, withTaskReportID(id))
+	return &TaskReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskReport.
+func (c *TaskReportClient) Delete() *TaskReportDelete {
+	mutation := newTaskReportMutation(c.config, OpDelete)
+	return &TaskReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskReportClient) DeleteOne(tr *TaskReport) *TaskReportDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskReportClient) DeleteOneID(id int) *TaskReportDeleteOne {
+	builder := c.Delete().Where(taskreport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskReportDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskReport.
+func (c *TaskReportClient) Query() *TaskReportQuery {
+	return &TaskReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskReport entity by its id.
+func (c *TaskReportClient) Get(ctx context.Context, id int) (*TaskReport, error) {
+	return c.Query().Where(taskreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskReportClient) GetX(ctx context.Context, id int) *TaskReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskReport.
+func (c *TaskReportClient) QueryTask(tr *TaskReport) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskreport.Table, taskreport.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskreport.TaskTable, taskreport.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReporter queries the reporter edge of a TaskReport.
+func (c *TaskReportClient) QueryReporter(tr *TaskReport) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskreport.Table, taskreport.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskreport.ReporterTable, taskreport.ReporterColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewer queries the reviewer edge of a TaskReport.
+func (c *TaskReportClient) QueryReviewer(tr *TaskReport) *EmployeeQuery {
+	query := (&EmployeeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskreport.Table, taskreport.FieldID, id),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskreport.ReviewerTable, taskreport.ReviewerColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskReportClient) Hooks() []Hook {
+	return c.hooks.TaskReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskReportClient) Interceptors() []Interceptor {
+	return c.inters.TaskReport
+}
+
+func (c *TaskReportClient) mutate(ctx context.Context, m *TaskReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskReport mutation op: %q", m.Op())
+	}
+}
+
+// TaskTemplateClient is a client for the TaskTemplate schema.
+type TaskTemplateClient struct {
+	config
+}
+
+// NewTaskTemplateClient returns a client for the TaskTemplate from the given config.
+func NewTaskTemplateClient(c config) *TaskTemplateClient {
+	return &TaskTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tasktemplate.Hooks(f(g(h())))`.
+func (c *TaskTemplateClient) Use(hooks ...Hook) {
+	c.hooks.TaskTemplate = append(c.hooks.TaskTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tasktemplate.Intercept(f(g(h())))`.
+func (c *TaskTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskTemplate = append(c.inters.TaskTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a TaskTemplate entity.
+func (c *TaskTemplateClient) Create() *TaskTemplateCreate {
+	mutation := newTaskTemplateMutation(c.config, OpCreate)
+	return &TaskTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskTemplate entities.
+func (c *TaskTemplateClient) CreateBulk(builders ...*TaskTemplateCreate) *TaskTemplateCreateBulk {
+	return &TaskTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskTemplateClient) MapCreateBulk(slice any, setFunc func(*TaskTemplateCreate, int)) *TaskTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskTemplateCreateBulk{err: fmt.Errorf("calling to TaskTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskTemplate.
+func (c *TaskTemplateClient) Update() *TaskTemplateUpdate {
+	mutation := newTaskTemplateMutation(c.config, OpUpdate)
+	return &TaskTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskTemplateClient) UpdateOne(tt *TaskTemplate) *TaskTemplateUpdateOne {
+	mutation := newTaskTemplateMutation(c.config, OpUpdateOne, withTaskTemplate(tt))
+	return &TaskTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskTemplateClient) UpdateOneID(id int) *TaskTemplateUpdateOne {
+	mutation := newTaskTemplateMutation(c.config, OpUpdateOne, withTaskTemplateID(id))
+	return &TaskTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskTemplate.
+func (c *TaskTemplateClient) Delete() *TaskTemplateDelete {
+	mutation := newTaskTemplateMutation(c.config, OpDelete)
+	return &TaskTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskTemplateClient) DeleteOne(tt *TaskTemplate) *TaskTemplateDeleteOne {
+	return c.DeleteOneID(tt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskTemplateClient) DeleteOneID(id int) *TaskTemplateDeleteOne {
+	builder := c.Delete().Where(tasktemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskTemplate.
+func (c *TaskTemplateClient) Query() *TaskTemplateQuery {
+	return &TaskTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskTemplate entity by its id.
+func (c *TaskTemplateClient) Get(ctx context.Context, id int) (*TaskTemplate, error) {
+	return c.Query().Where(tasktemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskTemplateClient) GetX(ctx context.Context, id int) *TaskTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a TaskTemplate.
+func (c *TaskTemplateClient) QueryProject(tt *TaskTemplate) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tasktemplate.Table, tasktemplate.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tasktemplate.ProjectTable, tasktemplate.ProjectColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryLeaveRequests queries the leave_requests edge of a Organization.
-func (c *OrganizationClient) QueryLeaveRequests(o *Organization) *LeaveRequestQuery {
-	query := (&LeaveRequestClient{config: c.config}).Query()
+// QueryRecurrences queries the recurrences edge of a TaskTemplate.
+func (c *TaskTemplateClient) QueryRecurrences(tt *TaskTemplate) *TaskRecurrenceQuery {
+	query := (&TaskRecurrenceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := o.ID
+		id := tt.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(leaverequest.Table, leaverequest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.LeaveRequestsTable, organization.LeaveRequestsColumn),
+			sqlgraph.From(tasktemplate.Table, tasktemplate.FieldID, id),
+			sqlgraph.To(taskrecurrence.Table, taskrecurrence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tasktemplate.RecurrencesTable, tasktemplate.RecurrencesColumn),
 		)
-		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationClient) Hooks() []Hook {
-	return c.hooks.Organization
+func (c *TaskTemplateClient) Hooks() []Hook {
+	return c.hooks.TaskTemplate
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationClient) Interceptors() []Interceptor {
-	return c.inters.Organization
+func (c *TaskTemplateClient) Interceptors() []Interceptor {
+	return c.inters.TaskTemplate
 }
 
-func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+func (c *TaskTemplateClient) mutate(ctx context.Context, m *TaskTemplateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TaskTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TaskTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TaskTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TaskTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TaskTemplate mutation op: %q", m.Op())
 	}
 }
 
-// PositionClient is a client for the Position schema.
-type PositionClient struct {
+// TaskViewClient is a client for the TaskView schema.
+type TaskViewClient struct {
 	config
 }
 
-// NewPositionClient returns a client for the Position from the given config.
-func NewPositionClient(c config) *PositionClient {
-	return &PositionClient{config: c}
+// NewTaskViewClient returns a client for the TaskView from the given config.
+func NewTaskViewClient(c config) *TaskViewClient {
+	return &TaskViewClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `position.Hooks(f(g(h())))`.
-func (c *PositionClient) Use(hooks ...Hook) {
-	c.hooks.Position = append(c.hooks.Position, hooks...)
+// A call to `Use(f, g, h)` equals to `taskview.Hooks(f(g(h())))`.
+func (c *TaskViewClient) Use(hooks ...Hook) {
+	c.hooks.TaskView = append(c.hooks.TaskView, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `position.Intercept(f(g(h())))`.
-func (c *PositionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Position = append(c.inters.Position, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `taskview.Intercept(f(g(h())))`.
+func (c *TaskViewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskView = append(c.inters.TaskView, interceptors...)
 }
 
-// Create returns a builder for creating a Position entity.
-func (c *PositionClient) Create() *PositionCreate {
-	mutation := newPositionMutation(c.config, OpCreate)
-	return &PositionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TaskView entity.
+func (c *TaskViewClient) Create() *TaskViewCreate {
+	mutation := newTaskViewMutation(c.config, OpCreate)
+	return &TaskViewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Position entities.
-func (c *PositionClient) CreateBulk(builders ...*PositionCreate) *PositionCreateBulk {
-	return &PositionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TaskView entities.
+func (c *TaskViewClient) CreateBulk(builders ...*TaskViewCreate) *TaskViewCreateBulk {
+	return &TaskViewCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PositionClient) MapCreateBulk(slice any, setFunc func(*PositionCreate, int)) *PositionCreateBulk {
+func (c *TaskViewClient) MapCreateBulk(slice any, setFunc func(*TaskViewCreate, int)) *TaskViewCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PositionCreateBulk{err: fmt.Errorf("calling to PositionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TaskViewCreateBulk{err: fmt.Errorf("calling to TaskViewClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PositionCreate, rv.Len())
+	builders := make([]*TaskViewCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PositionCreateBulk{config: c.config, builders: builders}
+	return &TaskViewCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Position.
-func (c *PositionClient) Update() *PositionUpdate {
-	mutation := newPositionMutation(c.config, OpUpdate)
-	return &PositionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TaskView.
+func (c *TaskViewClient) Update() *TaskViewUpdate {
+	mutation := newTaskViewMutation(c.config, OpUpdate)
+	return &TaskViewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PositionClient) UpdateOne(po *Position) *PositionUpdateOne {
-	mutation := newPositionMutation(c.config, OpUpdateOne, withPosition(po))
-	return &PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TaskViewClient) UpdateOne(tv *TaskView) *TaskViewUpdateOne {
+	mutation := newTaskViewMutation(c.config, OpUpdateOne, withTaskView(tv))
+	return &TaskViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PositionClient) UpdateOneID(id int) *PositionUpdateOne {
-	mutation := newPositionMutation(c.config, OpUpdateOne, withPositionID(id))
-	return &PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TaskViewClient) UpdateOneID(id int) *TaskViewUpdateOne {
+	mutation := newTaskViewMutation(c.config, OpUpdateOne, withTaskViewID(id))
+	return &TaskViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Position.
-func (c *PositionClient) Delete() *PositionDelete {
-	mutation := newPositionMutation(c.config, OpDelete)
-	return &PositionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TaskView.
+func (c *TaskViewClient) Delete() *TaskViewDelete {
+	mutation := newTaskViewMutation(c.config, OpDelete)
+	return &TaskViewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PositionClient) DeleteOne(po *Position) *PositionDeleteOne {
-	return c.DeleteOneID(po.ID)
+func (c *TaskViewClient) DeleteOne(tv *TaskView) *TaskViewDeleteOne {
+	return c.DeleteOneID(tv.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PositionClient) DeleteOneID(id int) *PositionDeleteOne {
-	builder := c.Delete().Where(position.ID(id))
+func (c *TaskViewClient) DeleteOneID(id int) *TaskViewDeleteOne {
+	builder := c.Delete().Where(taskview.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PositionDeleteOne{builder}
+	return &TaskViewDeleteOne{builder}
 }
 
-// Query returns a query builder for Position.
-func (c *PositionClient) Query() *PositionQuery {
-	return &PositionQuery{
+// Query returns a query builder for TaskView.
+func (c *TaskViewClient) Query() *TaskViewQuery {
+	return &TaskViewQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePosition},
+		ctx:    &QueryContext{Type: TypeTaskView},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Position entity by its id.
-func (c *PositionClient) Get(ctx context.Context, id int) (*Position, error) {
-	return c.Query().Where(position.ID(id)).Only(ctx)
+// Get returns a TaskView entity by its id.
+func (c *TaskViewClient) Get(ctx context.Context, id int) (*TaskView, error) {
+	return c.Query().Where(taskview.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PositionClient) GetX(ctx context.Context, id int) *Position {
+func (c *TaskViewClient) GetX(ctx context.Context, id int) *TaskView {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1726,196 +7660,148 @@ func (c *PositionClient) GetX(ctx context.Context, id int) *Position {
 	return obj
 }
 
-// QueryEmployees queries the employees edge of a Position.
-func (c *PositionClient) QueryEmployees(po *Position) *EmployeeQuery {
-	query := (&EmployeeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(position.Table, position.FieldID, id),
-			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, position.EmployeesTable, position.EmployeesColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDepartment queries the department edge of a Position.
-func (c *PositionClient) QueryDepartment(po *Position) *DepartmentQuery {
-	query := (&DepartmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(position.Table, position.FieldID, id),
-			sqlgraph.To(department.Table, department.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, position.DepartmentTable, position.DepartmentColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChildren queries the children edge of a Position.
-func (c *PositionClient) QueryChildren(po *Position) *PositionQuery {
-	query := (&PositionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(position.Table, position.FieldID, id),
-			sqlgraph.To(position.Table, position.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, position.ChildrenTable, position.ChildrenColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryParent queries the parent edge of a Position.
-func (c *PositionClient) QueryParent(po *Position) *PositionQuery {
-	query := (&PositionClient{config: c.config}).Query()
+// QueryProject queries the project edge of a TaskView.
+func (c *TaskViewClient) QueryProject(tv *TaskView) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
+		id := tv.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(position.Table, position.FieldID, id),
-			sqlgraph.To(position.Table, position.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, position.ParentTable, position.ParentColumn),
+			sqlgraph.From(taskview.Table, taskview.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskview.ProjectTable, taskview.ProjectColumn),
 		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tv.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PositionClient) Hooks() []Hook {
-	return c.hooks.Position
+func (c *TaskViewClient) Hooks() []Hook {
+	return c.hooks.TaskView
 }
 
 // Interceptors returns the client interceptors.
-func (c *PositionClient) Interceptors() []Interceptor {
-	return c.inters.Position
+func (c *TaskViewClient) Interceptors() []Interceptor {
+	return c.inters.TaskView
 }
 
-func (c *PositionClient) mutate(ctx context.Context, m *PositionMutation) (Value, error) {
+func (c *TaskViewClient) mutate(ctx context.Context, m *TaskViewMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PositionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TaskViewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PositionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TaskViewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TaskViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PositionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TaskViewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Position mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TaskView mutation op: %q", m.Op())
 	}
 }
 
-// ProjectClient is a client for the Project schema.
-type ProjectClient struct {
+// TaskWatcherClient is a client for the TaskWatcher schema.
+type TaskWatcherClient struct {
 	config
 }
 
-// NewProjectClient returns a client for the Project from the given config.
-func NewProjectClient(c config) *ProjectClient {
-	return &ProjectClient{config: c}
+// NewTaskWatcherClient returns a client for the TaskWatcher from the given config.
+func NewTaskWatcherClient(c config) *TaskWatcherClient {
+	return &TaskWatcherClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
-func (c *ProjectClient) Use(hooks ...Hook) {
-	c.hooks.Project = append(c.hooks.Project, hooks...)
+// A call to `Use(f, g, h)` equals to `taskwatcher.Hooks(f(g(h())))`.
+func (c *TaskWatcherClient) Use(hooks ...Hook) {
+	c.hooks.TaskWatcher = append(c.hooks.TaskWatcher, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
-func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Project = append(c.inters.Project, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `taskwatcher.Intercept(f(g(h())))`.
+func (c *TaskWatcherClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskWatcher = append(c.inters.TaskWatcher, interceptors...)
 }
 
-// Create returns a builder for creating a Project entity.
-func (c *ProjectClient) Create() *ProjectCreate {
-	mutation := newProjectMutation(c.config, OpCreate)
-	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TaskWatcher entity.
+func (c *TaskWatcherClient) Create() *TaskWatcherCreate {
+	mutation := newTaskWatcherMutation(c.config, OpCreate)
+	return &TaskWatcherCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Project entities.
-func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
-	return &ProjectCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TaskWatcher entities.
+func (c *TaskWatcherClient) CreateBulk(builders ...*TaskWatcherCreate) *TaskWatcherCreateBulk {
+	return &TaskWatcherCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+func (c *TaskWatcherClient) MapCreateBulk(slice any, setFunc func(*TaskWatcherCreate, int)) *TaskWatcherCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TaskWatcherCreateBulk{err: fmt.Errorf("calling to TaskWatcherClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProjectCreate, rv.Len())
+	builders := make([]*TaskWatcherCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProjectCreateBulk{config: c.config, builders: builders}
+	return &TaskWatcherCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Project.
-func (c *ProjectClient) Update() *ProjectUpdate {
-	mutation := newProjectMutation(c.config, OpUpdate)
-	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TaskWatcher.
+func (c *TaskWatcherClient) Update() *TaskWatcherUpdate {
+	mutation := newTaskWatcherMutation(c.config, OpUpdate)
+	return &TaskWatcherUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProjectClient) UpdateOne(pr *Project) *ProjectUpdateOne {
-	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(pr))
-	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TaskWatcherClient) UpdateOne(tw *TaskWatcher) *TaskWatcherUpdateOne {
+	mutation := newTaskWatcherMutation(c.config, OpUpdateOne, withTaskWatcher(tw))
+	return &TaskWatcherUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProjectClient) UpdateOneID(id int) *ProjectUpdateOne {
-	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
-	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TaskWatcherClient) UpdateOneID(id int) *TaskWatcherUpdateOne {
+	mutation := newTaskWatcherMutation(c.config, OpUpdateOne, withTaskWatcherID(id))
+	return &TaskWatcherUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Project.
-func (c *ProjectClient) Delete() *ProjectDelete {
-	mutation := newProjectMutation(c.config, OpDelete)
-	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TaskWatcher.
+func (c *TaskWatcherClient) Delete() *TaskWatcherDelete {
+	mutation := newTaskWatcherMutation(c.config, OpDelete)
+	return &TaskWatcherDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProjectClient) DeleteOne(pr *Project) *ProjectDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *TaskWatcherClient) DeleteOne(tw *TaskWatcher) *TaskWatcherDeleteOne {
+	return c.DeleteOneID(tw.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProjectClient) DeleteOneID(id int) *ProjectDeleteOne {
-	builder := c.Delete().Where(project.ID(id))
+func (c *TaskWatcherClient) DeleteOneID(id int) *TaskWatcherDeleteOne {
+	builder := c.Delete().Where(taskwatcher.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProjectDeleteOne{builder}
+	return &TaskWatcherDeleteOne{builder}
 }
 
-// Query returns a query builder for Project.
-func (c *ProjectClient) Query() *ProjectQuery {
-	return &ProjectQuery{
+// Query returns a query builder for TaskWatcher.
+func (c *TaskWatcherClient) Query() *TaskWatcherQuery {
+	return &TaskWatcherQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProject},
+		ctx:    &QueryContext{Type: TypeTaskWatcher},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Project entity by its id.
-func (c *ProjectClient) Get(ctx context.Context, id int) (*Project, error) {
-	return c.Query().Where(project.ID(id)).Only(ctx)
+// Get returns a TaskWatcher entity by its id.
+func (c *TaskWatcherClient) Get(ctx context.Context, id int) (*TaskWatcher, error) {
+	return c.Query().Where(taskwatcher.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
+func (c *TaskWatcherClient) GetX(ctx context.Context, id int) *TaskWatcher {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1923,212 +7809,313 @@ func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
 	return obj
 }
 
-// QueryTasks queries the tasks edge of a Project.
-func (c *ProjectClient) QueryTasks(pr *Project) *TaskQuery {
+// QueryTask queries the task edge of a TaskWatcher.
+func (c *TaskWatcherClient) QueryTask(tw *TaskWatcher) *TaskQuery {
 	query := (&TaskClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := tw.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.From(taskwatcher.Table, taskwatcher.FieldID, id),
 			sqlgraph.To(task.Table, task.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.TasksTable, project.TasksColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskwatcher.TaskTable, taskwatcher.TaskColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tw.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryOrganization queries the organization edge of a Project.
-func (c *ProjectClient) QueryOrganization(pr *Project) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, project.OrganizationTable, project.OrganizationColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *TaskWatcherClient) Hooks() []Hook {
+	return c.hooks.TaskWatcher
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskWatcherClient) Interceptors() []Interceptor {
+	return c.inters.TaskWatcher
+}
+
+func (c *TaskWatcherClient) mutate(ctx context.Context, m *TaskWatcherMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskWatcherCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskWatcherUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskWatcherUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskWatcherDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskWatcher mutation op: %q", m.Op())
+	}
+}
+
+// WorkLogClient is a client for the WorkLog schema.
+type WorkLogClient struct {
+	config
+}
+
+// NewWorkLogClient returns a client for the WorkLog from the given config.
+func NewWorkLogClient(c config) *WorkLogClient {
+	return &WorkLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `worklog.Hooks(f(g(h())))`.
+func (c *WorkLogClient) Use(hooks ...Hook) {
+	c.hooks.WorkLog = append(c.hooks.WorkLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `worklog.Intercept(f(g(h())))`.
+func (c *WorkLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkLog = append(c.inters.WorkLog, interceptors...)
+}
+
+// Create returns a builder for creating a WorkLog entity.
+func (c *WorkLogClient) Create() *WorkLogCreate {
+	mutation := newWorkLogMutation(c.config, OpCreate)
+	return &WorkLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkLog entities.
+func (c *WorkLogClient) CreateBulk(builders ...*WorkLogCreate) *WorkLogCreateBulk {
+	return &WorkLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkLogClient) MapCreateBulk(slice any, setFunc func(*WorkLogCreate, int)) *WorkLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkLogCreateBulk{err: fmt.Errorf("calling to WorkLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkLog.
+func (c *WorkLogClient) Update() *WorkLogUpdate {
+	mutation := newWorkLogMutation(c.config, OpUpdate)
+	return &WorkLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkLogClient) UpdateOne(wl *WorkLog) *WorkLogUpdateOne {
+	mutation := newWorkLogMutation(c.config, OpUpdateOne, withWorkLog(wl))
+	return &WorkLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkLogClient) UpdateOneID(id int) *WorkLogUpdateOne {
+	mutation := newWorkLogMutation(c.config, OpUpdateOne, withWorkLogID(id))
+	return &WorkLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkLog.
+func (c *WorkLogClient) Delete() *WorkLogDelete {
+	mutation := newWorkLogMutation(c.config, OpDelete)
+	return &WorkLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkLogClient) DeleteOne(wl *WorkLog) *WorkLogDeleteOne {
+	return c.DeleteOneID(wl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkLogClient) DeleteOneID(id int) *WorkLogDeleteOne {
+	builder := c.Delete().Where(worklog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkLogDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkLog.
+func (c *WorkLogClient) Query() *WorkLogQuery {
+	return &WorkLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkLog entity by its id.
+func (c *WorkLogClient) Get(ctx context.Context, id int) (*WorkLog, error) {
+	return c.Query().Where(worklog.ID(id)).Only(ctx)
 }
 
-// QueryCreator queries the creator edge of a Project.
-func (c *ProjectClient) QueryCreator(pr *Project) *EmployeeQuery {
-	query := (&EmployeeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, project.CreatorTable, project.CreatorColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkLogClient) GetX(ctx context.Context, id int) *WorkLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryUpdater queries the updater edge of a Project.
-func (c *ProjectClient) QueryUpdater(pr *Project) *EmployeeQuery {
-	query := (&EmployeeClient{config: c.config}).Query()
+// QueryTask queries the task edge of a WorkLog.
+func (c *WorkLogClient) QueryTask(wl *WorkLog) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := wl.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, project.UpdaterTable, project.UpdaterColumn),
+			sqlgraph.From(worklog.Table, worklog.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, worklog.TaskTable, worklog.TaskColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(wl.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryMembers queries the members edge of a Project.
-func (c *ProjectClient) QueryMembers(pr *Project) *EmployeeQuery {
+// QueryEmployee queries the employee edge of a WorkLog.
+func (c *WorkLogClient) QueryEmployee(wl *WorkLog) *EmployeeQuery {
 	query := (&EmployeeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := wl.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.From(worklog.Table, worklog.FieldID, id),
 			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, project.MembersTable, project.MembersPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, worklog.EmployeeTable, worklog.EmployeeColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(wl.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProjectClient) Hooks() []Hook {
-	return c.hooks.Project
+func (c *WorkLogClient) Hooks() []Hook {
+	return c.hooks.WorkLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProjectClient) Interceptors() []Interceptor {
-	return c.inters.Project
+func (c *WorkLogClient) Interceptors() []Interceptor {
+	return c.inters.WorkLog
 }
 
-func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+func (c *WorkLogClient) mutate(ctx context.Context, m *WorkLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WorkLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Project mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WorkLog mutation op: %q", m.Op())
 	}
 }
 
-// TaskClient is a client for the Task schema.
-type TaskClient struct {
+// WorkflowStatusClient is a client for the WorkflowStatus schema.
+type WorkflowStatusClient struct {
 	config
 }
 
-// NewTaskClient returns a client for the Task from the given config.
-func NewTaskClient(c config) *TaskClient {
-	return &TaskClient{config: c}
+// NewWorkflowStatusClient returns a client for the WorkflowStatus from the given config.
+func NewWorkflowStatusClient(c config) *WorkflowStatusClient {
+	return &WorkflowStatusClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
-func (c *TaskClient) Use(hooks ...Hook) {
-	c.hooks.Task = append(c.hooks.Task, hooks...)
+// A call to `Use(f, g, h)` equals to `workflowstatus.Hooks(f(g(h())))`.
+func (c *WorkflowStatusClient) Use(hooks ...Hook) {
+	c.hooks.WorkflowStatus = append(c.hooks.WorkflowStatus, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
-func (c *TaskClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Task = append(c.inters.Task, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `workflowstatus.Intercept(f(g(h())))`.
+func (c *WorkflowStatusClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkflowStatus = append(c.inters.WorkflowStatus, interceptors...)
 }
 
-// Create returns a builder for creating a Task entity.
-func (c *TaskClient) Create() *TaskCreate {
-	mutation := newTaskMutation(c.config, OpCreate)
-	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WorkflowStatus entity.
+func (c *WorkflowStatusClient) Create() *WorkflowStatusCreate {
+	mutation := newWorkflowStatusMutation(c.config, OpCreate)
+	return &WorkflowStatusCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Task entities.
-func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
-	return &TaskCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WorkflowStatus entities.
+func (c *WorkflowStatusClient) CreateBulk(builders ...*WorkflowStatusCreate) *WorkflowStatusCreateBulk {
+	return &WorkflowStatusCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+func (c *WorkflowStatusClient) MapCreateBulk(slice any, setFunc func(*WorkflowStatusCreate, int)) *WorkflowStatusCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WorkflowStatusCreateBulk{err: fmt.Errorf("calling to WorkflowStatusClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskCreate, rv.Len())
+	builders := make([]*WorkflowStatusCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskCreateBulk{config: c.config, builders: builders}
+	return &WorkflowStatusCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Task.
-func (c *TaskClient) Update() *TaskUpdate {
-	mutation := newTaskMutation(c.config, OpUpdate)
-	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WorkflowStatus.
+func (c *WorkflowStatusClient) Update() *WorkflowStatusUpdate {
+	mutation := newWorkflowStatusMutation(c.config, OpUpdate)
+	return &WorkflowStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskClient) UpdateOne(t *Task) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(t))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WorkflowStatusClient) UpdateOne(ws *WorkflowStatus) *WorkflowStatusUpdateOne {
+	mutation := newWorkflowStatusMutation(c.config, OpUpdateOne, withWorkflowStatus(ws))
+	return &WorkflowStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskClient) UpdateOneID(id int) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WorkflowStatusClient) UpdateOneID(id int) *WorkflowStatusUpdateOne {
+	mutation := newWorkflowStatusMutation(c.config, OpUpdateOne, withWorkflowStatusID(id))
+	return &WorkflowStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Task.
-func (c *TaskClient) Delete() *TaskDelete {
-	mutation := newTaskMutation(c.config, OpDelete)
-	return &TaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WorkflowStatus.
+func (c *WorkflowStatusClient) Delete() *WorkflowStatusDelete {
+	mutation := newWorkflowStatusMutation(c.config, OpDelete)
+	return &WorkflowStatusDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TaskClient) DeleteOne(t *Task) *TaskDeleteOne {
-	return c.DeleteOneID(t.ID)
+func (c *WorkflowStatusClient) DeleteOne(ws *WorkflowStatus) *WorkflowStatusDeleteOne {
+	return c.DeleteOneID(ws.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TaskClient) DeleteOneID(id int) *TaskDeleteOne {
-	builder := c.Delete().Where(task.ID(id))
+func (c *WorkflowStatusClient) DeleteOneID(id int) *WorkflowStatusDeleteOne {
+	builder := c.Delete().Where(workflowstatus.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TaskDeleteOne{builder}
+	return &WorkflowStatusDeleteOne{builder}
 }
 
-// Query returns a query builder for Task.
-func (c *TaskClient) Query() *TaskQuery {
-	return &TaskQuery{
+// Query returns a query builder for WorkflowStatus.
+func (c *WorkflowStatusClient) Query() *WorkflowStatusQuery {
+	return &WorkflowStatusQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTask},
+		ctx:    &QueryContext{Type: TypeWorkflowStatus},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Task entity by its id.
-func (c *TaskClient) Get(ctx context.Context, id int) (*Task, error) {
-	return c.Query().Where(task.ID(id)).Only(ctx)
+// Get returns a WorkflowStatus entity by its id.
+func (c *WorkflowStatusClient) Get(ctx context.Context, id int) (*WorkflowStatus, error) {
+	return c.Query().Where(workflowstatus.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
+func (c *WorkflowStatusClient) GetX(ctx context.Context, id int) *WorkflowStatus {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2136,196 +8123,148 @@ func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
 	return obj
 }
 
-// QueryProject queries the project edge of a Task.
-func (c *TaskClient) QueryProject(t *Task) *ProjectQuery {
+// QueryProject queries the project edge of a WorkflowStatus.
+func (c *WorkflowStatusClient) QueryProject(ws *WorkflowStatus) *ProjectQuery {
 	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := ws.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.From(workflowstatus.Table, workflowstatus.FieldID, id),
 			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, task.ProjectTable, task.ProjectColumn),
-		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLabels queries the labels edge of a Task.
-func (c *TaskClient) QueryLabels(t *Task) *LabelQuery {
-	query := (&LabelClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(label.Table, label.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, task.LabelsTable, task.LabelsPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.M2O, true, workflowstatus.ProjectTable, workflowstatus.ProjectColumn),
 		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAssignees queries the assignees edge of a Task.
-func (c *TaskClient) QueryAssignees(t *Task) *EmployeeQuery {
-	query := (&EmployeeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, task.AssigneesTable, task.AssigneesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReports queries the reports edge of a Task.
-func (c *TaskClient) QueryReports(t *Task) *TaskReportQuery {
-	query := (&TaskReportClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(taskreport.Table, taskreport.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, task.ReportsTable, task.ReportsColumn),
-		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ws.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *TaskClient) Hooks() []Hook {
-	return c.hooks.Task
+func (c *WorkflowStatusClient) Hooks() []Hook {
+	return c.hooks.WorkflowStatus
 }
 
 // Interceptors returns the client interceptors.
-func (c *TaskClient) Interceptors() []Interceptor {
-	return c.inters.Task
+func (c *WorkflowStatusClient) Interceptors() []Interceptor {
+	return c.inters.WorkflowStatus
 }
 
-func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error) {
+func (c *WorkflowStatusClient) mutate(ctx context.Context, m *WorkflowStatusMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkflowStatusCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkflowStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkflowStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WorkflowStatusDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Task mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WorkflowStatus mutation op: %q", m.Op())
 	}
 }
 
-// TaskReportClient is a client for the TaskReport schema.
-type TaskReportClient struct {
+// WorkflowTransitionClient is a client for the WorkflowTransition schema.
+type WorkflowTransitionClient struct {
 	config
 }
 
-// NewTaskReportClient returns a client for the TaskReport from the given config.
-func NewTaskReportClient(c config) *TaskReportClient {
-	return &TaskReportClient{config: c}
+// NewWorkflowTransitionClient returns a client for the WorkflowTransition from the given config.
+func NewWorkflowTransitionClient(c config) *WorkflowTransitionClient {
+	return &WorkflowTransitionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `taskreport.Hooks(f(g(h())))`.
-func (c *TaskReportClient) Use(hooks ...Hook) {
-	c.hooks.TaskReport = append(c.hooks.TaskReport, hooks...)
+// A call to `Use(f, g, h)` equals to `workflowtransition.Hooks(f(g(h())))`.
+func (c *WorkflowTransitionClient) Use(hooks ...Hook) {
+	c.hooks.WorkflowTransition = append(c.hooks.WorkflowTransition, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `taskreport.Intercept(f(g(h())))`.
-func (c *TaskReportClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TaskReport = append(c.inters.TaskReport, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `workflowtransition.Intercept(f(g(h())))`.
+func (c *WorkflowTransitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkflowTransition = append(c.inters.WorkflowTransition, interceptors...)
 }
 
-// Create returns a builder for creating a TaskReport entity.
-func (c *TaskReportClient) Create() *TaskReportCreate {
-	mutation := newTaskReportMutation(c.config, OpCreate)
-	return &TaskReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WorkflowTransition entity.
+func (c *WorkflowTransitionClient) Create() *WorkflowTransitionCreate {
+	mutation := newWorkflowTransitionMutation(c.config, OpCreate)
+	return &WorkflowTransitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TaskReport entities.
-func (c *TaskReportClient) CreateBulk(builders ...*TaskReportCreate) *TaskReportCreateBulk {
-	return &TaskReportCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WorkflowTransition entities.
+func (c *WorkflowTransitionClient) CreateBulk(builders ...*WorkflowTransitionCreate) *WorkflowTransitionCreateBulk {
+	return &WorkflowTransitionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskReportClient) MapCreateBulk(slice any, setFunc func(*TaskReportCreate, int)) *TaskReportCreateBulk {
+func (c *WorkflowTransitionClient) MapCreateBulk(slice any, setFunc func(*WorkflowTransitionCreate, int)) *WorkflowTransitionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskReportCreateBulk{err: fmt.Errorf("calling to TaskReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WorkflowTransitionCreateBulk{err: fmt.Errorf("calling to WorkflowTransitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskReportCreate, rv.Len())
+	builders := make([]*WorkflowTransitionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskReportCreateBulk{config: c.config, builders: builders}
+	return &WorkflowTransitionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TaskReport.
-func (c *TaskReportClient) Update() *TaskReportUpdate {
-	mutation := newTaskReportMutation(c.config, OpUpdate)
-	return &TaskReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WorkflowTransition.
+func (c *WorkflowTransitionClient) Update() *WorkflowTransitionUpdate {
+	mutation := newWorkflowTransitionMutation(c.config, OpUpdate)
+	return &WorkflowTransitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskReportClient) UpdateOne(tr *TaskReport) *TaskReportUpdateOne {
-	mutation := newTaskReportMutation(c.config, OpUpdateOne, withTaskReport(tr))
-	return &TaskReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WorkflowTransitionClient) UpdateOne(wt *WorkflowTransition) *WorkflowTransitionUpdateOne {
+	mutation := newWorkflowTransitionMutation(c.config, OpUpdateOne, withWorkflowTransition(wt))
+	return &WorkflowTransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskReportClient) UpdateOneID(id int) *TaskReportUpdateOne {
-	mutation := newTaskReportMutation(c.config, OpUpdateOne, withTaskReportID(id))
-	return &TaskReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WorkflowTransitionClient) UpdateOneID(id int) *WorkflowTransitionUpdateOne {
+	mutation := newWorkflowTransitionMutation(c.config, OpUpdateOne, withWorkflowTransitionID(id))
+	return &WorkflowTransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TaskReport.
-func (c *TaskReportClient) Delete() *TaskReportDelete {
-	mutation := newTaskReportMutation(c.config, OpDelete)
-	return &TaskReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WorkflowTransition.
+func (c *WorkflowTransitionClient) Delete() *WorkflowTransitionDelete {
+	mutation := newWorkflowTransitionMutation(c.config, OpDelete)
+	return &WorkflowTransitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TaskReportClient) DeleteOne(tr *TaskReport) *TaskReportDeleteOne {
-	return c.DeleteOneID(tr.ID)
+func (c *WorkflowTransitionClient) DeleteOne(wt *WorkflowTransition) *WorkflowTransitionDeleteOne {
+	return c.DeleteOneID(wt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TaskReportClient) DeleteOneID(id int) *TaskReportDeleteOne {
-	builder := c.Delete().Where(taskreport.ID(id))
+func (c *WorkflowTransitionClient) DeleteOneID(id int) *WorkflowTransitionDeleteOne {
+	builder := c.Delete().Where(workflowtransition.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TaskReportDeleteOne{builder}
+	return &WorkflowTransitionDeleteOne{builder}
 }
 
-// Query returns a query builder for TaskReport.
-func (c *TaskReportClient) Query() *TaskReportQuery {
-	return &TaskReportQuery{
+// Query returns a query builder for WorkflowTransition.
+func (c *WorkflowTransitionClient) Query() *WorkflowTransitionQuery {
+	return &WorkflowTransitionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTaskReport},
+		ctx:    &QueryContext{Type: TypeWorkflowTransition},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TaskReport entity by its id.
-func (c *TaskReportClient) Get(ctx context.Context, id int) (*TaskReport, error) {
-	return c.Query().Where(taskreport.ID(id)).Only(ctx)
+// Get returns a WorkflowTransition entity by its id.
+func (c *WorkflowTransitionClient) Get(ctx context.Context, id int) (*WorkflowTransition, error) {
+	return c.Query().Where(workflowtransition.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TaskReportClient) GetX(ctx context.Context, id int) *TaskReport {
+func (c *WorkflowTransitionClient) GetX(ctx context.Context, id int) *WorkflowTransition {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2333,71 +8272,68 @@ func (c *TaskReportClient) GetX(ctx context.Context, id int) *TaskReport {
 	return obj
 }
 
-// QueryTask queries the task edge of a TaskReport.
-func (c *TaskReportClient) QueryTask(tr *TaskReport) *TaskQuery {
-	query := (&TaskClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(taskreport.Table, taskreport.FieldID, id),
-			sqlgraph.To(task.Table, task.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, taskreport.TaskTable, taskreport.TaskColumn),
-		)
-		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReporter queries the reporter edge of a TaskReport.
-func (c *TaskReportClient) QueryReporter(tr *TaskReport) *EmployeeQuery {
-	query := (&EmployeeClient{config: c.config}).Query()
+// QueryProject queries the project edge of a WorkflowTransition.
+func (c *WorkflowTransitionClient) QueryProject(wt *WorkflowTransition) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := tr.ID
+		id := wt.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(taskreport.Table, taskreport.FieldID, id),
-			sqlgraph.To(employee.Table, employee.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, taskreport.ReporterTable, taskreport.ReporterColumn),
+			sqlgraph.From(workflowtransition.Table, workflowtransition.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workflowtransition.ProjectTable, workflowtransition.ProjectColumn),
 		)
-		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(wt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *TaskReportClient) Hooks() []Hook {
-	return c.hooks.TaskReport
+func (c *WorkflowTransitionClient) Hooks() []Hook {
+	return c.hooks.WorkflowTransition
 }
 
 // Interceptors returns the client interceptors.
-func (c *TaskReportClient) Interceptors() []Interceptor {
-	return c.inters.TaskReport
+func (c *WorkflowTransitionClient) Interceptors() []Interceptor {
+	return c.inters.WorkflowTransition
 }
 
-func (c *TaskReportClient) mutate(ctx context.Context, m *TaskReportMutation) (Value, error) {
+func (c *WorkflowTransitionClient) mutate(ctx context.Context, m *WorkflowTransitionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TaskReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkflowTransitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TaskReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkflowTransitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TaskReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkflowTransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TaskReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WorkflowTransitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TaskReport mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WorkflowTransition mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AppointmentHistory, Department, Employee, Label, LeaveApproval, LeaveRequest,
-		Organization, Position, Project, Task, TaskReport []ent.Hook
+		AppointmentHistory, Attachment, AttendanceCorrection, AttendanceRecord,
+		Compensation, Department, Employee, EmployeeContract, EmployeeStatusHistory,
+		Holiday, Label, LeaveApproval, LeaveRequest, Milestone, Notification,
+		NotificationPreference, Organization, OvertimeRequest, Position, Project,
+		ProjectRole, ReminderSetting, Roster, SalaryGrade, Shift, ShiftSwapRequest,
+		Sprint, Task, TaskActivity, TaskComment, TaskCommentRevision, TaskDependency,
+		TaskRecurrence, TaskRecurrenceRun, TaskReminder, TaskReport, TaskTemplate,
+		TaskView, TaskWatcher, WorkLog, WorkflowStatus, WorkflowTransition []ent.Hook
 	}
 	inters struct {
-		AppointmentHistory, Department, Employee, Label, LeaveApproval, LeaveRequest,
-		Organization, Position, Project, Task, TaskReport []ent.Interceptor
+		AppointmentHistory, Attachment, AttendanceCorrection, AttendanceRecord,
+		Compensation, Department, Employee, EmployeeContract, EmployeeStatusHistory,
+		Holiday, Label, LeaveApproval, LeaveRequest, Milestone, Notification,
+		NotificationPreference, Organization, OvertimeRequest, Position, Project,
+		ProjectRole, ReminderSetting, Roster, SalaryGrade, Shift, ShiftSwapRequest,
+		Sprint, Task, TaskActivity, TaskComment, TaskCommentRevision, TaskDependency,
+		TaskRecurrence, TaskRecurrenceRun, TaskReminder, TaskReport, TaskTemplate,
+		TaskView, TaskWatcher, WorkLog, WorkflowStatus,
+		WorkflowTransition []ent.Interceptor
 	}
 )
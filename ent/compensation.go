@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+)
+
+// Compensation is the model entity for the Compensation schema.
+type Compensation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// SalaryGradeID holds the value of the "salary_grade_id" field.
+	SalaryGradeID *int `json:"salary_grade_id"`
+	// BasePay holds the value of the "base_pay" field.
+	BasePay int64 `json:"base_pay"`
+	// Allowances holds the value of the "allowances" field.
+	Allowances map[string]int64 `json:"allowances"`
+	// TotalAllowance holds the value of the "total_allowance" field.
+	TotalAllowance int64 `json:"total_allowance"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency"`
+	// EffectiveFrom holds the value of the "effective_from" field.
+	EffectiveFrom time.Time `json:"effective_from"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason"`
+	// Status holds the value of the "status" field.
+	Status compensation.Status `json:"status"`
+	// RequesterID holds the value of the "requester_id" field.
+	RequesterID int `json:"requester_id"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *int `json:"reviewer_id"`
+	// ReviewComment holds the value of the "review_comment" field.
+	ReviewComment *string `json:"review_comment"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CompensationQuery when eager-loading is set.
+	Edges        CompensationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CompensationEdges holds the relations/edges for other nodes in the graph.
+type CompensationEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee"`
+	// SalaryGrade holds the value of the salary_grade edge.
+	SalaryGrade *SalaryGrade `json:"salary_grade"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CompensationEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// SalaryGradeOrErr returns the SalaryGrade value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CompensationEdges) SalaryGradeOrErr() (*SalaryGrade, error) {
+	if e.SalaryGrade != nil {
+		return e.SalaryGrade, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: salarygrade.Label}
+	}
+	return nil, &NotLoadedError{edge: "salary_grade"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Compensation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case compensation.FieldAllowances:
+			values[i] = new([]byte)
+		case compensation.FieldID, compensation.FieldEmployeeID, compensation.FieldOrgID, compensation.FieldSalaryGradeID, compensation.FieldBasePay, compensation.FieldTotalAllowance, compensation.FieldRequesterID, compensation.FieldReviewerID:
+			values[i] = new(sql.NullInt64)
+		case compensation.FieldCurrency, compensation.FieldReason, compensation.FieldStatus, compensation.FieldReviewComment:
+			values[i] = new(sql.NullString)
+		case compensation.FieldEffectiveFrom, compensation.FieldReviewedAt, compensation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Compensation fields.
+func (c *Compensation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case compensation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case compensation.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				c.EmployeeID = int(value.Int64)
+			}
+		case compensation.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				c.OrgID = int(value.Int64)
+			}
+		case compensation.FieldSalaryGradeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field salary_grade_id", values[i])
+			} else if value.Valid {
+				c.SalaryGradeID = new(int)
+				*c.SalaryGradeID = int(value.Int64)
+			}
+		case compensation.FieldBasePay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field base_pay", values[i])
+			} else if value.Valid {
+				c.BasePay = value.Int64
+			}
+		case compensation.FieldAllowances:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowances", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Allowances); err != nil {
+					return fmt.Errorf("unmarshal field allowances: %w", err)
+				}
+			}
+		case compensation.FieldTotalAllowance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_allowance", values[i])
+			} else if value.Valid {
+				c.TotalAllowance = value.Int64
+			}
+		case compensation.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				c.Currency = value.String
+			}
+		case compensation.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				c.EffectiveFrom = value.Time
+			}
+		case compensation.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				c.Reason = value.String
+			}
+		case compensation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = compensation.Status(value.String)
+			}
+		case compensation.FieldRequesterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requester_id", values[i])
+			} else if value.Valid {
+				c.RequesterID = int(value.Int64)
+			}
+		case compensation.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				c.ReviewerID = new(int)
+				*c.ReviewerID = int(value.Int64)
+			}
+		case compensation.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				c.ReviewComment = new(string)
+				*c.ReviewComment = value.String
+			}
+		case compensation.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				c.ReviewedAt = new(time.Time)
+				*c.ReviewedAt = value.Time
+			}
+		case compensation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Compensation.
+// This includes values selected through modifiers, order, etc.
+func (c *Compensation) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the Compensation entity.
+func (c *Compensation) QueryEmployee() *EmployeeQuery {
+	return NewCompensationClient(c.config).QueryEmployee(c)
+}
+
+// QuerySalaryGrade queries the "salary_grade" edge of the Compensation entity.
+func (c *Compensation) QuerySalaryGrade() *SalaryGradeQuery {
+	return NewCompensationClient(c.config).QuerySalaryGrade(c)
+}
+
+// Update returns a builder for updating this Compensation.
+// Note that you need to call Compensation.Unwrap() before calling this method if this Compensation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Compensation) Update() *CompensationUpdateOne {
+	return NewCompensationClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Compensation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Compensation) Unwrap() *Compensation {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Compensation is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Compensation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Compensation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.OrgID))
+	builder.WriteString(", ")
+	if v := c.SalaryGradeID; v != nil {
+		builder.WriteString("salary_grade_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("base_pay=")
+	builder.WriteString(fmt.Sprintf("%v", c.BasePay))
+	builder.WriteString(", ")
+	builder.WriteString("allowances=")
+	builder.WriteString(fmt.Sprintf("%v", c.Allowances))
+	builder.WriteString(", ")
+	builder.WriteString("total_allowance=")
+	builder.WriteString(fmt.Sprintf("%v", c.TotalAllowance))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(c.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(c.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(c.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", c.Status))
+	builder.WriteString(", ")
+	builder.WriteString("requester_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.RequesterID))
+	builder.WriteString(", ")
+	if v := c.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.ReviewComment; v != nil {
+		builder.WriteString("review_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := c.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Compensations is a parsable slice of Compensation.
+type Compensations []*Compensation
@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package compensation
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the compensation type in the database.
+	Label = "compensation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmployeeID holds the string denoting the employee_id field in the database.
+	FieldEmployeeID = "employee_id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldSalaryGradeID holds the string denoting the salary_grade_id field in the database.
+	FieldSalaryGradeID = "salary_grade_id"
+	// FieldBasePay holds the string denoting the base_pay field in the database.
+	FieldBasePay = "base_pay"
+	// FieldAllowances holds the string denoting the allowances field in the database.
+	FieldAllowances = "allowances"
+	// FieldTotalAllowance holds the string denoting the total_allowance field in the database.
+	FieldTotalAllowance = "total_allowance"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldEffectiveFrom holds the string denoting the effective_from field in the database.
+	FieldEffectiveFrom = "effective_from"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRequesterID holds the string denoting the requester_id field in the database.
+	FieldRequesterID = "requester_id"
+	// FieldReviewerID holds the string denoting the reviewer_id field in the database.
+	FieldReviewerID = "reviewer_id"
+	// FieldReviewComment holds the string denoting the review_comment field in the database.
+	FieldReviewComment = "review_comment"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeEmployee holds the string denoting the employee edge name in mutations.
+	EdgeEmployee = "employee"
+	// EdgeSalaryGrade holds the string denoting the salary_grade edge name in mutations.
+	EdgeSalaryGrade = "salary_grade"
+	// Table holds the table name of the compensation in the database.
+	Table = "compensations"
+	// EmployeeTable is the table that holds the employee relation/edge.
+	EmployeeTable = "compensations"
+	// EmployeeInverseTable is the table name for the Employee entity.
+	// It exists in this package in order to avoid circular dependency with the "employee" package.
+	EmployeeInverseTable = "employees"
+	// EmployeeColumn is the table column denoting the employee relation/edge.
+	EmployeeColumn = "employee_id"
+	// SalaryGradeTable is the table that holds the salary_grade relation/edge.
+	SalaryGradeTable = "compensations"
+	// SalaryGradeInverseTable is the table name for the SalaryGrade entity.
+	// It exists in this package in order to avoid circular dependency with the "salarygrade" package.
+	SalaryGradeInverseTable = "salary_grades"
+	// SalaryGradeColumn is the table column denoting the salary_grade relation/edge.
+	SalaryGradeColumn = "salary_grade_id"
+)
+
+// Columns holds all SQL columns for compensation fields.
+var Columns = []string{
+	FieldID,
+	FieldEmployeeID,
+	FieldOrgID,
+	FieldSalaryGradeID,
+	FieldBasePay,
+	FieldAllowances,
+	FieldTotalAllowance,
+	FieldCurrency,
+	FieldEffectiveFrom,
+	FieldReason,
+	FieldStatus,
+	FieldRequesterID,
+	FieldReviewerID,
+	FieldReviewComment,
+	FieldReviewedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// BasePayValidator is a validator for the "base_pay" field. It is called by the builders before save.
+	BasePayValidator func(int64) error
+	// DefaultTotalAllowance holds the default value on creation for the "total_allowance" field.
+	DefaultTotalAllowance int64
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("compensation: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Compensation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEmployeeID orders the results by the employee_id field.
+func ByEmployeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployeeID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// BySalaryGradeID orders the results by the salary_grade_id field.
+func BySalaryGradeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSalaryGradeID, opts...).ToFunc()
+}
+
+// ByBasePay orders the results by the base_pay field.
+func ByBasePay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBasePay, opts...).ToFunc()
+}
+
+// ByTotalAllowance orders the results by the total_allowance field.
+func ByTotalAllowance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalAllowance, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByEffectiveFrom orders the results by the effective_from field.
+func ByEffectiveFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveFrom, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRequesterID orders the results by the requester_id field.
+func ByRequesterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequesterID, opts...).ToFunc()
+}
+
+// ByReviewerID orders the results by the reviewer_id field.
+func ByReviewerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewerID, opts...).ToFunc()
+}
+
+// ByReviewComment orders the results by the review_comment field.
+func ByReviewComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewComment, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByEmployeeField orders the results by employee field.
+func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySalaryGradeField orders the results by salary_grade field.
+func BySalaryGradeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSalaryGradeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newEmployeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmployeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+	)
+}
+func newSalaryGradeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SalaryGradeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SalaryGradeTable, SalaryGradeColumn),
+	)
+}
@@ -0,0 +1,817 @@
+// Code generated by ent, DO NOT EDIT.
+
+package compensation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldID, id))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldOrgID, v))
+}
+
+// SalaryGradeID applies equality check predicate on the "salary_grade_id" field. It's identical to SalaryGradeIDEQ.
+func SalaryGradeID(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldSalaryGradeID, v))
+}
+
+// BasePay applies equality check predicate on the "base_pay" field. It's identical to BasePayEQ.
+func BasePay(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldBasePay, v))
+}
+
+// TotalAllowance applies equality check predicate on the "total_allowance" field. It's identical to TotalAllowanceEQ.
+func TotalAllowance(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldTotalAllowance, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldCurrency, v))
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldReason, v))
+}
+
+// RequesterID applies equality check predicate on the "requester_id" field. It's identical to RequesterIDEQ.
+func RequesterID(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldOrgID, v))
+}
+
+// SalaryGradeIDEQ applies the EQ predicate on the "salary_grade_id" field.
+func SalaryGradeIDEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldSalaryGradeID, v))
+}
+
+// SalaryGradeIDNEQ applies the NEQ predicate on the "salary_grade_id" field.
+func SalaryGradeIDNEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldSalaryGradeID, v))
+}
+
+// SalaryGradeIDIn applies the In predicate on the "salary_grade_id" field.
+func SalaryGradeIDIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldSalaryGradeID, vs...))
+}
+
+// SalaryGradeIDNotIn applies the NotIn predicate on the "salary_grade_id" field.
+func SalaryGradeIDNotIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldSalaryGradeID, vs...))
+}
+
+// SalaryGradeIDIsNil applies the IsNil predicate on the "salary_grade_id" field.
+func SalaryGradeIDIsNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldIsNull(FieldSalaryGradeID))
+}
+
+// SalaryGradeIDNotNil applies the NotNil predicate on the "salary_grade_id" field.
+func SalaryGradeIDNotNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotNull(FieldSalaryGradeID))
+}
+
+// BasePayEQ applies the EQ predicate on the "base_pay" field.
+func BasePayEQ(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldBasePay, v))
+}
+
+// BasePayNEQ applies the NEQ predicate on the "base_pay" field.
+func BasePayNEQ(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldBasePay, v))
+}
+
+// BasePayIn applies the In predicate on the "base_pay" field.
+func BasePayIn(vs ...int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldBasePay, vs...))
+}
+
+// BasePayNotIn applies the NotIn predicate on the "base_pay" field.
+func BasePayNotIn(vs ...int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldBasePay, vs...))
+}
+
+// BasePayGT applies the GT predicate on the "base_pay" field.
+func BasePayGT(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldBasePay, v))
+}
+
+// BasePayGTE applies the GTE predicate on the "base_pay" field.
+func BasePayGTE(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldBasePay, v))
+}
+
+// BasePayLT applies the LT predicate on the "base_pay" field.
+func BasePayLT(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldBasePay, v))
+}
+
+// BasePayLTE applies the LTE predicate on the "base_pay" field.
+func BasePayLTE(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldBasePay, v))
+}
+
+// AllowancesIsNil applies the IsNil predicate on the "allowances" field.
+func AllowancesIsNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldIsNull(FieldAllowances))
+}
+
+// AllowancesNotNil applies the NotNil predicate on the "allowances" field.
+func AllowancesNotNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotNull(FieldAllowances))
+}
+
+// TotalAllowanceEQ applies the EQ predicate on the "total_allowance" field.
+func TotalAllowanceEQ(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldTotalAllowance, v))
+}
+
+// TotalAllowanceNEQ applies the NEQ predicate on the "total_allowance" field.
+func TotalAllowanceNEQ(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldTotalAllowance, v))
+}
+
+// TotalAllowanceIn applies the In predicate on the "total_allowance" field.
+func TotalAllowanceIn(vs ...int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldTotalAllowance, vs...))
+}
+
+// TotalAllowanceNotIn applies the NotIn predicate on the "total_allowance" field.
+func TotalAllowanceNotIn(vs ...int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldTotalAllowance, vs...))
+}
+
+// TotalAllowanceGT applies the GT predicate on the "total_allowance" field.
+func TotalAllowanceGT(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldTotalAllowance, v))
+}
+
+// TotalAllowanceGTE applies the GTE predicate on the "total_allowance" field.
+func TotalAllowanceGTE(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldTotalAllowance, v))
+}
+
+// TotalAllowanceLT applies the LT predicate on the "total_allowance" field.
+func TotalAllowanceLT(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldTotalAllowance, v))
+}
+
+// TotalAllowanceLTE applies the LTE predicate on the "total_allowance" field.
+func TotalAllowanceLTE(v int64) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldTotalAllowance, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldEffectiveFrom, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RequesterIDEQ applies the EQ predicate on the "requester_id" field.
+func RequesterIDEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// RequesterIDNEQ applies the NEQ predicate on the "requester_id" field.
+func RequesterIDNEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldRequesterID, v))
+}
+
+// RequesterIDIn applies the In predicate on the "requester_id" field.
+func RequesterIDIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDNotIn applies the NotIn predicate on the "requester_id" field.
+func RequesterIDNotIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDGT applies the GT predicate on the "requester_id" field.
+func RequesterIDGT(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldRequesterID, v))
+}
+
+// RequesterIDGTE applies the GTE predicate on the "requester_id" field.
+func RequesterIDGTE(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldRequesterID, v))
+}
+
+// RequesterIDLT applies the LT predicate on the "requester_id" field.
+func RequesterIDLT(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldRequesterID, v))
+}
+
+// RequesterIDLTE applies the LTE predicate on the "requester_id" field.
+func RequesterIDLTE(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldRequesterID, v))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v int) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentIsNil applies the IsNil predicate on the "review_comment" field.
+func ReviewCommentIsNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldIsNull(FieldReviewComment))
+}
+
+// ReviewCommentNotNil applies the NotNil predicate on the "review_comment" field.
+func ReviewCommentNotNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotNull(FieldReviewComment))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.Compensation {
+	return predicate.Compensation(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Compensation {
+	return predicate.Compensation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.Compensation {
+	return predicate.Compensation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.Compensation {
+	return predicate.Compensation(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSalaryGrade applies the HasEdge predicate on the "salary_grade" edge.
+func HasSalaryGrade() predicate.Compensation {
+	return predicate.Compensation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SalaryGradeTable, SalaryGradeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalaryGradeWith applies the HasEdge predicate on the "salary_grade" edge with a given conditions (other predicates).
+func HasSalaryGradeWith(preds ...predicate.SalaryGrade) predicate.Compensation {
+	return predicate.Compensation(func(s *sql.Selector) {
+		step := newSalaryGradeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Compensation) predicate.Compensation {
+	return predicate.Compensation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Compensation) predicate.Compensation {
+	return predicate.Compensation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Compensation) predicate.Compensation {
+	return predicate.Compensation(sql.NotPredicates(p))
+}
@@ -0,0 +1,1036 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+)
+
+// CompensationCreate is the builder for creating a Compensation entity.
+type CompensationCreate struct {
+	config
+	mutation *CompensationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (cc *CompensationCreate) SetEmployeeID(i int) *CompensationCreate {
+	cc.mutation.SetEmployeeID(i)
+	return cc
+}
+
+// SetOrgID sets the "org_id" field.
+func (cc *CompensationCreate) SetOrgID(i int) *CompensationCreate {
+	cc.mutation.SetOrgID(i)
+	return cc
+}
+
+// SetSalaryGradeID sets the "salary_grade_id" field.
+func (cc *CompensationCreate) SetSalaryGradeID(i int) *CompensationCreate {
+	cc.mutation.SetSalaryGradeID(i)
+	return cc
+}
+
+// SetNillableSalaryGradeID sets the "salary_grade_id" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableSalaryGradeID(i *int) *CompensationCreate {
+	if i != nil {
+		cc.SetSalaryGradeID(*i)
+	}
+	return cc
+}
+
+// SetBasePay sets the "base_pay" field.
+func (cc *CompensationCreate) SetBasePay(i int64) *CompensationCreate {
+	cc.mutation.SetBasePay(i)
+	return cc
+}
+
+// SetAllowances sets the "allowances" field.
+func (cc *CompensationCreate) SetAllowances(m map[string]int64) *CompensationCreate {
+	cc.mutation.SetAllowances(m)
+	return cc
+}
+
+// SetTotalAllowance sets the "total_allowance" field.
+func (cc *CompensationCreate) SetTotalAllowance(i int64) *CompensationCreate {
+	cc.mutation.SetTotalAllowance(i)
+	return cc
+}
+
+// SetNillableTotalAllowance sets the "total_allowance" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableTotalAllowance(i *int64) *CompensationCreate {
+	if i != nil {
+		cc.SetTotalAllowance(*i)
+	}
+	return cc
+}
+
+// SetCurrency sets the "currency" field.
+func (cc *CompensationCreate) SetCurrency(s string) *CompensationCreate {
+	cc.mutation.SetCurrency(s)
+	return cc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableCurrency(s *string) *CompensationCreate {
+	if s != nil {
+		cc.SetCurrency(*s)
+	}
+	return cc
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (cc *CompensationCreate) SetEffectiveFrom(t time.Time) *CompensationCreate {
+	cc.mutation.SetEffectiveFrom(t)
+	return cc
+}
+
+// SetReason sets the "reason" field.
+func (cc *CompensationCreate) SetReason(s string) *CompensationCreate {
+	cc.mutation.SetReason(s)
+	return cc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableReason(s *string) *CompensationCreate {
+	if s != nil {
+		cc.SetReason(*s)
+	}
+	return cc
+}
+
+// SetStatus sets the "status" field.
+func (cc *CompensationCreate) SetStatus(c compensation.Status) *CompensationCreate {
+	cc.mutation.SetStatus(c)
+	return cc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableStatus(c *compensation.Status) *CompensationCreate {
+	if c != nil {
+		cc.SetStatus(*c)
+	}
+	return cc
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (cc *CompensationCreate) SetRequesterID(i int) *CompensationCreate {
+	cc.mutation.SetRequesterID(i)
+	return cc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (cc *CompensationCreate) SetReviewerID(i int) *CompensationCreate {
+	cc.mutation.SetReviewerID(i)
+	return cc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableReviewerID(i *int) *CompensationCreate {
+	if i != nil {
+		cc.SetReviewerID(*i)
+	}
+	return cc
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (cc *CompensationCreate) SetReviewComment(s string) *CompensationCreate {
+	cc.mutation.SetReviewComment(s)
+	return cc
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableReviewComment(s *string) *CompensationCreate {
+	if s != nil {
+		cc.SetReviewComment(*s)
+	}
+	return cc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (cc *CompensationCreate) SetReviewedAt(t time.Time) *CompensationCreate {
+	cc.mutation.SetReviewedAt(t)
+	return cc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableReviewedAt(t *time.Time) *CompensationCreate {
+	if t != nil {
+		cc.SetReviewedAt(*t)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CompensationCreate) SetCreatedAt(t time.Time) *CompensationCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CompensationCreate) SetNillableCreatedAt(t *time.Time) *CompensationCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (cc *CompensationCreate) SetEmployee(e *Employee) *CompensationCreate {
+	return cc.SetEmployeeID(e.ID)
+}
+
+// SetSalaryGrade sets the "salary_grade" edge to the SalaryGrade entity.
+func (cc *CompensationCreate) SetSalaryGrade(s *SalaryGrade) *CompensationCreate {
+	return cc.SetSalaryGradeID(s.ID)
+}
+
+// Mutation returns the CompensationMutation object of the builder.
+func (cc *CompensationCreate) Mutation() *CompensationMutation {
+	return cc.mutation
+}
+
+// Save creates the Compensation in the database.
+func (cc *CompensationCreate) Save(ctx context.Context) (*Compensation, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CompensationCreate) SaveX(ctx context.Context) *Compensation {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CompensationCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CompensationCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CompensationCreate) defaults() {
+	if _, ok := cc.mutation.TotalAllowance(); !ok {
+		v := compensation.DefaultTotalAllowance
+		cc.mutation.SetTotalAllowance(v)
+	}
+	if _, ok := cc.mutation.Currency(); !ok {
+		v := compensation.DefaultCurrency
+		cc.mutation.SetCurrency(v)
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		v := compensation.DefaultStatus
+		cc.mutation.SetStatus(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := compensation.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CompensationCreate) check() error {
+	if _, ok := cc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "Compensation.employee_id"`)}
+	}
+	if _, ok := cc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "Compensation.org_id"`)}
+	}
+	if _, ok := cc.mutation.BasePay(); !ok {
+		return &ValidationError{Name: "base_pay", err: errors.New(`ent: missing required field "Compensation.base_pay"`)}
+	}
+	if v, ok := cc.mutation.BasePay(); ok {
+		if err := compensation.BasePayValidator(v); err != nil {
+			return &ValidationError{Name: "base_pay", err: fmt.Errorf(`ent: validator failed for field "Compensation.base_pay": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.TotalAllowance(); !ok {
+		return &ValidationError{Name: "total_allowance", err: errors.New(`ent: missing required field "Compensation.total_allowance"`)}
+	}
+	if _, ok := cc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Compensation.currency"`)}
+	}
+	if _, ok := cc.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "Compensation.effective_from"`)}
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Compensation.status"`)}
+	}
+	if v, ok := cc.mutation.Status(); ok {
+		if err := compensation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Compensation.status": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.RequesterID(); !ok {
+		return &ValidationError{Name: "requester_id", err: errors.New(`ent: missing required field "Compensation.requester_id"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Compensation.created_at"`)}
+	}
+	if len(cc.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "Compensation.employee"`)}
+	}
+	return nil
+}
+
+func (cc *CompensationCreate) sqlSave(ctx context.Context) (*Compensation, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CompensationCreate) createSpec() (*Compensation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Compensation{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(compensation.Table, sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = cc.conflict
+	if value, ok := cc.mutation.OrgID(); ok {
+		_spec.SetField(compensation.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := cc.mutation.BasePay(); ok {
+		_spec.SetField(compensation.FieldBasePay, field.TypeInt64, value)
+		_node.BasePay = value
+	}
+	if value, ok := cc.mutation.Allowances(); ok {
+		_spec.SetField(compensation.FieldAllowances, field.TypeJSON, value)
+		_node.Allowances = value
+	}
+	if value, ok := cc.mutation.TotalAllowance(); ok {
+		_spec.SetField(compensation.FieldTotalAllowance, field.TypeInt64, value)
+		_node.TotalAllowance = value
+	}
+	if value, ok := cc.mutation.Currency(); ok {
+		_spec.SetField(compensation.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := cc.mutation.EffectiveFrom(); ok {
+		_spec.SetField(compensation.FieldEffectiveFrom, field.TypeTime, value)
+		_node.EffectiveFrom = value
+	}
+	if value, ok := cc.mutation.Reason(); ok {
+		_spec.SetField(compensation.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.SetField(compensation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.RequesterID(); ok {
+		_spec.SetField(compensation.FieldRequesterID, field.TypeInt, value)
+		_node.RequesterID = value
+	}
+	if value, ok := cc.mutation.ReviewerID(); ok {
+		_spec.SetField(compensation.FieldReviewerID, field.TypeInt, value)
+		_node.ReviewerID = &value
+	}
+	if value, ok := cc.mutation.ReviewComment(); ok {
+		_spec.SetField(compensation.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = &value
+	}
+	if value, ok := cc.mutation.ReviewedAt(); ok {
+		_spec.SetField(compensation.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(compensation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   compensation.EmployeeTable,
+			Columns: []string{compensation.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.SalaryGradeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   compensation.SalaryGradeTable,
+			Columns: []string{compensation.SalaryGradeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SalaryGradeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Compensation.Create().
+//		SetEmployeeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CompensationUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CompensationCreate) OnConflict(opts ...sql.ConflictOption) *CompensationUpsertOne {
+	cc.conflict = opts
+	return &CompensationUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Compensation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CompensationCreate) OnConflictColumns(columns ...string) *CompensationUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CompensationUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CompensationUpsertOne is the builder for "upsert"-ing
+	//  one Compensation node.
+	CompensationUpsertOne struct {
+		create *CompensationCreate
+	}
+
+	// CompensationUpsert is the "OnConflict" setter.
+	CompensationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *CompensationUpsert) SetStatus(v compensation.Status) *CompensationUpsert {
+	u.Set(compensation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CompensationUpsert) UpdateStatus() *CompensationUpsert {
+	u.SetExcluded(compensation.FieldStatus)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *CompensationUpsert) SetReviewerID(v int) *CompensationUpsert {
+	u.Set(compensation.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *CompensationUpsert) UpdateReviewerID() *CompensationUpsert {
+	u.SetExcluded(compensation.FieldReviewerID)
+	return u
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *CompensationUpsert) AddReviewerID(v int) *CompensationUpsert {
+	u.Add(compensation.FieldReviewerID, v)
+	return u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *CompensationUpsert) ClearReviewerID() *CompensationUpsert {
+	u.SetNull(compensation.FieldReviewerID)
+	return u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *CompensationUpsert) SetReviewComment(v string) *CompensationUpsert {
+	u.Set(compensation.FieldReviewComment, v)
+	return u
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *CompensationUpsert) UpdateReviewComment() *CompensationUpsert {
+	u.SetExcluded(compensation.FieldReviewComment)
+	return u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *CompensationUpsert) ClearReviewComment() *CompensationUpsert {
+	u.SetNull(compensation.FieldReviewComment)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CompensationUpsert) SetReviewedAt(v time.Time) *CompensationUpsert {
+	u.Set(compensation.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CompensationUpsert) UpdateReviewedAt() *CompensationUpsert {
+	u.SetExcluded(compensation.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CompensationUpsert) ClearReviewedAt() *CompensationUpsert {
+	u.SetNull(compensation.FieldReviewedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Compensation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CompensationUpsertOne) UpdateNewValues() *CompensationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.EmployeeID(); exists {
+			s.SetIgnore(compensation.FieldEmployeeID)
+		}
+		if _, exists := u.create.mutation.OrgID(); exists {
+			s.SetIgnore(compensation.FieldOrgID)
+		}
+		if _, exists := u.create.mutation.SalaryGradeID(); exists {
+			s.SetIgnore(compensation.FieldSalaryGradeID)
+		}
+		if _, exists := u.create.mutation.BasePay(); exists {
+			s.SetIgnore(compensation.FieldBasePay)
+		}
+		if _, exists := u.create.mutation.Allowances(); exists {
+			s.SetIgnore(compensation.FieldAllowances)
+		}
+		if _, exists := u.create.mutation.TotalAllowance(); exists {
+			s.SetIgnore(compensation.FieldTotalAllowance)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(compensation.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.EffectiveFrom(); exists {
+			s.SetIgnore(compensation.FieldEffectiveFrom)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(compensation.FieldReason)
+		}
+		if _, exists := u.create.mutation.RequesterID(); exists {
+			s.SetIgnore(compensation.FieldRequesterID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(compensation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Compensation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CompensationUpsertOne) Ignore() *CompensationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CompensationUpsertOne) DoNothing() *CompensationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CompensationCreate.OnConflict
+// documentation for more info.
+func (u *CompensationUpsertOne) Update(set func(*CompensationUpsert)) *CompensationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CompensationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CompensationUpsertOne) SetStatus(v compensation.Status) *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CompensationUpsertOne) UpdateStatus() *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *CompensationUpsertOne) SetReviewerID(v int) *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *CompensationUpsertOne) AddReviewerID(v int) *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *CompensationUpsertOne) UpdateReviewerID() *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *CompensationUpsertOne) ClearReviewerID() *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *CompensationUpsertOne) SetReviewComment(v string) *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *CompensationUpsertOne) UpdateReviewComment() *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *CompensationUpsertOne) ClearReviewComment() *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CompensationUpsertOne) SetReviewedAt(v time.Time) *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CompensationUpsertOne) UpdateReviewedAt() *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CompensationUpsertOne) ClearReviewedAt() *CompensationUpsertOne {
+	return u.Update(func(s *CompensationUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CompensationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CompensationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CompensationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CompensationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CompensationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CompensationCreateBulk is the builder for creating many Compensation entities in bulk.
+type CompensationCreateBulk struct {
+	config
+	err      error
+	builders []*CompensationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Compensation entities in the database.
+func (ccb *CompensationCreateBulk) Save(ctx context.Context) ([]*Compensation, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Compensation, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CompensationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CompensationCreateBulk) SaveX(ctx context.Context) []*Compensation {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CompensationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CompensationCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Compensation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CompensationUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CompensationCreateBulk) OnConflict(opts ...sql.ConflictOption) *CompensationUpsertBulk {
+	ccb.conflict = opts
+	return &CompensationUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Compensation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CompensationCreateBulk) OnConflictColumns(columns ...string) *CompensationUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CompensationUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CompensationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Compensation nodes.
+type CompensationUpsertBulk struct {
+	create *CompensationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Compensation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CompensationUpsertBulk) UpdateNewValues() *CompensationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.EmployeeID(); exists {
+				s.SetIgnore(compensation.FieldEmployeeID)
+			}
+			if _, exists := b.mutation.OrgID(); exists {
+				s.SetIgnore(compensation.FieldOrgID)
+			}
+			if _, exists := b.mutation.SalaryGradeID(); exists {
+				s.SetIgnore(compensation.FieldSalaryGradeID)
+			}
+			if _, exists := b.mutation.BasePay(); exists {
+				s.SetIgnore(compensation.FieldBasePay)
+			}
+			if _, exists := b.mutation.Allowances(); exists {
+				s.SetIgnore(compensation.FieldAllowances)
+			}
+			if _, exists := b.mutation.TotalAllowance(); exists {
+				s.SetIgnore(compensation.FieldTotalAllowance)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(compensation.FieldCurrency)
+			}
+			if _, exists := b.mutation.EffectiveFrom(); exists {
+				s.SetIgnore(compensation.FieldEffectiveFrom)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(compensation.FieldReason)
+			}
+			if _, exists := b.mutation.RequesterID(); exists {
+				s.SetIgnore(compensation.FieldRequesterID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(compensation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Compensation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CompensationUpsertBulk) Ignore() *CompensationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CompensationUpsertBulk) DoNothing() *CompensationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CompensationCreateBulk.OnConflict
+// documentation for more info.
+func (u *CompensationUpsertBulk) Update(set func(*CompensationUpsert)) *CompensationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CompensationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CompensationUpsertBulk) SetStatus(v compensation.Status) *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CompensationUpsertBulk) UpdateStatus() *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *CompensationUpsertBulk) SetReviewerID(v int) *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *CompensationUpsertBulk) AddReviewerID(v int) *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *CompensationUpsertBulk) UpdateReviewerID() *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *CompensationUpsertBulk) ClearReviewerID() *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *CompensationUpsertBulk) SetReviewComment(v string) *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *CompensationUpsertBulk) UpdateReviewComment() *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *CompensationUpsertBulk) ClearReviewComment() *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CompensationUpsertBulk) SetReviewedAt(v time.Time) *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CompensationUpsertBulk) UpdateReviewedAt() *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CompensationUpsertBulk) ClearReviewedAt() *CompensationUpsertBulk {
+	return u.Update(func(s *CompensationUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CompensationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CompensationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CompensationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CompensationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// CompensationUpdate is the builder for updating Compensation entities.
+type CompensationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CompensationMutation
+}
+
+// Where appends a list predicates to the CompensationUpdate builder.
+func (cu *CompensationUpdate) Where(ps ...predicate.Compensation) *CompensationUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetStatus sets the "status" field.
+func (cu *CompensationUpdate) SetStatus(c compensation.Status) *CompensationUpdate {
+	cu.mutation.SetStatus(c)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *CompensationUpdate) SetNillableStatus(c *compensation.Status) *CompensationUpdate {
+	if c != nil {
+		cu.SetStatus(*c)
+	}
+	return cu
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (cu *CompensationUpdate) SetReviewerID(i int) *CompensationUpdate {
+	cu.mutation.ResetReviewerID()
+	cu.mutation.SetReviewerID(i)
+	return cu
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (cu *CompensationUpdate) SetNillableReviewerID(i *int) *CompensationUpdate {
+	if i != nil {
+		cu.SetReviewerID(*i)
+	}
+	return cu
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (cu *CompensationUpdate) AddReviewerID(i int) *CompensationUpdate {
+	cu.mutation.AddReviewerID(i)
+	return cu
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (cu *CompensationUpdate) ClearReviewerID() *CompensationUpdate {
+	cu.mutation.ClearReviewerID()
+	return cu
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (cu *CompensationUpdate) SetReviewComment(s string) *CompensationUpdate {
+	cu.mutation.SetReviewComment(s)
+	return cu
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (cu *CompensationUpdate) SetNillableReviewComment(s *string) *CompensationUpdate {
+	if s != nil {
+		cu.SetReviewComment(*s)
+	}
+	return cu
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (cu *CompensationUpdate) ClearReviewComment() *CompensationUpdate {
+	cu.mutation.ClearReviewComment()
+	return cu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (cu *CompensationUpdate) SetReviewedAt(t time.Time) *CompensationUpdate {
+	cu.mutation.SetReviewedAt(t)
+	return cu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (cu *CompensationUpdate) SetNillableReviewedAt(t *time.Time) *CompensationUpdate {
+	if t != nil {
+		cu.SetReviewedAt(*t)
+	}
+	return cu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (cu *CompensationUpdate) ClearReviewedAt() *CompensationUpdate {
+	cu.mutation.ClearReviewedAt()
+	return cu
+}
+
+// Mutation returns the CompensationMutation object of the builder.
+func (cu *CompensationUpdate) Mutation() *CompensationMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CompensationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CompensationUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CompensationUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CompensationUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CompensationUpdate) check() error {
+	if v, ok := cu.mutation.Status(); ok {
+		if err := compensation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Compensation.status": %w`, err)}
+		}
+	}
+	if cu.mutation.EmployeeCleared() && len(cu.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Compensation.employee"`)
+	}
+	return nil
+}
+
+func (cu *CompensationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(compensation.Table, compensation.Columns, sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cu.mutation.AllowancesCleared() {
+		_spec.ClearField(compensation.FieldAllowances, field.TypeJSON)
+	}
+	if cu.mutation.ReasonCleared() {
+		_spec.ClearField(compensation.FieldReason, field.TypeString)
+	}
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(compensation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.ReviewerID(); ok {
+		_spec.SetField(compensation.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedReviewerID(); ok {
+		_spec.AddField(compensation.FieldReviewerID, field.TypeInt, value)
+	}
+	if cu.mutation.ReviewerIDCleared() {
+		_spec.ClearField(compensation.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := cu.mutation.ReviewComment(); ok {
+		_spec.SetField(compensation.FieldReviewComment, field.TypeString, value)
+	}
+	if cu.mutation.ReviewCommentCleared() {
+		_spec.ClearField(compensation.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := cu.mutation.ReviewedAt(); ok {
+		_spec.SetField(compensation.FieldReviewedAt, field.TypeTime, value)
+	}
+	if cu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(compensation.FieldReviewedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{compensation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CompensationUpdateOne is the builder for updating a single Compensation entity.
+type CompensationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CompensationMutation
+}
+
+// SetStatus sets the "status" field.
+func (cuo *CompensationUpdateOne) SetStatus(c compensation.Status) *CompensationUpdateOne {
+	cuo.mutation.SetStatus(c)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *CompensationUpdateOne) SetNillableStatus(c *compensation.Status) *CompensationUpdateOne {
+	if c != nil {
+		cuo.SetStatus(*c)
+	}
+	return cuo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (cuo *CompensationUpdateOne) SetReviewerID(i int) *CompensationUpdateOne {
+	cuo.mutation.ResetReviewerID()
+	cuo.mutation.SetReviewerID(i)
+	return cuo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (cuo *CompensationUpdateOne) SetNillableReviewerID(i *int) *CompensationUpdateOne {
+	if i != nil {
+		cuo.SetReviewerID(*i)
+	}
+	return cuo
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (cuo *CompensationUpdateOne) AddReviewerID(i int) *CompensationUpdateOne {
+	cuo.mutation.AddReviewerID(i)
+	return cuo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (cuo *CompensationUpdateOne) ClearReviewerID() *CompensationUpdateOne {
+	cuo.mutation.ClearReviewerID()
+	return cuo
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (cuo *CompensationUpdateOne) SetReviewComment(s string) *CompensationUpdateOne {
+	cuo.mutation.SetReviewComment(s)
+	return cuo
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (cuo *CompensationUpdateOne) SetNillableReviewComment(s *string) *CompensationUpdateOne {
+	if s != nil {
+		cuo.SetReviewComment(*s)
+	}
+	return cuo
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (cuo *CompensationUpdateOne) ClearReviewComment() *CompensationUpdateOne {
+	cuo.mutation.ClearReviewComment()
+	return cuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (cuo *CompensationUpdateOne) SetReviewedAt(t time.Time) *CompensationUpdateOne {
+	cuo.mutation.SetReviewedAt(t)
+	return cuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (cuo *CompensationUpdateOne) SetNillableReviewedAt(t *time.Time) *CompensationUpdateOne {
+	if t != nil {
+		cuo.SetReviewedAt(*t)
+	}
+	return cuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (cuo *CompensationUpdateOne) ClearReviewedAt() *CompensationUpdateOne {
+	cuo.mutation.ClearReviewedAt()
+	return cuo
+}
+
+// Mutation returns the CompensationMutation object of the builder.
+func (cuo *CompensationUpdateOne) Mutation() *CompensationMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CompensationUpdate builder.
+func (cuo *CompensationUpdateOne) Where(ps ...predicate.Compensation) *CompensationUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CompensationUpdateOne) Select(field string, fields ...string) *CompensationUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Compensation entity.
+func (cuo *CompensationUpdateOne) Save(ctx context.Context) (*Compensation, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CompensationUpdateOne) SaveX(ctx context.Context) *Compensation {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CompensationUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CompensationUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CompensationUpdateOne) check() error {
+	if v, ok := cuo.mutation.Status(); ok {
+		if err := compensation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Compensation.status": %w`, err)}
+		}
+	}
+	if cuo.mutation.EmployeeCleared() && len(cuo.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Compensation.employee"`)
+	}
+	return nil
+}
+
+func (cuo *CompensationUpdateOne) sqlSave(ctx context.Context) (_node *Compensation, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(compensation.Table, compensation.Columns, sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Compensation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, compensation.FieldID)
+		for _, f := range fields {
+			if !compensation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != compensation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cuo.mutation.AllowancesCleared() {
+		_spec.ClearField(compensation.FieldAllowances, field.TypeJSON)
+	}
+	if cuo.mutation.ReasonCleared() {
+		_spec.ClearField(compensation.FieldReason, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(compensation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.ReviewerID(); ok {
+		_spec.SetField(compensation.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedReviewerID(); ok {
+		_spec.AddField(compensation.FieldReviewerID, field.TypeInt, value)
+	}
+	if cuo.mutation.ReviewerIDCleared() {
+		_spec.ClearField(compensation.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := cuo.mutation.ReviewComment(); ok {
+		_spec.SetField(compensation.FieldReviewComment, field.TypeString, value)
+	}
+	if cuo.mutation.ReviewCommentCleared() {
+		_spec.ClearField(compensation.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := cuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(compensation.FieldReviewedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(compensation.FieldReviewedAt, field.TypeTime)
+	}
+	_node = &Compensation{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{compensation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
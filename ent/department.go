@@ -40,9 +40,11 @@ type DepartmentEdges struct {
 	Positions []*Position `json:"positions"`
 	// Organization holds the value of the organization edge.
 	Organization *Organization `json:"organization"`
+	// AppointmentHistories holds the value of the appointment_histories edge.
+	AppointmentHistories []*AppointmentHistory `json:"appointment_histories"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // PositionsOrErr returns the Positions value or an error if the edge
@@ -65,6 +67,15 @@ func (e DepartmentEdges) OrganizationOrErr() (*Organization, error) {
 	return nil, &NotLoadedError{edge: "organization"}
 }
 
+// AppointmentHistoriesOrErr returns the AppointmentHistories value or an error if the edge
+// was not loaded in eager-loading.
+func (e DepartmentEdges) AppointmentHistoriesOrErr() ([]*AppointmentHistory, error) {
+	if e.loadedTypes[2] {
+		return e.AppointmentHistories, nil
+	}
+	return nil, &NotLoadedError{edge: "appointment_histories"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Department) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -150,6 +161,11 @@ func (d *Department) QueryOrganization() *OrganizationQuery {
 	return NewDepartmentClient(d.config).QueryOrganization(d)
 }
 
+// QueryAppointmentHistories queries the "appointment_histories" edge of the Department entity.
+func (d *Department) QueryAppointmentHistories() *AppointmentHistoryQuery {
+	return NewDepartmentClient(d.config).QueryAppointmentHistories(d)
+}
+
 // Update returns a builder for updating this Department.
 // Note that you need to call Department.Unwrap() before calling this method if this Department
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -28,6 +28,8 @@ const (
 	EdgePositions = "positions"
 	// EdgeOrganization holds the string denoting the organization edge name in mutations.
 	EdgeOrganization = "organization"
+	// EdgeAppointmentHistories holds the string denoting the appointment_histories edge name in mutations.
+	EdgeAppointmentHistories = "appointment_histories"
 	// Table holds the table name of the department in the database.
 	Table = "departments"
 	// PositionsTable is the table that holds the positions relation/edge.
@@ -44,6 +46,13 @@ const (
 	OrganizationInverseTable = "organizations"
 	// OrganizationColumn is the table column denoting the organization relation/edge.
 	OrganizationColumn = "org_id"
+	// AppointmentHistoriesTable is the table that holds the appointment_histories relation/edge.
+	AppointmentHistoriesTable = "appointment_histories"
+	// AppointmentHistoriesInverseTable is the table name for the AppointmentHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "appointmenthistory" package.
+	AppointmentHistoriesInverseTable = "appointment_histories"
+	// AppointmentHistoriesColumn is the table column denoting the appointment_histories relation/edge.
+	AppointmentHistoriesColumn = "department_id"
 )
 
 // Columns holds all SQL columns for department fields.
@@ -130,6 +139,20 @@ func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByAppointmentHistoriesCount orders the results by appointment_histories count.
+func ByAppointmentHistoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAppointmentHistoriesStep(), opts...)
+	}
+}
+
+// ByAppointmentHistories orders the results by appointment_histories terms.
+func ByAppointmentHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppointmentHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPositionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -144,3 +167,10 @@ func newOrganizationStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
 	)
 }
+func newAppointmentHistoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppointmentHistoriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AppointmentHistoriesTable, AppointmentHistoriesColumn),
+	)
+}
@@ -356,6 +356,29 @@ func HasOrganizationWith(preds ...predicate.Organization) predicate.Department {
 	})
 }
 
+// HasAppointmentHistories applies the HasEdge predicate on the "appointment_histories" edge.
+func HasAppointmentHistories() predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AppointmentHistoriesTable, AppointmentHistoriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppointmentHistoriesWith applies the HasEdge predicate on the "appointment_histories" edge with a given conditions (other predicates).
+func HasAppointmentHistoriesWith(preds ...predicate.AppointmentHistory) predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := newAppointmentHistoriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Department) predicate.Department {
 	return predicate.Department(sql.AndPredicates(predicates...))
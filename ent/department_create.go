@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
@@ -96,6 +97,21 @@ func (dc *DepartmentCreate) SetOrganization(o *Organization) *DepartmentCreate {
 	return dc.SetOrganizationID(o.ID)
 }
 
+// AddAppointmentHistoryIDs adds the "appointment_histories" edge to the AppointmentHistory entity by IDs.
+func (dc *DepartmentCreate) AddAppointmentHistoryIDs(ids ...int) *DepartmentCreate {
+	dc.mutation.AddAppointmentHistoryIDs(ids...)
+	return dc
+}
+
+// AddAppointmentHistories adds the "appointment_histories" edges to the AppointmentHistory entity.
+func (dc *DepartmentCreate) AddAppointmentHistories(a ...*AppointmentHistory) *DepartmentCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return dc.AddAppointmentHistoryIDs(ids...)
+}
+
 // Mutation returns the DepartmentMutation object of the builder.
 func (dc *DepartmentCreate) Mutation() *DepartmentMutation {
 	return dc.mutation
@@ -247,6 +263,22 @@ func (dc *DepartmentCreate) createSpec() (*Department, *sqlgraph.CreateSpec) {
 		_node.OrgID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := dc.mutation.AppointmentHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.AppointmentHistoriesTable,
+			Columns: []string{department.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
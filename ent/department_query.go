@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
@@ -21,12 +22,13 @@ import (
 // DepartmentQuery is the builder for querying Department entities.
 type DepartmentQuery struct {
 	config
-	ctx              *QueryContext
-	order            []department.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Department
-	withPositions    *PositionQuery
-	withOrganization *OrganizationQuery
+	ctx                      *QueryContext
+	order                    []department.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Department
+	withPositions            *PositionQuery
+	withOrganization         *OrganizationQuery
+	withAppointmentHistories *AppointmentHistoryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (dq *DepartmentQuery) QueryOrganization() *OrganizationQuery {
 	return query
 }
 
+// QueryAppointmentHistories chains the current query on the "appointment_histories" edge.
+func (dq *DepartmentQuery) QueryAppointmentHistories() *AppointmentHistoryQuery {
+	query := (&AppointmentHistoryClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(department.Table, department.FieldID, selector),
+			sqlgraph.To(appointmenthistory.Table, appointmenthistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, department.AppointmentHistoriesTable, department.AppointmentHistoriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Department entity from the query.
 // Returns a *NotFoundError when no Department was found.
 func (dq *DepartmentQuery) First(ctx context.Context) (*Department, error) {
@@ -294,13 +318,14 @@ func (dq *DepartmentQuery) Clone() *DepartmentQuery {
 		return nil
 	}
 	return &DepartmentQuery{
-		config:           dq.config,
-		ctx:              dq.ctx.Clone(),
-		order:            append([]department.OrderOption{}, dq.order...),
-		inters:           append([]Interceptor{}, dq.inters...),
-		predicates:       append([]predicate.Department{}, dq.predicates...),
-		withPositions:    dq.withPositions.Clone(),
-		withOrganization: dq.withOrganization.Clone(),
+		config:                   dq.config,
+		ctx:                      dq.ctx.Clone(),
+		order:                    append([]department.OrderOption{}, dq.order...),
+		inters:                   append([]Interceptor{}, dq.inters...),
+		predicates:               append([]predicate.Department{}, dq.predicates...),
+		withPositions:            dq.withPositions.Clone(),
+		withOrganization:         dq.withOrganization.Clone(),
+		withAppointmentHistories: dq.withAppointmentHistories.Clone(),
 		// clone intermediate query.
 		sql:  dq.sql.Clone(),
 		path: dq.path,
@@ -329,6 +354,17 @@ func (dq *DepartmentQuery) WithOrganization(opts ...func(*OrganizationQuery)) *D
 	return dq
 }
 
+// WithAppointmentHistories tells the query-builder to eager-load the nodes that are connected to
+// the "appointment_histories" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DepartmentQuery) WithAppointmentHistories(opts ...func(*AppointmentHistoryQuery)) *DepartmentQuery {
+	query := (&AppointmentHistoryClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withAppointmentHistories = query
+	return dq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (dq *DepartmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*D
 	var (
 		nodes       = []*Department{}
 		_spec       = dq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			dq.withPositions != nil,
 			dq.withOrganization != nil,
+			dq.withAppointmentHistories != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,6 +480,15 @@ func (dq *DepartmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*D
 			return nil, err
 		}
 	}
+	if query := dq.withAppointmentHistories; query != nil {
+		if err := dq.loadAppointmentHistories(ctx, query, nodes,
+			func(n *Department) { n.Edges.AppointmentHistories = []*AppointmentHistory{} },
+			func(n *Department, e *AppointmentHistory) {
+				n.Edges.AppointmentHistories = append(n.Edges.AppointmentHistories, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -505,6 +551,39 @@ func (dq *DepartmentQuery) loadOrganization(ctx context.Context, query *Organiza
 	}
 	return nil
 }
+func (dq *DepartmentQuery) loadAppointmentHistories(ctx context.Context, query *AppointmentHistoryQuery, nodes []*Department, init func(*Department), assign func(*Department, *AppointmentHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Department)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(appointmenthistory.FieldDepartmentID)
+	}
+	query.Where(predicate.AppointmentHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(department.AppointmentHistoriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DepartmentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "department_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "department_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (dq *DepartmentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dq.querySpec()
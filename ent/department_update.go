@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
@@ -112,6 +113,21 @@ func (du *DepartmentUpdate) SetOrganization(o *Organization) *DepartmentUpdate {
 	return du.SetOrganizationID(o.ID)
 }
 
+// AddAppointmentHistoryIDs adds the "appointment_histories" edge to the AppointmentHistory entity by IDs.
+func (du *DepartmentUpdate) AddAppointmentHistoryIDs(ids ...int) *DepartmentUpdate {
+	du.mutation.AddAppointmentHistoryIDs(ids...)
+	return du
+}
+
+// AddAppointmentHistories adds the "appointment_histories" edges to the AppointmentHistory entity.
+func (du *DepartmentUpdate) AddAppointmentHistories(a ...*AppointmentHistory) *DepartmentUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return du.AddAppointmentHistoryIDs(ids...)
+}
+
 // Mutation returns the DepartmentMutation object of the builder.
 func (du *DepartmentUpdate) Mutation() *DepartmentMutation {
 	return du.mutation
@@ -144,6 +160,27 @@ func (du *DepartmentUpdate) ClearOrganization() *DepartmentUpdate {
 	return du
 }
 
+// ClearAppointmentHistories clears all "appointment_histories" edges to the AppointmentHistory entity.
+func (du *DepartmentUpdate) ClearAppointmentHistories() *DepartmentUpdate {
+	du.mutation.ClearAppointmentHistories()
+	return du
+}
+
+// RemoveAppointmentHistoryIDs removes the "appointment_histories" edge to AppointmentHistory entities by IDs.
+func (du *DepartmentUpdate) RemoveAppointmentHistoryIDs(ids ...int) *DepartmentUpdate {
+	du.mutation.RemoveAppointmentHistoryIDs(ids...)
+	return du
+}
+
+// RemoveAppointmentHistories removes "appointment_histories" edges to AppointmentHistory entities.
+func (du *DepartmentUpdate) RemoveAppointmentHistories(a ...*AppointmentHistory) *DepartmentUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return du.RemoveAppointmentHistoryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (du *DepartmentUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
@@ -284,6 +321,51 @@ func (du *DepartmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if du.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.AppointmentHistoriesTable,
+			Columns: []string{department.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedAppointmentHistoriesIDs(); len(nodes) > 0 && !du.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.AppointmentHistoriesTable,
+			Columns: []string{department.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.AppointmentHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.AppointmentHistoriesTable,
+			Columns: []string{department.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{department.Label}
@@ -386,6 +468,21 @@ func (duo *DepartmentUpdateOne) SetOrganization(o *Organization) *DepartmentUpda
 	return duo.SetOrganizationID(o.ID)
 }
 
+// AddAppointmentHistoryIDs adds the "appointment_histories" edge to the AppointmentHistory entity by IDs.
+func (duo *DepartmentUpdateOne) AddAppointmentHistoryIDs(ids ...int) *DepartmentUpdateOne {
+	duo.mutation.AddAppointmentHistoryIDs(ids...)
+	return duo
+}
+
+// AddAppointmentHistories adds the "appointment_histories" edges to the AppointmentHistory entity.
+func (duo *DepartmentUpdateOne) AddAppointmentHistories(a ...*AppointmentHistory) *DepartmentUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return duo.AddAppointmentHistoryIDs(ids...)
+}
+
 // Mutation returns the DepartmentMutation object of the builder.
 func (duo *DepartmentUpdateOne) Mutation() *DepartmentMutation {
 	return duo.mutation
@@ -418,6 +515,27 @@ func (duo *DepartmentUpdateOne) ClearOrganization() *DepartmentUpdateOne {
 	return duo
 }
 
+// ClearAppointmentHistories clears all "appointment_histories" edges to the AppointmentHistory entity.
+func (duo *DepartmentUpdateOne) ClearAppointmentHistories() *DepartmentUpdateOne {
+	duo.mutation.ClearAppointmentHistories()
+	return duo
+}
+
+// RemoveAppointmentHistoryIDs removes the "appointment_histories" edge to AppointmentHistory entities by IDs.
+func (duo *DepartmentUpdateOne) RemoveAppointmentHistoryIDs(ids ...int) *DepartmentUpdateOne {
+	duo.mutation.RemoveAppointmentHistoryIDs(ids...)
+	return duo
+}
+
+// RemoveAppointmentHistories removes "appointment_histories" edges to AppointmentHistory entities.
+func (duo *DepartmentUpdateOne) RemoveAppointmentHistories(a ...*AppointmentHistory) *DepartmentUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return duo.RemoveAppointmentHistoryIDs(ids...)
+}
+
 // Where appends a list predicates to the DepartmentUpdate builder.
 func (duo *DepartmentUpdateOne) Where(ps ...predicate.Department) *DepartmentUpdateOne {
 	duo.mutation.Where(ps...)
@@ -588,6 +706,51 @@ func (duo *DepartmentUpdateOne) sqlSave(ctx context.Context) (_node *Department,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if duo.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.AppointmentHistoriesTable,
+			Columns: []string{department.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedAppointmentHistoriesIDs(); len(nodes) > 0 && !duo.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.AppointmentHistoriesTable,
+			Columns: []string{department.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.AppointmentHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   department.AppointmentHistoriesTable,
+			Columns: []string{department.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Department{config: duo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -60,9 +60,33 @@ type EmployeeEdges struct {
 	Projects []*Project `json:"projects"`
 	// AppointmentHistories holds the value of the appointment_histories edge.
 	AppointmentHistories []*AppointmentHistory `json:"appointment_histories"`
+	// Contracts holds the value of the contracts edge.
+	Contracts []*EmployeeContract `json:"contracts"`
+	// StatusHistories holds the value of the status_histories edge.
+	StatusHistories []*EmployeeStatusHistory `json:"status_histories"`
+	// Compensations holds the value of the compensations edge.
+	Compensations []*Compensation `json:"compensations"`
+	// AttendanceRecords holds the value of the attendance_records edge.
+	AttendanceRecords []*AttendanceRecord `json:"attendance_records"`
+	// AttendanceCorrections holds the value of the attendance_corrections edge.
+	AttendanceCorrections []*AttendanceCorrection `json:"attendance_corrections"`
+	// Rosters holds the value of the rosters edge.
+	Rosters []*Roster `json:"rosters"`
+	// OvertimeRequests holds the value of the overtime_requests edge.
+	OvertimeRequests []*OvertimeRequest `json:"overtime_requests"`
+	// TaskComments holds the value of the task_comments edge.
+	TaskComments []*TaskComment `json:"task_comments"`
+	// CommentMentions holds the value of the comment_mentions edge.
+	CommentMentions []*TaskComment `json:"comment_mentions"`
+	// ReviewedTaskReports holds the value of the reviewed_task_reports edge.
+	ReviewedTaskReports []*TaskReport `json:"reviewed_task_reports"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments"`
+	// WorkLogs holds the value of the work_logs edge.
+	WorkLogs []*WorkLog `json:"work_logs"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [9]bool
+	loadedTypes [21]bool
 }
 
 // PositionOrErr returns the Position value or an error if the edge
@@ -148,6 +172,114 @@ func (e EmployeeEdges) AppointmentHistoriesOrErr() ([]*AppointmentHistory, error
 	return nil, &NotLoadedError{edge: "appointment_histories"}
 }
 
+// ContractsOrErr returns the Contracts value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) ContractsOrErr() ([]*EmployeeContract, error) {
+	if e.loadedTypes[9] {
+		return e.Contracts, nil
+	}
+	return nil, &NotLoadedError{edge: "contracts"}
+}
+
+// StatusHistoriesOrErr returns the StatusHistories value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) StatusHistoriesOrErr() ([]*EmployeeStatusHistory, error) {
+	if e.loadedTypes[10] {
+		return e.StatusHistories, nil
+	}
+	return nil, &NotLoadedError{edge: "status_histories"}
+}
+
+// CompensationsOrErr returns the Compensations value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) CompensationsOrErr() ([]*Compensation, error) {
+	if e.loadedTypes[11] {
+		return e.Compensations, nil
+	}
+	return nil, &NotLoadedError{edge: "compensations"}
+}
+
+// AttendanceRecordsOrErr returns the AttendanceRecords value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) AttendanceRecordsOrErr() ([]*AttendanceRecord, error) {
+	if e.loadedTypes[12] {
+		return e.AttendanceRecords, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance_records"}
+}
+
+// AttendanceCorrectionsOrErr returns the AttendanceCorrections value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) AttendanceCorrectionsOrErr() ([]*AttendanceCorrection, error) {
+	if e.loadedTypes[13] {
+		return e.AttendanceCorrections, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance_corrections"}
+}
+
+// RostersOrErr returns the Rosters value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) RostersOrErr() ([]*Roster, error) {
+	if e.loadedTypes[14] {
+		return e.Rosters, nil
+	}
+	return nil, &NotLoadedError{edge: "rosters"}
+}
+
+// OvertimeRequestsOrErr returns the OvertimeRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) OvertimeRequestsOrErr() ([]*OvertimeRequest, error) {
+	if e.loadedTypes[15] {
+		return e.OvertimeRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "overtime_requests"}
+}
+
+// TaskCommentsOrErr returns the TaskComments value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) TaskCommentsOrErr() ([]*TaskComment, error) {
+	if e.loadedTypes[16] {
+		return e.TaskComments, nil
+	}
+	return nil, &NotLoadedError{edge: "task_comments"}
+}
+
+// CommentMentionsOrErr returns the CommentMentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) CommentMentionsOrErr() ([]*TaskComment, error) {
+	if e.loadedTypes[17] {
+		return e.CommentMentions, nil
+	}
+	return nil, &NotLoadedError{edge: "comment_mentions"}
+}
+
+// ReviewedTaskReportsOrErr returns the ReviewedTaskReports value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) ReviewedTaskReportsOrErr() ([]*TaskReport, error) {
+	if e.loadedTypes[18] {
+		return e.ReviewedTaskReports, nil
+	}
+	return nil, &NotLoadedError{edge: "reviewed_task_reports"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[19] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// WorkLogsOrErr returns the WorkLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) WorkLogsOrErr() ([]*WorkLog, error) {
+	if e.loadedTypes[20] {
+		return e.WorkLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "work_logs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Employee) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -286,6 +418,66 @@ func (e *Employee) QueryAppointmentHistories() *AppointmentHistoryQuery {
 	return NewEmployeeClient(e.config).QueryAppointmentHistories(e)
 }
 
+// QueryContracts queries the "contracts" edge of the Employee entity.
+func (e *Employee) QueryContracts() *EmployeeContractQuery {
+	return NewEmployeeClient(e.config).QueryContracts(e)
+}
+
+// QueryStatusHistories queries the "status_histories" edge of the Employee entity.
+func (e *Employee) QueryStatusHistories() *EmployeeStatusHistoryQuery {
+	return NewEmployeeClient(e.config).QueryStatusHistories(e)
+}
+
+// QueryCompensations queries the "compensations" edge of the Employee entity.
+func (e *Employee) QueryCompensations() *CompensationQuery {
+	return NewEmployeeClient(e.config).QueryCompensations(e)
+}
+
+// QueryAttendanceRecords queries the "attendance_records" edge of the Employee entity.
+func (e *Employee) QueryAttendanceRecords() *AttendanceRecordQuery {
+	return NewEmployeeClient(e.config).QueryAttendanceRecords(e)
+}
+
+// QueryAttendanceCorrections queries the "attendance_corrections" edge of the Employee entity.
+func (e *Employee) QueryAttendanceCorrections() *AttendanceCorrectionQuery {
+	return NewEmployeeClient(e.config).QueryAttendanceCorrections(e)
+}
+
+// QueryRosters queries the "rosters" edge of the Employee entity.
+func (e *Employee) QueryRosters() *RosterQuery {
+	return NewEmployeeClient(e.config).QueryRosters(e)
+}
+
+// QueryOvertimeRequests queries the "overtime_requests" edge of the Employee entity.
+func (e *Employee) QueryOvertimeRequests() *OvertimeRequestQuery {
+	return NewEmployeeClient(e.config).QueryOvertimeRequests(e)
+}
+
+// QueryTaskComments queries the "task_comments" edge of the Employee entity.
+func (e *Employee) QueryTaskComments() *TaskCommentQuery {
+	return NewEmployeeClient(e.config).QueryTaskComments(e)
+}
+
+// QueryCommentMentions queries the "comment_mentions" edge of the Employee entity.
+func (e *Employee) QueryCommentMentions() *TaskCommentQuery {
+	return NewEmployeeClient(e.config).QueryCommentMentions(e)
+}
+
+// QueryReviewedTaskReports queries the "reviewed_task_reports" edge of the Employee entity.
+func (e *Employee) QueryReviewedTaskReports() *TaskReportQuery {
+	return NewEmployeeClient(e.config).QueryReviewedTaskReports(e)
+}
+
+// QueryAttachments queries the "attachments" edge of the Employee entity.
+func (e *Employee) QueryAttachments() *AttachmentQuery {
+	return NewEmployeeClient(e.config).QueryAttachments(e)
+}
+
+// QueryWorkLogs queries the "work_logs" edge of the Employee entity.
+func (e *Employee) QueryWorkLogs() *WorkLogQuery {
+	return NewEmployeeClient(e.config).QueryWorkLogs(e)
+}
+
 // Update returns a builder for updating this Employee.
 // Note that you need to call Employee.Unwrap() before calling this method if this Employee
 // was returned from a transaction, and the transaction was committed or rolled back.
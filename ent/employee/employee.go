@@ -49,6 +49,30 @@ const (
 	EdgeProjects = "projects"
 	// EdgeAppointmentHistories holds the string denoting the appointment_histories edge name in mutations.
 	EdgeAppointmentHistories = "appointment_histories"
+	// EdgeContracts holds the string denoting the contracts edge name in mutations.
+	EdgeContracts = "contracts"
+	// EdgeStatusHistories holds the string denoting the status_histories edge name in mutations.
+	EdgeStatusHistories = "status_histories"
+	// EdgeCompensations holds the string denoting the compensations edge name in mutations.
+	EdgeCompensations = "compensations"
+	// EdgeAttendanceRecords holds the string denoting the attendance_records edge name in mutations.
+	EdgeAttendanceRecords = "attendance_records"
+	// EdgeAttendanceCorrections holds the string denoting the attendance_corrections edge name in mutations.
+	EdgeAttendanceCorrections = "attendance_corrections"
+	// EdgeRosters holds the string denoting the rosters edge name in mutations.
+	EdgeRosters = "rosters"
+	// EdgeOvertimeRequests holds the string denoting the overtime_requests edge name in mutations.
+	EdgeOvertimeRequests = "overtime_requests"
+	// EdgeTaskComments holds the string denoting the task_comments edge name in mutations.
+	EdgeTaskComments = "task_comments"
+	// EdgeCommentMentions holds the string denoting the comment_mentions edge name in mutations.
+	EdgeCommentMentions = "comment_mentions"
+	// EdgeReviewedTaskReports holds the string denoting the reviewed_task_reports edge name in mutations.
+	EdgeReviewedTaskReports = "reviewed_task_reports"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeWorkLogs holds the string denoting the work_logs edge name in mutations.
+	EdgeWorkLogs = "work_logs"
 	// Table holds the table name of the employee in the database.
 	Table = "employees"
 	// PositionTable is the table that holds the position relation/edge.
@@ -110,6 +134,88 @@ const (
 	AppointmentHistoriesInverseTable = "appointment_histories"
 	// AppointmentHistoriesColumn is the table column denoting the appointment_histories relation/edge.
 	AppointmentHistoriesColumn = "employee_id"
+	// ContractsTable is the table that holds the contracts relation/edge.
+	ContractsTable = "employee_contracts"
+	// ContractsInverseTable is the table name for the EmployeeContract entity.
+	// It exists in this package in order to avoid circular dependency with the "employeecontract" package.
+	ContractsInverseTable = "employee_contracts"
+	// ContractsColumn is the table column denoting the contracts relation/edge.
+	ContractsColumn = "employee_id"
+	// StatusHistoriesTable is the table that holds the status_histories relation/edge.
+	StatusHistoriesTable = "employee_status_histories"
+	// StatusHistoriesInverseTable is the table name for the EmployeeStatusHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "employeestatushistory" package.
+	StatusHistoriesInverseTable = "employee_status_histories"
+	// StatusHistoriesColumn is the table column denoting the status_histories relation/edge.
+	StatusHistoriesColumn = "employee_id"
+	// CompensationsTable is the table that holds the compensations relation/edge.
+	CompensationsTable = "compensations"
+	// CompensationsInverseTable is the table name for the Compensation entity.
+	// It exists in this package in order to avoid circular dependency with the "compensation" package.
+	CompensationsInverseTable = "compensations"
+	// CompensationsColumn is the table column denoting the compensations relation/edge.
+	CompensationsColumn = "employee_id"
+	// AttendanceRecordsTable is the table that holds the attendance_records relation/edge.
+	AttendanceRecordsTable = "attendance_records"
+	// AttendanceRecordsInverseTable is the table name for the AttendanceRecord entity.
+	// It exists in this package in order to avoid circular dependency with the "attendancerecord" package.
+	AttendanceRecordsInverseTable = "attendance_records"
+	// AttendanceRecordsColumn is the table column denoting the attendance_records relation/edge.
+	AttendanceRecordsColumn = "employee_id"
+	// AttendanceCorrectionsTable is the table that holds the attendance_corrections relation/edge.
+	AttendanceCorrectionsTable = "attendance_corrections"
+	// AttendanceCorrectionsInverseTable is the table name for the AttendanceCorrection entity.
+	// It exists in this package in order to avoid circular dependency with the "attendancecorrection" package.
+	AttendanceCorrectionsInverseTable = "attendance_corrections"
+	// AttendanceCorrectionsColumn is the table column denoting the attendance_corrections relation/edge.
+	AttendanceCorrectionsColumn = "employee_id"
+	// RostersTable is the table that holds the rosters relation/edge.
+	RostersTable = "rosters"
+	// RostersInverseTable is the table name for the Roster entity.
+	// It exists in this package in order to avoid circular dependency with the "roster" package.
+	RostersInverseTable = "rosters"
+	// RostersColumn is the table column denoting the rosters relation/edge.
+	RostersColumn = "employee_id"
+	// OvertimeRequestsTable is the table that holds the overtime_requests relation/edge.
+	OvertimeRequestsTable = "overtime_requests"
+	// OvertimeRequestsInverseTable is the table name for the OvertimeRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "overtimerequest" package.
+	OvertimeRequestsInverseTable = "overtime_requests"
+	// OvertimeRequestsColumn is the table column denoting the overtime_requests relation/edge.
+	OvertimeRequestsColumn = "employee_id"
+	// TaskCommentsTable is the table that holds the task_comments relation/edge.
+	TaskCommentsTable = "task_comments"
+	// TaskCommentsInverseTable is the table name for the TaskComment entity.
+	// It exists in this package in order to avoid circular dependency with the "taskcomment" package.
+	TaskCommentsInverseTable = "task_comments"
+	// TaskCommentsColumn is the table column denoting the task_comments relation/edge.
+	TaskCommentsColumn = "author_id"
+	// CommentMentionsTable is the table that holds the comment_mentions relation/edge. The primary key declared below.
+	CommentMentionsTable = "task_comment_mentions"
+	// CommentMentionsInverseTable is the table name for the TaskComment entity.
+	// It exists in this package in order to avoid circular dependency with the "taskcomment" package.
+	CommentMentionsInverseTable = "task_comments"
+	// ReviewedTaskReportsTable is the table that holds the reviewed_task_reports relation/edge.
+	ReviewedTaskReportsTable = "task_reports"
+	// ReviewedTaskReportsInverseTable is the table name for the TaskReport entity.
+	// It exists in this package in order to avoid circular dependency with the "taskreport" package.
+	ReviewedTaskReportsInverseTable = "task_reports"
+	// ReviewedTaskReportsColumn is the table column denoting the reviewed_task_reports relation/edge.
+	ReviewedTaskReportsColumn = "reviewer_id"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "uploader_id"
+	// WorkLogsTable is the table that holds the work_logs relation/edge.
+	WorkLogsTable = "work_logs"
+	// WorkLogsInverseTable is the table name for the WorkLog entity.
+	// It exists in this package in order to avoid circular dependency with the "worklog" package.
+	WorkLogsInverseTable = "work_logs"
+	// WorkLogsColumn is the table column denoting the work_logs relation/edge.
+	WorkLogsColumn = "employee_id"
 )
 
 // Columns holds all SQL columns for employee fields.
@@ -132,6 +238,9 @@ var (
 	// ProjectsPrimaryKey and ProjectsColumn2 are the table columns denoting the
 	// primary key for the projects relation (M2M).
 	ProjectsPrimaryKey = []string{"project_id", "employee_id"}
+	// CommentMentionsPrimaryKey and CommentMentionsColumn2 are the table columns denoting the
+	// primary key for the comment_mentions relation (M2M).
+	CommentMentionsPrimaryKey = []string{"task_comment_id", "employee_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -347,6 +456,174 @@ func ByAppointmentHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpt
 		sqlgraph.OrderByNeighborTerms(s, newAppointmentHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByContractsCount orders the results by contracts count.
+func ByContractsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContractsStep(), opts...)
+	}
+}
+
+// ByContracts orders the results by contracts terms.
+func ByContracts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContractsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatusHistoriesCount orders the results by status_histories count.
+func ByStatusHistoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusHistoriesStep(), opts...)
+	}
+}
+
+// ByStatusHistories orders the results by status_histories terms.
+func ByStatusHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCompensationsCount orders the results by compensations count.
+func ByCompensationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCompensationsStep(), opts...)
+	}
+}
+
+// ByCompensations orders the results by compensations terms.
+func ByCompensations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCompensationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttendanceRecordsCount orders the results by attendance_records count.
+func ByAttendanceRecordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttendanceRecordsStep(), opts...)
+	}
+}
+
+// ByAttendanceRecords orders the results by attendance_records terms.
+func ByAttendanceRecords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceRecordsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttendanceCorrectionsCount orders the results by attendance_corrections count.
+func ByAttendanceCorrectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttendanceCorrectionsStep(), opts...)
+	}
+}
+
+// ByAttendanceCorrections orders the results by attendance_corrections terms.
+func ByAttendanceCorrections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceCorrectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRostersCount orders the results by rosters count.
+func ByRostersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRostersStep(), opts...)
+	}
+}
+
+// ByRosters orders the results by rosters terms.
+func ByRosters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRostersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOvertimeRequestsCount orders the results by overtime_requests count.
+func ByOvertimeRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOvertimeRequestsStep(), opts...)
+	}
+}
+
+// ByOvertimeRequests orders the results by overtime_requests terms.
+func ByOvertimeRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOvertimeRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTaskCommentsCount orders the results by task_comments count.
+func ByTaskCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaskCommentsStep(), opts...)
+	}
+}
+
+// ByTaskComments orders the results by task_comments terms.
+func ByTaskComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCommentMentionsCount orders the results by comment_mentions count.
+func ByCommentMentionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentMentionsStep(), opts...)
+	}
+}
+
+// ByCommentMentions orders the results by comment_mentions terms.
+func ByCommentMentions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentMentionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewedTaskReportsCount orders the results by reviewed_task_reports count.
+func ByReviewedTaskReportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewedTaskReportsStep(), opts...)
+	}
+}
+
+// ByReviewedTaskReports orders the results by reviewed_task_reports terms.
+func ByReviewedTaskReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewedTaskReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttachmentsCount orders the results by attachments count.
+func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentsStep(), opts...)
+	}
+}
+
+// ByAttachments orders the results by attachments terms.
+func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWorkLogsCount orders the results by work_logs count.
+func ByWorkLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkLogsStep(), opts...)
+	}
+}
+
+// ByWorkLogs orders the results by work_logs terms.
+func ByWorkLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPositionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -410,3 +687,87 @@ func newAppointmentHistoriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AppointmentHistoriesTable, AppointmentHistoriesColumn),
 	)
 }
+func newContractsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContractsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContractsTable, ContractsColumn),
+	)
+}
+func newStatusHistoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusHistoriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusHistoriesTable, StatusHistoriesColumn),
+	)
+}
+func newCompensationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CompensationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CompensationsTable, CompensationsColumn),
+	)
+}
+func newAttendanceRecordsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceRecordsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttendanceRecordsTable, AttendanceRecordsColumn),
+	)
+}
+func newAttendanceCorrectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceCorrectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttendanceCorrectionsTable, AttendanceCorrectionsColumn),
+	)
+}
+func newRostersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RostersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RostersTable, RostersColumn),
+	)
+}
+func newOvertimeRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OvertimeRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OvertimeRequestsTable, OvertimeRequestsColumn),
+	)
+}
+func newTaskCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskCommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TaskCommentsTable, TaskCommentsColumn),
+	)
+}
+func newCommentMentionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentMentionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, CommentMentionsTable, CommentMentionsPrimaryKey...),
+	)
+}
+func newReviewedTaskReportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewedTaskReportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewedTaskReportsTable, ReviewedTaskReportsColumn),
+	)
+}
+func newAttachmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+	)
+}
+func newWorkLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorkLogsTable, WorkLogsColumn),
+	)
+}
@@ -637,6 +637,282 @@ func HasAppointmentHistoriesWith(preds ...predicate.AppointmentHistory) predicat
 	})
 }
 
+// HasContracts applies the HasEdge predicate on the "contracts" edge.
+func HasContracts() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContractsTable, ContractsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContractsWith applies the HasEdge predicate on the "contracts" edge with a given conditions (other predicates).
+func HasContractsWith(preds ...predicate.EmployeeContract) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newContractsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatusHistories applies the HasEdge predicate on the "status_histories" edge.
+func HasStatusHistories() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusHistoriesTable, StatusHistoriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusHistoriesWith applies the HasEdge predicate on the "status_histories" edge with a given conditions (other predicates).
+func HasStatusHistoriesWith(preds ...predicate.EmployeeStatusHistory) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newStatusHistoriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCompensations applies the HasEdge predicate on the "compensations" edge.
+func HasCompensations() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CompensationsTable, CompensationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCompensationsWith applies the HasEdge predicate on the "compensations" edge with a given conditions (other predicates).
+func HasCompensationsWith(preds ...predicate.Compensation) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newCompensationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendanceRecords applies the HasEdge predicate on the "attendance_records" edge.
+func HasAttendanceRecords() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttendanceRecordsTable, AttendanceRecordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceRecordsWith applies the HasEdge predicate on the "attendance_records" edge with a given conditions (other predicates).
+func HasAttendanceRecordsWith(preds ...predicate.AttendanceRecord) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newAttendanceRecordsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendanceCorrections applies the HasEdge predicate on the "attendance_corrections" edge.
+func HasAttendanceCorrections() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttendanceCorrectionsTable, AttendanceCorrectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceCorrectionsWith applies the HasEdge predicate on the "attendance_corrections" edge with a given conditions (other predicates).
+func HasAttendanceCorrectionsWith(preds ...predicate.AttendanceCorrection) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newAttendanceCorrectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRosters applies the HasEdge predicate on the "rosters" edge.
+func HasRosters() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RostersTable, RostersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRostersWith applies the HasEdge predicate on the "rosters" edge with a given conditions (other predicates).
+func HasRostersWith(preds ...predicate.Roster) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newRostersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOvertimeRequests applies the HasEdge predicate on the "overtime_requests" edge.
+func HasOvertimeRequests() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OvertimeRequestsTable, OvertimeRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOvertimeRequestsWith applies the HasEdge predicate on the "overtime_requests" edge with a given conditions (other predicates).
+func HasOvertimeRequestsWith(preds ...predicate.OvertimeRequest) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newOvertimeRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTaskComments applies the HasEdge predicate on the "task_comments" edge.
+func HasTaskComments() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TaskCommentsTable, TaskCommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskCommentsWith applies the HasEdge predicate on the "task_comments" edge with a given conditions (other predicates).
+func HasTaskCommentsWith(preds ...predicate.TaskComment) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newTaskCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCommentMentions applies the HasEdge predicate on the "comment_mentions" edge.
+func HasCommentMentions() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, CommentMentionsTable, CommentMentionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentMentionsWith applies the HasEdge predicate on the "comment_mentions" edge with a given conditions (other predicates).
+func HasCommentMentionsWith(preds ...predicate.TaskComment) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newCommentMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewedTaskReports applies the HasEdge predicate on the "reviewed_task_reports" edge.
+func HasReviewedTaskReports() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewedTaskReportsTable, ReviewedTaskReportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewedTaskReportsWith applies the HasEdge predicate on the "reviewed_task_reports" edge with a given conditions (other predicates).
+func HasReviewedTaskReportsWith(preds ...predicate.TaskReport) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newReviewedTaskReportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkLogs applies the HasEdge predicate on the "work_logs" edge.
+func HasWorkLogs() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WorkLogsTable, WorkLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkLogsWith applies the HasEdge predicate on the "work_logs" edge with a given conditions (other predicates).
+func HasWorkLogsWith(preds ...predicate.WorkLog) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newWorkLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Employee) predicate.Employee {
 	return predicate.Employee(sql.AndPredicates(predicates...))
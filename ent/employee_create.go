@@ -12,13 +12,23 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaveapproval"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 )
 
 // EmployeeCreate is the builder for creating a Employee entity.
@@ -234,6 +244,186 @@ func (ec *EmployeeCreate) AddAppointmentHistories(a ...*AppointmentHistory) *Emp
 	return ec.AddAppointmentHistoryIDs(ids...)
 }
 
+// AddContractIDs adds the "contracts" edge to the EmployeeContract entity by IDs.
+func (ec *EmployeeCreate) AddContractIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddContractIDs(ids...)
+	return ec
+}
+
+// AddContracts adds the "contracts" edges to the EmployeeContract entity.
+func (ec *EmployeeCreate) AddContracts(e ...*EmployeeContract) *EmployeeCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ec.AddContractIDs(ids...)
+}
+
+// AddStatusHistoryIDs adds the "status_histories" edge to the EmployeeStatusHistory entity by IDs.
+func (ec *EmployeeCreate) AddStatusHistoryIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddStatusHistoryIDs(ids...)
+	return ec
+}
+
+// AddStatusHistories adds the "status_histories" edges to the EmployeeStatusHistory entity.
+func (ec *EmployeeCreate) AddStatusHistories(e ...*EmployeeStatusHistory) *EmployeeCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ec.AddStatusHistoryIDs(ids...)
+}
+
+// AddCompensationIDs adds the "compensations" edge to the Compensation entity by IDs.
+func (ec *EmployeeCreate) AddCompensationIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddCompensationIDs(ids...)
+	return ec
+}
+
+// AddCompensations adds the "compensations" edges to the Compensation entity.
+func (ec *EmployeeCreate) AddCompensations(c ...*Compensation) *EmployeeCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ec.AddCompensationIDs(ids...)
+}
+
+// AddAttendanceRecordIDs adds the "attendance_records" edge to the AttendanceRecord entity by IDs.
+func (ec *EmployeeCreate) AddAttendanceRecordIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddAttendanceRecordIDs(ids...)
+	return ec
+}
+
+// AddAttendanceRecords adds the "attendance_records" edges to the AttendanceRecord entity.
+func (ec *EmployeeCreate) AddAttendanceRecords(a ...*AttendanceRecord) *EmployeeCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ec.AddAttendanceRecordIDs(ids...)
+}
+
+// AddAttendanceCorrectionIDs adds the "attendance_corrections" edge to the AttendanceCorrection entity by IDs.
+func (ec *EmployeeCreate) AddAttendanceCorrectionIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddAttendanceCorrectionIDs(ids...)
+	return ec
+}
+
+// AddAttendanceCorrections adds the "attendance_corrections" edges to the AttendanceCorrection entity.
+func (ec *EmployeeCreate) AddAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ec.AddAttendanceCorrectionIDs(ids...)
+}
+
+// AddRosterIDs adds the "rosters" edge to the Roster entity by IDs.
+func (ec *EmployeeCreate) AddRosterIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddRosterIDs(ids...)
+	return ec
+}
+
+// AddRosters adds the "rosters" edges to the Roster entity.
+func (ec *EmployeeCreate) AddRosters(r ...*Roster) *EmployeeCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddRosterIDs(ids...)
+}
+
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (ec *EmployeeCreate) AddOvertimeRequestIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddOvertimeRequestIDs(ids...)
+	return ec
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (ec *EmployeeCreate) AddOvertimeRequests(o ...*OvertimeRequest) *EmployeeCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ec.AddOvertimeRequestIDs(ids...)
+}
+
+// AddTaskCommentIDs adds the "task_comments" edge to the TaskComment entity by IDs.
+func (ec *EmployeeCreate) AddTaskCommentIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddTaskCommentIDs(ids...)
+	return ec
+}
+
+// AddTaskComments adds the "task_comments" edges to the TaskComment entity.
+func (ec *EmployeeCreate) AddTaskComments(t ...*TaskComment) *EmployeeCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ec.AddTaskCommentIDs(ids...)
+}
+
+// AddCommentMentionIDs adds the "comment_mentions" edge to the TaskComment entity by IDs.
+func (ec *EmployeeCreate) AddCommentMentionIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddCommentMentionIDs(ids...)
+	return ec
+}
+
+// AddCommentMentions adds the "comment_mentions" edges to the TaskComment entity.
+func (ec *EmployeeCreate) AddCommentMentions(t ...*TaskComment) *EmployeeCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ec.AddCommentMentionIDs(ids...)
+}
+
+// AddReviewedTaskReportIDs adds the "reviewed_task_reports" edge to the TaskReport entity by IDs.
+func (ec *EmployeeCreate) AddReviewedTaskReportIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddReviewedTaskReportIDs(ids...)
+	return ec
+}
+
+// AddReviewedTaskReports adds the "reviewed_task_reports" edges to the TaskReport entity.
+func (ec *EmployeeCreate) AddReviewedTaskReports(t ...*TaskReport) *EmployeeCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ec.AddReviewedTaskReportIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (ec *EmployeeCreate) AddAttachmentIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddAttachmentIDs(ids...)
+	return ec
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (ec *EmployeeCreate) AddAttachments(a ...*Attachment) *EmployeeCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ec.AddAttachmentIDs(ids...)
+}
+
+// AddWorkLogIDs adds the "work_logs" edge to the WorkLog entity by IDs.
+func (ec *EmployeeCreate) AddWorkLogIDs(ids ...int) *EmployeeCreate {
+	ec.mutation.AddWorkLogIDs(ids...)
+	return ec
+}
+
+// AddWorkLogs adds the "work_logs" edges to the WorkLog entity.
+func (ec *EmployeeCreate) AddWorkLogs(w ...*WorkLog) *EmployeeCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ec.AddWorkLogIDs(ids...)
+}
+
 // Mutation returns the EmployeeMutation object of the builder.
 func (ec *EmployeeCreate) Mutation() *EmployeeMutation {
 	return ec.mutation
@@ -519,6 +709,198 @@ func (ec *EmployeeCreate) createSpec() (*Employee, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.ContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ContractsTable,
+			Columns: []string{employee.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.StatusHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.StatusHistoriesTable,
+			Columns: []string{employee.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.CompensationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CompensationsTable,
+			Columns: []string{employee.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.AttendanceRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceRecordsTable,
+			Columns: []string{employee.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.AttendanceCorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.RostersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.RostersTable,
+			Columns: []string{employee.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimeRequestsTable,
+			Columns: []string{employee.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.TaskCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.TaskCommentsTable,
+			Columns: []string{employee.TaskCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.CommentMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.CommentMentionsTable,
+			Columns: employee.CommentMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.ReviewedTaskReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ReviewedTaskReportsTable,
+			Columns: []string{employee.ReviewedTaskReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttachmentsTable,
+			Columns: []string{employee.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.WorkLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.WorkLogsTable,
+			Columns: []string{employee.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
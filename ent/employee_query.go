@@ -13,32 +13,54 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaveapproval"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 )
 
 // EmployeeQuery is the builder for querying Employee entities.
 type EmployeeQuery struct {
 	config
-	ctx                      *QueryContext
-	order                    []employee.OrderOption
-	inters                   []Interceptor
-	predicates               []predicate.Employee
-	withPosition             *PositionQuery
-	withCreatedProjects      *ProjectQuery
-	withUpdatedProjects      *ProjectQuery
-	withAssignedTasks        *TaskQuery
-	withLeaveApproves        *LeaveApprovalQuery
-	withLeaveRequests        *LeaveRequestQuery
-	withTaskReports          *TaskReportQuery
-	withProjects             *ProjectQuery
-	withAppointmentHistories *AppointmentHistoryQuery
+	ctx                       *QueryContext
+	order                     []employee.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Employee
+	withPosition              *PositionQuery
+	withCreatedProjects       *ProjectQuery
+	withUpdatedProjects       *ProjectQuery
+	withAssignedTasks         *TaskQuery
+	withLeaveApproves         *LeaveApprovalQuery
+	withLeaveRequests         *LeaveRequestQuery
+	withTaskReports           *TaskReportQuery
+	withProjects              *ProjectQuery
+	withAppointmentHistories  *AppointmentHistoryQuery
+	withContracts             *EmployeeContractQuery
+	withStatusHistories       *EmployeeStatusHistoryQuery
+	withCompensations         *CompensationQuery
+	withAttendanceRecords     *AttendanceRecordQuery
+	withAttendanceCorrections *AttendanceCorrectionQuery
+	withRosters               *RosterQuery
+	withOvertimeRequests      *OvertimeRequestQuery
+	withTaskComments          *TaskCommentQuery
+	withCommentMentions       *TaskCommentQuery
+	withReviewedTaskReports   *TaskReportQuery
+	withAttachments           *AttachmentQuery
+	withWorkLogs              *WorkLogQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -273,6 +295,270 @@ func (eq *EmployeeQuery) QueryAppointmentHistories() *AppointmentHistoryQuery {
 	return query
 }
 
+// QueryContracts chains the current query on the "contracts" edge.
+func (eq *EmployeeQuery) QueryContracts() *EmployeeContractQuery {
+	query := (&EmployeeContractClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(employeecontract.Table, employeecontract.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.ContractsTable, employee.ContractsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatusHistories chains the current query on the "status_histories" edge.
+func (eq *EmployeeQuery) QueryStatusHistories() *EmployeeStatusHistoryQuery {
+	query := (&EmployeeStatusHistoryClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(employeestatushistory.Table, employeestatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.StatusHistoriesTable, employee.StatusHistoriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCompensations chains the current query on the "compensations" edge.
+func (eq *EmployeeQuery) QueryCompensations() *CompensationQuery {
+	query := (&CompensationClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(compensation.Table, compensation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.CompensationsTable, employee.CompensationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttendanceRecords chains the current query on the "attendance_records" edge.
+func (eq *EmployeeQuery) QueryAttendanceRecords() *AttendanceRecordQuery {
+	query := (&AttendanceRecordClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(attendancerecord.Table, attendancerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendanceRecordsTable, employee.AttendanceRecordsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttendanceCorrections chains the current query on the "attendance_corrections" edge.
+func (eq *EmployeeQuery) QueryAttendanceCorrections() *AttendanceCorrectionQuery {
+	query := (&AttendanceCorrectionClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(attendancecorrection.Table, attendancecorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttendanceCorrectionsTable, employee.AttendanceCorrectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRosters chains the current query on the "rosters" edge.
+func (eq *EmployeeQuery) QueryRosters() *RosterQuery {
+	query := (&RosterClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(roster.Table, roster.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.RostersTable, employee.RostersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOvertimeRequests chains the current query on the "overtime_requests" edge.
+func (eq *EmployeeQuery) QueryOvertimeRequests() *OvertimeRequestQuery {
+	query := (&OvertimeRequestClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(overtimerequest.Table, overtimerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.OvertimeRequestsTable, employee.OvertimeRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTaskComments chains the current query on the "task_comments" edge.
+func (eq *EmployeeQuery) QueryTaskComments() *TaskCommentQuery {
+	query := (&TaskCommentClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.TaskCommentsTable, employee.TaskCommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCommentMentions chains the current query on the "comment_mentions" edge.
+func (eq *EmployeeQuery) QueryCommentMentions() *TaskCommentQuery {
+	query := (&TaskCommentClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, employee.CommentMentionsTable, employee.CommentMentionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReviewedTaskReports chains the current query on the "reviewed_task_reports" edge.
+func (eq *EmployeeQuery) QueryReviewedTaskReports() *TaskReportQuery {
+	query := (&TaskReportClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(taskreport.Table, taskreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.ReviewedTaskReportsTable, employee.ReviewedTaskReportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (eq *EmployeeQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AttachmentsTable, employee.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWorkLogs chains the current query on the "work_logs" edge.
+func (eq *EmployeeQuery) QueryWorkLogs() *WorkLogQuery {
+	query := (&WorkLogClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(worklog.Table, worklog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.WorkLogsTable, employee.WorkLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Employee entity from the query.
 // Returns a *NotFoundError when no Employee was found.
 func (eq *EmployeeQuery) First(ctx context.Context) (*Employee, error) {
@@ -460,20 +746,32 @@ func (eq *EmployeeQuery) Clone() *EmployeeQuery {
 		return nil
 	}
 	return &EmployeeQuery{
-		config:                   eq.config,
-		ctx:                      eq.ctx.Clone(),
-		order:                    append([]employee.OrderOption{}, eq.order...),
-		inters:                   append([]Interceptor{}, eq.inters...),
-		predicates:               append([]predicate.Employee{}, eq.predicates...),
-		withPosition:             eq.withPosition.Clone(),
-		withCreatedProjects:      eq.withCreatedProjects.Clone(),
-		withUpdatedProjects:      eq.withUpdatedProjects.Clone(),
-		withAssignedTasks:        eq.withAssignedTasks.Clone(),
-		withLeaveApproves:        eq.withLeaveApproves.Clone(),
-		withLeaveRequests:        eq.withLeaveRequests.Clone(),
-		withTaskReports:          eq.withTaskReports.Clone(),
-		withProjects:             eq.withProjects.Clone(),
-		withAppointmentHistories: eq.withAppointmentHistories.Clone(),
+		config:                    eq.config,
+		ctx:                       eq.ctx.Clone(),
+		order:                     append([]employee.OrderOption{}, eq.order...),
+		inters:                    append([]Interceptor{}, eq.inters...),
+		predicates:                append([]predicate.Employee{}, eq.predicates...),
+		withPosition:              eq.withPosition.Clone(),
+		withCreatedProjects:       eq.withCreatedProjects.Clone(),
+		withUpdatedProjects:       eq.withUpdatedProjects.Clone(),
+		withAssignedTasks:         eq.withAssignedTasks.Clone(),
+		withLeaveApproves:         eq.withLeaveApproves.Clone(),
+		withLeaveRequests:         eq.withLeaveRequests.Clone(),
+		withTaskReports:           eq.withTaskReports.Clone(),
+		withProjects:              eq.withProjects.Clone(),
+		withAppointmentHistories:  eq.withAppointmentHistories.Clone(),
+		withContracts:             eq.withContracts.Clone(),
+		withStatusHistories:       eq.withStatusHistories.Clone(),
+		withCompensations:         eq.withCompensations.Clone(),
+		withAttendanceRecords:     eq.withAttendanceRecords.Clone(),
+		withAttendanceCorrections: eq.withAttendanceCorrections.Clone(),
+		withRosters:               eq.withRosters.Clone(),
+		withOvertimeRequests:      eq.withOvertimeRequests.Clone(),
+		withTaskComments:          eq.withTaskComments.Clone(),
+		withCommentMentions:       eq.withCommentMentions.Clone(),
+		withReviewedTaskReports:   eq.withReviewedTaskReports.Clone(),
+		withAttachments:           eq.withAttachments.Clone(),
+		withWorkLogs:              eq.withWorkLogs.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -579,52 +877,184 @@ func (eq *EmployeeQuery) WithAppointmentHistories(opts ...func(*AppointmentHisto
 	return eq
 }
 
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		UserID string `json:"user_id"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.Employee.Query().
-//		GroupBy(employee.FieldUserID).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (eq *EmployeeQuery) GroupBy(field string, fields ...string) *EmployeeGroupBy {
-	eq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &EmployeeGroupBy{build: eq}
-	grbuild.flds = &eq.ctx.Fields
-	grbuild.label = employee.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
+// WithContracts tells the query-builder to eager-load the nodes that are connected to
+// the "contracts" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithContracts(opts ...func(*EmployeeContractQuery)) *EmployeeQuery {
+	query := (&EmployeeContractClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withContracts = query
+	return eq
 }
 
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		UserID string `json:"user_id"`
-//	}
-//
-//	client.Employee.Query().
-//		Select(employee.FieldUserID).
-//		Scan(ctx, &v)
-func (eq *EmployeeQuery) Select(fields ...string) *EmployeeSelect {
-	eq.ctx.Fields = append(eq.ctx.Fields, fields...)
-	sbuild := &EmployeeSelect{EmployeeQuery: eq}
-	sbuild.label = employee.Label
-	sbuild.flds, sbuild.scan = &eq.ctx.Fields, sbuild.Scan
-	return sbuild
+// WithStatusHistories tells the query-builder to eager-load the nodes that are connected to
+// the "status_histories" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithStatusHistories(opts ...func(*EmployeeStatusHistoryQuery)) *EmployeeQuery {
+	query := (&EmployeeStatusHistoryClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withStatusHistories = query
+	return eq
 }
 
-// Aggregate returns a EmployeeSelect configured with the given aggregations.
-func (eq *EmployeeQuery) Aggregate(fns ...AggregateFunc) *EmployeeSelect {
-	return eq.Select().Aggregate(fns...)
+// WithCompensations tells the query-builder to eager-load the nodes that are connected to
+// the "compensations" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithCompensations(opts ...func(*CompensationQuery)) *EmployeeQuery {
+	query := (&CompensationClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withCompensations = query
+	return eq
+}
+
+// WithAttendanceRecords tells the query-builder to eager-load the nodes that are connected to
+// the "attendance_records" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithAttendanceRecords(opts ...func(*AttendanceRecordQuery)) *EmployeeQuery {
+	query := (&AttendanceRecordClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withAttendanceRecords = query
+	return eq
+}
+
+// WithAttendanceCorrections tells the query-builder to eager-load the nodes that are connected to
+// the "attendance_corrections" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithAttendanceCorrections(opts ...func(*AttendanceCorrectionQuery)) *EmployeeQuery {
+	query := (&AttendanceCorrectionClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withAttendanceCorrections = query
+	return eq
+}
+
+// WithRosters tells the query-builder to eager-load the nodes that are connected to
+// the "rosters" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithRosters(opts ...func(*RosterQuery)) *EmployeeQuery {
+	query := (&RosterClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withRosters = query
+	return eq
+}
+
+// WithOvertimeRequests tells the query-builder to eager-load the nodes that are connected to
+// the "overtime_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithOvertimeRequests(opts ...func(*OvertimeRequestQuery)) *EmployeeQuery {
+	query := (&OvertimeRequestClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withOvertimeRequests = query
+	return eq
+}
+
+// WithTaskComments tells the query-builder to eager-load the nodes that are connected to
+// the "task_comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithTaskComments(opts ...func(*TaskCommentQuery)) *EmployeeQuery {
+	query := (&TaskCommentClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withTaskComments = query
+	return eq
+}
+
+// WithCommentMentions tells the query-builder to eager-load the nodes that are connected to
+// the "comment_mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithCommentMentions(opts ...func(*TaskCommentQuery)) *EmployeeQuery {
+	query := (&TaskCommentClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withCommentMentions = query
+	return eq
+}
+
+// WithReviewedTaskReports tells the query-builder to eager-load the nodes that are connected to
+// the "reviewed_task_reports" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithReviewedTaskReports(opts ...func(*TaskReportQuery)) *EmployeeQuery {
+	query := (&TaskReportClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withReviewedTaskReports = query
+	return eq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithAttachments(opts ...func(*AttachmentQuery)) *EmployeeQuery {
+	query := (&AttachmentClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withAttachments = query
+	return eq
+}
+
+// WithWorkLogs tells the query-builder to eager-load the nodes that are connected to
+// the "work_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EmployeeQuery) WithWorkLogs(opts ...func(*WorkLogQuery)) *EmployeeQuery {
+	query := (&WorkLogClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withWorkLogs = query
+	return eq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Employee.Query().
+//		GroupBy(employee.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eq *EmployeeQuery) GroupBy(field string, fields ...string) *EmployeeGroupBy {
+	eq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmployeeGroupBy{build: eq}
+	grbuild.flds = &eq.ctx.Fields
+	grbuild.label = employee.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id"`
+//	}
+//
+//	client.Employee.Query().
+//		Select(employee.FieldUserID).
+//		Scan(ctx, &v)
+func (eq *EmployeeQuery) Select(fields ...string) *EmployeeSelect {
+	eq.ctx.Fields = append(eq.ctx.Fields, fields...)
+	sbuild := &EmployeeSelect{EmployeeQuery: eq}
+	sbuild.label = employee.Label
+	sbuild.flds, sbuild.scan = &eq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmployeeSelect configured with the given aggregations.
+func (eq *EmployeeQuery) Aggregate(fns ...AggregateFunc) *EmployeeSelect {
+	return eq.Select().Aggregate(fns...)
 }
 
 func (eq *EmployeeQuery) prepareQuery(ctx context.Context) error {
@@ -657,7 +1087,7 @@ func (eq *EmployeeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Emp
 	var (
 		nodes       = []*Employee{}
 		_spec       = eq.querySpec()
-		loadedTypes = [9]bool{
+		loadedTypes = [21]bool{
 			eq.withPosition != nil,
 			eq.withCreatedProjects != nil,
 			eq.withUpdatedProjects != nil,
@@ -667,6 +1097,18 @@ func (eq *EmployeeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Emp
 			eq.withTaskReports != nil,
 			eq.withProjects != nil,
 			eq.withAppointmentHistories != nil,
+			eq.withContracts != nil,
+			eq.withStatusHistories != nil,
+			eq.withCompensations != nil,
+			eq.withAttendanceRecords != nil,
+			eq.withAttendanceCorrections != nil,
+			eq.withRosters != nil,
+			eq.withOvertimeRequests != nil,
+			eq.withTaskComments != nil,
+			eq.withCommentMentions != nil,
+			eq.withReviewedTaskReports != nil,
+			eq.withAttachments != nil,
+			eq.withWorkLogs != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -751,6 +1193,96 @@ func (eq *EmployeeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Emp
 			return nil, err
 		}
 	}
+	if query := eq.withContracts; query != nil {
+		if err := eq.loadContracts(ctx, query, nodes,
+			func(n *Employee) { n.Edges.Contracts = []*EmployeeContract{} },
+			func(n *Employee, e *EmployeeContract) { n.Edges.Contracts = append(n.Edges.Contracts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withStatusHistories; query != nil {
+		if err := eq.loadStatusHistories(ctx, query, nodes,
+			func(n *Employee) { n.Edges.StatusHistories = []*EmployeeStatusHistory{} },
+			func(n *Employee, e *EmployeeStatusHistory) {
+				n.Edges.StatusHistories = append(n.Edges.StatusHistories, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withCompensations; query != nil {
+		if err := eq.loadCompensations(ctx, query, nodes,
+			func(n *Employee) { n.Edges.Compensations = []*Compensation{} },
+			func(n *Employee, e *Compensation) { n.Edges.Compensations = append(n.Edges.Compensations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withAttendanceRecords; query != nil {
+		if err := eq.loadAttendanceRecords(ctx, query, nodes,
+			func(n *Employee) { n.Edges.AttendanceRecords = []*AttendanceRecord{} },
+			func(n *Employee, e *AttendanceRecord) {
+				n.Edges.AttendanceRecords = append(n.Edges.AttendanceRecords, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withAttendanceCorrections; query != nil {
+		if err := eq.loadAttendanceCorrections(ctx, query, nodes,
+			func(n *Employee) { n.Edges.AttendanceCorrections = []*AttendanceCorrection{} },
+			func(n *Employee, e *AttendanceCorrection) {
+				n.Edges.AttendanceCorrections = append(n.Edges.AttendanceCorrections, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withRosters; query != nil {
+		if err := eq.loadRosters(ctx, query, nodes,
+			func(n *Employee) { n.Edges.Rosters = []*Roster{} },
+			func(n *Employee, e *Roster) { n.Edges.Rosters = append(n.Edges.Rosters, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withOvertimeRequests; query != nil {
+		if err := eq.loadOvertimeRequests(ctx, query, nodes,
+			func(n *Employee) { n.Edges.OvertimeRequests = []*OvertimeRequest{} },
+			func(n *Employee, e *OvertimeRequest) { n.Edges.OvertimeRequests = append(n.Edges.OvertimeRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withTaskComments; query != nil {
+		if err := eq.loadTaskComments(ctx, query, nodes,
+			func(n *Employee) { n.Edges.TaskComments = []*TaskComment{} },
+			func(n *Employee, e *TaskComment) { n.Edges.TaskComments = append(n.Edges.TaskComments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withCommentMentions; query != nil {
+		if err := eq.loadCommentMentions(ctx, query, nodes,
+			func(n *Employee) { n.Edges.CommentMentions = []*TaskComment{} },
+			func(n *Employee, e *TaskComment) { n.Edges.CommentMentions = append(n.Edges.CommentMentions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withReviewedTaskReports; query != nil {
+		if err := eq.loadReviewedTaskReports(ctx, query, nodes,
+			func(n *Employee) { n.Edges.ReviewedTaskReports = []*TaskReport{} },
+			func(n *Employee, e *TaskReport) { n.Edges.ReviewedTaskReports = append(n.Edges.ReviewedTaskReports, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withAttachments; query != nil {
+		if err := eq.loadAttachments(ctx, query, nodes,
+			func(n *Employee) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Employee, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withWorkLogs; query != nil {
+		if err := eq.loadWorkLogs(ctx, query, nodes,
+			func(n *Employee) { n.Edges.WorkLogs = []*WorkLog{} },
+			func(n *Employee, e *WorkLog) { n.Edges.WorkLogs = append(n.Edges.WorkLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1085,6 +1617,400 @@ func (eq *EmployeeQuery) loadAppointmentHistories(ctx context.Context, query *Ap
 	}
 	return nil
 }
+func (eq *EmployeeQuery) loadContracts(ctx context.Context, query *EmployeeContractQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *EmployeeContract)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(employeecontract.FieldEmployeeID)
+	}
+	query.Where(predicate.EmployeeContract(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.ContractsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadStatusHistories(ctx context.Context, query *EmployeeStatusHistoryQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *EmployeeStatusHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(employeestatushistory.FieldEmployeeID)
+	}
+	query.Where(predicate.EmployeeStatusHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.StatusHistoriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadCompensations(ctx context.Context, query *CompensationQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *Compensation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(compensation.FieldEmployeeID)
+	}
+	query.Where(predicate.Compensation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.CompensationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadAttendanceRecords(ctx context.Context, query *AttendanceRecordQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *AttendanceRecord)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendancerecord.FieldEmployeeID)
+	}
+	query.Where(predicate.AttendanceRecord(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.AttendanceRecordsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadAttendanceCorrections(ctx context.Context, query *AttendanceCorrectionQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *AttendanceCorrection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendancecorrection.FieldEmployeeID)
+	}
+	query.Where(predicate.AttendanceCorrection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.AttendanceCorrectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadRosters(ctx context.Context, query *RosterQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *Roster)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roster.FieldEmployeeID)
+	}
+	query.Where(predicate.Roster(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.RostersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadOvertimeRequests(ctx context.Context, query *OvertimeRequestQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *OvertimeRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(overtimerequest.FieldEmployeeID)
+	}
+	query.Where(predicate.OvertimeRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.OvertimeRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadTaskComments(ctx context.Context, query *TaskCommentQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *TaskComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskcomment.FieldAuthorID)
+	}
+	query.Where(predicate.TaskComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.TaskCommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AuthorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "author_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadCommentMentions(ctx context.Context, query *TaskCommentQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *TaskComment)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Employee)
+	nids := make(map[int]map[*Employee]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(employee.CommentMentionsTable)
+		s.Join(joinT).On(s.C(taskcomment.FieldID), joinT.C(employee.CommentMentionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(employee.CommentMentionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(employee.CommentMentionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Employee]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*TaskComment](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "comment_mentions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadReviewedTaskReports(ctx context.Context, query *TaskReportQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *TaskReport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskreport.FieldReviewerID)
+	}
+	query.Where(predicate.TaskReport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.ReviewedTaskReportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReviewerID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "reviewer_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reviewer_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldUploaderID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UploaderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "uploader_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EmployeeQuery) loadWorkLogs(ctx context.Context, query *WorkLogQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *WorkLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(worklog.FieldEmployeeID)
+	}
+	query.Where(predicate.WorkLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.WorkLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (eq *EmployeeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
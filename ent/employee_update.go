@@ -12,14 +12,24 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaveapproval"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 )
 
 // EmployeeUpdate is the builder for updating Employee entities.
@@ -263,6 +273,186 @@ func (eu *EmployeeUpdate) AddAppointmentHistories(a ...*AppointmentHistory) *Emp
 	return eu.AddAppointmentHistoryIDs(ids...)
 }
 
+// AddContractIDs adds the "contracts" edge to the EmployeeContract entity by IDs.
+func (eu *EmployeeUpdate) AddContractIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddContractIDs(ids...)
+	return eu
+}
+
+// AddContracts adds the "contracts" edges to the EmployeeContract entity.
+func (eu *EmployeeUpdate) AddContracts(e ...*EmployeeContract) *EmployeeUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.AddContractIDs(ids...)
+}
+
+// AddStatusHistoryIDs adds the "status_histories" edge to the EmployeeStatusHistory entity by IDs.
+func (eu *EmployeeUpdate) AddStatusHistoryIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddStatusHistoryIDs(ids...)
+	return eu
+}
+
+// AddStatusHistories adds the "status_histories" edges to the EmployeeStatusHistory entity.
+func (eu *EmployeeUpdate) AddStatusHistories(e ...*EmployeeStatusHistory) *EmployeeUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.AddStatusHistoryIDs(ids...)
+}
+
+// AddCompensationIDs adds the "compensations" edge to the Compensation entity by IDs.
+func (eu *EmployeeUpdate) AddCompensationIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddCompensationIDs(ids...)
+	return eu
+}
+
+// AddCompensations adds the "compensations" edges to the Compensation entity.
+func (eu *EmployeeUpdate) AddCompensations(c ...*Compensation) *EmployeeUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.AddCompensationIDs(ids...)
+}
+
+// AddAttendanceRecordIDs adds the "attendance_records" edge to the AttendanceRecord entity by IDs.
+func (eu *EmployeeUpdate) AddAttendanceRecordIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddAttendanceRecordIDs(ids...)
+	return eu
+}
+
+// AddAttendanceRecords adds the "attendance_records" edges to the AttendanceRecord entity.
+func (eu *EmployeeUpdate) AddAttendanceRecords(a ...*AttendanceRecord) *EmployeeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.AddAttendanceRecordIDs(ids...)
+}
+
+// AddAttendanceCorrectionIDs adds the "attendance_corrections" edge to the AttendanceCorrection entity by IDs.
+func (eu *EmployeeUpdate) AddAttendanceCorrectionIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddAttendanceCorrectionIDs(ids...)
+	return eu
+}
+
+// AddAttendanceCorrections adds the "attendance_corrections" edges to the AttendanceCorrection entity.
+func (eu *EmployeeUpdate) AddAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.AddAttendanceCorrectionIDs(ids...)
+}
+
+// AddRosterIDs adds the "rosters" edge to the Roster entity by IDs.
+func (eu *EmployeeUpdate) AddRosterIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddRosterIDs(ids...)
+	return eu
+}
+
+// AddRosters adds the "rosters" edges to the Roster entity.
+func (eu *EmployeeUpdate) AddRosters(r ...*Roster) *EmployeeUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddRosterIDs(ids...)
+}
+
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (eu *EmployeeUpdate) AddOvertimeRequestIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddOvertimeRequestIDs(ids...)
+	return eu
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (eu *EmployeeUpdate) AddOvertimeRequests(o ...*OvertimeRequest) *EmployeeUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return eu.AddOvertimeRequestIDs(ids...)
+}
+
+// AddTaskCommentIDs adds the "task_comments" edge to the TaskComment entity by IDs.
+func (eu *EmployeeUpdate) AddTaskCommentIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddTaskCommentIDs(ids...)
+	return eu
+}
+
+// AddTaskComments adds the "task_comments" edges to the TaskComment entity.
+func (eu *EmployeeUpdate) AddTaskComments(t ...*TaskComment) *EmployeeUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eu.AddTaskCommentIDs(ids...)
+}
+
+// AddCommentMentionIDs adds the "comment_mentions" edge to the TaskComment entity by IDs.
+func (eu *EmployeeUpdate) AddCommentMentionIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddCommentMentionIDs(ids...)
+	return eu
+}
+
+// AddCommentMentions adds the "comment_mentions" edges to the TaskComment entity.
+func (eu *EmployeeUpdate) AddCommentMentions(t ...*TaskComment) *EmployeeUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eu.AddCommentMentionIDs(ids...)
+}
+
+// AddReviewedTaskReportIDs adds the "reviewed_task_reports" edge to the TaskReport entity by IDs.
+func (eu *EmployeeUpdate) AddReviewedTaskReportIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddReviewedTaskReportIDs(ids...)
+	return eu
+}
+
+// AddReviewedTaskReports adds the "reviewed_task_reports" edges to the TaskReport entity.
+func (eu *EmployeeUpdate) AddReviewedTaskReports(t ...*TaskReport) *EmployeeUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eu.AddReviewedTaskReportIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (eu *EmployeeUpdate) AddAttachmentIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddAttachmentIDs(ids...)
+	return eu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (eu *EmployeeUpdate) AddAttachments(a ...*Attachment) *EmployeeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.AddAttachmentIDs(ids...)
+}
+
+// AddWorkLogIDs adds the "work_logs" edge to the WorkLog entity by IDs.
+func (eu *EmployeeUpdate) AddWorkLogIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.AddWorkLogIDs(ids...)
+	return eu
+}
+
+// AddWorkLogs adds the "work_logs" edges to the WorkLog entity.
+func (eu *EmployeeUpdate) AddWorkLogs(w ...*WorkLog) *EmployeeUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eu.AddWorkLogIDs(ids...)
+}
+
 // Mutation returns the EmployeeMutation object of the builder.
 func (eu *EmployeeUpdate) Mutation() *EmployeeMutation {
 	return eu.mutation
@@ -442,6 +632,258 @@ func (eu *EmployeeUpdate) RemoveAppointmentHistories(a ...*AppointmentHistory) *
 	return eu.RemoveAppointmentHistoryIDs(ids...)
 }
 
+// ClearContracts clears all "contracts" edges to the EmployeeContract entity.
+func (eu *EmployeeUpdate) ClearContracts() *EmployeeUpdate {
+	eu.mutation.ClearContracts()
+	return eu
+}
+
+// RemoveContractIDs removes the "contracts" edge to EmployeeContract entities by IDs.
+func (eu *EmployeeUpdate) RemoveContractIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveContractIDs(ids...)
+	return eu
+}
+
+// RemoveContracts removes "contracts" edges to EmployeeContract entities.
+func (eu *EmployeeUpdate) RemoveContracts(e ...*EmployeeContract) *EmployeeUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.RemoveContractIDs(ids...)
+}
+
+// ClearStatusHistories clears all "status_histories" edges to the EmployeeStatusHistory entity.
+func (eu *EmployeeUpdate) ClearStatusHistories() *EmployeeUpdate {
+	eu.mutation.ClearStatusHistories()
+	return eu
+}
+
+// RemoveStatusHistoryIDs removes the "status_histories" edge to EmployeeStatusHistory entities by IDs.
+func (eu *EmployeeUpdate) RemoveStatusHistoryIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveStatusHistoryIDs(ids...)
+	return eu
+}
+
+// RemoveStatusHistories removes "status_histories" edges to EmployeeStatusHistory entities.
+func (eu *EmployeeUpdate) RemoveStatusHistories(e ...*EmployeeStatusHistory) *EmployeeUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.RemoveStatusHistoryIDs(ids...)
+}
+
+// ClearCompensations clears all "compensations" edges to the Compensation entity.
+func (eu *EmployeeUpdate) ClearCompensations() *EmployeeUpdate {
+	eu.mutation.ClearCompensations()
+	return eu
+}
+
+// RemoveCompensationIDs removes the "compensations" edge to Compensation entities by IDs.
+func (eu *EmployeeUpdate) RemoveCompensationIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveCompensationIDs(ids...)
+	return eu
+}
+
+// RemoveCompensations removes "compensations" edges to Compensation entities.
+func (eu *EmployeeUpdate) RemoveCompensations(c ...*Compensation) *EmployeeUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return eu.RemoveCompensationIDs(ids...)
+}
+
+// ClearAttendanceRecords clears all "attendance_records" edges to the AttendanceRecord entity.
+func (eu *EmployeeUpdate) ClearAttendanceRecords() *EmployeeUpdate {
+	eu.mutation.ClearAttendanceRecords()
+	return eu
+}
+
+// RemoveAttendanceRecordIDs removes the "attendance_records" edge to AttendanceRecord entities by IDs.
+func (eu *EmployeeUpdate) RemoveAttendanceRecordIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveAttendanceRecordIDs(ids...)
+	return eu
+}
+
+// RemoveAttendanceRecords removes "attendance_records" edges to AttendanceRecord entities.
+func (eu *EmployeeUpdate) RemoveAttendanceRecords(a ...*AttendanceRecord) *EmployeeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.RemoveAttendanceRecordIDs(ids...)
+}
+
+// ClearAttendanceCorrections clears all "attendance_corrections" edges to the AttendanceCorrection entity.
+func (eu *EmployeeUpdate) ClearAttendanceCorrections() *EmployeeUpdate {
+	eu.mutation.ClearAttendanceCorrections()
+	return eu
+}
+
+// RemoveAttendanceCorrectionIDs removes the "attendance_corrections" edge to AttendanceCorrection entities by IDs.
+func (eu *EmployeeUpdate) RemoveAttendanceCorrectionIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveAttendanceCorrectionIDs(ids...)
+	return eu
+}
+
+// RemoveAttendanceCorrections removes "attendance_corrections" edges to AttendanceCorrection entities.
+func (eu *EmployeeUpdate) RemoveAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.RemoveAttendanceCorrectionIDs(ids...)
+}
+
+// ClearRosters clears all "rosters" edges to the Roster entity.
+func (eu *EmployeeUpdate) ClearRosters() *EmployeeUpdate {
+	eu.mutation.ClearRosters()
+	return eu
+}
+
+// RemoveRosterIDs removes the "rosters" edge to Roster entities by IDs.
+func (eu *EmployeeUpdate) RemoveRosterIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveRosterIDs(ids...)
+	return eu
+}
+
+// RemoveRosters removes "rosters" edges to Roster entities.
+func (eu *EmployeeUpdate) RemoveRosters(r ...*Roster) *EmployeeUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveRosterIDs(ids...)
+}
+
+// ClearOvertimeRequests clears all "overtime_requests" edges to the OvertimeRequest entity.
+func (eu *EmployeeUpdate) ClearOvertimeRequests() *EmployeeUpdate {
+	eu.mutation.ClearOvertimeRequests()
+	return eu
+}
+
+// RemoveOvertimeRequestIDs removes the "overtime_requests" edge to OvertimeRequest entities by IDs.
+func (eu *EmployeeUpdate) RemoveOvertimeRequestIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveOvertimeRequestIDs(ids...)
+	return eu
+}
+
+// RemoveOvertimeRequests removes "overtime_requests" edges to OvertimeRequest entities.
+func (eu *EmployeeUpdate) RemoveOvertimeRequests(o ...*OvertimeRequest) *EmployeeUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return eu.RemoveOvertimeRequestIDs(ids...)
+}
+
+// ClearTaskComments clears all "task_comments" edges to the TaskComment entity.
+func (eu *EmployeeUpdate) ClearTaskComments() *EmployeeUpdate {
+	eu.mutation.ClearTaskComments()
+	return eu
+}
+
+// RemoveTaskCommentIDs removes the "task_comments" edge to TaskComment entities by IDs.
+func (eu *EmployeeUpdate) RemoveTaskCommentIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveTaskCommentIDs(ids...)
+	return eu
+}
+
+// RemoveTaskComments removes "task_comments" edges to TaskComment entities.
+func (eu *EmployeeUpdate) RemoveTaskComments(t ...*TaskComment) *EmployeeUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eu.RemoveTaskCommentIDs(ids...)
+}
+
+// ClearCommentMentions clears all "comment_mentions" edges to the TaskComment entity.
+func (eu *EmployeeUpdate) ClearCommentMentions() *EmployeeUpdate {
+	eu.mutation.ClearCommentMentions()
+	return eu
+}
+
+// RemoveCommentMentionIDs removes the "comment_mentions" edge to TaskComment entities by IDs.
+func (eu *EmployeeUpdate) RemoveCommentMentionIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveCommentMentionIDs(ids...)
+	return eu
+}
+
+// RemoveCommentMentions removes "comment_mentions" edges to TaskComment entities.
+func (eu *EmployeeUpdate) RemoveCommentMentions(t ...*TaskComment) *EmployeeUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eu.RemoveCommentMentionIDs(ids...)
+}
+
+// ClearReviewedTaskReports clears all "reviewed_task_reports" edges to the TaskReport entity.
+func (eu *EmployeeUpdate) ClearReviewedTaskReports() *EmployeeUpdate {
+	eu.mutation.ClearReviewedTaskReports()
+	return eu
+}
+
+// RemoveReviewedTaskReportIDs removes the "reviewed_task_reports" edge to TaskReport entities by IDs.
+func (eu *EmployeeUpdate) RemoveReviewedTaskReportIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveReviewedTaskReportIDs(ids...)
+	return eu
+}
+
+// RemoveReviewedTaskReports removes "reviewed_task_reports" edges to TaskReport entities.
+func (eu *EmployeeUpdate) RemoveReviewedTaskReports(t ...*TaskReport) *EmployeeUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eu.RemoveReviewedTaskReportIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (eu *EmployeeUpdate) ClearAttachments() *EmployeeUpdate {
+	eu.mutation.ClearAttachments()
+	return eu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (eu *EmployeeUpdate) RemoveAttachmentIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveAttachmentIDs(ids...)
+	return eu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (eu *EmployeeUpdate) RemoveAttachments(a ...*Attachment) *EmployeeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return eu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearWorkLogs clears all "work_logs" edges to the WorkLog entity.
+func (eu *EmployeeUpdate) ClearWorkLogs() *EmployeeUpdate {
+	eu.mutation.ClearWorkLogs()
+	return eu
+}
+
+// RemoveWorkLogIDs removes the "work_logs" edge to WorkLog entities by IDs.
+func (eu *EmployeeUpdate) RemoveWorkLogIDs(ids ...int) *EmployeeUpdate {
+	eu.mutation.RemoveWorkLogIDs(ids...)
+	return eu
+}
+
+// RemoveWorkLogs removes "work_logs" edges to WorkLog entities.
+func (eu *EmployeeUpdate) RemoveWorkLogs(w ...*WorkLog) *EmployeeUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eu.RemoveWorkLogIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (eu *EmployeeUpdate) Save(ctx context.Context) (int, error) {
 	eu.defaults()
@@ -921,575 +1363,1997 @@ func (eu *EmployeeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{employee.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if eu.mutation.ContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ContractsTable,
+			Columns: []string{employee.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
-	}
-	eu.mutation.done = true
-	return n, nil
-}
-
-// EmployeeUpdateOne is the builder for updating a single Employee entity.
-type EmployeeUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *EmployeeMutation
-}
-
-// SetUserID sets the "user_id" field.
-func (euo *EmployeeUpdateOne) SetUserID(s string) *EmployeeUpdateOne {
-	euo.mutation.SetUserID(s)
-	return euo
-}
-
-// SetNillableUserID sets the "user_id" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableUserID(s *string) *EmployeeUpdateOne {
-	if s != nil {
-		euo.SetUserID(*s)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo
-}
-
-// ClearUserID clears the value of the "user_id" field.
-func (euo *EmployeeUpdateOne) ClearUserID() *EmployeeUpdateOne {
-	euo.mutation.ClearUserID()
-	return euo
-}
-
-// SetCode sets the "code" field.
-func (euo *EmployeeUpdateOne) SetCode(s string) *EmployeeUpdateOne {
-	euo.mutation.SetCode(s)
-	return euo
-}
-
-// SetNillableCode sets the "code" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableCode(s *string) *EmployeeUpdateOne {
-	if s != nil {
-		euo.SetCode(*s)
+	if nodes := eu.mutation.RemovedContractsIDs(); len(nodes) > 0 && !eu.mutation.ContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ContractsTable,
+			Columns: []string{employee.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo
-}
-
-// SetStatus sets the "status" field.
-func (euo *EmployeeUpdateOne) SetStatus(e employee.Status) *EmployeeUpdateOne {
-	euo.mutation.SetStatus(e)
-	return euo
-}
-
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableStatus(e *employee.Status) *EmployeeUpdateOne {
-	if e != nil {
-		euo.SetStatus(*e)
+	if nodes := eu.mutation.ContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ContractsTable,
+			Columns: []string{employee.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return euo
-}
-
-// SetPositionID sets the "position_id" field.
-func (euo *EmployeeUpdateOne) SetPositionID(i int) *EmployeeUpdateOne {
-	euo.mutation.SetPositionID(i)
-	return euo
-}
-
-// SetNillablePositionID sets the "position_id" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillablePositionID(i *int) *EmployeeUpdateOne {
-	if i != nil {
-		euo.SetPositionID(*i)
+	if eu.mutation.StatusHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.StatusHistoriesTable,
+			Columns: []string{employee.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo
-}
-
-// SetJoiningAt sets the "joining_at" field.
-func (euo *EmployeeUpdateOne) SetJoiningAt(t time.Time) *EmployeeUpdateOne {
-	euo.mutation.SetJoiningAt(t)
-	return euo
-}
-
-// SetNillableJoiningAt sets the "joining_at" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableJoiningAt(t *time.Time) *EmployeeUpdateOne {
-	if t != nil {
-		euo.SetJoiningAt(*t)
+	if nodes := eu.mutation.RemovedStatusHistoriesIDs(); len(nodes) > 0 && !eu.mutation.StatusHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.StatusHistoriesTable,
+			Columns: []string{employee.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo
-}
-
-// SetOrgID sets the "org_id" field.
-func (euo *EmployeeUpdateOne) SetOrgID(i int) *EmployeeUpdateOne {
-	euo.mutation.ResetOrgID()
-	euo.mutation.SetOrgID(i)
-	return euo
-}
-
-// SetNillableOrgID sets the "org_id" field if the given value is not nil.
-func (euo *EmployeeUpdateOne) SetNillableOrgID(i *int) *EmployeeUpdateOne {
-	if i != nil {
-		euo.SetOrgID(*i)
+	if nodes := eu.mutation.StatusHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.StatusHistoriesTable,
+			Columns: []string{employee.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return euo
-}
-
-// AddOrgID adds i to the "org_id" field.
-func (euo *EmployeeUpdateOne) AddOrgID(i int) *EmployeeUpdateOne {
-	euo.mutation.AddOrgID(i)
-	return euo
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (euo *EmployeeUpdateOne) SetUpdatedAt(t time.Time) *EmployeeUpdateOne {
-	euo.mutation.SetUpdatedAt(t)
-	return euo
-}
-
-// SetPosition sets the "position" edge to the Position entity.
-func (euo *EmployeeUpdateOne) SetPosition(p *Position) *EmployeeUpdateOne {
-	return euo.SetPositionID(p.ID)
-}
-
-// AddCreatedProjectIDs adds the "created_projects" edge to the Project entity by IDs.
-func (euo *EmployeeUpdateOne) AddCreatedProjectIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.AddCreatedProjectIDs(ids...)
-	return euo
-}
-
-// AddCreatedProjects adds the "created_projects" edges to the Project entity.
-func (euo *EmployeeUpdateOne) AddCreatedProjects(p ...*Project) *EmployeeUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if eu.mutation.CompensationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CompensationsTable,
+			Columns: []string{employee.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.AddCreatedProjectIDs(ids...)
-}
-
-// AddUpdatedProjectIDs adds the "updated_projects" edge to the Project entity by IDs.
-func (euo *EmployeeUpdateOne) AddUpdatedProjectIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.AddUpdatedProjectIDs(ids...)
-	return euo
-}
-
-// AddUpdatedProjects adds the "updated_projects" edges to the Project entity.
-func (euo *EmployeeUpdateOne) AddUpdatedProjects(p ...*Project) *EmployeeUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := eu.mutation.RemovedCompensationsIDs(); len(nodes) > 0 && !eu.mutation.CompensationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CompensationsTable,
+			Columns: []string{employee.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.AddUpdatedProjectIDs(ids...)
-}
-
-// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
-func (euo *EmployeeUpdateOne) AddAssignedTaskIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.AddAssignedTaskIDs(ids...)
-	return euo
-}
-
-// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
-func (euo *EmployeeUpdateOne) AddAssignedTasks(t ...*Task) *EmployeeUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+	if nodes := eu.mutation.CompensationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CompensationsTable,
+			Columns: []string{employee.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return euo.AddAssignedTaskIDs(ids...)
-}
-
-// AddLeaveApprofeIDs adds the "leave_approves" edge to the LeaveApproval entity by IDs.
-func (euo *EmployeeUpdateOne) AddLeaveApprofeIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.AddLeaveApprofeIDs(ids...)
-	return euo
-}
-
-// AddLeaveApproves adds the "leave_approves" edges to the LeaveApproval entity.
-func (euo *EmployeeUpdateOne) AddLeaveApproves(l ...*LeaveApproval) *EmployeeUpdateOne {
-	ids := make([]int, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+	if eu.mutation.AttendanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceRecordsTable,
+			Columns: []string{employee.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.AddLeaveApprofeIDs(ids...)
-}
-
-// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
-func (euo *EmployeeUpdateOne) AddLeaveRequestIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.AddLeaveRequestIDs(ids...)
-	return euo
-}
-
-// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
-func (euo *EmployeeUpdateOne) AddLeaveRequests(l ...*LeaveRequest) *EmployeeUpdateOne {
-	ids := make([]int, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+	if nodes := eu.mutation.RemovedAttendanceRecordsIDs(); len(nodes) > 0 && !eu.mutation.AttendanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceRecordsTable,
+			Columns: []string{employee.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.AddLeaveRequestIDs(ids...)
-}
-
-// AddTaskReportIDs adds the "task_reports" edge to the TaskReport entity by IDs.
-func (euo *EmployeeUpdateOne) AddTaskReportIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.AddTaskReportIDs(ids...)
-	return euo
-}
-
-// AddTaskReports adds the "task_reports" edges to the TaskReport entity.
-func (euo *EmployeeUpdateOne) AddTaskReports(t ...*TaskReport) *EmployeeUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
-	}
-	return euo.AddTaskReportIDs(ids...)
-}
-
-// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
-func (euo *EmployeeUpdateOne) AddProjectIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.AddProjectIDs(ids...)
-	return euo
-}
-
-// AddProjects adds the "projects" edges to the Project entity.
-func (euo *EmployeeUpdateOne) AddProjects(p ...*Project) *EmployeeUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := eu.mutation.AttendanceRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceRecordsTable,
+			Columns: []string{employee.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return euo.AddProjectIDs(ids...)
-}
-
-// AddAppointmentHistoryIDs adds the "appointment_histories" edge to the AppointmentHistory entity by IDs.
-func (euo *EmployeeUpdateOne) AddAppointmentHistoryIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.AddAppointmentHistoryIDs(ids...)
-	return euo
-}
-
-// AddAppointmentHistories adds the "appointment_histories" edges to the AppointmentHistory entity.
-func (euo *EmployeeUpdateOne) AddAppointmentHistories(a ...*AppointmentHistory) *EmployeeUpdateOne {
-	ids := make([]int, len(a))
-	for i := range a {
-		ids[i] = a[i].ID
+	if eu.mutation.AttendanceCorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.AddAppointmentHistoryIDs(ids...)
-}
-
-// Mutation returns the EmployeeMutation object of the builder.
-func (euo *EmployeeUpdateOne) Mutation() *EmployeeMutation {
-	return euo.mutation
-}
-
-// ClearPosition clears the "position" edge to the Position entity.
-func (euo *EmployeeUpdateOne) ClearPosition() *EmployeeUpdateOne {
-	euo.mutation.ClearPosition()
-	return euo
-}
-
-// ClearCreatedProjects clears all "created_projects" edges to the Project entity.
-func (euo *EmployeeUpdateOne) ClearCreatedProjects() *EmployeeUpdateOne {
-	euo.mutation.ClearCreatedProjects()
-	return euo
-}
-
-// RemoveCreatedProjectIDs removes the "created_projects" edge to Project entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveCreatedProjectIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.RemoveCreatedProjectIDs(ids...)
-	return euo
-}
-
-// RemoveCreatedProjects removes "created_projects" edges to Project entities.
-func (euo *EmployeeUpdateOne) RemoveCreatedProjects(p ...*Project) *EmployeeUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := eu.mutation.RemovedAttendanceCorrectionsIDs(); len(nodes) > 0 && !eu.mutation.AttendanceCorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.RemoveCreatedProjectIDs(ids...)
-}
-
-// ClearUpdatedProjects clears all "updated_projects" edges to the Project entity.
-func (euo *EmployeeUpdateOne) ClearUpdatedProjects() *EmployeeUpdateOne {
-	euo.mutation.ClearUpdatedProjects()
-	return euo
-}
-
-// RemoveUpdatedProjectIDs removes the "updated_projects" edge to Project entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveUpdatedProjectIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.RemoveUpdatedProjectIDs(ids...)
-	return euo
-}
-
-// RemoveUpdatedProjects removes "updated_projects" edges to Project entities.
-func (euo *EmployeeUpdateOne) RemoveUpdatedProjects(p ...*Project) *EmployeeUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := eu.mutation.AttendanceCorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return euo.RemoveUpdatedProjectIDs(ids...)
-}
-
-// ClearAssignedTasks clears all "assigned_tasks" edges to the Task entity.
-func (euo *EmployeeUpdateOne) ClearAssignedTasks() *EmployeeUpdateOne {
-	euo.mutation.ClearAssignedTasks()
-	return euo
-}
-
-// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to Task entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveAssignedTaskIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.RemoveAssignedTaskIDs(ids...)
-	return euo
-}
-
-// RemoveAssignedTasks removes "assigned_tasks" edges to Task entities.
-func (euo *EmployeeUpdateOne) RemoveAssignedTasks(t ...*Task) *EmployeeUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+	if eu.mutation.RostersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.RostersTable,
+			Columns: []string{employee.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.RemoveAssignedTaskIDs(ids...)
-}
-
-// ClearLeaveApproves clears all "leave_approves" edges to the LeaveApproval entity.
-func (euo *EmployeeUpdateOne) ClearLeaveApproves() *EmployeeUpdateOne {
-	euo.mutation.ClearLeaveApproves()
-	return euo
-}
-
-// RemoveLeaveApprofeIDs removes the "leave_approves" edge to LeaveApproval entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveLeaveApprofeIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.RemoveLeaveApprofeIDs(ids...)
-	return euo
-}
-
-// RemoveLeaveApproves removes "leave_approves" edges to LeaveApproval entities.
-func (euo *EmployeeUpdateOne) RemoveLeaveApproves(l ...*LeaveApproval) *EmployeeUpdateOne {
-	ids := make([]int, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+	if nodes := eu.mutation.RemovedRostersIDs(); len(nodes) > 0 && !eu.mutation.RostersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.RostersTable,
+			Columns: []string{employee.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.RemoveLeaveApprofeIDs(ids...)
-}
-
-// ClearLeaveRequests clears all "leave_requests" edges to the LeaveRequest entity.
-func (euo *EmployeeUpdateOne) ClearLeaveRequests() *EmployeeUpdateOne {
-	euo.mutation.ClearLeaveRequests()
-	return euo
-}
-
-// RemoveLeaveRequestIDs removes the "leave_requests" edge to LeaveRequest entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveLeaveRequestIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.RemoveLeaveRequestIDs(ids...)
-	return euo
-}
-
-// RemoveLeaveRequests removes "leave_requests" edges to LeaveRequest entities.
-func (euo *EmployeeUpdateOne) RemoveLeaveRequests(l ...*LeaveRequest) *EmployeeUpdateOne {
-	ids := make([]int, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+	if nodes := eu.mutation.RostersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.RostersTable,
+			Columns: []string{employee.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return euo.RemoveLeaveRequestIDs(ids...)
-}
-
-// ClearTaskReports clears all "task_reports" edges to the TaskReport entity.
-func (euo *EmployeeUpdateOne) ClearTaskReports() *EmployeeUpdateOne {
-	euo.mutation.ClearTaskReports()
-	return euo
-}
-
-// RemoveTaskReportIDs removes the "task_reports" edge to TaskReport entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveTaskReportIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.RemoveTaskReportIDs(ids...)
-	return euo
-}
-
-// RemoveTaskReports removes "task_reports" edges to TaskReport entities.
-func (euo *EmployeeUpdateOne) RemoveTaskReports(t ...*TaskReport) *EmployeeUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+	if eu.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimeRequestsTable,
+			Columns: []string{employee.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.RemoveTaskReportIDs(ids...)
-}
-
-// ClearProjects clears all "projects" edges to the Project entity.
-func (euo *EmployeeUpdateOne) ClearProjects() *EmployeeUpdateOne {
-	euo.mutation.ClearProjects()
+	if nodes := eu.mutation.RemovedOvertimeRequestsIDs(); len(nodes) > 0 && !eu.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimeRequestsTable,
+			Columns: []string{employee.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.OvertimeRequestsTable,
+			Columns: []string{employee.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.TaskCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.TaskCommentsTable,
+			Columns: []string{employee.TaskCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedTaskCommentsIDs(); len(nodes) > 0 && !eu.mutation.TaskCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.TaskCommentsTable,
+			Columns: []string{employee.TaskCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.TaskCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.TaskCommentsTable,
+			Columns: []string{employee.TaskCommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.CommentMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.CommentMentionsTable,
+			Columns: employee.CommentMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedCommentMentionsIDs(); len(nodes) > 0 && !eu.mutation.CommentMentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.CommentMentionsTable,
+			Columns: employee.CommentMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.CommentMentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.CommentMentionsTable,
+			Columns: employee.CommentMentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.ReviewedTaskReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ReviewedTaskReportsTable,
+			Columns: []string{employee.ReviewedTaskReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedReviewedTaskReportsIDs(); len(nodes) > 0 && !eu.mutation.ReviewedTaskReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ReviewedTaskReportsTable,
+			Columns: []string{employee.ReviewedTaskReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ReviewedTaskReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ReviewedTaskReportsTable,
+			Columns: []string{employee.ReviewedTaskReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttachmentsTable,
+			Columns: []string{employee.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !eu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttachmentsTable,
+			Columns: []string{employee.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttachmentsTable,
+			Columns: []string{employee.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.WorkLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.WorkLogsTable,
+			Columns: []string{employee.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedWorkLogsIDs(); len(nodes) > 0 && !eu.mutation.WorkLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.WorkLogsTable,
+			Columns: []string{employee.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.WorkLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.WorkLogsTable,
+			Columns: []string{employee.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// EmployeeUpdateOne is the builder for updating a single Employee entity.
+type EmployeeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmployeeMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (euo *EmployeeUpdateOne) SetUserID(s string) *EmployeeUpdateOne {
+	euo.mutation.SetUserID(s)
 	return euo
 }
 
-// RemoveProjectIDs removes the "projects" edge to Project entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveProjectIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.RemoveProjectIDs(ids...)
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableUserID(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetUserID(*s)
+	}
 	return euo
 }
 
-// RemoveProjects removes "projects" edges to Project entities.
-func (euo *EmployeeUpdateOne) RemoveProjects(p ...*Project) *EmployeeUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+// ClearUserID clears the value of the "user_id" field.
+func (euo *EmployeeUpdateOne) ClearUserID() *EmployeeUpdateOne {
+	euo.mutation.ClearUserID()
+	return euo
+}
+
+// SetCode sets the "code" field.
+func (euo *EmployeeUpdateOne) SetCode(s string) *EmployeeUpdateOne {
+	euo.mutation.SetCode(s)
+	return euo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableCode(s *string) *EmployeeUpdateOne {
+	if s != nil {
+		euo.SetCode(*s)
+	}
+	return euo
+}
+
+// SetStatus sets the "status" field.
+func (euo *EmployeeUpdateOne) SetStatus(e employee.Status) *EmployeeUpdateOne {
+	euo.mutation.SetStatus(e)
+	return euo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableStatus(e *employee.Status) *EmployeeUpdateOne {
+	if e != nil {
+		euo.SetStatus(*e)
+	}
+	return euo
+}
+
+// SetPositionID sets the "position_id" field.
+func (euo *EmployeeUpdateOne) SetPositionID(i int) *EmployeeUpdateOne {
+	euo.mutation.SetPositionID(i)
+	return euo
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillablePositionID(i *int) *EmployeeUpdateOne {
+	if i != nil {
+		euo.SetPositionID(*i)
+	}
+	return euo
+}
+
+// SetJoiningAt sets the "joining_at" field.
+func (euo *EmployeeUpdateOne) SetJoiningAt(t time.Time) *EmployeeUpdateOne {
+	euo.mutation.SetJoiningAt(t)
+	return euo
+}
+
+// SetNillableJoiningAt sets the "joining_at" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableJoiningAt(t *time.Time) *EmployeeUpdateOne {
+	if t != nil {
+		euo.SetJoiningAt(*t)
+	}
+	return euo
+}
+
+// SetOrgID sets the "org_id" field.
+func (euo *EmployeeUpdateOne) SetOrgID(i int) *EmployeeUpdateOne {
+	euo.mutation.ResetOrgID()
+	euo.mutation.SetOrgID(i)
+	return euo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (euo *EmployeeUpdateOne) SetNillableOrgID(i *int) *EmployeeUpdateOne {
+	if i != nil {
+		euo.SetOrgID(*i)
+	}
+	return euo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (euo *EmployeeUpdateOne) AddOrgID(i int) *EmployeeUpdateOne {
+	euo.mutation.AddOrgID(i)
+	return euo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (euo *EmployeeUpdateOne) SetUpdatedAt(t time.Time) *EmployeeUpdateOne {
+	euo.mutation.SetUpdatedAt(t)
+	return euo
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (euo *EmployeeUpdateOne) SetPosition(p *Position) *EmployeeUpdateOne {
+	return euo.SetPositionID(p.ID)
+}
+
+// AddCreatedProjectIDs adds the "created_projects" edge to the Project entity by IDs.
+func (euo *EmployeeUpdateOne) AddCreatedProjectIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddCreatedProjectIDs(ids...)
+	return euo
+}
+
+// AddCreatedProjects adds the "created_projects" edges to the Project entity.
+func (euo *EmployeeUpdateOne) AddCreatedProjects(p ...*Project) *EmployeeUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return euo.AddCreatedProjectIDs(ids...)
+}
+
+// AddUpdatedProjectIDs adds the "updated_projects" edge to the Project entity by IDs.
+func (euo *EmployeeUpdateOne) AddUpdatedProjectIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddUpdatedProjectIDs(ids...)
+	return euo
+}
+
+// AddUpdatedProjects adds the "updated_projects" edges to the Project entity.
+func (euo *EmployeeUpdateOne) AddUpdatedProjects(p ...*Project) *EmployeeUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return euo.AddUpdatedProjectIDs(ids...)
+}
+
+// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
+func (euo *EmployeeUpdateOne) AddAssignedTaskIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddAssignedTaskIDs(ids...)
+	return euo
+}
+
+// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
+func (euo *EmployeeUpdateOne) AddAssignedTasks(t ...*Task) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.AddAssignedTaskIDs(ids...)
+}
+
+// AddLeaveApprofeIDs adds the "leave_approves" edge to the LeaveApproval entity by IDs.
+func (euo *EmployeeUpdateOne) AddLeaveApprofeIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddLeaveApprofeIDs(ids...)
+	return euo
+}
+
+// AddLeaveApproves adds the "leave_approves" edges to the LeaveApproval entity.
+func (euo *EmployeeUpdateOne) AddLeaveApproves(l ...*LeaveApproval) *EmployeeUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return euo.AddLeaveApprofeIDs(ids...)
+}
+
+// AddLeaveRequestIDs adds the "leave_requests" edge to the LeaveRequest entity by IDs.
+func (euo *EmployeeUpdateOne) AddLeaveRequestIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddLeaveRequestIDs(ids...)
+	return euo
+}
+
+// AddLeaveRequests adds the "leave_requests" edges to the LeaveRequest entity.
+func (euo *EmployeeUpdateOne) AddLeaveRequests(l ...*LeaveRequest) *EmployeeUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return euo.AddLeaveRequestIDs(ids...)
+}
+
+// AddTaskReportIDs adds the "task_reports" edge to the TaskReport entity by IDs.
+func (euo *EmployeeUpdateOne) AddTaskReportIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddTaskReportIDs(ids...)
+	return euo
+}
+
+// AddTaskReports adds the "task_reports" edges to the TaskReport entity.
+func (euo *EmployeeUpdateOne) AddTaskReports(t ...*TaskReport) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.AddTaskReportIDs(ids...)
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by IDs.
+func (euo *EmployeeUpdateOne) AddProjectIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddProjectIDs(ids...)
+	return euo
+}
+
+// AddProjects adds the "projects" edges to the Project entity.
+func (euo *EmployeeUpdateOne) AddProjects(p ...*Project) *EmployeeUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return euo.AddProjectIDs(ids...)
+}
+
+// AddAppointmentHistoryIDs adds the "appointment_histories" edge to the AppointmentHistory entity by IDs.
+func (euo *EmployeeUpdateOne) AddAppointmentHistoryIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddAppointmentHistoryIDs(ids...)
+	return euo
+}
+
+// AddAppointmentHistories adds the "appointment_histories" edges to the AppointmentHistory entity.
+func (euo *EmployeeUpdateOne) AddAppointmentHistories(a ...*AppointmentHistory) *EmployeeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.AddAppointmentHistoryIDs(ids...)
+}
+
+// AddContractIDs adds the "contracts" edge to the EmployeeContract entity by IDs.
+func (euo *EmployeeUpdateOne) AddContractIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddContractIDs(ids...)
+	return euo
+}
+
+// AddContracts adds the "contracts" edges to the EmployeeContract entity.
+func (euo *EmployeeUpdateOne) AddContracts(e ...*EmployeeContract) *EmployeeUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.AddContractIDs(ids...)
+}
+
+// AddStatusHistoryIDs adds the "status_histories" edge to the EmployeeStatusHistory entity by IDs.
+func (euo *EmployeeUpdateOne) AddStatusHistoryIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddStatusHistoryIDs(ids...)
+	return euo
+}
+
+// AddStatusHistories adds the "status_histories" edges to the EmployeeStatusHistory entity.
+func (euo *EmployeeUpdateOne) AddStatusHistories(e ...*EmployeeStatusHistory) *EmployeeUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.AddStatusHistoryIDs(ids...)
+}
+
+// AddCompensationIDs adds the "compensations" edge to the Compensation entity by IDs.
+func (euo *EmployeeUpdateOne) AddCompensationIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddCompensationIDs(ids...)
+	return euo
+}
+
+// AddCompensations adds the "compensations" edges to the Compensation entity.
+func (euo *EmployeeUpdateOne) AddCompensations(c ...*Compensation) *EmployeeUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.AddCompensationIDs(ids...)
+}
+
+// AddAttendanceRecordIDs adds the "attendance_records" edge to the AttendanceRecord entity by IDs.
+func (euo *EmployeeUpdateOne) AddAttendanceRecordIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddAttendanceRecordIDs(ids...)
+	return euo
+}
+
+// AddAttendanceRecords adds the "attendance_records" edges to the AttendanceRecord entity.
+func (euo *EmployeeUpdateOne) AddAttendanceRecords(a ...*AttendanceRecord) *EmployeeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.AddAttendanceRecordIDs(ids...)
+}
+
+// AddAttendanceCorrectionIDs adds the "attendance_corrections" edge to the AttendanceCorrection entity by IDs.
+func (euo *EmployeeUpdateOne) AddAttendanceCorrectionIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddAttendanceCorrectionIDs(ids...)
+	return euo
+}
+
+// AddAttendanceCorrections adds the "attendance_corrections" edges to the AttendanceCorrection entity.
+func (euo *EmployeeUpdateOne) AddAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.AddAttendanceCorrectionIDs(ids...)
+}
+
+// AddRosterIDs adds the "rosters" edge to the Roster entity by IDs.
+func (euo *EmployeeUpdateOne) AddRosterIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddRosterIDs(ids...)
+	return euo
+}
+
+// AddRosters adds the "rosters" edges to the Roster entity.
+func (euo *EmployeeUpdateOne) AddRosters(r ...*Roster) *EmployeeUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddRosterIDs(ids...)
+}
+
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (euo *EmployeeUpdateOne) AddOvertimeRequestIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddOvertimeRequestIDs(ids...)
+	return euo
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (euo *EmployeeUpdateOne) AddOvertimeRequests(o ...*OvertimeRequest) *EmployeeUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return euo.AddOvertimeRequestIDs(ids...)
+}
+
+// AddTaskCommentIDs adds the "task_comments" edge to the TaskComment entity by IDs.
+func (euo *EmployeeUpdateOne) AddTaskCommentIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddTaskCommentIDs(ids...)
+	return euo
+}
+
+// AddTaskComments adds the "task_comments" edges to the TaskComment entity.
+func (euo *EmployeeUpdateOne) AddTaskComments(t ...*TaskComment) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.AddTaskCommentIDs(ids...)
+}
+
+// AddCommentMentionIDs adds the "comment_mentions" edge to the TaskComment entity by IDs.
+func (euo *EmployeeUpdateOne) AddCommentMentionIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddCommentMentionIDs(ids...)
+	return euo
+}
+
+// AddCommentMentions adds the "comment_mentions" edges to the TaskComment entity.
+func (euo *EmployeeUpdateOne) AddCommentMentions(t ...*TaskComment) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.AddCommentMentionIDs(ids...)
+}
+
+// AddReviewedTaskReportIDs adds the "reviewed_task_reports" edge to the TaskReport entity by IDs.
+func (euo *EmployeeUpdateOne) AddReviewedTaskReportIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddReviewedTaskReportIDs(ids...)
+	return euo
+}
+
+// AddReviewedTaskReports adds the "reviewed_task_reports" edges to the TaskReport entity.
+func (euo *EmployeeUpdateOne) AddReviewedTaskReports(t ...*TaskReport) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.AddReviewedTaskReportIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (euo *EmployeeUpdateOne) AddAttachmentIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddAttachmentIDs(ids...)
+	return euo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (euo *EmployeeUpdateOne) AddAttachments(a ...*Attachment) *EmployeeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.AddAttachmentIDs(ids...)
+}
+
+// AddWorkLogIDs adds the "work_logs" edge to the WorkLog entity by IDs.
+func (euo *EmployeeUpdateOne) AddWorkLogIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.AddWorkLogIDs(ids...)
+	return euo
+}
+
+// AddWorkLogs adds the "work_logs" edges to the WorkLog entity.
+func (euo *EmployeeUpdateOne) AddWorkLogs(w ...*WorkLog) *EmployeeUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return euo.AddWorkLogIDs(ids...)
+}
+
+// Mutation returns the EmployeeMutation object of the builder.
+func (euo *EmployeeUpdateOne) Mutation() *EmployeeMutation {
+	return euo.mutation
+}
+
+// ClearPosition clears the "position" edge to the Position entity.
+func (euo *EmployeeUpdateOne) ClearPosition() *EmployeeUpdateOne {
+	euo.mutation.ClearPosition()
+	return euo
+}
+
+// ClearCreatedProjects clears all "created_projects" edges to the Project entity.
+func (euo *EmployeeUpdateOne) ClearCreatedProjects() *EmployeeUpdateOne {
+	euo.mutation.ClearCreatedProjects()
+	return euo
+}
+
+// RemoveCreatedProjectIDs removes the "created_projects" edge to Project entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveCreatedProjectIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveCreatedProjectIDs(ids...)
+	return euo
+}
+
+// RemoveCreatedProjects removes "created_projects" edges to Project entities.
+func (euo *EmployeeUpdateOne) RemoveCreatedProjects(p ...*Project) *EmployeeUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return euo.RemoveCreatedProjectIDs(ids...)
+}
+
+// ClearUpdatedProjects clears all "updated_projects" edges to the Project entity.
+func (euo *EmployeeUpdateOne) ClearUpdatedProjects() *EmployeeUpdateOne {
+	euo.mutation.ClearUpdatedProjects()
+	return euo
+}
+
+// RemoveUpdatedProjectIDs removes the "updated_projects" edge to Project entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveUpdatedProjectIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveUpdatedProjectIDs(ids...)
+	return euo
+}
+
+// RemoveUpdatedProjects removes "updated_projects" edges to Project entities.
+func (euo *EmployeeUpdateOne) RemoveUpdatedProjects(p ...*Project) *EmployeeUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return euo.RemoveUpdatedProjectIDs(ids...)
+}
+
+// ClearAssignedTasks clears all "assigned_tasks" edges to the Task entity.
+func (euo *EmployeeUpdateOne) ClearAssignedTasks() *EmployeeUpdateOne {
+	euo.mutation.ClearAssignedTasks()
+	return euo
+}
+
+// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to Task entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveAssignedTaskIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveAssignedTaskIDs(ids...)
+	return euo
+}
+
+// RemoveAssignedTasks removes "assigned_tasks" edges to Task entities.
+func (euo *EmployeeUpdateOne) RemoveAssignedTasks(t ...*Task) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.RemoveAssignedTaskIDs(ids...)
+}
+
+// ClearLeaveApproves clears all "leave_approves" edges to the LeaveApproval entity.
+func (euo *EmployeeUpdateOne) ClearLeaveApproves() *EmployeeUpdateOne {
+	euo.mutation.ClearLeaveApproves()
+	return euo
+}
+
+// RemoveLeaveApprofeIDs removes the "leave_approves" edge to LeaveApproval entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveLeaveApprofeIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveLeaveApprofeIDs(ids...)
+	return euo
+}
+
+// RemoveLeaveApproves removes "leave_approves" edges to LeaveApproval entities.
+func (euo *EmployeeUpdateOne) RemoveLeaveApproves(l ...*LeaveApproval) *EmployeeUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return euo.RemoveLeaveApprofeIDs(ids...)
+}
+
+// ClearLeaveRequests clears all "leave_requests" edges to the LeaveRequest entity.
+func (euo *EmployeeUpdateOne) ClearLeaveRequests() *EmployeeUpdateOne {
+	euo.mutation.ClearLeaveRequests()
+	return euo
+}
+
+// RemoveLeaveRequestIDs removes the "leave_requests" edge to LeaveRequest entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveLeaveRequestIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveLeaveRequestIDs(ids...)
+	return euo
+}
+
+// RemoveLeaveRequests removes "leave_requests" edges to LeaveRequest entities.
+func (euo *EmployeeUpdateOne) RemoveLeaveRequests(l ...*LeaveRequest) *EmployeeUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return euo.RemoveLeaveRequestIDs(ids...)
+}
+
+// ClearTaskReports clears all "task_reports" edges to the TaskReport entity.
+func (euo *EmployeeUpdateOne) ClearTaskReports() *EmployeeUpdateOne {
+	euo.mutation.ClearTaskReports()
+	return euo
+}
+
+// RemoveTaskReportIDs removes the "task_reports" edge to TaskReport entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveTaskReportIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveTaskReportIDs(ids...)
+	return euo
+}
+
+// RemoveTaskReports removes "task_reports" edges to TaskReport entities.
+func (euo *EmployeeUpdateOne) RemoveTaskReports(t ...*TaskReport) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.RemoveTaskReportIDs(ids...)
+}
+
+// ClearProjects clears all "projects" edges to the Project entity.
+func (euo *EmployeeUpdateOne) ClearProjects() *EmployeeUpdateOne {
+	euo.mutation.ClearProjects()
+	return euo
+}
+
+// RemoveProjectIDs removes the "projects" edge to Project entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveProjectIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveProjectIDs(ids...)
+	return euo
+}
+
+// RemoveProjects removes "projects" edges to Project entities.
+func (euo *EmployeeUpdateOne) RemoveProjects(p ...*Project) *EmployeeUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return euo.RemoveProjectIDs(ids...)
+}
+
+// ClearAppointmentHistories clears all "appointment_histories" edges to the AppointmentHistory entity.
+func (euo *EmployeeUpdateOne) ClearAppointmentHistories() *EmployeeUpdateOne {
+	euo.mutation.ClearAppointmentHistories()
+	return euo
+}
+
+// RemoveAppointmentHistoryIDs removes the "appointment_histories" edge to AppointmentHistory entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveAppointmentHistoryIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveAppointmentHistoryIDs(ids...)
+	return euo
+}
+
+// RemoveAppointmentHistories removes "appointment_histories" edges to AppointmentHistory entities.
+func (euo *EmployeeUpdateOne) RemoveAppointmentHistories(a ...*AppointmentHistory) *EmployeeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.RemoveAppointmentHistoryIDs(ids...)
+}
+
+// ClearContracts clears all "contracts" edges to the EmployeeContract entity.
+func (euo *EmployeeUpdateOne) ClearContracts() *EmployeeUpdateOne {
+	euo.mutation.ClearContracts()
+	return euo
+}
+
+// RemoveContractIDs removes the "contracts" edge to EmployeeContract entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveContractIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveContractIDs(ids...)
+	return euo
+}
+
+// RemoveContracts removes "contracts" edges to EmployeeContract entities.
+func (euo *EmployeeUpdateOne) RemoveContracts(e ...*EmployeeContract) *EmployeeUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.RemoveContractIDs(ids...)
+}
+
+// ClearStatusHistories clears all "status_histories" edges to the EmployeeStatusHistory entity.
+func (euo *EmployeeUpdateOne) ClearStatusHistories() *EmployeeUpdateOne {
+	euo.mutation.ClearStatusHistories()
+	return euo
+}
+
+// RemoveStatusHistoryIDs removes the "status_histories" edge to EmployeeStatusHistory entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveStatusHistoryIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveStatusHistoryIDs(ids...)
+	return euo
+}
+
+// RemoveStatusHistories removes "status_histories" edges to EmployeeStatusHistory entities.
+func (euo *EmployeeUpdateOne) RemoveStatusHistories(e ...*EmployeeStatusHistory) *EmployeeUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.RemoveStatusHistoryIDs(ids...)
+}
+
+// ClearCompensations clears all "compensations" edges to the Compensation entity.
+func (euo *EmployeeUpdateOne) ClearCompensations() *EmployeeUpdateOne {
+	euo.mutation.ClearCompensations()
+	return euo
+}
+
+// RemoveCompensationIDs removes the "compensations" edge to Compensation entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveCompensationIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveCompensationIDs(ids...)
+	return euo
+}
+
+// RemoveCompensations removes "compensations" edges to Compensation entities.
+func (euo *EmployeeUpdateOne) RemoveCompensations(c ...*Compensation) *EmployeeUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return euo.RemoveCompensationIDs(ids...)
+}
+
+// ClearAttendanceRecords clears all "attendance_records" edges to the AttendanceRecord entity.
+func (euo *EmployeeUpdateOne) ClearAttendanceRecords() *EmployeeUpdateOne {
+	euo.mutation.ClearAttendanceRecords()
+	return euo
+}
+
+// RemoveAttendanceRecordIDs removes the "attendance_records" edge to AttendanceRecord entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveAttendanceRecordIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveAttendanceRecordIDs(ids...)
+	return euo
+}
+
+// RemoveAttendanceRecords removes "attendance_records" edges to AttendanceRecord entities.
+func (euo *EmployeeUpdateOne) RemoveAttendanceRecords(a ...*AttendanceRecord) *EmployeeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.RemoveAttendanceRecordIDs(ids...)
+}
+
+// ClearAttendanceCorrections clears all "attendance_corrections" edges to the AttendanceCorrection entity.
+func (euo *EmployeeUpdateOne) ClearAttendanceCorrections() *EmployeeUpdateOne {
+	euo.mutation.ClearAttendanceCorrections()
+	return euo
+}
+
+// RemoveAttendanceCorrectionIDs removes the "attendance_corrections" edge to AttendanceCorrection entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveAttendanceCorrectionIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveAttendanceCorrectionIDs(ids...)
+	return euo
+}
+
+// RemoveAttendanceCorrections removes "attendance_corrections" edges to AttendanceCorrection entities.
+func (euo *EmployeeUpdateOne) RemoveAttendanceCorrections(a ...*AttendanceCorrection) *EmployeeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.RemoveAttendanceCorrectionIDs(ids...)
+}
+
+// ClearRosters clears all "rosters" edges to the Roster entity.
+func (euo *EmployeeUpdateOne) ClearRosters() *EmployeeUpdateOne {
+	euo.mutation.ClearRosters()
+	return euo
+}
+
+// RemoveRosterIDs removes the "rosters" edge to Roster entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveRosterIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveRosterIDs(ids...)
+	return euo
+}
+
+// RemoveRosters removes "rosters" edges to Roster entities.
+func (euo *EmployeeUpdateOne) RemoveRosters(r ...*Roster) *EmployeeUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveRosterIDs(ids...)
+}
+
+// ClearOvertimeRequests clears all "overtime_requests" edges to the OvertimeRequest entity.
+func (euo *EmployeeUpdateOne) ClearOvertimeRequests() *EmployeeUpdateOne {
+	euo.mutation.ClearOvertimeRequests()
+	return euo
+}
+
+// RemoveOvertimeRequestIDs removes the "overtime_requests" edge to OvertimeRequest entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveOvertimeRequestIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveOvertimeRequestIDs(ids...)
+	return euo
+}
+
+// RemoveOvertimeRequests removes "overtime_requests" edges to OvertimeRequest entities.
+func (euo *EmployeeUpdateOne) RemoveOvertimeRequests(o ...*OvertimeRequest) *EmployeeUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return euo.RemoveOvertimeRequestIDs(ids...)
+}
+
+// ClearTaskComments clears all "task_comments" edges to the TaskComment entity.
+func (euo *EmployeeUpdateOne) ClearTaskComments() *EmployeeUpdateOne {
+	euo.mutation.ClearTaskComments()
+	return euo
+}
+
+// RemoveTaskCommentIDs removes the "task_comments" edge to TaskComment entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveTaskCommentIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveTaskCommentIDs(ids...)
+	return euo
+}
+
+// RemoveTaskComments removes "task_comments" edges to TaskComment entities.
+func (euo *EmployeeUpdateOne) RemoveTaskComments(t ...*TaskComment) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.RemoveTaskCommentIDs(ids...)
+}
+
+// ClearCommentMentions clears all "comment_mentions" edges to the TaskComment entity.
+func (euo *EmployeeUpdateOne) ClearCommentMentions() *EmployeeUpdateOne {
+	euo.mutation.ClearCommentMentions()
+	return euo
+}
+
+// RemoveCommentMentionIDs removes the "comment_mentions" edge to TaskComment entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveCommentMentionIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveCommentMentionIDs(ids...)
+	return euo
+}
+
+// RemoveCommentMentions removes "comment_mentions" edges to TaskComment entities.
+func (euo *EmployeeUpdateOne) RemoveCommentMentions(t ...*TaskComment) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.RemoveCommentMentionIDs(ids...)
+}
+
+// ClearReviewedTaskReports clears all "reviewed_task_reports" edges to the TaskReport entity.
+func (euo *EmployeeUpdateOne) ClearReviewedTaskReports() *EmployeeUpdateOne {
+	euo.mutation.ClearReviewedTaskReports()
+	return euo
+}
+
+// RemoveReviewedTaskReportIDs removes the "reviewed_task_reports" edge to TaskReport entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveReviewedTaskReportIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveReviewedTaskReportIDs(ids...)
+	return euo
+}
+
+// RemoveReviewedTaskReports removes "reviewed_task_reports" edges to TaskReport entities.
+func (euo *EmployeeUpdateOne) RemoveReviewedTaskReports(t ...*TaskReport) *EmployeeUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.RemoveReviewedTaskReportIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (euo *EmployeeUpdateOne) ClearAttachments() *EmployeeUpdateOne {
+	euo.mutation.ClearAttachments()
+	return euo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveAttachmentIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveAttachmentIDs(ids...)
+	return euo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (euo *EmployeeUpdateOne) RemoveAttachments(a ...*Attachment) *EmployeeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return euo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearWorkLogs clears all "work_logs" edges to the WorkLog entity.
+func (euo *EmployeeUpdateOne) ClearWorkLogs() *EmployeeUpdateOne {
+	euo.mutation.ClearWorkLogs()
+	return euo
+}
+
+// RemoveWorkLogIDs removes the "work_logs" edge to WorkLog entities by IDs.
+func (euo *EmployeeUpdateOne) RemoveWorkLogIDs(ids ...int) *EmployeeUpdateOne {
+	euo.mutation.RemoveWorkLogIDs(ids...)
+	return euo
+}
+
+// RemoveWorkLogs removes "work_logs" edges to WorkLog entities.
+func (euo *EmployeeUpdateOne) RemoveWorkLogs(w ...*WorkLog) *EmployeeUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return euo.RemoveWorkLogIDs(ids...)
+}
+
+// Where appends a list predicates to the EmployeeUpdate builder.
+func (euo *EmployeeUpdateOne) Where(ps ...predicate.Employee) *EmployeeUpdateOne {
+	euo.mutation.Where(ps...)
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EmployeeUpdateOne) Select(field string, fields ...string) *EmployeeUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Employee entity.
+func (euo *EmployeeUpdateOne) Save(ctx context.Context) (*Employee, error) {
+	euo.defaults()
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EmployeeUpdateOne) SaveX(ctx context.Context) *Employee {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EmployeeUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EmployeeUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (euo *EmployeeUpdateOne) defaults() {
+	if _, ok := euo.mutation.UpdatedAt(); !ok {
+		v := employee.UpdateDefaultUpdatedAt()
+		euo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *EmployeeUpdateOne) check() error {
+	if v, ok := euo.mutation.Code(); ok {
+		if err := employee.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Employee.code": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Status(); ok {
+		if err := employee.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Employee.status": %w`, err)}
+		}
+	}
+	if euo.mutation.PositionCleared() && len(euo.mutation.PositionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Employee.position"`)
+	}
+	return nil
+}
+
+func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err error) {
+	if err := euo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(employee.Table, employee.Columns, sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Employee.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, employee.FieldID)
+		for _, f := range fields {
+			if !employee.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != employee.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.UserID(); ok {
+		_spec.SetField(employee.FieldUserID, field.TypeString, value)
+	}
+	if euo.mutation.UserIDCleared() {
+		_spec.ClearField(employee.FieldUserID, field.TypeString)
+	}
+	if value, ok := euo.mutation.Code(); ok {
+		_spec.SetField(employee.FieldCode, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Status(); ok {
+		_spec.SetField(employee.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := euo.mutation.JoiningAt(); ok {
+		_spec.SetField(employee.FieldJoiningAt, field.TypeTime, value)
+	}
+	if value, ok := euo.mutation.OrgID(); ok {
+		_spec.SetField(employee.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedOrgID(); ok {
+		_spec.AddField(employee.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.UpdatedAt(); ok {
+		_spec.SetField(employee.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if euo.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employee.PositionTable,
+			Columns: []string{employee.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employee.PositionTable,
+			Columns: []string{employee.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.CreatedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CreatedProjectsTable,
+			Columns: []string{employee.CreatedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedCreatedProjectsIDs(); len(nodes) > 0 && !euo.mutation.CreatedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CreatedProjectsTable,
+			Columns: []string{employee.CreatedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.CreatedProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.CreatedProjectsTable,
+			Columns: []string{employee.CreatedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.UpdatedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.UpdatedProjectsTable,
+			Columns: []string{employee.UpdatedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedUpdatedProjectsIDs(); len(nodes) > 0 && !euo.mutation.UpdatedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.UpdatedProjectsTable,
+			Columns: []string{employee.UpdatedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.UpdatedProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.UpdatedProjectsTable,
+			Columns: []string{employee.UpdatedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.AssignedTasksTable,
+			Columns: employee.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedAssignedTasksIDs(); len(nodes) > 0 && !euo.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.AssignedTasksTable,
+			Columns: employee.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.AssignedTasksTable,
+			Columns: employee.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.LeaveApprovesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveApprovesTable,
+			Columns: []string{employee.LeaveApprovesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaveapproval.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedLeaveApprovesIDs(); len(nodes) > 0 && !euo.mutation.LeaveApprovesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveApprovesTable,
+			Columns: []string{employee.LeaveApprovesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaveapproval.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.LeaveApprovesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveApprovesTable,
+			Columns: []string{employee.LeaveApprovesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaveapproval.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.RemoveProjectIDs(ids...)
-}
-
-// ClearAppointmentHistories clears all "appointment_histories" edges to the AppointmentHistory entity.
-func (euo *EmployeeUpdateOne) ClearAppointmentHistories() *EmployeeUpdateOne {
-	euo.mutation.ClearAppointmentHistories()
-	return euo
-}
-
-// RemoveAppointmentHistoryIDs removes the "appointment_histories" edge to AppointmentHistory entities by IDs.
-func (euo *EmployeeUpdateOne) RemoveAppointmentHistoryIDs(ids ...int) *EmployeeUpdateOne {
-	euo.mutation.RemoveAppointmentHistoryIDs(ids...)
-	return euo
-}
-
-// RemoveAppointmentHistories removes "appointment_histories" edges to AppointmentHistory entities.
-func (euo *EmployeeUpdateOne) RemoveAppointmentHistories(a ...*AppointmentHistory) *EmployeeUpdateOne {
-	ids := make([]int, len(a))
-	for i := range a {
-		ids[i] = a[i].ID
+	if nodes := euo.mutation.RemovedLeaveRequestsIDs(); len(nodes) > 0 && !euo.mutation.LeaveRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return euo.RemoveAppointmentHistoryIDs(ids...)
-}
-
-// Where appends a list predicates to the EmployeeUpdate builder.
-func (euo *EmployeeUpdateOne) Where(ps ...predicate.Employee) *EmployeeUpdateOne {
-	euo.mutation.Where(ps...)
-	return euo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (euo *EmployeeUpdateOne) Select(field string, fields ...string) *EmployeeUpdateOne {
-	euo.fields = append([]string{field}, fields...)
-	return euo
-}
-
-// Save executes the query and returns the updated Employee entity.
-func (euo *EmployeeUpdateOne) Save(ctx context.Context) (*Employee, error) {
-	euo.defaults()
-	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (euo *EmployeeUpdateOne) SaveX(ctx context.Context) *Employee {
-	node, err := euo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := euo.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LeaveRequestsTable,
+			Columns: []string{employee.LeaveRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (euo *EmployeeUpdateOne) Exec(ctx context.Context) error {
-	_, err := euo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (euo *EmployeeUpdateOne) ExecX(ctx context.Context) {
-	if err := euo.Exec(ctx); err != nil {
-		panic(err)
+	if euo.mutation.TaskReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.TaskReportsTable,
+			Columns: []string{employee.TaskReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (euo *EmployeeUpdateOne) defaults() {
-	if _, ok := euo.mutation.UpdatedAt(); !ok {
-		v := employee.UpdateDefaultUpdatedAt()
-		euo.mutation.SetUpdatedAt(v)
+	if nodes := euo.mutation.RemovedTaskReportsIDs(); len(nodes) > 0 && !euo.mutation.TaskReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.TaskReportsTable,
+			Columns: []string{employee.TaskReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (euo *EmployeeUpdateOne) check() error {
-	if v, ok := euo.mutation.Code(); ok {
-		if err := employee.CodeValidator(v); err != nil {
-			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Employee.code": %w`, err)}
+	if nodes := euo.mutation.TaskReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.TaskReportsTable,
+			Columns: []string{employee.TaskReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := euo.mutation.Status(); ok {
-		if err := employee.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Employee.status": %w`, err)}
+	if euo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.ProjectsTable,
+			Columns: employee.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if euo.mutation.PositionCleared() && len(euo.mutation.PositionIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Employee.position"`)
+	if nodes := euo.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !euo.mutation.ProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.ProjectsTable,
+			Columns: employee.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err error) {
-	if err := euo.check(); err != nil {
-		return _node, err
+	if nodes := euo.mutation.ProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   employee.ProjectsTable,
+			Columns: employee.ProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(employee.Table, employee.Columns, sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt))
-	id, ok := euo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Employee.id" for update`)}
+	if euo.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AppointmentHistoriesTable,
+			Columns: []string{employee.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := euo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, employee.FieldID)
-		for _, f := range fields {
-			if !employee.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != employee.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := euo.mutation.RemovedAppointmentHistoriesIDs(); len(nodes) > 0 && !euo.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AppointmentHistoriesTable,
+			Columns: []string{employee.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := euo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := euo.mutation.AppointmentHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AppointmentHistoriesTable,
+			Columns: []string{employee.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := euo.mutation.UserID(); ok {
-		_spec.SetField(employee.FieldUserID, field.TypeString, value)
-	}
-	if euo.mutation.UserIDCleared() {
-		_spec.ClearField(employee.FieldUserID, field.TypeString)
-	}
-	if value, ok := euo.mutation.Code(); ok {
-		_spec.SetField(employee.FieldCode, field.TypeString, value)
-	}
-	if value, ok := euo.mutation.Status(); ok {
-		_spec.SetField(employee.FieldStatus, field.TypeEnum, value)
-	}
-	if value, ok := euo.mutation.JoiningAt(); ok {
-		_spec.SetField(employee.FieldJoiningAt, field.TypeTime, value)
+	if euo.mutation.ContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ContractsTable,
+			Columns: []string{employee.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := euo.mutation.OrgID(); ok {
-		_spec.SetField(employee.FieldOrgID, field.TypeInt, value)
+	if nodes := euo.mutation.RemovedContractsIDs(); len(nodes) > 0 && !euo.mutation.ContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ContractsTable,
+			Columns: []string{employee.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := euo.mutation.AddedOrgID(); ok {
-		_spec.AddField(employee.FieldOrgID, field.TypeInt, value)
+	if nodes := euo.mutation.ContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.ContractsTable,
+			Columns: []string{employee.ContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := euo.mutation.UpdatedAt(); ok {
-		_spec.SetField(employee.FieldUpdatedAt, field.TypeTime, value)
+	if euo.mutation.StatusHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.StatusHistoriesTable,
+			Columns: []string{employee.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if euo.mutation.PositionCleared() {
+	if nodes := euo.mutation.RemovedStatusHistoriesIDs(); len(nodes) > 0 && !euo.mutation.StatusHistoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   employee.PositionTable,
-			Columns: []string{employee.PositionColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.StatusHistoriesTable,
+			Columns: []string{employee.StatusHistoriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.PositionIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.StatusHistoriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   employee.PositionTable,
-			Columns: []string{employee.PositionColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.StatusHistoriesTable,
+			Columns: []string{employee.StatusHistoriesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1497,28 +3361,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.CreatedProjectsCleared() {
+	if euo.mutation.CompensationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.CreatedProjectsTable,
-			Columns: []string{employee.CreatedProjectsColumn},
+			Table:   employee.CompensationsTable,
+			Columns: []string{employee.CompensationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedCreatedProjectsIDs(); len(nodes) > 0 && !euo.mutation.CreatedProjectsCleared() {
+	if nodes := euo.mutation.RemovedCompensationsIDs(); len(nodes) > 0 && !euo.mutation.CompensationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.CreatedProjectsTable,
-			Columns: []string{employee.CreatedProjectsColumn},
+			Table:   employee.CompensationsTable,
+			Columns: []string{employee.CompensationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1526,15 +3390,15 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.CreatedProjectsIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.CompensationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.CreatedProjectsTable,
-			Columns: []string{employee.CreatedProjectsColumn},
+			Table:   employee.CompensationsTable,
+			Columns: []string{employee.CompensationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1542,28 +3406,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.UpdatedProjectsCleared() {
+	if euo.mutation.AttendanceRecordsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.UpdatedProjectsTable,
-			Columns: []string{employee.UpdatedProjectsColumn},
+			Table:   employee.AttendanceRecordsTable,
+			Columns: []string{employee.AttendanceRecordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedUpdatedProjectsIDs(); len(nodes) > 0 && !euo.mutation.UpdatedProjectsCleared() {
+	if nodes := euo.mutation.RemovedAttendanceRecordsIDs(); len(nodes) > 0 && !euo.mutation.AttendanceRecordsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.UpdatedProjectsTable,
-			Columns: []string{employee.UpdatedProjectsColumn},
+			Table:   employee.AttendanceRecordsTable,
+			Columns: []string{employee.AttendanceRecordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1571,15 +3435,15 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.UpdatedProjectsIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.AttendanceRecordsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.UpdatedProjectsTable,
-			Columns: []string{employee.UpdatedProjectsColumn},
+			Table:   employee.AttendanceRecordsTable,
+			Columns: []string{employee.AttendanceRecordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1587,28 +3451,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.AssignedTasksCleared() {
+	if euo.mutation.AttendanceCorrectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   employee.AssignedTasksTable,
-			Columns: employee.AssignedTasksPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedAssignedTasksIDs(); len(nodes) > 0 && !euo.mutation.AssignedTasksCleared() {
+	if nodes := euo.mutation.RemovedAttendanceCorrectionsIDs(); len(nodes) > 0 && !euo.mutation.AttendanceCorrectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   employee.AssignedTasksTable,
-			Columns: employee.AssignedTasksPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1616,15 +3480,15 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.AttendanceCorrectionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   employee.AssignedTasksTable,
-			Columns: employee.AssignedTasksPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttendanceCorrectionsTable,
+			Columns: []string{employee.AttendanceCorrectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(attendancecorrection.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1632,28 +3496,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.LeaveApprovesCleared() {
+	if euo.mutation.RostersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.LeaveApprovesTable,
-			Columns: []string{employee.LeaveApprovesColumn},
+			Table:   employee.RostersTable,
+			Columns: []string{employee.RostersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leaveapproval.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedLeaveApprovesIDs(); len(nodes) > 0 && !euo.mutation.LeaveApprovesCleared() {
+	if nodes := euo.mutation.RemovedRostersIDs(); len(nodes) > 0 && !euo.mutation.RostersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.LeaveApprovesTable,
-			Columns: []string{employee.LeaveApprovesColumn},
+			Table:   employee.RostersTable,
+			Columns: []string{employee.RostersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leaveapproval.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1661,15 +3525,15 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.LeaveApprovesIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.RostersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.LeaveApprovesTable,
-			Columns: []string{employee.LeaveApprovesColumn},
+			Table:   employee.RostersTable,
+			Columns: []string{employee.RostersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leaveapproval.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1677,28 +3541,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.LeaveRequestsCleared() {
+	if euo.mutation.OvertimeRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.LeaveRequestsTable,
-			Columns: []string{employee.LeaveRequestsColumn},
+			Table:   employee.OvertimeRequestsTable,
+			Columns: []string{employee.OvertimeRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedLeaveRequestsIDs(); len(nodes) > 0 && !euo.mutation.LeaveRequestsCleared() {
+	if nodes := euo.mutation.RemovedOvertimeRequestsIDs(); len(nodes) > 0 && !euo.mutation.OvertimeRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.LeaveRequestsTable,
-			Columns: []string{employee.LeaveRequestsColumn},
+			Table:   employee.OvertimeRequestsTable,
+			Columns: []string{employee.OvertimeRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1706,15 +3570,15 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.LeaveRequestsIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.LeaveRequestsTable,
-			Columns: []string{employee.LeaveRequestsColumn},
+			Table:   employee.OvertimeRequestsTable,
+			Columns: []string{employee.OvertimeRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(leaverequest.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1722,28 +3586,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.TaskReportsCleared() {
+	if euo.mutation.TaskCommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.TaskReportsTable,
-			Columns: []string{employee.TaskReportsColumn},
+			Table:   employee.TaskCommentsTable,
+			Columns: []string{employee.TaskCommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedTaskReportsIDs(); len(nodes) > 0 && !euo.mutation.TaskReportsCleared() {
+	if nodes := euo.mutation.RemovedTaskCommentsIDs(); len(nodes) > 0 && !euo.mutation.TaskCommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.TaskReportsTable,
-			Columns: []string{employee.TaskReportsColumn},
+			Table:   employee.TaskCommentsTable,
+			Columns: []string{employee.TaskCommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1751,15 +3615,15 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.TaskReportsIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.TaskCommentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.TaskReportsTable,
-			Columns: []string{employee.TaskReportsColumn},
+			Table:   employee.TaskCommentsTable,
+			Columns: []string{employee.TaskCommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1767,28 +3631,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.ProjectsCleared() {
+	if euo.mutation.CommentMentionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: true,
-			Table:   employee.ProjectsTable,
-			Columns: employee.ProjectsPrimaryKey,
+			Table:   employee.CommentMentionsTable,
+			Columns: employee.CommentMentionsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedProjectsIDs(); len(nodes) > 0 && !euo.mutation.ProjectsCleared() {
+	if nodes := euo.mutation.RemovedCommentMentionsIDs(); len(nodes) > 0 && !euo.mutation.CommentMentionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: true,
-			Table:   employee.ProjectsTable,
-			Columns: employee.ProjectsPrimaryKey,
+			Table:   employee.CommentMentionsTable,
+			Columns: employee.CommentMentionsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1796,15 +3660,15 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.ProjectsIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.CommentMentionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
 			Inverse: true,
-			Table:   employee.ProjectsTable,
-			Columns: employee.ProjectsPrimaryKey,
+			Table:   employee.CommentMentionsTable,
+			Columns: employee.CommentMentionsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1812,28 +3676,28 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if euo.mutation.AppointmentHistoriesCleared() {
+	if euo.mutation.ReviewedTaskReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.AppointmentHistoriesTable,
-			Columns: []string{employee.AppointmentHistoriesColumn},
+			Table:   employee.ReviewedTaskReportsTable,
+			Columns: []string{employee.ReviewedTaskReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.RemovedAppointmentHistoriesIDs(); len(nodes) > 0 && !euo.mutation.AppointmentHistoriesCleared() {
+	if nodes := euo.mutation.RemovedReviewedTaskReportsIDs(); len(nodes) > 0 && !euo.mutation.ReviewedTaskReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.AppointmentHistoriesTable,
-			Columns: []string{employee.AppointmentHistoriesColumn},
+			Table:   employee.ReviewedTaskReportsTable,
+			Columns: []string{employee.ReviewedTaskReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1841,15 +3705,105 @@ func (euo *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := euo.mutation.AppointmentHistoriesIDs(); len(nodes) > 0 {
+	if nodes := euo.mutation.ReviewedTaskReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   employee.AppointmentHistoriesTable,
-			Columns: []string{employee.AppointmentHistoriesColumn},
+			Table:   employee.ReviewedTaskReportsTable,
+			Columns: []string{employee.ReviewedTaskReportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttachmentsTable,
+			Columns: []string{employee.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !euo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttachmentsTable,
+			Columns: []string{employee.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AttachmentsTable,
+			Columns: []string{employee.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.WorkLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.WorkLogsTable,
+			Columns: []string{employee.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedWorkLogsIDs(); len(nodes) > 0 && !euo.mutation.WorkLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.WorkLogsTable,
+			Columns: []string{employee.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.WorkLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.WorkLogsTable,
+			Columns: []string{employee.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+)
+
+// EmployeeContract is the model entity for the EmployeeContract schema.
+type EmployeeContract struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code"`
+	// Type holds the value of the "type" field.
+	Type employeecontract.Type `json:"type"`
+	// Status holds the value of the "status" field.
+	Status employeecontract.Status `json:"status"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt time.Time `json:"start_at"`
+	// EndAt holds the value of the "end_at" field.
+	EndAt *time.Time `json:"end_at"`
+	// Note holds the value of the "note" field.
+	Note *string `json:"note"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmployeeContractQuery when eager-loading is set.
+	Edges        EmployeeContractEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmployeeContractEdges holds the relations/edges for other nodes in the graph.
+type EmployeeContractEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmployeeContractEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmployeeContract) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case employeecontract.FieldID, employeecontract.FieldEmployeeID:
+			values[i] = new(sql.NullInt64)
+		case employeecontract.FieldCode, employeecontract.FieldType, employeecontract.FieldStatus, employeecontract.FieldNote:
+			values[i] = new(sql.NullString)
+		case employeecontract.FieldStartAt, employeecontract.FieldEndAt, employeecontract.FieldCreatedAt, employeecontract.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmployeeContract fields.
+func (ec *EmployeeContract) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case employeecontract.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ec.ID = int(value.Int64)
+		case employeecontract.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				ec.EmployeeID = int(value.Int64)
+			}
+		case employeecontract.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				ec.Code = value.String
+			}
+		case employeecontract.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ec.Type = employeecontract.Type(value.String)
+			}
+		case employeecontract.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ec.Status = employeecontract.Status(value.String)
+			}
+		case employeecontract.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				ec.StartAt = value.Time
+			}
+		case employeecontract.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				ec.EndAt = new(time.Time)
+				*ec.EndAt = value.Time
+			}
+		case employeecontract.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				ec.Note = new(string)
+				*ec.Note = value.String
+			}
+		case employeecontract.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ec.CreatedAt = value.Time
+			}
+		case employeecontract.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ec.UpdatedAt = value.Time
+			}
+		default:
+			ec.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmployeeContract.
+// This includes values selected through modifiers, order, etc.
+func (ec *EmployeeContract) Value(name string) (ent.Value, error) {
+	return ec.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the EmployeeContract entity.
+func (ec *EmployeeContract) QueryEmployee() *EmployeeQuery {
+	return NewEmployeeContractClient(ec.config).QueryEmployee(ec)
+}
+
+// Update returns a builder for updating this EmployeeContract.
+// Note that you need to call EmployeeContract.Unwrap() before calling this method if this EmployeeContract
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ec *EmployeeContract) Update() *EmployeeContractUpdateOne {
+	return NewEmployeeContractClient(ec.config).UpdateOne(ec)
+}
+
+// Unwrap unwraps the EmployeeContract entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ec *EmployeeContract) Unwrap() *EmployeeContract {
+	_tx, ok := ec.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmployeeContract is not a transactional entity")
+	}
+	ec.config.driver = _tx.drv
+	return ec
+}
+
+// String implements the fmt.Stringer.
+func (ec *EmployeeContract) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmployeeContract(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ec.ID))
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", ec.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(ec.Code)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", ec.Type))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ec.Status))
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(ec.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ec.EndAt; v != nil {
+		builder.WriteString("end_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ec.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ec.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ec.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmployeeContracts is a parsable slice of EmployeeContract.
+type EmployeeContracts []*EmployeeContract
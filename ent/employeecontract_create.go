@@ -0,0 +1,992 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+)
+
+// EmployeeContractCreate is the builder for creating a EmployeeContract entity.
+type EmployeeContractCreate struct {
+	config
+	mutation *EmployeeContractMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (ecc *EmployeeContractCreate) SetEmployeeID(i int) *EmployeeContractCreate {
+	ecc.mutation.SetEmployeeID(i)
+	return ecc
+}
+
+// SetCode sets the "code" field.
+func (ecc *EmployeeContractCreate) SetCode(s string) *EmployeeContractCreate {
+	ecc.mutation.SetCode(s)
+	return ecc
+}
+
+// SetType sets the "type" field.
+func (ecc *EmployeeContractCreate) SetType(e employeecontract.Type) *EmployeeContractCreate {
+	ecc.mutation.SetType(e)
+	return ecc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ecc *EmployeeContractCreate) SetNillableType(e *employeecontract.Type) *EmployeeContractCreate {
+	if e != nil {
+		ecc.SetType(*e)
+	}
+	return ecc
+}
+
+// SetStatus sets the "status" field.
+func (ecc *EmployeeContractCreate) SetStatus(e employeecontract.Status) *EmployeeContractCreate {
+	ecc.mutation.SetStatus(e)
+	return ecc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecc *EmployeeContractCreate) SetNillableStatus(e *employeecontract.Status) *EmployeeContractCreate {
+	if e != nil {
+		ecc.SetStatus(*e)
+	}
+	return ecc
+}
+
+// SetStartAt sets the "start_at" field.
+func (ecc *EmployeeContractCreate) SetStartAt(t time.Time) *EmployeeContractCreate {
+	ecc.mutation.SetStartAt(t)
+	return ecc
+}
+
+// SetEndAt sets the "end_at" field.
+func (ecc *EmployeeContractCreate) SetEndAt(t time.Time) *EmployeeContractCreate {
+	ecc.mutation.SetEndAt(t)
+	return ecc
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (ecc *EmployeeContractCreate) SetNillableEndAt(t *time.Time) *EmployeeContractCreate {
+	if t != nil {
+		ecc.SetEndAt(*t)
+	}
+	return ecc
+}
+
+// SetNote sets the "note" field.
+func (ecc *EmployeeContractCreate) SetNote(s string) *EmployeeContractCreate {
+	ecc.mutation.SetNote(s)
+	return ecc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ecc *EmployeeContractCreate) SetNillableNote(s *string) *EmployeeContractCreate {
+	if s != nil {
+		ecc.SetNote(*s)
+	}
+	return ecc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ecc *EmployeeContractCreate) SetCreatedAt(t time.Time) *EmployeeContractCreate {
+	ecc.mutation.SetCreatedAt(t)
+	return ecc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ecc *EmployeeContractCreate) SetNillableCreatedAt(t *time.Time) *EmployeeContractCreate {
+	if t != nil {
+		ecc.SetCreatedAt(*t)
+	}
+	return ecc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ecc *EmployeeContractCreate) SetUpdatedAt(t time.Time) *EmployeeContractCreate {
+	ecc.mutation.SetUpdatedAt(t)
+	return ecc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ecc *EmployeeContractCreate) SetNillableUpdatedAt(t *time.Time) *EmployeeContractCreate {
+	if t != nil {
+		ecc.SetUpdatedAt(*t)
+	}
+	return ecc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (ecc *EmployeeContractCreate) SetEmployee(e *Employee) *EmployeeContractCreate {
+	return ecc.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the EmployeeContractMutation object of the builder.
+func (ecc *EmployeeContractCreate) Mutation() *EmployeeContractMutation {
+	return ecc.mutation
+}
+
+// Save creates the EmployeeContract in the database.
+func (ecc *EmployeeContractCreate) Save(ctx context.Context) (*EmployeeContract, error) {
+	ecc.defaults()
+	return withHooks(ctx, ecc.sqlSave, ecc.mutation, ecc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ecc *EmployeeContractCreate) SaveX(ctx context.Context) *EmployeeContract {
+	v, err := ecc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecc *EmployeeContractCreate) Exec(ctx context.Context) error {
+	_, err := ecc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecc *EmployeeContractCreate) ExecX(ctx context.Context) {
+	if err := ecc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecc *EmployeeContractCreate) defaults() {
+	if _, ok := ecc.mutation.GetType(); !ok {
+		v := employeecontract.DefaultType
+		ecc.mutation.SetType(v)
+	}
+	if _, ok := ecc.mutation.Status(); !ok {
+		v := employeecontract.DefaultStatus
+		ecc.mutation.SetStatus(v)
+	}
+	if _, ok := ecc.mutation.CreatedAt(); !ok {
+		v := employeecontract.DefaultCreatedAt()
+		ecc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ecc.mutation.UpdatedAt(); !ok {
+		v := employeecontract.DefaultUpdatedAt()
+		ecc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecc *EmployeeContractCreate) check() error {
+	if _, ok := ecc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "EmployeeContract.employee_id"`)}
+	}
+	if _, ok := ecc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "EmployeeContract.code"`)}
+	}
+	if v, ok := ecc.mutation.Code(); ok {
+		if err := employeecontract.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.code": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "EmployeeContract.type"`)}
+	}
+	if v, ok := ecc.mutation.GetType(); ok {
+		if err := employeecontract.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.type": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmployeeContract.status"`)}
+	}
+	if v, ok := ecc.mutation.Status(); ok {
+		if err := employeecontract.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.status": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "EmployeeContract.start_at"`)}
+	}
+	if _, ok := ecc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmployeeContract.created_at"`)}
+	}
+	if _, ok := ecc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmployeeContract.updated_at"`)}
+	}
+	if len(ecc.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "EmployeeContract.employee"`)}
+	}
+	return nil
+}
+
+func (ecc *EmployeeContractCreate) sqlSave(ctx context.Context) (*EmployeeContract, error) {
+	if err := ecc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ecc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ecc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ecc.mutation.id = &_node.ID
+	ecc.mutation.done = true
+	return _node, nil
+}
+
+func (ecc *EmployeeContractCreate) createSpec() (*EmployeeContract, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmployeeContract{config: ecc.config}
+		_spec = sqlgraph.NewCreateSpec(employeecontract.Table, sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ecc.conflict
+	if value, ok := ecc.mutation.Code(); ok {
+		_spec.SetField(employeecontract.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := ecc.mutation.GetType(); ok {
+		_spec.SetField(employeecontract.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := ecc.mutation.Status(); ok {
+		_spec.SetField(employeecontract.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ecc.mutation.StartAt(); ok {
+		_spec.SetField(employeecontract.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := ecc.mutation.EndAt(); ok {
+		_spec.SetField(employeecontract.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = &value
+	}
+	if value, ok := ecc.mutation.Note(); ok {
+		_spec.SetField(employeecontract.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if value, ok := ecc.mutation.CreatedAt(); ok {
+		_spec.SetField(employeecontract.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ecc.mutation.UpdatedAt(); ok {
+		_spec.SetField(employeecontract.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ecc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeecontract.EmployeeTable,
+			Columns: []string{employeecontract.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmployeeContract.Create().
+//		SetEmployeeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmployeeContractUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (ecc *EmployeeContractCreate) OnConflict(opts ...sql.ConflictOption) *EmployeeContractUpsertOne {
+	ecc.conflict = opts
+	return &EmployeeContractUpsertOne{
+		create: ecc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmployeeContract.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ecc *EmployeeContractCreate) OnConflictColumns(columns ...string) *EmployeeContractUpsertOne {
+	ecc.conflict = append(ecc.conflict, sql.ConflictColumns(columns...))
+	return &EmployeeContractUpsertOne{
+		create: ecc,
+	}
+}
+
+type (
+	// EmployeeContractUpsertOne is the builder for "upsert"-ing
+	//  one EmployeeContract node.
+	EmployeeContractUpsertOne struct {
+		create *EmployeeContractCreate
+	}
+
+	// EmployeeContractUpsert is the "OnConflict" setter.
+	EmployeeContractUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *EmployeeContractUpsert) SetEmployeeID(v int) *EmployeeContractUpsert {
+	u.Set(employeecontract.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *EmployeeContractUpsert) UpdateEmployeeID() *EmployeeContractUpsert {
+	u.SetExcluded(employeecontract.FieldEmployeeID)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *EmployeeContractUpsert) SetCode(v string) *EmployeeContractUpsert {
+	u.Set(employeecontract.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *EmployeeContractUpsert) UpdateCode() *EmployeeContractUpsert {
+	u.SetExcluded(employeecontract.FieldCode)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *EmployeeContractUpsert) SetType(v employeecontract.Type) *EmployeeContractUpsert {
+	u.Set(employeecontract.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EmployeeContractUpsert) UpdateType() *EmployeeContractUpsert {
+	u.SetExcluded(employeecontract.FieldType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EmployeeContractUpsert) SetStatus(v employeecontract.Status) *EmployeeContractUpsert {
+	u.Set(employeecontract.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmployeeContractUpsert) UpdateStatus() *EmployeeContractUpsert {
+	u.SetExcluded(employeecontract.FieldStatus)
+	return u
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *EmployeeContractUpsert) SetStartAt(v time.Time) *EmployeeContractUpsert {
+	u.Set(employeecontract.FieldStartAt, v)
+	return u
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsert) UpdateStartAt() *EmployeeContractUpsert {
+	u.SetExcluded(employeecontract.FieldStartAt)
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *EmployeeContractUpsert) SetEndAt(v time.Time) *EmployeeContractUpsert {
+	u.Set(employeecontract.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsert) UpdateEndAt() *EmployeeContractUpsert {
+	u.SetExcluded(employeecontract.FieldEndAt)
+	return u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *EmployeeContractUpsert) ClearEndAt() *EmployeeContractUpsert {
+	u.SetNull(employeecontract.FieldEndAt)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *EmployeeContractUpsert) SetNote(v string) *EmployeeContractUpsert {
+	u.Set(employeecontract.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EmployeeContractUpsert) UpdateNote() *EmployeeContractUpsert {
+	u.SetExcluded(employeecontract.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EmployeeContractUpsert) ClearNote() *EmployeeContractUpsert {
+	u.SetNull(employeecontract.FieldNote)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmployeeContractUpsert) SetUpdatedAt(v time.Time) *EmployeeContractUpsert {
+	u.Set(employeecontract.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsert) UpdateUpdatedAt() *EmployeeContractUpsert {
+	u.SetExcluded(employeecontract.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EmployeeContract.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EmployeeContractUpsertOne) UpdateNewValues() *EmployeeContractUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(employeecontract.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmployeeContract.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmployeeContractUpsertOne) Ignore() *EmployeeContractUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmployeeContractUpsertOne) DoNothing() *EmployeeContractUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmployeeContractCreate.OnConflict
+// documentation for more info.
+func (u *EmployeeContractUpsertOne) Update(set func(*EmployeeContractUpsert)) *EmployeeContractUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmployeeContractUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *EmployeeContractUpsertOne) SetEmployeeID(v int) *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *EmployeeContractUpsertOne) UpdateEmployeeID() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *EmployeeContractUpsertOne) SetCode(v string) *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *EmployeeContractUpsertOne) UpdateCode() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *EmployeeContractUpsertOne) SetType(v employeecontract.Type) *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EmployeeContractUpsertOne) UpdateType() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmployeeContractUpsertOne) SetStatus(v employeecontract.Status) *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmployeeContractUpsertOne) UpdateStatus() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *EmployeeContractUpsertOne) SetStartAt(v time.Time) *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsertOne) UpdateStartAt() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *EmployeeContractUpsertOne) SetEndAt(v time.Time) *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsertOne) UpdateEndAt() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *EmployeeContractUpsertOne) ClearEndAt() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *EmployeeContractUpsertOne) SetNote(v string) *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EmployeeContractUpsertOne) UpdateNote() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EmployeeContractUpsertOne) ClearNote() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmployeeContractUpsertOne) SetUpdatedAt(v time.Time) *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsertOne) UpdateUpdatedAt() *EmployeeContractUpsertOne {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmployeeContractUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmployeeContractCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmployeeContractUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmployeeContractUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmployeeContractUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmployeeContractCreateBulk is the builder for creating many EmployeeContract entities in bulk.
+type EmployeeContractCreateBulk struct {
+	config
+	err      error
+	builders []*EmployeeContractCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmployeeContract entities in the database.
+func (eccb *EmployeeContractCreateBulk) Save(ctx context.Context) ([]*EmployeeContract, error) {
+	if eccb.err != nil {
+		return nil, eccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eccb.builders))
+	nodes := make([]*EmployeeContract, len(eccb.builders))
+	mutators := make([]Mutator, len(eccb.builders))
+	for i := range eccb.builders {
+		func(i int, root context.Context) {
+			builder := eccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmployeeContractMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = eccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eccb *EmployeeContractCreateBulk) SaveX(ctx context.Context) []*EmployeeContract {
+	v, err := eccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eccb *EmployeeContractCreateBulk) Exec(ctx context.Context) error {
+	_, err := eccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccb *EmployeeContractCreateBulk) ExecX(ctx context.Context) {
+	if err := eccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmployeeContract.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmployeeContractUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (eccb *EmployeeContractCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmployeeContractUpsertBulk {
+	eccb.conflict = opts
+	return &EmployeeContractUpsertBulk{
+		create: eccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmployeeContract.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eccb *EmployeeContractCreateBulk) OnConflictColumns(columns ...string) *EmployeeContractUpsertBulk {
+	eccb.conflict = append(eccb.conflict, sql.ConflictColumns(columns...))
+	return &EmployeeContractUpsertBulk{
+		create: eccb,
+	}
+}
+
+// EmployeeContractUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmployeeContract nodes.
+type EmployeeContractUpsertBulk struct {
+	create *EmployeeContractCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmployeeContract.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EmployeeContractUpsertBulk) UpdateNewValues() *EmployeeContractUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(employeecontract.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmployeeContract.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmployeeContractUpsertBulk) Ignore() *EmployeeContractUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmployeeContractUpsertBulk) DoNothing() *EmployeeContractUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmployeeContractCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmployeeContractUpsertBulk) Update(set func(*EmployeeContractUpsert)) *EmployeeContractUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmployeeContractUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *EmployeeContractUpsertBulk) SetEmployeeID(v int) *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *EmployeeContractUpsertBulk) UpdateEmployeeID() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *EmployeeContractUpsertBulk) SetCode(v string) *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *EmployeeContractUpsertBulk) UpdateCode() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *EmployeeContractUpsertBulk) SetType(v employeecontract.Type) *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EmployeeContractUpsertBulk) UpdateType() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmployeeContractUpsertBulk) SetStatus(v employeecontract.Status) *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmployeeContractUpsertBulk) UpdateStatus() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *EmployeeContractUpsertBulk) SetStartAt(v time.Time) *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsertBulk) UpdateStartAt() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *EmployeeContractUpsertBulk) SetEndAt(v time.Time) *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsertBulk) UpdateEndAt() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *EmployeeContractUpsertBulk) ClearEndAt() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *EmployeeContractUpsertBulk) SetNote(v string) *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EmployeeContractUpsertBulk) UpdateNote() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EmployeeContractUpsertBulk) ClearNote() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmployeeContractUpsertBulk) SetUpdatedAt(v time.Time) *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmployeeContractUpsertBulk) UpdateUpdatedAt() *EmployeeContractUpsertBulk {
+	return u.Update(func(s *EmployeeContractUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmployeeContractUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmployeeContractCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmployeeContractCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmployeeContractUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// EmployeeContractDelete is the builder for deleting a EmployeeContract entity.
+type EmployeeContractDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmployeeContractMutation
+}
+
+// Where appends a list predicates to the EmployeeContractDelete builder.
+func (ecd *EmployeeContractDelete) Where(ps ...predicate.EmployeeContract) *EmployeeContractDelete {
+	ecd.mutation.Where(ps...)
+	return ecd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ecd *EmployeeContractDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ecd.sqlExec, ecd.mutation, ecd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecd *EmployeeContractDelete) ExecX(ctx context.Context) int {
+	n, err := ecd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ecd *EmployeeContractDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(employeecontract.Table, sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt))
+	if ps := ecd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ecd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ecd.mutation.done = true
+	return affected, err
+}
+
+// EmployeeContractDeleteOne is the builder for deleting a single EmployeeContract entity.
+type EmployeeContractDeleteOne struct {
+	ecd *EmployeeContractDelete
+}
+
+// Where appends a list predicates to the EmployeeContractDelete builder.
+func (ecdo *EmployeeContractDeleteOne) Where(ps ...predicate.EmployeeContract) *EmployeeContractDeleteOne {
+	ecdo.ecd.mutation.Where(ps...)
+	return ecdo
+}
+
+// Exec executes the deletion query.
+func (ecdo *EmployeeContractDeleteOne) Exec(ctx context.Context) error {
+	n, err := ecdo.ecd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{employeecontract.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecdo *EmployeeContractDeleteOne) ExecX(ctx context.Context) {
+	if err := ecdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
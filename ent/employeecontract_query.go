@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// EmployeeContractQuery is the builder for querying EmployeeContract entities.
+type EmployeeContractQuery struct {
+	config
+	ctx          *QueryContext
+	order        []employeecontract.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.EmployeeContract
+	withEmployee *EmployeeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmployeeContractQuery builder.
+func (ecq *EmployeeContractQuery) Where(ps ...predicate.EmployeeContract) *EmployeeContractQuery {
+	ecq.predicates = append(ecq.predicates, ps...)
+	return ecq
+}
+
+// Limit the number of records to be returned by this query.
+func (ecq *EmployeeContractQuery) Limit(limit int) *EmployeeContractQuery {
+	ecq.ctx.Limit = &limit
+	return ecq
+}
+
+// Offset to start from.
+func (ecq *EmployeeContractQuery) Offset(offset int) *EmployeeContractQuery {
+	ecq.ctx.Offset = &offset
+	return ecq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ecq *EmployeeContractQuery) Unique(unique bool) *EmployeeContractQuery {
+	ecq.ctx.Unique = &unique
+	return ecq
+}
+
+// Order specifies how the records should be ordered.
+func (ecq *EmployeeContractQuery) Order(o ...employeecontract.OrderOption) *EmployeeContractQuery {
+	ecq.order = append(ecq.order, o...)
+	return ecq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (ecq *EmployeeContractQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: ecq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ecq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ecq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employeecontract.Table, employeecontract.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, employeecontract.EmployeeTable, employeecontract.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ecq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EmployeeContract entity from the query.
+// Returns a *NotFoundError when no EmployeeContract was found.
+func (ecq *EmployeeContractQuery) First(ctx context.Context) (*EmployeeContract, error) {
+	nodes, err := ecq.Limit(1).All(setContextOp(ctx, ecq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{employeecontract.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ecq *EmployeeContractQuery) FirstX(ctx context.Context) *EmployeeContract {
+	node, err := ecq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmployeeContract ID from the query.
+// Returns a *NotFoundError when no EmployeeContract ID was found.
+func (ecq *EmployeeContractQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ecq.Limit(1).IDs(setContextOp(ctx, ecq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{employeecontract.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ecq *EmployeeContractQuery) FirstIDX(ctx context.Context) int {
+	id, err := ecq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmployeeContract entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmployeeContract entity is found.
+// Returns a *NotFoundError when no EmployeeContract entities are found.
+func (ecq *EmployeeContractQuery) Only(ctx context.Context) (*EmployeeContract, error) {
+	nodes, err := ecq.Limit(2).All(setContextOp(ctx, ecq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{employeecontract.Label}
+	default:
+		return nil, &NotSingularError{employeecontract.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ecq *EmployeeContractQuery) OnlyX(ctx context.Context) *EmployeeContract {
+	node, err := ecq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmployeeContract ID in the query.
+// Returns a *NotSingularError when more than one EmployeeContract ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ecq *EmployeeContractQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ecq.Limit(2).IDs(setContextOp(ctx, ecq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{employeecontract.Label}
+	default:
+		err = &NotSingularError{employeecontract.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ecq *EmployeeContractQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ecq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmployeeContracts.
+func (ecq *EmployeeContractQuery) All(ctx context.Context) ([]*EmployeeContract, error) {
+	ctx = setContextOp(ctx, ecq.ctx, ent.OpQueryAll)
+	if err := ecq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmployeeContract, *EmployeeContractQuery]()
+	return withInterceptors[[]*EmployeeContract](ctx, ecq, qr, ecq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ecq *EmployeeContractQuery) AllX(ctx context.Context) []*EmployeeContract {
+	nodes, err := ecq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmployeeContract IDs.
+func (ecq *EmployeeContractQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ecq.ctx.Unique == nil && ecq.path != nil {
+		ecq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ecq.ctx, ent.OpQueryIDs)
+	if err = ecq.Select(employeecontract.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ecq *EmployeeContractQuery) IDsX(ctx context.Context) []int {
+	ids, err := ecq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ecq *EmployeeContractQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ecq.ctx, ent.OpQueryCount)
+	if err := ecq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ecq, querierCount[*EmployeeContractQuery](), ecq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ecq *EmployeeContractQuery) CountX(ctx context.Context) int {
+	count, err := ecq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ecq *EmployeeContractQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ecq.ctx, ent.OpQueryExist)
+	switch _, err := ecq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ecq *EmployeeContractQuery) ExistX(ctx context.Context) bool {
+	exist, err := ecq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmployeeContractQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ecq *EmployeeContractQuery) Clone() *EmployeeContractQuery {
+	if ecq == nil {
+		return nil
+	}
+	return &EmployeeContractQuery{
+		config:       ecq.config,
+		ctx:          ecq.ctx.Clone(),
+		order:        append([]employeecontract.OrderOption{}, ecq.order...),
+		inters:       append([]Interceptor{}, ecq.inters...),
+		predicates:   append([]predicate.EmployeeContract{}, ecq.predicates...),
+		withEmployee: ecq.withEmployee.Clone(),
+		// clone intermediate query.
+		sql:  ecq.sql.Clone(),
+		path: ecq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (ecq *EmployeeContractQuery) WithEmployee(opts ...func(*EmployeeQuery)) *EmployeeContractQuery {
+	query := (&EmployeeClient{config: ecq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ecq.withEmployee = query
+	return ecq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID int `json:"employee_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmployeeContract.Query().
+//		GroupBy(employeecontract.FieldEmployeeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ecq *EmployeeContractQuery) GroupBy(field string, fields ...string) *EmployeeContractGroupBy {
+	ecq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmployeeContractGroupBy{build: ecq}
+	grbuild.flds = &ecq.ctx.Fields
+	grbuild.label = employeecontract.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID int `json:"employee_id"`
+//	}
+//
+//	client.EmployeeContract.Query().
+//		Select(employeecontract.FieldEmployeeID).
+//		Scan(ctx, &v)
+func (ecq *EmployeeContractQuery) Select(fields ...string) *EmployeeContractSelect {
+	ecq.ctx.Fields = append(ecq.ctx.Fields, fields...)
+	sbuild := &EmployeeContractSelect{EmployeeContractQuery: ecq}
+	sbuild.label = employeecontract.Label
+	sbuild.flds, sbuild.scan = &ecq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmployeeContractSelect configured with the given aggregations.
+func (ecq *EmployeeContractQuery) Aggregate(fns ...AggregateFunc) *EmployeeContractSelect {
+	return ecq.Select().Aggregate(fns...)
+}
+
+func (ecq *EmployeeContractQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ecq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ecq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ecq.ctx.Fields {
+		if !employeecontract.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ecq.path != nil {
+		prev, err := ecq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ecq.sql = prev
+	}
+	return nil
+}
+
+func (ecq *EmployeeContractQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmployeeContract, error) {
+	var (
+		nodes       = []*EmployeeContract{}
+		_spec       = ecq.querySpec()
+		loadedTypes = [1]bool{
+			ecq.withEmployee != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmployeeContract).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmployeeContract{config: ecq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ecq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ecq.withEmployee; query != nil {
+		if err := ecq.loadEmployee(ctx, query, nodes, nil,
+			func(n *EmployeeContract, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ecq *EmployeeContractQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*EmployeeContract, init func(*EmployeeContract), assign func(*EmployeeContract, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*EmployeeContract)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ecq *EmployeeContractQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ecq.querySpec()
+	_spec.Node.Columns = ecq.ctx.Fields
+	if len(ecq.ctx.Fields) > 0 {
+		_spec.Unique = ecq.ctx.Unique != nil && *ecq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ecq.driver, _spec)
+}
+
+func (ecq *EmployeeContractQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(employeecontract.Table, employeecontract.Columns, sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt))
+	_spec.From = ecq.sql
+	if unique := ecq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ecq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ecq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, employeecontract.FieldID)
+		for i := range fields {
+			if fields[i] != employeecontract.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ecq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(employeecontract.FieldEmployeeID)
+		}
+	}
+	if ps := ecq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ecq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ecq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ecq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ecq *EmployeeContractQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ecq.driver.Dialect())
+	t1 := builder.Table(employeecontract.Table)
+	columns := ecq.ctx.Fields
+	if len(columns) == 0 {
+		columns = employeecontract.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ecq.sql != nil {
+		selector = ecq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ecq.ctx.Unique != nil && *ecq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ecq.predicates {
+		p(selector)
+	}
+	for _, p := range ecq.order {
+		p(selector)
+	}
+	if offset := ecq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ecq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EmployeeContractGroupBy is the group-by builder for EmployeeContract entities.
+type EmployeeContractGroupBy struct {
+	selector
+	build *EmployeeContractQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ecgb *EmployeeContractGroupBy) Aggregate(fns ...AggregateFunc) *EmployeeContractGroupBy {
+	ecgb.fns = append(ecgb.fns, fns...)
+	return ecgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ecgb *EmployeeContractGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ecgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ecgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmployeeContractQuery, *EmployeeContractGroupBy](ctx, ecgb.build, ecgb, ecgb.build.inters, v)
+}
+
+func (ecgb *EmployeeContractGroupBy) sqlScan(ctx context.Context, root *EmployeeContractQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ecgb.fns))
+	for _, fn := range ecgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ecgb.flds)+len(ecgb.fns))
+		for _, f := range *ecgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ecgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ecgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmployeeContractSelect is the builder for selecting fields of EmployeeContract entities.
+type EmployeeContractSelect struct {
+	*EmployeeContractQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ecs *EmployeeContractSelect) Aggregate(fns ...AggregateFunc) *EmployeeContractSelect {
+	ecs.fns = append(ecs.fns, fns...)
+	return ecs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ecs *EmployeeContractSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ecs.ctx, ent.OpQuerySelect)
+	if err := ecs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmployeeContractQuery, *EmployeeContractSelect](ctx, ecs.EmployeeContractQuery, ecs, ecs.inters, v)
+}
+
+func (ecs *EmployeeContractSelect) sqlScan(ctx context.Context, root *EmployeeContractQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ecs.fns))
+	for _, fn := range ecs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ecs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ecs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
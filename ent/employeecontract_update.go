@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// EmployeeContractUpdate is the builder for updating EmployeeContract entities.
+type EmployeeContractUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmployeeContractMutation
+}
+
+// Where appends a list predicates to the EmployeeContractUpdate builder.
+func (ecu *EmployeeContractUpdate) Where(ps ...predicate.EmployeeContract) *EmployeeContractUpdate {
+	ecu.mutation.Where(ps...)
+	return ecu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (ecu *EmployeeContractUpdate) SetEmployeeID(i int) *EmployeeContractUpdate {
+	ecu.mutation.SetEmployeeID(i)
+	return ecu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (ecu *EmployeeContractUpdate) SetNillableEmployeeID(i *int) *EmployeeContractUpdate {
+	if i != nil {
+		ecu.SetEmployeeID(*i)
+	}
+	return ecu
+}
+
+// SetCode sets the "code" field.
+func (ecu *EmployeeContractUpdate) SetCode(s string) *EmployeeContractUpdate {
+	ecu.mutation.SetCode(s)
+	return ecu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (ecu *EmployeeContractUpdate) SetNillableCode(s *string) *EmployeeContractUpdate {
+	if s != nil {
+		ecu.SetCode(*s)
+	}
+	return ecu
+}
+
+// SetType sets the "type" field.
+func (ecu *EmployeeContractUpdate) SetType(e employeecontract.Type) *EmployeeContractUpdate {
+	ecu.mutation.SetType(e)
+	return ecu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ecu *EmployeeContractUpdate) SetNillableType(e *employeecontract.Type) *EmployeeContractUpdate {
+	if e != nil {
+		ecu.SetType(*e)
+	}
+	return ecu
+}
+
+// SetStatus sets the "status" field.
+func (ecu *EmployeeContractUpdate) SetStatus(e employeecontract.Status) *EmployeeContractUpdate {
+	ecu.mutation.SetStatus(e)
+	return ecu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecu *EmployeeContractUpdate) SetNillableStatus(e *employeecontract.Status) *EmployeeContractUpdate {
+	if e != nil {
+		ecu.SetStatus(*e)
+	}
+	return ecu
+}
+
+// SetStartAt sets the "start_at" field.
+func (ecu *EmployeeContractUpdate) SetStartAt(t time.Time) *EmployeeContractUpdate {
+	ecu.mutation.SetStartAt(t)
+	return ecu
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (ecu *EmployeeContractUpdate) SetNillableStartAt(t *time.Time) *EmployeeContractUpdate {
+	if t != nil {
+		ecu.SetStartAt(*t)
+	}
+	return ecu
+}
+
+// SetEndAt sets the "end_at" field.
+func (ecu *EmployeeContractUpdate) SetEndAt(t time.Time) *EmployeeContractUpdate {
+	ecu.mutation.SetEndAt(t)
+	return ecu
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (ecu *EmployeeContractUpdate) SetNillableEndAt(t *time.Time) *EmployeeContractUpdate {
+	if t != nil {
+		ecu.SetEndAt(*t)
+	}
+	return ecu
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (ecu *EmployeeContractUpdate) ClearEndAt() *EmployeeContractUpdate {
+	ecu.mutation.ClearEndAt()
+	return ecu
+}
+
+// SetNote sets the "note" field.
+func (ecu *EmployeeContractUpdate) SetNote(s string) *EmployeeContractUpdate {
+	ecu.mutation.SetNote(s)
+	return ecu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ecu *EmployeeContractUpdate) SetNillableNote(s *string) *EmployeeContractUpdate {
+	if s != nil {
+		ecu.SetNote(*s)
+	}
+	return ecu
+}
+
+// ClearNote clears the value of the "note" field.
+func (ecu *EmployeeContractUpdate) ClearNote() *EmployeeContractUpdate {
+	ecu.mutation.ClearNote()
+	return ecu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ecu *EmployeeContractUpdate) SetUpdatedAt(t time.Time) *EmployeeContractUpdate {
+	ecu.mutation.SetUpdatedAt(t)
+	return ecu
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (ecu *EmployeeContractUpdate) SetEmployee(e *Employee) *EmployeeContractUpdate {
+	return ecu.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the EmployeeContractMutation object of the builder.
+func (ecu *EmployeeContractUpdate) Mutation() *EmployeeContractMutation {
+	return ecu.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (ecu *EmployeeContractUpdate) ClearEmployee() *EmployeeContractUpdate {
+	ecu.mutation.ClearEmployee()
+	return ecu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ecu *EmployeeContractUpdate) Save(ctx context.Context) (int, error) {
+	ecu.defaults()
+	return withHooks(ctx, ecu.sqlSave, ecu.mutation, ecu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecu *EmployeeContractUpdate) SaveX(ctx context.Context) int {
+	affected, err := ecu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ecu *EmployeeContractUpdate) Exec(ctx context.Context) error {
+	_, err := ecu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecu *EmployeeContractUpdate) ExecX(ctx context.Context) {
+	if err := ecu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecu *EmployeeContractUpdate) defaults() {
+	if _, ok := ecu.mutation.UpdatedAt(); !ok {
+		v := employeecontract.UpdateDefaultUpdatedAt()
+		ecu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecu *EmployeeContractUpdate) check() error {
+	if v, ok := ecu.mutation.Code(); ok {
+		if err := employeecontract.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.code": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.GetType(); ok {
+		if err := employeecontract.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.type": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.Status(); ok {
+		if err := employeecontract.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.status": %w`, err)}
+		}
+	}
+	if ecu.mutation.EmployeeCleared() && len(ecu.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmployeeContract.employee"`)
+	}
+	return nil
+}
+
+func (ecu *EmployeeContractUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ecu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(employeecontract.Table, employeecontract.Columns, sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt))
+	if ps := ecu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecu.mutation.Code(); ok {
+		_spec.SetField(employeecontract.FieldCode, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.GetType(); ok {
+		_spec.SetField(employeecontract.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ecu.mutation.Status(); ok {
+		_spec.SetField(employeecontract.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ecu.mutation.StartAt(); ok {
+		_spec.SetField(employeecontract.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := ecu.mutation.EndAt(); ok {
+		_spec.SetField(employeecontract.FieldEndAt, field.TypeTime, value)
+	}
+	if ecu.mutation.EndAtCleared() {
+		_spec.ClearField(employeecontract.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := ecu.mutation.Note(); ok {
+		_spec.SetField(employeecontract.FieldNote, field.TypeString, value)
+	}
+	if ecu.mutation.NoteCleared() {
+		_spec.ClearField(employeecontract.FieldNote, field.TypeString)
+	}
+	if value, ok := ecu.mutation.UpdatedAt(); ok {
+		_spec.SetField(employeecontract.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ecu.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeecontract.EmployeeTable,
+			Columns: []string{employeecontract.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ecu.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeecontract.EmployeeTable,
+			Columns: []string{employeecontract.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ecu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employeecontract.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ecu.mutation.done = true
+	return n, nil
+}
+
+// EmployeeContractUpdateOne is the builder for updating a single EmployeeContract entity.
+type EmployeeContractUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmployeeContractMutation
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (ecuo *EmployeeContractUpdateOne) SetEmployeeID(i int) *EmployeeContractUpdateOne {
+	ecuo.mutation.SetEmployeeID(i)
+	return ecuo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (ecuo *EmployeeContractUpdateOne) SetNillableEmployeeID(i *int) *EmployeeContractUpdateOne {
+	if i != nil {
+		ecuo.SetEmployeeID(*i)
+	}
+	return ecuo
+}
+
+// SetCode sets the "code" field.
+func (ecuo *EmployeeContractUpdateOne) SetCode(s string) *EmployeeContractUpdateOne {
+	ecuo.mutation.SetCode(s)
+	return ecuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (ecuo *EmployeeContractUpdateOne) SetNillableCode(s *string) *EmployeeContractUpdateOne {
+	if s != nil {
+		ecuo.SetCode(*s)
+	}
+	return ecuo
+}
+
+// SetType sets the "type" field.
+func (ecuo *EmployeeContractUpdateOne) SetType(e employeecontract.Type) *EmployeeContractUpdateOne {
+	ecuo.mutation.SetType(e)
+	return ecuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ecuo *EmployeeContractUpdateOne) SetNillableType(e *employeecontract.Type) *EmployeeContractUpdateOne {
+	if e != nil {
+		ecuo.SetType(*e)
+	}
+	return ecuo
+}
+
+// SetStatus sets the "status" field.
+func (ecuo *EmployeeContractUpdateOne) SetStatus(e employeecontract.Status) *EmployeeContractUpdateOne {
+	ecuo.mutation.SetStatus(e)
+	return ecuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecuo *EmployeeContractUpdateOne) SetNillableStatus(e *employeecontract.Status) *EmployeeContractUpdateOne {
+	if e != nil {
+		ecuo.SetStatus(*e)
+	}
+	return ecuo
+}
+
+// SetStartAt sets the "start_at" field.
+func (ecuo *EmployeeContractUpdateOne) SetStartAt(t time.Time) *EmployeeContractUpdateOne {
+	ecuo.mutation.SetStartAt(t)
+	return ecuo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (ecuo *EmployeeContractUpdateOne) SetNillableStartAt(t *time.Time) *EmployeeContractUpdateOne {
+	if t != nil {
+		ecuo.SetStartAt(*t)
+	}
+	return ecuo
+}
+
+// SetEndAt sets the "end_at" field.
+func (ecuo *EmployeeContractUpdateOne) SetEndAt(t time.Time) *EmployeeContractUpdateOne {
+	ecuo.mutation.SetEndAt(t)
+	return ecuo
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (ecuo *EmployeeContractUpdateOne) SetNillableEndAt(t *time.Time) *EmployeeContractUpdateOne {
+	if t != nil {
+		ecuo.SetEndAt(*t)
+	}
+	return ecuo
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (ecuo *EmployeeContractUpdateOne) ClearEndAt() *EmployeeContractUpdateOne {
+	ecuo.mutation.ClearEndAt()
+	return ecuo
+}
+
+// SetNote sets the "note" field.
+func (ecuo *EmployeeContractUpdateOne) SetNote(s string) *EmployeeContractUpdateOne {
+	ecuo.mutation.SetNote(s)
+	return ecuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ecuo *EmployeeContractUpdateOne) SetNillableNote(s *string) *EmployeeContractUpdateOne {
+	if s != nil {
+		ecuo.SetNote(*s)
+	}
+	return ecuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (ecuo *EmployeeContractUpdateOne) ClearNote() *EmployeeContractUpdateOne {
+	ecuo.mutation.ClearNote()
+	return ecuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ecuo *EmployeeContractUpdateOne) SetUpdatedAt(t time.Time) *EmployeeContractUpdateOne {
+	ecuo.mutation.SetUpdatedAt(t)
+	return ecuo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (ecuo *EmployeeContractUpdateOne) SetEmployee(e *Employee) *EmployeeContractUpdateOne {
+	return ecuo.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the EmployeeContractMutation object of the builder.
+func (ecuo *EmployeeContractUpdateOne) Mutation() *EmployeeContractMutation {
+	return ecuo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (ecuo *EmployeeContractUpdateOne) ClearEmployee() *EmployeeContractUpdateOne {
+	ecuo.mutation.ClearEmployee()
+	return ecuo
+}
+
+// Where appends a list predicates to the EmployeeContractUpdate builder.
+func (ecuo *EmployeeContractUpdateOne) Where(ps ...predicate.EmployeeContract) *EmployeeContractUpdateOne {
+	ecuo.mutation.Where(ps...)
+	return ecuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ecuo *EmployeeContractUpdateOne) Select(field string, fields ...string) *EmployeeContractUpdateOne {
+	ecuo.fields = append([]string{field}, fields...)
+	return ecuo
+}
+
+// Save executes the query and returns the updated EmployeeContract entity.
+func (ecuo *EmployeeContractUpdateOne) Save(ctx context.Context) (*EmployeeContract, error) {
+	ecuo.defaults()
+	return withHooks(ctx, ecuo.sqlSave, ecuo.mutation, ecuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecuo *EmployeeContractUpdateOne) SaveX(ctx context.Context) *EmployeeContract {
+	node, err := ecuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ecuo *EmployeeContractUpdateOne) Exec(ctx context.Context) error {
+	_, err := ecuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecuo *EmployeeContractUpdateOne) ExecX(ctx context.Context) {
+	if err := ecuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecuo *EmployeeContractUpdateOne) defaults() {
+	if _, ok := ecuo.mutation.UpdatedAt(); !ok {
+		v := employeecontract.UpdateDefaultUpdatedAt()
+		ecuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecuo *EmployeeContractUpdateOne) check() error {
+	if v, ok := ecuo.mutation.Code(); ok {
+		if err := employeecontract.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.code": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.GetType(); ok {
+		if err := employeecontract.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.type": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.Status(); ok {
+		if err := employeecontract.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmployeeContract.status": %w`, err)}
+		}
+	}
+	if ecuo.mutation.EmployeeCleared() && len(ecuo.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmployeeContract.employee"`)
+	}
+	return nil
+}
+
+func (ecuo *EmployeeContractUpdateOne) sqlSave(ctx context.Context) (_node *EmployeeContract, err error) {
+	if err := ecuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(employeecontract.Table, employeecontract.Columns, sqlgraph.NewFieldSpec(employeecontract.FieldID, field.TypeInt))
+	id, ok := ecuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmployeeContract.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ecuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, employeecontract.FieldID)
+		for _, f := range fields {
+			if !employeecontract.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != employeecontract.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ecuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecuo.mutation.Code(); ok {
+		_spec.SetField(employeecontract.FieldCode, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.GetType(); ok {
+		_spec.SetField(employeecontract.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ecuo.mutation.Status(); ok {
+		_spec.SetField(employeecontract.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ecuo.mutation.StartAt(); ok {
+		_spec.SetField(employeecontract.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := ecuo.mutation.EndAt(); ok {
+		_spec.SetField(employeecontract.FieldEndAt, field.TypeTime, value)
+	}
+	if ecuo.mutation.EndAtCleared() {
+		_spec.ClearField(employeecontract.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := ecuo.mutation.Note(); ok {
+		_spec.SetField(employeecontract.FieldNote, field.TypeString, value)
+	}
+	if ecuo.mutation.NoteCleared() {
+		_spec.ClearField(employeecontract.FieldNote, field.TypeString)
+	}
+	if value, ok := ecuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(employeecontract.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ecuo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeecontract.EmployeeTable,
+			Columns: []string{employeecontract.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ecuo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeecontract.EmployeeTable,
+			Columns: []string{employeecontract.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmployeeContract{config: ecuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ecuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employeecontract.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ecuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+)
+
+// EmployeeStatusHistory is the model entity for the EmployeeStatusHistory schema.
+type EmployeeStatusHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status"`
+	// ChangedBy holds the value of the "changed_by" field.
+	ChangedBy int `json:"changed_by"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt time.Time `json:"changed_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmployeeStatusHistoryQuery when eager-loading is set.
+	Edges        EmployeeStatusHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmployeeStatusHistoryEdges holds the relations/edges for other nodes in the graph.
+type EmployeeStatusHistoryEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmployeeStatusHistoryEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmployeeStatusHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case employeestatushistory.FieldID, employeestatushistory.FieldEmployeeID, employeestatushistory.FieldChangedBy:
+			values[i] = new(sql.NullInt64)
+		case employeestatushistory.FieldFromStatus, employeestatushistory.FieldToStatus, employeestatushistory.FieldNote:
+			values[i] = new(sql.NullString)
+		case employeestatushistory.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmployeeStatusHistory fields.
+func (esh *EmployeeStatusHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case employeestatushistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			esh.ID = int(value.Int64)
+		case employeestatushistory.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				esh.EmployeeID = int(value.Int64)
+			}
+		case employeestatushistory.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				esh.FromStatus = value.String
+			}
+		case employeestatushistory.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				esh.ToStatus = value.String
+			}
+		case employeestatushistory.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				esh.ChangedBy = int(value.Int64)
+			}
+		case employeestatushistory.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				esh.Note = value.String
+			}
+		case employeestatushistory.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				esh.ChangedAt = value.Time
+			}
+		default:
+			esh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmployeeStatusHistory.
+// This includes values selected through modifiers, order, etc.
+func (esh *EmployeeStatusHistory) Value(name string) (ent.Value, error) {
+	return esh.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the EmployeeStatusHistory entity.
+func (esh *EmployeeStatusHistory) QueryEmployee() *EmployeeQuery {
+	return NewEmployeeStatusHistoryClient(esh.config).QueryEmployee(esh)
+}
+
+// Update returns a builder for updating this EmployeeStatusHistory.
+// Note that you need to call EmployeeStatusHistory.Unwrap() before calling this method if this EmployeeStatusHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (esh *EmployeeStatusHistory) Update() *EmployeeStatusHistoryUpdateOne {
+	return NewEmployeeStatusHistoryClient(esh.config).UpdateOne(esh)
+}
+
+// Unwrap unwraps the EmployeeStatusHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (esh *EmployeeStatusHistory) Unwrap() *EmployeeStatusHistory {
+	_tx, ok := esh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmployeeStatusHistory is not a transactional entity")
+	}
+	esh.config.driver = _tx.drv
+	return esh
+}
+
+// String implements the fmt.Stringer.
+func (esh *EmployeeStatusHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmployeeStatusHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", esh.ID))
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", esh.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(esh.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(esh.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("changed_by=")
+	builder.WriteString(fmt.Sprintf("%v", esh.ChangedBy))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(esh.Note)
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(esh.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmployeeStatusHistories is a parsable slice of EmployeeStatusHistory.
+type EmployeeStatusHistories []*EmployeeStatusHistory
@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package employeestatushistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLTE(FieldID, id))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// FromStatus applies equality check predicate on the "from_status" field. It's identical to FromStatusEQ.
+func FromStatus(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// ToStatus applies equality check predicate on the "to_status" field. It's identical to ToStatusEQ.
+func ToStatus(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ChangedBy applies equality check predicate on the "changed_by" field. It's identical to ChangedByEQ.
+func ChangedBy(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldNote, v))
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusGT applies the GT predicate on the "from_status" field.
+func FromStatusGT(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGT(FieldFromStatus, v))
+}
+
+// FromStatusGTE applies the GTE predicate on the "from_status" field.
+func FromStatusGTE(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGTE(FieldFromStatus, v))
+}
+
+// FromStatusLT applies the LT predicate on the "from_status" field.
+func FromStatusLT(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLT(FieldFromStatus, v))
+}
+
+// FromStatusLTE applies the LTE predicate on the "from_status" field.
+func FromStatusLTE(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLTE(FieldFromStatus, v))
+}
+
+// FromStatusContains applies the Contains predicate on the "from_status" field.
+func FromStatusContains(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldContains(FieldFromStatus, v))
+}
+
+// FromStatusHasPrefix applies the HasPrefix predicate on the "from_status" field.
+func FromStatusHasPrefix(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldHasPrefix(FieldFromStatus, v))
+}
+
+// FromStatusHasSuffix applies the HasSuffix predicate on the "from_status" field.
+func FromStatusHasSuffix(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldHasSuffix(FieldFromStatus, v))
+}
+
+// FromStatusEqualFold applies the EqualFold predicate on the "from_status" field.
+func FromStatusEqualFold(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEqualFold(FieldFromStatus, v))
+}
+
+// FromStatusContainsFold applies the ContainsFold predicate on the "from_status" field.
+func FromStatusContainsFold(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldContainsFold(FieldFromStatus, v))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ToStatusGT applies the GT predicate on the "to_status" field.
+func ToStatusGT(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGT(FieldToStatus, v))
+}
+
+// ToStatusGTE applies the GTE predicate on the "to_status" field.
+func ToStatusGTE(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGTE(FieldToStatus, v))
+}
+
+// ToStatusLT applies the LT predicate on the "to_status" field.
+func ToStatusLT(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLT(FieldToStatus, v))
+}
+
+// ToStatusLTE applies the LTE predicate on the "to_status" field.
+func ToStatusLTE(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLTE(FieldToStatus, v))
+}
+
+// ToStatusContains applies the Contains predicate on the "to_status" field.
+func ToStatusContains(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldContains(FieldToStatus, v))
+}
+
+// ToStatusHasPrefix applies the HasPrefix predicate on the "to_status" field.
+func ToStatusHasPrefix(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldHasPrefix(FieldToStatus, v))
+}
+
+// ToStatusHasSuffix applies the HasSuffix predicate on the "to_status" field.
+func ToStatusHasSuffix(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldHasSuffix(FieldToStatus, v))
+}
+
+// ToStatusEqualFold applies the EqualFold predicate on the "to_status" field.
+func ToStatusEqualFold(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEqualFold(FieldToStatus, v))
+}
+
+// ToStatusContainsFold applies the ContainsFold predicate on the "to_status" field.
+func ToStatusContainsFold(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldContainsFold(FieldToStatus, v))
+}
+
+// ChangedByEQ applies the EQ predicate on the "changed_by" field.
+func ChangedByEQ(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedByNEQ applies the NEQ predicate on the "changed_by" field.
+func ChangedByNEQ(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNEQ(FieldChangedBy, v))
+}
+
+// ChangedByIn applies the In predicate on the "changed_by" field.
+func ChangedByIn(vs ...int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIn(FieldChangedBy, vs...))
+}
+
+// ChangedByNotIn applies the NotIn predicate on the "changed_by" field.
+func ChangedByNotIn(vs ...int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotIn(FieldChangedBy, vs...))
+}
+
+// ChangedByGT applies the GT predicate on the "changed_by" field.
+func ChangedByGT(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGT(FieldChangedBy, v))
+}
+
+// ChangedByGTE applies the GTE predicate on the "changed_by" field.
+func ChangedByGTE(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGTE(FieldChangedBy, v))
+}
+
+// ChangedByLT applies the LT predicate on the "changed_by" field.
+func ChangedByLT(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLT(FieldChangedBy, v))
+}
+
+// ChangedByLTE applies the LTE predicate on the "changed_by" field.
+func ChangedByLTE(v int) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLTE(FieldChangedBy, v))
+}
+
+// ChangedByIsNil applies the IsNil predicate on the "changed_by" field.
+func ChangedByIsNil() predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIsNull(FieldChangedBy))
+}
+
+// ChangedByNotNil applies the NotNil predicate on the "changed_by" field.
+func ChangedByNotNil() predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotNull(FieldChangedBy))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldContainsFold(FieldNote, v))
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmployeeStatusHistory) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmployeeStatusHistory) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmployeeStatusHistory) predicate.EmployeeStatusHistory {
+	return predicate.EmployeeStatusHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,802 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+)
+
+// EmployeeStatusHistoryCreate is the builder for creating a EmployeeStatusHistory entity.
+type EmployeeStatusHistoryCreate struct {
+	config
+	mutation *EmployeeStatusHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (eshc *EmployeeStatusHistoryCreate) SetEmployeeID(i int) *EmployeeStatusHistoryCreate {
+	eshc.mutation.SetEmployeeID(i)
+	return eshc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (eshc *EmployeeStatusHistoryCreate) SetFromStatus(s string) *EmployeeStatusHistoryCreate {
+	eshc.mutation.SetFromStatus(s)
+	return eshc
+}
+
+// SetToStatus sets the "to_status" field.
+func (eshc *EmployeeStatusHistoryCreate) SetToStatus(s string) *EmployeeStatusHistoryCreate {
+	eshc.mutation.SetToStatus(s)
+	return eshc
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (eshc *EmployeeStatusHistoryCreate) SetChangedBy(i int) *EmployeeStatusHistoryCreate {
+	eshc.mutation.SetChangedBy(i)
+	return eshc
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (eshc *EmployeeStatusHistoryCreate) SetNillableChangedBy(i *int) *EmployeeStatusHistoryCreate {
+	if i != nil {
+		eshc.SetChangedBy(*i)
+	}
+	return eshc
+}
+
+// SetNote sets the "note" field.
+func (eshc *EmployeeStatusHistoryCreate) SetNote(s string) *EmployeeStatusHistoryCreate {
+	eshc.mutation.SetNote(s)
+	return eshc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (eshc *EmployeeStatusHistoryCreate) SetNillableNote(s *string) *EmployeeStatusHistoryCreate {
+	if s != nil {
+		eshc.SetNote(*s)
+	}
+	return eshc
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (eshc *EmployeeStatusHistoryCreate) SetChangedAt(t time.Time) *EmployeeStatusHistoryCreate {
+	eshc.mutation.SetChangedAt(t)
+	return eshc
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (eshc *EmployeeStatusHistoryCreate) SetNillableChangedAt(t *time.Time) *EmployeeStatusHistoryCreate {
+	if t != nil {
+		eshc.SetChangedAt(*t)
+	}
+	return eshc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (eshc *EmployeeStatusHistoryCreate) SetEmployee(e *Employee) *EmployeeStatusHistoryCreate {
+	return eshc.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the EmployeeStatusHistoryMutation object of the builder.
+func (eshc *EmployeeStatusHistoryCreate) Mutation() *EmployeeStatusHistoryMutation {
+	return eshc.mutation
+}
+
+// Save creates the EmployeeStatusHistory in the database.
+func (eshc *EmployeeStatusHistoryCreate) Save(ctx context.Context) (*EmployeeStatusHistory, error) {
+	eshc.defaults()
+	return withHooks(ctx, eshc.sqlSave, eshc.mutation, eshc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eshc *EmployeeStatusHistoryCreate) SaveX(ctx context.Context) *EmployeeStatusHistory {
+	v, err := eshc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eshc *EmployeeStatusHistoryCreate) Exec(ctx context.Context) error {
+	_, err := eshc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eshc *EmployeeStatusHistoryCreate) ExecX(ctx context.Context) {
+	if err := eshc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eshc *EmployeeStatusHistoryCreate) defaults() {
+	if _, ok := eshc.mutation.ChangedAt(); !ok {
+		v := employeestatushistory.DefaultChangedAt()
+		eshc.mutation.SetChangedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eshc *EmployeeStatusHistoryCreate) check() error {
+	if _, ok := eshc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "EmployeeStatusHistory.employee_id"`)}
+	}
+	if _, ok := eshc.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "EmployeeStatusHistory.from_status"`)}
+	}
+	if _, ok := eshc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "EmployeeStatusHistory.to_status"`)}
+	}
+	if _, ok := eshc.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "EmployeeStatusHistory.changed_at"`)}
+	}
+	if len(eshc.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "EmployeeStatusHistory.employee"`)}
+	}
+	return nil
+}
+
+func (eshc *EmployeeStatusHistoryCreate) sqlSave(ctx context.Context) (*EmployeeStatusHistory, error) {
+	if err := eshc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eshc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eshc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	eshc.mutation.id = &_node.ID
+	eshc.mutation.done = true
+	return _node, nil
+}
+
+func (eshc *EmployeeStatusHistoryCreate) createSpec() (*EmployeeStatusHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmployeeStatusHistory{config: eshc.config}
+		_spec = sqlgraph.NewCreateSpec(employeestatushistory.Table, sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = eshc.conflict
+	if value, ok := eshc.mutation.FromStatus(); ok {
+		_spec.SetField(employeestatushistory.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := eshc.mutation.ToStatus(); ok {
+		_spec.SetField(employeestatushistory.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := eshc.mutation.ChangedBy(); ok {
+		_spec.SetField(employeestatushistory.FieldChangedBy, field.TypeInt, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := eshc.mutation.Note(); ok {
+		_spec.SetField(employeestatushistory.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := eshc.mutation.ChangedAt(); ok {
+		_spec.SetField(employeestatushistory.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if nodes := eshc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeestatushistory.EmployeeTable,
+			Columns: []string{employeestatushistory.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmployeeStatusHistory.Create().
+//		SetEmployeeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmployeeStatusHistoryUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (eshc *EmployeeStatusHistoryCreate) OnConflict(opts ...sql.ConflictOption) *EmployeeStatusHistoryUpsertOne {
+	eshc.conflict = opts
+	return &EmployeeStatusHistoryUpsertOne{
+		create: eshc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmployeeStatusHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eshc *EmployeeStatusHistoryCreate) OnConflictColumns(columns ...string) *EmployeeStatusHistoryUpsertOne {
+	eshc.conflict = append(eshc.conflict, sql.ConflictColumns(columns...))
+	return &EmployeeStatusHistoryUpsertOne{
+		create: eshc,
+	}
+}
+
+type (
+	// EmployeeStatusHistoryUpsertOne is the builder for "upsert"-ing
+	//  one EmployeeStatusHistory node.
+	EmployeeStatusHistoryUpsertOne struct {
+		create *EmployeeStatusHistoryCreate
+	}
+
+	// EmployeeStatusHistoryUpsert is the "OnConflict" setter.
+	EmployeeStatusHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *EmployeeStatusHistoryUpsert) SetEmployeeID(v int) *EmployeeStatusHistoryUpsert {
+	u.Set(employeestatushistory.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsert) UpdateEmployeeID() *EmployeeStatusHistoryUpsert {
+	u.SetExcluded(employeestatushistory.FieldEmployeeID)
+	return u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *EmployeeStatusHistoryUpsert) SetFromStatus(v string) *EmployeeStatusHistoryUpsert {
+	u.Set(employeestatushistory.FieldFromStatus, v)
+	return u
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsert) UpdateFromStatus() *EmployeeStatusHistoryUpsert {
+	u.SetExcluded(employeestatushistory.FieldFromStatus)
+	return u
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *EmployeeStatusHistoryUpsert) SetToStatus(v string) *EmployeeStatusHistoryUpsert {
+	u.Set(employeestatushistory.FieldToStatus, v)
+	return u
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsert) UpdateToStatus() *EmployeeStatusHistoryUpsert {
+	u.SetExcluded(employeestatushistory.FieldToStatus)
+	return u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsert) SetChangedBy(v int) *EmployeeStatusHistoryUpsert {
+	u.Set(employeestatushistory.FieldChangedBy, v)
+	return u
+}
+
+// UpdateChangedBy sets the "changed_by" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsert) UpdateChangedBy() *EmployeeStatusHistoryUpsert {
+	u.SetExcluded(employeestatushistory.FieldChangedBy)
+	return u
+}
+
+// AddChangedBy adds v to the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsert) AddChangedBy(v int) *EmployeeStatusHistoryUpsert {
+	u.Add(employeestatushistory.FieldChangedBy, v)
+	return u
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsert) ClearChangedBy() *EmployeeStatusHistoryUpsert {
+	u.SetNull(employeestatushistory.FieldChangedBy)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *EmployeeStatusHistoryUpsert) SetNote(v string) *EmployeeStatusHistoryUpsert {
+	u.Set(employeestatushistory.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsert) UpdateNote() *EmployeeStatusHistoryUpsert {
+	u.SetExcluded(employeestatushistory.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EmployeeStatusHistoryUpsert) ClearNote() *EmployeeStatusHistoryUpsert {
+	u.SetNull(employeestatushistory.FieldNote)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EmployeeStatusHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EmployeeStatusHistoryUpsertOne) UpdateNewValues() *EmployeeStatusHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ChangedAt(); exists {
+			s.SetIgnore(employeestatushistory.FieldChangedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmployeeStatusHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmployeeStatusHistoryUpsertOne) Ignore() *EmployeeStatusHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmployeeStatusHistoryUpsertOne) DoNothing() *EmployeeStatusHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmployeeStatusHistoryCreate.OnConflict
+// documentation for more info.
+func (u *EmployeeStatusHistoryUpsertOne) Update(set func(*EmployeeStatusHistoryUpsert)) *EmployeeStatusHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmployeeStatusHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *EmployeeStatusHistoryUpsertOne) SetEmployeeID(v int) *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertOne) UpdateEmployeeID() *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *EmployeeStatusHistoryUpsertOne) SetFromStatus(v string) *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetFromStatus(v)
+	})
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertOne) UpdateFromStatus() *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateFromStatus()
+	})
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *EmployeeStatusHistoryUpsertOne) SetToStatus(v string) *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetToStatus(v)
+	})
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertOne) UpdateToStatus() *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateToStatus()
+	})
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsertOne) SetChangedBy(v int) *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetChangedBy(v)
+	})
+}
+
+// AddChangedBy adds v to the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsertOne) AddChangedBy(v int) *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.AddChangedBy(v)
+	})
+}
+
+// UpdateChangedBy sets the "changed_by" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertOne) UpdateChangedBy() *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateChangedBy()
+	})
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsertOne) ClearChangedBy() *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.ClearChangedBy()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *EmployeeStatusHistoryUpsertOne) SetNote(v string) *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertOne) UpdateNote() *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EmployeeStatusHistoryUpsertOne) ClearNote() *EmployeeStatusHistoryUpsertOne {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *EmployeeStatusHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmployeeStatusHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmployeeStatusHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmployeeStatusHistoryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmployeeStatusHistoryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmployeeStatusHistoryCreateBulk is the builder for creating many EmployeeStatusHistory entities in bulk.
+type EmployeeStatusHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*EmployeeStatusHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmployeeStatusHistory entities in the database.
+func (eshcb *EmployeeStatusHistoryCreateBulk) Save(ctx context.Context) ([]*EmployeeStatusHistory, error) {
+	if eshcb.err != nil {
+		return nil, eshcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eshcb.builders))
+	nodes := make([]*EmployeeStatusHistory, len(eshcb.builders))
+	mutators := make([]Mutator, len(eshcb.builders))
+	for i := range eshcb.builders {
+		func(i int, root context.Context) {
+			builder := eshcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmployeeStatusHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eshcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = eshcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eshcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eshcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eshcb *EmployeeStatusHistoryCreateBulk) SaveX(ctx context.Context) []*EmployeeStatusHistory {
+	v, err := eshcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eshcb *EmployeeStatusHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := eshcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eshcb *EmployeeStatusHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := eshcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmployeeStatusHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmployeeStatusHistoryUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (eshcb *EmployeeStatusHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmployeeStatusHistoryUpsertBulk {
+	eshcb.conflict = opts
+	return &EmployeeStatusHistoryUpsertBulk{
+		create: eshcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmployeeStatusHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eshcb *EmployeeStatusHistoryCreateBulk) OnConflictColumns(columns ...string) *EmployeeStatusHistoryUpsertBulk {
+	eshcb.conflict = append(eshcb.conflict, sql.ConflictColumns(columns...))
+	return &EmployeeStatusHistoryUpsertBulk{
+		create: eshcb,
+	}
+}
+
+// EmployeeStatusHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmployeeStatusHistory nodes.
+type EmployeeStatusHistoryUpsertBulk struct {
+	create *EmployeeStatusHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmployeeStatusHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EmployeeStatusHistoryUpsertBulk) UpdateNewValues() *EmployeeStatusHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ChangedAt(); exists {
+				s.SetIgnore(employeestatushistory.FieldChangedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmployeeStatusHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmployeeStatusHistoryUpsertBulk) Ignore() *EmployeeStatusHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmployeeStatusHistoryUpsertBulk) DoNothing() *EmployeeStatusHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmployeeStatusHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmployeeStatusHistoryUpsertBulk) Update(set func(*EmployeeStatusHistoryUpsert)) *EmployeeStatusHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmployeeStatusHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *EmployeeStatusHistoryUpsertBulk) SetEmployeeID(v int) *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertBulk) UpdateEmployeeID() *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *EmployeeStatusHistoryUpsertBulk) SetFromStatus(v string) *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetFromStatus(v)
+	})
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertBulk) UpdateFromStatus() *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateFromStatus()
+	})
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *EmployeeStatusHistoryUpsertBulk) SetToStatus(v string) *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetToStatus(v)
+	})
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertBulk) UpdateToStatus() *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateToStatus()
+	})
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsertBulk) SetChangedBy(v int) *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetChangedBy(v)
+	})
+}
+
+// AddChangedBy adds v to the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsertBulk) AddChangedBy(v int) *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.AddChangedBy(v)
+	})
+}
+
+// UpdateChangedBy sets the "changed_by" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertBulk) UpdateChangedBy() *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateChangedBy()
+	})
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (u *EmployeeStatusHistoryUpsertBulk) ClearChangedBy() *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.ClearChangedBy()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *EmployeeStatusHistoryUpsertBulk) SetNote(v string) *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EmployeeStatusHistoryUpsertBulk) UpdateNote() *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EmployeeStatusHistoryUpsertBulk) ClearNote() *EmployeeStatusHistoryUpsertBulk {
+	return u.Update(func(s *EmployeeStatusHistoryUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *EmployeeStatusHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmployeeStatusHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmployeeStatusHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmployeeStatusHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
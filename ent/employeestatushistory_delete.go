@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// EmployeeStatusHistoryDelete is the builder for deleting a EmployeeStatusHistory entity.
+type EmployeeStatusHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmployeeStatusHistoryMutation
+}
+
+// Where appends a list predicates to the EmployeeStatusHistoryDelete builder.
+func (eshd *EmployeeStatusHistoryDelete) Where(ps ...predicate.EmployeeStatusHistory) *EmployeeStatusHistoryDelete {
+	eshd.mutation.Where(ps...)
+	return eshd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (eshd *EmployeeStatusHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, eshd.sqlExec, eshd.mutation, eshd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eshd *EmployeeStatusHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := eshd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (eshd *EmployeeStatusHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(employeestatushistory.Table, sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt))
+	if ps := eshd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, eshd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	eshd.mutation.done = true
+	return affected, err
+}
+
+// EmployeeStatusHistoryDeleteOne is the builder for deleting a single EmployeeStatusHistory entity.
+type EmployeeStatusHistoryDeleteOne struct {
+	eshd *EmployeeStatusHistoryDelete
+}
+
+// Where appends a list predicates to the EmployeeStatusHistoryDelete builder.
+func (eshdo *EmployeeStatusHistoryDeleteOne) Where(ps ...predicate.EmployeeStatusHistory) *EmployeeStatusHistoryDeleteOne {
+	eshdo.eshd.mutation.Where(ps...)
+	return eshdo
+}
+
+// Exec executes the deletion query.
+func (eshdo *EmployeeStatusHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := eshdo.eshd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{employeestatushistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eshdo *EmployeeStatusHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := eshdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
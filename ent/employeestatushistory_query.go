@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// EmployeeStatusHistoryQuery is the builder for querying EmployeeStatusHistory entities.
+type EmployeeStatusHistoryQuery struct {
+	config
+	ctx          *QueryContext
+	order        []employeestatushistory.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.EmployeeStatusHistory
+	withEmployee *EmployeeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmployeeStatusHistoryQuery builder.
+func (eshq *EmployeeStatusHistoryQuery) Where(ps ...predicate.EmployeeStatusHistory) *EmployeeStatusHistoryQuery {
+	eshq.predicates = append(eshq.predicates, ps...)
+	return eshq
+}
+
+// Limit the number of records to be returned by this query.
+func (eshq *EmployeeStatusHistoryQuery) Limit(limit int) *EmployeeStatusHistoryQuery {
+	eshq.ctx.Limit = &limit
+	return eshq
+}
+
+// Offset to start from.
+func (eshq *EmployeeStatusHistoryQuery) Offset(offset int) *EmployeeStatusHistoryQuery {
+	eshq.ctx.Offset = &offset
+	return eshq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eshq *EmployeeStatusHistoryQuery) Unique(unique bool) *EmployeeStatusHistoryQuery {
+	eshq.ctx.Unique = &unique
+	return eshq
+}
+
+// Order specifies how the records should be ordered.
+func (eshq *EmployeeStatusHistoryQuery) Order(o ...employeestatushistory.OrderOption) *EmployeeStatusHistoryQuery {
+	eshq.order = append(eshq.order, o...)
+	return eshq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (eshq *EmployeeStatusHistoryQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: eshq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eshq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eshq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employeestatushistory.Table, employeestatushistory.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, employeestatushistory.EmployeeTable, employeestatushistory.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eshq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EmployeeStatusHistory entity from the query.
+// Returns a *NotFoundError when no EmployeeStatusHistory was found.
+func (eshq *EmployeeStatusHistoryQuery) First(ctx context.Context) (*EmployeeStatusHistory, error) {
+	nodes, err := eshq.Limit(1).All(setContextOp(ctx, eshq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{employeestatushistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eshq *EmployeeStatusHistoryQuery) FirstX(ctx context.Context) *EmployeeStatusHistory {
+	node, err := eshq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmployeeStatusHistory ID from the query.
+// Returns a *NotFoundError when no EmployeeStatusHistory ID was found.
+func (eshq *EmployeeStatusHistoryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eshq.Limit(1).IDs(setContextOp(ctx, eshq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{employeestatushistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eshq *EmployeeStatusHistoryQuery) FirstIDX(ctx context.Context) int {
+	id, err := eshq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmployeeStatusHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmployeeStatusHistory entity is found.
+// Returns a *NotFoundError when no EmployeeStatusHistory entities are found.
+func (eshq *EmployeeStatusHistoryQuery) Only(ctx context.Context) (*EmployeeStatusHistory, error) {
+	nodes, err := eshq.Limit(2).All(setContextOp(ctx, eshq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{employeestatushistory.Label}
+	default:
+		return nil, &NotSingularError{employeestatushistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eshq *EmployeeStatusHistoryQuery) OnlyX(ctx context.Context) *EmployeeStatusHistory {
+	node, err := eshq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmployeeStatusHistory ID in the query.
+// Returns a *NotSingularError when more than one EmployeeStatusHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eshq *EmployeeStatusHistoryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = eshq.Limit(2).IDs(setContextOp(ctx, eshq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{employeestatushistory.Label}
+	default:
+		err = &NotSingularError{employeestatushistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eshq *EmployeeStatusHistoryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := eshq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmployeeStatusHistories.
+func (eshq *EmployeeStatusHistoryQuery) All(ctx context.Context) ([]*EmployeeStatusHistory, error) {
+	ctx = setContextOp(ctx, eshq.ctx, ent.OpQueryAll)
+	if err := eshq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmployeeStatusHistory, *EmployeeStatusHistoryQuery]()
+	return withInterceptors[[]*EmployeeStatusHistory](ctx, eshq, qr, eshq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eshq *EmployeeStatusHistoryQuery) AllX(ctx context.Context) []*EmployeeStatusHistory {
+	nodes, err := eshq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmployeeStatusHistory IDs.
+func (eshq *EmployeeStatusHistoryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if eshq.ctx.Unique == nil && eshq.path != nil {
+		eshq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eshq.ctx, ent.OpQueryIDs)
+	if err = eshq.Select(employeestatushistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eshq *EmployeeStatusHistoryQuery) IDsX(ctx context.Context) []int {
+	ids, err := eshq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eshq *EmployeeStatusHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eshq.ctx, ent.OpQueryCount)
+	if err := eshq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eshq, querierCount[*EmployeeStatusHistoryQuery](), eshq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eshq *EmployeeStatusHistoryQuery) CountX(ctx context.Context) int {
+	count, err := eshq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eshq *EmployeeStatusHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eshq.ctx, ent.OpQueryExist)
+	switch _, err := eshq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eshq *EmployeeStatusHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := eshq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmployeeStatusHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eshq *EmployeeStatusHistoryQuery) Clone() *EmployeeStatusHistoryQuery {
+	if eshq == nil {
+		return nil
+	}
+	return &EmployeeStatusHistoryQuery{
+		config:       eshq.config,
+		ctx:          eshq.ctx.Clone(),
+		order:        append([]employeestatushistory.OrderOption{}, eshq.order...),
+		inters:       append([]Interceptor{}, eshq.inters...),
+		predicates:   append([]predicate.EmployeeStatusHistory{}, eshq.predicates...),
+		withEmployee: eshq.withEmployee.Clone(),
+		// clone intermediate query.
+		sql:  eshq.sql.Clone(),
+		path: eshq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (eshq *EmployeeStatusHistoryQuery) WithEmployee(opts ...func(*EmployeeQuery)) *EmployeeStatusHistoryQuery {
+	query := (&EmployeeClient{config: eshq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eshq.withEmployee = query
+	return eshq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID int `json:"employee_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmployeeStatusHistory.Query().
+//		GroupBy(employeestatushistory.FieldEmployeeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eshq *EmployeeStatusHistoryQuery) GroupBy(field string, fields ...string) *EmployeeStatusHistoryGroupBy {
+	eshq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmployeeStatusHistoryGroupBy{build: eshq}
+	grbuild.flds = &eshq.ctx.Fields
+	grbuild.label = employeestatushistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID int `json:"employee_id"`
+//	}
+//
+//	client.EmployeeStatusHistory.Query().
+//		Select(employeestatushistory.FieldEmployeeID).
+//		Scan(ctx, &v)
+func (eshq *EmployeeStatusHistoryQuery) Select(fields ...string) *EmployeeStatusHistorySelect {
+	eshq.ctx.Fields = append(eshq.ctx.Fields, fields...)
+	sbuild := &EmployeeStatusHistorySelect{EmployeeStatusHistoryQuery: eshq}
+	sbuild.label = employeestatushistory.Label
+	sbuild.flds, sbuild.scan = &eshq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmployeeStatusHistorySelect configured with the given aggregations.
+func (eshq *EmployeeStatusHistoryQuery) Aggregate(fns ...AggregateFunc) *EmployeeStatusHistorySelect {
+	return eshq.Select().Aggregate(fns...)
+}
+
+func (eshq *EmployeeStatusHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eshq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eshq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eshq.ctx.Fields {
+		if !employeestatushistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eshq.path != nil {
+		prev, err := eshq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eshq.sql = prev
+	}
+	return nil
+}
+
+func (eshq *EmployeeStatusHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmployeeStatusHistory, error) {
+	var (
+		nodes       = []*EmployeeStatusHistory{}
+		_spec       = eshq.querySpec()
+		loadedTypes = [1]bool{
+			eshq.withEmployee != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmployeeStatusHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmployeeStatusHistory{config: eshq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eshq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := eshq.withEmployee; query != nil {
+		if err := eshq.loadEmployee(ctx, query, nodes, nil,
+			func(n *EmployeeStatusHistory, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (eshq *EmployeeStatusHistoryQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*EmployeeStatusHistory, init func(*EmployeeStatusHistory), assign func(*EmployeeStatusHistory, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*EmployeeStatusHistory)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (eshq *EmployeeStatusHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eshq.querySpec()
+	_spec.Node.Columns = eshq.ctx.Fields
+	if len(eshq.ctx.Fields) > 0 {
+		_spec.Unique = eshq.ctx.Unique != nil && *eshq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eshq.driver, _spec)
+}
+
+func (eshq *EmployeeStatusHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(employeestatushistory.Table, employeestatushistory.Columns, sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt))
+	_spec.From = eshq.sql
+	if unique := eshq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eshq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eshq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, employeestatushistory.FieldID)
+		for i := range fields {
+			if fields[i] != employeestatushistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if eshq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(employeestatushistory.FieldEmployeeID)
+		}
+	}
+	if ps := eshq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eshq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eshq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eshq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eshq *EmployeeStatusHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eshq.driver.Dialect())
+	t1 := builder.Table(employeestatushistory.Table)
+	columns := eshq.ctx.Fields
+	if len(columns) == 0 {
+		columns = employeestatushistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eshq.sql != nil {
+		selector = eshq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eshq.ctx.Unique != nil && *eshq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range eshq.predicates {
+		p(selector)
+	}
+	for _, p := range eshq.order {
+		p(selector)
+	}
+	if offset := eshq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eshq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EmployeeStatusHistoryGroupBy is the group-by builder for EmployeeStatusHistory entities.
+type EmployeeStatusHistoryGroupBy struct {
+	selector
+	build *EmployeeStatusHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eshgb *EmployeeStatusHistoryGroupBy) Aggregate(fns ...AggregateFunc) *EmployeeStatusHistoryGroupBy {
+	eshgb.fns = append(eshgb.fns, fns...)
+	return eshgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eshgb *EmployeeStatusHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eshgb.build.ctx, ent.OpQueryGroupBy)
+	if err := eshgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmployeeStatusHistoryQuery, *EmployeeStatusHistoryGroupBy](ctx, eshgb.build, eshgb, eshgb.build.inters, v)
+}
+
+func (eshgb *EmployeeStatusHistoryGroupBy) sqlScan(ctx context.Context, root *EmployeeStatusHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eshgb.fns))
+	for _, fn := range eshgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eshgb.flds)+len(eshgb.fns))
+		for _, f := range *eshgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eshgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eshgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmployeeStatusHistorySelect is the builder for selecting fields of EmployeeStatusHistory entities.
+type EmployeeStatusHistorySelect struct {
+	*EmployeeStatusHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eshs *EmployeeStatusHistorySelect) Aggregate(fns ...AggregateFunc) *EmployeeStatusHistorySelect {
+	eshs.fns = append(eshs.fns, fns...)
+	return eshs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eshs *EmployeeStatusHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eshs.ctx, ent.OpQuerySelect)
+	if err := eshs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmployeeStatusHistoryQuery, *EmployeeStatusHistorySelect](ctx, eshs.EmployeeStatusHistoryQuery, eshs, eshs.inters, v)
+}
+
+func (eshs *EmployeeStatusHistorySelect) sqlScan(ctx context.Context, root *EmployeeStatusHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eshs.fns))
+	for _, fn := range eshs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eshs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eshs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// EmployeeStatusHistoryUpdate is the builder for updating EmployeeStatusHistory entities.
+type EmployeeStatusHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmployeeStatusHistoryMutation
+}
+
+// Where appends a list predicates to the EmployeeStatusHistoryUpdate builder.
+func (eshu *EmployeeStatusHistoryUpdate) Where(ps ...predicate.EmployeeStatusHistory) *EmployeeStatusHistoryUpdate {
+	eshu.mutation.Where(ps...)
+	return eshu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (eshu *EmployeeStatusHistoryUpdate) SetEmployeeID(i int) *EmployeeStatusHistoryUpdate {
+	eshu.mutation.SetEmployeeID(i)
+	return eshu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (eshu *EmployeeStatusHistoryUpdate) SetNillableEmployeeID(i *int) *EmployeeStatusHistoryUpdate {
+	if i != nil {
+		eshu.SetEmployeeID(*i)
+	}
+	return eshu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (eshu *EmployeeStatusHistoryUpdate) SetFromStatus(s string) *EmployeeStatusHistoryUpdate {
+	eshu.mutation.SetFromStatus(s)
+	return eshu
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (eshu *EmployeeStatusHistoryUpdate) SetNillableFromStatus(s *string) *EmployeeStatusHistoryUpdate {
+	if s != nil {
+		eshu.SetFromStatus(*s)
+	}
+	return eshu
+}
+
+// SetToStatus sets the "to_status" field.
+func (eshu *EmployeeStatusHistoryUpdate) SetToStatus(s string) *EmployeeStatusHistoryUpdate {
+	eshu.mutation.SetToStatus(s)
+	return eshu
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (eshu *EmployeeStatusHistoryUpdate) SetNillableToStatus(s *string) *EmployeeStatusHistoryUpdate {
+	if s != nil {
+		eshu.SetToStatus(*s)
+	}
+	return eshu
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (eshu *EmployeeStatusHistoryUpdate) SetChangedBy(i int) *EmployeeStatusHistoryUpdate {
+	eshu.mutation.ResetChangedBy()
+	eshu.mutation.SetChangedBy(i)
+	return eshu
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (eshu *EmployeeStatusHistoryUpdate) SetNillableChangedBy(i *int) *EmployeeStatusHistoryUpdate {
+	if i != nil {
+		eshu.SetChangedBy(*i)
+	}
+	return eshu
+}
+
+// AddChangedBy adds i to the "changed_by" field.
+func (eshu *EmployeeStatusHistoryUpdate) AddChangedBy(i int) *EmployeeStatusHistoryUpdate {
+	eshu.mutation.AddChangedBy(i)
+	return eshu
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (eshu *EmployeeStatusHistoryUpdate) ClearChangedBy() *EmployeeStatusHistoryUpdate {
+	eshu.mutation.ClearChangedBy()
+	return eshu
+}
+
+// SetNote sets the "note" field.
+func (eshu *EmployeeStatusHistoryUpdate) SetNote(s string) *EmployeeStatusHistoryUpdate {
+	eshu.mutation.SetNote(s)
+	return eshu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (eshu *EmployeeStatusHistoryUpdate) SetNillableNote(s *string) *EmployeeStatusHistoryUpdate {
+	if s != nil {
+		eshu.SetNote(*s)
+	}
+	return eshu
+}
+
+// ClearNote clears the value of the "note" field.
+func (eshu *EmployeeStatusHistoryUpdate) ClearNote() *EmployeeStatusHistoryUpdate {
+	eshu.mutation.ClearNote()
+	return eshu
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (eshu *EmployeeStatusHistoryUpdate) SetEmployee(e *Employee) *EmployeeStatusHistoryUpdate {
+	return eshu.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the EmployeeStatusHistoryMutation object of the builder.
+func (eshu *EmployeeStatusHistoryUpdate) Mutation() *EmployeeStatusHistoryMutation {
+	return eshu.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (eshu *EmployeeStatusHistoryUpdate) ClearEmployee() *EmployeeStatusHistoryUpdate {
+	eshu.mutation.ClearEmployee()
+	return eshu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eshu *EmployeeStatusHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eshu.sqlSave, eshu.mutation, eshu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eshu *EmployeeStatusHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := eshu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eshu *EmployeeStatusHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := eshu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eshu *EmployeeStatusHistoryUpdate) ExecX(ctx context.Context) {
+	if err := eshu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eshu *EmployeeStatusHistoryUpdate) check() error {
+	if eshu.mutation.EmployeeCleared() && len(eshu.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmployeeStatusHistory.employee"`)
+	}
+	return nil
+}
+
+func (eshu *EmployeeStatusHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eshu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(employeestatushistory.Table, employeestatushistory.Columns, sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt))
+	if ps := eshu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eshu.mutation.FromStatus(); ok {
+		_spec.SetField(employeestatushistory.FieldFromStatus, field.TypeString, value)
+	}
+	if value, ok := eshu.mutation.ToStatus(); ok {
+		_spec.SetField(employeestatushistory.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := eshu.mutation.ChangedBy(); ok {
+		_spec.SetField(employeestatushistory.FieldChangedBy, field.TypeInt, value)
+	}
+	if value, ok := eshu.mutation.AddedChangedBy(); ok {
+		_spec.AddField(employeestatushistory.FieldChangedBy, field.TypeInt, value)
+	}
+	if eshu.mutation.ChangedByCleared() {
+		_spec.ClearField(employeestatushistory.FieldChangedBy, field.TypeInt)
+	}
+	if value, ok := eshu.mutation.Note(); ok {
+		_spec.SetField(employeestatushistory.FieldNote, field.TypeString, value)
+	}
+	if eshu.mutation.NoteCleared() {
+		_spec.ClearField(employeestatushistory.FieldNote, field.TypeString)
+	}
+	if eshu.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeestatushistory.EmployeeTable,
+			Columns: []string{employeestatushistory.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eshu.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeestatushistory.EmployeeTable,
+			Columns: []string{employeestatushistory.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eshu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employeestatushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eshu.mutation.done = true
+	return n, nil
+}
+
+// EmployeeStatusHistoryUpdateOne is the builder for updating a single EmployeeStatusHistory entity.
+type EmployeeStatusHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmployeeStatusHistoryMutation
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetEmployeeID(i int) *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.SetEmployeeID(i)
+	return eshuo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetNillableEmployeeID(i *int) *EmployeeStatusHistoryUpdateOne {
+	if i != nil {
+		eshuo.SetEmployeeID(*i)
+	}
+	return eshuo
+}
+
+// SetFromStatus sets the "from_status" field.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetFromStatus(s string) *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.SetFromStatus(s)
+	return eshuo
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetNillableFromStatus(s *string) *EmployeeStatusHistoryUpdateOne {
+	if s != nil {
+		eshuo.SetFromStatus(*s)
+	}
+	return eshuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetToStatus(s string) *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.SetToStatus(s)
+	return eshuo
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetNillableToStatus(s *string) *EmployeeStatusHistoryUpdateOne {
+	if s != nil {
+		eshuo.SetToStatus(*s)
+	}
+	return eshuo
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetChangedBy(i int) *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.ResetChangedBy()
+	eshuo.mutation.SetChangedBy(i)
+	return eshuo
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetNillableChangedBy(i *int) *EmployeeStatusHistoryUpdateOne {
+	if i != nil {
+		eshuo.SetChangedBy(*i)
+	}
+	return eshuo
+}
+
+// AddChangedBy adds i to the "changed_by" field.
+func (eshuo *EmployeeStatusHistoryUpdateOne) AddChangedBy(i int) *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.AddChangedBy(i)
+	return eshuo
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (eshuo *EmployeeStatusHistoryUpdateOne) ClearChangedBy() *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.ClearChangedBy()
+	return eshuo
+}
+
+// SetNote sets the "note" field.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetNote(s string) *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.SetNote(s)
+	return eshuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetNillableNote(s *string) *EmployeeStatusHistoryUpdateOne {
+	if s != nil {
+		eshuo.SetNote(*s)
+	}
+	return eshuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (eshuo *EmployeeStatusHistoryUpdateOne) ClearNote() *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.ClearNote()
+	return eshuo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SetEmployee(e *Employee) *EmployeeStatusHistoryUpdateOne {
+	return eshuo.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the EmployeeStatusHistoryMutation object of the builder.
+func (eshuo *EmployeeStatusHistoryUpdateOne) Mutation() *EmployeeStatusHistoryMutation {
+	return eshuo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (eshuo *EmployeeStatusHistoryUpdateOne) ClearEmployee() *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.ClearEmployee()
+	return eshuo
+}
+
+// Where appends a list predicates to the EmployeeStatusHistoryUpdate builder.
+func (eshuo *EmployeeStatusHistoryUpdateOne) Where(ps ...predicate.EmployeeStatusHistory) *EmployeeStatusHistoryUpdateOne {
+	eshuo.mutation.Where(ps...)
+	return eshuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eshuo *EmployeeStatusHistoryUpdateOne) Select(field string, fields ...string) *EmployeeStatusHistoryUpdateOne {
+	eshuo.fields = append([]string{field}, fields...)
+	return eshuo
+}
+
+// Save executes the query and returns the updated EmployeeStatusHistory entity.
+func (eshuo *EmployeeStatusHistoryUpdateOne) Save(ctx context.Context) (*EmployeeStatusHistory, error) {
+	return withHooks(ctx, eshuo.sqlSave, eshuo.mutation, eshuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eshuo *EmployeeStatusHistoryUpdateOne) SaveX(ctx context.Context) *EmployeeStatusHistory {
+	node, err := eshuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eshuo *EmployeeStatusHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := eshuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eshuo *EmployeeStatusHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := eshuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eshuo *EmployeeStatusHistoryUpdateOne) check() error {
+	if eshuo.mutation.EmployeeCleared() && len(eshuo.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmployeeStatusHistory.employee"`)
+	}
+	return nil
+}
+
+func (eshuo *EmployeeStatusHistoryUpdateOne) sqlSave(ctx context.Context) (_node *EmployeeStatusHistory, err error) {
+	if err := eshuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(employeestatushistory.Table, employeestatushistory.Columns, sqlgraph.NewFieldSpec(employeestatushistory.FieldID, field.TypeInt))
+	id, ok := eshuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmployeeStatusHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eshuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, employeestatushistory.FieldID)
+		for _, f := range fields {
+			if !employeestatushistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != employeestatushistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eshuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eshuo.mutation.FromStatus(); ok {
+		_spec.SetField(employeestatushistory.FieldFromStatus, field.TypeString, value)
+	}
+	if value, ok := eshuo.mutation.ToStatus(); ok {
+		_spec.SetField(employeestatushistory.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := eshuo.mutation.ChangedBy(); ok {
+		_spec.SetField(employeestatushistory.FieldChangedBy, field.TypeInt, value)
+	}
+	if value, ok := eshuo.mutation.AddedChangedBy(); ok {
+		_spec.AddField(employeestatushistory.FieldChangedBy, field.TypeInt, value)
+	}
+	if eshuo.mutation.ChangedByCleared() {
+		_spec.ClearField(employeestatushistory.FieldChangedBy, field.TypeInt)
+	}
+	if value, ok := eshuo.mutation.Note(); ok {
+		_spec.SetField(employeestatushistory.FieldNote, field.TypeString, value)
+	}
+	if eshuo.mutation.NoteCleared() {
+		_spec.ClearField(employeestatushistory.FieldNote, field.TypeString)
+	}
+	if eshuo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeestatushistory.EmployeeTable,
+			Columns: []string{employeestatushistory.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eshuo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   employeestatushistory.EmployeeTable,
+			Columns: []string{employeestatushistory.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmployeeStatusHistory{config: eshuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eshuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employeestatushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eshuo.mutation.done = true
+	return _node, nil
+}
@@ -13,16 +13,47 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaveapproval"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notificationpreference"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+	"github.com/longgggwwww/hrm-ms-hr/ent/remindersetting"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -83,17 +114,48 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			appointmenthistory.Table: appointmenthistory.ValidColumn,
-			department.Table:         department.ValidColumn,
-			employee.Table:           employee.ValidColumn,
-			label.Table:              label.ValidColumn,
-			leaveapproval.Table:      leaveapproval.ValidColumn,
-			leaverequest.Table:       leaverequest.ValidColumn,
-			organization.Table:       organization.ValidColumn,
-			position.Table:           position.ValidColumn,
-			project.Table:            project.ValidColumn,
-			task.Table:               task.ValidColumn,
-			taskreport.Table:         taskreport.ValidColumn,
+			appointmenthistory.Table:     appointmenthistory.ValidColumn,
+			attachment.Table:             attachment.ValidColumn,
+			attendancecorrection.Table:   attendancecorrection.ValidColumn,
+			attendancerecord.Table:       attendancerecord.ValidColumn,
+			compensation.Table:           compensation.ValidColumn,
+			department.Table:             department.ValidColumn,
+			employee.Table:               employee.ValidColumn,
+			employeecontract.Table:       employeecontract.ValidColumn,
+			employeestatushistory.Table:  employeestatushistory.ValidColumn,
+			holiday.Table:                holiday.ValidColumn,
+			label.Table:                  label.ValidColumn,
+			leaveapproval.Table:          leaveapproval.ValidColumn,
+			leaverequest.Table:           leaverequest.ValidColumn,
+			milestone.Table:              milestone.ValidColumn,
+			notification.Table:           notification.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			organization.Table:           organization.ValidColumn,
+			overtimerequest.Table:        overtimerequest.ValidColumn,
+			position.Table:               position.ValidColumn,
+			project.Table:                project.ValidColumn,
+			projectrole.Table:            projectrole.ValidColumn,
+			remindersetting.Table:        remindersetting.ValidColumn,
+			roster.Table:                 roster.ValidColumn,
+			salarygrade.Table:            salarygrade.ValidColumn,
+			shift.Table:                  shift.ValidColumn,
+			shiftswaprequest.Table:       shiftswaprequest.ValidColumn,
+			sprint.Table:                 sprint.ValidColumn,
+			task.Table:                   task.ValidColumn,
+			taskactivity.Table:           taskactivity.ValidColumn,
+			taskcomment.Table:            taskcomment.ValidColumn,
+			taskcommentrevision.Table:    taskcommentrevision.ValidColumn,
+			taskdependency.Table:         taskdependency.ValidColumn,
+			taskrecurrence.Table:         taskrecurrence.ValidColumn,
+			taskrecurrencerun.Table:      taskrecurrencerun.ValidColumn,
+			taskreminder.Table:           taskreminder.ValidColumn,
+			taskreport.Table:             taskreport.ValidColumn,
+			tasktemplate.Table:           tasktemplate.ValidColumn,
+			taskview.Table:               taskview.ValidColumn,
+			taskwatcher.Table:            taskwatcher.ValidColumn,
+			worklog.Table:                worklog.ValidColumn,
+			workflowstatus.Table:         workflowstatus.ValidColumn,
+			workflowtransition.Table:     workflowtransition.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+)
+
+// Holiday is the model entity for the Holiday schema.
+type Holiday struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HolidayQuery when eager-loading is set.
+	Edges        HolidayEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HolidayEdges holds the relations/edges for other nodes in the graph.
+type HolidayEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HolidayEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Holiday) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case holiday.FieldID, holiday.FieldOrgID:
+			values[i] = new(sql.NullInt64)
+		case holiday.FieldName:
+			values[i] = new(sql.NullString)
+		case holiday.FieldDate, holiday.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Holiday fields.
+func (h *Holiday) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case holiday.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			h.ID = int(value.Int64)
+		case holiday.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				h.OrgID = int(value.Int64)
+			}
+		case holiday.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				h.Date = value.Time
+			}
+		case holiday.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				h.Name = value.String
+			}
+		case holiday.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				h.CreatedAt = value.Time
+			}
+		default:
+			h.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Holiday.
+// This includes values selected through modifiers, order, etc.
+func (h *Holiday) Value(name string) (ent.Value, error) {
+	return h.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the Holiday entity.
+func (h *Holiday) QueryOrganization() *OrganizationQuery {
+	return NewHolidayClient(h.config).QueryOrganization(h)
+}
+
+// Update returns a builder for updating this Holiday.
+// Note that you need to call Holiday.Unwrap() before calling this method if this Holiday
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (h *Holiday) Update() *HolidayUpdateOne {
+	return NewHolidayClient(h.config).UpdateOne(h)
+}
+
+// Unwrap unwraps the Holiday entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (h *Holiday) Unwrap() *Holiday {
+	_tx, ok := h.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Holiday is not a transactional entity")
+	}
+	h.config.driver = _tx.drv
+	return h
+}
+
+// String implements the fmt.Stringer.
+func (h *Holiday) String() string {
+	var builder strings.Builder
+	builder.WriteString("Holiday(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", h.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", h.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(h.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(h.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(h.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holidays is a parsable slice of Holiday.
+type Holidays []*Holiday
@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+)
+
+// HolidayCreate is the builder for creating a Holiday entity.
+type HolidayCreate struct {
+	config
+	mutation *HolidayMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (hc *HolidayCreate) SetOrgID(i int) *HolidayCreate {
+	hc.mutation.SetOrgID(i)
+	return hc
+}
+
+// SetDate sets the "date" field.
+func (hc *HolidayCreate) SetDate(t time.Time) *HolidayCreate {
+	hc.mutation.SetDate(t)
+	return hc
+}
+
+// SetName sets the "name" field.
+func (hc *HolidayCreate) SetName(s string) *HolidayCreate {
+	hc.mutation.SetName(s)
+	return hc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hc *HolidayCreate) SetCreatedAt(t time.Time) *HolidayCreate {
+	hc.mutation.SetCreatedAt(t)
+	return hc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hc *HolidayCreate) SetNillableCreatedAt(t *time.Time) *HolidayCreate {
+	if t != nil {
+		hc.SetCreatedAt(*t)
+	}
+	return hc
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (hc *HolidayCreate) SetOrganizationID(id int) *HolidayCreate {
+	hc.mutation.SetOrganizationID(id)
+	return hc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (hc *HolidayCreate) SetOrganization(o *Organization) *HolidayCreate {
+	return hc.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (hc *HolidayCreate) Mutation() *HolidayMutation {
+	return hc.mutation
+}
+
+// Save creates the Holiday in the database.
+func (hc *HolidayCreate) Save(ctx context.Context) (*Holiday, error) {
+	hc.defaults()
+	return withHooks(ctx, hc.sqlSave, hc.mutation, hc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hc *HolidayCreate) SaveX(ctx context.Context) *Holiday {
+	v, err := hc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hc *HolidayCreate) Exec(ctx context.Context) error {
+	_, err := hc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hc *HolidayCreate) ExecX(ctx context.Context) {
+	if err := hc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hc *HolidayCreate) defaults() {
+	if _, ok := hc.mutation.CreatedAt(); !ok {
+		v := holiday.DefaultCreatedAt()
+		hc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hc *HolidayCreate) check() error {
+	if _, ok := hc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "Holiday.org_id"`)}
+	}
+	if _, ok := hc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Holiday.date"`)}
+	}
+	if _, ok := hc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Holiday.name"`)}
+	}
+	if v, ok := hc.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if _, ok := hc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Holiday.created_at"`)}
+	}
+	if len(hc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Holiday.organization"`)}
+	}
+	return nil
+}
+
+func (hc *HolidayCreate) sqlSave(ctx context.Context) (*Holiday, error) {
+	if err := hc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	hc.mutation.id = &_node.ID
+	hc.mutation.done = true
+	return _node, nil
+}
+
+func (hc *HolidayCreate) createSpec() (*Holiday, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Holiday{config: hc.config}
+		_spec = sqlgraph.NewCreateSpec(holiday.Table, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = hc.conflict
+	if value, ok := hc.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := hc.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := hc.mutation.CreatedAt(); ok {
+		_spec.SetField(holiday.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := hc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Holiday.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HolidayUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (hc *HolidayCreate) OnConflict(opts ...sql.ConflictOption) *HolidayUpsertOne {
+	hc.conflict = opts
+	return &HolidayUpsertOne{
+		create: hc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hc *HolidayCreate) OnConflictColumns(columns ...string) *HolidayUpsertOne {
+	hc.conflict = append(hc.conflict, sql.ConflictColumns(columns...))
+	return &HolidayUpsertOne{
+		create: hc,
+	}
+}
+
+type (
+	// HolidayUpsertOne is the builder for "upsert"-ing
+	//  one Holiday node.
+	HolidayUpsertOne struct {
+		create *HolidayCreate
+	}
+
+	// HolidayUpsert is the "OnConflict" setter.
+	HolidayUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *HolidayUpsert) SetOrgID(v int) *HolidayUpsert {
+	u.Set(holiday.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateOrgID() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldOrgID)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *HolidayUpsert) SetDate(v time.Time) *HolidayUpsert {
+	u.Set(holiday.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateDate() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldDate)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *HolidayUpsert) SetName(v string) *HolidayUpsert {
+	u.Set(holiday.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateName() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldName)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *HolidayUpsert) SetCreatedAt(v time.Time) *HolidayUpsert {
+	u.Set(holiday.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateCreatedAt() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *HolidayUpsertOne) UpdateNewValues() *HolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HolidayUpsertOne) Ignore() *HolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HolidayUpsertOne) DoNothing() *HolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HolidayCreate.OnConflict
+// documentation for more info.
+func (u *HolidayUpsertOne) Update(set func(*HolidayUpsert)) *HolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HolidayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *HolidayUpsertOne) SetOrgID(v int) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateOrgID() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *HolidayUpsertOne) SetDate(v time.Time) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateDate() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HolidayUpsertOne) SetName(v string) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateName() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *HolidayUpsertOne) SetCreatedAt(v time.Time) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateCreatedAt() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *HolidayUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HolidayCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HolidayUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HolidayUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HolidayUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HolidayCreateBulk is the builder for creating many Holiday entities in bulk.
+type HolidayCreateBulk struct {
+	config
+	err      error
+	builders []*HolidayCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Holiday entities in the database.
+func (hcb *HolidayCreateBulk) Save(ctx context.Context) ([]*Holiday, error) {
+	if hcb.err != nil {
+		return nil, hcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hcb.builders))
+	nodes := make([]*Holiday, len(hcb.builders))
+	mutators := make([]Mutator, len(hcb.builders))
+	for i := range hcb.builders {
+		func(i int, root context.Context) {
+			builder := hcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HolidayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hcb *HolidayCreateBulk) SaveX(ctx context.Context) []*Holiday {
+	v, err := hcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hcb *HolidayCreateBulk) Exec(ctx context.Context) error {
+	_, err := hcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hcb *HolidayCreateBulk) ExecX(ctx context.Context) {
+	if err := hcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Holiday.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HolidayUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (hcb *HolidayCreateBulk) OnConflict(opts ...sql.ConflictOption) *HolidayUpsertBulk {
+	hcb.conflict = opts
+	return &HolidayUpsertBulk{
+		create: hcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hcb *HolidayCreateBulk) OnConflictColumns(columns ...string) *HolidayUpsertBulk {
+	hcb.conflict = append(hcb.conflict, sql.ConflictColumns(columns...))
+	return &HolidayUpsertBulk{
+		create: hcb,
+	}
+}
+
+// HolidayUpsertBulk is the builder for "upsert"-ing
+// a bulk of Holiday nodes.
+type HolidayUpsertBulk struct {
+	create *HolidayCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *HolidayUpsertBulk) UpdateNewValues() *HolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HolidayUpsertBulk) Ignore() *HolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HolidayUpsertBulk) DoNothing() *HolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HolidayCreateBulk.OnConflict
+// documentation for more info.
+func (u *HolidayUpsertBulk) Update(set func(*HolidayUpsert)) *HolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HolidayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *HolidayUpsertBulk) SetOrgID(v int) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateOrgID() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *HolidayUpsertBulk) SetDate(v time.Time) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateDate() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HolidayUpsertBulk) SetName(v string) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateName() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *HolidayUpsertBulk) SetCreatedAt(v time.Time) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateCreatedAt() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *HolidayUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HolidayCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HolidayCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HolidayUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// HolidayUpdate is the builder for updating Holiday entities.
+type HolidayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HolidayMutation
+}
+
+// Where appends a list predicates to the HolidayUpdate builder.
+func (hu *HolidayUpdate) Where(ps ...predicate.Holiday) *HolidayUpdate {
+	hu.mutation.Where(ps...)
+	return hu
+}
+
+// SetOrgID sets the "org_id" field.
+func (hu *HolidayUpdate) SetOrgID(i int) *HolidayUpdate {
+	hu.mutation.SetOrgID(i)
+	return hu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (hu *HolidayUpdate) SetNillableOrgID(i *int) *HolidayUpdate {
+	if i != nil {
+		hu.SetOrgID(*i)
+	}
+	return hu
+}
+
+// SetDate sets the "date" field.
+func (hu *HolidayUpdate) SetDate(t time.Time) *HolidayUpdate {
+	hu.mutation.SetDate(t)
+	return hu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (hu *HolidayUpdate) SetNillableDate(t *time.Time) *HolidayUpdate {
+	if t != nil {
+		hu.SetDate(*t)
+	}
+	return hu
+}
+
+// SetName sets the "name" field.
+func (hu *HolidayUpdate) SetName(s string) *HolidayUpdate {
+	hu.mutation.SetName(s)
+	return hu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (hu *HolidayUpdate) SetNillableName(s *string) *HolidayUpdate {
+	if s != nil {
+		hu.SetName(*s)
+	}
+	return hu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hu *HolidayUpdate) SetCreatedAt(t time.Time) *HolidayUpdate {
+	hu.mutation.SetCreatedAt(t)
+	return hu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hu *HolidayUpdate) SetNillableCreatedAt(t *time.Time) *HolidayUpdate {
+	if t != nil {
+		hu.SetCreatedAt(*t)
+	}
+	return hu
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (hu *HolidayUpdate) SetOrganizationID(id int) *HolidayUpdate {
+	hu.mutation.SetOrganizationID(id)
+	return hu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (hu *HolidayUpdate) SetOrganization(o *Organization) *HolidayUpdate {
+	return hu.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (hu *HolidayUpdate) Mutation() *HolidayMutation {
+	return hu.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (hu *HolidayUpdate) ClearOrganization() *HolidayUpdate {
+	hu.mutation.ClearOrganization()
+	return hu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hu *HolidayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, hu.sqlSave, hu.mutation, hu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hu *HolidayUpdate) SaveX(ctx context.Context) int {
+	affected, err := hu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hu *HolidayUpdate) Exec(ctx context.Context) error {
+	_, err := hu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hu *HolidayUpdate) ExecX(ctx context.Context) {
+	if err := hu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hu *HolidayUpdate) check() error {
+	if v, ok := hu.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if hu.mutation.OrganizationCleared() && len(hu.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Holiday.organization"`)
+	}
+	return nil
+}
+
+func (hu *HolidayUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holiday.Table, holiday.Columns, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt))
+	if ps := hu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hu.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := hu.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+	}
+	if value, ok := hu.mutation.CreatedAt(); ok {
+		_spec.SetField(holiday.FieldCreatedAt, field.TypeTime, value)
+	}
+	if hu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hu.mutation.done = true
+	return n, nil
+}
+
+// HolidayUpdateOne is the builder for updating a single Holiday entity.
+type HolidayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HolidayMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (huo *HolidayUpdateOne) SetOrgID(i int) *HolidayUpdateOne {
+	huo.mutation.SetOrgID(i)
+	return huo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (huo *HolidayUpdateOne) SetNillableOrgID(i *int) *HolidayUpdateOne {
+	if i != nil {
+		huo.SetOrgID(*i)
+	}
+	return huo
+}
+
+// SetDate sets the "date" field.
+func (huo *HolidayUpdateOne) SetDate(t time.Time) *HolidayUpdateOne {
+	huo.mutation.SetDate(t)
+	return huo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (huo *HolidayUpdateOne) SetNillableDate(t *time.Time) *HolidayUpdateOne {
+	if t != nil {
+		huo.SetDate(*t)
+	}
+	return huo
+}
+
+// SetName sets the "name" field.
+func (huo *HolidayUpdateOne) SetName(s string) *HolidayUpdateOne {
+	huo.mutation.SetName(s)
+	return huo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (huo *HolidayUpdateOne) SetNillableName(s *string) *HolidayUpdateOne {
+	if s != nil {
+		huo.SetName(*s)
+	}
+	return huo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (huo *HolidayUpdateOne) SetCreatedAt(t time.Time) *HolidayUpdateOne {
+	huo.mutation.SetCreatedAt(t)
+	return huo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (huo *HolidayUpdateOne) SetNillableCreatedAt(t *time.Time) *HolidayUpdateOne {
+	if t != nil {
+		huo.SetCreatedAt(*t)
+	}
+	return huo
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (huo *HolidayUpdateOne) SetOrganizationID(id int) *HolidayUpdateOne {
+	huo.mutation.SetOrganizationID(id)
+	return huo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (huo *HolidayUpdateOne) SetOrganization(o *Organization) *HolidayUpdateOne {
+	return huo.SetOrganizationID(o.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (huo *HolidayUpdateOne) Mutation() *HolidayMutation {
+	return huo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (huo *HolidayUpdateOne) ClearOrganization() *HolidayUpdateOne {
+	huo.mutation.ClearOrganization()
+	return huo
+}
+
+// Where appends a list predicates to the HolidayUpdate builder.
+func (huo *HolidayUpdateOne) Where(ps ...predicate.Holiday) *HolidayUpdateOne {
+	huo.mutation.Where(ps...)
+	return huo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (huo *HolidayUpdateOne) Select(field string, fields ...string) *HolidayUpdateOne {
+	huo.fields = append([]string{field}, fields...)
+	return huo
+}
+
+// Save executes the query and returns the updated Holiday entity.
+func (huo *HolidayUpdateOne) Save(ctx context.Context) (*Holiday, error) {
+	return withHooks(ctx, huo.sqlSave, huo.mutation, huo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (huo *HolidayUpdateOne) SaveX(ctx context.Context) *Holiday {
+	node, err := huo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (huo *HolidayUpdateOne) Exec(ctx context.Context) error {
+	_, err := huo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (huo *HolidayUpdateOne) ExecX(ctx context.Context) {
+	if err := huo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (huo *HolidayUpdateOne) check() error {
+	if v, ok := huo.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if huo.mutation.OrganizationCleared() && len(huo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Holiday.organization"`)
+	}
+	return nil
+}
+
+func (huo *HolidayUpdateOne) sqlSave(ctx context.Context) (_node *Holiday, err error) {
+	if err := huo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holiday.Table, holiday.Columns, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt))
+	id, ok := huo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Holiday.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := huo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, holiday.FieldID)
+		for _, f := range fields {
+			if !holiday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != holiday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := huo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := huo.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := huo.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+	}
+	if value, ok := huo.mutation.CreatedAt(); ok {
+		_spec.SetField(holiday.FieldCreatedAt, field.TypeTime, value)
+	}
+	if huo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.OrganizationTable,
+			Columns: []string{holiday.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Holiday{config: huo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, huo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	huo.mutation.done = true
+	return _node, nil
+}
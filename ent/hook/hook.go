@@ -21,6 +21,54 @@ func (f AppointmentHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AppointmentHistoryMutation", m)
 }
 
+// The AttachmentFunc type is an adapter to allow the use of ordinary
+// function as Attachment mutator.
+type AttachmentFunc func(context.Context, *ent.AttachmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttachmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttachmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttachmentMutation", m)
+}
+
+// The AttendanceCorrectionFunc type is an adapter to allow the use of ordinary
+// function as AttendanceCorrection mutator.
+type AttendanceCorrectionFunc func(context.Context, *ent.AttendanceCorrectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttendanceCorrectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttendanceCorrectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendanceCorrectionMutation", m)
+}
+
+// The AttendanceRecordFunc type is an adapter to allow the use of ordinary
+// function as AttendanceRecord mutator.
+type AttendanceRecordFunc func(context.Context, *ent.AttendanceRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttendanceRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AttendanceRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AttendanceRecordMutation", m)
+}
+
+// The CompensationFunc type is an adapter to allow the use of ordinary
+// function as Compensation mutator.
+type CompensationFunc func(context.Context, *ent.CompensationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CompensationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CompensationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CompensationMutation", m)
+}
+
 // The DepartmentFunc type is an adapter to allow the use of ordinary
 // function as Department mutator.
 type DepartmentFunc func(context.Context, *ent.DepartmentMutation) (ent.Value, error)
@@ -45,6 +93,42 @@ func (f EmployeeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmployeeMutation", m)
 }
 
+// The EmployeeContractFunc type is an adapter to allow the use of ordinary
+// function as EmployeeContract mutator.
+type EmployeeContractFunc func(context.Context, *ent.EmployeeContractMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmployeeContractFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmployeeContractMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmployeeContractMutation", m)
+}
+
+// The EmployeeStatusHistoryFunc type is an adapter to allow the use of ordinary
+// function as EmployeeStatusHistory mutator.
+type EmployeeStatusHistoryFunc func(context.Context, *ent.EmployeeStatusHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmployeeStatusHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmployeeStatusHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmployeeStatusHistoryMutation", m)
+}
+
+// The HolidayFunc type is an adapter to allow the use of ordinary
+// function as Holiday mutator.
+type HolidayFunc func(context.Context, *ent.HolidayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HolidayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HolidayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HolidayMutation", m)
+}
+
 // The LabelFunc type is an adapter to allow the use of ordinary
 // function as Label mutator.
 type LabelFunc func(context.Context, *ent.LabelMutation) (ent.Value, error)
@@ -81,6 +165,42 @@ func (f LeaveRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveRequestMutation", m)
 }
 
+// The MilestoneFunc type is an adapter to allow the use of ordinary
+// function as Milestone mutator.
+type MilestoneFunc func(context.Context, *ent.MilestoneMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MilestoneFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MilestoneMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MilestoneMutation", m)
+}
+
+// The NotificationFunc type is an adapter to allow the use of ordinary
+// function as Notification mutator.
+type NotificationFunc func(context.Context, *ent.NotificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationMutation", m)
+}
+
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as NotificationPreference mutator.
+type NotificationPreferenceFunc func(context.Context, *ent.NotificationPreferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationPreferenceMutation", m)
+}
+
 // The OrganizationFunc type is an adapter to allow the use of ordinary
 // function as Organization mutator.
 type OrganizationFunc func(context.Context, *ent.OrganizationMutation) (ent.Value, error)
@@ -93,6 +213,18 @@ func (f OrganizationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrganizationMutation", m)
 }
 
+// The OvertimeRequestFunc type is an adapter to allow the use of ordinary
+// function as OvertimeRequest mutator.
+type OvertimeRequestFunc func(context.Context, *ent.OvertimeRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OvertimeRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OvertimeRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OvertimeRequestMutation", m)
+}
+
 // The PositionFunc type is an adapter to allow the use of ordinary
 // function as Position mutator.
 type PositionFunc func(context.Context, *ent.PositionMutation) (ent.Value, error)
@@ -117,6 +249,90 @@ func (f ProjectFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProjectMutation", m)
 }
 
+// The ProjectRoleFunc type is an adapter to allow the use of ordinary
+// function as ProjectRole mutator.
+type ProjectRoleFunc func(context.Context, *ent.ProjectRoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProjectRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProjectRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProjectRoleMutation", m)
+}
+
+// The ReminderSettingFunc type is an adapter to allow the use of ordinary
+// function as ReminderSetting mutator.
+type ReminderSettingFunc func(context.Context, *ent.ReminderSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReminderSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReminderSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReminderSettingMutation", m)
+}
+
+// The RosterFunc type is an adapter to allow the use of ordinary
+// function as Roster mutator.
+type RosterFunc func(context.Context, *ent.RosterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RosterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RosterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RosterMutation", m)
+}
+
+// The SalaryGradeFunc type is an adapter to allow the use of ordinary
+// function as SalaryGrade mutator.
+type SalaryGradeFunc func(context.Context, *ent.SalaryGradeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SalaryGradeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SalaryGradeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SalaryGradeMutation", m)
+}
+
+// The ShiftFunc type is an adapter to allow the use of ordinary
+// function as Shift mutator.
+type ShiftFunc func(context.Context, *ent.ShiftMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShiftFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShiftMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShiftMutation", m)
+}
+
+// The ShiftSwapRequestFunc type is an adapter to allow the use of ordinary
+// function as ShiftSwapRequest mutator.
+type ShiftSwapRequestFunc func(context.Context, *ent.ShiftSwapRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShiftSwapRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShiftSwapRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShiftSwapRequestMutation", m)
+}
+
+// The SprintFunc type is an adapter to allow the use of ordinary
+// function as Sprint mutator.
+type SprintFunc func(context.Context, *ent.SprintMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SprintFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SprintMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SprintMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *ent.TaskMutation) (ent.Value, error)
@@ -129,6 +345,90 @@ func (f TaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskMutation", m)
 }
 
+// The TaskActivityFunc type is an adapter to allow the use of ordinary
+// function as TaskActivity mutator.
+type TaskActivityFunc func(context.Context, *ent.TaskActivityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskActivityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskActivityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskActivityMutation", m)
+}
+
+// The TaskCommentFunc type is an adapter to allow the use of ordinary
+// function as TaskComment mutator.
+type TaskCommentFunc func(context.Context, *ent.TaskCommentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskCommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskCommentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskCommentMutation", m)
+}
+
+// The TaskCommentRevisionFunc type is an adapter to allow the use of ordinary
+// function as TaskCommentRevision mutator.
+type TaskCommentRevisionFunc func(context.Context, *ent.TaskCommentRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskCommentRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskCommentRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskCommentRevisionMutation", m)
+}
+
+// The TaskDependencyFunc type is an adapter to allow the use of ordinary
+// function as TaskDependency mutator.
+type TaskDependencyFunc func(context.Context, *ent.TaskDependencyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskDependencyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskDependencyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskDependencyMutation", m)
+}
+
+// The TaskRecurrenceFunc type is an adapter to allow the use of ordinary
+// function as TaskRecurrence mutator.
+type TaskRecurrenceFunc func(context.Context, *ent.TaskRecurrenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskRecurrenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskRecurrenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskRecurrenceMutation", m)
+}
+
+// The TaskRecurrenceRunFunc type is an adapter to allow the use of ordinary
+// function as TaskRecurrenceRun mutator.
+type TaskRecurrenceRunFunc func(context.Context, *ent.TaskRecurrenceRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskRecurrenceRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskRecurrenceRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskRecurrenceRunMutation", m)
+}
+
+// The TaskReminderFunc type is an adapter to allow the use of ordinary
+// function as TaskReminder mutator.
+type TaskReminderFunc func(context.Context, *ent.TaskReminderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskReminderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskReminderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskReminderMutation", m)
+}
+
 // The TaskReportFunc type is an adapter to allow the use of ordinary
 // function as TaskReport mutator.
 type TaskReportFunc func(context.Context, *ent.TaskReportMutation) (ent.Value, error)
@@ -141,6 +441,78 @@ func (f TaskReportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskReportMutation", m)
 }
 
+// The TaskTemplateFunc type is an adapter to allow the use of ordinary
+// function as TaskTemplate mutator.
+type TaskTemplateFunc func(context.Context, *ent.TaskTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskTemplateMutation", m)
+}
+
+// The TaskViewFunc type is an adapter to allow the use of ordinary
+// function as TaskView mutator.
+type TaskViewFunc func(context.Context, *ent.TaskViewMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskViewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskViewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskViewMutation", m)
+}
+
+// The TaskWatcherFunc type is an adapter to allow the use of ordinary
+// function as TaskWatcher mutator.
+type TaskWatcherFunc func(context.Context, *ent.TaskWatcherMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskWatcherFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskWatcherMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskWatcherMutation", m)
+}
+
+// The WorkLogFunc type is an adapter to allow the use of ordinary
+// function as WorkLog mutator.
+type WorkLogFunc func(context.Context, *ent.WorkLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkLogMutation", m)
+}
+
+// The WorkflowStatusFunc type is an adapter to allow the use of ordinary
+// function as WorkflowStatus mutator.
+type WorkflowStatusFunc func(context.Context, *ent.WorkflowStatusMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkflowStatusFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkflowStatusMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkflowStatusMutation", m)
+}
+
+// The WorkflowTransitionFunc type is an adapter to allow the use of ordinary
+// function as WorkflowTransition mutator.
+type WorkflowTransitionFunc func(context.Context, *ent.WorkflowTransitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkflowTransitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkflowTransitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkflowTransitionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
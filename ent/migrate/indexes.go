@@ -0,0 +1,17 @@
+package migrate
+
+import "entgo.io/ent/dialect/sql/schema"
+
+// The many-to-many tables of tasks are also indexed by their other side.
+// Their primary keys start with the task, which does not help the task list
+// filters by assignee or label.
+func init() {
+	TaskAssigneesTable.Indexes = append(TaskAssigneesTable.Indexes, &schema.Index{
+		Name:    "task_assignees_employee_id_task_id",
+		Columns: []*schema.Column{TaskAssigneesColumns[1], TaskAssigneesColumns[0]},
+	})
+	TaskLabelsTable.Indexes = append(TaskLabelsTable.Indexes, &schema.Index{
+		Name:    "task_labels_label_id_task_id",
+		Columns: []*schema.Column{TaskLabelsColumns[1], TaskLabelsColumns[0]},
+	})
+}
@@ -0,0 +1,44 @@
+//go:build ignore
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	atlas "ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/lib/pq"
+	"github.com/longgggwwww/hrm-ms-hr/ent/migrate"
+)
+
+// Generates a versioned migration from the difference between the migration
+// directory and the schema, join table indexes included. The directory is
+// replayed on the dev database given by DEV_URL, which is wiped.
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalln("migration name is required. Use: 'go run -mod=mod ent/migrate/main.go <name>'")
+	}
+	devURL := os.Getenv("DEV_URL")
+	if devURL == "" {
+		log.Fatalln("DEV_URL environment variable is not set")
+	}
+
+	dir, err := atlas.NewLocalDir("ent/migrate/migrations")
+	if err != nil {
+		log.Fatalf("failed creating atlas migration directory: %v", err)
+	}
+	opts := []schema.MigrateOption{
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(atlas.DefaultFormatter),
+		schema.WithDropIndex(true),
+		schema.WithDropColumn(true),
+	}
+	if err := schema.Diff(context.Background(), devURL, os.Args[1], migrate.Tables, opts...); err != nil {
+		log.Fatalf("failed generating migration file: %v", err)
+	}
+}
@@ -0,0 +1,6 @@
+-- Modify "appointment_histories" table
+ALTER TABLE "public"."appointment_histories" ADD COLUMN "effective_to" timestamptz NULL, ADD COLUMN "department_id" bigint NULL, ADD COLUMN "position_id" bigint NULL, ADD CONSTRAINT "appointment_histories_departments_appointment_histories" FOREIGN KEY ("department_id") REFERENCES "public"."departments" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, ADD CONSTRAINT "appointment_histories_positions_appointment_histories" FOREIGN KEY ("position_id") REFERENCES "public"."positions" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create "employee_contracts" table
+CREATE TABLE "public"."employee_contracts" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "code" character varying NOT NULL, "type" character varying NOT NULL DEFAULT 'fixed_term', "status" character varying NOT NULL DEFAULT 'active', "start_at" timestamptz NOT NULL, "end_at" timestamptz NULL, "note" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "employee_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "employee_contracts_employees_contracts" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create "employee_status_histories" table
+CREATE TABLE "public"."employee_status_histories" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "from_status" character varying NOT NULL, "to_status" character varying NOT NULL, "changed_by" bigint NULL, "note" character varying NULL, "changed_at" timestamptz NOT NULL, "employee_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "employee_status_histories_employees_status_histories" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,10 @@
+-- Create "salary_grades" table
+CREATE TABLE "public"."salary_grades" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "code" character varying NOT NULL, "name" character varying NOT NULL, "min_amount" double precision NOT NULL, "max_amount" double precision NOT NULL, "currency" character varying NOT NULL DEFAULT 'VND', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "org_id" bigint NOT NULL, "position_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "salary_grades_organizations_salary_grades" FOREIGN KEY ("org_id") REFERENCES "public"."organizations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "salary_grades_positions_salary_grades" FOREIGN KEY ("position_id") REFERENCES "public"."positions" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "salarygrade_org_id_code" to table: "salary_grades"
+CREATE UNIQUE INDEX "salarygrade_org_id_code" ON "public"."salary_grades" ("org_id", "code");
+-- Create "compensations" table
+CREATE TABLE "public"."compensations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "base_pay" double precision NOT NULL, "allowances" jsonb NULL, "total_allowance" double precision NOT NULL DEFAULT 0, "currency" character varying NOT NULL DEFAULT 'VND', "effective_from" timestamptz NOT NULL, "reason" character varying NULL, "status" character varying NOT NULL DEFAULT 'pending', "requester_id" bigint NOT NULL, "reviewer_id" bigint NULL, "review_comment" character varying NULL, "reviewed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "employee_id" bigint NOT NULL, "salary_grade_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "compensations_employees_compensations" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "compensations_salary_grades_compensations" FOREIGN KEY ("salary_grade_id") REFERENCES "public"."salary_grades" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "compensation_employee_id_status_effective_from" to table: "compensations"
+CREATE INDEX "compensation_employee_id_status_effective_from" ON "public"."compensations" ("employee_id", "status", "effective_from");
+-- Create index "compensation_org_id" to table: "compensations"
+CREATE INDEX "compensation_org_id" ON "public"."compensations" ("org_id");
@@ -0,0 +1,10 @@
+-- Create "attendance_records" table
+CREATE TABLE "public"."attendance_records" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "work_date" timestamptz NOT NULL, "check_in_at" timestamptz NOT NULL, "check_out_at" timestamptz NULL, "source" character varying NOT NULL DEFAULT 'web', "latitude" double precision NULL, "longitude" double precision NULL, "note" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "employee_id" bigint NOT NULL, "org_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "attendance_records_employees_attendance_records" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "attendance_records_organizations_attendance_records" FOREIGN KEY ("org_id") REFERENCES "public"."organizations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "attendancerecord_employee_id_work_date" to table: "attendance_records"
+CREATE UNIQUE INDEX "attendancerecord_employee_id_work_date" ON "public"."attendance_records" ("employee_id", "work_date");
+-- Create index "attendancerecord_org_id_work_date" to table: "attendance_records"
+CREATE INDEX "attendancerecord_org_id_work_date" ON "public"."attendance_records" ("org_id", "work_date");
+-- Create "attendance_corrections" table
+CREATE TABLE "public"."attendance_corrections" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "work_date" timestamptz NOT NULL, "check_in_at" timestamptz NULL, "check_out_at" timestamptz NULL, "reason" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "reviewer_id" bigint NULL, "review_comment" character varying NULL, "reviewed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "attendance_record_id" bigint NULL, "employee_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "attendance_corrections_attendance_records_corrections" FOREIGN KEY ("attendance_record_id") REFERENCES "public"."attendance_records" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "attendance_corrections_employees_attendance_corrections" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "attendancecorrection_org_id_status" to table: "attendance_corrections"
+CREATE INDEX "attendancecorrection_org_id_status" ON "public"."attendance_corrections" ("org_id", "status");
@@ -0,0 +1,14 @@
+-- Create "shifts" table
+CREATE TABLE "public"."shifts" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "code" character varying NOT NULL, "name" character varying NOT NULL, "start_time" character varying NOT NULL, "end_time" character varying NOT NULL, "break_minutes" bigint NOT NULL DEFAULT 0, "break_paid" boolean NOT NULL DEFAULT false, "is_night" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "org_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "shifts_organizations_shifts" FOREIGN KEY ("org_id") REFERENCES "public"."organizations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "shift_org_id_code" to table: "shifts"
+CREATE UNIQUE INDEX "shift_org_id_code" ON "public"."shifts" ("org_id", "code");
+-- Create "rosters" table
+CREATE TABLE "public"."rosters" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "work_date" timestamptz NOT NULL, "note" character varying NULL, "created_by" bigint NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "employee_id" bigint NOT NULL, "shift_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "rosters_employees_rosters" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "rosters_shifts_rosters" FOREIGN KEY ("shift_id") REFERENCES "public"."shifts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "roster_employee_id_work_date" to table: "rosters"
+CREATE UNIQUE INDEX "roster_employee_id_work_date" ON "public"."rosters" ("employee_id", "work_date");
+-- Create index "roster_org_id_work_date" to table: "rosters"
+CREATE INDEX "roster_org_id_work_date" ON "public"."rosters" ("org_id", "work_date");
+-- Create "shift_swap_requests" table
+CREATE TABLE "public"."shift_swap_requests" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "requester_id" bigint NOT NULL, "reason" character varying NULL, "status" character varying NOT NULL DEFAULT 'pending', "reviewer_id" bigint NULL, "review_comment" character varying NULL, "reviewed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "roster_id" bigint NOT NULL, "target_roster_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "shift_swap_requests_rosters_swap_requests" FOREIGN KEY ("roster_id") REFERENCES "public"."rosters" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "shift_swap_requests_rosters_targeted_swap_requests" FOREIGN KEY ("target_roster_id") REFERENCES "public"."rosters" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "shiftswaprequest_org_id_status" to table: "shift_swap_requests"
+CREATE INDEX "shiftswaprequest_org_id_status" ON "public"."shift_swap_requests" ("org_id", "status");
@@ -0,0 +1,12 @@
+-- Modify "organizations" table
+ALTER TABLE "public"."organizations" ADD COLUMN "weekend_days" jsonb NULL;
+-- Create "holidays" table
+CREATE TABLE "public"."holidays" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "date" timestamptz NOT NULL, "name" character varying NOT NULL, "created_at" timestamptz NOT NULL, "org_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "holidays_organizations_holidays" FOREIGN KEY ("org_id") REFERENCES "public"."organizations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "holiday_org_id_date" to table: "holidays"
+CREATE UNIQUE INDEX "holiday_org_id_date" ON "public"."holidays" ("org_id", "date");
+-- Create "overtime_requests" table
+CREATE TABLE "public"."overtime_requests" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "work_date" timestamptz NOT NULL, "hours" double precision NOT NULL, "reason" character varying NOT NULL, "day_type" character varying NOT NULL DEFAULT 'weekday', "status" character varying NOT NULL DEFAULT 'pending', "approver_position_id" bigint NULL, "reviewer_id" bigint NULL, "review_comment" character varying NULL, "reviewed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "employee_id" bigint NOT NULL, "project_id" bigint NULL, "task_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "overtime_requests_employees_overtime_requests" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "overtime_requests_projects_overtime_requests" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "overtime_requests_tasks_overtime_requests" FOREIGN KEY ("task_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "overtimerequest_employee_id_work_date" to table: "overtime_requests"
+CREATE INDEX "overtimerequest_employee_id_work_date" ON "public"."overtime_requests" ("employee_id", "work_date");
+-- Create index "overtimerequest_org_id_status" to table: "overtime_requests"
+CREATE INDEX "overtimerequest_org_id_status" ON "public"."overtime_requests" ("org_id", "status");
@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "parent_id" bigint NULL, ADD CONSTRAINT "tasks_tasks_children" FOREIGN KEY ("parent_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+-- Create "task_dependencies" table
+CREATE TABLE "public"."task_dependencies" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "type" character varying NOT NULL DEFAULT 'blocks', "creator_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "task_id" bigint NOT NULL, "target_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "task_dependencies_tasks_dependencies" FOREIGN KEY ("task_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "task_dependencies_tasks_dependents" FOREIGN KEY ("target_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "taskdependency_task_id_target_id_type" to table: "task_dependencies"
+CREATE UNIQUE INDEX "taskdependency_task_id_target_id_type" ON "public"."task_dependencies" ("task_id", "target_id", "type");
@@ -0,0 +1,8 @@
+-- Create "task_comments" table
+CREATE TABLE "public"."task_comments" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "content" text NOT NULL, "edited_at" timestamptz NULL, "deleted_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "author_id" bigint NOT NULL, "task_id" bigint NOT NULL, "parent_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "task_comments_employees_task_comments" FOREIGN KEY ("author_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "task_comments_task_comments_replies" FOREIGN KEY ("parent_id") REFERENCES "public"."task_comments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "task_comments_tasks_comments" FOREIGN KEY ("task_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "taskcomment_task_id_parent_id" to table: "task_comments"
+CREATE INDEX "taskcomment_task_id_parent_id" ON "public"."task_comments" ("task_id", "parent_id");
+-- Create "task_comment_mentions" table
+CREATE TABLE "public"."task_comment_mentions" ("task_comment_id" bigint NOT NULL, "employee_id" bigint NOT NULL, PRIMARY KEY ("task_comment_id", "employee_id"), CONSTRAINT "task_comment_mentions_employee_id" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "task_comment_mentions_task_comment_id" FOREIGN KEY ("task_comment_id") REFERENCES "public"."task_comments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create "task_comment_revisions" table
+CREATE TABLE "public"."task_comment_revisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "content" text NOT NULL, "editor_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "comment_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "task_comment_revisions_task_comments_revisions" FOREIGN KEY ("comment_id") REFERENCES "public"."task_comments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,2 @@
+-- Modify "task_reports" table
+ALTER TABLE "public"."task_reports" ADD COLUMN "progress" bigint NULL, ADD COLUMN "status" character varying NOT NULL DEFAULT 'submitted', ADD COLUMN "review_comment" character varying NULL, ADD COLUMN "reviewed_at" timestamptz NULL, ADD COLUMN "reviewer_id" bigint NULL, ADD CONSTRAINT "task_reports_employees_reviewed_task_reports" FOREIGN KEY ("reviewer_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
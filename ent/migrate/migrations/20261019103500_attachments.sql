@@ -0,0 +1,8 @@
+-- Modify "organizations" table
+ALTER TABLE "public"."organizations" ADD COLUMN "attachment_max_file_size" bigint NOT NULL DEFAULT 10485760, ADD COLUMN "attachment_quota" bigint NOT NULL DEFAULT 1073741824;
+-- Create "attachments" table
+CREATE TABLE "public"."attachments" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "owner_type" character varying NOT NULL DEFAULT 'task', "owner_id" bigint NOT NULL, "file_name" character varying NOT NULL, "mime_type" character varying NOT NULL, "size" bigint NOT NULL, "checksum" character varying NOT NULL, "storage_key" character varying NOT NULL, "scan_status" character varying NOT NULL DEFAULT 'pending', "created_at" timestamptz NOT NULL, "uploader_id" bigint NOT NULL, "org_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "attachments_employees_attachments" FOREIGN KEY ("uploader_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "attachments_organizations_attachments" FOREIGN KEY ("org_id") REFERENCES "public"."organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "attachment_owner_type_owner_id" to table: "attachments"
+CREATE INDEX "attachment_owner_type_owner_id" ON "public"."attachments" ("owner_type", "owner_id");
+-- Create index "attachments_storage_key_key" to table: "attachments"
+CREATE UNIQUE INDEX "attachments_storage_key_key" ON "public"."attachments" ("storage_key");
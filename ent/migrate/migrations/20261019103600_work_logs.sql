@@ -0,0 +1,10 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "original_estimate" bigint NULL, ADD COLUMN "remaining_estimate" bigint NULL;
+-- Create "work_logs" table
+CREATE TABLE "public"."work_logs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "started_at" timestamptz NOT NULL, "ended_at" timestamptz NULL, "duration" bigint NOT NULL DEFAULT 0, "note" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "employee_id" bigint NOT NULL, "task_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "work_logs_employees_work_logs" FOREIGN KEY ("employee_id") REFERENCES "public"."employees" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "work_logs_tasks_work_logs" FOREIGN KEY ("task_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "worklog_employee_id" to table: "work_logs"
+CREATE UNIQUE INDEX "worklog_employee_id" ON "public"."work_logs" ("employee_id") WHERE ended_at IS NULL;
+-- Create index "worklog_employee_id_started_at" to table: "work_logs"
+CREATE INDEX "worklog_employee_id_started_at" ON "public"."work_logs" ("employee_id", "started_at");
+-- Create index "worklog_task_id_started_at" to table: "work_logs"
+CREATE INDEX "worklog_task_id_started_at" ON "public"."work_logs" ("task_id", "started_at");
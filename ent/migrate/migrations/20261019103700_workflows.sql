@@ -0,0 +1,10 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "workflow_status" character varying NOT NULL DEFAULT '';
+-- Create "workflow_status" table
+CREATE TABLE "public"."workflow_status" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "key" character varying NOT NULL, "name" character varying NOT NULL, "category" character varying NOT NULL DEFAULT 'todo', "system_status" character varying NOT NULL DEFAULT 'not_received', "position" bigint NOT NULL DEFAULT 0, "project_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "workflow_status_projects_workflow_statuses" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "workflowstatus_project_id_key" to table: "workflow_status"
+CREATE UNIQUE INDEX "workflowstatus_project_id_key" ON "public"."workflow_status" ("project_id", "key");
+-- Create "workflow_transitions" table
+CREATE TABLE "public"."workflow_transitions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "from_key" character varying NOT NULL DEFAULT '', "to_key" character varying NOT NULL, "guards" jsonb NULL, "project_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "workflow_transitions_projects_workflow_transitions" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "workflowtransition_project_id_from_key_to_key" to table: "workflow_transitions"
+CREATE UNIQUE INDEX "workflowtransition_project_id_from_key_to_key" ON "public"."workflow_transitions" ("project_id", "from_key", "to_key");
@@ -0,0 +1,6 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "rank" character varying NOT NULL DEFAULT '';
+-- Create index "task_project_id_workflow_status_rank" to table: "tasks"
+CREATE INDEX "task_project_id_workflow_status_rank" ON "public"."tasks" ("project_id", "workflow_status", "rank");
+-- Modify "workflow_status" table
+ALTER TABLE "public"."workflow_status" ADD COLUMN "wip_limit" bigint NULL;
@@ -0,0 +1,12 @@
+-- Create "milestones" table
+CREATE TABLE "public"."milestones" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "goal" character varying NULL, "start_at" timestamptz NULL, "end_at" timestamptz NULL, "state" character varying NOT NULL DEFAULT 'planned', "creator_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "project_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "milestones_projects_milestones" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "milestone_project_id_end_at" to table: "milestones"
+CREATE INDEX "milestone_project_id_end_at" ON "public"."milestones" ("project_id", "end_at");
+-- Create "sprints" table
+CREATE TABLE "public"."sprints" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "goal" character varying NULL, "start_at" timestamptz NOT NULL, "end_at" timestamptz NOT NULL, "state" character varying NOT NULL DEFAULT 'planned', "started_at" timestamptz NULL, "closed_at" timestamptz NULL, "committed_task_ids" jsonb NULL, "completed_task_ids" jsonb NULL, "incomplete_task_ids" jsonb NULL, "creator_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "project_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "sprints_projects_sprints" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "sprint_project_id" to table: "sprints"
+CREATE UNIQUE INDEX "sprint_project_id" ON "public"."sprints" ("project_id") WHERE state = 'active';
+-- Create index "sprint_project_id_start_at" to table: "sprints"
+CREATE INDEX "sprint_project_id_start_at" ON "public"."sprints" ("project_id", "start_at");
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "completed_at" timestamptz NULL, ADD COLUMN "milestone_id" bigint NULL, ADD COLUMN "sprint_id" bigint NULL, ADD CONSTRAINT "tasks_milestones_tasks" FOREIGN KEY ("milestone_id") REFERENCES "public"."milestones" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, ADD CONSTRAINT "tasks_sprints_tasks" FOREIGN KEY ("sprint_id") REFERENCES "public"."sprints" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
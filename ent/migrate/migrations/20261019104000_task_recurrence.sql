@@ -0,0 +1,18 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "recurrence_run_id" bigint NULL;
+-- Create index "tasks_recurrence_run_id_key" to table: "tasks"
+CREATE UNIQUE INDEX "tasks_recurrence_run_id_key" ON "public"."tasks" ("recurrence_run_id");
+-- Create "task_templates" table
+CREATE TABLE "public"."task_templates" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "type" character varying NOT NULL DEFAULT 'task', "label_ids" jsonb NULL, "assignee_ids" jsonb NULL, "start_offset" bigint NULL, "due_offset" bigint NULL, "original_estimate" bigint NULL, "creator_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "project_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "task_templates_projects_task_templates" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "tasktemplate_org_id_project_id" to table: "task_templates"
+CREATE INDEX "tasktemplate_org_id_project_id" ON "public"."task_templates" ("org_id", "project_id");
+-- Create "task_recurrences" table
+CREATE TABLE "public"."task_recurrences" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "rule" character varying NOT NULL, "start_at" timestamptz NOT NULL, "until" timestamptz NULL, "next_run_at" timestamptz NULL, "last_run_at" timestamptz NULL, "timezone" character varying NOT NULL DEFAULT 'UTC', "enabled" boolean NOT NULL DEFAULT true, "creator_id" bigint NOT NULL, "employee_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "template_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "task_recurrences_task_templates_recurrences" FOREIGN KEY ("template_id") REFERENCES "public"."task_templates" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "taskrecurrence_enabled_next_run_at" to table: "task_recurrences"
+CREATE INDEX "taskrecurrence_enabled_next_run_at" ON "public"."task_recurrences" ("enabled", "next_run_at");
+-- Create "task_recurrence_runs" table
+CREATE TABLE "public"."task_recurrence_runs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "occurrence_at" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "task_id" bigint NULL, "attempts" bigint NOT NULL DEFAULT 0, "error" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "recurrence_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "task_recurrence_runs_task_recurrences_runs" FOREIGN KEY ("recurrence_id") REFERENCES "public"."task_recurrences" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "taskrecurrencerun_recurrence_id_occurrence_at" to table: "task_recurrence_runs"
+CREATE UNIQUE INDEX "taskrecurrencerun_recurrence_id_occurrence_at" ON "public"."task_recurrence_runs" ("recurrence_id", "occurrence_at");
+-- Create index "taskrecurrencerun_status_updated_at" to table: "task_recurrence_runs"
+CREATE INDEX "taskrecurrencerun_status_updated_at" ON "public"."task_recurrence_runs" ("status", "updated_at");
@@ -0,0 +1,12 @@
+-- Modify "organizations" table
+ALTER TABLE "public"."organizations" ADD COLUMN "task_seq" bigint NOT NULL DEFAULT 0;
+-- Modify "projects" table
+ALTER TABLE "public"."projects" ADD COLUMN "key" character varying NULL, ADD COLUMN "task_seq" bigint NOT NULL DEFAULT 0;
+-- Create index "project_org_id_key" to table: "projects"
+CREATE UNIQUE INDEX "project_org_id_key" ON "public"."projects" ("org_id", "key");
+-- Drop index "tasks_code_key" from table: "tasks"
+DROP INDEX "public"."tasks_code_key";
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ADD COLUMN "org_id" bigint NULL;
+-- Create index "task_org_id_code" to table: "tasks"
+CREATE UNIQUE INDEX "task_org_id_code" ON "public"."tasks" ("org_id", "code");
@@ -0,0 +1,80 @@
+-- Give existing projects a task key derived from their name: the initials of
+-- its words, or the first three letters of a single word, without diacritics.
+-- Keys already taken in the organization get the smallest free number suffix.
+DO $$
+DECLARE
+  p record;
+  words text[];
+  base text;
+  candidate text;
+  n int;
+BEGIN
+  FOR p IN SELECT "id", "org_id", "name" FROM "public"."projects" WHERE "key" IS NULL ORDER BY "id" LOOP
+    SELECT coalesce(array_agg(w.word ORDER BY w.ord), '{}') INTO words
+    FROM (
+      SELECT regexp_replace(translate(upper(normalize(t.part, NFD)), 'đĐ', 'DD'), '[^A-Z0-9]', '', 'g') AS word, t.ord
+      FROM regexp_split_to_table(p."name", '[^0-9A-Za-z\u0080-\U0010FFFF]+') WITH ORDINALITY AS t(part, ord)
+    ) w
+    WHERE w.word <> '';
+    IF cardinality(words) = 0 THEN
+      base := 'PRJ';
+    ELSE
+      IF cardinality(words) = 1 THEN
+        base := left(words[1], 3);
+      ELSE
+        SELECT string_agg(left(u.word, 1), '' ORDER BY u.ord) INTO base
+        FROM unnest(words) WITH ORDINALITY AS u(word, ord);
+      END IF;
+      IF base !~ '^[A-Z]' THEN
+        base := 'P' || base;
+      END IF;
+      base := left(base, 10);
+      IF length(base) < 2 THEN
+        base := base || 'X';
+      END IF;
+    END IF;
+    candidate := base;
+    n := 2;
+    WHILE EXISTS (SELECT 1 FROM "public"."projects" WHERE "org_id" = p."org_id" AND "key" = candidate) LOOP
+      candidate := left(base, 10 - length(n::text)) || n::text;
+      n := n + 1;
+    END LOOP;
+    UPDATE "public"."projects" SET "key" = candidate WHERE "id" = p."id";
+  END LOOP;
+END $$;
+-- Move existing tasks to the organization of their project, else of their
+-- first assignee, else of their creator
+UPDATE "public"."tasks" AS t SET "org_id" = p."org_id"
+FROM "public"."projects" AS p
+WHERE t."org_id" IS NULL AND t."project_id" = p."id";
+UPDATE "public"."tasks" AS t SET "org_id" = (
+  SELECT e."org_id" FROM "public"."task_assignees" AS a
+  JOIN "public"."employees" AS e ON e."id" = a."employee_id"
+  WHERE a."task_id" = t."id"
+  ORDER BY a."employee_id"
+  LIMIT 1
+)
+WHERE t."org_id" IS NULL AND EXISTS (SELECT 1 FROM "public"."task_assignees" AS a WHERE a."task_id" = t."id");
+UPDATE "public"."tasks" AS t SET "org_id" = e."org_id"
+FROM "public"."employees" AS e
+WHERE t."org_id" IS NULL AND e."user_id" = t."creator_id"::text;
+-- The organization of a task is required from here on. Tasks left without
+-- one stop the migration until their org_id is set by hand.
+DO $$
+DECLARE
+  orphans bigint;
+BEGIN
+  SELECT count(*) INTO orphans FROM "public"."tasks" WHERE "org_id" IS NULL;
+  IF orphans > 0 THEN
+    RAISE EXCEPTION '% tasks have no project, assignee or creator to take an organization from; set their org_id and migrate again', orphans;
+  END IF;
+END $$;
+-- Move organization counters past the #N codes in use
+UPDATE "public"."organizations" AS o SET "task_seq" = s."seq"
+FROM (
+  SELECT "org_id", max(substr("code", 2)::bigint) AS "seq"
+  FROM "public"."tasks"
+  WHERE "code" ~ '^#[0-9]{1,18}$'
+  GROUP BY "org_id"
+) AS s
+WHERE o."id" = s."org_id" AND o."task_seq" < s."seq";
@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE "public"."tasks" ALTER COLUMN "org_id" SET NOT NULL;
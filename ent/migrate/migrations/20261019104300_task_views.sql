@@ -0,0 +1,8 @@
+-- Create "task_views" table
+CREATE TABLE "public"."task_views" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "employee_id" bigint NOT NULL, "name" character varying NOT NULL, "visibility" character varying NOT NULL DEFAULT 'private', "filters" jsonb NULL, "timezone" character varying NOT NULL DEFAULT 'UTC', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "project_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "task_views_projects_task_views" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "taskview_org_id_employee_id" to table: "task_views"
+CREATE INDEX "taskview_org_id_employee_id" ON "public"."task_views" ("org_id", "employee_id");
+-- Create index "taskview_org_id_visibility" to table: "task_views"
+CREATE INDEX "taskview_org_id_visibility" ON "public"."task_views" ("org_id", "visibility");
+-- Create index "taskview_project_id" to table: "task_views"
+CREATE INDEX "taskview_project_id" ON "public"."task_views" ("project_id");
@@ -0,0 +1,8 @@
+-- Create index "task_org_id_due_date" to table: "tasks"
+CREATE INDEX "task_org_id_due_date" ON "public"."tasks" ("org_id", "due_date");
+-- Create index "task_org_id_updated_at" to table: "tasks"
+CREATE INDEX "task_org_id_updated_at" ON "public"."tasks" ("org_id", "updated_at");
+-- Create index "task_labels_label_id_task_id" to table: "task_labels"
+CREATE INDEX "task_labels_label_id_task_id" ON "public"."task_labels" ("label_id", "task_id");
+-- Create index "task_assignees_employee_id_task_id" to table: "task_assignees"
+CREATE INDEX "task_assignees_employee_id_task_id" ON "public"."task_assignees" ("employee_id", "task_id");
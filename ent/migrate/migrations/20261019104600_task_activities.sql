@@ -0,0 +1,6 @@
+-- Create "task_activities" table
+CREATE TABLE "public"."task_activities" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "project_id" bigint NULL, "actor_user_id" bigint NULL, "actor_employee_id" bigint NULL, "kind" character varying NOT NULL, "field_name" character varying NULL, "old_value" text NULL, "new_value" text NULL, "ref_id" bigint NULL, "created_at" timestamptz NOT NULL, "task_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "task_activities_tasks_activities" FOREIGN KEY ("task_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "taskactivity_org_id_project_id" to table: "task_activities"
+CREATE INDEX "taskactivity_org_id_project_id" ON "public"."task_activities" ("org_id", "project_id");
+-- Create index "taskactivity_task_id" to table: "task_activities"
+CREATE INDEX "taskactivity_task_id" ON "public"."task_activities" ("task_id");
@@ -0,0 +1,16 @@
+-- Create "notifications" table
+CREATE TABLE "public"."notifications" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "employee_id" bigint NOT NULL, "type" character varying NOT NULL, "title" character varying NOT NULL, "body" text NULL, "task_id" bigint NULL, "ref_id" bigint NULL, "actor_employee_id" bigint NULL, "dedup_key" character varying NULL, "read_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "notification_employee_id_dedup_key" to table: "notifications"
+CREATE UNIQUE INDEX "notification_employee_id_dedup_key" ON "public"."notifications" ("employee_id", "dedup_key");
+-- Create index "notification_employee_id_read_at" to table: "notifications"
+CREATE INDEX "notification_employee_id_read_at" ON "public"."notifications" ("employee_id", "read_at");
+-- Create "notification_preferences" table
+CREATE TABLE "public"."notification_preferences" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "employee_id" bigint NOT NULL, "type" character varying NOT NULL, "enabled" boolean NOT NULL DEFAULT true, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "notificationpreference_employee_id_type" to table: "notification_preferences"
+CREATE UNIQUE INDEX "notificationpreference_employee_id_type" ON "public"."notification_preferences" ("employee_id", "type");
+-- Create "task_watchers" table
+CREATE TABLE "public"."task_watchers" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "employee_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "task_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "task_watchers_tasks_watchers" FOREIGN KEY ("task_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "taskwatcher_employee_id" to table: "task_watchers"
+CREATE INDEX "taskwatcher_employee_id" ON "public"."task_watchers" ("employee_id");
+-- Create index "taskwatcher_task_id_employee_id" to table: "task_watchers"
+CREATE UNIQUE INDEX "taskwatcher_task_id_employee_id" ON "public"."task_watchers" ("task_id", "employee_id");
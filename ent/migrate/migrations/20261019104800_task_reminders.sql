@@ -0,0 +1,8 @@
+-- Create "reminder_settings" table
+CREATE TABLE "public"."reminder_settings" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "due_soon_enabled" boolean NOT NULL DEFAULT true, "due_soon_hours" bigint NOT NULL DEFAULT 24, "overdue_enabled" boolean NOT NULL DEFAULT true, "overdue_repeat_hours" bigint NOT NULL DEFAULT 24, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "reminder_settings_org_id_key" to table: "reminder_settings"
+CREATE UNIQUE INDEX "reminder_settings_org_id_key" ON "public"."reminder_settings" ("org_id");
+-- Create "task_reminders" table
+CREATE TABLE "public"."task_reminders" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "org_id" bigint NOT NULL, "kind" character varying NOT NULL, "due_date" timestamptz NOT NULL, "seq" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "task_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "task_reminders_tasks_reminders" FOREIGN KEY ("task_id") REFERENCES "public"."tasks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "taskreminder_task_id_kind_due_date_seq" to table: "task_reminders"
+CREATE UNIQUE INDEX "taskreminder_task_id_kind_due_date_seq" ON "public"."task_reminders" ("task_id", "kind", "due_date", "seq");
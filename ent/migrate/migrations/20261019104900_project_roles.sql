@@ -0,0 +1,4 @@
+-- Create "project_roles" table
+CREATE TABLE "public"."project_roles" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "employee_id" bigint NOT NULL, "role" character varying NOT NULL, "updated_at" timestamptz NOT NULL, "project_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "project_roles_projects_roles" FOREIGN KEY ("project_id") REFERENCES "public"."projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "projectrole_project_id_employee_id" to table: "project_roles"
+CREATE UNIQUE INDEX "projectrole_project_id_employee_id" ON "public"."project_roles" ("project_id", "employee_id");
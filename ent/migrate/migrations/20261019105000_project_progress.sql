@@ -0,0 +1,2 @@
+-- Modify "projects" table
+ALTER TABLE "public"."projects" ADD COLUMN "manual_progress" boolean NOT NULL DEFAULT false;
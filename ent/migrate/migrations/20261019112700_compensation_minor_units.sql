@@ -0,0 +1,21 @@
+-- Store money as integer minor units of its currency: none for the currencies
+-- without a minor unit, three or four decimals for the few that have them and
+-- two for the others
+CREATE FUNCTION pg_temp.minor_units(amount numeric, currency text) RETURNS bigint LANGUAGE sql IMMUTABLE AS $$
+  SELECT round(amount * CASE
+    WHEN upper(currency) IN ('BIF', 'CLP', 'DJF', 'GNF', 'ISK', 'JPY', 'KMF', 'KRW', 'PYG', 'RWF', 'UGX', 'UYI', 'VND', 'VUV', 'XAF', 'XOF', 'XPF') THEN 1
+    WHEN upper(currency) IN ('BHD', 'IQD', 'JOD', 'KWD', 'LYD', 'OMR', 'TND') THEN 1000
+    WHEN upper(currency) IN ('CLF', 'UYW') THEN 10000
+    ELSE 100
+  END)::bigint
+$$;
+UPDATE "public"."compensations" AS c SET "allowances" = (
+  SELECT jsonb_object_agg(a."key", pg_temp.minor_units(a."value"::numeric, c."currency"))
+  FROM jsonb_each_text(c."allowances") AS a
+)
+WHERE jsonb_typeof(c."allowances") = 'object' AND c."allowances" <> '{}'::jsonb;
+-- Modify "compensations" table
+ALTER TABLE "public"."compensations" ALTER COLUMN "base_pay" TYPE bigint USING pg_temp.minor_units("base_pay"::numeric, "currency"), ALTER COLUMN "total_allowance" TYPE bigint USING pg_temp.minor_units("total_allowance"::numeric, "currency");
+-- Modify "salary_grades" table
+ALTER TABLE "public"."salary_grades" ALTER COLUMN "min_amount" TYPE bigint USING pg_temp.minor_units("min_amount"::numeric, "currency"), ALTER COLUMN "max_amount" TYPE bigint USING pg_temp.minor_units("max_amount"::numeric, "currency");
+DROP FUNCTION pg_temp.minor_units(numeric, text);
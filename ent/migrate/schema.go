@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -17,7 +18,10 @@ var (
 		{Name: "attachment_urls", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "effective_to", Type: field.TypeTime, Nullable: true},
+		{Name: "department_id", Type: field.TypeInt, Nullable: true},
 		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "position_id", Type: field.TypeInt, Nullable: true},
 	}
 	// AppointmentHistoriesTable holds the schema information for the "appointment_histories" table.
 	AppointmentHistoriesTable = &schema.Table{
@@ -25,12 +29,208 @@ var (
 		Columns:    AppointmentHistoriesColumns,
 		PrimaryKey: []*schema.Column{AppointmentHistoriesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "appointment_histories_departments_appointment_histories",
+				Columns:    []*schema.Column{AppointmentHistoriesColumns[8]},
+				RefColumns: []*schema.Column{DepartmentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "appointment_histories_employees_appointment_histories",
-				Columns:    []*schema.Column{AppointmentHistoriesColumns[7]},
+				Columns:    []*schema.Column{AppointmentHistoriesColumns[9]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "appointment_histories_positions_appointment_histories",
+				Columns:    []*schema.Column{AppointmentHistoriesColumns[10]},
+				RefColumns: []*schema.Column{PositionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "owner_type", Type: field.TypeEnum, Enums: []string{"task", "task_report", "leave_request", "appointment_history"}, Default: "task"},
+		{Name: "owner_id", Type: field.TypeInt},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "storage_key", Type: field.TypeString, Unique: true},
+		{Name: "scan_status", Type: field.TypeEnum, Enums: []string{"pending", "clean", "infected", "skipped"}, Default: "pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "uploader_id", Type: field.TypeInt},
+		{Name: "org_id", Type: field.TypeInt},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachments_employees_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[10]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attachments_organizations_attachments",
+				Columns:    []*schema.Column{AttachmentsColumns[11]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_owner_type_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[1], AttachmentsColumns[2]},
+			},
+		},
+	}
+	// AttendanceCorrectionsColumns holds the columns for the "attendance_corrections" table.
+	AttendanceCorrectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "work_date", Type: field.TypeTime},
+		{Name: "check_in_at", Type: field.TypeTime, Nullable: true},
+		{Name: "check_out_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "pending"},
+		{Name: "reviewer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "attendance_record_id", Type: field.TypeInt, Nullable: true},
+		{Name: "employee_id", Type: field.TypeInt},
+	}
+	// AttendanceCorrectionsTable holds the schema information for the "attendance_corrections" table.
+	AttendanceCorrectionsTable = &schema.Table{
+		Name:       "attendance_corrections",
+		Columns:    AttendanceCorrectionsColumns,
+		PrimaryKey: []*schema.Column{AttendanceCorrectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attendance_corrections_attendance_records_corrections",
+				Columns:    []*schema.Column{AttendanceCorrectionsColumns[12]},
+				RefColumns: []*schema.Column{AttendanceRecordsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "attendance_corrections_employees_attendance_corrections",
+				Columns:    []*schema.Column{AttendanceCorrectionsColumns[13]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attendancecorrection_org_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceCorrectionsColumns[1], AttendanceCorrectionsColumns[6]},
+			},
+		},
+	}
+	// AttendanceRecordsColumns holds the columns for the "attendance_records" table.
+	AttendanceRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "work_date", Type: field.TypeTime},
+		{Name: "check_in_at", Type: field.TypeTime},
+		{Name: "check_out_at", Type: field.TypeTime, Nullable: true},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"web", "mobile", "kiosk"}, Default: "web"},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "org_id", Type: field.TypeInt},
+	}
+	// AttendanceRecordsTable holds the schema information for the "attendance_records" table.
+	AttendanceRecordsTable = &schema.Table{
+		Name:       "attendance_records",
+		Columns:    AttendanceRecordsColumns,
+		PrimaryKey: []*schema.Column{AttendanceRecordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attendance_records_employees_attendance_records",
+				Columns:    []*schema.Column{AttendanceRecordsColumns[10]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "attendance_records_organizations_attendance_records",
+				Columns:    []*schema.Column{AttendanceRecordsColumns[11]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attendancerecord_employee_id_work_date",
+				Unique:  true,
+				Columns: []*schema.Column{AttendanceRecordsColumns[10], AttendanceRecordsColumns[1]},
+			},
+			{
+				Name:    "attendancerecord_org_id_work_date",
+				Unique:  false,
+				Columns: []*schema.Column{AttendanceRecordsColumns[11], AttendanceRecordsColumns[1]},
+			},
+		},
+	}
+	// CompensationsColumns holds the columns for the "compensations" table.
+	CompensationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "base_pay", Type: field.TypeInt64},
+		{Name: "allowances", Type: field.TypeJSON, Nullable: true},
+		{Name: "total_allowance", Type: field.TypeInt64, Default: 0},
+		{Name: "currency", Type: field.TypeString, Default: "VND"},
+		{Name: "effective_from", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "pending"},
+		{Name: "requester_id", Type: field.TypeInt},
+		{Name: "reviewer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "salary_grade_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CompensationsTable holds the schema information for the "compensations" table.
+	CompensationsTable = &schema.Table{
+		Name:       "compensations",
+		Columns:    CompensationsColumns,
+		PrimaryKey: []*schema.Column{CompensationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "compensations_employees_compensations",
+				Columns:    []*schema.Column{CompensationsColumns[14]},
 				RefColumns: []*schema.Column{EmployeesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "compensations_salary_grades_compensations",
+				Columns:    []*schema.Column{CompensationsColumns[15]},
+				RefColumns: []*schema.Column{SalaryGradesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "compensation_employee_id_status_effective_from",
+				Unique:  false,
+				Columns: []*schema.Column{CompensationsColumns[14], CompensationsColumns[8], CompensationsColumns[6]},
+			},
+			{
+				Name:    "compensation_org_id",
+				Unique:  false,
+				Columns: []*schema.Column{CompensationsColumns[1]},
+			},
 		},
 	}
 	// DepartmentsColumns holds the columns for the "departments" table.
@@ -89,6 +289,86 @@ var (
 			},
 		},
 	}
+	// EmployeeContractsColumns holds the columns for the "employee_contracts" table.
+	EmployeeContractsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"fixed_term", "probation", "indefinite", "seasonal"}, Default: "fixed_term"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "expired", "terminated"}, Default: "active"},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeInt},
+	}
+	// EmployeeContractsTable holds the schema information for the "employee_contracts" table.
+	EmployeeContractsTable = &schema.Table{
+		Name:       "employee_contracts",
+		Columns:    EmployeeContractsColumns,
+		PrimaryKey: []*schema.Column{EmployeeContractsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "employee_contracts_employees_contracts",
+				Columns:    []*schema.Column{EmployeeContractsColumns[9]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// EmployeeStatusHistoriesColumns holds the columns for the "employee_status_histories" table.
+	EmployeeStatusHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_status", Type: field.TypeString},
+		{Name: "to_status", Type: field.TypeString},
+		{Name: "changed_by", Type: field.TypeInt, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeInt},
+	}
+	// EmployeeStatusHistoriesTable holds the schema information for the "employee_status_histories" table.
+	EmployeeStatusHistoriesTable = &schema.Table{
+		Name:       "employee_status_histories",
+		Columns:    EmployeeStatusHistoriesColumns,
+		PrimaryKey: []*schema.Column{EmployeeStatusHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "employee_status_histories_employees_status_histories",
+				Columns:    []*schema.Column{EmployeeStatusHistoriesColumns[6]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// HolidaysColumns holds the columns for the "holidays" table.
+	HolidaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "org_id", Type: field.TypeInt},
+	}
+	// HolidaysTable holds the schema information for the "holidays" table.
+	HolidaysTable = &schema.Table{
+		Name:       "holidays",
+		Columns:    HolidaysColumns,
+		PrimaryKey: []*schema.Column{HolidaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "holidays_organizations_holidays",
+				Columns:    []*schema.Column{HolidaysColumns[4]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "holiday_org_id_date",
+				Unique:  true,
+				Columns: []*schema.Column{HolidaysColumns[4], HolidaysColumns[1]},
+			},
+		},
+	}
 	// LabelsColumns holds the columns for the "labels" table.
 	LabelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -183,6 +463,95 @@ var (
 			},
 		},
 	}
+	// MilestonesColumns holds the columns for the "milestones" table.
+	MilestonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "goal", Type: field.TypeString, Nullable: true},
+		{Name: "start_at", Type: field.TypeTime, Nullable: true},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"planned", "active", "closed"}, Default: "planned"},
+		{Name: "creator_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// MilestonesTable holds the schema information for the "milestones" table.
+	MilestonesTable = &schema.Table{
+		Name:       "milestones",
+		Columns:    MilestonesColumns,
+		PrimaryKey: []*schema.Column{MilestonesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "milestones_projects_milestones",
+				Columns:    []*schema.Column{MilestonesColumns[9]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "milestone_project_id_end_at",
+				Unique:  false,
+				Columns: []*schema.Column{MilestonesColumns[9], MilestonesColumns[4]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"task_assigned", "task_status_changed", "task_due_soon", "task_overdue", "task_mentioned", "leave_decided"}},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "task_id", Type: field.TypeInt, Nullable: true},
+		{Name: "ref_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_employee_id", Type: field.TypeInt, Nullable: true},
+		{Name: "dedup_key", Type: field.TypeString, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_employee_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[2], NotificationsColumns[10]},
+			},
+			{
+				Name:    "notification_employee_id_dedup_key",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationsColumns[2], NotificationsColumns[9]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"task_assigned", "task_status_changed", "task_due_soon", "task_overdue", "task_mentioned", "leave_decided"}},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_employee_id_type",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[2], NotificationPreferencesColumns[3]},
+			},
+		},
+	}
 	// OrganizationsColumns holds the columns for the "organizations" table.
 	OrganizationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -195,6 +564,10 @@ var (
 		{Name: "website", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "weekend_days", Type: field.TypeJSON, Nullable: true},
+		{Name: "attachment_max_file_size", Type: field.TypeInt64, Default: 10485760},
+		{Name: "attachment_quota", Type: field.TypeInt64, Default: 1073741824},
+		{Name: "task_seq", Type: field.TypeInt, Default: 0},
 		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 	}
 	// OrganizationsTable holds the schema information for the "organizations" table.
@@ -205,12 +578,69 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "organizations_organizations_children",
-				Columns:    []*schema.Column{OrganizationsColumns[10]},
+				Columns:    []*schema.Column{OrganizationsColumns[14]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// OvertimeRequestsColumns holds the columns for the "overtime_requests" table.
+	OvertimeRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "work_date", Type: field.TypeTime},
+		{Name: "hours", Type: field.TypeFloat64},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "day_type", Type: field.TypeEnum, Enums: []string{"weekday", "weekend", "holiday"}, Default: "weekday"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "cancelled"}, Default: "pending"},
+		{Name: "approver_position_id", Type: field.TypeInt, Nullable: true},
+		{Name: "reviewer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+		{Name: "task_id", Type: field.TypeInt, Nullable: true},
+	}
+	// OvertimeRequestsTable holds the schema information for the "overtime_requests" table.
+	OvertimeRequestsTable = &schema.Table{
+		Name:       "overtime_requests",
+		Columns:    OvertimeRequestsColumns,
+		PrimaryKey: []*schema.Column{OvertimeRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "overtime_requests_employees_overtime_requests",
+				Columns:    []*schema.Column{OvertimeRequestsColumns[13]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "overtime_requests_projects_overtime_requests",
+				Columns:    []*schema.Column{OvertimeRequestsColumns[14]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "overtime_requests_tasks_overtime_requests",
+				Columns:    []*schema.Column{OvertimeRequestsColumns[15]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "overtimerequest_employee_id_work_date",
+				Unique:  false,
+				Columns: []*schema.Column{OvertimeRequestsColumns[13], OvertimeRequestsColumns[2]},
+			},
+			{
+				Name:    "overtimerequest_org_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{OvertimeRequestsColumns[1], OvertimeRequestsColumns[6]},
+			},
+		},
+	}
 	// PositionsColumns holds the columns for the "positions" table.
 	PositionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -258,6 +688,9 @@ var (
 		{Name: "end_at", Type: field.TypeTime, Nullable: true},
 		{Name: "process", Type: field.TypeInt, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"not_started", "in_progress", "completed"}, Default: "not_started"},
+		{Name: "manual_progress", Type: field.TypeBool, Default: false},
+		{Name: "key", Type: field.TypeString, Nullable: true},
+		{Name: "task_seq", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "creator_id", Type: field.TypeInt},
@@ -272,63 +705,628 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "projects_employees_created_projects",
-				Columns:    []*schema.Column{ProjectsColumns[10]},
+				Columns:    []*schema.Column{ProjectsColumns[13]},
 				RefColumns: []*schema.Column{EmployeesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "projects_employees_updated_projects",
-				Columns:    []*schema.Column{ProjectsColumns[11]},
+				Columns:    []*schema.Column{ProjectsColumns[14]},
 				RefColumns: []*schema.Column{EmployeesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "projects_organizations_projects",
-				Columns:    []*schema.Column{ProjectsColumns[12]},
+				Columns:    []*schema.Column{ProjectsColumns[15]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "project_org_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{ProjectsColumns[15], ProjectsColumns[9]},
+			},
+		},
 	}
-	// TasksColumns holds the columns for the "tasks" table.
-	TasksColumns = []*schema.Column{
+	// ProjectRolesColumns holds the columns for the "project_roles" table.
+	ProjectRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "name", Type: field.TypeString},
-		{Name: "code", Type: field.TypeString, Unique: true},
-		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "process", Type: field.TypeInt, Default: 0},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"not_received", "received", "in_progress", "completed", "cancelled"}, Default: "not_received"},
-		{Name: "start_at", Type: field.TypeTime, Nullable: true},
-		{Name: "due_date", Type: field.TypeTime, Nullable: true},
-		{Name: "creator_id", Type: field.TypeInt},
-		{Name: "updater_id", Type: field.TypeInt},
-		{Name: "created_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "manager", "member", "viewer"}},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"task", "feature", "bug", "another"}, Default: "task"},
-		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+		{Name: "project_id", Type: field.TypeInt},
 	}
-	// TasksTable holds the schema information for the "tasks" table.
-	TasksTable = &schema.Table{
-		Name:       "tasks",
-		Columns:    TasksColumns,
-		PrimaryKey: []*schema.Column{TasksColumns[0]},
+	// ProjectRolesTable holds the schema information for the "project_roles" table.
+	ProjectRolesTable = &schema.Table{
+		Name:       "project_roles",
+		Columns:    ProjectRolesColumns,
+		PrimaryKey: []*schema.Column{ProjectRolesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "tasks_projects_tasks",
-				Columns:    []*schema.Column{TasksColumns[13]},
+				Symbol:     "project_roles_projects_roles",
+				Columns:    []*schema.Column{ProjectRolesColumns[4]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectrole_project_id_employee_id",
+				Unique:  true,
+				Columns: []*schema.Column{ProjectRolesColumns[4], ProjectRolesColumns[1]},
 			},
 		},
 	}
-	// TaskReportsColumns holds the columns for the "task_reports" table.
-	TaskReportsColumns = []*schema.Column{
+	// ReminderSettingsColumns holds the columns for the "reminder_settings" table.
+	ReminderSettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "content", Type: field.TypeString, Nullable: true},
-		{Name: "created_at", Type: field.TypeTime},
+		{Name: "org_id", Type: field.TypeInt, Unique: true},
+		{Name: "due_soon_enabled", Type: field.TypeBool, Default: true},
+		{Name: "due_soon_hours", Type: field.TypeInt, Default: 24},
+		{Name: "overdue_enabled", Type: field.TypeBool, Default: true},
+		{Name: "overdue_repeat_hours", Type: field.TypeInt, Default: 24},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "reporter_id", Type: field.TypeInt},
-		{Name: "task_id", Type: field.TypeInt},
+	}
+	// ReminderSettingsTable holds the schema information for the "reminder_settings" table.
+	ReminderSettingsTable = &schema.Table{
+		Name:       "reminder_settings",
+		Columns:    ReminderSettingsColumns,
+		PrimaryKey: []*schema.Column{ReminderSettingsColumns[0]},
+	}
+	// RostersColumns holds the columns for the "rosters" table.
+	RostersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "work_date", Type: field.TypeTime},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "shift_id", Type: field.TypeInt},
+	}
+	// RostersTable holds the schema information for the "rosters" table.
+	RostersTable = &schema.Table{
+		Name:       "rosters",
+		Columns:    RostersColumns,
+		PrimaryKey: []*schema.Column{RostersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rosters_employees_rosters",
+				Columns:    []*schema.Column{RostersColumns[7]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "rosters_shifts_rosters",
+				Columns:    []*schema.Column{RostersColumns[8]},
+				RefColumns: []*schema.Column{ShiftsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roster_employee_id_work_date",
+				Unique:  true,
+				Columns: []*schema.Column{RostersColumns[7], RostersColumns[2]},
+			},
+			{
+				Name:    "roster_org_id_work_date",
+				Unique:  false,
+				Columns: []*schema.Column{RostersColumns[1], RostersColumns[2]},
+			},
+		},
+	}
+	// SalaryGradesColumns holds the columns for the "salary_grades" table.
+	SalaryGradesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "min_amount", Type: field.TypeInt64},
+		{Name: "max_amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "VND"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "position_id", Type: field.TypeInt, Nullable: true},
+	}
+	// SalaryGradesTable holds the schema information for the "salary_grades" table.
+	SalaryGradesTable = &schema.Table{
+		Name:       "salary_grades",
+		Columns:    SalaryGradesColumns,
+		PrimaryKey: []*schema.Column{SalaryGradesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "salary_grades_organizations_salary_grades",
+				Columns:    []*schema.Column{SalaryGradesColumns[8]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "salary_grades_positions_salary_grades",
+				Columns:    []*schema.Column{SalaryGradesColumns[9]},
+				RefColumns: []*schema.Column{PositionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "salarygrade_org_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{SalaryGradesColumns[8], SalaryGradesColumns[1]},
+			},
+		},
+	}
+	// ShiftsColumns holds the columns for the "shifts" table.
+	ShiftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "start_time", Type: field.TypeString},
+		{Name: "end_time", Type: field.TypeString},
+		{Name: "break_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "break_paid", Type: field.TypeBool, Default: false},
+		{Name: "is_night", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "org_id", Type: field.TypeInt},
+	}
+	// ShiftsTable holds the schema information for the "shifts" table.
+	ShiftsTable = &schema.Table{
+		Name:       "shifts",
+		Columns:    ShiftsColumns,
+		PrimaryKey: []*schema.Column{ShiftsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shifts_organizations_shifts",
+				Columns:    []*schema.Column{ShiftsColumns[10]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shift_org_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{ShiftsColumns[10], ShiftsColumns[1]},
+			},
+		},
+	}
+	// ShiftSwapRequestsColumns holds the columns for the "shift_swap_requests" table.
+	ShiftSwapRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "requester_id", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "pending"},
+		{Name: "reviewer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "roster_id", Type: field.TypeInt},
+		{Name: "target_roster_id", Type: field.TypeInt},
+	}
+	// ShiftSwapRequestsTable holds the schema information for the "shift_swap_requests" table.
+	ShiftSwapRequestsTable = &schema.Table{
+		Name:       "shift_swap_requests",
+		Columns:    ShiftSwapRequestsColumns,
+		PrimaryKey: []*schema.Column{ShiftSwapRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shift_swap_requests_rosters_swap_requests",
+				Columns:    []*schema.Column{ShiftSwapRequestsColumns[10]},
+				RefColumns: []*schema.Column{RostersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "shift_swap_requests_rosters_targeted_swap_requests",
+				Columns:    []*schema.Column{ShiftSwapRequestsColumns[11]},
+				RefColumns: []*schema.Column{RostersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shiftswaprequest_org_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ShiftSwapRequestsColumns[1], ShiftSwapRequestsColumns[4]},
+			},
+		},
+	}
+	// SprintsColumns holds the columns for the "sprints" table.
+	SprintsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "goal", Type: field.TypeString, Nullable: true},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"planned", "active", "closed"}, Default: "planned"},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "committed_task_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "completed_task_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "incomplete_task_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "creator_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// SprintsTable holds the schema information for the "sprints" table.
+	SprintsTable = &schema.Table{
+		Name:       "sprints",
+		Columns:    SprintsColumns,
+		PrimaryKey: []*schema.Column{SprintsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sprints_projects_sprints",
+				Columns:    []*schema.Column{SprintsColumns[14]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sprint_project_id_start_at",
+				Unique:  false,
+				Columns: []*schema.Column{SprintsColumns[14], SprintsColumns[3]},
+			},
+			{
+				Name:    "sprint_project_id",
+				Unique:  true,
+				Columns: []*schema.Column{SprintsColumns[14]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "state = 'active'",
+				},
+			},
+		},
+	}
+	// TasksColumns holds the columns for the "tasks" table.
+	TasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "code", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "process", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"not_received", "received", "in_progress", "completed", "cancelled"}, Default: "not_received"},
+		{Name: "start_at", Type: field.TypeTime, Nullable: true},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true},
+		{Name: "creator_id", Type: field.TypeInt},
+		{Name: "updater_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"task", "feature", "bug", "another"}, Default: "task"},
+		{Name: "original_estimate", Type: field.TypeInt, Nullable: true},
+		{Name: "remaining_estimate", Type: field.TypeInt, Nullable: true},
+		{Name: "workflow_status", Type: field.TypeString, Default: ""},
+		{Name: "rank", Type: field.TypeString, Default: ""},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "recurrence_run_id", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "milestone_id", Type: field.TypeInt, Nullable: true},
+		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+		{Name: "sprint_id", Type: field.TypeInt, Nullable: true},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+	}
+	// TasksTable holds the schema information for the "tasks" table.
+	TasksTable = &schema.Table{
+		Name:       "tasks",
+		Columns:    TasksColumns,
+		PrimaryKey: []*schema.Column{TasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tasks_milestones_tasks",
+				Columns:    []*schema.Column{TasksColumns[20]},
+				RefColumns: []*schema.Column{MilestonesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_projects_tasks",
+				Columns:    []*schema.Column{TasksColumns[21]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_sprints_tasks",
+				Columns:    []*schema.Column{TasksColumns[22]},
+				RefColumns: []*schema.Column{SprintsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_tasks_children",
+				Columns:    []*schema.Column{TasksColumns[23]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "task_project_id_workflow_status_rank",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[21], TasksColumns[15], TasksColumns[16]},
+			},
+			{
+				Name:    "task_org_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{TasksColumns[19], TasksColumns[2]},
+			},
+			{
+				Name:    "task_org_id_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[19], TasksColumns[11]},
+			},
+			{
+				Name:    "task_org_id_due_date",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[19], TasksColumns[7]},
+			},
+		},
+	}
+	// TaskActivitiesColumns holds the columns for the "task_activities" table.
+	TaskActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_employee_id", Type: field.TypeInt, Nullable: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"created", "field_changed", "status_changed", "assignee_added", "assignee_removed", "label_added", "label_removed", "comment_added", "comment_edited", "comment_deleted", "report_submitted", "report_updated", "report_reviewed"}},
+		{Name: "field_name", Type: field.TypeString, Nullable: true},
+		{Name: "old_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "new_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "ref_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeInt},
+	}
+	// TaskActivitiesTable holds the schema information for the "task_activities" table.
+	TaskActivitiesTable = &schema.Table{
+		Name:       "task_activities",
+		Columns:    TaskActivitiesColumns,
+		PrimaryKey: []*schema.Column{TaskActivitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_activities_tasks_activities",
+				Columns:    []*schema.Column{TaskActivitiesColumns[11]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskactivity_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskActivitiesColumns[11]},
+			},
+			{
+				Name:    "taskactivity_org_id_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskActivitiesColumns[1], TaskActivitiesColumns[2]},
+			},
+		},
+	}
+	// TaskCommentsColumns holds the columns for the "task_comments" table.
+	TaskCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "author_id", Type: field.TypeInt},
+		{Name: "task_id", Type: field.TypeInt},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+	}
+	// TaskCommentsTable holds the schema information for the "task_comments" table.
+	TaskCommentsTable = &schema.Table{
+		Name:       "task_comments",
+		Columns:    TaskCommentsColumns,
+		PrimaryKey: []*schema.Column{TaskCommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_comments_employees_task_comments",
+				Columns:    []*schema.Column{TaskCommentsColumns[6]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "task_comments_tasks_comments",
+				Columns:    []*schema.Column{TaskCommentsColumns[7]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_comments_task_comments_replies",
+				Columns:    []*schema.Column{TaskCommentsColumns[8]},
+				RefColumns: []*schema.Column{TaskCommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskcomment_task_id_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskCommentsColumns[7], TaskCommentsColumns[8]},
+			},
+		},
+	}
+	// TaskCommentRevisionsColumns holds the columns for the "task_comment_revisions" table.
+	TaskCommentRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "editor_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "comment_id", Type: field.TypeInt},
+	}
+	// TaskCommentRevisionsTable holds the schema information for the "task_comment_revisions" table.
+	TaskCommentRevisionsTable = &schema.Table{
+		Name:       "task_comment_revisions",
+		Columns:    TaskCommentRevisionsColumns,
+		PrimaryKey: []*schema.Column{TaskCommentRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_comment_revisions_task_comments_revisions",
+				Columns:    []*schema.Column{TaskCommentRevisionsColumns[4]},
+				RefColumns: []*schema.Column{TaskCommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TaskDependenciesColumns holds the columns for the "task_dependencies" table.
+	TaskDependenciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"blocks", "relates_to", "duplicates"}, Default: "blocks"},
+		{Name: "creator_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeInt},
+		{Name: "target_id", Type: field.TypeInt},
+	}
+	// TaskDependenciesTable holds the schema information for the "task_dependencies" table.
+	TaskDependenciesTable = &schema.Table{
+		Name:       "task_dependencies",
+		Columns:    TaskDependenciesColumns,
+		PrimaryKey: []*schema.Column{TaskDependenciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_dependencies_tasks_dependencies",
+				Columns:    []*schema.Column{TaskDependenciesColumns[4]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_dependencies_tasks_dependents",
+				Columns:    []*schema.Column{TaskDependenciesColumns[5]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskdependency_task_id_target_id_type",
+				Unique:  true,
+				Columns: []*schema.Column{TaskDependenciesColumns[4], TaskDependenciesColumns[5], TaskDependenciesColumns[1]},
+			},
+		},
+	}
+	// TaskRecurrencesColumns holds the columns for the "task_recurrences" table.
+	TaskRecurrencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "rule", Type: field.TypeString},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "until", Type: field.TypeTime, Nullable: true},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "creator_id", Type: field.TypeInt},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "template_id", Type: field.TypeInt},
+	}
+	// TaskRecurrencesTable holds the schema information for the "task_recurrences" table.
+	TaskRecurrencesTable = &schema.Table{
+		Name:       "task_recurrences",
+		Columns:    TaskRecurrencesColumns,
+		PrimaryKey: []*schema.Column{TaskRecurrencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_recurrences_task_templates_recurrences",
+				Columns:    []*schema.Column{TaskRecurrencesColumns[12]},
+				RefColumns: []*schema.Column{TaskTemplatesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskrecurrence_enabled_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{TaskRecurrencesColumns[7], TaskRecurrencesColumns[4]},
+			},
+		},
+	}
+	// TaskRecurrenceRunsColumns holds the columns for the "task_recurrence_runs" table.
+	TaskRecurrenceRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "occurrence_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "created", "failed"}, Default: "pending"},
+		{Name: "task_id", Type: field.TypeInt, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "recurrence_id", Type: field.TypeInt},
+	}
+	// TaskRecurrenceRunsTable holds the schema information for the "task_recurrence_runs" table.
+	TaskRecurrenceRunsTable = &schema.Table{
+		Name:       "task_recurrence_runs",
+		Columns:    TaskRecurrenceRunsColumns,
+		PrimaryKey: []*schema.Column{TaskRecurrenceRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_recurrence_runs_task_recurrences_runs",
+				Columns:    []*schema.Column{TaskRecurrenceRunsColumns[8]},
+				RefColumns: []*schema.Column{TaskRecurrencesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskrecurrencerun_recurrence_id_occurrence_at",
+				Unique:  true,
+				Columns: []*schema.Column{TaskRecurrenceRunsColumns[8], TaskRecurrenceRunsColumns[1]},
+			},
+			{
+				Name:    "taskrecurrencerun_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{TaskRecurrenceRunsColumns[2], TaskRecurrenceRunsColumns[7]},
+			},
+		},
+	}
+	// TaskRemindersColumns holds the columns for the "task_reminders" table.
+	TaskRemindersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"due_soon", "overdue"}},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "seq", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeInt},
+	}
+	// TaskRemindersTable holds the schema information for the "task_reminders" table.
+	TaskRemindersTable = &schema.Table{
+		Name:       "task_reminders",
+		Columns:    TaskRemindersColumns,
+		PrimaryKey: []*schema.Column{TaskRemindersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_reminders_tasks_reminders",
+				Columns:    []*schema.Column{TaskRemindersColumns[6]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskreminder_task_id_kind_due_date_seq",
+				Unique:  true,
+				Columns: []*schema.Column{TaskRemindersColumns[6], TaskRemindersColumns[2], TaskRemindersColumns[3], TaskRemindersColumns[4]},
+			},
+		},
+	}
+	// TaskReportsColumns holds the columns for the "task_reports" table.
+	TaskReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "content", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "progress", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"submitted", "accepted", "needs_changes"}, Default: "submitted"},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reporter_id", Type: field.TypeInt},
+		{Name: "reviewer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "task_id", Type: field.TypeInt},
 	}
 	// TaskReportsTable holds the schema information for the "task_reports" table.
 	TaskReportsTable = &schema.Table{
@@ -338,16 +1336,250 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "task_reports_employees_task_reports",
-				Columns:    []*schema.Column{TaskReportsColumns[4]},
+				Columns:    []*schema.Column{TaskReportsColumns[8]},
 				RefColumns: []*schema.Column{EmployeesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "task_reports_employees_reviewed_task_reports",
+				Columns:    []*schema.Column{TaskReportsColumns[9]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "task_reports_tasks_reports",
-				Columns:    []*schema.Column{TaskReportsColumns[5]},
+				Columns:    []*schema.Column{TaskReportsColumns[10]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// TaskTemplatesColumns holds the columns for the "task_templates" table.
+	TaskTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"task", "feature", "bug", "another"}, Default: "task"},
+		{Name: "label_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "assignee_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "start_offset", Type: field.TypeInt, Nullable: true},
+		{Name: "due_offset", Type: field.TypeInt, Nullable: true},
+		{Name: "original_estimate", Type: field.TypeInt, Nullable: true},
+		{Name: "creator_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+	}
+	// TaskTemplatesTable holds the schema information for the "task_templates" table.
+	TaskTemplatesTable = &schema.Table{
+		Name:       "task_templates",
+		Columns:    TaskTemplatesColumns,
+		PrimaryKey: []*schema.Column{TaskTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_templates_projects_task_templates",
+				Columns:    []*schema.Column{TaskTemplatesColumns[13]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tasktemplate_org_id_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskTemplatesColumns[1], TaskTemplatesColumns[13]},
+			},
+		},
+	}
+	// TaskViewsColumns holds the columns for the "task_views" table.
+	TaskViewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "org_id", Type: field.TypeInt},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "project", "organization"}, Default: "private"},
+		{Name: "filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+	}
+	// TaskViewsTable holds the schema information for the "task_views" table.
+	TaskViewsTable = &schema.Table{
+		Name:       "task_views",
+		Columns:    TaskViewsColumns,
+		PrimaryKey: []*schema.Column{TaskViewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_views_projects_task_views",
+				Columns:    []*schema.Column{TaskViewsColumns[9]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskview_org_id_employee_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskViewsColumns[1], TaskViewsColumns[2]},
+			},
+			{
+				Name:    "taskview_org_id_visibility",
+				Unique:  false,
+				Columns: []*schema.Column{TaskViewsColumns[1], TaskViewsColumns[4]},
+			},
+			{
+				Name:    "taskview_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskViewsColumns[9]},
+			},
+		},
+	}
+	// TaskWatchersColumns holds the columns for the "task_watchers" table.
+	TaskWatchersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeInt},
+	}
+	// TaskWatchersTable holds the schema information for the "task_watchers" table.
+	TaskWatchersTable = &schema.Table{
+		Name:       "task_watchers",
+		Columns:    TaskWatchersColumns,
+		PrimaryKey: []*schema.Column{TaskWatchersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_watchers_tasks_watchers",
+				Columns:    []*schema.Column{TaskWatchersColumns[3]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskwatcher_task_id_employee_id",
+				Unique:  true,
+				Columns: []*schema.Column{TaskWatchersColumns[3], TaskWatchersColumns[1]},
+			},
+			{
+				Name:    "taskwatcher_employee_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskWatchersColumns[1]},
+			},
+		},
+	}
+	// WorkLogsColumns holds the columns for the "work_logs" table.
+	WorkLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "duration", Type: field.TypeInt, Default: 0},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeInt},
+		{Name: "task_id", Type: field.TypeInt},
+	}
+	// WorkLogsTable holds the schema information for the "work_logs" table.
+	WorkLogsTable = &schema.Table{
+		Name:       "work_logs",
+		Columns:    WorkLogsColumns,
+		PrimaryKey: []*schema.Column{WorkLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "work_logs_employees_work_logs",
+				Columns:    []*schema.Column{WorkLogsColumns[7]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "work_logs_tasks_work_logs",
+				Columns:    []*schema.Column{WorkLogsColumns[8]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "worklog_task_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkLogsColumns[8], WorkLogsColumns[1]},
+			},
+			{
+				Name:    "worklog_employee_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkLogsColumns[7], WorkLogsColumns[1]},
+			},
+			{
+				Name:    "worklog_employee_id",
+				Unique:  true,
+				Columns: []*schema.Column{WorkLogsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "ended_at IS NULL",
+				},
+			},
+		},
+	}
+	// WorkflowStatusColumns holds the columns for the "workflow_status" table.
+	WorkflowStatusColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"todo", "doing", "done"}, Default: "todo"},
+		{Name: "system_status", Type: field.TypeEnum, Enums: []string{"not_received", "received", "in_progress", "completed", "cancelled"}, Default: "not_received"},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "wip_limit", Type: field.TypeInt, Nullable: true},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// WorkflowStatusTable holds the schema information for the "workflow_status" table.
+	WorkflowStatusTable = &schema.Table{
+		Name:       "workflow_status",
+		Columns:    WorkflowStatusColumns,
+		PrimaryKey: []*schema.Column{WorkflowStatusColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workflow_status_projects_workflow_statuses",
+				Columns:    []*schema.Column{WorkflowStatusColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflowstatus_project_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{WorkflowStatusColumns[7], WorkflowStatusColumns[1]},
+			},
+		},
+	}
+	// WorkflowTransitionsColumns holds the columns for the "workflow_transitions" table.
+	WorkflowTransitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_key", Type: field.TypeString, Default: ""},
+		{Name: "to_key", Type: field.TypeString},
+		{Name: "guards", Type: field.TypeJSON, Nullable: true},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// WorkflowTransitionsTable holds the schema information for the "workflow_transitions" table.
+	WorkflowTransitionsTable = &schema.Table{
+		Name:       "workflow_transitions",
+		Columns:    WorkflowTransitionsColumns,
+		PrimaryKey: []*schema.Column{WorkflowTransitionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workflow_transitions_projects_workflow_transitions",
+				Columns:    []*schema.Column{WorkflowTransitionsColumns[4]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflowtransition_project_id_from_key_to_key",
+				Unique:  true,
+				Columns: []*schema.Column{WorkflowTransitionsColumns[4], WorkflowTransitionsColumns[1], WorkflowTransitionsColumns[2]},
+			},
 		},
 	}
 	// ProjectMembersColumns holds the columns for the "project_members" table.
@@ -425,47 +1657,153 @@ var (
 			},
 		},
 	}
+	// TaskCommentMentionsColumns holds the columns for the "task_comment_mentions" table.
+	TaskCommentMentionsColumns = []*schema.Column{
+		{Name: "task_comment_id", Type: field.TypeInt},
+		{Name: "employee_id", Type: field.TypeInt},
+	}
+	// TaskCommentMentionsTable holds the schema information for the "task_comment_mentions" table.
+	TaskCommentMentionsTable = &schema.Table{
+		Name:       "task_comment_mentions",
+		Columns:    TaskCommentMentionsColumns,
+		PrimaryKey: []*schema.Column{TaskCommentMentionsColumns[0], TaskCommentMentionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_comment_mentions_task_comment_id",
+				Columns:    []*schema.Column{TaskCommentMentionsColumns[0]},
+				RefColumns: []*schema.Column{TaskCommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_comment_mentions_employee_id",
+				Columns:    []*schema.Column{TaskCommentMentionsColumns[1]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AppointmentHistoriesTable,
+		AttachmentsTable,
+		AttendanceCorrectionsTable,
+		AttendanceRecordsTable,
+		CompensationsTable,
 		DepartmentsTable,
 		EmployeesTable,
+		EmployeeContractsTable,
+		EmployeeStatusHistoriesTable,
+		HolidaysTable,
 		LabelsTable,
 		LeaveApprovalsTable,
 		LeaveRequestsTable,
+		MilestonesTable,
+		NotificationsTable,
+		NotificationPreferencesTable,
 		OrganizationsTable,
+		OvertimeRequestsTable,
 		PositionsTable,
 		ProjectsTable,
+		ProjectRolesTable,
+		ReminderSettingsTable,
+		RostersTable,
+		SalaryGradesTable,
+		ShiftsTable,
+		ShiftSwapRequestsTable,
+		SprintsTable,
 		TasksTable,
+		TaskActivitiesTable,
+		TaskCommentsTable,
+		TaskCommentRevisionsTable,
+		TaskDependenciesTable,
+		TaskRecurrencesTable,
+		TaskRecurrenceRunsTable,
+		TaskRemindersTable,
 		TaskReportsTable,
+		TaskTemplatesTable,
+		TaskViewsTable,
+		TaskWatchersTable,
+		WorkLogsTable,
+		WorkflowStatusTable,
+		WorkflowTransitionsTable,
 		ProjectMembersTable,
 		TaskLabelsTable,
 		TaskAssigneesTable,
+		TaskCommentMentionsTable,
 	}
 )
 
 func init() {
-	AppointmentHistoriesTable.ForeignKeys[0].RefTable = EmployeesTable
+	AppointmentHistoriesTable.ForeignKeys[0].RefTable = DepartmentsTable
+	AppointmentHistoriesTable.ForeignKeys[1].RefTable = EmployeesTable
+	AppointmentHistoriesTable.ForeignKeys[2].RefTable = PositionsTable
+	AttachmentsTable.ForeignKeys[0].RefTable = EmployeesTable
+	AttachmentsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	AttendanceCorrectionsTable.ForeignKeys[0].RefTable = AttendanceRecordsTable
+	AttendanceCorrectionsTable.ForeignKeys[1].RefTable = EmployeesTable
+	AttendanceRecordsTable.ForeignKeys[0].RefTable = EmployeesTable
+	AttendanceRecordsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	CompensationsTable.ForeignKeys[0].RefTable = EmployeesTable
+	CompensationsTable.ForeignKeys[1].RefTable = SalaryGradesTable
 	DepartmentsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	EmployeesTable.ForeignKeys[0].RefTable = PositionsTable
+	EmployeeContractsTable.ForeignKeys[0].RefTable = EmployeesTable
+	EmployeeStatusHistoriesTable.ForeignKeys[0].RefTable = EmployeesTable
+	HolidaysTable.ForeignKeys[0].RefTable = OrganizationsTable
 	LabelsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	LeaveApprovalsTable.ForeignKeys[0].RefTable = EmployeesTable
 	LeaveApprovalsTable.ForeignKeys[1].RefTable = LeaveRequestsTable
 	LeaveRequestsTable.ForeignKeys[0].RefTable = EmployeesTable
 	LeaveRequestsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	MilestonesTable.ForeignKeys[0].RefTable = ProjectsTable
 	OrganizationsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OvertimeRequestsTable.ForeignKeys[0].RefTable = EmployeesTable
+	OvertimeRequestsTable.ForeignKeys[1].RefTable = ProjectsTable
+	OvertimeRequestsTable.ForeignKeys[2].RefTable = TasksTable
 	PositionsTable.ForeignKeys[0].RefTable = DepartmentsTable
 	PositionsTable.ForeignKeys[1].RefTable = PositionsTable
 	ProjectsTable.ForeignKeys[0].RefTable = EmployeesTable
 	ProjectsTable.ForeignKeys[1].RefTable = EmployeesTable
 	ProjectsTable.ForeignKeys[2].RefTable = OrganizationsTable
-	TasksTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectRolesTable.ForeignKeys[0].RefTable = ProjectsTable
+	RostersTable.ForeignKeys[0].RefTable = EmployeesTable
+	RostersTable.ForeignKeys[1].RefTable = ShiftsTable
+	SalaryGradesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	SalaryGradesTable.ForeignKeys[1].RefTable = PositionsTable
+	ShiftsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	ShiftSwapRequestsTable.ForeignKeys[0].RefTable = RostersTable
+	ShiftSwapRequestsTable.ForeignKeys[1].RefTable = RostersTable
+	SprintsTable.ForeignKeys[0].RefTable = ProjectsTable
+	TasksTable.ForeignKeys[0].RefTable = MilestonesTable
+	TasksTable.ForeignKeys[1].RefTable = ProjectsTable
+	TasksTable.ForeignKeys[2].RefTable = SprintsTable
+	TasksTable.ForeignKeys[3].RefTable = TasksTable
+	TaskActivitiesTable.ForeignKeys[0].RefTable = TasksTable
+	TaskCommentsTable.ForeignKeys[0].RefTable = EmployeesTable
+	TaskCommentsTable.ForeignKeys[1].RefTable = TasksTable
+	TaskCommentsTable.ForeignKeys[2].RefTable = TaskCommentsTable
+	TaskCommentRevisionsTable.ForeignKeys[0].RefTable = TaskCommentsTable
+	TaskDependenciesTable.ForeignKeys[0].RefTable = TasksTable
+	TaskDependenciesTable.ForeignKeys[1].RefTable = TasksTable
+	TaskRecurrencesTable.ForeignKeys[0].RefTable = TaskTemplatesTable
+	TaskRecurrenceRunsTable.ForeignKeys[0].RefTable = TaskRecurrencesTable
+	TaskRemindersTable.ForeignKeys[0].RefTable = TasksTable
 	TaskReportsTable.ForeignKeys[0].RefTable = EmployeesTable
-	TaskReportsTable.ForeignKeys[1].RefTable = TasksTable
+	TaskReportsTable.ForeignKeys[1].RefTable = EmployeesTable
+	TaskReportsTable.ForeignKeys[2].RefTable = TasksTable
+	TaskTemplatesTable.ForeignKeys[0].RefTable = ProjectsTable
+	TaskViewsTable.ForeignKeys[0].RefTable = ProjectsTable
+	TaskWatchersTable.ForeignKeys[0].RefTable = TasksTable
+	WorkLogsTable.ForeignKeys[0].RefTable = EmployeesTable
+	WorkLogsTable.ForeignKeys[1].RefTable = TasksTable
+	WorkflowStatusTable.ForeignKeys[0].RefTable = ProjectsTable
+	WorkflowTransitionsTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProjectMembersTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProjectMembersTable.ForeignKeys[1].RefTable = EmployeesTable
 	TaskLabelsTable.ForeignKeys[0].RefTable = TasksTable
 	TaskLabelsTable.ForeignKeys[1].RefTable = LabelsTable
 	TaskAssigneesTable.ForeignKeys[0].RefTable = TasksTable
 	TaskAssigneesTable.ForeignKeys[1].RefTable = EmployeesTable
+	TaskCommentMentionsTable.ForeignKeys[0].RefTable = TaskCommentsTable
+	TaskCommentMentionsTable.ForeignKeys[1].RefTable = EmployeesTable
 }
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+)
+
+// Milestone is the model entity for the Milestone schema.
+type Milestone struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Goal holds the value of the "goal" field.
+	Goal string `json:"goal"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt *time.Time `json:"start_at"`
+	// EndAt holds the value of the "end_at" field.
+	EndAt *time.Time `json:"end_at"`
+	// State holds the value of the "state" field.
+	State milestone.State `json:"state"`
+	// CreatorID holds the value of the "creator_id" field.
+	CreatorID int `json:"creator_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MilestoneQuery when eager-loading is set.
+	Edges        MilestoneEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MilestoneEdges holds the relations/edges for other nodes in the graph.
+type MilestoneEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project"`
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MilestoneEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e MilestoneEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[1] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Milestone) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case milestone.FieldID, milestone.FieldProjectID, milestone.FieldCreatorID:
+			values[i] = new(sql.NullInt64)
+		case milestone.FieldName, milestone.FieldGoal, milestone.FieldState:
+			values[i] = new(sql.NullString)
+		case milestone.FieldStartAt, milestone.FieldEndAt, milestone.FieldCreatedAt, milestone.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Milestone fields.
+func (m *Milestone) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case milestone.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case milestone.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				m.ProjectID = int(value.Int64)
+			}
+		case milestone.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				m.Name = value.String
+			}
+		case milestone.FieldGoal:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field goal", values[i])
+			} else if value.Valid {
+				m.Goal = value.String
+			}
+		case milestone.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				m.StartAt = new(time.Time)
+				*m.StartAt = value.Time
+			}
+		case milestone.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				m.EndAt = new(time.Time)
+				*m.EndAt = value.Time
+			}
+		case milestone.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				m.State = milestone.State(value.String)
+			}
+		case milestone.FieldCreatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value.Valid {
+				m.CreatorID = int(value.Int64)
+			}
+		case milestone.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case milestone.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				m.UpdatedAt = value.Time
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Milestone.
+// This includes values selected through modifiers, order, etc.
+func (m *Milestone) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Milestone entity.
+func (m *Milestone) QueryProject() *ProjectQuery {
+	return NewMilestoneClient(m.config).QueryProject(m)
+}
+
+// QueryTasks queries the "tasks" edge of the Milestone entity.
+func (m *Milestone) QueryTasks() *TaskQuery {
+	return NewMilestoneClient(m.config).QueryTasks(m)
+}
+
+// Update returns a builder for updating this Milestone.
+// Note that you need to call Milestone.Unwrap() before calling this method if this Milestone
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Milestone) Update() *MilestoneUpdateOne {
+	return NewMilestoneClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Milestone entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Milestone) Unwrap() *Milestone {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Milestone is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Milestone) String() string {
+	var builder strings.Builder
+	builder.WriteString("Milestone(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("goal=")
+	builder.WriteString(m.Goal)
+	builder.WriteString(", ")
+	if v := m.StartAt; v != nil {
+		builder.WriteString("start_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := m.EndAt; v != nil {
+		builder.WriteString("end_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", m.State))
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Milestones is a parsable slice of Milestone.
+type Milestones []*Milestone
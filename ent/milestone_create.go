@@ -0,0 +1,1052 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// MilestoneCreate is the builder for creating a Milestone entity.
+type MilestoneCreate struct {
+	config
+	mutation *MilestoneMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProjectID sets the "project_id" field.
+func (mc *MilestoneCreate) SetProjectID(i int) *MilestoneCreate {
+	mc.mutation.SetProjectID(i)
+	return mc
+}
+
+// SetName sets the "name" field.
+func (mc *MilestoneCreate) SetName(s string) *MilestoneCreate {
+	mc.mutation.SetName(s)
+	return mc
+}
+
+// SetGoal sets the "goal" field.
+func (mc *MilestoneCreate) SetGoal(s string) *MilestoneCreate {
+	mc.mutation.SetGoal(s)
+	return mc
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (mc *MilestoneCreate) SetNillableGoal(s *string) *MilestoneCreate {
+	if s != nil {
+		mc.SetGoal(*s)
+	}
+	return mc
+}
+
+// SetStartAt sets the "start_at" field.
+func (mc *MilestoneCreate) SetStartAt(t time.Time) *MilestoneCreate {
+	mc.mutation.SetStartAt(t)
+	return mc
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (mc *MilestoneCreate) SetNillableStartAt(t *time.Time) *MilestoneCreate {
+	if t != nil {
+		mc.SetStartAt(*t)
+	}
+	return mc
+}
+
+// SetEndAt sets the "end_at" field.
+func (mc *MilestoneCreate) SetEndAt(t time.Time) *MilestoneCreate {
+	mc.mutation.SetEndAt(t)
+	return mc
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (mc *MilestoneCreate) SetNillableEndAt(t *time.Time) *MilestoneCreate {
+	if t != nil {
+		mc.SetEndAt(*t)
+	}
+	return mc
+}
+
+// SetState sets the "state" field.
+func (mc *MilestoneCreate) SetState(m milestone.State) *MilestoneCreate {
+	mc.mutation.SetState(m)
+	return mc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (mc *MilestoneCreate) SetNillableState(m *milestone.State) *MilestoneCreate {
+	if m != nil {
+		mc.SetState(*m)
+	}
+	return mc
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (mc *MilestoneCreate) SetCreatorID(i int) *MilestoneCreate {
+	mc.mutation.SetCreatorID(i)
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MilestoneCreate) SetCreatedAt(t time.Time) *MilestoneCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MilestoneCreate) SetNillableCreatedAt(t *time.Time) *MilestoneCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *MilestoneCreate) SetUpdatedAt(t time.Time) *MilestoneCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *MilestoneCreate) SetNillableUpdatedAt(t *time.Time) *MilestoneCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (mc *MilestoneCreate) SetProject(p *Project) *MilestoneCreate {
+	return mc.SetProjectID(p.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (mc *MilestoneCreate) AddTaskIDs(ids ...int) *MilestoneCreate {
+	mc.mutation.AddTaskIDs(ids...)
+	return mc
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (mc *MilestoneCreate) AddTasks(t ...*Task) *MilestoneCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddTaskIDs(ids...)
+}
+
+// Mutation returns the MilestoneMutation object of the builder.
+func (mc *MilestoneCreate) Mutation() *MilestoneMutation {
+	return mc.mutation
+}
+
+// Save creates the Milestone in the database.
+func (mc *MilestoneCreate) Save(ctx context.Context) (*Milestone, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MilestoneCreate) SaveX(ctx context.Context) *Milestone {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MilestoneCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MilestoneCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MilestoneCreate) defaults() {
+	if _, ok := mc.mutation.State(); !ok {
+		v := milestone.DefaultState
+		mc.mutation.SetState(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := milestone.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		v := milestone.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MilestoneCreate) check() error {
+	if _, ok := mc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "Milestone.project_id"`)}
+	}
+	if _, ok := mc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Milestone.name"`)}
+	}
+	if v, ok := mc.mutation.Name(); ok {
+		if err := milestone.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Milestone.name": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Milestone.state"`)}
+	}
+	if v, ok := mc.mutation.State(); ok {
+		if err := milestone.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Milestone.state": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`ent: missing required field "Milestone.creator_id"`)}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Milestone.created_at"`)}
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Milestone.updated_at"`)}
+	}
+	if len(mc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "Milestone.project"`)}
+	}
+	return nil
+}
+
+func (mc *MilestoneCreate) sqlSave(ctx context.Context) (*Milestone, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MilestoneCreate) createSpec() (*Milestone, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Milestone{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(milestone.Table, sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = mc.conflict
+	if value, ok := mc.mutation.Name(); ok {
+		_spec.SetField(milestone.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mc.mutation.Goal(); ok {
+		_spec.SetField(milestone.FieldGoal, field.TypeString, value)
+		_node.Goal = value
+	}
+	if value, ok := mc.mutation.StartAt(); ok {
+		_spec.SetField(milestone.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = &value
+	}
+	if value, ok := mc.mutation.EndAt(); ok {
+		_spec.SetField(milestone.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = &value
+	}
+	if value, ok := mc.mutation.State(); ok {
+		_spec.SetField(milestone.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := mc.mutation.CreatorID(); ok {
+		_spec.SetField(milestone.FieldCreatorID, field.TypeInt, value)
+		_node.CreatorID = value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(milestone.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(milestone.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := mc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Milestone.Create().
+//		SetProjectID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MilestoneUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (mc *MilestoneCreate) OnConflict(opts ...sql.ConflictOption) *MilestoneUpsertOne {
+	mc.conflict = opts
+	return &MilestoneUpsertOne{
+		create: mc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Milestone.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mc *MilestoneCreate) OnConflictColumns(columns ...string) *MilestoneUpsertOne {
+	mc.conflict = append(mc.conflict, sql.ConflictColumns(columns...))
+	return &MilestoneUpsertOne{
+		create: mc,
+	}
+}
+
+type (
+	// MilestoneUpsertOne is the builder for "upsert"-ing
+	//  one Milestone node.
+	MilestoneUpsertOne struct {
+		create *MilestoneCreate
+	}
+
+	// MilestoneUpsert is the "OnConflict" setter.
+	MilestoneUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProjectID sets the "project_id" field.
+func (u *MilestoneUpsert) SetProjectID(v int) *MilestoneUpsert {
+	u.Set(milestone.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *MilestoneUpsert) UpdateProjectID() *MilestoneUpsert {
+	u.SetExcluded(milestone.FieldProjectID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MilestoneUpsert) SetName(v string) *MilestoneUpsert {
+	u.Set(milestone.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MilestoneUpsert) UpdateName() *MilestoneUpsert {
+	u.SetExcluded(milestone.FieldName)
+	return u
+}
+
+// SetGoal sets the "goal" field.
+func (u *MilestoneUpsert) SetGoal(v string) *MilestoneUpsert {
+	u.Set(milestone.FieldGoal, v)
+	return u
+}
+
+// UpdateGoal sets the "goal" field to the value that was provided on create.
+func (u *MilestoneUpsert) UpdateGoal() *MilestoneUpsert {
+	u.SetExcluded(milestone.FieldGoal)
+	return u
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (u *MilestoneUpsert) ClearGoal() *MilestoneUpsert {
+	u.SetNull(milestone.FieldGoal)
+	return u
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *MilestoneUpsert) SetStartAt(v time.Time) *MilestoneUpsert {
+	u.Set(milestone.FieldStartAt, v)
+	return u
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *MilestoneUpsert) UpdateStartAt() *MilestoneUpsert {
+	u.SetExcluded(milestone.FieldStartAt)
+	return u
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (u *MilestoneUpsert) ClearStartAt() *MilestoneUpsert {
+	u.SetNull(milestone.FieldStartAt)
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *MilestoneUpsert) SetEndAt(v time.Time) *MilestoneUpsert {
+	u.Set(milestone.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *MilestoneUpsert) UpdateEndAt() *MilestoneUpsert {
+	u.SetExcluded(milestone.FieldEndAt)
+	return u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *MilestoneUpsert) ClearEndAt() *MilestoneUpsert {
+	u.SetNull(milestone.FieldEndAt)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *MilestoneUpsert) SetState(v milestone.State) *MilestoneUpsert {
+	u.Set(milestone.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *MilestoneUpsert) UpdateState() *MilestoneUpsert {
+	u.SetExcluded(milestone.FieldState)
+	return u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *MilestoneUpsert) SetCreatorID(v int) *MilestoneUpsert {
+	u.Set(milestone.FieldCreatorID, v)
+	return u
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *MilestoneUpsert) UpdateCreatorID() *MilestoneUpsert {
+	u.SetExcluded(milestone.FieldCreatorID)
+	return u
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *MilestoneUpsert) AddCreatorID(v int) *MilestoneUpsert {
+	u.Add(milestone.FieldCreatorID, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MilestoneUpsert) SetUpdatedAt(v time.Time) *MilestoneUpsert {
+	u.Set(milestone.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MilestoneUpsert) UpdateUpdatedAt() *MilestoneUpsert {
+	u.SetExcluded(milestone.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Milestone.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MilestoneUpsertOne) UpdateNewValues() *MilestoneUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(milestone.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Milestone.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MilestoneUpsertOne) Ignore() *MilestoneUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MilestoneUpsertOne) DoNothing() *MilestoneUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MilestoneCreate.OnConflict
+// documentation for more info.
+func (u *MilestoneUpsertOne) Update(set func(*MilestoneUpsert)) *MilestoneUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MilestoneUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *MilestoneUpsertOne) SetProjectID(v int) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *MilestoneUpsertOne) UpdateProjectID() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MilestoneUpsertOne) SetName(v string) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MilestoneUpsertOne) UpdateName() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetGoal sets the "goal" field.
+func (u *MilestoneUpsertOne) SetGoal(v string) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetGoal(v)
+	})
+}
+
+// UpdateGoal sets the "goal" field to the value that was provided on create.
+func (u *MilestoneUpsertOne) UpdateGoal() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateGoal()
+	})
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (u *MilestoneUpsertOne) ClearGoal() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.ClearGoal()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *MilestoneUpsertOne) SetStartAt(v time.Time) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *MilestoneUpsertOne) UpdateStartAt() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (u *MilestoneUpsertOne) ClearStartAt() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.ClearStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *MilestoneUpsertOne) SetEndAt(v time.Time) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *MilestoneUpsertOne) UpdateEndAt() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *MilestoneUpsertOne) ClearEndAt() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *MilestoneUpsertOne) SetState(v milestone.State) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *MilestoneUpsertOne) UpdateState() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *MilestoneUpsertOne) SetCreatorID(v int) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *MilestoneUpsertOne) AddCreatorID(v int) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *MilestoneUpsertOne) UpdateCreatorID() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MilestoneUpsertOne) SetUpdatedAt(v time.Time) *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MilestoneUpsertOne) UpdateUpdatedAt() *MilestoneUpsertOne {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MilestoneUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MilestoneCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MilestoneUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MilestoneUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MilestoneUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MilestoneCreateBulk is the builder for creating many Milestone entities in bulk.
+type MilestoneCreateBulk struct {
+	config
+	err      error
+	builders []*MilestoneCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Milestone entities in the database.
+func (mcb *MilestoneCreateBulk) Save(ctx context.Context) ([]*Milestone, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Milestone, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MilestoneMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MilestoneCreateBulk) SaveX(ctx context.Context) []*Milestone {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MilestoneCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MilestoneCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Milestone.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MilestoneUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (mcb *MilestoneCreateBulk) OnConflict(opts ...sql.ConflictOption) *MilestoneUpsertBulk {
+	mcb.conflict = opts
+	return &MilestoneUpsertBulk{
+		create: mcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Milestone.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcb *MilestoneCreateBulk) OnConflictColumns(columns ...string) *MilestoneUpsertBulk {
+	mcb.conflict = append(mcb.conflict, sql.ConflictColumns(columns...))
+	return &MilestoneUpsertBulk{
+		create: mcb,
+	}
+}
+
+// MilestoneUpsertBulk is the builder for "upsert"-ing
+// a bulk of Milestone nodes.
+type MilestoneUpsertBulk struct {
+	create *MilestoneCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Milestone.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MilestoneUpsertBulk) UpdateNewValues() *MilestoneUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(milestone.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Milestone.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MilestoneUpsertBulk) Ignore() *MilestoneUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MilestoneUpsertBulk) DoNothing() *MilestoneUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MilestoneCreateBulk.OnConflict
+// documentation for more info.
+func (u *MilestoneUpsertBulk) Update(set func(*MilestoneUpsert)) *MilestoneUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MilestoneUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *MilestoneUpsertBulk) SetProjectID(v int) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *MilestoneUpsertBulk) UpdateProjectID() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MilestoneUpsertBulk) SetName(v string) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MilestoneUpsertBulk) UpdateName() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetGoal sets the "goal" field.
+func (u *MilestoneUpsertBulk) SetGoal(v string) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetGoal(v)
+	})
+}
+
+// UpdateGoal sets the "goal" field to the value that was provided on create.
+func (u *MilestoneUpsertBulk) UpdateGoal() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateGoal()
+	})
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (u *MilestoneUpsertBulk) ClearGoal() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.ClearGoal()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *MilestoneUpsertBulk) SetStartAt(v time.Time) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *MilestoneUpsertBulk) UpdateStartAt() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (u *MilestoneUpsertBulk) ClearStartAt() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.ClearStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *MilestoneUpsertBulk) SetEndAt(v time.Time) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *MilestoneUpsertBulk) UpdateEndAt() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *MilestoneUpsertBulk) ClearEndAt() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *MilestoneUpsertBulk) SetState(v milestone.State) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *MilestoneUpsertBulk) UpdateState() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *MilestoneUpsertBulk) SetCreatorID(v int) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *MilestoneUpsertBulk) AddCreatorID(v int) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *MilestoneUpsertBulk) UpdateCreatorID() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MilestoneUpsertBulk) SetUpdatedAt(v time.Time) *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MilestoneUpsertBulk) UpdateUpdatedAt() *MilestoneUpsertBulk {
+	return u.Update(func(s *MilestoneUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MilestoneUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MilestoneCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MilestoneCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MilestoneUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,804 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// MilestoneUpdate is the builder for updating Milestone entities.
+type MilestoneUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MilestoneMutation
+}
+
+// Where appends a list predicates to the MilestoneUpdate builder.
+func (mu *MilestoneUpdate) Where(ps ...predicate.Milestone) *MilestoneUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetProjectID sets the "project_id" field.
+func (mu *MilestoneUpdate) SetProjectID(i int) *MilestoneUpdate {
+	mu.mutation.SetProjectID(i)
+	return mu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (mu *MilestoneUpdate) SetNillableProjectID(i *int) *MilestoneUpdate {
+	if i != nil {
+		mu.SetProjectID(*i)
+	}
+	return mu
+}
+
+// SetName sets the "name" field.
+func (mu *MilestoneUpdate) SetName(s string) *MilestoneUpdate {
+	mu.mutation.SetName(s)
+	return mu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mu *MilestoneUpdate) SetNillableName(s *string) *MilestoneUpdate {
+	if s != nil {
+		mu.SetName(*s)
+	}
+	return mu
+}
+
+// SetGoal sets the "goal" field.
+func (mu *MilestoneUpdate) SetGoal(s string) *MilestoneUpdate {
+	mu.mutation.SetGoal(s)
+	return mu
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (mu *MilestoneUpdate) SetNillableGoal(s *string) *MilestoneUpdate {
+	if s != nil {
+		mu.SetGoal(*s)
+	}
+	return mu
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (mu *MilestoneUpdate) ClearGoal() *MilestoneUpdate {
+	mu.mutation.ClearGoal()
+	return mu
+}
+
+// SetStartAt sets the "start_at" field.
+func (mu *MilestoneUpdate) SetStartAt(t time.Time) *MilestoneUpdate {
+	mu.mutation.SetStartAt(t)
+	return mu
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (mu *MilestoneUpdate) SetNillableStartAt(t *time.Time) *MilestoneUpdate {
+	if t != nil {
+		mu.SetStartAt(*t)
+	}
+	return mu
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (mu *MilestoneUpdate) ClearStartAt() *MilestoneUpdate {
+	mu.mutation.ClearStartAt()
+	return mu
+}
+
+// SetEndAt sets the "end_at" field.
+func (mu *MilestoneUpdate) SetEndAt(t time.Time) *MilestoneUpdate {
+	mu.mutation.SetEndAt(t)
+	return mu
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (mu *MilestoneUpdate) SetNillableEndAt(t *time.Time) *MilestoneUpdate {
+	if t != nil {
+		mu.SetEndAt(*t)
+	}
+	return mu
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (mu *MilestoneUpdate) ClearEndAt() *MilestoneUpdate {
+	mu.mutation.ClearEndAt()
+	return mu
+}
+
+// SetState sets the "state" field.
+func (mu *MilestoneUpdate) SetState(m milestone.State) *MilestoneUpdate {
+	mu.mutation.SetState(m)
+	return mu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (mu *MilestoneUpdate) SetNillableState(m *milestone.State) *MilestoneUpdate {
+	if m != nil {
+		mu.SetState(*m)
+	}
+	return mu
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (mu *MilestoneUpdate) SetCreatorID(i int) *MilestoneUpdate {
+	mu.mutation.ResetCreatorID()
+	mu.mutation.SetCreatorID(i)
+	return mu
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (mu *MilestoneUpdate) SetNillableCreatorID(i *int) *MilestoneUpdate {
+	if i != nil {
+		mu.SetCreatorID(*i)
+	}
+	return mu
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (mu *MilestoneUpdate) AddCreatorID(i int) *MilestoneUpdate {
+	mu.mutation.AddCreatorID(i)
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MilestoneUpdate) SetUpdatedAt(t time.Time) *MilestoneUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (mu *MilestoneUpdate) SetProject(p *Project) *MilestoneUpdate {
+	return mu.SetProjectID(p.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (mu *MilestoneUpdate) AddTaskIDs(ids ...int) *MilestoneUpdate {
+	mu.mutation.AddTaskIDs(ids...)
+	return mu
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (mu *MilestoneUpdate) AddTasks(t ...*Task) *MilestoneUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddTaskIDs(ids...)
+}
+
+// Mutation returns the MilestoneMutation object of the builder.
+func (mu *MilestoneUpdate) Mutation() *MilestoneMutation {
+	return mu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (mu *MilestoneUpdate) ClearProject() *MilestoneUpdate {
+	mu.mutation.ClearProject()
+	return mu
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (mu *MilestoneUpdate) ClearTasks() *MilestoneUpdate {
+	mu.mutation.ClearTasks()
+	return mu
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (mu *MilestoneUpdate) RemoveTaskIDs(ids ...int) *MilestoneUpdate {
+	mu.mutation.RemoveTaskIDs(ids...)
+	return mu
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (mu *MilestoneUpdate) RemoveTasks(t ...*Task) *MilestoneUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MilestoneUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MilestoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MilestoneUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MilestoneUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MilestoneUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := milestone.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MilestoneUpdate) check() error {
+	if v, ok := mu.mutation.Name(); ok {
+		if err := milestone.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Milestone.name": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.State(); ok {
+		if err := milestone.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Milestone.state": %w`, err)}
+		}
+	}
+	if mu.mutation.ProjectCleared() && len(mu.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Milestone.project"`)
+	}
+	return nil
+}
+
+func (mu *MilestoneUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(milestone.Table, milestone.Columns, sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.Name(); ok {
+		_spec.SetField(milestone.FieldName, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Goal(); ok {
+		_spec.SetField(milestone.FieldGoal, field.TypeString, value)
+	}
+	if mu.mutation.GoalCleared() {
+		_spec.ClearField(milestone.FieldGoal, field.TypeString)
+	}
+	if value, ok := mu.mutation.StartAt(); ok {
+		_spec.SetField(milestone.FieldStartAt, field.TypeTime, value)
+	}
+	if mu.mutation.StartAtCleared() {
+		_spec.ClearField(milestone.FieldStartAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.EndAt(); ok {
+		_spec.SetField(milestone.FieldEndAt, field.TypeTime, value)
+	}
+	if mu.mutation.EndAtCleared() {
+		_spec.ClearField(milestone.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.State(); ok {
+		_spec.SetField(milestone.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.CreatorID(); ok {
+		_spec.SetField(milestone.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedCreatorID(); ok {
+		_spec.AddField(milestone.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(milestone.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedTasksIDs(); len(nodes) > 0 && !mu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{milestone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MilestoneUpdateOne is the builder for updating a single Milestone entity.
+type MilestoneUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MilestoneMutation
+}
+
+// SetProjectID sets the "project_id" field.
+func (muo *MilestoneUpdateOne) SetProjectID(i int) *MilestoneUpdateOne {
+	muo.mutation.SetProjectID(i)
+	return muo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (muo *MilestoneUpdateOne) SetNillableProjectID(i *int) *MilestoneUpdateOne {
+	if i != nil {
+		muo.SetProjectID(*i)
+	}
+	return muo
+}
+
+// SetName sets the "name" field.
+func (muo *MilestoneUpdateOne) SetName(s string) *MilestoneUpdateOne {
+	muo.mutation.SetName(s)
+	return muo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (muo *MilestoneUpdateOne) SetNillableName(s *string) *MilestoneUpdateOne {
+	if s != nil {
+		muo.SetName(*s)
+	}
+	return muo
+}
+
+// SetGoal sets the "goal" field.
+func (muo *MilestoneUpdateOne) SetGoal(s string) *MilestoneUpdateOne {
+	muo.mutation.SetGoal(s)
+	return muo
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (muo *MilestoneUpdateOne) SetNillableGoal(s *string) *MilestoneUpdateOne {
+	if s != nil {
+		muo.SetGoal(*s)
+	}
+	return muo
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (muo *MilestoneUpdateOne) ClearGoal() *MilestoneUpdateOne {
+	muo.mutation.ClearGoal()
+	return muo
+}
+
+// SetStartAt sets the "start_at" field.
+func (muo *MilestoneUpdateOne) SetStartAt(t time.Time) *MilestoneUpdateOne {
+	muo.mutation.SetStartAt(t)
+	return muo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (muo *MilestoneUpdateOne) SetNillableStartAt(t *time.Time) *MilestoneUpdateOne {
+	if t != nil {
+		muo.SetStartAt(*t)
+	}
+	return muo
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (muo *MilestoneUpdateOne) ClearStartAt() *MilestoneUpdateOne {
+	muo.mutation.ClearStartAt()
+	return muo
+}
+
+// SetEndAt sets the "end_at" field.
+func (muo *MilestoneUpdateOne) SetEndAt(t time.Time) *MilestoneUpdateOne {
+	muo.mutation.SetEndAt(t)
+	return muo
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (muo *MilestoneUpdateOne) SetNillableEndAt(t *time.Time) *MilestoneUpdateOne {
+	if t != nil {
+		muo.SetEndAt(*t)
+	}
+	return muo
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (muo *MilestoneUpdateOne) ClearEndAt() *MilestoneUpdateOne {
+	muo.mutation.ClearEndAt()
+	return muo
+}
+
+// SetState sets the "state" field.
+func (muo *MilestoneUpdateOne) SetState(m milestone.State) *MilestoneUpdateOne {
+	muo.mutation.SetState(m)
+	return muo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (muo *MilestoneUpdateOne) SetNillableState(m *milestone.State) *MilestoneUpdateOne {
+	if m != nil {
+		muo.SetState(*m)
+	}
+	return muo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (muo *MilestoneUpdateOne) SetCreatorID(i int) *MilestoneUpdateOne {
+	muo.mutation.ResetCreatorID()
+	muo.mutation.SetCreatorID(i)
+	return muo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (muo *MilestoneUpdateOne) SetNillableCreatorID(i *int) *MilestoneUpdateOne {
+	if i != nil {
+		muo.SetCreatorID(*i)
+	}
+	return muo
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (muo *MilestoneUpdateOne) AddCreatorID(i int) *MilestoneUpdateOne {
+	muo.mutation.AddCreatorID(i)
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MilestoneUpdateOne) SetUpdatedAt(t time.Time) *MilestoneUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (muo *MilestoneUpdateOne) SetProject(p *Project) *MilestoneUpdateOne {
+	return muo.SetProjectID(p.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (muo *MilestoneUpdateOne) AddTaskIDs(ids ...int) *MilestoneUpdateOne {
+	muo.mutation.AddTaskIDs(ids...)
+	return muo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (muo *MilestoneUpdateOne) AddTasks(t ...*Task) *MilestoneUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddTaskIDs(ids...)
+}
+
+// Mutation returns the MilestoneMutation object of the builder.
+func (muo *MilestoneUpdateOne) Mutation() *MilestoneMutation {
+	return muo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (muo *MilestoneUpdateOne) ClearProject() *MilestoneUpdateOne {
+	muo.mutation.ClearProject()
+	return muo
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (muo *MilestoneUpdateOne) ClearTasks() *MilestoneUpdateOne {
+	muo.mutation.ClearTasks()
+	return muo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (muo *MilestoneUpdateOne) RemoveTaskIDs(ids ...int) *MilestoneUpdateOne {
+	muo.mutation.RemoveTaskIDs(ids...)
+	return muo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (muo *MilestoneUpdateOne) RemoveTasks(t ...*Task) *MilestoneUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the MilestoneUpdate builder.
+func (muo *MilestoneUpdateOne) Where(ps ...predicate.Milestone) *MilestoneUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MilestoneUpdateOne) Select(field string, fields ...string) *MilestoneUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Milestone entity.
+func (muo *MilestoneUpdateOne) Save(ctx context.Context) (*Milestone, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MilestoneUpdateOne) SaveX(ctx context.Context) *Milestone {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MilestoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MilestoneUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MilestoneUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := milestone.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MilestoneUpdateOne) check() error {
+	if v, ok := muo.mutation.Name(); ok {
+		if err := milestone.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Milestone.name": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.State(); ok {
+		if err := milestone.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Milestone.state": %w`, err)}
+		}
+	}
+	if muo.mutation.ProjectCleared() && len(muo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Milestone.project"`)
+	}
+	return nil
+}
+
+func (muo *MilestoneUpdateOne) sqlSave(ctx context.Context) (_node *Milestone, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(milestone.Table, milestone.Columns, sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Milestone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, milestone.FieldID)
+		for _, f := range fields {
+			if !milestone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != milestone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.Name(); ok {
+		_spec.SetField(milestone.FieldName, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Goal(); ok {
+		_spec.SetField(milestone.FieldGoal, field.TypeString, value)
+	}
+	if muo.mutation.GoalCleared() {
+		_spec.ClearField(milestone.FieldGoal, field.TypeString)
+	}
+	if value, ok := muo.mutation.StartAt(); ok {
+		_spec.SetField(milestone.FieldStartAt, field.TypeTime, value)
+	}
+	if muo.mutation.StartAtCleared() {
+		_spec.ClearField(milestone.FieldStartAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.EndAt(); ok {
+		_spec.SetField(milestone.FieldEndAt, field.TypeTime, value)
+	}
+	if muo.mutation.EndAtCleared() {
+		_spec.ClearField(milestone.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.State(); ok {
+		_spec.SetField(milestone.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.CreatorID(); ok {
+		_spec.SetField(milestone.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedCreatorID(); ok {
+		_spec.AddField(milestone.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(milestone.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !muo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Milestone{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{milestone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
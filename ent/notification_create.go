@@ -0,0 +1,1230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+)
+
+// NotificationCreate is the builder for creating a Notification entity.
+type NotificationCreate struct {
+	config
+	mutation *NotificationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (nc *NotificationCreate) SetOrgID(i int) *NotificationCreate {
+	nc.mutation.SetOrgID(i)
+	return nc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (nc *NotificationCreate) SetEmployeeID(i int) *NotificationCreate {
+	nc.mutation.SetEmployeeID(i)
+	return nc
+}
+
+// SetType sets the "type" field.
+func (nc *NotificationCreate) SetType(n notification.Type) *NotificationCreate {
+	nc.mutation.SetType(n)
+	return nc
+}
+
+// SetTitle sets the "title" field.
+func (nc *NotificationCreate) SetTitle(s string) *NotificationCreate {
+	nc.mutation.SetTitle(s)
+	return nc
+}
+
+// SetBody sets the "body" field.
+func (nc *NotificationCreate) SetBody(s string) *NotificationCreate {
+	nc.mutation.SetBody(s)
+	return nc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableBody(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetBody(*s)
+	}
+	return nc
+}
+
+// SetTaskID sets the "task_id" field.
+func (nc *NotificationCreate) SetTaskID(i int) *NotificationCreate {
+	nc.mutation.SetTaskID(i)
+	return nc
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableTaskID(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetTaskID(*i)
+	}
+	return nc
+}
+
+// SetRefID sets the "ref_id" field.
+func (nc *NotificationCreate) SetRefID(i int) *NotificationCreate {
+	nc.mutation.SetRefID(i)
+	return nc
+}
+
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableRefID(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetRefID(*i)
+	}
+	return nc
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (nc *NotificationCreate) SetActorEmployeeID(i int) *NotificationCreate {
+	nc.mutation.SetActorEmployeeID(i)
+	return nc
+}
+
+// SetNillableActorEmployeeID sets the "actor_employee_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableActorEmployeeID(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetActorEmployeeID(*i)
+	}
+	return nc
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (nc *NotificationCreate) SetDedupKey(s string) *NotificationCreate {
+	nc.mutation.SetDedupKey(s)
+	return nc
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableDedupKey(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetDedupKey(*s)
+	}
+	return nc
+}
+
+// SetReadAt sets the "read_at" field.
+func (nc *NotificationCreate) SetReadAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetReadAt(t)
+	return nc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableReadAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetReadAt(*t)
+	}
+	return nc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NotificationCreate) SetCreatedAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableCreatedAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetCreatedAt(*t)
+	}
+	return nc
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nc *NotificationCreate) Mutation() *NotificationMutation {
+	return nc.mutation
+}
+
+// Save creates the Notification in the database.
+func (nc *NotificationCreate) Save(ctx context.Context) (*Notification, error) {
+	nc.defaults()
+	return withHooks(ctx, nc.sqlSave, nc.mutation, nc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NotificationCreate) SaveX(ctx context.Context) *Notification {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NotificationCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NotificationCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NotificationCreate) defaults() {
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		v := notification.DefaultCreatedAt()
+		nc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NotificationCreate) check() error {
+	if _, ok := nc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "Notification.org_id"`)}
+	}
+	if _, ok := nc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "Notification.employee_id"`)}
+	}
+	if _, ok := nc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Notification.type"`)}
+	}
+	if v, ok := nc.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Notification.title"`)}
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Notification.created_at"`)}
+	}
+	return nil
+}
+
+func (nc *NotificationCreate) sqlSave(ctx context.Context) (*Notification, error) {
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nc.mutation.id = &_node.ID
+	nc.mutation.done = true
+	return _node, nil
+}
+
+func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notification{config: nc.config}
+		_spec = sqlgraph.NewCreateSpec(notification.Table, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = nc.conflict
+	if value, ok := nc.mutation.OrgID(); ok {
+		_spec.SetField(notification.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := nc.mutation.EmployeeID(); ok {
+		_spec.SetField(notification.FieldEmployeeID, field.TypeInt, value)
+		_node.EmployeeID = value
+	}
+	if value, ok := nc.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := nc.mutation.Title(); ok {
+		_spec.SetField(notification.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := nc.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := nc.mutation.TaskID(); ok {
+		_spec.SetField(notification.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = value
+	}
+	if value, ok := nc.mutation.RefID(); ok {
+		_spec.SetField(notification.FieldRefID, field.TypeInt, value)
+		_node.RefID = value
+	}
+	if value, ok := nc.mutation.ActorEmployeeID(); ok {
+		_spec.SetField(notification.FieldActorEmployeeID, field.TypeInt, value)
+		_node.ActorEmployeeID = value
+	}
+	if value, ok := nc.mutation.DedupKey(); ok {
+		_spec.SetField(notification.FieldDedupKey, field.TypeString, value)
+		_node.DedupKey = value
+	}
+	if value, ok := nc.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.SetField(notification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notification.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (nc *NotificationCreate) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertOne {
+	nc.conflict = opts
+	return &NotificationUpsertOne{
+		create: nc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nc *NotificationCreate) OnConflictColumns(columns ...string) *NotificationUpsertOne {
+	nc.conflict = append(nc.conflict, sql.ConflictColumns(columns...))
+	return &NotificationUpsertOne{
+		create: nc,
+	}
+}
+
+type (
+	// NotificationUpsertOne is the builder for "upsert"-ing
+	//  one Notification node.
+	NotificationUpsertOne struct {
+		create *NotificationCreate
+	}
+
+	// NotificationUpsert is the "OnConflict" setter.
+	NotificationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *NotificationUpsert) SetOrgID(v int) *NotificationUpsert {
+	u.Set(notification.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateOrgID() *NotificationUpsert {
+	u.SetExcluded(notification.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *NotificationUpsert) AddOrgID(v int) *NotificationUpsert {
+	u.Add(notification.FieldOrgID, v)
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *NotificationUpsert) SetEmployeeID(v int) *NotificationUpsert {
+	u.Set(notification.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateEmployeeID() *NotificationUpsert {
+	u.SetExcluded(notification.FieldEmployeeID)
+	return u
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *NotificationUpsert) AddEmployeeID(v int) *NotificationUpsert {
+	u.Add(notification.FieldEmployeeID, v)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *NotificationUpsert) SetType(v notification.Type) *NotificationUpsert {
+	u.Set(notification.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateType() *NotificationUpsert {
+	u.SetExcluded(notification.FieldType)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationUpsert) SetTitle(v string) *NotificationUpsert {
+	u.Set(notification.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateTitle() *NotificationUpsert {
+	u.SetExcluded(notification.FieldTitle)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationUpsert) SetBody(v string) *NotificationUpsert {
+	u.Set(notification.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateBody() *NotificationUpsert {
+	u.SetExcluded(notification.FieldBody)
+	return u
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationUpsert) ClearBody() *NotificationUpsert {
+	u.SetNull(notification.FieldBody)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *NotificationUpsert) SetTaskID(v int) *NotificationUpsert {
+	u.Set(notification.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateTaskID() *NotificationUpsert {
+	u.SetExcluded(notification.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *NotificationUpsert) AddTaskID(v int) *NotificationUpsert {
+	u.Add(notification.FieldTaskID, v)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *NotificationUpsert) ClearTaskID() *NotificationUpsert {
+	u.SetNull(notification.FieldTaskID)
+	return u
+}
+
+// SetRefID sets the "ref_id" field.
+func (u *NotificationUpsert) SetRefID(v int) *NotificationUpsert {
+	u.Set(notification.FieldRefID, v)
+	return u
+}
+
+// UpdateRefID sets the "ref_id" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateRefID() *NotificationUpsert {
+	u.SetExcluded(notification.FieldRefID)
+	return u
+}
+
+// AddRefID adds v to the "ref_id" field.
+func (u *NotificationUpsert) AddRefID(v int) *NotificationUpsert {
+	u.Add(notification.FieldRefID, v)
+	return u
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (u *NotificationUpsert) ClearRefID() *NotificationUpsert {
+	u.SetNull(notification.FieldRefID)
+	return u
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (u *NotificationUpsert) SetActorEmployeeID(v int) *NotificationUpsert {
+	u.Set(notification.FieldActorEmployeeID, v)
+	return u
+}
+
+// UpdateActorEmployeeID sets the "actor_employee_id" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateActorEmployeeID() *NotificationUpsert {
+	u.SetExcluded(notification.FieldActorEmployeeID)
+	return u
+}
+
+// AddActorEmployeeID adds v to the "actor_employee_id" field.
+func (u *NotificationUpsert) AddActorEmployeeID(v int) *NotificationUpsert {
+	u.Add(notification.FieldActorEmployeeID, v)
+	return u
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (u *NotificationUpsert) ClearActorEmployeeID() *NotificationUpsert {
+	u.SetNull(notification.FieldActorEmployeeID)
+	return u
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (u *NotificationUpsert) SetDedupKey(v string) *NotificationUpsert {
+	u.Set(notification.FieldDedupKey, v)
+	return u
+}
+
+// UpdateDedupKey sets the "dedup_key" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateDedupKey() *NotificationUpsert {
+	u.SetExcluded(notification.FieldDedupKey)
+	return u
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (u *NotificationUpsert) ClearDedupKey() *NotificationUpsert {
+	u.SetNull(notification.FieldDedupKey)
+	return u
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsert) SetReadAt(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldReadAt, v)
+	return u
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateReadAt() *NotificationUpsert {
+	u.SetExcluded(notification.FieldReadAt)
+	return u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsert) ClearReadAt() *NotificationUpsert {
+	u.SetNull(notification.FieldReadAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NotificationUpsertOne) UpdateNewValues() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notification.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationUpsertOne) Ignore() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationUpsertOne) DoNothing() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationCreate.OnConflict
+// documentation for more info.
+func (u *NotificationUpsertOne) Update(set func(*NotificationUpsert)) *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *NotificationUpsertOne) SetOrgID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *NotificationUpsertOne) AddOrgID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateOrgID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *NotificationUpsertOne) SetEmployeeID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *NotificationUpsertOne) AddEmployeeID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateEmployeeID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *NotificationUpsertOne) SetType(v notification.Type) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateType() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationUpsertOne) SetTitle(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateTitle() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationUpsertOne) SetBody(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateBody() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationUpsertOne) ClearBody() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *NotificationUpsertOne) SetTaskID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *NotificationUpsertOne) AddTaskID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateTaskID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *NotificationUpsertOne) ClearTaskID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetRefID sets the "ref_id" field.
+func (u *NotificationUpsertOne) SetRefID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetRefID(v)
+	})
+}
+
+// AddRefID adds v to the "ref_id" field.
+func (u *NotificationUpsertOne) AddRefID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddRefID(v)
+	})
+}
+
+// UpdateRefID sets the "ref_id" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateRefID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateRefID()
+	})
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (u *NotificationUpsertOne) ClearRefID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearRefID()
+	})
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (u *NotificationUpsertOne) SetActorEmployeeID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetActorEmployeeID(v)
+	})
+}
+
+// AddActorEmployeeID adds v to the "actor_employee_id" field.
+func (u *NotificationUpsertOne) AddActorEmployeeID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddActorEmployeeID(v)
+	})
+}
+
+// UpdateActorEmployeeID sets the "actor_employee_id" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateActorEmployeeID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateActorEmployeeID()
+	})
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (u *NotificationUpsertOne) ClearActorEmployeeID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearActorEmployeeID()
+	})
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (u *NotificationUpsertOne) SetDedupKey(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetDedupKey(v)
+	})
+}
+
+// UpdateDedupKey sets the "dedup_key" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateDedupKey() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateDedupKey()
+	})
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (u *NotificationUpsertOne) ClearDedupKey() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearDedupKey()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertOne) SetReadAt(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertOne) ClearReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationCreateBulk is the builder for creating many Notification entities in bulk.
+type NotificationCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Notification entities in the database.
+func (ncb *NotificationCreateBulk) Save(ctx context.Context) ([]*Notification, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Notification, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ncb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) SaveX(ctx context.Context) []*Notification {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notification.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (ncb *NotificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertBulk {
+	ncb.conflict = opts
+	return &NotificationUpsertBulk{
+		create: ncb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ncb *NotificationCreateBulk) OnConflictColumns(columns ...string) *NotificationUpsertBulk {
+	ncb.conflict = append(ncb.conflict, sql.ConflictColumns(columns...))
+	return &NotificationUpsertBulk{
+		create: ncb,
+	}
+}
+
+// NotificationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Notification nodes.
+type NotificationUpsertBulk struct {
+	create *NotificationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NotificationUpsertBulk) UpdateNewValues() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notification.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationUpsertBulk) Ignore() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationUpsertBulk) DoNothing() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationUpsertBulk) Update(set func(*NotificationUpsert)) *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *NotificationUpsertBulk) SetOrgID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *NotificationUpsertBulk) AddOrgID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateOrgID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *NotificationUpsertBulk) SetEmployeeID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *NotificationUpsertBulk) AddEmployeeID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateEmployeeID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *NotificationUpsertBulk) SetType(v notification.Type) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateType() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationUpsertBulk) SetTitle(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateTitle() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationUpsertBulk) SetBody(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateBody() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationUpsertBulk) ClearBody() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *NotificationUpsertBulk) SetTaskID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *NotificationUpsertBulk) AddTaskID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateTaskID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *NotificationUpsertBulk) ClearTaskID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetRefID sets the "ref_id" field.
+func (u *NotificationUpsertBulk) SetRefID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetRefID(v)
+	})
+}
+
+// AddRefID adds v to the "ref_id" field.
+func (u *NotificationUpsertBulk) AddRefID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddRefID(v)
+	})
+}
+
+// UpdateRefID sets the "ref_id" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateRefID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateRefID()
+	})
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (u *NotificationUpsertBulk) ClearRefID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearRefID()
+	})
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (u *NotificationUpsertBulk) SetActorEmployeeID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetActorEmployeeID(v)
+	})
+}
+
+// AddActorEmployeeID adds v to the "actor_employee_id" field.
+func (u *NotificationUpsertBulk) AddActorEmployeeID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.AddActorEmployeeID(v)
+	})
+}
+
+// UpdateActorEmployeeID sets the "actor_employee_id" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateActorEmployeeID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateActorEmployeeID()
+	})
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (u *NotificationUpsertBulk) ClearActorEmployeeID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearActorEmployeeID()
+	})
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (u *NotificationUpsertBulk) SetDedupKey(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetDedupKey(v)
+	})
+}
+
+// UpdateDedupKey sets the "dedup_key" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateDedupKey() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateDedupKey()
+	})
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (u *NotificationUpsertBulk) ClearDedupKey() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearDedupKey()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertBulk) SetReadAt(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertBulk) ClearReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetOrgID sets the "org_id" field.
+func (nu *NotificationUpdate) SetOrgID(i int) *NotificationUpdate {
+	nu.mutation.ResetOrgID()
+	nu.mutation.SetOrgID(i)
+	return nu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableOrgID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetOrgID(*i)
+	}
+	return nu
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (nu *NotificationUpdate) AddOrgID(i int) *NotificationUpdate {
+	nu.mutation.AddOrgID(i)
+	return nu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (nu *NotificationUpdate) SetEmployeeID(i int) *NotificationUpdate {
+	nu.mutation.ResetEmployeeID()
+	nu.mutation.SetEmployeeID(i)
+	return nu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableEmployeeID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetEmployeeID(*i)
+	}
+	return nu
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (nu *NotificationUpdate) AddEmployeeID(i int) *NotificationUpdate {
+	nu.mutation.AddEmployeeID(i)
+	return nu
+}
+
+// SetType sets the "type" field.
+func (nu *NotificationUpdate) SetType(n notification.Type) *NotificationUpdate {
+	nu.mutation.SetType(n)
+	return nu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableType(n *notification.Type) *NotificationUpdate {
+	if n != nil {
+		nu.SetType(*n)
+	}
+	return nu
+}
+
+// SetTitle sets the "title" field.
+func (nu *NotificationUpdate) SetTitle(s string) *NotificationUpdate {
+	nu.mutation.SetTitle(s)
+	return nu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableTitle(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetTitle(*s)
+	}
+	return nu
+}
+
+// SetBody sets the "body" field.
+func (nu *NotificationUpdate) SetBody(s string) *NotificationUpdate {
+	nu.mutation.SetBody(s)
+	return nu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableBody(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetBody(*s)
+	}
+	return nu
+}
+
+// ClearBody clears the value of the "body" field.
+func (nu *NotificationUpdate) ClearBody() *NotificationUpdate {
+	nu.mutation.ClearBody()
+	return nu
+}
+
+// SetTaskID sets the "task_id" field.
+func (nu *NotificationUpdate) SetTaskID(i int) *NotificationUpdate {
+	nu.mutation.ResetTaskID()
+	nu.mutation.SetTaskID(i)
+	return nu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableTaskID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetTaskID(*i)
+	}
+	return nu
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (nu *NotificationUpdate) AddTaskID(i int) *NotificationUpdate {
+	nu.mutation.AddTaskID(i)
+	return nu
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (nu *NotificationUpdate) ClearTaskID() *NotificationUpdate {
+	nu.mutation.ClearTaskID()
+	return nu
+}
+
+// SetRefID sets the "ref_id" field.
+func (nu *NotificationUpdate) SetRefID(i int) *NotificationUpdate {
+	nu.mutation.ResetRefID()
+	nu.mutation.SetRefID(i)
+	return nu
+}
+
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableRefID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetRefID(*i)
+	}
+	return nu
+}
+
+// AddRefID adds i to the "ref_id" field.
+func (nu *NotificationUpdate) AddRefID(i int) *NotificationUpdate {
+	nu.mutation.AddRefID(i)
+	return nu
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (nu *NotificationUpdate) ClearRefID() *NotificationUpdate {
+	nu.mutation.ClearRefID()
+	return nu
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (nu *NotificationUpdate) SetActorEmployeeID(i int) *NotificationUpdate {
+	nu.mutation.ResetActorEmployeeID()
+	nu.mutation.SetActorEmployeeID(i)
+	return nu
+}
+
+// SetNillableActorEmployeeID sets the "actor_employee_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableActorEmployeeID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetActorEmployeeID(*i)
+	}
+	return nu
+}
+
+// AddActorEmployeeID adds i to the "actor_employee_id" field.
+func (nu *NotificationUpdate) AddActorEmployeeID(i int) *NotificationUpdate {
+	nu.mutation.AddActorEmployeeID(i)
+	return nu
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (nu *NotificationUpdate) ClearActorEmployeeID() *NotificationUpdate {
+	nu.mutation.ClearActorEmployeeID()
+	return nu
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (nu *NotificationUpdate) SetDedupKey(s string) *NotificationUpdate {
+	nu.mutation.SetDedupKey(s)
+	return nu
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableDedupKey(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetDedupKey(*s)
+	}
+	return nu
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (nu *NotificationUpdate) ClearDedupKey() *NotificationUpdate {
+	nu.mutation.ClearDedupKey()
+	return nu
+}
+
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if v, ok := nu.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.OrgID(); ok {
+		_spec.SetField(notification.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedOrgID(); ok {
+		_spec.AddField(notification.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.EmployeeID(); ok {
+		_spec.SetField(notification.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(notification.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := nu.mutation.Title(); ok {
+		_spec.SetField(notification.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+	}
+	if nu.mutation.BodyCleared() {
+		_spec.ClearField(notification.FieldBody, field.TypeString)
+	}
+	if value, ok := nu.mutation.TaskID(); ok {
+		_spec.SetField(notification.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedTaskID(); ok {
+		_spec.AddField(notification.FieldTaskID, field.TypeInt, value)
+	}
+	if nu.mutation.TaskIDCleared() {
+		_spec.ClearField(notification.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := nu.mutation.RefID(); ok {
+		_spec.SetField(notification.FieldRefID, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedRefID(); ok {
+		_spec.AddField(notification.FieldRefID, field.TypeInt, value)
+	}
+	if nu.mutation.RefIDCleared() {
+		_spec.ClearField(notification.FieldRefID, field.TypeInt)
+	}
+	if value, ok := nu.mutation.ActorEmployeeID(); ok {
+		_spec.SetField(notification.FieldActorEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedActorEmployeeID(); ok {
+		_spec.AddField(notification.FieldActorEmployeeID, field.TypeInt, value)
+	}
+	if nu.mutation.ActorEmployeeIDCleared() {
+		_spec.ClearField(notification.FieldActorEmployeeID, field.TypeInt)
+	}
+	if value, ok := nu.mutation.DedupKey(); ok {
+		_spec.SetField(notification.FieldDedupKey, field.TypeString, value)
+	}
+	if nu.mutation.DedupKeyCleared() {
+		_spec.ClearField(notification.FieldDedupKey, field.TypeString)
+	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (nuo *NotificationUpdateOne) SetOrgID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetOrgID()
+	nuo.mutation.SetOrgID(i)
+	return nuo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableOrgID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetOrgID(*i)
+	}
+	return nuo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (nuo *NotificationUpdateOne) AddOrgID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddOrgID(i)
+	return nuo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (nuo *NotificationUpdateOne) SetEmployeeID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetEmployeeID()
+	nuo.mutation.SetEmployeeID(i)
+	return nuo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableEmployeeID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetEmployeeID(*i)
+	}
+	return nuo
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (nuo *NotificationUpdateOne) AddEmployeeID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddEmployeeID(i)
+	return nuo
+}
+
+// SetType sets the "type" field.
+func (nuo *NotificationUpdateOne) SetType(n notification.Type) *NotificationUpdateOne {
+	nuo.mutation.SetType(n)
+	return nuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableType(n *notification.Type) *NotificationUpdateOne {
+	if n != nil {
+		nuo.SetType(*n)
+	}
+	return nuo
+}
+
+// SetTitle sets the "title" field.
+func (nuo *NotificationUpdateOne) SetTitle(s string) *NotificationUpdateOne {
+	nuo.mutation.SetTitle(s)
+	return nuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableTitle(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetTitle(*s)
+	}
+	return nuo
+}
+
+// SetBody sets the "body" field.
+func (nuo *NotificationUpdateOne) SetBody(s string) *NotificationUpdateOne {
+	nuo.mutation.SetBody(s)
+	return nuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableBody(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetBody(*s)
+	}
+	return nuo
+}
+
+// ClearBody clears the value of the "body" field.
+func (nuo *NotificationUpdateOne) ClearBody() *NotificationUpdateOne {
+	nuo.mutation.ClearBody()
+	return nuo
+}
+
+// SetTaskID sets the "task_id" field.
+func (nuo *NotificationUpdateOne) SetTaskID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetTaskID()
+	nuo.mutation.SetTaskID(i)
+	return nuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableTaskID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetTaskID(*i)
+	}
+	return nuo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (nuo *NotificationUpdateOne) AddTaskID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddTaskID(i)
+	return nuo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (nuo *NotificationUpdateOne) ClearTaskID() *NotificationUpdateOne {
+	nuo.mutation.ClearTaskID()
+	return nuo
+}
+
+// SetRefID sets the "ref_id" field.
+func (nuo *NotificationUpdateOne) SetRefID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetRefID()
+	nuo.mutation.SetRefID(i)
+	return nuo
+}
+
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableRefID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetRefID(*i)
+	}
+	return nuo
+}
+
+// AddRefID adds i to the "ref_id" field.
+func (nuo *NotificationUpdateOne) AddRefID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddRefID(i)
+	return nuo
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (nuo *NotificationUpdateOne) ClearRefID() *NotificationUpdateOne {
+	nuo.mutation.ClearRefID()
+	return nuo
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (nuo *NotificationUpdateOne) SetActorEmployeeID(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetActorEmployeeID()
+	nuo.mutation.SetActorEmployeeID(i)
+	return nuo
+}
+
+// SetNillableActorEmployeeID sets the "actor_employee_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableActorEmployeeID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetActorEmployeeID(*i)
+	}
+	return nuo
+}
+
+// AddActorEmployeeID adds i to the "actor_employee_id" field.
+func (nuo *NotificationUpdateOne) AddActorEmployeeID(i int) *NotificationUpdateOne {
+	nuo.mutation.AddActorEmployeeID(i)
+	return nuo
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (nuo *NotificationUpdateOne) ClearActorEmployeeID() *NotificationUpdateOne {
+	nuo.mutation.ClearActorEmployeeID()
+	return nuo
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (nuo *NotificationUpdateOne) SetDedupKey(s string) *NotificationUpdateOne {
+	nuo.mutation.SetDedupKey(s)
+	return nuo
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableDedupKey(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetDedupKey(*s)
+	}
+	return nuo
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (nuo *NotificationUpdateOne) ClearDedupKey() *NotificationUpdateOne {
+	nuo.mutation.ClearDedupKey()
+	return nuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nuo *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if v, ok := nuo.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.OrgID(); ok {
+		_spec.SetField(notification.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedOrgID(); ok {
+		_spec.AddField(notification.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.EmployeeID(); ok {
+		_spec.SetField(notification.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(notification.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.Title(); ok {
+		_spec.SetField(notification.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+	}
+	if nuo.mutation.BodyCleared() {
+		_spec.ClearField(notification.FieldBody, field.TypeString)
+	}
+	if value, ok := nuo.mutation.TaskID(); ok {
+		_spec.SetField(notification.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedTaskID(); ok {
+		_spec.AddField(notification.FieldTaskID, field.TypeInt, value)
+	}
+	if nuo.mutation.TaskIDCleared() {
+		_spec.ClearField(notification.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := nuo.mutation.RefID(); ok {
+		_spec.SetField(notification.FieldRefID, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedRefID(); ok {
+		_spec.AddField(notification.FieldRefID, field.TypeInt, value)
+	}
+	if nuo.mutation.RefIDCleared() {
+		_spec.ClearField(notification.FieldRefID, field.TypeInt)
+	}
+	if value, ok := nuo.mutation.ActorEmployeeID(); ok {
+		_spec.SetField(notification.FieldActorEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedActorEmployeeID(); ok {
+		_spec.AddField(notification.FieldActorEmployeeID, field.TypeInt, value)
+	}
+	if nuo.mutation.ActorEmployeeIDCleared() {
+		_spec.ClearField(notification.FieldActorEmployeeID, field.TypeInt)
+	}
+	if value, ok := nuo.mutation.DedupKey(); ok {
+		_spec.SetField(notification.FieldDedupKey, field.TypeString, value)
+	}
+	if nuo.mutation.DedupKeyCleared() {
+		_spec.ClearField(notification.FieldDedupKey, field.TypeString)
+	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notificationpreference"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (npu *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	npu.mutation.Where(ps...)
+	return npu
+}
+
+// SetOrgID sets the "org_id" field.
+func (npu *NotificationPreferenceUpdate) SetOrgID(i int) *NotificationPreferenceUpdate {
+	npu.mutation.ResetOrgID()
+	npu.mutation.SetOrgID(i)
+	return npu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (npu *NotificationPreferenceUpdate) SetNillableOrgID(i *int) *NotificationPreferenceUpdate {
+	if i != nil {
+		npu.SetOrgID(*i)
+	}
+	return npu
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (npu *NotificationPreferenceUpdate) AddOrgID(i int) *NotificationPreferenceUpdate {
+	npu.mutation.AddOrgID(i)
+	return npu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (npu *NotificationPreferenceUpdate) SetEmployeeID(i int) *NotificationPreferenceUpdate {
+	npu.mutation.ResetEmployeeID()
+	npu.mutation.SetEmployeeID(i)
+	return npu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (npu *NotificationPreferenceUpdate) SetNillableEmployeeID(i *int) *NotificationPreferenceUpdate {
+	if i != nil {
+		npu.SetEmployeeID(*i)
+	}
+	return npu
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (npu *NotificationPreferenceUpdate) AddEmployeeID(i int) *NotificationPreferenceUpdate {
+	npu.mutation.AddEmployeeID(i)
+	return npu
+}
+
+// SetType sets the "type" field.
+func (npu *NotificationPreferenceUpdate) SetType(n notificationpreference.Type) *NotificationPreferenceUpdate {
+	npu.mutation.SetType(n)
+	return npu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (npu *NotificationPreferenceUpdate) SetNillableType(n *notificationpreference.Type) *NotificationPreferenceUpdate {
+	if n != nil {
+		npu.SetType(*n)
+	}
+	return npu
+}
+
+// SetEnabled sets the "enabled" field.
+func (npu *NotificationPreferenceUpdate) SetEnabled(b bool) *NotificationPreferenceUpdate {
+	npu.mutation.SetEnabled(b)
+	return npu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (npu *NotificationPreferenceUpdate) SetNillableEnabled(b *bool) *NotificationPreferenceUpdate {
+	if b != nil {
+		npu.SetEnabled(*b)
+	}
+	return npu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npu *NotificationPreferenceUpdate) SetUpdatedAt(t time.Time) *NotificationPreferenceUpdate {
+	npu.mutation.SetUpdatedAt(t)
+	return npu
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npu *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return npu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (npu *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	npu.defaults()
+	return withHooks(ctx, npu.sqlSave, npu.mutation, npu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npu *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := npu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (npu *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := npu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npu *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := npu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npu *NotificationPreferenceUpdate) defaults() {
+	if _, ok := npu.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		npu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npu *NotificationPreferenceUpdate) check() error {
+	if v, ok := npu.mutation.GetType(); ok {
+		if err := notificationpreference.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (npu *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := npu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt))
+	if ps := npu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npu.mutation.OrgID(); ok {
+		_spec.SetField(notificationpreference.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := npu.mutation.AddedOrgID(); ok {
+		_spec.AddField(notificationpreference.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := npu.mutation.EmployeeID(); ok {
+		_spec.SetField(notificationpreference.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := npu.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(notificationpreference.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := npu.mutation.GetType(); ok {
+		_spec.SetField(notificationpreference.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := npu.mutation.Enabled(); ok {
+		_spec.SetField(notificationpreference.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := npu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, npu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	npu.mutation.done = true
+	return n, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (npuo *NotificationPreferenceUpdateOne) SetOrgID(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.ResetOrgID()
+	npuo.mutation.SetOrgID(i)
+	return npuo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (npuo *NotificationPreferenceUpdateOne) SetNillableOrgID(i *int) *NotificationPreferenceUpdateOne {
+	if i != nil {
+		npuo.SetOrgID(*i)
+	}
+	return npuo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (npuo *NotificationPreferenceUpdateOne) AddOrgID(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.AddOrgID(i)
+	return npuo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (npuo *NotificationPreferenceUpdateOne) SetEmployeeID(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.ResetEmployeeID()
+	npuo.mutation.SetEmployeeID(i)
+	return npuo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (npuo *NotificationPreferenceUpdateOne) SetNillableEmployeeID(i *int) *NotificationPreferenceUpdateOne {
+	if i != nil {
+		npuo.SetEmployeeID(*i)
+	}
+	return npuo
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (npuo *NotificationPreferenceUpdateOne) AddEmployeeID(i int) *NotificationPreferenceUpdateOne {
+	npuo.mutation.AddEmployeeID(i)
+	return npuo
+}
+
+// SetType sets the "type" field.
+func (npuo *NotificationPreferenceUpdateOne) SetType(n notificationpreference.Type) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetType(n)
+	return npuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (npuo *NotificationPreferenceUpdateOne) SetNillableType(n *notificationpreference.Type) *NotificationPreferenceUpdateOne {
+	if n != nil {
+		npuo.SetType(*n)
+	}
+	return npuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (npuo *NotificationPreferenceUpdateOne) SetEnabled(b bool) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetEnabled(b)
+	return npuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (npuo *NotificationPreferenceUpdateOne) SetNillableEnabled(b *bool) *NotificationPreferenceUpdateOne {
+	if b != nil {
+		npuo.SetEnabled(*b)
+	}
+	return npuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npuo *NotificationPreferenceUpdateOne) SetUpdatedAt(t time.Time) *NotificationPreferenceUpdateOne {
+	npuo.mutation.SetUpdatedAt(t)
+	return npuo
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (npuo *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return npuo.mutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (npuo *NotificationPreferenceUpdateOne) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdateOne {
+	npuo.mutation.Where(ps...)
+	return npuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (npuo *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	npuo.fields = append([]string{field}, fields...)
+	return npuo
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (npuo *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	npuo.defaults()
+	return withHooks(ctx, npuo.sqlSave, npuo.mutation, npuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npuo *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := npuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (npuo *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := npuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npuo *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := npuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npuo *NotificationPreferenceUpdateOne) defaults() {
+	if _, ok := npuo.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		npuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npuo *NotificationPreferenceUpdateOne) check() error {
+	if v, ok := npuo.mutation.GetType(); ok {
+		if err := notificationpreference.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (npuo *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	if err := npuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeInt))
+	id, ok := npuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := npuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := npuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npuo.mutation.OrgID(); ok {
+		_spec.SetField(notificationpreference.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := npuo.mutation.AddedOrgID(); ok {
+		_spec.AddField(notificationpreference.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := npuo.mutation.EmployeeID(); ok {
+		_spec.SetField(notificationpreference.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := npuo.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(notificationpreference.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := npuo.mutation.GetType(); ok {
+		_spec.SetField(notificationpreference.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := npuo.mutation.Enabled(); ok {
+		_spec.SetField(notificationpreference.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := npuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &NotificationPreference{config: npuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, npuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	npuo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -37,6 +38,14 @@ type Organization struct {
 	UpdatedAt time.Time `json:"updated_at"`
 	// ParentID holds the value of the "parent_id" field.
 	ParentID *int `json:"parent_id"`
+	// WeekendDays holds the value of the "weekend_days" field.
+	WeekendDays []int `json:"weekend_days"`
+	// AttachmentMaxFileSize holds the value of the "attachment_max_file_size" field.
+	AttachmentMaxFileSize int64 `json:"attachment_max_file_size"`
+	// AttachmentQuota holds the value of the "attachment_quota" field.
+	AttachmentQuota int64 `json:"attachment_quota"`
+	// TaskSeq holds the value of the "task_seq" field.
+	TaskSeq int `json:"task_seq"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrganizationQuery when eager-loading is set.
 	Edges        OrganizationEdges `json:"edges"`
@@ -57,9 +66,19 @@ type OrganizationEdges struct {
 	Labels []*Label `json:"labels"`
 	// LeaveRequests holds the value of the leave_requests edge.
 	LeaveRequests []*LeaveRequest `json:"leave_requests"`
+	// SalaryGrades holds the value of the salary_grades edge.
+	SalaryGrades []*SalaryGrade `json:"salary_grades"`
+	// AttendanceRecords holds the value of the attendance_records edge.
+	AttendanceRecords []*AttendanceRecord `json:"attendance_records"`
+	// Shifts holds the value of the shifts edge.
+	Shifts []*Shift `json:"shifts"`
+	// Holidays holds the value of the holidays edge.
+	Holidays []*Holiday `json:"holidays"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [11]bool
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -118,12 +137,59 @@ func (e OrganizationEdges) LeaveRequestsOrErr() ([]*LeaveRequest, error) {
 	return nil, &NotLoadedError{edge: "leave_requests"}
 }
 
+// SalaryGradesOrErr returns the SalaryGrades value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) SalaryGradesOrErr() ([]*SalaryGrade, error) {
+	if e.loadedTypes[6] {
+		return e.SalaryGrades, nil
+	}
+	return nil, &NotLoadedError{edge: "salary_grades"}
+}
+
+// AttendanceRecordsOrErr returns the AttendanceRecords value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) AttendanceRecordsOrErr() ([]*AttendanceRecord, error) {
+	if e.loadedTypes[7] {
+		return e.AttendanceRecords, nil
+	}
+	return nil, &NotLoadedError{edge: "attendance_records"}
+}
+
+// ShiftsOrErr returns the Shifts value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) ShiftsOrErr() ([]*Shift, error) {
+	if e.loadedTypes[8] {
+		return e.Shifts, nil
+	}
+	return nil, &NotLoadedError{edge: "shifts"}
+}
+
+// HolidaysOrErr returns the Holidays value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) HolidaysOrErr() ([]*Holiday, error) {
+	if e.loadedTypes[9] {
+		return e.Holidays, nil
+	}
+	return nil, &NotLoadedError{edge: "holidays"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[10] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Organization) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case organization.FieldID, organization.FieldParentID:
+		case organization.FieldWeekendDays:
+			values[i] = new([]byte)
+		case organization.FieldID, organization.FieldParentID, organization.FieldAttachmentMaxFileSize, organization.FieldAttachmentQuota, organization.FieldTaskSeq:
 			values[i] = new(sql.NullInt64)
 		case organization.FieldName, organization.FieldCode, organization.FieldLogoURL, organization.FieldAddress, organization.FieldPhone, organization.FieldEmail, organization.FieldWebsite:
 			values[i] = new(sql.NullString)
@@ -216,6 +282,32 @@ func (o *Organization) assignValues(columns []string, values []any) error {
 				o.ParentID = new(int)
 				*o.ParentID = int(value.Int64)
 			}
+		case organization.FieldWeekendDays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field weekend_days", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.WeekendDays); err != nil {
+					return fmt.Errorf("unmarshal field weekend_days: %w", err)
+				}
+			}
+		case organization.FieldAttachmentMaxFileSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_max_file_size", values[i])
+			} else if value.Valid {
+				o.AttachmentMaxFileSize = value.Int64
+			}
+		case organization.FieldAttachmentQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_quota", values[i])
+			} else if value.Valid {
+				o.AttachmentQuota = value.Int64
+			}
+		case organization.FieldTaskSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_seq", values[i])
+			} else if value.Valid {
+				o.TaskSeq = int(value.Int64)
+			}
 		default:
 			o.selectValues.Set(columns[i], values[i])
 		}
@@ -259,6 +351,31 @@ func (o *Organization) QueryLeaveRequests() *LeaveRequestQuery {
 	return NewOrganizationClient(o.config).QueryLeaveRequests(o)
 }
 
+// QuerySalaryGrades queries the "salary_grades" edge of the Organization entity.
+func (o *Organization) QuerySalaryGrades() *SalaryGradeQuery {
+	return NewOrganizationClient(o.config).QuerySalaryGrades(o)
+}
+
+// QueryAttendanceRecords queries the "attendance_records" edge of the Organization entity.
+func (o *Organization) QueryAttendanceRecords() *AttendanceRecordQuery {
+	return NewOrganizationClient(o.config).QueryAttendanceRecords(o)
+}
+
+// QueryShifts queries the "shifts" edge of the Organization entity.
+func (o *Organization) QueryShifts() *ShiftQuery {
+	return NewOrganizationClient(o.config).QueryShifts(o)
+}
+
+// QueryHolidays queries the "holidays" edge of the Organization entity.
+func (o *Organization) QueryHolidays() *HolidayQuery {
+	return NewOrganizationClient(o.config).QueryHolidays(o)
+}
+
+// QueryAttachments queries the "attachments" edge of the Organization entity.
+func (o *Organization) QueryAttachments() *AttachmentQuery {
+	return NewOrganizationClient(o.config).QueryAttachments(o)
+}
+
 // Update returns a builder for updating this Organization.
 // Note that you need to call Organization.Unwrap() before calling this method if this Organization
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -323,6 +440,18 @@ func (o *Organization) String() string {
 		builder.WriteString("parent_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("weekend_days=")
+	builder.WriteString(fmt.Sprintf("%v", o.WeekendDays))
+	builder.WriteString(", ")
+	builder.WriteString("attachment_max_file_size=")
+	builder.WriteString(fmt.Sprintf("%v", o.AttachmentMaxFileSize))
+	builder.WriteString(", ")
+	builder.WriteString("attachment_quota=")
+	builder.WriteString(fmt.Sprintf("%v", o.AttachmentQuota))
+	builder.WriteString(", ")
+	builder.WriteString("task_seq=")
+	builder.WriteString(fmt.Sprintf("%v", o.TaskSeq))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -34,6 +34,14 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
+	// FieldWeekendDays holds the string denoting the weekend_days field in the database.
+	FieldWeekendDays = "weekend_days"
+	// FieldAttachmentMaxFileSize holds the string denoting the attachment_max_file_size field in the database.
+	FieldAttachmentMaxFileSize = "attachment_max_file_size"
+	// FieldAttachmentQuota holds the string denoting the attachment_quota field in the database.
+	FieldAttachmentQuota = "attachment_quota"
+	// FieldTaskSeq holds the string denoting the task_seq field in the database.
+	FieldTaskSeq = "task_seq"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -46,6 +54,16 @@ const (
 	EdgeLabels = "labels"
 	// EdgeLeaveRequests holds the string denoting the leave_requests edge name in mutations.
 	EdgeLeaveRequests = "leave_requests"
+	// EdgeSalaryGrades holds the string denoting the salary_grades edge name in mutations.
+	EdgeSalaryGrades = "salary_grades"
+	// EdgeAttendanceRecords holds the string denoting the attendance_records edge name in mutations.
+	EdgeAttendanceRecords = "attendance_records"
+	// EdgeShifts holds the string denoting the shifts edge name in mutations.
+	EdgeShifts = "shifts"
+	// EdgeHolidays holds the string denoting the holidays edge name in mutations.
+	EdgeHolidays = "holidays"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
 	// Table holds the table name of the organization in the database.
 	Table = "organizations"
 	// ParentTable is the table that holds the parent relation/edge.
@@ -84,6 +102,41 @@ const (
 	LeaveRequestsInverseTable = "leave_requests"
 	// LeaveRequestsColumn is the table column denoting the leave_requests relation/edge.
 	LeaveRequestsColumn = "org_id"
+	// SalaryGradesTable is the table that holds the salary_grades relation/edge.
+	SalaryGradesTable = "salary_grades"
+	// SalaryGradesInverseTable is the table name for the SalaryGrade entity.
+	// It exists in this package in order to avoid circular dependency with the "salarygrade" package.
+	SalaryGradesInverseTable = "salary_grades"
+	// SalaryGradesColumn is the table column denoting the salary_grades relation/edge.
+	SalaryGradesColumn = "org_id"
+	// AttendanceRecordsTable is the table that holds the attendance_records relation/edge.
+	AttendanceRecordsTable = "attendance_records"
+	// AttendanceRecordsInverseTable is the table name for the AttendanceRecord entity.
+	// It exists in this package in order to avoid circular dependency with the "attendancerecord" package.
+	AttendanceRecordsInverseTable = "attendance_records"
+	// AttendanceRecordsColumn is the table column denoting the attendance_records relation/edge.
+	AttendanceRecordsColumn = "org_id"
+	// ShiftsTable is the table that holds the shifts relation/edge.
+	ShiftsTable = "shifts"
+	// ShiftsInverseTable is the table name for the Shift entity.
+	// It exists in this package in order to avoid circular dependency with the "shift" package.
+	ShiftsInverseTable = "shifts"
+	// ShiftsColumn is the table column denoting the shifts relation/edge.
+	ShiftsColumn = "org_id"
+	// HolidaysTable is the table that holds the holidays relation/edge.
+	HolidaysTable = "holidays"
+	// HolidaysInverseTable is the table name for the Holiday entity.
+	// It exists in this package in order to avoid circular dependency with the "holiday" package.
+	HolidaysInverseTable = "holidays"
+	// HolidaysColumn is the table column denoting the holidays relation/edge.
+	HolidaysColumn = "org_id"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "org_id"
 )
 
 // Columns holds all SQL columns for organization fields.
@@ -99,6 +152,10 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldParentID,
+	FieldWeekendDays,
+	FieldAttachmentMaxFileSize,
+	FieldAttachmentQuota,
+	FieldTaskSeq,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -122,6 +179,16 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultAttachmentMaxFileSize holds the default value on creation for the "attachment_max_file_size" field.
+	DefaultAttachmentMaxFileSize int64
+	// AttachmentMaxFileSizeValidator is a validator for the "attachment_max_file_size" field. It is called by the builders before save.
+	AttachmentMaxFileSizeValidator func(int64) error
+	// DefaultAttachmentQuota holds the default value on creation for the "attachment_quota" field.
+	DefaultAttachmentQuota int64
+	// AttachmentQuotaValidator is a validator for the "attachment_quota" field. It is called by the builders before save.
+	AttachmentQuotaValidator func(int64) error
+	// DefaultTaskSeq holds the default value on creation for the "task_seq" field.
+	DefaultTaskSeq int
 )
 
 // OrderOption defines the ordering options for the Organization queries.
@@ -182,6 +249,21 @@ func ByParentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentID, opts...).ToFunc()
 }
 
+// ByAttachmentMaxFileSize orders the results by the attachment_max_file_size field.
+func ByAttachmentMaxFileSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentMaxFileSize, opts...).ToFunc()
+}
+
+// ByAttachmentQuota orders the results by the attachment_quota field.
+func ByAttachmentQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentQuota, opts...).ToFunc()
+}
+
+// ByTaskSeq orders the results by the task_seq field.
+func ByTaskSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskSeq, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -258,6 +340,76 @@ func ByLeaveRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLeaveRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySalaryGradesCount orders the results by salary_grades count.
+func BySalaryGradesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSalaryGradesStep(), opts...)
+	}
+}
+
+// BySalaryGrades orders the results by salary_grades terms.
+func BySalaryGrades(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSalaryGradesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttendanceRecordsCount orders the results by attendance_records count.
+func ByAttendanceRecordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttendanceRecordsStep(), opts...)
+	}
+}
+
+// ByAttendanceRecords orders the results by attendance_records terms.
+func ByAttendanceRecords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttendanceRecordsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShiftsCount orders the results by shifts count.
+func ByShiftsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShiftsStep(), opts...)
+	}
+}
+
+// ByShifts orders the results by shifts terms.
+func ByShifts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShiftsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHolidaysCount orders the results by holidays count.
+func ByHolidaysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHolidaysStep(), opts...)
+	}
+}
+
+// ByHolidays orders the results by holidays terms.
+func ByHolidays(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHolidaysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttachmentsCount orders the results by attachments count.
+func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentsStep(), opts...)
+	}
+}
+
+// ByAttachments orders the results by attachments terms.
+func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -300,3 +452,38 @@ func newLeaveRequestsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LeaveRequestsTable, LeaveRequestsColumn),
 	)
 }
+func newSalaryGradesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SalaryGradesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SalaryGradesTable, SalaryGradesColumn),
+	)
+}
+func newAttendanceRecordsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttendanceRecordsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttendanceRecordsTable, AttendanceRecordsColumn),
+	)
+}
+func newShiftsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShiftsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShiftsTable, ShiftsColumn),
+	)
+}
+func newHolidaysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HolidaysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HolidaysTable, HolidaysColumn),
+	)
+}
+func newAttachmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+	)
+}
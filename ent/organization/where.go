@@ -105,6 +105,21 @@ func ParentID(v int) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldParentID, v))
 }
 
+// AttachmentMaxFileSize applies equality check predicate on the "attachment_max_file_size" field. It's identical to AttachmentMaxFileSizeEQ.
+func AttachmentMaxFileSize(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldAttachmentMaxFileSize, v))
+}
+
+// AttachmentQuota applies equality check predicate on the "attachment_quota" field. It's identical to AttachmentQuotaEQ.
+func AttachmentQuota(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldAttachmentQuota, v))
+}
+
+// TaskSeq applies equality check predicate on the "task_seq" field. It's identical to TaskSeqEQ.
+func TaskSeq(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldTaskSeq, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldName, v))
@@ -720,6 +735,136 @@ func ParentIDNotNil() predicate.Organization {
 	return predicate.Organization(sql.FieldNotNull(FieldParentID))
 }
 
+// WeekendDaysIsNil applies the IsNil predicate on the "weekend_days" field.
+func WeekendDaysIsNil() predicate.Organization {
+	return predicate.Organization(sql.FieldIsNull(FieldWeekendDays))
+}
+
+// WeekendDaysNotNil applies the NotNil predicate on the "weekend_days" field.
+func WeekendDaysNotNil() predicate.Organization {
+	return predicate.Organization(sql.FieldNotNull(FieldWeekendDays))
+}
+
+// AttachmentMaxFileSizeEQ applies the EQ predicate on the "attachment_max_file_size" field.
+func AttachmentMaxFileSizeEQ(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldAttachmentMaxFileSize, v))
+}
+
+// AttachmentMaxFileSizeNEQ applies the NEQ predicate on the "attachment_max_file_size" field.
+func AttachmentMaxFileSizeNEQ(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldAttachmentMaxFileSize, v))
+}
+
+// AttachmentMaxFileSizeIn applies the In predicate on the "attachment_max_file_size" field.
+func AttachmentMaxFileSizeIn(vs ...int64) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldAttachmentMaxFileSize, vs...))
+}
+
+// AttachmentMaxFileSizeNotIn applies the NotIn predicate on the "attachment_max_file_size" field.
+func AttachmentMaxFileSizeNotIn(vs ...int64) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldAttachmentMaxFileSize, vs...))
+}
+
+// AttachmentMaxFileSizeGT applies the GT predicate on the "attachment_max_file_size" field.
+func AttachmentMaxFileSizeGT(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldAttachmentMaxFileSize, v))
+}
+
+// AttachmentMaxFileSizeGTE applies the GTE predicate on the "attachment_max_file_size" field.
+func AttachmentMaxFileSizeGTE(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldAttachmentMaxFileSize, v))
+}
+
+// AttachmentMaxFileSizeLT applies the LT predicate on the "attachment_max_file_size" field.
+func AttachmentMaxFileSizeLT(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldAttachmentMaxFileSize, v))
+}
+
+// AttachmentMaxFileSizeLTE applies the LTE predicate on the "attachment_max_file_size" field.
+func AttachmentMaxFileSizeLTE(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldAttachmentMaxFileSize, v))
+}
+
+// AttachmentQuotaEQ applies the EQ predicate on the "attachment_quota" field.
+func AttachmentQuotaEQ(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldAttachmentQuota, v))
+}
+
+// AttachmentQuotaNEQ applies the NEQ predicate on the "attachment_quota" field.
+func AttachmentQuotaNEQ(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldAttachmentQuota, v))
+}
+
+// AttachmentQuotaIn applies the In predicate on the "attachment_quota" field.
+func AttachmentQuotaIn(vs ...int64) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldAttachmentQuota, vs...))
+}
+
+// AttachmentQuotaNotIn applies the NotIn predicate on the "attachment_quota" field.
+func AttachmentQuotaNotIn(vs ...int64) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldAttachmentQuota, vs...))
+}
+
+// AttachmentQuotaGT applies the GT predicate on the "attachment_quota" field.
+func AttachmentQuotaGT(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldAttachmentQuota, v))
+}
+
+// AttachmentQuotaGTE applies the GTE predicate on the "attachment_quota" field.
+func AttachmentQuotaGTE(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldAttachmentQuota, v))
+}
+
+// AttachmentQuotaLT applies the LT predicate on the "attachment_quota" field.
+func AttachmentQuotaLT(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldAttachmentQuota, v))
+}
+
+// AttachmentQuotaLTE applies the LTE predicate on the "attachment_quota" field.
+func AttachmentQuotaLTE(v int64) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldAttachmentQuota, v))
+}
+
+// TaskSeqEQ applies the EQ predicate on the "task_seq" field.
+func TaskSeqEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldTaskSeq, v))
+}
+
+// TaskSeqNEQ applies the NEQ predicate on the "task_seq" field.
+func TaskSeqNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldTaskSeq, v))
+}
+
+// TaskSeqIn applies the In predicate on the "task_seq" field.
+func TaskSeqIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldTaskSeq, vs...))
+}
+
+// TaskSeqNotIn applies the NotIn predicate on the "task_seq" field.
+func TaskSeqNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldTaskSeq, vs...))
+}
+
+// TaskSeqGT applies the GT predicate on the "task_seq" field.
+func TaskSeqGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldTaskSeq, v))
+}
+
+// TaskSeqGTE applies the GTE predicate on the "task_seq" field.
+func TaskSeqGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldTaskSeq, v))
+}
+
+// TaskSeqLT applies the LT predicate on the "task_seq" field.
+func TaskSeqLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldTaskSeq, v))
+}
+
+// TaskSeqLTE applies the LTE predicate on the "task_seq" field.
+func TaskSeqLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldTaskSeq, v))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Organization {
 	return predicate.Organization(func(s *sql.Selector) {
@@ -858,6 +1003,121 @@ func HasLeaveRequestsWith(preds ...predicate.LeaveRequest) predicate.Organizatio
 	})
 }
 
+// HasSalaryGrades applies the HasEdge predicate on the "salary_grades" edge.
+func HasSalaryGrades() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SalaryGradesTable, SalaryGradesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalaryGradesWith applies the HasEdge predicate on the "salary_grades" edge with a given conditions (other predicates).
+func HasSalaryGradesWith(preds ...predicate.SalaryGrade) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newSalaryGradesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttendanceRecords applies the HasEdge predicate on the "attendance_records" edge.
+func HasAttendanceRecords() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttendanceRecordsTable, AttendanceRecordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttendanceRecordsWith applies the HasEdge predicate on the "attendance_records" edge with a given conditions (other predicates).
+func HasAttendanceRecordsWith(preds ...predicate.AttendanceRecord) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newAttendanceRecordsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShifts applies the HasEdge predicate on the "shifts" edge.
+func HasShifts() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShiftsTable, ShiftsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShiftsWith applies the HasEdge predicate on the "shifts" edge with a given conditions (other predicates).
+func HasShiftsWith(preds ...predicate.Shift) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newShiftsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHolidays applies the HasEdge predicate on the "holidays" edge.
+func HasHolidays() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HolidaysTable, HolidaysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHolidaysWith applies the HasEdge predicate on the "holidays" edge with a given conditions (other predicates).
+func HasHolidaysWith(preds ...predicate.Holiday) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newHolidaysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Organization) predicate.Organization {
 	return predicate.Organization(sql.AndPredicates(predicates...))
@@ -11,11 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
 )
 
 // OrganizationCreate is the builder for creating a Organization entity.
@@ -150,6 +155,54 @@ func (oc *OrganizationCreate) SetNillableParentID(i *int) *OrganizationCreate {
 	return oc
 }
 
+// SetWeekendDays sets the "weekend_days" field.
+func (oc *OrganizationCreate) SetWeekendDays(i []int) *OrganizationCreate {
+	oc.mutation.SetWeekendDays(i)
+	return oc
+}
+
+// SetAttachmentMaxFileSize sets the "attachment_max_file_size" field.
+func (oc *OrganizationCreate) SetAttachmentMaxFileSize(i int64) *OrganizationCreate {
+	oc.mutation.SetAttachmentMaxFileSize(i)
+	return oc
+}
+
+// SetNillableAttachmentMaxFileSize sets the "attachment_max_file_size" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableAttachmentMaxFileSize(i *int64) *OrganizationCreate {
+	if i != nil {
+		oc.SetAttachmentMaxFileSize(*i)
+	}
+	return oc
+}
+
+// SetAttachmentQuota sets the "attachment_quota" field.
+func (oc *OrganizationCreate) SetAttachmentQuota(i int64) *OrganizationCreate {
+	oc.mutation.SetAttachmentQuota(i)
+	return oc
+}
+
+// SetNillableAttachmentQuota sets the "attachment_quota" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableAttachmentQuota(i *int64) *OrganizationCreate {
+	if i != nil {
+		oc.SetAttachmentQuota(*i)
+	}
+	return oc
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (oc *OrganizationCreate) SetTaskSeq(i int) *OrganizationCreate {
+	oc.mutation.SetTaskSeq(i)
+	return oc
+}
+
+// SetNillableTaskSeq sets the "task_seq" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableTaskSeq(i *int) *OrganizationCreate {
+	if i != nil {
+		oc.SetTaskSeq(*i)
+	}
+	return oc
+}
+
 // SetParent sets the "parent" edge to the Organization entity.
 func (oc *OrganizationCreate) SetParent(o *Organization) *OrganizationCreate {
 	return oc.SetParentID(o.ID)
@@ -230,6 +283,81 @@ func (oc *OrganizationCreate) AddLeaveRequests(l ...*LeaveRequest) *Organization
 	return oc.AddLeaveRequestIDs(ids...)
 }
 
+// AddSalaryGradeIDs adds the "salary_grades" edge to the SalaryGrade entity by IDs.
+func (oc *OrganizationCreate) AddSalaryGradeIDs(ids ...int) *OrganizationCreate {
+	oc.mutation.AddSalaryGradeIDs(ids...)
+	return oc
+}
+
+// AddSalaryGrades adds the "salary_grades" edges to the SalaryGrade entity.
+func (oc *OrganizationCreate) AddSalaryGrades(s ...*SalaryGrade) *OrganizationCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oc.AddSalaryGradeIDs(ids...)
+}
+
+// AddAttendanceRecordIDs adds the "attendance_records" edge to the AttendanceRecord entity by IDs.
+func (oc *OrganizationCreate) AddAttendanceRecordIDs(ids ...int) *OrganizationCreate {
+	oc.mutation.AddAttendanceRecordIDs(ids...)
+	return oc
+}
+
+// AddAttendanceRecords adds the "attendance_records" edges to the AttendanceRecord entity.
+func (oc *OrganizationCreate) AddAttendanceRecords(a ...*AttendanceRecord) *OrganizationCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return oc.AddAttendanceRecordIDs(ids...)
+}
+
+// AddShiftIDs adds the "shifts" edge to the Shift entity by IDs.
+func (oc *OrganizationCreate) AddShiftIDs(ids ...int) *OrganizationCreate {
+	oc.mutation.AddShiftIDs(ids...)
+	return oc
+}
+
+// AddShifts adds the "shifts" edges to the Shift entity.
+func (oc *OrganizationCreate) AddShifts(s ...*Shift) *OrganizationCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return oc.AddShiftIDs(ids...)
+}
+
+// AddHolidayIDs adds the "holidays" edge to the Holiday entity by IDs.
+func (oc *OrganizationCreate) AddHolidayIDs(ids ...int) *OrganizationCreate {
+	oc.mutation.AddHolidayIDs(ids...)
+	return oc
+}
+
+// AddHolidays adds the "holidays" edges to the Holiday entity.
+func (oc *OrganizationCreate) AddHolidays(h ...*Holiday) *OrganizationCreate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return oc.AddHolidayIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (oc *OrganizationCreate) AddAttachmentIDs(ids ...int) *OrganizationCreate {
+	oc.mutation.AddAttachmentIDs(ids...)
+	return oc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (oc *OrganizationCreate) AddAttachments(a ...*Attachment) *OrganizationCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return oc.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (oc *OrganizationCreate) Mutation() *OrganizationMutation {
 	return oc.mutation
@@ -273,6 +401,18 @@ func (oc *OrganizationCreate) defaults() {
 		v := organization.DefaultUpdatedAt()
 		oc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := oc.mutation.AttachmentMaxFileSize(); !ok {
+		v := organization.DefaultAttachmentMaxFileSize
+		oc.mutation.SetAttachmentMaxFileSize(v)
+	}
+	if _, ok := oc.mutation.AttachmentQuota(); !ok {
+		v := organization.DefaultAttachmentQuota
+		oc.mutation.SetAttachmentQuota(v)
+	}
+	if _, ok := oc.mutation.TaskSeq(); !ok {
+		v := organization.DefaultTaskSeq
+		oc.mutation.SetTaskSeq(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -299,6 +439,25 @@ func (oc *OrganizationCreate) check() error {
 	if _, ok := oc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Organization.updated_at"`)}
 	}
+	if _, ok := oc.mutation.AttachmentMaxFileSize(); !ok {
+		return &ValidationError{Name: "attachment_max_file_size", err: errors.New(`ent: missing required field "Organization.attachment_max_file_size"`)}
+	}
+	if v, ok := oc.mutation.AttachmentMaxFileSize(); ok {
+		if err := organization.AttachmentMaxFileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_max_file_size", err: fmt.Errorf(`ent: validator failed for field "Organization.attachment_max_file_size": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.AttachmentQuota(); !ok {
+		return &ValidationError{Name: "attachment_quota", err: errors.New(`ent: missing required field "Organization.attachment_quota"`)}
+	}
+	if v, ok := oc.mutation.AttachmentQuota(); ok {
+		if err := organization.AttachmentQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_quota", err: fmt.Errorf(`ent: validator failed for field "Organization.attachment_quota": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.TaskSeq(); !ok {
+		return &ValidationError{Name: "task_seq", err: errors.New(`ent: missing required field "Organization.task_seq"`)}
+	}
 	return nil
 }
 
@@ -362,6 +521,22 @@ func (oc *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := oc.mutation.WeekendDays(); ok {
+		_spec.SetField(organization.FieldWeekendDays, field.TypeJSON, value)
+		_node.WeekendDays = value
+	}
+	if value, ok := oc.mutation.AttachmentMaxFileSize(); ok {
+		_spec.SetField(organization.FieldAttachmentMaxFileSize, field.TypeInt64, value)
+		_node.AttachmentMaxFileSize = value
+	}
+	if value, ok := oc.mutation.AttachmentQuota(); ok {
+		_spec.SetField(organization.FieldAttachmentQuota, field.TypeInt64, value)
+		_node.AttachmentQuota = value
+	}
+	if value, ok := oc.mutation.TaskSeq(); ok {
+		_spec.SetField(organization.FieldTaskSeq, field.TypeInt, value)
+		_node.TaskSeq = value
+	}
 	if nodes := oc.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -459,6 +634,86 @@ func (oc *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := oc.mutation.SalaryGradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SalaryGradesTable,
+			Columns: []string{organization.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.AttendanceRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttendanceRecordsTable,
+			Columns: []string{organization.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.ShiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ShiftsTable,
+			Columns: []string{organization.ShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.HolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.HolidaysTable,
+			Columns: []string{organization.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttachmentsTable,
+			Columns: []string{organization.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -667,6 +922,78 @@ func (u *OrganizationUpsert) ClearParentID() *OrganizationUpsert {
 	return u
 }
 
+// SetWeekendDays sets the "weekend_days" field.
+func (u *OrganizationUpsert) SetWeekendDays(v []int) *OrganizationUpsert {
+	u.Set(organization.FieldWeekendDays, v)
+	return u
+}
+
+// UpdateWeekendDays sets the "weekend_days" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateWeekendDays() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldWeekendDays)
+	return u
+}
+
+// ClearWeekendDays clears the value of the "weekend_days" field.
+func (u *OrganizationUpsert) ClearWeekendDays() *OrganizationUpsert {
+	u.SetNull(organization.FieldWeekendDays)
+	return u
+}
+
+// SetAttachmentMaxFileSize sets the "attachment_max_file_size" field.
+func (u *OrganizationUpsert) SetAttachmentMaxFileSize(v int64) *OrganizationUpsert {
+	u.Set(organization.FieldAttachmentMaxFileSize, v)
+	return u
+}
+
+// UpdateAttachmentMaxFileSize sets the "attachment_max_file_size" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateAttachmentMaxFileSize() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldAttachmentMaxFileSize)
+	return u
+}
+
+// AddAttachmentMaxFileSize adds v to the "attachment_max_file_size" field.
+func (u *OrganizationUpsert) AddAttachmentMaxFileSize(v int64) *OrganizationUpsert {
+	u.Add(organization.FieldAttachmentMaxFileSize, v)
+	return u
+}
+
+// SetAttachmentQuota sets the "attachment_quota" field.
+func (u *OrganizationUpsert) SetAttachmentQuota(v int64) *OrganizationUpsert {
+	u.Set(organization.FieldAttachmentQuota, v)
+	return u
+}
+
+// UpdateAttachmentQuota sets the "attachment_quota" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateAttachmentQuota() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldAttachmentQuota)
+	return u
+}
+
+// AddAttachmentQuota adds v to the "attachment_quota" field.
+func (u *OrganizationUpsert) AddAttachmentQuota(v int64) *OrganizationUpsert {
+	u.Add(organization.FieldAttachmentQuota, v)
+	return u
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (u *OrganizationUpsert) SetTaskSeq(v int) *OrganizationUpsert {
+	u.Set(organization.FieldTaskSeq, v)
+	return u
+}
+
+// UpdateTaskSeq sets the "task_seq" field to the value that was provided on create.
+func (u *OrganizationUpsert) UpdateTaskSeq() *OrganizationUpsert {
+	u.SetExcluded(organization.FieldTaskSeq)
+	return u
+}
+
+// AddTaskSeq adds v to the "task_seq" field.
+func (u *OrganizationUpsert) AddTaskSeq(v int) *OrganizationUpsert {
+	u.Add(organization.FieldTaskSeq, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -889,6 +1216,90 @@ func (u *OrganizationUpsertOne) ClearParentID() *OrganizationUpsertOne {
 	})
 }
 
+// SetWeekendDays sets the "weekend_days" field.
+func (u *OrganizationUpsertOne) SetWeekendDays(v []int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetWeekendDays(v)
+	})
+}
+
+// UpdateWeekendDays sets the "weekend_days" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateWeekendDays() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateWeekendDays()
+	})
+}
+
+// ClearWeekendDays clears the value of the "weekend_days" field.
+func (u *OrganizationUpsertOne) ClearWeekendDays() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.ClearWeekendDays()
+	})
+}
+
+// SetAttachmentMaxFileSize sets the "attachment_max_file_size" field.
+func (u *OrganizationUpsertOne) SetAttachmentMaxFileSize(v int64) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetAttachmentMaxFileSize(v)
+	})
+}
+
+// AddAttachmentMaxFileSize adds v to the "attachment_max_file_size" field.
+func (u *OrganizationUpsertOne) AddAttachmentMaxFileSize(v int64) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddAttachmentMaxFileSize(v)
+	})
+}
+
+// UpdateAttachmentMaxFileSize sets the "attachment_max_file_size" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateAttachmentMaxFileSize() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateAttachmentMaxFileSize()
+	})
+}
+
+// SetAttachmentQuota sets the "attachment_quota" field.
+func (u *OrganizationUpsertOne) SetAttachmentQuota(v int64) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetAttachmentQuota(v)
+	})
+}
+
+// AddAttachmentQuota adds v to the "attachment_quota" field.
+func (u *OrganizationUpsertOne) AddAttachmentQuota(v int64) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddAttachmentQuota(v)
+	})
+}
+
+// UpdateAttachmentQuota sets the "attachment_quota" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateAttachmentQuota() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateAttachmentQuota()
+	})
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (u *OrganizationUpsertOne) SetTaskSeq(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetTaskSeq(v)
+	})
+}
+
+// AddTaskSeq adds v to the "task_seq" field.
+func (u *OrganizationUpsertOne) AddTaskSeq(v int) *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddTaskSeq(v)
+	})
+}
+
+// UpdateTaskSeq sets the "task_seq" field to the value that was provided on create.
+func (u *OrganizationUpsertOne) UpdateTaskSeq() *OrganizationUpsertOne {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateTaskSeq()
+	})
+}
+
 // Exec executes the query.
 func (u *OrganizationUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1275,6 +1686,90 @@ func (u *OrganizationUpsertBulk) ClearParentID() *OrganizationUpsertBulk {
 	})
 }
 
+// SetWeekendDays sets the "weekend_days" field.
+func (u *OrganizationUpsertBulk) SetWeekendDays(v []int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetWeekendDays(v)
+	})
+}
+
+// UpdateWeekendDays sets the "weekend_days" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateWeekendDays() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateWeekendDays()
+	})
+}
+
+// ClearWeekendDays clears the value of the "weekend_days" field.
+func (u *OrganizationUpsertBulk) ClearWeekendDays() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.ClearWeekendDays()
+	})
+}
+
+// SetAttachmentMaxFileSize sets the "attachment_max_file_size" field.
+func (u *OrganizationUpsertBulk) SetAttachmentMaxFileSize(v int64) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetAttachmentMaxFileSize(v)
+	})
+}
+
+// AddAttachmentMaxFileSize adds v to the "attachment_max_file_size" field.
+func (u *OrganizationUpsertBulk) AddAttachmentMaxFileSize(v int64) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddAttachmentMaxFileSize(v)
+	})
+}
+
+// UpdateAttachmentMaxFileSize sets the "attachment_max_file_size" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateAttachmentMaxFileSize() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateAttachmentMaxFileSize()
+	})
+}
+
+// SetAttachmentQuota sets the "attachment_quota" field.
+func (u *OrganizationUpsertBulk) SetAttachmentQuota(v int64) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetAttachmentQuota(v)
+	})
+}
+
+// AddAttachmentQuota adds v to the "attachment_quota" field.
+func (u *OrganizationUpsertBulk) AddAttachmentQuota(v int64) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddAttachmentQuota(v)
+	})
+}
+
+// UpdateAttachmentQuota sets the "attachment_quota" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateAttachmentQuota() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateAttachmentQuota()
+	})
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (u *OrganizationUpsertBulk) SetTaskSeq(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.SetTaskSeq(v)
+	})
+}
+
+// AddTaskSeq adds v to the "task_seq" field.
+func (u *OrganizationUpsertBulk) AddTaskSeq(v int) *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.AddTaskSeq(v)
+	})
+}
+
+// UpdateTaskSeq sets the "task_seq" field to the value that was provided on create.
+func (u *OrganizationUpsertBulk) UpdateTaskSeq() *OrganizationUpsertBulk {
+	return u.Update(func(s *OrganizationUpsert) {
+		s.UpdateTaskSeq()
+	})
+}
+
 // Exec executes the query.
 func (u *OrganizationUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,27 +12,37 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
 )
 
 // OrganizationQuery is the builder for querying Organization entities.
 type OrganizationQuery struct {
 	config
-	ctx               *QueryContext
-	order             []organization.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Organization
-	withParent        *OrganizationQuery
-	withChildren      *OrganizationQuery
-	withDepartments   *DepartmentQuery
-	withProjects      *ProjectQuery
-	withLabels        *LabelQuery
-	withLeaveRequests *LeaveRequestQuery
+	ctx                   *QueryContext
+	order                 []organization.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Organization
+	withParent            *OrganizationQuery
+	withChildren          *OrganizationQuery
+	withDepartments       *DepartmentQuery
+	withProjects          *ProjectQuery
+	withLabels            *LabelQuery
+	withLeaveRequests     *LeaveRequestQuery
+	withSalaryGrades      *SalaryGradeQuery
+	withAttendanceRecords *AttendanceRecordQuery
+	withShifts            *ShiftQuery
+	withHolidays          *HolidayQuery
+	withAttachments       *AttachmentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -201,6 +211,116 @@ func (oq *OrganizationQuery) QueryLeaveRequests() *LeaveRequestQuery {
 	return query
 }
 
+// QuerySalaryGrades chains the current query on the "salary_grades" edge.
+func (oq *OrganizationQuery) QuerySalaryGrades() *SalaryGradeQuery {
+	query := (&SalaryGradeClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(salarygrade.Table, salarygrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.SalaryGradesTable, organization.SalaryGradesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttendanceRecords chains the current query on the "attendance_records" edge.
+func (oq *OrganizationQuery) QueryAttendanceRecords() *AttendanceRecordQuery {
+	query := (&AttendanceRecordClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(attendancerecord.Table, attendancerecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.AttendanceRecordsTable, organization.AttendanceRecordsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShifts chains the current query on the "shifts" edge.
+func (oq *OrganizationQuery) QueryShifts() *ShiftQuery {
+	query := (&ShiftClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(shift.Table, shift.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ShiftsTable, organization.ShiftsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHolidays chains the current query on the "holidays" edge.
+func (oq *OrganizationQuery) QueryHolidays() *HolidayQuery {
+	query := (&HolidayClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(holiday.Table, holiday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.HolidaysTable, organization.HolidaysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (oq *OrganizationQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.AttachmentsTable, organization.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Organization entity from the query.
 // Returns a *NotFoundError when no Organization was found.
 func (oq *OrganizationQuery) First(ctx context.Context) (*Organization, error) {
@@ -388,17 +508,22 @@ func (oq *OrganizationQuery) Clone() *OrganizationQuery {
 		return nil
 	}
 	return &OrganizationQuery{
-		config:            oq.config,
-		ctx:               oq.ctx.Clone(),
-		order:             append([]organization.OrderOption{}, oq.order...),
-		inters:            append([]Interceptor{}, oq.inters...),
-		predicates:        append([]predicate.Organization{}, oq.predicates...),
-		withParent:        oq.withParent.Clone(),
-		withChildren:      oq.withChildren.Clone(),
-		withDepartments:   oq.withDepartments.Clone(),
-		withProjects:      oq.withProjects.Clone(),
-		withLabels:        oq.withLabels.Clone(),
-		withLeaveRequests: oq.withLeaveRequests.Clone(),
+		config:                oq.config,
+		ctx:                   oq.ctx.Clone(),
+		order:                 append([]organization.OrderOption{}, oq.order...),
+		inters:                append([]Interceptor{}, oq.inters...),
+		predicates:            append([]predicate.Organization{}, oq.predicates...),
+		withParent:            oq.withParent.Clone(),
+		withChildren:          oq.withChildren.Clone(),
+		withDepartments:       oq.withDepartments.Clone(),
+		withProjects:          oq.withProjects.Clone(),
+		withLabels:            oq.withLabels.Clone(),
+		withLeaveRequests:     oq.withLeaveRequests.Clone(),
+		withSalaryGrades:      oq.withSalaryGrades.Clone(),
+		withAttendanceRecords: oq.withAttendanceRecords.Clone(),
+		withShifts:            oq.withShifts.Clone(),
+		withHolidays:          oq.withHolidays.Clone(),
+		withAttachments:       oq.withAttachments.Clone(),
 		// clone intermediate query.
 		sql:  oq.sql.Clone(),
 		path: oq.path,
@@ -471,6 +596,61 @@ func (oq *OrganizationQuery) WithLeaveRequests(opts ...func(*LeaveRequestQuery))
 	return oq
 }
 
+// WithSalaryGrades tells the query-builder to eager-load the nodes that are connected to
+// the "salary_grades" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithSalaryGrades(opts ...func(*SalaryGradeQuery)) *OrganizationQuery {
+	query := (&SalaryGradeClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withSalaryGrades = query
+	return oq
+}
+
+// WithAttendanceRecords tells the query-builder to eager-load the nodes that are connected to
+// the "attendance_records" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithAttendanceRecords(opts ...func(*AttendanceRecordQuery)) *OrganizationQuery {
+	query := (&AttendanceRecordClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withAttendanceRecords = query
+	return oq
+}
+
+// WithShifts tells the query-builder to eager-load the nodes that are connected to
+// the "shifts" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithShifts(opts ...func(*ShiftQuery)) *OrganizationQuery {
+	query := (&ShiftClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withShifts = query
+	return oq
+}
+
+// WithHolidays tells the query-builder to eager-load the nodes that are connected to
+// the "holidays" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithHolidays(opts ...func(*HolidayQuery)) *OrganizationQuery {
+	query := (&HolidayClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withHolidays = query
+	return oq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithAttachments(opts ...func(*AttachmentQuery)) *OrganizationQuery {
+	query := (&AttachmentClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withAttachments = query
+	return oq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -549,13 +729,18 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*Organization{}
 		_spec       = oq.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [11]bool{
 			oq.withParent != nil,
 			oq.withChildren != nil,
 			oq.withDepartments != nil,
 			oq.withProjects != nil,
 			oq.withLabels != nil,
 			oq.withLeaveRequests != nil,
+			oq.withSalaryGrades != nil,
+			oq.withAttendanceRecords != nil,
+			oq.withShifts != nil,
+			oq.withHolidays != nil,
+			oq.withAttachments != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -617,6 +802,43 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := oq.withSalaryGrades; query != nil {
+		if err := oq.loadSalaryGrades(ctx, query, nodes,
+			func(n *Organization) { n.Edges.SalaryGrades = []*SalaryGrade{} },
+			func(n *Organization, e *SalaryGrade) { n.Edges.SalaryGrades = append(n.Edges.SalaryGrades, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withAttendanceRecords; query != nil {
+		if err := oq.loadAttendanceRecords(ctx, query, nodes,
+			func(n *Organization) { n.Edges.AttendanceRecords = []*AttendanceRecord{} },
+			func(n *Organization, e *AttendanceRecord) {
+				n.Edges.AttendanceRecords = append(n.Edges.AttendanceRecords, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withShifts; query != nil {
+		if err := oq.loadShifts(ctx, query, nodes,
+			func(n *Organization) { n.Edges.Shifts = []*Shift{} },
+			func(n *Organization, e *Shift) { n.Edges.Shifts = append(n.Edges.Shifts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withHolidays; query != nil {
+		if err := oq.loadHolidays(ctx, query, nodes,
+			func(n *Organization) { n.Edges.Holidays = []*Holiday{} },
+			func(n *Organization, e *Holiday) { n.Edges.Holidays = append(n.Edges.Holidays, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withAttachments; query != nil {
+		if err := oq.loadAttachments(ctx, query, nodes,
+			func(n *Organization) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Organization, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -805,6 +1027,156 @@ func (oq *OrganizationQuery) loadLeaveRequests(ctx context.Context, query *Leave
 	}
 	return nil
 }
+func (oq *OrganizationQuery) loadSalaryGrades(ctx context.Context, query *SalaryGradeQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *SalaryGrade)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(salarygrade.FieldOrgID)
+	}
+	query.Where(predicate.SalaryGrade(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.SalaryGradesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrgID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "org_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrganizationQuery) loadAttendanceRecords(ctx context.Context, query *AttendanceRecordQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *AttendanceRecord)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attendancerecord.FieldOrgID)
+	}
+	query.Where(predicate.AttendanceRecord(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.AttendanceRecordsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrgID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "org_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrganizationQuery) loadShifts(ctx context.Context, query *ShiftQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *Shift)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shift.FieldOrgID)
+	}
+	query.Where(predicate.Shift(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.ShiftsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrgID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "org_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrganizationQuery) loadHolidays(ctx context.Context, query *HolidayQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *Holiday)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(holiday.FieldOrgID)
+	}
+	query.Where(predicate.Holiday(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.HolidaysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrgID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "org_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrganizationQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldOrgID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrgID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "org_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (oq *OrganizationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oq.querySpec()
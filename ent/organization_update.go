@@ -10,13 +10,19 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
 )
 
 // OrganizationUpdate is the builder for updating Organization entities.
@@ -200,6 +206,87 @@ func (ou *OrganizationUpdate) ClearParentID() *OrganizationUpdate {
 	return ou
 }
 
+// SetWeekendDays sets the "weekend_days" field.
+func (ou *OrganizationUpdate) SetWeekendDays(i []int) *OrganizationUpdate {
+	ou.mutation.SetWeekendDays(i)
+	return ou
+}
+
+// AppendWeekendDays appends i to the "weekend_days" field.
+func (ou *OrganizationUpdate) AppendWeekendDays(i []int) *OrganizationUpdate {
+	ou.mutation.AppendWeekendDays(i)
+	return ou
+}
+
+// ClearWeekendDays clears the value of the "weekend_days" field.
+func (ou *OrganizationUpdate) ClearWeekendDays() *OrganizationUpdate {
+	ou.mutation.ClearWeekendDays()
+	return ou
+}
+
+// SetAttachmentMaxFileSize sets the "attachment_max_file_size" field.
+func (ou *OrganizationUpdate) SetAttachmentMaxFileSize(i int64) *OrganizationUpdate {
+	ou.mutation.ResetAttachmentMaxFileSize()
+	ou.mutation.SetAttachmentMaxFileSize(i)
+	return ou
+}
+
+// SetNillableAttachmentMaxFileSize sets the "attachment_max_file_size" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableAttachmentMaxFileSize(i *int64) *OrganizationUpdate {
+	if i != nil {
+		ou.SetAttachmentMaxFileSize(*i)
+	}
+	return ou
+}
+
+// AddAttachmentMaxFileSize adds i to the "attachment_max_file_size" field.
+func (ou *OrganizationUpdate) AddAttachmentMaxFileSize(i int64) *OrganizationUpdate {
+	ou.mutation.AddAttachmentMaxFileSize(i)
+	return ou
+}
+
+// SetAttachmentQuota sets the "attachment_quota" field.
+func (ou *OrganizationUpdate) SetAttachmentQuota(i int64) *OrganizationUpdate {
+	ou.mutation.ResetAttachmentQuota()
+	ou.mutation.SetAttachmentQuota(i)
+	return ou
+}
+
+// SetNillableAttachmentQuota sets the "attachment_quota" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableAttachmentQuota(i *int64) *OrganizationUpdate {
+	if i != nil {
+		ou.SetAttachmentQuota(*i)
+	}
+	return ou
+}
+
+// AddAttachmentQuota adds i to the "attachment_quota" field.
+func (ou *OrganizationUpdate) AddAttachmentQuota(i int64) *OrganizationUpdate {
+	ou.mutation.AddAttachmentQuota(i)
+	return ou
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (ou *OrganizationUpdate) SetTaskSeq(i int) *OrganizationUpdate {
+	ou.mutation.ResetTaskSeq()
+	ou.mutation.SetTaskSeq(i)
+	return ou
+}
+
+// SetNillableTaskSeq sets the "task_seq" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableTaskSeq(i *int) *OrganizationUpdate {
+	if i != nil {
+		ou.SetTaskSeq(*i)
+	}
+	return ou
+}
+
+// AddTaskSeq adds i to the "task_seq" field.
+func (ou *OrganizationUpdate) AddTaskSeq(i int) *OrganizationUpdate {
+	ou.mutation.AddTaskSeq(i)
+	return ou
+}
+
 // SetParent sets the "parent" edge to the Organization entity.
 func (ou *OrganizationUpdate) SetParent(o *Organization) *OrganizationUpdate {
 	return ou.SetParentID(o.ID)
@@ -280,6 +367,81 @@ func (ou *OrganizationUpdate) AddLeaveRequests(l ...*LeaveRequest) *Organization
 	return ou.AddLeaveRequestIDs(ids...)
 }
 
+// AddSalaryGradeIDs adds the "salary_grades" edge to the SalaryGrade entity by IDs.
+func (ou *OrganizationUpdate) AddSalaryGradeIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddSalaryGradeIDs(ids...)
+	return ou
+}
+
+// AddSalaryGrades adds the "salary_grades" edges to the SalaryGrade entity.
+func (ou *OrganizationUpdate) AddSalaryGrades(s ...*SalaryGrade) *OrganizationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.AddSalaryGradeIDs(ids...)
+}
+
+// AddAttendanceRecordIDs adds the "attendance_records" edge to the AttendanceRecord entity by IDs.
+func (ou *OrganizationUpdate) AddAttendanceRecordIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddAttendanceRecordIDs(ids...)
+	return ou
+}
+
+// AddAttendanceRecords adds the "attendance_records" edges to the AttendanceRecord entity.
+func (ou *OrganizationUpdate) AddAttendanceRecords(a ...*AttendanceRecord) *OrganizationUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.AddAttendanceRecordIDs(ids...)
+}
+
+// AddShiftIDs adds the "shifts" edge to the Shift entity by IDs.
+func (ou *OrganizationUpdate) AddShiftIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddShiftIDs(ids...)
+	return ou
+}
+
+// AddShifts adds the "shifts" edges to the Shift entity.
+func (ou *OrganizationUpdate) AddShifts(s ...*Shift) *OrganizationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.AddShiftIDs(ids...)
+}
+
+// AddHolidayIDs adds the "holidays" edge to the Holiday entity by IDs.
+func (ou *OrganizationUpdate) AddHolidayIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddHolidayIDs(ids...)
+	return ou
+}
+
+// AddHolidays adds the "holidays" edges to the Holiday entity.
+func (ou *OrganizationUpdate) AddHolidays(h ...*Holiday) *OrganizationUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return ou.AddHolidayIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (ou *OrganizationUpdate) AddAttachmentIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddAttachmentIDs(ids...)
+	return ou
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (ou *OrganizationUpdate) AddAttachments(a ...*Attachment) *OrganizationUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (ou *OrganizationUpdate) Mutation() *OrganizationMutation {
 	return ou.mutation
@@ -396,6 +558,111 @@ func (ou *OrganizationUpdate) RemoveLeaveRequests(l ...*LeaveRequest) *Organizat
 	return ou.RemoveLeaveRequestIDs(ids...)
 }
 
+// ClearSalaryGrades clears all "salary_grades" edges to the SalaryGrade entity.
+func (ou *OrganizationUpdate) ClearSalaryGrades() *OrganizationUpdate {
+	ou.mutation.ClearSalaryGrades()
+	return ou
+}
+
+// RemoveSalaryGradeIDs removes the "salary_grades" edge to SalaryGrade entities by IDs.
+func (ou *OrganizationUpdate) RemoveSalaryGradeIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveSalaryGradeIDs(ids...)
+	return ou
+}
+
+// RemoveSalaryGrades removes "salary_grades" edges to SalaryGrade entities.
+func (ou *OrganizationUpdate) RemoveSalaryGrades(s ...*SalaryGrade) *OrganizationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.RemoveSalaryGradeIDs(ids...)
+}
+
+// ClearAttendanceRecords clears all "attendance_records" edges to the AttendanceRecord entity.
+func (ou *OrganizationUpdate) ClearAttendanceRecords() *OrganizationUpdate {
+	ou.mutation.ClearAttendanceRecords()
+	return ou
+}
+
+// RemoveAttendanceRecordIDs removes the "attendance_records" edge to AttendanceRecord entities by IDs.
+func (ou *OrganizationUpdate) RemoveAttendanceRecordIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveAttendanceRecordIDs(ids...)
+	return ou
+}
+
+// RemoveAttendanceRecords removes "attendance_records" edges to AttendanceRecord entities.
+func (ou *OrganizationUpdate) RemoveAttendanceRecords(a ...*AttendanceRecord) *OrganizationUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.RemoveAttendanceRecordIDs(ids...)
+}
+
+// ClearShifts clears all "shifts" edges to the Shift entity.
+func (ou *OrganizationUpdate) ClearShifts() *OrganizationUpdate {
+	ou.mutation.ClearShifts()
+	return ou
+}
+
+// RemoveShiftIDs removes the "shifts" edge to Shift entities by IDs.
+func (ou *OrganizationUpdate) RemoveShiftIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveShiftIDs(ids...)
+	return ou
+}
+
+// RemoveShifts removes "shifts" edges to Shift entities.
+func (ou *OrganizationUpdate) RemoveShifts(s ...*Shift) *OrganizationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.RemoveShiftIDs(ids...)
+}
+
+// ClearHolidays clears all "holidays" edges to the Holiday entity.
+func (ou *OrganizationUpdate) ClearHolidays() *OrganizationUpdate {
+	ou.mutation.ClearHolidays()
+	return ou
+}
+
+// RemoveHolidayIDs removes the "holidays" edge to Holiday entities by IDs.
+func (ou *OrganizationUpdate) RemoveHolidayIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveHolidayIDs(ids...)
+	return ou
+}
+
+// RemoveHolidays removes "holidays" edges to Holiday entities.
+func (ou *OrganizationUpdate) RemoveHolidays(h ...*Holiday) *OrganizationUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return ou.RemoveHolidayIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (ou *OrganizationUpdate) ClearAttachments() *OrganizationUpdate {
+	ou.mutation.ClearAttachments()
+	return ou
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (ou *OrganizationUpdate) RemoveAttachmentIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveAttachmentIDs(ids...)
+	return ou
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (ou *OrganizationUpdate) RemoveAttachments(a ...*Attachment) *OrganizationUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.RemoveAttachmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrganizationUpdate) Save(ctx context.Context) (int, error) {
 	ou.defaults()
@@ -444,6 +711,16 @@ func (ou *OrganizationUpdate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Organization.code": %w`, err)}
 		}
 	}
+	if v, ok := ou.mutation.AttachmentMaxFileSize(); ok {
+		if err := organization.AttachmentMaxFileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_max_file_size", err: fmt.Errorf(`ent: validator failed for field "Organization.attachment_max_file_size": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.AttachmentQuota(); ok {
+		if err := organization.AttachmentQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_quota", err: fmt.Errorf(`ent: validator failed for field "Organization.attachment_quota": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -501,6 +778,35 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.UpdatedAt(); ok {
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ou.mutation.WeekendDays(); ok {
+		_spec.SetField(organization.FieldWeekendDays, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedWeekendDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organization.FieldWeekendDays, value)
+		})
+	}
+	if ou.mutation.WeekendDaysCleared() {
+		_spec.ClearField(organization.FieldWeekendDays, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.AttachmentMaxFileSize(); ok {
+		_spec.SetField(organization.FieldAttachmentMaxFileSize, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedAttachmentMaxFileSize(); ok {
+		_spec.AddField(organization.FieldAttachmentMaxFileSize, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AttachmentQuota(); ok {
+		_spec.SetField(organization.FieldAttachmentQuota, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedAttachmentQuota(); ok {
+		_spec.AddField(organization.FieldAttachmentQuota, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.TaskSeq(); ok {
+		_spec.SetField(organization.FieldTaskSeq, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedTaskSeq(); ok {
+		_spec.AddField(organization.FieldTaskSeq, field.TypeInt, value)
+	}
 	if ou.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -755,6 +1061,231 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.SalaryGradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SalaryGradesTable,
+			Columns: []string{organization.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedSalaryGradesIDs(); len(nodes) > 0 && !ou.mutation.SalaryGradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SalaryGradesTable,
+			Columns: []string{organization.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.SalaryGradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SalaryGradesTable,
+			Columns: []string{organization.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.AttendanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttendanceRecordsTable,
+			Columns: []string{organization.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedAttendanceRecordsIDs(); len(nodes) > 0 && !ou.mutation.AttendanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttendanceRecordsTable,
+			Columns: []string{organization.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.AttendanceRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttendanceRecordsTable,
+			Columns: []string{organization.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.ShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ShiftsTable,
+			Columns: []string{organization.ShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedShiftsIDs(); len(nodes) > 0 && !ou.mutation.ShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ShiftsTable,
+			Columns: []string{organization.ShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ShiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ShiftsTable,
+			Columns: []string{organization.ShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.HolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.HolidaysTable,
+			Columns: []string{organization.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedHolidaysIDs(); len(nodes) > 0 && !ou.mutation.HolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.HolidaysTable,
+			Columns: []string{organization.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.HolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.HolidaysTable,
+			Columns: []string{organization.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttachmentsTable,
+			Columns: []string{organization.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !ou.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttachmentsTable,
+			Columns: []string{organization.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttachmentsTable,
+			Columns: []string{organization.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{organization.Label}
@@ -937,9 +1468,90 @@ func (ouo *OrganizationUpdateOne) SetNillableParentID(i *int) *OrganizationUpdat
 	return ouo
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (ouo *OrganizationUpdateOne) ClearParentID() *OrganizationUpdateOne {
-	ouo.mutation.ClearParentID()
+// ClearParentID clears the value of the "parent_id" field.
+func (ouo *OrganizationUpdateOne) ClearParentID() *OrganizationUpdateOne {
+	ouo.mutation.ClearParentID()
+	return ouo
+}
+
+// SetWeekendDays sets the "weekend_days" field.
+func (ouo *OrganizationUpdateOne) SetWeekendDays(i []int) *OrganizationUpdateOne {
+	ouo.mutation.SetWeekendDays(i)
+	return ouo
+}
+
+// AppendWeekendDays appends i to the "weekend_days" field.
+func (ouo *OrganizationUpdateOne) AppendWeekendDays(i []int) *OrganizationUpdateOne {
+	ouo.mutation.AppendWeekendDays(i)
+	return ouo
+}
+
+// ClearWeekendDays clears the value of the "weekend_days" field.
+func (ouo *OrganizationUpdateOne) ClearWeekendDays() *OrganizationUpdateOne {
+	ouo.mutation.ClearWeekendDays()
+	return ouo
+}
+
+// SetAttachmentMaxFileSize sets the "attachment_max_file_size" field.
+func (ouo *OrganizationUpdateOne) SetAttachmentMaxFileSize(i int64) *OrganizationUpdateOne {
+	ouo.mutation.ResetAttachmentMaxFileSize()
+	ouo.mutation.SetAttachmentMaxFileSize(i)
+	return ouo
+}
+
+// SetNillableAttachmentMaxFileSize sets the "attachment_max_file_size" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableAttachmentMaxFileSize(i *int64) *OrganizationUpdateOne {
+	if i != nil {
+		ouo.SetAttachmentMaxFileSize(*i)
+	}
+	return ouo
+}
+
+// AddAttachmentMaxFileSize adds i to the "attachment_max_file_size" field.
+func (ouo *OrganizationUpdateOne) AddAttachmentMaxFileSize(i int64) *OrganizationUpdateOne {
+	ouo.mutation.AddAttachmentMaxFileSize(i)
+	return ouo
+}
+
+// SetAttachmentQuota sets the "attachment_quota" field.
+func (ouo *OrganizationUpdateOne) SetAttachmentQuota(i int64) *OrganizationUpdateOne {
+	ouo.mutation.ResetAttachmentQuota()
+	ouo.mutation.SetAttachmentQuota(i)
+	return ouo
+}
+
+// SetNillableAttachmentQuota sets the "attachment_quota" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableAttachmentQuota(i *int64) *OrganizationUpdateOne {
+	if i != nil {
+		ouo.SetAttachmentQuota(*i)
+	}
+	return ouo
+}
+
+// AddAttachmentQuota adds i to the "attachment_quota" field.
+func (ouo *OrganizationUpdateOne) AddAttachmentQuota(i int64) *OrganizationUpdateOne {
+	ouo.mutation.AddAttachmentQuota(i)
+	return ouo
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (ouo *OrganizationUpdateOne) SetTaskSeq(i int) *OrganizationUpdateOne {
+	ouo.mutation.ResetTaskSeq()
+	ouo.mutation.SetTaskSeq(i)
+	return ouo
+}
+
+// SetNillableTaskSeq sets the "task_seq" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableTaskSeq(i *int) *OrganizationUpdateOne {
+	if i != nil {
+		ouo.SetTaskSeq(*i)
+	}
+	return ouo
+}
+
+// AddTaskSeq adds i to the "task_seq" field.
+func (ouo *OrganizationUpdateOne) AddTaskSeq(i int) *OrganizationUpdateOne {
+	ouo.mutation.AddTaskSeq(i)
 	return ouo
 }
 
@@ -1023,6 +1635,81 @@ func (ouo *OrganizationUpdateOne) AddLeaveRequests(l ...*LeaveRequest) *Organiza
 	return ouo.AddLeaveRequestIDs(ids...)
 }
 
+// AddSalaryGradeIDs adds the "salary_grades" edge to the SalaryGrade entity by IDs.
+func (ouo *OrganizationUpdateOne) AddSalaryGradeIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddSalaryGradeIDs(ids...)
+	return ouo
+}
+
+// AddSalaryGrades adds the "salary_grades" edges to the SalaryGrade entity.
+func (ouo *OrganizationUpdateOne) AddSalaryGrades(s ...*SalaryGrade) *OrganizationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.AddSalaryGradeIDs(ids...)
+}
+
+// AddAttendanceRecordIDs adds the "attendance_records" edge to the AttendanceRecord entity by IDs.
+func (ouo *OrganizationUpdateOne) AddAttendanceRecordIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddAttendanceRecordIDs(ids...)
+	return ouo
+}
+
+// AddAttendanceRecords adds the "attendance_records" edges to the AttendanceRecord entity.
+func (ouo *OrganizationUpdateOne) AddAttendanceRecords(a ...*AttendanceRecord) *OrganizationUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.AddAttendanceRecordIDs(ids...)
+}
+
+// AddShiftIDs adds the "shifts" edge to the Shift entity by IDs.
+func (ouo *OrganizationUpdateOne) AddShiftIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddShiftIDs(ids...)
+	return ouo
+}
+
+// AddShifts adds the "shifts" edges to the Shift entity.
+func (ouo *OrganizationUpdateOne) AddShifts(s ...*Shift) *OrganizationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.AddShiftIDs(ids...)
+}
+
+// AddHolidayIDs adds the "holidays" edge to the Holiday entity by IDs.
+func (ouo *OrganizationUpdateOne) AddHolidayIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddHolidayIDs(ids...)
+	return ouo
+}
+
+// AddHolidays adds the "holidays" edges to the Holiday entity.
+func (ouo *OrganizationUpdateOne) AddHolidays(h ...*Holiday) *OrganizationUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return ouo.AddHolidayIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (ouo *OrganizationUpdateOne) AddAttachmentIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddAttachmentIDs(ids...)
+	return ouo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (ouo *OrganizationUpdateOne) AddAttachments(a ...*Attachment) *OrganizationUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (ouo *OrganizationUpdateOne) Mutation() *OrganizationMutation {
 	return ouo.mutation
@@ -1139,6 +1826,111 @@ func (ouo *OrganizationUpdateOne) RemoveLeaveRequests(l ...*LeaveRequest) *Organ
 	return ouo.RemoveLeaveRequestIDs(ids...)
 }
 
+// ClearSalaryGrades clears all "salary_grades" edges to the SalaryGrade entity.
+func (ouo *OrganizationUpdateOne) ClearSalaryGrades() *OrganizationUpdateOne {
+	ouo.mutation.ClearSalaryGrades()
+	return ouo
+}
+
+// RemoveSalaryGradeIDs removes the "salary_grades" edge to SalaryGrade entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveSalaryGradeIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveSalaryGradeIDs(ids...)
+	return ouo
+}
+
+// RemoveSalaryGrades removes "salary_grades" edges to SalaryGrade entities.
+func (ouo *OrganizationUpdateOne) RemoveSalaryGrades(s ...*SalaryGrade) *OrganizationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.RemoveSalaryGradeIDs(ids...)
+}
+
+// ClearAttendanceRecords clears all "attendance_records" edges to the AttendanceRecord entity.
+func (ouo *OrganizationUpdateOne) ClearAttendanceRecords() *OrganizationUpdateOne {
+	ouo.mutation.ClearAttendanceRecords()
+	return ouo
+}
+
+// RemoveAttendanceRecordIDs removes the "attendance_records" edge to AttendanceRecord entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveAttendanceRecordIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveAttendanceRecordIDs(ids...)
+	return ouo
+}
+
+// RemoveAttendanceRecords removes "attendance_records" edges to AttendanceRecord entities.
+func (ouo *OrganizationUpdateOne) RemoveAttendanceRecords(a ...*AttendanceRecord) *OrganizationUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.RemoveAttendanceRecordIDs(ids...)
+}
+
+// ClearShifts clears all "shifts" edges to the Shift entity.
+func (ouo *OrganizationUpdateOne) ClearShifts() *OrganizationUpdateOne {
+	ouo.mutation.ClearShifts()
+	return ouo
+}
+
+// RemoveShiftIDs removes the "shifts" edge to Shift entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveShiftIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveShiftIDs(ids...)
+	return ouo
+}
+
+// RemoveShifts removes "shifts" edges to Shift entities.
+func (ouo *OrganizationUpdateOne) RemoveShifts(s ...*Shift) *OrganizationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.RemoveShiftIDs(ids...)
+}
+
+// ClearHolidays clears all "holidays" edges to the Holiday entity.
+func (ouo *OrganizationUpdateOne) ClearHolidays() *OrganizationUpdateOne {
+	ouo.mutation.ClearHolidays()
+	return ouo
+}
+
+// RemoveHolidayIDs removes the "holidays" edge to Holiday entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveHolidayIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveHolidayIDs(ids...)
+	return ouo
+}
+
+// RemoveHolidays removes "holidays" edges to Holiday entities.
+func (ouo *OrganizationUpdateOne) RemoveHolidays(h ...*Holiday) *OrganizationUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return ouo.RemoveHolidayIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (ouo *OrganizationUpdateOne) ClearAttachments() *OrganizationUpdateOne {
+	ouo.mutation.ClearAttachments()
+	return ouo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveAttachmentIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveAttachmentIDs(ids...)
+	return ouo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (ouo *OrganizationUpdateOne) RemoveAttachments(a ...*Attachment) *OrganizationUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.RemoveAttachmentIDs(ids...)
+}
+
 // Where appends a list predicates to the OrganizationUpdate builder.
 func (ouo *OrganizationUpdateOne) Where(ps ...predicate.Organization) *OrganizationUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -1200,6 +1992,16 @@ func (ouo *OrganizationUpdateOne) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Organization.code": %w`, err)}
 		}
 	}
+	if v, ok := ouo.mutation.AttachmentMaxFileSize(); ok {
+		if err := organization.AttachmentMaxFileSizeValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_max_file_size", err: fmt.Errorf(`ent: validator failed for field "Organization.attachment_max_file_size": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.AttachmentQuota(); ok {
+		if err := organization.AttachmentQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_quota", err: fmt.Errorf(`ent: validator failed for field "Organization.attachment_quota": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1274,6 +2076,35 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 	if value, ok := ouo.mutation.UpdatedAt(); ok {
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ouo.mutation.WeekendDays(); ok {
+		_spec.SetField(organization.FieldWeekendDays, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedWeekendDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organization.FieldWeekendDays, value)
+		})
+	}
+	if ouo.mutation.WeekendDaysCleared() {
+		_spec.ClearField(organization.FieldWeekendDays, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.AttachmentMaxFileSize(); ok {
+		_spec.SetField(organization.FieldAttachmentMaxFileSize, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedAttachmentMaxFileSize(); ok {
+		_spec.AddField(organization.FieldAttachmentMaxFileSize, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AttachmentQuota(); ok {
+		_spec.SetField(organization.FieldAttachmentQuota, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedAttachmentQuota(); ok {
+		_spec.AddField(organization.FieldAttachmentQuota, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.TaskSeq(); ok {
+		_spec.SetField(organization.FieldTaskSeq, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedTaskSeq(); ok {
+		_spec.AddField(organization.FieldTaskSeq, field.TypeInt, value)
+	}
 	if ouo.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1528,6 +2359,231 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.SalaryGradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SalaryGradesTable,
+			Columns: []string{organization.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedSalaryGradesIDs(); len(nodes) > 0 && !ouo.mutation.SalaryGradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SalaryGradesTable,
+			Columns: []string{organization.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.SalaryGradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SalaryGradesTable,
+			Columns: []string{organization.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.AttendanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttendanceRecordsTable,
+			Columns: []string{organization.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedAttendanceRecordsIDs(); len(nodes) > 0 && !ouo.mutation.AttendanceRecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttendanceRecordsTable,
+			Columns: []string{organization.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.AttendanceRecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttendanceRecordsTable,
+			Columns: []string{organization.AttendanceRecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attendancerecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.ShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ShiftsTable,
+			Columns: []string{organization.ShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedShiftsIDs(); len(nodes) > 0 && !ouo.mutation.ShiftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ShiftsTable,
+			Columns: []string{organization.ShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ShiftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ShiftsTable,
+			Columns: []string{organization.ShiftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.HolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.HolidaysTable,
+			Columns: []string{organization.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedHolidaysIDs(); len(nodes) > 0 && !ouo.mutation.HolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.HolidaysTable,
+			Columns: []string{organization.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.HolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.HolidaysTable,
+			Columns: []string{organization.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttachmentsTable,
+			Columns: []string{organization.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !ouo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttachmentsTable,
+			Columns: []string{organization.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.AttachmentsTable,
+			Columns: []string{organization.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Organization{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
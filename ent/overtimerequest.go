@@ -0,0 +1,347 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// OvertimeRequest is the model entity for the OvertimeRequest schema.
+type OvertimeRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// WorkDate holds the value of the "work_date" field.
+	WorkDate time.Time `json:"work_date"`
+	// Hours holds the value of the "hours" field.
+	Hours float64 `json:"hours"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason"`
+	// DayType holds the value of the "day_type" field.
+	DayType overtimerequest.DayType `json:"day_type"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID *int `json:"project_id"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID *int `json:"task_id"`
+	// Status holds the value of the "status" field.
+	Status overtimerequest.Status `json:"status"`
+	// ApproverPositionID holds the value of the "approver_position_id" field.
+	ApproverPositionID *int `json:"approver_position_id"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *int `json:"reviewer_id"`
+	// ReviewComment holds the value of the "review_comment" field.
+	ReviewComment *string `json:"review_comment"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OvertimeRequestQuery when eager-loading is set.
+	Edges        OvertimeRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OvertimeRequestEdges holds the relations/edges for other nodes in the graph.
+type OvertimeRequestEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee"`
+	// Project holds the value of the project edge.
+	Project *Project `json:"project"`
+	// Task holds the value of the task edge.
+	Task *Task `json:"task"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OvertimeRequestEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OvertimeRequestEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OvertimeRequestEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OvertimeRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case overtimerequest.FieldHours:
+			values[i] = new(sql.NullFloat64)
+		case overtimerequest.FieldID, overtimerequest.FieldOrgID, overtimerequest.FieldEmployeeID, overtimerequest.FieldProjectID, overtimerequest.FieldTaskID, overtimerequest.FieldApproverPositionID, overtimerequest.FieldReviewerID:
+			values[i] = new(sql.NullInt64)
+		case overtimerequest.FieldReason, overtimerequest.FieldDayType, overtimerequest.FieldStatus, overtimerequest.FieldReviewComment:
+			values[i] = new(sql.NullString)
+		case overtimerequest.FieldWorkDate, overtimerequest.FieldReviewedAt, overtimerequest.FieldCreatedAt, overtimerequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OvertimeRequest fields.
+func (or *OvertimeRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case overtimerequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			or.ID = int(value.Int64)
+		case overtimerequest.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				or.OrgID = int(value.Int64)
+			}
+		case overtimerequest.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				or.EmployeeID = int(value.Int64)
+			}
+		case overtimerequest.FieldWorkDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_date", values[i])
+			} else if value.Valid {
+				or.WorkDate = value.Time
+			}
+		case overtimerequest.FieldHours:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field hours", values[i])
+			} else if value.Valid {
+				or.Hours = value.Float64
+			}
+		case overtimerequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				or.Reason = value.String
+			}
+		case overtimerequest.FieldDayType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field day_type", values[i])
+			} else if value.Valid {
+				or.DayType = overtimerequest.DayType(value.String)
+			}
+		case overtimerequest.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				or.ProjectID = new(int)
+				*or.ProjectID = int(value.Int64)
+			}
+		case overtimerequest.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				or.TaskID = new(int)
+				*or.TaskID = int(value.Int64)
+			}
+		case overtimerequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				or.Status = overtimerequest.Status(value.String)
+			}
+		case overtimerequest.FieldApproverPositionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approver_position_id", values[i])
+			} else if value.Valid {
+				or.ApproverPositionID = new(int)
+				*or.ApproverPositionID = int(value.Int64)
+			}
+		case overtimerequest.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				or.ReviewerID = new(int)
+				*or.ReviewerID = int(value.Int64)
+			}
+		case overtimerequest.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				or.ReviewComment = new(string)
+				*or.ReviewComment = value.String
+			}
+		case overtimerequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				or.ReviewedAt = new(time.Time)
+				*or.ReviewedAt = value.Time
+			}
+		case overtimerequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				or.CreatedAt = value.Time
+			}
+		case overtimerequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				or.UpdatedAt = value.Time
+			}
+		default:
+			or.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OvertimeRequest.
+// This includes values selected through modifiers, order, etc.
+func (or *OvertimeRequest) Value(name string) (ent.Value, error) {
+	return or.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the OvertimeRequest entity.
+func (or *OvertimeRequest) QueryEmployee() *EmployeeQuery {
+	return NewOvertimeRequestClient(or.config).QueryEmployee(or)
+}
+
+// QueryProject queries the "project" edge of the OvertimeRequest entity.
+func (or *OvertimeRequest) QueryProject() *ProjectQuery {
+	return NewOvertimeRequestClient(or.config).QueryProject(or)
+}
+
+// QueryTask queries the "task" edge of the OvertimeRequest entity.
+func (or *OvertimeRequest) QueryTask() *TaskQuery {
+	return NewOvertimeRequestClient(or.config).QueryTask(or)
+}
+
+// Update returns a builder for updating this OvertimeRequest.
+// Note that you need to call OvertimeRequest.Unwrap() before calling this method if this OvertimeRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (or *OvertimeRequest) Update() *OvertimeRequestUpdateOne {
+	return NewOvertimeRequestClient(or.config).UpdateOne(or)
+}
+
+// Unwrap unwraps the OvertimeRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (or *OvertimeRequest) Unwrap() *OvertimeRequest {
+	_tx, ok := or.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OvertimeRequest is not a transactional entity")
+	}
+	or.config.driver = _tx.drv
+	return or
+}
+
+// String implements the fmt.Stringer.
+func (or *OvertimeRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("OvertimeRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", or.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", or.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", or.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("work_date=")
+	builder.WriteString(or.WorkDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("hours=")
+	builder.WriteString(fmt.Sprintf("%v", or.Hours))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(or.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("day_type=")
+	builder.WriteString(fmt.Sprintf("%v", or.DayType))
+	builder.WriteString(", ")
+	if v := or.ProjectID; v != nil {
+		builder.WriteString("project_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := or.TaskID; v != nil {
+		builder.WriteString("task_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", or.Status))
+	builder.WriteString(", ")
+	if v := or.ApproverPositionID; v != nil {
+		builder.WriteString("approver_position_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := or.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := or.ReviewComment; v != nil {
+		builder.WriteString("review_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := or.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(or.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(or.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OvertimeRequests is a parsable slice of OvertimeRequest.
+type OvertimeRequests []*OvertimeRequest
@@ -0,0 +1,815 @@
+// Code generated by ent, DO NOT EDIT.
+
+package overtimerequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldID, id))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldOrgID, v))
+}
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// WorkDate applies equality check predicate on the "work_date" field. It's identical to WorkDateEQ.
+func WorkDate(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldWorkDate, v))
+}
+
+// Hours applies equality check predicate on the "hours" field. It's identical to HoursEQ.
+func Hours(v float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldHours, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldProjectID, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldTaskID, v))
+}
+
+// ApproverPositionID applies equality check predicate on the "approver_position_id" field. It's identical to ApproverPositionIDEQ.
+func ApproverPositionID(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldApproverPositionID, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldOrgID, v))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// WorkDateEQ applies the EQ predicate on the "work_date" field.
+func WorkDateEQ(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldWorkDate, v))
+}
+
+// WorkDateNEQ applies the NEQ predicate on the "work_date" field.
+func WorkDateNEQ(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldWorkDate, v))
+}
+
+// WorkDateIn applies the In predicate on the "work_date" field.
+func WorkDateIn(vs ...time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldWorkDate, vs...))
+}
+
+// WorkDateNotIn applies the NotIn predicate on the "work_date" field.
+func WorkDateNotIn(vs ...time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldWorkDate, vs...))
+}
+
+// WorkDateGT applies the GT predicate on the "work_date" field.
+func WorkDateGT(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldWorkDate, v))
+}
+
+// WorkDateGTE applies the GTE predicate on the "work_date" field.
+func WorkDateGTE(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldWorkDate, v))
+}
+
+// WorkDateLT applies the LT predicate on the "work_date" field.
+func WorkDateLT(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldWorkDate, v))
+}
+
+// WorkDateLTE applies the LTE predicate on the "work_date" field.
+func WorkDateLTE(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldWorkDate, v))
+}
+
+// HoursEQ applies the EQ predicate on the "hours" field.
+func HoursEQ(v float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldHours, v))
+}
+
+// HoursNEQ applies the NEQ predicate on the "hours" field.
+func HoursNEQ(v float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldHours, v))
+}
+
+// HoursIn applies the In predicate on the "hours" field.
+func HoursIn(vs ...float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldHours, vs...))
+}
+
+// HoursNotIn applies the NotIn predicate on the "hours" field.
+func HoursNotIn(vs ...float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldHours, vs...))
+}
+
+// HoursGT applies the GT predicate on the "hours" field.
+func HoursGT(v float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldHours, v))
+}
+
+// HoursGTE applies the GTE predicate on the "hours" field.
+func HoursGTE(v float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldHours, v))
+}
+
+// HoursLT applies the LT predicate on the "hours" field.
+func HoursLT(v float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldHours, v))
+}
+
+// HoursLTE applies the LTE predicate on the "hours" field.
+func HoursLTE(v float64) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldHours, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldContainsFold(FieldReason, v))
+}
+
+// DayTypeEQ applies the EQ predicate on the "day_type" field.
+func DayTypeEQ(v DayType) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldDayType, v))
+}
+
+// DayTypeNEQ applies the NEQ predicate on the "day_type" field.
+func DayTypeNEQ(v DayType) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldDayType, v))
+}
+
+// DayTypeIn applies the In predicate on the "day_type" field.
+func DayTypeIn(vs ...DayType) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldDayType, vs...))
+}
+
+// DayTypeNotIn applies the NotIn predicate on the "day_type" field.
+func DayTypeNotIn(vs ...DayType) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldDayType, vs...))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotNull(FieldProjectID))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDIsNil applies the IsNil predicate on the "task_id" field.
+func TaskIDIsNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIsNull(FieldTaskID))
+}
+
+// TaskIDNotNil applies the NotNil predicate on the "task_id" field.
+func TaskIDNotNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotNull(FieldTaskID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ApproverPositionIDEQ applies the EQ predicate on the "approver_position_id" field.
+func ApproverPositionIDEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldApproverPositionID, v))
+}
+
+// ApproverPositionIDNEQ applies the NEQ predicate on the "approver_position_id" field.
+func ApproverPositionIDNEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldApproverPositionID, v))
+}
+
+// ApproverPositionIDIn applies the In predicate on the "approver_position_id" field.
+func ApproverPositionIDIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldApproverPositionID, vs...))
+}
+
+// ApproverPositionIDNotIn applies the NotIn predicate on the "approver_position_id" field.
+func ApproverPositionIDNotIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldApproverPositionID, vs...))
+}
+
+// ApproverPositionIDGT applies the GT predicate on the "approver_position_id" field.
+func ApproverPositionIDGT(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldApproverPositionID, v))
+}
+
+// ApproverPositionIDGTE applies the GTE predicate on the "approver_position_id" field.
+func ApproverPositionIDGTE(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldApproverPositionID, v))
+}
+
+// ApproverPositionIDLT applies the LT predicate on the "approver_position_id" field.
+func ApproverPositionIDLT(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldApproverPositionID, v))
+}
+
+// ApproverPositionIDLTE applies the LTE predicate on the "approver_position_id" field.
+func ApproverPositionIDLTE(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldApproverPositionID, v))
+}
+
+// ApproverPositionIDIsNil applies the IsNil predicate on the "approver_position_id" field.
+func ApproverPositionIDIsNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIsNull(FieldApproverPositionID))
+}
+
+// ApproverPositionIDNotNil applies the NotNil predicate on the "approver_position_id" field.
+func ApproverPositionIDNotNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotNull(FieldApproverPositionID))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v int) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentIsNil applies the IsNil predicate on the "review_comment" field.
+func ReviewCommentIsNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIsNull(FieldReviewComment))
+}
+
+// ReviewCommentNotNil applies the NotNil predicate on the "review_comment" field.
+func ReviewCommentNotNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotNull(FieldReviewComment))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OvertimeRequest) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OvertimeRequest) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OvertimeRequest) predicate.OvertimeRequest {
+	return predicate.OvertimeRequest(sql.NotPredicates(p))
+}
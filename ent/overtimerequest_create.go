@@ -0,0 +1,1556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// OvertimeRequestCreate is the builder for creating a OvertimeRequest entity.
+type OvertimeRequestCreate struct {
+	config
+	mutation *OvertimeRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (orc *OvertimeRequestCreate) SetOrgID(i int) *OvertimeRequestCreate {
+	orc.mutation.SetOrgID(i)
+	return orc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (orc *OvertimeRequestCreate) SetEmployeeID(i int) *OvertimeRequestCreate {
+	orc.mutation.SetEmployeeID(i)
+	return orc
+}
+
+// SetWorkDate sets the "work_date" field.
+func (orc *OvertimeRequestCreate) SetWorkDate(t time.Time) *OvertimeRequestCreate {
+	orc.mutation.SetWorkDate(t)
+	return orc
+}
+
+// SetHours sets the "hours" field.
+func (orc *OvertimeRequestCreate) SetHours(f float64) *OvertimeRequestCreate {
+	orc.mutation.SetHours(f)
+	return orc
+}
+
+// SetReason sets the "reason" field.
+func (orc *OvertimeRequestCreate) SetReason(s string) *OvertimeRequestCreate {
+	orc.mutation.SetReason(s)
+	return orc
+}
+
+// SetDayType sets the "day_type" field.
+func (orc *OvertimeRequestCreate) SetDayType(ot overtimerequest.DayType) *OvertimeRequestCreate {
+	orc.mutation.SetDayType(ot)
+	return orc
+}
+
+// SetNillableDayType sets the "day_type" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableDayType(ot *overtimerequest.DayType) *OvertimeRequestCreate {
+	if ot != nil {
+		orc.SetDayType(*ot)
+	}
+	return orc
+}
+
+// SetProjectID sets the "project_id" field.
+func (orc *OvertimeRequestCreate) SetProjectID(i int) *OvertimeRequestCreate {
+	orc.mutation.SetProjectID(i)
+	return orc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableProjectID(i *int) *OvertimeRequestCreate {
+	if i != nil {
+		orc.SetProjectID(*i)
+	}
+	return orc
+}
+
+// SetTaskID sets the "task_id" field.
+func (orc *OvertimeRequestCreate) SetTaskID(i int) *OvertimeRequestCreate {
+	orc.mutation.SetTaskID(i)
+	return orc
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableTaskID(i *int) *OvertimeRequestCreate {
+	if i != nil {
+		orc.SetTaskID(*i)
+	}
+	return orc
+}
+
+// SetStatus sets the "status" field.
+func (orc *OvertimeRequestCreate) SetStatus(o overtimerequest.Status) *OvertimeRequestCreate {
+	orc.mutation.SetStatus(o)
+	return orc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableStatus(o *overtimerequest.Status) *OvertimeRequestCreate {
+	if o != nil {
+		orc.SetStatus(*o)
+	}
+	return orc
+}
+
+// SetApproverPositionID sets the "approver_position_id" field.
+func (orc *OvertimeRequestCreate) SetApproverPositionID(i int) *OvertimeRequestCreate {
+	orc.mutation.SetApproverPositionID(i)
+	return orc
+}
+
+// SetNillableApproverPositionID sets the "approver_position_id" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableApproverPositionID(i *int) *OvertimeRequestCreate {
+	if i != nil {
+		orc.SetApproverPositionID(*i)
+	}
+	return orc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (orc *OvertimeRequestCreate) SetReviewerID(i int) *OvertimeRequestCreate {
+	orc.mutation.SetReviewerID(i)
+	return orc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableReviewerID(i *int) *OvertimeRequestCreate {
+	if i != nil {
+		orc.SetReviewerID(*i)
+	}
+	return orc
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (orc *OvertimeRequestCreate) SetReviewComment(s string) *OvertimeRequestCreate {
+	orc.mutation.SetReviewComment(s)
+	return orc
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableReviewComment(s *string) *OvertimeRequestCreate {
+	if s != nil {
+		orc.SetReviewComment(*s)
+	}
+	return orc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (orc *OvertimeRequestCreate) SetReviewedAt(t time.Time) *OvertimeRequestCreate {
+	orc.mutation.SetReviewedAt(t)
+	return orc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableReviewedAt(t *time.Time) *OvertimeRequestCreate {
+	if t != nil {
+		orc.SetReviewedAt(*t)
+	}
+	return orc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (orc *OvertimeRequestCreate) SetCreatedAt(t time.Time) *OvertimeRequestCreate {
+	orc.mutation.SetCreatedAt(t)
+	return orc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableCreatedAt(t *time.Time) *OvertimeRequestCreate {
+	if t != nil {
+		orc.SetCreatedAt(*t)
+	}
+	return orc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (orc *OvertimeRequestCreate) SetUpdatedAt(t time.Time) *OvertimeRequestCreate {
+	orc.mutation.SetUpdatedAt(t)
+	return orc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (orc *OvertimeRequestCreate) SetNillableUpdatedAt(t *time.Time) *OvertimeRequestCreate {
+	if t != nil {
+		orc.SetUpdatedAt(*t)
+	}
+	return orc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (orc *OvertimeRequestCreate) SetEmployee(e *Employee) *OvertimeRequestCreate {
+	return orc.SetEmployeeID(e.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (orc *OvertimeRequestCreate) SetProject(p *Project) *OvertimeRequestCreate {
+	return orc.SetProjectID(p.ID)
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (orc *OvertimeRequestCreate) SetTask(t *Task) *OvertimeRequestCreate {
+	return orc.SetTaskID(t.ID)
+}
+
+// Mutation returns the OvertimeRequestMutation object of the builder.
+func (orc *OvertimeRequestCreate) Mutation() *OvertimeRequestMutation {
+	return orc.mutation
+}
+
+// Save creates the OvertimeRequest in the database.
+func (orc *OvertimeRequestCreate) Save(ctx context.Context) (*OvertimeRequest, error) {
+	orc.defaults()
+	return withHooks(ctx, orc.sqlSave, orc.mutation, orc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (orc *OvertimeRequestCreate) SaveX(ctx context.Context) *OvertimeRequest {
+	v, err := orc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (orc *OvertimeRequestCreate) Exec(ctx context.Context) error {
+	_, err := orc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (orc *OvertimeRequestCreate) ExecX(ctx context.Context) {
+	if err := orc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (orc *OvertimeRequestCreate) defaults() {
+	if _, ok := orc.mutation.DayType(); !ok {
+		v := overtimerequest.DefaultDayType
+		orc.mutation.SetDayType(v)
+	}
+	if _, ok := orc.mutation.Status(); !ok {
+		v := overtimerequest.DefaultStatus
+		orc.mutation.SetStatus(v)
+	}
+	if _, ok := orc.mutation.CreatedAt(); !ok {
+		v := overtimerequest.DefaultCreatedAt()
+		orc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := orc.mutation.UpdatedAt(); !ok {
+		v := overtimerequest.DefaultUpdatedAt()
+		orc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (orc *OvertimeRequestCreate) check() error {
+	if _, ok := orc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "OvertimeRequest.org_id"`)}
+	}
+	if _, ok := orc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "OvertimeRequest.employee_id"`)}
+	}
+	if _, ok := orc.mutation.WorkDate(); !ok {
+		return &ValidationError{Name: "work_date", err: errors.New(`ent: missing required field "OvertimeRequest.work_date"`)}
+	}
+	if _, ok := orc.mutation.Hours(); !ok {
+		return &ValidationError{Name: "hours", err: errors.New(`ent: missing required field "OvertimeRequest.hours"`)}
+	}
+	if v, ok := orc.mutation.Hours(); ok {
+		if err := overtimerequest.HoursValidator(v); err != nil {
+			return &ValidationError{Name: "hours", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.hours": %w`, err)}
+		}
+	}
+	if _, ok := orc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "OvertimeRequest.reason"`)}
+	}
+	if _, ok := orc.mutation.DayType(); !ok {
+		return &ValidationError{Name: "day_type", err: errors.New(`ent: missing required field "OvertimeRequest.day_type"`)}
+	}
+	if v, ok := orc.mutation.DayType(); ok {
+		if err := overtimerequest.DayTypeValidator(v); err != nil {
+			return &ValidationError{Name: "day_type", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.day_type": %w`, err)}
+		}
+	}
+	if _, ok := orc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OvertimeRequest.status"`)}
+	}
+	if v, ok := orc.mutation.Status(); ok {
+		if err := overtimerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := orc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OvertimeRequest.created_at"`)}
+	}
+	if _, ok := orc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OvertimeRequest.updated_at"`)}
+	}
+	if len(orc.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "OvertimeRequest.employee"`)}
+	}
+	return nil
+}
+
+func (orc *OvertimeRequestCreate) sqlSave(ctx context.Context) (*OvertimeRequest, error) {
+	if err := orc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := orc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, orc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	orc.mutation.id = &_node.ID
+	orc.mutation.done = true
+	return _node, nil
+}
+
+func (orc *OvertimeRequestCreate) createSpec() (*OvertimeRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OvertimeRequest{config: orc.config}
+		_spec = sqlgraph.NewCreateSpec(overtimerequest.Table, sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = orc.conflict
+	if value, ok := orc.mutation.OrgID(); ok {
+		_spec.SetField(overtimerequest.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := orc.mutation.WorkDate(); ok {
+		_spec.SetField(overtimerequest.FieldWorkDate, field.TypeTime, value)
+		_node.WorkDate = value
+	}
+	if value, ok := orc.mutation.Hours(); ok {
+		_spec.SetField(overtimerequest.FieldHours, field.TypeFloat64, value)
+		_node.Hours = value
+	}
+	if value, ok := orc.mutation.Reason(); ok {
+		_spec.SetField(overtimerequest.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := orc.mutation.DayType(); ok {
+		_spec.SetField(overtimerequest.FieldDayType, field.TypeEnum, value)
+		_node.DayType = value
+	}
+	if value, ok := orc.mutation.Status(); ok {
+		_spec.SetField(overtimerequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := orc.mutation.ApproverPositionID(); ok {
+		_spec.SetField(overtimerequest.FieldApproverPositionID, field.TypeInt, value)
+		_node.ApproverPositionID = &value
+	}
+	if value, ok := orc.mutation.ReviewerID(); ok {
+		_spec.SetField(overtimerequest.FieldReviewerID, field.TypeInt, value)
+		_node.ReviewerID = &value
+	}
+	if value, ok := orc.mutation.ReviewComment(); ok {
+		_spec.SetField(overtimerequest.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = &value
+	}
+	if value, ok := orc.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtimerequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := orc.mutation.CreatedAt(); ok {
+		_spec.SetField(overtimerequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := orc.mutation.UpdatedAt(); ok {
+		_spec.SetField(overtimerequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := orc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.EmployeeTable,
+			Columns: []string{overtimerequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := orc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.ProjectTable,
+			Columns: []string{overtimerequest.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := orc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.TaskTable,
+			Columns: []string{overtimerequest.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OvertimeRequest.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OvertimeRequestUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (orc *OvertimeRequestCreate) OnConflict(opts ...sql.ConflictOption) *OvertimeRequestUpsertOne {
+	orc.conflict = opts
+	return &OvertimeRequestUpsertOne{
+		create: orc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OvertimeRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (orc *OvertimeRequestCreate) OnConflictColumns(columns ...string) *OvertimeRequestUpsertOne {
+	orc.conflict = append(orc.conflict, sql.ConflictColumns(columns...))
+	return &OvertimeRequestUpsertOne{
+		create: orc,
+	}
+}
+
+type (
+	// OvertimeRequestUpsertOne is the builder for "upsert"-ing
+	//  one OvertimeRequest node.
+	OvertimeRequestUpsertOne struct {
+		create *OvertimeRequestCreate
+	}
+
+	// OvertimeRequestUpsert is the "OnConflict" setter.
+	OvertimeRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *OvertimeRequestUpsert) SetOrgID(v int) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateOrgID() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *OvertimeRequestUpsert) AddOrgID(v int) *OvertimeRequestUpsert {
+	u.Add(overtimerequest.FieldOrgID, v)
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *OvertimeRequestUpsert) SetEmployeeID(v int) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateEmployeeID() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldEmployeeID)
+	return u
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *OvertimeRequestUpsert) SetWorkDate(v time.Time) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldWorkDate, v)
+	return u
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateWorkDate() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldWorkDate)
+	return u
+}
+
+// SetHours sets the "hours" field.
+func (u *OvertimeRequestUpsert) SetHours(v float64) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldHours, v)
+	return u
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateHours() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldHours)
+	return u
+}
+
+// AddHours adds v to the "hours" field.
+func (u *OvertimeRequestUpsert) AddHours(v float64) *OvertimeRequestUpsert {
+	u.Add(overtimerequest.FieldHours, v)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *OvertimeRequestUpsert) SetReason(v string) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateReason() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldReason)
+	return u
+}
+
+// SetDayType sets the "day_type" field.
+func (u *OvertimeRequestUpsert) SetDayType(v overtimerequest.DayType) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldDayType, v)
+	return u
+}
+
+// UpdateDayType sets the "day_type" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateDayType() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldDayType)
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *OvertimeRequestUpsert) SetProjectID(v int) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateProjectID() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldProjectID)
+	return u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *OvertimeRequestUpsert) ClearProjectID() *OvertimeRequestUpsert {
+	u.SetNull(overtimerequest.FieldProjectID)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *OvertimeRequestUpsert) SetTaskID(v int) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateTaskID() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldTaskID)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *OvertimeRequestUpsert) ClearTaskID() *OvertimeRequestUpsert {
+	u.SetNull(overtimerequest.FieldTaskID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *OvertimeRequestUpsert) SetStatus(v overtimerequest.Status) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateStatus() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldStatus)
+	return u
+}
+
+// SetApproverPositionID sets the "approver_position_id" field.
+func (u *OvertimeRequestUpsert) SetApproverPositionID(v int) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldApproverPositionID, v)
+	return u
+}
+
+// UpdateApproverPositionID sets the "approver_position_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateApproverPositionID() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldApproverPositionID)
+	return u
+}
+
+// AddApproverPositionID adds v to the "approver_position_id" field.
+func (u *OvertimeRequestUpsert) AddApproverPositionID(v int) *OvertimeRequestUpsert {
+	u.Add(overtimerequest.FieldApproverPositionID, v)
+	return u
+}
+
+// ClearApproverPositionID clears the value of the "approver_position_id" field.
+func (u *OvertimeRequestUpsert) ClearApproverPositionID() *OvertimeRequestUpsert {
+	u.SetNull(overtimerequest.FieldApproverPositionID)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *OvertimeRequestUpsert) SetReviewerID(v int) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateReviewerID() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldReviewerID)
+	return u
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *OvertimeRequestUpsert) AddReviewerID(v int) *OvertimeRequestUpsert {
+	u.Add(overtimerequest.FieldReviewerID, v)
+	return u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *OvertimeRequestUpsert) ClearReviewerID() *OvertimeRequestUpsert {
+	u.SetNull(overtimerequest.FieldReviewerID)
+	return u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *OvertimeRequestUpsert) SetReviewComment(v string) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldReviewComment, v)
+	return u
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateReviewComment() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldReviewComment)
+	return u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *OvertimeRequestUpsert) ClearReviewComment() *OvertimeRequestUpsert {
+	u.SetNull(overtimerequest.FieldReviewComment)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *OvertimeRequestUpsert) SetReviewedAt(v time.Time) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateReviewedAt() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *OvertimeRequestUpsert) ClearReviewedAt() *OvertimeRequestUpsert {
+	u.SetNull(overtimerequest.FieldReviewedAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *OvertimeRequestUpsert) SetCreatedAt(v time.Time) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateCreatedAt() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OvertimeRequestUpsert) SetUpdatedAt(v time.Time) *OvertimeRequestUpsert {
+	u.Set(overtimerequest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsert) UpdateUpdatedAt() *OvertimeRequestUpsert {
+	u.SetExcluded(overtimerequest.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.OvertimeRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *OvertimeRequestUpsertOne) UpdateNewValues() *OvertimeRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OvertimeRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OvertimeRequestUpsertOne) Ignore() *OvertimeRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OvertimeRequestUpsertOne) DoNothing() *OvertimeRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OvertimeRequestCreate.OnConflict
+// documentation for more info.
+func (u *OvertimeRequestUpsertOne) Update(set func(*OvertimeRequestUpsert)) *OvertimeRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OvertimeRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *OvertimeRequestUpsertOne) SetOrgID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *OvertimeRequestUpsertOne) AddOrgID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateOrgID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *OvertimeRequestUpsertOne) SetEmployeeID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateEmployeeID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *OvertimeRequestUpsertOne) SetWorkDate(v time.Time) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetWorkDate(v)
+	})
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateWorkDate() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateWorkDate()
+	})
+}
+
+// SetHours sets the "hours" field.
+func (u *OvertimeRequestUpsertOne) SetHours(v float64) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetHours(v)
+	})
+}
+
+// AddHours adds v to the "hours" field.
+func (u *OvertimeRequestUpsertOne) AddHours(v float64) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.AddHours(v)
+	})
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateHours() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateHours()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *OvertimeRequestUpsertOne) SetReason(v string) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateReason() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetDayType sets the "day_type" field.
+func (u *OvertimeRequestUpsertOne) SetDayType(v overtimerequest.DayType) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetDayType(v)
+	})
+}
+
+// UpdateDayType sets the "day_type" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateDayType() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateDayType()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *OvertimeRequestUpsertOne) SetProjectID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateProjectID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *OvertimeRequestUpsertOne) ClearProjectID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *OvertimeRequestUpsertOne) SetTaskID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateTaskID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *OvertimeRequestUpsertOne) ClearTaskID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OvertimeRequestUpsertOne) SetStatus(v overtimerequest.Status) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateStatus() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetApproverPositionID sets the "approver_position_id" field.
+func (u *OvertimeRequestUpsertOne) SetApproverPositionID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetApproverPositionID(v)
+	})
+}
+
+// AddApproverPositionID adds v to the "approver_position_id" field.
+func (u *OvertimeRequestUpsertOne) AddApproverPositionID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.AddApproverPositionID(v)
+	})
+}
+
+// UpdateApproverPositionID sets the "approver_position_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateApproverPositionID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateApproverPositionID()
+	})
+}
+
+// ClearApproverPositionID clears the value of the "approver_position_id" field.
+func (u *OvertimeRequestUpsertOne) ClearApproverPositionID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearApproverPositionID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *OvertimeRequestUpsertOne) SetReviewerID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *OvertimeRequestUpsertOne) AddReviewerID(v int) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateReviewerID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *OvertimeRequestUpsertOne) ClearReviewerID() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *OvertimeRequestUpsertOne) SetReviewComment(v string) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateReviewComment() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *OvertimeRequestUpsertOne) ClearReviewComment() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *OvertimeRequestUpsertOne) SetReviewedAt(v time.Time) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateReviewedAt() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *OvertimeRequestUpsertOne) ClearReviewedAt() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *OvertimeRequestUpsertOne) SetCreatedAt(v time.Time) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateCreatedAt() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OvertimeRequestUpsertOne) SetUpdatedAt(v time.Time) *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertOne) UpdateUpdatedAt() *OvertimeRequestUpsertOne {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OvertimeRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OvertimeRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OvertimeRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OvertimeRequestUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OvertimeRequestUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OvertimeRequestCreateBulk is the builder for creating many OvertimeRequest entities in bulk.
+type OvertimeRequestCreateBulk struct {
+	config
+	err      error
+	builders []*OvertimeRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OvertimeRequest entities in the database.
+func (orcb *OvertimeRequestCreateBulk) Save(ctx context.Context) ([]*OvertimeRequest, error) {
+	if orcb.err != nil {
+		return nil, orcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(orcb.builders))
+	nodes := make([]*OvertimeRequest, len(orcb.builders))
+	mutators := make([]Mutator, len(orcb.builders))
+	for i := range orcb.builders {
+		func(i int, root context.Context) {
+			builder := orcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OvertimeRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, orcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = orcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, orcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, orcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (orcb *OvertimeRequestCreateBulk) SaveX(ctx context.Context) []*OvertimeRequest {
+	v, err := orcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (orcb *OvertimeRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := orcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (orcb *OvertimeRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := orcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OvertimeRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OvertimeRequestUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (orcb *OvertimeRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *OvertimeRequestUpsertBulk {
+	orcb.conflict = opts
+	return &OvertimeRequestUpsertBulk{
+		create: orcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OvertimeRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (orcb *OvertimeRequestCreateBulk) OnConflictColumns(columns ...string) *OvertimeRequestUpsertBulk {
+	orcb.conflict = append(orcb.conflict, sql.ConflictColumns(columns...))
+	return &OvertimeRequestUpsertBulk{
+		create: orcb,
+	}
+}
+
+// OvertimeRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of OvertimeRequest nodes.
+type OvertimeRequestUpsertBulk struct {
+	create *OvertimeRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OvertimeRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *OvertimeRequestUpsertBulk) UpdateNewValues() *OvertimeRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OvertimeRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OvertimeRequestUpsertBulk) Ignore() *OvertimeRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OvertimeRequestUpsertBulk) DoNothing() *OvertimeRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OvertimeRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *OvertimeRequestUpsertBulk) Update(set func(*OvertimeRequestUpsert)) *OvertimeRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OvertimeRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *OvertimeRequestUpsertBulk) SetOrgID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *OvertimeRequestUpsertBulk) AddOrgID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateOrgID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *OvertimeRequestUpsertBulk) SetEmployeeID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateEmployeeID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *OvertimeRequestUpsertBulk) SetWorkDate(v time.Time) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetWorkDate(v)
+	})
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateWorkDate() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateWorkDate()
+	})
+}
+
+// SetHours sets the "hours" field.
+func (u *OvertimeRequestUpsertBulk) SetHours(v float64) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetHours(v)
+	})
+}
+
+// AddHours adds v to the "hours" field.
+func (u *OvertimeRequestUpsertBulk) AddHours(v float64) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.AddHours(v)
+	})
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateHours() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateHours()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *OvertimeRequestUpsertBulk) SetReason(v string) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateReason() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetDayType sets the "day_type" field.
+func (u *OvertimeRequestUpsertBulk) SetDayType(v overtimerequest.DayType) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetDayType(v)
+	})
+}
+
+// UpdateDayType sets the "day_type" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateDayType() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateDayType()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *OvertimeRequestUpsertBulk) SetProjectID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateProjectID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *OvertimeRequestUpsertBulk) ClearProjectID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *OvertimeRequestUpsertBulk) SetTaskID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateTaskID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *OvertimeRequestUpsertBulk) ClearTaskID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OvertimeRequestUpsertBulk) SetStatus(v overtimerequest.Status) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateStatus() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetApproverPositionID sets the "approver_position_id" field.
+func (u *OvertimeRequestUpsertBulk) SetApproverPositionID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetApproverPositionID(v)
+	})
+}
+
+// AddApproverPositionID adds v to the "approver_position_id" field.
+func (u *OvertimeRequestUpsertBulk) AddApproverPositionID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.AddApproverPositionID(v)
+	})
+}
+
+// UpdateApproverPositionID sets the "approver_position_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateApproverPositionID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateApproverPositionID()
+	})
+}
+
+// ClearApproverPositionID clears the value of the "approver_position_id" field.
+func (u *OvertimeRequestUpsertBulk) ClearApproverPositionID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearApproverPositionID()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *OvertimeRequestUpsertBulk) SetReviewerID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *OvertimeRequestUpsertBulk) AddReviewerID(v int) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateReviewerID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *OvertimeRequestUpsertBulk) ClearReviewerID() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *OvertimeRequestUpsertBulk) SetReviewComment(v string) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateReviewComment() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *OvertimeRequestUpsertBulk) ClearReviewComment() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *OvertimeRequestUpsertBulk) SetReviewedAt(v time.Time) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateReviewedAt() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *OvertimeRequestUpsertBulk) ClearReviewedAt() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *OvertimeRequestUpsertBulk) SetCreatedAt(v time.Time) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateCreatedAt() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OvertimeRequestUpsertBulk) SetUpdatedAt(v time.Time) *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OvertimeRequestUpsertBulk) UpdateUpdatedAt() *OvertimeRequestUpsertBulk {
+	return u.Update(func(s *OvertimeRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OvertimeRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OvertimeRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OvertimeRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OvertimeRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// OvertimeRequestDelete is the builder for deleting a OvertimeRequest entity.
+type OvertimeRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *OvertimeRequestMutation
+}
+
+// Where appends a list predicates to the OvertimeRequestDelete builder.
+func (ord *OvertimeRequestDelete) Where(ps ...predicate.OvertimeRequest) *OvertimeRequestDelete {
+	ord.mutation.Where(ps...)
+	return ord
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ord *OvertimeRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ord.sqlExec, ord.mutation, ord.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ord *OvertimeRequestDelete) ExecX(ctx context.Context) int {
+	n, err := ord.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ord *OvertimeRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(overtimerequest.Table, sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt))
+	if ps := ord.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ord.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ord.mutation.done = true
+	return affected, err
+}
+
+// OvertimeRequestDeleteOne is the builder for deleting a single OvertimeRequest entity.
+type OvertimeRequestDeleteOne struct {
+	ord *OvertimeRequestDelete
+}
+
+// Where appends a list predicates to the OvertimeRequestDelete builder.
+func (ordo *OvertimeRequestDeleteOne) Where(ps ...predicate.OvertimeRequest) *OvertimeRequestDeleteOne {
+	ordo.ord.mutation.Where(ps...)
+	return ordo
+}
+
+// Exec executes the deletion query.
+func (ordo *OvertimeRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := ordo.ord.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{overtimerequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ordo *OvertimeRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := ordo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
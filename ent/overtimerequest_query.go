@@ -0,0 +1,762 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// OvertimeRequestQuery is the builder for querying OvertimeRequest entities.
+type OvertimeRequestQuery struct {
+	config
+	ctx          *QueryContext
+	order        []overtimerequest.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.OvertimeRequest
+	withEmployee *EmployeeQuery
+	withProject  *ProjectQuery
+	withTask     *TaskQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OvertimeRequestQuery builder.
+func (orq *OvertimeRequestQuery) Where(ps ...predicate.OvertimeRequest) *OvertimeRequestQuery {
+	orq.predicates = append(orq.predicates, ps...)
+	return orq
+}
+
+// Limit the number of records to be returned by this query.
+func (orq *OvertimeRequestQuery) Limit(limit int) *OvertimeRequestQuery {
+	orq.ctx.Limit = &limit
+	return orq
+}
+
+// Offset to start from.
+func (orq *OvertimeRequestQuery) Offset(offset int) *OvertimeRequestQuery {
+	orq.ctx.Offset = &offset
+	return orq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (orq *OvertimeRequestQuery) Unique(unique bool) *OvertimeRequestQuery {
+	orq.ctx.Unique = &unique
+	return orq
+}
+
+// Order specifies how the records should be ordered.
+func (orq *OvertimeRequestQuery) Order(o ...overtimerequest.OrderOption) *OvertimeRequestQuery {
+	orq.order = append(orq.order, o...)
+	return orq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (orq *OvertimeRequestQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: orq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := orq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := orq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimerequest.Table, overtimerequest.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtimerequest.EmployeeTable, overtimerequest.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(orq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (orq *OvertimeRequestQuery) QueryProject() *ProjectQuery {
+	query := (&ProjectClient{config: orq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := orq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := orq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimerequest.Table, overtimerequest.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtimerequest.ProjectTable, overtimerequest.ProjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(orq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (orq *OvertimeRequestQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: orq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := orq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := orq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(overtimerequest.Table, overtimerequest.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, overtimerequest.TaskTable, overtimerequest.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(orq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OvertimeRequest entity from the query.
+// Returns a *NotFoundError when no OvertimeRequest was found.
+func (orq *OvertimeRequestQuery) First(ctx context.Context) (*OvertimeRequest, error) {
+	nodes, err := orq.Limit(1).All(setContextOp(ctx, orq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{overtimerequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (orq *OvertimeRequestQuery) FirstX(ctx context.Context) *OvertimeRequest {
+	node, err := orq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OvertimeRequest ID from the query.
+// Returns a *NotFoundError when no OvertimeRequest ID was found.
+func (orq *OvertimeRequestQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = orq.Limit(1).IDs(setContextOp(ctx, orq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{overtimerequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (orq *OvertimeRequestQuery) FirstIDX(ctx context.Context) int {
+	id, err := orq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OvertimeRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OvertimeRequest entity is found.
+// Returns a *NotFoundError when no OvertimeRequest entities are found.
+func (orq *OvertimeRequestQuery) Only(ctx context.Context) (*OvertimeRequest, error) {
+	nodes, err := orq.Limit(2).All(setContextOp(ctx, orq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{overtimerequest.Label}
+	default:
+		return nil, &NotSingularError{overtimerequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (orq *OvertimeRequestQuery) OnlyX(ctx context.Context) *OvertimeRequest {
+	node, err := orq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OvertimeRequest ID in the query.
+// Returns a *NotSingularError when more than one OvertimeRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (orq *OvertimeRequestQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = orq.Limit(2).IDs(setContextOp(ctx, orq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{overtimerequest.Label}
+	default:
+		err = &NotSingularError{overtimerequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (orq *OvertimeRequestQuery) OnlyIDX(ctx context.Context) int {
+	id, err := orq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OvertimeRequests.
+func (orq *OvertimeRequestQuery) All(ctx context.Context) ([]*OvertimeRequest, error) {
+	ctx = setContextOp(ctx, orq.ctx, ent.OpQueryAll)
+	if err := orq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OvertimeRequest, *OvertimeRequestQuery]()
+	return withInterceptors[[]*OvertimeRequest](ctx, orq, qr, orq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (orq *OvertimeRequestQuery) AllX(ctx context.Context) []*OvertimeRequest {
+	nodes, err := orq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OvertimeRequest IDs.
+func (orq *OvertimeRequestQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if orq.ctx.Unique == nil && orq.path != nil {
+		orq.Unique(true)
+	}
+	ctx = setContextOp(ctx, orq.ctx, ent.OpQueryIDs)
+	if err = orq.Select(overtimerequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (orq *OvertimeRequestQuery) IDsX(ctx context.Context) []int {
+	ids, err := orq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (orq *OvertimeRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, orq.ctx, ent.OpQueryCount)
+	if err := orq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, orq, querierCount[*OvertimeRequestQuery](), orq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (orq *OvertimeRequestQuery) CountX(ctx context.Context) int {
+	count, err := orq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (orq *OvertimeRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, orq.ctx, ent.OpQueryExist)
+	switch _, err := orq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (orq *OvertimeRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := orq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OvertimeRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (orq *OvertimeRequestQuery) Clone() *OvertimeRequestQuery {
+	if orq == nil {
+		return nil
+	}
+	return &OvertimeRequestQuery{
+		config:       orq.config,
+		ctx:          orq.ctx.Clone(),
+		order:        append([]overtimerequest.OrderOption{}, orq.order...),
+		inters:       append([]Interceptor{}, orq.inters...),
+		predicates:   append([]predicate.OvertimeRequest{}, orq.predicates...),
+		withEmployee: orq.withEmployee.Clone(),
+		withProject:  orq.withProject.Clone(),
+		withTask:     orq.withTask.Clone(),
+		// clone intermediate query.
+		sql:  orq.sql.Clone(),
+		path: orq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (orq *OvertimeRequestQuery) WithEmployee(opts ...func(*EmployeeQuery)) *OvertimeRequestQuery {
+	query := (&EmployeeClient{config: orq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	orq.withEmployee = query
+	return orq
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (orq *OvertimeRequestQuery) WithProject(opts ...func(*ProjectQuery)) *OvertimeRequestQuery {
+	query := (&ProjectClient{config: orq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	orq.withProject = query
+	return orq
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (orq *OvertimeRequestQuery) WithTask(opts ...func(*TaskQuery)) *OvertimeRequestQuery {
+	query := (&TaskClient{config: orq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	orq.withTask = query
+	return orq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int `json:"org_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OvertimeRequest.Query().
+//		GroupBy(overtimerequest.FieldOrgID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (orq *OvertimeRequestQuery) GroupBy(field string, fields ...string) *OvertimeRequestGroupBy {
+	orq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OvertimeRequestGroupBy{build: orq}
+	grbuild.flds = &orq.ctx.Fields
+	grbuild.label = overtimerequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int `json:"org_id"`
+//	}
+//
+//	client.OvertimeRequest.Query().
+//		Select(overtimerequest.FieldOrgID).
+//		Scan(ctx, &v)
+func (orq *OvertimeRequestQuery) Select(fields ...string) *OvertimeRequestSelect {
+	orq.ctx.Fields = append(orq.ctx.Fields, fields...)
+	sbuild := &OvertimeRequestSelect{OvertimeRequestQuery: orq}
+	sbuild.label = overtimerequest.Label
+	sbuild.flds, sbuild.scan = &orq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OvertimeRequestSelect configured with the given aggregations.
+func (orq *OvertimeRequestQuery) Aggregate(fns ...AggregateFunc) *OvertimeRequestSelect {
+	return orq.Select().Aggregate(fns...)
+}
+
+func (orq *OvertimeRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range orq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, orq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range orq.ctx.Fields {
+		if !overtimerequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if orq.path != nil {
+		prev, err := orq.path(ctx)
+		if err != nil {
+			return err
+		}
+		orq.sql = prev
+	}
+	return nil
+}
+
+func (orq *OvertimeRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OvertimeRequest, error) {
+	var (
+		nodes       = []*OvertimeRequest{}
+		_spec       = orq.querySpec()
+		loadedTypes = [3]bool{
+			orq.withEmployee != nil,
+			orq.withProject != nil,
+			orq.withTask != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OvertimeRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OvertimeRequest{config: orq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, orq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := orq.withEmployee; query != nil {
+		if err := orq.loadEmployee(ctx, query, nodes, nil,
+			func(n *OvertimeRequest, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := orq.withProject; query != nil {
+		if err := orq.loadProject(ctx, query, nodes, nil,
+			func(n *OvertimeRequest, e *Project) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := orq.withTask; query != nil {
+		if err := orq.loadTask(ctx, query, nodes, nil,
+			func(n *OvertimeRequest, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (orq *OvertimeRequestQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*OvertimeRequest, init func(*OvertimeRequest), assign func(*OvertimeRequest, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OvertimeRequest)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (orq *OvertimeRequestQuery) loadProject(ctx context.Context, query *ProjectQuery, nodes []*OvertimeRequest, init func(*OvertimeRequest), assign func(*OvertimeRequest, *Project)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OvertimeRequest)
+	for i := range nodes {
+		if nodes[i].ProjectID == nil {
+			continue
+		}
+		fk := *nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(project.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (orq *OvertimeRequestQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*OvertimeRequest, init func(*OvertimeRequest), assign func(*OvertimeRequest, *Task)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OvertimeRequest)
+	for i := range nodes {
+		if nodes[i].TaskID == nil {
+			continue
+		}
+		fk := *nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (orq *OvertimeRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := orq.querySpec()
+	_spec.Node.Columns = orq.ctx.Fields
+	if len(orq.ctx.Fields) > 0 {
+		_spec.Unique = orq.ctx.Unique != nil && *orq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, orq.driver, _spec)
+}
+
+func (orq *OvertimeRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(overtimerequest.Table, overtimerequest.Columns, sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt))
+	_spec.From = orq.sql
+	if unique := orq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if orq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := orq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, overtimerequest.FieldID)
+		for i := range fields {
+			if fields[i] != overtimerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if orq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(overtimerequest.FieldEmployeeID)
+		}
+		if orq.withProject != nil {
+			_spec.Node.AddColumnOnce(overtimerequest.FieldProjectID)
+		}
+		if orq.withTask != nil {
+			_spec.Node.AddColumnOnce(overtimerequest.FieldTaskID)
+		}
+	}
+	if ps := orq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := orq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := orq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := orq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (orq *OvertimeRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(orq.driver.Dialect())
+	t1 := builder.Table(overtimerequest.Table)
+	columns := orq.ctx.Fields
+	if len(columns) == 0 {
+		columns = overtimerequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if orq.sql != nil {
+		selector = orq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if orq.ctx.Unique != nil && *orq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range orq.predicates {
+		p(selector)
+	}
+	for _, p := range orq.order {
+		p(selector)
+	}
+	if offset := orq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := orq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OvertimeRequestGroupBy is the group-by builder for OvertimeRequest entities.
+type OvertimeRequestGroupBy struct {
+	selector
+	build *OvertimeRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (orgb *OvertimeRequestGroupBy) Aggregate(fns ...AggregateFunc) *OvertimeRequestGroupBy {
+	orgb.fns = append(orgb.fns, fns...)
+	return orgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (orgb *OvertimeRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, orgb.build.ctx, ent.OpQueryGroupBy)
+	if err := orgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OvertimeRequestQuery, *OvertimeRequestGroupBy](ctx, orgb.build, orgb, orgb.build.inters, v)
+}
+
+func (orgb *OvertimeRequestGroupBy) sqlScan(ctx context.Context, root *OvertimeRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(orgb.fns))
+	for _, fn := range orgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*orgb.flds)+len(orgb.fns))
+		for _, f := range *orgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*orgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := orgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OvertimeRequestSelect is the builder for selecting fields of OvertimeRequest entities.
+type OvertimeRequestSelect struct {
+	*OvertimeRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ors *OvertimeRequestSelect) Aggregate(fns ...AggregateFunc) *OvertimeRequestSelect {
+	ors.fns = append(ors.fns, fns...)
+	return ors
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ors *OvertimeRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ors.ctx, ent.OpQuerySelect)
+	if err := ors.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OvertimeRequestQuery, *OvertimeRequestSelect](ctx, ors.OvertimeRequestQuery, ors, ors.inters, v)
+}
+
+func (ors *OvertimeRequestSelect) sqlScan(ctx context.Context, root *OvertimeRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ors.fns))
+	for _, fn := range ors.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ors.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ors.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
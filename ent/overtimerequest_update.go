@@ -0,0 +1,1141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// OvertimeRequestUpdate is the builder for updating OvertimeRequest entities.
+type OvertimeRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OvertimeRequestMutation
+}
+
+// Where appends a list predicates to the OvertimeRequestUpdate builder.
+func (oru *OvertimeRequestUpdate) Where(ps ...predicate.OvertimeRequest) *OvertimeRequestUpdate {
+	oru.mutation.Where(ps...)
+	return oru
+}
+
+// SetOrgID sets the "org_id" field.
+func (oru *OvertimeRequestUpdate) SetOrgID(i int) *OvertimeRequestUpdate {
+	oru.mutation.ResetOrgID()
+	oru.mutation.SetOrgID(i)
+	return oru
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableOrgID(i *int) *OvertimeRequestUpdate {
+	if i != nil {
+		oru.SetOrgID(*i)
+	}
+	return oru
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (oru *OvertimeRequestUpdate) AddOrgID(i int) *OvertimeRequestUpdate {
+	oru.mutation.AddOrgID(i)
+	return oru
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (oru *OvertimeRequestUpdate) SetEmployeeID(i int) *OvertimeRequestUpdate {
+	oru.mutation.SetEmployeeID(i)
+	return oru
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableEmployeeID(i *int) *OvertimeRequestUpdate {
+	if i != nil {
+		oru.SetEmployeeID(*i)
+	}
+	return oru
+}
+
+// SetWorkDate sets the "work_date" field.
+func (oru *OvertimeRequestUpdate) SetWorkDate(t time.Time) *OvertimeRequestUpdate {
+	oru.mutation.SetWorkDate(t)
+	return oru
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableWorkDate(t *time.Time) *OvertimeRequestUpdate {
+	if t != nil {
+		oru.SetWorkDate(*t)
+	}
+	return oru
+}
+
+// SetHours sets the "hours" field.
+func (oru *OvertimeRequestUpdate) SetHours(f float64) *OvertimeRequestUpdate {
+	oru.mutation.ResetHours()
+	oru.mutation.SetHours(f)
+	return oru
+}
+
+// SetNillableHours sets the "hours" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableHours(f *float64) *OvertimeRequestUpdate {
+	if f != nil {
+		oru.SetHours(*f)
+	}
+	return oru
+}
+
+// AddHours adds f to the "hours" field.
+func (oru *OvertimeRequestUpdate) AddHours(f float64) *OvertimeRequestUpdate {
+	oru.mutation.AddHours(f)
+	return oru
+}
+
+// SetReason sets the "reason" field.
+func (oru *OvertimeRequestUpdate) SetReason(s string) *OvertimeRequestUpdate {
+	oru.mutation.SetReason(s)
+	return oru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableReason(s *string) *OvertimeRequestUpdate {
+	if s != nil {
+		oru.SetReason(*s)
+	}
+	return oru
+}
+
+// SetDayType sets the "day_type" field.
+func (oru *OvertimeRequestUpdate) SetDayType(ot overtimerequest.DayType) *OvertimeRequestUpdate {
+	oru.mutation.SetDayType(ot)
+	return oru
+}
+
+// SetNillableDayType sets the "day_type" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableDayType(ot *overtimerequest.DayType) *OvertimeRequestUpdate {
+	if ot != nil {
+		oru.SetDayType(*ot)
+	}
+	return oru
+}
+
+// SetProjectID sets the "project_id" field.
+func (oru *OvertimeRequestUpdate) SetProjectID(i int) *OvertimeRequestUpdate {
+	oru.mutation.SetProjectID(i)
+	return oru
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableProjectID(i *int) *OvertimeRequestUpdate {
+	if i != nil {
+		oru.SetProjectID(*i)
+	}
+	return oru
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (oru *OvertimeRequestUpdate) ClearProjectID() *OvertimeRequestUpdate {
+	oru.mutation.ClearProjectID()
+	return oru
+}
+
+// SetTaskID sets the "task_id" field.
+func (oru *OvertimeRequestUpdate) SetTaskID(i int) *OvertimeRequestUpdate {
+	oru.mutation.SetTaskID(i)
+	return oru
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableTaskID(i *int) *OvertimeRequestUpdate {
+	if i != nil {
+		oru.SetTaskID(*i)
+	}
+	return oru
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (oru *OvertimeRequestUpdate) ClearTaskID() *OvertimeRequestUpdate {
+	oru.mutation.ClearTaskID()
+	return oru
+}
+
+// SetStatus sets the "status" field.
+func (oru *OvertimeRequestUpdate) SetStatus(o overtimerequest.Status) *OvertimeRequestUpdate {
+	oru.mutation.SetStatus(o)
+	return oru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableStatus(o *overtimerequest.Status) *OvertimeRequestUpdate {
+	if o != nil {
+		oru.SetStatus(*o)
+	}
+	return oru
+}
+
+// SetApproverPositionID sets the "approver_position_id" field.
+func (oru *OvertimeRequestUpdate) SetApproverPositionID(i int) *OvertimeRequestUpdate {
+	oru.mutation.ResetApproverPositionID()
+	oru.mutation.SetApproverPositionID(i)
+	return oru
+}
+
+// SetNillableApproverPositionID sets the "approver_position_id" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableApproverPositionID(i *int) *OvertimeRequestUpdate {
+	if i != nil {
+		oru.SetApproverPositionID(*i)
+	}
+	return oru
+}
+
+// AddApproverPositionID adds i to the "approver_position_id" field.
+func (oru *OvertimeRequestUpdate) AddApproverPositionID(i int) *OvertimeRequestUpdate {
+	oru.mutation.AddApproverPositionID(i)
+	return oru
+}
+
+// ClearApproverPositionID clears the value of the "approver_position_id" field.
+func (oru *OvertimeRequestUpdate) ClearApproverPositionID() *OvertimeRequestUpdate {
+	oru.mutation.ClearApproverPositionID()
+	return oru
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (oru *OvertimeRequestUpdate) SetReviewerID(i int) *OvertimeRequestUpdate {
+	oru.mutation.ResetReviewerID()
+	oru.mutation.SetReviewerID(i)
+	return oru
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableReviewerID(i *int) *OvertimeRequestUpdate {
+	if i != nil {
+		oru.SetReviewerID(*i)
+	}
+	return oru
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (oru *OvertimeRequestUpdate) AddReviewerID(i int) *OvertimeRequestUpdate {
+	oru.mutation.AddReviewerID(i)
+	return oru
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (oru *OvertimeRequestUpdate) ClearReviewerID() *OvertimeRequestUpdate {
+	oru.mutation.ClearReviewerID()
+	return oru
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (oru *OvertimeRequestUpdate) SetReviewComment(s string) *OvertimeRequestUpdate {
+	oru.mutation.SetReviewComment(s)
+	return oru
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableReviewComment(s *string) *OvertimeRequestUpdate {
+	if s != nil {
+		oru.SetReviewComment(*s)
+	}
+	return oru
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (oru *OvertimeRequestUpdate) ClearReviewComment() *OvertimeRequestUpdate {
+	oru.mutation.ClearReviewComment()
+	return oru
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (oru *OvertimeRequestUpdate) SetReviewedAt(t time.Time) *OvertimeRequestUpdate {
+	oru.mutation.SetReviewedAt(t)
+	return oru
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableReviewedAt(t *time.Time) *OvertimeRequestUpdate {
+	if t != nil {
+		oru.SetReviewedAt(*t)
+	}
+	return oru
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (oru *OvertimeRequestUpdate) ClearReviewedAt() *OvertimeRequestUpdate {
+	oru.mutation.ClearReviewedAt()
+	return oru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oru *OvertimeRequestUpdate) SetCreatedAt(t time.Time) *OvertimeRequestUpdate {
+	oru.mutation.SetCreatedAt(t)
+	return oru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oru *OvertimeRequestUpdate) SetNillableCreatedAt(t *time.Time) *OvertimeRequestUpdate {
+	if t != nil {
+		oru.SetCreatedAt(*t)
+	}
+	return oru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oru *OvertimeRequestUpdate) SetUpdatedAt(t time.Time) *OvertimeRequestUpdate {
+	oru.mutation.SetUpdatedAt(t)
+	return oru
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (oru *OvertimeRequestUpdate) SetEmployee(e *Employee) *OvertimeRequestUpdate {
+	return oru.SetEmployeeID(e.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (oru *OvertimeRequestUpdate) SetProject(p *Project) *OvertimeRequestUpdate {
+	return oru.SetProjectID(p.ID)
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (oru *OvertimeRequestUpdate) SetTask(t *Task) *OvertimeRequestUpdate {
+	return oru.SetTaskID(t.ID)
+}
+
+// Mutation returns the OvertimeRequestMutation object of the builder.
+func (oru *OvertimeRequestUpdate) Mutation() *OvertimeRequestMutation {
+	return oru.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (oru *OvertimeRequestUpdate) ClearEmployee() *OvertimeRequestUpdate {
+	oru.mutation.ClearEmployee()
+	return oru
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (oru *OvertimeRequestUpdate) ClearProject() *OvertimeRequestUpdate {
+	oru.mutation.ClearProject()
+	return oru
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (oru *OvertimeRequestUpdate) ClearTask() *OvertimeRequestUpdate {
+	oru.mutation.ClearTask()
+	return oru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oru *OvertimeRequestUpdate) Save(ctx context.Context) (int, error) {
+	oru.defaults()
+	return withHooks(ctx, oru.sqlSave, oru.mutation, oru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oru *OvertimeRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := oru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oru *OvertimeRequestUpdate) Exec(ctx context.Context) error {
+	_, err := oru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oru *OvertimeRequestUpdate) ExecX(ctx context.Context) {
+	if err := oru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oru *OvertimeRequestUpdate) defaults() {
+	if _, ok := oru.mutation.UpdatedAt(); !ok {
+		v := overtimerequest.UpdateDefaultUpdatedAt()
+		oru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oru *OvertimeRequestUpdate) check() error {
+	if v, ok := oru.mutation.Hours(); ok {
+		if err := overtimerequest.HoursValidator(v); err != nil {
+			return &ValidationError{Name: "hours", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.hours": %w`, err)}
+		}
+	}
+	if v, ok := oru.mutation.DayType(); ok {
+		if err := overtimerequest.DayTypeValidator(v); err != nil {
+			return &ValidationError{Name: "day_type", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.day_type": %w`, err)}
+		}
+	}
+	if v, ok := oru.mutation.Status(); ok {
+		if err := overtimerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.status": %w`, err)}
+		}
+	}
+	if oru.mutation.EmployeeCleared() && len(oru.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OvertimeRequest.employee"`)
+	}
+	return nil
+}
+
+func (oru *OvertimeRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(overtimerequest.Table, overtimerequest.Columns, sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt))
+	if ps := oru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oru.mutation.OrgID(); ok {
+		_spec.SetField(overtimerequest.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := oru.mutation.AddedOrgID(); ok {
+		_spec.AddField(overtimerequest.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := oru.mutation.WorkDate(); ok {
+		_spec.SetField(overtimerequest.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := oru.mutation.Hours(); ok {
+		_spec.SetField(overtimerequest.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := oru.mutation.AddedHours(); ok {
+		_spec.AddField(overtimerequest.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := oru.mutation.Reason(); ok {
+		_spec.SetField(overtimerequest.FieldReason, field.TypeString, value)
+	}
+	if value, ok := oru.mutation.DayType(); ok {
+		_spec.SetField(overtimerequest.FieldDayType, field.TypeEnum, value)
+	}
+	if value, ok := oru.mutation.Status(); ok {
+		_spec.SetField(overtimerequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := oru.mutation.ApproverPositionID(); ok {
+		_spec.SetField(overtimerequest.FieldApproverPositionID, field.TypeInt, value)
+	}
+	if value, ok := oru.mutation.AddedApproverPositionID(); ok {
+		_spec.AddField(overtimerequest.FieldApproverPositionID, field.TypeInt, value)
+	}
+	if oru.mutation.ApproverPositionIDCleared() {
+		_spec.ClearField(overtimerequest.FieldApproverPositionID, field.TypeInt)
+	}
+	if value, ok := oru.mutation.ReviewerID(); ok {
+		_spec.SetField(overtimerequest.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := oru.mutation.AddedReviewerID(); ok {
+		_spec.AddField(overtimerequest.FieldReviewerID, field.TypeInt, value)
+	}
+	if oru.mutation.ReviewerIDCleared() {
+		_spec.ClearField(overtimerequest.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := oru.mutation.ReviewComment(); ok {
+		_spec.SetField(overtimerequest.FieldReviewComment, field.TypeString, value)
+	}
+	if oru.mutation.ReviewCommentCleared() {
+		_spec.ClearField(overtimerequest.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := oru.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtimerequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if oru.mutation.ReviewedAtCleared() {
+		_spec.ClearField(overtimerequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := oru.mutation.CreatedAt(); ok {
+		_spec.SetField(overtimerequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := oru.mutation.UpdatedAt(); ok {
+		_spec.SetField(overtimerequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oru.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.EmployeeTable,
+			Columns: []string{overtimerequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oru.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.EmployeeTable,
+			Columns: []string{overtimerequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oru.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.ProjectTable,
+			Columns: []string{overtimerequest.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oru.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.ProjectTable,
+			Columns: []string{overtimerequest.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oru.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.TaskTable,
+			Columns: []string{overtimerequest.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oru.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.TaskTable,
+			Columns: []string{overtimerequest.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{overtimerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oru.mutation.done = true
+	return n, nil
+}
+
+// OvertimeRequestUpdateOne is the builder for updating a single OvertimeRequest entity.
+type OvertimeRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OvertimeRequestMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (oruo *OvertimeRequestUpdateOne) SetOrgID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.ResetOrgID()
+	oruo.mutation.SetOrgID(i)
+	return oruo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableOrgID(i *int) *OvertimeRequestUpdateOne {
+	if i != nil {
+		oruo.SetOrgID(*i)
+	}
+	return oruo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (oruo *OvertimeRequestUpdateOne) AddOrgID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.AddOrgID(i)
+	return oruo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (oruo *OvertimeRequestUpdateOne) SetEmployeeID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetEmployeeID(i)
+	return oruo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableEmployeeID(i *int) *OvertimeRequestUpdateOne {
+	if i != nil {
+		oruo.SetEmployeeID(*i)
+	}
+	return oruo
+}
+
+// SetWorkDate sets the "work_date" field.
+func (oruo *OvertimeRequestUpdateOne) SetWorkDate(t time.Time) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetWorkDate(t)
+	return oruo
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableWorkDate(t *time.Time) *OvertimeRequestUpdateOne {
+	if t != nil {
+		oruo.SetWorkDate(*t)
+	}
+	return oruo
+}
+
+// SetHours sets the "hours" field.
+func (oruo *OvertimeRequestUpdateOne) SetHours(f float64) *OvertimeRequestUpdateOne {
+	oruo.mutation.ResetHours()
+	oruo.mutation.SetHours(f)
+	return oruo
+}
+
+// SetNillableHours sets the "hours" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableHours(f *float64) *OvertimeRequestUpdateOne {
+	if f != nil {
+		oruo.SetHours(*f)
+	}
+	return oruo
+}
+
+// AddHours adds f to the "hours" field.
+func (oruo *OvertimeRequestUpdateOne) AddHours(f float64) *OvertimeRequestUpdateOne {
+	oruo.mutation.AddHours(f)
+	return oruo
+}
+
+// SetReason sets the "reason" field.
+func (oruo *OvertimeRequestUpdateOne) SetReason(s string) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetReason(s)
+	return oruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableReason(s *string) *OvertimeRequestUpdateOne {
+	if s != nil {
+		oruo.SetReason(*s)
+	}
+	return oruo
+}
+
+// SetDayType sets the "day_type" field.
+func (oruo *OvertimeRequestUpdateOne) SetDayType(ot overtimerequest.DayType) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetDayType(ot)
+	return oruo
+}
+
+// SetNillableDayType sets the "day_type" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableDayType(ot *overtimerequest.DayType) *OvertimeRequestUpdateOne {
+	if ot != nil {
+		oruo.SetDayType(*ot)
+	}
+	return oruo
+}
+
+// SetProjectID sets the "project_id" field.
+func (oruo *OvertimeRequestUpdateOne) SetProjectID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetProjectID(i)
+	return oruo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableProjectID(i *int) *OvertimeRequestUpdateOne {
+	if i != nil {
+		oruo.SetProjectID(*i)
+	}
+	return oruo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (oruo *OvertimeRequestUpdateOne) ClearProjectID() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearProjectID()
+	return oruo
+}
+
+// SetTaskID sets the "task_id" field.
+func (oruo *OvertimeRequestUpdateOne) SetTaskID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetTaskID(i)
+	return oruo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableTaskID(i *int) *OvertimeRequestUpdateOne {
+	if i != nil {
+		oruo.SetTaskID(*i)
+	}
+	return oruo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (oruo *OvertimeRequestUpdateOne) ClearTaskID() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearTaskID()
+	return oruo
+}
+
+// SetStatus sets the "status" field.
+func (oruo *OvertimeRequestUpdateOne) SetStatus(o overtimerequest.Status) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetStatus(o)
+	return oruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableStatus(o *overtimerequest.Status) *OvertimeRequestUpdateOne {
+	if o != nil {
+		oruo.SetStatus(*o)
+	}
+	return oruo
+}
+
+// SetApproverPositionID sets the "approver_position_id" field.
+func (oruo *OvertimeRequestUpdateOne) SetApproverPositionID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.ResetApproverPositionID()
+	oruo.mutation.SetApproverPositionID(i)
+	return oruo
+}
+
+// SetNillableApproverPositionID sets the "approver_position_id" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableApproverPositionID(i *int) *OvertimeRequestUpdateOne {
+	if i != nil {
+		oruo.SetApproverPositionID(*i)
+	}
+	return oruo
+}
+
+// AddApproverPositionID adds i to the "approver_position_id" field.
+func (oruo *OvertimeRequestUpdateOne) AddApproverPositionID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.AddApproverPositionID(i)
+	return oruo
+}
+
+// ClearApproverPositionID clears the value of the "approver_position_id" field.
+func (oruo *OvertimeRequestUpdateOne) ClearApproverPositionID() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearApproverPositionID()
+	return oruo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (oruo *OvertimeRequestUpdateOne) SetReviewerID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.ResetReviewerID()
+	oruo.mutation.SetReviewerID(i)
+	return oruo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableReviewerID(i *int) *OvertimeRequestUpdateOne {
+	if i != nil {
+		oruo.SetReviewerID(*i)
+	}
+	return oruo
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (oruo *OvertimeRequestUpdateOne) AddReviewerID(i int) *OvertimeRequestUpdateOne {
+	oruo.mutation.AddReviewerID(i)
+	return oruo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (oruo *OvertimeRequestUpdateOne) ClearReviewerID() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearReviewerID()
+	return oruo
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (oruo *OvertimeRequestUpdateOne) SetReviewComment(s string) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetReviewComment(s)
+	return oruo
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableReviewComment(s *string) *OvertimeRequestUpdateOne {
+	if s != nil {
+		oruo.SetReviewComment(*s)
+	}
+	return oruo
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (oruo *OvertimeRequestUpdateOne) ClearReviewComment() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearReviewComment()
+	return oruo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (oruo *OvertimeRequestUpdateOne) SetReviewedAt(t time.Time) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetReviewedAt(t)
+	return oruo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableReviewedAt(t *time.Time) *OvertimeRequestUpdateOne {
+	if t != nil {
+		oruo.SetReviewedAt(*t)
+	}
+	return oruo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (oruo *OvertimeRequestUpdateOne) ClearReviewedAt() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearReviewedAt()
+	return oruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oruo *OvertimeRequestUpdateOne) SetCreatedAt(t time.Time) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetCreatedAt(t)
+	return oruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oruo *OvertimeRequestUpdateOne) SetNillableCreatedAt(t *time.Time) *OvertimeRequestUpdateOne {
+	if t != nil {
+		oruo.SetCreatedAt(*t)
+	}
+	return oruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oruo *OvertimeRequestUpdateOne) SetUpdatedAt(t time.Time) *OvertimeRequestUpdateOne {
+	oruo.mutation.SetUpdatedAt(t)
+	return oruo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (oruo *OvertimeRequestUpdateOne) SetEmployee(e *Employee) *OvertimeRequestUpdateOne {
+	return oruo.SetEmployeeID(e.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (oruo *OvertimeRequestUpdateOne) SetProject(p *Project) *OvertimeRequestUpdateOne {
+	return oruo.SetProjectID(p.ID)
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (oruo *OvertimeRequestUpdateOne) SetTask(t *Task) *OvertimeRequestUpdateOne {
+	return oruo.SetTaskID(t.ID)
+}
+
+// Mutation returns the OvertimeRequestMutation object of the builder.
+func (oruo *OvertimeRequestUpdateOne) Mutation() *OvertimeRequestMutation {
+	return oruo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (oruo *OvertimeRequestUpdateOne) ClearEmployee() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearEmployee()
+	return oruo
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (oruo *OvertimeRequestUpdateOne) ClearProject() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearProject()
+	return oruo
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (oruo *OvertimeRequestUpdateOne) ClearTask() *OvertimeRequestUpdateOne {
+	oruo.mutation.ClearTask()
+	return oruo
+}
+
+// Where appends a list predicates to the OvertimeRequestUpdate builder.
+func (oruo *OvertimeRequestUpdateOne) Where(ps ...predicate.OvertimeRequest) *OvertimeRequestUpdateOne {
+	oruo.mutation.Where(ps...)
+	return oruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oruo *OvertimeRequestUpdateOne) Select(field string, fields ...string) *OvertimeRequestUpdateOne {
+	oruo.fields = append([]string{field}, fields...)
+	return oruo
+}
+
+// Save executes the query and returns the updated OvertimeRequest entity.
+func (oruo *OvertimeRequestUpdateOne) Save(ctx context.Context) (*OvertimeRequest, error) {
+	oruo.defaults()
+	return withHooks(ctx, oruo.sqlSave, oruo.mutation, oruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oruo *OvertimeRequestUpdateOne) SaveX(ctx context.Context) *OvertimeRequest {
+	node, err := oruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oruo *OvertimeRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := oruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oruo *OvertimeRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := oruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oruo *OvertimeRequestUpdateOne) defaults() {
+	if _, ok := oruo.mutation.UpdatedAt(); !ok {
+		v := overtimerequest.UpdateDefaultUpdatedAt()
+		oruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oruo *OvertimeRequestUpdateOne) check() error {
+	if v, ok := oruo.mutation.Hours(); ok {
+		if err := overtimerequest.HoursValidator(v); err != nil {
+			return &ValidationError{Name: "hours", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.hours": %w`, err)}
+		}
+	}
+	if v, ok := oruo.mutation.DayType(); ok {
+		if err := overtimerequest.DayTypeValidator(v); err != nil {
+			return &ValidationError{Name: "day_type", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.day_type": %w`, err)}
+		}
+	}
+	if v, ok := oruo.mutation.Status(); ok {
+		if err := overtimerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OvertimeRequest.status": %w`, err)}
+		}
+	}
+	if oruo.mutation.EmployeeCleared() && len(oruo.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OvertimeRequest.employee"`)
+	}
+	return nil
+}
+
+func (oruo *OvertimeRequestUpdateOne) sqlSave(ctx context.Context) (_node *OvertimeRequest, err error) {
+	if err := oruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(overtimerequest.Table, overtimerequest.Columns, sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt))
+	id, ok := oruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OvertimeRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, overtimerequest.FieldID)
+		for _, f := range fields {
+			if !overtimerequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != overtimerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oruo.mutation.OrgID(); ok {
+		_spec.SetField(overtimerequest.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := oruo.mutation.AddedOrgID(); ok {
+		_spec.AddField(overtimerequest.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := oruo.mutation.WorkDate(); ok {
+		_spec.SetField(overtimerequest.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := oruo.mutation.Hours(); ok {
+		_spec.SetField(overtimerequest.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := oruo.mutation.AddedHours(); ok {
+		_spec.AddField(overtimerequest.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := oruo.mutation.Reason(); ok {
+		_spec.SetField(overtimerequest.FieldReason, field.TypeString, value)
+	}
+	if value, ok := oruo.mutation.DayType(); ok {
+		_spec.SetField(overtimerequest.FieldDayType, field.TypeEnum, value)
+	}
+	if value, ok := oruo.mutation.Status(); ok {
+		_spec.SetField(overtimerequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := oruo.mutation.ApproverPositionID(); ok {
+		_spec.SetField(overtimerequest.FieldApproverPositionID, field.TypeInt, value)
+	}
+	if value, ok := oruo.mutation.AddedApproverPositionID(); ok {
+		_spec.AddField(overtimerequest.FieldApproverPositionID, field.TypeInt, value)
+	}
+	if oruo.mutation.ApproverPositionIDCleared() {
+		_spec.ClearField(overtimerequest.FieldApproverPositionID, field.TypeInt)
+	}
+	if value, ok := oruo.mutation.ReviewerID(); ok {
+		_spec.SetField(overtimerequest.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := oruo.mutation.AddedReviewerID(); ok {
+		_spec.AddField(overtimerequest.FieldReviewerID, field.TypeInt, value)
+	}
+	if oruo.mutation.ReviewerIDCleared() {
+		_spec.ClearField(overtimerequest.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := oruo.mutation.ReviewComment(); ok {
+		_spec.SetField(overtimerequest.FieldReviewComment, field.TypeString, value)
+	}
+	if oruo.mutation.ReviewCommentCleared() {
+		_spec.ClearField(overtimerequest.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := oruo.mutation.ReviewedAt(); ok {
+		_spec.SetField(overtimerequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if oruo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(overtimerequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := oruo.mutation.CreatedAt(); ok {
+		_spec.SetField(overtimerequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := oruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(overtimerequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oruo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.EmployeeTable,
+			Columns: []string{overtimerequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oruo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.EmployeeTable,
+			Columns: []string{overtimerequest.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oruo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.ProjectTable,
+			Columns: []string{overtimerequest.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oruo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.ProjectTable,
+			Columns: []string{overtimerequest.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oruo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.TaskTable,
+			Columns: []string{overtimerequest.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oruo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   overtimerequest.TaskTable,
+			Columns: []string{overtimerequest.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OvertimeRequest{config: oruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{overtimerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oruo.mutation.done = true
+	return _node, nil
+}
@@ -46,9 +46,13 @@ type PositionEdges struct {
 	Children []*Position `json:"children"`
 	// Parent holds the value of the parent edge.
 	Parent *Position `json:"parent"`
+	// AppointmentHistories holds the value of the appointment_histories edge.
+	AppointmentHistories []*AppointmentHistory `json:"appointment_histories"`
+	// SalaryGrades holds the value of the salary_grades edge.
+	SalaryGrades []*SalaryGrade `json:"salary_grades"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // EmployeesOrErr returns the Employees value or an error if the edge
@@ -91,6 +95,24 @@ func (e PositionEdges) ParentOrErr() (*Position, error) {
 	return nil, &NotLoadedError{edge: "parent"}
 }
 
+// AppointmentHistoriesOrErr returns the AppointmentHistories value or an error if the edge
+// was not loaded in eager-loading.
+func (e PositionEdges) AppointmentHistoriesOrErr() ([]*AppointmentHistory, error) {
+	if e.loadedTypes[4] {
+		return e.AppointmentHistories, nil
+	}
+	return nil, &NotLoadedError{edge: "appointment_histories"}
+}
+
+// SalaryGradesOrErr returns the SalaryGrades value or an error if the edge
+// was not loaded in eager-loading.
+func (e PositionEdges) SalaryGradesOrErr() ([]*SalaryGrade, error) {
+	if e.loadedTypes[5] {
+		return e.SalaryGrades, nil
+	}
+	return nil, &NotLoadedError{edge: "salary_grades"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Position) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -192,6 +214,16 @@ func (po *Position) QueryParent() *PositionQuery {
 	return NewPositionClient(po.config).QueryParent(po)
 }
 
+// QueryAppointmentHistories queries the "appointment_histories" edge of the Position entity.
+func (po *Position) QueryAppointmentHistories() *AppointmentHistoryQuery {
+	return NewPositionClient(po.config).QueryAppointmentHistories(po)
+}
+
+// QuerySalaryGrades queries the "salary_grades" edge of the Position entity.
+func (po *Position) QuerySalaryGrades() *SalaryGradeQuery {
+	return NewPositionClient(po.config).QuerySalaryGrades(po)
+}
+
 // Update returns a builder for updating this Position.
 // Note that you need to call Position.Unwrap() before calling this method if this Position
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -34,6 +34,10 @@ const (
 	EdgeChildren = "children"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
+	// EdgeAppointmentHistories holds the string denoting the appointment_histories edge name in mutations.
+	EdgeAppointmentHistories = "appointment_histories"
+	// EdgeSalaryGrades holds the string denoting the salary_grades edge name in mutations.
+	EdgeSalaryGrades = "salary_grades"
 	// Table holds the table name of the position in the database.
 	Table = "positions"
 	// EmployeesTable is the table that holds the employees relation/edge.
@@ -58,6 +62,20 @@ const (
 	ParentTable = "positions"
 	// ParentColumn is the table column denoting the parent relation/edge.
 	ParentColumn = "parent_id"
+	// AppointmentHistoriesTable is the table that holds the appointment_histories relation/edge.
+	AppointmentHistoriesTable = "appointment_histories"
+	// AppointmentHistoriesInverseTable is the table name for the AppointmentHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "appointmenthistory" package.
+	AppointmentHistoriesInverseTable = "appointment_histories"
+	// AppointmentHistoriesColumn is the table column denoting the appointment_histories relation/edge.
+	AppointmentHistoriesColumn = "position_id"
+	// SalaryGradesTable is the table that holds the salary_grades relation/edge.
+	SalaryGradesTable = "salary_grades"
+	// SalaryGradesInverseTable is the table name for the SalaryGrade entity.
+	// It exists in this package in order to avoid circular dependency with the "salarygrade" package.
+	SalaryGradesInverseTable = "salary_grades"
+	// SalaryGradesColumn is the table column denoting the salary_grades relation/edge.
+	SalaryGradesColumn = "position_id"
 )
 
 // Columns holds all SQL columns for position fields.
@@ -171,6 +189,34 @@ func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByAppointmentHistoriesCount orders the results by appointment_histories count.
+func ByAppointmentHistoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAppointmentHistoriesStep(), opts...)
+	}
+}
+
+// ByAppointmentHistories orders the results by appointment_histories terms.
+func ByAppointmentHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppointmentHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySalaryGradesCount orders the results by salary_grades count.
+func BySalaryGradesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSalaryGradesStep(), opts...)
+	}
+}
+
+// BySalaryGrades orders the results by salary_grades terms.
+func BySalaryGrades(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSalaryGradesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEmployeesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -199,3 +245,17 @@ func newParentStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
 	)
 }
+func newAppointmentHistoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppointmentHistoriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AppointmentHistoriesTable, AppointmentHistoriesColumn),
+	)
+}
+func newSalaryGradesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SalaryGradesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SalaryGradesTable, SalaryGradesColumn),
+	)
+}
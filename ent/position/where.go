@@ -437,6 +437,52 @@ func HasParentWith(preds ...predicate.Position) predicate.Position {
 	})
 }
 
+// HasAppointmentHistories applies the HasEdge predicate on the "appointment_histories" edge.
+func HasAppointmentHistories() predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AppointmentHistoriesTable, AppointmentHistoriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppointmentHistoriesWith applies the HasEdge predicate on the "appointment_histories" edge with a given conditions (other predicates).
+func HasAppointmentHistoriesWith(preds ...predicate.AppointmentHistory) predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := newAppointmentHistoriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSalaryGrades applies the HasEdge predicate on the "salary_grades" edge.
+func HasSalaryGrades() predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SalaryGradesTable, SalaryGradesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalaryGradesWith applies the HasEdge predicate on the "salary_grades" edge with a given conditions (other predicates).
+func HasSalaryGradesWith(preds ...predicate.SalaryGrade) predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := newSalaryGradesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Position) predicate.Position {
 	return predicate.Position(sql.AndPredicates(predicates...))
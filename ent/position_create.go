@@ -11,9 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
 )
 
 // PositionCreate is the builder for creating a Position entity.
@@ -124,6 +126,36 @@ func (pc *PositionCreate) SetParent(p *Position) *PositionCreate {
 	return pc.SetParentID(p.ID)
 }
 
+// AddAppointmentHistoryIDs adds the "appointment_histories" edge to the AppointmentHistory entity by IDs.
+func (pc *PositionCreate) AddAppointmentHistoryIDs(ids ...int) *PositionCreate {
+	pc.mutation.AddAppointmentHistoryIDs(ids...)
+	return pc
+}
+
+// AddAppointmentHistories adds the "appointment_histories" edges to the AppointmentHistory entity.
+func (pc *PositionCreate) AddAppointmentHistories(a ...*AppointmentHistory) *PositionCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pc.AddAppointmentHistoryIDs(ids...)
+}
+
+// AddSalaryGradeIDs adds the "salary_grades" edge to the SalaryGrade entity by IDs.
+func (pc *PositionCreate) AddSalaryGradeIDs(ids ...int) *PositionCreate {
+	pc.mutation.AddSalaryGradeIDs(ids...)
+	return pc
+}
+
+// AddSalaryGrades adds the "salary_grades" edges to the SalaryGrade entity.
+func (pc *PositionCreate) AddSalaryGrades(s ...*SalaryGrade) *PositionCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddSalaryGradeIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (pc *PositionCreate) Mutation() *PositionMutation {
 	return pc.mutation
@@ -303,6 +335,38 @@ func (pc *PositionCreate) createSpec() (*Position, *sqlgraph.CreateSpec) {
 		_node.ParentID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.AppointmentHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.AppointmentHistoriesTable,
+			Columns: []string{position.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.SalaryGradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.SalaryGradesTable,
+			Columns: []string{position.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,23 +12,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
 )
 
 // PositionQuery is the builder for querying Position entities.
 type PositionQuery struct {
 	config
-	ctx            *QueryContext
-	order          []position.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Position
-	withEmployees  *EmployeeQuery
-	withDepartment *DepartmentQuery
-	withChildren   *PositionQuery
-	withParent     *PositionQuery
+	ctx                      *QueryContext
+	order                    []position.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Position
+	withEmployees            *EmployeeQuery
+	withDepartment           *DepartmentQuery
+	withChildren             *PositionQuery
+	withParent               *PositionQuery
+	withAppointmentHistories *AppointmentHistoryQuery
+	withSalaryGrades         *SalaryGradeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -153,6 +157,50 @@ func (pq *PositionQuery) QueryParent() *PositionQuery {
 	return query
 }
 
+// QueryAppointmentHistories chains the current query on the "appointment_histories" edge.
+func (pq *PositionQuery) QueryAppointmentHistories() *AppointmentHistoryQuery {
+	query := (&AppointmentHistoryClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, selector),
+			sqlgraph.To(appointmenthistory.Table, appointmenthistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, position.AppointmentHistoriesTable, position.AppointmentHistoriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySalaryGrades chains the current query on the "salary_grades" edge.
+func (pq *PositionQuery) QuerySalaryGrades() *SalaryGradeQuery {
+	query := (&SalaryGradeClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(position.Table, position.FieldID, selector),
+			sqlgraph.To(salarygrade.Table, salarygrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, position.SalaryGradesTable, position.SalaryGradesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Position entity from the query.
 // Returns a *NotFoundError when no Position was found.
 func (pq *PositionQuery) First(ctx context.Context) (*Position, error) {
@@ -340,15 +388,17 @@ func (pq *PositionQuery) Clone() *PositionQuery {
 		return nil
 	}
 	return &PositionQuery{
-		config:         pq.config,
-		ctx:            pq.ctx.Clone(),
-		order:          append([]position.OrderOption{}, pq.order...),
-		inters:         append([]Interceptor{}, pq.inters...),
-		predicates:     append([]predicate.Position{}, pq.predicates...),
-		withEmployees:  pq.withEmployees.Clone(),
-		withDepartment: pq.withDepartment.Clone(),
-		withChildren:   pq.withChildren.Clone(),
-		withParent:     pq.withParent.Clone(),
+		config:                   pq.config,
+		ctx:                      pq.ctx.Clone(),
+		order:                    append([]position.OrderOption{}, pq.order...),
+		inters:                   append([]Interceptor{}, pq.inters...),
+		predicates:               append([]predicate.Position{}, pq.predicates...),
+		withEmployees:            pq.withEmployees.Clone(),
+		withDepartment:           pq.withDepartment.Clone(),
+		withChildren:             pq.withChildren.Clone(),
+		withParent:               pq.withParent.Clone(),
+		withAppointmentHistories: pq.withAppointmentHistories.Clone(),
+		withSalaryGrades:         pq.withSalaryGrades.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -399,6 +449,28 @@ func (pq *PositionQuery) WithParent(opts ...func(*PositionQuery)) *PositionQuery
 	return pq
 }
 
+// WithAppointmentHistories tells the query-builder to eager-load the nodes that are connected to
+// the "appointment_histories" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PositionQuery) WithAppointmentHistories(opts ...func(*AppointmentHistoryQuery)) *PositionQuery {
+	query := (&AppointmentHistoryClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withAppointmentHistories = query
+	return pq
+}
+
+// WithSalaryGrades tells the query-builder to eager-load the nodes that are connected to
+// the "salary_grades" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PositionQuery) WithSalaryGrades(opts ...func(*SalaryGradeQuery)) *PositionQuery {
+	query := (&SalaryGradeClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSalaryGrades = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -477,11 +549,13 @@ func (pq *PositionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pos
 	var (
 		nodes       = []*Position{}
 		_spec       = pq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			pq.withEmployees != nil,
 			pq.withDepartment != nil,
 			pq.withChildren != nil,
 			pq.withParent != nil,
+			pq.withAppointmentHistories != nil,
+			pq.withSalaryGrades != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -528,6 +602,22 @@ func (pq *PositionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pos
 			return nil, err
 		}
 	}
+	if query := pq.withAppointmentHistories; query != nil {
+		if err := pq.loadAppointmentHistories(ctx, query, nodes,
+			func(n *Position) { n.Edges.AppointmentHistories = []*AppointmentHistory{} },
+			func(n *Position, e *AppointmentHistory) {
+				n.Edges.AppointmentHistories = append(n.Edges.AppointmentHistories, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withSalaryGrades; query != nil {
+		if err := pq.loadSalaryGrades(ctx, query, nodes,
+			func(n *Position) { n.Edges.SalaryGrades = []*SalaryGrade{} },
+			func(n *Position, e *SalaryGrade) { n.Edges.SalaryGrades = append(n.Edges.SalaryGrades, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -649,6 +739,72 @@ func (pq *PositionQuery) loadParent(ctx context.Context, query *PositionQuery, n
 	}
 	return nil
 }
+func (pq *PositionQuery) loadAppointmentHistories(ctx context.Context, query *AppointmentHistoryQuery, nodes []*Position, init func(*Position), assign func(*Position, *AppointmentHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Position)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(appointmenthistory.FieldPositionID)
+	}
+	query.Where(predicate.AppointmentHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(position.AppointmentHistoriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PositionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "position_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "position_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *PositionQuery) loadSalaryGrades(ctx context.Context, query *SalaryGradeQuery, nodes []*Position, init func(*Position), assign func(*Position, *SalaryGrade)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Position)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(salarygrade.FieldPositionID)
+	}
+	query.Where(predicate.SalaryGrade(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(position.SalaryGradesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PositionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "position_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "position_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *PositionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
@@ -11,10 +11,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
 )
 
 // PositionUpdate is the builder for updating Position entities.
@@ -138,6 +140,36 @@ func (pu *PositionUpdate) SetParent(p *Position) *PositionUpdate {
 	return pu.SetParentID(p.ID)
 }
 
+// AddAppointmentHistoryIDs adds the "appointment_histories" edge to the AppointmentHistory entity by IDs.
+func (pu *PositionUpdate) AddAppointmentHistoryIDs(ids ...int) *PositionUpdate {
+	pu.mutation.AddAppointmentHistoryIDs(ids...)
+	return pu
+}
+
+// AddAppointmentHistories adds the "appointment_histories" edges to the AppointmentHistory entity.
+func (pu *PositionUpdate) AddAppointmentHistories(a ...*AppointmentHistory) *PositionUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pu.AddAppointmentHistoryIDs(ids...)
+}
+
+// AddSalaryGradeIDs adds the "salary_grades" edge to the SalaryGrade entity by IDs.
+func (pu *PositionUpdate) AddSalaryGradeIDs(ids ...int) *PositionUpdate {
+	pu.mutation.AddSalaryGradeIDs(ids...)
+	return pu
+}
+
+// AddSalaryGrades adds the "salary_grades" edges to the SalaryGrade entity.
+func (pu *PositionUpdate) AddSalaryGrades(s ...*SalaryGrade) *PositionUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddSalaryGradeIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (pu *PositionUpdate) Mutation() *PositionMutation {
 	return pu.mutation
@@ -197,6 +229,48 @@ func (pu *PositionUpdate) ClearParent() *PositionUpdate {
 	return pu
 }
 
+// ClearAppointmentHistories clears all "appointment_histories" edges to the AppointmentHistory entity.
+func (pu *PositionUpdate) ClearAppointmentHistories() *PositionUpdate {
+	pu.mutation.ClearAppointmentHistories()
+	return pu
+}
+
+// RemoveAppointmentHistoryIDs removes the "appointment_histories" edge to AppointmentHistory entities by IDs.
+func (pu *PositionUpdate) RemoveAppointmentHistoryIDs(ids ...int) *PositionUpdate {
+	pu.mutation.RemoveAppointmentHistoryIDs(ids...)
+	return pu
+}
+
+// RemoveAppointmentHistories removes "appointment_histories" edges to AppointmentHistory entities.
+func (pu *PositionUpdate) RemoveAppointmentHistories(a ...*AppointmentHistory) *PositionUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pu.RemoveAppointmentHistoryIDs(ids...)
+}
+
+// ClearSalaryGrades clears all "salary_grades" edges to the SalaryGrade entity.
+func (pu *PositionUpdate) ClearSalaryGrades() *PositionUpdate {
+	pu.mutation.ClearSalaryGrades()
+	return pu
+}
+
+// RemoveSalaryGradeIDs removes the "salary_grades" edge to SalaryGrade entities by IDs.
+func (pu *PositionUpdate) RemoveSalaryGradeIDs(ids ...int) *PositionUpdate {
+	pu.mutation.RemoveSalaryGradeIDs(ids...)
+	return pu
+}
+
+// RemoveSalaryGrades removes "salary_grades" edges to SalaryGrade entities.
+func (pu *PositionUpdate) RemoveSalaryGrades(s ...*SalaryGrade) *PositionUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveSalaryGradeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PositionUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -415,6 +489,96 @@ func (pu *PositionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.AppointmentHistoriesTable,
+			Columns: []string{position.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedAppointmentHistoriesIDs(); len(nodes) > 0 && !pu.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.AppointmentHistoriesTable,
+			Columns: []string{position.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.AppointmentHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.AppointmentHistoriesTable,
+			Columns: []string{position.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.SalaryGradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.SalaryGradesTable,
+			Columns: []string{position.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedSalaryGradesIDs(); len(nodes) > 0 && !pu.mutation.SalaryGradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.SalaryGradesTable,
+			Columns: []string{position.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SalaryGradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.SalaryGradesTable,
+			Columns: []string{position.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{position.Label}
@@ -543,6 +707,36 @@ func (puo *PositionUpdateOne) SetParent(p *Position) *PositionUpdateOne {
 	return puo.SetParentID(p.ID)
 }
 
+// AddAppointmentHistoryIDs adds the "appointment_histories" edge to the AppointmentHistory entity by IDs.
+func (puo *PositionUpdateOne) AddAppointmentHistoryIDs(ids ...int) *PositionUpdateOne {
+	puo.mutation.AddAppointmentHistoryIDs(ids...)
+	return puo
+}
+
+// AddAppointmentHistories adds the "appointment_histories" edges to the AppointmentHistory entity.
+func (puo *PositionUpdateOne) AddAppointmentHistories(a ...*AppointmentHistory) *PositionUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return puo.AddAppointmentHistoryIDs(ids...)
+}
+
+// AddSalaryGradeIDs adds the "salary_grades" edge to the SalaryGrade entity by IDs.
+func (puo *PositionUpdateOne) AddSalaryGradeIDs(ids ...int) *PositionUpdateOne {
+	puo.mutation.AddSalaryGradeIDs(ids...)
+	return puo
+}
+
+// AddSalaryGrades adds the "salary_grades" edges to the SalaryGrade entity.
+func (puo *PositionUpdateOne) AddSalaryGrades(s ...*SalaryGrade) *PositionUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddSalaryGradeIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (puo *PositionUpdateOne) Mutation() *PositionMutation {
 	return puo.mutation
@@ -602,6 +796,48 @@ func (puo *PositionUpdateOne) ClearParent() *PositionUpdateOne {
 	return puo
 }
 
+// ClearAppointmentHistories clears all "appointment_histories" edges to the AppointmentHistory entity.
+func (puo *PositionUpdateOne) ClearAppointmentHistories() *PositionUpdateOne {
+	puo.mutation.ClearAppointmentHistories()
+	return puo
+}
+
+// RemoveAppointmentHistoryIDs removes the "appointment_histories" edge to AppointmentHistory entities by IDs.
+func (puo *PositionUpdateOne) RemoveAppointmentHistoryIDs(ids ...int) *PositionUpdateOne {
+	puo.mutation.RemoveAppointmentHistoryIDs(ids...)
+	return puo
+}
+
+// RemoveAppointmentHistories removes "appointment_histories" edges to AppointmentHistory entities.
+func (puo *PositionUpdateOne) RemoveAppointmentHistories(a ...*AppointmentHistory) *PositionUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return puo.RemoveAppointmentHistoryIDs(ids...)
+}
+
+// ClearSalaryGrades clears all "salary_grades" edges to the SalaryGrade entity.
+func (puo *PositionUpdateOne) ClearSalaryGrades() *PositionUpdateOne {
+	puo.mutation.ClearSalaryGrades()
+	return puo
+}
+
+// RemoveSalaryGradeIDs removes the "salary_grades" edge to SalaryGrade entities by IDs.
+func (puo *PositionUpdateOne) RemoveSalaryGradeIDs(ids ...int) *PositionUpdateOne {
+	puo.mutation.RemoveSalaryGradeIDs(ids...)
+	return puo
+}
+
+// RemoveSalaryGrades removes "salary_grades" edges to SalaryGrade entities.
+func (puo *PositionUpdateOne) RemoveSalaryGrades(s ...*SalaryGrade) *PositionUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveSalaryGradeIDs(ids...)
+}
+
 // Where appends a list predicates to the PositionUpdate builder.
 func (puo *PositionUpdateOne) Where(ps ...predicate.Position) *PositionUpdateOne {
 	puo.mutation.Where(ps...)
@@ -850,6 +1086,96 @@ func (puo *PositionUpdateOne) sqlSave(ctx context.Context) (_node *Position, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.AppointmentHistoriesTable,
+			Columns: []string{position.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedAppointmentHistoriesIDs(); len(nodes) > 0 && !puo.mutation.AppointmentHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.AppointmentHistoriesTable,
+			Columns: []string{position.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.AppointmentHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.AppointmentHistoriesTable,
+			Columns: []string{position.AppointmentHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointmenthistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.SalaryGradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.SalaryGradesTable,
+			Columns: []string{position.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedSalaryGradesIDs(); len(nodes) > 0 && !puo.mutation.SalaryGradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.SalaryGradesTable,
+			Columns: []string{position.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SalaryGradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   position.SalaryGradesTable,
+			Columns: []string{position.SalaryGradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Position{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
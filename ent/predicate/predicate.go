@@ -9,12 +9,33 @@ import (
 // AppointmentHistory is the predicate function for appointmenthistory builders.
 type AppointmentHistory func(*sql.Selector)
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// AttendanceCorrection is the predicate function for attendancecorrection builders.
+type AttendanceCorrection func(*sql.Selector)
+
+// AttendanceRecord is the predicate function for attendancerecord builders.
+type AttendanceRecord func(*sql.Selector)
+
+// Compensation is the predicate function for compensation builders.
+type Compensation func(*sql.Selector)
+
 // Department is the predicate function for department builders.
 type Department func(*sql.Selector)
 
 // Employee is the predicate function for employee builders.
 type Employee func(*sql.Selector)
 
+// EmployeeContract is the predicate function for employeecontract builders.
+type EmployeeContract func(*sql.Selector)
+
+// EmployeeStatusHistory is the predicate function for employeestatushistory builders.
+type EmployeeStatusHistory func(*sql.Selector)
+
+// Holiday is the predicate function for holiday builders.
+type Holiday func(*sql.Selector)
+
 // Label is the predicate function for label builders.
 type Label func(*sql.Selector)
 
@@ -24,17 +45,89 @@ type LeaveApproval func(*sql.Selector)
 // LeaveRequest is the predicate function for leaverequest builders.
 type LeaveRequest func(*sql.Selector)
 
+// Milestone is the predicate function for milestone builders.
+type Milestone func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
 // Organization is the predicate function for organization builders.
 type Organization func(*sql.Selector)
 
+// OvertimeRequest is the predicate function for overtimerequest builders.
+type OvertimeRequest func(*sql.Selector)
+
 // Position is the predicate function for position builders.
 type Position func(*sql.Selector)
 
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
+// ProjectRole is the predicate function for projectrole builders.
+type ProjectRole func(*sql.Selector)
+
+// ReminderSetting is the predicate function for remindersetting builders.
+type ReminderSetting func(*sql.Selector)
+
+// Roster is the predicate function for roster builders.
+type Roster func(*sql.Selector)
+
+// SalaryGrade is the predicate function for salarygrade builders.
+type SalaryGrade func(*sql.Selector)
+
+// Shift is the predicate function for shift builders.
+type Shift func(*sql.Selector)
+
+// ShiftSwapRequest is the predicate function for shiftswaprequest builders.
+type ShiftSwapRequest func(*sql.Selector)
+
+// Sprint is the predicate function for sprint builders.
+type Sprint func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// TaskActivity is the predicate function for taskactivity builders.
+type TaskActivity func(*sql.Selector)
+
+// TaskComment is the predicate function for taskcomment builders.
+type TaskComment func(*sql.Selector)
+
+// TaskCommentRevision is the predicate function for taskcommentrevision builders.
+type TaskCommentRevision func(*sql.Selector)
+
+// TaskDependency is the predicate function for taskdependency builders.
+type TaskDependency func(*sql.Selector)
+
+// TaskRecurrence is the predicate function for taskrecurrence builders.
+type TaskRecurrence func(*sql.Selector)
+
+// TaskRecurrenceRun is the predicate function for taskrecurrencerun builders.
+type TaskRecurrenceRun func(*sql.Selector)
+
+// TaskReminder is the predicate function for taskreminder builders.
+type TaskReminder func(*sql.Selector)
+
 // TaskReport is the predicate function for taskreport builders.
 type TaskReport func(*sql.Selector)
+
+// TaskTemplate is the predicate function for tasktemplate builders.
+type TaskTemplate func(*sql.Selector)
+
+// TaskView is the predicate function for taskview builders.
+type TaskView func(*sql.Selector)
+
+// TaskWatcher is the predicate function for taskwatcher builders.
+type TaskWatcher func(*sql.Selector)
+
+// WorkLog is the predicate function for worklog builders.
+type WorkLog func(*sql.Selector)
+
+// WorkflowStatus is the predicate function for workflowstatus builders.
+type WorkflowStatus func(*sql.Selector)
+
+// WorkflowTransition is the predicate function for workflowtransition builders.
+type WorkflowTransition func(*sql.Selector)
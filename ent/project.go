@@ -39,6 +39,12 @@ type Project struct {
 	Process int `json:"process"`
 	// Status holds the value of the "status" field.
 	Status project.Status `json:"status"`
+	// ManualProgress holds the value of the "manual_progress" field.
+	ManualProgress bool `json:"manual_progress"`
+	// Key holds the value of the "key" field.
+	Key *string `json:"key"`
+	// TaskSeq holds the value of the "task_seq" field.
+	TaskSeq int `json:"task_seq"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -61,9 +67,25 @@ type ProjectEdges struct {
 	Updater *Employee `json:"updater"`
 	// Members holds the value of the members edge.
 	Members []*Employee `json:"members"`
+	// OvertimeRequests holds the value of the overtime_requests edge.
+	OvertimeRequests []*OvertimeRequest `json:"overtime_requests"`
+	// WorkflowStatuses holds the value of the workflow_statuses edge.
+	WorkflowStatuses []*WorkflowStatus `json:"workflow_statuses"`
+	// WorkflowTransitions holds the value of the workflow_transitions edge.
+	WorkflowTransitions []*WorkflowTransition `json:"workflow_transitions"`
+	// Sprints holds the value of the sprints edge.
+	Sprints []*Sprint `json:"sprints"`
+	// Milestones holds the value of the milestones edge.
+	Milestones []*Milestone `json:"milestones"`
+	// TaskTemplates holds the value of the task_templates edge.
+	TaskTemplates []*TaskTemplate `json:"task_templates"`
+	// TaskViews holds the value of the task_views edge.
+	TaskViews []*TaskView `json:"task_views"`
+	// Roles holds the value of the roles edge.
+	Roles []*ProjectRole `json:"roles"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [13]bool
 }
 
 // TasksOrErr returns the Tasks value or an error if the edge
@@ -117,14 +139,88 @@ func (e ProjectEdges) MembersOrErr() ([]*Employee, error) {
 	return nil, &NotLoadedError{edge: "members"}
 }
 
+// OvertimeRequestsOrErr returns the OvertimeRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) OvertimeRequestsOrErr() ([]*OvertimeRequest, error) {
+	if e.loadedTypes[5] {
+		return e.OvertimeRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "overtime_requests"}
+}
+
+// WorkflowStatusesOrErr returns the WorkflowStatuses value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) WorkflowStatusesOrErr() ([]*WorkflowStatus, error) {
+	if e.loadedTypes[6] {
+		return e.WorkflowStatuses, nil
+	}
+	return nil, &NotLoadedError{edge: "workflow_statuses"}
+}
+
+// WorkflowTransitionsOrErr returns the WorkflowTransitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) WorkflowTransitionsOrErr() ([]*WorkflowTransition, error) {
+	if e.loadedTypes[7] {
+		return e.WorkflowTransitions, nil
+	}
+	return nil, &NotLoadedError{edge: "workflow_transitions"}
+}
+
+// SprintsOrErr returns the Sprints value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) SprintsOrErr() ([]*Sprint, error) {
+	if e.loadedTypes[8] {
+		return e.Sprints, nil
+	}
+	return nil, &NotLoadedError{edge: "sprints"}
+}
+
+// MilestonesOrErr returns the Milestones value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) MilestonesOrErr() ([]*Milestone, error) {
+	if e.loadedTypes[9] {
+		return e.Milestones, nil
+	}
+	return nil, &NotLoadedError{edge: "milestones"}
+}
+
+// TaskTemplatesOrErr returns the TaskTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TaskTemplatesOrErr() ([]*TaskTemplate, error) {
+	if e.loadedTypes[10] {
+		return e.TaskTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "task_templates"}
+}
+
+// TaskViewsOrErr returns the TaskViews value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TaskViewsOrErr() ([]*TaskView, error) {
+	if e.loadedTypes[11] {
+		return e.TaskViews, nil
+	}
+	return nil, &NotLoadedError{edge: "task_views"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) RolesOrErr() ([]*ProjectRole, error) {
+	if e.loadedTypes[12] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case project.FieldID, project.FieldCreatorID, project.FieldUpdaterID, project.FieldOrgID, project.FieldProcess:
+		case project.FieldManualProgress:
+			values[i] = new(sql.NullBool)
+		case project.FieldID, project.FieldCreatorID, project.FieldUpdaterID, project.FieldOrgID, project.FieldProcess, project.FieldTaskSeq:
 			values[i] = new(sql.NullInt64)
-		case project.FieldName, project.FieldCode, project.FieldDescription, project.FieldStatus:
+		case project.FieldName, project.FieldCode, project.FieldDescription, project.FieldStatus, project.FieldKey:
 			values[i] = new(sql.NullString)
 		case project.FieldStartAt, project.FieldEndAt, project.FieldCreatedAt, project.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -212,6 +308,25 @@ func (pr *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Status = project.Status(value.String)
 			}
+		case project.FieldManualProgress:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field manual_progress", values[i])
+			} else if value.Valid {
+				pr.ManualProgress = value.Bool
+			}
+		case project.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				pr.Key = new(string)
+				*pr.Key = value.String
+			}
+		case project.FieldTaskSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_seq", values[i])
+			} else if value.Valid {
+				pr.TaskSeq = int(value.Int64)
+			}
 		case project.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -262,6 +377,46 @@ func (pr *Project) QueryMembers() *EmployeeQuery {
 	return NewProjectClient(pr.config).QueryMembers(pr)
 }
 
+// QueryOvertimeRequests queries the "overtime_requests" edge of the Project entity.
+func (pr *Project) QueryOvertimeRequests() *OvertimeRequestQuery {
+	return NewProjectClient(pr.config).QueryOvertimeRequests(pr)
+}
+
+// QueryWorkflowStatuses queries the "workflow_statuses" edge of the Project entity.
+func (pr *Project) QueryWorkflowStatuses() *WorkflowStatusQuery {
+	return NewProjectClient(pr.config).QueryWorkflowStatuses(pr)
+}
+
+// QueryWorkflowTransitions queries the "workflow_transitions" edge of the Project entity.
+func (pr *Project) QueryWorkflowTransitions() *WorkflowTransitionQuery {
+	return NewProjectClient(pr.config).QueryWorkflowTransitions(pr)
+}
+
+// QuerySprints queries the "sprints" edge of the Project entity.
+func (pr *Project) QuerySprints() *SprintQuery {
+	return NewProjectClient(pr.config).QuerySprints(pr)
+}
+
+// QueryMilestones queries the "milestones" edge of the Project entity.
+func (pr *Project) QueryMilestones() *MilestoneQuery {
+	return NewProjectClient(pr.config).QueryMilestones(pr)
+}
+
+// QueryTaskTemplates queries the "task_templates" edge of the Project entity.
+func (pr *Project) QueryTaskTemplates() *TaskTemplateQuery {
+	return NewProjectClient(pr.config).QueryTaskTemplates(pr)
+}
+
+// QueryTaskViews queries the "task_views" edge of the Project entity.
+func (pr *Project) QueryTaskViews() *TaskViewQuery {
+	return NewProjectClient(pr.config).QueryTaskViews(pr)
+}
+
+// QueryRoles queries the "roles" edge of the Project entity.
+func (pr *Project) QueryRoles() *ProjectRoleQuery {
+	return NewProjectClient(pr.config).QueryRoles(pr)
+}
+
 // Update returns a builder for updating this Project.
 // Note that you need to call Project.Unwrap() before calling this method if this Project
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -321,6 +476,17 @@ func (pr *Project) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", pr.Status))
 	builder.WriteString(", ")
+	builder.WriteString("manual_progress=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ManualProgress))
+	builder.WriteString(", ")
+	if v := pr.Key; v != nil {
+		builder.WriteString("key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("task_seq=")
+	builder.WriteString(fmt.Sprintf("%v", pr.TaskSeq))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -35,6 +35,12 @@ const (
 	FieldProcess = "process"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldManualProgress holds the string denoting the manual_progress field in the database.
+	FieldManualProgress = "manual_progress"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldTaskSeq holds the string denoting the task_seq field in the database.
+	FieldTaskSeq = "task_seq"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -49,6 +55,22 @@ const (
 	EdgeUpdater = "updater"
 	// EdgeMembers holds the string denoting the members edge name in mutations.
 	EdgeMembers = "members"
+	// EdgeOvertimeRequests holds the string denoting the overtime_requests edge name in mutations.
+	EdgeOvertimeRequests = "overtime_requests"
+	// EdgeWorkflowStatuses holds the string denoting the workflow_statuses edge name in mutations.
+	EdgeWorkflowStatuses = "workflow_statuses"
+	// EdgeWorkflowTransitions holds the string denoting the workflow_transitions edge name in mutations.
+	EdgeWorkflowTransitions = "workflow_transitions"
+	// EdgeSprints holds the string denoting the sprints edge name in mutations.
+	EdgeSprints = "sprints"
+	// EdgeMilestones holds the string denoting the milestones edge name in mutations.
+	EdgeMilestones = "milestones"
+	// EdgeTaskTemplates holds the string denoting the task_templates edge name in mutations.
+	EdgeTaskTemplates = "task_templates"
+	// EdgeTaskViews holds the string denoting the task_views edge name in mutations.
+	EdgeTaskViews = "task_views"
+	// EdgeRoles holds the string denoting the roles edge name in mutations.
+	EdgeRoles = "roles"
 	// Table holds the table name of the project in the database.
 	Table = "projects"
 	// TasksTable is the table that holds the tasks relation/edge.
@@ -84,6 +106,62 @@ const (
 	// MembersInverseTable is the table name for the Employee entity.
 	// It exists in this package in order to avoid circular dependency with the "employee" package.
 	MembersInverseTable = "employees"
+	// OvertimeRequestsTable is the table that holds the overtime_requests relation/edge.
+	OvertimeRequestsTable = "overtime_requests"
+	// OvertimeRequestsInverseTable is the table name for the OvertimeRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "overtimerequest" package.
+	OvertimeRequestsInverseTable = "overtime_requests"
+	// OvertimeRequestsColumn is the table column denoting the overtime_requests relation/edge.
+	OvertimeRequestsColumn = "project_id"
+	// WorkflowStatusesTable is the table that holds the workflow_statuses relation/edge.
+	WorkflowStatusesTable = "workflow_status"
+	// WorkflowStatusesInverseTable is the table name for the WorkflowStatus entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowstatus" package.
+	WorkflowStatusesInverseTable = "workflow_status"
+	// WorkflowStatusesColumn is the table column denoting the workflow_statuses relation/edge.
+	WorkflowStatusesColumn = "project_id"
+	// WorkflowTransitionsTable is the table that holds the workflow_transitions relation/edge.
+	WorkflowTransitionsTable = "workflow_transitions"
+	// WorkflowTransitionsInverseTable is the table name for the WorkflowTransition entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowtransition" package.
+	WorkflowTransitionsInverseTable = "workflow_transitions"
+	// WorkflowTransitionsColumn is the table column denoting the workflow_transitions relation/edge.
+	WorkflowTransitionsColumn = "project_id"
+	// SprintsTable is the table that holds the sprints relation/edge.
+	SprintsTable = "sprints"
+	// SprintsInverseTable is the table name for the Sprint entity.
+	// It exists in this package in order to avoid circular dependency with the "sprint" package.
+	SprintsInverseTable = "sprints"
+	// SprintsColumn is the table column denoting the sprints relation/edge.
+	SprintsColumn = "project_id"
+	// MilestonesTable is the table that holds the milestones relation/edge.
+	MilestonesTable = "milestones"
+	// MilestonesInverseTable is the table name for the Milestone entity.
+	// It exists in this package in order to avoid circular dependency with the "milestone" package.
+	MilestonesInverseTable = "milestones"
+	// MilestonesColumn is the table column denoting the milestones relation/edge.
+	MilestonesColumn = "project_id"
+	// TaskTemplatesTable is the table that holds the task_templates relation/edge.
+	TaskTemplatesTable = "task_templates"
+	// TaskTemplatesInverseTable is the table name for the TaskTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "tasktemplate" package.
+	TaskTemplatesInverseTable = "task_templates"
+	// TaskTemplatesColumn is the table column denoting the task_templates relation/edge.
+	TaskTemplatesColumn = "project_id"
+	// TaskViewsTable is the table that holds the task_views relation/edge.
+	TaskViewsTable = "task_views"
+	// TaskViewsInverseTable is the table name for the TaskView entity.
+	// It exists in this package in order to avoid circular dependency with the "taskview" package.
+	TaskViewsInverseTable = "task_views"
+	// TaskViewsColumn is the table column denoting the task_views relation/edge.
+	TaskViewsColumn = "project_id"
+	// RolesTable is the table that holds the roles relation/edge.
+	RolesTable = "project_roles"
+	// RolesInverseTable is the table name for the ProjectRole entity.
+	// It exists in this package in order to avoid circular dependency with the "projectrole" package.
+	RolesInverseTable = "project_roles"
+	// RolesColumn is the table column denoting the roles relation/edge.
+	RolesColumn = "project_id"
 )
 
 // Columns holds all SQL columns for project fields.
@@ -99,6 +177,9 @@ var Columns = []string{
 	FieldOrgID,
 	FieldProcess,
 	FieldStatus,
+	FieldManualProgress,
+	FieldKey,
+	FieldTaskSeq,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -120,6 +201,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultManualProgress holds the default value on creation for the "manual_progress" field.
+	DefaultManualProgress bool
+	// DefaultTaskSeq holds the default value on creation for the "task_seq" field.
+	DefaultTaskSeq int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -213,6 +298,21 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByManualProgress orders the results by the manual_progress field.
+func ByManualProgress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldManualProgress, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByTaskSeq orders the results by the task_seq field.
+func ByTaskSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskSeq, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -271,6 +371,118 @@ func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOvertimeRequestsCount orders the results by overtime_requests count.
+func ByOvertimeRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOvertimeRequestsStep(), opts...)
+	}
+}
+
+// ByOvertimeRequests orders the results by overtime_requests terms.
+func ByOvertimeRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOvertimeRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWorkflowStatusesCount orders the results by workflow_statuses count.
+func ByWorkflowStatusesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkflowStatusesStep(), opts...)
+	}
+}
+
+// ByWorkflowStatuses orders the results by workflow_statuses terms.
+func ByWorkflowStatuses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkflowStatusesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWorkflowTransitionsCount orders the results by workflow_transitions count.
+func ByWorkflowTransitionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkflowTransitionsStep(), opts...)
+	}
+}
+
+// ByWorkflowTransitions orders the results by workflow_transitions terms.
+func ByWorkflowTransitions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkflowTransitionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySprintsCount orders the results by sprints count.
+func BySprintsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSprintsStep(), opts...)
+	}
+}
+
+// BySprints orders the results by sprints terms.
+func BySprints(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSprintsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMilestonesCount orders the results by milestones count.
+func ByMilestonesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMilestonesStep(), opts...)
+	}
+}
+
+// ByMilestones orders the results by milestones terms.
+func ByMilestones(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMilestonesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTaskTemplatesCount orders the results by task_templates count.
+func ByTaskTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaskTemplatesStep(), opts...)
+	}
+}
+
+// ByTaskTemplates orders the results by task_templates terms.
+func ByTaskTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTaskViewsCount orders the results by task_views count.
+func ByTaskViewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaskViewsStep(), opts...)
+	}
+}
+
+// ByTaskViews orders the results by task_views terms.
+func ByTaskViews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskViewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRolesCount orders the results by roles count.
+func ByRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRolesStep(), opts...)
+	}
+}
+
+// ByRoles orders the results by roles terms.
+func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTasksStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -306,3 +518,59 @@ func newMembersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, MembersTable, MembersPrimaryKey...),
 	)
 }
+func newOvertimeRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OvertimeRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OvertimeRequestsTable, OvertimeRequestsColumn),
+	)
+}
+func newWorkflowStatusesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkflowStatusesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorkflowStatusesTable, WorkflowStatusesColumn),
+	)
+}
+func newWorkflowTransitionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkflowTransitionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorkflowTransitionsTable, WorkflowTransitionsColumn),
+	)
+}
+func newSprintsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SprintsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SprintsTable, SprintsColumn),
+	)
+}
+func newMilestonesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MilestonesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+	)
+}
+func newTaskTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TaskTemplatesTable, TaskTemplatesColumn),
+	)
+}
+func newTaskViewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskViewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TaskViewsTable, TaskViewsColumn),
+	)
+}
+func newRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RolesTable, RolesColumn),
+	)
+}
@@ -100,6 +100,21 @@ func Process(v int) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldProcess, v))
 }
 
+// ManualProgress applies equality check predicate on the "manual_progress" field. It's identical to ManualProgressEQ.
+func ManualProgress(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldManualProgress, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldKey, v))
+}
+
+// TaskSeq applies equality check predicate on the "task_seq" field. It's identical to TaskSeqEQ.
+func TaskSeq(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldTaskSeq, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldCreatedAt, v))
@@ -545,6 +560,131 @@ func StatusNotIn(vs ...Status) predicate.Project {
 	return predicate.Project(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// ManualProgressEQ applies the EQ predicate on the "manual_progress" field.
+func ManualProgressEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldManualProgress, v))
+}
+
+// ManualProgressNEQ applies the NEQ predicate on the "manual_progress" field.
+func ManualProgressNEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldManualProgress, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyIsNil applies the IsNil predicate on the "key" field.
+func KeyIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldKey))
+}
+
+// KeyNotNil applies the NotNil predicate on the "key" field.
+func KeyNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldKey))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldKey, v))
+}
+
+// TaskSeqEQ applies the EQ predicate on the "task_seq" field.
+func TaskSeqEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldTaskSeq, v))
+}
+
+// TaskSeqNEQ applies the NEQ predicate on the "task_seq" field.
+func TaskSeqNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldTaskSeq, v))
+}
+
+// TaskSeqIn applies the In predicate on the "task_seq" field.
+func TaskSeqIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldTaskSeq, vs...))
+}
+
+// TaskSeqNotIn applies the NotIn predicate on the "task_seq" field.
+func TaskSeqNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldTaskSeq, vs...))
+}
+
+// TaskSeqGT applies the GT predicate on the "task_seq" field.
+func TaskSeqGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldTaskSeq, v))
+}
+
+// TaskSeqGTE applies the GTE predicate on the "task_seq" field.
+func TaskSeqGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldTaskSeq, v))
+}
+
+// TaskSeqLT applies the LT predicate on the "task_seq" field.
+func TaskSeqLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldTaskSeq, v))
+}
+
+// TaskSeqLTE applies the LTE predicate on the "task_seq" field.
+func TaskSeqLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldTaskSeq, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldCreatedAt, v))
@@ -740,6 +880,190 @@ func HasMembersWith(preds ...predicate.Employee) predicate.Project {
 	})
 }
 
+// HasOvertimeRequests applies the HasEdge predicate on the "overtime_requests" edge.
+func HasOvertimeRequests() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OvertimeRequestsTable, OvertimeRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOvertimeRequestsWith applies the HasEdge predicate on the "overtime_requests" edge with a given conditions (other predicates).
+func HasOvertimeRequestsWith(preds ...predicate.OvertimeRequest) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newOvertimeRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkflowStatuses applies the HasEdge predicate on the "workflow_statuses" edge.
+func HasWorkflowStatuses() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WorkflowStatusesTable, WorkflowStatusesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowStatusesWith applies the HasEdge predicate on the "workflow_statuses" edge with a given conditions (other predicates).
+func HasWorkflowStatusesWith(preds ...predicate.WorkflowStatus) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newWorkflowStatusesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkflowTransitions applies the HasEdge predicate on the "workflow_transitions" edge.
+func HasWorkflowTransitions() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WorkflowTransitionsTable, WorkflowTransitionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowTransitionsWith applies the HasEdge predicate on the "workflow_transitions" edge with a given conditions (other predicates).
+func HasWorkflowTransitionsWith(preds ...predicate.WorkflowTransition) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newWorkflowTransitionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSprints applies the HasEdge predicate on the "sprints" edge.
+func HasSprints() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SprintsTable, SprintsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSprintsWith applies the HasEdge predicate on the "sprints" edge with a given conditions (other predicates).
+func HasSprintsWith(preds ...predicate.Sprint) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newSprintsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMilestones applies the HasEdge predicate on the "milestones" edge.
+func HasMilestones() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMilestonesWith applies the HasEdge predicate on the "milestones" edge with a given conditions (other predicates).
+func HasMilestonesWith(preds ...predicate.Milestone) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newMilestonesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTaskTemplates applies the HasEdge predicate on the "task_templates" edge.
+func HasTaskTemplates() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TaskTemplatesTable, TaskTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskTemplatesWith applies the HasEdge predicate on the "task_templates" edge with a given conditions (other predicates).
+func HasTaskTemplatesWith(preds ...predicate.TaskTemplate) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newTaskTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTaskViews applies the HasEdge predicate on the "task_views" edge.
+func HasTaskViews() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TaskViewsTable, TaskViewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskViewsWith applies the HasEdge predicate on the "task_views" edge with a given conditions (other predicates).
+func HasTaskViewsWith(preds ...predicate.TaskView) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newTaskViewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoles applies the HasEdge predicate on the "roles" edge.
+func HasRoles() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RolesTable, RolesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
+func HasRolesWith(preds ...predicate.ProjectRole) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Project) predicate.Project {
 	return predicate.Project(sql.AndPredicates(predicates...))
@@ -12,9 +12,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
 )
 
 // ProjectCreate is the builder for creating a Project entity.
@@ -125,6 +133,48 @@ func (pc *ProjectCreate) SetNillableStatus(pr *project.Status) *ProjectCreate {
 	return pc
 }
 
+// SetManualProgress sets the "manual_progress" field.
+func (pc *ProjectCreate) SetManualProgress(b bool) *ProjectCreate {
+	pc.mutation.SetManualProgress(b)
+	return pc
+}
+
+// SetNillableManualProgress sets the "manual_progress" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableManualProgress(b *bool) *ProjectCreate {
+	if b != nil {
+		pc.SetManualProgress(*b)
+	}
+	return pc
+}
+
+// SetKey sets the "key" field.
+func (pc *ProjectCreate) SetKey(s string) *ProjectCreate {
+	pc.mutation.SetKey(s)
+	return pc
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableKey(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetKey(*s)
+	}
+	return pc
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (pc *ProjectCreate) SetTaskSeq(i int) *ProjectCreate {
+	pc.mutation.SetTaskSeq(i)
+	return pc
+}
+
+// SetNillableTaskSeq sets the "task_seq" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableTaskSeq(i *int) *ProjectCreate {
+	if i != nil {
+		pc.SetTaskSeq(*i)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *ProjectCreate) SetCreatedAt(t time.Time) *ProjectCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -204,6 +254,126 @@ func (pc *ProjectCreate) AddMembers(e ...*Employee) *ProjectCreate {
 	return pc.AddMemberIDs(ids...)
 }
 
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (pc *ProjectCreate) AddOvertimeRequestIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddOvertimeRequestIDs(ids...)
+	return pc
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (pc *ProjectCreate) AddOvertimeRequests(o ...*OvertimeRequest) *ProjectCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pc.AddOvertimeRequestIDs(ids...)
+}
+
+// AddWorkflowStatusIDs adds the "workflow_statuses" edge to the WorkflowStatus entity by IDs.
+func (pc *ProjectCreate) AddWorkflowStatusIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddWorkflowStatusIDs(ids...)
+	return pc
+}
+
+// AddWorkflowStatuses adds the "workflow_statuses" edges to the WorkflowStatus entity.
+func (pc *ProjectCreate) AddWorkflowStatuses(w ...*WorkflowStatus) *ProjectCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pc.AddWorkflowStatusIDs(ids...)
+}
+
+// AddWorkflowTransitionIDs adds the "workflow_transitions" edge to the WorkflowTransition entity by IDs.
+func (pc *ProjectCreate) AddWorkflowTransitionIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddWorkflowTransitionIDs(ids...)
+	return pc
+}
+
+// AddWorkflowTransitions adds the "workflow_transitions" edges to the WorkflowTransition entity.
+func (pc *ProjectCreate) AddWorkflowTransitions(w ...*WorkflowTransition) *ProjectCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pc.AddWorkflowTransitionIDs(ids...)
+}
+
+// AddSprintIDs adds the "sprints" edge to the Sprint entity by IDs.
+func (pc *ProjectCreate) AddSprintIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddSprintIDs(ids...)
+	return pc
+}
+
+// AddSprints adds the "sprints" edges to the Sprint entity.
+func (pc *ProjectCreate) AddSprints(s ...*Sprint) *ProjectCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddSprintIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (pc *ProjectCreate) AddMilestoneIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddMilestoneIDs(ids...)
+	return pc
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (pc *ProjectCreate) AddMilestones(m ...*Milestone) *ProjectCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pc.AddMilestoneIDs(ids...)
+}
+
+// AddTaskTemplateIDs adds the "task_templates" edge to the TaskTemplate entity by IDs.
+func (pc *ProjectCreate) AddTaskTemplateIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddTaskTemplateIDs(ids...)
+	return pc
+}
+
+// AddTaskTemplates adds the "task_templates" edges to the TaskTemplate entity.
+func (pc *ProjectCreate) AddTaskTemplates(t ...*TaskTemplate) *ProjectCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTaskTemplateIDs(ids...)
+}
+
+// AddTaskViewIDs adds the "task_views" edge to the TaskView entity by IDs.
+func (pc *ProjectCreate) AddTaskViewIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddTaskViewIDs(ids...)
+	return pc
+}
+
+// AddTaskViews adds the "task_views" edges to the TaskView entity.
+func (pc *ProjectCreate) AddTaskViews(t ...*TaskView) *ProjectCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTaskViewIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the ProjectRole entity by IDs.
+func (pc *ProjectCreate) AddRoleIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddRoleIDs(ids...)
+	return pc
+}
+
+// AddRoles adds the "roles" edges to the ProjectRole entity.
+func (pc *ProjectCreate) AddRoles(p ...*ProjectRole) *ProjectCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddRoleIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (pc *ProjectCreate) Mutation() *ProjectMutation {
 	return pc.mutation
@@ -243,6 +413,14 @@ func (pc *ProjectCreate) defaults() {
 		v := project.DefaultStatus
 		pc.mutation.SetStatus(v)
 	}
+	if _, ok := pc.mutation.ManualProgress(); !ok {
+		v := project.DefaultManualProgress
+		pc.mutation.SetManualProgress(v)
+	}
+	if _, ok := pc.mutation.TaskSeq(); !ok {
+		v := project.DefaultTaskSeq
+		pc.mutation.SetTaskSeq(v)
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		v := project.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
@@ -278,6 +456,12 @@ func (pc *ProjectCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Project.status": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.ManualProgress(); !ok {
+		return &ValidationError{Name: "manual_progress", err: errors.New(`ent: missing required field "Project.manual_progress"`)}
+	}
+	if _, ok := pc.mutation.TaskSeq(); !ok {
+		return &ValidationError{Name: "task_seq", err: errors.New(`ent: missing required field "Project.task_seq"`)}
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Project.created_at"`)}
 	}
@@ -348,6 +532,18 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := pc.mutation.ManualProgress(); ok {
+		_spec.SetField(project.FieldManualProgress, field.TypeBool, value)
+		_node.ManualProgress = value
+	}
+	if value, ok := pc.mutation.Key(); ok {
+		_spec.SetField(project.FieldKey, field.TypeString, value)
+		_node.Key = &value
+	}
+	if value, ok := pc.mutation.TaskSeq(); ok {
+		_spec.SetField(project.FieldTaskSeq, field.TypeInt, value)
+		_node.TaskSeq = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -439,6 +635,134 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.OvertimeRequestsTable,
+			Columns: []string{project.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.WorkflowStatusesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStatusesTable,
+			Columns: []string{project.WorkflowStatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.WorkflowTransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowTransitionsTable,
+			Columns: []string{project.WorkflowTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.SprintsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SprintsTable,
+			Columns: []string{project.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.TaskTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskTemplatesTable,
+			Columns: []string{project.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.TaskViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskViewsTable,
+			Columns: []string{project.TaskViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -641,6 +965,54 @@ func (u *ProjectUpsert) UpdateStatus() *ProjectUpsert {
 	return u
 }
 
+// SetManualProgress sets the "manual_progress" field.
+func (u *ProjectUpsert) SetManualProgress(v bool) *ProjectUpsert {
+	u.Set(project.FieldManualProgress, v)
+	return u
+}
+
+// UpdateManualProgress sets the "manual_progress" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateManualProgress() *ProjectUpsert {
+	u.SetExcluded(project.FieldManualProgress)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *ProjectUpsert) SetKey(v string) *ProjectUpsert {
+	u.Set(project.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateKey() *ProjectUpsert {
+	u.SetExcluded(project.FieldKey)
+	return u
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *ProjectUpsert) ClearKey() *ProjectUpsert {
+	u.SetNull(project.FieldKey)
+	return u
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (u *ProjectUpsert) SetTaskSeq(v int) *ProjectUpsert {
+	u.Set(project.FieldTaskSeq, v)
+	return u
+}
+
+// UpdateTaskSeq sets the "task_seq" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateTaskSeq() *ProjectUpsert {
+	u.SetExcluded(project.FieldTaskSeq)
+	return u
+}
+
+// AddTaskSeq adds v to the "task_seq" field.
+func (u *ProjectUpsert) AddTaskSeq(v int) *ProjectUpsert {
+	u.Add(project.FieldTaskSeq, v)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ProjectUpsert) SetUpdatedAt(v time.Time) *ProjectUpsert {
 	u.Set(project.FieldUpdatedAt, v)
@@ -873,6 +1245,62 @@ func (u *ProjectUpsertOne) UpdateStatus() *ProjectUpsertOne {
 	})
 }
 
+// SetManualProgress sets the "manual_progress" field.
+func (u *ProjectUpsertOne) SetManualProgress(v bool) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetManualProgress(v)
+	})
+}
+
+// UpdateManualProgress sets the "manual_progress" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateManualProgress() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateManualProgress()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *ProjectUpsertOne) SetKey(v string) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateKey() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *ProjectUpsertOne) ClearKey() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearKey()
+	})
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (u *ProjectUpsertOne) SetTaskSeq(v int) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetTaskSeq(v)
+	})
+}
+
+// AddTaskSeq adds v to the "task_seq" field.
+func (u *ProjectUpsertOne) AddTaskSeq(v int) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.AddTaskSeq(v)
+	})
+}
+
+// UpdateTaskSeq sets the "task_seq" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateTaskSeq() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateTaskSeq()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ProjectUpsertOne) SetUpdatedAt(v time.Time) *ProjectUpsertOne {
 	return u.Update(func(s *ProjectUpsert) {
@@ -1273,6 +1701,62 @@ func (u *ProjectUpsertBulk) UpdateStatus() *ProjectUpsertBulk {
 	})
 }
 
+// SetManualProgress sets the "manual_progress" field.
+func (u *ProjectUpsertBulk) SetManualProgress(v bool) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetManualProgress(v)
+	})
+}
+
+// UpdateManualProgress sets the "manual_progress" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateManualProgress() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateManualProgress()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *ProjectUpsertBulk) SetKey(v string) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateKey() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// ClearKey clears the value of the "key" field.
+func (u *ProjectUpsertBulk) ClearKey() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearKey()
+	})
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (u *ProjectUpsertBulk) SetTaskSeq(v int) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetTaskSeq(v)
+	})
+}
+
+// AddTaskSeq adds v to the "task_seq" field.
+func (u *ProjectUpsertBulk) AddTaskSeq(v int) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.AddTaskSeq(v)
+	})
+}
+
+// UpdateTaskSeq sets the "task_seq" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateTaskSeq() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateTaskSeq()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ProjectUpsertBulk) SetUpdatedAt(v time.Time) *ProjectUpsertBulk {
 	return u.Update(func(s *ProjectUpsert) {
@@ -13,24 +13,40 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
 )
 
 // ProjectQuery is the builder for querying Project entities.
 type ProjectQuery struct {
 	config
-	ctx              *QueryContext
-	order            []project.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Project
-	withTasks        *TaskQuery
-	withOrganization *OrganizationQuery
-	withCreator      *EmployeeQuery
-	withUpdater      *EmployeeQuery
-	withMembers      *EmployeeQuery
+	ctx                     *QueryContext
+	order                   []project.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Project
+	withTasks               *TaskQuery
+	withOrganization        *OrganizationQuery
+	withCreator             *EmployeeQuery
+	withUpdater             *EmployeeQuery
+	withMembers             *EmployeeQuery
+	withOvertimeRequests    *OvertimeRequestQuery
+	withWorkflowStatuses    *WorkflowStatusQuery
+	withWorkflowTransitions *WorkflowTransitionQuery
+	withSprints             *SprintQuery
+	withMilestones          *MilestoneQuery
+	withTaskTemplates       *TaskTemplateQuery
+	withTaskViews           *TaskViewQuery
+	withRoles               *ProjectRoleQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -177,6 +193,182 @@ func (pq *ProjectQuery) QueryMembers() *EmployeeQuery {
 	return query
 }
 
+// QueryOvertimeRequests chains the current query on the "overtime_requests" edge.
+func (pq *ProjectQuery) QueryOvertimeRequests() *OvertimeRequestQuery {
+	query := (&OvertimeRequestClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(overtimerequest.Table, overtimerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.OvertimeRequestsTable, project.OvertimeRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWorkflowStatuses chains the current query on the "workflow_statuses" edge.
+func (pq *ProjectQuery) QueryWorkflowStatuses() *WorkflowStatusQuery {
+	query := (&WorkflowStatusClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(workflowstatus.Table, workflowstatus.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStatusesTable, project.WorkflowStatusesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWorkflowTransitions chains the current query on the "workflow_transitions" edge.
+func (pq *ProjectQuery) QueryWorkflowTransitions() *WorkflowTransitionQuery {
+	query := (&WorkflowTransitionClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(workflowtransition.Table, workflowtransition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowTransitionsTable, project.WorkflowTransitionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySprints chains the current query on the "sprints" edge.
+func (pq *ProjectQuery) QuerySprints() *SprintQuery {
+	query := (&SprintClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(sprint.Table, sprint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.SprintsTable, project.SprintsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMilestones chains the current query on the "milestones" edge.
+func (pq *ProjectQuery) QueryMilestones() *MilestoneQuery {
+	query := (&MilestoneClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(milestone.Table, milestone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.MilestonesTable, project.MilestonesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTaskTemplates chains the current query on the "task_templates" edge.
+func (pq *ProjectQuery) QueryTaskTemplates() *TaskTemplateQuery {
+	query := (&TaskTemplateClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(tasktemplate.Table, tasktemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TaskTemplatesTable, project.TaskTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTaskViews chains the current query on the "task_views" edge.
+func (pq *ProjectQuery) QueryTaskViews() *TaskViewQuery {
+	query := (&TaskViewClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(taskview.Table, taskview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TaskViewsTable, project.TaskViewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRoles chains the current query on the "roles" edge.
+func (pq *ProjectQuery) QueryRoles() *ProjectRoleQuery {
+	query := (&ProjectRoleClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(projectrole.Table, projectrole.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.RolesTable, project.RolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Project entity from the query.
 // Returns a *NotFoundError when no Project was found.
 func (pq *ProjectQuery) First(ctx context.Context) (*Project, error) {
@@ -364,16 +556,24 @@ func (pq *ProjectQuery) Clone() *ProjectQuery {
 		return nil
 	}
 	return &ProjectQuery{
-		config:           pq.config,
-		ctx:              pq.ctx.Clone(),
-		order:            append([]project.OrderOption{}, pq.order...),
-		inters:           append([]Interceptor{}, pq.inters...),
-		predicates:       append([]predicate.Project{}, pq.predicates...),
-		withTasks:        pq.withTasks.Clone(),
-		withOrganization: pq.withOrganization.Clone(),
-		withCreator:      pq.withCreator.Clone(),
-		withUpdater:      pq.withUpdater.Clone(),
-		withMembers:      pq.withMembers.Clone(),
+		config:                  pq.config,
+		ctx:                     pq.ctx.Clone(),
+		order:                   append([]project.OrderOption{}, pq.order...),
+		inters:                  append([]Interceptor{}, pq.inters...),
+		predicates:              append([]predicate.Project{}, pq.predicates...),
+		withTasks:               pq.withTasks.Clone(),
+		withOrganization:        pq.withOrganization.Clone(),
+		withCreator:             pq.withCreator.Clone(),
+		withUpdater:             pq.withUpdater.Clone(),
+		withMembers:             pq.withMembers.Clone(),
+		withOvertimeRequests:    pq.withOvertimeRequests.Clone(),
+		withWorkflowStatuses:    pq.withWorkflowStatuses.Clone(),
+		withWorkflowTransitions: pq.withWorkflowTransitions.Clone(),
+		withSprints:             pq.withSprints.Clone(),
+		withMilestones:          pq.withMilestones.Clone(),
+		withTaskTemplates:       pq.withTaskTemplates.Clone(),
+		withTaskViews:           pq.withTaskViews.Clone(),
+		withRoles:               pq.withRoles.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -435,6 +635,94 @@ func (pq *ProjectQuery) WithMembers(opts ...func(*EmployeeQuery)) *ProjectQuery
 	return pq
 }
 
+// WithOvertimeRequests tells the query-builder to eager-load the nodes that are connected to
+// the "overtime_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithOvertimeRequests(opts ...func(*OvertimeRequestQuery)) *ProjectQuery {
+	query := (&OvertimeRequestClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withOvertimeRequests = query
+	return pq
+}
+
+// WithWorkflowStatuses tells the query-builder to eager-load the nodes that are connected to
+// the "workflow_statuses" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithWorkflowStatuses(opts ...func(*WorkflowStatusQuery)) *ProjectQuery {
+	query := (&WorkflowStatusClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withWorkflowStatuses = query
+	return pq
+}
+
+// WithWorkflowTransitions tells the query-builder to eager-load the nodes that are connected to
+// the "workflow_transitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithWorkflowTransitions(opts ...func(*WorkflowTransitionQuery)) *ProjectQuery {
+	query := (&WorkflowTransitionClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withWorkflowTransitions = query
+	return pq
+}
+
+// WithSprints tells the query-builder to eager-load the nodes that are connected to
+// the "sprints" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithSprints(opts ...func(*SprintQuery)) *ProjectQuery {
+	query := (&SprintClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSprints = query
+	return pq
+}
+
+// WithMilestones tells the query-builder to eager-load the nodes that are connected to
+// the "milestones" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithMilestones(opts ...func(*MilestoneQuery)) *ProjectQuery {
+	query := (&MilestoneClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withMilestones = query
+	return pq
+}
+
+// WithTaskTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "task_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithTaskTemplates(opts ...func(*TaskTemplateQuery)) *ProjectQuery {
+	query := (&TaskTemplateClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withTaskTemplates = query
+	return pq
+}
+
+// WithTaskViews tells the query-builder to eager-load the nodes that are connected to
+// the "task_views" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithTaskViews(opts ...func(*TaskViewQuery)) *ProjectQuery {
+	query := (&TaskViewClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withTaskViews = query
+	return pq
+}
+
+// WithRoles tells the query-builder to eager-load the nodes that are connected to
+// the "roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithRoles(opts ...func(*ProjectRoleQuery)) *ProjectQuery {
+	query := (&ProjectRoleClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRoles = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -513,12 +801,20 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 	var (
 		nodes       = []*Project{}
 		_spec       = pq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [13]bool{
 			pq.withTasks != nil,
 			pq.withOrganization != nil,
 			pq.withCreator != nil,
 			pq.withUpdater != nil,
 			pq.withMembers != nil,
+			pq.withOvertimeRequests != nil,
+			pq.withWorkflowStatuses != nil,
+			pq.withWorkflowTransitions != nil,
+			pq.withSprints != nil,
+			pq.withMilestones != nil,
+			pq.withTaskTemplates != nil,
+			pq.withTaskViews != nil,
+			pq.withRoles != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -571,6 +867,64 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			return nil, err
 		}
 	}
+	if query := pq.withOvertimeRequests; query != nil {
+		if err := pq.loadOvertimeRequests(ctx, query, nodes,
+			func(n *Project) { n.Edges.OvertimeRequests = []*OvertimeRequest{} },
+			func(n *Project, e *OvertimeRequest) { n.Edges.OvertimeRequests = append(n.Edges.OvertimeRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withWorkflowStatuses; query != nil {
+		if err := pq.loadWorkflowStatuses(ctx, query, nodes,
+			func(n *Project) { n.Edges.WorkflowStatuses = []*WorkflowStatus{} },
+			func(n *Project, e *WorkflowStatus) { n.Edges.WorkflowStatuses = append(n.Edges.WorkflowStatuses, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withWorkflowTransitions; query != nil {
+		if err := pq.loadWorkflowTransitions(ctx, query, nodes,
+			func(n *Project) { n.Edges.WorkflowTransitions = []*WorkflowTransition{} },
+			func(n *Project, e *WorkflowTransition) {
+				n.Edges.WorkflowTransitions = append(n.Edges.WorkflowTransitions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withSprints; query != nil {
+		if err := pq.loadSprints(ctx, query, nodes,
+			func(n *Project) { n.Edges.Sprints = []*Sprint{} },
+			func(n *Project, e *Sprint) { n.Edges.Sprints = append(n.Edges.Sprints, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withMilestones; query != nil {
+		if err := pq.loadMilestones(ctx, query, nodes,
+			func(n *Project) { n.Edges.Milestones = []*Milestone{} },
+			func(n *Project, e *Milestone) { n.Edges.Milestones = append(n.Edges.Milestones, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withTaskTemplates; query != nil {
+		if err := pq.loadTaskTemplates(ctx, query, nodes,
+			func(n *Project) { n.Edges.TaskTemplates = []*TaskTemplate{} },
+			func(n *Project, e *TaskTemplate) { n.Edges.TaskTemplates = append(n.Edges.TaskTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withTaskViews; query != nil {
+		if err := pq.loadTaskViews(ctx, query, nodes,
+			func(n *Project) { n.Edges.TaskViews = []*TaskView{} },
+			func(n *Project, e *TaskView) { n.Edges.TaskViews = append(n.Edges.TaskViews, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withRoles; query != nil {
+		if err := pq.loadRoles(ctx, query, nodes,
+			func(n *Project) { n.Edges.Roles = []*ProjectRole{} },
+			func(n *Project, e *ProjectRole) { n.Edges.Roles = append(n.Edges.Roles, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -752,6 +1106,249 @@ func (pq *ProjectQuery) loadMembers(ctx context.Context, query *EmployeeQuery, n
 	}
 	return nil
 }
+func (pq *ProjectQuery) loadOvertimeRequests(ctx context.Context, query *OvertimeRequestQuery, nodes []*Project, init func(*Project), assign func(*Project, *OvertimeRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(overtimerequest.FieldProjectID)
+	}
+	query.Where(predicate.OvertimeRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.OvertimeRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "project_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadWorkflowStatuses(ctx context.Context, query *WorkflowStatusQuery, nodes []*Project, init func(*Project), assign func(*Project, *WorkflowStatus)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(workflowstatus.FieldProjectID)
+	}
+	query.Where(predicate.WorkflowStatus(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.WorkflowStatusesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadWorkflowTransitions(ctx context.Context, query *WorkflowTransitionQuery, nodes []*Project, init func(*Project), assign func(*Project, *WorkflowTransition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(workflowtransition.FieldProjectID)
+	}
+	query.Where(predicate.WorkflowTransition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.WorkflowTransitionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadSprints(ctx context.Context, query *SprintQuery, nodes []*Project, init func(*Project), assign func(*Project, *Sprint)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sprint.FieldProjectID)
+	}
+	query.Where(predicate.Sprint(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.SprintsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadMilestones(ctx context.Context, query *MilestoneQuery, nodes []*Project, init func(*Project), assign func(*Project, *Milestone)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(milestone.FieldProjectID)
+	}
+	query.Where(predicate.Milestone(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.MilestonesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadTaskTemplates(ctx context.Context, query *TaskTemplateQuery, nodes []*Project, init func(*Project), assign func(*Project, *TaskTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tasktemplate.FieldProjectID)
+	}
+	query.Where(predicate.TaskTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.TaskTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadTaskViews(ctx context.Context, query *TaskViewQuery, nodes []*Project, init func(*Project), assign func(*Project, *TaskView)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskview.FieldProjectID)
+	}
+	query.Where(predicate.TaskView(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.TaskViewsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadRoles(ctx context.Context, query *ProjectRoleQuery, nodes []*Project, init func(*Project), assign func(*Project, *ProjectRole)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(projectrole.FieldProjectID)
+	}
+	query.Where(predicate.ProjectRole(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.RolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *ProjectQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
@@ -12,10 +12,18 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
 )
 
 // ProjectUpdate is the builder for updating Project entities.
@@ -202,6 +210,61 @@ func (pu *ProjectUpdate) SetNillableStatus(pr *project.Status) *ProjectUpdate {
 	return pu
 }
 
+// SetManualProgress sets the "manual_progress" field.
+func (pu *ProjectUpdate) SetManualProgress(b bool) *ProjectUpdate {
+	pu.mutation.SetManualProgress(b)
+	return pu
+}
+
+// SetNillableManualProgress sets the "manual_progress" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableManualProgress(b *bool) *ProjectUpdate {
+	if b != nil {
+		pu.SetManualProgress(*b)
+	}
+	return pu
+}
+
+// SetKey sets the "key" field.
+func (pu *ProjectUpdate) SetKey(s string) *ProjectUpdate {
+	pu.mutation.SetKey(s)
+	return pu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableKey(s *string) *ProjectUpdate {
+	if s != nil {
+		pu.SetKey(*s)
+	}
+	return pu
+}
+
+// ClearKey clears the value of the "key" field.
+func (pu *ProjectUpdate) ClearKey() *ProjectUpdate {
+	pu.mutation.ClearKey()
+	return pu
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (pu *ProjectUpdate) SetTaskSeq(i int) *ProjectUpdate {
+	pu.mutation.ResetTaskSeq()
+	pu.mutation.SetTaskSeq(i)
+	return pu
+}
+
+// SetNillableTaskSeq sets the "task_seq" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableTaskSeq(i *int) *ProjectUpdate {
+	if i != nil {
+		pu.SetTaskSeq(*i)
+	}
+	return pu
+}
+
+// AddTaskSeq adds i to the "task_seq" field.
+func (pu *ProjectUpdate) AddTaskSeq(i int) *ProjectUpdate {
+	pu.mutation.AddTaskSeq(i)
+	return pu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pu *ProjectUpdate) SetUpdatedAt(t time.Time) *ProjectUpdate {
 	pu.mutation.SetUpdatedAt(t)
@@ -259,6 +322,126 @@ func (pu *ProjectUpdate) AddMembers(e ...*Employee) *ProjectUpdate {
 	return pu.AddMemberIDs(ids...)
 }
 
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (pu *ProjectUpdate) AddOvertimeRequestIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddOvertimeRequestIDs(ids...)
+	return pu
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (pu *ProjectUpdate) AddOvertimeRequests(o ...*OvertimeRequest) *ProjectUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.AddOvertimeRequestIDs(ids...)
+}
+
+// AddWorkflowStatusIDs adds the "workflow_statuses" edge to the WorkflowStatus entity by IDs.
+func (pu *ProjectUpdate) AddWorkflowStatusIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddWorkflowStatusIDs(ids...)
+	return pu
+}
+
+// AddWorkflowStatuses adds the "workflow_statuses" edges to the WorkflowStatus entity.
+func (pu *ProjectUpdate) AddWorkflowStatuses(w ...*WorkflowStatus) *ProjectUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.AddWorkflowStatusIDs(ids...)
+}
+
+// AddWorkflowTransitionIDs adds the "workflow_transitions" edge to the WorkflowTransition entity by IDs.
+func (pu *ProjectUpdate) AddWorkflowTransitionIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddWorkflowTransitionIDs(ids...)
+	return pu
+}
+
+// AddWorkflowTransitions adds the "workflow_transitions" edges to the WorkflowTransition entity.
+func (pu *ProjectUpdate) AddWorkflowTransitions(w ...*WorkflowTransition) *ProjectUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.AddWorkflowTransitionIDs(ids...)
+}
+
+// AddSprintIDs adds the "sprints" edge to the Sprint entity by IDs.
+func (pu *ProjectUpdate) AddSprintIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddSprintIDs(ids...)
+	return pu
+}
+
+// AddSprints adds the "sprints" edges to the Sprint entity.
+func (pu *ProjectUpdate) AddSprints(s ...*Sprint) *ProjectUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddSprintIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (pu *ProjectUpdate) AddMilestoneIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddMilestoneIDs(ids...)
+	return pu
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (pu *ProjectUpdate) AddMilestones(m ...*Milestone) *ProjectUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pu.AddMilestoneIDs(ids...)
+}
+
+// AddTaskTemplateIDs adds the "task_templates" edge to the TaskTemplate entity by IDs.
+func (pu *ProjectUpdate) AddTaskTemplateIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddTaskTemplateIDs(ids...)
+	return pu
+}
+
+// AddTaskTemplates adds the "task_templates" edges to the TaskTemplate entity.
+func (pu *ProjectUpdate) AddTaskTemplates(t ...*TaskTemplate) *ProjectUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTaskTemplateIDs(ids...)
+}
+
+// AddTaskViewIDs adds the "task_views" edge to the TaskView entity by IDs.
+func (pu *ProjectUpdate) AddTaskViewIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddTaskViewIDs(ids...)
+	return pu
+}
+
+// AddTaskViews adds the "task_views" edges to the TaskView entity.
+func (pu *ProjectUpdate) AddTaskViews(t ...*TaskView) *ProjectUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTaskViewIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the ProjectRole entity by IDs.
+func (pu *ProjectUpdate) AddRoleIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddRoleIDs(ids...)
+	return pu
+}
+
+// AddRoles adds the "roles" edges to the ProjectRole entity.
+func (pu *ProjectUpdate) AddRoles(p ...*ProjectRole) *ProjectUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddRoleIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (pu *ProjectUpdate) Mutation() *ProjectMutation {
 	return pu.mutation
@@ -324,6 +507,174 @@ func (pu *ProjectUpdate) RemoveMembers(e ...*Employee) *ProjectUpdate {
 	return pu.RemoveMemberIDs(ids...)
 }
 
+// ClearOvertimeRequests clears all "overtime_requests" edges to the OvertimeRequest entity.
+func (pu *ProjectUpdate) ClearOvertimeRequests() *ProjectUpdate {
+	pu.mutation.ClearOvertimeRequests()
+	return pu
+}
+
+// RemoveOvertimeRequestIDs removes the "overtime_requests" edge to OvertimeRequest entities by IDs.
+func (pu *ProjectUpdate) RemoveOvertimeRequestIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveOvertimeRequestIDs(ids...)
+	return pu
+}
+
+// RemoveOvertimeRequests removes "overtime_requests" edges to OvertimeRequest entities.
+func (pu *ProjectUpdate) RemoveOvertimeRequests(o ...*OvertimeRequest) *ProjectUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.RemoveOvertimeRequestIDs(ids...)
+}
+
+// ClearWorkflowStatuses clears all "workflow_statuses" edges to the WorkflowStatus entity.
+func (pu *ProjectUpdate) ClearWorkflowStatuses() *ProjectUpdate {
+	pu.mutation.ClearWorkflowStatuses()
+	return pu
+}
+
+// RemoveWorkflowStatusIDs removes the "workflow_statuses" edge to WorkflowStatus entities by IDs.
+func (pu *ProjectUpdate) RemoveWorkflowStatusIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveWorkflowStatusIDs(ids...)
+	return pu
+}
+
+// RemoveWorkflowStatuses removes "workflow_statuses" edges to WorkflowStatus entities.
+func (pu *ProjectUpdate) RemoveWorkflowStatuses(w ...*WorkflowStatus) *ProjectUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.RemoveWorkflowStatusIDs(ids...)
+}
+
+// ClearWorkflowTransitions clears all "workflow_transitions" edges to the WorkflowTransition entity.
+func (pu *ProjectUpdate) ClearWorkflowTransitions() *ProjectUpdate {
+	pu.mutation.ClearWorkflowTransitions()
+	return pu
+}
+
+// RemoveWorkflowTransitionIDs removes the "workflow_transitions" edge to WorkflowTransition entities by IDs.
+func (pu *ProjectUpdate) RemoveWorkflowTransitionIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveWorkflowTransitionIDs(ids...)
+	return pu
+}
+
+// RemoveWorkflowTransitions removes "workflow_transitions" edges to WorkflowTransition entities.
+func (pu *ProjectUpdate) RemoveWorkflowTransitions(w ...*WorkflowTransition) *ProjectUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.RemoveWorkflowTransitionIDs(ids...)
+}
+
+// ClearSprints clears all "sprints" edges to the Sprint entity.
+func (pu *ProjectUpdate) ClearSprints() *ProjectUpdate {
+	pu.mutation.ClearSprints()
+	return pu
+}
+
+// RemoveSprintIDs removes the "sprints" edge to Sprint entities by IDs.
+func (pu *ProjectUpdate) RemoveSprintIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveSprintIDs(ids...)
+	return pu
+}
+
+// RemoveSprints removes "sprints" edges to Sprint entities.
+func (pu *ProjectUpdate) RemoveSprints(s ...*Sprint) *ProjectUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveSprintIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the Milestone entity.
+func (pu *ProjectUpdate) ClearMilestones() *ProjectUpdate {
+	pu.mutation.ClearMilestones()
+	return pu
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to Milestone entities by IDs.
+func (pu *ProjectUpdate) RemoveMilestoneIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveMilestoneIDs(ids...)
+	return pu
+}
+
+// RemoveMilestones removes "milestones" edges to Milestone entities.
+func (pu *ProjectUpdate) RemoveMilestones(m ...*Milestone) *ProjectUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pu.RemoveMilestoneIDs(ids...)
+}
+
+// ClearTaskTemplates clears all "task_templates" edges to the TaskTemplate entity.
+func (pu *ProjectUpdate) ClearTaskTemplates() *ProjectUpdate {
+	pu.mutation.ClearTaskTemplates()
+	return pu
+}
+
+// RemoveTaskTemplateIDs removes the "task_templates" edge to TaskTemplate entities by IDs.
+func (pu *ProjectUpdate) RemoveTaskTemplateIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveTaskTemplateIDs(ids...)
+	return pu
+}
+
+// RemoveTaskTemplates removes "task_templates" edges to TaskTemplate entities.
+func (pu *ProjectUpdate) RemoveTaskTemplates(t ...*TaskTemplate) *ProjectUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTaskTemplateIDs(ids...)
+}
+
+// ClearTaskViews clears all "task_views" edges to the TaskView entity.
+func (pu *ProjectUpdate) ClearTaskViews() *ProjectUpdate {
+	pu.mutation.ClearTaskViews()
+	return pu
+}
+
+// RemoveTaskViewIDs removes the "task_views" edge to TaskView entities by IDs.
+func (pu *ProjectUpdate) RemoveTaskViewIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveTaskViewIDs(ids...)
+	return pu
+}
+
+// RemoveTaskViews removes "task_views" edges to TaskView entities.
+func (pu *ProjectUpdate) RemoveTaskViews(t ...*TaskView) *ProjectUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTaskViewIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the ProjectRole entity.
+func (pu *ProjectUpdate) ClearRoles() *ProjectUpdate {
+	pu.mutation.ClearRoles()
+	return pu
+}
+
+// RemoveRoleIDs removes the "roles" edge to ProjectRole entities by IDs.
+func (pu *ProjectUpdate) RemoveRoleIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveRoleIDs(ids...)
+	return pu
+}
+
+// RemoveRoles removes "roles" edges to ProjectRole entities.
+func (pu *ProjectUpdate) RemoveRoles(p ...*ProjectRole) *ProjectUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveRoleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProjectUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -427,6 +778,21 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Status(); ok {
 		_spec.SetField(project.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := pu.mutation.ManualProgress(); ok {
+		_spec.SetField(project.FieldManualProgress, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.Key(); ok {
+		_spec.SetField(project.FieldKey, field.TypeString, value)
+	}
+	if pu.mutation.KeyCleared() {
+		_spec.ClearField(project.FieldKey, field.TypeString)
+	}
+	if value, ok := pu.mutation.TaskSeq(); ok {
+		_spec.SetField(project.FieldTaskSeq, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedTaskSeq(); ok {
+		_spec.AddField(project.FieldTaskSeq, field.TypeInt, value)
+	}
 	if value, ok := pu.mutation.UpdatedAt(); ok {
 		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -607,74 +973,434 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{project.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if pu.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.OvertimeRequestsTable,
+			Columns: []string{project.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
-	}
-	pu.mutation.done = true
-	return n, nil
-}
-
-// ProjectUpdateOne is the builder for updating a single Project entity.
-type ProjectUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProjectMutation
-}
-
-// SetName sets the "name" field.
-func (puo *ProjectUpdateOne) SetName(s string) *ProjectUpdateOne {
-	puo.mutation.SetName(s)
-	return puo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (puo *ProjectUpdateOne) SetNillableName(s *string) *ProjectUpdateOne {
-	if s != nil {
-		puo.SetName(*s)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return puo
-}
-
-// SetCode sets the "code" field.
-func (puo *ProjectUpdateOne) SetCode(s string) *ProjectUpdateOne {
-	puo.mutation.SetCode(s)
-	return puo
-}
-
-// SetNillableCode sets the "code" field if the given value is not nil.
-func (puo *ProjectUpdateOne) SetNillableCode(s *string) *ProjectUpdateOne {
-	if s != nil {
-		puo.SetCode(*s)
+	if nodes := pu.mutation.RemovedOvertimeRequestsIDs(); len(nodes) > 0 && !pu.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.OvertimeRequestsTable,
+			Columns: []string{project.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return puo
-}
-
-// SetDescription sets the "description" field.
-func (puo *ProjectUpdateOne) SetDescription(s string) *ProjectUpdateOne {
-	puo.mutation.SetDescription(s)
-	return puo
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (puo *ProjectUpdateOne) SetNillableDescription(s *string) *ProjectUpdateOne {
-	if s != nil {
-		puo.SetDescription(*s)
+	if nodes := pu.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.OvertimeRequestsTable,
+			Columns: []string{project.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.WorkflowStatusesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStatusesTable,
+			Columns: []string{project.WorkflowStatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedWorkflowStatusesIDs(); len(nodes) > 0 && !pu.mutation.WorkflowStatusesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStatusesTable,
+			Columns: []string{project.WorkflowStatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WorkflowStatusesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStatusesTable,
+			Columns: []string{project.WorkflowStatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.WorkflowTransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowTransitionsTable,
+			Columns: []string{project.WorkflowTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedWorkflowTransitionsIDs(); len(nodes) > 0 && !pu.mutation.WorkflowTransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowTransitionsTable,
+			Columns: []string{project.WorkflowTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WorkflowTransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowTransitionsTable,
+			Columns: []string{project.WorkflowTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.SprintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SprintsTable,
+			Columns: []string{project.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedSprintsIDs(); len(nodes) > 0 && !pu.mutation.SprintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SprintsTable,
+			Columns: []string{project.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SprintsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SprintsTable,
+			Columns: []string{project.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !pu.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.TaskTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskTemplatesTable,
+			Columns: []string{project.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTaskTemplatesIDs(); len(nodes) > 0 && !pu.mutation.TaskTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskTemplatesTable,
+			Columns: []string{project.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TaskTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskTemplatesTable,
+			Columns: []string{project.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.TaskViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskViewsTable,
+			Columns: []string{project.TaskViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTaskViewsIDs(); len(nodes) > 0 && !pu.mutation.TaskViewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskViewsTable,
+			Columns: []string{project.TaskViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TaskViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskViewsTable,
+			Columns: []string{project.TaskViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRolesIDs(); len(nodes) > 0 && !pu.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// ProjectUpdateOne is the builder for updating a single Project entity.
+type ProjectUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectMutation
+}
+
+// SetName sets the "name" field.
+func (puo *ProjectUpdateOne) SetName(s string) *ProjectUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableName(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetCode sets the "code" field.
+func (puo *ProjectUpdateOne) SetCode(s string) *ProjectUpdateOne {
+	puo.mutation.SetCode(s)
+	return puo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableCode(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetCode(*s)
+	}
+	return puo
+}
+
+// SetDescription sets the "description" field.
+func (puo *ProjectUpdateOne) SetDescription(s string) *ProjectUpdateOne {
+	puo.mutation.SetDescription(s)
+	return puo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableDescription(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetDescription(*s)
 	}
 	return puo
 }
 
-// ClearDescription clears the value of the "description" field.
-func (puo *ProjectUpdateOne) ClearDescription() *ProjectUpdateOne {
-	puo.mutation.ClearDescription()
-	return puo
-}
-
+// ClearDescription clears the value of the "description" field.
+func (puo *ProjectUpdateOne) ClearDescription() *ProjectUpdateOne {
+	puo.mutation.ClearDescription()
+	return puo
+}
+
 // SetStartAt sets the "start_at" field.
 func (puo *ProjectUpdateOne) SetStartAt(t time.Time) *ProjectUpdateOne {
 	puo.mutation.SetStartAt(t)
@@ -798,6 +1524,61 @@ func (puo *ProjectUpdateOne) SetNillableStatus(pr *project.Status) *ProjectUpdat
 	return puo
 }
 
+// SetManualProgress sets the "manual_progress" field.
+func (puo *ProjectUpdateOne) SetManualProgress(b bool) *ProjectUpdateOne {
+	puo.mutation.SetManualProgress(b)
+	return puo
+}
+
+// SetNillableManualProgress sets the "manual_progress" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableManualProgress(b *bool) *ProjectUpdateOne {
+	if b != nil {
+		puo.SetManualProgress(*b)
+	}
+	return puo
+}
+
+// SetKey sets the "key" field.
+func (puo *ProjectUpdateOne) SetKey(s string) *ProjectUpdateOne {
+	puo.mutation.SetKey(s)
+	return puo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableKey(s *string) *ProjectUpdateOne {
+	if s != nil {
+		puo.SetKey(*s)
+	}
+	return puo
+}
+
+// ClearKey clears the value of the "key" field.
+func (puo *ProjectUpdateOne) ClearKey() *ProjectUpdateOne {
+	puo.mutation.ClearKey()
+	return puo
+}
+
+// SetTaskSeq sets the "task_seq" field.
+func (puo *ProjectUpdateOne) SetTaskSeq(i int) *ProjectUpdateOne {
+	puo.mutation.ResetTaskSeq()
+	puo.mutation.SetTaskSeq(i)
+	return puo
+}
+
+// SetNillableTaskSeq sets the "task_seq" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableTaskSeq(i *int) *ProjectUpdateOne {
+	if i != nil {
+		puo.SetTaskSeq(*i)
+	}
+	return puo
+}
+
+// AddTaskSeq adds i to the "task_seq" field.
+func (puo *ProjectUpdateOne) AddTaskSeq(i int) *ProjectUpdateOne {
+	puo.mutation.AddTaskSeq(i)
+	return puo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (puo *ProjectUpdateOne) SetUpdatedAt(t time.Time) *ProjectUpdateOne {
 	puo.mutation.SetUpdatedAt(t)
@@ -846,78 +1627,366 @@ func (puo *ProjectUpdateOne) AddMemberIDs(ids ...int) *ProjectUpdateOne {
 	return puo
 }
 
-// AddMembers adds the "members" edges to the Employee entity.
-func (puo *ProjectUpdateOne) AddMembers(e ...*Employee) *ProjectUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
-	}
-	return puo.AddMemberIDs(ids...)
+// AddMembers adds the "members" edges to the Employee entity.
+func (puo *ProjectUpdateOne) AddMembers(e ...*Employee) *ProjectUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return puo.AddMemberIDs(ids...)
+}
+
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (puo *ProjectUpdateOne) AddOvertimeRequestIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddOvertimeRequestIDs(ids...)
+	return puo
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (puo *ProjectUpdateOne) AddOvertimeRequests(o ...*OvertimeRequest) *ProjectUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.AddOvertimeRequestIDs(ids...)
+}
+
+// AddWorkflowStatusIDs adds the "workflow_statuses" edge to the WorkflowStatus entity by IDs.
+func (puo *ProjectUpdateOne) AddWorkflowStatusIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddWorkflowStatusIDs(ids...)
+	return puo
+}
+
+// AddWorkflowStatuses adds the "workflow_statuses" edges to the WorkflowStatus entity.
+func (puo *ProjectUpdateOne) AddWorkflowStatuses(w ...*WorkflowStatus) *ProjectUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.AddWorkflowStatusIDs(ids...)
+}
+
+// AddWorkflowTransitionIDs adds the "workflow_transitions" edge to the WorkflowTransition entity by IDs.
+func (puo *ProjectUpdateOne) AddWorkflowTransitionIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddWorkflowTransitionIDs(ids...)
+	return puo
+}
+
+// AddWorkflowTransitions adds the "workflow_transitions" edges to the WorkflowTransition entity.
+func (puo *ProjectUpdateOne) AddWorkflowTransitions(w ...*WorkflowTransition) *ProjectUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.AddWorkflowTransitionIDs(ids...)
+}
+
+// AddSprintIDs adds the "sprints" edge to the Sprint entity by IDs.
+func (puo *ProjectUpdateOne) AddSprintIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddSprintIDs(ids...)
+	return puo
+}
+
+// AddSprints adds the "sprints" edges to the Sprint entity.
+func (puo *ProjectUpdateOne) AddSprints(s ...*Sprint) *ProjectUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddSprintIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (puo *ProjectUpdateOne) AddMilestoneIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddMilestoneIDs(ids...)
+	return puo
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (puo *ProjectUpdateOne) AddMilestones(m ...*Milestone) *ProjectUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return puo.AddMilestoneIDs(ids...)
+}
+
+// AddTaskTemplateIDs adds the "task_templates" edge to the TaskTemplate entity by IDs.
+func (puo *ProjectUpdateOne) AddTaskTemplateIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddTaskTemplateIDs(ids...)
+	return puo
+}
+
+// AddTaskTemplates adds the "task_templates" edges to the TaskTemplate entity.
+func (puo *ProjectUpdateOne) AddTaskTemplates(t ...*TaskTemplate) *ProjectUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTaskTemplateIDs(ids...)
+}
+
+// AddTaskViewIDs adds the "task_views" edge to the TaskView entity by IDs.
+func (puo *ProjectUpdateOne) AddTaskViewIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddTaskViewIDs(ids...)
+	return puo
+}
+
+// AddTaskViews adds the "task_views" edges to the TaskView entity.
+func (puo *ProjectUpdateOne) AddTaskViews(t ...*TaskView) *ProjectUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTaskViewIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the ProjectRole entity by IDs.
+func (puo *ProjectUpdateOne) AddRoleIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddRoleIDs(ids...)
+	return puo
+}
+
+// AddRoles adds the "roles" edges to the ProjectRole entity.
+func (puo *ProjectUpdateOne) AddRoles(p ...*ProjectRole) *ProjectUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddRoleIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (puo *ProjectUpdateOne) Mutation() *ProjectMutation {
+	return puo.mutation
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (puo *ProjectUpdateOne) ClearTasks() *ProjectUpdateOne {
+	puo.mutation.ClearTasks()
+	return puo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (puo *ProjectUpdateOne) RemoveTaskIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveTaskIDs(ids...)
+	return puo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (puo *ProjectUpdateOne) RemoveTasks(t ...*Task) *ProjectUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveTaskIDs(ids...)
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (puo *ProjectUpdateOne) ClearOrganization() *ProjectUpdateOne {
+	puo.mutation.ClearOrganization()
+	return puo
+}
+
+// ClearCreator clears the "creator" edge to the Employee entity.
+func (puo *ProjectUpdateOne) ClearCreator() *ProjectUpdateOne {
+	puo.mutation.ClearCreator()
+	return puo
+}
+
+// ClearUpdater clears the "updater" edge to the Employee entity.
+func (puo *ProjectUpdateOne) ClearUpdater() *ProjectUpdateOne {
+	puo.mutation.ClearUpdater()
+	return puo
+}
+
+// ClearMembers clears all "members" edges to the Employee entity.
+func (puo *ProjectUpdateOne) ClearMembers() *ProjectUpdateOne {
+	puo.mutation.ClearMembers()
+	return puo
+}
+
+// RemoveMemberIDs removes the "members" edge to Employee entities by IDs.
+func (puo *ProjectUpdateOne) RemoveMemberIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveMemberIDs(ids...)
+	return puo
+}
+
+// RemoveMembers removes "members" edges to Employee entities.
+func (puo *ProjectUpdateOne) RemoveMembers(e ...*Employee) *ProjectUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return puo.RemoveMemberIDs(ids...)
+}
+
+// ClearOvertimeRequests clears all "overtime_requests" edges to the OvertimeRequest entity.
+func (puo *ProjectUpdateOne) ClearOvertimeRequests() *ProjectUpdateOne {
+	puo.mutation.ClearOvertimeRequests()
+	return puo
+}
+
+// RemoveOvertimeRequestIDs removes the "overtime_requests" edge to OvertimeRequest entities by IDs.
+func (puo *ProjectUpdateOne) RemoveOvertimeRequestIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveOvertimeRequestIDs(ids...)
+	return puo
+}
+
+// RemoveOvertimeRequests removes "overtime_requests" edges to OvertimeRequest entities.
+func (puo *ProjectUpdateOne) RemoveOvertimeRequests(o ...*OvertimeRequest) *ProjectUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.RemoveOvertimeRequestIDs(ids...)
+}
+
+// ClearWorkflowStatuses clears all "workflow_statuses" edges to the WorkflowStatus entity.
+func (puo *ProjectUpdateOne) ClearWorkflowStatuses() *ProjectUpdateOne {
+	puo.mutation.ClearWorkflowStatuses()
+	return puo
+}
+
+// RemoveWorkflowStatusIDs removes the "workflow_statuses" edge to WorkflowStatus entities by IDs.
+func (puo *ProjectUpdateOne) RemoveWorkflowStatusIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveWorkflowStatusIDs(ids...)
+	return puo
+}
+
+// RemoveWorkflowStatuses removes "workflow_statuses" edges to WorkflowStatus entities.
+func (puo *ProjectUpdateOne) RemoveWorkflowStatuses(w ...*WorkflowStatus) *ProjectUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.RemoveWorkflowStatusIDs(ids...)
+}
+
+// ClearWorkflowTransitions clears all "workflow_transitions" edges to the WorkflowTransition entity.
+func (puo *ProjectUpdateOne) ClearWorkflowTransitions() *ProjectUpdateOne {
+	puo.mutation.ClearWorkflowTransitions()
+	return puo
+}
+
+// RemoveWorkflowTransitionIDs removes the "workflow_transitions" edge to WorkflowTransition entities by IDs.
+func (puo *ProjectUpdateOne) RemoveWorkflowTransitionIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveWorkflowTransitionIDs(ids...)
+	return puo
+}
+
+// RemoveWorkflowTransitions removes "workflow_transitions" edges to WorkflowTransition entities.
+func (puo *ProjectUpdateOne) RemoveWorkflowTransitions(w ...*WorkflowTransition) *ProjectUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.RemoveWorkflowTransitionIDs(ids...)
+}
+
+// ClearSprints clears all "sprints" edges to the Sprint entity.
+func (puo *ProjectUpdateOne) ClearSprints() *ProjectUpdateOne {
+	puo.mutation.ClearSprints()
+	return puo
+}
+
+// RemoveSprintIDs removes the "sprints" edge to Sprint entities by IDs.
+func (puo *ProjectUpdateOne) RemoveSprintIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveSprintIDs(ids...)
+	return puo
+}
+
+// RemoveSprints removes "sprints" edges to Sprint entities.
+func (puo *ProjectUpdateOne) RemoveSprints(s ...*Sprint) *ProjectUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveSprintIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the Milestone entity.
+func (puo *ProjectUpdateOne) ClearMilestones() *ProjectUpdateOne {
+	puo.mutation.ClearMilestones()
+	return puo
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to Milestone entities by IDs.
+func (puo *ProjectUpdateOne) RemoveMilestoneIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveMilestoneIDs(ids...)
+	return puo
 }
 
-// Mutation returns the ProjectMutation object of the builder.
-func (puo *ProjectUpdateOne) Mutation() *ProjectMutation {
-	return puo.mutation
+// RemoveMilestones removes "milestones" edges to Milestone entities.
+func (puo *ProjectUpdateOne) RemoveMilestones(m ...*Milestone) *ProjectUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return puo.RemoveMilestoneIDs(ids...)
 }
 
-// ClearTasks clears all "tasks" edges to the Task entity.
-func (puo *ProjectUpdateOne) ClearTasks() *ProjectUpdateOne {
-	puo.mutation.ClearTasks()
+// ClearTaskTemplates clears all "task_templates" edges to the TaskTemplate entity.
+func (puo *ProjectUpdateOne) ClearTaskTemplates() *ProjectUpdateOne {
+	puo.mutation.ClearTaskTemplates()
 	return puo
 }
 
-// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
-func (puo *ProjectUpdateOne) RemoveTaskIDs(ids ...int) *ProjectUpdateOne {
-	puo.mutation.RemoveTaskIDs(ids...)
+// RemoveTaskTemplateIDs removes the "task_templates" edge to TaskTemplate entities by IDs.
+func (puo *ProjectUpdateOne) RemoveTaskTemplateIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveTaskTemplateIDs(ids...)
 	return puo
 }
 
-// RemoveTasks removes "tasks" edges to Task entities.
-func (puo *ProjectUpdateOne) RemoveTasks(t ...*Task) *ProjectUpdateOne {
+// RemoveTaskTemplates removes "task_templates" edges to TaskTemplate entities.
+func (puo *ProjectUpdateOne) RemoveTaskTemplates(t ...*TaskTemplate) *ProjectUpdateOne {
 	ids := make([]int, len(t))
 	for i := range t {
 		ids[i] = t[i].ID
 	}
-	return puo.RemoveTaskIDs(ids...)
+	return puo.RemoveTaskTemplateIDs(ids...)
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (puo *ProjectUpdateOne) ClearOrganization() *ProjectUpdateOne {
-	puo.mutation.ClearOrganization()
+// ClearTaskViews clears all "task_views" edges to the TaskView entity.
+func (puo *ProjectUpdateOne) ClearTaskViews() *ProjectUpdateOne {
+	puo.mutation.ClearTaskViews()
 	return puo
 }
 
-// ClearCreator clears the "creator" edge to the Employee entity.
-func (puo *ProjectUpdateOne) ClearCreator() *ProjectUpdateOne {
-	puo.mutation.ClearCreator()
+// RemoveTaskViewIDs removes the "task_views" edge to TaskView entities by IDs.
+func (puo *ProjectUpdateOne) RemoveTaskViewIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveTaskViewIDs(ids...)
 	return puo
 }
 
-// ClearUpdater clears the "updater" edge to the Employee entity.
-func (puo *ProjectUpdateOne) ClearUpdater() *ProjectUpdateOne {
-	puo.mutation.ClearUpdater()
-	return puo
+// RemoveTaskViews removes "task_views" edges to TaskView entities.
+func (puo *ProjectUpdateOne) RemoveTaskViews(t ...*TaskView) *ProjectUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveTaskViewIDs(ids...)
 }
 
-// ClearMembers clears all "members" edges to the Employee entity.
-func (puo *ProjectUpdateOne) ClearMembers() *ProjectUpdateOne {
-	puo.mutation.ClearMembers()
+// ClearRoles clears all "roles" edges to the ProjectRole entity.
+func (puo *ProjectUpdateOne) ClearRoles() *ProjectUpdateOne {
+	puo.mutation.ClearRoles()
 	return puo
 }
 
-// RemoveMemberIDs removes the "members" edge to Employee entities by IDs.
-func (puo *ProjectUpdateOne) RemoveMemberIDs(ids ...int) *ProjectUpdateOne {
-	puo.mutation.RemoveMemberIDs(ids...)
+// RemoveRoleIDs removes the "roles" edge to ProjectRole entities by IDs.
+func (puo *ProjectUpdateOne) RemoveRoleIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveRoleIDs(ids...)
 	return puo
 }
 
-// RemoveMembers removes "members" edges to Employee entities.
-func (puo *ProjectUpdateOne) RemoveMembers(e ...*Employee) *ProjectUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
+// RemoveRoles removes "roles" edges to ProjectRole entities.
+func (puo *ProjectUpdateOne) RemoveRoles(p ...*ProjectRole) *ProjectUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return puo.RemoveMemberIDs(ids...)
+	return puo.RemoveRoleIDs(ids...)
 }
 
 // Where appends a list predicates to the ProjectUpdate builder.
@@ -1010,74 +2079,401 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 			}
 		}
 	}
-	if ps := puo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Code(); ok {
+		_spec.SetField(project.FieldCode, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+	}
+	if puo.mutation.DescriptionCleared() {
+		_spec.ClearField(project.FieldDescription, field.TypeString)
+	}
+	if value, ok := puo.mutation.StartAt(); ok {
+		_spec.SetField(project.FieldStartAt, field.TypeTime, value)
+	}
+	if puo.mutation.StartAtCleared() {
+		_spec.ClearField(project.FieldStartAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.EndAt(); ok {
+		_spec.SetField(project.FieldEndAt, field.TypeTime, value)
+	}
+	if puo.mutation.EndAtCleared() {
+		_spec.ClearField(project.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.Process(); ok {
+		_spec.SetField(project.FieldProcess, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedProcess(); ok {
+		_spec.AddField(project.FieldProcess, field.TypeInt, value)
+	}
+	if puo.mutation.ProcessCleared() {
+		_spec.ClearField(project.FieldProcess, field.TypeInt)
+	}
+	if value, ok := puo.mutation.Status(); ok {
+		_spec.SetField(project.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := puo.mutation.ManualProgress(); ok {
+		_spec.SetField(project.FieldManualProgress, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.Key(); ok {
+		_spec.SetField(project.FieldKey, field.TypeString, value)
+	}
+	if puo.mutation.KeyCleared() {
+		_spec.ClearField(project.FieldKey, field.TypeString)
+	}
+	if value, ok := puo.mutation.TaskSeq(); ok {
+		_spec.SetField(project.FieldTaskSeq, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedTaskSeq(); ok {
+		_spec.AddField(project.FieldTaskSeq, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !puo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.OrganizationTable,
+			Columns: []string{project.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.OrganizationTable,
+			Columns: []string{project.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.CreatorTable,
+			Columns: []string{project.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.CreatorTable,
+			Columns: []string{project.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.UpdaterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.UpdaterTable,
+			Columns: []string{project.UpdaterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.UpdaterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.UpdaterTable,
+			Columns: []string{project.UpdaterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !puo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.MembersTable,
+			Columns: project.MembersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := puo.mutation.Name(); ok {
-		_spec.SetField(project.FieldName, field.TypeString, value)
-	}
-	if value, ok := puo.mutation.Code(); ok {
-		_spec.SetField(project.FieldCode, field.TypeString, value)
-	}
-	if value, ok := puo.mutation.Description(); ok {
-		_spec.SetField(project.FieldDescription, field.TypeString, value)
-	}
-	if puo.mutation.DescriptionCleared() {
-		_spec.ClearField(project.FieldDescription, field.TypeString)
-	}
-	if value, ok := puo.mutation.StartAt(); ok {
-		_spec.SetField(project.FieldStartAt, field.TypeTime, value)
+	if puo.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.OvertimeRequestsTable,
+			Columns: []string{project.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if puo.mutation.StartAtCleared() {
-		_spec.ClearField(project.FieldStartAt, field.TypeTime)
+	if nodes := puo.mutation.RemovedOvertimeRequestsIDs(); len(nodes) > 0 && !puo.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.OvertimeRequestsTable,
+			Columns: []string{project.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := puo.mutation.EndAt(); ok {
-		_spec.SetField(project.FieldEndAt, field.TypeTime, value)
+	if nodes := puo.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.OvertimeRequestsTable,
+			Columns: []string{project.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.EndAtCleared() {
-		_spec.ClearField(project.FieldEndAt, field.TypeTime)
+	if puo.mutation.WorkflowStatusesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStatusesTable,
+			Columns: []string{project.WorkflowStatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := puo.mutation.Process(); ok {
-		_spec.SetField(project.FieldProcess, field.TypeInt, value)
+	if nodes := puo.mutation.RemovedWorkflowStatusesIDs(); len(nodes) > 0 && !puo.mutation.WorkflowStatusesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStatusesTable,
+			Columns: []string{project.WorkflowStatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := puo.mutation.AddedProcess(); ok {
-		_spec.AddField(project.FieldProcess, field.TypeInt, value)
+	if nodes := puo.mutation.WorkflowStatusesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStatusesTable,
+			Columns: []string{project.WorkflowStatusesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.ProcessCleared() {
-		_spec.ClearField(project.FieldProcess, field.TypeInt)
+	if puo.mutation.WorkflowTransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowTransitionsTable,
+			Columns: []string{project.WorkflowTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := puo.mutation.Status(); ok {
-		_spec.SetField(project.FieldStatus, field.TypeEnum, value)
+	if nodes := puo.mutation.RemovedWorkflowTransitionsIDs(); len(nodes) > 0 && !puo.mutation.WorkflowTransitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowTransitionsTable,
+			Columns: []string{project.WorkflowTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := puo.mutation.UpdatedAt(); ok {
-		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := puo.mutation.WorkflowTransitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowTransitionsTable,
+			Columns: []string{project.WorkflowTransitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.TasksCleared() {
+	if puo.mutation.SprintsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TasksTable,
-			Columns: []string{project.TasksColumn},
+			Table:   project.SprintsTable,
+			Columns: []string{project.SprintsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !puo.mutation.TasksCleared() {
+	if nodes := puo.mutation.RemovedSprintsIDs(); len(nodes) > 0 && !puo.mutation.SprintsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TasksTable,
-			Columns: []string{project.TasksColumn},
+			Table:   project.SprintsTable,
+			Columns: []string{project.SprintsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1085,15 +2481,15 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.TasksIDs(); len(nodes) > 0 {
+	if nodes := puo.mutation.SprintsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TasksTable,
-			Columns: []string{project.TasksColumn},
+			Table:   project.SprintsTable,
+			Columns: []string{project.SprintsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1101,28 +2497,44 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.OrganizationCleared() {
+	if puo.mutation.MilestonesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   project.OrganizationTable,
-			Columns: []string{project.OrganizationColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.OrganizationIDs(); len(nodes) > 0 {
+	if nodes := puo.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !puo.mutation.MilestonesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   project.OrganizationTable,
-			Columns: []string{project.OrganizationColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1130,28 +2542,44 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.CreatorCleared() {
+	if puo.mutation.TaskTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   project.CreatorTable,
-			Columns: []string{project.CreatorColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskTemplatesTable,
+			Columns: []string{project.TaskTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.CreatorIDs(); len(nodes) > 0 {
+	if nodes := puo.mutation.RemovedTaskTemplatesIDs(); len(nodes) > 0 && !puo.mutation.TaskTemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   project.CreatorTable,
-			Columns: []string{project.CreatorColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskTemplatesTable,
+			Columns: []string{project.TaskTemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TaskTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskTemplatesTable,
+			Columns: []string{project.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1159,28 +2587,44 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.UpdaterCleared() {
+	if puo.mutation.TaskViewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   project.UpdaterTable,
-			Columns: []string{project.UpdaterColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskViewsTable,
+			Columns: []string{project.TaskViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.UpdaterIDs(); len(nodes) > 0 {
+	if nodes := puo.mutation.RemovedTaskViewsIDs(); len(nodes) > 0 && !puo.mutation.TaskViewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   project.UpdaterTable,
-			Columns: []string{project.UpdaterColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskViewsTable,
+			Columns: []string{project.TaskViewsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TaskViewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TaskViewsTable,
+			Columns: []string{project.TaskViewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1188,28 +2632,28 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if puo.mutation.MembersCleared() {
+	if puo.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.MembersTable,
-			Columns: project.MembersPrimaryKey,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !puo.mutation.MembersCleared() {
+	if nodes := puo.mutation.RemovedRolesIDs(); len(nodes) > 0 && !puo.mutation.RolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.MembersTable,
-			Columns: project.MembersPrimaryKey,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1217,15 +2661,15 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := puo.mutation.MembersIDs(); len(nodes) > 0 {
+	if nodes := puo.mutation.RolesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.MembersTable,
-			Columns: project.MembersPrimaryKey,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+)
+
+// ProjectRole is the model entity for the ProjectRole schema.
+type ProjectRole struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// Role holds the value of the "role" field.
+	Role projectrole.Role `json:"role"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectRoleQuery when eager-loading is set.
+	Edges        ProjectRoleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProjectRoleEdges holds the relations/edges for other nodes in the graph.
+type ProjectRoleEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectRoleEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProjectRole) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case projectrole.FieldID, projectrole.FieldProjectID, projectrole.FieldEmployeeID:
+			values[i] = new(sql.NullInt64)
+		case projectrole.FieldRole:
+			values[i] = new(sql.NullString)
+		case projectrole.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProjectRole fields.
+func (pr *ProjectRole) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case projectrole.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case projectrole.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				pr.ProjectID = int(value.Int64)
+			}
+		case projectrole.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				pr.EmployeeID = int(value.Int64)
+			}
+		case projectrole.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				pr.Role = projectrole.Role(value.String)
+			}
+		case projectrole.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProjectRole.
+// This includes values selected through modifiers, order, etc.
+func (pr *ProjectRole) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the ProjectRole entity.
+func (pr *ProjectRole) QueryProject() *ProjectQuery {
+	return NewProjectRoleClient(pr.config).QueryProject(pr)
+}
+
+// Update returns a builder for updating this ProjectRole.
+// Note that you need to call ProjectRole.Unwrap() before calling this method if this ProjectRole
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *ProjectRole) Update() *ProjectRoleUpdateOne {
+	return NewProjectRoleClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the ProjectRole entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *ProjectRole) Unwrap() *ProjectRole {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProjectRole is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *ProjectRole) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProjectRole(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Role))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProjectRoles is a parsable slice of ProjectRole.
+type ProjectRoles []*ProjectRole
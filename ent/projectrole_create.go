@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+)
+
+// ProjectRoleCreate is the builder for creating a ProjectRole entity.
+type ProjectRoleCreate struct {
+	config
+	mutation *ProjectRoleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProjectID sets the "project_id" field.
+func (prc *ProjectRoleCreate) SetProjectID(i int) *ProjectRoleCreate {
+	prc.mutation.SetProjectID(i)
+	return prc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (prc *ProjectRoleCreate) SetEmployeeID(i int) *ProjectRoleCreate {
+	prc.mutation.SetEmployeeID(i)
+	return prc
+}
+
+// SetRole sets the "role" field.
+func (prc *ProjectRoleCreate) SetRole(pr projectrole.Role) *ProjectRoleCreate {
+	prc.mutation.SetRole(pr)
+	return prc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prc *ProjectRoleCreate) SetUpdatedAt(t time.Time) *ProjectRoleCreate {
+	prc.mutation.SetUpdatedAt(t)
+	return prc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (prc *ProjectRoleCreate) SetNillableUpdatedAt(t *time.Time) *ProjectRoleCreate {
+	if t != nil {
+		prc.SetUpdatedAt(*t)
+	}
+	return prc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (prc *ProjectRoleCreate) SetProject(p *Project) *ProjectRoleCreate {
+	return prc.SetProjectID(p.ID)
+}
+
+// Mutation returns the ProjectRoleMutation object of the builder.
+func (prc *ProjectRoleCreate) Mutation() *ProjectRoleMutation {
+	return prc.mutation
+}
+
+// Save creates the ProjectRole in the database.
+func (prc *ProjectRoleCreate) Save(ctx context.Context) (*ProjectRole, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *ProjectRoleCreate) SaveX(ctx context.Context) *ProjectRole {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *ProjectRoleCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *ProjectRoleCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *ProjectRoleCreate) defaults() {
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		v := projectrole.DefaultUpdatedAt()
+		prc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *ProjectRoleCreate) check() error {
+	if _, ok := prc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "ProjectRole.project_id"`)}
+	}
+	if _, ok := prc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "ProjectRole.employee_id"`)}
+	}
+	if _, ok := prc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "ProjectRole.role"`)}
+	}
+	if v, ok := prc.mutation.Role(); ok {
+		if err := projectrole.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ProjectRole.role": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProjectRole.updated_at"`)}
+	}
+	if len(prc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "ProjectRole.project"`)}
+	}
+	return nil
+}
+
+func (prc *ProjectRoleCreate) sqlSave(ctx context.Context) (*ProjectRole, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *ProjectRoleCreate) createSpec() (*ProjectRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProjectRole{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(projectrole.Table, sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = prc.conflict
+	if value, ok := prc.mutation.EmployeeID(); ok {
+		_spec.SetField(projectrole.FieldEmployeeID, field.TypeInt, value)
+		_node.EmployeeID = value
+	}
+	if value, ok := prc.mutation.Role(); ok {
+		_spec.SetField(projectrole.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := prc.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectrole.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := prc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectrole.ProjectTable,
+			Columns: []string{projectrole.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProjectRole.Create().
+//		SetProjectID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProjectRoleUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (prc *ProjectRoleCreate) OnConflict(opts ...sql.ConflictOption) *ProjectRoleUpsertOne {
+	prc.conflict = opts
+	return &ProjectRoleUpsertOne{
+		create: prc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProjectRole.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prc *ProjectRoleCreate) OnConflictColumns(columns ...string) *ProjectRoleUpsertOne {
+	prc.conflict = append(prc.conflict, sql.ConflictColumns(columns...))
+	return &ProjectRoleUpsertOne{
+		create: prc,
+	}
+}
+
+type (
+	// ProjectRoleUpsertOne is the builder for "upsert"-ing
+	//  one ProjectRole node.
+	ProjectRoleUpsertOne struct {
+		create *ProjectRoleCreate
+	}
+
+	// ProjectRoleUpsert is the "OnConflict" setter.
+	ProjectRoleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProjectID sets the "project_id" field.
+func (u *ProjectRoleUpsert) SetProjectID(v int) *ProjectRoleUpsert {
+	u.Set(projectrole.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *ProjectRoleUpsert) UpdateProjectID() *ProjectRoleUpsert {
+	u.SetExcluded(projectrole.FieldProjectID)
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *ProjectRoleUpsert) SetEmployeeID(v int) *ProjectRoleUpsert {
+	u.Set(projectrole.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *ProjectRoleUpsert) UpdateEmployeeID() *ProjectRoleUpsert {
+	u.SetExcluded(projectrole.FieldEmployeeID)
+	return u
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *ProjectRoleUpsert) AddEmployeeID(v int) *ProjectRoleUpsert {
+	u.Add(projectrole.FieldEmployeeID, v)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *ProjectRoleUpsert) SetRole(v projectrole.Role) *ProjectRoleUpsert {
+	u.Set(projectrole.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ProjectRoleUpsert) UpdateRole() *ProjectRoleUpsert {
+	u.SetExcluded(projectrole.FieldRole)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProjectRoleUpsert) SetUpdatedAt(v time.Time) *ProjectRoleUpsert {
+	u.Set(projectrole.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProjectRoleUpsert) UpdateUpdatedAt() *ProjectRoleUpsert {
+	u.SetExcluded(projectrole.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ProjectRole.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProjectRoleUpsertOne) UpdateNewValues() *ProjectRoleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProjectRole.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProjectRoleUpsertOne) Ignore() *ProjectRoleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProjectRoleUpsertOne) DoNothing() *ProjectRoleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProjectRoleCreate.OnConflict
+// documentation for more info.
+func (u *ProjectRoleUpsertOne) Update(set func(*ProjectRoleUpsert)) *ProjectRoleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProjectRoleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *ProjectRoleUpsertOne) SetProjectID(v int) *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *ProjectRoleUpsertOne) UpdateProjectID() *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *ProjectRoleUpsertOne) SetEmployeeID(v int) *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *ProjectRoleUpsertOne) AddEmployeeID(v int) *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *ProjectRoleUpsertOne) UpdateEmployeeID() *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ProjectRoleUpsertOne) SetRole(v projectrole.Role) *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ProjectRoleUpsertOne) UpdateRole() *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProjectRoleUpsertOne) SetUpdatedAt(v time.Time) *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProjectRoleUpsertOne) UpdateUpdatedAt() *ProjectRoleUpsertOne {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProjectRoleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProjectRoleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProjectRoleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProjectRoleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProjectRoleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProjectRoleCreateBulk is the builder for creating many ProjectRole entities in bulk.
+type ProjectRoleCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectRoleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProjectRole entities in the database.
+func (prcb *ProjectRoleCreateBulk) Save(ctx context.Context) ([]*ProjectRole, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*ProjectRole, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = prcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *ProjectRoleCreateBulk) SaveX(ctx context.Context) []*ProjectRole {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *ProjectRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *ProjectRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProjectRole.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProjectRoleUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (prcb *ProjectRoleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProjectRoleUpsertBulk {
+	prcb.conflict = opts
+	return &ProjectRoleUpsertBulk{
+		create: prcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProjectRole.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prcb *ProjectRoleCreateBulk) OnConflictColumns(columns ...string) *ProjectRoleUpsertBulk {
+	prcb.conflict = append(prcb.conflict, sql.ConflictColumns(columns...))
+	return &ProjectRoleUpsertBulk{
+		create: prcb,
+	}
+}
+
+// ProjectRoleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProjectRole nodes.
+type ProjectRoleUpsertBulk struct {
+	create *ProjectRoleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProjectRole.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProjectRoleUpsertBulk) UpdateNewValues() *ProjectRoleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProjectRole.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProjectRoleUpsertBulk) Ignore() *ProjectRoleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProjectRoleUpsertBulk) DoNothing() *ProjectRoleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProjectRoleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProjectRoleUpsertBulk) Update(set func(*ProjectRoleUpsert)) *ProjectRoleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProjectRoleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *ProjectRoleUpsertBulk) SetProjectID(v int) *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *ProjectRoleUpsertBulk) UpdateProjectID() *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *ProjectRoleUpsertBulk) SetEmployeeID(v int) *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *ProjectRoleUpsertBulk) AddEmployeeID(v int) *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *ProjectRoleUpsertBulk) UpdateEmployeeID() *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ProjectRoleUpsertBulk) SetRole(v projectrole.Role) *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ProjectRoleUpsertBulk) UpdateRole() *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProjectRoleUpsertBulk) SetUpdatedAt(v time.Time) *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProjectRoleUpsertBulk) UpdateUpdatedAt() *ProjectRoleUpsertBulk {
+	return u.Update(func(s *ProjectRoleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProjectRoleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProjectRoleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProjectRoleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProjectRoleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+)
+
+// ProjectRoleUpdate is the builder for updating ProjectRole entities.
+type ProjectRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProjectRoleMutation
+}
+
+// Where appends a list predicates to the ProjectRoleUpdate builder.
+func (pru *ProjectRoleUpdate) Where(ps ...predicate.ProjectRole) *ProjectRoleUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetProjectID sets the "project_id" field.
+func (pru *ProjectRoleUpdate) SetProjectID(i int) *ProjectRoleUpdate {
+	pru.mutation.SetProjectID(i)
+	return pru
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (pru *ProjectRoleUpdate) SetNillableProjectID(i *int) *ProjectRoleUpdate {
+	if i != nil {
+		pru.SetProjectID(*i)
+	}
+	return pru
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (pru *ProjectRoleUpdate) SetEmployeeID(i int) *ProjectRoleUpdate {
+	pru.mutation.ResetEmployeeID()
+	pru.mutation.SetEmployeeID(i)
+	return pru
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (pru *ProjectRoleUpdate) SetNillableEmployeeID(i *int) *ProjectRoleUpdate {
+	if i != nil {
+		pru.SetEmployeeID(*i)
+	}
+	return pru
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (pru *ProjectRoleUpdate) AddEmployeeID(i int) *ProjectRoleUpdate {
+	pru.mutation.AddEmployeeID(i)
+	return pru
+}
+
+// SetRole sets the "role" field.
+func (pru *ProjectRoleUpdate) SetRole(pr projectrole.Role) *ProjectRoleUpdate {
+	pru.mutation.SetRole(pr)
+	return pru
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (pru *ProjectRoleUpdate) SetNillableRole(pr *projectrole.Role) *ProjectRoleUpdate {
+	if pr != nil {
+		pru.SetRole(*pr)
+	}
+	return pru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pru *ProjectRoleUpdate) SetUpdatedAt(t time.Time) *ProjectRoleUpdate {
+	pru.mutation.SetUpdatedAt(t)
+	return pru
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (pru *ProjectRoleUpdate) SetProject(p *Project) *ProjectRoleUpdate {
+	return pru.SetProjectID(p.ID)
+}
+
+// Mutation returns the ProjectRoleMutation object of the builder.
+func (pru *ProjectRoleUpdate) Mutation() *ProjectRoleMutation {
+	return pru.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (pru *ProjectRoleUpdate) ClearProject() *ProjectRoleUpdate {
+	pru.mutation.ClearProject()
+	return pru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *ProjectRoleUpdate) Save(ctx context.Context) (int, error) {
+	pru.defaults()
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *ProjectRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *ProjectRoleUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *ProjectRoleUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pru *ProjectRoleUpdate) defaults() {
+	if _, ok := pru.mutation.UpdatedAt(); !ok {
+		v := projectrole.UpdateDefaultUpdatedAt()
+		pru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *ProjectRoleUpdate) check() error {
+	if v, ok := pru.mutation.Role(); ok {
+		if err := projectrole.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ProjectRole.role": %w`, err)}
+		}
+	}
+	if pru.mutation.ProjectCleared() && len(pru.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectRole.project"`)
+	}
+	return nil
+}
+
+func (pru *ProjectRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projectrole.Table, projectrole.Columns, sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.EmployeeID(); ok {
+		_spec.SetField(projectrole.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(projectrole.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.Role(); ok {
+		_spec.SetField(projectrole.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := pru.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectrole.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pru.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectrole.ProjectTable,
+			Columns: []string{projectrole.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectrole.ProjectTable,
+			Columns: []string{projectrole.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// ProjectRoleUpdateOne is the builder for updating a single ProjectRole entity.
+type ProjectRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectRoleMutation
+}
+
+// SetProjectID sets the "project_id" field.
+func (pruo *ProjectRoleUpdateOne) SetProjectID(i int) *ProjectRoleUpdateOne {
+	pruo.mutation.SetProjectID(i)
+	return pruo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (pruo *ProjectRoleUpdateOne) SetNillableProjectID(i *int) *ProjectRoleUpdateOne {
+	if i != nil {
+		pruo.SetProjectID(*i)
+	}
+	return pruo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (pruo *ProjectRoleUpdateOne) SetEmployeeID(i int) *ProjectRoleUpdateOne {
+	pruo.mutation.ResetEmployeeID()
+	pruo.mutation.SetEmployeeID(i)
+	return pruo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (pruo *ProjectRoleUpdateOne) SetNillableEmployeeID(i *int) *ProjectRoleUpdateOne {
+	if i != nil {
+		pruo.SetEmployeeID(*i)
+	}
+	return pruo
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (pruo *ProjectRoleUpdateOne) AddEmployeeID(i int) *ProjectRoleUpdateOne {
+	pruo.mutation.AddEmployeeID(i)
+	return pruo
+}
+
+// SetRole sets the "role" field.
+func (pruo *ProjectRoleUpdateOne) SetRole(pr projectrole.Role) *ProjectRoleUpdateOne {
+	pruo.mutation.SetRole(pr)
+	return pruo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (pruo *ProjectRoleUpdateOne) SetNillableRole(pr *projectrole.Role) *ProjectRoleUpdateOne {
+	if pr != nil {
+		pruo.SetRole(*pr)
+	}
+	return pruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pruo *ProjectRoleUpdateOne) SetUpdatedAt(t time.Time) *ProjectRoleUpdateOne {
+	pruo.mutation.SetUpdatedAt(t)
+	return pruo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (pruo *ProjectRoleUpdateOne) SetProject(p *Project) *ProjectRoleUpdateOne {
+	return pruo.SetProjectID(p.ID)
+}
+
+// Mutation returns the ProjectRoleMutation object of the builder.
+func (pruo *ProjectRoleUpdateOne) Mutation() *ProjectRoleMutation {
+	return pruo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (pruo *ProjectRoleUpdateOne) ClearProject() *ProjectRoleUpdateOne {
+	pruo.mutation.ClearProject()
+	return pruo
+}
+
+// Where appends a list predicates to the ProjectRoleUpdate builder.
+func (pruo *ProjectRoleUpdateOne) Where(ps ...predicate.ProjectRole) *ProjectRoleUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *ProjectRoleUpdateOne) Select(field string, fields ...string) *ProjectRoleUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated ProjectRole entity.
+func (pruo *ProjectRoleUpdateOne) Save(ctx context.Context) (*ProjectRole, error) {
+	pruo.defaults()
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *ProjectRoleUpdateOne) SaveX(ctx context.Context) *ProjectRole {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *ProjectRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *ProjectRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pruo *ProjectRoleUpdateOne) defaults() {
+	if _, ok := pruo.mutation.UpdatedAt(); !ok {
+		v := projectrole.UpdateDefaultUpdatedAt()
+		pruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *ProjectRoleUpdateOne) check() error {
+	if v, ok := pruo.mutation.Role(); ok {
+		if err := projectrole.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ProjectRole.role": %w`, err)}
+		}
+	}
+	if pruo.mutation.ProjectCleared() && len(pruo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectRole.project"`)
+	}
+	return nil
+}
+
+func (pruo *ProjectRoleUpdateOne) sqlSave(ctx context.Context) (_node *ProjectRole, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projectrole.Table, projectrole.Columns, sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeInt))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProjectRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, projectrole.FieldID)
+		for _, f := range fields {
+			if !projectrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != projectrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.EmployeeID(); ok {
+		_spec.SetField(projectrole.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(projectrole.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.Role(); ok {
+		_spec.SetField(projectrole.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := pruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectrole.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectrole.ProjectTable,
+			Columns: []string{projectrole.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectrole.ProjectTable,
+			Columns: []string{projectrole.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProjectRole{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
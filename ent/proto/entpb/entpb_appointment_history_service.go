@@ -10,7 +10,9 @@ import (
 	fmt "fmt"
 	ent "github.com/longgggwwww/hrm-ms-hr/ent"
 	appointmenthistory "github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	department "github.com/longgggwwww/hrm-ms-hr/ent/department"
 	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	position "github.com/longgggwwww/hrm-ms-hr/ent/position"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -39,24 +41,48 @@ func toProtoAppointmentHistory(e *ent.AppointmentHistory) (*AppointmentHistory,
 	v.AttachmentUrls = attachment_urls
 	created_at := timestamppb.New(e.CreatedAt)
 	v.CreatedAt = created_at
+	if e.DepartmentID != nil {
+		department := wrapperspb.Int64(int64(*e.DepartmentID))
+		v.DepartmentId = department
+	}
 	description := wrapperspb.String(e.Description)
 	v.Description = description
+	if e.EffectiveTo != nil {
+		effective_to := timestamppb.New(*e.EffectiveTo)
+		v.EffectiveTo = effective_to
+	}
 	employee := int64(e.EmployeeID)
 	v.EmployeeId = employee
 	id := int64(e.ID)
 	v.Id = id
 	joining_at := timestamppb.New(e.JoiningAt)
 	v.JoiningAt = joining_at
+	if e.PositionID != nil {
+		position := wrapperspb.Int64(int64(*e.PositionID))
+		v.PositionId = position
+	}
 	position_name := e.PositionName
 	v.PositionName = position_name
 	updated_at := timestamppb.New(e.UpdatedAt)
 	v.UpdatedAt = updated_at
+	if edg := e.Edges.Department; edg != nil {
+		id := int64(edg.ID)
+		v.Department = &Department{
+			Id: id,
+		}
+	}
 	if edg := e.Edges.Employee; edg != nil {
 		id := int64(edg.ID)
 		v.Employee = &Employee{
 			Id: id,
 		}
 	}
+	if edg := e.Edges.Position; edg != nil {
+		id := int64(edg.ID)
+		v.Position = &Position{
+			Id: id,
+		}
+	}
 	return v, nil
 }
 
@@ -111,9 +137,15 @@ func (svc *AppointmentHistoryService) Get(ctx context.Context, req *GetAppointme
 	case GetAppointmentHistoryRequest_WITH_EDGE_IDS:
 		get, err = svc.client.AppointmentHistory.Query().
 			Where(appointmenthistory.ID(id)).
+			WithDepartment(func(query *ent.DepartmentQuery) {
+				query.Select(department.FieldID)
+			}).
 			WithEmployee(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithPosition(func(query *ent.PositionQuery) {
+				query.Select(position.FieldID)
+			}).
 			Only(ctx)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
@@ -140,22 +172,42 @@ func (svc *AppointmentHistoryService) Update(ctx context.Context, req *UpdateApp
 	}
 	appointmenthistoryCreatedAt := runtime.ExtractTime(appointmenthistory.GetCreatedAt())
 	m.SetCreatedAt(appointmenthistoryCreatedAt)
+	if appointmenthistory.GetDepartmentId() != nil {
+		appointmenthistoryDepartmentID := int(appointmenthistory.GetDepartmentId().GetValue())
+		m.SetDepartmentID(appointmenthistoryDepartmentID)
+	}
 	if appointmenthistory.GetDescription() != nil {
 		appointmenthistoryDescription := appointmenthistory.GetDescription().GetValue()
 		m.SetDescription(appointmenthistoryDescription)
 	}
+	if appointmenthistory.GetEffectiveTo() != nil {
+		appointmenthistoryEffectiveTo := runtime.ExtractTime(appointmenthistory.GetEffectiveTo())
+		m.SetEffectiveTo(appointmenthistoryEffectiveTo)
+	}
 	appointmenthistoryEmployeeID := int(appointmenthistory.GetEmployeeId())
 	m.SetEmployeeID(appointmenthistoryEmployeeID)
 	appointmenthistoryJoiningAt := runtime.ExtractTime(appointmenthistory.GetJoiningAt())
 	m.SetJoiningAt(appointmenthistoryJoiningAt)
+	if appointmenthistory.GetPositionId() != nil {
+		appointmenthistoryPositionID := int(appointmenthistory.GetPositionId().GetValue())
+		m.SetPositionID(appointmenthistoryPositionID)
+	}
 	appointmenthistoryPositionName := appointmenthistory.GetPositionName()
 	m.SetPositionName(appointmenthistoryPositionName)
 	appointmenthistoryUpdatedAt := runtime.ExtractTime(appointmenthistory.GetUpdatedAt())
 	m.SetUpdatedAt(appointmenthistoryUpdatedAt)
+	if appointmenthistory.GetDepartment() != nil {
+		appointmenthistoryDepartment := int(appointmenthistory.GetDepartment().GetId())
+		m.SetDepartmentID(appointmenthistoryDepartment)
+	}
 	if appointmenthistory.GetEmployee() != nil {
 		appointmenthistoryEmployee := int(appointmenthistory.GetEmployee().GetId())
 		m.SetEmployeeID(appointmenthistoryEmployee)
 	}
+	if appointmenthistory.GetPosition() != nil {
+		appointmenthistoryPosition := int(appointmenthistory.GetPosition().GetId())
+		m.SetPositionID(appointmenthistoryPosition)
+	}
 
 	res, err := m.Save(ctx)
 	switch {
@@ -226,9 +278,15 @@ func (svc *AppointmentHistoryService) List(ctx context.Context, req *ListAppoint
 		entList, err = listQuery.All(ctx)
 	case ListAppointmentHistoryRequest_WITH_EDGE_IDS:
 		entList, err = listQuery.
+			WithDepartment(func(query *ent.DepartmentQuery) {
+				query.Select(department.FieldID)
+			}).
 			WithEmployee(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithPosition(func(query *ent.PositionQuery) {
+				query.Select(position.FieldID)
+			}).
 			All(ctx)
 	}
 	switch {
@@ -296,21 +354,41 @@ func (svc *AppointmentHistoryService) createBuilder(appointmenthistory *Appointm
 	}
 	appointmenthistoryCreatedAt := runtime.ExtractTime(appointmenthistory.GetCreatedAt())
 	m.SetCreatedAt(appointmenthistoryCreatedAt)
+	if appointmenthistory.GetDepartmentId() != nil {
+		appointmenthistoryDepartmentID := int(appointmenthistory.GetDepartmentId().GetValue())
+		m.SetDepartmentID(appointmenthistoryDepartmentID)
+	}
 	if appointmenthistory.GetDescription() != nil {
 		appointmenthistoryDescription := appointmenthistory.GetDescription().GetValue()
 		m.SetDescription(appointmenthistoryDescription)
 	}
+	if appointmenthistory.GetEffectiveTo() != nil {
+		appointmenthistoryEffectiveTo := runtime.ExtractTime(appointmenthistory.GetEffectiveTo())
+		m.SetEffectiveTo(appointmenthistoryEffectiveTo)
+	}
 	appointmenthistoryEmployeeID := int(appointmenthistory.GetEmployeeId())
 	m.SetEmployeeID(appointmenthistoryEmployeeID)
 	appointmenthistoryJoiningAt := runtime.ExtractTime(appointmenthistory.GetJoiningAt())
 	m.SetJoiningAt(appointmenthistoryJoiningAt)
+	if appointmenthistory.GetPositionId() != nil {
+		appointmenthistoryPositionID := int(appointmenthistory.GetPositionId().GetValue())
+		m.SetPositionID(appointmenthistoryPositionID)
+	}
 	appointmenthistoryPositionName := appointmenthistory.GetPositionName()
 	m.SetPositionName(appointmenthistoryPositionName)
 	appointmenthistoryUpdatedAt := runtime.ExtractTime(appointmenthistory.GetUpdatedAt())
 	m.SetUpdatedAt(appointmenthistoryUpdatedAt)
+	if appointmenthistory.GetDepartment() != nil {
+		appointmenthistoryDepartment := int(appointmenthistory.GetDepartment().GetId())
+		m.SetDepartmentID(appointmenthistoryDepartment)
+	}
 	if appointmenthistory.GetEmployee() != nil {
 		appointmenthistoryEmployee := int(appointmenthistory.GetEmployee().GetId())
 		m.SetEmployeeID(appointmenthistoryEmployee)
 	}
+	if appointmenthistory.GetPosition() != nil {
+		appointmenthistoryPosition := int(appointmenthistory.GetPosition().GetId())
+		m.SetPositionID(appointmenthistoryPosition)
+	}
 	return m, nil
 }
@@ -0,0 +1,406 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	attachment "github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	organization "github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// AttachmentService implements AttachmentServiceServer
+type AttachmentService struct {
+	client *ent.Client
+	UnimplementedAttachmentServiceServer
+}
+
+// NewAttachmentService returns a new AttachmentService
+func NewAttachmentService(client *ent.Client) *AttachmentService {
+	return &AttachmentService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpAttachment_OwnerType = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeAttachment_OwnerType(e string) string {
+	return protoIdentNormalizeRegexpAttachment_OwnerType.ReplaceAllString(e, "_")
+}
+
+func toProtoAttachment_OwnerType(e attachment.OwnerType) Attachment_OwnerType {
+	if v, ok := Attachment_OwnerType_value[strings.ToUpper("OWNER_TYPE_"+protoIdentNormalizeAttachment_OwnerType(string(e)))]; ok {
+		return Attachment_OwnerType(v)
+	}
+	return Attachment_OwnerType(0)
+}
+
+func toEntAttachment_OwnerType(e Attachment_OwnerType) attachment.OwnerType {
+	if v, ok := Attachment_OwnerType_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"OWNER_TYPE_TASK":                "task",
+			"OWNER_TYPE_TASK_REPORT":         "task_report",
+			"OWNER_TYPE_LEAVE_REQUEST":       "leave_request",
+			"OWNER_TYPE_APPOINTMENT_HISTORY": "appointment_history",
+		}[v]
+		return attachment.OwnerType(entVal)
+	}
+	return ""
+}
+
+var protoIdentNormalizeRegexpAttachment_ScanStatus = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeAttachment_ScanStatus(e string) string {
+	return protoIdentNormalizeRegexpAttachment_ScanStatus.ReplaceAllString(e, "_")
+}
+
+func toProtoAttachment_ScanStatus(e attachment.ScanStatus) Attachment_ScanStatus {
+	if v, ok := Attachment_ScanStatus_value[strings.ToUpper("SCAN_STATUS_"+protoIdentNormalizeAttachment_ScanStatus(string(e)))]; ok {
+		return Attachment_ScanStatus(v)
+	}
+	return Attachment_ScanStatus(0)
+}
+
+func toEntAttachment_ScanStatus(e Attachment_ScanStatus) attachment.ScanStatus {
+	if v, ok := Attachment_ScanStatus_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"SCAN_STATUS_PENDING":  "pending",
+			"SCAN_STATUS_CLEAN":    "clean",
+			"SCAN_STATUS_INFECTED": "infected",
+			"SCAN_STATUS_SKIPPED":  "skipped",
+		}[v]
+		return attachment.ScanStatus(entVal)
+	}
+	return ""
+}
+
+// toProtoAttachment transforms the ent type to the pb type
+func toProtoAttachment(e *ent.Attachment) (*Attachment, error) {
+	v := &Attachment{}
+	checksum := e.Checksum
+	v.Checksum = checksum
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	file_name := e.FileName
+	v.FileName = file_name
+	id := int64(e.ID)
+	v.Id = id
+	mime_type := e.MimeType
+	v.MimeType = mime_type
+	organization := int64(e.OrgID)
+	v.OrgId = organization
+	owner_id := int64(e.OwnerID)
+	v.OwnerId = owner_id
+	owner_type := toProtoAttachment_OwnerType(e.OwnerType)
+	v.OwnerType = owner_type
+	scan_status := toProtoAttachment_ScanStatus(e.ScanStatus)
+	v.ScanStatus = scan_status
+	size := e.Size
+	v.Size = size
+	storage_key := e.StorageKey
+	v.StorageKey = storage_key
+	uploader := int64(e.UploaderID)
+	v.UploaderId = uploader
+	if edg := e.Edges.Organization; edg != nil {
+		id := int64(edg.ID)
+		v.Organization = &Organization{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Uploader; edg != nil {
+		id := int64(edg.ID)
+		v.Uploader = &Employee{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoAttachmentList transforms a list of ent type to a list of pb type
+func toProtoAttachmentList(e []*ent.Attachment) ([]*Attachment, error) {
+	var pbList []*Attachment
+	for _, entEntity := range e {
+		pbEntity, err := toProtoAttachment(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements AttachmentServiceServer.Create
+func (svc *AttachmentService) Create(ctx context.Context, req *CreateAttachmentRequest) (*Attachment, error) {
+	attachment := req.GetAttachment()
+	m, err := svc.createBuilder(attachment)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoAttachment(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements AttachmentServiceServer.Get
+func (svc *AttachmentService) Get(ctx context.Context, req *GetAttachmentRequest) (*Attachment, error) {
+	var (
+		err error
+		get *ent.Attachment
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetAttachmentRequest_VIEW_UNSPECIFIED, GetAttachmentRequest_BASIC:
+		get, err = svc.client.Attachment.Get(ctx, id)
+	case GetAttachmentRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Attachment.Query().
+			Where(attachment.ID(id)).
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			WithUploader(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoAttachment(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements AttachmentServiceServer.Update
+func (svc *AttachmentService) Update(ctx context.Context, req *UpdateAttachmentRequest) (*Attachment, error) {
+	attachment := req.GetAttachment()
+	attachmentID := int(attachment.GetId())
+	m := svc.client.Attachment.UpdateOneID(attachmentID)
+	attachmentChecksum := attachment.GetChecksum()
+	m.SetChecksum(attachmentChecksum)
+	attachmentCreatedAt := runtime.ExtractTime(attachment.GetCreatedAt())
+	m.SetCreatedAt(attachmentCreatedAt)
+	attachmentFileName := attachment.GetFileName()
+	m.SetFileName(attachmentFileName)
+	attachmentMimeType := attachment.GetMimeType()
+	m.SetMimeType(attachmentMimeType)
+	attachmentOrgID := int(attachment.GetOrgId())
+	m.SetOrgID(attachmentOrgID)
+	attachmentOwnerID := int(attachment.GetOwnerId())
+	m.SetOwnerID(attachmentOwnerID)
+	attachmentOwnerType := toEntAttachment_OwnerType(attachment.GetOwnerType())
+	m.SetOwnerType(attachmentOwnerType)
+	attachmentScanStatus := toEntAttachment_ScanStatus(attachment.GetScanStatus())
+	m.SetScanStatus(attachmentScanStatus)
+	attachmentSize := int64(attachment.GetSize())
+	m.SetSize(attachmentSize)
+	attachmentStorageKey := attachment.GetStorageKey()
+	m.SetStorageKey(attachmentStorageKey)
+	attachmentUploaderID := int(attachment.GetUploaderId())
+	m.SetUploaderID(attachmentUploaderID)
+	if attachment.GetOrganization() != nil {
+		attachmentOrganization := int(attachment.GetOrganization().GetId())
+		m.SetOrganizationID(attachmentOrganization)
+	}
+	if attachment.GetUploader() != nil {
+		attachmentUploader := int(attachment.GetUploader().GetId())
+		m.SetUploaderID(attachmentUploader)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoAttachment(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements AttachmentServiceServer.Delete
+func (svc *AttachmentService) Delete(ctx context.Context, req *DeleteAttachmentRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.Attachment.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements AttachmentServiceServer.List
+func (svc *AttachmentService) List(ctx context.Context, req *ListAttachmentRequest) (*ListAttachmentResponse, error) {
+	var (
+		err      error
+		entList  []*ent.Attachment
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.Attachment.Query().
+		Order(ent.Desc(attachment.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(attachment.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListAttachmentRequest_VIEW_UNSPECIFIED, ListAttachmentRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListAttachmentRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			WithUploader(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoAttachmentList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListAttachmentResponse{
+			AttachmentList: protoList,
+			NextPageToken:  nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements AttachmentServiceServer.BatchCreate
+func (svc *AttachmentService) BatchCreate(ctx context.Context, req *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.AttachmentCreate, len(requests))
+	for i, req := range requests {
+		attachment := req.GetAttachment()
+		var err error
+		bulk[i], err = svc.createBuilder(attachment)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.Attachment.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoAttachmentList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateAttachmentsResponse{
+			Attachments: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *AttachmentService) createBuilder(attachment *Attachment) (*ent.AttachmentCreate, error) {
+	m := svc.client.Attachment.Create()
+	attachmentChecksum := attachment.GetChecksum()
+	m.SetChecksum(attachmentChecksum)
+	attachmentCreatedAt := runtime.ExtractTime(attachment.GetCreatedAt())
+	m.SetCreatedAt(attachmentCreatedAt)
+	attachmentFileName := attachment.GetFileName()
+	m.SetFileName(attachmentFileName)
+	attachmentMimeType := attachment.GetMimeType()
+	m.SetMimeType(attachmentMimeType)
+	attachmentOrgID := int(attachment.GetOrgId())
+	m.SetOrgID(attachmentOrgID)
+	attachmentOwnerID := int(attachment.GetOwnerId())
+	m.SetOwnerID(attachmentOwnerID)
+	attachmentOwnerType := toEntAttachment_OwnerType(attachment.GetOwnerType())
+	m.SetOwnerType(attachmentOwnerType)
+	attachmentScanStatus := toEntAttachment_ScanStatus(attachment.GetScanStatus())
+	m.SetScanStatus(attachmentScanStatus)
+	attachmentSize := int64(attachment.GetSize())
+	m.SetSize(attachmentSize)
+	attachmentStorageKey := attachment.GetStorageKey()
+	m.SetStorageKey(attachmentStorageKey)
+	attachmentUploaderID := int(attachment.GetUploaderId())
+	m.SetUploaderID(attachmentUploaderID)
+	if attachment.GetOrganization() != nil {
+		attachmentOrganization := int(attachment.GetOrganization().GetId())
+		m.SetOrganizationID(attachmentOrganization)
+	}
+	if attachment.GetUploader() != nil {
+		attachmentUploader := int(attachment.GetUploader().GetId())
+		m.SetUploaderID(attachmentUploader)
+	}
+	return m, nil
+}
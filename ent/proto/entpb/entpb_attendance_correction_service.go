@@ -0,0 +1,428 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	attendancecorrection "github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	attendancerecord "github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// AttendanceCorrectionService implements AttendanceCorrectionServiceServer
+type AttendanceCorrectionService struct {
+	client *ent.Client
+	UnimplementedAttendanceCorrectionServiceServer
+}
+
+// NewAttendanceCorrectionService returns a new AttendanceCorrectionService
+func NewAttendanceCorrectionService(client *ent.Client) *AttendanceCorrectionService {
+	return &AttendanceCorrectionService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpAttendanceCorrection_Status = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeAttendanceCorrection_Status(e string) string {
+	return protoIdentNormalizeRegexpAttendanceCorrection_Status.ReplaceAllString(e, "_")
+}
+
+func toProtoAttendanceCorrection_Status(e attendancecorrection.Status) AttendanceCorrection_Status {
+	if v, ok := AttendanceCorrection_Status_value[strings.ToUpper("STATUS_"+protoIdentNormalizeAttendanceCorrection_Status(string(e)))]; ok {
+		return AttendanceCorrection_Status(v)
+	}
+	return AttendanceCorrection_Status(0)
+}
+
+func toEntAttendanceCorrection_Status(e AttendanceCorrection_Status) attendancecorrection.Status {
+	if v, ok := AttendanceCorrection_Status_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATUS_PENDING":  "pending",
+			"STATUS_APPROVED": "approved",
+			"STATUS_REJECTED": "rejected",
+		}[v]
+		return attendancecorrection.Status(entVal)
+	}
+	return ""
+}
+
+// toProtoAttendanceCorrection transforms the ent type to the pb type
+func toProtoAttendanceCorrection(e *ent.AttendanceCorrection) (*AttendanceCorrection, error) {
+	v := &AttendanceCorrection{}
+	if e.AttendanceRecordID != nil {
+		attendance_record := wrapperspb.Int64(int64(*e.AttendanceRecordID))
+		v.AttendanceRecordId = attendance_record
+	}
+	if e.CheckInAt != nil {
+		check_in_at := timestamppb.New(*e.CheckInAt)
+		v.CheckInAt = check_in_at
+	}
+	if e.CheckOutAt != nil {
+		check_out_at := timestamppb.New(*e.CheckOutAt)
+		v.CheckOutAt = check_out_at
+	}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	employee := int64(e.EmployeeID)
+	v.EmployeeId = employee
+	id := int64(e.ID)
+	v.Id = id
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	reason := e.Reason
+	v.Reason = reason
+	if e.ReviewComment != nil {
+		review_comment := wrapperspb.String(*e.ReviewComment)
+		v.ReviewComment = review_comment
+	}
+	if e.ReviewedAt != nil {
+		reviewed_at := timestamppb.New(*e.ReviewedAt)
+		v.ReviewedAt = reviewed_at
+	}
+	if e.ReviewerID != nil {
+		reviewer_id := wrapperspb.Int64(int64(*e.ReviewerID))
+		v.ReviewerId = reviewer_id
+	}
+	status := toProtoAttendanceCorrection_Status(e.Status)
+	v.Status = status
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	work_date := timestamppb.New(e.WorkDate)
+	v.WorkDate = work_date
+	if edg := e.Edges.AttendanceRecord; edg != nil {
+		id := int64(edg.ID)
+		v.AttendanceRecord = &AttendanceRecord{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Employee; edg != nil {
+		id := int64(edg.ID)
+		v.Employee = &Employee{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoAttendanceCorrectionList transforms a list of ent type to a list of pb type
+func toProtoAttendanceCorrectionList(e []*ent.AttendanceCorrection) ([]*AttendanceCorrection, error) {
+	var pbList []*AttendanceCorrection
+	for _, entEntity := range e {
+		pbEntity, err := toProtoAttendanceCorrection(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements AttendanceCorrectionServiceServer.Create
+func (svc *AttendanceCorrectionService) Create(ctx context.Context, req *CreateAttendanceCorrectionRequest) (*AttendanceCorrection, error) {
+	attendancecorrection := req.GetAttendanceCorrection()
+	m, err := svc.createBuilder(attendancecorrection)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoAttendanceCorrection(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements AttendanceCorrectionServiceServer.Get
+func (svc *AttendanceCorrectionService) Get(ctx context.Context, req *GetAttendanceCorrectionRequest) (*AttendanceCorrection, error) {
+	var (
+		err error
+		get *ent.AttendanceCorrection
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetAttendanceCorrectionRequest_VIEW_UNSPECIFIED, GetAttendanceCorrectionRequest_BASIC:
+		get, err = svc.client.AttendanceCorrection.Get(ctx, id)
+	case GetAttendanceCorrectionRequest_WITH_EDGE_IDS:
+		get, err = svc.client.AttendanceCorrection.Query().
+			Where(attendancecorrection.ID(id)).
+			WithAttendanceRecord(func(query *ent.AttendanceRecordQuery) {
+				query.Select(attendancerecord.FieldID)
+			}).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoAttendanceCorrection(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements AttendanceCorrectionServiceServer.Update
+func (svc *AttendanceCorrectionService) Update(ctx context.Context, req *UpdateAttendanceCorrectionRequest) (*AttendanceCorrection, error) {
+	attendancecorrection := req.GetAttendanceCorrection()
+	attendancecorrectionID := int(attendancecorrection.GetId())
+	m := svc.client.AttendanceCorrection.UpdateOneID(attendancecorrectionID)
+	if attendancecorrection.GetAttendanceRecordId() != nil {
+		attendancecorrectionAttendanceRecordID := int(attendancecorrection.GetAttendanceRecordId().GetValue())
+		m.SetAttendanceRecordID(attendancecorrectionAttendanceRecordID)
+	}
+	if attendancecorrection.GetCheckInAt() != nil {
+		attendancecorrectionCheckInAt := runtime.ExtractTime(attendancecorrection.GetCheckInAt())
+		m.SetCheckInAt(attendancecorrectionCheckInAt)
+	}
+	if attendancecorrection.GetCheckOutAt() != nil {
+		attendancecorrectionCheckOutAt := runtime.ExtractTime(attendancecorrection.GetCheckOutAt())
+		m.SetCheckOutAt(attendancecorrectionCheckOutAt)
+	}
+	attendancecorrectionCreatedAt := runtime.ExtractTime(attendancecorrection.GetCreatedAt())
+	m.SetCreatedAt(attendancecorrectionCreatedAt)
+	attendancecorrectionEmployeeID := int(attendancecorrection.GetEmployeeId())
+	m.SetEmployeeID(attendancecorrectionEmployeeID)
+	attendancecorrectionOrgID := int(attendancecorrection.GetOrgId())
+	m.SetOrgID(attendancecorrectionOrgID)
+	attendancecorrectionReason := attendancecorrection.GetReason()
+	m.SetReason(attendancecorrectionReason)
+	if attendancecorrection.GetReviewComment() != nil {
+		attendancecorrectionReviewComment := attendancecorrection.GetReviewComment().GetValue()
+		m.SetReviewComment(attendancecorrectionReviewComment)
+	}
+	if attendancecorrection.GetReviewedAt() != nil {
+		attendancecorrectionReviewedAt := runtime.ExtractTime(attendancecorrection.GetReviewedAt())
+		m.SetReviewedAt(attendancecorrectionReviewedAt)
+	}
+	if attendancecorrection.GetReviewerId() != nil {
+		attendancecorrectionReviewerID := int(attendancecorrection.GetReviewerId().GetValue())
+		m.SetReviewerID(attendancecorrectionReviewerID)
+	}
+	attendancecorrectionStatus := toEntAttendanceCorrection_Status(attendancecorrection.GetStatus())
+	m.SetStatus(attendancecorrectionStatus)
+	attendancecorrectionUpdatedAt := runtime.ExtractTime(attendancecorrection.GetUpdatedAt())
+	m.SetUpdatedAt(attendancecorrectionUpdatedAt)
+	attendancecorrectionWorkDate := runtime.ExtractTime(attendancecorrection.GetWorkDate())
+	m.SetWorkDate(attendancecorrectionWorkDate)
+	if attendancecorrection.GetAttendanceRecord() != nil {
+		attendancecorrectionAttendanceRecord := int(attendancecorrection.GetAttendanceRecord().GetId())
+		m.SetAttendanceRecordID(attendancecorrectionAttendanceRecord)
+	}
+	if attendancecorrection.GetEmployee() != nil {
+		attendancecorrectionEmployee := int(attendancecorrection.GetEmployee().GetId())
+		m.SetEmployeeID(attendancecorrectionEmployee)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoAttendanceCorrection(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements AttendanceCorrectionServiceServer.Delete
+func (svc *AttendanceCorrectionService) Delete(ctx context.Context, req *DeleteAttendanceCorrectionRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.AttendanceCorrection.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements AttendanceCorrectionServiceServer.List
+func (svc *AttendanceCorrectionService) List(ctx context.Context, req *ListAttendanceCorrectionRequest) (*ListAttendanceCorrectionResponse, error) {
+	var (
+		err      error
+		entList  []*ent.AttendanceCorrection
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.AttendanceCorrection.Query().
+		Order(ent.Desc(attendancecorrection.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(attendancecorrection.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListAttendanceCorrectionRequest_VIEW_UNSPECIFIED, ListAttendanceCorrectionRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListAttendanceCorrectionRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithAttendanceRecord(func(query *ent.AttendanceRecordQuery) {
+				query.Select(attendancerecord.FieldID)
+			}).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoAttendanceCorrectionList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListAttendanceCorrectionResponse{
+			AttendanceCorrectionList: protoList,
+			NextPageToken:            nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements AttendanceCorrectionServiceServer.BatchCreate
+func (svc *AttendanceCorrectionService) BatchCreate(ctx context.Context, req *BatchCreateAttendanceCorrectionsRequest) (*BatchCreateAttendanceCorrectionsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.AttendanceCorrectionCreate, len(requests))
+	for i, req := range requests {
+		attendancecorrection := req.GetAttendanceCorrection()
+		var err error
+		bulk[i], err = svc.createBuilder(attendancecorrection)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.AttendanceCorrection.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoAttendanceCorrectionList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateAttendanceCorrectionsResponse{
+			AttendanceCorrections: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *AttendanceCorrectionService) createBuilder(attendancecorrection *AttendanceCorrection) (*ent.AttendanceCorrectionCreate, error) {
+	m := svc.client.AttendanceCorrection.Create()
+	if attendancecorrection.GetAttendanceRecordId() != nil {
+		attendancecorrectionAttendanceRecordID := int(attendancecorrection.GetAttendanceRecordId().GetValue())
+		m.SetAttendanceRecordID(attendancecorrectionAttendanceRecordID)
+	}
+	if attendancecorrection.GetCheckInAt() != nil {
+		attendancecorrectionCheckInAt := runtime.ExtractTime(attendancecorrection.GetCheckInAt())
+		m.SetCheckInAt(attendancecorrectionCheckInAt)
+	}
+	if attendancecorrection.GetCheckOutAt() != nil {
+		attendancecorrectionCheckOutAt := runtime.ExtractTime(attendancecorrection.GetCheckOutAt())
+		m.SetCheckOutAt(attendancecorrectionCheckOutAt)
+	}
+	attendancecorrectionCreatedAt := runtime.ExtractTime(attendancecorrection.GetCreatedAt())
+	m.SetCreatedAt(attendancecorrectionCreatedAt)
+	attendancecorrectionEmployeeID := int(attendancecorrection.GetEmployeeId())
+	m.SetEmployeeID(attendancecorrectionEmployeeID)
+	attendancecorrectionOrgID := int(attendancecorrection.GetOrgId())
+	m.SetOrgID(attendancecorrectionOrgID)
+	attendancecorrectionReason := attendancecorrection.GetReason()
+	m.SetReason(attendancecorrectionReason)
+	if attendancecorrection.GetReviewComment() != nil {
+		attendancecorrectionReviewComment := attendancecorrection.GetReviewComment().GetValue()
+		m.SetReviewComment(attendancecorrectionReviewComment)
+	}
+	if attendancecorrection.GetReviewedAt() != nil {
+		attendancecorrectionReviewedAt := runtime.ExtractTime(attendancecorrection.GetReviewedAt())
+		m.SetReviewedAt(attendancecorrectionReviewedAt)
+	}
+	if attendancecorrection.GetReviewerId() != nil {
+		attendancecorrectionReviewerID := int(attendancecorrection.GetReviewerId().GetValue())
+		m.SetReviewerID(attendancecorrectionReviewerID)
+	}
+	attendancecorrectionStatus := toEntAttendanceCorrection_Status(attendancecorrection.GetStatus())
+	m.SetStatus(attendancecorrectionStatus)
+	attendancecorrectionUpdatedAt := runtime.ExtractTime(attendancecorrection.GetUpdatedAt())
+	m.SetUpdatedAt(attendancecorrectionUpdatedAt)
+	attendancecorrectionWorkDate := runtime.ExtractTime(attendancecorrection.GetWorkDate())
+	m.SetWorkDate(attendancecorrectionWorkDate)
+	if attendancecorrection.GetAttendanceRecord() != nil {
+		attendancecorrectionAttendanceRecord := int(attendancecorrection.GetAttendanceRecord().GetId())
+		m.SetAttendanceRecordID(attendancecorrectionAttendanceRecord)
+	}
+	if attendancecorrection.GetEmployee() != nil {
+		attendancecorrectionEmployee := int(attendancecorrection.GetEmployee().GetId())
+		m.SetEmployeeID(attendancecorrectionEmployee)
+	}
+	return m, nil
+}
@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	attendancecorrection "github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	attendancerecord "github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	organization "github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// AttendanceRecordService implements AttendanceRecordServiceServer
+type AttendanceRecordService struct {
+	client *ent.Client
+	UnimplementedAttendanceRecordServiceServer
+}
+
+// NewAttendanceRecordService returns a new AttendanceRecordService
+func NewAttendanceRecordService(client *ent.Client) *AttendanceRecordService {
+	return &AttendanceRecordService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpAttendanceRecord_Source = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeAttendanceRecord_Source(e string) string {
+	return protoIdentNormalizeRegexpAttendanceRecord_Source.ReplaceAllString(e, "_")
+}
+
+func toProtoAttendanceRecord_Source(e attendancerecord.Source) AttendanceRecord_Source {
+	if v, ok := AttendanceRecord_Source_value[strings.ToUpper("SOURCE_"+protoIdentNormalizeAttendanceRecord_Source(string(e)))]; ok {
+		return AttendanceRecord_Source(v)
+	}
+	return AttendanceRecord_Source(0)
+}
+
+func toEntAttendanceRecord_Source(e AttendanceRecord_Source) attendancerecord.Source {
+	if v, ok := AttendanceRecord_Source_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"SOURCE_WEB":    "web",
+			"SOURCE_MOBILE": "mobile",
+			"SOURCE_KIOSK":  "kiosk",
+		}[v]
+		return attendancerecord.Source(entVal)
+	}
+	return ""
+}
+
+// toProtoAttendanceRecord transforms the ent type to the pb type
+func toProtoAttendanceRecord(e *ent.AttendanceRecord) (*AttendanceRecord, error) {
+	v := &AttendanceRecord{}
+	check_in_at := timestamppb.New(e.CheckInAt)
+	v.CheckInAt = check_in_at
+	if e.CheckOutAt != nil {
+		check_out_at := timestamppb.New(*e.CheckOutAt)
+		v.CheckOutAt = check_out_at
+	}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	employee := int64(e.EmployeeID)
+	v.EmployeeId = employee
+	id := int64(e.ID)
+	v.Id = id
+	if e.Latitude != nil {
+		latitude := wrapperspb.Double(*e.Latitude)
+		v.Latitude = latitude
+	}
+	if e.Longitude != nil {
+		longitude := wrapperspb.Double(*e.Longitude)
+		v.Longitude = longitude
+	}
+	if e.Note != nil {
+		note := wrapperspb.String(*e.Note)
+		v.Note = note
+	}
+	organization := int64(e.OrgID)
+	v.OrgId = organization
+	source := toProtoAttendanceRecord_Source(e.Source)
+	v.Source = source
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	work_date := timestamppb.New(e.WorkDate)
+	v.WorkDate = work_date
+	for _, edg := range e.Edges.Corrections {
+		id := int64(edg.ID)
+		v.Corrections = append(v.Corrections, &AttendanceCorrection{
+			Id: id,
+		})
+	}
+	if edg := e.Edges.Employee; edg != nil {
+		id := int64(edg.ID)
+		v.Employee = &Employee{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Organization; edg != nil {
+		id := int64(edg.ID)
+		v.Organization = &Organization{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoAttendanceRecordList transforms a list of ent type to a list of pb type
+func toProtoAttendanceRecordList(e []*ent.AttendanceRecord) ([]*AttendanceRecord, error) {
+	var pbList []*AttendanceRecord
+	for _, entEntity := range e {
+		pbEntity, err := toProtoAttendanceRecord(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements AttendanceRecordServiceServer.Create
+func (svc *AttendanceRecordService) Create(ctx context.Context, req *CreateAttendanceRecordRequest) (*AttendanceRecord, error) {
+	attendancerecord := req.GetAttendanceRecord()
+	m, err := svc.createBuilder(attendancerecord)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoAttendanceRecord(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements AttendanceRecordServiceServer.Get
+func (svc *AttendanceRecordService) Get(ctx context.Context, req *GetAttendanceRecordRequest) (*AttendanceRecord, error) {
+	var (
+		err error
+		get *ent.AttendanceRecord
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetAttendanceRecordRequest_VIEW_UNSPECIFIED, GetAttendanceRecordRequest_BASIC:
+		get, err = svc.client.AttendanceRecord.Get(ctx, id)
+	case GetAttendanceRecordRequest_WITH_EDGE_IDS:
+		get, err = svc.client.AttendanceRecord.Query().
+			Where(attendancerecord.ID(id)).
+			WithCorrections(func(query *ent.AttendanceCorrectionQuery) {
+				query.Select(attendancecorrection.FieldID)
+			}).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoAttendanceRecord(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements AttendanceRecordServiceServer.Update
+func (svc *AttendanceRecordService) Update(ctx context.Context, req *UpdateAttendanceRecordRequest) (*AttendanceRecord, error) {
+	attendancerecord := req.GetAttendanceRecord()
+	attendancerecordID := int(attendancerecord.GetId())
+	m := svc.client.AttendanceRecord.UpdateOneID(attendancerecordID)
+	attendancerecordCheckInAt := runtime.ExtractTime(attendancerecord.GetCheckInAt())
+	m.SetCheckInAt(attendancerecordCheckInAt)
+	if attendancerecord.GetCheckOutAt() != nil {
+		attendancerecordCheckOutAt := runtime.ExtractTime(attendancerecord.GetCheckOutAt())
+		m.SetCheckOutAt(attendancerecordCheckOutAt)
+	}
+	attendancerecordCreatedAt := runtime.ExtractTime(attendancerecord.GetCreatedAt())
+	m.SetCreatedAt(attendancerecordCreatedAt)
+	attendancerecordEmployeeID := int(attendancerecord.GetEmployeeId())
+	m.SetEmployeeID(attendancerecordEmployeeID)
+	if attendancerecord.GetLatitude() != nil {
+		attendancerecordLatitude := float64(attendancerecord.GetLatitude().GetValue())
+		m.SetLatitude(attendancerecordLatitude)
+	}
+	if attendancerecord.GetLongitude() != nil {
+		attendancerecordLongitude := float64(attendancerecord.GetLongitude().GetValue())
+		m.SetLongitude(attendancerecordLongitude)
+	}
+	if attendancerecord.GetNote() != nil {
+		attendancerecordNote := attendancerecord.GetNote().GetValue()
+		m.SetNote(attendancerecordNote)
+	}
+	attendancerecordOrgID := int(attendancerecord.GetOrgId())
+	m.SetOrgID(attendancerecordOrgID)
+	attendancerecordSource := toEntAttendanceRecord_Source(attendancerecord.GetSource())
+	m.SetSource(attendancerecordSource)
+	attendancerecordUpdatedAt := runtime.ExtractTime(attendancerecord.GetUpdatedAt())
+	m.SetUpdatedAt(attendancerecordUpdatedAt)
+	attendancerecordWorkDate := runtime.ExtractTime(attendancerecord.GetWorkDate())
+	m.SetWorkDate(attendancerecordWorkDate)
+	for _, item := range attendancerecord.GetCorrections() {
+		corrections := int(item.GetId())
+		m.AddCorrectionIDs(corrections)
+	}
+	if attendancerecord.GetEmployee() != nil {
+		attendancerecordEmployee := int(attendancerecord.GetEmployee().GetId())
+		m.SetEmployeeID(attendancerecordEmployee)
+	}
+	if attendancerecord.GetOrganization() != nil {
+		attendancerecordOrganization := int(attendancerecord.GetOrganization().GetId())
+		m.SetOrganizationID(attendancerecordOrganization)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoAttendanceRecord(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements AttendanceRecordServiceServer.Delete
+func (svc *AttendanceRecordService) Delete(ctx context.Context, req *DeleteAttendanceRecordRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.AttendanceRecord.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements AttendanceRecordServiceServer.List
+func (svc *AttendanceRecordService) List(ctx context.Context, req *ListAttendanceRecordRequest) (*ListAttendanceRecordResponse, error) {
+	var (
+		err      error
+		entList  []*ent.AttendanceRecord
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.AttendanceRecord.Query().
+		Order(ent.Desc(attendancerecord.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(attendancerecord.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListAttendanceRecordRequest_VIEW_UNSPECIFIED, ListAttendanceRecordRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListAttendanceRecordRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithCorrections(func(query *ent.AttendanceCorrectionQuery) {
+				query.Select(attendancecorrection.FieldID)
+			}).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoAttendanceRecordList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListAttendanceRecordResponse{
+			AttendanceRecordList: protoList,
+			NextPageToken:        nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements AttendanceRecordServiceServer.BatchCreate
+func (svc *AttendanceRecordService) BatchCreate(ctx context.Context, req *BatchCreateAttendanceRecordsRequest) (*BatchCreateAttendanceRecordsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.AttendanceRecordCreate, len(requests))
+	for i, req := range requests {
+		attendancerecord := req.GetAttendanceRecord()
+		var err error
+		bulk[i], err = svc.createBuilder(attendancerecord)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.AttendanceRecord.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoAttendanceRecordList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateAttendanceRecordsResponse{
+			AttendanceRecords: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *AttendanceRecordService) createBuilder(attendancerecord *AttendanceRecord) (*ent.AttendanceRecordCreate, error) {
+	m := svc.client.AttendanceRecord.Create()
+	attendancerecordCheckInAt := runtime.ExtractTime(attendancerecord.GetCheckInAt())
+	m.SetCheckInAt(attendancerecordCheckInAt)
+	if attendancerecord.GetCheckOutAt() != nil {
+		attendancerecordCheckOutAt := runtime.ExtractTime(attendancerecord.GetCheckOutAt())
+		m.SetCheckOutAt(attendancerecordCheckOutAt)
+	}
+	attendancerecordCreatedAt := runtime.ExtractTime(attendancerecord.GetCreatedAt())
+	m.SetCreatedAt(attendancerecordCreatedAt)
+	attendancerecordEmployeeID := int(attendancerecord.GetEmployeeId())
+	m.SetEmployeeID(attendancerecordEmployeeID)
+	if attendancerecord.GetLatitude() != nil {
+		attendancerecordLatitude := float64(attendancerecord.GetLatitude().GetValue())
+		m.SetLatitude(attendancerecordLatitude)
+	}
+	if attendancerecord.GetLongitude() != nil {
+		attendancerecordLongitude := float64(attendancerecord.GetLongitude().GetValue())
+		m.SetLongitude(attendancerecordLongitude)
+	}
+	if attendancerecord.GetNote() != nil {
+		attendancerecordNote := attendancerecord.GetNote().GetValue()
+		m.SetNote(attendancerecordNote)
+	}
+	attendancerecordOrgID := int(attendancerecord.GetOrgId())
+	m.SetOrgID(attendancerecordOrgID)
+	attendancerecordSource := toEntAttendanceRecord_Source(attendancerecord.GetSource())
+	m.SetSource(attendancerecordSource)
+	attendancerecordUpdatedAt := runtime.ExtractTime(attendancerecord.GetUpdatedAt())
+	m.SetUpdatedAt(attendancerecordUpdatedAt)
+	attendancerecordWorkDate := runtime.ExtractTime(attendancerecord.GetWorkDate())
+	m.SetWorkDate(attendancerecordWorkDate)
+	for _, item := range attendancerecord.GetCorrections() {
+		corrections := int(item.GetId())
+		m.AddCorrectionIDs(corrections)
+	}
+	if attendancerecord.GetEmployee() != nil {
+		attendancerecordEmployee := int(attendancerecord.GetEmployee().GetId())
+		m.SetEmployeeID(attendancerecordEmployee)
+	}
+	if attendancerecord.GetOrganization() != nil {
+		attendancerecordOrganization := int(attendancerecord.GetOrganization().GetId())
+		m.SetOrganizationID(attendancerecordOrganization)
+	}
+	return m, nil
+}
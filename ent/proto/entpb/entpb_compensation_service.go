@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	compensation "github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	salarygrade "github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// CompensationService implements CompensationServiceServer
+type CompensationService struct {
+	client *ent.Client
+	UnimplementedCompensationServiceServer
+}
+
+// NewCompensationService returns a new CompensationService
+func NewCompensationService(client *ent.Client) *CompensationService {
+	return &CompensationService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpCompensation_Status = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeCompensation_Status(e string) string {
+	return protoIdentNormalizeRegexpCompensation_Status.ReplaceAllString(e, "_")
+}
+
+func toProtoCompensation_Status(e compensation.Status) Compensation_Status {
+	if v, ok := Compensation_Status_value[strings.ToUpper("STATUS_"+protoIdentNormalizeCompensation_Status(string(e)))]; ok {
+		return Compensation_Status(v)
+	}
+	return Compensation_Status(0)
+}
+
+func toEntCompensation_Status(e Compensation_Status) compensation.Status {
+	if v, ok := Compensation_Status_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATUS_PENDING":  "pending",
+			"STATUS_APPROVED": "approved",
+			"STATUS_REJECTED": "rejected",
+		}[v]
+		return compensation.Status(entVal)
+	}
+	return ""
+}
+
+// toProtoCompensation transforms the ent type to the pb type
+func toProtoCompensation(e *ent.Compensation) (*Compensation, error) {
+	v := &Compensation{}
+	base_pay := e.BasePay
+	v.BasePay = base_pay
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	currency := e.Currency
+	v.Currency = currency
+	effective_from := timestamppb.New(e.EffectiveFrom)
+	v.EffectiveFrom = effective_from
+	employee := int64(e.EmployeeID)
+	v.EmployeeId = employee
+	id := int64(e.ID)
+	v.Id = id
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	reason := wrapperspb.String(e.Reason)
+	v.Reason = reason
+	requester_id := int64(e.RequesterID)
+	v.RequesterId = requester_id
+	if e.ReviewComment != nil {
+		review_comment := wrapperspb.String(*e.ReviewComment)
+		v.ReviewComment = review_comment
+	}
+	if e.ReviewedAt != nil {
+		reviewed_at := timestamppb.New(*e.ReviewedAt)
+		v.ReviewedAt = reviewed_at
+	}
+	if e.ReviewerID != nil {
+		reviewer_id := wrapperspb.Int64(int64(*e.ReviewerID))
+		v.ReviewerId = reviewer_id
+	}
+	if e.SalaryGradeID != nil {
+		salary_grade := wrapperspb.Int64(int64(*e.SalaryGradeID))
+		v.SalaryGradeId = salary_grade
+	}
+	status := toProtoCompensation_Status(e.Status)
+	v.Status = status
+	total_allowance := e.TotalAllowance
+	v.TotalAllowance = total_allowance
+	if edg := e.Edges.Employee; edg != nil {
+		id := int64(edg.ID)
+		v.Employee = &Employee{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.SalaryGrade; edg != nil {
+		id := int64(edg.ID)
+		v.SalaryGrade = &SalaryGrade{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoCompensationList transforms a list of ent type to a list of pb type
+func toProtoCompensationList(e []*ent.Compensation) ([]*Compensation, error) {
+	var pbList []*Compensation
+	for _, entEntity := range e {
+		pbEntity, err := toProtoCompensation(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements CompensationServiceServer.Create
+func (svc *CompensationService) Create(ctx context.Context, req *CreateCompensationRequest) (*Compensation, error) {
+	compensation := req.GetCompensation()
+	m, err := svc.createBuilder(compensation)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoCompensation(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements CompensationServiceServer.Get
+func (svc *CompensationService) Get(ctx context.Context, req *GetCompensationRequest) (*Compensation, error) {
+	var (
+		err error
+		get *ent.Compensation
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetCompensationRequest_VIEW_UNSPECIFIED, GetCompensationRequest_BASIC:
+		get, err = svc.client.Compensation.Get(ctx, id)
+	case GetCompensationRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Compensation.Query().
+			Where(compensation.ID(id)).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithSalaryGrade(func(query *ent.SalaryGradeQuery) {
+				query.Select(salarygrade.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoCompensation(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements CompensationServiceServer.List
+func (svc *CompensationService) List(ctx context.Context, req *ListCompensationRequest) (*ListCompensationResponse, error) {
+	var (
+		err      error
+		entList  []*ent.Compensation
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.Compensation.Query().
+		Order(ent.Desc(compensation.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(compensation.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListCompensationRequest_VIEW_UNSPECIFIED, ListCompensationRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListCompensationRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithSalaryGrade(func(query *ent.SalaryGradeQuery) {
+				query.Select(salarygrade.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoCompensationList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListCompensationResponse{
+			CompensationList: protoList,
+			NextPageToken:    nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements CompensationServiceServer.BatchCreate
+func (svc *CompensationService) BatchCreate(ctx context.Context, req *BatchCreateCompensationsRequest) (*BatchCreateCompensationsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.CompensationCreate, len(requests))
+	for i, req := range requests {
+		compensation := req.GetCompensation()
+		var err error
+		bulk[i], err = svc.createBuilder(compensation)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.Compensation.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoCompensationList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateCompensationsResponse{
+			Compensations: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *CompensationService) createBuilder(compensation *Compensation) (*ent.CompensationCreate, error) {
+	m := svc.client.Compensation.Create()
+	compensationBasePay := int64(compensation.GetBasePay())
+	m.SetBasePay(compensationBasePay)
+	compensationCreatedAt := runtime.ExtractTime(compensation.GetCreatedAt())
+	m.SetCreatedAt(compensationCreatedAt)
+	compensationCurrency := compensation.GetCurrency()
+	m.SetCurrency(compensationCurrency)
+	compensationEffectiveFrom := runtime.ExtractTime(compensation.GetEffectiveFrom())
+	m.SetEffectiveFrom(compensationEffectiveFrom)
+	compensationEmployeeID := int(compensation.GetEmployeeId())
+	m.SetEmployeeID(compensationEmployeeID)
+	compensationOrgID := int(compensation.GetOrgId())
+	m.SetOrgID(compensationOrgID)
+	if compensation.GetReason() != nil {
+		compensationReason := compensation.GetReason().GetValue()
+		m.SetReason(compensationReason)
+	}
+	compensationRequesterID := int(compensation.GetRequesterId())
+	m.SetRequesterID(compensationRequesterID)
+	if compensation.GetReviewComment() != nil {
+		compensationReviewComment := compensation.GetReviewComment().GetValue()
+		m.SetReviewComment(compensationReviewComment)
+	}
+	if compensation.GetReviewedAt() != nil {
+		compensationReviewedAt := runtime.ExtractTime(compensation.GetReviewedAt())
+		m.SetReviewedAt(compensationReviewedAt)
+	}
+	if compensation.GetReviewerId() != nil {
+		compensationReviewerID := int(compensation.GetReviewerId().GetValue())
+		m.SetReviewerID(compensationReviewerID)
+	}
+	if compensation.GetSalaryGradeId() != nil {
+		compensationSalaryGradeID := int(compensation.GetSalaryGradeId().GetValue())
+		m.SetSalaryGradeID(compensationSalaryGradeID)
+	}
+	compensationStatus := toEntCompensation_Status(compensation.GetStatus())
+	m.SetStatus(compensationStatus)
+	compensationTotalAllowance := int64(compensation.GetTotalAllowance())
+	m.SetTotalAllowance(compensationTotalAllowance)
+	if compensation.GetEmployee() != nil {
+		compensationEmployee := int(compensation.GetEmployee().GetId())
+		m.SetEmployeeID(compensationEmployee)
+	}
+	if compensation.GetSalaryGrade() != nil {
+		compensationSalaryGrade := int(compensation.GetSalaryGrade().GetId())
+		m.SetSalaryGradeID(compensationSalaryGrade)
+	}
+	return m, nil
+}
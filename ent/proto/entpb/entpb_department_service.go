@@ -9,6 +9,7 @@ import (
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	fmt "fmt"
 	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	appointmenthistory "github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
 	department "github.com/longgggwwww/hrm-ms-hr/ent/department"
 	organization "github.com/longgggwwww/hrm-ms-hr/ent/organization"
 	position "github.com/longgggwwww/hrm-ms-hr/ent/position"
@@ -47,6 +48,12 @@ func toProtoDepartment(e *ent.Department) (*Department, error) {
 	v.OrgId = organization
 	updated_at := timestamppb.New(e.UpdatedAt)
 	v.UpdatedAt = updated_at
+	for _, edg := range e.Edges.AppointmentHistories {
+		id := int64(edg.ID)
+		v.AppointmentHistories = append(v.AppointmentHistories, &AppointmentHistory{
+			Id: id,
+		})
+	}
 	if edg := e.Edges.Organization; edg != nil {
 		id := int64(edg.ID)
 		v.Organization = &Organization{
@@ -113,6 +120,9 @@ func (svc *DepartmentService) Get(ctx context.Context, req *GetDepartmentRequest
 	case GetDepartmentRequest_WITH_EDGE_IDS:
 		get, err = svc.client.Department.Query().
 			Where(department.ID(id)).
+			WithAppointmentHistories(func(query *ent.AppointmentHistoryQuery) {
+				query.Select(appointmenthistory.FieldID)
+			}).
 			WithOrganization(func(query *ent.OrganizationQuery) {
 				query.Select(organization.FieldID)
 			}).
@@ -147,6 +157,10 @@ func (svc *DepartmentService) Update(ctx context.Context, req *UpdateDepartmentR
 	m.SetOrgID(departmentOrgID)
 	departmentUpdatedAt := runtime.ExtractTime(department.GetUpdatedAt())
 	m.SetUpdatedAt(departmentUpdatedAt)
+	for _, item := range department.GetAppointmentHistories() {
+		appointmenthistories := int(item.GetId())
+		m.AddAppointmentHistoryIDs(appointmenthistories)
+	}
 	if department.GetOrganization() != nil {
 		departmentOrganization := int(department.GetOrganization().GetId())
 		m.SetOrganizationID(departmentOrganization)
@@ -225,6 +239,9 @@ func (svc *DepartmentService) List(ctx context.Context, req *ListDepartmentReque
 		entList, err = listQuery.All(ctx)
 	case ListDepartmentRequest_WITH_EDGE_IDS:
 		entList, err = listQuery.
+			WithAppointmentHistories(func(query *ent.AppointmentHistoryQuery) {
+				query.Select(appointmenthistory.FieldID)
+			}).
 			WithOrganization(func(query *ent.OrganizationQuery) {
 				query.Select(organization.FieldID)
 			}).
@@ -302,6 +319,10 @@ func (svc *DepartmentService) createBuilder(department *Department) (*ent.Depart
 	m.SetOrgID(departmentOrgID)
 	departmentUpdatedAt := runtime.ExtractTime(department.GetUpdatedAt())
 	m.SetUpdatedAt(departmentUpdatedAt)
+	for _, item := range department.GetAppointmentHistories() {
+		appointmenthistories := int(item.GetId())
+		m.AddAppointmentHistoryIDs(appointmenthistories)
+	}
 	if department.GetOrganization() != nil {
 		departmentOrganization := int(department.GetOrganization().GetId())
 		m.SetOrganizationID(departmentOrganization)
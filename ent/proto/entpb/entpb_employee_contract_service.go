@@ -0,0 +1,383 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	employeecontract "github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// EmployeeContractService implements EmployeeContractServiceServer
+type EmployeeContractService struct {
+	client *ent.Client
+	UnimplementedEmployeeContractServiceServer
+}
+
+// NewEmployeeContractService returns a new EmployeeContractService
+func NewEmployeeContractService(client *ent.Client) *EmployeeContractService {
+	return &EmployeeContractService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpEmployeeContract_Status = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeEmployeeContract_Status(e string) string {
+	return protoIdentNormalizeRegexpEmployeeContract_Status.ReplaceAllString(e, "_")
+}
+
+func toProtoEmployeeContract_Status(e employeecontract.Status) EmployeeContract_Status {
+	if v, ok := EmployeeContract_Status_value[strings.ToUpper("STATUS_"+protoIdentNormalizeEmployeeContract_Status(string(e)))]; ok {
+		return EmployeeContract_Status(v)
+	}
+	return EmployeeContract_Status(0)
+}
+
+func toEntEmployeeContract_Status(e EmployeeContract_Status) employeecontract.Status {
+	if v, ok := EmployeeContract_Status_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATUS_ACTIVE":     "active",
+			"STATUS_EXPIRED":    "expired",
+			"STATUS_TERMINATED": "terminated",
+		}[v]
+		return employeecontract.Status(entVal)
+	}
+	return ""
+}
+
+var protoIdentNormalizeRegexpEmployeeContract_Type = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeEmployeeContract_Type(e string) string {
+	return protoIdentNormalizeRegexpEmployeeContract_Type.ReplaceAllString(e, "_")
+}
+
+func toProtoEmployeeContract_Type(e employeecontract.Type) EmployeeContract_Type {
+	if v, ok := EmployeeContract_Type_value[strings.ToUpper("TYPE_"+protoIdentNormalizeEmployeeContract_Type(string(e)))]; ok {
+		return EmployeeContract_Type(v)
+	}
+	return EmployeeContract_Type(0)
+}
+
+func toEntEmployeeContract_Type(e EmployeeContract_Type) employeecontract.Type {
+	if v, ok := EmployeeContract_Type_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"TYPE_FIXED_TERM": "fixed_term",
+			"TYPE_PROBATION":  "probation",
+			"TYPE_INDEFINITE": "indefinite",
+			"TYPE_SEASONAL":   "seasonal",
+		}[v]
+		return employeecontract.Type(entVal)
+	}
+	return ""
+}
+
+// toProtoEmployeeContract transforms the ent type to the pb type
+func toProtoEmployeeContract(e *ent.EmployeeContract) (*EmployeeContract, error) {
+	v := &EmployeeContract{}
+	code := e.Code
+	v.Code = code
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	employee := int64(e.EmployeeID)
+	v.EmployeeId = employee
+	if e.EndAt != nil {
+		end_at := timestamppb.New(*e.EndAt)
+		v.EndAt = end_at
+	}
+	id := int64(e.ID)
+	v.Id = id
+	if e.Note != nil {
+		note := wrapperspb.String(*e.Note)
+		v.Note = note
+	}
+	start_at := timestamppb.New(e.StartAt)
+	v.StartAt = start_at
+	status := toProtoEmployeeContract_Status(e.Status)
+	v.Status = status
+	_type := toProtoEmployeeContract_Type(e.Type)
+	v.Type = _type
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Employee; edg != nil {
+		id := int64(edg.ID)
+		v.Employee = &Employee{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoEmployeeContractList transforms a list of ent type to a list of pb type
+func toProtoEmployeeContractList(e []*ent.EmployeeContract) ([]*EmployeeContract, error) {
+	var pbList []*EmployeeContract
+	for _, entEntity := range e {
+		pbEntity, err := toProtoEmployeeContract(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements EmployeeContractServiceServer.Create
+func (svc *EmployeeContractService) Create(ctx context.Context, req *CreateEmployeeContractRequest) (*EmployeeContract, error) {
+	employeecontract := req.GetEmployeeContract()
+	m, err := svc.createBuilder(employeecontract)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoEmployeeContract(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements EmployeeContractServiceServer.Get
+func (svc *EmployeeContractService) Get(ctx context.Context, req *GetEmployeeContractRequest) (*EmployeeContract, error) {
+	var (
+		err error
+		get *ent.EmployeeContract
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetEmployeeContractRequest_VIEW_UNSPECIFIED, GetEmployeeContractRequest_BASIC:
+		get, err = svc.client.EmployeeContract.Get(ctx, id)
+	case GetEmployeeContractRequest_WITH_EDGE_IDS:
+		get, err = svc.client.EmployeeContract.Query().
+			Where(employeecontract.ID(id)).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoEmployeeContract(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements EmployeeContractServiceServer.Update
+func (svc *EmployeeContractService) Update(ctx context.Context, req *UpdateEmployeeContractRequest) (*EmployeeContract, error) {
+	employeecontract := req.GetEmployeeContract()
+	employeecontractID := int(employeecontract.GetId())
+	m := svc.client.EmployeeContract.UpdateOneID(employeecontractID)
+	employeecontractCode := employeecontract.GetCode()
+	m.SetCode(employeecontractCode)
+	employeecontractEmployeeID := int(employeecontract.GetEmployeeId())
+	m.SetEmployeeID(employeecontractEmployeeID)
+	if employeecontract.GetEndAt() != nil {
+		employeecontractEndAt := runtime.ExtractTime(employeecontract.GetEndAt())
+		m.SetEndAt(employeecontractEndAt)
+	}
+	if employeecontract.GetNote() != nil {
+		employeecontractNote := employeecontract.GetNote().GetValue()
+		m.SetNote(employeecontractNote)
+	}
+	employeecontractStartAt := runtime.ExtractTime(employeecontract.GetStartAt())
+	m.SetStartAt(employeecontractStartAt)
+	employeecontractStatus := toEntEmployeeContract_Status(employeecontract.GetStatus())
+	m.SetStatus(employeecontractStatus)
+	employeecontractType := toEntEmployeeContract_Type(employeecontract.GetType())
+	m.SetType(employeecontractType)
+	employeecontractUpdatedAt := runtime.ExtractTime(employeecontract.GetUpdatedAt())
+	m.SetUpdatedAt(employeecontractUpdatedAt)
+	if employeecontract.GetEmployee() != nil {
+		employeecontractEmployee := int(employeecontract.GetEmployee().GetId())
+		m.SetEmployeeID(employeecontractEmployee)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoEmployeeContract(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements EmployeeContractServiceServer.Delete
+func (svc *EmployeeContractService) Delete(ctx context.Context, req *DeleteEmployeeContractRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.EmployeeContract.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements EmployeeContractServiceServer.List
+func (svc *EmployeeContractService) List(ctx context.Context, req *ListEmployeeContractRequest) (*ListEmployeeContractResponse, error) {
+	var (
+		err      error
+		entList  []*ent.EmployeeContract
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.EmployeeContract.Query().
+		Order(ent.Desc(employeecontract.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(employeecontract.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListEmployeeContractRequest_VIEW_UNSPECIFIED, ListEmployeeContractRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListEmployeeContractRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoEmployeeContractList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListEmployeeContractResponse{
+			EmployeeContractList: protoList,
+			NextPageToken:        nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements EmployeeContractServiceServer.BatchCreate
+func (svc *EmployeeContractService) BatchCreate(ctx context.Context, req *BatchCreateEmployeeContractsRequest) (*BatchCreateEmployeeContractsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.EmployeeContractCreate, len(requests))
+	for i, req := range requests {
+		employeecontract := req.GetEmployeeContract()
+		var err error
+		bulk[i], err = svc.createBuilder(employeecontract)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.EmployeeContract.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoEmployeeContractList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateEmployeeContractsResponse{
+			EmployeeContracts: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *EmployeeContractService) createBuilder(employeecontract *EmployeeContract) (*ent.EmployeeContractCreate, error) {
+	m := svc.client.EmployeeContract.Create()
+	employeecontractCode := employeecontract.GetCode()
+	m.SetCode(employeecontractCode)
+	employeecontractCreatedAt := runtime.ExtractTime(employeecontract.GetCreatedAt())
+	m.SetCreatedAt(employeecontractCreatedAt)
+	employeecontractEmployeeID := int(employeecontract.GetEmployeeId())
+	m.SetEmployeeID(employeecontractEmployeeID)
+	if employeecontract.GetEndAt() != nil {
+		employeecontractEndAt := runtime.ExtractTime(employeecontract.GetEndAt())
+		m.SetEndAt(employeecontractEndAt)
+	}
+	if employeecontract.GetNote() != nil {
+		employeecontractNote := employeecontract.GetNote().GetValue()
+		m.SetNote(employeecontractNote)
+	}
+	employeecontractStartAt := runtime.ExtractTime(employeecontract.GetStartAt())
+	m.SetStartAt(employeecontractStartAt)
+	employeecontractStatus := toEntEmployeeContract_Status(employeecontract.GetStatus())
+	m.SetStatus(employeecontractStatus)
+	employeecontractType := toEntEmployeeContract_Type(employeecontract.GetType())
+	m.SetType(employeecontractType)
+	employeecontractUpdatedAt := runtime.ExtractTime(employeecontract.GetUpdatedAt())
+	m.SetUpdatedAt(employeecontractUpdatedAt)
+	if employeecontract.GetEmployee() != nil {
+		employeecontractEmployee := int(employeecontract.GetEmployee().GetId())
+		m.SetEmployeeID(employeecontractEmployee)
+	}
+	return m, nil
+}
@@ -10,13 +10,23 @@ import (
 	fmt "fmt"
 	ent "github.com/longgggwwww/hrm-ms-hr/ent"
 	appointmenthistory "github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	attachment "github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	attendancecorrection "github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	attendancerecord "github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	compensation "github.com/longgggwwww/hrm-ms-hr/ent/compensation"
 	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	employeecontract "github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	employeestatushistory "github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
 	leaveapproval "github.com/longgggwwww/hrm-ms-hr/ent/leaveapproval"
 	leaverequest "github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	overtimerequest "github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	position "github.com/longgggwwww/hrm-ms-hr/ent/position"
 	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	roster "github.com/longgggwwww/hrm-ms-hr/ent/roster"
 	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	taskcomment "github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
 	taskreport "github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	worklog "github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -97,6 +107,42 @@ func toProtoEmployee(e *ent.Employee) (*Employee, error) {
 			Id: id,
 		})
 	}
+	for _, edg := range e.Edges.Attachments {
+		id := int64(edg.ID)
+		v.Attachments = append(v.Attachments, &Attachment{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.AttendanceCorrections {
+		id := int64(edg.ID)
+		v.AttendanceCorrections = append(v.AttendanceCorrections, &AttendanceCorrection{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.AttendanceRecords {
+		id := int64(edg.ID)
+		v.AttendanceRecords = append(v.AttendanceRecords, &AttendanceRecord{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.CommentMentions {
+		id := int64(edg.ID)
+		v.CommentMentions = append(v.CommentMentions, &TaskComment{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Compensations {
+		id := int64(edg.ID)
+		v.Compensations = append(v.Compensations, &Compensation{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Contracts {
+		id := int64(edg.ID)
+		v.Contracts = append(v.Contracts, &EmployeeContract{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.CreatedProjects {
 		id := int64(edg.ID)
 		v.CreatedProjects = append(v.CreatedProjects, &Project{
@@ -115,6 +161,12 @@ func toProtoEmployee(e *ent.Employee) (*Employee, error) {
 			Id: id,
 		})
 	}
+	for _, edg := range e.Edges.OvertimeRequests {
+		id := int64(edg.ID)
+		v.OvertimeRequests = append(v.OvertimeRequests, &OvertimeRequest{
+			Id: id,
+		})
+	}
 	if edg := e.Edges.Position; edg != nil {
 		id := int64(edg.ID)
 		v.Position = &Position{
@@ -127,6 +179,30 @@ func toProtoEmployee(e *ent.Employee) (*Employee, error) {
 			Id: id,
 		})
 	}
+	for _, edg := range e.Edges.ReviewedTaskReports {
+		id := int64(edg.ID)
+		v.ReviewedTaskReports = append(v.ReviewedTaskReports, &TaskReport{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Rosters {
+		id := int64(edg.ID)
+		v.Rosters = append(v.Rosters, &Roster{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.StatusHistories {
+		id := int64(edg.ID)
+		v.StatusHistories = append(v.StatusHistories, &EmployeeStatusHistory{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.TaskComments {
+		id := int64(edg.ID)
+		v.TaskComments = append(v.TaskComments, &TaskComment{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.TaskReports {
 		id := int64(edg.ID)
 		v.TaskReports = append(v.TaskReports, &TaskReport{
@@ -139,6 +215,12 @@ func toProtoEmployee(e *ent.Employee) (*Employee, error) {
 			Id: id,
 		})
 	}
+	for _, edg := range e.Edges.WorkLogs {
+		id := int64(edg.ID)
+		v.WorkLogs = append(v.WorkLogs, &WorkLog{
+			Id: id,
+		})
+	}
 	return v, nil
 }
 
@@ -199,6 +281,24 @@ func (svc *EmployeeService) Get(ctx context.Context, req *GetEmployeeRequest) (*
 			WithAssignedTasks(func(query *ent.TaskQuery) {
 				query.Select(task.FieldID)
 			}).
+			WithAttachments(func(query *ent.AttachmentQuery) {
+				query.Select(attachment.FieldID)
+			}).
+			WithAttendanceCorrections(func(query *ent.AttendanceCorrectionQuery) {
+				query.Select(attendancecorrection.FieldID)
+			}).
+			WithAttendanceRecords(func(query *ent.AttendanceRecordQuery) {
+				query.Select(attendancerecord.FieldID)
+			}).
+			WithCommentMentions(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			WithCompensations(func(query *ent.CompensationQuery) {
+				query.Select(compensation.FieldID)
+			}).
+			WithContracts(func(query *ent.EmployeeContractQuery) {
+				query.Select(employeecontract.FieldID)
+			}).
 			WithCreatedProjects(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
@@ -208,18 +308,36 @@ func (svc *EmployeeService) Get(ctx context.Context, req *GetEmployeeRequest) (*
 			WithLeaveRequests(func(query *ent.LeaveRequestQuery) {
 				query.Select(leaverequest.FieldID)
 			}).
+			WithOvertimeRequests(func(query *ent.OvertimeRequestQuery) {
+				query.Select(overtimerequest.FieldID)
+			}).
 			WithPosition(func(query *ent.PositionQuery) {
 				query.Select(position.FieldID)
 			}).
 			WithProjects(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
+			WithReviewedTaskReports(func(query *ent.TaskReportQuery) {
+				query.Select(taskreport.FieldID)
+			}).
+			WithRosters(func(query *ent.RosterQuery) {
+				query.Select(roster.FieldID)
+			}).
+			WithStatusHistories(func(query *ent.EmployeeStatusHistoryQuery) {
+				query.Select(employeestatushistory.FieldID)
+			}).
+			WithTaskComments(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
 			WithTaskReports(func(query *ent.TaskReportQuery) {
 				query.Select(taskreport.FieldID)
 			}).
 			WithUpdatedProjects(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
+			WithWorkLogs(func(query *ent.WorkLogQuery) {
+				query.Select(worklog.FieldID)
+			}).
 			Only(ctx)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
@@ -264,6 +382,30 @@ func (svc *EmployeeService) Update(ctx context.Context, req *UpdateEmployeeReque
 		assignedtasks := int(item.GetId())
 		m.AddAssignedTaskIDs(assignedtasks)
 	}
+	for _, item := range employee.GetAttachments() {
+		attachments := int(item.GetId())
+		m.AddAttachmentIDs(attachments)
+	}
+	for _, item := range employee.GetAttendanceCorrections() {
+		attendancecorrections := int(item.GetId())
+		m.AddAttendanceCorrectionIDs(attendancecorrections)
+	}
+	for _, item := range employee.GetAttendanceRecords() {
+		attendancerecords := int(item.GetId())
+		m.AddAttendanceRecordIDs(attendancerecords)
+	}
+	for _, item := range employee.GetCommentMentions() {
+		commentmentions := int(item.GetId())
+		m.AddCommentMentionIDs(commentmentions)
+	}
+	for _, item := range employee.GetCompensations() {
+		compensations := int(item.GetId())
+		m.AddCompensationIDs(compensations)
+	}
+	for _, item := range employee.GetContracts() {
+		contracts := int(item.GetId())
+		m.AddContractIDs(contracts)
+	}
 	for _, item := range employee.GetCreatedProjects() {
 		createdprojects := int(item.GetId())
 		m.AddCreatedProjectIDs(createdprojects)
@@ -276,6 +418,10 @@ func (svc *EmployeeService) Update(ctx context.Context, req *UpdateEmployeeReque
 		leaverequests := int(item.GetId())
 		m.AddLeaveRequestIDs(leaverequests)
 	}
+	for _, item := range employee.GetOvertimeRequests() {
+		overtimerequests := int(item.GetId())
+		m.AddOvertimeRequestIDs(overtimerequests)
+	}
 	if employee.GetPosition() != nil {
 		employeePosition := int(employee.GetPosition().GetId())
 		m.SetPositionID(employeePosition)
@@ -284,6 +430,22 @@ func (svc *EmployeeService) Update(ctx context.Context, req *UpdateEmployeeReque
 		projects := int(item.GetId())
 		m.AddProjectIDs(projects)
 	}
+	for _, item := range employee.GetReviewedTaskReports() {
+		reviewedtaskreports := int(item.GetId())
+		m.AddReviewedTaskReportIDs(reviewedtaskreports)
+	}
+	for _, item := range employee.GetRosters() {
+		rosters := int(item.GetId())
+		m.AddRosterIDs(rosters)
+	}
+	for _, item := range employee.GetStatusHistories() {
+		statushistories := int(item.GetId())
+		m.AddStatusHistoryIDs(statushistories)
+	}
+	for _, item := range employee.GetTaskComments() {
+		taskcomments := int(item.GetId())
+		m.AddTaskCommentIDs(taskcomments)
+	}
 	for _, item := range employee.GetTaskReports() {
 		taskreports := int(item.GetId())
 		m.AddTaskReportIDs(taskreports)
@@ -292,6 +454,10 @@ func (svc *EmployeeService) Update(ctx context.Context, req *UpdateEmployeeReque
 		updatedprojects := int(item.GetId())
 		m.AddUpdatedProjectIDs(updatedprojects)
 	}
+	for _, item := range employee.GetWorkLogs() {
+		worklogs := int(item.GetId())
+		m.AddWorkLogIDs(worklogs)
+	}
 
 	res, err := m.Save(ctx)
 	switch {
@@ -368,6 +534,24 @@ func (svc *EmployeeService) List(ctx context.Context, req *ListEmployeeRequest)
 			WithAssignedTasks(func(query *ent.TaskQuery) {
 				query.Select(task.FieldID)
 			}).
+			WithAttachments(func(query *ent.AttachmentQuery) {
+				query.Select(attachment.FieldID)
+			}).
+			WithAttendanceCorrections(func(query *ent.AttendanceCorrectionQuery) {
+				query.Select(attendancecorrection.FieldID)
+			}).
+			WithAttendanceRecords(func(query *ent.AttendanceRecordQuery) {
+				query.Select(attendancerecord.FieldID)
+			}).
+			WithCommentMentions(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			WithCompensations(func(query *ent.CompensationQuery) {
+				query.Select(compensation.FieldID)
+			}).
+			WithContracts(func(query *ent.EmployeeContractQuery) {
+				query.Select(employeecontract.FieldID)
+			}).
 			WithCreatedProjects(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
@@ -377,18 +561,36 @@ func (svc *EmployeeService) List(ctx context.Context, req *ListEmployeeRequest)
 			WithLeaveRequests(func(query *ent.LeaveRequestQuery) {
 				query.Select(leaverequest.FieldID)
 			}).
+			WithOvertimeRequests(func(query *ent.OvertimeRequestQuery) {
+				query.Select(overtimerequest.FieldID)
+			}).
 			WithPosition(func(query *ent.PositionQuery) {
 				query.Select(position.FieldID)
 			}).
 			WithProjects(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
+			WithReviewedTaskReports(func(query *ent.TaskReportQuery) {
+				query.Select(taskreport.FieldID)
+			}).
+			WithRosters(func(query *ent.RosterQuery) {
+				query.Select(roster.FieldID)
+			}).
+			WithStatusHistories(func(query *ent.EmployeeStatusHistoryQuery) {
+				query.Select(employeestatushistory.FieldID)
+			}).
+			WithTaskComments(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
 			WithTaskReports(func(query *ent.TaskReportQuery) {
 				query.Select(taskreport.FieldID)
 			}).
 			WithUpdatedProjects(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
+			WithWorkLogs(func(query *ent.WorkLogQuery) {
+				query.Select(worklog.FieldID)
+			}).
 			All(ctx)
 	}
 	switch {
@@ -476,6 +678,30 @@ func (svc *EmployeeService) createBuilder(employee *Employee) (*ent.EmployeeCrea
 		assignedtasks := int(item.GetId())
 		m.AddAssignedTaskIDs(assignedtasks)
 	}
+	for _, item := range employee.GetAttachments() {
+		attachments := int(item.GetId())
+		m.AddAttachmentIDs(attachments)
+	}
+	for _, item := range employee.GetAttendanceCorrections() {
+		attendancecorrections := int(item.GetId())
+		m.AddAttendanceCorrectionIDs(attendancecorrections)
+	}
+	for _, item := range employee.GetAttendanceRecords() {
+		attendancerecords := int(item.GetId())
+		m.AddAttendanceRecordIDs(attendancerecords)
+	}
+	for _, item := range employee.GetCommentMentions() {
+		commentmentions := int(item.GetId())
+		m.AddCommentMentionIDs(commentmentions)
+	}
+	for _, item := range employee.GetCompensations() {
+		compensations := int(item.GetId())
+		m.AddCompensationIDs(compensations)
+	}
+	for _, item := range employee.GetContracts() {
+		contracts := int(item.GetId())
+		m.AddContractIDs(contracts)
+	}
 	for _, item := range employee.GetCreatedProjects() {
 		createdprojects := int(item.GetId())
 		m.AddCreatedProjectIDs(createdprojects)
@@ -488,6 +714,10 @@ func (svc *EmployeeService) createBuilder(employee *Employee) (*ent.EmployeeCrea
 		leaverequests := int(item.GetId())
 		m.AddLeaveRequestIDs(leaverequests)
 	}
+	for _, item := range employee.GetOvertimeRequests() {
+		overtimerequests := int(item.GetId())
+		m.AddOvertimeRequestIDs(overtimerequests)
+	}
 	if employee.GetPosition() != nil {
 		employeePosition := int(employee.GetPosition().GetId())
 		m.SetPositionID(employeePosition)
@@ -496,6 +726,22 @@ func (svc *EmployeeService) createBuilder(employee *Employee) (*ent.EmployeeCrea
 		projects := int(item.GetId())
 		m.AddProjectIDs(projects)
 	}
+	for _, item := range employee.GetReviewedTaskReports() {
+		reviewedtaskreports := int(item.GetId())
+		m.AddReviewedTaskReportIDs(reviewedtaskreports)
+	}
+	for _, item := range employee.GetRosters() {
+		rosters := int(item.GetId())
+		m.AddRosterIDs(rosters)
+	}
+	for _, item := range employee.GetStatusHistories() {
+		statushistories := int(item.GetId())
+		m.AddStatusHistoryIDs(statushistories)
+	}
+	for _, item := range employee.GetTaskComments() {
+		taskcomments := int(item.GetId())
+		m.AddTaskCommentIDs(taskcomments)
+	}
 	for _, item := range employee.GetTaskReports() {
 		taskreports := int(item.GetId())
 		m.AddTaskReportIDs(taskreports)
@@ -504,5 +750,9 @@ func (svc *EmployeeService) createBuilder(employee *Employee) (*ent.EmployeeCrea
 		updatedprojects := int(item.GetId())
 		m.AddUpdatedProjectIDs(updatedprojects)
 	}
+	for _, item := range employee.GetWorkLogs() {
+		worklogs := int(item.GetId())
+		m.AddWorkLogIDs(worklogs)
+	}
 	return m, nil
 }
@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	employeestatushistory "github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	strconv "strconv"
+)
+
+// EmployeeStatusHistoryService implements EmployeeStatusHistoryServiceServer
+type EmployeeStatusHistoryService struct {
+	client *ent.Client
+	UnimplementedEmployeeStatusHistoryServiceServer
+}
+
+// NewEmployeeStatusHistoryService returns a new EmployeeStatusHistoryService
+func NewEmployeeStatusHistoryService(client *ent.Client) *EmployeeStatusHistoryService {
+	return &EmployeeStatusHistoryService{
+		client: client,
+	}
+}
+
+// toProtoEmployeeStatusHistory transforms the ent type to the pb type
+func toProtoEmployeeStatusHistory(e *ent.EmployeeStatusHistory) (*EmployeeStatusHistory, error) {
+	v := &EmployeeStatusHistory{}
+	changed_at := timestamppb.New(e.ChangedAt)
+	v.ChangedAt = changed_at
+	changed_by := wrapperspb.Int64(int64(e.ChangedBy))
+	v.ChangedBy = changed_by
+	employee := int64(e.EmployeeID)
+	v.EmployeeId = employee
+	from_status := e.FromStatus
+	v.FromStatus = from_status
+	id := int64(e.ID)
+	v.Id = id
+	note := wrapperspb.String(e.Note)
+	v.Note = note
+	to_status := e.ToStatus
+	v.ToStatus = to_status
+	if edg := e.Edges.Employee; edg != nil {
+		id := int64(edg.ID)
+		v.Employee = &Employee{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoEmployeeStatusHistoryList transforms a list of ent type to a list of pb type
+func toProtoEmployeeStatusHistoryList(e []*ent.EmployeeStatusHistory) ([]*EmployeeStatusHistory, error) {
+	var pbList []*EmployeeStatusHistory
+	for _, entEntity := range e {
+		pbEntity, err := toProtoEmployeeStatusHistory(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements EmployeeStatusHistoryServiceServer.Create
+func (svc *EmployeeStatusHistoryService) Create(ctx context.Context, req *CreateEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error) {
+	employeestatushistory := req.GetEmployeeStatusHistory()
+	m, err := svc.createBuilder(employeestatushistory)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoEmployeeStatusHistory(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements EmployeeStatusHistoryServiceServer.Get
+func (svc *EmployeeStatusHistoryService) Get(ctx context.Context, req *GetEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error) {
+	var (
+		err error
+		get *ent.EmployeeStatusHistory
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetEmployeeStatusHistoryRequest_VIEW_UNSPECIFIED, GetEmployeeStatusHistoryRequest_BASIC:
+		get, err = svc.client.EmployeeStatusHistory.Get(ctx, id)
+	case GetEmployeeStatusHistoryRequest_WITH_EDGE_IDS:
+		get, err = svc.client.EmployeeStatusHistory.Query().
+			Where(employeestatushistory.ID(id)).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoEmployeeStatusHistory(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements EmployeeStatusHistoryServiceServer.Update
+func (svc *EmployeeStatusHistoryService) Update(ctx context.Context, req *UpdateEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error) {
+	employeestatushistory := req.GetEmployeeStatusHistory()
+	employeestatushistoryID := int(employeestatushistory.GetId())
+	m := svc.client.EmployeeStatusHistory.UpdateOneID(employeestatushistoryID)
+	if employeestatushistory.GetChangedBy() != nil {
+		employeestatushistoryChangedBy := int(employeestatushistory.GetChangedBy().GetValue())
+		m.SetChangedBy(employeestatushistoryChangedBy)
+	}
+	employeestatushistoryEmployeeID := int(employeestatushistory.GetEmployeeId())
+	m.SetEmployeeID(employeestatushistoryEmployeeID)
+	employeestatushistoryFromStatus := employeestatushistory.GetFromStatus()
+	m.SetFromStatus(employeestatushistoryFromStatus)
+	if employeestatushistory.GetNote() != nil {
+		employeestatushistoryNote := employeestatushistory.GetNote().GetValue()
+		m.SetNote(employeestatushistoryNote)
+	}
+	employeestatushistoryToStatus := employeestatushistory.GetToStatus()
+	m.SetToStatus(employeestatushistoryToStatus)
+	if employeestatushistory.GetEmployee() != nil {
+		employeestatushistoryEmployee := int(employeestatushistory.GetEmployee().GetId())
+		m.SetEmployeeID(employeestatushistoryEmployee)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoEmployeeStatusHistory(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements EmployeeStatusHistoryServiceServer.Delete
+func (svc *EmployeeStatusHistoryService) Delete(ctx context.Context, req *DeleteEmployeeStatusHistoryRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.EmployeeStatusHistory.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements EmployeeStatusHistoryServiceServer.List
+func (svc *EmployeeStatusHistoryService) List(ctx context.Context, req *ListEmployeeStatusHistoryRequest) (*ListEmployeeStatusHistoryResponse, error) {
+	var (
+		err      error
+		entList  []*ent.EmployeeStatusHistory
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.EmployeeStatusHistory.Query().
+		Order(ent.Desc(employeestatushistory.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(employeestatushistory.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListEmployeeStatusHistoryRequest_VIEW_UNSPECIFIED, ListEmployeeStatusHistoryRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListEmployeeStatusHistoryRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoEmployeeStatusHistoryList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListEmployeeStatusHistoryResponse{
+			EmployeeStatusHistoryList: protoList,
+			NextPageToken:             nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements EmployeeStatusHistoryServiceServer.BatchCreate
+func (svc *EmployeeStatusHistoryService) BatchCreate(ctx context.Context, req *BatchCreateEmployeeStatusHistoriesRequest) (*BatchCreateEmployeeStatusHistoriesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.EmployeeStatusHistoryCreate, len(requests))
+	for i, req := range requests {
+		employeestatushistory := req.GetEmployeeStatusHistory()
+		var err error
+		bulk[i], err = svc.createBuilder(employeestatushistory)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.EmployeeStatusHistory.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoEmployeeStatusHistoryList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateEmployeeStatusHistoriesResponse{
+			EmployeeStatusHistories: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *EmployeeStatusHistoryService) createBuilder(employeestatushistory *EmployeeStatusHistory) (*ent.EmployeeStatusHistoryCreate, error) {
+	m := svc.client.EmployeeStatusHistory.Create()
+	employeestatushistoryChangedAt := runtime.ExtractTime(employeestatushistory.GetChangedAt())
+	m.SetChangedAt(employeestatushistoryChangedAt)
+	if employeestatushistory.GetChangedBy() != nil {
+		employeestatushistoryChangedBy := int(employeestatushistory.GetChangedBy().GetValue())
+		m.SetChangedBy(employeestatushistoryChangedBy)
+	}
+	employeestatushistoryEmployeeID := int(employeestatushistory.GetEmployeeId())
+	m.SetEmployeeID(employeestatushistoryEmployeeID)
+	employeestatushistoryFromStatus := employeestatushistory.GetFromStatus()
+	m.SetFromStatus(employeestatushistoryFromStatus)
+	if employeestatushistory.GetNote() != nil {
+		employeestatushistoryNote := employeestatushistory.GetNote().GetValue()
+		m.SetNote(employeestatushistoryNote)
+	}
+	employeestatushistoryToStatus := employeestatushistory.GetToStatus()
+	m.SetToStatus(employeestatushistoryToStatus)
+	if employeestatushistory.GetEmployee() != nil {
+		employeestatushistoryEmployee := int(employeestatushistory.GetEmployee().GetId())
+		m.SetEmployeeID(employeestatushistoryEmployee)
+	}
+	return m, nil
+}
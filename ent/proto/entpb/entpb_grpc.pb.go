@@ -315,291 +315,291 @@ var AppointmentHistoryService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	DepartmentService_Create_FullMethodName      = "/entpb.DepartmentService/Create"
-	DepartmentService_Get_FullMethodName         = "/entpb.DepartmentService/Get"
-	DepartmentService_Update_FullMethodName      = "/entpb.DepartmentService/Update"
-	DepartmentService_Delete_FullMethodName      = "/entpb.DepartmentService/Delete"
-	DepartmentService_List_FullMethodName        = "/entpb.DepartmentService/List"
-	DepartmentService_BatchCreate_FullMethodName = "/entpb.DepartmentService/BatchCreate"
+	AttachmentService_Create_FullMethodName      = "/entpb.AttachmentService/Create"
+	AttachmentService_Get_FullMethodName         = "/entpb.AttachmentService/Get"
+	AttachmentService_Update_FullMethodName      = "/entpb.AttachmentService/Update"
+	AttachmentService_Delete_FullMethodName      = "/entpb.AttachmentService/Delete"
+	AttachmentService_List_FullMethodName        = "/entpb.AttachmentService/List"
+	AttachmentService_BatchCreate_FullMethodName = "/entpb.AttachmentService/BatchCreate"
 )
 
-// DepartmentServiceClient is the client API for DepartmentService service.
+// AttachmentServiceClient is the client API for AttachmentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type DepartmentServiceClient interface {
-	Create(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
-	Get(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
-	Update(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
-	Delete(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListDepartmentRequest, opts ...grpc.CallOption) (*ListDepartmentResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateDepartmentsRequest, opts ...grpc.CallOption) (*BatchCreateDepartmentsResponse, error)
+type AttachmentServiceClient interface {
+	Create(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	Get(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	Update(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	Delete(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListAttachmentRequest, opts ...grpc.CallOption) (*ListAttachmentResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateAttachmentsRequest, opts ...grpc.CallOption) (*BatchCreateAttachmentsResponse, error)
 }
 
-type departmentServiceClient struct {
+type attachmentServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewDepartmentServiceClient(cc grpc.ClientConnInterface) DepartmentServiceClient {
-	return &departmentServiceClient{cc}
+func NewAttachmentServiceClient(cc grpc.ClientConnInterface) AttachmentServiceClient {
+	return &attachmentServiceClient{cc}
 }
 
-func (c *departmentServiceClient) Create(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+func (c *attachmentServiceClient) Create(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Department)
-	err := c.cc.Invoke(ctx, DepartmentService_Create_FullMethodName, in, out, cOpts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *departmentServiceClient) Get(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+func (c *attachmentServiceClient) Get(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Department)
-	err := c.cc.Invoke(ctx, DepartmentService_Get_FullMethodName, in, out, cOpts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *departmentServiceClient) Update(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+func (c *attachmentServiceClient) Update(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Department)
-	err := c.cc.Invoke(ctx, DepartmentService_Update_FullMethodName, in, out, cOpts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *departmentServiceClient) Delete(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *attachmentServiceClient) Delete(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, DepartmentService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AttachmentService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *departmentServiceClient) List(ctx context.Context, in *ListDepartmentRequest, opts ...grpc.CallOption) (*ListDepartmentResponse, error) {
+func (c *attachmentServiceClient) List(ctx context.Context, in *ListAttachmentRequest, opts ...grpc.CallOption) (*ListAttachmentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListDepartmentResponse)
-	err := c.cc.Invoke(ctx, DepartmentService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListAttachmentResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *departmentServiceClient) BatchCreate(ctx context.Context, in *BatchCreateDepartmentsRequest, opts ...grpc.CallOption) (*BatchCreateDepartmentsResponse, error) {
+func (c *attachmentServiceClient) BatchCreate(ctx context.Context, in *BatchCreateAttachmentsRequest, opts ...grpc.CallOption) (*BatchCreateAttachmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateDepartmentsResponse)
-	err := c.cc.Invoke(ctx, DepartmentService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateAttachmentsResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// DepartmentServiceServer is the server API for DepartmentService service.
-// All implementations must embed UnimplementedDepartmentServiceServer
+// AttachmentServiceServer is the server API for AttachmentService service.
+// All implementations must embed UnimplementedAttachmentServiceServer
 // for forward compatibility.
-type DepartmentServiceServer interface {
-	Create(context.Context, *CreateDepartmentRequest) (*Department, error)
-	Get(context.Context, *GetDepartmentRequest) (*Department, error)
-	Update(context.Context, *UpdateDepartmentRequest) (*Department, error)
-	Delete(context.Context, *DeleteDepartmentRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListDepartmentRequest) (*ListDepartmentResponse, error)
-	BatchCreate(context.Context, *BatchCreateDepartmentsRequest) (*BatchCreateDepartmentsResponse, error)
-	mustEmbedUnimplementedDepartmentServiceServer()
+type AttachmentServiceServer interface {
+	Create(context.Context, *CreateAttachmentRequest) (*Attachment, error)
+	Get(context.Context, *GetAttachmentRequest) (*Attachment, error)
+	Update(context.Context, *UpdateAttachmentRequest) (*Attachment, error)
+	Delete(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListAttachmentRequest) (*ListAttachmentResponse, error)
+	BatchCreate(context.Context, *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error)
+	mustEmbedUnimplementedAttachmentServiceServer()
 }
 
-// UnimplementedDepartmentServiceServer must be embedded to have
+// UnimplementedAttachmentServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedDepartmentServiceServer struct{}
+type UnimplementedAttachmentServiceServer struct{}
 
-func (UnimplementedDepartmentServiceServer) Create(context.Context, *CreateDepartmentRequest) (*Department, error) {
+func (UnimplementedAttachmentServiceServer) Create(context.Context, *CreateAttachmentRequest) (*Attachment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedDepartmentServiceServer) Get(context.Context, *GetDepartmentRequest) (*Department, error) {
+func (UnimplementedAttachmentServiceServer) Get(context.Context, *GetAttachmentRequest) (*Attachment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedDepartmentServiceServer) Update(context.Context, *UpdateDepartmentRequest) (*Department, error) {
+func (UnimplementedAttachmentServiceServer) Update(context.Context, *UpdateAttachmentRequest) (*Attachment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedDepartmentServiceServer) Delete(context.Context, *DeleteDepartmentRequest) (*emptypb.Empty, error) {
+func (UnimplementedAttachmentServiceServer) Delete(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedDepartmentServiceServer) List(context.Context, *ListDepartmentRequest) (*ListDepartmentResponse, error) {
+func (UnimplementedAttachmentServiceServer) List(context.Context, *ListAttachmentRequest) (*ListAttachmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedDepartmentServiceServer) BatchCreate(context.Context, *BatchCreateDepartmentsRequest) (*BatchCreateDepartmentsResponse, error) {
+func (UnimplementedAttachmentServiceServer) BatchCreate(context.Context, *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedDepartmentServiceServer) mustEmbedUnimplementedDepartmentServiceServer() {}
-func (UnimplementedDepartmentServiceServer) testEmbeddedByValue()                           {}
+func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
+func (UnimplementedAttachmentServiceServer) testEmbeddedByValue()                           {}
 
-// UnsafeDepartmentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DepartmentServiceServer will
+// UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServiceServer will
 // result in compilation errors.
-type UnsafeDepartmentServiceServer interface {
-	mustEmbedUnimplementedDepartmentServiceServer()
+type UnsafeAttachmentServiceServer interface {
+	mustEmbedUnimplementedAttachmentServiceServer()
 }
 
-func RegisterDepartmentServiceServer(s grpc.ServiceRegistrar, srv DepartmentServiceServer) {
-	// If the following call pancis, it indicates UnimplementedDepartmentServiceServer was
+func RegisterAttachmentServiceServer(s grpc.ServiceRegistrar, srv AttachmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttachmentServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&DepartmentService_ServiceDesc, srv)
+	s.RegisterService(&AttachmentService_ServiceDesc, srv)
 }
 
-func _DepartmentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDepartmentRequest)
+func _AttachmentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DepartmentServiceServer).Create(ctx, in)
+		return srv.(AttachmentServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DepartmentService_Create_FullMethodName,
+		FullMethod: AttachmentService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DepartmentServiceServer).Create(ctx, req.(*CreateDepartmentRequest))
+		return srv.(AttachmentServiceServer).Create(ctx, req.(*CreateAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DepartmentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDepartmentRequest)
+func _AttachmentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DepartmentServiceServer).Get(ctx, in)
+		return srv.(AttachmentServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DepartmentService_Get_FullMethodName,
+		FullMethod: AttachmentService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DepartmentServiceServer).Get(ctx, req.(*GetDepartmentRequest))
+		return srv.(AttachmentServiceServer).Get(ctx, req.(*GetAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DepartmentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDepartmentRequest)
+func _AttachmentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DepartmentServiceServer).Update(ctx, in)
+		return srv.(AttachmentServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DepartmentService_Update_FullMethodName,
+		FullMethod: AttachmentService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DepartmentServiceServer).Update(ctx, req.(*UpdateDepartmentRequest))
+		return srv.(AttachmentServiceServer).Update(ctx, req.(*UpdateAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DepartmentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDepartmentRequest)
+func _AttachmentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DepartmentServiceServer).Delete(ctx, in)
+		return srv.(AttachmentServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DepartmentService_Delete_FullMethodName,
+		FullMethod: AttachmentService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DepartmentServiceServer).Delete(ctx, req.(*DeleteDepartmentRequest))
+		return srv.(AttachmentServiceServer).Delete(ctx, req.(*DeleteAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DepartmentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListDepartmentRequest)
+func _AttachmentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DepartmentServiceServer).List(ctx, in)
+		return srv.(AttachmentServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DepartmentService_List_FullMethodName,
+		FullMethod: AttachmentService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DepartmentServiceServer).List(ctx, req.(*ListDepartmentRequest))
+		return srv.(AttachmentServiceServer).List(ctx, req.(*ListAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DepartmentService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateDepartmentsRequest)
+func _AttachmentService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateAttachmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DepartmentServiceServer).BatchCreate(ctx, in)
+		return srv.(AttachmentServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DepartmentService_BatchCreate_FullMethodName,
+		FullMethod: AttachmentService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DepartmentServiceServer).BatchCreate(ctx, req.(*BatchCreateDepartmentsRequest))
+		return srv.(AttachmentServiceServer).BatchCreate(ctx, req.(*BatchCreateAttachmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// DepartmentService_ServiceDesc is the grpc.ServiceDesc for DepartmentService service.
+// AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var DepartmentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.DepartmentService",
-	HandlerType: (*DepartmentServiceServer)(nil),
+var AttachmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.AttachmentService",
+	HandlerType: (*AttachmentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _DepartmentService_Create_Handler,
+			Handler:    _AttachmentService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _DepartmentService_Get_Handler,
+			Handler:    _AttachmentService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _DepartmentService_Update_Handler,
+			Handler:    _AttachmentService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _DepartmentService_Delete_Handler,
+			Handler:    _AttachmentService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _DepartmentService_List_Handler,
+			Handler:    _AttachmentService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _DepartmentService_BatchCreate_Handler,
+			Handler:    _AttachmentService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -607,291 +607,292 @@ var DepartmentService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EmployeeService_Create_FullMethodName      = "/entpb.EmployeeService/Create"
-	EmployeeService_Get_FullMethodName         = "/entpb.EmployeeService/Get"
-	EmployeeService_Update_FullMethodName      = "/entpb.EmployeeService/Update"
-	EmployeeService_Delete_FullMethodName      = "/entpb.EmployeeService/Delete"
-	EmployeeService_List_FullMethodName        = "/entpb.EmployeeService/List"
-	EmployeeService_BatchCreate_FullMethodName = "/entpb.EmployeeService/BatchCreate"
+	AttendanceCorrectionService_Create_FullMethodName      = "/entpb.AttendanceCorrectionService/Create"
+	AttendanceCorrectionService_Get_FullMethodName         = "/entpb.AttendanceCorrectionService/Get"
+	AttendanceCorrectionService_Update_FullMethodName      = "/entpb.AttendanceCorrectionService/Update"
+	AttendanceCorrectionService_Delete_FullMethodName      = "/entpb.AttendanceCorrectionService/Delete"
+	AttendanceCorrectionService_List_FullMethodName        = "/entpb.AttendanceCorrectionService/List"
+	AttendanceCorrectionService_BatchCreate_FullMethodName = "/entpb.AttendanceCorrectionService/BatchCreate"
 )
 
-// EmployeeServiceClient is the client API for EmployeeService service.
+// AttendanceCorrectionServiceClient is the client API for AttendanceCorrectionService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type EmployeeServiceClient interface {
-	Create(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
-	Get(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
-	Update(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
-	Delete(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListEmployeeRequest, opts ...grpc.CallOption) (*ListEmployeeResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateEmployeesRequest, opts ...grpc.CallOption) (*BatchCreateEmployeesResponse, error)
+type AttendanceCorrectionServiceClient interface {
+	Create(ctx context.Context, in *CreateAttendanceCorrectionRequest, opts ...grpc.CallOption) (*AttendanceCorrection, error)
+	Get(ctx context.Context, in *GetAttendanceCorrectionRequest, opts ...grpc.CallOption) (*AttendanceCorrection, error)
+	Update(ctx context.Context, in *UpdateAttendanceCorrectionRequest, opts ...grpc.CallOption) (*AttendanceCorrection, error)
+	Delete(ctx context.Context, in *DeleteAttendanceCorrectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListAttendanceCorrectionRequest, opts ...grpc.CallOption) (*ListAttendanceCorrectionResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateAttendanceCorrectionsRequest, opts ...grpc.CallOption) (*BatchCreateAttendanceCorrectionsResponse, error)
 }
 
-type employeeServiceClient struct {
+type attendanceCorrectionServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewEmployeeServiceClient(cc grpc.ClientConnInterface) EmployeeServiceClient {
-	return &employeeServiceClient{cc}
+func NewAttendanceCorrectionServiceClient(cc grpc.ClientConnInterface) AttendanceCorrectionServiceClient {
+	return &attendanceCorrectionServiceClient{cc}
 }
 
-func (c *employeeServiceClient) Create(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+func (c *attendanceCorrectionServiceClient) Create(ctx context.Context, in *CreateAttendanceCorrectionRequest, opts ...grpc.CallOption) (*AttendanceCorrection, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Employee)
-	err := c.cc.Invoke(ctx, EmployeeService_Create_FullMethodName, in, out, cOpts...)
+	out := new(AttendanceCorrection)
+	err := c.cc.Invoke(ctx, AttendanceCorrectionService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) Get(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+func (c *attendanceCorrectionServiceClient) Get(ctx context.Context, in *GetAttendanceCorrectionRequest, opts ...grpc.CallOption) (*AttendanceCorrection, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Employee)
-	err := c.cc.Invoke(ctx, EmployeeService_Get_FullMethodName, in, out, cOpts...)
+	out := new(AttendanceCorrection)
+	err := c.cc.Invoke(ctx, AttendanceCorrectionService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) Update(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+func (c *attendanceCorrectionServiceClient) Update(ctx context.Context, in *UpdateAttendanceCorrectionRequest, opts ...grpc.CallOption) (*AttendanceCorrection, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Employee)
-	err := c.cc.Invoke(ctx, EmployeeService_Update_FullMethodName, in, out, cOpts...)
+	out := new(AttendanceCorrection)
+	err := c.cc.Invoke(ctx, AttendanceCorrectionService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) Delete(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *attendanceCorrectionServiceClient) Delete(ctx context.Context, in *DeleteAttendanceCorrectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, EmployeeService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AttendanceCorrectionService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) List(ctx context.Context, in *ListEmployeeRequest, opts ...grpc.CallOption) (*ListEmployeeResponse, error) {
+func (c *attendanceCorrectionServiceClient) List(ctx context.Context, in *ListAttendanceCorrectionRequest, opts ...grpc.CallOption) (*ListAttendanceCorrectionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListEmployeeResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListAttendanceCorrectionResponse)
+	err := c.cc.Invoke(ctx, AttendanceCorrectionService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *employeeServiceClient) BatchCreate(ctx context.Context, in *BatchCreateEmployeesRequest, opts ...grpc.CallOption) (*BatchCreateEmployeesResponse, error) {
+func (c *attendanceCorrectionServiceClient) BatchCreate(ctx context.Context, in *BatchCreateAttendanceCorrectionsRequest, opts ...grpc.CallOption) (*BatchCreateAttendanceCorrectionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateEmployeesResponse)
-	err := c.cc.Invoke(ctx, EmployeeService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateAttendanceCorrectionsResponse)
+	err := c.cc.Invoke(ctx, AttendanceCorrectionService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// EmployeeServiceServer is the server API for EmployeeService service.
-// All implementations must embed UnimplementedEmployeeServiceServer
+// AttendanceCorrectionServiceServer is the server API for AttendanceCorrectionService service.
+// All implementations must embed UnimplementedAttendanceCorrectionServiceServer
 // for forward compatibility.
-type EmployeeServiceServer interface {
-	Create(context.Context, *CreateEmployeeRequest) (*Employee, error)
-	Get(context.Context, *GetEmployeeRequest) (*Employee, error)
-	Update(context.Context, *UpdateEmployeeRequest) (*Employee, error)
-	Delete(context.Context, *DeleteEmployeeRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListEmployeeRequest) (*ListEmployeeResponse, error)
-	BatchCreate(context.Context, *BatchCreateEmployeesRequest) (*BatchCreateEmployeesResponse, error)
-	mustEmbedUnimplementedEmployeeServiceServer()
+type AttendanceCorrectionServiceServer interface {
+	Create(context.Context, *CreateAttendanceCorrectionRequest) (*AttendanceCorrection, error)
+	Get(context.Context, *GetAttendanceCorrectionRequest) (*AttendanceCorrection, error)
+	Update(context.Context, *UpdateAttendanceCorrectionRequest) (*AttendanceCorrection, error)
+	Delete(context.Context, *DeleteAttendanceCorrectionRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListAttendanceCorrectionRequest) (*ListAttendanceCorrectionResponse, error)
+	BatchCreate(context.Context, *BatchCreateAttendanceCorrectionsRequest) (*BatchCreateAttendanceCorrectionsResponse, error)
+	mustEmbedUnimplementedAttendanceCorrectionServiceServer()
 }
 
-// UnimplementedEmployeeServiceServer must be embedded to have
+// UnimplementedAttendanceCorrectionServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedEmployeeServiceServer struct{}
+type UnimplementedAttendanceCorrectionServiceServer struct{}
 
-func (UnimplementedEmployeeServiceServer) Create(context.Context, *CreateEmployeeRequest) (*Employee, error) {
+func (UnimplementedAttendanceCorrectionServiceServer) Create(context.Context, *CreateAttendanceCorrectionRequest) (*AttendanceCorrection, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedEmployeeServiceServer) Get(context.Context, *GetEmployeeRequest) (*Employee, error) {
+func (UnimplementedAttendanceCorrectionServiceServer) Get(context.Context, *GetAttendanceCorrectionRequest) (*AttendanceCorrection, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedEmployeeServiceServer) Update(context.Context, *UpdateEmployeeRequest) (*Employee, error) {
+func (UnimplementedAttendanceCorrectionServiceServer) Update(context.Context, *UpdateAttendanceCorrectionRequest) (*AttendanceCorrection, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedEmployeeServiceServer) Delete(context.Context, *DeleteEmployeeRequest) (*emptypb.Empty, error) {
+func (UnimplementedAttendanceCorrectionServiceServer) Delete(context.Context, *DeleteAttendanceCorrectionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedEmployeeServiceServer) List(context.Context, *ListEmployeeRequest) (*ListEmployeeResponse, error) {
+func (UnimplementedAttendanceCorrectionServiceServer) List(context.Context, *ListAttendanceCorrectionRequest) (*ListAttendanceCorrectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedEmployeeServiceServer) BatchCreate(context.Context, *BatchCreateEmployeesRequest) (*BatchCreateEmployeesResponse, error) {
+func (UnimplementedAttendanceCorrectionServiceServer) BatchCreate(context.Context, *BatchCreateAttendanceCorrectionsRequest) (*BatchCreateAttendanceCorrectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
-func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
+func (UnimplementedAttendanceCorrectionServiceServer) mustEmbedUnimplementedAttendanceCorrectionServiceServer() {
+}
+func (UnimplementedAttendanceCorrectionServiceServer) testEmbeddedByValue() {}
 
-// UnsafeEmployeeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EmployeeServiceServer will
+// UnsafeAttendanceCorrectionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceCorrectionServiceServer will
 // result in compilation errors.
-type UnsafeEmployeeServiceServer interface {
-	mustEmbedUnimplementedEmployeeServiceServer()
+type UnsafeAttendanceCorrectionServiceServer interface {
+	mustEmbedUnimplementedAttendanceCorrectionServiceServer()
 }
 
-func RegisterEmployeeServiceServer(s grpc.ServiceRegistrar, srv EmployeeServiceServer) {
-	// If the following call pancis, it indicates UnimplementedEmployeeServiceServer was
+func RegisterAttendanceCorrectionServiceServer(s grpc.ServiceRegistrar, srv AttendanceCorrectionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceCorrectionServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&EmployeeService_ServiceDesc, srv)
+	s.RegisterService(&AttendanceCorrectionService_ServiceDesc, srv)
 }
 
-func _EmployeeService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEmployeeRequest)
+func _AttendanceCorrectionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttendanceCorrectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).Create(ctx, in)
+		return srv.(AttendanceCorrectionServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_Create_FullMethodName,
+		FullMethod: AttendanceCorrectionService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).Create(ctx, req.(*CreateEmployeeRequest))
+		return srv.(AttendanceCorrectionServiceServer).Create(ctx, req.(*CreateAttendanceCorrectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEmployeeRequest)
+func _AttendanceCorrectionService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceCorrectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).Get(ctx, in)
+		return srv.(AttendanceCorrectionServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_Get_FullMethodName,
+		FullMethod: AttendanceCorrectionService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).Get(ctx, req.(*GetEmployeeRequest))
+		return srv.(AttendanceCorrectionServiceServer).Get(ctx, req.(*GetAttendanceCorrectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEmployeeRequest)
+func _AttendanceCorrectionService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttendanceCorrectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).Update(ctx, in)
+		return srv.(AttendanceCorrectionServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_Update_FullMethodName,
+		FullMethod: AttendanceCorrectionService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).Update(ctx, req.(*UpdateEmployeeRequest))
+		return srv.(AttendanceCorrectionServiceServer).Update(ctx, req.(*UpdateAttendanceCorrectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEmployeeRequest)
+func _AttendanceCorrectionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttendanceCorrectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).Delete(ctx, in)
+		return srv.(AttendanceCorrectionServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_Delete_FullMethodName,
+		FullMethod: AttendanceCorrectionService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).Delete(ctx, req.(*DeleteEmployeeRequest))
+		return srv.(AttendanceCorrectionServiceServer).Delete(ctx, req.(*DeleteAttendanceCorrectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEmployeeRequest)
+func _AttendanceCorrectionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttendanceCorrectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).List(ctx, in)
+		return srv.(AttendanceCorrectionServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_List_FullMethodName,
+		FullMethod: AttendanceCorrectionService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).List(ctx, req.(*ListEmployeeRequest))
+		return srv.(AttendanceCorrectionServiceServer).List(ctx, req.(*ListAttendanceCorrectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EmployeeService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateEmployeesRequest)
+func _AttendanceCorrectionService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateAttendanceCorrectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EmployeeServiceServer).BatchCreate(ctx, in)
+		return srv.(AttendanceCorrectionServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EmployeeService_BatchCreate_FullMethodName,
+		FullMethod: AttendanceCorrectionService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EmployeeServiceServer).BatchCreate(ctx, req.(*BatchCreateEmployeesRequest))
+		return srv.(AttendanceCorrectionServiceServer).BatchCreate(ctx, req.(*BatchCreateAttendanceCorrectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// EmployeeService_ServiceDesc is the grpc.ServiceDesc for EmployeeService service.
+// AttendanceCorrectionService_ServiceDesc is the grpc.ServiceDesc for AttendanceCorrectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var EmployeeService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.EmployeeService",
-	HandlerType: (*EmployeeServiceServer)(nil),
+var AttendanceCorrectionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.AttendanceCorrectionService",
+	HandlerType: (*AttendanceCorrectionServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _EmployeeService_Create_Handler,
+			Handler:    _AttendanceCorrectionService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _EmployeeService_Get_Handler,
+			Handler:    _AttendanceCorrectionService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _EmployeeService_Update_Handler,
+			Handler:    _AttendanceCorrectionService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _EmployeeService_Delete_Handler,
+			Handler:    _AttendanceCorrectionService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _EmployeeService_List_Handler,
+			Handler:    _AttendanceCorrectionService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _EmployeeService_BatchCreate_Handler,
+			Handler:    _AttendanceCorrectionService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -899,291 +900,9276 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	LabelService_Create_FullMethodName      = "/entpb.LabelService/Create"
-	LabelService_Get_FullMethodName         = "/entpb.LabelService/Get"
-	LabelService_Update_FullMethodName      = "/entpb.LabelService/Update"
-	LabelService_Delete_FullMethodName      = "/entpb.LabelService/Delete"
-	LabelService_List_FullMethodName        = "/entpb.LabelService/List"
-	LabelService_BatchCreate_FullMethodName = "/entpb.LabelService/BatchCreate"
+	AttendanceRecordService_Create_FullMethodName      = "/entpb.AttendanceRecordService/Create"
+	AttendanceRecordService_Get_FullMethodName         = "/entpb.AttendanceRecordService/Get"
+	AttendanceRecordService_Update_FullMethodName      = "/entpb.AttendanceRecordService/Update"
+	AttendanceRecordService_Delete_FullMethodName      = "/entpb.AttendanceRecordService/Delete"
+	AttendanceRecordService_List_FullMethodName        = "/entpb.AttendanceRecordService/List"
+	AttendanceRecordService_BatchCreate_FullMethodName = "/entpb.AttendanceRecordService/BatchCreate"
 )
 
-// LabelServiceClient is the client API for LabelService service.
+// AttendanceRecordServiceClient is the client API for AttendanceRecordService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type LabelServiceClient interface {
-	Create(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*Label, error)
-	Get(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*Label, error)
-	Update(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*Label, error)
-	Delete(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListLabelRequest, opts ...grpc.CallOption) (*ListLabelResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateLabelsRequest, opts ...grpc.CallOption) (*BatchCreateLabelsResponse, error)
+type AttendanceRecordServiceClient interface {
+	Create(ctx context.Context, in *CreateAttendanceRecordRequest, opts ...grpc.CallOption) (*AttendanceRecord, error)
+	Get(ctx context.Context, in *GetAttendanceRecordRequest, opts ...grpc.CallOption) (*AttendanceRecord, error)
+	Update(ctx context.Context, in *UpdateAttendanceRecordRequest, opts ...grpc.CallOption) (*AttendanceRecord, error)
+	Delete(ctx context.Context, in *DeleteAttendanceRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListAttendanceRecordRequest, opts ...grpc.CallOption) (*ListAttendanceRecordResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateAttendanceRecordsRequest, opts ...grpc.CallOption) (*BatchCreateAttendanceRecordsResponse, error)
 }
 
-type labelServiceClient struct {
+type attendanceRecordServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewLabelServiceClient(cc grpc.ClientConnInterface) LabelServiceClient {
-	return &labelServiceClient{cc}
+func NewAttendanceRecordServiceClient(cc grpc.ClientConnInterface) AttendanceRecordServiceClient {
+	return &attendanceRecordServiceClient{cc}
 }
 
-func (c *labelServiceClient) Create(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+func (c *attendanceRecordServiceClient) Create(ctx context.Context, in *CreateAttendanceRecordRequest, opts ...grpc.CallOption) (*AttendanceRecord, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Label)
-	err := c.cc.Invoke(ctx, LabelService_Create_FullMethodName, in, out, cOpts...)
+	out := new(AttendanceRecord)
+	err := c.cc.Invoke(ctx, AttendanceRecordService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *labelServiceClient) Get(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+func (c *attendanceRecordServiceClient) Get(ctx context.Context, in *GetAttendanceRecordRequest, opts ...grpc.CallOption) (*AttendanceRecord, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Label)
-	err := c.cc.Invoke(ctx, LabelService_Get_FullMethodName, in, out, cOpts...)
+	out := new(AttendanceRecord)
+	err := c.cc.Invoke(ctx, AttendanceRecordService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *labelServiceClient) Update(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+func (c *attendanceRecordServiceClient) Update(ctx context.Context, in *UpdateAttendanceRecordRequest, opts ...grpc.CallOption) (*AttendanceRecord, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Label)
-	err := c.cc.Invoke(ctx, LabelService_Update_FullMethodName, in, out, cOpts...)
+	out := new(AttendanceRecord)
+	err := c.cc.Invoke(ctx, AttendanceRecordService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *labelServiceClient) Delete(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *attendanceRecordServiceClient) Delete(ctx context.Context, in *DeleteAttendanceRecordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, LabelService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, AttendanceRecordService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *labelServiceClient) List(ctx context.Context, in *ListLabelRequest, opts ...grpc.CallOption) (*ListLabelResponse, error) {
+func (c *attendanceRecordServiceClient) List(ctx context.Context, in *ListAttendanceRecordRequest, opts ...grpc.CallOption) (*ListAttendanceRecordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListLabelResponse)
-	err := c.cc.Invoke(ctx, LabelService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListAttendanceRecordResponse)
+	err := c.cc.Invoke(ctx, AttendanceRecordService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *labelServiceClient) BatchCreate(ctx context.Context, in *BatchCreateLabelsRequest, opts ...grpc.CallOption) (*BatchCreateLabelsResponse, error) {
+func (c *attendanceRecordServiceClient) BatchCreate(ctx context.Context, in *BatchCreateAttendanceRecordsRequest, opts ...grpc.CallOption) (*BatchCreateAttendanceRecordsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateLabelsResponse)
-	err := c.cc.Invoke(ctx, LabelService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateAttendanceRecordsResponse)
+	err := c.cc.Invoke(ctx, AttendanceRecordService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// LabelServiceServer is the server API for LabelService service.
-// All implementations must embed UnimplementedLabelServiceServer
+// AttendanceRecordServiceServer is the server API for AttendanceRecordService service.
+// All implementations must embed UnimplementedAttendanceRecordServiceServer
 // for forward compatibility.
-type LabelServiceServer interface {
-	Create(context.Context, *CreateLabelRequest) (*Label, error)
-	Get(context.Context, *GetLabelRequest) (*Label, error)
-	Update(context.Context, *UpdateLabelRequest) (*Label, error)
-	Delete(context.Context, *DeleteLabelRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListLabelRequest) (*ListLabelResponse, error)
-	BatchCreate(context.Context, *BatchCreateLabelsRequest) (*BatchCreateLabelsResponse, error)
-	mustEmbedUnimplementedLabelServiceServer()
+type AttendanceRecordServiceServer interface {
+	Create(context.Context, *CreateAttendanceRecordRequest) (*AttendanceRecord, error)
+	Get(context.Context, *GetAttendanceRecordRequest) (*AttendanceRecord, error)
+	Update(context.Context, *UpdateAttendanceRecordRequest) (*AttendanceRecord, error)
+	Delete(context.Context, *DeleteAttendanceRecordRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListAttendanceRecordRequest) (*ListAttendanceRecordResponse, error)
+	BatchCreate(context.Context, *BatchCreateAttendanceRecordsRequest) (*BatchCreateAttendanceRecordsResponse, error)
+	mustEmbedUnimplementedAttendanceRecordServiceServer()
 }
 
-// UnimplementedLabelServiceServer must be embedded to have
+// UnimplementedAttendanceRecordServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedLabelServiceServer struct{}
+type UnimplementedAttendanceRecordServiceServer struct{}
 
-func (UnimplementedLabelServiceServer) Create(context.Context, *CreateLabelRequest) (*Label, error) {
+func (UnimplementedAttendanceRecordServiceServer) Create(context.Context, *CreateAttendanceRecordRequest) (*AttendanceRecord, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedLabelServiceServer) Get(context.Context, *GetLabelRequest) (*Label, error) {
+func (UnimplementedAttendanceRecordServiceServer) Get(context.Context, *GetAttendanceRecordRequest) (*AttendanceRecord, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedLabelServiceServer) Update(context.Context, *UpdateLabelRequest) (*Label, error) {
+func (UnimplementedAttendanceRecordServiceServer) Update(context.Context, *UpdateAttendanceRecordRequest) (*AttendanceRecord, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedLabelServiceServer) Delete(context.Context, *DeleteLabelRequest) (*emptypb.Empty, error) {
+func (UnimplementedAttendanceRecordServiceServer) Delete(context.Context, *DeleteAttendanceRecordRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedLabelServiceServer) List(context.Context, *ListLabelRequest) (*ListLabelResponse, error) {
+func (UnimplementedAttendanceRecordServiceServer) List(context.Context, *ListAttendanceRecordRequest) (*ListAttendanceRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedLabelServiceServer) BatchCreate(context.Context, *BatchCreateLabelsRequest) (*BatchCreateLabelsResponse, error) {
+func (UnimplementedAttendanceRecordServiceServer) BatchCreate(context.Context, *BatchCreateAttendanceRecordsRequest) (*BatchCreateAttendanceRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedLabelServiceServer) mustEmbedUnimplementedLabelServiceServer() {}
+func (UnimplementedAttendanceRecordServiceServer) mustEmbedUnimplementedAttendanceRecordServiceServer() {
+}
+func (UnimplementedAttendanceRecordServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAttendanceRecordServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceRecordServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceRecordServiceServer interface {
+	mustEmbedUnimplementedAttendanceRecordServiceServer()
+}
+
+func RegisterAttendanceRecordServiceServer(s grpc.ServiceRegistrar, srv AttendanceRecordServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceRecordServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceRecordService_ServiceDesc, srv)
+}
+
+func _AttendanceRecordService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttendanceRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceRecordServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceRecordService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceRecordServiceServer).Create(ctx, req.(*CreateAttendanceRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceRecordService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceRecordServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceRecordService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceRecordServiceServer).Get(ctx, req.(*GetAttendanceRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceRecordService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttendanceRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceRecordServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceRecordService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceRecordServiceServer).Update(ctx, req.(*UpdateAttendanceRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceRecordService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttendanceRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceRecordServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceRecordService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceRecordServiceServer).Delete(ctx, req.(*DeleteAttendanceRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceRecordService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttendanceRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceRecordServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceRecordService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceRecordServiceServer).List(ctx, req.(*ListAttendanceRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceRecordService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateAttendanceRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceRecordServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceRecordService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceRecordServiceServer).BatchCreate(ctx, req.(*BatchCreateAttendanceRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceRecordService_ServiceDesc is the grpc.ServiceDesc for AttendanceRecordService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceRecordService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.AttendanceRecordService",
+	HandlerType: (*AttendanceRecordServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _AttendanceRecordService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AttendanceRecordService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AttendanceRecordService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AttendanceRecordService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _AttendanceRecordService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _AttendanceRecordService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	CompensationService_Create_FullMethodName      = "/entpb.CompensationService/Create"
+	CompensationService_Get_FullMethodName         = "/entpb.CompensationService/Get"
+	CompensationService_List_FullMethodName        = "/entpb.CompensationService/List"
+	CompensationService_BatchCreate_FullMethodName = "/entpb.CompensationService/BatchCreate"
+)
+
+// CompensationServiceClient is the client API for CompensationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CompensationServiceClient interface {
+	Create(ctx context.Context, in *CreateCompensationRequest, opts ...grpc.CallOption) (*Compensation, error)
+	Get(ctx context.Context, in *GetCompensationRequest, opts ...grpc.CallOption) (*Compensation, error)
+	List(ctx context.Context, in *ListCompensationRequest, opts ...grpc.CallOption) (*ListCompensationResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateCompensationsRequest, opts ...grpc.CallOption) (*BatchCreateCompensationsResponse, error)
+}
+
+type compensationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCompensationServiceClient(cc grpc.ClientConnInterface) CompensationServiceClient {
+	return &compensationServiceClient{cc}
+}
+
+func (c *compensationServiceClient) Create(ctx context.Context, in *CreateCompensationRequest, opts ...grpc.CallOption) (*Compensation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Compensation)
+	err := c.cc.Invoke(ctx, CompensationService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compensationServiceClient) Get(ctx context.Context, in *GetCompensationRequest, opts ...grpc.CallOption) (*Compensation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Compensation)
+	err := c.cc.Invoke(ctx, CompensationService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compensationServiceClient) List(ctx context.Context, in *ListCompensationRequest, opts ...grpc.CallOption) (*ListCompensationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompensationResponse)
+	err := c.cc.Invoke(ctx, CompensationService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compensationServiceClient) BatchCreate(ctx context.Context, in *BatchCreateCompensationsRequest, opts ...grpc.CallOption) (*BatchCreateCompensationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateCompensationsResponse)
+	err := c.cc.Invoke(ctx, CompensationService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CompensationServiceServer is the server API for CompensationService service.
+// All implementations must embed UnimplementedCompensationServiceServer
+// for forward compatibility.
+type CompensationServiceServer interface {
+	Create(context.Context, *CreateCompensationRequest) (*Compensation, error)
+	Get(context.Context, *GetCompensationRequest) (*Compensation, error)
+	List(context.Context, *ListCompensationRequest) (*ListCompensationResponse, error)
+	BatchCreate(context.Context, *BatchCreateCompensationsRequest) (*BatchCreateCompensationsResponse, error)
+	mustEmbedUnimplementedCompensationServiceServer()
+}
+
+// UnimplementedCompensationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCompensationServiceServer struct{}
+
+func (UnimplementedCompensationServiceServer) Create(context.Context, *CreateCompensationRequest) (*Compensation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedCompensationServiceServer) Get(context.Context, *GetCompensationRequest) (*Compensation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCompensationServiceServer) List(context.Context, *ListCompensationRequest) (*ListCompensationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedCompensationServiceServer) BatchCreate(context.Context, *BatchCreateCompensationsRequest) (*BatchCreateCompensationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedCompensationServiceServer) mustEmbedUnimplementedCompensationServiceServer() {}
+func (UnimplementedCompensationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeCompensationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompensationServiceServer will
+// result in compilation errors.
+type UnsafeCompensationServiceServer interface {
+	mustEmbedUnimplementedCompensationServiceServer()
+}
+
+func RegisterCompensationServiceServer(s grpc.ServiceRegistrar, srv CompensationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCompensationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CompensationService_ServiceDesc, srv)
+}
+
+func _CompensationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompensationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompensationService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationServiceServer).Create(ctx, req.(*CreateCompensationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompensationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompensationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompensationService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationServiceServer).Get(ctx, req.(*GetCompensationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompensationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompensationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompensationService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationServiceServer).List(ctx, req.(*ListCompensationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompensationService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateCompensationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompensationServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompensationService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompensationServiceServer).BatchCreate(ctx, req.(*BatchCreateCompensationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CompensationService_ServiceDesc is the grpc.ServiceDesc for CompensationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CompensationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.CompensationService",
+	HandlerType: (*CompensationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _CompensationService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CompensationService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _CompensationService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _CompensationService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	DepartmentService_Create_FullMethodName      = "/entpb.DepartmentService/Create"
+	DepartmentService_Get_FullMethodName         = "/entpb.DepartmentService/Get"
+	DepartmentService_Update_FullMethodName      = "/entpb.DepartmentService/Update"
+	DepartmentService_Delete_FullMethodName      = "/entpb.DepartmentService/Delete"
+	DepartmentService_List_FullMethodName        = "/entpb.DepartmentService/List"
+	DepartmentService_BatchCreate_FullMethodName = "/entpb.DepartmentService/BatchCreate"
+)
+
+// DepartmentServiceClient is the client API for DepartmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DepartmentServiceClient interface {
+	Create(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	Get(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	Update(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*Department, error)
+	Delete(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListDepartmentRequest, opts ...grpc.CallOption) (*ListDepartmentResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateDepartmentsRequest, opts ...grpc.CallOption) (*BatchCreateDepartmentsResponse, error)
+}
+
+type departmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDepartmentServiceClient(cc grpc.ClientConnInterface) DepartmentServiceClient {
+	return &departmentServiceClient{cc}
+}
+
+func (c *departmentServiceClient) Create(ctx context.Context, in *CreateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Department)
+	err := c.cc.Invoke(ctx, DepartmentService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) Get(ctx context.Context, in *GetDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Department)
+	err := c.cc.Invoke(ctx, DepartmentService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) Update(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*Department, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Department)
+	err := c.cc.Invoke(ctx, DepartmentService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) Delete(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DepartmentService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) List(ctx context.Context, in *ListDepartmentRequest, opts ...grpc.CallOption) (*ListDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) BatchCreate(ctx context.Context, in *BatchCreateDepartmentsRequest, opts ...grpc.CallOption) (*BatchCreateDepartmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateDepartmentsResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DepartmentServiceServer is the server API for DepartmentService service.
+// All implementations must embed UnimplementedDepartmentServiceServer
+// for forward compatibility.
+type DepartmentServiceServer interface {
+	Create(context.Context, *CreateDepartmentRequest) (*Department, error)
+	Get(context.Context, *GetDepartmentRequest) (*Department, error)
+	Update(context.Context, *UpdateDepartmentRequest) (*Department, error)
+	Delete(context.Context, *DeleteDepartmentRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListDepartmentRequest) (*ListDepartmentResponse, error)
+	BatchCreate(context.Context, *BatchCreateDepartmentsRequest) (*BatchCreateDepartmentsResponse, error)
+	mustEmbedUnimplementedDepartmentServiceServer()
+}
+
+// UnimplementedDepartmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDepartmentServiceServer struct{}
+
+func (UnimplementedDepartmentServiceServer) Create(context.Context, *CreateDepartmentRequest) (*Department, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedDepartmentServiceServer) Get(context.Context, *GetDepartmentRequest) (*Department, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDepartmentServiceServer) Update(context.Context, *UpdateDepartmentRequest) (*Department, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedDepartmentServiceServer) Delete(context.Context, *DeleteDepartmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedDepartmentServiceServer) List(context.Context, *ListDepartmentRequest) (*ListDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedDepartmentServiceServer) BatchCreate(context.Context, *BatchCreateDepartmentsRequest) (*BatchCreateDepartmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedDepartmentServiceServer) mustEmbedUnimplementedDepartmentServiceServer() {}
+func (UnimplementedDepartmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeDepartmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DepartmentServiceServer will
+// result in compilation errors.
+type UnsafeDepartmentServiceServer interface {
+	mustEmbedUnimplementedDepartmentServiceServer()
+}
+
+func RegisterDepartmentServiceServer(s grpc.ServiceRegistrar, srv DepartmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDepartmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DepartmentService_ServiceDesc, srv)
+}
+
+func _DepartmentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).Create(ctx, req.(*CreateDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).Get(ctx, req.(*GetDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).Update(ctx, req.(*UpdateDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).Delete(ctx, req.(*DeleteDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).List(ctx, req.(*ListDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateDepartmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).BatchCreate(ctx, req.(*BatchCreateDepartmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DepartmentService_ServiceDesc is the grpc.ServiceDesc for DepartmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DepartmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.DepartmentService",
+	HandlerType: (*DepartmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DepartmentService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DepartmentService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DepartmentService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DepartmentService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DepartmentService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _DepartmentService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	EmployeeService_Create_FullMethodName      = "/entpb.EmployeeService/Create"
+	EmployeeService_Get_FullMethodName         = "/entpb.EmployeeService/Get"
+	EmployeeService_Update_FullMethodName      = "/entpb.EmployeeService/Update"
+	EmployeeService_Delete_FullMethodName      = "/entpb.EmployeeService/Delete"
+	EmployeeService_List_FullMethodName        = "/entpb.EmployeeService/List"
+	EmployeeService_BatchCreate_FullMethodName = "/entpb.EmployeeService/BatchCreate"
+)
+
+// EmployeeServiceClient is the client API for EmployeeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EmployeeServiceClient interface {
+	Create(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
+	Get(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
+	Update(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error)
+	Delete(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListEmployeeRequest, opts ...grpc.CallOption) (*ListEmployeeResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateEmployeesRequest, opts ...grpc.CallOption) (*BatchCreateEmployeesResponse, error)
+}
+
+type employeeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmployeeServiceClient(cc grpc.ClientConnInterface) EmployeeServiceClient {
+	return &employeeServiceClient{cc}
+}
+
+func (c *employeeServiceClient) Create(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Employee)
+	err := c.cc.Invoke(ctx, EmployeeService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) Get(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Employee)
+	err := c.cc.Invoke(ctx, EmployeeService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) Update(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*Employee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Employee)
+	err := c.cc.Invoke(ctx, EmployeeService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) Delete(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EmployeeService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) List(ctx context.Context, in *ListEmployeeRequest, opts ...grpc.CallOption) (*ListEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) BatchCreate(ctx context.Context, in *BatchCreateEmployeesRequest, opts ...grpc.CallOption) (*BatchCreateEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateEmployeesResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmployeeServiceServer is the server API for EmployeeService service.
+// All implementations must embed UnimplementedEmployeeServiceServer
+// for forward compatibility.
+type EmployeeServiceServer interface {
+	Create(context.Context, *CreateEmployeeRequest) (*Employee, error)
+	Get(context.Context, *GetEmployeeRequest) (*Employee, error)
+	Update(context.Context, *UpdateEmployeeRequest) (*Employee, error)
+	Delete(context.Context, *DeleteEmployeeRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListEmployeeRequest) (*ListEmployeeResponse, error)
+	BatchCreate(context.Context, *BatchCreateEmployeesRequest) (*BatchCreateEmployeesResponse, error)
+	mustEmbedUnimplementedEmployeeServiceServer()
+}
+
+// UnimplementedEmployeeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmployeeServiceServer struct{}
+
+func (UnimplementedEmployeeServiceServer) Create(context.Context, *CreateEmployeeRequest) (*Employee, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedEmployeeServiceServer) Get(context.Context, *GetEmployeeRequest) (*Employee, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedEmployeeServiceServer) Update(context.Context, *UpdateEmployeeRequest) (*Employee, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedEmployeeServiceServer) Delete(context.Context, *DeleteEmployeeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedEmployeeServiceServer) List(context.Context, *ListEmployeeRequest) (*ListEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedEmployeeServiceServer) BatchCreate(context.Context, *BatchCreateEmployeesRequest) (*BatchCreateEmployeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
+func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeEmployeeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmployeeServiceServer will
+// result in compilation errors.
+type UnsafeEmployeeServiceServer interface {
+	mustEmbedUnimplementedEmployeeServiceServer()
+}
+
+func RegisterEmployeeServiceServer(s grpc.ServiceRegistrar, srv EmployeeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmployeeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmployeeService_ServiceDesc, srv)
+}
+
+func _EmployeeService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).Create(ctx, req.(*CreateEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).Get(ctx, req.(*GetEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).Update(ctx, req.(*UpdateEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).Delete(ctx, req.(*DeleteEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).List(ctx, req.(*ListEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).BatchCreate(ctx, req.(*BatchCreateEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmployeeService_ServiceDesc is the grpc.ServiceDesc for EmployeeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmployeeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.EmployeeService",
+	HandlerType: (*EmployeeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _EmployeeService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _EmployeeService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _EmployeeService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _EmployeeService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _EmployeeService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _EmployeeService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	EmployeeContractService_Create_FullMethodName      = "/entpb.EmployeeContractService/Create"
+	EmployeeContractService_Get_FullMethodName         = "/entpb.EmployeeContractService/Get"
+	EmployeeContractService_Update_FullMethodName      = "/entpb.EmployeeContractService/Update"
+	EmployeeContractService_Delete_FullMethodName      = "/entpb.EmployeeContractService/Delete"
+	EmployeeContractService_List_FullMethodName        = "/entpb.EmployeeContractService/List"
+	EmployeeContractService_BatchCreate_FullMethodName = "/entpb.EmployeeContractService/BatchCreate"
+)
+
+// EmployeeContractServiceClient is the client API for EmployeeContractService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EmployeeContractServiceClient interface {
+	Create(ctx context.Context, in *CreateEmployeeContractRequest, opts ...grpc.CallOption) (*EmployeeContract, error)
+	Get(ctx context.Context, in *GetEmployeeContractRequest, opts ...grpc.CallOption) (*EmployeeContract, error)
+	Update(ctx context.Context, in *UpdateEmployeeContractRequest, opts ...grpc.CallOption) (*EmployeeContract, error)
+	Delete(ctx context.Context, in *DeleteEmployeeContractRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListEmployeeContractRequest, opts ...grpc.CallOption) (*ListEmployeeContractResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateEmployeeContractsRequest, opts ...grpc.CallOption) (*BatchCreateEmployeeContractsResponse, error)
+}
+
+type employeeContractServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmployeeContractServiceClient(cc grpc.ClientConnInterface) EmployeeContractServiceClient {
+	return &employeeContractServiceClient{cc}
+}
+
+func (c *employeeContractServiceClient) Create(ctx context.Context, in *CreateEmployeeContractRequest, opts ...grpc.CallOption) (*EmployeeContract, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeContract)
+	err := c.cc.Invoke(ctx, EmployeeContractService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeContractServiceClient) Get(ctx context.Context, in *GetEmployeeContractRequest, opts ...grpc.CallOption) (*EmployeeContract, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeContract)
+	err := c.cc.Invoke(ctx, EmployeeContractService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeContractServiceClient) Update(ctx context.Context, in *UpdateEmployeeContractRequest, opts ...grpc.CallOption) (*EmployeeContract, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeContract)
+	err := c.cc.Invoke(ctx, EmployeeContractService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeContractServiceClient) Delete(ctx context.Context, in *DeleteEmployeeContractRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EmployeeContractService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeContractServiceClient) List(ctx context.Context, in *ListEmployeeContractRequest, opts ...grpc.CallOption) (*ListEmployeeContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeeContractResponse)
+	err := c.cc.Invoke(ctx, EmployeeContractService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeContractServiceClient) BatchCreate(ctx context.Context, in *BatchCreateEmployeeContractsRequest, opts ...grpc.CallOption) (*BatchCreateEmployeeContractsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateEmployeeContractsResponse)
+	err := c.cc.Invoke(ctx, EmployeeContractService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmployeeContractServiceServer is the server API for EmployeeContractService service.
+// All implementations must embed UnimplementedEmployeeContractServiceServer
+// for forward compatibility.
+type EmployeeContractServiceServer interface {
+	Create(context.Context, *CreateEmployeeContractRequest) (*EmployeeContract, error)
+	Get(context.Context, *GetEmployeeContractRequest) (*EmployeeContract, error)
+	Update(context.Context, *UpdateEmployeeContractRequest) (*EmployeeContract, error)
+	Delete(context.Context, *DeleteEmployeeContractRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListEmployeeContractRequest) (*ListEmployeeContractResponse, error)
+	BatchCreate(context.Context, *BatchCreateEmployeeContractsRequest) (*BatchCreateEmployeeContractsResponse, error)
+	mustEmbedUnimplementedEmployeeContractServiceServer()
+}
+
+// UnimplementedEmployeeContractServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmployeeContractServiceServer struct{}
+
+func (UnimplementedEmployeeContractServiceServer) Create(context.Context, *CreateEmployeeContractRequest) (*EmployeeContract, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedEmployeeContractServiceServer) Get(context.Context, *GetEmployeeContractRequest) (*EmployeeContract, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedEmployeeContractServiceServer) Update(context.Context, *UpdateEmployeeContractRequest) (*EmployeeContract, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedEmployeeContractServiceServer) Delete(context.Context, *DeleteEmployeeContractRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedEmployeeContractServiceServer) List(context.Context, *ListEmployeeContractRequest) (*ListEmployeeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedEmployeeContractServiceServer) BatchCreate(context.Context, *BatchCreateEmployeeContractsRequest) (*BatchCreateEmployeeContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedEmployeeContractServiceServer) mustEmbedUnimplementedEmployeeContractServiceServer() {
+}
+func (UnimplementedEmployeeContractServiceServer) testEmbeddedByValue() {}
+
+// UnsafeEmployeeContractServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmployeeContractServiceServer will
+// result in compilation errors.
+type UnsafeEmployeeContractServiceServer interface {
+	mustEmbedUnimplementedEmployeeContractServiceServer()
+}
+
+func RegisterEmployeeContractServiceServer(s grpc.ServiceRegistrar, srv EmployeeContractServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmployeeContractServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmployeeContractService_ServiceDesc, srv)
+}
+
+func _EmployeeContractService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmployeeContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeContractServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeContractService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeContractServiceServer).Create(ctx, req.(*CreateEmployeeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeContractService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeContractServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeContractService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeContractServiceServer).Get(ctx, req.(*GetEmployeeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeContractService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmployeeContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeContractServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeContractService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeContractServiceServer).Update(ctx, req.(*UpdateEmployeeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeContractService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployeeContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeContractServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeContractService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeContractServiceServer).Delete(ctx, req.(*DeleteEmployeeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeContractService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeeContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeContractServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeContractService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeContractServiceServer).List(ctx, req.(*ListEmployeeContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeContractService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateEmployeeContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeContractServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeContractService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeContractServiceServer).BatchCreate(ctx, req.(*BatchCreateEmployeeContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmployeeContractService_ServiceDesc is the grpc.ServiceDesc for EmployeeContractService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmployeeContractService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.EmployeeContractService",
+	HandlerType: (*EmployeeContractServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _EmployeeContractService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _EmployeeContractService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _EmployeeContractService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _EmployeeContractService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _EmployeeContractService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _EmployeeContractService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	EmployeeStatusHistoryService_Create_FullMethodName      = "/entpb.EmployeeStatusHistoryService/Create"
+	EmployeeStatusHistoryService_Get_FullMethodName         = "/entpb.EmployeeStatusHistoryService/Get"
+	EmployeeStatusHistoryService_Update_FullMethodName      = "/entpb.EmployeeStatusHistoryService/Update"
+	EmployeeStatusHistoryService_Delete_FullMethodName      = "/entpb.EmployeeStatusHistoryService/Delete"
+	EmployeeStatusHistoryService_List_FullMethodName        = "/entpb.EmployeeStatusHistoryService/List"
+	EmployeeStatusHistoryService_BatchCreate_FullMethodName = "/entpb.EmployeeStatusHistoryService/BatchCreate"
+)
+
+// EmployeeStatusHistoryServiceClient is the client API for EmployeeStatusHistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EmployeeStatusHistoryServiceClient interface {
+	Create(ctx context.Context, in *CreateEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*EmployeeStatusHistory, error)
+	Get(ctx context.Context, in *GetEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*EmployeeStatusHistory, error)
+	Update(ctx context.Context, in *UpdateEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*EmployeeStatusHistory, error)
+	Delete(ctx context.Context, in *DeleteEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*ListEmployeeStatusHistoryResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateEmployeeStatusHistoriesRequest, opts ...grpc.CallOption) (*BatchCreateEmployeeStatusHistoriesResponse, error)
+}
+
+type employeeStatusHistoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmployeeStatusHistoryServiceClient(cc grpc.ClientConnInterface) EmployeeStatusHistoryServiceClient {
+	return &employeeStatusHistoryServiceClient{cc}
+}
+
+func (c *employeeStatusHistoryServiceClient) Create(ctx context.Context, in *CreateEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*EmployeeStatusHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeStatusHistory)
+	err := c.cc.Invoke(ctx, EmployeeStatusHistoryService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeStatusHistoryServiceClient) Get(ctx context.Context, in *GetEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*EmployeeStatusHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeStatusHistory)
+	err := c.cc.Invoke(ctx, EmployeeStatusHistoryService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeStatusHistoryServiceClient) Update(ctx context.Context, in *UpdateEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*EmployeeStatusHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeStatusHistory)
+	err := c.cc.Invoke(ctx, EmployeeStatusHistoryService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeStatusHistoryServiceClient) Delete(ctx context.Context, in *DeleteEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EmployeeStatusHistoryService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeStatusHistoryServiceClient) List(ctx context.Context, in *ListEmployeeStatusHistoryRequest, opts ...grpc.CallOption) (*ListEmployeeStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeeStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, EmployeeStatusHistoryService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeStatusHistoryServiceClient) BatchCreate(ctx context.Context, in *BatchCreateEmployeeStatusHistoriesRequest, opts ...grpc.CallOption) (*BatchCreateEmployeeStatusHistoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateEmployeeStatusHistoriesResponse)
+	err := c.cc.Invoke(ctx, EmployeeStatusHistoryService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmployeeStatusHistoryServiceServer is the server API for EmployeeStatusHistoryService service.
+// All implementations must embed UnimplementedEmployeeStatusHistoryServiceServer
+// for forward compatibility.
+type EmployeeStatusHistoryServiceServer interface {
+	Create(context.Context, *CreateEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error)
+	Get(context.Context, *GetEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error)
+	Update(context.Context, *UpdateEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error)
+	Delete(context.Context, *DeleteEmployeeStatusHistoryRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListEmployeeStatusHistoryRequest) (*ListEmployeeStatusHistoryResponse, error)
+	BatchCreate(context.Context, *BatchCreateEmployeeStatusHistoriesRequest) (*BatchCreateEmployeeStatusHistoriesResponse, error)
+	mustEmbedUnimplementedEmployeeStatusHistoryServiceServer()
+}
+
+// UnimplementedEmployeeStatusHistoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmployeeStatusHistoryServiceServer struct{}
+
+func (UnimplementedEmployeeStatusHistoryServiceServer) Create(context.Context, *CreateEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedEmployeeStatusHistoryServiceServer) Get(context.Context, *GetEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedEmployeeStatusHistoryServiceServer) Update(context.Context, *UpdateEmployeeStatusHistoryRequest) (*EmployeeStatusHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedEmployeeStatusHistoryServiceServer) Delete(context.Context, *DeleteEmployeeStatusHistoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedEmployeeStatusHistoryServiceServer) List(context.Context, *ListEmployeeStatusHistoryRequest) (*ListEmployeeStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedEmployeeStatusHistoryServiceServer) BatchCreate(context.Context, *BatchCreateEmployeeStatusHistoriesRequest) (*BatchCreateEmployeeStatusHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedEmployeeStatusHistoryServiceServer) mustEmbedUnimplementedEmployeeStatusHistoryServiceServer() {
+}
+func (UnimplementedEmployeeStatusHistoryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeEmployeeStatusHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmployeeStatusHistoryServiceServer will
+// result in compilation errors.
+type UnsafeEmployeeStatusHistoryServiceServer interface {
+	mustEmbedUnimplementedEmployeeStatusHistoryServiceServer()
+}
+
+func RegisterEmployeeStatusHistoryServiceServer(s grpc.ServiceRegistrar, srv EmployeeStatusHistoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmployeeStatusHistoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmployeeStatusHistoryService_ServiceDesc, srv)
+}
+
+func _EmployeeStatusHistoryService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmployeeStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeStatusHistoryServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeStatusHistoryService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeStatusHistoryServiceServer).Create(ctx, req.(*CreateEmployeeStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeStatusHistoryService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeStatusHistoryServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeStatusHistoryService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeStatusHistoryServiceServer).Get(ctx, req.(*GetEmployeeStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeStatusHistoryService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmployeeStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeStatusHistoryServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeStatusHistoryService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeStatusHistoryServiceServer).Update(ctx, req.(*UpdateEmployeeStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeStatusHistoryService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployeeStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeStatusHistoryServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeStatusHistoryService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeStatusHistoryServiceServer).Delete(ctx, req.(*DeleteEmployeeStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeStatusHistoryService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeeStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeStatusHistoryServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeStatusHistoryService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeStatusHistoryServiceServer).List(ctx, req.(*ListEmployeeStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeStatusHistoryService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateEmployeeStatusHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeStatusHistoryServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeStatusHistoryService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeStatusHistoryServiceServer).BatchCreate(ctx, req.(*BatchCreateEmployeeStatusHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmployeeStatusHistoryService_ServiceDesc is the grpc.ServiceDesc for EmployeeStatusHistoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmployeeStatusHistoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.EmployeeStatusHistoryService",
+	HandlerType: (*EmployeeStatusHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _EmployeeStatusHistoryService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _EmployeeStatusHistoryService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _EmployeeStatusHistoryService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _EmployeeStatusHistoryService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _EmployeeStatusHistoryService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _EmployeeStatusHistoryService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	HolidayService_Create_FullMethodName      = "/entpb.HolidayService/Create"
+	HolidayService_Get_FullMethodName         = "/entpb.HolidayService/Get"
+	HolidayService_Update_FullMethodName      = "/entpb.HolidayService/Update"
+	HolidayService_Delete_FullMethodName      = "/entpb.HolidayService/Delete"
+	HolidayService_List_FullMethodName        = "/entpb.HolidayService/List"
+	HolidayService_BatchCreate_FullMethodName = "/entpb.HolidayService/BatchCreate"
+)
+
+// HolidayServiceClient is the client API for HolidayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HolidayServiceClient interface {
+	Create(ctx context.Context, in *CreateHolidayRequest, opts ...grpc.CallOption) (*Holiday, error)
+	Get(ctx context.Context, in *GetHolidayRequest, opts ...grpc.CallOption) (*Holiday, error)
+	Update(ctx context.Context, in *UpdateHolidayRequest, opts ...grpc.CallOption) (*Holiday, error)
+	Delete(ctx context.Context, in *DeleteHolidayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListHolidayRequest, opts ...grpc.CallOption) (*ListHolidayResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateHolidaysRequest, opts ...grpc.CallOption) (*BatchCreateHolidaysResponse, error)
+}
+
+type holidayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHolidayServiceClient(cc grpc.ClientConnInterface) HolidayServiceClient {
+	return &holidayServiceClient{cc}
+}
+
+func (c *holidayServiceClient) Create(ctx context.Context, in *CreateHolidayRequest, opts ...grpc.CallOption) (*Holiday, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holiday)
+	err := c.cc.Invoke(ctx, HolidayService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) Get(ctx context.Context, in *GetHolidayRequest, opts ...grpc.CallOption) (*Holiday, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holiday)
+	err := c.cc.Invoke(ctx, HolidayService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) Update(ctx context.Context, in *UpdateHolidayRequest, opts ...grpc.CallOption) (*Holiday, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holiday)
+	err := c.cc.Invoke(ctx, HolidayService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) Delete(ctx context.Context, in *DeleteHolidayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, HolidayService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) List(ctx context.Context, in *ListHolidayRequest, opts ...grpc.CallOption) (*ListHolidayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHolidayResponse)
+	err := c.cc.Invoke(ctx, HolidayService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) BatchCreate(ctx context.Context, in *BatchCreateHolidaysRequest, opts ...grpc.CallOption) (*BatchCreateHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateHolidaysResponse)
+	err := c.cc.Invoke(ctx, HolidayService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HolidayServiceServer is the server API for HolidayService service.
+// All implementations must embed UnimplementedHolidayServiceServer
+// for forward compatibility.
+type HolidayServiceServer interface {
+	Create(context.Context, *CreateHolidayRequest) (*Holiday, error)
+	Get(context.Context, *GetHolidayRequest) (*Holiday, error)
+	Update(context.Context, *UpdateHolidayRequest) (*Holiday, error)
+	Delete(context.Context, *DeleteHolidayRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListHolidayRequest) (*ListHolidayResponse, error)
+	BatchCreate(context.Context, *BatchCreateHolidaysRequest) (*BatchCreateHolidaysResponse, error)
+	mustEmbedUnimplementedHolidayServiceServer()
+}
+
+// UnimplementedHolidayServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHolidayServiceServer struct{}
+
+func (UnimplementedHolidayServiceServer) Create(context.Context, *CreateHolidayRequest) (*Holiday, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedHolidayServiceServer) Get(context.Context, *GetHolidayRequest) (*Holiday, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedHolidayServiceServer) Update(context.Context, *UpdateHolidayRequest) (*Holiday, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedHolidayServiceServer) Delete(context.Context, *DeleteHolidayRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedHolidayServiceServer) List(context.Context, *ListHolidayRequest) (*ListHolidayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedHolidayServiceServer) BatchCreate(context.Context, *BatchCreateHolidaysRequest) (*BatchCreateHolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedHolidayServiceServer) mustEmbedUnimplementedHolidayServiceServer() {}
+func (UnimplementedHolidayServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeHolidayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HolidayServiceServer will
+// result in compilation errors.
+type UnsafeHolidayServiceServer interface {
+	mustEmbedUnimplementedHolidayServiceServer()
+}
+
+func RegisterHolidayServiceServer(s grpc.ServiceRegistrar, srv HolidayServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHolidayServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HolidayService_ServiceDesc, srv)
+}
+
+func _HolidayService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).Create(ctx, req.(*CreateHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).Get(ctx, req.(*GetHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).Update(ctx, req.(*UpdateHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).Delete(ctx, req.(*DeleteHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).List(ctx, req.(*ListHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).BatchCreate(ctx, req.(*BatchCreateHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HolidayService_ServiceDesc is the grpc.ServiceDesc for HolidayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HolidayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.HolidayService",
+	HandlerType: (*HolidayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _HolidayService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _HolidayService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _HolidayService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _HolidayService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _HolidayService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _HolidayService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	LabelService_Create_FullMethodName      = "/entpb.LabelService/Create"
+	LabelService_Get_FullMethodName         = "/entpb.LabelService/Get"
+	LabelService_Update_FullMethodName      = "/entpb.LabelService/Update"
+	LabelService_Delete_FullMethodName      = "/entpb.LabelService/Delete"
+	LabelService_List_FullMethodName        = "/entpb.LabelService/List"
+	LabelService_BatchCreate_FullMethodName = "/entpb.LabelService/BatchCreate"
+)
+
+// LabelServiceClient is the client API for LabelService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LabelServiceClient interface {
+	Create(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*Label, error)
+	Get(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*Label, error)
+	Update(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*Label, error)
+	Delete(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListLabelRequest, opts ...grpc.CallOption) (*ListLabelResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateLabelsRequest, opts ...grpc.CallOption) (*BatchCreateLabelsResponse, error)
+}
+
+type labelServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLabelServiceClient(cc grpc.ClientConnInterface) LabelServiceClient {
+	return &labelServiceClient{cc}
+}
+
+func (c *labelServiceClient) Create(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Label)
+	err := c.cc.Invoke(ctx, LabelService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) Get(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Label)
+	err := c.cc.Invoke(ctx, LabelService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) Update(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Label)
+	err := c.cc.Invoke(ctx, LabelService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) Delete(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LabelService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) List(ctx context.Context, in *ListLabelRequest, opts ...grpc.CallOption) (*ListLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLabelResponse)
+	err := c.cc.Invoke(ctx, LabelService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labelServiceClient) BatchCreate(ctx context.Context, in *BatchCreateLabelsRequest, opts ...grpc.CallOption) (*BatchCreateLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateLabelsResponse)
+	err := c.cc.Invoke(ctx, LabelService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LabelServiceServer is the server API for LabelService service.
+// All implementations must embed UnimplementedLabelServiceServer
+// for forward compatibility.
+type LabelServiceServer interface {
+	Create(context.Context, *CreateLabelRequest) (*Label, error)
+	Get(context.Context, *GetLabelRequest) (*Label, error)
+	Update(context.Context, *UpdateLabelRequest) (*Label, error)
+	Delete(context.Context, *DeleteLabelRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListLabelRequest) (*ListLabelResponse, error)
+	BatchCreate(context.Context, *BatchCreateLabelsRequest) (*BatchCreateLabelsResponse, error)
+	mustEmbedUnimplementedLabelServiceServer()
+}
+
+// UnimplementedLabelServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLabelServiceServer struct{}
+
+func (UnimplementedLabelServiceServer) Create(context.Context, *CreateLabelRequest) (*Label, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedLabelServiceServer) Get(context.Context, *GetLabelRequest) (*Label, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedLabelServiceServer) Update(context.Context, *UpdateLabelRequest) (*Label, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedLabelServiceServer) Delete(context.Context, *DeleteLabelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedLabelServiceServer) List(context.Context, *ListLabelRequest) (*ListLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedLabelServiceServer) BatchCreate(context.Context, *BatchCreateLabelsRequest) (*BatchCreateLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedLabelServiceServer) mustEmbedUnimplementedLabelServiceServer() {}
 func (UnimplementedLabelServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeLabelServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LabelServiceServer will
+// UnsafeLabelServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LabelServiceServer will
+// result in compilation errors.
+type UnsafeLabelServiceServer interface {
+	mustEmbedUnimplementedLabelServiceServer()
+}
+
+func RegisterLabelServiceServer(s grpc.ServiceRegistrar, srv LabelServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLabelServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LabelService_ServiceDesc, srv)
+}
+
+func _LabelService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).Create(ctx, req.(*CreateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).Get(ctx, req.(*GetLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).Update(ctx, req.(*UpdateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).Delete(ctx, req.(*DeleteLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).List(ctx, req.(*ListLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabelService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabelService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).BatchCreate(ctx, req.(*BatchCreateLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LabelService_ServiceDesc is the grpc.ServiceDesc for LabelService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LabelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.LabelService",
+	HandlerType: (*LabelServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _LabelService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _LabelService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _LabelService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _LabelService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _LabelService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _LabelService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	LeaveApprovalService_Create_FullMethodName      = "/entpb.LeaveApprovalService/Create"
+	LeaveApprovalService_Get_FullMethodName         = "/entpb.LeaveApprovalService/Get"
+	LeaveApprovalService_Update_FullMethodName      = "/entpb.LeaveApprovalService/Update"
+	LeaveApprovalService_Delete_FullMethodName      = "/entpb.LeaveApprovalService/Delete"
+	LeaveApprovalService_List_FullMethodName        = "/entpb.LeaveApprovalService/List"
+	LeaveApprovalService_BatchCreate_FullMethodName = "/entpb.LeaveApprovalService/BatchCreate"
+)
+
+// LeaveApprovalServiceClient is the client API for LeaveApprovalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeaveApprovalServiceClient interface {
+	Create(ctx context.Context, in *CreateLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error)
+	Get(ctx context.Context, in *GetLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error)
+	Update(ctx context.Context, in *UpdateLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error)
+	Delete(ctx context.Context, in *DeleteLeaveApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListLeaveApprovalRequest, opts ...grpc.CallOption) (*ListLeaveApprovalResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateLeaveApprovalsRequest, opts ...grpc.CallOption) (*BatchCreateLeaveApprovalsResponse, error)
+}
+
+type leaveApprovalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeaveApprovalServiceClient(cc grpc.ClientConnInterface) LeaveApprovalServiceClient {
+	return &leaveApprovalServiceClient{cc}
+}
+
+func (c *leaveApprovalServiceClient) Create(ctx context.Context, in *CreateLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveApproval)
+	err := c.cc.Invoke(ctx, LeaveApprovalService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveApprovalServiceClient) Get(ctx context.Context, in *GetLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveApproval)
+	err := c.cc.Invoke(ctx, LeaveApprovalService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveApprovalServiceClient) Update(ctx context.Context, in *UpdateLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveApproval)
+	err := c.cc.Invoke(ctx, LeaveApprovalService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveApprovalServiceClient) Delete(ctx context.Context, in *DeleteLeaveApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LeaveApprovalService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveApprovalServiceClient) List(ctx context.Context, in *ListLeaveApprovalRequest, opts ...grpc.CallOption) (*ListLeaveApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeaveApprovalResponse)
+	err := c.cc.Invoke(ctx, LeaveApprovalService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveApprovalServiceClient) BatchCreate(ctx context.Context, in *BatchCreateLeaveApprovalsRequest, opts ...grpc.CallOption) (*BatchCreateLeaveApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateLeaveApprovalsResponse)
+	err := c.cc.Invoke(ctx, LeaveApprovalService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeaveApprovalServiceServer is the server API for LeaveApprovalService service.
+// All implementations must embed UnimplementedLeaveApprovalServiceServer
+// for forward compatibility.
+type LeaveApprovalServiceServer interface {
+	Create(context.Context, *CreateLeaveApprovalRequest) (*LeaveApproval, error)
+	Get(context.Context, *GetLeaveApprovalRequest) (*LeaveApproval, error)
+	Update(context.Context, *UpdateLeaveApprovalRequest) (*LeaveApproval, error)
+	Delete(context.Context, *DeleteLeaveApprovalRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListLeaveApprovalRequest) (*ListLeaveApprovalResponse, error)
+	BatchCreate(context.Context, *BatchCreateLeaveApprovalsRequest) (*BatchCreateLeaveApprovalsResponse, error)
+	mustEmbedUnimplementedLeaveApprovalServiceServer()
+}
+
+// UnimplementedLeaveApprovalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLeaveApprovalServiceServer struct{}
+
+func (UnimplementedLeaveApprovalServiceServer) Create(context.Context, *CreateLeaveApprovalRequest) (*LeaveApproval, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedLeaveApprovalServiceServer) Get(context.Context, *GetLeaveApprovalRequest) (*LeaveApproval, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedLeaveApprovalServiceServer) Update(context.Context, *UpdateLeaveApprovalRequest) (*LeaveApproval, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedLeaveApprovalServiceServer) Delete(context.Context, *DeleteLeaveApprovalRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedLeaveApprovalServiceServer) List(context.Context, *ListLeaveApprovalRequest) (*ListLeaveApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedLeaveApprovalServiceServer) BatchCreate(context.Context, *BatchCreateLeaveApprovalsRequest) (*BatchCreateLeaveApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedLeaveApprovalServiceServer) mustEmbedUnimplementedLeaveApprovalServiceServer() {}
+func (UnimplementedLeaveApprovalServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeLeaveApprovalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeaveApprovalServiceServer will
+// result in compilation errors.
+type UnsafeLeaveApprovalServiceServer interface {
+	mustEmbedUnimplementedLeaveApprovalServiceServer()
+}
+
+func RegisterLeaveApprovalServiceServer(s grpc.ServiceRegistrar, srv LeaveApprovalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLeaveApprovalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LeaveApprovalService_ServiceDesc, srv)
+}
+
+func _LeaveApprovalService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLeaveApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveApprovalServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveApprovalService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveApprovalServiceServer).Create(ctx, req.(*CreateLeaveApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveApprovalService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaveApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveApprovalServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveApprovalService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveApprovalServiceServer).Get(ctx, req.(*GetLeaveApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveApprovalService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeaveApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveApprovalServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveApprovalService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveApprovalServiceServer).Update(ctx, req.(*UpdateLeaveApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveApprovalService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLeaveApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveApprovalServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveApprovalService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveApprovalServiceServer).Delete(ctx, req.(*DeleteLeaveApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveApprovalService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaveApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveApprovalServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveApprovalService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveApprovalServiceServer).List(ctx, req.(*ListLeaveApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveApprovalService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateLeaveApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveApprovalServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveApprovalService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveApprovalServiceServer).BatchCreate(ctx, req.(*BatchCreateLeaveApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeaveApprovalService_ServiceDesc is the grpc.ServiceDesc for LeaveApprovalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeaveApprovalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.LeaveApprovalService",
+	HandlerType: (*LeaveApprovalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _LeaveApprovalService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _LeaveApprovalService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _LeaveApprovalService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _LeaveApprovalService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _LeaveApprovalService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _LeaveApprovalService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	LeaveRequestService_Create_FullMethodName      = "/entpb.LeaveRequestService/Create"
+	LeaveRequestService_Get_FullMethodName         = "/entpb.LeaveRequestService/Get"
+	LeaveRequestService_Update_FullMethodName      = "/entpb.LeaveRequestService/Update"
+	LeaveRequestService_Delete_FullMethodName      = "/entpb.LeaveRequestService/Delete"
+	LeaveRequestService_List_FullMethodName        = "/entpb.LeaveRequestService/List"
+	LeaveRequestService_BatchCreate_FullMethodName = "/entpb.LeaveRequestService/BatchCreate"
+)
+
+// LeaveRequestServiceClient is the client API for LeaveRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeaveRequestServiceClient interface {
+	Create(ctx context.Context, in *CreateLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error)
+	Get(ctx context.Context, in *GetLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error)
+	Update(ctx context.Context, in *UpdateLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error)
+	Delete(ctx context.Context, in *DeleteLeaveRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListLeaveRequestRequest, opts ...grpc.CallOption) (*ListLeaveRequestResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateLeaveRequestsRequest, opts ...grpc.CallOption) (*BatchCreateLeaveRequestsResponse, error)
+}
+
+type leaveRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeaveRequestServiceClient(cc grpc.ClientConnInterface) LeaveRequestServiceClient {
+	return &leaveRequestServiceClient{cc}
+}
+
+func (c *leaveRequestServiceClient) Create(ctx context.Context, in *CreateLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveRequest)
+	err := c.cc.Invoke(ctx, LeaveRequestService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveRequestServiceClient) Get(ctx context.Context, in *GetLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveRequest)
+	err := c.cc.Invoke(ctx, LeaveRequestService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveRequestServiceClient) Update(ctx context.Context, in *UpdateLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveRequest)
+	err := c.cc.Invoke(ctx, LeaveRequestService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveRequestServiceClient) Delete(ctx context.Context, in *DeleteLeaveRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LeaveRequestService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveRequestServiceClient) List(ctx context.Context, in *ListLeaveRequestRequest, opts ...grpc.CallOption) (*ListLeaveRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeaveRequestResponse)
+	err := c.cc.Invoke(ctx, LeaveRequestService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveRequestServiceClient) BatchCreate(ctx context.Context, in *BatchCreateLeaveRequestsRequest, opts ...grpc.CallOption) (*BatchCreateLeaveRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateLeaveRequestsResponse)
+	err := c.cc.Invoke(ctx, LeaveRequestService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeaveRequestServiceServer is the server API for LeaveRequestService service.
+// All implementations must embed UnimplementedLeaveRequestServiceServer
+// for forward compatibility.
+type LeaveRequestServiceServer interface {
+	Create(context.Context, *CreateLeaveRequestRequest) (*LeaveRequest, error)
+	Get(context.Context, *GetLeaveRequestRequest) (*LeaveRequest, error)
+	Update(context.Context, *UpdateLeaveRequestRequest) (*LeaveRequest, error)
+	Delete(context.Context, *DeleteLeaveRequestRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListLeaveRequestRequest) (*ListLeaveRequestResponse, error)
+	BatchCreate(context.Context, *BatchCreateLeaveRequestsRequest) (*BatchCreateLeaveRequestsResponse, error)
+	mustEmbedUnimplementedLeaveRequestServiceServer()
+}
+
+// UnimplementedLeaveRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLeaveRequestServiceServer struct{}
+
+func (UnimplementedLeaveRequestServiceServer) Create(context.Context, *CreateLeaveRequestRequest) (*LeaveRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedLeaveRequestServiceServer) Get(context.Context, *GetLeaveRequestRequest) (*LeaveRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedLeaveRequestServiceServer) Update(context.Context, *UpdateLeaveRequestRequest) (*LeaveRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedLeaveRequestServiceServer) Delete(context.Context, *DeleteLeaveRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedLeaveRequestServiceServer) List(context.Context, *ListLeaveRequestRequest) (*ListLeaveRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedLeaveRequestServiceServer) BatchCreate(context.Context, *BatchCreateLeaveRequestsRequest) (*BatchCreateLeaveRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedLeaveRequestServiceServer) mustEmbedUnimplementedLeaveRequestServiceServer() {}
+func (UnimplementedLeaveRequestServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeLeaveRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeaveRequestServiceServer will
+// result in compilation errors.
+type UnsafeLeaveRequestServiceServer interface {
+	mustEmbedUnimplementedLeaveRequestServiceServer()
+}
+
+func RegisterLeaveRequestServiceServer(s grpc.ServiceRegistrar, srv LeaveRequestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLeaveRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LeaveRequestService_ServiceDesc, srv)
+}
+
+func _LeaveRequestService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLeaveRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveRequestServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveRequestService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveRequestServiceServer).Create(ctx, req.(*CreateLeaveRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveRequestService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaveRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveRequestServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveRequestService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveRequestServiceServer).Get(ctx, req.(*GetLeaveRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveRequestService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeaveRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveRequestServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveRequestService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveRequestServiceServer).Update(ctx, req.(*UpdateLeaveRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveRequestService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLeaveRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveRequestServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveRequestService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveRequestServiceServer).Delete(ctx, req.(*DeleteLeaveRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveRequestService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaveRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveRequestServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveRequestService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveRequestServiceServer).List(ctx, req.(*ListLeaveRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveRequestService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateLeaveRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveRequestServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaveRequestService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveRequestServiceServer).BatchCreate(ctx, req.(*BatchCreateLeaveRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeaveRequestService_ServiceDesc is the grpc.ServiceDesc for LeaveRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeaveRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.LeaveRequestService",
+	HandlerType: (*LeaveRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _LeaveRequestService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _LeaveRequestService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _LeaveRequestService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _LeaveRequestService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _LeaveRequestService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _LeaveRequestService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	MilestoneService_Create_FullMethodName      = "/entpb.MilestoneService/Create"
+	MilestoneService_Get_FullMethodName         = "/entpb.MilestoneService/Get"
+	MilestoneService_Update_FullMethodName      = "/entpb.MilestoneService/Update"
+	MilestoneService_Delete_FullMethodName      = "/entpb.MilestoneService/Delete"
+	MilestoneService_List_FullMethodName        = "/entpb.MilestoneService/List"
+	MilestoneService_BatchCreate_FullMethodName = "/entpb.MilestoneService/BatchCreate"
+)
+
+// MilestoneServiceClient is the client API for MilestoneService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MilestoneServiceClient interface {
+	Create(ctx context.Context, in *CreateMilestoneRequest, opts ...grpc.CallOption) (*Milestone, error)
+	Get(ctx context.Context, in *GetMilestoneRequest, opts ...grpc.CallOption) (*Milestone, error)
+	Update(ctx context.Context, in *UpdateMilestoneRequest, opts ...grpc.CallOption) (*Milestone, error)
+	Delete(ctx context.Context, in *DeleteMilestoneRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListMilestoneRequest, opts ...grpc.CallOption) (*ListMilestoneResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateMilestonesRequest, opts ...grpc.CallOption) (*BatchCreateMilestonesResponse, error)
+}
+
+type milestoneServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMilestoneServiceClient(cc grpc.ClientConnInterface) MilestoneServiceClient {
+	return &milestoneServiceClient{cc}
+}
+
+func (c *milestoneServiceClient) Create(ctx context.Context, in *CreateMilestoneRequest, opts ...grpc.CallOption) (*Milestone, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Milestone)
+	err := c.cc.Invoke(ctx, MilestoneService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milestoneServiceClient) Get(ctx context.Context, in *GetMilestoneRequest, opts ...grpc.CallOption) (*Milestone, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Milestone)
+	err := c.cc.Invoke(ctx, MilestoneService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milestoneServiceClient) Update(ctx context.Context, in *UpdateMilestoneRequest, opts ...grpc.CallOption) (*Milestone, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Milestone)
+	err := c.cc.Invoke(ctx, MilestoneService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milestoneServiceClient) Delete(ctx context.Context, in *DeleteMilestoneRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MilestoneService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milestoneServiceClient) List(ctx context.Context, in *ListMilestoneRequest, opts ...grpc.CallOption) (*ListMilestoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMilestoneResponse)
+	err := c.cc.Invoke(ctx, MilestoneService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milestoneServiceClient) BatchCreate(ctx context.Context, in *BatchCreateMilestonesRequest, opts ...grpc.CallOption) (*BatchCreateMilestonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateMilestonesResponse)
+	err := c.cc.Invoke(ctx, MilestoneService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MilestoneServiceServer is the server API for MilestoneService service.
+// All implementations must embed UnimplementedMilestoneServiceServer
+// for forward compatibility.
+type MilestoneServiceServer interface {
+	Create(context.Context, *CreateMilestoneRequest) (*Milestone, error)
+	Get(context.Context, *GetMilestoneRequest) (*Milestone, error)
+	Update(context.Context, *UpdateMilestoneRequest) (*Milestone, error)
+	Delete(context.Context, *DeleteMilestoneRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListMilestoneRequest) (*ListMilestoneResponse, error)
+	BatchCreate(context.Context, *BatchCreateMilestonesRequest) (*BatchCreateMilestonesResponse, error)
+	mustEmbedUnimplementedMilestoneServiceServer()
+}
+
+// UnimplementedMilestoneServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMilestoneServiceServer struct{}
+
+func (UnimplementedMilestoneServiceServer) Create(context.Context, *CreateMilestoneRequest) (*Milestone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedMilestoneServiceServer) Get(context.Context, *GetMilestoneRequest) (*Milestone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedMilestoneServiceServer) Update(context.Context, *UpdateMilestoneRequest) (*Milestone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedMilestoneServiceServer) Delete(context.Context, *DeleteMilestoneRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedMilestoneServiceServer) List(context.Context, *ListMilestoneRequest) (*ListMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedMilestoneServiceServer) BatchCreate(context.Context, *BatchCreateMilestonesRequest) (*BatchCreateMilestonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedMilestoneServiceServer) mustEmbedUnimplementedMilestoneServiceServer() {}
+func (UnimplementedMilestoneServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeMilestoneServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MilestoneServiceServer will
+// result in compilation errors.
+type UnsafeMilestoneServiceServer interface {
+	mustEmbedUnimplementedMilestoneServiceServer()
+}
+
+func RegisterMilestoneServiceServer(s grpc.ServiceRegistrar, srv MilestoneServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMilestoneServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MilestoneService_ServiceDesc, srv)
+}
+
+func _MilestoneService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilestoneServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilestoneService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilestoneServiceServer).Create(ctx, req.(*CreateMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilestoneService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilestoneServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilestoneService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilestoneServiceServer).Get(ctx, req.(*GetMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilestoneService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilestoneServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilestoneService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilestoneServiceServer).Update(ctx, req.(*UpdateMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilestoneService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilestoneServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilestoneService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilestoneServiceServer).Delete(ctx, req.(*DeleteMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilestoneService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMilestoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilestoneServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilestoneService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilestoneServiceServer).List(ctx, req.(*ListMilestoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilestoneService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateMilestonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilestoneServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MilestoneService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilestoneServiceServer).BatchCreate(ctx, req.(*BatchCreateMilestonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MilestoneService_ServiceDesc is the grpc.ServiceDesc for MilestoneService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MilestoneService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.MilestoneService",
+	HandlerType: (*MilestoneServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _MilestoneService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _MilestoneService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _MilestoneService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _MilestoneService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _MilestoneService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _MilestoneService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	NotificationService_Create_FullMethodName      = "/entpb.NotificationService/Create"
+	NotificationService_Get_FullMethodName         = "/entpb.NotificationService/Get"
+	NotificationService_Update_FullMethodName      = "/entpb.NotificationService/Update"
+	NotificationService_Delete_FullMethodName      = "/entpb.NotificationService/Delete"
+	NotificationService_List_FullMethodName        = "/entpb.NotificationService/List"
+	NotificationService_BatchCreate_FullMethodName = "/entpb.NotificationService/BatchCreate"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationServiceClient interface {
+	Create(ctx context.Context, in *CreateNotificationRequest, opts ...grpc.CallOption) (*Notification, error)
+	Get(ctx context.Context, in *GetNotificationRequest, opts ...grpc.CallOption) (*Notification, error)
+	Update(ctx context.Context, in *UpdateNotificationRequest, opts ...grpc.CallOption) (*Notification, error)
+	Delete(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListNotificationRequest, opts ...grpc.CallOption) (*ListNotificationResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateNotificationsRequest, opts ...grpc.CallOption) (*BatchCreateNotificationsResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) Create(ctx context.Context, in *CreateNotificationRequest, opts ...grpc.CallOption) (*Notification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Notification)
+	err := c.cc.Invoke(ctx, NotificationService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) Get(ctx context.Context, in *GetNotificationRequest, opts ...grpc.CallOption) (*Notification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Notification)
+	err := c.cc.Invoke(ctx, NotificationService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) Update(ctx context.Context, in *UpdateNotificationRequest, opts ...grpc.CallOption) (*Notification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Notification)
+	err := c.cc.Invoke(ctx, NotificationService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) Delete(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotificationService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) List(ctx context.Context, in *ListNotificationRequest, opts ...grpc.CallOption) (*ListNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) BatchCreate(ctx context.Context, in *BatchCreateNotificationsRequest, opts ...grpc.CallOption) (*BatchCreateNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+type NotificationServiceServer interface {
+	Create(context.Context, *CreateNotificationRequest) (*Notification, error)
+	Get(context.Context, *GetNotificationRequest) (*Notification, error)
+	Update(context.Context, *UpdateNotificationRequest) (*Notification, error)
+	Delete(context.Context, *DeleteNotificationRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListNotificationRequest) (*ListNotificationResponse, error)
+	BatchCreate(context.Context, *BatchCreateNotificationsRequest) (*BatchCreateNotificationsResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) Create(context.Context, *CreateNotificationRequest) (*Notification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedNotificationServiceServer) Get(context.Context, *GetNotificationRequest) (*Notification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedNotificationServiceServer) Update(context.Context, *UpdateNotificationRequest) (*Notification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedNotificationServiceServer) Delete(context.Context, *DeleteNotificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedNotificationServiceServer) List(context.Context, *ListNotificationRequest) (*ListNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedNotificationServiceServer) BatchCreate(context.Context, *BatchCreateNotificationsRequest) (*BatchCreateNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Create(ctx, req.(*CreateNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Get(ctx, req.(*GetNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Update(ctx, req.(*UpdateNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Delete(ctx, req.(*DeleteNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).List(ctx, req.(*ListNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).BatchCreate(ctx, req.(*BatchCreateNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _NotificationService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _NotificationService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _NotificationService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NotificationService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _NotificationService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _NotificationService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	NotificationPreferenceService_Create_FullMethodName      = "/entpb.NotificationPreferenceService/Create"
+	NotificationPreferenceService_Get_FullMethodName         = "/entpb.NotificationPreferenceService/Get"
+	NotificationPreferenceService_Update_FullMethodName      = "/entpb.NotificationPreferenceService/Update"
+	NotificationPreferenceService_Delete_FullMethodName      = "/entpb.NotificationPreferenceService/Delete"
+	NotificationPreferenceService_List_FullMethodName        = "/entpb.NotificationPreferenceService/List"
+	NotificationPreferenceService_BatchCreate_FullMethodName = "/entpb.NotificationPreferenceService/BatchCreate"
+)
+
+// NotificationPreferenceServiceClient is the client API for NotificationPreferenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationPreferenceServiceClient interface {
+	Create(ctx context.Context, in *CreateNotificationPreferenceRequest, opts ...grpc.CallOption) (*NotificationPreference, error)
+	Get(ctx context.Context, in *GetNotificationPreferenceRequest, opts ...grpc.CallOption) (*NotificationPreference, error)
+	Update(ctx context.Context, in *UpdateNotificationPreferenceRequest, opts ...grpc.CallOption) (*NotificationPreference, error)
+	Delete(ctx context.Context, in *DeleteNotificationPreferenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListNotificationPreferenceRequest, opts ...grpc.CallOption) (*ListNotificationPreferenceResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateNotificationPreferencesRequest, opts ...grpc.CallOption) (*BatchCreateNotificationPreferencesResponse, error)
+}
+
+type notificationPreferenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationPreferenceServiceClient(cc grpc.ClientConnInterface) NotificationPreferenceServiceClient {
+	return &notificationPreferenceServiceClient{cc}
+}
+
+func (c *notificationPreferenceServiceClient) Create(ctx context.Context, in *CreateNotificationPreferenceRequest, opts ...grpc.CallOption) (*NotificationPreference, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreference)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceServiceClient) Get(ctx context.Context, in *GetNotificationPreferenceRequest, opts ...grpc.CallOption) (*NotificationPreference, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreference)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceServiceClient) Update(ctx context.Context, in *UpdateNotificationPreferenceRequest, opts ...grpc.CallOption) (*NotificationPreference, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreference)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceServiceClient) Delete(ctx context.Context, in *DeleteNotificationPreferenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceServiceClient) List(ctx context.Context, in *ListNotificationPreferenceRequest, opts ...grpc.CallOption) (*ListNotificationPreferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationPreferenceResponse)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPreferenceServiceClient) BatchCreate(ctx context.Context, in *BatchCreateNotificationPreferencesRequest, opts ...grpc.CallOption) (*BatchCreateNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, NotificationPreferenceService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationPreferenceServiceServer is the server API for NotificationPreferenceService service.
+// All implementations must embed UnimplementedNotificationPreferenceServiceServer
+// for forward compatibility.
+type NotificationPreferenceServiceServer interface {
+	Create(context.Context, *CreateNotificationPreferenceRequest) (*NotificationPreference, error)
+	Get(context.Context, *GetNotificationPreferenceRequest) (*NotificationPreference, error)
+	Update(context.Context, *UpdateNotificationPreferenceRequest) (*NotificationPreference, error)
+	Delete(context.Context, *DeleteNotificationPreferenceRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListNotificationPreferenceRequest) (*ListNotificationPreferenceResponse, error)
+	BatchCreate(context.Context, *BatchCreateNotificationPreferencesRequest) (*BatchCreateNotificationPreferencesResponse, error)
+	mustEmbedUnimplementedNotificationPreferenceServiceServer()
+}
+
+// UnimplementedNotificationPreferenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationPreferenceServiceServer struct{}
+
+func (UnimplementedNotificationPreferenceServiceServer) Create(context.Context, *CreateNotificationPreferenceRequest) (*NotificationPreference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) Get(context.Context, *GetNotificationPreferenceRequest) (*NotificationPreference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) Update(context.Context, *UpdateNotificationPreferenceRequest) (*NotificationPreference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) Delete(context.Context, *DeleteNotificationPreferenceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) List(context.Context, *ListNotificationPreferenceRequest) (*ListNotificationPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) BatchCreate(context.Context, *BatchCreateNotificationPreferencesRequest) (*BatchCreateNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedNotificationPreferenceServiceServer) mustEmbedUnimplementedNotificationPreferenceServiceServer() {
+}
+func (UnimplementedNotificationPreferenceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationPreferenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationPreferenceServiceServer will
+// result in compilation errors.
+type UnsafeNotificationPreferenceServiceServer interface {
+	mustEmbedUnimplementedNotificationPreferenceServiceServer()
+}
+
+func RegisterNotificationPreferenceServiceServer(s grpc.ServiceRegistrar, srv NotificationPreferenceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationPreferenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationPreferenceService_ServiceDesc, srv)
+}
+
+func _NotificationPreferenceService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).Create(ctx, req.(*CreateNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreferenceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).Get(ctx, req.(*GetNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreferenceService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).Update(ctx, req.(*UpdateNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreferenceService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).Delete(ctx, req.(*DeleteNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreferenceService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).List(ctx, req.(*ListNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPreferenceService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPreferenceServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPreferenceService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPreferenceServiceServer).BatchCreate(ctx, req.(*BatchCreateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationPreferenceService_ServiceDesc is the grpc.ServiceDesc for NotificationPreferenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationPreferenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.NotificationPreferenceService",
+	HandlerType: (*NotificationPreferenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _NotificationPreferenceService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _NotificationPreferenceService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _NotificationPreferenceService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NotificationPreferenceService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _NotificationPreferenceService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _NotificationPreferenceService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	OrganizationService_Create_FullMethodName      = "/entpb.OrganizationService/Create"
+	OrganizationService_Get_FullMethodName         = "/entpb.OrganizationService/Get"
+	OrganizationService_Update_FullMethodName      = "/entpb.OrganizationService/Update"
+	OrganizationService_Delete_FullMethodName      = "/entpb.OrganizationService/Delete"
+	OrganizationService_List_FullMethodName        = "/entpb.OrganizationService/List"
+	OrganizationService_BatchCreate_FullMethodName = "/entpb.OrganizationService/BatchCreate"
+)
+
+// OrganizationServiceClient is the client API for OrganizationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrganizationServiceClient interface {
+	Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateOrganizationsRequest, opts ...grpc.CallOption) (*BatchCreateOrganizationsResponse, error)
+}
+
+type organizationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrganizationServiceClient(cc grpc.ClientConnInterface) OrganizationServiceClient {
+	return &organizationServiceClient{cc}
+}
+
+func (c *organizationServiceClient) Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrganizationService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) BatchCreate(ctx context.Context, in *BatchCreateOrganizationsRequest, opts ...grpc.CallOption) (*BatchCreateOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateOrganizationsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrganizationServiceServer is the server API for OrganizationService service.
+// All implementations must embed UnimplementedOrganizationServiceServer
+// for forward compatibility.
+type OrganizationServiceServer interface {
+	Create(context.Context, *CreateOrganizationRequest) (*Organization, error)
+	Get(context.Context, *GetOrganizationRequest) (*Organization, error)
+	Update(context.Context, *UpdateOrganizationRequest) (*Organization, error)
+	Delete(context.Context, *DeleteOrganizationRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListOrganizationRequest) (*ListOrganizationResponse, error)
+	BatchCreate(context.Context, *BatchCreateOrganizationsRequest) (*BatchCreateOrganizationsResponse, error)
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+// UnimplementedOrganizationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrganizationServiceServer struct{}
+
+func (UnimplementedOrganizationServiceServer) Create(context.Context, *CreateOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedOrganizationServiceServer) Get(context.Context, *GetOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedOrganizationServiceServer) Update(context.Context, *UpdateOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedOrganizationServiceServer) Delete(context.Context, *DeleteOrganizationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedOrganizationServiceServer) List(context.Context, *ListOrganizationRequest) (*ListOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedOrganizationServiceServer) BatchCreate(context.Context, *BatchCreateOrganizationsRequest) (*BatchCreateOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
+func (UnimplementedOrganizationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeOrganizationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrganizationServiceServer will
+// result in compilation errors.
+type UnsafeOrganizationServiceServer interface {
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+func RegisterOrganizationServiceServer(s grpc.ServiceRegistrar, srv OrganizationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOrganizationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrganizationService_ServiceDesc, srv)
+}
+
+func _OrganizationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).Create(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).Get(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).Update(ctx, req.(*UpdateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).Delete(ctx, req.(*DeleteOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).List(ctx, req.(*ListOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).BatchCreate(ctx, req.(*BatchCreateOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrganizationService_ServiceDesc is the grpc.ServiceDesc for OrganizationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrganizationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.OrganizationService",
+	HandlerType: (*OrganizationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _OrganizationService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _OrganizationService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _OrganizationService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _OrganizationService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _OrganizationService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _OrganizationService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	OvertimeRequestService_Create_FullMethodName      = "/entpb.OvertimeRequestService/Create"
+	OvertimeRequestService_Get_FullMethodName         = "/entpb.OvertimeRequestService/Get"
+	OvertimeRequestService_Update_FullMethodName      = "/entpb.OvertimeRequestService/Update"
+	OvertimeRequestService_Delete_FullMethodName      = "/entpb.OvertimeRequestService/Delete"
+	OvertimeRequestService_List_FullMethodName        = "/entpb.OvertimeRequestService/List"
+	OvertimeRequestService_BatchCreate_FullMethodName = "/entpb.OvertimeRequestService/BatchCreate"
+)
+
+// OvertimeRequestServiceClient is the client API for OvertimeRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OvertimeRequestServiceClient interface {
+	Create(ctx context.Context, in *CreateOvertimeRequestRequest, opts ...grpc.CallOption) (*OvertimeRequest, error)
+	Get(ctx context.Context, in *GetOvertimeRequestRequest, opts ...grpc.CallOption) (*OvertimeRequest, error)
+	Update(ctx context.Context, in *UpdateOvertimeRequestRequest, opts ...grpc.CallOption) (*OvertimeRequest, error)
+	Delete(ctx context.Context, in *DeleteOvertimeRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListOvertimeRequestRequest, opts ...grpc.CallOption) (*ListOvertimeRequestResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateOvertimeRequestsRequest, opts ...grpc.CallOption) (*BatchCreateOvertimeRequestsResponse, error)
+}
+
+type overtimeRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOvertimeRequestServiceClient(cc grpc.ClientConnInterface) OvertimeRequestServiceClient {
+	return &overtimeRequestServiceClient{cc}
+}
+
+func (c *overtimeRequestServiceClient) Create(ctx context.Context, in *CreateOvertimeRequestRequest, opts ...grpc.CallOption) (*OvertimeRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OvertimeRequest)
+	err := c.cc.Invoke(ctx, OvertimeRequestService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimeRequestServiceClient) Get(ctx context.Context, in *GetOvertimeRequestRequest, opts ...grpc.CallOption) (*OvertimeRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OvertimeRequest)
+	err := c.cc.Invoke(ctx, OvertimeRequestService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimeRequestServiceClient) Update(ctx context.Context, in *UpdateOvertimeRequestRequest, opts ...grpc.CallOption) (*OvertimeRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OvertimeRequest)
+	err := c.cc.Invoke(ctx, OvertimeRequestService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimeRequestServiceClient) Delete(ctx context.Context, in *DeleteOvertimeRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OvertimeRequestService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimeRequestServiceClient) List(ctx context.Context, in *ListOvertimeRequestRequest, opts ...grpc.CallOption) (*ListOvertimeRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOvertimeRequestResponse)
+	err := c.cc.Invoke(ctx, OvertimeRequestService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overtimeRequestServiceClient) BatchCreate(ctx context.Context, in *BatchCreateOvertimeRequestsRequest, opts ...grpc.CallOption) (*BatchCreateOvertimeRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateOvertimeRequestsResponse)
+	err := c.cc.Invoke(ctx, OvertimeRequestService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OvertimeRequestServiceServer is the server API for OvertimeRequestService service.
+// All implementations must embed UnimplementedOvertimeRequestServiceServer
+// for forward compatibility.
+type OvertimeRequestServiceServer interface {
+	Create(context.Context, *CreateOvertimeRequestRequest) (*OvertimeRequest, error)
+	Get(context.Context, *GetOvertimeRequestRequest) (*OvertimeRequest, error)
+	Update(context.Context, *UpdateOvertimeRequestRequest) (*OvertimeRequest, error)
+	Delete(context.Context, *DeleteOvertimeRequestRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListOvertimeRequestRequest) (*ListOvertimeRequestResponse, error)
+	BatchCreate(context.Context, *BatchCreateOvertimeRequestsRequest) (*BatchCreateOvertimeRequestsResponse, error)
+	mustEmbedUnimplementedOvertimeRequestServiceServer()
+}
+
+// UnimplementedOvertimeRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOvertimeRequestServiceServer struct{}
+
+func (UnimplementedOvertimeRequestServiceServer) Create(context.Context, *CreateOvertimeRequestRequest) (*OvertimeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedOvertimeRequestServiceServer) Get(context.Context, *GetOvertimeRequestRequest) (*OvertimeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedOvertimeRequestServiceServer) Update(context.Context, *UpdateOvertimeRequestRequest) (*OvertimeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedOvertimeRequestServiceServer) Delete(context.Context, *DeleteOvertimeRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedOvertimeRequestServiceServer) List(context.Context, *ListOvertimeRequestRequest) (*ListOvertimeRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedOvertimeRequestServiceServer) BatchCreate(context.Context, *BatchCreateOvertimeRequestsRequest) (*BatchCreateOvertimeRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedOvertimeRequestServiceServer) mustEmbedUnimplementedOvertimeRequestServiceServer() {
+}
+func (UnimplementedOvertimeRequestServiceServer) testEmbeddedByValue() {}
+
+// UnsafeOvertimeRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OvertimeRequestServiceServer will
+// result in compilation errors.
+type UnsafeOvertimeRequestServiceServer interface {
+	mustEmbedUnimplementedOvertimeRequestServiceServer()
+}
+
+func RegisterOvertimeRequestServiceServer(s grpc.ServiceRegistrar, srv OvertimeRequestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOvertimeRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OvertimeRequestService_ServiceDesc, srv)
+}
+
+func _OvertimeRequestService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOvertimeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimeRequestServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OvertimeRequestService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimeRequestServiceServer).Create(ctx, req.(*CreateOvertimeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimeRequestService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOvertimeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimeRequestServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OvertimeRequestService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimeRequestServiceServer).Get(ctx, req.(*GetOvertimeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimeRequestService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOvertimeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimeRequestServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OvertimeRequestService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimeRequestServiceServer).Update(ctx, req.(*UpdateOvertimeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimeRequestService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOvertimeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimeRequestServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OvertimeRequestService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimeRequestServiceServer).Delete(ctx, req.(*DeleteOvertimeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimeRequestService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOvertimeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimeRequestServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OvertimeRequestService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimeRequestServiceServer).List(ctx, req.(*ListOvertimeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OvertimeRequestService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateOvertimeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OvertimeRequestServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OvertimeRequestService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OvertimeRequestServiceServer).BatchCreate(ctx, req.(*BatchCreateOvertimeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OvertimeRequestService_ServiceDesc is the grpc.ServiceDesc for OvertimeRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OvertimeRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.OvertimeRequestService",
+	HandlerType: (*OvertimeRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _OvertimeRequestService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _OvertimeRequestService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _OvertimeRequestService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _OvertimeRequestService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _OvertimeRequestService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _OvertimeRequestService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	PositionService_Create_FullMethodName      = "/entpb.PositionService/Create"
+	PositionService_Get_FullMethodName         = "/entpb.PositionService/Get"
+	PositionService_Update_FullMethodName      = "/entpb.PositionService/Update"
+	PositionService_Delete_FullMethodName      = "/entpb.PositionService/Delete"
+	PositionService_List_FullMethodName        = "/entpb.PositionService/List"
+	PositionService_BatchCreate_FullMethodName = "/entpb.PositionService/BatchCreate"
+)
+
+// PositionServiceClient is the client API for PositionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PositionServiceClient interface {
+	Create(ctx context.Context, in *CreatePositionRequest, opts ...grpc.CallOption) (*Position, error)
+	Get(ctx context.Context, in *GetPositionRequest, opts ...grpc.CallOption) (*Position, error)
+	Update(ctx context.Context, in *UpdatePositionRequest, opts ...grpc.CallOption) (*Position, error)
+	Delete(ctx context.Context, in *DeletePositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListPositionRequest, opts ...grpc.CallOption) (*ListPositionResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreatePositionsRequest, opts ...grpc.CallOption) (*BatchCreatePositionsResponse, error)
+}
+
+type positionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPositionServiceClient(cc grpc.ClientConnInterface) PositionServiceClient {
+	return &positionServiceClient{cc}
+}
+
+func (c *positionServiceClient) Create(ctx context.Context, in *CreatePositionRequest, opts ...grpc.CallOption) (*Position, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Position)
+	err := c.cc.Invoke(ctx, PositionService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) Get(ctx context.Context, in *GetPositionRequest, opts ...grpc.CallOption) (*Position, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Position)
+	err := c.cc.Invoke(ctx, PositionService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) Update(ctx context.Context, in *UpdatePositionRequest, opts ...grpc.CallOption) (*Position, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Position)
+	err := c.cc.Invoke(ctx, PositionService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) Delete(ctx context.Context, in *DeletePositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PositionService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) List(ctx context.Context, in *ListPositionRequest, opts ...grpc.CallOption) (*ListPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPositionResponse)
+	err := c.cc.Invoke(ctx, PositionService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) BatchCreate(ctx context.Context, in *BatchCreatePositionsRequest, opts ...grpc.CallOption) (*BatchCreatePositionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreatePositionsResponse)
+	err := c.cc.Invoke(ctx, PositionService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PositionServiceServer is the server API for PositionService service.
+// All implementations must embed UnimplementedPositionServiceServer
+// for forward compatibility.
+type PositionServiceServer interface {
+	Create(context.Context, *CreatePositionRequest) (*Position, error)
+	Get(context.Context, *GetPositionRequest) (*Position, error)
+	Update(context.Context, *UpdatePositionRequest) (*Position, error)
+	Delete(context.Context, *DeletePositionRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListPositionRequest) (*ListPositionResponse, error)
+	BatchCreate(context.Context, *BatchCreatePositionsRequest) (*BatchCreatePositionsResponse, error)
+	mustEmbedUnimplementedPositionServiceServer()
+}
+
+// UnimplementedPositionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPositionServiceServer struct{}
+
+func (UnimplementedPositionServiceServer) Create(context.Context, *CreatePositionRequest) (*Position, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPositionServiceServer) Get(context.Context, *GetPositionRequest) (*Position, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedPositionServiceServer) Update(context.Context, *UpdatePositionRequest) (*Position, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPositionServiceServer) Delete(context.Context, *DeletePositionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPositionServiceServer) List(context.Context, *ListPositionRequest) (*ListPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedPositionServiceServer) BatchCreate(context.Context, *BatchCreatePositionsRequest) (*BatchCreatePositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedPositionServiceServer) mustEmbedUnimplementedPositionServiceServer() {}
+func (UnimplementedPositionServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePositionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PositionServiceServer will
+// result in compilation errors.
+type UnsafePositionServiceServer interface {
+	mustEmbedUnimplementedPositionServiceServer()
+}
+
+func RegisterPositionServiceServer(s grpc.ServiceRegistrar, srv PositionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPositionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PositionService_ServiceDesc, srv)
+}
+
+func _PositionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).Create(ctx, req.(*CreatePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).Get(ctx, req.(*GetPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).Update(ctx, req.(*UpdatePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).Delete(ctx, req.(*DeletePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).List(ctx, req.(*ListPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreatePositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).BatchCreate(ctx, req.(*BatchCreatePositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PositionService_ServiceDesc is the grpc.ServiceDesc for PositionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PositionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.PositionService",
+	HandlerType: (*PositionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _PositionService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _PositionService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PositionService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PositionService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _PositionService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _PositionService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	ProjectService_Create_FullMethodName      = "/entpb.ProjectService/Create"
+	ProjectService_Get_FullMethodName         = "/entpb.ProjectService/Get"
+	ProjectService_Update_FullMethodName      = "/entpb.ProjectService/Update"
+	ProjectService_Delete_FullMethodName      = "/entpb.ProjectService/Delete"
+	ProjectService_List_FullMethodName        = "/entpb.ProjectService/List"
+	ProjectService_BatchCreate_FullMethodName = "/entpb.ProjectService/BatchCreate"
+)
+
+// ProjectServiceClient is the client API for ProjectService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectServiceClient interface {
+	Create(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	Get(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	Update(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	Delete(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (*ListProjectResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error)
+}
+
+type projectServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectServiceClient(cc grpc.ClientConnInterface) ProjectServiceClient {
+	return &projectServiceClient{cc}
+}
+
+func (c *projectServiceClient) Create(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, ProjectService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Get(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, ProjectService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Update(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Project)
+	err := c.cc.Invoke(ctx, ProjectService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Delete(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProjectService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) List(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (*ListProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) BatchCreate(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateProjectsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectServiceServer is the server API for ProjectService service.
+// All implementations must embed UnimplementedProjectServiceServer
+// for forward compatibility.
+type ProjectServiceServer interface {
+	Create(context.Context, *CreateProjectRequest) (*Project, error)
+	Get(context.Context, *GetProjectRequest) (*Project, error)
+	Update(context.Context, *UpdateProjectRequest) (*Project, error)
+	Delete(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListProjectRequest) (*ListProjectResponse, error)
+	BatchCreate(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error)
+	mustEmbedUnimplementedProjectServiceServer()
+}
+
+// UnimplementedProjectServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProjectServiceServer struct{}
+
+func (UnimplementedProjectServiceServer) Create(context.Context, *CreateProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedProjectServiceServer) Get(context.Context, *GetProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedProjectServiceServer) Update(context.Context, *UpdateProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedProjectServiceServer) Delete(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedProjectServiceServer) List(context.Context, *ListProjectRequest) (*ListProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedProjectServiceServer) BatchCreate(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
+func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectServiceServer will
+// result in compilation errors.
+type UnsafeProjectServiceServer interface {
+	mustEmbedUnimplementedProjectServiceServer()
+}
+
+func RegisterProjectServiceServer(s grpc.ServiceRegistrar, srv ProjectServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProjectServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProjectService_ServiceDesc, srv)
+}
+
+func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Create(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Get(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Update(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Delete(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).List(ctx, req.(*ListProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).BatchCreate(ctx, req.(*BatchCreateProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProjectService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.ProjectService",
+	HandlerType: (*ProjectServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ProjectService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ProjectService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ProjectService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ProjectService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ProjectService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _ProjectService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	ProjectRoleService_Create_FullMethodName      = "/entpb.ProjectRoleService/Create"
+	ProjectRoleService_Get_FullMethodName         = "/entpb.ProjectRoleService/Get"
+	ProjectRoleService_Update_FullMethodName      = "/entpb.ProjectRoleService/Update"
+	ProjectRoleService_Delete_FullMethodName      = "/entpb.ProjectRoleService/Delete"
+	ProjectRoleService_List_FullMethodName        = "/entpb.ProjectRoleService/List"
+	ProjectRoleService_BatchCreate_FullMethodName = "/entpb.ProjectRoleService/BatchCreate"
+)
+
+// ProjectRoleServiceClient is the client API for ProjectRoleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectRoleServiceClient interface {
+	Create(ctx context.Context, in *CreateProjectRoleRequest, opts ...grpc.CallOption) (*ProjectRole, error)
+	Get(ctx context.Context, in *GetProjectRoleRequest, opts ...grpc.CallOption) (*ProjectRole, error)
+	Update(ctx context.Context, in *UpdateProjectRoleRequest, opts ...grpc.CallOption) (*ProjectRole, error)
+	Delete(ctx context.Context, in *DeleteProjectRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListProjectRoleRequest, opts ...grpc.CallOption) (*ListProjectRoleResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateProjectRolesRequest, opts ...grpc.CallOption) (*BatchCreateProjectRolesResponse, error)
+}
+
+type projectRoleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectRoleServiceClient(cc grpc.ClientConnInterface) ProjectRoleServiceClient {
+	return &projectRoleServiceClient{cc}
+}
+
+func (c *projectRoleServiceClient) Create(ctx context.Context, in *CreateProjectRoleRequest, opts ...grpc.CallOption) (*ProjectRole, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectRole)
+	err := c.cc.Invoke(ctx, ProjectRoleService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectRoleServiceClient) Get(ctx context.Context, in *GetProjectRoleRequest, opts ...grpc.CallOption) (*ProjectRole, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectRole)
+	err := c.cc.Invoke(ctx, ProjectRoleService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectRoleServiceClient) Update(ctx context.Context, in *UpdateProjectRoleRequest, opts ...grpc.CallOption) (*ProjectRole, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectRole)
+	err := c.cc.Invoke(ctx, ProjectRoleService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectRoleServiceClient) Delete(ctx context.Context, in *DeleteProjectRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProjectRoleService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectRoleServiceClient) List(ctx context.Context, in *ListProjectRoleRequest, opts ...grpc.CallOption) (*ListProjectRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectRoleResponse)
+	err := c.cc.Invoke(ctx, ProjectRoleService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectRoleServiceClient) BatchCreate(ctx context.Context, in *BatchCreateProjectRolesRequest, opts ...grpc.CallOption) (*BatchCreateProjectRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateProjectRolesResponse)
+	err := c.cc.Invoke(ctx, ProjectRoleService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectRoleServiceServer is the server API for ProjectRoleService service.
+// All implementations must embed UnimplementedProjectRoleServiceServer
+// for forward compatibility.
+type ProjectRoleServiceServer interface {
+	Create(context.Context, *CreateProjectRoleRequest) (*ProjectRole, error)
+	Get(context.Context, *GetProjectRoleRequest) (*ProjectRole, error)
+	Update(context.Context, *UpdateProjectRoleRequest) (*ProjectRole, error)
+	Delete(context.Context, *DeleteProjectRoleRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListProjectRoleRequest) (*ListProjectRoleResponse, error)
+	BatchCreate(context.Context, *BatchCreateProjectRolesRequest) (*BatchCreateProjectRolesResponse, error)
+	mustEmbedUnimplementedProjectRoleServiceServer()
+}
+
+// UnimplementedProjectRoleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProjectRoleServiceServer struct{}
+
+func (UnimplementedProjectRoleServiceServer) Create(context.Context, *CreateProjectRoleRequest) (*ProjectRole, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedProjectRoleServiceServer) Get(context.Context, *GetProjectRoleRequest) (*ProjectRole, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedProjectRoleServiceServer) Update(context.Context, *UpdateProjectRoleRequest) (*ProjectRole, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedProjectRoleServiceServer) Delete(context.Context, *DeleteProjectRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedProjectRoleServiceServer) List(context.Context, *ListProjectRoleRequest) (*ListProjectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedProjectRoleServiceServer) BatchCreate(context.Context, *BatchCreateProjectRolesRequest) (*BatchCreateProjectRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedProjectRoleServiceServer) mustEmbedUnimplementedProjectRoleServiceServer() {}
+func (UnimplementedProjectRoleServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeProjectRoleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectRoleServiceServer will
+// result in compilation errors.
+type UnsafeProjectRoleServiceServer interface {
+	mustEmbedUnimplementedProjectRoleServiceServer()
+}
+
+func RegisterProjectRoleServiceServer(s grpc.ServiceRegistrar, srv ProjectRoleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProjectRoleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProjectRoleService_ServiceDesc, srv)
+}
+
+func _ProjectRoleService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectRoleServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectRoleService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectRoleServiceServer).Create(ctx, req.(*CreateProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectRoleService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectRoleServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectRoleService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectRoleServiceServer).Get(ctx, req.(*GetProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectRoleService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectRoleServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectRoleService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectRoleServiceServer).Update(ctx, req.(*UpdateProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectRoleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectRoleServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectRoleService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectRoleServiceServer).Delete(ctx, req.(*DeleteProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectRoleService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectRoleServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectRoleService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectRoleServiceServer).List(ctx, req.(*ListProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectRoleService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateProjectRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectRoleServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectRoleService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectRoleServiceServer).BatchCreate(ctx, req.(*BatchCreateProjectRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProjectRoleService_ServiceDesc is the grpc.ServiceDesc for ProjectRoleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProjectRoleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.ProjectRoleService",
+	HandlerType: (*ProjectRoleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ProjectRoleService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ProjectRoleService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ProjectRoleService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ProjectRoleService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ProjectRoleService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _ProjectRoleService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	ReminderSettingService_Create_FullMethodName      = "/entpb.ReminderSettingService/Create"
+	ReminderSettingService_Get_FullMethodName         = "/entpb.ReminderSettingService/Get"
+	ReminderSettingService_Update_FullMethodName      = "/entpb.ReminderSettingService/Update"
+	ReminderSettingService_Delete_FullMethodName      = "/entpb.ReminderSettingService/Delete"
+	ReminderSettingService_List_FullMethodName        = "/entpb.ReminderSettingService/List"
+	ReminderSettingService_BatchCreate_FullMethodName = "/entpb.ReminderSettingService/BatchCreate"
+)
+
+// ReminderSettingServiceClient is the client API for ReminderSettingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReminderSettingServiceClient interface {
+	Create(ctx context.Context, in *CreateReminderSettingRequest, opts ...grpc.CallOption) (*ReminderSetting, error)
+	Get(ctx context.Context, in *GetReminderSettingRequest, opts ...grpc.CallOption) (*ReminderSetting, error)
+	Update(ctx context.Context, in *UpdateReminderSettingRequest, opts ...grpc.CallOption) (*ReminderSetting, error)
+	Delete(ctx context.Context, in *DeleteReminderSettingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListReminderSettingRequest, opts ...grpc.CallOption) (*ListReminderSettingResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateReminderSettingsRequest, opts ...grpc.CallOption) (*BatchCreateReminderSettingsResponse, error)
+}
+
+type reminderSettingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReminderSettingServiceClient(cc grpc.ClientConnInterface) ReminderSettingServiceClient {
+	return &reminderSettingServiceClient{cc}
+}
+
+func (c *reminderSettingServiceClient) Create(ctx context.Context, in *CreateReminderSettingRequest, opts ...grpc.CallOption) (*ReminderSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReminderSetting)
+	err := c.cc.Invoke(ctx, ReminderSettingService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderSettingServiceClient) Get(ctx context.Context, in *GetReminderSettingRequest, opts ...grpc.CallOption) (*ReminderSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReminderSetting)
+	err := c.cc.Invoke(ctx, ReminderSettingService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderSettingServiceClient) Update(ctx context.Context, in *UpdateReminderSettingRequest, opts ...grpc.CallOption) (*ReminderSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReminderSetting)
+	err := c.cc.Invoke(ctx, ReminderSettingService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderSettingServiceClient) Delete(ctx context.Context, in *DeleteReminderSettingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReminderSettingService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderSettingServiceClient) List(ctx context.Context, in *ListReminderSettingRequest, opts ...grpc.CallOption) (*ListReminderSettingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReminderSettingResponse)
+	err := c.cc.Invoke(ctx, ReminderSettingService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderSettingServiceClient) BatchCreate(ctx context.Context, in *BatchCreateReminderSettingsRequest, opts ...grpc.CallOption) (*BatchCreateReminderSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateReminderSettingsResponse)
+	err := c.cc.Invoke(ctx, ReminderSettingService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReminderSettingServiceServer is the server API for ReminderSettingService service.
+// All implementations must embed UnimplementedReminderSettingServiceServer
+// for forward compatibility.
+type ReminderSettingServiceServer interface {
+	Create(context.Context, *CreateReminderSettingRequest) (*ReminderSetting, error)
+	Get(context.Context, *GetReminderSettingRequest) (*ReminderSetting, error)
+	Update(context.Context, *UpdateReminderSettingRequest) (*ReminderSetting, error)
+	Delete(context.Context, *DeleteReminderSettingRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListReminderSettingRequest) (*ListReminderSettingResponse, error)
+	BatchCreate(context.Context, *BatchCreateReminderSettingsRequest) (*BatchCreateReminderSettingsResponse, error)
+	mustEmbedUnimplementedReminderSettingServiceServer()
+}
+
+// UnimplementedReminderSettingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReminderSettingServiceServer struct{}
+
+func (UnimplementedReminderSettingServiceServer) Create(context.Context, *CreateReminderSettingRequest) (*ReminderSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedReminderSettingServiceServer) Get(context.Context, *GetReminderSettingRequest) (*ReminderSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedReminderSettingServiceServer) Update(context.Context, *UpdateReminderSettingRequest) (*ReminderSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedReminderSettingServiceServer) Delete(context.Context, *DeleteReminderSettingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedReminderSettingServiceServer) List(context.Context, *ListReminderSettingRequest) (*ListReminderSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedReminderSettingServiceServer) BatchCreate(context.Context, *BatchCreateReminderSettingsRequest) (*BatchCreateReminderSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedReminderSettingServiceServer) mustEmbedUnimplementedReminderSettingServiceServer() {
+}
+func (UnimplementedReminderSettingServiceServer) testEmbeddedByValue() {}
+
+// UnsafeReminderSettingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReminderSettingServiceServer will
+// result in compilation errors.
+type UnsafeReminderSettingServiceServer interface {
+	mustEmbedUnimplementedReminderSettingServiceServer()
+}
+
+func RegisterReminderSettingServiceServer(s grpc.ServiceRegistrar, srv ReminderSettingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReminderSettingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReminderSettingService_ServiceDesc, srv)
+}
+
+func _ReminderSettingService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReminderSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderSettingServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderSettingService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderSettingServiceServer).Create(ctx, req.(*CreateReminderSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderSettingService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReminderSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderSettingServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderSettingService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderSettingServiceServer).Get(ctx, req.(*GetReminderSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderSettingService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReminderSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderSettingServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderSettingService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderSettingServiceServer).Update(ctx, req.(*UpdateReminderSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderSettingService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReminderSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderSettingServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderSettingService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderSettingServiceServer).Delete(ctx, req.(*DeleteReminderSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderSettingService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReminderSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderSettingServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderSettingService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderSettingServiceServer).List(ctx, req.(*ListReminderSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderSettingService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateReminderSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderSettingServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderSettingService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderSettingServiceServer).BatchCreate(ctx, req.(*BatchCreateReminderSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReminderSettingService_ServiceDesc is the grpc.ServiceDesc for ReminderSettingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReminderSettingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.ReminderSettingService",
+	HandlerType: (*ReminderSettingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ReminderSettingService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ReminderSettingService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ReminderSettingService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ReminderSettingService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ReminderSettingService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _ReminderSettingService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	RosterService_Create_FullMethodName      = "/entpb.RosterService/Create"
+	RosterService_Get_FullMethodName         = "/entpb.RosterService/Get"
+	RosterService_Update_FullMethodName      = "/entpb.RosterService/Update"
+	RosterService_Delete_FullMethodName      = "/entpb.RosterService/Delete"
+	RosterService_List_FullMethodName        = "/entpb.RosterService/List"
+	RosterService_BatchCreate_FullMethodName = "/entpb.RosterService/BatchCreate"
+)
+
+// RosterServiceClient is the client API for RosterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RosterServiceClient interface {
+	Create(ctx context.Context, in *CreateRosterRequest, opts ...grpc.CallOption) (*Roster, error)
+	Get(ctx context.Context, in *GetRosterRequest, opts ...grpc.CallOption) (*Roster, error)
+	Update(ctx context.Context, in *UpdateRosterRequest, opts ...grpc.CallOption) (*Roster, error)
+	Delete(ctx context.Context, in *DeleteRosterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListRosterRequest, opts ...grpc.CallOption) (*ListRosterResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateRostersRequest, opts ...grpc.CallOption) (*BatchCreateRostersResponse, error)
+}
+
+type rosterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRosterServiceClient(cc grpc.ClientConnInterface) RosterServiceClient {
+	return &rosterServiceClient{cc}
+}
+
+func (c *rosterServiceClient) Create(ctx context.Context, in *CreateRosterRequest, opts ...grpc.CallOption) (*Roster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Roster)
+	err := c.cc.Invoke(ctx, RosterService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterServiceClient) Get(ctx context.Context, in *GetRosterRequest, opts ...grpc.CallOption) (*Roster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Roster)
+	err := c.cc.Invoke(ctx, RosterService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterServiceClient) Update(ctx context.Context, in *UpdateRosterRequest, opts ...grpc.CallOption) (*Roster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Roster)
+	err := c.cc.Invoke(ctx, RosterService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterServiceClient) Delete(ctx context.Context, in *DeleteRosterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RosterService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterServiceClient) List(ctx context.Context, in *ListRosterRequest, opts ...grpc.CallOption) (*ListRosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRosterResponse)
+	err := c.cc.Invoke(ctx, RosterService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterServiceClient) BatchCreate(ctx context.Context, in *BatchCreateRostersRequest, opts ...grpc.CallOption) (*BatchCreateRostersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateRostersResponse)
+	err := c.cc.Invoke(ctx, RosterService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RosterServiceServer is the server API for RosterService service.
+// All implementations must embed UnimplementedRosterServiceServer
+// for forward compatibility.
+type RosterServiceServer interface {
+	Create(context.Context, *CreateRosterRequest) (*Roster, error)
+	Get(context.Context, *GetRosterRequest) (*Roster, error)
+	Update(context.Context, *UpdateRosterRequest) (*Roster, error)
+	Delete(context.Context, *DeleteRosterRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListRosterRequest) (*ListRosterResponse, error)
+	BatchCreate(context.Context, *BatchCreateRostersRequest) (*BatchCreateRostersResponse, error)
+	mustEmbedUnimplementedRosterServiceServer()
+}
+
+// UnimplementedRosterServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRosterServiceServer struct{}
+
+func (UnimplementedRosterServiceServer) Create(context.Context, *CreateRosterRequest) (*Roster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedRosterServiceServer) Get(context.Context, *GetRosterRequest) (*Roster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedRosterServiceServer) Update(context.Context, *UpdateRosterRequest) (*Roster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedRosterServiceServer) Delete(context.Context, *DeleteRosterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedRosterServiceServer) List(context.Context, *ListRosterRequest) (*ListRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedRosterServiceServer) BatchCreate(context.Context, *BatchCreateRostersRequest) (*BatchCreateRostersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedRosterServiceServer) mustEmbedUnimplementedRosterServiceServer() {}
+func (UnimplementedRosterServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRosterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RosterServiceServer will
+// result in compilation errors.
+type UnsafeRosterServiceServer interface {
+	mustEmbedUnimplementedRosterServiceServer()
+}
+
+func RegisterRosterServiceServer(s grpc.ServiceRegistrar, srv RosterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRosterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RosterService_ServiceDesc, srv)
+}
+
+func _RosterService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterServiceServer).Create(ctx, req.(*CreateRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterServiceServer).Get(ctx, req.(*GetRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterServiceServer).Update(ctx, req.(*UpdateRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterServiceServer).Delete(ctx, req.(*DeleteRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterServiceServer).List(ctx, req.(*ListRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateRostersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterServiceServer).BatchCreate(ctx, req.(*BatchCreateRostersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RosterService_ServiceDesc is the grpc.ServiceDesc for RosterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RosterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.RosterService",
+	HandlerType: (*RosterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _RosterService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _RosterService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _RosterService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _RosterService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _RosterService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _RosterService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	SalaryGradeService_Create_FullMethodName      = "/entpb.SalaryGradeService/Create"
+	SalaryGradeService_Get_FullMethodName         = "/entpb.SalaryGradeService/Get"
+	SalaryGradeService_Update_FullMethodName      = "/entpb.SalaryGradeService/Update"
+	SalaryGradeService_Delete_FullMethodName      = "/entpb.SalaryGradeService/Delete"
+	SalaryGradeService_List_FullMethodName        = "/entpb.SalaryGradeService/List"
+	SalaryGradeService_BatchCreate_FullMethodName = "/entpb.SalaryGradeService/BatchCreate"
+)
+
+// SalaryGradeServiceClient is the client API for SalaryGradeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SalaryGradeServiceClient interface {
+	Create(ctx context.Context, in *CreateSalaryGradeRequest, opts ...grpc.CallOption) (*SalaryGrade, error)
+	Get(ctx context.Context, in *GetSalaryGradeRequest, opts ...grpc.CallOption) (*SalaryGrade, error)
+	Update(ctx context.Context, in *UpdateSalaryGradeRequest, opts ...grpc.CallOption) (*SalaryGrade, error)
+	Delete(ctx context.Context, in *DeleteSalaryGradeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListSalaryGradeRequest, opts ...grpc.CallOption) (*ListSalaryGradeResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateSalaryGradesRequest, opts ...grpc.CallOption) (*BatchCreateSalaryGradesResponse, error)
+}
+
+type salaryGradeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSalaryGradeServiceClient(cc grpc.ClientConnInterface) SalaryGradeServiceClient {
+	return &salaryGradeServiceClient{cc}
+}
+
+func (c *salaryGradeServiceClient) Create(ctx context.Context, in *CreateSalaryGradeRequest, opts ...grpc.CallOption) (*SalaryGrade, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SalaryGrade)
+	err := c.cc.Invoke(ctx, SalaryGradeService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *salaryGradeServiceClient) Get(ctx context.Context, in *GetSalaryGradeRequest, opts ...grpc.CallOption) (*SalaryGrade, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SalaryGrade)
+	err := c.cc.Invoke(ctx, SalaryGradeService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *salaryGradeServiceClient) Update(ctx context.Context, in *UpdateSalaryGradeRequest, opts ...grpc.CallOption) (*SalaryGrade, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SalaryGrade)
+	err := c.cc.Invoke(ctx, SalaryGradeService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *salaryGradeServiceClient) Delete(ctx context.Context, in *DeleteSalaryGradeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SalaryGradeService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *salaryGradeServiceClient) List(ctx context.Context, in *ListSalaryGradeRequest, opts ...grpc.CallOption) (*ListSalaryGradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSalaryGradeResponse)
+	err := c.cc.Invoke(ctx, SalaryGradeService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *salaryGradeServiceClient) BatchCreate(ctx context.Context, in *BatchCreateSalaryGradesRequest, opts ...grpc.CallOption) (*BatchCreateSalaryGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateSalaryGradesResponse)
+	err := c.cc.Invoke(ctx, SalaryGradeService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SalaryGradeServiceServer is the server API for SalaryGradeService service.
+// All implementations must embed UnimplementedSalaryGradeServiceServer
+// for forward compatibility.
+type SalaryGradeServiceServer interface {
+	Create(context.Context, *CreateSalaryGradeRequest) (*SalaryGrade, error)
+	Get(context.Context, *GetSalaryGradeRequest) (*SalaryGrade, error)
+	Update(context.Context, *UpdateSalaryGradeRequest) (*SalaryGrade, error)
+	Delete(context.Context, *DeleteSalaryGradeRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListSalaryGradeRequest) (*ListSalaryGradeResponse, error)
+	BatchCreate(context.Context, *BatchCreateSalaryGradesRequest) (*BatchCreateSalaryGradesResponse, error)
+	mustEmbedUnimplementedSalaryGradeServiceServer()
+}
+
+// UnimplementedSalaryGradeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSalaryGradeServiceServer struct{}
+
+func (UnimplementedSalaryGradeServiceServer) Create(context.Context, *CreateSalaryGradeRequest) (*SalaryGrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedSalaryGradeServiceServer) Get(context.Context, *GetSalaryGradeRequest) (*SalaryGrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedSalaryGradeServiceServer) Update(context.Context, *UpdateSalaryGradeRequest) (*SalaryGrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedSalaryGradeServiceServer) Delete(context.Context, *DeleteSalaryGradeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedSalaryGradeServiceServer) List(context.Context, *ListSalaryGradeRequest) (*ListSalaryGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSalaryGradeServiceServer) BatchCreate(context.Context, *BatchCreateSalaryGradesRequest) (*BatchCreateSalaryGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedSalaryGradeServiceServer) mustEmbedUnimplementedSalaryGradeServiceServer() {}
+func (UnimplementedSalaryGradeServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeSalaryGradeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SalaryGradeServiceServer will
+// result in compilation errors.
+type UnsafeSalaryGradeServiceServer interface {
+	mustEmbedUnimplementedSalaryGradeServiceServer()
+}
+
+func RegisterSalaryGradeServiceServer(s grpc.ServiceRegistrar, srv SalaryGradeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSalaryGradeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SalaryGradeService_ServiceDesc, srv)
+}
+
+func _SalaryGradeService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSalaryGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryGradeServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryGradeService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryGradeServiceServer).Create(ctx, req.(*CreateSalaryGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SalaryGradeService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalaryGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryGradeServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryGradeService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryGradeServiceServer).Get(ctx, req.(*GetSalaryGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SalaryGradeService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSalaryGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryGradeServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryGradeService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryGradeServiceServer).Update(ctx, req.(*UpdateSalaryGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SalaryGradeService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSalaryGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryGradeServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryGradeService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryGradeServiceServer).Delete(ctx, req.(*DeleteSalaryGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SalaryGradeService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSalaryGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryGradeServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryGradeService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryGradeServiceServer).List(ctx, req.(*ListSalaryGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SalaryGradeService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateSalaryGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SalaryGradeServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SalaryGradeService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SalaryGradeServiceServer).BatchCreate(ctx, req.(*BatchCreateSalaryGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SalaryGradeService_ServiceDesc is the grpc.ServiceDesc for SalaryGradeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SalaryGradeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.SalaryGradeService",
+	HandlerType: (*SalaryGradeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _SalaryGradeService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _SalaryGradeService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _SalaryGradeService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _SalaryGradeService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _SalaryGradeService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _SalaryGradeService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	ShiftService_Create_FullMethodName      = "/entpb.ShiftService/Create"
+	ShiftService_Get_FullMethodName         = "/entpb.ShiftService/Get"
+	ShiftService_Update_FullMethodName      = "/entpb.ShiftService/Update"
+	ShiftService_Delete_FullMethodName      = "/entpb.ShiftService/Delete"
+	ShiftService_List_FullMethodName        = "/entpb.ShiftService/List"
+	ShiftService_BatchCreate_FullMethodName = "/entpb.ShiftService/BatchCreate"
+)
+
+// ShiftServiceClient is the client API for ShiftService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShiftServiceClient interface {
+	Create(ctx context.Context, in *CreateShiftRequest, opts ...grpc.CallOption) (*Shift, error)
+	Get(ctx context.Context, in *GetShiftRequest, opts ...grpc.CallOption) (*Shift, error)
+	Update(ctx context.Context, in *UpdateShiftRequest, opts ...grpc.CallOption) (*Shift, error)
+	Delete(ctx context.Context, in *DeleteShiftRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListShiftRequest, opts ...grpc.CallOption) (*ListShiftResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateShiftsRequest, opts ...grpc.CallOption) (*BatchCreateShiftsResponse, error)
+}
+
+type shiftServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShiftServiceClient(cc grpc.ClientConnInterface) ShiftServiceClient {
+	return &shiftServiceClient{cc}
+}
+
+func (c *shiftServiceClient) Create(ctx context.Context, in *CreateShiftRequest, opts ...grpc.CallOption) (*Shift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, ShiftService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) Get(ctx context.Context, in *GetShiftRequest, opts ...grpc.CallOption) (*Shift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, ShiftService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) Update(ctx context.Context, in *UpdateShiftRequest, opts ...grpc.CallOption) (*Shift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, ShiftService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) Delete(ctx context.Context, in *DeleteShiftRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShiftService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) List(ctx context.Context, in *ListShiftRequest, opts ...grpc.CallOption) (*ListShiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShiftResponse)
+	err := c.cc.Invoke(ctx, ShiftService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) BatchCreate(ctx context.Context, in *BatchCreateShiftsRequest, opts ...grpc.CallOption) (*BatchCreateShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateShiftsResponse)
+	err := c.cc.Invoke(ctx, ShiftService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShiftServiceServer is the server API for ShiftService service.
+// All implementations must embed UnimplementedShiftServiceServer
+// for forward compatibility.
+type ShiftServiceServer interface {
+	Create(context.Context, *CreateShiftRequest) (*Shift, error)
+	Get(context.Context, *GetShiftRequest) (*Shift, error)
+	Update(context.Context, *UpdateShiftRequest) (*Shift, error)
+	Delete(context.Context, *DeleteShiftRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListShiftRequest) (*ListShiftResponse, error)
+	BatchCreate(context.Context, *BatchCreateShiftsRequest) (*BatchCreateShiftsResponse, error)
+	mustEmbedUnimplementedShiftServiceServer()
+}
+
+// UnimplementedShiftServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShiftServiceServer struct{}
+
+func (UnimplementedShiftServiceServer) Create(context.Context, *CreateShiftRequest) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedShiftServiceServer) Get(context.Context, *GetShiftRequest) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedShiftServiceServer) Update(context.Context, *UpdateShiftRequest) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedShiftServiceServer) Delete(context.Context, *DeleteShiftRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedShiftServiceServer) List(context.Context, *ListShiftRequest) (*ListShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedShiftServiceServer) BatchCreate(context.Context, *BatchCreateShiftsRequest) (*BatchCreateShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedShiftServiceServer) mustEmbedUnimplementedShiftServiceServer() {}
+func (UnimplementedShiftServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeShiftServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShiftServiceServer will
+// result in compilation errors.
+type UnsafeShiftServiceServer interface {
+	mustEmbedUnimplementedShiftServiceServer()
+}
+
+func RegisterShiftServiceServer(s grpc.ServiceRegistrar, srv ShiftServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShiftServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShiftService_ServiceDesc, srv)
+}
+
+func _ShiftService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).Create(ctx, req.(*CreateShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).Get(ctx, req.(*GetShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).Update(ctx, req.(*UpdateShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).Delete(ctx, req.(*DeleteShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).List(ctx, req.(*ListShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).BatchCreate(ctx, req.(*BatchCreateShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShiftService_ServiceDesc is the grpc.ServiceDesc for ShiftService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShiftService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.ShiftService",
+	HandlerType: (*ShiftServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ShiftService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ShiftService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ShiftService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ShiftService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ShiftService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _ShiftService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	ShiftSwapRequestService_Create_FullMethodName      = "/entpb.ShiftSwapRequestService/Create"
+	ShiftSwapRequestService_Get_FullMethodName         = "/entpb.ShiftSwapRequestService/Get"
+	ShiftSwapRequestService_Update_FullMethodName      = "/entpb.ShiftSwapRequestService/Update"
+	ShiftSwapRequestService_Delete_FullMethodName      = "/entpb.ShiftSwapRequestService/Delete"
+	ShiftSwapRequestService_List_FullMethodName        = "/entpb.ShiftSwapRequestService/List"
+	ShiftSwapRequestService_BatchCreate_FullMethodName = "/entpb.ShiftSwapRequestService/BatchCreate"
+)
+
+// ShiftSwapRequestServiceClient is the client API for ShiftSwapRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShiftSwapRequestServiceClient interface {
+	Create(ctx context.Context, in *CreateShiftSwapRequestRequest, opts ...grpc.CallOption) (*ShiftSwapRequest, error)
+	Get(ctx context.Context, in *GetShiftSwapRequestRequest, opts ...grpc.CallOption) (*ShiftSwapRequest, error)
+	Update(ctx context.Context, in *UpdateShiftSwapRequestRequest, opts ...grpc.CallOption) (*ShiftSwapRequest, error)
+	Delete(ctx context.Context, in *DeleteShiftSwapRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListShiftSwapRequestRequest, opts ...grpc.CallOption) (*ListShiftSwapRequestResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateShiftSwapRequestsRequest, opts ...grpc.CallOption) (*BatchCreateShiftSwapRequestsResponse, error)
+}
+
+type shiftSwapRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShiftSwapRequestServiceClient(cc grpc.ClientConnInterface) ShiftSwapRequestServiceClient {
+	return &shiftSwapRequestServiceClient{cc}
+}
+
+func (c *shiftSwapRequestServiceClient) Create(ctx context.Context, in *CreateShiftSwapRequestRequest, opts ...grpc.CallOption) (*ShiftSwapRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftSwapRequest)
+	err := c.cc.Invoke(ctx, ShiftSwapRequestService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapRequestServiceClient) Get(ctx context.Context, in *GetShiftSwapRequestRequest, opts ...grpc.CallOption) (*ShiftSwapRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftSwapRequest)
+	err := c.cc.Invoke(ctx, ShiftSwapRequestService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapRequestServiceClient) Update(ctx context.Context, in *UpdateShiftSwapRequestRequest, opts ...grpc.CallOption) (*ShiftSwapRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftSwapRequest)
+	err := c.cc.Invoke(ctx, ShiftSwapRequestService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapRequestServiceClient) Delete(ctx context.Context, in *DeleteShiftSwapRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShiftSwapRequestService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapRequestServiceClient) List(ctx context.Context, in *ListShiftSwapRequestRequest, opts ...grpc.CallOption) (*ListShiftSwapRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShiftSwapRequestResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapRequestService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapRequestServiceClient) BatchCreate(ctx context.Context, in *BatchCreateShiftSwapRequestsRequest, opts ...grpc.CallOption) (*BatchCreateShiftSwapRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateShiftSwapRequestsResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapRequestService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShiftSwapRequestServiceServer is the server API for ShiftSwapRequestService service.
+// All implementations must embed UnimplementedShiftSwapRequestServiceServer
+// for forward compatibility.
+type ShiftSwapRequestServiceServer interface {
+	Create(context.Context, *CreateShiftSwapRequestRequest) (*ShiftSwapRequest, error)
+	Get(context.Context, *GetShiftSwapRequestRequest) (*ShiftSwapRequest, error)
+	Update(context.Context, *UpdateShiftSwapRequestRequest) (*ShiftSwapRequest, error)
+	Delete(context.Context, *DeleteShiftSwapRequestRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListShiftSwapRequestRequest) (*ListShiftSwapRequestResponse, error)
+	BatchCreate(context.Context, *BatchCreateShiftSwapRequestsRequest) (*BatchCreateShiftSwapRequestsResponse, error)
+	mustEmbedUnimplementedShiftSwapRequestServiceServer()
+}
+
+// UnimplementedShiftSwapRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShiftSwapRequestServiceServer struct{}
+
+func (UnimplementedShiftSwapRequestServiceServer) Create(context.Context, *CreateShiftSwapRequestRequest) (*ShiftSwapRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedShiftSwapRequestServiceServer) Get(context.Context, *GetShiftSwapRequestRequest) (*ShiftSwapRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedShiftSwapRequestServiceServer) Update(context.Context, *UpdateShiftSwapRequestRequest) (*ShiftSwapRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedShiftSwapRequestServiceServer) Delete(context.Context, *DeleteShiftSwapRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedShiftSwapRequestServiceServer) List(context.Context, *ListShiftSwapRequestRequest) (*ListShiftSwapRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedShiftSwapRequestServiceServer) BatchCreate(context.Context, *BatchCreateShiftSwapRequestsRequest) (*BatchCreateShiftSwapRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedShiftSwapRequestServiceServer) mustEmbedUnimplementedShiftSwapRequestServiceServer() {
+}
+func (UnimplementedShiftSwapRequestServiceServer) testEmbeddedByValue() {}
+
+// UnsafeShiftSwapRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShiftSwapRequestServiceServer will
+// result in compilation errors.
+type UnsafeShiftSwapRequestServiceServer interface {
+	mustEmbedUnimplementedShiftSwapRequestServiceServer()
+}
+
+func RegisterShiftSwapRequestServiceServer(s grpc.ServiceRegistrar, srv ShiftSwapRequestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShiftSwapRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShiftSwapRequestService_ServiceDesc, srv)
+}
+
+func _ShiftSwapRequestService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShiftSwapRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapRequestServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapRequestService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapRequestServiceServer).Create(ctx, req.(*CreateShiftSwapRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapRequestService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShiftSwapRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapRequestServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapRequestService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapRequestServiceServer).Get(ctx, req.(*GetShiftSwapRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapRequestService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShiftSwapRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapRequestServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapRequestService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapRequestServiceServer).Update(ctx, req.(*UpdateShiftSwapRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapRequestService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShiftSwapRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapRequestServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapRequestService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapRequestServiceServer).Delete(ctx, req.(*DeleteShiftSwapRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapRequestService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShiftSwapRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapRequestServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapRequestService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapRequestServiceServer).List(ctx, req.(*ListShiftSwapRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapRequestService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateShiftSwapRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapRequestServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapRequestService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapRequestServiceServer).BatchCreate(ctx, req.(*BatchCreateShiftSwapRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShiftSwapRequestService_ServiceDesc is the grpc.ServiceDesc for ShiftSwapRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShiftSwapRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.ShiftSwapRequestService",
+	HandlerType: (*ShiftSwapRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ShiftSwapRequestService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ShiftSwapRequestService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ShiftSwapRequestService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ShiftSwapRequestService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ShiftSwapRequestService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _ShiftSwapRequestService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	SprintService_Create_FullMethodName      = "/entpb.SprintService/Create"
+	SprintService_Get_FullMethodName         = "/entpb.SprintService/Get"
+	SprintService_Update_FullMethodName      = "/entpb.SprintService/Update"
+	SprintService_Delete_FullMethodName      = "/entpb.SprintService/Delete"
+	SprintService_List_FullMethodName        = "/entpb.SprintService/List"
+	SprintService_BatchCreate_FullMethodName = "/entpb.SprintService/BatchCreate"
+)
+
+// SprintServiceClient is the client API for SprintService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SprintServiceClient interface {
+	Create(ctx context.Context, in *CreateSprintRequest, opts ...grpc.CallOption) (*Sprint, error)
+	Get(ctx context.Context, in *GetSprintRequest, opts ...grpc.CallOption) (*Sprint, error)
+	Update(ctx context.Context, in *UpdateSprintRequest, opts ...grpc.CallOption) (*Sprint, error)
+	Delete(ctx context.Context, in *DeleteSprintRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListSprintRequest, opts ...grpc.CallOption) (*ListSprintResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateSprintsRequest, opts ...grpc.CallOption) (*BatchCreateSprintsResponse, error)
+}
+
+type sprintServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSprintServiceClient(cc grpc.ClientConnInterface) SprintServiceClient {
+	return &sprintServiceClient{cc}
+}
+
+func (c *sprintServiceClient) Create(ctx context.Context, in *CreateSprintRequest, opts ...grpc.CallOption) (*Sprint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sprint)
+	err := c.cc.Invoke(ctx, SprintService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) Get(ctx context.Context, in *GetSprintRequest, opts ...grpc.CallOption) (*Sprint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sprint)
+	err := c.cc.Invoke(ctx, SprintService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) Update(ctx context.Context, in *UpdateSprintRequest, opts ...grpc.CallOption) (*Sprint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sprint)
+	err := c.cc.Invoke(ctx, SprintService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) Delete(ctx context.Context, in *DeleteSprintRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SprintService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) List(ctx context.Context, in *ListSprintRequest, opts ...grpc.CallOption) (*ListSprintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSprintResponse)
+	err := c.cc.Invoke(ctx, SprintService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sprintServiceClient) BatchCreate(ctx context.Context, in *BatchCreateSprintsRequest, opts ...grpc.CallOption) (*BatchCreateSprintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateSprintsResponse)
+	err := c.cc.Invoke(ctx, SprintService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SprintServiceServer is the server API for SprintService service.
+// All implementations must embed UnimplementedSprintServiceServer
+// for forward compatibility.
+type SprintServiceServer interface {
+	Create(context.Context, *CreateSprintRequest) (*Sprint, error)
+	Get(context.Context, *GetSprintRequest) (*Sprint, error)
+	Update(context.Context, *UpdateSprintRequest) (*Sprint, error)
+	Delete(context.Context, *DeleteSprintRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListSprintRequest) (*ListSprintResponse, error)
+	BatchCreate(context.Context, *BatchCreateSprintsRequest) (*BatchCreateSprintsResponse, error)
+	mustEmbedUnimplementedSprintServiceServer()
+}
+
+// UnimplementedSprintServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSprintServiceServer struct{}
+
+func (UnimplementedSprintServiceServer) Create(context.Context, *CreateSprintRequest) (*Sprint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedSprintServiceServer) Get(context.Context, *GetSprintRequest) (*Sprint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedSprintServiceServer) Update(context.Context, *UpdateSprintRequest) (*Sprint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedSprintServiceServer) Delete(context.Context, *DeleteSprintRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedSprintServiceServer) List(context.Context, *ListSprintRequest) (*ListSprintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSprintServiceServer) BatchCreate(context.Context, *BatchCreateSprintsRequest) (*BatchCreateSprintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedSprintServiceServer) mustEmbedUnimplementedSprintServiceServer() {}
+func (UnimplementedSprintServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSprintServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SprintServiceServer will
+// result in compilation errors.
+type UnsafeSprintServiceServer interface {
+	mustEmbedUnimplementedSprintServiceServer()
+}
+
+func RegisterSprintServiceServer(s grpc.ServiceRegistrar, srv SprintServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSprintServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SprintService_ServiceDesc, srv)
+}
+
+func _SprintService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).Create(ctx, req.(*CreateSprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).Get(ctx, req.(*GetSprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).Update(ctx, req.(*UpdateSprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).Delete(ctx, req.(*DeleteSprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).List(ctx, req.(*ListSprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SprintService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateSprintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SprintServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SprintService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SprintServiceServer).BatchCreate(ctx, req.(*BatchCreateSprintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SprintService_ServiceDesc is the grpc.ServiceDesc for SprintService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SprintService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.SprintService",
+	HandlerType: (*SprintServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _SprintService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _SprintService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _SprintService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _SprintService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _SprintService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _SprintService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	TaskService_Create_FullMethodName      = "/entpb.TaskService/Create"
+	TaskService_Get_FullMethodName         = "/entpb.TaskService/Get"
+	TaskService_Update_FullMethodName      = "/entpb.TaskService/Update"
+	TaskService_Delete_FullMethodName      = "/entpb.TaskService/Delete"
+	TaskService_List_FullMethodName        = "/entpb.TaskService/List"
+	TaskService_BatchCreate_FullMethodName = "/entpb.TaskService/BatchCreate"
+)
+
+// TaskServiceClient is the client API for TaskService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*Task, error)
+	Get(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*Task, error)
+	Update(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*Task, error)
+	Delete(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskRequest, opts ...grpc.CallOption) (*ListTaskResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error)
+}
+
+type taskServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskServiceClient(cc grpc.ClientConnInterface) TaskServiceClient {
+	return &taskServiceClient{cc}
+}
+
+func (c *taskServiceClient) Create(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, TaskService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) Get(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, TaskService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) Update(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, TaskService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) Delete(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) List(ctx context.Context, in *ListTaskRequest, opts ...grpc.CallOption) (*ListTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskServiceServer is the server API for TaskService service.
+// All implementations must embed UnimplementedTaskServiceServer
+// for forward compatibility.
+type TaskServiceServer interface {
+	Create(context.Context, *CreateTaskRequest) (*Task, error)
+	Get(context.Context, *GetTaskRequest) (*Task, error)
+	Update(context.Context, *UpdateTaskRequest) (*Task, error)
+	Delete(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskRequest) (*ListTaskResponse, error)
+	BatchCreate(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error)
+	mustEmbedUnimplementedTaskServiceServer()
+}
+
+// UnimplementedTaskServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskServiceServer struct{}
+
+func (UnimplementedTaskServiceServer) Create(context.Context, *CreateTaskRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaskServiceServer) Get(context.Context, *GetTaskRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskServiceServer) Update(context.Context, *UpdateTaskRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskServiceServer) Delete(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaskServiceServer) List(context.Context, *ListTaskRequest) (*ListTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchCreate(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
+func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskServiceServer will
+// result in compilation errors.
+type UnsafeTaskServiceServer interface {
+	mustEmbedUnimplementedTaskServiceServer()
+}
+
+func RegisterTaskServiceServer(s grpc.ServiceRegistrar, srv TaskServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskService_ServiceDesc, srv)
+}
+
+func _TaskService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).Create(ctx, req.(*CreateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).Get(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).Update(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).Delete(ctx, req.(*DeleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).List(ctx, req.(*ListTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchCreate(ctx, req.(*BatchCreateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskService",
+	HandlerType: (*TaskServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TaskService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TaskService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaskService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TaskService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TaskService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _TaskService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	TaskActivityService_Create_FullMethodName      = "/entpb.TaskActivityService/Create"
+	TaskActivityService_Get_FullMethodName         = "/entpb.TaskActivityService/Get"
+	TaskActivityService_Update_FullMethodName      = "/entpb.TaskActivityService/Update"
+	TaskActivityService_Delete_FullMethodName      = "/entpb.TaskActivityService/Delete"
+	TaskActivityService_List_FullMethodName        = "/entpb.TaskActivityService/List"
+	TaskActivityService_BatchCreate_FullMethodName = "/entpb.TaskActivityService/BatchCreate"
+)
+
+// TaskActivityServiceClient is the client API for TaskActivityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskActivityServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskActivityRequest, opts ...grpc.CallOption) (*TaskActivity, error)
+	Get(ctx context.Context, in *GetTaskActivityRequest, opts ...grpc.CallOption) (*TaskActivity, error)
+	Update(ctx context.Context, in *UpdateTaskActivityRequest, opts ...grpc.CallOption) (*TaskActivity, error)
+	Delete(ctx context.Context, in *DeleteTaskActivityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskActivityRequest, opts ...grpc.CallOption) (*ListTaskActivityResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskActivitiesRequest, opts ...grpc.CallOption) (*BatchCreateTaskActivitiesResponse, error)
+}
+
+type taskActivityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskActivityServiceClient(cc grpc.ClientConnInterface) TaskActivityServiceClient {
+	return &taskActivityServiceClient{cc}
+}
+
+func (c *taskActivityServiceClient) Create(ctx context.Context, in *CreateTaskActivityRequest, opts ...grpc.CallOption) (*TaskActivity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskActivity)
+	err := c.cc.Invoke(ctx, TaskActivityService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskActivityServiceClient) Get(ctx context.Context, in *GetTaskActivityRequest, opts ...grpc.CallOption) (*TaskActivity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskActivity)
+	err := c.cc.Invoke(ctx, TaskActivityService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskActivityServiceClient) Update(ctx context.Context, in *UpdateTaskActivityRequest, opts ...grpc.CallOption) (*TaskActivity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskActivity)
+	err := c.cc.Invoke(ctx, TaskActivityService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskActivityServiceClient) Delete(ctx context.Context, in *DeleteTaskActivityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskActivityService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskActivityServiceClient) List(ctx context.Context, in *ListTaskActivityRequest, opts ...grpc.CallOption) (*ListTaskActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskActivityResponse)
+	err := c.cc.Invoke(ctx, TaskActivityService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskActivityServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskActivitiesRequest, opts ...grpc.CallOption) (*BatchCreateTaskActivitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTaskActivitiesResponse)
+	err := c.cc.Invoke(ctx, TaskActivityService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskActivityServiceServer is the server API for TaskActivityService service.
+// All implementations must embed UnimplementedTaskActivityServiceServer
+// for forward compatibility.
+type TaskActivityServiceServer interface {
+	Create(context.Context, *CreateTaskActivityRequest) (*TaskActivity, error)
+	Get(context.Context, *GetTaskActivityRequest) (*TaskActivity, error)
+	Update(context.Context, *UpdateTaskActivityRequest) (*TaskActivity, error)
+	Delete(context.Context, *DeleteTaskActivityRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskActivityRequest) (*ListTaskActivityResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskActivitiesRequest) (*BatchCreateTaskActivitiesResponse, error)
+	mustEmbedUnimplementedTaskActivityServiceServer()
+}
+
+// UnimplementedTaskActivityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskActivityServiceServer struct{}
+
+func (UnimplementedTaskActivityServiceServer) Create(context.Context, *CreateTaskActivityRequest) (*TaskActivity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaskActivityServiceServer) Get(context.Context, *GetTaskActivityRequest) (*TaskActivity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskActivityServiceServer) Update(context.Context, *UpdateTaskActivityRequest) (*TaskActivity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskActivityServiceServer) Delete(context.Context, *DeleteTaskActivityRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaskActivityServiceServer) List(context.Context, *ListTaskActivityRequest) (*ListTaskActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskActivityServiceServer) BatchCreate(context.Context, *BatchCreateTaskActivitiesRequest) (*BatchCreateTaskActivitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTaskActivityServiceServer) mustEmbedUnimplementedTaskActivityServiceServer() {}
+func (UnimplementedTaskActivityServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeTaskActivityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskActivityServiceServer will
+// result in compilation errors.
+type UnsafeTaskActivityServiceServer interface {
+	mustEmbedUnimplementedTaskActivityServiceServer()
+}
+
+func RegisterTaskActivityServiceServer(s grpc.ServiceRegistrar, srv TaskActivityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskActivityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskActivityService_ServiceDesc, srv)
+}
+
+func _TaskActivityService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskActivityServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskActivityService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskActivityServiceServer).Create(ctx, req.(*CreateTaskActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskActivityService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskActivityServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskActivityService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskActivityServiceServer).Get(ctx, req.(*GetTaskActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskActivityService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskActivityServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskActivityService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskActivityServiceServer).Update(ctx, req.(*UpdateTaskActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskActivityService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskActivityServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskActivityService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskActivityServiceServer).Delete(ctx, req.(*DeleteTaskActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskActivityService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskActivityServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskActivityService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskActivityServiceServer).List(ctx, req.(*ListTaskActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskActivityService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskActivitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskActivityServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskActivityService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskActivityServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskActivitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskActivityService_ServiceDesc is the grpc.ServiceDesc for TaskActivityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskActivityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskActivityService",
+	HandlerType: (*TaskActivityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TaskActivityService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TaskActivityService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaskActivityService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TaskActivityService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TaskActivityService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _TaskActivityService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	TaskCommentService_Create_FullMethodName      = "/entpb.TaskCommentService/Create"
+	TaskCommentService_Get_FullMethodName         = "/entpb.TaskCommentService/Get"
+	TaskCommentService_Update_FullMethodName      = "/entpb.TaskCommentService/Update"
+	TaskCommentService_Delete_FullMethodName      = "/entpb.TaskCommentService/Delete"
+	TaskCommentService_List_FullMethodName        = "/entpb.TaskCommentService/List"
+	TaskCommentService_BatchCreate_FullMethodName = "/entpb.TaskCommentService/BatchCreate"
+)
+
+// TaskCommentServiceClient is the client API for TaskCommentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskCommentServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskCommentRequest, opts ...grpc.CallOption) (*TaskComment, error)
+	Get(ctx context.Context, in *GetTaskCommentRequest, opts ...grpc.CallOption) (*TaskComment, error)
+	Update(ctx context.Context, in *UpdateTaskCommentRequest, opts ...grpc.CallOption) (*TaskComment, error)
+	Delete(ctx context.Context, in *DeleteTaskCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskCommentRequest, opts ...grpc.CallOption) (*ListTaskCommentResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskCommentsRequest, opts ...grpc.CallOption) (*BatchCreateTaskCommentsResponse, error)
+}
+
+type taskCommentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskCommentServiceClient(cc grpc.ClientConnInterface) TaskCommentServiceClient {
+	return &taskCommentServiceClient{cc}
+}
+
+func (c *taskCommentServiceClient) Create(ctx context.Context, in *CreateTaskCommentRequest, opts ...grpc.CallOption) (*TaskComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskComment)
+	err := c.cc.Invoke(ctx, TaskCommentService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentServiceClient) Get(ctx context.Context, in *GetTaskCommentRequest, opts ...grpc.CallOption) (*TaskComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskComment)
+	err := c.cc.Invoke(ctx, TaskCommentService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentServiceClient) Update(ctx context.Context, in *UpdateTaskCommentRequest, opts ...grpc.CallOption) (*TaskComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskComment)
+	err := c.cc.Invoke(ctx, TaskCommentService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentServiceClient) Delete(ctx context.Context, in *DeleteTaskCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskCommentService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentServiceClient) List(ctx context.Context, in *ListTaskCommentRequest, opts ...grpc.CallOption) (*ListTaskCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskCommentResponse)
+	err := c.cc.Invoke(ctx, TaskCommentService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskCommentsRequest, opts ...grpc.CallOption) (*BatchCreateTaskCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTaskCommentsResponse)
+	err := c.cc.Invoke(ctx, TaskCommentService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskCommentServiceServer is the server API for TaskCommentService service.
+// All implementations must embed UnimplementedTaskCommentServiceServer
+// for forward compatibility.
+type TaskCommentServiceServer interface {
+	Create(context.Context, *CreateTaskCommentRequest) (*TaskComment, error)
+	Get(context.Context, *GetTaskCommentRequest) (*TaskComment, error)
+	Update(context.Context, *UpdateTaskCommentRequest) (*TaskComment, error)
+	Delete(context.Context, *DeleteTaskCommentRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskCommentRequest) (*ListTaskCommentResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskCommentsRequest) (*BatchCreateTaskCommentsResponse, error)
+	mustEmbedUnimplementedTaskCommentServiceServer()
+}
+
+// UnimplementedTaskCommentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskCommentServiceServer struct{}
+
+func (UnimplementedTaskCommentServiceServer) Create(context.Context, *CreateTaskCommentRequest) (*TaskComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaskCommentServiceServer) Get(context.Context, *GetTaskCommentRequest) (*TaskComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskCommentServiceServer) Update(context.Context, *UpdateTaskCommentRequest) (*TaskComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskCommentServiceServer) Delete(context.Context, *DeleteTaskCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaskCommentServiceServer) List(context.Context, *ListTaskCommentRequest) (*ListTaskCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskCommentServiceServer) BatchCreate(context.Context, *BatchCreateTaskCommentsRequest) (*BatchCreateTaskCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTaskCommentServiceServer) mustEmbedUnimplementedTaskCommentServiceServer() {}
+func (UnimplementedTaskCommentServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeTaskCommentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskCommentServiceServer will
+// result in compilation errors.
+type UnsafeTaskCommentServiceServer interface {
+	mustEmbedUnimplementedTaskCommentServiceServer()
+}
+
+func RegisterTaskCommentServiceServer(s grpc.ServiceRegistrar, srv TaskCommentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskCommentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskCommentService_ServiceDesc, srv)
+}
+
+func _TaskCommentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentServiceServer).Create(ctx, req.(*CreateTaskCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentServiceServer).Get(ctx, req.(*GetTaskCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentServiceServer).Update(ctx, req.(*UpdateTaskCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentServiceServer).Delete(ctx, req.(*DeleteTaskCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentServiceServer).List(ctx, req.(*ListTaskCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskCommentService_ServiceDesc is the grpc.ServiceDesc for TaskCommentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskCommentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskCommentService",
+	HandlerType: (*TaskCommentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TaskCommentService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TaskCommentService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaskCommentService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TaskCommentService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TaskCommentService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _TaskCommentService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	TaskCommentRevisionService_Create_FullMethodName      = "/entpb.TaskCommentRevisionService/Create"
+	TaskCommentRevisionService_Get_FullMethodName         = "/entpb.TaskCommentRevisionService/Get"
+	TaskCommentRevisionService_Update_FullMethodName      = "/entpb.TaskCommentRevisionService/Update"
+	TaskCommentRevisionService_Delete_FullMethodName      = "/entpb.TaskCommentRevisionService/Delete"
+	TaskCommentRevisionService_List_FullMethodName        = "/entpb.TaskCommentRevisionService/List"
+	TaskCommentRevisionService_BatchCreate_FullMethodName = "/entpb.TaskCommentRevisionService/BatchCreate"
+)
+
+// TaskCommentRevisionServiceClient is the client API for TaskCommentRevisionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskCommentRevisionServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskCommentRevisionRequest, opts ...grpc.CallOption) (*TaskCommentRevision, error)
+	Get(ctx context.Context, in *GetTaskCommentRevisionRequest, opts ...grpc.CallOption) (*TaskCommentRevision, error)
+	Update(ctx context.Context, in *UpdateTaskCommentRevisionRequest, opts ...grpc.CallOption) (*TaskCommentRevision, error)
+	Delete(ctx context.Context, in *DeleteTaskCommentRevisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskCommentRevisionRequest, opts ...grpc.CallOption) (*ListTaskCommentRevisionResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskCommentRevisionsRequest, opts ...grpc.CallOption) (*BatchCreateTaskCommentRevisionsResponse, error)
+}
+
+type taskCommentRevisionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskCommentRevisionServiceClient(cc grpc.ClientConnInterface) TaskCommentRevisionServiceClient {
+	return &taskCommentRevisionServiceClient{cc}
+}
+
+func (c *taskCommentRevisionServiceClient) Create(ctx context.Context, in *CreateTaskCommentRevisionRequest, opts ...grpc.CallOption) (*TaskCommentRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskCommentRevision)
+	err := c.cc.Invoke(ctx, TaskCommentRevisionService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentRevisionServiceClient) Get(ctx context.Context, in *GetTaskCommentRevisionRequest, opts ...grpc.CallOption) (*TaskCommentRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskCommentRevision)
+	err := c.cc.Invoke(ctx, TaskCommentRevisionService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentRevisionServiceClient) Update(ctx context.Context, in *UpdateTaskCommentRevisionRequest, opts ...grpc.CallOption) (*TaskCommentRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskCommentRevision)
+	err := c.cc.Invoke(ctx, TaskCommentRevisionService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentRevisionServiceClient) Delete(ctx context.Context, in *DeleteTaskCommentRevisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskCommentRevisionService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentRevisionServiceClient) List(ctx context.Context, in *ListTaskCommentRevisionRequest, opts ...grpc.CallOption) (*ListTaskCommentRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskCommentRevisionResponse)
+	err := c.cc.Invoke(ctx, TaskCommentRevisionService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskCommentRevisionServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskCommentRevisionsRequest, opts ...grpc.CallOption) (*BatchCreateTaskCommentRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTaskCommentRevisionsResponse)
+	err := c.cc.Invoke(ctx, TaskCommentRevisionService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskCommentRevisionServiceServer is the server API for TaskCommentRevisionService service.
+// All implementations must embed UnimplementedTaskCommentRevisionServiceServer
+// for forward compatibility.
+type TaskCommentRevisionServiceServer interface {
+	Create(context.Context, *CreateTaskCommentRevisionRequest) (*TaskCommentRevision, error)
+	Get(context.Context, *GetTaskCommentRevisionRequest) (*TaskCommentRevision, error)
+	Update(context.Context, *UpdateTaskCommentRevisionRequest) (*TaskCommentRevision, error)
+	Delete(context.Context, *DeleteTaskCommentRevisionRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskCommentRevisionRequest) (*ListTaskCommentRevisionResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskCommentRevisionsRequest) (*BatchCreateTaskCommentRevisionsResponse, error)
+	mustEmbedUnimplementedTaskCommentRevisionServiceServer()
+}
+
+// UnimplementedTaskCommentRevisionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskCommentRevisionServiceServer struct{}
+
+func (UnimplementedTaskCommentRevisionServiceServer) Create(context.Context, *CreateTaskCommentRevisionRequest) (*TaskCommentRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaskCommentRevisionServiceServer) Get(context.Context, *GetTaskCommentRevisionRequest) (*TaskCommentRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskCommentRevisionServiceServer) Update(context.Context, *UpdateTaskCommentRevisionRequest) (*TaskCommentRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskCommentRevisionServiceServer) Delete(context.Context, *DeleteTaskCommentRevisionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaskCommentRevisionServiceServer) List(context.Context, *ListTaskCommentRevisionRequest) (*ListTaskCommentRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskCommentRevisionServiceServer) BatchCreate(context.Context, *BatchCreateTaskCommentRevisionsRequest) (*BatchCreateTaskCommentRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTaskCommentRevisionServiceServer) mustEmbedUnimplementedTaskCommentRevisionServiceServer() {
+}
+func (UnimplementedTaskCommentRevisionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTaskCommentRevisionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskCommentRevisionServiceServer will
+// result in compilation errors.
+type UnsafeTaskCommentRevisionServiceServer interface {
+	mustEmbedUnimplementedTaskCommentRevisionServiceServer()
+}
+
+func RegisterTaskCommentRevisionServiceServer(s grpc.ServiceRegistrar, srv TaskCommentRevisionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskCommentRevisionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskCommentRevisionService_ServiceDesc, srv)
+}
+
+func _TaskCommentRevisionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskCommentRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentRevisionServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentRevisionService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentRevisionServiceServer).Create(ctx, req.(*CreateTaskCommentRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentRevisionService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskCommentRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentRevisionServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentRevisionService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentRevisionServiceServer).Get(ctx, req.(*GetTaskCommentRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentRevisionService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskCommentRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentRevisionServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentRevisionService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentRevisionServiceServer).Update(ctx, req.(*UpdateTaskCommentRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentRevisionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskCommentRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentRevisionServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentRevisionService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentRevisionServiceServer).Delete(ctx, req.(*DeleteTaskCommentRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentRevisionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskCommentRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentRevisionServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentRevisionService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentRevisionServiceServer).List(ctx, req.(*ListTaskCommentRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskCommentRevisionService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskCommentRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskCommentRevisionServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskCommentRevisionService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskCommentRevisionServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskCommentRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskCommentRevisionService_ServiceDesc is the grpc.ServiceDesc for TaskCommentRevisionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskCommentRevisionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskCommentRevisionService",
+	HandlerType: (*TaskCommentRevisionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TaskCommentRevisionService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TaskCommentRevisionService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaskCommentRevisionService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TaskCommentRevisionService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TaskCommentRevisionService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _TaskCommentRevisionService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	TaskDependencyService_Create_FullMethodName      = "/entpb.TaskDependencyService/Create"
+	TaskDependencyService_Get_FullMethodName         = "/entpb.TaskDependencyService/Get"
+	TaskDependencyService_Update_FullMethodName      = "/entpb.TaskDependencyService/Update"
+	TaskDependencyService_Delete_FullMethodName      = "/entpb.TaskDependencyService/Delete"
+	TaskDependencyService_List_FullMethodName        = "/entpb.TaskDependencyService/List"
+	TaskDependencyService_BatchCreate_FullMethodName = "/entpb.TaskDependencyService/BatchCreate"
+)
+
+// TaskDependencyServiceClient is the client API for TaskDependencyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskDependencyServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskDependencyRequest, opts ...grpc.CallOption) (*TaskDependency, error)
+	Get(ctx context.Context, in *GetTaskDependencyRequest, opts ...grpc.CallOption) (*TaskDependency, error)
+	Update(ctx context.Context, in *UpdateTaskDependencyRequest, opts ...grpc.CallOption) (*TaskDependency, error)
+	Delete(ctx context.Context, in *DeleteTaskDependencyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskDependencyRequest, opts ...grpc.CallOption) (*ListTaskDependencyResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskDependenciesRequest, opts ...grpc.CallOption) (*BatchCreateTaskDependenciesResponse, error)
+}
+
+type taskDependencyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskDependencyServiceClient(cc grpc.ClientConnInterface) TaskDependencyServiceClient {
+	return &taskDependencyServiceClient{cc}
+}
+
+func (c *taskDependencyServiceClient) Create(ctx context.Context, in *CreateTaskDependencyRequest, opts ...grpc.CallOption) (*TaskDependency, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskDependency)
+	err := c.cc.Invoke(ctx, TaskDependencyService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskDependencyServiceClient) Get(ctx context.Context, in *GetTaskDependencyRequest, opts ...grpc.CallOption) (*TaskDependency, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskDependency)
+	err := c.cc.Invoke(ctx, TaskDependencyService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskDependencyServiceClient) Update(ctx context.Context, in *UpdateTaskDependencyRequest, opts ...grpc.CallOption) (*TaskDependency, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskDependency)
+	err := c.cc.Invoke(ctx, TaskDependencyService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskDependencyServiceClient) Delete(ctx context.Context, in *DeleteTaskDependencyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskDependencyService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskDependencyServiceClient) List(ctx context.Context, in *ListTaskDependencyRequest, opts ...grpc.CallOption) (*ListTaskDependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskDependencyResponse)
+	err := c.cc.Invoke(ctx, TaskDependencyService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskDependencyServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskDependenciesRequest, opts ...grpc.CallOption) (*BatchCreateTaskDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTaskDependenciesResponse)
+	err := c.cc.Invoke(ctx, TaskDependencyService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskDependencyServiceServer is the server API for TaskDependencyService service.
+// All implementations must embed UnimplementedTaskDependencyServiceServer
+// for forward compatibility.
+type TaskDependencyServiceServer interface {
+	Create(context.Context, *CreateTaskDependencyRequest) (*TaskDependency, error)
+	Get(context.Context, *GetTaskDependencyRequest) (*TaskDependency, error)
+	Update(context.Context, *UpdateTaskDependencyRequest) (*TaskDependency, error)
+	Delete(context.Context, *DeleteTaskDependencyRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskDependencyRequest) (*ListTaskDependencyResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskDependenciesRequest) (*BatchCreateTaskDependenciesResponse, error)
+	mustEmbedUnimplementedTaskDependencyServiceServer()
+}
+
+// UnimplementedTaskDependencyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskDependencyServiceServer struct{}
+
+func (UnimplementedTaskDependencyServiceServer) Create(context.Context, *CreateTaskDependencyRequest) (*TaskDependency, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaskDependencyServiceServer) Get(context.Context, *GetTaskDependencyRequest) (*TaskDependency, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskDependencyServiceServer) Update(context.Context, *UpdateTaskDependencyRequest) (*TaskDependency, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskDependencyServiceServer) Delete(context.Context, *DeleteTaskDependencyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaskDependencyServiceServer) List(context.Context, *ListTaskDependencyRequest) (*ListTaskDependencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskDependencyServiceServer) BatchCreate(context.Context, *BatchCreateTaskDependenciesRequest) (*BatchCreateTaskDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTaskDependencyServiceServer) mustEmbedUnimplementedTaskDependencyServiceServer() {}
+func (UnimplementedTaskDependencyServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeTaskDependencyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskDependencyServiceServer will
+// result in compilation errors.
+type UnsafeTaskDependencyServiceServer interface {
+	mustEmbedUnimplementedTaskDependencyServiceServer()
+}
+
+func RegisterTaskDependencyServiceServer(s grpc.ServiceRegistrar, srv TaskDependencyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskDependencyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskDependencyService_ServiceDesc, srv)
+}
+
+func _TaskDependencyService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskDependencyServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskDependencyService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskDependencyServiceServer).Create(ctx, req.(*CreateTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskDependencyService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskDependencyServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskDependencyService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskDependencyServiceServer).Get(ctx, req.(*GetTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskDependencyService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskDependencyServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskDependencyService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskDependencyServiceServer).Update(ctx, req.(*UpdateTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskDependencyService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskDependencyServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskDependencyService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskDependencyServiceServer).Delete(ctx, req.(*DeleteTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskDependencyService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskDependencyServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskDependencyService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskDependencyServiceServer).List(ctx, req.(*ListTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskDependencyService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskDependencyServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskDependencyService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskDependencyServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskDependencyService_ServiceDesc is the grpc.ServiceDesc for TaskDependencyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskDependencyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskDependencyService",
+	HandlerType: (*TaskDependencyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TaskDependencyService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TaskDependencyService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaskDependencyService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TaskDependencyService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TaskDependencyService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _TaskDependencyService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	TaskRecurrenceService_Create_FullMethodName      = "/entpb.TaskRecurrenceService/Create"
+	TaskRecurrenceService_Get_FullMethodName         = "/entpb.TaskRecurrenceService/Get"
+	TaskRecurrenceService_Update_FullMethodName      = "/entpb.TaskRecurrenceService/Update"
+	TaskRecurrenceService_Delete_FullMethodName      = "/entpb.TaskRecurrenceService/Delete"
+	TaskRecurrenceService_List_FullMethodName        = "/entpb.TaskRecurrenceService/List"
+	TaskRecurrenceService_BatchCreate_FullMethodName = "/entpb.TaskRecurrenceService/BatchCreate"
+)
+
+// TaskRecurrenceServiceClient is the client API for TaskRecurrenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskRecurrenceServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskRecurrenceRequest, opts ...grpc.CallOption) (*TaskRecurrence, error)
+	Get(ctx context.Context, in *GetTaskRecurrenceRequest, opts ...grpc.CallOption) (*TaskRecurrence, error)
+	Update(ctx context.Context, in *UpdateTaskRecurrenceRequest, opts ...grpc.CallOption) (*TaskRecurrence, error)
+	Delete(ctx context.Context, in *DeleteTaskRecurrenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskRecurrenceRequest, opts ...grpc.CallOption) (*ListTaskRecurrenceResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskRecurrencesRequest, opts ...grpc.CallOption) (*BatchCreateTaskRecurrencesResponse, error)
+}
+
+type taskRecurrenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskRecurrenceServiceClient(cc grpc.ClientConnInterface) TaskRecurrenceServiceClient {
+	return &taskRecurrenceServiceClient{cc}
+}
+
+func (c *taskRecurrenceServiceClient) Create(ctx context.Context, in *CreateTaskRecurrenceRequest, opts ...grpc.CallOption) (*TaskRecurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRecurrence)
+	err := c.cc.Invoke(ctx, TaskRecurrenceService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceServiceClient) Get(ctx context.Context, in *GetTaskRecurrenceRequest, opts ...grpc.CallOption) (*TaskRecurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRecurrence)
+	err := c.cc.Invoke(ctx, TaskRecurrenceService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceServiceClient) Update(ctx context.Context, in *UpdateTaskRecurrenceRequest, opts ...grpc.CallOption) (*TaskRecurrence, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRecurrence)
+	err := c.cc.Invoke(ctx, TaskRecurrenceService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceServiceClient) Delete(ctx context.Context, in *DeleteTaskRecurrenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskRecurrenceService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceServiceClient) List(ctx context.Context, in *ListTaskRecurrenceRequest, opts ...grpc.CallOption) (*ListTaskRecurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskRecurrenceResponse)
+	err := c.cc.Invoke(ctx, TaskRecurrenceService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskRecurrencesRequest, opts ...grpc.CallOption) (*BatchCreateTaskRecurrencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTaskRecurrencesResponse)
+	err := c.cc.Invoke(ctx, TaskRecurrenceService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskRecurrenceServiceServer is the server API for TaskRecurrenceService service.
+// All implementations must embed UnimplementedTaskRecurrenceServiceServer
+// for forward compatibility.
+type TaskRecurrenceServiceServer interface {
+	Create(context.Context, *CreateTaskRecurrenceRequest) (*TaskRecurrence, error)
+	Get(context.Context, *GetTaskRecurrenceRequest) (*TaskRecurrence, error)
+	Update(context.Context, *UpdateTaskRecurrenceRequest) (*TaskRecurrence, error)
+	Delete(context.Context, *DeleteTaskRecurrenceRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskRecurrenceRequest) (*ListTaskRecurrenceResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskRecurrencesRequest) (*BatchCreateTaskRecurrencesResponse, error)
+	mustEmbedUnimplementedTaskRecurrenceServiceServer()
+}
+
+// UnimplementedTaskRecurrenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskRecurrenceServiceServer struct{}
+
+func (UnimplementedTaskRecurrenceServiceServer) Create(context.Context, *CreateTaskRecurrenceRequest) (*TaskRecurrence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaskRecurrenceServiceServer) Get(context.Context, *GetTaskRecurrenceRequest) (*TaskRecurrence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskRecurrenceServiceServer) Update(context.Context, *UpdateTaskRecurrenceRequest) (*TaskRecurrence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskRecurrenceServiceServer) Delete(context.Context, *DeleteTaskRecurrenceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaskRecurrenceServiceServer) List(context.Context, *ListTaskRecurrenceRequest) (*ListTaskRecurrenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskRecurrenceServiceServer) BatchCreate(context.Context, *BatchCreateTaskRecurrencesRequest) (*BatchCreateTaskRecurrencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTaskRecurrenceServiceServer) mustEmbedUnimplementedTaskRecurrenceServiceServer() {}
+func (UnimplementedTaskRecurrenceServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeTaskRecurrenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskRecurrenceServiceServer will
+// result in compilation errors.
+type UnsafeTaskRecurrenceServiceServer interface {
+	mustEmbedUnimplementedTaskRecurrenceServiceServer()
+}
+
+func RegisterTaskRecurrenceServiceServer(s grpc.ServiceRegistrar, srv TaskRecurrenceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskRecurrenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskRecurrenceService_ServiceDesc, srv)
+}
+
+func _TaskRecurrenceService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceServiceServer).Create(ctx, req.(*CreateTaskRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceServiceServer).Get(ctx, req.(*GetTaskRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceServiceServer).Update(ctx, req.(*UpdateTaskRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceServiceServer).Delete(ctx, req.(*DeleteTaskRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceServiceServer).List(ctx, req.(*ListTaskRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskRecurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskRecurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskRecurrenceService_ServiceDesc is the grpc.ServiceDesc for TaskRecurrenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskRecurrenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskRecurrenceService",
+	HandlerType: (*TaskRecurrenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TaskRecurrenceService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TaskRecurrenceService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaskRecurrenceService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TaskRecurrenceService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TaskRecurrenceService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _TaskRecurrenceService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	TaskRecurrenceRunService_Create_FullMethodName      = "/entpb.TaskRecurrenceRunService/Create"
+	TaskRecurrenceRunService_Get_FullMethodName         = "/entpb.TaskRecurrenceRunService/Get"
+	TaskRecurrenceRunService_Update_FullMethodName      = "/entpb.TaskRecurrenceRunService/Update"
+	TaskRecurrenceRunService_Delete_FullMethodName      = "/entpb.TaskRecurrenceRunService/Delete"
+	TaskRecurrenceRunService_List_FullMethodName        = "/entpb.TaskRecurrenceRunService/List"
+	TaskRecurrenceRunService_BatchCreate_FullMethodName = "/entpb.TaskRecurrenceRunService/BatchCreate"
+)
+
+// TaskRecurrenceRunServiceClient is the client API for TaskRecurrenceRunService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskRecurrenceRunServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*TaskRecurrenceRun, error)
+	Get(ctx context.Context, in *GetTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*TaskRecurrenceRun, error)
+	Update(ctx context.Context, in *UpdateTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*TaskRecurrenceRun, error)
+	Delete(ctx context.Context, in *DeleteTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*ListTaskRecurrenceRunResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskRecurrenceRunsRequest, opts ...grpc.CallOption) (*BatchCreateTaskRecurrenceRunsResponse, error)
+}
+
+type taskRecurrenceRunServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskRecurrenceRunServiceClient(cc grpc.ClientConnInterface) TaskRecurrenceRunServiceClient {
+	return &taskRecurrenceRunServiceClient{cc}
+}
+
+func (c *taskRecurrenceRunServiceClient) Create(ctx context.Context, in *CreateTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*TaskRecurrenceRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRecurrenceRun)
+	err := c.cc.Invoke(ctx, TaskRecurrenceRunService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceRunServiceClient) Get(ctx context.Context, in *GetTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*TaskRecurrenceRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRecurrenceRun)
+	err := c.cc.Invoke(ctx, TaskRecurrenceRunService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceRunServiceClient) Update(ctx context.Context, in *UpdateTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*TaskRecurrenceRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRecurrenceRun)
+	err := c.cc.Invoke(ctx, TaskRecurrenceRunService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceRunServiceClient) Delete(ctx context.Context, in *DeleteTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskRecurrenceRunService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceRunServiceClient) List(ctx context.Context, in *ListTaskRecurrenceRunRequest, opts ...grpc.CallOption) (*ListTaskRecurrenceRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskRecurrenceRunResponse)
+	err := c.cc.Invoke(ctx, TaskRecurrenceRunService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskRecurrenceRunServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskRecurrenceRunsRequest, opts ...grpc.CallOption) (*BatchCreateTaskRecurrenceRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTaskRecurrenceRunsResponse)
+	err := c.cc.Invoke(ctx, TaskRecurrenceRunService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskRecurrenceRunServiceServer is the server API for TaskRecurrenceRunService service.
+// All implementations must embed UnimplementedTaskRecurrenceRunServiceServer
+// for forward compatibility.
+type TaskRecurrenceRunServiceServer interface {
+	Create(context.Context, *CreateTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error)
+	Get(context.Context, *GetTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error)
+	Update(context.Context, *UpdateTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error)
+	Delete(context.Context, *DeleteTaskRecurrenceRunRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskRecurrenceRunRequest) (*ListTaskRecurrenceRunResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskRecurrenceRunsRequest) (*BatchCreateTaskRecurrenceRunsResponse, error)
+	mustEmbedUnimplementedTaskRecurrenceRunServiceServer()
+}
+
+// UnimplementedTaskRecurrenceRunServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskRecurrenceRunServiceServer struct{}
+
+func (UnimplementedTaskRecurrenceRunServiceServer) Create(context.Context, *CreateTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaskRecurrenceRunServiceServer) Get(context.Context, *GetTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskRecurrenceRunServiceServer) Update(context.Context, *UpdateTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskRecurrenceRunServiceServer) Delete(context.Context, *DeleteTaskRecurrenceRunRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaskRecurrenceRunServiceServer) List(context.Context, *ListTaskRecurrenceRunRequest) (*ListTaskRecurrenceRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskRecurrenceRunServiceServer) BatchCreate(context.Context, *BatchCreateTaskRecurrenceRunsRequest) (*BatchCreateTaskRecurrenceRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTaskRecurrenceRunServiceServer) mustEmbedUnimplementedTaskRecurrenceRunServiceServer() {
+}
+func (UnimplementedTaskRecurrenceRunServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTaskRecurrenceRunServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskRecurrenceRunServiceServer will
+// result in compilation errors.
+type UnsafeTaskRecurrenceRunServiceServer interface {
+	mustEmbedUnimplementedTaskRecurrenceRunServiceServer()
+}
+
+func RegisterTaskRecurrenceRunServiceServer(s grpc.ServiceRegistrar, srv TaskRecurrenceRunServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskRecurrenceRunServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskRecurrenceRunService_ServiceDesc, srv)
+}
+
+func _TaskRecurrenceRunService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRecurrenceRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceRunServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceRunService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceRunServiceServer).Create(ctx, req.(*CreateTaskRecurrenceRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceRunService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRecurrenceRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceRunServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceRunService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceRunServiceServer).Get(ctx, req.(*GetTaskRecurrenceRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceRunService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRecurrenceRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceRunServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceRunService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceRunServiceServer).Update(ctx, req.(*UpdateTaskRecurrenceRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceRunService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRecurrenceRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceRunServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceRunService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceRunServiceServer).Delete(ctx, req.(*DeleteTaskRecurrenceRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceRunService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRecurrenceRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceRunServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceRunService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceRunServiceServer).List(ctx, req.(*ListTaskRecurrenceRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskRecurrenceRunService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskRecurrenceRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskRecurrenceRunServiceServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskRecurrenceRunService_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskRecurrenceRunServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskRecurrenceRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskRecurrenceRunService_ServiceDesc is the grpc.ServiceDesc for TaskRecurrenceRunService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskRecurrenceRunService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskRecurrenceRunService",
+	HandlerType: (*TaskRecurrenceRunServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TaskRecurrenceRunService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TaskRecurrenceRunService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TaskRecurrenceRunService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TaskRecurrenceRunService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TaskRecurrenceRunService_List_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _TaskRecurrenceRunService_BatchCreate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/entpb.proto",
+}
+
+const (
+	TaskReminderService_Create_FullMethodName      = "/entpb.TaskReminderService/Create"
+	TaskReminderService_Get_FullMethodName         = "/entpb.TaskReminderService/Get"
+	TaskReminderService_Update_FullMethodName      = "/entpb.TaskReminderService/Update"
+	TaskReminderService_Delete_FullMethodName      = "/entpb.TaskReminderService/Delete"
+	TaskReminderService_List_FullMethodName        = "/entpb.TaskReminderService/List"
+	TaskReminderService_BatchCreate_FullMethodName = "/entpb.TaskReminderService/BatchCreate"
+)
+
+// TaskReminderServiceClient is the client API for TaskReminderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaskReminderServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskReminderRequest, opts ...grpc.CallOption) (*TaskReminder, error)
+	Get(ctx context.Context, in *GetTaskReminderRequest, opts ...grpc.CallOption) (*TaskReminder, error)
+	Update(ctx context.Context, in *UpdateTaskReminderRequest, opts ...grpc.CallOption) (*TaskReminder, error)
+	Delete(ctx context.Context, in *DeleteTaskReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskReminderRequest, opts ...grpc.CallOption) (*ListTaskReminderResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskRemindersRequest, opts ...grpc.CallOption) (*BatchCreateTaskRemindersResponse, error)
+}
+
+type taskReminderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaskReminderServiceClient(cc grpc.ClientConnInterface) TaskReminderServiceClient {
+	return &taskReminderServiceClient{cc}
+}
+
+func (c *taskReminderServiceClient) Create(ctx context.Context, in *CreateTaskReminderRequest, opts ...grpc.CallOption) (*TaskReminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReminder)
+	err := c.cc.Invoke(ctx, TaskReminderService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskReminderServiceClient) Get(ctx context.Context, in *GetTaskReminderRequest, opts ...grpc.CallOption) (*TaskReminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReminder)
+	err := c.cc.Invoke(ctx, TaskReminderService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskReminderServiceClient) Update(ctx context.Context, in *UpdateTaskReminderRequest, opts ...grpc.CallOption) (*TaskReminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReminder)
+	err := c.cc.Invoke(ctx, TaskReminderService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskReminderServiceClient) Delete(ctx context.Context, in *DeleteTaskReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskReminderService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskReminderServiceClient) List(ctx context.Context, in *ListTaskReminderRequest, opts ...grpc.CallOption) (*ListTaskReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskReminderResponse)
+	err := c.cc.Invoke(ctx, TaskReminderService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskReminderServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskRemindersRequest, opts ...grpc.CallOption) (*BatchCreateTaskRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTaskRemindersResponse)
+	err := c.cc.Invoke(ctx, TaskReminderService_BatchCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskReminderServiceServer is the server API for TaskReminderService service.
+// All implementations must embed UnimplementedTaskReminderServiceServer
+// for forward compatibility.
+type TaskReminderServiceServer interface {
+	Create(context.Context, *CreateTaskReminderRequest) (*TaskReminder, error)
+	Get(context.Context, *GetTaskReminderRequest) (*TaskReminder, error)
+	Update(context.Context, *UpdateTaskReminderRequest) (*TaskReminder, error)
+	Delete(context.Context, *DeleteTaskReminderRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskReminderRequest) (*ListTaskReminderResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskRemindersRequest) (*BatchCreateTaskRemindersResponse, error)
+	mustEmbedUnimplementedTaskReminderServiceServer()
+}
+
+// UnimplementedTaskReminderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskReminderServiceServer struct{}
+
+func (UnimplementedTaskReminderServiceServer) Create(context.Context, *CreateTaskReminderRequest) (*TaskReminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTaskReminderServiceServer) Get(context.Context, *GetTaskReminderRequest) (*TaskReminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedTaskReminderServiceServer) Update(context.Context, *UpdateTaskReminderRequest) (*TaskReminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTaskReminderServiceServer) Delete(context.Context, *DeleteTaskReminderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTaskReminderServiceServer) List(context.Context, *ListTaskReminderRequest) (*ListTaskReminderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskReminderServiceServer) BatchCreate(context.Context, *BatchCreateTaskRemindersRequest) (*BatchCreateTaskRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedTaskReminderServiceServer) mustEmbedUnimplementedTaskReminderServiceServer() {}
+func (UnimplementedTaskReminderServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeTaskReminderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskReminderServiceServer will
 // result in compilation errors.
-type UnsafeLabelServiceServer interface {
-	mustEmbedUnimplementedLabelServiceServer()
+type UnsafeTaskReminderServiceServer interface {
+	mustEmbedUnimplementedTaskReminderServiceServer()
 }
 
-func RegisterLabelServiceServer(s grpc.ServiceRegistrar, srv LabelServiceServer) {
-	// If the following call pancis, it indicates UnimplementedLabelServiceServer was
+func RegisterTaskReminderServiceServer(s grpc.ServiceRegistrar, srv TaskReminderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskReminderServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&LabelService_ServiceDesc, srv)
+	s.RegisterService(&TaskReminderService_ServiceDesc, srv)
 }
 
-func _LabelService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateLabelRequest)
+func _TaskReminderService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskReminderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LabelServiceServer).Create(ctx, in)
+		return srv.(TaskReminderServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LabelService_Create_FullMethodName,
+		FullMethod: TaskReminderService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabelServiceServer).Create(ctx, req.(*CreateLabelRequest))
+		return srv.(TaskReminderServiceServer).Create(ctx, req.(*CreateTaskReminderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LabelService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLabelRequest)
+func _TaskReminderService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskReminderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LabelServiceServer).Get(ctx, in)
+		return srv.(TaskReminderServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LabelService_Get_FullMethodName,
+		FullMethod: TaskReminderService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabelServiceServer).Get(ctx, req.(*GetLabelRequest))
+		return srv.(TaskReminderServiceServer).Get(ctx, req.(*GetTaskReminderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LabelService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLabelRequest)
+func _TaskReminderService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskReminderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LabelServiceServer).Update(ctx, in)
+		return srv.(TaskReminderServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LabelService_Update_FullMethodName,
+		FullMethod: TaskReminderService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabelServiceServer).Update(ctx, req.(*UpdateLabelRequest))
+		return srv.(TaskReminderServiceServer).Update(ctx, req.(*UpdateTaskReminderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LabelService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLabelRequest)
+func _TaskReminderService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskReminderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LabelServiceServer).Delete(ctx, in)
+		return srv.(TaskReminderServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LabelService_Delete_FullMethodName,
+		FullMethod: TaskReminderService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabelServiceServer).Delete(ctx, req.(*DeleteLabelRequest))
+		return srv.(TaskReminderServiceServer).Delete(ctx, req.(*DeleteTaskReminderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LabelService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLabelRequest)
+func _TaskReminderService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskReminderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LabelServiceServer).List(ctx, in)
+		return srv.(TaskReminderServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LabelService_List_FullMethodName,
+		FullMethod: TaskReminderService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabelServiceServer).List(ctx, req.(*ListLabelRequest))
+		return srv.(TaskReminderServiceServer).List(ctx, req.(*ListTaskReminderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LabelService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateLabelsRequest)
+func _TaskReminderService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskRemindersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LabelServiceServer).BatchCreate(ctx, in)
+		return srv.(TaskReminderServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LabelService_BatchCreate_FullMethodName,
+		FullMethod: TaskReminderService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LabelServiceServer).BatchCreate(ctx, req.(*BatchCreateLabelsRequest))
+		return srv.(TaskReminderServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskRemindersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// LabelService_ServiceDesc is the grpc.ServiceDesc for LabelService service.
+// TaskReminderService_ServiceDesc is the grpc.ServiceDesc for TaskReminderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var LabelService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.LabelService",
-	HandlerType: (*LabelServiceServer)(nil),
+var TaskReminderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskReminderService",
+	HandlerType: (*TaskReminderServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _LabelService_Create_Handler,
+			Handler:    _TaskReminderService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _LabelService_Get_Handler,
+			Handler:    _TaskReminderService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _LabelService_Update_Handler,
+			Handler:    _TaskReminderService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _LabelService_Delete_Handler,
+			Handler:    _TaskReminderService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _LabelService_List_Handler,
+			Handler:    _TaskReminderService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _LabelService_BatchCreate_Handler,
+			Handler:    _TaskReminderService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1191,291 +10177,291 @@ var LabelService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	LeaveApprovalService_Create_FullMethodName      = "/entpb.LeaveApprovalService/Create"
-	LeaveApprovalService_Get_FullMethodName         = "/entpb.LeaveApprovalService/Get"
-	LeaveApprovalService_Update_FullMethodName      = "/entpb.LeaveApprovalService/Update"
-	LeaveApprovalService_Delete_FullMethodName      = "/entpb.LeaveApprovalService/Delete"
-	LeaveApprovalService_List_FullMethodName        = "/entpb.LeaveApprovalService/List"
-	LeaveApprovalService_BatchCreate_FullMethodName = "/entpb.LeaveApprovalService/BatchCreate"
+	TaskReportService_Create_FullMethodName      = "/entpb.TaskReportService/Create"
+	TaskReportService_Get_FullMethodName         = "/entpb.TaskReportService/Get"
+	TaskReportService_Update_FullMethodName      = "/entpb.TaskReportService/Update"
+	TaskReportService_Delete_FullMethodName      = "/entpb.TaskReportService/Delete"
+	TaskReportService_List_FullMethodName        = "/entpb.TaskReportService/List"
+	TaskReportService_BatchCreate_FullMethodName = "/entpb.TaskReportService/BatchCreate"
 )
 
-// LeaveApprovalServiceClient is the client API for LeaveApprovalService service.
+// TaskReportServiceClient is the client API for TaskReportService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type LeaveApprovalServiceClient interface {
-	Create(ctx context.Context, in *CreateLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error)
-	Get(ctx context.Context, in *GetLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error)
-	Update(ctx context.Context, in *UpdateLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error)
-	Delete(ctx context.Context, in *DeleteLeaveApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListLeaveApprovalRequest, opts ...grpc.CallOption) (*ListLeaveApprovalResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateLeaveApprovalsRequest, opts ...grpc.CallOption) (*BatchCreateLeaveApprovalsResponse, error)
+type TaskReportServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error)
+	Get(ctx context.Context, in *GetTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error)
+	Update(ctx context.Context, in *UpdateTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error)
+	Delete(ctx context.Context, in *DeleteTaskReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskReportRequest, opts ...grpc.CallOption) (*ListTaskReportResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskReportsRequest, opts ...grpc.CallOption) (*BatchCreateTaskReportsResponse, error)
 }
 
-type leaveApprovalServiceClient struct {
+type taskReportServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewLeaveApprovalServiceClient(cc grpc.ClientConnInterface) LeaveApprovalServiceClient {
-	return &leaveApprovalServiceClient{cc}
+func NewTaskReportServiceClient(cc grpc.ClientConnInterface) TaskReportServiceClient {
+	return &taskReportServiceClient{cc}
 }
 
-func (c *leaveApprovalServiceClient) Create(ctx context.Context, in *CreateLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error) {
+func (c *taskReportServiceClient) Create(ctx context.Context, in *CreateTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(LeaveApproval)
-	err := c.cc.Invoke(ctx, LeaveApprovalService_Create_FullMethodName, in, out, cOpts...)
+	out := new(TaskReport)
+	err := c.cc.Invoke(ctx, TaskReportService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveApprovalServiceClient) Get(ctx context.Context, in *GetLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error) {
+func (c *taskReportServiceClient) Get(ctx context.Context, in *GetTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(LeaveApproval)
-	err := c.cc.Invoke(ctx, LeaveApprovalService_Get_FullMethodName, in, out, cOpts...)
+	out := new(TaskReport)
+	err := c.cc.Invoke(ctx, TaskReportService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveApprovalServiceClient) Update(ctx context.Context, in *UpdateLeaveApprovalRequest, opts ...grpc.CallOption) (*LeaveApproval, error) {
+func (c *taskReportServiceClient) Update(ctx context.Context, in *UpdateTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(LeaveApproval)
-	err := c.cc.Invoke(ctx, LeaveApprovalService_Update_FullMethodName, in, out, cOpts...)
+	out := new(TaskReport)
+	err := c.cc.Invoke(ctx, TaskReportService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveApprovalServiceClient) Delete(ctx context.Context, in *DeleteLeaveApprovalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *taskReportServiceClient) Delete(ctx context.Context, in *DeleteTaskReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, LeaveApprovalService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, TaskReportService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveApprovalServiceClient) List(ctx context.Context, in *ListLeaveApprovalRequest, opts ...grpc.CallOption) (*ListLeaveApprovalResponse, error) {
+func (c *taskReportServiceClient) List(ctx context.Context, in *ListTaskReportRequest, opts ...grpc.CallOption) (*ListTaskReportResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListLeaveApprovalResponse)
-	err := c.cc.Invoke(ctx, LeaveApprovalService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListTaskReportResponse)
+	err := c.cc.Invoke(ctx, TaskReportService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveApprovalServiceClient) BatchCreate(ctx context.Context, in *BatchCreateLeaveApprovalsRequest, opts ...grpc.CallOption) (*BatchCreateLeaveApprovalsResponse, error) {
+func (c *taskReportServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskReportsRequest, opts ...grpc.CallOption) (*BatchCreateTaskReportsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateLeaveApprovalsResponse)
-	err := c.cc.Invoke(ctx, LeaveApprovalService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateTaskReportsResponse)
+	err := c.cc.Invoke(ctx, TaskReportService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// LeaveApprovalServiceServer is the server API for LeaveApprovalService service.
-// All implementations must embed UnimplementedLeaveApprovalServiceServer
+// TaskReportServiceServer is the server API for TaskReportService service.
+// All implementations must embed UnimplementedTaskReportServiceServer
 // for forward compatibility.
-type LeaveApprovalServiceServer interface {
-	Create(context.Context, *CreateLeaveApprovalRequest) (*LeaveApproval, error)
-	Get(context.Context, *GetLeaveApprovalRequest) (*LeaveApproval, error)
-	Update(context.Context, *UpdateLeaveApprovalRequest) (*LeaveApproval, error)
-	Delete(context.Context, *DeleteLeaveApprovalRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListLeaveApprovalRequest) (*ListLeaveApprovalResponse, error)
-	BatchCreate(context.Context, *BatchCreateLeaveApprovalsRequest) (*BatchCreateLeaveApprovalsResponse, error)
-	mustEmbedUnimplementedLeaveApprovalServiceServer()
+type TaskReportServiceServer interface {
+	Create(context.Context, *CreateTaskReportRequest) (*TaskReport, error)
+	Get(context.Context, *GetTaskReportRequest) (*TaskReport, error)
+	Update(context.Context, *UpdateTaskReportRequest) (*TaskReport, error)
+	Delete(context.Context, *DeleteTaskReportRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskReportRequest) (*ListTaskReportResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskReportsRequest) (*BatchCreateTaskReportsResponse, error)
+	mustEmbedUnimplementedTaskReportServiceServer()
 }
 
-// UnimplementedLeaveApprovalServiceServer must be embedded to have
+// UnimplementedTaskReportServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedLeaveApprovalServiceServer struct{}
+type UnimplementedTaskReportServiceServer struct{}
 
-func (UnimplementedLeaveApprovalServiceServer) Create(context.Context, *CreateLeaveApprovalRequest) (*LeaveApproval, error) {
+func (UnimplementedTaskReportServiceServer) Create(context.Context, *CreateTaskReportRequest) (*TaskReport, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedLeaveApprovalServiceServer) Get(context.Context, *GetLeaveApprovalRequest) (*LeaveApproval, error) {
+func (UnimplementedTaskReportServiceServer) Get(context.Context, *GetTaskReportRequest) (*TaskReport, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedLeaveApprovalServiceServer) Update(context.Context, *UpdateLeaveApprovalRequest) (*LeaveApproval, error) {
+func (UnimplementedTaskReportServiceServer) Update(context.Context, *UpdateTaskReportRequest) (*TaskReport, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedLeaveApprovalServiceServer) Delete(context.Context, *DeleteLeaveApprovalRequest) (*emptypb.Empty, error) {
+func (UnimplementedTaskReportServiceServer) Delete(context.Context, *DeleteTaskReportRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedLeaveApprovalServiceServer) List(context.Context, *ListLeaveApprovalRequest) (*ListLeaveApprovalResponse, error) {
+func (UnimplementedTaskReportServiceServer) List(context.Context, *ListTaskReportRequest) (*ListTaskReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedLeaveApprovalServiceServer) BatchCreate(context.Context, *BatchCreateLeaveApprovalsRequest) (*BatchCreateLeaveApprovalsResponse, error) {
+func (UnimplementedTaskReportServiceServer) BatchCreate(context.Context, *BatchCreateTaskReportsRequest) (*BatchCreateTaskReportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedLeaveApprovalServiceServer) mustEmbedUnimplementedLeaveApprovalServiceServer() {}
-func (UnimplementedLeaveApprovalServiceServer) testEmbeddedByValue()                              {}
+func (UnimplementedTaskReportServiceServer) mustEmbedUnimplementedTaskReportServiceServer() {}
+func (UnimplementedTaskReportServiceServer) testEmbeddedByValue()                           {}
 
-// UnsafeLeaveApprovalServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LeaveApprovalServiceServer will
+// UnsafeTaskReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskReportServiceServer will
 // result in compilation errors.
-type UnsafeLeaveApprovalServiceServer interface {
-	mustEmbedUnimplementedLeaveApprovalServiceServer()
+type UnsafeTaskReportServiceServer interface {
+	mustEmbedUnimplementedTaskReportServiceServer()
 }
 
-func RegisterLeaveApprovalServiceServer(s grpc.ServiceRegistrar, srv LeaveApprovalServiceServer) {
-	// If the following call pancis, it indicates UnimplementedLeaveApprovalServiceServer was
+func RegisterTaskReportServiceServer(s grpc.ServiceRegistrar, srv TaskReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskReportServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&LeaveApprovalService_ServiceDesc, srv)
+	s.RegisterService(&TaskReportService_ServiceDesc, srv)
 }
 
-func _LeaveApprovalService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateLeaveApprovalRequest)
+func _TaskReportService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveApprovalServiceServer).Create(ctx, in)
+		return srv.(TaskReportServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveApprovalService_Create_FullMethodName,
+		FullMethod: TaskReportService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveApprovalServiceServer).Create(ctx, req.(*CreateLeaveApprovalRequest))
+		return srv.(TaskReportServiceServer).Create(ctx, req.(*CreateTaskReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveApprovalService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeaveApprovalRequest)
+func _TaskReportService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveApprovalServiceServer).Get(ctx, in)
+		return srv.(TaskReportServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveApprovalService_Get_FullMethodName,
+		FullMethod: TaskReportService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveApprovalServiceServer).Get(ctx, req.(*GetLeaveApprovalRequest))
+		return srv.(TaskReportServiceServer).Get(ctx, req.(*GetTaskReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveApprovalService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLeaveApprovalRequest)
+func _TaskReportService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveApprovalServiceServer).Update(ctx, in)
+		return srv.(TaskReportServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveApprovalService_Update_FullMethodName,
+		FullMethod: TaskReportService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveApprovalServiceServer).Update(ctx, req.(*UpdateLeaveApprovalRequest))
+		return srv.(TaskReportServiceServer).Update(ctx, req.(*UpdateTaskReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveApprovalService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLeaveApprovalRequest)
+func _TaskReportService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveApprovalServiceServer).Delete(ctx, in)
+		return srv.(TaskReportServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveApprovalService_Delete_FullMethodName,
+		FullMethod: TaskReportService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveApprovalServiceServer).Delete(ctx, req.(*DeleteLeaveApprovalRequest))
+		return srv.(TaskReportServiceServer).Delete(ctx, req.(*DeleteTaskReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveApprovalService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLeaveApprovalRequest)
+func _TaskReportService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveApprovalServiceServer).List(ctx, in)
+		return srv.(TaskReportServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveApprovalService_List_FullMethodName,
+		FullMethod: TaskReportService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveApprovalServiceServer).List(ctx, req.(*ListLeaveApprovalRequest))
+		return srv.(TaskReportServiceServer).List(ctx, req.(*ListTaskReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveApprovalService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateLeaveApprovalsRequest)
+func _TaskReportService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveApprovalServiceServer).BatchCreate(ctx, in)
+		return srv.(TaskReportServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveApprovalService_BatchCreate_FullMethodName,
+		FullMethod: TaskReportService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveApprovalServiceServer).BatchCreate(ctx, req.(*BatchCreateLeaveApprovalsRequest))
+		return srv.(TaskReportServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// LeaveApprovalService_ServiceDesc is the grpc.ServiceDesc for LeaveApprovalService service.
+// TaskReportService_ServiceDesc is the grpc.ServiceDesc for TaskReportService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var LeaveApprovalService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.LeaveApprovalService",
-	HandlerType: (*LeaveApprovalServiceServer)(nil),
+var TaskReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskReportService",
+	HandlerType: (*TaskReportServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _LeaveApprovalService_Create_Handler,
+			Handler:    _TaskReportService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _LeaveApprovalService_Get_Handler,
+			Handler:    _TaskReportService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _LeaveApprovalService_Update_Handler,
+			Handler:    _TaskReportService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _LeaveApprovalService_Delete_Handler,
+			Handler:    _TaskReportService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _LeaveApprovalService_List_Handler,
+			Handler:    _TaskReportService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _LeaveApprovalService_BatchCreate_Handler,
+			Handler:    _TaskReportService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1483,291 +10469,291 @@ var LeaveApprovalService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	LeaveRequestService_Create_FullMethodName      = "/entpb.LeaveRequestService/Create"
-	LeaveRequestService_Get_FullMethodName         = "/entpb.LeaveRequestService/Get"
-	LeaveRequestService_Update_FullMethodName      = "/entpb.LeaveRequestService/Update"
-	LeaveRequestService_Delete_FullMethodName      = "/entpb.LeaveRequestService/Delete"
-	LeaveRequestService_List_FullMethodName        = "/entpb.LeaveRequestService/List"
-	LeaveRequestService_BatchCreate_FullMethodName = "/entpb.LeaveRequestService/BatchCreate"
+	TaskTemplateService_Create_FullMethodName      = "/entpb.TaskTemplateService/Create"
+	TaskTemplateService_Get_FullMethodName         = "/entpb.TaskTemplateService/Get"
+	TaskTemplateService_Update_FullMethodName      = "/entpb.TaskTemplateService/Update"
+	TaskTemplateService_Delete_FullMethodName      = "/entpb.TaskTemplateService/Delete"
+	TaskTemplateService_List_FullMethodName        = "/entpb.TaskTemplateService/List"
+	TaskTemplateService_BatchCreate_FullMethodName = "/entpb.TaskTemplateService/BatchCreate"
 )
 
-// LeaveRequestServiceClient is the client API for LeaveRequestService service.
+// TaskTemplateServiceClient is the client API for TaskTemplateService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type LeaveRequestServiceClient interface {
-	Create(ctx context.Context, in *CreateLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error)
-	Get(ctx context.Context, in *GetLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error)
-	Update(ctx context.Context, in *UpdateLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error)
-	Delete(ctx context.Context, in *DeleteLeaveRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListLeaveRequestRequest, opts ...grpc.CallOption) (*ListLeaveRequestResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateLeaveRequestsRequest, opts ...grpc.CallOption) (*BatchCreateLeaveRequestsResponse, error)
+type TaskTemplateServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskTemplateRequest, opts ...grpc.CallOption) (*TaskTemplate, error)
+	Get(ctx context.Context, in *GetTaskTemplateRequest, opts ...grpc.CallOption) (*TaskTemplate, error)
+	Update(ctx context.Context, in *UpdateTaskTemplateRequest, opts ...grpc.CallOption) (*TaskTemplate, error)
+	Delete(ctx context.Context, in *DeleteTaskTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskTemplateRequest, opts ...grpc.CallOption) (*ListTaskTemplateResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskTemplatesRequest, opts ...grpc.CallOption) (*BatchCreateTaskTemplatesResponse, error)
 }
 
-type leaveRequestServiceClient struct {
+type taskTemplateServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewLeaveRequestServiceClient(cc grpc.ClientConnInterface) LeaveRequestServiceClient {
-	return &leaveRequestServiceClient{cc}
+func NewTaskTemplateServiceClient(cc grpc.ClientConnInterface) TaskTemplateServiceClient {
+	return &taskTemplateServiceClient{cc}
 }
 
-func (c *leaveRequestServiceClient) Create(ctx context.Context, in *CreateLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error) {
+func (c *taskTemplateServiceClient) Create(ctx context.Context, in *CreateTaskTemplateRequest, opts ...grpc.CallOption) (*TaskTemplate, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(LeaveRequest)
-	err := c.cc.Invoke(ctx, LeaveRequestService_Create_FullMethodName, in, out, cOpts...)
+	out := new(TaskTemplate)
+	err := c.cc.Invoke(ctx, TaskTemplateService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveRequestServiceClient) Get(ctx context.Context, in *GetLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error) {
+func (c *taskTemplateServiceClient) Get(ctx context.Context, in *GetTaskTemplateRequest, opts ...grpc.CallOption) (*TaskTemplate, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(LeaveRequest)
-	err := c.cc.Invoke(ctx, LeaveRequestService_Get_FullMethodName, in, out, cOpts...)
+	out := new(TaskTemplate)
+	err := c.cc.Invoke(ctx, TaskTemplateService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveRequestServiceClient) Update(ctx context.Context, in *UpdateLeaveRequestRequest, opts ...grpc.CallOption) (*LeaveRequest, error) {
+func (c *taskTemplateServiceClient) Update(ctx context.Context, in *UpdateTaskTemplateRequest, opts ...grpc.CallOption) (*TaskTemplate, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(LeaveRequest)
-	err := c.cc.Invoke(ctx, LeaveRequestService_Update_FullMethodName, in, out, cOpts...)
+	out := new(TaskTemplate)
+	err := c.cc.Invoke(ctx, TaskTemplateService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveRequestServiceClient) Delete(ctx context.Context, in *DeleteLeaveRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *taskTemplateServiceClient) Delete(ctx context.Context, in *DeleteTaskTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, LeaveRequestService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, TaskTemplateService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveRequestServiceClient) List(ctx context.Context, in *ListLeaveRequestRequest, opts ...grpc.CallOption) (*ListLeaveRequestResponse, error) {
+func (c *taskTemplateServiceClient) List(ctx context.Context, in *ListTaskTemplateRequest, opts ...grpc.CallOption) (*ListTaskTemplateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListLeaveRequestResponse)
-	err := c.cc.Invoke(ctx, LeaveRequestService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListTaskTemplateResponse)
+	err := c.cc.Invoke(ctx, TaskTemplateService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaveRequestServiceClient) BatchCreate(ctx context.Context, in *BatchCreateLeaveRequestsRequest, opts ...grpc.CallOption) (*BatchCreateLeaveRequestsResponse, error) {
+func (c *taskTemplateServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskTemplatesRequest, opts ...grpc.CallOption) (*BatchCreateTaskTemplatesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateLeaveRequestsResponse)
-	err := c.cc.Invoke(ctx, LeaveRequestService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateTaskTemplatesResponse)
+	err := c.cc.Invoke(ctx, TaskTemplateService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// LeaveRequestServiceServer is the server API for LeaveRequestService service.
-// All implementations must embed UnimplementedLeaveRequestServiceServer
+// TaskTemplateServiceServer is the server API for TaskTemplateService service.
+// All implementations must embed UnimplementedTaskTemplateServiceServer
 // for forward compatibility.
-type LeaveRequestServiceServer interface {
-	Create(context.Context, *CreateLeaveRequestRequest) (*LeaveRequest, error)
-	Get(context.Context, *GetLeaveRequestRequest) (*LeaveRequest, error)
-	Update(context.Context, *UpdateLeaveRequestRequest) (*LeaveRequest, error)
-	Delete(context.Context, *DeleteLeaveRequestRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListLeaveRequestRequest) (*ListLeaveRequestResponse, error)
-	BatchCreate(context.Context, *BatchCreateLeaveRequestsRequest) (*BatchCreateLeaveRequestsResponse, error)
-	mustEmbedUnimplementedLeaveRequestServiceServer()
+type TaskTemplateServiceServer interface {
+	Create(context.Context, *CreateTaskTemplateRequest) (*TaskTemplate, error)
+	Get(context.Context, *GetTaskTemplateRequest) (*TaskTemplate, error)
+	Update(context.Context, *UpdateTaskTemplateRequest) (*TaskTemplate, error)
+	Delete(context.Context, *DeleteTaskTemplateRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskTemplateRequest) (*ListTaskTemplateResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskTemplatesRequest) (*BatchCreateTaskTemplatesResponse, error)
+	mustEmbedUnimplementedTaskTemplateServiceServer()
 }
 
-// UnimplementedLeaveRequestServiceServer must be embedded to have
+// UnimplementedTaskTemplateServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedLeaveRequestServiceServer struct{}
+type UnimplementedTaskTemplateServiceServer struct{}
 
-func (UnimplementedLeaveRequestServiceServer) Create(context.Context, *CreateLeaveRequestRequest) (*LeaveRequest, error) {
+func (UnimplementedTaskTemplateServiceServer) Create(context.Context, *CreateTaskTemplateRequest) (*TaskTemplate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedLeaveRequestServiceServer) Get(context.Context, *GetLeaveRequestRequest) (*LeaveRequest, error) {
+func (UnimplementedTaskTemplateServiceServer) Get(context.Context, *GetTaskTemplateRequest) (*TaskTemplate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedLeaveRequestServiceServer) Update(context.Context, *UpdateLeaveRequestRequest) (*LeaveRequest, error) {
+func (UnimplementedTaskTemplateServiceServer) Update(context.Context, *UpdateTaskTemplateRequest) (*TaskTemplate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedLeaveRequestServiceServer) Delete(context.Context, *DeleteLeaveRequestRequest) (*emptypb.Empty, error) {
+func (UnimplementedTaskTemplateServiceServer) Delete(context.Context, *DeleteTaskTemplateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedLeaveRequestServiceServer) List(context.Context, *ListLeaveRequestRequest) (*ListLeaveRequestResponse, error) {
+func (UnimplementedTaskTemplateServiceServer) List(context.Context, *ListTaskTemplateRequest) (*ListTaskTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedLeaveRequestServiceServer) BatchCreate(context.Context, *BatchCreateLeaveRequestsRequest) (*BatchCreateLeaveRequestsResponse, error) {
+func (UnimplementedTaskTemplateServiceServer) BatchCreate(context.Context, *BatchCreateTaskTemplatesRequest) (*BatchCreateTaskTemplatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedLeaveRequestServiceServer) mustEmbedUnimplementedLeaveRequestServiceServer() {}
-func (UnimplementedLeaveRequestServiceServer) testEmbeddedByValue()                             {}
+func (UnimplementedTaskTemplateServiceServer) mustEmbedUnimplementedTaskTemplateServiceServer() {}
+func (UnimplementedTaskTemplateServiceServer) testEmbeddedByValue()                             {}
 
-// UnsafeLeaveRequestServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LeaveRequestServiceServer will
+// UnsafeTaskTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskTemplateServiceServer will
 // result in compilation errors.
-type UnsafeLeaveRequestServiceServer interface {
-	mustEmbedUnimplementedLeaveRequestServiceServer()
+type UnsafeTaskTemplateServiceServer interface {
+	mustEmbedUnimplementedTaskTemplateServiceServer()
 }
 
-func RegisterLeaveRequestServiceServer(s grpc.ServiceRegistrar, srv LeaveRequestServiceServer) {
-	// If the following call pancis, it indicates UnimplementedLeaveRequestServiceServer was
+func RegisterTaskTemplateServiceServer(s grpc.ServiceRegistrar, srv TaskTemplateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskTemplateServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&LeaveRequestService_ServiceDesc, srv)
+	s.RegisterService(&TaskTemplateService_ServiceDesc, srv)
 }
 
-func _LeaveRequestService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateLeaveRequestRequest)
+func _TaskTemplateService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveRequestServiceServer).Create(ctx, in)
+		return srv.(TaskTemplateServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveRequestService_Create_FullMethodName,
+		FullMethod: TaskTemplateService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveRequestServiceServer).Create(ctx, req.(*CreateLeaveRequestRequest))
+		return srv.(TaskTemplateServiceServer).Create(ctx, req.(*CreateTaskTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveRequestService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeaveRequestRequest)
+func _TaskTemplateService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveRequestServiceServer).Get(ctx, in)
+		return srv.(TaskTemplateServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveRequestService_Get_FullMethodName,
+		FullMethod: TaskTemplateService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveRequestServiceServer).Get(ctx, req.(*GetLeaveRequestRequest))
+		return srv.(TaskTemplateServiceServer).Get(ctx, req.(*GetTaskTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveRequestService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLeaveRequestRequest)
+func _TaskTemplateService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveRequestServiceServer).Update(ctx, in)
+		return srv.(TaskTemplateServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveRequestService_Update_FullMethodName,
+		FullMethod: TaskTemplateService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveRequestServiceServer).Update(ctx, req.(*UpdateLeaveRequestRequest))
+		return srv.(TaskTemplateServiceServer).Update(ctx, req.(*UpdateTaskTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveRequestService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLeaveRequestRequest)
+func _TaskTemplateService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveRequestServiceServer).Delete(ctx, in)
+		return srv.(TaskTemplateServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveRequestService_Delete_FullMethodName,
+		FullMethod: TaskTemplateService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveRequestServiceServer).Delete(ctx, req.(*DeleteLeaveRequestRequest))
+		return srv.(TaskTemplateServiceServer).Delete(ctx, req.(*DeleteTaskTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveRequestService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLeaveRequestRequest)
+func _TaskTemplateService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveRequestServiceServer).List(ctx, in)
+		return srv.(TaskTemplateServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveRequestService_List_FullMethodName,
+		FullMethod: TaskTemplateService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveRequestServiceServer).List(ctx, req.(*ListLeaveRequestRequest))
+		return srv.(TaskTemplateServiceServer).List(ctx, req.(*ListTaskTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LeaveRequestService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateLeaveRequestsRequest)
+func _TaskTemplateService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LeaveRequestServiceServer).BatchCreate(ctx, in)
+		return srv.(TaskTemplateServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LeaveRequestService_BatchCreate_FullMethodName,
+		FullMethod: TaskTemplateService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaveRequestServiceServer).BatchCreate(ctx, req.(*BatchCreateLeaveRequestsRequest))
+		return srv.(TaskTemplateServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// LeaveRequestService_ServiceDesc is the grpc.ServiceDesc for LeaveRequestService service.
+// TaskTemplateService_ServiceDesc is the grpc.ServiceDesc for TaskTemplateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var LeaveRequestService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.LeaveRequestService",
-	HandlerType: (*LeaveRequestServiceServer)(nil),
+var TaskTemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskTemplateService",
+	HandlerType: (*TaskTemplateServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _LeaveRequestService_Create_Handler,
+			Handler:    _TaskTemplateService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _LeaveRequestService_Get_Handler,
+			Handler:    _TaskTemplateService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _LeaveRequestService_Update_Handler,
+			Handler:    _TaskTemplateService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _LeaveRequestService_Delete_Handler,
+			Handler:    _TaskTemplateService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _LeaveRequestService_List_Handler,
+			Handler:    _TaskTemplateService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _LeaveRequestService_BatchCreate_Handler,
+			Handler:    _TaskTemplateService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1775,291 +10761,291 @@ var LeaveRequestService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	OrganizationService_Create_FullMethodName      = "/entpb.OrganizationService/Create"
-	OrganizationService_Get_FullMethodName         = "/entpb.OrganizationService/Get"
-	OrganizationService_Update_FullMethodName      = "/entpb.OrganizationService/Update"
-	OrganizationService_Delete_FullMethodName      = "/entpb.OrganizationService/Delete"
-	OrganizationService_List_FullMethodName        = "/entpb.OrganizationService/List"
-	OrganizationService_BatchCreate_FullMethodName = "/entpb.OrganizationService/BatchCreate"
+	TaskViewService_Create_FullMethodName      = "/entpb.TaskViewService/Create"
+	TaskViewService_Get_FullMethodName         = "/entpb.TaskViewService/Get"
+	TaskViewService_Update_FullMethodName      = "/entpb.TaskViewService/Update"
+	TaskViewService_Delete_FullMethodName      = "/entpb.TaskViewService/Delete"
+	TaskViewService_List_FullMethodName        = "/entpb.TaskViewService/List"
+	TaskViewService_BatchCreate_FullMethodName = "/entpb.TaskViewService/BatchCreate"
 )
 
-// OrganizationServiceClient is the client API for OrganizationService service.
+// TaskViewServiceClient is the client API for TaskViewService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type OrganizationServiceClient interface {
-	Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
-	Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
-	Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
-	Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateOrganizationsRequest, opts ...grpc.CallOption) (*BatchCreateOrganizationsResponse, error)
+type TaskViewServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskViewRequest, opts ...grpc.CallOption) (*TaskView, error)
+	Get(ctx context.Context, in *GetTaskViewRequest, opts ...grpc.CallOption) (*TaskView, error)
+	Update(ctx context.Context, in *UpdateTaskViewRequest, opts ...grpc.CallOption) (*TaskView, error)
+	Delete(ctx context.Context, in *DeleteTaskViewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskViewRequest, opts ...grpc.CallOption) (*ListTaskViewResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskViewsRequest, opts ...grpc.CallOption) (*BatchCreateTaskViewsResponse, error)
 }
 
-type organizationServiceClient struct {
+type taskViewServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewOrganizationServiceClient(cc grpc.ClientConnInterface) OrganizationServiceClient {
-	return &organizationServiceClient{cc}
+func NewTaskViewServiceClient(cc grpc.ClientConnInterface) TaskViewServiceClient {
+	return &taskViewServiceClient{cc}
 }
 
-func (c *organizationServiceClient) Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+func (c *taskViewServiceClient) Create(ctx context.Context, in *CreateTaskViewRequest, opts ...grpc.CallOption) (*TaskView, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Organization)
-	err := c.cc.Invoke(ctx, OrganizationService_Create_FullMethodName, in, out, cOpts...)
+	out := new(TaskView)
+	err := c.cc.Invoke(ctx, TaskViewService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+func (c *taskViewServiceClient) Get(ctx context.Context, in *GetTaskViewRequest, opts ...grpc.CallOption) (*TaskView, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Organization)
-	err := c.cc.Invoke(ctx, OrganizationService_Get_FullMethodName, in, out, cOpts...)
+	out := new(TaskView)
+	err := c.cc.Invoke(ctx, TaskViewService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+func (c *taskViewServiceClient) Update(ctx context.Context, in *UpdateTaskViewRequest, opts ...grpc.CallOption) (*TaskView, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Organization)
-	err := c.cc.Invoke(ctx, OrganizationService_Update_FullMethodName, in, out, cOpts...)
+	out := new(TaskView)
+	err := c.cc.Invoke(ctx, TaskViewService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *taskViewServiceClient) Delete(ctx context.Context, in *DeleteTaskViewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, OrganizationService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, TaskViewService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error) {
+func (c *taskViewServiceClient) List(ctx context.Context, in *ListTaskViewRequest, opts ...grpc.CallOption) (*ListTaskViewResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListOrganizationResponse)
-	err := c.cc.Invoke(ctx, OrganizationService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListTaskViewResponse)
+	err := c.cc.Invoke(ctx, TaskViewService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *organizationServiceClient) BatchCreate(ctx context.Context, in *BatchCreateOrganizationsRequest, opts ...grpc.CallOption) (*BatchCreateOrganizationsResponse, error) {
+func (c *taskViewServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskViewsRequest, opts ...grpc.CallOption) (*BatchCreateTaskViewsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateOrganizationsResponse)
-	err := c.cc.Invoke(ctx, OrganizationService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateTaskViewsResponse)
+	err := c.cc.Invoke(ctx, TaskViewService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// OrganizationServiceServer is the server API for OrganizationService service.
-// All implementations must embed UnimplementedOrganizationServiceServer
+// TaskViewServiceServer is the server API for TaskViewService service.
+// All implementations must embed UnimplementedTaskViewServiceServer
 // for forward compatibility.
-type OrganizationServiceServer interface {
-	Create(context.Context, *CreateOrganizationRequest) (*Organization, error)
-	Get(context.Context, *GetOrganizationRequest) (*Organization, error)
-	Update(context.Context, *UpdateOrganizationRequest) (*Organization, error)
-	Delete(context.Context, *DeleteOrganizationRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListOrganizationRequest) (*ListOrganizationResponse, error)
-	BatchCreate(context.Context, *BatchCreateOrganizationsRequest) (*BatchCreateOrganizationsResponse, error)
-	mustEmbedUnimplementedOrganizationServiceServer()
+type TaskViewServiceServer interface {
+	Create(context.Context, *CreateTaskViewRequest) (*TaskView, error)
+	Get(context.Context, *GetTaskViewRequest) (*TaskView, error)
+	Update(context.Context, *UpdateTaskViewRequest) (*TaskView, error)
+	Delete(context.Context, *DeleteTaskViewRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskViewRequest) (*ListTaskViewResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskViewsRequest) (*BatchCreateTaskViewsResponse, error)
+	mustEmbedUnimplementedTaskViewServiceServer()
 }
 
-// UnimplementedOrganizationServiceServer must be embedded to have
+// UnimplementedTaskViewServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedOrganizationServiceServer struct{}
+type UnimplementedTaskViewServiceServer struct{}
 
-func (UnimplementedOrganizationServiceServer) Create(context.Context, *CreateOrganizationRequest) (*Organization, error) {
+func (UnimplementedTaskViewServiceServer) Create(context.Context, *CreateTaskViewRequest) (*TaskView, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedOrganizationServiceServer) Get(context.Context, *GetOrganizationRequest) (*Organization, error) {
+func (UnimplementedTaskViewServiceServer) Get(context.Context, *GetTaskViewRequest) (*TaskView, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedOrganizationServiceServer) Update(context.Context, *UpdateOrganizationRequest) (*Organization, error) {
+func (UnimplementedTaskViewServiceServer) Update(context.Context, *UpdateTaskViewRequest) (*TaskView, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedOrganizationServiceServer) Delete(context.Context, *DeleteOrganizationRequest) (*emptypb.Empty, error) {
+func (UnimplementedTaskViewServiceServer) Delete(context.Context, *DeleteTaskViewRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedOrganizationServiceServer) List(context.Context, *ListOrganizationRequest) (*ListOrganizationResponse, error) {
+func (UnimplementedTaskViewServiceServer) List(context.Context, *ListTaskViewRequest) (*ListTaskViewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedOrganizationServiceServer) BatchCreate(context.Context, *BatchCreateOrganizationsRequest) (*BatchCreateOrganizationsResponse, error) {
+func (UnimplementedTaskViewServiceServer) BatchCreate(context.Context, *BatchCreateTaskViewsRequest) (*BatchCreateTaskViewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
-func (UnimplementedOrganizationServiceServer) testEmbeddedByValue()                             {}
+func (UnimplementedTaskViewServiceServer) mustEmbedUnimplementedTaskViewServiceServer() {}
+func (UnimplementedTaskViewServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeOrganizationServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OrganizationServiceServer will
+// UnsafeTaskViewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskViewServiceServer will
 // result in compilation errors.
-type UnsafeOrganizationServiceServer interface {
-	mustEmbedUnimplementedOrganizationServiceServer()
+type UnsafeTaskViewServiceServer interface {
+	mustEmbedUnimplementedTaskViewServiceServer()
 }
 
-func RegisterOrganizationServiceServer(s grpc.ServiceRegistrar, srv OrganizationServiceServer) {
-	// If the following call pancis, it indicates UnimplementedOrganizationServiceServer was
+func RegisterTaskViewServiceServer(s grpc.ServiceRegistrar, srv TaskViewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskViewServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&OrganizationService_ServiceDesc, srv)
+	s.RegisterService(&TaskViewService_ServiceDesc, srv)
 }
 
-func _OrganizationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrganizationRequest)
+func _TaskViewService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).Create(ctx, in)
+		return srv.(TaskViewServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_Create_FullMethodName,
+		FullMethod: TaskViewService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).Create(ctx, req.(*CreateOrganizationRequest))
+		return srv.(TaskViewServiceServer).Create(ctx, req.(*CreateTaskViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrganizationRequest)
+func _TaskViewService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).Get(ctx, in)
+		return srv.(TaskViewServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_Get_FullMethodName,
+		FullMethod: TaskViewService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).Get(ctx, req.(*GetOrganizationRequest))
+		return srv.(TaskViewServiceServer).Get(ctx, req.(*GetTaskViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrganizationRequest)
+func _TaskViewService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).Update(ctx, in)
+		return srv.(TaskViewServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_Update_FullMethodName,
+		FullMethod: TaskViewService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).Update(ctx, req.(*UpdateOrganizationRequest))
+		return srv.(TaskViewServiceServer).Update(ctx, req.(*UpdateTaskViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOrganizationRequest)
+func _TaskViewService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).Delete(ctx, in)
+		return srv.(TaskViewServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_Delete_FullMethodName,
+		FullMethod: TaskViewService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).Delete(ctx, req.(*DeleteOrganizationRequest))
+		return srv.(TaskViewServiceServer).Delete(ctx, req.(*DeleteTaskViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrganizationRequest)
+func _TaskViewService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskViewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).List(ctx, in)
+		return srv.(TaskViewServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_List_FullMethodName,
+		FullMethod: TaskViewService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).List(ctx, req.(*ListOrganizationRequest))
+		return srv.(TaskViewServiceServer).List(ctx, req.(*ListTaskViewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateOrganizationsRequest)
+func _TaskViewService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskViewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).BatchCreate(ctx, in)
+		return srv.(TaskViewServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrganizationService_BatchCreate_FullMethodName,
+		FullMethod: TaskViewService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).BatchCreate(ctx, req.(*BatchCreateOrganizationsRequest))
+		return srv.(TaskViewServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskViewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// OrganizationService_ServiceDesc is the grpc.ServiceDesc for OrganizationService service.
+// TaskViewService_ServiceDesc is the grpc.ServiceDesc for TaskViewService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var OrganizationService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.OrganizationService",
-	HandlerType: (*OrganizationServiceServer)(nil),
+var TaskViewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskViewService",
+	HandlerType: (*TaskViewServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _OrganizationService_Create_Handler,
+			Handler:    _TaskViewService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _OrganizationService_Get_Handler,
+			Handler:    _TaskViewService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _OrganizationService_Update_Handler,
+			Handler:    _TaskViewService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _OrganizationService_Delete_Handler,
+			Handler:    _TaskViewService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _OrganizationService_List_Handler,
+			Handler:    _TaskViewService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _OrganizationService_BatchCreate_Handler,
+			Handler:    _TaskViewService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2067,291 +11053,291 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	PositionService_Create_FullMethodName      = "/entpb.PositionService/Create"
-	PositionService_Get_FullMethodName         = "/entpb.PositionService/Get"
-	PositionService_Update_FullMethodName      = "/entpb.PositionService/Update"
-	PositionService_Delete_FullMethodName      = "/entpb.PositionService/Delete"
-	PositionService_List_FullMethodName        = "/entpb.PositionService/List"
-	PositionService_BatchCreate_FullMethodName = "/entpb.PositionService/BatchCreate"
+	TaskWatcherService_Create_FullMethodName      = "/entpb.TaskWatcherService/Create"
+	TaskWatcherService_Get_FullMethodName         = "/entpb.TaskWatcherService/Get"
+	TaskWatcherService_Update_FullMethodName      = "/entpb.TaskWatcherService/Update"
+	TaskWatcherService_Delete_FullMethodName      = "/entpb.TaskWatcherService/Delete"
+	TaskWatcherService_List_FullMethodName        = "/entpb.TaskWatcherService/List"
+	TaskWatcherService_BatchCreate_FullMethodName = "/entpb.TaskWatcherService/BatchCreate"
 )
 
-// PositionServiceClient is the client API for PositionService service.
+// TaskWatcherServiceClient is the client API for TaskWatcherService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PositionServiceClient interface {
-	Create(ctx context.Context, in *CreatePositionRequest, opts ...grpc.CallOption) (*Position, error)
-	Get(ctx context.Context, in *GetPositionRequest, opts ...grpc.CallOption) (*Position, error)
-	Update(ctx context.Context, in *UpdatePositionRequest, opts ...grpc.CallOption) (*Position, error)
-	Delete(ctx context.Context, in *DeletePositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListPositionRequest, opts ...grpc.CallOption) (*ListPositionResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreatePositionsRequest, opts ...grpc.CallOption) (*BatchCreatePositionsResponse, error)
+type TaskWatcherServiceClient interface {
+	Create(ctx context.Context, in *CreateTaskWatcherRequest, opts ...grpc.CallOption) (*TaskWatcher, error)
+	Get(ctx context.Context, in *GetTaskWatcherRequest, opts ...grpc.CallOption) (*TaskWatcher, error)
+	Update(ctx context.Context, in *UpdateTaskWatcherRequest, opts ...grpc.CallOption) (*TaskWatcher, error)
+	Delete(ctx context.Context, in *DeleteTaskWatcherRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListTaskWatcherRequest, opts ...grpc.CallOption) (*ListTaskWatcherResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateTaskWatchersRequest, opts ...grpc.CallOption) (*BatchCreateTaskWatchersResponse, error)
 }
 
-type positionServiceClient struct {
+type taskWatcherServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPositionServiceClient(cc grpc.ClientConnInterface) PositionServiceClient {
-	return &positionServiceClient{cc}
+func NewTaskWatcherServiceClient(cc grpc.ClientConnInterface) TaskWatcherServiceClient {
+	return &taskWatcherServiceClient{cc}
 }
 
-func (c *positionServiceClient) Create(ctx context.Context, in *CreatePositionRequest, opts ...grpc.CallOption) (*Position, error) {
+func (c *taskWatcherServiceClient) Create(ctx context.Context, in *CreateTaskWatcherRequest, opts ...grpc.CallOption) (*TaskWatcher, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Position)
-	err := c.cc.Invoke(ctx, PositionService_Create_FullMethodName, in, out, cOpts...)
+	out := new(TaskWatcher)
+	err := c.cc.Invoke(ctx, TaskWatcherService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *positionServiceClient) Get(ctx context.Context, in *GetPositionRequest, opts ...grpc.CallOption) (*Position, error) {
+func (c *taskWatcherServiceClient) Get(ctx context.Context, in *GetTaskWatcherRequest, opts ...grpc.CallOption) (*TaskWatcher, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Position)
-	err := c.cc.Invoke(ctx, PositionService_Get_FullMethodName, in, out, cOpts...)
+	out := new(TaskWatcher)
+	err := c.cc.Invoke(ctx, TaskWatcherService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *positionServiceClient) Update(ctx context.Context, in *UpdatePositionRequest, opts ...grpc.CallOption) (*Position, error) {
+func (c *taskWatcherServiceClient) Update(ctx context.Context, in *UpdateTaskWatcherRequest, opts ...grpc.CallOption) (*TaskWatcher, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Position)
-	err := c.cc.Invoke(ctx, PositionService_Update_FullMethodName, in, out, cOpts...)
+	out := new(TaskWatcher)
+	err := c.cc.Invoke(ctx, TaskWatcherService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *positionServiceClient) Delete(ctx context.Context, in *DeletePositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *taskWatcherServiceClient) Delete(ctx context.Context, in *DeleteTaskWatcherRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, PositionService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, TaskWatcherService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *positionServiceClient) List(ctx context.Context, in *ListPositionRequest, opts ...grpc.CallOption) (*ListPositionResponse, error) {
+func (c *taskWatcherServiceClient) List(ctx context.Context, in *ListTaskWatcherRequest, opts ...grpc.CallOption) (*ListTaskWatcherResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListPositionResponse)
-	err := c.cc.Invoke(ctx, PositionService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListTaskWatcherResponse)
+	err := c.cc.Invoke(ctx, TaskWatcherService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *positionServiceClient) BatchCreate(ctx context.Context, in *BatchCreatePositionsRequest, opts ...grpc.CallOption) (*BatchCreatePositionsResponse, error) {
+func (c *taskWatcherServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskWatchersRequest, opts ...grpc.CallOption) (*BatchCreateTaskWatchersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreatePositionsResponse)
-	err := c.cc.Invoke(ctx, PositionService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateTaskWatchersResponse)
+	err := c.cc.Invoke(ctx, TaskWatcherService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PositionServiceServer is the server API for PositionService service.
-// All implementations must embed UnimplementedPositionServiceServer
+// TaskWatcherServiceServer is the server API for TaskWatcherService service.
+// All implementations must embed UnimplementedTaskWatcherServiceServer
 // for forward compatibility.
-type PositionServiceServer interface {
-	Create(context.Context, *CreatePositionRequest) (*Position, error)
-	Get(context.Context, *GetPositionRequest) (*Position, error)
-	Update(context.Context, *UpdatePositionRequest) (*Position, error)
-	Delete(context.Context, *DeletePositionRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListPositionRequest) (*ListPositionResponse, error)
-	BatchCreate(context.Context, *BatchCreatePositionsRequest) (*BatchCreatePositionsResponse, error)
-	mustEmbedUnimplementedPositionServiceServer()
+type TaskWatcherServiceServer interface {
+	Create(context.Context, *CreateTaskWatcherRequest) (*TaskWatcher, error)
+	Get(context.Context, *GetTaskWatcherRequest) (*TaskWatcher, error)
+	Update(context.Context, *UpdateTaskWatcherRequest) (*TaskWatcher, error)
+	Delete(context.Context, *DeleteTaskWatcherRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListTaskWatcherRequest) (*ListTaskWatcherResponse, error)
+	BatchCreate(context.Context, *BatchCreateTaskWatchersRequest) (*BatchCreateTaskWatchersResponse, error)
+	mustEmbedUnimplementedTaskWatcherServiceServer()
 }
 
-// UnimplementedPositionServiceServer must be embedded to have
+// UnimplementedTaskWatcherServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPositionServiceServer struct{}
+type UnimplementedTaskWatcherServiceServer struct{}
 
-func (UnimplementedPositionServiceServer) Create(context.Context, *CreatePositionRequest) (*Position, error) {
+func (UnimplementedTaskWatcherServiceServer) Create(context.Context, *CreateTaskWatcherRequest) (*TaskWatcher, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedPositionServiceServer) Get(context.Context, *GetPositionRequest) (*Position, error) {
+func (UnimplementedTaskWatcherServiceServer) Get(context.Context, *GetTaskWatcherRequest) (*TaskWatcher, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedPositionServiceServer) Update(context.Context, *UpdatePositionRequest) (*Position, error) {
+func (UnimplementedTaskWatcherServiceServer) Update(context.Context, *UpdateTaskWatcherRequest) (*TaskWatcher, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedPositionServiceServer) Delete(context.Context, *DeletePositionRequest) (*emptypb.Empty, error) {
+func (UnimplementedTaskWatcherServiceServer) Delete(context.Context, *DeleteTaskWatcherRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedPositionServiceServer) List(context.Context, *ListPositionRequest) (*ListPositionResponse, error) {
+func (UnimplementedTaskWatcherServiceServer) List(context.Context, *ListTaskWatcherRequest) (*ListTaskWatcherResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedPositionServiceServer) BatchCreate(context.Context, *BatchCreatePositionsRequest) (*BatchCreatePositionsResponse, error) {
+func (UnimplementedTaskWatcherServiceServer) BatchCreate(context.Context, *BatchCreateTaskWatchersRequest) (*BatchCreateTaskWatchersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedPositionServiceServer) mustEmbedUnimplementedPositionServiceServer() {}
-func (UnimplementedPositionServiceServer) testEmbeddedByValue()                         {}
+func (UnimplementedTaskWatcherServiceServer) mustEmbedUnimplementedTaskWatcherServiceServer() {}
+func (UnimplementedTaskWatcherServiceServer) testEmbeddedByValue()                            {}
 
-// UnsafePositionServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PositionServiceServer will
+// UnsafeTaskWatcherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskWatcherServiceServer will
 // result in compilation errors.
-type UnsafePositionServiceServer interface {
-	mustEmbedUnimplementedPositionServiceServer()
+type UnsafeTaskWatcherServiceServer interface {
+	mustEmbedUnimplementedTaskWatcherServiceServer()
 }
 
-func RegisterPositionServiceServer(s grpc.ServiceRegistrar, srv PositionServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPositionServiceServer was
+func RegisterTaskWatcherServiceServer(s grpc.ServiceRegistrar, srv TaskWatcherServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaskWatcherServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&PositionService_ServiceDesc, srv)
+	s.RegisterService(&TaskWatcherService_ServiceDesc, srv)
 }
 
-func _PositionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePositionRequest)
+func _TaskWatcherService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskWatcherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PositionServiceServer).Create(ctx, in)
+		return srv.(TaskWatcherServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PositionService_Create_FullMethodName,
+		FullMethod: TaskWatcherService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PositionServiceServer).Create(ctx, req.(*CreatePositionRequest))
+		return srv.(TaskWatcherServiceServer).Create(ctx, req.(*CreateTaskWatcherRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PositionService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPositionRequest)
+func _TaskWatcherService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskWatcherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PositionServiceServer).Get(ctx, in)
+		return srv.(TaskWatcherServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PositionService_Get_FullMethodName,
+		FullMethod: TaskWatcherService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PositionServiceServer).Get(ctx, req.(*GetPositionRequest))
+		return srv.(TaskWatcherServiceServer).Get(ctx, req.(*GetTaskWatcherRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PositionService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePositionRequest)
+func _TaskWatcherService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskWatcherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PositionServiceServer).Update(ctx, in)
+		return srv.(TaskWatcherServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PositionService_Update_FullMethodName,
+		FullMethod: TaskWatcherService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PositionServiceServer).Update(ctx, req.(*UpdatePositionRequest))
+		return srv.(TaskWatcherServiceServer).Update(ctx, req.(*UpdateTaskWatcherRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PositionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePositionRequest)
+func _TaskWatcherService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskWatcherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PositionServiceServer).Delete(ctx, in)
+		return srv.(TaskWatcherServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PositionService_Delete_FullMethodName,
+		FullMethod: TaskWatcherService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PositionServiceServer).Delete(ctx, req.(*DeletePositionRequest))
+		return srv.(TaskWatcherServiceServer).Delete(ctx, req.(*DeleteTaskWatcherRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PositionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPositionRequest)
+func _TaskWatcherService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskWatcherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PositionServiceServer).List(ctx, in)
+		return srv.(TaskWatcherServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PositionService_List_FullMethodName,
+		FullMethod: TaskWatcherService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PositionServiceServer).List(ctx, req.(*ListPositionRequest))
+		return srv.(TaskWatcherServiceServer).List(ctx, req.(*ListTaskWatcherRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PositionService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreatePositionsRequest)
+func _TaskWatcherService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTaskWatchersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PositionServiceServer).BatchCreate(ctx, in)
+		return srv.(TaskWatcherServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PositionService_BatchCreate_FullMethodName,
+		FullMethod: TaskWatcherService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PositionServiceServer).BatchCreate(ctx, req.(*BatchCreatePositionsRequest))
+		return srv.(TaskWatcherServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskWatchersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PositionService_ServiceDesc is the grpc.ServiceDesc for PositionService service.
+// TaskWatcherService_ServiceDesc is the grpc.ServiceDesc for TaskWatcherService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var PositionService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.PositionService",
-	HandlerType: (*PositionServiceServer)(nil),
+var TaskWatcherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.TaskWatcherService",
+	HandlerType: (*TaskWatcherServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _PositionService_Create_Handler,
+			Handler:    _TaskWatcherService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _PositionService_Get_Handler,
+			Handler:    _TaskWatcherService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _PositionService_Update_Handler,
+			Handler:    _TaskWatcherService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _PositionService_Delete_Handler,
+			Handler:    _TaskWatcherService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _PositionService_List_Handler,
+			Handler:    _TaskWatcherService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _PositionService_BatchCreate_Handler,
+			Handler:    _TaskWatcherService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2359,291 +11345,291 @@ var PositionService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ProjectService_Create_FullMethodName      = "/entpb.ProjectService/Create"
-	ProjectService_Get_FullMethodName         = "/entpb.ProjectService/Get"
-	ProjectService_Update_FullMethodName      = "/entpb.ProjectService/Update"
-	ProjectService_Delete_FullMethodName      = "/entpb.ProjectService/Delete"
-	ProjectService_List_FullMethodName        = "/entpb.ProjectService/List"
-	ProjectService_BatchCreate_FullMethodName = "/entpb.ProjectService/BatchCreate"
+	WorkLogService_Create_FullMethodName      = "/entpb.WorkLogService/Create"
+	WorkLogService_Get_FullMethodName         = "/entpb.WorkLogService/Get"
+	WorkLogService_Update_FullMethodName      = "/entpb.WorkLogService/Update"
+	WorkLogService_Delete_FullMethodName      = "/entpb.WorkLogService/Delete"
+	WorkLogService_List_FullMethodName        = "/entpb.WorkLogService/List"
+	WorkLogService_BatchCreate_FullMethodName = "/entpb.WorkLogService/BatchCreate"
 )
 
-// ProjectServiceClient is the client API for ProjectService service.
+// WorkLogServiceClient is the client API for WorkLogService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type ProjectServiceClient interface {
-	Create(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error)
-	Get(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error)
-	Update(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error)
-	Delete(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (*ListProjectResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error)
+type WorkLogServiceClient interface {
+	Create(ctx context.Context, in *CreateWorkLogRequest, opts ...grpc.CallOption) (*WorkLog, error)
+	Get(ctx context.Context, in *GetWorkLogRequest, opts ...grpc.CallOption) (*WorkLog, error)
+	Update(ctx context.Context, in *UpdateWorkLogRequest, opts ...grpc.CallOption) (*WorkLog, error)
+	Delete(ctx context.Context, in *DeleteWorkLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListWorkLogRequest, opts ...grpc.CallOption) (*ListWorkLogResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateWorkLogsRequest, opts ...grpc.CallOption) (*BatchCreateWorkLogsResponse, error)
 }
 
-type projectServiceClient struct {
+type workLogServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewProjectServiceClient(cc grpc.ClientConnInterface) ProjectServiceClient {
-	return &projectServiceClient{cc}
+func NewWorkLogServiceClient(cc grpc.ClientConnInterface) WorkLogServiceClient {
+	return &workLogServiceClient{cc}
 }
 
-func (c *projectServiceClient) Create(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+func (c *workLogServiceClient) Create(ctx context.Context, in *CreateWorkLogRequest, opts ...grpc.CallOption) (*WorkLog, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Project)
-	err := c.cc.Invoke(ctx, ProjectService_Create_FullMethodName, in, out, cOpts...)
+	out := new(WorkLog)
+	err := c.cc.Invoke(ctx, WorkLogService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *projectServiceClient) Get(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+func (c *workLogServiceClient) Get(ctx context.Context, in *GetWorkLogRequest, opts ...grpc.CallOption) (*WorkLog, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Project)
-	err := c.cc.Invoke(ctx, ProjectService_Get_FullMethodName, in, out, cOpts...)
+	out := new(WorkLog)
+	err := c.cc.Invoke(ctx, WorkLogService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *projectServiceClient) Update(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+func (c *workLogServiceClient) Update(ctx context.Context, in *UpdateWorkLogRequest, opts ...grpc.CallOption) (*WorkLog, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Project)
-	err := c.cc.Invoke(ctx, ProjectService_Update_FullMethodName, in, out, cOpts...)
+	out := new(WorkLog)
+	err := c.cc.Invoke(ctx, WorkLogService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *projectServiceClient) Delete(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *workLogServiceClient) Delete(ctx context.Context, in *DeleteWorkLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, ProjectService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, WorkLogService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *projectServiceClient) List(ctx context.Context, in *ListProjectRequest, opts ...grpc.CallOption) (*ListProjectResponse, error) {
+func (c *workLogServiceClient) List(ctx context.Context, in *ListWorkLogRequest, opts ...grpc.CallOption) (*ListWorkLogResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListProjectResponse)
-	err := c.cc.Invoke(ctx, ProjectService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListWorkLogResponse)
+	err := c.cc.Invoke(ctx, WorkLogService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *projectServiceClient) BatchCreate(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error) {
+func (c *workLogServiceClient) BatchCreate(ctx context.Context, in *BatchCreateWorkLogsRequest, opts ...grpc.CallOption) (*BatchCreateWorkLogsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateProjectsResponse)
-	err := c.cc.Invoke(ctx, ProjectService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateWorkLogsResponse)
+	err := c.cc.Invoke(ctx, WorkLogService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ProjectServiceServer is the server API for ProjectService service.
-// All implementations must embed UnimplementedProjectServiceServer
+// WorkLogServiceServer is the server API for WorkLogService service.
+// All implementations must embed UnimplementedWorkLogServiceServer
 // for forward compatibility.
-type ProjectServiceServer interface {
-	Create(context.Context, *CreateProjectRequest) (*Project, error)
-	Get(context.Context, *GetProjectRequest) (*Project, error)
-	Update(context.Context, *UpdateProjectRequest) (*Project, error)
-	Delete(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListProjectRequest) (*ListProjectResponse, error)
-	BatchCreate(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error)
-	mustEmbedUnimplementedProjectServiceServer()
+type WorkLogServiceServer interface {
+	Create(context.Context, *CreateWorkLogRequest) (*WorkLog, error)
+	Get(context.Context, *GetWorkLogRequest) (*WorkLog, error)
+	Update(context.Context, *UpdateWorkLogRequest) (*WorkLog, error)
+	Delete(context.Context, *DeleteWorkLogRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListWorkLogRequest) (*ListWorkLogResponse, error)
+	BatchCreate(context.Context, *BatchCreateWorkLogsRequest) (*BatchCreateWorkLogsResponse, error)
+	mustEmbedUnimplementedWorkLogServiceServer()
 }
 
-// UnimplementedProjectServiceServer must be embedded to have
+// UnimplementedWorkLogServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedProjectServiceServer struct{}
+type UnimplementedWorkLogServiceServer struct{}
 
-func (UnimplementedProjectServiceServer) Create(context.Context, *CreateProjectRequest) (*Project, error) {
+func (UnimplementedWorkLogServiceServer) Create(context.Context, *CreateWorkLogRequest) (*WorkLog, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedProjectServiceServer) Get(context.Context, *GetProjectRequest) (*Project, error) {
+func (UnimplementedWorkLogServiceServer) Get(context.Context, *GetWorkLogRequest) (*WorkLog, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedProjectServiceServer) Update(context.Context, *UpdateProjectRequest) (*Project, error) {
+func (UnimplementedWorkLogServiceServer) Update(context.Context, *UpdateWorkLogRequest) (*WorkLog, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedProjectServiceServer) Delete(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
+func (UnimplementedWorkLogServiceServer) Delete(context.Context, *DeleteWorkLogRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedProjectServiceServer) List(context.Context, *ListProjectRequest) (*ListProjectResponse, error) {
+func (UnimplementedWorkLogServiceServer) List(context.Context, *ListWorkLogRequest) (*ListWorkLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedProjectServiceServer) BatchCreate(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error) {
+func (UnimplementedWorkLogServiceServer) BatchCreate(context.Context, *BatchCreateWorkLogsRequest) (*BatchCreateWorkLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
-func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
+func (UnimplementedWorkLogServiceServer) mustEmbedUnimplementedWorkLogServiceServer() {}
+func (UnimplementedWorkLogServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProjectServiceServer will
+// UnsafeWorkLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkLogServiceServer will
 // result in compilation errors.
-type UnsafeProjectServiceServer interface {
-	mustEmbedUnimplementedProjectServiceServer()
+type UnsafeWorkLogServiceServer interface {
+	mustEmbedUnimplementedWorkLogServiceServer()
 }
 
-func RegisterProjectServiceServer(s grpc.ServiceRegistrar, srv ProjectServiceServer) {
-	// If the following call pancis, it indicates UnimplementedProjectServiceServer was
+func RegisterWorkLogServiceServer(s grpc.ServiceRegistrar, srv WorkLogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorkLogServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&ProjectService_ServiceDesc, srv)
+	s.RegisterService(&WorkLogService_ServiceDesc, srv)
 }
 
-func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _WorkLogService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).Create(ctx, in)
+		return srv.(WorkLogServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_Create_FullMethodName,
+		FullMethod: WorkLogService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).Create(ctx, req.(*CreateProjectRequest))
+		return srv.(WorkLogServiceServer).Create(ctx, req.(*CreateWorkLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectRequest)
+func _WorkLogService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).Get(ctx, in)
+		return srv.(WorkLogServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_Get_FullMethodName,
+		FullMethod: WorkLogService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).Get(ctx, req.(*GetProjectRequest))
+		return srv.(WorkLogServiceServer).Get(ctx, req.(*GetWorkLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
+func _WorkLogService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).Update(ctx, in)
+		return srv.(WorkLogServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_Update_FullMethodName,
+		FullMethod: WorkLogService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).Update(ctx, req.(*UpdateProjectRequest))
+		return srv.(WorkLogServiceServer).Update(ctx, req.(*UpdateWorkLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRequest)
+func _WorkLogService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).Delete(ctx, in)
+		return srv.(WorkLogServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_Delete_FullMethodName,
+		FullMethod: WorkLogService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).Delete(ctx, req.(*DeleteProjectRequest))
+		return srv.(WorkLogServiceServer).Delete(ctx, req.(*DeleteWorkLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectRequest)
+func _WorkLogService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).List(ctx, in)
+		return srv.(WorkLogServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_List_FullMethodName,
+		FullMethod: WorkLogService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).List(ctx, req.(*ListProjectRequest))
+		return srv.(WorkLogServiceServer).List(ctx, req.(*ListWorkLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProjectService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateProjectsRequest)
+func _WorkLogService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateWorkLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProjectServiceServer).BatchCreate(ctx, in)
+		return srv.(WorkLogServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProjectService_BatchCreate_FullMethodName,
+		FullMethod: WorkLogService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProjectServiceServer).BatchCreate(ctx, req.(*BatchCreateProjectsRequest))
+		return srv.(WorkLogServiceServer).BatchCreate(ctx, req.(*BatchCreateWorkLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
+// WorkLogService_ServiceDesc is the grpc.ServiceDesc for WorkLogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var ProjectService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.ProjectService",
-	HandlerType: (*ProjectServiceServer)(nil),
+var WorkLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.WorkLogService",
+	HandlerType: (*WorkLogServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _ProjectService_Create_Handler,
+			Handler:    _WorkLogService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _ProjectService_Get_Handler,
+			Handler:    _WorkLogService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _ProjectService_Update_Handler,
+			Handler:    _WorkLogService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _ProjectService_Delete_Handler,
+			Handler:    _WorkLogService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _ProjectService_List_Handler,
+			Handler:    _WorkLogService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _ProjectService_BatchCreate_Handler,
+			Handler:    _WorkLogService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2651,291 +11637,291 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TaskService_Create_FullMethodName      = "/entpb.TaskService/Create"
-	TaskService_Get_FullMethodName         = "/entpb.TaskService/Get"
-	TaskService_Update_FullMethodName      = "/entpb.TaskService/Update"
-	TaskService_Delete_FullMethodName      = "/entpb.TaskService/Delete"
-	TaskService_List_FullMethodName        = "/entpb.TaskService/List"
-	TaskService_BatchCreate_FullMethodName = "/entpb.TaskService/BatchCreate"
+	WorkflowStatusService_Create_FullMethodName      = "/entpb.WorkflowStatusService/Create"
+	WorkflowStatusService_Get_FullMethodName         = "/entpb.WorkflowStatusService/Get"
+	WorkflowStatusService_Update_FullMethodName      = "/entpb.WorkflowStatusService/Update"
+	WorkflowStatusService_Delete_FullMethodName      = "/entpb.WorkflowStatusService/Delete"
+	WorkflowStatusService_List_FullMethodName        = "/entpb.WorkflowStatusService/List"
+	WorkflowStatusService_BatchCreate_FullMethodName = "/entpb.WorkflowStatusService/BatchCreate"
 )
 
-// TaskServiceClient is the client API for TaskService service.
+// WorkflowStatusServiceClient is the client API for WorkflowStatusService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type TaskServiceClient interface {
-	Create(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*Task, error)
-	Get(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*Task, error)
-	Update(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*Task, error)
-	Delete(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListTaskRequest, opts ...grpc.CallOption) (*ListTaskResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error)
+type WorkflowStatusServiceClient interface {
+	Create(ctx context.Context, in *CreateWorkflowStatusRequest, opts ...grpc.CallOption) (*WorkflowStatus, error)
+	Get(ctx context.Context, in *GetWorkflowStatusRequest, opts ...grpc.CallOption) (*WorkflowStatus, error)
+	Update(ctx context.Context, in *UpdateWorkflowStatusRequest, opts ...grpc.CallOption) (*WorkflowStatus, error)
+	Delete(ctx context.Context, in *DeleteWorkflowStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListWorkflowStatusRequest, opts ...grpc.CallOption) (*ListWorkflowStatusResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateWorkflowStatusSliceRequest, opts ...grpc.CallOption) (*BatchCreateWorkflowStatusSliceResponse, error)
 }
 
-type taskServiceClient struct {
+type workflowStatusServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewTaskServiceClient(cc grpc.ClientConnInterface) TaskServiceClient {
-	return &taskServiceClient{cc}
+func NewWorkflowStatusServiceClient(cc grpc.ClientConnInterface) WorkflowStatusServiceClient {
+	return &workflowStatusServiceClient{cc}
 }
 
-func (c *taskServiceClient) Create(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+func (c *workflowStatusServiceClient) Create(ctx context.Context, in *CreateWorkflowStatusRequest, opts ...grpc.CallOption) (*WorkflowStatus, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Task)
-	err := c.cc.Invoke(ctx, TaskService_Create_FullMethodName, in, out, cOpts...)
+	out := new(WorkflowStatus)
+	err := c.cc.Invoke(ctx, WorkflowStatusService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskServiceClient) Get(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+func (c *workflowStatusServiceClient) Get(ctx context.Context, in *GetWorkflowStatusRequest, opts ...grpc.CallOption) (*WorkflowStatus, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Task)
-	err := c.cc.Invoke(ctx, TaskService_Get_FullMethodName, in, out, cOpts...)
+	out := new(WorkflowStatus)
+	err := c.cc.Invoke(ctx, WorkflowStatusService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskServiceClient) Update(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+func (c *workflowStatusServiceClient) Update(ctx context.Context, in *UpdateWorkflowStatusRequest, opts ...grpc.CallOption) (*WorkflowStatus, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Task)
-	err := c.cc.Invoke(ctx, TaskService_Update_FullMethodName, in, out, cOpts...)
+	out := new(WorkflowStatus)
+	err := c.cc.Invoke(ctx, WorkflowStatusService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskServiceClient) Delete(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *workflowStatusServiceClient) Delete(ctx context.Context, in *DeleteWorkflowStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, TaskService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, WorkflowStatusService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskServiceClient) List(ctx context.Context, in *ListTaskRequest, opts ...grpc.CallOption) (*ListTaskResponse, error) {
+func (c *workflowStatusServiceClient) List(ctx context.Context, in *ListWorkflowStatusRequest, opts ...grpc.CallOption) (*ListWorkflowStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTaskResponse)
-	err := c.cc.Invoke(ctx, TaskService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListWorkflowStatusResponse)
+	err := c.cc.Invoke(ctx, WorkflowStatusService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error) {
+func (c *workflowStatusServiceClient) BatchCreate(ctx context.Context, in *BatchCreateWorkflowStatusSliceRequest, opts ...grpc.CallOption) (*BatchCreateWorkflowStatusSliceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateTasksResponse)
-	err := c.cc.Invoke(ctx, TaskService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateWorkflowStatusSliceResponse)
+	err := c.cc.Invoke(ctx, WorkflowStatusService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// TaskServiceServer is the server API for TaskService service.
-// All implementations must embed UnimplementedTaskServiceServer
+// WorkflowStatusServiceServer is the server API for WorkflowStatusService service.
+// All implementations must embed UnimplementedWorkflowStatusServiceServer
 // for forward compatibility.
-type TaskServiceServer interface {
-	Create(context.Context, *CreateTaskRequest) (*Task, error)
-	Get(context.Context, *GetTaskRequest) (*Task, error)
-	Update(context.Context, *UpdateTaskRequest) (*Task, error)
-	Delete(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListTaskRequest) (*ListTaskResponse, error)
-	BatchCreate(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error)
-	mustEmbedUnimplementedTaskServiceServer()
+type WorkflowStatusServiceServer interface {
+	Create(context.Context, *CreateWorkflowStatusRequest) (*WorkflowStatus, error)
+	Get(context.Context, *GetWorkflowStatusRequest) (*WorkflowStatus, error)
+	Update(context.Context, *UpdateWorkflowStatusRequest) (*WorkflowStatus, error)
+	Delete(context.Context, *DeleteWorkflowStatusRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListWorkflowStatusRequest) (*ListWorkflowStatusResponse, error)
+	BatchCreate(context.Context, *BatchCreateWorkflowStatusSliceRequest) (*BatchCreateWorkflowStatusSliceResponse, error)
+	mustEmbedUnimplementedWorkflowStatusServiceServer()
 }
 
-// UnimplementedTaskServiceServer must be embedded to have
+// UnimplementedWorkflowStatusServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedTaskServiceServer struct{}
+type UnimplementedWorkflowStatusServiceServer struct{}
 
-func (UnimplementedTaskServiceServer) Create(context.Context, *CreateTaskRequest) (*Task, error) {
+func (UnimplementedWorkflowStatusServiceServer) Create(context.Context, *CreateWorkflowStatusRequest) (*WorkflowStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedTaskServiceServer) Get(context.Context, *GetTaskRequest) (*Task, error) {
+func (UnimplementedWorkflowStatusServiceServer) Get(context.Context, *GetWorkflowStatusRequest) (*WorkflowStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedTaskServiceServer) Update(context.Context, *UpdateTaskRequest) (*Task, error) {
+func (UnimplementedWorkflowStatusServiceServer) Update(context.Context, *UpdateWorkflowStatusRequest) (*WorkflowStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedTaskServiceServer) Delete(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
+func (UnimplementedWorkflowStatusServiceServer) Delete(context.Context, *DeleteWorkflowStatusRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedTaskServiceServer) List(context.Context, *ListTaskRequest) (*ListTaskResponse, error) {
+func (UnimplementedWorkflowStatusServiceServer) List(context.Context, *ListWorkflowStatusRequest) (*ListWorkflowStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedTaskServiceServer) BatchCreate(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error) {
+func (UnimplementedWorkflowStatusServiceServer) BatchCreate(context.Context, *BatchCreateWorkflowStatusSliceRequest) (*BatchCreateWorkflowStatusSliceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
-func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
+func (UnimplementedWorkflowStatusServiceServer) mustEmbedUnimplementedWorkflowStatusServiceServer() {}
+func (UnimplementedWorkflowStatusServiceServer) testEmbeddedByValue()                               {}
 
-// UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TaskServiceServer will
+// UnsafeWorkflowStatusServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkflowStatusServiceServer will
 // result in compilation errors.
-type UnsafeTaskServiceServer interface {
-	mustEmbedUnimplementedTaskServiceServer()
+type UnsafeWorkflowStatusServiceServer interface {
+	mustEmbedUnimplementedWorkflowStatusServiceServer()
 }
 
-func RegisterTaskServiceServer(s grpc.ServiceRegistrar, srv TaskServiceServer) {
-	// If the following call pancis, it indicates UnimplementedTaskServiceServer was
+func RegisterWorkflowStatusServiceServer(s grpc.ServiceRegistrar, srv WorkflowStatusServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorkflowStatusServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&TaskService_ServiceDesc, srv)
+	s.RegisterService(&WorkflowStatusService_ServiceDesc, srv)
 }
 
-func _TaskService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTaskRequest)
+func _WorkflowStatusService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkflowStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).Create(ctx, in)
+		return srv.(WorkflowStatusServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_Create_FullMethodName,
+		FullMethod: WorkflowStatusService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).Create(ctx, req.(*CreateTaskRequest))
+		return srv.(WorkflowStatusServiceServer).Create(ctx, req.(*CreateWorkflowStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskRequest)
+func _WorkflowStatusService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).Get(ctx, in)
+		return srv.(WorkflowStatusServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_Get_FullMethodName,
+		FullMethod: WorkflowStatusService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).Get(ctx, req.(*GetTaskRequest))
+		return srv.(WorkflowStatusServiceServer).Get(ctx, req.(*GetWorkflowStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTaskRequest)
+func _WorkflowStatusService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).Update(ctx, in)
+		return srv.(WorkflowStatusServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_Update_FullMethodName,
+		FullMethod: WorkflowStatusService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).Update(ctx, req.(*UpdateTaskRequest))
+		return srv.(WorkflowStatusServiceServer).Update(ctx, req.(*UpdateWorkflowStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTaskRequest)
+func _WorkflowStatusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).Delete(ctx, in)
+		return srv.(WorkflowStatusServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_Delete_FullMethodName,
+		FullMethod: WorkflowStatusService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).Delete(ctx, req.(*DeleteTaskRequest))
+		return srv.(WorkflowStatusServiceServer).Delete(ctx, req.(*DeleteWorkflowStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTaskRequest)
+func _WorkflowStatusService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).List(ctx, in)
+		return srv.(WorkflowStatusServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_List_FullMethodName,
+		FullMethod: WorkflowStatusService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).List(ctx, req.(*ListTaskRequest))
+		return srv.(WorkflowStatusServiceServer).List(ctx, req.(*ListWorkflowStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateTasksRequest)
+func _WorkflowStatusService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateWorkflowStatusSliceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskServiceServer).BatchCreate(ctx, in)
+		return srv.(WorkflowStatusServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_BatchCreate_FullMethodName,
+		FullMethod: WorkflowStatusService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).BatchCreate(ctx, req.(*BatchCreateTasksRequest))
+		return srv.(WorkflowStatusServiceServer).BatchCreate(ctx, req.(*BatchCreateWorkflowStatusSliceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
+// WorkflowStatusService_ServiceDesc is the grpc.ServiceDesc for WorkflowStatusService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var TaskService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.TaskService",
-	HandlerType: (*TaskServiceServer)(nil),
+var WorkflowStatusService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.WorkflowStatusService",
+	HandlerType: (*WorkflowStatusServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _TaskService_Create_Handler,
+			Handler:    _WorkflowStatusService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _TaskService_Get_Handler,
+			Handler:    _WorkflowStatusService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _TaskService_Update_Handler,
+			Handler:    _WorkflowStatusService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _TaskService_Delete_Handler,
+			Handler:    _WorkflowStatusService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _TaskService_List_Handler,
+			Handler:    _WorkflowStatusService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _TaskService_BatchCreate_Handler,
+			Handler:    _WorkflowStatusService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2943,291 +11929,292 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TaskReportService_Create_FullMethodName      = "/entpb.TaskReportService/Create"
-	TaskReportService_Get_FullMethodName         = "/entpb.TaskReportService/Get"
-	TaskReportService_Update_FullMethodName      = "/entpb.TaskReportService/Update"
-	TaskReportService_Delete_FullMethodName      = "/entpb.TaskReportService/Delete"
-	TaskReportService_List_FullMethodName        = "/entpb.TaskReportService/List"
-	TaskReportService_BatchCreate_FullMethodName = "/entpb.TaskReportService/BatchCreate"
+	WorkflowTransitionService_Create_FullMethodName      = "/entpb.WorkflowTransitionService/Create"
+	WorkflowTransitionService_Get_FullMethodName         = "/entpb.WorkflowTransitionService/Get"
+	WorkflowTransitionService_Update_FullMethodName      = "/entpb.WorkflowTransitionService/Update"
+	WorkflowTransitionService_Delete_FullMethodName      = "/entpb.WorkflowTransitionService/Delete"
+	WorkflowTransitionService_List_FullMethodName        = "/entpb.WorkflowTransitionService/List"
+	WorkflowTransitionService_BatchCreate_FullMethodName = "/entpb.WorkflowTransitionService/BatchCreate"
 )
 
-// TaskReportServiceClient is the client API for TaskReportService service.
+// WorkflowTransitionServiceClient is the client API for WorkflowTransitionService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type TaskReportServiceClient interface {
-	Create(ctx context.Context, in *CreateTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error)
-	Get(ctx context.Context, in *GetTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error)
-	Update(ctx context.Context, in *UpdateTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error)
-	Delete(ctx context.Context, in *DeleteTaskReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	List(ctx context.Context, in *ListTaskReportRequest, opts ...grpc.CallOption) (*ListTaskReportResponse, error)
-	BatchCreate(ctx context.Context, in *BatchCreateTaskReportsRequest, opts ...grpc.CallOption) (*BatchCreateTaskReportsResponse, error)
+type WorkflowTransitionServiceClient interface {
+	Create(ctx context.Context, in *CreateWorkflowTransitionRequest, opts ...grpc.CallOption) (*WorkflowTransition, error)
+	Get(ctx context.Context, in *GetWorkflowTransitionRequest, opts ...grpc.CallOption) (*WorkflowTransition, error)
+	Update(ctx context.Context, in *UpdateWorkflowTransitionRequest, opts ...grpc.CallOption) (*WorkflowTransition, error)
+	Delete(ctx context.Context, in *DeleteWorkflowTransitionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *ListWorkflowTransitionRequest, opts ...grpc.CallOption) (*ListWorkflowTransitionResponse, error)
+	BatchCreate(ctx context.Context, in *BatchCreateWorkflowTransitionsRequest, opts ...grpc.CallOption) (*BatchCreateWorkflowTransitionsResponse, error)
 }
 
-type taskReportServiceClient struct {
+type workflowTransitionServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewTaskReportServiceClient(cc grpc.ClientConnInterface) TaskReportServiceClient {
-	return &taskReportServiceClient{cc}
+func NewWorkflowTransitionServiceClient(cc grpc.ClientConnInterface) WorkflowTransitionServiceClient {
+	return &workflowTransitionServiceClient{cc}
 }
 
-func (c *taskReportServiceClient) Create(ctx context.Context, in *CreateTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error) {
+func (c *workflowTransitionServiceClient) Create(ctx context.Context, in *CreateWorkflowTransitionRequest, opts ...grpc.CallOption) (*WorkflowTransition, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TaskReport)
-	err := c.cc.Invoke(ctx, TaskReportService_Create_FullMethodName, in, out, cOpts...)
+	out := new(WorkflowTransition)
+	err := c.cc.Invoke(ctx, WorkflowTransitionService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskReportServiceClient) Get(ctx context.Context, in *GetTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error) {
+func (c *workflowTransitionServiceClient) Get(ctx context.Context, in *GetWorkflowTransitionRequest, opts ...grpc.CallOption) (*WorkflowTransition, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TaskReport)
-	err := c.cc.Invoke(ctx, TaskReportService_Get_FullMethodName, in, out, cOpts...)
+	out := new(WorkflowTransition)
+	err := c.cc.Invoke(ctx, WorkflowTransitionService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskReportServiceClient) Update(ctx context.Context, in *UpdateTaskReportRequest, opts ...grpc.CallOption) (*TaskReport, error) {
+func (c *workflowTransitionServiceClient) Update(ctx context.Context, in *UpdateWorkflowTransitionRequest, opts ...grpc.CallOption) (*WorkflowTransition, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TaskReport)
-	err := c.cc.Invoke(ctx, TaskReportService_Update_FullMethodName, in, out, cOpts...)
+	out := new(WorkflowTransition)
+	err := c.cc.Invoke(ctx, WorkflowTransitionService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskReportServiceClient) Delete(ctx context.Context, in *DeleteTaskReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *workflowTransitionServiceClient) Delete(ctx context.Context, in *DeleteWorkflowTransitionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, TaskReportService_Delete_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, WorkflowTransitionService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskReportServiceClient) List(ctx context.Context, in *ListTaskReportRequest, opts ...grpc.CallOption) (*ListTaskReportResponse, error) {
+func (c *workflowTransitionServiceClient) List(ctx context.Context, in *ListWorkflowTransitionRequest, opts ...grpc.CallOption) (*ListWorkflowTransitionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListTaskReportResponse)
-	err := c.cc.Invoke(ctx, TaskReportService_List_FullMethodName, in, out, cOpts...)
+	out := new(ListWorkflowTransitionResponse)
+	err := c.cc.Invoke(ctx, WorkflowTransitionService_List_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *taskReportServiceClient) BatchCreate(ctx context.Context, in *BatchCreateTaskReportsRequest, opts ...grpc.CallOption) (*BatchCreateTaskReportsResponse, error) {
+func (c *workflowTransitionServiceClient) BatchCreate(ctx context.Context, in *BatchCreateWorkflowTransitionsRequest, opts ...grpc.CallOption) (*BatchCreateWorkflowTransitionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BatchCreateTaskReportsResponse)
-	err := c.cc.Invoke(ctx, TaskReportService_BatchCreate_FullMethodName, in, out, cOpts...)
+	out := new(BatchCreateWorkflowTransitionsResponse)
+	err := c.cc.Invoke(ctx, WorkflowTransitionService_BatchCreate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// TaskReportServiceServer is the server API for TaskReportService service.
-// All implementations must embed UnimplementedTaskReportServiceServer
+// WorkflowTransitionServiceServer is the server API for WorkflowTransitionService service.
+// All implementations must embed UnimplementedWorkflowTransitionServiceServer
 // for forward compatibility.
-type TaskReportServiceServer interface {
-	Create(context.Context, *CreateTaskReportRequest) (*TaskReport, error)
-	Get(context.Context, *GetTaskReportRequest) (*TaskReport, error)
-	Update(context.Context, *UpdateTaskReportRequest) (*TaskReport, error)
-	Delete(context.Context, *DeleteTaskReportRequest) (*emptypb.Empty, error)
-	List(context.Context, *ListTaskReportRequest) (*ListTaskReportResponse, error)
-	BatchCreate(context.Context, *BatchCreateTaskReportsRequest) (*BatchCreateTaskReportsResponse, error)
-	mustEmbedUnimplementedTaskReportServiceServer()
+type WorkflowTransitionServiceServer interface {
+	Create(context.Context, *CreateWorkflowTransitionRequest) (*WorkflowTransition, error)
+	Get(context.Context, *GetWorkflowTransitionRequest) (*WorkflowTransition, error)
+	Update(context.Context, *UpdateWorkflowTransitionRequest) (*WorkflowTransition, error)
+	Delete(context.Context, *DeleteWorkflowTransitionRequest) (*emptypb.Empty, error)
+	List(context.Context, *ListWorkflowTransitionRequest) (*ListWorkflowTransitionResponse, error)
+	BatchCreate(context.Context, *BatchCreateWorkflowTransitionsRequest) (*BatchCreateWorkflowTransitionsResponse, error)
+	mustEmbedUnimplementedWorkflowTransitionServiceServer()
 }
 
-// UnimplementedTaskReportServiceServer must be embedded to have
+// UnimplementedWorkflowTransitionServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedTaskReportServiceServer struct{}
+type UnimplementedWorkflowTransitionServiceServer struct{}
 
-func (UnimplementedTaskReportServiceServer) Create(context.Context, *CreateTaskReportRequest) (*TaskReport, error) {
+func (UnimplementedWorkflowTransitionServiceServer) Create(context.Context, *CreateWorkflowTransitionRequest) (*WorkflowTransition, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedTaskReportServiceServer) Get(context.Context, *GetTaskReportRequest) (*TaskReport, error) {
+func (UnimplementedWorkflowTransitionServiceServer) Get(context.Context, *GetWorkflowTransitionRequest) (*WorkflowTransition, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedTaskReportServiceServer) Update(context.Context, *UpdateTaskReportRequest) (*TaskReport, error) {
+func (UnimplementedWorkflowTransitionServiceServer) Update(context.Context, *UpdateWorkflowTransitionRequest) (*WorkflowTransition, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedTaskReportServiceServer) Delete(context.Context, *DeleteTaskReportRequest) (*emptypb.Empty, error) {
+func (UnimplementedWorkflowTransitionServiceServer) Delete(context.Context, *DeleteWorkflowTransitionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedTaskReportServiceServer) List(context.Context, *ListTaskReportRequest) (*ListTaskReportResponse, error) {
+func (UnimplementedWorkflowTransitionServiceServer) List(context.Context, *ListWorkflowTransitionRequest) (*ListWorkflowTransitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedTaskReportServiceServer) BatchCreate(context.Context, *BatchCreateTaskReportsRequest) (*BatchCreateTaskReportsResponse, error) {
+func (UnimplementedWorkflowTransitionServiceServer) BatchCreate(context.Context, *BatchCreateWorkflowTransitionsRequest) (*BatchCreateWorkflowTransitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
-func (UnimplementedTaskReportServiceServer) mustEmbedUnimplementedTaskReportServiceServer() {}
-func (UnimplementedTaskReportServiceServer) testEmbeddedByValue()                           {}
+func (UnimplementedWorkflowTransitionServiceServer) mustEmbedUnimplementedWorkflowTransitionServiceServer() {
+}
+func (UnimplementedWorkflowTransitionServiceServer) testEmbeddedByValue() {}
 
-// UnsafeTaskReportServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TaskReportServiceServer will
+// UnsafeWorkflowTransitionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkflowTransitionServiceServer will
 // result in compilation errors.
-type UnsafeTaskReportServiceServer interface {
-	mustEmbedUnimplementedTaskReportServiceServer()
+type UnsafeWorkflowTransitionServiceServer interface {
+	mustEmbedUnimplementedWorkflowTransitionServiceServer()
 }
 
-func RegisterTaskReportServiceServer(s grpc.ServiceRegistrar, srv TaskReportServiceServer) {
-	// If the following call pancis, it indicates UnimplementedTaskReportServiceServer was
+func RegisterWorkflowTransitionServiceServer(s grpc.ServiceRegistrar, srv WorkflowTransitionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorkflowTransitionServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&TaskReportService_ServiceDesc, srv)
+	s.RegisterService(&WorkflowTransitionService_ServiceDesc, srv)
 }
 
-func _TaskReportService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTaskReportRequest)
+func _WorkflowTransitionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkflowTransitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskReportServiceServer).Create(ctx, in)
+		return srv.(WorkflowTransitionServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskReportService_Create_FullMethodName,
+		FullMethod: WorkflowTransitionService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskReportServiceServer).Create(ctx, req.(*CreateTaskReportRequest))
+		return srv.(WorkflowTransitionServiceServer).Create(ctx, req.(*CreateWorkflowTransitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskReportService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskReportRequest)
+func _WorkflowTransitionService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowTransitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskReportServiceServer).Get(ctx, in)
+		return srv.(WorkflowTransitionServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskReportService_Get_FullMethodName,
+		FullMethod: WorkflowTransitionService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskReportServiceServer).Get(ctx, req.(*GetTaskReportRequest))
+		return srv.(WorkflowTransitionServiceServer).Get(ctx, req.(*GetWorkflowTransitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskReportService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTaskReportRequest)
+func _WorkflowTransitionService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowTransitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskReportServiceServer).Update(ctx, in)
+		return srv.(WorkflowTransitionServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskReportService_Update_FullMethodName,
+		FullMethod: WorkflowTransitionService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskReportServiceServer).Update(ctx, req.(*UpdateTaskReportRequest))
+		return srv.(WorkflowTransitionServiceServer).Update(ctx, req.(*UpdateWorkflowTransitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskReportService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTaskReportRequest)
+func _WorkflowTransitionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowTransitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskReportServiceServer).Delete(ctx, in)
+		return srv.(WorkflowTransitionServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskReportService_Delete_FullMethodName,
+		FullMethod: WorkflowTransitionService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskReportServiceServer).Delete(ctx, req.(*DeleteTaskReportRequest))
+		return srv.(WorkflowTransitionServiceServer).Delete(ctx, req.(*DeleteWorkflowTransitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskReportService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTaskReportRequest)
+func _WorkflowTransitionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowTransitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskReportServiceServer).List(ctx, in)
+		return srv.(WorkflowTransitionServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskReportService_List_FullMethodName,
+		FullMethod: WorkflowTransitionService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskReportServiceServer).List(ctx, req.(*ListTaskReportRequest))
+		return srv.(WorkflowTransitionServiceServer).List(ctx, req.(*ListWorkflowTransitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TaskReportService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchCreateTaskReportsRequest)
+func _WorkflowTransitionService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateWorkflowTransitionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TaskReportServiceServer).BatchCreate(ctx, in)
+		return srv.(WorkflowTransitionServiceServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TaskReportService_BatchCreate_FullMethodName,
+		FullMethod: WorkflowTransitionService_BatchCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskReportServiceServer).BatchCreate(ctx, req.(*BatchCreateTaskReportsRequest))
+		return srv.(WorkflowTransitionServiceServer).BatchCreate(ctx, req.(*BatchCreateWorkflowTransitionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// TaskReportService_ServiceDesc is the grpc.ServiceDesc for TaskReportService service.
+// WorkflowTransitionService_ServiceDesc is the grpc.ServiceDesc for WorkflowTransitionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var TaskReportService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "entpb.TaskReportService",
-	HandlerType: (*TaskReportServiceServer)(nil),
+var WorkflowTransitionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.WorkflowTransitionService",
+	HandlerType: (*WorkflowTransitionServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _TaskReportService_Create_Handler,
+			Handler:    _WorkflowTransitionService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
-			Handler:    _TaskReportService_Get_Handler,
+			Handler:    _WorkflowTransitionService_Get_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _TaskReportService_Update_Handler,
+			Handler:    _WorkflowTransitionService_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _TaskReportService_Delete_Handler,
+			Handler:    _WorkflowTransitionService_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _TaskReportService_List_Handler,
+			Handler:    _WorkflowTransitionService_List_Handler,
 		},
 		{
 			MethodName: "BatchCreate",
-			Handler:    _TaskReportService_BatchCreate_Handler,
+			Handler:    _WorkflowTransitionService_BatchCreate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
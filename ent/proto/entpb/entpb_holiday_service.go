@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	holiday "github.com/longgggwwww/hrm-ms-hr/ent/holiday"
+	organization "github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	strconv "strconv"
+)
+
+// HolidayService implements HolidayServiceServer
+type HolidayService struct {
+	client *ent.Client
+	UnimplementedHolidayServiceServer
+}
+
+// NewHolidayService returns a new HolidayService
+func NewHolidayService(client *ent.Client) *HolidayService {
+	return &HolidayService{
+		client: client,
+	}
+}
+
+// toProtoHoliday transforms the ent type to the pb type
+func toProtoHoliday(e *ent.Holiday) (*Holiday, error) {
+	v := &Holiday{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	date := timestamppb.New(e.Date)
+	v.Date = date
+	id := int64(e.ID)
+	v.Id = id
+	name := e.Name
+	v.Name = name
+	organization := int64(e.OrgID)
+	v.OrgId = organization
+	if edg := e.Edges.Organization; edg != nil {
+		id := int64(edg.ID)
+		v.Organization = &Organization{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoHolidayList transforms a list of ent type to a list of pb type
+func toProtoHolidayList(e []*ent.Holiday) ([]*Holiday, error) {
+	var pbList []*Holiday
+	for _, entEntity := range e {
+		pbEntity, err := toProtoHoliday(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements HolidayServiceServer.Create
+func (svc *HolidayService) Create(ctx context.Context, req *CreateHolidayRequest) (*Holiday, error) {
+	holiday := req.GetHoliday()
+	m, err := svc.createBuilder(holiday)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoHoliday(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements HolidayServiceServer.Get
+func (svc *HolidayService) Get(ctx context.Context, req *GetHolidayRequest) (*Holiday, error) {
+	var (
+		err error
+		get *ent.Holiday
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetHolidayRequest_VIEW_UNSPECIFIED, GetHolidayRequest_BASIC:
+		get, err = svc.client.Holiday.Get(ctx, id)
+	case GetHolidayRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Holiday.Query().
+			Where(holiday.ID(id)).
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoHoliday(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements HolidayServiceServer.Update
+func (svc *HolidayService) Update(ctx context.Context, req *UpdateHolidayRequest) (*Holiday, error) {
+	holiday := req.GetHoliday()
+	holidayID := int(holiday.GetId())
+	m := svc.client.Holiday.UpdateOneID(holidayID)
+	holidayCreatedAt := runtime.ExtractTime(holiday.GetCreatedAt())
+	m.SetCreatedAt(holidayCreatedAt)
+	holidayDate := runtime.ExtractTime(holiday.GetDate())
+	m.SetDate(holidayDate)
+	holidayName := holiday.GetName()
+	m.SetName(holidayName)
+	holidayOrgID := int(holiday.GetOrgId())
+	m.SetOrgID(holidayOrgID)
+	if holiday.GetOrganization() != nil {
+		holidayOrganization := int(holiday.GetOrganization().GetId())
+		m.SetOrganizationID(holidayOrganization)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoHoliday(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements HolidayServiceServer.Delete
+func (svc *HolidayService) Delete(ctx context.Context, req *DeleteHolidayRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.Holiday.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements HolidayServiceServer.List
+func (svc *HolidayService) List(ctx context.Context, req *ListHolidayRequest) (*ListHolidayResponse, error) {
+	var (
+		err      error
+		entList  []*ent.Holiday
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.Holiday.Query().
+		Order(ent.Desc(holiday.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(holiday.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListHolidayRequest_VIEW_UNSPECIFIED, ListHolidayRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListHolidayRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoHolidayList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListHolidayResponse{
+			HolidayList:   protoList,
+			NextPageToken: nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements HolidayServiceServer.BatchCreate
+func (svc *HolidayService) BatchCreate(ctx context.Context, req *BatchCreateHolidaysRequest) (*BatchCreateHolidaysResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.HolidayCreate, len(requests))
+	for i, req := range requests {
+		holiday := req.GetHoliday()
+		var err error
+		bulk[i], err = svc.createBuilder(holiday)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.Holiday.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoHolidayList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateHolidaysResponse{
+			Holidays: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *HolidayService) createBuilder(holiday *Holiday) (*ent.HolidayCreate, error) {
+	m := svc.client.Holiday.Create()
+	holidayCreatedAt := runtime.ExtractTime(holiday.GetCreatedAt())
+	m.SetCreatedAt(holidayCreatedAt)
+	holidayDate := runtime.ExtractTime(holiday.GetDate())
+	m.SetDate(holidayDate)
+	holidayName := holiday.GetName()
+	m.SetName(holidayName)
+	holidayOrgID := int(holiday.GetOrgId())
+	m.SetOrgID(holidayOrgID)
+	if holiday.GetOrganization() != nil {
+		holidayOrganization := int(holiday.GetOrganization().GetId())
+		m.SetOrganizationID(holidayOrganization)
+	}
+	return m, nil
+}
@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	milestone "github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// MilestoneService implements MilestoneServiceServer
+type MilestoneService struct {
+	client *ent.Client
+	UnimplementedMilestoneServiceServer
+}
+
+// NewMilestoneService returns a new MilestoneService
+func NewMilestoneService(client *ent.Client) *MilestoneService {
+	return &MilestoneService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpMilestone_State = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeMilestone_State(e string) string {
+	return protoIdentNormalizeRegexpMilestone_State.ReplaceAllString(e, "_")
+}
+
+func toProtoMilestone_State(e milestone.State) Milestone_State {
+	if v, ok := Milestone_State_value[strings.ToUpper("STATE_"+protoIdentNormalizeMilestone_State(string(e)))]; ok {
+		return Milestone_State(v)
+	}
+	return Milestone_State(0)
+}
+
+func toEntMilestone_State(e Milestone_State) milestone.State {
+	if v, ok := Milestone_State_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATE_PLANNED": "planned",
+			"STATE_ACTIVE":  "active",
+			"STATE_CLOSED":  "closed",
+		}[v]
+		return milestone.State(entVal)
+	}
+	return ""
+}
+
+// toProtoMilestone transforms the ent type to the pb type
+func toProtoMilestone(e *ent.Milestone) (*Milestone, error) {
+	v := &Milestone{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	creator_id := int64(e.CreatorID)
+	v.CreatorId = creator_id
+	if e.EndAt != nil {
+		end_at := timestamppb.New(*e.EndAt)
+		v.EndAt = end_at
+	}
+	goal := wrapperspb.String(e.Goal)
+	v.Goal = goal
+	id := int64(e.ID)
+	v.Id = id
+	name := e.Name
+	v.Name = name
+	project := int64(e.ProjectID)
+	v.ProjectId = project
+	if e.StartAt != nil {
+		start_at := timestamppb.New(*e.StartAt)
+		v.StartAt = start_at
+	}
+	state := toProtoMilestone_State(e.State)
+	v.State = state
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Project; edg != nil {
+		id := int64(edg.ID)
+		v.Project = &Project{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.Tasks {
+		id := int64(edg.ID)
+		v.Tasks = append(v.Tasks, &Task{
+			Id: id,
+		})
+	}
+	return v, nil
+}
+
+// toProtoMilestoneList transforms a list of ent type to a list of pb type
+func toProtoMilestoneList(e []*ent.Milestone) ([]*Milestone, error) {
+	var pbList []*Milestone
+	for _, entEntity := range e {
+		pbEntity, err := toProtoMilestone(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements MilestoneServiceServer.Create
+func (svc *MilestoneService) Create(ctx context.Context, req *CreateMilestoneRequest) (*Milestone, error) {
+	milestone := req.GetMilestone()
+	m, err := svc.createBuilder(milestone)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoMilestone(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements MilestoneServiceServer.Get
+func (svc *MilestoneService) Get(ctx context.Context, req *GetMilestoneRequest) (*Milestone, error) {
+	var (
+		err error
+		get *ent.Milestone
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetMilestoneRequest_VIEW_UNSPECIFIED, GetMilestoneRequest_BASIC:
+		get, err = svc.client.Milestone.Get(ctx, id)
+	case GetMilestoneRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Milestone.Query().
+			Where(milestone.ID(id)).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			WithTasks(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoMilestone(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements MilestoneServiceServer.Update
+func (svc *MilestoneService) Update(ctx context.Context, req *UpdateMilestoneRequest) (*Milestone, error) {
+	milestone := req.GetMilestone()
+	milestoneID := int(milestone.GetId())
+	m := svc.client.Milestone.UpdateOneID(milestoneID)
+	milestoneCreatorID := int(milestone.GetCreatorId())
+	m.SetCreatorID(milestoneCreatorID)
+	if milestone.GetEndAt() != nil {
+		milestoneEndAt := runtime.ExtractTime(milestone.GetEndAt())
+		m.SetEndAt(milestoneEndAt)
+	}
+	if milestone.GetGoal() != nil {
+		milestoneGoal := milestone.GetGoal().GetValue()
+		m.SetGoal(milestoneGoal)
+	}
+	milestoneName := milestone.GetName()
+	m.SetName(milestoneName)
+	milestoneProjectID := int(milestone.GetProjectId())
+	m.SetProjectID(milestoneProjectID)
+	if milestone.GetStartAt() != nil {
+		milestoneStartAt := runtime.ExtractTime(milestone.GetStartAt())
+		m.SetStartAt(milestoneStartAt)
+	}
+	milestoneState := toEntMilestone_State(milestone.GetState())
+	m.SetState(milestoneState)
+	milestoneUpdatedAt := runtime.ExtractTime(milestone.GetUpdatedAt())
+	m.SetUpdatedAt(milestoneUpdatedAt)
+	if milestone.GetProject() != nil {
+		milestoneProject := int(milestone.GetProject().GetId())
+		m.SetProjectID(milestoneProject)
+	}
+	for _, item := range milestone.GetTasks() {
+		tasks := int(item.GetId())
+		m.AddTaskIDs(tasks)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoMilestone(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements MilestoneServiceServer.Delete
+func (svc *MilestoneService) Delete(ctx context.Context, req *DeleteMilestoneRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.Milestone.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements MilestoneServiceServer.List
+func (svc *MilestoneService) List(ctx context.Context, req *ListMilestoneRequest) (*ListMilestoneResponse, error) {
+	var (
+		err      error
+		entList  []*ent.Milestone
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.Milestone.Query().
+		Order(ent.Desc(milestone.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(milestone.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListMilestoneRequest_VIEW_UNSPECIFIED, ListMilestoneRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListMilestoneRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			WithTasks(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoMilestoneList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListMilestoneResponse{
+			MilestoneList: protoList,
+			NextPageToken: nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements MilestoneServiceServer.BatchCreate
+func (svc *MilestoneService) BatchCreate(ctx context.Context, req *BatchCreateMilestonesRequest) (*BatchCreateMilestonesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.MilestoneCreate, len(requests))
+	for i, req := range requests {
+		milestone := req.GetMilestone()
+		var err error
+		bulk[i], err = svc.createBuilder(milestone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.Milestone.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoMilestoneList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateMilestonesResponse{
+			Milestones: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *MilestoneService) createBuilder(milestone *Milestone) (*ent.MilestoneCreate, error) {
+	m := svc.client.Milestone.Create()
+	milestoneCreatedAt := runtime.ExtractTime(milestone.GetCreatedAt())
+	m.SetCreatedAt(milestoneCreatedAt)
+	milestoneCreatorID := int(milestone.GetCreatorId())
+	m.SetCreatorID(milestoneCreatorID)
+	if milestone.GetEndAt() != nil {
+		milestoneEndAt := runtime.ExtractTime(milestone.GetEndAt())
+		m.SetEndAt(milestoneEndAt)
+	}
+	if milestone.GetGoal() != nil {
+		milestoneGoal := milestone.GetGoal().GetValue()
+		m.SetGoal(milestoneGoal)
+	}
+	milestoneName := milestone.GetName()
+	m.SetName(milestoneName)
+	milestoneProjectID := int(milestone.GetProjectId())
+	m.SetProjectID(milestoneProjectID)
+	if milestone.GetStartAt() != nil {
+		milestoneStartAt := runtime.ExtractTime(milestone.GetStartAt())
+		m.SetStartAt(milestoneStartAt)
+	}
+	milestoneState := toEntMilestone_State(milestone.GetState())
+	m.SetState(milestoneState)
+	milestoneUpdatedAt := runtime.ExtractTime(milestone.GetUpdatedAt())
+	m.SetUpdatedAt(milestoneUpdatedAt)
+	if milestone.GetProject() != nil {
+		milestoneProject := int(milestone.GetProject().GetId())
+		m.SetProjectID(milestoneProject)
+	}
+	for _, item := range milestone.GetTasks() {
+		tasks := int(item.GetId())
+		m.AddTaskIDs(tasks)
+	}
+	return m, nil
+}
@@ -0,0 +1,304 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	notificationpreference "github.com/longgggwwww/hrm-ms-hr/ent/notificationpreference"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// NotificationPreferenceService implements NotificationPreferenceServiceServer
+type NotificationPreferenceService struct {
+	client *ent.Client
+	UnimplementedNotificationPreferenceServiceServer
+}
+
+// NewNotificationPreferenceService returns a new NotificationPreferenceService
+func NewNotificationPreferenceService(client *ent.Client) *NotificationPreferenceService {
+	return &NotificationPreferenceService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpNotificationPreference_Type = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeNotificationPreference_Type(e string) string {
+	return protoIdentNormalizeRegexpNotificationPreference_Type.ReplaceAllString(e, "_")
+}
+
+func toProtoNotificationPreference_Type(e notificationpreference.Type) NotificationPreference_Type {
+	if v, ok := NotificationPreference_Type_value[strings.ToUpper("TYPE_"+protoIdentNormalizeNotificationPreference_Type(string(e)))]; ok {
+		return NotificationPreference_Type(v)
+	}
+	return NotificationPreference_Type(0)
+}
+
+func toEntNotificationPreference_Type(e NotificationPreference_Type) notificationpreference.Type {
+	if v, ok := NotificationPreference_Type_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"TYPE_TASK_ASSIGNED":       "task_assigned",
+			"TYPE_TASK_STATUS_CHANGED": "task_status_changed",
+			"TYPE_TASK_DUE_SOON":       "task_due_soon",
+			"TYPE_TASK_OVERDUE":        "task_overdue",
+			"TYPE_TASK_MENTIONED":      "task_mentioned",
+			"TYPE_LEAVE_DECIDED":       "leave_decided",
+		}[v]
+		return notificationpreference.Type(entVal)
+	}
+	return ""
+}
+
+// toProtoNotificationPreference transforms the ent type to the pb type
+func toProtoNotificationPreference(e *ent.NotificationPreference) (*NotificationPreference, error) {
+	v := &NotificationPreference{}
+	employee_id := int64(e.EmployeeID)
+	v.EmployeeId = employee_id
+	enabled := e.Enabled
+	v.Enabled = enabled
+	id := int64(e.ID)
+	v.Id = id
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	_type := toProtoNotificationPreference_Type(e.Type)
+	v.Type = _type
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	return v, nil
+}
+
+// toProtoNotificationPreferenceList transforms a list of ent type to a list of pb type
+func toProtoNotificationPreferenceList(e []*ent.NotificationPreference) ([]*NotificationPreference, error) {
+	var pbList []*NotificationPreference
+	for _, entEntity := range e {
+		pbEntity, err := toProtoNotificationPreference(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements NotificationPreferenceServiceServer.Create
+func (svc *NotificationPreferenceService) Create(ctx context.Context, req *CreateNotificationPreferenceRequest) (*NotificationPreference, error) {
+	notificationpreference := req.GetNotificationPreference()
+	m, err := svc.createBuilder(notificationpreference)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoNotificationPreference(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements NotificationPreferenceServiceServer.Get
+func (svc *NotificationPreferenceService) Get(ctx context.Context, req *GetNotificationPreferenceRequest) (*NotificationPreference, error) {
+	var (
+		err error
+		get *ent.NotificationPreference
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetNotificationPreferenceRequest_VIEW_UNSPECIFIED, GetNotificationPreferenceRequest_BASIC:
+		get, err = svc.client.NotificationPreference.Get(ctx, id)
+	case GetNotificationPreferenceRequest_WITH_EDGE_IDS:
+		get, err = svc.client.NotificationPreference.Query().
+			Where(notificationpreference.ID(id)).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoNotificationPreference(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements NotificationPreferenceServiceServer.Update
+func (svc *NotificationPreferenceService) Update(ctx context.Context, req *UpdateNotificationPreferenceRequest) (*NotificationPreference, error) {
+	notificationpreference := req.GetNotificationPreference()
+	notificationpreferenceID := int(notificationpreference.GetId())
+	m := svc.client.NotificationPreference.UpdateOneID(notificationpreferenceID)
+	notificationpreferenceEmployeeID := int(notificationpreference.GetEmployeeId())
+	m.SetEmployeeID(notificationpreferenceEmployeeID)
+	notificationpreferenceEnabled := notificationpreference.GetEnabled()
+	m.SetEnabled(notificationpreferenceEnabled)
+	notificationpreferenceOrgID := int(notificationpreference.GetOrgId())
+	m.SetOrgID(notificationpreferenceOrgID)
+	notificationpreferenceType := toEntNotificationPreference_Type(notificationpreference.GetType())
+	m.SetType(notificationpreferenceType)
+	notificationpreferenceUpdatedAt := runtime.ExtractTime(notificationpreference.GetUpdatedAt())
+	m.SetUpdatedAt(notificationpreferenceUpdatedAt)
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoNotificationPreference(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements NotificationPreferenceServiceServer.Delete
+func (svc *NotificationPreferenceService) Delete(ctx context.Context, req *DeleteNotificationPreferenceRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.NotificationPreference.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements NotificationPreferenceServiceServer.List
+func (svc *NotificationPreferenceService) List(ctx context.Context, req *ListNotificationPreferenceRequest) (*ListNotificationPreferenceResponse, error) {
+	var (
+		err      error
+		entList  []*ent.NotificationPreference
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.NotificationPreference.Query().
+		Order(ent.Desc(notificationpreference.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(notificationpreference.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListNotificationPreferenceRequest_VIEW_UNSPECIFIED, ListNotificationPreferenceRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListNotificationPreferenceRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoNotificationPreferenceList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListNotificationPreferenceResponse{
+			NotificationPreferenceList: protoList,
+			NextPageToken:              nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements NotificationPreferenceServiceServer.BatchCreate
+func (svc *NotificationPreferenceService) BatchCreate(ctx context.Context, req *BatchCreateNotificationPreferencesRequest) (*BatchCreateNotificationPreferencesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.NotificationPreferenceCreate, len(requests))
+	for i, req := range requests {
+		notificationpreference := req.GetNotificationPreference()
+		var err error
+		bulk[i], err = svc.createBuilder(notificationpreference)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.NotificationPreference.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoNotificationPreferenceList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateNotificationPreferencesResponse{
+			NotificationPreferences: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *NotificationPreferenceService) createBuilder(notificationpreference *NotificationPreference) (*ent.NotificationPreferenceCreate, error) {
+	m := svc.client.NotificationPreference.Create()
+	notificationpreferenceEmployeeID := int(notificationpreference.GetEmployeeId())
+	m.SetEmployeeID(notificationpreferenceEmployeeID)
+	notificationpreferenceEnabled := notificationpreference.GetEnabled()
+	m.SetEnabled(notificationpreferenceEnabled)
+	notificationpreferenceOrgID := int(notificationpreference.GetOrgId())
+	m.SetOrgID(notificationpreferenceOrgID)
+	notificationpreferenceType := toEntNotificationPreference_Type(notificationpreference.GetType())
+	m.SetType(notificationpreferenceType)
+	notificationpreferenceUpdatedAt := runtime.ExtractTime(notificationpreference.GetUpdatedAt())
+	m.SetUpdatedAt(notificationpreferenceUpdatedAt)
+	return m, nil
+}
@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	notification "github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// NotificationService implements NotificationServiceServer
+type NotificationService struct {
+	client *ent.Client
+	UnimplementedNotificationServiceServer
+}
+
+// NewNotificationService returns a new NotificationService
+func NewNotificationService(client *ent.Client) *NotificationService {
+	return &NotificationService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpNotification_Type = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeNotification_Type(e string) string {
+	return protoIdentNormalizeRegexpNotification_Type.ReplaceAllString(e, "_")
+}
+
+func toProtoNotification_Type(e notification.Type) Notification_Type {
+	if v, ok := Notification_Type_value[strings.ToUpper("TYPE_"+protoIdentNormalizeNotification_Type(string(e)))]; ok {
+		return Notification_Type(v)
+	}
+	return Notification_Type(0)
+}
+
+func toEntNotification_Type(e Notification_Type) notification.Type {
+	if v, ok := Notification_Type_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"TYPE_TASK_ASSIGNED":       "task_assigned",
+			"TYPE_TASK_STATUS_CHANGED": "task_status_changed",
+			"TYPE_TASK_DUE_SOON":       "task_due_soon",
+			"TYPE_TASK_OVERDUE":        "task_overdue",
+			"TYPE_TASK_MENTIONED":      "task_mentioned",
+			"TYPE_LEAVE_DECIDED":       "leave_decided",
+		}[v]
+		return notification.Type(entVal)
+	}
+	return ""
+}
+
+// toProtoNotification transforms the ent type to the pb type
+func toProtoNotification(e *ent.Notification) (*Notification, error) {
+	v := &Notification{}
+	actor_employee_id := wrapperspb.Int64(int64(e.ActorEmployeeID))
+	v.ActorEmployeeId = actor_employee_id
+	body := wrapperspb.String(e.Body)
+	v.Body = body
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	dedup_key := wrapperspb.String(e.DedupKey)
+	v.DedupKey = dedup_key
+	employee_id := int64(e.EmployeeID)
+	v.EmployeeId = employee_id
+	id := int64(e.ID)
+	v.Id = id
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	if e.ReadAt != nil {
+		read_at := timestamppb.New(*e.ReadAt)
+		v.ReadAt = read_at
+	}
+	ref_id := wrapperspb.Int64(int64(e.RefID))
+	v.RefId = ref_id
+	task_id := wrapperspb.Int64(int64(e.TaskID))
+	v.TaskId = task_id
+	title := e.Title
+	v.Title = title
+	_type := toProtoNotification_Type(e.Type)
+	v.Type = _type
+	return v, nil
+}
+
+// toProtoNotificationList transforms a list of ent type to a list of pb type
+func toProtoNotificationList(e []*ent.Notification) ([]*Notification, error) {
+	var pbList []*Notification
+	for _, entEntity := range e {
+		pbEntity, err := toProtoNotification(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements NotificationServiceServer.Create
+func (svc *NotificationService) Create(ctx context.Context, req *CreateNotificationRequest) (*Notification, error) {
+	notification := req.GetNotification()
+	m, err := svc.createBuilder(notification)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoNotification(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements NotificationServiceServer.Get
+func (svc *NotificationService) Get(ctx context.Context, req *GetNotificationRequest) (*Notification, error) {
+	var (
+		err error
+		get *ent.Notification
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetNotificationRequest_VIEW_UNSPECIFIED, GetNotificationRequest_BASIC:
+		get, err = svc.client.Notification.Get(ctx, id)
+	case GetNotificationRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Notification.Query().
+			Where(notification.ID(id)).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoNotification(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements NotificationServiceServer.Update
+func (svc *NotificationService) Update(ctx context.Context, req *UpdateNotificationRequest) (*Notification, error) {
+	notification := req.GetNotification()
+	notificationID := int(notification.GetId())
+	m := svc.client.Notification.UpdateOneID(notificationID)
+	if notification.GetActorEmployeeId() != nil {
+		notificationActorEmployeeID := int(notification.GetActorEmployeeId().GetValue())
+		m.SetActorEmployeeID(notificationActorEmployeeID)
+	}
+	if notification.GetBody() != nil {
+		notificationBody := notification.GetBody().GetValue()
+		m.SetBody(notificationBody)
+	}
+	if notification.GetDedupKey() != nil {
+		notificationDedupKey := notification.GetDedupKey().GetValue()
+		m.SetDedupKey(notificationDedupKey)
+	}
+	notificationEmployeeID := int(notification.GetEmployeeId())
+	m.SetEmployeeID(notificationEmployeeID)
+	notificationOrgID := int(notification.GetOrgId())
+	m.SetOrgID(notificationOrgID)
+	if notification.GetReadAt() != nil {
+		notificationReadAt := runtime.ExtractTime(notification.GetReadAt())
+		m.SetReadAt(notificationReadAt)
+	}
+	if notification.GetRefId() != nil {
+		notificationRefID := int(notification.GetRefId().GetValue())
+		m.SetRefID(notificationRefID)
+	}
+	if notification.GetTaskId() != nil {
+		notificationTaskID := int(notification.GetTaskId().GetValue())
+		m.SetTaskID(notificationTaskID)
+	}
+	notificationTitle := notification.GetTitle()
+	m.SetTitle(notificationTitle)
+	notificationType := toEntNotification_Type(notification.GetType())
+	m.SetType(notificationType)
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoNotification(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements NotificationServiceServer.Delete
+func (svc *NotificationService) Delete(ctx context.Context, req *DeleteNotificationRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.Notification.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements NotificationServiceServer.List
+func (svc *NotificationService) List(ctx context.Context, req *ListNotificationRequest) (*ListNotificationResponse, error) {
+	var (
+		err      error
+		entList  []*ent.Notification
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.Notification.Query().
+		Order(ent.Desc(notification.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(notification.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListNotificationRequest_VIEW_UNSPECIFIED, ListNotificationRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListNotificationRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoNotificationList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListNotificationResponse{
+			NotificationList: protoList,
+			NextPageToken:    nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements NotificationServiceServer.BatchCreate
+func (svc *NotificationService) BatchCreate(ctx context.Context, req *BatchCreateNotificationsRequest) (*BatchCreateNotificationsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.NotificationCreate, len(requests))
+	for i, req := range requests {
+		notification := req.GetNotification()
+		var err error
+		bulk[i], err = svc.createBuilder(notification)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.Notification.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoNotificationList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateNotificationsResponse{
+			Notifications: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *NotificationService) createBuilder(notification *Notification) (*ent.NotificationCreate, error) {
+	m := svc.client.Notification.Create()
+	if notification.GetActorEmployeeId() != nil {
+		notificationActorEmployeeID := int(notification.GetActorEmployeeId().GetValue())
+		m.SetActorEmployeeID(notificationActorEmployeeID)
+	}
+	if notification.GetBody() != nil {
+		notificationBody := notification.GetBody().GetValue()
+		m.SetBody(notificationBody)
+	}
+	notificationCreatedAt := runtime.ExtractTime(notification.GetCreatedAt())
+	m.SetCreatedAt(notificationCreatedAt)
+	if notification.GetDedupKey() != nil {
+		notificationDedupKey := notification.GetDedupKey().GetValue()
+		m.SetDedupKey(notificationDedupKey)
+	}
+	notificationEmployeeID := int(notification.GetEmployeeId())
+	m.SetEmployeeID(notificationEmployeeID)
+	notificationOrgID := int(notification.GetOrgId())
+	m.SetOrgID(notificationOrgID)
+	if notification.GetReadAt() != nil {
+		notificationReadAt := runtime.ExtractTime(notification.GetReadAt())
+		m.SetReadAt(notificationReadAt)
+	}
+	if notification.GetRefId() != nil {
+		notificationRefID := int(notification.GetRefId().GetValue())
+		m.SetRefID(notificationRefID)
+	}
+	if notification.GetTaskId() != nil {
+		notificationTaskID := int(notification.GetTaskId().GetValue())
+		m.SetTaskID(notificationTaskID)
+	}
+	notificationTitle := notification.GetTitle()
+	m.SetTitle(notificationTitle)
+	notificationType := toEntNotification_Type(notification.GetType())
+	m.SetType(notificationType)
+	return m, nil
+}
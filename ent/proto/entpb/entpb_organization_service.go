@@ -9,11 +9,16 @@ import (
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	fmt "fmt"
 	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	attachment "github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	attendancerecord "github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
 	department "github.com/longgggwwww/hrm-ms-hr/ent/department"
+	holiday "github.com/longgggwwww/hrm-ms-hr/ent/holiday"
 	label "github.com/longgggwwww/hrm-ms-hr/ent/label"
 	leaverequest "github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
 	organization "github.com/longgggwwww/hrm-ms-hr/ent/organization"
 	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	salarygrade "github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	shift "github.com/longgggwwww/hrm-ms-hr/ent/shift"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -42,6 +47,10 @@ func toProtoOrganization(e *ent.Organization) (*Organization, error) {
 		address := wrapperspb.String(*e.Address)
 		v.Address = address
 	}
+	attachment_max_file_size := e.AttachmentMaxFileSize
+	v.AttachmentMaxFileSize = attachment_max_file_size
+	attachment_quota := e.AttachmentQuota
+	v.AttachmentQuota = attachment_quota
 	code := e.Code
 	v.Code = code
 	created_at := timestamppb.New(e.CreatedAt)
@@ -66,12 +75,26 @@ func toProtoOrganization(e *ent.Organization) (*Organization, error) {
 		phone := wrapperspb.String(*e.Phone)
 		v.Phone = phone
 	}
+	task_seq := int64(e.TaskSeq)
+	v.TaskSeq = task_seq
 	updated_at := timestamppb.New(e.UpdatedAt)
 	v.UpdatedAt = updated_at
 	if e.Website != nil {
 		website := wrapperspb.String(*e.Website)
 		v.Website = website
 	}
+	for _, edg := range e.Edges.Attachments {
+		id := int64(edg.ID)
+		v.Attachments = append(v.Attachments, &Attachment{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.AttendanceRecords {
+		id := int64(edg.ID)
+		v.AttendanceRecords = append(v.AttendanceRecords, &AttendanceRecord{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.Children {
 		id := int64(edg.ID)
 		v.Children = append(v.Children, &Organization{
@@ -84,6 +107,12 @@ func toProtoOrganization(e *ent.Organization) (*Organization, error) {
 			Id: id,
 		})
 	}
+	for _, edg := range e.Edges.Holidays {
+		id := int64(edg.ID)
+		v.Holidays = append(v.Holidays, &Holiday{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.Labels {
 		id := int64(edg.ID)
 		v.Labels = append(v.Labels, &Label{
@@ -108,6 +137,18 @@ func toProtoOrganization(e *ent.Organization) (*Organization, error) {
 			Id: id,
 		})
 	}
+	for _, edg := range e.Edges.SalaryGrades {
+		id := int64(edg.ID)
+		v.SalaryGrades = append(v.SalaryGrades, &SalaryGrade{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Shifts {
+		id := int64(edg.ID)
+		v.Shifts = append(v.Shifts, &Shift{
+			Id: id,
+		})
+	}
 	return v, nil
 }
 
@@ -162,12 +203,21 @@ func (svc *OrganizationService) Get(ctx context.Context, req *GetOrganizationReq
 	case GetOrganizationRequest_WITH_EDGE_IDS:
 		get, err = svc.client.Organization.Query().
 			Where(organization.ID(id)).
+			WithAttachments(func(query *ent.AttachmentQuery) {
+				query.Select(attachment.FieldID)
+			}).
+			WithAttendanceRecords(func(query *ent.AttendanceRecordQuery) {
+				query.Select(attendancerecord.FieldID)
+			}).
 			WithChildren(func(query *ent.OrganizationQuery) {
 				query.Select(organization.FieldID)
 			}).
 			WithDepartments(func(query *ent.DepartmentQuery) {
 				query.Select(department.FieldID)
 			}).
+			WithHolidays(func(query *ent.HolidayQuery) {
+				query.Select(holiday.FieldID)
+			}).
 			WithLabels(func(query *ent.LabelQuery) {
 				query.Select(label.FieldID)
 			}).
@@ -180,6 +230,12 @@ func (svc *OrganizationService) Get(ctx context.Context, req *GetOrganizationReq
 			WithProjects(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
+			WithSalaryGrades(func(query *ent.SalaryGradeQuery) {
+				query.Select(salarygrade.FieldID)
+			}).
+			WithShifts(func(query *ent.ShiftQuery) {
+				query.Select(shift.FieldID)
+			}).
 			Only(ctx)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
@@ -204,6 +260,10 @@ func (svc *OrganizationService) Update(ctx context.Context, req *UpdateOrganizat
 		organizationAddress := organization.GetAddress().GetValue()
 		m.SetAddress(organizationAddress)
 	}
+	organizationAttachmentMaxFileSize := int64(organization.GetAttachmentMaxFileSize())
+	m.SetAttachmentMaxFileSize(organizationAttachmentMaxFileSize)
+	organizationAttachmentQuota := int64(organization.GetAttachmentQuota())
+	m.SetAttachmentQuota(organizationAttachmentQuota)
 	organizationCode := organization.GetCode()
 	m.SetCode(organizationCode)
 	organizationCreatedAt := runtime.ExtractTime(organization.GetCreatedAt())
@@ -226,12 +286,22 @@ func (svc *OrganizationService) Update(ctx context.Context, req *UpdateOrganizat
 		organizationPhone := organization.GetPhone().GetValue()
 		m.SetPhone(organizationPhone)
 	}
+	organizationTaskSeq := int(organization.GetTaskSeq())
+	m.SetTaskSeq(organizationTaskSeq)
 	organizationUpdatedAt := runtime.ExtractTime(organization.GetUpdatedAt())
 	m.SetUpdatedAt(organizationUpdatedAt)
 	if organization.GetWebsite() != nil {
 		organizationWebsite := organization.GetWebsite().GetValue()
 		m.SetWebsite(organizationWebsite)
 	}
+	for _, item := range organization.GetAttachments() {
+		attachments := int(item.GetId())
+		m.AddAttachmentIDs(attachments)
+	}
+	for _, item := range organization.GetAttendanceRecords() {
+		attendancerecords := int(item.GetId())
+		m.AddAttendanceRecordIDs(attendancerecords)
+	}
 	for _, item := range organization.GetChildren() {
 		children := int(item.GetId())
 		m.AddChildIDs(children)
@@ -240,6 +310,10 @@ func (svc *OrganizationService) Update(ctx context.Context, req *UpdateOrganizat
 		departments := int(item.GetId())
 		m.AddDepartmentIDs(departments)
 	}
+	for _, item := range organization.GetHolidays() {
+		holidays := int(item.GetId())
+		m.AddHolidayIDs(holidays)
+	}
 	for _, item := range organization.GetLabels() {
 		labels := int(item.GetId())
 		m.AddLabelIDs(labels)
@@ -256,6 +330,14 @@ func (svc *OrganizationService) Update(ctx context.Context, req *UpdateOrganizat
 		projects := int(item.GetId())
 		m.AddProjectIDs(projects)
 	}
+	for _, item := range organization.GetSalaryGrades() {
+		salarygrades := int(item.GetId())
+		m.AddSalaryGradeIDs(salarygrades)
+	}
+	for _, item := range organization.GetShifts() {
+		shifts := int(item.GetId())
+		m.AddShiftIDs(shifts)
+	}
 
 	res, err := m.Save(ctx)
 	switch {
@@ -326,12 +408,21 @@ func (svc *OrganizationService) List(ctx context.Context, req *ListOrganizationR
 		entList, err = listQuery.All(ctx)
 	case ListOrganizationRequest_WITH_EDGE_IDS:
 		entList, err = listQuery.
+			WithAttachments(func(query *ent.AttachmentQuery) {
+				query.Select(attachment.FieldID)
+			}).
+			WithAttendanceRecords(func(query *ent.AttendanceRecordQuery) {
+				query.Select(attendancerecord.FieldID)
+			}).
 			WithChildren(func(query *ent.OrganizationQuery) {
 				query.Select(organization.FieldID)
 			}).
 			WithDepartments(func(query *ent.DepartmentQuery) {
 				query.Select(department.FieldID)
 			}).
+			WithHolidays(func(query *ent.HolidayQuery) {
+				query.Select(holiday.FieldID)
+			}).
 			WithLabels(func(query *ent.LabelQuery) {
 				query.Select(label.FieldID)
 			}).
@@ -344,6 +435,12 @@ func (svc *OrganizationService) List(ctx context.Context, req *ListOrganizationR
 			WithProjects(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
+			WithSalaryGrades(func(query *ent.SalaryGradeQuery) {
+				query.Select(salarygrade.FieldID)
+			}).
+			WithShifts(func(query *ent.ShiftQuery) {
+				query.Select(shift.FieldID)
+			}).
 			All(ctx)
 	}
 	switch {
@@ -409,6 +506,10 @@ func (svc *OrganizationService) createBuilder(organization *Organization) (*ent.
 		organizationAddress := organization.GetAddress().GetValue()
 		m.SetAddress(organizationAddress)
 	}
+	organizationAttachmentMaxFileSize := int64(organization.GetAttachmentMaxFileSize())
+	m.SetAttachmentMaxFileSize(organizationAttachmentMaxFileSize)
+	organizationAttachmentQuota := int64(organization.GetAttachmentQuota())
+	m.SetAttachmentQuota(organizationAttachmentQuota)
 	organizationCode := organization.GetCode()
 	m.SetCode(organizationCode)
 	organizationCreatedAt := runtime.ExtractTime(organization.GetCreatedAt())
@@ -431,12 +532,22 @@ func (svc *OrganizationService) createBuilder(organization *Organization) (*ent.
 		organizationPhone := organization.GetPhone().GetValue()
 		m.SetPhone(organizationPhone)
 	}
+	organizationTaskSeq := int(organization.GetTaskSeq())
+	m.SetTaskSeq(organizationTaskSeq)
 	organizationUpdatedAt := runtime.ExtractTime(organization.GetUpdatedAt())
 	m.SetUpdatedAt(organizationUpdatedAt)
 	if organization.GetWebsite() != nil {
 		organizationWebsite := organization.GetWebsite().GetValue()
 		m.SetWebsite(organizationWebsite)
 	}
+	for _, item := range organization.GetAttachments() {
+		attachments := int(item.GetId())
+		m.AddAttachmentIDs(attachments)
+	}
+	for _, item := range organization.GetAttendanceRecords() {
+		attendancerecords := int(item.GetId())
+		m.AddAttendanceRecordIDs(attendancerecords)
+	}
 	for _, item := range organization.GetChildren() {
 		children := int(item.GetId())
 		m.AddChildIDs(children)
@@ -445,6 +556,10 @@ func (svc *OrganizationService) createBuilder(organization *Organization) (*ent.
 		departments := int(item.GetId())
 		m.AddDepartmentIDs(departments)
 	}
+	for _, item := range organization.GetHolidays() {
+		holidays := int(item.GetId())
+		m.AddHolidayIDs(holidays)
+	}
 	for _, item := range organization.GetLabels() {
 		labels := int(item.GetId())
 		m.AddLabelIDs(labels)
@@ -461,5 +576,13 @@ func (svc *OrganizationService) createBuilder(organization *Organization) (*ent.
 		projects := int(item.GetId())
 		m.AddProjectIDs(projects)
 	}
+	for _, item := range organization.GetSalaryGrades() {
+		salarygrades := int(item.GetId())
+		m.AddSalaryGradeIDs(salarygrades)
+	}
+	for _, item := range organization.GetShifts() {
+		shifts := int(item.GetId())
+		m.AddShiftIDs(shifts)
+	}
 	return m, nil
 }
@@ -0,0 +1,487 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	overtimerequest "github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// OvertimeRequestService implements OvertimeRequestServiceServer
+type OvertimeRequestService struct {
+	client *ent.Client
+	UnimplementedOvertimeRequestServiceServer
+}
+
+// NewOvertimeRequestService returns a new OvertimeRequestService
+func NewOvertimeRequestService(client *ent.Client) *OvertimeRequestService {
+	return &OvertimeRequestService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpOvertimeRequest_DayType = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeOvertimeRequest_DayType(e string) string {
+	return protoIdentNormalizeRegexpOvertimeRequest_DayType.ReplaceAllString(e, "_")
+}
+
+func toProtoOvertimeRequest_DayType(e overtimerequest.DayType) OvertimeRequest_DayType {
+	if v, ok := OvertimeRequest_DayType_value[strings.ToUpper("DAY_TYPE_"+protoIdentNormalizeOvertimeRequest_DayType(string(e)))]; ok {
+		return OvertimeRequest_DayType(v)
+	}
+	return OvertimeRequest_DayType(0)
+}
+
+func toEntOvertimeRequest_DayType(e OvertimeRequest_DayType) overtimerequest.DayType {
+	if v, ok := OvertimeRequest_DayType_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"DAY_TYPE_WEEKDAY": "weekday",
+			"DAY_TYPE_WEEKEND": "weekend",
+			"DAY_TYPE_HOLIDAY": "holiday",
+		}[v]
+		return overtimerequest.DayType(entVal)
+	}
+	return ""
+}
+
+var protoIdentNormalizeRegexpOvertimeRequest_Status = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeOvertimeRequest_Status(e string) string {
+	return protoIdentNormalizeRegexpOvertimeRequest_Status.ReplaceAllString(e, "_")
+}
+
+func toProtoOvertimeRequest_Status(e overtimerequest.Status) OvertimeRequest_Status {
+	if v, ok := OvertimeRequest_Status_value[strings.ToUpper("STATUS_"+protoIdentNormalizeOvertimeRequest_Status(string(e)))]; ok {
+		return OvertimeRequest_Status(v)
+	}
+	return OvertimeRequest_Status(0)
+}
+
+func toEntOvertimeRequest_Status(e OvertimeRequest_Status) overtimerequest.Status {
+	if v, ok := OvertimeRequest_Status_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATUS_PENDING":   "pending",
+			"STATUS_APPROVED":  "approved",
+			"STATUS_REJECTED":  "rejected",
+			"STATUS_CANCELLED": "cancelled",
+		}[v]
+		return overtimerequest.Status(entVal)
+	}
+	return ""
+}
+
+// toProtoOvertimeRequest transforms the ent type to the pb type
+func toProtoOvertimeRequest(e *ent.OvertimeRequest) (*OvertimeRequest, error) {
+	v := &OvertimeRequest{}
+	if e.ApproverPositionID != nil {
+		approver_position_id := wrapperspb.Int64(int64(*e.ApproverPositionID))
+		v.ApproverPositionId = approver_position_id
+	}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	day_type := toProtoOvertimeRequest_DayType(e.DayType)
+	v.DayType = day_type
+	employee := int64(e.EmployeeID)
+	v.EmployeeId = employee
+	hours := e.Hours
+	v.Hours = hours
+	id := int64(e.ID)
+	v.Id = id
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	if e.ProjectID != nil {
+		project := wrapperspb.Int64(int64(*e.ProjectID))
+		v.ProjectId = project
+	}
+	reason := e.Reason
+	v.Reason = reason
+	if e.ReviewComment != nil {
+		review_comment := wrapperspb.String(*e.ReviewComment)
+		v.ReviewComment = review_comment
+	}
+	if e.ReviewedAt != nil {
+		reviewed_at := timestamppb.New(*e.ReviewedAt)
+		v.ReviewedAt = reviewed_at
+	}
+	if e.ReviewerID != nil {
+		reviewer_id := wrapperspb.Int64(int64(*e.ReviewerID))
+		v.ReviewerId = reviewer_id
+	}
+	status := toProtoOvertimeRequest_Status(e.Status)
+	v.Status = status
+	if e.TaskID != nil {
+		task := wrapperspb.Int64(int64(*e.TaskID))
+		v.TaskId = task
+	}
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	work_date := timestamppb.New(e.WorkDate)
+	v.WorkDate = work_date
+	if edg := e.Edges.Employee; edg != nil {
+		id := int64(edg.ID)
+		v.Employee = &Employee{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Project; edg != nil {
+		id := int64(edg.ID)
+		v.Project = &Project{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Task; edg != nil {
+		id := int64(edg.ID)
+		v.Task = &Task{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoOvertimeRequestList transforms a list of ent type to a list of pb type
+func toProtoOvertimeRequestList(e []*ent.OvertimeRequest) ([]*OvertimeRequest, error) {
+	var pbList []*OvertimeRequest
+	for _, entEntity := range e {
+		pbEntity, err := toProtoOvertimeRequest(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements OvertimeRequestServiceServer.Create
+func (svc *OvertimeRequestService) Create(ctx context.Context, req *CreateOvertimeRequestRequest) (*OvertimeRequest, error) {
+	overtimerequest := req.GetOvertimeRequest()
+	m, err := svc.createBuilder(overtimerequest)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoOvertimeRequest(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements OvertimeRequestServiceServer.Get
+func (svc *OvertimeRequestService) Get(ctx context.Context, req *GetOvertimeRequestRequest) (*OvertimeRequest, error) {
+	var (
+		err error
+		get *ent.OvertimeRequest
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetOvertimeRequestRequest_VIEW_UNSPECIFIED, GetOvertimeRequestRequest_BASIC:
+		get, err = svc.client.OvertimeRequest.Get(ctx, id)
+	case GetOvertimeRequestRequest_WITH_EDGE_IDS:
+		get, err = svc.client.OvertimeRequest.Query().
+			Where(overtimerequest.ID(id)).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoOvertimeRequest(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements OvertimeRequestServiceServer.Update
+func (svc *OvertimeRequestService) Update(ctx context.Context, req *UpdateOvertimeRequestRequest) (*OvertimeRequest, error) {
+	overtimerequest := req.GetOvertimeRequest()
+	overtimerequestID := int(overtimerequest.GetId())
+	m := svc.client.OvertimeRequest.UpdateOneID(overtimerequestID)
+	if overtimerequest.GetApproverPositionId() != nil {
+		overtimerequestApproverPositionID := int(overtimerequest.GetApproverPositionId().GetValue())
+		m.SetApproverPositionID(overtimerequestApproverPositionID)
+	}
+	overtimerequestCreatedAt := runtime.ExtractTime(overtimerequest.GetCreatedAt())
+	m.SetCreatedAt(overtimerequestCreatedAt)
+	overtimerequestDayType := toEntOvertimeRequest_DayType(overtimerequest.GetDayType())
+	m.SetDayType(overtimerequestDayType)
+	overtimerequestEmployeeID := int(overtimerequest.GetEmployeeId())
+	m.SetEmployeeID(overtimerequestEmployeeID)
+	overtimerequestHours := float64(overtimerequest.GetHours())
+	m.SetHours(overtimerequestHours)
+	overtimerequestOrgID := int(overtimerequest.GetOrgId())
+	m.SetOrgID(overtimerequestOrgID)
+	if overtimerequest.GetProjectId() != nil {
+		overtimerequestProjectID := int(overtimerequest.GetProjectId().GetValue())
+		m.SetProjectID(overtimerequestProjectID)
+	}
+	overtimerequestReason := overtimerequest.GetReason()
+	m.SetReason(overtimerequestReason)
+	if overtimerequest.GetReviewComment() != nil {
+		overtimerequestReviewComment := overtimerequest.GetReviewComment().GetValue()
+		m.SetReviewComment(overtimerequestReviewComment)
+	}
+	if overtimerequest.GetReviewedAt() != nil {
+		overtimerequestReviewedAt := runtime.ExtractTime(overtimerequest.GetReviewedAt())
+		m.SetReviewedAt(overtimerequestReviewedAt)
+	}
+	if overtimerequest.GetReviewerId() != nil {
+		overtimerequestReviewerID := int(overtimerequest.GetReviewerId().GetValue())
+		m.SetReviewerID(overtimerequestReviewerID)
+	}
+	overtimerequestStatus := toEntOvertimeRequest_Status(overtimerequest.GetStatus())
+	m.SetStatus(overtimerequestStatus)
+	if overtimerequest.GetTaskId() != nil {
+		overtimerequestTaskID := int(overtimerequest.GetTaskId().GetValue())
+		m.SetTaskID(overtimerequestTaskID)
+	}
+	overtimerequestUpdatedAt := runtime.ExtractTime(overtimerequest.GetUpdatedAt())
+	m.SetUpdatedAt(overtimerequestUpdatedAt)
+	overtimerequestWorkDate := runtime.ExtractTime(overtimerequest.GetWorkDate())
+	m.SetWorkDate(overtimerequestWorkDate)
+	if overtimerequest.GetEmployee() != nil {
+		overtimerequestEmployee := int(overtimerequest.GetEmployee().GetId())
+		m.SetEmployeeID(overtimerequestEmployee)
+	}
+	if overtimerequest.GetProject() != nil {
+		overtimerequestProject := int(overtimerequest.GetProject().GetId())
+		m.SetProjectID(overtimerequestProject)
+	}
+	if overtimerequest.GetTask() != nil {
+		overtimerequestTask := int(overtimerequest.GetTask().GetId())
+		m.SetTaskID(overtimerequestTask)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoOvertimeRequest(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements OvertimeRequestServiceServer.Delete
+func (svc *OvertimeRequestService) Delete(ctx context.Context, req *DeleteOvertimeRequestRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.OvertimeRequest.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements OvertimeRequestServiceServer.List
+func (svc *OvertimeRequestService) List(ctx context.Context, req *ListOvertimeRequestRequest) (*ListOvertimeRequestResponse, error) {
+	var (
+		err      error
+		entList  []*ent.OvertimeRequest
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.OvertimeRequest.Query().
+		Order(ent.Desc(overtimerequest.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(overtimerequest.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListOvertimeRequestRequest_VIEW_UNSPECIFIED, ListOvertimeRequestRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListOvertimeRequestRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoOvertimeRequestList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListOvertimeRequestResponse{
+			OvertimeRequestList: protoList,
+			NextPageToken:       nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements OvertimeRequestServiceServer.BatchCreate
+func (svc *OvertimeRequestService) BatchCreate(ctx context.Context, req *BatchCreateOvertimeRequestsRequest) (*BatchCreateOvertimeRequestsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.OvertimeRequestCreate, len(requests))
+	for i, req := range requests {
+		overtimerequest := req.GetOvertimeRequest()
+		var err error
+		bulk[i], err = svc.createBuilder(overtimerequest)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.OvertimeRequest.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoOvertimeRequestList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateOvertimeRequestsResponse{
+			OvertimeRequests: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *OvertimeRequestService) createBuilder(overtimerequest *OvertimeRequest) (*ent.OvertimeRequestCreate, error) {
+	m := svc.client.OvertimeRequest.Create()
+	if overtimerequest.GetApproverPositionId() != nil {
+		overtimerequestApproverPositionID := int(overtimerequest.GetApproverPositionId().GetValue())
+		m.SetApproverPositionID(overtimerequestApproverPositionID)
+	}
+	overtimerequestCreatedAt := runtime.ExtractTime(overtimerequest.GetCreatedAt())
+	m.SetCreatedAt(overtimerequestCreatedAt)
+	overtimerequestDayType := toEntOvertimeRequest_DayType(overtimerequest.GetDayType())
+	m.SetDayType(overtimerequestDayType)
+	overtimerequestEmployeeID := int(overtimerequest.GetEmployeeId())
+	m.SetEmployeeID(overtimerequestEmployeeID)
+	overtimerequestHours := float64(overtimerequest.GetHours())
+	m.SetHours(overtimerequestHours)
+	overtimerequestOrgID := int(overtimerequest.GetOrgId())
+	m.SetOrgID(overtimerequestOrgID)
+	if overtimerequest.GetProjectId() != nil {
+		overtimerequestProjectID := int(overtimerequest.GetProjectId().GetValue())
+		m.SetProjectID(overtimerequestProjectID)
+	}
+	overtimerequestReason := overtimerequest.GetReason()
+	m.SetReason(overtimerequestReason)
+	if overtimerequest.GetReviewComment() != nil {
+		overtimerequestReviewComment := overtimerequest.GetReviewComment().GetValue()
+		m.SetReviewComment(overtimerequestReviewComment)
+	}
+	if overtimerequest.GetReviewedAt() != nil {
+		overtimerequestReviewedAt := runtime.ExtractTime(overtimerequest.GetReviewedAt())
+		m.SetReviewedAt(overtimerequestReviewedAt)
+	}
+	if overtimerequest.GetReviewerId() != nil {
+		overtimerequestReviewerID := int(overtimerequest.GetReviewerId().GetValue())
+		m.SetReviewerID(overtimerequestReviewerID)
+	}
+	overtimerequestStatus := toEntOvertimeRequest_Status(overtimerequest.GetStatus())
+	m.SetStatus(overtimerequestStatus)
+	if overtimerequest.GetTaskId() != nil {
+		overtimerequestTaskID := int(overtimerequest.GetTaskId().GetValue())
+		m.SetTaskID(overtimerequestTaskID)
+	}
+	overtimerequestUpdatedAt := runtime.ExtractTime(overtimerequest.GetUpdatedAt())
+	m.SetUpdatedAt(overtimerequestUpdatedAt)
+	overtimerequestWorkDate := runtime.ExtractTime(overtimerequest.GetWorkDate())
+	m.SetWorkDate(overtimerequestWorkDate)
+	if overtimerequest.GetEmployee() != nil {
+		overtimerequestEmployee := int(overtimerequest.GetEmployee().GetId())
+		m.SetEmployeeID(overtimerequestEmployee)
+	}
+	if overtimerequest.GetProject() != nil {
+		overtimerequestProject := int(overtimerequest.GetProject().GetId())
+		m.SetProjectID(overtimerequestProject)
+	}
+	if overtimerequest.GetTask() != nil {
+		overtimerequestTask := int(overtimerequest.GetTask().GetId())
+		m.SetTaskID(overtimerequestTask)
+	}
+	return m, nil
+}
@@ -9,9 +9,11 @@ import (
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	fmt "fmt"
 	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	appointmenthistory "github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
 	department "github.com/longgggwwww/hrm-ms-hr/ent/department"
 	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	position "github.com/longgggwwww/hrm-ms-hr/ent/position"
+	salarygrade "github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -50,6 +52,12 @@ func toProtoPosition(e *ent.Position) (*Position, error) {
 	v.ParentId = parent
 	updated_at := timestamppb.New(e.UpdatedAt)
 	v.UpdatedAt = updated_at
+	for _, edg := range e.Edges.AppointmentHistories {
+		id := int64(edg.ID)
+		v.AppointmentHistories = append(v.AppointmentHistories, &AppointmentHistory{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.Children {
 		id := int64(edg.ID)
 		v.Children = append(v.Children, &Position{
@@ -74,6 +82,12 @@ func toProtoPosition(e *ent.Position) (*Position, error) {
 			Id: id,
 		}
 	}
+	for _, edg := range e.Edges.SalaryGrades {
+		id := int64(edg.ID)
+		v.SalaryGrades = append(v.SalaryGrades, &SalaryGrade{
+			Id: id,
+		})
+	}
 	return v, nil
 }
 
@@ -128,6 +142,9 @@ func (svc *PositionService) Get(ctx context.Context, req *GetPositionRequest) (*
 	case GetPositionRequest_WITH_EDGE_IDS:
 		get, err = svc.client.Position.Query().
 			Where(position.ID(id)).
+			WithAppointmentHistories(func(query *ent.AppointmentHistoryQuery) {
+				query.Select(appointmenthistory.FieldID)
+			}).
 			WithChildren(func(query *ent.PositionQuery) {
 				query.Select(position.FieldID)
 			}).
@@ -140,6 +157,9 @@ func (svc *PositionService) Get(ctx context.Context, req *GetPositionRequest) (*
 			WithParent(func(query *ent.PositionQuery) {
 				query.Select(position.FieldID)
 			}).
+			WithSalaryGrades(func(query *ent.SalaryGradeQuery) {
+				query.Select(salarygrade.FieldID)
+			}).
 			Only(ctx)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
@@ -172,6 +192,10 @@ func (svc *PositionService) Update(ctx context.Context, req *UpdatePositionReque
 	}
 	positionUpdatedAt := runtime.ExtractTime(position.GetUpdatedAt())
 	m.SetUpdatedAt(positionUpdatedAt)
+	for _, item := range position.GetAppointmentHistories() {
+		appointmenthistories := int(item.GetId())
+		m.AddAppointmentHistoryIDs(appointmenthistories)
+	}
 	for _, item := range position.GetChildren() {
 		children := int(item.GetId())
 		m.AddChildIDs(children)
@@ -188,6 +212,10 @@ func (svc *PositionService) Update(ctx context.Context, req *UpdatePositionReque
 		positionParent := int(position.GetParent().GetId())
 		m.SetParentID(positionParent)
 	}
+	for _, item := range position.GetSalaryGrades() {
+		salarygrades := int(item.GetId())
+		m.AddSalaryGradeIDs(salarygrades)
+	}
 
 	res, err := m.Save(ctx)
 	switch {
@@ -258,6 +286,9 @@ func (svc *PositionService) List(ctx context.Context, req *ListPositionRequest)
 		entList, err = listQuery.All(ctx)
 	case ListPositionRequest_WITH_EDGE_IDS:
 		entList, err = listQuery.
+			WithAppointmentHistories(func(query *ent.AppointmentHistoryQuery) {
+				query.Select(appointmenthistory.FieldID)
+			}).
 			WithChildren(func(query *ent.PositionQuery) {
 				query.Select(position.FieldID)
 			}).
@@ -270,6 +301,9 @@ func (svc *PositionService) List(ctx context.Context, req *ListPositionRequest)
 			WithParent(func(query *ent.PositionQuery) {
 				query.Select(position.FieldID)
 			}).
+			WithSalaryGrades(func(query *ent.SalaryGradeQuery) {
+				query.Select(salarygrade.FieldID)
+			}).
 			All(ctx)
 	}
 	switch {
@@ -345,6 +379,10 @@ func (svc *PositionService) createBuilder(position *Position) (*ent.PositionCrea
 	}
 	positionUpdatedAt := runtime.ExtractTime(position.GetUpdatedAt())
 	m.SetUpdatedAt(positionUpdatedAt)
+	for _, item := range position.GetAppointmentHistories() {
+		appointmenthistories := int(item.GetId())
+		m.AddAppointmentHistoryIDs(appointmenthistories)
+	}
 	for _, item := range position.GetChildren() {
 		children := int(item.GetId())
 		m.AddChildIDs(children)
@@ -361,5 +399,9 @@ func (svc *PositionService) createBuilder(position *Position) (*ent.PositionCrea
 		positionParent := int(position.GetParent().GetId())
 		m.SetParentID(positionParent)
 	}
+	for _, item := range position.GetSalaryGrades() {
+		salarygrades := int(item.GetId())
+		m.AddSalaryGradeIDs(salarygrades)
+	}
 	return m, nil
 }
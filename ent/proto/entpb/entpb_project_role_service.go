@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	projectrole "github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// ProjectRoleService implements ProjectRoleServiceServer
+type ProjectRoleService struct {
+	client *ent.Client
+	UnimplementedProjectRoleServiceServer
+}
+
+// NewProjectRoleService returns a new ProjectRoleService
+func NewProjectRoleService(client *ent.Client) *ProjectRoleService {
+	return &ProjectRoleService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpProjectRole_Role = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeProjectRole_Role(e string) string {
+	return protoIdentNormalizeRegexpProjectRole_Role.ReplaceAllString(e, "_")
+}
+
+func toProtoProjectRole_Role(e projectrole.Role) ProjectRole_Role {
+	if v, ok := ProjectRole_Role_value[strings.ToUpper("ROLE_"+protoIdentNormalizeProjectRole_Role(string(e)))]; ok {
+		return ProjectRole_Role(v)
+	}
+	return ProjectRole_Role(0)
+}
+
+func toEntProjectRole_Role(e ProjectRole_Role) projectrole.Role {
+	if v, ok := ProjectRole_Role_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"ROLE_OWNER":   "owner",
+			"ROLE_MANAGER": "manager",
+			"ROLE_MEMBER":  "member",
+			"ROLE_VIEWER":  "viewer",
+		}[v]
+		return projectrole.Role(entVal)
+	}
+	return ""
+}
+
+// toProtoProjectRole transforms the ent type to the pb type
+func toProtoProjectRole(e *ent.ProjectRole) (*ProjectRole, error) {
+	v := &ProjectRole{}
+	employee_id := int64(e.EmployeeID)
+	v.EmployeeId = employee_id
+	id := int64(e.ID)
+	v.Id = id
+	project := int64(e.ProjectID)
+	v.ProjectId = project
+	role := toProtoProjectRole_Role(e.Role)
+	v.Role = role
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Project; edg != nil {
+		id := int64(edg.ID)
+		v.Project = &Project{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoProjectRoleList transforms a list of ent type to a list of pb type
+func toProtoProjectRoleList(e []*ent.ProjectRole) ([]*ProjectRole, error) {
+	var pbList []*ProjectRole
+	for _, entEntity := range e {
+		pbEntity, err := toProtoProjectRole(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements ProjectRoleServiceServer.Create
+func (svc *ProjectRoleService) Create(ctx context.Context, req *CreateProjectRoleRequest) (*ProjectRole, error) {
+	projectrole := req.GetProjectRole()
+	m, err := svc.createBuilder(projectrole)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoProjectRole(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements ProjectRoleServiceServer.Get
+func (svc *ProjectRoleService) Get(ctx context.Context, req *GetProjectRoleRequest) (*ProjectRole, error) {
+	var (
+		err error
+		get *ent.ProjectRole
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetProjectRoleRequest_VIEW_UNSPECIFIED, GetProjectRoleRequest_BASIC:
+		get, err = svc.client.ProjectRole.Get(ctx, id)
+	case GetProjectRoleRequest_WITH_EDGE_IDS:
+		get, err = svc.client.ProjectRole.Query().
+			Where(projectrole.ID(id)).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoProjectRole(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements ProjectRoleServiceServer.Update
+func (svc *ProjectRoleService) Update(ctx context.Context, req *UpdateProjectRoleRequest) (*ProjectRole, error) {
+	projectrole := req.GetProjectRole()
+	projectroleID := int(projectrole.GetId())
+	m := svc.client.ProjectRole.UpdateOneID(projectroleID)
+	projectroleEmployeeID := int(projectrole.GetEmployeeId())
+	m.SetEmployeeID(projectroleEmployeeID)
+	projectroleProjectID := int(projectrole.GetProjectId())
+	m.SetProjectID(projectroleProjectID)
+	projectroleRole := toEntProjectRole_Role(projectrole.GetRole())
+	m.SetRole(projectroleRole)
+	projectroleUpdatedAt := runtime.ExtractTime(projectrole.GetUpdatedAt())
+	m.SetUpdatedAt(projectroleUpdatedAt)
+	if projectrole.GetProject() != nil {
+		projectroleProject := int(projectrole.GetProject().GetId())
+		m.SetProjectID(projectroleProject)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoProjectRole(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements ProjectRoleServiceServer.Delete
+func (svc *ProjectRoleService) Delete(ctx context.Context, req *DeleteProjectRoleRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.ProjectRole.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements ProjectRoleServiceServer.List
+func (svc *ProjectRoleService) List(ctx context.Context, req *ListProjectRoleRequest) (*ListProjectRoleResponse, error) {
+	var (
+		err      error
+		entList  []*ent.ProjectRole
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.ProjectRole.Query().
+		Order(ent.Desc(projectrole.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(projectrole.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListProjectRoleRequest_VIEW_UNSPECIFIED, ListProjectRoleRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListProjectRoleRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoProjectRoleList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListProjectRoleResponse{
+			ProjectRoleList: protoList,
+			NextPageToken:   nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements ProjectRoleServiceServer.BatchCreate
+func (svc *ProjectRoleService) BatchCreate(ctx context.Context, req *BatchCreateProjectRolesRequest) (*BatchCreateProjectRolesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.ProjectRoleCreate, len(requests))
+	for i, req := range requests {
+		projectrole := req.GetProjectRole()
+		var err error
+		bulk[i], err = svc.createBuilder(projectrole)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.ProjectRole.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoProjectRoleList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateProjectRolesResponse{
+			ProjectRoles: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *ProjectRoleService) createBuilder(projectrole *ProjectRole) (*ent.ProjectRoleCreate, error) {
+	m := svc.client.ProjectRole.Create()
+	projectroleEmployeeID := int(projectrole.GetEmployeeId())
+	m.SetEmployeeID(projectroleEmployeeID)
+	projectroleProjectID := int(projectrole.GetProjectId())
+	m.SetProjectID(projectroleProjectID)
+	projectroleRole := toEntProjectRole_Role(projectrole.GetRole())
+	m.SetRole(projectroleRole)
+	projectroleUpdatedAt := runtime.ExtractTime(projectrole.GetUpdatedAt())
+	m.SetUpdatedAt(projectroleUpdatedAt)
+	if projectrole.GetProject() != nil {
+		projectroleProject := int(projectrole.GetProject().GetId())
+		m.SetProjectID(projectroleProject)
+	}
+	return m, nil
+}
@@ -10,9 +10,17 @@ import (
 	fmt "fmt"
 	ent "github.com/longgggwwww/hrm-ms-hr/ent"
 	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	milestone "github.com/longgggwwww/hrm-ms-hr/ent/milestone"
 	organization "github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	overtimerequest "github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	projectrole "github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+	sprint "github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	tasktemplate "github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	taskview "github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	workflowstatus "github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	workflowtransition "github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -80,6 +88,12 @@ func toProtoProject(e *ent.Project) (*Project, error) {
 	}
 	id := int64(e.ID)
 	v.Id = id
+	if e.Key != nil {
+		key := wrapperspb.String(*e.Key)
+		v.Key = key
+	}
+	manual_progress := e.ManualProgress
+	v.ManualProgress = manual_progress
 	name := e.Name
 	v.Name = name
 	organization := int64(e.OrgID)
@@ -92,6 +106,8 @@ func toProtoProject(e *ent.Project) (*Project, error) {
 	}
 	status := toProtoProject_Status(e.Status)
 	v.Status = status
+	task_seq := int64(e.TaskSeq)
+	v.TaskSeq = task_seq
 	updated_at := timestamppb.New(e.UpdatedAt)
 	v.UpdatedAt = updated_at
 	updater := int64(e.UpdaterID)
@@ -108,12 +124,48 @@ func toProtoProject(e *ent.Project) (*Project, error) {
 			Id: id,
 		})
 	}
+	for _, edg := range e.Edges.Milestones {
+		id := int64(edg.ID)
+		v.Milestones = append(v.Milestones, &Milestone{
+			Id: id,
+		})
+	}
 	if edg := e.Edges.Organization; edg != nil {
 		id := int64(edg.ID)
 		v.Organization = &Organization{
 			Id: id,
 		}
 	}
+	for _, edg := range e.Edges.OvertimeRequests {
+		id := int64(edg.ID)
+		v.OvertimeRequests = append(v.OvertimeRequests, &OvertimeRequest{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Roles {
+		id := int64(edg.ID)
+		v.Roles = append(v.Roles, &ProjectRole{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Sprints {
+		id := int64(edg.ID)
+		v.Sprints = append(v.Sprints, &Sprint{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.TaskTemplates {
+		id := int64(edg.ID)
+		v.TaskTemplates = append(v.TaskTemplates, &TaskTemplate{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.TaskViews {
+		id := int64(edg.ID)
+		v.TaskViews = append(v.TaskViews, &TaskView{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.Tasks {
 		id := int64(edg.ID)
 		v.Tasks = append(v.Tasks, &Task{
@@ -126,6 +178,18 @@ func toProtoProject(e *ent.Project) (*Project, error) {
 			Id: id,
 		}
 	}
+	for _, edg := range e.Edges.WorkflowStatuses {
+		id := int64(edg.ID)
+		v.WorkflowStatuses = append(v.WorkflowStatuses, &WorkflowStatus{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.WorkflowTransitions {
+		id := int64(edg.ID)
+		v.WorkflowTransitions = append(v.WorkflowTransitions, &WorkflowTransition{
+			Id: id,
+		})
+	}
 	return v, nil
 }
 
@@ -186,15 +250,39 @@ func (svc *ProjectService) Get(ctx context.Context, req *GetProjectRequest) (*Pr
 			WithMembers(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithMilestones(func(query *ent.MilestoneQuery) {
+				query.Select(milestone.FieldID)
+			}).
 			WithOrganization(func(query *ent.OrganizationQuery) {
 				query.Select(organization.FieldID)
 			}).
+			WithOvertimeRequests(func(query *ent.OvertimeRequestQuery) {
+				query.Select(overtimerequest.FieldID)
+			}).
+			WithRoles(func(query *ent.ProjectRoleQuery) {
+				query.Select(projectrole.FieldID)
+			}).
+			WithSprints(func(query *ent.SprintQuery) {
+				query.Select(sprint.FieldID)
+			}).
+			WithTaskTemplates(func(query *ent.TaskTemplateQuery) {
+				query.Select(tasktemplate.FieldID)
+			}).
+			WithTaskViews(func(query *ent.TaskViewQuery) {
+				query.Select(taskview.FieldID)
+			}).
 			WithTasks(func(query *ent.TaskQuery) {
 				query.Select(task.FieldID)
 			}).
 			WithUpdater(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithWorkflowStatuses(func(query *ent.WorkflowStatusQuery) {
+				query.Select(workflowstatus.FieldID)
+			}).
+			WithWorkflowTransitions(func(query *ent.WorkflowTransitionQuery) {
+				query.Select(workflowtransition.FieldID)
+			}).
 			Only(ctx)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
@@ -227,6 +315,12 @@ func (svc *ProjectService) Update(ctx context.Context, req *UpdateProjectRequest
 		projectEndAt := runtime.ExtractTime(project.GetEndAt())
 		m.SetEndAt(projectEndAt)
 	}
+	if project.GetKey() != nil {
+		projectKey := project.GetKey().GetValue()
+		m.SetKey(projectKey)
+	}
+	projectManualProgress := project.GetManualProgress()
+	m.SetManualProgress(projectManualProgress)
 	projectName := project.GetName()
 	m.SetName(projectName)
 	projectOrgID := int(project.GetOrgId())
@@ -241,6 +335,8 @@ func (svc *ProjectService) Update(ctx context.Context, req *UpdateProjectRequest
 	}
 	projectStatus := toEntProject_Status(project.GetStatus())
 	m.SetStatus(projectStatus)
+	projectTaskSeq := int(project.GetTaskSeq())
+	m.SetTaskSeq(projectTaskSeq)
 	projectUpdatedAt := runtime.ExtractTime(project.GetUpdatedAt())
 	m.SetUpdatedAt(projectUpdatedAt)
 	projectUpdaterID := int(project.GetUpdaterId())
@@ -253,10 +349,34 @@ func (svc *ProjectService) Update(ctx context.Context, req *UpdateProjectRequest
 		members := int(item.GetId())
 		m.AddMemberIDs(members)
 	}
+	for _, item := range project.GetMilestones() {
+		milestones := int(item.GetId())
+		m.AddMilestoneIDs(milestones)
+	}
 	if project.GetOrganization() != nil {
 		projectOrganization := int(project.GetOrganization().GetId())
 		m.SetOrganizationID(projectOrganization)
 	}
+	for _, item := range project.GetOvertimeRequests() {
+		overtimerequests := int(item.GetId())
+		m.AddOvertimeRequestIDs(overtimerequests)
+	}
+	for _, item := range project.GetRoles() {
+		roles := int(item.GetId())
+		m.AddRoleIDs(roles)
+	}
+	for _, item := range project.GetSprints() {
+		sprints := int(item.GetId())
+		m.AddSprintIDs(sprints)
+	}
+	for _, item := range project.GetTaskTemplates() {
+		tasktemplates := int(item.GetId())
+		m.AddTaskTemplateIDs(tasktemplates)
+	}
+	for _, item := range project.GetTaskViews() {
+		taskviews := int(item.GetId())
+		m.AddTaskViewIDs(taskviews)
+	}
 	for _, item := range project.GetTasks() {
 		tasks := int(item.GetId())
 		m.AddTaskIDs(tasks)
@@ -265,6 +385,14 @@ func (svc *ProjectService) Update(ctx context.Context, req *UpdateProjectRequest
 		projectUpdater := int(project.GetUpdater().GetId())
 		m.SetUpdaterID(projectUpdater)
 	}
+	for _, item := range project.GetWorkflowStatuses() {
+		workflowstatuses := int(item.GetId())
+		m.AddWorkflowStatusIDs(workflowstatuses)
+	}
+	for _, item := range project.GetWorkflowTransitions() {
+		workflowtransitions := int(item.GetId())
+		m.AddWorkflowTransitionIDs(workflowtransitions)
+	}
 
 	res, err := m.Save(ctx)
 	switch {
@@ -341,15 +469,39 @@ func (svc *ProjectService) List(ctx context.Context, req *ListProjectRequest) (*
 			WithMembers(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithMilestones(func(query *ent.MilestoneQuery) {
+				query.Select(milestone.FieldID)
+			}).
 			WithOrganization(func(query *ent.OrganizationQuery) {
 				query.Select(organization.FieldID)
 			}).
+			WithOvertimeRequests(func(query *ent.OvertimeRequestQuery) {
+				query.Select(overtimerequest.FieldID)
+			}).
+			WithRoles(func(query *ent.ProjectRoleQuery) {
+				query.Select(projectrole.FieldID)
+			}).
+			WithSprints(func(query *ent.SprintQuery) {
+				query.Select(sprint.FieldID)
+			}).
+			WithTaskTemplates(func(query *ent.TaskTemplateQuery) {
+				query.Select(tasktemplate.FieldID)
+			}).
+			WithTaskViews(func(query *ent.TaskViewQuery) {
+				query.Select(taskview.FieldID)
+			}).
 			WithTasks(func(query *ent.TaskQuery) {
 				query.Select(task.FieldID)
 			}).
 			WithUpdater(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithWorkflowStatuses(func(query *ent.WorkflowStatusQuery) {
+				query.Select(workflowstatus.FieldID)
+			}).
+			WithWorkflowTransitions(func(query *ent.WorkflowTransitionQuery) {
+				query.Select(workflowtransition.FieldID)
+			}).
 			All(ctx)
 	}
 	switch {
@@ -425,6 +577,12 @@ func (svc *ProjectService) createBuilder(project *Project) (*ent.ProjectCreate,
 		projectEndAt := runtime.ExtractTime(project.GetEndAt())
 		m.SetEndAt(projectEndAt)
 	}
+	if project.GetKey() != nil {
+		projectKey := project.GetKey().GetValue()
+		m.SetKey(projectKey)
+	}
+	projectManualProgress := project.GetManualProgress()
+	m.SetManualProgress(projectManualProgress)
 	projectName := project.GetName()
 	m.SetName(projectName)
 	projectOrgID := int(project.GetOrgId())
@@ -439,6 +597,8 @@ func (svc *ProjectService) createBuilder(project *Project) (*ent.ProjectCreate,
 	}
 	projectStatus := toEntProject_Status(project.GetStatus())
 	m.SetStatus(projectStatus)
+	projectTaskSeq := int(project.GetTaskSeq())
+	m.SetTaskSeq(projectTaskSeq)
 	projectUpdatedAt := runtime.ExtractTime(project.GetUpdatedAt())
 	m.SetUpdatedAt(projectUpdatedAt)
 	projectUpdaterID := int(project.GetUpdaterId())
@@ -451,10 +611,34 @@ func (svc *ProjectService) createBuilder(project *Project) (*ent.ProjectCreate,
 		members := int(item.GetId())
 		m.AddMemberIDs(members)
 	}
+	for _, item := range project.GetMilestones() {
+		milestones := int(item.GetId())
+		m.AddMilestoneIDs(milestones)
+	}
 	if project.GetOrganization() != nil {
 		projectOrganization := int(project.GetOrganization().GetId())
 		m.SetOrganizationID(projectOrganization)
 	}
+	for _, item := range project.GetOvertimeRequests() {
+		overtimerequests := int(item.GetId())
+		m.AddOvertimeRequestIDs(overtimerequests)
+	}
+	for _, item := range project.GetRoles() {
+		roles := int(item.GetId())
+		m.AddRoleIDs(roles)
+	}
+	for _, item := range project.GetSprints() {
+		sprints := int(item.GetId())
+		m.AddSprintIDs(sprints)
+	}
+	for _, item := range project.GetTaskTemplates() {
+		tasktemplates := int(item.GetId())
+		m.AddTaskTemplateIDs(tasktemplates)
+	}
+	for _, item := range project.GetTaskViews() {
+		taskviews := int(item.GetId())
+		m.AddTaskViewIDs(taskviews)
+	}
 	for _, item := range project.GetTasks() {
 		tasks := int(item.GetId())
 		m.AddTaskIDs(tasks)
@@ -463,5 +647,13 @@ func (svc *ProjectService) createBuilder(project *Project) (*ent.ProjectCreate,
 		projectUpdater := int(project.GetUpdater().GetId())
 		m.SetUpdaterID(projectUpdater)
 	}
+	for _, item := range project.GetWorkflowStatuses() {
+		workflowstatuses := int(item.GetId())
+		m.AddWorkflowStatusIDs(workflowstatuses)
+	}
+	for _, item := range project.GetWorkflowTransitions() {
+		workflowtransitions := int(item.GetId())
+		m.AddWorkflowTransitionIDs(workflowtransitions)
+	}
 	return m, nil
 }
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	remindersetting "github.com/longgggwwww/hrm-ms-hr/ent/remindersetting"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	strconv "strconv"
+)
+
+// ReminderSettingService implements ReminderSettingServiceServer
+type ReminderSettingService struct {
+	client *ent.Client
+	UnimplementedReminderSettingServiceServer
+}
+
+// NewReminderSettingService returns a new ReminderSettingService
+func NewReminderSettingService(client *ent.Client) *ReminderSettingService {
+	return &ReminderSettingService{
+		client: client,
+	}
+}
+
+// toProtoReminderSetting transforms the ent type to the pb type
+func toProtoReminderSetting(e *ent.ReminderSetting) (*ReminderSetting, error) {
+	v := &ReminderSetting{}
+	due_soon_enabled := e.DueSoonEnabled
+	v.DueSoonEnabled = due_soon_enabled
+	due_soon_hours := int64(e.DueSoonHours)
+	v.DueSoonHours = due_soon_hours
+	id := int64(e.ID)
+	v.Id = id
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	overdue_enabled := e.OverdueEnabled
+	v.OverdueEnabled = overdue_enabled
+	overdue_repeat_hours := int64(e.OverdueRepeatHours)
+	v.OverdueRepeatHours = overdue_repeat_hours
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	return v, nil
+}
+
+// toProtoReminderSettingList transforms a list of ent type to a list of pb type
+func toProtoReminderSettingList(e []*ent.ReminderSetting) ([]*ReminderSetting, error) {
+	var pbList []*ReminderSetting
+	for _, entEntity := range e {
+		pbEntity, err := toProtoReminderSetting(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements ReminderSettingServiceServer.Create
+func (svc *ReminderSettingService) Create(ctx context.Context, req *CreateReminderSettingRequest) (*ReminderSetting, error) {
+	remindersetting := req.GetReminderSetting()
+	m, err := svc.createBuilder(remindersetting)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoReminderSetting(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements ReminderSettingServiceServer.Get
+func (svc *ReminderSettingService) Get(ctx context.Context, req *GetReminderSettingRequest) (*ReminderSetting, error) {
+	var (
+		err error
+		get *ent.ReminderSetting
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetReminderSettingRequest_VIEW_UNSPECIFIED, GetReminderSettingRequest_BASIC:
+		get, err = svc.client.ReminderSetting.Get(ctx, id)
+	case GetReminderSettingRequest_WITH_EDGE_IDS:
+		get, err = svc.client.ReminderSetting.Query().
+			Where(remindersetting.ID(id)).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoReminderSetting(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements ReminderSettingServiceServer.Update
+func (svc *ReminderSettingService) Update(ctx context.Context, req *UpdateReminderSettingRequest) (*ReminderSetting, error) {
+	remindersetting := req.GetReminderSetting()
+	remindersettingID := int(remindersetting.GetId())
+	m := svc.client.ReminderSetting.UpdateOneID(remindersettingID)
+	remindersettingDueSoonEnabled := remindersetting.GetDueSoonEnabled()
+	m.SetDueSoonEnabled(remindersettingDueSoonEnabled)
+	remindersettingDueSoonHours := int(remindersetting.GetDueSoonHours())
+	m.SetDueSoonHours(remindersettingDueSoonHours)
+	remindersettingOrgID := int(remindersetting.GetOrgId())
+	m.SetOrgID(remindersettingOrgID)
+	remindersettingOverdueEnabled := remindersetting.GetOverdueEnabled()
+	m.SetOverdueEnabled(remindersettingOverdueEnabled)
+	remindersettingOverdueRepeatHours := int(remindersetting.GetOverdueRepeatHours())
+	m.SetOverdueRepeatHours(remindersettingOverdueRepeatHours)
+	remindersettingUpdatedAt := runtime.ExtractTime(remindersetting.GetUpdatedAt())
+	m.SetUpdatedAt(remindersettingUpdatedAt)
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoReminderSetting(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements ReminderSettingServiceServer.Delete
+func (svc *ReminderSettingService) Delete(ctx context.Context, req *DeleteReminderSettingRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.ReminderSetting.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements ReminderSettingServiceServer.List
+func (svc *ReminderSettingService) List(ctx context.Context, req *ListReminderSettingRequest) (*ListReminderSettingResponse, error) {
+	var (
+		err      error
+		entList  []*ent.ReminderSetting
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.ReminderSetting.Query().
+		Order(ent.Desc(remindersetting.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(remindersetting.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListReminderSettingRequest_VIEW_UNSPECIFIED, ListReminderSettingRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListReminderSettingRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoReminderSettingList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListReminderSettingResponse{
+			ReminderSettingList: protoList,
+			NextPageToken:       nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements ReminderSettingServiceServer.BatchCreate
+func (svc *ReminderSettingService) BatchCreate(ctx context.Context, req *BatchCreateReminderSettingsRequest) (*BatchCreateReminderSettingsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.ReminderSettingCreate, len(requests))
+	for i, req := range requests {
+		remindersetting := req.GetReminderSetting()
+		var err error
+		bulk[i], err = svc.createBuilder(remindersetting)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.ReminderSetting.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoReminderSettingList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateReminderSettingsResponse{
+			ReminderSettings: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *ReminderSettingService) createBuilder(remindersetting *ReminderSetting) (*ent.ReminderSettingCreate, error) {
+	m := svc.client.ReminderSetting.Create()
+	remindersettingDueSoonEnabled := remindersetting.GetDueSoonEnabled()
+	m.SetDueSoonEnabled(remindersettingDueSoonEnabled)
+	remindersettingDueSoonHours := int(remindersetting.GetDueSoonHours())
+	m.SetDueSoonHours(remindersettingDueSoonHours)
+	remindersettingOrgID := int(remindersetting.GetOrgId())
+	m.SetOrgID(remindersettingOrgID)
+	remindersettingOverdueEnabled := remindersetting.GetOverdueEnabled()
+	m.SetOverdueEnabled(remindersettingOverdueEnabled)
+	remindersettingOverdueRepeatHours := int(remindersetting.GetOverdueRepeatHours())
+	m.SetOverdueRepeatHours(remindersettingOverdueRepeatHours)
+	remindersettingUpdatedAt := runtime.ExtractTime(remindersetting.GetUpdatedAt())
+	m.SetUpdatedAt(remindersettingUpdatedAt)
+	return m, nil
+}
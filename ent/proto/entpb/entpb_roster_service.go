@@ -0,0 +1,388 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	roster "github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	shift "github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	shiftswaprequest "github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	strconv "strconv"
+)
+
+// RosterService implements RosterServiceServer
+type RosterService struct {
+	client *ent.Client
+	UnimplementedRosterServiceServer
+}
+
+// NewRosterService returns a new RosterService
+func NewRosterService(client *ent.Client) *RosterService {
+	return &RosterService{
+		client: client,
+	}
+}
+
+// toProtoRoster transforms the ent type to the pb type
+func toProtoRoster(e *ent.Roster) (*Roster, error) {
+	v := &Roster{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	if e.CreatedBy != nil {
+		created_by := wrapperspb.Int64(int64(*e.CreatedBy))
+		v.CreatedBy = created_by
+	}
+	employee := int64(e.EmployeeID)
+	v.EmployeeId = employee
+	id := int64(e.ID)
+	v.Id = id
+	if e.Note != nil {
+		note := wrapperspb.String(*e.Note)
+		v.Note = note
+	}
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	shift := int64(e.ShiftID)
+	v.ShiftId = shift
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	work_date := timestamppb.New(e.WorkDate)
+	v.WorkDate = work_date
+	if edg := e.Edges.Employee; edg != nil {
+		id := int64(edg.ID)
+		v.Employee = &Employee{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Shift; edg != nil {
+		id := int64(edg.ID)
+		v.Shift = &Shift{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.SwapRequests {
+		id := int64(edg.ID)
+		v.SwapRequests = append(v.SwapRequests, &ShiftSwapRequest{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.TargetedSwapRequests {
+		id := int64(edg.ID)
+		v.TargetedSwapRequests = append(v.TargetedSwapRequests, &ShiftSwapRequest{
+			Id: id,
+		})
+	}
+	return v, nil
+}
+
+// toProtoRosterList transforms a list of ent type to a list of pb type
+func toProtoRosterList(e []*ent.Roster) ([]*Roster, error) {
+	var pbList []*Roster
+	for _, entEntity := range e {
+		pbEntity, err := toProtoRoster(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements RosterServiceServer.Create
+func (svc *RosterService) Create(ctx context.Context, req *CreateRosterRequest) (*Roster, error) {
+	roster := req.GetRoster()
+	m, err := svc.createBuilder(roster)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoRoster(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements RosterServiceServer.Get
+func (svc *RosterService) Get(ctx context.Context, req *GetRosterRequest) (*Roster, error) {
+	var (
+		err error
+		get *ent.Roster
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetRosterRequest_VIEW_UNSPECIFIED, GetRosterRequest_BASIC:
+		get, err = svc.client.Roster.Get(ctx, id)
+	case GetRosterRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Roster.Query().
+			Where(roster.ID(id)).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithShift(func(query *ent.ShiftQuery) {
+				query.Select(shift.FieldID)
+			}).
+			WithSwapRequests(func(query *ent.ShiftSwapRequestQuery) {
+				query.Select(shiftswaprequest.FieldID)
+			}).
+			WithTargetedSwapRequests(func(query *ent.ShiftSwapRequestQuery) {
+				query.Select(shiftswaprequest.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoRoster(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements RosterServiceServer.Update
+func (svc *RosterService) Update(ctx context.Context, req *UpdateRosterRequest) (*Roster, error) {
+	roster := req.GetRoster()
+	rosterID := int(roster.GetId())
+	m := svc.client.Roster.UpdateOneID(rosterID)
+	rosterCreatedAt := runtime.ExtractTime(roster.GetCreatedAt())
+	m.SetCreatedAt(rosterCreatedAt)
+	if roster.GetCreatedBy() != nil {
+		rosterCreatedBy := int(roster.GetCreatedBy().GetValue())
+		m.SetCreatedBy(rosterCreatedBy)
+	}
+	rosterEmployeeID := int(roster.GetEmployeeId())
+	m.SetEmployeeID(rosterEmployeeID)
+	if roster.GetNote() != nil {
+		rosterNote := roster.GetNote().GetValue()
+		m.SetNote(rosterNote)
+	}
+	rosterOrgID := int(roster.GetOrgId())
+	m.SetOrgID(rosterOrgID)
+	rosterShiftID := int(roster.GetShiftId())
+	m.SetShiftID(rosterShiftID)
+	rosterUpdatedAt := runtime.ExtractTime(roster.GetUpdatedAt())
+	m.SetUpdatedAt(rosterUpdatedAt)
+	rosterWorkDate := runtime.ExtractTime(roster.GetWorkDate())
+	m.SetWorkDate(rosterWorkDate)
+	if roster.GetEmployee() != nil {
+		rosterEmployee := int(roster.GetEmployee().GetId())
+		m.SetEmployeeID(rosterEmployee)
+	}
+	if roster.GetShift() != nil {
+		rosterShift := int(roster.GetShift().GetId())
+		m.SetShiftID(rosterShift)
+	}
+	for _, item := range roster.GetSwapRequests() {
+		swaprequests := int(item.GetId())
+		m.AddSwapRequestIDs(swaprequests)
+	}
+	for _, item := range roster.GetTargetedSwapRequests() {
+		targetedswaprequests := int(item.GetId())
+		m.AddTargetedSwapRequestIDs(targetedswaprequests)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoRoster(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements RosterServiceServer.Delete
+func (svc *RosterService) Delete(ctx context.Context, req *DeleteRosterRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.Roster.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements RosterServiceServer.List
+func (svc *RosterService) List(ctx context.Context, req *ListRosterRequest) (*ListRosterResponse, error) {
+	var (
+		err      error
+		entList  []*ent.Roster
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.Roster.Query().
+		Order(ent.Desc(roster.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(roster.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListRosterRequest_VIEW_UNSPECIFIED, ListRosterRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListRosterRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithShift(func(query *ent.ShiftQuery) {
+				query.Select(shift.FieldID)
+			}).
+			WithSwapRequests(func(query *ent.ShiftSwapRequestQuery) {
+				query.Select(shiftswaprequest.FieldID)
+			}).
+			WithTargetedSwapRequests(func(query *ent.ShiftSwapRequestQuery) {
+				query.Select(shiftswaprequest.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoRosterList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListRosterResponse{
+			RosterList:    protoList,
+			NextPageToken: nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements RosterServiceServer.BatchCreate
+func (svc *RosterService) BatchCreate(ctx context.Context, req *BatchCreateRostersRequest) (*BatchCreateRostersResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.RosterCreate, len(requests))
+	for i, req := range requests {
+		roster := req.GetRoster()
+		var err error
+		bulk[i], err = svc.createBuilder(roster)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.Roster.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoRosterList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateRostersResponse{
+			Rosters: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *RosterService) createBuilder(roster *Roster) (*ent.RosterCreate, error) {
+	m := svc.client.Roster.Create()
+	rosterCreatedAt := runtime.ExtractTime(roster.GetCreatedAt())
+	m.SetCreatedAt(rosterCreatedAt)
+	if roster.GetCreatedBy() != nil {
+		rosterCreatedBy := int(roster.GetCreatedBy().GetValue())
+		m.SetCreatedBy(rosterCreatedBy)
+	}
+	rosterEmployeeID := int(roster.GetEmployeeId())
+	m.SetEmployeeID(rosterEmployeeID)
+	if roster.GetNote() != nil {
+		rosterNote := roster.GetNote().GetValue()
+		m.SetNote(rosterNote)
+	}
+	rosterOrgID := int(roster.GetOrgId())
+	m.SetOrgID(rosterOrgID)
+	rosterShiftID := int(roster.GetShiftId())
+	m.SetShiftID(rosterShiftID)
+	rosterUpdatedAt := runtime.ExtractTime(roster.GetUpdatedAt())
+	m.SetUpdatedAt(rosterUpdatedAt)
+	rosterWorkDate := runtime.ExtractTime(roster.GetWorkDate())
+	m.SetWorkDate(rosterWorkDate)
+	if roster.GetEmployee() != nil {
+		rosterEmployee := int(roster.GetEmployee().GetId())
+		m.SetEmployeeID(rosterEmployee)
+	}
+	if roster.GetShift() != nil {
+		rosterShift := int(roster.GetShift().GetId())
+		m.SetShiftID(rosterShift)
+	}
+	for _, item := range roster.GetSwapRequests() {
+		swaprequests := int(item.GetId())
+		m.AddSwapRequestIDs(swaprequests)
+	}
+	for _, item := range roster.GetTargetedSwapRequests() {
+		targetedswaprequests := int(item.GetId())
+		m.AddTargetedSwapRequestIDs(targetedswaprequests)
+	}
+	return m, nil
+}
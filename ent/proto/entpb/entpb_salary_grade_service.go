@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	compensation "github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	organization "github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	position "github.com/longgggwwww/hrm-ms-hr/ent/position"
+	salarygrade "github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	strconv "strconv"
+)
+
+// SalaryGradeService implements SalaryGradeServiceServer
+type SalaryGradeService struct {
+	client *ent.Client
+	UnimplementedSalaryGradeServiceServer
+}
+
+// NewSalaryGradeService returns a new SalaryGradeService
+func NewSalaryGradeService(client *ent.Client) *SalaryGradeService {
+	return &SalaryGradeService{
+		client: client,
+	}
+}
+
+// toProtoSalaryGrade transforms the ent type to the pb type
+func toProtoSalaryGrade(e *ent.SalaryGrade) (*SalaryGrade, error) {
+	v := &SalaryGrade{}
+	code := e.Code
+	v.Code = code
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	currency := e.Currency
+	v.Currency = currency
+	id := int64(e.ID)
+	v.Id = id
+	max_amount := e.MaxAmount
+	v.MaxAmount = max_amount
+	min_amount := e.MinAmount
+	v.MinAmount = min_amount
+	name := e.Name
+	v.Name = name
+	organization := int64(e.OrgID)
+	v.OrgId = organization
+	if e.PositionID != nil {
+		position := wrapperspb.Int64(int64(*e.PositionID))
+		v.PositionId = position
+	}
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	for _, edg := range e.Edges.Compensations {
+		id := int64(edg.ID)
+		v.Compensations = append(v.Compensations, &Compensation{
+			Id: id,
+		})
+	}
+	if edg := e.Edges.Organization; edg != nil {
+		id := int64(edg.ID)
+		v.Organization = &Organization{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Position; edg != nil {
+		id := int64(edg.ID)
+		v.Position = &Position{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoSalaryGradeList transforms a list of ent type to a list of pb type
+func toProtoSalaryGradeList(e []*ent.SalaryGrade) ([]*SalaryGrade, error) {
+	var pbList []*SalaryGrade
+	for _, entEntity := range e {
+		pbEntity, err := toProtoSalaryGrade(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements SalaryGradeServiceServer.Create
+func (svc *SalaryGradeService) Create(ctx context.Context, req *CreateSalaryGradeRequest) (*SalaryGrade, error) {
+	salarygrade := req.GetSalaryGrade()
+	m, err := svc.createBuilder(salarygrade)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoSalaryGrade(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements SalaryGradeServiceServer.Get
+func (svc *SalaryGradeService) Get(ctx context.Context, req *GetSalaryGradeRequest) (*SalaryGrade, error) {
+	var (
+		err error
+		get *ent.SalaryGrade
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetSalaryGradeRequest_VIEW_UNSPECIFIED, GetSalaryGradeRequest_BASIC:
+		get, err = svc.client.SalaryGrade.Get(ctx, id)
+	case GetSalaryGradeRequest_WITH_EDGE_IDS:
+		get, err = svc.client.SalaryGrade.Query().
+			Where(salarygrade.ID(id)).
+			WithCompensations(func(query *ent.CompensationQuery) {
+				query.Select(compensation.FieldID)
+			}).
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			WithPosition(func(query *ent.PositionQuery) {
+				query.Select(position.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoSalaryGrade(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements SalaryGradeServiceServer.Update
+func (svc *SalaryGradeService) Update(ctx context.Context, req *UpdateSalaryGradeRequest) (*SalaryGrade, error) {
+	salarygrade := req.GetSalaryGrade()
+	salarygradeID := int(salarygrade.GetId())
+	m := svc.client.SalaryGrade.UpdateOneID(salarygradeID)
+	salarygradeCode := salarygrade.GetCode()
+	m.SetCode(salarygradeCode)
+	salarygradeCurrency := salarygrade.GetCurrency()
+	m.SetCurrency(salarygradeCurrency)
+	salarygradeMaxAmount := int64(salarygrade.GetMaxAmount())
+	m.SetMaxAmount(salarygradeMaxAmount)
+	salarygradeMinAmount := int64(salarygrade.GetMinAmount())
+	m.SetMinAmount(salarygradeMinAmount)
+	salarygradeName := salarygrade.GetName()
+	m.SetName(salarygradeName)
+	salarygradeOrgID := int(salarygrade.GetOrgId())
+	m.SetOrgID(salarygradeOrgID)
+	if salarygrade.GetPositionId() != nil {
+		salarygradePositionID := int(salarygrade.GetPositionId().GetValue())
+		m.SetPositionID(salarygradePositionID)
+	}
+	salarygradeUpdatedAt := runtime.ExtractTime(salarygrade.GetUpdatedAt())
+	m.SetUpdatedAt(salarygradeUpdatedAt)
+	for _, item := range salarygrade.GetCompensations() {
+		compensations := int(item.GetId())
+		m.AddCompensationIDs(compensations)
+	}
+	if salarygrade.GetOrganization() != nil {
+		salarygradeOrganization := int(salarygrade.GetOrganization().GetId())
+		m.SetOrganizationID(salarygradeOrganization)
+	}
+	if salarygrade.GetPosition() != nil {
+		salarygradePosition := int(salarygrade.GetPosition().GetId())
+		m.SetPositionID(salarygradePosition)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoSalaryGrade(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements SalaryGradeServiceServer.Delete
+func (svc *SalaryGradeService) Delete(ctx context.Context, req *DeleteSalaryGradeRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.SalaryGrade.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements SalaryGradeServiceServer.List
+func (svc *SalaryGradeService) List(ctx context.Context, req *ListSalaryGradeRequest) (*ListSalaryGradeResponse, error) {
+	var (
+		err      error
+		entList  []*ent.SalaryGrade
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.SalaryGrade.Query().
+		Order(ent.Desc(salarygrade.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(salarygrade.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListSalaryGradeRequest_VIEW_UNSPECIFIED, ListSalaryGradeRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListSalaryGradeRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithCompensations(func(query *ent.CompensationQuery) {
+				query.Select(compensation.FieldID)
+			}).
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			WithPosition(func(query *ent.PositionQuery) {
+				query.Select(position.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoSalaryGradeList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListSalaryGradeResponse{
+			SalaryGradeList: protoList,
+			NextPageToken:   nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements SalaryGradeServiceServer.BatchCreate
+func (svc *SalaryGradeService) BatchCreate(ctx context.Context, req *BatchCreateSalaryGradesRequest) (*BatchCreateSalaryGradesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.SalaryGradeCreate, len(requests))
+	for i, req := range requests {
+		salarygrade := req.GetSalaryGrade()
+		var err error
+		bulk[i], err = svc.createBuilder(salarygrade)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.SalaryGrade.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoSalaryGradeList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateSalaryGradesResponse{
+			SalaryGrades: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *SalaryGradeService) createBuilder(salarygrade *SalaryGrade) (*ent.SalaryGradeCreate, error) {
+	m := svc.client.SalaryGrade.Create()
+	salarygradeCode := salarygrade.GetCode()
+	m.SetCode(salarygradeCode)
+	salarygradeCreatedAt := runtime.ExtractTime(salarygrade.GetCreatedAt())
+	m.SetCreatedAt(salarygradeCreatedAt)
+	salarygradeCurrency := salarygrade.GetCurrency()
+	m.SetCurrency(salarygradeCurrency)
+	salarygradeMaxAmount := int64(salarygrade.GetMaxAmount())
+	m.SetMaxAmount(salarygradeMaxAmount)
+	salarygradeMinAmount := int64(salarygrade.GetMinAmount())
+	m.SetMinAmount(salarygradeMinAmount)
+	salarygradeName := salarygrade.GetName()
+	m.SetName(salarygradeName)
+	salarygradeOrgID := int(salarygrade.GetOrgId())
+	m.SetOrgID(salarygradeOrgID)
+	if salarygrade.GetPositionId() != nil {
+		salarygradePositionID := int(salarygrade.GetPositionId().GetValue())
+		m.SetPositionID(salarygradePositionID)
+	}
+	salarygradeUpdatedAt := runtime.ExtractTime(salarygrade.GetUpdatedAt())
+	m.SetUpdatedAt(salarygradeUpdatedAt)
+	for _, item := range salarygrade.GetCompensations() {
+		compensations := int(item.GetId())
+		m.AddCompensationIDs(compensations)
+	}
+	if salarygrade.GetOrganization() != nil {
+		salarygradeOrganization := int(salarygrade.GetOrganization().GetId())
+		m.SetOrganizationID(salarygradeOrganization)
+	}
+	if salarygrade.GetPosition() != nil {
+		salarygradePosition := int(salarygrade.GetPosition().GetId())
+		m.SetPositionID(salarygradePosition)
+	}
+	return m, nil
+}
@@ -0,0 +1,346 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	organization "github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	roster "github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	shift "github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	strconv "strconv"
+)
+
+// ShiftService implements ShiftServiceServer
+type ShiftService struct {
+	client *ent.Client
+	UnimplementedShiftServiceServer
+}
+
+// NewShiftService returns a new ShiftService
+func NewShiftService(client *ent.Client) *ShiftService {
+	return &ShiftService{
+		client: client,
+	}
+}
+
+// toProtoShift transforms the ent type to the pb type
+func toProtoShift(e *ent.Shift) (*Shift, error) {
+	v := &Shift{}
+	break_minutes := int64(e.BreakMinutes)
+	v.BreakMinutes = break_minutes
+	break_paid := e.BreakPaid
+	v.BreakPaid = break_paid
+	code := e.Code
+	v.Code = code
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	end_time := e.EndTime
+	v.EndTime = end_time
+	id := int64(e.ID)
+	v.Id = id
+	is_night := e.IsNight
+	v.IsNight = is_night
+	name := e.Name
+	v.Name = name
+	organization := int64(e.OrgID)
+	v.OrgId = organization
+	start_time := e.StartTime
+	v.StartTime = start_time
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Organization; edg != nil {
+		id := int64(edg.ID)
+		v.Organization = &Organization{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.Rosters {
+		id := int64(edg.ID)
+		v.Rosters = append(v.Rosters, &Roster{
+			Id: id,
+		})
+	}
+	return v, nil
+}
+
+// toProtoShiftList transforms a list of ent type to a list of pb type
+func toProtoShiftList(e []*ent.Shift) ([]*Shift, error) {
+	var pbList []*Shift
+	for _, entEntity := range e {
+		pbEntity, err := toProtoShift(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements ShiftServiceServer.Create
+func (svc *ShiftService) Create(ctx context.Context, req *CreateShiftRequest) (*Shift, error) {
+	shift := req.GetShift()
+	m, err := svc.createBuilder(shift)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoShift(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements ShiftServiceServer.Get
+func (svc *ShiftService) Get(ctx context.Context, req *GetShiftRequest) (*Shift, error) {
+	var (
+		err error
+		get *ent.Shift
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetShiftRequest_VIEW_UNSPECIFIED, GetShiftRequest_BASIC:
+		get, err = svc.client.Shift.Get(ctx, id)
+	case GetShiftRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Shift.Query().
+			Where(shift.ID(id)).
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			WithRosters(func(query *ent.RosterQuery) {
+				query.Select(roster.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoShift(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements ShiftServiceServer.Update
+func (svc *ShiftService) Update(ctx context.Context, req *UpdateShiftRequest) (*Shift, error) {
+	shift := req.GetShift()
+	shiftID := int(shift.GetId())
+	m := svc.client.Shift.UpdateOneID(shiftID)
+	shiftBreakMinutes := int(shift.GetBreakMinutes())
+	m.SetBreakMinutes(shiftBreakMinutes)
+	shiftBreakPaid := shift.GetBreakPaid()
+	m.SetBreakPaid(shiftBreakPaid)
+	shiftCode := shift.GetCode()
+	m.SetCode(shiftCode)
+	shiftCreatedAt := runtime.ExtractTime(shift.GetCreatedAt())
+	m.SetCreatedAt(shiftCreatedAt)
+	shiftEndTime := shift.GetEndTime()
+	m.SetEndTime(shiftEndTime)
+	shiftIsNight := shift.GetIsNight()
+	m.SetIsNight(shiftIsNight)
+	shiftName := shift.GetName()
+	m.SetName(shiftName)
+	shiftOrgID := int(shift.GetOrgId())
+	m.SetOrgID(shiftOrgID)
+	shiftStartTime := shift.GetStartTime()
+	m.SetStartTime(shiftStartTime)
+	shiftUpdatedAt := runtime.ExtractTime(shift.GetUpdatedAt())
+	m.SetUpdatedAt(shiftUpdatedAt)
+	if shift.GetOrganization() != nil {
+		shiftOrganization := int(shift.GetOrganization().GetId())
+		m.SetOrganizationID(shiftOrganization)
+	}
+	for _, item := range shift.GetRosters() {
+		rosters := int(item.GetId())
+		m.AddRosterIDs(rosters)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoShift(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements ShiftServiceServer.Delete
+func (svc *ShiftService) Delete(ctx context.Context, req *DeleteShiftRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.Shift.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements ShiftServiceServer.List
+func (svc *ShiftService) List(ctx context.Context, req *ListShiftRequest) (*ListShiftResponse, error) {
+	var (
+		err      error
+		entList  []*ent.Shift
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.Shift.Query().
+		Order(ent.Desc(shift.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(shift.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListShiftRequest_VIEW_UNSPECIFIED, ListShiftRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListShiftRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithOrganization(func(query *ent.OrganizationQuery) {
+				query.Select(organization.FieldID)
+			}).
+			WithRosters(func(query *ent.RosterQuery) {
+				query.Select(roster.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoShiftList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListShiftResponse{
+			ShiftList:     protoList,
+			NextPageToken: nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements ShiftServiceServer.BatchCreate
+func (svc *ShiftService) BatchCreate(ctx context.Context, req *BatchCreateShiftsRequest) (*BatchCreateShiftsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.ShiftCreate, len(requests))
+	for i, req := range requests {
+		shift := req.GetShift()
+		var err error
+		bulk[i], err = svc.createBuilder(shift)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.Shift.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoShiftList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateShiftsResponse{
+			Shifts: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *ShiftService) createBuilder(shift *Shift) (*ent.ShiftCreate, error) {
+	m := svc.client.Shift.Create()
+	shiftBreakMinutes := int(shift.GetBreakMinutes())
+	m.SetBreakMinutes(shiftBreakMinutes)
+	shiftBreakPaid := shift.GetBreakPaid()
+	m.SetBreakPaid(shiftBreakPaid)
+	shiftCode := shift.GetCode()
+	m.SetCode(shiftCode)
+	shiftCreatedAt := runtime.ExtractTime(shift.GetCreatedAt())
+	m.SetCreatedAt(shiftCreatedAt)
+	shiftEndTime := shift.GetEndTime()
+	m.SetEndTime(shiftEndTime)
+	shiftIsNight := shift.GetIsNight()
+	m.SetIsNight(shiftIsNight)
+	shiftName := shift.GetName()
+	m.SetName(shiftName)
+	shiftOrgID := int(shift.GetOrgId())
+	m.SetOrgID(shiftOrgID)
+	shiftStartTime := shift.GetStartTime()
+	m.SetStartTime(shiftStartTime)
+	shiftUpdatedAt := runtime.ExtractTime(shift.GetUpdatedAt())
+	m.SetUpdatedAt(shiftUpdatedAt)
+	if shift.GetOrganization() != nil {
+		shiftOrganization := int(shift.GetOrganization().GetId())
+		m.SetOrganizationID(shiftOrganization)
+	}
+	for _, item := range shift.GetRosters() {
+		rosters := int(item.GetId())
+		m.AddRosterIDs(rosters)
+	}
+	return m, nil
+}
@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	roster "github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	shiftswaprequest "github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// ShiftSwapRequestService implements ShiftSwapRequestServiceServer
+type ShiftSwapRequestService struct {
+	client *ent.Client
+	UnimplementedShiftSwapRequestServiceServer
+}
+
+// NewShiftSwapRequestService returns a new ShiftSwapRequestService
+func NewShiftSwapRequestService(client *ent.Client) *ShiftSwapRequestService {
+	return &ShiftSwapRequestService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpShiftSwapRequest_Status = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeShiftSwapRequest_Status(e string) string {
+	return protoIdentNormalizeRegexpShiftSwapRequest_Status.ReplaceAllString(e, "_")
+}
+
+func toProtoShiftSwapRequest_Status(e shiftswaprequest.Status) ShiftSwapRequest_Status {
+	if v, ok := ShiftSwapRequest_Status_value[strings.ToUpper("STATUS_"+protoIdentNormalizeShiftSwapRequest_Status(string(e)))]; ok {
+		return ShiftSwapRequest_Status(v)
+	}
+	return ShiftSwapRequest_Status(0)
+}
+
+func toEntShiftSwapRequest_Status(e ShiftSwapRequest_Status) shiftswaprequest.Status {
+	if v, ok := ShiftSwapRequest_Status_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATUS_PENDING":  "pending",
+			"STATUS_APPROVED": "approved",
+			"STATUS_REJECTED": "rejected",
+		}[v]
+		return shiftswaprequest.Status(entVal)
+	}
+	return ""
+}
+
+// toProtoShiftSwapRequest transforms the ent type to the pb type
+func toProtoShiftSwapRequest(e *ent.ShiftSwapRequest) (*ShiftSwapRequest, error) {
+	v := &ShiftSwapRequest{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	id := int64(e.ID)
+	v.Id = id
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	if e.Reason != nil {
+		reason := wrapperspb.String(*e.Reason)
+		v.Reason = reason
+	}
+	requester_id := int64(e.RequesterID)
+	v.RequesterId = requester_id
+	if e.ReviewComment != nil {
+		review_comment := wrapperspb.String(*e.ReviewComment)
+		v.ReviewComment = review_comment
+	}
+	if e.ReviewedAt != nil {
+		reviewed_at := timestamppb.New(*e.ReviewedAt)
+		v.ReviewedAt = reviewed_at
+	}
+	if e.ReviewerID != nil {
+		reviewer_id := wrapperspb.Int64(int64(*e.ReviewerID))
+		v.ReviewerId = reviewer_id
+	}
+	roster := int64(e.RosterID)
+	v.RosterId = roster
+	status := toProtoShiftSwapRequest_Status(e.Status)
+	v.Status = status
+	target_roster := int64(e.TargetRosterID)
+	v.TargetRosterId = target_roster
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Roster; edg != nil {
+		id := int64(edg.ID)
+		v.Roster = &Roster{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.TargetRoster; edg != nil {
+		id := int64(edg.ID)
+		v.TargetRoster = &Roster{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoShiftSwapRequestList transforms a list of ent type to a list of pb type
+func toProtoShiftSwapRequestList(e []*ent.ShiftSwapRequest) ([]*ShiftSwapRequest, error) {
+	var pbList []*ShiftSwapRequest
+	for _, entEntity := range e {
+		pbEntity, err := toProtoShiftSwapRequest(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements ShiftSwapRequestServiceServer.Create
+func (svc *ShiftSwapRequestService) Create(ctx context.Context, req *CreateShiftSwapRequestRequest) (*ShiftSwapRequest, error) {
+	shiftswaprequest := req.GetShiftSwapRequest()
+	m, err := svc.createBuilder(shiftswaprequest)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoShiftSwapRequest(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements ShiftSwapRequestServiceServer.Get
+func (svc *ShiftSwapRequestService) Get(ctx context.Context, req *GetShiftSwapRequestRequest) (*ShiftSwapRequest, error) {
+	var (
+		err error
+		get *ent.ShiftSwapRequest
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetShiftSwapRequestRequest_VIEW_UNSPECIFIED, GetShiftSwapRequestRequest_BASIC:
+		get, err = svc.client.ShiftSwapRequest.Get(ctx, id)
+	case GetShiftSwapRequestRequest_WITH_EDGE_IDS:
+		get, err = svc.client.ShiftSwapRequest.Query().
+			Where(shiftswaprequest.ID(id)).
+			WithRoster(func(query *ent.RosterQuery) {
+				query.Select(roster.FieldID)
+			}).
+			WithTargetRoster(func(query *ent.RosterQuery) {
+				query.Select(roster.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoShiftSwapRequest(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements ShiftSwapRequestServiceServer.Update
+func (svc *ShiftSwapRequestService) Update(ctx context.Context, req *UpdateShiftSwapRequestRequest) (*ShiftSwapRequest, error) {
+	shiftswaprequest := req.GetShiftSwapRequest()
+	shiftswaprequestID := int(shiftswaprequest.GetId())
+	m := svc.client.ShiftSwapRequest.UpdateOneID(shiftswaprequestID)
+	shiftswaprequestCreatedAt := runtime.ExtractTime(shiftswaprequest.GetCreatedAt())
+	m.SetCreatedAt(shiftswaprequestCreatedAt)
+	shiftswaprequestOrgID := int(shiftswaprequest.GetOrgId())
+	m.SetOrgID(shiftswaprequestOrgID)
+	if shiftswaprequest.GetReason() != nil {
+		shiftswaprequestReason := shiftswaprequest.GetReason().GetValue()
+		m.SetReason(shiftswaprequestReason)
+	}
+	shiftswaprequestRequesterID := int(shiftswaprequest.GetRequesterId())
+	m.SetRequesterID(shiftswaprequestRequesterID)
+	if shiftswaprequest.GetReviewComment() != nil {
+		shiftswaprequestReviewComment := shiftswaprequest.GetReviewComment().GetValue()
+		m.SetReviewComment(shiftswaprequestReviewComment)
+	}
+	if shiftswaprequest.GetReviewedAt() != nil {
+		shiftswaprequestReviewedAt := runtime.ExtractTime(shiftswaprequest.GetReviewedAt())
+		m.SetReviewedAt(shiftswaprequestReviewedAt)
+	}
+	if shiftswaprequest.GetReviewerId() != nil {
+		shiftswaprequestReviewerID := int(shiftswaprequest.GetReviewerId().GetValue())
+		m.SetReviewerID(shiftswaprequestReviewerID)
+	}
+	shiftswaprequestRosterID := int(shiftswaprequest.GetRosterId())
+	m.SetRosterID(shiftswaprequestRosterID)
+	shiftswaprequestStatus := toEntShiftSwapRequest_Status(shiftswaprequest.GetStatus())
+	m.SetStatus(shiftswaprequestStatus)
+	shiftswaprequestTargetRosterID := int(shiftswaprequest.GetTargetRosterId())
+	m.SetTargetRosterID(shiftswaprequestTargetRosterID)
+	shiftswaprequestUpdatedAt := runtime.ExtractTime(shiftswaprequest.GetUpdatedAt())
+	m.SetUpdatedAt(shiftswaprequestUpdatedAt)
+	if shiftswaprequest.GetRoster() != nil {
+		shiftswaprequestRoster := int(shiftswaprequest.GetRoster().GetId())
+		m.SetRosterID(shiftswaprequestRoster)
+	}
+	if shiftswaprequest.GetTargetRoster() != nil {
+		shiftswaprequestTargetRoster := int(shiftswaprequest.GetTargetRoster().GetId())
+		m.SetTargetRosterID(shiftswaprequestTargetRoster)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoShiftSwapRequest(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements ShiftSwapRequestServiceServer.Delete
+func (svc *ShiftSwapRequestService) Delete(ctx context.Context, req *DeleteShiftSwapRequestRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.ShiftSwapRequest.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements ShiftSwapRequestServiceServer.List
+func (svc *ShiftSwapRequestService) List(ctx context.Context, req *ListShiftSwapRequestRequest) (*ListShiftSwapRequestResponse, error) {
+	var (
+		err      error
+		entList  []*ent.ShiftSwapRequest
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.ShiftSwapRequest.Query().
+		Order(ent.Desc(shiftswaprequest.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(shiftswaprequest.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListShiftSwapRequestRequest_VIEW_UNSPECIFIED, ListShiftSwapRequestRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListShiftSwapRequestRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithRoster(func(query *ent.RosterQuery) {
+				query.Select(roster.FieldID)
+			}).
+			WithTargetRoster(func(query *ent.RosterQuery) {
+				query.Select(roster.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoShiftSwapRequestList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListShiftSwapRequestResponse{
+			ShiftSwapRequestList: protoList,
+			NextPageToken:        nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements ShiftSwapRequestServiceServer.BatchCreate
+func (svc *ShiftSwapRequestService) BatchCreate(ctx context.Context, req *BatchCreateShiftSwapRequestsRequest) (*BatchCreateShiftSwapRequestsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.ShiftSwapRequestCreate, len(requests))
+	for i, req := range requests {
+		shiftswaprequest := req.GetShiftSwapRequest()
+		var err error
+		bulk[i], err = svc.createBuilder(shiftswaprequest)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.ShiftSwapRequest.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoShiftSwapRequestList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateShiftSwapRequestsResponse{
+			ShiftSwapRequests: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *ShiftSwapRequestService) createBuilder(shiftswaprequest *ShiftSwapRequest) (*ent.ShiftSwapRequestCreate, error) {
+	m := svc.client.ShiftSwapRequest.Create()
+	shiftswaprequestCreatedAt := runtime.ExtractTime(shiftswaprequest.GetCreatedAt())
+	m.SetCreatedAt(shiftswaprequestCreatedAt)
+	shiftswaprequestOrgID := int(shiftswaprequest.GetOrgId())
+	m.SetOrgID(shiftswaprequestOrgID)
+	if shiftswaprequest.GetReason() != nil {
+		shiftswaprequestReason := shiftswaprequest.GetReason().GetValue()
+		m.SetReason(shiftswaprequestReason)
+	}
+	shiftswaprequestRequesterID := int(shiftswaprequest.GetRequesterId())
+	m.SetRequesterID(shiftswaprequestRequesterID)
+	if shiftswaprequest.GetReviewComment() != nil {
+		shiftswaprequestReviewComment := shiftswaprequest.GetReviewComment().GetValue()
+		m.SetReviewComment(shiftswaprequestReviewComment)
+	}
+	if shiftswaprequest.GetReviewedAt() != nil {
+		shiftswaprequestReviewedAt := runtime.ExtractTime(shiftswaprequest.GetReviewedAt())
+		m.SetReviewedAt(shiftswaprequestReviewedAt)
+	}
+	if shiftswaprequest.GetReviewerId() != nil {
+		shiftswaprequestReviewerID := int(shiftswaprequest.GetReviewerId().GetValue())
+		m.SetReviewerID(shiftswaprequestReviewerID)
+	}
+	shiftswaprequestRosterID := int(shiftswaprequest.GetRosterId())
+	m.SetRosterID(shiftswaprequestRosterID)
+	shiftswaprequestStatus := toEntShiftSwapRequest_Status(shiftswaprequest.GetStatus())
+	m.SetStatus(shiftswaprequestStatus)
+	shiftswaprequestTargetRosterID := int(shiftswaprequest.GetTargetRosterId())
+	m.SetTargetRosterID(shiftswaprequestTargetRosterID)
+	shiftswaprequestUpdatedAt := runtime.ExtractTime(shiftswaprequest.GetUpdatedAt())
+	m.SetUpdatedAt(shiftswaprequestUpdatedAt)
+	if shiftswaprequest.GetRoster() != nil {
+		shiftswaprequestRoster := int(shiftswaprequest.GetRoster().GetId())
+		m.SetRosterID(shiftswaprequestRoster)
+	}
+	if shiftswaprequest.GetTargetRoster() != nil {
+		shiftswaprequestTargetRoster := int(shiftswaprequest.GetTargetRoster().GetId())
+		m.SetTargetRosterID(shiftswaprequestTargetRoster)
+	}
+	return m, nil
+}
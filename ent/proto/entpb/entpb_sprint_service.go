@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	sprint "github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// SprintService implements SprintServiceServer
+type SprintService struct {
+	client *ent.Client
+	UnimplementedSprintServiceServer
+}
+
+// NewSprintService returns a new SprintService
+func NewSprintService(client *ent.Client) *SprintService {
+	return &SprintService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpSprint_State = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeSprint_State(e string) string {
+	return protoIdentNormalizeRegexpSprint_State.ReplaceAllString(e, "_")
+}
+
+func toProtoSprint_State(e sprint.State) Sprint_State {
+	if v, ok := Sprint_State_value[strings.ToUpper("STATE_"+protoIdentNormalizeSprint_State(string(e)))]; ok {
+		return Sprint_State(v)
+	}
+	return Sprint_State(0)
+}
+
+func toEntSprint_State(e Sprint_State) sprint.State {
+	if v, ok := Sprint_State_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATE_PLANNED": "planned",
+			"STATE_ACTIVE":  "active",
+			"STATE_CLOSED":  "closed",
+		}[v]
+		return sprint.State(entVal)
+	}
+	return ""
+}
+
+// toProtoSprint transforms the ent type to the pb type
+func toProtoSprint(e *ent.Sprint) (*Sprint, error) {
+	v := &Sprint{}
+	if e.ClosedAt != nil {
+		closed_at := timestamppb.New(*e.ClosedAt)
+		v.ClosedAt = closed_at
+	}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	creator_id := int64(e.CreatorID)
+	v.CreatorId = creator_id
+	end_at := timestamppb.New(e.EndAt)
+	v.EndAt = end_at
+	goal := wrapperspb.String(e.Goal)
+	v.Goal = goal
+	id := int64(e.ID)
+	v.Id = id
+	name := e.Name
+	v.Name = name
+	project := int64(e.ProjectID)
+	v.ProjectId = project
+	start_at := timestamppb.New(e.StartAt)
+	v.StartAt = start_at
+	if e.StartedAt != nil {
+		started_at := timestamppb.New(*e.StartedAt)
+		v.StartedAt = started_at
+	}
+	state := toProtoSprint_State(e.State)
+	v.State = state
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Project; edg != nil {
+		id := int64(edg.ID)
+		v.Project = &Project{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.Tasks {
+		id := int64(edg.ID)
+		v.Tasks = append(v.Tasks, &Task{
+			Id: id,
+		})
+	}
+	return v, nil
+}
+
+// toProtoSprintList transforms a list of ent type to a list of pb type
+func toProtoSprintList(e []*ent.Sprint) ([]*Sprint, error) {
+	var pbList []*Sprint
+	for _, entEntity := range e {
+		pbEntity, err := toProtoSprint(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements SprintServiceServer.Create
+func (svc *SprintService) Create(ctx context.Context, req *CreateSprintRequest) (*Sprint, error) {
+	sprint := req.GetSprint()
+	m, err := svc.createBuilder(sprint)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoSprint(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements SprintServiceServer.Get
+func (svc *SprintService) Get(ctx context.Context, req *GetSprintRequest) (*Sprint, error) {
+	var (
+		err error
+		get *ent.Sprint
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetSprintRequest_VIEW_UNSPECIFIED, GetSprintRequest_BASIC:
+		get, err = svc.client.Sprint.Get(ctx, id)
+	case GetSprintRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Sprint.Query().
+			Where(sprint.ID(id)).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			WithTasks(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoSprint(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements SprintServiceServer.Update
+func (svc *SprintService) Update(ctx context.Context, req *UpdateSprintRequest) (*Sprint, error) {
+	sprint := req.GetSprint()
+	sprintID := int(sprint.GetId())
+	m := svc.client.Sprint.UpdateOneID(sprintID)
+	if sprint.GetClosedAt() != nil {
+		sprintClosedAt := runtime.ExtractTime(sprint.GetClosedAt())
+		m.SetClosedAt(sprintClosedAt)
+	}
+	sprintCreatorID := int(sprint.GetCreatorId())
+	m.SetCreatorID(sprintCreatorID)
+	sprintEndAt := runtime.ExtractTime(sprint.GetEndAt())
+	m.SetEndAt(sprintEndAt)
+	if sprint.GetGoal() != nil {
+		sprintGoal := sprint.GetGoal().GetValue()
+		m.SetGoal(sprintGoal)
+	}
+	sprintName := sprint.GetName()
+	m.SetName(sprintName)
+	sprintProjectID := int(sprint.GetProjectId())
+	m.SetProjectID(sprintProjectID)
+	sprintStartAt := runtime.ExtractTime(sprint.GetStartAt())
+	m.SetStartAt(sprintStartAt)
+	if sprint.GetStartedAt() != nil {
+		sprintStartedAt := runtime.ExtractTime(sprint.GetStartedAt())
+		m.SetStartedAt(sprintStartedAt)
+	}
+	sprintState := toEntSprint_State(sprint.GetState())
+	m.SetState(sprintState)
+	sprintUpdatedAt := runtime.ExtractTime(sprint.GetUpdatedAt())
+	m.SetUpdatedAt(sprintUpdatedAt)
+	if sprint.GetProject() != nil {
+		sprintProject := int(sprint.GetProject().GetId())
+		m.SetProjectID(sprintProject)
+	}
+	for _, item := range sprint.GetTasks() {
+		tasks := int(item.GetId())
+		m.AddTaskIDs(tasks)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoSprint(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements SprintServiceServer.Delete
+func (svc *SprintService) Delete(ctx context.Context, req *DeleteSprintRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.Sprint.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements SprintServiceServer.List
+func (svc *SprintService) List(ctx context.Context, req *ListSprintRequest) (*ListSprintResponse, error) {
+	var (
+		err      error
+		entList  []*ent.Sprint
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.Sprint.Query().
+		Order(ent.Desc(sprint.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(sprint.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListSprintRequest_VIEW_UNSPECIFIED, ListSprintRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListSprintRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			WithTasks(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoSprintList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListSprintResponse{
+			SprintList:    protoList,
+			NextPageToken: nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements SprintServiceServer.BatchCreate
+func (svc *SprintService) BatchCreate(ctx context.Context, req *BatchCreateSprintsRequest) (*BatchCreateSprintsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.SprintCreate, len(requests))
+	for i, req := range requests {
+		sprint := req.GetSprint()
+		var err error
+		bulk[i], err = svc.createBuilder(sprint)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.Sprint.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoSprintList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateSprintsResponse{
+			Sprints: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *SprintService) createBuilder(sprint *Sprint) (*ent.SprintCreate, error) {
+	m := svc.client.Sprint.Create()
+	if sprint.GetClosedAt() != nil {
+		sprintClosedAt := runtime.ExtractTime(sprint.GetClosedAt())
+		m.SetClosedAt(sprintClosedAt)
+	}
+	sprintCreatedAt := runtime.ExtractTime(sprint.GetCreatedAt())
+	m.SetCreatedAt(sprintCreatedAt)
+	sprintCreatorID := int(sprint.GetCreatorId())
+	m.SetCreatorID(sprintCreatorID)
+	sprintEndAt := runtime.ExtractTime(sprint.GetEndAt())
+	m.SetEndAt(sprintEndAt)
+	if sprint.GetGoal() != nil {
+		sprintGoal := sprint.GetGoal().GetValue()
+		m.SetGoal(sprintGoal)
+	}
+	sprintName := sprint.GetName()
+	m.SetName(sprintName)
+	sprintProjectID := int(sprint.GetProjectId())
+	m.SetProjectID(sprintProjectID)
+	sprintStartAt := runtime.ExtractTime(sprint.GetStartAt())
+	m.SetStartAt(sprintStartAt)
+	if sprint.GetStartedAt() != nil {
+		sprintStartedAt := runtime.ExtractTime(sprint.GetStartedAt())
+		m.SetStartedAt(sprintStartedAt)
+	}
+	sprintState := toEntSprint_State(sprint.GetState())
+	m.SetState(sprintState)
+	sprintUpdatedAt := runtime.ExtractTime(sprint.GetUpdatedAt())
+	m.SetUpdatedAt(sprintUpdatedAt)
+	if sprint.GetProject() != nil {
+		sprintProject := int(sprint.GetProject().GetId())
+		m.SetProjectID(sprintProject)
+	}
+	for _, item := range sprint.GetTasks() {
+		tasks := int(item.GetId())
+		m.AddTaskIDs(tasks)
+	}
+	return m, nil
+}
@@ -0,0 +1,395 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	taskactivity "github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// TaskActivityService implements TaskActivityServiceServer
+type TaskActivityService struct {
+	client *ent.Client
+	UnimplementedTaskActivityServiceServer
+}
+
+// NewTaskActivityService returns a new TaskActivityService
+func NewTaskActivityService(client *ent.Client) *TaskActivityService {
+	return &TaskActivityService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpTaskActivity_Kind = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeTaskActivity_Kind(e string) string {
+	return protoIdentNormalizeRegexpTaskActivity_Kind.ReplaceAllString(e, "_")
+}
+
+func toProtoTaskActivity_Kind(e taskactivity.Kind) TaskActivity_Kind {
+	if v, ok := TaskActivity_Kind_value[strings.ToUpper("KIND_"+protoIdentNormalizeTaskActivity_Kind(string(e)))]; ok {
+		return TaskActivity_Kind(v)
+	}
+	return TaskActivity_Kind(0)
+}
+
+func toEntTaskActivity_Kind(e TaskActivity_Kind) taskactivity.Kind {
+	if v, ok := TaskActivity_Kind_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"KIND_CREATED":          "created",
+			"KIND_FIELD_CHANGED":    "field_changed",
+			"KIND_STATUS_CHANGED":   "status_changed",
+			"KIND_ASSIGNEE_ADDED":   "assignee_added",
+			"KIND_ASSIGNEE_REMOVED": "assignee_removed",
+			"KIND_LABEL_ADDED":      "label_added",
+			"KIND_LABEL_REMOVED":    "label_removed",
+			"KIND_COMMENT_ADDED":    "comment_added",
+			"KIND_COMMENT_EDITED":   "comment_edited",
+			"KIND_COMMENT_DELETED":  "comment_deleted",
+			"KIND_REPORT_SUBMITTED": "report_submitted",
+			"KIND_REPORT_UPDATED":   "report_updated",
+			"KIND_REPORT_REVIEWED":  "report_reviewed",
+		}[v]
+		return taskactivity.Kind(entVal)
+	}
+	return ""
+}
+
+// toProtoTaskActivity transforms the ent type to the pb type
+func toProtoTaskActivity(e *ent.TaskActivity) (*TaskActivity, error) {
+	v := &TaskActivity{}
+	actor_employee_id := wrapperspb.Int64(int64(e.ActorEmployeeID))
+	v.ActorEmployeeId = actor_employee_id
+	actor_user_id := wrapperspb.Int64(int64(e.ActorUserID))
+	v.ActorUserId = actor_user_id
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	field_name := wrapperspb.String(e.FieldName)
+	v.FieldName = field_name
+	id := int64(e.ID)
+	v.Id = id
+	kind := toProtoTaskActivity_Kind(e.Kind)
+	v.Kind = kind
+	new_value := wrapperspb.String(e.NewValue)
+	v.NewValue = new_value
+	old_value := wrapperspb.String(e.OldValue)
+	v.OldValue = old_value
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	project_id := wrapperspb.Int64(int64(e.ProjectID))
+	v.ProjectId = project_id
+	ref_id := wrapperspb.Int64(int64(e.RefID))
+	v.RefId = ref_id
+	task := int64(e.TaskID)
+	v.TaskId = task
+	if edg := e.Edges.Task; edg != nil {
+		id := int64(edg.ID)
+		v.Task = &Task{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskActivityList transforms a list of ent type to a list of pb type
+func toProtoTaskActivityList(e []*ent.TaskActivity) ([]*TaskActivity, error) {
+	var pbList []*TaskActivity
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskActivity(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskActivityServiceServer.Create
+func (svc *TaskActivityService) Create(ctx context.Context, req *CreateTaskActivityRequest) (*TaskActivity, error) {
+	taskactivity := req.GetTaskActivity()
+	m, err := svc.createBuilder(taskactivity)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskActivity(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskActivityServiceServer.Get
+func (svc *TaskActivityService) Get(ctx context.Context, req *GetTaskActivityRequest) (*TaskActivity, error) {
+	var (
+		err error
+		get *ent.TaskActivity
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskActivityRequest_VIEW_UNSPECIFIED, GetTaskActivityRequest_BASIC:
+		get, err = svc.client.TaskActivity.Get(ctx, id)
+	case GetTaskActivityRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskActivity.Query().
+			Where(taskactivity.ID(id)).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskActivity(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskActivityServiceServer.Update
+func (svc *TaskActivityService) Update(ctx context.Context, req *UpdateTaskActivityRequest) (*TaskActivity, error) {
+	taskactivity := req.GetTaskActivity()
+	taskactivityID := int(taskactivity.GetId())
+	m := svc.client.TaskActivity.UpdateOneID(taskactivityID)
+	if taskactivity.GetActorEmployeeId() != nil {
+		taskactivityActorEmployeeID := int(taskactivity.GetActorEmployeeId().GetValue())
+		m.SetActorEmployeeID(taskactivityActorEmployeeID)
+	}
+	if taskactivity.GetActorUserId() != nil {
+		taskactivityActorUserID := int(taskactivity.GetActorUserId().GetValue())
+		m.SetActorUserID(taskactivityActorUserID)
+	}
+	if taskactivity.GetFieldName() != nil {
+		taskactivityFieldName := taskactivity.GetFieldName().GetValue()
+		m.SetFieldName(taskactivityFieldName)
+	}
+	taskactivityKind := toEntTaskActivity_Kind(taskactivity.GetKind())
+	m.SetKind(taskactivityKind)
+	if taskactivity.GetNewValue() != nil {
+		taskactivityNewValue := taskactivity.GetNewValue().GetValue()
+		m.SetNewValue(taskactivityNewValue)
+	}
+	if taskactivity.GetOldValue() != nil {
+		taskactivityOldValue := taskactivity.GetOldValue().GetValue()
+		m.SetOldValue(taskactivityOldValue)
+	}
+	taskactivityOrgID := int(taskactivity.GetOrgId())
+	m.SetOrgID(taskactivityOrgID)
+	if taskactivity.GetProjectId() != nil {
+		taskactivityProjectID := int(taskactivity.GetProjectId().GetValue())
+		m.SetProjectID(taskactivityProjectID)
+	}
+	if taskactivity.GetRefId() != nil {
+		taskactivityRefID := int(taskactivity.GetRefId().GetValue())
+		m.SetRefID(taskactivityRefID)
+	}
+	taskactivityTaskID := int(taskactivity.GetTaskId())
+	m.SetTaskID(taskactivityTaskID)
+	if taskactivity.GetTask() != nil {
+		taskactivityTask := int(taskactivity.GetTask().GetId())
+		m.SetTaskID(taskactivityTask)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskActivity(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskActivityServiceServer.Delete
+func (svc *TaskActivityService) Delete(ctx context.Context, req *DeleteTaskActivityRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskActivity.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskActivityServiceServer.List
+func (svc *TaskActivityService) List(ctx context.Context, req *ListTaskActivityRequest) (*ListTaskActivityResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskActivity
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskActivity.Query().
+		Order(ent.Desc(taskactivity.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskactivity.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskActivityRequest_VIEW_UNSPECIFIED, ListTaskActivityRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskActivityRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskActivityList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskActivityResponse{
+			TaskActivityList: protoList,
+			NextPageToken:    nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskActivityServiceServer.BatchCreate
+func (svc *TaskActivityService) BatchCreate(ctx context.Context, req *BatchCreateTaskActivitiesRequest) (*BatchCreateTaskActivitiesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskActivityCreate, len(requests))
+	for i, req := range requests {
+		taskactivity := req.GetTaskActivity()
+		var err error
+		bulk[i], err = svc.createBuilder(taskactivity)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskActivity.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskActivityList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskActivitiesResponse{
+			TaskActivities: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskActivityService) createBuilder(taskactivity *TaskActivity) (*ent.TaskActivityCreate, error) {
+	m := svc.client.TaskActivity.Create()
+	if taskactivity.GetActorEmployeeId() != nil {
+		taskactivityActorEmployeeID := int(taskactivity.GetActorEmployeeId().GetValue())
+		m.SetActorEmployeeID(taskactivityActorEmployeeID)
+	}
+	if taskactivity.GetActorUserId() != nil {
+		taskactivityActorUserID := int(taskactivity.GetActorUserId().GetValue())
+		m.SetActorUserID(taskactivityActorUserID)
+	}
+	taskactivityCreatedAt := runtime.ExtractTime(taskactivity.GetCreatedAt())
+	m.SetCreatedAt(taskactivityCreatedAt)
+	if taskactivity.GetFieldName() != nil {
+		taskactivityFieldName := taskactivity.GetFieldName().GetValue()
+		m.SetFieldName(taskactivityFieldName)
+	}
+	taskactivityKind := toEntTaskActivity_Kind(taskactivity.GetKind())
+	m.SetKind(taskactivityKind)
+	if taskactivity.GetNewValue() != nil {
+		taskactivityNewValue := taskactivity.GetNewValue().GetValue()
+		m.SetNewValue(taskactivityNewValue)
+	}
+	if taskactivity.GetOldValue() != nil {
+		taskactivityOldValue := taskactivity.GetOldValue().GetValue()
+		m.SetOldValue(taskactivityOldValue)
+	}
+	taskactivityOrgID := int(taskactivity.GetOrgId())
+	m.SetOrgID(taskactivityOrgID)
+	if taskactivity.GetProjectId() != nil {
+		taskactivityProjectID := int(taskactivity.GetProjectId().GetValue())
+		m.SetProjectID(taskactivityProjectID)
+	}
+	if taskactivity.GetRefId() != nil {
+		taskactivityRefID := int(taskactivity.GetRefId().GetValue())
+		m.SetRefID(taskactivityRefID)
+	}
+	taskactivityTaskID := int(taskactivity.GetTaskId())
+	m.SetTaskID(taskactivityTaskID)
+	if taskactivity.GetTask() != nil {
+		taskactivityTask := int(taskactivity.GetTask().GetId())
+		m.SetTaskID(taskactivityTask)
+	}
+	return m, nil
+}
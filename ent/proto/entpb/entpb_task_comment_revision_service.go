@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	taskcomment "github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	taskcommentrevision "github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	strconv "strconv"
+)
+
+// TaskCommentRevisionService implements TaskCommentRevisionServiceServer
+type TaskCommentRevisionService struct {
+	client *ent.Client
+	UnimplementedTaskCommentRevisionServiceServer
+}
+
+// NewTaskCommentRevisionService returns a new TaskCommentRevisionService
+func NewTaskCommentRevisionService(client *ent.Client) *TaskCommentRevisionService {
+	return &TaskCommentRevisionService{
+		client: client,
+	}
+}
+
+// toProtoTaskCommentRevision transforms the ent type to the pb type
+func toProtoTaskCommentRevision(e *ent.TaskCommentRevision) (*TaskCommentRevision, error) {
+	v := &TaskCommentRevision{}
+	comment := int64(e.CommentID)
+	v.CommentId = comment
+	content := e.Content
+	v.Content = content
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	editor_id := int64(e.EditorID)
+	v.EditorId = editor_id
+	id := int64(e.ID)
+	v.Id = id
+	if edg := e.Edges.Comment; edg != nil {
+		id := int64(edg.ID)
+		v.Comment = &TaskComment{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskCommentRevisionList transforms a list of ent type to a list of pb type
+func toProtoTaskCommentRevisionList(e []*ent.TaskCommentRevision) ([]*TaskCommentRevision, error) {
+	var pbList []*TaskCommentRevision
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskCommentRevision(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskCommentRevisionServiceServer.Create
+func (svc *TaskCommentRevisionService) Create(ctx context.Context, req *CreateTaskCommentRevisionRequest) (*TaskCommentRevision, error) {
+	taskcommentrevision := req.GetTaskCommentRevision()
+	m, err := svc.createBuilder(taskcommentrevision)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskCommentRevision(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskCommentRevisionServiceServer.Get
+func (svc *TaskCommentRevisionService) Get(ctx context.Context, req *GetTaskCommentRevisionRequest) (*TaskCommentRevision, error) {
+	var (
+		err error
+		get *ent.TaskCommentRevision
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskCommentRevisionRequest_VIEW_UNSPECIFIED, GetTaskCommentRevisionRequest_BASIC:
+		get, err = svc.client.TaskCommentRevision.Get(ctx, id)
+	case GetTaskCommentRevisionRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskCommentRevision.Query().
+			Where(taskcommentrevision.ID(id)).
+			WithComment(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskCommentRevision(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskCommentRevisionServiceServer.Update
+func (svc *TaskCommentRevisionService) Update(ctx context.Context, req *UpdateTaskCommentRevisionRequest) (*TaskCommentRevision, error) {
+	taskcommentrevision := req.GetTaskCommentRevision()
+	taskcommentrevisionID := int(taskcommentrevision.GetId())
+	m := svc.client.TaskCommentRevision.UpdateOneID(taskcommentrevisionID)
+	taskcommentrevisionCommentID := int(taskcommentrevision.GetCommentId())
+	m.SetCommentID(taskcommentrevisionCommentID)
+	taskcommentrevisionContent := taskcommentrevision.GetContent()
+	m.SetContent(taskcommentrevisionContent)
+	taskcommentrevisionCreatedAt := runtime.ExtractTime(taskcommentrevision.GetCreatedAt())
+	m.SetCreatedAt(taskcommentrevisionCreatedAt)
+	taskcommentrevisionEditorID := int(taskcommentrevision.GetEditorId())
+	m.SetEditorID(taskcommentrevisionEditorID)
+	if taskcommentrevision.GetComment() != nil {
+		taskcommentrevisionComment := int(taskcommentrevision.GetComment().GetId())
+		m.SetCommentID(taskcommentrevisionComment)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskCommentRevision(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskCommentRevisionServiceServer.Delete
+func (svc *TaskCommentRevisionService) Delete(ctx context.Context, req *DeleteTaskCommentRevisionRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskCommentRevision.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskCommentRevisionServiceServer.List
+func (svc *TaskCommentRevisionService) List(ctx context.Context, req *ListTaskCommentRevisionRequest) (*ListTaskCommentRevisionResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskCommentRevision
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskCommentRevision.Query().
+		Order(ent.Desc(taskcommentrevision.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskcommentrevision.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskCommentRevisionRequest_VIEW_UNSPECIFIED, ListTaskCommentRevisionRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskCommentRevisionRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithComment(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskCommentRevisionList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskCommentRevisionResponse{
+			TaskCommentRevisionList: protoList,
+			NextPageToken:           nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskCommentRevisionServiceServer.BatchCreate
+func (svc *TaskCommentRevisionService) BatchCreate(ctx context.Context, req *BatchCreateTaskCommentRevisionsRequest) (*BatchCreateTaskCommentRevisionsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskCommentRevisionCreate, len(requests))
+	for i, req := range requests {
+		taskcommentrevision := req.GetTaskCommentRevision()
+		var err error
+		bulk[i], err = svc.createBuilder(taskcommentrevision)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskCommentRevision.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskCommentRevisionList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskCommentRevisionsResponse{
+			TaskCommentRevisions: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskCommentRevisionService) createBuilder(taskcommentrevision *TaskCommentRevision) (*ent.TaskCommentRevisionCreate, error) {
+	m := svc.client.TaskCommentRevision.Create()
+	taskcommentrevisionCommentID := int(taskcommentrevision.GetCommentId())
+	m.SetCommentID(taskcommentrevisionCommentID)
+	taskcommentrevisionContent := taskcommentrevision.GetContent()
+	m.SetContent(taskcommentrevisionContent)
+	taskcommentrevisionCreatedAt := runtime.ExtractTime(taskcommentrevision.GetCreatedAt())
+	m.SetCreatedAt(taskcommentrevisionCreatedAt)
+	taskcommentrevisionEditorID := int(taskcommentrevision.GetEditorId())
+	m.SetEditorID(taskcommentrevisionEditorID)
+	if taskcommentrevision.GetComment() != nil {
+		taskcommentrevisionComment := int(taskcommentrevision.GetComment().GetId())
+		m.SetCommentID(taskcommentrevisionComment)
+	}
+	return m, nil
+}
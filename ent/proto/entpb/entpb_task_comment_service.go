@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	taskcomment "github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	taskcommentrevision "github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	strconv "strconv"
+)
+
+// TaskCommentService implements TaskCommentServiceServer
+type TaskCommentService struct {
+	client *ent.Client
+	UnimplementedTaskCommentServiceServer
+}
+
+// NewTaskCommentService returns a new TaskCommentService
+func NewTaskCommentService(client *ent.Client) *TaskCommentService {
+	return &TaskCommentService{
+		client: client,
+	}
+}
+
+// toProtoTaskComment transforms the ent type to the pb type
+func toProtoTaskComment(e *ent.TaskComment) (*TaskComment, error) {
+	v := &TaskComment{}
+	author := int64(e.AuthorID)
+	v.AuthorId = author
+	content := e.Content
+	v.Content = content
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	if e.DeletedAt != nil {
+		deleted_at := timestamppb.New(*e.DeletedAt)
+		v.DeletedAt = deleted_at
+	}
+	if e.EditedAt != nil {
+		edited_at := timestamppb.New(*e.EditedAt)
+		v.EditedAt = edited_at
+	}
+	id := int64(e.ID)
+	v.Id = id
+	parent := wrapperspb.Int64(int64(e.ParentID))
+	v.ParentId = parent
+	task := int64(e.TaskID)
+	v.TaskId = task
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Author; edg != nil {
+		id := int64(edg.ID)
+		v.Author = &Employee{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.Mentions {
+		id := int64(edg.ID)
+		v.Mentions = append(v.Mentions, &Employee{
+			Id: id,
+		})
+	}
+	if edg := e.Edges.Parent; edg != nil {
+		id := int64(edg.ID)
+		v.Parent = &TaskComment{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.Replies {
+		id := int64(edg.ID)
+		v.Replies = append(v.Replies, &TaskComment{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Revisions {
+		id := int64(edg.ID)
+		v.Revisions = append(v.Revisions, &TaskCommentRevision{
+			Id: id,
+		})
+	}
+	if edg := e.Edges.Task; edg != nil {
+		id := int64(edg.ID)
+		v.Task = &Task{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskCommentList transforms a list of ent type to a list of pb type
+func toProtoTaskCommentList(e []*ent.TaskComment) ([]*TaskComment, error) {
+	var pbList []*TaskComment
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskComment(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskCommentServiceServer.Create
+func (svc *TaskCommentService) Create(ctx context.Context, req *CreateTaskCommentRequest) (*TaskComment, error) {
+	taskcomment := req.GetTaskComment()
+	m, err := svc.createBuilder(taskcomment)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskComment(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskCommentServiceServer.Get
+func (svc *TaskCommentService) Get(ctx context.Context, req *GetTaskCommentRequest) (*TaskComment, error) {
+	var (
+		err error
+		get *ent.TaskComment
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskCommentRequest_VIEW_UNSPECIFIED, GetTaskCommentRequest_BASIC:
+		get, err = svc.client.TaskComment.Get(ctx, id)
+	case GetTaskCommentRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskComment.Query().
+			Where(taskcomment.ID(id)).
+			WithAuthor(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithMentions(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithParent(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			WithReplies(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			WithRevisions(func(query *ent.TaskCommentRevisionQuery) {
+				query.Select(taskcommentrevision.FieldID)
+			}).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskComment(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskCommentServiceServer.Update
+func (svc *TaskCommentService) Update(ctx context.Context, req *UpdateTaskCommentRequest) (*TaskComment, error) {
+	taskcomment := req.GetTaskComment()
+	taskcommentID := int(taskcomment.GetId())
+	m := svc.client.TaskComment.UpdateOneID(taskcommentID)
+	taskcommentAuthorID := int(taskcomment.GetAuthorId())
+	m.SetAuthorID(taskcommentAuthorID)
+	taskcommentContent := taskcomment.GetContent()
+	m.SetContent(taskcommentContent)
+	taskcommentCreatedAt := runtime.ExtractTime(taskcomment.GetCreatedAt())
+	m.SetCreatedAt(taskcommentCreatedAt)
+	if taskcomment.GetDeletedAt() != nil {
+		taskcommentDeletedAt := runtime.ExtractTime(taskcomment.GetDeletedAt())
+		m.SetDeletedAt(taskcommentDeletedAt)
+	}
+	if taskcomment.GetEditedAt() != nil {
+		taskcommentEditedAt := runtime.ExtractTime(taskcomment.GetEditedAt())
+		m.SetEditedAt(taskcommentEditedAt)
+	}
+	if taskcomment.GetParentId() != nil {
+		taskcommentParentID := int(taskcomment.GetParentId().GetValue())
+		m.SetParentID(taskcommentParentID)
+	}
+	taskcommentTaskID := int(taskcomment.GetTaskId())
+	m.SetTaskID(taskcommentTaskID)
+	taskcommentUpdatedAt := runtime.ExtractTime(taskcomment.GetUpdatedAt())
+	m.SetUpdatedAt(taskcommentUpdatedAt)
+	if taskcomment.GetAuthor() != nil {
+		taskcommentAuthor := int(taskcomment.GetAuthor().GetId())
+		m.SetAuthorID(taskcommentAuthor)
+	}
+	for _, item := range taskcomment.GetMentions() {
+		mentions := int(item.GetId())
+		m.AddMentionIDs(mentions)
+	}
+	if taskcomment.GetParent() != nil {
+		taskcommentParent := int(taskcomment.GetParent().GetId())
+		m.SetParentID(taskcommentParent)
+	}
+	for _, item := range taskcomment.GetReplies() {
+		replies := int(item.GetId())
+		m.AddReplyIDs(replies)
+	}
+	for _, item := range taskcomment.GetRevisions() {
+		revisions := int(item.GetId())
+		m.AddRevisionIDs(revisions)
+	}
+	if taskcomment.GetTask() != nil {
+		taskcommentTask := int(taskcomment.GetTask().GetId())
+		m.SetTaskID(taskcommentTask)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskComment(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskCommentServiceServer.Delete
+func (svc *TaskCommentService) Delete(ctx context.Context, req *DeleteTaskCommentRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskComment.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskCommentServiceServer.List
+func (svc *TaskCommentService) List(ctx context.Context, req *ListTaskCommentRequest) (*ListTaskCommentResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskComment
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskComment.Query().
+		Order(ent.Desc(taskcomment.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskcomment.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskCommentRequest_VIEW_UNSPECIFIED, ListTaskCommentRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskCommentRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithAuthor(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithMentions(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithParent(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			WithReplies(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			WithRevisions(func(query *ent.TaskCommentRevisionQuery) {
+				query.Select(taskcommentrevision.FieldID)
+			}).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskCommentList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskCommentResponse{
+			TaskCommentList: protoList,
+			NextPageToken:   nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskCommentServiceServer.BatchCreate
+func (svc *TaskCommentService) BatchCreate(ctx context.Context, req *BatchCreateTaskCommentsRequest) (*BatchCreateTaskCommentsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskCommentCreate, len(requests))
+	for i, req := range requests {
+		taskcomment := req.GetTaskComment()
+		var err error
+		bulk[i], err = svc.createBuilder(taskcomment)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskComment.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskCommentList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskCommentsResponse{
+			TaskComments: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskCommentService) createBuilder(taskcomment *TaskComment) (*ent.TaskCommentCreate, error) {
+	m := svc.client.TaskComment.Create()
+	taskcommentAuthorID := int(taskcomment.GetAuthorId())
+	m.SetAuthorID(taskcommentAuthorID)
+	taskcommentContent := taskcomment.GetContent()
+	m.SetContent(taskcommentContent)
+	taskcommentCreatedAt := runtime.ExtractTime(taskcomment.GetCreatedAt())
+	m.SetCreatedAt(taskcommentCreatedAt)
+	if taskcomment.GetDeletedAt() != nil {
+		taskcommentDeletedAt := runtime.ExtractTime(taskcomment.GetDeletedAt())
+		m.SetDeletedAt(taskcommentDeletedAt)
+	}
+	if taskcomment.GetEditedAt() != nil {
+		taskcommentEditedAt := runtime.ExtractTime(taskcomment.GetEditedAt())
+		m.SetEditedAt(taskcommentEditedAt)
+	}
+	if taskcomment.GetParentId() != nil {
+		taskcommentParentID := int(taskcomment.GetParentId().GetValue())
+		m.SetParentID(taskcommentParentID)
+	}
+	taskcommentTaskID := int(taskcomment.GetTaskId())
+	m.SetTaskID(taskcommentTaskID)
+	taskcommentUpdatedAt := runtime.ExtractTime(taskcomment.GetUpdatedAt())
+	m.SetUpdatedAt(taskcommentUpdatedAt)
+	if taskcomment.GetAuthor() != nil {
+		taskcommentAuthor := int(taskcomment.GetAuthor().GetId())
+		m.SetAuthorID(taskcommentAuthor)
+	}
+	for _, item := range taskcomment.GetMentions() {
+		mentions := int(item.GetId())
+		m.AddMentionIDs(mentions)
+	}
+	if taskcomment.GetParent() != nil {
+		taskcommentParent := int(taskcomment.GetParent().GetId())
+		m.SetParentID(taskcommentParent)
+	}
+	for _, item := range taskcomment.GetReplies() {
+		replies := int(item.GetId())
+		m.AddReplyIDs(replies)
+	}
+	for _, item := range taskcomment.GetRevisions() {
+		revisions := int(item.GetId())
+		m.AddRevisionIDs(revisions)
+	}
+	if taskcomment.GetTask() != nil {
+		taskcommentTask := int(taskcomment.GetTask().GetId())
+		m.SetTaskID(taskcommentTask)
+	}
+	return m, nil
+}
@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	taskdependency "github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// TaskDependencyService implements TaskDependencyServiceServer
+type TaskDependencyService struct {
+	client *ent.Client
+	UnimplementedTaskDependencyServiceServer
+}
+
+// NewTaskDependencyService returns a new TaskDependencyService
+func NewTaskDependencyService(client *ent.Client) *TaskDependencyService {
+	return &TaskDependencyService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpTaskDependency_Type = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeTaskDependency_Type(e string) string {
+	return protoIdentNormalizeRegexpTaskDependency_Type.ReplaceAllString(e, "_")
+}
+
+func toProtoTaskDependency_Type(e taskdependency.Type) TaskDependency_Type {
+	if v, ok := TaskDependency_Type_value[strings.ToUpper("TYPE_"+protoIdentNormalizeTaskDependency_Type(string(e)))]; ok {
+		return TaskDependency_Type(v)
+	}
+	return TaskDependency_Type(0)
+}
+
+func toEntTaskDependency_Type(e TaskDependency_Type) taskdependency.Type {
+	if v, ok := TaskDependency_Type_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"TYPE_BLOCKS":     "blocks",
+			"TYPE_RELATES_TO": "relates_to",
+			"TYPE_DUPLICATES": "duplicates",
+		}[v]
+		return taskdependency.Type(entVal)
+	}
+	return ""
+}
+
+// toProtoTaskDependency transforms the ent type to the pb type
+func toProtoTaskDependency(e *ent.TaskDependency) (*TaskDependency, error) {
+	v := &TaskDependency{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	creator_id := int64(e.CreatorID)
+	v.CreatorId = creator_id
+	id := int64(e.ID)
+	v.Id = id
+	target := int64(e.TargetID)
+	v.TargetId = target
+	task := int64(e.TaskID)
+	v.TaskId = task
+	_type := toProtoTaskDependency_Type(e.Type)
+	v.Type = _type
+	if edg := e.Edges.Target; edg != nil {
+		id := int64(edg.ID)
+		v.Target = &Task{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Task; edg != nil {
+		id := int64(edg.ID)
+		v.Task = &Task{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskDependencyList transforms a list of ent type to a list of pb type
+func toProtoTaskDependencyList(e []*ent.TaskDependency) ([]*TaskDependency, error) {
+	var pbList []*TaskDependency
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskDependency(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskDependencyServiceServer.Create
+func (svc *TaskDependencyService) Create(ctx context.Context, req *CreateTaskDependencyRequest) (*TaskDependency, error) {
+	taskdependency := req.GetTaskDependency()
+	m, err := svc.createBuilder(taskdependency)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskDependency(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskDependencyServiceServer.Get
+func (svc *TaskDependencyService) Get(ctx context.Context, req *GetTaskDependencyRequest) (*TaskDependency, error) {
+	var (
+		err error
+		get *ent.TaskDependency
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskDependencyRequest_VIEW_UNSPECIFIED, GetTaskDependencyRequest_BASIC:
+		get, err = svc.client.TaskDependency.Get(ctx, id)
+	case GetTaskDependencyRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskDependency.Query().
+			Where(taskdependency.ID(id)).
+			WithTarget(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskDependency(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskDependencyServiceServer.Update
+func (svc *TaskDependencyService) Update(ctx context.Context, req *UpdateTaskDependencyRequest) (*TaskDependency, error) {
+	taskdependency := req.GetTaskDependency()
+	taskdependencyID := int(taskdependency.GetId())
+	m := svc.client.TaskDependency.UpdateOneID(taskdependencyID)
+	taskdependencyCreatedAt := runtime.ExtractTime(taskdependency.GetCreatedAt())
+	m.SetCreatedAt(taskdependencyCreatedAt)
+	taskdependencyCreatorID := int(taskdependency.GetCreatorId())
+	m.SetCreatorID(taskdependencyCreatorID)
+	taskdependencyTargetID := int(taskdependency.GetTargetId())
+	m.SetTargetID(taskdependencyTargetID)
+	taskdependencyTaskID := int(taskdependency.GetTaskId())
+	m.SetTaskID(taskdependencyTaskID)
+	taskdependencyType := toEntTaskDependency_Type(taskdependency.GetType())
+	m.SetType(taskdependencyType)
+	if taskdependency.GetTarget() != nil {
+		taskdependencyTarget := int(taskdependency.GetTarget().GetId())
+		m.SetTargetID(taskdependencyTarget)
+	}
+	if taskdependency.GetTask() != nil {
+		taskdependencyTask := int(taskdependency.GetTask().GetId())
+		m.SetTaskID(taskdependencyTask)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskDependency(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskDependencyServiceServer.Delete
+func (svc *TaskDependencyService) Delete(ctx context.Context, req *DeleteTaskDependencyRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskDependency.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskDependencyServiceServer.List
+func (svc *TaskDependencyService) List(ctx context.Context, req *ListTaskDependencyRequest) (*ListTaskDependencyResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskDependency
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskDependency.Query().
+		Order(ent.Desc(taskdependency.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskdependency.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskDependencyRequest_VIEW_UNSPECIFIED, ListTaskDependencyRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskDependencyRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithTarget(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskDependencyList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskDependencyResponse{
+			TaskDependencyList: protoList,
+			NextPageToken:      nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskDependencyServiceServer.BatchCreate
+func (svc *TaskDependencyService) BatchCreate(ctx context.Context, req *BatchCreateTaskDependenciesRequest) (*BatchCreateTaskDependenciesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskDependencyCreate, len(requests))
+	for i, req := range requests {
+		taskdependency := req.GetTaskDependency()
+		var err error
+		bulk[i], err = svc.createBuilder(taskdependency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskDependency.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskDependencyList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskDependenciesResponse{
+			TaskDependencies: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskDependencyService) createBuilder(taskdependency *TaskDependency) (*ent.TaskDependencyCreate, error) {
+	m := svc.client.TaskDependency.Create()
+	taskdependencyCreatedAt := runtime.ExtractTime(taskdependency.GetCreatedAt())
+	m.SetCreatedAt(taskdependencyCreatedAt)
+	taskdependencyCreatorID := int(taskdependency.GetCreatorId())
+	m.SetCreatorID(taskdependencyCreatorID)
+	taskdependencyTargetID := int(taskdependency.GetTargetId())
+	m.SetTargetID(taskdependencyTargetID)
+	taskdependencyTaskID := int(taskdependency.GetTaskId())
+	m.SetTaskID(taskdependencyTaskID)
+	taskdependencyType := toEntTaskDependency_Type(taskdependency.GetType())
+	m.SetType(taskdependencyType)
+	if taskdependency.GetTarget() != nil {
+		taskdependencyTarget := int(taskdependency.GetTarget().GetId())
+		m.SetTargetID(taskdependencyTarget)
+	}
+	if taskdependency.GetTask() != nil {
+		taskdependencyTask := int(taskdependency.GetTask().GetId())
+		m.SetTaskID(taskdependencyTask)
+	}
+	return m, nil
+}
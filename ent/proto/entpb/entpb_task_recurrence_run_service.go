@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	taskrecurrence "github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	taskrecurrencerun "github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// TaskRecurrenceRunService implements TaskRecurrenceRunServiceServer
+type TaskRecurrenceRunService struct {
+	client *ent.Client
+	UnimplementedTaskRecurrenceRunServiceServer
+}
+
+// NewTaskRecurrenceRunService returns a new TaskRecurrenceRunService
+func NewTaskRecurrenceRunService(client *ent.Client) *TaskRecurrenceRunService {
+	return &TaskRecurrenceRunService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpTaskRecurrenceRun_Status = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeTaskRecurrenceRun_Status(e string) string {
+	return protoIdentNormalizeRegexpTaskRecurrenceRun_Status.ReplaceAllString(e, "_")
+}
+
+func toProtoTaskRecurrenceRun_Status(e taskrecurrencerun.Status) TaskRecurrenceRun_Status {
+	if v, ok := TaskRecurrenceRun_Status_value[strings.ToUpper("STATUS_"+protoIdentNormalizeTaskRecurrenceRun_Status(string(e)))]; ok {
+		return TaskRecurrenceRun_Status(v)
+	}
+	return TaskRecurrenceRun_Status(0)
+}
+
+func toEntTaskRecurrenceRun_Status(e TaskRecurrenceRun_Status) taskrecurrencerun.Status {
+	if v, ok := TaskRecurrenceRun_Status_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATUS_PENDING": "pending",
+			"STATUS_CREATED": "created",
+			"STATUS_FAILED":  "failed",
+		}[v]
+		return taskrecurrencerun.Status(entVal)
+	}
+	return ""
+}
+
+// toProtoTaskRecurrenceRun transforms the ent type to the pb type
+func toProtoTaskRecurrenceRun(e *ent.TaskRecurrenceRun) (*TaskRecurrenceRun, error) {
+	v := &TaskRecurrenceRun{}
+	attempts := int64(e.Attempts)
+	v.Attempts = attempts
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	error := wrapperspb.String(e.Error)
+	v.Error = error
+	id := int64(e.ID)
+	v.Id = id
+	occurrence_at := timestamppb.New(e.OccurrenceAt)
+	v.OccurrenceAt = occurrence_at
+	recurrence := int64(e.RecurrenceID)
+	v.RecurrenceId = recurrence
+	status := toProtoTaskRecurrenceRun_Status(e.Status)
+	v.Status = status
+	if e.TaskID != nil {
+		task_id := wrapperspb.Int64(int64(*e.TaskID))
+		v.TaskId = task_id
+	}
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Recurrence; edg != nil {
+		id := int64(edg.ID)
+		v.Recurrence = &TaskRecurrence{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskRecurrenceRunList transforms a list of ent type to a list of pb type
+func toProtoTaskRecurrenceRunList(e []*ent.TaskRecurrenceRun) ([]*TaskRecurrenceRun, error) {
+	var pbList []*TaskRecurrenceRun
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskRecurrenceRun(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskRecurrenceRunServiceServer.Create
+func (svc *TaskRecurrenceRunService) Create(ctx context.Context, req *CreateTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error) {
+	taskrecurrencerun := req.GetTaskRecurrenceRun()
+	m, err := svc.createBuilder(taskrecurrencerun)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskRecurrenceRun(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskRecurrenceRunServiceServer.Get
+func (svc *TaskRecurrenceRunService) Get(ctx context.Context, req *GetTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error) {
+	var (
+		err error
+		get *ent.TaskRecurrenceRun
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskRecurrenceRunRequest_VIEW_UNSPECIFIED, GetTaskRecurrenceRunRequest_BASIC:
+		get, err = svc.client.TaskRecurrenceRun.Get(ctx, id)
+	case GetTaskRecurrenceRunRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskRecurrenceRun.Query().
+			Where(taskrecurrencerun.ID(id)).
+			WithRecurrence(func(query *ent.TaskRecurrenceQuery) {
+				query.Select(taskrecurrence.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskRecurrenceRun(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskRecurrenceRunServiceServer.Update
+func (svc *TaskRecurrenceRunService) Update(ctx context.Context, req *UpdateTaskRecurrenceRunRequest) (*TaskRecurrenceRun, error) {
+	taskrecurrencerun := req.GetTaskRecurrenceRun()
+	taskrecurrencerunID := int(taskrecurrencerun.GetId())
+	m := svc.client.TaskRecurrenceRun.UpdateOneID(taskrecurrencerunID)
+	taskrecurrencerunAttempts := int(taskrecurrencerun.GetAttempts())
+	m.SetAttempts(taskrecurrencerunAttempts)
+	if taskrecurrencerun.GetError() != nil {
+		taskrecurrencerunError := taskrecurrencerun.GetError().GetValue()
+		m.SetError(taskrecurrencerunError)
+	}
+	taskrecurrencerunOccurrenceAt := runtime.ExtractTime(taskrecurrencerun.GetOccurrenceAt())
+	m.SetOccurrenceAt(taskrecurrencerunOccurrenceAt)
+	taskrecurrencerunRecurrenceID := int(taskrecurrencerun.GetRecurrenceId())
+	m.SetRecurrenceID(taskrecurrencerunRecurrenceID)
+	taskrecurrencerunStatus := toEntTaskRecurrenceRun_Status(taskrecurrencerun.GetStatus())
+	m.SetStatus(taskrecurrencerunStatus)
+	if taskrecurrencerun.GetTaskId() != nil {
+		taskrecurrencerunTaskID := int(taskrecurrencerun.GetTaskId().GetValue())
+		m.SetTaskID(taskrecurrencerunTaskID)
+	}
+	taskrecurrencerunUpdatedAt := runtime.ExtractTime(taskrecurrencerun.GetUpdatedAt())
+	m.SetUpdatedAt(taskrecurrencerunUpdatedAt)
+	if taskrecurrencerun.GetRecurrence() != nil {
+		taskrecurrencerunRecurrence := int(taskrecurrencerun.GetRecurrence().GetId())
+		m.SetRecurrenceID(taskrecurrencerunRecurrence)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskRecurrenceRun(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskRecurrenceRunServiceServer.Delete
+func (svc *TaskRecurrenceRunService) Delete(ctx context.Context, req *DeleteTaskRecurrenceRunRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskRecurrenceRun.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskRecurrenceRunServiceServer.List
+func (svc *TaskRecurrenceRunService) List(ctx context.Context, req *ListTaskRecurrenceRunRequest) (*ListTaskRecurrenceRunResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskRecurrenceRun
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskRecurrenceRun.Query().
+		Order(ent.Desc(taskrecurrencerun.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskrecurrencerun.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskRecurrenceRunRequest_VIEW_UNSPECIFIED, ListTaskRecurrenceRunRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskRecurrenceRunRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithRecurrence(func(query *ent.TaskRecurrenceQuery) {
+				query.Select(taskrecurrence.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskRecurrenceRunList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskRecurrenceRunResponse{
+			TaskRecurrenceRunList: protoList,
+			NextPageToken:         nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskRecurrenceRunServiceServer.BatchCreate
+func (svc *TaskRecurrenceRunService) BatchCreate(ctx context.Context, req *BatchCreateTaskRecurrenceRunsRequest) (*BatchCreateTaskRecurrenceRunsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskRecurrenceRunCreate, len(requests))
+	for i, req := range requests {
+		taskrecurrencerun := req.GetTaskRecurrenceRun()
+		var err error
+		bulk[i], err = svc.createBuilder(taskrecurrencerun)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskRecurrenceRun.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskRecurrenceRunList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskRecurrenceRunsResponse{
+			TaskRecurrenceRuns: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskRecurrenceRunService) createBuilder(taskrecurrencerun *TaskRecurrenceRun) (*ent.TaskRecurrenceRunCreate, error) {
+	m := svc.client.TaskRecurrenceRun.Create()
+	taskrecurrencerunAttempts := int(taskrecurrencerun.GetAttempts())
+	m.SetAttempts(taskrecurrencerunAttempts)
+	taskrecurrencerunCreatedAt := runtime.ExtractTime(taskrecurrencerun.GetCreatedAt())
+	m.SetCreatedAt(taskrecurrencerunCreatedAt)
+	if taskrecurrencerun.GetError() != nil {
+		taskrecurrencerunError := taskrecurrencerun.GetError().GetValue()
+		m.SetError(taskrecurrencerunError)
+	}
+	taskrecurrencerunOccurrenceAt := runtime.ExtractTime(taskrecurrencerun.GetOccurrenceAt())
+	m.SetOccurrenceAt(taskrecurrencerunOccurrenceAt)
+	taskrecurrencerunRecurrenceID := int(taskrecurrencerun.GetRecurrenceId())
+	m.SetRecurrenceID(taskrecurrencerunRecurrenceID)
+	taskrecurrencerunStatus := toEntTaskRecurrenceRun_Status(taskrecurrencerun.GetStatus())
+	m.SetStatus(taskrecurrencerunStatus)
+	if taskrecurrencerun.GetTaskId() != nil {
+		taskrecurrencerunTaskID := int(taskrecurrencerun.GetTaskId().GetValue())
+		m.SetTaskID(taskrecurrencerunTaskID)
+	}
+	taskrecurrencerunUpdatedAt := runtime.ExtractTime(taskrecurrencerun.GetUpdatedAt())
+	m.SetUpdatedAt(taskrecurrencerunUpdatedAt)
+	if taskrecurrencerun.GetRecurrence() != nil {
+		taskrecurrencerunRecurrence := int(taskrecurrencerun.GetRecurrence().GetId())
+		m.SetRecurrenceID(taskrecurrencerunRecurrence)
+	}
+	return m, nil
+}
@@ -0,0 +1,374 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	taskrecurrence "github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	taskrecurrencerun "github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	tasktemplate "github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	strconv "strconv"
+)
+
+// TaskRecurrenceService implements TaskRecurrenceServiceServer
+type TaskRecurrenceService struct {
+	client *ent.Client
+	UnimplementedTaskRecurrenceServiceServer
+}
+
+// NewTaskRecurrenceService returns a new TaskRecurrenceService
+func NewTaskRecurrenceService(client *ent.Client) *TaskRecurrenceService {
+	return &TaskRecurrenceService{
+		client: client,
+	}
+}
+
+// toProtoTaskRecurrence transforms the ent type to the pb type
+func toProtoTaskRecurrence(e *ent.TaskRecurrence) (*TaskRecurrence, error) {
+	v := &TaskRecurrence{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	creator_id := int64(e.CreatorID)
+	v.CreatorId = creator_id
+	employee_id := int64(e.EmployeeID)
+	v.EmployeeId = employee_id
+	enabled := e.Enabled
+	v.Enabled = enabled
+	id := int64(e.ID)
+	v.Id = id
+	if e.LastRunAt != nil {
+		last_run_at := timestamppb.New(*e.LastRunAt)
+		v.LastRunAt = last_run_at
+	}
+	if e.NextRunAt != nil {
+		next_run_at := timestamppb.New(*e.NextRunAt)
+		v.NextRunAt = next_run_at
+	}
+	rule := e.Rule
+	v.Rule = rule
+	start_at := timestamppb.New(e.StartAt)
+	v.StartAt = start_at
+	template := int64(e.TemplateID)
+	v.TemplateId = template
+	timezone := e.Timezone
+	v.Timezone = timezone
+	if e.Until != nil {
+		until := timestamppb.New(*e.Until)
+		v.Until = until
+	}
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	for _, edg := range e.Edges.Runs {
+		id := int64(edg.ID)
+		v.Runs = append(v.Runs, &TaskRecurrenceRun{
+			Id: id,
+		})
+	}
+	if edg := e.Edges.Template; edg != nil {
+		id := int64(edg.ID)
+		v.Template = &TaskTemplate{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskRecurrenceList transforms a list of ent type to a list of pb type
+func toProtoTaskRecurrenceList(e []*ent.TaskRecurrence) ([]*TaskRecurrence, error) {
+	var pbList []*TaskRecurrence
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskRecurrence(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskRecurrenceServiceServer.Create
+func (svc *TaskRecurrenceService) Create(ctx context.Context, req *CreateTaskRecurrenceRequest) (*TaskRecurrence, error) {
+	taskrecurrence := req.GetTaskRecurrence()
+	m, err := svc.createBuilder(taskrecurrence)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskRecurrence(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskRecurrenceServiceServer.Get
+func (svc *TaskRecurrenceService) Get(ctx context.Context, req *GetTaskRecurrenceRequest) (*TaskRecurrence, error) {
+	var (
+		err error
+		get *ent.TaskRecurrence
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskRecurrenceRequest_VIEW_UNSPECIFIED, GetTaskRecurrenceRequest_BASIC:
+		get, err = svc.client.TaskRecurrence.Get(ctx, id)
+	case GetTaskRecurrenceRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskRecurrence.Query().
+			Where(taskrecurrence.ID(id)).
+			WithRuns(func(query *ent.TaskRecurrenceRunQuery) {
+				query.Select(taskrecurrencerun.FieldID)
+			}).
+			WithTemplate(func(query *ent.TaskTemplateQuery) {
+				query.Select(tasktemplate.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskRecurrence(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskRecurrenceServiceServer.Update
+func (svc *TaskRecurrenceService) Update(ctx context.Context, req *UpdateTaskRecurrenceRequest) (*TaskRecurrence, error) {
+	taskrecurrence := req.GetTaskRecurrence()
+	taskrecurrenceID := int(taskrecurrence.GetId())
+	m := svc.client.TaskRecurrence.UpdateOneID(taskrecurrenceID)
+	taskrecurrenceCreatorID := int(taskrecurrence.GetCreatorId())
+	m.SetCreatorID(taskrecurrenceCreatorID)
+	taskrecurrenceEmployeeID := int(taskrecurrence.GetEmployeeId())
+	m.SetEmployeeID(taskrecurrenceEmployeeID)
+	taskrecurrenceEnabled := taskrecurrence.GetEnabled()
+	m.SetEnabled(taskrecurrenceEnabled)
+	if taskrecurrence.GetLastRunAt() != nil {
+		taskrecurrenceLastRunAt := runtime.ExtractTime(taskrecurrence.GetLastRunAt())
+		m.SetLastRunAt(taskrecurrenceLastRunAt)
+	}
+	if taskrecurrence.GetNextRunAt() != nil {
+		taskrecurrenceNextRunAt := runtime.ExtractTime(taskrecurrence.GetNextRunAt())
+		m.SetNextRunAt(taskrecurrenceNextRunAt)
+	}
+	taskrecurrenceRule := taskrecurrence.GetRule()
+	m.SetRule(taskrecurrenceRule)
+	taskrecurrenceStartAt := runtime.ExtractTime(taskrecurrence.GetStartAt())
+	m.SetStartAt(taskrecurrenceStartAt)
+	taskrecurrenceTemplateID := int(taskrecurrence.GetTemplateId())
+	m.SetTemplateID(taskrecurrenceTemplateID)
+	taskrecurrenceTimezone := taskrecurrence.GetTimezone()
+	m.SetTimezone(taskrecurrenceTimezone)
+	if taskrecurrence.GetUntil() != nil {
+		taskrecurrenceUntil := runtime.ExtractTime(taskrecurrence.GetUntil())
+		m.SetUntil(taskrecurrenceUntil)
+	}
+	taskrecurrenceUpdatedAt := runtime.ExtractTime(taskrecurrence.GetUpdatedAt())
+	m.SetUpdatedAt(taskrecurrenceUpdatedAt)
+	for _, item := range taskrecurrence.GetRuns() {
+		runs := int(item.GetId())
+		m.AddRunIDs(runs)
+	}
+	if taskrecurrence.GetTemplate() != nil {
+		taskrecurrenceTemplate := int(taskrecurrence.GetTemplate().GetId())
+		m.SetTemplateID(taskrecurrenceTemplate)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskRecurrence(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskRecurrenceServiceServer.Delete
+func (svc *TaskRecurrenceService) Delete(ctx context.Context, req *DeleteTaskRecurrenceRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskRecurrence.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskRecurrenceServiceServer.List
+func (svc *TaskRecurrenceService) List(ctx context.Context, req *ListTaskRecurrenceRequest) (*ListTaskRecurrenceResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskRecurrence
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskRecurrence.Query().
+		Order(ent.Desc(taskrecurrence.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskrecurrence.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskRecurrenceRequest_VIEW_UNSPECIFIED, ListTaskRecurrenceRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskRecurrenceRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithRuns(func(query *ent.TaskRecurrenceRunQuery) {
+				query.Select(taskrecurrencerun.FieldID)
+			}).
+			WithTemplate(func(query *ent.TaskTemplateQuery) {
+				query.Select(tasktemplate.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskRecurrenceList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskRecurrenceResponse{
+			TaskRecurrenceList: protoList,
+			NextPageToken:      nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskRecurrenceServiceServer.BatchCreate
+func (svc *TaskRecurrenceService) BatchCreate(ctx context.Context, req *BatchCreateTaskRecurrencesRequest) (*BatchCreateTaskRecurrencesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskRecurrenceCreate, len(requests))
+	for i, req := range requests {
+		taskrecurrence := req.GetTaskRecurrence()
+		var err error
+		bulk[i], err = svc.createBuilder(taskrecurrence)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskRecurrence.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskRecurrenceList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskRecurrencesResponse{
+			TaskRecurrences: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskRecurrenceService) createBuilder(taskrecurrence *TaskRecurrence) (*ent.TaskRecurrenceCreate, error) {
+	m := svc.client.TaskRecurrence.Create()
+	taskrecurrenceCreatedAt := runtime.ExtractTime(taskrecurrence.GetCreatedAt())
+	m.SetCreatedAt(taskrecurrenceCreatedAt)
+	taskrecurrenceCreatorID := int(taskrecurrence.GetCreatorId())
+	m.SetCreatorID(taskrecurrenceCreatorID)
+	taskrecurrenceEmployeeID := int(taskrecurrence.GetEmployeeId())
+	m.SetEmployeeID(taskrecurrenceEmployeeID)
+	taskrecurrenceEnabled := taskrecurrence.GetEnabled()
+	m.SetEnabled(taskrecurrenceEnabled)
+	if taskrecurrence.GetLastRunAt() != nil {
+		taskrecurrenceLastRunAt := runtime.ExtractTime(taskrecurrence.GetLastRunAt())
+		m.SetLastRunAt(taskrecurrenceLastRunAt)
+	}
+	if taskrecurrence.GetNextRunAt() != nil {
+		taskrecurrenceNextRunAt := runtime.ExtractTime(taskrecurrence.GetNextRunAt())
+		m.SetNextRunAt(taskrecurrenceNextRunAt)
+	}
+	taskrecurrenceRule := taskrecurrence.GetRule()
+	m.SetRule(taskrecurrenceRule)
+	taskrecurrenceStartAt := runtime.ExtractTime(taskrecurrence.GetStartAt())
+	m.SetStartAt(taskrecurrenceStartAt)
+	taskrecurrenceTemplateID := int(taskrecurrence.GetTemplateId())
+	m.SetTemplateID(taskrecurrenceTemplateID)
+	taskrecurrenceTimezone := taskrecurrence.GetTimezone()
+	m.SetTimezone(taskrecurrenceTimezone)
+	if taskrecurrence.GetUntil() != nil {
+		taskrecurrenceUntil := runtime.ExtractTime(taskrecurrence.GetUntil())
+		m.SetUntil(taskrecurrenceUntil)
+	}
+	taskrecurrenceUpdatedAt := runtime.ExtractTime(taskrecurrence.GetUpdatedAt())
+	m.SetUpdatedAt(taskrecurrenceUpdatedAt)
+	for _, item := range taskrecurrence.GetRuns() {
+		runs := int(item.GetId())
+		m.AddRunIDs(runs)
+	}
+	if taskrecurrence.GetTemplate() != nil {
+		taskrecurrenceTemplate := int(taskrecurrence.GetTemplate().GetId())
+		m.SetTemplateID(taskrecurrenceTemplate)
+	}
+	return m, nil
+}
@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	taskreminder "github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// TaskReminderService implements TaskReminderServiceServer
+type TaskReminderService struct {
+	client *ent.Client
+	UnimplementedTaskReminderServiceServer
+}
+
+// NewTaskReminderService returns a new TaskReminderService
+func NewTaskReminderService(client *ent.Client) *TaskReminderService {
+	return &TaskReminderService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpTaskReminder_Kind = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeTaskReminder_Kind(e string) string {
+	return protoIdentNormalizeRegexpTaskReminder_Kind.ReplaceAllString(e, "_")
+}
+
+func toProtoTaskReminder_Kind(e taskreminder.Kind) TaskReminder_Kind {
+	if v, ok := TaskReminder_Kind_value[strings.ToUpper("KIND_"+protoIdentNormalizeTaskReminder_Kind(string(e)))]; ok {
+		return TaskReminder_Kind(v)
+	}
+	return TaskReminder_Kind(0)
+}
+
+func toEntTaskReminder_Kind(e TaskReminder_Kind) taskreminder.Kind {
+	if v, ok := TaskReminder_Kind_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"KIND_DUE_SOON": "due_soon",
+			"KIND_OVERDUE":  "overdue",
+		}[v]
+		return taskreminder.Kind(entVal)
+	}
+	return ""
+}
+
+// toProtoTaskReminder transforms the ent type to the pb type
+func toProtoTaskReminder(e *ent.TaskReminder) (*TaskReminder, error) {
+	v := &TaskReminder{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	due_date := timestamppb.New(e.DueDate)
+	v.DueDate = due_date
+	id := int64(e.ID)
+	v.Id = id
+	kind := toProtoTaskReminder_Kind(e.Kind)
+	v.Kind = kind
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	seq := int64(e.Seq)
+	v.Seq = seq
+	task := int64(e.TaskID)
+	v.TaskId = task
+	if edg := e.Edges.Task; edg != nil {
+		id := int64(edg.ID)
+		v.Task = &Task{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskReminderList transforms a list of ent type to a list of pb type
+func toProtoTaskReminderList(e []*ent.TaskReminder) ([]*TaskReminder, error) {
+	var pbList []*TaskReminder
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskReminder(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskReminderServiceServer.Create
+func (svc *TaskReminderService) Create(ctx context.Context, req *CreateTaskReminderRequest) (*TaskReminder, error) {
+	taskreminder := req.GetTaskReminder()
+	m, err := svc.createBuilder(taskreminder)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskReminder(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskReminderServiceServer.Get
+func (svc *TaskReminderService) Get(ctx context.Context, req *GetTaskReminderRequest) (*TaskReminder, error) {
+	var (
+		err error
+		get *ent.TaskReminder
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskReminderRequest_VIEW_UNSPECIFIED, GetTaskReminderRequest_BASIC:
+		get, err = svc.client.TaskReminder.Get(ctx, id)
+	case GetTaskReminderRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskReminder.Query().
+			Where(taskreminder.ID(id)).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskReminder(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskReminderServiceServer.Update
+func (svc *TaskReminderService) Update(ctx context.Context, req *UpdateTaskReminderRequest) (*TaskReminder, error) {
+	taskreminder := req.GetTaskReminder()
+	taskreminderID := int(taskreminder.GetId())
+	m := svc.client.TaskReminder.UpdateOneID(taskreminderID)
+	taskreminderDueDate := runtime.ExtractTime(taskreminder.GetDueDate())
+	m.SetDueDate(taskreminderDueDate)
+	taskreminderKind := toEntTaskReminder_Kind(taskreminder.GetKind())
+	m.SetKind(taskreminderKind)
+	taskreminderOrgID := int(taskreminder.GetOrgId())
+	m.SetOrgID(taskreminderOrgID)
+	taskreminderSeq := int(taskreminder.GetSeq())
+	m.SetSeq(taskreminderSeq)
+	taskreminderTaskID := int(taskreminder.GetTaskId())
+	m.SetTaskID(taskreminderTaskID)
+	if taskreminder.GetTask() != nil {
+		taskreminderTask := int(taskreminder.GetTask().GetId())
+		m.SetTaskID(taskreminderTask)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskReminder(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskReminderServiceServer.Delete
+func (svc *TaskReminderService) Delete(ctx context.Context, req *DeleteTaskReminderRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskReminder.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskReminderServiceServer.List
+func (svc *TaskReminderService) List(ctx context.Context, req *ListTaskReminderRequest) (*ListTaskReminderResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskReminder
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskReminder.Query().
+		Order(ent.Desc(taskreminder.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskreminder.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskReminderRequest_VIEW_UNSPECIFIED, ListTaskReminderRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskReminderRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskReminderList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskReminderResponse{
+			TaskReminderList: protoList,
+			NextPageToken:    nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskReminderServiceServer.BatchCreate
+func (svc *TaskReminderService) BatchCreate(ctx context.Context, req *BatchCreateTaskRemindersRequest) (*BatchCreateTaskRemindersResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskReminderCreate, len(requests))
+	for i, req := range requests {
+		taskreminder := req.GetTaskReminder()
+		var err error
+		bulk[i], err = svc.createBuilder(taskreminder)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskReminder.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskReminderList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskRemindersResponse{
+			TaskReminders: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskReminderService) createBuilder(taskreminder *TaskReminder) (*ent.TaskReminderCreate, error) {
+	m := svc.client.TaskReminder.Create()
+	taskreminderCreatedAt := runtime.ExtractTime(taskreminder.GetCreatedAt())
+	m.SetCreatedAt(taskreminderCreatedAt)
+	taskreminderDueDate := runtime.ExtractTime(taskreminder.GetDueDate())
+	m.SetDueDate(taskreminderDueDate)
+	taskreminderKind := toEntTaskReminder_Kind(taskreminder.GetKind())
+	m.SetKind(taskreminderKind)
+	taskreminderOrgID := int(taskreminder.GetOrgId())
+	m.SetOrgID(taskreminderOrgID)
+	taskreminderSeq := int(taskreminder.GetSeq())
+	m.SetSeq(taskreminderSeq)
+	taskreminderTaskID := int(taskreminder.GetTaskId())
+	m.SetTaskID(taskreminderTaskID)
+	if taskreminder.GetTask() != nil {
+		taskreminderTask := int(taskreminder.GetTask().GetId())
+		m.SetTaskID(taskreminderTask)
+	}
+	return m, nil
+}
@@ -17,7 +17,9 @@ import (
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
 	strconv "strconv"
+	strings "strings"
 )
 
 // TaskReportService implements TaskReportServiceServer
@@ -33,6 +35,31 @@ func NewTaskReportService(client *ent.Client) *TaskReportService {
 	}
 }
 
+var protoIdentNormalizeRegexpTaskReport_Status = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeTaskReport_Status(e string) string {
+	return protoIdentNormalizeRegexpTaskReport_Status.ReplaceAllString(e, "_")
+}
+
+func toProtoTaskReport_Status(e taskreport.Status) TaskReport_Status {
+	if v, ok := TaskReport_Status_value[strings.ToUpper("STATUS_"+protoIdentNormalizeTaskReport_Status(string(e)))]; ok {
+		return TaskReport_Status(v)
+	}
+	return TaskReport_Status(0)
+}
+
+func toEntTaskReport_Status(e TaskReport_Status) taskreport.Status {
+	if v, ok := TaskReport_Status_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"STATUS_SUBMITTED":     "submitted",
+			"STATUS_ACCEPTED":      "accepted",
+			"STATUS_NEEDS_CHANGES": "needs_changes",
+		}[v]
+		return taskreport.Status(entVal)
+	}
+	return ""
+}
+
 // toProtoTaskReport transforms the ent type to the pb type
 func toProtoTaskReport(e *ent.TaskReport) (*TaskReport, error) {
 	v := &TaskReport{}
@@ -42,8 +69,24 @@ func toProtoTaskReport(e *ent.TaskReport) (*TaskReport, error) {
 	v.CreatedAt = created_at
 	id := int64(e.ID)
 	v.Id = id
+	if e.Progress != nil {
+		progress := wrapperspb.Int64(int64(*e.Progress))
+		v.Progress = progress
+	}
 	reporter := int64(e.ReporterID)
 	v.ReporterId = reporter
+	review_comment := wrapperspb.String(e.ReviewComment)
+	v.ReviewComment = review_comment
+	if e.ReviewedAt != nil {
+		reviewed_at := timestamppb.New(*e.ReviewedAt)
+		v.ReviewedAt = reviewed_at
+	}
+	if e.ReviewerID != nil {
+		reviewer := wrapperspb.Int64(int64(*e.ReviewerID))
+		v.ReviewerId = reviewer
+	}
+	status := toProtoTaskReport_Status(e.Status)
+	v.Status = status
 	task := int64(e.TaskID)
 	v.TaskId = task
 	updated_at := timestamppb.New(e.UpdatedAt)
@@ -54,6 +97,12 @@ func toProtoTaskReport(e *ent.TaskReport) (*TaskReport, error) {
 			Id: id,
 		}
 	}
+	if edg := e.Edges.Reviewer; edg != nil {
+		id := int64(edg.ID)
+		v.Reviewer = &Employee{
+			Id: id,
+		}
+	}
 	if edg := e.Edges.Task; edg != nil {
 		id := int64(edg.ID)
 		v.Task = &Task{
@@ -117,6 +166,9 @@ func (svc *TaskReportService) Get(ctx context.Context, req *GetTaskReportRequest
 			WithReporter(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithReviewer(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
 			WithTask(func(query *ent.TaskQuery) {
 				query.Select(task.FieldID)
 			}).
@@ -144,8 +196,26 @@ func (svc *TaskReportService) Update(ctx context.Context, req *UpdateTaskReportR
 		taskreportContent := taskreport.GetContent().GetValue()
 		m.SetContent(taskreportContent)
 	}
+	if taskreport.GetProgress() != nil {
+		taskreportProgress := int(taskreport.GetProgress().GetValue())
+		m.SetProgress(taskreportProgress)
+	}
 	taskreportReporterID := int(taskreport.GetReporterId())
 	m.SetReporterID(taskreportReporterID)
+	if taskreport.GetReviewComment() != nil {
+		taskreportReviewComment := taskreport.GetReviewComment().GetValue()
+		m.SetReviewComment(taskreportReviewComment)
+	}
+	if taskreport.GetReviewedAt() != nil {
+		taskreportReviewedAt := runtime.ExtractTime(taskreport.GetReviewedAt())
+		m.SetReviewedAt(taskreportReviewedAt)
+	}
+	if taskreport.GetReviewerId() != nil {
+		taskreportReviewerID := int(taskreport.GetReviewerId().GetValue())
+		m.SetReviewerID(taskreportReviewerID)
+	}
+	taskreportStatus := toEntTaskReport_Status(taskreport.GetStatus())
+	m.SetStatus(taskreportStatus)
 	taskreportTaskID := int(taskreport.GetTaskId())
 	m.SetTaskID(taskreportTaskID)
 	taskreportUpdatedAt := runtime.ExtractTime(taskreport.GetUpdatedAt())
@@ -154,6 +224,10 @@ func (svc *TaskReportService) Update(ctx context.Context, req *UpdateTaskReportR
 		taskreportReporter := int(taskreport.GetReporter().GetId())
 		m.SetReporterID(taskreportReporter)
 	}
+	if taskreport.GetReviewer() != nil {
+		taskreportReviewer := int(taskreport.GetReviewer().GetId())
+		m.SetReviewerID(taskreportReviewer)
+	}
 	if taskreport.GetTask() != nil {
 		taskreportTask := int(taskreport.GetTask().GetId())
 		m.SetTaskID(taskreportTask)
@@ -231,6 +305,9 @@ func (svc *TaskReportService) List(ctx context.Context, req *ListTaskReportReque
 			WithReporter(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithReviewer(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
 			WithTask(func(query *ent.TaskQuery) {
 				query.Select(task.FieldID)
 			}).
@@ -301,8 +378,26 @@ func (svc *TaskReportService) createBuilder(taskreport *TaskReport) (*ent.TaskRe
 	}
 	taskreportCreatedAt := runtime.ExtractTime(taskreport.GetCreatedAt())
 	m.SetCreatedAt(taskreportCreatedAt)
+	if taskreport.GetProgress() != nil {
+		taskreportProgress := int(taskreport.GetProgress().GetValue())
+		m.SetProgress(taskreportProgress)
+	}
 	taskreportReporterID := int(taskreport.GetReporterId())
 	m.SetReporterID(taskreportReporterID)
+	if taskreport.GetReviewComment() != nil {
+		taskreportReviewComment := taskreport.GetReviewComment().GetValue()
+		m.SetReviewComment(taskreportReviewComment)
+	}
+	if taskreport.GetReviewedAt() != nil {
+		taskreportReviewedAt := runtime.ExtractTime(taskreport.GetReviewedAt())
+		m.SetReviewedAt(taskreportReviewedAt)
+	}
+	if taskreport.GetReviewerId() != nil {
+		taskreportReviewerID := int(taskreport.GetReviewerId().GetValue())
+		m.SetReviewerID(taskreportReviewerID)
+	}
+	taskreportStatus := toEntTaskReport_Status(taskreport.GetStatus())
+	m.SetStatus(taskreportStatus)
 	taskreportTaskID := int(taskreport.GetTaskId())
 	m.SetTaskID(taskreportTaskID)
 	taskreportUpdatedAt := runtime.ExtractTime(taskreport.GetUpdatedAt())
@@ -311,6 +406,10 @@ func (svc *TaskReportService) createBuilder(taskreport *TaskReport) (*ent.TaskRe
 		taskreportReporter := int(taskreport.GetReporter().GetId())
 		m.SetReporterID(taskreportReporter)
 	}
+	if taskreport.GetReviewer() != nil {
+		taskreportReviewer := int(taskreport.GetReviewer().GetId())
+		m.SetReviewerID(taskreportReviewer)
+	}
 	if taskreport.GetTask() != nil {
 		taskreportTask := int(taskreport.GetTask().GetId())
 		m.SetTaskID(taskreportTask)
@@ -11,9 +11,18 @@ import (
 	ent "github.com/longgggwwww/hrm-ms-hr/ent"
 	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	label "github.com/longgggwwww/hrm-ms-hr/ent/label"
+	milestone "github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	overtimerequest "github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	sprint "github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	taskactivity "github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	taskcomment "github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	taskdependency "github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	taskreminder "github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
 	taskreport "github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	taskwatcher "github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+	worklog "github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -95,6 +104,10 @@ func toProtoTask(e *ent.Task) (*Task, error) {
 	v := &Task{}
 	code := e.Code
 	v.Code = code
+	if e.CompletedAt != nil {
+		completed_at := timestamppb.New(*e.CompletedAt)
+		v.CompletedAt = completed_at
+	}
 	created_at := timestamppb.New(e.CreatedAt)
 	v.CreatedAt = created_at
 	creator_id := int64(e.CreatorID)
@@ -107,12 +120,34 @@ func toProtoTask(e *ent.Task) (*Task, error) {
 	}
 	id := int64(e.ID)
 	v.Id = id
+	milestone := wrapperspb.Int64(int64(e.MilestoneID))
+	v.MilestoneId = milestone
 	name := e.Name
 	v.Name = name
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	if e.OriginalEstimate != nil {
+		original_estimate := wrapperspb.Int64(int64(*e.OriginalEstimate))
+		v.OriginalEstimate = original_estimate
+	}
+	parent := wrapperspb.Int64(int64(e.ParentID))
+	v.ParentId = parent
 	process := int64(e.Process)
 	v.Process = process
 	project := wrapperspb.Int64(int64(e.ProjectID))
 	v.ProjectId = project
+	rank := e.Rank
+	v.Rank = rank
+	if e.RecurrenceRunID != nil {
+		recurrence_run_id := wrapperspb.Int64(int64(*e.RecurrenceRunID))
+		v.RecurrenceRunId = recurrence_run_id
+	}
+	if e.RemainingEstimate != nil {
+		remaining_estimate := wrapperspb.Int64(int64(*e.RemainingEstimate))
+		v.RemainingEstimate = remaining_estimate
+	}
+	sprint := wrapperspb.Int64(int64(e.SprintID))
+	v.SprintId = sprint
 	if e.StartAt != nil {
 		start_at := timestamppb.New(*e.StartAt)
 		v.StartAt = start_at
@@ -125,30 +160,104 @@ func toProtoTask(e *ent.Task) (*Task, error) {
 	v.UpdatedAt = updated_at
 	updater_id := int64(e.UpdaterID)
 	v.UpdaterId = updater_id
+	workflow_status := e.WorkflowStatus
+	v.WorkflowStatus = workflow_status
+	for _, edg := range e.Edges.Activities {
+		id := int64(edg.ID)
+		v.Activities = append(v.Activities, &TaskActivity{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.Assignees {
 		id := int64(edg.ID)
 		v.Assignees = append(v.Assignees, &Employee{
 			Id: id,
 		})
 	}
+	for _, edg := range e.Edges.Children {
+		id := int64(edg.ID)
+		v.Children = append(v.Children, &Task{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Comments {
+		id := int64(edg.ID)
+		v.Comments = append(v.Comments, &TaskComment{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Dependencies {
+		id := int64(edg.ID)
+		v.Dependencies = append(v.Dependencies, &TaskDependency{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.Dependents {
+		id := int64(edg.ID)
+		v.Dependents = append(v.Dependents, &TaskDependency{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.Labels {
 		id := int64(edg.ID)
 		v.Labels = append(v.Labels, &Label{
 			Id: id,
 		})
 	}
+	if edg := e.Edges.Milestone; edg != nil {
+		id := int64(edg.ID)
+		v.Milestone = &Milestone{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.OvertimeRequests {
+		id := int64(edg.ID)
+		v.OvertimeRequests = append(v.OvertimeRequests, &OvertimeRequest{
+			Id: id,
+		})
+	}
+	if edg := e.Edges.Parent; edg != nil {
+		id := int64(edg.ID)
+		v.Parent = &Task{
+			Id: id,
+		}
+	}
 	if edg := e.Edges.Project; edg != nil {
 		id := int64(edg.ID)
 		v.Project = &Project{
 			Id: id,
 		}
 	}
+	for _, edg := range e.Edges.Reminders {
+		id := int64(edg.ID)
+		v.Reminders = append(v.Reminders, &TaskReminder{
+			Id: id,
+		})
+	}
 	for _, edg := range e.Edges.Reports {
 		id := int64(edg.ID)
 		v.Reports = append(v.Reports, &TaskReport{
 			Id: id,
 		})
 	}
+	if edg := e.Edges.Sprint; edg != nil {
+		id := int64(edg.ID)
+		v.Sprint = &Sprint{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.Watchers {
+		id := int64(edg.ID)
+		v.Watchers = append(v.Watchers, &TaskWatcher{
+			Id: id,
+		})
+	}
+	for _, edg := range e.Edges.WorkLogs {
+		id := int64(edg.ID)
+		v.WorkLogs = append(v.WorkLogs, &WorkLog{
+			Id: id,
+		})
+	}
 	return v, nil
 }
 
@@ -203,18 +312,54 @@ func (svc *TaskService) Get(ctx context.Context, req *GetTaskRequest) (*Task, er
 	case GetTaskRequest_WITH_EDGE_IDS:
 		get, err = svc.client.Task.Query().
 			Where(task.ID(id)).
+			WithActivities(func(query *ent.TaskActivityQuery) {
+				query.Select(taskactivity.FieldID)
+			}).
 			WithAssignees(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithChildren(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			WithComments(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			WithDependencies(func(query *ent.TaskDependencyQuery) {
+				query.Select(taskdependency.FieldID)
+			}).
+			WithDependents(func(query *ent.TaskDependencyQuery) {
+				query.Select(taskdependency.FieldID)
+			}).
 			WithLabels(func(query *ent.LabelQuery) {
 				query.Select(label.FieldID)
 			}).
+			WithMilestone(func(query *ent.MilestoneQuery) {
+				query.Select(milestone.FieldID)
+			}).
+			WithOvertimeRequests(func(query *ent.OvertimeRequestQuery) {
+				query.Select(overtimerequest.FieldID)
+			}).
+			WithParent(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
 			WithProject(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
+			WithReminders(func(query *ent.TaskReminderQuery) {
+				query.Select(taskreminder.FieldID)
+			}).
 			WithReports(func(query *ent.TaskReportQuery) {
 				query.Select(taskreport.FieldID)
 			}).
+			WithSprint(func(query *ent.SprintQuery) {
+				query.Select(sprint.FieldID)
+			}).
+			WithWatchers(func(query *ent.TaskWatcherQuery) {
+				query.Select(taskwatcher.FieldID)
+			}).
+			WithWorkLogs(func(query *ent.WorkLogQuery) {
+				query.Select(worklog.FieldID)
+			}).
 			Only(ctx)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
@@ -237,6 +382,10 @@ func (svc *TaskService) Update(ctx context.Context, req *UpdateTaskRequest) (*Ta
 	m := svc.client.Task.UpdateOneID(taskID)
 	taskCode := task.GetCode()
 	m.SetCode(taskCode)
+	if task.GetCompletedAt() != nil {
+		taskCompletedAt := runtime.ExtractTime(task.GetCompletedAt())
+		m.SetCompletedAt(taskCompletedAt)
+	}
 	taskCreatorID := int(task.GetCreatorId())
 	m.SetCreatorID(taskCreatorID)
 	if task.GetDescription() != nil {
@@ -247,14 +396,42 @@ func (svc *TaskService) Update(ctx context.Context, req *UpdateTaskRequest) (*Ta
 		taskDueDate := runtime.ExtractTime(task.GetDueDate())
 		m.SetDueDate(taskDueDate)
 	}
+	if task.GetMilestoneId() != nil {
+		taskMilestoneID := int(task.GetMilestoneId().GetValue())
+		m.SetMilestoneID(taskMilestoneID)
+	}
 	taskName := task.GetName()
 	m.SetName(taskName)
+	taskOrgID := int(task.GetOrgId())
+	m.SetOrgID(taskOrgID)
+	if task.GetOriginalEstimate() != nil {
+		taskOriginalEstimate := int(task.GetOriginalEstimate().GetValue())
+		m.SetOriginalEstimate(taskOriginalEstimate)
+	}
+	if task.GetParentId() != nil {
+		taskParentID := int(task.GetParentId().GetValue())
+		m.SetParentID(taskParentID)
+	}
 	taskProcess := int(task.GetProcess())
 	m.SetProcess(taskProcess)
 	if task.GetProjectId() != nil {
 		taskProjectID := int(task.GetProjectId().GetValue())
 		m.SetProjectID(taskProjectID)
 	}
+	taskRank := task.GetRank()
+	m.SetRank(taskRank)
+	if task.GetRecurrenceRunId() != nil {
+		taskRecurrenceRunID := int(task.GetRecurrenceRunId().GetValue())
+		m.SetRecurrenceRunID(taskRecurrenceRunID)
+	}
+	if task.GetRemainingEstimate() != nil {
+		taskRemainingEstimate := int(task.GetRemainingEstimate().GetValue())
+		m.SetRemainingEstimate(taskRemainingEstimate)
+	}
+	if task.GetSprintId() != nil {
+		taskSprintID := int(task.GetSprintId().GetValue())
+		m.SetSprintID(taskSprintID)
+	}
 	if task.GetStartAt() != nil {
 		taskStartAt := runtime.ExtractTime(task.GetStartAt())
 		m.SetStartAt(taskStartAt)
@@ -267,22 +444,72 @@ func (svc *TaskService) Update(ctx context.Context, req *UpdateTaskRequest) (*Ta
 	m.SetUpdatedAt(taskUpdatedAt)
 	taskUpdaterID := int(task.GetUpdaterId())
 	m.SetUpdaterID(taskUpdaterID)
+	taskWorkflowStatus := task.GetWorkflowStatus()
+	m.SetWorkflowStatus(taskWorkflowStatus)
+	for _, item := range task.GetActivities() {
+		activities := int(item.GetId())
+		m.AddActivityIDs(activities)
+	}
 	for _, item := range task.GetAssignees() {
 		assignees := int(item.GetId())
 		m.AddAssigneeIDs(assignees)
 	}
+	for _, item := range task.GetChildren() {
+		children := int(item.GetId())
+		m.AddChildIDs(children)
+	}
+	for _, item := range task.GetComments() {
+		comments := int(item.GetId())
+		m.AddCommentIDs(comments)
+	}
+	for _, item := range task.GetDependencies() {
+		dependencies := int(item.GetId())
+		m.AddDependencyIDs(dependencies)
+	}
+	for _, item := range task.GetDependents() {
+		dependents := int(item.GetId())
+		m.AddDependentIDs(dependents)
+	}
 	for _, item := range task.GetLabels() {
 		labels := int(item.GetId())
 		m.AddLabelIDs(labels)
 	}
+	if task.GetMilestone() != nil {
+		taskMilestone := int(task.GetMilestone().GetId())
+		m.SetMilestoneID(taskMilestone)
+	}
+	for _, item := range task.GetOvertimeRequests() {
+		overtimerequests := int(item.GetId())
+		m.AddOvertimeRequestIDs(overtimerequests)
+	}
+	if task.GetParent() != nil {
+		taskParent := int(task.GetParent().GetId())
+		m.SetParentID(taskParent)
+	}
 	if task.GetProject() != nil {
 		taskProject := int(task.GetProject().GetId())
 		m.SetProjectID(taskProject)
 	}
+	for _, item := range task.GetReminders() {
+		reminders := int(item.GetId())
+		m.AddReminderIDs(reminders)
+	}
 	for _, item := range task.GetReports() {
 		reports := int(item.GetId())
 		m.AddReportIDs(reports)
 	}
+	if task.GetSprint() != nil {
+		taskSprint := int(task.GetSprint().GetId())
+		m.SetSprintID(taskSprint)
+	}
+	for _, item := range task.GetWatchers() {
+		watchers := int(item.GetId())
+		m.AddWatcherIDs(watchers)
+	}
+	for _, item := range task.GetWorkLogs() {
+		worklogs := int(item.GetId())
+		m.AddWorkLogIDs(worklogs)
+	}
 
 	res, err := m.Save(ctx)
 	switch {
@@ -353,18 +580,54 @@ func (svc *TaskService) List(ctx context.Context, req *ListTaskRequest) (*ListTa
 		entList, err = listQuery.All(ctx)
 	case ListTaskRequest_WITH_EDGE_IDS:
 		entList, err = listQuery.
+			WithActivities(func(query *ent.TaskActivityQuery) {
+				query.Select(taskactivity.FieldID)
+			}).
 			WithAssignees(func(query *ent.EmployeeQuery) {
 				query.Select(employee.FieldID)
 			}).
+			WithChildren(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			WithComments(func(query *ent.TaskCommentQuery) {
+				query.Select(taskcomment.FieldID)
+			}).
+			WithDependencies(func(query *ent.TaskDependencyQuery) {
+				query.Select(taskdependency.FieldID)
+			}).
+			WithDependents(func(query *ent.TaskDependencyQuery) {
+				query.Select(taskdependency.FieldID)
+			}).
 			WithLabels(func(query *ent.LabelQuery) {
 				query.Select(label.FieldID)
 			}).
+			WithMilestone(func(query *ent.MilestoneQuery) {
+				query.Select(milestone.FieldID)
+			}).
+			WithOvertimeRequests(func(query *ent.OvertimeRequestQuery) {
+				query.Select(overtimerequest.FieldID)
+			}).
+			WithParent(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
 			WithProject(func(query *ent.ProjectQuery) {
 				query.Select(project.FieldID)
 			}).
+			WithReminders(func(query *ent.TaskReminderQuery) {
+				query.Select(taskreminder.FieldID)
+			}).
 			WithReports(func(query *ent.TaskReportQuery) {
 				query.Select(taskreport.FieldID)
 			}).
+			WithSprint(func(query *ent.SprintQuery) {
+				query.Select(sprint.FieldID)
+			}).
+			WithWatchers(func(query *ent.TaskWatcherQuery) {
+				query.Select(taskwatcher.FieldID)
+			}).
+			WithWorkLogs(func(query *ent.WorkLogQuery) {
+				query.Select(worklog.FieldID)
+			}).
 			All(ctx)
 	}
 	switch {
@@ -428,6 +691,10 @@ func (svc *TaskService) createBuilder(task *Task) (*ent.TaskCreate, error) {
 	m := svc.client.Task.Create()
 	taskCode := task.GetCode()
 	m.SetCode(taskCode)
+	if task.GetCompletedAt() != nil {
+		taskCompletedAt := runtime.ExtractTime(task.GetCompletedAt())
+		m.SetCompletedAt(taskCompletedAt)
+	}
 	taskCreatedAt := runtime.ExtractTime(task.GetCreatedAt())
 	m.SetCreatedAt(taskCreatedAt)
 	taskCreatorID := int(task.GetCreatorId())
@@ -440,14 +707,42 @@ func (svc *TaskService) createBuilder(task *Task) (*ent.TaskCreate, error) {
 		taskDueDate := runtime.ExtractTime(task.GetDueDate())
 		m.SetDueDate(taskDueDate)
 	}
+	if task.GetMilestoneId() != nil {
+		taskMilestoneID := int(task.GetMilestoneId().GetValue())
+		m.SetMilestoneID(taskMilestoneID)
+	}
 	taskName := task.GetName()
 	m.SetName(taskName)
+	taskOrgID := int(task.GetOrgId())
+	m.SetOrgID(taskOrgID)
+	if task.GetOriginalEstimate() != nil {
+		taskOriginalEstimate := int(task.GetOriginalEstimate().GetValue())
+		m.SetOriginalEstimate(taskOriginalEstimate)
+	}
+	if task.GetParentId() != nil {
+		taskParentID := int(task.GetParentId().GetValue())
+		m.SetParentID(taskParentID)
+	}
 	taskProcess := int(task.GetProcess())
 	m.SetProcess(taskProcess)
 	if task.GetProjectId() != nil {
 		taskProjectID := int(task.GetProjectId().GetValue())
 		m.SetProjectID(taskProjectID)
 	}
+	taskRank := task.GetRank()
+	m.SetRank(taskRank)
+	if task.GetRecurrenceRunId() != nil {
+		taskRecurrenceRunID := int(task.GetRecurrenceRunId().GetValue())
+		m.SetRecurrenceRunID(taskRecurrenceRunID)
+	}
+	if task.GetRemainingEstimate() != nil {
+		taskRemainingEstimate := int(task.GetRemainingEstimate().GetValue())
+		m.SetRemainingEstimate(taskRemainingEstimate)
+	}
+	if task.GetSprintId() != nil {
+		taskSprintID := int(task.GetSprintId().GetValue())
+		m.SetSprintID(taskSprintID)
+	}
 	if task.GetStartAt() != nil {
 		taskStartAt := runtime.ExtractTime(task.GetStartAt())
 		m.SetStartAt(taskStartAt)
@@ -460,21 +755,71 @@ func (svc *TaskService) createBuilder(task *Task) (*ent.TaskCreate, error) {
 	m.SetUpdatedAt(taskUpdatedAt)
 	taskUpdaterID := int(task.GetUpdaterId())
 	m.SetUpdaterID(taskUpdaterID)
+	taskWorkflowStatus := task.GetWorkflowStatus()
+	m.SetWorkflowStatus(taskWorkflowStatus)
+	for _, item := range task.GetActivities() {
+		activities := int(item.GetId())
+		m.AddActivityIDs(activities)
+	}
 	for _, item := range task.GetAssignees() {
 		assignees := int(item.GetId())
 		m.AddAssigneeIDs(assignees)
 	}
+	for _, item := range task.GetChildren() {
+		children := int(item.GetId())
+		m.AddChildIDs(children)
+	}
+	for _, item := range task.GetComments() {
+		comments := int(item.GetId())
+		m.AddCommentIDs(comments)
+	}
+	for _, item := range task.GetDependencies() {
+		dependencies := int(item.GetId())
+		m.AddDependencyIDs(dependencies)
+	}
+	for _, item := range task.GetDependents() {
+		dependents := int(item.GetId())
+		m.AddDependentIDs(dependents)
+	}
 	for _, item := range task.GetLabels() {
 		labels := int(item.GetId())
 		m.AddLabelIDs(labels)
 	}
+	if task.GetMilestone() != nil {
+		taskMilestone := int(task.GetMilestone().GetId())
+		m.SetMilestoneID(taskMilestone)
+	}
+	for _, item := range task.GetOvertimeRequests() {
+		overtimerequests := int(item.GetId())
+		m.AddOvertimeRequestIDs(overtimerequests)
+	}
+	if task.GetParent() != nil {
+		taskParent := int(task.GetParent().GetId())
+		m.SetParentID(taskParent)
+	}
 	if task.GetProject() != nil {
 		taskProject := int(task.GetProject().GetId())
 		m.SetProjectID(taskProject)
 	}
+	for _, item := range task.GetReminders() {
+		reminders := int(item.GetId())
+		m.AddReminderIDs(reminders)
+	}
 	for _, item := range task.GetReports() {
 		reports := int(item.GetId())
 		m.AddReportIDs(reports)
 	}
+	if task.GetSprint() != nil {
+		taskSprint := int(task.GetSprint().GetId())
+		m.SetSprintID(taskSprint)
+	}
+	for _, item := range task.GetWatchers() {
+		watchers := int(item.GetId())
+		m.AddWatcherIDs(watchers)
+	}
+	for _, item := range task.GetWorkLogs() {
+		worklogs := int(item.GetId())
+		m.AddWorkLogIDs(worklogs)
+	}
 	return m, nil
 }
@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	taskrecurrence "github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	tasktemplate "github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// TaskTemplateService implements TaskTemplateServiceServer
+type TaskTemplateService struct {
+	client *ent.Client
+	UnimplementedTaskTemplateServiceServer
+}
+
+// NewTaskTemplateService returns a new TaskTemplateService
+func NewTaskTemplateService(client *ent.Client) *TaskTemplateService {
+	return &TaskTemplateService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpTaskTemplate_Type = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeTaskTemplate_Type(e string) string {
+	return protoIdentNormalizeRegexpTaskTemplate_Type.ReplaceAllString(e, "_")
+}
+
+func toProtoTaskTemplate_Type(e tasktemplate.Type) TaskTemplate_Type {
+	if v, ok := TaskTemplate_Type_value[strings.ToUpper("TYPE_"+protoIdentNormalizeTaskTemplate_Type(string(e)))]; ok {
+		return TaskTemplate_Type(v)
+	}
+	return TaskTemplate_Type(0)
+}
+
+func toEntTaskTemplate_Type(e TaskTemplate_Type) tasktemplate.Type {
+	if v, ok := TaskTemplate_Type_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"TYPE_TASK":    "task",
+			"TYPE_FEATURE": "feature",
+			"TYPE_BUG":     "bug",
+			"TYPE_ANOTHER": "another",
+		}[v]
+		return tasktemplate.Type(entVal)
+	}
+	return ""
+}
+
+// toProtoTaskTemplate transforms the ent type to the pb type
+func toProtoTaskTemplate(e *ent.TaskTemplate) (*TaskTemplate, error) {
+	v := &TaskTemplate{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	creator_id := int64(e.CreatorID)
+	v.CreatorId = creator_id
+	description := wrapperspb.String(e.Description)
+	v.Description = description
+	if e.DueOffset != nil {
+		due_offset := wrapperspb.Int64(int64(*e.DueOffset))
+		v.DueOffset = due_offset
+	}
+	id := int64(e.ID)
+	v.Id = id
+	name := e.Name
+	v.Name = name
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	if e.OriginalEstimate != nil {
+		original_estimate := wrapperspb.Int64(int64(*e.OriginalEstimate))
+		v.OriginalEstimate = original_estimate
+	}
+	project := wrapperspb.Int64(int64(e.ProjectID))
+	v.ProjectId = project
+	if e.StartOffset != nil {
+		start_offset := wrapperspb.Int64(int64(*e.StartOffset))
+		v.StartOffset = start_offset
+	}
+	_type := toProtoTaskTemplate_Type(e.Type)
+	v.Type = _type
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Project; edg != nil {
+		id := int64(edg.ID)
+		v.Project = &Project{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.Recurrences {
+		id := int64(edg.ID)
+		v.Recurrences = append(v.Recurrences, &TaskRecurrence{
+			Id: id,
+		})
+	}
+	return v, nil
+}
+
+// toProtoTaskTemplateList transforms a list of ent type to a list of pb type
+func toProtoTaskTemplateList(e []*ent.TaskTemplate) ([]*TaskTemplate, error) {
+	var pbList []*TaskTemplate
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskTemplate(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskTemplateServiceServer.Create
+func (svc *TaskTemplateService) Create(ctx context.Context, req *CreateTaskTemplateRequest) (*TaskTemplate, error) {
+	tasktemplate := req.GetTaskTemplate()
+	m, err := svc.createBuilder(tasktemplate)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskTemplate(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskTemplateServiceServer.Get
+func (svc *TaskTemplateService) Get(ctx context.Context, req *GetTaskTemplateRequest) (*TaskTemplate, error) {
+	var (
+		err error
+		get *ent.TaskTemplate
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskTemplateRequest_VIEW_UNSPECIFIED, GetTaskTemplateRequest_BASIC:
+		get, err = svc.client.TaskTemplate.Get(ctx, id)
+	case GetTaskTemplateRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskTemplate.Query().
+			Where(tasktemplate.ID(id)).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			WithRecurrences(func(query *ent.TaskRecurrenceQuery) {
+				query.Select(taskrecurrence.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskTemplate(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskTemplateServiceServer.Update
+func (svc *TaskTemplateService) Update(ctx context.Context, req *UpdateTaskTemplateRequest) (*TaskTemplate, error) {
+	tasktemplate := req.GetTaskTemplate()
+	tasktemplateID := int(tasktemplate.GetId())
+	m := svc.client.TaskTemplate.UpdateOneID(tasktemplateID)
+	tasktemplateCreatorID := int(tasktemplate.GetCreatorId())
+	m.SetCreatorID(tasktemplateCreatorID)
+	if tasktemplate.GetDescription() != nil {
+		tasktemplateDescription := tasktemplate.GetDescription().GetValue()
+		m.SetDescription(tasktemplateDescription)
+	}
+	if tasktemplate.GetDueOffset() != nil {
+		tasktemplateDueOffset := int(tasktemplate.GetDueOffset().GetValue())
+		m.SetDueOffset(tasktemplateDueOffset)
+	}
+	tasktemplateName := tasktemplate.GetName()
+	m.SetName(tasktemplateName)
+	tasktemplateOrgID := int(tasktemplate.GetOrgId())
+	m.SetOrgID(tasktemplateOrgID)
+	if tasktemplate.GetOriginalEstimate() != nil {
+		tasktemplateOriginalEstimate := int(tasktemplate.GetOriginalEstimate().GetValue())
+		m.SetOriginalEstimate(tasktemplateOriginalEstimate)
+	}
+	if tasktemplate.GetProjectId() != nil {
+		tasktemplateProjectID := int(tasktemplate.GetProjectId().GetValue())
+		m.SetProjectID(tasktemplateProjectID)
+	}
+	if tasktemplate.GetStartOffset() != nil {
+		tasktemplateStartOffset := int(tasktemplate.GetStartOffset().GetValue())
+		m.SetStartOffset(tasktemplateStartOffset)
+	}
+	tasktemplateType := toEntTaskTemplate_Type(tasktemplate.GetType())
+	m.SetType(tasktemplateType)
+	tasktemplateUpdatedAt := runtime.ExtractTime(tasktemplate.GetUpdatedAt())
+	m.SetUpdatedAt(tasktemplateUpdatedAt)
+	if tasktemplate.GetProject() != nil {
+		tasktemplateProject := int(tasktemplate.GetProject().GetId())
+		m.SetProjectID(tasktemplateProject)
+	}
+	for _, item := range tasktemplate.GetRecurrences() {
+		recurrences := int(item.GetId())
+		m.AddRecurrenceIDs(recurrences)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskTemplate(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskTemplateServiceServer.Delete
+func (svc *TaskTemplateService) Delete(ctx context.Context, req *DeleteTaskTemplateRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskTemplate.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskTemplateServiceServer.List
+func (svc *TaskTemplateService) List(ctx context.Context, req *ListTaskTemplateRequest) (*ListTaskTemplateResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskTemplate
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskTemplate.Query().
+		Order(ent.Desc(tasktemplate.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(tasktemplate.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskTemplateRequest_VIEW_UNSPECIFIED, ListTaskTemplateRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskTemplateRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			WithRecurrences(func(query *ent.TaskRecurrenceQuery) {
+				query.Select(taskrecurrence.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskTemplateList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskTemplateResponse{
+			TaskTemplateList: protoList,
+			NextPageToken:    nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskTemplateServiceServer.BatchCreate
+func (svc *TaskTemplateService) BatchCreate(ctx context.Context, req *BatchCreateTaskTemplatesRequest) (*BatchCreateTaskTemplatesResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskTemplateCreate, len(requests))
+	for i, req := range requests {
+		tasktemplate := req.GetTaskTemplate()
+		var err error
+		bulk[i], err = svc.createBuilder(tasktemplate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskTemplate.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskTemplateList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskTemplatesResponse{
+			TaskTemplates: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskTemplateService) createBuilder(tasktemplate *TaskTemplate) (*ent.TaskTemplateCreate, error) {
+	m := svc.client.TaskTemplate.Create()
+	tasktemplateCreatedAt := runtime.ExtractTime(tasktemplate.GetCreatedAt())
+	m.SetCreatedAt(tasktemplateCreatedAt)
+	tasktemplateCreatorID := int(tasktemplate.GetCreatorId())
+	m.SetCreatorID(tasktemplateCreatorID)
+	if tasktemplate.GetDescription() != nil {
+		tasktemplateDescription := tasktemplate.GetDescription().GetValue()
+		m.SetDescription(tasktemplateDescription)
+	}
+	if tasktemplate.GetDueOffset() != nil {
+		tasktemplateDueOffset := int(tasktemplate.GetDueOffset().GetValue())
+		m.SetDueOffset(tasktemplateDueOffset)
+	}
+	tasktemplateName := tasktemplate.GetName()
+	m.SetName(tasktemplateName)
+	tasktemplateOrgID := int(tasktemplate.GetOrgId())
+	m.SetOrgID(tasktemplateOrgID)
+	if tasktemplate.GetOriginalEstimate() != nil {
+		tasktemplateOriginalEstimate := int(tasktemplate.GetOriginalEstimate().GetValue())
+		m.SetOriginalEstimate(tasktemplateOriginalEstimate)
+	}
+	if tasktemplate.GetProjectId() != nil {
+		tasktemplateProjectID := int(tasktemplate.GetProjectId().GetValue())
+		m.SetProjectID(tasktemplateProjectID)
+	}
+	if tasktemplate.GetStartOffset() != nil {
+		tasktemplateStartOffset := int(tasktemplate.GetStartOffset().GetValue())
+		m.SetStartOffset(tasktemplateStartOffset)
+	}
+	tasktemplateType := toEntTaskTemplate_Type(tasktemplate.GetType())
+	m.SetType(tasktemplateType)
+	tasktemplateUpdatedAt := runtime.ExtractTime(tasktemplate.GetUpdatedAt())
+	m.SetUpdatedAt(tasktemplateUpdatedAt)
+	if tasktemplate.GetProject() != nil {
+		tasktemplateProject := int(tasktemplate.GetProject().GetId())
+		m.SetProjectID(tasktemplateProject)
+	}
+	for _, item := range tasktemplate.GetRecurrences() {
+		recurrences := int(item.GetId())
+		m.AddRecurrenceIDs(recurrences)
+	}
+	return m, nil
+}
@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	taskview "github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// TaskViewService implements TaskViewServiceServer
+type TaskViewService struct {
+	client *ent.Client
+	UnimplementedTaskViewServiceServer
+}
+
+// NewTaskViewService returns a new TaskViewService
+func NewTaskViewService(client *ent.Client) *TaskViewService {
+	return &TaskViewService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpTaskView_Visibility = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeTaskView_Visibility(e string) string {
+	return protoIdentNormalizeRegexpTaskView_Visibility.ReplaceAllString(e, "_")
+}
+
+func toProtoTaskView_Visibility(e taskview.Visibility) TaskView_Visibility {
+	if v, ok := TaskView_Visibility_value[strings.ToUpper("VISIBILITY_"+protoIdentNormalizeTaskView_Visibility(string(e)))]; ok {
+		return TaskView_Visibility(v)
+	}
+	return TaskView_Visibility(0)
+}
+
+func toEntTaskView_Visibility(e TaskView_Visibility) taskview.Visibility {
+	if v, ok := TaskView_Visibility_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"VISIBILITY_PRIVATE":      "private",
+			"VISIBILITY_PROJECT":      "project",
+			"VISIBILITY_ORGANIZATION": "organization",
+		}[v]
+		return taskview.Visibility(entVal)
+	}
+	return ""
+}
+
+// toProtoTaskView transforms the ent type to the pb type
+func toProtoTaskView(e *ent.TaskView) (*TaskView, error) {
+	v := &TaskView{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	employee_id := int64(e.EmployeeID)
+	v.EmployeeId = employee_id
+	id := int64(e.ID)
+	v.Id = id
+	name := e.Name
+	v.Name = name
+	org_id := int64(e.OrgID)
+	v.OrgId = org_id
+	project := wrapperspb.Int64(int64(e.ProjectID))
+	v.ProjectId = project
+	timezone := e.Timezone
+	v.Timezone = timezone
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	visibility := toProtoTaskView_Visibility(e.Visibility)
+	v.Visibility = visibility
+	if edg := e.Edges.Project; edg != nil {
+		id := int64(edg.ID)
+		v.Project = &Project{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskViewList transforms a list of ent type to a list of pb type
+func toProtoTaskViewList(e []*ent.TaskView) ([]*TaskView, error) {
+	var pbList []*TaskView
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskView(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskViewServiceServer.Create
+func (svc *TaskViewService) Create(ctx context.Context, req *CreateTaskViewRequest) (*TaskView, error) {
+	taskview := req.GetTaskView()
+	m, err := svc.createBuilder(taskview)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskView(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskViewServiceServer.Get
+func (svc *TaskViewService) Get(ctx context.Context, req *GetTaskViewRequest) (*TaskView, error) {
+	var (
+		err error
+		get *ent.TaskView
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskViewRequest_VIEW_UNSPECIFIED, GetTaskViewRequest_BASIC:
+		get, err = svc.client.TaskView.Get(ctx, id)
+	case GetTaskViewRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskView.Query().
+			Where(taskview.ID(id)).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskView(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskViewServiceServer.Update
+func (svc *TaskViewService) Update(ctx context.Context, req *UpdateTaskViewRequest) (*TaskView, error) {
+	taskview := req.GetTaskView()
+	taskviewID := int(taskview.GetId())
+	m := svc.client.TaskView.UpdateOneID(taskviewID)
+	taskviewEmployeeID := int(taskview.GetEmployeeId())
+	m.SetEmployeeID(taskviewEmployeeID)
+	taskviewName := taskview.GetName()
+	m.SetName(taskviewName)
+	taskviewOrgID := int(taskview.GetOrgId())
+	m.SetOrgID(taskviewOrgID)
+	if taskview.GetProjectId() != nil {
+		taskviewProjectID := int(taskview.GetProjectId().GetValue())
+		m.SetProjectID(taskviewProjectID)
+	}
+	taskviewTimezone := taskview.GetTimezone()
+	m.SetTimezone(taskviewTimezone)
+	taskviewUpdatedAt := runtime.ExtractTime(taskview.GetUpdatedAt())
+	m.SetUpdatedAt(taskviewUpdatedAt)
+	taskviewVisibility := toEntTaskView_Visibility(taskview.GetVisibility())
+	m.SetVisibility(taskviewVisibility)
+	if taskview.GetProject() != nil {
+		taskviewProject := int(taskview.GetProject().GetId())
+		m.SetProjectID(taskviewProject)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskView(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskViewServiceServer.Delete
+func (svc *TaskViewService) Delete(ctx context.Context, req *DeleteTaskViewRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskView.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskViewServiceServer.List
+func (svc *TaskViewService) List(ctx context.Context, req *ListTaskViewRequest) (*ListTaskViewResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskView
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskView.Query().
+		Order(ent.Desc(taskview.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskview.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskViewRequest_VIEW_UNSPECIFIED, ListTaskViewRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskViewRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskViewList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskViewResponse{
+			TaskViewList:  protoList,
+			NextPageToken: nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskViewServiceServer.BatchCreate
+func (svc *TaskViewService) BatchCreate(ctx context.Context, req *BatchCreateTaskViewsRequest) (*BatchCreateTaskViewsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskViewCreate, len(requests))
+	for i, req := range requests {
+		taskview := req.GetTaskView()
+		var err error
+		bulk[i], err = svc.createBuilder(taskview)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskView.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskViewList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskViewsResponse{
+			TaskViews: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskViewService) createBuilder(taskview *TaskView) (*ent.TaskViewCreate, error) {
+	m := svc.client.TaskView.Create()
+	taskviewCreatedAt := runtime.ExtractTime(taskview.GetCreatedAt())
+	m.SetCreatedAt(taskviewCreatedAt)
+	taskviewEmployeeID := int(taskview.GetEmployeeId())
+	m.SetEmployeeID(taskviewEmployeeID)
+	taskviewName := taskview.GetName()
+	m.SetName(taskviewName)
+	taskviewOrgID := int(taskview.GetOrgId())
+	m.SetOrgID(taskviewOrgID)
+	if taskview.GetProjectId() != nil {
+		taskviewProjectID := int(taskview.GetProjectId().GetValue())
+		m.SetProjectID(taskviewProjectID)
+	}
+	taskviewTimezone := taskview.GetTimezone()
+	m.SetTimezone(taskviewTimezone)
+	taskviewUpdatedAt := runtime.ExtractTime(taskview.GetUpdatedAt())
+	m.SetUpdatedAt(taskviewUpdatedAt)
+	taskviewVisibility := toEntTaskView_Visibility(taskview.GetVisibility())
+	m.SetVisibility(taskviewVisibility)
+	if taskview.GetProject() != nil {
+		taskviewProject := int(taskview.GetProject().GetId())
+		m.SetProjectID(taskviewProject)
+	}
+	return m, nil
+}
@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	taskwatcher "github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	strconv "strconv"
+)
+
+// TaskWatcherService implements TaskWatcherServiceServer
+type TaskWatcherService struct {
+	client *ent.Client
+	UnimplementedTaskWatcherServiceServer
+}
+
+// NewTaskWatcherService returns a new TaskWatcherService
+func NewTaskWatcherService(client *ent.Client) *TaskWatcherService {
+	return &TaskWatcherService{
+		client: client,
+	}
+}
+
+// toProtoTaskWatcher transforms the ent type to the pb type
+func toProtoTaskWatcher(e *ent.TaskWatcher) (*TaskWatcher, error) {
+	v := &TaskWatcher{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	employee_id := int64(e.EmployeeID)
+	v.EmployeeId = employee_id
+	id := int64(e.ID)
+	v.Id = id
+	task := int64(e.TaskID)
+	v.TaskId = task
+	if edg := e.Edges.Task; edg != nil {
+		id := int64(edg.ID)
+		v.Task = &Task{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTaskWatcherList transforms a list of ent type to a list of pb type
+func toProtoTaskWatcherList(e []*ent.TaskWatcher) ([]*TaskWatcher, error) {
+	var pbList []*TaskWatcher
+	for _, entEntity := range e {
+		pbEntity, err := toProtoTaskWatcher(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements TaskWatcherServiceServer.Create
+func (svc *TaskWatcherService) Create(ctx context.Context, req *CreateTaskWatcherRequest) (*TaskWatcher, error) {
+	taskwatcher := req.GetTaskWatcher()
+	m, err := svc.createBuilder(taskwatcher)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskWatcher(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TaskWatcherServiceServer.Get
+func (svc *TaskWatcherService) Get(ctx context.Context, req *GetTaskWatcherRequest) (*TaskWatcher, error) {
+	var (
+		err error
+		get *ent.TaskWatcher
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTaskWatcherRequest_VIEW_UNSPECIFIED, GetTaskWatcherRequest_BASIC:
+		get, err = svc.client.TaskWatcher.Get(ctx, id)
+	case GetTaskWatcherRequest_WITH_EDGE_IDS:
+		get, err = svc.client.TaskWatcher.Query().
+			Where(taskwatcher.ID(id)).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoTaskWatcher(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TaskWatcherServiceServer.Update
+func (svc *TaskWatcherService) Update(ctx context.Context, req *UpdateTaskWatcherRequest) (*TaskWatcher, error) {
+	taskwatcher := req.GetTaskWatcher()
+	taskwatcherID := int(taskwatcher.GetId())
+	m := svc.client.TaskWatcher.UpdateOneID(taskwatcherID)
+	taskwatcherEmployeeID := int(taskwatcher.GetEmployeeId())
+	m.SetEmployeeID(taskwatcherEmployeeID)
+	taskwatcherTaskID := int(taskwatcher.GetTaskId())
+	m.SetTaskID(taskwatcherTaskID)
+	if taskwatcher.GetTask() != nil {
+		taskwatcherTask := int(taskwatcher.GetTask().GetId())
+		m.SetTaskID(taskwatcherTask)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTaskWatcher(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TaskWatcherServiceServer.Delete
+func (svc *TaskWatcherService) Delete(ctx context.Context, req *DeleteTaskWatcherRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.TaskWatcher.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TaskWatcherServiceServer.List
+func (svc *TaskWatcherService) List(ctx context.Context, req *ListTaskWatcherRequest) (*ListTaskWatcherResponse, error) {
+	var (
+		err      error
+		entList  []*ent.TaskWatcher
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.TaskWatcher.Query().
+		Order(ent.Desc(taskwatcher.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(taskwatcher.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListTaskWatcherRequest_VIEW_UNSPECIFIED, ListTaskWatcherRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListTaskWatcherRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoTaskWatcherList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListTaskWatcherResponse{
+			TaskWatcherList: protoList,
+			NextPageToken:   nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements TaskWatcherServiceServer.BatchCreate
+func (svc *TaskWatcherService) BatchCreate(ctx context.Context, req *BatchCreateTaskWatchersRequest) (*BatchCreateTaskWatchersResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.TaskWatcherCreate, len(requests))
+	for i, req := range requests {
+		taskwatcher := req.GetTaskWatcher()
+		var err error
+		bulk[i], err = svc.createBuilder(taskwatcher)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.TaskWatcher.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoTaskWatcherList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateTaskWatchersResponse{
+			TaskWatchers: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *TaskWatcherService) createBuilder(taskwatcher *TaskWatcher) (*ent.TaskWatcherCreate, error) {
+	m := svc.client.TaskWatcher.Create()
+	taskwatcherCreatedAt := runtime.ExtractTime(taskwatcher.GetCreatedAt())
+	m.SetCreatedAt(taskwatcherCreatedAt)
+	taskwatcherEmployeeID := int(taskwatcher.GetEmployeeId())
+	m.SetEmployeeID(taskwatcherEmployeeID)
+	taskwatcherTaskID := int(taskwatcher.GetTaskId())
+	m.SetTaskID(taskwatcherTaskID)
+	if taskwatcher.GetTask() != nil {
+		taskwatcherTask := int(taskwatcher.GetTask().GetId())
+		m.SetTaskID(taskwatcherTask)
+	}
+	return m, nil
+}
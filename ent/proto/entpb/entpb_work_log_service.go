@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	employee "github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	task "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	worklog "github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	strconv "strconv"
+)
+
+// WorkLogService implements WorkLogServiceServer
+type WorkLogService struct {
+	client *ent.Client
+	UnimplementedWorkLogServiceServer
+}
+
+// NewWorkLogService returns a new WorkLogService
+func NewWorkLogService(client *ent.Client) *WorkLogService {
+	return &WorkLogService{
+		client: client,
+	}
+}
+
+// toProtoWorkLog transforms the ent type to the pb type
+func toProtoWorkLog(e *ent.WorkLog) (*WorkLog, error) {
+	v := &WorkLog{}
+	created_at := timestamppb.New(e.CreatedAt)
+	v.CreatedAt = created_at
+	duration := int64(e.Duration)
+	v.Duration = duration
+	employee := int64(e.EmployeeID)
+	v.EmployeeId = employee
+	if e.EndedAt != nil {
+		ended_at := timestamppb.New(*e.EndedAt)
+		v.EndedAt = ended_at
+	}
+	id := int64(e.ID)
+	v.Id = id
+	note := wrapperspb.String(e.Note)
+	v.Note = note
+	started_at := timestamppb.New(e.StartedAt)
+	v.StartedAt = started_at
+	task := int64(e.TaskID)
+	v.TaskId = task
+	updated_at := timestamppb.New(e.UpdatedAt)
+	v.UpdatedAt = updated_at
+	if edg := e.Edges.Employee; edg != nil {
+		id := int64(edg.ID)
+		v.Employee = &Employee{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Task; edg != nil {
+		id := int64(edg.ID)
+		v.Task = &Task{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoWorkLogList transforms a list of ent type to a list of pb type
+func toProtoWorkLogList(e []*ent.WorkLog) ([]*WorkLog, error) {
+	var pbList []*WorkLog
+	for _, entEntity := range e {
+		pbEntity, err := toProtoWorkLog(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements WorkLogServiceServer.Create
+func (svc *WorkLogService) Create(ctx context.Context, req *CreateWorkLogRequest) (*WorkLog, error) {
+	worklog := req.GetWorkLog()
+	m, err := svc.createBuilder(worklog)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoWorkLog(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements WorkLogServiceServer.Get
+func (svc *WorkLogService) Get(ctx context.Context, req *GetWorkLogRequest) (*WorkLog, error) {
+	var (
+		err error
+		get *ent.WorkLog
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetWorkLogRequest_VIEW_UNSPECIFIED, GetWorkLogRequest_BASIC:
+		get, err = svc.client.WorkLog.Get(ctx, id)
+	case GetWorkLogRequest_WITH_EDGE_IDS:
+		get, err = svc.client.WorkLog.Query().
+			Where(worklog.ID(id)).
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoWorkLog(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements WorkLogServiceServer.Update
+func (svc *WorkLogService) Update(ctx context.Context, req *UpdateWorkLogRequest) (*WorkLog, error) {
+	worklog := req.GetWorkLog()
+	worklogID := int(worklog.GetId())
+	m := svc.client.WorkLog.UpdateOneID(worklogID)
+	worklogDuration := int(worklog.GetDuration())
+	m.SetDuration(worklogDuration)
+	worklogEmployeeID := int(worklog.GetEmployeeId())
+	m.SetEmployeeID(worklogEmployeeID)
+	if worklog.GetEndedAt() != nil {
+		worklogEndedAt := runtime.ExtractTime(worklog.GetEndedAt())
+		m.SetEndedAt(worklogEndedAt)
+	}
+	if worklog.GetNote() != nil {
+		worklogNote := worklog.GetNote().GetValue()
+		m.SetNote(worklogNote)
+	}
+	worklogStartedAt := runtime.ExtractTime(worklog.GetStartedAt())
+	m.SetStartedAt(worklogStartedAt)
+	worklogTaskID := int(worklog.GetTaskId())
+	m.SetTaskID(worklogTaskID)
+	worklogUpdatedAt := runtime.ExtractTime(worklog.GetUpdatedAt())
+	m.SetUpdatedAt(worklogUpdatedAt)
+	if worklog.GetEmployee() != nil {
+		worklogEmployee := int(worklog.GetEmployee().GetId())
+		m.SetEmployeeID(worklogEmployee)
+	}
+	if worklog.GetTask() != nil {
+		worklogTask := int(worklog.GetTask().GetId())
+		m.SetTaskID(worklogTask)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoWorkLog(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements WorkLogServiceServer.Delete
+func (svc *WorkLogService) Delete(ctx context.Context, req *DeleteWorkLogRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.WorkLog.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements WorkLogServiceServer.List
+func (svc *WorkLogService) List(ctx context.Context, req *ListWorkLogRequest) (*ListWorkLogResponse, error) {
+	var (
+		err      error
+		entList  []*ent.WorkLog
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.WorkLog.Query().
+		Order(ent.Desc(worklog.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(worklog.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListWorkLogRequest_VIEW_UNSPECIFIED, ListWorkLogRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListWorkLogRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithEmployee(func(query *ent.EmployeeQuery) {
+				query.Select(employee.FieldID)
+			}).
+			WithTask(func(query *ent.TaskQuery) {
+				query.Select(task.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoWorkLogList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListWorkLogResponse{
+			WorkLogList:   protoList,
+			NextPageToken: nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements WorkLogServiceServer.BatchCreate
+func (svc *WorkLogService) BatchCreate(ctx context.Context, req *BatchCreateWorkLogsRequest) (*BatchCreateWorkLogsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.WorkLogCreate, len(requests))
+	for i, req := range requests {
+		worklog := req.GetWorkLog()
+		var err error
+		bulk[i], err = svc.createBuilder(worklog)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.WorkLog.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoWorkLogList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateWorkLogsResponse{
+			WorkLogs: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *WorkLogService) createBuilder(worklog *WorkLog) (*ent.WorkLogCreate, error) {
+	m := svc.client.WorkLog.Create()
+	worklogCreatedAt := runtime.ExtractTime(worklog.GetCreatedAt())
+	m.SetCreatedAt(worklogCreatedAt)
+	worklogDuration := int(worklog.GetDuration())
+	m.SetDuration(worklogDuration)
+	worklogEmployeeID := int(worklog.GetEmployeeId())
+	m.SetEmployeeID(worklogEmployeeID)
+	if worklog.GetEndedAt() != nil {
+		worklogEndedAt := runtime.ExtractTime(worklog.GetEndedAt())
+		m.SetEndedAt(worklogEndedAt)
+	}
+	if worklog.GetNote() != nil {
+		worklogNote := worklog.GetNote().GetValue()
+		m.SetNote(worklogNote)
+	}
+	worklogStartedAt := runtime.ExtractTime(worklog.GetStartedAt())
+	m.SetStartedAt(worklogStartedAt)
+	worklogTaskID := int(worklog.GetTaskId())
+	m.SetTaskID(worklogTaskID)
+	worklogUpdatedAt := runtime.ExtractTime(worklog.GetUpdatedAt())
+	m.SetUpdatedAt(worklogUpdatedAt)
+	if worklog.GetEmployee() != nil {
+		worklogEmployee := int(worklog.GetEmployee().GetId())
+		m.SetEmployeeID(worklogEmployee)
+	}
+	if worklog.GetTask() != nil {
+		worklogTask := int(worklog.GetTask().GetId())
+		m.SetTaskID(worklogTask)
+	}
+	return m, nil
+}
@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	workflowstatus "github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// WorkflowStatusService implements WorkflowStatusServiceServer
+type WorkflowStatusService struct {
+	client *ent.Client
+	UnimplementedWorkflowStatusServiceServer
+}
+
+// NewWorkflowStatusService returns a new WorkflowStatusService
+func NewWorkflowStatusService(client *ent.Client) *WorkflowStatusService {
+	return &WorkflowStatusService{
+		client: client,
+	}
+}
+
+var protoIdentNormalizeRegexpWorkflowStatus_Category = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeWorkflowStatus_Category(e string) string {
+	return protoIdentNormalizeRegexpWorkflowStatus_Category.ReplaceAllString(e, "_")
+}
+
+func toProtoWorkflowStatus_Category(e workflowstatus.Category) WorkflowStatus_Category {
+	if v, ok := WorkflowStatus_Category_value[strings.ToUpper("CATEGORY_"+protoIdentNormalizeWorkflowStatus_Category(string(e)))]; ok {
+		return WorkflowStatus_Category(v)
+	}
+	return WorkflowStatus_Category(0)
+}
+
+func toEntWorkflowStatus_Category(e WorkflowStatus_Category) workflowstatus.Category {
+	if v, ok := WorkflowStatus_Category_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"CATEGORY_TODO":  "todo",
+			"CATEGORY_DOING": "doing",
+			"CATEGORY_DONE":  "done",
+		}[v]
+		return workflowstatus.Category(entVal)
+	}
+	return ""
+}
+
+var protoIdentNormalizeRegexpWorkflowStatus_SystemStatus = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+func protoIdentNormalizeWorkflowStatus_SystemStatus(e string) string {
+	return protoIdentNormalizeRegexpWorkflowStatus_SystemStatus.ReplaceAllString(e, "_")
+}
+
+func toProtoWorkflowStatus_SystemStatus(e workflowstatus.SystemStatus) WorkflowStatus_SystemStatus {
+	if v, ok := WorkflowStatus_SystemStatus_value[strings.ToUpper("SYSTEM_STATUS_"+protoIdentNormalizeWorkflowStatus_SystemStatus(string(e)))]; ok {
+		return WorkflowStatus_SystemStatus(v)
+	}
+	return WorkflowStatus_SystemStatus(0)
+}
+
+func toEntWorkflowStatus_SystemStatus(e WorkflowStatus_SystemStatus) workflowstatus.SystemStatus {
+	if v, ok := WorkflowStatus_SystemStatus_name[int32(e)]; ok {
+		entVal := map[string]string{
+			"SYSTEM_STATUS_NOT_RECEIVED": "not_received",
+			"SYSTEM_STATUS_RECEIVED":     "received",
+			"SYSTEM_STATUS_IN_PROGRESS":  "in_progress",
+			"SYSTEM_STATUS_COMPLETED":    "completed",
+			"SYSTEM_STATUS_CANCELLED":    "cancelled",
+		}[v]
+		return workflowstatus.SystemStatus(entVal)
+	}
+	return ""
+}
+
+// toProtoWorkflowStatus transforms the ent type to the pb type
+func toProtoWorkflowStatus(e *ent.WorkflowStatus) (*WorkflowStatus, error) {
+	v := &WorkflowStatus{}
+	category := toProtoWorkflowStatus_Category(e.Category)
+	v.Category = category
+	id := int64(e.ID)
+	v.Id = id
+	key := e.Key
+	v.Key = key
+	name := e.Name
+	v.Name = name
+	position := int64(e.Position)
+	v.Position = position
+	project := int64(e.ProjectID)
+	v.ProjectId = project
+	system_status := toProtoWorkflowStatus_SystemStatus(e.SystemStatus)
+	v.SystemStatus = system_status
+	if e.WipLimit != nil {
+		wip_limit := wrapperspb.Int64(int64(*e.WipLimit))
+		v.WipLimit = wip_limit
+	}
+	if edg := e.Edges.Project; edg != nil {
+		id := int64(edg.ID)
+		v.Project = &Project{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoWorkflowStatusList transforms a list of ent type to a list of pb type
+func toProtoWorkflowStatusList(e []*ent.WorkflowStatus) ([]*WorkflowStatus, error) {
+	var pbList []*WorkflowStatus
+	for _, entEntity := range e {
+		pbEntity, err := toProtoWorkflowStatus(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements WorkflowStatusServiceServer.Create
+func (svc *WorkflowStatusService) Create(ctx context.Context, req *CreateWorkflowStatusRequest) (*WorkflowStatus, error) {
+	workflowstatus := req.GetWorkflowStatus()
+	m, err := svc.createBuilder(workflowstatus)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoWorkflowStatus(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements WorkflowStatusServiceServer.Get
+func (svc *WorkflowStatusService) Get(ctx context.Context, req *GetWorkflowStatusRequest) (*WorkflowStatus, error) {
+	var (
+		err error
+		get *ent.WorkflowStatus
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetWorkflowStatusRequest_VIEW_UNSPECIFIED, GetWorkflowStatusRequest_BASIC:
+		get, err = svc.client.WorkflowStatus.Get(ctx, id)
+	case GetWorkflowStatusRequest_WITH_EDGE_IDS:
+		get, err = svc.client.WorkflowStatus.Query().
+			Where(workflowstatus.ID(id)).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoWorkflowStatus(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements WorkflowStatusServiceServer.Update
+func (svc *WorkflowStatusService) Update(ctx context.Context, req *UpdateWorkflowStatusRequest) (*WorkflowStatus, error) {
+	workflowstatus := req.GetWorkflowStatus()
+	workflowstatusID := int(workflowstatus.GetId())
+	m := svc.client.WorkflowStatus.UpdateOneID(workflowstatusID)
+	workflowstatusCategory := toEntWorkflowStatus_Category(workflowstatus.GetCategory())
+	m.SetCategory(workflowstatusCategory)
+	workflowstatusKey := workflowstatus.GetKey()
+	m.SetKey(workflowstatusKey)
+	workflowstatusName := workflowstatus.GetName()
+	m.SetName(workflowstatusName)
+	workflowstatusPosition := int(workflowstatus.GetPosition())
+	m.SetPosition(workflowstatusPosition)
+	workflowstatusProjectID := int(workflowstatus.GetProjectId())
+	m.SetProjectID(workflowstatusProjectID)
+	workflowstatusSystemStatus := toEntWorkflowStatus_SystemStatus(workflowstatus.GetSystemStatus())
+	m.SetSystemStatus(workflowstatusSystemStatus)
+	if workflowstatus.GetWipLimit() != nil {
+		workflowstatusWipLimit := int(workflowstatus.GetWipLimit().GetValue())
+		m.SetWipLimit(workflowstatusWipLimit)
+	}
+	if workflowstatus.GetProject() != nil {
+		workflowstatusProject := int(workflowstatus.GetProject().GetId())
+		m.SetProjectID(workflowstatusProject)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoWorkflowStatus(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements WorkflowStatusServiceServer.Delete
+func (svc *WorkflowStatusService) Delete(ctx context.Context, req *DeleteWorkflowStatusRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.WorkflowStatus.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements WorkflowStatusServiceServer.List
+func (svc *WorkflowStatusService) List(ctx context.Context, req *ListWorkflowStatusRequest) (*ListWorkflowStatusResponse, error) {
+	var (
+		err      error
+		entList  []*ent.WorkflowStatus
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.WorkflowStatus.Query().
+		Order(ent.Desc(workflowstatus.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(workflowstatus.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListWorkflowStatusRequest_VIEW_UNSPECIFIED, ListWorkflowStatusRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListWorkflowStatusRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoWorkflowStatusList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListWorkflowStatusResponse{
+			WorkflowStatusList: protoList,
+			NextPageToken:      nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements WorkflowStatusServiceServer.BatchCreate
+func (svc *WorkflowStatusService) BatchCreate(ctx context.Context, req *BatchCreateWorkflowStatusSliceRequest) (*BatchCreateWorkflowStatusSliceResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.WorkflowStatusCreate, len(requests))
+	for i, req := range requests {
+		workflowstatus := req.GetWorkflowStatus()
+		var err error
+		bulk[i], err = svc.createBuilder(workflowstatus)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.WorkflowStatus.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoWorkflowStatusList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateWorkflowStatusSliceResponse{
+			WorkflowStatusSlice: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *WorkflowStatusService) createBuilder(workflowstatus *WorkflowStatus) (*ent.WorkflowStatusCreate, error) {
+	m := svc.client.WorkflowStatus.Create()
+	workflowstatusCategory := toEntWorkflowStatus_Category(workflowstatus.GetCategory())
+	m.SetCategory(workflowstatusCategory)
+	workflowstatusKey := workflowstatus.GetKey()
+	m.SetKey(workflowstatusKey)
+	workflowstatusName := workflowstatus.GetName()
+	m.SetName(workflowstatusName)
+	workflowstatusPosition := int(workflowstatus.GetPosition())
+	m.SetPosition(workflowstatusPosition)
+	workflowstatusProjectID := int(workflowstatus.GetProjectId())
+	m.SetProjectID(workflowstatusProjectID)
+	workflowstatusSystemStatus := toEntWorkflowStatus_SystemStatus(workflowstatus.GetSystemStatus())
+	m.SetSystemStatus(workflowstatusSystemStatus)
+	if workflowstatus.GetWipLimit() != nil {
+		workflowstatusWipLimit := int(workflowstatus.GetWipLimit().GetValue())
+		m.SetWipLimit(workflowstatusWipLimit)
+	}
+	if workflowstatus.GetProject() != nil {
+		workflowstatusProject := int(workflowstatus.GetProject().GetId())
+		m.SetProjectID(workflowstatusProject)
+	}
+	return m, nil
+}
@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	base64 "encoding/base64"
+	entproto "entgo.io/contrib/entproto"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	fmt "fmt"
+	ent "github.com/longgggwwww/hrm-ms-hr/ent"
+	project "github.com/longgggwwww/hrm-ms-hr/ent/project"
+	workflowtransition "github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	strconv "strconv"
+)
+
+// WorkflowTransitionService implements WorkflowTransitionServiceServer
+type WorkflowTransitionService struct {
+	client *ent.Client
+	UnimplementedWorkflowTransitionServiceServer
+}
+
+// NewWorkflowTransitionService returns a new WorkflowTransitionService
+func NewWorkflowTransitionService(client *ent.Client) *WorkflowTransitionService {
+	return &WorkflowTransitionService{
+		client: client,
+	}
+}
+
+// toProtoWorkflowTransition transforms the ent type to the pb type
+func toProtoWorkflowTransition(e *ent.WorkflowTransition) (*WorkflowTransition, error) {
+	v := &WorkflowTransition{}
+	from_key := e.FromKey
+	v.FromKey = from_key
+	id := int64(e.ID)
+	v.Id = id
+	project := int64(e.ProjectID)
+	v.ProjectId = project
+	to_key := e.ToKey
+	v.ToKey = to_key
+	if edg := e.Edges.Project; edg != nil {
+		id := int64(edg.ID)
+		v.Project = &Project{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoWorkflowTransitionList transforms a list of ent type to a list of pb type
+func toProtoWorkflowTransitionList(e []*ent.WorkflowTransition) ([]*WorkflowTransition, error) {
+	var pbList []*WorkflowTransition
+	for _, entEntity := range e {
+		pbEntity, err := toProtoWorkflowTransition(entEntity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		pbList = append(pbList, pbEntity)
+	}
+	return pbList, nil
+}
+
+// Create implements WorkflowTransitionServiceServer.Create
+func (svc *WorkflowTransitionService) Create(ctx context.Context, req *CreateWorkflowTransitionRequest) (*WorkflowTransition, error) {
+	workflowtransition := req.GetWorkflowTransition()
+	m, err := svc.createBuilder(workflowtransition)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoWorkflowTransition(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements WorkflowTransitionServiceServer.Get
+func (svc *WorkflowTransitionService) Get(ctx context.Context, req *GetWorkflowTransitionRequest) (*WorkflowTransition, error) {
+	var (
+		err error
+		get *ent.WorkflowTransition
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetWorkflowTransitionRequest_VIEW_UNSPECIFIED, GetWorkflowTransitionRequest_BASIC:
+		get, err = svc.client.WorkflowTransition.Get(ctx, id)
+	case GetWorkflowTransitionRequest_WITH_EDGE_IDS:
+		get, err = svc.client.WorkflowTransition.Query().
+			Where(workflowtransition.ID(id)).
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		return toProtoWorkflowTransition(get)
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements WorkflowTransitionServiceServer.Update
+func (svc *WorkflowTransitionService) Update(ctx context.Context, req *UpdateWorkflowTransitionRequest) (*WorkflowTransition, error) {
+	workflowtransition := req.GetWorkflowTransition()
+	workflowtransitionID := int(workflowtransition.GetId())
+	m := svc.client.WorkflowTransition.UpdateOneID(workflowtransitionID)
+	workflowtransitionFromKey := workflowtransition.GetFromKey()
+	m.SetFromKey(workflowtransitionFromKey)
+	workflowtransitionProjectID := int(workflowtransition.GetProjectId())
+	m.SetProjectID(workflowtransitionProjectID)
+	workflowtransitionToKey := workflowtransition.GetToKey()
+	m.SetToKey(workflowtransitionToKey)
+	if workflowtransition.GetProject() != nil {
+		workflowtransitionProject := int(workflowtransition.GetProject().GetId())
+		m.SetProjectID(workflowtransitionProject)
+	}
+
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoWorkflowTransition(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements WorkflowTransitionServiceServer.Delete
+func (svc *WorkflowTransitionService) Delete(ctx context.Context, req *DeleteWorkflowTransitionRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.WorkflowTransition.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements WorkflowTransitionServiceServer.List
+func (svc *WorkflowTransitionService) List(ctx context.Context, req *ListWorkflowTransitionRequest) (*ListWorkflowTransitionResponse, error) {
+	var (
+		err      error
+		entList  []*ent.WorkflowTransition
+		pageSize int
+	)
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+	case pageSize == 0 || pageSize > entproto.MaxPageSize:
+		pageSize = entproto.MaxPageSize
+	}
+	listQuery := svc.client.WorkflowTransition.Query().
+		Order(ent.Desc(workflowtransition.FieldID)).
+		Limit(pageSize + 1)
+	if req.GetPageToken() != "" {
+		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+		}
+		pageToken := int(token)
+		listQuery = listQuery.
+			Where(workflowtransition.IDLTE(pageToken))
+	}
+	switch req.GetView() {
+	case ListWorkflowTransitionRequest_VIEW_UNSPECIFIED, ListWorkflowTransitionRequest_BASIC:
+		entList, err = listQuery.All(ctx)
+	case ListWorkflowTransitionRequest_WITH_EDGE_IDS:
+		entList, err = listQuery.
+			WithProject(func(query *ent.ProjectQuery) {
+				query.Select(project.FieldID)
+			}).
+			All(ctx)
+	}
+	switch {
+	case err == nil:
+		var nextPageToken string
+		if len(entList) == pageSize+1 {
+			nextPageToken = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			entList = entList[:len(entList)-1]
+		}
+		protoList, err := toProtoWorkflowTransitionList(entList)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &ListWorkflowTransitionResponse{
+			WorkflowTransitionList: protoList,
+			NextPageToken:          nextPageToken,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// BatchCreate implements WorkflowTransitionServiceServer.BatchCreate
+func (svc *WorkflowTransitionService) BatchCreate(ctx context.Context, req *BatchCreateWorkflowTransitionsRequest) (*BatchCreateWorkflowTransitionsResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > entproto.MaxBatchCreateSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+	}
+	bulk := make([]*ent.WorkflowTransitionCreate, len(requests))
+	for i, req := range requests {
+		workflowtransition := req.GetWorkflowTransition()
+		var err error
+		bulk[i], err = svc.createBuilder(workflowtransition)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := svc.client.WorkflowTransition.CreateBulk(bulk...).Save(ctx)
+	switch {
+	case err == nil:
+		protoList, err := toProtoWorkflowTransitionList(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return &BatchCreateWorkflowTransitionsResponse{
+			WorkflowTransitions: protoList,
+		}, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+func (svc *WorkflowTransitionService) createBuilder(workflowtransition *WorkflowTransition) (*ent.WorkflowTransitionCreate, error) {
+	m := svc.client.WorkflowTransition.Create()
+	workflowtransitionFromKey := workflowtransition.GetFromKey()
+	m.SetFromKey(workflowtransitionFromKey)
+	workflowtransitionProjectID := int(workflowtransition.GetProjectId())
+	m.SetProjectID(workflowtransitionProjectID)
+	workflowtransitionToKey := workflowtransition.GetToKey()
+	m.SetToKey(workflowtransitionToKey)
+	if workflowtransition.GetProject() != nil {
+		workflowtransitionProject := int(workflowtransition.GetProject().GetId())
+		m.SetProjectID(workflowtransitionProject)
+	}
+	return m, nil
+}
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/remindersetting"
+)
+
+// ReminderSetting is the model entity for the ReminderSetting schema.
+type ReminderSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// DueSoonEnabled holds the value of the "due_soon_enabled" field.
+	DueSoonEnabled bool `json:"due_soon_enabled"`
+	// DueSoonHours holds the value of the "due_soon_hours" field.
+	DueSoonHours int `json:"due_soon_hours"`
+	// OverdueEnabled holds the value of the "overdue_enabled" field.
+	OverdueEnabled bool `json:"overdue_enabled"`
+	// OverdueRepeatHours holds the value of the "overdue_repeat_hours" field.
+	OverdueRepeatHours int `json:"overdue_repeat_hours"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReminderSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case remindersetting.FieldDueSoonEnabled, remindersetting.FieldOverdueEnabled:
+			values[i] = new(sql.NullBool)
+		case remindersetting.FieldID, remindersetting.FieldOrgID, remindersetting.FieldDueSoonHours, remindersetting.FieldOverdueRepeatHours:
+			values[i] = new(sql.NullInt64)
+		case remindersetting.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReminderSetting fields.
+func (rs *ReminderSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case remindersetting.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rs.ID = int(value.Int64)
+		case remindersetting.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				rs.OrgID = int(value.Int64)
+			}
+		case remindersetting.FieldDueSoonEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field due_soon_enabled", values[i])
+			} else if value.Valid {
+				rs.DueSoonEnabled = value.Bool
+			}
+		case remindersetting.FieldDueSoonHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field due_soon_hours", values[i])
+			} else if value.Valid {
+				rs.DueSoonHours = int(value.Int64)
+			}
+		case remindersetting.FieldOverdueEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field overdue_enabled", values[i])
+			} else if value.Valid {
+				rs.OverdueEnabled = value.Bool
+			}
+		case remindersetting.FieldOverdueRepeatHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field overdue_repeat_hours", values[i])
+			} else if value.Valid {
+				rs.OverdueRepeatHours = int(value.Int64)
+			}
+		case remindersetting.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rs.UpdatedAt = value.Time
+			}
+		default:
+			rs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReminderSetting.
+// This includes values selected through modifiers, order, etc.
+func (rs *ReminderSetting) Value(name string) (ent.Value, error) {
+	return rs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReminderSetting.
+// Note that you need to call ReminderSetting.Unwrap() before calling this method if this ReminderSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rs *ReminderSetting) Update() *ReminderSettingUpdateOne {
+	return NewReminderSettingClient(rs.config).UpdateOne(rs)
+}
+
+// Unwrap unwraps the ReminderSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rs *ReminderSetting) Unwrap() *ReminderSetting {
+	_tx, ok := rs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReminderSetting is not a transactional entity")
+	}
+	rs.config.driver = _tx.drv
+	return rs
+}
+
+// String implements the fmt.Stringer.
+func (rs *ReminderSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReminderSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rs.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", rs.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("due_soon_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", rs.DueSoonEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("due_soon_hours=")
+	builder.WriteString(fmt.Sprintf("%v", rs.DueSoonHours))
+	builder.WriteString(", ")
+	builder.WriteString("overdue_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", rs.OverdueEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("overdue_repeat_hours=")
+	builder.WriteString(fmt.Sprintf("%v", rs.OverdueRepeatHours))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReminderSettings is a parsable slice of ReminderSetting.
+type ReminderSettings []*ReminderSetting
@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package remindersetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the remindersetting type in the database.
+	Label = "reminder_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldDueSoonEnabled holds the string denoting the due_soon_enabled field in the database.
+	FieldDueSoonEnabled = "due_soon_enabled"
+	// FieldDueSoonHours holds the string denoting the due_soon_hours field in the database.
+	FieldDueSoonHours = "due_soon_hours"
+	// FieldOverdueEnabled holds the string denoting the overdue_enabled field in the database.
+	FieldOverdueEnabled = "overdue_enabled"
+	// FieldOverdueRepeatHours holds the string denoting the overdue_repeat_hours field in the database.
+	FieldOverdueRepeatHours = "overdue_repeat_hours"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the remindersetting in the database.
+	Table = "reminder_settings"
+)
+
+// Columns holds all SQL columns for remindersetting fields.
+var Columns = []string{
+	FieldID,
+	FieldOrgID,
+	FieldDueSoonEnabled,
+	FieldDueSoonHours,
+	FieldOverdueEnabled,
+	FieldOverdueRepeatHours,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDueSoonEnabled holds the default value on creation for the "due_soon_enabled" field.
+	DefaultDueSoonEnabled bool
+	// DefaultDueSoonHours holds the default value on creation for the "due_soon_hours" field.
+	DefaultDueSoonHours int
+	// DueSoonHoursValidator is a validator for the "due_soon_hours" field. It is called by the builders before save.
+	DueSoonHoursValidator func(int) error
+	// DefaultOverdueEnabled holds the default value on creation for the "overdue_enabled" field.
+	DefaultOverdueEnabled bool
+	// DefaultOverdueRepeatHours holds the default value on creation for the "overdue_repeat_hours" field.
+	DefaultOverdueRepeatHours int
+	// OverdueRepeatHoursValidator is a validator for the "overdue_repeat_hours" field. It is called by the builders before save.
+	OverdueRepeatHoursValidator func(int) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ReminderSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// ByDueSoonEnabled orders the results by the due_soon_enabled field.
+func ByDueSoonEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueSoonEnabled, opts...).ToFunc()
+}
+
+// ByDueSoonHours orders the results by the due_soon_hours field.
+func ByDueSoonHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueSoonHours, opts...).ToFunc()
+}
+
+// ByOverdueEnabled orders the results by the overdue_enabled field.
+func ByOverdueEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverdueEnabled, opts...).ToFunc()
+}
+
+// ByOverdueRepeatHours orders the results by the overdue_repeat_hours field.
+func ByOverdueRepeatHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverdueRepeatHours, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package remindersetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLTE(FieldID, id))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldOrgID, v))
+}
+
+// DueSoonEnabled applies equality check predicate on the "due_soon_enabled" field. It's identical to DueSoonEnabledEQ.
+func DueSoonEnabled(v bool) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldDueSoonEnabled, v))
+}
+
+// DueSoonHours applies equality check predicate on the "due_soon_hours" field. It's identical to DueSoonHoursEQ.
+func DueSoonHours(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldDueSoonHours, v))
+}
+
+// OverdueEnabled applies equality check predicate on the "overdue_enabled" field. It's identical to OverdueEnabledEQ.
+func OverdueEnabled(v bool) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldOverdueEnabled, v))
+}
+
+// OverdueRepeatHours applies equality check predicate on the "overdue_repeat_hours" field. It's identical to OverdueRepeatHoursEQ.
+func OverdueRepeatHours(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldOverdueRepeatHours, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLTE(FieldOrgID, v))
+}
+
+// DueSoonEnabledEQ applies the EQ predicate on the "due_soon_enabled" field.
+func DueSoonEnabledEQ(v bool) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldDueSoonEnabled, v))
+}
+
+// DueSoonEnabledNEQ applies the NEQ predicate on the "due_soon_enabled" field.
+func DueSoonEnabledNEQ(v bool) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNEQ(FieldDueSoonEnabled, v))
+}
+
+// DueSoonHoursEQ applies the EQ predicate on the "due_soon_hours" field.
+func DueSoonHoursEQ(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldDueSoonHours, v))
+}
+
+// DueSoonHoursNEQ applies the NEQ predicate on the "due_soon_hours" field.
+func DueSoonHoursNEQ(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNEQ(FieldDueSoonHours, v))
+}
+
+// DueSoonHoursIn applies the In predicate on the "due_soon_hours" field.
+func DueSoonHoursIn(vs ...int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldIn(FieldDueSoonHours, vs...))
+}
+
+// DueSoonHoursNotIn applies the NotIn predicate on the "due_soon_hours" field.
+func DueSoonHoursNotIn(vs ...int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNotIn(FieldDueSoonHours, vs...))
+}
+
+// DueSoonHoursGT applies the GT predicate on the "due_soon_hours" field.
+func DueSoonHoursGT(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGT(FieldDueSoonHours, v))
+}
+
+// DueSoonHoursGTE applies the GTE predicate on the "due_soon_hours" field.
+func DueSoonHoursGTE(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGTE(FieldDueSoonHours, v))
+}
+
+// DueSoonHoursLT applies the LT predicate on the "due_soon_hours" field.
+func DueSoonHoursLT(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLT(FieldDueSoonHours, v))
+}
+
+// DueSoonHoursLTE applies the LTE predicate on the "due_soon_hours" field.
+func DueSoonHoursLTE(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLTE(FieldDueSoonHours, v))
+}
+
+// OverdueEnabledEQ applies the EQ predicate on the "overdue_enabled" field.
+func OverdueEnabledEQ(v bool) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldOverdueEnabled, v))
+}
+
+// OverdueEnabledNEQ applies the NEQ predicate on the "overdue_enabled" field.
+func OverdueEnabledNEQ(v bool) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNEQ(FieldOverdueEnabled, v))
+}
+
+// OverdueRepeatHoursEQ applies the EQ predicate on the "overdue_repeat_hours" field.
+func OverdueRepeatHoursEQ(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldOverdueRepeatHours, v))
+}
+
+// OverdueRepeatHoursNEQ applies the NEQ predicate on the "overdue_repeat_hours" field.
+func OverdueRepeatHoursNEQ(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNEQ(FieldOverdueRepeatHours, v))
+}
+
+// OverdueRepeatHoursIn applies the In predicate on the "overdue_repeat_hours" field.
+func OverdueRepeatHoursIn(vs ...int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldIn(FieldOverdueRepeatHours, vs...))
+}
+
+// OverdueRepeatHoursNotIn applies the NotIn predicate on the "overdue_repeat_hours" field.
+func OverdueRepeatHoursNotIn(vs ...int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNotIn(FieldOverdueRepeatHours, vs...))
+}
+
+// OverdueRepeatHoursGT applies the GT predicate on the "overdue_repeat_hours" field.
+func OverdueRepeatHoursGT(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGT(FieldOverdueRepeatHours, v))
+}
+
+// OverdueRepeatHoursGTE applies the GTE predicate on the "overdue_repeat_hours" field.
+func OverdueRepeatHoursGTE(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGTE(FieldOverdueRepeatHours, v))
+}
+
+// OverdueRepeatHoursLT applies the LT predicate on the "overdue_repeat_hours" field.
+func OverdueRepeatHoursLT(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLT(FieldOverdueRepeatHours, v))
+}
+
+// OverdueRepeatHoursLTE applies the LTE predicate on the "overdue_repeat_hours" field.
+func OverdueRepeatHoursLTE(v int) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLTE(FieldOverdueRepeatHours, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReminderSetting) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReminderSetting) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReminderSetting) predicate.ReminderSetting {
+	return predicate.ReminderSetting(sql.NotPredicates(p))
+}
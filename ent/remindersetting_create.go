@@ -0,0 +1,856 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/remindersetting"
+)
+
+// ReminderSettingCreate is the builder for creating a ReminderSetting entity.
+type ReminderSettingCreate struct {
+	config
+	mutation *ReminderSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (rsc *ReminderSettingCreate) SetOrgID(i int) *ReminderSettingCreate {
+	rsc.mutation.SetOrgID(i)
+	return rsc
+}
+
+// SetDueSoonEnabled sets the "due_soon_enabled" field.
+func (rsc *ReminderSettingCreate) SetDueSoonEnabled(b bool) *ReminderSettingCreate {
+	rsc.mutation.SetDueSoonEnabled(b)
+	return rsc
+}
+
+// SetNillableDueSoonEnabled sets the "due_soon_enabled" field if the given value is not nil.
+func (rsc *ReminderSettingCreate) SetNillableDueSoonEnabled(b *bool) *ReminderSettingCreate {
+	if b != nil {
+		rsc.SetDueSoonEnabled(*b)
+	}
+	return rsc
+}
+
+// SetDueSoonHours sets the "due_soon_hours" field.
+func (rsc *ReminderSettingCreate) SetDueSoonHours(i int) *ReminderSettingCreate {
+	rsc.mutation.SetDueSoonHours(i)
+	return rsc
+}
+
+// SetNillableDueSoonHours sets the "due_soon_hours" field if the given value is not nil.
+func (rsc *ReminderSettingCreate) SetNillableDueSoonHours(i *int) *ReminderSettingCreate {
+	if i != nil {
+		rsc.SetDueSoonHours(*i)
+	}
+	return rsc
+}
+
+// SetOverdueEnabled sets the "overdue_enabled" field.
+func (rsc *ReminderSettingCreate) SetOverdueEnabled(b bool) *ReminderSettingCreate {
+	rsc.mutation.SetOverdueEnabled(b)
+	return rsc
+}
+
+// SetNillableOverdueEnabled sets the "overdue_enabled" field if the given value is not nil.
+func (rsc *ReminderSettingCreate) SetNillableOverdueEnabled(b *bool) *ReminderSettingCreate {
+	if b != nil {
+		rsc.SetOverdueEnabled(*b)
+	}
+	return rsc
+}
+
+// SetOverdueRepeatHours sets the "overdue_repeat_hours" field.
+func (rsc *ReminderSettingCreate) SetOverdueRepeatHours(i int) *ReminderSettingCreate {
+	rsc.mutation.SetOverdueRepeatHours(i)
+	return rsc
+}
+
+// SetNillableOverdueRepeatHours sets the "overdue_repeat_hours" field if the given value is not nil.
+func (rsc *ReminderSettingCreate) SetNillableOverdueRepeatHours(i *int) *ReminderSettingCreate {
+	if i != nil {
+		rsc.SetOverdueRepeatHours(*i)
+	}
+	return rsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsc *ReminderSettingCreate) SetUpdatedAt(t time.Time) *ReminderSettingCreate {
+	rsc.mutation.SetUpdatedAt(t)
+	return rsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rsc *ReminderSettingCreate) SetNillableUpdatedAt(t *time.Time) *ReminderSettingCreate {
+	if t != nil {
+		rsc.SetUpdatedAt(*t)
+	}
+	return rsc
+}
+
+// Mutation returns the ReminderSettingMutation object of the builder.
+func (rsc *ReminderSettingCreate) Mutation() *ReminderSettingMutation {
+	return rsc.mutation
+}
+
+// Save creates the ReminderSetting in the database.
+func (rsc *ReminderSettingCreate) Save(ctx context.Context) (*ReminderSetting, error) {
+	rsc.defaults()
+	return withHooks(ctx, rsc.sqlSave, rsc.mutation, rsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rsc *ReminderSettingCreate) SaveX(ctx context.Context) *ReminderSetting {
+	v, err := rsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rsc *ReminderSettingCreate) Exec(ctx context.Context) error {
+	_, err := rsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsc *ReminderSettingCreate) ExecX(ctx context.Context) {
+	if err := rsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsc *ReminderSettingCreate) defaults() {
+	if _, ok := rsc.mutation.DueSoonEnabled(); !ok {
+		v := remindersetting.DefaultDueSoonEnabled
+		rsc.mutation.SetDueSoonEnabled(v)
+	}
+	if _, ok := rsc.mutation.DueSoonHours(); !ok {
+		v := remindersetting.DefaultDueSoonHours
+		rsc.mutation.SetDueSoonHours(v)
+	}
+	if _, ok := rsc.mutation.OverdueEnabled(); !ok {
+		v := remindersetting.DefaultOverdueEnabled
+		rsc.mutation.SetOverdueEnabled(v)
+	}
+	if _, ok := rsc.mutation.OverdueRepeatHours(); !ok {
+		v := remindersetting.DefaultOverdueRepeatHours
+		rsc.mutation.SetOverdueRepeatHours(v)
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		v := remindersetting.DefaultUpdatedAt()
+		rsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsc *ReminderSettingCreate) check() error {
+	if _, ok := rsc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "ReminderSetting.org_id"`)}
+	}
+	if _, ok := rsc.mutation.DueSoonEnabled(); !ok {
+		return &ValidationError{Name: "due_soon_enabled", err: errors.New(`ent: missing required field "ReminderSetting.due_soon_enabled"`)}
+	}
+	if _, ok := rsc.mutation.DueSoonHours(); !ok {
+		return &ValidationError{Name: "due_soon_hours", err: errors.New(`ent: missing required field "ReminderSetting.due_soon_hours"`)}
+	}
+	if v, ok := rsc.mutation.DueSoonHours(); ok {
+		if err := remindersetting.DueSoonHoursValidator(v); err != nil {
+			return &ValidationError{Name: "due_soon_hours", err: fmt.Errorf(`ent: validator failed for field "ReminderSetting.due_soon_hours": %w`, err)}
+		}
+	}
+	if _, ok := rsc.mutation.OverdueEnabled(); !ok {
+		return &ValidationError{Name: "overdue_enabled", err: errors.New(`ent: missing required field "ReminderSetting.overdue_enabled"`)}
+	}
+	if _, ok := rsc.mutation.OverdueRepeatHours(); !ok {
+		return &ValidationError{Name: "overdue_repeat_hours", err: errors.New(`ent: missing required field "ReminderSetting.overdue_repeat_hours"`)}
+	}
+	if v, ok := rsc.mutation.OverdueRepeatHours(); ok {
+		if err := remindersetting.OverdueRepeatHoursValidator(v); err != nil {
+			return &ValidationError{Name: "overdue_repeat_hours", err: fmt.Errorf(`ent: validator failed for field "ReminderSetting.overdue_repeat_hours": %w`, err)}
+		}
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReminderSetting.updated_at"`)}
+	}
+	return nil
+}
+
+func (rsc *ReminderSettingCreate) sqlSave(ctx context.Context) (*ReminderSetting, error) {
+	if err := rsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rsc.mutation.id = &_node.ID
+	rsc.mutation.done = true
+	return _node, nil
+}
+
+func (rsc *ReminderSettingCreate) createSpec() (*ReminderSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReminderSetting{config: rsc.config}
+		_spec = sqlgraph.NewCreateSpec(remindersetting.Table, sqlgraph.NewFieldSpec(remindersetting.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rsc.conflict
+	if value, ok := rsc.mutation.OrgID(); ok {
+		_spec.SetField(remindersetting.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := rsc.mutation.DueSoonEnabled(); ok {
+		_spec.SetField(remindersetting.FieldDueSoonEnabled, field.TypeBool, value)
+		_node.DueSoonEnabled = value
+	}
+	if value, ok := rsc.mutation.DueSoonHours(); ok {
+		_spec.SetField(remindersetting.FieldDueSoonHours, field.TypeInt, value)
+		_node.DueSoonHours = value
+	}
+	if value, ok := rsc.mutation.OverdueEnabled(); ok {
+		_spec.SetField(remindersetting.FieldOverdueEnabled, field.TypeBool, value)
+		_node.OverdueEnabled = value
+	}
+	if value, ok := rsc.mutation.OverdueRepeatHours(); ok {
+		_spec.SetField(remindersetting.FieldOverdueRepeatHours, field.TypeInt, value)
+		_node.OverdueRepeatHours = value
+	}
+	if value, ok := rsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(remindersetting.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReminderSetting.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReminderSettingUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (rsc *ReminderSettingCreate) OnConflict(opts ...sql.ConflictOption) *ReminderSettingUpsertOne {
+	rsc.conflict = opts
+	return &ReminderSettingUpsertOne{
+		create: rsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReminderSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rsc *ReminderSettingCreate) OnConflictColumns(columns ...string) *ReminderSettingUpsertOne {
+	rsc.conflict = append(rsc.conflict, sql.ConflictColumns(columns...))
+	return &ReminderSettingUpsertOne{
+		create: rsc,
+	}
+}
+
+type (
+	// ReminderSettingUpsertOne is the builder for "upsert"-ing
+	//  one ReminderSetting node.
+	ReminderSettingUpsertOne struct {
+		create *ReminderSettingCreate
+	}
+
+	// ReminderSettingUpsert is the "OnConflict" setter.
+	ReminderSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *ReminderSettingUpsert) SetOrgID(v int) *ReminderSettingUpsert {
+	u.Set(remindersetting.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ReminderSettingUpsert) UpdateOrgID() *ReminderSettingUpsert {
+	u.SetExcluded(remindersetting.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *ReminderSettingUpsert) AddOrgID(v int) *ReminderSettingUpsert {
+	u.Add(remindersetting.FieldOrgID, v)
+	return u
+}
+
+// SetDueSoonEnabled sets the "due_soon_enabled" field.
+func (u *ReminderSettingUpsert) SetDueSoonEnabled(v bool) *ReminderSettingUpsert {
+	u.Set(remindersetting.FieldDueSoonEnabled, v)
+	return u
+}
+
+// UpdateDueSoonEnabled sets the "due_soon_enabled" field to the value that was provided on create.
+func (u *ReminderSettingUpsert) UpdateDueSoonEnabled() *ReminderSettingUpsert {
+	u.SetExcluded(remindersetting.FieldDueSoonEnabled)
+	return u
+}
+
+// SetDueSoonHours sets the "due_soon_hours" field.
+func (u *ReminderSettingUpsert) SetDueSoonHours(v int) *ReminderSettingUpsert {
+	u.Set(remindersetting.FieldDueSoonHours, v)
+	return u
+}
+
+// UpdateDueSoonHours sets the "due_soon_hours" field to the value that was provided on create.
+func (u *ReminderSettingUpsert) UpdateDueSoonHours() *ReminderSettingUpsert {
+	u.SetExcluded(remindersetting.FieldDueSoonHours)
+	return u
+}
+
+// AddDueSoonHours adds v to the "due_soon_hours" field.
+func (u *ReminderSettingUpsert) AddDueSoonHours(v int) *ReminderSettingUpsert {
+	u.Add(remindersetting.FieldDueSoonHours, v)
+	return u
+}
+
+// SetOverdueEnabled sets the "overdue_enabled" field.
+func (u *ReminderSettingUpsert) SetOverdueEnabled(v bool) *ReminderSettingUpsert {
+	u.Set(remindersetting.FieldOverdueEnabled, v)
+	return u
+}
+
+// UpdateOverdueEnabled sets the "overdue_enabled" field to the value that was provided on create.
+func (u *ReminderSettingUpsert) UpdateOverdueEnabled() *ReminderSettingUpsert {
+	u.SetExcluded(remindersetting.FieldOverdueEnabled)
+	return u
+}
+
+// SetOverdueRepeatHours sets the "overdue_repeat_hours" field.
+func (u *ReminderSettingUpsert) SetOverdueRepeatHours(v int) *ReminderSettingUpsert {
+	u.Set(remindersetting.FieldOverdueRepeatHours, v)
+	return u
+}
+
+// UpdateOverdueRepeatHours sets the "overdue_repeat_hours" field to the value that was provided on create.
+func (u *ReminderSettingUpsert) UpdateOverdueRepeatHours() *ReminderSettingUpsert {
+	u.SetExcluded(remindersetting.FieldOverdueRepeatHours)
+	return u
+}
+
+// AddOverdueRepeatHours adds v to the "overdue_repeat_hours" field.
+func (u *ReminderSettingUpsert) AddOverdueRepeatHours(v int) *ReminderSettingUpsert {
+	u.Add(remindersetting.FieldOverdueRepeatHours, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderSettingUpsert) SetUpdatedAt(v time.Time) *ReminderSettingUpsert {
+	u.Set(remindersetting.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderSettingUpsert) UpdateUpdatedAt() *ReminderSettingUpsert {
+	u.SetExcluded(remindersetting.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ReminderSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReminderSettingUpsertOne) UpdateNewValues() *ReminderSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReminderSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReminderSettingUpsertOne) Ignore() *ReminderSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReminderSettingUpsertOne) DoNothing() *ReminderSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReminderSettingCreate.OnConflict
+// documentation for more info.
+func (u *ReminderSettingUpsertOne) Update(set func(*ReminderSettingUpsert)) *ReminderSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReminderSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *ReminderSettingUpsertOne) SetOrgID(v int) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *ReminderSettingUpsertOne) AddOrgID(v int) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ReminderSettingUpsertOne) UpdateOrgID() *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetDueSoonEnabled sets the "due_soon_enabled" field.
+func (u *ReminderSettingUpsertOne) SetDueSoonEnabled(v bool) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetDueSoonEnabled(v)
+	})
+}
+
+// UpdateDueSoonEnabled sets the "due_soon_enabled" field to the value that was provided on create.
+func (u *ReminderSettingUpsertOne) UpdateDueSoonEnabled() *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateDueSoonEnabled()
+	})
+}
+
+// SetDueSoonHours sets the "due_soon_hours" field.
+func (u *ReminderSettingUpsertOne) SetDueSoonHours(v int) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetDueSoonHours(v)
+	})
+}
+
+// AddDueSoonHours adds v to the "due_soon_hours" field.
+func (u *ReminderSettingUpsertOne) AddDueSoonHours(v int) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.AddDueSoonHours(v)
+	})
+}
+
+// UpdateDueSoonHours sets the "due_soon_hours" field to the value that was provided on create.
+func (u *ReminderSettingUpsertOne) UpdateDueSoonHours() *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateDueSoonHours()
+	})
+}
+
+// SetOverdueEnabled sets the "overdue_enabled" field.
+func (u *ReminderSettingUpsertOne) SetOverdueEnabled(v bool) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetOverdueEnabled(v)
+	})
+}
+
+// UpdateOverdueEnabled sets the "overdue_enabled" field to the value that was provided on create.
+func (u *ReminderSettingUpsertOne) UpdateOverdueEnabled() *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateOverdueEnabled()
+	})
+}
+
+// SetOverdueRepeatHours sets the "overdue_repeat_hours" field.
+func (u *ReminderSettingUpsertOne) SetOverdueRepeatHours(v int) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetOverdueRepeatHours(v)
+	})
+}
+
+// AddOverdueRepeatHours adds v to the "overdue_repeat_hours" field.
+func (u *ReminderSettingUpsertOne) AddOverdueRepeatHours(v int) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.AddOverdueRepeatHours(v)
+	})
+}
+
+// UpdateOverdueRepeatHours sets the "overdue_repeat_hours" field to the value that was provided on create.
+func (u *ReminderSettingUpsertOne) UpdateOverdueRepeatHours() *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateOverdueRepeatHours()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderSettingUpsertOne) SetUpdatedAt(v time.Time) *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderSettingUpsertOne) UpdateUpdatedAt() *ReminderSettingUpsertOne {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReminderSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReminderSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReminderSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReminderSettingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReminderSettingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReminderSettingCreateBulk is the builder for creating many ReminderSetting entities in bulk.
+type ReminderSettingCreateBulk struct {
+	config
+	err      error
+	builders []*ReminderSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReminderSetting entities in the database.
+func (rscb *ReminderSettingCreateBulk) Save(ctx context.Context) ([]*ReminderSetting, error) {
+	if rscb.err != nil {
+		return nil, rscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rscb.builders))
+	nodes := make([]*ReminderSetting, len(rscb.builders))
+	mutators := make([]Mutator, len(rscb.builders))
+	for i := range rscb.builders {
+		func(i int, root context.Context) {
+			builder := rscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReminderSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rscb *ReminderSettingCreateBulk) SaveX(ctx context.Context) []*ReminderSetting {
+	v, err := rscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rscb *ReminderSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := rscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rscb *ReminderSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := rscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReminderSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReminderSettingUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (rscb *ReminderSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReminderSettingUpsertBulk {
+	rscb.conflict = opts
+	return &ReminderSettingUpsertBulk{
+		create: rscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReminderSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rscb *ReminderSettingCreateBulk) OnConflictColumns(columns ...string) *ReminderSettingUpsertBulk {
+	rscb.conflict = append(rscb.conflict, sql.ConflictColumns(columns...))
+	return &ReminderSettingUpsertBulk{
+		create: rscb,
+	}
+}
+
+// ReminderSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReminderSetting nodes.
+type ReminderSettingUpsertBulk struct {
+	create *ReminderSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReminderSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReminderSettingUpsertBulk) UpdateNewValues() *ReminderSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReminderSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReminderSettingUpsertBulk) Ignore() *ReminderSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReminderSettingUpsertBulk) DoNothing() *ReminderSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReminderSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReminderSettingUpsertBulk) Update(set func(*ReminderSettingUpsert)) *ReminderSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReminderSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *ReminderSettingUpsertBulk) SetOrgID(v int) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *ReminderSettingUpsertBulk) AddOrgID(v int) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ReminderSettingUpsertBulk) UpdateOrgID() *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetDueSoonEnabled sets the "due_soon_enabled" field.
+func (u *ReminderSettingUpsertBulk) SetDueSoonEnabled(v bool) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetDueSoonEnabled(v)
+	})
+}
+
+// UpdateDueSoonEnabled sets the "due_soon_enabled" field to the value that was provided on create.
+func (u *ReminderSettingUpsertBulk) UpdateDueSoonEnabled() *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateDueSoonEnabled()
+	})
+}
+
+// SetDueSoonHours sets the "due_soon_hours" field.
+func (u *ReminderSettingUpsertBulk) SetDueSoonHours(v int) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetDueSoonHours(v)
+	})
+}
+
+// AddDueSoonHours adds v to the "due_soon_hours" field.
+func (u *ReminderSettingUpsertBulk) AddDueSoonHours(v int) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.AddDueSoonHours(v)
+	})
+}
+
+// UpdateDueSoonHours sets the "due_soon_hours" field to the value that was provided on create.
+func (u *ReminderSettingUpsertBulk) UpdateDueSoonHours() *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateDueSoonHours()
+	})
+}
+
+// SetOverdueEnabled sets the "overdue_enabled" field.
+func (u *ReminderSettingUpsertBulk) SetOverdueEnabled(v bool) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetOverdueEnabled(v)
+	})
+}
+
+// UpdateOverdueEnabled sets the "overdue_enabled" field to the value that was provided on create.
+func (u *ReminderSettingUpsertBulk) UpdateOverdueEnabled() *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateOverdueEnabled()
+	})
+}
+
+// SetOverdueRepeatHours sets the "overdue_repeat_hours" field.
+func (u *ReminderSettingUpsertBulk) SetOverdueRepeatHours(v int) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetOverdueRepeatHours(v)
+	})
+}
+
+// AddOverdueRepeatHours adds v to the "overdue_repeat_hours" field.
+func (u *ReminderSettingUpsertBulk) AddOverdueRepeatHours(v int) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.AddOverdueRepeatHours(v)
+	})
+}
+
+// UpdateOverdueRepeatHours sets the "overdue_repeat_hours" field to the value that was provided on create.
+func (u *ReminderSettingUpsertBulk) UpdateOverdueRepeatHours() *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateOverdueRepeatHours()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderSettingUpsertBulk) SetUpdatedAt(v time.Time) *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderSettingUpsertBulk) UpdateUpdatedAt() *ReminderSettingUpsertBulk {
+	return u.Update(func(s *ReminderSettingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReminderSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReminderSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReminderSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReminderSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,478 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/remindersetting"
+)
+
+// ReminderSettingUpdate is the builder for updating ReminderSetting entities.
+type ReminderSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReminderSettingMutation
+}
+
+// Where appends a list predicates to the ReminderSettingUpdate builder.
+func (rsu *ReminderSettingUpdate) Where(ps ...predicate.ReminderSetting) *ReminderSettingUpdate {
+	rsu.mutation.Where(ps...)
+	return rsu
+}
+
+// SetOrgID sets the "org_id" field.
+func (rsu *ReminderSettingUpdate) SetOrgID(i int) *ReminderSettingUpdate {
+	rsu.mutation.ResetOrgID()
+	rsu.mutation.SetOrgID(i)
+	return rsu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (rsu *ReminderSettingUpdate) SetNillableOrgID(i *int) *ReminderSettingUpdate {
+	if i != nil {
+		rsu.SetOrgID(*i)
+	}
+	return rsu
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (rsu *ReminderSettingUpdate) AddOrgID(i int) *ReminderSettingUpdate {
+	rsu.mutation.AddOrgID(i)
+	return rsu
+}
+
+// SetDueSoonEnabled sets the "due_soon_enabled" field.
+func (rsu *ReminderSettingUpdate) SetDueSoonEnabled(b bool) *ReminderSettingUpdate {
+	rsu.mutation.SetDueSoonEnabled(b)
+	return rsu
+}
+
+// SetNillableDueSoonEnabled sets the "due_soon_enabled" field if the given value is not nil.
+func (rsu *ReminderSettingUpdate) SetNillableDueSoonEnabled(b *bool) *ReminderSettingUpdate {
+	if b != nil {
+		rsu.SetDueSoonEnabled(*b)
+	}
+	return rsu
+}
+
+// SetDueSoonHours sets the "due_soon_hours" field.
+func (rsu *ReminderSettingUpdate) SetDueSoonHours(i int) *ReminderSettingUpdate {
+	rsu.mutation.ResetDueSoonHours()
+	rsu.mutation.SetDueSoonHours(i)
+	return rsu
+}
+
+// SetNillableDueSoonHours sets the "due_soon_hours" field if the given value is not nil.
+func (rsu *ReminderSettingUpdate) SetNillableDueSoonHours(i *int) *ReminderSettingUpdate {
+	if i != nil {
+		rsu.SetDueSoonHours(*i)
+	}
+	return rsu
+}
+
+// AddDueSoonHours adds i to the "due_soon_hours" field.
+func (rsu *ReminderSettingUpdate) AddDueSoonHours(i int) *ReminderSettingUpdate {
+	rsu.mutation.AddDueSoonHours(i)
+	return rsu
+}
+
+// SetOverdueEnabled sets the "overdue_enabled" field.
+func (rsu *ReminderSettingUpdate) SetOverdueEnabled(b bool) *ReminderSettingUpdate {
+	rsu.mutation.SetOverdueEnabled(b)
+	return rsu
+}
+
+// SetNillableOverdueEnabled sets the "overdue_enabled" field if the given value is not nil.
+func (rsu *ReminderSettingUpdate) SetNillableOverdueEnabled(b *bool) *ReminderSettingUpdate {
+	if b != nil {
+		rsu.SetOverdueEnabled(*b)
+	}
+	return rsu
+}
+
+// SetOverdueRepeatHours sets the "overdue_repeat_hours" field.
+func (rsu *ReminderSettingUpdate) SetOverdueRepeatHours(i int) *ReminderSettingUpdate {
+	rsu.mutation.ResetOverdueRepeatHours()
+	rsu.mutation.SetOverdueRepeatHours(i)
+	return rsu
+}
+
+// SetNillableOverdueRepeatHours sets the "overdue_repeat_hours" field if the given value is not nil.
+func (rsu *ReminderSettingUpdate) SetNillableOverdueRepeatHours(i *int) *ReminderSettingUpdate {
+	if i != nil {
+		rsu.SetOverdueRepeatHours(*i)
+	}
+	return rsu
+}
+
+// AddOverdueRepeatHours adds i to the "overdue_repeat_hours" field.
+func (rsu *ReminderSettingUpdate) AddOverdueRepeatHours(i int) *ReminderSettingUpdate {
+	rsu.mutation.AddOverdueRepeatHours(i)
+	return rsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsu *ReminderSettingUpdate) SetUpdatedAt(t time.Time) *ReminderSettingUpdate {
+	rsu.mutation.SetUpdatedAt(t)
+	return rsu
+}
+
+// Mutation returns the ReminderSettingMutation object of the builder.
+func (rsu *ReminderSettingUpdate) Mutation() *ReminderSettingMutation {
+	return rsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rsu *ReminderSettingUpdate) Save(ctx context.Context) (int, error) {
+	rsu.defaults()
+	return withHooks(ctx, rsu.sqlSave, rsu.mutation, rsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsu *ReminderSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := rsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rsu *ReminderSettingUpdate) Exec(ctx context.Context) error {
+	_, err := rsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsu *ReminderSettingUpdate) ExecX(ctx context.Context) {
+	if err := rsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsu *ReminderSettingUpdate) defaults() {
+	if _, ok := rsu.mutation.UpdatedAt(); !ok {
+		v := remindersetting.UpdateDefaultUpdatedAt()
+		rsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsu *ReminderSettingUpdate) check() error {
+	if v, ok := rsu.mutation.DueSoonHours(); ok {
+		if err := remindersetting.DueSoonHoursValidator(v); err != nil {
+			return &ValidationError{Name: "due_soon_hours", err: fmt.Errorf(`ent: validator failed for field "ReminderSetting.due_soon_hours": %w`, err)}
+		}
+	}
+	if v, ok := rsu.mutation.OverdueRepeatHours(); ok {
+		if err := remindersetting.OverdueRepeatHoursValidator(v); err != nil {
+			return &ValidationError{Name: "overdue_repeat_hours", err: fmt.Errorf(`ent: validator failed for field "ReminderSetting.overdue_repeat_hours": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rsu *ReminderSettingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(remindersetting.Table, remindersetting.Columns, sqlgraph.NewFieldSpec(remindersetting.FieldID, field.TypeInt))
+	if ps := rsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsu.mutation.OrgID(); ok {
+		_spec.SetField(remindersetting.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.AddedOrgID(); ok {
+		_spec.AddField(remindersetting.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.DueSoonEnabled(); ok {
+		_spec.SetField(remindersetting.FieldDueSoonEnabled, field.TypeBool, value)
+	}
+	if value, ok := rsu.mutation.DueSoonHours(); ok {
+		_spec.SetField(remindersetting.FieldDueSoonHours, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.AddedDueSoonHours(); ok {
+		_spec.AddField(remindersetting.FieldDueSoonHours, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.OverdueEnabled(); ok {
+		_spec.SetField(remindersetting.FieldOverdueEnabled, field.TypeBool, value)
+	}
+	if value, ok := rsu.mutation.OverdueRepeatHours(); ok {
+		_spec.SetField(remindersetting.FieldOverdueRepeatHours, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.AddedOverdueRepeatHours(); ok {
+		_spec.AddField(remindersetting.FieldOverdueRepeatHours, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(remindersetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{remindersetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rsu.mutation.done = true
+	return n, nil
+}
+
+// ReminderSettingUpdateOne is the builder for updating a single ReminderSetting entity.
+type ReminderSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReminderSettingMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (rsuo *ReminderSettingUpdateOne) SetOrgID(i int) *ReminderSettingUpdateOne {
+	rsuo.mutation.ResetOrgID()
+	rsuo.mutation.SetOrgID(i)
+	return rsuo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (rsuo *ReminderSettingUpdateOne) SetNillableOrgID(i *int) *ReminderSettingUpdateOne {
+	if i != nil {
+		rsuo.SetOrgID(*i)
+	}
+	return rsuo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (rsuo *ReminderSettingUpdateOne) AddOrgID(i int) *ReminderSettingUpdateOne {
+	rsuo.mutation.AddOrgID(i)
+	return rsuo
+}
+
+// SetDueSoonEnabled sets the "due_soon_enabled" field.
+func (rsuo *ReminderSettingUpdateOne) SetDueSoonEnabled(b bool) *ReminderSettingUpdateOne {
+	rsuo.mutation.SetDueSoonEnabled(b)
+	return rsuo
+}
+
+// SetNillableDueSoonEnabled sets the "due_soon_enabled" field if the given value is not nil.
+func (rsuo *ReminderSettingUpdateOne) SetNillableDueSoonEnabled(b *bool) *ReminderSettingUpdateOne {
+	if b != nil {
+		rsuo.SetDueSoonEnabled(*b)
+	}
+	return rsuo
+}
+
+// SetDueSoonHours sets the "due_soon_hours" field.
+func (rsuo *ReminderSettingUpdateOne) SetDueSoonHours(i int) *ReminderSettingUpdateOne {
+	rsuo.mutation.ResetDueSoonHours()
+	rsuo.mutation.SetDueSoonHours(i)
+	return rsuo
+}
+
+// SetNillableDueSoonHours sets the "due_soon_hours" field if the given value is not nil.
+func (rsuo *ReminderSettingUpdateOne) SetNillableDueSoonHours(i *int) *ReminderSettingUpdateOne {
+	if i != nil {
+		rsuo.SetDueSoonHours(*i)
+	}
+	return rsuo
+}
+
+// AddDueSoonHours adds i to the "due_soon_hours" field.
+func (rsuo *ReminderSettingUpdateOne) AddDueSoonHours(i int) *ReminderSettingUpdateOne {
+	rsuo.mutation.AddDueSoonHours(i)
+	return rsuo
+}
+
+// SetOverdueEnabled sets the "overdue_enabled" field.
+func (rsuo *ReminderSettingUpdateOne) SetOverdueEnabled(b bool) *ReminderSettingUpdateOne {
+	rsuo.mutation.SetOverdueEnabled(b)
+	return rsuo
+}
+
+// SetNillableOverdueEnabled sets the "overdue_enabled" field if the given value is not nil.
+func (rsuo *ReminderSettingUpdateOne) SetNillableOverdueEnabled(b *bool) *ReminderSettingUpdateOne {
+	if b != nil {
+		rsuo.SetOverdueEnabled(*b)
+	}
+	return rsuo
+}
+
+// SetOverdueRepeatHours sets the "overdue_repeat_hours" field.
+func (rsuo *ReminderSettingUpdateOne) SetOverdueRepeatHours(i int) *ReminderSettingUpdateOne {
+	rsuo.mutation.ResetOverdueRepeatHours()
+	rsuo.mutation.SetOverdueRepeatHours(i)
+	return rsuo
+}
+
+// SetNillableOverdueRepeatHours sets the "overdue_repeat_hours" field if the given value is not nil.
+func (rsuo *ReminderSettingUpdateOne) SetNillableOverdueRepeatHours(i *int) *ReminderSettingUpdateOne {
+	if i != nil {
+		rsuo.SetOverdueRepeatHours(*i)
+	}
+	return rsuo
+}
+
+// AddOverdueRepeatHours adds i to the "overdue_repeat_hours" field.
+func (rsuo *ReminderSettingUpdateOne) AddOverdueRepeatHours(i int) *ReminderSettingUpdateOne {
+	rsuo.mutation.AddOverdueRepeatHours(i)
+	return rsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsuo *ReminderSettingUpdateOne) SetUpdatedAt(t time.Time) *ReminderSettingUpdateOne {
+	rsuo.mutation.SetUpdatedAt(t)
+	return rsuo
+}
+
+// Mutation returns the ReminderSettingMutation object of the builder.
+func (rsuo *ReminderSettingUpdateOne) Mutation() *ReminderSettingMutation {
+	return rsuo.mutation
+}
+
+// Where appends a list predicates to the ReminderSettingUpdate builder.
+func (rsuo *ReminderSettingUpdateOne) Where(ps ...predicate.ReminderSetting) *ReminderSettingUpdateOne {
+	rsuo.mutation.Where(ps...)
+	return rsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rsuo *ReminderSettingUpdateOne) Select(field string, fields ...string) *ReminderSettingUpdateOne {
+	rsuo.fields = append([]string{field}, fields...)
+	return rsuo
+}
+
+// Save executes the query and returns the updated ReminderSetting entity.
+func (rsuo *ReminderSettingUpdateOne) Save(ctx context.Context) (*ReminderSetting, error) {
+	rsuo.defaults()
+	return withHooks(ctx, rsuo.sqlSave, rsuo.mutation, rsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsuo *ReminderSettingUpdateOne) SaveX(ctx context.Context) *ReminderSetting {
+	node, err := rsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rsuo *ReminderSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsuo *ReminderSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := rsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsuo *ReminderSettingUpdateOne) defaults() {
+	if _, ok := rsuo.mutation.UpdatedAt(); !ok {
+		v := remindersetting.UpdateDefaultUpdatedAt()
+		rsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsuo *ReminderSettingUpdateOne) check() error {
+	if v, ok := rsuo.mutation.DueSoonHours(); ok {
+		if err := remindersetting.DueSoonHoursValidator(v); err != nil {
+			return &ValidationError{Name: "due_soon_hours", err: fmt.Errorf(`ent: validator failed for field "ReminderSetting.due_soon_hours": %w`, err)}
+		}
+	}
+	if v, ok := rsuo.mutation.OverdueRepeatHours(); ok {
+		if err := remindersetting.OverdueRepeatHoursValidator(v); err != nil {
+			return &ValidationError{Name: "overdue_repeat_hours", err: fmt.Errorf(`ent: validator failed for field "ReminderSetting.overdue_repeat_hours": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rsuo *ReminderSettingUpdateOne) sqlSave(ctx context.Context) (_node *ReminderSetting, err error) {
+	if err := rsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(remindersetting.Table, remindersetting.Columns, sqlgraph.NewFieldSpec(remindersetting.FieldID, field.TypeInt))
+	id, ok := rsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReminderSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, remindersetting.FieldID)
+		for _, f := range fields {
+			if !remindersetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != remindersetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsuo.mutation.OrgID(); ok {
+		_spec.SetField(remindersetting.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.AddedOrgID(); ok {
+		_spec.AddField(remindersetting.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.DueSoonEnabled(); ok {
+		_spec.SetField(remindersetting.FieldDueSoonEnabled, field.TypeBool, value)
+	}
+	if value, ok := rsuo.mutation.DueSoonHours(); ok {
+		_spec.SetField(remindersetting.FieldDueSoonHours, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.AddedDueSoonHours(); ok {
+		_spec.AddField(remindersetting.FieldDueSoonHours, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.OverdueEnabled(); ok {
+		_spec.SetField(remindersetting.FieldOverdueEnabled, field.TypeBool, value)
+	}
+	if value, ok := rsuo.mutation.OverdueRepeatHours(); ok {
+		_spec.SetField(remindersetting.FieldOverdueRepeatHours, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.AddedOverdueRepeatHours(); ok {
+		_spec.AddField(remindersetting.FieldOverdueRepeatHours, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(remindersetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ReminderSetting{config: rsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{remindersetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rsuo.mutation.done = true
+	return _node, nil
+}
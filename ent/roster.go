@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+)
+
+// Roster is the model entity for the Roster schema.
+type Roster struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// ShiftID holds the value of the "shift_id" field.
+	ShiftID int `json:"shift_id"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// WorkDate holds the value of the "work_date" field.
+	WorkDate time.Time `json:"work_date"`
+	// Note holds the value of the "note" field.
+	Note *string `json:"note"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy *int `json:"created_by"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RosterQuery when eager-loading is set.
+	Edges        RosterEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RosterEdges holds the relations/edges for other nodes in the graph.
+type RosterEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee"`
+	// Shift holds the value of the shift edge.
+	Shift *Shift `json:"shift"`
+	// SwapRequests holds the value of the swap_requests edge.
+	SwapRequests []*ShiftSwapRequest `json:"swap_requests"`
+	// TargetedSwapRequests holds the value of the targeted_swap_requests edge.
+	TargetedSwapRequests []*ShiftSwapRequest `json:"targeted_swap_requests"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RosterEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// ShiftOrErr returns the Shift value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RosterEdges) ShiftOrErr() (*Shift, error) {
+	if e.Shift != nil {
+		return e.Shift, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: shift.Label}
+	}
+	return nil, &NotLoadedError{edge: "shift"}
+}
+
+// SwapRequestsOrErr returns the SwapRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e RosterEdges) SwapRequestsOrErr() ([]*ShiftSwapRequest, error) {
+	if e.loadedTypes[2] {
+		return e.SwapRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "swap_requests"}
+}
+
+// TargetedSwapRequestsOrErr returns the TargetedSwapRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e RosterEdges) TargetedSwapRequestsOrErr() ([]*ShiftSwapRequest, error) {
+	if e.loadedTypes[3] {
+		return e.TargetedSwapRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "targeted_swap_requests"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Roster) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roster.FieldID, roster.FieldEmployeeID, roster.FieldShiftID, roster.FieldOrgID, roster.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case roster.FieldNote:
+			values[i] = new(sql.NullString)
+		case roster.FieldWorkDate, roster.FieldCreatedAt, roster.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Roster fields.
+func (r *Roster) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roster.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case roster.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				r.EmployeeID = int(value.Int64)
+			}
+		case roster.FieldShiftID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shift_id", values[i])
+			} else if value.Valid {
+				r.ShiftID = int(value.Int64)
+			}
+		case roster.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				r.OrgID = int(value.Int64)
+			}
+		case roster.FieldWorkDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field work_date", values[i])
+			} else if value.Valid {
+				r.WorkDate = value.Time
+			}
+		case roster.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				r.Note = new(string)
+				*r.Note = value.String
+			}
+		case roster.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				r.CreatedBy = new(int)
+				*r.CreatedBy = int(value.Int64)
+			}
+		case roster.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case roster.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Roster.
+// This includes values selected through modifiers, order, etc.
+func (r *Roster) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the Roster entity.
+func (r *Roster) QueryEmployee() *EmployeeQuery {
+	return NewRosterClient(r.config).QueryEmployee(r)
+}
+
+// QueryShift queries the "shift" edge of the Roster entity.
+func (r *Roster) QueryShift() *ShiftQuery {
+	return NewRosterClient(r.config).QueryShift(r)
+}
+
+// QuerySwapRequests queries the "swap_requests" edge of the Roster entity.
+func (r *Roster) QuerySwapRequests() *ShiftSwapRequestQuery {
+	return NewRosterClient(r.config).QuerySwapRequests(r)
+}
+
+// QueryTargetedSwapRequests queries the "targeted_swap_requests" edge of the Roster entity.
+func (r *Roster) QueryTargetedSwapRequests() *ShiftSwapRequestQuery {
+	return NewRosterClient(r.config).QueryTargetedSwapRequests(r)
+}
+
+// Update returns a builder for updating this Roster.
+// Note that you need to call Roster.Unwrap() before calling this method if this Roster
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Roster) Update() *RosterUpdateOne {
+	return NewRosterClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Roster entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Roster) Unwrap() *Roster {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Roster is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Roster) String() string {
+	var builder strings.Builder
+	builder.WriteString("Roster(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("shift_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ShiftID))
+	builder.WriteString(", ")
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("work_date=")
+	builder.WriteString(r.WorkDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := r.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := r.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Rosters is a parsable slice of Roster.
+type Rosters []*Roster
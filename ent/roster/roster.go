@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roster
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the roster type in the database.
+	Label = "roster"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmployeeID holds the string denoting the employee_id field in the database.
+	FieldEmployeeID = "employee_id"
+	// FieldShiftID holds the string denoting the shift_id field in the database.
+	FieldShiftID = "shift_id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldWorkDate holds the string denoting the work_date field in the database.
+	FieldWorkDate = "work_date"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeEmployee holds the string denoting the employee edge name in mutations.
+	EdgeEmployee = "employee"
+	// EdgeShift holds the string denoting the shift edge name in mutations.
+	EdgeShift = "shift"
+	// EdgeSwapRequests holds the string denoting the swap_requests edge name in mutations.
+	EdgeSwapRequests = "swap_requests"
+	// EdgeTargetedSwapRequests holds the string denoting the targeted_swap_requests edge name in mutations.
+	EdgeTargetedSwapRequests = "targeted_swap_requests"
+	// Table holds the table name of the roster in the database.
+	Table = "rosters"
+	// EmployeeTable is the table that holds the employee relation/edge.
+	EmployeeTable = "rosters"
+	// EmployeeInverseTable is the table name for the Employee entity.
+	// It exists in this package in order to avoid circular dependency with the "employee" package.
+	EmployeeInverseTable = "employees"
+	// EmployeeColumn is the table column denoting the employee relation/edge.
+	EmployeeColumn = "employee_id"
+	// ShiftTable is the table that holds the shift relation/edge.
+	ShiftTable = "rosters"
+	// ShiftInverseTable is the table name for the Shift entity.
+	// It exists in this package in order to avoid circular dependency with the "shift" package.
+	ShiftInverseTable = "shifts"
+	// ShiftColumn is the table column denoting the shift relation/edge.
+	ShiftColumn = "shift_id"
+	// SwapRequestsTable is the table that holds the swap_requests relation/edge.
+	SwapRequestsTable = "shift_swap_requests"
+	// SwapRequestsInverseTable is the table name for the ShiftSwapRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "shiftswaprequest" package.
+	SwapRequestsInverseTable = "shift_swap_requests"
+	// SwapRequestsColumn is the table column denoting the swap_requests relation/edge.
+	SwapRequestsColumn = "roster_id"
+	// TargetedSwapRequestsTable is the table that holds the targeted_swap_requests relation/edge.
+	TargetedSwapRequestsTable = "shift_swap_requests"
+	// TargetedSwapRequestsInverseTable is the table name for the ShiftSwapRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "shiftswaprequest" package.
+	TargetedSwapRequestsInverseTable = "shift_swap_requests"
+	// TargetedSwapRequestsColumn is the table column denoting the targeted_swap_requests relation/edge.
+	TargetedSwapRequestsColumn = "target_roster_id"
+)
+
+// Columns holds all SQL columns for roster fields.
+var Columns = []string{
+	FieldID,
+	FieldEmployeeID,
+	FieldShiftID,
+	FieldOrgID,
+	FieldWorkDate,
+	FieldNote,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Roster queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEmployeeID orders the results by the employee_id field.
+func ByEmployeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmployeeID, opts...).ToFunc()
+}
+
+// ByShiftID orders the results by the shift_id field.
+func ByShiftID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShiftID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// ByWorkDate orders the results by the work_date field.
+func ByWorkDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkDate, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByEmployeeField orders the results by employee field.
+func ByEmployeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmployeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByShiftField orders the results by shift field.
+func ByShiftField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShiftStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySwapRequestsCount orders the results by swap_requests count.
+func BySwapRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSwapRequestsStep(), opts...)
+	}
+}
+
+// BySwapRequests orders the results by swap_requests terms.
+func BySwapRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSwapRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTargetedSwapRequestsCount orders the results by targeted_swap_requests count.
+func ByTargetedSwapRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTargetedSwapRequestsStep(), opts...)
+	}
+}
+
+// ByTargetedSwapRequests orders the results by targeted_swap_requests terms.
+func ByTargetedSwapRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTargetedSwapRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newEmployeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmployeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EmployeeTable, EmployeeColumn),
+	)
+}
+func newShiftStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShiftInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ShiftTable, ShiftColumn),
+	)
+}
+func newSwapRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SwapRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SwapRequestsTable, SwapRequestsColumn),
+	)
+}
+func newTargetedSwapRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TargetedSwapRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TargetedSwapRequestsTable, TargetedSwapRequestsColumn),
+	)
+}
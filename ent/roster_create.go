@@ -0,0 +1,1053 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+)
+
+// RosterCreate is the builder for creating a Roster entity.
+type RosterCreate struct {
+	config
+	mutation *RosterMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (rc *RosterCreate) SetEmployeeID(i int) *RosterCreate {
+	rc.mutation.SetEmployeeID(i)
+	return rc
+}
+
+// SetShiftID sets the "shift_id" field.
+func (rc *RosterCreate) SetShiftID(i int) *RosterCreate {
+	rc.mutation.SetShiftID(i)
+	return rc
+}
+
+// SetOrgID sets the "org_id" field.
+func (rc *RosterCreate) SetOrgID(i int) *RosterCreate {
+	rc.mutation.SetOrgID(i)
+	return rc
+}
+
+// SetWorkDate sets the "work_date" field.
+func (rc *RosterCreate) SetWorkDate(t time.Time) *RosterCreate {
+	rc.mutation.SetWorkDate(t)
+	return rc
+}
+
+// SetNote sets the "note" field.
+func (rc *RosterCreate) SetNote(s string) *RosterCreate {
+	rc.mutation.SetNote(s)
+	return rc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (rc *RosterCreate) SetNillableNote(s *string) *RosterCreate {
+	if s != nil {
+		rc.SetNote(*s)
+	}
+	return rc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rc *RosterCreate) SetCreatedBy(i int) *RosterCreate {
+	rc.mutation.SetCreatedBy(i)
+	return rc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rc *RosterCreate) SetNillableCreatedBy(i *int) *RosterCreate {
+	if i != nil {
+		rc.SetCreatedBy(*i)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *RosterCreate) SetCreatedAt(t time.Time) *RosterCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *RosterCreate) SetNillableCreatedAt(t *time.Time) *RosterCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *RosterCreate) SetUpdatedAt(t time.Time) *RosterCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *RosterCreate) SetNillableUpdatedAt(t *time.Time) *RosterCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (rc *RosterCreate) SetEmployee(e *Employee) *RosterCreate {
+	return rc.SetEmployeeID(e.ID)
+}
+
+// SetShift sets the "shift" edge to the Shift entity.
+func (rc *RosterCreate) SetShift(s *Shift) *RosterCreate {
+	return rc.SetShiftID(s.ID)
+}
+
+// AddSwapRequestIDs adds the "swap_requests" edge to the ShiftSwapRequest entity by IDs.
+func (rc *RosterCreate) AddSwapRequestIDs(ids ...int) *RosterCreate {
+	rc.mutation.AddSwapRequestIDs(ids...)
+	return rc
+}
+
+// AddSwapRequests adds the "swap_requests" edges to the ShiftSwapRequest entity.
+func (rc *RosterCreate) AddSwapRequests(s ...*ShiftSwapRequest) *RosterCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rc.AddSwapRequestIDs(ids...)
+}
+
+// AddTargetedSwapRequestIDs adds the "targeted_swap_requests" edge to the ShiftSwapRequest entity by IDs.
+func (rc *RosterCreate) AddTargetedSwapRequestIDs(ids ...int) *RosterCreate {
+	rc.mutation.AddTargetedSwapRequestIDs(ids...)
+	return rc
+}
+
+// AddTargetedSwapRequests adds the "targeted_swap_requests" edges to the ShiftSwapRequest entity.
+func (rc *RosterCreate) AddTargetedSwapRequests(s ...*ShiftSwapRequest) *RosterCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rc.AddTargetedSwapRequestIDs(ids...)
+}
+
+// Mutation returns the RosterMutation object of the builder.
+func (rc *RosterCreate) Mutation() *RosterMutation {
+	return rc.mutation
+}
+
+// Save creates the Roster in the database.
+func (rc *RosterCreate) Save(ctx context.Context) (*Roster, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RosterCreate) SaveX(ctx context.Context) *Roster {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RosterCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RosterCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *RosterCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := roster.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := roster.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RosterCreate) check() error {
+	if _, ok := rc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "Roster.employee_id"`)}
+	}
+	if _, ok := rc.mutation.ShiftID(); !ok {
+		return &ValidationError{Name: "shift_id", err: errors.New(`ent: missing required field "Roster.shift_id"`)}
+	}
+	if _, ok := rc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "Roster.org_id"`)}
+	}
+	if _, ok := rc.mutation.WorkDate(); !ok {
+		return &ValidationError{Name: "work_date", err: errors.New(`ent: missing required field "Roster.work_date"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Roster.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Roster.updated_at"`)}
+	}
+	if len(rc.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "Roster.employee"`)}
+	}
+	if len(rc.mutation.ShiftIDs()) == 0 {
+		return &ValidationError{Name: "shift", err: errors.New(`ent: missing required edge "Roster.shift"`)}
+	}
+	return nil
+}
+
+func (rc *RosterCreate) sqlSave(ctx context.Context) (*Roster, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RosterCreate) createSpec() (*Roster, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Roster{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(roster.Table, sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rc.conflict
+	if value, ok := rc.mutation.OrgID(); ok {
+		_spec.SetField(roster.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := rc.mutation.WorkDate(); ok {
+		_spec.SetField(roster.FieldWorkDate, field.TypeTime, value)
+		_node.WorkDate = value
+	}
+	if value, ok := rc.mutation.Note(); ok {
+		_spec.SetField(roster.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if value, ok := rc.mutation.CreatedBy(); ok {
+		_spec.SetField(roster.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(roster.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(roster.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := rc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.EmployeeTable,
+			Columns: []string{roster.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.ShiftTable,
+			Columns: []string{roster.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShiftID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.SwapRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.SwapRequestsTable,
+			Columns: []string{roster.SwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.TargetedSwapRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.TargetedSwapRequestsTable,
+			Columns: []string{roster.TargetedSwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Roster.Create().
+//		SetEmployeeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RosterUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *RosterCreate) OnConflict(opts ...sql.ConflictOption) *RosterUpsertOne {
+	rc.conflict = opts
+	return &RosterUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Roster.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *RosterCreate) OnConflictColumns(columns ...string) *RosterUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &RosterUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// RosterUpsertOne is the builder for "upsert"-ing
+	//  one Roster node.
+	RosterUpsertOne struct {
+		create *RosterCreate
+	}
+
+	// RosterUpsert is the "OnConflict" setter.
+	RosterUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *RosterUpsert) SetEmployeeID(v int) *RosterUpsert {
+	u.Set(roster.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *RosterUpsert) UpdateEmployeeID() *RosterUpsert {
+	u.SetExcluded(roster.FieldEmployeeID)
+	return u
+}
+
+// SetShiftID sets the "shift_id" field.
+func (u *RosterUpsert) SetShiftID(v int) *RosterUpsert {
+	u.Set(roster.FieldShiftID, v)
+	return u
+}
+
+// UpdateShiftID sets the "shift_id" field to the value that was provided on create.
+func (u *RosterUpsert) UpdateShiftID() *RosterUpsert {
+	u.SetExcluded(roster.FieldShiftID)
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *RosterUpsert) SetOrgID(v int) *RosterUpsert {
+	u.Set(roster.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *RosterUpsert) UpdateOrgID() *RosterUpsert {
+	u.SetExcluded(roster.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *RosterUpsert) AddOrgID(v int) *RosterUpsert {
+	u.Add(roster.FieldOrgID, v)
+	return u
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *RosterUpsert) SetWorkDate(v time.Time) *RosterUpsert {
+	u.Set(roster.FieldWorkDate, v)
+	return u
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *RosterUpsert) UpdateWorkDate() *RosterUpsert {
+	u.SetExcluded(roster.FieldWorkDate)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *RosterUpsert) SetNote(v string) *RosterUpsert {
+	u.Set(roster.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *RosterUpsert) UpdateNote() *RosterUpsert {
+	u.SetExcluded(roster.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *RosterUpsert) ClearNote() *RosterUpsert {
+	u.SetNull(roster.FieldNote)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RosterUpsert) SetCreatedBy(v int) *RosterUpsert {
+	u.Set(roster.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RosterUpsert) UpdateCreatedBy() *RosterUpsert {
+	u.SetExcluded(roster.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RosterUpsert) AddCreatedBy(v int) *RosterUpsert {
+	u.Add(roster.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RosterUpsert) ClearCreatedBy() *RosterUpsert {
+	u.SetNull(roster.FieldCreatedBy)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *RosterUpsert) SetCreatedAt(v time.Time) *RosterUpsert {
+	u.Set(roster.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *RosterUpsert) UpdateCreatedAt() *RosterUpsert {
+	u.SetExcluded(roster.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RosterUpsert) SetUpdatedAt(v time.Time) *RosterUpsert {
+	u.Set(roster.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RosterUpsert) UpdateUpdatedAt() *RosterUpsert {
+	u.SetExcluded(roster.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Roster.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RosterUpsertOne) UpdateNewValues() *RosterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Roster.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RosterUpsertOne) Ignore() *RosterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RosterUpsertOne) DoNothing() *RosterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RosterCreate.OnConflict
+// documentation for more info.
+func (u *RosterUpsertOne) Update(set func(*RosterUpsert)) *RosterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RosterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *RosterUpsertOne) SetEmployeeID(v int) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *RosterUpsertOne) UpdateEmployeeID() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetShiftID sets the "shift_id" field.
+func (u *RosterUpsertOne) SetShiftID(v int) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetShiftID(v)
+	})
+}
+
+// UpdateShiftID sets the "shift_id" field to the value that was provided on create.
+func (u *RosterUpsertOne) UpdateShiftID() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateShiftID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *RosterUpsertOne) SetOrgID(v int) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *RosterUpsertOne) AddOrgID(v int) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *RosterUpsertOne) UpdateOrgID() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *RosterUpsertOne) SetWorkDate(v time.Time) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetWorkDate(v)
+	})
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *RosterUpsertOne) UpdateWorkDate() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateWorkDate()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *RosterUpsertOne) SetNote(v string) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *RosterUpsertOne) UpdateNote() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *RosterUpsertOne) ClearNote() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RosterUpsertOne) SetCreatedBy(v int) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RosterUpsertOne) AddCreatedBy(v int) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RosterUpsertOne) UpdateCreatedBy() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RosterUpsertOne) ClearCreatedBy() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *RosterUpsertOne) SetCreatedAt(v time.Time) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *RosterUpsertOne) UpdateCreatedAt() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RosterUpsertOne) SetUpdatedAt(v time.Time) *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RosterUpsertOne) UpdateUpdatedAt() *RosterUpsertOne {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RosterUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RosterCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RosterUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RosterUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RosterUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RosterCreateBulk is the builder for creating many Roster entities in bulk.
+type RosterCreateBulk struct {
+	config
+	err      error
+	builders []*RosterCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Roster entities in the database.
+func (rcb *RosterCreateBulk) Save(ctx context.Context) ([]*Roster, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Roster, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RosterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RosterCreateBulk) SaveX(ctx context.Context) []*Roster {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RosterCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RosterCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Roster.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RosterUpsert) {
+//			SetEmployeeID(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *RosterCreateBulk) OnConflict(opts ...sql.ConflictOption) *RosterUpsertBulk {
+	rcb.conflict = opts
+	return &RosterUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Roster.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *RosterCreateBulk) OnConflictColumns(columns ...string) *RosterUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &RosterUpsertBulk{
+		create: rcb,
+	}
+}
+
+// RosterUpsertBulk is the builder for "upsert"-ing
+// a bulk of Roster nodes.
+type RosterUpsertBulk struct {
+	create *RosterCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Roster.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RosterUpsertBulk) UpdateNewValues() *RosterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Roster.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RosterUpsertBulk) Ignore() *RosterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RosterUpsertBulk) DoNothing() *RosterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RosterCreateBulk.OnConflict
+// documentation for more info.
+func (u *RosterUpsertBulk) Update(set func(*RosterUpsert)) *RosterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RosterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *RosterUpsertBulk) SetEmployeeID(v int) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *RosterUpsertBulk) UpdateEmployeeID() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetShiftID sets the "shift_id" field.
+func (u *RosterUpsertBulk) SetShiftID(v int) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetShiftID(v)
+	})
+}
+
+// UpdateShiftID sets the "shift_id" field to the value that was provided on create.
+func (u *RosterUpsertBulk) UpdateShiftID() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateShiftID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *RosterUpsertBulk) SetOrgID(v int) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *RosterUpsertBulk) AddOrgID(v int) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *RosterUpsertBulk) UpdateOrgID() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetWorkDate sets the "work_date" field.
+func (u *RosterUpsertBulk) SetWorkDate(v time.Time) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetWorkDate(v)
+	})
+}
+
+// UpdateWorkDate sets the "work_date" field to the value that was provided on create.
+func (u *RosterUpsertBulk) UpdateWorkDate() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateWorkDate()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *RosterUpsertBulk) SetNote(v string) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *RosterUpsertBulk) UpdateNote() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *RosterUpsertBulk) ClearNote() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *RosterUpsertBulk) SetCreatedBy(v int) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *RosterUpsertBulk) AddCreatedBy(v int) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *RosterUpsertBulk) UpdateCreatedBy() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *RosterUpsertBulk) ClearCreatedBy() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *RosterUpsertBulk) SetCreatedAt(v time.Time) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *RosterUpsertBulk) UpdateCreatedAt() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RosterUpsertBulk) SetUpdatedAt(v time.Time) *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RosterUpsertBulk) UpdateUpdatedAt() *RosterUpsertBulk {
+	return u.Update(func(s *RosterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RosterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RosterCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RosterCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RosterUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
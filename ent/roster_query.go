@@ -0,0 +1,831 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+)
+
+// RosterQuery is the builder for querying Roster entities.
+type RosterQuery struct {
+	config
+	ctx                      *QueryContext
+	order                    []roster.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Roster
+	withEmployee             *EmployeeQuery
+	withShift                *ShiftQuery
+	withSwapRequests         *ShiftSwapRequestQuery
+	withTargetedSwapRequests *ShiftSwapRequestQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RosterQuery builder.
+func (rq *RosterQuery) Where(ps ...predicate.Roster) *RosterQuery {
+	rq.predicates = append(rq.predicates, ps...)
+	return rq
+}
+
+// Limit the number of records to be returned by this query.
+func (rq *RosterQuery) Limit(limit int) *RosterQuery {
+	rq.ctx.Limit = &limit
+	return rq
+}
+
+// Offset to start from.
+func (rq *RosterQuery) Offset(offset int) *RosterQuery {
+	rq.ctx.Offset = &offset
+	return rq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rq *RosterQuery) Unique(unique bool) *RosterQuery {
+	rq.ctx.Unique = &unique
+	return rq
+}
+
+// Order specifies how the records should be ordered.
+func (rq *RosterQuery) Order(o ...roster.OrderOption) *RosterQuery {
+	rq.order = append(rq.order, o...)
+	return rq
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (rq *RosterQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roster.Table, roster.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roster.EmployeeTable, roster.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShift chains the current query on the "shift" edge.
+func (rq *RosterQuery) QueryShift() *ShiftQuery {
+	query := (&ShiftClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roster.Table, roster.FieldID, selector),
+			sqlgraph.To(shift.Table, shift.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roster.ShiftTable, roster.ShiftColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySwapRequests chains the current query on the "swap_requests" edge.
+func (rq *RosterQuery) QuerySwapRequests() *ShiftSwapRequestQuery {
+	query := (&ShiftSwapRequestClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roster.Table, roster.FieldID, selector),
+			sqlgraph.To(shiftswaprequest.Table, shiftswaprequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roster.SwapRequestsTable, roster.SwapRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTargetedSwapRequests chains the current query on the "targeted_swap_requests" edge.
+func (rq *RosterQuery) QueryTargetedSwapRequests() *ShiftSwapRequestQuery {
+	query := (&ShiftSwapRequestClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roster.Table, roster.FieldID, selector),
+			sqlgraph.To(shiftswaprequest.Table, shiftswaprequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roster.TargetedSwapRequestsTable, roster.TargetedSwapRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Roster entity from the query.
+// Returns a *NotFoundError when no Roster was found.
+func (rq *RosterQuery) First(ctx context.Context) (*Roster, error) {
+	nodes, err := rq.Limit(1).All(setContextOp(ctx, rq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{roster.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rq *RosterQuery) FirstX(ctx context.Context) *Roster {
+	node, err := rq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Roster ID from the query.
+// Returns a *NotFoundError when no Roster ID was found.
+func (rq *RosterQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rq.Limit(1).IDs(setContextOp(ctx, rq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{roster.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rq *RosterQuery) FirstIDX(ctx context.Context) int {
+	id, err := rq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Roster entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Roster entity is found.
+// Returns a *NotFoundError when no Roster entities are found.
+func (rq *RosterQuery) Only(ctx context.Context) (*Roster, error) {
+	nodes, err := rq.Limit(2).All(setContextOp(ctx, rq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{roster.Label}
+	default:
+		return nil, &NotSingularError{roster.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rq *RosterQuery) OnlyX(ctx context.Context) *Roster {
+	node, err := rq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Roster ID in the query.
+// Returns a *NotSingularError when more than one Roster ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rq *RosterQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rq.Limit(2).IDs(setContextOp(ctx, rq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{roster.Label}
+	default:
+		err = &NotSingularError{roster.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rq *RosterQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Rosters.
+func (rq *RosterQuery) All(ctx context.Context) ([]*Roster, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryAll)
+	if err := rq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Roster, *RosterQuery]()
+	return withInterceptors[[]*Roster](ctx, rq, qr, rq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rq *RosterQuery) AllX(ctx context.Context) []*Roster {
+	nodes, err := rq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Roster IDs.
+func (rq *RosterQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rq.ctx.Unique == nil && rq.path != nil {
+		rq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryIDs)
+	if err = rq.Select(roster.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rq *RosterQuery) IDsX(ctx context.Context) []int {
+	ids, err := rq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rq *RosterQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryCount)
+	if err := rq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rq, querierCount[*RosterQuery](), rq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rq *RosterQuery) CountX(ctx context.Context) int {
+	count, err := rq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rq *RosterQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryExist)
+	switch _, err := rq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rq *RosterQuery) ExistX(ctx context.Context) bool {
+	exist, err := rq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RosterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rq *RosterQuery) Clone() *RosterQuery {
+	if rq == nil {
+		return nil
+	}
+	return &RosterQuery{
+		config:                   rq.config,
+		ctx:                      rq.ctx.Clone(),
+		order:                    append([]roster.OrderOption{}, rq.order...),
+		inters:                   append([]Interceptor{}, rq.inters...),
+		predicates:               append([]predicate.Roster{}, rq.predicates...),
+		withEmployee:             rq.withEmployee.Clone(),
+		withShift:                rq.withShift.Clone(),
+		withSwapRequests:         rq.withSwapRequests.Clone(),
+		withTargetedSwapRequests: rq.withTargetedSwapRequests.Clone(),
+		// clone intermediate query.
+		sql:  rq.sql.Clone(),
+		path: rq.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RosterQuery) WithEmployee(opts ...func(*EmployeeQuery)) *RosterQuery {
+	query := (&EmployeeClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withEmployee = query
+	return rq
+}
+
+// WithShift tells the query-builder to eager-load the nodes that are connected to
+// the "shift" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RosterQuery) WithShift(opts ...func(*ShiftQuery)) *RosterQuery {
+	query := (&ShiftClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withShift = query
+	return rq
+}
+
+// WithSwapRequests tells the query-builder to eager-load the nodes that are connected to
+// the "swap_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RosterQuery) WithSwapRequests(opts ...func(*ShiftSwapRequestQuery)) *RosterQuery {
+	query := (&ShiftSwapRequestClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withSwapRequests = query
+	return rq
+}
+
+// WithTargetedSwapRequests tells the query-builder to eager-load the nodes that are connected to
+// the "targeted_swap_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RosterQuery) WithTargetedSwapRequests(opts ...func(*ShiftSwapRequestQuery)) *RosterQuery {
+	query := (&ShiftSwapRequestClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withTargetedSwapRequests = query
+	return rq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID int `json:"employee_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Roster.Query().
+//		GroupBy(roster.FieldEmployeeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rq *RosterQuery) GroupBy(field string, fields ...string) *RosterGroupBy {
+	rq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RosterGroupBy{build: rq}
+	grbuild.flds = &rq.ctx.Fields
+	grbuild.label = roster.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID int `json:"employee_id"`
+//	}
+//
+//	client.Roster.Query().
+//		Select(roster.FieldEmployeeID).
+//		Scan(ctx, &v)
+func (rq *RosterQuery) Select(fields ...string) *RosterSelect {
+	rq.ctx.Fields = append(rq.ctx.Fields, fields...)
+	sbuild := &RosterSelect{RosterQuery: rq}
+	sbuild.label = roster.Label
+	sbuild.flds, sbuild.scan = &rq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RosterSelect configured with the given aggregations.
+func (rq *RosterQuery) Aggregate(fns ...AggregateFunc) *RosterSelect {
+	return rq.Select().Aggregate(fns...)
+}
+
+func (rq *RosterQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rq.ctx.Fields {
+		if !roster.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rq.path != nil {
+		prev, err := rq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rq.sql = prev
+	}
+	return nil
+}
+
+func (rq *RosterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Roster, error) {
+	var (
+		nodes       = []*Roster{}
+		_spec       = rq.querySpec()
+		loadedTypes = [4]bool{
+			rq.withEmployee != nil,
+			rq.withShift != nil,
+			rq.withSwapRequests != nil,
+			rq.withTargetedSwapRequests != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Roster).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Roster{config: rq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rq.withEmployee; query != nil {
+		if err := rq.loadEmployee(ctx, query, nodes, nil,
+			func(n *Roster, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withShift; query != nil {
+		if err := rq.loadShift(ctx, query, nodes, nil,
+			func(n *Roster, e *Shift) { n.Edges.Shift = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withSwapRequests; query != nil {
+		if err := rq.loadSwapRequests(ctx, query, nodes,
+			func(n *Roster) { n.Edges.SwapRequests = []*ShiftSwapRequest{} },
+			func(n *Roster, e *ShiftSwapRequest) { n.Edges.SwapRequests = append(n.Edges.SwapRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withTargetedSwapRequests; query != nil {
+		if err := rq.loadTargetedSwapRequests(ctx, query, nodes,
+			func(n *Roster) { n.Edges.TargetedSwapRequests = []*ShiftSwapRequest{} },
+			func(n *Roster, e *ShiftSwapRequest) {
+				n.Edges.TargetedSwapRequests = append(n.Edges.TargetedSwapRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rq *RosterQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*Roster, init func(*Roster), assign func(*Roster, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Roster)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rq *RosterQuery) loadShift(ctx context.Context, query *ShiftQuery, nodes []*Roster, init func(*Roster), assign func(*Roster, *Shift)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Roster)
+	for i := range nodes {
+		fk := nodes[i].ShiftID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(shift.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "shift_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rq *RosterQuery) loadSwapRequests(ctx context.Context, query *ShiftSwapRequestQuery, nodes []*Roster, init func(*Roster), assign func(*Roster, *ShiftSwapRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roster)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shiftswaprequest.FieldRosterID)
+	}
+	query.Where(predicate.ShiftSwapRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roster.SwapRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RosterID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "roster_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RosterQuery) loadTargetedSwapRequests(ctx context.Context, query *ShiftSwapRequestQuery, nodes []*Roster, init func(*Roster), assign func(*Roster, *ShiftSwapRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roster)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shiftswaprequest.FieldTargetRosterID)
+	}
+	query.Where(predicate.ShiftSwapRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roster.TargetedSwapRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TargetRosterID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_roster_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (rq *RosterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rq.querySpec()
+	_spec.Node.Columns = rq.ctx.Fields
+	if len(rq.ctx.Fields) > 0 {
+		_spec.Unique = rq.ctx.Unique != nil && *rq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+}
+
+func (rq *RosterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(roster.Table, roster.Columns, sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt))
+	_spec.From = rq.sql
+	if unique := rq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roster.FieldID)
+		for i := range fields {
+			if fields[i] != roster.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(roster.FieldEmployeeID)
+		}
+		if rq.withShift != nil {
+			_spec.Node.AddColumnOnce(roster.FieldShiftID)
+		}
+	}
+	if ps := rq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rq *RosterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rq.driver.Dialect())
+	t1 := builder.Table(roster.Table)
+	columns := rq.ctx.Fields
+	if len(columns) == 0 {
+		columns = roster.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rq.sql != nil {
+		selector = rq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rq.ctx.Unique != nil && *rq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rq.predicates {
+		p(selector)
+	}
+	for _, p := range rq.order {
+		p(selector)
+	}
+	if offset := rq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RosterGroupBy is the group-by builder for Roster entities.
+type RosterGroupBy struct {
+	selector
+	build *RosterQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rgb *RosterGroupBy) Aggregate(fns ...AggregateFunc) *RosterGroupBy {
+	rgb.fns = append(rgb.fns, fns...)
+	return rgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rgb *RosterGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RosterQuery, *RosterGroupBy](ctx, rgb.build, rgb, rgb.build.inters, v)
+}
+
+func (rgb *RosterGroupBy) sqlScan(ctx context.Context, root *RosterQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rgb.fns))
+	for _, fn := range rgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rgb.flds)+len(rgb.fns))
+		for _, f := range *rgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RosterSelect is the builder for selecting fields of Roster entities.
+type RosterSelect struct {
+	*RosterQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rs *RosterSelect) Aggregate(fns ...AggregateFunc) *RosterSelect {
+	rs.fns = append(rs.fns, fns...)
+	return rs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rs *RosterSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rs.ctx, ent.OpQuerySelect)
+	if err := rs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RosterQuery, *RosterSelect](ctx, rs.RosterQuery, rs, rs.inters, v)
+}
+
+func (rs *RosterSelect) sqlScan(ctx context.Context, root *RosterQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rs.fns))
+	for _, fn := range rs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
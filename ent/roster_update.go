@@ -0,0 +1,1029 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+)
+
+// RosterUpdate is the builder for updating Roster entities.
+type RosterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RosterMutation
+}
+
+// Where appends a list predicates to the RosterUpdate builder.
+func (ru *RosterUpdate) Where(ps ...predicate.Roster) *RosterUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (ru *RosterUpdate) SetEmployeeID(i int) *RosterUpdate {
+	ru.mutation.SetEmployeeID(i)
+	return ru
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (ru *RosterUpdate) SetNillableEmployeeID(i *int) *RosterUpdate {
+	if i != nil {
+		ru.SetEmployeeID(*i)
+	}
+	return ru
+}
+
+// SetShiftID sets the "shift_id" field.
+func (ru *RosterUpdate) SetShiftID(i int) *RosterUpdate {
+	ru.mutation.SetShiftID(i)
+	return ru
+}
+
+// SetNillableShiftID sets the "shift_id" field if the given value is not nil.
+func (ru *RosterUpdate) SetNillableShiftID(i *int) *RosterUpdate {
+	if i != nil {
+		ru.SetShiftID(*i)
+	}
+	return ru
+}
+
+// SetOrgID sets the "org_id" field.
+func (ru *RosterUpdate) SetOrgID(i int) *RosterUpdate {
+	ru.mutation.ResetOrgID()
+	ru.mutation.SetOrgID(i)
+	return ru
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (ru *RosterUpdate) SetNillableOrgID(i *int) *RosterUpdate {
+	if i != nil {
+		ru.SetOrgID(*i)
+	}
+	return ru
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (ru *RosterUpdate) AddOrgID(i int) *RosterUpdate {
+	ru.mutation.AddOrgID(i)
+	return ru
+}
+
+// SetWorkDate sets the "work_date" field.
+func (ru *RosterUpdate) SetWorkDate(t time.Time) *RosterUpdate {
+	ru.mutation.SetWorkDate(t)
+	return ru
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (ru *RosterUpdate) SetNillableWorkDate(t *time.Time) *RosterUpdate {
+	if t != nil {
+		ru.SetWorkDate(*t)
+	}
+	return ru
+}
+
+// SetNote sets the "note" field.
+func (ru *RosterUpdate) SetNote(s string) *RosterUpdate {
+	ru.mutation.SetNote(s)
+	return ru
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ru *RosterUpdate) SetNillableNote(s *string) *RosterUpdate {
+	if s != nil {
+		ru.SetNote(*s)
+	}
+	return ru
+}
+
+// ClearNote clears the value of the "note" field.
+func (ru *RosterUpdate) ClearNote() *RosterUpdate {
+	ru.mutation.ClearNote()
+	return ru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ru *RosterUpdate) SetCreatedBy(i int) *RosterUpdate {
+	ru.mutation.ResetCreatedBy()
+	ru.mutation.SetCreatedBy(i)
+	return ru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ru *RosterUpdate) SetNillableCreatedBy(i *int) *RosterUpdate {
+	if i != nil {
+		ru.SetCreatedBy(*i)
+	}
+	return ru
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (ru *RosterUpdate) AddCreatedBy(i int) *RosterUpdate {
+	ru.mutation.AddCreatedBy(i)
+	return ru
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (ru *RosterUpdate) ClearCreatedBy() *RosterUpdate {
+	ru.mutation.ClearCreatedBy()
+	return ru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ru *RosterUpdate) SetCreatedAt(t time.Time) *RosterUpdate {
+	ru.mutation.SetCreatedAt(t)
+	return ru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ru *RosterUpdate) SetNillableCreatedAt(t *time.Time) *RosterUpdate {
+	if t != nil {
+		ru.SetCreatedAt(*t)
+	}
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *RosterUpdate) SetUpdatedAt(t time.Time) *RosterUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (ru *RosterUpdate) SetEmployee(e *Employee) *RosterUpdate {
+	return ru.SetEmployeeID(e.ID)
+}
+
+// SetShift sets the "shift" edge to the Shift entity.
+func (ru *RosterUpdate) SetShift(s *Shift) *RosterUpdate {
+	return ru.SetShiftID(s.ID)
+}
+
+// AddSwapRequestIDs adds the "swap_requests" edge to the ShiftSwapRequest entity by IDs.
+func (ru *RosterUpdate) AddSwapRequestIDs(ids ...int) *RosterUpdate {
+	ru.mutation.AddSwapRequestIDs(ids...)
+	return ru
+}
+
+// AddSwapRequests adds the "swap_requests" edges to the ShiftSwapRequest entity.
+func (ru *RosterUpdate) AddSwapRequests(s ...*ShiftSwapRequest) *RosterUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.AddSwapRequestIDs(ids...)
+}
+
+// AddTargetedSwapRequestIDs adds the "targeted_swap_requests" edge to the ShiftSwapRequest entity by IDs.
+func (ru *RosterUpdate) AddTargetedSwapRequestIDs(ids ...int) *RosterUpdate {
+	ru.mutation.AddTargetedSwapRequestIDs(ids...)
+	return ru
+}
+
+// AddTargetedSwapRequests adds the "targeted_swap_requests" edges to the ShiftSwapRequest entity.
+func (ru *RosterUpdate) AddTargetedSwapRequests(s ...*ShiftSwapRequest) *RosterUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.AddTargetedSwapRequestIDs(ids...)
+}
+
+// Mutation returns the RosterMutation object of the builder.
+func (ru *RosterUpdate) Mutation() *RosterMutation {
+	return ru.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (ru *RosterUpdate) ClearEmployee() *RosterUpdate {
+	ru.mutation.ClearEmployee()
+	return ru
+}
+
+// ClearShift clears the "shift" edge to the Shift entity.
+func (ru *RosterUpdate) ClearShift() *RosterUpdate {
+	ru.mutation.ClearShift()
+	return ru
+}
+
+// ClearSwapRequests clears all "swap_requests" edges to the ShiftSwapRequest entity.
+func (ru *RosterUpdate) ClearSwapRequests() *RosterUpdate {
+	ru.mutation.ClearSwapRequests()
+	return ru
+}
+
+// RemoveSwapRequestIDs removes the "swap_requests" edge to ShiftSwapRequest entities by IDs.
+func (ru *RosterUpdate) RemoveSwapRequestIDs(ids ...int) *RosterUpdate {
+	ru.mutation.RemoveSwapRequestIDs(ids...)
+	return ru
+}
+
+// RemoveSwapRequests removes "swap_requests" edges to ShiftSwapRequest entities.
+func (ru *RosterUpdate) RemoveSwapRequests(s ...*ShiftSwapRequest) *RosterUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.RemoveSwapRequestIDs(ids...)
+}
+
+// ClearTargetedSwapRequests clears all "targeted_swap_requests" edges to the ShiftSwapRequest entity.
+func (ru *RosterUpdate) ClearTargetedSwapRequests() *RosterUpdate {
+	ru.mutation.ClearTargetedSwapRequests()
+	return ru
+}
+
+// RemoveTargetedSwapRequestIDs removes the "targeted_swap_requests" edge to ShiftSwapRequest entities by IDs.
+func (ru *RosterUpdate) RemoveTargetedSwapRequestIDs(ids ...int) *RosterUpdate {
+	ru.mutation.RemoveTargetedSwapRequestIDs(ids...)
+	return ru
+}
+
+// RemoveTargetedSwapRequests removes "targeted_swap_requests" edges to ShiftSwapRequest entities.
+func (ru *RosterUpdate) RemoveTargetedSwapRequests(s ...*ShiftSwapRequest) *RosterUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.RemoveTargetedSwapRequestIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RosterUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RosterUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RosterUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RosterUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *RosterUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := roster.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RosterUpdate) check() error {
+	if ru.mutation.EmployeeCleared() && len(ru.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Roster.employee"`)
+	}
+	if ru.mutation.ShiftCleared() && len(ru.mutation.ShiftIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Roster.shift"`)
+	}
+	return nil
+}
+
+func (ru *RosterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roster.Table, roster.Columns, sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.OrgID(); ok {
+		_spec.SetField(roster.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedOrgID(); ok {
+		_spec.AddField(roster.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.WorkDate(); ok {
+		_spec.SetField(roster.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.Note(); ok {
+		_spec.SetField(roster.FieldNote, field.TypeString, value)
+	}
+	if ru.mutation.NoteCleared() {
+		_spec.ClearField(roster.FieldNote, field.TypeString)
+	}
+	if value, ok := ru.mutation.CreatedBy(); ok {
+		_spec.SetField(roster.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(roster.FieldCreatedBy, field.TypeInt, value)
+	}
+	if ru.mutation.CreatedByCleared() {
+		_spec.ClearField(roster.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := ru.mutation.CreatedAt(); ok {
+		_spec.SetField(roster.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(roster.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ru.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.EmployeeTable,
+			Columns: []string{roster.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.EmployeeTable,
+			Columns: []string{roster.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.ShiftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.ShiftTable,
+			Columns: []string{roster.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.ShiftTable,
+			Columns: []string{roster.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.SwapRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.SwapRequestsTable,
+			Columns: []string{roster.SwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedSwapRequestsIDs(); len(nodes) > 0 && !ru.mutation.SwapRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.SwapRequestsTable,
+			Columns: []string{roster.SwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.SwapRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.SwapRequestsTable,
+			Columns: []string{roster.SwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.TargetedSwapRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.TargetedSwapRequestsTable,
+			Columns: []string{roster.TargetedSwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedTargetedSwapRequestsIDs(); len(nodes) > 0 && !ru.mutation.TargetedSwapRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.TargetedSwapRequestsTable,
+			Columns: []string{roster.TargetedSwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.TargetedSwapRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.TargetedSwapRequestsTable,
+			Columns: []string{roster.TargetedSwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roster.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RosterUpdateOne is the builder for updating a single Roster entity.
+type RosterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RosterMutation
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (ruo *RosterUpdateOne) SetEmployeeID(i int) *RosterUpdateOne {
+	ruo.mutation.SetEmployeeID(i)
+	return ruo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (ruo *RosterUpdateOne) SetNillableEmployeeID(i *int) *RosterUpdateOne {
+	if i != nil {
+		ruo.SetEmployeeID(*i)
+	}
+	return ruo
+}
+
+// SetShiftID sets the "shift_id" field.
+func (ruo *RosterUpdateOne) SetShiftID(i int) *RosterUpdateOne {
+	ruo.mutation.SetShiftID(i)
+	return ruo
+}
+
+// SetNillableShiftID sets the "shift_id" field if the given value is not nil.
+func (ruo *RosterUpdateOne) SetNillableShiftID(i *int) *RosterUpdateOne {
+	if i != nil {
+		ruo.SetShiftID(*i)
+	}
+	return ruo
+}
+
+// SetOrgID sets the "org_id" field.
+func (ruo *RosterUpdateOne) SetOrgID(i int) *RosterUpdateOne {
+	ruo.mutation.ResetOrgID()
+	ruo.mutation.SetOrgID(i)
+	return ruo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (ruo *RosterUpdateOne) SetNillableOrgID(i *int) *RosterUpdateOne {
+	if i != nil {
+		ruo.SetOrgID(*i)
+	}
+	return ruo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (ruo *RosterUpdateOne) AddOrgID(i int) *RosterUpdateOne {
+	ruo.mutation.AddOrgID(i)
+	return ruo
+}
+
+// SetWorkDate sets the "work_date" field.
+func (ruo *RosterUpdateOne) SetWorkDate(t time.Time) *RosterUpdateOne {
+	ruo.mutation.SetWorkDate(t)
+	return ruo
+}
+
+// SetNillableWorkDate sets the "work_date" field if the given value is not nil.
+func (ruo *RosterUpdateOne) SetNillableWorkDate(t *time.Time) *RosterUpdateOne {
+	if t != nil {
+		ruo.SetWorkDate(*t)
+	}
+	return ruo
+}
+
+// SetNote sets the "note" field.
+func (ruo *RosterUpdateOne) SetNote(s string) *RosterUpdateOne {
+	ruo.mutation.SetNote(s)
+	return ruo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ruo *RosterUpdateOne) SetNillableNote(s *string) *RosterUpdateOne {
+	if s != nil {
+		ruo.SetNote(*s)
+	}
+	return ruo
+}
+
+// ClearNote clears the value of the "note" field.
+func (ruo *RosterUpdateOne) ClearNote() *RosterUpdateOne {
+	ruo.mutation.ClearNote()
+	return ruo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ruo *RosterUpdateOne) SetCreatedBy(i int) *RosterUpdateOne {
+	ruo.mutation.ResetCreatedBy()
+	ruo.mutation.SetCreatedBy(i)
+	return ruo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ruo *RosterUpdateOne) SetNillableCreatedBy(i *int) *RosterUpdateOne {
+	if i != nil {
+		ruo.SetCreatedBy(*i)
+	}
+	return ruo
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (ruo *RosterUpdateOne) AddCreatedBy(i int) *RosterUpdateOne {
+	ruo.mutation.AddCreatedBy(i)
+	return ruo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (ruo *RosterUpdateOne) ClearCreatedBy() *RosterUpdateOne {
+	ruo.mutation.ClearCreatedBy()
+	return ruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruo *RosterUpdateOne) SetCreatedAt(t time.Time) *RosterUpdateOne {
+	ruo.mutation.SetCreatedAt(t)
+	return ruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruo *RosterUpdateOne) SetNillableCreatedAt(t *time.Time) *RosterUpdateOne {
+	if t != nil {
+		ruo.SetCreatedAt(*t)
+	}
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *RosterUpdateOne) SetUpdatedAt(t time.Time) *RosterUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (ruo *RosterUpdateOne) SetEmployee(e *Employee) *RosterUpdateOne {
+	return ruo.SetEmployeeID(e.ID)
+}
+
+// SetShift sets the "shift" edge to the Shift entity.
+func (ruo *RosterUpdateOne) SetShift(s *Shift) *RosterUpdateOne {
+	return ruo.SetShiftID(s.ID)
+}
+
+// AddSwapRequestIDs adds the "swap_requests" edge to the ShiftSwapRequest entity by IDs.
+func (ruo *RosterUpdateOne) AddSwapRequestIDs(ids ...int) *RosterUpdateOne {
+	ruo.mutation.AddSwapRequestIDs(ids...)
+	return ruo
+}
+
+// AddSwapRequests adds the "swap_requests" edges to the ShiftSwapRequest entity.
+func (ruo *RosterUpdateOne) AddSwapRequests(s ...*ShiftSwapRequest) *RosterUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.AddSwapRequestIDs(ids...)
+}
+
+// AddTargetedSwapRequestIDs adds the "targeted_swap_requests" edge to the ShiftSwapRequest entity by IDs.
+func (ruo *RosterUpdateOne) AddTargetedSwapRequestIDs(ids ...int) *RosterUpdateOne {
+	ruo.mutation.AddTargetedSwapRequestIDs(ids...)
+	return ruo
+}
+
+// AddTargetedSwapRequests adds the "targeted_swap_requests" edges to the ShiftSwapRequest entity.
+func (ruo *RosterUpdateOne) AddTargetedSwapRequests(s ...*ShiftSwapRequest) *RosterUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.AddTargetedSwapRequestIDs(ids...)
+}
+
+// Mutation returns the RosterMutation object of the builder.
+func (ruo *RosterUpdateOne) Mutation() *RosterMutation {
+	return ruo.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (ruo *RosterUpdateOne) ClearEmployee() *RosterUpdateOne {
+	ruo.mutation.ClearEmployee()
+	return ruo
+}
+
+// ClearShift clears the "shift" edge to the Shift entity.
+func (ruo *RosterUpdateOne) ClearShift() *RosterUpdateOne {
+	ruo.mutation.ClearShift()
+	return ruo
+}
+
+// ClearSwapRequests clears all "swap_requests" edges to the ShiftSwapRequest entity.
+func (ruo *RosterUpdateOne) ClearSwapRequests() *RosterUpdateOne {
+	ruo.mutation.ClearSwapRequests()
+	return ruo
+}
+
+// RemoveSwapRequestIDs removes the "swap_requests" edge to ShiftSwapRequest entities by IDs.
+func (ruo *RosterUpdateOne) RemoveSwapRequestIDs(ids ...int) *RosterUpdateOne {
+	ruo.mutation.RemoveSwapRequestIDs(ids...)
+	return ruo
+}
+
+// RemoveSwapRequests removes "swap_requests" edges to ShiftSwapRequest entities.
+func (ruo *RosterUpdateOne) RemoveSwapRequests(s ...*ShiftSwapRequest) *RosterUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.RemoveSwapRequestIDs(ids...)
+}
+
+// ClearTargetedSwapRequests clears all "targeted_swap_requests" edges to the ShiftSwapRequest entity.
+func (ruo *RosterUpdateOne) ClearTargetedSwapRequests() *RosterUpdateOne {
+	ruo.mutation.ClearTargetedSwapRequests()
+	return ruo
+}
+
+// RemoveTargetedSwapRequestIDs removes the "targeted_swap_requests" edge to ShiftSwapRequest entities by IDs.
+func (ruo *RosterUpdateOne) RemoveTargetedSwapRequestIDs(ids ...int) *RosterUpdateOne {
+	ruo.mutation.RemoveTargetedSwapRequestIDs(ids...)
+	return ruo
+}
+
+// RemoveTargetedSwapRequests removes "targeted_swap_requests" edges to ShiftSwapRequest entities.
+func (ruo *RosterUpdateOne) RemoveTargetedSwapRequests(s ...*ShiftSwapRequest) *RosterUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.RemoveTargetedSwapRequestIDs(ids...)
+}
+
+// Where appends a list predicates to the RosterUpdate builder.
+func (ruo *RosterUpdateOne) Where(ps ...predicate.Roster) *RosterUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RosterUpdateOne) Select(field string, fields ...string) *RosterUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Roster entity.
+func (ruo *RosterUpdateOne) Save(ctx context.Context) (*Roster, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RosterUpdateOne) SaveX(ctx context.Context) *Roster {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RosterUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RosterUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *RosterUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := roster.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RosterUpdateOne) check() error {
+	if ruo.mutation.EmployeeCleared() && len(ruo.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Roster.employee"`)
+	}
+	if ruo.mutation.ShiftCleared() && len(ruo.mutation.ShiftIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Roster.shift"`)
+	}
+	return nil
+}
+
+func (ruo *RosterUpdateOne) sqlSave(ctx context.Context) (_node *Roster, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roster.Table, roster.Columns, sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Roster.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roster.FieldID)
+		for _, f := range fields {
+			if !roster.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roster.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.OrgID(); ok {
+		_spec.SetField(roster.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedOrgID(); ok {
+		_spec.AddField(roster.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.WorkDate(); ok {
+		_spec.SetField(roster.FieldWorkDate, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.Note(); ok {
+		_spec.SetField(roster.FieldNote, field.TypeString, value)
+	}
+	if ruo.mutation.NoteCleared() {
+		_spec.ClearField(roster.FieldNote, field.TypeString)
+	}
+	if value, ok := ruo.mutation.CreatedBy(); ok {
+		_spec.SetField(roster.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(roster.FieldCreatedBy, field.TypeInt, value)
+	}
+	if ruo.mutation.CreatedByCleared() {
+		_spec.ClearField(roster.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := ruo.mutation.CreatedAt(); ok {
+		_spec.SetField(roster.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(roster.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.EmployeeTable,
+			Columns: []string{roster.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.EmployeeTable,
+			Columns: []string{roster.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ShiftCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.ShiftTable,
+			Columns: []string{roster.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ShiftIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roster.ShiftTable,
+			Columns: []string{roster.ShiftColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.SwapRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.SwapRequestsTable,
+			Columns: []string{roster.SwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedSwapRequestsIDs(); len(nodes) > 0 && !ruo.mutation.SwapRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.SwapRequestsTable,
+			Columns: []string{roster.SwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.SwapRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.SwapRequestsTable,
+			Columns: []string{roster.SwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.TargetedSwapRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.TargetedSwapRequestsTable,
+			Columns: []string{roster.TargetedSwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedTargetedSwapRequestsIDs(); len(nodes) > 0 && !ruo.mutation.TargetedSwapRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.TargetedSwapRequestsTable,
+			Columns: []string{roster.TargetedSwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.TargetedSwapRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roster.TargetedSwapRequestsTable,
+			Columns: []string{roster.TargetedSwapRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Roster{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roster.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
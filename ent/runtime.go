@@ -6,17 +6,48 @@ import (
 	"time"
 
 	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
 	"github.com/longgggwwww/hrm-ms-hr/ent/department"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaveapproval"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notificationpreference"
 	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/position"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+	"github.com/longgggwwww/hrm-ms-hr/ent/remindersetting"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
 	"github.com/longgggwwww/hrm-ms-hr/ent/schema"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -35,6 +66,58 @@ func init() {
 	appointmenthistory.DefaultUpdatedAt = appointmenthistoryDescUpdatedAt.Default.(func() time.Time)
 	// appointmenthistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	appointmenthistory.UpdateDefaultUpdatedAt = appointmenthistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescFileName is the schema descriptor for file_name field.
+	attachmentDescFileName := attachmentFields[3].Descriptor()
+	// attachment.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	attachment.FileNameValidator = attachmentDescFileName.Validators[0].(func(string) error)
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentFields[10].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	attendancecorrectionFields := schema.AttendanceCorrection{}.Fields()
+	_ = attendancecorrectionFields
+	// attendancecorrectionDescCreatedAt is the schema descriptor for created_at field.
+	attendancecorrectionDescCreatedAt := attendancecorrectionFields[11].Descriptor()
+	// attendancecorrection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attendancecorrection.DefaultCreatedAt = attendancecorrectionDescCreatedAt.Default.(func() time.Time)
+	// attendancecorrectionDescUpdatedAt is the schema descriptor for updated_at field.
+	attendancecorrectionDescUpdatedAt := attendancecorrectionFields[12].Descriptor()
+	// attendancecorrection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attendancecorrection.DefaultUpdatedAt = attendancecorrectionDescUpdatedAt.Default.(func() time.Time)
+	// attendancecorrection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attendancecorrection.UpdateDefaultUpdatedAt = attendancecorrectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	attendancerecordFields := schema.AttendanceRecord{}.Fields()
+	_ = attendancerecordFields
+	// attendancerecordDescCreatedAt is the schema descriptor for created_at field.
+	attendancerecordDescCreatedAt := attendancerecordFields[9].Descriptor()
+	// attendancerecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attendancerecord.DefaultCreatedAt = attendancerecordDescCreatedAt.Default.(func() time.Time)
+	// attendancerecordDescUpdatedAt is the schema descriptor for updated_at field.
+	attendancerecordDescUpdatedAt := attendancerecordFields[10].Descriptor()
+	// attendancerecord.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attendancerecord.DefaultUpdatedAt = attendancerecordDescUpdatedAt.Default.(func() time.Time)
+	// attendancerecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attendancerecord.UpdateDefaultUpdatedAt = attendancerecordDescUpdatedAt.UpdateDefault.(func() time.Time)
+	compensationFields := schema.Compensation{}.Fields()
+	_ = compensationFields
+	// compensationDescBasePay is the schema descriptor for base_pay field.
+	compensationDescBasePay := compensationFields[3].Descriptor()
+	// compensation.BasePayValidator is a validator for the "base_pay" field. It is called by the builders before save.
+	compensation.BasePayValidator = compensationDescBasePay.Validators[0].(func(int64) error)
+	// compensationDescTotalAllowance is the schema descriptor for total_allowance field.
+	compensationDescTotalAllowance := compensationFields[5].Descriptor()
+	// compensation.DefaultTotalAllowance holds the default value on creation for the total_allowance field.
+	compensation.DefaultTotalAllowance = compensationDescTotalAllowance.Default.(int64)
+	// compensationDescCurrency is the schema descriptor for currency field.
+	compensationDescCurrency := compensationFields[6].Descriptor()
+	// compensation.DefaultCurrency holds the default value on creation for the currency field.
+	compensation.DefaultCurrency = compensationDescCurrency.Default.(string)
+	// compensationDescCreatedAt is the schema descriptor for created_at field.
+	compensationDescCreatedAt := compensationFields[14].Descriptor()
+	// compensation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	compensation.DefaultCreatedAt = compensationDescCreatedAt.Default.(func() time.Time)
 	departmentFields := schema.Department{}.Fields()
 	_ = departmentFields
 	// departmentDescName is the schema descriptor for name field.
@@ -69,6 +152,38 @@ func init() {
 	employee.DefaultUpdatedAt = employeeDescUpdatedAt.Default.(func() time.Time)
 	// employee.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	employee.UpdateDefaultUpdatedAt = employeeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	employeecontractFields := schema.EmployeeContract{}.Fields()
+	_ = employeecontractFields
+	// employeecontractDescCode is the schema descriptor for code field.
+	employeecontractDescCode := employeecontractFields[1].Descriptor()
+	// employeecontract.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	employeecontract.CodeValidator = employeecontractDescCode.Validators[0].(func(string) error)
+	// employeecontractDescCreatedAt is the schema descriptor for created_at field.
+	employeecontractDescCreatedAt := employeecontractFields[7].Descriptor()
+	// employeecontract.DefaultCreatedAt holds the default value on creation for the created_at field.
+	employeecontract.DefaultCreatedAt = employeecontractDescCreatedAt.Default.(func() time.Time)
+	// employeecontractDescUpdatedAt is the schema descriptor for updated_at field.
+	employeecontractDescUpdatedAt := employeecontractFields[8].Descriptor()
+	// employeecontract.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	employeecontract.DefaultUpdatedAt = employeecontractDescUpdatedAt.Default.(func() time.Time)
+	// employeecontract.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	employeecontract.UpdateDefaultUpdatedAt = employeecontractDescUpdatedAt.UpdateDefault.(func() time.Time)
+	employeestatushistoryFields := schema.EmployeeStatusHistory{}.Fields()
+	_ = employeestatushistoryFields
+	// employeestatushistoryDescChangedAt is the schema descriptor for changed_at field.
+	employeestatushistoryDescChangedAt := employeestatushistoryFields[5].Descriptor()
+	// employeestatushistory.DefaultChangedAt holds the default value on creation for the changed_at field.
+	employeestatushistory.DefaultChangedAt = employeestatushistoryDescChangedAt.Default.(func() time.Time)
+	holidayFields := schema.Holiday{}.Fields()
+	_ = holidayFields
+	// holidayDescName is the schema descriptor for name field.
+	holidayDescName := holidayFields[2].Descriptor()
+	// holiday.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	holiday.NameValidator = holidayDescName.Validators[0].(func(string) error)
+	// holidayDescCreatedAt is the schema descriptor for created_at field.
+	holidayDescCreatedAt := holidayFields[3].Descriptor()
+	// holiday.DefaultCreatedAt holds the default value on creation for the created_at field.
+	holiday.DefaultCreatedAt = holidayDescCreatedAt.Default.(func() time.Time)
 	labelFields := schema.Label{}.Fields()
 	_ = labelFields
 	// labelDescName is the schema descriptor for name field.
@@ -113,6 +228,40 @@ func init() {
 	leaverequest.DefaultUpdatedAt = leaverequestDescUpdatedAt.Default.(func() time.Time)
 	// leaverequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	leaverequest.UpdateDefaultUpdatedAt = leaverequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	milestoneFields := schema.Milestone{}.Fields()
+	_ = milestoneFields
+	// milestoneDescName is the schema descriptor for name field.
+	milestoneDescName := milestoneFields[1].Descriptor()
+	// milestone.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	milestone.NameValidator = milestoneDescName.Validators[0].(func(string) error)
+	// milestoneDescCreatedAt is the schema descriptor for created_at field.
+	milestoneDescCreatedAt := milestoneFields[7].Descriptor()
+	// milestone.DefaultCreatedAt holds the default value on creation for the created_at field.
+	milestone.DefaultCreatedAt = milestoneDescCreatedAt.Default.(func() time.Time)
+	// milestoneDescUpdatedAt is the schema descriptor for updated_at field.
+	milestoneDescUpdatedAt := milestoneFields[8].Descriptor()
+	// milestone.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	milestone.DefaultUpdatedAt = milestoneDescUpdatedAt.Default.(func() time.Time)
+	// milestone.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	milestone.UpdateDefaultUpdatedAt = milestoneDescUpdatedAt.UpdateDefault.(func() time.Time)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[10].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescEnabled is the schema descriptor for enabled field.
+	notificationpreferenceDescEnabled := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationpreference.DefaultEnabled = notificationpreferenceDescEnabled.Default.(bool)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceFields[4].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	organizationFields := schema.Organization{}.Fields()
 	_ = organizationFields
 	// organizationDescName is the schema descriptor for name field.
@@ -133,6 +282,38 @@ func init() {
 	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
 	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationDescAttachmentMaxFileSize is the schema descriptor for attachment_max_file_size field.
+	organizationDescAttachmentMaxFileSize := organizationFields[11].Descriptor()
+	// organization.DefaultAttachmentMaxFileSize holds the default value on creation for the attachment_max_file_size field.
+	organization.DefaultAttachmentMaxFileSize = organizationDescAttachmentMaxFileSize.Default.(int64)
+	// organization.AttachmentMaxFileSizeValidator is a validator for the "attachment_max_file_size" field. It is called by the builders before save.
+	organization.AttachmentMaxFileSizeValidator = organizationDescAttachmentMaxFileSize.Validators[0].(func(int64) error)
+	// organizationDescAttachmentQuota is the schema descriptor for attachment_quota field.
+	organizationDescAttachmentQuota := organizationFields[12].Descriptor()
+	// organization.DefaultAttachmentQuota holds the default value on creation for the attachment_quota field.
+	organization.DefaultAttachmentQuota = organizationDescAttachmentQuota.Default.(int64)
+	// organization.AttachmentQuotaValidator is a validator for the "attachment_quota" field. It is called by the builders before save.
+	organization.AttachmentQuotaValidator = organizationDescAttachmentQuota.Validators[0].(func(int64) error)
+	// organizationDescTaskSeq is the schema descriptor for task_seq field.
+	organizationDescTaskSeq := organizationFields[13].Descriptor()
+	// organization.DefaultTaskSeq holds the default value on creation for the task_seq field.
+	organization.DefaultTaskSeq = organizationDescTaskSeq.Default.(int)
+	overtimerequestFields := schema.OvertimeRequest{}.Fields()
+	_ = overtimerequestFields
+	// overtimerequestDescHours is the schema descriptor for hours field.
+	overtimerequestDescHours := overtimerequestFields[3].Descriptor()
+	// overtimerequest.HoursValidator is a validator for the "hours" field. It is called by the builders before save.
+	overtimerequest.HoursValidator = overtimerequestDescHours.Validators[0].(func(float64) error)
+	// overtimerequestDescCreatedAt is the schema descriptor for created_at field.
+	overtimerequestDescCreatedAt := overtimerequestFields[13].Descriptor()
+	// overtimerequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	overtimerequest.DefaultCreatedAt = overtimerequestDescCreatedAt.Default.(func() time.Time)
+	// overtimerequestDescUpdatedAt is the schema descriptor for updated_at field.
+	overtimerequestDescUpdatedAt := overtimerequestFields[14].Descriptor()
+	// overtimerequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	overtimerequest.DefaultUpdatedAt = overtimerequestDescUpdatedAt.Default.(func() time.Time)
+	// overtimerequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	overtimerequest.UpdateDefaultUpdatedAt = overtimerequestDescUpdatedAt.UpdateDefault.(func() time.Time)
 	positionFields := schema.Position{}.Fields()
 	_ = positionFields
 	// positionDescName is the schema descriptor for name field.
@@ -151,16 +332,166 @@ func init() {
 	position.UpdateDefaultUpdatedAt = positionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	projectFields := schema.Project{}.Fields()
 	_ = projectFields
+	// projectDescManualProgress is the schema descriptor for manual_progress field.
+	projectDescManualProgress := projectFields[10].Descriptor()
+	// project.DefaultManualProgress holds the default value on creation for the manual_progress field.
+	project.DefaultManualProgress = projectDescManualProgress.Default.(bool)
+	// projectDescTaskSeq is the schema descriptor for task_seq field.
+	projectDescTaskSeq := projectFields[12].Descriptor()
+	// project.DefaultTaskSeq holds the default value on creation for the task_seq field.
+	project.DefaultTaskSeq = projectDescTaskSeq.Default.(int)
 	// projectDescCreatedAt is the schema descriptor for created_at field.
-	projectDescCreatedAt := projectFields[10].Descriptor()
+	projectDescCreatedAt := projectFields[13].Descriptor()
 	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
 	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
 	// projectDescUpdatedAt is the schema descriptor for updated_at field.
-	projectDescUpdatedAt := projectFields[11].Descriptor()
+	projectDescUpdatedAt := projectFields[14].Descriptor()
 	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
 	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
+	projectroleFields := schema.ProjectRole{}.Fields()
+	_ = projectroleFields
+	// projectroleDescUpdatedAt is the schema descriptor for updated_at field.
+	projectroleDescUpdatedAt := projectroleFields[3].Descriptor()
+	// projectrole.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	projectrole.DefaultUpdatedAt = projectroleDescUpdatedAt.Default.(func() time.Time)
+	// projectrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	projectrole.UpdateDefaultUpdatedAt = projectroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	remindersettingFields := schema.ReminderSetting{}.Fields()
+	_ = remindersettingFields
+	// remindersettingDescDueSoonEnabled is the schema descriptor for due_soon_enabled field.
+	remindersettingDescDueSoonEnabled := remindersettingFields[1].Descriptor()
+	// remindersetting.DefaultDueSoonEnabled holds the default value on creation for the due_soon_enabled field.
+	remindersetting.DefaultDueSoonEnabled = remindersettingDescDueSoonEnabled.Default.(bool)
+	// remindersettingDescDueSoonHours is the schema descriptor for due_soon_hours field.
+	remindersettingDescDueSoonHours := remindersettingFields[2].Descriptor()
+	// remindersetting.DefaultDueSoonHours holds the default value on creation for the due_soon_hours field.
+	remindersetting.DefaultDueSoonHours = remindersettingDescDueSoonHours.Default.(int)
+	// remindersetting.DueSoonHoursValidator is a validator for the "due_soon_hours" field. It is called by the builders before save.
+	remindersetting.DueSoonHoursValidator = remindersettingDescDueSoonHours.Validators[0].(func(int) error)
+	// remindersettingDescOverdueEnabled is the schema descriptor for overdue_enabled field.
+	remindersettingDescOverdueEnabled := remindersettingFields[3].Descriptor()
+	// remindersetting.DefaultOverdueEnabled holds the default value on creation for the overdue_enabled field.
+	remindersetting.DefaultOverdueEnabled = remindersettingDescOverdueEnabled.Default.(bool)
+	// remindersettingDescOverdueRepeatHours is the schema descriptor for overdue_repeat_hours field.
+	remindersettingDescOverdueRepeatHours := remindersettingFields[4].Descriptor()
+	// remindersetting.DefaultOverdueRepeatHours holds the default value on creation for the overdue_repeat_hours field.
+	remindersetting.DefaultOverdueRepeatHours = remindersettingDescOverdueRepeatHours.Default.(int)
+	// remindersetting.OverdueRepeatHoursValidator is a validator for the "overdue_repeat_hours" field. It is called by the builders before save.
+	remindersetting.OverdueRepeatHoursValidator = remindersettingDescOverdueRepeatHours.Validators[0].(func(int) error)
+	// remindersettingDescUpdatedAt is the schema descriptor for updated_at field.
+	remindersettingDescUpdatedAt := remindersettingFields[5].Descriptor()
+	// remindersetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	remindersetting.DefaultUpdatedAt = remindersettingDescUpdatedAt.Default.(func() time.Time)
+	// remindersetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	remindersetting.UpdateDefaultUpdatedAt = remindersettingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	rosterFields := schema.Roster{}.Fields()
+	_ = rosterFields
+	// rosterDescCreatedAt is the schema descriptor for created_at field.
+	rosterDescCreatedAt := rosterFields[6].Descriptor()
+	// roster.DefaultCreatedAt holds the default value on creation for the created_at field.
+	roster.DefaultCreatedAt = rosterDescCreatedAt.Default.(func() time.Time)
+	// rosterDescUpdatedAt is the schema descriptor for updated_at field.
+	rosterDescUpdatedAt := rosterFields[7].Descriptor()
+	// roster.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	roster.DefaultUpdatedAt = rosterDescUpdatedAt.Default.(func() time.Time)
+	// roster.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	roster.UpdateDefaultUpdatedAt = rosterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	salarygradeFields := schema.SalaryGrade{}.Fields()
+	_ = salarygradeFields
+	// salarygradeDescCode is the schema descriptor for code field.
+	salarygradeDescCode := salarygradeFields[0].Descriptor()
+	// salarygrade.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	salarygrade.CodeValidator = salarygradeDescCode.Validators[0].(func(string) error)
+	// salarygradeDescName is the schema descriptor for name field.
+	salarygradeDescName := salarygradeFields[1].Descriptor()
+	// salarygrade.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	salarygrade.NameValidator = salarygradeDescName.Validators[0].(func(string) error)
+	// salarygradeDescMinAmount is the schema descriptor for min_amount field.
+	salarygradeDescMinAmount := salarygradeFields[2].Descriptor()
+	// salarygrade.MinAmountValidator is a validator for the "min_amount" field. It is called by the builders before save.
+	salarygrade.MinAmountValidator = salarygradeDescMinAmount.Validators[0].(func(int64) error)
+	// salarygradeDescMaxAmount is the schema descriptor for max_amount field.
+	salarygradeDescMaxAmount := salarygradeFields[3].Descriptor()
+	// salarygrade.MaxAmountValidator is a validator for the "max_amount" field. It is called by the builders before save.
+	salarygrade.MaxAmountValidator = salarygradeDescMaxAmount.Validators[0].(func(int64) error)
+	// salarygradeDescCurrency is the schema descriptor for currency field.
+	salarygradeDescCurrency := salarygradeFields[4].Descriptor()
+	// salarygrade.DefaultCurrency holds the default value on creation for the currency field.
+	salarygrade.DefaultCurrency = salarygradeDescCurrency.Default.(string)
+	// salarygradeDescCreatedAt is the schema descriptor for created_at field.
+	salarygradeDescCreatedAt := salarygradeFields[7].Descriptor()
+	// salarygrade.DefaultCreatedAt holds the default value on creation for the created_at field.
+	salarygrade.DefaultCreatedAt = salarygradeDescCreatedAt.Default.(func() time.Time)
+	// salarygradeDescUpdatedAt is the schema descriptor for updated_at field.
+	salarygradeDescUpdatedAt := salarygradeFields[8].Descriptor()
+	// salarygrade.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	salarygrade.DefaultUpdatedAt = salarygradeDescUpdatedAt.Default.(func() time.Time)
+	// salarygrade.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	salarygrade.UpdateDefaultUpdatedAt = salarygradeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	shiftFields := schema.Shift{}.Fields()
+	_ = shiftFields
+	// shiftDescCode is the schema descriptor for code field.
+	shiftDescCode := shiftFields[0].Descriptor()
+	// shift.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	shift.CodeValidator = shiftDescCode.Validators[0].(func(string) error)
+	// shiftDescName is the schema descriptor for name field.
+	shiftDescName := shiftFields[1].Descriptor()
+	// shift.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	shift.NameValidator = shiftDescName.Validators[0].(func(string) error)
+	// shiftDescBreakMinutes is the schema descriptor for break_minutes field.
+	shiftDescBreakMinutes := shiftFields[4].Descriptor()
+	// shift.DefaultBreakMinutes holds the default value on creation for the break_minutes field.
+	shift.DefaultBreakMinutes = shiftDescBreakMinutes.Default.(int)
+	// shift.BreakMinutesValidator is a validator for the "break_minutes" field. It is called by the builders before save.
+	shift.BreakMinutesValidator = shiftDescBreakMinutes.Validators[0].(func(int) error)
+	// shiftDescBreakPaid is the schema descriptor for break_paid field.
+	shiftDescBreakPaid := shiftFields[5].Descriptor()
+	// shift.DefaultBreakPaid holds the default value on creation for the break_paid field.
+	shift.DefaultBreakPaid = shiftDescBreakPaid.Default.(bool)
+	// shiftDescIsNight is the schema descriptor for is_night field.
+	shiftDescIsNight := shiftFields[6].Descriptor()
+	// shift.DefaultIsNight holds the default value on creation for the is_night field.
+	shift.DefaultIsNight = shiftDescIsNight.Default.(bool)
+	// shiftDescCreatedAt is the schema descriptor for created_at field.
+	shiftDescCreatedAt := shiftFields[8].Descriptor()
+	// shift.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shift.DefaultCreatedAt = shiftDescCreatedAt.Default.(func() time.Time)
+	// shiftDescUpdatedAt is the schema descriptor for updated_at field.
+	shiftDescUpdatedAt := shiftFields[9].Descriptor()
+	// shift.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shift.DefaultUpdatedAt = shiftDescUpdatedAt.Default.(func() time.Time)
+	// shift.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shift.UpdateDefaultUpdatedAt = shiftDescUpdatedAt.UpdateDefault.(func() time.Time)
+	shiftswaprequestFields := schema.ShiftSwapRequest{}.Fields()
+	_ = shiftswaprequestFields
+	// shiftswaprequestDescCreatedAt is the schema descriptor for created_at field.
+	shiftswaprequestDescCreatedAt := shiftswaprequestFields[9].Descriptor()
+	// shiftswaprequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shiftswaprequest.DefaultCreatedAt = shiftswaprequestDescCreatedAt.Default.(func() time.Time)
+	// shiftswaprequestDescUpdatedAt is the schema descriptor for updated_at field.
+	shiftswaprequestDescUpdatedAt := shiftswaprequestFields[10].Descriptor()
+	// shiftswaprequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shiftswaprequest.DefaultUpdatedAt = shiftswaprequestDescUpdatedAt.Default.(func() time.Time)
+	// shiftswaprequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shiftswaprequest.UpdateDefaultUpdatedAt = shiftswaprequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sprintFields := schema.Sprint{}.Fields()
+	_ = sprintFields
+	// sprintDescName is the schema descriptor for name field.
+	sprintDescName := sprintFields[1].Descriptor()
+	// sprint.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	sprint.NameValidator = sprintDescName.Validators[0].(func(string) error)
+	// sprintDescCreatedAt is the schema descriptor for created_at field.
+	sprintDescCreatedAt := sprintFields[12].Descriptor()
+	// sprint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sprint.DefaultCreatedAt = sprintDescCreatedAt.Default.(func() time.Time)
+	// sprintDescUpdatedAt is the schema descriptor for updated_at field.
+	sprintDescUpdatedAt := sprintFields[13].Descriptor()
+	// sprint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sprint.DefaultUpdatedAt = sprintDescUpdatedAt.Default.(func() time.Time)
+	// sprint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sprint.UpdateDefaultUpdatedAt = sprintDescUpdatedAt.UpdateDefault.(func() time.Time)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
 	// taskDescProcess is the schema descriptor for process field.
@@ -177,6 +508,102 @@ func init() {
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taskDescOriginalEstimate is the schema descriptor for original_estimate field.
+	taskDescOriginalEstimate := taskFields[14].Descriptor()
+	// task.OriginalEstimateValidator is a validator for the "original_estimate" field. It is called by the builders before save.
+	task.OriginalEstimateValidator = taskDescOriginalEstimate.Validators[0].(func(int) error)
+	// taskDescRemainingEstimate is the schema descriptor for remaining_estimate field.
+	taskDescRemainingEstimate := taskFields[15].Descriptor()
+	// task.RemainingEstimateValidator is a validator for the "remaining_estimate" field. It is called by the builders before save.
+	task.RemainingEstimateValidator = taskDescRemainingEstimate.Validators[0].(func(int) error)
+	// taskDescWorkflowStatus is the schema descriptor for workflow_status field.
+	taskDescWorkflowStatus := taskFields[16].Descriptor()
+	// task.DefaultWorkflowStatus holds the default value on creation for the workflow_status field.
+	task.DefaultWorkflowStatus = taskDescWorkflowStatus.Default.(string)
+	// taskDescRank is the schema descriptor for rank field.
+	taskDescRank := taskFields[17].Descriptor()
+	// task.DefaultRank holds the default value on creation for the rank field.
+	task.DefaultRank = taskDescRank.Default.(string)
+	taskactivityFields := schema.TaskActivity{}.Fields()
+	_ = taskactivityFields
+	// taskactivityDescCreatedAt is the schema descriptor for created_at field.
+	taskactivityDescCreatedAt := taskactivityFields[10].Descriptor()
+	// taskactivity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskactivity.DefaultCreatedAt = taskactivityDescCreatedAt.Default.(func() time.Time)
+	taskcommentFields := schema.TaskComment{}.Fields()
+	_ = taskcommentFields
+	// taskcommentDescCreatedAt is the schema descriptor for created_at field.
+	taskcommentDescCreatedAt := taskcommentFields[6].Descriptor()
+	// taskcomment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskcomment.DefaultCreatedAt = taskcommentDescCreatedAt.Default.(func() time.Time)
+	// taskcommentDescUpdatedAt is the schema descriptor for updated_at field.
+	taskcommentDescUpdatedAt := taskcommentFields[7].Descriptor()
+	// taskcomment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	taskcomment.DefaultUpdatedAt = taskcommentDescUpdatedAt.Default.(func() time.Time)
+	// taskcomment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	taskcomment.UpdateDefaultUpdatedAt = taskcommentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taskcommentrevisionFields := schema.TaskCommentRevision{}.Fields()
+	_ = taskcommentrevisionFields
+	// taskcommentrevisionDescCreatedAt is the schema descriptor for created_at field.
+	taskcommentrevisionDescCreatedAt := taskcommentrevisionFields[3].Descriptor()
+	// taskcommentrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskcommentrevision.DefaultCreatedAt = taskcommentrevisionDescCreatedAt.Default.(func() time.Time)
+	taskdependencyFields := schema.TaskDependency{}.Fields()
+	_ = taskdependencyFields
+	// taskdependencyDescCreatedAt is the schema descriptor for created_at field.
+	taskdependencyDescCreatedAt := taskdependencyFields[4].Descriptor()
+	// taskdependency.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskdependency.DefaultCreatedAt = taskdependencyDescCreatedAt.Default.(func() time.Time)
+	taskrecurrenceFields := schema.TaskRecurrence{}.Fields()
+	_ = taskrecurrenceFields
+	// taskrecurrenceDescRule is the schema descriptor for rule field.
+	taskrecurrenceDescRule := taskrecurrenceFields[1].Descriptor()
+	// taskrecurrence.RuleValidator is a validator for the "rule" field. It is called by the builders before save.
+	taskrecurrence.RuleValidator = taskrecurrenceDescRule.Validators[0].(func(string) error)
+	// taskrecurrenceDescTimezone is the schema descriptor for timezone field.
+	taskrecurrenceDescTimezone := taskrecurrenceFields[6].Descriptor()
+	// taskrecurrence.DefaultTimezone holds the default value on creation for the timezone field.
+	taskrecurrence.DefaultTimezone = taskrecurrenceDescTimezone.Default.(string)
+	// taskrecurrenceDescEnabled is the schema descriptor for enabled field.
+	taskrecurrenceDescEnabled := taskrecurrenceFields[7].Descriptor()
+	// taskrecurrence.DefaultEnabled holds the default value on creation for the enabled field.
+	taskrecurrence.DefaultEnabled = taskrecurrenceDescEnabled.Default.(bool)
+	// taskrecurrenceDescCreatedAt is the schema descriptor for created_at field.
+	taskrecurrenceDescCreatedAt := taskrecurrenceFields[10].Descriptor()
+	// taskrecurrence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskrecurrence.DefaultCreatedAt = taskrecurrenceDescCreatedAt.Default.(func() time.Time)
+	// taskrecurrenceDescUpdatedAt is the schema descriptor for updated_at field.
+	taskrecurrenceDescUpdatedAt := taskrecurrenceFields[11].Descriptor()
+	// taskrecurrence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	taskrecurrence.DefaultUpdatedAt = taskrecurrenceDescUpdatedAt.Default.(func() time.Time)
+	// taskrecurrence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	taskrecurrence.UpdateDefaultUpdatedAt = taskrecurrenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taskrecurrencerunFields := schema.TaskRecurrenceRun{}.Fields()
+	_ = taskrecurrencerunFields
+	// taskrecurrencerunDescAttempts is the schema descriptor for attempts field.
+	taskrecurrencerunDescAttempts := taskrecurrencerunFields[4].Descriptor()
+	// taskrecurrencerun.DefaultAttempts holds the default value on creation for the attempts field.
+	taskrecurrencerun.DefaultAttempts = taskrecurrencerunDescAttempts.Default.(int)
+	// taskrecurrencerunDescCreatedAt is the schema descriptor for created_at field.
+	taskrecurrencerunDescCreatedAt := taskrecurrencerunFields[6].Descriptor()
+	// taskrecurrencerun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskrecurrencerun.DefaultCreatedAt = taskrecurrencerunDescCreatedAt.Default.(func() time.Time)
+	// taskrecurrencerunDescUpdatedAt is the schema descriptor for updated_at field.
+	taskrecurrencerunDescUpdatedAt := taskrecurrencerunFields[7].Descriptor()
+	// taskrecurrencerun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	taskrecurrencerun.DefaultUpdatedAt = taskrecurrencerunDescUpdatedAt.Default.(func() time.Time)
+	// taskrecurrencerun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	taskrecurrencerun.UpdateDefaultUpdatedAt = taskrecurrencerunDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taskreminderFields := schema.TaskReminder{}.Fields()
+	_ = taskreminderFields
+	// taskreminderDescSeq is the schema descriptor for seq field.
+	taskreminderDescSeq := taskreminderFields[4].Descriptor()
+	// taskreminder.DefaultSeq holds the default value on creation for the seq field.
+	taskreminder.DefaultSeq = taskreminderDescSeq.Default.(int)
+	// taskreminderDescCreatedAt is the schema descriptor for created_at field.
+	taskreminderDescCreatedAt := taskreminderFields[5].Descriptor()
+	// taskreminder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskreminder.DefaultCreatedAt = taskreminderDescCreatedAt.Default.(func() time.Time)
 	taskreportFields := schema.TaskReport{}.Fields()
 	_ = taskreportFields
 	// taskreportDescCreatedAt is the schema descriptor for created_at field.
@@ -189,4 +616,102 @@ func init() {
 	taskreport.DefaultUpdatedAt = taskreportDescUpdatedAt.Default.(func() time.Time)
 	// taskreport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	taskreport.UpdateDefaultUpdatedAt = taskreportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taskreportDescProgress is the schema descriptor for progress field.
+	taskreportDescProgress := taskreportFields[5].Descriptor()
+	// taskreport.ProgressValidator is a validator for the "progress" field. It is called by the builders before save.
+	taskreport.ProgressValidator = func() func(int) error {
+		validators := taskreportDescProgress.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(progress int) error {
+			for _, fn := range fns {
+				if err := fn(progress); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	tasktemplateFields := schema.TaskTemplate{}.Fields()
+	_ = tasktemplateFields
+	// tasktemplateDescName is the schema descriptor for name field.
+	tasktemplateDescName := tasktemplateFields[2].Descriptor()
+	// tasktemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tasktemplate.NameValidator = tasktemplateDescName.Validators[0].(func(string) error)
+	// tasktemplateDescOriginalEstimate is the schema descriptor for original_estimate field.
+	tasktemplateDescOriginalEstimate := tasktemplateFields[9].Descriptor()
+	// tasktemplate.OriginalEstimateValidator is a validator for the "original_estimate" field. It is called by the builders before save.
+	tasktemplate.OriginalEstimateValidator = tasktemplateDescOriginalEstimate.Validators[0].(func(int) error)
+	// tasktemplateDescCreatedAt is the schema descriptor for created_at field.
+	tasktemplateDescCreatedAt := tasktemplateFields[11].Descriptor()
+	// tasktemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tasktemplate.DefaultCreatedAt = tasktemplateDescCreatedAt.Default.(func() time.Time)
+	// tasktemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	tasktemplateDescUpdatedAt := tasktemplateFields[12].Descriptor()
+	// tasktemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tasktemplate.DefaultUpdatedAt = tasktemplateDescUpdatedAt.Default.(func() time.Time)
+	// tasktemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tasktemplate.UpdateDefaultUpdatedAt = tasktemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taskviewFields := schema.TaskView{}.Fields()
+	_ = taskviewFields
+	// taskviewDescName is the schema descriptor for name field.
+	taskviewDescName := taskviewFields[3].Descriptor()
+	// taskview.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	taskview.NameValidator = taskviewDescName.Validators[0].(func(string) error)
+	// taskviewDescTimezone is the schema descriptor for timezone field.
+	taskviewDescTimezone := taskviewFields[6].Descriptor()
+	// taskview.DefaultTimezone holds the default value on creation for the timezone field.
+	taskview.DefaultTimezone = taskviewDescTimezone.Default.(string)
+	// taskviewDescCreatedAt is the schema descriptor for created_at field.
+	taskviewDescCreatedAt := taskviewFields[7].Descriptor()
+	// taskview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskview.DefaultCreatedAt = taskviewDescCreatedAt.Default.(func() time.Time)
+	// taskviewDescUpdatedAt is the schema descriptor for updated_at field.
+	taskviewDescUpdatedAt := taskviewFields[8].Descriptor()
+	// taskview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	taskview.DefaultUpdatedAt = taskviewDescUpdatedAt.Default.(func() time.Time)
+	// taskview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	taskview.UpdateDefaultUpdatedAt = taskviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taskwatcherFields := schema.TaskWatcher{}.Fields()
+	_ = taskwatcherFields
+	// taskwatcherDescCreatedAt is the schema descriptor for created_at field.
+	taskwatcherDescCreatedAt := taskwatcherFields[2].Descriptor()
+	// taskwatcher.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskwatcher.DefaultCreatedAt = taskwatcherDescCreatedAt.Default.(func() time.Time)
+	worklogFields := schema.WorkLog{}.Fields()
+	_ = worklogFields
+	// worklogDescDuration is the schema descriptor for duration field.
+	worklogDescDuration := worklogFields[4].Descriptor()
+	// worklog.DefaultDuration holds the default value on creation for the duration field.
+	worklog.DefaultDuration = worklogDescDuration.Default.(int)
+	// worklog.DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	worklog.DurationValidator = worklogDescDuration.Validators[0].(func(int) error)
+	// worklogDescCreatedAt is the schema descriptor for created_at field.
+	worklogDescCreatedAt := worklogFields[6].Descriptor()
+	// worklog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	worklog.DefaultCreatedAt = worklogDescCreatedAt.Default.(func() time.Time)
+	// worklogDescUpdatedAt is the schema descriptor for updated_at field.
+	worklogDescUpdatedAt := worklogFields[7].Descriptor()
+	// worklog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	worklog.DefaultUpdatedAt = worklogDescUpdatedAt.Default.(func() time.Time)
+	// worklog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	worklog.UpdateDefaultUpdatedAt = worklogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	workflowstatusFields := schema.WorkflowStatus{}.Fields()
+	_ = workflowstatusFields
+	// workflowstatusDescPosition is the schema descriptor for position field.
+	workflowstatusDescPosition := workflowstatusFields[5].Descriptor()
+	// workflowstatus.DefaultPosition holds the default value on creation for the position field.
+	workflowstatus.DefaultPosition = workflowstatusDescPosition.Default.(int)
+	// workflowstatusDescWipLimit is the schema descriptor for wip_limit field.
+	workflowstatusDescWipLimit := workflowstatusFields[6].Descriptor()
+	// workflowstatus.WipLimitValidator is a validator for the "wip_limit" field. It is called by the builders before save.
+	workflowstatus.WipLimitValidator = workflowstatusDescWipLimit.Validators[0].(func(int) error)
+	workflowtransitionFields := schema.WorkflowTransition{}.Fields()
+	_ = workflowtransitionFields
+	// workflowtransitionDescFromKey is the schema descriptor for from_key field.
+	workflowtransitionDescFromKey := workflowtransitionFields[1].Descriptor()
+	// workflowtransition.DefaultFromKey holds the default value on creation for the from_key field.
+	workflowtransition.DefaultFromKey = workflowtransitionDescFromKey.Default.(string)
 }
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+)
+
+// SalaryGrade is the model entity for the SalaryGrade schema.
+type SalaryGrade struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// MinAmount holds the value of the "min_amount" field.
+	MinAmount int64 `json:"min_amount"`
+	// MaxAmount holds the value of the "max_amount" field.
+	MaxAmount int64 `json:"max_amount"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// PositionID holds the value of the "position_id" field.
+	PositionID *int `json:"position_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SalaryGradeQuery when eager-loading is set.
+	Edges        SalaryGradeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SalaryGradeEdges holds the relations/edges for other nodes in the graph.
+type SalaryGradeEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization"`
+	// Position holds the value of the position edge.
+	Position *Position `json:"position"`
+	// Compensations holds the value of the compensations edge.
+	Compensations []*Compensation `json:"compensations"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SalaryGradeEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// PositionOrErr returns the Position value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SalaryGradeEdges) PositionOrErr() (*Position, error) {
+	if e.Position != nil {
+		return e.Position, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: position.Label}
+	}
+	return nil, &NotLoadedError{edge: "position"}
+}
+
+// CompensationsOrErr returns the Compensations value or an error if the edge
+// was not loaded in eager-loading.
+func (e SalaryGradeEdges) CompensationsOrErr() ([]*Compensation, error) {
+	if e.loadedTypes[2] {
+		return e.Compensations, nil
+	}
+	return nil, &NotLoadedError{edge: "compensations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SalaryGrade) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case salarygrade.FieldID, salarygrade.FieldMinAmount, salarygrade.FieldMaxAmount, salarygrade.FieldOrgID, salarygrade.FieldPositionID:
+			values[i] = new(sql.NullInt64)
+		case salarygrade.FieldCode, salarygrade.FieldName, salarygrade.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case salarygrade.FieldCreatedAt, salarygrade.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SalaryGrade fields.
+func (sg *SalaryGrade) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case salarygrade.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sg.ID = int(value.Int64)
+		case salarygrade.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				sg.Code = value.String
+			}
+		case salarygrade.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sg.Name = value.String
+			}
+		case salarygrade.FieldMinAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_amount", values[i])
+			} else if value.Valid {
+				sg.MinAmount = value.Int64
+			}
+		case salarygrade.FieldMaxAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_amount", values[i])
+			} else if value.Valid {
+				sg.MaxAmount = value.Int64
+			}
+		case salarygrade.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				sg.Currency = value.String
+			}
+		case salarygrade.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				sg.OrgID = int(value.Int64)
+			}
+		case salarygrade.FieldPositionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position_id", values[i])
+			} else if value.Valid {
+				sg.PositionID = new(int)
+				*sg.PositionID = int(value.Int64)
+			}
+		case salarygrade.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sg.CreatedAt = value.Time
+			}
+		case salarygrade.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sg.UpdatedAt = value.Time
+			}
+		default:
+			sg.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SalaryGrade.
+// This includes values selected through modifiers, order, etc.
+func (sg *SalaryGrade) Value(name string) (ent.Value, error) {
+	return sg.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the SalaryGrade entity.
+func (sg *SalaryGrade) QueryOrganization() *OrganizationQuery {
+	return NewSalaryGradeClient(sg.config).QueryOrganization(sg)
+}
+
+// QueryPosition queries the "position" edge of the SalaryGrade entity.
+func (sg *SalaryGrade) QueryPosition() *PositionQuery {
+	return NewSalaryGradeClient(sg.config).QueryPosition(sg)
+}
+
+// QueryCompensations queries the "compensations" edge of the SalaryGrade entity.
+func (sg *SalaryGrade) QueryCompensations() *CompensationQuery {
+	return NewSalaryGradeClient(sg.config).QueryCompensations(sg)
+}
+
+// Update returns a builder for updating this SalaryGrade.
+// Note that you need to call SalaryGrade.Unwrap() before calling this method if this SalaryGrade
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sg *SalaryGrade) Update() *SalaryGradeUpdateOne {
+	return NewSalaryGradeClient(sg.config).UpdateOne(sg)
+}
+
+// Unwrap unwraps the SalaryGrade entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sg *SalaryGrade) Unwrap() *SalaryGrade {
+	_tx, ok := sg.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SalaryGrade is not a transactional entity")
+	}
+	sg.config.driver = _tx.drv
+	return sg
+}
+
+// String implements the fmt.Stringer.
+func (sg *SalaryGrade) String() string {
+	var builder strings.Builder
+	builder.WriteString("SalaryGrade(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sg.ID))
+	builder.WriteString("code=")
+	builder.WriteString(sg.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sg.Name)
+	builder.WriteString(", ")
+	builder.WriteString("min_amount=")
+	builder.WriteString(fmt.Sprintf("%v", sg.MinAmount))
+	builder.WriteString(", ")
+	builder.WriteString("max_amount=")
+	builder.WriteString(fmt.Sprintf("%v", sg.MaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(sg.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", sg.OrgID))
+	builder.WriteString(", ")
+	if v := sg.PositionID; v != nil {
+		builder.WriteString("position_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sg.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sg.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SalaryGrades is a parsable slice of SalaryGrade.
+type SalaryGrades []*SalaryGrade
@@ -0,0 +1,1057 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+)
+
+// SalaryGradeCreate is the builder for creating a SalaryGrade entity.
+type SalaryGradeCreate struct {
+	config
+	mutation *SalaryGradeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (sgc *SalaryGradeCreate) SetCode(s string) *SalaryGradeCreate {
+	sgc.mutation.SetCode(s)
+	return sgc
+}
+
+// SetName sets the "name" field.
+func (sgc *SalaryGradeCreate) SetName(s string) *SalaryGradeCreate {
+	sgc.mutation.SetName(s)
+	return sgc
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (sgc *SalaryGradeCreate) SetMinAmount(i int64) *SalaryGradeCreate {
+	sgc.mutation.SetMinAmount(i)
+	return sgc
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (sgc *SalaryGradeCreate) SetMaxAmount(i int64) *SalaryGradeCreate {
+	sgc.mutation.SetMaxAmount(i)
+	return sgc
+}
+
+// SetCurrency sets the "currency" field.
+func (sgc *SalaryGradeCreate) SetCurrency(s string) *SalaryGradeCreate {
+	sgc.mutation.SetCurrency(s)
+	return sgc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (sgc *SalaryGradeCreate) SetNillableCurrency(s *string) *SalaryGradeCreate {
+	if s != nil {
+		sgc.SetCurrency(*s)
+	}
+	return sgc
+}
+
+// SetOrgID sets the "org_id" field.
+func (sgc *SalaryGradeCreate) SetOrgID(i int) *SalaryGradeCreate {
+	sgc.mutation.SetOrgID(i)
+	return sgc
+}
+
+// SetPositionID sets the "position_id" field.
+func (sgc *SalaryGradeCreate) SetPositionID(i int) *SalaryGradeCreate {
+	sgc.mutation.SetPositionID(i)
+	return sgc
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (sgc *SalaryGradeCreate) SetNillablePositionID(i *int) *SalaryGradeCreate {
+	if i != nil {
+		sgc.SetPositionID(*i)
+	}
+	return sgc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sgc *SalaryGradeCreate) SetCreatedAt(t time.Time) *SalaryGradeCreate {
+	sgc.mutation.SetCreatedAt(t)
+	return sgc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sgc *SalaryGradeCreate) SetNillableCreatedAt(t *time.Time) *SalaryGradeCreate {
+	if t != nil {
+		sgc.SetCreatedAt(*t)
+	}
+	return sgc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sgc *SalaryGradeCreate) SetUpdatedAt(t time.Time) *SalaryGradeCreate {
+	sgc.mutation.SetUpdatedAt(t)
+	return sgc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sgc *SalaryGradeCreate) SetNillableUpdatedAt(t *time.Time) *SalaryGradeCreate {
+	if t != nil {
+		sgc.SetUpdatedAt(*t)
+	}
+	return sgc
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (sgc *SalaryGradeCreate) SetOrganizationID(id int) *SalaryGradeCreate {
+	sgc.mutation.SetOrganizationID(id)
+	return sgc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (sgc *SalaryGradeCreate) SetOrganization(o *Organization) *SalaryGradeCreate {
+	return sgc.SetOrganizationID(o.ID)
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (sgc *SalaryGradeCreate) SetPosition(p *Position) *SalaryGradeCreate {
+	return sgc.SetPositionID(p.ID)
+}
+
+// AddCompensationIDs adds the "compensations" edge to the Compensation entity by IDs.
+func (sgc *SalaryGradeCreate) AddCompensationIDs(ids ...int) *SalaryGradeCreate {
+	sgc.mutation.AddCompensationIDs(ids...)
+	return sgc
+}
+
+// AddCompensations adds the "compensations" edges to the Compensation entity.
+func (sgc *SalaryGradeCreate) AddCompensations(c ...*Compensation) *SalaryGradeCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sgc.AddCompensationIDs(ids...)
+}
+
+// Mutation returns the SalaryGradeMutation object of the builder.
+func (sgc *SalaryGradeCreate) Mutation() *SalaryGradeMutation {
+	return sgc.mutation
+}
+
+// Save creates the SalaryGrade in the database.
+func (sgc *SalaryGradeCreate) Save(ctx context.Context) (*SalaryGrade, error) {
+	sgc.defaults()
+	return withHooks(ctx, sgc.sqlSave, sgc.mutation, sgc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sgc *SalaryGradeCreate) SaveX(ctx context.Context) *SalaryGrade {
+	v, err := sgc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sgc *SalaryGradeCreate) Exec(ctx context.Context) error {
+	_, err := sgc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgc *SalaryGradeCreate) ExecX(ctx context.Context) {
+	if err := sgc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sgc *SalaryGradeCreate) defaults() {
+	if _, ok := sgc.mutation.Currency(); !ok {
+		v := salarygrade.DefaultCurrency
+		sgc.mutation.SetCurrency(v)
+	}
+	if _, ok := sgc.mutation.CreatedAt(); !ok {
+		v := salarygrade.DefaultCreatedAt()
+		sgc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sgc.mutation.UpdatedAt(); !ok {
+		v := salarygrade.DefaultUpdatedAt()
+		sgc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sgc *SalaryGradeCreate) check() error {
+	if _, ok := sgc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "SalaryGrade.code"`)}
+	}
+	if v, ok := sgc.mutation.Code(); ok {
+		if err := salarygrade.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.code": %w`, err)}
+		}
+	}
+	if _, ok := sgc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SalaryGrade.name"`)}
+	}
+	if v, ok := sgc.mutation.Name(); ok {
+		if err := salarygrade.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.name": %w`, err)}
+		}
+	}
+	if _, ok := sgc.mutation.MinAmount(); !ok {
+		return &ValidationError{Name: "min_amount", err: errors.New(`ent: missing required field "SalaryGrade.min_amount"`)}
+	}
+	if v, ok := sgc.mutation.MinAmount(); ok {
+		if err := salarygrade.MinAmountValidator(v); err != nil {
+			return &ValidationError{Name: "min_amount", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.min_amount": %w`, err)}
+		}
+	}
+	if _, ok := sgc.mutation.MaxAmount(); !ok {
+		return &ValidationError{Name: "max_amount", err: errors.New(`ent: missing required field "SalaryGrade.max_amount"`)}
+	}
+	if v, ok := sgc.mutation.MaxAmount(); ok {
+		if err := salarygrade.MaxAmountValidator(v); err != nil {
+			return &ValidationError{Name: "max_amount", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.max_amount": %w`, err)}
+		}
+	}
+	if _, ok := sgc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "SalaryGrade.currency"`)}
+	}
+	if _, ok := sgc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "SalaryGrade.org_id"`)}
+	}
+	if _, ok := sgc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SalaryGrade.created_at"`)}
+	}
+	if _, ok := sgc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SalaryGrade.updated_at"`)}
+	}
+	if len(sgc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "SalaryGrade.organization"`)}
+	}
+	return nil
+}
+
+func (sgc *SalaryGradeCreate) sqlSave(ctx context.Context) (*SalaryGrade, error) {
+	if err := sgc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sgc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sgc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sgc.mutation.id = &_node.ID
+	sgc.mutation.done = true
+	return _node, nil
+}
+
+func (sgc *SalaryGradeCreate) createSpec() (*SalaryGrade, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SalaryGrade{config: sgc.config}
+		_spec = sqlgraph.NewCreateSpec(salarygrade.Table, sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sgc.conflict
+	if value, ok := sgc.mutation.Code(); ok {
+		_spec.SetField(salarygrade.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := sgc.mutation.Name(); ok {
+		_spec.SetField(salarygrade.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sgc.mutation.MinAmount(); ok {
+		_spec.SetField(salarygrade.FieldMinAmount, field.TypeInt64, value)
+		_node.MinAmount = value
+	}
+	if value, ok := sgc.mutation.MaxAmount(); ok {
+		_spec.SetField(salarygrade.FieldMaxAmount, field.TypeInt64, value)
+		_node.MaxAmount = value
+	}
+	if value, ok := sgc.mutation.Currency(); ok {
+		_spec.SetField(salarygrade.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := sgc.mutation.CreatedAt(); ok {
+		_spec.SetField(salarygrade.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sgc.mutation.UpdatedAt(); ok {
+		_spec.SetField(salarygrade.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sgc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.OrganizationTable,
+			Columns: []string{salarygrade.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sgc.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.PositionTable,
+			Columns: []string{salarygrade.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PositionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sgc.mutation.CompensationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarygrade.CompensationsTable,
+			Columns: []string{salarygrade.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SalaryGrade.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SalaryGradeUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (sgc *SalaryGradeCreate) OnConflict(opts ...sql.ConflictOption) *SalaryGradeUpsertOne {
+	sgc.conflict = opts
+	return &SalaryGradeUpsertOne{
+		create: sgc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SalaryGrade.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sgc *SalaryGradeCreate) OnConflictColumns(columns ...string) *SalaryGradeUpsertOne {
+	sgc.conflict = append(sgc.conflict, sql.ConflictColumns(columns...))
+	return &SalaryGradeUpsertOne{
+		create: sgc,
+	}
+}
+
+type (
+	// SalaryGradeUpsertOne is the builder for "upsert"-ing
+	//  one SalaryGrade node.
+	SalaryGradeUpsertOne struct {
+		create *SalaryGradeCreate
+	}
+
+	// SalaryGradeUpsert is the "OnConflict" setter.
+	SalaryGradeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCode sets the "code" field.
+func (u *SalaryGradeUpsert) SetCode(v string) *SalaryGradeUpsert {
+	u.Set(salarygrade.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SalaryGradeUpsert) UpdateCode() *SalaryGradeUpsert {
+	u.SetExcluded(salarygrade.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SalaryGradeUpsert) SetName(v string) *SalaryGradeUpsert {
+	u.Set(salarygrade.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SalaryGradeUpsert) UpdateName() *SalaryGradeUpsert {
+	u.SetExcluded(salarygrade.FieldName)
+	return u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *SalaryGradeUpsert) SetMinAmount(v int64) *SalaryGradeUpsert {
+	u.Set(salarygrade.FieldMinAmount, v)
+	return u
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *SalaryGradeUpsert) UpdateMinAmount() *SalaryGradeUpsert {
+	u.SetExcluded(salarygrade.FieldMinAmount)
+	return u
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *SalaryGradeUpsert) AddMinAmount(v int64) *SalaryGradeUpsert {
+	u.Add(salarygrade.FieldMinAmount, v)
+	return u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *SalaryGradeUpsert) SetMaxAmount(v int64) *SalaryGradeUpsert {
+	u.Set(salarygrade.FieldMaxAmount, v)
+	return u
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *SalaryGradeUpsert) UpdateMaxAmount() *SalaryGradeUpsert {
+	u.SetExcluded(salarygrade.FieldMaxAmount)
+	return u
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *SalaryGradeUpsert) AddMaxAmount(v int64) *SalaryGradeUpsert {
+	u.Add(salarygrade.FieldMaxAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SalaryGradeUpsert) SetCurrency(v string) *SalaryGradeUpsert {
+	u.Set(salarygrade.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SalaryGradeUpsert) UpdateCurrency() *SalaryGradeUpsert {
+	u.SetExcluded(salarygrade.FieldCurrency)
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *SalaryGradeUpsert) SetOrgID(v int) *SalaryGradeUpsert {
+	u.Set(salarygrade.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *SalaryGradeUpsert) UpdateOrgID() *SalaryGradeUpsert {
+	u.SetExcluded(salarygrade.FieldOrgID)
+	return u
+}
+
+// SetPositionID sets the "position_id" field.
+func (u *SalaryGradeUpsert) SetPositionID(v int) *SalaryGradeUpsert {
+	u.Set(salarygrade.FieldPositionID, v)
+	return u
+}
+
+// UpdatePositionID sets the "position_id" field to the value that was provided on create.
+func (u *SalaryGradeUpsert) UpdatePositionID() *SalaryGradeUpsert {
+	u.SetExcluded(salarygrade.FieldPositionID)
+	return u
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (u *SalaryGradeUpsert) ClearPositionID() *SalaryGradeUpsert {
+	u.SetNull(salarygrade.FieldPositionID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SalaryGradeUpsert) SetUpdatedAt(v time.Time) *SalaryGradeUpsert {
+	u.Set(salarygrade.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SalaryGradeUpsert) UpdateUpdatedAt() *SalaryGradeUpsert {
+	u.SetExcluded(salarygrade.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SalaryGrade.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SalaryGradeUpsertOne) UpdateNewValues() *SalaryGradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(salarygrade.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SalaryGrade.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SalaryGradeUpsertOne) Ignore() *SalaryGradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SalaryGradeUpsertOne) DoNothing() *SalaryGradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SalaryGradeCreate.OnConflict
+// documentation for more info.
+func (u *SalaryGradeUpsertOne) Update(set func(*SalaryGradeUpsert)) *SalaryGradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SalaryGradeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *SalaryGradeUpsertOne) SetCode(v string) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SalaryGradeUpsertOne) UpdateCode() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SalaryGradeUpsertOne) SetName(v string) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SalaryGradeUpsertOne) UpdateName() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *SalaryGradeUpsertOne) SetMinAmount(v int64) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *SalaryGradeUpsertOne) AddMinAmount(v int64) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *SalaryGradeUpsertOne) UpdateMinAmount() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *SalaryGradeUpsertOne) SetMaxAmount(v int64) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *SalaryGradeUpsertOne) AddMaxAmount(v int64) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *SalaryGradeUpsertOne) UpdateMaxAmount() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SalaryGradeUpsertOne) SetCurrency(v string) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SalaryGradeUpsertOne) UpdateCurrency() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *SalaryGradeUpsertOne) SetOrgID(v int) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *SalaryGradeUpsertOne) UpdateOrgID() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetPositionID sets the "position_id" field.
+func (u *SalaryGradeUpsertOne) SetPositionID(v int) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetPositionID(v)
+	})
+}
+
+// UpdatePositionID sets the "position_id" field to the value that was provided on create.
+func (u *SalaryGradeUpsertOne) UpdatePositionID() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdatePositionID()
+	})
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (u *SalaryGradeUpsertOne) ClearPositionID() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.ClearPositionID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SalaryGradeUpsertOne) SetUpdatedAt(v time.Time) *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SalaryGradeUpsertOne) UpdateUpdatedAt() *SalaryGradeUpsertOne {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SalaryGradeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SalaryGradeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SalaryGradeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SalaryGradeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SalaryGradeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SalaryGradeCreateBulk is the builder for creating many SalaryGrade entities in bulk.
+type SalaryGradeCreateBulk struct {
+	config
+	err      error
+	builders []*SalaryGradeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SalaryGrade entities in the database.
+func (sgcb *SalaryGradeCreateBulk) Save(ctx context.Context) ([]*SalaryGrade, error) {
+	if sgcb.err != nil {
+		return nil, sgcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sgcb.builders))
+	nodes := make([]*SalaryGrade, len(sgcb.builders))
+	mutators := make([]Mutator, len(sgcb.builders))
+	for i := range sgcb.builders {
+		func(i int, root context.Context) {
+			builder := sgcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SalaryGradeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sgcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sgcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sgcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sgcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sgcb *SalaryGradeCreateBulk) SaveX(ctx context.Context) []*SalaryGrade {
+	v, err := sgcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sgcb *SalaryGradeCreateBulk) Exec(ctx context.Context) error {
+	_, err := sgcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgcb *SalaryGradeCreateBulk) ExecX(ctx context.Context) {
+	if err := sgcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SalaryGrade.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SalaryGradeUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (sgcb *SalaryGradeCreateBulk) OnConflict(opts ...sql.ConflictOption) *SalaryGradeUpsertBulk {
+	sgcb.conflict = opts
+	return &SalaryGradeUpsertBulk{
+		create: sgcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SalaryGrade.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sgcb *SalaryGradeCreateBulk) OnConflictColumns(columns ...string) *SalaryGradeUpsertBulk {
+	sgcb.conflict = append(sgcb.conflict, sql.ConflictColumns(columns...))
+	return &SalaryGradeUpsertBulk{
+		create: sgcb,
+	}
+}
+
+// SalaryGradeUpsertBulk is the builder for "upsert"-ing
+// a bulk of SalaryGrade nodes.
+type SalaryGradeUpsertBulk struct {
+	create *SalaryGradeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SalaryGrade.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SalaryGradeUpsertBulk) UpdateNewValues() *SalaryGradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(salarygrade.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SalaryGrade.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SalaryGradeUpsertBulk) Ignore() *SalaryGradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SalaryGradeUpsertBulk) DoNothing() *SalaryGradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SalaryGradeCreateBulk.OnConflict
+// documentation for more info.
+func (u *SalaryGradeUpsertBulk) Update(set func(*SalaryGradeUpsert)) *SalaryGradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SalaryGradeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *SalaryGradeUpsertBulk) SetCode(v string) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SalaryGradeUpsertBulk) UpdateCode() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SalaryGradeUpsertBulk) SetName(v string) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SalaryGradeUpsertBulk) UpdateName() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *SalaryGradeUpsertBulk) SetMinAmount(v int64) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *SalaryGradeUpsertBulk) AddMinAmount(v int64) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *SalaryGradeUpsertBulk) UpdateMinAmount() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *SalaryGradeUpsertBulk) SetMaxAmount(v int64) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *SalaryGradeUpsertBulk) AddMaxAmount(v int64) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *SalaryGradeUpsertBulk) UpdateMaxAmount() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *SalaryGradeUpsertBulk) SetCurrency(v string) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SalaryGradeUpsertBulk) UpdateCurrency() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *SalaryGradeUpsertBulk) SetOrgID(v int) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *SalaryGradeUpsertBulk) UpdateOrgID() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetPositionID sets the "position_id" field.
+func (u *SalaryGradeUpsertBulk) SetPositionID(v int) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetPositionID(v)
+	})
+}
+
+// UpdatePositionID sets the "position_id" field to the value that was provided on create.
+func (u *SalaryGradeUpsertBulk) UpdatePositionID() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdatePositionID()
+	})
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (u *SalaryGradeUpsertBulk) ClearPositionID() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.ClearPositionID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SalaryGradeUpsertBulk) SetUpdatedAt(v time.Time) *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SalaryGradeUpsertBulk) UpdateUpdatedAt() *SalaryGradeUpsertBulk {
+	return u.Update(func(s *SalaryGradeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SalaryGradeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SalaryGradeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SalaryGradeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SalaryGradeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+)
+
+// SalaryGradeDelete is the builder for deleting a SalaryGrade entity.
+type SalaryGradeDelete struct {
+	config
+	hooks    []Hook
+	mutation *SalaryGradeMutation
+}
+
+// Where appends a list predicates to the SalaryGradeDelete builder.
+func (sgd *SalaryGradeDelete) Where(ps ...predicate.SalaryGrade) *SalaryGradeDelete {
+	sgd.mutation.Where(ps...)
+	return sgd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sgd *SalaryGradeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sgd.sqlExec, sgd.mutation, sgd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgd *SalaryGradeDelete) ExecX(ctx context.Context) int {
+	n, err := sgd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sgd *SalaryGradeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(salarygrade.Table, sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt))
+	if ps := sgd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sgd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sgd.mutation.done = true
+	return affected, err
+}
+
+// SalaryGradeDeleteOne is the builder for deleting a single SalaryGrade entity.
+type SalaryGradeDeleteOne struct {
+	sgd *SalaryGradeDelete
+}
+
+// Where appends a list predicates to the SalaryGradeDelete builder.
+func (sgdo *SalaryGradeDeleteOne) Where(ps ...predicate.SalaryGrade) *SalaryGradeDeleteOne {
+	sgdo.sgd.mutation.Where(ps...)
+	return sgdo
+}
+
+// Exec executes the deletion query.
+func (sgdo *SalaryGradeDeleteOne) Exec(ctx context.Context) error {
+	n, err := sgdo.sgd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{salarygrade.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgdo *SalaryGradeDeleteOne) ExecX(ctx context.Context) {
+	if err := sgdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,762 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+)
+
+// SalaryGradeQuery is the builder for querying SalaryGrade entities.
+type SalaryGradeQuery struct {
+	config
+	ctx               *QueryContext
+	order             []salarygrade.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.SalaryGrade
+	withOrganization  *OrganizationQuery
+	withPosition      *PositionQuery
+	withCompensations *CompensationQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SalaryGradeQuery builder.
+func (sgq *SalaryGradeQuery) Where(ps ...predicate.SalaryGrade) *SalaryGradeQuery {
+	sgq.predicates = append(sgq.predicates, ps...)
+	return sgq
+}
+
+// Limit the number of records to be returned by this query.
+func (sgq *SalaryGradeQuery) Limit(limit int) *SalaryGradeQuery {
+	sgq.ctx.Limit = &limit
+	return sgq
+}
+
+// Offset to start from.
+func (sgq *SalaryGradeQuery) Offset(offset int) *SalaryGradeQuery {
+	sgq.ctx.Offset = &offset
+	return sgq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sgq *SalaryGradeQuery) Unique(unique bool) *SalaryGradeQuery {
+	sgq.ctx.Unique = &unique
+	return sgq
+}
+
+// Order specifies how the records should be ordered.
+func (sgq *SalaryGradeQuery) Order(o ...salarygrade.OrderOption) *SalaryGradeQuery {
+	sgq.order = append(sgq.order, o...)
+	return sgq
+}
+
+// QueryOrganization chains the current query on the "organization" edge.
+func (sgq *SalaryGradeQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: sgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarygrade.Table, salarygrade.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarygrade.OrganizationTable, salarygrade.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPosition chains the current query on the "position" edge.
+func (sgq *SalaryGradeQuery) QueryPosition() *PositionQuery {
+	query := (&PositionClient{config: sgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarygrade.Table, salarygrade.FieldID, selector),
+			sqlgraph.To(position.Table, position.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, salarygrade.PositionTable, salarygrade.PositionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCompensations chains the current query on the "compensations" edge.
+func (sgq *SalaryGradeQuery) QueryCompensations() *CompensationQuery {
+	query := (&CompensationClient{config: sgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(salarygrade.Table, salarygrade.FieldID, selector),
+			sqlgraph.To(compensation.Table, compensation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, salarygrade.CompensationsTable, salarygrade.CompensationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SalaryGrade entity from the query.
+// Returns a *NotFoundError when no SalaryGrade was found.
+func (sgq *SalaryGradeQuery) First(ctx context.Context) (*SalaryGrade, error) {
+	nodes, err := sgq.Limit(1).All(setContextOp(ctx, sgq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{salarygrade.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sgq *SalaryGradeQuery) FirstX(ctx context.Context) *SalaryGrade {
+	node, err := sgq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SalaryGrade ID from the query.
+// Returns a *NotFoundError when no SalaryGrade ID was found.
+func (sgq *SalaryGradeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sgq.Limit(1).IDs(setContextOp(ctx, sgq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{salarygrade.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sgq *SalaryGradeQuery) FirstIDX(ctx context.Context) int {
+	id, err := sgq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SalaryGrade entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SalaryGrade entity is found.
+// Returns a *NotFoundError when no SalaryGrade entities are found.
+func (sgq *SalaryGradeQuery) Only(ctx context.Context) (*SalaryGrade, error) {
+	nodes, err := sgq.Limit(2).All(setContextOp(ctx, sgq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{salarygrade.Label}
+	default:
+		return nil, &NotSingularError{salarygrade.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sgq *SalaryGradeQuery) OnlyX(ctx context.Context) *SalaryGrade {
+	node, err := sgq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SalaryGrade ID in the query.
+// Returns a *NotSingularError when more than one SalaryGrade ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sgq *SalaryGradeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sgq.Limit(2).IDs(setContextOp(ctx, sgq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{salarygrade.Label}
+	default:
+		err = &NotSingularError{salarygrade.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sgq *SalaryGradeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := sgq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SalaryGrades.
+func (sgq *SalaryGradeQuery) All(ctx context.Context) ([]*SalaryGrade, error) {
+	ctx = setContextOp(ctx, sgq.ctx, ent.OpQueryAll)
+	if err := sgq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SalaryGrade, *SalaryGradeQuery]()
+	return withInterceptors[[]*SalaryGrade](ctx, sgq, qr, sgq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sgq *SalaryGradeQuery) AllX(ctx context.Context) []*SalaryGrade {
+	nodes, err := sgq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SalaryGrade IDs.
+func (sgq *SalaryGradeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if sgq.ctx.Unique == nil && sgq.path != nil {
+		sgq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sgq.ctx, ent.OpQueryIDs)
+	if err = sgq.Select(salarygrade.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sgq *SalaryGradeQuery) IDsX(ctx context.Context) []int {
+	ids, err := sgq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sgq *SalaryGradeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sgq.ctx, ent.OpQueryCount)
+	if err := sgq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sgq, querierCount[*SalaryGradeQuery](), sgq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sgq *SalaryGradeQuery) CountX(ctx context.Context) int {
+	count, err := sgq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sgq *SalaryGradeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sgq.ctx, ent.OpQueryExist)
+	switch _, err := sgq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sgq *SalaryGradeQuery) ExistX(ctx context.Context) bool {
+	exist, err := sgq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SalaryGradeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sgq *SalaryGradeQuery) Clone() *SalaryGradeQuery {
+	if sgq == nil {
+		return nil
+	}
+	return &SalaryGradeQuery{
+		config:            sgq.config,
+		ctx:               sgq.ctx.Clone(),
+		order:             append([]salarygrade.OrderOption{}, sgq.order...),
+		inters:            append([]Interceptor{}, sgq.inters...),
+		predicates:        append([]predicate.SalaryGrade{}, sgq.predicates...),
+		withOrganization:  sgq.withOrganization.Clone(),
+		withPosition:      sgq.withPosition.Clone(),
+		withCompensations: sgq.withCompensations.Clone(),
+		// clone intermediate query.
+		sql:  sgq.sql.Clone(),
+		path: sgq.path,
+	}
+}
+
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (sgq *SalaryGradeQuery) WithOrganization(opts ...func(*OrganizationQuery)) *SalaryGradeQuery {
+	query := (&OrganizationClient{config: sgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sgq.withOrganization = query
+	return sgq
+}
+
+// WithPosition tells the query-builder to eager-load the nodes that are connected to
+// the "position" edge. The optional arguments are used to configure the query builder of the edge.
+func (sgq *SalaryGradeQuery) WithPosition(opts ...func(*PositionQuery)) *SalaryGradeQuery {
+	query := (&PositionClient{config: sgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sgq.withPosition = query
+	return sgq
+}
+
+// WithCompensations tells the query-builder to eager-load the nodes that are connected to
+// the "compensations" edge. The optional arguments are used to configure the query builder of the edge.
+func (sgq *SalaryGradeQuery) WithCompensations(opts ...func(*CompensationQuery)) *SalaryGradeQuery {
+	query := (&CompensationClient{config: sgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sgq.withCompensations = query
+	return sgq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SalaryGrade.Query().
+//		GroupBy(salarygrade.FieldCode).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sgq *SalaryGradeQuery) GroupBy(field string, fields ...string) *SalaryGradeGroupBy {
+	sgq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SalaryGradeGroupBy{build: sgq}
+	grbuild.flds = &sgq.ctx.Fields
+	grbuild.label = salarygrade.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code"`
+//	}
+//
+//	client.SalaryGrade.Query().
+//		Select(salarygrade.FieldCode).
+//		Scan(ctx, &v)
+func (sgq *SalaryGradeQuery) Select(fields ...string) *SalaryGradeSelect {
+	sgq.ctx.Fields = append(sgq.ctx.Fields, fields...)
+	sbuild := &SalaryGradeSelect{SalaryGradeQuery: sgq}
+	sbuild.label = salarygrade.Label
+	sbuild.flds, sbuild.scan = &sgq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SalaryGradeSelect configured with the given aggregations.
+func (sgq *SalaryGradeQuery) Aggregate(fns ...AggregateFunc) *SalaryGradeSelect {
+	return sgq.Select().Aggregate(fns...)
+}
+
+func (sgq *SalaryGradeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sgq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sgq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sgq.ctx.Fields {
+		if !salarygrade.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sgq.path != nil {
+		prev, err := sgq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sgq.sql = prev
+	}
+	return nil
+}
+
+func (sgq *SalaryGradeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SalaryGrade, error) {
+	var (
+		nodes       = []*SalaryGrade{}
+		_spec       = sgq.querySpec()
+		loadedTypes = [3]bool{
+			sgq.withOrganization != nil,
+			sgq.withPosition != nil,
+			sgq.withCompensations != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SalaryGrade).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SalaryGrade{config: sgq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sgq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sgq.withOrganization; query != nil {
+		if err := sgq.loadOrganization(ctx, query, nodes, nil,
+			func(n *SalaryGrade, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sgq.withPosition; query != nil {
+		if err := sgq.loadPosition(ctx, query, nodes, nil,
+			func(n *SalaryGrade, e *Position) { n.Edges.Position = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sgq.withCompensations; query != nil {
+		if err := sgq.loadCompensations(ctx, query, nodes,
+			func(n *SalaryGrade) { n.Edges.Compensations = []*Compensation{} },
+			func(n *SalaryGrade, e *Compensation) { n.Edges.Compensations = append(n.Edges.Compensations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sgq *SalaryGradeQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*SalaryGrade, init func(*SalaryGrade), assign func(*SalaryGrade, *Organization)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SalaryGrade)
+	for i := range nodes {
+		fk := nodes[i].OrgID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "org_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (sgq *SalaryGradeQuery) loadPosition(ctx context.Context, query *PositionQuery, nodes []*SalaryGrade, init func(*SalaryGrade), assign func(*SalaryGrade, *Position)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SalaryGrade)
+	for i := range nodes {
+		if nodes[i].PositionID == nil {
+			continue
+		}
+		fk := *nodes[i].PositionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(position.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "position_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (sgq *SalaryGradeQuery) loadCompensations(ctx context.Context, query *CompensationQuery, nodes []*SalaryGrade, init func(*SalaryGrade), assign func(*SalaryGrade, *Compensation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SalaryGrade)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(compensation.FieldSalaryGradeID)
+	}
+	query.Where(predicate.Compensation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(salarygrade.CompensationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SalaryGradeID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "salary_grade_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "salary_grade_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (sgq *SalaryGradeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sgq.querySpec()
+	_spec.Node.Columns = sgq.ctx.Fields
+	if len(sgq.ctx.Fields) > 0 {
+		_spec.Unique = sgq.ctx.Unique != nil && *sgq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sgq.driver, _spec)
+}
+
+func (sgq *SalaryGradeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(salarygrade.Table, salarygrade.Columns, sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt))
+	_spec.From = sgq.sql
+	if unique := sgq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sgq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sgq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, salarygrade.FieldID)
+		for i := range fields {
+			if fields[i] != salarygrade.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if sgq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(salarygrade.FieldOrgID)
+		}
+		if sgq.withPosition != nil {
+			_spec.Node.AddColumnOnce(salarygrade.FieldPositionID)
+		}
+	}
+	if ps := sgq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sgq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sgq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sgq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sgq *SalaryGradeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sgq.driver.Dialect())
+	t1 := builder.Table(salarygrade.Table)
+	columns := sgq.ctx.Fields
+	if len(columns) == 0 {
+		columns = salarygrade.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sgq.sql != nil {
+		selector = sgq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sgq.ctx.Unique != nil && *sgq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range sgq.predicates {
+		p(selector)
+	}
+	for _, p := range sgq.order {
+		p(selector)
+	}
+	if offset := sgq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sgq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SalaryGradeGroupBy is the group-by builder for SalaryGrade entities.
+type SalaryGradeGroupBy struct {
+	selector
+	build *SalaryGradeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sggb *SalaryGradeGroupBy) Aggregate(fns ...AggregateFunc) *SalaryGradeGroupBy {
+	sggb.fns = append(sggb.fns, fns...)
+	return sggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sggb *SalaryGradeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sggb.build.ctx, ent.OpQueryGroupBy)
+	if err := sggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SalaryGradeQuery, *SalaryGradeGroupBy](ctx, sggb.build, sggb, sggb.build.inters, v)
+}
+
+func (sggb *SalaryGradeGroupBy) sqlScan(ctx context.Context, root *SalaryGradeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sggb.fns))
+	for _, fn := range sggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sggb.flds)+len(sggb.fns))
+		for _, f := range *sggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SalaryGradeSelect is the builder for selecting fields of SalaryGrade entities.
+type SalaryGradeSelect struct {
+	*SalaryGradeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sgs *SalaryGradeSelect) Aggregate(fns ...AggregateFunc) *SalaryGradeSelect {
+	sgs.fns = append(sgs.fns, fns...)
+	return sgs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sgs *SalaryGradeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sgs.ctx, ent.OpQuerySelect)
+	if err := sgs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SalaryGradeQuery, *SalaryGradeSelect](ctx, sgs.SalaryGradeQuery, sgs, sgs.inters, v)
+}
+
+func (sgs *SalaryGradeSelect) sqlScan(ctx context.Context, root *SalaryGradeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sgs.fns))
+	for _, fn := range sgs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sgs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sgs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,889 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+)
+
+// SalaryGradeUpdate is the builder for updating SalaryGrade entities.
+type SalaryGradeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SalaryGradeMutation
+}
+
+// Where appends a list predicates to the SalaryGradeUpdate builder.
+func (sgu *SalaryGradeUpdate) Where(ps ...predicate.SalaryGrade) *SalaryGradeUpdate {
+	sgu.mutation.Where(ps...)
+	return sgu
+}
+
+// SetCode sets the "code" field.
+func (sgu *SalaryGradeUpdate) SetCode(s string) *SalaryGradeUpdate {
+	sgu.mutation.SetCode(s)
+	return sgu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (sgu *SalaryGradeUpdate) SetNillableCode(s *string) *SalaryGradeUpdate {
+	if s != nil {
+		sgu.SetCode(*s)
+	}
+	return sgu
+}
+
+// SetName sets the "name" field.
+func (sgu *SalaryGradeUpdate) SetName(s string) *SalaryGradeUpdate {
+	sgu.mutation.SetName(s)
+	return sgu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sgu *SalaryGradeUpdate) SetNillableName(s *string) *SalaryGradeUpdate {
+	if s != nil {
+		sgu.SetName(*s)
+	}
+	return sgu
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (sgu *SalaryGradeUpdate) SetMinAmount(i int64) *SalaryGradeUpdate {
+	sgu.mutation.ResetMinAmount()
+	sgu.mutation.SetMinAmount(i)
+	return sgu
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (sgu *SalaryGradeUpdate) SetNillableMinAmount(i *int64) *SalaryGradeUpdate {
+	if i != nil {
+		sgu.SetMinAmount(*i)
+	}
+	return sgu
+}
+
+// AddMinAmount adds i to the "min_amount" field.
+func (sgu *SalaryGradeUpdate) AddMinAmount(i int64) *SalaryGradeUpdate {
+	sgu.mutation.AddMinAmount(i)
+	return sgu
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (sgu *SalaryGradeUpdate) SetMaxAmount(i int64) *SalaryGradeUpdate {
+	sgu.mutation.ResetMaxAmount()
+	sgu.mutation.SetMaxAmount(i)
+	return sgu
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (sgu *SalaryGradeUpdate) SetNillableMaxAmount(i *int64) *SalaryGradeUpdate {
+	if i != nil {
+		sgu.SetMaxAmount(*i)
+	}
+	return sgu
+}
+
+// AddMaxAmount adds i to the "max_amount" field.
+func (sgu *SalaryGradeUpdate) AddMaxAmount(i int64) *SalaryGradeUpdate {
+	sgu.mutation.AddMaxAmount(i)
+	return sgu
+}
+
+// SetCurrency sets the "currency" field.
+func (sgu *SalaryGradeUpdate) SetCurrency(s string) *SalaryGradeUpdate {
+	sgu.mutation.SetCurrency(s)
+	return sgu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (sgu *SalaryGradeUpdate) SetNillableCurrency(s *string) *SalaryGradeUpdate {
+	if s != nil {
+		sgu.SetCurrency(*s)
+	}
+	return sgu
+}
+
+// SetOrgID sets the "org_id" field.
+func (sgu *SalaryGradeUpdate) SetOrgID(i int) *SalaryGradeUpdate {
+	sgu.mutation.SetOrgID(i)
+	return sgu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (sgu *SalaryGradeUpdate) SetNillableOrgID(i *int) *SalaryGradeUpdate {
+	if i != nil {
+		sgu.SetOrgID(*i)
+	}
+	return sgu
+}
+
+// SetPositionID sets the "position_id" field.
+func (sgu *SalaryGradeUpdate) SetPositionID(i int) *SalaryGradeUpdate {
+	sgu.mutation.SetPositionID(i)
+	return sgu
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (sgu *SalaryGradeUpdate) SetNillablePositionID(i *int) *SalaryGradeUpdate {
+	if i != nil {
+		sgu.SetPositionID(*i)
+	}
+	return sgu
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (sgu *SalaryGradeUpdate) ClearPositionID() *SalaryGradeUpdate {
+	sgu.mutation.ClearPositionID()
+	return sgu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sgu *SalaryGradeUpdate) SetUpdatedAt(t time.Time) *SalaryGradeUpdate {
+	sgu.mutation.SetUpdatedAt(t)
+	return sgu
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (sgu *SalaryGradeUpdate) SetOrganizationID(id int) *SalaryGradeUpdate {
+	sgu.mutation.SetOrganizationID(id)
+	return sgu
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (sgu *SalaryGradeUpdate) SetOrganization(o *Organization) *SalaryGradeUpdate {
+	return sgu.SetOrganizationID(o.ID)
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (sgu *SalaryGradeUpdate) SetPosition(p *Position) *SalaryGradeUpdate {
+	return sgu.SetPositionID(p.ID)
+}
+
+// AddCompensationIDs adds the "compensations" edge to the Compensation entity by IDs.
+func (sgu *SalaryGradeUpdate) AddCompensationIDs(ids ...int) *SalaryGradeUpdate {
+	sgu.mutation.AddCompensationIDs(ids...)
+	return sgu
+}
+
+// AddCompensations adds the "compensations" edges to the Compensation entity.
+func (sgu *SalaryGradeUpdate) AddCompensations(c ...*Compensation) *SalaryGradeUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sgu.AddCompensationIDs(ids...)
+}
+
+// Mutation returns the SalaryGradeMutation object of the builder.
+func (sgu *SalaryGradeUpdate) Mutation() *SalaryGradeMutation {
+	return sgu.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (sgu *SalaryGradeUpdate) ClearOrganization() *SalaryGradeUpdate {
+	sgu.mutation.ClearOrganization()
+	return sgu
+}
+
+// ClearPosition clears the "position" edge to the Position entity.
+func (sgu *SalaryGradeUpdate) ClearPosition() *SalaryGradeUpdate {
+	sgu.mutation.ClearPosition()
+	return sgu
+}
+
+// ClearCompensations clears all "compensations" edges to the Compensation entity.
+func (sgu *SalaryGradeUpdate) ClearCompensations() *SalaryGradeUpdate {
+	sgu.mutation.ClearCompensations()
+	return sgu
+}
+
+// RemoveCompensationIDs removes the "compensations" edge to Compensation entities by IDs.
+func (sgu *SalaryGradeUpdate) RemoveCompensationIDs(ids ...int) *SalaryGradeUpdate {
+	sgu.mutation.RemoveCompensationIDs(ids...)
+	return sgu
+}
+
+// RemoveCompensations removes "compensations" edges to Compensation entities.
+func (sgu *SalaryGradeUpdate) RemoveCompensations(c ...*Compensation) *SalaryGradeUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sgu.RemoveCompensationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sgu *SalaryGradeUpdate) Save(ctx context.Context) (int, error) {
+	sgu.defaults()
+	return withHooks(ctx, sgu.sqlSave, sgu.mutation, sgu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sgu *SalaryGradeUpdate) SaveX(ctx context.Context) int {
+	affected, err := sgu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sgu *SalaryGradeUpdate) Exec(ctx context.Context) error {
+	_, err := sgu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgu *SalaryGradeUpdate) ExecX(ctx context.Context) {
+	if err := sgu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sgu *SalaryGradeUpdate) defaults() {
+	if _, ok := sgu.mutation.UpdatedAt(); !ok {
+		v := salarygrade.UpdateDefaultUpdatedAt()
+		sgu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sgu *SalaryGradeUpdate) check() error {
+	if v, ok := sgu.mutation.Code(); ok {
+		if err := salarygrade.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.code": %w`, err)}
+		}
+	}
+	if v, ok := sgu.mutation.Name(); ok {
+		if err := salarygrade.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.name": %w`, err)}
+		}
+	}
+	if v, ok := sgu.mutation.MinAmount(); ok {
+		if err := salarygrade.MinAmountValidator(v); err != nil {
+			return &ValidationError{Name: "min_amount", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.min_amount": %w`, err)}
+		}
+	}
+	if v, ok := sgu.mutation.MaxAmount(); ok {
+		if err := salarygrade.MaxAmountValidator(v); err != nil {
+			return &ValidationError{Name: "max_amount", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.max_amount": %w`, err)}
+		}
+	}
+	if sgu.mutation.OrganizationCleared() && len(sgu.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SalaryGrade.organization"`)
+	}
+	return nil
+}
+
+func (sgu *SalaryGradeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sgu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(salarygrade.Table, salarygrade.Columns, sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt))
+	if ps := sgu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sgu.mutation.Code(); ok {
+		_spec.SetField(salarygrade.FieldCode, field.TypeString, value)
+	}
+	if value, ok := sgu.mutation.Name(); ok {
+		_spec.SetField(salarygrade.FieldName, field.TypeString, value)
+	}
+	if value, ok := sgu.mutation.MinAmount(); ok {
+		_spec.SetField(salarygrade.FieldMinAmount, field.TypeInt64, value)
+	}
+	if value, ok := sgu.mutation.AddedMinAmount(); ok {
+		_spec.AddField(salarygrade.FieldMinAmount, field.TypeInt64, value)
+	}
+	if value, ok := sgu.mutation.MaxAmount(); ok {
+		_spec.SetField(salarygrade.FieldMaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := sgu.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(salarygrade.FieldMaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := sgu.mutation.Currency(); ok {
+		_spec.SetField(salarygrade.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := sgu.mutation.UpdatedAt(); ok {
+		_spec.SetField(salarygrade.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sgu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.OrganizationTable,
+			Columns: []string{salarygrade.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.OrganizationTable,
+			Columns: []string{salarygrade.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sgu.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.PositionTable,
+			Columns: []string{salarygrade.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.PositionTable,
+			Columns: []string{salarygrade.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sgu.mutation.CompensationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarygrade.CompensationsTable,
+			Columns: []string{salarygrade.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.RemovedCompensationsIDs(); len(nodes) > 0 && !sgu.mutation.CompensationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarygrade.CompensationsTable,
+			Columns: []string{salarygrade.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.CompensationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarygrade.CompensationsTable,
+			Columns: []string{salarygrade.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sgu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{salarygrade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sgu.mutation.done = true
+	return n, nil
+}
+
+// SalaryGradeUpdateOne is the builder for updating a single SalaryGrade entity.
+type SalaryGradeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SalaryGradeMutation
+}
+
+// SetCode sets the "code" field.
+func (sguo *SalaryGradeUpdateOne) SetCode(s string) *SalaryGradeUpdateOne {
+	sguo.mutation.SetCode(s)
+	return sguo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (sguo *SalaryGradeUpdateOne) SetNillableCode(s *string) *SalaryGradeUpdateOne {
+	if s != nil {
+		sguo.SetCode(*s)
+	}
+	return sguo
+}
+
+// SetName sets the "name" field.
+func (sguo *SalaryGradeUpdateOne) SetName(s string) *SalaryGradeUpdateOne {
+	sguo.mutation.SetName(s)
+	return sguo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sguo *SalaryGradeUpdateOne) SetNillableName(s *string) *SalaryGradeUpdateOne {
+	if s != nil {
+		sguo.SetName(*s)
+	}
+	return sguo
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (sguo *SalaryGradeUpdateOne) SetMinAmount(i int64) *SalaryGradeUpdateOne {
+	sguo.mutation.ResetMinAmount()
+	sguo.mutation.SetMinAmount(i)
+	return sguo
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (sguo *SalaryGradeUpdateOne) SetNillableMinAmount(i *int64) *SalaryGradeUpdateOne {
+	if i != nil {
+		sguo.SetMinAmount(*i)
+	}
+	return sguo
+}
+
+// AddMinAmount adds i to the "min_amount" field.
+func (sguo *SalaryGradeUpdateOne) AddMinAmount(i int64) *SalaryGradeUpdateOne {
+	sguo.mutation.AddMinAmount(i)
+	return sguo
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (sguo *SalaryGradeUpdateOne) SetMaxAmount(i int64) *SalaryGradeUpdateOne {
+	sguo.mutation.ResetMaxAmount()
+	sguo.mutation.SetMaxAmount(i)
+	return sguo
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (sguo *SalaryGradeUpdateOne) SetNillableMaxAmount(i *int64) *SalaryGradeUpdateOne {
+	if i != nil {
+		sguo.SetMaxAmount(*i)
+	}
+	return sguo
+}
+
+// AddMaxAmount adds i to the "max_amount" field.
+func (sguo *SalaryGradeUpdateOne) AddMaxAmount(i int64) *SalaryGradeUpdateOne {
+	sguo.mutation.AddMaxAmount(i)
+	return sguo
+}
+
+// SetCurrency sets the "currency" field.
+func (sguo *SalaryGradeUpdateOne) SetCurrency(s string) *SalaryGradeUpdateOne {
+	sguo.mutation.SetCurrency(s)
+	return sguo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (sguo *SalaryGradeUpdateOne) SetNillableCurrency(s *string) *SalaryGradeUpdateOne {
+	if s != nil {
+		sguo.SetCurrency(*s)
+	}
+	return sguo
+}
+
+// SetOrgID sets the "org_id" field.
+func (sguo *SalaryGradeUpdateOne) SetOrgID(i int) *SalaryGradeUpdateOne {
+	sguo.mutation.SetOrgID(i)
+	return sguo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (sguo *SalaryGradeUpdateOne) SetNillableOrgID(i *int) *SalaryGradeUpdateOne {
+	if i != nil {
+		sguo.SetOrgID(*i)
+	}
+	return sguo
+}
+
+// SetPositionID sets the "position_id" field.
+func (sguo *SalaryGradeUpdateOne) SetPositionID(i int) *SalaryGradeUpdateOne {
+	sguo.mutation.SetPositionID(i)
+	return sguo
+}
+
+// SetNillablePositionID sets the "position_id" field if the given value is not nil.
+func (sguo *SalaryGradeUpdateOne) SetNillablePositionID(i *int) *SalaryGradeUpdateOne {
+	if i != nil {
+		sguo.SetPositionID(*i)
+	}
+	return sguo
+}
+
+// ClearPositionID clears the value of the "position_id" field.
+func (sguo *SalaryGradeUpdateOne) ClearPositionID() *SalaryGradeUpdateOne {
+	sguo.mutation.ClearPositionID()
+	return sguo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sguo *SalaryGradeUpdateOne) SetUpdatedAt(t time.Time) *SalaryGradeUpdateOne {
+	sguo.mutation.SetUpdatedAt(t)
+	return sguo
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (sguo *SalaryGradeUpdateOne) SetOrganizationID(id int) *SalaryGradeUpdateOne {
+	sguo.mutation.SetOrganizationID(id)
+	return sguo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (sguo *SalaryGradeUpdateOne) SetOrganization(o *Organization) *SalaryGradeUpdateOne {
+	return sguo.SetOrganizationID(o.ID)
+}
+
+// SetPosition sets the "position" edge to the Position entity.
+func (sguo *SalaryGradeUpdateOne) SetPosition(p *Position) *SalaryGradeUpdateOne {
+	return sguo.SetPositionID(p.ID)
+}
+
+// AddCompensationIDs adds the "compensations" edge to the Compensation entity by IDs.
+func (sguo *SalaryGradeUpdateOne) AddCompensationIDs(ids ...int) *SalaryGradeUpdateOne {
+	sguo.mutation.AddCompensationIDs(ids...)
+	return sguo
+}
+
+// AddCompensations adds the "compensations" edges to the Compensation entity.
+func (sguo *SalaryGradeUpdateOne) AddCompensations(c ...*Compensation) *SalaryGradeUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sguo.AddCompensationIDs(ids...)
+}
+
+// Mutation returns the SalaryGradeMutation object of the builder.
+func (sguo *SalaryGradeUpdateOne) Mutation() *SalaryGradeMutation {
+	return sguo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (sguo *SalaryGradeUpdateOne) ClearOrganization() *SalaryGradeUpdateOne {
+	sguo.mutation.ClearOrganization()
+	return sguo
+}
+
+// ClearPosition clears the "position" edge to the Position entity.
+func (sguo *SalaryGradeUpdateOne) ClearPosition() *SalaryGradeUpdateOne {
+	sguo.mutation.ClearPosition()
+	return sguo
+}
+
+// ClearCompensations clears all "compensations" edges to the Compensation entity.
+func (sguo *SalaryGradeUpdateOne) ClearCompensations() *SalaryGradeUpdateOne {
+	sguo.mutation.ClearCompensations()
+	return sguo
+}
+
+// RemoveCompensationIDs removes the "compensations" edge to Compensation entities by IDs.
+func (sguo *SalaryGradeUpdateOne) RemoveCompensationIDs(ids ...int) *SalaryGradeUpdateOne {
+	sguo.mutation.RemoveCompensationIDs(ids...)
+	return sguo
+}
+
+// RemoveCompensations removes "compensations" edges to Compensation entities.
+func (sguo *SalaryGradeUpdateOne) RemoveCompensations(c ...*Compensation) *SalaryGradeUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sguo.RemoveCompensationIDs(ids...)
+}
+
+// Where appends a list predicates to the SalaryGradeUpdate builder.
+func (sguo *SalaryGradeUpdateOne) Where(ps ...predicate.SalaryGrade) *SalaryGradeUpdateOne {
+	sguo.mutation.Where(ps...)
+	return sguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sguo *SalaryGradeUpdateOne) Select(field string, fields ...string) *SalaryGradeUpdateOne {
+	sguo.fields = append([]string{field}, fields...)
+	return sguo
+}
+
+// Save executes the query and returns the updated SalaryGrade entity.
+func (sguo *SalaryGradeUpdateOne) Save(ctx context.Context) (*SalaryGrade, error) {
+	sguo.defaults()
+	return withHooks(ctx, sguo.sqlSave, sguo.mutation, sguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sguo *SalaryGradeUpdateOne) SaveX(ctx context.Context) *SalaryGrade {
+	node, err := sguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sguo *SalaryGradeUpdateOne) Exec(ctx context.Context) error {
+	_, err := sguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sguo *SalaryGradeUpdateOne) ExecX(ctx context.Context) {
+	if err := sguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sguo *SalaryGradeUpdateOne) defaults() {
+	if _, ok := sguo.mutation.UpdatedAt(); !ok {
+		v := salarygrade.UpdateDefaultUpdatedAt()
+		sguo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sguo *SalaryGradeUpdateOne) check() error {
+	if v, ok := sguo.mutation.Code(); ok {
+		if err := salarygrade.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.code": %w`, err)}
+		}
+	}
+	if v, ok := sguo.mutation.Name(); ok {
+		if err := salarygrade.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.name": %w`, err)}
+		}
+	}
+	if v, ok := sguo.mutation.MinAmount(); ok {
+		if err := salarygrade.MinAmountValidator(v); err != nil {
+			return &ValidationError{Name: "min_amount", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.min_amount": %w`, err)}
+		}
+	}
+	if v, ok := sguo.mutation.MaxAmount(); ok {
+		if err := salarygrade.MaxAmountValidator(v); err != nil {
+			return &ValidationError{Name: "max_amount", err: fmt.Errorf(`ent: validator failed for field "SalaryGrade.max_amount": %w`, err)}
+		}
+	}
+	if sguo.mutation.OrganizationCleared() && len(sguo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SalaryGrade.organization"`)
+	}
+	return nil
+}
+
+func (sguo *SalaryGradeUpdateOne) sqlSave(ctx context.Context) (_node *SalaryGrade, err error) {
+	if err := sguo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(salarygrade.Table, salarygrade.Columns, sqlgraph.NewFieldSpec(salarygrade.FieldID, field.TypeInt))
+	id, ok := sguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SalaryGrade.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, salarygrade.FieldID)
+		for _, f := range fields {
+			if !salarygrade.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != salarygrade.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sguo.mutation.Code(); ok {
+		_spec.SetField(salarygrade.FieldCode, field.TypeString, value)
+	}
+	if value, ok := sguo.mutation.Name(); ok {
+		_spec.SetField(salarygrade.FieldName, field.TypeString, value)
+	}
+	if value, ok := sguo.mutation.MinAmount(); ok {
+		_spec.SetField(salarygrade.FieldMinAmount, field.TypeInt64, value)
+	}
+	if value, ok := sguo.mutation.AddedMinAmount(); ok {
+		_spec.AddField(salarygrade.FieldMinAmount, field.TypeInt64, value)
+	}
+	if value, ok := sguo.mutation.MaxAmount(); ok {
+		_spec.SetField(salarygrade.FieldMaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := sguo.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(salarygrade.FieldMaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := sguo.mutation.Currency(); ok {
+		_spec.SetField(salarygrade.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := sguo.mutation.UpdatedAt(); ok {
+		_spec.SetField(salarygrade.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sguo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.OrganizationTable,
+			Columns: []string{salarygrade.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.OrganizationTable,
+			Columns: []string{salarygrade.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sguo.mutation.PositionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.PositionTable,
+			Columns: []string{salarygrade.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.PositionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   salarygrade.PositionTable,
+			Columns: []string{salarygrade.PositionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(position.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sguo.mutation.CompensationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarygrade.CompensationsTable,
+			Columns: []string{salarygrade.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.RemovedCompensationsIDs(); len(nodes) > 0 && !sguo.mutation.CompensationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarygrade.CompensationsTable,
+			Columns: []string{salarygrade.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.CompensationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   salarygrade.CompensationsTable,
+			Columns: []string{salarygrade.CompensationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(compensation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SalaryGrade{config: sguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{salarygrade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sguo.mutation.done = true
+	return _node, nil
+}
@@ -39,6 +39,15 @@ func (AppointmentHistory) Fields() []ent.Field {
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).
 			StructTag(`json:"updated_at"`).
 			Annotations(entproto.Field(8)),
+		field.Int("position_id").Optional().Nillable().
+			StructTag(`json:"position_id"`).
+			Annotations(entproto.Field(10)),
+		field.Int("department_id").Optional().Nillable().
+			StructTag(`json:"department_id"`).
+			Annotations(entproto.Field(11)),
+		field.Time("effective_to").Optional().Nillable().
+			StructTag(`json:"effective_to"`).
+			Annotations(entproto.Field(12)),
 	}
 }
 
@@ -51,6 +60,18 @@ func (AppointmentHistory) Edges() []ent.Edge {
 			Required().
 			StructTag(`json:"employee"`).
 			Annotations(entproto.Field(9), entsql.OnDelete(entsql.Cascade)),
+		edge.From("position", Position.Type).
+			Ref("appointment_histories").
+			Field("position_id").
+			Unique().
+			StructTag(`json:"position"`).
+			Annotations(entproto.Field(13)),
+		edge.From("department", Department.Type).
+			Ref("appointment_histories").
+			Field("department_id").
+			Unique().
+			StructTag(`json:"department"`).
+			Annotations(entproto.Field(14)),
 	}
 }
 
@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Attachment holds the metadata of an uploaded file. The file itself lives in
+// the configured storage backend under storage_key. An attachment belongs to
+// one record, identified by owner_type and owner_id.
+type Attachment struct {
+	ent.Schema
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		field.Enum("owner_type").
+			Values("task", "task_report", "leave_request", "appointment_history").
+			Default("task").
+			StructTag(`json:"owner_type"`).
+			Annotations(
+				entproto.Field(3),
+				entproto.Enum(map[string]int32{
+					"task":                0,
+					"task_report":         1,
+					"leave_request":       2,
+					"appointment_history": 3,
+				})),
+		field.Int("owner_id").
+			StructTag(`json:"owner_id"`).
+			Annotations(entproto.Field(4)),
+		field.String("file_name").
+			NotEmpty().
+			StructTag(`json:"file_name"`).
+			Annotations(entproto.Field(5)),
+		// MIME type sniffed from the file content, not the one sent by the client
+		field.String("mime_type").
+			StructTag(`json:"mime_type"`).
+			Annotations(entproto.Field(6)),
+		field.Int64("size").
+			StructTag(`json:"size"`).
+			Annotations(entproto.Field(7)),
+		// Hex encoded SHA-256 of the content
+		field.String("checksum").
+			StructTag(`json:"checksum"`).
+			Annotations(entproto.Field(8)),
+		field.String("storage_key").
+			Unique().
+			StructTag(`json:"-"`).
+			Annotations(entproto.Field(9)),
+		field.Enum("scan_status").
+			Values("pending", "clean", "infected", "skipped").
+			Default("pending").
+			StructTag(`json:"scan_status"`).
+			Annotations(
+				entproto.Field(10),
+				entproto.Enum(map[string]int32{
+					"pending":  0,
+					"clean":    1,
+					"infected": 2,
+					"skipped":  3,
+				})),
+		field.Int("uploader_id").
+			StructTag(`json:"uploader_id"`).
+			Annotations(entproto.Field(11)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("attachments").
+			Field("org_id").
+			Unique().
+			Required().
+			StructTag(`json:"organization"`).
+			Annotations(entproto.Field(13)),
+		edge.From("uploader", Employee.Type).
+			Ref("attachments").
+			Field("uploader_id").
+			Unique().
+			Required().
+			StructTag(`json:"uploader"`).
+			Annotations(entproto.Field(14)),
+	}
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_type", "owner_id"),
+	}
+}
+
+func (Attachment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,119 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttendanceCorrection holds a request from an employee to fix the attendance of a work day.
+type AttendanceCorrection struct {
+	ent.Schema
+}
+
+// Fields of the AttendanceCorrection.
+func (AttendanceCorrection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("attendance_record_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"attendance_record_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(3)),
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(4)),
+		field.Time("work_date").
+			StructTag(`json:"work_date"`).
+			Annotations(entproto.Field(5)),
+		field.Time("check_in_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"check_in_at"`).
+			Annotations(entproto.Field(6)),
+		field.Time("check_out_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"check_out_at"`).
+			Annotations(entproto.Field(7)),
+		field.String("reason").
+			StructTag(`json:"reason"`).
+			Annotations(entproto.Field(8)),
+		field.Enum("status").
+			Values("pending", "approved", "rejected").
+			Default("pending").
+			StructTag(`json:"status"`).
+			Annotations(
+				entproto.Field(9),
+				entproto.Enum(map[string]int32{
+					"pending":  0,
+					"approved": 1,
+					"rejected": 2,
+				}),
+			),
+		field.Int("reviewer_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewer_id"`).
+			Annotations(entproto.Field(10)),
+		field.String("review_comment").
+			Optional().
+			Nillable().
+			StructTag(`json:"review_comment"`).
+			Annotations(entproto.Field(11)),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewed_at"`).
+			Annotations(entproto.Field(12)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(13)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(14)),
+	}
+}
+
+// Edges of the AttendanceCorrection.
+func (AttendanceCorrection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attendance_record", AttendanceRecord.Type).
+			Ref("corrections").
+			Field("attendance_record_id").
+			Unique().
+			StructTag(`json:"attendance_record"`).
+			Annotations(entproto.Field(15)),
+		edge.From("employee", Employee.Type).
+			Ref("attendance_corrections").
+			Field("employee_id").
+			Unique().
+			Required().
+			StructTag(`json:"employee"`).
+			Annotations(entproto.Field(16)),
+	}
+}
+
+// Indexes of the AttendanceCorrection.
+func (AttendanceCorrection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "status"),
+	}
+}
+
+func (AttendanceCorrection) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AttendanceRecord holds the clock-in/clock-out of an employee for one work day.
+type AttendanceRecord struct {
+	ent.Schema
+}
+
+// Fields of the AttendanceRecord.
+func (AttendanceRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(3)),
+		field.Time("work_date").
+			StructTag(`json:"work_date"`).
+			Annotations(entproto.Field(4)),
+		field.Time("check_in_at").
+			StructTag(`json:"check_in_at"`).
+			Annotations(entproto.Field(5)),
+		field.Time("check_out_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"check_out_at"`).
+			Annotations(entproto.Field(6)),
+		field.Enum("source").
+			Values("web", "mobile", "kiosk").
+			Default("web").
+			StructTag(`json:"source"`).
+			Annotations(
+				entproto.Field(7),
+				entproto.Enum(map[string]int32{
+					"web":    0,
+					"mobile": 1,
+					"kiosk":  2,
+				}),
+			),
+		field.Float("latitude").
+			Optional().
+			Nillable().
+			StructTag(`json:"latitude"`).
+			Annotations(entproto.Field(8)),
+		field.Float("longitude").
+			Optional().
+			Nillable().
+			StructTag(`json:"longitude"`).
+			Annotations(entproto.Field(9)),
+		field.String("note").
+			Optional().
+			Nillable().
+			StructTag(`json:"note"`).
+			Annotations(entproto.Field(10)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(11)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Edges of the AttendanceRecord.
+func (AttendanceRecord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("attendance_records").
+			Field("employee_id").
+			Unique().
+			Required().
+			StructTag(`json:"employee"`).
+			Annotations(entproto.Field(13)),
+		edge.From("organization", Organization.Type).
+			Ref("attendance_records").
+			Field("org_id").
+			Unique().
+			Required().
+			StructTag(`json:"organization"`).
+			Annotations(entproto.Field(14)),
+		edge.To("corrections", AttendanceCorrection.Type).
+			StructTag(`json:"corrections"`).
+			Annotations(entproto.Field(15), entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Indexes of the AttendanceRecord.
+func (AttendanceRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "work_date").Unique(),
+		index.Fields("org_id", "work_date"),
+	}
+}
+
+func (AttendanceRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,143 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Compensation holds one salary record of an employee. Records are append-only:
+// a raise creates a new pending record which becomes effective once approved.
+type Compensation struct {
+	ent.Schema
+}
+
+// Fields of the Compensation.
+func (Compensation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("employee_id").
+			Immutable().
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("org_id").
+			Immutable().
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(3)),
+		field.Int("salary_grade_id").
+			Optional().
+			Nillable().
+			Immutable().
+			StructTag(`json:"salary_grade_id"`).
+			Annotations(entproto.Field(4)),
+		// Amounts are in minor units of the currency (cents, đồng), so pay
+		// and band checks are exact
+		field.Int64("base_pay").
+			Min(0).
+			Immutable().
+			StructTag(`json:"base_pay"`).
+			Annotations(entproto.Field(5)),
+		field.JSON("allowances", map[string]int64{}).
+			Optional().
+			Immutable().
+			StructTag(`json:"allowances"`).
+			Annotations(entproto.Skip()),
+		field.Int64("total_allowance").
+			Default(0).
+			Immutable().
+			StructTag(`json:"total_allowance"`).
+			Annotations(entproto.Field(6)),
+		field.String("currency").
+			Default("VND").
+			Immutable().
+			StructTag(`json:"currency"`).
+			Annotations(entproto.Field(7)),
+		field.Time("effective_from").
+			Immutable().
+			StructTag(`json:"effective_from"`).
+			Annotations(entproto.Field(8)),
+		field.String("reason").
+			Optional().
+			Immutable().
+			StructTag(`json:"reason"`).
+			Annotations(entproto.Field(9)),
+		field.Enum("status").
+			Values("pending", "approved", "rejected").
+			Default("pending").
+			StructTag(`json:"status"`).
+			Annotations(
+				entproto.Field(10),
+				entproto.Enum(map[string]int32{
+					"pending":  0,
+					"approved": 1,
+					"rejected": 2,
+				})),
+		field.Int("requester_id").
+			Immutable().
+			StructTag(`json:"requester_id"`).
+			Annotations(entproto.Field(11)),
+		field.Int("reviewer_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewer_id"`).
+			Annotations(entproto.Field(12)),
+		field.String("review_comment").
+			Optional().
+			Nillable().
+			StructTag(`json:"review_comment"`).
+			Annotations(entproto.Field(13)),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewed_at"`).
+			Annotations(entproto.Field(14)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(15)),
+	}
+}
+
+// Edges of the Compensation.
+func (Compensation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("compensations").
+			Field("employee_id").
+			Unique().
+			Required().
+			Immutable().
+			StructTag(`json:"employee"`).
+			Annotations(entproto.Field(16)),
+		edge.From("salary_grade", SalaryGrade.Type).
+			Ref("compensations").
+			Field("salary_grade_id").
+			Unique().
+			Immutable().
+			StructTag(`json:"salary_grade"`).
+			Annotations(entproto.Field(17)),
+	}
+}
+
+// Indexes of the Compensation.
+func (Compensation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "status", "effective_from"),
+		index.Fields("org_id"),
+	}
+}
+
+func (Compensation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		// No Update/Delete: compensation history is immutable
+		entproto.Service(
+			entproto.Methods(entproto.MethodCreate | entproto.MethodGet | entproto.MethodList | entproto.MethodBatchCreate),
+		),
+	}
+}
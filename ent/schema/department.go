@@ -55,6 +55,9 @@ func (Department) Edges() []ent.Edge {
 			Required().
 			StructTag(`json:"organization"`).
 			Annotations(entproto.Field(8)),
+		edge.To("appointment_histories", AppointmentHistory.Type).
+			StructTag(`json:"appointment_histories"`).
+			Annotations(entproto.Field(9)),
 	}
 }
 
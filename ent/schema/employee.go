@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -96,6 +97,43 @@ func (Employee) Edges() []ent.Edge {
 		edge.To("appointment_histories", AppointmentHistory.Type).
 			StructTag(`json:"appointment_histories"`).
 			Annotations(entproto.Field(18)),
+		edge.To("contracts", EmployeeContract.Type).
+			StructTag(`json:"contracts"`).
+			Annotations(entproto.Field(19), entsql.OnDelete(entsql.Cascade)),
+		edge.To("status_histories", EmployeeStatusHistory.Type).
+			StructTag(`json:"status_histories"`).
+			Annotations(entproto.Field(20), entsql.OnDelete(entsql.Cascade)),
+		edge.To("compensations", Compensation.Type).
+			StructTag(`json:"compensations"`).
+			Annotations(entproto.Field(21)),
+		edge.To("attendance_records", AttendanceRecord.Type).
+			StructTag(`json:"attendance_records"`).
+			Annotations(entproto.Field(22), entsql.OnDelete(entsql.Cascade)),
+		edge.To("attendance_corrections", AttendanceCorrection.Type).
+			StructTag(`json:"attendance_corrections"`).
+			Annotations(entproto.Field(23), entsql.OnDelete(entsql.Cascade)),
+		edge.To("rosters", Roster.Type).
+			StructTag(`json:"rosters"`).
+			Annotations(entproto.Field(24), entsql.OnDelete(entsql.Cascade)),
+		edge.To("overtime_requests", OvertimeRequest.Type).
+			StructTag(`json:"overtime_requests"`).
+			Annotations(entproto.Field(25), entsql.OnDelete(entsql.Cascade)),
+		edge.To("task_comments", TaskComment.Type).
+			StructTag(`json:"task_comments"`).
+			Annotations(entproto.Field(26)),
+		edge.From("comment_mentions", TaskComment.Type).
+			Ref("mentions").
+			StructTag(`json:"comment_mentions"`).
+			Annotations(entproto.Field(27)),
+		edge.To("reviewed_task_reports", TaskReport.Type).
+			StructTag(`json:"reviewed_task_reports"`).
+			Annotations(entproto.Field(28)),
+		edge.To("attachments", Attachment.Type).
+			StructTag(`json:"attachments"`).
+			Annotations(entproto.Field(29)),
+		edge.To("work_logs", WorkLog.Type).
+			StructTag(`json:"work_logs"`).
+			Annotations(entproto.Field(30)),
 	}
 }
 
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EmployeeContract holds the schema definition for the EmployeeContract entity.
+type EmployeeContract struct {
+	ent.Schema
+}
+
+// Fields of the EmployeeContract.
+func (EmployeeContract) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(2)),
+		field.String("code").
+			NotEmpty().
+			StructTag(`json:"code"`).
+			Annotations(entproto.Field(3)),
+		field.Enum("type").
+			Values("fixed_term", "probation", "indefinite", "seasonal").
+			Default("fixed_term").
+			StructTag(`json:"type"`).
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(map[string]int32{
+					"fixed_term": 0,
+					"probation":  1,
+					"indefinite": 2,
+					"seasonal":   3,
+				})),
+		field.Enum("status").
+			Values("active", "expired", "terminated").
+			Default("active").
+			StructTag(`json:"status"`).
+			Annotations(
+				entproto.Field(5),
+				entproto.Enum(map[string]int32{
+					"active":     0,
+					"expired":    1,
+					"terminated": 2,
+				})),
+		field.Time("start_at").
+			StructTag(`json:"start_at"`).
+			Annotations(entproto.Field(6)),
+		field.Time("end_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"end_at"`).
+			Annotations(entproto.Field(7)),
+		field.String("note").
+			Optional().
+			Nillable().
+			StructTag(`json:"note"`).
+			Annotations(entproto.Field(8)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(9)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(10)),
+	}
+}
+
+// Edges of the EmployeeContract.
+func (EmployeeContract) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("contracts").
+			Field("employee_id").
+			Unique().
+			Required().
+			StructTag(`json:"employee"`).
+			Annotations(entproto.Field(11)),
+	}
+}
+
+func (EmployeeContract) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
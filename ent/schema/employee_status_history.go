@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EmployeeStatusHistory records every change of an employee's status.
+type EmployeeStatusHistory struct {
+	ent.Schema
+}
+
+// Fields of the EmployeeStatusHistory.
+func (EmployeeStatusHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(2)),
+		field.String("from_status").
+			StructTag(`json:"from_status"`).
+			Annotations(entproto.Field(3)),
+		field.String("to_status").
+			StructTag(`json:"to_status"`).
+			Annotations(entproto.Field(4)),
+		field.Int("changed_by").
+			Optional().
+			StructTag(`json:"changed_by"`).
+			Annotations(entproto.Field(5)),
+		field.String("note").
+			Optional().
+			StructTag(`json:"note"`).
+			Annotations(entproto.Field(6)),
+		field.Time("changed_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"changed_at"`).
+			Annotations(entproto.Field(7)),
+	}
+}
+
+// Edges of the EmployeeStatusHistory.
+func (EmployeeStatusHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("status_histories").
+			Field("employee_id").
+			Unique().
+			Required().
+			StructTag(`json:"employee"`).
+			Annotations(entproto.Field(8)),
+	}
+}
+
+func (EmployeeStatusHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
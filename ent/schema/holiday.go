@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Holiday holds a public or company holiday of an organization.
+type Holiday struct {
+	ent.Schema
+}
+
+// Fields of the Holiday.
+func (Holiday) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		field.Time("date").
+			StructTag(`json:"date"`).
+			Annotations(entproto.Field(3)),
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`).
+			Annotations(entproto.Field(4)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(5)),
+	}
+}
+
+// Edges of the Holiday.
+func (Holiday) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("holidays").
+			Field("org_id").
+			Unique().
+			Required().
+			StructTag(`json:"organization"`).
+			Annotations(entproto.Field(6)),
+	}
+}
+
+// Indexes of the Holiday.
+func (Holiday) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "date").Unique(),
+	}
+}
+
+func (Holiday) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
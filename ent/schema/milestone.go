@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Milestone holds the schema definition for the Milestone entity, a target
+// of a project that groups the tasks needed to reach it.
+type Milestone struct {
+	ent.Schema
+}
+
+// Fields of the Milestone.
+func (Milestone) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(2)),
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`).
+			Annotations(entproto.Field(3)),
+		field.String("goal").
+			Optional().
+			StructTag(`json:"goal"`).
+			Annotations(entproto.Field(4)),
+		field.Time("start_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"start_at"`).
+			Annotations(entproto.Field(5)),
+		field.Time("end_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"end_at"`).
+			Annotations(entproto.Field(6)),
+		field.Enum("state").
+			Values("planned", "active", "closed").
+			Default("planned").
+			StructTag(`json:"state"`).
+			Annotations(
+				entproto.Field(7),
+				entproto.Enum(map[string]int32{
+					"planned": 0,
+					"active":  1,
+					"closed":  2,
+				})),
+		field.Int("creator_id").
+			StructTag(`json:"creator_id"`).
+			Annotations(entproto.Field(8)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(9)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(10)),
+	}
+}
+
+// Edges of the Milestone.
+func (Milestone) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("milestones").
+			Field("project_id").
+			Unique().
+			Required().
+			StructTag(`json:"project"`).
+			Annotations(entproto.Field(11)),
+		edge.To("tasks", Task.Type).
+			StructTag(`json:"tasks"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Indexes of the Milestone.
+func (Milestone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "end_at"),
+	}
+}
+
+func (Milestone) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity, an
+// in-app message to an employee about a task or one of their requests.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		// Employee receiving the notification
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(3)),
+		field.Enum("type").
+			Values(
+				"task_assigned",
+				"task_status_changed",
+				"task_due_soon",
+				"task_overdue",
+				"task_mentioned",
+				"leave_decided",
+			).
+			StructTag(`json:"type"`).
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(map[string]int32{
+					"task_assigned":       1,
+					"task_status_changed": 2,
+					"task_due_soon":       3,
+					"task_mentioned":      4,
+					"leave_decided":       5,
+					"task_overdue":        6,
+				})),
+		field.String("title").
+			StructTag(`json:"title"`).
+			Annotations(entproto.Field(5)),
+		field.Text("body").
+			Optional().
+			StructTag(`json:"body"`).
+			Annotations(entproto.Field(6)),
+		field.Int("task_id").
+			Optional().
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(7)),
+		// Comment or leave request the notification is about
+		field.Int("ref_id").
+			Optional().
+			StructTag(`json:"ref_id"`).
+			Annotations(entproto.Field(8)),
+		field.Int("actor_employee_id").
+			Optional().
+			StructTag(`json:"actor_employee_id"`).
+			Annotations(entproto.Field(9)),
+		// Notifications sharing a key are only sent once to an employee,
+		// e.g. a due-soon reminder for one due date of a task
+		field.String("dedup_key").
+			Optional().
+			StructTag(`json:"-"`).
+			Annotations(entproto.Field(10)),
+		field.Time("read_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"read_at"`).
+			Annotations(entproto.Field(11)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "read_at"),
+		index.Fields("employee_id", "dedup_key").
+			Unique(),
+	}
+}
+
+func (Notification) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
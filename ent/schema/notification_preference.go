@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NotificationPreference turns one type of notification on or off for an
+// employee. Types without a preference are on.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(3)),
+		field.Enum("type").
+			Values(
+				"task_assigned",
+				"task_status_changed",
+				"task_due_soon",
+				"task_overdue",
+				"task_mentioned",
+				"leave_decided",
+			).
+			StructTag(`json:"type"`).
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(map[string]int32{
+					"task_assigned":       1,
+					"task_status_changed": 2,
+					"task_due_soon":       3,
+					"task_mentioned":      4,
+					"leave_decided":       5,
+					"task_overdue":        6,
+				})),
+		field.Bool("enabled").
+			Default(true).
+			StructTag(`json:"enabled"`).
+			Annotations(entproto.Field(5)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(6)),
+	}
+}
+
+// Indexes of the NotificationPreference.
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "type").
+			Unique(),
+	}
+}
+
+func (NotificationPreference) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -66,6 +67,28 @@ func (Organization) Fields() []ent.Field {
 			Nillable().
 			StructTag(`json:"parent_id"`).
 			Annotations(entproto.Field(11)),
+		// Weekdays (0 = Sunday ... 6 = Saturday) treated as weekend; empty means Saturday and Sunday
+		field.JSON("weekend_days", []int{}).
+			Optional().
+			StructTag(`json:"weekend_days"`).
+			Annotations(entproto.Skip()),
+		// Largest attachment a member of the organization may upload, in bytes
+		field.Int64("attachment_max_file_size").
+			Default(10 << 20).
+			Positive().
+			StructTag(`json:"attachment_max_file_size"`).
+			Annotations(entproto.Field(22)),
+		// Total size of all attachments of the organization, in bytes
+		field.Int64("attachment_quota").
+			Default(1 << 30).
+			Positive().
+			StructTag(`json:"attachment_quota"`).
+			Annotations(entproto.Field(23)),
+		// Number of the last code given to a task outside projects
+		field.Int("task_seq").
+			Default(0).
+			StructTag(`json:"task_seq"`).
+			Annotations(entproto.Field(25)),
 	}
 }
 
@@ -93,6 +116,21 @@ func (Organization) Edges() []ent.Edge {
 		edge.To("leave_requests", LeaveRequest.Type).
 			StructTag(`json:"leave_requests"`).
 			Annotations(entproto.Field(17)),
+		edge.To("salary_grades", SalaryGrade.Type).
+			StructTag(`json:"salary_grades"`).
+			Annotations(entproto.Field(18)),
+		edge.To("attendance_records", AttendanceRecord.Type).
+			StructTag(`json:"attendance_records"`).
+			Annotations(entproto.Field(19)),
+		edge.To("shifts", Shift.Type).
+			StructTag(`json:"shifts"`).
+			Annotations(entproto.Field(20)),
+		edge.To("holidays", Holiday.Type).
+			StructTag(`json:"holidays"`).
+			Annotations(entproto.Field(21)),
+		edge.To("attachments", Attachment.Type).
+			StructTag(`json:"attachments"`).
+			Annotations(entproto.Field(24), entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
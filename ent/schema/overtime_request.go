@@ -0,0 +1,144 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OvertimeRequest holds a request from an employee to work overtime on a given day.
+type OvertimeRequest struct {
+	ent.Schema
+}
+
+// Fields of the OvertimeRequest.
+func (OvertimeRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(3)),
+		field.Time("work_date").
+			StructTag(`json:"work_date"`).
+			Annotations(entproto.Field(4)),
+		field.Float("hours").
+			Positive().
+			StructTag(`json:"hours"`).
+			Annotations(entproto.Field(5)),
+		field.String("reason").
+			StructTag(`json:"reason"`).
+			Annotations(entproto.Field(6)),
+		field.Enum("day_type").
+			Values("weekday", "weekend", "holiday").
+			Default("weekday").
+			StructTag(`json:"day_type"`).
+			Annotations(
+				entproto.Field(7),
+				entproto.Enum(map[string]int32{
+					"weekday": 0,
+					"weekend": 1,
+					"holiday": 2,
+				}),
+			),
+		field.Int("project_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(8)),
+		field.Int("task_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(9)),
+		field.Enum("status").
+			Values("pending", "approved", "rejected", "cancelled").
+			Default("pending").
+			StructTag(`json:"status"`).
+			Annotations(
+				entproto.Field(10),
+				entproto.Enum(map[string]int32{
+					"pending":   0,
+					"approved":  1,
+					"rejected":  2,
+					"cancelled": 3,
+				}),
+			),
+		// Position whose holders approve the request; nil when the employee has no manager
+		field.Int("approver_position_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"approver_position_id"`).
+			Annotations(entproto.Field(11)),
+		field.Int("reviewer_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewer_id"`).
+			Annotations(entproto.Field(12)),
+		field.String("review_comment").
+			Optional().
+			Nillable().
+			StructTag(`json:"review_comment"`).
+			Annotations(entproto.Field(13)),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewed_at"`).
+			Annotations(entproto.Field(14)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(15)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(16)),
+	}
+}
+
+// Edges of the OvertimeRequest.
+func (OvertimeRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("overtime_requests").
+			Field("employee_id").
+			Unique().
+			Required().
+			StructTag(`json:"employee"`).
+			Annotations(entproto.Field(17)),
+		edge.From("project", Project.Type).
+			Ref("overtime_requests").
+			Field("project_id").
+			Unique().
+			StructTag(`json:"project"`).
+			Annotations(entproto.Field(18)),
+		edge.From("task", Task.Type).
+			Ref("overtime_requests").
+			Field("task_id").
+			Unique().
+			StructTag(`json:"task"`).
+			Annotations(entproto.Field(19)),
+	}
+}
+
+// Indexes of the OvertimeRequest.
+func (OvertimeRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "work_date"),
+		index.Fields("org_id", "status"),
+	}
+}
+
+func (OvertimeRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -72,6 +72,12 @@ func (Position) Edges() []ent.Edge {
 			Field("parent_id").
 			StructTag(`json:"parent"`).
 			Annotations(entproto.Field(11)),
+		edge.To("appointment_histories", AppointmentHistory.Type).
+			StructTag(`json:"appointment_histories"`).
+			Annotations(entproto.Field(12)),
+		edge.To("salary_grades", SalaryGrade.Type).
+			StructTag(`json:"salary_grades"`).
+			Annotations(entproto.Field(13)),
 	}
 }
 
@@ -5,9 +5,11 @@ import (
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Project holds the schema definition for the Project entity.
@@ -63,6 +65,25 @@ func (Project) Fields() []ent.Field {
 					"in_progress": 1,
 					"completed":   2,
 				})),
+		// Process and status are rolled up from the project's tasks unless
+		// they are overridden by hand
+		field.Bool("manual_progress").
+			Default(false).
+			StructTag(`json:"manual_progress"`).
+			Annotations(entproto.Field(29)),
+		// Key prefixes the codes of the project's tasks, e.g. HRM-12; it is
+		// unique within the organization
+		field.String("key").
+			Optional().
+			Nillable().
+			StructTag(`json:"key"`).
+			Annotations(entproto.Field(25)),
+		// Number of the project's last task code, incremented in place so that
+		// concurrent creates get distinct codes
+		field.Int("task_seq").
+			Default(0).
+			StructTag(`json:"task_seq"`).
+			Annotations(entproto.Field(26)),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
@@ -106,6 +127,38 @@ func (Project) Edges() []ent.Edge {
 		edge.To("members", Employee.Type).
 			StructTag(`json:"members"`).
 			Annotations(entproto.Field(18)),
+		edge.To("overtime_requests", OvertimeRequest.Type).
+			StructTag(`json:"overtime_requests"`).
+			Annotations(entproto.Field(19), entsql.OnDelete(entsql.SetNull)),
+		edge.To("workflow_statuses", WorkflowStatus.Type).
+			StructTag(`json:"workflow_statuses"`).
+			Annotations(entproto.Field(20), entsql.OnDelete(entsql.Cascade)),
+		edge.To("workflow_transitions", WorkflowTransition.Type).
+			StructTag(`json:"workflow_transitions"`).
+			Annotations(entproto.Field(21), entsql.OnDelete(entsql.Cascade)),
+		edge.To("sprints", Sprint.Type).
+			StructTag(`json:"sprints"`).
+			Annotations(entproto.Field(22), entsql.OnDelete(entsql.Cascade)),
+		edge.To("milestones", Milestone.Type).
+			StructTag(`json:"milestones"`).
+			Annotations(entproto.Field(23), entsql.OnDelete(entsql.Cascade)),
+		edge.To("task_templates", TaskTemplate.Type).
+			StructTag(`json:"task_templates"`).
+			Annotations(entproto.Field(24), entsql.OnDelete(entsql.Cascade)),
+		edge.To("task_views", TaskView.Type).
+			StructTag(`json:"task_views"`).
+			Annotations(entproto.Field(27), entsql.OnDelete(entsql.Cascade)),
+		edge.To("roles", ProjectRole.Type).
+			StructTag(`json:"roles"`).
+			Annotations(entproto.Field(28), entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Project.
+func (Project) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "key").
+			Unique(),
 	}
 }
 
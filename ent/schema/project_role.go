@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProjectRole holds the schema definition for the ProjectRole entity, the role
+// of a member in a project. Members without one are plain members.
+type ProjectRole struct {
+	ent.Schema
+}
+
+// Fields of the ProjectRole.
+func (ProjectRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(3)),
+		field.Enum("role").
+			Values("owner", "manager", "member", "viewer").
+			StructTag(`json:"role"`).
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(map[string]int32{
+					"owner":   1,
+					"manager": 2,
+					"member":  3,
+					"viewer":  4,
+				})),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(5)),
+	}
+}
+
+// Edges of the ProjectRole.
+func (ProjectRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("roles").
+			Field("project_id").
+			Unique().
+			Required().
+			StructTag(`json:"project"`).
+			Annotations(entproto.Field(6)),
+	}
+}
+
+// Indexes of the ProjectRole.
+func (ProjectRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "employee_id").
+			Unique(),
+	}
+}
+
+func (ProjectRole) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
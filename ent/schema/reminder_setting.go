@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ReminderSetting holds the schedule of task reminders of an organization.
+// Organizations without one use the defaults.
+type ReminderSetting struct {
+	ent.Schema
+}
+
+// Fields of the ReminderSetting.
+func (ReminderSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			Unique().
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		field.Bool("due_soon_enabled").
+			Default(true).
+			StructTag(`json:"due_soon_enabled"`).
+			Annotations(entproto.Field(3)),
+		// Assignees are reminded of tasks due within this many hours
+		field.Int("due_soon_hours").
+			Default(24).
+			Positive().
+			StructTag(`json:"due_soon_hours"`).
+			Annotations(entproto.Field(4)),
+		field.Bool("overdue_enabled").
+			Default(true).
+			StructTag(`json:"overdue_enabled"`).
+			Annotations(entproto.Field(5)),
+		// Overdue tasks are reminded again every this many hours; 0 reminds
+		// them once
+		field.Int("overdue_repeat_hours").
+			Default(24).
+			NonNegative().
+			StructTag(`json:"overdue_repeat_hours"`).
+			Annotations(entproto.Field(6)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(7)),
+	}
+}
+
+func (ReminderSetting) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Roster assigns an employee to a shift on a given work day.
+type Roster struct {
+	ent.Schema
+}
+
+// Fields of the Roster.
+func (Roster) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("shift_id").
+			StructTag(`json:"shift_id"`).
+			Annotations(entproto.Field(3)),
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(4)),
+		field.Time("work_date").
+			StructTag(`json:"work_date"`).
+			Annotations(entproto.Field(5)),
+		field.String("note").
+			Optional().
+			Nillable().
+			StructTag(`json:"note"`).
+			Annotations(entproto.Field(6)),
+		field.Int("created_by").
+			Optional().
+			Nillable().
+			StructTag(`json:"created_by"`).
+			Annotations(entproto.Field(7)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(8)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(9)),
+	}
+}
+
+// Edges of the Roster.
+func (Roster) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("employee", Employee.Type).
+			Ref("rosters").
+			Field("employee_id").
+			Unique().
+			Required().
+			StructTag(`json:"employee"`).
+			Annotations(entproto.Field(10)),
+		edge.From("shift", Shift.Type).
+			Ref("rosters").
+			Field("shift_id").
+			Unique().
+			Required().
+			StructTag(`json:"shift"`).
+			Annotations(entproto.Field(11)),
+		edge.To("swap_requests", ShiftSwapRequest.Type).
+			StructTag(`json:"swap_requests"`).
+			Annotations(entproto.Field(12), entsql.OnDelete(entsql.Cascade)),
+		edge.To("targeted_swap_requests", ShiftSwapRequest.Type).
+			StructTag(`json:"targeted_swap_requests"`).
+			Annotations(entproto.Field(13), entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Roster.
+func (Roster) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("employee_id", "work_date").Unique(),
+		index.Fields("org_id", "work_date"),
+	}
+}
+
+func (Roster) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SalaryGrade holds the schema definition for the SalaryGrade (pay band) entity.
+type SalaryGrade struct {
+	ent.Schema
+}
+
+// Fields of the SalaryGrade.
+func (SalaryGrade) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			NotEmpty().
+			StructTag(`json:"code"`).
+			Annotations(entproto.Field(2)),
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`).
+			Annotations(entproto.Field(3)),
+		// Bounds are in minor units of the currency (cents, đồng)
+		field.Int64("min_amount").
+			Min(0).
+			StructTag(`json:"min_amount"`).
+			Annotations(entproto.Field(4)),
+		field.Int64("max_amount").
+			Min(0).
+			StructTag(`json:"max_amount"`).
+			Annotations(entproto.Field(5)),
+		field.String("currency").
+			Default("VND").
+			StructTag(`json:"currency"`).
+			Annotations(entproto.Field(6)),
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(7)),
+		field.Int("position_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"position_id"`).
+			Annotations(entproto.Field(8)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(9)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(10)),
+	}
+}
+
+// Edges of the SalaryGrade.
+func (SalaryGrade) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("salary_grades").
+			Field("org_id").
+			Unique().
+			Required().
+			StructTag(`json:"organization"`).
+			Annotations(entproto.Field(11)),
+		edge.From("position", Position.Type).
+			Ref("salary_grades").
+			Field("position_id").
+			Unique().
+			StructTag(`json:"position"`).
+			Annotations(entproto.Field(12)),
+		edge.To("compensations", Compensation.Type).
+			StructTag(`json:"compensations"`).
+			Annotations(entproto.Field(13)),
+	}
+}
+
+// Indexes of the SalaryGrade.
+func (SalaryGrade) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "code").Unique(),
+	}
+}
+
+func (SalaryGrade) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
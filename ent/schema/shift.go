@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Shift holds a reusable shift template of an organization.
+type Shift struct {
+	ent.Schema
+}
+
+// Fields of the Shift.
+func (Shift) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			NotEmpty().
+			StructTag(`json:"code"`).
+			Annotations(entproto.Field(2)),
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`).
+			Annotations(entproto.Field(3)),
+		// Local start time in HH:MM format
+		field.String("start_time").
+			StructTag(`json:"start_time"`).
+			Annotations(entproto.Field(4)),
+		// Local end time in HH:MM format; earlier than start_time when the shift ends the next day
+		field.String("end_time").
+			StructTag(`json:"end_time"`).
+			Annotations(entproto.Field(5)),
+		field.Int("break_minutes").
+			Default(0).
+			NonNegative().
+			StructTag(`json:"break_minutes"`).
+			Annotations(entproto.Field(6)),
+		field.Bool("break_paid").
+			Default(false).
+			StructTag(`json:"break_paid"`).
+			Annotations(entproto.Field(7)),
+		field.Bool("is_night").
+			Default(false).
+			StructTag(`json:"is_night"`).
+			Annotations(entproto.Field(8)),
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(9)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(10)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(11)),
+	}
+}
+
+// Edges of the Shift.
+func (Shift) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("shifts").
+			Field("org_id").
+			Unique().
+			Required().
+			StructTag(`json:"organization"`).
+			Annotations(entproto.Field(12)),
+		edge.To("rosters", Roster.Type).
+			StructTag(`json:"rosters"`).
+			Annotations(entproto.Field(13)),
+	}
+}
+
+// Indexes of the Shift.
+func (Shift) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "code").Unique(),
+	}
+}
+
+func (Shift) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ShiftSwapRequest holds a request from an employee to swap a rostered shift with a colleague.
+type ShiftSwapRequest struct {
+	ent.Schema
+}
+
+// Fields of the ShiftSwapRequest.
+func (ShiftSwapRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("requester_id").
+			StructTag(`json:"requester_id"`).
+			Annotations(entproto.Field(3)),
+		field.Int("roster_id").
+			StructTag(`json:"roster_id"`).
+			Annotations(entproto.Field(4)),
+		field.Int("target_roster_id").
+			StructTag(`json:"target_roster_id"`).
+			Annotations(entproto.Field(5)),
+		field.String("reason").
+			Optional().
+			Nillable().
+			StructTag(`json:"reason"`).
+			Annotations(entproto.Field(6)),
+		field.Enum("status").
+			Values("pending", "approved", "rejected").
+			Default("pending").
+			StructTag(`json:"status"`).
+			Annotations(
+				entproto.Field(7),
+				entproto.Enum(map[string]int32{
+					"pending":  0,
+					"approved": 1,
+					"rejected": 2,
+				}),
+			),
+		field.Int("reviewer_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewer_id"`).
+			Annotations(entproto.Field(8)),
+		field.String("review_comment").
+			Optional().
+			Nillable().
+			StructTag(`json:"review_comment"`).
+			Annotations(entproto.Field(9)),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewed_at"`).
+			Annotations(entproto.Field(10)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(11)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Edges of the ShiftSwapRequest.
+func (ShiftSwapRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("roster", Roster.Type).
+			Ref("swap_requests").
+			Field("roster_id").
+			Unique().
+			Required().
+			StructTag(`json:"roster"`).
+			Annotations(entproto.Field(13)),
+		edge.From("target_roster", Roster.Type).
+			Ref("targeted_swap_requests").
+			Field("target_roster_id").
+			Unique().
+			Required().
+			StructTag(`json:"target_roster"`).
+			Annotations(entproto.Field(14)),
+	}
+}
+
+// Indexes of the ShiftSwapRequest.
+func (ShiftSwapRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "status"),
+	}
+}
+
+func (ShiftSwapRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
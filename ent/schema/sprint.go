@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Sprint holds the schema definition for the Sprint entity, a time-boxed
+// iteration of a project.
+type Sprint struct {
+	ent.Schema
+}
+
+// Fields of the Sprint.
+func (Sprint) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(2)),
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`).
+			Annotations(entproto.Field(3)),
+		field.String("goal").
+			Optional().
+			StructTag(`json:"goal"`).
+			Annotations(entproto.Field(4)),
+		field.Time("start_at").
+			StructTag(`json:"start_at"`).
+			Annotations(entproto.Field(5)),
+		field.Time("end_at").
+			StructTag(`json:"end_at"`).
+			Annotations(entproto.Field(6)),
+		field.Enum("state").
+			Values("planned", "active", "closed").
+			Default("planned").
+			StructTag(`json:"state"`).
+			Annotations(
+				entproto.Field(7),
+				entproto.Enum(map[string]int32{
+					"planned": 0,
+					"active":  1,
+					"closed":  2,
+				})),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"started_at"`).
+			Annotations(entproto.Field(8)),
+		field.Time("closed_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"closed_at"`).
+			Annotations(entproto.Field(9)),
+		// Snapshots for the sprint report: the tasks in the sprint when it
+		// started, and its completed and unfinished tasks when it closed
+		field.Ints("committed_task_ids").
+			Optional().
+			StructTag(`json:"committed_task_ids"`).
+			Annotations(entproto.Skip()),
+		field.Ints("completed_task_ids").
+			Optional().
+			StructTag(`json:"completed_task_ids"`).
+			Annotations(entproto.Skip()),
+		field.Ints("incomplete_task_ids").
+			Optional().
+			StructTag(`json:"incomplete_task_ids"`).
+			Annotations(entproto.Skip()),
+		field.Int("creator_id").
+			StructTag(`json:"creator_id"`).
+			Annotations(entproto.Field(10)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(11)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Edges of the Sprint.
+func (Sprint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("sprints").
+			Field("project_id").
+			Unique().
+			Required().
+			StructTag(`json:"project"`).
+			Annotations(entproto.Field(13)),
+		edge.To("tasks", Task.Type).
+			StructTag(`json:"tasks"`).
+			Annotations(entproto.Field(14)),
+	}
+}
+
+// Indexes of the Sprint.
+func (Sprint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "start_at"),
+		// A project runs at most one sprint at a time
+		index.Fields("project_id").
+			Unique().
+			Annotations(entsql.IndexWhere("state = 'active'")),
+	}
+}
+
+func (Sprint) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
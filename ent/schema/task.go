@@ -5,9 +5,11 @@ import (
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Task holds the schema definition for the Task entity.
@@ -21,8 +23,9 @@ func (Task) Fields() []ent.Field {
 		field.String("name").
 			StructTag(`json:"name"`).
 			Annotations(entproto.Field(2)),
+		// Code is unique within the organization, e.g. HRM-12 for the 12th
+		// task of the HRM project, or #7 for a task outside projects
 		field.String("code").
-			Unique().
 			StructTag(`json:"code"`).
 			Annotations(entproto.Field(3)),
 		field.String("description").
@@ -88,6 +91,62 @@ func (Task) Fields() []ent.Field {
 					"bug":     2,
 					"another": 3,
 				})),
+		// Subtasks point at their parent; top-level tasks leave it empty.
+		field.Int("parent_id").
+			Optional().
+			StructTag(`json:"parent_id"`).
+			Annotations(entproto.Field(20)),
+		// Estimates are in minutes; the remaining estimate goes down as time is logged
+		field.Int("original_estimate").
+			Optional().
+			Nillable().
+			NonNegative().
+			StructTag(`json:"original_estimate"`).
+			Annotations(entproto.Field(26)),
+		field.Int("remaining_estimate").
+			Optional().
+			Nillable().
+			NonNegative().
+			StructTag(`json:"remaining_estimate"`).
+			Annotations(entproto.Field(27)),
+		// Key of the task's status in its project workflow; status holds the
+		// built-in status it maps to
+		field.String("workflow_status").
+			Default("").
+			StructTag(`json:"workflow_status"`).
+			Annotations(entproto.Field(29)),
+		// Rank orders the tasks of a board column; it is a base-36 string
+		// compared lexicographically, empty until the task is first ranked
+		field.String("rank").
+			Default("").
+			StructTag(`json:"rank"`).
+			Annotations(entproto.Field(30)),
+		field.Int("sprint_id").
+			Optional().
+			StructTag(`json:"sprint_id"`).
+			Annotations(entproto.Field(31)),
+		field.Int("milestone_id").
+			Optional().
+			StructTag(`json:"milestone_id"`).
+			Annotations(entproto.Field(32)),
+		// Set when the task reaches the completed status, for burndowns
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"completed_at"`).
+			Annotations(entproto.Field(33)),
+		// The recurrence run that created the task; unique so a retried run
+		// cannot create a second task
+		field.Int("recurrence_run_id").
+			Optional().
+			Nillable().
+			Unique().
+			StructTag(`json:"recurrence_run_id"`).
+			Annotations(entproto.Field(36)),
+		// Organization the task belongs to; every task query is scoped by it
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(37)),
 	}
 }
 
@@ -109,6 +168,63 @@ func (Task) Edges() []ent.Edge {
 		edge.To("reports", TaskReport.Type).
 			StructTag(`json:"reports"`).
 			Annotations(entproto.Field(18)), // Edge đến TaskReport
+		edge.To("overtime_requests", OvertimeRequest.Type).
+			StructTag(`json:"overtime_requests"`).
+			Annotations(entproto.Field(19), entsql.OnDelete(entsql.SetNull)),
+		edge.To("children", Task.Type).
+			StructTag(`json:"children"`).
+			Annotations(entproto.Field(21)), // Edge đến các task con
+		edge.From("parent", Task.Type).
+			Ref("children").
+			Unique().
+			Field("parent_id").
+			StructTag(`json:"parent"`).
+			Annotations(entproto.Field(22)), // Edge đến task cha
+		edge.To("dependencies", TaskDependency.Type).
+			StructTag(`json:"dependencies"`).
+			Annotations(entproto.Field(23), entsql.OnDelete(entsql.Cascade)), // Liên kết từ task này tới task khác
+		edge.To("dependents", TaskDependency.Type).
+			StructTag(`json:"dependents"`).
+			Annotations(entproto.Field(24), entsql.OnDelete(entsql.Cascade)), // Liên kết từ task khác tới task này
+		edge.To("comments", TaskComment.Type).
+			StructTag(`json:"comments"`).
+			Annotations(entproto.Field(25), entsql.OnDelete(entsql.Cascade)), // Edge đến TaskComment
+		edge.To("work_logs", WorkLog.Type).
+			StructTag(`json:"work_logs"`).
+			Annotations(entproto.Field(28), entsql.OnDelete(entsql.Cascade)), // Edge đến WorkLog
+		edge.From("sprint", Sprint.Type).
+			Ref("tasks").
+			Field("sprint_id").
+			Unique().
+			StructTag(`json:"sprint"`).
+			Annotations(entproto.Field(34)), // Sprint chứa task
+		edge.From("milestone", Milestone.Type).
+			Ref("tasks").
+			Field("milestone_id").
+			Unique().
+			StructTag(`json:"milestone"`).
+			Annotations(entproto.Field(35)), // Milestone chứa task
+		edge.To("activities", TaskActivity.Type).
+			StructTag(`json:"activities"`).
+			Annotations(entproto.Field(38), entsql.OnDelete(entsql.Cascade)),
+		edge.To("watchers", TaskWatcher.Type).
+			StructTag(`json:"watchers"`).
+			Annotations(entproto.Field(39), entsql.OnDelete(entsql.Cascade)),
+		edge.To("reminders", TaskReminder.Type).
+			StructTag(`json:"reminders"`).
+			Annotations(entproto.Field(40), entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Task.
+func (Task) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "workflow_status", "rank"),
+		index.Fields("org_id", "code").
+			Unique(),
+		// Incremental sync and the overdue filter of the task list
+		index.Fields("org_id", "updated_at"),
+		index.Fields("org_id", "due_date"),
 	}
 }
 
@@ -0,0 +1,130 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskActivity records one change of a task: a field or status change, an
+// assignee or label added or removed, or a comment or report.
+type TaskActivity struct {
+	ent.Schema
+}
+
+// Fields of the TaskActivity.
+func (TaskActivity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("task_id").
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(3)),
+		// Project of the task when the change was made, for the project feed
+		field.Int("project_id").
+			Optional().
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(4)),
+		// Task fields keep user IDs while comments and reports are made by
+		// employees, so either may be set
+		field.Int("actor_user_id").
+			Optional().
+			StructTag(`json:"actor_user_id"`).
+			Annotations(entproto.Field(5)),
+		field.Int("actor_employee_id").
+			Optional().
+			StructTag(`json:"actor_employee_id"`).
+			Annotations(entproto.Field(6)),
+		field.Enum("kind").
+			Values(
+				"created",
+				"field_changed",
+				"status_changed",
+				"assignee_added",
+				"assignee_removed",
+				"label_added",
+				"label_removed",
+				"comment_added",
+				"comment_edited",
+				"comment_deleted",
+				"report_submitted",
+				"report_updated",
+				"report_reviewed",
+			).
+			StructTag(`json:"kind"`).
+			Annotations(
+				entproto.Field(7),
+				entproto.Enum(map[string]int32{
+					"created":          1,
+					"field_changed":    2,
+					"status_changed":   3,
+					"assignee_added":   4,
+					"assignee_removed": 5,
+					"label_added":      6,
+					"label_removed":    7,
+					"comment_added":    8,
+					"comment_edited":   9,
+					"comment_deleted":  10,
+					"report_submitted": 11,
+					"report_updated":   12,
+					"report_reviewed":  13,
+				})),
+		// Changed field of field_changed and status_changed entries
+		field.String("field_name").
+			Optional().
+			StructTag(`json:"field_name"`).
+			Annotations(entproto.Field(8)),
+		field.Text("old_value").
+			Optional().
+			StructTag(`json:"old_value"`).
+			Annotations(entproto.Field(9)),
+		field.Text("new_value").
+			Optional().
+			StructTag(`json:"new_value"`).
+			Annotations(entproto.Field(10)),
+		// Employee, label, comment or report the entry is about
+		field.Int("ref_id").
+			Optional().
+			StructTag(`json:"ref_id"`).
+			Annotations(entproto.Field(11)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Edges of the TaskActivity.
+func (TaskActivity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("activities").
+			Field("task_id").
+			Unique().
+			Required().
+			StructTag(`json:"task"`).
+			Annotations(entproto.Field(13)),
+	}
+}
+
+// Indexes of the TaskActivity.
+func (TaskActivity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id"),
+		index.Fields("org_id", "project_id"),
+	}
+}
+
+func (TaskActivity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskComment holds a comment in the discussion of a task. Replies point at
+// the first comment of their thread.
+type TaskComment struct {
+	ent.Schema
+}
+
+// Fields of the TaskComment.
+func (TaskComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id").
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("author_id").
+			StructTag(`json:"author_id"`).
+			Annotations(entproto.Field(3)),
+		field.Int("parent_id").
+			Optional().
+			StructTag(`json:"parent_id"`).
+			Annotations(entproto.Field(4)),
+		field.Text("content").
+			StructTag(`json:"content"`).
+			Annotations(entproto.Field(5)),
+		field.Time("edited_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"edited_at"`).
+			Annotations(entproto.Field(6)),
+		// Deleted comments are kept so that their threads stay intact
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"deleted_at"`).
+			Annotations(entproto.Field(7)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(8)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(9)),
+	}
+}
+
+// Edges of the TaskComment.
+func (TaskComment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("comments").
+			Field("task_id").
+			Unique().
+			Required().
+			StructTag(`json:"task"`).
+			Annotations(entproto.Field(10)),
+		edge.From("author", Employee.Type).
+			Ref("task_comments").
+			Field("author_id").
+			Unique().
+			Required().
+			StructTag(`json:"author"`).
+			Annotations(entproto.Field(11)),
+		edge.To("replies", TaskComment.Type).
+			StructTag(`json:"replies"`).
+			Annotations(entproto.Field(12), entsql.OnDelete(entsql.Cascade)),
+		edge.From("parent", TaskComment.Type).
+			Ref("replies").
+			Field("parent_id").
+			Unique().
+			StructTag(`json:"parent"`).
+			Annotations(entproto.Field(13)),
+		edge.To("mentions", Employee.Type).
+			StructTag(`json:"mentions"`).
+			Annotations(entproto.Field(14)),
+		edge.To("revisions", TaskCommentRevision.Type).
+			StructTag(`json:"revisions"`).
+			Annotations(entproto.Field(15), entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the TaskComment.
+func (TaskComment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "parent_id"),
+	}
+}
+
+func (TaskComment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
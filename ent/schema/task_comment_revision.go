@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskCommentRevision keeps the previous content of a comment each time it is edited.
+type TaskCommentRevision struct {
+	ent.Schema
+}
+
+// Fields of the TaskCommentRevision.
+func (TaskCommentRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("comment_id").
+			StructTag(`json:"comment_id"`).
+			Annotations(entproto.Field(2)),
+		field.Text("content").
+			StructTag(`json:"content"`).
+			Annotations(entproto.Field(3)),
+		field.Int("editor_id").
+			StructTag(`json:"editor_id"`).
+			Annotations(entproto.Field(4)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(5)),
+	}
+}
+
+// Edges of the TaskCommentRevision.
+func (TaskCommentRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("comment", TaskComment.Type).
+			Ref("revisions").
+			Field("comment_id").
+			Unique().
+			Required().
+			StructTag(`json:"comment"`).
+			Annotations(entproto.Field(6)),
+	}
+}
+
+func (TaskCommentRevision) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskDependency links two tasks. For "blocks" links, the task identified by
+// task_id must be completed before the target task can start.
+type TaskDependency struct {
+	ent.Schema
+}
+
+// Fields of the TaskDependency.
+func (TaskDependency) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id").
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("target_id").
+			StructTag(`json:"target_id"`).
+			Annotations(entproto.Field(3)),
+		field.Enum("type").
+			Values("blocks", "relates_to", "duplicates").
+			Default("blocks").
+			StructTag(`json:"type"`).
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(map[string]int32{
+					"blocks":     0,
+					"relates_to": 1,
+					"duplicates": 2,
+				})),
+		field.Int("creator_id").
+			StructTag(`json:"creator_id"`).
+			Annotations(entproto.Field(5)),
+		field.Time("created_at").
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(6)),
+	}
+}
+
+// Edges of the TaskDependency.
+func (TaskDependency) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("dependencies").
+			Field("task_id").
+			Unique().
+			Required().
+			StructTag(`json:"task"`).
+			Annotations(entproto.Field(7)),
+		edge.From("target", Task.Type).
+			Ref("dependents").
+			Field("target_id").
+			Unique().
+			Required().
+			StructTag(`json:"target"`).
+			Annotations(entproto.Field(8)),
+	}
+}
+
+// Indexes of the TaskDependency.
+func (TaskDependency) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "target_id", "type").Unique(),
+	}
+}
+
+func (TaskDependency) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskRecurrence holds the schema definition for the TaskRecurrence entity, a
+// rule that creates tasks from a template on a schedule.
+type TaskRecurrence struct {
+	ent.Schema
+}
+
+// Fields of the TaskRecurrence.
+func (TaskRecurrence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("template_id").
+			StructTag(`json:"template_id"`).
+			Annotations(entproto.Field(2)),
+		// RRULE subset, e.g. FREQ=WEEKLY;BYDAY=MO,TH
+		field.String("rule").
+			NotEmpty().
+			StructTag(`json:"rule"`).
+			Annotations(entproto.Field(3)),
+		// The first occurrence; later ones keep its time of day
+		field.Time("start_at").
+			StructTag(`json:"start_at"`).
+			Annotations(entproto.Field(4)),
+		field.Time("until").
+			Optional().
+			Nillable().
+			StructTag(`json:"until"`).
+			Annotations(entproto.Field(5)),
+		// Nil once the rule has no occurrence left
+		field.Time("next_run_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"next_run_at"`).
+			Annotations(entproto.Field(6)),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"last_run_at"`).
+			Annotations(entproto.Field(7)),
+		// IANA time zone the rule is evaluated in
+		field.String("timezone").
+			Default("UTC").
+			StructTag(`json:"timezone"`).
+			Annotations(entproto.Field(15)),
+		field.Bool("enabled").
+			Default(true).
+			StructTag(`json:"enabled"`).
+			Annotations(entproto.Field(8)),
+		// Tasks are created on behalf of the user and employee who set up the rule
+		field.Int("creator_id").
+			StructTag(`json:"creator_id"`).
+			Annotations(entproto.Field(9)),
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(10)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(11)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Edges of the TaskRecurrence.
+func (TaskRecurrence) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("template", TaskTemplate.Type).
+			Ref("recurrences").
+			Field("template_id").
+			Unique().
+			Required().
+			StructTag(`json:"template"`).
+			Annotations(entproto.Field(13)),
+		edge.To("runs", TaskRecurrenceRun.Type).
+			StructTag(`json:"runs"`).
+			Annotations(entproto.Field(14), entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the TaskRecurrence.
+func (TaskRecurrence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled", "next_run_at"),
+	}
+}
+
+func (TaskRecurrence) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskRecurrenceRun holds the schema definition for the TaskRecurrenceRun
+// entity. One row per occurrence of a recurrence guarantees each occurrence is
+// claimed by a single replica and creates at most one task.
+type TaskRecurrenceRun struct {
+	ent.Schema
+}
+
+// Fields of the TaskRecurrenceRun.
+func (TaskRecurrenceRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("recurrence_id").
+			StructTag(`json:"recurrence_id"`).
+			Annotations(entproto.Field(2)),
+		field.Time("occurrence_at").
+			StructTag(`json:"occurrence_at"`).
+			Annotations(entproto.Field(3)),
+		field.Enum("status").
+			Values("pending", "created", "failed").
+			Default("pending").
+			StructTag(`json:"status"`).
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(map[string]int32{
+					"pending": 0,
+					"created": 1,
+					"failed":  2,
+				})),
+		field.Int("task_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(5)),
+		field.Int("attempts").
+			Default(0).
+			StructTag(`json:"attempts"`).
+			Annotations(entproto.Field(6)),
+		field.String("error").
+			Optional().
+			StructTag(`json:"error"`).
+			Annotations(entproto.Field(7)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(8)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(9)),
+	}
+}
+
+// Edges of the TaskRecurrenceRun.
+func (TaskRecurrenceRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("recurrence", TaskRecurrence.Type).
+			Ref("runs").
+			Field("recurrence_id").
+			Unique().
+			Required().
+			StructTag(`json:"recurrence"`).
+			Annotations(entproto.Field(10)),
+	}
+}
+
+// Indexes of the TaskRecurrenceRun.
+func (TaskRecurrenceRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("recurrence_id", "occurrence_at").
+			Unique(),
+		index.Fields("status", "updated_at"),
+	}
+}
+
+func (TaskRecurrenceRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
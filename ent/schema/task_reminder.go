@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskReminder holds the schema definition for the TaskReminder entity, a
+// due-soon or overdue reminder sent for a task. A reminder is claimed by
+// inserting its row, so each one is sent once.
+type TaskReminder struct {
+	ent.Schema
+}
+
+// Fields of the TaskReminder.
+func (TaskReminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id").
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(3)),
+		field.Enum("kind").
+			Values("due_soon", "overdue").
+			StructTag(`json:"kind"`).
+			Annotations(
+				entproto.Field(4),
+				entproto.Enum(map[string]int32{
+					"due_soon": 1,
+					"overdue":  2,
+				})),
+		// Due date the reminder was sent for; moving the due date reminds
+		// again
+		field.Time("due_date").
+			StructTag(`json:"due_date"`).
+			Annotations(entproto.Field(5)),
+		// Number of the overdue reminder for repeated reminders, 0 otherwise
+		field.Int("seq").
+			Default(0).
+			StructTag(`json:"seq"`).
+			Annotations(entproto.Field(6)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(7)),
+	}
+}
+
+// Edges of the TaskReminder.
+func (TaskReminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("reminders").
+			Field("task_id").
+			Unique().
+			Required().
+			StructTag(`json:"task"`).
+			Annotations(entproto.Field(8)),
+	}
+}
+
+// Indexes of the TaskReminder.
+func (TaskReminder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "kind", "due_date", "seq").
+			Unique(),
+	}
+}
+
+func (TaskReminder) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
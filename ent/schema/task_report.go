@@ -38,6 +38,39 @@ func (TaskReport) Fields() []ent.Field {
 			UpdateDefault(time.Now).
 			StructTag(`json:"updated_at"`).
 			Annotations(entproto.Field(7)),
+		// Progress reported for the task, applied to Task.process once accepted
+		field.Int("progress").
+			Optional().
+			Nillable().
+			Min(0).
+			Max(100).
+			StructTag(`json:"progress"`).
+			Annotations(entproto.Field(10)),
+		field.Enum("status").
+			Values("submitted", "accepted", "needs_changes").
+			Default("submitted").
+			StructTag(`json:"status"`).
+			Annotations(
+				entproto.Field(11),
+				entproto.Enum(map[string]int32{
+					"submitted":     0,
+					"accepted":      1,
+					"needs_changes": 2,
+				})),
+		field.Int("reviewer_id").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewer_id"`).
+			Annotations(entproto.Field(12)),
+		field.String("review_comment").
+			Optional().
+			StructTag(`json:"review_comment"`).
+			Annotations(entproto.Field(13)),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"reviewed_at"`).
+			Annotations(entproto.Field(14)),
 	}
 }
 
@@ -58,6 +91,12 @@ func (TaskReport) Edges() []ent.Edge {
 			Required().
 			StructTag(`json:"reporter"`).
 			Annotations(entproto.Field(9)),
+		edge.From("reviewer", Employee.Type).
+			Ref("reviewed_task_reports").
+			Field("reviewer_id").
+			Unique().
+			StructTag(`json:"reviewer"`).
+			Annotations(entproto.Field(15)),
 	}
 }
 
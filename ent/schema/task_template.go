@@ -0,0 +1,120 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskTemplate holds the schema definition for the TaskTemplate entity, a
+// blueprint for routine tasks of an organization or one of its projects.
+type TaskTemplate struct {
+	ent.Schema
+}
+
+// Fields of the TaskTemplate.
+func (TaskTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("project_id").
+			Optional().
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(3)),
+		// Name of the created tasks; {date} is replaced by the occurrence date
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`).
+			Annotations(entproto.Field(4)),
+		field.String("description").
+			Optional().
+			StructTag(`json:"description"`).
+			Annotations(entproto.Field(5)),
+		field.Enum("type").
+			Values("task", "feature", "bug", "another").
+			Default("task").
+			StructTag(`json:"type"`).
+			Annotations(
+				entproto.Field(6),
+				entproto.Enum(map[string]int32{
+					"task":    0,
+					"feature": 1,
+					"bug":     2,
+					"another": 3,
+				})),
+		field.Ints("label_ids").
+			Optional().
+			StructTag(`json:"label_ids"`).
+			Annotations(entproto.Skip()),
+		field.Ints("assignee_ids").
+			Optional().
+			StructTag(`json:"assignee_ids"`).
+			Annotations(entproto.Skip()),
+		// Offsets in minutes from the time a task is created
+		field.Int("start_offset").
+			Optional().
+			Nillable().
+			StructTag(`json:"start_offset"`).
+			Annotations(entproto.Field(7)),
+		field.Int("due_offset").
+			Optional().
+			Nillable().
+			StructTag(`json:"due_offset"`).
+			Annotations(entproto.Field(8)),
+		field.Int("original_estimate").
+			Optional().
+			Nillable().
+			NonNegative().
+			StructTag(`json:"original_estimate"`).
+			Annotations(entproto.Field(9)),
+		field.Int("creator_id").
+			StructTag(`json:"creator_id"`).
+			Annotations(entproto.Field(10)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(11)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(12)),
+	}
+}
+
+// Edges of the TaskTemplate.
+func (TaskTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("task_templates").
+			Field("project_id").
+			Unique().
+			StructTag(`json:"project"`).
+			Annotations(entproto.Field(13)),
+		edge.To("recurrences", TaskRecurrence.Type).
+			StructTag(`json:"recurrences"`).
+			Annotations(entproto.Field(14), entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the TaskTemplate.
+func (TaskTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "project_id"),
+	}
+}
+
+func (TaskTemplate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
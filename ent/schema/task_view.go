@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskView holds the schema definition for the TaskView entity, a saved task
+// list filter of an employee that can be shared with a project or the whole
+// organization.
+type TaskView struct {
+	ent.Schema
+}
+
+// Fields of the TaskView.
+func (TaskView) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("org_id").
+			StructTag(`json:"org_id"`).
+			Annotations(entproto.Field(2)),
+		// Employee owning the view; only they can change it
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(3)),
+		field.Int("project_id").
+			Optional().
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(4)),
+		field.String("name").
+			NotEmpty().
+			StructTag(`json:"name"`).
+			Annotations(entproto.Field(5)),
+		field.Enum("visibility").
+			Values("private", "project", "organization").
+			Default("private").
+			StructTag(`json:"visibility"`).
+			Annotations(
+				entproto.Field(6),
+				entproto.Enum(map[string]int32{
+					"private":      0,
+					"project":      1,
+					"organization": 2,
+				})),
+		// Task list query parameters by name; dates may be relative, e.g. today+7d
+		field.JSON("filters", map[string]string{}).
+			Optional().
+			StructTag(`json:"filters"`).
+			Annotations(entproto.Skip()),
+		// Time zone relative dates are resolved in
+		field.String("timezone").
+			Default("UTC").
+			StructTag(`json:"timezone"`).
+			Annotations(entproto.Field(7)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(8)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(9)),
+	}
+}
+
+// Edges of the TaskView.
+func (TaskView) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("task_views").
+			Field("project_id").
+			Unique().
+			StructTag(`json:"project"`).
+			Annotations(entproto.Field(10)),
+	}
+}
+
+// Indexes of the TaskView.
+func (TaskView) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "employee_id"),
+		index.Fields("org_id", "visibility"),
+		index.Fields("project_id"),
+	}
+}
+
+func (TaskView) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
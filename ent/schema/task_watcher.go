@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskWatcher holds the schema definition for the TaskWatcher entity, an
+// employee notified of a task's status changes besides its assignees.
+type TaskWatcher struct {
+	ent.Schema
+}
+
+// Fields of the TaskWatcher.
+func (TaskWatcher) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id").
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(3)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(4)),
+	}
+}
+
+// Edges of the TaskWatcher.
+func (TaskWatcher) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("watchers").
+			Field("task_id").
+			Unique().
+			Required().
+			StructTag(`json:"task"`).
+			Annotations(entproto.Field(5)),
+	}
+}
+
+// Indexes of the TaskWatcher.
+func (TaskWatcher) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "employee_id").
+			Unique(),
+		index.Fields("employee_id"),
+	}
+}
+
+func (TaskWatcher) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WorkLog holds time spent by an employee on a task. A log without an end
+// time is a running timer.
+type WorkLog struct {
+	ent.Schema
+}
+
+// Fields of the WorkLog.
+func (WorkLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id").
+			StructTag(`json:"task_id"`).
+			Annotations(entproto.Field(2)),
+		field.Int("employee_id").
+			StructTag(`json:"employee_id"`).
+			Annotations(entproto.Field(3)),
+		field.Time("started_at").
+			StructTag(`json:"started_at"`).
+			Annotations(entproto.Field(4)),
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			StructTag(`json:"ended_at"`).
+			Annotations(entproto.Field(5)),
+		// Duration in minutes, filled when the log is stopped
+		field.Int("duration").
+			Default(0).
+			NonNegative().
+			StructTag(`json:"duration"`).
+			Annotations(entproto.Field(6)),
+		field.String("note").
+			Optional().
+			StructTag(`json:"note"`).
+			Annotations(entproto.Field(7)),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			StructTag(`json:"created_at"`).
+			Annotations(entproto.Field(8)),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			StructTag(`json:"updated_at"`).
+			Annotations(entproto.Field(9)),
+	}
+}
+
+// Edges of the WorkLog.
+func (WorkLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("work_logs").
+			Field("task_id").
+			Unique().
+			Required().
+			StructTag(`json:"task"`).
+			Annotations(entproto.Field(10)),
+		edge.From("employee", Employee.Type).
+			Ref("work_logs").
+			Field("employee_id").
+			Unique().
+			Required().
+			StructTag(`json:"employee"`).
+			Annotations(entproto.Field(11)),
+	}
+}
+
+// Indexes of the WorkLog.
+func (WorkLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "started_at"),
+		index.Fields("employee_id", "started_at"),
+		// An employee runs at most one timer at a time
+		index.Fields("employee_id").
+			Unique().
+			Annotations(entsql.IndexWhere("ended_at IS NULL")),
+	}
+}
+
+func (WorkLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WorkflowStatus holds a custom task status of a project's workflow. Each
+// status belongs to a category and maps to one of the built-in task statuses,
+// which the rest of the system keeps relying on.
+type WorkflowStatus struct {
+	ent.Schema
+}
+
+// Fields of the WorkflowStatus.
+func (WorkflowStatus) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(2)),
+		field.String("key").
+			StructTag(`json:"key"`).
+			Annotations(entproto.Field(3)),
+		field.String("name").
+			StructTag(`json:"name"`).
+			Annotations(entproto.Field(4)),
+		field.Enum("category").
+			Values("todo", "doing", "done").
+			Default("todo").
+			StructTag(`json:"category"`).
+			Annotations(
+				entproto.Field(5),
+				entproto.Enum(map[string]int32{
+					"todo":  0,
+					"doing": 1,
+					"done":  2,
+				})),
+		field.Enum("system_status").
+			Values("not_received", "received", "in_progress", "completed", "cancelled").
+			Default("not_received").
+			StructTag(`json:"system_status"`).
+			Annotations(
+				entproto.Field(6),
+				entproto.Enum(map[string]int32{
+					"not_received": 0,
+					"received":     1,
+					"in_progress":  2,
+					"completed":    3,
+					"cancelled":    4,
+				})),
+		// Position orders the statuses; the first one is given to new tasks
+		field.Int("position").
+			Default(0).
+			StructTag(`json:"position"`).
+			Annotations(entproto.Field(7)),
+		// WIPLimit caps the number of tasks in the status' board column
+		field.Int("wip_limit").
+			Optional().
+			Nillable().
+			Positive().
+			StructTag(`json:"wip_limit"`).
+			Annotations(entproto.Field(9)),
+	}
+}
+
+// Edges of the WorkflowStatus.
+func (WorkflowStatus) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("workflow_statuses").
+			Field("project_id").
+			Unique().
+			Required().
+			StructTag(`json:"project"`).
+			Annotations(entproto.Field(8)),
+	}
+}
+
+// Indexes of the WorkflowStatus.
+func (WorkflowStatus) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "key").
+			Unique(),
+	}
+}
+
+func (WorkflowStatus) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WorkflowTransition holds an allowed move between two statuses of a
+// project's workflow, with the guards that must pass before it is taken
+type WorkflowTransition struct {
+	ent.Schema
+}
+
+// Fields of the WorkflowTransition.
+func (WorkflowTransition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			StructTag(`json:"project_id"`).
+			Annotations(entproto.Field(2)),
+		// An empty from_key allows the transition from any status
+		field.String("from_key").
+			Default("").
+			StructTag(`json:"from_key"`).
+			Annotations(entproto.Field(3)),
+		field.String("to_key").
+			StructTag(`json:"to_key"`).
+			Annotations(entproto.Field(4)),
+		field.Strings("guards").
+			Optional().
+			StructTag(`json:"guards"`).
+			Annotations(entproto.Skip()),
+	}
+}
+
+// Edges of the WorkflowTransition.
+func (WorkflowTransition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("workflow_transitions").
+			Field("project_id").
+			Unique().
+			Required().
+			StructTag(`json:"project"`).
+			Annotations(entproto.Field(6)),
+	}
+}
+
+// Indexes of the WorkflowTransition.
+func (WorkflowTransition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "from_key", "to_key").
+			Unique(),
+	}
+}
+
+func (WorkflowTransition) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(),
+	}
+}
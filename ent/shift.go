@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+)
+
+// Shift is the model entity for the Shift schema.
+type Shift struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// StartTime holds the value of the "start_time" field.
+	StartTime string `json:"start_time"`
+	// EndTime holds the value of the "end_time" field.
+	EndTime string `json:"end_time"`
+	// BreakMinutes holds the value of the "break_minutes" field.
+	BreakMinutes int `json:"break_minutes"`
+	// BreakPaid holds the value of the "break_paid" field.
+	BreakPaid bool `json:"break_paid"`
+	// IsNight holds the value of the "is_night" field.
+	IsNight bool `json:"is_night"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShiftQuery when eager-loading is set.
+	Edges        ShiftEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShiftEdges holds the relations/edges for other nodes in the graph.
+type ShiftEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization"`
+	// Rosters holds the value of the rosters edge.
+	Rosters []*Roster `json:"rosters"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShiftEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// RostersOrErr returns the Rosters value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShiftEdges) RostersOrErr() ([]*Roster, error) {
+	if e.loadedTypes[1] {
+		return e.Rosters, nil
+	}
+	return nil, &NotLoadedError{edge: "rosters"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Shift) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shift.FieldBreakPaid, shift.FieldIsNight:
+			values[i] = new(sql.NullBool)
+		case shift.FieldID, shift.FieldBreakMinutes, shift.FieldOrgID:
+			values[i] = new(sql.NullInt64)
+		case shift.FieldCode, shift.FieldName, shift.FieldStartTime, shift.FieldEndTime:
+			values[i] = new(sql.NullString)
+		case shift.FieldCreatedAt, shift.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Shift fields.
+func (s *Shift) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shift.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case shift.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				s.Code = value.String
+			}
+		case shift.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case shift.FieldStartTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				s.StartTime = value.String
+			}
+		case shift.FieldEndTime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				s.EndTime = value.String
+			}
+		case shift.FieldBreakMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field break_minutes", values[i])
+			} else if value.Valid {
+				s.BreakMinutes = int(value.Int64)
+			}
+		case shift.FieldBreakPaid:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field break_paid", values[i])
+			} else if value.Valid {
+				s.BreakPaid = value.Bool
+			}
+		case shift.FieldIsNight:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_night", values[i])
+			} else if value.Valid {
+				s.IsNight = value.Bool
+			}
+		case shift.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				s.OrgID = int(value.Int64)
+			}
+		case shift.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case shift.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Shift.
+// This includes values selected through modifiers, order, etc.
+func (s *Shift) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the Shift entity.
+func (s *Shift) QueryOrganization() *OrganizationQuery {
+	return NewShiftClient(s.config).QueryOrganization(s)
+}
+
+// QueryRosters queries the "rosters" edge of the Shift entity.
+func (s *Shift) QueryRosters() *RosterQuery {
+	return NewShiftClient(s.config).QueryRosters(s)
+}
+
+// Update returns a builder for updating this Shift.
+// Note that you need to call Shift.Unwrap() before calling this method if this Shift
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Shift) Update() *ShiftUpdateOne {
+	return NewShiftClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Shift entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Shift) Unwrap() *Shift {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Shift is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Shift) String() string {
+	var builder strings.Builder
+	builder.WriteString("Shift(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("code=")
+	builder.WriteString(s.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(s.StartTime)
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(s.EndTime)
+	builder.WriteString(", ")
+	builder.WriteString("break_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", s.BreakMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("break_paid=")
+	builder.WriteString(fmt.Sprintf("%v", s.BreakPaid))
+	builder.WriteString(", ")
+	builder.WriteString("is_night=")
+	builder.WriteString(fmt.Sprintf("%v", s.IsNight))
+	builder.WriteString(", ")
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shifts is a parsable slice of Shift.
+type Shifts []*Shift
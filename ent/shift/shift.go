@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shift
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the shift type in the database.
+	Label = "shift"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldBreakMinutes holds the string denoting the break_minutes field in the database.
+	FieldBreakMinutes = "break_minutes"
+	// FieldBreakPaid holds the string denoting the break_paid field in the database.
+	FieldBreakPaid = "break_paid"
+	// FieldIsNight holds the string denoting the is_night field in the database.
+	FieldIsNight = "is_night"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeRosters holds the string denoting the rosters edge name in mutations.
+	EdgeRosters = "rosters"
+	// Table holds the table name of the shift in the database.
+	Table = "shifts"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "shifts"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "org_id"
+	// RostersTable is the table that holds the rosters relation/edge.
+	RostersTable = "rosters"
+	// RostersInverseTable is the table name for the Roster entity.
+	// It exists in this package in order to avoid circular dependency with the "roster" package.
+	RostersInverseTable = "rosters"
+	// RostersColumn is the table column denoting the rosters relation/edge.
+	RostersColumn = "shift_id"
+)
+
+// Columns holds all SQL columns for shift fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldName,
+	FieldStartTime,
+	FieldEndTime,
+	FieldBreakMinutes,
+	FieldBreakPaid,
+	FieldIsNight,
+	FieldOrgID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultBreakMinutes holds the default value on creation for the "break_minutes" field.
+	DefaultBreakMinutes int
+	// BreakMinutesValidator is a validator for the "break_minutes" field. It is called by the builders before save.
+	BreakMinutesValidator func(int) error
+	// DefaultBreakPaid holds the default value on creation for the "break_paid" field.
+	DefaultBreakPaid bool
+	// DefaultIsNight holds the default value on creation for the "is_night" field.
+	DefaultIsNight bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Shift queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByBreakMinutes orders the results by the break_minutes field.
+func ByBreakMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakMinutes, opts...).ToFunc()
+}
+
+// ByBreakPaid orders the results by the break_paid field.
+func ByBreakPaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakPaid, opts...).ToFunc()
+}
+
+// ByIsNight orders the results by the is_night field.
+func ByIsNight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsNight, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRostersCount orders the results by rosters count.
+func ByRostersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRostersStep(), opts...)
+	}
+}
+
+// ByRosters orders the results by rosters terms.
+func ByRosters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRostersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
+func newRostersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RostersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RostersTable, RostersColumn),
+	)
+}
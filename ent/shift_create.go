@@ -0,0 +1,1093 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+)
+
+// ShiftCreate is the builder for creating a Shift entity.
+type ShiftCreate struct {
+	config
+	mutation *ShiftMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (sc *ShiftCreate) SetCode(s string) *ShiftCreate {
+	sc.mutation.SetCode(s)
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *ShiftCreate) SetName(s string) *ShiftCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetStartTime sets the "start_time" field.
+func (sc *ShiftCreate) SetStartTime(s string) *ShiftCreate {
+	sc.mutation.SetStartTime(s)
+	return sc
+}
+
+// SetEndTime sets the "end_time" field.
+func (sc *ShiftCreate) SetEndTime(s string) *ShiftCreate {
+	sc.mutation.SetEndTime(s)
+	return sc
+}
+
+// SetBreakMinutes sets the "break_minutes" field.
+func (sc *ShiftCreate) SetBreakMinutes(i int) *ShiftCreate {
+	sc.mutation.SetBreakMinutes(i)
+	return sc
+}
+
+// SetNillableBreakMinutes sets the "break_minutes" field if the given value is not nil.
+func (sc *ShiftCreate) SetNillableBreakMinutes(i *int) *ShiftCreate {
+	if i != nil {
+		sc.SetBreakMinutes(*i)
+	}
+	return sc
+}
+
+// SetBreakPaid sets the "break_paid" field.
+func (sc *ShiftCreate) SetBreakPaid(b bool) *ShiftCreate {
+	sc.mutation.SetBreakPaid(b)
+	return sc
+}
+
+// SetNillableBreakPaid sets the "break_paid" field if the given value is not nil.
+func (sc *ShiftCreate) SetNillableBreakPaid(b *bool) *ShiftCreate {
+	if b != nil {
+		sc.SetBreakPaid(*b)
+	}
+	return sc
+}
+
+// SetIsNight sets the "is_night" field.
+func (sc *ShiftCreate) SetIsNight(b bool) *ShiftCreate {
+	sc.mutation.SetIsNight(b)
+	return sc
+}
+
+// SetNillableIsNight sets the "is_night" field if the given value is not nil.
+func (sc *ShiftCreate) SetNillableIsNight(b *bool) *ShiftCreate {
+	if b != nil {
+		sc.SetIsNight(*b)
+	}
+	return sc
+}
+
+// SetOrgID sets the "org_id" field.
+func (sc *ShiftCreate) SetOrgID(i int) *ShiftCreate {
+	sc.mutation.SetOrgID(i)
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ShiftCreate) SetCreatedAt(t time.Time) *ShiftCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ShiftCreate) SetNillableCreatedAt(t *time.Time) *ShiftCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ShiftCreate) SetUpdatedAt(t time.Time) *ShiftCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ShiftCreate) SetNillableUpdatedAt(t *time.Time) *ShiftCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (sc *ShiftCreate) SetOrganizationID(id int) *ShiftCreate {
+	sc.mutation.SetOrganizationID(id)
+	return sc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (sc *ShiftCreate) SetOrganization(o *Organization) *ShiftCreate {
+	return sc.SetOrganizationID(o.ID)
+}
+
+// AddRosterIDs adds the "rosters" edge to the Roster entity by IDs.
+func (sc *ShiftCreate) AddRosterIDs(ids ...int) *ShiftCreate {
+	sc.mutation.AddRosterIDs(ids...)
+	return sc
+}
+
+// AddRosters adds the "rosters" edges to the Roster entity.
+func (sc *ShiftCreate) AddRosters(r ...*Roster) *ShiftCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return sc.AddRosterIDs(ids...)
+}
+
+// Mutation returns the ShiftMutation object of the builder.
+func (sc *ShiftCreate) Mutation() *ShiftMutation {
+	return sc.mutation
+}
+
+// Save creates the Shift in the database.
+func (sc *ShiftCreate) Save(ctx context.Context) (*Shift, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ShiftCreate) SaveX(ctx context.Context) *Shift {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ShiftCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ShiftCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ShiftCreate) defaults() {
+	if _, ok := sc.mutation.BreakMinutes(); !ok {
+		v := shift.DefaultBreakMinutes
+		sc.mutation.SetBreakMinutes(v)
+	}
+	if _, ok := sc.mutation.BreakPaid(); !ok {
+		v := shift.DefaultBreakPaid
+		sc.mutation.SetBreakPaid(v)
+	}
+	if _, ok := sc.mutation.IsNight(); !ok {
+		v := shift.DefaultIsNight
+		sc.mutation.SetIsNight(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := shift.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := shift.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ShiftCreate) check() error {
+	if _, ok := sc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Shift.code"`)}
+	}
+	if v, ok := sc.mutation.Code(); ok {
+		if err := shift.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Shift.code": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Shift.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := shift.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Shift.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "Shift.start_time"`)}
+	}
+	if _, ok := sc.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "Shift.end_time"`)}
+	}
+	if _, ok := sc.mutation.BreakMinutes(); !ok {
+		return &ValidationError{Name: "break_minutes", err: errors.New(`ent: missing required field "Shift.break_minutes"`)}
+	}
+	if v, ok := sc.mutation.BreakMinutes(); ok {
+		if err := shift.BreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "break_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.break_minutes": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.BreakPaid(); !ok {
+		return &ValidationError{Name: "break_paid", err: errors.New(`ent: missing required field "Shift.break_paid"`)}
+	}
+	if _, ok := sc.mutation.IsNight(); !ok {
+		return &ValidationError{Name: "is_night", err: errors.New(`ent: missing required field "Shift.is_night"`)}
+	}
+	if _, ok := sc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "Shift.org_id"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Shift.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Shift.updated_at"`)}
+	}
+	if len(sc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Shift.organization"`)}
+	}
+	return nil
+}
+
+func (sc *ShiftCreate) sqlSave(ctx context.Context) (*Shift, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ShiftCreate) createSpec() (*Shift, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Shift{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(shift.Table, sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sc.conflict
+	if value, ok := sc.mutation.Code(); ok {
+		_spec.SetField(shift.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(shift.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.StartTime(); ok {
+		_spec.SetField(shift.FieldStartTime, field.TypeString, value)
+		_node.StartTime = value
+	}
+	if value, ok := sc.mutation.EndTime(); ok {
+		_spec.SetField(shift.FieldEndTime, field.TypeString, value)
+		_node.EndTime = value
+	}
+	if value, ok := sc.mutation.BreakMinutes(); ok {
+		_spec.SetField(shift.FieldBreakMinutes, field.TypeInt, value)
+		_node.BreakMinutes = value
+	}
+	if value, ok := sc.mutation.BreakPaid(); ok {
+		_spec.SetField(shift.FieldBreakPaid, field.TypeBool, value)
+		_node.BreakPaid = value
+	}
+	if value, ok := sc.mutation.IsNight(); ok {
+		_spec.SetField(shift.FieldIsNight, field.TypeBool, value)
+		_node.IsNight = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(shift.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(shift.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shift.OrganizationTable,
+			Columns: []string{shift.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.RostersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.RostersTable,
+			Columns: []string{shift.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Shift.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShiftUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *ShiftCreate) OnConflict(opts ...sql.ConflictOption) *ShiftUpsertOne {
+	sc.conflict = opts
+	return &ShiftUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Shift.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *ShiftCreate) OnConflictColumns(columns ...string) *ShiftUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &ShiftUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// ShiftUpsertOne is the builder for "upsert"-ing
+	//  one Shift node.
+	ShiftUpsertOne struct {
+		create *ShiftCreate
+	}
+
+	// ShiftUpsert is the "OnConflict" setter.
+	ShiftUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCode sets the "code" field.
+func (u *ShiftUpsert) SetCode(v string) *ShiftUpsert {
+	u.Set(shift.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateCode() *ShiftUpsert {
+	u.SetExcluded(shift.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ShiftUpsert) SetName(v string) *ShiftUpsert {
+	u.Set(shift.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateName() *ShiftUpsert {
+	u.SetExcluded(shift.FieldName)
+	return u
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *ShiftUpsert) SetStartTime(v string) *ShiftUpsert {
+	u.Set(shift.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateStartTime() *ShiftUpsert {
+	u.SetExcluded(shift.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *ShiftUpsert) SetEndTime(v string) *ShiftUpsert {
+	u.Set(shift.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateEndTime() *ShiftUpsert {
+	u.SetExcluded(shift.FieldEndTime)
+	return u
+}
+
+// SetBreakMinutes sets the "break_minutes" field.
+func (u *ShiftUpsert) SetBreakMinutes(v int) *ShiftUpsert {
+	u.Set(shift.FieldBreakMinutes, v)
+	return u
+}
+
+// UpdateBreakMinutes sets the "break_minutes" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateBreakMinutes() *ShiftUpsert {
+	u.SetExcluded(shift.FieldBreakMinutes)
+	return u
+}
+
+// AddBreakMinutes adds v to the "break_minutes" field.
+func (u *ShiftUpsert) AddBreakMinutes(v int) *ShiftUpsert {
+	u.Add(shift.FieldBreakMinutes, v)
+	return u
+}
+
+// SetBreakPaid sets the "break_paid" field.
+func (u *ShiftUpsert) SetBreakPaid(v bool) *ShiftUpsert {
+	u.Set(shift.FieldBreakPaid, v)
+	return u
+}
+
+// UpdateBreakPaid sets the "break_paid" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateBreakPaid() *ShiftUpsert {
+	u.SetExcluded(shift.FieldBreakPaid)
+	return u
+}
+
+// SetIsNight sets the "is_night" field.
+func (u *ShiftUpsert) SetIsNight(v bool) *ShiftUpsert {
+	u.Set(shift.FieldIsNight, v)
+	return u
+}
+
+// UpdateIsNight sets the "is_night" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateIsNight() *ShiftUpsert {
+	u.SetExcluded(shift.FieldIsNight)
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *ShiftUpsert) SetOrgID(v int) *ShiftUpsert {
+	u.Set(shift.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateOrgID() *ShiftUpsert {
+	u.SetExcluded(shift.FieldOrgID)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShiftUpsert) SetCreatedAt(v time.Time) *ShiftUpsert {
+	u.Set(shift.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateCreatedAt() *ShiftUpsert {
+	u.SetExcluded(shift.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShiftUpsert) SetUpdatedAt(v time.Time) *ShiftUpsert {
+	u.Set(shift.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShiftUpsert) UpdateUpdatedAt() *ShiftUpsert {
+	u.SetExcluded(shift.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Shift.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ShiftUpsertOne) UpdateNewValues() *ShiftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Shift.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShiftUpsertOne) Ignore() *ShiftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShiftUpsertOne) DoNothing() *ShiftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShiftCreate.OnConflict
+// documentation for more info.
+func (u *ShiftUpsertOne) Update(set func(*ShiftUpsert)) *ShiftUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShiftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *ShiftUpsertOne) SetCode(v string) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateCode() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ShiftUpsertOne) SetName(v string) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateName() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *ShiftUpsertOne) SetStartTime(v string) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateStartTime() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *ShiftUpsertOne) SetEndTime(v string) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateEndTime() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetBreakMinutes sets the "break_minutes" field.
+func (u *ShiftUpsertOne) SetBreakMinutes(v int) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetBreakMinutes(v)
+	})
+}
+
+// AddBreakMinutes adds v to the "break_minutes" field.
+func (u *ShiftUpsertOne) AddBreakMinutes(v int) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.AddBreakMinutes(v)
+	})
+}
+
+// UpdateBreakMinutes sets the "break_minutes" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateBreakMinutes() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateBreakMinutes()
+	})
+}
+
+// SetBreakPaid sets the "break_paid" field.
+func (u *ShiftUpsertOne) SetBreakPaid(v bool) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetBreakPaid(v)
+	})
+}
+
+// UpdateBreakPaid sets the "break_paid" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateBreakPaid() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateBreakPaid()
+	})
+}
+
+// SetIsNight sets the "is_night" field.
+func (u *ShiftUpsertOne) SetIsNight(v bool) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetIsNight(v)
+	})
+}
+
+// UpdateIsNight sets the "is_night" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateIsNight() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateIsNight()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *ShiftUpsertOne) SetOrgID(v int) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateOrgID() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShiftUpsertOne) SetCreatedAt(v time.Time) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateCreatedAt() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShiftUpsertOne) SetUpdatedAt(v time.Time) *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShiftUpsertOne) UpdateUpdatedAt() *ShiftUpsertOne {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShiftUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShiftCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShiftUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShiftUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShiftUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShiftCreateBulk is the builder for creating many Shift entities in bulk.
+type ShiftCreateBulk struct {
+	config
+	err      error
+	builders []*ShiftCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Shift entities in the database.
+func (scb *ShiftCreateBulk) Save(ctx context.Context) ([]*Shift, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Shift, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShiftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ShiftCreateBulk) SaveX(ctx context.Context) []*Shift {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ShiftCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ShiftCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Shift.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShiftUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *ShiftCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShiftUpsertBulk {
+	scb.conflict = opts
+	return &ShiftUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Shift.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *ShiftCreateBulk) OnConflictColumns(columns ...string) *ShiftUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &ShiftUpsertBulk{
+		create: scb,
+	}
+}
+
+// ShiftUpsertBulk is the builder for "upsert"-ing
+// a bulk of Shift nodes.
+type ShiftUpsertBulk struct {
+	create *ShiftCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Shift.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ShiftUpsertBulk) UpdateNewValues() *ShiftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Shift.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShiftUpsertBulk) Ignore() *ShiftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShiftUpsertBulk) DoNothing() *ShiftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShiftCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShiftUpsertBulk) Update(set func(*ShiftUpsert)) *ShiftUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShiftUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *ShiftUpsertBulk) SetCode(v string) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateCode() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ShiftUpsertBulk) SetName(v string) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateName() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *ShiftUpsertBulk) SetStartTime(v string) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateStartTime() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *ShiftUpsertBulk) SetEndTime(v string) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateEndTime() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetBreakMinutes sets the "break_minutes" field.
+func (u *ShiftUpsertBulk) SetBreakMinutes(v int) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetBreakMinutes(v)
+	})
+}
+
+// AddBreakMinutes adds v to the "break_minutes" field.
+func (u *ShiftUpsertBulk) AddBreakMinutes(v int) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.AddBreakMinutes(v)
+	})
+}
+
+// UpdateBreakMinutes sets the "break_minutes" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateBreakMinutes() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateBreakMinutes()
+	})
+}
+
+// SetBreakPaid sets the "break_paid" field.
+func (u *ShiftUpsertBulk) SetBreakPaid(v bool) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetBreakPaid(v)
+	})
+}
+
+// UpdateBreakPaid sets the "break_paid" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateBreakPaid() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateBreakPaid()
+	})
+}
+
+// SetIsNight sets the "is_night" field.
+func (u *ShiftUpsertBulk) SetIsNight(v bool) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetIsNight(v)
+	})
+}
+
+// UpdateIsNight sets the "is_night" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateIsNight() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateIsNight()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *ShiftUpsertBulk) SetOrgID(v int) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateOrgID() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShiftUpsertBulk) SetCreatedAt(v time.Time) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateCreatedAt() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShiftUpsertBulk) SetUpdatedAt(v time.Time) *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShiftUpsertBulk) UpdateUpdatedAt() *ShiftUpsertBulk {
+	return u.Update(func(s *ShiftUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShiftUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShiftCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShiftCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShiftUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,840 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shift"
+)
+
+// ShiftUpdate is the builder for updating Shift entities.
+type ShiftUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShiftMutation
+}
+
+// Where appends a list predicates to the ShiftUpdate builder.
+func (su *ShiftUpdate) Where(ps ...predicate.Shift) *ShiftUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetCode sets the "code" field.
+func (su *ShiftUpdate) SetCode(s string) *ShiftUpdate {
+	su.mutation.SetCode(s)
+	return su
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableCode(s *string) *ShiftUpdate {
+	if s != nil {
+		su.SetCode(*s)
+	}
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *ShiftUpdate) SetName(s string) *ShiftUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableName(s *string) *ShiftUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetStartTime sets the "start_time" field.
+func (su *ShiftUpdate) SetStartTime(s string) *ShiftUpdate {
+	su.mutation.SetStartTime(s)
+	return su
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableStartTime(s *string) *ShiftUpdate {
+	if s != nil {
+		su.SetStartTime(*s)
+	}
+	return su
+}
+
+// SetEndTime sets the "end_time" field.
+func (su *ShiftUpdate) SetEndTime(s string) *ShiftUpdate {
+	su.mutation.SetEndTime(s)
+	return su
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableEndTime(s *string) *ShiftUpdate {
+	if s != nil {
+		su.SetEndTime(*s)
+	}
+	return su
+}
+
+// SetBreakMinutes sets the "break_minutes" field.
+func (su *ShiftUpdate) SetBreakMinutes(i int) *ShiftUpdate {
+	su.mutation.ResetBreakMinutes()
+	su.mutation.SetBreakMinutes(i)
+	return su
+}
+
+// SetNillableBreakMinutes sets the "break_minutes" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableBreakMinutes(i *int) *ShiftUpdate {
+	if i != nil {
+		su.SetBreakMinutes(*i)
+	}
+	return su
+}
+
+// AddBreakMinutes adds i to the "break_minutes" field.
+func (su *ShiftUpdate) AddBreakMinutes(i int) *ShiftUpdate {
+	su.mutation.AddBreakMinutes(i)
+	return su
+}
+
+// SetBreakPaid sets the "break_paid" field.
+func (su *ShiftUpdate) SetBreakPaid(b bool) *ShiftUpdate {
+	su.mutation.SetBreakPaid(b)
+	return su
+}
+
+// SetNillableBreakPaid sets the "break_paid" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableBreakPaid(b *bool) *ShiftUpdate {
+	if b != nil {
+		su.SetBreakPaid(*b)
+	}
+	return su
+}
+
+// SetIsNight sets the "is_night" field.
+func (su *ShiftUpdate) SetIsNight(b bool) *ShiftUpdate {
+	su.mutation.SetIsNight(b)
+	return su
+}
+
+// SetNillableIsNight sets the "is_night" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableIsNight(b *bool) *ShiftUpdate {
+	if b != nil {
+		su.SetIsNight(*b)
+	}
+	return su
+}
+
+// SetOrgID sets the "org_id" field.
+func (su *ShiftUpdate) SetOrgID(i int) *ShiftUpdate {
+	su.mutation.SetOrgID(i)
+	return su
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableOrgID(i *int) *ShiftUpdate {
+	if i != nil {
+		su.SetOrgID(*i)
+	}
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *ShiftUpdate) SetCreatedAt(t time.Time) *ShiftUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *ShiftUpdate) SetNillableCreatedAt(t *time.Time) *ShiftUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ShiftUpdate) SetUpdatedAt(t time.Time) *ShiftUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (su *ShiftUpdate) SetOrganizationID(id int) *ShiftUpdate {
+	su.mutation.SetOrganizationID(id)
+	return su
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (su *ShiftUpdate) SetOrganization(o *Organization) *ShiftUpdate {
+	return su.SetOrganizationID(o.ID)
+}
+
+// AddRosterIDs adds the "rosters" edge to the Roster entity by IDs.
+func (su *ShiftUpdate) AddRosterIDs(ids ...int) *ShiftUpdate {
+	su.mutation.AddRosterIDs(ids...)
+	return su
+}
+
+// AddRosters adds the "rosters" edges to the Roster entity.
+func (su *ShiftUpdate) AddRosters(r ...*Roster) *ShiftUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return su.AddRosterIDs(ids...)
+}
+
+// Mutation returns the ShiftMutation object of the builder.
+func (su *ShiftUpdate) Mutation() *ShiftMutation {
+	return su.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (su *ShiftUpdate) ClearOrganization() *ShiftUpdate {
+	su.mutation.ClearOrganization()
+	return su
+}
+
+// ClearRosters clears all "rosters" edges to the Roster entity.
+func (su *ShiftUpdate) ClearRosters() *ShiftUpdate {
+	su.mutation.ClearRosters()
+	return su
+}
+
+// RemoveRosterIDs removes the "rosters" edge to Roster entities by IDs.
+func (su *ShiftUpdate) RemoveRosterIDs(ids ...int) *ShiftUpdate {
+	su.mutation.RemoveRosterIDs(ids...)
+	return su
+}
+
+// RemoveRosters removes "rosters" edges to Roster entities.
+func (su *ShiftUpdate) RemoveRosters(r ...*Roster) *ShiftUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return su.RemoveRosterIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ShiftUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ShiftUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ShiftUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ShiftUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ShiftUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := shift.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ShiftUpdate) check() error {
+	if v, ok := su.mutation.Code(); ok {
+		if err := shift.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Shift.code": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Name(); ok {
+		if err := shift.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Shift.name": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.BreakMinutes(); ok {
+		if err := shift.BreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "break_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.break_minutes": %w`, err)}
+		}
+	}
+	if su.mutation.OrganizationCleared() && len(su.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Shift.organization"`)
+	}
+	return nil
+}
+
+func (su *ShiftUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shift.Table, shift.Columns, sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Code(); ok {
+		_spec.SetField(shift.FieldCode, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(shift.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.StartTime(); ok {
+		_spec.SetField(shift.FieldStartTime, field.TypeString, value)
+	}
+	if value, ok := su.mutation.EndTime(); ok {
+		_spec.SetField(shift.FieldEndTime, field.TypeString, value)
+	}
+	if value, ok := su.mutation.BreakMinutes(); ok {
+		_spec.SetField(shift.FieldBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedBreakMinutes(); ok {
+		_spec.AddField(shift.FieldBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.BreakPaid(); ok {
+		_spec.SetField(shift.FieldBreakPaid, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.IsNight(); ok {
+		_spec.SetField(shift.FieldIsNight, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(shift.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(shift.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shift.OrganizationTable,
+			Columns: []string{shift.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shift.OrganizationTable,
+			Columns: []string{shift.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.RostersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.RostersTable,
+			Columns: []string{shift.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedRostersIDs(); len(nodes) > 0 && !su.mutation.RostersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.RostersTable,
+			Columns: []string{shift.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RostersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.RostersTable,
+			Columns: []string{shift.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ShiftUpdateOne is the builder for updating a single Shift entity.
+type ShiftUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShiftMutation
+}
+
+// SetCode sets the "code" field.
+func (suo *ShiftUpdateOne) SetCode(s string) *ShiftUpdateOne {
+	suo.mutation.SetCode(s)
+	return suo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableCode(s *string) *ShiftUpdateOne {
+	if s != nil {
+		suo.SetCode(*s)
+	}
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *ShiftUpdateOne) SetName(s string) *ShiftUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableName(s *string) *ShiftUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetStartTime sets the "start_time" field.
+func (suo *ShiftUpdateOne) SetStartTime(s string) *ShiftUpdateOne {
+	suo.mutation.SetStartTime(s)
+	return suo
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableStartTime(s *string) *ShiftUpdateOne {
+	if s != nil {
+		suo.SetStartTime(*s)
+	}
+	return suo
+}
+
+// SetEndTime sets the "end_time" field.
+func (suo *ShiftUpdateOne) SetEndTime(s string) *ShiftUpdateOne {
+	suo.mutation.SetEndTime(s)
+	return suo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableEndTime(s *string) *ShiftUpdateOne {
+	if s != nil {
+		suo.SetEndTime(*s)
+	}
+	return suo
+}
+
+// SetBreakMinutes sets the "break_minutes" field.
+func (suo *ShiftUpdateOne) SetBreakMinutes(i int) *ShiftUpdateOne {
+	suo.mutation.ResetBreakMinutes()
+	suo.mutation.SetBreakMinutes(i)
+	return suo
+}
+
+// SetNillableBreakMinutes sets the "break_minutes" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableBreakMinutes(i *int) *ShiftUpdateOne {
+	if i != nil {
+		suo.SetBreakMinutes(*i)
+	}
+	return suo
+}
+
+// AddBreakMinutes adds i to the "break_minutes" field.
+func (suo *ShiftUpdateOne) AddBreakMinutes(i int) *ShiftUpdateOne {
+	suo.mutation.AddBreakMinutes(i)
+	return suo
+}
+
+// SetBreakPaid sets the "break_paid" field.
+func (suo *ShiftUpdateOne) SetBreakPaid(b bool) *ShiftUpdateOne {
+	suo.mutation.SetBreakPaid(b)
+	return suo
+}
+
+// SetNillableBreakPaid sets the "break_paid" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableBreakPaid(b *bool) *ShiftUpdateOne {
+	if b != nil {
+		suo.SetBreakPaid(*b)
+	}
+	return suo
+}
+
+// SetIsNight sets the "is_night" field.
+func (suo *ShiftUpdateOne) SetIsNight(b bool) *ShiftUpdateOne {
+	suo.mutation.SetIsNight(b)
+	return suo
+}
+
+// SetNillableIsNight sets the "is_night" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableIsNight(b *bool) *ShiftUpdateOne {
+	if b != nil {
+		suo.SetIsNight(*b)
+	}
+	return suo
+}
+
+// SetOrgID sets the "org_id" field.
+func (suo *ShiftUpdateOne) SetOrgID(i int) *ShiftUpdateOne {
+	suo.mutation.SetOrgID(i)
+	return suo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableOrgID(i *int) *ShiftUpdateOne {
+	if i != nil {
+		suo.SetOrgID(*i)
+	}
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *ShiftUpdateOne) SetCreatedAt(t time.Time) *ShiftUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *ShiftUpdateOne) SetNillableCreatedAt(t *time.Time) *ShiftUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ShiftUpdateOne) SetUpdatedAt(t time.Time) *ShiftUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (suo *ShiftUpdateOne) SetOrganizationID(id int) *ShiftUpdateOne {
+	suo.mutation.SetOrganizationID(id)
+	return suo
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (suo *ShiftUpdateOne) SetOrganization(o *Organization) *ShiftUpdateOne {
+	return suo.SetOrganizationID(o.ID)
+}
+
+// AddRosterIDs adds the "rosters" edge to the Roster entity by IDs.
+func (suo *ShiftUpdateOne) AddRosterIDs(ids ...int) *ShiftUpdateOne {
+	suo.mutation.AddRosterIDs(ids...)
+	return suo
+}
+
+// AddRosters adds the "rosters" edges to the Roster entity.
+func (suo *ShiftUpdateOne) AddRosters(r ...*Roster) *ShiftUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return suo.AddRosterIDs(ids...)
+}
+
+// Mutation returns the ShiftMutation object of the builder.
+func (suo *ShiftUpdateOne) Mutation() *ShiftMutation {
+	return suo.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (suo *ShiftUpdateOne) ClearOrganization() *ShiftUpdateOne {
+	suo.mutation.ClearOrganization()
+	return suo
+}
+
+// ClearRosters clears all "rosters" edges to the Roster entity.
+func (suo *ShiftUpdateOne) ClearRosters() *ShiftUpdateOne {
+	suo.mutation.ClearRosters()
+	return suo
+}
+
+// RemoveRosterIDs removes the "rosters" edge to Roster entities by IDs.
+func (suo *ShiftUpdateOne) RemoveRosterIDs(ids ...int) *ShiftUpdateOne {
+	suo.mutation.RemoveRosterIDs(ids...)
+	return suo
+}
+
+// RemoveRosters removes "rosters" edges to Roster entities.
+func (suo *ShiftUpdateOne) RemoveRosters(r ...*Roster) *ShiftUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return suo.RemoveRosterIDs(ids...)
+}
+
+// Where appends a list predicates to the ShiftUpdate builder.
+func (suo *ShiftUpdateOne) Where(ps ...predicate.Shift) *ShiftUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ShiftUpdateOne) Select(field string, fields ...string) *ShiftUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Shift entity.
+func (suo *ShiftUpdateOne) Save(ctx context.Context) (*Shift, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ShiftUpdateOne) SaveX(ctx context.Context) *Shift {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ShiftUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ShiftUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ShiftUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := shift.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ShiftUpdateOne) check() error {
+	if v, ok := suo.mutation.Code(); ok {
+		if err := shift.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Shift.code": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Name(); ok {
+		if err := shift.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Shift.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.BreakMinutes(); ok {
+		if err := shift.BreakMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "break_minutes", err: fmt.Errorf(`ent: validator failed for field "Shift.break_minutes": %w`, err)}
+		}
+	}
+	if suo.mutation.OrganizationCleared() && len(suo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Shift.organization"`)
+	}
+	return nil
+}
+
+func (suo *ShiftUpdateOne) sqlSave(ctx context.Context) (_node *Shift, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shift.Table, shift.Columns, sqlgraph.NewFieldSpec(shift.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Shift.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shift.FieldID)
+		for _, f := range fields {
+			if !shift.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shift.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Code(); ok {
+		_spec.SetField(shift.FieldCode, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(shift.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.StartTime(); ok {
+		_spec.SetField(shift.FieldStartTime, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.EndTime(); ok {
+		_spec.SetField(shift.FieldEndTime, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.BreakMinutes(); ok {
+		_spec.SetField(shift.FieldBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedBreakMinutes(); ok {
+		_spec.AddField(shift.FieldBreakMinutes, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.BreakPaid(); ok {
+		_spec.SetField(shift.FieldBreakPaid, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.IsNight(); ok {
+		_spec.SetField(shift.FieldIsNight, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(shift.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(shift.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shift.OrganizationTable,
+			Columns: []string{shift.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shift.OrganizationTable,
+			Columns: []string{shift.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.RostersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.RostersTable,
+			Columns: []string{shift.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedRostersIDs(); len(nodes) > 0 && !suo.mutation.RostersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.RostersTable,
+			Columns: []string{shift.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RostersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shift.RostersTable,
+			Columns: []string{shift.RostersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Shift{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+)
+
+// ShiftSwapRequest is the model entity for the ShiftSwapRequest schema.
+type ShiftSwapRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// RequesterID holds the value of the "requester_id" field.
+	RequesterID int `json:"requester_id"`
+	// RosterID holds the value of the "roster_id" field.
+	RosterID int `json:"roster_id"`
+	// TargetRosterID holds the value of the "target_roster_id" field.
+	TargetRosterID int `json:"target_roster_id"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason"`
+	// Status holds the value of the "status" field.
+	Status shiftswaprequest.Status `json:"status"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *int `json:"reviewer_id"`
+	// ReviewComment holds the value of the "review_comment" field.
+	ReviewComment *string `json:"review_comment"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShiftSwapRequestQuery when eager-loading is set.
+	Edges        ShiftSwapRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShiftSwapRequestEdges holds the relations/edges for other nodes in the graph.
+type ShiftSwapRequestEdges struct {
+	// Roster holds the value of the roster edge.
+	Roster *Roster `json:"roster"`
+	// TargetRoster holds the value of the target_roster edge.
+	TargetRoster *Roster `json:"target_roster"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RosterOrErr returns the Roster value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShiftSwapRequestEdges) RosterOrErr() (*Roster, error) {
+	if e.Roster != nil {
+		return e.Roster, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: roster.Label}
+	}
+	return nil, &NotLoadedError{edge: "roster"}
+}
+
+// TargetRosterOrErr returns the TargetRoster value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShiftSwapRequestEdges) TargetRosterOrErr() (*Roster, error) {
+	if e.TargetRoster != nil {
+		return e.TargetRoster, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: roster.Label}
+	}
+	return nil, &NotLoadedError{edge: "target_roster"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShiftSwapRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shiftswaprequest.FieldID, shiftswaprequest.FieldOrgID, shiftswaprequest.FieldRequesterID, shiftswaprequest.FieldRosterID, shiftswaprequest.FieldTargetRosterID, shiftswaprequest.FieldReviewerID:
+			values[i] = new(sql.NullInt64)
+		case shiftswaprequest.FieldReason, shiftswaprequest.FieldStatus, shiftswaprequest.FieldReviewComment:
+			values[i] = new(sql.NullString)
+		case shiftswaprequest.FieldReviewedAt, shiftswaprequest.FieldCreatedAt, shiftswaprequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShiftSwapRequest fields.
+func (ssr *ShiftSwapRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shiftswaprequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ssr.ID = int(value.Int64)
+		case shiftswaprequest.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				ssr.OrgID = int(value.Int64)
+			}
+		case shiftswaprequest.FieldRequesterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requester_id", values[i])
+			} else if value.Valid {
+				ssr.RequesterID = int(value.Int64)
+			}
+		case shiftswaprequest.FieldRosterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field roster_id", values[i])
+			} else if value.Valid {
+				ssr.RosterID = int(value.Int64)
+			}
+		case shiftswaprequest.FieldTargetRosterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_roster_id", values[i])
+			} else if value.Valid {
+				ssr.TargetRosterID = int(value.Int64)
+			}
+		case shiftswaprequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ssr.Reason = new(string)
+				*ssr.Reason = value.String
+			}
+		case shiftswaprequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ssr.Status = shiftswaprequest.Status(value.String)
+			}
+		case shiftswaprequest.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				ssr.ReviewerID = new(int)
+				*ssr.ReviewerID = int(value.Int64)
+			}
+		case shiftswaprequest.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				ssr.ReviewComment = new(string)
+				*ssr.ReviewComment = value.String
+			}
+		case shiftswaprequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				ssr.ReviewedAt = new(time.Time)
+				*ssr.ReviewedAt = value.Time
+			}
+		case shiftswaprequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ssr.CreatedAt = value.Time
+			}
+		case shiftswaprequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ssr.UpdatedAt = value.Time
+			}
+		default:
+			ssr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShiftSwapRequest.
+// This includes values selected through modifiers, order, etc.
+func (ssr *ShiftSwapRequest) Value(name string) (ent.Value, error) {
+	return ssr.selectValues.Get(name)
+}
+
+// QueryRoster queries the "roster" edge of the ShiftSwapRequest entity.
+func (ssr *ShiftSwapRequest) QueryRoster() *RosterQuery {
+	return NewShiftSwapRequestClient(ssr.config).QueryRoster(ssr)
+}
+
+// QueryTargetRoster queries the "target_roster" edge of the ShiftSwapRequest entity.
+func (ssr *ShiftSwapRequest) QueryTargetRoster() *RosterQuery {
+	return NewShiftSwapRequestClient(ssr.config).QueryTargetRoster(ssr)
+}
+
+// Update returns a builder for updating this ShiftSwapRequest.
+// Note that you need to call ShiftSwapRequest.Unwrap() before calling this method if this ShiftSwapRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ssr *ShiftSwapRequest) Update() *ShiftSwapRequestUpdateOne {
+	return NewShiftSwapRequestClient(ssr.config).UpdateOne(ssr)
+}
+
+// Unwrap unwraps the ShiftSwapRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ssr *ShiftSwapRequest) Unwrap() *ShiftSwapRequest {
+	_tx, ok := ssr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShiftSwapRequest is not a transactional entity")
+	}
+	ssr.config.driver = _tx.drv
+	return ssr
+}
+
+// String implements the fmt.Stringer.
+func (ssr *ShiftSwapRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShiftSwapRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ssr.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", ssr.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("requester_id=")
+	builder.WriteString(fmt.Sprintf("%v", ssr.RequesterID))
+	builder.WriteString(", ")
+	builder.WriteString("roster_id=")
+	builder.WriteString(fmt.Sprintf("%v", ssr.RosterID))
+	builder.WriteString(", ")
+	builder.WriteString("target_roster_id=")
+	builder.WriteString(fmt.Sprintf("%v", ssr.TargetRosterID))
+	builder.WriteString(", ")
+	if v := ssr.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ssr.Status))
+	builder.WriteString(", ")
+	if v := ssr.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ssr.ReviewComment; v != nil {
+		builder.WriteString("review_comment=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ssr.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ssr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ssr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShiftSwapRequests is a parsable slice of ShiftSwapRequest.
+type ShiftSwapRequests []*ShiftSwapRequest
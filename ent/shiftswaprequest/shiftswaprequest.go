@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shiftswaprequest
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the shiftswaprequest type in the database.
+	Label = "shift_swap_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldRequesterID holds the string denoting the requester_id field in the database.
+	FieldRequesterID = "requester_id"
+	// FieldRosterID holds the string denoting the roster_id field in the database.
+	FieldRosterID = "roster_id"
+	// FieldTargetRosterID holds the string denoting the target_roster_id field in the database.
+	FieldTargetRosterID = "target_roster_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewerID holds the string denoting the reviewer_id field in the database.
+	FieldReviewerID = "reviewer_id"
+	// FieldReviewComment holds the string denoting the review_comment field in the database.
+	FieldReviewComment = "review_comment"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeRoster holds the string denoting the roster edge name in mutations.
+	EdgeRoster = "roster"
+	// EdgeTargetRoster holds the string denoting the target_roster edge name in mutations.
+	EdgeTargetRoster = "target_roster"
+	// Table holds the table name of the shiftswaprequest in the database.
+	Table = "shift_swap_requests"
+	// RosterTable is the table that holds the roster relation/edge.
+	RosterTable = "shift_swap_requests"
+	// RosterInverseTable is the table name for the Roster entity.
+	// It exists in this package in order to avoid circular dependency with the "roster" package.
+	RosterInverseTable = "rosters"
+	// RosterColumn is the table column denoting the roster relation/edge.
+	RosterColumn = "roster_id"
+	// TargetRosterTable is the table that holds the target_roster relation/edge.
+	TargetRosterTable = "shift_swap_requests"
+	// TargetRosterInverseTable is the table name for the Roster entity.
+	// It exists in this package in order to avoid circular dependency with the "roster" package.
+	TargetRosterInverseTable = "rosters"
+	// TargetRosterColumn is the table column denoting the target_roster relation/edge.
+	TargetRosterColumn = "target_roster_id"
+)
+
+// Columns holds all SQL columns for shiftswaprequest fields.
+var Columns = []string{
+	FieldID,
+	FieldOrgID,
+	FieldRequesterID,
+	FieldRosterID,
+	FieldTargetRosterID,
+	FieldReason,
+	FieldStatus,
+	FieldReviewerID,
+	FieldReviewComment,
+	FieldReviewedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("shiftswaprequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ShiftSwapRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// ByRequesterID orders the results by the requester_id field.
+func ByRequesterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequesterID, opts...).ToFunc()
+}
+
+// ByRosterID orders the results by the roster_id field.
+func ByRosterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRosterID, opts...).ToFunc()
+}
+
+// ByTargetRosterID orders the results by the target_roster_id field.
+func ByTargetRosterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetRosterID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewerID orders the results by the reviewer_id field.
+func ByReviewerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewerID, opts...).ToFunc()
+}
+
+// ByReviewComment orders the results by the review_comment field.
+func ByReviewComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewComment, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByRosterField orders the results by roster field.
+func ByRosterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRosterStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTargetRosterField orders the results by target_roster field.
+func ByTargetRosterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTargetRosterStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRosterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RosterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RosterTable, RosterColumn),
+	)
+}
+func newTargetRosterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TargetRosterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TargetRosterTable, TargetRosterColumn),
+	)
+}
@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shiftswaprequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldID, id))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldOrgID, v))
+}
+
+// RequesterID applies equality check predicate on the "requester_id" field. It's identical to RequesterIDEQ.
+func RequesterID(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// RosterID applies equality check predicate on the "roster_id" field. It's identical to RosterIDEQ.
+func RosterID(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldRosterID, v))
+}
+
+// TargetRosterID applies equality check predicate on the "target_roster_id" field. It's identical to TargetRosterIDEQ.
+func TargetRosterID(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldTargetRosterID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldOrgID, v))
+}
+
+// RequesterIDEQ applies the EQ predicate on the "requester_id" field.
+func RequesterIDEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// RequesterIDNEQ applies the NEQ predicate on the "requester_id" field.
+func RequesterIDNEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldRequesterID, v))
+}
+
+// RequesterIDIn applies the In predicate on the "requester_id" field.
+func RequesterIDIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDNotIn applies the NotIn predicate on the "requester_id" field.
+func RequesterIDNotIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDGT applies the GT predicate on the "requester_id" field.
+func RequesterIDGT(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldRequesterID, v))
+}
+
+// RequesterIDGTE applies the GTE predicate on the "requester_id" field.
+func RequesterIDGTE(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldRequesterID, v))
+}
+
+// RequesterIDLT applies the LT predicate on the "requester_id" field.
+func RequesterIDLT(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldRequesterID, v))
+}
+
+// RequesterIDLTE applies the LTE predicate on the "requester_id" field.
+func RequesterIDLTE(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldRequesterID, v))
+}
+
+// RosterIDEQ applies the EQ predicate on the "roster_id" field.
+func RosterIDEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldRosterID, v))
+}
+
+// RosterIDNEQ applies the NEQ predicate on the "roster_id" field.
+func RosterIDNEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldRosterID, v))
+}
+
+// RosterIDIn applies the In predicate on the "roster_id" field.
+func RosterIDIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldRosterID, vs...))
+}
+
+// RosterIDNotIn applies the NotIn predicate on the "roster_id" field.
+func RosterIDNotIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldRosterID, vs...))
+}
+
+// TargetRosterIDEQ applies the EQ predicate on the "target_roster_id" field.
+func TargetRosterIDEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldTargetRosterID, v))
+}
+
+// TargetRosterIDNEQ applies the NEQ predicate on the "target_roster_id" field.
+func TargetRosterIDNEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldTargetRosterID, v))
+}
+
+// TargetRosterIDIn applies the In predicate on the "target_roster_id" field.
+func TargetRosterIDIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldTargetRosterID, vs...))
+}
+
+// TargetRosterIDNotIn applies the NotIn predicate on the "target_roster_id" field.
+func TargetRosterIDNotIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldTargetRosterID, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v int) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentIsNil applies the IsNil predicate on the "review_comment" field.
+func ReviewCommentIsNil() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIsNull(FieldReviewComment))
+}
+
+// ReviewCommentNotNil applies the NotNil predicate on the "review_comment" field.
+func ReviewCommentNotNil() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotNull(FieldReviewComment))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasRoster applies the HasEdge predicate on the "roster" edge.
+func HasRoster() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RosterTable, RosterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRosterWith applies the HasEdge predicate on the "roster" edge with a given conditions (other predicates).
+func HasRosterWith(preds ...predicate.Roster) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(func(s *sql.Selector) {
+		step := newRosterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTargetRoster applies the HasEdge predicate on the "target_roster" edge.
+func HasTargetRoster() predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TargetRosterTable, TargetRosterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTargetRosterWith applies the HasEdge predicate on the "target_roster" edge with a given conditions (other predicates).
+func HasTargetRosterWith(preds ...predicate.Roster) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(func(s *sql.Selector) {
+		step := newTargetRosterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShiftSwapRequest) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShiftSwapRequest) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShiftSwapRequest) predicate.ShiftSwapRequest {
+	return predicate.ShiftSwapRequest(sql.NotPredicates(p))
+}
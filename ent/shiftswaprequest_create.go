@@ -0,0 +1,1235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+)
+
+// ShiftSwapRequestCreate is the builder for creating a ShiftSwapRequest entity.
+type ShiftSwapRequestCreate struct {
+	config
+	mutation *ShiftSwapRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (ssrc *ShiftSwapRequestCreate) SetOrgID(i int) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetOrgID(i)
+	return ssrc
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (ssrc *ShiftSwapRequestCreate) SetRequesterID(i int) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetRequesterID(i)
+	return ssrc
+}
+
+// SetRosterID sets the "roster_id" field.
+func (ssrc *ShiftSwapRequestCreate) SetRosterID(i int) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetRosterID(i)
+	return ssrc
+}
+
+// SetTargetRosterID sets the "target_roster_id" field.
+func (ssrc *ShiftSwapRequestCreate) SetTargetRosterID(i int) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetTargetRosterID(i)
+	return ssrc
+}
+
+// SetReason sets the "reason" field.
+func (ssrc *ShiftSwapRequestCreate) SetReason(s string) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetReason(s)
+	return ssrc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ssrc *ShiftSwapRequestCreate) SetNillableReason(s *string) *ShiftSwapRequestCreate {
+	if s != nil {
+		ssrc.SetReason(*s)
+	}
+	return ssrc
+}
+
+// SetStatus sets the "status" field.
+func (ssrc *ShiftSwapRequestCreate) SetStatus(s shiftswaprequest.Status) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetStatus(s)
+	return ssrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ssrc *ShiftSwapRequestCreate) SetNillableStatus(s *shiftswaprequest.Status) *ShiftSwapRequestCreate {
+	if s != nil {
+		ssrc.SetStatus(*s)
+	}
+	return ssrc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (ssrc *ShiftSwapRequestCreate) SetReviewerID(i int) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetReviewerID(i)
+	return ssrc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (ssrc *ShiftSwapRequestCreate) SetNillableReviewerID(i *int) *ShiftSwapRequestCreate {
+	if i != nil {
+		ssrc.SetReviewerID(*i)
+	}
+	return ssrc
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (ssrc *ShiftSwapRequestCreate) SetReviewComment(s string) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetReviewComment(s)
+	return ssrc
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (ssrc *ShiftSwapRequestCreate) SetNillableReviewComment(s *string) *ShiftSwapRequestCreate {
+	if s != nil {
+		ssrc.SetReviewComment(*s)
+	}
+	return ssrc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ssrc *ShiftSwapRequestCreate) SetReviewedAt(t time.Time) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetReviewedAt(t)
+	return ssrc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ssrc *ShiftSwapRequestCreate) SetNillableReviewedAt(t *time.Time) *ShiftSwapRequestCreate {
+	if t != nil {
+		ssrc.SetReviewedAt(*t)
+	}
+	return ssrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssrc *ShiftSwapRequestCreate) SetCreatedAt(t time.Time) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetCreatedAt(t)
+	return ssrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssrc *ShiftSwapRequestCreate) SetNillableCreatedAt(t *time.Time) *ShiftSwapRequestCreate {
+	if t != nil {
+		ssrc.SetCreatedAt(*t)
+	}
+	return ssrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssrc *ShiftSwapRequestCreate) SetUpdatedAt(t time.Time) *ShiftSwapRequestCreate {
+	ssrc.mutation.SetUpdatedAt(t)
+	return ssrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ssrc *ShiftSwapRequestCreate) SetNillableUpdatedAt(t *time.Time) *ShiftSwapRequestCreate {
+	if t != nil {
+		ssrc.SetUpdatedAt(*t)
+	}
+	return ssrc
+}
+
+// SetRoster sets the "roster" edge to the Roster entity.
+func (ssrc *ShiftSwapRequestCreate) SetRoster(r *Roster) *ShiftSwapRequestCreate {
+	return ssrc.SetRosterID(r.ID)
+}
+
+// SetTargetRoster sets the "target_roster" edge to the Roster entity.
+func (ssrc *ShiftSwapRequestCreate) SetTargetRoster(r *Roster) *ShiftSwapRequestCreate {
+	return ssrc.SetTargetRosterID(r.ID)
+}
+
+// Mutation returns the ShiftSwapRequestMutation object of the builder.
+func (ssrc *ShiftSwapRequestCreate) Mutation() *ShiftSwapRequestMutation {
+	return ssrc.mutation
+}
+
+// Save creates the ShiftSwapRequest in the database.
+func (ssrc *ShiftSwapRequestCreate) Save(ctx context.Context) (*ShiftSwapRequest, error) {
+	ssrc.defaults()
+	return withHooks(ctx, ssrc.sqlSave, ssrc.mutation, ssrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssrc *ShiftSwapRequestCreate) SaveX(ctx context.Context) *ShiftSwapRequest {
+	v, err := ssrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssrc *ShiftSwapRequestCreate) Exec(ctx context.Context) error {
+	_, err := ssrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssrc *ShiftSwapRequestCreate) ExecX(ctx context.Context) {
+	if err := ssrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssrc *ShiftSwapRequestCreate) defaults() {
+	if _, ok := ssrc.mutation.Status(); !ok {
+		v := shiftswaprequest.DefaultStatus
+		ssrc.mutation.SetStatus(v)
+	}
+	if _, ok := ssrc.mutation.CreatedAt(); !ok {
+		v := shiftswaprequest.DefaultCreatedAt()
+		ssrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ssrc.mutation.UpdatedAt(); !ok {
+		v := shiftswaprequest.DefaultUpdatedAt()
+		ssrc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssrc *ShiftSwapRequestCreate) check() error {
+	if _, ok := ssrc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "ShiftSwapRequest.org_id"`)}
+	}
+	if _, ok := ssrc.mutation.RequesterID(); !ok {
+		return &ValidationError{Name: "requester_id", err: errors.New(`ent: missing required field "ShiftSwapRequest.requester_id"`)}
+	}
+	if _, ok := ssrc.mutation.RosterID(); !ok {
+		return &ValidationError{Name: "roster_id", err: errors.New(`ent: missing required field "ShiftSwapRequest.roster_id"`)}
+	}
+	if _, ok := ssrc.mutation.TargetRosterID(); !ok {
+		return &ValidationError{Name: "target_roster_id", err: errors.New(`ent: missing required field "ShiftSwapRequest.target_roster_id"`)}
+	}
+	if _, ok := ssrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ShiftSwapRequest.status"`)}
+	}
+	if v, ok := ssrc.mutation.Status(); ok {
+		if err := shiftswaprequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShiftSwapRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := ssrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShiftSwapRequest.created_at"`)}
+	}
+	if _, ok := ssrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ShiftSwapRequest.updated_at"`)}
+	}
+	if len(ssrc.mutation.RosterIDs()) == 0 {
+		return &ValidationError{Name: "roster", err: errors.New(`ent: missing required edge "ShiftSwapRequest.roster"`)}
+	}
+	if len(ssrc.mutation.TargetRosterIDs()) == 0 {
+		return &ValidationError{Name: "target_roster", err: errors.New(`ent: missing required edge "ShiftSwapRequest.target_roster"`)}
+	}
+	return nil
+}
+
+func (ssrc *ShiftSwapRequestCreate) sqlSave(ctx context.Context) (*ShiftSwapRequest, error) {
+	if err := ssrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ssrc.mutation.id = &_node.ID
+	ssrc.mutation.done = true
+	return _node, nil
+}
+
+func (ssrc *ShiftSwapRequestCreate) createSpec() (*ShiftSwapRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShiftSwapRequest{config: ssrc.config}
+		_spec = sqlgraph.NewCreateSpec(shiftswaprequest.Table, sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ssrc.conflict
+	if value, ok := ssrc.mutation.OrgID(); ok {
+		_spec.SetField(shiftswaprequest.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := ssrc.mutation.RequesterID(); ok {
+		_spec.SetField(shiftswaprequest.FieldRequesterID, field.TypeInt, value)
+		_node.RequesterID = value
+	}
+	if value, ok := ssrc.mutation.Reason(); ok {
+		_spec.SetField(shiftswaprequest.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := ssrc.mutation.Status(); ok {
+		_spec.SetField(shiftswaprequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ssrc.mutation.ReviewerID(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewerID, field.TypeInt, value)
+		_node.ReviewerID = &value
+	}
+	if value, ok := ssrc.mutation.ReviewComment(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = &value
+	}
+	if value, ok := ssrc.mutation.ReviewedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := ssrc.mutation.CreatedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ssrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ssrc.mutation.RosterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.RosterTable,
+			Columns: []string{shiftswaprequest.RosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RosterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ssrc.mutation.TargetRosterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.TargetRosterTable,
+			Columns: []string{shiftswaprequest.TargetRosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetRosterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShiftSwapRequest.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShiftSwapRequestUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (ssrc *ShiftSwapRequestCreate) OnConflict(opts ...sql.ConflictOption) *ShiftSwapRequestUpsertOne {
+	ssrc.conflict = opts
+	return &ShiftSwapRequestUpsertOne{
+		create: ssrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShiftSwapRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ssrc *ShiftSwapRequestCreate) OnConflictColumns(columns ...string) *ShiftSwapRequestUpsertOne {
+	ssrc.conflict = append(ssrc.conflict, sql.ConflictColumns(columns...))
+	return &ShiftSwapRequestUpsertOne{
+		create: ssrc,
+	}
+}
+
+type (
+	// ShiftSwapRequestUpsertOne is the builder for "upsert"-ing
+	//  one ShiftSwapRequest node.
+	ShiftSwapRequestUpsertOne struct {
+		create *ShiftSwapRequestCreate
+	}
+
+	// ShiftSwapRequestUpsert is the "OnConflict" setter.
+	ShiftSwapRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *ShiftSwapRequestUpsert) SetOrgID(v int) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateOrgID() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *ShiftSwapRequestUpsert) AddOrgID(v int) *ShiftSwapRequestUpsert {
+	u.Add(shiftswaprequest.FieldOrgID, v)
+	return u
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (u *ShiftSwapRequestUpsert) SetRequesterID(v int) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldRequesterID, v)
+	return u
+}
+
+// UpdateRequesterID sets the "requester_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateRequesterID() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldRequesterID)
+	return u
+}
+
+// AddRequesterID adds v to the "requester_id" field.
+func (u *ShiftSwapRequestUpsert) AddRequesterID(v int) *ShiftSwapRequestUpsert {
+	u.Add(shiftswaprequest.FieldRequesterID, v)
+	return u
+}
+
+// SetRosterID sets the "roster_id" field.
+func (u *ShiftSwapRequestUpsert) SetRosterID(v int) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldRosterID, v)
+	return u
+}
+
+// UpdateRosterID sets the "roster_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateRosterID() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldRosterID)
+	return u
+}
+
+// SetTargetRosterID sets the "target_roster_id" field.
+func (u *ShiftSwapRequestUpsert) SetTargetRosterID(v int) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldTargetRosterID, v)
+	return u
+}
+
+// UpdateTargetRosterID sets the "target_roster_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateTargetRosterID() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldTargetRosterID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ShiftSwapRequestUpsert) SetReason(v string) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateReason() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ShiftSwapRequestUpsert) ClearReason() *ShiftSwapRequestUpsert {
+	u.SetNull(shiftswaprequest.FieldReason)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ShiftSwapRequestUpsert) SetStatus(v shiftswaprequest.Status) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateStatus() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldStatus)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsert) SetReviewerID(v int) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateReviewerID() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldReviewerID)
+	return u
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsert) AddReviewerID(v int) *ShiftSwapRequestUpsert {
+	u.Add(shiftswaprequest.FieldReviewerID, v)
+	return u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsert) ClearReviewerID() *ShiftSwapRequestUpsert {
+	u.SetNull(shiftswaprequest.FieldReviewerID)
+	return u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *ShiftSwapRequestUpsert) SetReviewComment(v string) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldReviewComment, v)
+	return u
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateReviewComment() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldReviewComment)
+	return u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *ShiftSwapRequestUpsert) ClearReviewComment() *ShiftSwapRequestUpsert {
+	u.SetNull(shiftswaprequest.FieldReviewComment)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ShiftSwapRequestUpsert) SetReviewedAt(v time.Time) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateReviewedAt() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ShiftSwapRequestUpsert) ClearReviewedAt() *ShiftSwapRequestUpsert {
+	u.SetNull(shiftswaprequest.FieldReviewedAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShiftSwapRequestUpsert) SetCreatedAt(v time.Time) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateCreatedAt() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShiftSwapRequestUpsert) SetUpdatedAt(v time.Time) *ShiftSwapRequestUpsert {
+	u.Set(shiftswaprequest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsert) UpdateUpdatedAt() *ShiftSwapRequestUpsert {
+	u.SetExcluded(shiftswaprequest.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ShiftSwapRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ShiftSwapRequestUpsertOne) UpdateNewValues() *ShiftSwapRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShiftSwapRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShiftSwapRequestUpsertOne) Ignore() *ShiftSwapRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShiftSwapRequestUpsertOne) DoNothing() *ShiftSwapRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShiftSwapRequestCreate.OnConflict
+// documentation for more info.
+func (u *ShiftSwapRequestUpsertOne) Update(set func(*ShiftSwapRequestUpsert)) *ShiftSwapRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShiftSwapRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *ShiftSwapRequestUpsertOne) SetOrgID(v int) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *ShiftSwapRequestUpsertOne) AddOrgID(v int) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateOrgID() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (u *ShiftSwapRequestUpsertOne) SetRequesterID(v int) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetRequesterID(v)
+	})
+}
+
+// AddRequesterID adds v to the "requester_id" field.
+func (u *ShiftSwapRequestUpsertOne) AddRequesterID(v int) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.AddRequesterID(v)
+	})
+}
+
+// UpdateRequesterID sets the "requester_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateRequesterID() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateRequesterID()
+	})
+}
+
+// SetRosterID sets the "roster_id" field.
+func (u *ShiftSwapRequestUpsertOne) SetRosterID(v int) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetRosterID(v)
+	})
+}
+
+// UpdateRosterID sets the "roster_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateRosterID() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateRosterID()
+	})
+}
+
+// SetTargetRosterID sets the "target_roster_id" field.
+func (u *ShiftSwapRequestUpsertOne) SetTargetRosterID(v int) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetTargetRosterID(v)
+	})
+}
+
+// UpdateTargetRosterID sets the "target_roster_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateTargetRosterID() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateTargetRosterID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ShiftSwapRequestUpsertOne) SetReason(v string) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateReason() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ShiftSwapRequestUpsertOne) ClearReason() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ShiftSwapRequestUpsertOne) SetStatus(v shiftswaprequest.Status) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateStatus() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsertOne) SetReviewerID(v int) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsertOne) AddReviewerID(v int) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateReviewerID() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsertOne) ClearReviewerID() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *ShiftSwapRequestUpsertOne) SetReviewComment(v string) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateReviewComment() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *ShiftSwapRequestUpsertOne) ClearReviewComment() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ShiftSwapRequestUpsertOne) SetReviewedAt(v time.Time) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateReviewedAt() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ShiftSwapRequestUpsertOne) ClearReviewedAt() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShiftSwapRequestUpsertOne) SetCreatedAt(v time.Time) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateCreatedAt() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShiftSwapRequestUpsertOne) SetUpdatedAt(v time.Time) *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertOne) UpdateUpdatedAt() *ShiftSwapRequestUpsertOne {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShiftSwapRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShiftSwapRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShiftSwapRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShiftSwapRequestUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShiftSwapRequestUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShiftSwapRequestCreateBulk is the builder for creating many ShiftSwapRequest entities in bulk.
+type ShiftSwapRequestCreateBulk struct {
+	config
+	err      error
+	builders []*ShiftSwapRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ShiftSwapRequest entities in the database.
+func (ssrcb *ShiftSwapRequestCreateBulk) Save(ctx context.Context) ([]*ShiftSwapRequest, error) {
+	if ssrcb.err != nil {
+		return nil, ssrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ssrcb.builders))
+	nodes := make([]*ShiftSwapRequest, len(ssrcb.builders))
+	mutators := make([]Mutator, len(ssrcb.builders))
+	for i := range ssrcb.builders {
+		func(i int, root context.Context) {
+			builder := ssrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShiftSwapRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ssrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ssrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ssrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ssrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssrcb *ShiftSwapRequestCreateBulk) SaveX(ctx context.Context) []*ShiftSwapRequest {
+	v, err := ssrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssrcb *ShiftSwapRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := ssrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssrcb *ShiftSwapRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := ssrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShiftSwapRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShiftSwapRequestUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (ssrcb *ShiftSwapRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShiftSwapRequestUpsertBulk {
+	ssrcb.conflict = opts
+	return &ShiftSwapRequestUpsertBulk{
+		create: ssrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShiftSwapRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ssrcb *ShiftSwapRequestCreateBulk) OnConflictColumns(columns ...string) *ShiftSwapRequestUpsertBulk {
+	ssrcb.conflict = append(ssrcb.conflict, sql.ConflictColumns(columns...))
+	return &ShiftSwapRequestUpsertBulk{
+		create: ssrcb,
+	}
+}
+
+// ShiftSwapRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of ShiftSwapRequest nodes.
+type ShiftSwapRequestUpsertBulk struct {
+	create *ShiftSwapRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ShiftSwapRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ShiftSwapRequestUpsertBulk) UpdateNewValues() *ShiftSwapRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShiftSwapRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShiftSwapRequestUpsertBulk) Ignore() *ShiftSwapRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShiftSwapRequestUpsertBulk) DoNothing() *ShiftSwapRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShiftSwapRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShiftSwapRequestUpsertBulk) Update(set func(*ShiftSwapRequestUpsert)) *ShiftSwapRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShiftSwapRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *ShiftSwapRequestUpsertBulk) SetOrgID(v int) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *ShiftSwapRequestUpsertBulk) AddOrgID(v int) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateOrgID() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (u *ShiftSwapRequestUpsertBulk) SetRequesterID(v int) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetRequesterID(v)
+	})
+}
+
+// AddRequesterID adds v to the "requester_id" field.
+func (u *ShiftSwapRequestUpsertBulk) AddRequesterID(v int) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.AddRequesterID(v)
+	})
+}
+
+// UpdateRequesterID sets the "requester_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateRequesterID() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateRequesterID()
+	})
+}
+
+// SetRosterID sets the "roster_id" field.
+func (u *ShiftSwapRequestUpsertBulk) SetRosterID(v int) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetRosterID(v)
+	})
+}
+
+// UpdateRosterID sets the "roster_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateRosterID() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateRosterID()
+	})
+}
+
+// SetTargetRosterID sets the "target_roster_id" field.
+func (u *ShiftSwapRequestUpsertBulk) SetTargetRosterID(v int) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetTargetRosterID(v)
+	})
+}
+
+// UpdateTargetRosterID sets the "target_roster_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateTargetRosterID() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateTargetRosterID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ShiftSwapRequestUpsertBulk) SetReason(v string) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateReason() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *ShiftSwapRequestUpsertBulk) ClearReason() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ShiftSwapRequestUpsertBulk) SetStatus(v shiftswaprequest.Status) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateStatus() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsertBulk) SetReviewerID(v int) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsertBulk) AddReviewerID(v int) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateReviewerID() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *ShiftSwapRequestUpsertBulk) ClearReviewerID() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *ShiftSwapRequestUpsertBulk) SetReviewComment(v string) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateReviewComment() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *ShiftSwapRequestUpsertBulk) ClearReviewComment() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ShiftSwapRequestUpsertBulk) SetReviewedAt(v time.Time) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateReviewedAt() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ShiftSwapRequestUpsertBulk) ClearReviewedAt() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShiftSwapRequestUpsertBulk) SetCreatedAt(v time.Time) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateCreatedAt() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShiftSwapRequestUpsertBulk) SetUpdatedAt(v time.Time) *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShiftSwapRequestUpsertBulk) UpdateUpdatedAt() *ShiftSwapRequestUpsertBulk {
+	return u.Update(func(s *ShiftSwapRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShiftSwapRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShiftSwapRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShiftSwapRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShiftSwapRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
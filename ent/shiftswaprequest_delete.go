@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+)
+
+// ShiftSwapRequestDelete is the builder for deleting a ShiftSwapRequest entity.
+type ShiftSwapRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShiftSwapRequestMutation
+}
+
+// Where appends a list predicates to the ShiftSwapRequestDelete builder.
+func (ssrd *ShiftSwapRequestDelete) Where(ps ...predicate.ShiftSwapRequest) *ShiftSwapRequestDelete {
+	ssrd.mutation.Where(ps...)
+	return ssrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ssrd *ShiftSwapRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssrd.sqlExec, ssrd.mutation, ssrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssrd *ShiftSwapRequestDelete) ExecX(ctx context.Context) int {
+	n, err := ssrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ssrd *ShiftSwapRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(shiftswaprequest.Table, sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt))
+	if ps := ssrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ssrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ssrd.mutation.done = true
+	return affected, err
+}
+
+// ShiftSwapRequestDeleteOne is the builder for deleting a single ShiftSwapRequest entity.
+type ShiftSwapRequestDeleteOne struct {
+	ssrd *ShiftSwapRequestDelete
+}
+
+// Where appends a list predicates to the ShiftSwapRequestDelete builder.
+func (ssrdo *ShiftSwapRequestDeleteOne) Where(ps ...predicate.ShiftSwapRequest) *ShiftSwapRequestDeleteOne {
+	ssrdo.ssrd.mutation.Where(ps...)
+	return ssrdo
+}
+
+// Exec executes the deletion query.
+func (ssrdo *ShiftSwapRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := ssrdo.ssrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shiftswaprequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssrdo *ShiftSwapRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := ssrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+)
+
+// ShiftSwapRequestQuery is the builder for querying ShiftSwapRequest entities.
+type ShiftSwapRequestQuery struct {
+	config
+	ctx              *QueryContext
+	order            []shiftswaprequest.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.ShiftSwapRequest
+	withRoster       *RosterQuery
+	withTargetRoster *RosterQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShiftSwapRequestQuery builder.
+func (ssrq *ShiftSwapRequestQuery) Where(ps ...predicate.ShiftSwapRequest) *ShiftSwapRequestQuery {
+	ssrq.predicates = append(ssrq.predicates, ps...)
+	return ssrq
+}
+
+// Limit the number of records to be returned by this query.
+func (ssrq *ShiftSwapRequestQuery) Limit(limit int) *ShiftSwapRequestQuery {
+	ssrq.ctx.Limit = &limit
+	return ssrq
+}
+
+// Offset to start from.
+func (ssrq *ShiftSwapRequestQuery) Offset(offset int) *ShiftSwapRequestQuery {
+	ssrq.ctx.Offset = &offset
+	return ssrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ssrq *ShiftSwapRequestQuery) Unique(unique bool) *ShiftSwapRequestQuery {
+	ssrq.ctx.Unique = &unique
+	return ssrq
+}
+
+// Order specifies how the records should be ordered.
+func (ssrq *ShiftSwapRequestQuery) Order(o ...shiftswaprequest.OrderOption) *ShiftSwapRequestQuery {
+	ssrq.order = append(ssrq.order, o...)
+	return ssrq
+}
+
+// QueryRoster chains the current query on the "roster" edge.
+func (ssrq *ShiftSwapRequestQuery) QueryRoster() *RosterQuery {
+	query := (&RosterClient{config: ssrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftswaprequest.Table, shiftswaprequest.FieldID, selector),
+			sqlgraph.To(roster.Table, roster.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftswaprequest.RosterTable, shiftswaprequest.RosterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTargetRoster chains the current query on the "target_roster" edge.
+func (ssrq *ShiftSwapRequestQuery) QueryTargetRoster() *RosterQuery {
+	query := (&RosterClient{config: ssrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shiftswaprequest.Table, shiftswaprequest.FieldID, selector),
+			sqlgraph.To(roster.Table, roster.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shiftswaprequest.TargetRosterTable, shiftswaprequest.TargetRosterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShiftSwapRequest entity from the query.
+// Returns a *NotFoundError when no ShiftSwapRequest was found.
+func (ssrq *ShiftSwapRequestQuery) First(ctx context.Context) (*ShiftSwapRequest, error) {
+	nodes, err := ssrq.Limit(1).All(setContextOp(ctx, ssrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{shiftswaprequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ssrq *ShiftSwapRequestQuery) FirstX(ctx context.Context) *ShiftSwapRequest {
+	node, err := ssrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShiftSwapRequest ID from the query.
+// Returns a *NotFoundError when no ShiftSwapRequest ID was found.
+func (ssrq *ShiftSwapRequestQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ssrq.Limit(1).IDs(setContextOp(ctx, ssrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{shiftswaprequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ssrq *ShiftSwapRequestQuery) FirstIDX(ctx context.Context) int {
+	id, err := ssrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShiftSwapRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShiftSwapRequest entity is found.
+// Returns a *NotFoundError when no ShiftSwapRequest entities are found.
+func (ssrq *ShiftSwapRequestQuery) Only(ctx context.Context) (*ShiftSwapRequest, error) {
+	nodes, err := ssrq.Limit(2).All(setContextOp(ctx, ssrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{shiftswaprequest.Label}
+	default:
+		return nil, &NotSingularError{shiftswaprequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ssrq *ShiftSwapRequestQuery) OnlyX(ctx context.Context) *ShiftSwapRequest {
+	node, err := ssrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShiftSwapRequest ID in the query.
+// Returns a *NotSingularError when more than one ShiftSwapRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ssrq *ShiftSwapRequestQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ssrq.Limit(2).IDs(setContextOp(ctx, ssrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{shiftswaprequest.Label}
+	default:
+		err = &NotSingularError{shiftswaprequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ssrq *ShiftSwapRequestQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ssrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShiftSwapRequests.
+func (ssrq *ShiftSwapRequestQuery) All(ctx context.Context) ([]*ShiftSwapRequest, error) {
+	ctx = setContextOp(ctx, ssrq.ctx, ent.OpQueryAll)
+	if err := ssrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShiftSwapRequest, *ShiftSwapRequestQuery]()
+	return withInterceptors[[]*ShiftSwapRequest](ctx, ssrq, qr, ssrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ssrq *ShiftSwapRequestQuery) AllX(ctx context.Context) []*ShiftSwapRequest {
+	nodes, err := ssrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShiftSwapRequest IDs.
+func (ssrq *ShiftSwapRequestQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ssrq.ctx.Unique == nil && ssrq.path != nil {
+		ssrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ssrq.ctx, ent.OpQueryIDs)
+	if err = ssrq.Select(shiftswaprequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ssrq *ShiftSwapRequestQuery) IDsX(ctx context.Context) []int {
+	ids, err := ssrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ssrq *ShiftSwapRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ssrq.ctx, ent.OpQueryCount)
+	if err := ssrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ssrq, querierCount[*ShiftSwapRequestQuery](), ssrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ssrq *ShiftSwapRequestQuery) CountX(ctx context.Context) int {
+	count, err := ssrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ssrq *ShiftSwapRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ssrq.ctx, ent.OpQueryExist)
+	switch _, err := ssrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ssrq *ShiftSwapRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := ssrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShiftSwapRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ssrq *ShiftSwapRequestQuery) Clone() *ShiftSwapRequestQuery {
+	if ssrq == nil {
+		return nil
+	}
+	return &ShiftSwapRequestQuery{
+		config:           ssrq.config,
+		ctx:              ssrq.ctx.Clone(),
+		order:            append([]shiftswaprequest.OrderOption{}, ssrq.order...),
+		inters:           append([]Interceptor{}, ssrq.inters...),
+		predicates:       append([]predicate.ShiftSwapRequest{}, ssrq.predicates...),
+		withRoster:       ssrq.withRoster.Clone(),
+		withTargetRoster: ssrq.withTargetRoster.Clone(),
+		// clone intermediate query.
+		sql:  ssrq.sql.Clone(),
+		path: ssrq.path,
+	}
+}
+
+// WithRoster tells the query-builder to eager-load the nodes that are connected to
+// the "roster" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssrq *ShiftSwapRequestQuery) WithRoster(opts ...func(*RosterQuery)) *ShiftSwapRequestQuery {
+	query := (&RosterClient{config: ssrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssrq.withRoster = query
+	return ssrq
+}
+
+// WithTargetRoster tells the query-builder to eager-load the nodes that are connected to
+// the "target_roster" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssrq *ShiftSwapRequestQuery) WithTargetRoster(opts ...func(*RosterQuery)) *ShiftSwapRequestQuery {
+	query := (&RosterClient{config: ssrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssrq.withTargetRoster = query
+	return ssrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int `json:"org_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShiftSwapRequest.Query().
+//		GroupBy(shiftswaprequest.FieldOrgID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ssrq *ShiftSwapRequestQuery) GroupBy(field string, fields ...string) *ShiftSwapRequestGroupBy {
+	ssrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShiftSwapRequestGroupBy{build: ssrq}
+	grbuild.flds = &ssrq.ctx.Fields
+	grbuild.label = shiftswaprequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int `json:"org_id"`
+//	}
+//
+//	client.ShiftSwapRequest.Query().
+//		Select(shiftswaprequest.FieldOrgID).
+//		Scan(ctx, &v)
+func (ssrq *ShiftSwapRequestQuery) Select(fields ...string) *ShiftSwapRequestSelect {
+	ssrq.ctx.Fields = append(ssrq.ctx.Fields, fields...)
+	sbuild := &ShiftSwapRequestSelect{ShiftSwapRequestQuery: ssrq}
+	sbuild.label = shiftswaprequest.Label
+	sbuild.flds, sbuild.scan = &ssrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShiftSwapRequestSelect configured with the given aggregations.
+func (ssrq *ShiftSwapRequestQuery) Aggregate(fns ...AggregateFunc) *ShiftSwapRequestSelect {
+	return ssrq.Select().Aggregate(fns...)
+}
+
+func (ssrq *ShiftSwapRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ssrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ssrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ssrq.ctx.Fields {
+		if !shiftswaprequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ssrq.path != nil {
+		prev, err := ssrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ssrq.sql = prev
+	}
+	return nil
+}
+
+func (ssrq *ShiftSwapRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShiftSwapRequest, error) {
+	var (
+		nodes       = []*ShiftSwapRequest{}
+		_spec       = ssrq.querySpec()
+		loadedTypes = [2]bool{
+			ssrq.withRoster != nil,
+			ssrq.withTargetRoster != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShiftSwapRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShiftSwapRequest{config: ssrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ssrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ssrq.withRoster; query != nil {
+		if err := ssrq.loadRoster(ctx, query, nodes, nil,
+			func(n *ShiftSwapRequest, e *Roster) { n.Edges.Roster = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ssrq.withTargetRoster; query != nil {
+		if err := ssrq.loadTargetRoster(ctx, query, nodes, nil,
+			func(n *ShiftSwapRequest, e *Roster) { n.Edges.TargetRoster = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ssrq *ShiftSwapRequestQuery) loadRoster(ctx context.Context, query *RosterQuery, nodes []*ShiftSwapRequest, init func(*ShiftSwapRequest), assign func(*ShiftSwapRequest, *Roster)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShiftSwapRequest)
+	for i := range nodes {
+		fk := nodes[i].RosterID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roster.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "roster_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ssrq *ShiftSwapRequestQuery) loadTargetRoster(ctx context.Context, query *RosterQuery, nodes []*ShiftSwapRequest, init func(*ShiftSwapRequest), assign func(*ShiftSwapRequest, *Roster)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShiftSwapRequest)
+	for i := range nodes {
+		fk := nodes[i].TargetRosterID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roster.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "target_roster_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ssrq *ShiftSwapRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ssrq.querySpec()
+	_spec.Node.Columns = ssrq.ctx.Fields
+	if len(ssrq.ctx.Fields) > 0 {
+		_spec.Unique = ssrq.ctx.Unique != nil && *ssrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ssrq.driver, _spec)
+}
+
+func (ssrq *ShiftSwapRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(shiftswaprequest.Table, shiftswaprequest.Columns, sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt))
+	_spec.From = ssrq.sql
+	if unique := ssrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ssrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ssrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shiftswaprequest.FieldID)
+		for i := range fields {
+			if fields[i] != shiftswaprequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ssrq.withRoster != nil {
+			_spec.Node.AddColumnOnce(shiftswaprequest.FieldRosterID)
+		}
+		if ssrq.withTargetRoster != nil {
+			_spec.Node.AddColumnOnce(shiftswaprequest.FieldTargetRosterID)
+		}
+	}
+	if ps := ssrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ssrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ssrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ssrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ssrq *ShiftSwapRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ssrq.driver.Dialect())
+	t1 := builder.Table(shiftswaprequest.Table)
+	columns := ssrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = shiftswaprequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ssrq.sql != nil {
+		selector = ssrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ssrq.ctx.Unique != nil && *ssrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ssrq.predicates {
+		p(selector)
+	}
+	for _, p := range ssrq.order {
+		p(selector)
+	}
+	if offset := ssrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ssrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ShiftSwapRequestGroupBy is the group-by builder for ShiftSwapRequest entities.
+type ShiftSwapRequestGroupBy struct {
+	selector
+	build *ShiftSwapRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ssrgb *ShiftSwapRequestGroupBy) Aggregate(fns ...AggregateFunc) *ShiftSwapRequestGroupBy {
+	ssrgb.fns = append(ssrgb.fns, fns...)
+	return ssrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssrgb *ShiftSwapRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ssrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShiftSwapRequestQuery, *ShiftSwapRequestGroupBy](ctx, ssrgb.build, ssrgb, ssrgb.build.inters, v)
+}
+
+func (ssrgb *ShiftSwapRequestGroupBy) sqlScan(ctx context.Context, root *ShiftSwapRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ssrgb.fns))
+	for _, fn := range ssrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ssrgb.flds)+len(ssrgb.fns))
+		for _, f := range *ssrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ssrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShiftSwapRequestSelect is the builder for selecting fields of ShiftSwapRequest entities.
+type ShiftSwapRequestSelect struct {
+	*ShiftSwapRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ssrs *ShiftSwapRequestSelect) Aggregate(fns ...AggregateFunc) *ShiftSwapRequestSelect {
+	ssrs.fns = append(ssrs.fns, fns...)
+	return ssrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssrs *ShiftSwapRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssrs.ctx, ent.OpQuerySelect)
+	if err := ssrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShiftSwapRequestQuery, *ShiftSwapRequestSelect](ctx, ssrs.ShiftSwapRequestQuery, ssrs, ssrs.inters, v)
+}
+
+func (ssrs *ShiftSwapRequestSelect) sqlScan(ctx context.Context, root *ShiftSwapRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ssrs.fns))
+	for _, fn := range ssrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ssrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,871 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+)
+
+// ShiftSwapRequestUpdate is the builder for updating ShiftSwapRequest entities.
+type ShiftSwapRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShiftSwapRequestMutation
+}
+
+// Where appends a list predicates to the ShiftSwapRequestUpdate builder.
+func (ssru *ShiftSwapRequestUpdate) Where(ps ...predicate.ShiftSwapRequest) *ShiftSwapRequestUpdate {
+	ssru.mutation.Where(ps...)
+	return ssru
+}
+
+// SetOrgID sets the "org_id" field.
+func (ssru *ShiftSwapRequestUpdate) SetOrgID(i int) *ShiftSwapRequestUpdate {
+	ssru.mutation.ResetOrgID()
+	ssru.mutation.SetOrgID(i)
+	return ssru
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableOrgID(i *int) *ShiftSwapRequestUpdate {
+	if i != nil {
+		ssru.SetOrgID(*i)
+	}
+	return ssru
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (ssru *ShiftSwapRequestUpdate) AddOrgID(i int) *ShiftSwapRequestUpdate {
+	ssru.mutation.AddOrgID(i)
+	return ssru
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (ssru *ShiftSwapRequestUpdate) SetRequesterID(i int) *ShiftSwapRequestUpdate {
+	ssru.mutation.ResetRequesterID()
+	ssru.mutation.SetRequesterID(i)
+	return ssru
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableRequesterID(i *int) *ShiftSwapRequestUpdate {
+	if i != nil {
+		ssru.SetRequesterID(*i)
+	}
+	return ssru
+}
+
+// AddRequesterID adds i to the "requester_id" field.
+func (ssru *ShiftSwapRequestUpdate) AddRequesterID(i int) *ShiftSwapRequestUpdate {
+	ssru.mutation.AddRequesterID(i)
+	return ssru
+}
+
+// SetRosterID sets the "roster_id" field.
+func (ssru *ShiftSwapRequestUpdate) SetRosterID(i int) *ShiftSwapRequestUpdate {
+	ssru.mutation.SetRosterID(i)
+	return ssru
+}
+
+// SetNillableRosterID sets the "roster_id" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableRosterID(i *int) *ShiftSwapRequestUpdate {
+	if i != nil {
+		ssru.SetRosterID(*i)
+	}
+	return ssru
+}
+
+// SetTargetRosterID sets the "target_roster_id" field.
+func (ssru *ShiftSwapRequestUpdate) SetTargetRosterID(i int) *ShiftSwapRequestUpdate {
+	ssru.mutation.SetTargetRosterID(i)
+	return ssru
+}
+
+// SetNillableTargetRosterID sets the "target_roster_id" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableTargetRosterID(i *int) *ShiftSwapRequestUpdate {
+	if i != nil {
+		ssru.SetTargetRosterID(*i)
+	}
+	return ssru
+}
+
+// SetReason sets the "reason" field.
+func (ssru *ShiftSwapRequestUpdate) SetReason(s string) *ShiftSwapRequestUpdate {
+	ssru.mutation.SetReason(s)
+	return ssru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableReason(s *string) *ShiftSwapRequestUpdate {
+	if s != nil {
+		ssru.SetReason(*s)
+	}
+	return ssru
+}
+
+// ClearReason clears the value of the "reason" field.
+func (ssru *ShiftSwapRequestUpdate) ClearReason() *ShiftSwapRequestUpdate {
+	ssru.mutation.ClearReason()
+	return ssru
+}
+
+// SetStatus sets the "status" field.
+func (ssru *ShiftSwapRequestUpdate) SetStatus(s shiftswaprequest.Status) *ShiftSwapRequestUpdate {
+	ssru.mutation.SetStatus(s)
+	return ssru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableStatus(s *shiftswaprequest.Status) *ShiftSwapRequestUpdate {
+	if s != nil {
+		ssru.SetStatus(*s)
+	}
+	return ssru
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (ssru *ShiftSwapRequestUpdate) SetReviewerID(i int) *ShiftSwapRequestUpdate {
+	ssru.mutation.ResetReviewerID()
+	ssru.mutation.SetReviewerID(i)
+	return ssru
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableReviewerID(i *int) *ShiftSwapRequestUpdate {
+	if i != nil {
+		ssru.SetReviewerID(*i)
+	}
+	return ssru
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (ssru *ShiftSwapRequestUpdate) AddReviewerID(i int) *ShiftSwapRequestUpdate {
+	ssru.mutation.AddReviewerID(i)
+	return ssru
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (ssru *ShiftSwapRequestUpdate) ClearReviewerID() *ShiftSwapRequestUpdate {
+	ssru.mutation.ClearReviewerID()
+	return ssru
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (ssru *ShiftSwapRequestUpdate) SetReviewComment(s string) *ShiftSwapRequestUpdate {
+	ssru.mutation.SetReviewComment(s)
+	return ssru
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableReviewComment(s *string) *ShiftSwapRequestUpdate {
+	if s != nil {
+		ssru.SetReviewComment(*s)
+	}
+	return ssru
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (ssru *ShiftSwapRequestUpdate) ClearReviewComment() *ShiftSwapRequestUpdate {
+	ssru.mutation.ClearReviewComment()
+	return ssru
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ssru *ShiftSwapRequestUpdate) SetReviewedAt(t time.Time) *ShiftSwapRequestUpdate {
+	ssru.mutation.SetReviewedAt(t)
+	return ssru
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableReviewedAt(t *time.Time) *ShiftSwapRequestUpdate {
+	if t != nil {
+		ssru.SetReviewedAt(*t)
+	}
+	return ssru
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (ssru *ShiftSwapRequestUpdate) ClearReviewedAt() *ShiftSwapRequestUpdate {
+	ssru.mutation.ClearReviewedAt()
+	return ssru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssru *ShiftSwapRequestUpdate) SetCreatedAt(t time.Time) *ShiftSwapRequestUpdate {
+	ssru.mutation.SetCreatedAt(t)
+	return ssru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssru *ShiftSwapRequestUpdate) SetNillableCreatedAt(t *time.Time) *ShiftSwapRequestUpdate {
+	if t != nil {
+		ssru.SetCreatedAt(*t)
+	}
+	return ssru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssru *ShiftSwapRequestUpdate) SetUpdatedAt(t time.Time) *ShiftSwapRequestUpdate {
+	ssru.mutation.SetUpdatedAt(t)
+	return ssru
+}
+
+// SetRoster sets the "roster" edge to the Roster entity.
+func (ssru *ShiftSwapRequestUpdate) SetRoster(r *Roster) *ShiftSwapRequestUpdate {
+	return ssru.SetRosterID(r.ID)
+}
+
+// SetTargetRoster sets the "target_roster" edge to the Roster entity.
+func (ssru *ShiftSwapRequestUpdate) SetTargetRoster(r *Roster) *ShiftSwapRequestUpdate {
+	return ssru.SetTargetRosterID(r.ID)
+}
+
+// Mutation returns the ShiftSwapRequestMutation object of the builder.
+func (ssru *ShiftSwapRequestUpdate) Mutation() *ShiftSwapRequestMutation {
+	return ssru.mutation
+}
+
+// ClearRoster clears the "roster" edge to the Roster entity.
+func (ssru *ShiftSwapRequestUpdate) ClearRoster() *ShiftSwapRequestUpdate {
+	ssru.mutation.ClearRoster()
+	return ssru
+}
+
+// ClearTargetRoster clears the "target_roster" edge to the Roster entity.
+func (ssru *ShiftSwapRequestUpdate) ClearTargetRoster() *ShiftSwapRequestUpdate {
+	ssru.mutation.ClearTargetRoster()
+	return ssru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssru *ShiftSwapRequestUpdate) Save(ctx context.Context) (int, error) {
+	ssru.defaults()
+	return withHooks(ctx, ssru.sqlSave, ssru.mutation, ssru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssru *ShiftSwapRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssru *ShiftSwapRequestUpdate) Exec(ctx context.Context) error {
+	_, err := ssru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssru *ShiftSwapRequestUpdate) ExecX(ctx context.Context) {
+	if err := ssru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssru *ShiftSwapRequestUpdate) defaults() {
+	if _, ok := ssru.mutation.UpdatedAt(); !ok {
+		v := shiftswaprequest.UpdateDefaultUpdatedAt()
+		ssru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssru *ShiftSwapRequestUpdate) check() error {
+	if v, ok := ssru.mutation.Status(); ok {
+		if err := shiftswaprequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShiftSwapRequest.status": %w`, err)}
+		}
+	}
+	if ssru.mutation.RosterCleared() && len(ssru.mutation.RosterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShiftSwapRequest.roster"`)
+	}
+	if ssru.mutation.TargetRosterCleared() && len(ssru.mutation.TargetRosterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShiftSwapRequest.target_roster"`)
+	}
+	return nil
+}
+
+func (ssru *ShiftSwapRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shiftswaprequest.Table, shiftswaprequest.Columns, sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt))
+	if ps := ssru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssru.mutation.OrgID(); ok {
+		_spec.SetField(shiftswaprequest.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ssru.mutation.AddedOrgID(); ok {
+		_spec.AddField(shiftswaprequest.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ssru.mutation.RequesterID(); ok {
+		_spec.SetField(shiftswaprequest.FieldRequesterID, field.TypeInt, value)
+	}
+	if value, ok := ssru.mutation.AddedRequesterID(); ok {
+		_spec.AddField(shiftswaprequest.FieldRequesterID, field.TypeInt, value)
+	}
+	if value, ok := ssru.mutation.Reason(); ok {
+		_spec.SetField(shiftswaprequest.FieldReason, field.TypeString, value)
+	}
+	if ssru.mutation.ReasonCleared() {
+		_spec.ClearField(shiftswaprequest.FieldReason, field.TypeString)
+	}
+	if value, ok := ssru.mutation.Status(); ok {
+		_spec.SetField(shiftswaprequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ssru.mutation.ReviewerID(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := ssru.mutation.AddedReviewerID(); ok {
+		_spec.AddField(shiftswaprequest.FieldReviewerID, field.TypeInt, value)
+	}
+	if ssru.mutation.ReviewerIDCleared() {
+		_spec.ClearField(shiftswaprequest.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := ssru.mutation.ReviewComment(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewComment, field.TypeString, value)
+	}
+	if ssru.mutation.ReviewCommentCleared() {
+		_spec.ClearField(shiftswaprequest.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := ssru.mutation.ReviewedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if ssru.mutation.ReviewedAtCleared() {
+		_spec.ClearField(shiftswaprequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := ssru.mutation.CreatedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ssru.mutation.UpdatedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ssru.mutation.RosterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.RosterTable,
+			Columns: []string{shiftswaprequest.RosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssru.mutation.RosterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.RosterTable,
+			Columns: []string{shiftswaprequest.RosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssru.mutation.TargetRosterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.TargetRosterTable,
+			Columns: []string{shiftswaprequest.TargetRosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssru.mutation.TargetRosterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.TargetRosterTable,
+			Columns: []string{shiftswaprequest.TargetRosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ssru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shiftswaprequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssru.mutation.done = true
+	return n, nil
+}
+
+// ShiftSwapRequestUpdateOne is the builder for updating a single ShiftSwapRequest entity.
+type ShiftSwapRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShiftSwapRequestMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetOrgID(i int) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ResetOrgID()
+	ssruo.mutation.SetOrgID(i)
+	return ssruo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableOrgID(i *int) *ShiftSwapRequestUpdateOne {
+	if i != nil {
+		ssruo.SetOrgID(*i)
+	}
+	return ssruo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) AddOrgID(i int) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.AddOrgID(i)
+	return ssruo
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetRequesterID(i int) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ResetRequesterID()
+	ssruo.mutation.SetRequesterID(i)
+	return ssruo
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableRequesterID(i *int) *ShiftSwapRequestUpdateOne {
+	if i != nil {
+		ssruo.SetRequesterID(*i)
+	}
+	return ssruo
+}
+
+// AddRequesterID adds i to the "requester_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) AddRequesterID(i int) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.AddRequesterID(i)
+	return ssruo
+}
+
+// SetRosterID sets the "roster_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetRosterID(i int) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.SetRosterID(i)
+	return ssruo
+}
+
+// SetNillableRosterID sets the "roster_id" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableRosterID(i *int) *ShiftSwapRequestUpdateOne {
+	if i != nil {
+		ssruo.SetRosterID(*i)
+	}
+	return ssruo
+}
+
+// SetTargetRosterID sets the "target_roster_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetTargetRosterID(i int) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.SetTargetRosterID(i)
+	return ssruo
+}
+
+// SetNillableTargetRosterID sets the "target_roster_id" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableTargetRosterID(i *int) *ShiftSwapRequestUpdateOne {
+	if i != nil {
+		ssruo.SetTargetRosterID(*i)
+	}
+	return ssruo
+}
+
+// SetReason sets the "reason" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetReason(s string) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.SetReason(s)
+	return ssruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableReason(s *string) *ShiftSwapRequestUpdateOne {
+	if s != nil {
+		ssruo.SetReason(*s)
+	}
+	return ssruo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (ssruo *ShiftSwapRequestUpdateOne) ClearReason() *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ClearReason()
+	return ssruo
+}
+
+// SetStatus sets the "status" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetStatus(s shiftswaprequest.Status) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.SetStatus(s)
+	return ssruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableStatus(s *shiftswaprequest.Status) *ShiftSwapRequestUpdateOne {
+	if s != nil {
+		ssruo.SetStatus(*s)
+	}
+	return ssruo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetReviewerID(i int) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ResetReviewerID()
+	ssruo.mutation.SetReviewerID(i)
+	return ssruo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableReviewerID(i *int) *ShiftSwapRequestUpdateOne {
+	if i != nil {
+		ssruo.SetReviewerID(*i)
+	}
+	return ssruo
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) AddReviewerID(i int) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.AddReviewerID(i)
+	return ssruo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (ssruo *ShiftSwapRequestUpdateOne) ClearReviewerID() *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ClearReviewerID()
+	return ssruo
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetReviewComment(s string) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.SetReviewComment(s)
+	return ssruo
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableReviewComment(s *string) *ShiftSwapRequestUpdateOne {
+	if s != nil {
+		ssruo.SetReviewComment(*s)
+	}
+	return ssruo
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (ssruo *ShiftSwapRequestUpdateOne) ClearReviewComment() *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ClearReviewComment()
+	return ssruo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetReviewedAt(t time.Time) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.SetReviewedAt(t)
+	return ssruo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableReviewedAt(t *time.Time) *ShiftSwapRequestUpdateOne {
+	if t != nil {
+		ssruo.SetReviewedAt(*t)
+	}
+	return ssruo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (ssruo *ShiftSwapRequestUpdateOne) ClearReviewedAt() *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ClearReviewedAt()
+	return ssruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetCreatedAt(t time.Time) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.SetCreatedAt(t)
+	return ssruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssruo *ShiftSwapRequestUpdateOne) SetNillableCreatedAt(t *time.Time) *ShiftSwapRequestUpdateOne {
+	if t != nil {
+		ssruo.SetCreatedAt(*t)
+	}
+	return ssruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssruo *ShiftSwapRequestUpdateOne) SetUpdatedAt(t time.Time) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.SetUpdatedAt(t)
+	return ssruo
+}
+
+// SetRoster sets the "roster" edge to the Roster entity.
+func (ssruo *ShiftSwapRequestUpdateOne) SetRoster(r *Roster) *ShiftSwapRequestUpdateOne {
+	return ssruo.SetRosterID(r.ID)
+}
+
+// SetTargetRoster sets the "target_roster" edge to the Roster entity.
+func (ssruo *ShiftSwapRequestUpdateOne) SetTargetRoster(r *Roster) *ShiftSwapRequestUpdateOne {
+	return ssruo.SetTargetRosterID(r.ID)
+}
+
+// Mutation returns the ShiftSwapRequestMutation object of the builder.
+func (ssruo *ShiftSwapRequestUpdateOne) Mutation() *ShiftSwapRequestMutation {
+	return ssruo.mutation
+}
+
+// ClearRoster clears the "roster" edge to the Roster entity.
+func (ssruo *ShiftSwapRequestUpdateOne) ClearRoster() *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ClearRoster()
+	return ssruo
+}
+
+// ClearTargetRoster clears the "target_roster" edge to the Roster entity.
+func (ssruo *ShiftSwapRequestUpdateOne) ClearTargetRoster() *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.ClearTargetRoster()
+	return ssruo
+}
+
+// Where appends a list predicates to the ShiftSwapRequestUpdate builder.
+func (ssruo *ShiftSwapRequestUpdateOne) Where(ps ...predicate.ShiftSwapRequest) *ShiftSwapRequestUpdateOne {
+	ssruo.mutation.Where(ps...)
+	return ssruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssruo *ShiftSwapRequestUpdateOne) Select(field string, fields ...string) *ShiftSwapRequestUpdateOne {
+	ssruo.fields = append([]string{field}, fields...)
+	return ssruo
+}
+
+// Save executes the query and returns the updated ShiftSwapRequest entity.
+func (ssruo *ShiftSwapRequestUpdateOne) Save(ctx context.Context) (*ShiftSwapRequest, error) {
+	ssruo.defaults()
+	return withHooks(ctx, ssruo.sqlSave, ssruo.mutation, ssruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssruo *ShiftSwapRequestUpdateOne) SaveX(ctx context.Context) *ShiftSwapRequest {
+	node, err := ssruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssruo *ShiftSwapRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssruo *ShiftSwapRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := ssruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssruo *ShiftSwapRequestUpdateOne) defaults() {
+	if _, ok := ssruo.mutation.UpdatedAt(); !ok {
+		v := shiftswaprequest.UpdateDefaultUpdatedAt()
+		ssruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssruo *ShiftSwapRequestUpdateOne) check() error {
+	if v, ok := ssruo.mutation.Status(); ok {
+		if err := shiftswaprequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ShiftSwapRequest.status": %w`, err)}
+		}
+	}
+	if ssruo.mutation.RosterCleared() && len(ssruo.mutation.RosterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShiftSwapRequest.roster"`)
+	}
+	if ssruo.mutation.TargetRosterCleared() && len(ssruo.mutation.TargetRosterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShiftSwapRequest.target_roster"`)
+	}
+	return nil
+}
+
+func (ssruo *ShiftSwapRequestUpdateOne) sqlSave(ctx context.Context) (_node *ShiftSwapRequest, err error) {
+	if err := ssruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shiftswaprequest.Table, shiftswaprequest.Columns, sqlgraph.NewFieldSpec(shiftswaprequest.FieldID, field.TypeInt))
+	id, ok := ssruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShiftSwapRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shiftswaprequest.FieldID)
+		for _, f := range fields {
+			if !shiftswaprequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shiftswaprequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssruo.mutation.OrgID(); ok {
+		_spec.SetField(shiftswaprequest.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ssruo.mutation.AddedOrgID(); ok {
+		_spec.AddField(shiftswaprequest.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ssruo.mutation.RequesterID(); ok {
+		_spec.SetField(shiftswaprequest.FieldRequesterID, field.TypeInt, value)
+	}
+	if value, ok := ssruo.mutation.AddedRequesterID(); ok {
+		_spec.AddField(shiftswaprequest.FieldRequesterID, field.TypeInt, value)
+	}
+	if value, ok := ssruo.mutation.Reason(); ok {
+		_spec.SetField(shiftswaprequest.FieldReason, field.TypeString, value)
+	}
+	if ssruo.mutation.ReasonCleared() {
+		_spec.ClearField(shiftswaprequest.FieldReason, field.TypeString)
+	}
+	if value, ok := ssruo.mutation.Status(); ok {
+		_spec.SetField(shiftswaprequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ssruo.mutation.ReviewerID(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := ssruo.mutation.AddedReviewerID(); ok {
+		_spec.AddField(shiftswaprequest.FieldReviewerID, field.TypeInt, value)
+	}
+	if ssruo.mutation.ReviewerIDCleared() {
+		_spec.ClearField(shiftswaprequest.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := ssruo.mutation.ReviewComment(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewComment, field.TypeString, value)
+	}
+	if ssruo.mutation.ReviewCommentCleared() {
+		_spec.ClearField(shiftswaprequest.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := ssruo.mutation.ReviewedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if ssruo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(shiftswaprequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := ssruo.mutation.CreatedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ssruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(shiftswaprequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ssruo.mutation.RosterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.RosterTable,
+			Columns: []string{shiftswaprequest.RosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssruo.mutation.RosterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.RosterTable,
+			Columns: []string{shiftswaprequest.RosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssruo.mutation.TargetRosterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.TargetRosterTable,
+			Columns: []string{shiftswaprequest.TargetRosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssruo.mutation.TargetRosterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shiftswaprequest.TargetRosterTable,
+			Columns: []string{shiftswaprequest.TargetRosterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShiftSwapRequest{config: ssruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shiftswaprequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+)
+
+// Sprint is the model entity for the Sprint schema.
+type Sprint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Goal holds the value of the "goal" field.
+	Goal string `json:"goal"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt time.Time `json:"start_at"`
+	// EndAt holds the value of the "end_at" field.
+	EndAt time.Time `json:"end_at"`
+	// State holds the value of the "state" field.
+	State sprint.State `json:"state"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at"`
+	// CommittedTaskIds holds the value of the "committed_task_ids" field.
+	CommittedTaskIds []int `json:"committed_task_ids"`
+	// CompletedTaskIds holds the value of the "completed_task_ids" field.
+	CompletedTaskIds []int `json:"completed_task_ids"`
+	// IncompleteTaskIds holds the value of the "incomplete_task_ids" field.
+	IncompleteTaskIds []int `json:"incomplete_task_ids"`
+	// CreatorID holds the value of the "creator_id" field.
+	CreatorID int `json:"creator_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SprintQuery when eager-loading is set.
+	Edges        SprintEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SprintEdges holds the relations/edges for other nodes in the graph.
+type SprintEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project"`
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SprintEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e SprintEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[1] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Sprint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sprint.FieldCommittedTaskIds, sprint.FieldCompletedTaskIds, sprint.FieldIncompleteTaskIds:
+			values[i] = new([]byte)
+		case sprint.FieldID, sprint.FieldProjectID, sprint.FieldCreatorID:
+			values[i] = new(sql.NullInt64)
+		case sprint.FieldName, sprint.FieldGoal, sprint.FieldState:
+			values[i] = new(sql.NullString)
+		case sprint.FieldStartAt, sprint.FieldEndAt, sprint.FieldStartedAt, sprint.FieldClosedAt, sprint.FieldCreatedAt, sprint.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Sprint fields.
+func (s *Sprint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sprint.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case sprint.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				s.ProjectID = int(value.Int64)
+			}
+		case sprint.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case sprint.FieldGoal:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field goal", values[i])
+			} else if value.Valid {
+				s.Goal = value.String
+			}
+		case sprint.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				s.StartAt = value.Time
+			}
+		case sprint.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				s.EndAt = value.Time
+			}
+		case sprint.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				s.State = sprint.State(value.String)
+			}
+		case sprint.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				s.StartedAt = new(time.Time)
+				*s.StartedAt = value.Time
+			}
+		case sprint.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				s.ClosedAt = new(time.Time)
+				*s.ClosedAt = value.Time
+			}
+		case sprint.FieldCommittedTaskIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field committed_task_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.CommittedTaskIds); err != nil {
+					return fmt.Errorf("unmarshal field committed_task_ids: %w", err)
+				}
+			}
+		case sprint.FieldCompletedTaskIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_task_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.CompletedTaskIds); err != nil {
+					return fmt.Errorf("unmarshal field completed_task_ids: %w", err)
+				}
+			}
+		case sprint.FieldIncompleteTaskIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field incomplete_task_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.IncompleteTaskIds); err != nil {
+					return fmt.Errorf("unmarshal field incomplete_task_ids: %w", err)
+				}
+			}
+		case sprint.FieldCreatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value.Valid {
+				s.CreatorID = int(value.Int64)
+			}
+		case sprint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case sprint.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Sprint.
+// This includes values selected through modifiers, order, etc.
+func (s *Sprint) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Sprint entity.
+func (s *Sprint) QueryProject() *ProjectQuery {
+	return NewSprintClient(s.config).QueryProject(s)
+}
+
+// QueryTasks queries the "tasks" edge of the Sprint entity.
+func (s *Sprint) QueryTasks() *TaskQuery {
+	return NewSprintClient(s.config).QueryTasks(s)
+}
+
+// Update returns a builder for updating this Sprint.
+// Note that you need to call Sprint.Unwrap() before calling this method if this Sprint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Sprint) Update() *SprintUpdateOne {
+	return NewSprintClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Sprint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Sprint) Unwrap() *Sprint {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Sprint is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Sprint) String() string {
+	var builder strings.Builder
+	builder.WriteString("Sprint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("goal=")
+	builder.WriteString(s.Goal)
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(s.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(s.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", s.State))
+	builder.WriteString(", ")
+	if v := s.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("committed_task_ids=")
+	builder.WriteString(fmt.Sprintf("%v", s.CommittedTaskIds))
+	builder.WriteString(", ")
+	builder.WriteString("completed_task_ids=")
+	builder.WriteString(fmt.Sprintf("%v", s.CompletedTaskIds))
+	builder.WriteString(", ")
+	builder.WriteString("incomplete_task_ids=")
+	builder.WriteString(fmt.Sprintf("%v", s.IncompleteTaskIds))
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Sprints is a parsable slice of Sprint.
+type Sprints []*Sprint
@@ -0,0 +1,1368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// SprintCreate is the builder for creating a Sprint entity.
+type SprintCreate struct {
+	config
+	mutation *SprintMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProjectID sets the "project_id" field.
+func (sc *SprintCreate) SetProjectID(i int) *SprintCreate {
+	sc.mutation.SetProjectID(i)
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *SprintCreate) SetName(s string) *SprintCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetGoal sets the "goal" field.
+func (sc *SprintCreate) SetGoal(s string) *SprintCreate {
+	sc.mutation.SetGoal(s)
+	return sc
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableGoal(s *string) *SprintCreate {
+	if s != nil {
+		sc.SetGoal(*s)
+	}
+	return sc
+}
+
+// SetStartAt sets the "start_at" field.
+func (sc *SprintCreate) SetStartAt(t time.Time) *SprintCreate {
+	sc.mutation.SetStartAt(t)
+	return sc
+}
+
+// SetEndAt sets the "end_at" field.
+func (sc *SprintCreate) SetEndAt(t time.Time) *SprintCreate {
+	sc.mutation.SetEndAt(t)
+	return sc
+}
+
+// SetState sets the "state" field.
+func (sc *SprintCreate) SetState(s sprint.State) *SprintCreate {
+	sc.mutation.SetState(s)
+	return sc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableState(s *sprint.State) *SprintCreate {
+	if s != nil {
+		sc.SetState(*s)
+	}
+	return sc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sc *SprintCreate) SetStartedAt(t time.Time) *SprintCreate {
+	sc.mutation.SetStartedAt(t)
+	return sc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableStartedAt(t *time.Time) *SprintCreate {
+	if t != nil {
+		sc.SetStartedAt(*t)
+	}
+	return sc
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (sc *SprintCreate) SetClosedAt(t time.Time) *SprintCreate {
+	sc.mutation.SetClosedAt(t)
+	return sc
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableClosedAt(t *time.Time) *SprintCreate {
+	if t != nil {
+		sc.SetClosedAt(*t)
+	}
+	return sc
+}
+
+// SetCommittedTaskIds sets the "committed_task_ids" field.
+func (sc *SprintCreate) SetCommittedTaskIds(i []int) *SprintCreate {
+	sc.mutation.SetCommittedTaskIds(i)
+	return sc
+}
+
+// SetCompletedTaskIds sets the "completed_task_ids" field.
+func (sc *SprintCreate) SetCompletedTaskIds(i []int) *SprintCreate {
+	sc.mutation.SetCompletedTaskIds(i)
+	return sc
+}
+
+// SetIncompleteTaskIds sets the "incomplete_task_ids" field.
+func (sc *SprintCreate) SetIncompleteTaskIds(i []int) *SprintCreate {
+	sc.mutation.SetIncompleteTaskIds(i)
+	return sc
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (sc *SprintCreate) SetCreatorID(i int) *SprintCreate {
+	sc.mutation.SetCreatorID(i)
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SprintCreate) SetCreatedAt(t time.Time) *SprintCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableCreatedAt(t *time.Time) *SprintCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SprintCreate) SetUpdatedAt(t time.Time) *SprintCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableUpdatedAt(t *time.Time) *SprintCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (sc *SprintCreate) SetProject(p *Project) *SprintCreate {
+	return sc.SetProjectID(p.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (sc *SprintCreate) AddTaskIDs(ids ...int) *SprintCreate {
+	sc.mutation.AddTaskIDs(ids...)
+	return sc
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (sc *SprintCreate) AddTasks(t ...*Task) *SprintCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sc.AddTaskIDs(ids...)
+}
+
+// Mutation returns the SprintMutation object of the builder.
+func (sc *SprintCreate) Mutation() *SprintMutation {
+	return sc.mutation
+}
+
+// Save creates the Sprint in the database.
+func (sc *SprintCreate) Save(ctx context.Context) (*Sprint, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SprintCreate) SaveX(ctx context.Context) *Sprint {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SprintCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SprintCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SprintCreate) defaults() {
+	if _, ok := sc.mutation.State(); !ok {
+		v := sprint.DefaultState
+		sc.mutation.SetState(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := sprint.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := sprint.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SprintCreate) check() error {
+	if _, ok := sc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "Sprint.project_id"`)}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Sprint.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := sprint.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Sprint.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "Sprint.start_at"`)}
+	}
+	if _, ok := sc.mutation.EndAt(); !ok {
+		return &ValidationError{Name: "end_at", err: errors.New(`ent: missing required field "Sprint.end_at"`)}
+	}
+	if _, ok := sc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Sprint.state"`)}
+	}
+	if v, ok := sc.mutation.State(); ok {
+		if err := sprint.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Sprint.state": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`ent: missing required field "Sprint.creator_id"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Sprint.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Sprint.updated_at"`)}
+	}
+	if len(sc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "Sprint.project"`)}
+	}
+	return nil
+}
+
+func (sc *SprintCreate) sqlSave(ctx context.Context) (*Sprint, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SprintCreate) createSpec() (*Sprint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sprint{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(sprint.Table, sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sc.conflict
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(sprint.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.Goal(); ok {
+		_spec.SetField(sprint.FieldGoal, field.TypeString, value)
+		_node.Goal = value
+	}
+	if value, ok := sc.mutation.StartAt(); ok {
+		_spec.SetField(sprint.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := sc.mutation.EndAt(); ok {
+		_spec.SetField(sprint.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = value
+	}
+	if value, ok := sc.mutation.State(); ok {
+		_spec.SetField(sprint.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := sc.mutation.StartedAt(); ok {
+		_spec.SetField(sprint.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := sc.mutation.ClosedAt(); ok {
+		_spec.SetField(sprint.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := sc.mutation.CommittedTaskIds(); ok {
+		_spec.SetField(sprint.FieldCommittedTaskIds, field.TypeJSON, value)
+		_node.CommittedTaskIds = value
+	}
+	if value, ok := sc.mutation.CompletedTaskIds(); ok {
+		_spec.SetField(sprint.FieldCompletedTaskIds, field.TypeJSON, value)
+		_node.CompletedTaskIds = value
+	}
+	if value, ok := sc.mutation.IncompleteTaskIds(); ok {
+		_spec.SetField(sprint.FieldIncompleteTaskIds, field.TypeJSON, value)
+		_node.IncompleteTaskIds = value
+	}
+	if value, ok := sc.mutation.CreatorID(); ok {
+		_spec.SetField(sprint.FieldCreatorID, field.TypeInt, value)
+		_node.CreatorID = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(sprint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(sprint.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.ProjectTable,
+			Columns: []string{sprint.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sprint.Create().
+//		SetProjectID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SprintUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SprintCreate) OnConflict(opts ...sql.ConflictOption) *SprintUpsertOne {
+	sc.conflict = opts
+	return &SprintUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sprint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SprintCreate) OnConflictColumns(columns ...string) *SprintUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SprintUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SprintUpsertOne is the builder for "upsert"-ing
+	//  one Sprint node.
+	SprintUpsertOne struct {
+		create *SprintCreate
+	}
+
+	// SprintUpsert is the "OnConflict" setter.
+	SprintUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProjectID sets the "project_id" field.
+func (u *SprintUpsert) SetProjectID(v int) *SprintUpsert {
+	u.Set(sprint.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateProjectID() *SprintUpsert {
+	u.SetExcluded(sprint.FieldProjectID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SprintUpsert) SetName(v string) *SprintUpsert {
+	u.Set(sprint.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateName() *SprintUpsert {
+	u.SetExcluded(sprint.FieldName)
+	return u
+}
+
+// SetGoal sets the "goal" field.
+func (u *SprintUpsert) SetGoal(v string) *SprintUpsert {
+	u.Set(sprint.FieldGoal, v)
+	return u
+}
+
+// UpdateGoal sets the "goal" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateGoal() *SprintUpsert {
+	u.SetExcluded(sprint.FieldGoal)
+	return u
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (u *SprintUpsert) ClearGoal() *SprintUpsert {
+	u.SetNull(sprint.FieldGoal)
+	return u
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *SprintUpsert) SetStartAt(v time.Time) *SprintUpsert {
+	u.Set(sprint.FieldStartAt, v)
+	return u
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateStartAt() *SprintUpsert {
+	u.SetExcluded(sprint.FieldStartAt)
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *SprintUpsert) SetEndAt(v time.Time) *SprintUpsert {
+	u.Set(sprint.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateEndAt() *SprintUpsert {
+	u.SetExcluded(sprint.FieldEndAt)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *SprintUpsert) SetState(v sprint.State) *SprintUpsert {
+	u.Set(sprint.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateState() *SprintUpsert {
+	u.SetExcluded(sprint.FieldState)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SprintUpsert) SetStartedAt(v time.Time) *SprintUpsert {
+	u.Set(sprint.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateStartedAt() *SprintUpsert {
+	u.SetExcluded(sprint.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *SprintUpsert) ClearStartedAt() *SprintUpsert {
+	u.SetNull(sprint.FieldStartedAt)
+	return u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *SprintUpsert) SetClosedAt(v time.Time) *SprintUpsert {
+	u.Set(sprint.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateClosedAt() *SprintUpsert {
+	u.SetExcluded(sprint.FieldClosedAt)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *SprintUpsert) ClearClosedAt() *SprintUpsert {
+	u.SetNull(sprint.FieldClosedAt)
+	return u
+}
+
+// SetCommittedTaskIds sets the "committed_task_ids" field.
+func (u *SprintUpsert) SetCommittedTaskIds(v []int) *SprintUpsert {
+	u.Set(sprint.FieldCommittedTaskIds, v)
+	return u
+}
+
+// UpdateCommittedTaskIds sets the "committed_task_ids" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateCommittedTaskIds() *SprintUpsert {
+	u.SetExcluded(sprint.FieldCommittedTaskIds)
+	return u
+}
+
+// ClearCommittedTaskIds clears the value of the "committed_task_ids" field.
+func (u *SprintUpsert) ClearCommittedTaskIds() *SprintUpsert {
+	u.SetNull(sprint.FieldCommittedTaskIds)
+	return u
+}
+
+// SetCompletedTaskIds sets the "completed_task_ids" field.
+func (u *SprintUpsert) SetCompletedTaskIds(v []int) *SprintUpsert {
+	u.Set(sprint.FieldCompletedTaskIds, v)
+	return u
+}
+
+// UpdateCompletedTaskIds sets the "completed_task_ids" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateCompletedTaskIds() *SprintUpsert {
+	u.SetExcluded(sprint.FieldCompletedTaskIds)
+	return u
+}
+
+// ClearCompletedTaskIds clears the value of the "completed_task_ids" field.
+func (u *SprintUpsert) ClearCompletedTaskIds() *SprintUpsert {
+	u.SetNull(sprint.FieldCompletedTaskIds)
+	return u
+}
+
+// SetIncompleteTaskIds sets the "incomplete_task_ids" field.
+func (u *SprintUpsert) SetIncompleteTaskIds(v []int) *SprintUpsert {
+	u.Set(sprint.FieldIncompleteTaskIds, v)
+	return u
+}
+
+// UpdateIncompleteTaskIds sets the "incomplete_task_ids" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateIncompleteTaskIds() *SprintUpsert {
+	u.SetExcluded(sprint.FieldIncompleteTaskIds)
+	return u
+}
+
+// ClearIncompleteTaskIds clears the value of the "incomplete_task_ids" field.
+func (u *SprintUpsert) ClearIncompleteTaskIds() *SprintUpsert {
+	u.SetNull(sprint.FieldIncompleteTaskIds)
+	return u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *SprintUpsert) SetCreatorID(v int) *SprintUpsert {
+	u.Set(sprint.FieldCreatorID, v)
+	return u
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateCreatorID() *SprintUpsert {
+	u.SetExcluded(sprint.FieldCreatorID)
+	return u
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *SprintUpsert) AddCreatorID(v int) *SprintUpsert {
+	u.Add(sprint.FieldCreatorID, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SprintUpsert) SetUpdatedAt(v time.Time) *SprintUpsert {
+	u.Set(sprint.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SprintUpsert) UpdateUpdatedAt() *SprintUpsert {
+	u.SetExcluded(sprint.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Sprint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SprintUpsertOne) UpdateNewValues() *SprintUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(sprint.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sprint.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SprintUpsertOne) Ignore() *SprintUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SprintUpsertOne) DoNothing() *SprintUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SprintCreate.OnConflict
+// documentation for more info.
+func (u *SprintUpsertOne) Update(set func(*SprintUpsert)) *SprintUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SprintUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *SprintUpsertOne) SetProjectID(v int) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateProjectID() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SprintUpsertOne) SetName(v string) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateName() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetGoal sets the "goal" field.
+func (u *SprintUpsertOne) SetGoal(v string) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetGoal(v)
+	})
+}
+
+// UpdateGoal sets the "goal" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateGoal() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateGoal()
+	})
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (u *SprintUpsertOne) ClearGoal() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearGoal()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *SprintUpsertOne) SetStartAt(v time.Time) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateStartAt() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *SprintUpsertOne) SetEndAt(v time.Time) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateEndAt() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *SprintUpsertOne) SetState(v sprint.State) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateState() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SprintUpsertOne) SetStartedAt(v time.Time) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateStartedAt() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *SprintUpsertOne) ClearStartedAt() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *SprintUpsertOne) SetClosedAt(v time.Time) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateClosedAt() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *SprintUpsertOne) ClearClosedAt() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetCommittedTaskIds sets the "committed_task_ids" field.
+func (u *SprintUpsertOne) SetCommittedTaskIds(v []int) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetCommittedTaskIds(v)
+	})
+}
+
+// UpdateCommittedTaskIds sets the "committed_task_ids" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateCommittedTaskIds() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateCommittedTaskIds()
+	})
+}
+
+// ClearCommittedTaskIds clears the value of the "committed_task_ids" field.
+func (u *SprintUpsertOne) ClearCommittedTaskIds() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearCommittedTaskIds()
+	})
+}
+
+// SetCompletedTaskIds sets the "completed_task_ids" field.
+func (u *SprintUpsertOne) SetCompletedTaskIds(v []int) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetCompletedTaskIds(v)
+	})
+}
+
+// UpdateCompletedTaskIds sets the "completed_task_ids" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateCompletedTaskIds() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateCompletedTaskIds()
+	})
+}
+
+// ClearCompletedTaskIds clears the value of the "completed_task_ids" field.
+func (u *SprintUpsertOne) ClearCompletedTaskIds() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearCompletedTaskIds()
+	})
+}
+
+// SetIncompleteTaskIds sets the "incomplete_task_ids" field.
+func (u *SprintUpsertOne) SetIncompleteTaskIds(v []int) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetIncompleteTaskIds(v)
+	})
+}
+
+// UpdateIncompleteTaskIds sets the "incomplete_task_ids" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateIncompleteTaskIds() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateIncompleteTaskIds()
+	})
+}
+
+// ClearIncompleteTaskIds clears the value of the "incomplete_task_ids" field.
+func (u *SprintUpsertOne) ClearIncompleteTaskIds() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearIncompleteTaskIds()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *SprintUpsertOne) SetCreatorID(v int) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *SprintUpsertOne) AddCreatorID(v int) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateCreatorID() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SprintUpsertOne) SetUpdatedAt(v time.Time) *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SprintUpsertOne) UpdateUpdatedAt() *SprintUpsertOne {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SprintUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SprintCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SprintUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SprintUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SprintUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SprintCreateBulk is the builder for creating many Sprint entities in bulk.
+type SprintCreateBulk struct {
+	config
+	err      error
+	builders []*SprintCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Sprint entities in the database.
+func (scb *SprintCreateBulk) Save(ctx context.Context) ([]*Sprint, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Sprint, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SprintMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SprintCreateBulk) SaveX(ctx context.Context) []*Sprint {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SprintCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SprintCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sprint.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SprintUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SprintCreateBulk) OnConflict(opts ...sql.ConflictOption) *SprintUpsertBulk {
+	scb.conflict = opts
+	return &SprintUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sprint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SprintCreateBulk) OnConflictColumns(columns ...string) *SprintUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SprintUpsertBulk{
+		create: scb,
+	}
+}
+
+// SprintUpsertBulk is the builder for "upsert"-ing
+// a bulk of Sprint nodes.
+type SprintUpsertBulk struct {
+	create *SprintCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Sprint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SprintUpsertBulk) UpdateNewValues() *SprintUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(sprint.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sprint.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SprintUpsertBulk) Ignore() *SprintUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SprintUpsertBulk) DoNothing() *SprintUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SprintCreateBulk.OnConflict
+// documentation for more info.
+func (u *SprintUpsertBulk) Update(set func(*SprintUpsert)) *SprintUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SprintUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *SprintUpsertBulk) SetProjectID(v int) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateProjectID() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SprintUpsertBulk) SetName(v string) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateName() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetGoal sets the "goal" field.
+func (u *SprintUpsertBulk) SetGoal(v string) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetGoal(v)
+	})
+}
+
+// UpdateGoal sets the "goal" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateGoal() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateGoal()
+	})
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (u *SprintUpsertBulk) ClearGoal() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearGoal()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *SprintUpsertBulk) SetStartAt(v time.Time) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateStartAt() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *SprintUpsertBulk) SetEndAt(v time.Time) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateEndAt() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *SprintUpsertBulk) SetState(v sprint.State) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateState() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SprintUpsertBulk) SetStartedAt(v time.Time) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateStartedAt() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *SprintUpsertBulk) ClearStartedAt() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *SprintUpsertBulk) SetClosedAt(v time.Time) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateClosedAt() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *SprintUpsertBulk) ClearClosedAt() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetCommittedTaskIds sets the "committed_task_ids" field.
+func (u *SprintUpsertBulk) SetCommittedTaskIds(v []int) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetCommittedTaskIds(v)
+	})
+}
+
+// UpdateCommittedTaskIds sets the "committed_task_ids" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateCommittedTaskIds() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateCommittedTaskIds()
+	})
+}
+
+// ClearCommittedTaskIds clears the value of the "committed_task_ids" field.
+func (u *SprintUpsertBulk) ClearCommittedTaskIds() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearCommittedTaskIds()
+	})
+}
+
+// SetCompletedTaskIds sets the "completed_task_ids" field.
+func (u *SprintUpsertBulk) SetCompletedTaskIds(v []int) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetCompletedTaskIds(v)
+	})
+}
+
+// UpdateCompletedTaskIds sets the "completed_task_ids" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateCompletedTaskIds() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateCompletedTaskIds()
+	})
+}
+
+// ClearCompletedTaskIds clears the value of the "completed_task_ids" field.
+func (u *SprintUpsertBulk) ClearCompletedTaskIds() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearCompletedTaskIds()
+	})
+}
+
+// SetIncompleteTaskIds sets the "incomplete_task_ids" field.
+func (u *SprintUpsertBulk) SetIncompleteTaskIds(v []int) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetIncompleteTaskIds(v)
+	})
+}
+
+// UpdateIncompleteTaskIds sets the "incomplete_task_ids" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateIncompleteTaskIds() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateIncompleteTaskIds()
+	})
+}
+
+// ClearIncompleteTaskIds clears the value of the "incomplete_task_ids" field.
+func (u *SprintUpsertBulk) ClearIncompleteTaskIds() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.ClearIncompleteTaskIds()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *SprintUpsertBulk) SetCreatorID(v int) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *SprintUpsertBulk) AddCreatorID(v int) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateCreatorID() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SprintUpsertBulk) SetUpdatedAt(v time.Time) *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SprintUpsertBulk) UpdateUpdatedAt() *SprintUpsertBulk {
+	return u.Update(func(s *SprintUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SprintUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SprintCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SprintCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SprintUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1047 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// SprintUpdate is the builder for updating Sprint entities.
+type SprintUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SprintMutation
+}
+
+// Where appends a list predicates to the SprintUpdate builder.
+func (su *SprintUpdate) Where(ps ...predicate.Sprint) *SprintUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetProjectID sets the "project_id" field.
+func (su *SprintUpdate) SetProjectID(i int) *SprintUpdate {
+	su.mutation.SetProjectID(i)
+	return su
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableProjectID(i *int) *SprintUpdate {
+	if i != nil {
+		su.SetProjectID(*i)
+	}
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *SprintUpdate) SetName(s string) *SprintUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableName(s *string) *SprintUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetGoal sets the "goal" field.
+func (su *SprintUpdate) SetGoal(s string) *SprintUpdate {
+	su.mutation.SetGoal(s)
+	return su
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableGoal(s *string) *SprintUpdate {
+	if s != nil {
+		su.SetGoal(*s)
+	}
+	return su
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (su *SprintUpdate) ClearGoal() *SprintUpdate {
+	su.mutation.ClearGoal()
+	return su
+}
+
+// SetStartAt sets the "start_at" field.
+func (su *SprintUpdate) SetStartAt(t time.Time) *SprintUpdate {
+	su.mutation.SetStartAt(t)
+	return su
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableStartAt(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetStartAt(*t)
+	}
+	return su
+}
+
+// SetEndAt sets the "end_at" field.
+func (su *SprintUpdate) SetEndAt(t time.Time) *SprintUpdate {
+	su.mutation.SetEndAt(t)
+	return su
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableEndAt(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetEndAt(*t)
+	}
+	return su
+}
+
+// SetState sets the "state" field.
+func (su *SprintUpdate) SetState(s sprint.State) *SprintUpdate {
+	su.mutation.SetState(s)
+	return su
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableState(s *sprint.State) *SprintUpdate {
+	if s != nil {
+		su.SetState(*s)
+	}
+	return su
+}
+
+// SetStartedAt sets the "started_at" field.
+func (su *SprintUpdate) SetStartedAt(t time.Time) *SprintUpdate {
+	su.mutation.SetStartedAt(t)
+	return su
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableStartedAt(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetStartedAt(*t)
+	}
+	return su
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (su *SprintUpdate) ClearStartedAt() *SprintUpdate {
+	su.mutation.ClearStartedAt()
+	return su
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (su *SprintUpdate) SetClosedAt(t time.Time) *SprintUpdate {
+	su.mutation.SetClosedAt(t)
+	return su
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableClosedAt(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetClosedAt(*t)
+	}
+	return su
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (su *SprintUpdate) ClearClosedAt() *SprintUpdate {
+	su.mutation.ClearClosedAt()
+	return su
+}
+
+// SetCommittedTaskIds sets the "committed_task_ids" field.
+func (su *SprintUpdate) SetCommittedTaskIds(i []int) *SprintUpdate {
+	su.mutation.SetCommittedTaskIds(i)
+	return su
+}
+
+// AppendCommittedTaskIds appends i to the "committed_task_ids" field.
+func (su *SprintUpdate) AppendCommittedTaskIds(i []int) *SprintUpdate {
+	su.mutation.AppendCommittedTaskIds(i)
+	return su
+}
+
+// ClearCommittedTaskIds clears the value of the "committed_task_ids" field.
+func (su *SprintUpdate) ClearCommittedTaskIds() *SprintUpdate {
+	su.mutation.ClearCommittedTaskIds()
+	return su
+}
+
+// SetCompletedTaskIds sets the "completed_task_ids" field.
+func (su *SprintUpdate) SetCompletedTaskIds(i []int) *SprintUpdate {
+	su.mutation.SetCompletedTaskIds(i)
+	return su
+}
+
+// AppendCompletedTaskIds appends i to the "completed_task_ids" field.
+func (su *SprintUpdate) AppendCompletedTaskIds(i []int) *SprintUpdate {
+	su.mutation.AppendCompletedTaskIds(i)
+	return su
+}
+
+// ClearCompletedTaskIds clears the value of the "completed_task_ids" field.
+func (su *SprintUpdate) ClearCompletedTaskIds() *SprintUpdate {
+	su.mutation.ClearCompletedTaskIds()
+	return su
+}
+
+// SetIncompleteTaskIds sets the "incomplete_task_ids" field.
+func (su *SprintUpdate) SetIncompleteTaskIds(i []int) *SprintUpdate {
+	su.mutation.SetIncompleteTaskIds(i)
+	return su
+}
+
+// AppendIncompleteTaskIds appends i to the "incomplete_task_ids" field.
+func (su *SprintUpdate) AppendIncompleteTaskIds(i []int) *SprintUpdate {
+	su.mutation.AppendIncompleteTaskIds(i)
+	return su
+}
+
+// ClearIncompleteTaskIds clears the value of the "incomplete_task_ids" field.
+func (su *SprintUpdate) ClearIncompleteTaskIds() *SprintUpdate {
+	su.mutation.ClearIncompleteTaskIds()
+	return su
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (su *SprintUpdate) SetCreatorID(i int) *SprintUpdate {
+	su.mutation.ResetCreatorID()
+	su.mutation.SetCreatorID(i)
+	return su
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableCreatorID(i *int) *SprintUpdate {
+	if i != nil {
+		su.SetCreatorID(*i)
+	}
+	return su
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (su *SprintUpdate) AddCreatorID(i int) *SprintUpdate {
+	su.mutation.AddCreatorID(i)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SprintUpdate) SetUpdatedAt(t time.Time) *SprintUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (su *SprintUpdate) SetProject(p *Project) *SprintUpdate {
+	return su.SetProjectID(p.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (su *SprintUpdate) AddTaskIDs(ids ...int) *SprintUpdate {
+	su.mutation.AddTaskIDs(ids...)
+	return su
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (su *SprintUpdate) AddTasks(t ...*Task) *SprintUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.AddTaskIDs(ids...)
+}
+
+// Mutation returns the SprintMutation object of the builder.
+func (su *SprintUpdate) Mutation() *SprintMutation {
+	return su.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (su *SprintUpdate) ClearProject() *SprintUpdate {
+	su.mutation.ClearProject()
+	return su
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (su *SprintUpdate) ClearTasks() *SprintUpdate {
+	su.mutation.ClearTasks()
+	return su
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (su *SprintUpdate) RemoveTaskIDs(ids ...int) *SprintUpdate {
+	su.mutation.RemoveTaskIDs(ids...)
+	return su
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (su *SprintUpdate) RemoveTasks(t ...*Task) *SprintUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SprintUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SprintUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SprintUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SprintUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SprintUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := sprint.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SprintUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := sprint.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Sprint.name": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.State(); ok {
+		if err := sprint.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Sprint.state": %w`, err)}
+		}
+	}
+	if su.mutation.ProjectCleared() && len(su.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sprint.project"`)
+	}
+	return nil
+}
+
+func (su *SprintUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sprint.Table, sprint.Columns, sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(sprint.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Goal(); ok {
+		_spec.SetField(sprint.FieldGoal, field.TypeString, value)
+	}
+	if su.mutation.GoalCleared() {
+		_spec.ClearField(sprint.FieldGoal, field.TypeString)
+	}
+	if value, ok := su.mutation.StartAt(); ok {
+		_spec.SetField(sprint.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.EndAt(); ok {
+		_spec.SetField(sprint.FieldEndAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.State(); ok {
+		_spec.SetField(sprint.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.StartedAt(); ok {
+		_spec.SetField(sprint.FieldStartedAt, field.TypeTime, value)
+	}
+	if su.mutation.StartedAtCleared() {
+		_spec.ClearField(sprint.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.ClosedAt(); ok {
+		_spec.SetField(sprint.FieldClosedAt, field.TypeTime, value)
+	}
+	if su.mutation.ClosedAtCleared() {
+		_spec.ClearField(sprint.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.CommittedTaskIds(); ok {
+		_spec.SetField(sprint.FieldCommittedTaskIds, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedCommittedTaskIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sprint.FieldCommittedTaskIds, value)
+		})
+	}
+	if su.mutation.CommittedTaskIdsCleared() {
+		_spec.ClearField(sprint.FieldCommittedTaskIds, field.TypeJSON)
+	}
+	if value, ok := su.mutation.CompletedTaskIds(); ok {
+		_spec.SetField(sprint.FieldCompletedTaskIds, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedCompletedTaskIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sprint.FieldCompletedTaskIds, value)
+		})
+	}
+	if su.mutation.CompletedTaskIdsCleared() {
+		_spec.ClearField(sprint.FieldCompletedTaskIds, field.TypeJSON)
+	}
+	if value, ok := su.mutation.IncompleteTaskIds(); ok {
+		_spec.SetField(sprint.FieldIncompleteTaskIds, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedIncompleteTaskIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sprint.FieldIncompleteTaskIds, value)
+		})
+	}
+	if su.mutation.IncompleteTaskIdsCleared() {
+		_spec.ClearField(sprint.FieldIncompleteTaskIds, field.TypeJSON)
+	}
+	if value, ok := su.mutation.CreatorID(); ok {
+		_spec.SetField(sprint.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedCreatorID(); ok {
+		_spec.AddField(sprint.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(sprint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.ProjectTable,
+			Columns: []string{sprint.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.ProjectTable,
+			Columns: []string{sprint.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedTasksIDs(); len(nodes) > 0 && !su.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sprint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SprintUpdateOne is the builder for updating a single Sprint entity.
+type SprintUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SprintMutation
+}
+
+// SetProjectID sets the "project_id" field.
+func (suo *SprintUpdateOne) SetProjectID(i int) *SprintUpdateOne {
+	suo.mutation.SetProjectID(i)
+	return suo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableProjectID(i *int) *SprintUpdateOne {
+	if i != nil {
+		suo.SetProjectID(*i)
+	}
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *SprintUpdateOne) SetName(s string) *SprintUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableName(s *string) *SprintUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetGoal sets the "goal" field.
+func (suo *SprintUpdateOne) SetGoal(s string) *SprintUpdateOne {
+	suo.mutation.SetGoal(s)
+	return suo
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableGoal(s *string) *SprintUpdateOne {
+	if s != nil {
+		suo.SetGoal(*s)
+	}
+	return suo
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (suo *SprintUpdateOne) ClearGoal() *SprintUpdateOne {
+	suo.mutation.ClearGoal()
+	return suo
+}
+
+// SetStartAt sets the "start_at" field.
+func (suo *SprintUpdateOne) SetStartAt(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetStartAt(t)
+	return suo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableStartAt(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetStartAt(*t)
+	}
+	return suo
+}
+
+// SetEndAt sets the "end_at" field.
+func (suo *SprintUpdateOne) SetEndAt(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetEndAt(t)
+	return suo
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableEndAt(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetEndAt(*t)
+	}
+	return suo
+}
+
+// SetState sets the "state" field.
+func (suo *SprintUpdateOne) SetState(s sprint.State) *SprintUpdateOne {
+	suo.mutation.SetState(s)
+	return suo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableState(s *sprint.State) *SprintUpdateOne {
+	if s != nil {
+		suo.SetState(*s)
+	}
+	return suo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (suo *SprintUpdateOne) SetStartedAt(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetStartedAt(t)
+	return suo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableStartedAt(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetStartedAt(*t)
+	}
+	return suo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (suo *SprintUpdateOne) ClearStartedAt() *SprintUpdateOne {
+	suo.mutation.ClearStartedAt()
+	return suo
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (suo *SprintUpdateOne) SetClosedAt(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetClosedAt(t)
+	return suo
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableClosedAt(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetClosedAt(*t)
+	}
+	return suo
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (suo *SprintUpdateOne) ClearClosedAt() *SprintUpdateOne {
+	suo.mutation.ClearClosedAt()
+	return suo
+}
+
+// SetCommittedTaskIds sets the "committed_task_ids" field.
+func (suo *SprintUpdateOne) SetCommittedTaskIds(i []int) *SprintUpdateOne {
+	suo.mutation.SetCommittedTaskIds(i)
+	return suo
+}
+
+// AppendCommittedTaskIds appends i to the "committed_task_ids" field.
+func (suo *SprintUpdateOne) AppendCommittedTaskIds(i []int) *SprintUpdateOne {
+	suo.mutation.AppendCommittedTaskIds(i)
+	return suo
+}
+
+// ClearCommittedTaskIds clears the value of the "committed_task_ids" field.
+func (suo *SprintUpdateOne) ClearCommittedTaskIds() *SprintUpdateOne {
+	suo.mutation.ClearCommittedTaskIds()
+	return suo
+}
+
+// SetCompletedTaskIds sets the "completed_task_ids" field.
+func (suo *SprintUpdateOne) SetCompletedTaskIds(i []int) *SprintUpdateOne {
+	suo.mutation.SetCompletedTaskIds(i)
+	return suo
+}
+
+// AppendCompletedTaskIds appends i to the "completed_task_ids" field.
+func (suo *SprintUpdateOne) AppendCompletedTaskIds(i []int) *SprintUpdateOne {
+	suo.mutation.AppendCompletedTaskIds(i)
+	return suo
+}
+
+// ClearCompletedTaskIds clears the value of the "completed_task_ids" field.
+func (suo *SprintUpdateOne) ClearCompletedTaskIds() *SprintUpdateOne {
+	suo.mutation.ClearCompletedTaskIds()
+	return suo
+}
+
+// SetIncompleteTaskIds sets the "incomplete_task_ids" field.
+func (suo *SprintUpdateOne) SetIncompleteTaskIds(i []int) *SprintUpdateOne {
+	suo.mutation.SetIncompleteTaskIds(i)
+	return suo
+}
+
+// AppendIncompleteTaskIds appends i to the "incomplete_task_ids" field.
+func (suo *SprintUpdateOne) AppendIncompleteTaskIds(i []int) *SprintUpdateOne {
+	suo.mutation.AppendIncompleteTaskIds(i)
+	return suo
+}
+
+// ClearIncompleteTaskIds clears the value of the "incomplete_task_ids" field.
+func (suo *SprintUpdateOne) ClearIncompleteTaskIds() *SprintUpdateOne {
+	suo.mutation.ClearIncompleteTaskIds()
+	return suo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (suo *SprintUpdateOne) SetCreatorID(i int) *SprintUpdateOne {
+	suo.mutation.ResetCreatorID()
+	suo.mutation.SetCreatorID(i)
+	return suo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableCreatorID(i *int) *SprintUpdateOne {
+	if i != nil {
+		suo.SetCreatorID(*i)
+	}
+	return suo
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (suo *SprintUpdateOne) AddCreatorID(i int) *SprintUpdateOne {
+	suo.mutation.AddCreatorID(i)
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SprintUpdateOne) SetUpdatedAt(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (suo *SprintUpdateOne) SetProject(p *Project) *SprintUpdateOne {
+	return suo.SetProjectID(p.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (suo *SprintUpdateOne) AddTaskIDs(ids ...int) *SprintUpdateOne {
+	suo.mutation.AddTaskIDs(ids...)
+	return suo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (suo *SprintUpdateOne) AddTasks(t ...*Task) *SprintUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.AddTaskIDs(ids...)
+}
+
+// Mutation returns the SprintMutation object of the builder.
+func (suo *SprintUpdateOne) Mutation() *SprintMutation {
+	return suo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (suo *SprintUpdateOne) ClearProject() *SprintUpdateOne {
+	suo.mutation.ClearProject()
+	return suo
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (suo *SprintUpdateOne) ClearTasks() *SprintUpdateOne {
+	suo.mutation.ClearTasks()
+	return suo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (suo *SprintUpdateOne) RemoveTaskIDs(ids ...int) *SprintUpdateOne {
+	suo.mutation.RemoveTaskIDs(ids...)
+	return suo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (suo *SprintUpdateOne) RemoveTasks(t ...*Task) *SprintUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the SprintUpdate builder.
+func (suo *SprintUpdateOne) Where(ps ...predicate.Sprint) *SprintUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SprintUpdateOne) Select(field string, fields ...string) *SprintUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Sprint entity.
+func (suo *SprintUpdateOne) Save(ctx context.Context) (*Sprint, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SprintUpdateOne) SaveX(ctx context.Context) *Sprint {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SprintUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SprintUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SprintUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := sprint.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SprintUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := sprint.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Sprint.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.State(); ok {
+		if err := sprint.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Sprint.state": %w`, err)}
+		}
+	}
+	if suo.mutation.ProjectCleared() && len(suo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sprint.project"`)
+	}
+	return nil
+}
+
+func (suo *SprintUpdateOne) sqlSave(ctx context.Context) (_node *Sprint, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sprint.Table, sprint.Columns, sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Sprint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sprint.FieldID)
+		for _, f := range fields {
+			if !sprint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sprint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(sprint.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Goal(); ok {
+		_spec.SetField(sprint.FieldGoal, field.TypeString, value)
+	}
+	if suo.mutation.GoalCleared() {
+		_spec.ClearField(sprint.FieldGoal, field.TypeString)
+	}
+	if value, ok := suo.mutation.StartAt(); ok {
+		_spec.SetField(sprint.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.EndAt(); ok {
+		_spec.SetField(sprint.FieldEndAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.State(); ok {
+		_spec.SetField(sprint.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.StartedAt(); ok {
+		_spec.SetField(sprint.FieldStartedAt, field.TypeTime, value)
+	}
+	if suo.mutation.StartedAtCleared() {
+		_spec.ClearField(sprint.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.ClosedAt(); ok {
+		_spec.SetField(sprint.FieldClosedAt, field.TypeTime, value)
+	}
+	if suo.mutation.ClosedAtCleared() {
+		_spec.ClearField(sprint.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CommittedTaskIds(); ok {
+		_spec.SetField(sprint.FieldCommittedTaskIds, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedCommittedTaskIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sprint.FieldCommittedTaskIds, value)
+		})
+	}
+	if suo.mutation.CommittedTaskIdsCleared() {
+		_spec.ClearField(sprint.FieldCommittedTaskIds, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.CompletedTaskIds(); ok {
+		_spec.SetField(sprint.FieldCompletedTaskIds, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedCompletedTaskIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sprint.FieldCompletedTaskIds, value)
+		})
+	}
+	if suo.mutation.CompletedTaskIdsCleared() {
+		_spec.ClearField(sprint.FieldCompletedTaskIds, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.IncompleteTaskIds(); ok {
+		_spec.SetField(sprint.FieldIncompleteTaskIds, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedIncompleteTaskIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sprint.FieldIncompleteTaskIds, value)
+		})
+	}
+	if suo.mutation.IncompleteTaskIdsCleared() {
+		_spec.ClearField(sprint.FieldIncompleteTaskIds, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.CreatorID(); ok {
+		_spec.SetField(sprint.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedCreatorID(); ok {
+		_spec.AddField(sprint.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(sprint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.ProjectTable,
+			Columns: []string{sprint.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.ProjectTable,
+			Columns: []string{sprint.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !suo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Sprint{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sprint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
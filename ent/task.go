@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
 )
 
@@ -44,6 +46,26 @@ type Task struct {
 	UpdatedAt time.Time `json:"updated_at"`
 	// Type holds the value of the "type" field.
 	Type task.Type `json:"type"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID int `json:"parent_id"`
+	// OriginalEstimate holds the value of the "original_estimate" field.
+	OriginalEstimate *int `json:"original_estimate"`
+	// RemainingEstimate holds the value of the "remaining_estimate" field.
+	RemainingEstimate *int `json:"remaining_estimate"`
+	// WorkflowStatus holds the value of the "workflow_status" field.
+	WorkflowStatus string `json:"workflow_status"`
+	// Rank holds the value of the "rank" field.
+	Rank string `json:"rank"`
+	// SprintID holds the value of the "sprint_id" field.
+	SprintID int `json:"sprint_id"`
+	// MilestoneID holds the value of the "milestone_id" field.
+	MilestoneID int `json:"milestone_id"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at"`
+	// RecurrenceRunID holds the value of the "recurrence_run_id" field.
+	RecurrenceRunID *int `json:"recurrence_run_id"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -60,9 +82,33 @@ type TaskEdges struct {
 	Assignees []*Employee `json:"assignees"`
 	// Reports holds the value of the reports edge.
 	Reports []*TaskReport `json:"reports"`
+	// OvertimeRequests holds the value of the overtime_requests edge.
+	OvertimeRequests []*OvertimeRequest `json:"overtime_requests"`
+	// Children holds the value of the children edge.
+	Children []*Task `json:"children"`
+	// Parent holds the value of the parent edge.
+	Parent *Task `json:"parent"`
+	// Dependencies holds the value of the dependencies edge.
+	Dependencies []*TaskDependency `json:"dependencies"`
+	// Dependents holds the value of the dependents edge.
+	Dependents []*TaskDependency `json:"dependents"`
+	// Comments holds the value of the comments edge.
+	Comments []*TaskComment `json:"comments"`
+	// WorkLogs holds the value of the work_logs edge.
+	WorkLogs []*WorkLog `json:"work_logs"`
+	// Sprint holds the value of the sprint edge.
+	Sprint *Sprint `json:"sprint"`
+	// Milestone holds the value of the milestone edge.
+	Milestone *Milestone `json:"milestone"`
+	// Activities holds the value of the activities edge.
+	Activities []*TaskActivity `json:"activities"`
+	// Watchers holds the value of the watchers edge.
+	Watchers []*TaskWatcher `json:"watchers"`
+	// Reminders holds the value of the reminders edge.
+	Reminders []*TaskReminder `json:"reminders"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [16]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -103,16 +149,130 @@ func (e TaskEdges) ReportsOrErr() ([]*TaskReport, error) {
 	return nil, &NotLoadedError{edge: "reports"}
 }
 
+// OvertimeRequestsOrErr returns the OvertimeRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) OvertimeRequestsOrErr() ([]*OvertimeRequest, error) {
+	if e.loadedTypes[4] {
+		return e.OvertimeRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "overtime_requests"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ChildrenOrErr() ([]*Task, error) {
+	if e.loadedTypes[5] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) ParentOrErr() (*Task, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// DependenciesOrErr returns the Dependencies value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) DependenciesOrErr() ([]*TaskDependency, error) {
+	if e.loadedTypes[7] {
+		return e.Dependencies, nil
+	}
+	return nil, &NotLoadedError{edge: "dependencies"}
+}
+
+// DependentsOrErr returns the Dependents value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) DependentsOrErr() ([]*TaskDependency, error) {
+	if e.loadedTypes[8] {
+		return e.Dependents, nil
+	}
+	return nil, &NotLoadedError{edge: "dependents"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) CommentsOrErr() ([]*TaskComment, error) {
+	if e.loadedTypes[9] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// WorkLogsOrErr returns the WorkLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) WorkLogsOrErr() ([]*WorkLog, error) {
+	if e.loadedTypes[10] {
+		return e.WorkLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "work_logs"}
+}
+
+// SprintOrErr returns the Sprint value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) SprintOrErr() (*Sprint, error) {
+	if e.Sprint != nil {
+		return e.Sprint, nil
+	} else if e.loadedTypes[11] {
+		return nil, &NotFoundError{label: sprint.Label}
+	}
+	return nil, &NotLoadedError{edge: "sprint"}
+}
+
+// MilestoneOrErr returns the Milestone value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) MilestoneOrErr() (*Milestone, error) {
+	if e.Milestone != nil {
+		return e.Milestone, nil
+	} else if e.loadedTypes[12] {
+		return nil, &NotFoundError{label: milestone.Label}
+	}
+	return nil, &NotLoadedError{edge: "milestone"}
+}
+
+// ActivitiesOrErr returns the Activities value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ActivitiesOrErr() ([]*TaskActivity, error) {
+	if e.loadedTypes[13] {
+		return e.Activities, nil
+	}
+	return nil, &NotLoadedError{edge: "activities"}
+}
+
+// WatchersOrErr returns the Watchers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) WatchersOrErr() ([]*TaskWatcher, error) {
+	if e.loadedTypes[14] {
+		return e.Watchers, nil
+	}
+	return nil, &NotLoadedError{edge: "watchers"}
+}
+
+// RemindersOrErr returns the Reminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) RemindersOrErr() ([]*TaskReminder, error) {
+	if e.loadedTypes[15] {
+		return e.Reminders, nil
+	}
+	return nil, &NotLoadedError{edge: "reminders"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldID, task.FieldProcess, task.FieldProjectID, task.FieldCreatorID, task.FieldUpdaterID:
+		case task.FieldID, task.FieldProcess, task.FieldProjectID, task.FieldCreatorID, task.FieldUpdaterID, task.FieldParentID, task.FieldOriginalEstimate, task.FieldRemainingEstimate, task.FieldSprintID, task.FieldMilestoneID, task.FieldRecurrenceRunID, task.FieldOrgID:
 			values[i] = new(sql.NullInt64)
-		case task.FieldName, task.FieldCode, task.FieldDescription, task.FieldStatus, task.FieldType:
+		case task.FieldName, task.FieldCode, task.FieldDescription, task.FieldStatus, task.FieldType, task.FieldWorkflowStatus, task.FieldRank:
 			values[i] = new(sql.NullString)
-		case task.FieldStartAt, task.FieldDueDate, task.FieldCreatedAt, task.FieldUpdatedAt:
+		case task.FieldStartAt, task.FieldDueDate, task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldCompletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -215,6 +375,70 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Type = task.Type(value.String)
 			}
+		case task.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				t.ParentID = int(value.Int64)
+			}
+		case task.FieldOriginalEstimate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_estimate", values[i])
+			} else if value.Valid {
+				t.OriginalEstimate = new(int)
+				*t.OriginalEstimate = int(value.Int64)
+			}
+		case task.FieldRemainingEstimate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining_estimate", values[i])
+			} else if value.Valid {
+				t.RemainingEstimate = new(int)
+				*t.RemainingEstimate = int(value.Int64)
+			}
+		case task.FieldWorkflowStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_status", values[i])
+			} else if value.Valid {
+				t.WorkflowStatus = value.String
+			}
+		case task.FieldRank:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				t.Rank = value.String
+			}
+		case task.FieldSprintID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sprint_id", values[i])
+			} else if value.Valid {
+				t.SprintID = int(value.Int64)
+			}
+		case task.FieldMilestoneID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone_id", values[i])
+			} else if value.Valid {
+				t.MilestoneID = int(value.Int64)
+			}
+		case task.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				t.CompletedAt = new(time.Time)
+				*t.CompletedAt = value.Time
+			}
+		case task.FieldRecurrenceRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_run_id", values[i])
+			} else if value.Valid {
+				t.RecurrenceRunID = new(int)
+				*t.RecurrenceRunID = int(value.Int64)
+			}
+		case task.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				t.OrgID = int(value.Int64)
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -248,6 +472,66 @@ func (t *Task) QueryReports() *TaskReportQuery {
 	return NewTaskClient(t.config).QueryReports(t)
 }
 
+// QueryOvertimeRequests queries the "overtime_requests" edge of the Task entity.
+func (t *Task) QueryOvertimeRequests() *OvertimeRequestQuery {
+	return NewTaskClient(t.config).QueryOvertimeRequests(t)
+}
+
+// QueryChildren queries the "children" edge of the Task entity.
+func (t *Task) QueryChildren() *TaskQuery {
+	return NewTaskClient(t.config).QueryChildren(t)
+}
+
+// QueryParent queries the "parent" edge of the Task entity.
+func (t *Task) QueryParent() *TaskQuery {
+	return NewTaskClient(t.config).QueryParent(t)
+}
+
+// QueryDependencies queries the "dependencies" edge of the Task entity.
+func (t *Task) QueryDependencies() *TaskDependencyQuery {
+	return NewTaskClient(t.config).QueryDependencies(t)
+}
+
+// QueryDependents queries the "dependents" edge of the Task entity.
+func (t *Task) QueryDependents() *TaskDependencyQuery {
+	return NewTaskClient(t.config).QueryDependents(t)
+}
+
+// QueryComments queries the "comments" edge of the Task entity.
+func (t *Task) QueryComments() *TaskCommentQuery {
+	return NewTaskClient(t.config).QueryComments(t)
+}
+
+// QueryWorkLogs queries the "work_logs" edge of the Task entity.
+func (t *Task) QueryWorkLogs() *WorkLogQuery {
+	return NewTaskClient(t.config).QueryWorkLogs(t)
+}
+
+// QuerySprint queries the "sprint" edge of the Task entity.
+func (t *Task) QuerySprint() *SprintQuery {
+	return NewTaskClient(t.config).QuerySprint(t)
+}
+
+// QueryMilestone queries the "milestone" edge of the Task entity.
+func (t *Task) QueryMilestone() *MilestoneQuery {
+	return NewTaskClient(t.config).QueryMilestone(t)
+}
+
+// QueryActivities queries the "activities" edge of the Task entity.
+func (t *Task) QueryActivities() *TaskActivityQuery {
+	return NewTaskClient(t.config).QueryActivities(t)
+}
+
+// QueryWatchers queries the "watchers" edge of the Task entity.
+func (t *Task) QueryWatchers() *TaskWatcherQuery {
+	return NewTaskClient(t.config).QueryWatchers(t)
+}
+
+// QueryReminders queries the "reminders" edge of the Task entity.
+func (t *Task) QueryReminders() *TaskReminderQuery {
+	return NewTaskClient(t.config).QueryReminders(t)
+}
+
 // Update returns a builder for updating this Task.
 // Note that you need to call Task.Unwrap() before calling this method if this Task
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -313,6 +597,44 @@ func (t *Task) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", t.Type))
+	builder.WriteString(", ")
+	builder.WriteString("parent_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.ParentID))
+	builder.WriteString(", ")
+	if v := t.OriginalEstimate; v != nil {
+		builder.WriteString("original_estimate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.RemainingEstimate; v != nil {
+		builder.WriteString("remaining_estimate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("workflow_status=")
+	builder.WriteString(t.WorkflowStatus)
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(t.Rank)
+	builder.WriteString(", ")
+	builder.WriteString("sprint_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.SprintID))
+	builder.WriteString(", ")
+	builder.WriteString("milestone_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.MilestoneID))
+	builder.WriteString(", ")
+	if v := t.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.RecurrenceRunID; v != nil {
+		builder.WriteString("recurrence_run_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.OrgID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -41,6 +41,26 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldOriginalEstimate holds the string denoting the original_estimate field in the database.
+	FieldOriginalEstimate = "original_estimate"
+	// FieldRemainingEstimate holds the string denoting the remaining_estimate field in the database.
+	FieldRemainingEstimate = "remaining_estimate"
+	// FieldWorkflowStatus holds the string denoting the workflow_status field in the database.
+	FieldWorkflowStatus = "workflow_status"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
+	// FieldSprintID holds the string denoting the sprint_id field in the database.
+	FieldSprintID = "sprint_id"
+	// FieldMilestoneID holds the string denoting the milestone_id field in the database.
+	FieldMilestoneID = "milestone_id"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldRecurrenceRunID holds the string denoting the recurrence_run_id field in the database.
+	FieldRecurrenceRunID = "recurrence_run_id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
 	// EdgeProject holds the string denoting the project edge name in mutations.
 	EdgeProject = "project"
 	// EdgeLabels holds the string denoting the labels edge name in mutations.
@@ -49,6 +69,30 @@ const (
 	EdgeAssignees = "assignees"
 	// EdgeReports holds the string denoting the reports edge name in mutations.
 	EdgeReports = "reports"
+	// EdgeOvertimeRequests holds the string denoting the overtime_requests edge name in mutations.
+	EdgeOvertimeRequests = "overtime_requests"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeDependencies holds the string denoting the dependencies edge name in mutations.
+	EdgeDependencies = "dependencies"
+	// EdgeDependents holds the string denoting the dependents edge name in mutations.
+	EdgeDependents = "dependents"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeWorkLogs holds the string denoting the work_logs edge name in mutations.
+	EdgeWorkLogs = "work_logs"
+	// EdgeSprint holds the string denoting the sprint edge name in mutations.
+	EdgeSprint = "sprint"
+	// EdgeMilestone holds the string denoting the milestone edge name in mutations.
+	EdgeMilestone = "milestone"
+	// EdgeActivities holds the string denoting the activities edge name in mutations.
+	EdgeActivities = "activities"
+	// EdgeWatchers holds the string denoting the watchers edge name in mutations.
+	EdgeWatchers = "watchers"
+	// EdgeReminders holds the string denoting the reminders edge name in mutations.
+	EdgeReminders = "reminders"
 	// Table holds the table name of the task in the database.
 	Table = "tasks"
 	// ProjectTable is the table that holds the project relation/edge.
@@ -75,6 +119,84 @@ const (
 	ReportsInverseTable = "task_reports"
 	// ReportsColumn is the table column denoting the reports relation/edge.
 	ReportsColumn = "task_id"
+	// OvertimeRequestsTable is the table that holds the overtime_requests relation/edge.
+	OvertimeRequestsTable = "overtime_requests"
+	// OvertimeRequestsInverseTable is the table name for the OvertimeRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "overtimerequest" package.
+	OvertimeRequestsInverseTable = "overtime_requests"
+	// OvertimeRequestsColumn is the table column denoting the overtime_requests relation/edge.
+	OvertimeRequestsColumn = "task_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "tasks"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "tasks"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// DependenciesTable is the table that holds the dependencies relation/edge.
+	DependenciesTable = "task_dependencies"
+	// DependenciesInverseTable is the table name for the TaskDependency entity.
+	// It exists in this package in order to avoid circular dependency with the "taskdependency" package.
+	DependenciesInverseTable = "task_dependencies"
+	// DependenciesColumn is the table column denoting the dependencies relation/edge.
+	DependenciesColumn = "task_id"
+	// DependentsTable is the table that holds the dependents relation/edge.
+	DependentsTable = "task_dependencies"
+	// DependentsInverseTable is the table name for the TaskDependency entity.
+	// It exists in this package in order to avoid circular dependency with the "taskdependency" package.
+	DependentsInverseTable = "task_dependencies"
+	// DependentsColumn is the table column denoting the dependents relation/edge.
+	DependentsColumn = "target_id"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "task_comments"
+	// CommentsInverseTable is the table name for the TaskComment entity.
+	// It exists in this package in order to avoid circular dependency with the "taskcomment" package.
+	CommentsInverseTable = "task_comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "task_id"
+	// WorkLogsTable is the table that holds the work_logs relation/edge.
+	WorkLogsTable = "work_logs"
+	// WorkLogsInverseTable is the table name for the WorkLog entity.
+	// It exists in this package in order to avoid circular dependency with the "worklog" package.
+	WorkLogsInverseTable = "work_logs"
+	// WorkLogsColumn is the table column denoting the work_logs relation/edge.
+	WorkLogsColumn = "task_id"
+	// SprintTable is the table that holds the sprint relation/edge.
+	SprintTable = "tasks"
+	// SprintInverseTable is the table name for the Sprint entity.
+	// It exists in this package in order to avoid circular dependency with the "sprint" package.
+	SprintInverseTable = "sprints"
+	// SprintColumn is the table column denoting the sprint relation/edge.
+	SprintColumn = "sprint_id"
+	// MilestoneTable is the table that holds the milestone relation/edge.
+	MilestoneTable = "tasks"
+	// MilestoneInverseTable is the table name for the Milestone entity.
+	// It exists in this package in order to avoid circular dependency with the "milestone" package.
+	MilestoneInverseTable = "milestones"
+	// MilestoneColumn is the table column denoting the milestone relation/edge.
+	MilestoneColumn = "milestone_id"
+	// ActivitiesTable is the table that holds the activities relation/edge.
+	ActivitiesTable = "task_activities"
+	// ActivitiesInverseTable is the table name for the TaskActivity entity.
+	// It exists in this package in order to avoid circular dependency with the "taskactivity" package.
+	ActivitiesInverseTable = "task_activities"
+	// ActivitiesColumn is the table column denoting the activities relation/edge.
+	ActivitiesColumn = "task_id"
+	// WatchersTable is the table that holds the watchers relation/edge.
+	WatchersTable = "task_watchers"
+	// WatchersInverseTable is the table name for the TaskWatcher entity.
+	// It exists in this package in order to avoid circular dependency with the "taskwatcher" package.
+	WatchersInverseTable = "task_watchers"
+	// WatchersColumn is the table column denoting the watchers relation/edge.
+	WatchersColumn = "task_id"
+	// RemindersTable is the table that holds the reminders relation/edge.
+	RemindersTable = "task_reminders"
+	// RemindersInverseTable is the table name for the TaskReminder entity.
+	// It exists in this package in order to avoid circular dependency with the "taskreminder" package.
+	RemindersInverseTable = "task_reminders"
+	// RemindersColumn is the table column denoting the reminders relation/edge.
+	RemindersColumn = "task_id"
 )
 
 // Columns holds all SQL columns for task fields.
@@ -93,6 +215,16 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldType,
+	FieldParentID,
+	FieldOriginalEstimate,
+	FieldRemainingEstimate,
+	FieldWorkflowStatus,
+	FieldRank,
+	FieldSprintID,
+	FieldMilestoneID,
+	FieldCompletedAt,
+	FieldRecurrenceRunID,
+	FieldOrgID,
 }
 
 var (
@@ -123,6 +255,14 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// OriginalEstimateValidator is a validator for the "original_estimate" field. It is called by the builders before save.
+	OriginalEstimateValidator func(int) error
+	// RemainingEstimateValidator is a validator for the "remaining_estimate" field. It is called by the builders before save.
+	RemainingEstimateValidator func(int) error
+	// DefaultWorkflowStatus holds the default value on creation for the "workflow_status" field.
+	DefaultWorkflowStatus string
+	// DefaultRank holds the default value on creation for the "rank" field.
+	DefaultRank string
 )
 
 // Status defines the type for the "status" enum field.
@@ -255,6 +395,56 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// ByOriginalEstimate orders the results by the original_estimate field.
+func ByOriginalEstimate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalEstimate, opts...).ToFunc()
+}
+
+// ByRemainingEstimate orders the results by the remaining_estimate field.
+func ByRemainingEstimate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemainingEstimate, opts...).ToFunc()
+}
+
+// ByWorkflowStatus orders the results by the workflow_status field.
+func ByWorkflowStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowStatus, opts...).ToFunc()
+}
+
+// ByRank orders the results by the rank field.
+func ByRank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRank, opts...).ToFunc()
+}
+
+// BySprintID orders the results by the sprint_id field.
+func BySprintID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSprintID, opts...).ToFunc()
+}
+
+// ByMilestoneID orders the results by the milestone_id field.
+func ByMilestoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMilestoneID, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByRecurrenceRunID orders the results by the recurrence_run_id field.
+func ByRecurrenceRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrenceRunID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
 // ByProjectField orders the results by project field.
 func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -303,6 +493,153 @@ func ByReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOvertimeRequestsCount orders the results by overtime_requests count.
+func ByOvertimeRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOvertimeRequestsStep(), opts...)
+	}
+}
+
+// ByOvertimeRequests orders the results by overtime_requests terms.
+func ByOvertimeRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOvertimeRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDependenciesCount orders the results by dependencies count.
+func ByDependenciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDependenciesStep(), opts...)
+	}
+}
+
+// ByDependencies orders the results by dependencies terms.
+func ByDependencies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDependenciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDependentsCount orders the results by dependents count.
+func ByDependentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDependentsStep(), opts...)
+	}
+}
+
+// ByDependents orders the results by dependents terms.
+func ByDependents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDependentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWorkLogsCount orders the results by work_logs count.
+func ByWorkLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkLogsStep(), opts...)
+	}
+}
+
+// ByWorkLogs orders the results by work_logs terms.
+func ByWorkLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySprintField orders the results by sprint field.
+func BySprintField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSprintStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMilestoneField orders the results by milestone field.
+func ByMilestoneField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMilestoneStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByActivitiesCount orders the results by activities count.
+func ByActivitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActivitiesStep(), opts...)
+	}
+}
+
+// ByActivities orders the results by activities terms.
+func ByActivities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActivitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWatchersCount orders the results by watchers count.
+func ByWatchersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchersStep(), opts...)
+	}
+}
+
+// ByWatchers orders the results by watchers terms.
+func ByWatchers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRemindersCount orders the results by reminders count.
+func ByRemindersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRemindersStep(), opts...)
+	}
+}
+
+// ByReminders orders the results by reminders terms.
+func ByReminders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRemindersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProjectStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -331,3 +668,87 @@ func newReportsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ReportsTable, ReportsColumn),
 	)
 }
+func newOvertimeRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OvertimeRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OvertimeRequestsTable, OvertimeRequestsColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newDependenciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DependenciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DependenciesTable, DependenciesColumn),
+	)
+}
+func newDependentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DependentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DependentsTable, DependentsColumn),
+	)
+}
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
+func newWorkLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorkLogsTable, WorkLogsColumn),
+	)
+}
+func newSprintStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SprintInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SprintTable, SprintColumn),
+	)
+}
+func newMilestoneStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MilestoneInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MilestoneTable, MilestoneColumn),
+	)
+}
+func newActivitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActivitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+	)
+}
+func newWatchersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WatchersTable, WatchersColumn),
+	)
+}
+func newRemindersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RemindersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RemindersTable, RemindersColumn),
+	)
+}
@@ -110,6 +110,56 @@ func UpdatedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParentID, v))
+}
+
+// OriginalEstimate applies equality check predicate on the "original_estimate" field. It's identical to OriginalEstimateEQ.
+func OriginalEstimate(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOriginalEstimate, v))
+}
+
+// RemainingEstimate applies equality check predicate on the "remaining_estimate" field. It's identical to RemainingEstimateEQ.
+func RemainingEstimate(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRemainingEstimate, v))
+}
+
+// WorkflowStatus applies equality check predicate on the "workflow_status" field. It's identical to WorkflowStatusEQ.
+func WorkflowStatus(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldWorkflowStatus, v))
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRank, v))
+}
+
+// SprintID applies equality check predicate on the "sprint_id" field. It's identical to SprintIDEQ.
+func SprintID(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSprintID, v))
+}
+
+// MilestoneID applies equality check predicate on the "milestone_id" field. It's identical to MilestoneIDEQ.
+func MilestoneID(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// RecurrenceRunID applies equality check predicate on the "recurrence_run_id" field. It's identical to RecurrenceRunIDEQ.
+func RecurrenceRunID(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrenceRunID, v))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOrgID, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldName, v))
@@ -685,6 +735,466 @@ func TypeNotIn(vs ...Type) predicate.Task {
 	return predicate.Task(sql.FieldNotIn(FieldType, vs...))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldParentID))
+}
+
+// OriginalEstimateEQ applies the EQ predicate on the "original_estimate" field.
+func OriginalEstimateEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateNEQ applies the NEQ predicate on the "original_estimate" field.
+func OriginalEstimateNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateIn applies the In predicate on the "original_estimate" field.
+func OriginalEstimateIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldOriginalEstimate, vs...))
+}
+
+// OriginalEstimateNotIn applies the NotIn predicate on the "original_estimate" field.
+func OriginalEstimateNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldOriginalEstimate, vs...))
+}
+
+// OriginalEstimateGT applies the GT predicate on the "original_estimate" field.
+func OriginalEstimateGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateGTE applies the GTE predicate on the "original_estimate" field.
+func OriginalEstimateGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateLT applies the LT predicate on the "original_estimate" field.
+func OriginalEstimateLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateLTE applies the LTE predicate on the "original_estimate" field.
+func OriginalEstimateLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateIsNil applies the IsNil predicate on the "original_estimate" field.
+func OriginalEstimateIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldOriginalEstimate))
+}
+
+// OriginalEstimateNotNil applies the NotNil predicate on the "original_estimate" field.
+func OriginalEstimateNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldOriginalEstimate))
+}
+
+// RemainingEstimateEQ applies the EQ predicate on the "remaining_estimate" field.
+func RemainingEstimateEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRemainingEstimate, v))
+}
+
+// RemainingEstimateNEQ applies the NEQ predicate on the "remaining_estimate" field.
+func RemainingEstimateNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRemainingEstimate, v))
+}
+
+// RemainingEstimateIn applies the In predicate on the "remaining_estimate" field.
+func RemainingEstimateIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRemainingEstimate, vs...))
+}
+
+// RemainingEstimateNotIn applies the NotIn predicate on the "remaining_estimate" field.
+func RemainingEstimateNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRemainingEstimate, vs...))
+}
+
+// RemainingEstimateGT applies the GT predicate on the "remaining_estimate" field.
+func RemainingEstimateGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRemainingEstimate, v))
+}
+
+// RemainingEstimateGTE applies the GTE predicate on the "remaining_estimate" field.
+func RemainingEstimateGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRemainingEstimate, v))
+}
+
+// RemainingEstimateLT applies the LT predicate on the "remaining_estimate" field.
+func RemainingEstimateLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRemainingEstimate, v))
+}
+
+// RemainingEstimateLTE applies the LTE predicate on the "remaining_estimate" field.
+func RemainingEstimateLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRemainingEstimate, v))
+}
+
+// RemainingEstimateIsNil applies the IsNil predicate on the "remaining_estimate" field.
+func RemainingEstimateIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldRemainingEstimate))
+}
+
+// RemainingEstimateNotNil applies the NotNil predicate on the "remaining_estimate" field.
+func RemainingEstimateNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldRemainingEstimate))
+}
+
+// WorkflowStatusEQ applies the EQ predicate on the "workflow_status" field.
+func WorkflowStatusEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusNEQ applies the NEQ predicate on the "workflow_status" field.
+func WorkflowStatusNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusIn applies the In predicate on the "workflow_status" field.
+func WorkflowStatusIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldWorkflowStatus, vs...))
+}
+
+// WorkflowStatusNotIn applies the NotIn predicate on the "workflow_status" field.
+func WorkflowStatusNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldWorkflowStatus, vs...))
+}
+
+// WorkflowStatusGT applies the GT predicate on the "workflow_status" field.
+func WorkflowStatusGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusGTE applies the GTE predicate on the "workflow_status" field.
+func WorkflowStatusGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusLT applies the LT predicate on the "workflow_status" field.
+func WorkflowStatusLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusLTE applies the LTE predicate on the "workflow_status" field.
+func WorkflowStatusLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusContains applies the Contains predicate on the "workflow_status" field.
+func WorkflowStatusContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusHasPrefix applies the HasPrefix predicate on the "workflow_status" field.
+func WorkflowStatusHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusHasSuffix applies the HasSuffix predicate on the "workflow_status" field.
+func WorkflowStatusHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusEqualFold applies the EqualFold predicate on the "workflow_status" field.
+func WorkflowStatusEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldWorkflowStatus, v))
+}
+
+// WorkflowStatusContainsFold applies the ContainsFold predicate on the "workflow_status" field.
+func WorkflowStatusContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldWorkflowStatus, v))
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRank, v))
+}
+
+// RankContains applies the Contains predicate on the "rank" field.
+func RankContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldRank, v))
+}
+
+// RankHasPrefix applies the HasPrefix predicate on the "rank" field.
+func RankHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldRank, v))
+}
+
+// RankHasSuffix applies the HasSuffix predicate on the "rank" field.
+func RankHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldRank, v))
+}
+
+// RankEqualFold applies the EqualFold predicate on the "rank" field.
+func RankEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldRank, v))
+}
+
+// RankContainsFold applies the ContainsFold predicate on the "rank" field.
+func RankContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldRank, v))
+}
+
+// SprintIDEQ applies the EQ predicate on the "sprint_id" field.
+func SprintIDEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSprintID, v))
+}
+
+// SprintIDNEQ applies the NEQ predicate on the "sprint_id" field.
+func SprintIDNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldSprintID, v))
+}
+
+// SprintIDIn applies the In predicate on the "sprint_id" field.
+func SprintIDIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldSprintID, vs...))
+}
+
+// SprintIDNotIn applies the NotIn predicate on the "sprint_id" field.
+func SprintIDNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldSprintID, vs...))
+}
+
+// SprintIDIsNil applies the IsNil predicate on the "sprint_id" field.
+func SprintIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldSprintID))
+}
+
+// SprintIDNotNil applies the NotNil predicate on the "sprint_id" field.
+func SprintIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldSprintID))
+}
+
+// MilestoneIDEQ applies the EQ predicate on the "milestone_id" field.
+func MilestoneIDEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDNEQ applies the NEQ predicate on the "milestone_id" field.
+func MilestoneIDNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDIn applies the In predicate on the "milestone_id" field.
+func MilestoneIDIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDNotIn applies the NotIn predicate on the "milestone_id" field.
+func MilestoneIDNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDIsNil applies the IsNil predicate on the "milestone_id" field.
+func MilestoneIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldMilestoneID))
+}
+
+// MilestoneIDNotNil applies the NotNil predicate on the "milestone_id" field.
+func MilestoneIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldMilestoneID))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// RecurrenceRunIDEQ applies the EQ predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRecurrenceRunID, v))
+}
+
+// RecurrenceRunIDNEQ applies the NEQ predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRecurrenceRunID, v))
+}
+
+// RecurrenceRunIDIn applies the In predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRecurrenceRunID, vs...))
+}
+
+// RecurrenceRunIDNotIn applies the NotIn predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRecurrenceRunID, vs...))
+}
+
+// RecurrenceRunIDGT applies the GT predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRecurrenceRunID, v))
+}
+
+// RecurrenceRunIDGTE applies the GTE predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRecurrenceRunID, v))
+}
+
+// RecurrenceRunIDLT applies the LT predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRecurrenceRunID, v))
+}
+
+// RecurrenceRunIDLTE applies the LTE predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRecurrenceRunID, v))
+}
+
+// RecurrenceRunIDIsNil applies the IsNil predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldRecurrenceRunID))
+}
+
+// RecurrenceRunIDNotNil applies the NotNil predicate on the "recurrence_run_id" field.
+func RecurrenceRunIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldRecurrenceRunID))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldOrgID, v))
+}
+
 // HasProject applies the HasEdge predicate on the "project" edge.
 func HasProject() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -777,6 +1287,282 @@ func HasReportsWith(preds ...predicate.TaskReport) predicate.Task {
 	})
 }
 
+// HasOvertimeRequests applies the HasEdge predicate on the "overtime_requests" edge.
+func HasOvertimeRequests() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OvertimeRequestsTable, OvertimeRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOvertimeRequestsWith applies the HasEdge predicate on the "overtime_requests" edge with a given conditions (other predicates).
+func HasOvertimeRequestsWith(preds ...predicate.OvertimeRequest) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newOvertimeRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDependencies applies the HasEdge predicate on the "dependencies" edge.
+func HasDependencies() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DependenciesTable, DependenciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDependenciesWith applies the HasEdge predicate on the "dependencies" edge with a given conditions (other predicates).
+func HasDependenciesWith(preds ...predicate.TaskDependency) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newDependenciesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDependents applies the HasEdge predicate on the "dependents" edge.
+func HasDependents() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DependentsTable, DependentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDependentsWith applies the HasEdge predicate on the "dependents" edge with a given conditions (other predicates).
+func HasDependentsWith(preds ...predicate.TaskDependency) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newDependentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.TaskComment) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkLogs applies the HasEdge predicate on the "work_logs" edge.
+func HasWorkLogs() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WorkLogsTable, WorkLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkLogsWith applies the HasEdge predicate on the "work_logs" edge with a given conditions (other predicates).
+func HasWorkLogsWith(preds ...predicate.WorkLog) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newWorkLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSprint applies the HasEdge predicate on the "sprint" edge.
+func HasSprint() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SprintTable, SprintColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSprintWith applies the HasEdge predicate on the "sprint" edge with a given conditions (other predicates).
+func HasSprintWith(preds ...predicate.Sprint) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newSprintStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMilestone applies the HasEdge predicate on the "milestone" edge.
+func HasMilestone() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MilestoneTable, MilestoneColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMilestoneWith applies the HasEdge predicate on the "milestone" edge with a given conditions (other predicates).
+func HasMilestoneWith(preds ...predicate.Milestone) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newMilestoneStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActivities applies the HasEdge predicate on the "activities" edge.
+func HasActivities() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActivitiesWith applies the HasEdge predicate on the "activities" edge with a given conditions (other predicates).
+func HasActivitiesWith(preds ...predicate.TaskActivity) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newActivitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWatchers applies the HasEdge predicate on the "watchers" edge.
+func HasWatchers() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WatchersTable, WatchersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWatchersWith applies the HasEdge predicate on the "watchers" edge with a given conditions (other predicates).
+func HasWatchersWith(preds ...predicate.TaskWatcher) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newWatchersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReminders applies the HasEdge predicate on the "reminders" edge.
+func HasReminders() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RemindersTable, RemindersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRemindersWith applies the HasEdge predicate on the "reminders" edge with a given conditions (other predicates).
+func HasRemindersWith(preds ...predicate.TaskReminder) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newRemindersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(sql.AndPredicates(predicates...))
@@ -13,9 +13,18 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 )
 
 // TaskCreate is the builder for creating a Task entity.
@@ -176,6 +185,138 @@ func (tc *TaskCreate) SetNillableType(t *task.Type) *TaskCreate {
 	return tc
 }
 
+// SetParentID sets the "parent_id" field.
+func (tc *TaskCreate) SetParentID(i int) *TaskCreate {
+	tc.mutation.SetParentID(i)
+	return tc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableParentID(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetParentID(*i)
+	}
+	return tc
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (tc *TaskCreate) SetOriginalEstimate(i int) *TaskCreate {
+	tc.mutation.SetOriginalEstimate(i)
+	return tc
+}
+
+// SetNillableOriginalEstimate sets the "original_estimate" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableOriginalEstimate(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetOriginalEstimate(*i)
+	}
+	return tc
+}
+
+// SetRemainingEstimate sets the "remaining_estimate" field.
+func (tc *TaskCreate) SetRemainingEstimate(i int) *TaskCreate {
+	tc.mutation.SetRemainingEstimate(i)
+	return tc
+}
+
+// SetNillableRemainingEstimate sets the "remaining_estimate" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRemainingEstimate(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetRemainingEstimate(*i)
+	}
+	return tc
+}
+
+// SetWorkflowStatus sets the "workflow_status" field.
+func (tc *TaskCreate) SetWorkflowStatus(s string) *TaskCreate {
+	tc.mutation.SetWorkflowStatus(s)
+	return tc
+}
+
+// SetNillableWorkflowStatus sets the "workflow_status" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableWorkflowStatus(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetWorkflowStatus(*s)
+	}
+	return tc
+}
+
+// SetRank sets the "rank" field.
+func (tc *TaskCreate) SetRank(s string) *TaskCreate {
+	tc.mutation.SetRank(s)
+	return tc
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRank(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetRank(*s)
+	}
+	return tc
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (tc *TaskCreate) SetSprintID(i int) *TaskCreate {
+	tc.mutation.SetSprintID(i)
+	return tc
+}
+
+// SetNillableSprintID sets the "sprint_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableSprintID(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetSprintID(*i)
+	}
+	return tc
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (tc *TaskCreate) SetMilestoneID(i int) *TaskCreate {
+	tc.mutation.SetMilestoneID(i)
+	return tc
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableMilestoneID(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetMilestoneID(*i)
+	}
+	return tc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tc *TaskCreate) SetCompletedAt(t time.Time) *TaskCreate {
+	tc.mutation.SetCompletedAt(t)
+	return tc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableCompletedAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetCompletedAt(*t)
+	}
+	return tc
+}
+
+// SetRecurrenceRunID sets the "recurrence_run_id" field.
+func (tc *TaskCreate) SetRecurrenceRunID(i int) *TaskCreate {
+	tc.mutation.SetRecurrenceRunID(i)
+	return tc
+}
+
+// SetNillableRecurrenceRunID sets the "recurrence_run_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRecurrenceRunID(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetRecurrenceRunID(*i)
+	}
+	return tc
+}
+
+// SetOrgID sets the "org_id" field.
+func (tc *TaskCreate) SetOrgID(i int) *TaskCreate {
+	tc.mutation.SetOrgID(i)
+	return tc
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (tc *TaskCreate) SetProject(p *Project) *TaskCreate {
 	return tc.SetProjectID(p.ID)
@@ -226,6 +367,156 @@ func (tc *TaskCreate) AddReports(t ...*TaskReport) *TaskCreate {
 	return tc.AddReportIDs(ids...)
 }
 
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (tc *TaskCreate) AddOvertimeRequestIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddOvertimeRequestIDs(ids...)
+	return tc
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (tc *TaskCreate) AddOvertimeRequests(o ...*OvertimeRequest) *TaskCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return tc.AddOvertimeRequestIDs(ids...)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tc *TaskCreate) AddChildIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddChildIDs(ids...)
+	return tc
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tc *TaskCreate) AddChildren(t ...*Task) *TaskCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddChildIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Task entity.
+func (tc *TaskCreate) SetParent(t *Task) *TaskCreate {
+	return tc.SetParentID(t.ID)
+}
+
+// AddDependencyIDs adds the "dependencies" edge to the TaskDependency entity by IDs.
+func (tc *TaskCreate) AddDependencyIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddDependencyIDs(ids...)
+	return tc
+}
+
+// AddDependencies adds the "dependencies" edges to the TaskDependency entity.
+func (tc *TaskCreate) AddDependencies(t ...*TaskDependency) *TaskCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddDependencyIDs(ids...)
+}
+
+// AddDependentIDs adds the "dependents" edge to the TaskDependency entity by IDs.
+func (tc *TaskCreate) AddDependentIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddDependentIDs(ids...)
+	return tc
+}
+
+// AddDependents adds the "dependents" edges to the TaskDependency entity.
+func (tc *TaskCreate) AddDependents(t ...*TaskDependency) *TaskCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddDependentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the TaskComment entity by IDs.
+func (tc *TaskCreate) AddCommentIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddCommentIDs(ids...)
+	return tc
+}
+
+// AddComments adds the "comments" edges to the TaskComment entity.
+func (tc *TaskCreate) AddComments(t ...*TaskComment) *TaskCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddCommentIDs(ids...)
+}
+
+// AddWorkLogIDs adds the "work_logs" edge to the WorkLog entity by IDs.
+func (tc *TaskCreate) AddWorkLogIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddWorkLogIDs(ids...)
+	return tc
+}
+
+// AddWorkLogs adds the "work_logs" edges to the WorkLog entity.
+func (tc *TaskCreate) AddWorkLogs(w ...*WorkLog) *TaskCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tc.AddWorkLogIDs(ids...)
+}
+
+// SetSprint sets the "sprint" edge to the Sprint entity.
+func (tc *TaskCreate) SetSprint(s *Sprint) *TaskCreate {
+	return tc.SetSprintID(s.ID)
+}
+
+// SetMilestone sets the "milestone" edge to the Milestone entity.
+func (tc *TaskCreate) SetMilestone(m *Milestone) *TaskCreate {
+	return tc.SetMilestoneID(m.ID)
+}
+
+// AddActivityIDs adds the "activities" edge to the TaskActivity entity by IDs.
+func (tc *TaskCreate) AddActivityIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddActivityIDs(ids...)
+	return tc
+}
+
+// AddActivities adds the "activities" edges to the TaskActivity entity.
+func (tc *TaskCreate) AddActivities(t ...*TaskActivity) *TaskCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddActivityIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the TaskWatcher entity by IDs.
+func (tc *TaskCreate) AddWatcherIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddWatcherIDs(ids...)
+	return tc
+}
+
+// AddWatchers adds the "watchers" edges to the TaskWatcher entity.
+func (tc *TaskCreate) AddWatchers(t ...*TaskWatcher) *TaskCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddWatcherIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the TaskReminder entity by IDs.
+func (tc *TaskCreate) AddReminderIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddReminderIDs(ids...)
+	return tc
+}
+
+// AddReminders adds the "reminders" edges to the TaskReminder entity.
+func (tc *TaskCreate) AddReminders(t ...*TaskReminder) *TaskCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddReminderIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tc *TaskCreate) Mutation() *TaskMutation {
 	return tc.mutation
@@ -281,6 +572,14 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultType
 		tc.mutation.SetType(v)
 	}
+	if _, ok := tc.mutation.WorkflowStatus(); !ok {
+		v := task.DefaultWorkflowStatus
+		tc.mutation.SetWorkflowStatus(v)
+	}
+	if _, ok := tc.mutation.Rank(); !ok {
+		v := task.DefaultRank
+		tc.mutation.SetRank(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -322,6 +621,25 @@ func (tc *TaskCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Task.type": %w`, err)}
 		}
 	}
+	if v, ok := tc.mutation.OriginalEstimate(); ok {
+		if err := task.OriginalEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "original_estimate", err: fmt.Errorf(`ent: validator failed for field "Task.original_estimate": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.RemainingEstimate(); ok {
+		if err := task.RemainingEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "remaining_estimate", err: fmt.Errorf(`ent: validator failed for field "Task.remaining_estimate": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.WorkflowStatus(); !ok {
+		return &ValidationError{Name: "workflow_status", err: errors.New(`ent: missing required field "Task.workflow_status"`)}
+	}
+	if _, ok := tc.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "Task.rank"`)}
+	}
+	if _, ok := tc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "Task.org_id"`)}
+	}
 	return nil
 }
 
@@ -397,6 +715,34 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldType, field.TypeEnum, value)
 		_node.Type = value
 	}
+	if value, ok := tc.mutation.OriginalEstimate(); ok {
+		_spec.SetField(task.FieldOriginalEstimate, field.TypeInt, value)
+		_node.OriginalEstimate = &value
+	}
+	if value, ok := tc.mutation.RemainingEstimate(); ok {
+		_spec.SetField(task.FieldRemainingEstimate, field.TypeInt, value)
+		_node.RemainingEstimate = &value
+	}
+	if value, ok := tc.mutation.WorkflowStatus(); ok {
+		_spec.SetField(task.FieldWorkflowStatus, field.TypeString, value)
+		_node.WorkflowStatus = value
+	}
+	if value, ok := tc.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+		_node.Rank = value
+	}
+	if value, ok := tc.mutation.CompletedAt(); ok {
+		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := tc.mutation.RecurrenceRunID(); ok {
+		_spec.SetField(task.FieldRecurrenceRunID, field.TypeInt, value)
+		_node.RecurrenceRunID = &value
+	}
+	if value, ok := tc.mutation.OrgID(); ok {
+		_spec.SetField(task.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
 	if nodes := tc.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -462,6 +808,201 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.OvertimeRequestsTable,
+			Columns: []string{task.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.DependenciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependenciesTable,
+			Columns: []string{task.DependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependentsTable,
+			Columns: []string{task.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.WorkLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorkLogsTable,
+			Columns: []string{task.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.SprintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SprintID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.MilestoneIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MilestoneID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -688,15 +1229,201 @@ func (u *TaskUpsert) UpdateUpdatedAt() *TaskUpsert {
 	return u
 }
 
-// SetType sets the "type" field.
-func (u *TaskUpsert) SetType(v task.Type) *TaskUpsert {
-	u.Set(task.FieldType, v)
+// SetType sets the "type" field.
+func (u *TaskUpsert) SetType(v task.Type) *TaskUpsert {
+	u.Set(task.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateType() *TaskUpsert {
+	u.SetExcluded(task.FieldType)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *TaskUpsert) SetParentID(v int) *TaskUpsert {
+	u.Set(task.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateParentID() *TaskUpsert {
+	u.SetExcluded(task.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TaskUpsert) ClearParentID() *TaskUpsert {
+	u.SetNull(task.FieldParentID)
+	return u
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (u *TaskUpsert) SetOriginalEstimate(v int) *TaskUpsert {
+	u.Set(task.FieldOriginalEstimate, v)
+	return u
+}
+
+// UpdateOriginalEstimate sets the "original_estimate" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateOriginalEstimate() *TaskUpsert {
+	u.SetExcluded(task.FieldOriginalEstimate)
+	return u
+}
+
+// AddOriginalEstimate adds v to the "original_estimate" field.
+func (u *TaskUpsert) AddOriginalEstimate(v int) *TaskUpsert {
+	u.Add(task.FieldOriginalEstimate, v)
+	return u
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (u *TaskUpsert) ClearOriginalEstimate() *TaskUpsert {
+	u.SetNull(task.FieldOriginalEstimate)
+	return u
+}
+
+// SetRemainingEstimate sets the "remaining_estimate" field.
+func (u *TaskUpsert) SetRemainingEstimate(v int) *TaskUpsert {
+	u.Set(task.FieldRemainingEstimate, v)
+	return u
+}
+
+// UpdateRemainingEstimate sets the "remaining_estimate" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateRemainingEstimate() *TaskUpsert {
+	u.SetExcluded(task.FieldRemainingEstimate)
+	return u
+}
+
+// AddRemainingEstimate adds v to the "remaining_estimate" field.
+func (u *TaskUpsert) AddRemainingEstimate(v int) *TaskUpsert {
+	u.Add(task.FieldRemainingEstimate, v)
+	return u
+}
+
+// ClearRemainingEstimate clears the value of the "remaining_estimate" field.
+func (u *TaskUpsert) ClearRemainingEstimate() *TaskUpsert {
+	u.SetNull(task.FieldRemainingEstimate)
+	return u
+}
+
+// SetWorkflowStatus sets the "workflow_status" field.
+func (u *TaskUpsert) SetWorkflowStatus(v string) *TaskUpsert {
+	u.Set(task.FieldWorkflowStatus, v)
+	return u
+}
+
+// UpdateWorkflowStatus sets the "workflow_status" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateWorkflowStatus() *TaskUpsert {
+	u.SetExcluded(task.FieldWorkflowStatus)
+	return u
+}
+
+// SetRank sets the "rank" field.
+func (u *TaskUpsert) SetRank(v string) *TaskUpsert {
+	u.Set(task.FieldRank, v)
+	return u
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateRank() *TaskUpsert {
+	u.SetExcluded(task.FieldRank)
+	return u
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (u *TaskUpsert) SetSprintID(v int) *TaskUpsert {
+	u.Set(task.FieldSprintID, v)
+	return u
+}
+
+// UpdateSprintID sets the "sprint_id" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateSprintID() *TaskUpsert {
+	u.SetExcluded(task.FieldSprintID)
+	return u
+}
+
+// ClearSprintID clears the value of the "sprint_id" field.
+func (u *TaskUpsert) ClearSprintID() *TaskUpsert {
+	u.SetNull(task.FieldSprintID)
+	return u
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (u *TaskUpsert) SetMilestoneID(v int) *TaskUpsert {
+	u.Set(task.FieldMilestoneID, v)
+	return u
+}
+
+// UpdateMilestoneID sets the "milestone_id" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateMilestoneID() *TaskUpsert {
+	u.SetExcluded(task.FieldMilestoneID)
+	return u
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (u *TaskUpsert) ClearMilestoneID() *TaskUpsert {
+	u.SetNull(task.FieldMilestoneID)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *TaskUpsert) SetCompletedAt(v time.Time) *TaskUpsert {
+	u.Set(task.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateCompletedAt() *TaskUpsert {
+	u.SetExcluded(task.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *TaskUpsert) ClearCompletedAt() *TaskUpsert {
+	u.SetNull(task.FieldCompletedAt)
+	return u
+}
+
+// SetRecurrenceRunID sets the "recurrence_run_id" field.
+func (u *TaskUpsert) SetRecurrenceRunID(v int) *TaskUpsert {
+	u.Set(task.FieldRecurrenceRunID, v)
+	return u
+}
+
+// UpdateRecurrenceRunID sets the "recurrence_run_id" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateRecurrenceRunID() *TaskUpsert {
+	u.SetExcluded(task.FieldRecurrenceRunID)
+	return u
+}
+
+// AddRecurrenceRunID adds v to the "recurrence_run_id" field.
+func (u *TaskUpsert) AddRecurrenceRunID(v int) *TaskUpsert {
+	u.Add(task.FieldRecurrenceRunID, v)
+	return u
+}
+
+// ClearRecurrenceRunID clears the value of the "recurrence_run_id" field.
+func (u *TaskUpsert) ClearRecurrenceRunID() *TaskUpsert {
+	u.SetNull(task.FieldRecurrenceRunID)
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskUpsert) SetOrgID(v int) *TaskUpsert {
+	u.Set(task.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateOrgID() *TaskUpsert {
+	u.SetExcluded(task.FieldOrgID)
 	return u
 }
 
-// UpdateType sets the "type" field to the value that was provided on create.
-func (u *TaskUpsert) UpdateType() *TaskUpsert {
-	u.SetExcluded(task.FieldType)
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskUpsert) AddOrgID(v int) *TaskUpsert {
+	u.Add(task.FieldOrgID, v)
 	return u
 }
 
@@ -962,6 +1689,223 @@ func (u *TaskUpsertOne) UpdateType() *TaskUpsertOne {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *TaskUpsertOne) SetParentID(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateParentID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TaskUpsertOne) ClearParentID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (u *TaskUpsertOne) SetOriginalEstimate(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetOriginalEstimate(v)
+	})
+}
+
+// AddOriginalEstimate adds v to the "original_estimate" field.
+func (u *TaskUpsertOne) AddOriginalEstimate(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddOriginalEstimate(v)
+	})
+}
+
+// UpdateOriginalEstimate sets the "original_estimate" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateOriginalEstimate() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateOriginalEstimate()
+	})
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (u *TaskUpsertOne) ClearOriginalEstimate() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearOriginalEstimate()
+	})
+}
+
+// SetRemainingEstimate sets the "remaining_estimate" field.
+func (u *TaskUpsertOne) SetRemainingEstimate(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetRemainingEstimate(v)
+	})
+}
+
+// AddRemainingEstimate adds v to the "remaining_estimate" field.
+func (u *TaskUpsertOne) AddRemainingEstimate(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddRemainingEstimate(v)
+	})
+}
+
+// UpdateRemainingEstimate sets the "remaining_estimate" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateRemainingEstimate() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateRemainingEstimate()
+	})
+}
+
+// ClearRemainingEstimate clears the value of the "remaining_estimate" field.
+func (u *TaskUpsertOne) ClearRemainingEstimate() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearRemainingEstimate()
+	})
+}
+
+// SetWorkflowStatus sets the "workflow_status" field.
+func (u *TaskUpsertOne) SetWorkflowStatus(v string) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetWorkflowStatus(v)
+	})
+}
+
+// UpdateWorkflowStatus sets the "workflow_status" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateWorkflowStatus() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateWorkflowStatus()
+	})
+}
+
+// SetRank sets the "rank" field.
+func (u *TaskUpsertOne) SetRank(v string) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetRank(v)
+	})
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateRank() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateRank()
+	})
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (u *TaskUpsertOne) SetSprintID(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetSprintID(v)
+	})
+}
+
+// UpdateSprintID sets the "sprint_id" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateSprintID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateSprintID()
+	})
+}
+
+// ClearSprintID clears the value of the "sprint_id" field.
+func (u *TaskUpsertOne) ClearSprintID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearSprintID()
+	})
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (u *TaskUpsertOne) SetMilestoneID(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetMilestoneID(v)
+	})
+}
+
+// UpdateMilestoneID sets the "milestone_id" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateMilestoneID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateMilestoneID()
+	})
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (u *TaskUpsertOne) ClearMilestoneID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearMilestoneID()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *TaskUpsertOne) SetCompletedAt(v time.Time) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateCompletedAt() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *TaskUpsertOne) ClearCompletedAt() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetRecurrenceRunID sets the "recurrence_run_id" field.
+func (u *TaskUpsertOne) SetRecurrenceRunID(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetRecurrenceRunID(v)
+	})
+}
+
+// AddRecurrenceRunID adds v to the "recurrence_run_id" field.
+func (u *TaskUpsertOne) AddRecurrenceRunID(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddRecurrenceRunID(v)
+	})
+}
+
+// UpdateRecurrenceRunID sets the "recurrence_run_id" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateRecurrenceRunID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateRecurrenceRunID()
+	})
+}
+
+// ClearRecurrenceRunID clears the value of the "recurrence_run_id" field.
+func (u *TaskUpsertOne) ClearRecurrenceRunID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearRecurrenceRunID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskUpsertOne) SetOrgID(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskUpsertOne) AddOrgID(v int) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateOrgID() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1390,6 +2334,223 @@ func (u *TaskUpsertBulk) UpdateType() *TaskUpsertBulk {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *TaskUpsertBulk) SetParentID(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateParentID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TaskUpsertBulk) ClearParentID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (u *TaskUpsertBulk) SetOriginalEstimate(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetOriginalEstimate(v)
+	})
+}
+
+// AddOriginalEstimate adds v to the "original_estimate" field.
+func (u *TaskUpsertBulk) AddOriginalEstimate(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddOriginalEstimate(v)
+	})
+}
+
+// UpdateOriginalEstimate sets the "original_estimate" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateOriginalEstimate() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateOriginalEstimate()
+	})
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (u *TaskUpsertBulk) ClearOriginalEstimate() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearOriginalEstimate()
+	})
+}
+
+// SetRemainingEstimate sets the "remaining_estimate" field.
+func (u *TaskUpsertBulk) SetRemainingEstimate(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetRemainingEstimate(v)
+	})
+}
+
+// AddRemainingEstimate adds v to the "remaining_estimate" field.
+func (u *TaskUpsertBulk) AddRemainingEstimate(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddRemainingEstimate(v)
+	})
+}
+
+// UpdateRemainingEstimate sets the "remaining_estimate" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateRemainingEstimate() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateRemainingEstimate()
+	})
+}
+
+// ClearRemainingEstimate clears the value of the "remaining_estimate" field.
+func (u *TaskUpsertBulk) ClearRemainingEstimate() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearRemainingEstimate()
+	})
+}
+
+// SetWorkflowStatus sets the "workflow_status" field.
+func (u *TaskUpsertBulk) SetWorkflowStatus(v string) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetWorkflowStatus(v)
+	})
+}
+
+// UpdateWorkflowStatus sets the "workflow_status" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateWorkflowStatus() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateWorkflowStatus()
+	})
+}
+
+// SetRank sets the "rank" field.
+func (u *TaskUpsertBulk) SetRank(v string) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetRank(v)
+	})
+}
+
+// UpdateRank sets the "rank" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateRank() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateRank()
+	})
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (u *TaskUpsertBulk) SetSprintID(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetSprintID(v)
+	})
+}
+
+// UpdateSprintID sets the "sprint_id" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateSprintID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateSprintID()
+	})
+}
+
+// ClearSprintID clears the value of the "sprint_id" field.
+func (u *TaskUpsertBulk) ClearSprintID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearSprintID()
+	})
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (u *TaskUpsertBulk) SetMilestoneID(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetMilestoneID(v)
+	})
+}
+
+// UpdateMilestoneID sets the "milestone_id" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateMilestoneID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateMilestoneID()
+	})
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (u *TaskUpsertBulk) ClearMilestoneID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearMilestoneID()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *TaskUpsertBulk) SetCompletedAt(v time.Time) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateCompletedAt() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *TaskUpsertBulk) ClearCompletedAt() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetRecurrenceRunID sets the "recurrence_run_id" field.
+func (u *TaskUpsertBulk) SetRecurrenceRunID(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetRecurrenceRunID(v)
+	})
+}
+
+// AddRecurrenceRunID adds v to the "recurrence_run_id" field.
+func (u *TaskUpsertBulk) AddRecurrenceRunID(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddRecurrenceRunID(v)
+	})
+}
+
+// UpdateRecurrenceRunID sets the "recurrence_run_id" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateRecurrenceRunID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateRecurrenceRunID()
+	})
+}
+
+// ClearRecurrenceRunID clears the value of the "recurrence_run_id" field.
+func (u *TaskUpsertBulk) ClearRecurrenceRunID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearRecurrenceRunID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskUpsertBulk) SetOrgID(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskUpsertBulk) AddOrgID(v int) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateOrgID() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
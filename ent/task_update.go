@@ -13,10 +13,19 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
 	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
 )
 
 // TaskUpdate is the builder for updating Task entities.
@@ -237,6 +246,216 @@ func (tu *TaskUpdate) SetNillableType(t *task.Type) *TaskUpdate {
 	return tu
 }
 
+// SetParentID sets the "parent_id" field.
+func (tu *TaskUpdate) SetParentID(i int) *TaskUpdate {
+	tu.mutation.SetParentID(i)
+	return tu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableParentID(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetParentID(*i)
+	}
+	return tu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tu *TaskUpdate) ClearParentID() *TaskUpdate {
+	tu.mutation.ClearParentID()
+	return tu
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (tu *TaskUpdate) SetOriginalEstimate(i int) *TaskUpdate {
+	tu.mutation.ResetOriginalEstimate()
+	tu.mutation.SetOriginalEstimate(i)
+	return tu
+}
+
+// SetNillableOriginalEstimate sets the "original_estimate" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableOriginalEstimate(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetOriginalEstimate(*i)
+	}
+	return tu
+}
+
+// AddOriginalEstimate adds i to the "original_estimate" field.
+func (tu *TaskUpdate) AddOriginalEstimate(i int) *TaskUpdate {
+	tu.mutation.AddOriginalEstimate(i)
+	return tu
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (tu *TaskUpdate) ClearOriginalEstimate() *TaskUpdate {
+	tu.mutation.ClearOriginalEstimate()
+	return tu
+}
+
+// SetRemainingEstimate sets the "remaining_estimate" field.
+func (tu *TaskUpdate) SetRemainingEstimate(i int) *TaskUpdate {
+	tu.mutation.ResetRemainingEstimate()
+	tu.mutation.SetRemainingEstimate(i)
+	return tu
+}
+
+// SetNillableRemainingEstimate sets the "remaining_estimate" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRemainingEstimate(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetRemainingEstimate(*i)
+	}
+	return tu
+}
+
+// AddRemainingEstimate adds i to the "remaining_estimate" field.
+func (tu *TaskUpdate) AddRemainingEstimate(i int) *TaskUpdate {
+	tu.mutation.AddRemainingEstimate(i)
+	return tu
+}
+
+// ClearRemainingEstimate clears the value of the "remaining_estimate" field.
+func (tu *TaskUpdate) ClearRemainingEstimate() *TaskUpdate {
+	tu.mutation.ClearRemainingEstimate()
+	return tu
+}
+
+// SetWorkflowStatus sets the "workflow_status" field.
+func (tu *TaskUpdate) SetWorkflowStatus(s string) *TaskUpdate {
+	tu.mutation.SetWorkflowStatus(s)
+	return tu
+}
+
+// SetNillableWorkflowStatus sets the "workflow_status" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableWorkflowStatus(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetWorkflowStatus(*s)
+	}
+	return tu
+}
+
+// SetRank sets the "rank" field.
+func (tu *TaskUpdate) SetRank(s string) *TaskUpdate {
+	tu.mutation.SetRank(s)
+	return tu
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRank(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetRank(*s)
+	}
+	return tu
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (tu *TaskUpdate) SetSprintID(i int) *TaskUpdate {
+	tu.mutation.SetSprintID(i)
+	return tu
+}
+
+// SetNillableSprintID sets the "sprint_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableSprintID(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetSprintID(*i)
+	}
+	return tu
+}
+
+// ClearSprintID clears the value of the "sprint_id" field.
+func (tu *TaskUpdate) ClearSprintID() *TaskUpdate {
+	tu.mutation.ClearSprintID()
+	return tu
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (tu *TaskUpdate) SetMilestoneID(i int) *TaskUpdate {
+	tu.mutation.SetMilestoneID(i)
+	return tu
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableMilestoneID(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetMilestoneID(*i)
+	}
+	return tu
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (tu *TaskUpdate) ClearMilestoneID() *TaskUpdate {
+	tu.mutation.ClearMilestoneID()
+	return tu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tu *TaskUpdate) SetCompletedAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetCompletedAt(t)
+	return tu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCompletedAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetCompletedAt(*t)
+	}
+	return tu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tu *TaskUpdate) ClearCompletedAt() *TaskUpdate {
+	tu.mutation.ClearCompletedAt()
+	return tu
+}
+
+// SetRecurrenceRunID sets the "recurrence_run_id" field.
+func (tu *TaskUpdate) SetRecurrenceRunID(i int) *TaskUpdate {
+	tu.mutation.ResetRecurrenceRunID()
+	tu.mutation.SetRecurrenceRunID(i)
+	return tu
+}
+
+// SetNillableRecurrenceRunID sets the "recurrence_run_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRecurrenceRunID(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetRecurrenceRunID(*i)
+	}
+	return tu
+}
+
+// AddRecurrenceRunID adds i to the "recurrence_run_id" field.
+func (tu *TaskUpdate) AddRecurrenceRunID(i int) *TaskUpdate {
+	tu.mutation.AddRecurrenceRunID(i)
+	return tu
+}
+
+// ClearRecurrenceRunID clears the value of the "recurrence_run_id" field.
+func (tu *TaskUpdate) ClearRecurrenceRunID() *TaskUpdate {
+	tu.mutation.ClearRecurrenceRunID()
+	return tu
+}
+
+// SetOrgID sets the "org_id" field.
+func (tu *TaskUpdate) SetOrgID(i int) *TaskUpdate {
+	tu.mutation.ResetOrgID()
+	tu.mutation.SetOrgID(i)
+	return tu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableOrgID(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetOrgID(*i)
+	}
+	return tu
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (tu *TaskUpdate) AddOrgID(i int) *TaskUpdate {
+	tu.mutation.AddOrgID(i)
+	return tu
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (tu *TaskUpdate) SetProject(p *Project) *TaskUpdate {
 	return tu.SetProjectID(p.ID)
@@ -287,6 +506,156 @@ func (tu *TaskUpdate) AddReports(t ...*TaskReport) *TaskUpdate {
 	return tu.AddReportIDs(ids...)
 }
 
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (tu *TaskUpdate) AddOvertimeRequestIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddOvertimeRequestIDs(ids...)
+	return tu
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (tu *TaskUpdate) AddOvertimeRequests(o ...*OvertimeRequest) *TaskUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return tu.AddOvertimeRequestIDs(ids...)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tu *TaskUpdate) AddChildIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddChildIDs(ids...)
+	return tu
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tu *TaskUpdate) AddChildren(t ...*Task) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddChildIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Task entity.
+func (tu *TaskUpdate) SetParent(t *Task) *TaskUpdate {
+	return tu.SetParentID(t.ID)
+}
+
+// AddDependencyIDs adds the "dependencies" edge to the TaskDependency entity by IDs.
+func (tu *TaskUpdate) AddDependencyIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddDependencyIDs(ids...)
+	return tu
+}
+
+// AddDependencies adds the "dependencies" edges to the TaskDependency entity.
+func (tu *TaskUpdate) AddDependencies(t ...*TaskDependency) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddDependencyIDs(ids...)
+}
+
+// AddDependentIDs adds the "dependents" edge to the TaskDependency entity by IDs.
+func (tu *TaskUpdate) AddDependentIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddDependentIDs(ids...)
+	return tu
+}
+
+// AddDependents adds the "dependents" edges to the TaskDependency entity.
+func (tu *TaskUpdate) AddDependents(t ...*TaskDependency) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddDependentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the TaskComment entity by IDs.
+func (tu *TaskUpdate) AddCommentIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddCommentIDs(ids...)
+	return tu
+}
+
+// AddComments adds the "comments" edges to the TaskComment entity.
+func (tu *TaskUpdate) AddComments(t ...*TaskComment) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddCommentIDs(ids...)
+}
+
+// AddWorkLogIDs adds the "work_logs" edge to the WorkLog entity by IDs.
+func (tu *TaskUpdate) AddWorkLogIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddWorkLogIDs(ids...)
+	return tu
+}
+
+// AddWorkLogs adds the "work_logs" edges to the WorkLog entity.
+func (tu *TaskUpdate) AddWorkLogs(w ...*WorkLog) *TaskUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.AddWorkLogIDs(ids...)
+}
+
+// SetSprint sets the "sprint" edge to the Sprint entity.
+func (tu *TaskUpdate) SetSprint(s *Sprint) *TaskUpdate {
+	return tu.SetSprintID(s.ID)
+}
+
+// SetMilestone sets the "milestone" edge to the Milestone entity.
+func (tu *TaskUpdate) SetMilestone(m *Milestone) *TaskUpdate {
+	return tu.SetMilestoneID(m.ID)
+}
+
+// AddActivityIDs adds the "activities" edge to the TaskActivity entity by IDs.
+func (tu *TaskUpdate) AddActivityIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddActivityIDs(ids...)
+	return tu
+}
+
+// AddActivities adds the "activities" edges to the TaskActivity entity.
+func (tu *TaskUpdate) AddActivities(t ...*TaskActivity) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddActivityIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the TaskWatcher entity by IDs.
+func (tu *TaskUpdate) AddWatcherIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddWatcherIDs(ids...)
+	return tu
+}
+
+// AddWatchers adds the "watchers" edges to the TaskWatcher entity.
+func (tu *TaskUpdate) AddWatchers(t ...*TaskWatcher) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddWatcherIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the TaskReminder entity by IDs.
+func (tu *TaskUpdate) AddReminderIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddReminderIDs(ids...)
+	return tu
+}
+
+// AddReminders adds the "reminders" edges to the TaskReminder entity.
+func (tu *TaskUpdate) AddReminders(t ...*TaskReminder) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddReminderIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tu *TaskUpdate) Mutation() *TaskMutation {
 	return tu.mutation
@@ -361,64 +730,281 @@ func (tu *TaskUpdate) RemoveReports(t ...*TaskReport) *TaskUpdate {
 	return tu.RemoveReportIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
-	tu.defaults()
-	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+// ClearOvertimeRequests clears all "overtime_requests" edges to the OvertimeRequest entity.
+func (tu *TaskUpdate) ClearOvertimeRequests() *TaskUpdate {
+	tu.mutation.ClearOvertimeRequests()
+	return tu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (tu *TaskUpdate) SaveX(ctx context.Context) int {
-	affected, err := tu.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveOvertimeRequestIDs removes the "overtime_requests" edge to OvertimeRequest entities by IDs.
+func (tu *TaskUpdate) RemoveOvertimeRequestIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveOvertimeRequestIDs(ids...)
+	return tu
+}
+
+// RemoveOvertimeRequests removes "overtime_requests" edges to OvertimeRequest entities.
+func (tu *TaskUpdate) RemoveOvertimeRequests(o ...*OvertimeRequest) *TaskUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return affected
+	return tu.RemoveOvertimeRequestIDs(ids...)
 }
 
-// Exec executes the query.
-func (tu *TaskUpdate) Exec(ctx context.Context) error {
-	_, err := tu.Save(ctx)
-	return err
+// ClearChildren clears all "children" edges to the Task entity.
+func (tu *TaskUpdate) ClearChildren() *TaskUpdate {
+	tu.mutation.ClearChildren()
+	return tu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (tu *TaskUpdate) ExecX(ctx context.Context) {
-	if err := tu.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemoveChildIDs removes the "children" edge to Task entities by IDs.
+func (tu *TaskUpdate) RemoveChildIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveChildIDs(ids...)
+	return tu
 }
 
-// defaults sets the default values of the builder before save.
-func (tu *TaskUpdate) defaults() {
-	if _, ok := tu.mutation.UpdatedAt(); !ok {
-		v := task.UpdateDefaultUpdatedAt()
-		tu.mutation.SetUpdatedAt(v)
+// RemoveChildren removes "children" edges to Task entities.
+func (tu *TaskUpdate) RemoveChildren(t ...*Task) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tu.RemoveChildIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (tu *TaskUpdate) check() error {
-	if v, ok := tu.mutation.Status(); ok {
-		if err := task.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
-		}
-	}
-	if v, ok := tu.mutation.GetType(); ok {
-		if err := task.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Task.type": %w`, err)}
-		}
-	}
-	return nil
+// ClearParent clears the "parent" edge to the Task entity.
+func (tu *TaskUpdate) ClearParent() *TaskUpdate {
+	tu.mutation.ClearParent()
+	return tu
 }
 
-func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := tu.check(); err != nil {
-		return n, err
+// ClearDependencies clears all "dependencies" edges to the TaskDependency entity.
+func (tu *TaskUpdate) ClearDependencies() *TaskUpdate {
+	tu.mutation.ClearDependencies()
+	return tu
+}
+
+// RemoveDependencyIDs removes the "dependencies" edge to TaskDependency entities by IDs.
+func (tu *TaskUpdate) RemoveDependencyIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveDependencyIDs(ids...)
+	return tu
+}
+
+// RemoveDependencies removes "dependencies" edges to TaskDependency entities.
+func (tu *TaskUpdate) RemoveDependencies(t ...*TaskDependency) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt))
-	if ps := tu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
+	return tu.RemoveDependencyIDs(ids...)
+}
+
+// ClearDependents clears all "dependents" edges to the TaskDependency entity.
+func (tu *TaskUpdate) ClearDependents() *TaskUpdate {
+	tu.mutation.ClearDependents()
+	return tu
+}
+
+// RemoveDependentIDs removes the "dependents" edge to TaskDependency entities by IDs.
+func (tu *TaskUpdate) RemoveDependentIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveDependentIDs(ids...)
+	return tu
+}
+
+// RemoveDependents removes "dependents" edges to TaskDependency entities.
+func (tu *TaskUpdate) RemoveDependents(t ...*TaskDependency) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveDependentIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the TaskComment entity.
+func (tu *TaskUpdate) ClearComments() *TaskUpdate {
+	tu.mutation.ClearComments()
+	return tu
+}
+
+// RemoveCommentIDs removes the "comments" edge to TaskComment entities by IDs.
+func (tu *TaskUpdate) RemoveCommentIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveCommentIDs(ids...)
+	return tu
+}
+
+// RemoveComments removes "comments" edges to TaskComment entities.
+func (tu *TaskUpdate) RemoveComments(t ...*TaskComment) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveCommentIDs(ids...)
+}
+
+// ClearWorkLogs clears all "work_logs" edges to the WorkLog entity.
+func (tu *TaskUpdate) ClearWorkLogs() *TaskUpdate {
+	tu.mutation.ClearWorkLogs()
+	return tu
+}
+
+// RemoveWorkLogIDs removes the "work_logs" edge to WorkLog entities by IDs.
+func (tu *TaskUpdate) RemoveWorkLogIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveWorkLogIDs(ids...)
+	return tu
+}
+
+// RemoveWorkLogs removes "work_logs" edges to WorkLog entities.
+func (tu *TaskUpdate) RemoveWorkLogs(w ...*WorkLog) *TaskUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.RemoveWorkLogIDs(ids...)
+}
+
+// ClearSprint clears the "sprint" edge to the Sprint entity.
+func (tu *TaskUpdate) ClearSprint() *TaskUpdate {
+	tu.mutation.ClearSprint()
+	return tu
+}
+
+// ClearMilestone clears the "milestone" edge to the Milestone entity.
+func (tu *TaskUpdate) ClearMilestone() *TaskUpdate {
+	tu.mutation.ClearMilestone()
+	return tu
+}
+
+// ClearActivities clears all "activities" edges to the TaskActivity entity.
+func (tu *TaskUpdate) ClearActivities() *TaskUpdate {
+	tu.mutation.ClearActivities()
+	return tu
+}
+
+// RemoveActivityIDs removes the "activities" edge to TaskActivity entities by IDs.
+func (tu *TaskUpdate) RemoveActivityIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveActivityIDs(ids...)
+	return tu
+}
+
+// RemoveActivities removes "activities" edges to TaskActivity entities.
+func (tu *TaskUpdate) RemoveActivities(t ...*TaskActivity) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveActivityIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the TaskWatcher entity.
+func (tu *TaskUpdate) ClearWatchers() *TaskUpdate {
+	tu.mutation.ClearWatchers()
+	return tu
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to TaskWatcher entities by IDs.
+func (tu *TaskUpdate) RemoveWatcherIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveWatcherIDs(ids...)
+	return tu
+}
+
+// RemoveWatchers removes "watchers" edges to TaskWatcher entities.
+func (tu *TaskUpdate) RemoveWatchers(t ...*TaskWatcher) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveWatcherIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the TaskReminder entity.
+func (tu *TaskUpdate) ClearReminders() *TaskUpdate {
+	tu.mutation.ClearReminders()
+	return tu
+}
+
+// RemoveReminderIDs removes the "reminders" edge to TaskReminder entities by IDs.
+func (tu *TaskUpdate) RemoveReminderIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveReminderIDs(ids...)
+	return tu
+}
+
+// RemoveReminders removes "reminders" edges to TaskReminder entities.
+func (tu *TaskUpdate) RemoveReminders(t ...*TaskReminder) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveReminderIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
+	tu.defaults()
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TaskUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TaskUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tu *TaskUpdate) defaults() {
+	if _, ok := tu.mutation.UpdatedAt(); !ok {
+		v := task.UpdateDefaultUpdatedAt()
+		tu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TaskUpdate) check() error {
+	if v, ok := tu.mutation.Status(); ok {
+		if err := task.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.GetType(); ok {
+		if err := task.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Task.type": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.OriginalEstimate(); ok {
+		if err := task.OriginalEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "original_estimate", err: fmt.Errorf(`ent: validator failed for field "Task.original_estimate": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.RemainingEstimate(); ok {
+		if err := task.RemainingEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "remaining_estimate", err: fmt.Errorf(`ent: validator failed for field "Task.remaining_estimate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
@@ -475,6 +1061,51 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.GetType(); ok {
 		_spec.SetField(task.FieldType, field.TypeEnum, value)
 	}
+	if value, ok := tu.mutation.OriginalEstimate(); ok {
+		_spec.SetField(task.FieldOriginalEstimate, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedOriginalEstimate(); ok {
+		_spec.AddField(task.FieldOriginalEstimate, field.TypeInt, value)
+	}
+	if tu.mutation.OriginalEstimateCleared() {
+		_spec.ClearField(task.FieldOriginalEstimate, field.TypeInt)
+	}
+	if value, ok := tu.mutation.RemainingEstimate(); ok {
+		_spec.SetField(task.FieldRemainingEstimate, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedRemainingEstimate(); ok {
+		_spec.AddField(task.FieldRemainingEstimate, field.TypeInt, value)
+	}
+	if tu.mutation.RemainingEstimateCleared() {
+		_spec.ClearField(task.FieldRemainingEstimate, field.TypeInt)
+	}
+	if value, ok := tu.mutation.WorkflowStatus(); ok {
+		_spec.SetField(task.FieldWorkflowStatus, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.CompletedAt(); ok {
+		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tu.mutation.CompletedAtCleared() {
+		_spec.ClearField(task.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.RecurrenceRunID(); ok {
+		_spec.SetField(task.FieldRecurrenceRunID, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedRecurrenceRunID(); ok {
+		_spec.AddField(task.FieldRecurrenceRunID, field.TypeInt, value)
+	}
+	if tu.mutation.RecurrenceRunIDCleared() {
+		_spec.ClearField(task.FieldRecurrenceRunID, field.TypeInt)
+	}
+	if value, ok := tu.mutation.OrgID(); ok {
+		_spec.SetField(task.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedOrgID(); ok {
+		_spec.AddField(task.FieldOrgID, field.TypeInt, value)
+	}
 	if tu.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -639,521 +1270,2127 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{task.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if tu.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.OvertimeRequestsTable,
+			Columns: []string{task.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	tu.mutation.done = true
-	return n, nil
-}
-
-// TaskUpdateOne is the builder for updating a single Task entity.
-type TaskUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TaskMutation
-}
-
-// SetName sets the "name" field.
-func (tuo *TaskUpdateOne) SetName(s string) *TaskUpdateOne {
-	tuo.mutation.SetName(s)
-	return tuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableName(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetName(*s)
+	if nodes := tu.mutation.RemovedOvertimeRequestsIDs(); len(nodes) > 0 && !tu.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.OvertimeRequestsTable,
+			Columns: []string{task.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// SetCode sets the "code" field.
-func (tuo *TaskUpdateOne) SetCode(s string) *TaskUpdateOne {
-	tuo.mutation.SetCode(s)
-	return tuo
-}
-
-// SetNillableCode sets the "code" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableCode(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetCode(*s)
+	if nodes := tu.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.OvertimeRequestsTable,
+			Columns: []string{task.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return tuo
-}
-
-// SetDescription sets the "description" field.
-func (tuo *TaskUpdateOne) SetDescription(s string) *TaskUpdateOne {
-	tuo.mutation.SetDescription(s)
-	return tuo
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableDescription(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetDescription(*s)
+	if tu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// ClearDescription clears the value of the "description" field.
-func (tuo *TaskUpdateOne) ClearDescription() *TaskUpdateOne {
-	tuo.mutation.ClearDescription()
-	return tuo
-}
-
-// SetProcess sets the "process" field.
-func (tuo *TaskUpdateOne) SetProcess(i int) *TaskUpdateOne {
-	tuo.mutation.ResetProcess()
+	if nodes := tu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.DependenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependenciesTable,
+			Columns: []string{task.DependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedDependenciesIDs(); len(nodes) > 0 && !tu.mutation.DependenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependenciesTable,
+			Columns: []string{task.DependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.DependenciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependenciesTable,
+			Columns: []string{task.DependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependentsTable,
+			Columns: []string{task.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedDependentsIDs(); len(nodes) > 0 && !tu.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependentsTable,
+			Columns: []string{task.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependentsTable,
+			Columns: []string{task.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WorkLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorkLogsTable,
+			Columns: []string{task.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWorkLogsIDs(); len(nodes) > 0 && !tu.mutation.WorkLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorkLogsTable,
+			Columns: []string{task.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WorkLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorkLogsTable,
+			Columns: []string{task.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.SprintCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.SprintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.MilestoneCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.MilestoneIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !tu.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !tu.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !tu.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{task.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TaskUpdateOne is the builder for updating a single Task entity.
+type TaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskMutation
+}
+
+// SetName sets the "name" field.
+func (tuo *TaskUpdateOne) SetName(s string) *TaskUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableName(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetName(*s)
+	}
+	return tuo
+}
+
+// SetCode sets the "code" field.
+func (tuo *TaskUpdateOne) SetCode(s string) *TaskUpdateOne {
+	tuo.mutation.SetCode(s)
+	return tuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCode(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetCode(*s)
+	}
+	return tuo
+}
+
+// SetDescription sets the "description" field.
+func (tuo *TaskUpdateOne) SetDescription(s string) *TaskUpdateOne {
+	tuo.mutation.SetDescription(s)
+	return tuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDescription(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetDescription(*s)
+	}
+	return tuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tuo *TaskUpdateOne) ClearDescription() *TaskUpdateOne {
+	tuo.mutation.ClearDescription()
+	return tuo
+}
+
+// SetProcess sets the "process" field.
+func (tuo *TaskUpdateOne) SetProcess(i int) *TaskUpdateOne {
+	tuo.mutation.ResetProcess()
 	tuo.mutation.SetProcess(i)
 	return tuo
 }
 
-// SetNillableProcess sets the "process" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableProcess(i *int) *TaskUpdateOne {
-	if i != nil {
-		tuo.SetProcess(*i)
+// SetNillableProcess sets the "process" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableProcess(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetProcess(*i)
+	}
+	return tuo
+}
+
+// AddProcess adds i to the "process" field.
+func (tuo *TaskUpdateOne) AddProcess(i int) *TaskUpdateOne {
+	tuo.mutation.AddProcess(i)
+	return tuo
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TaskUpdateOne) SetStatus(t task.Status) *TaskUpdateOne {
+	tuo.mutation.SetStatus(t)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStatus(t *task.Status) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetStatus(*t)
+	}
+	return tuo
+}
+
+// SetStartAt sets the "start_at" field.
+func (tuo *TaskUpdateOne) SetStartAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetStartAt(t)
+	return tuo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStartAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetStartAt(*t)
+	}
+	return tuo
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (tuo *TaskUpdateOne) ClearStartAt() *TaskUpdateOne {
+	tuo.mutation.ClearStartAt()
+	return tuo
+}
+
+// SetDueDate sets the "due_date" field.
+func (tuo *TaskUpdateOne) SetDueDate(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetDueDate(t)
+	return tuo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDueDate(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetDueDate(*t)
+	}
+	return tuo
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (tuo *TaskUpdateOne) ClearDueDate() *TaskUpdateOne {
+	tuo.mutation.ClearDueDate()
+	return tuo
+}
+
+// SetProjectID sets the "project_id" field.
+func (tuo *TaskUpdateOne) SetProjectID(i int) *TaskUpdateOne {
+	tuo.mutation.SetProjectID(i)
+	return tuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableProjectID(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetProjectID(*i)
+	}
+	return tuo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tuo *TaskUpdateOne) ClearProjectID() *TaskUpdateOne {
+	tuo.mutation.ClearProjectID()
+	return tuo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (tuo *TaskUpdateOne) SetCreatorID(i int) *TaskUpdateOne {
+	tuo.mutation.ResetCreatorID()
+	tuo.mutation.SetCreatorID(i)
+	return tuo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCreatorID(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetCreatorID(*i)
+	}
+	return tuo
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (tuo *TaskUpdateOne) AddCreatorID(i int) *TaskUpdateOne {
+	tuo.mutation.AddCreatorID(i)
+	return tuo
+}
+
+// SetUpdaterID sets the "updater_id" field.
+func (tuo *TaskUpdateOne) SetUpdaterID(i int) *TaskUpdateOne {
+	tuo.mutation.ResetUpdaterID()
+	tuo.mutation.SetUpdaterID(i)
+	return tuo
+}
+
+// SetNillableUpdaterID sets the "updater_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableUpdaterID(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetUpdaterID(*i)
+	}
+	return tuo
+}
+
+// AddUpdaterID adds i to the "updater_id" field.
+func (tuo *TaskUpdateOne) AddUpdaterID(i int) *TaskUpdateOne {
+	tuo.mutation.AddUpdaterID(i)
+	return tuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TaskUpdateOne) SetUpdatedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetUpdatedAt(t)
+	return tuo
+}
+
+// SetType sets the "type" field.
+func (tuo *TaskUpdateOne) SetType(t task.Type) *TaskUpdateOne {
+	tuo.mutation.SetType(t)
+	return tuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableType(t *task.Type) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetType(*t)
+	}
+	return tuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (tuo *TaskUpdateOne) SetParentID(i int) *TaskUpdateOne {
+	tuo.mutation.SetParentID(i)
+	return tuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableParentID(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetParentID(*i)
+	}
+	return tuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tuo *TaskUpdateOne) ClearParentID() *TaskUpdateOne {
+	tuo.mutation.ClearParentID()
+	return tuo
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (tuo *TaskUpdateOne) SetOriginalEstimate(i int) *TaskUpdateOne {
+	tuo.mutation.ResetOriginalEstimate()
+	tuo.mutation.SetOriginalEstimate(i)
+	return tuo
+}
+
+// SetNillableOriginalEstimate sets the "original_estimate" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableOriginalEstimate(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetOriginalEstimate(*i)
+	}
+	return tuo
+}
+
+// AddOriginalEstimate adds i to the "original_estimate" field.
+func (tuo *TaskUpdateOne) AddOriginalEstimate(i int) *TaskUpdateOne {
+	tuo.mutation.AddOriginalEstimate(i)
+	return tuo
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (tuo *TaskUpdateOne) ClearOriginalEstimate() *TaskUpdateOne {
+	tuo.mutation.ClearOriginalEstimate()
+	return tuo
+}
+
+// SetRemainingEstimate sets the "remaining_estimate" field.
+func (tuo *TaskUpdateOne) SetRemainingEstimate(i int) *TaskUpdateOne {
+	tuo.mutation.ResetRemainingEstimate()
+	tuo.mutation.SetRemainingEstimate(i)
+	return tuo
+}
+
+// SetNillableRemainingEstimate sets the "remaining_estimate" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRemainingEstimate(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetRemainingEstimate(*i)
+	}
+	return tuo
+}
+
+// AddRemainingEstimate adds i to the "remaining_estimate" field.
+func (tuo *TaskUpdateOne) AddRemainingEstimate(i int) *TaskUpdateOne {
+	tuo.mutation.AddRemainingEstimate(i)
+	return tuo
+}
+
+// ClearRemainingEstimate clears the value of the "remaining_estimate" field.
+func (tuo *TaskUpdateOne) ClearRemainingEstimate() *TaskUpdateOne {
+	tuo.mutation.ClearRemainingEstimate()
+	return tuo
+}
+
+// SetWorkflowStatus sets the "workflow_status" field.
+func (tuo *TaskUpdateOne) SetWorkflowStatus(s string) *TaskUpdateOne {
+	tuo.mutation.SetWorkflowStatus(s)
+	return tuo
+}
+
+// SetNillableWorkflowStatus sets the "workflow_status" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableWorkflowStatus(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetWorkflowStatus(*s)
+	}
+	return tuo
+}
+
+// SetRank sets the "rank" field.
+func (tuo *TaskUpdateOne) SetRank(s string) *TaskUpdateOne {
+	tuo.mutation.SetRank(s)
+	return tuo
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRank(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetRank(*s)
+	}
+	return tuo
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (tuo *TaskUpdateOne) SetSprintID(i int) *TaskUpdateOne {
+	tuo.mutation.SetSprintID(i)
+	return tuo
+}
+
+// SetNillableSprintID sets the "sprint_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableSprintID(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetSprintID(*i)
+	}
+	return tuo
+}
+
+// ClearSprintID clears the value of the "sprint_id" field.
+func (tuo *TaskUpdateOne) ClearSprintID() *TaskUpdateOne {
+	tuo.mutation.ClearSprintID()
+	return tuo
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (tuo *TaskUpdateOne) SetMilestoneID(i int) *TaskUpdateOne {
+	tuo.mutation.SetMilestoneID(i)
+	return tuo
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableMilestoneID(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetMilestoneID(*i)
+	}
+	return tuo
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (tuo *TaskUpdateOne) ClearMilestoneID() *TaskUpdateOne {
+	tuo.mutation.ClearMilestoneID()
+	return tuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tuo *TaskUpdateOne) SetCompletedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetCompletedAt(t)
+	return tuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCompletedAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetCompletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tuo *TaskUpdateOne) ClearCompletedAt() *TaskUpdateOne {
+	tuo.mutation.ClearCompletedAt()
+	return tuo
+}
+
+// SetRecurrenceRunID sets the "recurrence_run_id" field.
+func (tuo *TaskUpdateOne) SetRecurrenceRunID(i int) *TaskUpdateOne {
+	tuo.mutation.ResetRecurrenceRunID()
+	tuo.mutation.SetRecurrenceRunID(i)
+	return tuo
+}
+
+// SetNillableRecurrenceRunID sets the "recurrence_run_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRecurrenceRunID(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetRecurrenceRunID(*i)
+	}
+	return tuo
+}
+
+// AddRecurrenceRunID adds i to the "recurrence_run_id" field.
+func (tuo *TaskUpdateOne) AddRecurrenceRunID(i int) *TaskUpdateOne {
+	tuo.mutation.AddRecurrenceRunID(i)
+	return tuo
+}
+
+// ClearRecurrenceRunID clears the value of the "recurrence_run_id" field.
+func (tuo *TaskUpdateOne) ClearRecurrenceRunID() *TaskUpdateOne {
+	tuo.mutation.ClearRecurrenceRunID()
+	return tuo
+}
+
+// SetOrgID sets the "org_id" field.
+func (tuo *TaskUpdateOne) SetOrgID(i int) *TaskUpdateOne {
+	tuo.mutation.ResetOrgID()
+	tuo.mutation.SetOrgID(i)
+	return tuo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableOrgID(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetOrgID(*i)
+	}
+	return tuo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (tuo *TaskUpdateOne) AddOrgID(i int) *TaskUpdateOne {
+	tuo.mutation.AddOrgID(i)
+	return tuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tuo *TaskUpdateOne) SetProject(p *Project) *TaskUpdateOne {
+	return tuo.SetProjectID(p.ID)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (tuo *TaskUpdateOne) AddLabelIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddLabelIDs(ids...)
+	return tuo
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (tuo *TaskUpdateOne) AddLabels(l ...*Label) *TaskUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tuo.AddLabelIDs(ids...)
+}
+
+// AddAssigneeIDs adds the "assignees" edge to the Employee entity by IDs.
+func (tuo *TaskUpdateOne) AddAssigneeIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddAssigneeIDs(ids...)
+	return tuo
+}
+
+// AddAssignees adds the "assignees" edges to the Employee entity.
+func (tuo *TaskUpdateOne) AddAssignees(e ...*Employee) *TaskUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tuo.AddAssigneeIDs(ids...)
+}
+
+// AddReportIDs adds the "reports" edge to the TaskReport entity by IDs.
+func (tuo *TaskUpdateOne) AddReportIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddReportIDs(ids...)
+	return tuo
+}
+
+// AddReports adds the "reports" edges to the TaskReport entity.
+func (tuo *TaskUpdateOne) AddReports(t ...*TaskReport) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddReportIDs(ids...)
+}
+
+// AddOvertimeRequestIDs adds the "overtime_requests" edge to the OvertimeRequest entity by IDs.
+func (tuo *TaskUpdateOne) AddOvertimeRequestIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddOvertimeRequestIDs(ids...)
+	return tuo
+}
+
+// AddOvertimeRequests adds the "overtime_requests" edges to the OvertimeRequest entity.
+func (tuo *TaskUpdateOne) AddOvertimeRequests(o ...*OvertimeRequest) *TaskUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return tuo.AddOvertimeRequestIDs(ids...)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tuo *TaskUpdateOne) AddChildIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddChildIDs(ids...)
+	return tuo
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tuo *TaskUpdateOne) AddChildren(t ...*Task) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.AddChildIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Task entity.
+func (tuo *TaskUpdateOne) SetParent(t *Task) *TaskUpdateOne {
+	return tuo.SetParentID(t.ID)
+}
+
+// AddDependencyIDs adds the "dependencies" edge to the TaskDependency entity by IDs.
+func (tuo *TaskUpdateOne) AddDependencyIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddDependencyIDs(ids...)
 	return tuo
 }
 
-// AddProcess adds i to the "process" field.
-func (tuo *TaskUpdateOne) AddProcess(i int) *TaskUpdateOne {
-	tuo.mutation.AddProcess(i)
+// AddDependencies adds the "dependencies" edges to the TaskDependency entity.
+func (tuo *TaskUpdateOne) AddDependencies(t ...*TaskDependency) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddDependencyIDs(ids...)
+}
+
+// AddDependentIDs adds the "dependents" edge to the TaskDependency entity by IDs.
+func (tuo *TaskUpdateOne) AddDependentIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddDependentIDs(ids...)
 	return tuo
 }
 
-// SetStatus sets the "status" field.
-func (tuo *TaskUpdateOne) SetStatus(t task.Status) *TaskUpdateOne {
-	tuo.mutation.SetStatus(t)
+// AddDependents adds the "dependents" edges to the TaskDependency entity.
+func (tuo *TaskUpdateOne) AddDependents(t ...*TaskDependency) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddDependentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the TaskComment entity by IDs.
+func (tuo *TaskUpdateOne) AddCommentIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddCommentIDs(ids...)
 	return tuo
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableStatus(t *task.Status) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetStatus(*t)
+// AddComments adds the "comments" edges to the TaskComment entity.
+func (tuo *TaskUpdateOne) AddComments(t ...*TaskComment) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.AddCommentIDs(ids...)
+}
+
+// AddWorkLogIDs adds the "work_logs" edge to the WorkLog entity by IDs.
+func (tuo *TaskUpdateOne) AddWorkLogIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddWorkLogIDs(ids...)
 	return tuo
 }
 
-// SetStartAt sets the "start_at" field.
-func (tuo *TaskUpdateOne) SetStartAt(t time.Time) *TaskUpdateOne {
-	tuo.mutation.SetStartAt(t)
+// AddWorkLogs adds the "work_logs" edges to the WorkLog entity.
+func (tuo *TaskUpdateOne) AddWorkLogs(w ...*WorkLog) *TaskUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.AddWorkLogIDs(ids...)
+}
+
+// SetSprint sets the "sprint" edge to the Sprint entity.
+func (tuo *TaskUpdateOne) SetSprint(s *Sprint) *TaskUpdateOne {
+	return tuo.SetSprintID(s.ID)
+}
+
+// SetMilestone sets the "milestone" edge to the Milestone entity.
+func (tuo *TaskUpdateOne) SetMilestone(m *Milestone) *TaskUpdateOne {
+	return tuo.SetMilestoneID(m.ID)
+}
+
+// AddActivityIDs adds the "activities" edge to the TaskActivity entity by IDs.
+func (tuo *TaskUpdateOne) AddActivityIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddActivityIDs(ids...)
 	return tuo
 }
 
-// SetNillableStartAt sets the "start_at" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableStartAt(t *time.Time) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetStartAt(*t)
+// AddActivities adds the "activities" edges to the TaskActivity entity.
+func (tuo *TaskUpdateOne) AddActivities(t ...*TaskActivity) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.AddActivityIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the TaskWatcher entity by IDs.
+func (tuo *TaskUpdateOne) AddWatcherIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddWatcherIDs(ids...)
 	return tuo
 }
 
-// ClearStartAt clears the value of the "start_at" field.
-func (tuo *TaskUpdateOne) ClearStartAt() *TaskUpdateOne {
-	tuo.mutation.ClearStartAt()
+// AddWatchers adds the "watchers" edges to the TaskWatcher entity.
+func (tuo *TaskUpdateOne) AddWatchers(t ...*TaskWatcher) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddWatcherIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the TaskReminder entity by IDs.
+func (tuo *TaskUpdateOne) AddReminderIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddReminderIDs(ids...)
 	return tuo
 }
 
-// SetDueDate sets the "due_date" field.
-func (tuo *TaskUpdateOne) SetDueDate(t time.Time) *TaskUpdateOne {
-	tuo.mutation.SetDueDate(t)
+// AddReminders adds the "reminders" edges to the TaskReminder entity.
+func (tuo *TaskUpdateOne) AddReminders(t ...*TaskReminder) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddReminderIDs(ids...)
+}
+
+// Mutation returns the TaskMutation object of the builder.
+func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
+	return tuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tuo *TaskUpdateOne) ClearProject() *TaskUpdateOne {
+	tuo.mutation.ClearProject()
 	return tuo
 }
 
-// SetNillableDueDate sets the "due_date" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableDueDate(t *time.Time) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetDueDate(*t)
+// ClearLabels clears all "labels" edges to the Label entity.
+func (tuo *TaskUpdateOne) ClearLabels() *TaskUpdateOne {
+	tuo.mutation.ClearLabels()
+	return tuo
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (tuo *TaskUpdateOne) RemoveLabelIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveLabelIDs(ids...)
+	return tuo
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (tuo *TaskUpdateOne) RemoveLabels(l ...*Label) *TaskUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
+	return tuo.RemoveLabelIDs(ids...)
+}
+
+// ClearAssignees clears all "assignees" edges to the Employee entity.
+func (tuo *TaskUpdateOne) ClearAssignees() *TaskUpdateOne {
+	tuo.mutation.ClearAssignees()
 	return tuo
 }
 
-// ClearDueDate clears the value of the "due_date" field.
-func (tuo *TaskUpdateOne) ClearDueDate() *TaskUpdateOne {
-	tuo.mutation.ClearDueDate()
+// RemoveAssigneeIDs removes the "assignees" edge to Employee entities by IDs.
+func (tuo *TaskUpdateOne) RemoveAssigneeIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveAssigneeIDs(ids...)
 	return tuo
 }
 
-// SetProjectID sets the "project_id" field.
-func (tuo *TaskUpdateOne) SetProjectID(i int) *TaskUpdateOne {
-	tuo.mutation.SetProjectID(i)
+// RemoveAssignees removes "assignees" edges to Employee entities.
+func (tuo *TaskUpdateOne) RemoveAssignees(e ...*Employee) *TaskUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tuo.RemoveAssigneeIDs(ids...)
+}
+
+// ClearReports clears all "reports" edges to the TaskReport entity.
+func (tuo *TaskUpdateOne) ClearReports() *TaskUpdateOne {
+	tuo.mutation.ClearReports()
 	return tuo
 }
 
-// SetNillableProjectID sets the "project_id" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableProjectID(i *int) *TaskUpdateOne {
-	if i != nil {
-		tuo.SetProjectID(*i)
+// RemoveReportIDs removes the "reports" edge to TaskReport entities by IDs.
+func (tuo *TaskUpdateOne) RemoveReportIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveReportIDs(ids...)
+	return tuo
+}
+
+// RemoveReports removes "reports" edges to TaskReport entities.
+func (tuo *TaskUpdateOne) RemoveReports(t ...*TaskReport) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveReportIDs(ids...)
+}
+
+// ClearOvertimeRequests clears all "overtime_requests" edges to the OvertimeRequest entity.
+func (tuo *TaskUpdateOne) ClearOvertimeRequests() *TaskUpdateOne {
+	tuo.mutation.ClearOvertimeRequests()
 	return tuo
 }
 
-// ClearProjectID clears the value of the "project_id" field.
-func (tuo *TaskUpdateOne) ClearProjectID() *TaskUpdateOne {
-	tuo.mutation.ClearProjectID()
+// RemoveOvertimeRequestIDs removes the "overtime_requests" edge to OvertimeRequest entities by IDs.
+func (tuo *TaskUpdateOne) RemoveOvertimeRequestIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveOvertimeRequestIDs(ids...)
 	return tuo
 }
 
-// SetCreatorID sets the "creator_id" field.
-func (tuo *TaskUpdateOne) SetCreatorID(i int) *TaskUpdateOne {
-	tuo.mutation.ResetCreatorID()
-	tuo.mutation.SetCreatorID(i)
+// RemoveOvertimeRequests removes "overtime_requests" edges to OvertimeRequest entities.
+func (tuo *TaskUpdateOne) RemoveOvertimeRequests(o ...*OvertimeRequest) *TaskUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return tuo.RemoveOvertimeRequestIDs(ids...)
+}
+
+// ClearChildren clears all "children" edges to the Task entity.
+func (tuo *TaskUpdateOne) ClearChildren() *TaskUpdateOne {
+	tuo.mutation.ClearChildren()
 	return tuo
 }
 
-// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableCreatorID(i *int) *TaskUpdateOne {
-	if i != nil {
-		tuo.SetCreatorID(*i)
+// RemoveChildIDs removes the "children" edge to Task entities by IDs.
+func (tuo *TaskUpdateOne) RemoveChildIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveChildIDs(ids...)
+	return tuo
+}
+
+// RemoveChildren removes "children" edges to Task entities.
+func (tuo *TaskUpdateOne) RemoveChildren(t ...*Task) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveChildIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Task entity.
+func (tuo *TaskUpdateOne) ClearParent() *TaskUpdateOne {
+	tuo.mutation.ClearParent()
 	return tuo
 }
 
-// AddCreatorID adds i to the "creator_id" field.
-func (tuo *TaskUpdateOne) AddCreatorID(i int) *TaskUpdateOne {
-	tuo.mutation.AddCreatorID(i)
+// ClearDependencies clears all "dependencies" edges to the TaskDependency entity.
+func (tuo *TaskUpdateOne) ClearDependencies() *TaskUpdateOne {
+	tuo.mutation.ClearDependencies()
 	return tuo
 }
 
-// SetUpdaterID sets the "updater_id" field.
-func (tuo *TaskUpdateOne) SetUpdaterID(i int) *TaskUpdateOne {
-	tuo.mutation.ResetUpdaterID()
-	tuo.mutation.SetUpdaterID(i)
+// RemoveDependencyIDs removes the "dependencies" edge to TaskDependency entities by IDs.
+func (tuo *TaskUpdateOne) RemoveDependencyIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveDependencyIDs(ids...)
 	return tuo
 }
 
-// SetNillableUpdaterID sets the "updater_id" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableUpdaterID(i *int) *TaskUpdateOne {
-	if i != nil {
-		tuo.SetUpdaterID(*i)
+// RemoveDependencies removes "dependencies" edges to TaskDependency entities.
+func (tuo *TaskUpdateOne) RemoveDependencies(t ...*TaskDependency) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveDependencyIDs(ids...)
+}
+
+// ClearDependents clears all "dependents" edges to the TaskDependency entity.
+func (tuo *TaskUpdateOne) ClearDependents() *TaskUpdateOne {
+	tuo.mutation.ClearDependents()
 	return tuo
 }
 
-// AddUpdaterID adds i to the "updater_id" field.
-func (tuo *TaskUpdateOne) AddUpdaterID(i int) *TaskUpdateOne {
-	tuo.mutation.AddUpdaterID(i)
+// RemoveDependentIDs removes the "dependents" edge to TaskDependency entities by IDs.
+func (tuo *TaskUpdateOne) RemoveDependentIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveDependentIDs(ids...)
 	return tuo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (tuo *TaskUpdateOne) SetUpdatedAt(t time.Time) *TaskUpdateOne {
-	tuo.mutation.SetUpdatedAt(t)
+// RemoveDependents removes "dependents" edges to TaskDependency entities.
+func (tuo *TaskUpdateOne) RemoveDependents(t ...*TaskDependency) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveDependentIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the TaskComment entity.
+func (tuo *TaskUpdateOne) ClearComments() *TaskUpdateOne {
+	tuo.mutation.ClearComments()
 	return tuo
 }
 
-// SetType sets the "type" field.
-func (tuo *TaskUpdateOne) SetType(t task.Type) *TaskUpdateOne {
-	tuo.mutation.SetType(t)
+// RemoveCommentIDs removes the "comments" edge to TaskComment entities by IDs.
+func (tuo *TaskUpdateOne) RemoveCommentIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveCommentIDs(ids...)
 	return tuo
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableType(t *task.Type) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetType(*t)
+// RemoveComments removes "comments" edges to TaskComment entities.
+func (tuo *TaskUpdateOne) RemoveComments(t ...*TaskComment) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return tuo.RemoveCommentIDs(ids...)
+}
+
+// ClearWorkLogs clears all "work_logs" edges to the WorkLog entity.
+func (tuo *TaskUpdateOne) ClearWorkLogs() *TaskUpdateOne {
+	tuo.mutation.ClearWorkLogs()
 	return tuo
 }
 
-// SetProject sets the "project" edge to the Project entity.
-func (tuo *TaskUpdateOne) SetProject(p *Project) *TaskUpdateOne {
-	return tuo.SetProjectID(p.ID)
+// RemoveWorkLogIDs removes the "work_logs" edge to WorkLog entities by IDs.
+func (tuo *TaskUpdateOne) RemoveWorkLogIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveWorkLogIDs(ids...)
+	return tuo
 }
 
-// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
-func (tuo *TaskUpdateOne) AddLabelIDs(ids ...int) *TaskUpdateOne {
-	tuo.mutation.AddLabelIDs(ids...)
+// RemoveWorkLogs removes "work_logs" edges to WorkLog entities.
+func (tuo *TaskUpdateOne) RemoveWorkLogs(w ...*WorkLog) *TaskUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.RemoveWorkLogIDs(ids...)
+}
+
+// ClearSprint clears the "sprint" edge to the Sprint entity.
+func (tuo *TaskUpdateOne) ClearSprint() *TaskUpdateOne {
+	tuo.mutation.ClearSprint()
 	return tuo
 }
 
-// AddLabels adds the "labels" edges to the Label entity.
-func (tuo *TaskUpdateOne) AddLabels(l ...*Label) *TaskUpdateOne {
-	ids := make([]int, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+// ClearMilestone clears the "milestone" edge to the Milestone entity.
+func (tuo *TaskUpdateOne) ClearMilestone() *TaskUpdateOne {
+	tuo.mutation.ClearMilestone()
+	return tuo
+}
+
+// ClearActivities clears all "activities" edges to the TaskActivity entity.
+func (tuo *TaskUpdateOne) ClearActivities() *TaskUpdateOne {
+	tuo.mutation.ClearActivities()
+	return tuo
+}
+
+// RemoveActivityIDs removes the "activities" edge to TaskActivity entities by IDs.
+func (tuo *TaskUpdateOne) RemoveActivityIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveActivityIDs(ids...)
+	return tuo
+}
+
+// RemoveActivities removes "activities" edges to TaskActivity entities.
+func (tuo *TaskUpdateOne) RemoveActivities(t ...*TaskActivity) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return tuo.AddLabelIDs(ids...)
+	return tuo.RemoveActivityIDs(ids...)
 }
 
-// AddAssigneeIDs adds the "assignees" edge to the Employee entity by IDs.
-func (tuo *TaskUpdateOne) AddAssigneeIDs(ids ...int) *TaskUpdateOne {
-	tuo.mutation.AddAssigneeIDs(ids...)
+// ClearWatchers clears all "watchers" edges to the TaskWatcher entity.
+func (tuo *TaskUpdateOne) ClearWatchers() *TaskUpdateOne {
+	tuo.mutation.ClearWatchers()
 	return tuo
 }
 
-// AddAssignees adds the "assignees" edges to the Employee entity.
-func (tuo *TaskUpdateOne) AddAssignees(e ...*Employee) *TaskUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
+// RemoveWatcherIDs removes the "watchers" edge to TaskWatcher entities by IDs.
+func (tuo *TaskUpdateOne) RemoveWatcherIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveWatcherIDs(ids...)
+	return tuo
+}
+
+// RemoveWatchers removes "watchers" edges to TaskWatcher entities.
+func (tuo *TaskUpdateOne) RemoveWatchers(t ...*TaskWatcher) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return tuo.AddAssigneeIDs(ids...)
+	return tuo.RemoveWatcherIDs(ids...)
 }
 
-// AddReportIDs adds the "reports" edge to the TaskReport entity by IDs.
-func (tuo *TaskUpdateOne) AddReportIDs(ids ...int) *TaskUpdateOne {
-	tuo.mutation.AddReportIDs(ids...)
+// ClearReminders clears all "reminders" edges to the TaskReminder entity.
+func (tuo *TaskUpdateOne) ClearReminders() *TaskUpdateOne {
+	tuo.mutation.ClearReminders()
 	return tuo
 }
 
-// AddReports adds the "reports" edges to the TaskReport entity.
-func (tuo *TaskUpdateOne) AddReports(t ...*TaskReport) *TaskUpdateOne {
+// RemoveReminderIDs removes the "reminders" edge to TaskReminder entities by IDs.
+func (tuo *TaskUpdateOne) RemoveReminderIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveReminderIDs(ids...)
+	return tuo
+}
+
+// RemoveReminders removes "reminders" edges to TaskReminder entities.
+func (tuo *TaskUpdateOne) RemoveReminders(t ...*TaskReminder) *TaskUpdateOne {
 	ids := make([]int, len(t))
 	for i := range t {
 		ids[i] = t[i].ID
 	}
-	return tuo.AddReportIDs(ids...)
+	return tuo.RemoveReminderIDs(ids...)
 }
 
-// Mutation returns the TaskMutation object of the builder.
-func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
-	return tuo.mutation
+// Where appends a list predicates to the TaskUpdate builder.
+func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
 }
 
-// ClearProject clears the "project" edge to the Project entity.
-func (tuo *TaskUpdateOne) ClearProject() *TaskUpdateOne {
-	tuo.mutation.ClearProject()
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TaskUpdateOne) Select(field string, fields ...string) *TaskUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
 	return tuo
 }
 
-// ClearLabels clears all "labels" edges to the Label entity.
-func (tuo *TaskUpdateOne) ClearLabels() *TaskUpdateOne {
-	tuo.mutation.ClearLabels()
-	return tuo
+// Save executes the query and returns the updated Task entity.
+func (tuo *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
+	tuo.defaults()
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
 }
 
-// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
-func (tuo *TaskUpdateOne) RemoveLabelIDs(ids ...int) *TaskUpdateOne {
-	tuo.mutation.RemoveLabelIDs(ids...)
-	return tuo
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TaskUpdateOne) SaveX(ctx context.Context) *Task {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
 }
 
-// RemoveLabels removes "labels" edges to Label entities.
-func (tuo *TaskUpdateOne) RemoveLabels(l ...*Label) *TaskUpdateOne {
-	ids := make([]int, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+// Exec executes the query on the entity.
+func (tuo *TaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tuo *TaskUpdateOne) defaults() {
+	if _, ok := tuo.mutation.UpdatedAt(); !ok {
+		v := task.UpdateDefaultUpdatedAt()
+		tuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TaskUpdateOne) check() error {
+	if v, ok := tuo.mutation.Status(); ok {
+		if err := task.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.GetType(); ok {
+		if err := task.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Task.type": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.OriginalEstimate(); ok {
+		if err := task.OriginalEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "original_estimate", err: fmt.Errorf(`ent: validator failed for field "Task.original_estimate": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.RemainingEstimate(); ok {
+		if err := task.RemainingEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "remaining_estimate", err: fmt.Errorf(`ent: validator failed for field "Task.remaining_estimate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Task.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, task.FieldID)
+		for _, f := range fields {
+			if !task.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != task.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Name(); ok {
+		_spec.SetField(task.FieldName, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Code(); ok {
+		_spec.SetField(task.FieldCode, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Description(); ok {
+		_spec.SetField(task.FieldDescription, field.TypeString, value)
+	}
+	if tuo.mutation.DescriptionCleared() {
+		_spec.ClearField(task.FieldDescription, field.TypeString)
+	}
+	if value, ok := tuo.mutation.Process(); ok {
+		_spec.SetField(task.FieldProcess, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedProcess(); ok {
+		_spec.AddField(task.FieldProcess, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.StartAt(); ok {
+		_spec.SetField(task.FieldStartAt, field.TypeTime, value)
+	}
+	if tuo.mutation.StartAtCleared() {
+		_spec.ClearField(task.FieldStartAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.DueDate(); ok {
+		_spec.SetField(task.FieldDueDate, field.TypeTime, value)
+	}
+	if tuo.mutation.DueDateCleared() {
+		_spec.ClearField(task.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.CreatorID(); ok {
+		_spec.SetField(task.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedCreatorID(); ok {
+		_spec.AddField(task.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.UpdaterID(); ok {
+		_spec.SetField(task.FieldUpdaterID, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedUpdaterID(); ok {
+		_spec.AddField(task.FieldUpdaterID, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tuo.mutation.GetType(); ok {
+		_spec.SetField(task.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.OriginalEstimate(); ok {
+		_spec.SetField(task.FieldOriginalEstimate, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedOriginalEstimate(); ok {
+		_spec.AddField(task.FieldOriginalEstimate, field.TypeInt, value)
+	}
+	if tuo.mutation.OriginalEstimateCleared() {
+		_spec.ClearField(task.FieldOriginalEstimate, field.TypeInt)
+	}
+	if value, ok := tuo.mutation.RemainingEstimate(); ok {
+		_spec.SetField(task.FieldRemainingEstimate, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedRemainingEstimate(); ok {
+		_spec.AddField(task.FieldRemainingEstimate, field.TypeInt, value)
+	}
+	if tuo.mutation.RemainingEstimateCleared() {
+		_spec.ClearField(task.FieldRemainingEstimate, field.TypeInt)
+	}
+	if value, ok := tuo.mutation.WorkflowStatus(); ok {
+		_spec.SetField(task.FieldWorkflowStatus, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.CompletedAt(); ok {
+		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(task.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.RecurrenceRunID(); ok {
+		_spec.SetField(task.FieldRecurrenceRunID, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedRecurrenceRunID(); ok {
+		_spec.AddField(task.FieldRecurrenceRunID, field.TypeInt, value)
+	}
+	if tuo.mutation.RecurrenceRunIDCleared() {
+		_spec.ClearField(task.FieldRecurrenceRunID, field.TypeInt)
+	}
+	if value, ok := tuo.mutation.OrgID(); ok {
+		_spec.SetField(task.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedOrgID(); ok {
+		_spec.AddField(task.FieldOrgID, field.TypeInt, value)
+	}
+	if tuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !tuo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return tuo.RemoveLabelIDs(ids...)
-}
-
-// ClearAssignees clears all "assignees" edges to the Employee entity.
-func (tuo *TaskUpdateOne) ClearAssignees() *TaskUpdateOne {
-	tuo.mutation.ClearAssignees()
-	return tuo
-}
-
-// RemoveAssigneeIDs removes the "assignees" edge to Employee entities by IDs.
-func (tuo *TaskUpdateOne) RemoveAssigneeIDs(ids ...int) *TaskUpdateOne {
-	tuo.mutation.RemoveAssigneeIDs(ids...)
-	return tuo
-}
-
-// RemoveAssignees removes "assignees" edges to Employee entities.
-func (tuo *TaskUpdateOne) RemoveAssignees(e ...*Employee) *TaskUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
+	if tuo.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo.RemoveAssigneeIDs(ids...)
-}
-
-// ClearReports clears all "reports" edges to the TaskReport entity.
-func (tuo *TaskUpdateOne) ClearReports() *TaskUpdateOne {
-	tuo.mutation.ClearReports()
-	return tuo
-}
-
-// RemoveReportIDs removes the "reports" edge to TaskReport entities by IDs.
-func (tuo *TaskUpdateOne) RemoveReportIDs(ids ...int) *TaskUpdateOne {
-	tuo.mutation.RemoveReportIDs(ids...)
-	return tuo
-}
-
-// RemoveReports removes "reports" edges to TaskReport entities.
-func (tuo *TaskUpdateOne) RemoveReports(t ...*TaskReport) *TaskUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+	if nodes := tuo.mutation.RemovedAssigneesIDs(); len(nodes) > 0 && !tuo.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo.RemoveReportIDs(ids...)
-}
-
-// Where appends a list predicates to the TaskUpdate builder.
-func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
-	tuo.mutation.Where(ps...)
-	return tuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (tuo *TaskUpdateOne) Select(field string, fields ...string) *TaskUpdateOne {
-	tuo.fields = append([]string{field}, fields...)
-	return tuo
-}
-
-// Save executes the query and returns the updated Task entity.
-func (tuo *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
-	tuo.defaults()
-	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (tuo *TaskUpdateOne) SaveX(ctx context.Context) *Task {
-	node, err := tuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := tuo.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (tuo *TaskUpdateOne) Exec(ctx context.Context) error {
-	_, err := tuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
-	if err := tuo.Exec(ctx); err != nil {
-		panic(err)
+	if tuo.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ReportsTable,
+			Columns: []string{task.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (tuo *TaskUpdateOne) defaults() {
-	if _, ok := tuo.mutation.UpdatedAt(); !ok {
-		v := task.UpdateDefaultUpdatedAt()
-		tuo.mutation.SetUpdatedAt(v)
+	if nodes := tuo.mutation.RemovedReportsIDs(); len(nodes) > 0 && !tuo.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ReportsTable,
+			Columns: []string{task.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (tuo *TaskUpdateOne) check() error {
-	if v, ok := tuo.mutation.Status(); ok {
-		if err := task.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+	if nodes := tuo.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ReportsTable,
+			Columns: []string{task.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := tuo.mutation.GetType(); ok {
-		if err := task.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Task.type": %w`, err)}
+	if tuo.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.OvertimeRequestsTable,
+			Columns: []string{task.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
-	if err := tuo.check(); err != nil {
-		return _node, err
+	if nodes := tuo.mutation.RemovedOvertimeRequestsIDs(); len(nodes) > 0 && !tuo.mutation.OvertimeRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.OvertimeRequestsTable,
+			Columns: []string{task.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt))
-	id, ok := tuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Task.id" for update`)}
+	if nodes := tuo.mutation.OvertimeRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.OvertimeRequestsTable,
+			Columns: []string{task.OvertimeRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(overtimerequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := tuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, task.FieldID)
-		for _, f := range fields {
-			if !task.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != task.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if tuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := tuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if tuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.Name(); ok {
-		_spec.SetField(task.FieldName, field.TypeString, value)
-	}
-	if value, ok := tuo.mutation.Code(); ok {
-		_spec.SetField(task.FieldCode, field.TypeString, value)
-	}
-	if value, ok := tuo.mutation.Description(); ok {
-		_spec.SetField(task.FieldDescription, field.TypeString, value)
+	if nodes := tuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if tuo.mutation.DescriptionCleared() {
-		_spec.ClearField(task.FieldDescription, field.TypeString)
+	if tuo.mutation.DependenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependenciesTable,
+			Columns: []string{task.DependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.Process(); ok {
-		_spec.SetField(task.FieldProcess, field.TypeInt, value)
+	if nodes := tuo.mutation.RemovedDependenciesIDs(); len(nodes) > 0 && !tuo.mutation.DependenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependenciesTable,
+			Columns: []string{task.DependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.AddedProcess(); ok {
-		_spec.AddField(task.FieldProcess, field.TypeInt, value)
+	if nodes := tuo.mutation.DependenciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependenciesTable,
+			Columns: []string{task.DependenciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := tuo.mutation.Status(); ok {
-		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
+	if tuo.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependentsTable,
+			Columns: []string{task.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.StartAt(); ok {
-		_spec.SetField(task.FieldStartAt, field.TypeTime, value)
+	if nodes := tuo.mutation.RemovedDependentsIDs(); len(nodes) > 0 && !tuo.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependentsTable,
+			Columns: []string{task.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if tuo.mutation.StartAtCleared() {
-		_spec.ClearField(task.FieldStartAt, field.TypeTime)
+	if nodes := tuo.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.DependentsTable,
+			Columns: []string{task.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := tuo.mutation.DueDate(); ok {
-		_spec.SetField(task.FieldDueDate, field.TypeTime, value)
+	if tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if tuo.mutation.DueDateCleared() {
-		_spec.ClearField(task.FieldDueDate, field.TypeTime)
+	if nodes := tuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.CreatorID(); ok {
-		_spec.SetField(task.FieldCreatorID, field.TypeInt, value)
+	if nodes := tuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := tuo.mutation.AddedCreatorID(); ok {
-		_spec.AddField(task.FieldCreatorID, field.TypeInt, value)
+	if tuo.mutation.WorkLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorkLogsTable,
+			Columns: []string{task.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.UpdaterID(); ok {
-		_spec.SetField(task.FieldUpdaterID, field.TypeInt, value)
+	if nodes := tuo.mutation.RemovedWorkLogsIDs(); len(nodes) > 0 && !tuo.mutation.WorkLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorkLogsTable,
+			Columns: []string{task.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.AddedUpdaterID(); ok {
-		_spec.AddField(task.FieldUpdaterID, field.TypeInt, value)
+	if nodes := tuo.mutation.WorkLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorkLogsTable,
+			Columns: []string{task.WorkLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := tuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
+	if tuo.mutation.SprintCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.GetType(); ok {
-		_spec.SetField(task.FieldType, field.TypeEnum, value)
+	if nodes := tuo.mutation.SprintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if tuo.mutation.ProjectCleared() {
+	if tuo.mutation.MilestoneCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   task.ProjectTable,
-			Columns: []string{task.ProjectColumn},
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.ProjectIDs(); len(nodes) > 0 {
+	if nodes := tuo.mutation.MilestoneIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   task.ProjectTable,
-			Columns: []string{task.ProjectColumn},
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1161,28 +3398,28 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if tuo.mutation.LabelsCleared() {
+	if tuo.mutation.ActivitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.LabelsTable,
-			Columns: task.LabelsPrimaryKey,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !tuo.mutation.LabelsCleared() {
+	if nodes := tuo.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !tuo.mutation.ActivitiesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.LabelsTable,
-			Columns: task.LabelsPrimaryKey,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1190,15 +3427,15 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.LabelsIDs(); len(nodes) > 0 {
+	if nodes := tuo.mutation.ActivitiesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.LabelsTable,
-			Columns: task.LabelsPrimaryKey,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1206,28 +3443,28 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if tuo.mutation.AssigneesCleared() {
+	if tuo.mutation.WatchersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.AssigneesTable,
-			Columns: task.AssigneesPrimaryKey,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.RemovedAssigneesIDs(); len(nodes) > 0 && !tuo.mutation.AssigneesCleared() {
+	if nodes := tuo.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !tuo.mutation.WatchersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.AssigneesTable,
-			Columns: task.AssigneesPrimaryKey,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1235,15 +3472,15 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.AssigneesIDs(); len(nodes) > 0 {
+	if nodes := tuo.mutation.WatchersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.AssigneesTable,
-			Columns: task.AssigneesPrimaryKey,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1251,28 +3488,28 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if tuo.mutation.ReportsCleared() {
+	if tuo.mutation.RemindersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.ReportsTable,
-			Columns: []string{task.ReportsColumn},
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.RemovedReportsIDs(); len(nodes) > 0 && !tuo.mutation.ReportsCleared() {
+	if nodes := tuo.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !tuo.mutation.RemindersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.ReportsTable,
-			Columns: []string{task.ReportsColumn},
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1280,15 +3517,15 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.ReportsIDs(); len(nodes) > 0 {
+	if nodes := tuo.mutation.RemindersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.ReportsTable,
-			Columns: []string{task.ReportsColumn},
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(taskreport.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+)
+
+// TaskActivity is the model entity for the TaskActivity schema.
+type TaskActivity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id"`
+	// ActorUserID holds the value of the "actor_user_id" field.
+	ActorUserID int `json:"actor_user_id"`
+	// ActorEmployeeID holds the value of the "actor_employee_id" field.
+	ActorEmployeeID int `json:"actor_employee_id"`
+	// Kind holds the value of the "kind" field.
+	Kind taskactivity.Kind `json:"kind"`
+	// FieldName holds the value of the "field_name" field.
+	FieldName string `json:"field_name"`
+	// OldValue holds the value of the "old_value" field.
+	OldValue string `json:"old_value"`
+	// NewValue holds the value of the "new_value" field.
+	NewValue string `json:"new_value"`
+	// RefID holds the value of the "ref_id" field.
+	RefID int `json:"ref_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskActivityQuery when eager-loading is set.
+	Edges        TaskActivityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskActivityEdges holds the relations/edges for other nodes in the graph.
+type TaskActivityEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskActivityEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskActivity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskactivity.FieldID, taskactivity.FieldOrgID, taskactivity.FieldTaskID, taskactivity.FieldProjectID, taskactivity.FieldActorUserID, taskactivity.FieldActorEmployeeID, taskactivity.FieldRefID:
+			values[i] = new(sql.NullInt64)
+		case taskactivity.FieldKind, taskactivity.FieldFieldName, taskactivity.FieldOldValue, taskactivity.FieldNewValue:
+			values[i] = new(sql.NullString)
+		case taskactivity.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskActivity fields.
+func (ta *TaskActivity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskactivity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ta.ID = int(value.Int64)
+		case taskactivity.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				ta.OrgID = int(value.Int64)
+			}
+		case taskactivity.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				ta.TaskID = int(value.Int64)
+			}
+		case taskactivity.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				ta.ProjectID = int(value.Int64)
+			}
+		case taskactivity.FieldActorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_user_id", values[i])
+			} else if value.Valid {
+				ta.ActorUserID = int(value.Int64)
+			}
+		case taskactivity.FieldActorEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_employee_id", values[i])
+			} else if value.Valid {
+				ta.ActorEmployeeID = int(value.Int64)
+			}
+		case taskactivity.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ta.Kind = taskactivity.Kind(value.String)
+			}
+		case taskactivity.FieldFieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field_name", values[i])
+			} else if value.Valid {
+				ta.FieldName = value.String
+			}
+		case taskactivity.FieldOldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_value", values[i])
+			} else if value.Valid {
+				ta.OldValue = value.String
+			}
+		case taskactivity.FieldNewValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_value", values[i])
+			} else if value.Valid {
+				ta.NewValue = value.String
+			}
+		case taskactivity.FieldRefID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ref_id", values[i])
+			} else if value.Valid {
+				ta.RefID = int(value.Int64)
+			}
+		case taskactivity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ta.CreatedAt = value.Time
+			}
+		default:
+			ta.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskActivity.
+// This includes values selected through modifiers, order, etc.
+func (ta *TaskActivity) Value(name string) (ent.Value, error) {
+	return ta.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskActivity entity.
+func (ta *TaskActivity) QueryTask() *TaskQuery {
+	return NewTaskActivityClient(ta.config).QueryTask(ta)
+}
+
+// Update returns a builder for updating this TaskActivity.
+// Note that you need to call TaskActivity.Unwrap() before calling this method if this TaskActivity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ta *TaskActivity) Update() *TaskActivityUpdateOne {
+	return NewTaskActivityClient(ta.config).UpdateOne(ta)
+}
+
+// Unwrap unwraps the TaskActivity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ta *TaskActivity) Unwrap() *TaskActivity {
+	_tx, ok := ta.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskActivity is not a transactional entity")
+	}
+	ta.config.driver = _tx.drv
+	return ta
+}
+
+// String implements the fmt.Stringer.
+func (ta *TaskActivity) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskActivity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ta.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("actor_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.ActorUserID))
+	builder.WriteString(", ")
+	builder.WriteString("actor_employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.ActorEmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ta.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("field_name=")
+	builder.WriteString(ta.FieldName)
+	builder.WriteString(", ")
+	builder.WriteString("old_value=")
+	builder.WriteString(ta.OldValue)
+	builder.WriteString(", ")
+	builder.WriteString("new_value=")
+	builder.WriteString(ta.NewValue)
+	builder.WriteString(", ")
+	builder.WriteString("ref_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.RefID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ta.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskActivities is a parsable slice of TaskActivity.
+type TaskActivities []*TaskActivity
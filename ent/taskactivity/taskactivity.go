@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskactivity
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the taskactivity type in the database.
+	Label = "task_activity"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldActorUserID holds the string denoting the actor_user_id field in the database.
+	FieldActorUserID = "actor_user_id"
+	// FieldActorEmployeeID holds the string denoting the actor_employee_id field in the database.
+	FieldActorEmployeeID = "actor_employee_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldFieldName holds the string denoting the field_name field in the database.
+	FieldFieldName = "field_name"
+	// FieldOldValue holds the string denoting the old_value field in the database.
+	FieldOldValue = "old_value"
+	// FieldNewValue holds the string denoting the new_value field in the database.
+	FieldNewValue = "new_value"
+	// FieldRefID holds the string denoting the ref_id field in the database.
+	FieldRefID = "ref_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTask holds the string denoting the task edge name in mutations.
+	EdgeTask = "task"
+	// Table holds the table name of the taskactivity in the database.
+	Table = "task_activities"
+	// TaskTable is the table that holds the task relation/edge.
+	TaskTable = "task_activities"
+	// TaskInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TaskInverseTable = "tasks"
+	// TaskColumn is the table column denoting the task relation/edge.
+	TaskColumn = "task_id"
+)
+
+// Columns holds all SQL columns for taskactivity fields.
+var Columns = []string{
+	FieldID,
+	FieldOrgID,
+	FieldTaskID,
+	FieldProjectID,
+	FieldActorUserID,
+	FieldActorEmployeeID,
+	FieldKind,
+	FieldFieldName,
+	FieldOldValue,
+	FieldNewValue,
+	FieldRefID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindCreated         Kind = "created"
+	KindFieldChanged    Kind = "field_changed"
+	KindStatusChanged   Kind = "status_changed"
+	KindAssigneeAdded   Kind = "assignee_added"
+	KindAssigneeRemoved Kind = "assignee_removed"
+	KindLabelAdded      Kind = "label_added"
+	KindLabelRemoved    Kind = "label_removed"
+	KindCommentAdded    Kind = "comment_added"
+	KindCommentEdited   Kind = "comment_edited"
+	KindCommentDeleted  Kind = "comment_deleted"
+	KindReportSubmitted Kind = "report_submitted"
+	KindReportUpdated   Kind = "report_updated"
+	KindReportReviewed  Kind = "report_reviewed"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindCreated, KindFieldChanged, KindStatusChanged, KindAssigneeAdded, KindAssigneeRemoved, KindLabelAdded, KindLabelRemoved, KindCommentAdded, KindCommentEdited, KindCommentDeleted, KindReportSubmitted, KindReportUpdated, KindReportReviewed:
+		return nil
+	default:
+		return fmt.Errorf("taskactivity: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the TaskActivity queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByActorUserID orders the results by the actor_user_id field.
+func ByActorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorUserID, opts...).ToFunc()
+}
+
+// ByActorEmployeeID orders the results by the actor_employee_id field.
+func ByActorEmployeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorEmployeeID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByFieldName orders the results by the field_name field.
+func ByFieldName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFieldName, opts...).ToFunc()
+}
+
+// ByOldValue orders the results by the old_value field.
+func ByOldValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldValue, opts...).ToFunc()
+}
+
+// ByNewValue orders the results by the new_value field.
+func ByNewValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewValue, opts...).ToFunc()
+}
+
+// ByRefID orders the results by the ref_id field.
+func ByRefID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTaskField orders the results by task field.
+func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTaskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+	)
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskactivity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldID, id))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldOrgID, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldTaskID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ActorUserID applies equality check predicate on the "actor_user_id" field. It's identical to ActorUserIDEQ.
+func ActorUserID(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldActorUserID, v))
+}
+
+// ActorEmployeeID applies equality check predicate on the "actor_employee_id" field. It's identical to ActorEmployeeIDEQ.
+func ActorEmployeeID(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldActorEmployeeID, v))
+}
+
+// FieldName applies equality check predicate on the "field_name" field. It's identical to FieldNameEQ.
+func FieldName(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldFieldName, v))
+}
+
+// OldValue applies equality check predicate on the "old_value" field. It's identical to OldValueEQ.
+func OldValue(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldOldValue, v))
+}
+
+// NewValue applies equality check predicate on the "new_value" field. It's identical to NewValueEQ.
+func NewValue(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldNewValue, v))
+}
+
+// RefID applies equality check predicate on the "ref_id" field. It's identical to RefIDEQ.
+func RefID(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldRefID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldOrgID, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldProjectID))
+}
+
+// ActorUserIDEQ applies the EQ predicate on the "actor_user_id" field.
+func ActorUserIDEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldActorUserID, v))
+}
+
+// ActorUserIDNEQ applies the NEQ predicate on the "actor_user_id" field.
+func ActorUserIDNEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldActorUserID, v))
+}
+
+// ActorUserIDIn applies the In predicate on the "actor_user_id" field.
+func ActorUserIDIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldActorUserID, vs...))
+}
+
+// ActorUserIDNotIn applies the NotIn predicate on the "actor_user_id" field.
+func ActorUserIDNotIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldActorUserID, vs...))
+}
+
+// ActorUserIDGT applies the GT predicate on the "actor_user_id" field.
+func ActorUserIDGT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldActorUserID, v))
+}
+
+// ActorUserIDGTE applies the GTE predicate on the "actor_user_id" field.
+func ActorUserIDGTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldActorUserID, v))
+}
+
+// ActorUserIDLT applies the LT predicate on the "actor_user_id" field.
+func ActorUserIDLT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldActorUserID, v))
+}
+
+// ActorUserIDLTE applies the LTE predicate on the "actor_user_id" field.
+func ActorUserIDLTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldActorUserID, v))
+}
+
+// ActorUserIDIsNil applies the IsNil predicate on the "actor_user_id" field.
+func ActorUserIDIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldActorUserID))
+}
+
+// ActorUserIDNotNil applies the NotNil predicate on the "actor_user_id" field.
+func ActorUserIDNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldActorUserID))
+}
+
+// ActorEmployeeIDEQ applies the EQ predicate on the "actor_employee_id" field.
+func ActorEmployeeIDEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldActorEmployeeID, v))
+}
+
+// ActorEmployeeIDNEQ applies the NEQ predicate on the "actor_employee_id" field.
+func ActorEmployeeIDNEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldActorEmployeeID, v))
+}
+
+// ActorEmployeeIDIn applies the In predicate on the "actor_employee_id" field.
+func ActorEmployeeIDIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldActorEmployeeID, vs...))
+}
+
+// ActorEmployeeIDNotIn applies the NotIn predicate on the "actor_employee_id" field.
+func ActorEmployeeIDNotIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldActorEmployeeID, vs...))
+}
+
+// ActorEmployeeIDGT applies the GT predicate on the "actor_employee_id" field.
+func ActorEmployeeIDGT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldActorEmployeeID, v))
+}
+
+// ActorEmployeeIDGTE applies the GTE predicate on the "actor_employee_id" field.
+func ActorEmployeeIDGTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldActorEmployeeID, v))
+}
+
+// ActorEmployeeIDLT applies the LT predicate on the "actor_employee_id" field.
+func ActorEmployeeIDLT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldActorEmployeeID, v))
+}
+
+// ActorEmployeeIDLTE applies the LTE predicate on the "actor_employee_id" field.
+func ActorEmployeeIDLTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldActorEmployeeID, v))
+}
+
+// ActorEmployeeIDIsNil applies the IsNil predicate on the "actor_employee_id" field.
+func ActorEmployeeIDIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldActorEmployeeID))
+}
+
+// ActorEmployeeIDNotNil applies the NotNil predicate on the "actor_employee_id" field.
+func ActorEmployeeIDNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldActorEmployeeID))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// FieldNameEQ applies the EQ predicate on the "field_name" field.
+func FieldNameEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldFieldName, v))
+}
+
+// FieldNameNEQ applies the NEQ predicate on the "field_name" field.
+func FieldNameNEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldFieldName, v))
+}
+
+// FieldNameIn applies the In predicate on the "field_name" field.
+func FieldNameIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldFieldName, vs...))
+}
+
+// FieldNameNotIn applies the NotIn predicate on the "field_name" field.
+func FieldNameNotIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldFieldName, vs...))
+}
+
+// FieldNameGT applies the GT predicate on the "field_name" field.
+func FieldNameGT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldFieldName, v))
+}
+
+// FieldNameGTE applies the GTE predicate on the "field_name" field.
+func FieldNameGTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldFieldName, v))
+}
+
+// FieldNameLT applies the LT predicate on the "field_name" field.
+func FieldNameLT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldFieldName, v))
+}
+
+// FieldNameLTE applies the LTE predicate on the "field_name" field.
+func FieldNameLTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldFieldName, v))
+}
+
+// FieldNameContains applies the Contains predicate on the "field_name" field.
+func FieldNameContains(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContains(FieldFieldName, v))
+}
+
+// FieldNameHasPrefix applies the HasPrefix predicate on the "field_name" field.
+func FieldNameHasPrefix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasPrefix(FieldFieldName, v))
+}
+
+// FieldNameHasSuffix applies the HasSuffix predicate on the "field_name" field.
+func FieldNameHasSuffix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasSuffix(FieldFieldName, v))
+}
+
+// FieldNameIsNil applies the IsNil predicate on the "field_name" field.
+func FieldNameIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldFieldName))
+}
+
+// FieldNameNotNil applies the NotNil predicate on the "field_name" field.
+func FieldNameNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldFieldName))
+}
+
+// FieldNameEqualFold applies the EqualFold predicate on the "field_name" field.
+func FieldNameEqualFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEqualFold(FieldFieldName, v))
+}
+
+// FieldNameContainsFold applies the ContainsFold predicate on the "field_name" field.
+func FieldNameContainsFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContainsFold(FieldFieldName, v))
+}
+
+// OldValueEQ applies the EQ predicate on the "old_value" field.
+func OldValueEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldOldValue, v))
+}
+
+// OldValueNEQ applies the NEQ predicate on the "old_value" field.
+func OldValueNEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldOldValue, v))
+}
+
+// OldValueIn applies the In predicate on the "old_value" field.
+func OldValueIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldOldValue, vs...))
+}
+
+// OldValueNotIn applies the NotIn predicate on the "old_value" field.
+func OldValueNotIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldOldValue, vs...))
+}
+
+// OldValueGT applies the GT predicate on the "old_value" field.
+func OldValueGT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldOldValue, v))
+}
+
+// OldValueGTE applies the GTE predicate on the "old_value" field.
+func OldValueGTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldOldValue, v))
+}
+
+// OldValueLT applies the LT predicate on the "old_value" field.
+func OldValueLT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldOldValue, v))
+}
+
+// OldValueLTE applies the LTE predicate on the "old_value" field.
+func OldValueLTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldOldValue, v))
+}
+
+// OldValueContains applies the Contains predicate on the "old_value" field.
+func OldValueContains(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContains(FieldOldValue, v))
+}
+
+// OldValueHasPrefix applies the HasPrefix predicate on the "old_value" field.
+func OldValueHasPrefix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasPrefix(FieldOldValue, v))
+}
+
+// OldValueHasSuffix applies the HasSuffix predicate on the "old_value" field.
+func OldValueHasSuffix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasSuffix(FieldOldValue, v))
+}
+
+// OldValueIsNil applies the IsNil predicate on the "old_value" field.
+func OldValueIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldOldValue))
+}
+
+// OldValueNotNil applies the NotNil predicate on the "old_value" field.
+func OldValueNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldOldValue))
+}
+
+// OldValueEqualFold applies the EqualFold predicate on the "old_value" field.
+func OldValueEqualFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEqualFold(FieldOldValue, v))
+}
+
+// OldValueContainsFold applies the ContainsFold predicate on the "old_value" field.
+func OldValueContainsFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContainsFold(FieldOldValue, v))
+}
+
+// NewValueEQ applies the EQ predicate on the "new_value" field.
+func NewValueEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldNewValue, v))
+}
+
+// NewValueNEQ applies the NEQ predicate on the "new_value" field.
+func NewValueNEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldNewValue, v))
+}
+
+// NewValueIn applies the In predicate on the "new_value" field.
+func NewValueIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldNewValue, vs...))
+}
+
+// NewValueNotIn applies the NotIn predicate on the "new_value" field.
+func NewValueNotIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldNewValue, vs...))
+}
+
+// NewValueGT applies the GT predicate on the "new_value" field.
+func NewValueGT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldNewValue, v))
+}
+
+// NewValueGTE applies the GTE predicate on the "new_value" field.
+func NewValueGTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldNewValue, v))
+}
+
+// NewValueLT applies the LT predicate on the "new_value" field.
+func NewValueLT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldNewValue, v))
+}
+
+// NewValueLTE applies the LTE predicate on the "new_value" field.
+func NewValueLTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldNewValue, v))
+}
+
+// NewValueContains applies the Contains predicate on the "new_value" field.
+func NewValueContains(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContains(FieldNewValue, v))
+}
+
+// NewValueHasPrefix applies the HasPrefix predicate on the "new_value" field.
+func NewValueHasPrefix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasPrefix(FieldNewValue, v))
+}
+
+// NewValueHasSuffix applies the HasSuffix predicate on the "new_value" field.
+func NewValueHasSuffix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasSuffix(FieldNewValue, v))
+}
+
+// NewValueIsNil applies the IsNil predicate on the "new_value" field.
+func NewValueIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldNewValue))
+}
+
+// NewValueNotNil applies the NotNil predicate on the "new_value" field.
+func NewValueNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldNewValue))
+}
+
+// NewValueEqualFold applies the EqualFold predicate on the "new_value" field.
+func NewValueEqualFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEqualFold(FieldNewValue, v))
+}
+
+// NewValueContainsFold applies the ContainsFold predicate on the "new_value" field.
+func NewValueContainsFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContainsFold(FieldNewValue, v))
+}
+
+// RefIDEQ applies the EQ predicate on the "ref_id" field.
+func RefIDEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldRefID, v))
+}
+
+// RefIDNEQ applies the NEQ predicate on the "ref_id" field.
+func RefIDNEQ(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldRefID, v))
+}
+
+// RefIDIn applies the In predicate on the "ref_id" field.
+func RefIDIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldRefID, vs...))
+}
+
+// RefIDNotIn applies the NotIn predicate on the "ref_id" field.
+func RefIDNotIn(vs ...int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldRefID, vs...))
+}
+
+// RefIDGT applies the GT predicate on the "ref_id" field.
+func RefIDGT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldRefID, v))
+}
+
+// RefIDGTE applies the GTE predicate on the "ref_id" field.
+func RefIDGTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldRefID, v))
+}
+
+// RefIDLT applies the LT predicate on the "ref_id" field.
+func RefIDLT(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldRefID, v))
+}
+
+// RefIDLTE applies the LTE predicate on the "ref_id" field.
+func RefIDLTE(v int) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldRefID, v))
+}
+
+// RefIDIsNil applies the IsNil predicate on the "ref_id" field.
+func RefIDIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldRefID))
+}
+
+// RefIDNotNil applies the NotNil predicate on the "ref_id" field.
+func RefIDNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldRefID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.TaskActivity {
+	return predicate.TaskActivity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.TaskActivity {
+	return predicate.TaskActivity(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskActivity) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskActivity) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskActivity) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.NotPredicates(p))
+}
@@ -0,0 +1,1277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+)
+
+// TaskActivityCreate is the builder for creating a TaskActivity entity.
+type TaskActivityCreate struct {
+	config
+	mutation *TaskActivityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (tac *TaskActivityCreate) SetOrgID(i int) *TaskActivityCreate {
+	tac.mutation.SetOrgID(i)
+	return tac
+}
+
+// SetTaskID sets the "task_id" field.
+func (tac *TaskActivityCreate) SetTaskID(i int) *TaskActivityCreate {
+	tac.mutation.SetTaskID(i)
+	return tac
+}
+
+// SetProjectID sets the "project_id" field.
+func (tac *TaskActivityCreate) SetProjectID(i int) *TaskActivityCreate {
+	tac.mutation.SetProjectID(i)
+	return tac
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tac *TaskActivityCreate) SetNillableProjectID(i *int) *TaskActivityCreate {
+	if i != nil {
+		tac.SetProjectID(*i)
+	}
+	return tac
+}
+
+// SetActorUserID sets the "actor_user_id" field.
+func (tac *TaskActivityCreate) SetActorUserID(i int) *TaskActivityCreate {
+	tac.mutation.SetActorUserID(i)
+	return tac
+}
+
+// SetNillableActorUserID sets the "actor_user_id" field if the given value is not nil.
+func (tac *TaskActivityCreate) SetNillableActorUserID(i *int) *TaskActivityCreate {
+	if i != nil {
+		tac.SetActorUserID(*i)
+	}
+	return tac
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (tac *TaskActivityCreate) SetActorEmployeeID(i int) *TaskActivityCreate {
+	tac.mutation.SetActorEmployeeID(i)
+	return tac
+}
+
+// SetNillableActorEmployeeID sets the "actor_employee_id" field if the given value is not nil.
+func (tac *TaskActivityCreate) SetNillableActorEmployeeID(i *int) *TaskActivityCreate {
+	if i != nil {
+		tac.SetActorEmployeeID(*i)
+	}
+	return tac
+}
+
+// SetKind sets the "kind" field.
+func (tac *TaskActivityCreate) SetKind(t taskactivity.Kind) *TaskActivityCreate {
+	tac.mutation.SetKind(t)
+	return tac
+}
+
+// SetFieldName sets the "field_name" field.
+func (tac *TaskActivityCreate) SetFieldName(s string) *TaskActivityCreate {
+	tac.mutation.SetFieldName(s)
+	return tac
+}
+
+// SetNillableFieldName sets the "field_name" field if the given value is not nil.
+func (tac *TaskActivityCreate) SetNillableFieldName(s *string) *TaskActivityCreate {
+	if s != nil {
+		tac.SetFieldName(*s)
+	}
+	return tac
+}
+
+// SetOldValue sets the "old_value" field.
+func (tac *TaskActivityCreate) SetOldValue(s string) *TaskActivityCreate {
+	tac.mutation.SetOldValue(s)
+	return tac
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (tac *TaskActivityCreate) SetNillableOldValue(s *string) *TaskActivityCreate {
+	if s != nil {
+		tac.SetOldValue(*s)
+	}
+	return tac
+}
+
+// SetNewValue sets the "new_value" field.
+func (tac *TaskActivityCreate) SetNewValue(s string) *TaskActivityCreate {
+	tac.mutation.SetNewValue(s)
+	return tac
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (tac *TaskActivityCreate) SetNillableNewValue(s *string) *TaskActivityCreate {
+	if s != nil {
+		tac.SetNewValue(*s)
+	}
+	return tac
+}
+
+// SetRefID sets the "ref_id" field.
+func (tac *TaskActivityCreate) SetRefID(i int) *TaskActivityCreate {
+	tac.mutation.SetRefID(i)
+	return tac
+}
+
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (tac *TaskActivityCreate) SetNillableRefID(i *int) *TaskActivityCreate {
+	if i != nil {
+		tac.SetRefID(*i)
+	}
+	return tac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tac *TaskActivityCreate) SetCreatedAt(t time.Time) *TaskActivityCreate {
+	tac.mutation.SetCreatedAt(t)
+	return tac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tac *TaskActivityCreate) SetNillableCreatedAt(t *time.Time) *TaskActivityCreate {
+	if t != nil {
+		tac.SetCreatedAt(*t)
+	}
+	return tac
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tac *TaskActivityCreate) SetTask(t *Task) *TaskActivityCreate {
+	return tac.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskActivityMutation object of the builder.
+func (tac *TaskActivityCreate) Mutation() *TaskActivityMutation {
+	return tac.mutation
+}
+
+// Save creates the TaskActivity in the database.
+func (tac *TaskActivityCreate) Save(ctx context.Context) (*TaskActivity, error) {
+	tac.defaults()
+	return withHooks(ctx, tac.sqlSave, tac.mutation, tac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tac *TaskActivityCreate) SaveX(ctx context.Context) *TaskActivity {
+	v, err := tac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tac *TaskActivityCreate) Exec(ctx context.Context) error {
+	_, err := tac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tac *TaskActivityCreate) ExecX(ctx context.Context) {
+	if err := tac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tac *TaskActivityCreate) defaults() {
+	if _, ok := tac.mutation.CreatedAt(); !ok {
+		v := taskactivity.DefaultCreatedAt()
+		tac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tac *TaskActivityCreate) check() error {
+	if _, ok := tac.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "TaskActivity.org_id"`)}
+	}
+	if _, ok := tac.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskActivity.task_id"`)}
+	}
+	if _, ok := tac.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "TaskActivity.kind"`)}
+	}
+	if v, ok := tac.mutation.Kind(); ok {
+		if err := taskactivity.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "TaskActivity.kind": %w`, err)}
+		}
+	}
+	if _, ok := tac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskActivity.created_at"`)}
+	}
+	if len(tac.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskActivity.task"`)}
+	}
+	return nil
+}
+
+func (tac *TaskActivityCreate) sqlSave(ctx context.Context) (*TaskActivity, error) {
+	if err := tac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tac.mutation.id = &_node.ID
+	tac.mutation.done = true
+	return _node, nil
+}
+
+func (tac *TaskActivityCreate) createSpec() (*TaskActivity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskActivity{config: tac.config}
+		_spec = sqlgraph.NewCreateSpec(taskactivity.Table, sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tac.conflict
+	if value, ok := tac.mutation.OrgID(); ok {
+		_spec.SetField(taskactivity.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := tac.mutation.ProjectID(); ok {
+		_spec.SetField(taskactivity.FieldProjectID, field.TypeInt, value)
+		_node.ProjectID = value
+	}
+	if value, ok := tac.mutation.ActorUserID(); ok {
+		_spec.SetField(taskactivity.FieldActorUserID, field.TypeInt, value)
+		_node.ActorUserID = value
+	}
+	if value, ok := tac.mutation.ActorEmployeeID(); ok {
+		_spec.SetField(taskactivity.FieldActorEmployeeID, field.TypeInt, value)
+		_node.ActorEmployeeID = value
+	}
+	if value, ok := tac.mutation.Kind(); ok {
+		_spec.SetField(taskactivity.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := tac.mutation.FieldName(); ok {
+		_spec.SetField(taskactivity.FieldFieldName, field.TypeString, value)
+		_node.FieldName = value
+	}
+	if value, ok := tac.mutation.OldValue(); ok {
+		_spec.SetField(taskactivity.FieldOldValue, field.TypeString, value)
+		_node.OldValue = value
+	}
+	if value, ok := tac.mutation.NewValue(); ok {
+		_spec.SetField(taskactivity.FieldNewValue, field.TypeString, value)
+		_node.NewValue = value
+	}
+	if value, ok := tac.mutation.RefID(); ok {
+		_spec.SetField(taskactivity.FieldRefID, field.TypeInt, value)
+		_node.RefID = value
+	}
+	if value, ok := tac.mutation.CreatedAt(); ok {
+		_spec.SetField(taskactivity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tac.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskActivity.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskActivityUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (tac *TaskActivityCreate) OnConflict(opts ...sql.ConflictOption) *TaskActivityUpsertOne {
+	tac.conflict = opts
+	return &TaskActivityUpsertOne{
+		create: tac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskActivity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tac *TaskActivityCreate) OnConflictColumns(columns ...string) *TaskActivityUpsertOne {
+	tac.conflict = append(tac.conflict, sql.ConflictColumns(columns...))
+	return &TaskActivityUpsertOne{
+		create: tac,
+	}
+}
+
+type (
+	// TaskActivityUpsertOne is the builder for "upsert"-ing
+	//  one TaskActivity node.
+	TaskActivityUpsertOne struct {
+		create *TaskActivityCreate
+	}
+
+	// TaskActivityUpsert is the "OnConflict" setter.
+	TaskActivityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskActivityUpsert) SetOrgID(v int) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateOrgID() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskActivityUpsert) AddOrgID(v int) *TaskActivityUpsert {
+	u.Add(taskactivity.FieldOrgID, v)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskActivityUpsert) SetTaskID(v int) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateTaskID() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldTaskID)
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskActivityUpsert) SetProjectID(v int) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateProjectID() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldProjectID)
+	return u
+}
+
+// AddProjectID adds v to the "project_id" field.
+func (u *TaskActivityUpsert) AddProjectID(v int) *TaskActivityUpsert {
+	u.Add(taskactivity.FieldProjectID, v)
+	return u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskActivityUpsert) ClearProjectID() *TaskActivityUpsert {
+	u.SetNull(taskactivity.FieldProjectID)
+	return u
+}
+
+// SetActorUserID sets the "actor_user_id" field.
+func (u *TaskActivityUpsert) SetActorUserID(v int) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldActorUserID, v)
+	return u
+}
+
+// UpdateActorUserID sets the "actor_user_id" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateActorUserID() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldActorUserID)
+	return u
+}
+
+// AddActorUserID adds v to the "actor_user_id" field.
+func (u *TaskActivityUpsert) AddActorUserID(v int) *TaskActivityUpsert {
+	u.Add(taskactivity.FieldActorUserID, v)
+	return u
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (u *TaskActivityUpsert) ClearActorUserID() *TaskActivityUpsert {
+	u.SetNull(taskactivity.FieldActorUserID)
+	return u
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (u *TaskActivityUpsert) SetActorEmployeeID(v int) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldActorEmployeeID, v)
+	return u
+}
+
+// UpdateActorEmployeeID sets the "actor_employee_id" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateActorEmployeeID() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldActorEmployeeID)
+	return u
+}
+
+// AddActorEmployeeID adds v to the "actor_employee_id" field.
+func (u *TaskActivityUpsert) AddActorEmployeeID(v int) *TaskActivityUpsert {
+	u.Add(taskactivity.FieldActorEmployeeID, v)
+	return u
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (u *TaskActivityUpsert) ClearActorEmployeeID() *TaskActivityUpsert {
+	u.SetNull(taskactivity.FieldActorEmployeeID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *TaskActivityUpsert) SetKind(v taskactivity.Kind) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateKind() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldKind)
+	return u
+}
+
+// SetFieldName sets the "field_name" field.
+func (u *TaskActivityUpsert) SetFieldName(v string) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldFieldName, v)
+	return u
+}
+
+// UpdateFieldName sets the "field_name" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateFieldName() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldFieldName)
+	return u
+}
+
+// ClearFieldName clears the value of the "field_name" field.
+func (u *TaskActivityUpsert) ClearFieldName() *TaskActivityUpsert {
+	u.SetNull(taskactivity.FieldFieldName)
+	return u
+}
+
+// SetOldValue sets the "old_value" field.
+func (u *TaskActivityUpsert) SetOldValue(v string) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldOldValue, v)
+	return u
+}
+
+// UpdateOldValue sets the "old_value" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateOldValue() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldOldValue)
+	return u
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (u *TaskActivityUpsert) ClearOldValue() *TaskActivityUpsert {
+	u.SetNull(taskactivity.FieldOldValue)
+	return u
+}
+
+// SetNewValue sets the "new_value" field.
+func (u *TaskActivityUpsert) SetNewValue(v string) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldNewValue, v)
+	return u
+}
+
+// UpdateNewValue sets the "new_value" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateNewValue() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldNewValue)
+	return u
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (u *TaskActivityUpsert) ClearNewValue() *TaskActivityUpsert {
+	u.SetNull(taskactivity.FieldNewValue)
+	return u
+}
+
+// SetRefID sets the "ref_id" field.
+func (u *TaskActivityUpsert) SetRefID(v int) *TaskActivityUpsert {
+	u.Set(taskactivity.FieldRefID, v)
+	return u
+}
+
+// UpdateRefID sets the "ref_id" field to the value that was provided on create.
+func (u *TaskActivityUpsert) UpdateRefID() *TaskActivityUpsert {
+	u.SetExcluded(taskactivity.FieldRefID)
+	return u
+}
+
+// AddRefID adds v to the "ref_id" field.
+func (u *TaskActivityUpsert) AddRefID(v int) *TaskActivityUpsert {
+	u.Add(taskactivity.FieldRefID, v)
+	return u
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (u *TaskActivityUpsert) ClearRefID() *TaskActivityUpsert {
+	u.SetNull(taskactivity.FieldRefID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskActivity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskActivityUpsertOne) UpdateNewValues() *TaskActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskactivity.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskActivity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskActivityUpsertOne) Ignore() *TaskActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskActivityUpsertOne) DoNothing() *TaskActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskActivityCreate.OnConflict
+// documentation for more info.
+func (u *TaskActivityUpsertOne) Update(set func(*TaskActivityUpsert)) *TaskActivityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskActivityUpsertOne) SetOrgID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskActivityUpsertOne) AddOrgID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateOrgID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskActivityUpsertOne) SetTaskID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateTaskID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskActivityUpsertOne) SetProjectID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// AddProjectID adds v to the "project_id" field.
+func (u *TaskActivityUpsertOne) AddProjectID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateProjectID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskActivityUpsertOne) ClearProjectID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetActorUserID sets the "actor_user_id" field.
+func (u *TaskActivityUpsertOne) SetActorUserID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetActorUserID(v)
+	})
+}
+
+// AddActorUserID adds v to the "actor_user_id" field.
+func (u *TaskActivityUpsertOne) AddActorUserID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddActorUserID(v)
+	})
+}
+
+// UpdateActorUserID sets the "actor_user_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateActorUserID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateActorUserID()
+	})
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (u *TaskActivityUpsertOne) ClearActorUserID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearActorUserID()
+	})
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (u *TaskActivityUpsertOne) SetActorEmployeeID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetActorEmployeeID(v)
+	})
+}
+
+// AddActorEmployeeID adds v to the "actor_employee_id" field.
+func (u *TaskActivityUpsertOne) AddActorEmployeeID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddActorEmployeeID(v)
+	})
+}
+
+// UpdateActorEmployeeID sets the "actor_employee_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateActorEmployeeID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateActorEmployeeID()
+	})
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (u *TaskActivityUpsertOne) ClearActorEmployeeID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearActorEmployeeID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *TaskActivityUpsertOne) SetKind(v taskactivity.Kind) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateKind() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetFieldName sets the "field_name" field.
+func (u *TaskActivityUpsertOne) SetFieldName(v string) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetFieldName(v)
+	})
+}
+
+// UpdateFieldName sets the "field_name" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateFieldName() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateFieldName()
+	})
+}
+
+// ClearFieldName clears the value of the "field_name" field.
+func (u *TaskActivityUpsertOne) ClearFieldName() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearFieldName()
+	})
+}
+
+// SetOldValue sets the "old_value" field.
+func (u *TaskActivityUpsertOne) SetOldValue(v string) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetOldValue(v)
+	})
+}
+
+// UpdateOldValue sets the "old_value" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateOldValue() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateOldValue()
+	})
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (u *TaskActivityUpsertOne) ClearOldValue() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearOldValue()
+	})
+}
+
+// SetNewValue sets the "new_value" field.
+func (u *TaskActivityUpsertOne) SetNewValue(v string) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetNewValue(v)
+	})
+}
+
+// UpdateNewValue sets the "new_value" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateNewValue() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateNewValue()
+	})
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (u *TaskActivityUpsertOne) ClearNewValue() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearNewValue()
+	})
+}
+
+// SetRefID sets the "ref_id" field.
+func (u *TaskActivityUpsertOne) SetRefID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetRefID(v)
+	})
+}
+
+// AddRefID adds v to the "ref_id" field.
+func (u *TaskActivityUpsertOne) AddRefID(v int) *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddRefID(v)
+	})
+}
+
+// UpdateRefID sets the "ref_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertOne) UpdateRefID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateRefID()
+	})
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (u *TaskActivityUpsertOne) ClearRefID() *TaskActivityUpsertOne {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearRefID()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskActivityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskActivityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskActivityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskActivityUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskActivityUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskActivityCreateBulk is the builder for creating many TaskActivity entities in bulk.
+type TaskActivityCreateBulk struct {
+	config
+	err      error
+	builders []*TaskActivityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskActivity entities in the database.
+func (tacb *TaskActivityCreateBulk) Save(ctx context.Context) ([]*TaskActivity, error) {
+	if tacb.err != nil {
+		return nil, tacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tacb.builders))
+	nodes := make([]*TaskActivity, len(tacb.builders))
+	mutators := make([]Mutator, len(tacb.builders))
+	for i := range tacb.builders {
+		func(i int, root context.Context) {
+			builder := tacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tacb *TaskActivityCreateBulk) SaveX(ctx context.Context) []*TaskActivity {
+	v, err := tacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tacb *TaskActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := tacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tacb *TaskActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := tacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskActivity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskActivityUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (tacb *TaskActivityCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskActivityUpsertBulk {
+	tacb.conflict = opts
+	return &TaskActivityUpsertBulk{
+		create: tacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskActivity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tacb *TaskActivityCreateBulk) OnConflictColumns(columns ...string) *TaskActivityUpsertBulk {
+	tacb.conflict = append(tacb.conflict, sql.ConflictColumns(columns...))
+	return &TaskActivityUpsertBulk{
+		create: tacb,
+	}
+}
+
+// TaskActivityUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskActivity nodes.
+type TaskActivityUpsertBulk struct {
+	create *TaskActivityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskActivity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskActivityUpsertBulk) UpdateNewValues() *TaskActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskactivity.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskActivity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskActivityUpsertBulk) Ignore() *TaskActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskActivityUpsertBulk) DoNothing() *TaskActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskActivityCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskActivityUpsertBulk) Update(set func(*TaskActivityUpsert)) *TaskActivityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskActivityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskActivityUpsertBulk) SetOrgID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskActivityUpsertBulk) AddOrgID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateOrgID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskActivityUpsertBulk) SetTaskID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateTaskID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskActivityUpsertBulk) SetProjectID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// AddProjectID adds v to the "project_id" field.
+func (u *TaskActivityUpsertBulk) AddProjectID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateProjectID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskActivityUpsertBulk) ClearProjectID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetActorUserID sets the "actor_user_id" field.
+func (u *TaskActivityUpsertBulk) SetActorUserID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetActorUserID(v)
+	})
+}
+
+// AddActorUserID adds v to the "actor_user_id" field.
+func (u *TaskActivityUpsertBulk) AddActorUserID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddActorUserID(v)
+	})
+}
+
+// UpdateActorUserID sets the "actor_user_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateActorUserID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateActorUserID()
+	})
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (u *TaskActivityUpsertBulk) ClearActorUserID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearActorUserID()
+	})
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (u *TaskActivityUpsertBulk) SetActorEmployeeID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetActorEmployeeID(v)
+	})
+}
+
+// AddActorEmployeeID adds v to the "actor_employee_id" field.
+func (u *TaskActivityUpsertBulk) AddActorEmployeeID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddActorEmployeeID(v)
+	})
+}
+
+// UpdateActorEmployeeID sets the "actor_employee_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateActorEmployeeID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateActorEmployeeID()
+	})
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (u *TaskActivityUpsertBulk) ClearActorEmployeeID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearActorEmployeeID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *TaskActivityUpsertBulk) SetKind(v taskactivity.Kind) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateKind() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetFieldName sets the "field_name" field.
+func (u *TaskActivityUpsertBulk) SetFieldName(v string) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetFieldName(v)
+	})
+}
+
+// UpdateFieldName sets the "field_name" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateFieldName() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateFieldName()
+	})
+}
+
+// ClearFieldName clears the value of the "field_name" field.
+func (u *TaskActivityUpsertBulk) ClearFieldName() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearFieldName()
+	})
+}
+
+// SetOldValue sets the "old_value" field.
+func (u *TaskActivityUpsertBulk) SetOldValue(v string) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetOldValue(v)
+	})
+}
+
+// UpdateOldValue sets the "old_value" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateOldValue() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateOldValue()
+	})
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (u *TaskActivityUpsertBulk) ClearOldValue() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearOldValue()
+	})
+}
+
+// SetNewValue sets the "new_value" field.
+func (u *TaskActivityUpsertBulk) SetNewValue(v string) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetNewValue(v)
+	})
+}
+
+// UpdateNewValue sets the "new_value" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateNewValue() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateNewValue()
+	})
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (u *TaskActivityUpsertBulk) ClearNewValue() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearNewValue()
+	})
+}
+
+// SetRefID sets the "ref_id" field.
+func (u *TaskActivityUpsertBulk) SetRefID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.SetRefID(v)
+	})
+}
+
+// AddRefID adds v to the "ref_id" field.
+func (u *TaskActivityUpsertBulk) AddRefID(v int) *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.AddRefID(v)
+	})
+}
+
+// UpdateRefID sets the "ref_id" field to the value that was provided on create.
+func (u *TaskActivityUpsertBulk) UpdateRefID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.UpdateRefID()
+	})
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (u *TaskActivityUpsertBulk) ClearRefID() *TaskActivityUpsertBulk {
+	return u.Update(func(s *TaskActivityUpsert) {
+		s.ClearRefID()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskActivityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskActivityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskActivityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskActivityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+)
+
+// TaskActivityQuery is the builder for querying TaskActivity entities.
+type TaskActivityQuery struct {
+	config
+	ctx        *QueryContext
+	order      []taskactivity.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaskActivity
+	withTask   *TaskQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskActivityQuery builder.
+func (taq *TaskActivityQuery) Where(ps ...predicate.TaskActivity) *TaskActivityQuery {
+	taq.predicates = append(taq.predicates, ps...)
+	return taq
+}
+
+// Limit the number of records to be returned by this query.
+func (taq *TaskActivityQuery) Limit(limit int) *TaskActivityQuery {
+	taq.ctx.Limit = &limit
+	return taq
+}
+
+// Offset to start from.
+func (taq *TaskActivityQuery) Offset(offset int) *TaskActivityQuery {
+	taq.ctx.Offset = &offset
+	return taq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (taq *TaskActivityQuery) Unique(unique bool) *TaskActivityQuery {
+	taq.ctx.Unique = &unique
+	return taq
+}
+
+// Order specifies how the records should be ordered.
+func (taq *TaskActivityQuery) Order(o ...taskactivity.OrderOption) *TaskActivityQuery {
+	taq.order = append(taq.order, o...)
+	return taq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (taq *TaskActivityQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: taq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := taq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := taq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskactivity.Table, taskactivity.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskactivity.TaskTable, taskactivity.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(taq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskActivity entity from the query.
+// Returns a *NotFoundError when no TaskActivity was found.
+func (taq *TaskActivityQuery) First(ctx context.Context) (*TaskActivity, error) {
+	nodes, err := taq.Limit(1).All(setContextOp(ctx, taq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskactivity.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (taq *TaskActivityQuery) FirstX(ctx context.Context) *TaskActivity {
+	node, err := taq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskActivity ID from the query.
+// Returns a *NotFoundError when no TaskActivity ID was found.
+func (taq *TaskActivityQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = taq.Limit(1).IDs(setContextOp(ctx, taq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskactivity.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (taq *TaskActivityQuery) FirstIDX(ctx context.Context) int {
+	id, err := taq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskActivity entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskActivity entity is found.
+// Returns a *NotFoundError when no TaskActivity entities are found.
+func (taq *TaskActivityQuery) Only(ctx context.Context) (*TaskActivity, error) {
+	nodes, err := taq.Limit(2).All(setContextOp(ctx, taq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskactivity.Label}
+	default:
+		return nil, &NotSingularError{taskactivity.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (taq *TaskActivityQuery) OnlyX(ctx context.Context) *TaskActivity {
+	node, err := taq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskActivity ID in the query.
+// Returns a *NotSingularError when more than one TaskActivity ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (taq *TaskActivityQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = taq.Limit(2).IDs(setContextOp(ctx, taq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskactivity.Label}
+	default:
+		err = &NotSingularError{taskactivity.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (taq *TaskActivityQuery) OnlyIDX(ctx context.Context) int {
+	id, err := taq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskActivities.
+func (taq *TaskActivityQuery) All(ctx context.Context) ([]*TaskActivity, error) {
+	ctx = setContextOp(ctx, taq.ctx, ent.OpQueryAll)
+	if err := taq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskActivity, *TaskActivityQuery]()
+	return withInterceptors[[]*TaskActivity](ctx, taq, qr, taq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (taq *TaskActivityQuery) AllX(ctx context.Context) []*TaskActivity {
+	nodes, err := taq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskActivity IDs.
+func (taq *TaskActivityQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if taq.ctx.Unique == nil && taq.path != nil {
+		taq.Unique(true)
+	}
+	ctx = setContextOp(ctx, taq.ctx, ent.OpQueryIDs)
+	if err = taq.Select(taskactivity.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (taq *TaskActivityQuery) IDsX(ctx context.Context) []int {
+	ids, err := taq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (taq *TaskActivityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, taq.ctx, ent.OpQueryCount)
+	if err := taq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, taq, querierCount[*TaskActivityQuery](), taq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (taq *TaskActivityQuery) CountX(ctx context.Context) int {
+	count, err := taq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (taq *TaskActivityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, taq.ctx, ent.OpQueryExist)
+	switch _, err := taq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (taq *TaskActivityQuery) ExistX(ctx context.Context) bool {
+	exist, err := taq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskActivityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (taq *TaskActivityQuery) Clone() *TaskActivityQuery {
+	if taq == nil {
+		return nil
+	}
+	return &TaskActivityQuery{
+		config:     taq.config,
+		ctx:        taq.ctx.Clone(),
+		order:      append([]taskactivity.OrderOption{}, taq.order...),
+		inters:     append([]Interceptor{}, taq.inters...),
+		predicates: append([]predicate.TaskActivity{}, taq.predicates...),
+		withTask:   taq.withTask.Clone(),
+		// clone intermediate query.
+		sql:  taq.sql.Clone(),
+		path: taq.path,
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (taq *TaskActivityQuery) WithTask(opts ...func(*TaskQuery)) *TaskActivityQuery {
+	query := (&TaskClient{config: taq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	taq.withTask = query
+	return taq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int `json:"org_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskActivity.Query().
+//		GroupBy(taskactivity.FieldOrgID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (taq *TaskActivityQuery) GroupBy(field string, fields ...string) *TaskActivityGroupBy {
+	taq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskActivityGroupBy{build: taq}
+	grbuild.flds = &taq.ctx.Fields
+	grbuild.label = taskactivity.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int `json:"org_id"`
+//	}
+//
+//	client.TaskActivity.Query().
+//		Select(taskactivity.FieldOrgID).
+//		Scan(ctx, &v)
+func (taq *TaskActivityQuery) Select(fields ...string) *TaskActivitySelect {
+	taq.ctx.Fields = append(taq.ctx.Fields, fields...)
+	sbuild := &TaskActivitySelect{TaskActivityQuery: taq}
+	sbuild.label = taskactivity.Label
+	sbuild.flds, sbuild.scan = &taq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskActivitySelect configured with the given aggregations.
+func (taq *TaskActivityQuery) Aggregate(fns ...AggregateFunc) *TaskActivitySelect {
+	return taq.Select().Aggregate(fns...)
+}
+
+func (taq *TaskActivityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range taq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, taq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range taq.ctx.Fields {
+		if !taskactivity.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if taq.path != nil {
+		prev, err := taq.path(ctx)
+		if err != nil {
+			return err
+		}
+		taq.sql = prev
+	}
+	return nil
+}
+
+func (taq *TaskActivityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskActivity, error) {
+	var (
+		nodes       = []*TaskActivity{}
+		_spec       = taq.querySpec()
+		loadedTypes = [1]bool{
+			taq.withTask != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskActivity).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskActivity{config: taq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, taq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := taq.withTask; query != nil {
+		if err := taq.loadTask(ctx, query, nodes, nil,
+			func(n *TaskActivity, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (taq *TaskActivityQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*TaskActivity, init func(*TaskActivity), assign func(*TaskActivity, *Task)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskActivity)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (taq *TaskActivityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := taq.querySpec()
+	_spec.Node.Columns = taq.ctx.Fields
+	if len(taq.ctx.Fields) > 0 {
+		_spec.Unique = taq.ctx.Unique != nil && *taq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, taq.driver, _spec)
+}
+
+func (taq *TaskActivityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskactivity.Table, taskactivity.Columns, sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt))
+	_spec.From = taq.sql
+	if unique := taq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if taq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := taq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskactivity.FieldID)
+		for i := range fields {
+			if fields[i] != taskactivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if taq.withTask != nil {
+			_spec.Node.AddColumnOnce(taskactivity.FieldTaskID)
+		}
+	}
+	if ps := taq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := taq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := taq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := taq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (taq *TaskActivityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(taq.driver.Dialect())
+	t1 := builder.Table(taskactivity.Table)
+	columns := taq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskactivity.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if taq.sql != nil {
+		selector = taq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if taq.ctx.Unique != nil && *taq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range taq.predicates {
+		p(selector)
+	}
+	for _, p := range taq.order {
+		p(selector)
+	}
+	if offset := taq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := taq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaskActivityGroupBy is the group-by builder for TaskActivity entities.
+type TaskActivityGroupBy struct {
+	selector
+	build *TaskActivityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tagb *TaskActivityGroupBy) Aggregate(fns ...AggregateFunc) *TaskActivityGroupBy {
+	tagb.fns = append(tagb.fns, fns...)
+	return tagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tagb *TaskActivityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tagb.build.ctx, ent.OpQueryGroupBy)
+	if err := tagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskActivityQuery, *TaskActivityGroupBy](ctx, tagb.build, tagb, tagb.build.inters, v)
+}
+
+func (tagb *TaskActivityGroupBy) sqlScan(ctx context.Context, root *TaskActivityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tagb.fns))
+	for _, fn := range tagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tagb.flds)+len(tagb.fns))
+		for _, f := range *tagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskActivitySelect is the builder for selecting fields of TaskActivity entities.
+type TaskActivitySelect struct {
+	*TaskActivityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tas *TaskActivitySelect) Aggregate(fns ...AggregateFunc) *TaskActivitySelect {
+	tas.fns = append(tas.fns, fns...)
+	return tas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tas *TaskActivitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tas.ctx, ent.OpQuerySelect)
+	if err := tas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskActivityQuery, *TaskActivitySelect](ctx, tas.TaskActivityQuery, tas, tas.inters, v)
+}
+
+func (tas *TaskActivitySelect) sqlScan(ctx context.Context, root *TaskActivityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tas.fns))
+	for _, fn := range tas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,848 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+)
+
+// TaskActivityUpdate is the builder for updating TaskActivity entities.
+type TaskActivityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskActivityMutation
+}
+
+// Where appends a list predicates to the TaskActivityUpdate builder.
+func (tau *TaskActivityUpdate) Where(ps ...predicate.TaskActivity) *TaskActivityUpdate {
+	tau.mutation.Where(ps...)
+	return tau
+}
+
+// SetOrgID sets the "org_id" field.
+func (tau *TaskActivityUpdate) SetOrgID(i int) *TaskActivityUpdate {
+	tau.mutation.ResetOrgID()
+	tau.mutation.SetOrgID(i)
+	return tau
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableOrgID(i *int) *TaskActivityUpdate {
+	if i != nil {
+		tau.SetOrgID(*i)
+	}
+	return tau
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (tau *TaskActivityUpdate) AddOrgID(i int) *TaskActivityUpdate {
+	tau.mutation.AddOrgID(i)
+	return tau
+}
+
+// SetTaskID sets the "task_id" field.
+func (tau *TaskActivityUpdate) SetTaskID(i int) *TaskActivityUpdate {
+	tau.mutation.SetTaskID(i)
+	return tau
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableTaskID(i *int) *TaskActivityUpdate {
+	if i != nil {
+		tau.SetTaskID(*i)
+	}
+	return tau
+}
+
+// SetProjectID sets the "project_id" field.
+func (tau *TaskActivityUpdate) SetProjectID(i int) *TaskActivityUpdate {
+	tau.mutation.ResetProjectID()
+	tau.mutation.SetProjectID(i)
+	return tau
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableProjectID(i *int) *TaskActivityUpdate {
+	if i != nil {
+		tau.SetProjectID(*i)
+	}
+	return tau
+}
+
+// AddProjectID adds i to the "project_id" field.
+func (tau *TaskActivityUpdate) AddProjectID(i int) *TaskActivityUpdate {
+	tau.mutation.AddProjectID(i)
+	return tau
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tau *TaskActivityUpdate) ClearProjectID() *TaskActivityUpdate {
+	tau.mutation.ClearProjectID()
+	return tau
+}
+
+// SetActorUserID sets the "actor_user_id" field.
+func (tau *TaskActivityUpdate) SetActorUserID(i int) *TaskActivityUpdate {
+	tau.mutation.ResetActorUserID()
+	tau.mutation.SetActorUserID(i)
+	return tau
+}
+
+// SetNillableActorUserID sets the "actor_user_id" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableActorUserID(i *int) *TaskActivityUpdate {
+	if i != nil {
+		tau.SetActorUserID(*i)
+	}
+	return tau
+}
+
+// AddActorUserID adds i to the "actor_user_id" field.
+func (tau *TaskActivityUpdate) AddActorUserID(i int) *TaskActivityUpdate {
+	tau.mutation.AddActorUserID(i)
+	return tau
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (tau *TaskActivityUpdate) ClearActorUserID() *TaskActivityUpdate {
+	tau.mutation.ClearActorUserID()
+	return tau
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (tau *TaskActivityUpdate) SetActorEmployeeID(i int) *TaskActivityUpdate {
+	tau.mutation.ResetActorEmployeeID()
+	tau.mutation.SetActorEmployeeID(i)
+	return tau
+}
+
+// SetNillableActorEmployeeID sets the "actor_employee_id" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableActorEmployeeID(i *int) *TaskActivityUpdate {
+	if i != nil {
+		tau.SetActorEmployeeID(*i)
+	}
+	return tau
+}
+
+// AddActorEmployeeID adds i to the "actor_employee_id" field.
+func (tau *TaskActivityUpdate) AddActorEmployeeID(i int) *TaskActivityUpdate {
+	tau.mutation.AddActorEmployeeID(i)
+	return tau
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (tau *TaskActivityUpdate) ClearActorEmployeeID() *TaskActivityUpdate {
+	tau.mutation.ClearActorEmployeeID()
+	return tau
+}
+
+// SetKind sets the "kind" field.
+func (tau *TaskActivityUpdate) SetKind(t taskactivity.Kind) *TaskActivityUpdate {
+	tau.mutation.SetKind(t)
+	return tau
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableKind(t *taskactivity.Kind) *TaskActivityUpdate {
+	if t != nil {
+		tau.SetKind(*t)
+	}
+	return tau
+}
+
+// SetFieldName sets the "field_name" field.
+func (tau *TaskActivityUpdate) SetFieldName(s string) *TaskActivityUpdate {
+	tau.mutation.SetFieldName(s)
+	return tau
+}
+
+// SetNillableFieldName sets the "field_name" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableFieldName(s *string) *TaskActivityUpdate {
+	if s != nil {
+		tau.SetFieldName(*s)
+	}
+	return tau
+}
+
+// ClearFieldName clears the value of the "field_name" field.
+func (tau *TaskActivityUpdate) ClearFieldName() *TaskActivityUpdate {
+	tau.mutation.ClearFieldName()
+	return tau
+}
+
+// SetOldValue sets the "old_value" field.
+func (tau *TaskActivityUpdate) SetOldValue(s string) *TaskActivityUpdate {
+	tau.mutation.SetOldValue(s)
+	return tau
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableOldValue(s *string) *TaskActivityUpdate {
+	if s != nil {
+		tau.SetOldValue(*s)
+	}
+	return tau
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (tau *TaskActivityUpdate) ClearOldValue() *TaskActivityUpdate {
+	tau.mutation.ClearOldValue()
+	return tau
+}
+
+// SetNewValue sets the "new_value" field.
+func (tau *TaskActivityUpdate) SetNewValue(s string) *TaskActivityUpdate {
+	tau.mutation.SetNewValue(s)
+	return tau
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableNewValue(s *string) *TaskActivityUpdate {
+	if s != nil {
+		tau.SetNewValue(*s)
+	}
+	return tau
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (tau *TaskActivityUpdate) ClearNewValue() *TaskActivityUpdate {
+	tau.mutation.ClearNewValue()
+	return tau
+}
+
+// SetRefID sets the "ref_id" field.
+func (tau *TaskActivityUpdate) SetRefID(i int) *TaskActivityUpdate {
+	tau.mutation.ResetRefID()
+	tau.mutation.SetRefID(i)
+	return tau
+}
+
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (tau *TaskActivityUpdate) SetNillableRefID(i *int) *TaskActivityUpdate {
+	if i != nil {
+		tau.SetRefID(*i)
+	}
+	return tau
+}
+
+// AddRefID adds i to the "ref_id" field.
+func (tau *TaskActivityUpdate) AddRefID(i int) *TaskActivityUpdate {
+	tau.mutation.AddRefID(i)
+	return tau
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (tau *TaskActivityUpdate) ClearRefID() *TaskActivityUpdate {
+	tau.mutation.ClearRefID()
+	return tau
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tau *TaskActivityUpdate) SetTask(t *Task) *TaskActivityUpdate {
+	return tau.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskActivityMutation object of the builder.
+func (tau *TaskActivityUpdate) Mutation() *TaskActivityMutation {
+	return tau.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tau *TaskActivityUpdate) ClearTask() *TaskActivityUpdate {
+	tau.mutation.ClearTask()
+	return tau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tau *TaskActivityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tau.sqlSave, tau.mutation, tau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tau *TaskActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := tau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tau *TaskActivityUpdate) Exec(ctx context.Context) error {
+	_, err := tau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tau *TaskActivityUpdate) ExecX(ctx context.Context) {
+	if err := tau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tau *TaskActivityUpdate) check() error {
+	if v, ok := tau.mutation.Kind(); ok {
+		if err := taskactivity.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "TaskActivity.kind": %w`, err)}
+		}
+	}
+	if tau.mutation.TaskCleared() && len(tau.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskActivity.task"`)
+	}
+	return nil
+}
+
+func (tau *TaskActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskactivity.Table, taskactivity.Columns, sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt))
+	if ps := tau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tau.mutation.OrgID(); ok {
+		_spec.SetField(taskactivity.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tau.mutation.AddedOrgID(); ok {
+		_spec.AddField(taskactivity.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tau.mutation.ProjectID(); ok {
+		_spec.SetField(taskactivity.FieldProjectID, field.TypeInt, value)
+	}
+	if value, ok := tau.mutation.AddedProjectID(); ok {
+		_spec.AddField(taskactivity.FieldProjectID, field.TypeInt, value)
+	}
+	if tau.mutation.ProjectIDCleared() {
+		_spec.ClearField(taskactivity.FieldProjectID, field.TypeInt)
+	}
+	if value, ok := tau.mutation.ActorUserID(); ok {
+		_spec.SetField(taskactivity.FieldActorUserID, field.TypeInt, value)
+	}
+	if value, ok := tau.mutation.AddedActorUserID(); ok {
+		_spec.AddField(taskactivity.FieldActorUserID, field.TypeInt, value)
+	}
+	if tau.mutation.ActorUserIDCleared() {
+		_spec.ClearField(taskactivity.FieldActorUserID, field.TypeInt)
+	}
+	if value, ok := tau.mutation.ActorEmployeeID(); ok {
+		_spec.SetField(taskactivity.FieldActorEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := tau.mutation.AddedActorEmployeeID(); ok {
+		_spec.AddField(taskactivity.FieldActorEmployeeID, field.TypeInt, value)
+	}
+	if tau.mutation.ActorEmployeeIDCleared() {
+		_spec.ClearField(taskactivity.FieldActorEmployeeID, field.TypeInt)
+	}
+	if value, ok := tau.mutation.Kind(); ok {
+		_spec.SetField(taskactivity.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := tau.mutation.FieldName(); ok {
+		_spec.SetField(taskactivity.FieldFieldName, field.TypeString, value)
+	}
+	if tau.mutation.FieldNameCleared() {
+		_spec.ClearField(taskactivity.FieldFieldName, field.TypeString)
+	}
+	if value, ok := tau.mutation.OldValue(); ok {
+		_spec.SetField(taskactivity.FieldOldValue, field.TypeString, value)
+	}
+	if tau.mutation.OldValueCleared() {
+		_spec.ClearField(taskactivity.FieldOldValue, field.TypeString)
+	}
+	if value, ok := tau.mutation.NewValue(); ok {
+		_spec.SetField(taskactivity.FieldNewValue, field.TypeString, value)
+	}
+	if tau.mutation.NewValueCleared() {
+		_spec.ClearField(taskactivity.FieldNewValue, field.TypeString)
+	}
+	if value, ok := tau.mutation.RefID(); ok {
+		_spec.SetField(taskactivity.FieldRefID, field.TypeInt, value)
+	}
+	if value, ok := tau.mutation.AddedRefID(); ok {
+		_spec.AddField(taskactivity.FieldRefID, field.TypeInt, value)
+	}
+	if tau.mutation.RefIDCleared() {
+		_spec.ClearField(taskactivity.FieldRefID, field.TypeInt)
+	}
+	if tau.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tau.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tau.mutation.done = true
+	return n, nil
+}
+
+// TaskActivityUpdateOne is the builder for updating a single TaskActivity entity.
+type TaskActivityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskActivityMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (tauo *TaskActivityUpdateOne) SetOrgID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.ResetOrgID()
+	tauo.mutation.SetOrgID(i)
+	return tauo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableOrgID(i *int) *TaskActivityUpdateOne {
+	if i != nil {
+		tauo.SetOrgID(*i)
+	}
+	return tauo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (tauo *TaskActivityUpdateOne) AddOrgID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.AddOrgID(i)
+	return tauo
+}
+
+// SetTaskID sets the "task_id" field.
+func (tauo *TaskActivityUpdateOne) SetTaskID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.SetTaskID(i)
+	return tauo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableTaskID(i *int) *TaskActivityUpdateOne {
+	if i != nil {
+		tauo.SetTaskID(*i)
+	}
+	return tauo
+}
+
+// SetProjectID sets the "project_id" field.
+func (tauo *TaskActivityUpdateOne) SetProjectID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.ResetProjectID()
+	tauo.mutation.SetProjectID(i)
+	return tauo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableProjectID(i *int) *TaskActivityUpdateOne {
+	if i != nil {
+		tauo.SetProjectID(*i)
+	}
+	return tauo
+}
+
+// AddProjectID adds i to the "project_id" field.
+func (tauo *TaskActivityUpdateOne) AddProjectID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.AddProjectID(i)
+	return tauo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tauo *TaskActivityUpdateOne) ClearProjectID() *TaskActivityUpdateOne {
+	tauo.mutation.ClearProjectID()
+	return tauo
+}
+
+// SetActorUserID sets the "actor_user_id" field.
+func (tauo *TaskActivityUpdateOne) SetActorUserID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.ResetActorUserID()
+	tauo.mutation.SetActorUserID(i)
+	return tauo
+}
+
+// SetNillableActorUserID sets the "actor_user_id" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableActorUserID(i *int) *TaskActivityUpdateOne {
+	if i != nil {
+		tauo.SetActorUserID(*i)
+	}
+	return tauo
+}
+
+// AddActorUserID adds i to the "actor_user_id" field.
+func (tauo *TaskActivityUpdateOne) AddActorUserID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.AddActorUserID(i)
+	return tauo
+}
+
+// ClearActorUserID clears the value of the "actor_user_id" field.
+func (tauo *TaskActivityUpdateOne) ClearActorUserID() *TaskActivityUpdateOne {
+	tauo.mutation.ClearActorUserID()
+	return tauo
+}
+
+// SetActorEmployeeID sets the "actor_employee_id" field.
+func (tauo *TaskActivityUpdateOne) SetActorEmployeeID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.ResetActorEmployeeID()
+	tauo.mutation.SetActorEmployeeID(i)
+	return tauo
+}
+
+// SetNillableActorEmployeeID sets the "actor_employee_id" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableActorEmployeeID(i *int) *TaskActivityUpdateOne {
+	if i != nil {
+		tauo.SetActorEmployeeID(*i)
+	}
+	return tauo
+}
+
+// AddActorEmployeeID adds i to the "actor_employee_id" field.
+func (tauo *TaskActivityUpdateOne) AddActorEmployeeID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.AddActorEmployeeID(i)
+	return tauo
+}
+
+// ClearActorEmployeeID clears the value of the "actor_employee_id" field.
+func (tauo *TaskActivityUpdateOne) ClearActorEmployeeID() *TaskActivityUpdateOne {
+	tauo.mutation.ClearActorEmployeeID()
+	return tauo
+}
+
+// SetKind sets the "kind" field.
+func (tauo *TaskActivityUpdateOne) SetKind(t taskactivity.Kind) *TaskActivityUpdateOne {
+	tauo.mutation.SetKind(t)
+	return tauo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableKind(t *taskactivity.Kind) *TaskActivityUpdateOne {
+	if t != nil {
+		tauo.SetKind(*t)
+	}
+	return tauo
+}
+
+// SetFieldName sets the "field_name" field.
+func (tauo *TaskActivityUpdateOne) SetFieldName(s string) *TaskActivityUpdateOne {
+	tauo.mutation.SetFieldName(s)
+	return tauo
+}
+
+// SetNillableFieldName sets the "field_name" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableFieldName(s *string) *TaskActivityUpdateOne {
+	if s != nil {
+		tauo.SetFieldName(*s)
+	}
+	return tauo
+}
+
+// ClearFieldName clears the value of the "field_name" field.
+func (tauo *TaskActivityUpdateOne) ClearFieldName() *TaskActivityUpdateOne {
+	tauo.mutation.ClearFieldName()
+	return tauo
+}
+
+// SetOldValue sets the "old_value" field.
+func (tauo *TaskActivityUpdateOne) SetOldValue(s string) *TaskActivityUpdateOne {
+	tauo.mutation.SetOldValue(s)
+	return tauo
+}
+
+// SetNillableOldValue sets the "old_value" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableOldValue(s *string) *TaskActivityUpdateOne {
+	if s != nil {
+		tauo.SetOldValue(*s)
+	}
+	return tauo
+}
+
+// ClearOldValue clears the value of the "old_value" field.
+func (tauo *TaskActivityUpdateOne) ClearOldValue() *TaskActivityUpdateOne {
+	tauo.mutation.ClearOldValue()
+	return tauo
+}
+
+// SetNewValue sets the "new_value" field.
+func (tauo *TaskActivityUpdateOne) SetNewValue(s string) *TaskActivityUpdateOne {
+	tauo.mutation.SetNewValue(s)
+	return tauo
+}
+
+// SetNillableNewValue sets the "new_value" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableNewValue(s *string) *TaskActivityUpdateOne {
+	if s != nil {
+		tauo.SetNewValue(*s)
+	}
+	return tauo
+}
+
+// ClearNewValue clears the value of the "new_value" field.
+func (tauo *TaskActivityUpdateOne) ClearNewValue() *TaskActivityUpdateOne {
+	tauo.mutation.ClearNewValue()
+	return tauo
+}
+
+// SetRefID sets the "ref_id" field.
+func (tauo *TaskActivityUpdateOne) SetRefID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.ResetRefID()
+	tauo.mutation.SetRefID(i)
+	return tauo
+}
+
+// SetNillableRefID sets the "ref_id" field if the given value is not nil.
+func (tauo *TaskActivityUpdateOne) SetNillableRefID(i *int) *TaskActivityUpdateOne {
+	if i != nil {
+		tauo.SetRefID(*i)
+	}
+	return tauo
+}
+
+// AddRefID adds i to the "ref_id" field.
+func (tauo *TaskActivityUpdateOne) AddRefID(i int) *TaskActivityUpdateOne {
+	tauo.mutation.AddRefID(i)
+	return tauo
+}
+
+// ClearRefID clears the value of the "ref_id" field.
+func (tauo *TaskActivityUpdateOne) ClearRefID() *TaskActivityUpdateOne {
+	tauo.mutation.ClearRefID()
+	return tauo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tauo *TaskActivityUpdateOne) SetTask(t *Task) *TaskActivityUpdateOne {
+	return tauo.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskActivityMutation object of the builder.
+func (tauo *TaskActivityUpdateOne) Mutation() *TaskActivityMutation {
+	return tauo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tauo *TaskActivityUpdateOne) ClearTask() *TaskActivityUpdateOne {
+	tauo.mutation.ClearTask()
+	return tauo
+}
+
+// Where appends a list predicates to the TaskActivityUpdate builder.
+func (tauo *TaskActivityUpdateOne) Where(ps ...predicate.TaskActivity) *TaskActivityUpdateOne {
+	tauo.mutation.Where(ps...)
+	return tauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tauo *TaskActivityUpdateOne) Select(field string, fields ...string) *TaskActivityUpdateOne {
+	tauo.fields = append([]string{field}, fields...)
+	return tauo
+}
+
+// Save executes the query and returns the updated TaskActivity entity.
+func (tauo *TaskActivityUpdateOne) Save(ctx context.Context) (*TaskActivity, error) {
+	return withHooks(ctx, tauo.sqlSave, tauo.mutation, tauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tauo *TaskActivityUpdateOne) SaveX(ctx context.Context) *TaskActivity {
+	node, err := tauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tauo *TaskActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := tauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tauo *TaskActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := tauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tauo *TaskActivityUpdateOne) check() error {
+	if v, ok := tauo.mutation.Kind(); ok {
+		if err := taskactivity.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "TaskActivity.kind": %w`, err)}
+		}
+	}
+	if tauo.mutation.TaskCleared() && len(tauo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskActivity.task"`)
+	}
+	return nil
+}
+
+func (tauo *TaskActivityUpdateOne) sqlSave(ctx context.Context) (_node *TaskActivity, err error) {
+	if err := tauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskactivity.Table, taskactivity.Columns, sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeInt))
+	id, ok := tauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskActivity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskactivity.FieldID)
+		for _, f := range fields {
+			if !taskactivity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskactivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tauo.mutation.OrgID(); ok {
+		_spec.SetField(taskactivity.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tauo.mutation.AddedOrgID(); ok {
+		_spec.AddField(taskactivity.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tauo.mutation.ProjectID(); ok {
+		_spec.SetField(taskactivity.FieldProjectID, field.TypeInt, value)
+	}
+	if value, ok := tauo.mutation.AddedProjectID(); ok {
+		_spec.AddField(taskactivity.FieldProjectID, field.TypeInt, value)
+	}
+	if tauo.mutation.ProjectIDCleared() {
+		_spec.ClearField(taskactivity.FieldProjectID, field.TypeInt)
+	}
+	if value, ok := tauo.mutation.ActorUserID(); ok {
+		_spec.SetField(taskactivity.FieldActorUserID, field.TypeInt, value)
+	}
+	if value, ok := tauo.mutation.AddedActorUserID(); ok {
+		_spec.AddField(taskactivity.FieldActorUserID, field.TypeInt, value)
+	}
+	if tauo.mutation.ActorUserIDCleared() {
+		_spec.ClearField(taskactivity.FieldActorUserID, field.TypeInt)
+	}
+	if value, ok := tauo.mutation.ActorEmployeeID(); ok {
+		_spec.SetField(taskactivity.FieldActorEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := tauo.mutation.AddedActorEmployeeID(); ok {
+		_spec.AddField(taskactivity.FieldActorEmployeeID, field.TypeInt, value)
+	}
+	if tauo.mutation.ActorEmployeeIDCleared() {
+		_spec.ClearField(taskactivity.FieldActorEmployeeID, field.TypeInt)
+	}
+	if value, ok := tauo.mutation.Kind(); ok {
+		_spec.SetField(taskactivity.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := tauo.mutation.FieldName(); ok {
+		_spec.SetField(taskactivity.FieldFieldName, field.TypeString, value)
+	}
+	if tauo.mutation.FieldNameCleared() {
+		_spec.ClearField(taskactivity.FieldFieldName, field.TypeString)
+	}
+	if value, ok := tauo.mutation.OldValue(); ok {
+		_spec.SetField(taskactivity.FieldOldValue, field.TypeString, value)
+	}
+	if tauo.mutation.OldValueCleared() {
+		_spec.ClearField(taskactivity.FieldOldValue, field.TypeString)
+	}
+	if value, ok := tauo.mutation.NewValue(); ok {
+		_spec.SetField(taskactivity.FieldNewValue, field.TypeString, value)
+	}
+	if tauo.mutation.NewValueCleared() {
+		_spec.ClearField(taskactivity.FieldNewValue, field.TypeString)
+	}
+	if value, ok := tauo.mutation.RefID(); ok {
+		_spec.SetField(taskactivity.FieldRefID, field.TypeInt, value)
+	}
+	if value, ok := tauo.mutation.AddedRefID(); ok {
+		_spec.AddField(taskactivity.FieldRefID, field.TypeInt, value)
+	}
+	if tauo.mutation.RefIDCleared() {
+		_spec.ClearField(taskactivity.FieldRefID, field.TypeInt)
+	}
+	if tauo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tauo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskActivity{config: tauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tauo.mutation.done = true
+	return _node, nil
+}
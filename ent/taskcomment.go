@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+)
+
+// TaskComment is the model entity for the TaskComment schema.
+type TaskComment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID int `json:"author_id"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID int `json:"parent_id"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt *time.Time `json:"edited_at"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskCommentQuery when eager-loading is set.
+	Edges        TaskCommentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskCommentEdges holds the relations/edges for other nodes in the graph.
+type TaskCommentEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task"`
+	// Author holds the value of the author edge.
+	Author *Employee `json:"author"`
+	// Replies holds the value of the replies edge.
+	Replies []*TaskComment `json:"replies"`
+	// Parent holds the value of the parent edge.
+	Parent *TaskComment `json:"parent"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*Employee `json:"mentions"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*TaskCommentRevision `json:"revisions"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskCommentEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskCommentEdges) AuthorOrErr() (*Employee, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskCommentEdges) RepliesOrErr() ([]*TaskComment, error) {
+	if e.loadedTypes[2] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskCommentEdges) ParentOrErr() (*TaskComment, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: taskcomment.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskCommentEdges) MentionsOrErr() ([]*Employee, error) {
+	if e.loadedTypes[4] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskCommentEdges) RevisionsOrErr() ([]*TaskCommentRevision, error) {
+	if e.loadedTypes[5] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskComment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskcomment.FieldID, taskcomment.FieldTaskID, taskcomment.FieldAuthorID, taskcomment.FieldParentID:
+			values[i] = new(sql.NullInt64)
+		case taskcomment.FieldContent:
+			values[i] = new(sql.NullString)
+		case taskcomment.FieldEditedAt, taskcomment.FieldDeletedAt, taskcomment.FieldCreatedAt, taskcomment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskComment fields.
+func (tc *TaskComment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskcomment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tc.ID = int(value.Int64)
+		case taskcomment.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				tc.TaskID = int(value.Int64)
+			}
+		case taskcomment.FieldAuthorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value.Valid {
+				tc.AuthorID = int(value.Int64)
+			}
+		case taskcomment.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				tc.ParentID = int(value.Int64)
+			}
+		case taskcomment.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				tc.Content = value.String
+			}
+		case taskcomment.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				tc.EditedAt = new(time.Time)
+				*tc.EditedAt = value.Time
+			}
+		case taskcomment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				tc.DeletedAt = new(time.Time)
+				*tc.DeletedAt = value.Time
+			}
+		case taskcomment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tc.CreatedAt = value.Time
+			}
+		case taskcomment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tc.UpdatedAt = value.Time
+			}
+		default:
+			tc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskComment.
+// This includes values selected through modifiers, order, etc.
+func (tc *TaskComment) Value(name string) (ent.Value, error) {
+	return tc.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskComment entity.
+func (tc *TaskComment) QueryTask() *TaskQuery {
+	return NewTaskCommentClient(tc.config).QueryTask(tc)
+}
+
+// QueryAuthor queries the "author" edge of the TaskComment entity.
+func (tc *TaskComment) QueryAuthor() *EmployeeQuery {
+	return NewTaskCommentClient(tc.config).QueryAuthor(tc)
+}
+
+// QueryReplies queries the "replies" edge of the TaskComment entity.
+func (tc *TaskComment) QueryReplies() *TaskCommentQuery {
+	return NewTaskCommentClient(tc.config).QueryReplies(tc)
+}
+
+// QueryParent queries the "parent" edge of the TaskComment entity.
+func (tc *TaskComment) QueryParent() *TaskCommentQuery {
+	return NewTaskCommentClient(tc.config).QueryParent(tc)
+}
+
+// QueryMentions queries the "mentions" edge of the TaskComment entity.
+func (tc *TaskComment) QueryMentions() *EmployeeQuery {
+	return NewTaskCommentClient(tc.config).QueryMentions(tc)
+}
+
+// QueryRevisions queries the "revisions" edge of the TaskComment entity.
+func (tc *TaskComment) QueryRevisions() *TaskCommentRevisionQuery {
+	return NewTaskCommentClient(tc.config).QueryRevisions(tc)
+}
+
+// Update returns a builder for updating this TaskComment.
+// Note that you need to call TaskComment.Unwrap() before calling this method if this TaskComment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tc *TaskComment) Update() *TaskCommentUpdateOne {
+	return NewTaskCommentClient(tc.config).UpdateOne(tc)
+}
+
+// Unwrap unwraps the TaskComment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tc *TaskComment) Unwrap() *TaskComment {
+	_tx, ok := tc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskComment is not a transactional entity")
+	}
+	tc.config.driver = _tx.drv
+	return tc
+}
+
+// String implements the fmt.Stringer.
+func (tc *TaskComment) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskComment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tc.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", tc.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(fmt.Sprintf("%v", tc.AuthorID))
+	builder.WriteString(", ")
+	builder.WriteString("parent_id=")
+	builder.WriteString(fmt.Sprintf("%v", tc.ParentID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(tc.Content)
+	builder.WriteString(", ")
+	if v := tc.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := tc.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskComments is a parsable slice of TaskComment.
+type TaskComments []*TaskComment
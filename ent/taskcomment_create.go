@@ -0,0 +1,1087 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+)
+
+// TaskCommentCreate is the builder for creating a TaskComment entity.
+type TaskCommentCreate struct {
+	config
+	mutation *TaskCommentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTaskID sets the "task_id" field.
+func (tcc *TaskCommentCreate) SetTaskID(i int) *TaskCommentCreate {
+	tcc.mutation.SetTaskID(i)
+	return tcc
+}
+
+// SetAuthorID sets the "author_id" field.
+func (tcc *TaskCommentCreate) SetAuthorID(i int) *TaskCommentCreate {
+	tcc.mutation.SetAuthorID(i)
+	return tcc
+}
+
+// SetParentID sets the "parent_id" field.
+func (tcc *TaskCommentCreate) SetParentID(i int) *TaskCommentCreate {
+	tcc.mutation.SetParentID(i)
+	return tcc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableParentID(i *int) *TaskCommentCreate {
+	if i != nil {
+		tcc.SetParentID(*i)
+	}
+	return tcc
+}
+
+// SetContent sets the "content" field.
+func (tcc *TaskCommentCreate) SetContent(s string) *TaskCommentCreate {
+	tcc.mutation.SetContent(s)
+	return tcc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tcc *TaskCommentCreate) SetEditedAt(t time.Time) *TaskCommentCreate {
+	tcc.mutation.SetEditedAt(t)
+	return tcc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableEditedAt(t *time.Time) *TaskCommentCreate {
+	if t != nil {
+		tcc.SetEditedAt(*t)
+	}
+	return tcc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tcc *TaskCommentCreate) SetDeletedAt(t time.Time) *TaskCommentCreate {
+	tcc.mutation.SetDeletedAt(t)
+	return tcc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableDeletedAt(t *time.Time) *TaskCommentCreate {
+	if t != nil {
+		tcc.SetDeletedAt(*t)
+	}
+	return tcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcc *TaskCommentCreate) SetCreatedAt(t time.Time) *TaskCommentCreate {
+	tcc.mutation.SetCreatedAt(t)
+	return tcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableCreatedAt(t *time.Time) *TaskCommentCreate {
+	if t != nil {
+		tcc.SetCreatedAt(*t)
+	}
+	return tcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcc *TaskCommentCreate) SetUpdatedAt(t time.Time) *TaskCommentCreate {
+	tcc.mutation.SetUpdatedAt(t)
+	return tcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableUpdatedAt(t *time.Time) *TaskCommentCreate {
+	if t != nil {
+		tcc.SetUpdatedAt(*t)
+	}
+	return tcc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tcc *TaskCommentCreate) SetTask(t *Task) *TaskCommentCreate {
+	return tcc.SetTaskID(t.ID)
+}
+
+// SetAuthor sets the "author" edge to the Employee entity.
+func (tcc *TaskCommentCreate) SetAuthor(e *Employee) *TaskCommentCreate {
+	return tcc.SetAuthorID(e.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the TaskComment entity by IDs.
+func (tcc *TaskCommentCreate) AddReplyIDs(ids ...int) *TaskCommentCreate {
+	tcc.mutation.AddReplyIDs(ids...)
+	return tcc
+}
+
+// AddReplies adds the "replies" edges to the TaskComment entity.
+func (tcc *TaskCommentCreate) AddReplies(t ...*TaskComment) *TaskCommentCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcc.AddReplyIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the TaskComment entity.
+func (tcc *TaskCommentCreate) SetParent(t *TaskComment) *TaskCommentCreate {
+	return tcc.SetParentID(t.ID)
+}
+
+// AddMentionIDs adds the "mentions" edge to the Employee entity by IDs.
+func (tcc *TaskCommentCreate) AddMentionIDs(ids ...int) *TaskCommentCreate {
+	tcc.mutation.AddMentionIDs(ids...)
+	return tcc
+}
+
+// AddMentions adds the "mentions" edges to the Employee entity.
+func (tcc *TaskCommentCreate) AddMentions(e ...*Employee) *TaskCommentCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tcc.AddMentionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the TaskCommentRevision entity by IDs.
+func (tcc *TaskCommentCreate) AddRevisionIDs(ids ...int) *TaskCommentCreate {
+	tcc.mutation.AddRevisionIDs(ids...)
+	return tcc
+}
+
+// AddRevisions adds the "revisions" edges to the TaskCommentRevision entity.
+func (tcc *TaskCommentCreate) AddRevisions(t ...*TaskCommentRevision) *TaskCommentCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcc.AddRevisionIDs(ids...)
+}
+
+// Mutation returns the TaskCommentMutation object of the builder.
+func (tcc *TaskCommentCreate) Mutation() *TaskCommentMutation {
+	return tcc.mutation
+}
+
+// Save creates the TaskComment in the database.
+func (tcc *TaskCommentCreate) Save(ctx context.Context) (*TaskComment, error) {
+	tcc.defaults()
+	return withHooks(ctx, tcc.sqlSave, tcc.mutation, tcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tcc *TaskCommentCreate) SaveX(ctx context.Context) *TaskComment {
+	v, err := tcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcc *TaskCommentCreate) Exec(ctx context.Context) error {
+	_, err := tcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcc *TaskCommentCreate) ExecX(ctx context.Context) {
+	if err := tcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcc *TaskCommentCreate) defaults() {
+	if _, ok := tcc.mutation.CreatedAt(); !ok {
+		v := taskcomment.DefaultCreatedAt()
+		tcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tcc.mutation.UpdatedAt(); !ok {
+		v := taskcomment.DefaultUpdatedAt()
+		tcc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcc *TaskCommentCreate) check() error {
+	if _, ok := tcc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskComment.task_id"`)}
+	}
+	if _, ok := tcc.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`ent: missing required field "TaskComment.author_id"`)}
+	}
+	if _, ok := tcc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "TaskComment.content"`)}
+	}
+	if _, ok := tcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskComment.created_at"`)}
+	}
+	if _, ok := tcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaskComment.updated_at"`)}
+	}
+	if len(tcc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskComment.task"`)}
+	}
+	if len(tcc.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "TaskComment.author"`)}
+	}
+	return nil
+}
+
+func (tcc *TaskCommentCreate) sqlSave(ctx context.Context) (*TaskComment, error) {
+	if err := tcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tcc.mutation.id = &_node.ID
+	tcc.mutation.done = true
+	return _node, nil
+}
+
+func (tcc *TaskCommentCreate) createSpec() (*TaskComment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskComment{config: tcc.config}
+		_spec = sqlgraph.NewCreateSpec(taskcomment.Table, sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tcc.conflict
+	if value, ok := tcc.mutation.Content(); ok {
+		_spec.SetField(taskcomment.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := tcc.mutation.EditedAt(); ok {
+		_spec.SetField(taskcomment.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := tcc.mutation.DeletedAt(); ok {
+		_spec.SetField(taskcomment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := tcc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskcomment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskcomment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := tcc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.TaskTable,
+			Columns: []string{taskcomment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tcc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.AuthorTable,
+			Columns: []string{taskcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AuthorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tcc.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RepliesTable,
+			Columns: []string{taskcomment.RepliesColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tcc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.ParentTable,
+			Columns: []string{taskcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tcc.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   taskcomment.MentionsTable,
+			Columns: taskcomment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tcc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RevisionsTable,
+			Columns: []string{taskcomment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskComment.Create().
+//		SetTaskID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskCommentUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (tcc *TaskCommentCreate) OnConflict(opts ...sql.ConflictOption) *TaskCommentUpsertOne {
+	tcc.conflict = opts
+	return &TaskCommentUpsertOne{
+		create: tcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskComment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcc *TaskCommentCreate) OnConflictColumns(columns ...string) *TaskCommentUpsertOne {
+	tcc.conflict = append(tcc.conflict, sql.ConflictColumns(columns...))
+	return &TaskCommentUpsertOne{
+		create: tcc,
+	}
+}
+
+type (
+	// TaskCommentUpsertOne is the builder for "upsert"-ing
+	//  one TaskComment node.
+	TaskCommentUpsertOne struct {
+		create *TaskCommentCreate
+	}
+
+	// TaskCommentUpsert is the "OnConflict" setter.
+	TaskCommentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskCommentUpsert) SetTaskID(v int) *TaskCommentUpsert {
+	u.Set(taskcomment.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskCommentUpsert) UpdateTaskID() *TaskCommentUpsert {
+	u.SetExcluded(taskcomment.FieldTaskID)
+	return u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *TaskCommentUpsert) SetAuthorID(v int) *TaskCommentUpsert {
+	u.Set(taskcomment.FieldAuthorID, v)
+	return u
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *TaskCommentUpsert) UpdateAuthorID() *TaskCommentUpsert {
+	u.SetExcluded(taskcomment.FieldAuthorID)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *TaskCommentUpsert) SetParentID(v int) *TaskCommentUpsert {
+	u.Set(taskcomment.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TaskCommentUpsert) UpdateParentID() *TaskCommentUpsert {
+	u.SetExcluded(taskcomment.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TaskCommentUpsert) ClearParentID() *TaskCommentUpsert {
+	u.SetNull(taskcomment.FieldParentID)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *TaskCommentUpsert) SetContent(v string) *TaskCommentUpsert {
+	u.Set(taskcomment.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *TaskCommentUpsert) UpdateContent() *TaskCommentUpsert {
+	u.SetExcluded(taskcomment.FieldContent)
+	return u
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *TaskCommentUpsert) SetEditedAt(v time.Time) *TaskCommentUpsert {
+	u.Set(taskcomment.FieldEditedAt, v)
+	return u
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *TaskCommentUpsert) UpdateEditedAt() *TaskCommentUpsert {
+	u.SetExcluded(taskcomment.FieldEditedAt)
+	return u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *TaskCommentUpsert) ClearEditedAt() *TaskCommentUpsert {
+	u.SetNull(taskcomment.FieldEditedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskCommentUpsert) SetDeletedAt(v time.Time) *TaskCommentUpsert {
+	u.Set(taskcomment.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskCommentUpsert) UpdateDeletedAt() *TaskCommentUpsert {
+	u.SetExcluded(taskcomment.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskCommentUpsert) ClearDeletedAt() *TaskCommentUpsert {
+	u.SetNull(taskcomment.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskCommentUpsert) SetCreatedAt(v time.Time) *TaskCommentUpsert {
+	u.Set(taskcomment.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskCommentUpsert) UpdateCreatedAt() *TaskCommentUpsert {
+	u.SetExcluded(taskcomment.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskCommentUpsert) SetUpdatedAt(v time.Time) *TaskCommentUpsert {
+	u.Set(taskcomment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskCommentUpsert) UpdateUpdatedAt() *TaskCommentUpsert {
+	u.SetExcluded(taskcomment.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskComment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskCommentUpsertOne) UpdateNewValues() *TaskCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskComment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskCommentUpsertOne) Ignore() *TaskCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskCommentUpsertOne) DoNothing() *TaskCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskCommentCreate.OnConflict
+// documentation for more info.
+func (u *TaskCommentUpsertOne) Update(set func(*TaskCommentUpsert)) *TaskCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskCommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskCommentUpsertOne) SetTaskID(v int) *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskCommentUpsertOne) UpdateTaskID() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *TaskCommentUpsertOne) SetAuthorID(v int) *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *TaskCommentUpsertOne) UpdateAuthorID() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *TaskCommentUpsertOne) SetParentID(v int) *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TaskCommentUpsertOne) UpdateParentID() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TaskCommentUpsertOne) ClearParentID() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *TaskCommentUpsertOne) SetContent(v string) *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *TaskCommentUpsertOne) UpdateContent() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *TaskCommentUpsertOne) SetEditedAt(v time.Time) *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *TaskCommentUpsertOne) UpdateEditedAt() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *TaskCommentUpsertOne) ClearEditedAt() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskCommentUpsertOne) SetDeletedAt(v time.Time) *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskCommentUpsertOne) UpdateDeletedAt() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskCommentUpsertOne) ClearDeletedAt() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskCommentUpsertOne) SetCreatedAt(v time.Time) *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskCommentUpsertOne) UpdateCreatedAt() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskCommentUpsertOne) SetUpdatedAt(v time.Time) *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskCommentUpsertOne) UpdateUpdatedAt() *TaskCommentUpsertOne {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskCommentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskCommentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskCommentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskCommentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskCommentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskCommentCreateBulk is the builder for creating many TaskComment entities in bulk.
+type TaskCommentCreateBulk struct {
+	config
+	err      error
+	builders []*TaskCommentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskComment entities in the database.
+func (tccb *TaskCommentCreateBulk) Save(ctx context.Context) ([]*TaskComment, error) {
+	if tccb.err != nil {
+		return nil, tccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tccb.builders))
+	nodes := make([]*TaskComment, len(tccb.builders))
+	mutators := make([]Mutator, len(tccb.builders))
+	for i := range tccb.builders {
+		func(i int, root context.Context) {
+			builder := tccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskCommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tccb *TaskCommentCreateBulk) SaveX(ctx context.Context) []*TaskComment {
+	v, err := tccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tccb *TaskCommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := tccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tccb *TaskCommentCreateBulk) ExecX(ctx context.Context) {
+	if err := tccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskComment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskCommentUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (tccb *TaskCommentCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskCommentUpsertBulk {
+	tccb.conflict = opts
+	return &TaskCommentUpsertBulk{
+		create: tccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskComment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tccb *TaskCommentCreateBulk) OnConflictColumns(columns ...string) *TaskCommentUpsertBulk {
+	tccb.conflict = append(tccb.conflict, sql.ConflictColumns(columns...))
+	return &TaskCommentUpsertBulk{
+		create: tccb,
+	}
+}
+
+// TaskCommentUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskComment nodes.
+type TaskCommentUpsertBulk struct {
+	create *TaskCommentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskComment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskCommentUpsertBulk) UpdateNewValues() *TaskCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskComment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskCommentUpsertBulk) Ignore() *TaskCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskCommentUpsertBulk) DoNothing() *TaskCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskCommentCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskCommentUpsertBulk) Update(set func(*TaskCommentUpsert)) *TaskCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskCommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskCommentUpsertBulk) SetTaskID(v int) *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskCommentUpsertBulk) UpdateTaskID() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *TaskCommentUpsertBulk) SetAuthorID(v int) *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *TaskCommentUpsertBulk) UpdateAuthorID() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *TaskCommentUpsertBulk) SetParentID(v int) *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TaskCommentUpsertBulk) UpdateParentID() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TaskCommentUpsertBulk) ClearParentID() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *TaskCommentUpsertBulk) SetContent(v string) *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *TaskCommentUpsertBulk) UpdateContent() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *TaskCommentUpsertBulk) SetEditedAt(v time.Time) *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *TaskCommentUpsertBulk) UpdateEditedAt() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *TaskCommentUpsertBulk) ClearEditedAt() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskCommentUpsertBulk) SetDeletedAt(v time.Time) *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskCommentUpsertBulk) UpdateDeletedAt() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskCommentUpsertBulk) ClearDeletedAt() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskCommentUpsertBulk) SetCreatedAt(v time.Time) *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskCommentUpsertBulk) UpdateCreatedAt() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskCommentUpsertBulk) SetUpdatedAt(v time.Time) *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskCommentUpsertBulk) UpdateUpdatedAt() *TaskCommentUpsertBulk {
+	return u.Update(func(s *TaskCommentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskCommentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskCommentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskCommentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskCommentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
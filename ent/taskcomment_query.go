@@ -0,0 +1,1007 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+)
+
+// TaskCommentQuery is the builder for querying TaskComment entities.
+type TaskCommentQuery struct {
+	config
+	ctx           *QueryContext
+	order         []taskcomment.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.TaskComment
+	withTask      *TaskQuery
+	withAuthor    *EmployeeQuery
+	withReplies   *TaskCommentQuery
+	withParent    *TaskCommentQuery
+	withMentions  *EmployeeQuery
+	withRevisions *TaskCommentRevisionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskCommentQuery builder.
+func (tcq *TaskCommentQuery) Where(ps ...predicate.TaskComment) *TaskCommentQuery {
+	tcq.predicates = append(tcq.predicates, ps...)
+	return tcq
+}
+
+// Limit the number of records to be returned by this query.
+func (tcq *TaskCommentQuery) Limit(limit int) *TaskCommentQuery {
+	tcq.ctx.Limit = &limit
+	return tcq
+}
+
+// Offset to start from.
+func (tcq *TaskCommentQuery) Offset(offset int) *TaskCommentQuery {
+	tcq.ctx.Offset = &offset
+	return tcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tcq *TaskCommentQuery) Unique(unique bool) *TaskCommentQuery {
+	tcq.ctx.Unique = &unique
+	return tcq
+}
+
+// Order specifies how the records should be ordered.
+func (tcq *TaskCommentQuery) Order(o ...taskcomment.OrderOption) *TaskCommentQuery {
+	tcq.order = append(tcq.order, o...)
+	return tcq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (tcq *TaskCommentQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskcomment.TaskTable, taskcomment.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuthor chains the current query on the "author" edge.
+func (tcq *TaskCommentQuery) QueryAuthor() *EmployeeQuery {
+	query := (&EmployeeClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskcomment.AuthorTable, taskcomment.AuthorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplies chains the current query on the "replies" edge.
+func (tcq *TaskCommentQuery) QueryReplies() *TaskCommentQuery {
+	query := (&TaskCommentClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, selector),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, taskcomment.RepliesTable, taskcomment.RepliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (tcq *TaskCommentQuery) QueryParent() *TaskCommentQuery {
+	query := (&TaskCommentClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, selector),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskcomment.ParentTable, taskcomment.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMentions chains the current query on the "mentions" edge.
+func (tcq *TaskCommentQuery) QueryMentions() *EmployeeQuery {
+	query := (&EmployeeClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, taskcomment.MentionsTable, taskcomment.MentionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (tcq *TaskCommentQuery) QueryRevisions() *TaskCommentRevisionQuery {
+	query := (&TaskCommentRevisionClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcomment.Table, taskcomment.FieldID, selector),
+			sqlgraph.To(taskcommentrevision.Table, taskcommentrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, taskcomment.RevisionsTable, taskcomment.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskComment entity from the query.
+// Returns a *NotFoundError when no TaskComment was found.
+func (tcq *TaskCommentQuery) First(ctx context.Context) (*TaskComment, error) {
+	nodes, err := tcq.Limit(1).All(setContextOp(ctx, tcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskcomment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tcq *TaskCommentQuery) FirstX(ctx context.Context) *TaskComment {
+	node, err := tcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskComment ID from the query.
+// Returns a *NotFoundError when no TaskComment ID was found.
+func (tcq *TaskCommentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tcq.Limit(1).IDs(setContextOp(ctx, tcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskcomment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tcq *TaskCommentQuery) FirstIDX(ctx context.Context) int {
+	id, err := tcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskComment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskComment entity is found.
+// Returns a *NotFoundError when no TaskComment entities are found.
+func (tcq *TaskCommentQuery) Only(ctx context.Context) (*TaskComment, error) {
+	nodes, err := tcq.Limit(2).All(setContextOp(ctx, tcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskcomment.Label}
+	default:
+		return nil, &NotSingularError{taskcomment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tcq *TaskCommentQuery) OnlyX(ctx context.Context) *TaskComment {
+	node, err := tcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskComment ID in the query.
+// Returns a *NotSingularError when more than one TaskComment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tcq *TaskCommentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tcq.Limit(2).IDs(setContextOp(ctx, tcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskcomment.Label}
+	default:
+		err = &NotSingularError{taskcomment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tcq *TaskCommentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskComments.
+func (tcq *TaskCommentQuery) All(ctx context.Context) ([]*TaskComment, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryAll)
+	if err := tcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskComment, *TaskCommentQuery]()
+	return withInterceptors[[]*TaskComment](ctx, tcq, qr, tcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tcq *TaskCommentQuery) AllX(ctx context.Context) []*TaskComment {
+	nodes, err := tcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskComment IDs.
+func (tcq *TaskCommentQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tcq.ctx.Unique == nil && tcq.path != nil {
+		tcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryIDs)
+	if err = tcq.Select(taskcomment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tcq *TaskCommentQuery) IDsX(ctx context.Context) []int {
+	ids, err := tcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tcq *TaskCommentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryCount)
+	if err := tcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tcq, querierCount[*TaskCommentQuery](), tcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tcq *TaskCommentQuery) CountX(ctx context.Context) int {
+	count, err := tcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tcq *TaskCommentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryExist)
+	switch _, err := tcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tcq *TaskCommentQuery) ExistX(ctx context.Context) bool {
+	exist, err := tcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskCommentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tcq *TaskCommentQuery) Clone() *TaskCommentQuery {
+	if tcq == nil {
+		return nil
+	}
+	return &TaskCommentQuery{
+		config:        tcq.config,
+		ctx:           tcq.ctx.Clone(),
+		order:         append([]taskcomment.OrderOption{}, tcq.order...),
+		inters:        append([]Interceptor{}, tcq.inters...),
+		predicates:    append([]predicate.TaskComment{}, tcq.predicates...),
+		withTask:      tcq.withTask.Clone(),
+		withAuthor:    tcq.withAuthor.Clone(),
+		withReplies:   tcq.withReplies.Clone(),
+		withParent:    tcq.withParent.Clone(),
+		withMentions:  tcq.withMentions.Clone(),
+		withRevisions: tcq.withRevisions.Clone(),
+		// clone intermediate query.
+		sql:  tcq.sql.Clone(),
+		path: tcq.path,
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TaskCommentQuery) WithTask(opts ...func(*TaskQuery)) *TaskCommentQuery {
+	query := (&TaskClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withTask = query
+	return tcq
+}
+
+// WithAuthor tells the query-builder to eager-load the nodes that are connected to
+// the "author" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TaskCommentQuery) WithAuthor(opts ...func(*EmployeeQuery)) *TaskCommentQuery {
+	query := (&EmployeeClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withAuthor = query
+	return tcq
+}
+
+// WithReplies tells the query-builder to eager-load the nodes that are connected to
+// the "replies" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TaskCommentQuery) WithReplies(opts ...func(*TaskCommentQuery)) *TaskCommentQuery {
+	query := (&TaskCommentClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withReplies = query
+	return tcq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TaskCommentQuery) WithParent(opts ...func(*TaskCommentQuery)) *TaskCommentQuery {
+	query := (&TaskCommentClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withParent = query
+	return tcq
+}
+
+// WithMentions tells the query-builder to eager-load the nodes that are connected to
+// the "mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TaskCommentQuery) WithMentions(opts ...func(*EmployeeQuery)) *TaskCommentQuery {
+	query := (&EmployeeClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withMentions = query
+	return tcq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TaskCommentQuery) WithRevisions(opts ...func(*TaskCommentRevisionQuery)) *TaskCommentQuery {
+	query := (&TaskCommentRevisionClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withRevisions = query
+	return tcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID int `json:"task_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskComment.Query().
+//		GroupBy(taskcomment.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tcq *TaskCommentQuery) GroupBy(field string, fields ...string) *TaskCommentGroupBy {
+	tcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskCommentGroupBy{build: tcq}
+	grbuild.flds = &tcq.ctx.Fields
+	grbuild.label = taskcomment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID int `json:"task_id"`
+//	}
+//
+//	client.TaskComment.Query().
+//		Select(taskcomment.FieldTaskID).
+//		Scan(ctx, &v)
+func (tcq *TaskCommentQuery) Select(fields ...string) *TaskCommentSelect {
+	tcq.ctx.Fields = append(tcq.ctx.Fields, fields...)
+	sbuild := &TaskCommentSelect{TaskCommentQuery: tcq}
+	sbuild.label = taskcomment.Label
+	sbuild.flds, sbuild.scan = &tcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskCommentSelect configured with the given aggregations.
+func (tcq *TaskCommentQuery) Aggregate(fns ...AggregateFunc) *TaskCommentSelect {
+	return tcq.Select().Aggregate(fns...)
+}
+
+func (tcq *TaskCommentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tcq.ctx.Fields {
+		if !taskcomment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tcq.path != nil {
+		prev, err := tcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tcq.sql = prev
+	}
+	return nil
+}
+
+func (tcq *TaskCommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskComment, error) {
+	var (
+		nodes       = []*TaskComment{}
+		_spec       = tcq.querySpec()
+		loadedTypes = [6]bool{
+			tcq.withTask != nil,
+			tcq.withAuthor != nil,
+			tcq.withReplies != nil,
+			tcq.withParent != nil,
+			tcq.withMentions != nil,
+			tcq.withRevisions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskComment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskComment{config: tcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tcq.withTask; query != nil {
+		if err := tcq.loadTask(ctx, query, nodes, nil,
+			func(n *TaskComment, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tcq.withAuthor; query != nil {
+		if err := tcq.loadAuthor(ctx, query, nodes, nil,
+			func(n *TaskComment, e *Employee) { n.Edges.Author = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tcq.withReplies; query != nil {
+		if err := tcq.loadReplies(ctx, query, nodes,
+			func(n *TaskComment) { n.Edges.Replies = []*TaskComment{} },
+			func(n *TaskComment, e *TaskComment) { n.Edges.Replies = append(n.Edges.Replies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tcq.withParent; query != nil {
+		if err := tcq.loadParent(ctx, query, nodes, nil,
+			func(n *TaskComment, e *TaskComment) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tcq.withMentions; query != nil {
+		if err := tcq.loadMentions(ctx, query, nodes,
+			func(n *TaskComment) { n.Edges.Mentions = []*Employee{} },
+			func(n *TaskComment, e *Employee) { n.Edges.Mentions = append(n.Edges.Mentions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tcq.withRevisions; query != nil {
+		if err := tcq.loadRevisions(ctx, query, nodes,
+			func(n *TaskComment) { n.Edges.Revisions = []*TaskCommentRevision{} },
+			func(n *TaskComment, e *TaskCommentRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tcq *TaskCommentQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*TaskComment, init func(*TaskComment), assign func(*TaskComment, *Task)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskComment)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tcq *TaskCommentQuery) loadAuthor(ctx context.Context, query *EmployeeQuery, nodes []*TaskComment, init func(*TaskComment), assign func(*TaskComment, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskComment)
+	for i := range nodes {
+		fk := nodes[i].AuthorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "author_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tcq *TaskCommentQuery) loadReplies(ctx context.Context, query *TaskCommentQuery, nodes []*TaskComment, init func(*TaskComment), assign func(*TaskComment, *TaskComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*TaskComment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskcomment.FieldParentID)
+	}
+	query.Where(predicate.TaskComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(taskcomment.RepliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tcq *TaskCommentQuery) loadParent(ctx context.Context, query *TaskCommentQuery, nodes []*TaskComment, init func(*TaskComment), assign func(*TaskComment, *TaskComment)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskComment)
+	for i := range nodes {
+		fk := nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(taskcomment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tcq *TaskCommentQuery) loadMentions(ctx context.Context, query *EmployeeQuery, nodes []*TaskComment, init func(*TaskComment), assign func(*TaskComment, *Employee)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*TaskComment)
+	nids := make(map[int]map[*TaskComment]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(taskcomment.MentionsTable)
+		s.Join(joinT).On(s.C(employee.FieldID), joinT.C(taskcomment.MentionsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(taskcomment.MentionsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(taskcomment.MentionsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*TaskComment]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Employee](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "mentions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (tcq *TaskCommentQuery) loadRevisions(ctx context.Context, query *TaskCommentRevisionQuery, nodes []*TaskComment, init func(*TaskComment), assign func(*TaskComment, *TaskCommentRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*TaskComment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskcommentrevision.FieldCommentID)
+	}
+	query.Where(predicate.TaskCommentRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(taskcomment.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CommentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "comment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (tcq *TaskCommentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tcq.querySpec()
+	_spec.Node.Columns = tcq.ctx.Fields
+	if len(tcq.ctx.Fields) > 0 {
+		_spec.Unique = tcq.ctx.Unique != nil && *tcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tcq.driver, _spec)
+}
+
+func (tcq *TaskCommentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskcomment.Table, taskcomment.Columns, sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt))
+	_spec.From = tcq.sql
+	if unique := tcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskcomment.FieldID)
+		for i := range fields {
+			if fields[i] != taskcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tcq.withTask != nil {
+			_spec.Node.AddColumnOnce(taskcomment.FieldTaskID)
+		}
+		if tcq.withAuthor != nil {
+			_spec.Node.AddColumnOnce(taskcomment.FieldAuthorID)
+		}
+		if tcq.withParent != nil {
+			_spec.Node.AddColumnOnce(taskcomment.FieldParentID)
+		}
+	}
+	if ps := tcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tcq *TaskCommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tcq.driver.Dialect())
+	t1 := builder.Table(taskcomment.Table)
+	columns := tcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskcomment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tcq.sql != nil {
+		selector = tcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tcq.ctx.Unique != nil && *tcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tcq.predicates {
+		p(selector)
+	}
+	for _, p := range tcq.order {
+		p(selector)
+	}
+	if offset := tcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaskCommentGroupBy is the group-by builder for TaskComment entities.
+type TaskCommentGroupBy struct {
+	selector
+	build *TaskCommentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tcgb *TaskCommentGroupBy) Aggregate(fns ...AggregateFunc) *TaskCommentGroupBy {
+	tcgb.fns = append(tcgb.fns, fns...)
+	return tcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcgb *TaskCommentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskCommentQuery, *TaskCommentGroupBy](ctx, tcgb.build, tcgb, tcgb.build.inters, v)
+}
+
+func (tcgb *TaskCommentGroupBy) sqlScan(ctx context.Context, root *TaskCommentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tcgb.fns))
+	for _, fn := range tcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tcgb.flds)+len(tcgb.fns))
+		for _, f := range *tcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskCommentSelect is the builder for selecting fields of TaskComment entities.
+type TaskCommentSelect struct {
+	*TaskCommentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tcs *TaskCommentSelect) Aggregate(fns ...AggregateFunc) *TaskCommentSelect {
+	tcs.fns = append(tcs.fns, fns...)
+	return tcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcs *TaskCommentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcs.ctx, ent.OpQuerySelect)
+	if err := tcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskCommentQuery, *TaskCommentSelect](ctx, tcs.TaskCommentQuery, tcs, tcs.inters, v)
+}
+
+func (tcs *TaskCommentSelect) sqlScan(ctx context.Context, root *TaskCommentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tcs.fns))
+	for _, fn := range tcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+)
+
+// TaskCommentUpdate is the builder for updating TaskComment entities.
+type TaskCommentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskCommentMutation
+}
+
+// Where appends a list predicates to the TaskCommentUpdate builder.
+func (tcu *TaskCommentUpdate) Where(ps ...predicate.TaskComment) *TaskCommentUpdate {
+	tcu.mutation.Where(ps...)
+	return tcu
+}
+
+// SetTaskID sets the "task_id" field.
+func (tcu *TaskCommentUpdate) SetTaskID(i int) *TaskCommentUpdate {
+	tcu.mutation.SetTaskID(i)
+	return tcu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableTaskID(i *int) *TaskCommentUpdate {
+	if i != nil {
+		tcu.SetTaskID(*i)
+	}
+	return tcu
+}
+
+// SetAuthorID sets the "author_id" field.
+func (tcu *TaskCommentUpdate) SetAuthorID(i int) *TaskCommentUpdate {
+	tcu.mutation.SetAuthorID(i)
+	return tcu
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableAuthorID(i *int) *TaskCommentUpdate {
+	if i != nil {
+		tcu.SetAuthorID(*i)
+	}
+	return tcu
+}
+
+// SetParentID sets the "parent_id" field.
+func (tcu *TaskCommentUpdate) SetParentID(i int) *TaskCommentUpdate {
+	tcu.mutation.SetParentID(i)
+	return tcu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableParentID(i *int) *TaskCommentUpdate {
+	if i != nil {
+		tcu.SetParentID(*i)
+	}
+	return tcu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tcu *TaskCommentUpdate) ClearParentID() *TaskCommentUpdate {
+	tcu.mutation.ClearParentID()
+	return tcu
+}
+
+// SetContent sets the "content" field.
+func (tcu *TaskCommentUpdate) SetContent(s string) *TaskCommentUpdate {
+	tcu.mutation.SetContent(s)
+	return tcu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableContent(s *string) *TaskCommentUpdate {
+	if s != nil {
+		tcu.SetContent(*s)
+	}
+	return tcu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tcu *TaskCommentUpdate) SetEditedAt(t time.Time) *TaskCommentUpdate {
+	tcu.mutation.SetEditedAt(t)
+	return tcu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableEditedAt(t *time.Time) *TaskCommentUpdate {
+	if t != nil {
+		tcu.SetEditedAt(*t)
+	}
+	return tcu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (tcu *TaskCommentUpdate) ClearEditedAt() *TaskCommentUpdate {
+	tcu.mutation.ClearEditedAt()
+	return tcu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tcu *TaskCommentUpdate) SetDeletedAt(t time.Time) *TaskCommentUpdate {
+	tcu.mutation.SetDeletedAt(t)
+	return tcu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableDeletedAt(t *time.Time) *TaskCommentUpdate {
+	if t != nil {
+		tcu.SetDeletedAt(*t)
+	}
+	return tcu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tcu *TaskCommentUpdate) ClearDeletedAt() *TaskCommentUpdate {
+	tcu.mutation.ClearDeletedAt()
+	return tcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcu *TaskCommentUpdate) SetCreatedAt(t time.Time) *TaskCommentUpdate {
+	tcu.mutation.SetCreatedAt(t)
+	return tcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableCreatedAt(t *time.Time) *TaskCommentUpdate {
+	if t != nil {
+		tcu.SetCreatedAt(*t)
+	}
+	return tcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcu *TaskCommentUpdate) SetUpdatedAt(t time.Time) *TaskCommentUpdate {
+	tcu.mutation.SetUpdatedAt(t)
+	return tcu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tcu *TaskCommentUpdate) SetTask(t *Task) *TaskCommentUpdate {
+	return tcu.SetTaskID(t.ID)
+}
+
+// SetAuthor sets the "author" edge to the Employee entity.
+func (tcu *TaskCommentUpdate) SetAuthor(e *Employee) *TaskCommentUpdate {
+	return tcu.SetAuthorID(e.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the TaskComment entity by IDs.
+func (tcu *TaskCommentUpdate) AddReplyIDs(ids ...int) *TaskCommentUpdate {
+	tcu.mutation.AddReplyIDs(ids...)
+	return tcu
+}
+
+// AddReplies adds the "replies" edges to the TaskComment entity.
+func (tcu *TaskCommentUpdate) AddReplies(t ...*TaskComment) *TaskCommentUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcu.AddReplyIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the TaskComment entity.
+func (tcu *TaskCommentUpdate) SetParent(t *TaskComment) *TaskCommentUpdate {
+	return tcu.SetParentID(t.ID)
+}
+
+// AddMentionIDs adds the "mentions" edge to the Employee entity by IDs.
+func (tcu *TaskCommentUpdate) AddMentionIDs(ids ...int) *TaskCommentUpdate {
+	tcu.mutation.AddMentionIDs(ids...)
+	return tcu
+}
+
+// AddMentions adds the "mentions" edges to the Employee entity.
+func (tcu *TaskCommentUpdate) AddMentions(e ...*Employee) *TaskCommentUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tcu.AddMentionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the TaskCommentRevision entity by IDs.
+func (tcu *TaskCommentUpdate) AddRevisionIDs(ids ...int) *TaskCommentUpdate {
+	tcu.mutation.AddRevisionIDs(ids...)
+	return tcu
+}
+
+// AddRevisions adds the "revisions" edges to the TaskCommentRevision entity.
+func (tcu *TaskCommentUpdate) AddRevisions(t ...*TaskCommentRevision) *TaskCommentUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcu.AddRevisionIDs(ids...)
+}
+
+// Mutation returns the TaskCommentMutation object of the builder.
+func (tcu *TaskCommentUpdate) Mutation() *TaskCommentMutation {
+	return tcu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tcu *TaskCommentUpdate) ClearTask() *TaskCommentUpdate {
+	tcu.mutation.ClearTask()
+	return tcu
+}
+
+// ClearAuthor clears the "author" edge to the Employee entity.
+func (tcu *TaskCommentUpdate) ClearAuthor() *TaskCommentUpdate {
+	tcu.mutation.ClearAuthor()
+	return tcu
+}
+
+// ClearReplies clears all "replies" edges to the TaskComment entity.
+func (tcu *TaskCommentUpdate) ClearReplies() *TaskCommentUpdate {
+	tcu.mutation.ClearReplies()
+	return tcu
+}
+
+// RemoveReplyIDs removes the "replies" edge to TaskComment entities by IDs.
+func (tcu *TaskCommentUpdate) RemoveReplyIDs(ids ...int) *TaskCommentUpdate {
+	tcu.mutation.RemoveReplyIDs(ids...)
+	return tcu
+}
+
+// RemoveReplies removes "replies" edges to TaskComment entities.
+func (tcu *TaskCommentUpdate) RemoveReplies(t ...*TaskComment) *TaskCommentUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcu.RemoveReplyIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the TaskComment entity.
+func (tcu *TaskCommentUpdate) ClearParent() *TaskCommentUpdate {
+	tcu.mutation.ClearParent()
+	return tcu
+}
+
+// ClearMentions clears all "mentions" edges to the Employee entity.
+func (tcu *TaskCommentUpdate) ClearMentions() *TaskCommentUpdate {
+	tcu.mutation.ClearMentions()
+	return tcu
+}
+
+// RemoveMentionIDs removes the "mentions" edge to Employee entities by IDs.
+func (tcu *TaskCommentUpdate) RemoveMentionIDs(ids ...int) *TaskCommentUpdate {
+	tcu.mutation.RemoveMentionIDs(ids...)
+	return tcu
+}
+
+// RemoveMentions removes "mentions" edges to Employee entities.
+func (tcu *TaskCommentUpdate) RemoveMentions(e ...*Employee) *TaskCommentUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tcu.RemoveMentionIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the TaskCommentRevision entity.
+func (tcu *TaskCommentUpdate) ClearRevisions() *TaskCommentUpdate {
+	tcu.mutation.ClearRevisions()
+	return tcu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to TaskCommentRevision entities by IDs.
+func (tcu *TaskCommentUpdate) RemoveRevisionIDs(ids ...int) *TaskCommentUpdate {
+	tcu.mutation.RemoveRevisionIDs(ids...)
+	return tcu
+}
+
+// RemoveRevisions removes "revisions" edges to TaskCommentRevision entities.
+func (tcu *TaskCommentUpdate) RemoveRevisions(t ...*TaskCommentRevision) *TaskCommentUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcu.RemoveRevisionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tcu *TaskCommentUpdate) Save(ctx context.Context) (int, error) {
+	tcu.defaults()
+	return withHooks(ctx, tcu.sqlSave, tcu.mutation, tcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcu *TaskCommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := tcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tcu *TaskCommentUpdate) Exec(ctx context.Context) error {
+	_, err := tcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcu *TaskCommentUpdate) ExecX(ctx context.Context) {
+	if err := tcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcu *TaskCommentUpdate) defaults() {
+	if _, ok := tcu.mutation.UpdatedAt(); !ok {
+		v := taskcomment.UpdateDefaultUpdatedAt()
+		tcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcu *TaskCommentUpdate) check() error {
+	if tcu.mutation.TaskCleared() && len(tcu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskComment.task"`)
+	}
+	if tcu.mutation.AuthorCleared() && len(tcu.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskComment.author"`)
+	}
+	return nil
+}
+
+func (tcu *TaskCommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskcomment.Table, taskcomment.Columns, sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt))
+	if ps := tcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcu.mutation.Content(); ok {
+		_spec.SetField(taskcomment.FieldContent, field.TypeString, value)
+	}
+	if value, ok := tcu.mutation.EditedAt(); ok {
+		_spec.SetField(taskcomment.FieldEditedAt, field.TypeTime, value)
+	}
+	if tcu.mutation.EditedAtCleared() {
+		_spec.ClearField(taskcomment.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := tcu.mutation.DeletedAt(); ok {
+		_spec.SetField(taskcomment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tcu.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskcomment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tcu.mutation.CreatedAt(); ok {
+		_spec.SetField(taskcomment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskcomment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tcu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.TaskTable,
+			Columns: []string{taskcomment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.TaskTable,
+			Columns: []string{taskcomment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.AuthorTable,
+			Columns: []string{taskcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.AuthorTable,
+			Columns: []string{taskcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RepliesTable,
+			Columns: []string{taskcomment.RepliesColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !tcu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RepliesTable,
+			Columns: []string{taskcomment.RepliesColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RepliesTable,
+			Columns: []string{taskcomment.RepliesColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.ParentTable,
+			Columns: []string{taskcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.ParentTable,
+			Columns: []string{taskcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcu.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   taskcomment.MentionsTable,
+			Columns: taskcomment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !tcu.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   taskcomment.MentionsTable,
+			Columns: taskcomment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   taskcomment.MentionsTable,
+			Columns: taskcomment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RevisionsTable,
+			Columns: []string{taskcomment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !tcu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RevisionsTable,
+			Columns: []string{taskcomment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RevisionsTable,
+			Columns: []string{taskcomment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tcu.mutation.done = true
+	return n, nil
+}
+
+// TaskCommentUpdateOne is the builder for updating a single TaskComment entity.
+type TaskCommentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskCommentMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (tcuo *TaskCommentUpdateOne) SetTaskID(i int) *TaskCommentUpdateOne {
+	tcuo.mutation.SetTaskID(i)
+	return tcuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableTaskID(i *int) *TaskCommentUpdateOne {
+	if i != nil {
+		tcuo.SetTaskID(*i)
+	}
+	return tcuo
+}
+
+// SetAuthorID sets the "author_id" field.
+func (tcuo *TaskCommentUpdateOne) SetAuthorID(i int) *TaskCommentUpdateOne {
+	tcuo.mutation.SetAuthorID(i)
+	return tcuo
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableAuthorID(i *int) *TaskCommentUpdateOne {
+	if i != nil {
+		tcuo.SetAuthorID(*i)
+	}
+	return tcuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (tcuo *TaskCommentUpdateOne) SetParentID(i int) *TaskCommentUpdateOne {
+	tcuo.mutation.SetParentID(i)
+	return tcuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableParentID(i *int) *TaskCommentUpdateOne {
+	if i != nil {
+		tcuo.SetParentID(*i)
+	}
+	return tcuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tcuo *TaskCommentUpdateOne) ClearParentID() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearParentID()
+	return tcuo
+}
+
+// SetContent sets the "content" field.
+func (tcuo *TaskCommentUpdateOne) SetContent(s string) *TaskCommentUpdateOne {
+	tcuo.mutation.SetContent(s)
+	return tcuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableContent(s *string) *TaskCommentUpdateOne {
+	if s != nil {
+		tcuo.SetContent(*s)
+	}
+	return tcuo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (tcuo *TaskCommentUpdateOne) SetEditedAt(t time.Time) *TaskCommentUpdateOne {
+	tcuo.mutation.SetEditedAt(t)
+	return tcuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableEditedAt(t *time.Time) *TaskCommentUpdateOne {
+	if t != nil {
+		tcuo.SetEditedAt(*t)
+	}
+	return tcuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (tcuo *TaskCommentUpdateOne) ClearEditedAt() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearEditedAt()
+	return tcuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tcuo *TaskCommentUpdateOne) SetDeletedAt(t time.Time) *TaskCommentUpdateOne {
+	tcuo.mutation.SetDeletedAt(t)
+	return tcuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableDeletedAt(t *time.Time) *TaskCommentUpdateOne {
+	if t != nil {
+		tcuo.SetDeletedAt(*t)
+	}
+	return tcuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tcuo *TaskCommentUpdateOne) ClearDeletedAt() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearDeletedAt()
+	return tcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcuo *TaskCommentUpdateOne) SetCreatedAt(t time.Time) *TaskCommentUpdateOne {
+	tcuo.mutation.SetCreatedAt(t)
+	return tcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableCreatedAt(t *time.Time) *TaskCommentUpdateOne {
+	if t != nil {
+		tcuo.SetCreatedAt(*t)
+	}
+	return tcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcuo *TaskCommentUpdateOne) SetUpdatedAt(t time.Time) *TaskCommentUpdateOne {
+	tcuo.mutation.SetUpdatedAt(t)
+	return tcuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tcuo *TaskCommentUpdateOne) SetTask(t *Task) *TaskCommentUpdateOne {
+	return tcuo.SetTaskID(t.ID)
+}
+
+// SetAuthor sets the "author" edge to the Employee entity.
+func (tcuo *TaskCommentUpdateOne) SetAuthor(e *Employee) *TaskCommentUpdateOne {
+	return tcuo.SetAuthorID(e.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the TaskComment entity by IDs.
+func (tcuo *TaskCommentUpdateOne) AddReplyIDs(ids ...int) *TaskCommentUpdateOne {
+	tcuo.mutation.AddReplyIDs(ids...)
+	return tcuo
+}
+
+// AddReplies adds the "replies" edges to the TaskComment entity.
+func (tcuo *TaskCommentUpdateOne) AddReplies(t ...*TaskComment) *TaskCommentUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcuo.AddReplyIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the TaskComment entity.
+func (tcuo *TaskCommentUpdateOne) SetParent(t *TaskComment) *TaskCommentUpdateOne {
+	return tcuo.SetParentID(t.ID)
+}
+
+// AddMentionIDs adds the "mentions" edge to the Employee entity by IDs.
+func (tcuo *TaskCommentUpdateOne) AddMentionIDs(ids ...int) *TaskCommentUpdateOne {
+	tcuo.mutation.AddMentionIDs(ids...)
+	return tcuo
+}
+
+// AddMentions adds the "mentions" edges to the Employee entity.
+func (tcuo *TaskCommentUpdateOne) AddMentions(e ...*Employee) *TaskCommentUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tcuo.AddMentionIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the TaskCommentRevision entity by IDs.
+func (tcuo *TaskCommentUpdateOne) AddRevisionIDs(ids ...int) *TaskCommentUpdateOne {
+	tcuo.mutation.AddRevisionIDs(ids...)
+	return tcuo
+}
+
+// AddRevisions adds the "revisions" edges to the TaskCommentRevision entity.
+func (tcuo *TaskCommentUpdateOne) AddRevisions(t ...*TaskCommentRevision) *TaskCommentUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcuo.AddRevisionIDs(ids...)
+}
+
+// Mutation returns the TaskCommentMutation object of the builder.
+func (tcuo *TaskCommentUpdateOne) Mutation() *TaskCommentMutation {
+	return tcuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tcuo *TaskCommentUpdateOne) ClearTask() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearTask()
+	return tcuo
+}
+
+// ClearAuthor clears the "author" edge to the Employee entity.
+func (tcuo *TaskCommentUpdateOne) ClearAuthor() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearAuthor()
+	return tcuo
+}
+
+// ClearReplies clears all "replies" edges to the TaskComment entity.
+func (tcuo *TaskCommentUpdateOne) ClearReplies() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearReplies()
+	return tcuo
+}
+
+// RemoveReplyIDs removes the "replies" edge to TaskComment entities by IDs.
+func (tcuo *TaskCommentUpdateOne) RemoveReplyIDs(ids ...int) *TaskCommentUpdateOne {
+	tcuo.mutation.RemoveReplyIDs(ids...)
+	return tcuo
+}
+
+// RemoveReplies removes "replies" edges to TaskComment entities.
+func (tcuo *TaskCommentUpdateOne) RemoveReplies(t ...*TaskComment) *TaskCommentUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcuo.RemoveReplyIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the TaskComment entity.
+func (tcuo *TaskCommentUpdateOne) ClearParent() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearParent()
+	return tcuo
+}
+
+// ClearMentions clears all "mentions" edges to the Employee entity.
+func (tcuo *TaskCommentUpdateOne) ClearMentions() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearMentions()
+	return tcuo
+}
+
+// RemoveMentionIDs removes the "mentions" edge to Employee entities by IDs.
+func (tcuo *TaskCommentUpdateOne) RemoveMentionIDs(ids ...int) *TaskCommentUpdateOne {
+	tcuo.mutation.RemoveMentionIDs(ids...)
+	return tcuo
+}
+
+// RemoveMentions removes "mentions" edges to Employee entities.
+func (tcuo *TaskCommentUpdateOne) RemoveMentions(e ...*Employee) *TaskCommentUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return tcuo.RemoveMentionIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the TaskCommentRevision entity.
+func (tcuo *TaskCommentUpdateOne) ClearRevisions() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearRevisions()
+	return tcuo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to TaskCommentRevision entities by IDs.
+func (tcuo *TaskCommentUpdateOne) RemoveRevisionIDs(ids ...int) *TaskCommentUpdateOne {
+	tcuo.mutation.RemoveRevisionIDs(ids...)
+	return tcuo
+}
+
+// RemoveRevisions removes "revisions" edges to TaskCommentRevision entities.
+func (tcuo *TaskCommentUpdateOne) RemoveRevisions(t ...*TaskCommentRevision) *TaskCommentUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcuo.RemoveRevisionIDs(ids...)
+}
+
+// Where appends a list predicates to the TaskCommentUpdate builder.
+func (tcuo *TaskCommentUpdateOne) Where(ps ...predicate.TaskComment) *TaskCommentUpdateOne {
+	tcuo.mutation.Where(ps...)
+	return tcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tcuo *TaskCommentUpdateOne) Select(field string, fields ...string) *TaskCommentUpdateOne {
+	tcuo.fields = append([]string{field}, fields...)
+	return tcuo
+}
+
+// Save executes the query and returns the updated TaskComment entity.
+func (tcuo *TaskCommentUpdateOne) Save(ctx context.Context) (*TaskComment, error) {
+	tcuo.defaults()
+	return withHooks(ctx, tcuo.sqlSave, tcuo.mutation, tcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcuo *TaskCommentUpdateOne) SaveX(ctx context.Context) *TaskComment {
+	node, err := tcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tcuo *TaskCommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := tcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcuo *TaskCommentUpdateOne) ExecX(ctx context.Context) {
+	if err := tcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcuo *TaskCommentUpdateOne) defaults() {
+	if _, ok := tcuo.mutation.UpdatedAt(); !ok {
+		v := taskcomment.UpdateDefaultUpdatedAt()
+		tcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcuo *TaskCommentUpdateOne) check() error {
+	if tcuo.mutation.TaskCleared() && len(tcuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskComment.task"`)
+	}
+	if tcuo.mutation.AuthorCleared() && len(tcuo.mutation.AuthorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskComment.author"`)
+	}
+	return nil
+}
+
+func (tcuo *TaskCommentUpdateOne) sqlSave(ctx context.Context) (_node *TaskComment, err error) {
+	if err := tcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskcomment.Table, taskcomment.Columns, sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt))
+	id, ok := tcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskComment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskcomment.FieldID)
+		for _, f := range fields {
+			if !taskcomment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcuo.mutation.Content(); ok {
+		_spec.SetField(taskcomment.FieldContent, field.TypeString, value)
+	}
+	if value, ok := tcuo.mutation.EditedAt(); ok {
+		_spec.SetField(taskcomment.FieldEditedAt, field.TypeTime, value)
+	}
+	if tcuo.mutation.EditedAtCleared() {
+		_spec.ClearField(taskcomment.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := tcuo.mutation.DeletedAt(); ok {
+		_spec.SetField(taskcomment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tcuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskcomment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tcuo.mutation.CreatedAt(); ok {
+		_spec.SetField(taskcomment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskcomment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tcuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.TaskTable,
+			Columns: []string{taskcomment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.TaskTable,
+			Columns: []string{taskcomment.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.AuthorTable,
+			Columns: []string{taskcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.AuthorTable,
+			Columns: []string{taskcomment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcuo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RepliesTable,
+			Columns: []string{taskcomment.RepliesColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !tcuo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RepliesTable,
+			Columns: []string{taskcomment.RepliesColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RepliesTable,
+			Columns: []string{taskcomment.RepliesColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.ParentTable,
+			Columns: []string{taskcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcomment.ParentTable,
+			Columns: []string{taskcomment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcuo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   taskcomment.MentionsTable,
+			Columns: taskcomment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !tcuo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   taskcomment.MentionsTable,
+			Columns: taskcomment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   taskcomment.MentionsTable,
+			Columns: taskcomment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RevisionsTable,
+			Columns: []string{taskcomment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !tcuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RevisionsTable,
+			Columns: []string{taskcomment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskcomment.RevisionsTable,
+			Columns: []string{taskcomment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskComment{config: tcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+)
+
+// TaskCommentRevision is the model entity for the TaskCommentRevision schema.
+type TaskCommentRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CommentID holds the value of the "comment_id" field.
+	CommentID int `json:"comment_id"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content"`
+	// EditorID holds the value of the "editor_id" field.
+	EditorID int `json:"editor_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskCommentRevisionQuery when eager-loading is set.
+	Edges        TaskCommentRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskCommentRevisionEdges holds the relations/edges for other nodes in the graph.
+type TaskCommentRevisionEdges struct {
+	// Comment holds the value of the comment edge.
+	Comment *TaskComment `json:"comment"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CommentOrErr returns the Comment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskCommentRevisionEdges) CommentOrErr() (*TaskComment, error) {
+	if e.Comment != nil {
+		return e.Comment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: taskcomment.Label}
+	}
+	return nil, &NotLoadedError{edge: "comment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskCommentRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskcommentrevision.FieldID, taskcommentrevision.FieldCommentID, taskcommentrevision.FieldEditorID:
+			values[i] = new(sql.NullInt64)
+		case taskcommentrevision.FieldContent:
+			values[i] = new(sql.NullString)
+		case taskcommentrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskCommentRevision fields.
+func (tcr *TaskCommentRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskcommentrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tcr.ID = int(value.Int64)
+		case taskcommentrevision.FieldCommentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_id", values[i])
+			} else if value.Valid {
+				tcr.CommentID = int(value.Int64)
+			}
+		case taskcommentrevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				tcr.Content = value.String
+			}
+		case taskcommentrevision.FieldEditorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field editor_id", values[i])
+			} else if value.Valid {
+				tcr.EditorID = int(value.Int64)
+			}
+		case taskcommentrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tcr.CreatedAt = value.Time
+			}
+		default:
+			tcr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskCommentRevision.
+// This includes values selected through modifiers, order, etc.
+func (tcr *TaskCommentRevision) Value(name string) (ent.Value, error) {
+	return tcr.selectValues.Get(name)
+}
+
+// QueryComment queries the "comment" edge of the TaskCommentRevision entity.
+func (tcr *TaskCommentRevision) QueryComment() *TaskCommentQuery {
+	return NewTaskCommentRevisionClient(tcr.config).QueryComment(tcr)
+}
+
+// Update returns a builder for updating this TaskCommentRevision.
+// Note that you need to call TaskCommentRevision.Unwrap() before calling this method if this TaskCommentRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tcr *TaskCommentRevision) Update() *TaskCommentRevisionUpdateOne {
+	return NewTaskCommentRevisionClient(tcr.config).UpdateOne(tcr)
+}
+
+// Unwrap unwraps the TaskCommentRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tcr *TaskCommentRevision) Unwrap() *TaskCommentRevision {
+	_tx, ok := tcr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskCommentRevision is not a transactional entity")
+	}
+	tcr.config.driver = _tx.drv
+	return tcr
+}
+
+// String implements the fmt.Stringer.
+func (tcr *TaskCommentRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskCommentRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tcr.ID))
+	builder.WriteString("comment_id=")
+	builder.WriteString(fmt.Sprintf("%v", tcr.CommentID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(tcr.Content)
+	builder.WriteString(", ")
+	builder.WriteString("editor_id=")
+	builder.WriteString(fmt.Sprintf("%v", tcr.EditorID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tcr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskCommentRevisions is a parsable slice of TaskCommentRevision.
+type TaskCommentRevisions []*TaskCommentRevision
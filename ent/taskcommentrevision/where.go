@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskcommentrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldLTE(FieldID, id))
+}
+
+// CommentID applies equality check predicate on the "comment_id" field. It's identical to CommentIDEQ.
+func CommentID(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldCommentID, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// EditorID applies equality check predicate on the "editor_id" field. It's identical to EditorIDEQ.
+func EditorID(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldEditorID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CommentIDEQ applies the EQ predicate on the "comment_id" field.
+func CommentIDEQ(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldCommentID, v))
+}
+
+// CommentIDNEQ applies the NEQ predicate on the "comment_id" field.
+func CommentIDNEQ(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNEQ(FieldCommentID, v))
+}
+
+// CommentIDIn applies the In predicate on the "comment_id" field.
+func CommentIDIn(vs ...int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldIn(FieldCommentID, vs...))
+}
+
+// CommentIDNotIn applies the NotIn predicate on the "comment_id" field.
+func CommentIDNotIn(vs ...int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNotIn(FieldCommentID, vs...))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldContainsFold(FieldContent, v))
+}
+
+// EditorIDEQ applies the EQ predicate on the "editor_id" field.
+func EditorIDEQ(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldEditorID, v))
+}
+
+// EditorIDNEQ applies the NEQ predicate on the "editor_id" field.
+func EditorIDNEQ(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNEQ(FieldEditorID, v))
+}
+
+// EditorIDIn applies the In predicate on the "editor_id" field.
+func EditorIDIn(vs ...int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldIn(FieldEditorID, vs...))
+}
+
+// EditorIDNotIn applies the NotIn predicate on the "editor_id" field.
+func EditorIDNotIn(vs ...int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNotIn(FieldEditorID, vs...))
+}
+
+// EditorIDGT applies the GT predicate on the "editor_id" field.
+func EditorIDGT(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldGT(FieldEditorID, v))
+}
+
+// EditorIDGTE applies the GTE predicate on the "editor_id" field.
+func EditorIDGTE(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldGTE(FieldEditorID, v))
+}
+
+// EditorIDLT applies the LT predicate on the "editor_id" field.
+func EditorIDLT(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldLT(FieldEditorID, v))
+}
+
+// EditorIDLTE applies the LTE predicate on the "editor_id" field.
+func EditorIDLTE(v int) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldLTE(FieldEditorID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasComment applies the HasEdge predicate on the "comment" edge.
+func HasComment() predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CommentTable, CommentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentWith applies the HasEdge predicate on the "comment" edge with a given conditions (other predicates).
+func HasCommentWith(preds ...predicate.TaskComment) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(func(s *sql.Selector) {
+		step := newCommentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskCommentRevision) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskCommentRevision) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskCommentRevision) predicate.TaskCommentRevision {
+	return predicate.TaskCommentRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+)
+
+// TaskCommentRevisionCreate is the builder for creating a TaskCommentRevision entity.
+type TaskCommentRevisionCreate struct {
+	config
+	mutation *TaskCommentRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCommentID sets the "comment_id" field.
+func (tcrc *TaskCommentRevisionCreate) SetCommentID(i int) *TaskCommentRevisionCreate {
+	tcrc.mutation.SetCommentID(i)
+	return tcrc
+}
+
+// SetContent sets the "content" field.
+func (tcrc *TaskCommentRevisionCreate) SetContent(s string) *TaskCommentRevisionCreate {
+	tcrc.mutation.SetContent(s)
+	return tcrc
+}
+
+// SetEditorID sets the "editor_id" field.
+func (tcrc *TaskCommentRevisionCreate) SetEditorID(i int) *TaskCommentRevisionCreate {
+	tcrc.mutation.SetEditorID(i)
+	return tcrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcrc *TaskCommentRevisionCreate) SetCreatedAt(t time.Time) *TaskCommentRevisionCreate {
+	tcrc.mutation.SetCreatedAt(t)
+	return tcrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcrc *TaskCommentRevisionCreate) SetNillableCreatedAt(t *time.Time) *TaskCommentRevisionCreate {
+	if t != nil {
+		tcrc.SetCreatedAt(*t)
+	}
+	return tcrc
+}
+
+// SetComment sets the "comment" edge to the TaskComment entity.
+func (tcrc *TaskCommentRevisionCreate) SetComment(t *TaskComment) *TaskCommentRevisionCreate {
+	return tcrc.SetCommentID(t.ID)
+}
+
+// Mutation returns the TaskCommentRevisionMutation object of the builder.
+func (tcrc *TaskCommentRevisionCreate) Mutation() *TaskCommentRevisionMutation {
+	return tcrc.mutation
+}
+
+// Save creates the TaskCommentRevision in the database.
+func (tcrc *TaskCommentRevisionCreate) Save(ctx context.Context) (*TaskCommentRevision, error) {
+	tcrc.defaults()
+	return withHooks(ctx, tcrc.sqlSave, tcrc.mutation, tcrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tcrc *TaskCommentRevisionCreate) SaveX(ctx context.Context) *TaskCommentRevision {
+	v, err := tcrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcrc *TaskCommentRevisionCreate) Exec(ctx context.Context) error {
+	_, err := tcrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcrc *TaskCommentRevisionCreate) ExecX(ctx context.Context) {
+	if err := tcrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcrc *TaskCommentRevisionCreate) defaults() {
+	if _, ok := tcrc.mutation.CreatedAt(); !ok {
+		v := taskcommentrevision.DefaultCreatedAt()
+		tcrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcrc *TaskCommentRevisionCreate) check() error {
+	if _, ok := tcrc.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment_id", err: errors.New(`ent: missing required field "TaskCommentRevision.comment_id"`)}
+	}
+	if _, ok := tcrc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "TaskCommentRevision.content"`)}
+	}
+	if _, ok := tcrc.mutation.EditorID(); !ok {
+		return &ValidationError{Name: "editor_id", err: errors.New(`ent: missing required field "TaskCommentRevision.editor_id"`)}
+	}
+	if _, ok := tcrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskCommentRevision.created_at"`)}
+	}
+	if len(tcrc.mutation.CommentIDs()) == 0 {
+		return &ValidationError{Name: "comment", err: errors.New(`ent: missing required edge "TaskCommentRevision.comment"`)}
+	}
+	return nil
+}
+
+func (tcrc *TaskCommentRevisionCreate) sqlSave(ctx context.Context) (*TaskCommentRevision, error) {
+	if err := tcrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tcrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tcrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tcrc.mutation.id = &_node.ID
+	tcrc.mutation.done = true
+	return _node, nil
+}
+
+func (tcrc *TaskCommentRevisionCreate) createSpec() (*TaskCommentRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskCommentRevision{config: tcrc.config}
+		_spec = sqlgraph.NewCreateSpec(taskcommentrevision.Table, sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tcrc.conflict
+	if value, ok := tcrc.mutation.Content(); ok {
+		_spec.SetField(taskcommentrevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := tcrc.mutation.EditorID(); ok {
+		_spec.SetField(taskcommentrevision.FieldEditorID, field.TypeInt, value)
+		_node.EditorID = value
+	}
+	if value, ok := tcrc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskcommentrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tcrc.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcommentrevision.CommentTable,
+			Columns: []string{taskcommentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CommentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskCommentRevision.Create().
+//		SetCommentID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskCommentRevisionUpsert) {
+//			SetCommentID(v+v).
+//		}).
+//		Exec(ctx)
+func (tcrc *TaskCommentRevisionCreate) OnConflict(opts ...sql.ConflictOption) *TaskCommentRevisionUpsertOne {
+	tcrc.conflict = opts
+	return &TaskCommentRevisionUpsertOne{
+		create: tcrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskCommentRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcrc *TaskCommentRevisionCreate) OnConflictColumns(columns ...string) *TaskCommentRevisionUpsertOne {
+	tcrc.conflict = append(tcrc.conflict, sql.ConflictColumns(columns...))
+	return &TaskCommentRevisionUpsertOne{
+		create: tcrc,
+	}
+}
+
+type (
+	// TaskCommentRevisionUpsertOne is the builder for "upsert"-ing
+	//  one TaskCommentRevision node.
+	TaskCommentRevisionUpsertOne struct {
+		create *TaskCommentRevisionCreate
+	}
+
+	// TaskCommentRevisionUpsert is the "OnConflict" setter.
+	TaskCommentRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCommentID sets the "comment_id" field.
+func (u *TaskCommentRevisionUpsert) SetCommentID(v int) *TaskCommentRevisionUpsert {
+	u.Set(taskcommentrevision.FieldCommentID, v)
+	return u
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsert) UpdateCommentID() *TaskCommentRevisionUpsert {
+	u.SetExcluded(taskcommentrevision.FieldCommentID)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *TaskCommentRevisionUpsert) SetContent(v string) *TaskCommentRevisionUpsert {
+	u.Set(taskcommentrevision.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsert) UpdateContent() *TaskCommentRevisionUpsert {
+	u.SetExcluded(taskcommentrevision.FieldContent)
+	return u
+}
+
+// SetEditorID sets the "editor_id" field.
+func (u *TaskCommentRevisionUpsert) SetEditorID(v int) *TaskCommentRevisionUpsert {
+	u.Set(taskcommentrevision.FieldEditorID, v)
+	return u
+}
+
+// UpdateEditorID sets the "editor_id" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsert) UpdateEditorID() *TaskCommentRevisionUpsert {
+	u.SetExcluded(taskcommentrevision.FieldEditorID)
+	return u
+}
+
+// AddEditorID adds v to the "editor_id" field.
+func (u *TaskCommentRevisionUpsert) AddEditorID(v int) *TaskCommentRevisionUpsert {
+	u.Add(taskcommentrevision.FieldEditorID, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskCommentRevisionUpsert) SetCreatedAt(v time.Time) *TaskCommentRevisionUpsert {
+	u.Set(taskcommentrevision.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsert) UpdateCreatedAt() *TaskCommentRevisionUpsert {
+	u.SetExcluded(taskcommentrevision.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskCommentRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskCommentRevisionUpsertOne) UpdateNewValues() *TaskCommentRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskCommentRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskCommentRevisionUpsertOne) Ignore() *TaskCommentRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskCommentRevisionUpsertOne) DoNothing() *TaskCommentRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskCommentRevisionCreate.OnConflict
+// documentation for more info.
+func (u *TaskCommentRevisionUpsertOne) Update(set func(*TaskCommentRevisionUpsert)) *TaskCommentRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskCommentRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *TaskCommentRevisionUpsertOne) SetCommentID(v int) *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.SetCommentID(v)
+	})
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsertOne) UpdateCommentID() *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.UpdateCommentID()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *TaskCommentRevisionUpsertOne) SetContent(v string) *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsertOne) UpdateContent() *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetEditorID sets the "editor_id" field.
+func (u *TaskCommentRevisionUpsertOne) SetEditorID(v int) *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.SetEditorID(v)
+	})
+}
+
+// AddEditorID adds v to the "editor_id" field.
+func (u *TaskCommentRevisionUpsertOne) AddEditorID(v int) *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.AddEditorID(v)
+	})
+}
+
+// UpdateEditorID sets the "editor_id" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsertOne) UpdateEditorID() *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.UpdateEditorID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskCommentRevisionUpsertOne) SetCreatedAt(v time.Time) *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsertOne) UpdateCreatedAt() *TaskCommentRevisionUpsertOne {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskCommentRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskCommentRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskCommentRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskCommentRevisionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskCommentRevisionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskCommentRevisionCreateBulk is the builder for creating many TaskCommentRevision entities in bulk.
+type TaskCommentRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*TaskCommentRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskCommentRevision entities in the database.
+func (tcrcb *TaskCommentRevisionCreateBulk) Save(ctx context.Context) ([]*TaskCommentRevision, error) {
+	if tcrcb.err != nil {
+		return nil, tcrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcrcb.builders))
+	nodes := make([]*TaskCommentRevision, len(tcrcb.builders))
+	mutators := make([]Mutator, len(tcrcb.builders))
+	for i := range tcrcb.builders {
+		func(i int, root context.Context) {
+			builder := tcrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskCommentRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcrcb *TaskCommentRevisionCreateBulk) SaveX(ctx context.Context) []*TaskCommentRevision {
+	v, err := tcrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcrcb *TaskCommentRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcrcb *TaskCommentRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := tcrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskCommentRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskCommentRevisionUpsert) {
+//			SetCommentID(v+v).
+//		}).
+//		Exec(ctx)
+func (tcrcb *TaskCommentRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskCommentRevisionUpsertBulk {
+	tcrcb.conflict = opts
+	return &TaskCommentRevisionUpsertBulk{
+		create: tcrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskCommentRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcrcb *TaskCommentRevisionCreateBulk) OnConflictColumns(columns ...string) *TaskCommentRevisionUpsertBulk {
+	tcrcb.conflict = append(tcrcb.conflict, sql.ConflictColumns(columns...))
+	return &TaskCommentRevisionUpsertBulk{
+		create: tcrcb,
+	}
+}
+
+// TaskCommentRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskCommentRevision nodes.
+type TaskCommentRevisionUpsertBulk struct {
+	create *TaskCommentRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskCommentRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskCommentRevisionUpsertBulk) UpdateNewValues() *TaskCommentRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskCommentRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskCommentRevisionUpsertBulk) Ignore() *TaskCommentRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskCommentRevisionUpsertBulk) DoNothing() *TaskCommentRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskCommentRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskCommentRevisionUpsertBulk) Update(set func(*TaskCommentRevisionUpsert)) *TaskCommentRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskCommentRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *TaskCommentRevisionUpsertBulk) SetCommentID(v int) *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.SetCommentID(v)
+	})
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsertBulk) UpdateCommentID() *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.UpdateCommentID()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *TaskCommentRevisionUpsertBulk) SetContent(v string) *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsertBulk) UpdateContent() *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetEditorID sets the "editor_id" field.
+func (u *TaskCommentRevisionUpsertBulk) SetEditorID(v int) *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.SetEditorID(v)
+	})
+}
+
+// AddEditorID adds v to the "editor_id" field.
+func (u *TaskCommentRevisionUpsertBulk) AddEditorID(v int) *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.AddEditorID(v)
+	})
+}
+
+// UpdateEditorID sets the "editor_id" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsertBulk) UpdateEditorID() *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.UpdateEditorID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskCommentRevisionUpsertBulk) SetCreatedAt(v time.Time) *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskCommentRevisionUpsertBulk) UpdateCreatedAt() *TaskCommentRevisionUpsertBulk {
+	return u.Update(func(s *TaskCommentRevisionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskCommentRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskCommentRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskCommentRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskCommentRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+)
+
+// TaskCommentRevisionDelete is the builder for deleting a TaskCommentRevision entity.
+type TaskCommentRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskCommentRevisionMutation
+}
+
+// Where appends a list predicates to the TaskCommentRevisionDelete builder.
+func (tcrd *TaskCommentRevisionDelete) Where(ps ...predicate.TaskCommentRevision) *TaskCommentRevisionDelete {
+	tcrd.mutation.Where(ps...)
+	return tcrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tcrd *TaskCommentRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcrd.sqlExec, tcrd.mutation, tcrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcrd *TaskCommentRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := tcrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tcrd *TaskCommentRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskcommentrevision.Table, sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt))
+	if ps := tcrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tcrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tcrd.mutation.done = true
+	return affected, err
+}
+
+// TaskCommentRevisionDeleteOne is the builder for deleting a single TaskCommentRevision entity.
+type TaskCommentRevisionDeleteOne struct {
+	tcrd *TaskCommentRevisionDelete
+}
+
+// Where appends a list predicates to the TaskCommentRevisionDelete builder.
+func (tcrdo *TaskCommentRevisionDeleteOne) Where(ps ...predicate.TaskCommentRevision) *TaskCommentRevisionDeleteOne {
+	tcrdo.tcrd.mutation.Where(ps...)
+	return tcrdo
+}
+
+// Exec executes the deletion query.
+func (tcrdo *TaskCommentRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := tcrdo.tcrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskcommentrevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcrdo *TaskCommentRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := tcrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+)
+
+// TaskCommentRevisionQuery is the builder for querying TaskCommentRevision entities.
+type TaskCommentRevisionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []taskcommentrevision.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.TaskCommentRevision
+	withComment *TaskCommentQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskCommentRevisionQuery builder.
+func (tcrq *TaskCommentRevisionQuery) Where(ps ...predicate.TaskCommentRevision) *TaskCommentRevisionQuery {
+	tcrq.predicates = append(tcrq.predicates, ps...)
+	return tcrq
+}
+
+// Limit the number of records to be returned by this query.
+func (tcrq *TaskCommentRevisionQuery) Limit(limit int) *TaskCommentRevisionQuery {
+	tcrq.ctx.Limit = &limit
+	return tcrq
+}
+
+// Offset to start from.
+func (tcrq *TaskCommentRevisionQuery) Offset(offset int) *TaskCommentRevisionQuery {
+	tcrq.ctx.Offset = &offset
+	return tcrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tcrq *TaskCommentRevisionQuery) Unique(unique bool) *TaskCommentRevisionQuery {
+	tcrq.ctx.Unique = &unique
+	return tcrq
+}
+
+// Order specifies how the records should be ordered.
+func (tcrq *TaskCommentRevisionQuery) Order(o ...taskcommentrevision.OrderOption) *TaskCommentRevisionQuery {
+	tcrq.order = append(tcrq.order, o...)
+	return tcrq
+}
+
+// QueryComment chains the current query on the "comment" edge.
+func (tcrq *TaskCommentRevisionQuery) QueryComment() *TaskCommentQuery {
+	query := (&TaskCommentClient{config: tcrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskcommentrevision.Table, taskcommentrevision.FieldID, selector),
+			sqlgraph.To(taskcomment.Table, taskcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskcommentrevision.CommentTable, taskcommentrevision.CommentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskCommentRevision entity from the query.
+// Returns a *NotFoundError when no TaskCommentRevision was found.
+func (tcrq *TaskCommentRevisionQuery) First(ctx context.Context) (*TaskCommentRevision, error) {
+	nodes, err := tcrq.Limit(1).All(setContextOp(ctx, tcrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskcommentrevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tcrq *TaskCommentRevisionQuery) FirstX(ctx context.Context) *TaskCommentRevision {
+	node, err := tcrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskCommentRevision ID from the query.
+// Returns a *NotFoundError when no TaskCommentRevision ID was found.
+func (tcrq *TaskCommentRevisionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tcrq.Limit(1).IDs(setContextOp(ctx, tcrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskcommentrevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tcrq *TaskCommentRevisionQuery) FirstIDX(ctx context.Context) int {
+	id, err := tcrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskCommentRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskCommentRevision entity is found.
+// Returns a *NotFoundError when no TaskCommentRevision entities are found.
+func (tcrq *TaskCommentRevisionQuery) Only(ctx context.Context) (*TaskCommentRevision, error) {
+	nodes, err := tcrq.Limit(2).All(setContextOp(ctx, tcrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskcommentrevision.Label}
+	default:
+		return nil, &NotSingularError{taskcommentrevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tcrq *TaskCommentRevisionQuery) OnlyX(ctx context.Context) *TaskCommentRevision {
+	node, err := tcrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskCommentRevision ID in the query.
+// Returns a *NotSingularError when more than one TaskCommentRevision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tcrq *TaskCommentRevisionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tcrq.Limit(2).IDs(setContextOp(ctx, tcrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskcommentrevision.Label}
+	default:
+		err = &NotSingularError{taskcommentrevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tcrq *TaskCommentRevisionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tcrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskCommentRevisions.
+func (tcrq *TaskCommentRevisionQuery) All(ctx context.Context) ([]*TaskCommentRevision, error) {
+	ctx = setContextOp(ctx, tcrq.ctx, ent.OpQueryAll)
+	if err := tcrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskCommentRevision, *TaskCommentRevisionQuery]()
+	return withInterceptors[[]*TaskCommentRevision](ctx, tcrq, qr, tcrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tcrq *TaskCommentRevisionQuery) AllX(ctx context.Context) []*TaskCommentRevision {
+	nodes, err := tcrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskCommentRevision IDs.
+func (tcrq *TaskCommentRevisionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tcrq.ctx.Unique == nil && tcrq.path != nil {
+		tcrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tcrq.ctx, ent.OpQueryIDs)
+	if err = tcrq.Select(taskcommentrevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tcrq *TaskCommentRevisionQuery) IDsX(ctx context.Context) []int {
+	ids, err := tcrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tcrq *TaskCommentRevisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tcrq.ctx, ent.OpQueryCount)
+	if err := tcrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tcrq, querierCount[*TaskCommentRevisionQuery](), tcrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tcrq *TaskCommentRevisionQuery) CountX(ctx context.Context) int {
+	count, err := tcrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tcrq *TaskCommentRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tcrq.ctx, ent.OpQueryExist)
+	switch _, err := tcrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tcrq *TaskCommentRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := tcrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskCommentRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tcrq *TaskCommentRevisionQuery) Clone() *TaskCommentRevisionQuery {
+	if tcrq == nil {
+		return nil
+	}
+	return &TaskCommentRevisionQuery{
+		config:      tcrq.config,
+		ctx:         tcrq.ctx.Clone(),
+		order:       append([]taskcommentrevision.OrderOption{}, tcrq.order...),
+		inters:      append([]Interceptor{}, tcrq.inters...),
+		predicates:  append([]predicate.TaskCommentRevision{}, tcrq.predicates...),
+		withComment: tcrq.withComment.Clone(),
+		// clone intermediate query.
+		sql:  tcrq.sql.Clone(),
+		path: tcrq.path,
+	}
+}
+
+// WithComment tells the query-builder to eager-load the nodes that are connected to
+// the "comment" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcrq *TaskCommentRevisionQuery) WithComment(opts ...func(*TaskCommentQuery)) *TaskCommentRevisionQuery {
+	query := (&TaskCommentClient{config: tcrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcrq.withComment = query
+	return tcrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CommentID int `json:"comment_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskCommentRevision.Query().
+//		GroupBy(taskcommentrevision.FieldCommentID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tcrq *TaskCommentRevisionQuery) GroupBy(field string, fields ...string) *TaskCommentRevisionGroupBy {
+	tcrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskCommentRevisionGroupBy{build: tcrq}
+	grbuild.flds = &tcrq.ctx.Fields
+	grbuild.label = taskcommentrevision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CommentID int `json:"comment_id"`
+//	}
+//
+//	client.TaskCommentRevision.Query().
+//		Select(taskcommentrevision.FieldCommentID).
+//		Scan(ctx, &v)
+func (tcrq *TaskCommentRevisionQuery) Select(fields ...string) *TaskCommentRevisionSelect {
+	tcrq.ctx.Fields = append(tcrq.ctx.Fields, fields...)
+	sbuild := &TaskCommentRevisionSelect{TaskCommentRevisionQuery: tcrq}
+	sbuild.label = taskcommentrevision.Label
+	sbuild.flds, sbuild.scan = &tcrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskCommentRevisionSelect configured with the given aggregations.
+func (tcrq *TaskCommentRevisionQuery) Aggregate(fns ...AggregateFunc) *TaskCommentRevisionSelect {
+	return tcrq.Select().Aggregate(fns...)
+}
+
+func (tcrq *TaskCommentRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tcrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tcrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tcrq.ctx.Fields {
+		if !taskcommentrevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tcrq.path != nil {
+		prev, err := tcrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tcrq.sql = prev
+	}
+	return nil
+}
+
+func (tcrq *TaskCommentRevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskCommentRevision, error) {
+	var (
+		nodes       = []*TaskCommentRevision{}
+		_spec       = tcrq.querySpec()
+		loadedTypes = [1]bool{
+			tcrq.withComment != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskCommentRevision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskCommentRevision{config: tcrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tcrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tcrq.withComment; query != nil {
+		if err := tcrq.loadComment(ctx, query, nodes, nil,
+			func(n *TaskCommentRevision, e *TaskComment) { n.Edges.Comment = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tcrq *TaskCommentRevisionQuery) loadComment(ctx context.Context, query *TaskCommentQuery, nodes []*TaskCommentRevision, init func(*TaskCommentRevision), assign func(*TaskCommentRevision, *TaskComment)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskCommentRevision)
+	for i := range nodes {
+		fk := nodes[i].CommentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(taskcomment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "comment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tcrq *TaskCommentRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tcrq.querySpec()
+	_spec.Node.Columns = tcrq.ctx.Fields
+	if len(tcrq.ctx.Fields) > 0 {
+		_spec.Unique = tcrq.ctx.Unique != nil && *tcrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tcrq.driver, _spec)
+}
+
+func (tcrq *TaskCommentRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskcommentrevision.Table, taskcommentrevision.Columns, sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt))
+	_spec.From = tcrq.sql
+	if unique := tcrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tcrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tcrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskcommentrevision.FieldID)
+		for i := range fields {
+			if fields[i] != taskcommentrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tcrq.withComment != nil {
+			_spec.Node.AddColumnOnce(taskcommentrevision.FieldCommentID)
+		}
+	}
+	if ps := tcrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tcrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tcrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tcrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tcrq *TaskCommentRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tcrq.driver.Dialect())
+	t1 := builder.Table(taskcommentrevision.Table)
+	columns := tcrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskcommentrevision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tcrq.sql != nil {
+		selector = tcrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tcrq.ctx.Unique != nil && *tcrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tcrq.predicates {
+		p(selector)
+	}
+	for _, p := range tcrq.order {
+		p(selector)
+	}
+	if offset := tcrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tcrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaskCommentRevisionGroupBy is the group-by builder for TaskCommentRevision entities.
+type TaskCommentRevisionGroupBy struct {
+	selector
+	build *TaskCommentRevisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tcrgb *TaskCommentRevisionGroupBy) Aggregate(fns ...AggregateFunc) *TaskCommentRevisionGroupBy {
+	tcrgb.fns = append(tcrgb.fns, fns...)
+	return tcrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcrgb *TaskCommentRevisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tcrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskCommentRevisionQuery, *TaskCommentRevisionGroupBy](ctx, tcrgb.build, tcrgb, tcrgb.build.inters, v)
+}
+
+func (tcrgb *TaskCommentRevisionGroupBy) sqlScan(ctx context.Context, root *TaskCommentRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tcrgb.fns))
+	for _, fn := range tcrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tcrgb.flds)+len(tcrgb.fns))
+		for _, f := range *tcrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tcrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskCommentRevisionSelect is the builder for selecting fields of TaskCommentRevision entities.
+type TaskCommentRevisionSelect struct {
+	*TaskCommentRevisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tcrs *TaskCommentRevisionSelect) Aggregate(fns ...AggregateFunc) *TaskCommentRevisionSelect {
+	tcrs.fns = append(tcrs.fns, fns...)
+	return tcrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcrs *TaskCommentRevisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcrs.ctx, ent.OpQuerySelect)
+	if err := tcrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskCommentRevisionQuery, *TaskCommentRevisionSelect](ctx, tcrs.TaskCommentRevisionQuery, tcrs, tcrs.inters, v)
+}
+
+func (tcrs *TaskCommentRevisionSelect) sqlScan(ctx context.Context, root *TaskCommentRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tcrs.fns))
+	for _, fn := range tcrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tcrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
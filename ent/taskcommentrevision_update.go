@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+)
+
+// TaskCommentRevisionUpdate is the builder for updating TaskCommentRevision entities.
+type TaskCommentRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskCommentRevisionMutation
+}
+
+// Where appends a list predicates to the TaskCommentRevisionUpdate builder.
+func (tcru *TaskCommentRevisionUpdate) Where(ps ...predicate.TaskCommentRevision) *TaskCommentRevisionUpdate {
+	tcru.mutation.Where(ps...)
+	return tcru
+}
+
+// SetCommentID sets the "comment_id" field.
+func (tcru *TaskCommentRevisionUpdate) SetCommentID(i int) *TaskCommentRevisionUpdate {
+	tcru.mutation.SetCommentID(i)
+	return tcru
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (tcru *TaskCommentRevisionUpdate) SetNillableCommentID(i *int) *TaskCommentRevisionUpdate {
+	if i != nil {
+		tcru.SetCommentID(*i)
+	}
+	return tcru
+}
+
+// SetContent sets the "content" field.
+func (tcru *TaskCommentRevisionUpdate) SetContent(s string) *TaskCommentRevisionUpdate {
+	tcru.mutation.SetContent(s)
+	return tcru
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (tcru *TaskCommentRevisionUpdate) SetNillableContent(s *string) *TaskCommentRevisionUpdate {
+	if s != nil {
+		tcru.SetContent(*s)
+	}
+	return tcru
+}
+
+// SetEditorID sets the "editor_id" field.
+func (tcru *TaskCommentRevisionUpdate) SetEditorID(i int) *TaskCommentRevisionUpdate {
+	tcru.mutation.ResetEditorID()
+	tcru.mutation.SetEditorID(i)
+	return tcru
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (tcru *TaskCommentRevisionUpdate) SetNillableEditorID(i *int) *TaskCommentRevisionUpdate {
+	if i != nil {
+		tcru.SetEditorID(*i)
+	}
+	return tcru
+}
+
+// AddEditorID adds i to the "editor_id" field.
+func (tcru *TaskCommentRevisionUpdate) AddEditorID(i int) *TaskCommentRevisionUpdate {
+	tcru.mutation.AddEditorID(i)
+	return tcru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcru *TaskCommentRevisionUpdate) SetCreatedAt(t time.Time) *TaskCommentRevisionUpdate {
+	tcru.mutation.SetCreatedAt(t)
+	return tcru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcru *TaskCommentRevisionUpdate) SetNillableCreatedAt(t *time.Time) *TaskCommentRevisionUpdate {
+	if t != nil {
+		tcru.SetCreatedAt(*t)
+	}
+	return tcru
+}
+
+// SetComment sets the "comment" edge to the TaskComment entity.
+func (tcru *TaskCommentRevisionUpdate) SetComment(t *TaskComment) *TaskCommentRevisionUpdate {
+	return tcru.SetCommentID(t.ID)
+}
+
+// Mutation returns the TaskCommentRevisionMutation object of the builder.
+func (tcru *TaskCommentRevisionUpdate) Mutation() *TaskCommentRevisionMutation {
+	return tcru.mutation
+}
+
+// ClearComment clears the "comment" edge to the TaskComment entity.
+func (tcru *TaskCommentRevisionUpdate) ClearComment() *TaskCommentRevisionUpdate {
+	tcru.mutation.ClearComment()
+	return tcru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tcru *TaskCommentRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcru.sqlSave, tcru.mutation, tcru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcru *TaskCommentRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := tcru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tcru *TaskCommentRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := tcru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcru *TaskCommentRevisionUpdate) ExecX(ctx context.Context) {
+	if err := tcru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcru *TaskCommentRevisionUpdate) check() error {
+	if tcru.mutation.CommentCleared() && len(tcru.mutation.CommentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskCommentRevision.comment"`)
+	}
+	return nil
+}
+
+func (tcru *TaskCommentRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tcru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskcommentrevision.Table, taskcommentrevision.Columns, sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt))
+	if ps := tcru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcru.mutation.Content(); ok {
+		_spec.SetField(taskcommentrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := tcru.mutation.EditorID(); ok {
+		_spec.SetField(taskcommentrevision.FieldEditorID, field.TypeInt, value)
+	}
+	if value, ok := tcru.mutation.AddedEditorID(); ok {
+		_spec.AddField(taskcommentrevision.FieldEditorID, field.TypeInt, value)
+	}
+	if value, ok := tcru.mutation.CreatedAt(); ok {
+		_spec.SetField(taskcommentrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if tcru.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcommentrevision.CommentTable,
+			Columns: []string{taskcommentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcru.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcommentrevision.CommentTable,
+			Columns: []string{taskcommentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tcru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskcommentrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tcru.mutation.done = true
+	return n, nil
+}
+
+// TaskCommentRevisionUpdateOne is the builder for updating a single TaskCommentRevision entity.
+type TaskCommentRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskCommentRevisionMutation
+}
+
+// SetCommentID sets the "comment_id" field.
+func (tcruo *TaskCommentRevisionUpdateOne) SetCommentID(i int) *TaskCommentRevisionUpdateOne {
+	tcruo.mutation.SetCommentID(i)
+	return tcruo
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (tcruo *TaskCommentRevisionUpdateOne) SetNillableCommentID(i *int) *TaskCommentRevisionUpdateOne {
+	if i != nil {
+		tcruo.SetCommentID(*i)
+	}
+	return tcruo
+}
+
+// SetContent sets the "content" field.
+func (tcruo *TaskCommentRevisionUpdateOne) SetContent(s string) *TaskCommentRevisionUpdateOne {
+	tcruo.mutation.SetContent(s)
+	return tcruo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (tcruo *TaskCommentRevisionUpdateOne) SetNillableContent(s *string) *TaskCommentRevisionUpdateOne {
+	if s != nil {
+		tcruo.SetContent(*s)
+	}
+	return tcruo
+}
+
+// SetEditorID sets the "editor_id" field.
+func (tcruo *TaskCommentRevisionUpdateOne) SetEditorID(i int) *TaskCommentRevisionUpdateOne {
+	tcruo.mutation.ResetEditorID()
+	tcruo.mutation.SetEditorID(i)
+	return tcruo
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (tcruo *TaskCommentRevisionUpdateOne) SetNillableEditorID(i *int) *TaskCommentRevisionUpdateOne {
+	if i != nil {
+		tcruo.SetEditorID(*i)
+	}
+	return tcruo
+}
+
+// AddEditorID adds i to the "editor_id" field.
+func (tcruo *TaskCommentRevisionUpdateOne) AddEditorID(i int) *TaskCommentRevisionUpdateOne {
+	tcruo.mutation.AddEditorID(i)
+	return tcruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcruo *TaskCommentRevisionUpdateOne) SetCreatedAt(t time.Time) *TaskCommentRevisionUpdateOne {
+	tcruo.mutation.SetCreatedAt(t)
+	return tcruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcruo *TaskCommentRevisionUpdateOne) SetNillableCreatedAt(t *time.Time) *TaskCommentRevisionUpdateOne {
+	if t != nil {
+		tcruo.SetCreatedAt(*t)
+	}
+	return tcruo
+}
+
+// SetComment sets the "comment" edge to the TaskComment entity.
+func (tcruo *TaskCommentRevisionUpdateOne) SetComment(t *TaskComment) *TaskCommentRevisionUpdateOne {
+	return tcruo.SetCommentID(t.ID)
+}
+
+// Mutation returns the TaskCommentRevisionMutation object of the builder.
+func (tcruo *TaskCommentRevisionUpdateOne) Mutation() *TaskCommentRevisionMutation {
+	return tcruo.mutation
+}
+
+// ClearComment clears the "comment" edge to the TaskComment entity.
+func (tcruo *TaskCommentRevisionUpdateOne) ClearComment() *TaskCommentRevisionUpdateOne {
+	tcruo.mutation.ClearComment()
+	return tcruo
+}
+
+// Where appends a list predicates to the TaskCommentRevisionUpdate builder.
+func (tcruo *TaskCommentRevisionUpdateOne) Where(ps ...predicate.TaskCommentRevision) *TaskCommentRevisionUpdateOne {
+	tcruo.mutation.Where(ps...)
+	return tcruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tcruo *TaskCommentRevisionUpdateOne) Select(field string, fields ...string) *TaskCommentRevisionUpdateOne {
+	tcruo.fields = append([]string{field}, fields...)
+	return tcruo
+}
+
+// Save executes the query and returns the updated TaskCommentRevision entity.
+func (tcruo *TaskCommentRevisionUpdateOne) Save(ctx context.Context) (*TaskCommentRevision, error) {
+	return withHooks(ctx, tcruo.sqlSave, tcruo.mutation, tcruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcruo *TaskCommentRevisionUpdateOne) SaveX(ctx context.Context) *TaskCommentRevision {
+	node, err := tcruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tcruo *TaskCommentRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := tcruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcruo *TaskCommentRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := tcruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcruo *TaskCommentRevisionUpdateOne) check() error {
+	if tcruo.mutation.CommentCleared() && len(tcruo.mutation.CommentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskCommentRevision.comment"`)
+	}
+	return nil
+}
+
+func (tcruo *TaskCommentRevisionUpdateOne) sqlSave(ctx context.Context) (_node *TaskCommentRevision, err error) {
+	if err := tcruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskcommentrevision.Table, taskcommentrevision.Columns, sqlgraph.NewFieldSpec(taskcommentrevision.FieldID, field.TypeInt))
+	id, ok := tcruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskCommentRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tcruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskcommentrevision.FieldID)
+		for _, f := range fields {
+			if !taskcommentrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskcommentrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tcruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcruo.mutation.Content(); ok {
+		_spec.SetField(taskcommentrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := tcruo.mutation.EditorID(); ok {
+		_spec.SetField(taskcommentrevision.FieldEditorID, field.TypeInt, value)
+	}
+	if value, ok := tcruo.mutation.AddedEditorID(); ok {
+		_spec.AddField(taskcommentrevision.FieldEditorID, field.TypeInt, value)
+	}
+	if value, ok := tcruo.mutation.CreatedAt(); ok {
+		_spec.SetField(taskcommentrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if tcruo.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcommentrevision.CommentTable,
+			Columns: []string{taskcommentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcruo.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskcommentrevision.CommentTable,
+			Columns: []string{taskcommentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskCommentRevision{config: tcruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tcruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskcommentrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tcruo.mutation.done = true
+	return _node, nil
+}
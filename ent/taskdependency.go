@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+)
+
+// TaskDependency is the model entity for the TaskDependency schema.
+type TaskDependency struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID int `json:"target_id"`
+	// Type holds the value of the "type" field.
+	Type taskdependency.Type `json:"type"`
+	// CreatorID holds the value of the "creator_id" field.
+	CreatorID int `json:"creator_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskDependencyQuery when eager-loading is set.
+	Edges        TaskDependencyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskDependencyEdges holds the relations/edges for other nodes in the graph.
+type TaskDependencyEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task"`
+	// Target holds the value of the target edge.
+	Target *Task `json:"target"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskDependencyEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// TargetOrErr returns the Target value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskDependencyEdges) TargetOrErr() (*Task, error) {
+	if e.Target != nil {
+		return e.Target, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "target"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskDependency) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskdependency.FieldID, taskdependency.FieldTaskID, taskdependency.FieldTargetID, taskdependency.FieldCreatorID:
+			values[i] = new(sql.NullInt64)
+		case taskdependency.FieldType:
+			values[i] = new(sql.NullString)
+		case taskdependency.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskDependency fields.
+func (td *TaskDependency) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskdependency.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			td.ID = int(value.Int64)
+		case taskdependency.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				td.TaskID = int(value.Int64)
+			}
+		case taskdependency.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				td.TargetID = int(value.Int64)
+			}
+		case taskdependency.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				td.Type = taskdependency.Type(value.String)
+			}
+		case taskdependency.FieldCreatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value.Valid {
+				td.CreatorID = int(value.Int64)
+			}
+		case taskdependency.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				td.CreatedAt = value.Time
+			}
+		default:
+			td.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskDependency.
+// This includes values selected through modifiers, order, etc.
+func (td *TaskDependency) Value(name string) (ent.Value, error) {
+	return td.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskDependency entity.
+func (td *TaskDependency) QueryTask() *TaskQuery {
+	return NewTaskDependencyClient(td.config).QueryTask(td)
+}
+
+// QueryTarget queries the "target" edge of the TaskDependency entity.
+func (td *TaskDependency) QueryTarget() *TaskQuery {
+	return NewTaskDependencyClient(td.config).QueryTarget(td)
+}
+
+// Update returns a builder for updating this TaskDependency.
+// Note that you need to call TaskDependency.Unwrap() before calling this method if this TaskDependency
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (td *TaskDependency) Update() *TaskDependencyUpdateOne {
+	return NewTaskDependencyClient(td.config).UpdateOne(td)
+}
+
+// Unwrap unwraps the TaskDependency entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (td *TaskDependency) Unwrap() *TaskDependency {
+	_tx, ok := td.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskDependency is not a transactional entity")
+	}
+	td.config.driver = _tx.drv
+	return td
+}
+
+// String implements the fmt.Stringer.
+func (td *TaskDependency) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskDependency(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", td.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", td.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", td.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", td.Type))
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", td.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(td.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskDependencies is a parsable slice of TaskDependency.
+type TaskDependencies []*TaskDependency
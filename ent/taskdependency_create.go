@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+)
+
+// TaskDependencyCreate is the builder for creating a TaskDependency entity.
+type TaskDependencyCreate struct {
+	config
+	mutation *TaskDependencyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTaskID sets the "task_id" field.
+func (tdc *TaskDependencyCreate) SetTaskID(i int) *TaskDependencyCreate {
+	tdc.mutation.SetTaskID(i)
+	return tdc
+}
+
+// SetTargetID sets the "target_id" field.
+func (tdc *TaskDependencyCreate) SetTargetID(i int) *TaskDependencyCreate {
+	tdc.mutation.SetTargetID(i)
+	return tdc
+}
+
+// SetType sets the "type" field.
+func (tdc *TaskDependencyCreate) SetType(t taskdependency.Type) *TaskDependencyCreate {
+	tdc.mutation.SetType(t)
+	return tdc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tdc *TaskDependencyCreate) SetNillableType(t *taskdependency.Type) *TaskDependencyCreate {
+	if t != nil {
+		tdc.SetType(*t)
+	}
+	return tdc
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (tdc *TaskDependencyCreate) SetCreatorID(i int) *TaskDependencyCreate {
+	tdc.mutation.SetCreatorID(i)
+	return tdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tdc *TaskDependencyCreate) SetCreatedAt(t time.Time) *TaskDependencyCreate {
+	tdc.mutation.SetCreatedAt(t)
+	return tdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tdc *TaskDependencyCreate) SetNillableCreatedAt(t *time.Time) *TaskDependencyCreate {
+	if t != nil {
+		tdc.SetCreatedAt(*t)
+	}
+	return tdc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tdc *TaskDependencyCreate) SetTask(t *Task) *TaskDependencyCreate {
+	return tdc.SetTaskID(t.ID)
+}
+
+// SetTarget sets the "target" edge to the Task entity.
+func (tdc *TaskDependencyCreate) SetTarget(t *Task) *TaskDependencyCreate {
+	return tdc.SetTargetID(t.ID)
+}
+
+// Mutation returns the TaskDependencyMutation object of the builder.
+func (tdc *TaskDependencyCreate) Mutation() *TaskDependencyMutation {
+	return tdc.mutation
+}
+
+// Save creates the TaskDependency in the database.
+func (tdc *TaskDependencyCreate) Save(ctx context.Context) (*TaskDependency, error) {
+	tdc.defaults()
+	return withHooks(ctx, tdc.sqlSave, tdc.mutation, tdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tdc *TaskDependencyCreate) SaveX(ctx context.Context) *TaskDependency {
+	v, err := tdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tdc *TaskDependencyCreate) Exec(ctx context.Context) error {
+	_, err := tdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdc *TaskDependencyCreate) ExecX(ctx context.Context) {
+	if err := tdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tdc *TaskDependencyCreate) defaults() {
+	if _, ok := tdc.mutation.GetType(); !ok {
+		v := taskdependency.DefaultType
+		tdc.mutation.SetType(v)
+	}
+	if _, ok := tdc.mutation.CreatedAt(); !ok {
+		v := taskdependency.DefaultCreatedAt()
+		tdc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tdc *TaskDependencyCreate) check() error {
+	if _, ok := tdc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskDependency.task_id"`)}
+	}
+	if _, ok := tdc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "TaskDependency.target_id"`)}
+	}
+	if _, ok := tdc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TaskDependency.type"`)}
+	}
+	if v, ok := tdc.mutation.GetType(); ok {
+		if err := taskdependency.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskDependency.type": %w`, err)}
+		}
+	}
+	if _, ok := tdc.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`ent: missing required field "TaskDependency.creator_id"`)}
+	}
+	if _, ok := tdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskDependency.created_at"`)}
+	}
+	if len(tdc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskDependency.task"`)}
+	}
+	if len(tdc.mutation.TargetIDs()) == 0 {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required edge "TaskDependency.target"`)}
+	}
+	return nil
+}
+
+func (tdc *TaskDependencyCreate) sqlSave(ctx context.Context) (*TaskDependency, error) {
+	if err := tdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tdc.mutation.id = &_node.ID
+	tdc.mutation.done = true
+	return _node, nil
+}
+
+func (tdc *TaskDependencyCreate) createSpec() (*TaskDependency, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskDependency{config: tdc.config}
+		_spec = sqlgraph.NewCreateSpec(taskdependency.Table, sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tdc.conflict
+	if value, ok := tdc.mutation.GetType(); ok {
+		_spec.SetField(taskdependency.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := tdc.mutation.CreatorID(); ok {
+		_spec.SetField(taskdependency.FieldCreatorID, field.TypeInt, value)
+		_node.CreatorID = value
+	}
+	if value, ok := tdc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskdependency.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tdc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TaskTable,
+			Columns: []string{taskdependency.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tdc.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TargetTable,
+			Columns: []string{taskdependency.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskDependency.Create().
+//		SetTaskID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskDependencyUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (tdc *TaskDependencyCreate) OnConflict(opts ...sql.ConflictOption) *TaskDependencyUpsertOne {
+	tdc.conflict = opts
+	return &TaskDependencyUpsertOne{
+		create: tdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskDependency.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tdc *TaskDependencyCreate) OnConflictColumns(columns ...string) *TaskDependencyUpsertOne {
+	tdc.conflict = append(tdc.conflict, sql.ConflictColumns(columns...))
+	return &TaskDependencyUpsertOne{
+		create: tdc,
+	}
+}
+
+type (
+	// TaskDependencyUpsertOne is the builder for "upsert"-ing
+	//  one TaskDependency node.
+	TaskDependencyUpsertOne struct {
+		create *TaskDependencyCreate
+	}
+
+	// TaskDependencyUpsert is the "OnConflict" setter.
+	TaskDependencyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskDependencyUpsert) SetTaskID(v int) *TaskDependencyUpsert {
+	u.Set(taskdependency.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsert) UpdateTaskID() *TaskDependencyUpsert {
+	u.SetExcluded(taskdependency.FieldTaskID)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *TaskDependencyUpsert) SetTargetID(v int) *TaskDependencyUpsert {
+	u.Set(taskdependency.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsert) UpdateTargetID() *TaskDependencyUpsert {
+	u.SetExcluded(taskdependency.FieldTargetID)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *TaskDependencyUpsert) SetType(v taskdependency.Type) *TaskDependencyUpsert {
+	u.Set(taskdependency.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TaskDependencyUpsert) UpdateType() *TaskDependencyUpsert {
+	u.SetExcluded(taskdependency.FieldType)
+	return u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskDependencyUpsert) SetCreatorID(v int) *TaskDependencyUpsert {
+	u.Set(taskdependency.FieldCreatorID, v)
+	return u
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsert) UpdateCreatorID() *TaskDependencyUpsert {
+	u.SetExcluded(taskdependency.FieldCreatorID)
+	return u
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskDependencyUpsert) AddCreatorID(v int) *TaskDependencyUpsert {
+	u.Add(taskdependency.FieldCreatorID, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskDependencyUpsert) SetCreatedAt(v time.Time) *TaskDependencyUpsert {
+	u.Set(taskdependency.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskDependencyUpsert) UpdateCreatedAt() *TaskDependencyUpsert {
+	u.SetExcluded(taskdependency.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskDependency.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskDependencyUpsertOne) UpdateNewValues() *TaskDependencyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskDependency.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskDependencyUpsertOne) Ignore() *TaskDependencyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskDependencyUpsertOne) DoNothing() *TaskDependencyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskDependencyCreate.OnConflict
+// documentation for more info.
+func (u *TaskDependencyUpsertOne) Update(set func(*TaskDependencyUpsert)) *TaskDependencyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskDependencyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskDependencyUpsertOne) SetTaskID(v int) *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsertOne) UpdateTaskID() *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *TaskDependencyUpsertOne) SetTargetID(v int) *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsertOne) UpdateTargetID() *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TaskDependencyUpsertOne) SetType(v taskdependency.Type) *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TaskDependencyUpsertOne) UpdateType() *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskDependencyUpsertOne) SetCreatorID(v int) *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskDependencyUpsertOne) AddCreatorID(v int) *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsertOne) UpdateCreatorID() *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskDependencyUpsertOne) SetCreatedAt(v time.Time) *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskDependencyUpsertOne) UpdateCreatedAt() *TaskDependencyUpsertOne {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskDependencyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskDependencyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskDependencyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskDependencyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskDependencyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskDependencyCreateBulk is the builder for creating many TaskDependency entities in bulk.
+type TaskDependencyCreateBulk struct {
+	config
+	err      error
+	builders []*TaskDependencyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskDependency entities in the database.
+func (tdcb *TaskDependencyCreateBulk) Save(ctx context.Context) ([]*TaskDependency, error) {
+	if tdcb.err != nil {
+		return nil, tdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tdcb.builders))
+	nodes := make([]*TaskDependency, len(tdcb.builders))
+	mutators := make([]Mutator, len(tdcb.builders))
+	for i := range tdcb.builders {
+		func(i int, root context.Context) {
+			builder := tdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskDependencyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tdcb *TaskDependencyCreateBulk) SaveX(ctx context.Context) []*TaskDependency {
+	v, err := tdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tdcb *TaskDependencyCreateBulk) Exec(ctx context.Context) error {
+	_, err := tdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdcb *TaskDependencyCreateBulk) ExecX(ctx context.Context) {
+	if err := tdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskDependency.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskDependencyUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (tdcb *TaskDependencyCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskDependencyUpsertBulk {
+	tdcb.conflict = opts
+	return &TaskDependencyUpsertBulk{
+		create: tdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskDependency.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tdcb *TaskDependencyCreateBulk) OnConflictColumns(columns ...string) *TaskDependencyUpsertBulk {
+	tdcb.conflict = append(tdcb.conflict, sql.ConflictColumns(columns...))
+	return &TaskDependencyUpsertBulk{
+		create: tdcb,
+	}
+}
+
+// TaskDependencyUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskDependency nodes.
+type TaskDependencyUpsertBulk struct {
+	create *TaskDependencyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskDependency.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskDependencyUpsertBulk) UpdateNewValues() *TaskDependencyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskDependency.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskDependencyUpsertBulk) Ignore() *TaskDependencyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskDependencyUpsertBulk) DoNothing() *TaskDependencyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskDependencyCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskDependencyUpsertBulk) Update(set func(*TaskDependencyUpsert)) *TaskDependencyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskDependencyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskDependencyUpsertBulk) SetTaskID(v int) *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsertBulk) UpdateTaskID() *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *TaskDependencyUpsertBulk) SetTargetID(v int) *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsertBulk) UpdateTargetID() *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TaskDependencyUpsertBulk) SetType(v taskdependency.Type) *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TaskDependencyUpsertBulk) UpdateType() *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskDependencyUpsertBulk) SetCreatorID(v int) *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskDependencyUpsertBulk) AddCreatorID(v int) *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskDependencyUpsertBulk) UpdateCreatorID() *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TaskDependencyUpsertBulk) SetCreatedAt(v time.Time) *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TaskDependencyUpsertBulk) UpdateCreatedAt() *TaskDependencyUpsertBulk {
+	return u.Update(func(s *TaskDependencyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskDependencyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskDependencyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskDependencyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskDependencyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+)
+
+// TaskDependencyDelete is the builder for deleting a TaskDependency entity.
+type TaskDependencyDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskDependencyMutation
+}
+
+// Where appends a list predicates to the TaskDependencyDelete builder.
+func (tdd *TaskDependencyDelete) Where(ps ...predicate.TaskDependency) *TaskDependencyDelete {
+	tdd.mutation.Where(ps...)
+	return tdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tdd *TaskDependencyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tdd.sqlExec, tdd.mutation, tdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdd *TaskDependencyDelete) ExecX(ctx context.Context) int {
+	n, err := tdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tdd *TaskDependencyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskdependency.Table, sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt))
+	if ps := tdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tdd.mutation.done = true
+	return affected, err
+}
+
+// TaskDependencyDeleteOne is the builder for deleting a single TaskDependency entity.
+type TaskDependencyDeleteOne struct {
+	tdd *TaskDependencyDelete
+}
+
+// Where appends a list predicates to the TaskDependencyDelete builder.
+func (tddo *TaskDependencyDeleteOne) Where(ps ...predicate.TaskDependency) *TaskDependencyDeleteOne {
+	tddo.tdd.mutation.Where(ps...)
+	return tddo
+}
+
+// Exec executes the deletion query.
+func (tddo *TaskDependencyDeleteOne) Exec(ctx context.Context) error {
+	n, err := tddo.tdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskdependency.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tddo *TaskDependencyDeleteOne) ExecX(ctx context.Context) {
+	if err := tddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+)
+
+// TaskDependencyQuery is the builder for querying TaskDependency entities.
+type TaskDependencyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []taskdependency.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaskDependency
+	withTask   *TaskQuery
+	withTarget *TaskQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskDependencyQuery builder.
+func (tdq *TaskDependencyQuery) Where(ps ...predicate.TaskDependency) *TaskDependencyQuery {
+	tdq.predicates = append(tdq.predicates, ps...)
+	return tdq
+}
+
+// Limit the number of records to be returned by this query.
+func (tdq *TaskDependencyQuery) Limit(limit int) *TaskDependencyQuery {
+	tdq.ctx.Limit = &limit
+	return tdq
+}
+
+// Offset to start from.
+func (tdq *TaskDependencyQuery) Offset(offset int) *TaskDependencyQuery {
+	tdq.ctx.Offset = &offset
+	return tdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tdq *TaskDependencyQuery) Unique(unique bool) *TaskDependencyQuery {
+	tdq.ctx.Unique = &unique
+	return tdq
+}
+
+// Order specifies how the records should be ordered.
+func (tdq *TaskDependencyQuery) Order(o ...taskdependency.OrderOption) *TaskDependencyQuery {
+	tdq.order = append(tdq.order, o...)
+	return tdq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (tdq *TaskDependencyQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: tdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskdependency.Table, taskdependency.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskdependency.TaskTable, taskdependency.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTarget chains the current query on the "target" edge.
+func (tdq *TaskDependencyQuery) QueryTarget() *TaskQuery {
+	query := (&TaskClient{config: tdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskdependency.Table, taskdependency.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskdependency.TargetTable, taskdependency.TargetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskDependency entity from the query.
+// Returns a *NotFoundError when no TaskDependency was found.
+func (tdq *TaskDependencyQuery) First(ctx context.Context) (*TaskDependency, error) {
+	nodes, err := tdq.Limit(1).All(setContextOp(ctx, tdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskdependency.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tdq *TaskDependencyQuery) FirstX(ctx context.Context) *TaskDependency {
+	node, err := tdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskDependency ID from the query.
+// Returns a *NotFoundError when no TaskDependency ID was found.
+func (tdq *TaskDependencyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tdq.Limit(1).IDs(setContextOp(ctx, tdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskdependency.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tdq *TaskDependencyQuery) FirstIDX(ctx context.Context) int {
+	id, err := tdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskDependency entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskDependency entity is found.
+// Returns a *NotFoundError when no TaskDependency entities are found.
+func (tdq *TaskDependencyQuery) Only(ctx context.Context) (*TaskDependency, error) {
+	nodes, err := tdq.Limit(2).All(setContextOp(ctx, tdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskdependency.Label}
+	default:
+		return nil, &NotSingularError{taskdependency.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tdq *TaskDependencyQuery) OnlyX(ctx context.Context) *TaskDependency {
+	node, err := tdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskDependency ID in the query.
+// Returns a *NotSingularError when more than one TaskDependency ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tdq *TaskDependencyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tdq.Limit(2).IDs(setContextOp(ctx, tdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskdependency.Label}
+	default:
+		err = &NotSingularError{taskdependency.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tdq *TaskDependencyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskDependencies.
+func (tdq *TaskDependencyQuery) All(ctx context.Context) ([]*TaskDependency, error) {
+	ctx = setContextOp(ctx, tdq.ctx, ent.OpQueryAll)
+	if err := tdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskDependency, *TaskDependencyQuery]()
+	return withInterceptors[[]*TaskDependency](ctx, tdq, qr, tdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tdq *TaskDependencyQuery) AllX(ctx context.Context) []*TaskDependency {
+	nodes, err := tdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskDependency IDs.
+func (tdq *TaskDependencyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tdq.ctx.Unique == nil && tdq.path != nil {
+		tdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tdq.ctx, ent.OpQueryIDs)
+	if err = tdq.Select(taskdependency.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tdq *TaskDependencyQuery) IDsX(ctx context.Context) []int {
+	ids, err := tdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tdq *TaskDependencyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tdq.ctx, ent.OpQueryCount)
+	if err := tdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tdq, querierCount[*TaskDependencyQuery](), tdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tdq *TaskDependencyQuery) CountX(ctx context.Context) int {
+	count, err := tdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tdq *TaskDependencyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tdq.ctx, ent.OpQueryExist)
+	switch _, err := tdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tdq *TaskDependencyQuery) ExistX(ctx context.Context) bool {
+	exist, err := tdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskDependencyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tdq *TaskDependencyQuery) Clone() *TaskDependencyQuery {
+	if tdq == nil {
+		return nil
+	}
+	return &TaskDependencyQuery{
+		config:     tdq.config,
+		ctx:        tdq.ctx.Clone(),
+		order:      append([]taskdependency.OrderOption{}, tdq.order...),
+		inters:     append([]Interceptor{}, tdq.inters...),
+		predicates: append([]predicate.TaskDependency{}, tdq.predicates...),
+		withTask:   tdq.withTask.Clone(),
+		withTarget: tdq.withTarget.Clone(),
+		// clone intermediate query.
+		sql:  tdq.sql.Clone(),
+		path: tdq.path,
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (tdq *TaskDependencyQuery) WithTask(opts ...func(*TaskQuery)) *TaskDependencyQuery {
+	query := (&TaskClient{config: tdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tdq.withTask = query
+	return tdq
+}
+
+// WithTarget tells the query-builder to eager-load the nodes that are connected to
+// the "target" edge. The optional arguments are used to configure the query builder of the edge.
+func (tdq *TaskDependencyQuery) WithTarget(opts ...func(*TaskQuery)) *TaskDependencyQuery {
+	query := (&TaskClient{config: tdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tdq.withTarget = query
+	return tdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID int `json:"task_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskDependency.Query().
+//		GroupBy(taskdependency.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tdq *TaskDependencyQuery) GroupBy(field string, fields ...string) *TaskDependencyGroupBy {
+	tdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskDependencyGroupBy{build: tdq}
+	grbuild.flds = &tdq.ctx.Fields
+	grbuild.label = taskdependency.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID int `json:"task_id"`
+//	}
+//
+//	client.TaskDependency.Query().
+//		Select(taskdependency.FieldTaskID).
+//		Scan(ctx, &v)
+func (tdq *TaskDependencyQuery) Select(fields ...string) *TaskDependencySelect {
+	tdq.ctx.Fields = append(tdq.ctx.Fields, fields...)
+	sbuild := &TaskDependencySelect{TaskDependencyQuery: tdq}
+	sbuild.label = taskdependency.Label
+	sbuild.flds, sbuild.scan = &tdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskDependencySelect configured with the given aggregations.
+func (tdq *TaskDependencyQuery) Aggregate(fns ...AggregateFunc) *TaskDependencySelect {
+	return tdq.Select().Aggregate(fns...)
+}
+
+func (tdq *TaskDependencyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tdq.ctx.Fields {
+		if !taskdependency.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tdq.path != nil {
+		prev, err := tdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tdq.sql = prev
+	}
+	return nil
+}
+
+func (tdq *TaskDependencyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskDependency, error) {
+	var (
+		nodes       = []*TaskDependency{}
+		_spec       = tdq.querySpec()
+		loadedTypes = [2]bool{
+			tdq.withTask != nil,
+			tdq.withTarget != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskDependency).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskDependency{config: tdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tdq.withTask; query != nil {
+		if err := tdq.loadTask(ctx, query, nodes, nil,
+			func(n *TaskDependency, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tdq.withTarget; query != nil {
+		if err := tdq.loadTarget(ctx, query, nodes, nil,
+			func(n *TaskDependency, e *Task) { n.Edges.Target = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tdq *TaskDependencyQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*TaskDependency, init func(*TaskDependency), assign func(*TaskDependency, *Task)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskDependency)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tdq *TaskDependencyQuery) loadTarget(ctx context.Context, query *TaskQuery, nodes []*TaskDependency, init func(*TaskDependency), assign func(*TaskDependency, *Task)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskDependency)
+	for i := range nodes {
+		fk := nodes[i].TargetID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "target_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tdq *TaskDependencyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tdq.querySpec()
+	_spec.Node.Columns = tdq.ctx.Fields
+	if len(tdq.ctx.Fields) > 0 {
+		_spec.Unique = tdq.ctx.Unique != nil && *tdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tdq.driver, _spec)
+}
+
+func (tdq *TaskDependencyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskdependency.Table, taskdependency.Columns, sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt))
+	_spec.From = tdq.sql
+	if unique := tdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskdependency.FieldID)
+		for i := range fields {
+			if fields[i] != taskdependency.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tdq.withTask != nil {
+			_spec.Node.AddColumnOnce(taskdependency.FieldTaskID)
+		}
+		if tdq.withTarget != nil {
+			_spec.Node.AddColumnOnce(taskdependency.FieldTargetID)
+		}
+	}
+	if ps := tdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tdq *TaskDependencyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tdq.driver.Dialect())
+	t1 := builder.Table(taskdependency.Table)
+	columns := tdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskdependency.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tdq.sql != nil {
+		selector = tdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tdq.ctx.Unique != nil && *tdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tdq.predicates {
+		p(selector)
+	}
+	for _, p := range tdq.order {
+		p(selector)
+	}
+	if offset := tdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaskDependencyGroupBy is the group-by builder for TaskDependency entities.
+type TaskDependencyGroupBy struct {
+	selector
+	build *TaskDependencyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tdgb *TaskDependencyGroupBy) Aggregate(fns ...AggregateFunc) *TaskDependencyGroupBy {
+	tdgb.fns = append(tdgb.fns, fns...)
+	return tdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tdgb *TaskDependencyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskDependencyQuery, *TaskDependencyGroupBy](ctx, tdgb.build, tdgb, tdgb.build.inters, v)
+}
+
+func (tdgb *TaskDependencyGroupBy) sqlScan(ctx context.Context, root *TaskDependencyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tdgb.fns))
+	for _, fn := range tdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tdgb.flds)+len(tdgb.fns))
+		for _, f := range *tdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskDependencySelect is the builder for selecting fields of TaskDependency entities.
+type TaskDependencySelect struct {
+	*TaskDependencyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tds *TaskDependencySelect) Aggregate(fns ...AggregateFunc) *TaskDependencySelect {
+	tds.fns = append(tds.fns, fns...)
+	return tds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tds *TaskDependencySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tds.ctx, ent.OpQuerySelect)
+	if err := tds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskDependencyQuery, *TaskDependencySelect](ctx, tds.TaskDependencyQuery, tds, tds.inters, v)
+}
+
+func (tds *TaskDependencySelect) sqlScan(ctx context.Context, root *TaskDependencyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tds.fns))
+	for _, fn := range tds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
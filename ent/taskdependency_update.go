@@ -0,0 +1,553 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+)
+
+// TaskDependencyUpdate is the builder for updating TaskDependency entities.
+type TaskDependencyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskDependencyMutation
+}
+
+// Where appends a list predicates to the TaskDependencyUpdate builder.
+func (tdu *TaskDependencyUpdate) Where(ps ...predicate.TaskDependency) *TaskDependencyUpdate {
+	tdu.mutation.Where(ps...)
+	return tdu
+}
+
+// SetTaskID sets the "task_id" field.
+func (tdu *TaskDependencyUpdate) SetTaskID(i int) *TaskDependencyUpdate {
+	tdu.mutation.SetTaskID(i)
+	return tdu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tdu *TaskDependencyUpdate) SetNillableTaskID(i *int) *TaskDependencyUpdate {
+	if i != nil {
+		tdu.SetTaskID(*i)
+	}
+	return tdu
+}
+
+// SetTargetID sets the "target_id" field.
+func (tdu *TaskDependencyUpdate) SetTargetID(i int) *TaskDependencyUpdate {
+	tdu.mutation.SetTargetID(i)
+	return tdu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (tdu *TaskDependencyUpdate) SetNillableTargetID(i *int) *TaskDependencyUpdate {
+	if i != nil {
+		tdu.SetTargetID(*i)
+	}
+	return tdu
+}
+
+// SetType sets the "type" field.
+func (tdu *TaskDependencyUpdate) SetType(t taskdependency.Type) *TaskDependencyUpdate {
+	tdu.mutation.SetType(t)
+	return tdu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tdu *TaskDependencyUpdate) SetNillableType(t *taskdependency.Type) *TaskDependencyUpdate {
+	if t != nil {
+		tdu.SetType(*t)
+	}
+	return tdu
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (tdu *TaskDependencyUpdate) SetCreatorID(i int) *TaskDependencyUpdate {
+	tdu.mutation.ResetCreatorID()
+	tdu.mutation.SetCreatorID(i)
+	return tdu
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (tdu *TaskDependencyUpdate) SetNillableCreatorID(i *int) *TaskDependencyUpdate {
+	if i != nil {
+		tdu.SetCreatorID(*i)
+	}
+	return tdu
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (tdu *TaskDependencyUpdate) AddCreatorID(i int) *TaskDependencyUpdate {
+	tdu.mutation.AddCreatorID(i)
+	return tdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tdu *TaskDependencyUpdate) SetCreatedAt(t time.Time) *TaskDependencyUpdate {
+	tdu.mutation.SetCreatedAt(t)
+	return tdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tdu *TaskDependencyUpdate) SetNillableCreatedAt(t *time.Time) *TaskDependencyUpdate {
+	if t != nil {
+		tdu.SetCreatedAt(*t)
+	}
+	return tdu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tdu *TaskDependencyUpdate) SetTask(t *Task) *TaskDependencyUpdate {
+	return tdu.SetTaskID(t.ID)
+}
+
+// SetTarget sets the "target" edge to the Task entity.
+func (tdu *TaskDependencyUpdate) SetTarget(t *Task) *TaskDependencyUpdate {
+	return tdu.SetTargetID(t.ID)
+}
+
+// Mutation returns the TaskDependencyMutation object of the builder.
+func (tdu *TaskDependencyUpdate) Mutation() *TaskDependencyMutation {
+	return tdu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tdu *TaskDependencyUpdate) ClearTask() *TaskDependencyUpdate {
+	tdu.mutation.ClearTask()
+	return tdu
+}
+
+// ClearTarget clears the "target" edge to the Task entity.
+func (tdu *TaskDependencyUpdate) ClearTarget() *TaskDependencyUpdate {
+	tdu.mutation.ClearTarget()
+	return tdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tdu *TaskDependencyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tdu.sqlSave, tdu.mutation, tdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tdu *TaskDependencyUpdate) SaveX(ctx context.Context) int {
+	affected, err := tdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tdu *TaskDependencyUpdate) Exec(ctx context.Context) error {
+	_, err := tdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdu *TaskDependencyUpdate) ExecX(ctx context.Context) {
+	if err := tdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tdu *TaskDependencyUpdate) check() error {
+	if v, ok := tdu.mutation.GetType(); ok {
+		if err := taskdependency.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskDependency.type": %w`, err)}
+		}
+	}
+	if tdu.mutation.TaskCleared() && len(tdu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskDependency.task"`)
+	}
+	if tdu.mutation.TargetCleared() && len(tdu.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskDependency.target"`)
+	}
+	return nil
+}
+
+func (tdu *TaskDependencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskdependency.Table, taskdependency.Columns, sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt))
+	if ps := tdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tdu.mutation.GetType(); ok {
+		_spec.SetField(taskdependency.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := tdu.mutation.CreatorID(); ok {
+		_spec.SetField(taskdependency.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := tdu.mutation.AddedCreatorID(); ok {
+		_spec.AddField(taskdependency.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := tdu.mutation.CreatedAt(); ok {
+		_spec.SetField(taskdependency.FieldCreatedAt, field.TypeTime, value)
+	}
+	if tdu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TaskTable,
+			Columns: []string{taskdependency.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tdu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TaskTable,
+			Columns: []string{taskdependency.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tdu.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TargetTable,
+			Columns: []string{taskdependency.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tdu.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TargetTable,
+			Columns: []string{taskdependency.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskdependency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tdu.mutation.done = true
+	return n, nil
+}
+
+// TaskDependencyUpdateOne is the builder for updating a single TaskDependency entity.
+type TaskDependencyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskDependencyMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (tduo *TaskDependencyUpdateOne) SetTaskID(i int) *TaskDependencyUpdateOne {
+	tduo.mutation.SetTaskID(i)
+	return tduo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tduo *TaskDependencyUpdateOne) SetNillableTaskID(i *int) *TaskDependencyUpdateOne {
+	if i != nil {
+		tduo.SetTaskID(*i)
+	}
+	return tduo
+}
+
+// SetTargetID sets the "target_id" field.
+func (tduo *TaskDependencyUpdateOne) SetTargetID(i int) *TaskDependencyUpdateOne {
+	tduo.mutation.SetTargetID(i)
+	return tduo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (tduo *TaskDependencyUpdateOne) SetNillableTargetID(i *int) *TaskDependencyUpdateOne {
+	if i != nil {
+		tduo.SetTargetID(*i)
+	}
+	return tduo
+}
+
+// SetType sets the "type" field.
+func (tduo *TaskDependencyUpdateOne) SetType(t taskdependency.Type) *TaskDependencyUpdateOne {
+	tduo.mutation.SetType(t)
+	return tduo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tduo *TaskDependencyUpdateOne) SetNillableType(t *taskdependency.Type) *TaskDependencyUpdateOne {
+	if t != nil {
+		tduo.SetType(*t)
+	}
+	return tduo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (tduo *TaskDependencyUpdateOne) SetCreatorID(i int) *TaskDependencyUpdateOne {
+	tduo.mutation.ResetCreatorID()
+	tduo.mutation.SetCreatorID(i)
+	return tduo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (tduo *TaskDependencyUpdateOne) SetNillableCreatorID(i *int) *TaskDependencyUpdateOne {
+	if i != nil {
+		tduo.SetCreatorID(*i)
+	}
+	return tduo
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (tduo *TaskDependencyUpdateOne) AddCreatorID(i int) *TaskDependencyUpdateOne {
+	tduo.mutation.AddCreatorID(i)
+	return tduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tduo *TaskDependencyUpdateOne) SetCreatedAt(t time.Time) *TaskDependencyUpdateOne {
+	tduo.mutation.SetCreatedAt(t)
+	return tduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tduo *TaskDependencyUpdateOne) SetNillableCreatedAt(t *time.Time) *TaskDependencyUpdateOne {
+	if t != nil {
+		tduo.SetCreatedAt(*t)
+	}
+	return tduo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tduo *TaskDependencyUpdateOne) SetTask(t *Task) *TaskDependencyUpdateOne {
+	return tduo.SetTaskID(t.ID)
+}
+
+// SetTarget sets the "target" edge to the Task entity.
+func (tduo *TaskDependencyUpdateOne) SetTarget(t *Task) *TaskDependencyUpdateOne {
+	return tduo.SetTargetID(t.ID)
+}
+
+// Mutation returns the TaskDependencyMutation object of the builder.
+func (tduo *TaskDependencyUpdateOne) Mutation() *TaskDependencyMutation {
+	return tduo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tduo *TaskDependencyUpdateOne) ClearTask() *TaskDependencyUpdateOne {
+	tduo.mutation.ClearTask()
+	return tduo
+}
+
+// ClearTarget clears the "target" edge to the Task entity.
+func (tduo *TaskDependencyUpdateOne) ClearTarget() *TaskDependencyUpdateOne {
+	tduo.mutation.ClearTarget()
+	return tduo
+}
+
+// Where appends a list predicates to the TaskDependencyUpdate builder.
+func (tduo *TaskDependencyUpdateOne) Where(ps ...predicate.TaskDependency) *TaskDependencyUpdateOne {
+	tduo.mutation.Where(ps...)
+	return tduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tduo *TaskDependencyUpdateOne) Select(field string, fields ...string) *TaskDependencyUpdateOne {
+	tduo.fields = append([]string{field}, fields...)
+	return tduo
+}
+
+// Save executes the query and returns the updated TaskDependency entity.
+func (tduo *TaskDependencyUpdateOne) Save(ctx context.Context) (*TaskDependency, error) {
+	return withHooks(ctx, tduo.sqlSave, tduo.mutation, tduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tduo *TaskDependencyUpdateOne) SaveX(ctx context.Context) *TaskDependency {
+	node, err := tduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tduo *TaskDependencyUpdateOne) Exec(ctx context.Context) error {
+	_, err := tduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tduo *TaskDependencyUpdateOne) ExecX(ctx context.Context) {
+	if err := tduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tduo *TaskDependencyUpdateOne) check() error {
+	if v, ok := tduo.mutation.GetType(); ok {
+		if err := taskdependency.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskDependency.type": %w`, err)}
+		}
+	}
+	if tduo.mutation.TaskCleared() && len(tduo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskDependency.task"`)
+	}
+	if tduo.mutation.TargetCleared() && len(tduo.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskDependency.target"`)
+	}
+	return nil
+}
+
+func (tduo *TaskDependencyUpdateOne) sqlSave(ctx context.Context) (_node *TaskDependency, err error) {
+	if err := tduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskdependency.Table, taskdependency.Columns, sqlgraph.NewFieldSpec(taskdependency.FieldID, field.TypeInt))
+	id, ok := tduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskDependency.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskdependency.FieldID)
+		for _, f := range fields {
+			if !taskdependency.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskdependency.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tduo.mutation.GetType(); ok {
+		_spec.SetField(taskdependency.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := tduo.mutation.CreatorID(); ok {
+		_spec.SetField(taskdependency.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := tduo.mutation.AddedCreatorID(); ok {
+		_spec.AddField(taskdependency.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := tduo.mutation.CreatedAt(); ok {
+		_spec.SetField(taskdependency.FieldCreatedAt, field.TypeTime, value)
+	}
+	if tduo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TaskTable,
+			Columns: []string{taskdependency.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tduo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TaskTable,
+			Columns: []string{taskdependency.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tduo.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TargetTable,
+			Columns: []string{taskdependency.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tduo.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskdependency.TargetTable,
+			Columns: []string{taskdependency.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskDependency{config: tduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskdependency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+)
+
+// TaskRecurrence is the model entity for the TaskRecurrence schema.
+type TaskRecurrence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TemplateID holds the value of the "template_id" field.
+	TemplateID int `json:"template_id"`
+	// Rule holds the value of the "rule" field.
+	Rule string `json:"rule"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt time.Time `json:"start_at"`
+	// Until holds the value of the "until" field.
+	Until *time.Time `json:"until"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt *time.Time `json:"next_run_at"`
+	// LastRunAt holds the value of the "last_run_at" field.
+	LastRunAt *time.Time `json:"last_run_at"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled"`
+	// CreatorID holds the value of the "creator_id" field.
+	CreatorID int `json:"creator_id"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskRecurrenceQuery when eager-loading is set.
+	Edges        TaskRecurrenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskRecurrenceEdges holds the relations/edges for other nodes in the graph.
+type TaskRecurrenceEdges struct {
+	// Template holds the value of the template edge.
+	Template *TaskTemplate `json:"template"`
+	// Runs holds the value of the runs edge.
+	Runs []*TaskRecurrenceRun `json:"runs"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TemplateOrErr returns the Template value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskRecurrenceEdges) TemplateOrErr() (*TaskTemplate, error) {
+	if e.Template != nil {
+		return e.Template, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tasktemplate.Label}
+	}
+	return nil, &NotLoadedError{edge: "template"}
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskRecurrenceEdges) RunsOrErr() ([]*TaskRecurrenceRun, error) {
+	if e.loadedTypes[1] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskRecurrence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskrecurrence.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case taskrecurrence.FieldID, taskrecurrence.FieldTemplateID, taskrecurrence.FieldCreatorID, taskrecurrence.FieldEmployeeID:
+			values[i] = new(sql.NullInt64)
+		case taskrecurrence.FieldRule, taskrecurrence.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case taskrecurrence.FieldStartAt, taskrecurrence.FieldUntil, taskrecurrence.FieldNextRunAt, taskrecurrence.FieldLastRunAt, taskrecurrence.FieldCreatedAt, taskrecurrence.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskRecurrence fields.
+func (tr *TaskRecurrence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskrecurrence.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tr.ID = int(value.Int64)
+		case taskrecurrence.FieldTemplateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value.Valid {
+				tr.TemplateID = int(value.Int64)
+			}
+		case taskrecurrence.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				tr.Rule = value.String
+			}
+		case taskrecurrence.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				tr.StartAt = value.Time
+			}
+		case taskrecurrence.FieldUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field until", values[i])
+			} else if value.Valid {
+				tr.Until = new(time.Time)
+				*tr.Until = value.Time
+			}
+		case taskrecurrence.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				tr.NextRunAt = new(time.Time)
+				*tr.NextRunAt = value.Time
+			}
+		case taskrecurrence.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				tr.LastRunAt = new(time.Time)
+				*tr.LastRunAt = value.Time
+			}
+		case taskrecurrence.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				tr.Timezone = value.String
+			}
+		case taskrecurrence.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				tr.Enabled = value.Bool
+			}
+		case taskrecurrence.FieldCreatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value.Valid {
+				tr.CreatorID = int(value.Int64)
+			}
+		case taskrecurrence.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				tr.EmployeeID = int(value.Int64)
+			}
+		case taskrecurrence.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tr.CreatedAt = value.Time
+			}
+		case taskrecurrence.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tr.UpdatedAt = value.Time
+			}
+		default:
+			tr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskRecurrence.
+// This includes values selected through modifiers, order, etc.
+func (tr *TaskRecurrence) Value(name string) (ent.Value, error) {
+	return tr.selectValues.Get(name)
+}
+
+// QueryTemplate queries the "template" edge of the TaskRecurrence entity.
+func (tr *TaskRecurrence) QueryTemplate() *TaskTemplateQuery {
+	return NewTaskRecurrenceClient(tr.config).QueryTemplate(tr)
+}
+
+// QueryRuns queries the "runs" edge of the TaskRecurrence entity.
+func (tr *TaskRecurrence) QueryRuns() *TaskRecurrenceRunQuery {
+	return NewTaskRecurrenceClient(tr.config).QueryRuns(tr)
+}
+
+// Update returns a builder for updating this TaskRecurrence.
+// Note that you need to call TaskRecurrence.Unwrap() before calling this method if this TaskRecurrence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TaskRecurrence) Update() *TaskRecurrenceUpdateOne {
+	return NewTaskRecurrenceClient(tr.config).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TaskRecurrence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TaskRecurrence) Unwrap() *TaskRecurrence {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskRecurrence is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TaskRecurrence) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskRecurrence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("template_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.TemplateID))
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(tr.Rule)
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(tr.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tr.Until; v != nil {
+		builder.WriteString("until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := tr.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := tr.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(tr.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskRecurrences is a parsable slice of TaskRecurrence.
+type TaskRecurrences []*TaskRecurrence
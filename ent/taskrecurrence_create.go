@@ -0,0 +1,1238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+)
+
+// TaskRecurrenceCreate is the builder for creating a TaskRecurrence entity.
+type TaskRecurrenceCreate struct {
+	config
+	mutation *TaskRecurrenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTemplateID sets the "template_id" field.
+func (trc *TaskRecurrenceCreate) SetTemplateID(i int) *TaskRecurrenceCreate {
+	trc.mutation.SetTemplateID(i)
+	return trc
+}
+
+// SetRule sets the "rule" field.
+func (trc *TaskRecurrenceCreate) SetRule(s string) *TaskRecurrenceCreate {
+	trc.mutation.SetRule(s)
+	return trc
+}
+
+// SetStartAt sets the "start_at" field.
+func (trc *TaskRecurrenceCreate) SetStartAt(t time.Time) *TaskRecurrenceCreate {
+	trc.mutation.SetStartAt(t)
+	return trc
+}
+
+// SetUntil sets the "until" field.
+func (trc *TaskRecurrenceCreate) SetUntil(t time.Time) *TaskRecurrenceCreate {
+	trc.mutation.SetUntil(t)
+	return trc
+}
+
+// SetNillableUntil sets the "until" field if the given value is not nil.
+func (trc *TaskRecurrenceCreate) SetNillableUntil(t *time.Time) *TaskRecurrenceCreate {
+	if t != nil {
+		trc.SetUntil(*t)
+	}
+	return trc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (trc *TaskRecurrenceCreate) SetNextRunAt(t time.Time) *TaskRecurrenceCreate {
+	trc.mutation.SetNextRunAt(t)
+	return trc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (trc *TaskRecurrenceCreate) SetNillableNextRunAt(t *time.Time) *TaskRecurrenceCreate {
+	if t != nil {
+		trc.SetNextRunAt(*t)
+	}
+	return trc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (trc *TaskRecurrenceCreate) SetLastRunAt(t time.Time) *TaskRecurrenceCreate {
+	trc.mutation.SetLastRunAt(t)
+	return trc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (trc *TaskRecurrenceCreate) SetNillableLastRunAt(t *time.Time) *TaskRecurrenceCreate {
+	if t != nil {
+		trc.SetLastRunAt(*t)
+	}
+	return trc
+}
+
+// SetTimezone sets the "timezone" field.
+func (trc *TaskRecurrenceCreate) SetTimezone(s string) *TaskRecurrenceCreate {
+	trc.mutation.SetTimezone(s)
+	return trc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (trc *TaskRecurrenceCreate) SetNillableTimezone(s *string) *TaskRecurrenceCreate {
+	if s != nil {
+		trc.SetTimezone(*s)
+	}
+	return trc
+}
+
+// SetEnabled sets the "enabled" field.
+func (trc *TaskRecurrenceCreate) SetEnabled(b bool) *TaskRecurrenceCreate {
+	trc.mutation.SetEnabled(b)
+	return trc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (trc *TaskRecurrenceCreate) SetNillableEnabled(b *bool) *TaskRecurrenceCreate {
+	if b != nil {
+		trc.SetEnabled(*b)
+	}
+	return trc
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (trc *TaskRecurrenceCreate) SetCreatorID(i int) *TaskRecurrenceCreate {
+	trc.mutation.SetCreatorID(i)
+	return trc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (trc *TaskRecurrenceCreate) SetEmployeeID(i int) *TaskRecurrenceCreate {
+	trc.mutation.SetEmployeeID(i)
+	return trc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (trc *TaskRecurrenceCreate) SetCreatedAt(t time.Time) *TaskRecurrenceCreate {
+	trc.mutation.SetCreatedAt(t)
+	return trc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (trc *TaskRecurrenceCreate) SetNillableCreatedAt(t *time.Time) *TaskRecurrenceCreate {
+	if t != nil {
+		trc.SetCreatedAt(*t)
+	}
+	return trc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trc *TaskRecurrenceCreate) SetUpdatedAt(t time.Time) *TaskRecurrenceCreate {
+	trc.mutation.SetUpdatedAt(t)
+	return trc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (trc *TaskRecurrenceCreate) SetNillableUpdatedAt(t *time.Time) *TaskRecurrenceCreate {
+	if t != nil {
+		trc.SetUpdatedAt(*t)
+	}
+	return trc
+}
+
+// SetTemplate sets the "template" edge to the TaskTemplate entity.
+func (trc *TaskRecurrenceCreate) SetTemplate(t *TaskTemplate) *TaskRecurrenceCreate {
+	return trc.SetTemplateID(t.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the TaskRecurrenceRun entity by IDs.
+func (trc *TaskRecurrenceCreate) AddRunIDs(ids ...int) *TaskRecurrenceCreate {
+	trc.mutation.AddRunIDs(ids...)
+	return trc
+}
+
+// AddRuns adds the "runs" edges to the TaskRecurrenceRun entity.
+func (trc *TaskRecurrenceCreate) AddRuns(t ...*TaskRecurrenceRun) *TaskRecurrenceCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return trc.AddRunIDs(ids...)
+}
+
+// Mutation returns the TaskRecurrenceMutation object of the builder.
+func (trc *TaskRecurrenceCreate) Mutation() *TaskRecurrenceMutation {
+	return trc.mutation
+}
+
+// Save creates the TaskRecurrence in the database.
+func (trc *TaskRecurrenceCreate) Save(ctx context.Context) (*TaskRecurrence, error) {
+	trc.defaults()
+	return withHooks(ctx, trc.sqlSave, trc.mutation, trc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TaskRecurrenceCreate) SaveX(ctx context.Context) *TaskRecurrence {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TaskRecurrenceCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TaskRecurrenceCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TaskRecurrenceCreate) defaults() {
+	if _, ok := trc.mutation.Timezone(); !ok {
+		v := taskrecurrence.DefaultTimezone
+		trc.mutation.SetTimezone(v)
+	}
+	if _, ok := trc.mutation.Enabled(); !ok {
+		v := taskrecurrence.DefaultEnabled
+		trc.mutation.SetEnabled(v)
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		v := taskrecurrence.DefaultCreatedAt()
+		trc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		v := taskrecurrence.DefaultUpdatedAt()
+		trc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TaskRecurrenceCreate) check() error {
+	if _, ok := trc.mutation.TemplateID(); !ok {
+		return &ValidationError{Name: "template_id", err: errors.New(`ent: missing required field "TaskRecurrence.template_id"`)}
+	}
+	if _, ok := trc.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required field "TaskRecurrence.rule"`)}
+	}
+	if v, ok := trc.mutation.Rule(); ok {
+		if err := taskrecurrence.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "TaskRecurrence.rule": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "TaskRecurrence.start_at"`)}
+	}
+	if _, ok := trc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "TaskRecurrence.timezone"`)}
+	}
+	if _, ok := trc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "TaskRecurrence.enabled"`)}
+	}
+	if _, ok := trc.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`ent: missing required field "TaskRecurrence.creator_id"`)}
+	}
+	if _, ok := trc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "TaskRecurrence.employee_id"`)}
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskRecurrence.created_at"`)}
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaskRecurrence.updated_at"`)}
+	}
+	if len(trc.mutation.TemplateIDs()) == 0 {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required edge "TaskRecurrence.template"`)}
+	}
+	return nil
+}
+
+func (trc *TaskRecurrenceCreate) sqlSave(ctx context.Context) (*TaskRecurrence, error) {
+	if err := trc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	trc.mutation.id = &_node.ID
+	trc.mutation.done = true
+	return _node, nil
+}
+
+func (trc *TaskRecurrenceCreate) createSpec() (*TaskRecurrence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskRecurrence{config: trc.config}
+		_spec = sqlgraph.NewCreateSpec(taskrecurrence.Table, sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = trc.conflict
+	if value, ok := trc.mutation.Rule(); ok {
+		_spec.SetField(taskrecurrence.FieldRule, field.TypeString, value)
+		_node.Rule = value
+	}
+	if value, ok := trc.mutation.StartAt(); ok {
+		_spec.SetField(taskrecurrence.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := trc.mutation.Until(); ok {
+		_spec.SetField(taskrecurrence.FieldUntil, field.TypeTime, value)
+		_node.Until = &value
+	}
+	if value, ok := trc.mutation.NextRunAt(); ok {
+		_spec.SetField(taskrecurrence.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := trc.mutation.LastRunAt(); ok {
+		_spec.SetField(taskrecurrence.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := trc.mutation.Timezone(); ok {
+		_spec.SetField(taskrecurrence.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := trc.mutation.Enabled(); ok {
+		_spec.SetField(taskrecurrence.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := trc.mutation.CreatorID(); ok {
+		_spec.SetField(taskrecurrence.FieldCreatorID, field.TypeInt, value)
+		_node.CreatorID = value
+	}
+	if value, ok := trc.mutation.EmployeeID(); ok {
+		_spec.SetField(taskrecurrence.FieldEmployeeID, field.TypeInt, value)
+		_node.EmployeeID = value
+	}
+	if value, ok := trc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskrecurrence.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := trc.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrecurrence.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := trc.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrence.TemplateTable,
+			Columns: []string{taskrecurrence.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TemplateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := trc.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskrecurrence.RunsTable,
+			Columns: []string{taskrecurrence.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRecurrence.Create().
+//		SetTemplateID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRecurrenceUpsert) {
+//			SetTemplateID(v+v).
+//		}).
+//		Exec(ctx)
+func (trc *TaskRecurrenceCreate) OnConflict(opts ...sql.ConflictOption) *TaskRecurrenceUpsertOne {
+	trc.conflict = opts
+	return &TaskRecurrenceUpsertOne{
+		create: trc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRecurrence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trc *TaskRecurrenceCreate) OnConflictColumns(columns ...string) *TaskRecurrenceUpsertOne {
+	trc.conflict = append(trc.conflict, sql.ConflictColumns(columns...))
+	return &TaskRecurrenceUpsertOne{
+		create: trc,
+	}
+}
+
+type (
+	// TaskRecurrenceUpsertOne is the builder for "upsert"-ing
+	//  one TaskRecurrence node.
+	TaskRecurrenceUpsertOne struct {
+		create *TaskRecurrenceCreate
+	}
+
+	// TaskRecurrenceUpsert is the "OnConflict" setter.
+	TaskRecurrenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTemplateID sets the "template_id" field.
+func (u *TaskRecurrenceUpsert) SetTemplateID(v int) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldTemplateID, v)
+	return u
+}
+
+// UpdateTemplateID sets the "template_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateTemplateID() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldTemplateID)
+	return u
+}
+
+// SetRule sets the "rule" field.
+func (u *TaskRecurrenceUpsert) SetRule(v string) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldRule, v)
+	return u
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateRule() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldRule)
+	return u
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *TaskRecurrenceUpsert) SetStartAt(v time.Time) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldStartAt, v)
+	return u
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateStartAt() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldStartAt)
+	return u
+}
+
+// SetUntil sets the "until" field.
+func (u *TaskRecurrenceUpsert) SetUntil(v time.Time) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldUntil, v)
+	return u
+}
+
+// UpdateUntil sets the "until" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateUntil() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldUntil)
+	return u
+}
+
+// ClearUntil clears the value of the "until" field.
+func (u *TaskRecurrenceUpsert) ClearUntil() *TaskRecurrenceUpsert {
+	u.SetNull(taskrecurrence.FieldUntil)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *TaskRecurrenceUpsert) SetNextRunAt(v time.Time) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateNextRunAt() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *TaskRecurrenceUpsert) ClearNextRunAt() *TaskRecurrenceUpsert {
+	u.SetNull(taskrecurrence.FieldNextRunAt)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *TaskRecurrenceUpsert) SetLastRunAt(v time.Time) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateLastRunAt() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *TaskRecurrenceUpsert) ClearLastRunAt() *TaskRecurrenceUpsert {
+	u.SetNull(taskrecurrence.FieldLastRunAt)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *TaskRecurrenceUpsert) SetTimezone(v string) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateTimezone() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldTimezone)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TaskRecurrenceUpsert) SetEnabled(v bool) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateEnabled() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldEnabled)
+	return u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskRecurrenceUpsert) SetCreatorID(v int) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldCreatorID, v)
+	return u
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateCreatorID() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldCreatorID)
+	return u
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskRecurrenceUpsert) AddCreatorID(v int) *TaskRecurrenceUpsert {
+	u.Add(taskrecurrence.FieldCreatorID, v)
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskRecurrenceUpsert) SetEmployeeID(v int) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateEmployeeID() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldEmployeeID)
+	return u
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskRecurrenceUpsert) AddEmployeeID(v int) *TaskRecurrenceUpsert {
+	u.Add(taskrecurrence.FieldEmployeeID, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRecurrenceUpsert) SetUpdatedAt(v time.Time) *TaskRecurrenceUpsert {
+	u.Set(taskrecurrence.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsert) UpdateUpdatedAt() *TaskRecurrenceUpsert {
+	u.SetExcluded(taskrecurrence.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskRecurrence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskRecurrenceUpsertOne) UpdateNewValues() *TaskRecurrenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskrecurrence.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRecurrence.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskRecurrenceUpsertOne) Ignore() *TaskRecurrenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRecurrenceUpsertOne) DoNothing() *TaskRecurrenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRecurrenceCreate.OnConflict
+// documentation for more info.
+func (u *TaskRecurrenceUpsertOne) Update(set func(*TaskRecurrenceUpsert)) *TaskRecurrenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRecurrenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTemplateID sets the "template_id" field.
+func (u *TaskRecurrenceUpsertOne) SetTemplateID(v int) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetTemplateID(v)
+	})
+}
+
+// UpdateTemplateID sets the "template_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateTemplateID() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateTemplateID()
+	})
+}
+
+// SetRule sets the "rule" field.
+func (u *TaskRecurrenceUpsertOne) SetRule(v string) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetRule(v)
+	})
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateRule() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateRule()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *TaskRecurrenceUpsertOne) SetStartAt(v time.Time) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateStartAt() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetUntil sets the "until" field.
+func (u *TaskRecurrenceUpsertOne) SetUntil(v time.Time) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetUntil(v)
+	})
+}
+
+// UpdateUntil sets the "until" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateUntil() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateUntil()
+	})
+}
+
+// ClearUntil clears the value of the "until" field.
+func (u *TaskRecurrenceUpsertOne) ClearUntil() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.ClearUntil()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *TaskRecurrenceUpsertOne) SetNextRunAt(v time.Time) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateNextRunAt() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *TaskRecurrenceUpsertOne) ClearNextRunAt() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *TaskRecurrenceUpsertOne) SetLastRunAt(v time.Time) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateLastRunAt() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *TaskRecurrenceUpsertOne) ClearLastRunAt() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *TaskRecurrenceUpsertOne) SetTimezone(v string) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateTimezone() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TaskRecurrenceUpsertOne) SetEnabled(v bool) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateEnabled() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskRecurrenceUpsertOne) SetCreatorID(v int) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskRecurrenceUpsertOne) AddCreatorID(v int) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateCreatorID() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskRecurrenceUpsertOne) SetEmployeeID(v int) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskRecurrenceUpsertOne) AddEmployeeID(v int) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateEmployeeID() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRecurrenceUpsertOne) SetUpdatedAt(v time.Time) *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertOne) UpdateUpdatedAt() *TaskRecurrenceUpsertOne {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRecurrenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRecurrenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRecurrenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskRecurrenceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskRecurrenceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskRecurrenceCreateBulk is the builder for creating many TaskRecurrence entities in bulk.
+type TaskRecurrenceCreateBulk struct {
+	config
+	err      error
+	builders []*TaskRecurrenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskRecurrence entities in the database.
+func (trcb *TaskRecurrenceCreateBulk) Save(ctx context.Context) ([]*TaskRecurrence, error) {
+	if trcb.err != nil {
+		return nil, trcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TaskRecurrence, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskRecurrenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = trcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TaskRecurrenceCreateBulk) SaveX(ctx context.Context) []*TaskRecurrence {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TaskRecurrenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TaskRecurrenceCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRecurrence.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRecurrenceUpsert) {
+//			SetTemplateID(v+v).
+//		}).
+//		Exec(ctx)
+func (trcb *TaskRecurrenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskRecurrenceUpsertBulk {
+	trcb.conflict = opts
+	return &TaskRecurrenceUpsertBulk{
+		create: trcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRecurrence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trcb *TaskRecurrenceCreateBulk) OnConflictColumns(columns ...string) *TaskRecurrenceUpsertBulk {
+	trcb.conflict = append(trcb.conflict, sql.ConflictColumns(columns...))
+	return &TaskRecurrenceUpsertBulk{
+		create: trcb,
+	}
+}
+
+// TaskRecurrenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskRecurrence nodes.
+type TaskRecurrenceUpsertBulk struct {
+	create *TaskRecurrenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskRecurrence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskRecurrenceUpsertBulk) UpdateNewValues() *TaskRecurrenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskrecurrence.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRecurrence.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskRecurrenceUpsertBulk) Ignore() *TaskRecurrenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRecurrenceUpsertBulk) DoNothing() *TaskRecurrenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRecurrenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskRecurrenceUpsertBulk) Update(set func(*TaskRecurrenceUpsert)) *TaskRecurrenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRecurrenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTemplateID sets the "template_id" field.
+func (u *TaskRecurrenceUpsertBulk) SetTemplateID(v int) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetTemplateID(v)
+	})
+}
+
+// UpdateTemplateID sets the "template_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateTemplateID() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateTemplateID()
+	})
+}
+
+// SetRule sets the "rule" field.
+func (u *TaskRecurrenceUpsertBulk) SetRule(v string) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetRule(v)
+	})
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateRule() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateRule()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *TaskRecurrenceUpsertBulk) SetStartAt(v time.Time) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateStartAt() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetUntil sets the "until" field.
+func (u *TaskRecurrenceUpsertBulk) SetUntil(v time.Time) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetUntil(v)
+	})
+}
+
+// UpdateUntil sets the "until" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateUntil() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateUntil()
+	})
+}
+
+// ClearUntil clears the value of the "until" field.
+func (u *TaskRecurrenceUpsertBulk) ClearUntil() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.ClearUntil()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *TaskRecurrenceUpsertBulk) SetNextRunAt(v time.Time) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateNextRunAt() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *TaskRecurrenceUpsertBulk) ClearNextRunAt() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *TaskRecurrenceUpsertBulk) SetLastRunAt(v time.Time) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateLastRunAt() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *TaskRecurrenceUpsertBulk) ClearLastRunAt() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *TaskRecurrenceUpsertBulk) SetTimezone(v string) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateTimezone() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TaskRecurrenceUpsertBulk) SetEnabled(v bool) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateEnabled() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskRecurrenceUpsertBulk) SetCreatorID(v int) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskRecurrenceUpsertBulk) AddCreatorID(v int) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateCreatorID() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskRecurrenceUpsertBulk) SetEmployeeID(v int) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskRecurrenceUpsertBulk) AddEmployeeID(v int) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateEmployeeID() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRecurrenceUpsertBulk) SetUpdatedAt(v time.Time) *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRecurrenceUpsertBulk) UpdateUpdatedAt() *TaskRecurrenceUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRecurrenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskRecurrenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRecurrenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRecurrenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+)
+
+// TaskRecurrenceDelete is the builder for deleting a TaskRecurrence entity.
+type TaskRecurrenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskRecurrenceMutation
+}
+
+// Where appends a list predicates to the TaskRecurrenceDelete builder.
+func (trd *TaskRecurrenceDelete) Where(ps ...predicate.TaskRecurrence) *TaskRecurrenceDelete {
+	trd.mutation.Where(ps...)
+	return trd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (trd *TaskRecurrenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, trd.sqlExec, trd.mutation, trd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trd *TaskRecurrenceDelete) ExecX(ctx context.Context) int {
+	n, err := trd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (trd *TaskRecurrenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskrecurrence.Table, sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt))
+	if ps := trd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, trd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	trd.mutation.done = true
+	return affected, err
+}
+
+// TaskRecurrenceDeleteOne is the builder for deleting a single TaskRecurrence entity.
+type TaskRecurrenceDeleteOne struct {
+	trd *TaskRecurrenceDelete
+}
+
+// Where appends a list predicates to the TaskRecurrenceDelete builder.
+func (trdo *TaskRecurrenceDeleteOne) Where(ps ...predicate.TaskRecurrence) *TaskRecurrenceDeleteOne {
+	trdo.trd.mutation.Where(ps...)
+	return trdo
+}
+
+// Exec executes the deletion query.
+func (trdo *TaskRecurrenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := trdo.trd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskrecurrence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trdo *TaskRecurrenceDeleteOne) ExecX(ctx context.Context) {
+	if err := trdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+)
+
+// TaskRecurrenceQuery is the builder for querying TaskRecurrence entities.
+type TaskRecurrenceQuery struct {
+	config
+	ctx          *QueryContext
+	order        []taskrecurrence.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.TaskRecurrence
+	withTemplate *TaskTemplateQuery
+	withRuns     *TaskRecurrenceRunQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskRecurrenceQuery builder.
+func (trq *TaskRecurrenceQuery) Where(ps ...predicate.TaskRecurrence) *TaskRecurrenceQuery {
+	trq.predicates = append(trq.predicates, ps...)
+	return trq
+}
+
+// Limit the number of records to be returned by this query.
+func (trq *TaskRecurrenceQuery) Limit(limit int) *TaskRecurrenceQuery {
+	trq.ctx.Limit = &limit
+	return trq
+}
+
+// Offset to start from.
+func (trq *TaskRecurrenceQuery) Offset(offset int) *TaskRecurrenceQuery {
+	trq.ctx.Offset = &offset
+	return trq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trq *TaskRecurrenceQuery) Unique(unique bool) *TaskRecurrenceQuery {
+	trq.ctx.Unique = &unique
+	return trq
+}
+
+// Order specifies how the records should be ordered.
+func (trq *TaskRecurrenceQuery) Order(o ...taskrecurrence.OrderOption) *TaskRecurrenceQuery {
+	trq.order = append(trq.order, o...)
+	return trq
+}
+
+// QueryTemplate chains the current query on the "template" edge.
+func (trq *TaskRecurrenceQuery) QueryTemplate() *TaskTemplateQuery {
+	query := (&TaskTemplateClient{config: trq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskrecurrence.Table, taskrecurrence.FieldID, selector),
+			sqlgraph.To(tasktemplate.Table, tasktemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskrecurrence.TemplateTable, taskrecurrence.TemplateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRuns chains the current query on the "runs" edge.
+func (trq *TaskRecurrenceQuery) QueryRuns() *TaskRecurrenceRunQuery {
+	query := (&TaskRecurrenceRunClient{config: trq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskrecurrence.Table, taskrecurrence.FieldID, selector),
+			sqlgraph.To(taskrecurrencerun.Table, taskrecurrencerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, taskrecurrence.RunsTable, taskrecurrence.RunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskRecurrence entity from the query.
+// Returns a *NotFoundError when no TaskRecurrence was found.
+func (trq *TaskRecurrenceQuery) First(ctx context.Context) (*TaskRecurrence, error) {
+	nodes, err := trq.Limit(1).All(setContextOp(ctx, trq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskrecurrence.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trq *TaskRecurrenceQuery) FirstX(ctx context.Context) *TaskRecurrence {
+	node, err := trq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskRecurrence ID from the query.
+// Returns a *NotFoundError when no TaskRecurrence ID was found.
+func (trq *TaskRecurrenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trq.Limit(1).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskrecurrence.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trq *TaskRecurrenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := trq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskRecurrence entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskRecurrence entity is found.
+// Returns a *NotFoundError when no TaskRecurrence entities are found.
+func (trq *TaskRecurrenceQuery) Only(ctx context.Context) (*TaskRecurrence, error) {
+	nodes, err := trq.Limit(2).All(setContextOp(ctx, trq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskrecurrence.Label}
+	default:
+		return nil, &NotSingularError{taskrecurrence.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trq *TaskRecurrenceQuery) OnlyX(ctx context.Context) *TaskRecurrence {
+	node, err := trq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskRecurrence ID in the query.
+// Returns a *NotSingularError when more than one TaskRecurrence ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trq *TaskRecurrenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trq.Limit(2).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskrecurrence.Label}
+	default:
+		err = &NotSingularError{taskrecurrence.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trq *TaskRecurrenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := trq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskRecurrences.
+func (trq *TaskRecurrenceQuery) All(ctx context.Context) ([]*TaskRecurrence, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryAll)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskRecurrence, *TaskRecurrenceQuery]()
+	return withInterceptors[[]*TaskRecurrence](ctx, trq, qr, trq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trq *TaskRecurrenceQuery) AllX(ctx context.Context) []*TaskRecurrence {
+	nodes, err := trq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskRecurrence IDs.
+func (trq *TaskRecurrenceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if trq.ctx.Unique == nil && trq.path != nil {
+		trq.Unique(true)
+	}
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryIDs)
+	if err = trq.Select(taskrecurrence.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trq *TaskRecurrenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := trq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trq *TaskRecurrenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryCount)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, trq, querierCount[*TaskRecurrenceQuery](), trq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trq *TaskRecurrenceQuery) CountX(ctx context.Context) int {
+	count, err := trq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trq *TaskRecurrenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryExist)
+	switch _, err := trq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trq *TaskRecurrenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := trq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskRecurrenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trq *TaskRecurrenceQuery) Clone() *TaskRecurrenceQuery {
+	if trq == nil {
+		return nil
+	}
+	return &TaskRecurrenceQuery{
+		config:       trq.config,
+		ctx:          trq.ctx.Clone(),
+		order:        append([]taskrecurrence.OrderOption{}, trq.order...),
+		inters:       append([]Interceptor{}, trq.inters...),
+		predicates:   append([]predicate.TaskRecurrence{}, trq.predicates...),
+		withTemplate: trq.withTemplate.Clone(),
+		withRuns:     trq.withRuns.Clone(),
+		// clone intermediate query.
+		sql:  trq.sql.Clone(),
+		path: trq.path,
+	}
+}
+
+// WithTemplate tells the query-builder to eager-load the nodes that are connected to
+// the "template" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TaskRecurrenceQuery) WithTemplate(opts ...func(*TaskTemplateQuery)) *TaskRecurrenceQuery {
+	query := (&TaskTemplateClient{config: trq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withTemplate = query
+	return trq
+}
+
+// WithRuns tells the query-builder to eager-load the nodes that are connected to
+// the "runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TaskRecurrenceQuery) WithRuns(opts ...func(*TaskRecurrenceRunQuery)) *TaskRecurrenceQuery {
+	query := (&TaskRecurrenceRunClient{config: trq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withRuns = query
+	return trq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TemplateID int `json:"template_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskRecurrence.Query().
+//		GroupBy(taskrecurrence.FieldTemplateID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (trq *TaskRecurrenceQuery) GroupBy(field string, fields ...string) *TaskRecurrenceGroupBy {
+	trq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskRecurrenceGroupBy{build: trq}
+	grbuild.flds = &trq.ctx.Fields
+	grbuild.label = taskrecurrence.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TemplateID int `json:"template_id"`
+//	}
+//
+//	client.TaskRecurrence.Query().
+//		Select(taskrecurrence.FieldTemplateID).
+//		Scan(ctx, &v)
+func (trq *TaskRecurrenceQuery) Select(fields ...string) *TaskRecurrenceSelect {
+	trq.ctx.Fields = append(trq.ctx.Fields, fields...)
+	sbuild := &TaskRecurrenceSelect{TaskRecurrenceQuery: trq}
+	sbuild.label = taskrecurrence.Label
+	sbuild.flds, sbuild.scan = &trq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskRecurrenceSelect configured with the given aggregations.
+func (trq *TaskRecurrenceQuery) Aggregate(fns ...AggregateFunc) *TaskRecurrenceSelect {
+	return trq.Select().Aggregate(fns...)
+}
+
+func (trq *TaskRecurrenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range trq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, trq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range trq.ctx.Fields {
+		if !taskrecurrence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trq.path != nil {
+		prev, err := trq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trq.sql = prev
+	}
+	return nil
+}
+
+func (trq *TaskRecurrenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskRecurrence, error) {
+	var (
+		nodes       = []*TaskRecurrence{}
+		_spec       = trq.querySpec()
+		loadedTypes = [2]bool{
+			trq.withTemplate != nil,
+			trq.withRuns != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskRecurrence).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskRecurrence{config: trq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := trq.withTemplate; query != nil {
+		if err := trq.loadTemplate(ctx, query, nodes, nil,
+			func(n *TaskRecurrence, e *TaskTemplate) { n.Edges.Template = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := trq.withRuns; query != nil {
+		if err := trq.loadRuns(ctx, query, nodes,
+			func(n *TaskRecurrence) { n.Edges.Runs = []*TaskRecurrenceRun{} },
+			func(n *TaskRecurrence, e *TaskRecurrenceRun) { n.Edges.Runs = append(n.Edges.Runs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (trq *TaskRecurrenceQuery) loadTemplate(ctx context.Context, query *TaskTemplateQuery, nodes []*TaskRecurrence, init func(*TaskRecurrence), assign func(*TaskRecurrence, *TaskTemplate)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskRecurrence)
+	for i := range nodes {
+		fk := nodes[i].TemplateID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tasktemplate.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "template_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (trq *TaskRecurrenceQuery) loadRuns(ctx context.Context, query *TaskRecurrenceRunQuery, nodes []*TaskRecurrence, init func(*TaskRecurrence), assign func(*TaskRecurrence, *TaskRecurrenceRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*TaskRecurrence)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskrecurrencerun.FieldRecurrenceID)
+	}
+	query.Where(predicate.TaskRecurrenceRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(taskrecurrence.RunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RecurrenceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "recurrence_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (trq *TaskRecurrenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trq.querySpec()
+	_spec.Node.Columns = trq.ctx.Fields
+	if len(trq.ctx.Fields) > 0 {
+		_spec.Unique = trq.ctx.Unique != nil && *trq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, trq.driver, _spec)
+}
+
+func (trq *TaskRecurrenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskrecurrence.Table, taskrecurrence.Columns, sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt))
+	_spec.From = trq.sql
+	if unique := trq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if trq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := trq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrecurrence.FieldID)
+		for i := range fields {
+			if fields[i] != taskrecurrence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if trq.withTemplate != nil {
+			_spec.Node.AddColumnOnce(taskrecurrence.FieldTemplateID)
+		}
+	}
+	if ps := trq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trq *TaskRecurrenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trq.driver.Dialect())
+	t1 := builder.Table(taskrecurrence.Table)
+	columns := trq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskrecurrence.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trq.sql != nil {
+		selector = trq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trq.ctx.Unique != nil && *trq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range trq.predicates {
+		p(selector)
+	}
+	for _, p := range trq.order {
+		p(selector)
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaskRecurrenceGroupBy is the group-by builder for TaskRecurrence entities.
+type TaskRecurrenceGroupBy struct {
+	selector
+	build *TaskRecurrenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trgb *TaskRecurrenceGroupBy) Aggregate(fns ...AggregateFunc) *TaskRecurrenceGroupBy {
+	trgb.fns = append(trgb.fns, fns...)
+	return trgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trgb *TaskRecurrenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trgb.build.ctx, ent.OpQueryGroupBy)
+	if err := trgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskRecurrenceQuery, *TaskRecurrenceGroupBy](ctx, trgb.build, trgb, trgb.build.inters, v)
+}
+
+func (trgb *TaskRecurrenceGroupBy) sqlScan(ctx context.Context, root *TaskRecurrenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(trgb.fns))
+	for _, fn := range trgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*trgb.flds)+len(trgb.fns))
+		for _, f := range *trgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*trgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskRecurrenceSelect is the builder for selecting fields of TaskRecurrence entities.
+type TaskRecurrenceSelect struct {
+	*TaskRecurrenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (trs *TaskRecurrenceSelect) Aggregate(fns ...AggregateFunc) *TaskRecurrenceSelect {
+	trs.fns = append(trs.fns, fns...)
+	return trs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trs *TaskRecurrenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trs.ctx, ent.OpQuerySelect)
+	if err := trs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskRecurrenceQuery, *TaskRecurrenceSelect](ctx, trs.TaskRecurrenceQuery, trs, trs.inters, v)
+}
+
+func (trs *TaskRecurrenceSelect) sqlScan(ctx context.Context, root *TaskRecurrenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(trs.fns))
+	for _, fn := range trs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*trs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+)
+
+// TaskRecurrenceUpdate is the builder for updating TaskRecurrence entities.
+type TaskRecurrenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskRecurrenceMutation
+}
+
+// Where appends a list predicates to the TaskRecurrenceUpdate builder.
+func (tru *TaskRecurrenceUpdate) Where(ps ...predicate.TaskRecurrence) *TaskRecurrenceUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetTemplateID sets the "template_id" field.
+func (tru *TaskRecurrenceUpdate) SetTemplateID(i int) *TaskRecurrenceUpdate {
+	tru.mutation.SetTemplateID(i)
+	return tru
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableTemplateID(i *int) *TaskRecurrenceUpdate {
+	if i != nil {
+		tru.SetTemplateID(*i)
+	}
+	return tru
+}
+
+// SetRule sets the "rule" field.
+func (tru *TaskRecurrenceUpdate) SetRule(s string) *TaskRecurrenceUpdate {
+	tru.mutation.SetRule(s)
+	return tru
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableRule(s *string) *TaskRecurrenceUpdate {
+	if s != nil {
+		tru.SetRule(*s)
+	}
+	return tru
+}
+
+// SetStartAt sets the "start_at" field.
+func (tru *TaskRecurrenceUpdate) SetStartAt(t time.Time) *TaskRecurrenceUpdate {
+	tru.mutation.SetStartAt(t)
+	return tru
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableStartAt(t *time.Time) *TaskRecurrenceUpdate {
+	if t != nil {
+		tru.SetStartAt(*t)
+	}
+	return tru
+}
+
+// SetUntil sets the "until" field.
+func (tru *TaskRecurrenceUpdate) SetUntil(t time.Time) *TaskRecurrenceUpdate {
+	tru.mutation.SetUntil(t)
+	return tru
+}
+
+// SetNillableUntil sets the "until" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableUntil(t *time.Time) *TaskRecurrenceUpdate {
+	if t != nil {
+		tru.SetUntil(*t)
+	}
+	return tru
+}
+
+// ClearUntil clears the value of the "until" field.
+func (tru *TaskRecurrenceUpdate) ClearUntil() *TaskRecurrenceUpdate {
+	tru.mutation.ClearUntil()
+	return tru
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (tru *TaskRecurrenceUpdate) SetNextRunAt(t time.Time) *TaskRecurrenceUpdate {
+	tru.mutation.SetNextRunAt(t)
+	return tru
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableNextRunAt(t *time.Time) *TaskRecurrenceUpdate {
+	if t != nil {
+		tru.SetNextRunAt(*t)
+	}
+	return tru
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (tru *TaskRecurrenceUpdate) ClearNextRunAt() *TaskRecurrenceUpdate {
+	tru.mutation.ClearNextRunAt()
+	return tru
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (tru *TaskRecurrenceUpdate) SetLastRunAt(t time.Time) *TaskRecurrenceUpdate {
+	tru.mutation.SetLastRunAt(t)
+	return tru
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableLastRunAt(t *time.Time) *TaskRecurrenceUpdate {
+	if t != nil {
+		tru.SetLastRunAt(*t)
+	}
+	return tru
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (tru *TaskRecurrenceUpdate) ClearLastRunAt() *TaskRecurrenceUpdate {
+	tru.mutation.ClearLastRunAt()
+	return tru
+}
+
+// SetTimezone sets the "timezone" field.
+func (tru *TaskRecurrenceUpdate) SetTimezone(s string) *TaskRecurrenceUpdate {
+	tru.mutation.SetTimezone(s)
+	return tru
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableTimezone(s *string) *TaskRecurrenceUpdate {
+	if s != nil {
+		tru.SetTimezone(*s)
+	}
+	return tru
+}
+
+// SetEnabled sets the "enabled" field.
+func (tru *TaskRecurrenceUpdate) SetEnabled(b bool) *TaskRecurrenceUpdate {
+	tru.mutation.SetEnabled(b)
+	return tru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableEnabled(b *bool) *TaskRecurrenceUpdate {
+	if b != nil {
+		tru.SetEnabled(*b)
+	}
+	return tru
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (tru *TaskRecurrenceUpdate) SetCreatorID(i int) *TaskRecurrenceUpdate {
+	tru.mutation.ResetCreatorID()
+	tru.mutation.SetCreatorID(i)
+	return tru
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableCreatorID(i *int) *TaskRecurrenceUpdate {
+	if i != nil {
+		tru.SetCreatorID(*i)
+	}
+	return tru
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (tru *TaskRecurrenceUpdate) AddCreatorID(i int) *TaskRecurrenceUpdate {
+	tru.mutation.AddCreatorID(i)
+	return tru
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (tru *TaskRecurrenceUpdate) SetEmployeeID(i int) *TaskRecurrenceUpdate {
+	tru.mutation.ResetEmployeeID()
+	tru.mutation.SetEmployeeID(i)
+	return tru
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (tru *TaskRecurrenceUpdate) SetNillableEmployeeID(i *int) *TaskRecurrenceUpdate {
+	if i != nil {
+		tru.SetEmployeeID(*i)
+	}
+	return tru
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (tru *TaskRecurrenceUpdate) AddEmployeeID(i int) *TaskRecurrenceUpdate {
+	tru.mutation.AddEmployeeID(i)
+	return tru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tru *TaskRecurrenceUpdate) SetUpdatedAt(t time.Time) *TaskRecurrenceUpdate {
+	tru.mutation.SetUpdatedAt(t)
+	return tru
+}
+
+// SetTemplate sets the "template" edge to the TaskTemplate entity.
+func (tru *TaskRecurrenceUpdate) SetTemplate(t *TaskTemplate) *TaskRecurrenceUpdate {
+	return tru.SetTemplateID(t.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the TaskRecurrenceRun entity by IDs.
+func (tru *TaskRecurrenceUpdate) AddRunIDs(ids ...int) *TaskRecurrenceUpdate {
+	tru.mutation.AddRunIDs(ids...)
+	return tru
+}
+
+// AddRuns adds the "runs" edges to the TaskRecurrenceRun entity.
+func (tru *TaskRecurrenceUpdate) AddRuns(t ...*TaskRecurrenceRun) *TaskRecurrenceUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tru.AddRunIDs(ids...)
+}
+
+// Mutation returns the TaskRecurrenceMutation object of the builder.
+func (tru *TaskRecurrenceUpdate) Mutation() *TaskRecurrenceMutation {
+	return tru.mutation
+}
+
+// ClearTemplate clears the "template" edge to the TaskTemplate entity.
+func (tru *TaskRecurrenceUpdate) ClearTemplate() *TaskRecurrenceUpdate {
+	tru.mutation.ClearTemplate()
+	return tru
+}
+
+// ClearRuns clears all "runs" edges to the TaskRecurrenceRun entity.
+func (tru *TaskRecurrenceUpdate) ClearRuns() *TaskRecurrenceUpdate {
+	tru.mutation.ClearRuns()
+	return tru
+}
+
+// RemoveRunIDs removes the "runs" edge to TaskRecurrenceRun entities by IDs.
+func (tru *TaskRecurrenceUpdate) RemoveRunIDs(ids ...int) *TaskRecurrenceUpdate {
+	tru.mutation.RemoveRunIDs(ids...)
+	return tru
+}
+
+// RemoveRuns removes "runs" edges to TaskRecurrenceRun entities.
+func (tru *TaskRecurrenceUpdate) RemoveRuns(t ...*TaskRecurrenceRun) *TaskRecurrenceUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tru.RemoveRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TaskRecurrenceUpdate) Save(ctx context.Context) (int, error) {
+	tru.defaults()
+	return withHooks(ctx, tru.sqlSave, tru.mutation, tru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TaskRecurrenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TaskRecurrenceUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TaskRecurrenceUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tru *TaskRecurrenceUpdate) defaults() {
+	if _, ok := tru.mutation.UpdatedAt(); !ok {
+		v := taskrecurrence.UpdateDefaultUpdatedAt()
+		tru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tru *TaskRecurrenceUpdate) check() error {
+	if v, ok := tru.mutation.Rule(); ok {
+		if err := taskrecurrence.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "TaskRecurrence.rule": %w`, err)}
+		}
+	}
+	if tru.mutation.TemplateCleared() && len(tru.mutation.TemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskRecurrence.template"`)
+	}
+	return nil
+}
+
+func (tru *TaskRecurrenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrecurrence.Table, taskrecurrence.Columns, sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt))
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.Rule(); ok {
+		_spec.SetField(taskrecurrence.FieldRule, field.TypeString, value)
+	}
+	if value, ok := tru.mutation.StartAt(); ok {
+		_spec.SetField(taskrecurrence.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := tru.mutation.Until(); ok {
+		_spec.SetField(taskrecurrence.FieldUntil, field.TypeTime, value)
+	}
+	if tru.mutation.UntilCleared() {
+		_spec.ClearField(taskrecurrence.FieldUntil, field.TypeTime)
+	}
+	if value, ok := tru.mutation.NextRunAt(); ok {
+		_spec.SetField(taskrecurrence.FieldNextRunAt, field.TypeTime, value)
+	}
+	if tru.mutation.NextRunAtCleared() {
+		_spec.ClearField(taskrecurrence.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := tru.mutation.LastRunAt(); ok {
+		_spec.SetField(taskrecurrence.FieldLastRunAt, field.TypeTime, value)
+	}
+	if tru.mutation.LastRunAtCleared() {
+		_spec.ClearField(taskrecurrence.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := tru.mutation.Timezone(); ok {
+		_spec.SetField(taskrecurrence.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := tru.mutation.Enabled(); ok {
+		_spec.SetField(taskrecurrence.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := tru.mutation.CreatorID(); ok {
+		_spec.SetField(taskrecurrence.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.AddedCreatorID(); ok {
+		_spec.AddField(taskrecurrence.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.EmployeeID(); ok {
+		_spec.SetField(taskrecurrence.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(taskrecurrence.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrecurrence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tru.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrence.TemplateTable,
+			Columns: []string{taskrecurrence.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrence.TemplateTable,
+			Columns: []string{taskrecurrence.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tru.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskrecurrence.RunsTable,
+			Columns: []string{taskrecurrence.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.RemovedRunsIDs(); len(nodes) > 0 && !tru.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskrecurrence.RunsTable,
+			Columns: []string{taskrecurrence.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskrecurrence.RunsTable,
+			Columns: []string{taskrecurrence.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrecurrence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tru.mutation.done = true
+	return n, nil
+}
+
+// TaskRecurrenceUpdateOne is the builder for updating a single TaskRecurrence entity.
+type TaskRecurrenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskRecurrenceMutation
+}
+
+// SetTemplateID sets the "template_id" field.
+func (truo *TaskRecurrenceUpdateOne) SetTemplateID(i int) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetTemplateID(i)
+	return truo
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableTemplateID(i *int) *TaskRecurrenceUpdateOne {
+	if i != nil {
+		truo.SetTemplateID(*i)
+	}
+	return truo
+}
+
+// SetRule sets the "rule" field.
+func (truo *TaskRecurrenceUpdateOne) SetRule(s string) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetRule(s)
+	return truo
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableRule(s *string) *TaskRecurrenceUpdateOne {
+	if s != nil {
+		truo.SetRule(*s)
+	}
+	return truo
+}
+
+// SetStartAt sets the "start_at" field.
+func (truo *TaskRecurrenceUpdateOne) SetStartAt(t time.Time) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetStartAt(t)
+	return truo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableStartAt(t *time.Time) *TaskRecurrenceUpdateOne {
+	if t != nil {
+		truo.SetStartAt(*t)
+	}
+	return truo
+}
+
+// SetUntil sets the "until" field.
+func (truo *TaskRecurrenceUpdateOne) SetUntil(t time.Time) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetUntil(t)
+	return truo
+}
+
+// SetNillableUntil sets the "until" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableUntil(t *time.Time) *TaskRecurrenceUpdateOne {
+	if t != nil {
+		truo.SetUntil(*t)
+	}
+	return truo
+}
+
+// ClearUntil clears the value of the "until" field.
+func (truo *TaskRecurrenceUpdateOne) ClearUntil() *TaskRecurrenceUpdateOne {
+	truo.mutation.ClearUntil()
+	return truo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (truo *TaskRecurrenceUpdateOne) SetNextRunAt(t time.Time) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetNextRunAt(t)
+	return truo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableNextRunAt(t *time.Time) *TaskRecurrenceUpdateOne {
+	if t != nil {
+		truo.SetNextRunAt(*t)
+	}
+	return truo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (truo *TaskRecurrenceUpdateOne) ClearNextRunAt() *TaskRecurrenceUpdateOne {
+	truo.mutation.ClearNextRunAt()
+	return truo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (truo *TaskRecurrenceUpdateOne) SetLastRunAt(t time.Time) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetLastRunAt(t)
+	return truo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableLastRunAt(t *time.Time) *TaskRecurrenceUpdateOne {
+	if t != nil {
+		truo.SetLastRunAt(*t)
+	}
+	return truo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (truo *TaskRecurrenceUpdateOne) ClearLastRunAt() *TaskRecurrenceUpdateOne {
+	truo.mutation.ClearLastRunAt()
+	return truo
+}
+
+// SetTimezone sets the "timezone" field.
+func (truo *TaskRecurrenceUpdateOne) SetTimezone(s string) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetTimezone(s)
+	return truo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableTimezone(s *string) *TaskRecurrenceUpdateOne {
+	if s != nil {
+		truo.SetTimezone(*s)
+	}
+	return truo
+}
+
+// SetEnabled sets the "enabled" field.
+func (truo *TaskRecurrenceUpdateOne) SetEnabled(b bool) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetEnabled(b)
+	return truo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableEnabled(b *bool) *TaskRecurrenceUpdateOne {
+	if b != nil {
+		truo.SetEnabled(*b)
+	}
+	return truo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (truo *TaskRecurrenceUpdateOne) SetCreatorID(i int) *TaskRecurrenceUpdateOne {
+	truo.mutation.ResetCreatorID()
+	truo.mutation.SetCreatorID(i)
+	return truo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableCreatorID(i *int) *TaskRecurrenceUpdateOne {
+	if i != nil {
+		truo.SetCreatorID(*i)
+	}
+	return truo
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (truo *TaskRecurrenceUpdateOne) AddCreatorID(i int) *TaskRecurrenceUpdateOne {
+	truo.mutation.AddCreatorID(i)
+	return truo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (truo *TaskRecurrenceUpdateOne) SetEmployeeID(i int) *TaskRecurrenceUpdateOne {
+	truo.mutation.ResetEmployeeID()
+	truo.mutation.SetEmployeeID(i)
+	return truo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (truo *TaskRecurrenceUpdateOne) SetNillableEmployeeID(i *int) *TaskRecurrenceUpdateOne {
+	if i != nil {
+		truo.SetEmployeeID(*i)
+	}
+	return truo
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (truo *TaskRecurrenceUpdateOne) AddEmployeeID(i int) *TaskRecurrenceUpdateOne {
+	truo.mutation.AddEmployeeID(i)
+	return truo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (truo *TaskRecurrenceUpdateOne) SetUpdatedAt(t time.Time) *TaskRecurrenceUpdateOne {
+	truo.mutation.SetUpdatedAt(t)
+	return truo
+}
+
+// SetTemplate sets the "template" edge to the TaskTemplate entity.
+func (truo *TaskRecurrenceUpdateOne) SetTemplate(t *TaskTemplate) *TaskRecurrenceUpdateOne {
+	return truo.SetTemplateID(t.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the TaskRecurrenceRun entity by IDs.
+func (truo *TaskRecurrenceUpdateOne) AddRunIDs(ids ...int) *TaskRecurrenceUpdateOne {
+	truo.mutation.AddRunIDs(ids...)
+	return truo
+}
+
+// AddRuns adds the "runs" edges to the TaskRecurrenceRun entity.
+func (truo *TaskRecurrenceUpdateOne) AddRuns(t ...*TaskRecurrenceRun) *TaskRecurrenceUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return truo.AddRunIDs(ids...)
+}
+
+// Mutation returns the TaskRecurrenceMutation object of the builder.
+func (truo *TaskRecurrenceUpdateOne) Mutation() *TaskRecurrenceMutation {
+	return truo.mutation
+}
+
+// ClearTemplate clears the "template" edge to the TaskTemplate entity.
+func (truo *TaskRecurrenceUpdateOne) ClearTemplate() *TaskRecurrenceUpdateOne {
+	truo.mutation.ClearTemplate()
+	return truo
+}
+
+// ClearRuns clears all "runs" edges to the TaskRecurrenceRun entity.
+func (truo *TaskRecurrenceUpdateOne) ClearRuns() *TaskRecurrenceUpdateOne {
+	truo.mutation.ClearRuns()
+	return truo
+}
+
+// RemoveRunIDs removes the "runs" edge to TaskRecurrenceRun entities by IDs.
+func (truo *TaskRecurrenceUpdateOne) RemoveRunIDs(ids ...int) *TaskRecurrenceUpdateOne {
+	truo.mutation.RemoveRunIDs(ids...)
+	return truo
+}
+
+// RemoveRuns removes "runs" edges to TaskRecurrenceRun entities.
+func (truo *TaskRecurrenceUpdateOne) RemoveRuns(t ...*TaskRecurrenceRun) *TaskRecurrenceUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return truo.RemoveRunIDs(ids...)
+}
+
+// Where appends a list predicates to the TaskRecurrenceUpdate builder.
+func (truo *TaskRecurrenceUpdateOne) Where(ps ...predicate.TaskRecurrence) *TaskRecurrenceUpdateOne {
+	truo.mutation.Where(ps...)
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TaskRecurrenceUpdateOne) Select(field string, fields ...string) *TaskRecurrenceUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TaskRecurrence entity.
+func (truo *TaskRecurrenceUpdateOne) Save(ctx context.Context) (*TaskRecurrence, error) {
+	truo.defaults()
+	return withHooks(ctx, truo.sqlSave, truo.mutation, truo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TaskRecurrenceUpdateOne) SaveX(ctx context.Context) *TaskRecurrence {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TaskRecurrenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TaskRecurrenceUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (truo *TaskRecurrenceUpdateOne) defaults() {
+	if _, ok := truo.mutation.UpdatedAt(); !ok {
+		v := taskrecurrence.UpdateDefaultUpdatedAt()
+		truo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (truo *TaskRecurrenceUpdateOne) check() error {
+	if v, ok := truo.mutation.Rule(); ok {
+		if err := taskrecurrence.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "TaskRecurrence.rule": %w`, err)}
+		}
+	}
+	if truo.mutation.TemplateCleared() && len(truo.mutation.TemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskRecurrence.template"`)
+	}
+	return nil
+}
+
+func (truo *TaskRecurrenceUpdateOne) sqlSave(ctx context.Context) (_node *TaskRecurrence, err error) {
+	if err := truo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrecurrence.Table, taskrecurrence.Columns, sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt))
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskRecurrence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrecurrence.FieldID)
+		for _, f := range fields {
+			if !taskrecurrence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskrecurrence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.Rule(); ok {
+		_spec.SetField(taskrecurrence.FieldRule, field.TypeString, value)
+	}
+	if value, ok := truo.mutation.StartAt(); ok {
+		_spec.SetField(taskrecurrence.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := truo.mutation.Until(); ok {
+		_spec.SetField(taskrecurrence.FieldUntil, field.TypeTime, value)
+	}
+	if truo.mutation.UntilCleared() {
+		_spec.ClearField(taskrecurrence.FieldUntil, field.TypeTime)
+	}
+	if value, ok := truo.mutation.NextRunAt(); ok {
+		_spec.SetField(taskrecurrence.FieldNextRunAt, field.TypeTime, value)
+	}
+	if truo.mutation.NextRunAtCleared() {
+		_spec.ClearField(taskrecurrence.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := truo.mutation.LastRunAt(); ok {
+		_spec.SetField(taskrecurrence.FieldLastRunAt, field.TypeTime, value)
+	}
+	if truo.mutation.LastRunAtCleared() {
+		_spec.ClearField(taskrecurrence.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := truo.mutation.Timezone(); ok {
+		_spec.SetField(taskrecurrence.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := truo.mutation.Enabled(); ok {
+		_spec.SetField(taskrecurrence.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := truo.mutation.CreatorID(); ok {
+		_spec.SetField(taskrecurrence.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.AddedCreatorID(); ok {
+		_spec.AddField(taskrecurrence.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.EmployeeID(); ok {
+		_spec.SetField(taskrecurrence.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(taskrecurrence.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrecurrence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if truo.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrence.TemplateTable,
+			Columns: []string{taskrecurrence.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrence.TemplateTable,
+			Columns: []string{taskrecurrence.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if truo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskrecurrence.RunsTable,
+			Columns: []string{taskrecurrence.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.RemovedRunsIDs(); len(nodes) > 0 && !truo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskrecurrence.RunsTable,
+			Columns: []string{taskrecurrence.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   taskrecurrence.RunsTable,
+			Columns: []string{taskrecurrence.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskRecurrence{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrecurrence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	truo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+)
+
+// TaskRecurrenceRun is the model entity for the TaskRecurrenceRun schema.
+type TaskRecurrenceRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RecurrenceID holds the value of the "recurrence_id" field.
+	RecurrenceID int `json:"recurrence_id"`
+	// OccurrenceAt holds the value of the "occurrence_at" field.
+	OccurrenceAt time.Time `json:"occurrence_at"`
+	// Status holds the value of the "status" field.
+	Status taskrecurrencerun.Status `json:"status"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID *int `json:"task_id"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskRecurrenceRunQuery when eager-loading is set.
+	Edges        TaskRecurrenceRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskRecurrenceRunEdges holds the relations/edges for other nodes in the graph.
+type TaskRecurrenceRunEdges struct {
+	// Recurrence holds the value of the recurrence edge.
+	Recurrence *TaskRecurrence `json:"recurrence"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RecurrenceOrErr returns the Recurrence value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskRecurrenceRunEdges) RecurrenceOrErr() (*TaskRecurrence, error) {
+	if e.Recurrence != nil {
+		return e.Recurrence, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: taskrecurrence.Label}
+	}
+	return nil, &NotLoadedError{edge: "recurrence"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskRecurrenceRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskrecurrencerun.FieldID, taskrecurrencerun.FieldRecurrenceID, taskrecurrencerun.FieldTaskID, taskrecurrencerun.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case taskrecurrencerun.FieldStatus, taskrecurrencerun.FieldError:
+			values[i] = new(sql.NullString)
+		case taskrecurrencerun.FieldOccurrenceAt, taskrecurrencerun.FieldCreatedAt, taskrecurrencerun.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskRecurrenceRun fields.
+func (trr *TaskRecurrenceRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskrecurrencerun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			trr.ID = int(value.Int64)
+		case taskrecurrencerun.FieldRecurrenceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_id", values[i])
+			} else if value.Valid {
+				trr.RecurrenceID = int(value.Int64)
+			}
+		case taskrecurrencerun.FieldOccurrenceAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurrence_at", values[i])
+			} else if value.Valid {
+				trr.OccurrenceAt = value.Time
+			}
+		case taskrecurrencerun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				trr.Status = taskrecurrencerun.Status(value.String)
+			}
+		case taskrecurrencerun.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				trr.TaskID = new(int)
+				*trr.TaskID = int(value.Int64)
+			}
+		case taskrecurrencerun.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				trr.Attempts = int(value.Int64)
+			}
+		case taskrecurrencerun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				trr.Error = value.String
+			}
+		case taskrecurrencerun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				trr.CreatedAt = value.Time
+			}
+		case taskrecurrencerun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				trr.UpdatedAt = value.Time
+			}
+		default:
+			trr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskRecurrenceRun.
+// This includes values selected through modifiers, order, etc.
+func (trr *TaskRecurrenceRun) Value(name string) (ent.Value, error) {
+	return trr.selectValues.Get(name)
+}
+
+// QueryRecurrence queries the "recurrence" edge of the TaskRecurrenceRun entity.
+func (trr *TaskRecurrenceRun) QueryRecurrence() *TaskRecurrenceQuery {
+	return NewTaskRecurrenceRunClient(trr.config).QueryRecurrence(trr)
+}
+
+// Update returns a builder for updating this TaskRecurrenceRun.
+// Note that you need to call TaskRecurrenceRun.Unwrap() before calling this method if this TaskRecurrenceRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (trr *TaskRecurrenceRun) Update() *TaskRecurrenceRunUpdateOne {
+	return NewTaskRecurrenceRunClient(trr.config).UpdateOne(trr)
+}
+
+// Unwrap unwraps the TaskRecurrenceRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (trr *TaskRecurrenceRun) Unwrap() *TaskRecurrenceRun {
+	_tx, ok := trr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskRecurrenceRun is not a transactional entity")
+	}
+	trr.config.driver = _tx.drv
+	return trr
+}
+
+// String implements the fmt.Stringer.
+func (trr *TaskRecurrenceRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskRecurrenceRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", trr.ID))
+	builder.WriteString("recurrence_id=")
+	builder.WriteString(fmt.Sprintf("%v", trr.RecurrenceID))
+	builder.WriteString(", ")
+	builder.WriteString("occurrence_at=")
+	builder.WriteString(trr.OccurrenceAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", trr.Status))
+	builder.WriteString(", ")
+	if v := trr.TaskID; v != nil {
+		builder.WriteString("task_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", trr.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(trr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(trr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(trr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskRecurrenceRuns is a parsable slice of TaskRecurrenceRun.
+type TaskRecurrenceRuns []*TaskRecurrenceRun
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskrecurrencerun
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the taskrecurrencerun type in the database.
+	Label = "task_recurrence_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRecurrenceID holds the string denoting the recurrence_id field in the database.
+	FieldRecurrenceID = "recurrence_id"
+	// FieldOccurrenceAt holds the string denoting the occurrence_at field in the database.
+	FieldOccurrenceAt = "occurrence_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeRecurrence holds the string denoting the recurrence edge name in mutations.
+	EdgeRecurrence = "recurrence"
+	// Table holds the table name of the taskrecurrencerun in the database.
+	Table = "task_recurrence_runs"
+	// RecurrenceTable is the table that holds the recurrence relation/edge.
+	RecurrenceTable = "task_recurrence_runs"
+	// RecurrenceInverseTable is the table name for the TaskRecurrence entity.
+	// It exists in this package in order to avoid circular dependency with the "taskrecurrence" package.
+	RecurrenceInverseTable = "task_recurrences"
+	// RecurrenceColumn is the table column denoting the recurrence relation/edge.
+	RecurrenceColumn = "recurrence_id"
+)
+
+// Columns holds all SQL columns for taskrecurrencerun fields.
+var Columns = []string{
+	FieldID,
+	FieldRecurrenceID,
+	FieldOccurrenceAt,
+	FieldStatus,
+	FieldTaskID,
+	FieldAttempts,
+	FieldError,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending Status = "pending"
+	StatusCreated Status = "created"
+	StatusFailed  Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusCreated, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("taskrecurrencerun: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the TaskRecurrenceRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRecurrenceID orders the results by the recurrence_id field.
+func ByRecurrenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrenceID, opts...).ToFunc()
+}
+
+// ByOccurrenceAt orders the results by the occurrence_at field.
+func ByOccurrenceAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurrenceAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByRecurrenceField orders the results by recurrence field.
+func ByRecurrenceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurrenceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRecurrenceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurrenceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RecurrenceTable, RecurrenceColumn),
+	)
+}
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskrecurrencerun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLTE(FieldID, id))
+}
+
+// RecurrenceID applies equality check predicate on the "recurrence_id" field. It's identical to RecurrenceIDEQ.
+func RecurrenceID(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldRecurrenceID, v))
+}
+
+// OccurrenceAt applies equality check predicate on the "occurrence_at" field. It's identical to OccurrenceAtEQ.
+func OccurrenceAt(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldOccurrenceAt, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldTaskID, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldAttempts, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RecurrenceIDEQ applies the EQ predicate on the "recurrence_id" field.
+func RecurrenceIDEQ(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldRecurrenceID, v))
+}
+
+// RecurrenceIDNEQ applies the NEQ predicate on the "recurrence_id" field.
+func RecurrenceIDNEQ(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldRecurrenceID, v))
+}
+
+// RecurrenceIDIn applies the In predicate on the "recurrence_id" field.
+func RecurrenceIDIn(vs ...int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldRecurrenceID, vs...))
+}
+
+// RecurrenceIDNotIn applies the NotIn predicate on the "recurrence_id" field.
+func RecurrenceIDNotIn(vs ...int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldRecurrenceID, vs...))
+}
+
+// OccurrenceAtEQ applies the EQ predicate on the "occurrence_at" field.
+func OccurrenceAtEQ(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtNEQ applies the NEQ predicate on the "occurrence_at" field.
+func OccurrenceAtNEQ(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtIn applies the In predicate on the "occurrence_at" field.
+func OccurrenceAtIn(vs ...time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldOccurrenceAt, vs...))
+}
+
+// OccurrenceAtNotIn applies the NotIn predicate on the "occurrence_at" field.
+func OccurrenceAtNotIn(vs ...time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldOccurrenceAt, vs...))
+}
+
+// OccurrenceAtGT applies the GT predicate on the "occurrence_at" field.
+func OccurrenceAtGT(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGT(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtGTE applies the GTE predicate on the "occurrence_at" field.
+func OccurrenceAtGTE(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGTE(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtLT applies the LT predicate on the "occurrence_at" field.
+func OccurrenceAtLT(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLT(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtLTE applies the LTE predicate on the "occurrence_at" field.
+func OccurrenceAtLTE(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLTE(FieldOccurrenceAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDIsNil applies the IsNil predicate on the "task_id" field.
+func TaskIDIsNil() predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIsNull(FieldTaskID))
+}
+
+// TaskIDNotNil applies the NotNil predicate on the "task_id" field.
+func TaskIDNotNil() predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotNull(FieldTaskID))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasRecurrence applies the HasEdge predicate on the "recurrence" edge.
+func HasRecurrence() predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RecurrenceTable, RecurrenceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecurrenceWith applies the HasEdge predicate on the "recurrence" edge with a given conditions (other predicates).
+func HasRecurrenceWith(preds ...predicate.TaskRecurrence) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(func(s *sql.Selector) {
+		step := newRecurrenceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskRecurrenceRun) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskRecurrenceRun) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskRecurrenceRun) predicate.TaskRecurrenceRun {
+	return predicate.TaskRecurrenceRun(sql.NotPredicates(p))
+}
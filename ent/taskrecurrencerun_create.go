@@ -0,0 +1,969 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+)
+
+// TaskRecurrenceRunCreate is the builder for creating a TaskRecurrenceRun entity.
+type TaskRecurrenceRunCreate struct {
+	config
+	mutation *TaskRecurrenceRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRecurrenceID sets the "recurrence_id" field.
+func (trrc *TaskRecurrenceRunCreate) SetRecurrenceID(i int) *TaskRecurrenceRunCreate {
+	trrc.mutation.SetRecurrenceID(i)
+	return trrc
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (trrc *TaskRecurrenceRunCreate) SetOccurrenceAt(t time.Time) *TaskRecurrenceRunCreate {
+	trrc.mutation.SetOccurrenceAt(t)
+	return trrc
+}
+
+// SetStatus sets the "status" field.
+func (trrc *TaskRecurrenceRunCreate) SetStatus(t taskrecurrencerun.Status) *TaskRecurrenceRunCreate {
+	trrc.mutation.SetStatus(t)
+	return trrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (trrc *TaskRecurrenceRunCreate) SetNillableStatus(t *taskrecurrencerun.Status) *TaskRecurrenceRunCreate {
+	if t != nil {
+		trrc.SetStatus(*t)
+	}
+	return trrc
+}
+
+// SetTaskID sets the "task_id" field.
+func (trrc *TaskRecurrenceRunCreate) SetTaskID(i int) *TaskRecurrenceRunCreate {
+	trrc.mutation.SetTaskID(i)
+	return trrc
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (trrc *TaskRecurrenceRunCreate) SetNillableTaskID(i *int) *TaskRecurrenceRunCreate {
+	if i != nil {
+		trrc.SetTaskID(*i)
+	}
+	return trrc
+}
+
+// SetAttempts sets the "attempts" field.
+func (trrc *TaskRecurrenceRunCreate) SetAttempts(i int) *TaskRecurrenceRunCreate {
+	trrc.mutation.SetAttempts(i)
+	return trrc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (trrc *TaskRecurrenceRunCreate) SetNillableAttempts(i *int) *TaskRecurrenceRunCreate {
+	if i != nil {
+		trrc.SetAttempts(*i)
+	}
+	return trrc
+}
+
+// SetError sets the "error" field.
+func (trrc *TaskRecurrenceRunCreate) SetError(s string) *TaskRecurrenceRunCreate {
+	trrc.mutation.SetError(s)
+	return trrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (trrc *TaskRecurrenceRunCreate) SetNillableError(s *string) *TaskRecurrenceRunCreate {
+	if s != nil {
+		trrc.SetError(*s)
+	}
+	return trrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (trrc *TaskRecurrenceRunCreate) SetCreatedAt(t time.Time) *TaskRecurrenceRunCreate {
+	trrc.mutation.SetCreatedAt(t)
+	return trrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (trrc *TaskRecurrenceRunCreate) SetNillableCreatedAt(t *time.Time) *TaskRecurrenceRunCreate {
+	if t != nil {
+		trrc.SetCreatedAt(*t)
+	}
+	return trrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trrc *TaskRecurrenceRunCreate) SetUpdatedAt(t time.Time) *TaskRecurrenceRunCreate {
+	trrc.mutation.SetUpdatedAt(t)
+	return trrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (trrc *TaskRecurrenceRunCreate) SetNillableUpdatedAt(t *time.Time) *TaskRecurrenceRunCreate {
+	if t != nil {
+		trrc.SetUpdatedAt(*t)
+	}
+	return trrc
+}
+
+// SetRecurrence sets the "recurrence" edge to the TaskRecurrence entity.
+func (trrc *TaskRecurrenceRunCreate) SetRecurrence(t *TaskRecurrence) *TaskRecurrenceRunCreate {
+	return trrc.SetRecurrenceID(t.ID)
+}
+
+// Mutation returns the TaskRecurrenceRunMutation object of the builder.
+func (trrc *TaskRecurrenceRunCreate) Mutation() *TaskRecurrenceRunMutation {
+	return trrc.mutation
+}
+
+// Save creates the TaskRecurrenceRun in the database.
+func (trrc *TaskRecurrenceRunCreate) Save(ctx context.Context) (*TaskRecurrenceRun, error) {
+	trrc.defaults()
+	return withHooks(ctx, trrc.sqlSave, trrc.mutation, trrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trrc *TaskRecurrenceRunCreate) SaveX(ctx context.Context) *TaskRecurrenceRun {
+	v, err := trrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trrc *TaskRecurrenceRunCreate) Exec(ctx context.Context) error {
+	_, err := trrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trrc *TaskRecurrenceRunCreate) ExecX(ctx context.Context) {
+	if err := trrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trrc *TaskRecurrenceRunCreate) defaults() {
+	if _, ok := trrc.mutation.Status(); !ok {
+		v := taskrecurrencerun.DefaultStatus
+		trrc.mutation.SetStatus(v)
+	}
+	if _, ok := trrc.mutation.Attempts(); !ok {
+		v := taskrecurrencerun.DefaultAttempts
+		trrc.mutation.SetAttempts(v)
+	}
+	if _, ok := trrc.mutation.CreatedAt(); !ok {
+		v := taskrecurrencerun.DefaultCreatedAt()
+		trrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := trrc.mutation.UpdatedAt(); !ok {
+		v := taskrecurrencerun.DefaultUpdatedAt()
+		trrc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trrc *TaskRecurrenceRunCreate) check() error {
+	if _, ok := trrc.mutation.RecurrenceID(); !ok {
+		return &ValidationError{Name: "recurrence_id", err: errors.New(`ent: missing required field "TaskRecurrenceRun.recurrence_id"`)}
+	}
+	if _, ok := trrc.mutation.OccurrenceAt(); !ok {
+		return &ValidationError{Name: "occurrence_at", err: errors.New(`ent: missing required field "TaskRecurrenceRun.occurrence_at"`)}
+	}
+	if _, ok := trrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TaskRecurrenceRun.status"`)}
+	}
+	if v, ok := trrc.mutation.Status(); ok {
+		if err := taskrecurrencerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRecurrenceRun.status": %w`, err)}
+		}
+	}
+	if _, ok := trrc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "TaskRecurrenceRun.attempts"`)}
+	}
+	if _, ok := trrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskRecurrenceRun.created_at"`)}
+	}
+	if _, ok := trrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaskRecurrenceRun.updated_at"`)}
+	}
+	if len(trrc.mutation.RecurrenceIDs()) == 0 {
+		return &ValidationError{Name: "recurrence", err: errors.New(`ent: missing required edge "TaskRecurrenceRun.recurrence"`)}
+	}
+	return nil
+}
+
+func (trrc *TaskRecurrenceRunCreate) sqlSave(ctx context.Context) (*TaskRecurrenceRun, error) {
+	if err := trrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	trrc.mutation.id = &_node.ID
+	trrc.mutation.done = true
+	return _node, nil
+}
+
+func (trrc *TaskRecurrenceRunCreate) createSpec() (*TaskRecurrenceRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskRecurrenceRun{config: trrc.config}
+		_spec = sqlgraph.NewCreateSpec(taskrecurrencerun.Table, sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = trrc.conflict
+	if value, ok := trrc.mutation.OccurrenceAt(); ok {
+		_spec.SetField(taskrecurrencerun.FieldOccurrenceAt, field.TypeTime, value)
+		_node.OccurrenceAt = value
+	}
+	if value, ok := trrc.mutation.Status(); ok {
+		_spec.SetField(taskrecurrencerun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := trrc.mutation.TaskID(); ok {
+		_spec.SetField(taskrecurrencerun.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = &value
+	}
+	if value, ok := trrc.mutation.Attempts(); ok {
+		_spec.SetField(taskrecurrencerun.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := trrc.mutation.Error(); ok {
+		_spec.SetField(taskrecurrencerun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := trrc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskrecurrencerun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := trrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrecurrencerun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := trrc.mutation.RecurrenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrencerun.RecurrenceTable,
+			Columns: []string{taskrecurrencerun.RecurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RecurrenceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRecurrenceRun.Create().
+//		SetRecurrenceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRecurrenceRunUpsert) {
+//			SetRecurrenceID(v+v).
+//		}).
+//		Exec(ctx)
+func (trrc *TaskRecurrenceRunCreate) OnConflict(opts ...sql.ConflictOption) *TaskRecurrenceRunUpsertOne {
+	trrc.conflict = opts
+	return &TaskRecurrenceRunUpsertOne{
+		create: trrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRecurrenceRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trrc *TaskRecurrenceRunCreate) OnConflictColumns(columns ...string) *TaskRecurrenceRunUpsertOne {
+	trrc.conflict = append(trrc.conflict, sql.ConflictColumns(columns...))
+	return &TaskRecurrenceRunUpsertOne{
+		create: trrc,
+	}
+}
+
+type (
+	// TaskRecurrenceRunUpsertOne is the builder for "upsert"-ing
+	//  one TaskRecurrenceRun node.
+	TaskRecurrenceRunUpsertOne struct {
+		create *TaskRecurrenceRunCreate
+	}
+
+	// TaskRecurrenceRunUpsert is the "OnConflict" setter.
+	TaskRecurrenceRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRecurrenceID sets the "recurrence_id" field.
+func (u *TaskRecurrenceRunUpsert) SetRecurrenceID(v int) *TaskRecurrenceRunUpsert {
+	u.Set(taskrecurrencerun.FieldRecurrenceID, v)
+	return u
+}
+
+// UpdateRecurrenceID sets the "recurrence_id" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsert) UpdateRecurrenceID() *TaskRecurrenceRunUpsert {
+	u.SetExcluded(taskrecurrencerun.FieldRecurrenceID)
+	return u
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (u *TaskRecurrenceRunUpsert) SetOccurrenceAt(v time.Time) *TaskRecurrenceRunUpsert {
+	u.Set(taskrecurrencerun.FieldOccurrenceAt, v)
+	return u
+}
+
+// UpdateOccurrenceAt sets the "occurrence_at" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsert) UpdateOccurrenceAt() *TaskRecurrenceRunUpsert {
+	u.SetExcluded(taskrecurrencerun.FieldOccurrenceAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRecurrenceRunUpsert) SetStatus(v taskrecurrencerun.Status) *TaskRecurrenceRunUpsert {
+	u.Set(taskrecurrencerun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsert) UpdateStatus() *TaskRecurrenceRunUpsert {
+	u.SetExcluded(taskrecurrencerun.FieldStatus)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRecurrenceRunUpsert) SetTaskID(v int) *TaskRecurrenceRunUpsert {
+	u.Set(taskrecurrencerun.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsert) UpdateTaskID() *TaskRecurrenceRunUpsert {
+	u.SetExcluded(taskrecurrencerun.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRecurrenceRunUpsert) AddTaskID(v int) *TaskRecurrenceRunUpsert {
+	u.Add(taskrecurrencerun.FieldTaskID, v)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRecurrenceRunUpsert) ClearTaskID() *TaskRecurrenceRunUpsert {
+	u.SetNull(taskrecurrencerun.FieldTaskID)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *TaskRecurrenceRunUpsert) SetAttempts(v int) *TaskRecurrenceRunUpsert {
+	u.Set(taskrecurrencerun.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsert) UpdateAttempts() *TaskRecurrenceRunUpsert {
+	u.SetExcluded(taskrecurrencerun.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *TaskRecurrenceRunUpsert) AddAttempts(v int) *TaskRecurrenceRunUpsert {
+	u.Add(taskrecurrencerun.FieldAttempts, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *TaskRecurrenceRunUpsert) SetError(v string) *TaskRecurrenceRunUpsert {
+	u.Set(taskrecurrencerun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsert) UpdateError() *TaskRecurrenceRunUpsert {
+	u.SetExcluded(taskrecurrencerun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TaskRecurrenceRunUpsert) ClearError() *TaskRecurrenceRunUpsert {
+	u.SetNull(taskrecurrencerun.FieldError)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRecurrenceRunUpsert) SetUpdatedAt(v time.Time) *TaskRecurrenceRunUpsert {
+	u.Set(taskrecurrencerun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsert) UpdateUpdatedAt() *TaskRecurrenceRunUpsert {
+	u.SetExcluded(taskrecurrencerun.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskRecurrenceRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskRecurrenceRunUpsertOne) UpdateNewValues() *TaskRecurrenceRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskrecurrencerun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRecurrenceRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskRecurrenceRunUpsertOne) Ignore() *TaskRecurrenceRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRecurrenceRunUpsertOne) DoNothing() *TaskRecurrenceRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRecurrenceRunCreate.OnConflict
+// documentation for more info.
+func (u *TaskRecurrenceRunUpsertOne) Update(set func(*TaskRecurrenceRunUpsert)) *TaskRecurrenceRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRecurrenceRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRecurrenceID sets the "recurrence_id" field.
+func (u *TaskRecurrenceRunUpsertOne) SetRecurrenceID(v int) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetRecurrenceID(v)
+	})
+}
+
+// UpdateRecurrenceID sets the "recurrence_id" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertOne) UpdateRecurrenceID() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateRecurrenceID()
+	})
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (u *TaskRecurrenceRunUpsertOne) SetOccurrenceAt(v time.Time) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetOccurrenceAt(v)
+	})
+}
+
+// UpdateOccurrenceAt sets the "occurrence_at" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertOne) UpdateOccurrenceAt() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateOccurrenceAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRecurrenceRunUpsertOne) SetStatus(v taskrecurrencerun.Status) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertOne) UpdateStatus() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRecurrenceRunUpsertOne) SetTaskID(v int) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRecurrenceRunUpsertOne) AddTaskID(v int) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertOne) UpdateTaskID() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRecurrenceRunUpsertOne) ClearTaskID() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *TaskRecurrenceRunUpsertOne) SetAttempts(v int) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *TaskRecurrenceRunUpsertOne) AddAttempts(v int) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertOne) UpdateAttempts() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *TaskRecurrenceRunUpsertOne) SetError(v string) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertOne) UpdateError() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TaskRecurrenceRunUpsertOne) ClearError() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRecurrenceRunUpsertOne) SetUpdatedAt(v time.Time) *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertOne) UpdateUpdatedAt() *TaskRecurrenceRunUpsertOne {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRecurrenceRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRecurrenceRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRecurrenceRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskRecurrenceRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskRecurrenceRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskRecurrenceRunCreateBulk is the builder for creating many TaskRecurrenceRun entities in bulk.
+type TaskRecurrenceRunCreateBulk struct {
+	config
+	err      error
+	builders []*TaskRecurrenceRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskRecurrenceRun entities in the database.
+func (trrcb *TaskRecurrenceRunCreateBulk) Save(ctx context.Context) ([]*TaskRecurrenceRun, error) {
+	if trrcb.err != nil {
+		return nil, trrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trrcb.builders))
+	nodes := make([]*TaskRecurrenceRun, len(trrcb.builders))
+	mutators := make([]Mutator, len(trrcb.builders))
+	for i := range trrcb.builders {
+		func(i int, root context.Context) {
+			builder := trrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskRecurrenceRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = trrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trrcb *TaskRecurrenceRunCreateBulk) SaveX(ctx context.Context) []*TaskRecurrenceRun {
+	v, err := trrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trrcb *TaskRecurrenceRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := trrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trrcb *TaskRecurrenceRunCreateBulk) ExecX(ctx context.Context) {
+	if err := trrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRecurrenceRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRecurrenceRunUpsert) {
+//			SetRecurrenceID(v+v).
+//		}).
+//		Exec(ctx)
+func (trrcb *TaskRecurrenceRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskRecurrenceRunUpsertBulk {
+	trrcb.conflict = opts
+	return &TaskRecurrenceRunUpsertBulk{
+		create: trrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRecurrenceRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trrcb *TaskRecurrenceRunCreateBulk) OnConflictColumns(columns ...string) *TaskRecurrenceRunUpsertBulk {
+	trrcb.conflict = append(trrcb.conflict, sql.ConflictColumns(columns...))
+	return &TaskRecurrenceRunUpsertBulk{
+		create: trrcb,
+	}
+}
+
+// TaskRecurrenceRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskRecurrenceRun nodes.
+type TaskRecurrenceRunUpsertBulk struct {
+	create *TaskRecurrenceRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskRecurrenceRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskRecurrenceRunUpsertBulk) UpdateNewValues() *TaskRecurrenceRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskrecurrencerun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRecurrenceRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskRecurrenceRunUpsertBulk) Ignore() *TaskRecurrenceRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRecurrenceRunUpsertBulk) DoNothing() *TaskRecurrenceRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRecurrenceRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskRecurrenceRunUpsertBulk) Update(set func(*TaskRecurrenceRunUpsert)) *TaskRecurrenceRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRecurrenceRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRecurrenceID sets the "recurrence_id" field.
+func (u *TaskRecurrenceRunUpsertBulk) SetRecurrenceID(v int) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetRecurrenceID(v)
+	})
+}
+
+// UpdateRecurrenceID sets the "recurrence_id" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertBulk) UpdateRecurrenceID() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateRecurrenceID()
+	})
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (u *TaskRecurrenceRunUpsertBulk) SetOccurrenceAt(v time.Time) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetOccurrenceAt(v)
+	})
+}
+
+// UpdateOccurrenceAt sets the "occurrence_at" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertBulk) UpdateOccurrenceAt() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateOccurrenceAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRecurrenceRunUpsertBulk) SetStatus(v taskrecurrencerun.Status) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertBulk) UpdateStatus() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRecurrenceRunUpsertBulk) SetTaskID(v int) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRecurrenceRunUpsertBulk) AddTaskID(v int) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertBulk) UpdateTaskID() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRecurrenceRunUpsertBulk) ClearTaskID() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *TaskRecurrenceRunUpsertBulk) SetAttempts(v int) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *TaskRecurrenceRunUpsertBulk) AddAttempts(v int) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertBulk) UpdateAttempts() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *TaskRecurrenceRunUpsertBulk) SetError(v string) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertBulk) UpdateError() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *TaskRecurrenceRunUpsertBulk) ClearError() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRecurrenceRunUpsertBulk) SetUpdatedAt(v time.Time) *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRecurrenceRunUpsertBulk) UpdateUpdatedAt() *TaskRecurrenceRunUpsertBulk {
+	return u.Update(func(s *TaskRecurrenceRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRecurrenceRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskRecurrenceRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRecurrenceRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRecurrenceRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
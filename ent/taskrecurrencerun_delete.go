@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+)
+
+// TaskRecurrenceRunDelete is the builder for deleting a TaskRecurrenceRun entity.
+type TaskRecurrenceRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskRecurrenceRunMutation
+}
+
+// Where appends a list predicates to the TaskRecurrenceRunDelete builder.
+func (trrd *TaskRecurrenceRunDelete) Where(ps ...predicate.TaskRecurrenceRun) *TaskRecurrenceRunDelete {
+	trrd.mutation.Where(ps...)
+	return trrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (trrd *TaskRecurrenceRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, trrd.sqlExec, trrd.mutation, trrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trrd *TaskRecurrenceRunDelete) ExecX(ctx context.Context) int {
+	n, err := trrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (trrd *TaskRecurrenceRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskrecurrencerun.Table, sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt))
+	if ps := trrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, trrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	trrd.mutation.done = true
+	return affected, err
+}
+
+// TaskRecurrenceRunDeleteOne is the builder for deleting a single TaskRecurrenceRun entity.
+type TaskRecurrenceRunDeleteOne struct {
+	trrd *TaskRecurrenceRunDelete
+}
+
+// Where appends a list predicates to the TaskRecurrenceRunDelete builder.
+func (trrdo *TaskRecurrenceRunDeleteOne) Where(ps ...predicate.TaskRecurrenceRun) *TaskRecurrenceRunDeleteOne {
+	trrdo.trrd.mutation.Where(ps...)
+	return trrdo
+}
+
+// Exec executes the deletion query.
+func (trrdo *TaskRecurrenceRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := trrdo.trrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskrecurrencerun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trrdo *TaskRecurrenceRunDeleteOne) ExecX(ctx context.Context) {
+	if err := trrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
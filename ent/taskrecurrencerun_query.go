@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+)
+
+// TaskRecurrenceRunQuery is the builder for querying TaskRecurrenceRun entities.
+type TaskRecurrenceRunQuery struct {
+	config
+	ctx            *QueryContext
+	order          []taskrecurrencerun.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.TaskRecurrenceRun
+	withRecurrence *TaskRecurrenceQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskRecurrenceRunQuery builder.
+func (trrq *TaskRecurrenceRunQuery) Where(ps ...predicate.TaskRecurrenceRun) *TaskRecurrenceRunQuery {
+	trrq.predicates = append(trrq.predicates, ps...)
+	return trrq
+}
+
+// Limit the number of records to be returned by this query.
+func (trrq *TaskRecurrenceRunQuery) Limit(limit int) *TaskRecurrenceRunQuery {
+	trrq.ctx.Limit = &limit
+	return trrq
+}
+
+// Offset to start from.
+func (trrq *TaskRecurrenceRunQuery) Offset(offset int) *TaskRecurrenceRunQuery {
+	trrq.ctx.Offset = &offset
+	return trrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trrq *TaskRecurrenceRunQuery) Unique(unique bool) *TaskRecurrenceRunQuery {
+	trrq.ctx.Unique = &unique
+	return trrq
+}
+
+// Order specifies how the records should be ordered.
+func (trrq *TaskRecurrenceRunQuery) Order(o ...taskrecurrencerun.OrderOption) *TaskRecurrenceRunQuery {
+	trrq.order = append(trrq.order, o...)
+	return trrq
+}
+
+// QueryRecurrence chains the current query on the "recurrence" edge.
+func (trrq *TaskRecurrenceRunQuery) QueryRecurrence() *TaskRecurrenceQuery {
+	query := (&TaskRecurrenceClient{config: trrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskrecurrencerun.Table, taskrecurrencerun.FieldID, selector),
+			sqlgraph.To(taskrecurrence.Table, taskrecurrence.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskrecurrencerun.RecurrenceTable, taskrecurrencerun.RecurrenceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskRecurrenceRun entity from the query.
+// Returns a *NotFoundError when no TaskRecurrenceRun was found.
+func (trrq *TaskRecurrenceRunQuery) First(ctx context.Context) (*TaskRecurrenceRun, error) {
+	nodes, err := trrq.Limit(1).All(setContextOp(ctx, trrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskrecurrencerun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trrq *TaskRecurrenceRunQuery) FirstX(ctx context.Context) *TaskRecurrenceRun {
+	node, err := trrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskRecurrenceRun ID from the query.
+// Returns a *NotFoundError when no TaskRecurrenceRun ID was found.
+func (trrq *TaskRecurrenceRunQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trrq.Limit(1).IDs(setContextOp(ctx, trrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskrecurrencerun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trrq *TaskRecurrenceRunQuery) FirstIDX(ctx context.Context) int {
+	id, err := trrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskRecurrenceRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskRecurrenceRun entity is found.
+// Returns a *NotFoundError when no TaskRecurrenceRun entities are found.
+func (trrq *TaskRecurrenceRunQuery) Only(ctx context.Context) (*TaskRecurrenceRun, error) {
+	nodes, err := trrq.Limit(2).All(setContextOp(ctx, trrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskrecurrencerun.Label}
+	default:
+		return nil, &NotSingularError{taskrecurrencerun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trrq *TaskRecurrenceRunQuery) OnlyX(ctx context.Context) *TaskRecurrenceRun {
+	node, err := trrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskRecurrenceRun ID in the query.
+// Returns a *NotSingularError when more than one TaskRecurrenceRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trrq *TaskRecurrenceRunQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trrq.Limit(2).IDs(setContextOp(ctx, trrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskrecurrencerun.Label}
+	default:
+		err = &NotSingularError{taskrecurrencerun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trrq *TaskRecurrenceRunQuery) OnlyIDX(ctx context.Context) int {
+	id, err := trrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskRecurrenceRuns.
+func (trrq *TaskRecurrenceRunQuery) All(ctx context.Context) ([]*TaskRecurrenceRun, error) {
+	ctx = setContextOp(ctx, trrq.ctx, ent.OpQueryAll)
+	if err := trrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskRecurrenceRun, *TaskRecurrenceRunQuery]()
+	return withInterceptors[[]*TaskRecurrenceRun](ctx, trrq, qr, trrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trrq *TaskRecurrenceRunQuery) AllX(ctx context.Context) []*TaskRecurrenceRun {
+	nodes, err := trrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskRecurrenceRun IDs.
+func (trrq *TaskRecurrenceRunQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if trrq.ctx.Unique == nil && trrq.path != nil {
+		trrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, trrq.ctx, ent.OpQueryIDs)
+	if err = trrq.Select(taskrecurrencerun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trrq *TaskRecurrenceRunQuery) IDsX(ctx context.Context) []int {
+	ids, err := trrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trrq *TaskRecurrenceRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, trrq.ctx, ent.OpQueryCount)
+	if err := trrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, trrq, querierCount[*TaskRecurrenceRunQuery](), trrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trrq *TaskRecurrenceRunQuery) CountX(ctx context.Context) int {
+	count, err := trrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trrq *TaskRecurrenceRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, trrq.ctx, ent.OpQueryExist)
+	switch _, err := trrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trrq *TaskRecurrenceRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := trrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskRecurrenceRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trrq *TaskRecurrenceRunQuery) Clone() *TaskRecurrenceRunQuery {
+	if trrq == nil {
+		return nil
+	}
+	return &TaskRecurrenceRunQuery{
+		config:         trrq.config,
+		ctx:            trrq.ctx.Clone(),
+		order:          append([]taskrecurrencerun.OrderOption{}, trrq.order...),
+		inters:         append([]Interceptor{}, trrq.inters...),
+		predicates:     append([]predicate.TaskRecurrenceRun{}, trrq.predicates...),
+		withRecurrence: trrq.withRecurrence.Clone(),
+		// clone intermediate query.
+		sql:  trrq.sql.Clone(),
+		path: trrq.path,
+	}
+}
+
+// WithRecurrence tells the query-builder to eager-load the nodes that are connected to
+// the "recurrence" edge. The optional arguments are used to configure the query builder of the edge.
+func (trrq *TaskRecurrenceRunQuery) WithRecurrence(opts ...func(*TaskRecurrenceQuery)) *TaskRecurrenceRunQuery {
+	query := (&TaskRecurrenceClient{config: trrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trrq.withRecurrence = query
+	return trrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RecurrenceID int `json:"recurrence_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskRecurrenceRun.Query().
+//		GroupBy(taskrecurrencerun.FieldRecurrenceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (trrq *TaskRecurrenceRunQuery) GroupBy(field string, fields ...string) *TaskRecurrenceRunGroupBy {
+	trrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskRecurrenceRunGroupBy{build: trrq}
+	grbuild.flds = &trrq.ctx.Fields
+	grbuild.label = taskrecurrencerun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RecurrenceID int `json:"recurrence_id"`
+//	}
+//
+//	client.TaskRecurrenceRun.Query().
+//		Select(taskrecurrencerun.FieldRecurrenceID).
+//		Scan(ctx, &v)
+func (trrq *TaskRecurrenceRunQuery) Select(fields ...string) *TaskRecurrenceRunSelect {
+	trrq.ctx.Fields = append(trrq.ctx.Fields, fields...)
+	sbuild := &TaskRecurrenceRunSelect{TaskRecurrenceRunQuery: trrq}
+	sbuild.label = taskrecurrencerun.Label
+	sbuild.flds, sbuild.scan = &trrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskRecurrenceRunSelect configured with the given aggregations.
+func (trrq *TaskRecurrenceRunQuery) Aggregate(fns ...AggregateFunc) *TaskRecurrenceRunSelect {
+	return trrq.Select().Aggregate(fns...)
+}
+
+func (trrq *TaskRecurrenceRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range trrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, trrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range trrq.ctx.Fields {
+		if !taskrecurrencerun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trrq.path != nil {
+		prev, err := trrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trrq.sql = prev
+	}
+	return nil
+}
+
+func (trrq *TaskRecurrenceRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskRecurrenceRun, error) {
+	var (
+		nodes       = []*TaskRecurrenceRun{}
+		_spec       = trrq.querySpec()
+		loadedTypes = [1]bool{
+			trrq.withRecurrence != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskRecurrenceRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskRecurrenceRun{config: trrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := trrq.withRecurrence; query != nil {
+		if err := trrq.loadRecurrence(ctx, query, nodes, nil,
+			func(n *TaskRecurrenceRun, e *TaskRecurrence) { n.Edges.Recurrence = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (trrq *TaskRecurrenceRunQuery) loadRecurrence(ctx context.Context, query *TaskRecurrenceQuery, nodes []*TaskRecurrenceRun, init func(*TaskRecurrenceRun), assign func(*TaskRecurrenceRun, *TaskRecurrence)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskRecurrenceRun)
+	for i := range nodes {
+		fk := nodes[i].RecurrenceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(taskrecurrence.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "recurrence_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (trrq *TaskRecurrenceRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trrq.querySpec()
+	_spec.Node.Columns = trrq.ctx.Fields
+	if len(trrq.ctx.Fields) > 0 {
+		_spec.Unique = trrq.ctx.Unique != nil && *trrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, trrq.driver, _spec)
+}
+
+func (trrq *TaskRecurrenceRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskrecurrencerun.Table, taskrecurrencerun.Columns, sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt))
+	_spec.From = trrq.sql
+	if unique := trrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if trrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := trrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrecurrencerun.FieldID)
+		for i := range fields {
+			if fields[i] != taskrecurrencerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if trrq.withRecurrence != nil {
+			_spec.Node.AddColumnOnce(taskrecurrencerun.FieldRecurrenceID)
+		}
+	}
+	if ps := trrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trrq *TaskRecurrenceRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trrq.driver.Dialect())
+	t1 := builder.Table(taskrecurrencerun.Table)
+	columns := trrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskrecurrencerun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trrq.sql != nil {
+		selector = trrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trrq.ctx.Unique != nil && *trrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range trrq.predicates {
+		p(selector)
+	}
+	for _, p := range trrq.order {
+		p(selector)
+	}
+	if offset := trrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaskRecurrenceRunGroupBy is the group-by builder for TaskRecurrenceRun entities.
+type TaskRecurrenceRunGroupBy struct {
+	selector
+	build *TaskRecurrenceRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trrgb *TaskRecurrenceRunGroupBy) Aggregate(fns ...AggregateFunc) *TaskRecurrenceRunGroupBy {
+	trrgb.fns = append(trrgb.fns, fns...)
+	return trrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trrgb *TaskRecurrenceRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := trrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskRecurrenceRunQuery, *TaskRecurrenceRunGroupBy](ctx, trrgb.build, trrgb, trrgb.build.inters, v)
+}
+
+func (trrgb *TaskRecurrenceRunGroupBy) sqlScan(ctx context.Context, root *TaskRecurrenceRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(trrgb.fns))
+	for _, fn := range trrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*trrgb.flds)+len(trrgb.fns))
+		for _, f := range *trrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*trrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskRecurrenceRunSelect is the builder for selecting fields of TaskRecurrenceRun entities.
+type TaskRecurrenceRunSelect struct {
+	*TaskRecurrenceRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (trrs *TaskRecurrenceRunSelect) Aggregate(fns ...AggregateFunc) *TaskRecurrenceRunSelect {
+	trrs.fns = append(trrs.fns, fns...)
+	return trrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trrs *TaskRecurrenceRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trrs.ctx, ent.OpQuerySelect)
+	if err := trrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskRecurrenceRunQuery, *TaskRecurrenceRunSelect](ctx, trrs.TaskRecurrenceRunQuery, trrs, trrs.inters, v)
+}
+
+func (trrs *TaskRecurrenceRunSelect) sqlScan(ctx context.Context, root *TaskRecurrenceRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(trrs.fns))
+	for _, fn := range trrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*trrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+)
+
+// TaskRecurrenceRunUpdate is the builder for updating TaskRecurrenceRun entities.
+type TaskRecurrenceRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskRecurrenceRunMutation
+}
+
+// Where appends a list predicates to the TaskRecurrenceRunUpdate builder.
+func (trru *TaskRecurrenceRunUpdate) Where(ps ...predicate.TaskRecurrenceRun) *TaskRecurrenceRunUpdate {
+	trru.mutation.Where(ps...)
+	return trru
+}
+
+// SetRecurrenceID sets the "recurrence_id" field.
+func (trru *TaskRecurrenceRunUpdate) SetRecurrenceID(i int) *TaskRecurrenceRunUpdate {
+	trru.mutation.SetRecurrenceID(i)
+	return trru
+}
+
+// SetNillableRecurrenceID sets the "recurrence_id" field if the given value is not nil.
+func (trru *TaskRecurrenceRunUpdate) SetNillableRecurrenceID(i *int) *TaskRecurrenceRunUpdate {
+	if i != nil {
+		trru.SetRecurrenceID(*i)
+	}
+	return trru
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (trru *TaskRecurrenceRunUpdate) SetOccurrenceAt(t time.Time) *TaskRecurrenceRunUpdate {
+	trru.mutation.SetOccurrenceAt(t)
+	return trru
+}
+
+// SetNillableOccurrenceAt sets the "occurrence_at" field if the given value is not nil.
+func (trru *TaskRecurrenceRunUpdate) SetNillableOccurrenceAt(t *time.Time) *TaskRecurrenceRunUpdate {
+	if t != nil {
+		trru.SetOccurrenceAt(*t)
+	}
+	return trru
+}
+
+// SetStatus sets the "status" field.
+func (trru *TaskRecurrenceRunUpdate) SetStatus(t taskrecurrencerun.Status) *TaskRecurrenceRunUpdate {
+	trru.mutation.SetStatus(t)
+	return trru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (trru *TaskRecurrenceRunUpdate) SetNillableStatus(t *taskrecurrencerun.Status) *TaskRecurrenceRunUpdate {
+	if t != nil {
+		trru.SetStatus(*t)
+	}
+	return trru
+}
+
+// SetTaskID sets the "task_id" field.
+func (trru *TaskRecurrenceRunUpdate) SetTaskID(i int) *TaskRecurrenceRunUpdate {
+	trru.mutation.ResetTaskID()
+	trru.mutation.SetTaskID(i)
+	return trru
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (trru *TaskRecurrenceRunUpdate) SetNillableTaskID(i *int) *TaskRecurrenceRunUpdate {
+	if i != nil {
+		trru.SetTaskID(*i)
+	}
+	return trru
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (trru *TaskRecurrenceRunUpdate) AddTaskID(i int) *TaskRecurrenceRunUpdate {
+	trru.mutation.AddTaskID(i)
+	return trru
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (trru *TaskRecurrenceRunUpdate) ClearTaskID() *TaskRecurrenceRunUpdate {
+	trru.mutation.ClearTaskID()
+	return trru
+}
+
+// SetAttempts sets the "attempts" field.
+func (trru *TaskRecurrenceRunUpdate) SetAttempts(i int) *TaskRecurrenceRunUpdate {
+	trru.mutation.ResetAttempts()
+	trru.mutation.SetAttempts(i)
+	return trru
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (trru *TaskRecurrenceRunUpdate) SetNillableAttempts(i *int) *TaskRecurrenceRunUpdate {
+	if i != nil {
+		trru.SetAttempts(*i)
+	}
+	return trru
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (trru *TaskRecurrenceRunUpdate) AddAttempts(i int) *TaskRecurrenceRunUpdate {
+	trru.mutation.AddAttempts(i)
+	return trru
+}
+
+// SetError sets the "error" field.
+func (trru *TaskRecurrenceRunUpdate) SetError(s string) *TaskRecurrenceRunUpdate {
+	trru.mutation.SetError(s)
+	return trru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (trru *TaskRecurrenceRunUpdate) SetNillableError(s *string) *TaskRecurrenceRunUpdate {
+	if s != nil {
+		trru.SetError(*s)
+	}
+	return trru
+}
+
+// ClearError clears the value of the "error" field.
+func (trru *TaskRecurrenceRunUpdate) ClearError() *TaskRecurrenceRunUpdate {
+	trru.mutation.ClearError()
+	return trru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trru *TaskRecurrenceRunUpdate) SetUpdatedAt(t time.Time) *TaskRecurrenceRunUpdate {
+	trru.mutation.SetUpdatedAt(t)
+	return trru
+}
+
+// SetRecurrence sets the "recurrence" edge to the TaskRecurrence entity.
+func (trru *TaskRecurrenceRunUpdate) SetRecurrence(t *TaskRecurrence) *TaskRecurrenceRunUpdate {
+	return trru.SetRecurrenceID(t.ID)
+}
+
+// Mutation returns the TaskRecurrenceRunMutation object of the builder.
+func (trru *TaskRecurrenceRunUpdate) Mutation() *TaskRecurrenceRunMutation {
+	return trru.mutation
+}
+
+// ClearRecurrence clears the "recurrence" edge to the TaskRecurrence entity.
+func (trru *TaskRecurrenceRunUpdate) ClearRecurrence() *TaskRecurrenceRunUpdate {
+	trru.mutation.ClearRecurrence()
+	return trru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (trru *TaskRecurrenceRunUpdate) Save(ctx context.Context) (int, error) {
+	trru.defaults()
+	return withHooks(ctx, trru.sqlSave, trru.mutation, trru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trru *TaskRecurrenceRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := trru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (trru *TaskRecurrenceRunUpdate) Exec(ctx context.Context) error {
+	_, err := trru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trru *TaskRecurrenceRunUpdate) ExecX(ctx context.Context) {
+	if err := trru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trru *TaskRecurrenceRunUpdate) defaults() {
+	if _, ok := trru.mutation.UpdatedAt(); !ok {
+		v := taskrecurrencerun.UpdateDefaultUpdatedAt()
+		trru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trru *TaskRecurrenceRunUpdate) check() error {
+	if v, ok := trru.mutation.Status(); ok {
+		if err := taskrecurrencerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRecurrenceRun.status": %w`, err)}
+		}
+	}
+	if trru.mutation.RecurrenceCleared() && len(trru.mutation.RecurrenceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskRecurrenceRun.recurrence"`)
+	}
+	return nil
+}
+
+func (trru *TaskRecurrenceRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := trru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrecurrencerun.Table, taskrecurrencerun.Columns, sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt))
+	if ps := trru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := trru.mutation.OccurrenceAt(); ok {
+		_spec.SetField(taskrecurrencerun.FieldOccurrenceAt, field.TypeTime, value)
+	}
+	if value, ok := trru.mutation.Status(); ok {
+		_spec.SetField(taskrecurrencerun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := trru.mutation.TaskID(); ok {
+		_spec.SetField(taskrecurrencerun.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := trru.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskrecurrencerun.FieldTaskID, field.TypeInt, value)
+	}
+	if trru.mutation.TaskIDCleared() {
+		_spec.ClearField(taskrecurrencerun.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := trru.mutation.Attempts(); ok {
+		_spec.SetField(taskrecurrencerun.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := trru.mutation.AddedAttempts(); ok {
+		_spec.AddField(taskrecurrencerun.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := trru.mutation.Error(); ok {
+		_spec.SetField(taskrecurrencerun.FieldError, field.TypeString, value)
+	}
+	if trru.mutation.ErrorCleared() {
+		_spec.ClearField(taskrecurrencerun.FieldError, field.TypeString)
+	}
+	if value, ok := trru.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrecurrencerun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if trru.mutation.RecurrenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrencerun.RecurrenceTable,
+			Columns: []string{taskrecurrencerun.RecurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := trru.mutation.RecurrenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrencerun.RecurrenceTable,
+			Columns: []string{taskrecurrencerun.RecurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, trru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrecurrencerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	trru.mutation.done = true
+	return n, nil
+}
+
+// TaskRecurrenceRunUpdateOne is the builder for updating a single TaskRecurrenceRun entity.
+type TaskRecurrenceRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskRecurrenceRunMutation
+}
+
+// SetRecurrenceID sets the "recurrence_id" field.
+func (trruo *TaskRecurrenceRunUpdateOne) SetRecurrenceID(i int) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.SetRecurrenceID(i)
+	return trruo
+}
+
+// SetNillableRecurrenceID sets the "recurrence_id" field if the given value is not nil.
+func (trruo *TaskRecurrenceRunUpdateOne) SetNillableRecurrenceID(i *int) *TaskRecurrenceRunUpdateOne {
+	if i != nil {
+		trruo.SetRecurrenceID(*i)
+	}
+	return trruo
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (trruo *TaskRecurrenceRunUpdateOne) SetOccurrenceAt(t time.Time) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.SetOccurrenceAt(t)
+	return trruo
+}
+
+// SetNillableOccurrenceAt sets the "occurrence_at" field if the given value is not nil.
+func (trruo *TaskRecurrenceRunUpdateOne) SetNillableOccurrenceAt(t *time.Time) *TaskRecurrenceRunUpdateOne {
+	if t != nil {
+		trruo.SetOccurrenceAt(*t)
+	}
+	return trruo
+}
+
+// SetStatus sets the "status" field.
+func (trruo *TaskRecurrenceRunUpdateOne) SetStatus(t taskrecurrencerun.Status) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.SetStatus(t)
+	return trruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (trruo *TaskRecurrenceRunUpdateOne) SetNillableStatus(t *taskrecurrencerun.Status) *TaskRecurrenceRunUpdateOne {
+	if t != nil {
+		trruo.SetStatus(*t)
+	}
+	return trruo
+}
+
+// SetTaskID sets the "task_id" field.
+func (trruo *TaskRecurrenceRunUpdateOne) SetTaskID(i int) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.ResetTaskID()
+	trruo.mutation.SetTaskID(i)
+	return trruo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (trruo *TaskRecurrenceRunUpdateOne) SetNillableTaskID(i *int) *TaskRecurrenceRunUpdateOne {
+	if i != nil {
+		trruo.SetTaskID(*i)
+	}
+	return trruo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (trruo *TaskRecurrenceRunUpdateOne) AddTaskID(i int) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.AddTaskID(i)
+	return trruo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (trruo *TaskRecurrenceRunUpdateOne) ClearTaskID() *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.ClearTaskID()
+	return trruo
+}
+
+// SetAttempts sets the "attempts" field.
+func (trruo *TaskRecurrenceRunUpdateOne) SetAttempts(i int) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.ResetAttempts()
+	trruo.mutation.SetAttempts(i)
+	return trruo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (trruo *TaskRecurrenceRunUpdateOne) SetNillableAttempts(i *int) *TaskRecurrenceRunUpdateOne {
+	if i != nil {
+		trruo.SetAttempts(*i)
+	}
+	return trruo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (trruo *TaskRecurrenceRunUpdateOne) AddAttempts(i int) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.AddAttempts(i)
+	return trruo
+}
+
+// SetError sets the "error" field.
+func (trruo *TaskRecurrenceRunUpdateOne) SetError(s string) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.SetError(s)
+	return trruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (trruo *TaskRecurrenceRunUpdateOne) SetNillableError(s *string) *TaskRecurrenceRunUpdateOne {
+	if s != nil {
+		trruo.SetError(*s)
+	}
+	return trruo
+}
+
+// ClearError clears the value of the "error" field.
+func (trruo *TaskRecurrenceRunUpdateOne) ClearError() *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.ClearError()
+	return trruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trruo *TaskRecurrenceRunUpdateOne) SetUpdatedAt(t time.Time) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.SetUpdatedAt(t)
+	return trruo
+}
+
+// SetRecurrence sets the "recurrence" edge to the TaskRecurrence entity.
+func (trruo *TaskRecurrenceRunUpdateOne) SetRecurrence(t *TaskRecurrence) *TaskRecurrenceRunUpdateOne {
+	return trruo.SetRecurrenceID(t.ID)
+}
+
+// Mutation returns the TaskRecurrenceRunMutation object of the builder.
+func (trruo *TaskRecurrenceRunUpdateOne) Mutation() *TaskRecurrenceRunMutation {
+	return trruo.mutation
+}
+
+// ClearRecurrence clears the "recurrence" edge to the TaskRecurrence entity.
+func (trruo *TaskRecurrenceRunUpdateOne) ClearRecurrence() *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.ClearRecurrence()
+	return trruo
+}
+
+// Where appends a list predicates to the TaskRecurrenceRunUpdate builder.
+func (trruo *TaskRecurrenceRunUpdateOne) Where(ps ...predicate.TaskRecurrenceRun) *TaskRecurrenceRunUpdateOne {
+	trruo.mutation.Where(ps...)
+	return trruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (trruo *TaskRecurrenceRunUpdateOne) Select(field string, fields ...string) *TaskRecurrenceRunUpdateOne {
+	trruo.fields = append([]string{field}, fields...)
+	return trruo
+}
+
+// Save executes the query and returns the updated TaskRecurrenceRun entity.
+func (trruo *TaskRecurrenceRunUpdateOne) Save(ctx context.Context) (*TaskRecurrenceRun, error) {
+	trruo.defaults()
+	return withHooks(ctx, trruo.sqlSave, trruo.mutation, trruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trruo *TaskRecurrenceRunUpdateOne) SaveX(ctx context.Context) *TaskRecurrenceRun {
+	node, err := trruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (trruo *TaskRecurrenceRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := trruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trruo *TaskRecurrenceRunUpdateOne) ExecX(ctx context.Context) {
+	if err := trruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trruo *TaskRecurrenceRunUpdateOne) defaults() {
+	if _, ok := trruo.mutation.UpdatedAt(); !ok {
+		v := taskrecurrencerun.UpdateDefaultUpdatedAt()
+		trruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trruo *TaskRecurrenceRunUpdateOne) check() error {
+	if v, ok := trruo.mutation.Status(); ok {
+		if err := taskrecurrencerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRecurrenceRun.status": %w`, err)}
+		}
+	}
+	if trruo.mutation.RecurrenceCleared() && len(trruo.mutation.RecurrenceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskRecurrenceRun.recurrence"`)
+	}
+	return nil
+}
+
+func (trruo *TaskRecurrenceRunUpdateOne) sqlSave(ctx context.Context) (_node *TaskRecurrenceRun, err error) {
+	if err := trruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrecurrencerun.Table, taskrecurrencerun.Columns, sqlgraph.NewFieldSpec(taskrecurrencerun.FieldID, field.TypeInt))
+	id, ok := trruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskRecurrenceRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := trruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrecurrencerun.FieldID)
+		for _, f := range fields {
+			if !taskrecurrencerun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskrecurrencerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := trruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := trruo.mutation.OccurrenceAt(); ok {
+		_spec.SetField(taskrecurrencerun.FieldOccurrenceAt, field.TypeTime, value)
+	}
+	if value, ok := trruo.mutation.Status(); ok {
+		_spec.SetField(taskrecurrencerun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := trruo.mutation.TaskID(); ok {
+		_spec.SetField(taskrecurrencerun.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := trruo.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskrecurrencerun.FieldTaskID, field.TypeInt, value)
+	}
+	if trruo.mutation.TaskIDCleared() {
+		_spec.ClearField(taskrecurrencerun.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := trruo.mutation.Attempts(); ok {
+		_spec.SetField(taskrecurrencerun.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := trruo.mutation.AddedAttempts(); ok {
+		_spec.AddField(taskrecurrencerun.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := trruo.mutation.Error(); ok {
+		_spec.SetField(taskrecurrencerun.FieldError, field.TypeString, value)
+	}
+	if trruo.mutation.ErrorCleared() {
+		_spec.ClearField(taskrecurrencerun.FieldError, field.TypeString)
+	}
+	if value, ok := trruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrecurrencerun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if trruo.mutation.RecurrenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrencerun.RecurrenceTable,
+			Columns: []string{taskrecurrencerun.RecurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := trruo.mutation.RecurrenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrecurrencerun.RecurrenceTable,
+			Columns: []string{taskrecurrencerun.RecurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskRecurrenceRun{config: trruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, trruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrecurrencerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	trruo.mutation.done = true
+	return _node, nil
+}
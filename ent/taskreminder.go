@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
+)
+
+// TaskReminder is the model entity for the TaskReminder schema.
+type TaskReminder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// Kind holds the value of the "kind" field.
+	Kind taskreminder.Kind `json:"kind"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date"`
+	// Seq holds the value of the "seq" field.
+	Seq int `json:"seq"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskReminderQuery when eager-loading is set.
+	Edges        TaskReminderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskReminderEdges holds the relations/edges for other nodes in the graph.
+type TaskReminderEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskReminderEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskReminder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskreminder.FieldID, taskreminder.FieldTaskID, taskreminder.FieldOrgID, taskreminder.FieldSeq:
+			values[i] = new(sql.NullInt64)
+		case taskreminder.FieldKind:
+			values[i] = new(sql.NullString)
+		case taskreminder.FieldDueDate, taskreminder.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskReminder fields.
+func (tr *TaskReminder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskreminder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tr.ID = int(value.Int64)
+		case taskreminder.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				tr.TaskID = int(value.Int64)
+			}
+		case taskreminder.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				tr.OrgID = int(value.Int64)
+			}
+		case taskreminder.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				tr.Kind = taskreminder.Kind(value.String)
+			}
+		case taskreminder.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				tr.DueDate = value.Time
+			}
+		case taskreminder.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				tr.Seq = int(value.Int64)
+			}
+		case taskreminder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tr.CreatedAt = value.Time
+			}
+		default:
+			tr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskReminder.
+// This includes values selected through modifiers, order, etc.
+func (tr *TaskReminder) Value(name string) (ent.Value, error) {
+	return tr.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskReminder entity.
+func (tr *TaskReminder) QueryTask() *TaskQuery {
+	return NewTaskReminderClient(tr.config).QueryTask(tr)
+}
+
+// Update returns a builder for updating this TaskReminder.
+// Note that you need to call TaskReminder.Unwrap() before calling this method if this TaskReminder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TaskReminder) Update() *TaskReminderUpdateOne {
+	return NewTaskReminderClient(tr.config).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TaskReminder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TaskReminder) Unwrap() *TaskReminder {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskReminder is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TaskReminder) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskReminder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(tr.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskReminders is a parsable slice of TaskReminder.
+type TaskReminders []*TaskReminder
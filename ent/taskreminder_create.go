@@ -0,0 +1,789 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
+)
+
+// TaskReminderCreate is the builder for creating a TaskReminder entity.
+type TaskReminderCreate struct {
+	config
+	mutation *TaskReminderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTaskID sets the "task_id" field.
+func (trc *TaskReminderCreate) SetTaskID(i int) *TaskReminderCreate {
+	trc.mutation.SetTaskID(i)
+	return trc
+}
+
+// SetOrgID sets the "org_id" field.
+func (trc *TaskReminderCreate) SetOrgID(i int) *TaskReminderCreate {
+	trc.mutation.SetOrgID(i)
+	return trc
+}
+
+// SetKind sets the "kind" field.
+func (trc *TaskReminderCreate) SetKind(t taskreminder.Kind) *TaskReminderCreate {
+	trc.mutation.SetKind(t)
+	return trc
+}
+
+// SetDueDate sets the "due_date" field.
+func (trc *TaskReminderCreate) SetDueDate(t time.Time) *TaskReminderCreate {
+	trc.mutation.SetDueDate(t)
+	return trc
+}
+
+// SetSeq sets the "seq" field.
+func (trc *TaskReminderCreate) SetSeq(i int) *TaskReminderCreate {
+	trc.mutation.SetSeq(i)
+	return trc
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (trc *TaskReminderCreate) SetNillableSeq(i *int) *TaskReminderCreate {
+	if i != nil {
+		trc.SetSeq(*i)
+	}
+	return trc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (trc *TaskReminderCreate) SetCreatedAt(t time.Time) *TaskReminderCreate {
+	trc.mutation.SetCreatedAt(t)
+	return trc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (trc *TaskReminderCreate) SetNillableCreatedAt(t *time.Time) *TaskReminderCreate {
+	if t != nil {
+		trc.SetCreatedAt(*t)
+	}
+	return trc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (trc *TaskReminderCreate) SetTask(t *Task) *TaskReminderCreate {
+	return trc.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskReminderMutation object of the builder.
+func (trc *TaskReminderCreate) Mutation() *TaskReminderMutation {
+	return trc.mutation
+}
+
+// Save creates the TaskReminder in the database.
+func (trc *TaskReminderCreate) Save(ctx context.Context) (*TaskReminder, error) {
+	trc.defaults()
+	return withHooks(ctx, trc.sqlSave, trc.mutation, trc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TaskReminderCreate) SaveX(ctx context.Context) *TaskReminder {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TaskReminderCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TaskReminderCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TaskReminderCreate) defaults() {
+	if _, ok := trc.mutation.Seq(); !ok {
+		v := taskreminder.DefaultSeq
+		trc.mutation.SetSeq(v)
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		v := taskreminder.DefaultCreatedAt()
+		trc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TaskReminderCreate) check() error {
+	if _, ok := trc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskReminder.task_id"`)}
+	}
+	if _, ok := trc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "TaskReminder.org_id"`)}
+	}
+	if _, ok := trc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "TaskReminder.kind"`)}
+	}
+	if v, ok := trc.mutation.Kind(); ok {
+		if err := taskreminder.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "TaskReminder.kind": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "TaskReminder.due_date"`)}
+	}
+	if _, ok := trc.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "TaskReminder.seq"`)}
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskReminder.created_at"`)}
+	}
+	if len(trc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskReminder.task"`)}
+	}
+	return nil
+}
+
+func (trc *TaskReminderCreate) sqlSave(ctx context.Context) (*TaskReminder, error) {
+	if err := trc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	trc.mutation.id = &_node.ID
+	trc.mutation.done = true
+	return _node, nil
+}
+
+func (trc *TaskReminderCreate) createSpec() (*TaskReminder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskReminder{config: trc.config}
+		_spec = sqlgraph.NewCreateSpec(taskreminder.Table, sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = trc.conflict
+	if value, ok := trc.mutation.OrgID(); ok {
+		_spec.SetField(taskreminder.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := trc.mutation.Kind(); ok {
+		_spec.SetField(taskreminder.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := trc.mutation.DueDate(); ok {
+		_spec.SetField(taskreminder.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if value, ok := trc.mutation.Seq(); ok {
+		_spec.SetField(taskreminder.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := trc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskreminder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := trc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskReminder.Create().
+//		SetTaskID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskReminderUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (trc *TaskReminderCreate) OnConflict(opts ...sql.ConflictOption) *TaskReminderUpsertOne {
+	trc.conflict = opts
+	return &TaskReminderUpsertOne{
+		create: trc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskReminder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trc *TaskReminderCreate) OnConflictColumns(columns ...string) *TaskReminderUpsertOne {
+	trc.conflict = append(trc.conflict, sql.ConflictColumns(columns...))
+	return &TaskReminderUpsertOne{
+		create: trc,
+	}
+}
+
+type (
+	// TaskReminderUpsertOne is the builder for "upsert"-ing
+	//  one TaskReminder node.
+	TaskReminderUpsertOne struct {
+		create *TaskReminderCreate
+	}
+
+	// TaskReminderUpsert is the "OnConflict" setter.
+	TaskReminderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskReminderUpsert) SetTaskID(v int) *TaskReminderUpsert {
+	u.Set(taskreminder.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskReminderUpsert) UpdateTaskID() *TaskReminderUpsert {
+	u.SetExcluded(taskreminder.FieldTaskID)
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskReminderUpsert) SetOrgID(v int) *TaskReminderUpsert {
+	u.Set(taskreminder.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskReminderUpsert) UpdateOrgID() *TaskReminderUpsert {
+	u.SetExcluded(taskreminder.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskReminderUpsert) AddOrgID(v int) *TaskReminderUpsert {
+	u.Add(taskreminder.FieldOrgID, v)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *TaskReminderUpsert) SetKind(v taskreminder.Kind) *TaskReminderUpsert {
+	u.Set(taskreminder.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *TaskReminderUpsert) UpdateKind() *TaskReminderUpsert {
+	u.SetExcluded(taskreminder.FieldKind)
+	return u
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *TaskReminderUpsert) SetDueDate(v time.Time) *TaskReminderUpsert {
+	u.Set(taskreminder.FieldDueDate, v)
+	return u
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *TaskReminderUpsert) UpdateDueDate() *TaskReminderUpsert {
+	u.SetExcluded(taskreminder.FieldDueDate)
+	return u
+}
+
+// SetSeq sets the "seq" field.
+func (u *TaskReminderUpsert) SetSeq(v int) *TaskReminderUpsert {
+	u.Set(taskreminder.FieldSeq, v)
+	return u
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *TaskReminderUpsert) UpdateSeq() *TaskReminderUpsert {
+	u.SetExcluded(taskreminder.FieldSeq)
+	return u
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *TaskReminderUpsert) AddSeq(v int) *TaskReminderUpsert {
+	u.Add(taskreminder.FieldSeq, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskReminder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskReminderUpsertOne) UpdateNewValues() *TaskReminderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskreminder.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskReminder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskReminderUpsertOne) Ignore() *TaskReminderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskReminderUpsertOne) DoNothing() *TaskReminderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskReminderCreate.OnConflict
+// documentation for more info.
+func (u *TaskReminderUpsertOne) Update(set func(*TaskReminderUpsert)) *TaskReminderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskReminderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskReminderUpsertOne) SetTaskID(v int) *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskReminderUpsertOne) UpdateTaskID() *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskReminderUpsertOne) SetOrgID(v int) *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskReminderUpsertOne) AddOrgID(v int) *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskReminderUpsertOne) UpdateOrgID() *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *TaskReminderUpsertOne) SetKind(v taskreminder.Kind) *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *TaskReminderUpsertOne) UpdateKind() *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *TaskReminderUpsertOne) SetDueDate(v time.Time) *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *TaskReminderUpsertOne) UpdateDueDate() *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetSeq sets the "seq" field.
+func (u *TaskReminderUpsertOne) SetSeq(v int) *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetSeq(v)
+	})
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *TaskReminderUpsertOne) AddSeq(v int) *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.AddSeq(v)
+	})
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *TaskReminderUpsertOne) UpdateSeq() *TaskReminderUpsertOne {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateSeq()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskReminderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskReminderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskReminderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskReminderUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskReminderUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskReminderCreateBulk is the builder for creating many TaskReminder entities in bulk.
+type TaskReminderCreateBulk struct {
+	config
+	err      error
+	builders []*TaskReminderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskReminder entities in the database.
+func (trcb *TaskReminderCreateBulk) Save(ctx context.Context) ([]*TaskReminder, error) {
+	if trcb.err != nil {
+		return nil, trcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TaskReminder, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskReminderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = trcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TaskReminderCreateBulk) SaveX(ctx context.Context) []*TaskReminder {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TaskReminderCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TaskReminderCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskReminder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskReminderUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (trcb *TaskReminderCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskReminderUpsertBulk {
+	trcb.conflict = opts
+	return &TaskReminderUpsertBulk{
+		create: trcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskReminder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trcb *TaskReminderCreateBulk) OnConflictColumns(columns ...string) *TaskReminderUpsertBulk {
+	trcb.conflict = append(trcb.conflict, sql.ConflictColumns(columns...))
+	return &TaskReminderUpsertBulk{
+		create: trcb,
+	}
+}
+
+// TaskReminderUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskReminder nodes.
+type TaskReminderUpsertBulk struct {
+	create *TaskReminderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskReminder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskReminderUpsertBulk) UpdateNewValues() *TaskReminderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskreminder.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskReminder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskReminderUpsertBulk) Ignore() *TaskReminderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskReminderUpsertBulk) DoNothing() *TaskReminderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskReminderCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskReminderUpsertBulk) Update(set func(*TaskReminderUpsert)) *TaskReminderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskReminderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskReminderUpsertBulk) SetTaskID(v int) *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskReminderUpsertBulk) UpdateTaskID() *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskReminderUpsertBulk) SetOrgID(v int) *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskReminderUpsertBulk) AddOrgID(v int) *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskReminderUpsertBulk) UpdateOrgID() *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *TaskReminderUpsertBulk) SetKind(v taskreminder.Kind) *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *TaskReminderUpsertBulk) UpdateKind() *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *TaskReminderUpsertBulk) SetDueDate(v time.Time) *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *TaskReminderUpsertBulk) UpdateDueDate() *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetSeq sets the "seq" field.
+func (u *TaskReminderUpsertBulk) SetSeq(v int) *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.SetSeq(v)
+	})
+}
+
+// AddSeq adds v to the "seq" field.
+func (u *TaskReminderUpsertBulk) AddSeq(v int) *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.AddSeq(v)
+	})
+}
+
+// UpdateSeq sets the "seq" field to the value that was provided on create.
+func (u *TaskReminderUpsertBulk) UpdateSeq() *TaskReminderUpsertBulk {
+	return u.Update(func(s *TaskReminderUpsert) {
+		s.UpdateSeq()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskReminderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskReminderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskReminderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskReminderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
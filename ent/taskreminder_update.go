@@ -0,0 +1,493 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
+)
+
+// TaskReminderUpdate is the builder for updating TaskReminder entities.
+type TaskReminderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskReminderMutation
+}
+
+// Where appends a list predicates to the TaskReminderUpdate builder.
+func (tru *TaskReminderUpdate) Where(ps ...predicate.TaskReminder) *TaskReminderUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetTaskID sets the "task_id" field.
+func (tru *TaskReminderUpdate) SetTaskID(i int) *TaskReminderUpdate {
+	tru.mutation.SetTaskID(i)
+	return tru
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tru *TaskReminderUpdate) SetNillableTaskID(i *int) *TaskReminderUpdate {
+	if i != nil {
+		tru.SetTaskID(*i)
+	}
+	return tru
+}
+
+// SetOrgID sets the "org_id" field.
+func (tru *TaskReminderUpdate) SetOrgID(i int) *TaskReminderUpdate {
+	tru.mutation.ResetOrgID()
+	tru.mutation.SetOrgID(i)
+	return tru
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (tru *TaskReminderUpdate) SetNillableOrgID(i *int) *TaskReminderUpdate {
+	if i != nil {
+		tru.SetOrgID(*i)
+	}
+	return tru
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (tru *TaskReminderUpdate) AddOrgID(i int) *TaskReminderUpdate {
+	tru.mutation.AddOrgID(i)
+	return tru
+}
+
+// SetKind sets the "kind" field.
+func (tru *TaskReminderUpdate) SetKind(t taskreminder.Kind) *TaskReminderUpdate {
+	tru.mutation.SetKind(t)
+	return tru
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (tru *TaskReminderUpdate) SetNillableKind(t *taskreminder.Kind) *TaskReminderUpdate {
+	if t != nil {
+		tru.SetKind(*t)
+	}
+	return tru
+}
+
+// SetDueDate sets the "due_date" field.
+func (tru *TaskReminderUpdate) SetDueDate(t time.Time) *TaskReminderUpdate {
+	tru.mutation.SetDueDate(t)
+	return tru
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (tru *TaskReminderUpdate) SetNillableDueDate(t *time.Time) *TaskReminderUpdate {
+	if t != nil {
+		tru.SetDueDate(*t)
+	}
+	return tru
+}
+
+// SetSeq sets the "seq" field.
+func (tru *TaskReminderUpdate) SetSeq(i int) *TaskReminderUpdate {
+	tru.mutation.ResetSeq()
+	tru.mutation.SetSeq(i)
+	return tru
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (tru *TaskReminderUpdate) SetNillableSeq(i *int) *TaskReminderUpdate {
+	if i != nil {
+		tru.SetSeq(*i)
+	}
+	return tru
+}
+
+// AddSeq adds i to the "seq" field.
+func (tru *TaskReminderUpdate) AddSeq(i int) *TaskReminderUpdate {
+	tru.mutation.AddSeq(i)
+	return tru
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tru *TaskReminderUpdate) SetTask(t *Task) *TaskReminderUpdate {
+	return tru.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskReminderMutation object of the builder.
+func (tru *TaskReminderUpdate) Mutation() *TaskReminderMutation {
+	return tru.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tru *TaskReminderUpdate) ClearTask() *TaskReminderUpdate {
+	tru.mutation.ClearTask()
+	return tru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TaskReminderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tru.sqlSave, tru.mutation, tru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TaskReminderUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TaskReminderUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TaskReminderUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tru *TaskReminderUpdate) check() error {
+	if v, ok := tru.mutation.Kind(); ok {
+		if err := taskreminder.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "TaskReminder.kind": %w`, err)}
+		}
+	}
+	if tru.mutation.TaskCleared() && len(tru.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskReminder.task"`)
+	}
+	return nil
+}
+
+func (tru *TaskReminderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskreminder.Table, taskreminder.Columns, sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt))
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.OrgID(); ok {
+		_spec.SetField(taskreminder.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.AddedOrgID(); ok {
+		_spec.AddField(taskreminder.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.Kind(); ok {
+		_spec.SetField(taskreminder.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := tru.mutation.DueDate(); ok {
+		_spec.SetField(taskreminder.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := tru.mutation.Seq(); ok {
+		_spec.SetField(taskreminder.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.AddedSeq(); ok {
+		_spec.AddField(taskreminder.FieldSeq, field.TypeInt, value)
+	}
+	if tru.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskreminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tru.mutation.done = true
+	return n, nil
+}
+
+// TaskReminderUpdateOne is the builder for updating a single TaskReminder entity.
+type TaskReminderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskReminderMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (truo *TaskReminderUpdateOne) SetTaskID(i int) *TaskReminderUpdateOne {
+	truo.mutation.SetTaskID(i)
+	return truo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (truo *TaskReminderUpdateOne) SetNillableTaskID(i *int) *TaskReminderUpdateOne {
+	if i != nil {
+		truo.SetTaskID(*i)
+	}
+	return truo
+}
+
+// SetOrgID sets the "org_id" field.
+func (truo *TaskReminderUpdateOne) SetOrgID(i int) *TaskReminderUpdateOne {
+	truo.mutation.ResetOrgID()
+	truo.mutation.SetOrgID(i)
+	return truo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (truo *TaskReminderUpdateOne) SetNillableOrgID(i *int) *TaskReminderUpdateOne {
+	if i != nil {
+		truo.SetOrgID(*i)
+	}
+	return truo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (truo *TaskReminderUpdateOne) AddOrgID(i int) *TaskReminderUpdateOne {
+	truo.mutation.AddOrgID(i)
+	return truo
+}
+
+// SetKind sets the "kind" field.
+func (truo *TaskReminderUpdateOne) SetKind(t taskreminder.Kind) *TaskReminderUpdateOne {
+	truo.mutation.SetKind(t)
+	return truo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (truo *TaskReminderUpdateOne) SetNillableKind(t *taskreminder.Kind) *TaskReminderUpdateOne {
+	if t != nil {
+		truo.SetKind(*t)
+	}
+	return truo
+}
+
+// SetDueDate sets the "due_date" field.
+func (truo *TaskReminderUpdateOne) SetDueDate(t time.Time) *TaskReminderUpdateOne {
+	truo.mutation.SetDueDate(t)
+	return truo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (truo *TaskReminderUpdateOne) SetNillableDueDate(t *time.Time) *TaskReminderUpdateOne {
+	if t != nil {
+		truo.SetDueDate(*t)
+	}
+	return truo
+}
+
+// SetSeq sets the "seq" field.
+func (truo *TaskReminderUpdateOne) SetSeq(i int) *TaskReminderUpdateOne {
+	truo.mutation.ResetSeq()
+	truo.mutation.SetSeq(i)
+	return truo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (truo *TaskReminderUpdateOne) SetNillableSeq(i *int) *TaskReminderUpdateOne {
+	if i != nil {
+		truo.SetSeq(*i)
+	}
+	return truo
+}
+
+// AddSeq adds i to the "seq" field.
+func (truo *TaskReminderUpdateOne) AddSeq(i int) *TaskReminderUpdateOne {
+	truo.mutation.AddSeq(i)
+	return truo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (truo *TaskReminderUpdateOne) SetTask(t *Task) *TaskReminderUpdateOne {
+	return truo.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskReminderMutation object of the builder.
+func (truo *TaskReminderUpdateOne) Mutation() *TaskReminderMutation {
+	return truo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (truo *TaskReminderUpdateOne) ClearTask() *TaskReminderUpdateOne {
+	truo.mutation.ClearTask()
+	return truo
+}
+
+// Where appends a list predicates to the TaskReminderUpdate builder.
+func (truo *TaskReminderUpdateOne) Where(ps ...predicate.TaskReminder) *TaskReminderUpdateOne {
+	truo.mutation.Where(ps...)
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TaskReminderUpdateOne) Select(field string, fields ...string) *TaskReminderUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TaskReminder entity.
+func (truo *TaskReminderUpdateOne) Save(ctx context.Context) (*TaskReminder, error) {
+	return withHooks(ctx, truo.sqlSave, truo.mutation, truo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TaskReminderUpdateOne) SaveX(ctx context.Context) *TaskReminder {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TaskReminderUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TaskReminderUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (truo *TaskReminderUpdateOne) check() error {
+	if v, ok := truo.mutation.Kind(); ok {
+		if err := taskreminder.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "TaskReminder.kind": %w`, err)}
+		}
+	}
+	if truo.mutation.TaskCleared() && len(truo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskReminder.task"`)
+	}
+	return nil
+}
+
+func (truo *TaskReminderUpdateOne) sqlSave(ctx context.Context) (_node *TaskReminder, err error) {
+	if err := truo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskreminder.Table, taskreminder.Columns, sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeInt))
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskReminder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskreminder.FieldID)
+		for _, f := range fields {
+			if !taskreminder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskreminder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.OrgID(); ok {
+		_spec.SetField(taskreminder.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.AddedOrgID(); ok {
+		_spec.AddField(taskreminder.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.Kind(); ok {
+		_spec.SetField(taskreminder.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := truo.mutation.DueDate(); ok {
+		_spec.SetField(taskreminder.FieldDueDate, field.TypeTime, value)
+	}
+	if value, ok := truo.mutation.Seq(); ok {
+		_spec.SetField(taskreminder.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.AddedSeq(); ok {
+		_spec.AddField(taskreminder.FieldSeq, field.TypeInt, value)
+	}
+	if truo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskReminder{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskreminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	truo.mutation.done = true
+	return _node, nil
+}
@@ -29,6 +29,16 @@ type TaskReport struct {
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at"`
+	// Progress holds the value of the "progress" field.
+	Progress *int `json:"progress"`
+	// Status holds the value of the "status" field.
+	Status taskreport.Status `json:"status"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *int `json:"reviewer_id"`
+	// ReviewComment holds the value of the "review_comment" field.
+	ReviewComment string `json:"review_comment"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskReportQuery when eager-loading is set.
 	Edges        TaskReportEdges `json:"edges"`
@@ -41,9 +51,11 @@ type TaskReportEdges struct {
 	Task *Task `json:"task"`
 	// Reporter holds the value of the reporter edge.
 	Reporter *Employee `json:"reporter"`
+	// Reviewer holds the value of the reviewer edge.
+	Reviewer *Employee `json:"reviewer"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TaskOrErr returns the Task value or an error if the edge
@@ -68,16 +80,27 @@ func (e TaskReportEdges) ReporterOrErr() (*Employee, error) {
 	return nil, &NotLoadedError{edge: "reporter"}
 }
 
+// ReviewerOrErr returns the Reviewer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskReportEdges) ReviewerOrErr() (*Employee, error) {
+	if e.Reviewer != nil {
+		return e.Reviewer, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "reviewer"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*TaskReport) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case taskreport.FieldID, taskreport.FieldTaskID, taskreport.FieldReporterID:
+		case taskreport.FieldID, taskreport.FieldTaskID, taskreport.FieldReporterID, taskreport.FieldProgress, taskreport.FieldReviewerID:
 			values[i] = new(sql.NullInt64)
-		case taskreport.FieldContent:
+		case taskreport.FieldContent, taskreport.FieldStatus, taskreport.FieldReviewComment:
 			values[i] = new(sql.NullString)
-		case taskreport.FieldCreatedAt, taskreport.FieldUpdatedAt:
+		case taskreport.FieldCreatedAt, taskreport.FieldUpdatedAt, taskreport.FieldReviewedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -130,6 +153,39 @@ func (tr *TaskReport) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				tr.UpdatedAt = value.Time
 			}
+		case taskreport.FieldProgress:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress", values[i])
+			} else if value.Valid {
+				tr.Progress = new(int)
+				*tr.Progress = int(value.Int64)
+			}
+		case taskreport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				tr.Status = taskreport.Status(value.String)
+			}
+		case taskreport.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				tr.ReviewerID = new(int)
+				*tr.ReviewerID = int(value.Int64)
+			}
+		case taskreport.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				tr.ReviewComment = value.String
+			}
+		case taskreport.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				tr.ReviewedAt = new(time.Time)
+				*tr.ReviewedAt = value.Time
+			}
 		default:
 			tr.selectValues.Set(columns[i], values[i])
 		}
@@ -153,6 +209,11 @@ func (tr *TaskReport) QueryReporter() *EmployeeQuery {
 	return NewTaskReportClient(tr.config).QueryReporter(tr)
 }
 
+// QueryReviewer queries the "reviewer" edge of the TaskReport entity.
+func (tr *TaskReport) QueryReviewer() *EmployeeQuery {
+	return NewTaskReportClient(tr.config).QueryReviewer(tr)
+}
+
 // Update returns a builder for updating this TaskReport.
 // Note that you need to call TaskReport.Unwrap() before calling this method if this TaskReport
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -190,6 +251,27 @@ func (tr *TaskReport) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(tr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := tr.Progress; v != nil {
+		builder.WriteString("progress=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Status))
+	builder.WriteString(", ")
+	if v := tr.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_comment=")
+	builder.WriteString(tr.ReviewComment)
+	builder.WriteString(", ")
+	if v := tr.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
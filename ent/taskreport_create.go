@@ -78,6 +78,76 @@ func (trc *TaskReportCreate) SetNillableUpdatedAt(t *time.Time) *TaskReportCreat
 	return trc
 }
 
+// SetProgress sets the "progress" field.
+func (trc *TaskReportCreate) SetProgress(i int) *TaskReportCreate {
+	trc.mutation.SetProgress(i)
+	return trc
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (trc *TaskReportCreate) SetNillableProgress(i *int) *TaskReportCreate {
+	if i != nil {
+		trc.SetProgress(*i)
+	}
+	return trc
+}
+
+// SetStatus sets the "status" field.
+func (trc *TaskReportCreate) SetStatus(t taskreport.Status) *TaskReportCreate {
+	trc.mutation.SetStatus(t)
+	return trc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (trc *TaskReportCreate) SetNillableStatus(t *taskreport.Status) *TaskReportCreate {
+	if t != nil {
+		trc.SetStatus(*t)
+	}
+	return trc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (trc *TaskReportCreate) SetReviewerID(i int) *TaskReportCreate {
+	trc.mutation.SetReviewerID(i)
+	return trc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (trc *TaskReportCreate) SetNillableReviewerID(i *int) *TaskReportCreate {
+	if i != nil {
+		trc.SetReviewerID(*i)
+	}
+	return trc
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (trc *TaskReportCreate) SetReviewComment(s string) *TaskReportCreate {
+	trc.mutation.SetReviewComment(s)
+	return trc
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (trc *TaskReportCreate) SetNillableReviewComment(s *string) *TaskReportCreate {
+	if s != nil {
+		trc.SetReviewComment(*s)
+	}
+	return trc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (trc *TaskReportCreate) SetReviewedAt(t time.Time) *TaskReportCreate {
+	trc.mutation.SetReviewedAt(t)
+	return trc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (trc *TaskReportCreate) SetNillableReviewedAt(t *time.Time) *TaskReportCreate {
+	if t != nil {
+		trc.SetReviewedAt(*t)
+	}
+	return trc
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (trc *TaskReportCreate) SetTask(t *Task) *TaskReportCreate {
 	return trc.SetTaskID(t.ID)
@@ -88,6 +158,11 @@ func (trc *TaskReportCreate) SetReporter(e *Employee) *TaskReportCreate {
 	return trc.SetReporterID(e.ID)
 }
 
+// SetReviewer sets the "reviewer" edge to the Employee entity.
+func (trc *TaskReportCreate) SetReviewer(e *Employee) *TaskReportCreate {
+	return trc.SetReviewerID(e.ID)
+}
+
 // Mutation returns the TaskReportMutation object of the builder.
 func (trc *TaskReportCreate) Mutation() *TaskReportMutation {
 	return trc.mutation
@@ -131,6 +206,10 @@ func (trc *TaskReportCreate) defaults() {
 		v := taskreport.DefaultUpdatedAt()
 		trc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := trc.mutation.Status(); !ok {
+		v := taskreport.DefaultStatus
+		trc.mutation.SetStatus(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -147,6 +226,19 @@ func (trc *TaskReportCreate) check() error {
 	if _, ok := trc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaskReport.updated_at"`)}
 	}
+	if v, ok := trc.mutation.Progress(); ok {
+		if err := taskreport.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "TaskReport.progress": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TaskReport.status"`)}
+	}
+	if v, ok := trc.mutation.Status(); ok {
+		if err := taskreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskReport.status": %w`, err)}
+		}
+	}
 	if len(trc.mutation.TaskIDs()) == 0 {
 		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskReport.task"`)}
 	}
@@ -192,6 +284,22 @@ func (trc *TaskReportCreate) createSpec() (*TaskReport, *sqlgraph.CreateSpec) {
 		_spec.SetField(taskreport.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := trc.mutation.Progress(); ok {
+		_spec.SetField(taskreport.FieldProgress, field.TypeInt, value)
+		_node.Progress = &value
+	}
+	if value, ok := trc.mutation.Status(); ok {
+		_spec.SetField(taskreport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := trc.mutation.ReviewComment(); ok {
+		_spec.SetField(taskreport.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = value
+	}
+	if value, ok := trc.mutation.ReviewedAt(); ok {
+		_spec.SetField(taskreport.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
 	if nodes := trc.mutation.TaskIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -226,6 +334,23 @@ func (trc *TaskReportCreate) createSpec() (*TaskReport, *sqlgraph.CreateSpec) {
 		_node.ReporterID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := trc.mutation.ReviewerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreport.ReviewerTable,
+			Columns: []string{taskreport.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewerID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -332,6 +457,96 @@ func (u *TaskReportUpsert) UpdateUpdatedAt() *TaskReportUpsert {
 	return u
 }
 
+// SetProgress sets the "progress" field.
+func (u *TaskReportUpsert) SetProgress(v int) *TaskReportUpsert {
+	u.Set(taskreport.FieldProgress, v)
+	return u
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskReportUpsert) UpdateProgress() *TaskReportUpsert {
+	u.SetExcluded(taskreport.FieldProgress)
+	return u
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskReportUpsert) AddProgress(v int) *TaskReportUpsert {
+	u.Add(taskreport.FieldProgress, v)
+	return u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskReportUpsert) ClearProgress() *TaskReportUpsert {
+	u.SetNull(taskreport.FieldProgress)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskReportUpsert) SetStatus(v taskreport.Status) *TaskReportUpsert {
+	u.Set(taskreport.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskReportUpsert) UpdateStatus() *TaskReportUpsert {
+	u.SetExcluded(taskreport.FieldStatus)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *TaskReportUpsert) SetReviewerID(v int) *TaskReportUpsert {
+	u.Set(taskreport.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *TaskReportUpsert) UpdateReviewerID() *TaskReportUpsert {
+	u.SetExcluded(taskreport.FieldReviewerID)
+	return u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *TaskReportUpsert) ClearReviewerID() *TaskReportUpsert {
+	u.SetNull(taskreport.FieldReviewerID)
+	return u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *TaskReportUpsert) SetReviewComment(v string) *TaskReportUpsert {
+	u.Set(taskreport.FieldReviewComment, v)
+	return u
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *TaskReportUpsert) UpdateReviewComment() *TaskReportUpsert {
+	u.SetExcluded(taskreport.FieldReviewComment)
+	return u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *TaskReportUpsert) ClearReviewComment() *TaskReportUpsert {
+	u.SetNull(taskreport.FieldReviewComment)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *TaskReportUpsert) SetReviewedAt(v time.Time) *TaskReportUpsert {
+	u.Set(taskreport.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *TaskReportUpsert) UpdateReviewedAt() *TaskReportUpsert {
+	u.SetExcluded(taskreport.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *TaskReportUpsert) ClearReviewedAt() *TaskReportUpsert {
+	u.SetNull(taskreport.FieldReviewedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -440,6 +655,111 @@ func (u *TaskReportUpsertOne) UpdateUpdatedAt() *TaskReportUpsertOne {
 	})
 }
 
+// SetProgress sets the "progress" field.
+func (u *TaskReportUpsertOne) SetProgress(v int) *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskReportUpsertOne) AddProgress(v int) *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskReportUpsertOne) UpdateProgress() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskReportUpsertOne) ClearProgress() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskReportUpsertOne) SetStatus(v taskreport.Status) *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskReportUpsertOne) UpdateStatus() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *TaskReportUpsertOne) SetReviewerID(v int) *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *TaskReportUpsertOne) UpdateReviewerID() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *TaskReportUpsertOne) ClearReviewerID() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *TaskReportUpsertOne) SetReviewComment(v string) *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *TaskReportUpsertOne) UpdateReviewComment() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *TaskReportUpsertOne) ClearReviewComment() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *TaskReportUpsertOne) SetReviewedAt(v time.Time) *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *TaskReportUpsertOne) UpdateReviewedAt() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *TaskReportUpsertOne) ClearReviewedAt() *TaskReportUpsertOne {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskReportUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -714,6 +1034,111 @@ func (u *TaskReportUpsertBulk) UpdateUpdatedAt() *TaskReportUpsertBulk {
 	})
 }
 
+// SetProgress sets the "progress" field.
+func (u *TaskReportUpsertBulk) SetProgress(v int) *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskReportUpsertBulk) AddProgress(v int) *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskReportUpsertBulk) UpdateProgress() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskReportUpsertBulk) ClearProgress() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskReportUpsertBulk) SetStatus(v taskreport.Status) *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskReportUpsertBulk) UpdateStatus() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *TaskReportUpsertBulk) SetReviewerID(v int) *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *TaskReportUpsertBulk) UpdateReviewerID() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *TaskReportUpsertBulk) ClearReviewerID() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (u *TaskReportUpsertBulk) SetReviewComment(v string) *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetReviewComment(v)
+	})
+}
+
+// UpdateReviewComment sets the "review_comment" field to the value that was provided on create.
+func (u *TaskReportUpsertBulk) UpdateReviewComment() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateReviewComment()
+	})
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (u *TaskReportUpsertBulk) ClearReviewComment() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.ClearReviewComment()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *TaskReportUpsertBulk) SetReviewedAt(v time.Time) *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *TaskReportUpsertBulk) UpdateReviewedAt() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *TaskReportUpsertBulk) ClearReviewedAt() *TaskReportUpsertBulk {
+	return u.Update(func(s *TaskReportUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskReportUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
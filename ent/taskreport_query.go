@@ -26,6 +26,7 @@ type TaskReportQuery struct {
 	predicates   []predicate.TaskReport
 	withTask     *TaskQuery
 	withReporter *EmployeeQuery
+	withReviewer *EmployeeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +107,28 @@ func (trq *TaskReportQuery) QueryReporter() *EmployeeQuery {
 	return query
 }
 
+// QueryReviewer chains the current query on the "reviewer" edge.
+func (trq *TaskReportQuery) QueryReviewer() *EmployeeQuery {
+	query := (&EmployeeClient{config: trq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskreport.Table, taskreport.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskreport.ReviewerTable, taskreport.ReviewerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TaskReport entity from the query.
 // Returns a *NotFoundError when no TaskReport was found.
 func (trq *TaskReportQuery) First(ctx context.Context) (*TaskReport, error) {
@@ -300,6 +323,7 @@ func (trq *TaskReportQuery) Clone() *TaskReportQuery {
 		predicates:   append([]predicate.TaskReport{}, trq.predicates...),
 		withTask:     trq.withTask.Clone(),
 		withReporter: trq.withReporter.Clone(),
+		withReviewer: trq.withReviewer.Clone(),
 		// clone intermediate query.
 		sql:  trq.sql.Clone(),
 		path: trq.path,
@@ -328,6 +352,17 @@ func (trq *TaskReportQuery) WithReporter(opts ...func(*EmployeeQuery)) *TaskRepo
 	return trq
 }
 
+// WithReviewer tells the query-builder to eager-load the nodes that are connected to
+// the "reviewer" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TaskReportQuery) WithReviewer(opts ...func(*EmployeeQuery)) *TaskReportQuery {
+	query := (&EmployeeClient{config: trq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withReviewer = query
+	return trq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +441,10 @@ func (trq *TaskReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*TaskReport{}
 		_spec       = trq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			trq.withTask != nil,
 			trq.withReporter != nil,
+			trq.withReviewer != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +477,12 @@ func (trq *TaskReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := trq.withReviewer; query != nil {
+		if err := trq.loadReviewer(ctx, query, nodes, nil,
+			func(n *TaskReport, e *Employee) { n.Edges.Reviewer = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,6 +544,38 @@ func (trq *TaskReportQuery) loadReporter(ctx context.Context, query *EmployeeQue
 	}
 	return nil
 }
+func (trq *TaskReportQuery) loadReviewer(ctx context.Context, query *EmployeeQuery, nodes []*TaskReport, init func(*TaskReport), assign func(*TaskReport, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskReport)
+	for i := range nodes {
+		if nodes[i].ReviewerID == nil {
+			continue
+		}
+		fk := *nodes[i].ReviewerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "reviewer_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (trq *TaskReportQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := trq.querySpec()
@@ -534,6 +608,9 @@ func (trq *TaskReportQuery) querySpec() *sqlgraph.QuerySpec {
 		if trq.withReporter != nil {
 			_spec.Node.AddColumnOnce(taskreport.FieldReporterID)
 		}
+		if trq.withReviewer != nil {
+			_spec.Node.AddColumnOnce(taskreport.FieldReviewerID)
+		}
 	}
 	if ps := trq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
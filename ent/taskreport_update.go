@@ -84,6 +84,107 @@ func (tru *TaskReportUpdate) SetUpdatedAt(t time.Time) *TaskReportUpdate {
 	return tru
 }
 
+// SetProgress sets the "progress" field.
+func (tru *TaskReportUpdate) SetProgress(i int) *TaskReportUpdate {
+	tru.mutation.ResetProgress()
+	tru.mutation.SetProgress(i)
+	return tru
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (tru *TaskReportUpdate) SetNillableProgress(i *int) *TaskReportUpdate {
+	if i != nil {
+		tru.SetProgress(*i)
+	}
+	return tru
+}
+
+// AddProgress adds i to the "progress" field.
+func (tru *TaskReportUpdate) AddProgress(i int) *TaskReportUpdate {
+	tru.mutation.AddProgress(i)
+	return tru
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (tru *TaskReportUpdate) ClearProgress() *TaskReportUpdate {
+	tru.mutation.ClearProgress()
+	return tru
+}
+
+// SetStatus sets the "status" field.
+func (tru *TaskReportUpdate) SetStatus(t taskreport.Status) *TaskReportUpdate {
+	tru.mutation.SetStatus(t)
+	return tru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tru *TaskReportUpdate) SetNillableStatus(t *taskreport.Status) *TaskReportUpdate {
+	if t != nil {
+		tru.SetStatus(*t)
+	}
+	return tru
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (tru *TaskReportUpdate) SetReviewerID(i int) *TaskReportUpdate {
+	tru.mutation.SetReviewerID(i)
+	return tru
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (tru *TaskReportUpdate) SetNillableReviewerID(i *int) *TaskReportUpdate {
+	if i != nil {
+		tru.SetReviewerID(*i)
+	}
+	return tru
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (tru *TaskReportUpdate) ClearReviewerID() *TaskReportUpdate {
+	tru.mutation.ClearReviewerID()
+	return tru
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (tru *TaskReportUpdate) SetReviewComment(s string) *TaskReportUpdate {
+	tru.mutation.SetReviewComment(s)
+	return tru
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (tru *TaskReportUpdate) SetNillableReviewComment(s *string) *TaskReportUpdate {
+	if s != nil {
+		tru.SetReviewComment(*s)
+	}
+	return tru
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (tru *TaskReportUpdate) ClearReviewComment() *TaskReportUpdate {
+	tru.mutation.ClearReviewComment()
+	return tru
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (tru *TaskReportUpdate) SetReviewedAt(t time.Time) *TaskReportUpdate {
+	tru.mutation.SetReviewedAt(t)
+	return tru
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (tru *TaskReportUpdate) SetNillableReviewedAt(t *time.Time) *TaskReportUpdate {
+	if t != nil {
+		tru.SetReviewedAt(*t)
+	}
+	return tru
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (tru *TaskReportUpdate) ClearReviewedAt() *TaskReportUpdate {
+	tru.mutation.ClearReviewedAt()
+	return tru
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (tru *TaskReportUpdate) SetTask(t *Task) *TaskReportUpdate {
 	return tru.SetTaskID(t.ID)
@@ -94,6 +195,11 @@ func (tru *TaskReportUpdate) SetReporter(e *Employee) *TaskReportUpdate {
 	return tru.SetReporterID(e.ID)
 }
 
+// SetReviewer sets the "reviewer" edge to the Employee entity.
+func (tru *TaskReportUpdate) SetReviewer(e *Employee) *TaskReportUpdate {
+	return tru.SetReviewerID(e.ID)
+}
+
 // Mutation returns the TaskReportMutation object of the builder.
 func (tru *TaskReportUpdate) Mutation() *TaskReportMutation {
 	return tru.mutation
@@ -111,6 +217,12 @@ func (tru *TaskReportUpdate) ClearReporter() *TaskReportUpdate {
 	return tru
 }
 
+// ClearReviewer clears the "reviewer" edge to the Employee entity.
+func (tru *TaskReportUpdate) ClearReviewer() *TaskReportUpdate {
+	tru.mutation.ClearReviewer()
+	return tru
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tru *TaskReportUpdate) Save(ctx context.Context) (int, error) {
 	tru.defaults()
@@ -149,6 +261,16 @@ func (tru *TaskReportUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (tru *TaskReportUpdate) check() error {
+	if v, ok := tru.mutation.Progress(); ok {
+		if err := taskreport.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "TaskReport.progress": %w`, err)}
+		}
+	}
+	if v, ok := tru.mutation.Status(); ok {
+		if err := taskreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskReport.status": %w`, err)}
+		}
+	}
 	if tru.mutation.TaskCleared() && len(tru.mutation.TaskIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "TaskReport.task"`)
 	}
@@ -179,6 +301,30 @@ func (tru *TaskReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tru.mutation.UpdatedAt(); ok {
 		_spec.SetField(taskreport.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := tru.mutation.Progress(); ok {
+		_spec.SetField(taskreport.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.AddedProgress(); ok {
+		_spec.AddField(taskreport.FieldProgress, field.TypeInt, value)
+	}
+	if tru.mutation.ProgressCleared() {
+		_spec.ClearField(taskreport.FieldProgress, field.TypeInt)
+	}
+	if value, ok := tru.mutation.Status(); ok {
+		_spec.SetField(taskreport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tru.mutation.ReviewComment(); ok {
+		_spec.SetField(taskreport.FieldReviewComment, field.TypeString, value)
+	}
+	if tru.mutation.ReviewCommentCleared() {
+		_spec.ClearField(taskreport.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := tru.mutation.ReviewedAt(); ok {
+		_spec.SetField(taskreport.FieldReviewedAt, field.TypeTime, value)
+	}
+	if tru.mutation.ReviewedAtCleared() {
+		_spec.ClearField(taskreport.FieldReviewedAt, field.TypeTime)
+	}
 	if tru.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -237,6 +383,35 @@ func (tru *TaskReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tru.mutation.ReviewerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreport.ReviewerTable,
+			Columns: []string{taskreport.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.ReviewerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreport.ReviewerTable,
+			Columns: []string{taskreport.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{taskreport.Label}
@@ -311,6 +486,107 @@ func (truo *TaskReportUpdateOne) SetUpdatedAt(t time.Time) *TaskReportUpdateOne
 	return truo
 }
 
+// SetProgress sets the "progress" field.
+func (truo *TaskReportUpdateOne) SetProgress(i int) *TaskReportUpdateOne {
+	truo.mutation.ResetProgress()
+	truo.mutation.SetProgress(i)
+	return truo
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (truo *TaskReportUpdateOne) SetNillableProgress(i *int) *TaskReportUpdateOne {
+	if i != nil {
+		truo.SetProgress(*i)
+	}
+	return truo
+}
+
+// AddProgress adds i to the "progress" field.
+func (truo *TaskReportUpdateOne) AddProgress(i int) *TaskReportUpdateOne {
+	truo.mutation.AddProgress(i)
+	return truo
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (truo *TaskReportUpdateOne) ClearProgress() *TaskReportUpdateOne {
+	truo.mutation.ClearProgress()
+	return truo
+}
+
+// SetStatus sets the "status" field.
+func (truo *TaskReportUpdateOne) SetStatus(t taskreport.Status) *TaskReportUpdateOne {
+	truo.mutation.SetStatus(t)
+	return truo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (truo *TaskReportUpdateOne) SetNillableStatus(t *taskreport.Status) *TaskReportUpdateOne {
+	if t != nil {
+		truo.SetStatus(*t)
+	}
+	return truo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (truo *TaskReportUpdateOne) SetReviewerID(i int) *TaskReportUpdateOne {
+	truo.mutation.SetReviewerID(i)
+	return truo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (truo *TaskReportUpdateOne) SetNillableReviewerID(i *int) *TaskReportUpdateOne {
+	if i != nil {
+		truo.SetReviewerID(*i)
+	}
+	return truo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (truo *TaskReportUpdateOne) ClearReviewerID() *TaskReportUpdateOne {
+	truo.mutation.ClearReviewerID()
+	return truo
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (truo *TaskReportUpdateOne) SetReviewComment(s string) *TaskReportUpdateOne {
+	truo.mutation.SetReviewComment(s)
+	return truo
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (truo *TaskReportUpdateOne) SetNillableReviewComment(s *string) *TaskReportUpdateOne {
+	if s != nil {
+		truo.SetReviewComment(*s)
+	}
+	return truo
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (truo *TaskReportUpdateOne) ClearReviewComment() *TaskReportUpdateOne {
+	truo.mutation.ClearReviewComment()
+	return truo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (truo *TaskReportUpdateOne) SetReviewedAt(t time.Time) *TaskReportUpdateOne {
+	truo.mutation.SetReviewedAt(t)
+	return truo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (truo *TaskReportUpdateOne) SetNillableReviewedAt(t *time.Time) *TaskReportUpdateOne {
+	if t != nil {
+		truo.SetReviewedAt(*t)
+	}
+	return truo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (truo *TaskReportUpdateOne) ClearReviewedAt() *TaskReportUpdateOne {
+	truo.mutation.ClearReviewedAt()
+	return truo
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (truo *TaskReportUpdateOne) SetTask(t *Task) *TaskReportUpdateOne {
 	return truo.SetTaskID(t.ID)
@@ -321,6 +597,11 @@ func (truo *TaskReportUpdateOne) SetReporter(e *Employee) *TaskReportUpdateOne {
 	return truo.SetReporterID(e.ID)
 }
 
+// SetReviewer sets the "reviewer" edge to the Employee entity.
+func (truo *TaskReportUpdateOne) SetReviewer(e *Employee) *TaskReportUpdateOne {
+	return truo.SetReviewerID(e.ID)
+}
+
 // Mutation returns the TaskReportMutation object of the builder.
 func (truo *TaskReportUpdateOne) Mutation() *TaskReportMutation {
 	return truo.mutation
@@ -338,6 +619,12 @@ func (truo *TaskReportUpdateOne) ClearReporter() *TaskReportUpdateOne {
 	return truo
 }
 
+// ClearReviewer clears the "reviewer" edge to the Employee entity.
+func (truo *TaskReportUpdateOne) ClearReviewer() *TaskReportUpdateOne {
+	truo.mutation.ClearReviewer()
+	return truo
+}
+
 // Where appends a list predicates to the TaskReportUpdate builder.
 func (truo *TaskReportUpdateOne) Where(ps ...predicate.TaskReport) *TaskReportUpdateOne {
 	truo.mutation.Where(ps...)
@@ -389,6 +676,16 @@ func (truo *TaskReportUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (truo *TaskReportUpdateOne) check() error {
+	if v, ok := truo.mutation.Progress(); ok {
+		if err := taskreport.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "TaskReport.progress": %w`, err)}
+		}
+	}
+	if v, ok := truo.mutation.Status(); ok {
+		if err := taskreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskReport.status": %w`, err)}
+		}
+	}
 	if truo.mutation.TaskCleared() && len(truo.mutation.TaskIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "TaskReport.task"`)
 	}
@@ -436,6 +733,30 @@ func (truo *TaskReportUpdateOne) sqlSave(ctx context.Context) (_node *TaskReport
 	if value, ok := truo.mutation.UpdatedAt(); ok {
 		_spec.SetField(taskreport.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := truo.mutation.Progress(); ok {
+		_spec.SetField(taskreport.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.AddedProgress(); ok {
+		_spec.AddField(taskreport.FieldProgress, field.TypeInt, value)
+	}
+	if truo.mutation.ProgressCleared() {
+		_spec.ClearField(taskreport.FieldProgress, field.TypeInt)
+	}
+	if value, ok := truo.mutation.Status(); ok {
+		_spec.SetField(taskreport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := truo.mutation.ReviewComment(); ok {
+		_spec.SetField(taskreport.FieldReviewComment, field.TypeString, value)
+	}
+	if truo.mutation.ReviewCommentCleared() {
+		_spec.ClearField(taskreport.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := truo.mutation.ReviewedAt(); ok {
+		_spec.SetField(taskreport.FieldReviewedAt, field.TypeTime, value)
+	}
+	if truo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(taskreport.FieldReviewedAt, field.TypeTime)
+	}
 	if truo.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -494,6 +815,35 @@ func (truo *TaskReportUpdateOne) sqlSave(ctx context.Context) (_node *TaskReport
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if truo.mutation.ReviewerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreport.ReviewerTable,
+			Columns: []string{taskreport.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.ReviewerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreport.ReviewerTable,
+			Columns: []string{taskreport.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &TaskReport{config: truo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
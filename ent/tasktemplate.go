@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+)
+
+// TaskTemplate is the model entity for the TaskTemplate schema.
+type TaskTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description"`
+	// Type holds the value of the "type" field.
+	Type tasktemplate.Type `json:"type"`
+	// LabelIds holds the value of the "label_ids" field.
+	LabelIds []int `json:"label_ids"`
+	// AssigneeIds holds the value of the "assignee_ids" field.
+	AssigneeIds []int `json:"assignee_ids"`
+	// StartOffset holds the value of the "start_offset" field.
+	StartOffset *int `json:"start_offset"`
+	// DueOffset holds the value of the "due_offset" field.
+	DueOffset *int `json:"due_offset"`
+	// OriginalEstimate holds the value of the "original_estimate" field.
+	OriginalEstimate *int `json:"original_estimate"`
+	// CreatorID holds the value of the "creator_id" field.
+	CreatorID int `json:"creator_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskTemplateQuery when eager-loading is set.
+	Edges        TaskTemplateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskTemplateEdges holds the relations/edges for other nodes in the graph.
+type TaskTemplateEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project"`
+	// Recurrences holds the value of the recurrences edge.
+	Recurrences []*TaskRecurrence `json:"recurrences"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskTemplateEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// RecurrencesOrErr returns the Recurrences value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskTemplateEdges) RecurrencesOrErr() ([]*TaskRecurrence, error) {
+	if e.loadedTypes[1] {
+		return e.Recurrences, nil
+	}
+	return nil, &NotLoadedError{edge: "recurrences"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tasktemplate.FieldLabelIds, tasktemplate.FieldAssigneeIds:
+			values[i] = new([]byte)
+		case tasktemplate.FieldID, tasktemplate.FieldOrgID, tasktemplate.FieldProjectID, tasktemplate.FieldStartOffset, tasktemplate.FieldDueOffset, tasktemplate.FieldOriginalEstimate, tasktemplate.FieldCreatorID:
+			values[i] = new(sql.NullInt64)
+		case tasktemplate.FieldName, tasktemplate.FieldDescription, tasktemplate.FieldType:
+			values[i] = new(sql.NullString)
+		case tasktemplate.FieldCreatedAt, tasktemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskTemplate fields.
+func (tt *TaskTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tasktemplate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tt.ID = int(value.Int64)
+		case tasktemplate.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				tt.OrgID = int(value.Int64)
+			}
+		case tasktemplate.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				tt.ProjectID = int(value.Int64)
+			}
+		case tasktemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				tt.Name = value.String
+			}
+		case tasktemplate.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				tt.Description = value.String
+			}
+		case tasktemplate.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				tt.Type = tasktemplate.Type(value.String)
+			}
+		case tasktemplate.FieldLabelIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field label_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tt.LabelIds); err != nil {
+					return fmt.Errorf("unmarshal field label_ids: %w", err)
+				}
+			}
+		case tasktemplate.FieldAssigneeIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tt.AssigneeIds); err != nil {
+					return fmt.Errorf("unmarshal field assignee_ids: %w", err)
+				}
+			}
+		case tasktemplate.FieldStartOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_offset", values[i])
+			} else if value.Valid {
+				tt.StartOffset = new(int)
+				*tt.StartOffset = int(value.Int64)
+			}
+		case tasktemplate.FieldDueOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field due_offset", values[i])
+			} else if value.Valid {
+				tt.DueOffset = new(int)
+				*tt.DueOffset = int(value.Int64)
+			}
+		case tasktemplate.FieldOriginalEstimate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_estimate", values[i])
+			} else if value.Valid {
+				tt.OriginalEstimate = new(int)
+				*tt.OriginalEstimate = int(value.Int64)
+			}
+		case tasktemplate.FieldCreatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value.Valid {
+				tt.CreatorID = int(value.Int64)
+			}
+		case tasktemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tt.CreatedAt = value.Time
+			}
+		case tasktemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tt.UpdatedAt = value.Time
+			}
+		default:
+			tt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskTemplate.
+// This includes values selected through modifiers, order, etc.
+func (tt *TaskTemplate) Value(name string) (ent.Value, error) {
+	return tt.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the TaskTemplate entity.
+func (tt *TaskTemplate) QueryProject() *ProjectQuery {
+	return NewTaskTemplateClient(tt.config).QueryProject(tt)
+}
+
+// QueryRecurrences queries the "recurrences" edge of the TaskTemplate entity.
+func (tt *TaskTemplate) QueryRecurrences() *TaskRecurrenceQuery {
+	return NewTaskTemplateClient(tt.config).QueryRecurrences(tt)
+}
+
+// Update returns a builder for updating this TaskTemplate.
+// Note that you need to call TaskTemplate.Unwrap() before calling this method if this TaskTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tt *TaskTemplate) Update() *TaskTemplateUpdateOne {
+	return NewTaskTemplateClient(tt.config).UpdateOne(tt)
+}
+
+// Unwrap unwraps the TaskTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tt *TaskTemplate) Unwrap() *TaskTemplate {
+	_tx, ok := tt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskTemplate is not a transactional entity")
+	}
+	tt.config.driver = _tx.drv
+	return tt
+}
+
+// String implements the fmt.Stringer.
+func (tt *TaskTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tt.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", tt.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", tt.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(tt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(tt.Description)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", tt.Type))
+	builder.WriteString(", ")
+	builder.WriteString("label_ids=")
+	builder.WriteString(fmt.Sprintf("%v", tt.LabelIds))
+	builder.WriteString(", ")
+	builder.WriteString("assignee_ids=")
+	builder.WriteString(fmt.Sprintf("%v", tt.AssigneeIds))
+	builder.WriteString(", ")
+	if v := tt.StartOffset; v != nil {
+		builder.WriteString("start_offset=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := tt.DueOffset; v != nil {
+		builder.WriteString("due_offset=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := tt.OriginalEstimate; v != nil {
+		builder.WriteString("original_estimate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", tt.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskTemplates is a parsable slice of TaskTemplate.
+type TaskTemplates []*TaskTemplate
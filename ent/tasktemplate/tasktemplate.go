@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tasktemplate
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the tasktemplate type in the database.
+	Label = "task_template"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrgID holds the string denoting the org_id field in the database.
+	FieldOrgID = "org_id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldLabelIds holds the string denoting the label_ids field in the database.
+	FieldLabelIds = "label_ids"
+	// FieldAssigneeIds holds the string denoting the assignee_ids field in the database.
+	FieldAssigneeIds = "assignee_ids"
+	// FieldStartOffset holds the string denoting the start_offset field in the database.
+	FieldStartOffset = "start_offset"
+	// FieldDueOffset holds the string denoting the due_offset field in the database.
+	FieldDueOffset = "due_offset"
+	// FieldOriginalEstimate holds the string denoting the original_estimate field in the database.
+	FieldOriginalEstimate = "original_estimate"
+	// FieldCreatorID holds the string denoting the creator_id field in the database.
+	FieldCreatorID = "creator_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// EdgeRecurrences holds the string denoting the recurrences edge name in mutations.
+	EdgeRecurrences = "recurrences"
+	// Table holds the table name of the tasktemplate in the database.
+	Table = "task_templates"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "task_templates"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_id"
+	// RecurrencesTable is the table that holds the recurrences relation/edge.
+	RecurrencesTable = "task_recurrences"
+	// RecurrencesInverseTable is the table name for the TaskRecurrence entity.
+	// It exists in this package in order to avoid circular dependency with the "taskrecurrence" package.
+	RecurrencesInverseTable = "task_recurrences"
+	// RecurrencesColumn is the table column denoting the recurrences relation/edge.
+	RecurrencesColumn = "template_id"
+)
+
+// Columns holds all SQL columns for tasktemplate fields.
+var Columns = []string{
+	FieldID,
+	FieldOrgID,
+	FieldProjectID,
+	FieldName,
+	FieldDescription,
+	FieldType,
+	FieldLabelIds,
+	FieldAssigneeIds,
+	FieldStartOffset,
+	FieldDueOffset,
+	FieldOriginalEstimate,
+	FieldCreatorID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// OriginalEstimateValidator is a validator for the "original_estimate" field. It is called by the builders before save.
+	OriginalEstimateValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// TypeTask is the default value of the Type enum.
+const DefaultType = TypeTask
+
+// Type values.
+const (
+	TypeTask    Type = "task"
+	TypeFeature Type = "feature"
+	TypeBug     Type = "bug"
+	TypeAnother Type = "another"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeTask, TypeFeature, TypeBug, TypeAnother:
+		return nil
+	default:
+		return fmt.Errorf("tasktemplate: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the TaskTemplate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrgID orders the results by the org_id field.
+func ByOrgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByStartOffset orders the results by the start_offset field.
+func ByStartOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartOffset, opts...).ToFunc()
+}
+
+// ByDueOffset orders the results by the due_offset field.
+func ByDueOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueOffset, opts...).ToFunc()
+}
+
+// ByOriginalEstimate orders the results by the original_estimate field.
+func ByOriginalEstimate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalEstimate, opts...).ToFunc()
+}
+
+// ByCreatorID orders the results by the creator_id field.
+func ByCreatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatorID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecurrencesCount orders the results by recurrences count.
+func ByRecurrencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecurrencesStep(), opts...)
+	}
+}
+
+// ByRecurrences orders the results by recurrences terms.
+func ByRecurrences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurrencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
+func newRecurrencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurrencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecurrencesTable, RecurrencesColumn),
+	)
+}
@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tasktemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldOrgID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// StartOffset applies equality check predicate on the "start_offset" field. It's identical to StartOffsetEQ.
+func StartOffset(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldStartOffset, v))
+}
+
+// DueOffset applies equality check predicate on the "due_offset" field. It's identical to DueOffsetEQ.
+func DueOffset(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDueOffset, v))
+}
+
+// OriginalEstimate applies equality check predicate on the "original_estimate" field. It's identical to OriginalEstimateEQ.
+func OriginalEstimate(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldOriginalEstimate, v))
+}
+
+// CreatorID applies equality check predicate on the "creator_id" field. It's identical to CreatorIDEQ.
+func CreatorID(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldCreatorID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldOrgID, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldProjectID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldType, vs...))
+}
+
+// LabelIdsIsNil applies the IsNil predicate on the "label_ids" field.
+func LabelIdsIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldLabelIds))
+}
+
+// LabelIdsNotNil applies the NotNil predicate on the "label_ids" field.
+func LabelIdsNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldLabelIds))
+}
+
+// AssigneeIdsIsNil applies the IsNil predicate on the "assignee_ids" field.
+func AssigneeIdsIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldAssigneeIds))
+}
+
+// AssigneeIdsNotNil applies the NotNil predicate on the "assignee_ids" field.
+func AssigneeIdsNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldAssigneeIds))
+}
+
+// StartOffsetEQ applies the EQ predicate on the "start_offset" field.
+func StartOffsetEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldStartOffset, v))
+}
+
+// StartOffsetNEQ applies the NEQ predicate on the "start_offset" field.
+func StartOffsetNEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldStartOffset, v))
+}
+
+// StartOffsetIn applies the In predicate on the "start_offset" field.
+func StartOffsetIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldStartOffset, vs...))
+}
+
+// StartOffsetNotIn applies the NotIn predicate on the "start_offset" field.
+func StartOffsetNotIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldStartOffset, vs...))
+}
+
+// StartOffsetGT applies the GT predicate on the "start_offset" field.
+func StartOffsetGT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldStartOffset, v))
+}
+
+// StartOffsetGTE applies the GTE predicate on the "start_offset" field.
+func StartOffsetGTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldStartOffset, v))
+}
+
+// StartOffsetLT applies the LT predicate on the "start_offset" field.
+func StartOffsetLT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldStartOffset, v))
+}
+
+// StartOffsetLTE applies the LTE predicate on the "start_offset" field.
+func StartOffsetLTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldStartOffset, v))
+}
+
+// StartOffsetIsNil applies the IsNil predicate on the "start_offset" field.
+func StartOffsetIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldStartOffset))
+}
+
+// StartOffsetNotNil applies the NotNil predicate on the "start_offset" field.
+func StartOffsetNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldStartOffset))
+}
+
+// DueOffsetEQ applies the EQ predicate on the "due_offset" field.
+func DueOffsetEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDueOffset, v))
+}
+
+// DueOffsetNEQ applies the NEQ predicate on the "due_offset" field.
+func DueOffsetNEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldDueOffset, v))
+}
+
+// DueOffsetIn applies the In predicate on the "due_offset" field.
+func DueOffsetIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldDueOffset, vs...))
+}
+
+// DueOffsetNotIn applies the NotIn predicate on the "due_offset" field.
+func DueOffsetNotIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldDueOffset, vs...))
+}
+
+// DueOffsetGT applies the GT predicate on the "due_offset" field.
+func DueOffsetGT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldDueOffset, v))
+}
+
+// DueOffsetGTE applies the GTE predicate on the "due_offset" field.
+func DueOffsetGTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldDueOffset, v))
+}
+
+// DueOffsetLT applies the LT predicate on the "due_offset" field.
+func DueOffsetLT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldDueOffset, v))
+}
+
+// DueOffsetLTE applies the LTE predicate on the "due_offset" field.
+func DueOffsetLTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldDueOffset, v))
+}
+
+// DueOffsetIsNil applies the IsNil predicate on the "due_offset" field.
+func DueOffsetIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldDueOffset))
+}
+
+// DueOffsetNotNil applies the NotNil predicate on the "due_offset" field.
+func DueOffsetNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldDueOffset))
+}
+
+// OriginalEstimateEQ applies the EQ predicate on the "original_estimate" field.
+func OriginalEstimateEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateNEQ applies the NEQ predicate on the "original_estimate" field.
+func OriginalEstimateNEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateIn applies the In predicate on the "original_estimate" field.
+func OriginalEstimateIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldOriginalEstimate, vs...))
+}
+
+// OriginalEstimateNotIn applies the NotIn predicate on the "original_estimate" field.
+func OriginalEstimateNotIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldOriginalEstimate, vs...))
+}
+
+// OriginalEstimateGT applies the GT predicate on the "original_estimate" field.
+func OriginalEstimateGT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateGTE applies the GTE predicate on the "original_estimate" field.
+func OriginalEstimateGTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateLT applies the LT predicate on the "original_estimate" field.
+func OriginalEstimateLT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateLTE applies the LTE predicate on the "original_estimate" field.
+func OriginalEstimateLTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldOriginalEstimate, v))
+}
+
+// OriginalEstimateIsNil applies the IsNil predicate on the "original_estimate" field.
+func OriginalEstimateIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldOriginalEstimate))
+}
+
+// OriginalEstimateNotNil applies the NotNil predicate on the "original_estimate" field.
+func OriginalEstimateNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldOriginalEstimate))
+}
+
+// CreatorIDEQ applies the EQ predicate on the "creator_id" field.
+func CreatorIDEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldCreatorID, v))
+}
+
+// CreatorIDNEQ applies the NEQ predicate on the "creator_id" field.
+func CreatorIDNEQ(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldCreatorID, v))
+}
+
+// CreatorIDIn applies the In predicate on the "creator_id" field.
+func CreatorIDIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldCreatorID, vs...))
+}
+
+// CreatorIDNotIn applies the NotIn predicate on the "creator_id" field.
+func CreatorIDNotIn(vs ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldCreatorID, vs...))
+}
+
+// CreatorIDGT applies the GT predicate on the "creator_id" field.
+func CreatorIDGT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldCreatorID, v))
+}
+
+// CreatorIDGTE applies the GTE predicate on the "creator_id" field.
+func CreatorIDGTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldCreatorID, v))
+}
+
+// CreatorIDLT applies the LT predicate on the "creator_id" field.
+func CreatorIDLT(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldCreatorID, v))
+}
+
+// CreatorIDLTE applies the LTE predicate on the "creator_id" field.
+func CreatorIDLTE(v int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldCreatorID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.TaskTemplate {
+	return predicate.TaskTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.TaskTemplate {
+	return predicate.TaskTemplate(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecurrences applies the HasEdge predicate on the "recurrences" edge.
+func HasRecurrences() predicate.TaskTemplate {
+	return predicate.TaskTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecurrencesTable, RecurrencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecurrencesWith applies the HasEdge predicate on the "recurrences" edge with a given conditions (other predicates).
+func HasRecurrencesWith(preds ...predicate.TaskRecurrence) predicate.TaskTemplate {
+	return predicate.TaskTemplate(func(s *sql.Selector) {
+		step := newRecurrencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.NotPredicates(p))
+}
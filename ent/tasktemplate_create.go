@@ -0,0 +1,1430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+)
+
+// TaskTemplateCreate is the builder for creating a TaskTemplate entity.
+type TaskTemplateCreate struct {
+	config
+	mutation *TaskTemplateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (ttc *TaskTemplateCreate) SetOrgID(i int) *TaskTemplateCreate {
+	ttc.mutation.SetOrgID(i)
+	return ttc
+}
+
+// SetProjectID sets the "project_id" field.
+func (ttc *TaskTemplateCreate) SetProjectID(i int) *TaskTemplateCreate {
+	ttc.mutation.SetProjectID(i)
+	return ttc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableProjectID(i *int) *TaskTemplateCreate {
+	if i != nil {
+		ttc.SetProjectID(*i)
+	}
+	return ttc
+}
+
+// SetName sets the "name" field.
+func (ttc *TaskTemplateCreate) SetName(s string) *TaskTemplateCreate {
+	ttc.mutation.SetName(s)
+	return ttc
+}
+
+// SetDescription sets the "description" field.
+func (ttc *TaskTemplateCreate) SetDescription(s string) *TaskTemplateCreate {
+	ttc.mutation.SetDescription(s)
+	return ttc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableDescription(s *string) *TaskTemplateCreate {
+	if s != nil {
+		ttc.SetDescription(*s)
+	}
+	return ttc
+}
+
+// SetType sets the "type" field.
+func (ttc *TaskTemplateCreate) SetType(t tasktemplate.Type) *TaskTemplateCreate {
+	ttc.mutation.SetType(t)
+	return ttc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableType(t *tasktemplate.Type) *TaskTemplateCreate {
+	if t != nil {
+		ttc.SetType(*t)
+	}
+	return ttc
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (ttc *TaskTemplateCreate) SetLabelIds(i []int) *TaskTemplateCreate {
+	ttc.mutation.SetLabelIds(i)
+	return ttc
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (ttc *TaskTemplateCreate) SetAssigneeIds(i []int) *TaskTemplateCreate {
+	ttc.mutation.SetAssigneeIds(i)
+	return ttc
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (ttc *TaskTemplateCreate) SetStartOffset(i int) *TaskTemplateCreate {
+	ttc.mutation.SetStartOffset(i)
+	return ttc
+}
+
+// SetNillableStartOffset sets the "start_offset" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableStartOffset(i *int) *TaskTemplateCreate {
+	if i != nil {
+		ttc.SetStartOffset(*i)
+	}
+	return ttc
+}
+
+// SetDueOffset sets the "due_offset" field.
+func (ttc *TaskTemplateCreate) SetDueOffset(i int) *TaskTemplateCreate {
+	ttc.mutation.SetDueOffset(i)
+	return ttc
+}
+
+// SetNillableDueOffset sets the "due_offset" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableDueOffset(i *int) *TaskTemplateCreate {
+	if i != nil {
+		ttc.SetDueOffset(*i)
+	}
+	return ttc
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (ttc *TaskTemplateCreate) SetOriginalEstimate(i int) *TaskTemplateCreate {
+	ttc.mutation.SetOriginalEstimate(i)
+	return ttc
+}
+
+// SetNillableOriginalEstimate sets the "original_estimate" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableOriginalEstimate(i *int) *TaskTemplateCreate {
+	if i != nil {
+		ttc.SetOriginalEstimate(*i)
+	}
+	return ttc
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (ttc *TaskTemplateCreate) SetCreatorID(i int) *TaskTemplateCreate {
+	ttc.mutation.SetCreatorID(i)
+	return ttc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ttc *TaskTemplateCreate) SetCreatedAt(t time.Time) *TaskTemplateCreate {
+	ttc.mutation.SetCreatedAt(t)
+	return ttc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableCreatedAt(t *time.Time) *TaskTemplateCreate {
+	if t != nil {
+		ttc.SetCreatedAt(*t)
+	}
+	return ttc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttc *TaskTemplateCreate) SetUpdatedAt(t time.Time) *TaskTemplateCreate {
+	ttc.mutation.SetUpdatedAt(t)
+	return ttc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableUpdatedAt(t *time.Time) *TaskTemplateCreate {
+	if t != nil {
+		ttc.SetUpdatedAt(*t)
+	}
+	return ttc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (ttc *TaskTemplateCreate) SetProject(p *Project) *TaskTemplateCreate {
+	return ttc.SetProjectID(p.ID)
+}
+
+// AddRecurrenceIDs adds the "recurrences" edge to the TaskRecurrence entity by IDs.
+func (ttc *TaskTemplateCreate) AddRecurrenceIDs(ids ...int) *TaskTemplateCreate {
+	ttc.mutation.AddRecurrenceIDs(ids...)
+	return ttc
+}
+
+// AddRecurrences adds the "recurrences" edges to the TaskRecurrence entity.
+func (ttc *TaskTemplateCreate) AddRecurrences(t ...*TaskRecurrence) *TaskTemplateCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ttc.AddRecurrenceIDs(ids...)
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (ttc *TaskTemplateCreate) Mutation() *TaskTemplateMutation {
+	return ttc.mutation
+}
+
+// Save creates the TaskTemplate in the database.
+func (ttc *TaskTemplateCreate) Save(ctx context.Context) (*TaskTemplate, error) {
+	ttc.defaults()
+	return withHooks(ctx, ttc.sqlSave, ttc.mutation, ttc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ttc *TaskTemplateCreate) SaveX(ctx context.Context) *TaskTemplate {
+	v, err := ttc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttc *TaskTemplateCreate) Exec(ctx context.Context) error {
+	_, err := ttc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttc *TaskTemplateCreate) ExecX(ctx context.Context) {
+	if err := ttc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttc *TaskTemplateCreate) defaults() {
+	if _, ok := ttc.mutation.GetType(); !ok {
+		v := tasktemplate.DefaultType
+		ttc.mutation.SetType(v)
+	}
+	if _, ok := ttc.mutation.CreatedAt(); !ok {
+		v := tasktemplate.DefaultCreatedAt()
+		ttc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ttc.mutation.UpdatedAt(); !ok {
+		v := tasktemplate.DefaultUpdatedAt()
+		ttc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttc *TaskTemplateCreate) check() error {
+	if _, ok := ttc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "TaskTemplate.org_id"`)}
+	}
+	if _, ok := ttc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TaskTemplate.name"`)}
+	}
+	if v, ok := ttc.mutation.Name(); ok {
+		if err := tasktemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TaskTemplate.type"`)}
+	}
+	if v, ok := ttc.mutation.GetType(); ok {
+		if err := tasktemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := ttc.mutation.OriginalEstimate(); ok {
+		if err := tasktemplate.OriginalEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "original_estimate", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.original_estimate": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`ent: missing required field "TaskTemplate.creator_id"`)}
+	}
+	if _, ok := ttc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskTemplate.created_at"`)}
+	}
+	if _, ok := ttc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaskTemplate.updated_at"`)}
+	}
+	return nil
+}
+
+func (ttc *TaskTemplateCreate) sqlSave(ctx context.Context) (*TaskTemplate, error) {
+	if err := ttc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ttc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ttc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ttc.mutation.id = &_node.ID
+	ttc.mutation.done = true
+	return _node, nil
+}
+
+func (ttc *TaskTemplateCreate) createSpec() (*TaskTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskTemplate{config: ttc.config}
+		_spec = sqlgraph.NewCreateSpec(tasktemplate.Table, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ttc.conflict
+	if value, ok := ttc.mutation.OrgID(); ok {
+		_spec.SetField(tasktemplate.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := ttc.mutation.Name(); ok {
+		_spec.SetField(tasktemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ttc.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := ttc.mutation.GetType(); ok {
+		_spec.SetField(tasktemplate.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := ttc.mutation.LabelIds(); ok {
+		_spec.SetField(tasktemplate.FieldLabelIds, field.TypeJSON, value)
+		_node.LabelIds = value
+	}
+	if value, ok := ttc.mutation.AssigneeIds(); ok {
+		_spec.SetField(tasktemplate.FieldAssigneeIds, field.TypeJSON, value)
+		_node.AssigneeIds = value
+	}
+	if value, ok := ttc.mutation.StartOffset(); ok {
+		_spec.SetField(tasktemplate.FieldStartOffset, field.TypeInt, value)
+		_node.StartOffset = &value
+	}
+	if value, ok := ttc.mutation.DueOffset(); ok {
+		_spec.SetField(tasktemplate.FieldDueOffset, field.TypeInt, value)
+		_node.DueOffset = &value
+	}
+	if value, ok := ttc.mutation.OriginalEstimate(); ok {
+		_spec.SetField(tasktemplate.FieldOriginalEstimate, field.TypeInt, value)
+		_node.OriginalEstimate = &value
+	}
+	if value, ok := ttc.mutation.CreatorID(); ok {
+		_spec.SetField(tasktemplate.FieldCreatorID, field.TypeInt, value)
+		_node.CreatorID = value
+	}
+	if value, ok := ttc.mutation.CreatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ttc.mutation.UpdatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ttc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.ProjectTable,
+			Columns: []string{tasktemplate.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ttc.mutation.RecurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tasktemplate.RecurrencesTable,
+			Columns: []string{tasktemplate.RecurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskTemplate.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskTemplateUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (ttc *TaskTemplateCreate) OnConflict(opts ...sql.ConflictOption) *TaskTemplateUpsertOne {
+	ttc.conflict = opts
+	return &TaskTemplateUpsertOne{
+		create: ttc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ttc *TaskTemplateCreate) OnConflictColumns(columns ...string) *TaskTemplateUpsertOne {
+	ttc.conflict = append(ttc.conflict, sql.ConflictColumns(columns...))
+	return &TaskTemplateUpsertOne{
+		create: ttc,
+	}
+}
+
+type (
+	// TaskTemplateUpsertOne is the builder for "upsert"-ing
+	//  one TaskTemplate node.
+	TaskTemplateUpsertOne struct {
+		create *TaskTemplateCreate
+	}
+
+	// TaskTemplateUpsert is the "OnConflict" setter.
+	TaskTemplateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskTemplateUpsert) SetOrgID(v int) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateOrgID() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskTemplateUpsert) AddOrgID(v int) *TaskTemplateUpsert {
+	u.Add(tasktemplate.FieldOrgID, v)
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskTemplateUpsert) SetProjectID(v int) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateProjectID() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldProjectID)
+	return u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskTemplateUpsert) ClearProjectID() *TaskTemplateUpsert {
+	u.SetNull(tasktemplate.FieldProjectID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TaskTemplateUpsert) SetName(v string) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateName() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TaskTemplateUpsert) SetDescription(v string) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateDescription() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TaskTemplateUpsert) ClearDescription() *TaskTemplateUpsert {
+	u.SetNull(tasktemplate.FieldDescription)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *TaskTemplateUpsert) SetType(v tasktemplate.Type) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateType() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldType)
+	return u
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (u *TaskTemplateUpsert) SetLabelIds(v []int) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldLabelIds, v)
+	return u
+}
+
+// UpdateLabelIds sets the "label_ids" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateLabelIds() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldLabelIds)
+	return u
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (u *TaskTemplateUpsert) ClearLabelIds() *TaskTemplateUpsert {
+	u.SetNull(tasktemplate.FieldLabelIds)
+	return u
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (u *TaskTemplateUpsert) SetAssigneeIds(v []int) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldAssigneeIds, v)
+	return u
+}
+
+// UpdateAssigneeIds sets the "assignee_ids" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateAssigneeIds() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldAssigneeIds)
+	return u
+}
+
+// ClearAssigneeIds clears the value of the "assignee_ids" field.
+func (u *TaskTemplateUpsert) ClearAssigneeIds() *TaskTemplateUpsert {
+	u.SetNull(tasktemplate.FieldAssigneeIds)
+	return u
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (u *TaskTemplateUpsert) SetStartOffset(v int) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldStartOffset, v)
+	return u
+}
+
+// UpdateStartOffset sets the "start_offset" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateStartOffset() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldStartOffset)
+	return u
+}
+
+// AddStartOffset adds v to the "start_offset" field.
+func (u *TaskTemplateUpsert) AddStartOffset(v int) *TaskTemplateUpsert {
+	u.Add(tasktemplate.FieldStartOffset, v)
+	return u
+}
+
+// ClearStartOffset clears the value of the "start_offset" field.
+func (u *TaskTemplateUpsert) ClearStartOffset() *TaskTemplateUpsert {
+	u.SetNull(tasktemplate.FieldStartOffset)
+	return u
+}
+
+// SetDueOffset sets the "due_offset" field.
+func (u *TaskTemplateUpsert) SetDueOffset(v int) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldDueOffset, v)
+	return u
+}
+
+// UpdateDueOffset sets the "due_offset" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateDueOffset() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldDueOffset)
+	return u
+}
+
+// AddDueOffset adds v to the "due_offset" field.
+func (u *TaskTemplateUpsert) AddDueOffset(v int) *TaskTemplateUpsert {
+	u.Add(tasktemplate.FieldDueOffset, v)
+	return u
+}
+
+// ClearDueOffset clears the value of the "due_offset" field.
+func (u *TaskTemplateUpsert) ClearDueOffset() *TaskTemplateUpsert {
+	u.SetNull(tasktemplate.FieldDueOffset)
+	return u
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (u *TaskTemplateUpsert) SetOriginalEstimate(v int) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldOriginalEstimate, v)
+	return u
+}
+
+// UpdateOriginalEstimate sets the "original_estimate" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateOriginalEstimate() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldOriginalEstimate)
+	return u
+}
+
+// AddOriginalEstimate adds v to the "original_estimate" field.
+func (u *TaskTemplateUpsert) AddOriginalEstimate(v int) *TaskTemplateUpsert {
+	u.Add(tasktemplate.FieldOriginalEstimate, v)
+	return u
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (u *TaskTemplateUpsert) ClearOriginalEstimate() *TaskTemplateUpsert {
+	u.SetNull(tasktemplate.FieldOriginalEstimate)
+	return u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskTemplateUpsert) SetCreatorID(v int) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldCreatorID, v)
+	return u
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateCreatorID() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldCreatorID)
+	return u
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskTemplateUpsert) AddCreatorID(v int) *TaskTemplateUpsert {
+	u.Add(tasktemplate.FieldCreatorID, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskTemplateUpsert) SetUpdatedAt(v time.Time) *TaskTemplateUpsert {
+	u.Set(tasktemplate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskTemplateUpsert) UpdateUpdatedAt() *TaskTemplateUpsert {
+	u.SetExcluded(tasktemplate.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskTemplateUpsertOne) UpdateNewValues() *TaskTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tasktemplate.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskTemplate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskTemplateUpsertOne) Ignore() *TaskTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskTemplateUpsertOne) DoNothing() *TaskTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskTemplateCreate.OnConflict
+// documentation for more info.
+func (u *TaskTemplateUpsertOne) Update(set func(*TaskTemplateUpsert)) *TaskTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskTemplateUpsertOne) SetOrgID(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskTemplateUpsertOne) AddOrgID(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateOrgID() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskTemplateUpsertOne) SetProjectID(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateProjectID() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskTemplateUpsertOne) ClearProjectID() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TaskTemplateUpsertOne) SetName(v string) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateName() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TaskTemplateUpsertOne) SetDescription(v string) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateDescription() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TaskTemplateUpsertOne) ClearDescription() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TaskTemplateUpsertOne) SetType(v tasktemplate.Type) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateType() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (u *TaskTemplateUpsertOne) SetLabelIds(v []int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetLabelIds(v)
+	})
+}
+
+// UpdateLabelIds sets the "label_ids" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateLabelIds() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateLabelIds()
+	})
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (u *TaskTemplateUpsertOne) ClearLabelIds() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearLabelIds()
+	})
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (u *TaskTemplateUpsertOne) SetAssigneeIds(v []int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetAssigneeIds(v)
+	})
+}
+
+// UpdateAssigneeIds sets the "assignee_ids" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateAssigneeIds() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateAssigneeIds()
+	})
+}
+
+// ClearAssigneeIds clears the value of the "assignee_ids" field.
+func (u *TaskTemplateUpsertOne) ClearAssigneeIds() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearAssigneeIds()
+	})
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (u *TaskTemplateUpsertOne) SetStartOffset(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetStartOffset(v)
+	})
+}
+
+// AddStartOffset adds v to the "start_offset" field.
+func (u *TaskTemplateUpsertOne) AddStartOffset(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddStartOffset(v)
+	})
+}
+
+// UpdateStartOffset sets the "start_offset" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateStartOffset() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateStartOffset()
+	})
+}
+
+// ClearStartOffset clears the value of the "start_offset" field.
+func (u *TaskTemplateUpsertOne) ClearStartOffset() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearStartOffset()
+	})
+}
+
+// SetDueOffset sets the "due_offset" field.
+func (u *TaskTemplateUpsertOne) SetDueOffset(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetDueOffset(v)
+	})
+}
+
+// AddDueOffset adds v to the "due_offset" field.
+func (u *TaskTemplateUpsertOne) AddDueOffset(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddDueOffset(v)
+	})
+}
+
+// UpdateDueOffset sets the "due_offset" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateDueOffset() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateDueOffset()
+	})
+}
+
+// ClearDueOffset clears the value of the "due_offset" field.
+func (u *TaskTemplateUpsertOne) ClearDueOffset() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearDueOffset()
+	})
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (u *TaskTemplateUpsertOne) SetOriginalEstimate(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetOriginalEstimate(v)
+	})
+}
+
+// AddOriginalEstimate adds v to the "original_estimate" field.
+func (u *TaskTemplateUpsertOne) AddOriginalEstimate(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddOriginalEstimate(v)
+	})
+}
+
+// UpdateOriginalEstimate sets the "original_estimate" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateOriginalEstimate() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateOriginalEstimate()
+	})
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (u *TaskTemplateUpsertOne) ClearOriginalEstimate() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearOriginalEstimate()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskTemplateUpsertOne) SetCreatorID(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskTemplateUpsertOne) AddCreatorID(v int) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateCreatorID() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskTemplateUpsertOne) SetUpdatedAt(v time.Time) *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskTemplateUpsertOne) UpdateUpdatedAt() *TaskTemplateUpsertOne {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskTemplateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskTemplateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskTemplateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskTemplateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskTemplateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskTemplateCreateBulk is the builder for creating many TaskTemplate entities in bulk.
+type TaskTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*TaskTemplateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskTemplate entities in the database.
+func (ttcb *TaskTemplateCreateBulk) Save(ctx context.Context) ([]*TaskTemplate, error) {
+	if ttcb.err != nil {
+		return nil, ttcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ttcb.builders))
+	nodes := make([]*TaskTemplate, len(ttcb.builders))
+	mutators := make([]Mutator, len(ttcb.builders))
+	for i := range ttcb.builders {
+		func(i int, root context.Context) {
+			builder := ttcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ttcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ttcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ttcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ttcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttcb *TaskTemplateCreateBulk) SaveX(ctx context.Context) []*TaskTemplate {
+	v, err := ttcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttcb *TaskTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ttcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttcb *TaskTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := ttcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskTemplate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskTemplateUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (ttcb *TaskTemplateCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskTemplateUpsertBulk {
+	ttcb.conflict = opts
+	return &TaskTemplateUpsertBulk{
+		create: ttcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ttcb *TaskTemplateCreateBulk) OnConflictColumns(columns ...string) *TaskTemplateUpsertBulk {
+	ttcb.conflict = append(ttcb.conflict, sql.ConflictColumns(columns...))
+	return &TaskTemplateUpsertBulk{
+		create: ttcb,
+	}
+}
+
+// TaskTemplateUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskTemplate nodes.
+type TaskTemplateUpsertBulk struct {
+	create *TaskTemplateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskTemplateUpsertBulk) UpdateNewValues() *TaskTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tasktemplate.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskTemplate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskTemplateUpsertBulk) Ignore() *TaskTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskTemplateUpsertBulk) DoNothing() *TaskTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskTemplateCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskTemplateUpsertBulk) Update(set func(*TaskTemplateUpsert)) *TaskTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskTemplateUpsertBulk) SetOrgID(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskTemplateUpsertBulk) AddOrgID(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateOrgID() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskTemplateUpsertBulk) SetProjectID(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateProjectID() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskTemplateUpsertBulk) ClearProjectID() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TaskTemplateUpsertBulk) SetName(v string) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateName() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TaskTemplateUpsertBulk) SetDescription(v string) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateDescription() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TaskTemplateUpsertBulk) ClearDescription() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TaskTemplateUpsertBulk) SetType(v tasktemplate.Type) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateType() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (u *TaskTemplateUpsertBulk) SetLabelIds(v []int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetLabelIds(v)
+	})
+}
+
+// UpdateLabelIds sets the "label_ids" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateLabelIds() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateLabelIds()
+	})
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (u *TaskTemplateUpsertBulk) ClearLabelIds() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearLabelIds()
+	})
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (u *TaskTemplateUpsertBulk) SetAssigneeIds(v []int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetAssigneeIds(v)
+	})
+}
+
+// UpdateAssigneeIds sets the "assignee_ids" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateAssigneeIds() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateAssigneeIds()
+	})
+}
+
+// ClearAssigneeIds clears the value of the "assignee_ids" field.
+func (u *TaskTemplateUpsertBulk) ClearAssigneeIds() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearAssigneeIds()
+	})
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (u *TaskTemplateUpsertBulk) SetStartOffset(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetStartOffset(v)
+	})
+}
+
+// AddStartOffset adds v to the "start_offset" field.
+func (u *TaskTemplateUpsertBulk) AddStartOffset(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddStartOffset(v)
+	})
+}
+
+// UpdateStartOffset sets the "start_offset" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateStartOffset() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateStartOffset()
+	})
+}
+
+// ClearStartOffset clears the value of the "start_offset" field.
+func (u *TaskTemplateUpsertBulk) ClearStartOffset() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearStartOffset()
+	})
+}
+
+// SetDueOffset sets the "due_offset" field.
+func (u *TaskTemplateUpsertBulk) SetDueOffset(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetDueOffset(v)
+	})
+}
+
+// AddDueOffset adds v to the "due_offset" field.
+func (u *TaskTemplateUpsertBulk) AddDueOffset(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddDueOffset(v)
+	})
+}
+
+// UpdateDueOffset sets the "due_offset" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateDueOffset() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateDueOffset()
+	})
+}
+
+// ClearDueOffset clears the value of the "due_offset" field.
+func (u *TaskTemplateUpsertBulk) ClearDueOffset() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearDueOffset()
+	})
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (u *TaskTemplateUpsertBulk) SetOriginalEstimate(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetOriginalEstimate(v)
+	})
+}
+
+// AddOriginalEstimate adds v to the "original_estimate" field.
+func (u *TaskTemplateUpsertBulk) AddOriginalEstimate(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddOriginalEstimate(v)
+	})
+}
+
+// UpdateOriginalEstimate sets the "original_estimate" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateOriginalEstimate() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateOriginalEstimate()
+	})
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (u *TaskTemplateUpsertBulk) ClearOriginalEstimate() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.ClearOriginalEstimate()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *TaskTemplateUpsertBulk) SetCreatorID(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// AddCreatorID adds v to the "creator_id" field.
+func (u *TaskTemplateUpsertBulk) AddCreatorID(v int) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.AddCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateCreatorID() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskTemplateUpsertBulk) SetUpdatedAt(v time.Time) *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskTemplateUpsertBulk) UpdateUpdatedAt() *TaskTemplateUpsertBulk {
+	return u.Update(func(s *TaskTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskTemplateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskTemplateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskTemplateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskTemplateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+)
+
+// TaskTemplateUpdate is the builder for updating TaskTemplate entities.
+type TaskTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskTemplateMutation
+}
+
+// Where appends a list predicates to the TaskTemplateUpdate builder.
+func (ttu *TaskTemplateUpdate) Where(ps ...predicate.TaskTemplate) *TaskTemplateUpdate {
+	ttu.mutation.Where(ps...)
+	return ttu
+}
+
+// SetOrgID sets the "org_id" field.
+func (ttu *TaskTemplateUpdate) SetOrgID(i int) *TaskTemplateUpdate {
+	ttu.mutation.ResetOrgID()
+	ttu.mutation.SetOrgID(i)
+	return ttu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableOrgID(i *int) *TaskTemplateUpdate {
+	if i != nil {
+		ttu.SetOrgID(*i)
+	}
+	return ttu
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (ttu *TaskTemplateUpdate) AddOrgID(i int) *TaskTemplateUpdate {
+	ttu.mutation.AddOrgID(i)
+	return ttu
+}
+
+// SetProjectID sets the "project_id" field.
+func (ttu *TaskTemplateUpdate) SetProjectID(i int) *TaskTemplateUpdate {
+	ttu.mutation.SetProjectID(i)
+	return ttu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableProjectID(i *int) *TaskTemplateUpdate {
+	if i != nil {
+		ttu.SetProjectID(*i)
+	}
+	return ttu
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (ttu *TaskTemplateUpdate) ClearProjectID() *TaskTemplateUpdate {
+	ttu.mutation.ClearProjectID()
+	return ttu
+}
+
+// SetName sets the "name" field.
+func (ttu *TaskTemplateUpdate) SetName(s string) *TaskTemplateUpdate {
+	ttu.mutation.SetName(s)
+	return ttu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableName(s *string) *TaskTemplateUpdate {
+	if s != nil {
+		ttu.SetName(*s)
+	}
+	return ttu
+}
+
+// SetDescription sets the "description" field.
+func (ttu *TaskTemplateUpdate) SetDescription(s string) *TaskTemplateUpdate {
+	ttu.mutation.SetDescription(s)
+	return ttu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableDescription(s *string) *TaskTemplateUpdate {
+	if s != nil {
+		ttu.SetDescription(*s)
+	}
+	return ttu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ttu *TaskTemplateUpdate) ClearDescription() *TaskTemplateUpdate {
+	ttu.mutation.ClearDescription()
+	return ttu
+}
+
+// SetType sets the "type" field.
+func (ttu *TaskTemplateUpdate) SetType(t tasktemplate.Type) *TaskTemplateUpdate {
+	ttu.mutation.SetType(t)
+	return ttu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableType(t *tasktemplate.Type) *TaskTemplateUpdate {
+	if t != nil {
+		ttu.SetType(*t)
+	}
+	return ttu
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (ttu *TaskTemplateUpdate) SetLabelIds(i []int) *TaskTemplateUpdate {
+	ttu.mutation.SetLabelIds(i)
+	return ttu
+}
+
+// AppendLabelIds appends i to the "label_ids" field.
+func (ttu *TaskTemplateUpdate) AppendLabelIds(i []int) *TaskTemplateUpdate {
+	ttu.mutation.AppendLabelIds(i)
+	return ttu
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (ttu *TaskTemplateUpdate) ClearLabelIds() *TaskTemplateUpdate {
+	ttu.mutation.ClearLabelIds()
+	return ttu
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (ttu *TaskTemplateUpdate) SetAssigneeIds(i []int) *TaskTemplateUpdate {
+	ttu.mutation.SetAssigneeIds(i)
+	return ttu
+}
+
+// AppendAssigneeIds appends i to the "assignee_ids" field.
+func (ttu *TaskTemplateUpdate) AppendAssigneeIds(i []int) *TaskTemplateUpdate {
+	ttu.mutation.AppendAssigneeIds(i)
+	return ttu
+}
+
+// ClearAssigneeIds clears the value of the "assignee_ids" field.
+func (ttu *TaskTemplateUpdate) ClearAssigneeIds() *TaskTemplateUpdate {
+	ttu.mutation.ClearAssigneeIds()
+	return ttu
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (ttu *TaskTemplateUpdate) SetStartOffset(i int) *TaskTemplateUpdate {
+	ttu.mutation.ResetStartOffset()
+	ttu.mutation.SetStartOffset(i)
+	return ttu
+}
+
+// SetNillableStartOffset sets the "start_offset" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableStartOffset(i *int) *TaskTemplateUpdate {
+	if i != nil {
+		ttu.SetStartOffset(*i)
+	}
+	return ttu
+}
+
+// AddStartOffset adds i to the "start_offset" field.
+func (ttu *TaskTemplateUpdate) AddStartOffset(i int) *TaskTemplateUpdate {
+	ttu.mutation.AddStartOffset(i)
+	return ttu
+}
+
+// ClearStartOffset clears the value of the "start_offset" field.
+func (ttu *TaskTemplateUpdate) ClearStartOffset() *TaskTemplateUpdate {
+	ttu.mutation.ClearStartOffset()
+	return ttu
+}
+
+// SetDueOffset sets the "due_offset" field.
+func (ttu *TaskTemplateUpdate) SetDueOffset(i int) *TaskTemplateUpdate {
+	ttu.mutation.ResetDueOffset()
+	ttu.mutation.SetDueOffset(i)
+	return ttu
+}
+
+// SetNillableDueOffset sets the "due_offset" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableDueOffset(i *int) *TaskTemplateUpdate {
+	if i != nil {
+		ttu.SetDueOffset(*i)
+	}
+	return ttu
+}
+
+// AddDueOffset adds i to the "due_offset" field.
+func (ttu *TaskTemplateUpdate) AddDueOffset(i int) *TaskTemplateUpdate {
+	ttu.mutation.AddDueOffset(i)
+	return ttu
+}
+
+// ClearDueOffset clears the value of the "due_offset" field.
+func (ttu *TaskTemplateUpdate) ClearDueOffset() *TaskTemplateUpdate {
+	ttu.mutation.ClearDueOffset()
+	return ttu
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (ttu *TaskTemplateUpdate) SetOriginalEstimate(i int) *TaskTemplateUpdate {
+	ttu.mutation.ResetOriginalEstimate()
+	ttu.mutation.SetOriginalEstimate(i)
+	return ttu
+}
+
+// SetNillableOriginalEstimate sets the "original_estimate" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableOriginalEstimate(i *int) *TaskTemplateUpdate {
+	if i != nil {
+		ttu.SetOriginalEstimate(*i)
+	}
+	return ttu
+}
+
+// AddOriginalEstimate adds i to the "original_estimate" field.
+func (ttu *TaskTemplateUpdate) AddOriginalEstimate(i int) *TaskTemplateUpdate {
+	ttu.mutation.AddOriginalEstimate(i)
+	return ttu
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (ttu *TaskTemplateUpdate) ClearOriginalEstimate() *TaskTemplateUpdate {
+	ttu.mutation.ClearOriginalEstimate()
+	return ttu
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (ttu *TaskTemplateUpdate) SetCreatorID(i int) *TaskTemplateUpdate {
+	ttu.mutation.ResetCreatorID()
+	ttu.mutation.SetCreatorID(i)
+	return ttu
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableCreatorID(i *int) *TaskTemplateUpdate {
+	if i != nil {
+		ttu.SetCreatorID(*i)
+	}
+	return ttu
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (ttu *TaskTemplateUpdate) AddCreatorID(i int) *TaskTemplateUpdate {
+	ttu.mutation.AddCreatorID(i)
+	return ttu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttu *TaskTemplateUpdate) SetUpdatedAt(t time.Time) *TaskTemplateUpdate {
+	ttu.mutation.SetUpdatedAt(t)
+	return ttu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (ttu *TaskTemplateUpdate) SetProject(p *Project) *TaskTemplateUpdate {
+	return ttu.SetProjectID(p.ID)
+}
+
+// AddRecurrenceIDs adds the "recurrences" edge to the TaskRecurrence entity by IDs.
+func (ttu *TaskTemplateUpdate) AddRecurrenceIDs(ids ...int) *TaskTemplateUpdate {
+	ttu.mutation.AddRecurrenceIDs(ids...)
+	return ttu
+}
+
+// AddRecurrences adds the "recurrences" edges to the TaskRecurrence entity.
+func (ttu *TaskTemplateUpdate) AddRecurrences(t ...*TaskRecurrence) *TaskTemplateUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ttu.AddRecurrenceIDs(ids...)
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (ttu *TaskTemplateUpdate) Mutation() *TaskTemplateMutation {
+	return ttu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (ttu *TaskTemplateUpdate) ClearProject() *TaskTemplateUpdate {
+	ttu.mutation.ClearProject()
+	return ttu
+}
+
+// ClearRecurrences clears all "recurrences" edges to the TaskRecurrence entity.
+func (ttu *TaskTemplateUpdate) ClearRecurrences() *TaskTemplateUpdate {
+	ttu.mutation.ClearRecurrences()
+	return ttu
+}
+
+// RemoveRecurrenceIDs removes the "recurrences" edge to TaskRecurrence entities by IDs.
+func (ttu *TaskTemplateUpdate) RemoveRecurrenceIDs(ids ...int) *TaskTemplateUpdate {
+	ttu.mutation.RemoveRecurrenceIDs(ids...)
+	return ttu
+}
+
+// RemoveRecurrences removes "recurrences" edges to TaskRecurrence entities.
+func (ttu *TaskTemplateUpdate) RemoveRecurrences(t ...*TaskRecurrence) *TaskTemplateUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ttu.RemoveRecurrenceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ttu *TaskTemplateUpdate) Save(ctx context.Context) (int, error) {
+	ttu.defaults()
+	return withHooks(ctx, ttu.sqlSave, ttu.mutation, ttu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttu *TaskTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ttu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ttu *TaskTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := ttu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttu *TaskTemplateUpdate) ExecX(ctx context.Context) {
+	if err := ttu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttu *TaskTemplateUpdate) defaults() {
+	if _, ok := ttu.mutation.UpdatedAt(); !ok {
+		v := tasktemplate.UpdateDefaultUpdatedAt()
+		ttu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttu *TaskTemplateUpdate) check() error {
+	if v, ok := ttu.mutation.Name(); ok {
+		if err := tasktemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := ttu.mutation.GetType(); ok {
+		if err := tasktemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := ttu.mutation.OriginalEstimate(); ok {
+		if err := tasktemplate.OriginalEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "original_estimate", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.original_estimate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ttu *TaskTemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ttu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tasktemplate.Table, tasktemplate.Columns, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt))
+	if ps := ttu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttu.mutation.OrgID(); ok {
+		_spec.SetField(tasktemplate.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.AddedOrgID(); ok {
+		_spec.AddField(tasktemplate.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.Name(); ok {
+		_spec.SetField(tasktemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := ttu.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+	}
+	if ttu.mutation.DescriptionCleared() {
+		_spec.ClearField(tasktemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := ttu.mutation.GetType(); ok {
+		_spec.SetField(tasktemplate.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ttu.mutation.LabelIds(); ok {
+		_spec.SetField(tasktemplate.FieldLabelIds, field.TypeJSON, value)
+	}
+	if value, ok := ttu.mutation.AppendedLabelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldLabelIds, value)
+		})
+	}
+	if ttu.mutation.LabelIdsCleared() {
+		_spec.ClearField(tasktemplate.FieldLabelIds, field.TypeJSON)
+	}
+	if value, ok := ttu.mutation.AssigneeIds(); ok {
+		_spec.SetField(tasktemplate.FieldAssigneeIds, field.TypeJSON, value)
+	}
+	if value, ok := ttu.mutation.AppendedAssigneeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldAssigneeIds, value)
+		})
+	}
+	if ttu.mutation.AssigneeIdsCleared() {
+		_spec.ClearField(tasktemplate.FieldAssigneeIds, field.TypeJSON)
+	}
+	if value, ok := ttu.mutation.StartOffset(); ok {
+		_spec.SetField(tasktemplate.FieldStartOffset, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.AddedStartOffset(); ok {
+		_spec.AddField(tasktemplate.FieldStartOffset, field.TypeInt, value)
+	}
+	if ttu.mutation.StartOffsetCleared() {
+		_spec.ClearField(tasktemplate.FieldStartOffset, field.TypeInt)
+	}
+	if value, ok := ttu.mutation.DueOffset(); ok {
+		_spec.SetField(tasktemplate.FieldDueOffset, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.AddedDueOffset(); ok {
+		_spec.AddField(tasktemplate.FieldDueOffset, field.TypeInt, value)
+	}
+	if ttu.mutation.DueOffsetCleared() {
+		_spec.ClearField(tasktemplate.FieldDueOffset, field.TypeInt)
+	}
+	if value, ok := ttu.mutation.OriginalEstimate(); ok {
+		_spec.SetField(tasktemplate.FieldOriginalEstimate, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.AddedOriginalEstimate(); ok {
+		_spec.AddField(tasktemplate.FieldOriginalEstimate, field.TypeInt, value)
+	}
+	if ttu.mutation.OriginalEstimateCleared() {
+		_spec.ClearField(tasktemplate.FieldOriginalEstimate, field.TypeInt)
+	}
+	if value, ok := ttu.mutation.CreatorID(); ok {
+		_spec.SetField(tasktemplate.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.AddedCreatorID(); ok {
+		_spec.AddField(tasktemplate.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := ttu.mutation.UpdatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ttu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.ProjectTable,
+			Columns: []string{tasktemplate.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.ProjectTable,
+			Columns: []string{tasktemplate.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ttu.mutation.RecurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tasktemplate.RecurrencesTable,
+			Columns: []string{tasktemplate.RecurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttu.mutation.RemovedRecurrencesIDs(); len(nodes) > 0 && !ttu.mutation.RecurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tasktemplate.RecurrencesTable,
+			Columns: []string{tasktemplate.RecurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttu.mutation.RecurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tasktemplate.RecurrencesTable,
+			Columns: []string{tasktemplate.RecurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasktemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ttu.mutation.done = true
+	return n, nil
+}
+
+// TaskTemplateUpdateOne is the builder for updating a single TaskTemplate entity.
+type TaskTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskTemplateMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (ttuo *TaskTemplateUpdateOne) SetOrgID(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.ResetOrgID()
+	ttuo.mutation.SetOrgID(i)
+	return ttuo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableOrgID(i *int) *TaskTemplateUpdateOne {
+	if i != nil {
+		ttuo.SetOrgID(*i)
+	}
+	return ttuo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (ttuo *TaskTemplateUpdateOne) AddOrgID(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.AddOrgID(i)
+	return ttuo
+}
+
+// SetProjectID sets the "project_id" field.
+func (ttuo *TaskTemplateUpdateOne) SetProjectID(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetProjectID(i)
+	return ttuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableProjectID(i *int) *TaskTemplateUpdateOne {
+	if i != nil {
+		ttuo.SetProjectID(*i)
+	}
+	return ttuo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (ttuo *TaskTemplateUpdateOne) ClearProjectID() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearProjectID()
+	return ttuo
+}
+
+// SetName sets the "name" field.
+func (ttuo *TaskTemplateUpdateOne) SetName(s string) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetName(s)
+	return ttuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableName(s *string) *TaskTemplateUpdateOne {
+	if s != nil {
+		ttuo.SetName(*s)
+	}
+	return ttuo
+}
+
+// SetDescription sets the "description" field.
+func (ttuo *TaskTemplateUpdateOne) SetDescription(s string) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetDescription(s)
+	return ttuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableDescription(s *string) *TaskTemplateUpdateOne {
+	if s != nil {
+		ttuo.SetDescription(*s)
+	}
+	return ttuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ttuo *TaskTemplateUpdateOne) ClearDescription() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearDescription()
+	return ttuo
+}
+
+// SetType sets the "type" field.
+func (ttuo *TaskTemplateUpdateOne) SetType(t tasktemplate.Type) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetType(t)
+	return ttuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableType(t *tasktemplate.Type) *TaskTemplateUpdateOne {
+	if t != nil {
+		ttuo.SetType(*t)
+	}
+	return ttuo
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (ttuo *TaskTemplateUpdateOne) SetLabelIds(i []int) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetLabelIds(i)
+	return ttuo
+}
+
+// AppendLabelIds appends i to the "label_ids" field.
+func (ttuo *TaskTemplateUpdateOne) AppendLabelIds(i []int) *TaskTemplateUpdateOne {
+	ttuo.mutation.AppendLabelIds(i)
+	return ttuo
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (ttuo *TaskTemplateUpdateOne) ClearLabelIds() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearLabelIds()
+	return ttuo
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (ttuo *TaskTemplateUpdateOne) SetAssigneeIds(i []int) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetAssigneeIds(i)
+	return ttuo
+}
+
+// AppendAssigneeIds appends i to the "assignee_ids" field.
+func (ttuo *TaskTemplateUpdateOne) AppendAssigneeIds(i []int) *TaskTemplateUpdateOne {
+	ttuo.mutation.AppendAssigneeIds(i)
+	return ttuo
+}
+
+// ClearAssigneeIds clears the value of the "assignee_ids" field.
+func (ttuo *TaskTemplateUpdateOne) ClearAssigneeIds() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearAssigneeIds()
+	return ttuo
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (ttuo *TaskTemplateUpdateOne) SetStartOffset(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.ResetStartOffset()
+	ttuo.mutation.SetStartOffset(i)
+	return ttuo
+}
+
+// SetNillableStartOffset sets the "start_offset" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableStartOffset(i *int) *TaskTemplateUpdateOne {
+	if i != nil {
+		ttuo.SetStartOffset(*i)
+	}
+	return ttuo
+}
+
+// AddStartOffset adds i to the "start_offset" field.
+func (ttuo *TaskTemplateUpdateOne) AddStartOffset(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.AddStartOffset(i)
+	return ttuo
+}
+
+// ClearStartOffset clears the value of the "start_offset" field.
+func (ttuo *TaskTemplateUpdateOne) ClearStartOffset() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearStartOffset()
+	return ttuo
+}
+
+// SetDueOffset sets the "due_offset" field.
+func (ttuo *TaskTemplateUpdateOne) SetDueOffset(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.ResetDueOffset()
+	ttuo.mutation.SetDueOffset(i)
+	return ttuo
+}
+
+// SetNillableDueOffset sets the "due_offset" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableDueOffset(i *int) *TaskTemplateUpdateOne {
+	if i != nil {
+		ttuo.SetDueOffset(*i)
+	}
+	return ttuo
+}
+
+// AddDueOffset adds i to the "due_offset" field.
+func (ttuo *TaskTemplateUpdateOne) AddDueOffset(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.AddDueOffset(i)
+	return ttuo
+}
+
+// ClearDueOffset clears the value of the "due_offset" field.
+func (ttuo *TaskTemplateUpdateOne) ClearDueOffset() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearDueOffset()
+	return ttuo
+}
+
+// SetOriginalEstimate sets the "original_estimate" field.
+func (ttuo *TaskTemplateUpdateOne) SetOriginalEstimate(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.ResetOriginalEstimate()
+	ttuo.mutation.SetOriginalEstimate(i)
+	return ttuo
+}
+
+// SetNillableOriginalEstimate sets the "original_estimate" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableOriginalEstimate(i *int) *TaskTemplateUpdateOne {
+	if i != nil {
+		ttuo.SetOriginalEstimate(*i)
+	}
+	return ttuo
+}
+
+// AddOriginalEstimate adds i to the "original_estimate" field.
+func (ttuo *TaskTemplateUpdateOne) AddOriginalEstimate(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.AddOriginalEstimate(i)
+	return ttuo
+}
+
+// ClearOriginalEstimate clears the value of the "original_estimate" field.
+func (ttuo *TaskTemplateUpdateOne) ClearOriginalEstimate() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearOriginalEstimate()
+	return ttuo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (ttuo *TaskTemplateUpdateOne) SetCreatorID(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.ResetCreatorID()
+	ttuo.mutation.SetCreatorID(i)
+	return ttuo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableCreatorID(i *int) *TaskTemplateUpdateOne {
+	if i != nil {
+		ttuo.SetCreatorID(*i)
+	}
+	return ttuo
+}
+
+// AddCreatorID adds i to the "creator_id" field.
+func (ttuo *TaskTemplateUpdateOne) AddCreatorID(i int) *TaskTemplateUpdateOne {
+	ttuo.mutation.AddCreatorID(i)
+	return ttuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttuo *TaskTemplateUpdateOne) SetUpdatedAt(t time.Time) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetUpdatedAt(t)
+	return ttuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (ttuo *TaskTemplateUpdateOne) SetProject(p *Project) *TaskTemplateUpdateOne {
+	return ttuo.SetProjectID(p.ID)
+}
+
+// AddRecurrenceIDs adds the "recurrences" edge to the TaskRecurrence entity by IDs.
+func (ttuo *TaskTemplateUpdateOne) AddRecurrenceIDs(ids ...int) *TaskTemplateUpdateOne {
+	ttuo.mutation.AddRecurrenceIDs(ids...)
+	return ttuo
+}
+
+// AddRecurrences adds the "recurrences" edges to the TaskRecurrence entity.
+func (ttuo *TaskTemplateUpdateOne) AddRecurrences(t ...*TaskRecurrence) *TaskTemplateUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ttuo.AddRecurrenceIDs(ids...)
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (ttuo *TaskTemplateUpdateOne) Mutation() *TaskTemplateMutation {
+	return ttuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (ttuo *TaskTemplateUpdateOne) ClearProject() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearProject()
+	return ttuo
+}
+
+// ClearRecurrences clears all "recurrences" edges to the TaskRecurrence entity.
+func (ttuo *TaskTemplateUpdateOne) ClearRecurrences() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearRecurrences()
+	return ttuo
+}
+
+// RemoveRecurrenceIDs removes the "recurrences" edge to TaskRecurrence entities by IDs.
+func (ttuo *TaskTemplateUpdateOne) RemoveRecurrenceIDs(ids ...int) *TaskTemplateUpdateOne {
+	ttuo.mutation.RemoveRecurrenceIDs(ids...)
+	return ttuo
+}
+
+// RemoveRecurrences removes "recurrences" edges to TaskRecurrence entities.
+func (ttuo *TaskTemplateUpdateOne) RemoveRecurrences(t ...*TaskRecurrence) *TaskTemplateUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ttuo.RemoveRecurrenceIDs(ids...)
+}
+
+// Where appends a list predicates to the TaskTemplateUpdate builder.
+func (ttuo *TaskTemplateUpdateOne) Where(ps ...predicate.TaskTemplate) *TaskTemplateUpdateOne {
+	ttuo.mutation.Where(ps...)
+	return ttuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ttuo *TaskTemplateUpdateOne) Select(field string, fields ...string) *TaskTemplateUpdateOne {
+	ttuo.fields = append([]string{field}, fields...)
+	return ttuo
+}
+
+// Save executes the query and returns the updated TaskTemplate entity.
+func (ttuo *TaskTemplateUpdateOne) Save(ctx context.Context) (*TaskTemplate, error) {
+	ttuo.defaults()
+	return withHooks(ctx, ttuo.sqlSave, ttuo.mutation, ttuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttuo *TaskTemplateUpdateOne) SaveX(ctx context.Context) *TaskTemplate {
+	node, err := ttuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ttuo *TaskTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ttuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttuo *TaskTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := ttuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttuo *TaskTemplateUpdateOne) defaults() {
+	if _, ok := ttuo.mutation.UpdatedAt(); !ok {
+		v := tasktemplate.UpdateDefaultUpdatedAt()
+		ttuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttuo *TaskTemplateUpdateOne) check() error {
+	if v, ok := ttuo.mutation.Name(); ok {
+		if err := tasktemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.name": %w`, err)}
+		}
+	}
+	if v, ok := ttuo.mutation.GetType(); ok {
+		if err := tasktemplate.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.type": %w`, err)}
+		}
+	}
+	if v, ok := ttuo.mutation.OriginalEstimate(); ok {
+		if err := tasktemplate.OriginalEstimateValidator(v); err != nil {
+			return &ValidationError{Name: "original_estimate", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.original_estimate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ttuo *TaskTemplateUpdateOne) sqlSave(ctx context.Context) (_node *TaskTemplate, err error) {
+	if err := ttuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tasktemplate.Table, tasktemplate.Columns, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt))
+	id, ok := ttuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ttuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tasktemplate.FieldID)
+		for _, f := range fields {
+			if !tasktemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tasktemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ttuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttuo.mutation.OrgID(); ok {
+		_spec.SetField(tasktemplate.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.AddedOrgID(); ok {
+		_spec.AddField(tasktemplate.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.Name(); ok {
+		_spec.SetField(tasktemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := ttuo.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+	}
+	if ttuo.mutation.DescriptionCleared() {
+		_spec.ClearField(tasktemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := ttuo.mutation.GetType(); ok {
+		_spec.SetField(tasktemplate.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ttuo.mutation.LabelIds(); ok {
+		_spec.SetField(tasktemplate.FieldLabelIds, field.TypeJSON, value)
+	}
+	if value, ok := ttuo.mutation.AppendedLabelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldLabelIds, value)
+		})
+	}
+	if ttuo.mutation.LabelIdsCleared() {
+		_spec.ClearField(tasktemplate.FieldLabelIds, field.TypeJSON)
+	}
+	if value, ok := ttuo.mutation.AssigneeIds(); ok {
+		_spec.SetField(tasktemplate.FieldAssigneeIds, field.TypeJSON, value)
+	}
+	if value, ok := ttuo.mutation.AppendedAssigneeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldAssigneeIds, value)
+		})
+	}
+	if ttuo.mutation.AssigneeIdsCleared() {
+		_spec.ClearField(tasktemplate.FieldAssigneeIds, field.TypeJSON)
+	}
+	if value, ok := ttuo.mutation.StartOffset(); ok {
+		_spec.SetField(tasktemplate.FieldStartOffset, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.AddedStartOffset(); ok {
+		_spec.AddField(tasktemplate.FieldStartOffset, field.TypeInt, value)
+	}
+	if ttuo.mutation.StartOffsetCleared() {
+		_spec.ClearField(tasktemplate.FieldStartOffset, field.TypeInt)
+	}
+	if value, ok := ttuo.mutation.DueOffset(); ok {
+		_spec.SetField(tasktemplate.FieldDueOffset, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.AddedDueOffset(); ok {
+		_spec.AddField(tasktemplate.FieldDueOffset, field.TypeInt, value)
+	}
+	if ttuo.mutation.DueOffsetCleared() {
+		_spec.ClearField(tasktemplate.FieldDueOffset, field.TypeInt)
+	}
+	if value, ok := ttuo.mutation.OriginalEstimate(); ok {
+		_spec.SetField(tasktemplate.FieldOriginalEstimate, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.AddedOriginalEstimate(); ok {
+		_spec.AddField(tasktemplate.FieldOriginalEstimate, field.TypeInt, value)
+	}
+	if ttuo.mutation.OriginalEstimateCleared() {
+		_spec.ClearField(tasktemplate.FieldOriginalEstimate, field.TypeInt)
+	}
+	if value, ok := ttuo.mutation.CreatorID(); ok {
+		_spec.SetField(tasktemplate.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.AddedCreatorID(); ok {
+		_spec.AddField(tasktemplate.FieldCreatorID, field.TypeInt, value)
+	}
+	if value, ok := ttuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ttuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.ProjectTable,
+			Columns: []string{tasktemplate.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.ProjectTable,
+			Columns: []string{tasktemplate.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ttuo.mutation.RecurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tasktemplate.RecurrencesTable,
+			Columns: []string{tasktemplate.RecurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttuo.mutation.RemovedRecurrencesIDs(); len(nodes) > 0 && !ttuo.mutation.RecurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tasktemplate.RecurrencesTable,
+			Columns: []string{tasktemplate.RecurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttuo.mutation.RecurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tasktemplate.RecurrencesTable,
+			Columns: []string{tasktemplate.RecurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrecurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskTemplate{config: ttuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ttuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasktemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ttuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+)
+
+// TaskView is the model entity for the TaskView schema.
+type TaskView struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID int `json:"org_id"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility taskview.Visibility `json:"visibility"`
+	// Filters holds the value of the "filters" field.
+	Filters map[string]string `json:"filters"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskViewQuery when eager-loading is set.
+	Edges        TaskViewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskViewEdges holds the relations/edges for other nodes in the graph.
+type TaskViewEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskViewEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskView) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskview.FieldFilters:
+			values[i] = new([]byte)
+		case taskview.FieldID, taskview.FieldOrgID, taskview.FieldEmployeeID, taskview.FieldProjectID:
+			values[i] = new(sql.NullInt64)
+		case taskview.FieldName, taskview.FieldVisibility, taskview.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case taskview.FieldCreatedAt, taskview.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskView fields.
+func (tv *TaskView) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskview.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tv.ID = int(value.Int64)
+		case taskview.FieldOrgID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				tv.OrgID = int(value.Int64)
+			}
+		case taskview.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				tv.EmployeeID = int(value.Int64)
+			}
+		case taskview.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				tv.ProjectID = int(value.Int64)
+			}
+		case taskview.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				tv.Name = value.String
+			}
+		case taskview.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				tv.Visibility = taskview.Visibility(value.String)
+			}
+		case taskview.FieldFilters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tv.Filters); err != nil {
+					return fmt.Errorf("unmarshal field filters: %w", err)
+				}
+			}
+		case taskview.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				tv.Timezone = value.String
+			}
+		case taskview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tv.CreatedAt = value.Time
+			}
+		case taskview.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tv.UpdatedAt = value.Time
+			}
+		default:
+			tv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskView.
+// This includes values selected through modifiers, order, etc.
+func (tv *TaskView) Value(name string) (ent.Value, error) {
+	return tv.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the TaskView entity.
+func (tv *TaskView) QueryProject() *ProjectQuery {
+	return NewTaskViewClient(tv.config).QueryProject(tv)
+}
+
+// Update returns a builder for updating this TaskView.
+// Note that you need to call TaskView.Unwrap() before calling this method if this TaskView
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tv *TaskView) Update() *TaskViewUpdateOne {
+	return NewTaskViewClient(tv.config).UpdateOne(tv)
+}
+
+// Unwrap unwraps the TaskView entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tv *TaskView) Unwrap() *TaskView {
+	_tx, ok := tv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskView is not a transactional entity")
+	}
+	tv.config.driver = _tx.drv
+	return tv
+}
+
+// String implements the fmt.Stringer.
+func (tv *TaskView) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskView(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tv.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", tv.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", tv.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", tv.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(tv.Name)
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", tv.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("filters=")
+	builder.WriteString(fmt.Sprintf("%v", tv.Filters))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(tv.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskViews is a parsable slice of TaskView.
+type TaskViews []*TaskView
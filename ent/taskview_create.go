@@ -0,0 +1,1016 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+)
+
+// TaskViewCreate is the builder for creating a TaskView entity.
+type TaskViewCreate struct {
+	config
+	mutation *TaskViewMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (tvc *TaskViewCreate) SetOrgID(i int) *TaskViewCreate {
+	tvc.mutation.SetOrgID(i)
+	return tvc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (tvc *TaskViewCreate) SetEmployeeID(i int) *TaskViewCreate {
+	tvc.mutation.SetEmployeeID(i)
+	return tvc
+}
+
+// SetProjectID sets the "project_id" field.
+func (tvc *TaskViewCreate) SetProjectID(i int) *TaskViewCreate {
+	tvc.mutation.SetProjectID(i)
+	return tvc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tvc *TaskViewCreate) SetNillableProjectID(i *int) *TaskViewCreate {
+	if i != nil {
+		tvc.SetProjectID(*i)
+	}
+	return tvc
+}
+
+// SetName sets the "name" field.
+func (tvc *TaskViewCreate) SetName(s string) *TaskViewCreate {
+	tvc.mutation.SetName(s)
+	return tvc
+}
+
+// SetVisibility sets the "visibility" field.
+func (tvc *TaskViewCreate) SetVisibility(t taskview.Visibility) *TaskViewCreate {
+	tvc.mutation.SetVisibility(t)
+	return tvc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tvc *TaskViewCreate) SetNillableVisibility(t *taskview.Visibility) *TaskViewCreate {
+	if t != nil {
+		tvc.SetVisibility(*t)
+	}
+	return tvc
+}
+
+// SetFilters sets the "filters" field.
+func (tvc *TaskViewCreate) SetFilters(m map[string]string) *TaskViewCreate {
+	tvc.mutation.SetFilters(m)
+	return tvc
+}
+
+// SetTimezone sets the "timezone" field.
+func (tvc *TaskViewCreate) SetTimezone(s string) *TaskViewCreate {
+	tvc.mutation.SetTimezone(s)
+	return tvc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (tvc *TaskViewCreate) SetNillableTimezone(s *string) *TaskViewCreate {
+	if s != nil {
+		tvc.SetTimezone(*s)
+	}
+	return tvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tvc *TaskViewCreate) SetCreatedAt(t time.Time) *TaskViewCreate {
+	tvc.mutation.SetCreatedAt(t)
+	return tvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tvc *TaskViewCreate) SetNillableCreatedAt(t *time.Time) *TaskViewCreate {
+	if t != nil {
+		tvc.SetCreatedAt(*t)
+	}
+	return tvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvc *TaskViewCreate) SetUpdatedAt(t time.Time) *TaskViewCreate {
+	tvc.mutation.SetUpdatedAt(t)
+	return tvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tvc *TaskViewCreate) SetNillableUpdatedAt(t *time.Time) *TaskViewCreate {
+	if t != nil {
+		tvc.SetUpdatedAt(*t)
+	}
+	return tvc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tvc *TaskViewCreate) SetProject(p *Project) *TaskViewCreate {
+	return tvc.SetProjectID(p.ID)
+}
+
+// Mutation returns the TaskViewMutation object of the builder.
+func (tvc *TaskViewCreate) Mutation() *TaskViewMutation {
+	return tvc.mutation
+}
+
+// Save creates the TaskView in the database.
+func (tvc *TaskViewCreate) Save(ctx context.Context) (*TaskView, error) {
+	tvc.defaults()
+	return withHooks(ctx, tvc.sqlSave, tvc.mutation, tvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tvc *TaskViewCreate) SaveX(ctx context.Context) *TaskView {
+	v, err := tvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tvc *TaskViewCreate) Exec(ctx context.Context) error {
+	_, err := tvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvc *TaskViewCreate) ExecX(ctx context.Context) {
+	if err := tvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvc *TaskViewCreate) defaults() {
+	if _, ok := tvc.mutation.Visibility(); !ok {
+		v := taskview.DefaultVisibility
+		tvc.mutation.SetVisibility(v)
+	}
+	if _, ok := tvc.mutation.Timezone(); !ok {
+		v := taskview.DefaultTimezone
+		tvc.mutation.SetTimezone(v)
+	}
+	if _, ok := tvc.mutation.CreatedAt(); !ok {
+		v := taskview.DefaultCreatedAt()
+		tvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tvc.mutation.UpdatedAt(); !ok {
+		v := taskview.DefaultUpdatedAt()
+		tvc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvc *TaskViewCreate) check() error {
+	if _, ok := tvc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "TaskView.org_id"`)}
+	}
+	if _, ok := tvc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "TaskView.employee_id"`)}
+	}
+	if _, ok := tvc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TaskView.name"`)}
+	}
+	if v, ok := tvc.mutation.Name(); ok {
+		if err := taskview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskView.name": %w`, err)}
+		}
+	}
+	if _, ok := tvc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "TaskView.visibility"`)}
+	}
+	if v, ok := tvc.mutation.Visibility(); ok {
+		if err := taskview.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "TaskView.visibility": %w`, err)}
+		}
+	}
+	if _, ok := tvc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "TaskView.timezone"`)}
+	}
+	if _, ok := tvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskView.created_at"`)}
+	}
+	if _, ok := tvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaskView.updated_at"`)}
+	}
+	return nil
+}
+
+func (tvc *TaskViewCreate) sqlSave(ctx context.Context) (*TaskView, error) {
+	if err := tvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tvc.mutation.id = &_node.ID
+	tvc.mutation.done = true
+	return _node, nil
+}
+
+func (tvc *TaskViewCreate) createSpec() (*TaskView, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskView{config: tvc.config}
+		_spec = sqlgraph.NewCreateSpec(taskview.Table, sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tvc.conflict
+	if value, ok := tvc.mutation.OrgID(); ok {
+		_spec.SetField(taskview.FieldOrgID, field.TypeInt, value)
+		_node.OrgID = value
+	}
+	if value, ok := tvc.mutation.EmployeeID(); ok {
+		_spec.SetField(taskview.FieldEmployeeID, field.TypeInt, value)
+		_node.EmployeeID = value
+	}
+	if value, ok := tvc.mutation.Name(); ok {
+		_spec.SetField(taskview.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tvc.mutation.Visibility(); ok {
+		_spec.SetField(taskview.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := tvc.mutation.Filters(); ok {
+		_spec.SetField(taskview.FieldFilters, field.TypeJSON, value)
+		_node.Filters = value
+	}
+	if value, ok := tvc.mutation.Timezone(); ok {
+		_spec.SetField(taskview.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := tvc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskview.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := tvc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskview.ProjectTable,
+			Columns: []string{taskview.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskView.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskViewUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (tvc *TaskViewCreate) OnConflict(opts ...sql.ConflictOption) *TaskViewUpsertOne {
+	tvc.conflict = opts
+	return &TaskViewUpsertOne{
+		create: tvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskView.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tvc *TaskViewCreate) OnConflictColumns(columns ...string) *TaskViewUpsertOne {
+	tvc.conflict = append(tvc.conflict, sql.ConflictColumns(columns...))
+	return &TaskViewUpsertOne{
+		create: tvc,
+	}
+}
+
+type (
+	// TaskViewUpsertOne is the builder for "upsert"-ing
+	//  one TaskView node.
+	TaskViewUpsertOne struct {
+		create *TaskViewCreate
+	}
+
+	// TaskViewUpsert is the "OnConflict" setter.
+	TaskViewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskViewUpsert) SetOrgID(v int) *TaskViewUpsert {
+	u.Set(taskview.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskViewUpsert) UpdateOrgID() *TaskViewUpsert {
+	u.SetExcluded(taskview.FieldOrgID)
+	return u
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskViewUpsert) AddOrgID(v int) *TaskViewUpsert {
+	u.Add(taskview.FieldOrgID, v)
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskViewUpsert) SetEmployeeID(v int) *TaskViewUpsert {
+	u.Set(taskview.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskViewUpsert) UpdateEmployeeID() *TaskViewUpsert {
+	u.SetExcluded(taskview.FieldEmployeeID)
+	return u
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskViewUpsert) AddEmployeeID(v int) *TaskViewUpsert {
+	u.Add(taskview.FieldEmployeeID, v)
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskViewUpsert) SetProjectID(v int) *TaskViewUpsert {
+	u.Set(taskview.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskViewUpsert) UpdateProjectID() *TaskViewUpsert {
+	u.SetExcluded(taskview.FieldProjectID)
+	return u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskViewUpsert) ClearProjectID() *TaskViewUpsert {
+	u.SetNull(taskview.FieldProjectID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TaskViewUpsert) SetName(v string) *TaskViewUpsert {
+	u.Set(taskview.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskViewUpsert) UpdateName() *TaskViewUpsert {
+	u.SetExcluded(taskview.FieldName)
+	return u
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *TaskViewUpsert) SetVisibility(v taskview.Visibility) *TaskViewUpsert {
+	u.Set(taskview.FieldVisibility, v)
+	return u
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *TaskViewUpsert) UpdateVisibility() *TaskViewUpsert {
+	u.SetExcluded(taskview.FieldVisibility)
+	return u
+}
+
+// SetFilters sets the "filters" field.
+func (u *TaskViewUpsert) SetFilters(v map[string]string) *TaskViewUpsert {
+	u.Set(taskview.FieldFilters, v)
+	return u
+}
+
+// UpdateFilters sets the "filters" field to the value that was provided on create.
+func (u *TaskViewUpsert) UpdateFilters() *TaskViewUpsert {
+	u.SetExcluded(taskview.FieldFilters)
+	return u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (u *TaskViewUpsert) ClearFilters() *TaskViewUpsert {
+	u.SetNull(taskview.FieldFilters)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *TaskViewUpsert) SetTimezone(v string) *TaskViewUpsert {
+	u.Set(taskview.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskViewUpsert) UpdateTimezone() *TaskViewUpsert {
+	u.SetExcluded(taskview.FieldTimezone)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskViewUpsert) SetUpdatedAt(v time.Time) *TaskViewUpsert {
+	u.Set(taskview.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskViewUpsert) UpdateUpdatedAt() *TaskViewUpsert {
+	u.SetExcluded(taskview.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskView.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskViewUpsertOne) UpdateNewValues() *TaskViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskview.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskView.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskViewUpsertOne) Ignore() *TaskViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskViewUpsertOne) DoNothing() *TaskViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskViewCreate.OnConflict
+// documentation for more info.
+func (u *TaskViewUpsertOne) Update(set func(*TaskViewUpsert)) *TaskViewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskViewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskViewUpsertOne) SetOrgID(v int) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskViewUpsertOne) AddOrgID(v int) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskViewUpsertOne) UpdateOrgID() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskViewUpsertOne) SetEmployeeID(v int) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskViewUpsertOne) AddEmployeeID(v int) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskViewUpsertOne) UpdateEmployeeID() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskViewUpsertOne) SetProjectID(v int) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskViewUpsertOne) UpdateProjectID() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskViewUpsertOne) ClearProjectID() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TaskViewUpsertOne) SetName(v string) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskViewUpsertOne) UpdateName() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *TaskViewUpsertOne) SetVisibility(v taskview.Visibility) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *TaskViewUpsertOne) UpdateVisibility() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetFilters sets the "filters" field.
+func (u *TaskViewUpsertOne) SetFilters(v map[string]string) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetFilters(v)
+	})
+}
+
+// UpdateFilters sets the "filters" field to the value that was provided on create.
+func (u *TaskViewUpsertOne) UpdateFilters() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateFilters()
+	})
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (u *TaskViewUpsertOne) ClearFilters() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.ClearFilters()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *TaskViewUpsertOne) SetTimezone(v string) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskViewUpsertOne) UpdateTimezone() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskViewUpsertOne) SetUpdatedAt(v time.Time) *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskViewUpsertOne) UpdateUpdatedAt() *TaskViewUpsertOne {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskViewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskViewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskViewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskViewUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskViewUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskViewCreateBulk is the builder for creating many TaskView entities in bulk.
+type TaskViewCreateBulk struct {
+	config
+	err      error
+	builders []*TaskViewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskView entities in the database.
+func (tvcb *TaskViewCreateBulk) Save(ctx context.Context) ([]*TaskView, error) {
+	if tvcb.err != nil {
+		return nil, tvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tvcb.builders))
+	nodes := make([]*TaskView, len(tvcb.builders))
+	mutators := make([]Mutator, len(tvcb.builders))
+	for i := range tvcb.builders {
+		func(i int, root context.Context) {
+			builder := tvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskViewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvcb *TaskViewCreateBulk) SaveX(ctx context.Context) []*TaskView {
+	v, err := tvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tvcb *TaskViewCreateBulk) Exec(ctx context.Context) error {
+	_, err := tvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvcb *TaskViewCreateBulk) ExecX(ctx context.Context) {
+	if err := tvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskView.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskViewUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (tvcb *TaskViewCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskViewUpsertBulk {
+	tvcb.conflict = opts
+	return &TaskViewUpsertBulk{
+		create: tvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskView.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tvcb *TaskViewCreateBulk) OnConflictColumns(columns ...string) *TaskViewUpsertBulk {
+	tvcb.conflict = append(tvcb.conflict, sql.ConflictColumns(columns...))
+	return &TaskViewUpsertBulk{
+		create: tvcb,
+	}
+}
+
+// TaskViewUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskView nodes.
+type TaskViewUpsertBulk struct {
+	create *TaskViewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskView.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskViewUpsertBulk) UpdateNewValues() *TaskViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskview.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskView.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskViewUpsertBulk) Ignore() *TaskViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskViewUpsertBulk) DoNothing() *TaskViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskViewCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskViewUpsertBulk) Update(set func(*TaskViewUpsert)) *TaskViewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskViewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *TaskViewUpsertBulk) SetOrgID(v int) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// AddOrgID adds v to the "org_id" field.
+func (u *TaskViewUpsertBulk) AddOrgID(v int) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.AddOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *TaskViewUpsertBulk) UpdateOrgID() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskViewUpsertBulk) SetEmployeeID(v int) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskViewUpsertBulk) AddEmployeeID(v int) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskViewUpsertBulk) UpdateEmployeeID() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TaskViewUpsertBulk) SetProjectID(v int) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TaskViewUpsertBulk) UpdateProjectID() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TaskViewUpsertBulk) ClearProjectID() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TaskViewUpsertBulk) SetName(v string) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TaskViewUpsertBulk) UpdateName() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *TaskViewUpsertBulk) SetVisibility(v taskview.Visibility) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *TaskViewUpsertBulk) UpdateVisibility() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetFilters sets the "filters" field.
+func (u *TaskViewUpsertBulk) SetFilters(v map[string]string) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetFilters(v)
+	})
+}
+
+// UpdateFilters sets the "filters" field to the value that was provided on create.
+func (u *TaskViewUpsertBulk) UpdateFilters() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateFilters()
+	})
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (u *TaskViewUpsertBulk) ClearFilters() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.ClearFilters()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *TaskViewUpsertBulk) SetTimezone(v string) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *TaskViewUpsertBulk) UpdateTimezone() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskViewUpsertBulk) SetUpdatedAt(v time.Time) *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskViewUpsertBulk) UpdateUpdatedAt() *TaskViewUpsertBulk {
+	return u.Update(func(s *TaskViewUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskViewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskViewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskViewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskViewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+)
+
+// TaskViewDelete is the builder for deleting a TaskView entity.
+type TaskViewDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskViewMutation
+}
+
+// Where appends a list predicates to the TaskViewDelete builder.
+func (tvd *TaskViewDelete) Where(ps ...predicate.TaskView) *TaskViewDelete {
+	tvd.mutation.Where(ps...)
+	return tvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tvd *TaskViewDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tvd.sqlExec, tvd.mutation, tvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvd *TaskViewDelete) ExecX(ctx context.Context) int {
+	n, err := tvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tvd *TaskViewDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskview.Table, sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt))
+	if ps := tvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tvd.mutation.done = true
+	return affected, err
+}
+
+// TaskViewDeleteOne is the builder for deleting a single TaskView entity.
+type TaskViewDeleteOne struct {
+	tvd *TaskViewDelete
+}
+
+// Where appends a list predicates to the TaskViewDelete builder.
+func (tvdo *TaskViewDeleteOne) Where(ps ...predicate.TaskView) *TaskViewDeleteOne {
+	tvdo.tvd.mutation.Where(ps...)
+	return tvdo
+}
+
+// Exec executes the deletion query.
+func (tvdo *TaskViewDeleteOne) Exec(ctx context.Context) error {
+	n, err := tvdo.tvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskview.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvdo *TaskViewDeleteOne) ExecX(ctx context.Context) {
+	if err := tvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+)
+
+// TaskViewQuery is the builder for querying TaskView entities.
+type TaskViewQuery struct {
+	config
+	ctx         *QueryContext
+	order       []taskview.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.TaskView
+	withProject *ProjectQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskViewQuery builder.
+func (tvq *TaskViewQuery) Where(ps ...predicate.TaskView) *TaskViewQuery {
+	tvq.predicates = append(tvq.predicates, ps...)
+	return tvq
+}
+
+// Limit the number of records to be returned by this query.
+func (tvq *TaskViewQuery) Limit(limit int) *TaskViewQuery {
+	tvq.ctx.Limit = &limit
+	return tvq
+}
+
+// Offset to start from.
+func (tvq *TaskViewQuery) Offset(offset int) *TaskViewQuery {
+	tvq.ctx.Offset = &offset
+	return tvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tvq *TaskViewQuery) Unique(unique bool) *TaskViewQuery {
+	tvq.ctx.Unique = &unique
+	return tvq
+}
+
+// Order specifies how the records should be ordered.
+func (tvq *TaskViewQuery) Order(o ...taskview.OrderOption) *TaskViewQuery {
+	tvq.order = append(tvq.order, o...)
+	return tvq
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (tvq *TaskViewQuery) QueryProject() *ProjectQuery {
+	query := (&ProjectClient{config: tvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskview.Table, taskview.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskview.ProjectTable, taskview.ProjectColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskView entity from the query.
+// Returns a *NotFoundError when no TaskView was found.
+func (tvq *TaskViewQuery) First(ctx context.Context) (*TaskView, error) {
+	nodes, err := tvq.Limit(1).All(setContextOp(ctx, tvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskview.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tvq *TaskViewQuery) FirstX(ctx context.Context) *TaskView {
+	node, err := tvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskView ID from the query.
+// Returns a *NotFoundError when no TaskView ID was found.
+func (tvq *TaskViewQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tvq.Limit(1).IDs(setContextOp(ctx, tvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskview.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tvq *TaskViewQuery) FirstIDX(ctx context.Context) int {
+	id, err := tvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskView entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskView entity is found.
+// Returns a *NotFoundError when no TaskView entities are found.
+func (tvq *TaskViewQuery) Only(ctx context.Context) (*TaskView, error) {
+	nodes, err := tvq.Limit(2).All(setContextOp(ctx, tvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskview.Label}
+	default:
+		return nil, &NotSingularError{taskview.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tvq *TaskViewQuery) OnlyX(ctx context.Context) *TaskView {
+	node, err := tvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskView ID in the query.
+// Returns a *NotSingularError when more than one TaskView ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tvq *TaskViewQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tvq.Limit(2).IDs(setContextOp(ctx, tvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskview.Label}
+	default:
+		err = &NotSingularError{taskview.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tvq *TaskViewQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskViews.
+func (tvq *TaskViewQuery) All(ctx context.Context) ([]*TaskView, error) {
+	ctx = setContextOp(ctx, tvq.ctx, ent.OpQueryAll)
+	if err := tvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskView, *TaskViewQuery]()
+	return withInterceptors[[]*TaskView](ctx, tvq, qr, tvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tvq *TaskViewQuery) AllX(ctx context.Context) []*TaskView {
+	nodes, err := tvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskView IDs.
+func (tvq *TaskViewQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tvq.ctx.Unique == nil && tvq.path != nil {
+		tvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tvq.ctx, ent.OpQueryIDs)
+	if err = tvq.Select(taskview.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tvq *TaskViewQuery) IDsX(ctx context.Context) []int {
+	ids, err := tvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tvq *TaskViewQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tvq.ctx, ent.OpQueryCount)
+	if err := tvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tvq, querierCount[*TaskViewQuery](), tvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tvq *TaskViewQuery) CountX(ctx context.Context) int {
+	count, err := tvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tvq *TaskViewQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tvq.ctx, ent.OpQueryExist)
+	switch _, err := tvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tvq *TaskViewQuery) ExistX(ctx context.Context) bool {
+	exist, err := tvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskViewQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tvq *TaskViewQuery) Clone() *TaskViewQuery {
+	if tvq == nil {
+		return nil
+	}
+	return &TaskViewQuery{
+		config:      tvq.config,
+		ctx:         tvq.ctx.Clone(),
+		order:       append([]taskview.OrderOption{}, tvq.order...),
+		inters:      append([]Interceptor{}, tvq.inters...),
+		predicates:  append([]predicate.TaskView{}, tvq.predicates...),
+		withProject: tvq.withProject.Clone(),
+		// clone intermediate query.
+		sql:  tvq.sql.Clone(),
+		path: tvq.path,
+	}
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (tvq *TaskViewQuery) WithProject(opts ...func(*ProjectQuery)) *TaskViewQuery {
+	query := (&ProjectClient{config: tvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tvq.withProject = query
+	return tvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int `json:"org_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskView.Query().
+//		GroupBy(taskview.FieldOrgID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tvq *TaskViewQuery) GroupBy(field string, fields ...string) *TaskViewGroupBy {
+	tvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskViewGroupBy{build: tvq}
+	grbuild.flds = &tvq.ctx.Fields
+	grbuild.label = taskview.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID int `json:"org_id"`
+//	}
+//
+//	client.TaskView.Query().
+//		Select(taskview.FieldOrgID).
+//		Scan(ctx, &v)
+func (tvq *TaskViewQuery) Select(fields ...string) *TaskViewSelect {
+	tvq.ctx.Fields = append(tvq.ctx.Fields, fields...)
+	sbuild := &TaskViewSelect{TaskViewQuery: tvq}
+	sbuild.label = taskview.Label
+	sbuild.flds, sbuild.scan = &tvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskViewSelect configured with the given aggregations.
+func (tvq *TaskViewQuery) Aggregate(fns ...AggregateFunc) *TaskViewSelect {
+	return tvq.Select().Aggregate(fns...)
+}
+
+func (tvq *TaskViewQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tvq.ctx.Fields {
+		if !taskview.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tvq.path != nil {
+		prev, err := tvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tvq.sql = prev
+	}
+	return nil
+}
+
+func (tvq *TaskViewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskView, error) {
+	var (
+		nodes       = []*TaskView{}
+		_spec       = tvq.querySpec()
+		loadedTypes = [1]bool{
+			tvq.withProject != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskView).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskView{config: tvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tvq.withProject; query != nil {
+		if err := tvq.loadProject(ctx, query, nodes, nil,
+			func(n *TaskView, e *Project) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tvq *TaskViewQuery) loadProject(ctx context.Context, query *ProjectQuery, nodes []*TaskView, init func(*TaskView), assign func(*TaskView, *Project)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TaskView)
+	for i := range nodes {
+		fk := nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(project.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tvq *TaskViewQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tvq.querySpec()
+	_spec.Node.Columns = tvq.ctx.Fields
+	if len(tvq.ctx.Fields) > 0 {
+		_spec.Unique = tvq.ctx.Unique != nil && *tvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tvq.driver, _spec)
+}
+
+func (tvq *TaskViewQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskview.Table, taskview.Columns, sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt))
+	_spec.From = tvq.sql
+	if unique := tvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskview.FieldID)
+		for i := range fields {
+			if fields[i] != taskview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tvq.withProject != nil {
+			_spec.Node.AddColumnOnce(taskview.FieldProjectID)
+		}
+	}
+	if ps := tvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tvq *TaskViewQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tvq.driver.Dialect())
+	t1 := builder.Table(taskview.Table)
+	columns := tvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskview.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tvq.sql != nil {
+		selector = tvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tvq.ctx.Unique != nil && *tvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tvq.predicates {
+		p(selector)
+	}
+	for _, p := range tvq.order {
+		p(selector)
+	}
+	if offset := tvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaskViewGroupBy is the group-by builder for TaskView entities.
+type TaskViewGroupBy struct {
+	selector
+	build *TaskViewQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tvgb *TaskViewGroupBy) Aggregate(fns ...AggregateFunc) *TaskViewGroupBy {
+	tvgb.fns = append(tvgb.fns, fns...)
+	return tvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tvgb *TaskViewGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskViewQuery, *TaskViewGroupBy](ctx, tvgb.build, tvgb, tvgb.build.inters, v)
+}
+
+func (tvgb *TaskViewGroupBy) sqlScan(ctx context.Context, root *TaskViewQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tvgb.fns))
+	for _, fn := range tvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tvgb.flds)+len(tvgb.fns))
+		for _, f := range *tvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskViewSelect is the builder for selecting fields of TaskView entities.
+type TaskViewSelect struct {
+	*TaskViewQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tvs *TaskViewSelect) Aggregate(fns ...AggregateFunc) *TaskViewSelect {
+	tvs.fns = append(tvs.fns, fns...)
+	return tvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tvs *TaskViewSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tvs.ctx, ent.OpQuerySelect)
+	if err := tvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskViewQuery, *TaskViewSelect](ctx, tvs.TaskViewQuery, tvs, tvs.inters, v)
+}
+
+func (tvs *TaskViewSelect) sqlScan(ctx context.Context, root *TaskViewQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tvs.fns))
+	for _, fn := range tvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+)
+
+// TaskViewUpdate is the builder for updating TaskView entities.
+type TaskViewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskViewMutation
+}
+
+// Where appends a list predicates to the TaskViewUpdate builder.
+func (tvu *TaskViewUpdate) Where(ps ...predicate.TaskView) *TaskViewUpdate {
+	tvu.mutation.Where(ps...)
+	return tvu
+}
+
+// SetOrgID sets the "org_id" field.
+func (tvu *TaskViewUpdate) SetOrgID(i int) *TaskViewUpdate {
+	tvu.mutation.ResetOrgID()
+	tvu.mutation.SetOrgID(i)
+	return tvu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (tvu *TaskViewUpdate) SetNillableOrgID(i *int) *TaskViewUpdate {
+	if i != nil {
+		tvu.SetOrgID(*i)
+	}
+	return tvu
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (tvu *TaskViewUpdate) AddOrgID(i int) *TaskViewUpdate {
+	tvu.mutation.AddOrgID(i)
+	return tvu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (tvu *TaskViewUpdate) SetEmployeeID(i int) *TaskViewUpdate {
+	tvu.mutation.ResetEmployeeID()
+	tvu.mutation.SetEmployeeID(i)
+	return tvu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (tvu *TaskViewUpdate) SetNillableEmployeeID(i *int) *TaskViewUpdate {
+	if i != nil {
+		tvu.SetEmployeeID(*i)
+	}
+	return tvu
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (tvu *TaskViewUpdate) AddEmployeeID(i int) *TaskViewUpdate {
+	tvu.mutation.AddEmployeeID(i)
+	return tvu
+}
+
+// SetProjectID sets the "project_id" field.
+func (tvu *TaskViewUpdate) SetProjectID(i int) *TaskViewUpdate {
+	tvu.mutation.SetProjectID(i)
+	return tvu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tvu *TaskViewUpdate) SetNillableProjectID(i *int) *TaskViewUpdate {
+	if i != nil {
+		tvu.SetProjectID(*i)
+	}
+	return tvu
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tvu *TaskViewUpdate) ClearProjectID() *TaskViewUpdate {
+	tvu.mutation.ClearProjectID()
+	return tvu
+}
+
+// SetName sets the "name" field.
+func (tvu *TaskViewUpdate) SetName(s string) *TaskViewUpdate {
+	tvu.mutation.SetName(s)
+	return tvu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tvu *TaskViewUpdate) SetNillableName(s *string) *TaskViewUpdate {
+	if s != nil {
+		tvu.SetName(*s)
+	}
+	return tvu
+}
+
+// SetVisibility sets the "visibility" field.
+func (tvu *TaskViewUpdate) SetVisibility(t taskview.Visibility) *TaskViewUpdate {
+	tvu.mutation.SetVisibility(t)
+	return tvu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tvu *TaskViewUpdate) SetNillableVisibility(t *taskview.Visibility) *TaskViewUpdate {
+	if t != nil {
+		tvu.SetVisibility(*t)
+	}
+	return tvu
+}
+
+// SetFilters sets the "filters" field.
+func (tvu *TaskViewUpdate) SetFilters(m map[string]string) *TaskViewUpdate {
+	tvu.mutation.SetFilters(m)
+	return tvu
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (tvu *TaskViewUpdate) ClearFilters() *TaskViewUpdate {
+	tvu.mutation.ClearFilters()
+	return tvu
+}
+
+// SetTimezone sets the "timezone" field.
+func (tvu *TaskViewUpdate) SetTimezone(s string) *TaskViewUpdate {
+	tvu.mutation.SetTimezone(s)
+	return tvu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (tvu *TaskViewUpdate) SetNillableTimezone(s *string) *TaskViewUpdate {
+	if s != nil {
+		tvu.SetTimezone(*s)
+	}
+	return tvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvu *TaskViewUpdate) SetUpdatedAt(t time.Time) *TaskViewUpdate {
+	tvu.mutation.SetUpdatedAt(t)
+	return tvu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tvu *TaskViewUpdate) SetProject(p *Project) *TaskViewUpdate {
+	return tvu.SetProjectID(p.ID)
+}
+
+// Mutation returns the TaskViewMutation object of the builder.
+func (tvu *TaskViewUpdate) Mutation() *TaskViewMutation {
+	return tvu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tvu *TaskViewUpdate) ClearProject() *TaskViewUpdate {
+	tvu.mutation.ClearProject()
+	return tvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tvu *TaskViewUpdate) Save(ctx context.Context) (int, error) {
+	tvu.defaults()
+	return withHooks(ctx, tvu.sqlSave, tvu.mutation, tvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvu *TaskViewUpdate) SaveX(ctx context.Context) int {
+	affected, err := tvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tvu *TaskViewUpdate) Exec(ctx context.Context) error {
+	_, err := tvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvu *TaskViewUpdate) ExecX(ctx context.Context) {
+	if err := tvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvu *TaskViewUpdate) defaults() {
+	if _, ok := tvu.mutation.UpdatedAt(); !ok {
+		v := taskview.UpdateDefaultUpdatedAt()
+		tvu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvu *TaskViewUpdate) check() error {
+	if v, ok := tvu.mutation.Name(); ok {
+		if err := taskview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskView.name": %w`, err)}
+		}
+	}
+	if v, ok := tvu.mutation.Visibility(); ok {
+		if err := taskview.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "TaskView.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tvu *TaskViewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskview.Table, taskview.Columns, sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt))
+	if ps := tvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tvu.mutation.OrgID(); ok {
+		_spec.SetField(taskview.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tvu.mutation.AddedOrgID(); ok {
+		_spec.AddField(taskview.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tvu.mutation.EmployeeID(); ok {
+		_spec.SetField(taskview.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := tvu.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(taskview.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := tvu.mutation.Name(); ok {
+		_spec.SetField(taskview.FieldName, field.TypeString, value)
+	}
+	if value, ok := tvu.mutation.Visibility(); ok {
+		_spec.SetField(taskview.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := tvu.mutation.Filters(); ok {
+		_spec.SetField(taskview.FieldFilters, field.TypeJSON, value)
+	}
+	if tvu.mutation.FiltersCleared() {
+		_spec.ClearField(taskview.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := tvu.mutation.Timezone(); ok {
+		_spec.SetField(taskview.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := tvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tvu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskview.ProjectTable,
+			Columns: []string{taskview.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskview.ProjectTable,
+			Columns: []string{taskview.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tvu.mutation.done = true
+	return n, nil
+}
+
+// TaskViewUpdateOne is the builder for updating a single TaskView entity.
+type TaskViewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskViewMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (tvuo *TaskViewUpdateOne) SetOrgID(i int) *TaskViewUpdateOne {
+	tvuo.mutation.ResetOrgID()
+	tvuo.mutation.SetOrgID(i)
+	return tvuo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (tvuo *TaskViewUpdateOne) SetNillableOrgID(i *int) *TaskViewUpdateOne {
+	if i != nil {
+		tvuo.SetOrgID(*i)
+	}
+	return tvuo
+}
+
+// AddOrgID adds i to the "org_id" field.
+func (tvuo *TaskViewUpdateOne) AddOrgID(i int) *TaskViewUpdateOne {
+	tvuo.mutation.AddOrgID(i)
+	return tvuo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (tvuo *TaskViewUpdateOne) SetEmployeeID(i int) *TaskViewUpdateOne {
+	tvuo.mutation.ResetEmployeeID()
+	tvuo.mutation.SetEmployeeID(i)
+	return tvuo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (tvuo *TaskViewUpdateOne) SetNillableEmployeeID(i *int) *TaskViewUpdateOne {
+	if i != nil {
+		tvuo.SetEmployeeID(*i)
+	}
+	return tvuo
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (tvuo *TaskViewUpdateOne) AddEmployeeID(i int) *TaskViewUpdateOne {
+	tvuo.mutation.AddEmployeeID(i)
+	return tvuo
+}
+
+// SetProjectID sets the "project_id" field.
+func (tvuo *TaskViewUpdateOne) SetProjectID(i int) *TaskViewUpdateOne {
+	tvuo.mutation.SetProjectID(i)
+	return tvuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tvuo *TaskViewUpdateOne) SetNillableProjectID(i *int) *TaskViewUpdateOne {
+	if i != nil {
+		tvuo.SetProjectID(*i)
+	}
+	return tvuo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tvuo *TaskViewUpdateOne) ClearProjectID() *TaskViewUpdateOne {
+	tvuo.mutation.ClearProjectID()
+	return tvuo
+}
+
+// SetName sets the "name" field.
+func (tvuo *TaskViewUpdateOne) SetName(s string) *TaskViewUpdateOne {
+	tvuo.mutation.SetName(s)
+	return tvuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tvuo *TaskViewUpdateOne) SetNillableName(s *string) *TaskViewUpdateOne {
+	if s != nil {
+		tvuo.SetName(*s)
+	}
+	return tvuo
+}
+
+// SetVisibility sets the "visibility" field.
+func (tvuo *TaskViewUpdateOne) SetVisibility(t taskview.Visibility) *TaskViewUpdateOne {
+	tvuo.mutation.SetVisibility(t)
+	return tvuo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tvuo *TaskViewUpdateOne) SetNillableVisibility(t *taskview.Visibility) *TaskViewUpdateOne {
+	if t != nil {
+		tvuo.SetVisibility(*t)
+	}
+	return tvuo
+}
+
+// SetFilters sets the "filters" field.
+func (tvuo *TaskViewUpdateOne) SetFilters(m map[string]string) *TaskViewUpdateOne {
+	tvuo.mutation.SetFilters(m)
+	return tvuo
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (tvuo *TaskViewUpdateOne) ClearFilters() *TaskViewUpdateOne {
+	tvuo.mutation.ClearFilters()
+	return tvuo
+}
+
+// SetTimezone sets the "timezone" field.
+func (tvuo *TaskViewUpdateOne) SetTimezone(s string) *TaskViewUpdateOne {
+	tvuo.mutation.SetTimezone(s)
+	return tvuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (tvuo *TaskViewUpdateOne) SetNillableTimezone(s *string) *TaskViewUpdateOne {
+	if s != nil {
+		tvuo.SetTimezone(*s)
+	}
+	return tvuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvuo *TaskViewUpdateOne) SetUpdatedAt(t time.Time) *TaskViewUpdateOne {
+	tvuo.mutation.SetUpdatedAt(t)
+	return tvuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tvuo *TaskViewUpdateOne) SetProject(p *Project) *TaskViewUpdateOne {
+	return tvuo.SetProjectID(p.ID)
+}
+
+// Mutation returns the TaskViewMutation object of the builder.
+func (tvuo *TaskViewUpdateOne) Mutation() *TaskViewMutation {
+	return tvuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tvuo *TaskViewUpdateOne) ClearProject() *TaskViewUpdateOne {
+	tvuo.mutation.ClearProject()
+	return tvuo
+}
+
+// Where appends a list predicates to the TaskViewUpdate builder.
+func (tvuo *TaskViewUpdateOne) Where(ps ...predicate.TaskView) *TaskViewUpdateOne {
+	tvuo.mutation.Where(ps...)
+	return tvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tvuo *TaskViewUpdateOne) Select(field string, fields ...string) *TaskViewUpdateOne {
+	tvuo.fields = append([]string{field}, fields...)
+	return tvuo
+}
+
+// Save executes the query and returns the updated TaskView entity.
+func (tvuo *TaskViewUpdateOne) Save(ctx context.Context) (*TaskView, error) {
+	tvuo.defaults()
+	return withHooks(ctx, tvuo.sqlSave, tvuo.mutation, tvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvuo *TaskViewUpdateOne) SaveX(ctx context.Context) *TaskView {
+	node, err := tvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tvuo *TaskViewUpdateOne) Exec(ctx context.Context) error {
+	_, err := tvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvuo *TaskViewUpdateOne) ExecX(ctx context.Context) {
+	if err := tvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvuo *TaskViewUpdateOne) defaults() {
+	if _, ok := tvuo.mutation.UpdatedAt(); !ok {
+		v := taskview.UpdateDefaultUpdatedAt()
+		tvuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvuo *TaskViewUpdateOne) check() error {
+	if v, ok := tvuo.mutation.Name(); ok {
+		if err := taskview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskView.name": %w`, err)}
+		}
+	}
+	if v, ok := tvuo.mutation.Visibility(); ok {
+		if err := taskview.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "TaskView.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tvuo *TaskViewUpdateOne) sqlSave(ctx context.Context) (_node *TaskView, err error) {
+	if err := tvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskview.Table, taskview.Columns, sqlgraph.NewFieldSpec(taskview.FieldID, field.TypeInt))
+	id, ok := tvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskView.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskview.FieldID)
+		for _, f := range fields {
+			if !taskview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tvuo.mutation.OrgID(); ok {
+		_spec.SetField(taskview.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tvuo.mutation.AddedOrgID(); ok {
+		_spec.AddField(taskview.FieldOrgID, field.TypeInt, value)
+	}
+	if value, ok := tvuo.mutation.EmployeeID(); ok {
+		_spec.SetField(taskview.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := tvuo.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(taskview.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := tvuo.mutation.Name(); ok {
+		_spec.SetField(taskview.FieldName, field.TypeString, value)
+	}
+	if value, ok := tvuo.mutation.Visibility(); ok {
+		_spec.SetField(taskview.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := tvuo.mutation.Filters(); ok {
+		_spec.SetField(taskview.FieldFilters, field.TypeJSON, value)
+	}
+	if tvuo.mutation.FiltersCleared() {
+		_spec.ClearField(taskview.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := tvuo.mutation.Timezone(); ok {
+		_spec.SetField(taskview.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := tvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tvuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskview.ProjectTable,
+			Columns: []string{taskview.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskview.ProjectTable,
+			Columns: []string{taskview.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskView{config: tvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tvuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+)
+
+// TaskWatcher is the model entity for the TaskWatcher schema.
+type TaskWatcher struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskWatcherQuery when eager-loading is set.
+	Edges        TaskWatcherEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskWatcherEdges holds the relations/edges for other nodes in the graph.
+type TaskWatcherEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskWatcherEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskWatcher) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskwatcher.FieldID, taskwatcher.FieldTaskID, taskwatcher.FieldEmployeeID:
+			values[i] = new(sql.NullInt64)
+		case taskwatcher.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskWatcher fields.
+func (tw *TaskWatcher) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskwatcher.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tw.ID = int(value.Int64)
+		case taskwatcher.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				tw.TaskID = int(value.Int64)
+			}
+		case taskwatcher.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				tw.EmployeeID = int(value.Int64)
+			}
+		case taskwatcher.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tw.CreatedAt = value.Time
+			}
+		default:
+			tw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskWatcher.
+// This includes values selected through modifiers, order, etc.
+func (tw *TaskWatcher) Value(name string) (ent.Value, error) {
+	return tw.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskWatcher entity.
+func (tw *TaskWatcher) QueryTask() *TaskQuery {
+	return NewTaskWatcherClient(tw.config).QueryTask(tw)
+}
+
+// Update returns a builder for updating this TaskWatcher.
+// Note that you need to call TaskWatcher.Unwrap() before calling this method if this TaskWatcher
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tw *TaskWatcher) Update() *TaskWatcherUpdateOne {
+	return NewTaskWatcherClient(tw.config).UpdateOne(tw)
+}
+
+// Unwrap unwraps the TaskWatcher entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tw *TaskWatcher) Unwrap() *TaskWatcher {
+	_tx, ok := tw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskWatcher is not a transactional entity")
+	}
+	tw.config.driver = _tx.drv
+	return tw
+}
+
+// String implements the fmt.Stringer.
+func (tw *TaskWatcher) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskWatcher(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tw.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", tw.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", tw.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tw.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskWatchers is a parsable slice of TaskWatcher.
+type TaskWatchers []*TaskWatcher
@@ -0,0 +1,593 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+)
+
+// TaskWatcherCreate is the builder for creating a TaskWatcher entity.
+type TaskWatcherCreate struct {
+	config
+	mutation *TaskWatcherMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTaskID sets the "task_id" field.
+func (twc *TaskWatcherCreate) SetTaskID(i int) *TaskWatcherCreate {
+	twc.mutation.SetTaskID(i)
+	return twc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (twc *TaskWatcherCreate) SetEmployeeID(i int) *TaskWatcherCreate {
+	twc.mutation.SetEmployeeID(i)
+	return twc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (twc *TaskWatcherCreate) SetCreatedAt(t time.Time) *TaskWatcherCreate {
+	twc.mutation.SetCreatedAt(t)
+	return twc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (twc *TaskWatcherCreate) SetNillableCreatedAt(t *time.Time) *TaskWatcherCreate {
+	if t != nil {
+		twc.SetCreatedAt(*t)
+	}
+	return twc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (twc *TaskWatcherCreate) SetTask(t *Task) *TaskWatcherCreate {
+	return twc.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskWatcherMutation object of the builder.
+func (twc *TaskWatcherCreate) Mutation() *TaskWatcherMutation {
+	return twc.mutation
+}
+
+// Save creates the TaskWatcher in the database.
+func (twc *TaskWatcherCreate) Save(ctx context.Context) (*TaskWatcher, error) {
+	twc.defaults()
+	return withHooks(ctx, twc.sqlSave, twc.mutation, twc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (twc *TaskWatcherCreate) SaveX(ctx context.Context) *TaskWatcher {
+	v, err := twc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (twc *TaskWatcherCreate) Exec(ctx context.Context) error {
+	_, err := twc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twc *TaskWatcherCreate) ExecX(ctx context.Context) {
+	if err := twc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (twc *TaskWatcherCreate) defaults() {
+	if _, ok := twc.mutation.CreatedAt(); !ok {
+		v := taskwatcher.DefaultCreatedAt()
+		twc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twc *TaskWatcherCreate) check() error {
+	if _, ok := twc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskWatcher.task_id"`)}
+	}
+	if _, ok := twc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "TaskWatcher.employee_id"`)}
+	}
+	if _, ok := twc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskWatcher.created_at"`)}
+	}
+	if len(twc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskWatcher.task"`)}
+	}
+	return nil
+}
+
+func (twc *TaskWatcherCreate) sqlSave(ctx context.Context) (*TaskWatcher, error) {
+	if err := twc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := twc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, twc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	twc.mutation.id = &_node.ID
+	twc.mutation.done = true
+	return _node, nil
+}
+
+func (twc *TaskWatcherCreate) createSpec() (*TaskWatcher, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskWatcher{config: twc.config}
+		_spec = sqlgraph.NewCreateSpec(taskwatcher.Table, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = twc.conflict
+	if value, ok := twc.mutation.EmployeeID(); ok {
+		_spec.SetField(taskwatcher.FieldEmployeeID, field.TypeInt, value)
+		_node.EmployeeID = value
+	}
+	if value, ok := twc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskwatcher.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := twc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskWatcher.Create().
+//		SetTaskID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskWatcherUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (twc *TaskWatcherCreate) OnConflict(opts ...sql.ConflictOption) *TaskWatcherUpsertOne {
+	twc.conflict = opts
+	return &TaskWatcherUpsertOne{
+		create: twc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskWatcher.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (twc *TaskWatcherCreate) OnConflictColumns(columns ...string) *TaskWatcherUpsertOne {
+	twc.conflict = append(twc.conflict, sql.ConflictColumns(columns...))
+	return &TaskWatcherUpsertOne{
+		create: twc,
+	}
+}
+
+type (
+	// TaskWatcherUpsertOne is the builder for "upsert"-ing
+	//  one TaskWatcher node.
+	TaskWatcherUpsertOne struct {
+		create *TaskWatcherCreate
+	}
+
+	// TaskWatcherUpsert is the "OnConflict" setter.
+	TaskWatcherUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskWatcherUpsert) SetTaskID(v int) *TaskWatcherUpsert {
+	u.Set(taskwatcher.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskWatcherUpsert) UpdateTaskID() *TaskWatcherUpsert {
+	u.SetExcluded(taskwatcher.FieldTaskID)
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskWatcherUpsert) SetEmployeeID(v int) *TaskWatcherUpsert {
+	u.Set(taskwatcher.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskWatcherUpsert) UpdateEmployeeID() *TaskWatcherUpsert {
+	u.SetExcluded(taskwatcher.FieldEmployeeID)
+	return u
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskWatcherUpsert) AddEmployeeID(v int) *TaskWatcherUpsert {
+	u.Add(taskwatcher.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaskWatcher.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskWatcherUpsertOne) UpdateNewValues() *TaskWatcherUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskwatcher.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskWatcher.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskWatcherUpsertOne) Ignore() *TaskWatcherUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskWatcherUpsertOne) DoNothing() *TaskWatcherUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskWatcherCreate.OnConflict
+// documentation for more info.
+func (u *TaskWatcherUpsertOne) Update(set func(*TaskWatcherUpsert)) *TaskWatcherUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskWatcherUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskWatcherUpsertOne) SetTaskID(v int) *TaskWatcherUpsertOne {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskWatcherUpsertOne) UpdateTaskID() *TaskWatcherUpsertOne {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskWatcherUpsertOne) SetEmployeeID(v int) *TaskWatcherUpsertOne {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskWatcherUpsertOne) AddEmployeeID(v int) *TaskWatcherUpsertOne {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskWatcherUpsertOne) UpdateEmployeeID() *TaskWatcherUpsertOne {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskWatcherUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskWatcherCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskWatcherUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskWatcherUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskWatcherUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskWatcherCreateBulk is the builder for creating many TaskWatcher entities in bulk.
+type TaskWatcherCreateBulk struct {
+	config
+	err      error
+	builders []*TaskWatcherCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskWatcher entities in the database.
+func (twcb *TaskWatcherCreateBulk) Save(ctx context.Context) ([]*TaskWatcher, error) {
+	if twcb.err != nil {
+		return nil, twcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(twcb.builders))
+	nodes := make([]*TaskWatcher, len(twcb.builders))
+	mutators := make([]Mutator, len(twcb.builders))
+	for i := range twcb.builders {
+		func(i int, root context.Context) {
+			builder := twcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskWatcherMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, twcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = twcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, twcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, twcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twcb *TaskWatcherCreateBulk) SaveX(ctx context.Context) []*TaskWatcher {
+	v, err := twcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (twcb *TaskWatcherCreateBulk) Exec(ctx context.Context) error {
+	_, err := twcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twcb *TaskWatcherCreateBulk) ExecX(ctx context.Context) {
+	if err := twcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskWatcher.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskWatcherUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (twcb *TaskWatcherCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskWatcherUpsertBulk {
+	twcb.conflict = opts
+	return &TaskWatcherUpsertBulk{
+		create: twcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskWatcher.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (twcb *TaskWatcherCreateBulk) OnConflictColumns(columns ...string) *TaskWatcherUpsertBulk {
+	twcb.conflict = append(twcb.conflict, sql.ConflictColumns(columns...))
+	return &TaskWatcherUpsertBulk{
+		create: twcb,
+	}
+}
+
+// TaskWatcherUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskWatcher nodes.
+type TaskWatcherUpsertBulk struct {
+	create *TaskWatcherCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskWatcher.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaskWatcherUpsertBulk) UpdateNewValues() *TaskWatcherUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskwatcher.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskWatcher.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskWatcherUpsertBulk) Ignore() *TaskWatcherUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskWatcherUpsertBulk) DoNothing() *TaskWatcherUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskWatcherCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskWatcherUpsertBulk) Update(set func(*TaskWatcherUpsert)) *TaskWatcherUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskWatcherUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskWatcherUpsertBulk) SetTaskID(v int) *TaskWatcherUpsertBulk {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskWatcherUpsertBulk) UpdateTaskID() *TaskWatcherUpsertBulk {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *TaskWatcherUpsertBulk) SetEmployeeID(v int) *TaskWatcherUpsertBulk {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// AddEmployeeID adds v to the "employee_id" field.
+func (u *TaskWatcherUpsertBulk) AddEmployeeID(v int) *TaskWatcherUpsertBulk {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.AddEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *TaskWatcherUpsertBulk) UpdateEmployeeID() *TaskWatcherUpsertBulk {
+	return u.Update(func(s *TaskWatcherUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskWatcherUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskWatcherCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskWatcherCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskWatcherUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
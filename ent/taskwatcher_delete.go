@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+)
+
+// TaskWatcherDelete is the builder for deleting a TaskWatcher entity.
+type TaskWatcherDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskWatcherMutation
+}
+
+// Where appends a list predicates to the TaskWatcherDelete builder.
+func (twd *TaskWatcherDelete) Where(ps ...predicate.TaskWatcher) *TaskWatcherDelete {
+	twd.mutation.Where(ps...)
+	return twd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (twd *TaskWatcherDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, twd.sqlExec, twd.mutation, twd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twd *TaskWatcherDelete) ExecX(ctx context.Context) int {
+	n, err := twd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (twd *TaskWatcherDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskwatcher.Table, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt))
+	if ps := twd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, twd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	twd.mutation.done = true
+	return affected, err
+}
+
+// TaskWatcherDeleteOne is the builder for deleting a single TaskWatcher entity.
+type TaskWatcherDeleteOne struct {
+	twd *TaskWatcherDelete
+}
+
+// Where appends a list predicates to the TaskWatcherDelete builder.
+func (twdo *TaskWatcherDeleteOne) Where(ps ...predicate.TaskWatcher) *TaskWatcherDeleteOne {
+	twdo.twd.mutation.Where(ps...)
+	return twdo
+}
+
+// Exec executes the deletion query.
+func (twdo *TaskWatcherDeleteOne) Exec(ctx context.Context) error {
+	n, err := twdo.twd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskwatcher.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twdo *TaskWatcherDeleteOne) ExecX(ctx context.Context) {
+	if err := twdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
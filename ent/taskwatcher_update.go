@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+)
+
+// TaskWatcherUpdate is the builder for updating TaskWatcher entities.
+type TaskWatcherUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskWatcherMutation
+}
+
+// Where appends a list predicates to the TaskWatcherUpdate builder.
+func (twu *TaskWatcherUpdate) Where(ps ...predicate.TaskWatcher) *TaskWatcherUpdate {
+	twu.mutation.Where(ps...)
+	return twu
+}
+
+// SetTaskID sets the "task_id" field.
+func (twu *TaskWatcherUpdate) SetTaskID(i int) *TaskWatcherUpdate {
+	twu.mutation.SetTaskID(i)
+	return twu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (twu *TaskWatcherUpdate) SetNillableTaskID(i *int) *TaskWatcherUpdate {
+	if i != nil {
+		twu.SetTaskID(*i)
+	}
+	return twu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (twu *TaskWatcherUpdate) SetEmployeeID(i int) *TaskWatcherUpdate {
+	twu.mutation.ResetEmployeeID()
+	twu.mutation.SetEmployeeID(i)
+	return twu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (twu *TaskWatcherUpdate) SetNillableEmployeeID(i *int) *TaskWatcherUpdate {
+	if i != nil {
+		twu.SetEmployeeID(*i)
+	}
+	return twu
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (twu *TaskWatcherUpdate) AddEmployeeID(i int) *TaskWatcherUpdate {
+	twu.mutation.AddEmployeeID(i)
+	return twu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (twu *TaskWatcherUpdate) SetTask(t *Task) *TaskWatcherUpdate {
+	return twu.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskWatcherMutation object of the builder.
+func (twu *TaskWatcherUpdate) Mutation() *TaskWatcherMutation {
+	return twu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (twu *TaskWatcherUpdate) ClearTask() *TaskWatcherUpdate {
+	twu.mutation.ClearTask()
+	return twu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (twu *TaskWatcherUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, twu.sqlSave, twu.mutation, twu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twu *TaskWatcherUpdate) SaveX(ctx context.Context) int {
+	affected, err := twu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (twu *TaskWatcherUpdate) Exec(ctx context.Context) error {
+	_, err := twu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twu *TaskWatcherUpdate) ExecX(ctx context.Context) {
+	if err := twu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twu *TaskWatcherUpdate) check() error {
+	if twu.mutation.TaskCleared() && len(twu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.task"`)
+	}
+	return nil
+}
+
+func (twu *TaskWatcherUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := twu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskwatcher.Table, taskwatcher.Columns, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt))
+	if ps := twu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := twu.mutation.EmployeeID(); ok {
+		_spec.SetField(taskwatcher.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := twu.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(taskwatcher.FieldEmployeeID, field.TypeInt, value)
+	}
+	if twu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, twu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskwatcher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	twu.mutation.done = true
+	return n, nil
+}
+
+// TaskWatcherUpdateOne is the builder for updating a single TaskWatcher entity.
+type TaskWatcherUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskWatcherMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (twuo *TaskWatcherUpdateOne) SetTaskID(i int) *TaskWatcherUpdateOne {
+	twuo.mutation.SetTaskID(i)
+	return twuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (twuo *TaskWatcherUpdateOne) SetNillableTaskID(i *int) *TaskWatcherUpdateOne {
+	if i != nil {
+		twuo.SetTaskID(*i)
+	}
+	return twuo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (twuo *TaskWatcherUpdateOne) SetEmployeeID(i int) *TaskWatcherUpdateOne {
+	twuo.mutation.ResetEmployeeID()
+	twuo.mutation.SetEmployeeID(i)
+	return twuo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (twuo *TaskWatcherUpdateOne) SetNillableEmployeeID(i *int) *TaskWatcherUpdateOne {
+	if i != nil {
+		twuo.SetEmployeeID(*i)
+	}
+	return twuo
+}
+
+// AddEmployeeID adds i to the "employee_id" field.
+func (twuo *TaskWatcherUpdateOne) AddEmployeeID(i int) *TaskWatcherUpdateOne {
+	twuo.mutation.AddEmployeeID(i)
+	return twuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (twuo *TaskWatcherUpdateOne) SetTask(t *Task) *TaskWatcherUpdateOne {
+	return twuo.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskWatcherMutation object of the builder.
+func (twuo *TaskWatcherUpdateOne) Mutation() *TaskWatcherMutation {
+	return twuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (twuo *TaskWatcherUpdateOne) ClearTask() *TaskWatcherUpdateOne {
+	twuo.mutation.ClearTask()
+	return twuo
+}
+
+// Where appends a list predicates to the TaskWatcherUpdate builder.
+func (twuo *TaskWatcherUpdateOne) Where(ps ...predicate.TaskWatcher) *TaskWatcherUpdateOne {
+	twuo.mutation.Where(ps...)
+	return twuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (twuo *TaskWatcherUpdateOne) Select(field string, fields ...string) *TaskWatcherUpdateOne {
+	twuo.fields = append([]string{field}, fields...)
+	return twuo
+}
+
+// Save executes the query and returns the updated TaskWatcher entity.
+func (twuo *TaskWatcherUpdateOne) Save(ctx context.Context) (*TaskWatcher, error) {
+	return withHooks(ctx, twuo.sqlSave, twuo.mutation, twuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twuo *TaskWatcherUpdateOne) SaveX(ctx context.Context) *TaskWatcher {
+	node, err := twuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (twuo *TaskWatcherUpdateOne) Exec(ctx context.Context) error {
+	_, err := twuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twuo *TaskWatcherUpdateOne) ExecX(ctx context.Context) {
+	if err := twuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twuo *TaskWatcherUpdateOne) check() error {
+	if twuo.mutation.TaskCleared() && len(twuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.task"`)
+	}
+	return nil
+}
+
+func (twuo *TaskWatcherUpdateOne) sqlSave(ctx context.Context) (_node *TaskWatcher, err error) {
+	if err := twuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskwatcher.Table, taskwatcher.Columns, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt))
+	id, ok := twuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskWatcher.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := twuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskwatcher.FieldID)
+		for _, f := range fields {
+			if !taskwatcher.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskwatcher.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := twuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := twuo.mutation.EmployeeID(); ok {
+		_spec.SetField(taskwatcher.FieldEmployeeID, field.TypeInt, value)
+	}
+	if value, ok := twuo.mutation.AddedEmployeeID(); ok {
+		_spec.AddField(taskwatcher.FieldEmployeeID, field.TypeInt, value)
+	}
+	if twuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskWatcher{config: twuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, twuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskwatcher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	twuo.mutation.done = true
+	return _node, nil
+}
@@ -14,26 +14,88 @@ type Tx struct {
 	config
 	// AppointmentHistory is the client for interacting with the AppointmentHistory builders.
 	AppointmentHistory *AppointmentHistoryClient
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// AttendanceCorrection is the client for interacting with the AttendanceCorrection builders.
+	AttendanceCorrection *AttendanceCorrectionClient
+	// AttendanceRecord is the client for interacting with the AttendanceRecord builders.
+	AttendanceRecord *AttendanceRecordClient
+	// Compensation is the client for interacting with the Compensation builders.
+	Compensation *CompensationClient
 	// Department is the client for interacting with the Department builders.
 	Department *DepartmentClient
 	// Employee is the client for interacting with the Employee builders.
 	Employee *EmployeeClient
+	// EmployeeContract is the client for interacting with the EmployeeContract builders.
+	EmployeeContract *EmployeeContractClient
+	// EmployeeStatusHistory is the client for interacting with the EmployeeStatusHistory builders.
+	EmployeeStatusHistory *EmployeeStatusHistoryClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
 	// Label is the client for interacting with the Label builders.
 	Label *LabelClient
 	// LeaveApproval is the client for interacting with the LeaveApproval builders.
 	LeaveApproval *LeaveApprovalClient
 	// LeaveRequest is the client for interacting with the LeaveRequest builders.
 	LeaveRequest *LeaveRequestClient
+	// Milestone is the client for interacting with the Milestone builders.
+	Milestone *MilestoneClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// OvertimeRequest is the client for interacting with the OvertimeRequest builders.
+	OvertimeRequest *OvertimeRequestClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// ProjectRole is the client for interacting with the ProjectRole builders.
+	ProjectRole *ProjectRoleClient
+	// ReminderSetting is the client for interacting with the ReminderSetting builders.
+	ReminderSetting *ReminderSettingClient
+	// Roster is the client for interacting with the Roster builders.
+	Roster *RosterClient
+	// SalaryGrade is the client for interacting with the SalaryGrade builders.
+	SalaryGrade *SalaryGradeClient
+	// Shift is the client for interacting with the Shift builders.
+	Shift *ShiftClient
+	// ShiftSwapRequest is the client for interacting with the ShiftSwapRequest builders.
+	ShiftSwapRequest *ShiftSwapRequestClient
+	// Sprint is the client for interacting with the Sprint builders.
+	Sprint *SprintClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskActivity is the client for interacting with the TaskActivity builders.
+	TaskActivity *TaskActivityClient
+	// TaskComment is the client for interacting with the TaskComment builders.
+	TaskComment *TaskCommentClient
+	// TaskCommentRevision is the client for interacting with the TaskCommentRevision builders.
+	TaskCommentRevision *TaskCommentRevisionClient
+	// TaskDependency is the client for interacting with the TaskDependency builders.
+	TaskDependency *TaskDependencyClient
+	// TaskRecurrence is the client for interacting with the TaskRecurrence builders.
+	TaskRecurrence *TaskRecurrenceClient
+	// TaskRecurrenceRun is the client for interacting with the TaskRecurrenceRun builders.
+	TaskRecurrenceRun *TaskRecurrenceRunClient
+	// TaskReminder is the client for interacting with the TaskReminder builders.
+	TaskReminder *TaskReminderClient
 	// TaskReport is the client for interacting with the TaskReport builders.
 	TaskReport *TaskReportClient
+	// TaskTemplate is the client for interacting with the TaskTemplate builders.
+	TaskTemplate *TaskTemplateClient
+	// TaskView is the client for interacting with the TaskView builders.
+	TaskView *TaskViewClient
+	// TaskWatcher is the client for interacting with the TaskWatcher builders.
+	TaskWatcher *TaskWatcherClient
+	// WorkLog is the client for interacting with the WorkLog builders.
+	WorkLog *WorkLogClient
+	// WorkflowStatus is the client for interacting with the WorkflowStatus builders.
+	WorkflowStatus *WorkflowStatusClient
+	// WorkflowTransition is the client for interacting with the WorkflowTransition builders.
+	WorkflowTransition *WorkflowTransitionClient
 
 	// lazily loaded.
 	client     *Client
@@ -166,16 +228,47 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.AppointmentHistory = NewAppointmentHistoryClient(tx.config)
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.AttendanceCorrection = NewAttendanceCorrectionClient(tx.config)
+	tx.AttendanceRecord = NewAttendanceRecordClient(tx.config)
+	tx.Compensation = NewCompensationClient(tx.config)
 	tx.Department = NewDepartmentClient(tx.config)
 	tx.Employee = NewEmployeeClient(tx.config)
+	tx.EmployeeContract = NewEmployeeContractClient(tx.config)
+	tx.EmployeeStatusHistory = NewEmployeeStatusHistoryClient(tx.config)
+	tx.Holiday = NewHolidayClient(tx.config)
 	tx.Label = NewLabelClient(tx.config)
 	tx.LeaveApproval = NewLeaveApprovalClient(tx.config)
 	tx.LeaveRequest = NewLeaveRequestClient(tx.config)
+	tx.Milestone = NewMilestoneClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
+	tx.OvertimeRequest = NewOvertimeRequestClient(tx.config)
 	tx.Position = NewPositionClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
+	tx.ProjectRole = NewProjectRoleClient(tx.config)
+	tx.ReminderSetting = NewReminderSettingClient(tx.config)
+	tx.Roster = NewRosterClient(tx.config)
+	tx.SalaryGrade = NewSalaryGradeClient(tx.config)
+	tx.Shift = NewShiftClient(tx.config)
+	tx.ShiftSwapRequest = NewShiftSwapRequestClient(tx.config)
+	tx.Sprint = NewSprintClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.TaskActivity = NewTaskActivityClient(tx.config)
+	tx.TaskComment = NewTaskCommentClient(tx.config)
+	tx.TaskCommentRevision = NewTaskCommentRevisionClient(tx.config)
+	tx.TaskDependency = NewTaskDependencyClient(tx.config)
+	tx.TaskRecurrence = NewTaskRecurrenceClient(tx.config)
+	tx.TaskRecurrenceRun = NewTaskRecurrenceRunClient(tx.config)
+	tx.TaskReminder = NewTaskReminderClient(tx.config)
 	tx.TaskReport = NewTaskReportClient(tx.config)
+	tx.TaskTemplate = NewTaskTemplateClient(tx.config)
+	tx.TaskView = NewTaskViewClient(tx.config)
+	tx.TaskWatcher = NewTaskWatcherClient(tx.config)
+	tx.WorkLog = NewWorkLogClient(tx.config)
+	tx.WorkflowStatus = NewWorkflowStatusClient(tx.config)
+	tx.WorkflowTransition = NewWorkflowTransitionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+)
+
+// WorkflowStatus is the model entity for the WorkflowStatus schema.
+type WorkflowStatus struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Category holds the value of the "category" field.
+	Category workflowstatus.Category `json:"category"`
+	// SystemStatus holds the value of the "system_status" field.
+	SystemStatus workflowstatus.SystemStatus `json:"system_status"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position"`
+	// WipLimit holds the value of the "wip_limit" field.
+	WipLimit *int `json:"wip_limit"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkflowStatusQuery when eager-loading is set.
+	Edges        WorkflowStatusEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkflowStatusEdges holds the relations/edges for other nodes in the graph.
+type WorkflowStatusEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkflowStatusEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkflowStatus) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workflowstatus.FieldID, workflowstatus.FieldProjectID, workflowstatus.FieldPosition, workflowstatus.FieldWipLimit:
+			values[i] = new(sql.NullInt64)
+		case workflowstatus.FieldKey, workflowstatus.FieldName, workflowstatus.FieldCategory, workflowstatus.FieldSystemStatus:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkflowStatus fields.
+func (ws *WorkflowStatus) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workflowstatus.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ws.ID = int(value.Int64)
+		case workflowstatus.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				ws.ProjectID = int(value.Int64)
+			}
+		case workflowstatus.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ws.Key = value.String
+			}
+		case workflowstatus.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ws.Name = value.String
+			}
+		case workflowstatus.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				ws.Category = workflowstatus.Category(value.String)
+			}
+		case workflowstatus.FieldSystemStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field system_status", values[i])
+			} else if value.Valid {
+				ws.SystemStatus = workflowstatus.SystemStatus(value.String)
+			}
+		case workflowstatus.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				ws.Position = int(value.Int64)
+			}
+		case workflowstatus.FieldWipLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wip_limit", values[i])
+			} else if value.Valid {
+				ws.WipLimit = new(int)
+				*ws.WipLimit = int(value.Int64)
+			}
+		default:
+			ws.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkflowStatus.
+// This includes values selected through modifiers, order, etc.
+func (ws *WorkflowStatus) Value(name string) (ent.Value, error) {
+	return ws.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the WorkflowStatus entity.
+func (ws *WorkflowStatus) QueryProject() *ProjectQuery {
+	return NewWorkflowStatusClient(ws.config).QueryProject(ws)
+}
+
+// Update returns a builder for updating this WorkflowStatus.
+// Note that you need to call WorkflowStatus.Unwrap() before calling this method if this WorkflowStatus
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ws *WorkflowStatus) Update() *WorkflowStatusUpdateOne {
+	return NewWorkflowStatusClient(ws.config).UpdateOne(ws)
+}
+
+// Unwrap unwraps the WorkflowStatus entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ws *WorkflowStatus) Unwrap() *WorkflowStatus {
+	_tx, ok := ws.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkflowStatus is not a transactional entity")
+	}
+	ws.config.driver = _tx.drv
+	return ws
+}
+
+// String implements the fmt.Stringer.
+func (ws *WorkflowStatus) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkflowStatus(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ws.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", ws.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(ws.Key)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ws.Name)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Category))
+	builder.WriteString(", ")
+	builder.WriteString("system_status=")
+	builder.WriteString(fmt.Sprintf("%v", ws.SystemStatus))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Position))
+	builder.WriteString(", ")
+	if v := ws.WipLimit; v != nil {
+		builder.WriteString("wip_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkflowStatusSlice is a parsable slice of WorkflowStatus.
+type WorkflowStatusSlice []*WorkflowStatus
@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workflowstatus
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLTE(FieldID, id))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldName, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldPosition, v))
+}
+
+// WipLimit applies equality check predicate on the "wip_limit" field. It's identical to WipLimitEQ.
+func WipLimit(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldWipLimit, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldContainsFold(FieldKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldContainsFold(FieldName, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v Category) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v Category) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...Category) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...Category) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// SystemStatusEQ applies the EQ predicate on the "system_status" field.
+func SystemStatusEQ(v SystemStatus) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldSystemStatus, v))
+}
+
+// SystemStatusNEQ applies the NEQ predicate on the "system_status" field.
+func SystemStatusNEQ(v SystemStatus) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNEQ(FieldSystemStatus, v))
+}
+
+// SystemStatusIn applies the In predicate on the "system_status" field.
+func SystemStatusIn(vs ...SystemStatus) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIn(FieldSystemStatus, vs...))
+}
+
+// SystemStatusNotIn applies the NotIn predicate on the "system_status" field.
+func SystemStatusNotIn(vs ...SystemStatus) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotIn(FieldSystemStatus, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLTE(FieldPosition, v))
+}
+
+// WipLimitEQ applies the EQ predicate on the "wip_limit" field.
+func WipLimitEQ(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldEQ(FieldWipLimit, v))
+}
+
+// WipLimitNEQ applies the NEQ predicate on the "wip_limit" field.
+func WipLimitNEQ(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNEQ(FieldWipLimit, v))
+}
+
+// WipLimitIn applies the In predicate on the "wip_limit" field.
+func WipLimitIn(vs ...int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIn(FieldWipLimit, vs...))
+}
+
+// WipLimitNotIn applies the NotIn predicate on the "wip_limit" field.
+func WipLimitNotIn(vs ...int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotIn(FieldWipLimit, vs...))
+}
+
+// WipLimitGT applies the GT predicate on the "wip_limit" field.
+func WipLimitGT(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGT(FieldWipLimit, v))
+}
+
+// WipLimitGTE applies the GTE predicate on the "wip_limit" field.
+func WipLimitGTE(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldGTE(FieldWipLimit, v))
+}
+
+// WipLimitLT applies the LT predicate on the "wip_limit" field.
+func WipLimitLT(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLT(FieldWipLimit, v))
+}
+
+// WipLimitLTE applies the LTE predicate on the "wip_limit" field.
+func WipLimitLTE(v int) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldLTE(FieldWipLimit, v))
+}
+
+// WipLimitIsNil applies the IsNil predicate on the "wip_limit" field.
+func WipLimitIsNil() predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldIsNull(FieldWipLimit))
+}
+
+// WipLimitNotNil applies the NotNil predicate on the "wip_limit" field.
+func WipLimitNotNil() predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.FieldNotNull(FieldWipLimit))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkflowStatus) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkflowStatus) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkflowStatus) predicate.WorkflowStatus {
+	return predicate.WorkflowStatus(sql.NotPredicates(p))
+}
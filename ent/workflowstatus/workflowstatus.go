@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workflowstatus
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the workflowstatus type in the database.
+	Label = "workflow_status"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldSystemStatus holds the string denoting the system_status field in the database.
+	FieldSystemStatus = "system_status"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldWipLimit holds the string denoting the wip_limit field in the database.
+	FieldWipLimit = "wip_limit"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// Table holds the table name of the workflowstatus in the database.
+	Table = "workflow_status"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "workflow_status"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_id"
+)
+
+// Columns holds all SQL columns for workflowstatus fields.
+var Columns = []string{
+	FieldID,
+	FieldProjectID,
+	FieldKey,
+	FieldName,
+	FieldCategory,
+	FieldSystemStatus,
+	FieldPosition,
+	FieldWipLimit,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// WipLimitValidator is a validator for the "wip_limit" field. It is called by the builders before save.
+	WipLimitValidator func(int) error
+)
+
+// Category defines the type for the "category" enum field.
+type Category string
+
+// CategoryTodo is the default value of the Category enum.
+const DefaultCategory = CategoryTodo
+
+// Category values.
+const (
+	CategoryTodo  Category = "todo"
+	CategoryDoing Category = "doing"
+	CategoryDone  Category = "done"
+)
+
+func (c Category) String() string {
+	return string(c)
+}
+
+// CategoryValidator is a validator for the "category" field enum values. It is called by the builders before save.
+func CategoryValidator(c Category) error {
+	switch c {
+	case CategoryTodo, CategoryDoing, CategoryDone:
+		return nil
+	default:
+		return fmt.Errorf("workflowstatus: invalid enum value for category field: %q", c)
+	}
+}
+
+// SystemStatus defines the type for the "system_status" enum field.
+type SystemStatus string
+
+// SystemStatusNotReceived is the default value of the SystemStatus enum.
+const DefaultSystemStatus = SystemStatusNotReceived
+
+// SystemStatus values.
+const (
+	SystemStatusNotReceived SystemStatus = "not_received"
+	SystemStatusReceived    SystemStatus = "received"
+	SystemStatusInProgress  SystemStatus = "in_progress"
+	SystemStatusCompleted   SystemStatus = "completed"
+	SystemStatusCancelled   SystemStatus = "cancelled"
+)
+
+func (ss SystemStatus) String() string {
+	return string(ss)
+}
+
+// SystemStatusValidator is a validator for the "system_status" field enum values. It is called by the builders before save.
+func SystemStatusValidator(ss SystemStatus) error {
+	switch ss {
+	case SystemStatusNotReceived, SystemStatusReceived, SystemStatusInProgress, SystemStatusCompleted, SystemStatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("workflowstatus: invalid enum value for system_status field: %q", ss)
+	}
+}
+
+// OrderOption defines the ordering options for the WorkflowStatus queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// BySystemStatus orders the results by the system_status field.
+func BySystemStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSystemStatus, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByWipLimit orders the results by the wip_limit field.
+func ByWipLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWipLimit, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+)
+
+// WorkflowStatusCreate is the builder for creating a WorkflowStatus entity.
+type WorkflowStatusCreate struct {
+	config
+	mutation *WorkflowStatusMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProjectID sets the "project_id" field.
+func (wsc *WorkflowStatusCreate) SetProjectID(i int) *WorkflowStatusCreate {
+	wsc.mutation.SetProjectID(i)
+	return wsc
+}
+
+// SetKey sets the "key" field.
+func (wsc *WorkflowStatusCreate) SetKey(s string) *WorkflowStatusCreate {
+	wsc.mutation.SetKey(s)
+	return wsc
+}
+
+// SetName sets the "name" field.
+func (wsc *WorkflowStatusCreate) SetName(s string) *WorkflowStatusCreate {
+	wsc.mutation.SetName(s)
+	return wsc
+}
+
+// SetCategory sets the "category" field.
+func (wsc *WorkflowStatusCreate) SetCategory(w workflowstatus.Category) *WorkflowStatusCreate {
+	wsc.mutation.SetCategory(w)
+	return wsc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (wsc *WorkflowStatusCreate) SetNillableCategory(w *workflowstatus.Category) *WorkflowStatusCreate {
+	if w != nil {
+		wsc.SetCategory(*w)
+	}
+	return wsc
+}
+
+// SetSystemStatus sets the "system_status" field.
+func (wsc *WorkflowStatusCreate) SetSystemStatus(ws workflowstatus.SystemStatus) *WorkflowStatusCreate {
+	wsc.mutation.SetSystemStatus(ws)
+	return wsc
+}
+
+// SetNillableSystemStatus sets the "system_status" field if the given value is not nil.
+func (wsc *WorkflowStatusCreate) SetNillableSystemStatus(ws *workflowstatus.SystemStatus) *WorkflowStatusCreate {
+	if ws != nil {
+		wsc.SetSystemStatus(*ws)
+	}
+	return wsc
+}
+
+// SetPosition sets the "position" field.
+func (wsc *WorkflowStatusCreate) SetPosition(i int) *WorkflowStatusCreate {
+	wsc.mutation.SetPosition(i)
+	return wsc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (wsc *WorkflowStatusCreate) SetNillablePosition(i *int) *WorkflowStatusCreate {
+	if i != nil {
+		wsc.SetPosition(*i)
+	}
+	return wsc
+}
+
+// SetWipLimit sets the "wip_limit" field.
+func (wsc *WorkflowStatusCreate) SetWipLimit(i int) *WorkflowStatusCreate {
+	wsc.mutation.SetWipLimit(i)
+	return wsc
+}
+
+// SetNillableWipLimit sets the "wip_limit" field if the given value is not nil.
+func (wsc *WorkflowStatusCreate) SetNillableWipLimit(i *int) *WorkflowStatusCreate {
+	if i != nil {
+		wsc.SetWipLimit(*i)
+	}
+	return wsc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (wsc *WorkflowStatusCreate) SetProject(p *Project) *WorkflowStatusCreate {
+	return wsc.SetProjectID(p.ID)
+}
+
+// Mutation returns the WorkflowStatusMutation object of the builder.
+func (wsc *WorkflowStatusCreate) Mutation() *WorkflowStatusMutation {
+	return wsc.mutation
+}
+
+// Save creates the WorkflowStatus in the database.
+func (wsc *WorkflowStatusCreate) Save(ctx context.Context) (*WorkflowStatus, error) {
+	wsc.defaults()
+	return withHooks(ctx, wsc.sqlSave, wsc.mutation, wsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WorkflowStatusCreate) SaveX(ctx context.Context) *WorkflowStatus {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WorkflowStatusCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WorkflowStatusCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WorkflowStatusCreate) defaults() {
+	if _, ok := wsc.mutation.Category(); !ok {
+		v := workflowstatus.DefaultCategory
+		wsc.mutation.SetCategory(v)
+	}
+	if _, ok := wsc.mutation.SystemStatus(); !ok {
+		v := workflowstatus.DefaultSystemStatus
+		wsc.mutation.SetSystemStatus(v)
+	}
+	if _, ok := wsc.mutation.Position(); !ok {
+		v := workflowstatus.DefaultPosition
+		wsc.mutation.SetPosition(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WorkflowStatusCreate) check() error {
+	if _, ok := wsc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "WorkflowStatus.project_id"`)}
+	}
+	if _, ok := wsc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "WorkflowStatus.key"`)}
+	}
+	if _, ok := wsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WorkflowStatus.name"`)}
+	}
+	if _, ok := wsc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "WorkflowStatus.category"`)}
+	}
+	if v, ok := wsc.mutation.Category(); ok {
+		if err := workflowstatus.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.category": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.SystemStatus(); !ok {
+		return &ValidationError{Name: "system_status", err: errors.New(`ent: missing required field "WorkflowStatus.system_status"`)}
+	}
+	if v, ok := wsc.mutation.SystemStatus(); ok {
+		if err := workflowstatus.SystemStatusValidator(v); err != nil {
+			return &ValidationError{Name: "system_status", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.system_status": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "WorkflowStatus.position"`)}
+	}
+	if v, ok := wsc.mutation.WipLimit(); ok {
+		if err := workflowstatus.WipLimitValidator(v); err != nil {
+			return &ValidationError{Name: "wip_limit", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.wip_limit": %w`, err)}
+		}
+	}
+	if len(wsc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "WorkflowStatus.project"`)}
+	}
+	return nil
+}
+
+func (wsc *WorkflowStatusCreate) sqlSave(ctx context.Context) (*WorkflowStatus, error) {
+	if err := wsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wsc.mutation.id = &_node.ID
+	wsc.mutation.done = true
+	return _node, nil
+}
+
+func (wsc *WorkflowStatusCreate) createSpec() (*WorkflowStatus, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkflowStatus{config: wsc.config}
+		_spec = sqlgraph.NewCreateSpec(workflowstatus.Table, sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = wsc.conflict
+	if value, ok := wsc.mutation.Key(); ok {
+		_spec.SetField(workflowstatus.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := wsc.mutation.Name(); ok {
+		_spec.SetField(workflowstatus.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wsc.mutation.Category(); ok {
+		_spec.SetField(workflowstatus.FieldCategory, field.TypeEnum, value)
+		_node.Category = value
+	}
+	if value, ok := wsc.mutation.SystemStatus(); ok {
+		_spec.SetField(workflowstatus.FieldSystemStatus, field.TypeEnum, value)
+		_node.SystemStatus = value
+	}
+	if value, ok := wsc.mutation.Position(); ok {
+		_spec.SetField(workflowstatus.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := wsc.mutation.WipLimit(); ok {
+		_spec.SetField(workflowstatus.FieldWipLimit, field.TypeInt, value)
+		_node.WipLimit = &value
+	}
+	if nodes := wsc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.ProjectTable,
+			Columns: []string{workflowstatus.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkflowStatus.Create().
+//		SetProjectID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkflowStatusUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (wsc *WorkflowStatusCreate) OnConflict(opts ...sql.ConflictOption) *WorkflowStatusUpsertOne {
+	wsc.conflict = opts
+	return &WorkflowStatusUpsertOne{
+		create: wsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkflowStatus.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wsc *WorkflowStatusCreate) OnConflictColumns(columns ...string) *WorkflowStatusUpsertOne {
+	wsc.conflict = append(wsc.conflict, sql.ConflictColumns(columns...))
+	return &WorkflowStatusUpsertOne{
+		create: wsc,
+	}
+}
+
+type (
+	// WorkflowStatusUpsertOne is the builder for "upsert"-ing
+	//  one WorkflowStatus node.
+	WorkflowStatusUpsertOne struct {
+		create *WorkflowStatusCreate
+	}
+
+	// WorkflowStatusUpsert is the "OnConflict" setter.
+	WorkflowStatusUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProjectID sets the "project_id" field.
+func (u *WorkflowStatusUpsert) SetProjectID(v int) *WorkflowStatusUpsert {
+	u.Set(workflowstatus.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *WorkflowStatusUpsert) UpdateProjectID() *WorkflowStatusUpsert {
+	u.SetExcluded(workflowstatus.FieldProjectID)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *WorkflowStatusUpsert) SetKey(v string) *WorkflowStatusUpsert {
+	u.Set(workflowstatus.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *WorkflowStatusUpsert) UpdateKey() *WorkflowStatusUpsert {
+	u.SetExcluded(workflowstatus.FieldKey)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *WorkflowStatusUpsert) SetName(v string) *WorkflowStatusUpsert {
+	u.Set(workflowstatus.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkflowStatusUpsert) UpdateName() *WorkflowStatusUpsert {
+	u.SetExcluded(workflowstatus.FieldName)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *WorkflowStatusUpsert) SetCategory(v workflowstatus.Category) *WorkflowStatusUpsert {
+	u.Set(workflowstatus.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *WorkflowStatusUpsert) UpdateCategory() *WorkflowStatusUpsert {
+	u.SetExcluded(workflowstatus.FieldCategory)
+	return u
+}
+
+// SetSystemStatus sets the "system_status" field.
+func (u *WorkflowStatusUpsert) SetSystemStatus(v workflowstatus.SystemStatus) *WorkflowStatusUpsert {
+	u.Set(workflowstatus.FieldSystemStatus, v)
+	return u
+}
+
+// UpdateSystemStatus sets the "system_status" field to the value that was provided on create.
+func (u *WorkflowStatusUpsert) UpdateSystemStatus() *WorkflowStatusUpsert {
+	u.SetExcluded(workflowstatus.FieldSystemStatus)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *WorkflowStatusUpsert) SetPosition(v int) *WorkflowStatusUpsert {
+	u.Set(workflowstatus.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *WorkflowStatusUpsert) UpdatePosition() *WorkflowStatusUpsert {
+	u.SetExcluded(workflowstatus.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *WorkflowStatusUpsert) AddPosition(v int) *WorkflowStatusUpsert {
+	u.Add(workflowstatus.FieldPosition, v)
+	return u
+}
+
+// SetWipLimit sets the "wip_limit" field.
+func (u *WorkflowStatusUpsert) SetWipLimit(v int) *WorkflowStatusUpsert {
+	u.Set(workflowstatus.FieldWipLimit, v)
+	return u
+}
+
+// UpdateWipLimit sets the "wip_limit" field to the value that was provided on create.
+func (u *WorkflowStatusUpsert) UpdateWipLimit() *WorkflowStatusUpsert {
+	u.SetExcluded(workflowstatus.FieldWipLimit)
+	return u
+}
+
+// AddWipLimit adds v to the "wip_limit" field.
+func (u *WorkflowStatusUpsert) AddWipLimit(v int) *WorkflowStatusUpsert {
+	u.Add(workflowstatus.FieldWipLimit, v)
+	return u
+}
+
+// ClearWipLimit clears the value of the "wip_limit" field.
+func (u *WorkflowStatusUpsert) ClearWipLimit() *WorkflowStatusUpsert {
+	u.SetNull(workflowstatus.FieldWipLimit)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowStatus.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WorkflowStatusUpsertOne) UpdateNewValues() *WorkflowStatusUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowStatus.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WorkflowStatusUpsertOne) Ignore() *WorkflowStatusUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkflowStatusUpsertOne) DoNothing() *WorkflowStatusUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkflowStatusCreate.OnConflict
+// documentation for more info.
+func (u *WorkflowStatusUpsertOne) Update(set func(*WorkflowStatusUpsert)) *WorkflowStatusUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkflowStatusUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *WorkflowStatusUpsertOne) SetProjectID(v int) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertOne) UpdateProjectID() *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *WorkflowStatusUpsertOne) SetKey(v string) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertOne) UpdateKey() *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WorkflowStatusUpsertOne) SetName(v string) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertOne) UpdateName() *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *WorkflowStatusUpsertOne) SetCategory(v workflowstatus.Category) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertOne) UpdateCategory() *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetSystemStatus sets the "system_status" field.
+func (u *WorkflowStatusUpsertOne) SetSystemStatus(v workflowstatus.SystemStatus) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetSystemStatus(v)
+	})
+}
+
+// UpdateSystemStatus sets the "system_status" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertOne) UpdateSystemStatus() *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateSystemStatus()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *WorkflowStatusUpsertOne) SetPosition(v int) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *WorkflowStatusUpsertOne) AddPosition(v int) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertOne) UpdatePosition() *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetWipLimit sets the "wip_limit" field.
+func (u *WorkflowStatusUpsertOne) SetWipLimit(v int) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetWipLimit(v)
+	})
+}
+
+// AddWipLimit adds v to the "wip_limit" field.
+func (u *WorkflowStatusUpsertOne) AddWipLimit(v int) *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.AddWipLimit(v)
+	})
+}
+
+// UpdateWipLimit sets the "wip_limit" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertOne) UpdateWipLimit() *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateWipLimit()
+	})
+}
+
+// ClearWipLimit clears the value of the "wip_limit" field.
+func (u *WorkflowStatusUpsertOne) ClearWipLimit() *WorkflowStatusUpsertOne {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.ClearWipLimit()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkflowStatusUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkflowStatusCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkflowStatusUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WorkflowStatusUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WorkflowStatusUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WorkflowStatusCreateBulk is the builder for creating many WorkflowStatus entities in bulk.
+type WorkflowStatusCreateBulk struct {
+	config
+	err      error
+	builders []*WorkflowStatusCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WorkflowStatus entities in the database.
+func (wscb *WorkflowStatusCreateBulk) Save(ctx context.Context) ([]*WorkflowStatus, error) {
+	if wscb.err != nil {
+		return nil, wscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WorkflowStatus, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkflowStatusMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WorkflowStatusCreateBulk) SaveX(ctx context.Context) []*WorkflowStatus {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WorkflowStatusCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WorkflowStatusCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkflowStatus.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkflowStatusUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (wscb *WorkflowStatusCreateBulk) OnConflict(opts ...sql.ConflictOption) *WorkflowStatusUpsertBulk {
+	wscb.conflict = opts
+	return &WorkflowStatusUpsertBulk{
+		create: wscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkflowStatus.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wscb *WorkflowStatusCreateBulk) OnConflictColumns(columns ...string) *WorkflowStatusUpsertBulk {
+	wscb.conflict = append(wscb.conflict, sql.ConflictColumns(columns...))
+	return &WorkflowStatusUpsertBulk{
+		create: wscb,
+	}
+}
+
+// WorkflowStatusUpsertBulk is the builder for "upsert"-ing
+// a bulk of WorkflowStatus nodes.
+type WorkflowStatusUpsertBulk struct {
+	create *WorkflowStatusCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WorkflowStatus.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WorkflowStatusUpsertBulk) UpdateNewValues() *WorkflowStatusUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowStatus.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WorkflowStatusUpsertBulk) Ignore() *WorkflowStatusUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkflowStatusUpsertBulk) DoNothing() *WorkflowStatusUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkflowStatusCreateBulk.OnConflict
+// documentation for more info.
+func (u *WorkflowStatusUpsertBulk) Update(set func(*WorkflowStatusUpsert)) *WorkflowStatusUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkflowStatusUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *WorkflowStatusUpsertBulk) SetProjectID(v int) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertBulk) UpdateProjectID() *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *WorkflowStatusUpsertBulk) SetKey(v string) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertBulk) UpdateKey() *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WorkflowStatusUpsertBulk) SetName(v string) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertBulk) UpdateName() *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *WorkflowStatusUpsertBulk) SetCategory(v workflowstatus.Category) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertBulk) UpdateCategory() *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetSystemStatus sets the "system_status" field.
+func (u *WorkflowStatusUpsertBulk) SetSystemStatus(v workflowstatus.SystemStatus) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetSystemStatus(v)
+	})
+}
+
+// UpdateSystemStatus sets the "system_status" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertBulk) UpdateSystemStatus() *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateSystemStatus()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *WorkflowStatusUpsertBulk) SetPosition(v int) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *WorkflowStatusUpsertBulk) AddPosition(v int) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertBulk) UpdatePosition() *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetWipLimit sets the "wip_limit" field.
+func (u *WorkflowStatusUpsertBulk) SetWipLimit(v int) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.SetWipLimit(v)
+	})
+}
+
+// AddWipLimit adds v to the "wip_limit" field.
+func (u *WorkflowStatusUpsertBulk) AddWipLimit(v int) *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.AddWipLimit(v)
+	})
+}
+
+// UpdateWipLimit sets the "wip_limit" field to the value that was provided on create.
+func (u *WorkflowStatusUpsertBulk) UpdateWipLimit() *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.UpdateWipLimit()
+	})
+}
+
+// ClearWipLimit clears the value of the "wip_limit" field.
+func (u *WorkflowStatusUpsertBulk) ClearWipLimit() *WorkflowStatusUpsertBulk {
+	return u.Update(func(s *WorkflowStatusUpsert) {
+		s.ClearWipLimit()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkflowStatusUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WorkflowStatusCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkflowStatusCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkflowStatusUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+)
+
+// WorkflowStatusUpdate is the builder for updating WorkflowStatus entities.
+type WorkflowStatusUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkflowStatusMutation
+}
+
+// Where appends a list predicates to the WorkflowStatusUpdate builder.
+func (wsu *WorkflowStatusUpdate) Where(ps ...predicate.WorkflowStatus) *WorkflowStatusUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetProjectID sets the "project_id" field.
+func (wsu *WorkflowStatusUpdate) SetProjectID(i int) *WorkflowStatusUpdate {
+	wsu.mutation.SetProjectID(i)
+	return wsu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableProjectID(i *int) *WorkflowStatusUpdate {
+	if i != nil {
+		wsu.SetProjectID(*i)
+	}
+	return wsu
+}
+
+// SetKey sets the "key" field.
+func (wsu *WorkflowStatusUpdate) SetKey(s string) *WorkflowStatusUpdate {
+	wsu.mutation.SetKey(s)
+	return wsu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableKey(s *string) *WorkflowStatusUpdate {
+	if s != nil {
+		wsu.SetKey(*s)
+	}
+	return wsu
+}
+
+// SetName sets the "name" field.
+func (wsu *WorkflowStatusUpdate) SetName(s string) *WorkflowStatusUpdate {
+	wsu.mutation.SetName(s)
+	return wsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableName(s *string) *WorkflowStatusUpdate {
+	if s != nil {
+		wsu.SetName(*s)
+	}
+	return wsu
+}
+
+// SetCategory sets the "category" field.
+func (wsu *WorkflowStatusUpdate) SetCategory(w workflowstatus.Category) *WorkflowStatusUpdate {
+	wsu.mutation.SetCategory(w)
+	return wsu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableCategory(w *workflowstatus.Category) *WorkflowStatusUpdate {
+	if w != nil {
+		wsu.SetCategory(*w)
+	}
+	return wsu
+}
+
+// SetSystemStatus sets the "system_status" field.
+func (wsu *WorkflowStatusUpdate) SetSystemStatus(ws workflowstatus.SystemStatus) *WorkflowStatusUpdate {
+	wsu.mutation.SetSystemStatus(ws)
+	return wsu
+}
+
+// SetNillableSystemStatus sets the "system_status" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableSystemStatus(ws *workflowstatus.SystemStatus) *WorkflowStatusUpdate {
+	if ws != nil {
+		wsu.SetSystemStatus(*ws)
+	}
+	return wsu
+}
+
+// SetPosition sets the "position" field.
+func (wsu *WorkflowStatusUpdate) SetPosition(i int) *WorkflowStatusUpdate {
+	wsu.mutation.ResetPosition()
+	wsu.mutation.SetPosition(i)
+	return wsu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillablePosition(i *int) *WorkflowStatusUpdate {
+	if i != nil {
+		wsu.SetPosition(*i)
+	}
+	return wsu
+}
+
+// AddPosition adds i to the "position" field.
+func (wsu *WorkflowStatusUpdate) AddPosition(i int) *WorkflowStatusUpdate {
+	wsu.mutation.AddPosition(i)
+	return wsu
+}
+
+// SetWipLimit sets the "wip_limit" field.
+func (wsu *WorkflowStatusUpdate) SetWipLimit(i int) *WorkflowStatusUpdate {
+	wsu.mutation.ResetWipLimit()
+	wsu.mutation.SetWipLimit(i)
+	return wsu
+}
+
+// SetNillableWipLimit sets the "wip_limit" field if the given value is not nil.
+func (wsu *WorkflowStatusUpdate) SetNillableWipLimit(i *int) *WorkflowStatusUpdate {
+	if i != nil {
+		wsu.SetWipLimit(*i)
+	}
+	return wsu
+}
+
+// AddWipLimit adds i to the "wip_limit" field.
+func (wsu *WorkflowStatusUpdate) AddWipLimit(i int) *WorkflowStatusUpdate {
+	wsu.mutation.AddWipLimit(i)
+	return wsu
+}
+
+// ClearWipLimit clears the value of the "wip_limit" field.
+func (wsu *WorkflowStatusUpdate) ClearWipLimit() *WorkflowStatusUpdate {
+	wsu.mutation.ClearWipLimit()
+	return wsu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (wsu *WorkflowStatusUpdate) SetProject(p *Project) *WorkflowStatusUpdate {
+	return wsu.SetProjectID(p.ID)
+}
+
+// Mutation returns the WorkflowStatusMutation object of the builder.
+func (wsu *WorkflowStatusUpdate) Mutation() *WorkflowStatusMutation {
+	return wsu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (wsu *WorkflowStatusUpdate) ClearProject() *WorkflowStatusUpdate {
+	wsu.mutation.ClearProject()
+	return wsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WorkflowStatusUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wsu.sqlSave, wsu.mutation, wsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WorkflowStatusUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WorkflowStatusUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WorkflowStatusUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsu *WorkflowStatusUpdate) check() error {
+	if v, ok := wsu.mutation.Category(); ok {
+		if err := workflowstatus.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.category": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.SystemStatus(); ok {
+		if err := workflowstatus.SystemStatusValidator(v); err != nil {
+			return &ValidationError{Name: "system_status", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.system_status": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.WipLimit(); ok {
+		if err := workflowstatus.WipLimitValidator(v); err != nil {
+			return &ValidationError{Name: "wip_limit", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.wip_limit": %w`, err)}
+		}
+	}
+	if wsu.mutation.ProjectCleared() && len(wsu.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkflowStatus.project"`)
+	}
+	return nil
+}
+
+func (wsu *WorkflowStatusUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowstatus.Table, workflowstatus.Columns, sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt))
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsu.mutation.Key(); ok {
+		_spec.SetField(workflowstatus.FieldKey, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Name(); ok {
+		_spec.SetField(workflowstatus.FieldName, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Category(); ok {
+		_spec.SetField(workflowstatus.FieldCategory, field.TypeEnum, value)
+	}
+	if value, ok := wsu.mutation.SystemStatus(); ok {
+		_spec.SetField(workflowstatus.FieldSystemStatus, field.TypeEnum, value)
+	}
+	if value, ok := wsu.mutation.Position(); ok {
+		_spec.SetField(workflowstatus.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.AddedPosition(); ok {
+		_spec.AddField(workflowstatus.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.WipLimit(); ok {
+		_spec.SetField(workflowstatus.FieldWipLimit, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.AddedWipLimit(); ok {
+		_spec.AddField(workflowstatus.FieldWipLimit, field.TypeInt, value)
+	}
+	if wsu.mutation.WipLimitCleared() {
+		_spec.ClearField(workflowstatus.FieldWipLimit, field.TypeInt)
+	}
+	if wsu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.ProjectTable,
+			Columns: []string{workflowstatus.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.ProjectTable,
+			Columns: []string{workflowstatus.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowstatus.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wsu.mutation.done = true
+	return n, nil
+}
+
+// WorkflowStatusUpdateOne is the builder for updating a single WorkflowStatus entity.
+type WorkflowStatusUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkflowStatusMutation
+}
+
+// SetProjectID sets the "project_id" field.
+func (wsuo *WorkflowStatusUpdateOne) SetProjectID(i int) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetProjectID(i)
+	return wsuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableProjectID(i *int) *WorkflowStatusUpdateOne {
+	if i != nil {
+		wsuo.SetProjectID(*i)
+	}
+	return wsuo
+}
+
+// SetKey sets the "key" field.
+func (wsuo *WorkflowStatusUpdateOne) SetKey(s string) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetKey(s)
+	return wsuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableKey(s *string) *WorkflowStatusUpdateOne {
+	if s != nil {
+		wsuo.SetKey(*s)
+	}
+	return wsuo
+}
+
+// SetName sets the "name" field.
+func (wsuo *WorkflowStatusUpdateOne) SetName(s string) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetName(s)
+	return wsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableName(s *string) *WorkflowStatusUpdateOne {
+	if s != nil {
+		wsuo.SetName(*s)
+	}
+	return wsuo
+}
+
+// SetCategory sets the "category" field.
+func (wsuo *WorkflowStatusUpdateOne) SetCategory(w workflowstatus.Category) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetCategory(w)
+	return wsuo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableCategory(w *workflowstatus.Category) *WorkflowStatusUpdateOne {
+	if w != nil {
+		wsuo.SetCategory(*w)
+	}
+	return wsuo
+}
+
+// SetSystemStatus sets the "system_status" field.
+func (wsuo *WorkflowStatusUpdateOne) SetSystemStatus(ws workflowstatus.SystemStatus) *WorkflowStatusUpdateOne {
+	wsuo.mutation.SetSystemStatus(ws)
+	return wsuo
+}
+
+// SetNillableSystemStatus sets the "system_status" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableSystemStatus(ws *workflowstatus.SystemStatus) *WorkflowStatusUpdateOne {
+	if ws != nil {
+		wsuo.SetSystemStatus(*ws)
+	}
+	return wsuo
+}
+
+// SetPosition sets the "position" field.
+func (wsuo *WorkflowStatusUpdateOne) SetPosition(i int) *WorkflowStatusUpdateOne {
+	wsuo.mutation.ResetPosition()
+	wsuo.mutation.SetPosition(i)
+	return wsuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillablePosition(i *int) *WorkflowStatusUpdateOne {
+	if i != nil {
+		wsuo.SetPosition(*i)
+	}
+	return wsuo
+}
+
+// AddPosition adds i to the "position" field.
+func (wsuo *WorkflowStatusUpdateOne) AddPosition(i int) *WorkflowStatusUpdateOne {
+	wsuo.mutation.AddPosition(i)
+	return wsuo
+}
+
+// SetWipLimit sets the "wip_limit" field.
+func (wsuo *WorkflowStatusUpdateOne) SetWipLimit(i int) *WorkflowStatusUpdateOne {
+	wsuo.mutation.ResetWipLimit()
+	wsuo.mutation.SetWipLimit(i)
+	return wsuo
+}
+
+// SetNillableWipLimit sets the "wip_limit" field if the given value is not nil.
+func (wsuo *WorkflowStatusUpdateOne) SetNillableWipLimit(i *int) *WorkflowStatusUpdateOne {
+	if i != nil {
+		wsuo.SetWipLimit(*i)
+	}
+	return wsuo
+}
+
+// AddWipLimit adds i to the "wip_limit" field.
+func (wsuo *WorkflowStatusUpdateOne) AddWipLimit(i int) *WorkflowStatusUpdateOne {
+	wsuo.mutation.AddWipLimit(i)
+	return wsuo
+}
+
+// ClearWipLimit clears the value of the "wip_limit" field.
+func (wsuo *WorkflowStatusUpdateOne) ClearWipLimit() *WorkflowStatusUpdateOne {
+	wsuo.mutation.ClearWipLimit()
+	return wsuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (wsuo *WorkflowStatusUpdateOne) SetProject(p *Project) *WorkflowStatusUpdateOne {
+	return wsuo.SetProjectID(p.ID)
+}
+
+// Mutation returns the WorkflowStatusMutation object of the builder.
+func (wsuo *WorkflowStatusUpdateOne) Mutation() *WorkflowStatusMutation {
+	return wsuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (wsuo *WorkflowStatusUpdateOne) ClearProject() *WorkflowStatusUpdateOne {
+	wsuo.mutation.ClearProject()
+	return wsuo
+}
+
+// Where appends a list predicates to the WorkflowStatusUpdate builder.
+func (wsuo *WorkflowStatusUpdateOne) Where(ps ...predicate.WorkflowStatus) *WorkflowStatusUpdateOne {
+	wsuo.mutation.Where(ps...)
+	return wsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WorkflowStatusUpdateOne) Select(field string, fields ...string) *WorkflowStatusUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WorkflowStatus entity.
+func (wsuo *WorkflowStatusUpdateOne) Save(ctx context.Context) (*WorkflowStatus, error) {
+	return withHooks(ctx, wsuo.sqlSave, wsuo.mutation, wsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WorkflowStatusUpdateOne) SaveX(ctx context.Context) *WorkflowStatus {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WorkflowStatusUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WorkflowStatusUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsuo *WorkflowStatusUpdateOne) check() error {
+	if v, ok := wsuo.mutation.Category(); ok {
+		if err := workflowstatus.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.category": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.SystemStatus(); ok {
+		if err := workflowstatus.SystemStatusValidator(v); err != nil {
+			return &ValidationError{Name: "system_status", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.system_status": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.WipLimit(); ok {
+		if err := workflowstatus.WipLimitValidator(v); err != nil {
+			return &ValidationError{Name: "wip_limit", err: fmt.Errorf(`ent: validator failed for field "WorkflowStatus.wip_limit": %w`, err)}
+		}
+	}
+	if wsuo.mutation.ProjectCleared() && len(wsuo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkflowStatus.project"`)
+	}
+	return nil
+}
+
+func (wsuo *WorkflowStatusUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowStatus, err error) {
+	if err := wsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowstatus.Table, workflowstatus.Columns, sqlgraph.NewFieldSpec(workflowstatus.FieldID, field.TypeInt))
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkflowStatus.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowstatus.FieldID)
+		for _, f := range fields {
+			if !workflowstatus.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workflowstatus.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsuo.mutation.Key(); ok {
+		_spec.SetField(workflowstatus.FieldKey, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Name(); ok {
+		_spec.SetField(workflowstatus.FieldName, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Category(); ok {
+		_spec.SetField(workflowstatus.FieldCategory, field.TypeEnum, value)
+	}
+	if value, ok := wsuo.mutation.SystemStatus(); ok {
+		_spec.SetField(workflowstatus.FieldSystemStatus, field.TypeEnum, value)
+	}
+	if value, ok := wsuo.mutation.Position(); ok {
+		_spec.SetField(workflowstatus.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.AddedPosition(); ok {
+		_spec.AddField(workflowstatus.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.WipLimit(); ok {
+		_spec.SetField(workflowstatus.FieldWipLimit, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.AddedWipLimit(); ok {
+		_spec.AddField(workflowstatus.FieldWipLimit, field.TypeInt, value)
+	}
+	if wsuo.mutation.WipLimitCleared() {
+		_spec.ClearField(workflowstatus.FieldWipLimit, field.TypeInt)
+	}
+	if wsuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.ProjectTable,
+			Columns: []string{workflowstatus.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowstatus.ProjectTable,
+			Columns: []string{workflowstatus.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WorkflowStatus{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowstatus.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
+)
+
+// WorkflowTransition is the model entity for the WorkflowTransition schema.
+type WorkflowTransition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id"`
+	// FromKey holds the value of the "from_key" field.
+	FromKey string `json:"from_key"`
+	// ToKey holds the value of the "to_key" field.
+	ToKey string `json:"to_key"`
+	// Guards holds the value of the "guards" field.
+	Guards []string `json:"guards"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkflowTransitionQuery when eager-loading is set.
+	Edges        WorkflowTransitionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkflowTransitionEdges holds the relations/edges for other nodes in the graph.
+type WorkflowTransitionEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkflowTransitionEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkflowTransition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workflowtransition.FieldGuards:
+			values[i] = new([]byte)
+		case workflowtransition.FieldID, workflowtransition.FieldProjectID:
+			values[i] = new(sql.NullInt64)
+		case workflowtransition.FieldFromKey, workflowtransition.FieldToKey:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkflowTransition fields.
+func (wt *WorkflowTransition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workflowtransition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wt.ID = int(value.Int64)
+		case workflowtransition.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				wt.ProjectID = int(value.Int64)
+			}
+		case workflowtransition.FieldFromKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_key", values[i])
+			} else if value.Valid {
+				wt.FromKey = value.String
+			}
+		case workflowtransition.FieldToKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_key", values[i])
+			} else if value.Valid {
+				wt.ToKey = value.String
+			}
+		case workflowtransition.FieldGuards:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field guards", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wt.Guards); err != nil {
+					return fmt.Errorf("unmarshal field guards: %w", err)
+				}
+			}
+		default:
+			wt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkflowTransition.
+// This includes values selected through modifiers, order, etc.
+func (wt *WorkflowTransition) Value(name string) (ent.Value, error) {
+	return wt.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the WorkflowTransition entity.
+func (wt *WorkflowTransition) QueryProject() *ProjectQuery {
+	return NewWorkflowTransitionClient(wt.config).QueryProject(wt)
+}
+
+// Update returns a builder for updating this WorkflowTransition.
+// Note that you need to call WorkflowTransition.Unwrap() before calling this method if this WorkflowTransition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wt *WorkflowTransition) Update() *WorkflowTransitionUpdateOne {
+	return NewWorkflowTransitionClient(wt.config).UpdateOne(wt)
+}
+
+// Unwrap unwraps the WorkflowTransition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wt *WorkflowTransition) Unwrap() *WorkflowTransition {
+	_tx, ok := wt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkflowTransition is not a transactional entity")
+	}
+	wt.config.driver = _tx.drv
+	return wt
+}
+
+// String implements the fmt.Stringer.
+func (wt *WorkflowTransition) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkflowTransition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wt.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", wt.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("from_key=")
+	builder.WriteString(wt.FromKey)
+	builder.WriteString(", ")
+	builder.WriteString("to_key=")
+	builder.WriteString(wt.ToKey)
+	builder.WriteString(", ")
+	builder.WriteString("guards=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Guards))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkflowTransitions is a parsable slice of WorkflowTransition.
+type WorkflowTransitions []*WorkflowTransition
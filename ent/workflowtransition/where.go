@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workflowtransition
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldLTE(FieldID, id))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEQ(FieldProjectID, v))
+}
+
+// FromKey applies equality check predicate on the "from_key" field. It's identical to FromKeyEQ.
+func FromKey(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEQ(FieldFromKey, v))
+}
+
+// ToKey applies equality check predicate on the "to_key" field. It's identical to ToKeyEQ.
+func ToKey(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEQ(FieldToKey, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// FromKeyEQ applies the EQ predicate on the "from_key" field.
+func FromKeyEQ(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEQ(FieldFromKey, v))
+}
+
+// FromKeyNEQ applies the NEQ predicate on the "from_key" field.
+func FromKeyNEQ(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNEQ(FieldFromKey, v))
+}
+
+// FromKeyIn applies the In predicate on the "from_key" field.
+func FromKeyIn(vs ...string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldIn(FieldFromKey, vs...))
+}
+
+// FromKeyNotIn applies the NotIn predicate on the "from_key" field.
+func FromKeyNotIn(vs ...string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNotIn(FieldFromKey, vs...))
+}
+
+// FromKeyGT applies the GT predicate on the "from_key" field.
+func FromKeyGT(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldGT(FieldFromKey, v))
+}
+
+// FromKeyGTE applies the GTE predicate on the "from_key" field.
+func FromKeyGTE(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldGTE(FieldFromKey, v))
+}
+
+// FromKeyLT applies the LT predicate on the "from_key" field.
+func FromKeyLT(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldLT(FieldFromKey, v))
+}
+
+// FromKeyLTE applies the LTE predicate on the "from_key" field.
+func FromKeyLTE(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldLTE(FieldFromKey, v))
+}
+
+// FromKeyContains applies the Contains predicate on the "from_key" field.
+func FromKeyContains(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldContains(FieldFromKey, v))
+}
+
+// FromKeyHasPrefix applies the HasPrefix predicate on the "from_key" field.
+func FromKeyHasPrefix(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldHasPrefix(FieldFromKey, v))
+}
+
+// FromKeyHasSuffix applies the HasSuffix predicate on the "from_key" field.
+func FromKeyHasSuffix(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldHasSuffix(FieldFromKey, v))
+}
+
+// FromKeyEqualFold applies the EqualFold predicate on the "from_key" field.
+func FromKeyEqualFold(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEqualFold(FieldFromKey, v))
+}
+
+// FromKeyContainsFold applies the ContainsFold predicate on the "from_key" field.
+func FromKeyContainsFold(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldContainsFold(FieldFromKey, v))
+}
+
+// ToKeyEQ applies the EQ predicate on the "to_key" field.
+func ToKeyEQ(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEQ(FieldToKey, v))
+}
+
+// ToKeyNEQ applies the NEQ predicate on the "to_key" field.
+func ToKeyNEQ(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNEQ(FieldToKey, v))
+}
+
+// ToKeyIn applies the In predicate on the "to_key" field.
+func ToKeyIn(vs ...string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldIn(FieldToKey, vs...))
+}
+
+// ToKeyNotIn applies the NotIn predicate on the "to_key" field.
+func ToKeyNotIn(vs ...string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNotIn(FieldToKey, vs...))
+}
+
+// ToKeyGT applies the GT predicate on the "to_key" field.
+func ToKeyGT(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldGT(FieldToKey, v))
+}
+
+// ToKeyGTE applies the GTE predicate on the "to_key" field.
+func ToKeyGTE(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldGTE(FieldToKey, v))
+}
+
+// ToKeyLT applies the LT predicate on the "to_key" field.
+func ToKeyLT(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldLT(FieldToKey, v))
+}
+
+// ToKeyLTE applies the LTE predicate on the "to_key" field.
+func ToKeyLTE(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldLTE(FieldToKey, v))
+}
+
+// ToKeyContains applies the Contains predicate on the "to_key" field.
+func ToKeyContains(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldContains(FieldToKey, v))
+}
+
+// ToKeyHasPrefix applies the HasPrefix predicate on the "to_key" field.
+func ToKeyHasPrefix(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldHasPrefix(FieldToKey, v))
+}
+
+// ToKeyHasSuffix applies the HasSuffix predicate on the "to_key" field.
+func ToKeyHasSuffix(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldHasSuffix(FieldToKey, v))
+}
+
+// ToKeyEqualFold applies the EqualFold predicate on the "to_key" field.
+func ToKeyEqualFold(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldEqualFold(FieldToKey, v))
+}
+
+// ToKeyContainsFold applies the ContainsFold predicate on the "to_key" field.
+func ToKeyContainsFold(v string) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldContainsFold(FieldToKey, v))
+}
+
+// GuardsIsNil applies the IsNil predicate on the "guards" field.
+func GuardsIsNil() predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldIsNull(FieldGuards))
+}
+
+// GuardsNotNil applies the NotNil predicate on the "guards" field.
+func GuardsNotNil() predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.FieldNotNull(FieldGuards))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkflowTransition) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkflowTransition) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkflowTransition) predicate.WorkflowTransition {
+	return predicate.WorkflowTransition(sql.NotPredicates(p))
+}
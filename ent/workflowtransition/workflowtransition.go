@@ -0,0 +1,95 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workflowtransition
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the workflowtransition type in the database.
+	Label = "workflow_transition"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldFromKey holds the string denoting the from_key field in the database.
+	FieldFromKey = "from_key"
+	// FieldToKey holds the string denoting the to_key field in the database.
+	FieldToKey = "to_key"
+	// FieldGuards holds the string denoting the guards field in the database.
+	FieldGuards = "guards"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// Table holds the table name of the workflowtransition in the database.
+	Table = "workflow_transitions"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "workflow_transitions"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_id"
+)
+
+// Columns holds all SQL columns for workflowtransition fields.
+var Columns = []string{
+	FieldID,
+	FieldProjectID,
+	FieldFromKey,
+	FieldToKey,
+	FieldGuards,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFromKey holds the default value on creation for the "from_key" field.
+	DefaultFromKey string
+)
+
+// OrderOption defines the ordering options for the WorkflowTransition queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByFromKey orders the results by the from_key field.
+func ByFromKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromKey, opts...).ToFunc()
+}
+
+// ByToKey orders the results by the to_key field.
+func ByToKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToKey, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
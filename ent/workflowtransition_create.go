@@ -0,0 +1,670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
+)
+
+// WorkflowTransitionCreate is the builder for creating a WorkflowTransition entity.
+type WorkflowTransitionCreate struct {
+	config
+	mutation *WorkflowTransitionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProjectID sets the "project_id" field.
+func (wtc *WorkflowTransitionCreate) SetProjectID(i int) *WorkflowTransitionCreate {
+	wtc.mutation.SetProjectID(i)
+	return wtc
+}
+
+// SetFromKey sets the "from_key" field.
+func (wtc *WorkflowTransitionCreate) SetFromKey(s string) *WorkflowTransitionCreate {
+	wtc.mutation.SetFromKey(s)
+	return wtc
+}
+
+// SetNillableFromKey sets the "from_key" field if the given value is not nil.
+func (wtc *WorkflowTransitionCreate) SetNillableFromKey(s *string) *WorkflowTransitionCreate {
+	if s != nil {
+		wtc.SetFromKey(*s)
+	}
+	return wtc
+}
+
+// SetToKey sets the "to_key" field.
+func (wtc *WorkflowTransitionCreate) SetToKey(s string) *WorkflowTransitionCreate {
+	wtc.mutation.SetToKey(s)
+	return wtc
+}
+
+// SetGuards sets the "guards" field.
+func (wtc *WorkflowTransitionCreate) SetGuards(s []string) *WorkflowTransitionCreate {
+	wtc.mutation.SetGuards(s)
+	return wtc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (wtc *WorkflowTransitionCreate) SetProject(p *Project) *WorkflowTransitionCreate {
+	return wtc.SetProjectID(p.ID)
+}
+
+// Mutation returns the WorkflowTransitionMutation object of the builder.
+func (wtc *WorkflowTransitionCreate) Mutation() *WorkflowTransitionMutation {
+	return wtc.mutation
+}
+
+// Save creates the WorkflowTransition in the database.
+func (wtc *WorkflowTransitionCreate) Save(ctx context.Context) (*WorkflowTransition, error) {
+	wtc.defaults()
+	return withHooks(ctx, wtc.sqlSave, wtc.mutation, wtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wtc *WorkflowTransitionCreate) SaveX(ctx context.Context) *WorkflowTransition {
+	v, err := wtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wtc *WorkflowTransitionCreate) Exec(ctx context.Context) error {
+	_, err := wtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtc *WorkflowTransitionCreate) ExecX(ctx context.Context) {
+	if err := wtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wtc *WorkflowTransitionCreate) defaults() {
+	if _, ok := wtc.mutation.FromKey(); !ok {
+		v := workflowtransition.DefaultFromKey
+		wtc.mutation.SetFromKey(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtc *WorkflowTransitionCreate) check() error {
+	if _, ok := wtc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "WorkflowTransition.project_id"`)}
+	}
+	if _, ok := wtc.mutation.FromKey(); !ok {
+		return &ValidationError{Name: "from_key", err: errors.New(`ent: missing required field "WorkflowTransition.from_key"`)}
+	}
+	if _, ok := wtc.mutation.ToKey(); !ok {
+		return &ValidationError{Name: "to_key", err: errors.New(`ent: missing required field "WorkflowTransition.to_key"`)}
+	}
+	if len(wtc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "WorkflowTransition.project"`)}
+	}
+	return nil
+}
+
+func (wtc *WorkflowTransitionCreate) sqlSave(ctx context.Context) (*WorkflowTransition, error) {
+	if err := wtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wtc.mutation.id = &_node.ID
+	wtc.mutation.done = true
+	return _node, nil
+}
+
+func (wtc *WorkflowTransitionCreate) createSpec() (*WorkflowTransition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkflowTransition{config: wtc.config}
+		_spec = sqlgraph.NewCreateSpec(workflowtransition.Table, sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = wtc.conflict
+	if value, ok := wtc.mutation.FromKey(); ok {
+		_spec.SetField(workflowtransition.FieldFromKey, field.TypeString, value)
+		_node.FromKey = value
+	}
+	if value, ok := wtc.mutation.ToKey(); ok {
+		_spec.SetField(workflowtransition.FieldToKey, field.TypeString, value)
+		_node.ToKey = value
+	}
+	if value, ok := wtc.mutation.Guards(); ok {
+		_spec.SetField(workflowtransition.FieldGuards, field.TypeJSON, value)
+		_node.Guards = value
+	}
+	if nodes := wtc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowtransition.ProjectTable,
+			Columns: []string{workflowtransition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkflowTransition.Create().
+//		SetProjectID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkflowTransitionUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (wtc *WorkflowTransitionCreate) OnConflict(opts ...sql.ConflictOption) *WorkflowTransitionUpsertOne {
+	wtc.conflict = opts
+	return &WorkflowTransitionUpsertOne{
+		create: wtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkflowTransition.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wtc *WorkflowTransitionCreate) OnConflictColumns(columns ...string) *WorkflowTransitionUpsertOne {
+	wtc.conflict = append(wtc.conflict, sql.ConflictColumns(columns...))
+	return &WorkflowTransitionUpsertOne{
+		create: wtc,
+	}
+}
+
+type (
+	// WorkflowTransitionUpsertOne is the builder for "upsert"-ing
+	//  one WorkflowTransition node.
+	WorkflowTransitionUpsertOne struct {
+		create *WorkflowTransitionCreate
+	}
+
+	// WorkflowTransitionUpsert is the "OnConflict" setter.
+	WorkflowTransitionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProjectID sets the "project_id" field.
+func (u *WorkflowTransitionUpsert) SetProjectID(v int) *WorkflowTransitionUpsert {
+	u.Set(workflowtransition.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsert) UpdateProjectID() *WorkflowTransitionUpsert {
+	u.SetExcluded(workflowtransition.FieldProjectID)
+	return u
+}
+
+// SetFromKey sets the "from_key" field.
+func (u *WorkflowTransitionUpsert) SetFromKey(v string) *WorkflowTransitionUpsert {
+	u.Set(workflowtransition.FieldFromKey, v)
+	return u
+}
+
+// UpdateFromKey sets the "from_key" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsert) UpdateFromKey() *WorkflowTransitionUpsert {
+	u.SetExcluded(workflowtransition.FieldFromKey)
+	return u
+}
+
+// SetToKey sets the "to_key" field.
+func (u *WorkflowTransitionUpsert) SetToKey(v string) *WorkflowTransitionUpsert {
+	u.Set(workflowtransition.FieldToKey, v)
+	return u
+}
+
+// UpdateToKey sets the "to_key" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsert) UpdateToKey() *WorkflowTransitionUpsert {
+	u.SetExcluded(workflowtransition.FieldToKey)
+	return u
+}
+
+// SetGuards sets the "guards" field.
+func (u *WorkflowTransitionUpsert) SetGuards(v []string) *WorkflowTransitionUpsert {
+	u.Set(workflowtransition.FieldGuards, v)
+	return u
+}
+
+// UpdateGuards sets the "guards" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsert) UpdateGuards() *WorkflowTransitionUpsert {
+	u.SetExcluded(workflowtransition.FieldGuards)
+	return u
+}
+
+// ClearGuards clears the value of the "guards" field.
+func (u *WorkflowTransitionUpsert) ClearGuards() *WorkflowTransitionUpsert {
+	u.SetNull(workflowtransition.FieldGuards)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowTransition.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WorkflowTransitionUpsertOne) UpdateNewValues() *WorkflowTransitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowTransition.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WorkflowTransitionUpsertOne) Ignore() *WorkflowTransitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkflowTransitionUpsertOne) DoNothing() *WorkflowTransitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkflowTransitionCreate.OnConflict
+// documentation for more info.
+func (u *WorkflowTransitionUpsertOne) Update(set func(*WorkflowTransitionUpsert)) *WorkflowTransitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkflowTransitionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *WorkflowTransitionUpsertOne) SetProjectID(v int) *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsertOne) UpdateProjectID() *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetFromKey sets the "from_key" field.
+func (u *WorkflowTransitionUpsertOne) SetFromKey(v string) *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.SetFromKey(v)
+	})
+}
+
+// UpdateFromKey sets the "from_key" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsertOne) UpdateFromKey() *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.UpdateFromKey()
+	})
+}
+
+// SetToKey sets the "to_key" field.
+func (u *WorkflowTransitionUpsertOne) SetToKey(v string) *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.SetToKey(v)
+	})
+}
+
+// UpdateToKey sets the "to_key" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsertOne) UpdateToKey() *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.UpdateToKey()
+	})
+}
+
+// SetGuards sets the "guards" field.
+func (u *WorkflowTransitionUpsertOne) SetGuards(v []string) *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.SetGuards(v)
+	})
+}
+
+// UpdateGuards sets the "guards" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsertOne) UpdateGuards() *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.UpdateGuards()
+	})
+}
+
+// ClearGuards clears the value of the "guards" field.
+func (u *WorkflowTransitionUpsertOne) ClearGuards() *WorkflowTransitionUpsertOne {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.ClearGuards()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkflowTransitionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkflowTransitionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkflowTransitionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WorkflowTransitionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WorkflowTransitionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WorkflowTransitionCreateBulk is the builder for creating many WorkflowTransition entities in bulk.
+type WorkflowTransitionCreateBulk struct {
+	config
+	err      error
+	builders []*WorkflowTransitionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WorkflowTransition entities in the database.
+func (wtcb *WorkflowTransitionCreateBulk) Save(ctx context.Context) ([]*WorkflowTransition, error) {
+	if wtcb.err != nil {
+		return nil, wtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wtcb.builders))
+	nodes := make([]*WorkflowTransition, len(wtcb.builders))
+	mutators := make([]Mutator, len(wtcb.builders))
+	for i := range wtcb.builders {
+		func(i int, root context.Context) {
+			builder := wtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkflowTransitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtcb *WorkflowTransitionCreateBulk) SaveX(ctx context.Context) []*WorkflowTransition {
+	v, err := wtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wtcb *WorkflowTransitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtcb *WorkflowTransitionCreateBulk) ExecX(ctx context.Context) {
+	if err := wtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkflowTransition.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkflowTransitionUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (wtcb *WorkflowTransitionCreateBulk) OnConflict(opts ...sql.ConflictOption) *WorkflowTransitionUpsertBulk {
+	wtcb.conflict = opts
+	return &WorkflowTransitionUpsertBulk{
+		create: wtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkflowTransition.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wtcb *WorkflowTransitionCreateBulk) OnConflictColumns(columns ...string) *WorkflowTransitionUpsertBulk {
+	wtcb.conflict = append(wtcb.conflict, sql.ConflictColumns(columns...))
+	return &WorkflowTransitionUpsertBulk{
+		create: wtcb,
+	}
+}
+
+// WorkflowTransitionUpsertBulk is the builder for "upsert"-ing
+// a bulk of WorkflowTransition nodes.
+type WorkflowTransitionUpsertBulk struct {
+	create *WorkflowTransitionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WorkflowTransition.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WorkflowTransitionUpsertBulk) UpdateNewValues() *WorkflowTransitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowTransition.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WorkflowTransitionUpsertBulk) Ignore() *WorkflowTransitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkflowTransitionUpsertBulk) DoNothing() *WorkflowTransitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkflowTransitionCreateBulk.OnConflict
+// documentation for more info.
+func (u *WorkflowTransitionUpsertBulk) Update(set func(*WorkflowTransitionUpsert)) *WorkflowTransitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkflowTransitionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *WorkflowTransitionUpsertBulk) SetProjectID(v int) *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsertBulk) UpdateProjectID() *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetFromKey sets the "from_key" field.
+func (u *WorkflowTransitionUpsertBulk) SetFromKey(v string) *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.SetFromKey(v)
+	})
+}
+
+// UpdateFromKey sets the "from_key" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsertBulk) UpdateFromKey() *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.UpdateFromKey()
+	})
+}
+
+// SetToKey sets the "to_key" field.
+func (u *WorkflowTransitionUpsertBulk) SetToKey(v string) *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.SetToKey(v)
+	})
+}
+
+// UpdateToKey sets the "to_key" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsertBulk) UpdateToKey() *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.UpdateToKey()
+	})
+}
+
+// SetGuards sets the "guards" field.
+func (u *WorkflowTransitionUpsertBulk) SetGuards(v []string) *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.SetGuards(v)
+	})
+}
+
+// UpdateGuards sets the "guards" field to the value that was provided on create.
+func (u *WorkflowTransitionUpsertBulk) UpdateGuards() *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.UpdateGuards()
+	})
+}
+
+// ClearGuards clears the value of the "guards" field.
+func (u *WorkflowTransitionUpsertBulk) ClearGuards() *WorkflowTransitionUpsertBulk {
+	return u.Update(func(s *WorkflowTransitionUpsert) {
+		s.ClearGuards()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkflowTransitionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WorkflowTransitionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkflowTransitionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkflowTransitionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
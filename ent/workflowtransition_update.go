@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
+)
+
+// WorkflowTransitionUpdate is the builder for updating WorkflowTransition entities.
+type WorkflowTransitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkflowTransitionMutation
+}
+
+// Where appends a list predicates to the WorkflowTransitionUpdate builder.
+func (wtu *WorkflowTransitionUpdate) Where(ps ...predicate.WorkflowTransition) *WorkflowTransitionUpdate {
+	wtu.mutation.Where(ps...)
+	return wtu
+}
+
+// SetProjectID sets the "project_id" field.
+func (wtu *WorkflowTransitionUpdate) SetProjectID(i int) *WorkflowTransitionUpdate {
+	wtu.mutation.SetProjectID(i)
+	return wtu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (wtu *WorkflowTransitionUpdate) SetNillableProjectID(i *int) *WorkflowTransitionUpdate {
+	if i != nil {
+		wtu.SetProjectID(*i)
+	}
+	return wtu
+}
+
+// SetFromKey sets the "from_key" field.
+func (wtu *WorkflowTransitionUpdate) SetFromKey(s string) *WorkflowTransitionUpdate {
+	wtu.mutation.SetFromKey(s)
+	return wtu
+}
+
+// SetNillableFromKey sets the "from_key" field if the given value is not nil.
+func (wtu *WorkflowTransitionUpdate) SetNillableFromKey(s *string) *WorkflowTransitionUpdate {
+	if s != nil {
+		wtu.SetFromKey(*s)
+	}
+	return wtu
+}
+
+// SetToKey sets the "to_key" field.
+func (wtu *WorkflowTransitionUpdate) SetToKey(s string) *WorkflowTransitionUpdate {
+	wtu.mutation.SetToKey(s)
+	return wtu
+}
+
+// SetNillableToKey sets the "to_key" field if the given value is not nil.
+func (wtu *WorkflowTransitionUpdate) SetNillableToKey(s *string) *WorkflowTransitionUpdate {
+	if s != nil {
+		wtu.SetToKey(*s)
+	}
+	return wtu
+}
+
+// SetGuards sets the "guards" field.
+func (wtu *WorkflowTransitionUpdate) SetGuards(s []string) *WorkflowTransitionUpdate {
+	wtu.mutation.SetGuards(s)
+	return wtu
+}
+
+// AppendGuards appends s to the "guards" field.
+func (wtu *WorkflowTransitionUpdate) AppendGuards(s []string) *WorkflowTransitionUpdate {
+	wtu.mutation.AppendGuards(s)
+	return wtu
+}
+
+// ClearGuards clears the value of the "guards" field.
+func (wtu *WorkflowTransitionUpdate) ClearGuards() *WorkflowTransitionUpdate {
+	wtu.mutation.ClearGuards()
+	return wtu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (wtu *WorkflowTransitionUpdate) SetProject(p *Project) *WorkflowTransitionUpdate {
+	return wtu.SetProjectID(p.ID)
+}
+
+// Mutation returns the WorkflowTransitionMutation object of the builder.
+func (wtu *WorkflowTransitionUpdate) Mutation() *WorkflowTransitionMutation {
+	return wtu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (wtu *WorkflowTransitionUpdate) ClearProject() *WorkflowTransitionUpdate {
+	wtu.mutation.ClearProject()
+	return wtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wtu *WorkflowTransitionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wtu.sqlSave, wtu.mutation, wtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtu *WorkflowTransitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wtu *WorkflowTransitionUpdate) Exec(ctx context.Context) error {
+	_, err := wtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtu *WorkflowTransitionUpdate) ExecX(ctx context.Context) {
+	if err := wtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtu *WorkflowTransitionUpdate) check() error {
+	if wtu.mutation.ProjectCleared() && len(wtu.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkflowTransition.project"`)
+	}
+	return nil
+}
+
+func (wtu *WorkflowTransitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowtransition.Table, workflowtransition.Columns, sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt))
+	if ps := wtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtu.mutation.FromKey(); ok {
+		_spec.SetField(workflowtransition.FieldFromKey, field.TypeString, value)
+	}
+	if value, ok := wtu.mutation.ToKey(); ok {
+		_spec.SetField(workflowtransition.FieldToKey, field.TypeString, value)
+	}
+	if value, ok := wtu.mutation.Guards(); ok {
+		_spec.SetField(workflowtransition.FieldGuards, field.TypeJSON, value)
+	}
+	if value, ok := wtu.mutation.AppendedGuards(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowtransition.FieldGuards, value)
+		})
+	}
+	if wtu.mutation.GuardsCleared() {
+		_spec.ClearField(workflowtransition.FieldGuards, field.TypeJSON)
+	}
+	if wtu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowtransition.ProjectTable,
+			Columns: []string{workflowtransition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wtu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowtransition.ProjectTable,
+			Columns: []string{workflowtransition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowtransition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wtu.mutation.done = true
+	return n, nil
+}
+
+// WorkflowTransitionUpdateOne is the builder for updating a single WorkflowTransition entity.
+type WorkflowTransitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkflowTransitionMutation
+}
+
+// SetProjectID sets the "project_id" field.
+func (wtuo *WorkflowTransitionUpdateOne) SetProjectID(i int) *WorkflowTransitionUpdateOne {
+	wtuo.mutation.SetProjectID(i)
+	return wtuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (wtuo *WorkflowTransitionUpdateOne) SetNillableProjectID(i *int) *WorkflowTransitionUpdateOne {
+	if i != nil {
+		wtuo.SetProjectID(*i)
+	}
+	return wtuo
+}
+
+// SetFromKey sets the "from_key" field.
+func (wtuo *WorkflowTransitionUpdateOne) SetFromKey(s string) *WorkflowTransitionUpdateOne {
+	wtuo.mutation.SetFromKey(s)
+	return wtuo
+}
+
+// SetNillableFromKey sets the "from_key" field if the given value is not nil.
+func (wtuo *WorkflowTransitionUpdateOne) SetNillableFromKey(s *string) *WorkflowTransitionUpdateOne {
+	if s != nil {
+		wtuo.SetFromKey(*s)
+	}
+	return wtuo
+}
+
+// SetToKey sets the "to_key" field.
+func (wtuo *WorkflowTransitionUpdateOne) SetToKey(s string) *WorkflowTransitionUpdateOne {
+	wtuo.mutation.SetToKey(s)
+	return wtuo
+}
+
+// SetNillableToKey sets the "to_key" field if the given value is not nil.
+func (wtuo *WorkflowTransitionUpdateOne) SetNillableToKey(s *string) *WorkflowTransitionUpdateOne {
+	if s != nil {
+		wtuo.SetToKey(*s)
+	}
+	return wtuo
+}
+
+// SetGuards sets the "guards" field.
+func (wtuo *WorkflowTransitionUpdateOne) SetGuards(s []string) *WorkflowTransitionUpdateOne {
+	wtuo.mutation.SetGuards(s)
+	return wtuo
+}
+
+// AppendGuards appends s to the "guards" field.
+func (wtuo *WorkflowTransitionUpdateOne) AppendGuards(s []string) *WorkflowTransitionUpdateOne {
+	wtuo.mutation.AppendGuards(s)
+	return wtuo
+}
+
+// ClearGuards clears the value of the "guards" field.
+func (wtuo *WorkflowTransitionUpdateOne) ClearGuards() *WorkflowTransitionUpdateOne {
+	wtuo.mutation.ClearGuards()
+	return wtuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (wtuo *WorkflowTransitionUpdateOne) SetProject(p *Project) *WorkflowTransitionUpdateOne {
+	return wtuo.SetProjectID(p.ID)
+}
+
+// Mutation returns the WorkflowTransitionMutation object of the builder.
+func (wtuo *WorkflowTransitionUpdateOne) Mutation() *WorkflowTransitionMutation {
+	return wtuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (wtuo *WorkflowTransitionUpdateOne) ClearProject() *WorkflowTransitionUpdateOne {
+	wtuo.mutation.ClearProject()
+	return wtuo
+}
+
+// Where appends a list predicates to the WorkflowTransitionUpdate builder.
+func (wtuo *WorkflowTransitionUpdateOne) Where(ps ...predicate.WorkflowTransition) *WorkflowTransitionUpdateOne {
+	wtuo.mutation.Where(ps...)
+	return wtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wtuo *WorkflowTransitionUpdateOne) Select(field string, fields ...string) *WorkflowTransitionUpdateOne {
+	wtuo.fields = append([]string{field}, fields...)
+	return wtuo
+}
+
+// Save executes the query and returns the updated WorkflowTransition entity.
+func (wtuo *WorkflowTransitionUpdateOne) Save(ctx context.Context) (*WorkflowTransition, error) {
+	return withHooks(ctx, wtuo.sqlSave, wtuo.mutation, wtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtuo *WorkflowTransitionUpdateOne) SaveX(ctx context.Context) *WorkflowTransition {
+	node, err := wtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wtuo *WorkflowTransitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtuo *WorkflowTransitionUpdateOne) ExecX(ctx context.Context) {
+	if err := wtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtuo *WorkflowTransitionUpdateOne) check() error {
+	if wtuo.mutation.ProjectCleared() && len(wtuo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkflowTransition.project"`)
+	}
+	return nil
+}
+
+func (wtuo *WorkflowTransitionUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowTransition, err error) {
+	if err := wtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowtransition.Table, workflowtransition.Columns, sqlgraph.NewFieldSpec(workflowtransition.FieldID, field.TypeInt))
+	id, ok := wtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkflowTransition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowtransition.FieldID)
+		for _, f := range fields {
+			if !workflowtransition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workflowtransition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtuo.mutation.FromKey(); ok {
+		_spec.SetField(workflowtransition.FieldFromKey, field.TypeString, value)
+	}
+	if value, ok := wtuo.mutation.ToKey(); ok {
+		_spec.SetField(workflowtransition.FieldToKey, field.TypeString, value)
+	}
+	if value, ok := wtuo.mutation.Guards(); ok {
+		_spec.SetField(workflowtransition.FieldGuards, field.TypeJSON, value)
+	}
+	if value, ok := wtuo.mutation.AppendedGuards(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, workflowtransition.FieldGuards, value)
+		})
+	}
+	if wtuo.mutation.GuardsCleared() {
+		_spec.ClearField(workflowtransition.FieldGuards, field.TypeJSON)
+	}
+	if wtuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowtransition.ProjectTable,
+			Columns: []string{workflowtransition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wtuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowtransition.ProjectTable,
+			Columns: []string{workflowtransition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WorkflowTransition{config: wtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowtransition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wtuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+)
+
+// WorkLog is the model entity for the WorkLog schema.
+type WorkLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id"`
+	// EmployeeID holds the value of the "employee_id" field.
+	EmployeeID int `json:"employee_id"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at"`
+	// Duration holds the value of the "duration" field.
+	Duration int `json:"duration"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkLogQuery when eager-loading is set.
+	Edges        WorkLogEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkLogEdges holds the relations/edges for other nodes in the graph.
+type WorkLogEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task"`
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkLogEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkLogEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case worklog.FieldID, worklog.FieldTaskID, worklog.FieldEmployeeID, worklog.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case worklog.FieldNote:
+			values[i] = new(sql.NullString)
+		case worklog.FieldStartedAt, worklog.FieldEndedAt, worklog.FieldCreatedAt, worklog.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkLog fields.
+func (wl *WorkLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case worklog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wl.ID = int(value.Int64)
+		case worklog.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				wl.TaskID = int(value.Int64)
+			}
+		case worklog.FieldEmployeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value.Valid {
+				wl.EmployeeID = int(value.Int64)
+			}
+		case worklog.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				wl.StartedAt = value.Time
+			}
+		case worklog.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				wl.EndedAt = new(time.Time)
+				*wl.EndedAt = value.Time
+			}
+		case worklog.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				wl.Duration = int(value.Int64)
+			}
+		case worklog.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				wl.Note = value.String
+			}
+		case worklog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wl.CreatedAt = value.Time
+			}
+		case worklog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wl.UpdatedAt = value.Time
+			}
+		default:
+			wl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkLog.
+// This includes values selected through modifiers, order, etc.
+func (wl *WorkLog) Value(name string) (ent.Value, error) {
+	return wl.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the WorkLog entity.
+func (wl *WorkLog) QueryTask() *TaskQuery {
+	return NewWorkLogClient(wl.config).QueryTask(wl)
+}
+
+// QueryEmployee queries the "employee" edge of the WorkLog entity.
+func (wl *WorkLog) QueryEmployee() *EmployeeQuery {
+	return NewWorkLogClient(wl.config).QueryEmployee(wl)
+}
+
+// Update returns a builder for updating this WorkLog.
+// Note that you need to call WorkLog.Unwrap() before calling this method if this WorkLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wl *WorkLog) Update() *WorkLogUpdateOne {
+	return NewWorkLogClient(wl.config).UpdateOne(wl)
+}
+
+// Unwrap unwraps the WorkLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wl *WorkLog) Unwrap() *WorkLog {
+	_tx, ok := wl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkLog is not a transactional entity")
+	}
+	wl.config.driver = _tx.drv
+	return wl
+}
+
+// String implements the fmt.Stringer.
+func (wl *WorkLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wl.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", wl.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", wl.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(wl.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := wl.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", wl.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(wl.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkLogs is a parsable slice of WorkLog.
+type WorkLogs []*WorkLog
@@ -0,0 +1,959 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+)
+
+// WorkLogCreate is the builder for creating a WorkLog entity.
+type WorkLogCreate struct {
+	config
+	mutation *WorkLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTaskID sets the "task_id" field.
+func (wlc *WorkLogCreate) SetTaskID(i int) *WorkLogCreate {
+	wlc.mutation.SetTaskID(i)
+	return wlc
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (wlc *WorkLogCreate) SetEmployeeID(i int) *WorkLogCreate {
+	wlc.mutation.SetEmployeeID(i)
+	return wlc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (wlc *WorkLogCreate) SetStartedAt(t time.Time) *WorkLogCreate {
+	wlc.mutation.SetStartedAt(t)
+	return wlc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (wlc *WorkLogCreate) SetEndedAt(t time.Time) *WorkLogCreate {
+	wlc.mutation.SetEndedAt(t)
+	return wlc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (wlc *WorkLogCreate) SetNillableEndedAt(t *time.Time) *WorkLogCreate {
+	if t != nil {
+		wlc.SetEndedAt(*t)
+	}
+	return wlc
+}
+
+// SetDuration sets the "duration" field.
+func (wlc *WorkLogCreate) SetDuration(i int) *WorkLogCreate {
+	wlc.mutation.SetDuration(i)
+	return wlc
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (wlc *WorkLogCreate) SetNillableDuration(i *int) *WorkLogCreate {
+	if i != nil {
+		wlc.SetDuration(*i)
+	}
+	return wlc
+}
+
+// SetNote sets the "note" field.
+func (wlc *WorkLogCreate) SetNote(s string) *WorkLogCreate {
+	wlc.mutation.SetNote(s)
+	return wlc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wlc *WorkLogCreate) SetNillableNote(s *string) *WorkLogCreate {
+	if s != nil {
+		wlc.SetNote(*s)
+	}
+	return wlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wlc *WorkLogCreate) SetCreatedAt(t time.Time) *WorkLogCreate {
+	wlc.mutation.SetCreatedAt(t)
+	return wlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wlc *WorkLogCreate) SetNillableCreatedAt(t *time.Time) *WorkLogCreate {
+	if t != nil {
+		wlc.SetCreatedAt(*t)
+	}
+	return wlc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wlc *WorkLogCreate) SetUpdatedAt(t time.Time) *WorkLogCreate {
+	wlc.mutation.SetUpdatedAt(t)
+	return wlc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wlc *WorkLogCreate) SetNillableUpdatedAt(t *time.Time) *WorkLogCreate {
+	if t != nil {
+		wlc.SetUpdatedAt(*t)
+	}
+	return wlc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (wlc *WorkLogCreate) SetTask(t *Task) *WorkLogCreate {
+	return wlc.SetTaskID(t.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (wlc *WorkLogCreate) SetEmployee(e *Employee) *WorkLogCreate {
+	return wlc.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the WorkLogMutation object of the builder.
+func (wlc *WorkLogCreate) Mutation() *WorkLogMutation {
+	return wlc.mutation
+}
+
+// Save creates the WorkLog in the database.
+func (wlc *WorkLogCreate) Save(ctx context.Context) (*WorkLog, error) {
+	wlc.defaults()
+	return withHooks(ctx, wlc.sqlSave, wlc.mutation, wlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wlc *WorkLogCreate) SaveX(ctx context.Context) *WorkLog {
+	v, err := wlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wlc *WorkLogCreate) Exec(ctx context.Context) error {
+	_, err := wlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wlc *WorkLogCreate) ExecX(ctx context.Context) {
+	if err := wlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wlc *WorkLogCreate) defaults() {
+	if _, ok := wlc.mutation.Duration(); !ok {
+		v := worklog.DefaultDuration
+		wlc.mutation.SetDuration(v)
+	}
+	if _, ok := wlc.mutation.CreatedAt(); !ok {
+		v := worklog.DefaultCreatedAt()
+		wlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wlc.mutation.UpdatedAt(); !ok {
+		v := worklog.DefaultUpdatedAt()
+		wlc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wlc *WorkLogCreate) check() error {
+	if _, ok := wlc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "WorkLog.task_id"`)}
+	}
+	if _, ok := wlc.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "WorkLog.employee_id"`)}
+	}
+	if _, ok := wlc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "WorkLog.started_at"`)}
+	}
+	if _, ok := wlc.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "WorkLog.duration"`)}
+	}
+	if v, ok := wlc.mutation.Duration(); ok {
+		if err := worklog.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "WorkLog.duration": %w`, err)}
+		}
+	}
+	if _, ok := wlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WorkLog.created_at"`)}
+	}
+	if _, ok := wlc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WorkLog.updated_at"`)}
+	}
+	if len(wlc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "WorkLog.task"`)}
+	}
+	if len(wlc.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "WorkLog.employee"`)}
+	}
+	return nil
+}
+
+func (wlc *WorkLogCreate) sqlSave(ctx context.Context) (*WorkLog, error) {
+	if err := wlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wlc.mutation.id = &_node.ID
+	wlc.mutation.done = true
+	return _node, nil
+}
+
+func (wlc *WorkLogCreate) createSpec() (*WorkLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkLog{config: wlc.config}
+		_spec = sqlgraph.NewCreateSpec(worklog.Table, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = wlc.conflict
+	if value, ok := wlc.mutation.StartedAt(); ok {
+		_spec.SetField(worklog.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := wlc.mutation.EndedAt(); ok {
+		_spec.SetField(worklog.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := wlc.mutation.Duration(); ok {
+		_spec.SetField(worklog.FieldDuration, field.TypeInt, value)
+		_node.Duration = value
+	}
+	if value, ok := wlc.mutation.Note(); ok {
+		_spec.SetField(worklog.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := wlc.mutation.CreatedAt(); ok {
+		_spec.SetField(worklog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wlc.mutation.UpdatedAt(); ok {
+		_spec.SetField(worklog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wlc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wlc.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.EmployeeTable,
+			Columns: []string{worklog.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkLog.Create().
+//		SetTaskID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkLogUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (wlc *WorkLogCreate) OnConflict(opts ...sql.ConflictOption) *WorkLogUpsertOne {
+	wlc.conflict = opts
+	return &WorkLogUpsertOne{
+		create: wlc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wlc *WorkLogCreate) OnConflictColumns(columns ...string) *WorkLogUpsertOne {
+	wlc.conflict = append(wlc.conflict, sql.ConflictColumns(columns...))
+	return &WorkLogUpsertOne{
+		create: wlc,
+	}
+}
+
+type (
+	// WorkLogUpsertOne is the builder for "upsert"-ing
+	//  one WorkLog node.
+	WorkLogUpsertOne struct {
+		create *WorkLogCreate
+	}
+
+	// WorkLogUpsert is the "OnConflict" setter.
+	WorkLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *WorkLogUpsert) SetTaskID(v int) *WorkLogUpsert {
+	u.Set(worklog.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *WorkLogUpsert) UpdateTaskID() *WorkLogUpsert {
+	u.SetExcluded(worklog.FieldTaskID)
+	return u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *WorkLogUpsert) SetEmployeeID(v int) *WorkLogUpsert {
+	u.Set(worklog.FieldEmployeeID, v)
+	return u
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *WorkLogUpsert) UpdateEmployeeID() *WorkLogUpsert {
+	u.SetExcluded(worklog.FieldEmployeeID)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *WorkLogUpsert) SetStartedAt(v time.Time) *WorkLogUpsert {
+	u.Set(worklog.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *WorkLogUpsert) UpdateStartedAt() *WorkLogUpsert {
+	u.SetExcluded(worklog.FieldStartedAt)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *WorkLogUpsert) SetEndedAt(v time.Time) *WorkLogUpsert {
+	u.Set(worklog.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *WorkLogUpsert) UpdateEndedAt() *WorkLogUpsert {
+	u.SetExcluded(worklog.FieldEndedAt)
+	return u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *WorkLogUpsert) ClearEndedAt() *WorkLogUpsert {
+	u.SetNull(worklog.FieldEndedAt)
+	return u
+}
+
+// SetDuration sets the "duration" field.
+func (u *WorkLogUpsert) SetDuration(v int) *WorkLogUpsert {
+	u.Set(worklog.FieldDuration, v)
+	return u
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *WorkLogUpsert) UpdateDuration() *WorkLogUpsert {
+	u.SetExcluded(worklog.FieldDuration)
+	return u
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *WorkLogUpsert) AddDuration(v int) *WorkLogUpsert {
+	u.Add(worklog.FieldDuration, v)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *WorkLogUpsert) SetNote(v string) *WorkLogUpsert {
+	u.Set(worklog.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *WorkLogUpsert) UpdateNote() *WorkLogUpsert {
+	u.SetExcluded(worklog.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *WorkLogUpsert) ClearNote() *WorkLogUpsert {
+	u.SetNull(worklog.FieldNote)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WorkLogUpsert) SetUpdatedAt(v time.Time) *WorkLogUpsert {
+	u.Set(worklog.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WorkLogUpsert) UpdateUpdatedAt() *WorkLogUpsert {
+	u.SetExcluded(worklog.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WorkLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WorkLogUpsertOne) UpdateNewValues() *WorkLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(worklog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WorkLogUpsertOne) Ignore() *WorkLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkLogUpsertOne) DoNothing() *WorkLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkLogCreate.OnConflict
+// documentation for more info.
+func (u *WorkLogUpsertOne) Update(set func(*WorkLogUpsert)) *WorkLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *WorkLogUpsertOne) SetTaskID(v int) *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *WorkLogUpsertOne) UpdateTaskID() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *WorkLogUpsertOne) SetEmployeeID(v int) *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *WorkLogUpsertOne) UpdateEmployeeID() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *WorkLogUpsertOne) SetStartedAt(v time.Time) *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *WorkLogUpsertOne) UpdateStartedAt() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *WorkLogUpsertOne) SetEndedAt(v time.Time) *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *WorkLogUpsertOne) UpdateEndedAt() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *WorkLogUpsertOne) ClearEndedAt() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *WorkLogUpsertOne) SetDuration(v int) *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *WorkLogUpsertOne) AddDuration(v int) *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *WorkLogUpsertOne) UpdateDuration() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *WorkLogUpsertOne) SetNote(v string) *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *WorkLogUpsertOne) UpdateNote() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *WorkLogUpsertOne) ClearNote() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WorkLogUpsertOne) SetUpdatedAt(v time.Time) *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WorkLogUpsertOne) UpdateUpdatedAt() *WorkLogUpsertOne {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WorkLogUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WorkLogUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WorkLogCreateBulk is the builder for creating many WorkLog entities in bulk.
+type WorkLogCreateBulk struct {
+	config
+	err      error
+	builders []*WorkLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WorkLog entities in the database.
+func (wlcb *WorkLogCreateBulk) Save(ctx context.Context) ([]*WorkLog, error) {
+	if wlcb.err != nil {
+		return nil, wlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wlcb.builders))
+	nodes := make([]*WorkLog, len(wlcb.builders))
+	mutators := make([]Mutator, len(wlcb.builders))
+	for i := range wlcb.builders {
+		func(i int, root context.Context) {
+			builder := wlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wlcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wlcb *WorkLogCreateBulk) SaveX(ctx context.Context) []*WorkLog {
+	v, err := wlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wlcb *WorkLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := wlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wlcb *WorkLogCreateBulk) ExecX(ctx context.Context) {
+	if err := wlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkLogUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (wlcb *WorkLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *WorkLogUpsertBulk {
+	wlcb.conflict = opts
+	return &WorkLogUpsertBulk{
+		create: wlcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wlcb *WorkLogCreateBulk) OnConflictColumns(columns ...string) *WorkLogUpsertBulk {
+	wlcb.conflict = append(wlcb.conflict, sql.ConflictColumns(columns...))
+	return &WorkLogUpsertBulk{
+		create: wlcb,
+	}
+}
+
+// WorkLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of WorkLog nodes.
+type WorkLogUpsertBulk struct {
+	create *WorkLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WorkLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WorkLogUpsertBulk) UpdateNewValues() *WorkLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(worklog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WorkLogUpsertBulk) Ignore() *WorkLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkLogUpsertBulk) DoNothing() *WorkLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *WorkLogUpsertBulk) Update(set func(*WorkLogUpsert)) *WorkLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *WorkLogUpsertBulk) SetTaskID(v int) *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *WorkLogUpsertBulk) UpdateTaskID() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (u *WorkLogUpsertBulk) SetEmployeeID(v int) *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetEmployeeID(v)
+	})
+}
+
+// UpdateEmployeeID sets the "employee_id" field to the value that was provided on create.
+func (u *WorkLogUpsertBulk) UpdateEmployeeID() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateEmployeeID()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *WorkLogUpsertBulk) SetStartedAt(v time.Time) *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *WorkLogUpsertBulk) UpdateStartedAt() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *WorkLogUpsertBulk) SetEndedAt(v time.Time) *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *WorkLogUpsertBulk) UpdateEndedAt() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *WorkLogUpsertBulk) ClearEndedAt() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *WorkLogUpsertBulk) SetDuration(v int) *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *WorkLogUpsertBulk) AddDuration(v int) *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *WorkLogUpsertBulk) UpdateDuration() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *WorkLogUpsertBulk) SetNote(v string) *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *WorkLogUpsertBulk) UpdateNote() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *WorkLogUpsertBulk) ClearNote() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WorkLogUpsertBulk) SetUpdatedAt(v time.Time) *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WorkLogUpsertBulk) UpdateUpdatedAt() *WorkLogUpsertBulk {
+	return u.Update(func(s *WorkLogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WorkLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
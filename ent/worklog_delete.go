@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+)
+
+// WorkLogDelete is the builder for deleting a WorkLog entity.
+type WorkLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *WorkLogMutation
+}
+
+// Where appends a list predicates to the WorkLogDelete builder.
+func (wld *WorkLogDelete) Where(ps ...predicate.WorkLog) *WorkLogDelete {
+	wld.mutation.Where(ps...)
+	return wld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wld *WorkLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wld.sqlExec, wld.mutation, wld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wld *WorkLogDelete) ExecX(ctx context.Context) int {
+	n, err := wld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wld *WorkLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(worklog.Table, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt))
+	if ps := wld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wld.mutation.done = true
+	return affected, err
+}
+
+// WorkLogDeleteOne is the builder for deleting a single WorkLog entity.
+type WorkLogDeleteOne struct {
+	wld *WorkLogDelete
+}
+
+// Where appends a list predicates to the WorkLogDelete builder.
+func (wldo *WorkLogDeleteOne) Where(ps ...predicate.WorkLog) *WorkLogDeleteOne {
+	wldo.wld.mutation.Where(ps...)
+	return wldo
+}
+
+// Exec executes the deletion query.
+func (wldo *WorkLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := wldo.wld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{worklog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wldo *WorkLogDeleteOne) ExecX(ctx context.Context) {
+	if err := wldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+)
+
+// WorkLogQuery is the builder for querying WorkLog entities.
+type WorkLogQuery struct {
+	config
+	ctx          *QueryContext
+	order        []worklog.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.WorkLog
+	withTask     *TaskQuery
+	withEmployee *EmployeeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorkLogQuery builder.
+func (wlq *WorkLogQuery) Where(ps ...predicate.WorkLog) *WorkLogQuery {
+	wlq.predicates = append(wlq.predicates, ps...)
+	return wlq
+}
+
+// Limit the number of records to be returned by this query.
+func (wlq *WorkLogQuery) Limit(limit int) *WorkLogQuery {
+	wlq.ctx.Limit = &limit
+	return wlq
+}
+
+// Offset to start from.
+func (wlq *WorkLogQuery) Offset(offset int) *WorkLogQuery {
+	wlq.ctx.Offset = &offset
+	return wlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wlq *WorkLogQuery) Unique(unique bool) *WorkLogQuery {
+	wlq.ctx.Unique = &unique
+	return wlq
+}
+
+// Order specifies how the records should be ordered.
+func (wlq *WorkLogQuery) Order(o ...worklog.OrderOption) *WorkLogQuery {
+	wlq.order = append(wlq.order, o...)
+	return wlq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (wlq *WorkLogQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: wlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(worklog.Table, worklog.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, worklog.TaskTable, worklog.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (wlq *WorkLogQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: wlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(worklog.Table, worklog.FieldID, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, worklog.EmployeeTable, worklog.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WorkLog entity from the query.
+// Returns a *NotFoundError when no WorkLog was found.
+func (wlq *WorkLogQuery) First(ctx context.Context) (*WorkLog, error) {
+	nodes, err := wlq.Limit(1).All(setContextOp(ctx, wlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{worklog.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wlq *WorkLogQuery) FirstX(ctx context.Context) *WorkLog {
+	node, err := wlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WorkLog ID from the query.
+// Returns a *NotFoundError when no WorkLog ID was found.
+func (wlq *WorkLogQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wlq.Limit(1).IDs(setContextOp(ctx, wlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{worklog.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wlq *WorkLogQuery) FirstIDX(ctx context.Context) int {
+	id, err := wlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WorkLog entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WorkLog entity is found.
+// Returns a *NotFoundError when no WorkLog entities are found.
+func (wlq *WorkLogQuery) Only(ctx context.Context) (*WorkLog, error) {
+	nodes, err := wlq.Limit(2).All(setContextOp(ctx, wlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{worklog.Label}
+	default:
+		return nil, &NotSingularError{worklog.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wlq *WorkLogQuery) OnlyX(ctx context.Context) *WorkLog {
+	node, err := wlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WorkLog ID in the query.
+// Returns a *NotSingularError when more than one WorkLog ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wlq *WorkLogQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wlq.Limit(2).IDs(setContextOp(ctx, wlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{worklog.Label}
+	default:
+		err = &NotSingularError{worklog.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wlq *WorkLogQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WorkLogs.
+func (wlq *WorkLogQuery) All(ctx context.Context) ([]*WorkLog, error) {
+	ctx = setContextOp(ctx, wlq.ctx, ent.OpQueryAll)
+	if err := wlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WorkLog, *WorkLogQuery]()
+	return withInterceptors[[]*WorkLog](ctx, wlq, qr, wlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wlq *WorkLogQuery) AllX(ctx context.Context) []*WorkLog {
+	nodes, err := wlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WorkLog IDs.
+func (wlq *WorkLogQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if wlq.ctx.Unique == nil && wlq.path != nil {
+		wlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wlq.ctx, ent.OpQueryIDs)
+	if err = wlq.Select(worklog.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wlq *WorkLogQuery) IDsX(ctx context.Context) []int {
+	ids, err := wlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wlq *WorkLogQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wlq.ctx, ent.OpQueryCount)
+	if err := wlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wlq, querierCount[*WorkLogQuery](), wlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wlq *WorkLogQuery) CountX(ctx context.Context) int {
+	count, err := wlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wlq *WorkLogQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wlq.ctx, ent.OpQueryExist)
+	switch _, err := wlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wlq *WorkLogQuery) ExistX(ctx context.Context) bool {
+	exist, err := wlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorkLogQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wlq *WorkLogQuery) Clone() *WorkLogQuery {
+	if wlq == nil {
+		return nil
+	}
+	return &WorkLogQuery{
+		config:       wlq.config,
+		ctx:          wlq.ctx.Clone(),
+		order:        append([]worklog.OrderOption{}, wlq.order...),
+		inters:       append([]Interceptor{}, wlq.inters...),
+		predicates:   append([]predicate.WorkLog{}, wlq.predicates...),
+		withTask:     wlq.withTask.Clone(),
+		withEmployee: wlq.withEmployee.Clone(),
+		// clone intermediate query.
+		sql:  wlq.sql.Clone(),
+		path: wlq.path,
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (wlq *WorkLogQuery) WithTask(opts ...func(*TaskQuery)) *WorkLogQuery {
+	query := (&TaskClient{config: wlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wlq.withTask = query
+	return wlq
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (wlq *WorkLogQuery) WithEmployee(opts ...func(*EmployeeQuery)) *WorkLogQuery {
+	query := (&EmployeeClient{config: wlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wlq.withEmployee = query
+	return wlq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID int `json:"task_id"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WorkLog.Query().
+//		GroupBy(worklog.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wlq *WorkLogQuery) GroupBy(field string, fields ...string) *WorkLogGroupBy {
+	wlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WorkLogGroupBy{build: wlq}
+	grbuild.flds = &wlq.ctx.Fields
+	grbuild.label = worklog.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID int `json:"task_id"`
+//	}
+//
+//	client.WorkLog.Query().
+//		Select(worklog.FieldTaskID).
+//		Scan(ctx, &v)
+func (wlq *WorkLogQuery) Select(fields ...string) *WorkLogSelect {
+	wlq.ctx.Fields = append(wlq.ctx.Fields, fields...)
+	sbuild := &WorkLogSelect{WorkLogQuery: wlq}
+	sbuild.label = worklog.Label
+	sbuild.flds, sbuild.scan = &wlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WorkLogSelect configured with the given aggregations.
+func (wlq *WorkLogQuery) Aggregate(fns ...AggregateFunc) *WorkLogSelect {
+	return wlq.Select().Aggregate(fns...)
+}
+
+func (wlq *WorkLogQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wlq.ctx.Fields {
+		if !worklog.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wlq.path != nil {
+		prev, err := wlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wlq.sql = prev
+	}
+	return nil
+}
+
+func (wlq *WorkLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WorkLog, error) {
+	var (
+		nodes       = []*WorkLog{}
+		_spec       = wlq.querySpec()
+		loadedTypes = [2]bool{
+			wlq.withTask != nil,
+			wlq.withEmployee != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WorkLog).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WorkLog{config: wlq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wlq.withTask; query != nil {
+		if err := wlq.loadTask(ctx, query, nodes, nil,
+			func(n *WorkLog, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wlq.withEmployee; query != nil {
+		if err := wlq.loadEmployee(ctx, query, nodes, nil,
+			func(n *WorkLog, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wlq *WorkLogQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*WorkLog, init func(*WorkLog), assign func(*WorkLog, *Task)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WorkLog)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wlq *WorkLogQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*WorkLog, init func(*WorkLog), assign func(*WorkLog, *Employee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WorkLog)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wlq *WorkLogQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wlq.querySpec()
+	_spec.Node.Columns = wlq.ctx.Fields
+	if len(wlq.ctx.Fields) > 0 {
+		_spec.Unique = wlq.ctx.Unique != nil && *wlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wlq.driver, _spec)
+}
+
+func (wlq *WorkLogQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(worklog.Table, worklog.Columns, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt))
+	_spec.From = wlq.sql
+	if unique := wlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, worklog.FieldID)
+		for i := range fields {
+			if fields[i] != worklog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if wlq.withTask != nil {
+			_spec.Node.AddColumnOnce(worklog.FieldTaskID)
+		}
+		if wlq.withEmployee != nil {
+			_spec.Node.AddColumnOnce(worklog.FieldEmployeeID)
+		}
+	}
+	if ps := wlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wlq *WorkLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wlq.driver.Dialect())
+	t1 := builder.Table(worklog.Table)
+	columns := wlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = worklog.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wlq.sql != nil {
+		selector = wlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wlq.ctx.Unique != nil && *wlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range wlq.predicates {
+		p(selector)
+	}
+	for _, p := range wlq.order {
+		p(selector)
+	}
+	if offset := wlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WorkLogGroupBy is the group-by builder for WorkLog entities.
+type WorkLogGroupBy struct {
+	selector
+	build *WorkLogQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wlgb *WorkLogGroupBy) Aggregate(fns ...AggregateFunc) *WorkLogGroupBy {
+	wlgb.fns = append(wlgb.fns, fns...)
+	return wlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wlgb *WorkLogGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkLogQuery, *WorkLogGroupBy](ctx, wlgb.build, wlgb, wlgb.build.inters, v)
+}
+
+func (wlgb *WorkLogGroupBy) sqlScan(ctx context.Context, root *WorkLogQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wlgb.fns))
+	for _, fn := range wlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wlgb.flds)+len(wlgb.fns))
+		for _, f := range *wlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WorkLogSelect is the builder for selecting fields of WorkLog entities.
+type WorkLogSelect struct {
+	*WorkLogQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wls *WorkLogSelect) Aggregate(fns ...AggregateFunc) *WorkLogSelect {
+	wls.fns = append(wls.fns, fns...)
+	return wls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wls *WorkLogSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wls.ctx, ent.OpQuerySelect)
+	if err := wls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkLogQuery, *WorkLogSelect](ctx, wls.WorkLogQuery, wls, wls.inters, v)
+}
+
+func (wls *WorkLogSelect) sqlScan(ctx context.Context, root *WorkLogQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wls.fns))
+	for _, fn := range wls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+)
+
+// WorkLogUpdate is the builder for updating WorkLog entities.
+type WorkLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkLogMutation
+}
+
+// Where appends a list predicates to the WorkLogUpdate builder.
+func (wlu *WorkLogUpdate) Where(ps ...predicate.WorkLog) *WorkLogUpdate {
+	wlu.mutation.Where(ps...)
+	return wlu
+}
+
+// SetTaskID sets the "task_id" field.
+func (wlu *WorkLogUpdate) SetTaskID(i int) *WorkLogUpdate {
+	wlu.mutation.SetTaskID(i)
+	return wlu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (wlu *WorkLogUpdate) SetNillableTaskID(i *int) *WorkLogUpdate {
+	if i != nil {
+		wlu.SetTaskID(*i)
+	}
+	return wlu
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (wlu *WorkLogUpdate) SetEmployeeID(i int) *WorkLogUpdate {
+	wlu.mutation.SetEmployeeID(i)
+	return wlu
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (wlu *WorkLogUpdate) SetNillableEmployeeID(i *int) *WorkLogUpdate {
+	if i != nil {
+		wlu.SetEmployeeID(*i)
+	}
+	return wlu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (wlu *WorkLogUpdate) SetStartedAt(t time.Time) *WorkLogUpdate {
+	wlu.mutation.SetStartedAt(t)
+	return wlu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (wlu *WorkLogUpdate) SetNillableStartedAt(t *time.Time) *WorkLogUpdate {
+	if t != nil {
+		wlu.SetStartedAt(*t)
+	}
+	return wlu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (wlu *WorkLogUpdate) SetEndedAt(t time.Time) *WorkLogUpdate {
+	wlu.mutation.SetEndedAt(t)
+	return wlu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (wlu *WorkLogUpdate) SetNillableEndedAt(t *time.Time) *WorkLogUpdate {
+	if t != nil {
+		wlu.SetEndedAt(*t)
+	}
+	return wlu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (wlu *WorkLogUpdate) ClearEndedAt() *WorkLogUpdate {
+	wlu.mutation.ClearEndedAt()
+	return wlu
+}
+
+// SetDuration sets the "duration" field.
+func (wlu *WorkLogUpdate) SetDuration(i int) *WorkLogUpdate {
+	wlu.mutation.ResetDuration()
+	wlu.mutation.SetDuration(i)
+	return wlu
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (wlu *WorkLogUpdate) SetNillableDuration(i *int) *WorkLogUpdate {
+	if i != nil {
+		wlu.SetDuration(*i)
+	}
+	return wlu
+}
+
+// AddDuration adds i to the "duration" field.
+func (wlu *WorkLogUpdate) AddDuration(i int) *WorkLogUpdate {
+	wlu.mutation.AddDuration(i)
+	return wlu
+}
+
+// SetNote sets the "note" field.
+func (wlu *WorkLogUpdate) SetNote(s string) *WorkLogUpdate {
+	wlu.mutation.SetNote(s)
+	return wlu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wlu *WorkLogUpdate) SetNillableNote(s *string) *WorkLogUpdate {
+	if s != nil {
+		wlu.SetNote(*s)
+	}
+	return wlu
+}
+
+// ClearNote clears the value of the "note" field.
+func (wlu *WorkLogUpdate) ClearNote() *WorkLogUpdate {
+	wlu.mutation.ClearNote()
+	return wlu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wlu *WorkLogUpdate) SetUpdatedAt(t time.Time) *WorkLogUpdate {
+	wlu.mutation.SetUpdatedAt(t)
+	return wlu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (wlu *WorkLogUpdate) SetTask(t *Task) *WorkLogUpdate {
+	return wlu.SetTaskID(t.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (wlu *WorkLogUpdate) SetEmployee(e *Employee) *WorkLogUpdate {
+	return wlu.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the WorkLogMutation object of the builder.
+func (wlu *WorkLogUpdate) Mutation() *WorkLogMutation {
+	return wlu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (wlu *WorkLogUpdate) ClearTask() *WorkLogUpdate {
+	wlu.mutation.ClearTask()
+	return wlu
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (wlu *WorkLogUpdate) ClearEmployee() *WorkLogUpdate {
+	wlu.mutation.ClearEmployee()
+	return wlu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wlu *WorkLogUpdate) Save(ctx context.Context) (int, error) {
+	wlu.defaults()
+	return withHooks(ctx, wlu.sqlSave, wlu.mutation, wlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wlu *WorkLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := wlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wlu *WorkLogUpdate) Exec(ctx context.Context) error {
+	_, err := wlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wlu *WorkLogUpdate) ExecX(ctx context.Context) {
+	if err := wlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wlu *WorkLogUpdate) defaults() {
+	if _, ok := wlu.mutation.UpdatedAt(); !ok {
+		v := worklog.UpdateDefaultUpdatedAt()
+		wlu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wlu *WorkLogUpdate) check() error {
+	if v, ok := wlu.mutation.Duration(); ok {
+		if err := worklog.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "WorkLog.duration": %w`, err)}
+		}
+	}
+	if wlu.mutation.TaskCleared() && len(wlu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkLog.task"`)
+	}
+	if wlu.mutation.EmployeeCleared() && len(wlu.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkLog.employee"`)
+	}
+	return nil
+}
+
+func (wlu *WorkLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(worklog.Table, worklog.Columns, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt))
+	if ps := wlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wlu.mutation.StartedAt(); ok {
+		_spec.SetField(worklog.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := wlu.mutation.EndedAt(); ok {
+		_spec.SetField(worklog.FieldEndedAt, field.TypeTime, value)
+	}
+	if wlu.mutation.EndedAtCleared() {
+		_spec.ClearField(worklog.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := wlu.mutation.Duration(); ok {
+		_spec.SetField(worklog.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := wlu.mutation.AddedDuration(); ok {
+		_spec.AddField(worklog.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := wlu.mutation.Note(); ok {
+		_spec.SetField(worklog.FieldNote, field.TypeString, value)
+	}
+	if wlu.mutation.NoteCleared() {
+		_spec.ClearField(worklog.FieldNote, field.TypeString)
+	}
+	if value, ok := wlu.mutation.UpdatedAt(); ok {
+		_spec.SetField(worklog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wlu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wlu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wlu.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.EmployeeTable,
+			Columns: []string{worklog.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wlu.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.EmployeeTable,
+			Columns: []string{worklog.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{worklog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wlu.mutation.done = true
+	return n, nil
+}
+
+// WorkLogUpdateOne is the builder for updating a single WorkLog entity.
+type WorkLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkLogMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (wluo *WorkLogUpdateOne) SetTaskID(i int) *WorkLogUpdateOne {
+	wluo.mutation.SetTaskID(i)
+	return wluo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (wluo *WorkLogUpdateOne) SetNillableTaskID(i *int) *WorkLogUpdateOne {
+	if i != nil {
+		wluo.SetTaskID(*i)
+	}
+	return wluo
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (wluo *WorkLogUpdateOne) SetEmployeeID(i int) *WorkLogUpdateOne {
+	wluo.mutation.SetEmployeeID(i)
+	return wluo
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (wluo *WorkLogUpdateOne) SetNillableEmployeeID(i *int) *WorkLogUpdateOne {
+	if i != nil {
+		wluo.SetEmployeeID(*i)
+	}
+	return wluo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (wluo *WorkLogUpdateOne) SetStartedAt(t time.Time) *WorkLogUpdateOne {
+	wluo.mutation.SetStartedAt(t)
+	return wluo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (wluo *WorkLogUpdateOne) SetNillableStartedAt(t *time.Time) *WorkLogUpdateOne {
+	if t != nil {
+		wluo.SetStartedAt(*t)
+	}
+	return wluo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (wluo *WorkLogUpdateOne) SetEndedAt(t time.Time) *WorkLogUpdateOne {
+	wluo.mutation.SetEndedAt(t)
+	return wluo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (wluo *WorkLogUpdateOne) SetNillableEndedAt(t *time.Time) *WorkLogUpdateOne {
+	if t != nil {
+		wluo.SetEndedAt(*t)
+	}
+	return wluo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (wluo *WorkLogUpdateOne) ClearEndedAt() *WorkLogUpdateOne {
+	wluo.mutation.ClearEndedAt()
+	return wluo
+}
+
+// SetDuration sets the "duration" field.
+func (wluo *WorkLogUpdateOne) SetDuration(i int) *WorkLogUpdateOne {
+	wluo.mutation.ResetDuration()
+	wluo.mutation.SetDuration(i)
+	return wluo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (wluo *WorkLogUpdateOne) SetNillableDuration(i *int) *WorkLogUpdateOne {
+	if i != nil {
+		wluo.SetDuration(*i)
+	}
+	return wluo
+}
+
+// AddDuration adds i to the "duration" field.
+func (wluo *WorkLogUpdateOne) AddDuration(i int) *WorkLogUpdateOne {
+	wluo.mutation.AddDuration(i)
+	return wluo
+}
+
+// SetNote sets the "note" field.
+func (wluo *WorkLogUpdateOne) SetNote(s string) *WorkLogUpdateOne {
+	wluo.mutation.SetNote(s)
+	return wluo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wluo *WorkLogUpdateOne) SetNillableNote(s *string) *WorkLogUpdateOne {
+	if s != nil {
+		wluo.SetNote(*s)
+	}
+	return wluo
+}
+
+// ClearNote clears the value of the "note" field.
+func (wluo *WorkLogUpdateOne) ClearNote() *WorkLogUpdateOne {
+	wluo.mutation.ClearNote()
+	return wluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wluo *WorkLogUpdateOne) SetUpdatedAt(t time.Time) *WorkLogUpdateOne {
+	wluo.mutation.SetUpdatedAt(t)
+	return wluo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (wluo *WorkLogUpdateOne) SetTask(t *Task) *WorkLogUpdateOne {
+	return wluo.SetTaskID(t.ID)
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (wluo *WorkLogUpdateOne) SetEmployee(e *Employee) *WorkLogUpdateOne {
+	return wluo.SetEmployeeID(e.ID)
+}
+
+// Mutation returns the WorkLogMutation object of the builder.
+func (wluo *WorkLogUpdateOne) Mutation() *WorkLogMutation {
+	return wluo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (wluo *WorkLogUpdateOne) ClearTask() *WorkLogUpdateOne {
+	wluo.mutation.ClearTask()
+	return wluo
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (wluo *WorkLogUpdateOne) ClearEmployee() *WorkLogUpdateOne {
+	wluo.mutation.ClearEmployee()
+	return wluo
+}
+
+// Where appends a list predicates to the WorkLogUpdate builder.
+func (wluo *WorkLogUpdateOne) Where(ps ...predicate.WorkLog) *WorkLogUpdateOne {
+	wluo.mutation.Where(ps...)
+	return wluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wluo *WorkLogUpdateOne) Select(field string, fields ...string) *WorkLogUpdateOne {
+	wluo.fields = append([]string{field}, fields...)
+	return wluo
+}
+
+// Save executes the query and returns the updated WorkLog entity.
+func (wluo *WorkLogUpdateOne) Save(ctx context.Context) (*WorkLog, error) {
+	wluo.defaults()
+	return withHooks(ctx, wluo.sqlSave, wluo.mutation, wluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wluo *WorkLogUpdateOne) SaveX(ctx context.Context) *WorkLog {
+	node, err := wluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wluo *WorkLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := wluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wluo *WorkLogUpdateOne) ExecX(ctx context.Context) {
+	if err := wluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wluo *WorkLogUpdateOne) defaults() {
+	if _, ok := wluo.mutation.UpdatedAt(); !ok {
+		v := worklog.UpdateDefaultUpdatedAt()
+		wluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wluo *WorkLogUpdateOne) check() error {
+	if v, ok := wluo.mutation.Duration(); ok {
+		if err := worklog.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "WorkLog.duration": %w`, err)}
+		}
+	}
+	if wluo.mutation.TaskCleared() && len(wluo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkLog.task"`)
+	}
+	if wluo.mutation.EmployeeCleared() && len(wluo.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkLog.employee"`)
+	}
+	return nil
+}
+
+func (wluo *WorkLogUpdateOne) sqlSave(ctx context.Context) (_node *WorkLog, err error) {
+	if err := wluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(worklog.Table, worklog.Columns, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt))
+	id, ok := wluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, worklog.FieldID)
+		for _, f := range fields {
+			if !worklog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != worklog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wluo.mutation.StartedAt(); ok {
+		_spec.SetField(worklog.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := wluo.mutation.EndedAt(); ok {
+		_spec.SetField(worklog.FieldEndedAt, field.TypeTime, value)
+	}
+	if wluo.mutation.EndedAtCleared() {
+		_spec.ClearField(worklog.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := wluo.mutation.Duration(); ok {
+		_spec.SetField(worklog.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := wluo.mutation.AddedDuration(); ok {
+		_spec.AddField(worklog.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := wluo.mutation.Note(); ok {
+		_spec.SetField(worklog.FieldNote, field.TypeString, value)
+	}
+	if wluo.mutation.NoteCleared() {
+		_spec.ClearField(worklog.FieldNote, field.TypeString)
+	}
+	if value, ok := wluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(worklog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wluo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wluo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wluo.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.EmployeeTable,
+			Columns: []string{worklog.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wluo.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.EmployeeTable,
+			Columns: []string{worklog.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WorkLog{config: wluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{worklog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wluo.mutation.done = true
+	return _node, nil
+}
@@ -29,6 +29,8 @@ const (
 const (
 	TaskReportCreate = "task_report:create"
 	TaskReportUpdate = "task_report:update"
+	TaskReportRead   = "task_report:read"
+	TaskReportReview = "task_report:review"
 )
 
 // Task permissions
@@ -71,6 +73,98 @@ const (
 	LabelDelete = "label:delete"
 )
 
+// Appointment History permissions
+const (
+	AppointmentHistoryRead   = "appointment_history:read"
+	AppointmentHistoryUpdate = "appointment_history:update"
+	AppointmentHistoryDelete = "appointment_history:delete"
+)
+
+// Salary Grade permissions
+const (
+	SalaryGradeCreate = "salary_grade:create"
+	SalaryGradeRead   = "salary_grade:read"
+	SalaryGradeUpdate = "salary_grade:update"
+	SalaryGradeDelete = "salary_grade:delete"
+)
+
+// Compensation permissions
+const (
+	CompensationRead    = "compensation:read"
+	CompensationCreate  = "compensation:create"
+	CompensationApprove = "compensation:approve"
+	CompensationExport  = "compensation:export"
+)
+
+// Attendance permissions
+const (
+	AttendanceClockEmployee = "attendance:clock:employee"
+	AttendanceReadEmployee  = "attendance:read:employee"
+	AttendanceReadAdmin     = "attendance:read:admin"
+	AttendanceExportAdmin   = "attendance:export:admin"
+)
+
+// Attendance Correction permissions
+const (
+	AttendanceCorrectionCreateEmployee = "attendance_correction:create:employee"
+	AttendanceCorrectionReadEmployee   = "attendance_correction:read:employee"
+	AttendanceCorrectionReadAdmin      = "attendance_correction:read:admin"
+	AttendanceCorrectionApproveAdmin   = "attendance_correction:approve:admin"
+)
+
+// Shift permissions
+const (
+	ShiftCreate = "shift:create"
+	ShiftRead   = "shift:read"
+	ShiftUpdate = "shift:update"
+	ShiftDelete = "shift:delete"
+)
+
+// Roster permissions
+const (
+	RosterReadEmployee = "roster:read:employee"
+	RosterReadAdmin    = "roster:read:admin"
+	RosterManageAdmin  = "roster:manage:admin"
+)
+
+// Shift Swap permissions
+const (
+	ShiftSwapCreateEmployee = "shift_swap:create:employee"
+	ShiftSwapReadEmployee   = "shift_swap:read:employee"
+	ShiftSwapReadAdmin      = "shift_swap:read:admin"
+	ShiftSwapApproveAdmin   = "shift_swap:approve:admin"
+)
+
+// Overtime permissions
+const (
+	OvertimeCreateEmployee = "overtime:create:employee"
+	OvertimeReadEmployee   = "overtime:read:employee"
+	OvertimeReadAdmin      = "overtime:read:admin"
+	OvertimeApproveAdmin   = "overtime:approve:admin"
+	OvertimeExportAdmin    = "overtime:export:admin"
+)
+
+// Work Calendar permissions
+const (
+	WorkCalendarRead   = "work_calendar:read"
+	WorkCalendarUpdate = "work_calendar:update"
+)
+
+// Attachment permissions
+const (
+	AttachmentCreate         = "attachment:create"
+	AttachmentRead           = "attachment:read"
+	AttachmentDelete         = "attachment:delete"
+	AttachmentSettingsRead   = "attachment:settings:read"
+	AttachmentSettingsUpdate = "attachment:settings:update"
+)
+
+// Notification permissions
+const (
+	NotificationReadEmployee   = "notification:read:employee"
+	NotificationUpdateEmployee = "notification:update:employee"
+)
+
 // Permission groups for easier management
 var (
 	// Employee permission group
@@ -102,6 +196,8 @@ var (
 	TaskReportPermissions = []string{
 		TaskReportCreate,
 		TaskReportUpdate,
+		TaskReportRead,
+		TaskReportReview,
 	}
 
 	// Task permission group
@@ -144,6 +240,98 @@ var (
 		LabelDelete,
 	}
 
+	// Appointment History permission group
+	AppointmentHistoryPermissions = []string{
+		AppointmentHistoryRead,
+		AppointmentHistoryUpdate,
+		AppointmentHistoryDelete,
+	}
+
+	// Salary Grade permission group
+	SalaryGradePermissions = []string{
+		SalaryGradeCreate,
+		SalaryGradeRead,
+		SalaryGradeUpdate,
+		SalaryGradeDelete,
+	}
+
+	// Compensation permission group
+	CompensationPermissions = []string{
+		CompensationRead,
+		CompensationCreate,
+		CompensationApprove,
+		CompensationExport,
+	}
+
+	// Attendance permission group
+	AttendancePermissions = []string{
+		AttendanceClockEmployee,
+		AttendanceReadEmployee,
+		AttendanceReadAdmin,
+		AttendanceExportAdmin,
+	}
+
+	// Attendance Correction permission group
+	AttendanceCorrectionPermissions = []string{
+		AttendanceCorrectionCreateEmployee,
+		AttendanceCorrectionReadEmployee,
+		AttendanceCorrectionReadAdmin,
+		AttendanceCorrectionApproveAdmin,
+	}
+
+	// Shift permission group
+	ShiftPermissions = []string{
+		ShiftCreate,
+		ShiftRead,
+		ShiftUpdate,
+		ShiftDelete,
+	}
+
+	// Roster permission group
+	RosterPermissions = []string{
+		RosterReadEmployee,
+		RosterReadAdmin,
+		RosterManageAdmin,
+	}
+
+	// Shift Swap permission group
+	ShiftSwapPermissions = []string{
+		ShiftSwapCreateEmployee,
+		ShiftSwapReadEmployee,
+		ShiftSwapReadAdmin,
+		ShiftSwapApproveAdmin,
+	}
+
+	// Overtime permission group
+	OvertimePermissions = []string{
+		OvertimeCreateEmployee,
+		OvertimeReadEmployee,
+		OvertimeReadAdmin,
+		OvertimeApproveAdmin,
+		OvertimeExportAdmin,
+	}
+
+	// Work Calendar permission group
+	WorkCalendarPermissions = []string{
+		WorkCalendarRead,
+		WorkCalendarUpdate,
+	}
+
+	// Attachment permission group
+	AttachmentPermissions = []string{
+		AttachmentCreate,
+		AttachmentRead,
+		AttachmentDelete,
+		AttachmentSettingsRead,
+		AttachmentSettingsUpdate,
+	}
+
+	// Notification permission group
+	NotificationPermissions = []string{
+		NotificationReadEmployee,
+		NotificationUpdateEmployee,
+	}
+
 	// All permissions
 	AllPermissions = []string{
 		// Employee
@@ -165,6 +353,8 @@ var (
 		// Task Report
 		TaskReportCreate,
 		TaskReportUpdate,
+		TaskReportRead,
+		TaskReportReview,
 		// Task
 		TaskCreate,
 		TaskRead,
@@ -190,6 +380,62 @@ var (
 		LabelRead,
 		LabelUpdate,
 		LabelDelete,
+		// Appointment History
+		AppointmentHistoryRead,
+		AppointmentHistoryUpdate,
+		AppointmentHistoryDelete,
+		// Salary Grade
+		SalaryGradeCreate,
+		SalaryGradeRead,
+		SalaryGradeUpdate,
+		SalaryGradeDelete,
+		// Compensation
+		CompensationRead,
+		CompensationCreate,
+		CompensationApprove,
+		CompensationExport,
+		// Attendance
+		AttendanceClockEmployee,
+		AttendanceReadEmployee,
+		AttendanceReadAdmin,
+		AttendanceExportAdmin,
+		// Attendance Correction
+		AttendanceCorrectionCreateEmployee,
+		AttendanceCorrectionReadEmployee,
+		AttendanceCorrectionReadAdmin,
+		AttendanceCorrectionApproveAdmin,
+		// Shift
+		ShiftCreate,
+		ShiftRead,
+		ShiftUpdate,
+		ShiftDelete,
+		// Roster
+		RosterReadEmployee,
+		RosterReadAdmin,
+		RosterManageAdmin,
+		// Shift Swap
+		ShiftSwapCreateEmployee,
+		ShiftSwapReadEmployee,
+		ShiftSwapReadAdmin,
+		ShiftSwapApproveAdmin,
+		// Overtime
+		OvertimeCreateEmployee,
+		OvertimeReadEmployee,
+		OvertimeReadAdmin,
+		OvertimeApproveAdmin,
+		OvertimeExportAdmin,
+		// Work Calendar
+		WorkCalendarRead,
+		WorkCalendarUpdate,
+		// Attachment
+		AttachmentCreate,
+		AttachmentRead,
+		AttachmentDelete,
+		AttachmentSettingsRead,
+		AttachmentSettingsUpdate,
+		// Notification
+		NotificationReadEmployee,
+		NotificationUpdateEmployee,
 	}
 )
 
@@ -0,0 +1,56 @@
+package dtos
+
+import "time"
+
+// AppointmentHistoryListQuery represents query parameters for listing appointment histories
+type AppointmentHistoryListQuery struct {
+	EmployeeID   int    `form:"employee_id" validate:"omitempty,min=1"`
+	PositionID   int    `form:"position_id" validate:"omitempty,min=1"`
+	DepartmentID int    `form:"department_id" validate:"omitempty,min=1"`
+	ActiveOn     string `form:"active_on" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	OrderBy      string `form:"order_by" validate:"omitempty,oneof=id joining_at effective_to created_at updated_at"`
+	OrderDir     string `form:"order_dir" validate:"omitempty,oneof=asc desc"`
+	Page         int    `form:"page" validate:"omitempty,min=1"`
+	Limit        int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	OrgID        int    // From JWT token
+}
+
+// AppointmentHistoryUpdateInput represents the input for correcting an appointment history entry
+type AppointmentHistoryUpdateInput struct {
+	PositionID     *int     `json:"position_id" validate:"omitempty,min=1"`
+	JoiningAt      *string  `json:"joining_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EffectiveTo    *string  `json:"effective_to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Description    *string  `json:"description" validate:"omitempty,max=1000"`
+	AttachmentUrls []string `json:"attachment_urls" validate:"omitempty,dive,url"`
+}
+
+// AppointmentHistoryListResponse represents the response for appointment history list with pagination
+type AppointmentHistoryListResponse struct {
+	Data       interface{}      `json:"data"`
+	Pagination OffsetPagination `json:"pagination"`
+}
+
+// EmployeeContractCreateInput represents the input for recording an employee contract
+type EmployeeContractCreateInput struct {
+	Code    string  `json:"code" binding:"required" validate:"required,min=1,max=50"`
+	Type    string  `json:"type" binding:"required" validate:"required,oneof=fixed_term probation indefinite seasonal"`
+	StartAt string  `json:"start_at" binding:"required" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndAt   *string `json:"end_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Note    *string `json:"note" validate:"omitempty,max=1000"`
+}
+
+// Career timeline entry types
+const (
+	TimelineTypeAppointment = "appointment"
+	TimelineTypeContract    = "contract"
+	TimelineTypeStatus      = "status"
+)
+
+// CareerTimelineEntry represents a single event in an employee's career timeline
+type CareerTimelineEntry struct {
+	Type    string      `json:"type"`
+	Date    time.Time   `json:"date"`
+	EndDate *time.Time  `json:"end_date,omitempty"`
+	Title   string      `json:"title"`
+	Detail  interface{} `json:"detail"`
+}
@@ -0,0 +1,35 @@
+package dtos
+
+import "time"
+
+// AttachmentUploadInput represents the form fields sent with an uploaded file
+type AttachmentUploadInput struct {
+	OwnerType string `form:"owner_type" binding:"required" validate:"required,oneof=task task_report leave_request appointment_history"`
+	OwnerID   int    `form:"owner_id" binding:"required" validate:"required,min=1"`
+}
+
+// AttachmentListQuery represents query parameters for listing the attachments of a record
+type AttachmentListQuery struct {
+	OwnerType string `form:"owner_type" binding:"required" validate:"required,oneof=task task_report leave_request appointment_history"`
+	OwnerID   int    `form:"owner_id" binding:"required" validate:"required,min=1"`
+}
+
+// AttachmentResponse represents an attachment with a signed download URL
+type AttachmentResponse struct {
+	Attachment  interface{} `json:"attachment"`
+	DownloadURL string      `json:"download_url"`
+	ExpiresAt   time.Time   `json:"expires_at"`
+}
+
+// AttachmentSettingsInput represents the input for changing an organization's attachment limits
+type AttachmentSettingsInput struct {
+	MaxFileSize *int64 `json:"max_file_size" validate:"omitempty,min=1"`
+	Quota       *int64 `json:"quota" validate:"omitempty,min=1"`
+}
+
+// AttachmentSettingsResponse represents an organization's attachment limits and usage, in bytes
+type AttachmentSettingsResponse struct {
+	MaxFileSize int64 `json:"max_file_size"`
+	Quota       int64 `json:"quota"`
+	Used        int64 `json:"used"`
+}
@@ -0,0 +1,77 @@
+package dtos
+
+import "time"
+
+// AttendanceClockInput represents the input for clocking in or out
+type AttendanceClockInput struct {
+	Source    string   `json:"source" validate:"omitempty,oneof=web mobile kiosk"`
+	Latitude  *float64 `json:"latitude" validate:"omitempty,latitude"`
+	Longitude *float64 `json:"longitude" validate:"omitempty,longitude"`
+	Note      string   `json:"note" validate:"omitempty,max=500"`
+}
+
+// AttendanceListQuery represents query parameters for listing attendance records
+type AttendanceListQuery struct {
+	EmployeeID int    `form:"employee_id" validate:"omitempty,min=1"`
+	From       string `form:"from" validate:"omitempty,datetime=2006-01-02"`
+	To         string `form:"to" validate:"omitempty,datetime=2006-01-02"`
+	Page       int    `form:"page" validate:"omitempty,min=1"`
+	Limit      int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	OrgID      int    // From JWT token
+}
+
+// AttendanceListResponse represents the paginated response for attendance records
+type AttendanceListResponse struct {
+	Data       interface{}      `json:"data"`
+	Pagination OffsetPagination `json:"pagination"`
+}
+
+// AttendanceSummaryQuery represents query parameters for daily attendance summaries
+type AttendanceSummaryQuery struct {
+	EmployeeID int    `form:"employee_id" validate:"omitempty,min=1"`
+	From       string `form:"from" binding:"required" validate:"required,datetime=2006-01-02"`
+	To         string `form:"to" binding:"required" validate:"required,datetime=2006-01-02"`
+}
+
+// Daily attendance statuses
+const (
+	AttendanceStatusPresent = "present"
+	AttendanceStatusAbsent  = "absent"
+	AttendanceStatusExcused = "excused"
+	AttendanceStatusDayOff  = "day_off"
+)
+
+// AttendanceDailySummary represents the computed attendance of an employee for one day
+type AttendanceDailySummary struct {
+	EmployeeID        int        `json:"employee_id"`
+	Date              string     `json:"date"`
+	Status            string     `json:"status"`
+	CheckInAt         *time.Time `json:"check_in_at,omitempty"`
+	CheckOutAt        *time.Time `json:"check_out_at,omitempty"`
+	LateMinutes       int        `json:"late_minutes"`
+	EarlyLeaveMinutes int        `json:"early_leave_minutes"`
+	WorkedHours       float64    `json:"worked_hours"`
+	LeaveRequestID    *int       `json:"leave_request_id,omitempty"`
+}
+
+// AttendanceCorrectionCreateInput represents a request to fix the attendance of a work day
+type AttendanceCorrectionCreateInput struct {
+	WorkDate   string  `json:"work_date" binding:"required" validate:"required,datetime=2006-01-02"`
+	CheckInAt  *string `json:"check_in_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CheckOutAt *string `json:"check_out_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Reason     string  `json:"reason" binding:"required" validate:"required,min=1,max=1000"`
+}
+
+// AttendanceCorrectionListQuery represents query parameters for listing correction requests
+type AttendanceCorrectionListQuery struct {
+	EmployeeID int    `form:"employee_id" validate:"omitempty,min=1"`
+	Status     string `form:"status" validate:"omitempty,oneof=pending approved rejected"`
+	Page       int    `form:"page" validate:"omitempty,min=1"`
+	Limit      int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	OrgID      int    // From JWT token
+}
+
+// AttendanceCorrectionReviewInput represents the input for approving or rejecting a correction
+type AttendanceCorrectionReviewInput struct {
+	Comment string `json:"comment" validate:"omitempty,max=1000"`
+}
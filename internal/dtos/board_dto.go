@@ -0,0 +1,79 @@
+package dtos
+
+// BoardQuery represents query parameters for a project's board. Swimlanes
+// split the columns by assignee or by label.
+type BoardQuery struct {
+	Swimlane string `form:"swimlane" validate:"omitempty,oneof=assignee label"`
+}
+
+// BoardMoveInput represents the input for moving a task on a board. The task
+// is placed between PrevID and NextID in the column of Status; without them
+// it goes to the end of the column.
+type BoardMoveInput struct {
+	// Status is a status key of the project workflow; empty keeps the current one
+	Status string `json:"status" validate:"omitempty,max=50"`
+	PrevID *int   `json:"prev_id" validate:"omitempty,min=1"`
+	NextID *int   `json:"next_id" validate:"omitempty,min=1"`
+}
+
+// BoardWIPLimitsInput represents the input for setting the WIP limits of a
+// board's columns, keyed by status. A null limit removes it.
+type BoardWIPLimitsInput struct {
+	Limits map[string]*int `json:"limits" binding:"required" validate:"required,min=1,dive,omitempty,min=1"`
+}
+
+// BoardResponse represents a project's tasks grouped by workflow status
+type BoardResponse struct {
+	ProjectID int           `json:"project_id"`
+	Swimlane  string        `json:"swimlane,omitempty"`
+	Columns   []BoardColumn `json:"columns"`
+	Lanes     []BoardLane   `json:"lanes,omitempty"`
+}
+
+// BoardColumn represents a workflow status and its tasks in rank order
+type BoardColumn struct {
+	Key       string      `json:"key"`
+	Name      string      `json:"name"`
+	Category  string      `json:"category"`
+	WIPLimit  *int        `json:"wip_limit"`
+	Count     int         `json:"count"`
+	OverLimit bool        `json:"over_limit"`
+	Tasks     []BoardTask `json:"tasks"`
+}
+
+// BoardTask represents a task card on a board
+type BoardTask struct {
+	ID             int          `json:"id"`
+	Code           string       `json:"code"`
+	Name           string       `json:"name"`
+	Type           string       `json:"type"`
+	Status         string       `json:"status"`
+	WorkflowStatus string       `json:"workflow_status"`
+	Rank           string       `json:"rank"`
+	Process        int          `json:"process"`
+	DueDate        string       `json:"due_date,omitempty"`
+	ParentID       int          `json:"parent_id,omitempty"`
+	AssigneeIDs    []int        `json:"assignee_ids"`
+	Labels         []BoardLabel `json:"labels"`
+}
+
+// BoardLabel represents a label shown on a task card
+type BoardLabel struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// BoardLane represents a swimlane. ID 0 holds the tasks without an assignee
+// or label; a task with several assignees or labels shows in each lane.
+type BoardLane struct {
+	ID      int               `json:"id"`
+	Name    string            `json:"name"`
+	Columns []BoardLaneColumn `json:"columns"`
+}
+
+// BoardLaneColumn lists the tasks of a column within a swimlane, in rank order
+type BoardLaneColumn struct {
+	Key     string `json:"key"`
+	TaskIDs []int  `json:"task_ids"`
+}
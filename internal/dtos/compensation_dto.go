@@ -0,0 +1,53 @@
+package dtos
+
+// SalaryGradeCreateInput represents the input for creating a salary grade (pay band).
+// Amounts are in minor units of the currency, for example cents or đồng.
+type SalaryGradeCreateInput struct {
+	Code       string `json:"code" binding:"required" validate:"required,min=1,max=50"`
+	Name       string `json:"name" binding:"required" validate:"required,min=1,max=200"`
+	MinAmount  int64  `json:"min_amount" validate:"min=0"`
+	MaxAmount  int64  `json:"max_amount" validate:"min=0,gtefield=MinAmount"`
+	Currency   string `json:"currency" validate:"omitempty,len=3"`
+	PositionID *int   `json:"position_id" validate:"omitempty,min=1"`
+}
+
+// SalaryGradeUpdateInput represents the input for updating a salary grade
+type SalaryGradeUpdateInput struct {
+	Name       *string `json:"name" validate:"omitempty,min=1,max=200"`
+	MinAmount  *int64  `json:"min_amount" validate:"omitempty,min=0"`
+	MaxAmount  *int64  `json:"max_amount" validate:"omitempty,min=0"`
+	Currency   *string `json:"currency" validate:"omitempty,len=3"`
+	PositionID *int    `json:"position_id" validate:"omitempty,min=0"`
+}
+
+// CompensationCreateInput represents a salary change request for an employee.
+// Amounts are in minor units of the currency, for example cents or đồng.
+type CompensationCreateInput struct {
+	EmployeeID    int              `json:"employee_id" binding:"required" validate:"required,min=1"`
+	SalaryGradeID *int             `json:"salary_grade_id" validate:"omitempty,min=1"`
+	BasePay       int64            `json:"base_pay" binding:"required" validate:"required,gt=0"`
+	Allowances    map[string]int64 `json:"allowances" validate:"omitempty,dive,keys,min=1,max=100,endkeys,min=0"`
+	Currency      string           `json:"currency" validate:"omitempty,len=3"`
+	EffectiveFrom string           `json:"effective_from" binding:"required" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Reason        string           `json:"reason" validate:"omitempty,max=1000"`
+}
+
+// CompensationReviewInput represents the input for approving or rejecting a salary change
+type CompensationReviewInput struct {
+	Comment string `json:"comment" validate:"omitempty,max=1000"`
+}
+
+// CompensationListQuery represents query parameters for listing compensation records
+type CompensationListQuery struct {
+	EmployeeID int    `form:"employee_id" validate:"omitempty,min=1"`
+	Status     string `form:"status" validate:"omitempty,oneof=pending approved rejected"`
+	Page       int    `form:"page" validate:"omitempty,min=1"`
+	Limit      int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	OrgID      int    // From JWT token
+}
+
+// CompensationListResponse represents the paginated response for compensation records
+type CompensationListResponse struct {
+	Data       interface{}      `json:"data"`
+	Pagination OffsetPagination `json:"pagination"`
+}
@@ -0,0 +1,39 @@
+package dtos
+
+// MilestoneCreateInput represents the input for adding a milestone to a project
+type MilestoneCreateInput struct {
+	Name    string  `json:"name" binding:"required" validate:"required,min=1,max=200"`
+	Goal    string  `json:"goal" validate:"omitempty,max=1000"`
+	StartAt *string `json:"start_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EndAt   *string `json:"end_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// MilestoneUpdateInput represents the input for updating a milestone
+type MilestoneUpdateInput struct {
+	Name    *string `json:"name" validate:"omitempty,min=1,max=200"`
+	Goal    *string `json:"goal" validate:"omitempty,max=1000"`
+	StartAt *string `json:"start_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EndAt   *string `json:"end_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	State   *string `json:"state" validate:"omitempty,oneof=planned active closed"`
+}
+
+// MilestoneListQuery represents query parameters for listing a project's milestones
+type MilestoneListQuery struct {
+	State string `form:"state" validate:"omitempty,oneof=planned active closed"`
+}
+
+// MilestoneResponse represents a milestone and the progress of its tasks
+type MilestoneResponse struct {
+	ID             int    `json:"id"`
+	ProjectID      int    `json:"project_id"`
+	Name           string `json:"name"`
+	Goal           string `json:"goal"`
+	StartAt        string `json:"start_at,omitempty"`
+	EndAt          string `json:"end_at,omitempty"`
+	State          string `json:"state"`
+	CreatorID      int    `json:"creator_id"`
+	TotalTasks     int    `json:"total_tasks"`
+	CompletedTasks int    `json:"completed_tasks"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
@@ -0,0 +1,25 @@
+package dtos
+
+// NotificationListQuery represents query parameters for listing the caller's
+// notifications, newest first
+type NotificationListQuery struct {
+	Unread bool   `form:"unread"`
+	Type   string `form:"type" validate:"omitempty,max=30"`
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// NotificationListResponse represents a page of notifications with the number
+// of unread ones
+type NotificationListResponse struct {
+	Data        interface{}      `json:"data"`
+	UnreadCount int              `json:"unread_count"`
+	Pagination  CursorPagination `json:"pagination"`
+}
+
+// NotificationPreferencesInput turns notification types on or off, e.g.
+//
+//	{"types": {"task_status_changed": false, "task_due_soon": true}}
+type NotificationPreferencesInput struct {
+	Types map[string]bool `json:"types" binding:"required" validate:"required,min=1"`
+}
@@ -0,0 +1,42 @@
+package dtos
+
+// OvertimeCreateInput represents the input for requesting overtime
+type OvertimeCreateInput struct {
+	WorkDate  string  `json:"work_date" binding:"required" validate:"required,datetime=2006-01-02"`
+	Hours     float64 `json:"hours" binding:"required" validate:"required,gt=0,lte=12"`
+	Reason    string  `json:"reason" binding:"required" validate:"required,min=1,max=1000"`
+	ProjectID *int    `json:"project_id" validate:"omitempty,min=1"`
+	TaskID    *int    `json:"task_id" validate:"omitempty,min=1"`
+}
+
+// OvertimeListQuery represents query parameters for listing overtime requests
+type OvertimeListQuery struct {
+	EmployeeID int    `form:"employee_id" validate:"omitempty,min=1"`
+	Status     string `form:"status" validate:"omitempty,oneof=pending approved rejected cancelled"`
+	Month      string `form:"month" validate:"omitempty,datetime=2006-01"`
+	Page       int    `form:"page" validate:"omitempty,min=1"`
+	Limit      int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	OrgID      int    // From JWT token
+}
+
+// OvertimeListResponse represents the paginated response for overtime requests
+type OvertimeListResponse struct {
+	Data       interface{}      `json:"data"`
+	Pagination OffsetPagination `json:"pagination"`
+}
+
+// OvertimeReviewInput represents the input for approving or rejecting an overtime request
+type OvertimeReviewInput struct {
+	Comment string `json:"comment" validate:"omitempty,max=1000"`
+}
+
+// HolidayCreateInput represents the input for adding an organization holiday
+type HolidayCreateInput struct {
+	Date string `json:"date" binding:"required" validate:"required,datetime=2006-01-02"`
+	Name string `json:"name" binding:"required" validate:"required,min=1,max=200"`
+}
+
+// WeekendUpdateInput represents the weekdays (0 = Sunday ... 6 = Saturday) an organization treats as weekend
+type WeekendUpdateInput struct {
+	WeekendDays []int `json:"weekend_days" validate:"max=7,unique,dive,min=0,max=6"`
+}
@@ -16,10 +16,19 @@ func projectCodeValidation(fl validator.FieldLevel) bool {
 	return matched
 }
 
+// projectKeyPattern is the format of project keys prefixing task codes, e.g. HRM
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+// projectKeyValidation validates that a project key follows the expected format
+func projectKeyValidation(fl validator.FieldLevel) bool {
+	return projectKeyPattern.MatchString(fl.Field().String())
+}
+
 // ProjectCreateInput represents the input for creating a project
 type ProjectCreateInput struct {
 	Name        string  `json:"name" binding:"required" validate:"required,min=1,max=200"`
 	Code        *string `json:"code" validate:"omitempty,project_code"`
+	Key         *string `json:"key" validate:"omitempty,project_key"`
 	Description *string `json:"description" validate:"omitempty,max=1000"`
 	StartAt     *string `json:"start_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 	EndAt       *string `json:"end_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
@@ -30,15 +39,18 @@ type ProjectCreateInput struct {
 type ProjectUpdateInput struct {
 	Name        *string `json:"name" validate:"omitempty,min=1,max=200"`
 	Code        *string `json:"code" validate:"omitempty,project_code"`
+	Key         *string `json:"key" validate:"omitempty,project_key"`
 	Description *string `json:"description" validate:"omitempty,max=1000"`
 	StartAt     *string `json:"start_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 	EndAt       *string `json:"end_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 	CreatorID   *int    `json:"creator_id" validate:"omitempty,min=1"`
 	UpdaterID   *int    `json:"updater_id" validate:"omitempty,min=1"`
 	OrgID       *int    `json:"org_id" validate:"omitempty,min=1"`
-	Process     *int    `json:"process" validate:"omitempty,min=0,max=100"`
-	Status      *string `json:"status" validate:"omitempty,oneof=not_started in_progress completed"`
-	MemberIDs   []int   `json:"member_ids" validate:"omitempty,dive,min=1"`
+	// Process and status can only be set while manual_progress is on
+	ManualProgress *bool   `json:"manual_progress"`
+	Process        *int    `json:"process" validate:"omitempty,min=0,max=100"`
+	Status         *string `json:"status" validate:"omitempty,oneof=not_started in_progress completed"`
+	MemberIDs      []int   `json:"member_ids" validate:"omitempty,dive,min=1"`
 }
 
 // ProjectListQuery represents query parameters for listing projects
@@ -74,21 +86,23 @@ type ProjectBulkDeleteResponse struct {
 
 // ProjectResponse represents a project with additional computed fields
 type ProjectResponse struct {
-	ID          int         `json:"id"`
-	Name        string      `json:"name"`
-	Code        string      `json:"code"`
-	Description *string     `json:"description"`
-	StartAt     *time.Time  `json:"start_at"`
-	EndAt       *time.Time  `json:"end_at"`
-	CreatorID   int         `json:"creator_id"`
-	UpdaterID   int         `json:"updater_id"`
-	OrgID       int         `json:"org_id"`
-	Process     int         `json:"process"`
-	Status      string      `json:"status"`
-	CreatedAt   string      `json:"created_at"`
-	UpdatedAt   string      `json:"updated_at"`
-	TaskCount   int         `json:"task_count"`
-	Edges       interface{} `json:"edges,omitempty"`
+	ID             int         `json:"id"`
+	Name           string      `json:"name"`
+	Code           string      `json:"code"`
+	Key            *string     `json:"key"`
+	Description    *string     `json:"description"`
+	StartAt        *time.Time  `json:"start_at"`
+	EndAt          *time.Time  `json:"end_at"`
+	CreatorID      int         `json:"creator_id"`
+	UpdaterID      int         `json:"updater_id"`
+	OrgID          int         `json:"org_id"`
+	Process        int         `json:"process"`
+	Status         string      `json:"status"`
+	ManualProgress bool        `json:"manual_progress"`
+	CreatedAt      string      `json:"created_at"`
+	UpdatedAt      string      `json:"updated_at"`
+	TaskCount      int         `json:"task_count"`
+	Edges          interface{} `json:"edges,omitempty"`
 }
 
 // ProjectListResponse represents the response for project list with pagination
@@ -114,9 +128,22 @@ type ProjectCursorPagination struct {
 	NextCursor *string `json:"next_cursor"`
 }
 
-// ProjectAddMembersInput represents the input for adding members to a project
+// ProjectAddMembersInput represents the input for adding members to a project.
+// Role applies to the new members and defaults to member.
 type ProjectAddMembersInput struct {
-	MemberIDs []int `json:"member_ids" binding:"required" validate:"required,dive,min=1"`
+	MemberIDs []int  `json:"member_ids" binding:"required" validate:"required,dive,min=1"`
+	Role      string `json:"role" validate:"omitempty,oneof=owner manager member viewer"`
+}
+
+// ProjectMemberRoleInput represents the input for changing a member's role
+type ProjectMemberRoleInput struct {
+	Role string `json:"role" binding:"required" validate:"required,oneof=owner manager member viewer"`
+}
+
+// ProjectTransferOwnershipInput represents the input for transferring a
+// project to another member
+type ProjectTransferOwnershipInput struct {
+	EmployeeID int `json:"employee_id" binding:"required" validate:"required,min=1"`
 }
 
 // ProjectRemoveMembersInput represents the input for removing members from a project
@@ -127,4 +154,5 @@ type ProjectRemoveMembersInput struct {
 // RegisterProjectValidators registers custom validators for project DTOs
 func RegisterProjectValidators(v *validator.Validate) {
 	v.RegisterValidation("project_code", projectCodeValidation)
+	v.RegisterValidation("project_key", projectKeyValidation)
 }
@@ -0,0 +1,13 @@
+package dtos
+
+// ReminderSettingsInput changes the task reminder schedule of an organization;
+// fields left out keep their value
+type ReminderSettingsInput struct {
+	DueSoonEnabled *bool `json:"due_soon_enabled"`
+	// Assignees are reminded of tasks due within this many hours
+	DueSoonHours   *int  `json:"due_soon_hours" validate:"omitempty,min=1,max=720"`
+	OverdueEnabled *bool `json:"overdue_enabled"`
+	// Overdue tasks are reminded again every this many hours; 0 reminds them
+	// once
+	OverdueRepeatHours *int `json:"overdue_repeat_hours" validate:"omitempty,min=0,max=720"`
+}
@@ -0,0 +1,68 @@
+package dtos
+
+// ShiftCreateInput represents the input for creating a shift template
+type ShiftCreateInput struct {
+	Code         string `json:"code" binding:"required" validate:"required,min=1,max=50"`
+	Name         string `json:"name" binding:"required" validate:"required,min=1,max=200"`
+	StartTime    string `json:"start_time" binding:"required" validate:"required,datetime=15:04"`
+	EndTime      string `json:"end_time" binding:"required" validate:"required,datetime=15:04"`
+	BreakMinutes int    `json:"break_minutes" validate:"omitempty,min=0,max=480"`
+	BreakPaid    bool   `json:"break_paid"`
+	IsNight      bool   `json:"is_night"`
+}
+
+// ShiftUpdateInput represents the input for updating a shift template
+type ShiftUpdateInput struct {
+	Name         *string `json:"name" validate:"omitempty,min=1,max=200"`
+	StartTime    *string `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndTime      *string `json:"end_time" validate:"omitempty,datetime=15:04"`
+	BreakMinutes *int    `json:"break_minutes" validate:"omitempty,min=0,max=480"`
+	BreakPaid    *bool   `json:"break_paid"`
+	IsNight      *bool   `json:"is_night"`
+}
+
+// RosterAssignInput represents the input for assigning an employee to a shift on one or more days
+type RosterAssignInput struct {
+	EmployeeID int      `json:"employee_id" binding:"required" validate:"required,min=1"`
+	ShiftID    int      `json:"shift_id" binding:"required" validate:"required,min=1"`
+	WorkDates  []string `json:"work_dates" binding:"required" validate:"required,min=1,max=62,dive,datetime=2006-01-02"`
+	Note       string   `json:"note" validate:"omitempty,max=500"`
+}
+
+// RosterListQuery represents query parameters for listing roster entries
+type RosterListQuery struct {
+	EmployeeID   int    `form:"employee_id" validate:"omitempty,min=1"`
+	ShiftID      int    `form:"shift_id" validate:"omitempty,min=1"`
+	DepartmentID int    `form:"department_id" validate:"omitempty,min=1"`
+	From         string `form:"from" binding:"required" validate:"required,datetime=2006-01-02"`
+	To           string `form:"to" binding:"required" validate:"required,datetime=2006-01-02"`
+	Format       string `form:"format" validate:"omitempty,oneof=csv ics"`
+	OrgID        int    // From JWT token
+}
+
+// ShiftSwapCreateInput represents a request to swap a rostered shift with a colleague
+type ShiftSwapCreateInput struct {
+	RosterID       int    `json:"roster_id" binding:"required" validate:"required,min=1"`
+	TargetRosterID int    `json:"target_roster_id" binding:"required" validate:"required,min=1"`
+	Reason         string `json:"reason" validate:"omitempty,max=1000"`
+}
+
+// ShiftSwapListQuery represents query parameters for listing shift swap requests
+type ShiftSwapListQuery struct {
+	Status     string `form:"status" validate:"omitempty,oneof=pending approved rejected"`
+	Page       int    `form:"page" validate:"omitempty,min=1"`
+	Limit      int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	EmployeeID int    // Set for employee-scoped listings
+	OrgID      int    // From JWT token
+}
+
+// ShiftSwapListResponse represents the paginated response for shift swap requests
+type ShiftSwapListResponse struct {
+	Data       interface{}      `json:"data"`
+	Pagination OffsetPagination `json:"pagination"`
+}
+
+// ShiftSwapReviewInput represents the input for approving or rejecting a shift swap
+type ShiftSwapReviewInput struct {
+	Comment string `json:"comment" validate:"omitempty,max=1000"`
+}
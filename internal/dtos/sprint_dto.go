@@ -0,0 +1,75 @@
+package dtos
+
+// SprintCreateInput represents the input for planning a sprint in a project
+type SprintCreateInput struct {
+	Name    string `json:"name" binding:"required" validate:"required,min=1,max=200"`
+	Goal    string `json:"goal" validate:"omitempty,max=1000"`
+	StartAt string `json:"start_at" binding:"required" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndAt   string `json:"end_at" binding:"required" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// SprintUpdateInput represents the input for updating a sprint that is not closed
+type SprintUpdateInput struct {
+	Name    *string `json:"name" validate:"omitempty,min=1,max=200"`
+	Goal    *string `json:"goal" validate:"omitempty,max=1000"`
+	StartAt *string `json:"start_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	EndAt   *string `json:"end_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// SprintListQuery represents query parameters for listing a project's sprints
+type SprintListQuery struct {
+	State string `form:"state" validate:"omitempty,oneof=planned active closed"`
+}
+
+// SprintCloseInput represents the input for closing a sprint. Unfinished
+// tasks move to NextSprintID, or to the project's next planned sprint, or
+// back to the backlog when there is none.
+type SprintCloseInput struct {
+	NextSprintID *int `json:"next_sprint_id" validate:"omitempty,min=1"`
+}
+
+// SprintCloseResponse represents the outcome of closing a sprint
+type SprintCloseResponse struct {
+	SprintID         int   `json:"sprint_id"`
+	CompletedTaskIDs []int `json:"completed_task_ids"`
+	MovedTaskIDs     []int `json:"moved_task_ids"`
+	// NextSprintID is the sprint the unfinished tasks moved to; nil means the backlog
+	NextSprintID *int `json:"next_sprint_id"`
+}
+
+// SprintWork represents a set of tasks of a sprint and their estimates in minutes
+type SprintWork struct {
+	Tasks    int   `json:"tasks"`
+	Estimate int   `json:"estimate"`
+	TaskIDs  []int `json:"task_ids"`
+}
+
+// SprintReportResponse compares the work committed when a sprint started
+// with the work completed in it
+type SprintReportResponse struct {
+	SprintID  int        `json:"sprint_id"`
+	State     string     `json:"state"`
+	Committed SprintWork `json:"committed"`
+	// Added holds tasks planned in the sprint after it started
+	Added SprintWork `json:"added"`
+	// Removed holds committed tasks taken out of the sprint before it closed
+	Removed    SprintWork `json:"removed"`
+	Completed  SprintWork `json:"completed"`
+	Incomplete SprintWork `json:"incomplete"`
+}
+
+// SprintBurndownResponse represents the remaining work of a sprint per day
+type SprintBurndownResponse struct {
+	SprintID int                   `json:"sprint_id"`
+	Points   []SprintBurndownPoint `json:"points"`
+}
+
+// SprintBurndownPoint represents the work left at the end of a day, or now for
+// the current day. Remaining values are nil for days still to come; the ideal
+// line burns the committed estimate down evenly.
+type SprintBurndownPoint struct {
+	Date              string `json:"date"`
+	RemainingTasks    *int   `json:"remaining_tasks"`
+	RemainingEstimate *int   `json:"remaining_estimate"`
+	IdealEstimate     int    `json:"ideal_estimate"`
+}
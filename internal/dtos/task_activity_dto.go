@@ -0,0 +1,15 @@
+package dtos
+
+// TaskActivityListQuery represents query parameters for listing the activity
+// of a task or project, newest first
+type TaskActivityListQuery struct {
+	Kind   string `form:"kind" validate:"omitempty,max=30"`
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// TaskActivityListResponse represents a page of activity entries
+type TaskActivityListResponse struct {
+	Data       interface{}      `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
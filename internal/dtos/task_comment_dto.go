@@ -0,0 +1,25 @@
+package dtos
+
+// TaskCommentCreateInput represents the input for commenting on a task.
+// Employees are mentioned with @ followed by their employee code.
+type TaskCommentCreateInput struct {
+	Content  string `json:"content" binding:"required" validate:"required,min=1,max=5000"`
+	ParentID *int   `json:"parent_id" validate:"omitempty,min=1"`
+}
+
+// TaskCommentUpdateInput represents the input for editing a comment
+type TaskCommentUpdateInput struct {
+	Content string `json:"content" binding:"required" validate:"required,min=1,max=5000"`
+}
+
+// TaskCommentListQuery represents query parameters for listing task comments
+type TaskCommentListQuery struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// TaskCommentListResponse represents a page of comment threads
+type TaskCommentListResponse struct {
+	Data       interface{}      `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
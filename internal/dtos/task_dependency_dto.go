@@ -0,0 +1,50 @@
+package dtos
+
+import (
+	"time"
+)
+
+// TaskDependencyCreateInput represents the input for linking a task to another task
+type TaskDependencyCreateInput struct {
+	TargetID int    `json:"target_id" binding:"required" validate:"required,min=1"`
+	Type     string `json:"type" validate:"omitempty,oneof=blocks relates_to duplicates"`
+}
+
+// TaskDependencyListResponse represents the links of a task in both directions
+type TaskDependencyListResponse struct {
+	Dependencies interface{} `json:"dependencies"`
+	Dependents   interface{} `json:"dependents"`
+}
+
+// DependencyGraphNode represents a task in a project's dependency graph.
+// Schedule values are hours relative to the start of the project's critical path.
+type DependencyGraphNode struct {
+	ID             int        `json:"id"`
+	Code           string     `json:"code"`
+	Name           string     `json:"name"`
+	Status         string     `json:"status"`
+	StartAt        *time.Time `json:"start_at"`
+	DueDate        *time.Time `json:"due_date"`
+	DurationHours  float64    `json:"duration_hours"`
+	EarliestStart  float64    `json:"earliest_start"`
+	EarliestFinish float64    `json:"earliest_finish"`
+	SlackHours     float64    `json:"slack_hours"`
+	Critical       bool       `json:"critical"`
+}
+
+// DependencyGraphEdge represents a link between two tasks of a project
+type DependencyGraphEdge struct {
+	ID       int    `json:"id"`
+	TaskID   int    `json:"task_id"`
+	TargetID int    `json:"target_id"`
+	Type     string `json:"type"`
+}
+
+// DependencyGraphResponse represents a project's task dependency graph
+type DependencyGraphResponse struct {
+	ProjectID         int                   `json:"project_id"`
+	Nodes             []DependencyGraphNode `json:"nodes"`
+	Edges             []DependencyGraphEdge `json:"edges"`
+	CriticalPath      []int                 `json:"critical_path"`
+	CriticalPathHours float64               `json:"critical_path_hours"`
+}
@@ -12,8 +12,18 @@ type TaskCreateInput struct {
 	StartAt     *string `json:"start_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 	DueDate     *string `json:"due_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 	ProjectID   *int    `json:"project_id" validate:"omitempty,min=1"`
+	ParentID    *int    `json:"parent_id" validate:"omitempty,min=1"`
 	LabelIDs    []int   `json:"label_ids" validate:"omitempty,dive,min=1"`
 	AssigneeIDs []int   `json:"assignee_ids" validate:"omitempty,dive,min=1"`
+	Description *string `json:"description" validate:"omitempty,max=1000"`
+	// Estimates are in minutes; the remaining estimate defaults to the original one
+	OriginalEstimate  *int `json:"original_estimate" validate:"omitempty,min=0"`
+	RemainingEstimate *int `json:"remaining_estimate" validate:"omitempty,min=0"`
+	// A sprint or milestone of the task's project
+	SprintID    *int `json:"sprint_id" validate:"omitempty,min=1"`
+	MilestoneID *int `json:"milestone_id" validate:"omitempty,min=1"`
+	// RecurrenceRunID is set by the recurrence scheduler, never by clients
+	RecurrenceRunID *int `json:"-" validate:"-"`
 }
 
 // TaskUpdateInput represents the input for updating a task
@@ -26,14 +36,23 @@ type TaskUpdateInput struct {
 	ProjectID   *int    `json:"project_id" validate:"omitempty,min=1"`
 	LabelIDs    []int   `json:"label_ids" validate:"omitempty,dive,min=1"`
 	AssigneeIDs []int   `json:"assignee_ids" validate:"omitempty,dive,min=1"`
-	Status      *string `json:"status" validate:"omitempty,oneof=not_received received in_progress completed cancelled"`
+	Status      *string `json:"status" validate:"omitempty,min=1,max=50"`
 	Process     *int    `json:"process" validate:"omitempty,min=0,max=100"`
 	Description *string `json:"description" validate:"omitempty,max=1000"`
+	// ParentID moves the task under another task; 0 makes it a top-level task
+	ParentID *int `json:"parent_id" validate:"omitempty,min=0"`
+	// Estimates are in minutes
+	OriginalEstimate  *int `json:"original_estimate" validate:"omitempty,min=0"`
+	RemainingEstimate *int `json:"remaining_estimate" validate:"omitempty,min=0"`
+	// SprintID and MilestoneID plan the task in its project; 0 removes it
+	SprintID    *int `json:"sprint_id" validate:"omitempty,min=0"`
+	MilestoneID *int `json:"milestone_id" validate:"omitempty,min=0"`
 }
 
-// TaskUpdateProgressInput represents the input for updating task progress
+// TaskUpdateProgressInput represents the input for updating task progress.
+// Status is a status key of the project workflow or a built-in status.
 type TaskUpdateProgressInput struct {
-	Status  *string `json:"status" validate:"omitempty,oneof=in_progress completed cancelled"`
+	Status  *string `json:"status" validate:"omitempty,min=1,max=50"`
 	Process *int    `json:"process" validate:"omitempty,min=0,max=100"`
 }
 
@@ -41,10 +60,21 @@ type TaskUpdateProgressInput struct {
 type TaskListQuery struct {
 	Name           string `form:"name"`
 	Code           string `form:"code"`
-	Status         string `form:"status" validate:"omitempty,oneof=not_received received in_progress completed cancelled"`
+	Status         string `form:"status" validate:"omitempty,max=50"`
 	Type           string `form:"type" validate:"omitempty,oneof=task feature bug another"`
 	ProjectID      string `form:"project_id"`
 	CreatorID      string `form:"creator_id"`
+	ParentID       string `form:"parent_id"`
+	SprintID       string `form:"sprint_id"`
+	MilestoneID    string `form:"milestone_id"`
+	AssigneeIDs    string `form:"assignee_ids"`
+	AssigneeMode   string `form:"assignee_mode" validate:"omitempty,oneof=any all"`
+	LabelIDs       string `form:"label_ids"`
+	LabelMode      string `form:"label_mode" validate:"omitempty,oneof=any all"`
+	Unassigned     bool   `form:"unassigned"`
+	Overdue        bool   `form:"overdue"`
+	Mine           bool   `form:"mine"`
+	UpdatedSince   string `form:"updated_since" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 	Process        string `form:"process"`
 	StartDateFrom  string `form:"start_date_from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
 	StartDateTo    string `form:"start_date_to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
@@ -57,11 +87,15 @@ type TaskListQuery struct {
 	Cursor         string `form:"cursor"`
 	CursorLimit    int    `form:"cursor_limit" validate:"omitempty,min=1,max=100"`
 	PaginationType string `form:"pagination_type" validate:"omitempty,oneof=page cursor"`
+	View           string `form:"view" validate:"omitempty,oneof=flat tree"`
+	EmployeeID     int    // From JWT token, resolves mine
 }
 
 // TaskBulkDeleteInput represents input for bulk deleting tasks
 type TaskBulkDeleteInput struct {
 	IDs []int `json:"ids" binding:"required,min=1" validate:"required,min=1,max=100,dive,min=1"`
+	// Cascade also deletes the subtasks of every listed task
+	Cascade bool `json:"cascade"`
 }
 
 // TaskBulkDeleteResponse represents the response for bulk delete operations
@@ -71,6 +105,44 @@ type TaskBulkDeleteResponse struct {
 	Errors       []string `json:"errors,omitempty"`
 }
 
+// TaskBulkUpdateInput represents a change applied to many tasks at once. The
+// tasks are listed by IDs or, without IDs, selected by the task list filters of
+// the query string. Only the given changes are applied; DueDate "" clears the
+// due date.
+type TaskBulkUpdateInput struct {
+	IDs               []int   `json:"ids" validate:"omitempty,max=100,dive,min=1"`
+	AddAssigneeIDs    []int   `json:"add_assignee_ids" validate:"omitempty,max=50,dive,min=1"`
+	RemoveAssigneeIDs []int   `json:"remove_assignee_ids" validate:"omitempty,max=50,dive,min=1"`
+	AddLabelIDs       []int   `json:"add_label_ids" validate:"omitempty,max=50,dive,min=1"`
+	RemoveLabelIDs    []int   `json:"remove_label_ids" validate:"omitempty,max=50,dive,min=1"`
+	ProjectID         *int    `json:"project_id" validate:"omitempty,min=1"`
+	DueDate           *string `json:"due_date" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Status            *string `json:"status" validate:"omitempty,min=1,max=50"`
+	// DryRun reports what would change without saving anything
+	DryRun bool `json:"dry_run"`
+}
+
+// TaskBulkUpdateResult is the outcome of a bulk update for one task: updated,
+// unchanged or failed
+type TaskBulkUpdateResult struct {
+	ID     int    `json:"id"`
+	Code   string `json:"code,omitempty"`
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// TaskBulkUpdateResponse represents the response for bulk updates. Changes are
+// all or nothing: when a task fails, none is saved and Applied is false.
+type TaskBulkUpdateResponse struct {
+	DryRun         bool                   `json:"dry_run"`
+	Applied        bool                   `json:"applied"`
+	MatchedCount   int                    `json:"matched_count"`
+	UpdatedCount   int                    `json:"updated_count"`
+	UnchangedCount int                    `json:"unchanged_count"`
+	FailedCount    int                    `json:"failed_count"`
+	Results        []TaskBulkUpdateResult `json:"results"`
+}
+
 // TaskResponse represents a task with additional computed fields
 type TaskResponse struct {
 	ID          int         `json:"id"`
@@ -83,6 +155,7 @@ type TaskResponse struct {
 	StartAt     string      `json:"start_at,omitempty"`
 	DueDate     string      `json:"due_date,omitempty"`
 	ProjectID   int         `json:"project_id,omitempty"`
+	ParentID    int         `json:"parent_id,omitempty"`
 	CreatorID   int         `json:"creator_id"`
 	UpdaterID   int         `json:"updater_id"`
 	CreatedAt   string      `json:"created_at"`
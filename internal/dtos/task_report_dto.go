@@ -0,0 +1,38 @@
+package dtos
+
+// TaskReportCreateInput represents the input for reporting on a task
+type TaskReportCreateInput struct {
+	TaskID   int    `json:"task_id" binding:"required" validate:"required,min=1"`
+	Content  string `json:"content" binding:"required" validate:"required,min=1,max=5000"`
+	Progress *int   `json:"progress" validate:"omitempty,min=0,max=100"`
+}
+
+// TaskReportUpdateInput represents the input for editing a report before it is accepted
+type TaskReportUpdateInput struct {
+	Content  string `json:"content" binding:"required" validate:"required,min=1,max=5000"`
+	Progress *int   `json:"progress" validate:"omitempty,min=0,max=100"`
+}
+
+// TaskReportReviewInput represents the input for reviewing a task report
+type TaskReportReviewInput struct {
+	Status  string `json:"status" binding:"required" validate:"required,oneof=accepted needs_changes"`
+	Comment string `json:"comment" validate:"omitempty,max=1000"`
+}
+
+// TaskReportListQuery represents query parameters for listing task reports
+type TaskReportListQuery struct {
+	TaskID     int    `form:"task_id" validate:"omitempty,min=1"`
+	ReporterID int    `form:"reporter_id" validate:"omitempty,min=1"`
+	ProjectID  int    `form:"project_id" validate:"omitempty,min=1"`
+	Status     string `form:"status" validate:"omitempty,oneof=submitted accepted needs_changes"`
+	From       string `form:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To         string `form:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Page       int    `form:"page" validate:"omitempty,min=1"`
+	Limit      int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// TaskReportListResponse represents the paginated response for task reports
+type TaskReportListResponse struct {
+	Data       interface{}      `json:"data"`
+	Pagination OffsetPagination `json:"pagination"`
+}
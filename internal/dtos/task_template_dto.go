@@ -0,0 +1,79 @@
+package dtos
+
+// TaskTemplateCreateInput represents the input for creating a task template of
+// the caller's organization, optionally bound to one of its projects. The name
+// may contain {date}, replaced by the date a task is created for.
+type TaskTemplateCreateInput struct {
+	Name        string  `json:"name" binding:"required" validate:"required,min=1,max=200"`
+	Description string  `json:"description" validate:"omitempty,max=1000"`
+	Type        *string `json:"type" validate:"omitempty,oneof=task feature bug another"`
+	ProjectID   *int    `json:"project_id" validate:"omitempty,min=1"`
+	LabelIDs    []int   `json:"label_ids" validate:"omitempty,dive,min=1"`
+	AssigneeIDs []int   `json:"assignee_ids" validate:"omitempty,dive,min=1"`
+	// Offsets in minutes from the time a task is created for
+	StartOffset *int `json:"start_offset" validate:"omitempty,min=0,max=525600"`
+	DueOffset   *int `json:"due_offset" validate:"omitempty,min=0,max=525600"`
+	// Estimate in minutes
+	OriginalEstimate *int `json:"original_estimate" validate:"omitempty,min=0"`
+}
+
+// TaskTemplateUpdateInput represents the input for updating a task template
+type TaskTemplateUpdateInput struct {
+	Name        *string `json:"name" validate:"omitempty,min=1,max=200"`
+	Description *string `json:"description" validate:"omitempty,max=1000"`
+	Type        *string `json:"type" validate:"omitempty,oneof=task feature bug another"`
+	// ProjectID binds the template to another project; 0 unbinds it
+	ProjectID   *int  `json:"project_id" validate:"omitempty,min=0"`
+	LabelIDs    []int `json:"label_ids" validate:"omitempty,dive,min=1"`
+	AssigneeIDs []int `json:"assignee_ids" validate:"omitempty,dive,min=1"`
+	// Offsets and estimate in minutes; -1 removes them
+	StartOffset      *int `json:"start_offset" validate:"omitempty,min=-1,max=525600"`
+	DueOffset        *int `json:"due_offset" validate:"omitempty,min=-1,max=525600"`
+	OriginalEstimate *int `json:"original_estimate" validate:"omitempty,min=-1"`
+}
+
+// TaskTemplateListQuery represents query parameters for listing task templates
+type TaskTemplateListQuery struct {
+	ProjectID string `form:"project_id"`
+}
+
+// TaskTemplateInstantiateInput represents the input for creating a task from a
+// template now. At is the time the offsets count from and defaults to now.
+type TaskTemplateInstantiateInput struct {
+	At *string `json:"at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// TaskRecurrenceCreateInput represents the input for scheduling a template.
+// Rule is an RRULE subset:
+//
+//	FREQ=DAILY[;INTERVAL=n]
+//	FREQ=WEEKLY[;INTERVAL=n][;BYDAY=MO,WE,...]
+//	FREQ=MONTHLY[;INTERVAL=n][;BYMONTHDAY=d]
+//
+// Tasks are created at the time of day of StartAt in Timezone.
+type TaskRecurrenceCreateInput struct {
+	Rule     string  `json:"rule" binding:"required" validate:"required,max=200"`
+	StartAt  string  `json:"start_at" binding:"required" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Until    *string `json:"until" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Timezone *string `json:"timezone" validate:"omitempty,max=64"`
+	Enabled  *bool   `json:"enabled"`
+}
+
+// TaskRecurrenceUpdateInput represents the input for updating a recurrence.
+// Changing the schedule or enabling it again restarts it from now, so
+// occurrences missed in the meantime are not created.
+type TaskRecurrenceUpdateInput struct {
+	Rule    *string `json:"rule" validate:"omitempty,max=200"`
+	StartAt *string `json:"start_at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	// Until ends the recurrence; an empty string removes the end
+	Until    *string `json:"until" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Timezone *string `json:"timezone" validate:"omitempty,max=64"`
+	Enabled  *bool   `json:"enabled"`
+}
+
+// TaskRecurrenceRunListQuery represents query parameters for listing the runs
+// of a recurrence
+type TaskRecurrenceRunListQuery struct {
+	Status string `form:"status" validate:"omitempty,oneof=pending created failed"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
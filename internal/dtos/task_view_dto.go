@@ -0,0 +1,50 @@
+package dtos
+
+// TaskViewCreateInput represents the input for saving a task list filter.
+// Filters holds task list query parameters by name, e.g.
+//
+//	{"status": "in_progress", "type": "bug", "due_date_to": "today+7d"}
+//
+// Date filters take RFC3339 times or times relative to now or the start of
+// today in Timezone: now, today, today+7d, now-12h, today-2w. The mine filter
+// selects the tasks of whoever runs the view.
+type TaskViewCreateInput struct {
+	Name string `json:"name" binding:"required" validate:"required,min=1,max=100"`
+	// Visibility shares the view with the members of ProjectID or the whole
+	// organization; private views are only seen by their owner
+	Visibility *string           `json:"visibility" validate:"omitempty,oneof=private project organization"`
+	ProjectID  *int              `json:"project_id" validate:"omitempty,min=1"`
+	Filters    map[string]string `json:"filters" validate:"omitempty,max=30"`
+	Timezone   *string           `json:"timezone" validate:"omitempty,max=64"`
+}
+
+// TaskViewUpdateInput represents the input for updating a saved view. Filters
+// replace the saved ones as a whole.
+type TaskViewUpdateInput struct {
+	Name       *string `json:"name" validate:"omitempty,min=1,max=100"`
+	Visibility *string `json:"visibility" validate:"omitempty,oneof=private project organization"`
+	// ProjectID shares the view with another project; 0 removes the project
+	ProjectID *int              `json:"project_id" validate:"omitempty,min=0"`
+	Filters   map[string]string `json:"filters" validate:"omitempty,max=30"`
+	Timezone  *string           `json:"timezone" validate:"omitempty,max=64"`
+}
+
+// TaskViewListQuery represents query parameters for listing the saved views
+// visible to the caller
+type TaskViewListQuery struct {
+	ProjectID string `form:"project_id"`
+	// Mine only lists the caller's own views
+	Mine bool `form:"mine"`
+}
+
+// TaskViewRunQuery represents query parameters for running a saved view. The
+// pagination parameters are those of the task list; CountOnly returns only the
+// number of matching tasks.
+type TaskViewRunQuery struct {
+	CountOnly      bool   `form:"count_only"`
+	Page           int    `form:"page" validate:"omitempty,min=1"`
+	Limit          int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor         string `form:"cursor"`
+	CursorLimit    int    `form:"cursor_limit" validate:"omitempty,min=1,max=100"`
+	PaginationType string `form:"pagination_type" validate:"omitempty,oneof=page cursor"`
+}
@@ -0,0 +1,69 @@
+package dtos
+
+// WorkLogCreateInput represents the input for logging time spent on a task.
+// Durations and estimates are in minutes.
+type WorkLogCreateInput struct {
+	StartedAt string `json:"started_at" binding:"required" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Duration  int    `json:"duration" binding:"required" validate:"required,min=1,max=1440"`
+	Note      string `json:"note" validate:"omitempty,max=1000"`
+	// RemainingEstimate overrides the automatic reduction of the remaining estimate
+	RemainingEstimate *int `json:"remaining_estimate" validate:"omitempty,min=0"`
+}
+
+// WorkLogTimerInput represents the input for starting a timer on a task
+type WorkLogTimerInput struct {
+	Note string `json:"note" validate:"omitempty,max=1000"`
+}
+
+// TaskTimeTrackingResponse compares the estimates of a task with the time logged on it
+type TaskTimeTrackingResponse struct {
+	TaskID            int  `json:"task_id"`
+	OriginalEstimate  *int `json:"original_estimate"`
+	RemainingEstimate *int `json:"remaining_estimate"`
+	Logged            int  `json:"logged"`
+	// Variance is logged plus remaining minus original; positive means over estimate
+	Variance   *int                   `json:"variance"`
+	ByEmployee []WorkLogEmployeeTotal `json:"by_employee"`
+}
+
+// WorkLogEmployeeTotal represents the time logged by one employee
+type WorkLogEmployeeTotal struct {
+	EmployeeID int `json:"employee_id"`
+	Minutes    int `json:"minutes"`
+}
+
+// WorkLogWeeklyQuery represents query parameters for weekly time aggregates.
+// Weeks start on Monday; from and to default to the last four weeks.
+type WorkLogWeeklyQuery struct {
+	GroupBy    string `form:"group_by" validate:"omitempty,oneof=task project employee"`
+	TaskID     int    `form:"task_id" validate:"omitempty,min=1"`
+	ProjectID  int    `form:"project_id" validate:"omitempty,min=1"`
+	EmployeeID int    `form:"employee_id" validate:"omitempty,min=1"`
+	From       string `form:"from" validate:"omitempty,datetime=2006-01-02"`
+	To         string `form:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// WorkLogWeeklyRow represents the minutes logged in a week for a task, project or employee
+type WorkLogWeeklyRow struct {
+	WeekStart string `json:"week_start"`
+	ID        int    `json:"id"`
+	Minutes   int    `json:"minutes"`
+}
+
+// WorkLogWeeklyResponse represents weekly time aggregates
+type WorkLogWeeklyResponse struct {
+	GroupBy      string             `json:"group_by"`
+	From         string             `json:"from"`
+	To           string             `json:"to"`
+	Data         []WorkLogWeeklyRow `json:"data"`
+	TotalMinutes int                `json:"total_minutes"`
+}
+
+// ProjectTimeExportQuery represents query parameters for exporting a project's time.
+// The logs format lists every work log for billing; summary compares estimates
+// with the time logged per task.
+type ProjectTimeExportQuery struct {
+	From   string `form:"from" validate:"omitempty,datetime=2006-01-02"`
+	To     string `form:"to" validate:"omitempty,datetime=2006-01-02"`
+	Format string `form:"format" validate:"omitempty,oneof=logs summary"`
+}
@@ -0,0 +1,28 @@
+package dtos
+
+// WorkflowInput represents the input for replacing a project's workflow. The
+// first status is given to new tasks and must be in the todo category.
+type WorkflowInput struct {
+	Statuses    []WorkflowStatusInput     `json:"statuses" binding:"required" validate:"required,min=1,max=30,dive"`
+	Transitions []WorkflowTransitionInput `json:"transitions" validate:"max=300,dive"`
+}
+
+// WorkflowStatusInput represents a status of a workflow
+type WorkflowStatusInput struct {
+	Key      string `json:"key" validate:"required,max=50"`
+	Name     string `json:"name" validate:"omitempty,max=100"`
+	Category string `json:"category" validate:"required,oneof=todo doing done"`
+	// SystemStatus is the built-in status the custom status maps to; it
+	// defaults to not_received, in_progress or completed by category
+	SystemStatus string `json:"system_status" validate:"omitempty,oneof=not_received received in_progress completed cancelled"`
+	// WIPLimit caps the number of tasks in the status' board column
+	WIPLimit *int `json:"wip_limit" validate:"omitempty,min=1"`
+}
+
+// WorkflowTransitionInput represents an allowed move between two statuses
+type WorkflowTransitionInput struct {
+	// From is empty to allow the transition from any status
+	From   string   `json:"from" validate:"omitempty,max=50"`
+	To     string   `json:"to" validate:"required,max=50"`
+	Guards []string `json:"guards" validate:"omitempty,dive,oneof=creator_only assignee_only requires_report requires_accepted_report"`
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/appointment"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type AppointmentHistoryHandler struct {
+	Service *appointment.AppointmentService
+}
+
+func NewAppointmentHistoryHandler(client *ent.Client) *AppointmentHistoryHandler {
+	return &AppointmentHistoryHandler{
+		Service: appointment.NewAppointmentService(client),
+	}
+}
+
+func (h *AppointmentHistoryHandler) RegisterRoutes(r *gin.Engine) {
+	histories := r.Group("/appointment-histories")
+	{
+		histories.GET("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AppointmentHistoryRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.List(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		histories.GET(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AppointmentHistoryRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		histories.PATCH(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AppointmentHistoryUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Update(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		histories.DELETE(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AppointmentHistoryDelete},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Delete(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// List retrieves appointment histories of the caller's organization.
+//
+// Query Parameters:
+// - employee_id, position_id, department_id: Filter by the linked entity
+// - active_on: Only entries effective at this instant (RFC3339 format)
+// - order_by: Sort field (id, joining_at, effective_to, created_at, updated_at) - default: joining_at
+// - order_dir: Sort direction (asc, desc) - default: desc
+// - page, limit: Offset pagination (default: 1, 10)
+func (h *AppointmentHistoryHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.AppointmentHistoryListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+
+	response, err := h.Service.List(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AppointmentHistoryHandler) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid appointment history ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	history, err := h.Service.Get(c.Request.Context(), id, ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// Update corrects an appointment history entry.
+//
+// Request body (all fields optional):
+//
+//	{
+//	  "position_id": 3,
+//	  "joining_at": "2025-01-01T00:00:00Z",
+//	  "effective_to": "2025-06-30T00:00:00Z", // "" reopens the entry
+//	  "description": "...",
+//	  "attachment_urls": ["https://..."]
+//	}
+func (h *AppointmentHistoryHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid appointment history ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.AppointmentHistoryUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	history, err := h.Service.Update(c.Request.Context(), id, ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+func (h *AppointmentHistoryHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid appointment history ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	if err := h.Service.Delete(c.Request.Context(), id, ids["org_id"]); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *AppointmentHistoryHandler) respondError(c *gin.Context, err error) {
+	if serviceErr, ok := err.(*appointment.ServiceError); ok {
+		c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+}
@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/internal/storage"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type AttachmentHandler struct {
+	Service *attachment.AttachmentService
+}
+
+func NewAttachmentHandler(client *ent.Client, store storage.Storage, scanner storage.Scanner, signingKey []byte) *AttachmentHandler {
+	return &AttachmentHandler{
+		Service: attachment.NewAttachmentService(client, store, scanner, signingKey),
+	}
+}
+
+func (h *AttachmentHandler) RegisterRoutes(r *gin.Engine) {
+	attachments := r.Group("/attachments")
+	{
+		attachments.GET("/settings", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttachmentSettingsRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.GetSettings(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		attachments.PUT("/settings", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttachmentSettingsUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.UpdateSettings(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		attachments.GET("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttachmentRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.List(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		attachments.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttachmentCreate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Upload(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		attachments.GET("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttachmentRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		// The signed URL authorizes the download, so links can be opened
+		// directly by browsers without a bearer token
+		attachments.GET("/:id/download", h.Download)
+
+		attachments.DELETE("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttachmentDelete},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Delete(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// Upload stores a file and links it to a task, task report, leave request or
+// appointment history of the organization.
+//
+// Multipart form fields:
+// - file: The uploaded file
+// - owner_type: task, task_report, leave_request or appointment_history
+// - owner_id: ID of the linked record
+func (h *AttachmentHandler) Upload(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var input dtos.AttachmentUploadInput
+	if err := c.ShouldBind(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is required"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	response, err := h.Service.Upload(c.Request.Context(), ids["org_id"], ids["employee_id"], input, fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, response)
+}
+
+// List returns the attachments of a record.
+//
+// Query Parameters:
+// - owner_type: task, task_report, leave_request or appointment_history
+// - owner_id: ID of the linked record
+func (h *AttachmentHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var query dtos.AttachmentListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.Service.List(c.Request.Context(), ids["org_id"], query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": response})
+}
+
+func (h *AttachmentHandler) Get(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+
+	response, err := h.Service.Get(c.Request.Context(), ids["org_id"], id)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// Download streams the content of an attachment.
+//
+// Query Parameters:
+// - expires: Expiry of the link (Unix seconds)
+// - signature: Signature issued with the link
+func (h *AttachmentHandler) Download(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Download link is invalid or has expired"})
+		return
+	}
+
+	row, body, err := h.Service.Open(c.Request.Context(), id, expires, c.Query("signature"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	defer body.Close()
+
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.DataFromReader(http.StatusOK, row.Size, row.MimeType, body, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": row.FileName}),
+	})
+}
+
+func (h *AttachmentHandler) Delete(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attachment ID"})
+		return
+	}
+
+	if err := h.Service.Delete(c.Request.Context(), ids["org_id"], ids["employee_id"], id); err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Attachment deleted successfully"})
+}
+
+func (h *AttachmentHandler) GetSettings(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.Service.GetSettings(c.Request.Context(), ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateSettings changes the attachment limits of the organization.
+//
+// Request body:
+//
+//	{
+//	  "max_file_size": 10485760, // bytes, optional
+//	  "quota": 1073741824        // bytes, optional
+//	}
+func (h *AttachmentHandler) UpdateSettings(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var input dtos.AttachmentSettingsInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.Service.UpdateSettings(c.Request.Context(), ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AttachmentHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*attachment.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
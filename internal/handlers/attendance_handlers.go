@@ -0,0 +1,502 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/attendance"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type AttendanceHandler struct {
+	Client  *ent.Client
+	Service *attendance.AttendanceService
+}
+
+func NewAttendanceHandler(client *ent.Client) *AttendanceHandler {
+	return &AttendanceHandler{
+		Client:  client,
+		Service: attendance.NewAttendanceService(client),
+	}
+}
+
+func (h *AttendanceHandler) RegisterRoutes(r *gin.Engine) {
+	records := r.Group("/attendance")
+	{
+		// Employee routes
+		records.POST("/check-in", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceClockEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CheckIn(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		records.POST("/check-out", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceClockEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CheckOut(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		records.GET("/employee", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceReadEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListEmployee(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		records.GET("/employee/summary", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceReadEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.SummaryEmployee(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		// Admin routes
+		records.GET("/admin", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceReadAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListAdmin(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		records.GET("/admin/summary", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceReadAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.SummaryAdmin(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		records.GET("/admin/export", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceExportAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Export(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
+	corrections := r.Group("/attendance-corrections")
+	{
+		// Employee routes
+		corrections.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceCorrectionCreateEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateCorrection(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		corrections.GET("/employee", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceCorrectionReadEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListCorrectionsEmployee(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		// Admin routes
+		corrections.GET("/admin", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceCorrectionReadAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListCorrectionsAdmin(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		corrections.PATCH(":id/approve", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceCorrectionApproveAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ApproveCorrection(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		corrections.PATCH(":id/reject", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.AttendanceCorrectionApproveAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.RejectCorrection(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// CheckIn clocks the caller in for today.
+//
+// Request body (optional):
+//
+//	{
+//	  "source": "mobile", // web (default), mobile, kiosk
+//	  "latitude": 10.7769,
+//	  "longitude": 106.7009,
+//	  "note": "..."
+//	}
+func (h *AttendanceHandler) CheckIn(c *gin.Context) {
+	h.clock(c, h.Service.CheckIn, http.StatusCreated)
+}
+
+// CheckOut clocks the caller out for today. Accepts the same body as CheckIn.
+func (h *AttendanceHandler) CheckOut(c *gin.Context) {
+	h.clock(c, h.Service.CheckOut, http.StatusOK)
+}
+
+func (h *AttendanceHandler) clock(c *gin.Context, fn func(ctx context.Context, orgID, employeeID int, input dtos.AttendanceClockInput) (*ent.AttendanceRecord, error), status int) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.AttendanceClockInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validator.New().Struct(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	record, err := fn(c.Request.Context(), ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(status, record)
+}
+
+// ListEmployee retrieves the caller's own attendance records.
+//
+// Query Parameters:
+// - from, to: Work date range (YYYY-MM-DD)
+// - page, limit: Offset pagination (default: 1, 10)
+func (h *AttendanceHandler) ListEmployee(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var query dtos.AttendanceListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+	query.EmployeeID = ids["employee_id"]
+
+	response, err := h.Service.List(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListAdmin retrieves attendance records of the caller's organization.
+//
+// Query Parameters:
+// - employee_id: Filter by employee
+// - from, to: Work date range (YYYY-MM-DD)
+// - page, limit: Offset pagination (default: 1, 10)
+func (h *AttendanceHandler) ListAdmin(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.AttendanceListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+
+	response, err := h.Service.List(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SummaryEmployee returns the caller's daily attendance summaries.
+//
+// Query Parameters:
+// - from, to: Required date range (YYYY-MM-DD)
+func (h *AttendanceHandler) SummaryEmployee(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	h.summary(c, ids["org_id"], []int{ids["employee_id"]})
+}
+
+// SummaryAdmin returns daily attendance summaries of the caller's organization.
+//
+// Query Parameters:
+// - from, to: Required date range (YYYY-MM-DD)
+// - employee_id: Only this employee - default: every employee of the organization
+func (h *AttendanceHandler) SummaryAdmin(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	employeeIDs := []int{}
+	if v := c.Query("employee_id"); v != "" {
+		employeeID, err := strconv.Atoi(v)
+		if err != nil || employeeID <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid employee_id"})
+			return
+		}
+		employeeIDs = append(employeeIDs, employeeID)
+	} else {
+		employeeIDs, err = h.Client.Employee.Query().
+			Where(employee.OrgID(ids["org_id"])).
+			IDs(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch employees"})
+			return
+		}
+	}
+
+	h.summary(c, ids["org_id"], employeeIDs)
+}
+
+func (h *AttendanceHandler) summary(c *gin.Context, orgID int, employeeIDs []int) {
+	var query dtos.AttendanceSummaryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, _ := time.ParseInLocation("2006-01-02", query.From, time.Local)
+	to, _ := time.ParseInLocation("2006-01-02", query.To, time.Local)
+
+	summaries, err := h.Service.DailySummaries(c.Request.Context(), orgID, employeeIDs, from, to)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": summaries})
+}
+
+// Export downloads the monthly timesheet of a department as CSV.
+//
+// Query Parameters:
+// - department_id: Required
+// - month: Required month (YYYY-MM)
+func (h *AttendanceHandler) Export(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	departmentID, err := strconv.Atoi(c.Query("department_id"))
+	if err != nil || departmentID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or missing department_id"})
+		return
+	}
+	month, err := time.ParseInLocation("2006-01", c.Query("month"), time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or missing month, must be YYYY-MM"})
+		return
+	}
+
+	rows, err := h.Service.MonthlyTimesheet(c.Request.Context(), ids["org_id"], departmentID, month)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	filename := fmt.Sprintf("timesheet-department-%d-%s.csv", departmentID, month.Format("2006-01"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	if err := csv.NewWriter(c.Writer).WriteAll(rows); err != nil {
+		c.Error(err)
+	}
+}
+
+// CreateCorrection submits a correction request for one of the caller's work days.
+//
+// Request body:
+//
+//	{
+//	  "work_date": "2025-07-01",
+//	  "check_in_at": "2025-07-01T08:00:00+07:00",  // optional
+//	  "check_out_at": "2025-07-01T17:00:00+07:00", // optional
+//	  "reason": "Forgot to check out"
+//	}
+func (h *AttendanceHandler) CreateCorrection(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.AttendanceCorrectionCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	correction, err := h.Service.CreateCorrection(c.Request.Context(), ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, correction)
+}
+
+// ListCorrectionsEmployee retrieves the caller's own correction requests.
+func (h *AttendanceHandler) ListCorrectionsEmployee(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var query dtos.AttendanceCorrectionListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+	query.EmployeeID = ids["employee_id"]
+
+	response, err := h.Service.ListCorrections(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListCorrectionsAdmin retrieves correction requests of the caller's organization.
+//
+// Query Parameters:
+// - employee_id: Filter by employee
+// - status: Filter by status (pending, approved, rejected)
+// - page, limit: Offset pagination (default: 1, 10)
+func (h *AttendanceHandler) ListCorrectionsAdmin(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.AttendanceCorrectionListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+
+	response, err := h.Service.ListCorrections(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AttendanceHandler) ApproveCorrection(c *gin.Context) {
+	h.reviewCorrection(c, h.Service.ApproveCorrection)
+}
+
+func (h *AttendanceHandler) RejectCorrection(c *gin.Context) {
+	h.reviewCorrection(c, h.Service.RejectCorrection)
+}
+
+func (h *AttendanceHandler) reviewCorrection(c *gin.Context, fn func(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.AttendanceCorrection, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid attendance correction ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.AttendanceCorrectionReviewInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validator.New().Struct(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	correction, err := fn(c.Request.Context(), id, ids["org_id"], ids["employee_id"], input.Comment)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, correction)
+}
+
+func (h *AttendanceHandler) respondError(c *gin.Context, err error) {
+	if serviceErr, ok := err.(*attendance.ServiceError); ok {
+		c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	taskService "github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type BoardHandler struct {
+	TaskService     *taskService.TaskService
+	WorkflowService *workflow.WorkflowService
+}
+
+func NewBoardHandler(client *ent.Client) *BoardHandler {
+	return &BoardHandler{
+		TaskService:     taskService.NewTaskService(client),
+		WorkflowService: workflow.NewWorkflowService(client),
+	}
+}
+
+func (h *BoardHandler) RegisterRoutes(r *gin.Engine) {
+	projects := r.Group("/projects")
+	{
+		projects.GET("/:id/board", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		projects.PUT("/:id/board/wip-limits", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.SetWIPLimits(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// Get returns a project's board: one column per workflow status with its
+// tasks in rank order.
+//
+// Query Parameters:
+// - swimlane: assignee or label, to also split the columns into lanes
+func (h *BoardHandler) Get(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var query dtos.BoardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	board, err := h.TaskService.Board(c.Request.Context(), id, ids["org_id"], query)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, board)
+}
+
+// SetWIPLimits sets the WIP limits of a project's board columns.
+//
+// Request body:
+//
+//	{
+//	  "limits": {"in_progress": 5, "review": null}
+//	}
+func (h *BoardHandler) SetWIPLimits(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var input dtos.BoardWIPLimitsInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	def, err := h.WorkflowService.SetWIPLimits(c.Request.Context(), id, ids["org_id"], ids["employee_id"], input.Limits)
+	if err != nil {
+		if svcErr, ok := err.(*workflow.ServiceError); ok {
+			c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, def)
+}
@@ -0,0 +1,479 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type CompensationHandler struct {
+	Service *compensation.CompensationService
+}
+
+func NewCompensationHandler(client *ent.Client) *CompensationHandler {
+	return &CompensationHandler{
+		Service: compensation.NewCompensationService(client),
+	}
+}
+
+func (h *CompensationHandler) RegisterRoutes(r *gin.Engine) {
+	grades := r.Group("/salary-grades")
+	{
+		grades.GET("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.SalaryGradeRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListSalaryGrades(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		grades.GET(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.SalaryGradeRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.GetSalaryGrade(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		grades.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.SalaryGradeCreate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateSalaryGrade(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		grades.PATCH(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.SalaryGradeUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.UpdateSalaryGrade(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		grades.DELETE(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.SalaryGradeDelete},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.DeleteSalaryGrade(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
+	compensations := r.Group("/compensations")
+	{
+		compensations.GET("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.CompensationRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.List(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		compensations.GET("current", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.CompensationRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Current(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		compensations.GET("export", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.CompensationExport},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Export(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		compensations.GET(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.CompensationRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		compensations.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.CompensationCreate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Create(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		compensations.PATCH(":id/approve", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.CompensationApprove},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Approve(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		compensations.PATCH(":id/reject", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.CompensationApprove},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Reject(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// ListSalaryGrades retrieves the pay bands of the caller's organization.
+//
+// Query Parameters:
+// - position_id: Only grades attached to this position
+func (h *CompensationHandler) ListSalaryGrades(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	positionID := 0
+	if v := c.Query("position_id"); v != "" {
+		positionID, err = strconv.Atoi(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position_id"})
+			return
+		}
+	}
+
+	grades, err := h.Service.ListSalaryGrades(c.Request.Context(), ids["org_id"], positionID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, grades)
+}
+
+func (h *CompensationHandler) GetSalaryGrade(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid salary grade ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	grade, err := h.Service.GetSalaryGrade(c.Request.Context(), id, ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, grade)
+}
+
+func (h *CompensationHandler) CreateSalaryGrade(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.SalaryGradeCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grade, err := h.Service.CreateSalaryGrade(c.Request.Context(), ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, grade)
+}
+
+func (h *CompensationHandler) UpdateSalaryGrade(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid salary grade ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.SalaryGradeUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grade, err := h.Service.UpdateSalaryGrade(c.Request.Context(), id, ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, grade)
+}
+
+func (h *CompensationHandler) DeleteSalaryGrade(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid salary grade ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	if err := h.Service.DeleteSalaryGrade(c.Request.Context(), id, ids["org_id"]); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// List retrieves the compensation history of the caller's organization.
+//
+// Query Parameters:
+// - employee_id: Filter by employee
+// - status: Filter by status (pending, approved, rejected)
+// - page, limit: Offset pagination (default: 1, 10)
+func (h *CompensationHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.CompensationListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+
+	response, err := h.Service.List(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CompensationHandler) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid compensation ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	record, err := h.Service.Get(c.Request.Context(), id, ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, record)
+}
+
+// Current returns the approved compensation in effect for an employee.
+//
+// Query Parameters:
+// - employee_id: Required
+// - at: Point in time (RFC3339 format) - default: now
+func (h *CompensationHandler) Current(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	employeeID, err := strconv.Atoi(c.Query("employee_id"))
+	if err != nil || employeeID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or missing employee_id"})
+		return
+	}
+
+	at := time.Now()
+	if v := c.Query("at"); v != "" {
+		at, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid at format, must be RFC3339"})
+			return
+		}
+	}
+
+	record, err := h.Service.Current(c.Request.Context(), employeeID, ids["org_id"], at)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, record)
+}
+
+// Create submits a salary change request for approval.
+//
+// Request body:
+//
+//	{
+//	  "employee_id": 1,
+//	  "salary_grade_id": 2,
+//	  "base_pay": 15000000,
+//	  "allowances": {"lunch": 730000, "transport": 500000},
+//	  "currency": "VND",
+//	  "effective_from": "2025-07-01T00:00:00Z",
+//	  "reason": "Annual review"
+//	}
+func (h *CompensationHandler) Create(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.CompensationCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	record, err := h.Service.Create(c.Request.Context(), ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, record)
+}
+
+func (h *CompensationHandler) Approve(c *gin.Context) {
+	h.review(c, h.Service.Approve)
+}
+
+func (h *CompensationHandler) Reject(c *gin.Context) {
+	h.review(c, h.Service.Reject)
+}
+
+// Export downloads a CSV salary review for a department.
+//
+// Query Parameters:
+// - department_id: Required
+// - at: Point in time (RFC3339 format) - default: now
+func (h *CompensationHandler) Export(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	departmentID, err := strconv.Atoi(c.Query("department_id"))
+	if err != nil || departmentID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or missing department_id"})
+		return
+	}
+
+	at := time.Now()
+	if v := c.Query("at"); v != "" {
+		at, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid at format, must be RFC3339"})
+			return
+		}
+	}
+
+	rows, err := h.Service.ExportDepartment(c.Request.Context(), ids["org_id"], departmentID, at)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	filename := fmt.Sprintf("salary-review-department-%d-%s.csv", departmentID, at.Format("20060102"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	if err := csv.NewWriter(c.Writer).WriteAll(rows); err != nil {
+		c.Error(err)
+	}
+}
+
+// review approves or rejects a pending salary change request on behalf of the caller.
+//
+// Request body (optional):
+//
+//	{
+//	  "comment": "Approved in annual review"
+//	}
+func (h *CompensationHandler) review(c *gin.Context, fn func(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.Compensation, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid compensation ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.CompensationReviewInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validator.New().Struct(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	record, err := fn(c.Request.Context(), id, ids["org_id"], ids["employee_id"], input.Comment)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, record)
+}
+
+func (h *CompensationHandler) respondError(c *gin.Context, err error) {
+	if serviceErr, ok := err.(*compensation.ServiceError); ok {
+		c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+}
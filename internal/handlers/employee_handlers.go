@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
 
 	"github.com/longgggwwww/hrm-ms-hr/ent"
@@ -12,6 +13,7 @@ import (
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 	"github.com/longgggwwww/hrm-ms-hr/internal/grpc_clients"
 	"github.com/longgggwwww/hrm-ms-hr/internal/services"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/appointment"
 	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
 )
 
@@ -66,6 +68,27 @@ func (h *EmployeeHandler) RegisterRoutes(r *gin.Engine) {
 					h.UpdatePositionById(c)
 				})).ServeHTTP(c.Writer, c.Request)
 		})
+		employees.GET(":id/timeline", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.EmployeeRead, constants.AppointmentHistoryRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Timeline(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		employees.GET(":id/contracts", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.EmployeeRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListContracts(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		employees.POST(":id/contracts", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.EmployeeUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateContract(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
 		employees.DELETE(":id", func(c *gin.Context) {
 			middleware.AuthMiddleware([]string{constants.EmployeeDelete},
 				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -280,7 +303,7 @@ func (h *EmployeeHandler) UpdateById(c *gin.Context) {
 	}
 
 	svc := services.NewEmployeeService(h.Client, h.UserClient)
-	emp, userInfo, err := svc.UpdateById(c.Request.Context(), id, orgID, input)
+	emp, userInfo, err := svc.UpdateById(c.Request.Context(), id, orgID, ids["employee_id"], input)
 	if err != nil {
 		if serr, ok := err.(*services.ServiceError); ok {
 			c.JSON(serr.Status, gin.H{"error": serr.Msg})
@@ -396,6 +419,91 @@ func (h *EmployeeHandler) DeleteById(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// Timeline trả về lịch sử công tác của employee: bổ nhiệm, hợp đồng và thay đổi trạng thái
+func (h *EmployeeHandler) Timeline(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "#1 Timeline: Invalid employee ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "#2 Timeline: Invalid or missing org_id in token"})
+		return
+	}
+
+	svc := appointment.NewAppointmentService(h.Client)
+	entries, err := svc.Timeline(c.Request.Context(), id, ids["org_id"])
+	if err != nil {
+		if serr, ok := err.(*appointment.ServiceError); ok {
+			c.JSON(serr.Status, gin.H{"error": serr.Msg})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": entries})
+}
+
+func (h *EmployeeHandler) ListContracts(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "#1 ListContracts: Invalid employee ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "#2 ListContracts: Invalid or missing org_id in token"})
+		return
+	}
+
+	svc := services.NewEmployeeService(h.Client, h.UserClient)
+	contracts, err := svc.ListContracts(c.Request.Context(), ids["org_id"], id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "#3 ListContracts: Failed to fetch contracts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": contracts})
+}
+
+func (h *EmployeeHandler) CreateContract(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "#1 CreateContract: Invalid employee ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "#2 CreateContract: Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.EmployeeContractCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	svc := services.NewEmployeeService(h.Client, h.UserClient)
+	contract, err := svc.CreateContract(c.Request.Context(), ids["org_id"], id, input)
+	if err != nil {
+		if serr, ok := err.(*services.ServiceError); ok {
+			c.JSON(serr.Status, gin.H{"error": serr.Msg})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, contract)
+}
+
 func normalizeUserInfo(user *grpc_clients.User) gin.H {
 	if user == nil {
 		return gin.H{}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type MilestoneHandler struct {
+	Service *milestone.MilestoneService
+}
+
+func NewMilestoneHandler(client *ent.Client) *MilestoneHandler {
+	return &MilestoneHandler{
+		Service: milestone.NewMilestoneService(client),
+	}
+}
+
+func (h *MilestoneHandler) RegisterRoutes(r *gin.Engine) {
+	projects := r.Group("/projects")
+	{
+		projects.GET("/:id/milestones", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.List(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		projects.POST("/:id/milestones", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Create(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
+	milestones := r.Group("/milestones")
+	{
+		milestones.GET("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		milestones.PATCH("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Update(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		milestones.DELETE("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Delete(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// List returns the milestones of a project with the progress of their tasks.
+//
+// Query Parameters:
+// - state: planned, active or closed
+func (h *MilestoneHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var query dtos.MilestoneListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rows, err := h.Service.List(c.Request.Context(), id, ids["org_id"], query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": rows})
+}
+
+// Create adds a milestone to a project
+func (h *MilestoneHandler) Create(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var input dtos.MilestoneCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	row, err := h.Service.Create(c.Request.Context(), id, ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, row)
+}
+
+func (h *MilestoneHandler) Get(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid milestone ID"})
+		return
+	}
+
+	row, err := h.Service.Get(c.Request.Context(), id, ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+func (h *MilestoneHandler) Update(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid milestone ID"})
+		return
+	}
+
+	var input dtos.MilestoneUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	row, err := h.Service.Update(c.Request.Context(), id, ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+// Delete removes a milestone; its tasks are kept without one
+func (h *MilestoneHandler) Delete(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid milestone ID"})
+		return
+	}
+
+	if err := h.Service.Delete(c.Request.Context(), id, ids["org_id"]); err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Milestone deleted successfully"})
+}
+
+func (h *MilestoneHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*milestone.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	notificationService "github.com/longgggwwww/hrm-ms-hr/internal/services/notification"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type NotificationHandler struct {
+	NotificationService *notificationService.NotificationService
+}
+
+func NewNotificationHandler(client *ent.Client) *NotificationHandler {
+	return &NotificationHandler{
+		NotificationService: notificationService.NewNotificationService(client),
+	}
+}
+
+func (h *NotificationHandler) RegisterRoutes(r *gin.Engine) {
+	r.GET("/notifications", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.NotificationReadEmployee},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.List(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.PATCH("/notifications/:id/read", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.NotificationUpdateEmployee},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.MarkRead(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.PATCH("/notifications/:id/unread", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.NotificationUpdateEmployee},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.MarkUnread(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.POST("/notifications/read-all", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.NotificationUpdateEmployee},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.MarkAllRead(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.GET("/notifications/preferences", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.NotificationReadEmployee},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.GetPreferences(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.PUT("/notifications/preferences", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.NotificationUpdateEmployee},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.UpdatePreferences(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+}
+
+// List returns the caller's notifications, newest first, with the number of
+// unread ones.
+//
+// Query Parameters:
+// - unread: only unread notifications
+// - type: only one type, e.g. task_assigned
+// - cursor, limit: cursor pagination (default limit 20, max 100)
+func (h *NotificationHandler) List(c *gin.Context) {
+	ids, ok := h.extractIDs(c)
+	if !ok {
+		return
+	}
+
+	var query dtos.NotificationListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.NotificationService.List(c.Request.Context(), ids["org_id"], ids["employee_id"], query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// MarkRead marks one of the caller's notifications as read
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	h.setRead(c, true)
+}
+
+// MarkUnread marks one of the caller's notifications as unread again
+func (h *NotificationHandler) MarkUnread(c *gin.Context) {
+	h.setRead(c, false)
+}
+
+func (h *NotificationHandler) setRead(c *gin.Context, read bool) {
+	ids, ok := h.extractIDs(c)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid notification ID"})
+		return
+	}
+
+	row, err := h.NotificationService.SetRead(c.Request.Context(), ids["org_id"], ids["employee_id"], id, read)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+// MarkAllRead marks all of the caller's notifications as read
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	ids, ok := h.extractIDs(c)
+	if !ok {
+		return
+	}
+
+	count, err := h.NotificationService.MarkAllRead(c.Request.Context(), ids["org_id"], ids["employee_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"updated_count": count})
+}
+
+// GetPreferences returns whether each notification type is on for the caller.
+// Types are on until turned off.
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	ids, ok := h.extractIDs(c)
+	if !ok {
+		return
+	}
+
+	prefs, err := h.NotificationService.Preferences(c.Request.Context(), ids["org_id"], ids["employee_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"types": prefs})
+}
+
+// UpdatePreferences turns notification types on or off for the caller; types
+// left out keep their setting
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	ids, ok := h.extractIDs(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.NotificationPreferencesInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	prefs, err := h.NotificationService.UpdatePreferences(c.Request.Context(), ids["org_id"], ids["employee_id"], req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"types": prefs})
+}
+
+// extractIDs reads the caller from the token; notifications belong to
+// employees, so tokens without one are refused
+func (h *NotificationHandler) extractIDs(c *gin.Context) (map[string]int, bool) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return nil, false
+	}
+	if ids["employee_id"] == 0 {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only employees have notifications"})
+		return nil, false
+	}
+	return ids, true
+}
+
+func (h *NotificationHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*notificationService.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,468 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/overtime"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type OvertimeHandler struct {
+	Service *overtime.OvertimeService
+}
+
+func NewOvertimeHandler(client *ent.Client) *OvertimeHandler {
+	return &OvertimeHandler{
+		Service: overtime.NewOvertimeService(client),
+	}
+}
+
+func (h *OvertimeHandler) RegisterRoutes(r *gin.Engine) {
+	requests := r.Group("/overtime-requests")
+	{
+		// Admin routes
+		requests.GET("/admin", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.OvertimeReadAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListAdmin(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		requests.GET("/admin/export", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.OvertimeExportAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Export(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		requests.PATCH(":id/approve", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.OvertimeApproveAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Approve(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		requests.PATCH(":id/reject", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.OvertimeApproveAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Reject(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		// Employee routes
+		requests.GET("/employee", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.OvertimeReadEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListEmployee(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		requests.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.OvertimeCreateEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Create(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		requests.PATCH(":id/cancel", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.OvertimeCreateEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Cancel(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
+	calendar := r.Group("/work-calendar")
+	{
+		calendar.GET("/weekend", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.WorkCalendarRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.GetWeekend(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		calendar.PUT("/weekend", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.WorkCalendarUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.SetWeekend(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		calendar.GET("/holidays", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.WorkCalendarRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListHolidays(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		calendar.POST("/holidays", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.WorkCalendarUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateHoliday(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		calendar.DELETE("/holidays/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.WorkCalendarUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.DeleteHoliday(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// Create submits an overtime request for the caller.
+//
+// Request body:
+//
+//	{
+//	  "work_date": "2025-07-05",
+//	  "hours": 3.5,
+//	  "reason": "Release deadline",
+//	  "project_id": 2, // optional
+//	  "task_id": 15    // optional
+//	}
+func (h *OvertimeHandler) Create(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.OvertimeCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := h.Service.Create(c.Request.Context(), ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// ListEmployee retrieves the caller's own overtime requests.
+//
+// Query Parameters:
+// - status: Filter by status (pending, approved, rejected, cancelled)
+// - month: Filter by month (YYYY-MM)
+// - page, limit: Offset pagination (default: 1, 10)
+func (h *OvertimeHandler) ListEmployee(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var query dtos.OvertimeListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+	query.EmployeeID = ids["employee_id"]
+
+	response, err := h.Service.List(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListAdmin retrieves overtime requests of the caller's organization.
+//
+// Query Parameters:
+// - employee_id: Filter by employee
+// - status: Filter by status (pending, approved, rejected, cancelled)
+// - month: Filter by month (YYYY-MM)
+// - page, limit: Offset pagination (default: 1, 10)
+func (h *OvertimeHandler) ListAdmin(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.OvertimeListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+
+	response, err := h.Service.List(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *OvertimeHandler) Cancel(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid overtime request ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	cancelled, err := h.Service.Cancel(c.Request.Context(), id, ids["org_id"], ids["employee_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, cancelled)
+}
+
+func (h *OvertimeHandler) Approve(c *gin.Context) {
+	h.review(c, h.Service.Approve)
+}
+
+func (h *OvertimeHandler) Reject(c *gin.Context) {
+	h.review(c, h.Service.Reject)
+}
+
+func (h *OvertimeHandler) review(c *gin.Context, fn func(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.OvertimeRequest, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid overtime request ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.OvertimeReviewInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validator.New().Struct(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	reviewed, err := fn(c.Request.Context(), id, ids["org_id"], ids["employee_id"], input.Comment)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, reviewed)
+}
+
+// Export downloads the approved overtime of a month as CSV for payroll.
+//
+// Query Parameters:
+// - month: Required month (YYYY-MM)
+// - department_id: Only this department - default: whole organization
+func (h *OvertimeHandler) Export(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	month, err := time.ParseInLocation("2006-01", c.Query("month"), time.Local)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or missing month, must be YYYY-MM"})
+		return
+	}
+	departmentID := 0
+	if v := c.Query("department_id"); v != "" {
+		departmentID, err = strconv.Atoi(v)
+		if err != nil || departmentID <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department_id"})
+			return
+		}
+	}
+
+	rows, err := h.Service.PayrollExport(c.Request.Context(), ids["org_id"], departmentID, month)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	filename := fmt.Sprintf("overtime-%s.csv", month.Format("2006-01"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	if err := csv.NewWriter(c.Writer).WriteAll(rows); err != nil {
+		c.Error(err)
+	}
+}
+
+func (h *OvertimeHandler) GetWeekend(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	weekend, err := h.Service.GetWeekend(c.Request.Context(), ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"weekend_days": weekend})
+}
+
+// SetWeekend replaces the weekdays treated as weekend.
+//
+// Request body:
+//
+//	{
+//	  "weekend_days": [0] // 0 = Sunday ... 6 = Saturday; [] restores Saturday and Sunday
+//	}
+func (h *OvertimeHandler) SetWeekend(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.WeekendUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	weekend, err := h.Service.SetWeekend(c.Request.Context(), ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"weekend_days": weekend})
+}
+
+// ListHolidays retrieves the organization's holidays.
+//
+// Query Parameters:
+// - year: default: current year
+func (h *OvertimeHandler) ListHolidays(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	year := time.Now().Year()
+	if v := c.Query("year"); v != "" {
+		year, err = strconv.Atoi(v)
+		if err != nil || year < 1970 || year > 9999 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid year"})
+			return
+		}
+	}
+
+	holidays, err := h.Service.ListHolidays(c.Request.Context(), ids["org_id"], year)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, holidays)
+}
+
+func (h *OvertimeHandler) CreateHoliday(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.HolidayCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := h.Service.CreateHoliday(c.Request.Context(), ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+func (h *OvertimeHandler) DeleteHoliday(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid holiday ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	if err := h.Service.DeleteHoliday(c.Request.Context(), id, ids["org_id"]); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *OvertimeHandler) respondError(c *gin.Context, err error) {
+	if serviceErr, ok := err.(*overtime.ServiceError); ok {
+		c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+}
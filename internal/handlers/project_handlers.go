@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"encoding/csv"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
 
 	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
 	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 	"github.com/longgggwwww/hrm-ms-hr/internal/grpc_clients"
@@ -38,6 +41,8 @@ func (h *ProjectHandler) RegisterRoutes(r *gin.Engine) {
 		})
 		projs.GET("/", h.List)
 		projs.GET("/:id", h.Get)
+		projs.GET("/:id/dependency-graph", h.DependencyGraph)
+		projs.GET("/:id/time-export", h.TimeExport)
 		projs.PATCH("/:id", func(c *gin.Context) {
 			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
 				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -73,6 +78,27 @@ func (h *ProjectHandler) RegisterRoutes(r *gin.Engine) {
 					h.RemoveMembers(c)
 				})).ServeHTTP(c.Writer, c.Request)
 		})
+		projs.GET("/:id/members", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListMembers(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		projs.PATCH("/:id/members/:employee_id/role", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.SetMemberRole(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		projs.POST("/:id/transfer-ownership", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.TransferOwnership(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
 	}
 }
 
@@ -438,3 +464,186 @@ func (h *ProjectHandler) RemoveMembers(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// ListMembers returns the members of the project with their roles: owner,
+// manager, member or viewer
+func (h *ProjectHandler) ListMembers(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	members, err := h.Service.ListMembers(c.Request.Context(), id, ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": members})
+}
+
+// SetMemberRole changes the role of a project member. Only owners change
+// roles, and the last owner cannot step down.
+func (h *ProjectHandler) SetMemberRole(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+	memberID, err := strconv.Atoi(c.Param("employee_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
+		return
+	}
+
+	var input dtos.ProjectMemberRoleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	members, err := h.Service.SetMemberRole(c.Request.Context(), id, ids["employee_id"], ids["org_id"], memberID, projectrole.Role(input.Role))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": members})
+}
+
+// TransferOwnership hands the project over to another member. The caller
+// must own it and stays on as a manager.
+func (h *ProjectHandler) TransferOwnership(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var input dtos.ProjectTransferOwnershipInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	members, err := h.Service.TransferOwnership(c.Request.Context(), id, ids["employee_id"], ids["org_id"], input.EmployeeID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": members})
+}
+
+func (h *ProjectHandler) respondError(c *gin.Context, err error) {
+	if serviceErr, ok := err.(*project.ServiceError); ok {
+		c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"error": "Internal server error: " + err.Error(),
+	})
+}
+
+// DependencyGraph returns the project's tasks, the links between them and the
+// critical path through its blocking links
+func (h *ProjectHandler) DependencyGraph(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	response, err := h.Service.DependencyGraph(c.Request.Context(), id)
+	if err != nil {
+		if serviceErr, ok := err.(*project.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Internal server error: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// TimeExport downloads the time logged on the project as CSV. Only project
+// owners and managers can export.
+//
+// Query Parameters:
+// - from, to: Date range of the work logs (YYYY-MM-DD)
+// - format: logs (default, one row per work log) or summary (estimate versus actual per task)
+func (h *ProjectHandler) TimeExport(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var query dtos.ProjectTimeExportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	rows, err := h.Service.TimeExport(c.Request.Context(), id, ids["employee_id"], query)
+	if err != nil {
+		if serviceErr, ok := err.(*project.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Internal server error: " + err.Error(),
+		})
+		return
+	}
+
+	format := query.Format
+	if format == "" {
+		format = "logs"
+	}
+	filename := fmt.Sprintf("project-%d-time-%s.csv", id, format)
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	if err := csv.NewWriter(c.Writer).WriteAll(rows); err != nil {
+		c.Error(err)
+	}
+}
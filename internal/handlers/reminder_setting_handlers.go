@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	taskService "github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type ReminderSettingHandler struct {
+	TaskService *taskService.TaskService
+}
+
+func NewReminderSettingHandler(client *ent.Client) *ReminderSettingHandler {
+	return &ReminderSettingHandler{
+		TaskService: taskService.NewTaskService(client),
+	}
+}
+
+func (h *ReminderSettingHandler) RegisterRoutes(r *gin.Engine) {
+	r.GET("/reminder-settings", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.OrgRead},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.Get(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.PUT("/reminder-settings", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.OrgUpdate},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.Update(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+}
+
+// Get returns the task reminder schedule of the caller's organization: whether
+// assignees are reminded of tasks coming due and how many hours ahead, and
+// whether overdue tasks are reminded and how often
+func (h *ReminderSettingHandler) Get(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	setting, err := h.TaskService.ReminderSettings(c.Request.Context(), ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, setting)
+}
+
+// Update changes the task reminder schedule of the caller's organization;
+// fields left out keep their value
+func (h *ReminderSettingHandler) Update(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var req dtos.ReminderSettingsInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	setting, err := h.TaskService.UpdateReminderSettings(c.Request.Context(), ids["org_id"], req)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, setting)
+}
+
+func (h *ReminderSettingHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*taskService.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
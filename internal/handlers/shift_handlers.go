@@ -0,0 +1,554 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/shift"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type ShiftHandler struct {
+	Service *shift.ShiftService
+}
+
+func NewShiftHandler(client *ent.Client) *ShiftHandler {
+	return &ShiftHandler{
+		Service: shift.NewShiftService(client),
+	}
+}
+
+func (h *ShiftHandler) RegisterRoutes(r *gin.Engine) {
+	shifts := r.Group("/shifts")
+	{
+		shifts.GET("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListShifts(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		shifts.GET(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.GetShift(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		shifts.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftCreate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateShift(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		shifts.PATCH(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.UpdateShift(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		shifts.DELETE(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftDelete},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.DeleteShift(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
+	rosters := r.Group("/rosters")
+	{
+		// Employee routes
+		rosters.GET("/employee", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.RosterReadEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListRosterEmployee(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		// Admin routes
+		rosters.GET("/admin", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.RosterReadAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListRosterAdmin(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		rosters.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.RosterManageAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Assign(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		rosters.DELETE(":id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.RosterManageAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Unassign(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
+	swaps := r.Group("/shift-swaps")
+	{
+		// Employee routes
+		swaps.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftSwapCreateEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateSwap(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		swaps.GET("/employee", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftSwapReadEmployee},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListSwapsEmployee(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		// Admin routes
+		swaps.GET("/admin", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftSwapReadAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListSwapsAdmin(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		swaps.PATCH(":id/approve", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftSwapApproveAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ApproveSwap(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+		swaps.PATCH(":id/reject", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ShiftSwapApproveAdmin},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.RejectSwap(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+func (h *ShiftHandler) ListShifts(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	shifts, err := h.Service.ListShifts(c.Request.Context(), ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, shifts)
+}
+
+func (h *ShiftHandler) GetShift(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid shift ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	found, err := h.Service.GetShift(c.Request.Context(), id, ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, found)
+}
+
+// CreateShift creates a shift template.
+//
+// Request body:
+//
+//	{
+//	  "code": "N1",
+//	  "name": "Night shift",
+//	  "start_time": "22:00",
+//	  "end_time": "06:00", // earlier than start_time: ends the next day
+//	  "break_minutes": 30,
+//	  "break_paid": false,
+//	  "is_night": true
+//	}
+func (h *ShiftHandler) CreateShift(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.ShiftCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := h.Service.CreateShift(c.Request.Context(), ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+func (h *ShiftHandler) UpdateShift(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid shift ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.ShiftUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updated, err := h.Service.UpdateShift(c.Request.Context(), id, ids["org_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+func (h *ShiftHandler) DeleteShift(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid shift ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	if err := h.Service.DeleteShift(c.Request.Context(), id, ids["org_id"]); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// Assign rosters an employee on a shift for one or more days.
+//
+// Request body:
+//
+//	{
+//	  "employee_id": 12,
+//	  "shift_id": 3,
+//	  "work_dates": ["2025-07-01", "2025-07-02"],
+//	  "note": "..."
+//	}
+func (h *ShiftHandler) Assign(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.RosterAssignInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rosters, err := h.Service.Assign(c.Request.Context(), ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, rosters)
+}
+
+func (h *ShiftHandler) Unassign(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid roster ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	if err := h.Service.Unassign(c.Request.Context(), id, ids["org_id"]); err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// ListRosterEmployee retrieves the caller's own roster.
+//
+// Query Parameters:
+// - from, to: Required date range (YYYY-MM-DD)
+// - format: csv or ics to download instead of JSON
+func (h *ShiftHandler) ListRosterEmployee(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var query dtos.RosterListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+	query.EmployeeID = ids["employee_id"]
+	query.DepartmentID = 0
+
+	h.listRoster(c, query)
+}
+
+// ListRosterAdmin retrieves the roster of the caller's organization.
+//
+// Query Parameters:
+// - from, to: Required date range (YYYY-MM-DD)
+// - employee_id, shift_id, department_id: Filter by the linked entity
+// - format: csv or ics to download instead of JSON
+func (h *ShiftHandler) ListRosterAdmin(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.RosterListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+
+	h.listRoster(c, query)
+}
+
+func (h *ShiftHandler) listRoster(c *gin.Context, query dtos.RosterListQuery) {
+	rosters, err := h.Service.ListRoster(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	filename := fmt.Sprintf("roster-%s-%s", query.From, query.To)
+	switch query.Format {
+	case "csv":
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".csv"))
+		c.Status(http.StatusOK)
+		if err := csv.NewWriter(c.Writer).WriteAll(shift.RosterCSV(rosters)); err != nil {
+			c.Error(err)
+		}
+	case "ics":
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".ics"))
+		c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(shift.RosterICS(rosters)))
+	default:
+		c.JSON(http.StatusOK, gin.H{"data": rosters})
+	}
+}
+
+// CreateSwap asks to swap one of the caller's rostered shifts with a colleague's.
+//
+// Request body:
+//
+//	{
+//	  "roster_id": 10,
+//	  "target_roster_id": 14,
+//	  "reason": "..."
+//	}
+func (h *ShiftHandler) CreateSwap(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.ShiftSwapCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	swap, err := h.Service.CreateSwap(c.Request.Context(), ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, swap)
+}
+
+// ListSwapsEmployee retrieves swap requests made by or targeting the caller.
+func (h *ShiftHandler) ListSwapsEmployee(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var query dtos.ShiftSwapListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+	query.EmployeeID = ids["employee_id"]
+
+	response, err := h.Service.ListSwaps(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListSwapsAdmin retrieves swap requests of the caller's organization.
+//
+// Query Parameters:
+// - status: Filter by status (pending, approved, rejected)
+// - page, limit: Offset pagination (default: 1, 10)
+func (h *ShiftHandler) ListSwapsAdmin(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.ShiftSwapListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.OrgID = ids["org_id"]
+
+	response, err := h.Service.ListSwaps(c.Request.Context(), query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ShiftHandler) ApproveSwap(c *gin.Context) {
+	h.reviewSwap(c, h.Service.ApproveSwap)
+}
+
+func (h *ShiftHandler) RejectSwap(c *gin.Context) {
+	h.reviewSwap(c, h.Service.RejectSwap)
+}
+
+func (h *ShiftHandler) reviewSwap(c *gin.Context, fn func(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.ShiftSwapRequest, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid shift swap request ID"})
+		return
+	}
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+
+	var input dtos.ShiftSwapReviewInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validator.New().Struct(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	swap, err := fn(c.Request.Context(), id, ids["org_id"], ids["employee_id"], input.Comment)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, swap)
+}
+
+func (h *ShiftHandler) respondError(c *gin.Context, err error) {
+	if serviceErr, ok := err.(*shift.ServiceError); ok {
+		c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+}
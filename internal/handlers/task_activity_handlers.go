@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	taskService "github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type TaskActivityHandler struct {
+	TaskService *taskService.TaskService
+}
+
+func NewTaskActivityHandler(client *ent.Client) *TaskActivityHandler {
+	return &TaskActivityHandler{
+		TaskService: taskService.NewTaskService(client),
+	}
+}
+
+func (h *TaskActivityHandler) RegisterRoutes(r *gin.Engine) {
+	r.GET("/tasks/:id/activity", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.TaskRead},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.ListTask(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.GET("/projects/:id/activity", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.TaskRead},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.ListProject(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+}
+
+// ListTask returns the activity stream of a task, newest first: field and
+// status changes, assignees and labels added or removed, comments and reports,
+// each with its actor and time.
+//
+// Query Parameters:
+// - kind: only one kind of entry, e.g. status_changed
+// - cursor, limit: cursor pagination (default limit 20, max 100)
+func (h *TaskActivityHandler) ListTask(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	query, ok := h.bindQuery(c)
+	if !ok {
+		return
+	}
+	response, err := h.TaskService.ListActivity(c.Request.Context(), ids["org_id"], id, query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// ListProject returns the activity of all tasks of a project, newest first,
+// with the same query parameters as ListTask.
+func (h *TaskActivityHandler) ListProject(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	query, ok := h.bindQuery(c)
+	if !ok {
+		return
+	}
+	response, err := h.TaskService.ProjectActivity(c.Request.Context(), ids["org_id"], id, query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TaskActivityHandler) bindQuery(c *gin.Context) (dtos.TaskActivityListQuery, bool) {
+	var query dtos.TaskActivityListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return query, false
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return query, false
+	}
+	return query, true
+}
+
+func (h *TaskActivityHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*taskService.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
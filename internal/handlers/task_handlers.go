@@ -5,7 +5,11 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
 	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 	"github.com/longgggwwww/hrm-ms-hr/internal/kafka"
 	taskService "github.com/longgggwwww/hrm-ms-hr/internal/services/task"
@@ -36,16 +40,210 @@ func NewTaskHandlerWithKafka(client *ent.Client, kafkaClient *kafka.KafkaClient)
 }
 
 func (h *TaskHandler) RegisterRoutes(r *gin.Engine) {
-	tasks := r.Group("tasks")
+	tasks := r.Group("/tasks")
+	{
+		tasks.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskCreate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Create(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.GET("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.List(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.GET("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.PATCH("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Update(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.DELETE("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskDelete},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Delete(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.DELETE("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskDelete},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.BulkDelete(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.PATCH("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.BulkUpdate(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.PATCH("/:id/receive", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ReceiveTask(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.PATCH("/:id/progress", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.UpdateProgress(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.POST("/:id/move", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Move(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.GET("/:id/dependencies", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListDependencies(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.POST("/:id/dependencies", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateDependency(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.DELETE("/:id/dependencies/:dependency_id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.DeleteDependency(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.GET("/:id/comments", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListComments(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.POST("/:id/comments", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateComment(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.PATCH("/:id/comments/:comment_id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.UpdateComment(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.DELETE("/:id/comments/:comment_id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.DeleteComment(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.GET("/:id/comments/:comment_id/revisions", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListCommentRevisions(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.GET("/:id/work-logs", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListWorkLogs(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.POST("/:id/work-logs", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.LogWork(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.DELETE("/:id/work-logs/:work_log_id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.DeleteWorkLog(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.POST("/:id/timer/start", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.StartTimer(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.POST("/:id/timer/stop", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.StopTimer(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		tasks.GET("/:id/time-tracking", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.TimeTracking(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
+	workLogs := r.Group("/work-logs")
 	{
-		tasks.POST("", h.Create)
-		tasks.GET("", h.List)
-		tasks.GET(":id", h.Get)
-		tasks.PATCH(":id", h.Update)
-		tasks.DELETE(":id", h.Delete)
-		tasks.DELETE("", h.BulkDelete)
-		tasks.PATCH(":id/receive", h.ReceiveTask)
-		tasks.PATCH(":id/progress", h.UpdateProgress)
+		workLogs.GET("/weekly", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.WeeklyTime(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
 	}
 }
 
@@ -58,15 +256,14 @@ func (h *TaskHandler) Create(c *gin.Context) {
 
 	// Extract user ID and employee ID from JWT token
 	ids, err := utils.ExtractIDsFromToken(c)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
 		return
 	}
-	userID := ids["user_id"]
-	employeeID := ids["employee_id"]
+	actor := taskService.Actor{UserID: ids["user_id"], EmployeeID: ids["employee_id"], OrgID: ids["org_id"]}
 
 	// Call the task service to create the task
-	task, err := h.TaskService.Create(c.Request.Context(), userID, employeeID, req)
+	task, err := h.TaskService.Create(c.Request.Context(), actor, req)
 	if err != nil {
 		// Check if it's a ServiceError
 		if serviceErr, ok := err.(*taskService.ServiceError); ok {
@@ -89,6 +286,16 @@ func (h *TaskHandler) Create(c *gin.Context) {
 // - type: Filter by type (task, feature, bug, another)
 // - project_id: Filter by project ID
 // - creator_id: Filter by creator ID
+// - parent_id: Filter by parent task ID (0 for top-level tasks only)
+// - assignee_ids: Comma-separated employee IDs
+// - assignee_mode: any (default), tasks assigned to any of them, or all
+// - label_ids: Comma-separated label IDs
+// - label_mode: any (default), tasks with any of the labels, or all
+// - unassigned: true for tasks without assignees
+// - mine: true for tasks assigned to the caller
+// - overdue: true for tasks past their due date that are not completed or cancelled
+// - updated_since: Tasks changed since this time (RFC3339), for incremental sync with order_by=updated_at&order_dir=asc
+// - view: flat (default) or tree, which nests subtasks under each top-level row
 // - process: Filter by process percentage
 // - start_date_from: Filter tasks that start from this date (RFC3339 format)
 // - start_date_to: Filter tasks that start before this date (RFC3339 format)
@@ -96,17 +303,32 @@ func (h *TaskHandler) Create(c *gin.Context) {
 // - due_date_to: Filter tasks with due date before this date (RFC3339 format)
 // - order_by: Sort field (id, name, code, status, type, process, project_id, creator_id, start_at, due_date, created_at, updated_at)
 // - order_dir: Sort direction (asc, desc) - default: desc
+// - pagination_type: page (default) or cursor
 // - page: Page number (default: 1)
 // - limit: Items per page (default: 10, max: 100)
+// - cursor: Cursor returned as next_cursor by the previous page, for the same order
+// - cursor_limit: Items per page in cursor mode (default: 10, max: 100)
 //
 // Example: GET /tasks?name=example&status=in_progress&type=feature&order_by=name&order_dir=asc&page=1&limit=20
+// Example: GET /tasks?pagination_type=cursor&order_by=due_date&order_dir=asc&cursor_limit=50
 func (h *TaskHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
 	// Parse query parameters
 	var query dtos.TaskListQuery
 	if err := c.ShouldBindQuery(&query); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	query.EmployeeID = ids["employee_id"]
 
 	// Set default values for pagination
 	if query.Page == 0 {
@@ -123,7 +345,7 @@ func (h *TaskHandler) List(c *gin.Context) {
 	}
 
 	// Call service
-	response, err := h.TaskService.List(c.Request.Context(), query)
+	response, err := h.TaskService.List(c.Request.Context(), ids["org_id"], query)
 	if err != nil {
 		if serviceErr, ok := err.(*taskService.ServiceError); ok {
 			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
@@ -143,8 +365,14 @@ func (h *TaskHandler) Get(c *gin.Context) {
 		return
 	}
 
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
 	// Call service
-	task, err := h.TaskService.Get(c.Request.Context(), id)
+	task, err := h.TaskService.Get(c.Request.Context(), ids["org_id"], id)
 	if err != nil {
 		if serviceErr, ok := err.(*taskService.ServiceError); ok {
 			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
@@ -172,14 +400,14 @@ func (h *TaskHandler) Update(c *gin.Context) {
 
 	// Extract user ID from JWT token
 	ids, err := utils.ExtractIDsFromToken(c)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
 		return
 	}
-	userID := ids["user_id"]
+	actor := taskService.Actor{UserID: ids["user_id"], EmployeeID: ids["employee_id"], OrgID: ids["org_id"]}
 
 	// Call service
-	updatedTask, err := h.TaskService.Update(c.Request.Context(), id, userID, req)
+	updatedTask, err := h.TaskService.Update(c.Request.Context(), id, actor, req)
 	if err != nil {
 		if serviceErr, ok := err.(*taskService.ServiceError); ok {
 			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
@@ -192,6 +420,8 @@ func (h *TaskHandler) Update(c *gin.Context) {
 	c.JSON(http.StatusOK, updatedTask)
 }
 
+// Delete removes a task. Tasks with subtasks are refused unless the
+// cascade=true query parameter is given, which deletes the whole subtree.
 func (h *TaskHandler) Delete(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
@@ -199,8 +429,24 @@ func (h *TaskHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	cascade := false
+	if v := c.Query("cascade"); v != "" {
+		cascade, err = strconv.ParseBool(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid cascade value"})
+			return
+		}
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
 	// Call service
-	err = h.TaskService.Delete(c.Request.Context(), id)
+	actor := taskService.Actor{UserID: ids["user_id"], EmployeeID: ids["employee_id"], OrgID: ids["org_id"]}
+	err = h.TaskService.Delete(c.Request.Context(), actor, id, cascade)
 	if err != nil {
 		if serviceErr, ok := err.(*taskService.ServiceError); ok {
 			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
@@ -218,7 +464,8 @@ func (h *TaskHandler) Delete(c *gin.Context) {
 // Request body should contain:
 //
 //	{
-//	  "ids": [1, 2, 3, 4, 5]
+//	  "ids": [1, 2, 3, 4, 5],
+//	  "cascade": false // optional, also delete subtasks
 //	}
 //
 // Response will include:
@@ -232,8 +479,15 @@ func (h *TaskHandler) BulkDelete(c *gin.Context) {
 		return
 	}
 
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
 	// Call service
-	response, err := h.TaskService.BulkDelete(c.Request.Context(), req)
+	actor := taskService.Actor{UserID: ids["user_id"], EmployeeID: ids["employee_id"], OrgID: ids["org_id"]}
+	response, err := h.TaskService.BulkDelete(c.Request.Context(), actor, req)
 	if err != nil {
 		if serviceErr, ok := err.(*taskService.ServiceError); ok {
 			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
@@ -253,6 +507,71 @@ func (h *TaskHandler) BulkDelete(c *gin.Context) {
 	}
 }
 
+// BulkUpdate applies the same change to many tasks. Tasks are listed by ids in
+// the body or, without ids, selected by the filters of GET /tasks in the query
+// string (at most 100 tasks either way).
+//
+// Request body:
+//
+//	{
+//	  "ids": [1, 2, 3],
+//	  "add_assignee_ids": [7],
+//	  "remove_assignee_ids": [4],
+//	  "add_label_ids": [2],
+//	  "remove_label_ids": [5],
+//	  "project_id": 3,
+//	  "due_date": "2025-07-01T17:00:00Z", // "" clears the due date
+//	  "status": "in_progress",
+//	  "dry_run": true // optional, preview without saving
+//	}
+//
+// Every task is reported as updated, unchanged or failed. Changes are saved all
+// together: when a task fails nothing is saved and 409 is returned.
+func (h *TaskHandler) BulkUpdate(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	actor := taskService.Actor{UserID: ids["user_id"], EmployeeID: ids["employee_id"], OrgID: ids["org_id"]}
+
+	var req dtos.TaskBulkUpdateInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var filter dtos.TaskListQuery
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter.EmployeeID = ids["employee_id"]
+
+	response, err := h.TaskService.BulkUpdate(c.Request.Context(), actor, req, filter)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	if response.FailedCount > 0 {
+		c.JSON(http.StatusConflict, response)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
 // ReceiveTask allows an assigned employee to receive/accept a task.
 // Only employees who are assigned to the task can receive it.
 // This changes the task status from "not_received" to "received".
@@ -265,14 +584,14 @@ func (h *TaskHandler) ReceiveTask(c *gin.Context) {
 
 	// Extract user ID from JWT token
 	ids, err := utils.ExtractIDsFromToken(c)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
 		return
 	}
-	userID := ids["user_id"]
+	actor := taskService.Actor{UserID: ids["user_id"], EmployeeID: ids["employee_id"], OrgID: ids["org_id"]}
 
 	// Call service
-	taskWithEdges, err := h.TaskService.ReceiveTask(c.Request.Context(), id, userID)
+	taskWithEdges, err := h.TaskService.ReceiveTask(c.Request.Context(), id, actor)
 	if err != nil {
 		if serviceErr, ok := err.(*taskService.ServiceError); ok {
 			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
@@ -294,7 +613,7 @@ func (h *TaskHandler) ReceiveTask(c *gin.Context) {
 // Request body:
 //
 //	{
-//	  "status": "in_progress|completed|cancelled", // optional
+//	  "status": "in_progress", // optional, a workflow status key
 //	  "process": 50 // optional, percentage (0-100)
 //	}
 func (h *TaskHandler) UpdateProgress(c *gin.Context) {
@@ -306,11 +625,11 @@ func (h *TaskHandler) UpdateProgress(c *gin.Context) {
 
 	// Extract user ID from JWT token
 	ids, err := utils.ExtractIDsFromToken(c)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
 		return
 	}
-	userID := ids["user_id"]
+	actor := taskService.Actor{UserID: ids["user_id"], EmployeeID: ids["employee_id"], OrgID: ids["org_id"]}
 
 	var req dtos.TaskUpdateProgressInput
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -319,7 +638,7 @@ func (h *TaskHandler) UpdateProgress(c *gin.Context) {
 	}
 
 	// Call service
-	taskWithEdges, err := h.TaskService.UpdateProgress(c.Request.Context(), id, userID, req)
+	taskWithEdges, err := h.TaskService.UpdateProgress(c.Request.Context(), id, actor, req)
 	if err != nil {
 		if serviceErr, ok := err.(*taskService.ServiceError); ok {
 			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
@@ -334,3 +653,587 @@ func (h *TaskHandler) UpdateProgress(c *gin.Context) {
 		"task":    taskWithEdges,
 	})
 }
+
+// CreateDependency links the task to another task.
+//
+// Request body:
+//
+//	{
+//	  "target_id": 12,
+//	  "type": "blocks|relates_to|duplicates" // optional, default blocks
+//	}
+//
+// A "blocks" link means the task must be completed before the target can start.
+func (h *TaskHandler) CreateDependency(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req dtos.TaskDependencyCreateInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Extract user ID from JWT token
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	userID := ids["user_id"]
+
+	dependency, err := h.TaskService.CreateDependency(c.Request.Context(), ids["org_id"], id, userID, req)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dependency)
+}
+
+// ListDependencies returns the links of a task: "dependencies" start at the
+// task and "dependents" point at it.
+func (h *TaskHandler) ListDependencies(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	response, err := h.TaskService.ListDependencies(c.Request.Context(), ids["org_id"], id)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteDependency removes a link of the task
+func (h *TaskHandler) DeleteDependency(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+	dependencyID, err := strconv.Atoi(c.Param("dependency_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid dependency ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	if err := h.TaskService.DeleteDependency(c.Request.Context(), ids["org_id"], id, dependencyID); err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// CreateComment adds a comment to the task as the current employee.
+//
+// Request body:
+//
+//	{
+//	  "content": "Please check with @NV001",
+//	  "parent_id": 3 // optional, replies to a comment
+//	}
+func (h *TaskHandler) CreateComment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req dtos.TaskCommentCreateInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	comment, err := h.TaskService.CreateComment(c.Request.Context(), ids["org_id"], id, ids["employee_id"], req)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+// ListComments returns the comment threads of the task with cursor pagination.
+//
+// Query Parameters:
+// - cursor: Cursor returned by the previous page
+// - limit: Threads per page (default: 10, max: 100)
+func (h *TaskHandler) ListComments(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var query dtos.TaskCommentListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	response, err := h.TaskService.ListComments(c.Request.Context(), ids["org_id"], id, query)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateComment edits a comment written by the current employee
+func (h *TaskHandler) UpdateComment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+	commentID, err := strconv.Atoi(c.Param("comment_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
+	}
+
+	var req dtos.TaskCommentUpdateInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	comment, err := h.TaskService.UpdateComment(c.Request.Context(), ids["org_id"], id, commentID, ids["employee_id"], req)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+// DeleteComment soft-deletes a comment written by the current employee
+func (h *TaskHandler) DeleteComment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+	commentID, err := strconv.Atoi(c.Param("comment_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	if err := h.TaskService.DeleteComment(c.Request.Context(), ids["org_id"], id, commentID, ids["employee_id"]); err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// ListCommentRevisions returns the edit history of a comment
+func (h *TaskHandler) ListCommentRevisions(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+	commentID, err := strconv.Atoi(c.Param("comment_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	revisions, err := h.TaskService.ListCommentRevisions(c.Request.Context(), ids["org_id"], id, commentID)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": revisions})
+}
+
+// LogWork records time the current employee spent on the task. Only assignees
+// can log time.
+//
+// Request body:
+//
+//	{
+//	  "started_at": "2025-01-06T09:00:00+07:00",
+//	  "duration": 90, // minutes
+//	  "note": "Investigated the login bug", // optional
+//	  "remaining_estimate": 120 // optional, minutes
+//	}
+func (h *TaskHandler) LogWork(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req dtos.WorkLogCreateInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	workLog, err := h.TaskService.LogWork(c.Request.Context(), ids["org_id"], id, ids["employee_id"], req)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, workLog)
+}
+
+// ListWorkLogs returns the time logged on the task, newest first
+func (h *TaskHandler) ListWorkLogs(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	workLogs, err := h.TaskService.ListWorkLogs(c.Request.Context(), ids["org_id"], id)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": workLogs})
+}
+
+// DeleteWorkLog removes a work log of the current employee
+func (h *TaskHandler) DeleteWorkLog(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+	workLogID, err := strconv.Atoi(c.Param("work_log_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid work log ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	if err := h.TaskService.DeleteWorkLog(c.Request.Context(), ids["org_id"], id, workLogID, ids["employee_id"]); err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// Move places a task on its project's board, changing its status and rank
+// together. Moves between columns follow the project workflow.
+//
+// Request body:
+//
+//	{
+//	  "status": "in_progress", // optional, the target column
+//	  "prev_id": 12, // optional, the task above
+//	  "next_id": 15 // optional, the task below
+//	}
+func (h *TaskHandler) Move(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req dtos.BoardMoveInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	actor := taskService.Actor{UserID: ids["user_id"], EmployeeID: ids["employee_id"], OrgID: ids["org_id"]}
+
+	movedTask, err := h.TaskService.Move(c.Request.Context(), id, actor, req)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, movedTask)
+}
+
+// StartTimer starts tracking the current employee's time on the task.
+//
+// Request body (optional):
+//
+//	{
+//	  "note": "Pairing on the release"
+//	}
+func (h *TaskHandler) StartTimer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	var req dtos.WorkLogTimerInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if err := validator.New().Struct(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	workLog, err := h.TaskService.StartTimer(c.Request.Context(), ids["org_id"], id, ids["employee_id"], req)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, workLog)
+}
+
+// StopTimer stops the current employee's timer on the task and logs the time
+func (h *TaskHandler) StopTimer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	workLog, err := h.TaskService.StopTimer(c.Request.Context(), ids["org_id"], id, ids["employee_id"])
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, workLog)
+}
+
+// TimeTracking compares the task's estimates with the time logged on it
+func (h *TaskHandler) TimeTracking(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	response, err := h.TaskService.TimeTracking(c.Request.Context(), ids["org_id"], id)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// WeeklyTime returns the minutes logged per week in the organization.
+//
+// Query Parameters:
+// - group_by: task, project or employee (default: employee)
+// - task_id, project_id, employee_id: Filter by task, project or employee
+// - from, to: Date range (YYYY-MM-DD, default: the last four weeks)
+func (h *TaskHandler) WeeklyTime(c *gin.Context) {
+	var query dtos.WorkLogWeeklyQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	response, err := h.TaskService.WeeklyTime(c.Request.Context(), ids["org_id"], query)
+	if err != nil {
+		if serviceErr, ok := err.(*taskService.ServiceError); ok {
+			c.JSON(serviceErr.Status, gin.H{"error": serviceErr.Msg})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
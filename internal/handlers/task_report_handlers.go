@@ -5,9 +5,11 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 	"github.com/longgggwwww/hrm-ms-hr/internal/services"
 	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
 )
@@ -23,6 +25,22 @@ func NewTaskReportHandler(client *ent.Client) *TaskReportHandler {
 func (h *TaskReportHandler) RegisterRoutes(r *gin.Engine) {
 	taskReports := r.Group("/task-reports")
 
+	taskReports.GET("", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.TaskReportRead},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.List(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	taskReports.GET(":id", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.TaskReportRead},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.Get(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
 	taskReports.POST("", func(c *gin.Context) {
 		middleware.AuthMiddleware([]string{constants.TaskReportCreate},
 			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -38,6 +56,14 @@ func (h *TaskReportHandler) RegisterRoutes(r *gin.Engine) {
 				h.Update(c)
 			})).ServeHTTP(c.Writer, c.Request)
 	})
+
+	taskReports.PATCH(":id/review", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.TaskReportReview},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.Review(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
 }
 
 func (h *TaskReportHandler) Create(c *gin.Context) {
@@ -47,18 +73,18 @@ func (h *TaskReportHandler) Create(c *gin.Context) {
 		return
 	}
 	reporterID := ids["employee_id"]
-	type Input struct {
-		TaskID  int    `json:"task_id" binding:"required"`
-		Content string `json:"content" binding:"required"`
-	}
-	var input Input
+	var input dtos.TaskReportCreateInput
 	if err := c.ShouldBindJSON(&input); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	report, err := services.CreateTaskReport(c.Request.Context(), h.Client, input.TaskID, reporterID, input.Content)
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	report, err := services.CreateTaskReport(c.Request.Context(), h.Client, reporterID, input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		h.respondError(c, err)
 		return
 	}
 	c.JSON(http.StatusCreated, report)
@@ -76,18 +102,113 @@ func (h *TaskReportHandler) Update(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task report ID"})
 		return
 	}
-	type Input struct {
-		Content string `json:"content" binding:"required"`
+	var input dtos.TaskReportUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	report, err := services.UpdateTaskReport(c.Request.Context(), h.Client, id, reporterID, input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// List returns the task reports of the organization.
+//
+// Query Parameters:
+// - task_id, reporter_id, project_id: Filter by task, reporter or project
+// - status: submitted, accepted or needs_changes
+// - from, to: Creation time range (RFC3339 format)
+// - page: Page number (default: 1)
+// - limit: Items per page (default: 10, max: 100)
+func (h *TaskReportHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	var query dtos.TaskReportListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	response, err := services.ListTaskReports(c.Request.Context(), h.Client, ids["org_id"], query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TaskReportHandler) Get(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task report ID"})
+		return
+	}
+	report, err := services.GetTaskReport(c.Request.Context(), h.Client, ids["org_id"], id)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// Review accepts a submitted report or sends it back to the reporter.
+//
+// Request body:
+//
+//	{
+//	  "status": "accepted|needs_changes",
+//	  "comment": "Looks good" // optional
+//	}
+func (h *TaskReportHandler) Review(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task report ID"})
+		return
 	}
-	var input Input
+	var input dtos.TaskReportReviewInput
 	if err := c.ShouldBindJSON(&input); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	report, err := services.UpdateTaskReport(c.Request.Context(), h.Client, id, reporterID, input.Content)
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	report, err := services.ReviewTaskReport(c.Request.Context(), h.Client, ids["org_id"], id, ids["employee_id"], input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		h.respondError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, report)
 }
+
+func (h *TaskReportHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*services.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
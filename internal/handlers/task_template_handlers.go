@@ -0,0 +1,442 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/kafka"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/recurrence"
+	taskService "github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type TaskTemplateHandler struct {
+	Service *recurrence.RecurrenceService
+}
+
+func NewTaskTemplateHandler(client *ent.Client, kafkaClient *kafka.KafkaClient) *TaskTemplateHandler {
+	taskSvc := taskService.NewTaskService(client)
+	taskSvc.SetKafkaClient(kafkaClient)
+
+	return &TaskTemplateHandler{
+		Service: recurrence.NewRecurrenceService(client, taskSvc),
+	}
+}
+
+func (h *TaskTemplateHandler) RegisterRoutes(r *gin.Engine) {
+	templates := r.Group("/task-templates")
+	{
+		templates.GET("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.List(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		templates.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskCreate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Create(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		templates.GET("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		templates.PATCH("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Update(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		templates.DELETE("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskDelete},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Delete(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		templates.POST("/:id/instantiate", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskCreate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Instantiate(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		templates.GET("/:id/recurrences", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListRecurrences(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		templates.POST("/:id/recurrences", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskCreate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.CreateRecurrence(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
+	recurrences := r.Group("/task-recurrences")
+	{
+		recurrences.PATCH("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.UpdateRecurrence(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		recurrences.DELETE("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskDelete},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.DeleteRecurrence(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		recurrences.GET("/:id/runs", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.ListRuns(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// List returns the task templates of the caller's organization.
+//
+// Query Parameters:
+// - project_id: only the templates of a project
+func (h *TaskTemplateHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.TaskTemplateListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rows, err := h.Service.ListTemplates(c.Request.Context(), ids["org_id"], query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": rows})
+}
+
+// Create creates a task template.
+//
+// Request body:
+//
+//	{
+//	  "name": "Payroll check {date}",
+//	  "project_id": 3,
+//	  "label_ids": [1],
+//	  "assignee_ids": [12],
+//	  "due_offset": 2880
+//	}
+func (h *TaskTemplateHandler) Create(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.TaskTemplateCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	row, err := h.Service.CreateTemplate(c.Request.Context(), ids["org_id"], ids["user_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, row)
+}
+
+func (h *TaskTemplateHandler) Get(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task template ID"})
+		return
+	}
+
+	row, err := h.Service.GetTemplate(c.Request.Context(), ids["org_id"], id)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+func (h *TaskTemplateHandler) Update(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task template ID"})
+		return
+	}
+
+	var input dtos.TaskTemplateUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	row, err := h.Service.UpdateTemplate(c.Request.Context(), ids["org_id"], id, input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+func (h *TaskTemplateHandler) Delete(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task template ID"})
+		return
+	}
+
+	if err := h.Service.DeleteTemplate(c.Request.Context(), ids["org_id"], id); err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Task template deleted successfully"})
+}
+
+// Instantiate creates a task from a template right away
+func (h *TaskTemplateHandler) Instantiate(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task template ID"})
+		return
+	}
+
+	var input dtos.TaskTemplateInstantiateInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	task, err := h.Service.Instantiate(c.Request.Context(), ids["org_id"], id, ids["user_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, task)
+}
+
+func (h *TaskTemplateHandler) ListRecurrences(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task template ID"})
+		return
+	}
+
+	rows, err := h.Service.ListRecurrences(c.Request.Context(), ids["org_id"], id)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": rows})
+}
+
+// CreateRecurrence schedules a template. Tasks are created on behalf of the
+// caller.
+//
+// Request body:
+//
+//	{
+//	  "rule": "FREQ=MONTHLY;BYMONTHDAY=-1",
+//	  "start_at": "2025-01-31T09:00:00+07:00",
+//	  "timezone": "Asia/Ho_Chi_Minh"
+//	}
+func (h *TaskTemplateHandler) CreateRecurrence(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 || ids["employee_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id/employee_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task template ID"})
+		return
+	}
+
+	var input dtos.TaskRecurrenceCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	row, err := h.Service.CreateRecurrence(c.Request.Context(), ids["org_id"], id, ids["user_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, row)
+}
+
+func (h *TaskTemplateHandler) UpdateRecurrence(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid recurrence ID"})
+		return
+	}
+
+	var input dtos.TaskRecurrenceUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	row, err := h.Service.UpdateRecurrence(c.Request.Context(), ids["org_id"], id, input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+func (h *TaskTemplateHandler) DeleteRecurrence(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid recurrence ID"})
+		return
+	}
+
+	if err := h.Service.DeleteRecurrence(c.Request.Context(), ids["org_id"], id); err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Recurrence deleted successfully"})
+}
+
+// ListRuns returns the latest runs of a recurrence, newest occurrence first.
+//
+// Query Parameters:
+// - status: pending, created or failed
+// - limit: at most 100, 50 by default
+func (h *TaskTemplateHandler) ListRuns(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid recurrence ID"})
+		return
+	}
+
+	var query dtos.TaskRecurrenceRunListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rows, err := h.Service.ListRuns(c.Request.Context(), ids["org_id"], id, query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": rows})
+}
+
+func (h *TaskTemplateHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*recurrence.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	taskService "github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/taskview"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type TaskViewHandler struct {
+	Service *taskview.TaskViewService
+}
+
+func NewTaskViewHandler(client *ent.Client) *TaskViewHandler {
+	return &TaskViewHandler{
+		Service: taskview.NewTaskViewService(client, taskService.NewTaskService(client)),
+	}
+}
+
+func (h *TaskViewHandler) RegisterRoutes(r *gin.Engine) {
+	views := r.Group("/task-views")
+	{
+		views.GET("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.List(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		views.POST("", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Create(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		views.GET("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		views.PATCH("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Update(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		views.DELETE("/:id", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Delete(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		views.GET("/:id/tasks", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.TaskRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Run(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// List returns the saved views the caller can see: their own and those shared
+// with the organization or with their projects.
+//
+// Query Parameters:
+// - project_id: only the views of a project
+// - mine: true to only list the caller's own views
+func (h *TaskViewHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var query dtos.TaskViewListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rows, err := h.Service.List(c.Request.Context(), ids["org_id"], ids["employee_id"], query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": rows})
+}
+
+// Create saves a task list filter as a view of the caller.
+//
+// Request body:
+//
+//	{
+//	  "name": "Open bugs due this week",
+//	  "visibility": "project",
+//	  "project_id": 3,
+//	  "filters": {"type": "bug", "status": "in_progress", "due_date_to": "today+7d"},
+//	  "timezone": "Asia/Ho_Chi_Minh"
+//	}
+func (h *TaskViewHandler) Create(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	var input dtos.TaskViewCreateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	row, err := h.Service.Create(c.Request.Context(), ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, row)
+}
+
+func (h *TaskViewHandler) Get(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task view ID"})
+		return
+	}
+
+	row, err := h.Service.Get(c.Request.Context(), ids["org_id"], ids["employee_id"], id)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+func (h *TaskViewHandler) Update(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task view ID"})
+		return
+	}
+
+	var input dtos.TaskViewUpdateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	row, err := h.Service.Update(c.Request.Context(), ids["org_id"], ids["employee_id"], id, input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+func (h *TaskViewHandler) Delete(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task view ID"})
+		return
+	}
+
+	if err := h.Service.Delete(c.Request.Context(), ids["org_id"], ids["employee_id"], id); err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Task view deleted successfully"})
+}
+
+// Run lists the tasks matching a saved view, like GET /tasks with its filters.
+//
+// Query Parameters:
+// - count_only: true to only return {"count": n}
+// - page, limit: offset pagination
+// - pagination_type=cursor, cursor, cursor_limit: cursor pagination
+func (h *TaskViewHandler) Run(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task view ID"})
+		return
+	}
+
+	var query dtos.TaskViewRunQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.Service.Run(c.Request.Context(), ids["org_id"], ids["employee_id"], id, query)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *TaskViewHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*taskview.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
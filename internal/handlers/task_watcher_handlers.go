@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	taskService "github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type TaskWatcherHandler struct {
+	TaskService *taskService.TaskService
+}
+
+func NewTaskWatcherHandler(client *ent.Client) *TaskWatcherHandler {
+	return &TaskWatcherHandler{
+		TaskService: taskService.NewTaskService(client),
+	}
+}
+
+func (h *TaskWatcherHandler) RegisterRoutes(r *gin.Engine) {
+	r.GET("/tasks/:id/watchers", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.TaskRead},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.List(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.PUT("/tasks/:id/watch", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.TaskRead},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.Watch(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+
+	r.DELETE("/tasks/:id/watch", func(c *gin.Context) {
+		middleware.AuthMiddleware([]string{constants.TaskRead},
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c.Request = r
+				h.Unwatch(c)
+			})).ServeHTTP(c.Writer, c.Request)
+	})
+}
+
+// List returns the employees watching a task. Creators, commenters and
+// assignees start watching automatically.
+func (h *TaskWatcherHandler) List(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	watchers, err := h.TaskService.ListWatchers(c.Request.Context(), ids["org_id"], id)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": watchers})
+}
+
+// Watch makes the caller a watcher of a task, so they are told of its status
+// changes. Watching a task twice is a no-op.
+func (h *TaskWatcherHandler) Watch(c *gin.Context) {
+	h.setWatching(c, true)
+}
+
+// Unwatch stops the caller watching a task
+func (h *TaskWatcherHandler) Unwatch(c *gin.Context) {
+	h.setWatching(c, false)
+}
+
+func (h *TaskWatcherHandler) setWatching(c *gin.Context, watching bool) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID"})
+		return
+	}
+
+	if err := h.TaskService.SetWatching(c.Request.Context(), ids["org_id"], id, ids["employee_id"], watching); err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"watching": watching})
+}
+
+func (h *TaskWatcherHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*taskService.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
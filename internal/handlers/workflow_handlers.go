@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/huynhthanhthao/hrm-ms-shared/middleware"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/constants"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+type WorkflowHandler struct {
+	Service *workflow.WorkflowService
+}
+
+func NewWorkflowHandler(client *ent.Client) *WorkflowHandler {
+	return &WorkflowHandler{
+		Service: workflow.NewWorkflowService(client),
+	}
+}
+
+func (h *WorkflowHandler) RegisterRoutes(r *gin.Engine) {
+	projects := r.Group("/projects")
+	{
+		projects.GET("/:id/workflow", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectRead},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Get(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		projects.PUT("/:id/workflow", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Replace(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+
+		projects.DELETE("/:id/workflow", func(c *gin.Context) {
+			middleware.AuthMiddleware([]string{constants.ProjectUpdate},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					c.Request = r
+					h.Reset(c)
+				})).ServeHTTP(c.Writer, c.Request)
+		})
+	}
+}
+
+// Get returns the workflow of a project: its statuses in board order and the
+// transitions allowed between them
+func (h *WorkflowHandler) Get(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	def, err := h.Service.Get(c.Request.Context(), id, ids["org_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, def)
+}
+
+// Replace sets the workflow of a project. Tasks in a status that is removed
+// are moved to the closest remaining status.
+//
+// Request body:
+//
+//	{
+//	  "statuses": [
+//	    {"key": "backlog", "name": "Backlog", "category": "todo"},
+//	    {"key": "review", "name": "In review", "category": "doing"},
+//	    {"key": "done", "name": "Done", "category": "done"}
+//	  ],
+//	  "transitions": [
+//	    {"from": "backlog", "to": "review"},
+//	    {"from": "review", "to": "done", "guards": ["creator_only"]}
+//	  ]
+//	}
+func (h *WorkflowHandler) Replace(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	var input dtos.WorkflowInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validator.New().Struct(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	def, err := h.Service.Replace(c.Request.Context(), id, ids["org_id"], ids["employee_id"], input)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, def)
+}
+
+// Reset makes a project use the default workflow again
+func (h *WorkflowHandler) Reset(c *gin.Context) {
+	ids, err := utils.ExtractIDsFromToken(c)
+	if err != nil || ids["org_id"] == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or missing org_id in token"})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid project ID"})
+		return
+	}
+
+	def, err := h.Service.Reset(c.Request.Context(), id, ids["org_id"], ids["employee_id"])
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, def)
+}
+
+func (h *WorkflowHandler) respondError(c *gin.Context, err error) {
+	if svcErr, ok := err.(*workflow.ServiceError); ok {
+		c.JSON(svcErr.Status, gin.H{"error": svcErr.Msg})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
@@ -144,3 +144,106 @@ func randomString(length int) string {
 	}
 	return string(result)
 }
+
+// CommentEventType represents the type of task comment event
+type CommentEventType string
+
+const (
+	CommentCreated   CommentEventType = "task.comment.created"
+	CommentMentioned CommentEventType = "task.comment.mentioned"
+)
+
+// TaskCommentEvent represents an event about a comment on a task. For mention
+// events MentionedIDs holds the employees to notify.
+type TaskCommentEvent struct {
+	EventID      string           `json:"event_id"`
+	EventType    CommentEventType `json:"event_type"`
+	Timestamp    time.Time        `json:"timestamp"`
+	Source       string           `json:"source"`
+	TaskID       int              `json:"task_id"`
+	TaskCode     string           `json:"task_code"`
+	TaskName     string           `json:"task_name"`
+	CommentID    int              `json:"comment_id"`
+	ParentID     *int             `json:"parent_id,omitempty"`
+	AuthorID     int              `json:"author_id"`
+	Content      string           `json:"content"`
+	MentionedIDs []int            `json:"mentioned_ids,omitempty"`
+	OrgID        int              `json:"org_id,omitempty"`
+}
+
+// NewTaskCommentEvent creates a new task comment event
+func NewTaskCommentEvent(eventType CommentEventType, task *ent.Task, comment *ent.TaskComment, mentionedIDs []int, orgID int) *TaskCommentEvent {
+	var parentID *int
+	if comment.ParentID != 0 {
+		parentID = &comment.ParentID
+	}
+
+	return &TaskCommentEvent{
+		EventID:      generateEventID(),
+		EventType:    eventType,
+		Timestamp:    time.Now(),
+		Source:       "hrm-ms-hr",
+		TaskID:       task.ID,
+		TaskCode:     task.Code,
+		TaskName:     task.Name,
+		CommentID:    comment.ID,
+		ParentID:     parentID,
+		AuthorID:     comment.AuthorID,
+		Content:      comment.Content,
+		MentionedIDs: mentionedIDs,
+		OrgID:        orgID,
+	}
+}
+
+// ReminderEventType represents the type of task reminder event
+type ReminderEventType string
+
+const (
+	TaskDueSoon ReminderEventType = "task.due_soon"
+	TaskOverdue ReminderEventType = "task.overdue"
+)
+
+// TaskReminderEvent represents a reminder about a task coming due or overdue.
+// Seq counts the repeated reminders of an overdue task, starting at 0.
+type TaskReminderEvent struct {
+	EventID     string            `json:"event_id"`
+	EventType   ReminderEventType `json:"event_type"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Source      string            `json:"source"`
+	TaskID      int               `json:"task_id"`
+	TaskCode    string            `json:"task_code"`
+	TaskName    string            `json:"task_name"`
+	ProjectID   *int              `json:"project_id,omitempty"`
+	DueDate     time.Time         `json:"due_date"`
+	Seq         int               `json:"seq"`
+	AssigneeIDs []int             `json:"assignee_ids,omitempty"`
+	OrgID       int               `json:"org_id,omitempty"`
+}
+
+// NewTaskReminderEvent creates a new task reminder event
+func NewTaskReminderEvent(eventType ReminderEventType, task *ent.Task, seq int) *TaskReminderEvent {
+	assigneeIDs := make([]int, 0)
+	for _, assignee := range task.Edges.Assignees {
+		assigneeIDs = append(assigneeIDs, assignee.ID)
+	}
+
+	var projectID *int
+	if task.ProjectID != 0 {
+		projectID = &task.ProjectID
+	}
+
+	return &TaskReminderEvent{
+		EventID:     generateEventID(),
+		EventType:   eventType,
+		Timestamp:   time.Now(),
+		Source:      "hrm-ms-hr",
+		TaskID:      task.ID,
+		TaskCode:    task.Code,
+		TaskName:    task.Name,
+		ProjectID:   projectID,
+		DueDate:     *task.DueDate,
+		Seq:         seq,
+		AssigneeIDs: assigneeIDs,
+		OrgID:       task.OrgID,
+	}
+}
@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// DefaultLockKey is the Postgres advisory lock the replicas of this service
+// elect their scheduler leader with
+const DefaultLockKey int64 = 7_202_048
+
+// Job is a periodic job run by the leader
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context, now time.Time) error
+}
+
+// Scheduler runs periodic jobs on a single replica.
+//
+// Every replica runs one, and on each tick tries to take a session-level
+// Postgres advisory lock on a dedicated connection. The replica holding the
+// lock is the leader and runs the jobs that are due; the others wait. The lock
+// goes with the connection, so when the leader dies or loses its connection
+// another replica takes over on its next tick. Jobs run one after another and
+// should be idempotent, since a new leader runs each of them right away.
+type Scheduler struct {
+	DB      *sql.DB
+	LockKey int64
+	Tick    time.Duration
+
+	jobs    []Job
+	lastRun map[string]time.Time
+	conn    *sql.Conn
+}
+
+// New creates a scheduler electing its leader through an advisory lock
+func New(db *sql.DB, lockKey int64, tick time.Duration) *Scheduler {
+	return &Scheduler{
+		DB:      db,
+		LockKey: lockKey,
+		Tick:    tick,
+		lastRun: make(map[string]time.Time),
+	}
+}
+
+// Register adds a job. Jobs must be registered before Run.
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Run ticks until the context is done, then gives up the leadership
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.Tick)
+	defer ticker.Stop()
+	defer s.resign()
+	for {
+		s.RunOnce(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs the due jobs if this replica is the leader
+func (s *Scheduler) RunOnce(ctx context.Context, now time.Time) {
+	if !s.lead(ctx) {
+		return
+	}
+	for _, job := range s.jobs {
+		if last, ok := s.lastRun[job.Name]; ok && now.Sub(last) < job.Interval {
+			continue
+		}
+		s.lastRun[job.Name] = now
+		if err := job.Run(ctx, now); err != nil {
+			log.Printf("Scheduled job %s failed: %v", job.Name, err)
+		}
+	}
+}
+
+// lead reports whether this replica holds the leader lock, trying to take it
+// when it does not
+func (s *Scheduler) lead(ctx context.Context) bool {
+	if s.conn != nil {
+		if err := s.conn.PingContext(ctx); err == nil {
+			return true
+		}
+		// The lock went with the connection
+		log.Printf("Scheduler lost its leader connection")
+		s.conn.Close()
+		s.conn = nil
+	}
+
+	conn, err := s.DB.Conn(ctx)
+	if err != nil {
+		log.Printf("Scheduler failed to get a connection: %v", err)
+		return false
+	}
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", s.LockKey).Scan(&locked); err != nil {
+		log.Printf("Scheduler failed to take the leader lock: %v", err)
+		conn.Close()
+		return false
+	}
+	if !locked {
+		conn.Close()
+		return false
+	}
+
+	log.Println("Scheduler became leader")
+	s.conn = conn
+	s.lastRun = make(map[string]time.Time)
+	return true
+}
+
+// resign releases the leader lock, if held
+func (s *Scheduler) resign() {
+	if s.conn == nil {
+		return
+	}
+	if _, err := s.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", s.LockKey); err != nil {
+		log.Printf("Scheduler failed to release the leader lock: %v", err)
+	}
+	s.conn.Close()
+	s.conn = nil
+}
@@ -0,0 +1,34 @@
+package appointment
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+)
+
+// Delete removes an appointment history entry of the caller's organization
+func (s *AppointmentService) Delete(ctx context.Context, id, orgID int) error {
+	affected, err := s.Client.AppointmentHistory.Delete().
+		Where(
+			appointmenthistory.ID(id),
+			appointmenthistory.HasEmployeeWith(employee.OrgID(orgID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete appointment history",
+		}
+	}
+
+	if affected == 0 {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Appointment history not found",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package appointment
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// List retrieves appointment histories of the caller's organization with filtering and pagination
+func (s *AppointmentService) List(ctx context.Context, query dtos.AppointmentHistoryListQuery) (*dtos.AppointmentHistoryListResponse, error) {
+	ahQuery := s.Client.AppointmentHistory.Query().
+		Where(appointmenthistory.HasEmployeeWith(employee.OrgID(query.OrgID))).
+		WithPosition().
+		WithDepartment()
+
+	if query.EmployeeID > 0 {
+		ahQuery = ahQuery.Where(appointmenthistory.EmployeeID(query.EmployeeID))
+	}
+	if query.PositionID > 0 {
+		ahQuery = ahQuery.Where(appointmenthistory.PositionID(query.PositionID))
+	}
+	if query.DepartmentID > 0 {
+		ahQuery = ahQuery.Where(appointmenthistory.DepartmentID(query.DepartmentID))
+	}
+
+	// Only entries whose effective range covers the given instant
+	if query.ActiveOn != "" {
+		activeOn, err := time.Parse(time.RFC3339, query.ActiveOn)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid active_on format, must be RFC3339",
+			}
+		}
+		ahQuery = ahQuery.Where(
+			appointmenthistory.JoiningAtLTE(activeOn),
+			appointmenthistory.Or(
+				appointmenthistory.EffectiveToIsNil(),
+				appointmenthistory.EffectiveToGT(activeOn),
+			),
+		)
+	}
+
+	orderBy := query.OrderBy
+	if orderBy == "" {
+		orderBy = "joining_at"
+	}
+	orderDir := query.OrderDir
+	if orderDir == "" {
+		orderDir = "desc"
+	}
+
+	opts := []sql.OrderTermOption{}
+	if orderDir == "desc" {
+		opts = append(opts, sql.OrderDesc())
+	}
+
+	var orderOption appointmenthistory.OrderOption
+	switch orderBy {
+	case "id":
+		orderOption = appointmenthistory.ByID(opts...)
+	case "joining_at":
+		orderOption = appointmenthistory.ByJoiningAt(opts...)
+	case "effective_to":
+		orderOption = appointmenthistory.ByEffectiveTo(opts...)
+	case "created_at":
+		orderOption = appointmenthistory.ByCreatedAt(opts...)
+	case "updated_at":
+		orderOption = appointmenthistory.ByUpdatedAt(opts...)
+	default:
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid order_by field. Valid fields: id, joining_at, effective_to, created_at, updated_at",
+		}
+	}
+
+	total, err := ahQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count appointment histories",
+		}
+	}
+
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := query.Limit
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	histories, err := ahQuery.
+		Order(orderOption, appointmenthistory.ByID(opts...)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch appointment histories",
+		}
+	}
+
+	return &dtos.AppointmentHistoryListResponse{
+		Data: histories,
+		Pagination: dtos.OffsetPagination{
+			Type:        "page",
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			TotalItems:  total,
+			PerPage:     limit,
+		},
+	}, nil
+}
+
+// Get retrieves a single appointment history entry of the caller's organization
+func (s *AppointmentService) Get(ctx context.Context, id, orgID int) (*ent.AppointmentHistory, error) {
+	history, err := s.Client.AppointmentHistory.Query().
+		Where(
+			appointmenthistory.ID(id),
+			appointmenthistory.HasEmployeeWith(employee.OrgID(orgID)),
+		).
+		WithEmployee().
+		WithPosition().
+		WithDepartment().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Appointment history not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch appointment history",
+		}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,27 @@
+package appointment
+
+import (
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// AppointmentService provides business logic for appointment history operations
+type AppointmentService struct {
+	Client *ent.Client
+}
+
+// NewAppointmentService creates a new appointment service
+func NewAppointmentService(client *ent.Client) *AppointmentService {
+	return &AppointmentService{
+		Client: client,
+	}
+}
@@ -0,0 +1,110 @@
+package appointment
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeestatushistory"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// Timeline merges appointments, contract changes and status changes of an employee
+// into a single list ordered from the most recent event
+func (s *AppointmentService) Timeline(ctx context.Context, employeeID, orgID int) ([]dtos.CareerTimelineEntry, error) {
+	exists, err := s.Client.Employee.Query().
+		Where(employee.ID(employeeID), employee.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch employee",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Employee not found or not in your organization",
+		}
+	}
+
+	appointments, err := s.Client.AppointmentHistory.Query().
+		Where(appointmenthistory.EmployeeID(employeeID)).
+		WithPosition().
+		WithDepartment().
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch appointment histories",
+		}
+	}
+
+	contracts, err := s.Client.EmployeeContract.Query().
+		Where(employeecontract.EmployeeID(employeeID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch employee contracts",
+		}
+	}
+
+	statusChanges, err := s.Client.EmployeeStatusHistory.Query().
+		Where(employeestatushistory.EmployeeID(employeeID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch status histories",
+		}
+	}
+
+	entries := make([]dtos.CareerTimelineEntry, 0, len(appointments)+len(contracts)+len(statusChanges))
+	for _, ah := range appointments {
+		entries = append(entries, appointmentEntry(ah))
+	}
+	for _, ct := range contracts {
+		entries = append(entries, dtos.CareerTimelineEntry{
+			Type:    dtos.TimelineTypeContract,
+			Date:    ct.StartAt,
+			EndDate: ct.EndAt,
+			Title:   "Contract " + ct.Code + " (" + string(ct.Type) + ")",
+			Detail:  ct,
+		})
+	}
+	for _, sc := range statusChanges {
+		entries = append(entries, dtos.CareerTimelineEntry{
+			Type:   dtos.TimelineTypeStatus,
+			Date:   sc.ChangedAt,
+			Title:  "Status changed from " + sc.FromStatus + " to " + sc.ToStatus,
+			Detail: sc,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.After(entries[j].Date)
+	})
+
+	return entries, nil
+}
+
+// appointmentEntry converts an appointment history into a timeline entry
+func appointmentEntry(ah *ent.AppointmentHistory) dtos.CareerTimelineEntry {
+	title := "Appointed as " + ah.PositionName
+	if dept := ah.Edges.Department; dept != nil {
+		title += " - " + dept.Name
+	}
+
+	return dtos.CareerTimelineEntry{
+		Type:    dtos.TimelineTypeAppointment,
+		Date:    ah.JoiningAt,
+		EndDate: ah.EffectiveTo,
+		Title:   title,
+		Detail:  ah,
+	}
+}
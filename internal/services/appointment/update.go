@@ -0,0 +1,111 @@
+package appointment
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/department"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// Update corrects an existing appointment history entry
+func (s *AppointmentService) Update(ctx context.Context, id, orgID int, input dtos.AppointmentHistoryUpdateInput) (*ent.AppointmentHistory, error) {
+	existing, err := s.Client.AppointmentHistory.Query().
+		Where(
+			appointmenthistory.ID(id),
+			appointmenthistory.HasEmployeeWith(employee.OrgID(orgID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Appointment history not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch appointment history",
+		}
+	}
+
+	ahUpdate := s.Client.AppointmentHistory.UpdateOneID(id)
+
+	// Re-link to another position, keeping the denormalized name in sync
+	if input.PositionID != nil {
+		pos, err := s.Client.Position.Query().
+			Where(
+				position.ID(*input.PositionID),
+				position.HasDepartmentWith(department.OrgID(orgID)),
+			).
+			Only(ctx)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Position not found in your organization",
+			}
+		}
+		ahUpdate.SetPositionID(pos.ID).
+			SetPositionName(pos.Name).
+			SetDepartmentID(pos.DepartmentID)
+	}
+
+	joiningAt := existing.JoiningAt
+	if input.JoiningAt != nil {
+		t, err := time.Parse(time.RFC3339, *input.JoiningAt)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid joining_at format, must be RFC3339",
+			}
+		}
+		joiningAt = t
+		ahUpdate.SetJoiningAt(t)
+	}
+
+	effectiveTo := existing.EffectiveTo
+	if input.EffectiveTo != nil {
+		if *input.EffectiveTo == "" {
+			effectiveTo = nil
+			ahUpdate.ClearEffectiveTo()
+		} else {
+			t, err := time.Parse(time.RFC3339, *input.EffectiveTo)
+			if err != nil {
+				return nil, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "Invalid effective_to format, must be RFC3339",
+				}
+			}
+			effectiveTo = &t
+			ahUpdate.SetEffectiveTo(t)
+		}
+	}
+
+	if effectiveTo != nil && effectiveTo.Before(joiningAt) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "effective_to must not be before joining_at",
+		}
+	}
+
+	if input.Description != nil {
+		ahUpdate.SetDescription(*input.Description)
+	}
+	if input.AttachmentUrls != nil {
+		ahUpdate.SetAttachmentUrls(input.AttachmentUrls)
+	}
+
+	if _, err := ahUpdate.Save(ctx); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update appointment history",
+		}
+	}
+
+	return s.Get(ctx, id, orgID)
+}
@@ -0,0 +1,62 @@
+package attachment
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+)
+
+// checkOwner verifies that the record an attachment is linked to exists and
+// belongs to the organization
+func (s *AttachmentService) checkOwner(ctx context.Context, orgID int, ownerType attachment.OwnerType, ownerID int) error {
+	var (
+		exists bool
+		err    error
+	)
+	switch ownerType {
+	case attachment.OwnerTypeTask:
+		exists, err = s.Client.Task.Query().
+			Where(task.ID(ownerID)).
+			Where(task.OrgID(orgID)).
+			Exist(ctx)
+	case attachment.OwnerTypeTaskReport:
+		exists, err = s.Client.TaskReport.Query().
+			Where(taskreport.ID(ownerID)).
+			Where(taskreport.HasReporterWith(employee.OrgID(orgID))).
+			Exist(ctx)
+	case attachment.OwnerTypeLeaveRequest:
+		exists, err = s.Client.LeaveRequest.Query().
+			Where(leaverequest.ID(ownerID)).
+			Where(leaverequest.OrgID(orgID)).
+			Exist(ctx)
+	case attachment.OwnerTypeAppointmentHistory:
+		exists, err = s.Client.AppointmentHistory.Query().
+			Where(appointmenthistory.ID(ownerID)).
+			Where(appointmenthistory.HasEmployeeWith(employee.OrgID(orgID))).
+			Exist(ctx)
+	default:
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid owner_type",
+		}
+	}
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check owner record",
+		}
+	}
+	if !exists {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Owner record not found",
+		}
+	}
+	return nil
+}
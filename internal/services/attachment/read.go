@@ -0,0 +1,162 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/storage"
+)
+
+// List returns the attachments of a record with signed download URLs
+func (s *AttachmentService) List(ctx context.Context, orgID int, query dtos.AttachmentListQuery) ([]*dtos.AttachmentResponse, error) {
+	ownerType := attachment.OwnerType(query.OwnerType)
+	if err := s.checkOwner(ctx, orgID, ownerType, query.OwnerID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.Client.Attachment.Query().
+		Where(attachment.OrgID(orgID)).
+		Where(attachment.OwnerTypeEQ(ownerType)).
+		Where(attachment.OwnerID(query.OwnerID)).
+		Order(ent.Desc(attachment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch attachments",
+		}
+	}
+
+	now := time.Now()
+	result := make([]*dtos.AttachmentResponse, len(rows))
+	for i, row := range rows {
+		result[i] = s.response(row, now)
+	}
+	return result, nil
+}
+
+// Get returns an attachment of the organization with a signed download URL
+func (s *AttachmentService) Get(ctx context.Context, orgID, id int) (*dtos.AttachmentResponse, error) {
+	row, err := s.get(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.response(row, time.Now()), nil
+}
+
+// Open verifies a signed download URL and opens the attachment content
+func (s *AttachmentService) Open(ctx context.Context, id int, expires int64, signature string) (*ent.Attachment, io.ReadCloser, error) {
+	if s.Storage == nil {
+		return nil, nil, &ServiceError{
+			Status: http.StatusServiceUnavailable,
+			Msg:    "Attachment storage is not configured",
+		}
+	}
+	if !s.verify(id, expires, signature, time.Now()) {
+		return nil, nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Download link is invalid or has expired",
+		}
+	}
+
+	row, err := s.Client.Attachment.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Attachment not found",
+			}
+		}
+		return nil, nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch attachment",
+		}
+	}
+	if row.ScanStatus == attachment.ScanStatusInfected || row.ScanStatus == attachment.ScanStatusPending {
+		return nil, nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Attachment is not available for download",
+		}
+	}
+
+	body, err := s.Storage.Get(ctx, row.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Attachment content not found",
+			}
+		}
+		log.Printf("Failed to read attachment %s: %v", row.StorageKey, err)
+		return nil, nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to read attachment",
+		}
+	}
+	return row, body, nil
+}
+
+// Delete removes an attachment uploaded by the employee, with its content
+func (s *AttachmentService) Delete(ctx context.Context, orgID, employeeID, id int) error {
+	row, err := s.get(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+	if row.UploaderID != employeeID {
+		return &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only the uploader can delete this attachment",
+		}
+	}
+
+	if err := s.Client.Attachment.DeleteOneID(id).Exec(ctx); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete attachment",
+		}
+	}
+	if s.Storage != nil {
+		if err := s.Storage.Delete(ctx, row.StorageKey); err != nil {
+			log.Printf("Failed to remove attachment content %s: %v", row.StorageKey, err)
+		}
+	}
+	return nil
+}
+
+// get fetches an attachment of the organization
+func (s *AttachmentService) get(ctx context.Context, orgID, id int) (*ent.Attachment, error) {
+	row, err := s.Client.Attachment.Query().
+		Where(attachment.ID(id)).
+		Where(attachment.OrgID(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Attachment not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch attachment",
+		}
+	}
+	return row, nil
+}
+
+// response pairs an attachment with a fresh signed download URL
+func (s *AttachmentService) response(row *ent.Attachment, now time.Time) *dtos.AttachmentResponse {
+	url, expiresAt := s.SignedURL(row.ID, now)
+	return &dtos.AttachmentResponse{
+		Attachment:  row,
+		DownloadURL: url,
+		ExpiresAt:   expiresAt,
+	}
+}
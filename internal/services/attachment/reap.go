@@ -0,0 +1,75 @@
+package attachment
+
+import (
+	"context"
+	"log"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/appointmenthistory"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+)
+
+// ownerTables lists the table of the records of each owner type
+var ownerTables = []struct {
+	ownerType attachment.OwnerType
+	table     string
+}{
+	{attachment.OwnerTypeTask, task.Table},
+	{attachment.OwnerTypeTaskReport, taskreport.Table},
+	{attachment.OwnerTypeLeaveRequest, leaverequest.Table},
+	{attachment.OwnerTypeAppointmentHistory, appointmenthistory.Table},
+}
+
+// ReapOrphans removes up to limit attachments whose owner record no longer
+// exists, with their content, and returns how many it removed. Owners are not
+// tied to their attachments by foreign keys, so deleting a task, a report, a
+// leave request or an appointment history, directly or through a cascade,
+// leaves them behind and their size keeps counting against the quota until
+// they are reaped.
+func (s *AttachmentService) ReapOrphans(ctx context.Context, limit int) (int, error) {
+	rows, err := s.Client.Attachment.Query().
+		Where(ownerless()).
+		Order(ent.Asc(attachment.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reaped := 0
+	for _, row := range rows {
+		// The content goes first: a failure keeps the row, so the next run
+		// tries again instead of losing track of the object
+		if err := s.Storage.Delete(ctx, row.StorageKey); err != nil {
+			log.Printf("Failed to remove attachment content %s: %v", row.StorageKey, err)
+			continue
+		}
+		if err := s.Client.Attachment.DeleteOneID(row.ID).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return reaped, err
+		}
+		reaped++
+	}
+	return reaped, nil
+}
+
+// ownerless selects attachments whose owner record does not exist
+func ownerless() predicate.Attachment {
+	return func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		conds := make([]*sql.Predicate, 0, len(ownerTables))
+		for _, owner := range ownerTables {
+			t := builder.Table(owner.table)
+			conds = append(conds, sql.And(
+				sql.EQ(s.C(attachment.FieldOwnerType), owner.ownerType),
+				sql.NotExists(builder.Select(t.C(task.FieldID)).From(t).Where(sql.ColumnsEQ(t.C(task.FieldID), s.C(attachment.FieldOwnerID)))),
+			))
+		}
+		s.Where(sql.Or(conds...))
+	}
+}
@@ -0,0 +1,76 @@
+package attachment
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/storage"
+)
+
+// DownloadURLTTL is how long a signed download URL stays valid
+const DownloadURLTTL = 15 * time.Minute
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// AttachmentService provides business logic for file attachments
+type AttachmentService struct {
+	Client  *ent.Client
+	Storage storage.Storage
+	// Scanner checks uploads for malware; uploads are marked as skipped when nil
+	Scanner    storage.Scanner
+	SigningKey []byte
+}
+
+// NewAttachmentService creates a new attachment service. Without a signing
+// key a random one is generated, so download URLs do not survive a restart.
+func NewAttachmentService(client *ent.Client, store storage.Storage, scanner storage.Scanner, signingKey []byte) *AttachmentService {
+	if len(signingKey) == 0 {
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			log.Fatalf("failed to generate attachment signing key: %v", err)
+		}
+	}
+	return &AttachmentService{
+		Client:     client,
+		Storage:    store,
+		Scanner:    scanner,
+		SigningKey: signingKey,
+	}
+}
+
+// SignedURL returns a download URL for an attachment that expires after DownloadURLTTL
+func (s *AttachmentService) SignedURL(id int, now time.Time) (string, time.Time) {
+	expiresAt := now.Add(DownloadURLTTL).Truncate(time.Second)
+	expires := expiresAt.Unix()
+	return fmt.Sprintf("/attachments/%d/download?expires=%d&signature=%s", id, expires, s.signature(id, expires)), expiresAt
+}
+
+// signature computes the HMAC of an attachment ID and expiry time
+func (s *AttachmentService) signature(id int, expires int64) string {
+	mac := hmac.New(sha256.New, s.SigningKey)
+	mac.Write([]byte(strconv.Itoa(id) + ":" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks a download signature and its expiry
+func (s *AttachmentService) verify(id int, expires int64, signature string, now time.Time) bool {
+	if now.Unix() > expires {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(s.signature(id, expires)))
+}
@@ -0,0 +1,85 @@
+package attachment
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// GetSettings returns the attachment limits of an organization and its current usage
+func (s *AttachmentService) GetSettings(ctx context.Context, orgID int) (*dtos.AttachmentSettingsResponse, error) {
+	org, err := s.Client.Organization.Get(ctx, orgID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Organization not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch organization",
+		}
+	}
+
+	used, err := s.usage(ctx, orgID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to compute storage usage",
+		}
+	}
+
+	return &dtos.AttachmentSettingsResponse{
+		MaxFileSize: org.AttachmentMaxFileSize,
+		Quota:       org.AttachmentQuota,
+		Used:        used,
+	}, nil
+}
+
+// UpdateSettings changes the attachment limits of an organization. Lowering
+// the quota below the current usage only blocks new uploads.
+func (s *AttachmentService) UpdateSettings(ctx context.Context, orgID int, input dtos.AttachmentSettingsInput) (*dtos.AttachmentSettingsResponse, error) {
+	update := s.Client.Organization.UpdateOneID(orgID)
+	if input.MaxFileSize != nil {
+		update.SetAttachmentMaxFileSize(*input.MaxFileSize)
+	}
+	if input.Quota != nil {
+		update.SetAttachmentQuota(*input.Quota)
+	}
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Organization not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update attachment settings",
+		}
+	}
+	return s.GetSettings(ctx, orgID)
+}
+
+// usage returns the total size of the organization's attachments in bytes.
+// Attachments of deleted records waiting to be reaped are not counted.
+func (s *AttachmentService) usage(ctx context.Context, orgID int) (int64, error) {
+	var v []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	if err := s.Client.Attachment.Query().
+		Where(attachment.OrgID(orgID), attachment.Not(ownerless())).
+		Aggregate(ent.Sum(attachment.FieldSize)).
+		Scan(ctx, &v); err != nil {
+		return 0, err
+	}
+	if len(v) == 0 {
+		return 0, nil
+	}
+	return v[0].Sum.Int64, nil
+}
@@ -0,0 +1,193 @@
+package attachment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attachment"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// Upload stores a file and links it to a record of the organization. The file
+// is checked against the organization's size limit and quota, its MIME type
+// is sniffed from the content and it is scanned before being stored.
+func (s *AttachmentService) Upload(ctx context.Context, orgID, employeeID int, input dtos.AttachmentUploadInput, fileName string, declaredSize int64, content io.Reader) (*dtos.AttachmentResponse, error) {
+	if s.Storage == nil {
+		return nil, &ServiceError{
+			Status: http.StatusServiceUnavailable,
+			Msg:    "Attachment storage is not configured",
+		}
+	}
+
+	ownerType := attachment.OwnerType(input.OwnerType)
+	if err := s.checkOwner(ctx, orgID, ownerType, input.OwnerID); err != nil {
+		return nil, err
+	}
+
+	org, err := s.Client.Organization.Get(ctx, orgID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch organization",
+		}
+	}
+	if declaredSize > org.AttachmentMaxFileSize {
+		return nil, tooLarge(org.AttachmentMaxFileSize)
+	}
+
+	used, err := s.usage(ctx, orgID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to compute storage usage",
+		}
+	}
+	if used+declaredSize > org.AttachmentQuota {
+		return nil, &ServiceError{
+			Status: http.StatusRequestEntityTooLarge,
+			Msg:    "Organization attachment quota exceeded",
+		}
+	}
+
+	// Spool the upload to a temporary file, enforcing the size limit on the
+	// actual content rather than the declared size
+	tmp, err := os.CreateTemp("", "attachment-*")
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to buffer upload",
+		}
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(content, org.AttachmentMaxFileSize+1))
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Failed to read uploaded file",
+		}
+	}
+	if size > org.AttachmentMaxFileSize {
+		return nil, tooLarge(org.AttachmentMaxFileSize)
+	}
+	if size == 0 {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Uploaded file is empty",
+		}
+	}
+	if used+size > org.AttachmentQuota {
+		return nil, &ServiceError{
+			Status: http.StatusRequestEntityTooLarge,
+			Msg:    "Organization attachment quota exceeded",
+		}
+	}
+
+	head := make([]byte, 512)
+	n, err := tmp.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to read uploaded file",
+		}
+	}
+	mimeType := http.DetectContentType(head[:n])
+
+	scanStatus := attachment.ScanStatusSkipped
+	if s.Scanner != nil {
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to read uploaded file",
+			}
+		}
+		infected, signature, err := s.Scanner.Scan(ctx, tmp)
+		if err != nil {
+			log.Printf("Failed to scan attachment for org %d: %v", orgID, err)
+			return nil, &ServiceError{
+				Status: http.StatusServiceUnavailable,
+				Msg:    "Virus scan is unavailable, please try again later",
+			}
+		}
+		if infected {
+			return nil, &ServiceError{
+				Status: http.StatusUnprocessableEntity,
+				Msg:    "File was rejected by the virus scanner: " + signature,
+			}
+		}
+		scanStatus = attachment.ScanStatusClean
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to read uploaded file",
+		}
+	}
+	key := "org/" + strconv.Itoa(orgID) + "/" + uuid.NewString()
+	if err := s.Storage.Put(ctx, key, tmp, size, mimeType); err != nil {
+		log.Printf("Failed to store attachment %s: %v", key, err)
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to store file",
+		}
+	}
+
+	row, err := s.Client.Attachment.Create().
+		SetOrgID(orgID).
+		SetOwnerType(ownerType).
+		SetOwnerID(input.OwnerID).
+		SetFileName(cleanFileName(fileName)).
+		SetMimeType(mimeType).
+		SetSize(size).
+		SetChecksum(hex.EncodeToString(hash.Sum(nil))).
+		SetStorageKey(key).
+		SetScanStatus(scanStatus).
+		SetUploaderID(employeeID).
+		Save(ctx)
+	if err != nil {
+		if delErr := s.Storage.Delete(ctx, key); delErr != nil {
+			log.Printf("Failed to remove orphaned attachment %s: %v", key, delErr)
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to save attachment",
+		}
+	}
+
+	return s.response(row, time.Now()), nil
+}
+
+// cleanFileName keeps the base name of an uploaded file
+func cleanFileName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		name = "file"
+	}
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+	return name
+}
+
+// tooLarge builds the error for files above the organization's size limit
+func tooLarge(limit int64) error {
+	return &ServiceError{
+		Status: http.StatusRequestEntityTooLarge,
+		Msg:    "File exceeds the maximum size of " + strconv.FormatInt(limit, 10) + " bytes",
+	}
+}
@@ -0,0 +1,136 @@
+package attendance
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// CheckIn opens today's attendance record for the employee
+func (s *AttendanceService) CheckIn(ctx context.Context, orgID, employeeID int, input dtos.AttendanceClockInput) (*ent.AttendanceRecord, error) {
+	exists, err := s.Client.Employee.Query().
+		Where(employee.ID(employeeID), employee.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate employee",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Employee not found",
+		}
+	}
+
+	now := time.Now()
+	today := utils.DayOf(now)
+
+	checkedIn, err := s.Client.AttendanceRecord.Query().
+		Where(
+			attendancerecord.EmployeeID(employeeID),
+			attendancerecord.WorkDate(today),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check today's attendance",
+		}
+	}
+	if checkedIn {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "You have already checked in today",
+		}
+	}
+
+	arCreate := s.Client.AttendanceRecord.Create().
+		SetEmployeeID(employeeID).
+		SetOrgID(orgID).
+		SetWorkDate(today).
+		SetCheckInAt(now).
+		SetNillableLatitude(input.Latitude).
+		SetNillableLongitude(input.Longitude)
+
+	if input.Source != "" {
+		arCreate.SetSource(attendancerecord.Source(input.Source))
+	}
+	if input.Note != "" {
+		arCreate.SetNote(input.Note)
+	}
+
+	record, err := arCreate.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "You have already checked in today",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check in",
+		}
+	}
+	return record, nil
+}
+
+// CheckOut closes the employee's open attendance record. Records opened yesterday are
+// included so that night shifts ending after midnight can be closed.
+func (s *AttendanceService) CheckOut(ctx context.Context, orgID, employeeID int, input dtos.AttendanceClockInput) (*ent.AttendanceRecord, error) {
+	now := time.Now()
+	today := utils.DayOf(now)
+
+	record, err := s.Client.AttendanceRecord.Query().
+		Where(
+			attendancerecord.EmployeeID(employeeID),
+			attendancerecord.OrgID(orgID),
+			attendancerecord.WorkDateGTE(today.AddDate(0, 0, -1)),
+			attendancerecord.WorkDateLTE(today),
+		).
+		Order(ent.Desc(attendancerecord.FieldWorkDate)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch today's attendance",
+		}
+	}
+	// Yesterday's record only counts while it is still open and less than a day old
+	if ent.IsNotFound(err) || (record.WorkDate.Before(today) &&
+		(record.CheckOutAt != nil || now.Sub(record.CheckInAt) >= 24*time.Hour)) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "You have not checked in today",
+		}
+	}
+	if record.CheckOutAt != nil {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "You have already checked out today",
+		}
+	}
+
+	arUpdate := s.Client.AttendanceRecord.UpdateOneID(record.ID).
+		SetCheckOutAt(now)
+	if input.Note != "" {
+		arUpdate.SetNote(input.Note)
+	}
+
+	updated, err := arUpdate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check out",
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,381 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancecorrection"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// CreateCorrection submits a request to fix the attendance of one of the employee's work days
+func (s *AttendanceService) CreateCorrection(ctx context.Context, orgID, employeeID int, input dtos.AttendanceCorrectionCreateInput) (*ent.AttendanceCorrection, error) {
+	day, err := utils.ParseDate(input.WorkDate)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid work_date format, must be YYYY-MM-DD",
+		}
+	}
+	if day.After(utils.DayOf(time.Now())) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Cannot request a correction for a future date",
+		}
+	}
+	if input.CheckInAt == nil && input.CheckOutAt == nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "At least one of check_in_at or check_out_at is required",
+		}
+	}
+
+	var checkInAt, checkOutAt *time.Time
+	if input.CheckInAt != nil {
+		t, err := time.Parse(time.RFC3339, *input.CheckInAt)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid check_in_at format, must be RFC3339",
+			}
+		}
+		checkInAt = &t
+	}
+	if input.CheckOutAt != nil {
+		t, err := time.Parse(time.RFC3339, *input.CheckOutAt)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid check_out_at format, must be RFC3339",
+			}
+		}
+		checkOutAt = &t
+	}
+
+	pending, err := s.Client.AttendanceCorrection.Query().
+		Where(
+			attendancecorrection.EmployeeID(employeeID),
+			attendancecorrection.WorkDate(day),
+			attendancecorrection.StatusEQ(attendancecorrection.StatusPending),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check pending corrections",
+		}
+	}
+	if pending {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "A correction for this day is already pending",
+		}
+	}
+
+	acCreate := s.Client.AttendanceCorrection.Create().
+		SetEmployeeID(employeeID).
+		SetOrgID(orgID).
+		SetWorkDate(day).
+		SetNillableCheckInAt(checkInAt).
+		SetNillableCheckOutAt(checkOutAt).
+		SetReason(input.Reason)
+
+	record, err := s.Client.AttendanceRecord.Query().
+		Where(
+			attendancerecord.EmployeeID(employeeID),
+			attendancerecord.OrgID(orgID),
+			attendancerecord.WorkDate(day),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		acCreate.SetAttendanceRecordID(record.ID)
+		if err := validateTimes(record.CheckInAt, checkInAt, record.CheckOutAt, checkOutAt); err != nil {
+			return nil, err
+		}
+	case ent.IsNotFound(err):
+		// A missing day can only be restored with a check-in time
+		if checkInAt == nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "check_in_at is required when there is no attendance record for the day",
+			}
+		}
+		if err := validateTimes(*checkInAt, nil, nil, checkOutAt); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch attendance record",
+		}
+	}
+
+	correction, err := acCreate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create attendance correction",
+		}
+	}
+	return correction, nil
+}
+
+// ListCorrections retrieves correction requests of the caller's organization, newest first
+func (s *AttendanceService) ListCorrections(ctx context.Context, query dtos.AttendanceCorrectionListQuery) (*dtos.AttendanceListResponse, error) {
+	acQuery := s.Client.AttendanceCorrection.Query().
+		Where(attendancecorrection.OrgID(query.OrgID)).
+		WithAttendanceRecord()
+
+	if query.EmployeeID > 0 {
+		acQuery = acQuery.Where(attendancecorrection.EmployeeID(query.EmployeeID))
+	}
+	if query.Status != "" {
+		acQuery = acQuery.Where(attendancecorrection.StatusEQ(attendancecorrection.Status(query.Status)))
+	}
+
+	total, err := acQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count attendance corrections",
+		}
+	}
+
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := query.Limit
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	corrections, err := acQuery.
+		Order(ent.Desc(attendancecorrection.FieldCreatedAt), ent.Desc(attendancecorrection.FieldID)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch attendance corrections",
+		}
+	}
+
+	return &dtos.AttendanceListResponse{
+		Data: corrections,
+		Pagination: dtos.OffsetPagination{
+			Type:        "page",
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			TotalItems:  total,
+			PerPage:     limit,
+		},
+	}, nil
+}
+
+// ApproveCorrection approves a pending correction and applies it to the attendance record
+func (s *AttendanceService) ApproveCorrection(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.AttendanceCorrection, error) {
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	correction, err := s.pendingCorrection(ctx, tx, id, orgID, reviewerID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Apply the corrected times, restoring the day if it has no record yet
+	recordID := correction.AttendanceRecordID
+	if recordID != nil {
+		arUpdate := tx.AttendanceRecord.UpdateOneID(*recordID)
+		if correction.CheckInAt != nil {
+			arUpdate.SetCheckInAt(*correction.CheckInAt)
+		}
+		if correction.CheckOutAt != nil {
+			arUpdate.SetCheckOutAt(*correction.CheckOutAt)
+		}
+		if err := arUpdate.Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to apply attendance correction",
+			}
+		}
+	} else {
+		record, err := tx.AttendanceRecord.Create().
+			SetEmployeeID(correction.EmployeeID).
+			SetOrgID(correction.OrgID).
+			SetWorkDate(correction.WorkDate).
+			SetCheckInAt(*correction.CheckInAt).
+			SetNillableCheckOutAt(correction.CheckOutAt).
+			SetNote(fmt.Sprintf("Restored by attendance correction #%d", correction.ID)).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			if ent.IsConstraintError(err) {
+				return nil, &ServiceError{
+					Status: http.StatusConflict,
+					Msg:    "An attendance record for this day already exists",
+				}
+			}
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to apply attendance correction",
+			}
+		}
+		recordID = &record.ID
+	}
+
+	acUpdate := tx.AttendanceCorrection.UpdateOneID(id).
+		Where(attendancecorrection.StatusEQ(attendancecorrection.StatusPending)).
+		SetStatus(attendancecorrection.StatusApproved).
+		SetAttendanceRecordID(*recordID).
+		SetReviewerID(reviewerID).
+		SetReviewedAt(time.Now())
+	if comment != "" {
+		acUpdate.SetReviewComment(comment)
+	}
+
+	updated, err := acUpdate.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Attendance correction has already been reviewed",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to approve attendance correction",
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	return updated, nil
+}
+
+// RejectCorrection rejects a pending correction
+func (s *AttendanceService) RejectCorrection(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.AttendanceCorrection, error) {
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+
+	if _, err := s.pendingCorrection(ctx, tx, id, orgID, reviewerID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	acUpdate := tx.AttendanceCorrection.UpdateOneID(id).
+		Where(attendancecorrection.StatusEQ(attendancecorrection.StatusPending)).
+		SetStatus(attendancecorrection.StatusRejected).
+		SetReviewerID(reviewerID).
+		SetReviewedAt(time.Now())
+	if comment != "" {
+		acUpdate.SetReviewComment(comment)
+	}
+
+	updated, err := acUpdate.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Attendance correction has already been reviewed",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to reject attendance correction",
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	return updated, nil
+}
+
+// pendingCorrection loads a correction that the reviewer is allowed to review
+func (s *AttendanceService) pendingCorrection(ctx context.Context, tx *ent.Tx, id, orgID, reviewerID int) (*ent.AttendanceCorrection, error) {
+	correction, err := tx.AttendanceCorrection.Query().
+		Where(
+			attendancecorrection.ID(id),
+			attendancecorrection.OrgID(orgID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Attendance correction not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch attendance correction",
+		}
+	}
+	if correction.Status != attendancecorrection.StatusPending {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Only pending corrections can be reviewed",
+		}
+	}
+	if correction.EmployeeID == reviewerID {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "You cannot review your own attendance correction",
+		}
+	}
+	return correction, nil
+}
+
+// validateTimes checks that the resulting check-out is not before the resulting check-in
+func validateTimes(currentIn time.Time, newIn *time.Time, currentOut, newOut *time.Time) error {
+	in := currentIn
+	if newIn != nil {
+		in = *newIn
+	}
+	out := currentOut
+	if newOut != nil {
+		out = newOut
+	}
+	if out != nil && out.Before(in) {
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "check_out_at must not be before check_in_at",
+		}
+	}
+	return nil
+}
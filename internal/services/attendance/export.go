@@ -0,0 +1,114 @@
+package attendance
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/department"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// MonthlyTimesheet builds the CSV rows of a monthly timesheet for every employee of a department.
+// Each row holds the monthly totals followed by the status of every day of the month.
+func (s *AttendanceService) MonthlyTimesheet(ctx context.Context, orgID, departmentID int, month time.Time) ([][]string, error) {
+	exists, err := s.Client.Department.Query().
+		Where(department.ID(departmentID), department.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate department",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Department not found",
+		}
+	}
+
+	employees, err := s.Client.Employee.Query().
+		Where(
+			employee.OrgID(orgID),
+			employee.HasPositionWith(position.DepartmentID(departmentID)),
+		).
+		WithPosition().
+		Order(ent.Asc(employee.FieldCode)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch department employees",
+		}
+	}
+
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 1, -1)
+
+	header := []string{
+		"employee_id", "employee_code", "position",
+		"days_present", "days_excused", "days_absent",
+		"late_minutes", "early_leave_minutes", "worked_hours",
+	}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		header = append(header, day.Format("2006-01-02"))
+	}
+	rows := [][]string{header}
+	if len(employees) == 0 {
+		return rows, nil
+	}
+
+	employeeIDs := make([]int, len(employees))
+	for i, emp := range employees {
+		employeeIDs[i] = emp.ID
+	}
+	summaries, err := s.DailySummaries(ctx, orgID, employeeIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	byEmployee := make(map[int][]dtos.AttendanceDailySummary, len(employees))
+	for _, summary := range summaries {
+		byEmployee[summary.EmployeeID] = append(byEmployee[summary.EmployeeID], summary)
+	}
+
+	for _, emp := range employees {
+		var present, excused, absent, late, early int
+		var worked float64
+		statusByDate := make(map[string]string)
+		for _, summary := range byEmployee[emp.ID] {
+			statusByDate[summary.Date] = summary.Status
+			switch summary.Status {
+			case dtos.AttendanceStatusPresent:
+				present++
+			case dtos.AttendanceStatusExcused:
+				excused++
+			case dtos.AttendanceStatusAbsent:
+				absent++
+			}
+			late += summary.LateMinutes
+			early += summary.EarlyLeaveMinutes
+			worked += summary.WorkedHours
+		}
+
+		positionName := ""
+		if emp.Edges.Position != nil {
+			positionName = emp.Edges.Position.Name
+		}
+		row := []string{
+			strconv.Itoa(emp.ID), emp.Code, positionName,
+			strconv.Itoa(present), strconv.Itoa(excused), strconv.Itoa(absent),
+			strconv.Itoa(late), strconv.Itoa(early), strconv.FormatFloat(worked, 'f', 2, 64),
+		}
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			row = append(row, statusByDate[day.Format("2006-01-02")])
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
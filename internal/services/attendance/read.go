@@ -0,0 +1,81 @@
+package attendance
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// List retrieves attendance records of the caller's organization, newest first
+func (s *AttendanceService) List(ctx context.Context, query dtos.AttendanceListQuery) (*dtos.AttendanceListResponse, error) {
+	arQuery := s.Client.AttendanceRecord.Query().
+		Where(attendancerecord.OrgID(query.OrgID))
+
+	if query.EmployeeID > 0 {
+		arQuery = arQuery.Where(attendancerecord.EmployeeID(query.EmployeeID))
+	}
+	if query.From != "" {
+		from, err := utils.ParseDate(query.From)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid from format, must be YYYY-MM-DD",
+			}
+		}
+		arQuery = arQuery.Where(attendancerecord.WorkDateGTE(from))
+	}
+	if query.To != "" {
+		to, err := utils.ParseDate(query.To)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid to format, must be YYYY-MM-DD",
+			}
+		}
+		arQuery = arQuery.Where(attendancerecord.WorkDateLTE(to))
+	}
+
+	total, err := arQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count attendance records",
+		}
+	}
+
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := query.Limit
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	records, err := arQuery.
+		Order(ent.Desc(attendancerecord.FieldWorkDate), ent.Desc(attendancerecord.FieldID)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch attendance records",
+		}
+	}
+
+	return &dtos.AttendanceListResponse{
+		Data: records,
+		Pagination: dtos.OffsetPagination{
+			Type:        "page",
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			TotalItems:  total,
+			PerPage:     limit,
+		},
+	}, nil
+}
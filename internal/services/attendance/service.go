@@ -0,0 +1,35 @@
+package attendance
+
+import (
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+)
+
+// Default working hours, as offsets from midnight, used to compute late arrivals and early leaves
+const (
+	WorkdayStart = 8 * time.Hour
+	WorkdayEnd   = 17 * time.Hour
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// AttendanceService provides business logic for attendance tracking
+type AttendanceService struct {
+	Client *ent.Client
+}
+
+// NewAttendanceService creates a new attendance service
+func NewAttendanceService(client *ent.Client) *AttendanceService {
+	return &AttendanceService{
+		Client: client,
+	}
+}
@@ -0,0 +1,206 @@
+package attendance
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/attendancerecord"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/calendar"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/shift"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// MaxSummaryDays limits the date range of a single summary request
+const MaxSummaryDays = 62
+
+// DailySummaries computes the attendance of the given employees for every day in [from, to].
+// Rostered days are measured against their shift, other days against the default working hours.
+// Days covered by an approved leave request are reported as excused instead of absent, and
+// weekends and holidays of the organization without a shift as days off.
+// Days in the future and days before the employee joined are omitted.
+func (s *AttendanceService) DailySummaries(ctx context.Context, orgID int, employeeIDs []int, from, to time.Time) ([]dtos.AttendanceDailySummary, error) {
+	from, to = utils.DayOf(from), utils.DayOf(to)
+	if to.Before(from) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "to must not be before from",
+		}
+	}
+	if to.Sub(from) > MaxSummaryDays*24*time.Hour {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Date range is too large",
+		}
+	}
+
+	employees, err := s.Client.Employee.Query().
+		Where(employee.IDIn(employeeIDs...), employee.OrgID(orgID)).
+		Order(ent.Asc(employee.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch employees",
+		}
+	}
+
+	records, err := s.Client.AttendanceRecord.Query().
+		Where(
+			attendancerecord.EmployeeIDIn(employeeIDs...),
+			attendancerecord.OrgID(orgID),
+			attendancerecord.WorkDateGTE(from),
+			attendancerecord.WorkDateLTE(to),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch attendance records",
+		}
+	}
+
+	leaves, err := s.Client.LeaveRequest.Query().
+		Where(
+			leaverequest.EmployeeIDIn(employeeIDs...),
+			leaverequest.OrgID(orgID),
+			leaverequest.StatusEQ(leaverequest.StatusApproved),
+			leaverequest.StartAtLT(to.AddDate(0, 0, 1)),
+			leaverequest.EndAtGTE(from),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch leave requests",
+		}
+	}
+
+	rosters, err := s.Client.Roster.Query().
+		Where(
+			roster.EmployeeIDIn(employeeIDs...),
+			roster.OrgID(orgID),
+			roster.WorkDateGTE(from),
+			roster.WorkDateLTE(to),
+		).
+		WithShift().
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch roster",
+		}
+	}
+
+	// Weekends and holidays come from the organization's calendar
+	cal, err := calendar.NewCalendarService(s.Client).Load(ctx, orgID, from, to)
+	if err != nil {
+		return nil, calendarError(err)
+	}
+
+	type dayKey struct {
+		employeeID int
+		date       string
+	}
+	recordByDay := make(map[dayKey]*ent.AttendanceRecord, len(records))
+	for _, r := range records {
+		recordByDay[dayKey{r.EmployeeID, utils.DayOf(r.WorkDate).Format("2006-01-02")}] = r
+	}
+	shiftByDay := make(map[dayKey]*ent.Shift, len(rosters))
+	for _, r := range rosters {
+		shiftByDay[dayKey{r.EmployeeID, utils.DayOf(r.WorkDate).Format("2006-01-02")}] = r.Edges.Shift
+	}
+	leavesByEmployee := make(map[int][]*ent.LeaveRequest)
+	for _, l := range leaves {
+		leavesByEmployee[l.EmployeeID] = append(leavesByEmployee[l.EmployeeID], l)
+	}
+
+	today := utils.DayOf(time.Now())
+	summaries := []dtos.AttendanceDailySummary{}
+	for _, emp := range employees {
+		joined := utils.DayOf(emp.JoiningAt)
+		for day := from; !day.After(to) && !day.After(today); day = day.AddDate(0, 0, 1) {
+			if day.Before(joined) {
+				continue
+			}
+			date := day.Format("2006-01-02")
+			summary := dtos.AttendanceDailySummary{
+				EmployeeID: emp.ID,
+				Date:       date,
+			}
+
+			rostered, hasShift := shiftByDay[dayKey{emp.ID, date}]
+			if r, ok := recordByDay[dayKey{emp.ID, date}]; ok {
+				summarizeRecord(&summary, day, r, rostered)
+			} else if l := leaveOn(leavesByEmployee[emp.ID], day); l != nil {
+				summary.Status = dtos.AttendanceStatusExcused
+				summary.LeaveRequestID = &l.ID
+			} else if !hasShift && (cal.IsWeekend(day) || cal.IsHoliday(day)) {
+				summary.Status = dtos.AttendanceStatusDayOff
+			} else {
+				summary.Status = dtos.AttendanceStatusAbsent
+			}
+			summaries = append(summaries, summary)
+		}
+	}
+	return summaries, nil
+}
+
+// summarizeRecord fills late/early/worked figures from a clock-in/clock-out record,
+// measured against the rostered shift when there is one
+func summarizeRecord(summary *dtos.AttendanceDailySummary, day time.Time, r *ent.AttendanceRecord, rostered *ent.Shift) {
+	summary.Status = dtos.AttendanceStatusPresent
+	summary.CheckInAt = &r.CheckInAt
+	summary.CheckOutAt = r.CheckOutAt
+
+	start, end := day.Add(WorkdayStart), day.Add(WorkdayEnd)
+	var unpaidBreak time.Duration
+	if rostered != nil {
+		if shiftStart, shiftEnd, err := shift.Window(rostered, day); err == nil {
+			start, end = shiftStart, shiftEnd
+		}
+		if !rostered.BreakPaid {
+			unpaidBreak = time.Duration(rostered.BreakMinutes) * time.Minute
+		}
+	}
+
+	if late := r.CheckInAt.Sub(start); late > 0 {
+		summary.LateMinutes = int(late.Minutes())
+	}
+	if r.CheckOutAt != nil {
+		if early := end.Sub(*r.CheckOutAt); early > 0 {
+			summary.EarlyLeaveMinutes = int(early.Minutes())
+		}
+		if worked := r.CheckOutAt.Sub(r.CheckInAt) - unpaidBreak; worked > 0 {
+			summary.WorkedHours = math.Round(worked.Hours()*100) / 100
+		}
+	}
+}
+
+// leaveOn returns the approved leave request covering the given day, if any
+func leaveOn(leaves []*ent.LeaveRequest, day time.Time) *ent.LeaveRequest {
+	next := day.AddDate(0, 0, 1)
+	for _, l := range leaves {
+		if l.StartAt.Before(next) && !l.EndAt.Before(day) {
+			return l
+		}
+	}
+	return nil
+}
+
+// calendarError converts an error of the calendar service
+func calendarError(err error) error {
+	if calendarErr, ok := err.(*calendar.ServiceError); ok {
+		return &ServiceError{
+			Status: calendarErr.Status,
+			Msg:    calendarErr.Msg,
+		}
+	}
+	return err
+}
@@ -0,0 +1,89 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
+	"github.com/longgggwwww/hrm-ms-hr/ent/organization"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// DefaultWeekendDays applies to organizations that have not configured their weekend
+var DefaultWeekendDays = []int{int(time.Saturday), int(time.Sunday)}
+
+// Calendar holds the weekend and the holidays of an organization
+type Calendar struct {
+	weekend  map[time.Weekday]bool
+	holidays map[string]bool
+}
+
+// IsWeekend reports whether a day falls on the organization's weekend
+func (c *Calendar) IsWeekend(day time.Time) bool {
+	return c.weekend[day.Weekday()]
+}
+
+// IsHoliday reports whether a day is a holiday of the organization
+func (c *Calendar) IsHoliday(day time.Time) bool {
+	return c.holidays[utils.DayOf(day).Format("2006-01-02")]
+}
+
+// Load reads the weekend of an organization and its holidays from one day to
+// another
+func (s *CalendarService) Load(ctx context.Context, orgID int, from, to time.Time) (*Calendar, error) {
+	weekend, err := s.Weekend(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := s.Client.Holiday.Query().
+		Where(
+			holiday.OrgID(orgID),
+			holiday.DateGTE(utils.DayOf(from)),
+			holiday.DateLTE(utils.DayOf(to)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check holidays",
+		}
+	}
+
+	cal := &Calendar{
+		weekend:  make(map[time.Weekday]bool, len(weekend)),
+		holidays: make(map[string]bool, len(holidays)),
+	}
+	for _, d := range weekend {
+		cal.weekend[time.Weekday(d)] = true
+	}
+	for _, h := range holidays {
+		cal.holidays[utils.DayOf(h.Date).Format("2006-01-02")] = true
+	}
+	return cal, nil
+}
+
+// Weekend returns the weekdays the organization treats as weekend
+func (s *CalendarService) Weekend(ctx context.Context, orgID int) ([]int, error) {
+	org, err := s.Client.Organization.Query().
+		Where(organization.ID(orgID)).
+		Select(organization.FieldWeekendDays).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Organization not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch organization",
+		}
+	}
+	if len(org.WeekendDays) == 0 {
+		return DefaultWeekendDays, nil
+	}
+	return org.WeekendDays, nil
+}
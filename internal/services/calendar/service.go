@@ -0,0 +1,28 @@
+package calendar
+
+import (
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// CalendarService reads the work calendar of organizations: their weekend
+// and their holidays
+type CalendarService struct {
+	Client *ent.Client
+}
+
+// NewCalendarService creates a new calendar service
+func NewCalendarService(client *ent.Client) *CalendarService {
+	return &CalendarService{
+		Client: client,
+	}
+}
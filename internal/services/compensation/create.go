@@ -0,0 +1,107 @@
+package compensation
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// Create records a salary change request for an employee. The record starts as
+// pending and only takes effect once it has been approved.
+func (s *CompensationService) Create(ctx context.Context, orgID, requesterID int, input dtos.CompensationCreateInput) (*ent.Compensation, error) {
+	exists, err := s.Client.Employee.Query().
+		Where(employee.ID(input.EmployeeID), employee.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate employee",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Employee not found in your organization",
+		}
+	}
+
+	effectiveFrom, err := time.Parse(time.RFC3339, input.EffectiveFrom)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid effective_from format, must be RFC3339",
+		}
+	}
+
+	var totalAllowance int64
+	for _, amount := range input.Allowances {
+		totalAllowance += amount
+	}
+
+	cCreate := s.Client.Compensation.Create().
+		SetEmployeeID(input.EmployeeID).
+		SetOrgID(orgID).
+		SetBasePay(input.BasePay).
+		SetTotalAllowance(totalAllowance).
+		SetEffectiveFrom(effectiveFrom).
+		SetReason(input.Reason).
+		SetRequesterID(requesterID)
+
+	if input.Allowances != nil {
+		cCreate.SetAllowances(input.Allowances)
+	}
+
+	currency := input.Currency
+
+	// Base pay must fall within the selected pay band
+	if input.SalaryGradeID != nil {
+		grade, err := s.Client.SalaryGrade.Query().
+			Where(salarygrade.ID(*input.SalaryGradeID), salarygrade.OrgID(orgID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "Salary grade not found in your organization",
+				}
+			}
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to fetch salary grade",
+			}
+		}
+		if input.BasePay < grade.MinAmount || input.BasePay > grade.MaxAmount {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "base_pay is outside the range of the salary grade",
+			}
+		}
+		if currency == "" {
+			currency = grade.Currency
+		} else if currency != grade.Currency {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Currency does not match the salary grade",
+			}
+		}
+		cCreate.SetSalaryGradeID(grade.ID)
+	}
+
+	if currency != "" {
+		cCreate.SetCurrency(currency)
+	}
+
+	record, err := cCreate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create compensation record",
+		}
+	}
+	return record, nil
+}
@@ -0,0 +1,83 @@
+package compensation
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/ent/department"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+)
+
+// ExportDepartment builds the CSV rows of a salary review for every employee of a
+// department, using the approved compensation in effect at the given time
+func (s *CompensationService) ExportDepartment(ctx context.Context, orgID, departmentID int, at time.Time) ([][]string, error) {
+	exists, err := s.Client.Department.Query().
+		Where(department.ID(departmentID), department.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate department",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Department not found",
+		}
+	}
+
+	employees, err := s.Client.Employee.Query().
+		Where(
+			employee.OrgID(orgID),
+			employee.HasPositionWith(position.DepartmentID(departmentID)),
+		).
+		WithPosition().
+		WithCompensations(func(q *ent.CompensationQuery) {
+			q.Where(
+				compensation.StatusEQ(compensation.StatusApproved),
+				compensation.EffectiveFromLTE(at),
+			).
+				WithSalaryGrade().
+				Order(ent.Desc(compensation.FieldEffectiveFrom), ent.Desc(compensation.FieldID))
+		}).
+		Order(ent.Asc(employee.FieldCode)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch department employees",
+		}
+	}
+
+	rows := [][]string{{
+		"employee_id", "employee_code", "position", "salary_grade",
+		"base_pay", "total_allowance", "total_pay", "currency", "effective_from",
+	}}
+
+	for _, emp := range employees {
+		row := []string{strconv.Itoa(emp.ID), emp.Code, "", "", "", "", "", "", ""}
+		if emp.Edges.Position != nil {
+			row[2] = emp.Edges.Position.Name
+		}
+		if len(emp.Edges.Compensations) > 0 {
+			current := emp.Edges.Compensations[0]
+			if current.Edges.SalaryGrade != nil {
+				row[3] = current.Edges.SalaryGrade.Code
+			}
+			row[4] = formatAmount(current.BasePay, current.Currency)
+			row[5] = formatAmount(current.TotalAllowance, current.Currency)
+			row[6] = formatAmount(current.BasePay+current.TotalAllowance, current.Currency)
+			row[7] = current.Currency
+			row[8] = current.EffectiveFrom.Format(time.RFC3339)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
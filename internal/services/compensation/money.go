@@ -0,0 +1,36 @@
+package compensation
+
+import (
+	"strconv"
+	"strings"
+)
+
+// minorDigits lists the ISO 4217 currencies whose minor unit is not a
+// hundredth of the major one
+var minorDigits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// formatAmount writes an amount in minor units of a currency as a decimal
+// amount of its major unit, e.g. 150050 USD as 1500.50
+func formatAmount(amount int64, currency string) string {
+	digits, ok := minorDigits[strings.ToUpper(currency)]
+	if !ok {
+		digits = 2
+	}
+	s := strconv.FormatInt(amount, 10)
+	if digits == 0 {
+		return s
+	}
+	sign := ""
+	if amount < 0 {
+		sign, s = "-", s[1:]
+	}
+	if len(s) <= digits {
+		s = strings.Repeat("0", digits-len(s)+1) + s
+	}
+	return sign + s[:len(s)-digits] + "." + s[len(s)-digits:]
+}
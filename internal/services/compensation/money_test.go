@@ -0,0 +1,25 @@
+package compensation
+
+import "testing"
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		want     string
+	}{
+		{15000000, "VND", "15000000"},
+		{150050, "USD", "1500.50"},
+		{5, "usd", "0.05"},
+		{0, "EUR", "0.00"},
+		{-1999, "USD", "-19.99"},
+		{1234567, "KWD", "1234.567"},
+		{12, "BHD", "0.012"},
+		{990, "JPY", "990"},
+	}
+	for _, tt := range tests {
+		if got := formatAmount(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("formatAmount(%d, %s) = %s, want %s", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package compensation
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// List retrieves compensation records of the caller's organization, newest first
+func (s *CompensationService) List(ctx context.Context, query dtos.CompensationListQuery) (*dtos.CompensationListResponse, error) {
+	cQuery := s.Client.Compensation.Query().
+		Where(compensation.OrgID(query.OrgID)).
+		WithSalaryGrade()
+
+	if query.EmployeeID > 0 {
+		cQuery = cQuery.Where(compensation.EmployeeID(query.EmployeeID))
+	}
+	if query.Status != "" {
+		cQuery = cQuery.Where(compensation.StatusEQ(compensation.Status(query.Status)))
+	}
+
+	total, err := cQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count compensation records",
+		}
+	}
+
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := query.Limit
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	records, err := cQuery.
+		Order(ent.Desc(compensation.FieldEffectiveFrom), ent.Desc(compensation.FieldID)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch compensation records",
+		}
+	}
+
+	return &dtos.CompensationListResponse{
+		Data: records,
+		Pagination: dtos.OffsetPagination{
+			Type:        "page",
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			TotalItems:  total,
+			PerPage:     limit,
+		},
+	}, nil
+}
+
+// Get retrieves a single compensation record of the caller's organization
+func (s *CompensationService) Get(ctx context.Context, id, orgID int) (*ent.Compensation, error) {
+	record, err := s.Client.Compensation.Query().
+		Where(compensation.ID(id), compensation.OrgID(orgID)).
+		WithEmployee().
+		WithSalaryGrade().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Compensation record not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch compensation record",
+		}
+	}
+	return record, nil
+}
+
+// Current returns the approved compensation in effect for an employee at the given time
+func (s *CompensationService) Current(ctx context.Context, employeeID, orgID int, at time.Time) (*ent.Compensation, error) {
+	record, err := s.Client.Compensation.Query().
+		Where(
+			compensation.EmployeeID(employeeID),
+			compensation.OrgID(orgID),
+			compensation.StatusEQ(compensation.StatusApproved),
+			compensation.EffectiveFromLTE(at),
+		).
+		WithSalaryGrade().
+		Order(ent.Desc(compensation.FieldEffectiveFrom), ent.Desc(compensation.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "No approved compensation in effect for this employee",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch current compensation",
+		}
+	}
+	return record, nil
+}
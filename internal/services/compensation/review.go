@@ -0,0 +1,70 @@
+package compensation
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+)
+
+// Approve approves a pending salary change request
+func (s *CompensationService) Approve(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.Compensation, error) {
+	return s.review(ctx, id, orgID, reviewerID, comment, compensation.StatusApproved)
+}
+
+// Reject rejects a pending salary change request
+func (s *CompensationService) Reject(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.Compensation, error) {
+	return s.review(ctx, id, orgID, reviewerID, comment, compensation.StatusRejected)
+}
+
+func (s *CompensationService) review(ctx context.Context, id, orgID, reviewerID int, comment string, status compensation.Status) (*ent.Compensation, error) {
+	record, err := s.Get(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Status != compensation.StatusPending {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Only pending compensation records can be reviewed",
+		}
+	}
+	if record.RequesterID == reviewerID {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "You cannot review your own salary change request",
+		}
+	}
+	if record.EmployeeID == reviewerID {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "You cannot review a salary change for yourself",
+		}
+	}
+
+	cUpdate := s.Client.Compensation.UpdateOneID(id).
+		Where(compensation.StatusEQ(compensation.StatusPending)).
+		SetStatus(status).
+		SetReviewerID(reviewerID).
+		SetReviewedAt(time.Now())
+	if comment != "" {
+		cUpdate.SetReviewComment(comment)
+	}
+
+	updated, err := cUpdate.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Compensation record has already been reviewed",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to review compensation record",
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,213 @@
+package compensation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/compensation"
+	"github.com/longgggwwww/hrm-ms-hr/ent/department"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/ent/salarygrade"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// CreateSalaryGrade creates a new pay band in the caller's organization
+func (s *CompensationService) CreateSalaryGrade(ctx context.Context, orgID int, input dtos.SalaryGradeCreateInput) (*ent.SalaryGrade, error) {
+	if input.MaxAmount < input.MinAmount {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "max_amount must be greater than or equal to min_amount",
+		}
+	}
+
+	exists, err := s.Client.SalaryGrade.Query().
+		Where(salarygrade.OrgID(orgID), salarygrade.Code(input.Code)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check salary grade code",
+		}
+	}
+	if exists {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Salary grade code already exists",
+		}
+	}
+
+	sgCreate := s.Client.SalaryGrade.Create().
+		SetCode(input.Code).
+		SetName(input.Name).
+		SetMinAmount(input.MinAmount).
+		SetMaxAmount(input.MaxAmount).
+		SetOrgID(orgID)
+
+	if input.Currency != "" {
+		sgCreate.SetCurrency(input.Currency)
+	}
+	if input.PositionID != nil {
+		if err := s.checkPosition(ctx, orgID, *input.PositionID); err != nil {
+			return nil, err
+		}
+		sgCreate.SetPositionID(*input.PositionID)
+	}
+
+	grade, err := sgCreate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create salary grade",
+		}
+	}
+	return grade, nil
+}
+
+// ListSalaryGrades retrieves all pay bands of the caller's organization
+func (s *CompensationService) ListSalaryGrades(ctx context.Context, orgID, positionID int) ([]*ent.SalaryGrade, error) {
+	sgQuery := s.Client.SalaryGrade.Query().
+		Where(salarygrade.OrgID(orgID)).
+		WithPosition()
+
+	if positionID > 0 {
+		sgQuery = sgQuery.Where(salarygrade.PositionID(positionID))
+	}
+
+	grades, err := sgQuery.Order(ent.Asc(salarygrade.FieldMinAmount), ent.Asc(salarygrade.FieldID)).All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch salary grades",
+		}
+	}
+	return grades, nil
+}
+
+// GetSalaryGrade retrieves a single pay band of the caller's organization
+func (s *CompensationService) GetSalaryGrade(ctx context.Context, id, orgID int) (*ent.SalaryGrade, error) {
+	grade, err := s.Client.SalaryGrade.Query().
+		Where(salarygrade.ID(id), salarygrade.OrgID(orgID)).
+		WithPosition().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Salary grade not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch salary grade",
+		}
+	}
+	return grade, nil
+}
+
+// UpdateSalaryGrade updates a pay band. Existing compensation records are not affected.
+func (s *CompensationService) UpdateSalaryGrade(ctx context.Context, id, orgID int, input dtos.SalaryGradeUpdateInput) (*ent.SalaryGrade, error) {
+	grade, err := s.GetSalaryGrade(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	minAmount := grade.MinAmount
+	if input.MinAmount != nil {
+		minAmount = *input.MinAmount
+	}
+	maxAmount := grade.MaxAmount
+	if input.MaxAmount != nil {
+		maxAmount = *input.MaxAmount
+	}
+	if maxAmount < minAmount {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "max_amount must be greater than or equal to min_amount",
+		}
+	}
+
+	sgUpdate := s.Client.SalaryGrade.UpdateOneID(id).
+		SetMinAmount(minAmount).
+		SetMaxAmount(maxAmount)
+
+	if input.Name != nil {
+		sgUpdate.SetName(*input.Name)
+	}
+	if input.Currency != nil {
+		sgUpdate.SetCurrency(*input.Currency)
+	}
+	// position_id = 0 detaches the grade from its position
+	if input.PositionID != nil {
+		if *input.PositionID == 0 {
+			sgUpdate.ClearPositionID()
+		} else {
+			if err := s.checkPosition(ctx, orgID, *input.PositionID); err != nil {
+				return nil, err
+			}
+			sgUpdate.SetPositionID(*input.PositionID)
+		}
+	}
+
+	updated, err := sgUpdate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update salary grade",
+		}
+	}
+	return updated, nil
+}
+
+// DeleteSalaryGrade deletes a pay band that is not referenced by any compensation record
+func (s *CompensationService) DeleteSalaryGrade(ctx context.Context, id, orgID int) error {
+	if _, err := s.GetSalaryGrade(ctx, id, orgID); err != nil {
+		return err
+	}
+
+	inUse, err := s.Client.Compensation.Query().
+		Where(compensation.SalaryGradeID(id)).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check salary grade usage",
+		}
+	}
+	if inUse {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Salary grade is referenced by compensation history and cannot be deleted",
+		}
+	}
+
+	if err := s.Client.SalaryGrade.DeleteOneID(id).Exec(ctx); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete salary grade",
+		}
+	}
+	return nil
+}
+
+func (s *CompensationService) checkPosition(ctx context.Context, orgID, positionID int) error {
+	exists, err := s.Client.Position.Query().
+		Where(
+			position.ID(positionID),
+			position.HasDepartmentWith(department.OrgID(orgID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate position",
+		}
+	}
+	if !exists {
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Position not found in your organization",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package compensation
+
+import (
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// CompensationService provides business logic for salary grades and compensation history
+type CompensationService struct {
+	Client *ent.Client
+}
+
+// NewCompensationService creates a new compensation service
+func NewCompensationService(client *ent.Client) *CompensationService {
+	return &CompensationService{
+		Client: client,
+	}
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employeecontract"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// CreateContract ghi nhận hợp đồng mới cho employee, hợp đồng đang hiệu lực trước đó sẽ hết hạn
+func (s *EmployeeService) CreateContract(ctx context.Context, orgID int, empID int, input dtos.EmployeeContractCreateInput) (*ent.EmployeeContract, error) {
+	startAt, err := time.Parse(time.RFC3339, input.StartAt)
+	if err != nil {
+		return nil, &ServiceError{Status: http.StatusBadRequest, Msg: "#1 CreateContract: Invalid start_at format, must be RFC3339"}
+	}
+	var endAt *time.Time
+	if input.EndAt != nil {
+		t, err := time.Parse(time.RFC3339, *input.EndAt)
+		if err != nil {
+			return nil, &ServiceError{Status: http.StatusBadRequest, Msg: "#2 CreateContract: Invalid end_at format, must be RFC3339"}
+		}
+		if t.Before(startAt) {
+			return nil, &ServiceError{Status: http.StatusBadRequest, Msg: "#3 CreateContract: end_at must not be before start_at"}
+		}
+		endAt = &t
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#4 CreateContract: Failed to start transaction"}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	exists, err := tx.Employee.Query().Where(employee.ID(empID), employee.OrgID(orgID)).Exist(ctx)
+	if err != nil || !exists {
+		tx.Rollback()
+		return nil, &ServiceError{Status: http.StatusNotFound, Msg: "#5 CreateContract: Employee not found or not in your organization"}
+	}
+
+	_, err = tx.EmployeeContract.Update().
+		Where(
+			employeecontract.EmployeeID(empID),
+			employeecontract.StatusEQ(employeecontract.StatusActive),
+		).
+		SetStatus(employeecontract.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#6 CreateContract: Failed to expire previous contracts"}
+	}
+
+	contract, err := tx.EmployeeContract.Create().
+		SetEmployeeID(empID).
+		SetCode(input.Code).
+		SetType(employeecontract.Type(input.Type)).
+		SetStartAt(startAt).
+		SetNillableEndAt(endAt).
+		SetNillableNote(input.Note).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#7 CreateContract: " + err.Error()}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#8 CreateContract: Failed to commit transaction"}
+	}
+	return contract, nil
+}
+
+// ListContracts trả về danh sách hợp đồng của employee, mới nhất trước
+func (s *EmployeeService) ListContracts(ctx context.Context, orgID int, empID int) ([]*ent.EmployeeContract, error) {
+	return s.Client.EmployeeContract.Query().
+		Where(
+			employeecontract.EmployeeID(empID),
+			employeecontract.HasEmployeeWith(employee.OrgID(orgID)),
+		).
+		Order(employeecontract.ByStartAt(sql.OrderDesc())).
+		All(ctx)
+}
@@ -269,7 +269,7 @@ func (s *EmployeeService) DeleteById(ctx context.Context, id int, orgID int) (*e
 	return emp, nil
 }
 
-func (s *EmployeeService) UpdateById(ctx context.Context, id int, orgID int, input dtos.EmployeeUpdateInput) (*ent.Employee, *grpc_clients.User, error) {
+func (s *EmployeeService) UpdateById(ctx context.Context, id int, orgID int, actorID int, input dtos.EmployeeUpdateInput) (*ent.Employee, *grpc_clients.User, error) {
 	tx, err := s.Client.Tx(ctx)
 	if err != nil {
 		return nil, nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#1 UpdateById: Failed to start transaction"}
@@ -306,6 +306,21 @@ func (s *EmployeeService) UpdateById(ctx context.Context, id int, orgID int, inp
 		return nil, nil, &ServiceError{Status: http.StatusInternalServerError, Msg: err.Error()}
 	}
 
+	// Lưu lịch sử thay đổi trạng thái để hiển thị trên timeline
+	if updatedEmp.Status != emp.Status {
+		sh := tx.EmployeeStatusHistory.Create().
+			SetEmployeeID(id).
+			SetFromStatus(string(emp.Status)).
+			SetToStatus(string(updatedEmp.Status))
+		if actorID > 0 {
+			sh.SetChangedBy(actorID)
+		}
+		if _, err := sh.Save(ctx); err != nil {
+			tx.Rollback()
+			return nil, nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#3 UpdateById: Failed to save status history"}
+		}
+	}
+
 	var userInfo *grpc_clients.User
 	if emp.UserID != "" && s.UserClient != nil {
 		if uid, err := strconv.Atoi(emp.UserID); err == nil {
@@ -339,7 +354,7 @@ func (s *EmployeeService) UpdateById(ctx context.Context, id int, orgID int, inp
 			userResp, err := s.UserClient.UpdateUser(ctx, userReq)
 			if err != nil {
 				tx.Rollback()
-				return nil, nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#3 UpdateById: Failed to update user info: " + err.Error()}
+				return nil, nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#4 UpdateById: Failed to update user info: " + err.Error()}
 			}
 			if userResp != nil {
 				userInfo = userResp.User
@@ -348,7 +363,7 @@ func (s *EmployeeService) UpdateById(ctx context.Context, id int, orgID int, inp
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#4 UpdateById: Failed to commit transaction"}
+		return nil, nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#5 UpdateById: Failed to commit transaction"}
 	}
 
 	return updatedEmp, userInfo, nil
@@ -394,14 +409,31 @@ func (s *EmployeeService) UpdatePositionAndLogHistory(ctx context.Context, orgID
 		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: err.Error()}
 	}
 
-	ah := s.Client.AppointmentHistory.Create().
-		SetEmployeeID(empID).
-		SetPositionName(pos.Name)
+	effectiveFrom := updatedEmp.JoiningAt
 	if !joiningAtTime.IsZero() {
-		ah.SetJoiningAt(joiningAtTime)
-	} else {
-		ah.SetJoiningAt(updatedEmp.JoiningAt)
+		effectiveFrom = joiningAtTime
 	}
+
+	// Đóng bản ghi bổ nhiệm đang hiệu lực trước khi tạo bản ghi mới
+	_, err = tx.AppointmentHistory.Update().
+		Where(
+			appointmenthistory.EmployeeID(empID),
+			appointmenthistory.EffectiveToIsNil(),
+			appointmenthistory.JoiningAtLTE(effectiveFrom),
+		).
+		SetEffectiveTo(effectiveFrom).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#4 UpdatePositionAndLogHistory: Failed to close previous appointment: " + err.Error()}
+	}
+
+	ah := tx.AppointmentHistory.Create().
+		SetEmployeeID(empID).
+		SetPositionID(pos.ID).
+		SetPositionName(pos.Name).
+		SetDepartmentID(pos.DepartmentID).
+		SetJoiningAt(effectiveFrom)
 	if input.Description != "" {
 		ah.SetDescription(input.Description)
 	}
@@ -411,11 +443,11 @@ func (s *EmployeeService) UpdatePositionAndLogHistory(ctx context.Context, orgID
 	_, err = ah.Save(ctx)
 	if err != nil {
 		tx.Rollback()
-		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#4 UpdatePositionAndLogHistory: Failed to save appointment history: " + err.Error()}
+		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#5 UpdatePositionAndLogHistory: Failed to save appointment history: " + err.Error()}
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#5 UpdatePositionAndLogHistory: Failed to commit transaction"}
+		return nil, &ServiceError{Status: http.StatusInternalServerError, Msg: "#6 UpdatePositionAndLogHistory: Failed to commit transaction"}
 	}
 	return updatedEmp, nil
 }
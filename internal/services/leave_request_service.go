@@ -9,6 +9,8 @@ import (
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaveapproval"
 	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	notificationService "github.com/longgggwwww/hrm-ms-hr/internal/services/notification"
 )
 
 // DTO cho tạo mới đơn nghỉ phép
@@ -134,6 +136,7 @@ func ApproveLeaveRequest(ctx context.Context, client *ent.Client, id int, review
 	if err != nil {
 		return nil, err
 	}
+	notifyLeaveDecided(ctx, client, leave, reviewerID)
 	// Eager load applicant và leave_approves
 	return client.LeaveRequest.Query().
 		Where(leaverequest.ID(leave.ID)).
@@ -166,6 +169,7 @@ func Reject(ctx context.Context, client *ent.Client, id int, reviewerID int) (*e
 	if err != nil {
 		return nil, err
 	}
+	notifyLeaveDecided(ctx, client, leave, reviewerID)
 	// Eager load applicant và leave_approves
 	return client.LeaveRequest.Query().
 		Where(leaverequest.ID(leave.ID)).
@@ -188,6 +192,22 @@ func RejectByEmployee(ctx context.Context, client *ent.Client, id int, employeeI
 		Save(ctx)
 }
 
+// Thông báo cho người nộp đơn kết quả duyệt
+func notifyLeaveDecided(ctx context.Context, client *ent.Client, leave *ent.LeaveRequest, reviewerID int) {
+	notice := notificationService.Notice{
+		OrgID:           leave.OrgID,
+		Type:            notification.TypeLeaveDecided,
+		RecipientIDs:    []int{leave.EmployeeID},
+		ActorEmployeeID: reviewerID,
+		Title:           "Your leave request was " + string(leave.Status),
+		RefID:           leave.ID,
+	}
+	if leave.Reason != nil {
+		notice.Body = *leave.Reason
+	}
+	notificationService.NewNotificationService(client).Notify(ctx, notice)
+}
+
 type ServiceError struct {
 	Status int
 	Msg    string
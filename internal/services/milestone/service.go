@@ -0,0 +1,274 @@
+package milestone
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// MilestoneService provides business logic for project milestones
+type MilestoneService struct {
+	Client *ent.Client
+}
+
+// NewMilestoneService creates a new milestone service
+func NewMilestoneService(client *ent.Client) *MilestoneService {
+	return &MilestoneService{
+		Client: client,
+	}
+}
+
+// List returns the milestones of a project by due date
+func (s *MilestoneService) List(ctx context.Context, projectID, orgID int, query dtos.MilestoneListQuery) ([]dtos.MilestoneResponse, error) {
+	if err := s.checkProject(ctx, projectID, orgID); err != nil {
+		return nil, err
+	}
+
+	milestoneQuery := s.Client.Milestone.Query().Where(milestone.ProjectID(projectID))
+	if query.State != "" {
+		milestoneQuery = milestoneQuery.Where(milestone.StateEQ(milestone.State(query.State)))
+	}
+	rows, err := milestoneQuery.
+		WithTasks(func(q *ent.TaskQuery) {
+			q.Select(task.FieldMilestoneID, task.FieldStatus)
+		}).
+		Order(ent.Asc(milestone.FieldEndAt), ent.Asc(milestone.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch milestones",
+		}
+	}
+
+	response := make([]dtos.MilestoneResponse, len(rows))
+	for i, row := range rows {
+		response[i] = toResponse(row)
+	}
+	return response, nil
+}
+
+// Create adds a milestone to a project
+func (s *MilestoneService) Create(ctx context.Context, projectID, orgID, employeeID int, input dtos.MilestoneCreateInput) (*dtos.MilestoneResponse, error) {
+	if err := s.checkProject(ctx, projectID, orgID); err != nil {
+		return nil, err
+	}
+	startAt, endAt, err := parseRange(input.StartAt, input.EndAt)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.Client.Milestone.Create().
+		SetProjectID(projectID).
+		SetName(input.Name).
+		SetGoal(input.Goal).
+		SetNillableStartAt(startAt).
+		SetNillableEndAt(endAt).
+		SetCreatorID(employeeID).
+		Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create milestone",
+		}
+	}
+	return s.Get(ctx, row.ID, orgID)
+}
+
+// Get returns a milestone with the progress of its tasks
+func (s *MilestoneService) Get(ctx context.Context, id, orgID int) (*dtos.MilestoneResponse, error) {
+	row, err := s.Client.Milestone.Query().
+		Where(milestone.ID(id), milestone.HasProjectWith(project.OrgID(orgID))).
+		WithTasks(func(q *ent.TaskQuery) {
+			q.Select(task.FieldMilestoneID, task.FieldStatus)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Milestone not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch milestone",
+		}
+	}
+	response := toResponse(row)
+	return &response, nil
+}
+
+// Update changes a milestone, including its state
+func (s *MilestoneService) Update(ctx context.Context, id, orgID int, input dtos.MilestoneUpdateInput) (*dtos.MilestoneResponse, error) {
+	row, err := s.Client.Milestone.Query().
+		Where(milestone.ID(id), milestone.HasProjectWith(project.OrgID(orgID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Milestone not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch milestone",
+		}
+	}
+
+	startAt, endAt := formatTime(row.StartAt), formatTime(row.EndAt)
+	if input.StartAt != nil {
+		startAt = input.StartAt
+	}
+	if input.EndAt != nil {
+		endAt = input.EndAt
+	}
+	start, end, err := parseRange(startAt, endAt)
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.Client.Milestone.UpdateOneID(id).
+		SetNillableStartAt(start).
+		SetNillableEndAt(end)
+	if input.Name != nil {
+		update.SetName(*input.Name)
+	}
+	if input.Goal != nil {
+		update.SetGoal(*input.Goal)
+	}
+	if input.State != nil {
+		update.SetState(milestone.State(*input.State))
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update milestone",
+		}
+	}
+	return s.Get(ctx, id, orgID)
+}
+
+// Delete removes a milestone; its tasks are kept without one
+func (s *MilestoneService) Delete(ctx context.Context, id, orgID int) error {
+	deleted, err := s.Client.Milestone.Delete().
+		Where(milestone.ID(id), milestone.HasProjectWith(project.OrgID(orgID))).
+		Exec(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete milestone",
+		}
+	}
+	if deleted == 0 {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Milestone not found",
+		}
+	}
+	return nil
+}
+
+// checkProject makes sure the project exists in the organization
+func (s *MilestoneService) checkProject(ctx context.Context, projectID, orgID int) error {
+	exists, err := s.Client.Project.Query().
+		Where(project.ID(projectID), project.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project",
+		}
+	}
+	if !exists {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Project not found",
+		}
+	}
+	return nil
+}
+
+// parseRange parses the optional dates of a milestone
+func parseRange(startAt, endAt *string) (*time.Time, *time.Time, error) {
+	var start, end *time.Time
+	if startAt != nil {
+		t, err := time.Parse(time.RFC3339, *startAt)
+		if err != nil {
+			return nil, nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid start_at format, must be RFC3339",
+			}
+		}
+		start = &t
+	}
+	if endAt != nil {
+		t, err := time.Parse(time.RFC3339, *endAt)
+		if err != nil {
+			return nil, nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid end_at format, must be RFC3339",
+			}
+		}
+		end = &t
+	}
+	if start != nil && end != nil && end.Before(*start) {
+		return nil, nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "end_at must not be before start_at",
+		}
+	}
+	return start, end, nil
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
+
+// toResponse converts a milestone loaded with its tasks
+func toResponse(row *ent.Milestone) dtos.MilestoneResponse {
+	response := dtos.MilestoneResponse{
+		ID:         row.ID,
+		ProjectID:  row.ProjectID,
+		Name:       row.Name,
+		Goal:       row.Goal,
+		State:      string(row.State),
+		CreatorID:  row.CreatorID,
+		TotalTasks: len(row.Edges.Tasks),
+		CreatedAt:  row.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  row.UpdatedAt.Format(time.RFC3339),
+	}
+	if row.StartAt != nil {
+		response.StartAt = row.StartAt.Format(time.RFC3339)
+	}
+	if row.EndAt != nil {
+		response.EndAt = row.EndAt.Format(time.RFC3339)
+	}
+	for _, t := range row.Edges.Tasks {
+		if t.Status == task.StatusCompleted {
+			response.CompletedTasks++
+		}
+	}
+	return response
+}
@@ -0,0 +1,31 @@
+package notification
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// encodeCursor encodes the ID of the last returned row as an opaque cursor
+func encodeCursor(lastID int) string {
+	data, _ := json.Marshal(map[string]interface{}{"last_id": lastID})
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// decodeCursor returns the row ID stored in a cursor made by encodeCursor
+func decodeCursor(cursor string) (int, error) {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return 0, err
+	}
+	lastID, ok := result["last_id"].(float64)
+	if !ok {
+		return 0, errors.New("cursor has no last_id")
+	}
+	return int(lastID), nil
+}
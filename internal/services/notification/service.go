@@ -0,0 +1,336 @@
+package notification
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notificationpreference"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// Types are the kinds of notifications employees can turn off
+var Types = []notification.Type{
+	notification.TypeTaskAssigned,
+	notification.TypeTaskStatusChanged,
+	notification.TypeTaskDueSoon,
+	notification.TypeTaskOverdue,
+	notification.TypeTaskMentioned,
+	notification.TypeLeaveDecided,
+}
+
+// Notice is a notification to send to a set of employees
+type Notice struct {
+	OrgID int
+	Type  notification.Type
+	// RecipientIDs are employees; the actor is never notified of their own
+	// change
+	RecipientIDs    []int
+	ActorEmployeeID int
+	Title           string
+	Body            string
+	TaskID          int
+	RefID           int
+	// DedupKey sends the notice at most once to each recipient
+	DedupKey string
+}
+
+// NotificationService provides business logic for in-app notifications
+type NotificationService struct {
+	Client *ent.Client
+}
+
+// NewNotificationService creates a new notification service. Notices are
+// stored with the client, so one built on a transaction's client only
+// notifies when the transaction commits.
+func NewNotificationService(client *ent.Client) *NotificationService {
+	return &NotificationService{
+		Client: client,
+	}
+}
+
+// Notify stores a notice for each recipient that has not turned its type off.
+// Like Kafka events, notifications never fail the change they are about, so
+// errors are only logged.
+func (s *NotificationService) Notify(ctx context.Context, notice Notice) {
+	recipients := make([]int, 0, len(notice.RecipientIDs))
+	seen := make(map[int]bool, len(notice.RecipientIDs))
+	for _, id := range notice.RecipientIDs {
+		if id != 0 && id != notice.ActorEmployeeID && !seen[id] {
+			seen[id] = true
+			recipients = append(recipients, id)
+		}
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	muted, err := s.Client.NotificationPreference.Query().
+		Where(
+			notificationpreference.EmployeeIDIn(recipients...),
+			notificationpreference.TypeEQ(notificationpreference.Type(notice.Type)),
+			notificationpreference.Enabled(false),
+		).
+		Select(notificationpreference.FieldEmployeeID).
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to fetch notification preferences: %v", err)
+		return
+	}
+	off := make(map[int]bool, len(muted))
+	for _, pref := range muted {
+		off[pref.EmployeeID] = true
+	}
+
+	builders := make([]*ent.NotificationCreate, 0, len(recipients))
+	for _, id := range recipients {
+		if off[id] {
+			continue
+		}
+		create := s.Client.Notification.Create().
+			SetOrgID(notice.OrgID).
+			SetEmployeeID(id).
+			SetType(notice.Type).
+			SetTitle(notice.Title)
+		if notice.Body != "" {
+			create.SetBody(notice.Body)
+		}
+		if notice.TaskID != 0 {
+			create.SetTaskID(notice.TaskID)
+		}
+		if notice.RefID != 0 {
+			create.SetRefID(notice.RefID)
+		}
+		if notice.ActorEmployeeID != 0 {
+			create.SetActorEmployeeID(notice.ActorEmployeeID)
+		}
+		if notice.DedupKey != "" {
+			create.SetDedupKey(notice.DedupKey)
+		}
+		builders = append(builders, create)
+	}
+	if len(builders) == 0 {
+		return
+	}
+
+	err = s.Client.Notification.CreateBulk(builders...).
+		OnConflict(
+			sql.ConflictColumns(notification.FieldEmployeeID, notification.FieldDedupKey),
+			sql.DoNothing(),
+		).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Failed to store %s notifications: %v", notice.Type, err)
+	}
+}
+
+// List returns the notifications of an employee, newest first, with the number
+// of unread ones
+func (s *NotificationService) List(ctx context.Context, orgID, employeeID int, query dtos.NotificationListQuery) (*dtos.NotificationListResponse, error) {
+	limit := query.Limit
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	notificationQuery := s.Client.Notification.Query().
+		Where(notification.OrgID(orgID), notification.EmployeeID(employeeID))
+	if query.Unread {
+		notificationQuery = notificationQuery.Where(notification.ReadAtIsNil())
+	}
+	if query.Type != "" {
+		if err := notification.TypeValidator(notification.Type(query.Type)); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid type value",
+			}
+		}
+		notificationQuery = notificationQuery.Where(notification.TypeEQ(notification.Type(query.Type)))
+	}
+	if query.Cursor != "" {
+		lastID, err := decodeCursor(query.Cursor)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid cursor format",
+			}
+		}
+		notificationQuery = notificationQuery.Where(notification.IDLT(lastID))
+	}
+
+	// Fetch limit + 1 to check if there are more items
+	rows, err := notificationQuery.
+		Order(ent.Desc(notification.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch notifications",
+		}
+	}
+	hasNext := len(rows) > limit
+	if hasNext {
+		rows = rows[:limit]
+	}
+	var nextCursor *string
+	if hasNext && len(rows) > 0 {
+		cursor := encodeCursor(rows[len(rows)-1].ID)
+		nextCursor = &cursor
+	}
+
+	unread, err := s.Client.Notification.Query().
+		Where(notification.OrgID(orgID), notification.EmployeeID(employeeID)).
+		Where(notification.ReadAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count unread notifications",
+		}
+	}
+
+	return &dtos.NotificationListResponse{
+		Data:        rows,
+		UnreadCount: unread,
+		Pagination: dtos.CursorPagination{
+			Type:       "cursor",
+			PerPage:    limit,
+			HasNext:    hasNext,
+			NextCursor: nextCursor,
+		},
+	}, nil
+}
+
+// SetRead marks a notification of an employee as read or unread
+func (s *NotificationService) SetRead(ctx context.Context, orgID, employeeID, id int, read bool) (*ent.Notification, error) {
+	update := s.Client.Notification.UpdateOneID(id).
+		Where(notification.OrgID(orgID), notification.EmployeeID(employeeID))
+	if read {
+		// Keep when it was first read
+		row, err := s.Client.Notification.Query().
+			Where(notification.ID(id), notification.OrgID(orgID), notification.EmployeeID(employeeID)).
+			Only(ctx)
+		if err != nil {
+			return nil, notFound(err)
+		}
+		if row.ReadAt != nil {
+			return row, nil
+		}
+		update.SetReadAt(time.Now())
+	} else {
+		update.ClearReadAt()
+	}
+
+	row, err := update.Save(ctx)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return row, nil
+}
+
+// MarkAllRead marks every unread notification of an employee as read and
+// returns how many there were
+func (s *NotificationService) MarkAllRead(ctx context.Context, orgID, employeeID int) (int, error) {
+	count, err := s.Client.Notification.Update().
+		Where(notification.OrgID(orgID), notification.EmployeeID(employeeID)).
+		Where(notification.ReadAtIsNil()).
+		SetReadAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to mark notifications as read",
+		}
+	}
+	return count, nil
+}
+
+// Preferences returns whether each type of notification is on for an employee
+func (s *NotificationService) Preferences(ctx context.Context, orgID, employeeID int) (map[string]bool, error) {
+	rows, err := s.Client.NotificationPreference.Query().
+		Where(
+			notificationpreference.OrgID(orgID),
+			notificationpreference.EmployeeID(employeeID),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch notification preferences",
+		}
+	}
+
+	prefs := make(map[string]bool, len(Types))
+	for _, t := range Types {
+		prefs[string(t)] = true
+	}
+	for _, row := range rows {
+		prefs[string(row.Type)] = row.Enabled
+	}
+	return prefs, nil
+}
+
+// UpdatePreferences turns types of notification on or off for an employee;
+// types left out keep their setting
+func (s *NotificationService) UpdatePreferences(ctx context.Context, orgID, employeeID int, input dtos.NotificationPreferencesInput) (map[string]bool, error) {
+	if employeeID == 0 {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only employees have notification preferences",
+		}
+	}
+	for key, enabled := range input.Types {
+		if err := notificationpreference.TypeValidator(notificationpreference.Type(key)); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Unknown notification type " + key,
+			}
+		}
+		err := s.Client.NotificationPreference.Create().
+			SetOrgID(orgID).
+			SetEmployeeID(employeeID).
+			SetType(notificationpreference.Type(key)).
+			SetEnabled(enabled).
+			OnConflictColumns(notificationpreference.FieldEmployeeID, notificationpreference.FieldType).
+			UpdateEnabled().
+			UpdateUpdatedAt().
+			Exec(ctx)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to save notification preferences",
+			}
+		}
+	}
+	return s.Preferences(ctx, orgID, employeeID)
+}
+
+func notFound(err error) error {
+	if ent.IsNotFound(err) {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Notification not found",
+		}
+	}
+	return &ServiceError{
+		Status: http.StatusInternalServerError,
+		Msg:    "Failed to update notification",
+	}
+}
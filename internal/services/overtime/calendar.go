@@ -0,0 +1,143 @@
+package overtime
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/holiday"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/calendar"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// DayType classifies a day of an organization as weekday, weekend or holiday
+func (s *OvertimeService) DayType(ctx context.Context, orgID int, day time.Time) (overtimerequest.DayType, error) {
+	cal, err := calendar.NewCalendarService(s.Client).Load(ctx, orgID, day, day)
+	if err != nil {
+		return "", calendarError(err)
+	}
+	if cal.IsHoliday(day) {
+		return overtimerequest.DayTypeHoliday, nil
+	}
+	if cal.IsWeekend(day) {
+		return overtimerequest.DayTypeWeekend, nil
+	}
+	return overtimerequest.DayTypeWeekday, nil
+}
+
+// GetWeekend returns the weekdays the organization treats as weekend
+func (s *OvertimeService) GetWeekend(ctx context.Context, orgID int) ([]int, error) {
+	weekend, err := calendar.NewCalendarService(s.Client).Weekend(ctx, orgID)
+	if err != nil {
+		return nil, calendarError(err)
+	}
+	return weekend, nil
+}
+
+// SetWeekend replaces the weekdays the organization treats as weekend.
+// An empty list restores the default of Saturday and Sunday.
+func (s *OvertimeService) SetWeekend(ctx context.Context, orgID int, input dtos.WeekendUpdateInput) ([]int, error) {
+	oUpdate := s.Client.Organization.UpdateOneID(orgID)
+	if len(input.WeekendDays) == 0 {
+		oUpdate.ClearWeekendDays()
+	} else {
+		oUpdate.SetWeekendDays(input.WeekendDays)
+	}
+	if err := oUpdate.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Organization not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update weekend days",
+		}
+	}
+	return s.GetWeekend(ctx, orgID)
+}
+
+// ListHolidays retrieves the holidays of the organization in the given year
+func (s *OvertimeService) ListHolidays(ctx context.Context, orgID, year int) ([]*ent.Holiday, error) {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local)
+	holidays, err := s.Client.Holiday.Query().
+		Where(
+			holiday.OrgID(orgID),
+			holiday.DateGTE(from),
+			holiday.DateLT(from.AddDate(1, 0, 0)),
+		).
+		Order(ent.Asc(holiday.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch holidays",
+		}
+	}
+	return holidays, nil
+}
+
+// CreateHoliday adds a holiday to the organization's calendar
+func (s *OvertimeService) CreateHoliday(ctx context.Context, orgID int, input dtos.HolidayCreateInput) (*ent.Holiday, error) {
+	day, err := utils.ParseDate(input.Date)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid date format, must be YYYY-MM-DD",
+		}
+	}
+
+	created, err := s.Client.Holiday.Create().
+		SetOrgID(orgID).
+		SetDate(day).
+		SetName(input.Name).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "A holiday already exists on this date",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create holiday",
+		}
+	}
+	return created, nil
+}
+
+// DeleteHoliday removes a holiday from the organization's calendar
+func (s *OvertimeService) DeleteHoliday(ctx context.Context, id, orgID int) error {
+	deleted, err := s.Client.Holiday.Delete().
+		Where(holiday.ID(id), holiday.OrgID(orgID)).
+		Exec(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete holiday",
+		}
+	}
+	if deleted == 0 {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Holiday not found",
+		}
+	}
+	return nil
+}
+
+// calendarError converts an error of the calendar service
+func calendarError(err error) error {
+	if calendarErr, ok := err.(*calendar.ServiceError); ok {
+		return &ServiceError{
+			Status: calendarErr.Status,
+			Msg:    calendarErr.Msg,
+		}
+	}
+	return err
+}
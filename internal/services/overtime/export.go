@@ -0,0 +1,76 @@
+package overtime
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+)
+
+// PayrollExport builds the CSV rows of approved overtime in a month for payroll, one row per
+// request with the applicable pay multiplier. departmentID = 0 covers the whole organization.
+func (s *OvertimeService) PayrollExport(ctx context.Context, orgID, departmentID int, month time.Time) ([][]string, error) {
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Local)
+
+	otQuery := s.Client.OvertimeRequest.Query().
+		Where(
+			overtimerequest.OrgID(orgID),
+			overtimerequest.StatusEQ(overtimerequest.StatusApproved),
+			overtimerequest.WorkDateGTE(from),
+			overtimerequest.WorkDateLT(from.AddDate(0, 1, 0)),
+		).
+		WithEmployee().
+		WithProject().
+		WithTask()
+	if departmentID > 0 {
+		otQuery = otQuery.Where(overtimerequest.HasEmployeeWith(
+			employee.HasPositionWith(position.DepartmentID(departmentID)),
+		))
+	}
+
+	requests, err := otQuery.
+		Order(ent.Asc(overtimerequest.FieldEmployeeID), ent.Asc(overtimerequest.FieldWorkDate)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch approved overtime",
+		}
+	}
+
+	rows := [][]string{{
+		"request_id", "employee_id", "employee_code", "work_date", "day_type",
+		"hours", "multiplier", "weighted_hours", "project_code", "task_code",
+	}}
+	for _, r := range requests {
+		multiplier := PayMultipliers[r.DayType]
+		row := []string{
+			strconv.Itoa(r.ID),
+			strconv.Itoa(r.EmployeeID),
+			"",
+			r.WorkDate.In(time.Local).Format("2006-01-02"),
+			string(r.DayType),
+			strconv.FormatFloat(r.Hours, 'f', 2, 64),
+			strconv.FormatFloat(multiplier, 'f', 1, 64),
+			strconv.FormatFloat(r.Hours*multiplier, 'f', 2, 64),
+			"",
+			"",
+		}
+		if r.Edges.Employee != nil {
+			row[2] = r.Edges.Employee.Code
+		}
+		if r.Edges.Project != nil {
+			row[8] = r.Edges.Project.Code
+		}
+		if r.Edges.Task != nil {
+			row[9] = r.Edges.Task.Code
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
@@ -0,0 +1,362 @@
+package overtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// Create submits an overtime request. The day is classified against the organization's
+// work calendar, the legal caps are checked against the employee's pending and approved
+// overtime, and the request is routed to the holders of the employee's parent position.
+func (s *OvertimeService) Create(ctx context.Context, orgID, employeeID int, input dtos.OvertimeCreateInput) (*ent.OvertimeRequest, error) {
+	emp, err := s.Client.Employee.Query().
+		Where(employee.ID(employeeID), employee.OrgID(orgID)).
+		WithPosition().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Employee not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch employee",
+		}
+	}
+
+	day, err := utils.ParseDate(input.WorkDate)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid work_date format, must be YYYY-MM-DD",
+		}
+	}
+
+	if input.ProjectID != nil {
+		exists, err := s.Client.Project.Query().
+			Where(project.ID(*input.ProjectID), project.OrgID(orgID)).
+			Exist(ctx)
+		if err != nil || !exists {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Project not found in your organization",
+			}
+		}
+	}
+	if input.TaskID != nil {
+		tQuery := s.Client.Task.Query().
+			Where(task.ID(*input.TaskID), task.HasProjectWith(project.OrgID(orgID)))
+		if input.ProjectID != nil {
+			tQuery = tQuery.Where(task.ProjectID(*input.ProjectID))
+		}
+		exists, err := tQuery.Exist(ctx)
+		if err != nil || !exists {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Task not found in your organization or project",
+			}
+		}
+	}
+
+	dayType, err := s.DayType(ctx, orgID, day)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCaps(ctx, employeeID, day, dayType, input.Hours, 0,
+		overtimerequest.StatusPending, overtimerequest.StatusApproved); err != nil {
+		return nil, err
+	}
+
+	otCreate := s.Client.OvertimeRequest.Create().
+		SetOrgID(orgID).
+		SetEmployeeID(employeeID).
+		SetWorkDate(day).
+		SetHours(input.Hours).
+		SetReason(input.Reason).
+		SetDayType(dayType).
+		SetNillableProjectID(input.ProjectID).
+		SetNillableTaskID(input.TaskID)
+
+	if emp.Edges.Position != nil && emp.Edges.Position.ParentID != 0 {
+		otCreate.SetApproverPositionID(emp.Edges.Position.ParentID)
+	}
+
+	created, err := otCreate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create overtime request",
+		}
+	}
+	return created, nil
+}
+
+// List retrieves overtime requests of the caller's organization, newest first
+func (s *OvertimeService) List(ctx context.Context, query dtos.OvertimeListQuery) (*dtos.OvertimeListResponse, error) {
+	otQuery := s.Client.OvertimeRequest.Query().
+		Where(overtimerequest.OrgID(query.OrgID)).
+		WithEmployee().
+		WithProject().
+		WithTask()
+
+	if query.EmployeeID > 0 {
+		otQuery = otQuery.Where(overtimerequest.EmployeeID(query.EmployeeID))
+	}
+	if query.Status != "" {
+		otQuery = otQuery.Where(overtimerequest.StatusEQ(overtimerequest.Status(query.Status)))
+	}
+	if query.Month != "" {
+		month, err := time.ParseInLocation("2006-01", query.Month, time.Local)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid month format, must be YYYY-MM",
+			}
+		}
+		otQuery = otQuery.Where(
+			overtimerequest.WorkDateGTE(month),
+			overtimerequest.WorkDateLT(month.AddDate(0, 1, 0)),
+		)
+	}
+
+	total, err := otQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count overtime requests",
+		}
+	}
+
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := query.Limit
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	requests, err := otQuery.
+		Order(ent.Desc(overtimerequest.FieldWorkDate), ent.Desc(overtimerequest.FieldID)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch overtime requests",
+		}
+	}
+
+	return &dtos.OvertimeListResponse{
+		Data: requests,
+		Pagination: dtos.OffsetPagination{
+			Type:        "page",
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			TotalItems:  total,
+			PerPage:     limit,
+		},
+	}, nil
+}
+
+// Cancel withdraws one of the employee's own pending requests
+func (s *OvertimeService) Cancel(ctx context.Context, id, orgID, employeeID int) (*ent.OvertimeRequest, error) {
+	updated, err := s.Client.OvertimeRequest.UpdateOneID(id).
+		Where(
+			overtimerequest.OrgID(orgID),
+			overtimerequest.EmployeeID(employeeID),
+			overtimerequest.StatusEQ(overtimerequest.StatusPending),
+		).
+		SetStatus(overtimerequest.StatusCancelled).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Pending overtime request not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to cancel overtime request",
+		}
+	}
+	return updated, nil
+}
+
+// Approve approves a pending request after re-checking the caps against approved overtime
+func (s *OvertimeService) Approve(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.OvertimeRequest, error) {
+	req, err := s.pendingRequest(ctx, id, orgID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCaps(ctx, req.EmployeeID, req.WorkDate, req.DayType, req.Hours, req.ID,
+		overtimerequest.StatusApproved); err != nil {
+		return nil, err
+	}
+
+	return s.review(ctx, id, reviewerID, comment, overtimerequest.StatusApproved)
+}
+
+// Reject rejects a pending request
+func (s *OvertimeService) Reject(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.OvertimeRequest, error) {
+	if _, err := s.pendingRequest(ctx, id, orgID, reviewerID); err != nil {
+		return nil, err
+	}
+
+	return s.review(ctx, id, reviewerID, comment, overtimerequest.StatusRejected)
+}
+
+func (s *OvertimeService) review(ctx context.Context, id, reviewerID int, comment string, status overtimerequest.Status) (*ent.OvertimeRequest, error) {
+	otUpdate := s.Client.OvertimeRequest.UpdateOneID(id).
+		Where(overtimerequest.StatusEQ(overtimerequest.StatusPending)).
+		SetStatus(status).
+		SetReviewerID(reviewerID).
+		SetReviewedAt(time.Now())
+	if comment != "" {
+		otUpdate.SetReviewComment(comment)
+	}
+
+	updated, err := otUpdate.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Overtime request has already been reviewed",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to review overtime request",
+		}
+	}
+	return updated, nil
+}
+
+// pendingRequest loads a pending request and checks that the reviewer is the employee's manager,
+// i.e. holds the position the request was routed to
+func (s *OvertimeService) pendingRequest(ctx context.Context, id, orgID, reviewerID int) (*ent.OvertimeRequest, error) {
+	req, err := s.Client.OvertimeRequest.Query().
+		Where(overtimerequest.ID(id), overtimerequest.OrgID(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Overtime request not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch overtime request",
+		}
+	}
+	if req.Status != overtimerequest.StatusPending {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Only pending overtime requests can be reviewed",
+		}
+	}
+	if req.EmployeeID == reviewerID {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "You cannot review your own overtime request",
+		}
+	}
+
+	if req.ApproverPositionID != nil {
+		isManager, err := s.Client.Employee.Query().
+			Where(
+				employee.ID(reviewerID),
+				employee.OrgID(orgID),
+				employee.PositionID(*req.ApproverPositionID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to validate reviewer",
+			}
+		}
+		if !isManager {
+			return nil, &ServiceError{
+				Status: http.StatusForbidden,
+				Msg:    "Only the employee's manager can review this overtime request",
+			}
+		}
+	}
+	return req, nil
+}
+
+// checkCaps verifies that adding hours on day keeps the employee within the daily, monthly
+// and yearly caps. Only requests in the given statuses count, and excludeID is ignored.
+func (s *OvertimeService) checkCaps(ctx context.Context, employeeID int, day time.Time, dayType overtimerequest.DayType, hours float64, excludeID int, statuses ...overtimerequest.Status) error {
+	sum := func(from, to time.Time) (float64, error) {
+		requests, err := s.Client.OvertimeRequest.Query().
+			Where(
+				overtimerequest.EmployeeID(employeeID),
+				overtimerequest.StatusIn(statuses...),
+				overtimerequest.IDNEQ(excludeID),
+				overtimerequest.WorkDateGTE(from),
+				overtimerequest.WorkDateLT(to),
+			).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+		var total float64
+		for _, r := range requests {
+			total += r.Hours
+		}
+		return total, nil
+	}
+
+	dailyCap := float64(MaxWeekdayHoursPerDay)
+	if dayType != overtimerequest.DayTypeWeekday {
+		dailyCap = MaxRestDayHoursPerDay
+	}
+	monthStart := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.Local)
+	yearStart := time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.Local)
+
+	caps := []struct {
+		name     string
+		from, to time.Time
+		limit    float64
+	}{
+		{"daily", day, day.AddDate(0, 0, 1), dailyCap},
+		{"monthly", monthStart, monthStart.AddDate(0, 1, 0), MaxHoursPerMonth},
+		{"yearly", yearStart, yearStart.AddDate(1, 0, 0), MaxHoursPerYear},
+	}
+	for _, c := range caps {
+		total, err := sum(c.from, c.to)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to compute overtime totals",
+			}
+		}
+		if total+hours > c.limit {
+			return &ServiceError{
+				Status: http.StatusUnprocessableEntity,
+				Msg: fmt.Sprintf("Overtime exceeds the %s cap of %.0f hours (already %.2f hours)",
+					c.name, c.limit, total),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package overtime
+
+import (
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/overtimerequest"
+)
+
+// Legal overtime caps (Labour Code 2019, article 107)
+const (
+	// Overtime on a working day may not exceed 50% of the normal 8-hour day
+	MaxWeekdayHoursPerDay = 4
+	// Overtime on a weekend or holiday may not exceed a full 12-hour day
+	MaxRestDayHoursPerDay = 12
+	MaxHoursPerMonth      = 40
+	MaxHoursPerYear       = 200
+)
+
+// PayMultipliers are the minimum overtime pay rates by day type (Labour Code 2019, article 98)
+var PayMultipliers = map[overtimerequest.DayType]float64{
+	overtimerequest.DayTypeWeekday: 1.5,
+	overtimerequest.DayTypeWeekend: 2.0,
+	overtimerequest.DayTypeHoliday: 3.0,
+}
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// OvertimeService provides business logic for overtime requests and the work calendar
+type OvertimeService struct {
+	Client *ent.Client
+}
+
+// NewOvertimeService creates a new overtime service
+func NewOvertimeService(client *ent.Client) *OvertimeService {
+	return &OvertimeService{
+		Client: client,
+	}
+}
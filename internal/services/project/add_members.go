@@ -7,13 +7,14 @@ import (
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 	"github.com/longgggwwww/hrm-ms-hr/internal/grpc_clients"
 )
 
 // AddMembers adds members to an existing project
 func (s *ProjectService) AddMembers(ctx context.Context, id, employeeID, orgID int, input dtos.ProjectAddMembersInput) (map[string]interface{}, error) {
-	// Check if the project exists and if the current user is the creator
+	// Check if the project exists
 	existingProject, err := s.Client.Project.Query().
 		Where(project.ID(id)).
 		Only(ctx)
@@ -30,11 +31,22 @@ func (s *ProjectService) AddMembers(ctx context.Context, id, employeeID, orgID i
 		}
 	}
 
-	// Check if current employee is the creator of the project
-	if existingProject.CreatorID != employeeID {
+	// Check if current employee's project role allows managing members
+	actorRole, err := s.authorize(ctx, id, employeeID, ActionManageMembers, "Only project owners and managers can add members to this project")
+	if err != nil {
+		return nil, err
+	}
+
+	// New members are plain members unless given another role; only owners
+	// hand out roles above member
+	role := projectrole.RoleMember
+	if input.Role != "" {
+		role = projectrole.Role(input.Role)
+	}
+	if (role == projectrole.RoleOwner || role == projectrole.RoleManager) && !Allows(actorRole, ActionManageRoles) {
 		return nil, &ServiceError{
 			Status: http.StatusForbidden,
-			Msg:    "Only the project creator can add members to this project",
+			Msg:    "Only project owners can add owners and managers",
 		}
 	}
 
@@ -144,6 +156,15 @@ func (s *ProjectService) AddMembers(ctx context.Context, id, employeeID, orgID i
 			Msg:    "Failed to add members to project",
 		}
 	}
+	if role != projectrole.RoleMember {
+		roles := make(map[int]projectrole.Role, len(newMemberIDs))
+		for _, memberID := range newMemberIDs {
+			roles[memberID] = role
+		}
+		if err := s.saveRoles(ctx, existingProject, roles); err != nil {
+			return nil, err
+		}
+	}
 
 	// Get the updated project with all edges
 	updatedProject, err := s.Client.Project.Query().
@@ -11,6 +11,7 @@ import (
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 	"github.com/longgggwwww/hrm-ms-hr/internal/grpc_clients"
 )
@@ -114,6 +115,29 @@ func (s *ProjectService) Create(ctx context.Context, orgID, employeeID int, inpu
 		}
 	}
 
+	// Task codes of the project are prefixed with its key, derived from the
+	// name unless given
+	var projectKey string
+	if input.Key != nil && *input.Key != "" {
+		taken, err := s.keyTaken(ctx, orgID, *input.Key, 0)
+		if err != nil {
+			return nil, err
+		}
+		if taken {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Project key already exists in your organization",
+			}
+		}
+		projectKey = *input.Key
+	} else {
+		key, err := s.availableKey(ctx, orgID, DefaultKey(input.Name))
+		if err != nil {
+			return nil, err
+		}
+		projectKey = key
+	}
+
 	// Prepare all member IDs (current employee + provided member IDs)
 	allMemberIDs := []int{}
 
@@ -157,6 +181,7 @@ func (s *ProjectService) Create(ctx context.Context, orgID, employeeID int, inpu
 	projectCreate := s.Client.Project.Create().
 		SetName(input.Name).
 		SetCode(projectCode).
+		SetKey(projectKey).
 		SetNillableDescription(input.Description).
 		SetNillableStartAt(startAtPtr).
 		SetNillableEndAt(endAtPtr).
@@ -171,12 +196,32 @@ func (s *ProjectService) Create(ctx context.Context, orgID, employeeID int, inpu
 
 	row, err := projectCreate.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Project code or key already exists in your organization",
+			}
+		}
 		return nil, &ServiceError{
 			Status: http.StatusInternalServerError,
 			Msg:    "Failed to create project",
 		}
 	}
 
+	// The creator owns the project
+	if employeeID > 0 {
+		if err := s.Client.ProjectRole.Create().
+			SetProjectID(row.ID).
+			SetEmployeeID(employeeID).
+			SetRole(projectrole.RoleOwner).
+			Exec(ctx); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to set project owner",
+			}
+		}
+	}
+
 	// Get the created project with all edges
 	createdProject, err := s.Client.Project.Query().
 		Where(project.ID(row.ID)).
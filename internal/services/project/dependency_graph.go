@@ -0,0 +1,183 @@
+package project
+
+import (
+	"context"
+	"math"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// DependencyGraph returns the tasks of a project with the links between them
+// and the critical path through its "blocks" links. A task's duration is the
+// time between its start_at and due_date; tasks missing either count as zero.
+func (s *ProjectService) DependencyGraph(ctx context.Context, projectID int) (*dtos.DependencyGraphResponse, error) {
+	exists, err := s.Client.Project.Query().Where(project.ID(projectID)).Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Project not found",
+		}
+	}
+
+	tasks, err := s.Client.Task.Query().
+		Where(task.ProjectID(projectID)).
+		Order(ent.Asc(task.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project tasks",
+		}
+	}
+
+	ids := make([]int, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+
+	links, err := s.Client.TaskDependency.Query().
+		Where(taskdependency.TaskIDIn(ids...)).
+		Where(taskdependency.TargetIDIn(ids...)).
+		Order(ent.Asc(taskdependency.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task dependencies",
+		}
+	}
+
+	return buildGraph(projectID, tasks, links), nil
+}
+
+// buildGraph lays out tasks and the links between them, and finds the
+// critical path through the "blocks" links by a forward and a backward pass
+// over them in topological order
+func buildGraph(projectID int, tasks []*ent.Task, links []*ent.TaskDependency) *dtos.DependencyGraphResponse {
+	response := &dtos.DependencyGraphResponse{
+		ProjectID:    projectID,
+		Nodes:        make([]dtos.DependencyGraphNode, len(tasks)),
+		Edges:        make([]dtos.DependencyGraphEdge, len(links)),
+		CriticalPath: []int{},
+	}
+
+	index := make(map[int]int, len(tasks))
+	for i, t := range tasks {
+		index[t.ID] = i
+		node := dtos.DependencyGraphNode{
+			ID:      t.ID,
+			Code:    t.Code,
+			Name:    t.Name,
+			Status:  string(t.Status),
+			StartAt: t.StartAt,
+			DueDate: t.DueDate,
+		}
+		if t.StartAt != nil && t.DueDate != nil && t.DueDate.After(*t.StartAt) {
+			node.DurationHours = t.DueDate.Sub(*t.StartAt).Hours()
+		}
+		response.Nodes[i] = node
+	}
+
+	preds := make([][]int, len(tasks))
+	succs := make([][]int, len(tasks))
+	inDegree := make([]int, len(tasks))
+	for i, link := range links {
+		response.Edges[i] = dtos.DependencyGraphEdge{
+			ID:       link.ID,
+			TaskID:   link.TaskID,
+			TargetID: link.TargetID,
+			Type:     string(link.Type),
+		}
+		if link.Type != taskdependency.TypeBlocks {
+			continue
+		}
+		from, to := index[link.TaskID], index[link.TargetID]
+		succs[from] = append(succs[from], to)
+		preds[to] = append(preds[to], from)
+		inDegree[to]++
+	}
+
+	// Topological order of the blocking links
+	var order []int
+	for i := range tasks {
+		if inDegree[i] == 0 {
+			order = append(order, i)
+		}
+	}
+	for i := 0; i < len(order); i++ {
+		for _, next := range succs[order[i]] {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				order = append(order, next)
+			}
+		}
+	}
+
+	// Forward pass: earliest start and finish of each task
+	es := make([]float64, len(tasks))
+	ef := make([]float64, len(tasks))
+	end := 0.0
+	for _, v := range order {
+		for _, p := range preds[v] {
+			es[v] = math.Max(es[v], ef[p])
+		}
+		ef[v] = es[v] + response.Nodes[v].DurationHours
+		end = math.Max(end, ef[v])
+	}
+
+	// Backward pass: latest start of each task without delaying the project
+	ls := make([]float64, len(tasks))
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		lf := end
+		for _, next := range succs[v] {
+			lf = math.Min(lf, ls[next])
+		}
+		ls[v] = lf - response.Nodes[v].DurationHours
+	}
+
+	const epsilon = 1e-6
+	last := -1
+	for _, v := range order {
+		node := &response.Nodes[v]
+		node.EarliestStart = roundHours(es[v])
+		node.EarliestFinish = roundHours(ef[v])
+		node.SlackHours = roundHours(ls[v] - es[v])
+		node.Critical = ls[v]-es[v] < epsilon
+		if node.Critical && (last == -1 || ef[v] > ef[last]+epsilon) {
+			last = v
+		}
+	}
+
+	// Walk back from the last critical task through critical predecessors
+	for v := last; v != -1; {
+		response.CriticalPath = append([]int{tasks[v].ID}, response.CriticalPath...)
+		next := -1
+		for _, p := range preds[v] {
+			if response.Nodes[p].Critical && math.Abs(ef[p]-es[v]) < epsilon {
+				next = p
+				break
+			}
+		}
+		v = next
+	}
+	response.CriticalPathHours = roundHours(end)
+
+	return response
+}
+
+// roundHours rounds a number of hours to two decimal places
+func roundHours(h float64) float64 {
+	return math.Round(h*100) / 100
+}
@@ -0,0 +1,142 @@
+package project
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+)
+
+func TestBuildGraph(t *testing.T) {
+	start := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	// task lasts the given hours; a negative duration leaves it undated
+	task := func(id int, hours float64) *ent.Task {
+		t := &ent.Task{ID: id}
+		if hours >= 0 {
+			due := start.Add(time.Duration(hours * float64(time.Hour)))
+			t.StartAt, t.DueDate = &start, &due
+		}
+		return t
+	}
+	link := func(from, to int, typ taskdependency.Type) *ent.TaskDependency {
+		return &ent.TaskDependency{ID: from*100 + to, TaskID: from, TargetID: to, Type: typ}
+	}
+	blocks := func(from, to int) *ent.TaskDependency {
+		return link(from, to, taskdependency.TypeBlocks)
+	}
+
+	tests := []struct {
+		name      string
+		tasks     []*ent.Task
+		links     []*ent.TaskDependency
+		wantPath  []int
+		wantHours float64
+		// wantSlack is the slack of each task by ID
+		wantSlack map[int]float64
+	}{
+		{
+			name:     "no tasks",
+			wantPath: []int{},
+		},
+		{
+			name:      "single task",
+			tasks:     []*ent.Task{task(1, 8)},
+			wantPath:  []int{1},
+			wantHours: 8,
+			wantSlack: map[int]float64{1: 0},
+		},
+		{
+			name:      "chain",
+			tasks:     []*ent.Task{task(1, 8), task(2, 4), task(3, 2)},
+			links:     []*ent.TaskDependency{blocks(1, 2), blocks(2, 3)},
+			wantPath:  []int{1, 2, 3},
+			wantHours: 14,
+			wantSlack: map[int]float64{1: 0, 2: 0, 3: 0},
+		},
+		{
+			name:      "chain listed out of order",
+			tasks:     []*ent.Task{task(1, 2), task(2, 4), task(3, 8)},
+			links:     []*ent.TaskDependency{blocks(3, 2), blocks(2, 1)},
+			wantPath:  []int{3, 2, 1},
+			wantHours: 14,
+			wantSlack: map[int]float64{1: 0, 2: 0, 3: 0},
+		},
+		{
+			name:      "parallel blockers",
+			tasks:     []*ent.Task{task(1, 8), task(2, 4), task(3, 2)},
+			links:     []*ent.TaskDependency{blocks(1, 3), blocks(2, 3)},
+			wantPath:  []int{1, 3},
+			wantHours: 10,
+			wantSlack: map[int]float64{1: 0, 2: 4, 3: 0},
+		},
+		{
+			name:      "diamond",
+			tasks:     []*ent.Task{task(1, 2), task(2, 10), task(3, 3), task(4, 1)},
+			links:     []*ent.TaskDependency{blocks(1, 2), blocks(1, 3), blocks(2, 4), blocks(3, 4)},
+			wantPath:  []int{1, 2, 4},
+			wantHours: 13,
+			wantSlack: map[int]float64{1: 0, 2: 0, 3: 7, 4: 0},
+		},
+		{
+			name:      "longest of independent chains",
+			tasks:     []*ent.Task{task(1, 3), task(2, 3), task(3, 5)},
+			links:     []*ent.TaskDependency{blocks(1, 2)},
+			wantPath:  []int{1, 2},
+			wantHours: 6,
+			wantSlack: map[int]float64{1: 0, 2: 0, 3: 1},
+		},
+		{
+			name:  "only blocking links count",
+			tasks: []*ent.Task{task(1, 8), task(2, 4), task(3, 4)},
+			links: []*ent.TaskDependency{
+				link(2, 3, taskdependency.TypeRelatesTo),
+				link(3, 2, taskdependency.TypeDuplicates),
+			},
+			wantPath:  []int{1},
+			wantHours: 8,
+			wantSlack: map[int]float64{1: 0, 2: 4, 3: 4},
+		},
+		{
+			name:      "undated tasks last no time",
+			tasks:     []*ent.Task{task(1, -1), task(2, 5)},
+			links:     []*ent.TaskDependency{blocks(1, 2)},
+			wantPath:  []int{1, 2},
+			wantHours: 5,
+			wantSlack: map[int]float64{1: 0, 2: 0},
+		},
+		{
+			name:      "fractional hours",
+			tasks:     []*ent.Task{task(1, 1.5), task(2, 0.25)},
+			links:     []*ent.TaskDependency{blocks(1, 2)},
+			wantPath:  []int{1, 2},
+			wantHours: 1.75,
+			wantSlack: map[int]float64{1: 0, 2: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := buildGraph(7, tt.tasks, tt.links)
+			if graph.ProjectID != 7 || len(graph.Nodes) != len(tt.tasks) || len(graph.Edges) != len(tt.links) {
+				t.Fatalf("graph of project %d has %d nodes and %d edges, want project 7 with %d and %d",
+					graph.ProjectID, len(graph.Nodes), len(graph.Edges), len(tt.tasks), len(tt.links))
+			}
+			if !reflect.DeepEqual(graph.CriticalPath, tt.wantPath) {
+				t.Errorf("critical path = %v, want %v", graph.CriticalPath, tt.wantPath)
+			}
+			if graph.CriticalPathHours != tt.wantHours {
+				t.Errorf("critical path hours = %v, want %v", graph.CriticalPathHours, tt.wantHours)
+			}
+			for _, node := range graph.Nodes {
+				slack := tt.wantSlack[node.ID]
+				if node.SlackHours != slack || node.Critical != (slack == 0) {
+					t.Errorf("task %d has slack %v and critical %v, want slack %v", node.ID, node.SlackHours, node.Critical, slack)
+				}
+				if node.EarliestFinish-node.EarliestStart != node.DurationHours {
+					t.Errorf("task %d starts at %v and finishes at %v, but lasts %v", node.ID, node.EarliestStart, node.EarliestFinish, node.DurationHours)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package project
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+)
+
+// DefaultKey derives a task key from a project name: the initials of its
+// words, or the first letters of a single word, without diacritics, e.g.
+// "Quản lý nhân sự" gives QLNS and "Payroll" gives PAY.
+func DefaultKey(name string) string {
+	var words []string
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if word = asciiUpper(word); word != "" {
+			words = append(words, word)
+		}
+	}
+
+	var key string
+	switch len(words) {
+	case 0:
+		return "PRJ"
+	case 1:
+		key = words[0]
+		if len(key) > 3 {
+			key = key[:3]
+		}
+	default:
+		for _, word := range words {
+			key += word[:1]
+		}
+	}
+	if key[0] < 'A' || key[0] > 'Z' {
+		key = "P" + key
+	}
+	if len(key) > 10 {
+		key = key[:10]
+	}
+	if len(key) < 2 {
+		key += "X"
+	}
+	return key
+}
+
+// asciiUpper upper-cases a word and drops diacritics and anything that is
+// not an ASCII letter or digit
+func asciiUpper(word string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(word) {
+		switch {
+		case r == 'đ' || r == 'Đ':
+			b.WriteByte('D')
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return b.String()
+}
+
+// availableKey returns the key, or the key with the smallest numeric suffix,
+// not used by another project of the organization
+func (s *ProjectService) availableKey(ctx context.Context, orgID int, key string) (string, error) {
+	candidate := key
+	for n := 2; ; n++ {
+		taken, err := s.keyTaken(ctx, orgID, candidate, 0)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+		suffix := strconv.Itoa(n)
+		base := key
+		if len(base)+len(suffix) > 10 {
+			base = base[:10-len(suffix)]
+		}
+		candidate = base + suffix
+	}
+}
+
+// keyTaken reports whether another project of the organization uses a key
+func (s *ProjectService) keyTaken(ctx context.Context, orgID int, key string, excludeID int) (bool, error) {
+	taken, err := s.Client.Project.Query().
+		Where(project.OrgIDEQ(orgID)).
+		Where(project.KeyEQ(key)).
+		Where(project.IDNEQ(excludeID)).
+		Exist(ctx)
+	if err != nil {
+		return false, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate project key",
+		}
+	}
+	return taken, nil
+}
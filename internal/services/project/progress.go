@@ -0,0 +1,113 @@
+package project
+
+import (
+	"context"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// RollUpProgress recomputes the process and status of a project from its
+// tasks, unless they are overridden by hand.
+//
+// Only tasks without subtasks count, so that work is not counted twice, and
+// cancelled ones are left out. Each task weighs its original estimate; tasks
+// without one weigh the average estimate, and when none are estimated every
+// task weighs the same. The project is in progress once a task has started and
+// completed once all of them are; a started project is never moved back to not
+// started.
+func RollUpProgress(ctx context.Context, client *ent.Client, projectID int) error {
+	if projectID == 0 {
+		return nil
+	}
+	proj, err := client.Project.Query().
+		Where(project.ID(projectID)).
+		Select(project.FieldProcess, project.FieldStatus, project.FieldManualProgress).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if proj.ManualProgress {
+		return nil
+	}
+
+	tasks, err := client.Task.Query().
+		Where(
+			task.ProjectID(projectID),
+			task.StatusNEQ(task.StatusCancelled),
+			task.Not(task.HasChildren()),
+		).
+		Select(task.FieldProcess, task.FieldStatus, task.FieldOriginalEstimate).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	process, status := rollUp(tasks, proj.Status)
+	if process == proj.Process && status == proj.Status {
+		return nil
+	}
+	return client.Project.UpdateOneID(projectID).
+		SetProcess(process).
+		SetStatus(status).
+		Exec(ctx)
+}
+
+// rollUp computes the process and status of a project from its counted tasks
+// and its current status. Once started, a project stays in progress when its
+// tasks are reset or removed, as work was done on it.
+func rollUp(tasks []*ent.Task, current project.Status) (int, project.Status) {
+	idle := project.StatusNotStarted
+	if current != project.StatusNotStarted {
+		idle = project.StatusInProgress
+	}
+	if len(tasks) == 0 {
+		return 0, idle
+	}
+
+	estimated, estimateSum := 0, 0
+	for _, t := range tasks {
+		if t.OriginalEstimate != nil && *t.OriginalEstimate > 0 {
+			estimated++
+			estimateSum += *t.OriginalEstimate
+		}
+	}
+	defaultWeight := 1.0
+	if estimated > 0 {
+		defaultWeight = float64(estimateSum) / float64(estimated)
+	}
+
+	var done, weights float64
+	started, completed := false, true
+	for _, t := range tasks {
+		weight := defaultWeight
+		if t.OriginalEstimate != nil && *t.OriginalEstimate > 0 {
+			weight = float64(*t.OriginalEstimate)
+		}
+		process := t.Process
+		if t.Status == task.StatusCompleted {
+			process = 100
+		} else {
+			completed = false
+		}
+		if process > 0 || t.Status == task.StatusInProgress || t.Status == task.StatusCompleted {
+			started = true
+		}
+		done += weight * float64(process)
+		weights += weight
+	}
+
+	switch {
+	case completed:
+		return 100, project.StatusCompleted
+	case started:
+		// Rounded down, so that only a finished project shows 100
+		return min(int(done/weights), 99), project.StatusInProgress
+	default:
+		return 0, idle
+	}
+}
@@ -0,0 +1,124 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+func TestRollUp(t *testing.T) {
+	// counted builds a task with a status, process and original estimate;
+	// an estimate of 0 leaves it unestimated
+	counted := func(status task.Status, process, estimate int) *ent.Task {
+		t := &ent.Task{Status: status, Process: process}
+		if estimate > 0 {
+			t.OriginalEstimate = &estimate
+		}
+		return t
+	}
+
+	tests := []struct {
+		name        string
+		tasks       []*ent.Task
+		current     project.Status
+		wantProcess int
+		wantStatus  project.Status
+	}{
+		{
+			name:        "no tasks",
+			current:     project.StatusNotStarted,
+			wantProcess: 0,
+			wantStatus:  project.StatusNotStarted,
+		},
+		{
+			name:        "no tasks left in a started project",
+			current:     project.StatusInProgress,
+			wantProcess: 0,
+			wantStatus:  project.StatusInProgress,
+		},
+		{
+			name:        "nothing started",
+			tasks:       []*ent.Task{counted(task.StatusNotReceived, 0, 0), counted(task.StatusReceived, 0, 0)},
+			current:     project.StatusNotStarted,
+			wantProcess: 0,
+			wantStatus:  project.StatusNotStarted,
+		},
+		{
+			name:        "started tasks reset to not received",
+			tasks:       []*ent.Task{counted(task.StatusNotReceived, 0, 0), counted(task.StatusNotReceived, 0, 0)},
+			current:     project.StatusInProgress,
+			wantProcess: 0,
+			wantStatus:  project.StatusInProgress,
+		},
+		{
+			name:        "reopened task in a completed project",
+			tasks:       []*ent.Task{counted(task.StatusReceived, 0, 0)},
+			current:     project.StatusCompleted,
+			wantProcess: 0,
+			wantStatus:  project.StatusInProgress,
+		},
+		{
+			name:        "task in progress without process",
+			tasks:       []*ent.Task{counted(task.StatusInProgress, 0, 0), counted(task.StatusNotReceived, 0, 0)},
+			current:     project.StatusNotStarted,
+			wantProcess: 0,
+			wantStatus:  project.StatusInProgress,
+		},
+		{
+			name:        "equal weights without estimates",
+			tasks:       []*ent.Task{counted(task.StatusInProgress, 50, 0), counted(task.StatusNotReceived, 0, 0)},
+			current:     project.StatusNotStarted,
+			wantProcess: 25,
+			wantStatus:  project.StatusInProgress,
+		},
+		{
+			name:        "weighted by estimate",
+			tasks:       []*ent.Task{counted(task.StatusCompleted, 0, 30), counted(task.StatusNotReceived, 0, 10)},
+			current:     project.StatusInProgress,
+			wantProcess: 75,
+			wantStatus:  project.StatusInProgress,
+		},
+		{
+			name: "unestimated tasks weigh the average estimate",
+			tasks: []*ent.Task{
+				counted(task.StatusCompleted, 100, 10),
+				counted(task.StatusNotReceived, 0, 30),
+				counted(task.StatusCompleted, 100, 0),
+			},
+			current:     project.StatusInProgress,
+			wantProcess: 50,
+			wantStatus:  project.StatusInProgress,
+		},
+		{
+			name:        "only a finished project shows 100",
+			tasks:       []*ent.Task{counted(task.StatusCompleted, 100, 999), counted(task.StatusInProgress, 99, 1)},
+			current:     project.StatusInProgress,
+			wantProcess: 99,
+			wantStatus:  project.StatusInProgress,
+		},
+		{
+			name:        "all completed",
+			tasks:       []*ent.Task{counted(task.StatusCompleted, 40, 0), counted(task.StatusCompleted, 100, 5)},
+			current:     project.StatusInProgress,
+			wantProcess: 100,
+			wantStatus:  project.StatusCompleted,
+		},
+		{
+			name:        "completed without starting",
+			tasks:       []*ent.Task{counted(task.StatusCompleted, 0, 0)},
+			current:     project.StatusNotStarted,
+			wantProcess: 100,
+			wantStatus:  project.StatusCompleted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			process, status := rollUp(tt.tasks, tt.current)
+			if process != tt.wantProcess || status != tt.wantStatus {
+				t.Fatalf("rollUp() = %d, %s, want %d, %s", process, status, tt.wantProcess, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -13,7 +13,7 @@ import (
 
 // RemoveMembers removes members from an existing project
 func (s *ProjectService) RemoveMembers(ctx context.Context, id, employeeID, orgID int, input dtos.ProjectRemoveMembersInput) (map[string]interface{}, error) {
-	// Check if the project exists and if the current user is the creator
+	// Check if the project exists
 	existingProject, err := s.Client.Project.Query().
 		Where(project.ID(id)).
 		Only(ctx)
@@ -30,12 +30,10 @@ func (s *ProjectService) RemoveMembers(ctx context.Context, id, employeeID, orgI
 		}
 	}
 
-	// Check if current employee is the creator of the project
-	if existingProject.CreatorID != employeeID {
-		return nil, &ServiceError{
-			Status: http.StatusForbidden,
-			Msg:    "Only the project creator can remove members from this project",
-		}
+	// Check if current employee's project role allows managing members
+	actorRole, err := s.authorize(ctx, id, employeeID, ActionManageMembers, "Only project owners and managers can remove members from this project")
+	if err != nil {
+		return nil, err
 	}
 
 	// Get current project members
@@ -110,17 +108,43 @@ func (s *ProjectService) RemoveMembers(ctx context.Context, id, employeeID, orgI
 		return enrichedProject, nil
 	}
 
-	// Remove members from the project
-	projectUpdate := s.Client.Project.UpdateOneID(id)
-	projectUpdate.RemoveMemberIDs(membersToRemove...)
+	// Owners and managers can only be removed by owners, and the last owner
+	// cannot be removed
+	if err := s.checkRemoval(ctx, existingProject, actorRole, membersToRemove); err != nil {
+		return nil, err
+	}
 
-	_, err = projectUpdate.Save(ctx)
+	// Remove members from the project together with their roles
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	_, err = tx.Project.UpdateOneID(id).
+		RemoveMemberIDs(membersToRemove...).
+		Save(ctx)
 	if err != nil {
+		tx.Rollback()
 		return nil, &ServiceError{
 			Status: http.StatusInternalServerError,
 			Msg:    "Failed to remove members from project",
 		}
 	}
+	if err := clearRoles(ctx, tx.Client(), id, membersToRemove); err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to remove roles of removed members",
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
 
 	// Get the updated project with all edges
 	updatedProject, err := s.Client.Project.Query().
@@ -0,0 +1,457 @@
+package project
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/projectrole"
+)
+
+// Action is something a project role may allow
+type Action int
+
+const (
+	// ActionEditProject edits the project itself
+	ActionEditProject Action = iota
+	// ActionManageMembers adds and removes members
+	ActionManageMembers
+	// ActionManageRoles changes roles and transfers ownership
+	ActionManageRoles
+	// ActionCreateTask creates tasks in the project or moves tasks into or out of it
+	ActionCreateTask
+	// ActionAssignTask assigns tasks to anyone; everyone who can create tasks
+	// may assign themselves
+	ActionAssignTask
+	// ActionDeleteTask deletes tasks of the project
+	ActionDeleteTask
+)
+
+// allowed lists the actions of each project role
+var allowed = map[projectrole.Role][]Action{
+	projectrole.RoleOwner: {
+		ActionEditProject, ActionManageMembers, ActionManageRoles,
+		ActionCreateTask, ActionAssignTask, ActionDeleteTask,
+	},
+	projectrole.RoleManager: {
+		ActionEditProject, ActionManageMembers,
+		ActionCreateTask, ActionAssignTask, ActionDeleteTask,
+	},
+	projectrole.RoleMember: {
+		ActionCreateTask,
+	},
+	projectrole.RoleViewer: {},
+}
+
+// Allows reports whether a project role allows an action. Non-members, with
+// an empty role, are allowed nothing.
+func Allows(role projectrole.Role, action Action) bool {
+	for _, a := range allowed[role] {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// MemberRole returns the role of an employee in a project, or "" when they are
+// not a member. Members without a stored role are plain members, except the
+// creator of a project that has no owner, which predates roles and is owned
+// by them.
+func MemberRole(ctx context.Context, client *ent.Client, projectID, employeeID int) (projectrole.Role, error) {
+	if employeeID == 0 {
+		return "", nil
+	}
+	proj, err := client.Project.Query().
+		Where(project.ID(projectID)).
+		Select(project.FieldCreatorID).
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	isMember, err := client.Project.Query().
+		Where(project.ID(projectID)).
+		QueryMembers().
+		Where(employee.ID(employeeID)).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !isMember {
+		return "", nil
+	}
+
+	row, err := client.ProjectRole.Query().
+		Where(projectrole.ProjectID(projectID), projectrole.EmployeeID(employeeID)).
+		Only(ctx)
+	if err == nil {
+		return row.Role, nil
+	}
+	if !ent.IsNotFound(err) {
+		return "", err
+	}
+	if proj.CreatorID == employeeID {
+		hasOwner, err := client.ProjectRole.Query().
+			Where(projectrole.ProjectID(projectID), projectrole.RoleEQ(projectrole.RoleOwner)).
+			Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !hasOwner {
+			return projectrole.RoleOwner, nil
+		}
+	}
+	return projectrole.RoleMember, nil
+}
+
+// authorize checks that an employee's role in a project allows an action and
+// returns the role
+func (s *ProjectService) authorize(ctx context.Context, projectID, employeeID int, action Action, msg string) (projectrole.Role, error) {
+	role, err := MemberRole(ctx, s.Client, projectID, employeeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Project not found",
+			}
+		}
+		return "", &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check project role",
+		}
+	}
+	if !Allows(role, action) {
+		return role, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    msg,
+		}
+	}
+	return role, nil
+}
+
+// memberRoles returns the stored roles of the members of a project, with the
+// legacy owner filled in
+func (s *ProjectService) memberRoles(ctx context.Context, proj *ent.Project, memberIDs []int) (map[int]projectrole.Role, error) {
+	rows, err := s.Client.ProjectRole.Query().
+		Where(projectrole.ProjectID(proj.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[int]projectrole.Role, len(rows))
+	hasOwner := false
+	for _, row := range rows {
+		stored[row.EmployeeID] = row.Role
+		if row.Role == projectrole.RoleOwner {
+			hasOwner = true
+		}
+	}
+
+	roles := make(map[int]projectrole.Role, len(memberIDs))
+	for _, id := range memberIDs {
+		switch role, ok := stored[id]; {
+		case ok:
+			roles[id] = role
+		case id == proj.CreatorID && !hasOwner:
+			roles[id] = projectrole.RoleOwner
+		default:
+			roles[id] = projectrole.RoleMember
+		}
+	}
+	return roles, nil
+}
+
+// ListMembers returns the members of a project with their roles
+func (s *ProjectService) ListMembers(ctx context.Context, id, orgID int) ([]map[string]interface{}, error) {
+	proj, err := s.Client.Project.Query().
+		Where(project.ID(id), project.OrgID(orgID)).
+		WithMembers().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Project not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to get project",
+		}
+	}
+
+	memberIDs := make([]int, 0, len(proj.Edges.Members))
+	for _, member := range proj.Edges.Members {
+		memberIDs = append(memberIDs, member.ID)
+	}
+	roles, err := s.memberRoles(ctx, proj, memberIDs)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to get project roles",
+		}
+	}
+
+	members := make([]map[string]interface{}, 0, len(proj.Edges.Members))
+	for _, member := range proj.Edges.Members {
+		members = append(members, map[string]interface{}{
+			"employee": member,
+			"role":     roles[member.ID],
+		})
+	}
+	return members, nil
+}
+
+// SetMemberRole changes the role of a member. Only owners change roles, and a
+// project always keeps an owner.
+func (s *ProjectService) SetMemberRole(ctx context.Context, id, employeeID, orgID, memberID int, role projectrole.Role) ([]map[string]interface{}, error) {
+	proj, err := s.projectInOrg(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorize(ctx, id, employeeID, ActionManageRoles, "Only project owners can change member roles"); err != nil {
+		return nil, err
+	}
+
+	current, err := MemberRole(ctx, s.Client, id, memberID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check project role",
+		}
+	}
+	if current == "" {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Employee is not a member of this project",
+		}
+	}
+	if current == projectrole.RoleOwner && role != projectrole.RoleOwner {
+		if err := s.checkOwnersLeft(ctx, proj, []int{memberID}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.saveRoles(ctx, proj, map[int]projectrole.Role{memberID: role}); err != nil {
+		return nil, err
+	}
+	return s.ListMembers(ctx, id, orgID)
+}
+
+// TransferOwnership makes a member the owner of a project in place of the
+// caller, who stays on as a manager
+func (s *ProjectService) TransferOwnership(ctx context.Context, id, employeeID, orgID, newOwnerID int) ([]map[string]interface{}, error) {
+	proj, err := s.projectInOrg(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	role, err := s.authorize(ctx, id, employeeID, ActionManageRoles, "Only project owners can transfer ownership")
+	if err != nil {
+		return nil, err
+	}
+	if role != projectrole.RoleOwner {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only project owners can transfer ownership",
+		}
+	}
+	if newOwnerID == employeeID {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "You already own this project",
+		}
+	}
+	target, err := MemberRole(ctx, s.Client, id, newOwnerID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check project role",
+		}
+	}
+	if target == "" {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "The new owner must be a member of this project",
+		}
+	}
+
+	if err := s.saveRoles(ctx, proj, map[int]projectrole.Role{
+		newOwnerID: projectrole.RoleOwner,
+		employeeID: projectrole.RoleManager,
+	}); err != nil {
+		return nil, err
+	}
+	return s.ListMembers(ctx, id, orgID)
+}
+
+// checkOwnersLeft fails when taking the owner role from some members would
+// leave a project without an owner
+func (s *ProjectService) checkOwnersLeft(ctx context.Context, proj *ent.Project, leaving []int) error {
+	memberIDs, err := s.Client.Project.Query().
+		Where(project.ID(proj.ID)).
+		QueryMembers().
+		IDs(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to get current project members",
+		}
+	}
+	roles, err := s.memberRoles(ctx, proj, memberIDs)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to get project roles",
+		}
+	}
+
+	gone := make(map[int]bool, len(leaving))
+	for _, id := range leaving {
+		gone[id] = true
+	}
+	for id, role := range roles {
+		if role == projectrole.RoleOwner && !gone[id] {
+			return nil
+		}
+	}
+	return &ServiceError{
+		Status: http.StatusBadRequest,
+		Msg:    "A project must keep at least one owner",
+	}
+}
+
+// saveRoles stores roles of members of a project in one transaction. The
+// ownership of a creator that predates roles is stored first, so changing
+// other roles keeps it.
+func (s *ProjectService) saveRoles(ctx context.Context, proj *ent.Project, roles map[int]projectrole.Role) error {
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to save project roles",
+		}
+	}
+	if err := storeLegacyOwner(ctx, tx.Client(), proj); err != nil {
+		tx.Rollback()
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to save project roles",
+		}
+	}
+	for memberID, role := range roles {
+		err := tx.ProjectRole.Create().
+			SetProjectID(proj.ID).
+			SetEmployeeID(memberID).
+			SetRole(role).
+			OnConflictColumns(projectrole.FieldProjectID, projectrole.FieldEmployeeID).
+			UpdateRole().
+			UpdateUpdatedAt().
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to save project roles",
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to save project roles",
+		}
+	}
+	return nil
+}
+
+// storeLegacyOwner stores the owner role of the creator of a project without
+// an owner, when the creator is a member without a role
+func storeLegacyOwner(ctx context.Context, client *ent.Client, proj *ent.Project) error {
+	hasOwner, err := client.ProjectRole.Query().
+		Where(projectrole.ProjectID(proj.ID), projectrole.RoleEQ(projectrole.RoleOwner)).
+		Exist(ctx)
+	if err != nil || hasOwner {
+		return err
+	}
+	isMember, err := client.Project.Query().
+		Where(project.ID(proj.ID)).
+		QueryMembers().
+		Where(employee.ID(proj.CreatorID)).
+		Exist(ctx)
+	if err != nil || !isMember {
+		return err
+	}
+	return client.ProjectRole.Create().
+		SetProjectID(proj.ID).
+		SetEmployeeID(proj.CreatorID).
+		SetRole(projectrole.RoleOwner).
+		OnConflictColumns(projectrole.FieldProjectID, projectrole.FieldEmployeeID).
+		DoNothing().
+		Exec(ctx)
+}
+
+// projectInOrg returns a project of an organization
+func (s *ProjectService) projectInOrg(ctx context.Context, id, orgID int) (*ent.Project, error) {
+	proj, err := s.Client.Project.Query().
+		Where(project.ID(id), project.OrgID(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Project not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to get project",
+		}
+	}
+	return proj, nil
+}
+
+// checkRemoval checks that a member with a role may remove members from a
+// project: only owners remove owners and managers, and a project keeps an
+// owner
+func (s *ProjectService) checkRemoval(ctx context.Context, proj *ent.Project, actorRole projectrole.Role, removing []int) error {
+	roles, err := s.memberRoles(ctx, proj, removing)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to get project roles",
+		}
+	}
+	removesOwner := false
+	for _, id := range removing {
+		switch roles[id] {
+		case projectrole.RoleOwner:
+			removesOwner = true
+			fallthrough
+		case projectrole.RoleManager:
+			if !Allows(actorRole, ActionManageRoles) {
+				return &ServiceError{
+					Status: http.StatusForbidden,
+					Msg:    "Only project owners can remove owners and managers",
+				}
+			}
+		}
+	}
+	if removesOwner {
+		return s.checkOwnersLeft(ctx, proj, removing)
+	}
+	return nil
+}
+
+// clearRoles forgets the roles of members removed from a project; it runs in
+// the transaction removing them
+func clearRoles(ctx context.Context, client *ent.Client, projectID int, removed []int) error {
+	if len(removed) == 0 {
+		return nil
+	}
+	_, err := client.ProjectRole.Delete().
+		Where(projectrole.ProjectID(projectID), projectrole.EmployeeIDIn(removed...)).
+		Exec(ctx)
+	return err
+}
@@ -223,20 +223,22 @@ func (s *ProjectService) addTaskCountsToProjects(ctx context.Context, projects [
 // enrichProjectWithUserInfo enriches a single project with user information for list operations
 func (s *ProjectService) enrichProjectWithUserInfo(proj *ent.Project, userMap map[int32]*grpc_clients.User, taskCount int) dtos.ProjectResponse {
 	response := dtos.ProjectResponse{
-		ID:          proj.ID,
-		Name:        proj.Name,
-		Code:        proj.Code,
-		Description: proj.Description, // Convert to pointer
-		StartAt:     proj.StartAt,     // Convert to pointer
-		EndAt:       proj.EndAt,       // Convert to pointer
-		CreatorID:   proj.CreatorID,
-		UpdaterID:   proj.UpdaterID,
-		OrgID:       proj.OrgID,
-		Process:     proj.Process,
-		Status:      string(proj.Status),
-		CreatedAt:   proj.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   proj.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		TaskCount:   taskCount,
+		ID:             proj.ID,
+		Name:           proj.Name,
+		Code:           proj.Code,
+		Key:            proj.Key,
+		Description:    proj.Description, // Convert to pointer
+		StartAt:        proj.StartAt,     // Convert to pointer
+		EndAt:          proj.EndAt,       // Convert to pointer
+		CreatorID:      proj.CreatorID,
+		UpdaterID:      proj.UpdaterID,
+		OrgID:          proj.OrgID,
+		Process:        proj.Process,
+		Status:         string(proj.Status),
+		ManualProgress: proj.ManualProgress,
+		CreatedAt:      proj.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      proj.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		TaskCount:      taskCount,
 	}
 
 	// Create edges structure preserving original structure
@@ -345,19 +347,21 @@ func (s *ProjectService) enrichProjectWithUserInfo(proj *ent.Project, userMap ma
 // enrichProjectWithUserInfoForGet enriches a single project with user information and tasks (for Get method)
 func (s *ProjectService) enrichProjectWithUserInfoForGet(proj *ent.Project, userMap map[int32]*grpc_clients.User) map[string]interface{} {
 	result := map[string]interface{}{
-		"id":          proj.ID,
-		"name":        proj.Name,
-		"code":        proj.Code,
-		"description": proj.Description,
-		"start_at":    proj.StartAt,
-		"end_at":      proj.EndAt,
-		"creator_id":  proj.CreatorID,
-		"updater_id":  proj.UpdaterID,
-		"org_id":      proj.OrgID,
-		"process":     proj.Process,
-		"status":      proj.Status,
-		"created_at":  proj.CreatedAt,
-		"updated_at":  proj.UpdatedAt,
+		"id":              proj.ID,
+		"name":            proj.Name,
+		"code":            proj.Code,
+		"key":             proj.Key,
+		"description":     proj.Description,
+		"start_at":        proj.StartAt,
+		"end_at":          proj.EndAt,
+		"creator_id":      proj.CreatorID,
+		"updater_id":      proj.UpdaterID,
+		"org_id":          proj.OrgID,
+		"process":         proj.Process,
+		"status":          proj.Status,
+		"manual_progress": proj.ManualProgress,
+		"created_at":      proj.CreatedAt,
+		"updated_at":      proj.UpdatedAt,
 	}
 
 	// Create edges structure preserving original structure
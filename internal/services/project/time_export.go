@@ -0,0 +1,171 @@
+package project
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// TimeExport returns the time logged on a project as CSV rows. The logs
+// format lists every work log for billing; the summary format compares each
+// task's estimates with the time logged on it. Only project owners and
+// managers can export.
+func (s *ProjectService) TimeExport(ctx context.Context, projectID, employeeID int, query dtos.ProjectTimeExportQuery) ([][]string, error) {
+	proj, err := s.Client.Project.Get(ctx, projectID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Project not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project",
+		}
+	}
+	if _, err := s.authorize(ctx, proj.ID, employeeID, ActionEditProject, "Only project owners and managers can export its time"); err != nil {
+		return nil, err
+	}
+
+	logQuery := s.Client.WorkLog.Query().
+		Where(worklog.EndedAtNotNil()).
+		Where(worklog.HasTaskWith(task.ProjectID(projectID)))
+	if query.From != "" {
+		from, err := time.ParseInLocation("2006-01-02", query.From, time.Local)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid from format, must be YYYY-MM-DD",
+			}
+		}
+		logQuery = logQuery.Where(worklog.StartedAtGTE(from))
+	}
+	if query.To != "" {
+		to, err := time.ParseInLocation("2006-01-02", query.To, time.Local)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid to format, must be YYYY-MM-DD",
+			}
+		}
+		logQuery = logQuery.Where(worklog.StartedAtLT(to.AddDate(0, 0, 1)))
+	}
+
+	if query.Format == "summary" {
+		return s.timeSummaryRows(ctx, projectID, logQuery)
+	}
+
+	logs, err := logQuery.
+		WithTask().
+		WithEmployee().
+		Order(ent.Asc(worklog.FieldStartedAt), ent.Asc(worklog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch work logs",
+		}
+	}
+
+	rows := [][]string{{
+		"work_log_id", "date", "task_code", "task_name", "employee_id",
+		"employee_code", "started_at", "minutes", "hours", "note",
+	}}
+	for _, l := range logs {
+		row := []string{
+			strconv.Itoa(l.ID),
+			l.StartedAt.In(time.Local).Format("2006-01-02"),
+			"",
+			"",
+			strconv.Itoa(l.EmployeeID),
+			"",
+			l.StartedAt.In(time.Local).Format(time.RFC3339),
+			strconv.Itoa(l.Duration),
+			minutesToHours(l.Duration),
+			l.Note,
+		}
+		if l.Edges.Task != nil {
+			row[2] = l.Edges.Task.Code
+			row[3] = l.Edges.Task.Name
+		}
+		if l.Edges.Employee != nil {
+			row[5] = l.Edges.Employee.Code
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// timeSummaryRows compares the estimates of every task of a project with the
+// time logged on it
+func (s *ProjectService) timeSummaryRows(ctx context.Context, projectID int, logQuery *ent.WorkLogQuery) ([][]string, error) {
+	var totals []struct {
+		TaskID int `json:"task_id"`
+		Sum    int `json:"sum"`
+	}
+	if err := logQuery.
+		GroupBy(worklog.FieldTaskID).
+		Aggregate(ent.Sum(worklog.FieldDuration)).
+		Scan(ctx, &totals); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to aggregate work logs",
+		}
+	}
+	logged := make(map[int]int, len(totals))
+	for _, t := range totals {
+		logged[t.TaskID] = t.Sum
+	}
+
+	tasks, err := s.Client.Task.Query().
+		Where(task.ProjectID(projectID)).
+		Order(ent.Asc(task.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project tasks",
+		}
+	}
+
+	rows := [][]string{{
+		"task_code", "task_name", "status", "original_estimate_hours",
+		"logged_hours", "remaining_estimate_hours", "variance_hours",
+	}}
+	for _, t := range tasks {
+		row := []string{
+			t.Code,
+			t.Name,
+			string(t.Status),
+			"",
+			minutesToHours(logged[t.ID]),
+			"",
+			"",
+		}
+		if t.OriginalEstimate != nil {
+			row[3] = minutesToHours(*t.OriginalEstimate)
+			variance := logged[t.ID] - *t.OriginalEstimate
+			if t.RemainingEstimate != nil {
+				variance += *t.RemainingEstimate
+			}
+			row[6] = minutesToHours(variance)
+		}
+		if t.RemainingEstimate != nil {
+			row[5] = minutesToHours(*t.RemainingEstimate)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// minutesToHours formats minutes as hours with two decimals
+func minutesToHours(minutes int) string {
+	return strconv.FormatFloat(float64(minutes)/60, 'f', 2, 64)
+}
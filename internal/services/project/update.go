@@ -14,7 +14,7 @@ import (
 
 // Update updates an existing project
 func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int, input dtos.ProjectUpdateInput) (map[string]interface{}, error) {
-	// Check if the project exists and if the current user is the creator
+	// Check if the project exists
 	existingProject, err := s.Client.Project.Query().
 		Where(project.ID(id)).
 		Only(ctx)
@@ -31,15 +31,21 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 		}
 	}
 
-	// Check if current employee is the creator of the project
-	if existingProject.CreatorID != employeeID {
+	// Check if current employee's project role allows editing it
+	actorRole, err := s.authorize(ctx, id, employeeID, ActionEditProject, "Only project owners and managers can update this project")
+	if err != nil {
+		return nil, err
+	}
+
+	// Members and their roles change together
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
 		return nil, &ServiceError{
-			Status: http.StatusForbidden,
-			Msg:    "Only the project creator can update this project",
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
 		}
 	}
-
-	projectUpdate := s.Client.Project.UpdateOneID(id)
+	projectUpdate := tx.Project.UpdateOneID(id)
 
 	// Update fields if provided
 	if input.Name != nil {
@@ -54,12 +60,14 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 			Where(project.IDNEQ(id)). // Exclude current project
 			First(ctx)
 		if err == nil && existingProject != nil {
+			tx.Rollback()
 			return nil, &ServiceError{
 				Status: http.StatusBadRequest,
 				Msg:    "Project code already exists in your organization",
 			}
 		}
 		if err != nil && !ent.IsNotFound(err) {
+			tx.Rollback()
 			return nil, &ServiceError{
 				Status: http.StatusInternalServerError,
 				Msg:    "Failed to validate project code",
@@ -68,6 +76,26 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 		projectUpdate.SetCode(*input.Code)
 	}
 
+	// A new key applies to tasks created from now on; existing task codes are
+	// kept
+	if input.Key != nil {
+		taken, err := s.keyTaken(ctx, existingProject.OrgID, *input.Key, id)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if taken {
+			tx.Rollback()
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Project key already exists in your organization",
+			}
+		}
+		if existingProject.Key == nil || *existingProject.Key != *input.Key {
+			projectUpdate.SetKey(*input.Key).SetTaskSeq(0)
+		}
+	}
+
 	if input.Description != nil {
 		projectUpdate.SetDescription(*input.Description)
 	}
@@ -75,6 +103,7 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 	if input.StartAt != nil {
 		startAt, err := time.Parse(time.RFC3339, *input.StartAt)
 		if err != nil {
+			tx.Rollback()
 			return nil, &ServiceError{
 				Status: http.StatusBadRequest,
 				Msg:    "Invalid start_at format, must be RFC3339",
@@ -86,6 +115,7 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 	if input.EndAt != nil {
 		endAt, err := time.Parse(time.RFC3339, *input.EndAt)
 		if err != nil {
+			tx.Rollback()
 			return nil, &ServiceError{
 				Status: http.StatusBadRequest,
 				Msg:    "Invalid end_at format, must be RFC3339",
@@ -106,6 +136,21 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 		projectUpdate.SetOrgID(*input.OrgID)
 	}
 
+	// Process and status are only set by hand while they are overridden;
+	// otherwise they are rolled up from the project's tasks
+	manual := existingProject.ManualProgress
+	if input.ManualProgress != nil {
+		manual = *input.ManualProgress
+		projectUpdate.SetManualProgress(manual)
+	}
+	if (input.Process != nil || input.Status != nil) && !manual {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Process and status are computed from the project's tasks; set manual_progress to override them",
+		}
+	}
+
 	if input.Process != nil {
 		projectUpdate.SetProcess(*input.Process)
 	}
@@ -115,6 +160,7 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 		case string(project.StatusNotStarted), string(project.StatusInProgress), string(project.StatusCompleted):
 			projectUpdate.SetStatus(project.Status(*input.Status))
 		default:
+			tx.Rollback()
 			return nil, &ServiceError{
 				Status: http.StatusBadRequest,
 				Msg:    "Invalid status value",
@@ -123,7 +169,40 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 	}
 
 	// Handle member assignments
+	var removedMemberIDs []int
 	if input.MemberIDs != nil {
+		if !Allows(actorRole, ActionManageMembers) {
+			tx.Rollback()
+			return nil, &ServiceError{
+				Status: http.StatusForbidden,
+				Msg:    "Only project owners and managers can change project members",
+			}
+		}
+		currentMemberIDs, err := s.Client.Project.Query().
+			Where(project.ID(id)).
+			QueryMembers().
+			IDs(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to get current project members",
+			}
+		}
+		kept := make(map[int]bool, len(input.MemberIDs))
+		for _, memberID := range input.MemberIDs {
+			kept[memberID] = true
+		}
+		for _, memberID := range currentMemberIDs {
+			if !kept[memberID] {
+				removedMemberIDs = append(removedMemberIDs, memberID)
+			}
+		}
+		if err := s.checkRemoval(ctx, existingProject, actorRole, removedMemberIDs); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
 		// Clear existing members first
 		projectUpdate.ClearMembers()
 
@@ -135,6 +214,7 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 				Select(employee.FieldID).
 				All(ctx)
 			if err != nil {
+				tx.Rollback()
 				return nil, &ServiceError{
 					Status: http.StatusInternalServerError,
 					Msg:    "Failed to validate member IDs",
@@ -156,6 +236,7 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 			}
 
 			if len(invalidIDs) > 0 {
+				tx.Rollback()
 				return nil, &ServiceError{
 					Status: http.StatusBadRequest,
 					Msg:    "Some member IDs do not exist or do not belong to your organization",
@@ -168,6 +249,7 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 
 	_, err = projectUpdate.Save(ctx)
 	if err != nil {
+		tx.Rollback()
 		if ent.IsNotFound(err) {
 			return nil, &ServiceError{
 				Status: http.StatusNotFound,
@@ -179,6 +261,29 @@ func (s *ProjectService) Update(ctx context.Context, id, employeeID, orgID int,
 			Msg:    "Failed to update project",
 		}
 	}
+	if err := clearRoles(ctx, tx.Client(), id, removedMemberIDs); err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to remove roles of removed members",
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	// Dropping the override brings process and status back in line with the
+	// tasks
+	if existingProject.ManualProgress && !manual {
+		if err := RollUpProgress(ctx, s.Client, id); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to compute project progress",
+			}
+		}
+	}
 
 	// Get the updated project with all edges
 	updatedProject, err := s.Client.Project.Query().
@@ -0,0 +1,273 @@
+package recurrence
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// schedule is a parsed recurrence rule with its start and end
+type schedule struct {
+	rule  *Rule
+	start time.Time
+	until *time.Time
+}
+
+// newSchedule parses a rule evaluated in a time zone
+func newSchedule(rule string, start time.Time, until *time.Time, timezone string) (*schedule, error) {
+	r, err := Parse(rule)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid rule: " + err.Error(),
+		}
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid timezone " + timezone,
+		}
+	}
+	if until != nil && until.Before(start) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "until must not be before start_at",
+		}
+	}
+	return &schedule{rule: r, start: start.In(loc), until: until}, nil
+}
+
+// scheduleOf returns the schedule of a stored recurrence
+func scheduleOf(rec *ent.TaskRecurrence) (*schedule, error) {
+	return newSchedule(rec.Rule, rec.StartAt, rec.Until, rec.Timezone)
+}
+
+// next returns the first occurrence after a time, or nil when there is none
+func (sc *schedule) next(after time.Time) *time.Time {
+	t := sc.rule.Next(sc.start, after)
+	if t.IsZero() || (sc.until != nil && t.After(*sc.until)) {
+		return nil
+	}
+	return &t
+}
+
+// ListRecurrences returns the recurrences of a task template
+func (s *RecurrenceService) ListRecurrences(ctx context.Context, orgID, templateID int) ([]*ent.TaskRecurrence, error) {
+	if _, err := s.GetTemplate(ctx, orgID, templateID); err != nil {
+		return nil, err
+	}
+	rows, err := s.Client.TaskRecurrence.Query().
+		Where(taskrecurrence.TemplateID(templateID)).
+		Order(ent.Asc(taskrecurrence.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch recurrences",
+		}
+	}
+	return rows, nil
+}
+
+// CreateRecurrence schedules a task template. Tasks are created on behalf of
+// the user and employee who scheduled it.
+func (s *RecurrenceService) CreateRecurrence(ctx context.Context, orgID, templateID, userID, employeeID int, input dtos.TaskRecurrenceCreateInput) (*ent.TaskRecurrence, error) {
+	if _, err := s.GetTemplate(ctx, orgID, templateID); err != nil {
+		return nil, err
+	}
+	startAt, err := time.Parse(time.RFC3339, input.StartAt)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid start_at format, must be RFC3339",
+		}
+	}
+	until, err := parseUntil(input.Until)
+	if err != nil {
+		return nil, err
+	}
+	timezone := "UTC"
+	if input.Timezone != nil {
+		timezone = *input.Timezone
+	}
+	sc, err := newSchedule(input.Rule, startAt, until, timezone)
+	if err != nil {
+		return nil, err
+	}
+	enabled := input.Enabled == nil || *input.Enabled
+
+	recurrenceCreate := s.Client.TaskRecurrence.Create().
+		SetTemplateID(templateID).
+		SetRule(input.Rule).
+		SetStartAt(startAt).
+		SetNillableUntil(until).
+		SetTimezone(timezone).
+		SetEnabled(enabled).
+		SetCreatorID(userID).
+		SetEmployeeID(employeeID)
+	if enabled {
+		recurrenceCreate = recurrenceCreate.SetNillableNextRunAt(sc.next(time.Now()))
+	}
+
+	row, err := recurrenceCreate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create recurrence",
+		}
+	}
+	return row, nil
+}
+
+// UpdateRecurrence updates a recurrence. A new schedule, or enabling it
+// again, takes effect from now on.
+func (s *RecurrenceService) UpdateRecurrence(ctx context.Context, orgID, id int, input dtos.TaskRecurrenceUpdateInput) (*ent.TaskRecurrence, error) {
+	rec, err := s.getRecurrence(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, startAt, until, timezone := rec.Rule, rec.StartAt, rec.Until, rec.Timezone
+	if input.Rule != nil {
+		rule = *input.Rule
+	}
+	if input.StartAt != nil {
+		if startAt, err = time.Parse(time.RFC3339, *input.StartAt); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid start_at format, must be RFC3339",
+			}
+		}
+	}
+	if input.Until != nil {
+		if until, err = parseUntil(input.Until); err != nil {
+			return nil, err
+		}
+	}
+	if input.Timezone != nil {
+		timezone = *input.Timezone
+	}
+	sc, err := newSchedule(rule, startAt, until, timezone)
+	if err != nil {
+		return nil, err
+	}
+	enabled := rec.Enabled
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	recurrenceUpdate := s.Client.TaskRecurrence.UpdateOneID(id).
+		SetRule(rule).
+		SetStartAt(startAt).
+		SetTimezone(timezone).
+		SetEnabled(enabled)
+	if until != nil {
+		recurrenceUpdate = recurrenceUpdate.SetUntil(*until)
+	} else {
+		recurrenceUpdate = recurrenceUpdate.ClearUntil()
+	}
+	rescheduled := input.Rule != nil || input.StartAt != nil || input.Until != nil || input.Timezone != nil
+	switch {
+	case !enabled:
+		recurrenceUpdate = recurrenceUpdate.ClearNextRunAt()
+	case rescheduled || !rec.Enabled:
+		if next := sc.next(time.Now()); next != nil {
+			recurrenceUpdate = recurrenceUpdate.SetNextRunAt(*next)
+		} else {
+			recurrenceUpdate = recurrenceUpdate.ClearNextRunAt()
+		}
+	}
+
+	row, err := recurrenceUpdate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update recurrence",
+		}
+	}
+	return row, nil
+}
+
+// DeleteRecurrence deletes a recurrence with its runs
+func (s *RecurrenceService) DeleteRecurrence(ctx context.Context, orgID, id int) error {
+	if _, err := s.getRecurrence(ctx, orgID, id); err != nil {
+		return err
+	}
+	if err := s.Client.TaskRecurrence.DeleteOneID(id).Exec(ctx); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete recurrence",
+		}
+	}
+	return nil
+}
+
+// ListRuns returns the latest runs of a recurrence
+func (s *RecurrenceService) ListRuns(ctx context.Context, orgID, id int, query dtos.TaskRecurrenceRunListQuery) ([]*ent.TaskRecurrenceRun, error) {
+	if _, err := s.getRecurrence(ctx, orgID, id); err != nil {
+		return nil, err
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = 50
+	}
+
+	runQuery := s.Client.TaskRecurrenceRun.Query().Where(taskrecurrencerun.RecurrenceID(id))
+	if query.Status != "" {
+		runQuery = runQuery.Where(taskrecurrencerun.StatusEQ(taskrecurrencerun.Status(query.Status)))
+	}
+	rows, err := runQuery.
+		Order(ent.Desc(taskrecurrencerun.FieldOccurrenceAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch recurrence runs",
+		}
+	}
+	return rows, nil
+}
+
+// getRecurrence returns a recurrence of a template of an organization
+func (s *RecurrenceService) getRecurrence(ctx context.Context, orgID, id int) (*ent.TaskRecurrence, error) {
+	row, err := s.Client.TaskRecurrence.Query().
+		Where(taskrecurrence.ID(id)).
+		Where(taskrecurrence.HasTemplateWith(tasktemplate.OrgID(orgID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Recurrence not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch recurrence",
+		}
+	}
+	return row, nil
+}
+
+// parseUntil parses an optional end time; an empty string means no end
+func parseUntil(value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	until, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid until format, must be RFC3339",
+		}
+	}
+	return &until, nil
+}
@@ -0,0 +1,193 @@
+package recurrence
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Supported frequencies
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+)
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is the supported subset of an iCalendar RRULE:
+//
+//	FREQ=DAILY[;INTERVAL=n]
+//	FREQ=WEEKLY[;INTERVAL=n][;BYDAY=MO,WE,...]
+//	FREQ=MONTHLY[;INTERVAL=n][;BYMONTHDAY=d]
+//
+// BYMONTHDAY=-1 is the last day of the month; months without day d are
+// skipped. Without BYDAY or BYMONTHDAY the rule repeats on the weekday or day
+// of month of its start.
+type Rule struct {
+	Freq       string
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay int
+}
+
+// Parse parses an RRULE string
+func Parse(s string) (*Rule, error) {
+	r := &Rule{Interval: 1}
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(s), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = strings.ToUpper(value)
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > 366 {
+				return nil, fmt.Errorf("INTERVAL must be between 1 and 366")
+			}
+			r.Interval = n
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				wd, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, fmt.Errorf("invalid BYDAY value %q", day)
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(value)
+			if err != nil || n == 0 || n < -1 || n > 31 {
+				return nil, fmt.Errorf("BYMONTHDAY must be between 1 and 31, or -1")
+			}
+			r.ByMonthDay = n
+		default:
+			return nil, fmt.Errorf("unsupported rule part %s", key)
+		}
+	}
+
+	switch r.Freq {
+	case FreqDaily:
+		if len(r.ByDay) > 0 || r.ByMonthDay != 0 {
+			return nil, fmt.Errorf("a daily rule takes no BYDAY or BYMONTHDAY")
+		}
+	case FreqWeekly:
+		if r.ByMonthDay != 0 {
+			return nil, fmt.Errorf("a weekly rule takes no BYMONTHDAY")
+		}
+	case FreqMonthly:
+		if len(r.ByDay) > 0 {
+			return nil, fmt.Errorf("a monthly rule takes no BYDAY")
+		}
+	case "":
+		return nil, fmt.Errorf("FREQ is required")
+	default:
+		return nil, fmt.Errorf("unsupported FREQ %s, use DAILY, WEEKLY or MONTHLY", r.Freq)
+	}
+	return r, nil
+}
+
+// Next returns the first occurrence strictly after a time, for a rule that
+// starts at start. Occurrences keep the time of day of start in its location.
+func (r *Rule) Next(start, after time.Time) time.Time {
+	if after.Before(start) {
+		after = start.Add(-time.Nanosecond)
+	}
+	loc := start.Location()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, start.Hour(), start.Minute(), start.Second(), 0, loc)
+	}
+
+	switch r.Freq {
+	case FreqMonthly:
+		day := r.ByMonthDay
+		if day == 0 {
+			day = start.Day()
+		}
+		// Months are stepped from the start; a month lacking the day is skipped
+		from := after.In(loc)
+		months := (from.Year()-start.Year())*12 + int(from.Month()-start.Month())
+		months -= months % r.Interval
+		for i := 0; i < 12*4*r.Interval; i += r.Interval {
+			y, m := start.Year(), start.Month()+time.Month(months+i)
+			last := time.Date(y, m+1, 0, 0, 0, 0, 0, loc).Day()
+			d := day
+			if d == -1 {
+				d = last
+			}
+			if d > last {
+				continue
+			}
+			if t := at(y, m, d); t.After(after) {
+				return t
+			}
+		}
+
+	default:
+		// Daily and weekly rules are checked day by day from the later of
+		// start and after; a period never spans more than 7*366 days
+		first := at(start.Year(), start.Month(), start.Day())
+		from := after.In(loc)
+		day := at(from.Year(), from.Month(), from.Day())
+		for i := 0; i < 7*r.Interval+8; i++ {
+			t := day.AddDate(0, 0, i)
+			if t.After(after) && r.matches(first, t) {
+				return t
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// matches reports whether a day is an occurrence of a daily or weekly rule
+func (r *Rule) matches(first, t time.Time) bool {
+	days := daysBetween(first, t)
+	if days < 0 {
+		return false
+	}
+	if r.Freq == FreqDaily {
+		return days%r.Interval == 0
+	}
+
+	byDay := r.ByDay
+	if len(byDay) == 0 {
+		byDay = []time.Weekday{first.Weekday()}
+	}
+	found := false
+	for _, wd := range byDay {
+		if t.Weekday() == wd {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+	// Weeks start on Monday, counted from the week of the first occurrence
+	weeks := daysBetween(weekStart(first), weekStart(t)) / 7
+	return weeks%r.Interval == 0
+}
+
+// daysBetween counts calendar days between two dates in the same location
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
+
+func weekStart(t time.Time) time.Time {
+	return t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+}
@@ -0,0 +1,261 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{name: "daily", rule: "FREQ=DAILY"},
+		{name: "prefix and lowercase", rule: "RRULE:freq=weekly;byday=mo,we"},
+		{name: "month day 31", rule: "FREQ=MONTHLY;BYMONTHDAY=31"},
+		{name: "last day of month", rule: "FREQ=MONTHLY;BYMONTHDAY=-1"},
+		{name: "interval", rule: "FREQ=DAILY;INTERVAL=366"},
+		{name: "missing freq", rule: "INTERVAL=2", wantErr: true},
+		{name: "yearly", rule: "FREQ=YEARLY", wantErr: true},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "interval too large", rule: "FREQ=DAILY;INTERVAL=367", wantErr: true},
+		{name: "month day 32", rule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "month day 0", rule: "FREQ=MONTHLY;BYMONTHDAY=0", wantErr: true},
+		{name: "month day -2", rule: "FREQ=MONTHLY;BYMONTHDAY=-2", wantErr: true},
+		{name: "unknown weekday", rule: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "daily by day", rule: "FREQ=DAILY;BYDAY=MO", wantErr: true},
+		{name: "weekly by month day", rule: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{name: "monthly by day", rule: "FREQ=MONTHLY;BYDAY=MO", wantErr: true},
+		{name: "bysetpos is unsupported", rule: "FREQ=MONTHLY;BYDAY=MO;BYSETPOS=1", wantErr: true},
+		{name: "count is unsupported", rule: "FREQ=DAILY;COUNT=5", wantErr: true},
+		{name: "until belongs to the recurrence", rule: "FREQ=DAILY;UNTIL=20250101T000000Z", wantErr: true},
+		{name: "part without value", rule: "FREQ=DAILY;INTERVAL", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.rule, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRuleNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	utc := func(y int, m time.Month, d, h int) time.Time {
+		return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+	}
+	ny := func(y int, m time.Month, d, h int) time.Time {
+		return time.Date(y, m, d, h, 0, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		after time.Time
+		want  time.Time
+	}{
+		{
+			name:  "first occurrence is the start",
+			rule:  "FREQ=DAILY",
+			start: utc(2025, time.January, 1, 9),
+			after: utc(2024, time.December, 1, 0),
+			want:  utc(2025, time.January, 1, 9),
+		},
+		{
+			name:  "strictly after",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: utc(2025, time.January, 1, 9),
+			after: utc(2025, time.January, 1, 9),
+			want:  utc(2025, time.January, 3, 9),
+		},
+		{
+			name:  "daily interval counts from the start",
+			rule:  "FREQ=DAILY;INTERVAL=3",
+			start: utc(2025, time.January, 1, 9),
+			after: utc(2025, time.January, 5, 12),
+			want:  utc(2025, time.January, 7, 9),
+		},
+		{
+			name:  "weekly on listed days",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,FR",
+			start: utc(2025, time.January, 1, 9), // Wednesday
+			after: utc(2025, time.January, 1, 9),
+			want:  utc(2025, time.January, 3, 9),
+		},
+		{
+			name:  "weekly defaults to the start weekday",
+			rule:  "FREQ=WEEKLY",
+			start: utc(2025, time.January, 1, 9),
+			after: utc(2025, time.January, 1, 9),
+			want:  utc(2025, time.January, 8, 9),
+		},
+		{
+			name:  "every other week",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			start: utc(2025, time.January, 6, 9),
+			after: utc(2025, time.January, 6, 9),
+			want:  utc(2025, time.January, 20, 9),
+		},
+		{
+			name:  "every other week starts the week on Monday",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,SU",
+			start: utc(2025, time.January, 6, 9), // Monday
+			after: utc(2025, time.January, 6, 9),
+			want:  utc(2025, time.January, 12, 9),
+		},
+		{
+			name:  "month day 31 skips shorter months",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=31",
+			start: utc(2025, time.January, 31, 9),
+			after: utc(2025, time.January, 31, 9),
+			want:  utc(2025, time.March, 31, 9),
+		},
+		{
+			name:  "month day 31 skips 30-day months",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=31",
+			start: utc(2025, time.January, 31, 9),
+			after: utc(2025, time.March, 31, 9),
+			want:  utc(2025, time.May, 31, 9),
+		},
+		{
+			name:  "month day 31 before the start day",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=31",
+			start: utc(2025, time.January, 15, 9),
+			after: utc(2025, time.January, 1, 0),
+			want:  utc(2025, time.January, 31, 9),
+		},
+		{
+			name:  "month end",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: utc(2025, time.January, 31, 9),
+			after: utc(2025, time.January, 31, 9),
+			want:  utc(2025, time.February, 28, 9),
+		},
+		{
+			name:  "month end in a leap year",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: utc(2024, time.January, 31, 9),
+			after: utc(2024, time.January, 31, 9),
+			want:  utc(2024, time.February, 29, 9),
+		},
+		{
+			name:  "month end across the year",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: utc(2024, time.January, 31, 9),
+			after: utc(2024, time.December, 31, 9),
+			want:  utc(2025, time.January, 31, 9),
+		},
+		{
+			name:  "monthly defaults to the start day",
+			rule:  "FREQ=MONTHLY",
+			start: utc(2025, time.January, 15, 9),
+			after: utc(2025, time.January, 15, 9),
+			want:  utc(2025, time.February, 15, 9),
+		},
+		{
+			name:  "quarterly skips a month without the day",
+			rule:  "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=31",
+			start: utc(2025, time.January, 31, 9),
+			after: utc(2025, time.January, 31, 9),
+			want:  utc(2025, time.July, 31, 9),
+		},
+		{
+			name:  "no month has the day",
+			rule:  "FREQ=MONTHLY;INTERVAL=12;BYMONTHDAY=30",
+			start: utc(2025, time.February, 1, 9),
+			after: utc(2025, time.February, 1, 9),
+			want:  time.Time{},
+		},
+		{
+			name:  "daily keeps the wall clock when DST starts",
+			rule:  "FREQ=DAILY",
+			start: ny(2025, time.March, 8, 9),
+			after: ny(2025, time.March, 8, 9),
+			want:  ny(2025, time.March, 9, 9),
+		},
+		{
+			name:  "daily keeps the wall clock when DST ends",
+			rule:  "FREQ=DAILY",
+			start: ny(2025, time.November, 1, 9),
+			after: ny(2025, time.November, 1, 9),
+			want:  ny(2025, time.November, 2, 9),
+		},
+		{
+			name:  "weekly across DST",
+			rule:  "FREQ=WEEKLY;BYDAY=MO",
+			start: ny(2025, time.March, 3, 9),
+			after: ny(2025, time.March, 3, 9),
+			want:  ny(2025, time.March, 10, 9),
+		},
+		{
+			name:  "monthly across DST",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: ny(2025, time.February, 28, 9),
+			after: ny(2025, time.February, 28, 9),
+			want:  ny(2025, time.March, 31, 9),
+		},
+		{
+			name:  "after given in another zone",
+			rule:  "FREQ=DAILY",
+			start: ny(2025, time.January, 1, 9),
+			after: utc(2025, time.January, 2, 13), // 08:00 in New York
+			want:  ny(2025, time.January, 2, 9),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.rule, err)
+			}
+			if got := r.Next(tt.start, tt.after); !got.Equal(tt.want) {
+				t.Fatalf("Next(%s, %s) = %s, want %s", tt.start, tt.after, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleUntil(t *testing.T) {
+	start := time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC)
+	second, until := start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)
+
+	sc, err := newSchedule("FREQ=DAILY", start, &until, "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		after time.Time
+		want  *time.Time
+	}{
+		{name: "before until", after: start, want: &second},
+		{name: "on until", after: second, want: &until},
+		{name: "past until", after: until, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sc.next(tt.after)
+			switch {
+			case got == nil && tt.want == nil:
+			case got == nil || tt.want == nil || !got.Equal(*tt.want):
+				t.Fatalf("next(%s) = %v, want %v", tt.after, got, tt.want)
+			}
+		})
+	}
+
+	early := start.Add(-time.Hour)
+	if _, err := newSchedule("FREQ=DAILY", start, &early, "UTC"); err == nil {
+		t.Fatal("newSchedule accepted an until before the start")
+	}
+	if _, err := newSchedule("FREQ=DAILY", start, nil, "Mars/Olympus"); err == nil {
+		t.Fatal("newSchedule accepted an unknown time zone")
+	}
+}
@@ -0,0 +1,266 @@
+package recurrence
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	taskEntity "github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrence"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskrecurrencerun"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+)
+
+const (
+	// maxClaims bounds the occurrences claimed per tick, so catching up after
+	// a long downtime is spread over several ticks
+	maxClaims = 100
+	// maxAttempts is how often a run is tried before it is marked failed
+	maxAttempts = 5
+	// retryAfter is how long a pending run is left alone after an attempt,
+	// which covers both retries and replicas that died mid-run
+	retryAfter = 5 * time.Minute
+)
+
+// Runner creates the tasks of due recurrences.
+//
+// Every replica may run one. Each occurrence is claimed by inserting its run
+// row, which is unique per recurrence and occurrence, in the same transaction
+// that moves the recurrence's next_run_at forward only if no one else did.
+// Runs are then executed by whoever bumps their attempt count first, and the
+// task they create is unique per run, so an occurrence yields at most one task
+// however often it is retried. Occurrences missed while no replica was running
+// are claimed on the next ticks.
+type Runner struct {
+	Service  *RecurrenceService
+	Interval time.Duration
+}
+
+// NewRunner creates a runner polling at an interval
+func NewRunner(service *RecurrenceService, interval time.Duration) *Runner {
+	return &Runner{
+		Service:  service,
+		Interval: interval,
+	}
+}
+
+// RunOnce claims the due occurrences and executes the pending runs
+func (r *Runner) RunOnce(ctx context.Context) {
+	claimed, err := r.claim(ctx, time.Now())
+	if err != nil {
+		log.Printf("Failed to claim recurrence occurrences: %v", err)
+	}
+	if claimed > 0 {
+		log.Printf("Claimed %d recurrence occurrences", claimed)
+	}
+	if err := r.execute(ctx); err != nil {
+		log.Printf("Failed to execute recurrence runs: %v", err)
+	}
+}
+
+// claim turns due occurrences into pending runs
+func (r *Runner) claim(ctx context.Context, now time.Time) (int, error) {
+	claimed := 0
+	for claimed < maxClaims {
+		due, err := r.Service.Client.TaskRecurrence.Query().
+			Where(taskrecurrence.Enabled(true)).
+			Where(taskrecurrence.NextRunAtLTE(now)).
+			Order(ent.Asc(taskrecurrence.FieldNextRunAt)).
+			Limit(maxClaims - claimed).
+			All(ctx)
+		if err != nil {
+			return claimed, err
+		}
+		if len(due) == 0 {
+			return claimed, nil
+		}
+
+		progressed := false
+		for _, rec := range due {
+			ok, err := r.claimOne(ctx, rec)
+			if err != nil {
+				log.Printf("Failed to claim an occurrence of recurrence %d: %v", rec.ID, err)
+				continue
+			}
+			if ok {
+				claimed++
+				progressed = true
+			}
+		}
+		// Everything left due was claimed by another replica meanwhile, or
+		// keeps failing; try again on the next tick
+		if !progressed {
+			return claimed, nil
+		}
+	}
+	return claimed, nil
+}
+
+// claimOne claims the next occurrence of a recurrence. It reports false when
+// another replica claimed it first.
+func (r *Runner) claimOne(ctx context.Context, rec *ent.TaskRecurrence) (bool, error) {
+	occurrence := *rec.NextRunAt
+	var next *time.Time
+	sc, err := scheduleOf(rec)
+	if err != nil {
+		// A rule that no longer parses stops the recurrence rather than
+		// failing every tick
+		log.Printf("Disabling recurrence %d: %v", rec.ID, err)
+	} else {
+		next = sc.next(occurrence)
+	}
+
+	tx, err := r.Service.Client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	advance := tx.TaskRecurrence.Update().
+		Where(taskrecurrence.ID(rec.ID)).
+		Where(taskrecurrence.NextRunAt(occurrence)).
+		SetLastRunAt(occurrence)
+	switch {
+	case sc == nil:
+		advance = advance.ClearNextRunAt().SetEnabled(false)
+	case next != nil:
+		advance = advance.SetNextRunAt(*next)
+	default:
+		advance = advance.ClearNextRunAt()
+	}
+	advanced, err := advance.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if advanced == 0 {
+		tx.Rollback()
+		return false, nil
+	}
+
+	if sc != nil {
+		if err := tx.TaskRecurrenceRun.Create().
+			SetRecurrenceID(rec.ID).
+			SetOccurrenceAt(occurrence).
+			Exec(ctx); err != nil {
+			tx.Rollback()
+			if ent.IsConstraintError(err) {
+				return false, nil
+			}
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return sc != nil, nil
+}
+
+// execute creates the tasks of pending runs
+func (r *Runner) execute(ctx context.Context) error {
+	runs, err := r.Service.Client.TaskRecurrenceRun.Query().
+		Where(taskrecurrencerun.StatusEQ(taskrecurrencerun.StatusPending)).
+		Where(taskrecurrencerun.Or(
+			taskrecurrencerun.Attempts(0),
+			taskrecurrencerun.UpdatedAtLT(time.Now().Add(-retryAfter)),
+		)).
+		Where(taskrecurrencerun.HasRecurrenceWith(taskrecurrence.Enabled(true))).
+		WithRecurrence(func(q *ent.TaskRecurrenceQuery) {
+			q.WithTemplate()
+		}).
+		Order(ent.Asc(taskrecurrencerun.FieldOccurrenceAt)).
+		Limit(maxClaims).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, run := range runs {
+		// Bumping the attempt count from the value read claims the attempt
+		claimed, err := r.Service.Client.TaskRecurrenceRun.Update().
+			Where(taskrecurrencerun.ID(run.ID)).
+			Where(taskrecurrencerun.StatusEQ(taskrecurrencerun.StatusPending)).
+			Where(taskrecurrencerun.Attempts(run.Attempts)).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			log.Printf("Failed to claim recurrence run %d: %v", run.ID, err)
+			continue
+		}
+		if claimed == 0 {
+			continue
+		}
+		r.executeOne(ctx, run, run.Attempts+1)
+	}
+	return nil
+}
+
+// executeOne creates the task of a run and records the outcome
+func (r *Runner) executeOne(ctx context.Context, run *ent.TaskRecurrenceRun, attempt int) {
+	// A previous attempt may have created the task and died before recording it
+	existing, err := r.Service.Client.Task.Query().
+		Where(taskEntity.RecurrenceRunID(run.ID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		log.Printf("Failed to check the task of recurrence run %d: %v", run.ID, err)
+		return
+	}
+
+	taskID := 0
+	if existing != nil {
+		taskID = existing.ID
+	} else {
+		rec := run.Edges.Recurrence
+		input := taskInput(rec.Edges.Template, run.OccurrenceAt.In(r.location(rec)))
+		input.RecurrenceRunID = &run.ID
+
+		created, err := r.Service.Tasks.Create(ctx, task.Actor{
+			UserID:     rec.CreatorID,
+			EmployeeID: rec.EmployeeID,
+			OrgID:      rec.Edges.Template.OrgID,
+		}, input)
+		if err != nil {
+			// Requests the task service refuses will not succeed on retry
+			permanent := false
+			if taskErr, ok := err.(*task.ServiceError); ok {
+				permanent = taskErr.Status < http.StatusInternalServerError
+			}
+			runUpdate := r.Service.Client.TaskRecurrenceRun.UpdateOneID(run.ID).
+				SetError(err.Error())
+			if permanent || attempt >= maxAttempts {
+				runUpdate = runUpdate.SetStatus(taskrecurrencerun.StatusFailed)
+			}
+			if err := runUpdate.Exec(ctx); err != nil {
+				log.Printf("Failed to record recurrence run %d: %v", run.ID, err)
+			}
+			log.Printf("Recurrence run %d failed on attempt %d: %v", run.ID, attempt, err)
+			return
+		}
+		taskID = created.ID
+	}
+
+	if err := r.Service.Client.TaskRecurrenceRun.UpdateOneID(run.ID).
+		SetStatus(taskrecurrencerun.StatusCreated).
+		SetTaskID(taskID).
+		SetError("").
+		Exec(ctx); err != nil {
+		log.Printf("Failed to record recurrence run %d: %v", run.ID, err)
+	}
+}
+
+// location returns the time zone a recurrence is evaluated in
+func (r *Runner) location(rec *ent.TaskRecurrence) *time.Location {
+	loc, err := time.LoadLocation(rec.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,336 @@
+package recurrence
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/label"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/tasktemplate"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// RecurrenceService provides business logic for task templates and the
+// recurrences that create tasks from them
+type RecurrenceService struct {
+	Client *ent.Client
+	Tasks  *task.TaskService
+}
+
+// NewRecurrenceService creates a new recurrence service. Tasks are created
+// through tasks, so they get the same validation and events as any other.
+func NewRecurrenceService(client *ent.Client, tasks *task.TaskService) *RecurrenceService {
+	return &RecurrenceService{
+		Client: client,
+		Tasks:  tasks,
+	}
+}
+
+// ListTemplates returns the task templates of an organization
+func (s *RecurrenceService) ListTemplates(ctx context.Context, orgID int, query dtos.TaskTemplateListQuery) ([]*ent.TaskTemplate, error) {
+	templateQuery := s.Client.TaskTemplate.Query().Where(tasktemplate.OrgID(orgID))
+	if query.ProjectID != "" {
+		projectID, err := strconv.Atoi(query.ProjectID)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid project_id",
+			}
+		}
+		templateQuery = templateQuery.Where(tasktemplate.ProjectID(projectID))
+	}
+
+	rows, err := templateQuery.
+		WithRecurrences().
+		Order(ent.Asc(tasktemplate.FieldName), ent.Asc(tasktemplate.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task templates",
+		}
+	}
+	return rows, nil
+}
+
+// CreateTemplate creates a task template in an organization
+func (s *RecurrenceService) CreateTemplate(ctx context.Context, orgID, userID int, input dtos.TaskTemplateCreateInput) (*ent.TaskTemplate, error) {
+	if err := s.checkRefs(ctx, orgID, input.ProjectID, input.LabelIDs, input.AssigneeIDs); err != nil {
+		return nil, err
+	}
+
+	templateCreate := s.Client.TaskTemplate.Create().
+		SetOrgID(orgID).
+		SetNillableProjectID(input.ProjectID).
+		SetName(input.Name).
+		SetDescription(input.Description).
+		SetLabelIds(input.LabelIDs).
+		SetAssigneeIds(input.AssigneeIDs).
+		SetNillableStartOffset(input.StartOffset).
+		SetNillableDueOffset(input.DueOffset).
+		SetNillableOriginalEstimate(input.OriginalEstimate).
+		SetCreatorID(userID)
+	if input.Type != nil {
+		templateCreate = templateCreate.SetType(tasktemplate.Type(*input.Type))
+	}
+
+	row, err := templateCreate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create task template",
+		}
+	}
+	return row, nil
+}
+
+// GetTemplate returns a task template of an organization with its recurrences
+func (s *RecurrenceService) GetTemplate(ctx context.Context, orgID, id int) (*ent.TaskTemplate, error) {
+	row, err := s.Client.TaskTemplate.Query().
+		Where(tasktemplate.ID(id), tasktemplate.OrgID(orgID)).
+		WithRecurrences().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Task template not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task template",
+		}
+	}
+	return row, nil
+}
+
+// UpdateTemplate updates a task template. Recurrences pick up the change from
+// their next occurrence.
+func (s *RecurrenceService) UpdateTemplate(ctx context.Context, orgID, id int, input dtos.TaskTemplateUpdateInput) (*ent.TaskTemplate, error) {
+	if _, err := s.GetTemplate(ctx, orgID, id); err != nil {
+		return nil, err
+	}
+	var projectID *int
+	if input.ProjectID != nil && *input.ProjectID != 0 {
+		projectID = input.ProjectID
+	}
+	if err := s.checkRefs(ctx, orgID, projectID, input.LabelIDs, input.AssigneeIDs); err != nil {
+		return nil, err
+	}
+
+	templateUpdate := s.Client.TaskTemplate.UpdateOneID(id).
+		SetNillableName(input.Name).
+		SetNillableDescription(input.Description)
+	if input.Type != nil {
+		templateUpdate = templateUpdate.SetType(tasktemplate.Type(*input.Type))
+	}
+	if input.ProjectID != nil {
+		if *input.ProjectID == 0 {
+			templateUpdate = templateUpdate.ClearProjectID()
+		} else {
+			templateUpdate = templateUpdate.SetProjectID(*input.ProjectID)
+		}
+	}
+	if input.LabelIDs != nil {
+		templateUpdate = templateUpdate.SetLabelIds(input.LabelIDs)
+	}
+	if input.AssigneeIDs != nil {
+		templateUpdate = templateUpdate.SetAssigneeIds(input.AssigneeIDs)
+	}
+	if input.StartOffset != nil {
+		if *input.StartOffset < 0 {
+			templateUpdate = templateUpdate.ClearStartOffset()
+		} else {
+			templateUpdate = templateUpdate.SetStartOffset(*input.StartOffset)
+		}
+	}
+	if input.DueOffset != nil {
+		if *input.DueOffset < 0 {
+			templateUpdate = templateUpdate.ClearDueOffset()
+		} else {
+			templateUpdate = templateUpdate.SetDueOffset(*input.DueOffset)
+		}
+	}
+	if input.OriginalEstimate != nil {
+		if *input.OriginalEstimate < 0 {
+			templateUpdate = templateUpdate.ClearOriginalEstimate()
+		} else {
+			templateUpdate = templateUpdate.SetOriginalEstimate(*input.OriginalEstimate)
+		}
+	}
+
+	if _, err := templateUpdate.Save(ctx); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update task template",
+		}
+	}
+	return s.GetTemplate(ctx, orgID, id)
+}
+
+// DeleteTemplate deletes a task template with its recurrences and their runs.
+// Tasks already created from it are kept.
+func (s *RecurrenceService) DeleteTemplate(ctx context.Context, orgID, id int) error {
+	deleted, err := s.Client.TaskTemplate.Delete().
+		Where(tasktemplate.ID(id), tasktemplate.OrgID(orgID)).
+		Exec(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete task template",
+		}
+	}
+	if deleted == 0 {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Task template not found",
+		}
+	}
+	return nil
+}
+
+// Instantiate creates a task from a template on behalf of a user
+func (s *RecurrenceService) Instantiate(ctx context.Context, orgID, id, userID, employeeID int, input dtos.TaskTemplateInstantiateInput) (*ent.Task, error) {
+	tmpl, err := s.GetTemplate(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	at := time.Now()
+	if input.At != nil {
+		if at, err = time.Parse(time.RFC3339, *input.At); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid at format, must be RFC3339",
+			}
+		}
+	}
+
+	created, err := s.Tasks.Create(ctx, task.Actor{
+		UserID:     userID,
+		EmployeeID: employeeID,
+		OrgID:      orgID,
+	}, taskInput(tmpl, at))
+	if err != nil {
+		if taskErr, ok := err.(*task.ServiceError); ok {
+			return nil, &ServiceError{
+				Status: taskErr.Status,
+				Msg:    taskErr.Msg,
+			}
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// taskInput builds the input creating a task from a template for a time
+func taskInput(tmpl *ent.TaskTemplate, at time.Time) dtos.TaskCreateInput {
+	taskType := string(tmpl.Type)
+	input := dtos.TaskCreateInput{
+		Name:             strings.ReplaceAll(tmpl.Name, "{date}", at.Format("2006-01-02")),
+		Type:             &taskType,
+		LabelIDs:         tmpl.LabelIds,
+		AssigneeIDs:      tmpl.AssigneeIds,
+		OriginalEstimate: tmpl.OriginalEstimate,
+	}
+	if tmpl.ProjectID != 0 {
+		projectID := tmpl.ProjectID
+		input.ProjectID = &projectID
+	}
+	if tmpl.Description != "" {
+		description := tmpl.Description
+		input.Description = &description
+	}
+	if tmpl.StartOffset != nil {
+		startAt := at.Add(time.Duration(*tmpl.StartOffset) * time.Minute).Format(time.RFC3339)
+		input.StartAt = &startAt
+	}
+	if tmpl.DueOffset != nil {
+		dueDate := at.Add(time.Duration(*tmpl.DueOffset) * time.Minute).Format(time.RFC3339)
+		input.DueDate = &dueDate
+	}
+	return input
+}
+
+// checkRefs checks that a template's project, labels and default assignees
+// belong to its organization
+func (s *RecurrenceService) checkRefs(ctx context.Context, orgID int, projectID *int, labelIDs, assigneeIDs []int) error {
+	if projectID != nil {
+		exists, err := s.Client.Project.Query().
+			Where(project.ID(*projectID), project.OrgID(orgID)).
+			Exist(ctx)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to fetch project",
+			}
+		}
+		if !exists {
+			return &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Project not found",
+			}
+		}
+	}
+
+	if len(labelIDs) > 0 {
+		count, err := s.Client.Label.Query().
+			Where(label.IDIn(labelIDs...), label.OrgID(orgID)).
+			Count(ctx)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to validate label IDs",
+			}
+		}
+		if count != len(unique(labelIDs)) {
+			return &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Some label IDs do not exist",
+			}
+		}
+	}
+
+	if len(assigneeIDs) > 0 {
+		count, err := s.Client.Employee.Query().
+			Where(employee.IDIn(assigneeIDs...), employee.OrgID(orgID)).
+			Count(ctx)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to validate assignee IDs",
+			}
+		}
+		if count != len(unique(assigneeIDs)) {
+			return &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Some assignee IDs do not exist",
+			}
+		}
+	}
+	return nil
+}
+
+func unique(ids []int) map[int]struct{} {
+	set := make(map[int]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,88 @@
+package shift
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// RosterCSV builds the CSV rows of a roster listing. Entries must be loaded with their shift and employee.
+func RosterCSV(rosters []*ent.Roster) [][]string {
+	rows := [][]string{{
+		"roster_id", "work_date", "employee_id", "employee_code",
+		"shift_code", "shift_name", "start_at", "end_at", "break_minutes", "is_night",
+	}}
+	for _, r := range rosters {
+		row := []string{strconv.Itoa(r.ID), utils.DayOf(r.WorkDate).Format("2006-01-02"), strconv.Itoa(r.EmployeeID), "", "", "", "", "", "", ""}
+		if r.Edges.Employee != nil {
+			row[3] = r.Edges.Employee.Code
+		}
+		if sh := r.Edges.Shift; sh != nil {
+			row[4] = sh.Code
+			row[5] = sh.Name
+			if start, end, err := Window(sh, r.WorkDate); err == nil {
+				row[6] = start.Format(time.RFC3339)
+				row[7] = end.Format(time.RFC3339)
+			}
+			row[8] = strconv.Itoa(sh.BreakMinutes)
+			row[9] = strconv.FormatBool(sh.IsNight)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// RosterICS renders a roster listing as an iCalendar (RFC 5545) document with one event per entry.
+// Entries must be loaded with their shift and employee.
+func RosterICS(rosters []*ent.Roster) string {
+	const layout = "20060102T150405Z"
+	stamp := time.Now().UTC().Format(layout)
+
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//hrm-ms-hr//Roster//EN\r\n")
+	b.WriteString("CALSCALE:GREGORIAN\r\n")
+	for _, r := range rosters {
+		sh := r.Edges.Shift
+		if sh == nil {
+			continue
+		}
+		start, end, err := Window(sh, r.WorkDate)
+		if err != nil {
+			continue
+		}
+		summary := sh.Name
+		if r.Edges.Employee != nil {
+			summary = fmt.Sprintf("%s - %s", sh.Name, r.Edges.Employee.Code)
+		}
+
+		b.WriteString("BEGIN:VEVENT\r\n")
+		fmt.Fprintf(&b, "UID:roster-%d@hrm-ms-hr\r\n", r.ID)
+		fmt.Fprintf(&b, "DTSTAMP:%s\r\n", stamp)
+		fmt.Fprintf(&b, "DTSTART:%s\r\n", start.UTC().Format(layout))
+		fmt.Fprintf(&b, "DTEND:%s\r\n", end.UTC().Format(layout))
+		fmt.Fprintf(&b, "SUMMARY:%s\r\n", escapeICSText(summary))
+		if r.Note != nil {
+			fmt.Fprintf(&b, "DESCRIPTION:%s\r\n", escapeICSText(*r.Note))
+		}
+		b.WriteString("END:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+// escapeICSText escapes a value for an iCalendar TEXT property
+func escapeICSText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
@@ -0,0 +1,210 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/position"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	entshift "github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// Assign rosters an employee on a shift for each of the given days. Either every day is
+// assigned or none is.
+func (s *ShiftService) Assign(ctx context.Context, orgID, actorID int, input dtos.RosterAssignInput) ([]*ent.Roster, error) {
+	exists, err := s.Client.Employee.Query().
+		Where(employee.ID(input.EmployeeID), employee.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate employee",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Employee not found in your organization",
+		}
+	}
+
+	exists, err = s.Client.Shift.Query().
+		Where(entshift.ID(input.ShiftID), entshift.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate shift",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Shift not found in your organization",
+		}
+	}
+
+	days := make([]time.Time, 0, len(input.WorkDates))
+	seen := make(map[string]bool, len(input.WorkDates))
+	for _, value := range input.WorkDates {
+		day, err := utils.ParseDate(value)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid work_dates format, must be YYYY-MM-DD",
+			}
+		}
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	rosters := make([]*ent.Roster, 0, len(days))
+	for _, day := range days {
+		// Each assignment is validated against the ones created before it in this batch
+		if err := validateAssignment(ctx, tx.Client(), input.EmployeeID, day); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		rCreate := tx.Roster.Create().
+			SetEmployeeID(input.EmployeeID).
+			SetShiftID(input.ShiftID).
+			SetOrgID(orgID).
+			SetWorkDate(day).
+			SetCreatedBy(actorID)
+		if input.Note != "" {
+			rCreate.SetNote(input.Note)
+		}
+
+		created, err := rCreate.Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			if ent.IsConstraintError(err) {
+				return nil, &ServiceError{
+					Status: http.StatusConflict,
+					Msg:    fmt.Sprintf("Employee is already rostered on %s", day.Format("2006-01-02")),
+				}
+			}
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to create roster entry",
+			}
+		}
+		rosters = append(rosters, created)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	return rosters, nil
+}
+
+// ListRoster retrieves roster entries of the caller's organization within a date range
+func (s *ShiftService) ListRoster(ctx context.Context, query dtos.RosterListQuery) ([]*ent.Roster, error) {
+	from, err := utils.ParseDate(query.From)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid from format, must be YYYY-MM-DD",
+		}
+	}
+	to, err := utils.ParseDate(query.To)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid to format, must be YYYY-MM-DD",
+		}
+	}
+	if to.Before(from) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "to must not be before from",
+		}
+	}
+	if to.Sub(from) > MaxRangeDays*24*time.Hour {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Date range is too large",
+		}
+	}
+
+	rQuery := s.Client.Roster.Query().
+		Where(
+			roster.OrgID(query.OrgID),
+			roster.WorkDateGTE(from),
+			roster.WorkDateLTE(to),
+		).
+		WithShift().
+		WithEmployee()
+
+	if query.EmployeeID > 0 {
+		rQuery = rQuery.Where(roster.EmployeeID(query.EmployeeID))
+	}
+	if query.ShiftID > 0 {
+		rQuery = rQuery.Where(roster.ShiftID(query.ShiftID))
+	}
+	if query.DepartmentID > 0 {
+		rQuery = rQuery.Where(roster.HasEmployeeWith(
+			employee.HasPositionWith(position.DepartmentID(query.DepartmentID)),
+		))
+	}
+
+	rosters, err := rQuery.
+		Order(ent.Asc(roster.FieldWorkDate), ent.Asc(roster.FieldEmployeeID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch roster",
+		}
+	}
+	return rosters, nil
+}
+
+// Unassign removes a roster entry of the caller's organization
+func (s *ShiftService) Unassign(ctx context.Context, id, orgID int) error {
+	deleted, err := s.Client.Roster.Delete().
+		Where(roster.ID(id), roster.OrgID(orgID)).
+		Exec(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete roster entry",
+		}
+	}
+	if deleted == 0 {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Roster entry not found",
+		}
+	}
+	return nil
+}
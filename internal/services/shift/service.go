@@ -0,0 +1,64 @@
+package shift
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// MaxConsecutiveWorkingDays is the longest run of rostered days allowed for one employee
+const MaxConsecutiveWorkingDays = 6
+
+// MaxRangeDays limits the date range of a single roster listing or export
+const MaxRangeDays = 62
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// ShiftService provides business logic for shifts, rosters and shift swaps
+type ShiftService struct {
+	Client *ent.Client
+}
+
+// NewShiftService creates a new shift service
+func NewShiftService(client *ent.Client) *ShiftService {
+	return &ShiftService{
+		Client: client,
+	}
+}
+
+// Window returns the start and end instants of a shift worked on the given day.
+// A shift whose end time is not after its start time ends on the following day.
+func Window(s *ent.Shift, day time.Time) (time.Time, time.Time, error) {
+	start, err := clockOffset(s.StartTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := clockOffset(s.EndTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end <= start {
+		end += 24 * time.Hour
+	}
+	day = utils.DayOf(day)
+	return day.Add(start), day.Add(end), nil
+}
+
+// clockOffset converts an HH:MM time into an offset from midnight
+func clockOffset(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, must be HH:MM", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
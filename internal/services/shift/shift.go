@@ -0,0 +1,169 @@
+package shift
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	entshift "github.com/longgggwwww/hrm-ms-hr/ent/shift"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// CreateShift creates a new shift template in the caller's organization
+func (s *ShiftService) CreateShift(ctx context.Context, orgID int, input dtos.ShiftCreateInput) (*ent.Shift, error) {
+	if input.StartTime == input.EndTime {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "start_time and end_time must differ",
+		}
+	}
+
+	exists, err := s.Client.Shift.Query().
+		Where(entshift.OrgID(orgID), entshift.Code(input.Code)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check shift code",
+		}
+	}
+	if exists {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Shift code already exists",
+		}
+	}
+
+	created, err := s.Client.Shift.Create().
+		SetCode(input.Code).
+		SetName(input.Name).
+		SetStartTime(input.StartTime).
+		SetEndTime(input.EndTime).
+		SetBreakMinutes(input.BreakMinutes).
+		SetBreakPaid(input.BreakPaid).
+		SetIsNight(input.IsNight).
+		SetOrgID(orgID).
+		Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create shift",
+		}
+	}
+	return created, nil
+}
+
+// ListShifts retrieves all shift templates of the caller's organization
+func (s *ShiftService) ListShifts(ctx context.Context, orgID int) ([]*ent.Shift, error) {
+	shifts, err := s.Client.Shift.Query().
+		Where(entshift.OrgID(orgID)).
+		Order(ent.Asc(entshift.FieldStartTime), ent.Asc(entshift.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch shifts",
+		}
+	}
+	return shifts, nil
+}
+
+// GetShift retrieves a single shift template of the caller's organization
+func (s *ShiftService) GetShift(ctx context.Context, id, orgID int) (*ent.Shift, error) {
+	found, err := s.Client.Shift.Query().
+		Where(entshift.ID(id), entshift.OrgID(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Shift not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch shift",
+		}
+	}
+	return found, nil
+}
+
+// UpdateShift updates a shift template. Existing roster entries follow the new times.
+func (s *ShiftService) UpdateShift(ctx context.Context, id, orgID int, input dtos.ShiftUpdateInput) (*ent.Shift, error) {
+	existing, err := s.GetShift(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, endTime := existing.StartTime, existing.EndTime
+	if input.StartTime != nil {
+		startTime = *input.StartTime
+	}
+	if input.EndTime != nil {
+		endTime = *input.EndTime
+	}
+	if startTime == endTime {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "start_time and end_time must differ",
+		}
+	}
+
+	sUpdate := s.Client.Shift.UpdateOneID(id).
+		SetStartTime(startTime).
+		SetEndTime(endTime)
+
+	if input.Name != nil {
+		sUpdate.SetName(*input.Name)
+	}
+	if input.BreakMinutes != nil {
+		sUpdate.SetBreakMinutes(*input.BreakMinutes)
+	}
+	if input.BreakPaid != nil {
+		sUpdate.SetBreakPaid(*input.BreakPaid)
+	}
+	if input.IsNight != nil {
+		sUpdate.SetIsNight(*input.IsNight)
+	}
+
+	updated, err := sUpdate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update shift",
+		}
+	}
+	return updated, nil
+}
+
+// DeleteShift deletes a shift template that is not used by any roster entry
+func (s *ShiftService) DeleteShift(ctx context.Context, id, orgID int) error {
+	if _, err := s.GetShift(ctx, id, orgID); err != nil {
+		return err
+	}
+
+	inUse, err := s.Client.Roster.Query().
+		Where(roster.ShiftID(id)).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check shift usage",
+		}
+	}
+	if inUse {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Shift is used by the roster and cannot be deleted",
+		}
+	}
+
+	if err := s.Client.Shift.DeleteOneID(id).Exec(ctx); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete shift",
+		}
+	}
+	return nil
+}
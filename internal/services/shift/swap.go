@@ -0,0 +1,331 @@
+package shift
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/ent/shiftswaprequest"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// CreateSwap submits a request to swap one of the caller's rostered shifts with a colleague's
+func (s *ShiftService) CreateSwap(ctx context.Context, orgID, requesterID int, input dtos.ShiftSwapCreateInput) (*ent.ShiftSwapRequest, error) {
+	own, err := s.Client.Roster.Query().
+		Where(roster.ID(input.RosterID), roster.OrgID(orgID), roster.EmployeeID(requesterID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Roster entry not found or not assigned to you",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch roster entry",
+		}
+	}
+
+	target, err := s.Client.Roster.Query().
+		Where(roster.ID(input.TargetRosterID), roster.OrgID(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Target roster entry not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch target roster entry",
+		}
+	}
+	if target.EmployeeID == requesterID {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Cannot swap shifts with yourself",
+		}
+	}
+
+	today := utils.DayOf(time.Now())
+	if own.WorkDate.Before(today) || target.WorkDate.Before(today) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Cannot swap shifts in the past",
+		}
+	}
+
+	pending, err := s.Client.ShiftSwapRequest.Query().
+		Where(
+			shiftswaprequest.StatusEQ(shiftswaprequest.StatusPending),
+			shiftswaprequest.Or(
+				shiftswaprequest.RosterIDIn(own.ID, target.ID),
+				shiftswaprequest.TargetRosterIDIn(own.ID, target.ID),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check pending swap requests",
+		}
+	}
+	if pending {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "One of the shifts already has a pending swap request",
+		}
+	}
+
+	ssCreate := s.Client.ShiftSwapRequest.Create().
+		SetOrgID(orgID).
+		SetRequesterID(requesterID).
+		SetRosterID(own.ID).
+		SetTargetRosterID(target.ID)
+	if input.Reason != "" {
+		ssCreate.SetReason(input.Reason)
+	}
+
+	swap, err := ssCreate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create shift swap request",
+		}
+	}
+	return swap, nil
+}
+
+// ListSwaps retrieves shift swap requests of the caller's organization, newest first
+func (s *ShiftService) ListSwaps(ctx context.Context, query dtos.ShiftSwapListQuery) (*dtos.ShiftSwapListResponse, error) {
+	ssQuery := s.Client.ShiftSwapRequest.Query().
+		Where(shiftswaprequest.OrgID(query.OrgID)).
+		WithRoster(func(q *ent.RosterQuery) { q.WithShift() }).
+		WithTargetRoster(func(q *ent.RosterQuery) { q.WithShift() })
+
+	// Employees see the requests they made and the ones targeting their shifts
+	if query.EmployeeID > 0 {
+		ssQuery = ssQuery.Where(shiftswaprequest.Or(
+			shiftswaprequest.RequesterID(query.EmployeeID),
+			shiftswaprequest.HasTargetRosterWith(roster.EmployeeID(query.EmployeeID)),
+		))
+	}
+	if query.Status != "" {
+		ssQuery = ssQuery.Where(shiftswaprequest.StatusEQ(shiftswaprequest.Status(query.Status)))
+	}
+
+	total, err := ssQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count shift swap requests",
+		}
+	}
+
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := query.Limit
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	swaps, err := ssQuery.
+		Order(ent.Desc(shiftswaprequest.FieldCreatedAt), ent.Desc(shiftswaprequest.FieldID)).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch shift swap requests",
+		}
+	}
+
+	return &dtos.ShiftSwapListResponse{
+		Data: swaps,
+		Pagination: dtos.OffsetPagination{
+			Type:        "page",
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			TotalItems:  total,
+			PerPage:     limit,
+		},
+	}, nil
+}
+
+// ApproveSwap approves a pending swap and exchanges the two roster entries
+func (s *ShiftService) ApproveSwap(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.ShiftSwapRequest, error) {
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	swap, err := pendingSwap(ctx, tx.Client(), id, orgID, reviewerID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	own, target := swap.Edges.Roster, swap.Edges.TargetRoster
+
+	if own.WorkDate.Equal(target.WorkDate) {
+		// Same day: the employees simply trade shifts
+		if err := tx.Roster.UpdateOneID(own.ID).SetShiftID(target.ShiftID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to swap roster entries",
+			}
+		}
+		if err := tx.Roster.UpdateOneID(target.ID).SetShiftID(own.ShiftID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to swap roster entries",
+			}
+		}
+	} else {
+		// Different days: each employee takes over the other's entry, re-checked against leave and rest rules
+		if err := validateAssignment(ctx, tx.Client(), own.EmployeeID, target.WorkDate, own.ID, target.ID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := validateAssignment(ctx, tx.Client(), target.EmployeeID, own.WorkDate, own.ID, target.ID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if err := tx.Roster.UpdateOneID(own.ID).SetEmployeeID(target.EmployeeID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, swapConflict(err)
+		}
+		if err := tx.Roster.UpdateOneID(target.ID).SetEmployeeID(own.EmployeeID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, swapConflict(err)
+		}
+	}
+
+	ssUpdate := tx.ShiftSwapRequest.UpdateOneID(id).
+		Where(shiftswaprequest.StatusEQ(shiftswaprequest.StatusPending)).
+		SetStatus(shiftswaprequest.StatusApproved).
+		SetReviewerID(reviewerID).
+		SetReviewedAt(time.Now())
+	if comment != "" {
+		ssUpdate.SetReviewComment(comment)
+	}
+
+	updated, err := ssUpdate.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Shift swap request has already been reviewed",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to approve shift swap request",
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	return updated, nil
+}
+
+// RejectSwap rejects a pending swap request
+func (s *ShiftService) RejectSwap(ctx context.Context, id, orgID, reviewerID int, comment string) (*ent.ShiftSwapRequest, error) {
+	if _, err := pendingSwap(ctx, s.Client, id, orgID, reviewerID); err != nil {
+		return nil, err
+	}
+
+	ssUpdate := s.Client.ShiftSwapRequest.UpdateOneID(id).
+		Where(shiftswaprequest.StatusEQ(shiftswaprequest.StatusPending)).
+		SetStatus(shiftswaprequest.StatusRejected).
+		SetReviewerID(reviewerID).
+		SetReviewedAt(time.Now())
+	if comment != "" {
+		ssUpdate.SetReviewComment(comment)
+	}
+
+	updated, err := ssUpdate.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Shift swap request has already been reviewed",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to reject shift swap request",
+		}
+	}
+	return updated, nil
+}
+
+// pendingSwap loads a swap request, with both roster entries, that the reviewer is allowed to review
+func pendingSwap(ctx context.Context, client *ent.Client, id, orgID, reviewerID int) (*ent.ShiftSwapRequest, error) {
+	swap, err := client.ShiftSwapRequest.Query().
+		Where(shiftswaprequest.ID(id), shiftswaprequest.OrgID(orgID)).
+		WithRoster().
+		WithTargetRoster().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Shift swap request not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch shift swap request",
+		}
+	}
+	if swap.Status != shiftswaprequest.StatusPending {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Only pending shift swap requests can be reviewed",
+		}
+	}
+	if swap.RequesterID == reviewerID || swap.Edges.TargetRoster.EmployeeID == reviewerID {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "You cannot review a shift swap you are part of",
+		}
+	}
+	return swap, nil
+}
+
+func swapConflict(err error) error {
+	if ent.IsConstraintError(err) {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "One of the employees is already rostered on the other day",
+		}
+	}
+	return &ServiceError{
+		Status: http.StatusInternalServerError,
+		Msg:    "Failed to swap roster entries",
+	}
+}
@@ -0,0 +1,78 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/leaverequest"
+	"github.com/longgggwwww/hrm-ms-hr/ent/roster"
+	"github.com/longgggwwww/hrm-ms-hr/internal/utils"
+)
+
+// validateAssignment checks that an employee can be rostered on the given day: the day must
+// not be covered by an approved leave request and must not push the employee over
+// MaxConsecutiveWorkingDays. Roster entries listed in exclude are ignored, which lets
+// callers validate an entry that is about to move to another employee or day.
+func validateAssignment(ctx context.Context, client *ent.Client, employeeID int, day time.Time, exclude ...int) error {
+	onLeave, err := client.LeaveRequest.Query().
+		Where(
+			leaverequest.EmployeeID(employeeID),
+			leaverequest.StatusEQ(leaverequest.StatusApproved),
+			leaverequest.StartAtLT(day.AddDate(0, 0, 1)),
+			leaverequest.EndAtGTE(day),
+		).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check leave requests",
+		}
+	}
+	if onLeave {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    fmt.Sprintf("Employee %d is on approved leave on %s", employeeID, day.Format("2006-01-02")),
+		}
+	}
+
+	rQuery := client.Roster.Query().
+		Where(
+			roster.EmployeeID(employeeID),
+			roster.WorkDateGTE(day.AddDate(0, 0, -MaxConsecutiveWorkingDays)),
+			roster.WorkDateLTE(day.AddDate(0, 0, MaxConsecutiveWorkingDays)),
+		)
+	if len(exclude) > 0 {
+		rQuery = rQuery.Where(roster.IDNotIn(exclude...))
+	}
+	nearby, err := rQuery.All(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check roster",
+		}
+	}
+
+	worked := make(map[string]bool, len(nearby))
+	for _, r := range nearby {
+		worked[utils.DayOf(r.WorkDate).Format("2006-01-02")] = true
+	}
+
+	streak := 1
+	for d := day.AddDate(0, 0, -1); worked[d.Format("2006-01-02")]; d = d.AddDate(0, 0, -1) {
+		streak++
+	}
+	for d := day.AddDate(0, 0, 1); worked[d.Format("2006-01-02")]; d = d.AddDate(0, 0, 1) {
+		streak++
+	}
+	if streak > MaxConsecutiveWorkingDays {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg: fmt.Sprintf("Employee %d would work more than %d consecutive days around %s",
+				employeeID, MaxConsecutiveWorkingDays, day.Format("2006-01-02")),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package sprint
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// List returns the sprints of a project in start order
+func (s *SprintService) List(ctx context.Context, projectID, orgID int, query dtos.SprintListQuery) ([]*ent.Sprint, error) {
+	if err := s.checkProject(ctx, projectID, orgID); err != nil {
+		return nil, err
+	}
+
+	sprintQuery := s.Client.Sprint.Query().Where(sprint.ProjectID(projectID))
+	if query.State != "" {
+		sprintQuery = sprintQuery.Where(sprint.StateEQ(sprint.State(query.State)))
+	}
+	rows, err := sprintQuery.
+		Order(ent.Asc(sprint.FieldStartAt), ent.Asc(sprint.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch sprints",
+		}
+	}
+	return rows, nil
+}
+
+// Create plans a new sprint in a project
+func (s *SprintService) Create(ctx context.Context, projectID, orgID, employeeID int, input dtos.SprintCreateInput) (*ent.Sprint, error) {
+	if err := s.checkProject(ctx, projectID, orgID); err != nil {
+		return nil, err
+	}
+	start, end, err := parseRange(input.StartAt, input.EndAt)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.Client.Sprint.Create().
+		SetProjectID(projectID).
+		SetName(input.Name).
+		SetGoal(input.Goal).
+		SetStartAt(start).
+		SetEndAt(end).
+		SetCreatorID(employeeID).
+		Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create sprint",
+		}
+	}
+	return row, nil
+}
+
+// Get returns a sprint
+func (s *SprintService) Get(ctx context.Context, id, orgID int) (*ent.Sprint, error) {
+	return s.get(ctx, id, orgID)
+}
+
+// Update changes a sprint that is not closed
+func (s *SprintService) Update(ctx context.Context, id, orgID int, input dtos.SprintUpdateInput) (*ent.Sprint, error) {
+	row, err := s.get(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if row.State == sprint.StateClosed {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "A closed sprint cannot be changed",
+		}
+	}
+
+	startAt := row.StartAt.Format(time.RFC3339)
+	if input.StartAt != nil {
+		startAt = *input.StartAt
+	}
+	endAt := row.EndAt.Format(time.RFC3339)
+	if input.EndAt != nil {
+		endAt = *input.EndAt
+	}
+	start, end, err := parseRange(startAt, endAt)
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.Client.Sprint.UpdateOneID(id).
+		SetStartAt(start).
+		SetEndAt(end)
+	if input.Name != nil {
+		update.SetName(*input.Name)
+	}
+	if input.Goal != nil {
+		update.SetGoal(*input.Goal)
+	}
+	row, err = update.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update sprint",
+		}
+	}
+	return row, nil
+}
+
+// Delete removes a sprint that is not running; its tasks go back to the backlog
+func (s *SprintService) Delete(ctx context.Context, id, orgID int) error {
+	row, err := s.get(ctx, id, orgID)
+	if err != nil {
+		return err
+	}
+	if row.State == sprint.StateActive {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "An active sprint cannot be deleted, close it first",
+		}
+	}
+
+	if err := s.Client.Sprint.DeleteOneID(id).Exec(ctx); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete sprint",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package sprint
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// Start makes a planned sprint the active one of its project and records the
+// tasks committed to it
+func (s *SprintService) Start(ctx context.Context, id, orgID int) (*ent.Sprint, error) {
+	row, err := s.get(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if row.State != sprint.StatePlanned {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Only a planned sprint can be started",
+		}
+	}
+
+	active, err := s.Client.Sprint.Query().
+		Where(sprint.ProjectID(row.ProjectID)).
+		Where(sprint.StateEQ(sprint.StateActive)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check active sprints",
+		}
+	}
+	if active {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "The project already has an active sprint",
+		}
+	}
+
+	committed, err := s.Client.Task.Query().
+		Where(task.SprintID(id)).
+		IDs(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch sprint tasks",
+		}
+	}
+
+	row, err = s.Client.Sprint.UpdateOneID(id).
+		Where(sprint.StateEQ(sprint.StatePlanned)).
+		SetState(sprint.StateActive).
+		SetStartedAt(time.Now()).
+		SetCommittedTaskIds(committed).
+		Save(ctx)
+	if err != nil {
+		// The partial unique index refuses a second active sprint started
+		// concurrently
+		if ent.IsConstraintError(err) || ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "The project already has an active sprint",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start sprint",
+		}
+	}
+	return row, nil
+}
+
+// Close ends the active sprint. Unfinished tasks move to the chosen sprint,
+// else to the project's next planned sprint, else back to the backlog.
+// Cancelled tasks stay in the closed sprint.
+func (s *SprintService) Close(ctx context.Context, id, orgID int, input dtos.SprintCloseInput) (*dtos.SprintCloseResponse, error) {
+	row, err := s.get(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if row.State != sprint.StateActive {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Only an active sprint can be closed",
+		}
+	}
+
+	next, err := s.nextSprint(ctx, row, orgID, input.NextSprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.Client.Task.Query().
+		Where(task.SprintID(id)).
+		Select(task.FieldID, task.FieldStatus).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch sprint tasks",
+		}
+	}
+	response := &dtos.SprintCloseResponse{
+		SprintID:         id,
+		CompletedTaskIDs: []int{},
+		MovedTaskIDs:     []int{},
+	}
+	for _, t := range tasks {
+		switch t.Status {
+		case task.StatusCompleted:
+			response.CompletedTaskIDs = append(response.CompletedTaskIDs, t.ID)
+		case task.StatusCancelled:
+		default:
+			response.MovedTaskIDs = append(response.MovedTaskIDs, t.ID)
+		}
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if len(response.MovedTaskIDs) > 0 {
+		move := tx.Task.Update().Where(task.IDIn(response.MovedTaskIDs...))
+		if next != nil {
+			move.SetSprintID(next.ID)
+		} else {
+			move.ClearSprintID()
+		}
+		if err := move.Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to move unfinished tasks",
+			}
+		}
+	}
+
+	n, err := tx.Sprint.Update().
+		Where(sprint.ID(id)).
+		Where(sprint.StateEQ(sprint.StateActive)).
+		SetState(sprint.StateClosed).
+		SetClosedAt(time.Now()).
+		SetCompletedTaskIds(response.CompletedTaskIDs).
+		SetIncompleteTaskIds(response.MovedTaskIDs).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to close sprint",
+		}
+	}
+	if n == 0 {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "The sprint has already been closed",
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+
+	if next != nil {
+		response.NextSprintID = &next.ID
+	}
+	return response, nil
+}
+
+// nextSprint finds the sprint that receives the unfinished tasks of a closing
+// sprint; nil means the backlog
+func (s *SprintService) nextSprint(ctx context.Context, closing *ent.Sprint, orgID int, nextID *int) (*ent.Sprint, error) {
+	if nextID != nil {
+		next, err := s.get(ctx, *nextID, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if next.ID == closing.ID || next.ProjectID != closing.ProjectID || next.State != sprint.StatePlanned {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "The next sprint must be another planned sprint of the same project",
+			}
+		}
+		return next, nil
+	}
+
+	next, err := s.Client.Sprint.Query().
+		Where(sprint.ProjectID(closing.ProjectID)).
+		Where(sprint.StateEQ(sprint.StatePlanned)).
+		Order(ent.Asc(sprint.FieldStartAt), ent.Asc(sprint.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch the next sprint",
+		}
+	}
+	return next, nil
+}
@@ -0,0 +1,257 @@
+package sprint
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// sprintWork sorts the tasks of a sprint for its report and burndown
+type sprintWork struct {
+	tasks      map[int]*ent.Task
+	committed  []int
+	added      []int
+	removed    []int
+	completed  []int
+	incomplete []int
+	// scope holds the tasks in the sprint when it closed, or now
+	scope []int
+}
+
+// work sorts the tasks of a sprint. A planned sprint has not committed yet,
+// so its current tasks are reported as committed.
+func (s *SprintService) work(ctx context.Context, row *ent.Sprint) (*sprintWork, error) {
+	members, err := s.Client.Task.Query().
+		Where(task.SprintID(row.ID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch sprint tasks",
+		}
+	}
+
+	w := &sprintWork{committed: row.CommittedTaskIds}
+	if row.State == sprint.StatePlanned {
+		w.committed = members
+	}
+	w.scope = members
+	if row.State == sprint.StateClosed {
+		// Unfinished tasks have moved on; the snapshots remember them
+		w.scope = union(row.CompletedTaskIds, row.IncompleteTaskIds, members)
+	}
+
+	ids := union(w.committed, w.scope)
+	rows, err := s.Client.Task.Query().
+		Where(task.IDIn(ids...)).
+		Select(
+			task.FieldID, task.FieldStatus, task.FieldOriginalEstimate,
+			task.FieldCompletedAt, task.FieldCreatedAt, task.FieldUpdatedAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch sprint tasks",
+		}
+	}
+	w.tasks = make(map[int]*ent.Task, len(rows))
+	for _, t := range rows {
+		w.tasks[t.ID] = t
+	}
+
+	inScope := toSet(w.scope)
+	isCommitted := toSet(w.committed)
+	for _, id := range w.committed {
+		if !inScope[id] {
+			w.removed = append(w.removed, id)
+		}
+	}
+	for _, id := range w.scope {
+		if !isCommitted[id] {
+			w.added = append(w.added, id)
+		}
+	}
+
+	if row.State == sprint.StateClosed {
+		w.completed = row.CompletedTaskIds
+		w.incomplete = row.IncompleteTaskIds
+	} else {
+		for _, id := range members {
+			t, ok := w.tasks[id]
+			if !ok {
+				continue
+			}
+			switch t.Status {
+			case task.StatusCompleted:
+				w.completed = append(w.completed, id)
+			case task.StatusCancelled:
+			default:
+				w.incomplete = append(w.incomplete, id)
+			}
+		}
+	}
+	return w, nil
+}
+
+// total sums a set of tasks; deleted tasks are left out
+func (w *sprintWork) total(ids []int) dtos.SprintWork {
+	total := dtos.SprintWork{TaskIDs: []int{}}
+	for _, id := range ids {
+		t, ok := w.tasks[id]
+		if !ok {
+			continue
+		}
+		total.Tasks++
+		total.TaskIDs = append(total.TaskIDs, id)
+		if t.OriginalEstimate != nil {
+			total.Estimate += *t.OriginalEstimate
+		}
+	}
+	return total
+}
+
+// Report compares the work committed to a sprint with the work completed.
+// Estimates are the tasks' original estimates in minutes.
+func (s *SprintService) Report(ctx context.Context, id, orgID int) (*dtos.SprintReportResponse, error) {
+	row, err := s.get(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	w, err := s.work(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.SprintReportResponse{
+		SprintID:   row.ID,
+		State:      string(row.State),
+		Committed:  w.total(w.committed),
+		Added:      w.total(w.added),
+		Removed:    w.total(w.removed),
+		Completed:  w.total(w.completed),
+		Incomplete: w.total(w.incomplete),
+	}, nil
+}
+
+// Burndown returns the tasks and estimate left in a sprint at the end of each
+// of its days. Cancelled and removed tasks are left out; tasks added after
+// the start count from their creation.
+func (s *SprintService) Burndown(ctx context.Context, id, orgID int) (*dtos.SprintBurndownResponse, error) {
+	row, err := s.get(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if row.State == sprint.StatePlanned {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "The sprint has not started yet",
+		}
+	}
+	w, err := s.work(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	var scope []*ent.Task
+	for _, id := range w.scope {
+		if t, ok := w.tasks[id]; ok && t.Status != task.StatusCancelled {
+			scope = append(scope, t)
+		}
+	}
+	isCommitted := toSet(w.committed)
+	committedEstimate := w.total(w.committed).Estimate
+
+	cutoff := time.Now()
+	if row.ClosedAt != nil && row.ClosedAt.Before(cutoff) {
+		cutoff = *row.ClosedAt
+	}
+
+	first := startOfDay(row.StartAt)
+	days := int(startOfDay(row.EndAt).Sub(first).Hours()/24+0.5) + 1
+	points := make([]dtos.SprintBurndownPoint, days)
+	for i := range points {
+		day := first.AddDate(0, 0, i)
+		point := dtos.SprintBurndownPoint{
+			Date:          day.Format("2006-01-02"),
+			IdealEstimate: committedEstimate,
+		}
+		if days > 1 {
+			point.IdealEstimate = committedEstimate * (days - 1 - i) / (days - 1)
+		}
+
+		if !day.After(cutoff) {
+			at := day.AddDate(0, 0, 1)
+			if at.After(cutoff) {
+				at = cutoff
+			}
+			tasks, estimate := 0, 0
+			for _, t := range scope {
+				if !isCommitted[t.ID] && !t.CreatedAt.Before(at) {
+					continue
+				}
+				if done := completedAt(t); done != nil && done.Before(at) {
+					continue
+				}
+				tasks++
+				if t.OriginalEstimate != nil {
+					estimate += *t.OriginalEstimate
+				}
+			}
+			point.RemainingTasks = &tasks
+			point.RemainingEstimate = &estimate
+		}
+		points[i] = point
+	}
+
+	return &dtos.SprintBurndownResponse{
+		SprintID: row.ID,
+		Points:   points,
+	}, nil
+}
+
+// completedAt returns when a task was completed. Tasks completed before the
+// time was recorded fall back to their last update.
+func completedAt(t *ent.Task) *time.Time {
+	if t.CompletedAt != nil {
+		return t.CompletedAt
+	}
+	if t.Status == task.StatusCompleted {
+		return &t.UpdatedAt
+	}
+	return nil
+}
+
+// startOfDay returns midnight of t's day in local time
+func startOfDay(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// union merges ID lists without duplicates, keeping their order
+func union(lists ...[]int) []int {
+	seen := make(map[int]bool)
+	var ids []int
+	for _, list := range lists {
+		for _, id := range list {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+func toSet(ids []int) map[int]bool {
+	set := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
@@ -0,0 +1,104 @@
+package sprint
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// SprintService provides business logic for project sprints
+type SprintService struct {
+	Client *ent.Client
+}
+
+// NewSprintService creates a new sprint service
+func NewSprintService(client *ent.Client) *SprintService {
+	return &SprintService{
+		Client: client,
+	}
+}
+
+// checkProject makes sure the project exists in the organization
+func (s *SprintService) checkProject(ctx context.Context, projectID, orgID int) error {
+	exists, err := s.Client.Project.Query().
+		Where(project.ID(projectID), project.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project",
+		}
+	}
+	if !exists {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Project not found",
+		}
+	}
+	return nil
+}
+
+// get fetches a sprint of a project in the organization
+func (s *SprintService) get(ctx context.Context, id, orgID int) (*ent.Sprint, error) {
+	row, err := s.Client.Sprint.Query().
+		Where(sprint.ID(id), sprint.HasProjectWith(project.OrgID(orgID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Sprint not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch sprint",
+		}
+	}
+	return row, nil
+}
+
+// parseRange parses the dates of a sprint, which must end after it starts
+func parseRange(startAt, endAt string) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, startAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid start_at format, must be RFC3339",
+		}
+	}
+	end, err := time.Parse(time.RFC3339, endAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid end_at format, must be RFC3339",
+		}
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "end_at must be after start_at",
+		}
+	}
+	if end.Sub(start) > 366*24*time.Hour {
+		return time.Time{}, time.Time{}, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "A sprint cannot last more than a year",
+		}
+	}
+	return start, end, nil
+}
@@ -0,0 +1,292 @@
+package task
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
+)
+
+// change is one entry of a task's activity stream
+type change struct {
+	Kind     taskactivity.Kind
+	Field    string
+	OldValue string
+	NewValue string
+	RefID    int
+}
+
+// taskChanges lists what differs between two versions of a task. Assignees and
+// labels are only compared when they are loaded on both.
+func taskChanges(before, after *ent.Task) []change {
+	var changes []change
+	fieldChange := func(name, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, change{
+				Kind:     taskactivity.KindFieldChanged,
+				Field:    name,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+
+	fieldChange("name", before.Name, after.Name)
+	fieldChange("code", before.Code, after.Code)
+	fieldChange("description", before.Description, after.Description)
+	fieldChange("type", string(before.Type), string(after.Type))
+	fieldChange("process", strconv.Itoa(before.Process), strconv.Itoa(after.Process))
+	fieldChange("start_at", formatTime(before.StartAt), formatTime(after.StartAt))
+	fieldChange("due_date", formatTime(before.DueDate), formatTime(after.DueDate))
+	fieldChange("project_id", formatID(before.ProjectID), formatID(after.ProjectID))
+	fieldChange("parent_id", formatID(before.ParentID), formatID(after.ParentID))
+	fieldChange("sprint_id", formatID(before.SprintID), formatID(after.SprintID))
+	fieldChange("milestone_id", formatID(before.MilestoneID), formatID(after.MilestoneID))
+	fieldChange("original_estimate", formatInt(before.OriginalEstimate), formatInt(after.OriginalEstimate))
+	fieldChange("remaining_estimate", formatInt(before.RemainingEstimate), formatInt(after.RemainingEstimate))
+
+	if from, to := workflow.StatusKey(before), workflow.StatusKey(after); from != to {
+		changes = append(changes, change{
+			Kind:     taskactivity.KindStatusChanged,
+			Field:    "status",
+			OldValue: from,
+			NewValue: to,
+		})
+	}
+
+	beforeAssignees, errBefore := before.Edges.AssigneesOrErr()
+	afterAssignees, errAfter := after.Edges.AssigneesOrErr()
+	if errBefore == nil && errAfter == nil {
+		var oldIDs, newIDs []int
+		for _, emp := range beforeAssignees {
+			oldIDs = append(oldIDs, emp.ID)
+		}
+		for _, emp := range afterAssignees {
+			newIDs = append(newIDs, emp.ID)
+		}
+		changes = append(changes, edgeChanges(oldIDs, newIDs, taskactivity.KindAssigneeAdded, taskactivity.KindAssigneeRemoved)...)
+	}
+
+	beforeLabels, errBefore := before.Edges.LabelsOrErr()
+	afterLabels, errAfter := after.Edges.LabelsOrErr()
+	if errBefore == nil && errAfter == nil {
+		var oldIDs, newIDs []int
+		for _, lbl := range beforeLabels {
+			oldIDs = append(oldIDs, lbl.ID)
+		}
+		for _, lbl := range afterLabels {
+			newIDs = append(newIDs, lbl.ID)
+		}
+		changes = append(changes, edgeChanges(oldIDs, newIDs, taskactivity.KindLabelAdded, taskactivity.KindLabelRemoved)...)
+	}
+
+	return changes
+}
+
+// edgeChanges lists the IDs added to and removed from an edge
+func edgeChanges(oldIDs, newIDs []int, added, removed taskactivity.Kind) []change {
+	oldSet := make(map[int]bool, len(oldIDs))
+	for _, id := range oldIDs {
+		oldSet[id] = true
+	}
+	newSet := make(map[int]bool, len(newIDs))
+	for _, id := range newIDs {
+		newSet[id] = true
+	}
+
+	var changes []change
+	for _, id := range newIDs {
+		if !oldSet[id] {
+			changes = append(changes, change{Kind: added, RefID: id})
+		}
+	}
+	for _, id := range oldIDs {
+		if !newSet[id] {
+			changes = append(changes, change{Kind: removed, RefID: id})
+		}
+	}
+	return changes
+}
+
+// recordActivity adds changes of a task made by an actor to its activity
+// stream. Like the Kafka events, a failure is only logged and never fails the
+// change itself.
+func (s *TaskService) recordActivity(ctx context.Context, t *ent.Task, actor Actor, changes ...change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	builders := make([]*ent.TaskActivityCreate, 0, len(changes))
+	for _, c := range changes {
+		create := s.Client.TaskActivity.Create().
+			SetOrgID(t.OrgID).
+			SetTaskID(t.ID).
+			SetKind(c.Kind)
+		if t.ProjectID != 0 {
+			create.SetProjectID(t.ProjectID)
+		}
+		if actor.UserID != 0 {
+			create.SetActorUserID(actor.UserID)
+		}
+		if actor.EmployeeID != 0 {
+			create.SetActorEmployeeID(actor.EmployeeID)
+		}
+		if c.Field != "" {
+			create.SetFieldName(c.Field)
+		}
+		if c.OldValue != "" {
+			create.SetOldValue(c.OldValue)
+		}
+		if c.NewValue != "" {
+			create.SetNewValue(c.NewValue)
+		}
+		if c.RefID != 0 {
+			create.SetRefID(c.RefID)
+		}
+		builders = append(builders, create)
+	}
+
+	if err := s.Client.TaskActivity.CreateBulk(builders...).Exec(ctx); err != nil {
+		log.Printf("Failed to record activity of task %d: %v", t.ID, err)
+	}
+}
+
+// RecordReportActivity adds a task report event to the activity stream of its
+// task. Reports are written and reviewed by employees.
+func (s *TaskService) RecordReportActivity(ctx context.Context, taskID, reportID, employeeID int, kind taskactivity.Kind, status string) {
+	t, err := s.Client.Task.Get(ctx, taskID)
+	if err != nil {
+		log.Printf("Failed to record activity of task %d: %v", taskID, err)
+		return
+	}
+	s.recordActivity(ctx, t, Actor{EmployeeID: employeeID, OrgID: t.OrgID}, change{
+		Kind:     kind,
+		NewValue: status,
+		RefID:    reportID,
+	})
+}
+
+// ListActivity returns the activity stream of a task, newest first
+func (s *TaskService) ListActivity(ctx context.Context, orgID, taskID int, query dtos.TaskActivityListQuery) (*dtos.TaskActivityListResponse, error) {
+	if _, err := s.getTask(ctx, orgID, taskID); err != nil {
+		return nil, err
+	}
+	return s.activityPage(ctx, s.Client.TaskActivity.Query().
+		Where(taskactivity.OrgID(orgID), taskactivity.TaskID(taskID)), query)
+}
+
+// ProjectActivity returns the activity of the tasks of a project, newest
+// first. Tasks moved out of the project keep their earlier entries here.
+func (s *TaskService) ProjectActivity(ctx context.Context, orgID, projectID int, query dtos.TaskActivityListQuery) (*dtos.TaskActivityListResponse, error) {
+	exists, err := s.Client.Project.Query().
+		Where(project.ID(projectID), project.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Project not found",
+		}
+	}
+	return s.activityPage(ctx, s.Client.TaskActivity.Query().
+		Where(taskactivity.OrgID(orgID), taskactivity.ProjectID(projectID)), query)
+}
+
+// activityPage returns a page of activity entries, newest first
+func (s *TaskService) activityPage(ctx context.Context, activityQuery *ent.TaskActivityQuery, query dtos.TaskActivityListQuery) (*dtos.TaskActivityListResponse, error) {
+	limit := query.Limit
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	if query.Kind != "" {
+		if err := taskactivity.KindValidator(taskactivity.Kind(query.Kind)); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid kind value",
+			}
+		}
+		activityQuery = activityQuery.Where(taskactivity.KindEQ(taskactivity.Kind(query.Kind)))
+	}
+	if query.Cursor != "" {
+		cursorData, err := s.decodeCursor(query.Cursor)
+		lastID, ok := cursorData["last_id"].(float64)
+		if err != nil || !ok {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid cursor format",
+			}
+		}
+		activityQuery = activityQuery.Where(taskactivity.IDLT(int(lastID)))
+	}
+
+	// Fetch limit + 1 to check if there are more items
+	rows, err := activityQuery.
+		Order(ent.Desc(taskactivity.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task activity",
+		}
+	}
+
+	hasNext := len(rows) > limit
+	if hasNext {
+		rows = rows[:limit]
+	}
+	var nextCursor *string
+	if hasNext && len(rows) > 0 {
+		cursor := s.encodeCursor(map[string]interface{}{
+			"last_id": rows[len(rows)-1].ID,
+		})
+		nextCursor = &cursor
+	}
+
+	return &dtos.TaskActivityListResponse{
+		Data: rows,
+		Pagination: dtos.CursorPagination{
+			Type:       "cursor",
+			PerPage:    limit,
+			HasNext:    hasNext,
+			NextCursor: nextCursor,
+		},
+	}, nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+func formatInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
@@ -0,0 +1,403 @@
+package task
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
+)
+
+// Board returns the tasks of a project grouped by workflow status, each
+// column in rank order, optionally split into swimlanes
+func (s *TaskService) Board(ctx context.Context, projectID, orgID int, query dtos.BoardQuery) (*dtos.BoardResponse, error) {
+	exists, err := s.Client.Project.Query().
+		Where(project.ID(projectID), project.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Project not found",
+		}
+	}
+
+	def, err := s.workflowOf(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.Client.Task.Query().
+		Where(task.ProjectID(projectID)).
+		WithAssignees(func(q *ent.EmployeeQuery) {
+			q.Select(employee.FieldID, employee.FieldCode)
+		}).
+		WithLabels().
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project tasks",
+		}
+	}
+
+	columnTasks := make(map[string][]*ent.Task, len(def.Statuses))
+	for _, t := range tasks {
+		key := def.Remap(workflow.StatusKey(t), string(t.Status)).Key
+		columnTasks[key] = append(columnTasks[key], t)
+	}
+
+	response := &dtos.BoardResponse{
+		ProjectID: projectID,
+		Swimlane:  query.Swimlane,
+		Columns:   make([]dtos.BoardColumn, len(def.Statuses)),
+	}
+	for i, st := range def.Statuses {
+		tasks := columnTasks[st.Key]
+		sortByRank(tasks)
+
+		column := dtos.BoardColumn{
+			Key:       st.Key,
+			Name:      st.Name,
+			Category:  st.Category,
+			WIPLimit:  st.WIPLimit,
+			Count:     len(tasks),
+			OverLimit: st.WIPLimit != nil && len(tasks) > *st.WIPLimit,
+			Tasks:     make([]dtos.BoardTask, len(tasks)),
+		}
+		for j, t := range tasks {
+			column.Tasks[j] = boardTask(t)
+		}
+		response.Columns[i] = column
+	}
+
+	if query.Swimlane != "" {
+		response.Lanes = boardLanes(def, columnTasks, query.Swimlane)
+	}
+	return response, nil
+}
+
+// boardTask converts a task to a board card
+func boardTask(t *ent.Task) dtos.BoardTask {
+	card := dtos.BoardTask{
+		ID:             t.ID,
+		Code:           t.Code,
+		Name:           t.Name,
+		Type:           string(t.Type),
+		Status:         string(t.Status),
+		WorkflowStatus: t.WorkflowStatus,
+		Rank:           t.Rank,
+		Process:        t.Process,
+		ParentID:       t.ParentID,
+		AssigneeIDs:    make([]int, len(t.Edges.Assignees)),
+		Labels:         make([]dtos.BoardLabel, len(t.Edges.Labels)),
+	}
+	if t.DueDate != nil {
+		card.DueDate = t.DueDate.Format(time.RFC3339)
+	}
+	for i, a := range t.Edges.Assignees {
+		card.AssigneeIDs[i] = a.ID
+	}
+	for i, l := range t.Edges.Labels {
+		card.Labels[i] = dtos.BoardLabel{
+			ID:    l.ID,
+			Name:  l.Name,
+			Color: l.Color,
+		}
+	}
+	return card
+}
+
+// boardLanes splits ranked columns into swimlanes by assignee or label. Lanes
+// are ordered by ID with the lane of tasks without one last.
+func boardLanes(def *workflow.Definition, columnTasks map[string][]*ent.Task, swimlane string) []dtos.BoardLane {
+	lanes := make(map[int]*dtos.BoardLane)
+	lane := func(id int, name string) *dtos.BoardLane {
+		l, ok := lanes[id]
+		if !ok {
+			l = &dtos.BoardLane{
+				ID:      id,
+				Name:    name,
+				Columns: make([]dtos.BoardLaneColumn, len(def.Statuses)),
+			}
+			for i, st := range def.Statuses {
+				l.Columns[i] = dtos.BoardLaneColumn{Key: st.Key, TaskIDs: []int{}}
+			}
+			lanes[id] = l
+		}
+		return l
+	}
+
+	for i, st := range def.Statuses {
+		for _, t := range columnTasks[st.Key] {
+			var members []*dtos.BoardLane
+			if swimlane == "label" {
+				for _, l := range t.Edges.Labels {
+					members = append(members, lane(l.ID, l.Name))
+				}
+				if len(members) == 0 {
+					members = append(members, lane(0, "No label"))
+				}
+			} else {
+				for _, a := range t.Edges.Assignees {
+					members = append(members, lane(a.ID, a.Code))
+				}
+				if len(members) == 0 {
+					members = append(members, lane(0, "Unassigned"))
+				}
+			}
+			for _, l := range members {
+				l.Columns[i].TaskIDs = append(l.Columns[i].TaskIDs, t.ID)
+			}
+		}
+	}
+
+	result := make([]dtos.BoardLane, 0, len(lanes))
+	for _, l := range lanes {
+		result = append(result, *l)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if (result[i].ID == 0) != (result[j].ID == 0) {
+			return result[j].ID == 0
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+// Move places a task in a board column, changing its status and rank in one
+// transaction. The status change goes through the project workflow like any
+// other, including its guards and WIP limits.
+func (s *TaskService) Move(ctx context.Context, taskID int, actor Actor, input dtos.BoardMoveInput) (*ent.Task, error) {
+	taskEntity, err := s.getTask(ctx, actor.OrgID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if taskEntity.ProjectID == 0 {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Only tasks of a project can be moved on a board",
+		}
+	}
+
+	def, err := s.workflowOf(ctx, taskEntity.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	target := def.Remap(workflow.StatusKey(taskEntity), string(taskEntity.Status))
+	if input.Status != "" {
+		target, err = resolveStatus(def, input.Status)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkTransition(ctx, def, taskEntity, target, actor); err != nil {
+		return nil, err
+	}
+
+	column, err := s.Client.Task.Query().
+		Where(task.ProjectID(taskEntity.ProjectID)).
+		Where(inColumn(target)).
+		Where(task.IDNEQ(taskID)).
+		Select(task.FieldID, task.FieldRank).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch board column",
+		}
+	}
+	sortByRank(column)
+
+	pos, err := movePosition(column, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unranked tasks sort last, so only an unranked predecessor forces the
+	// column to be re-ranked
+	var rank string
+	rerank := pos > 0 && column[pos-1].Rank == ""
+	if !rerank {
+		prev, next := "", ""
+		if pos > 0 {
+			prev = column[pos-1].Rank
+		}
+		if pos < len(column) {
+			next = column[pos].Rank
+		}
+		var ok bool
+		rank, ok = rankBetween(prev, next)
+		rerank = !ok || len(rank) > maxRankLength
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if rerank {
+		ranks := spreadRanks(len(column) + 1)
+		rank = ranks[pos]
+		for i, t := range column {
+			r := ranks[i]
+			if i >= pos {
+				r = ranks[i+1]
+			}
+			if r == t.Rank {
+				continue
+			}
+			if err := tx.Task.Update().
+				Where(task.ID(t.ID)).
+				SetRank(r).
+				Exec(ctx); err != nil {
+				tx.Rollback()
+				return nil, &ServiceError{
+					Status: http.StatusInternalServerError,
+					Msg:    "Failed to rank board column",
+				}
+			}
+		}
+	}
+
+	updatedTask, err := setStatus(tx.Task.UpdateOneID(taskID), taskEntity, target).
+		SetRank(rank).
+		SetUpdaterID(actor.UserID).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to move task",
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	s.afterChange(ctx, updatedTask, actor, taskChanges(taskEntity, updatedTask)...)
+	s.RollUpProjects(ctx, updatedTask.ProjectID)
+	return updatedTask, nil
+}
+
+// movePosition finds where a moved task goes in a column that excludes it
+func movePosition(column []*ent.Task, input dtos.BoardMoveInput) (int, error) {
+	indexOf := func(id int) int {
+		for i, t := range column {
+			if t.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	pos := len(column)
+	if input.PrevID != nil {
+		i := indexOf(*input.PrevID)
+		if i < 0 {
+			return 0, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Task " + strconv.Itoa(*input.PrevID) + " is not in the target column",
+			}
+		}
+		pos = i + 1
+	}
+	if input.NextID != nil {
+		i := indexOf(*input.NextID)
+		if i < 0 {
+			return 0, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Task " + strconv.Itoa(*input.NextID) + " is not in the target column",
+			}
+		}
+		if input.PrevID != nil && i != pos {
+			return 0, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "The board has changed, reload it and try again",
+			}
+		}
+		pos = i
+	}
+	return pos, nil
+}
+
+// inColumn matches the tasks in a workflow status. Tasks created before
+// workflows existed only have a built-in status, which is also the key of
+// the matching default status.
+func inColumn(st *workflow.Status) predicate.Task {
+	if st.Key != st.SystemStatus {
+		return task.WorkflowStatus(st.Key)
+	}
+	return task.Or(
+		task.WorkflowStatus(st.Key),
+		task.And(task.WorkflowStatus(""), task.StatusEQ(task.Status(st.SystemStatus))),
+	)
+}
+
+// checkWIPLimit refuses to move a task into a full board column
+func (s *TaskService) checkWIPLimit(ctx context.Context, projectID int, st *workflow.Status) error {
+	if projectID == 0 || st.WIPLimit == nil {
+		return nil
+	}
+	count, err := s.Client.Task.Query().
+		Where(task.ProjectID(projectID)).
+		Where(inColumn(st)).
+		Count(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check the WIP limit",
+		}
+	}
+	if count >= *st.WIPLimit {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "The WIP limit of " + strconv.Itoa(*st.WIPLimit) + " tasks for '" + st.Name + "' has been reached",
+		}
+	}
+	return nil
+}
+
+// nextRank returns a rank placing a task at the end of a board column
+func (s *TaskService) nextRank(ctx context.Context, projectID int, st *workflow.Status) (string, error) {
+	last, err := s.Client.Task.Query().
+		Where(task.ProjectID(projectID)).
+		Where(inColumn(st)).
+		Where(task.RankNEQ("")).
+		Order(ent.Desc(task.FieldRank)).
+		Select(task.FieldRank).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", err
+	}
+	prev := ""
+	if last != nil {
+		prev = last.Rank
+	}
+	rank, _ := rankBetween(prev, "")
+	return rank, nil
+}
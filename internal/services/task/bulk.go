@@ -0,0 +1,540 @@
+package task
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/label"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	projectService "github.com/longgggwwww/hrm-ms-hr/internal/services/project"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
+)
+
+// maxBulkTasks limits how many tasks a bulk update can change
+const maxBulkTasks = 100
+
+// Results of a bulk update for one task
+const (
+	bulkUpdated   = "updated"
+	bulkUnchanged = "unchanged"
+	bulkFailed    = "failed"
+)
+
+// BulkUpdate applies the same change to many tasks of an organization. The
+// tasks are listed by ID or selected by the filters of the task list. Every
+// task goes through the checks of Update in one transaction, so the workflow
+// and WIP limits see the earlier tasks of the batch; when any task fails or
+// in dry-run mode the transaction is rolled back. Moved tasks take their
+// subtasks along. One update event is published per changed task.
+func (s *TaskService) BulkUpdate(ctx context.Context, actor Actor, input dtos.TaskBulkUpdateInput, filter dtos.TaskListQuery) (*dtos.TaskBulkUpdateResponse, error) {
+	if len(input.AddAssigneeIDs) == 0 && len(input.RemoveAssigneeIDs) == 0 &&
+		len(input.AddLabelIDs) == 0 && len(input.RemoveLabelIDs) == 0 &&
+		input.ProjectID == nil && input.DueDate == nil && input.Status == nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "No changes provided",
+		}
+	}
+	if overlaps(input.AddAssigneeIDs, input.RemoveAssigneeIDs) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "An assignee cannot be both added and removed",
+		}
+	}
+	if overlaps(input.AddLabelIDs, input.RemoveLabelIDs) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "A label cannot be both added and removed",
+		}
+	}
+
+	// An empty due date clears it
+	var dueDate *time.Time
+	if input.DueDate != nil && *input.DueDate != "" {
+		parsed, err := time.Parse(time.RFC3339, *input.DueDate)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid due_date format, must be RFC3339",
+			}
+		}
+		dueDate = &parsed
+	}
+
+	ids, err := s.bulkTargets(ctx, actor.OrgID, input.IDs, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBulkReferences(ctx, actor, input); err != nil {
+		return nil, err
+	}
+
+	response := &dtos.TaskBulkUpdateResponse{
+		DryRun:       input.DryRun,
+		MatchedCount: len(ids),
+		Results:      []dtos.TaskBulkUpdateResult{},
+	}
+	if len(ids) == 0 {
+		return response, nil
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	txService := &TaskService{Client: tx.Client()}
+
+	rows, err := tx.Task.Query().
+		Where(task.IDIn(ids...), task.OrgID(actor.OrgID)).
+		WithAssignees(func(q *ent.EmployeeQuery) { q.Select(employee.FieldID) }).
+		WithLabels(func(q *ent.LabelQuery) { q.Select(label.FieldID) }).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch tasks",
+		}
+	}
+	found := make(map[int]*ent.Task, len(rows))
+	for _, row := range rows {
+		found[row.ID] = row
+	}
+
+	// Subtasks move with their parent, so when moving, tasks below another
+	// task of the batch come after it and are read again once it moved them
+	order := ids
+	below := make(map[int]bool)
+	if input.ProjectID != nil {
+		if below, err = txService.belowOthers(ctx, found); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		order = make([]int, 0, len(ids))
+		for _, id := range ids {
+			if !below[id] {
+				order = append(order, id)
+			}
+		}
+		for _, id := range ids {
+			if below[id] {
+				order = append(order, id)
+			}
+		}
+	}
+
+	var changed []*ent.Task
+	var movedIDs []int
+	results := make(map[int]dtos.TaskBulkUpdateResult, len(ids))
+	for _, id := range order {
+		current, ok := found[id]
+		if !ok {
+			results[id] = dtos.TaskBulkUpdateResult{
+				ID:     id,
+				Result: bulkFailed,
+				Error:  "Task not found",
+			}
+			response.FailedCount++
+			continue
+		}
+
+		result := dtos.TaskBulkUpdateResult{ID: id, Code: current.Code, Result: bulkUnchanged}
+		carried := false
+		if below[id] {
+			current, err = tx.Task.Query().
+				Where(task.ID(id)).
+				WithAssignees(func(q *ent.EmployeeQuery) { q.Select(employee.FieldID) }).
+				WithLabels(func(q *ent.LabelQuery) { q.Select(label.FieldID) }).
+				Only(ctx)
+			if err != nil {
+				tx.Rollback()
+				return nil, &ServiceError{
+					Status: http.StatusInternalServerError,
+					Msg:    "Failed to fetch tasks",
+				}
+			}
+			carried = current.ProjectID != found[id].ProjectID
+		}
+		updated, moved, err := txService.bulkApply(ctx, current, actor, input, dueDate)
+		switch {
+		case err != nil:
+			result.Result = bulkFailed
+			result.Error = "Failed to update task"
+			if serviceErr, ok := err.(*ServiceError); ok {
+				result.Error = serviceErr.Msg
+			}
+			response.FailedCount++
+		case updated || carried:
+			result.Result = bulkUpdated
+			response.UpdatedCount++
+			changed = append(changed, found[id])
+		default:
+			response.UnchangedCount++
+		}
+		movedIDs = append(movedIDs, moved...)
+		results[id] = result
+	}
+	for _, id := range ids {
+		response.Results = append(response.Results, results[id])
+	}
+
+	if input.DryRun || response.FailedCount > 0 {
+		tx.Rollback()
+		return response, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	response.Applied = true
+
+	// Status changes move the progress of parents that have subtasks
+	if input.Status != nil || input.ProjectID != nil {
+		parents := make(map[int]bool)
+		for _, t := range changed {
+			if t.ParentID != 0 && !parents[t.ParentID] {
+				parents[t.ParentID] = true
+				if err := s.RollUpProgress(ctx, t.ParentID); err != nil {
+					log.Printf("Failed to roll up progress for task %d: %v", t.ParentID, err)
+				}
+			}
+		}
+
+		// and the progress of the projects they left or joined
+		projectIDs := make([]int, 0, len(changed)+1)
+		for _, t := range changed {
+			projectIDs = append(projectIDs, t.ProjectID)
+		}
+		if input.ProjectID != nil {
+			projectIDs = append(projectIDs, *input.ProjectID)
+		}
+		s.RollUpProjects(ctx, projectIDs...)
+	}
+
+	// One update event per changed task and per subtask moved along
+	changedIDs := make([]int, 0, len(changed)+len(movedIDs))
+	for _, t := range changed {
+		changedIDs = append(changedIDs, t.ID)
+	}
+	s.publishUpdated(ctx, uniqueIDs(append(changedIDs, movedIDs...)), actor.UserID)
+
+	return response, nil
+}
+
+// bulkTargets returns the IDs of the tasks a bulk update applies to, either the
+// listed IDs or those of the tasks matching a filter, in ID order
+func (s *TaskService) bulkTargets(ctx context.Context, orgID int, ids []int, filter dtos.TaskListQuery) ([]int, error) {
+	// Paging and sorting do not select tasks
+	filter.Page, filter.Limit = 0, 0
+	filter.Cursor, filter.CursorLimit, filter.PaginationType = "", 0, ""
+	filter.OrderBy, filter.OrderDir = "", ""
+	hasFilter := filter != dtos.TaskListQuery{EmployeeID: filter.EmployeeID}
+
+	if len(ids) > 0 {
+		if hasFilter {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Use either ids or task filters, not both",
+			}
+		}
+		if len(ids) > maxBulkTasks {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Maximum " + strconv.Itoa(maxBulkTasks) + " IDs allowed per bulk update",
+			}
+		}
+		return uniqueIDs(ids), nil
+	}
+
+	// Without IDs a filter is required, so a bulk update never reaches every
+	// task of the organization by accident
+	if !hasFilter {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Provide task ids or at least one task filter",
+		}
+	}
+	taskQuery, err := s.filterQuery(orgID, filter)
+	if err != nil {
+		return nil, err
+	}
+	matched, err := taskQuery.
+		Order(ent.Asc(task.FieldID)).
+		Limit(maxBulkTasks + 1).
+		IDs(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch tasks",
+		}
+	}
+	if len(matched) > maxBulkTasks {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "The filter matches more than " + strconv.Itoa(maxBulkTasks) + " tasks; narrow it down",
+		}
+	}
+	return matched, nil
+}
+
+// checkBulkReferences checks what a bulk update refers to: like Create, tasks
+// can only be moved to a project the actor's role lets them create tasks in,
+// and added assignees and labels must belong to the organization. The
+// projects tasks leave are checked per task by bulkApply.
+func (s *TaskService) checkBulkReferences(ctx context.Context, actor Actor, input dtos.TaskBulkUpdateInput) error {
+	if input.ProjectID != nil {
+		if err := s.authorizeProject(ctx, *input.ProjectID, actor, projectService.ActionCreateTask, "Your project role does not allow moving tasks into this project"); err != nil {
+			return err
+		}
+	}
+
+	if assigneeIDs := uniqueIDs(input.AddAssigneeIDs); len(assigneeIDs) > 0 {
+		count, err := s.Client.Employee.Query().
+			Where(employee.IDIn(assigneeIDs...), employee.OrgID(actor.OrgID)).
+			Count(ctx)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to validate assignee IDs",
+			}
+		}
+		if count != len(assigneeIDs) {
+			return &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Some assignee IDs do not exist",
+			}
+		}
+	}
+
+	if labelIDs := uniqueIDs(input.AddLabelIDs); len(labelIDs) > 0 {
+		count, err := s.Client.Label.Query().
+			Where(label.IDIn(labelIDs...), label.OrgID(actor.OrgID)).
+			Count(ctx)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to validate label IDs",
+			}
+		}
+		if count != len(labelIDs) {
+			return &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Some label IDs do not exist",
+			}
+		}
+	}
+	return nil
+}
+
+// bulkApply applies a bulk change to one task, with its assignees and labels
+// loaded, and reports whether anything changed and which subtasks moved with it
+func (s *TaskService) bulkApply(ctx context.Context, current *ent.Task, actor Actor, input dtos.TaskBulkUpdateInput, dueDate *time.Time) (bool, []int, error) {
+	taskUpdate := s.Client.Task.UpdateOneID(current.ID).SetUpdaterID(actor.UserID)
+	changed := false
+
+	assigned := make(map[int]bool)
+	for _, emp := range current.Edges.Assignees {
+		assigned[emp.ID] = true
+	}
+	added := missing(input.AddAssigneeIDs, assigned)
+	removed := present(input.RemoveAssigneeIDs, assigned)
+	if len(added) > 0 || len(removed) > 0 {
+		projectID := current.ProjectID
+		if input.ProjectID != nil {
+			projectID = *input.ProjectID
+		}
+		// Only the changed assignees matter to the actor's project role
+		if err := s.authorizeAssign(ctx, projectID, actor, removed, added); err != nil {
+			return false, nil, err
+		}
+	}
+	if len(added) > 0 {
+		taskUpdate.AddAssigneeIDs(added...)
+		changed = true
+	}
+	if len(removed) > 0 {
+		taskUpdate.RemoveAssigneeIDs(removed...)
+		changed = true
+	}
+
+	labelled := make(map[int]bool)
+	for _, lbl := range current.Edges.Labels {
+		labelled[lbl.ID] = true
+	}
+	if ids := missing(input.AddLabelIDs, labelled); len(ids) > 0 {
+		taskUpdate.AddLabelIDs(ids...)
+		changed = true
+	}
+	if ids := present(input.RemoveLabelIDs, labelled); len(ids) > 0 {
+		taskUpdate.RemoveLabelIDs(ids...)
+		changed = true
+	}
+
+	if input.DueDate != nil {
+		switch {
+		case dueDate == nil && current.DueDate != nil:
+			taskUpdate.ClearDueDate()
+			changed = true
+		case dueDate != nil && (current.DueDate == nil || !current.DueDate.Equal(*dueDate)):
+			taskUpdate.SetDueDate(*dueDate)
+			changed = true
+		}
+	}
+
+	// Moving to another project keeps the closest status of its workflow, as
+	// in Update, and a subtask stays in the project of its parent
+	targetProjectID := current.ProjectID
+	if input.ProjectID != nil {
+		targetProjectID = *input.ProjectID
+	}
+	moved := targetProjectID != current.ProjectID
+	var subtasks []*ent.Task
+	if moved {
+		var err error
+		if subtasks, err = s.subtasksOf(ctx, current.ID); err != nil {
+			return false, nil, err
+		}
+		if err := s.authorizeMoveOut(ctx, actor, targetProjectID, append(subtasks, current)...); err != nil {
+			return false, nil, err
+		}
+		if current.ParentID != 0 {
+			parent, err := s.Client.Task.Query().
+				Where(task.ID(current.ParentID)).
+				Select(task.FieldProjectID).
+				Only(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return false, nil, &ServiceError{
+					Status: http.StatusInternalServerError,
+					Msg:    "Failed to fetch parent task",
+				}
+			}
+			if parent != nil && parent.ProjectID != 0 && parent.ProjectID != targetProjectID {
+				return false, nil, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "A subtask must belong to the same project as its parent",
+				}
+			}
+		}
+		taskUpdate.SetProjectID(targetProjectID).
+			SetRank("").
+			ClearSprintID().
+			ClearMilestoneID()
+		changed = true
+	}
+	if input.Status != nil || moved {
+		def, err := s.workflowOf(ctx, targetProjectID)
+		if err != nil {
+			return false, nil, err
+		}
+		next := def.Remap(workflow.StatusKey(current), string(current.Status))
+		if input.Status != nil {
+			next, err = resolveStatus(def, *input.Status)
+			if err != nil {
+				return false, nil, err
+			}
+			if err := s.checkTransition(ctx, def, current, next, actor); err != nil {
+				return false, nil, err
+			}
+		}
+		if moved || next.Key != workflow.StatusKey(current) || next.SystemStatus != string(current.Status) {
+			setStatus(taskUpdate, current, next)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return false, nil, nil
+	}
+	if err := taskUpdate.Exec(ctx); err != nil {
+		return false, nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update task",
+		}
+	}
+
+	// Recorded in the transaction, so a dry run leaves no activity behind
+	updated, err := s.Client.Task.Query().
+		Where(task.ID(current.ID)).
+		WithAssignees(func(q *ent.EmployeeQuery) { q.Select(employee.FieldID) }).
+		WithLabels(func(q *ent.LabelQuery) { q.Select(label.FieldID) }).
+		Only(ctx)
+	if err != nil {
+		return false, nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task",
+		}
+	}
+	s.afterChange(ctx, updated, actor, taskChanges(current, updated)...)
+
+	movedIDs, err := s.moveSubtasks(ctx, subtasks, targetProjectID, actor)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, movedIDs, nil
+}
+
+// uniqueIDs returns IDs without duplicates, in their first order
+func uniqueIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	var unique []int
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// missing returns the IDs that are not in a set
+func missing(ids []int, set map[int]bool) []int {
+	var out []int
+	for _, id := range uniqueIDs(ids) {
+		if !set[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// present returns the IDs that are in a set
+func present(ids []int, set map[int]bool) []int {
+	var out []int
+	for _, id := range uniqueIDs(ids) {
+		if set[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// overlaps reports whether two ID lists share an ID
+func overlaps(a, b []int) bool {
+	set := make(map[int]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if set[id] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package task
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// maxCodeAttempts bounds how many generated codes a save tries when
+// concurrent saves keep taking them
+const maxCodeAttempts = 3
+
+// nextCode allocates the code of a new task: the project key and the next
+// number of the project, e.g. HRM-12, or #7 for the next number of the
+// organization when the task is outside projects or the project has no key.
+//
+// Counters are incremented in place by one UPDATE, which locks the counter
+// row until it commits, so concurrent creates never get the same number.
+// Numbers already taken by a custom code are skipped.
+func (s *TaskService) nextCode(ctx context.Context, orgID, projectID int) (string, error) {
+	prefix := "#"
+	if projectID != 0 {
+		p, err := s.Client.Project.Get(ctx, projectID)
+		if err != nil {
+			return "", err
+		}
+		if p.Key != nil && *p.Key != "" {
+			prefix = *p.Key + "-"
+		} else {
+			projectID = 0
+		}
+	}
+
+	for {
+		var seq int
+		if projectID != 0 {
+			p, err := s.Client.Project.UpdateOneID(projectID).AddTaskSeq(1).Save(ctx)
+			if err != nil {
+				return "", err
+			}
+			seq = p.TaskSeq
+		} else {
+			org, err := s.Client.Organization.UpdateOneID(orgID).AddTaskSeq(1).Save(ctx)
+			if err != nil {
+				return "", err
+			}
+			seq = org.TaskSeq
+		}
+
+		code := prefix + strconv.Itoa(seq)
+		taken, err := s.codeTaken(ctx, orgID, code, 0)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return code, nil
+		}
+	}
+}
+
+// codeTaken reports whether another task of the organization has a code
+func (s *TaskService) codeTaken(ctx context.Context, orgID int, code string, excludeID int) (bool, error) {
+	return s.Client.Task.Query().
+		Where(task.OrgID(orgID)).
+		Where(task.CodeEQ(code)).
+		Where(task.IDNEQ(excludeID)).
+		Exist(ctx)
+}
+
+// codeConflict reports whether a save failed because another task of the
+// organization took the code in the meantime. Codes are checked before the
+// save, so two concurrent saves of one code both pass the check and the
+// unique index rejects the second.
+func (s *TaskService) codeConflict(ctx context.Context, err error, orgID int, code string, excludeID int) bool {
+	if !ent.IsConstraintError(err) {
+		return false
+	}
+	taken, err := s.codeTaken(ctx, orgID, code, excludeID)
+	return err == nil && taken
+}
@@ -0,0 +1,456 @@
+package task
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcomment"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskcommentrevision"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/kafka"
+)
+
+// mentionPattern matches @code mentions, where code is an employee code
+var mentionPattern = regexp.MustCompile(`@([\p{L}\p{N}_.\-]+)`)
+
+// CreateComment adds a comment to a task. A reply to a reply joins the
+// thread of the comment it answers, so threads are a single level deep.
+func (s *TaskService) CreateComment(ctx context.Context, orgID, taskID, employeeID int, input dtos.TaskCommentCreateInput) (*ent.TaskComment, error) {
+	taskEntity, err := s.getTask(ctx, orgID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := s.Client.Employee.Get(ctx, employeeID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Employee not found",
+		}
+	}
+
+	var parentID *int
+	if input.ParentID != nil {
+		parent, err := s.Client.TaskComment.Query().
+			Where(taskcomment.ID(*input.ParentID)).
+			Where(taskcomment.TaskID(taskID)).
+			Where(taskcomment.DeletedAtIsNil()).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "Parent comment not found",
+				}
+			}
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to fetch parent comment",
+			}
+		}
+		root := parent.ID
+		if parent.ParentID != 0 {
+			root = parent.ParentID
+		}
+		parentID = &root
+	}
+
+	mentionIDs, err := s.resolveMentions(ctx, author.OrgID, input.Content)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to resolve mentions",
+		}
+	}
+
+	row, err := s.Client.TaskComment.Create().
+		SetTaskID(taskID).
+		SetAuthorID(employeeID).
+		SetNillableParentID(parentID).
+		SetContent(input.Content).
+		AddMentionIDs(mentionIDs...).
+		Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create comment",
+		}
+	}
+
+	s.recordActivity(ctx, taskEntity, Actor{EmployeeID: employeeID, OrgID: orgID}, change{
+		Kind:  taskactivity.KindCommentAdded,
+		RefID: row.ID,
+	})
+	s.watch(ctx, taskID, employeeID)
+	s.notifyMentions(ctx, taskEntity, row, employeeID, withoutID(mentionIDs, employeeID))
+	s.publishCommentEvent(ctx, kafka.CommentCreated, taskEntity, row, nil, author.OrgID)
+	s.publishCommentEvent(ctx, kafka.CommentMentioned, taskEntity, row, withoutID(mentionIDs, employeeID), author.OrgID)
+
+	return s.getComment(ctx, row.ID)
+}
+
+// ListComments returns the comment threads of a task, oldest first. Deleted
+// comments are hidden, except thread starters that still have replies, which
+// are returned with their content blanked.
+func (s *TaskService) ListComments(ctx context.Context, orgID, taskID int, query dtos.TaskCommentListQuery) (*dtos.TaskCommentListResponse, error) {
+	if _, err := s.getTask(ctx, orgID, taskID); err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	commentQuery := s.Client.TaskComment.Query().
+		Where(taskcomment.TaskID(taskID)).
+		Where(taskcomment.ParentIDIsNil()).
+		Where(taskcomment.Or(
+			taskcomment.DeletedAtIsNil(),
+			taskcomment.HasRepliesWith(taskcomment.DeletedAtIsNil()),
+		)).
+		WithAuthor().
+		WithMentions().
+		WithReplies(func(q *ent.TaskCommentQuery) {
+			q.Where(taskcomment.DeletedAtIsNil()).
+				WithAuthor().
+				WithMentions().
+				Order(ent.Asc(taskcomment.FieldID))
+		}).
+		Order(ent.Asc(taskcomment.FieldID))
+
+	if query.Cursor != "" {
+		cursorData, err := s.decodeCursor(query.Cursor)
+		lastID, ok := cursorData["last_id"].(float64)
+		if err != nil || !ok {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid cursor format",
+			}
+		}
+		commentQuery = commentQuery.Where(taskcomment.IDGT(int(lastID)))
+	}
+
+	// Fetch limit + 1 to check if there are more items
+	comments, err := commentQuery.Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch comments",
+		}
+	}
+
+	hasNext := len(comments) > limit
+	if hasNext {
+		comments = comments[:limit]
+	}
+
+	for _, c := range comments {
+		if c.DeletedAt != nil {
+			c.Content = ""
+			c.Edges.Mentions = nil
+		}
+	}
+
+	var nextCursor *string
+	if hasNext && len(comments) > 0 {
+		cursor := s.encodeCursor(map[string]interface{}{
+			"last_id": comments[len(comments)-1].ID,
+		})
+		nextCursor = &cursor
+	}
+
+	return &dtos.TaskCommentListResponse{
+		Data: comments,
+		Pagination: dtos.CursorPagination{
+			Type:       "cursor",
+			PerPage:    limit,
+			HasNext:    hasNext,
+			NextCursor: nextCursor,
+		},
+	}, nil
+}
+
+// UpdateComment edits the content of a comment. Only the author can edit and
+// the previous content is kept as a revision.
+func (s *TaskService) UpdateComment(ctx context.Context, orgID, taskID, commentID, employeeID int, input dtos.TaskCommentUpdateInput) (*ent.TaskComment, error) {
+	comment, err := s.authoredComment(ctx, orgID, taskID, commentID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Content == input.Content {
+		return s.getComment(ctx, comment.ID)
+	}
+
+	author, err := s.Client.Employee.Get(ctx, employeeID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Employee not found",
+		}
+	}
+
+	previous, err := comment.QueryMentions().IDs(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch mentions",
+		}
+	}
+	mentionIDs, err := s.resolveMentions(ctx, author.OrgID, input.Content)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to resolve mentions",
+		}
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if _, err := tx.TaskCommentRevision.Create().
+		SetCommentID(comment.ID).
+		SetContent(comment.Content).
+		SetEditorID(employeeID).
+		Save(ctx); err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to save comment revision",
+		}
+	}
+
+	row, err := tx.TaskComment.UpdateOneID(comment.ID).
+		Where(taskcomment.DeletedAtIsNil()).
+		SetContent(input.Content).
+		SetEditedAt(time.Now()).
+		ClearMentions().
+		AddMentionIDs(mentionIDs...).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Comment not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update comment",
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+
+	// Only employees who were not mentioned before are notified
+	known := make(map[int]bool, len(previous))
+	for _, id := range previous {
+		known[id] = true
+	}
+	var added []int
+	for _, id := range withoutID(mentionIDs, employeeID) {
+		if !known[id] {
+			added = append(added, id)
+		}
+	}
+	if taskEntity, err := s.getTask(ctx, orgID, taskID); err == nil {
+		s.recordActivity(ctx, taskEntity, Actor{EmployeeID: employeeID, OrgID: orgID}, change{
+			Kind:  taskactivity.KindCommentEdited,
+			RefID: row.ID,
+		})
+		s.notifyMentions(ctx, taskEntity, row, employeeID, added)
+		s.publishCommentEvent(ctx, kafka.CommentMentioned, taskEntity, row, added, author.OrgID)
+	}
+
+	return s.getComment(ctx, row.ID)
+}
+
+// DeleteComment soft-deletes a comment. Only the author can delete it.
+func (s *TaskService) DeleteComment(ctx context.Context, orgID, taskID, commentID, employeeID int) error {
+	comment, err := s.authoredComment(ctx, orgID, taskID, commentID, employeeID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Client.TaskComment.UpdateOneID(comment.ID).
+		Where(taskcomment.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Comment not found",
+			}
+		}
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete comment",
+		}
+	}
+	if taskEntity, err := s.getTask(ctx, orgID, taskID); err == nil {
+		s.recordActivity(ctx, taskEntity, Actor{EmployeeID: employeeID, OrgID: orgID}, change{
+			Kind:  taskactivity.KindCommentDeleted,
+			RefID: comment.ID,
+		})
+	}
+	return nil
+}
+
+// ListCommentRevisions returns the edit history of a comment, newest first
+func (s *TaskService) ListCommentRevisions(ctx context.Context, orgID, taskID, commentID int) ([]*ent.TaskCommentRevision, error) {
+	exists, err := s.Client.TaskComment.Query().
+		Where(taskcomment.ID(commentID)).
+		Where(taskcomment.TaskID(taskID)).
+		Where(taskcomment.HasTaskWith(task.OrgID(orgID))).
+		Where(taskcomment.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch comment",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Comment not found",
+		}
+	}
+
+	revisions, err := s.Client.TaskCommentRevision.Query().
+		Where(taskcommentrevision.CommentID(commentID)).
+		Order(ent.Desc(taskcommentrevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch comment revisions",
+		}
+	}
+	return revisions, nil
+}
+
+// authoredComment returns a live comment of the task written by the employee
+func (s *TaskService) authoredComment(ctx context.Context, orgID, taskID, commentID, employeeID int) (*ent.TaskComment, error) {
+	comment, err := s.Client.TaskComment.Query().
+		Where(taskcomment.ID(commentID)).
+		Where(taskcomment.TaskID(taskID)).
+		Where(taskcomment.HasTaskWith(task.OrgID(orgID))).
+		Where(taskcomment.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Comment not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch comment",
+		}
+	}
+	if comment.AuthorID != employeeID {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only the author can change this comment",
+		}
+	}
+	return comment, nil
+}
+
+// getComment fetches a comment with its author and mentions
+func (s *TaskService) getComment(ctx context.Context, id int) (*ent.TaskComment, error) {
+	comment, err := s.Client.TaskComment.Query().
+		Where(taskcomment.ID(id)).
+		WithAuthor().
+		WithMentions().
+		Only(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch comment",
+		}
+	}
+	return comment, nil
+}
+
+// resolveMentions maps the @code mentions in content to employees of the organization
+func (s *TaskService) resolveMentions(ctx context.Context, orgID int, content string) ([]int, error) {
+	var codes []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		// A mention at the end of a sentence carries the full stop with it
+		code := strings.TrimRight(match[1], ".-")
+		if code != "" && !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	return s.Client.Employee.Query().
+		Where(employee.OrgID(orgID)).
+		Where(employee.CodeIn(codes...)).
+		IDs(ctx)
+}
+
+// withoutID returns ids without the given one
+func withoutID(ids []int, id int) []int {
+	var result []int
+	for _, v := range ids {
+		if v != id {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// publishCommentEvent publishes a task comment event to Kafka. Mention events
+// are skipped when nobody new was mentioned.
+func (s *TaskService) publishCommentEvent(ctx context.Context, eventType kafka.CommentEventType, taskEntity *ent.Task, comment *ent.TaskComment, mentionedIDs []int, orgID int) {
+	if s.KafkaClient == nil {
+		return
+	}
+	if eventType == kafka.CommentMentioned && len(mentionedIDs) == 0 {
+		return
+	}
+
+	event := kafka.NewTaskCommentEvent(eventType, taskEntity, comment, mentionedIDs, orgID)
+	key := strconv.Itoa(taskEntity.ID)
+
+	if err := s.KafkaClient.PublishEvent(ctx, "task-events", key, event); err != nil {
+		log.Printf("Failed to publish task comment event: %v", err)
+	}
+}
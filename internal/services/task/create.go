@@ -10,32 +10,42 @@ import (
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
 	"github.com/longgggwwww/hrm-ms-hr/ent/label"
-	"github.com/longgggwwww/hrm-ms-hr/ent/project"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 	"github.com/longgggwwww/hrm-ms-hr/internal/kafka"
+	projectService "github.com/longgggwwww/hrm-ms-hr/internal/services/project"
 )
 
 // Create creates a new task
-func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input dtos.TaskCreateInput) (*ent.Task, error) {
-	// Validate project membership if project_id is provided
-	if input.ProjectID != nil {
-		// Check if the employee is a member of the specified project
-		projectExists, err := s.Client.Project.Query().
-			Where(project.ID(*input.ProjectID)).
-			Where(project.HasMembersWith(employee.ID(employeeID))).
-			Exist(ctx)
+func (s *TaskService) Create(ctx context.Context, actor Actor, input dtos.TaskCreateInput) (*ent.Task, error) {
+	userID, employeeID := actor.UserID, actor.EmployeeID
+
+	// Subtasks live in the same project as their parent
+	if input.ParentID != nil {
+		parent, err := s.validateParent(ctx, actor.OrgID, 0, *input.ParentID)
 		if err != nil {
-			return nil, &ServiceError{
-				Status: http.StatusInternalServerError,
-				Msg:    "Failed to validate project membership",
-			}
+			return nil, err
 		}
-		if !projectExists {
-			return nil, &ServiceError{
-				Status: http.StatusForbidden,
-				Msg:    "You are not a member of this project",
+		if parent.ProjectID != 0 {
+			if input.ProjectID != nil && *input.ProjectID != parent.ProjectID {
+				return nil, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "A subtask must belong to the same project as its parent",
+				}
 			}
+			input.ProjectID = &parent.ProjectID
+		}
+	}
+
+	// The actor's project role must allow creating tasks, and assigning them
+	// to others
+	if input.ProjectID != nil {
+		if err := s.authorizeProject(ctx, *input.ProjectID, actor, projectService.ActionCreateTask, "Your project role does not allow creating tasks"); err != nil {
+			return nil, err
+		}
+		if err := s.authorizeAssign(ctx, *input.ProjectID, actor, nil, input.AssigneeIDs); err != nil {
+			return nil, err
 		}
 	}
 
@@ -84,15 +94,13 @@ func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input
 		typeVal = task.TypeTask
 	}
 
-	// Auto-generate code if not provided (GitHub-style: #x)
+	// Custom codes must be unique in the organization; others are allocated
+	// from the project or organization counter
 	var taskCode string
 	if input.Code != nil && *input.Code != "" {
 		taskCode = *input.Code
 
-		// Check if code already exists globally
-		exists, err := s.Client.Task.Query().
-			Where(task.CodeEQ(taskCode)).
-			Exist(ctx)
+		exists, err := s.codeTaken(ctx, actor.OrgID, taskCode, 0)
 		if err != nil {
 			return nil, &ServiceError{
 				Status: http.StatusInternalServerError,
@@ -101,41 +109,55 @@ func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input
 		}
 		if exists {
 			return nil, &ServiceError{
-				Status: http.StatusBadRequest,
+				Status: http.StatusConflict,
 				Msg:    "Task code already exists",
 			}
 		}
-	} else {
-		// Auto-generate code in GitHub-style format: #x
-		// Get the total count of tasks to generate the next sequence number
-		count, err := s.Client.Task.Query().
-			Count(ctx)
-		if err != nil {
+	}
+
+	if input.RemainingEstimate == nil {
+		input.RemainingEstimate = input.OriginalEstimate
+	}
+
+	// New tasks start in the first status of their project's workflow
+	projectID := 0
+	if input.ProjectID != nil {
+		projectID = *input.ProjectID
+	}
+	def, err := s.workflowOf(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	initial := def.Initial()
+	if input.SprintID != nil {
+		if err := s.checkSprint(ctx, projectID, *input.SprintID); err != nil {
+			return nil, err
+		}
+	}
+	if input.MilestoneID != nil {
+		if err := s.checkMilestone(ctx, projectID, *input.MilestoneID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkWIPLimit(ctx, projectID, initial); err != nil {
+		return nil, err
+	}
+	rank := ""
+	if projectID != 0 {
+		if rank, err = s.nextRank(ctx, projectID, initial); err != nil {
 			return nil, &ServiceError{
 				Status: http.StatusInternalServerError,
-				Msg:    "Failed to generate task code",
+				Msg:    "Failed to rank task",
 			}
 		}
-
-		sequence := count + 1
-		taskCode = "#" + strconv.Itoa(sequence)
-
-		// Double-check uniqueness (in case of concurrent requests)
-		for {
-			exists, err := s.Client.Task.Query().
-				Where(task.CodeEQ(taskCode)).
-				Exist(ctx)
-			if err != nil {
-				return nil, &ServiceError{
-					Status: http.StatusInternalServerError,
-					Msg:    "Failed to validate generated task code",
-				}
-			}
-			if !exists {
-				break
+	}
+	generated := taskCode == ""
+	if generated {
+		if taskCode, err = s.nextCode(ctx, actor.OrgID, projectID); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to generate task code",
 			}
-			sequence++
-			taskCode = "#" + strconv.Itoa(sequence)
 		}
 	}
 
@@ -143,10 +165,21 @@ func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input
 	taskCreate := s.Client.Task.Create().
 		SetName(input.Name).
 		SetCode(taskCode).
+		SetOrgID(actor.OrgID).
 		SetType(typeVal).
+		SetStatus(task.Status(initial.SystemStatus)).
+		SetWorkflowStatus(initial.Key).
+		SetRank(rank).
 		SetNillableStartAt(startAtPtr).
 		SetNillableDueDate(dueDatePtr).
 		SetNillableProjectID(input.ProjectID).
+		SetNillableParentID(input.ParentID).
+		SetNillableSprintID(input.SprintID).
+		SetNillableMilestoneID(input.MilestoneID).
+		SetNillableOriginalEstimate(input.OriginalEstimate).
+		SetNillableRemainingEstimate(input.RemainingEstimate).
+		SetNillableDescription(input.Description).
+		SetNillableRecurrenceRunID(input.RecurrenceRunID).
 		SetCreatorID(userID).
 		SetUpdaterID(userID)
 
@@ -155,6 +188,7 @@ func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input
 		// Check if all label IDs exist in the label table
 		existingLabels, err := s.Client.Label.Query().
 			Where(label.IDIn(input.LabelIDs...)).
+			Where(label.OrgID(actor.OrgID)).
 			Select(label.FieldID).
 			All(ctx)
 		if err != nil {
@@ -193,6 +227,7 @@ func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input
 		// Check if all assignee IDs exist in the employee table
 		existingEmployees, err := s.Client.Employee.Query().
 			Where(employee.IDIn(input.AssigneeIDs...)).
+			Where(employee.OrgID(actor.OrgID)).
 			Select(employee.FieldID).
 			All(ctx)
 		if err != nil {
@@ -227,6 +262,23 @@ func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input
 	}
 
 	row, err := taskCreate.Save(ctx)
+	// A code taken by a concurrent save is a conflict for a custom code and
+	// is allocated again for a generated one
+	for attempt := 1; err != nil && s.codeConflict(ctx, err, actor.OrgID, taskCode, 0); attempt++ {
+		if !generated || attempt == maxCodeAttempts {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Task code already exists",
+			}
+		}
+		if taskCode, err = s.nextCode(ctx, actor.OrgID, projectID); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to generate task code",
+			}
+		}
+		row, err = taskCreate.SetCode(taskCode).Save(ctx)
+	}
 	if err != nil {
 		return nil, &ServiceError{
 			Status: http.StatusInternalServerError,
@@ -234,9 +286,16 @@ func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input
 		}
 	}
 
+	// A new subtask starts at 0%, which lowers the parent's progress
+	if err := s.RollUpProgress(ctx, row.ParentID); err != nil {
+		log.Printf("Failed to roll up progress for task %d: %v", row.ParentID, err)
+	}
+	s.RollUpProjects(ctx, row.ProjectID)
+
 	// Get the created task with all edges
 	createdTask, err := s.Client.Task.Query().
 		Where(task.ID(row.ID)).
+		WithParent().
 		WithProject().
 		WithLabels().
 		WithAssignees().
@@ -248,6 +307,14 @@ func (s *TaskService) Create(ctx context.Context, userID, employeeID int, input
 	}
 
 	// Send Kafka event for task created
+	changes := []change{{
+		Kind:     taskactivity.KindCreated,
+		NewValue: createdTask.Code,
+	}}
+	for _, emp := range createdTask.Edges.Assignees {
+		changes = append(changes, change{Kind: taskactivity.KindAssigneeAdded, RefID: emp.ID})
+	}
+	s.afterChange(ctx, createdTask, actor, changes...)
 	s.publishTaskCreatedEvent(ctx, createdTask, employeeID)
 
 	return createdTask, nil
@@ -259,13 +326,7 @@ func (s *TaskService) publishTaskCreatedEvent(ctx context.Context, task *ent.Tas
 		return
 	}
 
-	// Get organization ID from employee
-	orgID := 0
-	if task.Edges.Assignees != nil && len(task.Edges.Assignees) > 0 {
-		orgID = task.Edges.Assignees[0].OrgID
-	}
-
-	event := kafka.NewTaskCreatedEvent(task, orgID)
+	event := kafka.NewTaskCreatedEvent(task, task.OrgID)
 	key := strconv.Itoa(task.ID)
 
 	if err := s.KafkaClient.PublishEvent(ctx, "task-events", key, event); err != nil {
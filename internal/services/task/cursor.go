@@ -0,0 +1,141 @@
+package task
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+var (
+	cursorKeyOnce sync.Once
+	cursorKeyData []byte
+)
+
+// cursorKey returns the key signing list cursors, from CURSOR_SIGNING_KEY.
+// Without one a random key is generated, so cursors do not survive a restart
+// and are not accepted by other replicas.
+func cursorKey() []byte {
+	cursorKeyOnce.Do(func() {
+		if key := os.Getenv("CURSOR_SIGNING_KEY"); key != "" {
+			cursorKeyData = []byte(key)
+			return
+		}
+		cursorKeyData = make([]byte, 32)
+		if _, err := rand.Read(cursorKeyData); err != nil {
+			log.Fatalf("failed to generate cursor signing key: %v", err)
+		}
+	})
+	return cursorKeyData
+}
+
+// signCursor returns the signature of an encoded cursor payload
+func signCursor(encoded string) string {
+	mac := hmac.New(sha256.New, cursorKey())
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// sortValue returns the value of a task's sort field, nil when it is not set
+func sortValue(t *ent.Task, orderBy string) interface{} {
+	switch orderBy {
+	case task.FieldName:
+		return t.Name
+	case task.FieldCode:
+		return t.Code
+	case task.FieldStatus:
+		return string(t.Status)
+	case task.FieldType:
+		return string(t.Type)
+	case task.FieldProcess:
+		return t.Process
+	case task.FieldProjectID:
+		if t.ProjectID == 0 {
+			return nil
+		}
+		return t.ProjectID
+	case task.FieldCreatorID:
+		return t.CreatorID
+	case task.FieldStartAt:
+		return t.StartAt
+	case task.FieldDueDate:
+		return t.DueDate
+	case task.FieldCreatedAt:
+		return t.CreatedAt
+	case task.FieldUpdatedAt:
+		return t.UpdatedAt
+	default:
+		return t.ID
+	}
+}
+
+// parseSortValue reads the sort value stored in a cursor back into the type of
+// the field, nil when the field was not set
+func parseSortValue(value interface{}, orderBy string) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch orderBy {
+	case task.FieldName, task.FieldCode, task.FieldStatus, task.FieldType:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case task.FieldStartAt, task.FieldDueDate, task.FieldCreatedAt, task.FieldUpdatedAt:
+		if v, ok := value.(string); ok {
+			return time.Parse(time.RFC3339Nano, v)
+		}
+	default:
+		if v, ok := value.(float64); ok {
+			return int(v), nil
+		}
+	}
+	return nil, errors.New("invalid sort value")
+}
+
+// afterCursor selects the rows following a cursor in an order by the field
+// and then the ID, both in the same direction. Postgres sorts nulls as the
+// largest values: last when ascending and first when descending.
+func afterCursor(field string, desc bool, value interface{}, lastID int) predicate.Task {
+	return func(s *sql.Selector) {
+		col, idCol := s.C(field), s.C(task.FieldID)
+		if field == task.FieldID {
+			if desc {
+				s.Where(sql.LT(idCol, lastID))
+			} else {
+				s.Where(sql.GT(idCol, lastID))
+			}
+			return
+		}
+
+		switch {
+		case value == nil && desc:
+			s.Where(sql.Or(
+				sql.And(sql.IsNull(col), sql.LT(idCol, lastID)),
+				sql.NotNull(col),
+			))
+		case value == nil:
+			s.Where(sql.And(sql.IsNull(col), sql.GT(idCol, lastID)))
+		case desc:
+			s.Where(sql.Or(
+				sql.LT(col, value),
+				sql.And(sql.EQ(col, value), sql.LT(idCol, lastID)),
+			))
+		default:
+			s.Where(sql.Or(
+				sql.GT(col, value),
+				sql.And(sql.EQ(col, value), sql.GT(idCol, lastID)),
+				sql.IsNull(col),
+			))
+		}
+	}
+}
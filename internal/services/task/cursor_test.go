@@ -0,0 +1,40 @@
+package task
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestCursorSignature(t *testing.T) {
+	s := &TaskService{}
+	cursor := s.encodeCursor(map[string]interface{}{"order_by": "id", "value": 7, "last_id": 7})
+
+	data, err := s.decodeCursor(cursor)
+	if err != nil {
+		t.Fatalf("decodeCursor(%q): %v", cursor, err)
+	}
+	if data["last_id"] != float64(7) || data["order_by"] != "id" {
+		t.Fatalf("decodeCursor(%q) = %v", cursor, data)
+	}
+
+	encoded, signature, _ := strings.Cut(cursor, ".")
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"order_by":"id","value":1,"last_id":1}`))
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "unsigned", cursor: encoded},
+		{name: "edited payload", cursor: forged + "." + signature},
+		{name: "edited signature", cursor: encoded + "." + strings.Repeat("A", len(signature))},
+		{name: "plain base64", cursor: base64.StdEncoding.EncodeToString([]byte(`{"last_id":1}`))},
+		{name: "empty", cursor: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.decodeCursor(tt.cursor); err == nil {
+				t.Fatalf("decodeCursor(%q) accepted a cursor it did not sign", tt.cursor)
+			}
+		})
+	}
+}
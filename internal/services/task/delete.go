@@ -2,16 +2,46 @@ package task
 
 import (
 	"context"
+	"log"
 	"net/http"
 	"strconv"
 
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	projectService "github.com/longgggwwww/hrm-ms-hr/internal/services/project"
 )
 
-// Delete removes a single task by ID
-func (s *TaskService) Delete(ctx context.Context, id int) error {
-	affected, err := s.Client.Task.Delete().Where(task.ID(id)).Exec(ctx)
+// Delete removes a single task of an organization by ID. A task with subtasks
+// is only deleted when cascade is set, in which case its whole subtree goes
+// with it. Tasks of a project are deleted by its owners and managers.
+func (s *TaskService) Delete(ctx context.Context, actor Actor, id int, cascade bool) error {
+	target, err := s.getTask(ctx, actor.OrgID, id)
+	if err != nil {
+		return err
+	}
+	if target.ProjectID != 0 {
+		if err := s.authorizeProject(ctx, target.ProjectID, actor, projectService.ActionDeleteTask, "Only project owners and managers can delete its tasks"); err != nil {
+			return err
+		}
+	}
+
+	descendants, err := s.descendantIDs(ctx, id)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check subtasks",
+		}
+	}
+	if len(descendants) > 0 && !cascade {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Task has subtasks; delete them first or set cascade=true",
+		}
+	}
+
+	affected, err := s.Client.Task.Delete().
+		Where(task.IDIn(append(descendants, id)...)).
+		Exec(ctx)
 	if err != nil {
 		return &ServiceError{
 			Status: http.StatusInternalServerError,
@@ -26,11 +56,19 @@ func (s *TaskService) Delete(ctx context.Context, id int) error {
 		}
 	}
 
+	if err := s.RollUpProgress(ctx, target.ParentID); err != nil {
+		log.Printf("Failed to roll up progress for task %d: %v", target.ParentID, err)
+	}
+	s.RollUpProjects(ctx, target.ProjectID)
+
 	return nil
 }
 
-// BulkDelete removes multiple tasks by their IDs
-func (s *TaskService) BulkDelete(ctx context.Context, input dtos.TaskBulkDeleteInput) (*dtos.TaskBulkDeleteResponse, error) {
+// BulkDelete removes multiple tasks of an organization by their IDs; tasks of
+// other organizations are reported as not found, and tasks of projects the
+// actor may not delete tasks of as failed
+func (s *TaskService) BulkDelete(ctx context.Context, actor Actor, input dtos.TaskBulkDeleteInput) (*dtos.TaskBulkDeleteResponse, error) {
+	orgID := actor.OrgID
 	// Validate maximum number of IDs to prevent abuse
 	if len(input.IDs) > 100 {
 		return nil, &ServiceError{
@@ -48,8 +86,8 @@ func (s *TaskService) BulkDelete(ctx context.Context, input dtos.TaskBulkDeleteI
 
 	// Check which tasks exist before attempting deletion
 	existingTasks, err := s.Client.Task.Query().
-		Where(task.IDIn(input.IDs...)).
-		Select(task.FieldID).
+		Where(task.IDIn(input.IDs...), task.OrgID(orgID)).
+		Select(task.FieldID, task.FieldParentID, task.FieldProjectID).
 		All(ctx)
 	if err != nil {
 		return nil, &ServiceError{
@@ -75,11 +113,79 @@ func (s *TaskService) BulkDelete(ctx context.Context, input dtos.TaskBulkDeleteI
 		}
 	}
 
-	// Perform bulk deletion for valid IDs
 	var deletedCount int
 	var failedIDs []int
 	var errors []string
 
+	// Tasks of a project are only deleted by its owners and managers
+	projectOf := make(map[int]int, len(existingTasks))
+	for _, t := range existingTasks {
+		projectOf[t.ID] = t.ProjectID
+	}
+	allowed := make(map[int]bool)
+	var permitted []int
+	for _, id := range validIDs {
+		projectID := projectOf[id]
+		if projectID != 0 {
+			ok, checked := allowed[projectID]
+			if !checked {
+				ok = s.authorizeProject(ctx, projectID, actor, projectService.ActionDeleteTask, "") == nil
+				allowed[projectID] = ok
+			}
+			if !ok {
+				failedIDs = append(failedIDs, id)
+				errors = append(errors, "Task ID "+strconv.Itoa(id)+": only project owners and managers can delete it")
+				continue
+			}
+		}
+		permitted = append(permitted, id)
+	}
+	validIDs = permitted
+
+	// Subtasks are either deleted along with their parents or, without cascade,
+	// any listed task that still has subtasks outside the request is refused
+	if len(validIDs) > 0 {
+		if input.Cascade {
+			descendants, err := s.descendantIDs(ctx, validIDs...)
+			if err != nil {
+				return nil, &ServiceError{
+					Status: http.StatusInternalServerError,
+					Msg:    "Failed to check subtasks",
+				}
+			}
+			validIDs = append(validIDs, descendants...)
+		} else {
+			children, err := s.Client.Task.Query().
+				Where(task.ParentIDIn(validIDs...)).
+				Where(task.IDNotIn(validIDs...)).
+				Select(task.FieldParentID).
+				All(ctx)
+			if err != nil {
+				return nil, &ServiceError{
+					Status: http.StatusInternalServerError,
+					Msg:    "Failed to check subtasks",
+				}
+			}
+			blocked := make(map[int]bool)
+			for _, child := range children {
+				blocked[child.ParentID] = true
+			}
+
+			var deletable []int
+			for _, id := range validIDs {
+				if blocked[id] {
+					failedIDs = append(failedIDs, id)
+					errors = append(errors, "Task ID "+strconv.Itoa(id)+" has subtasks")
+				} else {
+					deletable = append(deletable, id)
+				}
+			}
+			validIDs = deletable
+		}
+	}
+
+	// Perform bulk deletion for valid IDs
+
 	if len(validIDs) > 0 {
 		deletedCount, err = s.Client.Task.Delete().
 			Where(task.IDIn(validIDs...)).
@@ -88,6 +194,25 @@ func (s *TaskService) BulkDelete(ctx context.Context, input dtos.TaskBulkDeleteI
 			// If deletion fails, add all valid IDs to failed IDs
 			failedIDs = append(failedIDs, validIDs...)
 			errors = append(errors, "Failed to delete tasks: "+err.Error())
+		} else {
+			// Parents that survive the deletion lose subtasks, so refresh their
+			// progress and that of the projects
+			deleted := make(map[int]bool)
+			for _, id := range validIDs {
+				deleted[id] = true
+			}
+			var projectIDs []int
+			for _, t := range existingTasks {
+				if deleted[t.ID] && t.ParentID != 0 && !deleted[t.ParentID] {
+					if err := s.RollUpProgress(ctx, t.ParentID); err != nil {
+						log.Printf("Failed to roll up progress for task %d: %v", t.ParentID, err)
+					}
+				}
+				if deleted[t.ID] {
+					projectIDs = append(projectIDs, t.ProjectID)
+				}
+			}
+			s.RollUpProjects(ctx, projectIDs...)
 		}
 	}
 
@@ -0,0 +1,234 @@
+package task
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskdependency"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// CreateDependency links a task to a target task. For "blocks" links the task
+// must be completed before the target can start, so cycles are refused.
+func (s *TaskService) CreateDependency(ctx context.Context, orgID, taskID, userID int, input dtos.TaskDependencyCreateInput) (*ent.TaskDependency, error) {
+	depType := taskdependency.TypeBlocks
+	if input.Type != "" {
+		depType = taskdependency.Type(input.Type)
+		if err := taskdependency.TypeValidator(depType); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid type value. Valid values: blocks, relates_to, duplicates",
+			}
+		}
+	}
+
+	if input.TargetID == taskID {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "A task cannot depend on itself",
+		}
+	}
+
+	if _, err := s.getTask(ctx, orgID, taskID); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.Client.Task.Query().
+		Where(task.ID(input.TargetID), task.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch target task",
+		}
+	}
+	if !exists {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Target task not found",
+		}
+	}
+
+	// The same link in either direction is a duplicate
+	linked, err := s.Client.TaskDependency.Query().
+		Where(taskdependency.TypeEQ(depType)).
+		Where(taskdependency.Or(
+			taskdependency.And(taskdependency.TaskID(taskID), taskdependency.TargetID(input.TargetID)),
+			taskdependency.And(taskdependency.TaskID(input.TargetID), taskdependency.TargetID(taskID)),
+		)).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check existing dependencies",
+		}
+	}
+	if linked {
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "These tasks are already linked with this type",
+		}
+	}
+
+	if depType == taskdependency.TypeBlocks {
+		cycle, err := s.blocks(ctx, input.TargetID, taskID)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to check dependency cycle",
+			}
+		}
+		if cycle {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "This dependency would create a cycle",
+			}
+		}
+	}
+
+	dep, err := s.Client.TaskDependency.Create().
+		SetTaskID(taskID).
+		SetTargetID(input.TargetID).
+		SetType(depType).
+		SetCreatorID(userID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "These tasks are already linked with this type",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create dependency",
+		}
+	}
+
+	return dep, nil
+}
+
+// ListDependencies returns the links going out of and coming into a task
+func (s *TaskService) ListDependencies(ctx context.Context, orgID, taskID int) (*dtos.TaskDependencyListResponse, error) {
+	if _, err := s.getTask(ctx, orgID, taskID); err != nil {
+		return nil, err
+	}
+
+	outgoing, err := s.Client.TaskDependency.Query().
+		Where(taskdependency.TaskID(taskID)).
+		WithTarget().
+		Order(ent.Asc(taskdependency.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch dependencies",
+		}
+	}
+
+	incoming, err := s.Client.TaskDependency.Query().
+		Where(taskdependency.TargetID(taskID)).
+		WithTask().
+		Order(ent.Asc(taskdependency.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch dependencies",
+		}
+	}
+
+	return &dtos.TaskDependencyListResponse{
+		Dependencies: outgoing,
+		Dependents:   incoming,
+	}, nil
+}
+
+// DeleteDependency removes a link that starts or ends at the given task
+func (s *TaskService) DeleteDependency(ctx context.Context, orgID, taskID, dependencyID int) error {
+	if _, err := s.getTask(ctx, orgID, taskID); err != nil {
+		return err
+	}
+
+	affected, err := s.Client.TaskDependency.Delete().
+		Where(taskdependency.ID(dependencyID)).
+		Where(taskdependency.Or(
+			taskdependency.TaskID(taskID),
+			taskdependency.TargetID(taskID),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete dependency",
+		}
+	}
+	if affected == 0 {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Dependency not found",
+		}
+	}
+	return nil
+}
+
+// blocks reports whether task from blocks task to, directly or through a
+// chain of other blocking links
+func (s *TaskService) blocks(ctx context.Context, from, to int) (bool, error) {
+	seen := map[int]bool{from: true}
+	level := []int{from}
+	for len(level) > 0 {
+		links, err := s.Client.TaskDependency.Query().
+			Where(taskdependency.TypeEQ(taskdependency.TypeBlocks)).
+			Where(taskdependency.TaskIDIn(level...)).
+			Select(taskdependency.FieldTargetID).
+			All(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		level = nil
+		for _, link := range links {
+			if link.TargetID == to {
+				return true, nil
+			}
+			if !seen[link.TargetID] {
+				seen[link.TargetID] = true
+				level = append(level, link.TargetID)
+			}
+		}
+	}
+	return false, nil
+}
+
+// checkNotBlocked refuses to start a task while any task blocking it is not completed
+func (s *TaskService) checkNotBlocked(ctx context.Context, taskID int) error {
+	blockers, err := s.Client.Task.Query().
+		Where(task.HasDependenciesWith(
+			taskdependency.TypeEQ(taskdependency.TypeBlocks),
+			taskdependency.TargetID(taskID),
+		)).
+		Where(task.StatusNEQ(task.StatusCompleted)).
+		Select(task.FieldCode).
+		All(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check blocking tasks",
+		}
+	}
+	if len(blockers) > 0 {
+		codes := make([]string, len(blockers))
+		for i, b := range blockers {
+			codes[i] = b.Code
+		}
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Task is blocked by unfinished tasks: " + strings.Join(codes, ", "),
+		}
+	}
+	return nil
+}
@@ -8,10 +8,12 @@ import (
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
 )
 
-// Get retrieves a single task by ID with all related data
-func (s *TaskService) Get(ctx context.Context, id int) (*ent.Task, error) {
+// Get retrieves a single task of an organization by ID with all related data
+func (s *TaskService) Get(ctx context.Context, orgID, id int) (*ent.Task, error) {
 	task, err := s.Client.Task.Query().
-		Where(task.ID(id)).
+		Where(task.ID(id), task.OrgID(orgID)).
+		WithParent().
+		WithChildren().
 		WithProject().
 		WithLabels().
 		WithAssignees().
@@ -0,0 +1,260 @@
+package task
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	projectService "github.com/longgggwwww/hrm-ms-hr/internal/services/project"
+)
+
+// MaxTaskDepth is the maximum number of levels in a task tree, counting the
+// top-level task as level 1.
+const MaxTaskDepth = 5
+
+// depthOf returns the level of a task in its tree, 1 for a top-level task
+func (s *TaskService) depthOf(ctx context.Context, id int) (int, error) {
+	depth := 0
+	for id != 0 {
+		depth++
+		if depth > MaxTaskDepth {
+			// Either the tree is already too deep or the data contains a loop;
+			// both mean nothing more can be attached below this task.
+			return depth, nil
+		}
+		t, err := s.Client.Task.Query().
+			Where(task.ID(id)).
+			Select(task.FieldParentID).
+			Only(ctx)
+		if err != nil {
+			return 0, err
+		}
+		id = t.ParentID
+	}
+	return depth, nil
+}
+
+// descendantIDs returns the IDs of every task below the given ones, level by level
+func (s *TaskService) descendantIDs(ctx context.Context, ids ...int) ([]int, error) {
+	var result []int
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		seen[id] = true
+	}
+
+	level := ids
+	for len(level) > 0 {
+		children, err := s.Client.Task.Query().
+			Where(task.ParentIDIn(level...)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		level = nil
+		for _, id := range children {
+			if !seen[id] {
+				seen[id] = true
+				level = append(level, id)
+				result = append(result, id)
+			}
+		}
+	}
+	return result, nil
+}
+
+// subtreeHeight returns the number of levels in the subtree rooted at a task
+func (s *TaskService) subtreeHeight(ctx context.Context, id int) (int, error) {
+	height := 0
+	level := []int{id}
+	for len(level) > 0 && height <= MaxTaskDepth {
+		height++
+		children, err := s.Client.Task.Query().
+			Where(task.ParentIDIn(level...)).
+			IDs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		level = children
+	}
+	return height, nil
+}
+
+// validateParent checks that taskID (0 for a new task) may be placed under
+// parentID, a task of the organization, without creating a cycle or exceeding
+// MaxTaskDepth
+func (s *TaskService) validateParent(ctx context.Context, orgID, taskID, parentID int) (*ent.Task, error) {
+	if taskID != 0 && taskID == parentID {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "A task cannot be its own parent",
+		}
+	}
+
+	parent, err := s.Client.Task.Query().
+		Where(task.ID(parentID), task.OrgID(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Parent task not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch parent task",
+		}
+	}
+
+	height := 1
+	if taskID != 0 {
+		descendants, err := s.descendantIDs(ctx, taskID)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to validate task hierarchy",
+			}
+		}
+		for _, id := range descendants {
+			if id == parentID {
+				return nil, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "A task cannot be moved under one of its own subtasks",
+				}
+			}
+		}
+
+		height, err = s.subtreeHeight(ctx, taskID)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to validate task hierarchy",
+			}
+		}
+	}
+
+	depth, err := s.depthOf(ctx, parentID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate task hierarchy",
+		}
+	}
+	if depth+height > MaxTaskDepth {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Task hierarchy cannot be deeper than " + strconv.Itoa(MaxTaskDepth) + " levels",
+		}
+	}
+
+	return parent, nil
+}
+
+// hasChildren reports whether a task has any subtasks
+func (s *TaskService) hasChildren(ctx context.Context, id int) (bool, error) {
+	return s.Client.Task.Query().
+		Where(task.ParentID(id)).
+		Exist(ctx)
+}
+
+// checkCanComplete refuses to complete a task while any of its subtasks are open
+func (s *TaskService) checkCanComplete(ctx context.Context, id int) error {
+	open, err := s.Client.Task.Query().
+		Where(task.ParentID(id)).
+		Where(task.StatusNotIn(task.StatusCompleted, task.StatusCancelled)).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check subtasks",
+		}
+	}
+	if open {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Cannot complete a task while it has open subtasks",
+		}
+	}
+	return nil
+}
+
+// RollUpProgress recomputes the process of parentID and each of its ancestors
+// as the average process of their subtasks. Cancelled subtasks are left out and
+// completed ones count as 100.
+func (s *TaskService) RollUpProgress(ctx context.Context, parentID int) error {
+	for hops := 0; parentID != 0 && hops < MaxTaskDepth; hops++ {
+		children, err := s.Client.Task.Query().
+			Where(task.ParentID(parentID)).
+			Select(task.FieldProcess, task.FieldStatus).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		sum, count := 0, 0
+		for _, child := range children {
+			switch child.Status {
+			case task.StatusCancelled:
+				continue
+			case task.StatusCompleted:
+				sum += 100
+			default:
+				sum += child.Process
+			}
+			count++
+		}
+		if count > 0 {
+			if err := s.Client.Task.UpdateOneID(parentID).
+				SetProcess(sum / count).
+				Exec(ctx); err != nil {
+				if ent.IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+		}
+
+		parent, err := s.Client.Task.Query().
+			Where(task.ID(parentID)).
+			Select(task.FieldParentID).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		parentID = parent.ParentID
+	}
+	return nil
+}
+
+// RollUpProjects recomputes the process and status of the given projects from
+// their tasks; failures are logged since the task change itself went through
+func (s *TaskService) RollUpProjects(ctx context.Context, projectIDs ...int) {
+	seen := make(map[int]bool, len(projectIDs))
+	for _, projectID := range projectIDs {
+		if projectID == 0 || seen[projectID] {
+			continue
+		}
+		seen[projectID] = true
+		if err := projectService.RollUpProgress(ctx, s.Client, projectID); err != nil {
+			log.Printf("Failed to roll up progress for project %d: %v", projectID, err)
+		}
+	}
+}
+
+// withSubtree eager-loads subtasks down to the maximum tree depth
+func withSubtree(q *ent.TaskQuery, levels int) {
+	if levels <= 0 {
+		return
+	}
+	q.WithChildren(func(cq *ent.TaskQuery) {
+		cq.WithLabels().WithAssignees()
+		withSubtree(cq, levels-1)
+	})
+}
@@ -2,21 +2,211 @@ package task
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/label"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 )
 
-// List retrieves tasks with filtering, sorting, and pagination support
-func (s *TaskService) List(ctx context.Context, query dtos.TaskListQuery) (map[string]interface{}, error) {
-	taskQuery := s.Client.Task.Query().
+// List retrieves the tasks of an organization with filtering, sorting, and
+// pagination support.
+// In tree view the filters and pagination apply to the top level of the result
+// (top-level tasks, or the children of parent_id) and each row carries its
+// subtasks nested under edges.children.
+func (s *TaskService) List(ctx context.Context, orgID int, query dtos.TaskListQuery) (map[string]interface{}, error) {
+	taskQuery, err := s.filterQuery(orgID, query)
+	if err != nil {
+		return nil, err
+	}
+	taskQuery = taskQuery.
 		WithProject().
 		WithLabels().
 		WithAssignees()
+	if query.View == "tree" {
+		withSubtree(taskQuery, MaxTaskDepth-1)
+	}
+
+	// Order by field and direction
+	orderBy := query.OrderBy
+	if orderBy == "" {
+		orderBy = "created_at"
+	}
+	// Lists are newest first unless ascending order is asked for
+	orderDir := "desc"
+	if query.OrderDir == "asc" {
+		orderDir = "asc"
+	}
+
+	var orderOption task.OrderOption
+	switch orderBy {
+	case "id":
+		if orderDir == "asc" {
+			orderOption = task.ByID()
+		} else {
+			orderOption = task.ByID(sql.OrderDesc())
+		}
+	case "name":
+		if orderDir == "asc" {
+			orderOption = task.ByName()
+		} else {
+			orderOption = task.ByName(sql.OrderDesc())
+		}
+	case "code":
+		if orderDir == "asc" {
+			orderOption = task.ByCode()
+		} else {
+			orderOption = task.ByCode(sql.OrderDesc())
+		}
+	case "status":
+		if orderDir == "asc" {
+			orderOption = task.ByStatus()
+		} else {
+			orderOption = task.ByStatus(sql.OrderDesc())
+		}
+	case "type":
+		if orderDir == "asc" {
+			orderOption = task.ByType()
+		} else {
+			orderOption = task.ByType(sql.OrderDesc())
+		}
+	case "process":
+		if orderDir == "asc" {
+			orderOption = task.ByProcess()
+		} else {
+			orderOption = task.ByProcess(sql.OrderDesc())
+		}
+	case "project_id":
+		if orderDir == "asc" {
+			orderOption = task.ByProjectID()
+		} else {
+			orderOption = task.ByProjectID(sql.OrderDesc())
+		}
+	case "creator_id":
+		if orderDir == "asc" {
+			orderOption = task.ByCreatorID()
+		} else {
+			orderOption = task.ByCreatorID(sql.OrderDesc())
+		}
+	case "start_at":
+		if orderDir == "asc" {
+			orderOption = task.ByStartAt()
+		} else {
+			orderOption = task.ByStartAt(sql.OrderDesc())
+		}
+	case "due_date":
+		if orderDir == "asc" {
+			orderOption = task.ByDueDate()
+		} else {
+			orderOption = task.ByDueDate(sql.OrderDesc())
+		}
+	case "created_at":
+		if orderDir == "asc" {
+			orderOption = task.ByCreatedAt()
+		} else {
+			orderOption = task.ByCreatedAt(sql.OrderDesc())
+		}
+	case "updated_at":
+		if orderDir == "asc" {
+			orderOption = task.ByUpdatedAt()
+		} else {
+			orderOption = task.ByUpdatedAt(sql.OrderDesc())
+		}
+	default:
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid order_by field. Valid fields: id, name, code, status, type, process, project_id, creator_id, start_at, due_date, created_at, updated_at",
+		}
+	}
+
+	// Apply ordering, with the ID breaking ties so pages are stable
+	taskQuery = taskQuery.Order(orderOption)
+	if orderBy != "id" {
+		if orderDir == "asc" {
+			taskQuery = taskQuery.Order(task.ByID())
+		} else {
+			taskQuery = taskQuery.Order(task.ByID(sql.OrderDesc()))
+		}
+	}
+
+	if query.PaginationType == "cursor" {
+		return s.listWithCursorPagination(ctx, taskQuery, orderBy, orderDir, query)
+	}
+
+	// Pagination
+	page := 1
+	limit := 10
+	if query.Page > 0 {
+		page = query.Page
+	}
+	if query.Limit > 0 && query.Limit <= 100 {
+		limit = query.Limit
+	}
+
+	// Get total count for pagination info
+	total, err := taskQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count total tasks",
+		}
+	}
+
+	offset := (page - 1) * limit
+	taskQuery = taskQuery.Offset(offset).Limit(limit)
+
+	tasks, err := taskQuery.All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch tasks",
+		}
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	response := map[string]interface{}{
+		"data": tasks,
+		"pagination": map[string]interface{}{
+			"current_page": page,
+			"total_pages":  totalPages,
+			"total_items":  total,
+			"per_page":     limit,
+		},
+	}
+
+	return response, nil
+}
+
+// Count returns the number of tasks of an organization matching the filters
+// of a list query, ignoring its sorting and pagination
+func (s *TaskService) Count(ctx context.Context, orgID int, query dtos.TaskListQuery) (int, error) {
+	taskQuery, err := s.filterQuery(orgID, query)
+	if err != nil {
+		return 0, err
+	}
+	count, err := taskQuery.Count(ctx)
+	if err != nil {
+		return 0, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to count tasks",
+		}
+	}
+	return count, nil
+}
+
+// filterQuery builds the query selecting the tasks of an organization that
+// match the filters of a list query
+func (s *TaskService) filterQuery(orgID int, query dtos.TaskListQuery) (*ent.TaskQuery, error) {
+	taskQuery := s.Client.Task.Query().
+		Where(task.OrgID(orgID))
 
 	// Filter by name
 	if query.Name != "" {
@@ -38,10 +228,8 @@ func (s *TaskService) List(ctx context.Context, query dtos.TaskListQuery) (map[s
 			string(task.StatusCancelled):
 			taskQuery = taskQuery.Where(task.StatusEQ(task.Status(query.Status)))
 		default:
-			return nil, &ServiceError{
-				Status: http.StatusBadRequest,
-				Msg:    "Invalid status value. Valid values: not_received, received, in_progress, completed, cancelled",
-			}
+			// Any other value is a custom status key of a project workflow
+			taskQuery = taskQuery.Where(task.WorkflowStatus(query.Status))
 		}
 	}
 
@@ -85,6 +273,61 @@ func (s *TaskService) List(ctx context.Context, query dtos.TaskListQuery) (map[s
 		taskQuery = taskQuery.Where(task.CreatorIDEQ(creatorID))
 	}
 
+	if query.View != "" && query.View != "flat" && query.View != "tree" {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid view value. Valid values: flat, tree",
+		}
+	}
+
+	// Filter by parent_id, where 0 selects top-level tasks only
+	if query.ParentID != "" {
+		parentID, err := strconv.Atoi(query.ParentID)
+		if err != nil || parentID < 0 {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid parent_id format",
+			}
+		}
+		if parentID == 0 {
+			taskQuery = taskQuery.Where(task.ParentIDIsNil())
+		} else {
+			taskQuery = taskQuery.Where(task.ParentIDEQ(parentID))
+		}
+	} else if query.View == "tree" {
+		taskQuery = taskQuery.Where(task.ParentIDIsNil())
+	}
+
+	// Filter by sprint or milestone; 0 lists the tasks without one
+	if query.SprintID != "" {
+		sprintID, err := strconv.Atoi(query.SprintID)
+		if err != nil || sprintID < 0 {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid sprint_id format",
+			}
+		}
+		if sprintID == 0 {
+			taskQuery = taskQuery.Where(task.SprintIDIsNil())
+		} else {
+			taskQuery = taskQuery.Where(task.SprintIDEQ(sprintID))
+		}
+	}
+	if query.MilestoneID != "" {
+		milestoneID, err := strconv.Atoi(query.MilestoneID)
+		if err != nil || milestoneID < 0 {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid milestone_id format",
+			}
+		}
+		if milestoneID == 0 {
+			taskQuery = taskQuery.Where(task.MilestoneIDIsNil())
+		} else {
+			taskQuery = taskQuery.Where(task.MilestoneIDEQ(milestoneID))
+		}
+	}
+
 	// Filter by process (percentage)
 	if query.Process != "" {
 		process, err := strconv.Atoi(query.Process)
@@ -143,114 +386,131 @@ func (s *TaskService) List(ctx context.Context, query dtos.TaskListQuery) (map[s
 		taskQuery = taskQuery.Where(task.DueDateLTE(dueDate))
 	}
 
-	// Order by field and direction
-	orderBy := query.OrderBy
-	if orderBy == "" {
-		orderBy = "created_at"
-	}
-	orderDir := query.OrderDir
-	if orderDir == "" {
-		orderDir = "desc"
-	}
-
-	var orderOption task.OrderOption
-	switch orderBy {
-	case "id":
-		if orderDir == "asc" {
-			orderOption = task.ByID()
-		} else {
-			orderOption = task.ByID(sql.OrderDesc())
-		}
-	case "name":
-		if orderDir == "asc" {
-			orderOption = task.ByName()
-		} else {
-			orderOption = task.ByName(sql.OrderDesc())
-		}
-	case "code":
-		if orderDir == "asc" {
-			orderOption = task.ByCode()
-		} else {
-			orderOption = task.ByCode(sql.OrderDesc())
-		}
-	case "status":
-		if orderDir == "asc" {
-			orderOption = task.ByStatus()
-		} else {
-			orderOption = task.ByStatus(sql.OrderDesc())
-		}
-	case "type":
-		if orderDir == "asc" {
-			orderOption = task.ByType()
-		} else {
-			orderOption = task.ByType(sql.OrderDesc())
-		}
-	case "process":
-		if orderDir == "asc" {
-			orderOption = task.ByProcess()
-		} else {
-			orderOption = task.ByProcess(sql.OrderDesc())
-		}
-	case "project_id":
-		if orderDir == "asc" {
-			orderOption = task.ByProjectID()
-		} else {
-			orderOption = task.ByProjectID(sql.OrderDesc())
+	// Filter by assignees or labels, matching tasks with any of them or, in
+	// all mode, with every one of them
+	if query.AssigneeIDs != "" {
+		assigneeIDs, err := parseIDs(query.AssigneeIDs)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid assignee_ids format",
+			}
 		}
-	case "creator_id":
-		if orderDir == "asc" {
-			orderOption = task.ByCreatorID()
+		if query.AssigneeMode == "all" {
+			for _, id := range assigneeIDs {
+				taskQuery = taskQuery.Where(task.HasAssigneesWith(employee.ID(id)))
+			}
 		} else {
-			orderOption = task.ByCreatorID(sql.OrderDesc())
+			taskQuery = taskQuery.Where(task.HasAssigneesWith(employee.IDIn(assigneeIDs...)))
 		}
-	case "start_at":
-		if orderDir == "asc" {
-			orderOption = task.ByStartAt()
-		} else {
-			orderOption = task.ByStartAt(sql.OrderDesc())
+	}
+	if query.LabelIDs != "" {
+		labelIDs, err := parseIDs(query.LabelIDs)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid label_ids format",
+			}
 		}
-	case "due_date":
-		if orderDir == "asc" {
-			orderOption = task.ByDueDate()
+		if query.LabelMode == "all" {
+			for _, id := range labelIDs {
+				taskQuery = taskQuery.Where(task.HasLabelsWith(label.ID(id)))
+			}
 		} else {
-			orderOption = task.ByDueDate(sql.OrderDesc())
+			taskQuery = taskQuery.Where(task.HasLabelsWith(label.IDIn(labelIDs...)))
 		}
-	case "created_at":
-		if orderDir == "asc" {
-			orderOption = task.ByCreatedAt()
-		} else {
-			orderOption = task.ByCreatedAt(sql.OrderDesc())
+	}
+
+	// Filter by tasks without assignees, or assigned to the caller
+	if query.Unassigned {
+		if query.AssigneeIDs != "" || query.Mine {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "unassigned cannot be combined with assignee_ids or mine",
+			}
 		}
-	case "updated_at":
-		if orderDir == "asc" {
-			orderOption = task.ByUpdatedAt()
-		} else {
-			orderOption = task.ByUpdatedAt(sql.OrderDesc())
+		taskQuery = taskQuery.Where(task.Not(task.HasAssignees()))
+	}
+	if query.Mine {
+		if query.EmployeeID == 0 {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "mine requires a token of an employee",
+			}
 		}
-	default:
-		return nil, &ServiceError{
-			Status: http.StatusBadRequest,
-			Msg:    "Invalid order_by field. Valid fields: id, name, code, status, type, process, project_id, creator_id, start_at, due_date, created_at, updated_at",
+		taskQuery = taskQuery.Where(task.HasAssigneesWith(employee.ID(query.EmployeeID)))
+	}
+
+	// Filter by tasks past their due date that are neither completed nor cancelled
+	if query.Overdue {
+		taskQuery = taskQuery.Where(
+			task.DueDateLT(time.Now()),
+			task.StatusNotIn(task.StatusCompleted, task.StatusCancelled),
+		)
+	}
+
+	// Filter by tasks changed since a time, for incremental sync
+	if query.UpdatedSince != "" {
+		updatedSince, err := time.Parse(time.RFC3339, query.UpdatedSince)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid updated_since format, must be RFC3339",
+			}
 		}
+		taskQuery = taskQuery.Where(task.UpdatedAtGTE(updatedSince))
 	}
 
-	// Apply ordering
-	taskQuery = taskQuery.Order(orderOption)
+	return taskQuery, nil
+}
 
-	// Pagination
-	page := 1
-	limit := 10
-	if query.Page > 0 {
-		page = query.Page
+// parseIDs parses a comma-separated list of IDs
+func parseIDs(value string) ([]int, error) {
+	var ids []int
+	for _, part := range strings.Split(value, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || id < 1 {
+			return nil, errors.New("invalid id " + part)
+		}
+		ids = append(ids, id)
 	}
-	if query.Limit > 0 && query.Limit <= 100 {
-		limit = query.Limit
+	return ids, nil
+}
+
+// listWithCursorPagination pages through tasks by keyset: each page starts
+// after the sort value and ID of the last row of the previous one, so rows
+// are neither skipped nor repeated when tasks are added or removed meanwhile.
+func (s *TaskService) listWithCursorPagination(ctx context.Context, taskQuery *ent.TaskQuery, orderBy, orderDir string, query dtos.TaskListQuery) (map[string]interface{}, error) {
+	limit := query.CursorLimit
+	if limit < 1 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
 	}
 
-	offset := (page - 1) * limit
-	taskQuery = taskQuery.Offset(offset).Limit(limit)
+	if query.Cursor != "" {
+		// A cursor only continues the order it was made for
+		cursorData, err := s.decodeCursor(query.Cursor)
+		lastID, ok := cursorData["last_id"].(float64)
+		if err != nil || !ok || cursorData["order_by"] != orderBy || cursorData["order_dir"] != orderDir {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid cursor format",
+			}
+		}
+		value, err := parseSortValue(cursorData["value"], orderBy)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid cursor format",
+			}
+		}
+		taskQuery = taskQuery.Where(afterCursor(orderBy, orderDir == "desc", value, int(lastID)))
+	}
 
-	tasks, err := taskQuery.All(ctx)
+	// Fetch limit + 1 to check if there are more items
+	tasks, err := taskQuery.Limit(limit + 1).All(ctx)
 	if err != nil {
 		return nil, &ServiceError{
 			Status: http.StatusInternalServerError,
@@ -258,24 +518,31 @@ func (s *TaskService) List(ctx context.Context, query dtos.TaskListQuery) (map[s
 		}
 	}
 
-	// Get total count for pagination info
-	total, err := s.Client.Task.Query().Count(ctx)
-	if err != nil {
-		return nil, &ServiceError{
-			Status: http.StatusInternalServerError,
-			Msg:    "Failed to count total tasks",
-		}
+	hasNext := len(tasks) > limit
+	if hasNext {
+		tasks = tasks[:limit]
 	}
 
-	totalPages := (total + limit - 1) / limit
+	var nextCursor *string
+	if hasNext {
+		lastTask := tasks[len(tasks)-1]
+		cursorData := map[string]interface{}{
+			"order_by":  orderBy,
+			"order_dir": orderDir,
+			"value":     sortValue(lastTask, orderBy),
+			"last_id":   lastTask.ID,
+		}
+		cursor := s.encodeCursor(cursorData)
+		nextCursor = &cursor
+	}
 
 	response := map[string]interface{}{
 		"data": tasks,
-		"pagination": map[string]interface{}{
-			"current_page": page,
-			"total_pages":  totalPages,
-			"total_items":  total,
-			"per_page":     limit,
+		"pagination": dtos.TaskCursorPagination{
+			Type:       "cursor",
+			PerPage:    limit,
+			HasNext:    hasNext,
+			NextCursor: nextCursor,
 		},
 	}
 
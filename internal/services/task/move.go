@@ -0,0 +1,144 @@
+package task
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	projectService "github.com/longgggwwww/hrm-ms-hr/internal/services/project"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
+)
+
+// subtasksOf returns every task below a task
+func (s *TaskService) subtasksOf(ctx context.Context, id int) ([]*ent.Task, error) {
+	ids, err := s.descendantIDs(ctx, id)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch subtasks",
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	subtasks, err := s.Client.Task.Query().
+		Where(task.IDIn(ids...)).
+		Order(ent.Asc(task.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch subtasks",
+		}
+	}
+	return subtasks, nil
+}
+
+// authorizeMoveOut checks that the actor's role lets them move tasks out of
+// each project they leave for another one, as it must let them move tasks
+// into that one
+func (s *TaskService) authorizeMoveOut(ctx context.Context, actor Actor, targetProjectID int, tasks ...*ent.Task) error {
+	checked := make(map[int]bool)
+	for _, t := range tasks {
+		if t.ProjectID == 0 || t.ProjectID == targetProjectID || checked[t.ProjectID] {
+			continue
+		}
+		checked[t.ProjectID] = true
+		if err := s.authorizeProject(ctx, t.ProjectID, actor, projectService.ActionCreateTask, "Your project role does not allow moving tasks out of this project"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveSubtasks moves the subtasks of a moved task into its new project, so
+// that they stay in the project of their parent, and returns the IDs of those
+// it moved. Like their parent they take the closest status of the new
+// workflow and lose their rank, sprint and milestone.
+func (s *TaskService) moveSubtasks(ctx context.Context, subtasks []*ent.Task, targetProjectID int, actor Actor) ([]int, error) {
+	if len(subtasks) == 0 {
+		return nil, nil
+	}
+	def, err := s.workflowOf(ctx, targetProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var moved []int
+	for _, current := range subtasks {
+		if current.ProjectID == targetProjectID {
+			continue
+		}
+		taskUpdate := s.Client.Task.UpdateOneID(current.ID).
+			SetUpdaterID(actor.UserID).
+			SetProjectID(targetProjectID).
+			SetRank("").
+			ClearSprintID().
+			ClearMilestoneID()
+		setStatus(taskUpdate, current, def.Remap(workflow.StatusKey(current), string(current.Status)))
+		updated, err := taskUpdate.Save(ctx)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to move subtasks",
+			}
+		}
+		s.afterChange(ctx, updated, actor, taskChanges(current, updated)...)
+		moved = append(moved, current.ID)
+	}
+	return moved, nil
+}
+
+// publishUpdated publishes an update event for each of the given tasks
+func (s *TaskService) publishUpdated(ctx context.Context, ids []int, userID int) {
+	if len(ids) == 0 {
+		return
+	}
+	updatedTasks, err := s.Client.Task.Query().
+		Where(task.IDIn(ids...)).
+		WithParent().
+		WithChildren().
+		WithProject().
+		WithLabels().
+		WithAssignees().
+		WithReports().
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to fetch updated tasks for events: %v", err)
+		return
+	}
+	for _, t := range updatedTasks {
+		s.publishTaskUpdatedEvent(ctx, t, userID)
+	}
+}
+
+// belowOthers reports which of the given tasks sit below another one of them
+func (s *TaskService) belowOthers(ctx context.Context, tasks map[int]*ent.Task) (map[int]bool, error) {
+	below := make(map[int]bool)
+	for id, t := range tasks {
+		parentID := t.ParentID
+		for hops := 0; parentID != 0 && hops < MaxTaskDepth; hops++ {
+			if _, ok := tasks[parentID]; ok {
+				below[id] = true
+				break
+			}
+			parent, err := s.Client.Task.Query().
+				Where(task.ID(parentID)).
+				Select(task.FieldParentID).
+				Only(ctx)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					break
+				}
+				return nil, &ServiceError{
+					Status: http.StatusInternalServerError,
+					Msg:    "Failed to fetch parent task",
+				}
+			}
+			parentID = parent.ParentID
+		}
+	}
+	return below, nil
+}
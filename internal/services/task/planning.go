@@ -0,0 +1,74 @@
+package task
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/milestone"
+	"github.com/longgggwwww/hrm-ms-hr/ent/sprint"
+)
+
+// checkSprint makes sure a task of a project can be planned in a sprint: the
+// sprint must belong to the same project and must not be closed
+func (s *TaskService) checkSprint(ctx context.Context, projectID, sprintID int) error {
+	if projectID == 0 {
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Only tasks of a project can be planned in a sprint",
+		}
+	}
+	row, err := s.Client.Sprint.Get(ctx, sprintID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Sprint not found",
+			}
+		}
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch sprint",
+		}
+	}
+	if row.ProjectID != projectID {
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "The sprint belongs to another project",
+		}
+	}
+	if row.State == sprint.StateClosed {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "Tasks cannot be added to a closed sprint",
+		}
+	}
+	return nil
+}
+
+// checkMilestone makes sure a milestone belongs to a task's project
+func (s *TaskService) checkMilestone(ctx context.Context, projectID, milestoneID int) error {
+	if projectID == 0 {
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Only tasks of a project can be linked to a milestone",
+		}
+	}
+	exists, err := s.Client.Milestone.Query().
+		Where(milestone.ID(milestoneID)).
+		Where(milestone.ProjectID(projectID)).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch milestone",
+		}
+	}
+	if !exists {
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Milestone not found in the task's project",
+		}
+	}
+	return nil
+}
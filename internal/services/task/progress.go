@@ -2,16 +2,19 @@ package task
 
 import (
 	"context"
+	"log"
 	"net/http"
 
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
 )
 
 // UpdateProgress allows an assigned employee to update task status and progress
 // Only employees who are assigned to the task can update its progress
-func (s *TaskService) UpdateProgress(ctx context.Context, taskID, userID int, input dtos.TaskUpdateProgressInput) (*ent.Task, error) {
+func (s *TaskService) UpdateProgress(ctx context.Context, taskID int, actor Actor, input dtos.TaskUpdateProgressInput) (*ent.Task, error) {
+	userID := actor.UserID
 	// Validate at least one field is provided
 	if input.Status == nil && input.Process == nil {
 		return nil, &ServiceError{
@@ -19,10 +22,16 @@ func (s *TaskService) UpdateProgress(ctx context.Context, taskID, userID int, in
 			Msg:    "At least one field (status or process) must be provided",
 		}
 	}
+	if input.Process != nil && (*input.Process < 0 || *input.Process > 100) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Process must be between 0 and 100",
+		}
+	}
 
 	// Get the task with assignees to check if user is assigned
 	taskEntity, err := s.Client.Task.Query().
-		Where(task.ID(taskID)).
+		Where(task.ID(taskID), task.OrgID(actor.OrgID)).
 		WithAssignees().
 		Only(ctx)
 	if err != nil {
@@ -41,7 +50,7 @@ func (s *TaskService) UpdateProgress(ctx context.Context, taskID, userID int, in
 	// Check if the current user is assigned to this task
 	isAssigned := false
 	for _, assignee := range taskEntity.Edges.Assignees {
-		if assignee.ID == userID {
+		if assignee.ID == actor.EmployeeID {
 			isAssigned = true
 			break
 		}
@@ -54,56 +63,87 @@ func (s *TaskService) UpdateProgress(ctx context.Context, taskID, userID int, in
 		}
 	}
 
-	// Check if task is in a valid status for progress updates
-	if taskEntity.Status == task.StatusNotReceived {
+	// A parent task's progress follows its subtasks and it can only be
+	// completed once none of them are open
+	hasChildren, err := s.hasChildren(ctx, taskID)
+	if err != nil {
 		return nil, &ServiceError{
-			Status: http.StatusBadRequest,
-			Msg:    "Task must be received before updating progress. Current status: " + string(taskEntity.Status),
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check subtasks",
 		}
 	}
-
-	if taskEntity.Status == task.StatusCompleted || taskEntity.Status == task.StatusCancelled {
+	if hasChildren && input.Process != nil {
 		return nil, &ServiceError{
 			Status: http.StatusBadRequest,
-			Msg:    "Cannot update progress for completed or cancelled tasks. Current status: " + string(taskEntity.Status),
+			Msg:    "Process of a task with subtasks is computed from its subtasks",
 		}
 	}
 
-	taskUpdate := s.Client.Task.UpdateOneID(taskID).SetUpdaterID(userID)
-
-	// Validate and set status if provided
-	if input.Status != nil {
-		switch *input.Status {
-		case string(task.StatusInProgress),
-			string(task.StatusCompleted),
-			string(task.StatusCancelled):
-			taskUpdate.SetStatus(task.Status(*input.Status))
-		default:
+	// Work out the target status: the requested one, or the one implied by
+	// the new process, then let the workflow validate the move
+	def, err := s.workflowOf(ctx, taskEntity.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	current := def.Remap(workflow.StatusKey(taskEntity), string(taskEntity.Status))
+	inProgress := func(st *workflow.Status) bool {
+		return st.SystemStatus == string(task.StatusInProgress)
+	}
+	var next *workflow.Status
+	switch {
+	case input.Status != nil:
+		next, err = resolveStatus(def, *input.Status)
+		if err != nil {
+			return nil, err
+		}
+	case input.Process == nil:
+	case *input.Process == 100:
+		if current.SystemStatus == string(task.StatusCompleted) {
+			break
+		}
+		var ok bool
+		next, ok = def.Next(current.Key, func(st *workflow.Status) bool {
+			return st.SystemStatus == string(task.StatusCompleted)
+		})
+		if !ok {
 			return nil, &ServiceError{
-				Status: http.StatusBadRequest,
-				Msg:    "Invalid status value. Valid values for progress update: in_progress, completed, cancelled",
+				Status: http.StatusConflict,
+				Msg:    "The project workflow does not allow completing the task from '" + current.Name + "'",
 			}
 		}
-	}
-
-	// Validate and set process if provided
-	if input.Process != nil {
-		if *input.Process < 0 || *input.Process > 100 {
+	case current.SystemStatus == string(task.StatusInProgress):
+	case current.SystemStatus == string(task.StatusReceived):
+		// Starting work moves the task into progress when the workflow allows it
+		if *input.Process > 0 {
+			next, _ = def.Next(current.Key, inProgress)
+		}
+	case current.SystemStatus == string(task.StatusNotReceived) && *input.Process == 0:
+	default:
+		// Progress on a task that is not being worked on starts or reopens
+		// it, as far as the workflow allows
+		var ok bool
+		next, ok = def.Next(current.Key, inProgress)
+		if !ok {
 			return nil, &ServiceError{
-				Status: http.StatusBadRequest,
-				Msg:    "Process must be between 0 and 100",
+				Status: http.StatusConflict,
+				Msg:    "The project workflow does not allow moving the task from '" + current.Name + "' into progress",
 			}
 		}
-		taskUpdate.SetProcess(*input.Process)
-
-		// Auto-update status based on process
-		if *input.Process == 100 && input.Status == nil {
-			taskUpdate.SetStatus(task.StatusCompleted)
-		} else if *input.Process > 0 && *input.Process < 100 && input.Status == nil && taskEntity.Status == task.StatusReceived {
-			taskUpdate.SetStatus(task.StatusInProgress)
+	}
+	if next != nil {
+		if err := s.checkTransition(ctx, def, taskEntity, next, actor); err != nil {
+			return nil, err
 		}
 	}
 
+	taskUpdate := s.Client.Task.UpdateOneID(taskID).SetUpdaterID(userID)
+	if next != nil {
+		setStatus(taskUpdate, taskEntity, next)
+	}
+	if input.Process != nil {
+		taskUpdate.SetProcess(*input.Process)
+	}
+
 	updatedTask, err := taskUpdate.Save(ctx)
 	if err != nil {
 		return nil, &ServiceError{
@@ -111,6 +151,12 @@ func (s *TaskService) UpdateProgress(ctx context.Context, taskID, userID int, in
 			Msg:    "Failed to update task progress",
 		}
 	}
+	s.afterChange(ctx, updatedTask, actor, taskChanges(taskEntity, updatedTask)...)
+
+	if err := s.RollUpProgress(ctx, updatedTask.ParentID); err != nil {
+		log.Printf("Failed to roll up progress for task %d: %v", updatedTask.ParentID, err)
+	}
+	s.RollUpProjects(ctx, updatedTask.ProjectID)
 
 	// Get the updated task with all edges
 	taskWithEdges, err := s.Client.Task.Query().
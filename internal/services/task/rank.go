@@ -0,0 +1,99 @@
+package task
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+)
+
+// Ranks are base-36 strings compared lexicographically, so a task can always
+// be placed between two others by only rewriting its own rank
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// maxRankLength is the length past which a column is re-ranked, which keeps
+// ranks short after many moves into the same spot
+const maxRankLength = 32
+
+// rankBetween returns a rank strictly between prev and next. An empty prev
+// means the start of the column and an empty next its end. It reports false
+// when prev does not sort before next.
+//
+// Ranks never end in "0", which keeps room before every rank. Appending and
+// prepending step by one digit instead of halving, so a column filled from
+// one end grows its ranks slowly.
+func rankBetween(prev, next string) (string, bool) {
+	if next != "" && prev >= next {
+		return "", false
+	}
+
+	var rank []byte
+	for i := 0; ; i++ {
+		lo := 0
+		if i < len(prev) {
+			lo = strings.IndexByte(rankDigits, prev[i])
+		}
+		hi := len(rankDigits)
+		if next != "" && i < len(next) {
+			hi = strings.IndexByte(rankDigits, next[i])
+		}
+		if lo < 0 || hi < 0 {
+			return "", false
+		}
+
+		switch {
+		case lo == hi:
+			rank = append(rank, rankDigits[lo])
+		case hi-lo > 1:
+			digit := (lo + hi) / 2
+			switch {
+			case next == "" && i < len(prev):
+				digit = lo + 1
+			case next != "" && i >= len(prev):
+				digit = hi - 1
+			}
+			return string(append(rank, rankDigits[digit])), true
+		default:
+			// Adjacent digits: keep prev's digit, anything after it sorts
+			// before next
+			rank = append(rank, rankDigits[lo])
+			next = ""
+		}
+	}
+}
+
+// spreadRanks returns n evenly spaced ranks, used to re-rank a whole column
+func spreadRanks(n int) []string {
+	width, space := 2, len(rankDigits)*len(rankDigits)
+	for space/(n+1) < len(rankDigits) {
+		width++
+		space *= len(rankDigits)
+	}
+	step := space / (n + 1)
+
+	ranks := make([]string, n)
+	for i := range ranks {
+		v := (i + 1) * step
+		b := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			b[j] = rankDigits[v%len(rankDigits)]
+			v /= len(rankDigits)
+		}
+		ranks[i] = strings.TrimRight(string(b), "0")
+	}
+	return ranks
+}
+
+// sortByRank orders tasks by rank; unranked tasks come last, oldest first
+func sortByRank(tasks []*ent.Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if (a.Rank == "") != (b.Rank == "") {
+			return b.Rank == ""
+		}
+		if a.Rank != b.Rank {
+			return a.Rank < b.Rank
+		}
+		return a.ID < b.ID
+	})
+}
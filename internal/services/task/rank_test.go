@@ -0,0 +1,137 @@
+package task
+
+import (
+	"strings"
+	"testing"
+)
+
+// checkRank fails unless rank is a valid rank strictly between prev and next
+func checkRank(t *testing.T, prev, next, rank string) {
+	t.Helper()
+	if rank == "" || strings.Trim(rank, rankDigits) != "" {
+		t.Fatalf("rankBetween(%q, %q) = %q, not a rank", prev, next, rank)
+	}
+	if strings.HasSuffix(rank, "0") {
+		t.Fatalf("rankBetween(%q, %q) = %q, ends in 0", prev, next, rank)
+	}
+	if prev != "" && rank <= prev {
+		t.Fatalf("rankBetween(%q, %q) = %q, not after prev", prev, next, rank)
+	}
+	if next != "" && rank >= next {
+		t.Fatalf("rankBetween(%q, %q) = %q, not before next", prev, next, rank)
+	}
+}
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name       string
+		prev, next string
+		wantOK     bool
+	}{
+		{name: "empty column", wantOK: true},
+		{name: "start of column", next: "i", wantOK: true},
+		{name: "before the smallest digit", next: "1", wantOK: true},
+		{name: "before a long rank", next: "0001", wantOK: true},
+		{name: "end of column", prev: "i", wantOK: true},
+		{name: "after the largest digit", prev: "z", wantOK: true},
+		{name: "after a long rank", prev: "zzzz", wantOK: true},
+		{name: "between distant ranks", prev: "a", next: "m", wantOK: true},
+		{name: "between adjacent digits", prev: "a", next: "b", wantOK: true},
+		{name: "between a rank and its extension", prev: "a", next: "a1", wantOK: true},
+		{name: "between ranks of different length", prev: "az", next: "b", wantOK: true},
+		{name: "between a rank and a longer one", prev: "a", next: "b01", wantOK: true},
+		{name: "sharing a long prefix", prev: "abcdx", next: "abcdy", wantOK: true},
+		{name: "equal ranks", prev: "a", next: "a"},
+		{name: "reversed ranks", prev: "b", next: "a"},
+		{name: "invalid digit in prev", prev: "A"},
+		{name: "invalid digit in next", next: "B"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, ok := rankBetween(tt.prev, tt.next)
+			if ok != tt.wantOK {
+				t.Fatalf("rankBetween(%q, %q) ok = %v, want %v", tt.prev, tt.next, ok, tt.wantOK)
+			}
+			if ok {
+				checkRank(t, tt.prev, tt.next, rank)
+			}
+		})
+	}
+}
+
+func TestRankBetweenRepeated(t *testing.T) {
+	// Repeated moves into the same spot grow ranks by a digit at most every
+	// 16 moves, against every 5 or so when halving, until the column is
+	// re-ranked past maxRankLength
+	const moves = 1000
+	tests := []struct {
+		name       string
+		prev, next string
+		// step returns the bounds of the next move from the bounds of the
+		// previous one and the rank it gave
+		step func(prev, next, rank string) (string, string)
+	}{
+		{
+			name: "append",
+			prev: "i",
+			step: func(prev, next, rank string) (string, string) { return rank, "" },
+		},
+		{
+			name: "prepend",
+			next: "i",
+			step: func(prev, next, rank string) (string, string) { return "", rank },
+		},
+		{
+			name: "insert right after a task",
+			prev: "i", next: "j",
+			step: func(prev, next, rank string) (string, string) { return prev, rank },
+		},
+		{
+			name: "insert right before a task",
+			prev: "i", next: "j",
+			step: func(prev, next, rank string) (string, string) { return rank, next },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, next, rank := tt.prev, tt.next, ""
+			for i := 0; i < moves; i++ {
+				var ok bool
+				if rank, ok = rankBetween(prev, next); !ok {
+					t.Fatalf("move %d: rankBetween(%q, %q) failed", i, prev, next)
+				}
+				checkRank(t, prev, next, rank)
+				prev, next = tt.step(prev, next, rank)
+			}
+			if len(rank) > moves/16 {
+				t.Fatalf("rank grew to %d digits in %d moves", len(rank), moves)
+			}
+		})
+	}
+}
+
+func TestSpreadRanks(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 10, 35, 36, 100, 1295, 1296, 5000, 50000} {
+		ranks := spreadRanks(n)
+		if len(ranks) != n {
+			t.Fatalf("spreadRanks(%d) returned %d ranks", n, len(ranks))
+		}
+		for i, rank := range ranks {
+			if len(rank) > maxRankLength {
+				t.Fatalf("spreadRanks(%d)[%d] = %q, longer than %d", n, i, rank, maxRankLength)
+			}
+			prev := ""
+			if i > 0 {
+				prev = ranks[i-1]
+			}
+			// Every rank sorts after the previous one and can be ranked
+			// after by rankBetween
+			checkRank(t, prev, "", rank)
+			if i > 0 {
+				if _, ok := rankBetween(prev, rank); !ok {
+					t.Fatalf("spreadRanks(%d): no room between %q and %q", n, prev, rank)
+				}
+			}
+		}
+	}
+}
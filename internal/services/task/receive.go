@@ -6,15 +6,17 @@ import (
 
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
 )
 
 // ReceiveTask allows an assigned employee to receive/accept a task
 // Only employees who are assigned to the task can receive it
-// This changes the task status from "not_received" to "received"
-func (s *TaskService) ReceiveTask(ctx context.Context, taskID, userID int) (*ent.Task, error) {
+// This moves the task to the first received status its project workflow
+// allows from the current one
+func (s *TaskService) ReceiveTask(ctx context.Context, taskID int, actor Actor) (*ent.Task, error) {
 	// Get the task with assignees to check if user is assigned
 	taskEntity, err := s.Client.Task.Query().
-		Where(task.ID(taskID)).
+		Where(task.ID(taskID), task.OrgID(actor.OrgID)).
 		WithAssignees().
 		Only(ctx)
 	if err != nil {
@@ -33,7 +35,7 @@ func (s *TaskService) ReceiveTask(ctx context.Context, taskID, userID int) (*ent
 	// Check if the current user is assigned to this task
 	isAssigned := false
 	for _, assignee := range taskEntity.Edges.Assignees {
-		if assignee.ID == userID {
+		if assignee.ID == actor.EmployeeID {
 			isAssigned = true
 			break
 		}
@@ -46,18 +48,28 @@ func (s *TaskService) ReceiveTask(ctx context.Context, taskID, userID int) (*ent
 		}
 	}
 
-	// Check if task is in the correct status to be received
-	if taskEntity.Status != task.StatusNotReceived {
+	// Which statuses a task can be received from is up to its project workflow
+	def, err := s.workflowOf(ctx, taskEntity.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	current := def.Remap(workflow.StatusKey(taskEntity), string(taskEntity.Status))
+	next, ok := def.Next(current.Key, func(st *workflow.Status) bool {
+		return st.SystemStatus == string(task.StatusReceived)
+	})
+	if !ok {
 		return nil, &ServiceError{
-			Status: http.StatusBadRequest,
-			Msg:    "Task can only be received when status is 'not_received'. Current status: " + string(taskEntity.Status),
+			Status: http.StatusConflict,
+			Msg:    "The project workflow does not allow receiving the task from '" + current.Name + "'",
 		}
 	}
+	// The workflow also refuses blocked tasks until their blockers are completed
+	if err := s.checkTransition(ctx, def, taskEntity, next, actor); err != nil {
+		return nil, err
+	}
 
-	// Update task status to "received"
-	updatedTask, err := s.Client.Task.UpdateOneID(taskID).
-		SetStatus(task.StatusReceived).
-		SetUpdaterID(userID).
+	updatedTask, err := setStatus(s.Client.Task.UpdateOneID(taskID), taskEntity, next).
+		SetUpdaterID(actor.UserID).
 		Save(ctx)
 	if err != nil {
 		return nil, &ServiceError{
@@ -65,6 +77,8 @@ func (s *TaskService) ReceiveTask(ctx context.Context, taskID, userID int) (*ent
 			Msg:    "Failed to update task status",
 		}
 	}
+	s.afterChange(ctx, updatedTask, actor, taskChanges(taskEntity, updatedTask)...)
+	s.RollUpProjects(ctx, updatedTask.ProjectID)
 
 	// Get the updated task with all edges
 	taskWithEdges, err := s.Client.Task.Query().
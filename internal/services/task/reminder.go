@@ -0,0 +1,306 @@
+package task
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/remindersetting"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreminder"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/kafka"
+	notificationService "github.com/longgggwwww/hrm-ms-hr/internal/services/notification"
+)
+
+// ReminderSettings returns the task reminder schedule of an organization,
+// the defaults if it never changed it
+func (s *TaskService) ReminderSettings(ctx context.Context, orgID int) (*ent.ReminderSetting, error) {
+	setting, err := s.Client.ReminderSetting.Query().
+		Where(remindersetting.OrgID(orgID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return defaultReminderSetting(orgID), nil
+	}
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch reminder settings",
+		}
+	}
+	return setting, nil
+}
+
+// UpdateReminderSettings changes the task reminder schedule of an organization
+func (s *TaskService) UpdateReminderSettings(ctx context.Context, orgID int, input dtos.ReminderSettingsInput) (*ent.ReminderSetting, error) {
+	current, err := s.ReminderSettings(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if input.DueSoonEnabled != nil {
+		current.DueSoonEnabled = *input.DueSoonEnabled
+	}
+	if input.DueSoonHours != nil {
+		current.DueSoonHours = *input.DueSoonHours
+	}
+	if input.OverdueEnabled != nil {
+		current.OverdueEnabled = *input.OverdueEnabled
+	}
+	if input.OverdueRepeatHours != nil {
+		current.OverdueRepeatHours = *input.OverdueRepeatHours
+	}
+
+	err = s.Client.ReminderSetting.Create().
+		SetOrgID(orgID).
+		SetDueSoonEnabled(current.DueSoonEnabled).
+		SetDueSoonHours(current.DueSoonHours).
+		SetOverdueEnabled(current.OverdueEnabled).
+		SetOverdueRepeatHours(current.OverdueRepeatHours).
+		OnConflictColumns(remindersetting.FieldOrgID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to save reminder settings",
+		}
+	}
+	return s.ReminderSettings(ctx, orgID)
+}
+
+func defaultReminderSetting(orgID int) *ent.ReminderSetting {
+	return &ent.ReminderSetting{
+		OrgID:              orgID,
+		DueSoonEnabled:     remindersetting.DefaultDueSoonEnabled,
+		DueSoonHours:       remindersetting.DefaultDueSoonHours,
+		OverdueEnabled:     remindersetting.DefaultOverdueEnabled,
+		OverdueRepeatHours: remindersetting.DefaultOverdueRepeatHours,
+	}
+}
+
+// reminderBatch is how many tasks SendReminders loads at a time
+const reminderBatch = 200
+
+// SendReminders reminds the assignees of open tasks coming due or overdue, on
+// the schedule of their organization, and returns how many reminders it sent.
+//
+// Each reminder is claimed by inserting its TaskReminder row, which is unique
+// per task, kind, due date and overdue repeat, so it is sent once however
+// often this runs, and again when the due date moves.
+func (s *TaskService) SendReminders(ctx context.Context, now time.Time) (int, error) {
+	settings, err := s.Client.ReminderSetting.Query().All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	byOrg := make(map[int]*ent.ReminderSetting, len(settings))
+	for _, setting := range settings {
+		byOrg[setting.OrgID] = setting
+	}
+	candidates, ok := reminderCandidates(settings, now)
+	if !ok {
+		return 0, nil
+	}
+
+	sent, lastID := 0, 0
+	for {
+		tasks, err := s.Client.Task.Query().
+			Where(
+				candidates,
+				task.IDGT(lastID),
+				task.StatusNotIn(task.StatusCompleted, task.StatusCancelled),
+				task.HasAssignees(),
+			).
+			WithAssignees(func(q *ent.EmployeeQuery) { q.Select(employee.FieldID) }).
+			Order(ent.Asc(task.FieldID)).
+			Limit(reminderBatch).
+			All(ctx)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, t := range tasks {
+			setting, ok := byOrg[t.OrgID]
+			if !ok {
+				setting = defaultReminderSetting(t.OrgID)
+			}
+			kind, seq, due := reminderDue(setting, *t.DueDate, now)
+			if !due {
+				continue
+			}
+
+			err := s.Client.TaskReminder.Create().
+				SetTaskID(t.ID).
+				SetOrgID(t.OrgID).
+				SetKind(kind).
+				SetDueDate(*t.DueDate).
+				SetSeq(seq).
+				Exec(ctx)
+			if ent.IsConstraintError(err) {
+				// Already sent
+				continue
+			}
+			if err != nil {
+				log.Printf("Failed to claim %s reminder of task %d: %v", kind, t.ID, err)
+				continue
+			}
+
+			s.sendReminder(ctx, t, kind, seq)
+			sent++
+		}
+
+		if len(tasks) < reminderBatch {
+			return sent, nil
+		}
+		lastID = tasks[len(tasks)-1].ID
+	}
+}
+
+// reminderCandidates selects the tasks that may have a reminder due: tasks
+// coming due within the window of their organization that were not reminded
+// of their due date, and overdue tasks that were not reminded within the
+// repeat interval of their organization, or ever when it does not repeat.
+// It reports false when no organization sends reminders.
+func reminderCandidates(settings []*ent.ReminderSetting, now time.Time) (predicate.Task, bool) {
+	// Organizations are grouped by their due-soon window and overdue repeat
+	// interval, so the query grows with the distinct schedules only
+	dueSoon := make(map[int][]int)
+	overdue := make(map[int][]int)
+	configured := make([]int, len(settings))
+	for i, setting := range settings {
+		configured[i] = setting.OrgID
+		if setting.DueSoonEnabled {
+			dueSoon[setting.DueSoonHours] = append(dueSoon[setting.DueSoonHours], setting.OrgID)
+		}
+		if setting.OverdueEnabled {
+			overdue[setting.OverdueRepeatHours] = append(overdue[setting.OverdueRepeatHours], setting.OrgID)
+		}
+	}
+
+	var soon, late []predicate.Task
+	dueWithin := func(orgs predicate.Task, hours int) predicate.Task {
+		return task.And(orgs, task.DueDateLTE(now.Add(time.Duration(hours)*time.Hour)))
+	}
+	notRemindedSince := func(orgs predicate.Task, repeatHours int) predicate.Task {
+		since := time.Time{}
+		if repeatHours > 0 {
+			since = now.Add(-time.Duration(repeatHours) * time.Hour)
+		}
+		return task.And(orgs, notReminded(taskreminder.KindOverdue, since))
+	}
+	for hours, orgs := range dueSoon {
+		soon = append(soon, dueWithin(task.OrgIDIn(orgs...), hours))
+	}
+	for hours, orgs := range overdue {
+		late = append(late, notRemindedSince(task.OrgIDIn(orgs...), hours))
+	}
+
+	// Organizations without settings use the defaults
+	defaults := defaultReminderSetting(0)
+	if defaults.DueSoonEnabled {
+		soon = append(soon, dueWithin(task.OrgIDNotIn(configured...), defaults.DueSoonHours))
+	}
+	if defaults.OverdueEnabled {
+		late = append(late, notRemindedSince(task.OrgIDNotIn(configured...), defaults.OverdueRepeatHours))
+	}
+
+	var branches []predicate.Task
+	if len(soon) > 0 {
+		branches = append(branches, task.And(
+			task.DueDateGT(now),
+			task.Or(soon...),
+			notReminded(taskreminder.KindDueSoon, time.Time{}),
+		))
+	}
+	if len(late) > 0 {
+		branches = append(branches, task.And(
+			task.DueDateLTE(now),
+			task.Or(late...),
+		))
+	}
+	if len(branches) == 0 {
+		return nil, false
+	}
+	return task.And(task.DueDateNotNil(), task.Or(branches...)), true
+}
+
+// notReminded selects tasks without a reminder of a kind for their current
+// due date, sent after a time unless it is zero
+func notReminded(kind taskreminder.Kind, since time.Time) predicate.Task {
+	return func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(taskreminder.Table)
+		conds := []*sql.Predicate{
+			sql.ColumnsEQ(t.C(taskreminder.FieldTaskID), s.C(task.FieldID)),
+			sql.ColumnsEQ(t.C(taskreminder.FieldDueDate), s.C(task.FieldDueDate)),
+			sql.EQ(t.C(taskreminder.FieldKind), kind),
+		}
+		if !since.IsZero() {
+			conds = append(conds, sql.GT(t.C(taskreminder.FieldCreatedAt), since))
+		}
+		s.Where(sql.NotExists(builder.Select(t.C(taskreminder.FieldID)).From(t).Where(sql.And(conds...))))
+	}
+}
+
+// reminderDue reports which reminder of a task an organization's schedule
+// calls for at a time, if any
+func reminderDue(setting *ent.ReminderSetting, dueDate, now time.Time) (taskreminder.Kind, int, bool) {
+	if dueDate.After(now) {
+		window := time.Duration(setting.DueSoonHours) * time.Hour
+		if !setting.DueSoonEnabled || dueDate.After(now.Add(window)) {
+			return "", 0, false
+		}
+		return taskreminder.KindDueSoon, 0, true
+	}
+
+	if !setting.OverdueEnabled {
+		return "", 0, false
+	}
+	seq := 0
+	if setting.OverdueRepeatHours > 0 {
+		seq = int(now.Sub(dueDate) / (time.Duration(setting.OverdueRepeatHours) * time.Hour))
+	}
+	return taskreminder.KindOverdue, seq, true
+}
+
+// sendReminder notifies the assignees of a task and publishes the reminder
+// event
+func (s *TaskService) sendReminder(ctx context.Context, t *ent.Task, kind taskreminder.Kind, seq int) {
+	assignees := make([]int, 0, len(t.Edges.Assignees))
+	for _, emp := range t.Edges.Assignees {
+		assignees = append(assignees, emp.ID)
+	}
+
+	notice := notificationService.Notice{
+		OrgID:        t.OrgID,
+		RecipientIDs: assignees,
+		Body:         t.Name,
+		TaskID:       t.ID,
+		DedupKey: string(kind) + ":" + strconv.Itoa(t.ID) + ":" +
+			strconv.FormatInt(t.DueDate.Unix(), 10) + ":" + strconv.Itoa(seq),
+	}
+	eventType := kafka.TaskDueSoon
+	if kind == taskreminder.KindOverdue {
+		notice.Type = notification.TypeTaskOverdue
+		notice.Title = t.Code + " is overdue since " + t.DueDate.UTC().Format(time.RFC3339)
+		eventType = kafka.TaskOverdue
+	} else {
+		notice.Type = notification.TypeTaskDueSoon
+		notice.Title = t.Code + " is due " + t.DueDate.UTC().Format(time.RFC3339)
+	}
+	s.notifications().Notify(ctx, notice)
+
+	if s.KafkaClient == nil {
+		return
+	}
+	event := kafka.NewTaskReminderEvent(eventType, t, seq)
+	if err := s.KafkaClient.PublishEvent(ctx, "task-events", strconv.Itoa(t.ID), event); err != nil {
+		log.Printf("Failed to publish task reminder event: %v", err)
+	}
+}
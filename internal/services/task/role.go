@@ -0,0 +1,71 @@
+package task
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	projectService "github.com/longgggwwww/hrm-ms-hr/internal/services/project"
+)
+
+// authorizeProject checks that the actor's role in a project of their
+// organization allows an action
+func (s *TaskService) authorizeProject(ctx context.Context, projectID int, actor Actor, action projectService.Action, msg string) error {
+	exists, err := s.Client.Project.Query().
+		Where(project.ID(projectID), project.OrgID(actor.OrgID)).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate project membership",
+		}
+	}
+	if !exists {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Project not found",
+		}
+	}
+
+	role, err := projectService.MemberRole(ctx, s.Client, projectID, actor.EmployeeID)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to validate project membership",
+		}
+	}
+	if role == "" {
+		return &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "You are not a member of this project",
+		}
+	}
+	if !projectService.Allows(role, action) {
+		return &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    msg,
+		}
+	}
+	return nil
+}
+
+// authorizeAssign checks that the actor may change the assignees of a task in
+// a project. Anyone who can create tasks may assign or unassign themselves;
+// assigning others takes a role that allows it.
+func (s *TaskService) authorizeAssign(ctx context.Context, projectID int, actor Actor, before, after []int) error {
+	if projectID == 0 {
+		return nil
+	}
+	changes := edgeChanges(before, after, "", "")
+	if len(changes) == 0 {
+		return nil
+	}
+	action := projectService.ActionCreateTask
+	for _, c := range changes {
+		if c.RefID != actor.EmployeeID {
+			action = projectService.ActionAssignTask
+			break
+		}
+	}
+	return s.authorizeProject(ctx, projectID, actor, action, "Only project owners and managers can assign tasks to others")
+}
@@ -1,6 +1,12 @@
 package task
 
 import (
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/internal/kafka"
 )
@@ -33,3 +39,27 @@ func NewTaskService(client *ent.Client) *TaskService {
 func (s *TaskService) SetKafkaClient(kafkaClient *kafka.KafkaClient) {
 	s.KafkaClient = kafkaClient
 }
+
+// encodeCursor encodes cursor data to base64 and signs it, so a client
+// cannot make up its own position
+func (s *TaskService) encodeCursor(data map[string]interface{}) string {
+	jsonData, _ := json.Marshal(data)
+	encoded := base64.RawURLEncoding.EncodeToString(jsonData)
+	return encoded + "." + signCursor(encoded)
+}
+
+// decodeCursor verifies a cursor made by encodeCursor and decodes its data
+func (s *TaskService) decodeCursor(cursor string) (map[string]interface{}, error) {
+	encoded, signature, ok := strings.Cut(cursor, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(signCursor(encoded))) {
+		return nil, errors.New("cursor signature mismatch")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	err = json.Unmarshal(data, &result)
+	return result, err
+}
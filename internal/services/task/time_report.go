@@ -0,0 +1,138 @@
+package task
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// WeeklyTime sums the time logged by employees of the organization per week,
+// grouped by task, project or employee. Running timers are not counted.
+func (s *TaskService) WeeklyTime(ctx context.Context, orgID int, query dtos.WorkLogWeeklyQuery) (*dtos.WorkLogWeeklyResponse, error) {
+	groupBy := query.GroupBy
+	if groupBy == "" {
+		groupBy = "employee"
+	}
+
+	to := time.Now().In(time.Local)
+	if query.To != "" {
+		t, err := time.ParseInLocation("2006-01-02", query.To, time.Local)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid to format, must be YYYY-MM-DD",
+			}
+		}
+		to = t
+	}
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.Local)
+	from := WeekStart(to).AddDate(0, 0, -21)
+	if query.From != "" {
+		f, err := time.ParseInLocation("2006-01-02", query.From, time.Local)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid from format, must be YYYY-MM-DD",
+			}
+		}
+		from = f
+	}
+	if from.After(to) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "from must not be after to",
+		}
+	}
+	if to.Sub(from) > 366*24*time.Hour {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "The range cannot exceed one year",
+		}
+	}
+
+	logQuery := s.Client.WorkLog.Query().
+		Where(worklog.EndedAtNotNil()).
+		Where(worklog.StartedAtGTE(from)).
+		Where(worklog.StartedAtLT(to.AddDate(0, 0, 1))).
+		Where(worklog.HasEmployeeWith(employee.OrgID(orgID)))
+	if query.TaskID > 0 {
+		logQuery = logQuery.Where(worklog.TaskID(query.TaskID))
+	}
+	if query.ProjectID > 0 {
+		logQuery = logQuery.Where(worklog.HasTaskWith(task.ProjectID(query.ProjectID)))
+	}
+	if query.EmployeeID > 0 {
+		logQuery = logQuery.Where(worklog.EmployeeID(query.EmployeeID))
+	}
+
+	logs, err := logQuery.
+		WithTask(func(q *ent.TaskQuery) {
+			q.Select(task.FieldID, task.FieldProjectID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch work logs",
+		}
+	}
+
+	type bucket struct {
+		week time.Time
+		id   int
+	}
+	sums := make(map[bucket]int)
+	total := 0
+	for _, l := range logs {
+		id := l.EmployeeID
+		switch groupBy {
+		case "task":
+			id = l.TaskID
+		case "project":
+			// Tasks outside a project are reported under ID 0
+			id = 0
+			if l.Edges.Task != nil {
+				id = l.Edges.Task.ProjectID
+			}
+		}
+		sums[bucket{week: WeekStart(l.StartedAt), id: id}] += l.Duration
+		total += l.Duration
+	}
+
+	rows := make([]dtos.WorkLogWeeklyRow, 0, len(sums))
+	for b, minutes := range sums {
+		rows = append(rows, dtos.WorkLogWeeklyRow{
+			WeekStart: b.week.Format("2006-01-02"),
+			ID:        b.id,
+			Minutes:   minutes,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].WeekStart != rows[j].WeekStart {
+			return rows[i].WeekStart < rows[j].WeekStart
+		}
+		return rows[i].ID < rows[j].ID
+	})
+
+	return &dtos.WorkLogWeeklyResponse{
+		GroupBy:      groupBy,
+		From:         from.Format("2006-01-02"),
+		To:           to.Format("2006-01-02"),
+		Data:         rows,
+		TotalMinutes: total,
+	}, nil
+}
+
+// WeekStart returns the Monday starting the week of t, in local time
+func WeekStart(t time.Time) time.Time {
+	t = t.In(time.Local)
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.Local)
+}
@@ -9,41 +9,87 @@ import (
 
 	"github.com/longgggwwww/hrm-ms-hr/ent"
 	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/label"
 	"github.com/longgggwwww/hrm-ms-hr/ent/task"
 	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
 	"github.com/longgggwwww/hrm-ms-hr/internal/kafka"
+	projectService "github.com/longgggwwww/hrm-ms-hr/internal/services/project"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
 )
 
-// Update updates an existing task
-func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.TaskUpdateInput) (*ent.Task, error) {
+// Update updates an existing task. Status changes go through the workflow of
+// the task's project, and a task moved to another project takes its subtasks
+// along.
+func (s *TaskService) Update(ctx context.Context, id int, actor Actor, input dtos.TaskUpdateInput) (*ent.Task, error) {
+	userID := actor.UserID
+	current, err := s.getTask(ctx, actor.OrgID, id)
+	if err != nil {
+		return nil, err
+	}
+	// Assignees and labels before the change, for the activity stream
+	if input.AssigneeIDs != nil || input.LabelIDs != nil {
+		current, err = s.Client.Task.Query().
+			Where(task.ID(id)).
+			WithAssignees(func(q *ent.EmployeeQuery) { q.Select(employee.FieldID) }).
+			WithLabels(func(q *ent.LabelQuery) { q.Select(label.FieldID) }).
+			Only(ctx)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to fetch task",
+			}
+		}
+	}
+
+	// Moving a task, with its subtasks, and changing its assignees are checked
+	// against the actor's project roles
+	moving := input.ProjectID != nil && *input.ProjectID != current.ProjectID
+	var subtasks []*ent.Task
+	if moving {
+		if *input.ProjectID != 0 {
+			if err := s.authorizeProject(ctx, *input.ProjectID, actor, projectService.ActionCreateTask, "Your project role does not allow moving tasks into this project"); err != nil {
+				return nil, err
+			}
+		}
+		if subtasks, err = s.subtasksOf(ctx, id); err != nil {
+			return nil, err
+		}
+		if err := s.authorizeMoveOut(ctx, actor, *input.ProjectID, append(subtasks, current)...); err != nil {
+			return nil, err
+		}
+	}
+	if input.AssigneeIDs != nil {
+		projectID := current.ProjectID
+		if input.ProjectID != nil {
+			projectID = *input.ProjectID
+		}
+		var before []int
+		for _, emp := range current.Edges.Assignees {
+			before = append(before, emp.ID)
+		}
+		if err := s.authorizeAssign(ctx, projectID, actor, before, input.AssigneeIDs); err != nil {
+			return nil, err
+		}
+	}
+
 	taskUpdate := s.Client.Task.UpdateOneID(id).SetUpdaterID(userID)
 
 	if input.Name != nil {
 		taskUpdate.SetName(*input.Name)
 	}
 
-	// Handle code update with auto-generation if needed
+	// A custom code must be unique in the organization; an empty one
+	// allocates a new code from the task's project or organization
+	var taskCode string
+	var generated bool
+	codeProjectID := current.ProjectID
+	if input.ProjectID != nil {
+		codeProjectID = *input.ProjectID
+	}
 	if input.Code != nil {
-		var taskCode string
-		if *input.Code != "" {
-			taskCode = *input.Code
-
-			// Check if the new code already exists (excluding current task)
-			var existsQuery *ent.TaskQuery
-			if input.ProjectID != nil {
-				// Check uniqueness within the project
-				existsQuery = s.Client.Task.Query().
-					Where(task.CodeEQ(taskCode)).
-					Where(task.ProjectIDEQ(*input.ProjectID)).
-					Where(task.IDNEQ(id))
-			} else {
-				// Check global uniqueness if no project
-				existsQuery = s.Client.Task.Query().
-					Where(task.CodeEQ(taskCode)).
-					Where(task.IDNEQ(id))
-			}
-
-			exists, err := existsQuery.Exist(ctx)
+		taskCode = *input.Code
+		if taskCode != "" {
+			exists, err := s.codeTaken(ctx, actor.OrgID, taskCode, id)
 			if err != nil {
 				return nil, &ServiceError{
 					Status: http.StatusInternalServerError,
@@ -52,44 +98,18 @@ func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.Tas
 			}
 			if exists {
 				return nil, &ServiceError{
-					Status: http.StatusBadRequest,
+					Status: http.StatusConflict,
 					Msg:    "Task code already exists",
 				}
 			}
 		} else {
-			// Auto-generate code in GitHub-style format: #x
-			// Get the total count of tasks to generate the next sequence number
-			count, err := s.Client.Task.Query().
-				Where(task.IDNEQ(id)). // Exclude current task
-				Count(ctx)
-			if err != nil {
+			generated = true
+			if taskCode, err = s.nextCode(ctx, actor.OrgID, codeProjectID); err != nil {
 				return nil, &ServiceError{
 					Status: http.StatusInternalServerError,
 					Msg:    "Failed to generate task code",
 				}
 			}
-
-			sequence := count + 1
-			taskCode = "#" + strconv.Itoa(sequence)
-
-			// Double-check uniqueness (in case of concurrent requests)
-			for {
-				exists, err := s.Client.Task.Query().
-					Where(task.CodeEQ(taskCode)).
-					Where(task.IDNEQ(id)).
-					Exist(ctx)
-				if err != nil {
-					return nil, &ServiceError{
-						Status: http.StatusInternalServerError,
-						Msg:    "Failed to validate generated task code",
-					}
-				}
-				if !exists {
-					break
-				}
-				sequence++
-				taskCode = "#" + strconv.Itoa(sequence)
-			}
 		}
 		taskUpdate.SetCode(taskCode)
 	}
@@ -98,8 +118,32 @@ func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.Tas
 		taskUpdate.SetDescription(*input.Description)
 	}
 	if input.Process != nil {
+		// The progress of a parent task is rolled up from its subtasks
+		hasChildren, err := s.hasChildren(ctx, id)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to check subtasks",
+			}
+		}
+		if hasChildren {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Process of a task with subtasks is computed from its subtasks",
+			}
+		}
 		taskUpdate.SetProcess(*input.Process)
 	}
+	if input.OriginalEstimate != nil {
+		taskUpdate.SetOriginalEstimate(*input.OriginalEstimate)
+		// A first estimate also sets the remaining work
+		if input.RemainingEstimate == nil && current.RemainingEstimate == nil {
+			taskUpdate.SetRemainingEstimate(*input.OriginalEstimate)
+		}
+	}
+	if input.RemainingEstimate != nil {
+		taskUpdate.SetRemainingEstimate(*input.RemainingEstimate)
+	}
 	if input.StartAt != nil {
 		startAt, err := time.Parse(time.RFC3339, *input.StartAt)
 		if err != nil {
@@ -123,19 +167,72 @@ func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.Tas
 	if input.ProjectID != nil {
 		taskUpdate.SetProjectID(*input.ProjectID)
 	}
-	if input.Status != nil {
-		switch *input.Status {
-		case string(task.StatusNotReceived),
-			string(task.StatusReceived),
-			string(task.StatusInProgress),
-			string(task.StatusCompleted),
-			string(task.StatusCancelled):
-			taskUpdate.SetStatus(task.Status(*input.Status))
-		default:
-			return nil, &ServiceError{
-				Status: http.StatusBadRequest,
-				Msg:    "Invalid status value. Valid values: not_received, received, in_progress, completed, cancelled",
+	if input.ParentID != nil {
+		if *input.ParentID == 0 {
+			taskUpdate.ClearParentID()
+		} else {
+			parent, err := s.validateParent(ctx, actor.OrgID, id, *input.ParentID)
+			if err != nil {
+				return nil, err
+			}
+			projectID := current.ProjectID
+			if input.ProjectID != nil {
+				projectID = *input.ProjectID
 			}
+			if parent.ProjectID != 0 && parent.ProjectID != projectID {
+				return nil, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "A subtask must belong to the same project as its parent",
+				}
+			}
+			taskUpdate.SetParentID(*input.ParentID)
+		}
+	}
+	// Moving to another project keeps the closest status of its workflow
+	targetProjectID := current.ProjectID
+	if input.ProjectID != nil {
+		targetProjectID = *input.ProjectID
+	}
+	if input.Status != nil || targetProjectID != current.ProjectID {
+		def, err := s.workflowOf(ctx, targetProjectID)
+		if err != nil {
+			return nil, err
+		}
+		next := def.Remap(workflow.StatusKey(current), string(current.Status))
+		if input.Status != nil {
+			next, err = resolveStatus(def, *input.Status)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.checkTransition(ctx, def, current, next, actor); err != nil {
+				return nil, err
+			}
+		}
+		setStatus(taskUpdate, current, next)
+		if targetProjectID != current.ProjectID {
+			// Unranked tasks go to the end of their board column, and the
+			// sprint and milestone of the old project no longer apply
+			taskUpdate.SetRank("").ClearSprintID().ClearMilestoneID()
+		}
+	}
+	if input.SprintID != nil {
+		if *input.SprintID == 0 {
+			taskUpdate.ClearSprintID()
+		} else {
+			if err := s.checkSprint(ctx, targetProjectID, *input.SprintID); err != nil {
+				return nil, err
+			}
+			taskUpdate.SetSprintID(*input.SprintID)
+		}
+	}
+	if input.MilestoneID != nil {
+		if *input.MilestoneID == 0 {
+			taskUpdate.ClearMilestoneID()
+		} else {
+			if err := s.checkMilestone(ctx, targetProjectID, *input.MilestoneID); err != nil {
+				return nil, err
+			}
+			taskUpdate.SetMilestoneID(*input.MilestoneID)
 		}
 	}
 	if input.Type != nil {
@@ -158,6 +255,35 @@ func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.Tas
 		// Clear existing labels and add new ones
 		taskUpdate.ClearLabels()
 		if len(input.LabelIDs) > 0 {
+			// Validate that all label IDs exist in the organization
+			existingLabels, err := s.Client.Label.Query().
+				Where(label.IDIn(input.LabelIDs...)).
+				Where(label.OrgID(actor.OrgID)).
+				Select(label.FieldID).
+				All(ctx)
+			if err != nil {
+				return nil, &ServiceError{
+					Status: http.StatusInternalServerError,
+					Msg:    "Failed to validate label IDs",
+				}
+			}
+
+			// Create map of existing label IDs for validation
+			existingIDs := make(map[int]bool)
+			for _, lbl := range existingLabels {
+				existingIDs[lbl.ID] = true
+			}
+
+			// Check if all requested label IDs exist
+			for _, id := range input.LabelIDs {
+				if !existingIDs[id] {
+					return nil, &ServiceError{
+						Status: http.StatusBadRequest,
+						Msg:    "Some label IDs do not exist",
+					}
+				}
+			}
+
 			taskUpdate.AddLabelIDs(input.LabelIDs...)
 		}
 	}
@@ -168,9 +294,10 @@ func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.Tas
 		taskUpdate.ClearAssignees()
 
 		if len(input.AssigneeIDs) > 0 {
-			// Validate that all assignee IDs exist in the employee table
+			// Validate that all assignee IDs exist in the organization
 			existingEmployees, err := s.Client.Employee.Query().
 				Where(employee.IDIn(input.AssigneeIDs...)).
+				Where(employee.OrgID(actor.OrgID)).
 				Select(employee.FieldID).
 				All(ctx)
 			if err != nil {
@@ -205,7 +332,24 @@ func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.Tas
 		}
 	}
 
-	_, err := taskUpdate.Save(ctx)
+	row, err := taskUpdate.Save(ctx)
+	// A code taken by a concurrent save is a conflict for a custom code and
+	// is allocated again for a generated one
+	for attempt := 1; err != nil && input.Code != nil && s.codeConflict(ctx, err, actor.OrgID, taskCode, id); attempt++ {
+		if !generated || attempt == maxCodeAttempts {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Task code already exists",
+			}
+		}
+		if taskCode, err = s.nextCode(ctx, actor.OrgID, codeProjectID); err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to generate task code",
+			}
+		}
+		row, err = taskUpdate.SetCode(taskCode).Save(ctx)
+	}
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, &ServiceError{
@@ -219,9 +363,39 @@ func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.Tas
 		}
 	}
 
+	var movedIDs []int
+	if moving {
+		if movedIDs, err = s.moveSubtasks(ctx, subtasks, row.ProjectID, actor); err != nil {
+			return nil, err
+		}
+	}
+
+	// Keep the progress of the old and new parent in line with their subtasks
+	if input.Process != nil || input.Status != nil || input.ParentID != nil {
+		if err := s.RollUpProgress(ctx, row.ParentID); err != nil {
+			log.Printf("Failed to roll up progress for task %d: %v", row.ParentID, err)
+		}
+		if current.ParentID != row.ParentID {
+			if err := s.RollUpProgress(ctx, current.ParentID); err != nil {
+				log.Printf("Failed to roll up progress for task %d: %v", current.ParentID, err)
+			}
+		}
+	}
+	// and the progress of the old and new project in line with their tasks
+	if input.Process != nil || input.Status != nil || input.ParentID != nil ||
+		input.ProjectID != nil || input.OriginalEstimate != nil {
+		projectIDs := []int{current.ProjectID, row.ProjectID}
+		for _, st := range subtasks {
+			projectIDs = append(projectIDs, st.ProjectID)
+		}
+		s.RollUpProjects(ctx, projectIDs...)
+	}
+
 	// Get the updated task with all edges
 	updatedTask, err := s.Client.Task.Query().
 		Where(task.ID(id)).
+		WithParent().
+		WithChildren().
 		WithProject().
 		WithLabels().
 		WithAssignees().
@@ -235,7 +409,9 @@ func (s *TaskService) Update(ctx context.Context, id, userID int, input dtos.Tas
 	}
 
 	// Send Kafka event for task updated
+	s.afterChange(ctx, updatedTask, actor, taskChanges(current, updatedTask)...)
 	s.publishTaskUpdatedEvent(ctx, updatedTask, userID)
+	s.publishUpdated(ctx, movedIDs, userID)
 
 	return updatedTask, nil
 }
@@ -246,13 +422,7 @@ func (s *TaskService) publishTaskUpdatedEvent(ctx context.Context, task *ent.Tas
 		return
 	}
 
-	// Get organization ID from assignees or project
-	orgID := 0
-	if task.Edges.Assignees != nil && len(task.Edges.Assignees) > 0 {
-		orgID = task.Edges.Assignees[0].OrgID
-	}
-
-	event := kafka.NewTaskUpdatedEvent(task, orgID)
+	event := kafka.NewTaskUpdatedEvent(task, task.OrgID)
 	key := strconv.Itoa(task.ID)
 
 	if err := s.KafkaClient.PublishEvent(ctx, "task-events", key, event); err != nil {
@@ -0,0 +1,200 @@
+package task
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/notification"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskwatcher"
+	notificationService "github.com/longgggwwww/hrm-ms-hr/internal/services/notification"
+)
+
+// notifications returns the notification service on the client of the task
+// service, so notices made in a transaction share its fate
+func (s *TaskService) notifications() *notificationService.NotificationService {
+	return notificationService.NewNotificationService(s.Client)
+}
+
+// afterChange follows changes of a task: they are recorded in its activity
+// stream, new assignees start watching the task and are told of their
+// assignment, and status changes are sent to assignees and watchers
+func (s *TaskService) afterChange(ctx context.Context, t *ent.Task, actor Actor, changes ...change) {
+	s.recordActivity(ctx, t, actor, changes...)
+
+	var assigned []int
+	statusChanged := false
+	for _, c := range changes {
+		switch c.Kind {
+		case taskactivity.KindCreated:
+			s.watch(ctx, t.ID, actor.EmployeeID)
+		case taskactivity.KindAssigneeAdded:
+			assigned = append(assigned, c.RefID)
+		case taskactivity.KindStatusChanged:
+			statusChanged = true
+		}
+	}
+
+	if len(assigned) > 0 {
+		s.watch(ctx, t.ID, assigned...)
+		s.notifications().Notify(ctx, notificationService.Notice{
+			OrgID:           t.OrgID,
+			Type:            notification.TypeTaskAssigned,
+			RecipientIDs:    assigned,
+			ActorEmployeeID: actor.EmployeeID,
+			Title:           "You were assigned to " + t.Code,
+			Body:            t.Name,
+			TaskID:          t.ID,
+		})
+	}
+
+	if statusChanged {
+		recipients, err := s.followers(ctx, t.ID)
+		if err != nil {
+			log.Printf("Failed to fetch followers of task %d: %v", t.ID, err)
+			return
+		}
+		status := t.WorkflowStatus
+		if status == "" {
+			status = string(t.Status)
+		}
+		s.notifications().Notify(ctx, notificationService.Notice{
+			OrgID:           t.OrgID,
+			Type:            notification.TypeTaskStatusChanged,
+			RecipientIDs:    recipients,
+			ActorEmployeeID: actor.EmployeeID,
+			Title:           t.Code + " moved to " + status,
+			Body:            t.Name,
+			TaskID:          t.ID,
+		})
+	}
+}
+
+// notifyMentions tells employees they were mentioned in a comment
+func (s *TaskService) notifyMentions(ctx context.Context, t *ent.Task, comment *ent.TaskComment, authorID int, mentionedIDs []int) {
+	if len(mentionedIDs) == 0 {
+		return
+	}
+	s.notifications().Notify(ctx, notificationService.Notice{
+		OrgID:           t.OrgID,
+		Type:            notification.TypeTaskMentioned,
+		RecipientIDs:    mentionedIDs,
+		ActorEmployeeID: authorID,
+		Title:           "You were mentioned on " + t.Code,
+		Body:            comment.Content,
+		TaskID:          t.ID,
+		RefID:           comment.ID,
+	})
+}
+
+// followers returns the employees following a task: its assignees and watchers
+func (s *TaskService) followers(ctx context.Context, taskID int) ([]int, error) {
+	assignees, err := s.Client.Task.Query().
+		Where(task.ID(taskID)).
+		QueryAssignees().
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	watchers, err := s.Client.TaskWatcher.Query().
+		Where(taskwatcher.TaskID(taskID)).
+		Select(taskwatcher.FieldEmployeeID).
+		Ints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(assignees, watchers...), nil
+}
+
+// watch makes employees watchers of a task; watching twice is a no-op
+func (s *TaskService) watch(ctx context.Context, taskID int, employeeIDs ...int) {
+	builders := make([]*ent.TaskWatcherCreate, 0, len(employeeIDs))
+	for _, id := range uniqueIDs(employeeIDs) {
+		if id != 0 {
+			builders = append(builders, s.Client.TaskWatcher.Create().
+				SetTaskID(taskID).
+				SetEmployeeID(id))
+		}
+	}
+	if len(builders) == 0 {
+		return
+	}
+	err := s.Client.TaskWatcher.CreateBulk(builders...).
+		OnConflictColumns(taskwatcher.FieldTaskID, taskwatcher.FieldEmployeeID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Failed to add watchers to task %d: %v", taskID, err)
+	}
+}
+
+// ListWatchers returns the employees watching a task
+func (s *TaskService) ListWatchers(ctx context.Context, orgID, taskID int) ([]*ent.Employee, error) {
+	if _, err := s.getTask(ctx, orgID, taskID); err != nil {
+		return nil, err
+	}
+	ids, err := s.Client.TaskWatcher.Query().
+		Where(taskwatcher.TaskID(taskID)).
+		Select(taskwatcher.FieldEmployeeID).
+		Ints(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task watchers",
+		}
+	}
+	watchers, err := s.Client.Employee.Query().
+		Where(employee.IDIn(ids...)).
+		Order(ent.Asc(employee.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task watchers",
+		}
+	}
+	return watchers, nil
+}
+
+// SetWatching starts or stops an employee watching a task
+func (s *TaskService) SetWatching(ctx context.Context, orgID, taskID, employeeID int, watching bool) error {
+	if employeeID == 0 {
+		return &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only employees can watch tasks",
+		}
+	}
+	if _, err := s.getTask(ctx, orgID, taskID); err != nil {
+		return err
+	}
+
+	if watching {
+		err := s.Client.TaskWatcher.Create().
+			SetTaskID(taskID).
+			SetEmployeeID(employeeID).
+			OnConflictColumns(taskwatcher.FieldTaskID, taskwatcher.FieldEmployeeID).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to update task watchers",
+			}
+		}
+		return nil
+	}
+
+	if _, err := s.Client.TaskWatcher.Delete().
+		Where(taskwatcher.TaskID(taskID), taskwatcher.EmployeeID(employeeID)).
+		Exec(ctx); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update task watchers",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package task
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/workflow"
+)
+
+// Actor identifies who changes a task. Task creators are stored as user IDs
+// while assignees are employees, so guards need both. Only tasks of the
+// actor's organization can be reached.
+type Actor struct {
+	UserID     int
+	EmployeeID int
+	OrgID      int
+}
+
+// workflowOf loads the workflow of a project
+func (s *TaskService) workflowOf(ctx context.Context, projectID int) (*workflow.Definition, error) {
+	def, err := workflow.Load(ctx, s.Client, projectID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to load project workflow",
+		}
+	}
+	return def, nil
+}
+
+// resolveStatus finds the workflow status for a requested status key
+func resolveStatus(def *workflow.Definition, key string) (*workflow.Status, error) {
+	st, ok := def.Resolve(key)
+	if !ok {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Unknown status '" + key + "' for this project's workflow",
+		}
+	}
+	return st, nil
+}
+
+// checkTransition validates every status change of a task. The workflow must
+// allow the move, its guards must pass and the target column must be under its
+// WIP limit. Rules shared by all workflows also apply: a task with open
+// subtasks cannot be completed and a task cannot be picked up while it is
+// blocked.
+func (s *TaskService) checkTransition(ctx context.Context, def *workflow.Definition, t *ent.Task, to *workflow.Status, actor Actor) error {
+	from := def.Remap(workflow.StatusKey(t), string(t.Status))
+	if from.Key == to.Key {
+		return nil
+	}
+
+	transition, ok := def.Transition(from.Key, to.Key)
+	if !ok {
+		return &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    "The project workflow does not allow moving a task from '" + from.Name + "' to '" + to.Name + "'",
+		}
+	}
+	for _, guard := range transition.Guards {
+		if err := s.checkGuard(ctx, guard, t, actor); err != nil {
+			return err
+		}
+	}
+	if err := s.checkWIPLimit(ctx, t.ProjectID, to); err != nil {
+		return err
+	}
+
+	if to.SystemStatus == string(task.StatusCompleted) {
+		if err := s.checkCanComplete(ctx, t.ID); err != nil {
+			return err
+		}
+	}
+	if to.SystemStatus != string(t.Status) {
+		switch task.Status(to.SystemStatus) {
+		case task.StatusReceived, task.StatusInProgress, task.StatusCompleted:
+			if err := s.checkNotBlocked(ctx, t.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkGuard evaluates a transition guard for the actor
+func (s *TaskService) checkGuard(ctx context.Context, guard string, t *ent.Task, actor Actor) error {
+	switch guard {
+	case workflow.GuardCreatorOnly:
+		if t.CreatorID != actor.UserID {
+			return &ServiceError{
+				Status: http.StatusForbidden,
+				Msg:    "Only the task creator can move the task to this status",
+			}
+		}
+	case workflow.GuardAssigneeOnly:
+		assigned, err := s.Client.Task.Query().
+			Where(task.ID(t.ID)).
+			Where(task.HasAssigneesWith(employee.ID(actor.EmployeeID))).
+			Exist(ctx)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to check task assignees",
+			}
+		}
+		if !assigned {
+			return &ServiceError{
+				Status: http.StatusForbidden,
+				Msg:    "Only an assignee can move the task to this status",
+			}
+		}
+	case workflow.GuardRequiresReport, workflow.GuardRequiresAcceptedReport:
+		reportQuery := s.Client.TaskReport.Query().Where(taskreport.TaskID(t.ID))
+		msg := "A report is required before moving the task to this status"
+		if guard == workflow.GuardRequiresAcceptedReport {
+			reportQuery = reportQuery.Where(taskreport.StatusEQ(taskreport.StatusAccepted))
+			msg = "An accepted report is required before moving the task to this status"
+		}
+		exists, err := reportQuery.Exist(ctx)
+		if err != nil {
+			return &ServiceError{
+				Status: http.StatusInternalServerError,
+				Msg:    "Failed to check task reports",
+			}
+		}
+		if !exists {
+			return &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    msg,
+			}
+		}
+	}
+	return nil
+}
+
+// setStatus applies a workflow status to a task update. Completing a task
+// records when it happened; leaving the completed status clears it.
+func setStatus(update *ent.TaskUpdateOne, t *ent.Task, st *workflow.Status) *ent.TaskUpdateOne {
+	update.
+		SetStatus(task.Status(st.SystemStatus)).
+		SetWorkflowStatus(st.Key)
+	if st.SystemStatus != string(t.Status) {
+		if st.SystemStatus == string(task.StatusCompleted) {
+			update.SetCompletedAt(time.Now())
+		} else {
+			update.ClearCompletedAt()
+		}
+	}
+	return update
+}
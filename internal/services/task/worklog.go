@@ -0,0 +1,420 @@
+package task
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/worklog"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// LogWork records time an assignee spent on a task and lowers the task's
+// remaining estimate by the same amount unless an explicit value is given
+func (s *TaskService) LogWork(ctx context.Context, orgID, taskID, employeeID int, input dtos.WorkLogCreateInput) (*ent.WorkLog, error) {
+	taskEntity, err := s.assignedTask(ctx, orgID, taskID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt, err := time.Parse(time.RFC3339, input.StartedAt)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid started_at format, must be RFC3339",
+		}
+	}
+	endedAt := startedAt.Add(time.Duration(input.Duration) * time.Minute)
+	if endedAt.After(time.Now()) {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Work cannot be logged in the future",
+		}
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	row, err := tx.WorkLog.Create().
+		SetTaskID(taskID).
+		SetEmployeeID(employeeID).
+		SetStartedAt(startedAt).
+		SetEndedAt(endedAt).
+		SetDuration(input.Duration).
+		SetNote(input.Note).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to log work",
+		}
+	}
+
+	if err := adjustRemaining(ctx, tx, taskEntity, -input.Duration, input.RemainingEstimate); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	return row, nil
+}
+
+// StartTimer starts tracking time on a task. An employee can only run one
+// timer at a time.
+func (s *TaskService) StartTimer(ctx context.Context, orgID, taskID, employeeID int, input dtos.WorkLogTimerInput) (*ent.WorkLog, error) {
+	if _, err := s.assignedTask(ctx, orgID, taskID, employeeID); err != nil {
+		return nil, err
+	}
+
+	running, err := s.Client.WorkLog.Query().
+		Where(worklog.EmployeeID(employeeID)).
+		Where(worklog.EndedAtIsNil()).
+		WithTask().
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check running timers",
+		}
+	}
+	if running != nil {
+		msg := "You already have a running timer"
+		if running.Edges.Task != nil {
+			msg += " on task " + running.Edges.Task.Code
+		}
+		return nil, &ServiceError{
+			Status: http.StatusConflict,
+			Msg:    msg,
+		}
+	}
+
+	row, err := s.Client.WorkLog.Create().
+		SetTaskID(taskID).
+		SetEmployeeID(employeeID).
+		SetStartedAt(time.Now()).
+		SetNote(input.Note).
+		Save(ctx)
+	if err != nil {
+		// The unique index on running timers catches concurrent starts
+		if ent.IsConstraintError(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "You already have a running timer",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start timer",
+		}
+	}
+	return row, nil
+}
+
+// StopTimer stops the employee's running timer on a task and logs the elapsed
+// time, rounded up to the minute
+func (s *TaskService) StopTimer(ctx context.Context, orgID, taskID, employeeID int) (*ent.WorkLog, error) {
+	taskEntity, err := s.assignedTask(ctx, orgID, taskID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := s.Client.WorkLog.Query().
+		Where(worklog.TaskID(taskID)).
+		Where(worklog.EmployeeID(employeeID)).
+		Where(worklog.EndedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "No running timer on this task",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch running timer",
+		}
+	}
+
+	now := time.Now()
+	minutes := int((now.Sub(running.StartedAt) + time.Minute - 1) / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// Only a timer that is still running can be stopped
+	row, err := tx.WorkLog.UpdateOneID(running.ID).
+		Where(worklog.EndedAtIsNil()).
+		SetEndedAt(now).
+		SetDuration(minutes).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusConflict,
+				Msg:    "Timer was already stopped",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to stop timer",
+		}
+	}
+
+	if err := adjustRemaining(ctx, tx, taskEntity, -minutes, nil); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	return row, nil
+}
+
+// ListWorkLogs returns the work logged on a task, newest first
+func (s *TaskService) ListWorkLogs(ctx context.Context, orgID, taskID int) ([]*ent.WorkLog, error) {
+	if _, err := s.getTask(ctx, orgID, taskID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.Client.WorkLog.Query().
+		Where(worklog.TaskID(taskID)).
+		WithEmployee().
+		Order(ent.Desc(worklog.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch work logs",
+		}
+	}
+	return rows, nil
+}
+
+// DeleteWorkLog removes a work log of the current employee and gives its time
+// back to the task's remaining estimate
+func (s *TaskService) DeleteWorkLog(ctx context.Context, orgID, taskID, workLogID, employeeID int) error {
+	row, err := s.Client.WorkLog.Query().
+		Where(worklog.ID(workLogID)).
+		Where(worklog.TaskID(taskID)).
+		Where(worklog.HasTaskWith(task.OrgID(orgID))).
+		WithTask().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Work log not found",
+			}
+		}
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch work log",
+		}
+	}
+	if row.EmployeeID != employeeID {
+		return &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "You can only delete your own work logs",
+		}
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to start transaction",
+		}
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.WorkLog.DeleteOneID(workLogID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete work log",
+		}
+	}
+	if row.Duration > 0 {
+		if err := adjustRemaining(ctx, tx, row.Edges.Task, row.Duration, nil); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to commit transaction",
+		}
+	}
+	return nil
+}
+
+// TimeTracking compares a task's estimates with the time logged on it
+func (s *TaskService) TimeTracking(ctx context.Context, orgID, taskID int) (*dtos.TaskTimeTrackingResponse, error) {
+	taskEntity, err := s.getTask(ctx, orgID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var totals []struct {
+		EmployeeID int `json:"employee_id"`
+		Sum        int `json:"sum"`
+	}
+	if err := s.Client.WorkLog.Query().
+		Where(worklog.TaskID(taskID)).
+		Where(worklog.EndedAtNotNil()).
+		GroupBy(worklog.FieldEmployeeID).
+		Aggregate(ent.Sum(worklog.FieldDuration)).
+		Scan(ctx, &totals); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to aggregate work logs",
+		}
+	}
+	sort.Slice(totals, func(i, j int) bool { return totals[i].EmployeeID < totals[j].EmployeeID })
+
+	response := &dtos.TaskTimeTrackingResponse{
+		TaskID:            taskID,
+		OriginalEstimate:  taskEntity.OriginalEstimate,
+		RemainingEstimate: taskEntity.RemainingEstimate,
+		ByEmployee:        make([]dtos.WorkLogEmployeeTotal, len(totals)),
+	}
+	for i, t := range totals {
+		response.Logged += t.Sum
+		response.ByEmployee[i] = dtos.WorkLogEmployeeTotal{EmployeeID: t.EmployeeID, Minutes: t.Sum}
+	}
+	if taskEntity.OriginalEstimate != nil {
+		variance := response.Logged - *taskEntity.OriginalEstimate
+		if taskEntity.RemainingEstimate != nil {
+			variance += *taskEntity.RemainingEstimate
+		}
+		response.Variance = &variance
+	}
+	return response, nil
+}
+
+// assignedTask fetches a task the employee is assigned to. Only assignees can
+// track time on a task.
+func (s *TaskService) assignedTask(ctx context.Context, orgID, taskID, employeeID int) (*ent.Task, error) {
+	taskEntity, err := s.getTask(ctx, orgID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned, err := s.Client.Task.Query().
+		Where(task.ID(taskID)).
+		Where(task.HasAssigneesWith(employee.ID(employeeID))).
+		Exist(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check task assignees",
+		}
+	}
+	if !assigned {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only assignees can log time on this task",
+		}
+	}
+	return taskEntity, nil
+}
+
+// getTask fetches a task of an organization by ID
+func (s *TaskService) getTask(ctx context.Context, orgID, id int) (*ent.Task, error) {
+	taskEntity, err := s.Client.Task.Query().
+		Where(task.ID(id), task.OrgID(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Task not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task",
+		}
+	}
+	return taskEntity, nil
+}
+
+// adjustRemaining moves a task's remaining estimate by delta minutes, never
+// below zero, or sets it to override when given. Tasks without an estimate
+// are left alone.
+func adjustRemaining(ctx context.Context, tx *ent.Tx, taskEntity *ent.Task, delta int, override *int) error {
+	var err error
+	switch {
+	case override != nil:
+		err = tx.Task.UpdateOneID(taskEntity.ID).SetRemainingEstimate(*override).Exec(ctx)
+	case taskEntity.RemainingEstimate != nil:
+		// Add in SQL so that concurrent logs are not lost, clamping at zero
+		err = tx.Task.UpdateOneID(taskEntity.ID).
+			Where(task.RemainingEstimateNotNil()).
+			Where(task.RemainingEstimateGTE(-delta)).
+			AddRemainingEstimate(delta).
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			err = tx.Task.Update().
+				Where(task.ID(taskEntity.ID)).
+				Where(task.RemainingEstimateNotNil()).
+				SetRemainingEstimate(0).
+				Exec(ctx)
+		}
+	}
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update remaining estimate",
+		}
+	}
+	return nil
+}
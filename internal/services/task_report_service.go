@@ -2,40 +2,278 @@ package services
 
 import (
 	"context"
+	"log"
+	"net/http"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskactivity"
 	"github.com/longgggwwww/hrm-ms-hr/ent/taskreport"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	taskService "github.com/longgggwwww/hrm-ms-hr/internal/services/task"
 )
 
-func CreateTaskReport(ctx context.Context, client *ent.Client, taskID, reporterID int, content string) (*ent.TaskReport, error) {
+// CreateTaskReport submits a report on a task. Only assignees of the task can report on it.
+func CreateTaskReport(ctx context.Context, client *ent.Client, reporterID int, input dtos.TaskReportCreateInput) (*ent.TaskReport, error) {
+	exists, err := client.Task.Query().Where(task.ID(input.TaskID)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, &ServiceError{Status: http.StatusNotFound, Msg: "Task not found"}
+	}
+
+	assigned, err := client.Task.Query().
+		Where(task.ID(input.TaskID)).
+		Where(task.HasAssigneesWith(employee.ID(reporterID))).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !assigned {
+		return nil, &ServiceError{Status: http.StatusForbidden, Msg: "You are not assigned to this task"}
+	}
+
+	if input.Progress != nil {
+		if err := checkReportableProgress(ctx, client, input.TaskID); err != nil {
+			return nil, err
+		}
+	}
+
 	report, err := client.TaskReport.Create().
-		SetTaskID(taskID).
+		SetTaskID(input.TaskID).
 		SetReporterID(reporterID).
-		SetContent(content).
+		SetContent(input.Content).
+		SetNillableProgress(input.Progress).
 		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return client.TaskReport.Query().
-		Where(taskreport.ID(report.ID)).
+	taskService.NewTaskService(client).RecordReportActivity(ctx, report.TaskID, report.ID, reporterID, taskactivity.KindReportSubmitted, string(report.Status))
+	return getTaskReport(ctx, client, report.ID)
+}
+
+// UpdateTaskReport edits a report of the reporter. Accepted reports are final;
+// editing a report that needs changes submits it again for review.
+func UpdateTaskReport(ctx context.Context, client *ent.Client, id, reporterID int, input dtos.TaskReportUpdateInput) (*ent.TaskReport, error) {
+	report, err := client.TaskReport.Query().
+		Where(taskreport.ID(id), taskreport.ReporterID(reporterID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{Status: http.StatusNotFound, Msg: "Task report not found"}
+		}
+		return nil, err
+	}
+	if report.Status == taskreport.StatusAccepted {
+		return nil, &ServiceError{Status: http.StatusConflict, Msg: "An accepted report can no longer be changed"}
+	}
+
+	update := client.TaskReport.UpdateOneID(id).
+		Where(taskreport.StatusNEQ(taskreport.StatusAccepted)).
+		SetContent(input.Content).
+		SetStatus(taskreport.StatusSubmitted)
+	if input.Progress != nil {
+		if err := checkReportableProgress(ctx, client, report.TaskID); err != nil {
+			return nil, err
+		}
+		update.SetProgress(*input.Progress)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{Status: http.StatusConflict, Msg: "An accepted report can no longer be changed"}
+		}
+		return nil, err
+	}
+	taskService.NewTaskService(client).RecordReportActivity(ctx, report.TaskID, id, reporterID, taskactivity.KindReportUpdated, string(taskreport.StatusSubmitted))
+	return getTaskReport(ctx, client, id)
+}
+
+// GetTaskReport returns a report written by an employee of the organization
+func GetTaskReport(ctx context.Context, client *ent.Client, orgID, id int) (*ent.TaskReport, error) {
+	report, err := client.TaskReport.Query().
+		Where(taskreport.ID(id)).
+		Where(taskreport.HasReporterWith(employee.OrgID(orgID))).
 		WithTask().
 		WithReporter().
+		WithReviewer().
 		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{Status: http.StatusNotFound, Msg: "Task report not found"}
+		}
+		return nil, err
+	}
+	return report, nil
+}
+
+// ListTaskReports lists the reports of an organization, newest first
+func ListTaskReports(ctx context.Context, client *ent.Client, orgID int, query dtos.TaskReportListQuery) (*dtos.TaskReportListResponse, error) {
+	q := client.TaskReport.Query().
+		Where(taskreport.HasReporterWith(employee.OrgID(orgID)))
+
+	if query.TaskID > 0 {
+		q = q.Where(taskreport.TaskID(query.TaskID))
+	}
+	if query.ReporterID > 0 {
+		q = q.Where(taskreport.ReporterID(query.ReporterID))
+	}
+	if query.ProjectID > 0 {
+		q = q.Where(taskreport.HasTaskWith(task.ProjectID(query.ProjectID)))
+	}
+	if query.Status != "" {
+		q = q.Where(taskreport.StatusEQ(taskreport.Status(query.Status)))
+	}
+	if query.From != "" {
+		from, err := time.Parse(time.RFC3339, query.From)
+		if err != nil {
+			return nil, &ServiceError{Status: http.StatusBadRequest, Msg: "Invalid from format, must be RFC3339"}
+		}
+		q = q.Where(taskreport.CreatedAtGTE(from))
+	}
+	if query.To != "" {
+		to, err := time.Parse(time.RFC3339, query.To)
+		if err != nil {
+			return nil, &ServiceError{Status: http.StatusBadRequest, Msg: "Invalid to format, must be RFC3339"}
+		}
+		q = q.Where(taskreport.CreatedAtLTE(to))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	if query.Page > 0 {
+		page = query.Page
+	}
+	limit := 10
+	if query.Limit > 0 && query.Limit <= 100 {
+		limit = query.Limit
+	}
+
+	reports, err := q.
+		WithTask().
+		WithReporter().
+		WithReviewer().
+		Order(taskreport.ByCreatedAt(sql.OrderDesc()), taskreport.ByID(sql.OrderDesc())).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.TaskReportListResponse{
+		Data: reports,
+		Pagination: dtos.OffsetPagination{
+			Type:        "page",
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			TotalItems:  total,
+			PerPage:     limit,
+		},
+	}, nil
 }
 
-func UpdateTaskReport(ctx context.Context, client *ent.Client, id, reporterID int, content string) (*ent.TaskReport, error) {
-	if _, err := client.TaskReport.Query().Where(taskreport.ID(id), taskreport.ReporterID(reporterID)).Only(ctx); err != nil {
+// ReviewTaskReport accepts a submitted report or sends it back for changes.
+// Accepting a report that carries a progress value sets Task.process to it.
+func ReviewTaskReport(ctx context.Context, client *ent.Client, orgID, id, reviewerID int, input dtos.TaskReportReviewInput) (*ent.TaskReport, error) {
+	report, err := GetTaskReport(ctx, client, orgID, id)
+	if err != nil {
 		return nil, err
 	}
-	_, err := client.TaskReport.UpdateOneID(id).
-		SetContent(content).
-		Save(ctx)
+	if report.ReporterID == reviewerID {
+		return nil, &ServiceError{Status: http.StatusForbidden, Msg: "You cannot review your own report"}
+	}
+	if report.Status != taskreport.StatusSubmitted {
+		return nil, &ServiceError{Status: http.StatusConflict, Msg: "Task report has already been reviewed"}
+	}
+
+	status := taskreport.Status(input.Status)
+	applyProgress := status == taskreport.StatusAccepted && report.Progress != nil
+	if applyProgress {
+		if err := checkReportableProgress(ctx, client, report.TaskID); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := tx.TaskReport.UpdateOneID(id).
+		Where(taskreport.StatusEQ(taskreport.StatusSubmitted)).
+		SetStatus(status).
+		SetReviewerID(reviewerID).
+		SetReviewComment(input.Comment).
+		SetReviewedAt(time.Now()).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{Status: http.StatusConflict, Msg: "Task report has already been reviewed"}
+		}
+		return nil, err
+	}
+
+	if applyProgress {
+		if err := tx.Task.UpdateOneID(report.TaskID).
+			SetProcess(*report.Progress).
+			SetUpdaterID(reviewerID).
+			Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	taskService.NewTaskService(client).RecordReportActivity(ctx, report.TaskID, id, reviewerID, taskactivity.KindReportReviewed, input.Status)
+	if applyProgress && report.Edges.Task != nil {
+		tasks := taskService.NewTaskService(client)
+		if err := tasks.RollUpProgress(ctx, report.Edges.Task.ParentID); err != nil {
+			log.Printf("Failed to roll up progress for task %d: %v", report.Edges.Task.ParentID, err)
+		}
+		tasks.RollUpProjects(ctx, report.Edges.Task.ProjectID)
+	}
+
+	return getTaskReport(ctx, client, id)
+}
+
+// checkReportableProgress refuses progress values for tasks whose progress is
+// rolled up from their subtasks
+func checkReportableProgress(ctx context.Context, client *ent.Client, taskID int) error {
+	hasChildren, err := client.Task.Query().
+		Where(task.ParentID(taskID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if hasChildren {
+		return &ServiceError{Status: http.StatusBadRequest, Msg: "Process of a task with subtasks is computed from its subtasks"}
+	}
+	return nil
+}
+
+// getTaskReport fetches a report with its task, reporter and reviewer
+func getTaskReport(ctx context.Context, client *ent.Client, id int) (*ent.TaskReport, error) {
 	return client.TaskReport.Query().
 		Where(taskreport.ID(id)).
 		WithTask().
 		WithReporter().
+		WithReviewer().
 		Only(ctx)
 }
@@ -0,0 +1,178 @@
+package taskview
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+)
+
+// filterKeys are the task list query parameters a view can save
+var filterKeys = map[string]bool{
+	"name":            true,
+	"code":            true,
+	"status":          true,
+	"type":            true,
+	"project_id":      true,
+	"creator_id":      true,
+	"parent_id":       true,
+	"sprint_id":       true,
+	"milestone_id":    true,
+	"assignee_ids":    true,
+	"assignee_mode":   true,
+	"label_ids":       true,
+	"label_mode":      true,
+	"unassigned":      true,
+	"overdue":         true,
+	"mine":            true,
+	"updated_since":   true,
+	"process":         true,
+	"start_date_from": true,
+	"start_date_to":   true,
+	"due_date_from":   true,
+	"due_date_to":     true,
+	"order_by":        true,
+	"order_dir":       true,
+	"view":            true,
+}
+
+// dateKeys are the filters taking times, which may be relative
+var dateKeys = map[string]bool{
+	"start_date_from": true,
+	"start_date_to":   true,
+	"due_date_from":   true,
+	"due_date_to":     true,
+	"updated_since":   true,
+}
+
+// boolKeys are the filters taking true or false
+var boolKeys = map[string]bool{
+	"unassigned": true,
+	"overdue":    true,
+	"mine":       true,
+}
+
+// relativeDate matches now or today, optionally shifted by hours, days or weeks
+var relativeDate = regexp.MustCompile(`^(now|today)(?:([+-])(\d{1,4})([hdw]))?$`)
+
+// resolveDate returns a date filter as an RFC3339 time. Relative dates are
+// resolved against now in a time zone.
+func resolveDate(value string, now time.Time, loc *time.Location) (string, bool) {
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return value, true
+	}
+	m := relativeDate.FindStringSubmatch(value)
+	if m == nil {
+		return "", false
+	}
+
+	t := now.In(loc)
+	if m[1] == "today" {
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+	if m[2] != "" {
+		n, _ := strconv.Atoi(m[3])
+		if m[2] == "-" {
+			n = -n
+		}
+		switch m[4] {
+		case "h":
+			t = t.Add(time.Duration(n) * time.Hour)
+		case "d":
+			t = t.AddDate(0, 0, n)
+		case "w":
+			t = t.AddDate(0, 0, 7*n)
+		}
+	}
+	return t.Format(time.RFC3339), true
+}
+
+// listQuery turns saved filters into a task list query of an employee for a
+// time; mine selects the tasks of the employee running the view
+func listQuery(filters map[string]string, employeeID int, now time.Time, loc *time.Location) (dtos.TaskListQuery, error) {
+	query := dtos.TaskListQuery{EmployeeID: employeeID}
+	for key, value := range filters {
+		if !filterKeys[key] {
+			return query, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Unsupported filter " + key,
+			}
+		}
+		if dateKeys[key] && value != "" {
+			resolved, ok := resolveDate(value, now, loc)
+			if !ok {
+				return query, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "Invalid date in filter " + key + ", must be RFC3339 or relative like today+7d",
+				}
+			}
+			value = resolved
+		}
+		var flag bool
+		if boolKeys[key] && value != "" {
+			var err error
+			if flag, err = strconv.ParseBool(value); err != nil {
+				return query, &ServiceError{
+					Status: http.StatusBadRequest,
+					Msg:    "Invalid value in filter " + key + ", must be true or false",
+				}
+			}
+		}
+
+		switch key {
+		case "name":
+			query.Name = value
+		case "code":
+			query.Code = value
+		case "status":
+			query.Status = value
+		case "type":
+			query.Type = value
+		case "project_id":
+			query.ProjectID = value
+		case "creator_id":
+			query.CreatorID = value
+		case "parent_id":
+			query.ParentID = value
+		case "sprint_id":
+			query.SprintID = value
+		case "milestone_id":
+			query.MilestoneID = value
+		case "assignee_ids":
+			query.AssigneeIDs = value
+		case "assignee_mode":
+			query.AssigneeMode = value
+		case "label_ids":
+			query.LabelIDs = value
+		case "label_mode":
+			query.LabelMode = value
+		case "unassigned":
+			query.Unassigned = flag
+		case "overdue":
+			query.Overdue = flag
+		case "mine":
+			query.Mine = flag
+		case "updated_since":
+			query.UpdatedSince = value
+		case "process":
+			query.Process = value
+		case "start_date_from":
+			query.StartDateFrom = value
+		case "start_date_to":
+			query.StartDateTo = value
+		case "due_date_from":
+			query.DueDateFrom = value
+		case "due_date_to":
+			query.DueDateTo = value
+		case "order_by":
+			query.OrderBy = value
+		case "order_dir":
+			query.OrderDir = value
+		case "view":
+			query.View = value
+		}
+	}
+	return query, nil
+}
@@ -0,0 +1,341 @@
+package taskview
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/employee"
+	"github.com/longgggwwww/hrm-ms-hr/ent/predicate"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/taskview"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	"github.com/longgggwwww/hrm-ms-hr/internal/services/task"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// TaskViewService provides business logic for saved task views
+type TaskViewService struct {
+	Client *ent.Client
+	Tasks  *task.TaskService
+}
+
+// NewTaskViewService creates a new task view service. Views are run through
+// tasks, so they filter, sort and page like the task list.
+func NewTaskViewService(client *ent.Client, tasks *task.TaskService) *TaskViewService {
+	return &TaskViewService{
+		Client: client,
+		Tasks:  tasks,
+	}
+}
+
+// List returns the views an employee can see: their own, those shared with the
+// organization and those shared with projects they are a member of
+func (s *TaskViewService) List(ctx context.Context, orgID, employeeID int, query dtos.TaskViewListQuery) ([]*ent.TaskView, error) {
+	viewQuery := s.Client.TaskView.Query().
+		Where(taskview.OrgID(orgID)).
+		Where(visibleTo(employeeID))
+	if query.Mine {
+		viewQuery = viewQuery.Where(taskview.EmployeeID(employeeID))
+	}
+	if query.ProjectID != "" {
+		projectID, err := strconv.Atoi(query.ProjectID)
+		if err != nil {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Invalid project_id",
+			}
+		}
+		viewQuery = viewQuery.Where(taskview.ProjectID(projectID))
+	}
+
+	rows, err := viewQuery.
+		Order(ent.Asc(taskview.FieldName), ent.Asc(taskview.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task views",
+		}
+	}
+	return rows, nil
+}
+
+// Create saves a view owned by an employee
+func (s *TaskViewService) Create(ctx context.Context, orgID, employeeID int, input dtos.TaskViewCreateInput) (*ent.TaskView, error) {
+	if employeeID == 0 {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only employees can save task views",
+		}
+	}
+	visibility := taskview.VisibilityPrivate
+	if input.Visibility != nil {
+		visibility = taskview.Visibility(*input.Visibility)
+	}
+	timezone := "UTC"
+	if input.Timezone != nil {
+		timezone = *input.Timezone
+	}
+	if err := s.checkSharing(ctx, orgID, employeeID, visibility, input.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := s.checkFilters(ctx, orgID, employeeID, input.Filters, timezone); err != nil {
+		return nil, err
+	}
+
+	row, err := s.Client.TaskView.Create().
+		SetOrgID(orgID).
+		SetEmployeeID(employeeID).
+		SetNillableProjectID(input.ProjectID).
+		SetName(input.Name).
+		SetVisibility(visibility).
+		SetFilters(input.Filters).
+		SetTimezone(timezone).
+		Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to create task view",
+		}
+	}
+	return row, nil
+}
+
+// Get returns a view an employee can see
+func (s *TaskViewService) Get(ctx context.Context, orgID, employeeID, id int) (*ent.TaskView, error) {
+	row, err := s.Client.TaskView.Query().
+		Where(taskview.ID(id), taskview.OrgID(orgID)).
+		Where(visibleTo(employeeID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, &ServiceError{
+				Status: http.StatusNotFound,
+				Msg:    "Task view not found",
+			}
+		}
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch task view",
+		}
+	}
+	return row, nil
+}
+
+// Update changes a view of its owner
+func (s *TaskViewService) Update(ctx context.Context, orgID, employeeID, id int, input dtos.TaskViewUpdateInput) (*ent.TaskView, error) {
+	view, err := s.owned(ctx, orgID, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility := view.Visibility
+	if input.Visibility != nil {
+		visibility = taskview.Visibility(*input.Visibility)
+	}
+	var projectID *int
+	if view.ProjectID != 0 {
+		projectID = &view.ProjectID
+	}
+	if input.ProjectID != nil {
+		projectID = nil
+		if *input.ProjectID != 0 {
+			projectID = input.ProjectID
+		}
+	}
+	timezone := view.Timezone
+	if input.Timezone != nil {
+		timezone = *input.Timezone
+	}
+	filters := view.Filters
+	if input.Filters != nil {
+		filters = input.Filters
+	}
+	if err := s.checkSharing(ctx, orgID, employeeID, visibility, projectID); err != nil {
+		return nil, err
+	}
+	if err := s.checkFilters(ctx, orgID, employeeID, filters, timezone); err != nil {
+		return nil, err
+	}
+
+	viewUpdate := s.Client.TaskView.UpdateOneID(id).
+		SetNillableName(input.Name).
+		SetVisibility(visibility).
+		SetFilters(filters).
+		SetTimezone(timezone)
+	if projectID != nil {
+		viewUpdate = viewUpdate.SetProjectID(*projectID)
+	} else {
+		viewUpdate = viewUpdate.ClearProjectID()
+	}
+
+	row, err := viewUpdate.Save(ctx)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to update task view",
+		}
+	}
+	return row, nil
+}
+
+// Delete removes a view of its owner
+func (s *TaskViewService) Delete(ctx context.Context, orgID, employeeID, id int) error {
+	if _, err := s.owned(ctx, orgID, employeeID, id); err != nil {
+		return err
+	}
+	if err := s.Client.TaskView.DeleteOneID(id).Exec(ctx); err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to delete task view",
+		}
+	}
+	return nil
+}
+
+// Run lists the tasks matching a view, with relative dates resolved now. In
+// count-only mode the response only holds the number of matching tasks.
+func (s *TaskViewService) Run(ctx context.Context, orgID, employeeID, id int, query dtos.TaskViewRunQuery) (map[string]interface{}, error) {
+	view, err := s.Get(ctx, orgID, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	taskQuery, err := listQuery(view.Filters, employeeID, time.Now(), location(view.Timezone))
+	if err != nil {
+		return nil, err
+	}
+
+	if query.CountOnly {
+		count, err := s.Tasks.Count(ctx, orgID, taskQuery)
+		if err != nil {
+			return nil, taskError(err)
+		}
+		return map[string]interface{}{"count": count}, nil
+	}
+
+	taskQuery.Page = query.Page
+	taskQuery.Limit = query.Limit
+	taskQuery.Cursor = query.Cursor
+	taskQuery.CursorLimit = query.CursorLimit
+	taskQuery.PaginationType = query.PaginationType
+	response, err := s.Tasks.List(ctx, orgID, taskQuery)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	return response, nil
+}
+
+// owned returns a view when the employee owns it
+func (s *TaskViewService) owned(ctx context.Context, orgID, employeeID, id int) (*ent.TaskView, error) {
+	view, err := s.Get(ctx, orgID, employeeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if view.EmployeeID != employeeID {
+		return nil, &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only the owner can change this task view",
+		}
+	}
+	return view, nil
+}
+
+// checkSharing checks that the project of a view belongs to the organization
+// and, for views shared with it, that the owner is one of its members
+func (s *TaskViewService) checkSharing(ctx context.Context, orgID, employeeID int, visibility taskview.Visibility, projectID *int) error {
+	if projectID == nil {
+		if visibility == taskview.VisibilityProject {
+			return &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "project_id is required to share a view with a project",
+			}
+		}
+		return nil
+	}
+
+	projectQuery := s.Client.Project.Query().
+		Where(project.ID(*projectID), project.OrgID(orgID))
+	if visibility == taskview.VisibilityProject {
+		projectQuery = projectQuery.Where(project.HasMembersWith(employee.ID(employeeID)))
+	}
+	exists, err := projectQuery.Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project",
+		}
+	}
+	if !exists {
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Project not found or you are not a member of it",
+		}
+	}
+	return nil
+}
+
+// checkFilters checks that saved filters run, by listing the first task they
+// match with the same validation as the task list
+func (s *TaskViewService) checkFilters(ctx context.Context, orgID, employeeID int, filters map[string]string, timezone string) error {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    "Invalid timezone " + timezone,
+		}
+	}
+	taskQuery, err := listQuery(filters, employeeID, time.Now(), loc)
+	if err != nil {
+		return err
+	}
+	taskQuery.PaginationType = "cursor"
+	taskQuery.CursorLimit = 1
+	if _, err := s.Tasks.List(ctx, orgID, taskQuery); err != nil {
+		return taskError(err)
+	}
+	return nil
+}
+
+// visibleTo selects the views an employee can see
+func visibleTo(employeeID int) predicate.TaskView {
+	return taskview.Or(
+		taskview.EmployeeID(employeeID),
+		taskview.VisibilityEQ(taskview.VisibilityOrganization),
+		taskview.And(
+			taskview.VisibilityEQ(taskview.VisibilityProject),
+			taskview.HasProjectWith(project.HasMembersWith(employee.ID(employeeID))),
+		),
+	)
+}
+
+// location returns the time zone of a view, UTC when it no longer loads
+func location(timezone string) *time.Location {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// taskError converts an error of the task service
+func taskError(err error) error {
+	if taskErr, ok := err.(*task.ServiceError); ok {
+		return &ServiceError{
+			Status: taskErr.Status,
+			Msg:    taskErr.Msg,
+		}
+	}
+	return err
+}
@@ -0,0 +1,325 @@
+package workflow
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+)
+
+// Status categories group custom statuses for boards and reports
+const (
+	CategoryTodo  = "todo"
+	CategoryDoing = "doing"
+	CategoryDone  = "done"
+)
+
+// Guards that can be attached to a transition
+const (
+	// GuardCreatorOnly lets only the task creator take the transition
+	GuardCreatorOnly = "creator_only"
+	// GuardAssigneeOnly lets only an assignee of the task take the transition
+	GuardAssigneeOnly = "assignee_only"
+	// GuardRequiresReport requires at least one report on the task
+	GuardRequiresReport = "requires_report"
+	// GuardRequiresAcceptedReport requires an accepted report on the task
+	GuardRequiresAcceptedReport = "requires_accepted_report"
+)
+
+// Guards lists every supported guard
+var Guards = []string{
+	GuardCreatorOnly,
+	GuardAssigneeOnly,
+	GuardRequiresReport,
+	GuardRequiresAcceptedReport,
+}
+
+// systemStatuses lists the built-in task statuses each category may map to;
+// the first one is the default
+var systemStatuses = map[string][]string{
+	CategoryTodo:  {string(task.StatusNotReceived), string(task.StatusReceived)},
+	CategoryDoing: {string(task.StatusInProgress)},
+	CategoryDone:  {string(task.StatusCompleted), string(task.StatusCancelled)},
+}
+
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// Status is a status of a workflow
+type Status struct {
+	Key          string `json:"key"`
+	Name         string `json:"name"`
+	Category     string `json:"category"`
+	SystemStatus string `json:"system_status"`
+	Position     int    `json:"position"`
+	// WIPLimit caps the number of tasks in the status' board column
+	WIPLimit *int `json:"wip_limit"`
+}
+
+// Transition is an allowed move between two statuses. An empty From allows
+// the move from any status.
+type Transition struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Guards []string `json:"guards"`
+}
+
+// Definition is the workflow of a project. Projects without their own
+// workflow use Default.
+type Definition struct {
+	ProjectID   int          `json:"project_id"`
+	Custom      bool         `json:"custom"`
+	Statuses    []Status     `json:"statuses"`
+	Transitions []Transition `json:"transitions"`
+}
+
+// Default returns the built-in workflow, whose status keys are the built-in
+// task statuses
+func Default(projectID int) *Definition {
+	return &Definition{
+		ProjectID: projectID,
+		Statuses: []Status{
+			{Key: "not_received", Name: "Not received", Category: CategoryTodo, SystemStatus: "not_received", Position: 0},
+			{Key: "received", Name: "Received", Category: CategoryTodo, SystemStatus: "received", Position: 1},
+			{Key: "in_progress", Name: "In progress", Category: CategoryDoing, SystemStatus: "in_progress", Position: 2},
+			{Key: "completed", Name: "Completed", Category: CategoryDone, SystemStatus: "completed", Position: 3},
+			{Key: "cancelled", Name: "Cancelled", Category: CategoryDone, SystemStatus: "cancelled", Position: 4},
+		},
+		Transitions: []Transition{
+			{From: "not_received", To: "received"},
+			{From: "not_received", To: "cancelled"},
+			{From: "received", To: "in_progress"},
+			{From: "received", To: "completed"},
+			{From: "received", To: "cancelled"},
+			{From: "in_progress", To: "completed"},
+			{From: "in_progress", To: "cancelled"},
+			{From: "completed", To: "in_progress"},
+			{From: "cancelled", To: "not_received"},
+		},
+	}
+}
+
+// Status returns the status with the given key
+func (d *Definition) Status(key string) (*Status, bool) {
+	for i := range d.Statuses {
+		if d.Statuses[i].Key == key {
+			return &d.Statuses[i], true
+		}
+	}
+	return nil, false
+}
+
+// Initial returns the status given to new tasks
+func (d *Definition) Initial() *Status {
+	return &d.Statuses[0]
+}
+
+// Transition returns the transition between two statuses, if allowed
+func (d *Definition) Transition(from, to string) (*Transition, bool) {
+	var wildcard *Transition
+	for i := range d.Transitions {
+		t := &d.Transitions[i]
+		if t.To != to {
+			continue
+		}
+		if t.From == from {
+			return t, true
+		}
+		if t.From == "" && wildcard == nil {
+			wildcard = t
+		}
+	}
+	return wildcard, wildcard != nil
+}
+
+// Resolve finds the status for a requested key. Built-in status names are
+// accepted too, so clients unaware of custom workflows keep working.
+func (d *Definition) Resolve(key string) (*Status, bool) {
+	if st, ok := d.Status(key); ok {
+		return st, true
+	}
+	return d.ForSystemStatus(key)
+}
+
+// Next returns the first status, in workflow order, that matches and can be
+// reached from a status
+func (d *Definition) Next(from string, match func(*Status) bool) (*Status, bool) {
+	for i := range d.Statuses {
+		st := &d.Statuses[i]
+		if st.Key == from || !match(st) {
+			continue
+		}
+		if _, ok := d.Transition(from, st.Key); ok {
+			return st, true
+		}
+	}
+	return nil, false
+}
+
+// ForSystemStatus returns the first status mapped to a built-in status
+func (d *Definition) ForSystemStatus(systemStatus string) (*Status, bool) {
+	for i := range d.Statuses {
+		if d.Statuses[i].SystemStatus == systemStatus {
+			return &d.Statuses[i], true
+		}
+	}
+	return nil, false
+}
+
+// Remap finds the status a task keeps when moved into this workflow: the same
+// key, else the first status with the same built-in status, else the first
+// one of the same category, else the initial status
+func (d *Definition) Remap(key, systemStatus string) *Status {
+	if st, ok := d.Status(key); ok && st.SystemStatus == systemStatus {
+		return st
+	}
+	if st, ok := d.ForSystemStatus(systemStatus); ok {
+		return st
+	}
+	category := CategoryOf(systemStatus)
+	for i := range d.Statuses {
+		if d.Statuses[i].Category == category {
+			return &d.Statuses[i]
+		}
+	}
+	return d.Initial()
+}
+
+// Validate checks the statuses and transitions of a definition and fills
+// default system statuses and positions. Transitions may go back to earlier
+// statuses, so tasks can be reopened, but not from a status to itself.
+func (d *Definition) Validate() error {
+	if len(d.Statuses) == 0 {
+		return fmt.Errorf("a workflow needs at least one status")
+	}
+	if d.Statuses[0].Category != CategoryTodo {
+		return fmt.Errorf("the first status must be in the todo category")
+	}
+
+	seen := make(map[string]bool, len(d.Statuses))
+	for i := range d.Statuses {
+		st := &d.Statuses[i]
+		if !keyPattern.MatchString(st.Key) {
+			return fmt.Errorf("invalid status key %q, use lowercase letters, digits and underscores", st.Key)
+		}
+		if seen[st.Key] {
+			return fmt.Errorf("duplicate status key %q", st.Key)
+		}
+		seen[st.Key] = true
+
+		allowed, ok := systemStatuses[st.Category]
+		if !ok {
+			return fmt.Errorf("invalid category %q for status %q", st.Category, st.Key)
+		}
+		if st.SystemStatus == "" {
+			st.SystemStatus = allowed[0]
+		}
+		if !contains(allowed, st.SystemStatus) {
+			return fmt.Errorf("status %q in category %s cannot map to %s", st.Key, st.Category, st.SystemStatus)
+		}
+		if st.Name == "" {
+			st.Name = st.Key
+		}
+		if st.WIPLimit != nil && *st.WIPLimit < 1 {
+			return fmt.Errorf("the WIP limit of status %q must be at least 1", st.Key)
+		}
+		st.Position = i
+	}
+
+	pairs := make(map[[2]string]bool, len(d.Transitions))
+	for _, t := range d.Transitions {
+		if t.From != "" && !seen[t.From] {
+			return fmt.Errorf("transition from unknown status %q", t.From)
+		}
+		if !seen[t.To] {
+			return fmt.Errorf("transition to unknown status %q", t.To)
+		}
+		if t.From == t.To {
+			return fmt.Errorf("transition from %q to itself", t.To)
+		}
+		if pairs[[2]string{t.From, t.To}] {
+			return fmt.Errorf("duplicate transition from %q to %q", t.From, t.To)
+		}
+		pairs[[2]string{t.From, t.To}] = true
+		for _, g := range t.Guards {
+			if !contains(Guards, g) {
+				return fmt.Errorf("unknown guard %q", g)
+			}
+		}
+	}
+
+	// No task could ever enter a status that cannot be reached from the
+	// initial one
+	initial := d.Statuses[0].Key
+	reached := map[string]bool{initial: true}
+	for queue := []string{initial}; len(queue) > 0; queue = queue[1:] {
+		for _, t := range d.Transitions {
+			if (t.From == queue[0] || t.From == "") && !reached[t.To] {
+				reached[t.To] = true
+				queue = append(queue, t.To)
+			}
+		}
+	}
+	for _, st := range d.Statuses {
+		if !reached[st.Key] {
+			return fmt.Errorf("status %q cannot be reached from %q", st.Key, initial)
+		}
+	}
+	return nil
+}
+
+// CategoryOf returns the category of a built-in task status
+func CategoryOf(systemStatus string) string {
+	for category, statuses := range systemStatuses {
+		if contains(statuses, systemStatus) {
+			return category
+		}
+	}
+	return CategoryTodo
+}
+
+// StatusKey returns the workflow status key of a task. Tasks created before
+// workflows existed only have a built-in status.
+func StatusKey(t *ent.Task) string {
+	if t.WorkflowStatus != "" {
+		return t.WorkflowStatus
+	}
+	return string(t.Status)
+}
+
+// fromRows builds a definition from stored statuses and transitions
+func fromRows(projectID int, statuses []*ent.WorkflowStatus, transitions []*ent.WorkflowTransition) *Definition {
+	d := &Definition{
+		ProjectID:   projectID,
+		Custom:      true,
+		Statuses:    make([]Status, len(statuses)),
+		Transitions: make([]Transition, len(transitions)),
+	}
+	for i, st := range statuses {
+		d.Statuses[i] = Status{
+			Key:          st.Key,
+			Name:         st.Name,
+			Category:     string(st.Category),
+			SystemStatus: string(st.SystemStatus),
+			Position:     st.Position,
+			WIPLimit:     st.WipLimit,
+		}
+	}
+	for i, t := range transitions {
+		d.Transitions[i] = Transition{
+			From:   t.FromKey,
+			To:     t.ToKey,
+			Guards: t.Guards,
+		}
+	}
+	return d
+}
+
+func contains(values []string, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
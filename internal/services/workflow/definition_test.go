@@ -0,0 +1,191 @@
+package workflow
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	limit := func(n int) *int { return &n }
+	statuses := func(keys ...string) []Status {
+		categories := []string{CategoryTodo, CategoryDoing, CategoryDone}
+		list := make([]Status, len(keys))
+		for i, key := range keys {
+			list[i] = Status{Key: key, Category: categories[min(i, len(categories)-1)]}
+		}
+		return list
+	}
+
+	tests := []struct {
+		name    string
+		def     *Definition
+		wantErr string
+	}{
+		{
+			name: "default workflow with reopening cycles",
+			def:  Default(1),
+		},
+		{
+			name: "linear workflow",
+			def: &Definition{
+				Statuses:    statuses("backlog", "review", "done"),
+				Transitions: []Transition{{From: "backlog", To: "review"}, {From: "review", To: "done", Guards: []string{GuardCreatorOnly}}},
+			},
+		},
+		{
+			name: "cycle between statuses",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing", "done"),
+				Transitions: []Transition{{From: "todo", To: "doing"}, {From: "doing", To: "done"}, {From: "done", To: "todo"}},
+			},
+		},
+		{
+			name: "status reached by a wildcard transition",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing", "done"),
+				Transitions: []Transition{{From: "todo", To: "doing"}, {To: "done"}},
+			},
+		},
+		{
+			name: "single status",
+			def:  &Definition{Statuses: statuses("todo")},
+		},
+		{
+			name:    "no statuses",
+			def:     &Definition{},
+			wantErr: "at least one status",
+		},
+		{
+			name: "initial status not todo",
+			def: &Definition{
+				Statuses: []Status{{Key: "doing", Category: CategoryDoing}},
+			},
+			wantErr: "first status",
+		},
+		{
+			name:    "invalid key",
+			def:     &Definition{Statuses: statuses("To Do")},
+			wantErr: "invalid status key",
+		},
+		{
+			name: "duplicate key",
+			def: &Definition{
+				Statuses:    []Status{{Key: "todo", Category: CategoryTodo}, {Key: "todo", Category: CategoryDone}},
+				Transitions: []Transition{{From: "todo", To: "todo"}},
+			},
+			wantErr: "duplicate status key",
+		},
+		{
+			name: "invalid category",
+			def: &Definition{
+				Statuses:    []Status{{Key: "todo", Category: CategoryTodo}, {Key: "later", Category: "later"}},
+				Transitions: []Transition{{From: "todo", To: "later"}},
+			},
+			wantErr: "invalid category",
+		},
+		{
+			name:    "system status of another category",
+			def:     &Definition{Statuses: []Status{{Key: "todo", Category: CategoryTodo, SystemStatus: "completed"}}},
+			wantErr: "cannot map to completed",
+		},
+		{
+			name:    "WIP limit below one",
+			def:     &Definition{Statuses: []Status{{Key: "todo", Category: CategoryTodo, WIPLimit: limit(0)}}},
+			wantErr: "WIP limit",
+		},
+		{
+			name: "transition from unknown status",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing"),
+				Transitions: []Transition{{From: "todo", To: "doing"}, {From: "review", To: "doing"}},
+			},
+			wantErr: "transition from unknown status",
+		},
+		{
+			name: "transition to unknown status",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing"),
+				Transitions: []Transition{{From: "todo", To: "review"}},
+			},
+			wantErr: "transition to unknown status",
+		},
+		{
+			name: "transition from a status to itself",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing"),
+				Transitions: []Transition{{From: "todo", To: "doing"}, {From: "doing", To: "doing"}},
+			},
+			wantErr: "to itself",
+		},
+		{
+			name: "duplicate transition",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing"),
+				Transitions: []Transition{{From: "todo", To: "doing"}, {From: "todo", To: "doing"}},
+			},
+			wantErr: "duplicate transition",
+		},
+		{
+			name: "unknown guard",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing"),
+				Transitions: []Transition{{From: "todo", To: "doing", Guards: []string{"manager_only"}}},
+			},
+			wantErr: "unknown guard",
+		},
+		{
+			name: "status without incoming transition",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing", "done"),
+				Transitions: []Transition{{From: "todo", To: "doing"}, {From: "done", To: "todo"}},
+			},
+			wantErr: `status "done" cannot be reached`,
+		},
+		{
+			name: "cycle cut off from the initial status",
+			def: &Definition{
+				Statuses:    statuses("todo", "doing", "review", "done"),
+				Transitions: []Transition{{From: "todo", To: "done"}, {From: "doing", To: "review"}, {From: "review", To: "doing"}},
+			},
+			wantErr: `status "doing" cannot be reached`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.def.Validate()
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("Validate() = %v, want no error", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("Validate() = nil, want an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("Validate() = %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateFillsDefaults(t *testing.T) {
+	def := &Definition{
+		Statuses: []Status{
+			{Key: "backlog", Category: CategoryTodo, Position: 7},
+			{Key: "review", Name: "In review", Category: CategoryDoing},
+			{Key: "dropped", Category: CategoryDone, SystemStatus: "cancelled"},
+		},
+		Transitions: []Transition{{From: "backlog", To: "review"}, {To: "dropped"}},
+	}
+	if err := def.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Status{
+		{Key: "backlog", Name: "backlog", Category: CategoryTodo, SystemStatus: "not_received", Position: 0},
+		{Key: "review", Name: "In review", Category: CategoryDoing, SystemStatus: "in_progress", Position: 1},
+		{Key: "dropped", Name: "dropped", Category: CategoryDone, SystemStatus: "cancelled", Position: 2},
+	}
+	for i, st := range def.Statuses {
+		if st != want[i] {
+			t.Errorf("status %d = %+v, want %+v", i, st, want[i])
+		}
+	}
+}
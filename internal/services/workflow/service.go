@@ -0,0 +1,322 @@
+package workflow
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/longgggwwww/hrm-ms-hr/ent"
+	"github.com/longgggwwww/hrm-ms-hr/ent/project"
+	"github.com/longgggwwww/hrm-ms-hr/ent/task"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowstatus"
+	"github.com/longgggwwww/hrm-ms-hr/ent/workflowtransition"
+	"github.com/longgggwwww/hrm-ms-hr/internal/dtos"
+	projectService "github.com/longgggwwww/hrm-ms-hr/internal/services/project"
+)
+
+// ServiceError represents a service-level error
+type ServiceError struct {
+	Status int
+	Msg    string
+}
+
+func (e *ServiceError) Error() string {
+	return e.Msg
+}
+
+// WorkflowService provides business logic for project workflows
+type WorkflowService struct {
+	Client *ent.Client
+}
+
+// NewWorkflowService creates a new workflow service
+func NewWorkflowService(client *ent.Client) *WorkflowService {
+	return &WorkflowService{
+		Client: client,
+	}
+}
+
+// Load returns the workflow of a project, or the default workflow when the
+// project has none or projectID is 0
+func Load(ctx context.Context, client *ent.Client, projectID int) (*Definition, error) {
+	if projectID == 0 {
+		return Default(0), nil
+	}
+
+	statuses, err := client.WorkflowStatus.Query().
+		Where(workflowstatus.ProjectID(projectID)).
+		Order(ent.Asc(workflowstatus.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return Default(projectID), nil
+	}
+
+	transitions, err := client.WorkflowTransition.Query().
+		Where(workflowtransition.ProjectID(projectID)).
+		Order(ent.Asc(workflowtransition.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fromRows(projectID, statuses, transitions), nil
+}
+
+// Get returns the workflow of a project of the organization
+func (s *WorkflowService) Get(ctx context.Context, projectID, orgID int) (*Definition, error) {
+	if err := s.checkProject(ctx, projectID, orgID); err != nil {
+		return nil, err
+	}
+
+	def, err := Load(ctx, s.Client, projectID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch workflow",
+		}
+	}
+	return def, nil
+}
+
+// Replace sets the workflow of a project. Tasks whose status no longer exists
+// are moved to the closest status of the new workflow.
+func (s *WorkflowService) Replace(ctx context.Context, projectID, orgID, employeeID int, input dtos.WorkflowInput) (*Definition, error) {
+	if err := s.checkEditor(ctx, projectID, orgID, employeeID); err != nil {
+		return nil, err
+	}
+
+	def := &Definition{
+		ProjectID:   projectID,
+		Custom:      true,
+		Statuses:    make([]Status, len(input.Statuses)),
+		Transitions: make([]Transition, len(input.Transitions)),
+	}
+	for i, st := range input.Statuses {
+		def.Statuses[i] = Status{
+			Key:          st.Key,
+			Name:         st.Name,
+			Category:     st.Category,
+			SystemStatus: st.SystemStatus,
+			WIPLimit:     st.WIPLimit,
+		}
+	}
+	for i, t := range input.Transitions {
+		def.Transitions[i] = Transition{
+			From:   t.From,
+			To:     t.To,
+			Guards: t.Guards,
+		}
+	}
+	return s.save(ctx, def)
+}
+
+// SetWIPLimits sets the WIP limits of a project's board columns, keyed by
+// status. A nil limit removes it. Projects on the default workflow get their
+// own copy of it to hold the limits.
+func (s *WorkflowService) SetWIPLimits(ctx context.Context, projectID, orgID, employeeID int, limits map[string]*int) (*Definition, error) {
+	if err := s.checkEditor(ctx, projectID, orgID, employeeID); err != nil {
+		return nil, err
+	}
+
+	def, err := Load(ctx, s.Client, projectID)
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch workflow",
+		}
+	}
+	for key, limit := range limits {
+		st, ok := def.Status(key)
+		if !ok {
+			return nil, &ServiceError{
+				Status: http.StatusBadRequest,
+				Msg:    "Unknown status '" + key + "' for this project's workflow",
+			}
+		}
+		st.WIPLimit = limit
+	}
+	def.Custom = true
+	return s.save(ctx, def)
+}
+
+// save validates a definition and stores it as the workflow of its project
+func (s *WorkflowService) save(ctx context.Context, def *Definition) (*Definition, error) {
+	if err := def.Validate(); err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusBadRequest,
+			Msg:    err.Error(),
+		}
+	}
+	projectID := def.ProjectID
+
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		if err := deleteStored(ctx, tx, projectID); err != nil {
+			return err
+		}
+
+		statusCreates := make([]*ent.WorkflowStatusCreate, len(def.Statuses))
+		for i, st := range def.Statuses {
+			statusCreates[i] = tx.WorkflowStatus.Create().
+				SetProjectID(projectID).
+				SetKey(st.Key).
+				SetName(st.Name).
+				SetCategory(workflowstatus.Category(st.Category)).
+				SetSystemStatus(workflowstatus.SystemStatus(st.SystemStatus)).
+				SetPosition(st.Position).
+				SetNillableWipLimit(st.WIPLimit)
+		}
+		if err := tx.WorkflowStatus.CreateBulk(statusCreates...).Exec(ctx); err != nil {
+			return err
+		}
+
+		if len(def.Transitions) > 0 {
+			transitionCreates := make([]*ent.WorkflowTransitionCreate, len(def.Transitions))
+			for i, t := range def.Transitions {
+				transitionCreates[i] = tx.WorkflowTransition.Create().
+					SetProjectID(projectID).
+					SetFromKey(t.From).
+					SetToKey(t.To).
+					SetGuards(t.Guards)
+			}
+			if err := tx.WorkflowTransition.CreateBulk(transitionCreates...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return remapTasks(ctx, tx, def)
+	})
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to save workflow",
+		}
+	}
+	return def, nil
+}
+
+// Reset removes a project's own workflow so that it uses the default one again
+func (s *WorkflowService) Reset(ctx context.Context, projectID, orgID, employeeID int) (*Definition, error) {
+	if err := s.checkEditor(ctx, projectID, orgID, employeeID); err != nil {
+		return nil, err
+	}
+
+	def := Default(projectID)
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		if err := deleteStored(ctx, tx, projectID); err != nil {
+			return err
+		}
+		return remapTasks(ctx, tx, def)
+	})
+	if err != nil {
+		return nil, &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to reset workflow",
+		}
+	}
+	return def, nil
+}
+
+// checkProject makes sure the project exists in the organization
+func (s *WorkflowService) checkProject(ctx context.Context, projectID, orgID int) error {
+	exists, err := s.Client.Project.Query().
+		Where(project.ID(projectID), project.OrgID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to fetch project",
+		}
+	}
+	if !exists {
+		return &ServiceError{
+			Status: http.StatusNotFound,
+			Msg:    "Project not found",
+		}
+	}
+	return nil
+}
+
+// checkEditor makes sure the project exists in the organization and the
+// employee's project role allows changing its workflow
+func (s *WorkflowService) checkEditor(ctx context.Context, projectID, orgID, employeeID int) error {
+	if err := s.checkProject(ctx, projectID, orgID); err != nil {
+		return err
+	}
+	role, err := projectService.MemberRole(ctx, s.Client, projectID, employeeID)
+	if err != nil {
+		return &ServiceError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Failed to check project role",
+		}
+	}
+	if !projectService.Allows(role, projectService.ActionEditProject) {
+		return &ServiceError{
+			Status: http.StatusForbidden,
+			Msg:    "Only project owners and managers can change its workflow",
+		}
+	}
+	return nil
+}
+
+// withTx runs fn in a transaction, rolling back on error or panic
+func (s *WorkflowService) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// deleteStored deletes the stored workflow of a project
+func deleteStored(ctx context.Context, tx *ent.Tx, projectID int) error {
+	if _, err := tx.WorkflowTransition.Delete().
+		Where(workflowtransition.ProjectID(projectID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.WorkflowStatus.Delete().
+		Where(workflowstatus.ProjectID(projectID)).
+		Exec(ctx)
+	return err
+}
+
+// remapTasks moves the project's tasks to the closest status of a workflow
+func remapTasks(ctx context.Context, tx *ent.Tx, def *Definition) error {
+	tasks, err := tx.Task.Query().
+		Where(task.ProjectID(def.ProjectID)).
+		Select(task.FieldStatus, task.FieldWorkflowStatus).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	moves := make(map[string][]int)
+	for _, t := range tasks {
+		st := def.Remap(StatusKey(t), string(t.Status))
+		if st.Key != t.WorkflowStatus || st.SystemStatus != string(t.Status) {
+			moves[st.Key] = append(moves[st.Key], t.ID)
+		}
+	}
+	for key, ids := range moves {
+		st, _ := def.Status(key)
+		if err := tx.Task.Update().
+			Where(task.IDIn(ids...)).
+			SetWorkflowStatus(st.Key).
+			SetStatus(task.Status(st.SystemStatus)).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"os"
+	"strings"
+)
+
+// Config holds attachment storage configuration
+type Config struct {
+	// Driver selects the backend: "local" (default) or "s3"
+	Driver   string
+	LocalDir string
+
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	// S3PathStyle addresses the bucket as a path segment, as MinIO expects
+	S3PathStyle bool
+
+	// ClamdAddr is the TCP address of a clamd daemon used to scan uploads
+	ClamdAddr string
+	// SigningKey signs attachment download URLs
+	SigningKey string
+}
+
+// NewConfig creates a new storage configuration from environment variables
+func NewConfig() *Config {
+	cfg := &Config{
+		Driver:      strings.ToLower(os.Getenv("STORAGE_DRIVER")),
+		LocalDir:    os.Getenv("STORAGE_LOCAL_DIR"),
+		S3Endpoint:  os.Getenv("S3_ENDPOINT"),
+		S3Region:    os.Getenv("S3_REGION"),
+		S3Bucket:    os.Getenv("S3_BUCKET"),
+		S3AccessKey: os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey: os.Getenv("S3_SECRET_KEY"),
+		S3PathStyle: os.Getenv("S3_PATH_STYLE") == "true",
+		ClamdAddr:   os.Getenv("CLAMD_ADDR"),
+		SigningKey:  os.Getenv("ATTACHMENT_SIGNING_KEY"),
+	}
+	if cfg.Driver == "" {
+		cfg.Driver = "local"
+	}
+	if cfg.LocalDir == "" {
+		cfg.LocalDir = "./uploads"
+	}
+	if cfg.S3Region == "" {
+		cfg.S3Region = "us-east-1"
+	}
+	return cfg
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// Scanner checks uploaded content for malware before it is stored
+type Scanner interface {
+	// Scan reads the content and reports whether it is infected, with the
+	// name of the detected signature
+	Scan(ctx context.Context, content io.Reader) (infected bool, signature string, err error)
+}
+
+// NewScanner returns the scanner selected by the configuration, or nil when
+// scanning is not configured
+func NewScanner(cfg *Config) Scanner {
+	if cfg.ClamdAddr == "" {
+		return nil
+	}
+	return &ClamdScanner{Addr: cfg.ClamdAddr, Timeout: time.Minute}
+}
+
+// ClamdScanner scans content with a ClamAV daemon using the INSTREAM command
+type ClamdScanner struct {
+	Addr    string
+	Timeout time.Duration
+}
+
+func (s *ClamdScanner) Scan(ctx context.Context, content io.Reader) (bool, string, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return false, "", err
+	}
+	defer conn.Close()
+	if s.Timeout > 0 {
+		conn.SetDeadline(time.Now().Add(s.Timeout))
+	}
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return false, "", err
+	}
+
+	// The content is sent in chunks, each prefixed by its length, and
+	// terminated by a zero-length chunk
+	buf := make([]byte, 32*1024)
+	size := make([]byte, 4)
+	for {
+		n, readErr := content.Read(buf)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size, uint32(n))
+			if _, err := conn.Write(size); err != nil {
+				return false, "", err
+			}
+			if _, err := conn.Write(buf[:n]); err != nil {
+				return false, "", err
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return false, "", readErr
+		}
+	}
+	binary.BigEndian.PutUint32(size, 0)
+	if _, err := conn.Write(size); err != nil {
+		return false, "", err
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && err != io.EOF {
+		return false, "", err
+	}
+	reply = strings.TrimRight(reply, "\x00\n")
+
+	// Replies look like "stream: OK" or "stream: Eicar-Signature FOUND"
+	switch {
+	case strings.HasSuffix(reply, " OK"):
+		return false, "", nil
+	case strings.HasSuffix(reply, " FOUND"):
+		signature := strings.TrimSuffix(strings.TrimPrefix(reply, "stream: "), " FOUND")
+		return true, signature, nil
+	default:
+		return false, "", fmt.Errorf("storage: unexpected clamd reply %q", reply)
+	}
+}
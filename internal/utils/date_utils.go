@@ -0,0 +1,14 @@
+package utils
+
+import "time"
+
+// DayOf truncates t to midnight of the same day in the server's local time zone
+func DayOf(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// ParseDate parses a YYYY-MM-DD date in the server's local time zone
+func ParseDate(value string) (time.Time, error) {
+	return time.ParseInLocation("2006-01-02", value, time.Local)
+}